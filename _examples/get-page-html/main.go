@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	chrome "github.com/mkenney/go-chrome/tot"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/page"
@@ -34,9 +35,9 @@ func main() {
 
 	// When the page load event fires, deliver the root DOM node.
 	tab.Page().OnDOMContentEventFired(func(event *page.DOMContentEventFiredEvent) {
-		document := <-tab.DOM().GetDocument(&dom.GetDocumentParams{Depth: -1})
+		document := <-tab.DOM().GetDocument(&dom.GetDocumentParams{Depth: optional.Int(-1)})
 		outer_html_chan <- <-tab.DOM().GetOuterHTML(&dom.GetOuterHTMLParams{
-			NodeID: document.Root.NodeID,
+			NodeID: optional.Of(document.Root.NodeID),
 		})
 	})
 
@@ -50,9 +51,9 @@ func main() {
 	case result = <-outer_html_chan:
 	case <-time.After(2 * time.Second):
 		fmt.Println("timeout elapsed, requesting dom")
-		document = <-tab.DOM().GetDocument(&dom.GetDocumentParams{Depth: -1})
+		document = <-tab.DOM().GetDocument(&dom.GetDocumentParams{Depth: optional.Int(-1)})
 		result = <-tab.DOM().GetOuterHTML(&dom.GetOuterHTMLParams{
-			NodeID: document.Root.NodeID,
+			NodeID: optional.Of(document.Root.NodeID),
 		})
 	}
 	tmp, _ := json.MarshalIndent(result, "", "    ")
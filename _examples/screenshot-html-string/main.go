@@ -92,7 +92,7 @@ func main() {
 	screenshotResult := <-tab.Page().CaptureScreenshot(
 		&page.CaptureScreenshotParams{
 			Format: page.Format.Png,
-			//Quality: 100,
+			//Quality: optional.Int(100),
 		},
 	)
 	if nil != screenshotResult.Err {
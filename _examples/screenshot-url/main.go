@@ -3,14 +3,16 @@
 package main
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	chrome "github.com/mkenney/go-chrome/tot"
 	"github.com/mkenney/go-chrome/tot/emulation"
 	"github.com/mkenney/go-chrome/tot/page"
-	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 func main() {
@@ -25,8 +27,8 @@ func main() {
 		}, "", "", "", "",
 	)
 
-	// Open a tab and navigate to the URL you want to screenshot.
-	tab, err := browser.NewTab("https://www.google.com")
+	// Open a tab.
+	tab, err := browser.NewTab("about:blank")
 	if nil != err {
 		panic(err)
 	}
@@ -36,56 +38,49 @@ func main() {
 		panic(enableResult.Err)
 	}
 
-	// Create a channel to receive the screenshot data generated by the
-	// event handler.
-	results := make(chan *page.CaptureScreenshotResult)
-
-	// Create an event handler that executes when the page load event is
-	// received.
-	loadEventHandler := socket.NewEventHandler(
-		"Page.loadEventFired",
-
-		// This function will generate a screenshot and write the data
-		// to the results channel.
-		func(response *socket.Response) {
-
-			// Set the device emulation parameters.
-			overrideResult := <-tab.Emulation().SetDeviceMetricsOverride(
-				&emulation.SetDeviceMetricsOverrideParams{
-					Width:  1440,
-					Height: 1440,
-					ScreenOrientation: &emulation.ScreenOrientation{
-						Type:  emulation.OrientationType.PortraitPrimary,
-						Angle: 90,
-					},
-				},
-			)
-			if nil != overrideResult.Err {
-				panic(overrideResult.Err)
-			}
+	// Start waiting for the page load event before navigating so the event
+	// can't be missed.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	loadWaiter := tab.Page().WaitLoadEventFired(ctx)
+
+	// Navigate to the URL you want to screenshot.
+	if navigateResult := <-tab.Page().Navigate(
+		&page.NavigateParams{URL: "https://www.google.com"},
+	); nil != navigateResult.Err {
+		panic(navigateResult.Err)
+	}
 
-			// Capture a screenshot of the current state of the current
-			// page.
-			screenshotResult := <-tab.Page().CaptureScreenshot(
-				&page.CaptureScreenshotParams{
-					Format:  page.Format.Jpeg,
-					Quality: 50,
-				},
-			)
-			if nil != screenshotResult.Err {
-				panic(screenshotResult.Err)
-			}
+	// Wait for the page to load.
+	if _, err := loadWaiter.Wait(); nil != err {
+		panic(err)
+	}
 
-			results <- screenshotResult
+	// Set the device emulation parameters.
+	overrideResult := <-tab.Emulation().SetDeviceMetricsOverride(
+		&emulation.SetDeviceMetricsOverrideParams{
+			Width:  1440,
+			Height: 1440,
+			ScreenOrientation: &emulation.ScreenOrientation{
+				Type:  emulation.OrientationType.PortraitPrimary,
+				Angle: 90,
+			},
 		},
 	)
+	if nil != overrideResult.Err {
+		panic(overrideResult.Err)
+	}
 
-	// Add the handler to the stack for this tab. Multiple handlers can
-	// listen to the same event.
-	tab.AddEventHandler(loadEventHandler)
-
-	// Wait for the handler to fire
-	result := <-results
+	// Capture a screenshot of the current state of the current page.
+	result := <-tab.Page().CaptureScreenshot(
+		&page.CaptureScreenshotParams{
+			Format:  page.Format.Jpeg,
+			Quality: optional.Int(50),
+		},
+	)
+	if nil != result.Err {
+		panic(result.Err)
+	}
 
 	// Decode the base64 encoded image data
 	data, err := base64.StdEncoding.DecodeString(result.Data)
@@ -0,0 +1,486 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+const testImport = "github.com/mkenney/go-chrome/tot"
+
+func TestSplitWords(t *testing.T) {
+	tests := map[string]string{
+		"frameId":                "frame Id",
+		"DOMDebugger":            "DOM Debugger",
+		"AXNode":                 "AX Node",
+		"no-referrer-when-cross": "no referrer when cross",
+		"ScreencastFrame":        "Screencast Frame",
+		"webp":                   "webp",
+		"utf8Encoded":            "utf8 Encoded",
+	}
+	for name, expected := range tests {
+		if words := strings.Join(splitWords(name), " "); expected != words {
+			t.Errorf("%s: expected '%s', got '%s'", name, expected, words)
+		}
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	tests := map[string]string{
+		"frameId":          "FrameID",
+		"url":              "URL",
+		"nodeIds":          "NodeIDs",
+		"urls":             "URLs",
+		"DOMDebugger":      "DOMDebugger",
+		"IndexedDB":        "IndexedDB",
+		"subtree-modified": "SubtreeModified",
+		"3d":               "Value3d",
+		"":                 "Empty",
+	}
+	for name, expected := range tests {
+		if identifier := exportedName(name); expected != identifier {
+			t.Errorf("%s: expected '%s', got '%s'", name, expected, identifier)
+		}
+	}
+	if identifier := unexportedName("type"); "type_" != identifier {
+		t.Errorf("Expected 'type_', got '%s'", identifier)
+	}
+	if path := strings.Join(domainPath("DOMDebugger"), "/"); "dom/debugger" != path {
+		t.Errorf("Expected 'dom/debugger', got '%s'", path)
+	}
+}
+
+func TestStripDomain(t *testing.T) {
+	tests := map[string][2]string{
+		"TargetInfo":          {"Target", "Info"},
+		"targetCreated":       {"Target", "Created"},
+		"workerErrorReported": {"ServiceWorker", "ErrorReported"},
+		"Target":              {"Target", "Target"},
+		"FrameId":             {"Page", "FrameId"},
+		"pageLoaded":          {"DOMDebugger", "pageLoaded"},
+	}
+	for name, test := range tests {
+		if stripped := stripDomain(test[0], name); test[1] != stripped {
+			t.Errorf("%s: expected '%s', got '%s'", name, test[1], stripped)
+		}
+	}
+}
+
+func TestGeneratorCycles(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	generator, err := NewGenerator(protocol, nil, testImport, "tot", nil)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	page := generator.byName["Page"]
+	if !page.imports["DOM"] {
+		t.Errorf("Expected Page to import DOM, got %v", sortedKeys(page.imports))
+	}
+	dom := generator.byName["DOM"]
+	if dom.imports["Page"] {
+		t.Errorf("Expected DOM not to import Page")
+	}
+	if name := dom.dupNames["Page.FrameId"]; "FrameID" != name {
+		t.Errorf("Expected Page.FrameId to be copied into DOM as FrameID, got '%s'", name)
+	}
+}
+
+func TestGeneratorDomains(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+
+	generator, err := NewGenerator(protocol, nil, testImport, "tot", []string{"DOMDebugger"})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	var names []string
+	for _, model := range generator.domains {
+		names = append(names, model.Domain.Domain)
+	}
+	if "DOM DOMDebugger Page Runtime" != strings.Join(names, " ") {
+		t.Errorf("Expected referenced domains to be generated, got %v", names)
+	}
+
+	if _, err = NewGenerator(protocol, nil, testImport, "tot", []string{"Tethering"}); nil == err {
+		t.Errorf("Expected error, got nil")
+	}
+}
+
+func TestProtocolStable(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	stable := protocol.Stable()
+	for _, domain := range stable.Domains {
+		if "DOMDebugger" == domain.Domain {
+			t.Errorf("Expected experimental domain DOMDebugger to be removed")
+		}
+		for _, command := range domain.Commands {
+			if command.Experimental || command.Deprecated {
+				t.Errorf("Expected %s.%s to be removed", domain.Domain, command.Name)
+			}
+			for _, param := range command.Parameters {
+				if param.Experimental {
+					t.Errorf("Expected %s.%s parameter %s to be removed", domain.Domain, command.Name, param.Name)
+				}
+			}
+		}
+	}
+	if 3 != len(stable.Domains) {
+		t.Errorf("Expected 3 stable domains, got %d", len(stable.Domains))
+	}
+	if 4 != len(protocol.Domains) {
+		t.Errorf("Expected the protocol to be unchanged, got %d domains", len(protocol.Domains))
+	}
+}
+
+func TestGeneratorPinnedProtocol(t *testing.T) {
+	protocol, err := LoadProtocol("protocol")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if protocol, err = protocol.WithLegacy("protocol"); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	names, err := LoadNames("protocol")
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	for _, protocol := range []*Protocol{protocol, protocol.Stable()} {
+		generator, err := NewGenerator(protocol, names, testImport, "tot", nil)
+		if nil != err {
+			t.Fatalf("Expected nil, got error: '%s'", err.Error())
+		}
+		if _, err := generator.Files(); nil != err {
+			t.Errorf("Expected nil, got error: '%s'", err.Error())
+		}
+	}
+}
+
+func TestGeneratorNames(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	names := &Names{
+		Types: map[string]*TypeNames{
+			"Page.FrameId":    {Name: "ID"},
+			"Page.DialogType": {Domain: "DOM"},
+		},
+		Commands: map[string]*CommandNames{
+			"Page.captureScreenshot": {Name: "Screenshot"},
+			"Page.navigate":          {Types: "Goto"},
+		},
+		Events: map[string]string{"Page.loadEventFired": "Loaded"},
+		Fields: map[string]string{"Page.navigate.url": "Address"},
+		Enums: map[string]*EnumNames{
+			"Page.captureScreenshot.format": {
+				Name:   "ImageFormat",
+				File:   "enum.image_format",
+				Values: []string{"bmp"},
+			},
+		},
+	}
+	generator, err := NewGenerator(protocol, names, testImport, "tot", nil)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	files, err := generator.Files()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	sources := map[string]string{}
+	for _, file := range files {
+		sources[file.Path] = string(file.Source)
+	}
+
+	expected := map[string][]string{
+		"page/cdtp.go": {"type ID string", "ParentID ID `json:\"parentId,omitempty\"`"},
+		"page/command.go": {
+			"type ScreenshotParams struct",
+			"Format ImageFormatEnum `json:\"format,omitempty\"`",
+			"type GotoParams struct",
+			"Address string `json:\"url\"`",
+		},
+		"page/event.go":             {"type LoadedEvent struct", "Type dom.DialogTypeEnum"},
+		"page/enum.image_format.go": {"var ImageFormat = imageFormatEnum{", "Bmp  ImageFormatEnum"},
+		"dom/enum.dialog_type.go":   {"type DialogTypeEnum int"},
+		"socket/cdtp.page.go":       {") Screenshot(", "params *page.GotoParams", ") OnLoaded("},
+	}
+	for path, fragments := range expected {
+		source, ok := sources[path]
+		if !ok {
+			t.Errorf("Expected %s to be generated", path)
+			continue
+		}
+		for _, fragment := range fragments {
+			if !strings.Contains(source, fragment) {
+				t.Errorf("Expected %s to contain '%s'", path, fragment)
+			}
+		}
+	}
+	if _, ok := sources["page/enum.dialog_type.go"]; ok {
+		t.Errorf("Expected Page.DialogType to be moved to the DOM package")
+	}
+}
+
+func TestGeneratorPartial(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	generator, err := NewGenerator(protocol, nil, testImport, "tot", []string{"DOMDebugger"})
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if !generator.Partial {
+		t.Errorf("Expected a partial generator")
+	}
+	files, err := generator.Files()
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	for _, file := range files {
+		switch file.Path {
+		case "socket/interface.protocoller.go", "socket/socket.protocoller.go", "tab.socket.protocoller.go":
+			t.Errorf("Expected %s not to be generated", file.Path)
+		}
+	}
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "socket", "cdtp.fetch.go")
+	if err := os.MkdirAll(filepath.Dir(existing), 0755); nil != err {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(existing, []byte(Header), 0644); nil != err {
+		t.Fatal(err)
+	}
+	if err := WriteFiles(dir, files, !generator.Partial); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if _, err := os.Stat(existing); nil != err {
+		t.Errorf("Expected the generated files of other domains to be kept, got error: '%s'", err.Error())
+	}
+	if err := WriteFiles(dir, files, true); nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if _, err := os.Stat(existing); !os.IsNotExist(err) {
+		t.Errorf("Expected files no longer generated to be pruned")
+	}
+}
+
+func TestProtocolWithLegacy(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	dir := t.TempDir()
+	legacy := `{"domains": [
+		{"domain": "Page", "commands": [
+			{"name": "navigate", "parameters": [{"name": "url", "type": "string"}]},
+			{"name": "setAutoAttach"}
+		]},
+		{"domain": "ApplicationCache", "commands": [{"name": "enable"}]}
+	]}`
+	if err := os.WriteFile(filepath.Join(dir, LegacyFile), []byte(legacy), 0644); nil != err {
+		t.Fatal(err)
+	}
+	merged, err := protocol.WithLegacy(dir)
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	if 5 != len(merged.Domains) || 4 != len(protocol.Domains) {
+		t.Fatalf("Expected the legacy domain to be added to a copy, got %d and %d domains", len(merged.Domains), len(protocol.Domains))
+	}
+	commands := map[string]*Command{}
+	for _, domain := range merged.Domains {
+		for _, command := range domain.Commands {
+			commands[domain.Domain+"."+command.Name] = command
+		}
+	}
+	if command := commands["Page.navigate"]; nil == command || command.Deprecated {
+		t.Errorf("Expected Page.navigate to be kept, got %+v", command)
+	}
+	if command := commands["Page.setAutoAttach"]; nil == command || !command.Deprecated {
+		t.Errorf("Expected Page.setAutoAttach to be added as deprecated, got %+v", command)
+	}
+	for _, domain := range merged.Stable().Domains {
+		if "ApplicationCache" == domain.Domain {
+			t.Errorf("Expected the legacy domain to be removed by Stable")
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join("testdata", "protocol", LegacyFile)); !os.IsNotExist(err) {
+		t.Fatalf("Expected no legacy file in the fixture protocol")
+	}
+	if merged, err = protocol.WithLegacy(filepath.Join("testdata", "protocol")); nil != err || 4 != len(merged.Domains) {
+		t.Errorf("Expected the protocol to be unchanged without a legacy file")
+	}
+}
+
+/*
+TestGeneratorGolden compares the generated output for the fixture protocol with
+the golden files in testdata/golden. Run with -update to rewrite them.
+*/
+func TestGeneratorGolden(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatalf("Expected nil, got error: '%s'", err.Error())
+	}
+	for name, protocol := range map[string]*Protocol{
+		"tot":    protocol,
+		"stable": protocol.Stable(),
+	} {
+		generator, err := NewGenerator(protocol, nil, testImport, name, nil)
+		if nil != err {
+			t.Fatalf("%s: expected nil, got error: '%s'", name, err.Error())
+		}
+		files, err := generator.Files()
+		if nil != err {
+			t.Fatalf("%s: expected nil, got error: '%s'", name, err.Error())
+		}
+		dir := filepath.Join("testdata", "golden", name)
+		if *update {
+			if err := os.RemoveAll(dir); nil != err {
+				t.Fatal(err)
+			}
+		}
+
+		expected := map[string]bool{}
+		for _, file := range files {
+			path := filepath.Join(dir, filepath.FromSlash(file.Path)) + ".golden"
+			expected[path] = true
+			if *update {
+				if err := os.MkdirAll(filepath.Dir(path), 0755); nil != err {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, file.Source, 0644); nil != err {
+					t.Fatal(err)
+				}
+				continue
+			}
+			golden, err := os.ReadFile(path)
+			if nil != err {
+				t.Errorf("%s: expected golden file, got error: '%s'", file.Path, err.Error())
+				continue
+			}
+			if string(golden) != string(file.Source) {
+				t.Errorf("%s: generated output differs from %s", file.Path, path)
+			}
+		}
+
+		filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if nil == err && !info.IsDir() && !expected[path] {
+				t.Errorf("%s: golden file is no longer generated", path)
+			}
+			return nil
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	protocol, err := LoadProtocol(filepath.Join("testdata", "protocol"))
+	if nil != err {
+		t.Fatal(err)
+	}
+	methods, err := reportMethods(protocol, filepath.Join("testdata", "report"))
+	if nil != err {
+		t.Fatal(err)
+	}
+	expected := []reportMethod{
+		{"PageProtocol.CaptureScreenshot", "Page.captureScreenshot", "", "stable"},
+		{"PageProtocol.GetFrameOwner", "Page.getFrameOwner", "EXPERIMENTAL.", "experimental"},
+		{"PageProtocol.OnLoadEventFired", "Page.loadEventFired", "DEPRECATED.", "stable"},
+		{"PageProtocol.Removed", "Page.removed", "", "removed"},
+	}
+	if len(expected) != len(methods) {
+		t.Fatalf("expected %d methods, got %d", len(expected), len(methods))
+	}
+	for a, method := range methods {
+		if expected[a] != *method {
+			t.Errorf("expected %+v, got %+v", expected[a], *method)
+		}
+	}
+
+	report, err := Report(protocol, filepath.Join("testdata", "report"))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !strings.Contains(report, "3 of 4 methods are listed.") {
+		t.Errorf("expected 3 of 4 methods to be listed:\n%s", report)
+	}
+	if strings.Contains(report, "Page.captureScreenshot") {
+		t.Errorf("expected stable methods to be omitted:\n%s", report)
+	}
+	if !strings.Contains(report, "| `PageProtocol.GetFrameOwner` | `Page.getFrameOwner` | EXPERIMENTAL. | experimental |") {
+		t.Errorf("expected Page.getFrameOwner to be listed:\n%s", report)
+	}
+}
+
+func TestRuntimeFiles(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                             "module example.com/m\n",
+		"tot/chrome.go":                      "package chrome\n\nimport (\n\t\"example.com/m/totals\"\n\t\"example.com/m/tot/socket\"\n)\n",
+		"tot/context.go":                     ExperimentalDirective + "\n\npackage chrome\n",
+		"tot/tab.go":                         "package chrome\n",
+		"tot/tab.socket.protocoller_test.go": "package chrome\n",
+		"tot/pool/pool.go":                   "package pool\n",
+		"tot/socket/socket.go":               "package socket\n\nimport \"example.com/m/tot\"\n",
+		"tot/socket/cdtp.page.go":            Header + "package socket\n",
+		"tot/socket/cdtp.page_test.go":       "package socket\n",
+		"tot/socket/socket.generated.go":     Header + "package socket\n",
+		"stable/tab.go":                      "package chrome\n",
+		"stable/socket/socket.go":            Header + "package socket\n",
+	}
+	for name, src := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); nil != err {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0644); nil != err {
+			t.Fatal(err)
+		}
+	}
+
+	for stable, expected := range map[bool]string{
+		true:  "chrome.go socket/socket.go",
+		false: "chrome.go context.go socket/socket.go",
+	} {
+		runtime, err := RuntimeFiles(filepath.Join(root, "tot"), filepath.Join(root, "stable"), "example.com/m/stable", stable)
+		if nil != err {
+			t.Fatalf("Expected nil, got error: '%s'", err.Error())
+		}
+		var paths []string
+		sources := map[string]string{}
+		for _, file := range runtime {
+			paths = append(paths, file.Path)
+			sources[file.Path] = string(file.Source)
+		}
+		if expected != strings.Join(paths, " ") {
+			t.Errorf("Expected %s, got %v", expected, paths)
+		}
+		for path, src := range sources {
+			if !strings.HasPrefix(src, Header) {
+				t.Errorf("Expected %s to be marked as generated", path)
+			}
+		}
+		if !strings.Contains(sources["chrome.go"], `"example.com/m/stable/socket"`) || !strings.Contains(sources["chrome.go"], `"example.com/m/totals"`) {
+			t.Errorf("Expected only the imports of the package tree to be rewritten:\n%s", sources["chrome.go"])
+		}
+		if !strings.Contains(sources["socket/socket.go"], `import "example.com/m/stable"`) {
+			t.Errorf("Expected the import of the package tree to be rewritten:\n%s", sources["socket/socket.go"])
+		}
+	}
+}
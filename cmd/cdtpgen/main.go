@@ -0,0 +1,171 @@
+/*
+Cdtpgen generates the Chrome DevTools Protocol domain packages, enums, socket
+protocol wrappers and their tests from the protocol description files
+browser_protocol.json and js_protocol.json.
+
+Usage:
+
+	go run ./cmd/cdtpgen -out <dir> -import <import path> [flags]
+	go run ./cmd/cdtpgen -report <socket dir> > <file>
+
+The flags are:
+
+	-protocol dir
+		The directory containing the protocol description files. The
+		default is the pinned copy in cmd/cdtpgen/protocol.
+	-out dir
+		The directory to write the generated packages to.
+	-import path
+		The import path of the output directory.
+	-docs version
+		The protocol documentation version linked from doc comments. The
+		default is "tot".
+	-domains list
+		A comma separated list of domains to generate. Domains they reference
+		are also generated. The default is all domains. Partial runs don't
+		write the files listing every domain and don't remove files.
+	-stable
+		Omit experimental and deprecated domains, commands, events,
+		parameters and properties.
+	-runtime dir
+		Also copy the runtime of the package tree in dir into the output
+		directory; see RuntimeFiles.
+	-report dir
+		Instead of generating packages, print a markdown compatibility
+		report of the socket protocol methods in dir that are experimental,
+		deprecated or no longer in the protocol.
+
+The output directory receives a package per domain, the socket package protocol
+wrappers (socket/cdtp.*.go, socket/interface.protocoller.go and
+socket/socket.protocoller.go) and the chrome package Tab accessors
+(tab.socket.protocoller.go). The rest of the socket and chrome packages, the
+runtime, is written by hand in the tot package tree. Other package trees copy
+it with -runtime tot, keeping hand written versions of the files that differ
+between protocol versions.
+
+Two optional files in the protocol directory keep the API of the packages
+stable as the protocol changes. legacy.json lists, in the protocol format, the
+items removed from the protocol; they are generated as deprecated. names.json
+lists the Go names, types and packages that differ from the generated ones;
+see Names.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	protocolDir := flag.String("protocol", filepath.Join("cmd", "cdtpgen", "protocol"), "protocol description directory")
+	out := flag.String("out", "", "output directory")
+	importPath := flag.String("import", "", "import path of the output directory")
+	docs := flag.String("docs", "tot", "protocol documentation version")
+	domains := flag.String("domains", "", "comma separated domains to generate")
+	stable := flag.Bool("stable", false, "omit experimental and deprecated items")
+	runtime := flag.String("runtime", "", "package tree to copy the runtime from")
+	report := flag.String("report", "", "print a compatibility report for a socket package directory")
+	flag.Parse()
+
+	if "" != *report {
+		protocol, err := LoadProtocol(*protocolDir)
+		if nil == err {
+			var markdown string
+			if markdown, err = Report(protocol, *report); nil == err {
+				fmt.Print(markdown)
+				return
+			}
+		}
+		fmt.Fprintln(os.Stderr, "cdtpgen:", err)
+		os.Exit(1)
+	}
+
+	if "" == *out || "" == *importPath {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*protocolDir, *out, *importPath, *docs, *domains, *runtime, *stable); nil != err {
+		fmt.Fprintln(os.Stderr, "cdtpgen:", err)
+		os.Exit(1)
+	}
+}
+
+/*
+run generates the packages for a protocol description into out.
+*/
+func run(protocolDir, out, importPath, docs, domains, runtime string, stable bool) error {
+	protocol, err := LoadProtocol(protocolDir)
+	if nil != err {
+		return err
+	}
+	if protocol, err = protocol.WithLegacy(protocolDir); nil != err {
+		return err
+	}
+	if stable {
+		protocol = protocol.Stable()
+	}
+	names, err := LoadNames(protocolDir)
+	if nil != err {
+		return err
+	}
+	var selected []string
+	for _, name := range strings.Split(domains, ",") {
+		if name = strings.TrimSpace(name); "" != name {
+			selected = append(selected, name)
+		}
+	}
+
+	generator, err := NewGenerator(protocol, names, importPath, docs, selected)
+	if nil != err {
+		return err
+	}
+	files, err := generator.Files()
+	if nil != err {
+		return err
+	}
+	if "" != runtime {
+		runtimeFiles, err := RuntimeFiles(runtime, out, importPath, stable)
+		if nil != err {
+			return err
+		}
+		files = append(files, runtimeFiles...)
+	}
+	return WriteFiles(out, files, !generator.Partial)
+}
+
+/*
+WriteFiles writes generated files below dir. If prune is set, generated files
+that are no longer produced are removed.
+*/
+func WriteFiles(dir string, files []*File, prune bool) error {
+	written := map[string]bool{}
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file.Path))
+		written[path] = true
+		if err := os.MkdirAll(filepath.Dir(path), 0755); nil != err {
+			return err
+		}
+		if err := os.WriteFile(path, file.Source, 0644); nil != err {
+			return err
+		}
+	}
+	if !prune {
+		return nil
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if nil != err || info.IsDir() || written[path] || ".go" != filepath.Ext(path) {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if nil != err {
+			return err
+		}
+		if strings.HasPrefix(string(data), Header) {
+			return os.Remove(path)
+		}
+		return nil
+	})
+}
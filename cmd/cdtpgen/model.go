@@ -0,0 +1,857 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+Generator builds Go packages from a protocol description.
+*/
+type Generator struct {
+	// DocsVersion is the protocol documentation version linked from doc
+	// comments, for example "tot" or "1-3".
+	DocsVersion string
+
+	// Import is the import path of the output directory.
+	Import string
+
+	// Partial is set when only some domains are generated. The files that
+	// list every domain are then not generated.
+	Partial bool
+
+	domains []*domainModel
+	byName  map[string]*domainModel
+	names   *Names
+}
+
+/*
+domainModel is the generated package for a protocol domain.
+*/
+type domainModel struct {
+	*Domain
+
+	// GoName is the domain name used in Go identifiers, for example
+	// "DOMDebugger".
+	GoName string
+
+	// Package is the Go package name.
+	Package string
+
+	// Path is the package path relative to the output directory.
+	Path string
+
+	generator *Generator
+	types     map[string]*Type
+
+	// imports are the domains this package imports.
+	imports map[string]bool
+
+	// aliases maps imported domains to the package names they are
+	// imported as.
+	aliases map[string]string
+
+	// dups are the foreign types copied into this package to avoid import
+	// cycles, in the order they were found. dupNames maps "Domain.Type" to
+	// the local Go name of the copy.
+	dups     []*dupType
+	dupNames map[string]string
+
+	// enums are the enum types of this package, in the order they were
+	// found. enumKeys maps an enum key to its enum.
+	enums    []*enumModel
+	enumKeys map[string]*enumModel
+
+	// names are the package level identifiers in use.
+	names map[string]bool
+}
+
+/*
+dupType is a foreign type copied into a package.
+*/
+type dupType struct {
+	domain *domainModel
+	typ    *Type
+	name   string
+}
+
+/*
+enumModel is a generated enum type.
+*/
+type enumModel struct {
+	// GoType is the enum type name, for example "FormatEnum".
+	GoType string
+
+	// Var is the name of the variable providing named access to the
+	// values, for example "Format".
+	Var string
+
+	// File is the file name without the extension, for example
+	// "enum.format".
+	File string
+
+	// Source names the protocol type or property that defines the values,
+	// for example "Page.captureScreenshot.format".
+	Source string
+
+	Description string
+	Links       []string
+	Values      []*enumValue
+}
+
+/*
+enumValue is a value of an enum type.
+*/
+type enumValue struct {
+	// Ident is the field name in the enum variable, for example "Png".
+	Ident string
+
+	// Const is the unexported constant name, for example "formatPng".
+	Const string
+
+	// Value is the protocol string value.
+	Value string
+}
+
+/*
+NewGenerator returns a Generator for the domains of a protocol, named as names
+specifies. If domains is not empty only those domains and the domains they
+reference are generated.
+*/
+func NewGenerator(protocol *Protocol, names *Names, importPath string, docsVersion string, domains []string) (*Generator, error) {
+	if nil == names {
+		names = &Names{}
+	}
+	protocol, err := names.apply(protocol)
+	if nil != err {
+		return nil, err
+	}
+	generator := &Generator{
+		DocsVersion: docsVersion,
+		Import:      strings.TrimSuffix(importPath, "/"),
+		Partial:     0 != len(domains),
+		byName:      map[string]*domainModel{},
+		names:       names,
+	}
+	var all []*domainModel
+	for _, domain := range protocol.Domains {
+		path := domainPath(domain.Domain)
+		model := &domainModel{
+			Domain:    domain,
+			GoName:    domain.Domain,
+			Package:   path[len(path)-1],
+			Path:      strings.Join(path, "/"),
+			generator: generator,
+			types:     map[string]*Type{},
+			imports:   map[string]bool{},
+			aliases:   map[string]string{},
+			dupNames:  map[string]string{},
+			enumKeys:  map[string]*enumModel{},
+			names:     map[string]bool{},
+		}
+		for _, typ := range domain.Types {
+			model.types[typ.ID] = typ
+		}
+		if _, ok := generator.byName[domain.Domain]; ok {
+			return nil, fmt.Errorf("duplicate domain %s", domain.Domain)
+		}
+		generator.byName[domain.Domain] = model
+		all = append(all, model)
+	}
+
+	selected := map[string]bool{}
+	if 0 == len(domains) {
+		for _, model := range all {
+			selected[model.Domain.Domain] = true
+		}
+	}
+	for _, name := range domains {
+		if err := generator.selectDomain(name, selected); nil != err {
+			return nil, err
+		}
+	}
+	for _, model := range all {
+		if selected[model.Domain.Domain] {
+			generator.domains = append(generator.domains, model)
+		}
+	}
+
+	if err := generator.resolve(); nil != err {
+		return nil, err
+	}
+	return generator, nil
+}
+
+/*
+selectDomain selects a domain and the domains it references.
+*/
+func (generator *Generator) selectDomain(name string, selected map[string]bool) error {
+	if selected[name] {
+		return nil
+	}
+	model, ok := generator.byName[name]
+	if !ok {
+		return fmt.Errorf("unknown domain %s", name)
+	}
+	selected[name] = true
+	var err error
+	model.eachRef(func(domain string, typ string) {
+		if nil == err && domain != name {
+			err = generator.selectDomain(domain, selected)
+		}
+	})
+	return err
+}
+
+/*
+order returns the domains ordered so that declared dependencies come before
+the domains that depend on them.
+*/
+func (generator *Generator) order() []*domainModel {
+	var ordered []*domainModel
+	visited := map[string]bool{}
+	var visit func(model *domainModel)
+	visit = func(model *domainModel) {
+		if visited[model.Domain.Domain] {
+			return
+		}
+		visited[model.Domain.Domain] = true
+		for _, dependency := range model.Dependencies {
+			if dep, ok := generator.byName[dependency]; ok {
+				visit(dep)
+			}
+		}
+		ordered = append(ordered, model)
+	}
+	for _, model := range generator.domains {
+		visit(model)
+	}
+	return ordered
+}
+
+/*
+resolve decides which domains each package imports and which foreign types are
+copied to avoid import cycles, then collects the enum types.
+
+References to the imports preferred by the names are linked first, then
+references to declared dependencies so that cycles are broken on the
+undeclared side, then the remaining references in dependency order. A
+reference that would create an import cycle copies the referenced type into
+the referencing package, as the hand written packages do.
+*/
+func (generator *Generator) resolve() error {
+	ordered := generator.order()
+	passes := []func(model *domainModel, domain string) bool{
+		func(model *domainModel, domain string) bool {
+			for _, preferred := range generator.names.Imports[model.Domain.Domain] {
+				if preferred == domain {
+					return true
+				}
+			}
+			return false
+		},
+		func(model *domainModel, domain string) bool {
+			for _, dependency := range model.Dependencies {
+				if dependency == domain {
+					return true
+				}
+			}
+			return false
+		},
+		func(model *domainModel, domain string) bool {
+			return true
+		},
+	}
+	for _, pass := range passes {
+		for _, model := range ordered {
+			var err error
+			model.eachRef(func(domain string, typ string) {
+				if nil != err || domain == model.Domain.Domain || !pass(model, domain) {
+					return
+				}
+				err = generator.link(model, domain, typ)
+			})
+			if nil != err {
+				return err
+			}
+		}
+	}
+
+	for _, model := range generator.domains {
+		model.claimNames()
+		model.collectEnums()
+		model.assignAliases()
+	}
+	return nil
+}
+
+/*
+link records a reference from a package to a type in another domain.
+*/
+func (generator *Generator) link(model *domainModel, domain string, typ string) error {
+	target, ok := generator.byName[domain]
+	if !ok {
+		return fmt.Errorf("%s references unknown domain %s", model.Domain.Domain, domain)
+	}
+	if _, ok := target.types[typ]; !ok {
+		return fmt.Errorf("%s references unknown type %s.%s", model.Domain.Domain, domain, typ)
+	}
+	if model.imports[domain] {
+		return nil
+	}
+	if !generator.reachable(domain, model.Domain.Domain, map[string]bool{}) {
+		model.imports[domain] = true
+		return nil
+	}
+
+	key := domain + "." + typ
+	if _, ok := model.dupNames[key]; ok {
+		return nil
+	}
+	model.dupNames[key] = ""
+	model.dups = append(model.dups, &dupType{domain: target, typ: target.types[typ]})
+
+	// References made by the copy are resolved from this package.
+	var err error
+	eachTypeRef(domain, target.types[typ], func(refDomain string, refType string) {
+		if nil != err || refDomain == model.Domain.Domain {
+			return
+		}
+		err = generator.link(model, refDomain, refType)
+	})
+	return err
+}
+
+/*
+reachable returns whether domain from imports domain to, directly or
+indirectly.
+*/
+func (generator *Generator) reachable(from string, to string, visited map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+	model, ok := generator.byName[from]
+	if !ok {
+		return false
+	}
+	for _, domain := range sortedKeys(model.imports) {
+		if generator.reachable(domain, to, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+eachRef calls fn for every type reference made by the domain's types, commands
+and events.
+*/
+func (model *domainModel) eachRef(fn func(domain string, typ string)) {
+	name := model.Domain.Domain
+	for _, typ := range model.Types {
+		eachTypeRef(name, typ, fn)
+	}
+	for _, command := range model.Commands {
+		for _, param := range command.Parameters {
+			eachTypeRef(name, param, fn)
+		}
+		for _, param := range command.Returns {
+			eachTypeRef(name, param, fn)
+		}
+	}
+	for _, event := range model.Events {
+		for _, param := range event.Parameters {
+			eachTypeRef(name, param, fn)
+		}
+	}
+}
+
+/*
+eachTypeRef calls fn for every type reference made by a type defined in
+domain.
+*/
+func eachTypeRef(domain string, typ *Type, fn func(domain string, typ string)) {
+	if nil == typ {
+		return
+	}
+	if "" != typ.Ref {
+		refDomain, refType := splitRef(domain, typ.Ref)
+		fn(refDomain, refType)
+	}
+	eachTypeRef(domain, typ.Items, fn)
+	for _, property := range typ.Properties {
+		eachTypeRef(domain, property, fn)
+	}
+}
+
+/*
+splitRef splits a type reference into its domain and type ID. References
+without a domain belong to domain.
+*/
+func splitRef(domain string, ref string) (string, string) {
+	if k := strings.Index(ref, "."); k >= 0 {
+		return ref[:k], ref[k+1:]
+	}
+	return domain, ref
+}
+
+/*
+claimNames reserves the package level identifiers of the domain's own types,
+commands and events, then names the copied foreign types.
+*/
+func (model *domainModel) claimNames() {
+	domain := model.Domain.Domain
+	for _, typ := range model.Types {
+		if 0 == len(typ.Enum) {
+			model.names[model.generator.typeName(domain, typ)] = true
+		}
+	}
+	for _, command := range model.Commands {
+		_, types := model.generator.commandNames(domain, command)
+		model.names[types+"Params"] = true
+		model.names[types+"Result"] = true
+	}
+	for _, event := range model.Events {
+		name, _ := model.generator.eventNames(domain, event)
+		model.names[name+"Event"] = true
+	}
+	for _, dup := range model.dups {
+		if 0 != len(dup.typ.Enum) {
+			continue
+		}
+		name := exportedName(stripDomain(domain, dup.typ.ID))
+		if override, ok := model.generator.names.Types[typeKey(dup.domain.Domain.Domain, dup.typ)]; ok && "" != override.Name {
+			name = override.Name
+		}
+		dup.name = model.claim(name, dup.domain.GoName)
+		model.dupNames[dup.domain.Domain.Domain+"."+dup.typ.ID] = dup.name
+	}
+}
+
+/*
+typeName returns the Go name of a named type defined in domain.
+*/
+func (generator *Generator) typeName(domain string, typ *Type) string {
+	if override, ok := generator.names.Types[typeKey(domain, typ)]; ok && "" != override.Name {
+		return override.Name
+	}
+	return exportedName(stripDomain(domain, typ.ID))
+}
+
+/*
+commandNames returns the socket method name of a command and the prefix of its
+parameter and result types.
+*/
+func (generator *Generator) commandNames(domain string, command *Command) (string, string) {
+	method := exportedName(command.Name)
+	types := method
+	if override, ok := generator.names.Commands[domain+"."+command.Name]; ok {
+		if "" != override.Name {
+			method, types = override.Name, override.Name
+		}
+		if "" != override.Types {
+			types = override.Types
+		}
+	}
+	return method, types
+}
+
+/*
+eventNames returns the prefix of an event's type and its socket method name.
+*/
+func (generator *Generator) eventNames(domain string, event *Command) (string, string) {
+	if name, ok := generator.names.Events[domain+"."+event.Name]; ok {
+		return name, "On" + name
+	}
+	return exportedName(stripDomain(domain, event.Name)), "On" + exportedName(event.Name)
+}
+
+/*
+fieldName returns the struct field name of a property or parameter defined by
+parent in domain.
+*/
+func (generator *Generator) fieldName(domain string, parent string, name string) string {
+	if field, ok := generator.names.Fields[domain+"."+parent+"."+name]; ok {
+		return field
+	}
+	return fieldName(name)
+}
+
+/*
+typeKey returns the "Domain.Type" key of a named type defined in domain, or in
+the domain it was moved from.
+*/
+func typeKey(domain string, typ *Type) string {
+	return typeDomain(domain, typ) + "." + typ.ID
+}
+
+/*
+typeDomain returns the domain that defines a named type in the protocol, which
+differs from domain for types moved to another package.
+*/
+func typeDomain(domain string, typ *Type) string {
+	if "" != typ.origin {
+		return typ.origin
+	}
+	return domain
+}
+
+/*
+claim reserves a package level identifier. If name is taken it is prefixed
+with prefix, then numbered.
+*/
+func (model *domainModel) claim(name string, prefix string) string {
+	if !model.names[name] {
+		model.names[name] = true
+		return name
+	}
+	candidate := exportedName(prefix) + name
+	for a := 2; model.names[candidate]; a++ {
+		candidate = fmt.Sprintf("%s%s%d", exportedName(prefix), name, a)
+	}
+	model.names[candidate] = true
+	return candidate
+}
+
+/*
+collectEnums creates the enum types for named enum types and inline enum
+properties of the domain and of its copied foreign types.
+*/
+func (model *domainModel) collectEnums() {
+	name := model.Domain.Domain
+	for _, typ := range model.Types {
+		model.collectTypeEnums(model, typ)
+	}
+	for _, command := range model.Commands {
+		link := model.docsURL(name, "method-"+command.Name)
+		for _, param := range command.Parameters {
+			model.collectInlineEnum(name, command.Name, param, link)
+		}
+		for _, param := range command.Returns {
+			model.collectInlineEnum(name, command.Name, param, link)
+		}
+	}
+	for _, event := range model.Events {
+		link := model.docsURL(name, "event-"+event.Name)
+		for _, param := range event.Parameters {
+			model.collectInlineEnum(name, event.Name, param, link)
+		}
+	}
+	for _, dup := range model.dups {
+		model.collectTypeEnums(dup.domain, dup.typ)
+		if 0 != len(dup.typ.Enum) {
+			model.dupNames[dup.domain.Domain.Domain+"."+dup.typ.ID] = model.enumKeys[enumKey(dup.domain.Domain.Domain, dup.typ.ID, "")].GoType
+		}
+	}
+}
+
+/*
+collectTypeEnums creates the enum types of a named type defined in source.
+*/
+func (model *domainModel) collectTypeEnums(source *domainModel, typ *Type) {
+	domain := source.Domain.Domain
+	link := model.docsURL(typeDomain(domain, typ), "type-"+typ.ID)
+	if 0 != len(typ.Enum) {
+		key := enumKey(domain, typ.ID, "")
+		if _, ok := model.enumKeys[key]; ok {
+			return
+		}
+		base := model.generator.typeName(domain, typ)
+		override := model.generator.names.Enums[typeKey(domain, typ)]
+		enum := model.newEnum(base, source.GoName, "enum."+snakeName(typ.ID), typ, link, override)
+		enum.Source = typeKey(domain, typ)
+		model.enumKeys[key] = enum
+		return
+	}
+	for _, property := range typ.Properties {
+		model.collectInlineEnum(domain, typ.ID, property, link)
+	}
+}
+
+/*
+collectInlineEnum creates the enum type of an enum property or parameter.
+Properties with the same name and values share an enum type, as do properties
+given the same enum name by the names.
+*/
+func (model *domainModel) collectInlineEnum(domain string, parent string, property *Type, link string) {
+	typ := property
+	if nil != property.Items {
+		typ = property.Items
+	}
+	if 0 == len(typ.Enum) {
+		return
+	}
+	key := enumKey(domain, parent, property.Name)
+	override := model.generator.names.Enums[key]
+	if nil != override && "" != override.Name {
+		for _, enum := range model.enums {
+			if enum.Var == override.Name {
+				model.enumKeys[key] = enum
+				enum.Links = appendUnique(enum.Links, link)
+				model.addValues(enum, typ.Enum, override)
+				return
+			}
+		}
+	}
+	base := exportedName(property.Name)
+	for _, enum := range model.enums {
+		if enum.GoType == base+"Enum" && sameValues(enum.Values, typ.Enum) {
+			model.enumKeys[key] = enum
+			enum.Links = appendUnique(enum.Links, link)
+			return
+		}
+	}
+	if model.names[base] || model.names[base+"Enum"] {
+		base = exportedName(parent) + base
+	}
+	enum := model.newEnum(base, model.GoName, "enum."+snakeName(parent)+"."+snakeName(property.Name), &Type{
+		Description: property.Description,
+		Enum:        typ.Enum,
+	}, link, override)
+	enum.Source = key
+	model.enumKeys[key] = enum
+}
+
+/*
+newEnum names and records an enum type. The names in override, if any, replace
+the generated ones.
+*/
+func (model *domainModel) newEnum(base string, prefix string, file string, typ *Type, link string, override *EnumNames) *enumModel {
+	if nil != override && "" != override.Name {
+		base = override.Name
+	} else {
+		if model.names[base] || model.names[base+"Enum"] {
+			base = exportedName(prefix) + base
+		}
+		for a := 2; model.names[base] || model.names[base+"Enum"]; a++ {
+			base = fmt.Sprintf("%s%d", strings.TrimRight(base, "0123456789"), a)
+		}
+	}
+	model.names[base] = true
+	model.names[base+"Enum"] = true
+
+	enum := &enumModel{
+		GoType:      base + "Enum",
+		Var:         base,
+		File:        file,
+		Description: typ.Description,
+		Links:       []string{link},
+	}
+	if nil != override && "" != override.File {
+		enum.File = override.File
+	} else {
+		for _, existing := range model.enums {
+			if existing.File == enum.File {
+				enum.File = "enum." + snakeName(base)
+			}
+		}
+	}
+	model.addValues(enum, typ.Enum, override)
+	model.enums = append(model.enums, enum)
+	return enum
+}
+
+/*
+addValues adds the values an enum type doesn't have yet.
+*/
+func (model *domainModel) addValues(enum *enumModel, values []string, override *EnumNames) {
+	// Unexported value constants are prefixed, so the name can't be a
+	// keyword.
+	prefix := strings.TrimSuffix(unexportedName(enum.Var), "_")
+	if nil != override && override.Exported {
+		prefix = enum.Var
+	}
+	idents := map[string]bool{}
+	known := map[string]bool{}
+	for _, value := range enum.Values {
+		idents[value.Ident] = true
+		known[value.Value] = true
+	}
+	for _, value := range values {
+		if known[value] {
+			continue
+		}
+		known[value] = true
+		name := exportedName(value)
+		if nil != override && "" != override.Idents[value] {
+			name = override.Idents[value]
+		}
+		ident := name
+		for a := 2; idents[ident]; a++ {
+			ident = fmt.Sprintf("%s%d", name, a)
+		}
+		idents[ident] = true
+		enum.Values = append(enum.Values, &enumValue{
+			Ident: ident,
+			Const: prefix + ident,
+			Value: value,
+		})
+	}
+}
+
+/*
+enumKey returns the key of a named enum type, or of an enum property when
+property is not empty.
+*/
+func enumKey(domain string, parent string, property string) string {
+	if "" == property {
+		return domain + "." + parent
+	}
+	return domain + "." + parent + "." + property
+}
+
+/*
+assignAliases chooses the package names imported domains are referred to by.
+Imports that share a package name with each other or with this package use the
+full domain name.
+*/
+func (model *domainModel) assignAliases() {
+	counts := map[string]int{model.Package: 1}
+	for domain := range model.imports {
+		counts[model.generator.byName[domain].Package]++
+	}
+	for domain := range model.imports {
+		imported := model.generator.byName[domain]
+		model.aliases[domain] = imported.Package
+		if counts[imported.Package] > 1 {
+			model.aliases[domain] = unexportedName(imported.GoName)
+		}
+	}
+}
+
+/*
+goType returns the Go type of a type, property or parameter defined in domain,
+as used in this package. parent is the named type, command or event that
+defines it.
+*/
+func (model *domainModel) goType(domain string, parent string, typ *Type, imports map[string]bool) string {
+	if "" != typ.Ref {
+		refDomain, refType := splitRef(domain, typ.Ref)
+		target := model.generator.byName[refDomain]
+		ref := target.types[refType]
+		pointer := ""
+		if "object" == ref.Type && 0 != len(ref.Properties) {
+			pointer = "*"
+		}
+
+		if refDomain == model.Domain.Domain {
+			if 0 != len(ref.Enum) {
+				return model.enumKeys[enumKey(refDomain, refType, "")].GoType
+			}
+			return pointer + model.generator.typeName(refDomain, ref)
+		}
+		if name, ok := model.dupNames[refDomain+"."+refType]; ok {
+			return pointer + name
+		}
+		imports[refDomain] = true
+		name := model.generator.typeName(refDomain, ref)
+		if 0 != len(ref.Enum) {
+			name = target.enumKeys[enumKey(refDomain, refType, "")].GoType
+		}
+		return pointer + model.aliases[refDomain] + "." + name
+	}
+
+	if 0 != len(typ.Enum) {
+		if "" == typ.Name {
+			return "string"
+		}
+		return model.enumKeys[enumKey(domain, parent, typ.Name)].GoType
+	}
+
+	switch typ.Type {
+	case "array":
+		if nil != typ.Items && 0 != len(typ.Items.Enum) {
+			return "[]" + model.enumKeys[enumKey(domain, parent, typ.Name)].GoType
+		}
+		return "[]" + model.goType(domain, parent, typ.Items, imports)
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "object":
+		return "map[string]interface{}"
+	case "string", "binary":
+		return "string"
+	}
+	return "interface{}"
+}
+
+/*
+enumFor returns the enum type of a property, if it is an enum.
+*/
+func (model *domainModel) enumFor(domain string, parent string, typ *Type) *enumModel {
+	if "" != typ.Ref {
+		refDomain, refType := splitRef(domain, typ.Ref)
+		target := model.generator.byName[refDomain]
+		if 0 == len(target.types[refType].Enum) {
+			return nil
+		}
+		if refDomain == model.Domain.Domain {
+			return model.enumKeys[enumKey(refDomain, refType, "")]
+		}
+		if enum, ok := model.enumKeys[enumKey(refDomain, refType, "")]; ok {
+			return enum
+		}
+		return target.enumKeys[enumKey(refDomain, refType, "")]
+	}
+	if 0 != len(typ.Enum) || (nil != typ.Items && 0 != len(typ.Items.Enum)) {
+		return model.enumKeys[enumKey(domain, parent, typ.Name)]
+	}
+	return nil
+}
+
+/*
+docsURL returns the documentation URL of a domain, or of an item in a domain
+when anchor is not empty.
+*/
+func (model *domainModel) docsURL(domain string, anchor string) string {
+	url := fmt.Sprintf("https://chromedevtools.github.io/devtools-protocol/%s/%s/", model.generator.DocsVersion, domain)
+	if "" != anchor {
+		url += "#" + anchor
+	}
+	return url
+}
+
+/*
+importPath returns the import path of the domain package.
+*/
+func (model *domainModel) importPath() string {
+	return model.generator.Import + "/" + model.Path
+}
+
+func sameValues(values []*enumValue, enum []string) bool {
+	if len(values) != len(enum) {
+		return false
+	}
+	for a, value := range values {
+		if value.Value != enum[a] {
+			return false
+		}
+	}
+	return true
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, item := range list {
+		if item == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+/*
+initialisms are words written in upper case in Go identifiers.
+*/
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true,
+	"DNS": true, "DOM": true, "EOF": true, "GPU": true, "GUID": true,
+	"HTML": true, "HTTP": true, "HTTPS": true, "ID": true, "IO": true,
+	"IP": true, "JS": true, "JSON": true, "PDF": true, "RPC": true,
+	"SQL": true, "SSL": true, "SVG": true, "TCP": true, "TLS": true,
+	"TTL": true, "UDP": true, "UI": true, "UID": true, "URI": true,
+	"URL": true, "UTF8": true, "UUID": true, "XHR": true, "XML": true,
+}
+
+/*
+splitWords splits a protocol name into words at punctuation and case changes:
+
+	"frameId"        -> "frame", "Id"
+	"DOMDebugger"    -> "DOM", "Debugger"
+	"no-referrer"    -> "no", "referrer"
+*/
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := -1
+	for a, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:a]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = a
+			continue
+		}
+		prev := runes[a-1]
+		boundary := false
+		switch {
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			boundary = true
+		case unicode.IsUpper(r) && unicode.IsDigit(prev):
+			boundary = true
+		case unicode.IsUpper(r) && unicode.IsUpper(prev) &&
+			a+1 < len(runes) && unicode.IsLower(runes[a+1]):
+			// The last capital of an upper case run starts a word: "AXNode".
+			boundary = true
+		}
+		if boundary {
+			words = append(words, string(runes[start:a]))
+			start = a
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+/*
+exportedName returns the exported Go identifier for a protocol name.
+*/
+func exportedName(name string) string {
+	var result strings.Builder
+	for _, word := range splitWords(name) {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			result.WriteString(upper)
+			continue
+		}
+		// Plural initialisms: "Ids" is "IDs".
+		if upper := strings.ToUpper(strings.TrimSuffix(word, "s")); strings.HasSuffix(word, "s") && initialisms[upper] {
+			result.WriteString(upper + "s")
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		result.WriteString(string(runes))
+	}
+	identifier := result.String()
+	if "" == identifier {
+		return "Empty"
+	}
+	if unicode.IsDigit([]rune(identifier)[0]) {
+		identifier = "Value" + identifier
+	}
+	return identifier
+}
+
+/*
+unexportedName returns the unexported Go identifier for a protocol name.
+*/
+func unexportedName(name string) string {
+	words := splitWords(exportedName(name))
+	words[0] = strings.ToLower(words[0])
+	identifier := words[0]
+	for _, word := range words[1:] {
+		identifier += word
+	}
+	if token.IsKeyword(identifier) {
+		identifier += "_"
+	}
+	return identifier
+}
+
+/*
+snakeName returns the lower case, underscore separated form of a name, used in
+file names.
+*/
+func snakeName(name string) string {
+	words := splitWords(name)
+	for a, word := range words {
+		words[a] = strings.ToLower(word)
+	}
+	return strings.Join(words, "_")
+}
+
+/*
+domainPath returns the package path elements for a domain. Each word of the
+domain name is a directory: "DOMDebugger" is "dom/debugger".
+*/
+func domainPath(domain string) []string {
+	words := splitWords(domain)
+	for a, word := range words {
+		words[a] = strings.ToLower(word)
+	}
+	return words
+}
+
+/*
+stripDomain removes the leading words of a name that repeat the end of the
+domain name, so names read well when qualified by the package name:
+
+	"TargetInfo" in the Target domain              -> "Info"
+	"workerErrorReported" in the ServiceWorker domain -> "ErrorReported"
+
+Names made only of those words are kept.
+*/
+func stripDomain(domain string, name string) string {
+	domainWords := splitWords(domain)
+	words := splitWords(name)
+	for a := range domainWords {
+		suffix := domainWords[a:]
+		if len(words) <= len(suffix) {
+			continue
+		}
+		match := true
+		for b, word := range suffix {
+			match = match && strings.EqualFold(word, words[b])
+		}
+		if match {
+			return strings.Join(words[len(suffix):], "")
+		}
+	}
+	return name
+}
+
+/*
+Names holds the Go names and types that differ from the generated defaults. It
+is read from the NamesFile of the protocol directory and keeps the names of the
+hand written packages the generated ones replaced, so regenerating them
+doesn't break their API.
+
+Keys are protocol names. Types are keyed by "Domain.Type", commands and events
+by "Domain.name", properties and parameters by "Domain.parent.name" where
+parent is the type, command or event that defines them, and enums by the type
+key or the property key.
+*/
+type Names struct {
+	// Imports lists, by domain, the domains it imports before its declared
+	// dependencies are linked. References from those domains back to it
+	// then copy the referenced types.
+	Imports map[string][]string `json:"imports,omitempty"`
+
+	// Types holds the names, Go types and packages of named types.
+	Types map[string]*TypeNames `json:"types,omitempty"`
+
+	// Commands holds the method names of commands.
+	Commands map[string]*CommandNames `json:"commands,omitempty"`
+
+	// Events holds the names of events, used for the event type and the
+	// socket On* method.
+	Events map[string]string `json:"events,omitempty"`
+
+	// Fields holds the struct field names of properties and parameters.
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// Enums holds the names of enum types and their values.
+	Enums map[string]*EnumNames `json:"enums,omitempty"`
+}
+
+/*
+TypeNames overrides the generated name, Go type or package of a named type.
+*/
+type TypeNames struct {
+	// Name is the Go type name.
+	Name string `json:"name,omitempty"`
+
+	// GoType is the underlying Go type. An enum type given a Go type is
+	// not generated as an enum.
+	GoType string `json:"goType,omitempty"`
+
+	// Domain is the domain whose package defines the type. References to
+	// the type from every other package use that package.
+	Domain string `json:"domain,omitempty"`
+}
+
+/*
+CommandNames overrides the generated names of a command.
+*/
+type CommandNames struct {
+	// Name is the socket method name. It is also the prefix of the
+	// parameter and result types unless Types is set.
+	Name string `json:"name,omitempty"`
+
+	// Types is the prefix of the parameter and result types.
+	Types string `json:"types,omitempty"`
+}
+
+/*
+EnumNames overrides the generated names of an enum type. Enum properties given
+the same name in a package share an enum type that accepts the values of all
+of them.
+*/
+type EnumNames struct {
+	// Name is the name of the variable providing named access to the
+	// values. The type name is Name followed by "Enum".
+	Name string `json:"name,omitempty"`
+
+	// File is the file name without the extension.
+	File string `json:"file,omitempty"`
+
+	// Exported exports the value constants.
+	Exported bool `json:"exported,omitempty"`
+
+	// Idents maps protocol values to the field names of the values.
+	Idents map[string]string `json:"idents,omitempty"`
+
+	// Values are added to the values of the property or type, for values
+	// removed from the protocol or properties that are no longer enums.
+	Values []string `json:"values,omitempty"`
+}
+
+/*
+LoadNames reads the NamesFile in dir. A missing file gives empty names.
+*/
+func LoadNames(dir string) (*Names, error) {
+	names := &Names{}
+	data, err := os.ReadFile(filepath.Join(dir, NamesFile))
+	if os.IsNotExist(err) {
+		return names, nil
+	} else if nil != err {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, names); nil != err {
+		return nil, fmt.Errorf("could not parse %s: %w", NamesFile, err)
+	}
+	return names, nil
+}
+
+/*
+apply returns a copy of the protocol with the names' type moves, Go types and
+enum values applied. Names of items missing from the protocol, for example
+experimental items omitted by -stable, are ignored.
+*/
+func (names *Names) apply(protocol *Protocol) (*Protocol, error) {
+	data, err := json.Marshal(protocol)
+	if nil != err {
+		return nil, err
+	}
+	applied := &Protocol{}
+	if err := json.Unmarshal(data, applied); nil != err {
+		return nil, err
+	}
+	byName := map[string]*Domain{}
+	for _, domain := range applied.Domains {
+		byName[domain.Domain] = domain
+	}
+
+	for _, key := range sortedKeys(keySet(names.Types)) {
+		override := names.Types[key]
+		domainName, id := splitRef("", key)
+		domain, ok := byName[domainName]
+		if !ok {
+			continue
+		}
+		typ := findType(domain.Types, id)
+		if nil == typ {
+			continue
+		}
+		if "" != override.GoType {
+			typ.Enum = nil
+		}
+		if "" == override.Domain || override.Domain == domainName {
+			continue
+		}
+		target, ok := byName[override.Domain]
+		if !ok {
+			continue
+		}
+		if nil != findType(target.Types, id) {
+			return nil, fmt.Errorf("can't move %s: %s.%s exists", key, override.Domain, id)
+		}
+		moveType(applied, domain, typ, target)
+	}
+
+	for _, key := range sortedKeys(keySet(names.Enums)) {
+		values := names.Enums[key].Values
+		if 0 == len(values) {
+			continue
+		}
+		parts := strings.SplitN(key, ".", 3)
+		domain, ok := byName[parts[0]]
+		if !ok || len(parts) < 2 {
+			continue
+		}
+		if 2 == len(parts) {
+			if typ := findType(domain.Types, parts[1]); nil != typ {
+				typ.Enum = appendValues(typ.Enum, values)
+			}
+			continue
+		}
+		for _, property := range domain.properties(parts[1]) {
+			if parts[2] != property.Name {
+				continue
+			}
+			if nil != property.Items {
+				property = property.Items
+			}
+			property.Enum = appendValues(property.Enum, values)
+		}
+	}
+	return applied, nil
+}
+
+/*
+moveType moves a named type to the package of another domain and points every
+reference to it there.
+*/
+func moveType(protocol *Protocol, from *Domain, typ *Type, to *Domain) {
+	for a, existing := range from.Types {
+		if existing == typ {
+			from.Types = append(from.Types[:a:a], from.Types[a+1:]...)
+			break
+		}
+	}
+	// References made by the type are resolved from the domain it came from.
+	walkType(typ, func(ref *Type) {
+		if "" != ref.Ref && !strings.Contains(ref.Ref, ".") {
+			ref.Ref = from.Domain + "." + ref.Ref
+		}
+	})
+	typ.origin = from.Domain
+	to.Types = append(to.Types, typ)
+
+	oldRef := from.Domain + "." + typ.ID
+	for _, domain := range protocol.Domains {
+		domain.walk(func(ref *Type) {
+			if oldRef == ref.Ref || (domain == from && typ.ID == ref.Ref) {
+				ref.Ref = to.Domain + "." + typ.ID
+			}
+		})
+	}
+}
+
+/*
+properties returns the properties of the named type, or the parameters and
+return values of the command or event, named parent.
+*/
+func (domain *Domain) properties(parent string) []*Type {
+	var properties []*Type
+	if typ := findType(domain.Types, parent); nil != typ {
+		properties = append(properties, typ.Properties...)
+	}
+	for _, command := range append(append([]*Command{}, domain.Commands...), domain.Events...) {
+		if parent == command.Name {
+			properties = append(append(properties, command.Parameters...), command.Returns...)
+		}
+	}
+	return properties
+}
+
+/*
+walk calls fn for every type, property, parameter and array item type of the
+domain.
+*/
+func (domain *Domain) walk(fn func(typ *Type)) {
+	for _, typ := range domain.Types {
+		walkType(typ, fn)
+	}
+	for _, command := range append(append([]*Command{}, domain.Commands...), domain.Events...) {
+		for _, param := range append(append([]*Type{}, command.Parameters...), command.Returns...) {
+			walkType(param, fn)
+		}
+	}
+}
+
+/*
+walkType calls fn for a type and the array item types and properties it
+contains.
+*/
+func walkType(typ *Type, fn func(typ *Type)) {
+	if nil == typ {
+		return
+	}
+	fn(typ)
+	walkType(typ.Items, fn)
+	for _, property := range typ.Properties {
+		walkType(property, fn)
+	}
+}
+
+/*
+findType returns the named type with an ID, or nil.
+*/
+func findType(types []*Type, id string) *Type {
+	for _, typ := range types {
+		if id == typ.ID {
+			return typ
+		}
+	}
+	return nil
+}
+
+func appendValues(enum []string, values []string) []string {
+	for _, value := range values {
+		enum = appendUnique(enum, value)
+	}
+	return enum
+}
+
+func keySet[T any](items map[string]T) map[string]bool {
+	set := map[string]bool{}
+	for key := range items {
+		set[key] = true
+	}
+	return set
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+/*
+ProtocolFiles are the protocol description files read from the protocol
+directory, in the order their domains are merged.
+*/
+var ProtocolFiles = []string{
+	"browser_protocol.json",
+	"js_protocol.json",
+}
+
+/*
+LegacyFile is the file in the protocol directory that describes the domains,
+commands, events, types and properties removed from the protocol that the
+generated packages keep. It is optional.
+*/
+const LegacyFile = "legacy.json"
+
+/*
+NamesFile is the file in the protocol directory that holds the Go names and
+types that differ from the generated defaults. It is optional.
+*/
+const NamesFile = "names.json"
+
+/*
+Protocol is a Chrome DevTools Protocol description as published in
+browser_protocol.json and js_protocol.json.
+*/
+type Protocol struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*Domain `json:"domains"`
+}
+
+/*
+Domain describes a protocol domain.
+*/
+type Domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description,omitempty"`
+	Experimental bool       `json:"experimental,omitempty"`
+	Deprecated   bool       `json:"deprecated,omitempty"`
+	Dependencies []string   `json:"dependencies,omitempty"`
+	Types        []*Type    `json:"types,omitempty"`
+	Commands     []*Command `json:"commands,omitempty"`
+	Events       []*Command `json:"events,omitempty"`
+}
+
+/*
+Type describes a named protocol type, a property or a parameter. Named types
+have an ID and properties have a Name.
+*/
+type Type struct {
+	ID           string   `json:"id,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Experimental bool     `json:"experimental,omitempty"`
+	Deprecated   bool     `json:"deprecated,omitempty"`
+	Optional     bool     `json:"optional,omitempty"`
+	Ref          string   `json:"$ref,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Enum         []string `json:"enum,omitempty"`
+	Items        *Type    `json:"items,omitempty"`
+	Properties   []*Type  `json:"properties,omitempty"`
+
+	// origin is the domain that defined a type moved to the package of
+	// another domain.
+	origin string
+}
+
+/*
+Command describes a protocol command or event.
+*/
+type Command struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	Experimental bool    `json:"experimental,omitempty"`
+	Deprecated   bool    `json:"deprecated,omitempty"`
+	Redirect     string  `json:"redirect,omitempty"`
+	Parameters   []*Type `json:"parameters,omitempty"`
+	Returns      []*Type `json:"returns,omitempty"`
+}
+
+/*
+LoadProtocol reads and merges the protocol description files in dir.
+*/
+func LoadProtocol(dir string) (*Protocol, error) {
+	protocol := &Protocol{}
+	for _, name := range ProtocolFiles {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if nil != err {
+			return nil, err
+		}
+		file := &Protocol{}
+		if err := json.Unmarshal(data, file); nil != err {
+			return nil, fmt.Errorf("could not parse %s: %w", name, err)
+		}
+		if "" == protocol.Version.Major {
+			protocol.Version = file.Version
+		}
+		protocol.Domains = append(protocol.Domains, file.Domains...)
+	}
+	return protocol, nil
+}
+
+/*
+WithLegacy returns a copy of the protocol with the legacy items described in
+the LegacyFile of dir merged in. Legacy items are marked deprecated, so Stable
+removes them again.
+*/
+func (protocol *Protocol) WithLegacy(dir string) (*Protocol, error) {
+	merged := &Protocol{Version: protocol.Version}
+	byName := map[string]*Domain{}
+	for _, domain := range protocol.Domains {
+		mergedDomain := *domain
+		merged.Domains = append(merged.Domains, &mergedDomain)
+		byName[domain.Domain] = &mergedDomain
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, LegacyFile))
+	if os.IsNotExist(err) {
+		return merged, nil
+	} else if nil != err {
+		return nil, err
+	}
+	legacy := &Protocol{}
+	if err := json.Unmarshal(data, legacy); nil != err {
+		return nil, fmt.Errorf("could not parse %s: %w", LegacyFile, err)
+	}
+
+	for _, domain := range legacy.Domains {
+		mergedDomain, ok := byName[domain.Domain]
+		if !ok {
+			legacyDomain := *domain
+			legacyDomain.Deprecated = true
+			merged.Domains = append(merged.Domains, &legacyDomain)
+			continue
+		}
+		mergedDomain.Types = mergeTypes(mergedDomain.Types, domain.Types)
+		mergedDomain.Commands = mergeCommands(mergedDomain.Commands, domain.Commands)
+		mergedDomain.Events = mergeCommands(mergedDomain.Events, domain.Events)
+	}
+	return merged, nil
+}
+
+/*
+mergeTypes adds legacy types, and the legacy properties of existing types, to
+a list of types. The list is not modified.
+*/
+func mergeTypes(types []*Type, legacy []*Type) []*Type {
+	merged := append([]*Type{}, types...)
+	for _, legacyType := range legacy {
+		found := false
+		for a, typ := range merged {
+			if typ.ID != legacyType.ID {
+				continue
+			}
+			mergedType := *typ
+			mergedType.Properties = mergeProperties(typ.Properties, legacyType.Properties)
+			merged[a] = &mergedType
+			found = true
+		}
+		if !found {
+			deprecated := *legacyType
+			deprecated.Deprecated = true
+			merged = append(merged, &deprecated)
+		}
+	}
+	return merged
+}
+
+/*
+mergeCommands adds legacy commands or events, and the legacy parameters and
+return values of existing ones, to a list of commands or events. The list is
+not modified.
+*/
+func mergeCommands(commands []*Command, legacy []*Command) []*Command {
+	merged := append([]*Command{}, commands...)
+	for _, legacyCommand := range legacy {
+		found := false
+		for a, command := range merged {
+			if command.Name != legacyCommand.Name {
+				continue
+			}
+			mergedCommand := *command
+			mergedCommand.Parameters = mergeProperties(command.Parameters, legacyCommand.Parameters)
+			mergedCommand.Returns = mergeProperties(command.Returns, legacyCommand.Returns)
+			merged[a] = &mergedCommand
+			found = true
+		}
+		if !found {
+			deprecated := *legacyCommand
+			deprecated.Deprecated = true
+			merged = append(merged, &deprecated)
+		}
+	}
+	return merged
+}
+
+/*
+mergeProperties adds legacy properties or parameters that are missing from a
+list. The added properties are deprecated.
+*/
+func mergeProperties(properties []*Type, legacy []*Type) []*Type {
+	merged := append([]*Type{}, properties...)
+	for _, legacyProperty := range legacy {
+		found := false
+		for _, property := range properties {
+			found = found || property.Name == legacyProperty.Name
+		}
+		if !found {
+			deprecated := *legacyProperty
+			deprecated.Deprecated = true
+			merged = append(merged, &deprecated)
+		}
+	}
+	return merged
+}
+
+/*
+Stable returns a copy of the protocol without experimental and deprecated
+domains, commands, events, parameters and properties.
+*/
+func (protocol *Protocol) Stable() *Protocol {
+	stable := &Protocol{Version: protocol.Version}
+	for _, domain := range protocol.Domains {
+		if domain.Experimental || domain.Deprecated {
+			continue
+		}
+		stableDomain := *domain
+		stableDomain.Types = nil
+		for _, typ := range domain.Types {
+			stableType := *typ
+			stableType.Properties = stableTypes(typ.Properties)
+			stableDomain.Types = append(stableDomain.Types, &stableType)
+		}
+		stableDomain.Commands = stableCommands(domain.Commands)
+		stableDomain.Events = stableCommands(domain.Events)
+		stable.Domains = append(stable.Domains, &stableDomain)
+	}
+	return stable
+}
+
+func stableCommands(commands []*Command) []*Command {
+	var stable []*Command
+	for _, command := range commands {
+		if command.Experimental || command.Deprecated {
+			continue
+		}
+		stableCommand := *command
+		stableCommand.Parameters = stableTypes(command.Parameters)
+		stableCommand.Returns = stableTypes(command.Returns)
+		stable = append(stable, &stableCommand)
+	}
+	return stable
+}
+
+func stableTypes(types []*Type) []*Type {
+	var stable []*Type
+	for _, typ := range types {
+		if typ.Experimental || typ.Deprecated {
+			continue
+		}
+		stable = append(stable, typ)
+	}
+	return stable
+}
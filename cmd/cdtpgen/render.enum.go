@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+/*
+renderEnum renders an enum type with named access to its values.
+*/
+func (model *domainModel) renderEnum(enum *enumModel) string {
+	structType := unexportedName(enum.GoType)
+	values := "_" + structType + "s"
+	body := &bytes.Buffer{}
+	body.WriteString(Header + "package " + model.Package + "\n\n")
+	body.WriteString("import (\n\t\"encoding/json\"\n\n\t\"github.com/mkenney/go-chrome/enums\"\n)\n\n")
+
+	fmt.Fprintf(body, "type %s struct {\n", structType)
+	for _, value := range enum.Values {
+		fmt.Fprintf(body, "\t%s %s\n", value.Ident, enum.GoType)
+	}
+	body.WriteString("}\n\n")
+
+	fmt.Fprintf(body, "/*\n%s provides named access to the %s values.\n*/\n", enum.Var, enum.GoType)
+	fmt.Fprintf(body, "var %s = %s{\n", enum.Var, structType)
+	for _, value := range enum.Values {
+		fmt.Fprintf(body, "\t%s: %s,\n", value.Ident, value.Const)
+	}
+	body.WriteString("}\n\n")
+
+	lines := wrap(fmt.Sprintf("%s represents the %s values.", enum.GoType, enum.Source), commentWidth)
+	if description := docLines(enum.Description, false, false, commentWidth); 0 != len(description) {
+		lines = append(append(lines, ""), description...)
+	}
+	lines = append(lines, "", "Allowed values:")
+	width := 0
+	for _, value := range enum.Values {
+		if len(value.Ident) > width {
+			width = len(value.Ident)
+		}
+	}
+	for _, value := range enum.Values {
+		lines = append(lines, fmt.Sprintf("  - %s.%-*s %q", enum.Var, width, value.Ident, value.Value))
+	}
+	body.WriteString("/*\n" + strings.Join(lines, "\n") + "\n\n" + strings.Join(enum.Links, "\n") + "\n*/\n")
+	fmt.Fprintf(body, "type %s int\n\n", enum.GoType)
+
+	fmt.Fprintf(body, `/*
+String implements Stringer
+*/
+func (enum %[1]s) String() string {
+	return %[2]s.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum %[1]s) Unknown() bool {
+	return !%[2]s.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum %[1]s) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *%[1]s) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = %[2]s.Parse(val)
+	return nil
+}
+
+const (
+`, enum.GoType, values)
+	for a, value := range enum.Values {
+		fmt.Fprintf(body, "\t// %s represents the %q value.\n", value.Const, value.Value)
+		if 0 == a {
+			fmt.Fprintf(body, "\t%s %s = iota + 1\n", value.Const, enum.GoType)
+			continue
+		}
+		fmt.Fprintf(body, "\t%s\n", value.Const)
+	}
+	body.WriteString(")\n\n")
+
+	fmt.Fprintf(body, "var %s = enums.New(map[%s]string{\n\t%s(0): \"\",\n", values, enum.GoType, enum.GoType)
+	for _, value := range enum.Values {
+		fmt.Fprintf(body, "\t%s: %q,\n", value.Const, value.Value)
+	}
+	body.WriteString("})\n")
+	return body.String()
+}
+
+/*
+renderEnumTest renders the test of an enum type.
+*/
+func (model *domainModel) renderEnumTest(enum *enumModel) string {
+	body := &bytes.Buffer{}
+	body.WriteString(Header + "package " + model.Package + "\n\n")
+	body.WriteString("import (\n\t\"encoding/json\"\n\t\"testing\"\n)\n\n")
+	fmt.Fprintf(body, `func TestEnum%[1]s(t *testing.T) {
+	var enum %[2]s
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`+"`\"\"`"+`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown %[2]s
+	err = json.Unmarshal([]byte(`+"`\"invalid value\"`"+`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `+"`\"invalid value\"`"+` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `+"`\"\"`"+` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%%s'", result)
+	}
+`, enum.Var, enum.GoType)
+	for _, value := range enum.Values {
+		encoded := fmt.Sprintf("%q", value.Value)
+		quoted := "`" + encoded + "`"
+		if strings.Contains(encoded, "`") {
+			quoted = fmt.Sprintf("%q", encoded)
+		}
+		fmt.Fprintf(body, `
+	enum = %[1]s.%[2]s
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if %[3]s != string(result) {
+		t.Errorf("Expected '%%s', got '%%s'", %[3]s, result)
+	}
+	json.Unmarshal([]byte(%[3]s), &enum)
+	if %[1]s.%[2]s != enum {
+		t.Errorf("Expected %%d, got %%d", %[1]s.%[2]s, enum)
+	}
+`, enum.Var, value.Ident, quoted)
+	}
+	body.WriteString("}\n")
+	return body.String()
+}
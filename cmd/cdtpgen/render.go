@@ -0,0 +1,419 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+/*
+Header marks generated files.
+*/
+const Header = "// Code generated by cdtpgen. DO NOT EDIT.\n\n"
+
+/*
+commentWidth is the column doc comments are wrapped at.
+*/
+const commentWidth = 80
+
+/*
+File is a generated source file.
+*/
+type File struct {
+	// Path is the file path relative to the output directory.
+	Path string
+
+	// Source is the formatted file contents.
+	Source []byte
+}
+
+/*
+Files returns the generated files, sorted by path.
+*/
+func (generator *Generator) Files() ([]*File, error) {
+	var files []*File
+	add := func(path string, source string) error {
+		formatted, err := format.Source([]byte(source))
+		if nil != err {
+			return fmt.Errorf("%s: %w\n%s", path, err, source)
+		}
+		files = append(files, &File{Path: path, Source: formatted})
+		return nil
+	}
+
+	for _, model := range generator.domains {
+		if err := add(model.Path+"/cdtp.go", model.renderTypes()); nil != err {
+			return nil, err
+		}
+		if 0 != len(model.Commands) {
+			if err := add(model.Path+"/command.go", model.renderCommands()); nil != err {
+				return nil, err
+			}
+		}
+		if 0 != len(model.Events) {
+			if err := add(model.Path+"/event.go", model.renderEvents()); nil != err {
+				return nil, err
+			}
+		}
+		for _, enum := range model.enums {
+			if err := add(model.Path+"/"+enum.File+".go", model.renderEnum(enum)); nil != err {
+				return nil, err
+			}
+			if err := add(model.Path+"/"+enum.File+"_test.go", model.renderEnumTest(enum)); nil != err {
+				return nil, err
+			}
+		}
+		socketFile := "socket/cdtp." + strings.ReplaceAll(model.Path, "/", ".")
+		if err := add(socketFile+".go", model.renderSocket()); nil != err {
+			return nil, err
+		}
+		if err := add(socketFile+".table_test.go", model.renderSocketTest()); nil != err {
+			return nil, err
+		}
+	}
+
+	// The files listing every domain would drop the domains that weren't
+	// generated.
+	if !generator.Partial {
+		if err := add("socket/interface.protocoller.go", generator.renderProtocollerInterface()); nil != err {
+			return nil, err
+		}
+		if err := add("socket/socket.protocoller.go", generator.renderProtocoller()); nil != err {
+			return nil, err
+		}
+		if err := add("tab.socket.protocoller.go", generator.renderTabProtocoller()); nil != err {
+			return nil, err
+		}
+	}
+
+	sort.Slice(files, func(a, b int) bool {
+		return files[a].Path < files[b].Path
+	})
+	return files, nil
+}
+
+/*
+renderTypes renders the package documentation and the named types of a domain.
+*/
+func (model *domainModel) renderTypes() string {
+	imports := map[string]bool{}
+	body := &bytes.Buffer{}
+	for _, dup := range model.dups {
+		if 0 != len(dup.typ.Enum) {
+			continue
+		}
+		note := fmt.Sprintf("This is a duplicate of %s to avoid an invalid import cycle.", typeKey(dup.domain.Domain.Domain, dup.typ))
+		model.renderType(body, dup.domain, dup.typ, dup.name, note, imports)
+	}
+	for _, typ := range model.Types {
+		if 0 != len(typ.Enum) {
+			continue
+		}
+		model.renderType(body, model, typ, model.generator.typeName(model.Domain.Domain, typ), "", imports)
+	}
+
+	source := &bytes.Buffer{}
+	source.WriteString(Header)
+	source.WriteString("/*\n")
+	fmt.Fprintf(source, "Package %s provides type definitions for use with the Chrome %s protocol\n", model.Package, model.Domain.Domain)
+	if doc := docLines(model.Description, model.Experimental, model.Deprecated, commentWidth); 0 != len(doc) {
+		source.WriteString("\n" + strings.Join(doc, "\n") + "\n")
+	}
+	fmt.Fprintf(source, "\n%s\n*/\n", model.docsURL(model.Domain.Domain, ""))
+	fmt.Fprintf(source, "package %s\n", model.Package)
+	source.WriteString(model.renderImports(imports))
+	source.Write(body.Bytes())
+	return source.String()
+}
+
+/*
+renderType renders a named type defined in source as name.
+*/
+func (model *domainModel) renderType(body *bytes.Buffer, source *domainModel, typ *Type, name string, note string, imports map[string]bool) {
+	domain := source.Domain.Domain
+	summary := fmt.Sprintf("%s represents the %s type.", name, typeKey(domain, typ))
+	if "" != note {
+		summary += " " + note
+	}
+	writeDoc(body, wrap(summary, commentWidth), docLines(typ.Description, typ.Experimental, typ.Deprecated, commentWidth), model.docsURL(typeDomain(domain, typ), "type-"+typ.ID))
+	if "object" == typ.Type && 0 != len(typ.Properties) {
+		fmt.Fprintf(body, "type %s struct {\n", name)
+		model.renderFields(body, domain, typ.ID, typ.Properties, false, imports)
+		body.WriteString("}\n")
+		return
+	}
+	goType := model.goType(domain, typ.ID, &Type{Type: typ.Type, Items: typ.Items}, imports)
+	if override, ok := model.generator.names.Types[typeKey(domain, typ)]; ok && "" != override.GoType {
+		goType = override.GoType
+	}
+	fmt.Fprintf(body, "type %s %s\n", name, goType)
+}
+
+/*
+scalarTypes lists the builtin types of optional command parameters that are
+rendered as pointers, so a zero value can be told apart from an unset one.
+*/
+var scalarTypes = map[string]bool{
+	"bool":    true,
+	"float64": true,
+	"int":     true,
+	"string":  true,
+}
+
+/*
+scalarRef returns whether a property refers to a named type whose underlying
+type is a scalar type. Enum types are excluded, their zero value is unset.
+*/
+func (model *domainModel) scalarRef(domain string, property *Type) bool {
+	if "" == property.Ref {
+		return false
+	}
+	refDomain, refType := splitRef(domain, property.Ref)
+	ref := model.generator.byName[refDomain].types[refType]
+	if override, ok := model.generator.names.Types[typeKey(refDomain, ref)]; ok && "" != override.GoType {
+		return scalarTypes[override.GoType]
+	}
+	if 0 != len(ref.Enum) {
+		return false
+	}
+	switch ref.Type {
+	case "boolean", "integer", "number", "string":
+		return true
+	}
+	return false
+}
+
+/*
+renderFields renders struct fields for properties or parameters. If pointers
+is set, optional fields of scalar types and named scalar types are rendered as
+pointers.
+*/
+func (model *domainModel) renderFields(body *bytes.Buffer, domain string, parent string, properties []*Type, pointers bool, imports map[string]bool) {
+	for a, property := range properties {
+		if a > 0 {
+			body.WriteString("\n")
+		}
+		description := property.Description
+		if property.Optional {
+			description = "Optional. " + description
+		}
+		lines := docLines(description, property.Experimental, property.Deprecated, commentWidth-4)
+		if enum := model.enumFor(domain, parent, property); nil != enum {
+			if 0 == len(lines) {
+				lines = []string{"Allowed values:"}
+			} else {
+				lines[len(lines)-1] += " Allowed values:"
+			}
+			for _, value := range enum.Values {
+				lines = append(lines, fmt.Sprintf("  - %s.%s", model.enumVar(enum), value.Ident))
+			}
+		}
+		for _, line := range lines {
+			fmt.Fprintf(body, "\t// %s\n", line)
+		}
+		tag := property.Name
+		if property.Optional {
+			tag += ",omitempty"
+		}
+		goType := model.goType(domain, parent, property, imports)
+		if pointers && property.Optional && (scalarTypes[goType] || model.scalarRef(domain, property)) {
+			goType = "*" + goType
+		}
+		fmt.Fprintf(body, "\t%s %s `json:\"%s\"`\n", model.generator.fieldName(domain, parent, property.Name), goType, tag)
+	}
+}
+
+/*
+enumVar returns the name of an enum's value accessor as used in this package.
+*/
+func (model *domainModel) enumVar(enum *enumModel) string {
+	for _, local := range model.enums {
+		if local == enum {
+			return enum.Var
+		}
+	}
+	for domain, alias := range model.aliases {
+		for _, foreign := range model.generator.byName[domain].enums {
+			if foreign == enum {
+				return alias + "." + enum.Var
+			}
+		}
+	}
+	return enum.Var
+}
+
+/*
+renderCommands renders the parameter and result types of a domain's commands.
+*/
+func (model *domainModel) renderCommands() string {
+	imports := map[string]bool{}
+	body := &bytes.Buffer{}
+	domain := model.Domain.Domain
+	for _, command := range model.Commands {
+		_, name := model.generator.commandNames(domain, command)
+		link := model.docsURL(domain, "method-"+command.Name)
+		if 0 != len(command.Parameters) {
+			writeDoc(body, []string{fmt.Sprintf("%sParams represents %s.%s parameters.", name, domain, command.Name)}, nil, link)
+			fmt.Fprintf(body, "type %sParams struct {\n", name)
+			model.renderFields(body, domain, command.Name, command.Parameters, true, imports)
+			body.WriteString("}\n\n")
+		}
+		writeDoc(body, []string{fmt.Sprintf("%sResult represents the result of calls to %s.%s.", name, domain, command.Name)}, nil, link)
+		fmt.Fprintf(body, "type %sResult struct {\n", name)
+		if 0 != len(command.Returns) {
+			model.renderFields(body, domain, command.Name, command.Returns, false, imports)
+			body.WriteString("\n")
+		}
+		body.WriteString("\t// Error information related to executing this method\n")
+		body.WriteString("\tErr error `json:\"-\"`\n")
+		body.WriteString("}\n\n")
+	}
+	return Header + "package " + model.Package + "\n" + model.renderImports(imports) + body.String()
+}
+
+/*
+renderEvents renders the event types of a domain.
+*/
+func (model *domainModel) renderEvents() string {
+	imports := map[string]bool{}
+	body := &bytes.Buffer{}
+	domain := model.Domain.Domain
+	for _, event := range model.Events {
+		name, _ := model.generator.eventNames(domain, event)
+		writeDoc(body, []string{fmt.Sprintf("%sEvent represents %s.%s event data.", name, domain, event.Name)}, nil, model.docsURL(domain, "event-"+event.Name))
+		fmt.Fprintf(body, "type %sEvent struct {\n", name)
+		if 0 != len(event.Parameters) {
+			model.renderFields(body, domain, event.Name, event.Parameters, false, imports)
+			body.WriteString("\n")
+		}
+		body.WriteString("\t// Error information related to this event\n")
+		body.WriteString("\tErr error `json:\"-\"`\n")
+		body.WriteString("}\n\n")
+	}
+	return Header + "package " + model.Package + "\n" + model.renderImports(imports) + body.String()
+}
+
+/*
+renderImports renders the import block for the domains used by a file.
+*/
+func (model *domainModel) renderImports(imports map[string]bool) string {
+	if 0 == len(imports) {
+		return "\n"
+	}
+	lines := []string{}
+	for _, domain := range sortedKeys(imports) {
+		imported := model.generator.byName[domain]
+		line := fmt.Sprintf("%q", imported.importPath())
+		if alias := model.aliases[domain]; alias != imported.Package {
+			line = alias + " " + line
+		}
+		lines = append(lines, "\t"+line)
+	}
+	sort.Strings(lines)
+	return "\nimport (\n" + strings.Join(lines, "\n") + "\n)\n\n"
+}
+
+/*
+fieldName returns the Go struct field name for a property. The name Err is
+reserved for error information.
+*/
+func fieldName(name string) string {
+	field := exportedName(name)
+	if "Err" == field {
+		field = "ErrValue"
+	}
+	return field
+}
+
+/*
+writeDoc writes a block doc comment made of a summary, a description and a
+documentation link.
+*/
+func writeDoc(body *bytes.Buffer, summary []string, description []string, link string) {
+	body.WriteString("/*\n")
+	body.WriteString(strings.Join(summary, "\n") + "\n")
+	if 0 != len(description) {
+		body.WriteString("\n" + strings.Join(description, "\n") + "\n")
+	}
+	if "" != link {
+		body.WriteString("\n" + link + "\n")
+	}
+	body.WriteString("*/\n")
+}
+
+/*
+docLines returns a protocol description wrapped for a doc comment, with the
+experimental and deprecated markers appended.
+*/
+func docLines(description string, experimental bool, deprecated bool, width int) []string {
+	description = strings.TrimSpace(description)
+	if experimental {
+		description = strings.TrimSpace(description + " EXPERIMENTAL.")
+	}
+	if deprecated {
+		description = strings.TrimSpace(description + " DEPRECATED.")
+	}
+	if "" == description {
+		return nil
+	}
+	// Descriptions must not end block comments.
+	description = strings.ReplaceAll(description, "*/", "* /")
+	var lines []string
+	for _, paragraph := range paragraphs(description) {
+		lines = append(lines, wrap(paragraph, width)...)
+	}
+	return lines
+}
+
+/*
+paragraphs joins the hard wrapped lines of a description into paragraphs. Blank
+lines and list items start a new paragraph; blank lines are kept.
+*/
+func paragraphs(text string) []string {
+	var paragraphs []string
+	joining := false
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case "" == line:
+			paragraphs = append(paragraphs, "")
+			joining = false
+		case joining && !isListItem(line):
+			paragraphs[len(paragraphs)-1] += " " + line
+		default:
+			paragraphs = append(paragraphs, line)
+			joining = true
+		}
+	}
+	return paragraphs
+}
+
+/*
+isListItem returns whether a description line starts a list item.
+*/
+func isListItem(line string) bool {
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ")
+}
+
+/*
+wrap splits text into lines of at most width characters where possible.
+*/
+func wrap(text string, width int) []string {
+	words := strings.Fields(text)
+	if 0 == len(words) {
+		return []string{""}
+	}
+	var lines []string
+	line := words[0]
+	for _, word := range words[1:] {
+		if len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = word
+			continue
+		}
+		line += " " + word
+	}
+	return append(lines, line)
+}
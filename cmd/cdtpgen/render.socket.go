@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+protocolName returns the name of the domain's socket protocol accessor, for
+example "DOMDebugger".
+*/
+func (model *domainModel) protocolName() string {
+	return exportedName(model.GoName)
+}
+
+/*
+renderSocket renders the socket protocol wrapper of a domain.
+*/
+func (model *domainModel) renderSocket() string {
+	domain := model.Domain.Domain
+	name := model.protocolName()
+	protocol := name + "Protocol"
+	body := &bytes.Buffer{}
+
+	summary := fmt.Sprintf("%s provides a namespace for the Chrome %s protocol methods.", protocol, domain)
+	writeDoc(body, wrap(summary, commentWidth), docLines(model.Description, model.Experimental, model.Deprecated, commentWidth), model.docsURL(domain, ""))
+	fmt.Fprintf(body, `type %[1]s struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *%[1]s) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *%[1]s) WithContext(ctx context.Context) *%[1]s {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &%[1]s{Socket: protocol.Socket, ctx: ctx}
+}
+`, protocol)
+
+	usesJSON := false
+	for _, command := range model.Commands {
+		method, types := model.generator.commandNames(domain, command)
+		result := model.Package + "." + types + "Result"
+		description := command.Description
+		if "" == strings.TrimSpace(description) {
+			description = fmt.Sprintf("%s sends the %s.%s command.", method, domain, command.Name)
+		}
+		optional := optionalParams(command)
+		if optional {
+			description += "\n\nThe parameters are optional."
+		}
+		body.WriteString("\n")
+		writeDoc(body, docLines(description, command.Experimental, command.Deprecated, commentWidth), nil, model.docsURL(domain, "method-"+command.Name))
+
+		// Commands whose parameters are all optional take them as a variadic
+		// argument, so calls written before the protocol added them still
+		// compile.
+		switch {
+		case optional:
+			fmt.Fprintf(body, "func (protocol *%s) %s(\n\tparams ...*%s.%sParams,\n) <-chan *%s {\n", protocol, method, model.Package, types, result)
+			fmt.Fprintf(body, "\tresultChan := make(chan *%s, 1)\n", result)
+			fmt.Fprintf(body, "\tcommand := NewCommand(protocol.Socket, %q, nil)\n", domain+"."+command.Name)
+			fmt.Fprintf(body, "\tif 0 != len(params) {\n\t\tcommand = NewCommand(protocol.Socket, %q, params[0])\n\t}\n", domain+"."+command.Name)
+		case 0 != len(command.Parameters):
+			fmt.Fprintf(body, "func (protocol *%s) %s(\n\tparams *%s.%sParams,\n) <-chan *%s {\n", protocol, method, model.Package, types, result)
+			fmt.Fprintf(body, "\tresultChan := make(chan *%s, 1)\n", result)
+			fmt.Fprintf(body, "\tcommand := NewCommand(protocol.Socket, %q, params)\n", domain+"."+command.Name)
+		default:
+			fmt.Fprintf(body, "func (protocol *%s) %s() <-chan *%s {\n", protocol, method, result)
+			fmt.Fprintf(body, "\tresultChan := make(chan *%s, 1)\n", result)
+			fmt.Fprintf(body, "\tcommand := NewCommand(protocol.Socket, %q, nil)\n", domain+"."+command.Name)
+		}
+		fmt.Fprintf(body, "\tresult := &%s{}\n\n", result)
+		body.WriteString(`	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+`)
+		if 0 != len(command.Returns) {
+			usesJSON = true
+			body.WriteString("\t\t} else {\n\t\t\tresult.Err = json.Unmarshal(response.Result, &result)\n")
+		}
+		body.WriteString(`		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+`)
+	}
+
+	for _, event := range model.Events {
+		usesJSON = true
+		eventName, method := model.generator.eventNames(domain, event)
+		eventType := model.Package + "." + eventName + "Event"
+		summary := fmt.Sprintf("%s adds a handler to the %s.%s event.", method, domain, event.Name)
+		body.WriteString("\n")
+		writeDoc(body, wrap(summary, commentWidth), docLines(event.Description, event.Experimental, event.Deprecated, commentWidth), model.docsURL(domain, "event-"+event.Name))
+		fmt.Fprintf(body, `func (protocol *%s) %s(
+	callback func(event *%s),
+) *Listener {
+	handler := NewEventHandler(
+		%q,
+		func(response *Response) {
+			event := &%s{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+`, protocol, method, eventType, domain+"."+event.Name, eventType)
+	}
+
+	imports := []string{`"context"`}
+	if usesJSON {
+		imports = append(imports, `"encoding/json"`)
+	}
+	source := Header + "package socket\n\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n"
+	if 0 != len(model.Commands)+len(model.Events) {
+		source += fmt.Sprintf("\n\t%q\n", model.importPath())
+	}
+	return source + ")\n\n" + body.String()
+}
+
+/*
+optionalParams returns whether a command has parameters and all of them are
+optional.
+*/
+func optionalParams(command *Command) bool {
+	for _, param := range command.Parameters {
+		if !param.Optional {
+			return false
+		}
+	}
+	return 0 != len(command.Parameters)
+}
+
+/*
+renderSocketTest renders the table driven tests of a domain's socket protocol
+wrapper.
+*/
+func (model *domainModel) renderSocketTest() string {
+	domain := model.Domain.Domain
+	name := model.protocolName()
+	body := &bytes.Buffer{}
+
+	if 0 != len(model.Commands) {
+		fmt.Fprintf(body, `
+func Test%[1]sCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test%[1]sCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+`, name)
+		for _, command := range model.Commands {
+			method, types := model.generator.commandNames(domain, command)
+			args := ""
+			if 0 != len(command.Parameters) {
+				args = fmt.Sprintf("&%s.%sParams{}", model.Package, types)
+			}
+			fmt.Fprintf(body, `		{%q, func() func() error {
+			resultChan := mockSocket.%s().%s(%s)
+			return func() error { return (<-resultChan).Err }
+		}},
+`, domain+"."+command.Name, name, method, args)
+		}
+		body.WriteString(`	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(` + "`{}`" + `),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(` + "`\"error data\"`" + `),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+`)
+	}
+
+	if 0 != len(model.Events) {
+		fmt.Fprintf(body, `
+func Test%[1]sEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/Test%[1]sEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+`, name)
+		for _, event := range model.Events {
+			eventName, method := model.generator.eventNames(domain, event)
+			fmt.Fprintf(body, `		{%q, func(results chan<- error) *Listener {
+			return mockSocket.%s().%s(func(event *%s.%sEvent) {
+				results <- event.Err
+			})
+		}},
+`, domain+"."+event.Name, name, method, model.Package, eventName)
+		}
+		body.WriteString(`	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(` + "`{}`" + `),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(` + "`\"error data\"`" + `),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
+`)
+	}
+
+	source := Header + "package socket\n"
+	if 0 == body.Len() {
+		return source
+	}
+	source += "\nimport (\n\t\"net/url\"\n\t\"testing\"\n"
+	params := false
+	for _, command := range model.Commands {
+		params = params || 0 != len(command.Parameters)
+	}
+	if params || 0 != len(model.Events) {
+		source += fmt.Sprintf("\n\t%q\n", model.importPath())
+	}
+	return source + ")\n" + body.String()
+}
+
+/*
+renderProtocollerInterface renders the Protocoller interface.
+*/
+func (generator *Generator) renderProtocollerInterface() string {
+	body := &bytes.Buffer{}
+	body.WriteString(Header + `package socket
+
+/*
+Protocoller defines the Chrome DevTools Protocol API methods
+
+https://chromedevtools.github.io/devtools-protocol/
+*/
+type Protocoller interface {
+`)
+	for a, model := range generator.sortedDomains() {
+		if a > 0 {
+			body.WriteString("\n")
+		}
+		name := model.protocolName()
+		fmt.Fprintf(body, "\t// %[1]s returns the %[1]sProtocol instance.\n\t%[1]s() *%[1]sProtocol\n", name)
+	}
+	body.WriteString("}\n")
+	return body.String()
+}
+
+/*
+renderProtocoller renders the Socket implementation of the Protocoller
+interface.
+*/
+func (generator *Generator) renderProtocoller() string {
+	body := &bytes.Buffer{}
+	body.WriteString(Header + "package socket\n")
+	for _, model := range generator.sortedDomains() {
+		fmt.Fprintf(body, `
+/*
+%[1]s returns the %[1]sProtocol instance.
+
+%[1]s is a Protocoller implementation.
+*/
+func (socket *Socket) %[1]s() *%[1]sProtocol {
+	return &%[1]sProtocol{Socket: socket}
+}
+`, model.protocolName())
+	}
+	return body.String()
+}
+
+/*
+renderTabProtocoller renders the Tab implementation of the Protocoller
+interface.
+*/
+func (generator *Generator) renderTabProtocoller() string {
+	body := &bytes.Buffer{}
+	fmt.Fprintf(body, "%spackage chrome\n\nimport (\n\t%q\n)\n", Header, generator.Import+"/socket")
+	for _, model := range generator.sortedDomains() {
+		fmt.Fprintf(body, `
+/*
+%[1]s implements socket.Protocoller
+*/
+func (tab *Tab) %[1]s() *socket.%[1]sProtocol {
+	return tab.protocol.%[1]s()
+}
+`, model.protocolName())
+	}
+	return body.String()
+}
+
+/*
+sortedDomains returns the generated domains sorted by accessor name.
+*/
+func (generator *Generator) sortedDomains() []*domainModel {
+	sorted := append([]*domainModel{}, generator.domains...)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].protocolName() < sorted[b].protocolName()
+	})
+	return sorted
+}
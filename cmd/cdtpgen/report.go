@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+reportMethod is a socket protocol method listed in a compatibility report.
+*/
+type reportMethod struct {
+	// Method is the Go method, for example "PageProtocol.Reload".
+	Method string
+
+	// Name is the protocol command or event, for example "Page.reload".
+	Name string
+
+	// Documented lists the markers found in the method and protocol doc
+	// comments, for example "EXPERIMENTAL. DEPRECATED.".
+	Documented string
+
+	// Status is the status of the command or event in the protocol.
+	Status string
+}
+
+/*
+Report returns a markdown report of the socket protocol methods defined in dir
+that are documented as experimental or deprecated, or that are not stable in
+the protocol. Methods that are not stable are not generated by -stable.
+*/
+func Report(protocol *Protocol, dir string) (string, error) {
+	methods, err := reportMethods(protocol, dir)
+	if nil != err {
+		return "", err
+	}
+
+	total := len(methods)
+	domains := map[string][]*reportMethod{}
+	listed := 0
+	for _, method := range methods {
+		if "" == method.Documented && "stable" == method.Status {
+			continue
+		}
+		domain := strings.SplitN(method.Name, ".", 2)[0]
+		domains[domain] = append(domains[domain], method)
+		listed++
+	}
+
+	report := &bytes.Buffer{}
+	fmt.Fprintf(report, "<!-- Code generated by cdtpgen -report %s. DO NOT EDIT. -->\n\n", filepath.ToSlash(dir))
+	report.WriteString("# Compatibility report\n\n")
+	fmt.Fprintf(report, "This report lists the methods of the `%s` socket protocols that are documented as experimental or deprecated, or that are not stable in protocol version %s.%s. ", filepath.ToSlash(dir), protocol.Version.Major, protocol.Version.Minor)
+	report.WriteString("Experimental and deprecated methods can change or be removed in any Chrome release. ")
+	report.WriteString("Only methods that are stable in the protocol are available in the `stable` package.\n\n")
+	fmt.Fprintf(report, "%d of %d methods are listed.\n", listed, total)
+	report.WriteString("\nThe **Documented** column shows the markers in the method documentation. The **Protocol** column shows the current status of the command or event: `stable`, `experimental`, `deprecated` or `removed`.\n")
+
+	names := make([]string, 0, len(domains))
+	for domain := range domains {
+		names = append(names, domain)
+	}
+	sort.Strings(names)
+	for _, domain := range names {
+		fmt.Fprintf(report, "\n## %s\n\n", domain)
+		report.WriteString("| Method | Command or event | Documented | Protocol |\n")
+		report.WriteString("|---|---|---|---|\n")
+		for _, method := range domains[domain] {
+			fmt.Fprintf(report, "| `%s` | `%s` | %s | %s |\n", method.Method, method.Name, method.Documented, method.Status)
+		}
+	}
+	return report.String(), nil
+}
+
+/*
+reportMethods returns the socket protocol methods defined in dir that send a
+command or handle an event, sorted by method.
+*/
+func reportMethods(protocol *Protocol, dir string) ([]*reportMethod, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if nil != err {
+		return nil, err
+	}
+	files := token.NewFileSet()
+	typeDocs := map[string]string{}
+	var funcs []*ast.FuncDecl
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(files, path, nil, parser.ParseComments)
+		if nil != err {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok && nil != decl.Doc {
+						typeDocs[spec.Name.Name] = decl.Doc.Text()
+					}
+				}
+			case *ast.FuncDecl:
+				funcs = append(funcs, decl)
+			}
+		}
+	}
+
+	var methods []*reportMethod
+	for _, decl := range funcs {
+		receiver := receiverType(decl)
+		if !strings.HasSuffix(receiver, "Protocol") {
+			continue
+		}
+		name := commandName(decl)
+		if "" == name {
+			continue
+		}
+		doc := typeDocs[receiver]
+		if nil != decl.Doc {
+			doc += decl.Doc.Text()
+		}
+		methods = append(methods, &reportMethod{
+			Method:     receiver + "." + decl.Name.Name,
+			Name:       name,
+			Documented: docMarkers(doc),
+			Status:     protocol.status(name),
+		})
+	}
+	sort.Slice(methods, func(a, b int) bool {
+		return methods[a].Method < methods[b].Method
+	})
+	return methods, nil
+}
+
+/*
+receiverType returns the name of a method's pointer receiver type.
+*/
+func receiverType(decl *ast.FuncDecl) string {
+	if nil == decl.Recv || 1 != len(decl.Recv.List) {
+		return ""
+	}
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return ident.Name
+}
+
+/*
+commandName returns the command or event name passed to NewCommand or
+NewEventHandler in a method body.
+*/
+func commandName(decl *ast.FuncDecl) string {
+	name := ""
+	ast.Inspect(decl, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || "" != name {
+			return "" == name
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		arg := -1
+		switch ident.Name {
+		case "NewCommand":
+			arg = 1
+		case "NewEventHandler":
+			arg = 0
+		}
+		if arg < 0 || len(call.Args) <= arg {
+			return true
+		}
+		if literal, ok := call.Args[arg].(*ast.BasicLit); ok && token.STRING == literal.Kind {
+			name, _ = strconv.Unquote(literal.Value)
+		}
+		return true
+	})
+	return name
+}
+
+/*
+docMarkers returns the EXPERIMENTAL and DEPRECATED markers found in a doc
+comment.
+*/
+func docMarkers(doc string) string {
+	var markers []string
+	for _, marker := range []string{"EXPERIMENTAL", "DEPRECATED"} {
+		if strings.Contains(doc, marker) {
+			markers = append(markers, marker+".")
+		}
+	}
+	return strings.Join(markers, " ")
+}
+
+/*
+status returns the status of a command or event in the protocol.
+*/
+func (protocol *Protocol) status(name string) string {
+	domainName, itemName := splitRef("", name)
+	for _, domain := range protocol.Domains {
+		if domainName != domain.Domain {
+			continue
+		}
+		for _, items := range [][]*Command{domain.Commands, domain.Events} {
+			for _, item := range items {
+				if itemName != item.Name {
+					continue
+				}
+				var status []string
+				if domain.Experimental || item.Experimental {
+					status = append(status, "experimental")
+				}
+				if domain.Deprecated || item.Deprecated {
+					status = append(status, "deprecated")
+				}
+				if 0 == len(status) {
+					return "stable"
+				}
+				return strings.Join(status, ", ")
+			}
+		}
+	}
+	return "removed"
+}
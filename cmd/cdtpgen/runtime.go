@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+ExperimentalDirective marks runtime files that use experimental protocol items.
+They are not copied by -stable.
+*/
+const ExperimentalDirective = "//cdtpgen:experimental"
+
+/*
+runtimePackages are the directories of the packages, relative to a package
+tree, whose hand written files make up the runtime.
+*/
+var runtimePackages = []string{".", "socket"}
+
+/*
+RuntimeFiles returns the runtime of the package tree in dir, the hand written
+files of its chrome and socket packages, as generated files of the package
+tree in out. Imports of the packages in dir are rewritten to importPath, the
+import path of out.
+
+Files are skipped if they are generated, if they test the protocol wrappers of
+dir (cdtp.*.go and *.protocoller_test.go), if out has a hand written file of
+the same name that replaces them, or, when stable is set, if they are marked
+with the ExperimentalDirective.
+*/
+func RuntimeFiles(dir string, out string, importPath string, stable bool) ([]*File, error) {
+	runtimeImport, err := moduleImport(dir)
+	if nil != err {
+		return nil, err
+	}
+
+	var files []*File
+	for _, pkg := range runtimePackages {
+		paths, err := filepath.Glob(filepath.Join(dir, pkg, "*.go"))
+		if nil != err {
+			return nil, err
+		}
+		sort.Strings(paths)
+		for _, source := range paths {
+			name := filepath.Base(source)
+			if strings.HasPrefix(name, "cdtp.") || strings.HasSuffix(name, ".protocoller_test.go") {
+				continue
+			}
+			data, err := os.ReadFile(source)
+			if nil != err {
+				return nil, err
+			}
+			if strings.HasPrefix(string(data), Header) {
+				continue
+			}
+			if stable && hasDirective(string(data), ExperimentalDirective) {
+				continue
+			}
+			rel := path.Join(pkg, name)
+			replaced, err := os.ReadFile(filepath.Join(out, filepath.FromSlash(rel)))
+			if nil == err && !strings.HasPrefix(string(replaced), Header) {
+				continue
+			} else if nil != err && !os.IsNotExist(err) {
+				return nil, err
+			}
+
+			src, err := rewriteImports(source, data, runtimeImport, importPath)
+			if nil != err {
+				return nil, err
+			}
+			files = append(files, &File{Path: rel, Source: src})
+		}
+	}
+	return files, nil
+}
+
+/*
+hasDirective returns whether a line of a Go source file is the directive.
+*/
+func hasDirective(src string, directive string) bool {
+	for _, line := range strings.Split(src, "\n") {
+		if directive == strings.TrimSpace(line) {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+rewriteImports returns a source file with the generated file header and with
+the imports of from, and of the packages below it, rewritten to to.
+*/
+func rewriteImports(filename string, src []byte, from string, to string) ([]byte, error) {
+	files := token.NewFileSet()
+	file, err := parser.ParseFile(files, filename, src, parser.ImportsOnly|parser.ParseComments)
+	if nil != err {
+		return nil, err
+	}
+	result := string(src)
+	// Imports are replaced from the end so the offsets of the earlier ones
+	// don't change.
+	for a := len(file.Imports) - 1; a >= 0; a-- {
+		spec := file.Imports[a]
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if nil != err {
+			return nil, err
+		}
+		if from != importPath && !strings.HasPrefix(importPath, from+"/") {
+			continue
+		}
+		start := files.Position(spec.Path.Pos()).Offset
+		end := files.Position(spec.Path.End()).Offset
+		result = result[:start] + strconv.Quote(to+strings.TrimPrefix(importPath, from)) + result[end:]
+	}
+	return format.Source([]byte(Header + result))
+}
+
+/*
+moduleImport returns the import path of the package in dir, read from the
+go.mod file of the module that contains it.
+*/
+func moduleImport(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if nil != err {
+		return "", err
+	}
+	for root := abs; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if os.IsNotExist(err) {
+			if filepath.Dir(root) == root {
+				return "", fmt.Errorf("no go.mod found for %s", dir)
+			}
+			continue
+		} else if nil != err {
+			return "", err
+		}
+
+		module := ""
+		for _, line := range strings.Split(string(data), "\n") {
+			if fields := strings.Fields(line); 2 <= len(fields) && "module" == fields[0] {
+				module = strings.Trim(fields[1], `"`)
+			}
+		}
+		if "" == module {
+			return "", fmt.Errorf("no module path in %s", filepath.Join(root, "go.mod"))
+		}
+		rel, err := filepath.Rel(root, abs)
+		if nil != err {
+			return "", err
+		}
+		if "." == rel {
+			return module, nil
+		}
+		return module + "/" + filepath.ToSlash(rel), nil
+	}
+}
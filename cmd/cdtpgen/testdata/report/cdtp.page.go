@@ -0,0 +1,46 @@
+package socket
+
+/*
+PageProtocol is a fixture for the compatibility report tests.
+*/
+type PageProtocol struct {
+	Socket Socketer
+}
+
+/*
+CaptureScreenshot is stable.
+*/
+func (protocol *PageProtocol) CaptureScreenshot() {
+	NewCommand(protocol.Socket, "Page.captureScreenshot", nil)
+}
+
+/*
+GetFrameOwner is experimental.
+
+EXPERIMENTAL.
+*/
+func (protocol *PageProtocol) GetFrameOwner() {
+	NewCommand(protocol.Socket, "Page.getFrameOwner", nil)
+}
+
+/*
+OnLoadEventFired is documented as deprecated.
+
+DEPRECATED.
+*/
+func (protocol *PageProtocol) OnLoadEventFired() {
+	NewEventHandler("Page.loadEventFired", nil)
+}
+
+/*
+Removed sends a command that is no longer in the protocol.
+*/
+func (protocol *PageProtocol) Removed() {
+	NewCommand(protocol.Socket, "Page.removed", nil)
+}
+
+/*
+Helper does not send a command.
+*/
+func (protocol *PageProtocol) Helper() {
+}
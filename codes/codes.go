@@ -36,6 +36,23 @@ const (
 	ChromeTabNotFound
 	// ChromeVersionQueryFailed - 2008: Chromium version query failed.
 	ChromeVersionQueryFailed
+	// ChromePipeFailed - 2009: Cannot create the remote debugging pipe.
+	ChromePipeFailed
+	// ChromeBrowserConnectFailed - 2010: Cannot connect to the browser target.
+	ChromeBrowserConnectFailed
+	// ChromeTargetDiscoveryFailed - 2011: Cannot enable target discovery.
+	ChromeTargetDiscoveryFailed
+	// ChromeBinaryNotFound - 2012: Cannot find the Chromium binary.
+	ChromeBinaryNotFound
+	// ChromeVersionInvalid - 2013: Invalid Chromium version number.
+	ChromeVersionInvalid
+	// ChromeVersionUnsupported - 2014: Chromium is older than the minimum
+	// supported version.
+	ChromeVersionUnsupported
+	// ChromeProfileFailed - 2015: Cannot create the Chromium profile directory.
+	ChromeProfileFailed
+	// ChromeBrowserContextFailed - 2016: A browser context command failed.
+	ChromeBrowserContextFailed
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -83,6 +100,40 @@ const (
 	SocketWriteFailed
 	// SocketPanic - 5003: A panic occurred while reading from a websocket.
 	SocketPanic
+	// SocketCommandCancelled - 5009: The command context was cancelled before
+	// a response was received.
+	SocketCommandCancelled
+	// SocketCommandTimeout - 5010: The command context deadline passed before
+	// a response was received.
+	SocketCommandTimeout
+	// SocketReconnectFailed - 5011: The websocket connection dropped and could
+	// not be re-established.
+	SocketReconnectFailed
+	// SocketDisconnected - 5012: The websocket connection closed before a
+	// response was received.
+	SocketDisconnected
+	// SocketTargetCrashed - 5013: The inspected target crashed before a
+	// response was received.
+	SocketTargetCrashed
+	// SocketAttachFailed - 5014: Attaching to a target session failed.
+	SocketAttachFailed
+	// SocketEventTimeout - 5015: The context deadline passed before a
+	// matching event was received.
+	SocketEventTimeout
+	// SocketEventCancelled - 5016: The context was cancelled before a
+	// matching event was received.
+	SocketEventCancelled
+	// SocketEventHandlerPanic - 5017: An event handler panicked.
+	SocketEventHandlerPanic
+	// SocketEventDropped - 5018: An event was discarded from a full event
+	// queue.
+	SocketEventDropped
+	// SocketMethodUnsupported - 5019: The connected browser does not support
+	// a protocol method or event.
+	SocketMethodUnsupported
+	// SocketCapabilitiesFailed - 5020: Cannot read the connected browser's
+	// protocol description.
+	SocketCapabilitiesFailed
 )
 
 ////////////////////////////////////////////////////////////////////////////
@@ -97,6 +148,25 @@ const (
 	WebsocketPanic
 )
 
+////////////////////////////////////////////////////////////////////////////
+// Pool errors
+////////////////////////////////////////////////////////////////////////////
+const (
+	// PoolClosed - 7000: The pool has been closed.
+	PoolClosed std.Code = iota + 7000
+	// PoolBrowserFailed - 7001: A pooled browser could not be started.
+	PoolBrowserFailed
+	// PoolAcquireCancelled - 7002: The context was done before a tab was
+	// available.
+	PoolAcquireCancelled
+	// PoolTabFailed - 7003: A tab could not be opened in a pooled browser.
+	PoolTabFailed
+	// PoolTabNotLeased - 7004: The released tab was not leased from the pool.
+	PoolTabNotLeased
+	// PoolTabResetFailed - 7005: The tab state could not be reset.
+	PoolTabResetFailed
+)
+
 func init() {
 	errs.Codes[Unspecified] = errs.ErrCode{Int: "The error code was unspecified", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[Unknown] = errs.ErrCode{Int: "An unspecified error occurred", Ext: "An unknown error occurred", HTTP: 500}
@@ -111,6 +181,14 @@ func init() {
 	errs.Codes[ChromeStartTimeout] = errs.ErrCode{Int: "Chromium took too long to start", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeTabNotFound] = errs.ErrCode{Int: "Chromium tab not found", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[ChromeVersionQueryFailed] = errs.ErrCode{Int: "Chromium version query failed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromePipeFailed] = errs.ErrCode{Int: "Cannot create the remote debugging pipe", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBrowserConnectFailed] = errs.ErrCode{Int: "Cannot connect to the browser target", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeTargetDiscoveryFailed] = errs.ErrCode{Int: "Cannot enable target discovery", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBinaryNotFound] = errs.ErrCode{Int: "Cannot find the Chromium binary", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeVersionInvalid] = errs.ErrCode{Int: "Invalid Chromium version number", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeVersionUnsupported] = errs.ErrCode{Int: "Chromium is older than the minimum supported version", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeProfileFailed] = errs.ErrCode{Int: "Cannot create the Chromium profile directory", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[ChromeBrowserContextFailed] = errs.ErrCode{Int: "A browser context command failed", Ext: "An unknown error occurred", HTTP: 500}
 
 	errs.Codes[FlagDoesNotExist] = errs.ErrCode{Int: "The specified argument does not exist", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[FlagTypeInvalid] = errs.ErrCode{Int: "Invalid data type for the specified argument", Ext: "An unknown error occurred", HTTP: 500}
@@ -122,8 +200,27 @@ func init() {
 	errs.Codes[SocketCloseFailed] = errs.ErrCode{Int: "A failure occurred while closing a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketReadFailed] = errs.ErrCode{Int: "A failure occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[SocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketCommandCancelled] = errs.ErrCode{Int: "The command was cancelled before a response was received", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketCommandTimeout] = errs.ErrCode{Int: "The command timed out before a response was received", Ext: "An unknown error occurred", HTTP: 504}
+	errs.Codes[SocketReconnectFailed] = errs.ErrCode{Int: "The websocket connection could not be re-established", Ext: "An unknown error occurred", HTTP: 502}
+	errs.Codes[SocketDisconnected] = errs.ErrCode{Int: "The websocket connection closed before a response was received", Ext: "An unknown error occurred", HTTP: 502}
+	errs.Codes[SocketTargetCrashed] = errs.ErrCode{Int: "The inspected target crashed before a response was received", Ext: "An unknown error occurred", HTTP: 502}
+	errs.Codes[SocketAttachFailed] = errs.ErrCode{Int: "Attaching to a target session failed", Ext: "An unknown error occurred", HTTP: 502}
+	errs.Codes[SocketEventTimeout] = errs.ErrCode{Int: "The wait timed out before a matching event was received", Ext: "An unknown error occurred", HTTP: 504}
+	errs.Codes[SocketEventCancelled] = errs.ErrCode{Int: "The wait was cancelled before a matching event was received", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketEventHandlerPanic] = errs.ErrCode{Int: "A panic occurred in an event handler", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketEventDropped] = errs.ErrCode{Int: "An event was discarded from a full event queue", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[SocketMethodUnsupported] = errs.ErrCode{Int: "The connected browser does not support the protocol method or event", Ext: "An unknown error occurred", HTTP: 501}
+	errs.Codes[SocketCapabilitiesFailed] = errs.ErrCode{Int: "Cannot read the connected browser's protocol description", Ext: "An unknown error occurred", HTTP: 502}
 
 	errs.Codes[WebsocketConnectFailed] = errs.ErrCode{Int: "Websocket connection failed", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketNotConnected] = errs.ErrCode{Int: "Websocket not connected", Ext: "An unknown error occurred", HTTP: 500}
 	errs.Codes[WebsocketPanic] = errs.ErrCode{Int: "A panic occurred while reading from a websocket", Ext: "An unknown error occurred", HTTP: 500}
+
+	errs.Codes[PoolClosed] = errs.ErrCode{Int: "The pool has been closed", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[PoolBrowserFailed] = errs.ErrCode{Int: "A pooled browser could not be started", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[PoolAcquireCancelled] = errs.ErrCode{Int: "The context was done before a tab was available", Ext: "An unknown error occurred", HTTP: 503}
+	errs.Codes[PoolTabFailed] = errs.ErrCode{Int: "A tab could not be opened in a pooled browser", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[PoolTabNotLeased] = errs.ErrCode{Int: "The released tab was not leased from the pool", Ext: "An unknown error occurred", HTTP: 500}
+	errs.Codes[PoolTabResetFailed] = errs.ErrCode{Int: "The tab state could not be reset", Ext: "An unknown error occurred", HTTP: 500}
 }
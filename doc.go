@@ -4,10 +4,12 @@ implementation.
 
 Versions
 
-Versioned packages are available. Curently the only version is `tot` or
-Tip-of-Tree. Stable versions will be made available in the future.
+Versioned packages are available. The `tot` package implements the
+Tip-of-Tree protocol and the `stable` package implements the stable 1.3
+protocol with the same API.
 
     import "github.com/mkenney/go-chrome/tot"
+    import "github.com/mkenney/go-chrome/stable"
 
 Work in progress
 
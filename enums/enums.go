@@ -0,0 +1,111 @@
+/*
+Package enums maps the integer protocol enum types to their string values.
+
+Values that are not allowed by the protocol description, for example values
+added by a newer browser version, are kept rather than rejected. Each unknown
+string is assigned a negative value of the enum type, so it survives decoding
+and is encoded again unchanged. At most MaxUnknown unknown strings are kept for
+each enum type, so decoding changing or untrusted values can't grow memory use
+without bound.
+*/
+package enums
+
+import (
+	"sync"
+)
+
+/*
+MaxUnknown is the maximum number of unknown strings kept for each enum type.
+Further unknown strings all parse to a single overflow value whose string is
+empty.
+*/
+const MaxUnknown = 100
+
+/*
+Values maps the values of an enum type to their strings.
+*/
+type Values[T ~int] struct {
+	// known maps the allowed values to their strings.
+	known map[T]string
+
+	// mux guards the unknown values.
+	mux *sync.RWMutex
+
+	// unknown maps the values assigned to unknown strings to the strings.
+	unknown map[T]string
+
+	// values maps the allowed and unknown strings to their values.
+	values map[string]T
+}
+
+/*
+New returns the Values of an enum type with the allowed values in known.
+*/
+func New[T ~int](known map[T]string) *Values[T] {
+	values := &Values[T]{
+		known:   known,
+		mux:     &sync.RWMutex{},
+		unknown: make(map[T]string),
+		values:  make(map[string]T, len(known)),
+	}
+	for value, str := range known {
+		values.values[str] = value
+	}
+	if _, ok := values.values[""]; !ok {
+		values.values[""] = 0
+	}
+	return values
+}
+
+/*
+Known returns whether value is an allowed value. The zero value, which
+represents an unset enum, is known.
+*/
+func (values *Values[T]) Known(value T) bool {
+	if 0 == value {
+		return true
+	}
+	_, ok := values.known[value]
+	return ok
+}
+
+/*
+Parse returns the value of str. An empty string returns the zero value. An
+unknown string is assigned a new negative value, and the same string always
+returns the same value. Once MaxUnknown unknown strings have been kept, other
+unknown strings return the overflow value.
+*/
+func (values *Values[T]) Parse(str string) T {
+	values.mux.RLock()
+	value, ok := values.values[str]
+	values.mux.RUnlock()
+	if ok {
+		return value
+	}
+
+	values.mux.Lock()
+	defer values.mux.Unlock()
+	if value, ok := values.values[str]; ok {
+		return value
+	}
+	if len(values.unknown) >= MaxUnknown {
+		return T(-MaxUnknown - 1)
+	}
+	value = T(-len(values.unknown) - 1)
+	values.unknown[value] = str
+	values.values[str] = value
+	return value
+}
+
+/*
+String returns the string of value. The string of an unknown value is the
+string it was parsed from, and the string of the overflow value is empty.
+*/
+func (values *Values[T]) String(value T) string {
+	if str, ok := values.known[value]; ok {
+		return str
+	}
+	values.mux.RLock()
+	defer values.mux.RUnlock()
+	return values.unknown[value]
+}
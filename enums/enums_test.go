@@ -0,0 +1,90 @@
+package enums
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type testEnum int
+
+func TestValues(t *testing.T) {
+	values := New(map[testEnum]string{
+		testEnum(0): "",
+		1:           "png",
+		2:           "jpeg",
+	})
+
+	if 1 != values.Parse("png") || "jpeg" != values.String(2) {
+		t.Errorf("Expected the allowed values to be mapped")
+	}
+	if !values.Known(0) || !values.Known(1) || values.Known(3) {
+		t.Errorf("Expected only the allowed values to be known")
+	}
+
+	webp := values.Parse("webp")
+	if webp >= 0 {
+		t.Errorf("Expected a negative value for an unknown string, got %d", webp)
+	}
+	if values.Known(webp) {
+		t.Errorf("Expected %d to be unknown", webp)
+	}
+	if "webp" != values.String(webp) {
+		t.Errorf("Expected 'webp', got '%s'", values.String(webp))
+	}
+	if webp != values.Parse("webp") {
+		t.Errorf("Expected the same value for the same unknown string")
+	}
+	if avif := values.Parse("avif"); webp == avif || "avif" != values.String(avif) {
+		t.Errorf("Expected a new value for a different unknown string")
+	}
+	if "" != values.String(99) {
+		t.Errorf("Expected an empty string for an unmapped value")
+	}
+	if 0 != values.Parse("") {
+		t.Errorf("Expected the zero value for an empty string")
+	}
+}
+
+func TestValuesOverflow(t *testing.T) {
+	values := New(map[testEnum]string{1: "png"})
+	for a := 0; a < MaxUnknown; a++ {
+		value := values.Parse(fmt.Sprintf("format-%d", a))
+		if fmt.Sprintf("format-%d", a) != values.String(value) {
+			t.Errorf("Expected 'format-%d', got '%s'", a, values.String(value))
+		}
+	}
+
+	overflow := values.Parse("webp")
+	if values.Known(overflow) || "" != values.String(overflow) {
+		t.Errorf("Expected an unknown overflow value with an empty string, got '%s'", values.String(overflow))
+	}
+	if overflow != values.Parse("avif") {
+		t.Errorf("Expected unknown strings past the limit to share the overflow value")
+	}
+	if MaxUnknown != len(values.unknown) || MaxUnknown+2 != len(values.values) {
+		t.Errorf("Expected %d unknown strings to be kept, found %d", MaxUnknown, len(values.unknown))
+	}
+	if "format-0" != values.String(values.Parse("format-0")) {
+		t.Errorf("Expected kept unknown strings to still parse")
+	}
+}
+
+func TestValuesConcurrency(t *testing.T) {
+	values := New(map[testEnum]string{1: "png"})
+	wg := &sync.WaitGroup{}
+	for a := 0; a < 10; a++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value := values.Parse("webp")
+			if "webp" != values.String(value) {
+				t.Errorf("Expected 'webp', got '%s'", values.String(value))
+			}
+		}()
+	}
+	wg.Wait()
+	if -1 != values.Parse("webp") {
+		t.Errorf("Expected a single value for the unknown string")
+	}
+}
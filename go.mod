@@ -10,6 +10,7 @@ require (
 )
 
 require (
+	github.com/sanity-io/litter v1.5.8 // indirect
 	github.com/stretchr/testify v1.7.1 // indirect
 	golang.org/x/crypto v0.0.0-20220411220226-7b82a4e95df4 // indirect
 	golang.org/x/sys v0.0.0-20220412071739-889880a91fd5 // indirect
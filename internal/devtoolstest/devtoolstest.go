@@ -0,0 +1,300 @@
+/*
+Package devtoolstest provides a mock of the Chromium developer tools HTTP and
+websocket endpoints for unit testing. It doesn't depend on the protocol
+packages so it can be shared by every protocol version.
+*/
+package devtoolstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+/*
+Target is a target returned by the /json/list and /json/new endpoints.
+*/
+type Target struct {
+	ID                   string `json:"id"`
+	BrowserContextID     string `json:"browserContextId,omitempty"`
+	Type                 string `json:"type"`
+	URL                  string `json:"url"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerURL"`
+}
+
+/*
+Payload is a command payload received by the mock server.
+*/
+type Payload struct {
+	ID        int         `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	SessionID string      `json:"sessionId,omitempty"`
+
+	// TargetID is the ID of the target whose websocket endpoint received
+	// the command, or 'browser' for the browser endpoint.
+	TargetID string `json:"-"`
+}
+
+/*
+message is a command response or an event sent by the mock server.
+*/
+type message struct {
+	ID        int             `json:"id,omitempty"`
+	Method    string          `json:"method,omitempty"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Result    json.RawMessage `json:"result,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+}
+
+/*
+Server is a mock developer tools server.
+*/
+type Server struct {
+	*httptest.Server
+
+	// Results maps protocol methods to the JSON result returned for them.
+	// Methods without a result return an empty object, or a generated ID
+	// for new targets and browser contexts.
+	Results map[string]string
+
+	// Targets is the list of targets returned by the /json/list endpoint.
+	// Empty websocket URLs are filled in with an endpoint on the mock server.
+	Targets []*Target
+
+	conns    []*websocket.Conn
+	mux      *sync.Mutex
+	news     int
+	paths    []string
+	payloads []*Payload
+	targetID int
+	writeMux *sync.Mutex
+}
+
+/*
+New starts and returns a mock developer tools server.
+*/
+func New() *Server {
+	devtools := &Server{
+		Results:  map[string]string{},
+		mux:      &sync.Mutex{},
+		writeMux: &sync.Mutex{},
+	}
+	handler := http.NewServeMux()
+	handler.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		devtools.mux.Lock()
+		defer devtools.mux.Unlock()
+		for _, target := range devtools.Targets {
+			if "" == target.WebSocketDebuggerURL {
+				target.WebSocketDebuggerURL = fmt.Sprintf("ws://%s/devtools/page/%s", r.Host, target.ID)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(devtools.Targets)
+	})
+	handler.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		devtools.mux.Lock()
+		devtools.news++
+		devtools.targetID++
+		target := &Target{
+			ID:   fmt.Sprintf("new-%d", devtools.targetID),
+			Type: "page",
+			URL:  r.URL.RawQuery,
+		}
+		target.WebSocketDebuggerURL = fmt.Sprintf("ws://%s/devtools/page/%s", r.Host, target.ID)
+		devtools.Targets = append(devtools.Targets, target)
+		devtools.mux.Unlock()
+		_ = json.NewEncoder(w).Encode(target)
+	})
+	handler.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/json/close/")
+		devtools.mux.Lock()
+		defer devtools.mux.Unlock()
+		for k, target := range devtools.Targets {
+			if id == target.ID {
+				devtools.Targets = append(devtools.Targets[:k], devtools.Targets[k+1:]...)
+				fmt.Fprint(w, "Target is closing")
+				return
+			}
+		}
+		http.Error(w, "No such target id: "+id, http.StatusNotFound)
+	})
+	handler.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{
+			"Browser": "HeadlessChrome/100.0.4896.60",
+			"Protocol-Version": "1.3",
+			"webSocketDebuggerUrl": "ws://%s/devtools/browser/mock"
+		}`, r.Host)
+	})
+	handler.HandleFunc("/devtools/", devtools.serveWebsocket)
+	devtools.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		devtools.mux.Lock()
+		devtools.paths = append(devtools.paths, r.URL.Path)
+		devtools.mux.Unlock()
+		handler.ServeHTTP(w, r)
+	}))
+	return devtools
+}
+
+/*
+Addr returns the host and port of the mock server.
+*/
+func (devtools *Server) Addr() (string, int) {
+	host, port, _ := net.SplitHostPort(devtools.Listener.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	return host, portNum
+}
+
+/*
+Emit sends an event to every websocket connected to the browser endpoint.
+*/
+func (devtools *Server) Emit(method string, params string) {
+	devtools.mux.Lock()
+	conns := append([]*websocket.Conn{}, devtools.conns...)
+	devtools.mux.Unlock()
+
+	devtools.writeMux.Lock()
+	defer devtools.writeMux.Unlock()
+	for _, conn := range conns {
+		_ = conn.WriteJSON(&message{
+			Method: method,
+			Params: json.RawMessage(params),
+		})
+	}
+}
+
+/*
+Methods returns the protocol methods received from a target.
+*/
+func (devtools *Server) Methods(targetID string) []string {
+	methods := []string{}
+	for _, payload := range devtools.TargetPayloads(targetID) {
+		methods = append(methods, payload.Method)
+	}
+	return methods
+}
+
+/*
+News returns the number of targets opened with the /json/new endpoint.
+*/
+func (devtools *Server) News() int {
+	devtools.mux.Lock()
+	defer devtools.mux.Unlock()
+	return devtools.news
+}
+
+/*
+Paths returns the HTTP request paths received by the mock server.
+*/
+func (devtools *Server) Paths() []string {
+	devtools.mux.Lock()
+	defer devtools.mux.Unlock()
+	return append([]string{}, devtools.paths...)
+}
+
+/*
+Payloads returns the command payloads received by the mock server.
+*/
+func (devtools *Server) Payloads() []*Payload {
+	devtools.mux.Lock()
+	defer devtools.mux.Unlock()
+	return append([]*Payload{}, devtools.payloads...)
+}
+
+/*
+TargetPayloads returns the command payloads received from a target.
+*/
+func (devtools *Server) TargetPayloads(targetID string) []*Payload {
+	devtools.mux.Lock()
+	defer devtools.mux.Unlock()
+	payloads := []*Payload{}
+	for _, payload := range devtools.payloads {
+		if targetID == payload.TargetID {
+			payloads = append(payloads, payload)
+		}
+	}
+	return payloads
+}
+
+/*
+defaultResult returns the result for a command without a configured result.
+New targets and browser contexts are given unique IDs. The caller must hold
+the lock.
+*/
+func (devtools *Server) defaultResult(payload *Payload) string {
+	params, _ := payload.Params.(map[string]interface{})
+	switch payload.Method {
+	case "Target.createBrowserContext":
+		devtools.targetID++
+		return fmt.Sprintf(`{"browserContextId":"context-%d"}`, devtools.targetID)
+
+	case "Target.createTarget":
+		devtools.targetID++
+		target := &Target{
+			ID:   fmt.Sprintf("target-%d", devtools.targetID),
+			Type: "page",
+		}
+		target.URL, _ = params["url"].(string)
+		target.BrowserContextID, _ = params["browserContextId"].(string)
+		devtools.Targets = append(devtools.Targets, target)
+		return fmt.Sprintf(`{"targetId":"%s"}`, target.ID)
+
+	case "Target.disposeBrowserContext":
+		contextID, _ := params["browserContextId"].(string)
+		targets := []*Target{}
+		for _, target := range devtools.Targets {
+			if contextID != target.BrowserContextID {
+				targets = append(targets, target)
+			}
+		}
+		devtools.Targets = targets
+	}
+	return `{}`
+}
+
+func (devtools *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if nil != err {
+		return
+	}
+	defer conn.Close()
+	targetID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	if strings.HasPrefix(r.URL.Path, "/devtools/browser/") {
+		targetID = "browser"
+		devtools.mux.Lock()
+		devtools.conns = append(devtools.conns, conn)
+		devtools.mux.Unlock()
+	}
+	for {
+		payload := &Payload{}
+		if err := conn.ReadJSON(payload); nil != err {
+			return
+		}
+		payload.TargetID = targetID
+		devtools.mux.Lock()
+		devtools.payloads = append(devtools.payloads, payload)
+		result, ok := devtools.Results[payload.Method]
+		if !ok {
+			result = devtools.defaultResult(payload)
+		}
+		devtools.mux.Unlock()
+		response := &message{
+			ID:        payload.ID,
+			Result:    json.RawMessage(result),
+			SessionID: payload.SessionID,
+		}
+		devtools.writeMux.Lock()
+		err := conn.WriteJSON(response)
+		devtools.writeMux.Unlock()
+		if nil != err {
+			return
+		}
+	}
+}
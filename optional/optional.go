@@ -0,0 +1,62 @@
+/*
+Package optional provides constructors for optional protocol command
+parameters.
+
+Optional scalar parameters are pointers, so an unset parameter (nil) is omitted
+from the command while a zero value such as 0 or false is sent:
+
+	params := &page.CaptureScreenshotParams{
+		Format:  page.Format.Jpeg,
+		Quality: optional.Int(0),
+	}
+
+Parameters of named scalar types, such as dom.NodeID, are set with Of:
+
+	params := &dom.DescribeNodeParams{
+		NodeID: optional.Of(nodeID),
+	}
+*/
+package optional
+
+/*
+Bool returns a pointer to a bool parameter value.
+*/
+func Bool(value bool) *bool {
+	return &value
+}
+
+/*
+Float64 returns a pointer to a float64 parameter value.
+*/
+func Float64(value float64) *float64 {
+	return &value
+}
+
+/*
+Int returns a pointer to an int parameter value.
+*/
+func Int(value int) *int {
+	return &value
+}
+
+/*
+Int64 returns a pointer to an int64 parameter value.
+*/
+func Int64(value int64) *int64 {
+	return &value
+}
+
+/*
+String returns a pointer to a string parameter value.
+*/
+func String(value string) *string {
+	return &value
+}
+
+/*
+Of returns a pointer to a parameter value of any type, for parameters of named
+types such as dom.NodeID or network.TimeSinceEpoch.
+*/
+func Of[T any](value T) *T {
+	return &value
+}
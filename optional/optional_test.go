@@ -0,0 +1,60 @@
+package optional
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptional(t *testing.T) {
+	params := struct {
+		Bool    *bool    `json:"bool,omitempty"`
+		Float64 *float64 `json:"float64,omitempty"`
+		Int     *int     `json:"int,omitempty"`
+		Int64   *int64   `json:"int64,omitempty"`
+		String  *string  `json:"string,omitempty"`
+	}{}
+
+	result, err := json.Marshal(params)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `{}` != string(result) {
+		t.Errorf("Expected unset parameters to be omitted, got '%s'", result)
+	}
+
+	params.Bool = Bool(false)
+	params.Float64 = Float64(0)
+	params.Int = Int(0)
+	params.Int64 = Int64(0)
+	params.String = String("")
+	result, err = json.Marshal(params)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `{"bool":false,"float64":0,"int":0,"int64":0,"string":""}` != string(result) {
+		t.Errorf("Expected zero values to be sent, got '%s'", result)
+	}
+}
+
+func TestOf(t *testing.T) {
+	type NodeID int
+	params := struct {
+		NodeID *NodeID `json:"nodeId,omitempty"`
+	}{}
+	result, err := json.Marshal(params)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `{}` != string(result) {
+		t.Errorf("Expected unset parameters to be omitted, got '%s'", result)
+	}
+
+	params.NodeID = Of(NodeID(0))
+	result, err = json.Marshal(params)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `{"nodeId":0}` != string(result) {
+		t.Errorf("Expected zero values to be sent, got '%s'", result)
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package browser provides type definitions for use with the Chrome Browser protocol
+
+The Browser domain defines methods and events for browser managing.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/
+*/
+package browser
+
+/*
+ContextID represents the Browser.BrowserContextID type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-BrowserContextID
+*/
+type ContextID string
+
+/*
+WindowID represents the Browser.WindowID type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-WindowID
+*/
+type WindowID int
+
+/*
+WindowState represents the Browser.WindowState type.
+
+The state of the browser window. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-WindowState
+*/
+type WindowState string
+
+/*
+Bounds represents the Browser.Bounds type.
+
+Browser window bounds information EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-Bounds
+*/
+type Bounds struct {
+	// Optional. The offset from the left edge of the screen to the window in
+	// pixels.
+	Left int `json:"left,omitempty"`
+
+	// Optional. The offset from the top edge of the screen to the window in
+	// pixels.
+	Top int `json:"top,omitempty"`
+
+	// Optional. The window width in pixels.
+	Width int `json:"width,omitempty"`
+
+	// Optional. The window height in pixels.
+	Height int `json:"height,omitempty"`
+
+	// Optional. The window state. Default to normal.
+	WindowState WindowState `json:"windowState,omitempty"`
+}
+
+/*
+PermissionDescriptor represents the Browser.PermissionDescriptor type.
+
+Definition of PermissionDescriptor defined in the Permissions API:
+https://w3c.github.io/permissions/#dom-permissiondescriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-PermissionDescriptor
+*/
+type PermissionDescriptor struct {
+	// Name of permission. See
+	// https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl
+	// for valid permission names.
+	Name string `json:"name"`
+
+	// Optional. For "midi" permission, may also specify sysex control.
+	Sysex bool `json:"sysex,omitempty"`
+
+	// Optional. For "push" permission, may specify userVisibleOnly. Note that
+	// userVisibleOnly = true is the only currently supported type.
+	UserVisibleOnly bool `json:"userVisibleOnly,omitempty"`
+
+	// Optional. For "clipboard" permission, may specify allowWithoutSanitization.
+	AllowWithoutSanitization bool `json:"allowWithoutSanitization,omitempty"`
+
+	// Optional. For "fullscreen" permission, must specify
+	// allowWithoutGesture:true.
+	AllowWithoutGesture bool `json:"allowWithoutGesture,omitempty"`
+
+	// Optional. For "camera" permission, may specify panTiltZoom.
+	PanTiltZoom bool `json:"panTiltZoom,omitempty"`
+}
+
+/*
+Bucket represents the Browser.Bucket type.
+
+Chrome histogram bucket. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-Bucket
+*/
+type Bucket struct {
+	// Minimum value (inclusive).
+	Low int `json:"low"`
+
+	// Maximum value (exclusive).
+	High int `json:"high"`
+
+	// Number of samples.
+	Count int `json:"count"`
+}
+
+/*
+Histogram represents the Browser.Histogram type.
+
+Chrome histogram. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-Histogram
+*/
+type Histogram struct {
+	// Name.
+	Name string `json:"name"`
+
+	// Sum of sample values.
+	Sum int `json:"sum"`
+
+	// Total number of samples.
+	Count int `json:"count"`
+
+	// Buckets.
+	Buckets []*Bucket `json:"buckets"`
+}
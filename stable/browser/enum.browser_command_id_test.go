@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCommandID(t *testing.T) {
+	var enum CommandIDEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CommandIDEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CommandID.OpenTabSearch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"openTabSearch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"openTabSearch"`, result)
+	}
+	json.Unmarshal([]byte(`"openTabSearch"`), &enum)
+	if CommandID.OpenTabSearch != enum {
+		t.Errorf("Expected %d, got %d", CommandID.OpenTabSearch, enum)
+	}
+
+	enum = CommandID.CloseTabSearch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"closeTabSearch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"closeTabSearch"`, result)
+	}
+	json.Unmarshal([]byte(`"closeTabSearch"`), &enum)
+	if CommandID.CloseTabSearch != enum {
+		t.Errorf("Expected %d, got %d", CommandID.CloseTabSearch, enum)
+	}
+
+	enum = CommandID.OpenGlic
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"openGlic"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"openGlic"`, result)
+	}
+	json.Unmarshal([]byte(`"openGlic"`), &enum)
+	if CommandID.OpenGlic != enum {
+		t.Errorf("Expected %d, got %d", CommandID.OpenGlic, enum)
+	}
+}
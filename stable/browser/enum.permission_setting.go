@@ -0,0 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type permissionSettingEnum struct {
+	Granted PermissionSettingEnum
+	Denied  PermissionSettingEnum
+	Prompt  PermissionSettingEnum
+}
+
+/*
+PermissionSetting provides named access to the PermissionSettingEnum values.
+*/
+var PermissionSetting = permissionSettingEnum{
+	Granted: permissionSettingGranted,
+	Denied:  permissionSettingDenied,
+	Prompt:  permissionSettingPrompt,
+}
+
+/*
+PermissionSettingEnum represents the Browser.PermissionSetting values.
+
+Allowed values:
+  - PermissionSetting.Granted "granted"
+  - PermissionSetting.Denied  "denied"
+  - PermissionSetting.Prompt  "prompt"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#type-PermissionSetting
+*/
+type PermissionSettingEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PermissionSettingEnum) String() string {
+	return _permissionSettingEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PermissionSettingEnum) Unknown() bool {
+	return !_permissionSettingEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PermissionSettingEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PermissionSettingEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _permissionSettingEnums.Parse(val)
+	return nil
+}
+
+const (
+	// permissionSettingGranted represents the "granted" value.
+	permissionSettingGranted PermissionSettingEnum = iota + 1
+	// permissionSettingDenied represents the "denied" value.
+	permissionSettingDenied
+	// permissionSettingPrompt represents the "prompt" value.
+	permissionSettingPrompt
+)
+
+var _permissionSettingEnums = enums.New(map[PermissionSettingEnum]string{
+	PermissionSettingEnum(0): "",
+	permissionSettingGranted: "granted",
+	permissionSettingDenied:  "denied",
+	permissionSettingPrompt:  "prompt",
+})
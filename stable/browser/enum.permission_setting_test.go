@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPermissionSetting(t *testing.T) {
+	var enum PermissionSettingEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PermissionSettingEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PermissionSetting.Granted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"granted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"granted"`, result)
+	}
+	json.Unmarshal([]byte(`"granted"`), &enum)
+	if PermissionSetting.Granted != enum {
+		t.Errorf("Expected %d, got %d", PermissionSetting.Granted, enum)
+	}
+
+	enum = PermissionSetting.Denied
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"denied"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"denied"`, result)
+	}
+	json.Unmarshal([]byte(`"denied"`), &enum)
+	if PermissionSetting.Denied != enum {
+		t.Errorf("Expected %d, got %d", PermissionSetting.Denied, enum)
+	}
+
+	enum = PermissionSetting.Prompt
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prompt"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prompt"`, result)
+	}
+	json.Unmarshal([]byte(`"prompt"`), &enum)
+	if PermissionSetting.Prompt != enum {
+		t.Errorf("Expected %d, got %d", PermissionSetting.Prompt, enum)
+	}
+}
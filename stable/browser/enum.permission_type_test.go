@@ -0,0 +1,524 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPermissionType(t *testing.T) {
+	var enum PermissionTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PermissionTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PermissionType.Ar
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ar"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ar"`, result)
+	}
+	json.Unmarshal([]byte(`"ar"`), &enum)
+	if PermissionType.Ar != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Ar, enum)
+	}
+
+	enum = PermissionType.AudioCapture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"audioCapture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"audioCapture"`, result)
+	}
+	json.Unmarshal([]byte(`"audioCapture"`), &enum)
+	if PermissionType.AudioCapture != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.AudioCapture, enum)
+	}
+
+	enum = PermissionType.AutomaticFullscreen
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"automaticFullscreen"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"automaticFullscreen"`, result)
+	}
+	json.Unmarshal([]byte(`"automaticFullscreen"`), &enum)
+	if PermissionType.AutomaticFullscreen != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.AutomaticFullscreen, enum)
+	}
+
+	enum = PermissionType.BackgroundFetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"backgroundFetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"backgroundFetch"`, result)
+	}
+	json.Unmarshal([]byte(`"backgroundFetch"`), &enum)
+	if PermissionType.BackgroundFetch != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.BackgroundFetch, enum)
+	}
+
+	enum = PermissionType.BackgroundSync
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"backgroundSync"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"backgroundSync"`, result)
+	}
+	json.Unmarshal([]byte(`"backgroundSync"`), &enum)
+	if PermissionType.BackgroundSync != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.BackgroundSync, enum)
+	}
+
+	enum = PermissionType.CameraPanTiltZoom
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cameraPanTiltZoom"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cameraPanTiltZoom"`, result)
+	}
+	json.Unmarshal([]byte(`"cameraPanTiltZoom"`), &enum)
+	if PermissionType.CameraPanTiltZoom != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.CameraPanTiltZoom, enum)
+	}
+
+	enum = PermissionType.CapturedSurfaceControl
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"capturedSurfaceControl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"capturedSurfaceControl"`, result)
+	}
+	json.Unmarshal([]byte(`"capturedSurfaceControl"`), &enum)
+	if PermissionType.CapturedSurfaceControl != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.CapturedSurfaceControl, enum)
+	}
+
+	enum = PermissionType.ClipboardReadWrite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clipboardReadWrite"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clipboardReadWrite"`, result)
+	}
+	json.Unmarshal([]byte(`"clipboardReadWrite"`), &enum)
+	if PermissionType.ClipboardReadWrite != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.ClipboardReadWrite, enum)
+	}
+
+	enum = PermissionType.ClipboardSanitizedWrite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clipboardSanitizedWrite"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clipboardSanitizedWrite"`, result)
+	}
+	json.Unmarshal([]byte(`"clipboardSanitizedWrite"`), &enum)
+	if PermissionType.ClipboardSanitizedWrite != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.ClipboardSanitizedWrite, enum)
+	}
+
+	enum = PermissionType.DisplayCapture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"displayCapture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"displayCapture"`, result)
+	}
+	json.Unmarshal([]byte(`"displayCapture"`), &enum)
+	if PermissionType.DisplayCapture != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.DisplayCapture, enum)
+	}
+
+	enum = PermissionType.DurableStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"durableStorage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"durableStorage"`, result)
+	}
+	json.Unmarshal([]byte(`"durableStorage"`), &enum)
+	if PermissionType.DurableStorage != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.DurableStorage, enum)
+	}
+
+	enum = PermissionType.Geolocation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"geolocation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"geolocation"`, result)
+	}
+	json.Unmarshal([]byte(`"geolocation"`), &enum)
+	if PermissionType.Geolocation != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Geolocation, enum)
+	}
+
+	enum = PermissionType.HandTracking
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"handTracking"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"handTracking"`, result)
+	}
+	json.Unmarshal([]byte(`"handTracking"`), &enum)
+	if PermissionType.HandTracking != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.HandTracking, enum)
+	}
+
+	enum = PermissionType.IdleDetection
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"idleDetection"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"idleDetection"`, result)
+	}
+	json.Unmarshal([]byte(`"idleDetection"`), &enum)
+	if PermissionType.IdleDetection != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.IdleDetection, enum)
+	}
+
+	enum = PermissionType.KeyboardLock
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"keyboardLock"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"keyboardLock"`, result)
+	}
+	json.Unmarshal([]byte(`"keyboardLock"`), &enum)
+	if PermissionType.KeyboardLock != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.KeyboardLock, enum)
+	}
+
+	enum = PermissionType.LocalFonts
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"localFonts"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"localFonts"`, result)
+	}
+	json.Unmarshal([]byte(`"localFonts"`), &enum)
+	if PermissionType.LocalFonts != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.LocalFonts, enum)
+	}
+
+	enum = PermissionType.LocalNetworkAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"localNetworkAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"localNetworkAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"localNetworkAccess"`), &enum)
+	if PermissionType.LocalNetworkAccess != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.LocalNetworkAccess, enum)
+	}
+
+	enum = PermissionType.Midi
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"midi"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"midi"`, result)
+	}
+	json.Unmarshal([]byte(`"midi"`), &enum)
+	if PermissionType.Midi != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Midi, enum)
+	}
+
+	enum = PermissionType.MidiSysex
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"midiSysex"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"midiSysex"`, result)
+	}
+	json.Unmarshal([]byte(`"midiSysex"`), &enum)
+	if PermissionType.MidiSysex != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.MidiSysex, enum)
+	}
+
+	enum = PermissionType.Nfc
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"nfc"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"nfc"`, result)
+	}
+	json.Unmarshal([]byte(`"nfc"`), &enum)
+	if PermissionType.Nfc != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Nfc, enum)
+	}
+
+	enum = PermissionType.Notifications
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"notifications"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"notifications"`, result)
+	}
+	json.Unmarshal([]byte(`"notifications"`), &enum)
+	if PermissionType.Notifications != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Notifications, enum)
+	}
+
+	enum = PermissionType.PaymentHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"paymentHandler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"paymentHandler"`, result)
+	}
+	json.Unmarshal([]byte(`"paymentHandler"`), &enum)
+	if PermissionType.PaymentHandler != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.PaymentHandler, enum)
+	}
+
+	enum = PermissionType.PeriodicBackgroundSync
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"periodicBackgroundSync"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"periodicBackgroundSync"`, result)
+	}
+	json.Unmarshal([]byte(`"periodicBackgroundSync"`), &enum)
+	if PermissionType.PeriodicBackgroundSync != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.PeriodicBackgroundSync, enum)
+	}
+
+	enum = PermissionType.PointerLock
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"pointerLock"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"pointerLock"`, result)
+	}
+	json.Unmarshal([]byte(`"pointerLock"`), &enum)
+	if PermissionType.PointerLock != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.PointerLock, enum)
+	}
+
+	enum = PermissionType.ProtectedMediaIdentifier
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"protectedMediaIdentifier"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"protectedMediaIdentifier"`, result)
+	}
+	json.Unmarshal([]byte(`"protectedMediaIdentifier"`), &enum)
+	if PermissionType.ProtectedMediaIdentifier != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.ProtectedMediaIdentifier, enum)
+	}
+
+	enum = PermissionType.Sensors
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sensors"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sensors"`, result)
+	}
+	json.Unmarshal([]byte(`"sensors"`), &enum)
+	if PermissionType.Sensors != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Sensors, enum)
+	}
+
+	enum = PermissionType.SmartCard
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"smartCard"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"smartCard"`, result)
+	}
+	json.Unmarshal([]byte(`"smartCard"`), &enum)
+	if PermissionType.SmartCard != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.SmartCard, enum)
+	}
+
+	enum = PermissionType.SpeakerSelection
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"speakerSelection"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"speakerSelection"`, result)
+	}
+	json.Unmarshal([]byte(`"speakerSelection"`), &enum)
+	if PermissionType.SpeakerSelection != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.SpeakerSelection, enum)
+	}
+
+	enum = PermissionType.StorageAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"storageAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"storageAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"storageAccess"`), &enum)
+	if PermissionType.StorageAccess != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.StorageAccess, enum)
+	}
+
+	enum = PermissionType.TopLevelStorageAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"topLevelStorageAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"topLevelStorageAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"topLevelStorageAccess"`), &enum)
+	if PermissionType.TopLevelStorageAccess != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.TopLevelStorageAccess, enum)
+	}
+
+	enum = PermissionType.VideoCapture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"videoCapture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"videoCapture"`, result)
+	}
+	json.Unmarshal([]byte(`"videoCapture"`), &enum)
+	if PermissionType.VideoCapture != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.VideoCapture, enum)
+	}
+
+	enum = PermissionType.Vr
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"vr"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"vr"`, result)
+	}
+	json.Unmarshal([]byte(`"vr"`), &enum)
+	if PermissionType.Vr != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.Vr, enum)
+	}
+
+	enum = PermissionType.WakeLockScreen
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"wakeLockScreen"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"wakeLockScreen"`, result)
+	}
+	json.Unmarshal([]byte(`"wakeLockScreen"`), &enum)
+	if PermissionType.WakeLockScreen != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.WakeLockScreen, enum)
+	}
+
+	enum = PermissionType.WakeLockSystem
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"wakeLockSystem"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"wakeLockSystem"`, result)
+	}
+	json.Unmarshal([]byte(`"wakeLockSystem"`), &enum)
+	if PermissionType.WakeLockSystem != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.WakeLockSystem, enum)
+	}
+
+	enum = PermissionType.WebAppInstallation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"webAppInstallation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webAppInstallation"`, result)
+	}
+	json.Unmarshal([]byte(`"webAppInstallation"`), &enum)
+	if PermissionType.WebAppInstallation != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.WebAppInstallation, enum)
+	}
+
+	enum = PermissionType.WebPrinting
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"webPrinting"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webPrinting"`, result)
+	}
+	json.Unmarshal([]byte(`"webPrinting"`), &enum)
+	if PermissionType.WebPrinting != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.WebPrinting, enum)
+	}
+
+	enum = PermissionType.WindowManagement
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"windowManagement"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"windowManagement"`, result)
+	}
+	json.Unmarshal([]byte(`"windowManagement"`), &enum)
+	if PermissionType.WindowManagement != enum {
+		t.Errorf("Expected %d, got %d", PermissionType.WindowManagement, enum)
+	}
+}
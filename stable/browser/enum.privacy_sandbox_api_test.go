@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPrivacySandboxAPI(t *testing.T) {
+	var enum PrivacySandboxAPIEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PrivacySandboxAPIEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PrivacySandboxAPI.BiddingAndAuctionServices
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BiddingAndAuctionServices"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BiddingAndAuctionServices"`, result)
+	}
+	json.Unmarshal([]byte(`"BiddingAndAuctionServices"`), &enum)
+	if PrivacySandboxAPI.BiddingAndAuctionServices != enum {
+		t.Errorf("Expected %d, got %d", PrivacySandboxAPI.BiddingAndAuctionServices, enum)
+	}
+
+	enum = PrivacySandboxAPI.TrustedKeyValue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TrustedKeyValue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TrustedKeyValue"`, result)
+	}
+	json.Unmarshal([]byte(`"TrustedKeyValue"`), &enum)
+	if PrivacySandboxAPI.TrustedKeyValue != enum {
+		t.Errorf("Expected %d, got %d", PrivacySandboxAPI.TrustedKeyValue, enum)
+	}
+}
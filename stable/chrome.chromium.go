@@ -0,0 +1,766 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/stable/socket"
+)
+
+/*
+New returns a pointer to a Chromium instance.
+*/
+func New(
+	flags ChromiumFlags,
+	binary string,
+	workdir string,
+	stdout string,
+	stderr string,
+) *Chrome {
+	return &Chrome{
+		flags:      flags,
+		binary:     binary,
+		connMux:    &sync.Mutex{},
+		mux:        &sync.Mutex{},
+		stderr:     stderr,
+		stderrTail: &stderrTail{mux: &sync.Mutex{}},
+		stdout:     stdout,
+		workdir:    workdir,
+	}
+}
+
+/*
+Connect returns a pointer to a Chromium instance connected to an already
+running browser whose developer tools endpoints are available at addr:port. No
+process is launched. Existing page targets are discovered using the /json/list
+endpoint and adopted as tabs, whether or not they were created by this library.
+
+Close detaches from the adopted tabs without closing them or signalling the
+browser process.
+*/
+func Connect(addr string, port int) (*Chrome, error) {
+	chrome := New(
+		&Flags{
+			"addr": addr,
+			"port": port,
+		},
+		"",
+		"",
+		"",
+		"",
+	)
+	if _, err := chrome.Version(); nil != err {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, fmt.Sprintf("could not connect to %s:%d", addr, port))
+	}
+
+	targets := []*TabData{}
+	if _, err := chrome.Query("/json/list", url.Values{}, &targets); nil != err {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "/json/list query failed")
+	}
+	for _, data := range targets {
+		if "page" != data.Type {
+			continue
+		}
+		if _, err := chrome.adoptTab(data); nil != err {
+			chrome.Close()
+			return nil, err
+		}
+	}
+
+	if err := chrome.watchTargets(); nil != err {
+		log.WithFields(log.Fields{"error": err}).Warn("target discovery unavailable, tabs will not be synchronized")
+	}
+
+	return chrome, nil
+}
+
+/*
+Chrome implements Chromium. Chrome, its tabs and their sockets are safe for
+concurrent use.
+*/
+type Chrome struct {
+	// flags stores CLI arguments for the Chromium binary.
+	flags ChromiumFlags
+
+	// Optional. binary is the path to the Chromium binary. Defaults to the
+	// binary found by FindBinary, or '/usr/bin/google-chrome'.
+	binary string
+
+	// binaryErr is the error FindBinary returned when the CHROME_BIN
+	// environment variable names a binary that does not exist.
+	binaryErr error
+
+	// minimumVersion is the oldest Chromium version Launch accepts.
+	minimumVersion *BrowserVersion
+
+	// profile defines the Chromium user data directory.
+	profile *Profile
+
+	// tempProfileDir is the temporary profile directory created by Launch,
+	// which is deleted on Close.
+	tempProfileDir string
+
+	// Optional. port is the port number the developer tools endpoints will
+	// listen on. Defaults to 9222.
+	//port int
+
+	// tabs is a list of the currently open tabs.
+	tabs []*Tab
+
+	// mux guards the list of open tabs, the new page handlers and settings
+	// that are initialized with default values on first use.
+	mux *sync.Mutex
+
+	// connMux guards the browser connection and version data, which are
+	// initialized on first use.
+	connMux *sync.Mutex
+
+	// newPageHandlers are called when a new page target is added to the list
+	// of open tabs.
+	newPageHandlers []func(tab *Tab)
+
+	// capabilities is the protocol description of the browser read by
+	// DetectCapabilities.
+	capabilities *socket.Capabilities
+
+	// version contains Chromium version information.
+	version *Version
+
+	// Optional. workdir is the path to the Chromium working directory. Defaults
+	// to '/tmp/headless-chrome'.
+	workdir string
+
+	// Optional. stderr is a path to a file to be used to capture STDERR output.
+	// Defaults to the system STDERR.
+	stderr string
+
+	// Optional. stdout is a path to a file to be used to capture STDOUT output.
+	// Defaults to the system STDOUT.
+	stdout string
+
+	// stdERRFile is a pointer to a file handle to be used to capture STDERR
+	// output.
+	stdERRFile *os.File
+
+	// stdOUTFile is a pointer to a file handle to be used to capture STDOUT
+	// output.
+	stdOUTFile *os.File
+
+	// process is a pointer to the os.Process struct containing the process PID.
+	process *os.Process
+
+	// exited is closed when the Chromium process exits.
+	exited chan struct{}
+
+	// closing is set when Close starts stopping the Chromium process, so the
+	// exit isn't reported as unexpected.
+	closing bool
+
+	// exitHandlers are called when the Chromium process exits unexpectedly.
+	exitHandlers []func(exit *ProcessExit)
+
+	// shutdownPolicy defines the grace periods Close waits for the process
+	// to exit.
+	shutdownPolicy *ShutdownPolicy
+
+	// stderrTail is the tail of the Chromium STDERR output.
+	stderrTail *stderrTail
+
+	// stderrDone is closed when the Chromium STDERR output ends.
+	stderrDone <-chan struct{}
+
+	// pipe is the socket connected to the browser over the remote debugging
+	// pipe when Chromium is launched with the 'remote-debugging-pipe' flag.
+	pipe *socket.Socket
+
+	// browser is the browser-level websocket connection shared by flattened
+	// tab sessions.
+	browser *socket.Socket
+
+	// flatten defines whether new tabs are attached as flattened sessions on
+	// the browser-level connection instead of opening their own websocket.
+	flatten bool
+}
+
+/*
+Address implements Chromium.
+
+Default value is 'localhost'
+*/
+func (chrome *Chrome) Address() string {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if !chrome.Flags().Has("addr") {
+		chrome.Flags().Set("addr", "localhost")
+	}
+	value, _ := chrome.Flags().Get("addr")
+	return value.(string)
+}
+
+/*
+Flags implements Chromium.
+*/
+func (chrome *Chrome) Flags() ChromiumFlags {
+	return chrome.flags
+}
+
+/*
+Binary implements Chromium.
+
+Default value is the binary named by the CHROME_BIN environment variable or the
+first of BinaryNames found on the PATH. If CHROME_BIN is not set and no binary is
+found it is '/usr/bin/google-chrome' for use with the mkenney/chromium-headless
+Docker image.
+*/
+func (chrome *Chrome) Binary() string {
+	binary, _ := chrome.findBinary()
+	return binary
+}
+
+/*
+findBinary returns the path to the Chromium binary. If the CHROME_BIN
+environment variable names a binary that does not exist the path is returned
+unchanged with a codes.ChromeBinaryNotFound error, rather than falling back to
+a different browser.
+*/
+func (chrome *Chrome) findBinary() (string, error) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if "" == chrome.binary {
+		binary, err := FindBinary()
+		if nil != err {
+			if env := os.Getenv(BinaryEnv); "" != env {
+				binary = env
+				chrome.binaryErr = err
+			} else {
+				binary = DefaultBinary
+			}
+		}
+		chrome.binary = binary
+	}
+	return chrome.binary, chrome.binaryErr
+}
+
+/*
+Browser implements Chromium.
+
+The browser-level connection is opened on the first call using the
+webSocketDebuggerUrl reported by the /json/version endpoint and is shared by
+subsequent calls. When Chromium was launched with the 'remote-debugging-pipe'
+flag the pipe connection is returned.
+*/
+func (chrome *Chrome) Browser() (*socket.Socket, error) {
+	if nil != chrome.pipe {
+		return chrome.pipe, nil
+	}
+
+	chrome.connMux.Lock()
+	defer chrome.connMux.Unlock()
+	if nil != chrome.browser {
+		return chrome.browser, nil
+	}
+
+	version, err := chrome.queryVersion()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromeBrowserConnectFailed, "could not discover the browser websocket URL")
+	}
+	if "" == version.WebSocketDebuggerURL {
+		return nil, errs.New(codes.ChromeBrowserConnectFailed, "no browser websocket URL found")
+	}
+	websocketURL, err := url.Parse(version.WebSocketDebuggerURL)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromeBrowserConnectFailed, fmt.Sprintf("invalid browser websocket URL '%s'", version.WebSocketDebuggerURL))
+	}
+
+	browser := socket.New(websocketURL)
+	if err := browser.Connect(); nil != err {
+		browser.Stop()
+		return nil, errs.Wrap(err, codes.ChromeBrowserConnectFailed, "could not connect to the browser")
+	}
+	chrome.browser = browser
+	return chrome.browser, nil
+}
+
+/*
+Close implements Chromium.
+
+If this instance didn't launch the Chromium process, for example when it was
+created by Connect, open tabs are detached rather than closed and the browser
+keeps running.
+*/
+func (chrome *Chrome) Close() error {
+	var err error
+	if chrome.process == nil {
+		for _, tab := range chrome.Tabs() {
+			tab.Detach()
+		}
+	} else {
+		chrome.closeTabs()
+		err = chrome.shutdown()
+	}
+	chrome.connMux.Lock()
+	browser := chrome.browser
+	chrome.browser = nil
+	chrome.capabilities = nil
+	chrome.connMux.Unlock()
+	if browser != nil {
+		browser.Stop()
+	}
+	if chrome.pipe != nil {
+		chrome.pipe.Stop()
+	}
+	if nil != chrome.stderrDone {
+		select {
+		case <-chrome.stderrDone:
+		case <-time.After(time.Second):
+		}
+	}
+	chrome.closeOutput()
+	chrome.removeProfile()
+	return err
+}
+
+/*
+closeOutput closes the STDOUT and STDERR output files. The process's own STDOUT
+and STDERR are left open.
+*/
+func (chrome *Chrome) closeOutput() {
+	if chrome.stdOUTFile != nil && chrome.stdOUTFile != os.Stdout {
+		chrome.stdOUTFile.Close()
+	}
+	if chrome.stdERRFile != nil && chrome.stdERRFile != os.Stderr {
+		chrome.stdERRFile.Close()
+	}
+}
+
+/*
+DebuggingAddress implements Chromium.
+
+Default value is '0.0.0.0'.
+*/
+func (chrome *Chrome) DebuggingAddress() string {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if !chrome.Flags().Has("remote-debugging-address") {
+		chrome.Flags().Set("remote-debugging-address", "0.0.0.0")
+	}
+	value, _ := chrome.Flags().Get("remote-debugging-address")
+	return value.(string)
+}
+
+/*
+DebuggingPort implements Chromium.
+*/
+func (chrome *Chrome) DebuggingPort() int {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if !chrome.Flags().Has("remote-debugging-port") {
+		chrome.Flags().Set("remote-debugging-port", 9222)
+	}
+	value, _ := chrome.Flags().Get("remote-debugging-port")
+	return value.(int)
+}
+
+/*
+GetTab implements Chromium.
+*/
+func (chrome *Chrome) GetTab(tabID string) (Tabber, error) {
+	var tab Tabber
+	var err error
+	for _, tab = range chrome.Tabs() {
+		if tab.Data().ID == tabID {
+			return tab, nil
+		}
+	}
+	err = errs.New(codes.ChromeTabNotFound, fmt.Sprintf("tab '%s' not found", tabID))
+	return tab, err
+}
+
+/*
+Launch implements Chromium.
+
+This implementation makes it's best effort to set a few sane default values if
+they aren't included in the Flags definition:
+
+	addr = "localhost"
+	remote-debugging-address = "0.0.0.0"
+	remote-debugging-port = 9222
+	port = 9222
+	user-data-dir = a new temporary directory, deleted on Close (see SetProfile)
+	chrome.workdir = "headless-chrome"
+	chrome.output = "/dev/stdout"
+
+If the 'remote-debugging-pipe' flag is set, the DevTools protocol is served over
+file descriptors 3 and 4 instead of a TCP port. The remote debugging address and
+port defaults are not applied and the browser connection is available from
+Pipe().
+
+If the 'remote-debugging-port' flag is set to 0, Chromium chooses a free port.
+The port is read from the "DevTools listening on ..." line Chromium writes to
+STDERR and the 'port' flag is updated to match. Launch returns as soon as that
+line is written.
+*/
+func (chrome *Chrome) Launch() error {
+	var err error
+
+	// Default values for required parameters
+	usePipe := chrome.Flags().Has("remote-debugging-pipe")
+	chrome.Address()
+	if !usePipe {
+		chrome.DebuggingAddress()
+		chrome.DebuggingPort()
+	}
+	chrome.Port()
+	if _, err = chrome.findBinary(); nil != err {
+		return err
+	}
+
+	if err = os.MkdirAll(chrome.Workdir(), 0700); err != nil {
+		return errs.Wrap(err, codes.ChromeInvalidWorkdir, fmt.Sprintf("cannot create working directory '%s'", chrome.Workdir()))
+	}
+
+	if "" == chrome.STDERR() {
+		chrome.stdERRFile = os.Stderr
+	} else {
+		chrome.stdERRFile, err = os.OpenFile(
+			chrome.STDERR(),
+			os.O_APPEND|os.O_CREATE|os.O_RDWR,
+			0600,
+		)
+		if err != nil {
+			return errs.Wrap(err, codes.ChromeCannotOpenStderr, fmt.Sprintf("cannot open error output file '%s'", chrome.STDERR()))
+		}
+	}
+
+	if "" == chrome.STDOUT() {
+		chrome.stdOUTFile = os.Stdout
+	} else {
+		chrome.stdOUTFile, err = os.OpenFile(
+			chrome.STDOUT(),
+			os.O_APPEND|os.O_CREATE|os.O_RDWR,
+			0600,
+		)
+		if err != nil {
+			return errs.Wrap(err, codes.ChromeCannotOpenStdout, fmt.Sprintf("cannot open standard output file '%s'", chrome.STDOUT()))
+		}
+	}
+
+	if err = chrome.prepareProfile(); nil != err {
+		chrome.closeOutput()
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"flags": chrome.Flags(),
+		"path":  chrome.Binary(),
+	}).Info("Starting process")
+	var procAttributes os.ProcAttr
+	procAttributes.Dir = chrome.Workdir()
+	procAttributes.Sys = processAttributes()
+
+	// Chromium's STDERR is copied to the STDERR output by watchSTDERR so the
+	// DevTools endpoint can be read from it.
+	stderrRead, stderrWrite, err := os.Pipe()
+	if nil != err {
+		chrome.closeOutput()
+		chrome.removeProfile()
+		return errs.Wrap(err, codes.ChromeCannotOpenStderr, "cannot create error output pipe")
+	}
+	procAttributes.Files = []*os.File{nil, chrome.stdOUTFile, stderrWrite}
+	childFiles := []*os.File{stderrWrite}
+
+	// Chromium reads commands from fd 3 and writes responses to fd 4.
+	var pipe *socket.ChromePipe
+	if usePipe {
+		var pipeFiles []*os.File
+		pipe, pipeFiles, err = newChromePipe()
+		if nil != err {
+			stderrRead.Close()
+			stderrWrite.Close()
+			chrome.closeOutput()
+			chrome.removeProfile()
+			return err
+		}
+		procAttributes.Files = append(procAttributes.Files, pipeFiles...)
+		childFiles = append(childFiles, pipeFiles...)
+	}
+
+	chrome.process, err = os.StartProcess(
+		chrome.Binary(),
+		append([]string{chrome.Binary()}, chrome.Flags().List()...),
+		&procAttributes,
+	)
+	for _, file := range childFiles {
+		file.Close()
+	}
+	if nil != err {
+		if nil != pipe {
+			pipe.Close()
+		}
+		stderrRead.Close()
+		chrome.closeOutput()
+		chrome.removeProfile()
+		return errs.Wrap(err, codes.ChromeCannotOpenStdout, "error starting chrome")
+	}
+	var listening <-chan string
+	listening, chrome.stderrDone = chrome.watchSTDERR(stderrRead)
+	chrome.exited = make(chan struct{})
+	go chrome.watchProcess(chrome.process, chrome.stderrDone)
+
+	if usePipe {
+		if err = chrome.connectPipe(pipe); nil != err {
+			return err
+		}
+	} else if err = chrome.waitForDevTools(listening, 10*time.Second); nil != err {
+		log.Error("Chromium took too long to start")
+		chrome.Close()
+		return err
+	}
+
+	if err = chrome.CheckVersion(); nil != err {
+		log.WithFields(log.Fields{"error": err}).Error("unsupported Chromium version")
+		chrome.Close()
+		return err
+	}
+
+	if err = chrome.watchTargets(); nil != err {
+		log.WithFields(log.Fields{"error": err}).Warn("target discovery unavailable, tabs will not be synchronized")
+	}
+
+	return nil
+}
+
+/*
+connectPipe connects to the browser over the remote debugging pipe and waits up
+to 10 seconds for it to respond.
+*/
+func (chrome *Chrome) connectPipe(pipe *socket.ChromePipe) error {
+	chrome.pipe = socket.NewPipeSocket(pipe)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	result := <-chrome.pipe.Browser().WithContext(ctx).GetVersion()
+	if nil != result.Err {
+		log.Error("Chromium took too long to start")
+		chrome.Close()
+		return errs.Wrap(result.Err, codes.ChromeStartTimeout, "chromium took too long to start")
+	}
+
+	chrome.connMux.Lock()
+	defer chrome.connMux.Unlock()
+	chrome.version = &Version{
+		Browser:         result.Product,
+		ProtocolVersion: result.ProtocolVersion,
+		UserAgent:       result.UserAgent,
+		V8Version:       result.JSVersion,
+		WebKitVersion:   result.Revision,
+	}
+	return nil
+}
+
+/*
+newChromePipe creates the pair of pipes used by the remote debugging pipe
+transport. It returns the parent's end of the connection and the files to pass
+to the child process as fds 3 and 4.
+*/
+func newChromePipe() (*socket.ChromePipe, []*os.File, error) {
+	cmdRead, cmdWrite, err := os.Pipe()
+	if nil != err {
+		return nil, nil, errs.Wrap(err, codes.ChromePipeFailed, "cannot create remote debugging pipe")
+	}
+	respRead, respWrite, err := os.Pipe()
+	if nil != err {
+		cmdRead.Close()
+		cmdWrite.Close()
+		return nil, nil, errs.Wrap(err, codes.ChromePipeFailed, "cannot create remote debugging pipe")
+	}
+	return socket.NewPipe(cmdWrite, respRead), []*os.File{cmdRead, respWrite}, nil
+}
+
+/*
+Pipe returns the socket connected to the browser over the remote debugging pipe,
+or nil if Chromium was not launched with the 'remote-debugging-pipe' flag.
+*/
+func (chrome *Chrome) Pipe() *socket.Socket {
+	return chrome.pipe
+}
+
+/*
+Port implements Chromium.
+
+Default value is 9222
+*/
+func (chrome *Chrome) Port() int {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if !chrome.Flags().Has("port") {
+		chrome.Flags().Set("port", 9222)
+	}
+	value, _ := chrome.Flags().Get("port")
+	return value.(int)
+}
+
+/*
+Query implements Chromium.
+*/
+func (chrome *Chrome) Query(
+	path string,
+	params url.Values,
+	msg interface{}, // Data receiver
+) (interface{}, error) {
+	return chrome.queryContext(context.Background(), path, params, msg)
+}
+
+/*
+queryContext is Query with the request bound to ctx.
+*/
+func (chrome *Chrome) queryContext(
+	ctx context.Context,
+	path string,
+	params url.Values,
+	msg interface{}, // Data receiver
+) (interface{}, error) {
+	if len(params) > 0 {
+		path += fmt.Sprintf("?%s", params.Encode())
+	}
+
+	uri := fmt.Sprintf("http://%s:%d%s", chrome.Address(), chrome.Port(), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "invalid uri")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "get uri failed")
+	}
+	defer resp.Body.Close()
+
+	log.WithFields(log.Fields{
+		"path":   path,
+		"status": resp.Status,
+	}).Debug("querying chrome")
+	if 200 != resp.StatusCode {
+		return nil, errs.New(codes.ChromeQueryFailed, resp.Status)
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errs.Wrap(err, codes.ChromeQueryFailed, "read failed")
+	} else if err := json.Unmarshal(content, &msg); err != nil {
+		// it's not JSON so just return it
+		return content, nil
+	}
+
+	return msg, nil
+}
+
+/*
+RemoveTab implements Chromium.
+*/
+func (chrome *Chrome) RemoveTab(tab *Tab) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	for k, t := range chrome.tabs {
+		if t == tab {
+			chrome.tabs = append(chrome.tabs[:k], chrome.tabs[k+1:]...)
+			break
+		}
+	}
+}
+
+/*
+SetFlatten sets whether new tabs share the browser-level connection as
+flattened target sessions instead of each opening its own websocket. Tabs are
+always flattened sessions when Chromium is launched with the
+'remote-debugging-pipe' flag.
+*/
+func (chrome *Chrome) SetFlatten(flatten bool) {
+	chrome.mux.Lock()
+	chrome.flatten = flatten
+	chrome.mux.Unlock()
+}
+
+/*
+sessionTabs returns whether tabs are attached as flattened sessions on the
+browser connection.
+*/
+func (chrome *Chrome) sessionTabs() bool {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	return chrome.flatten || nil != chrome.pipe
+}
+
+/*
+STDERR implements Chromium.
+*/
+func (chrome *Chrome) STDERR() string {
+	return chrome.stderr
+}
+
+/*
+STDOUT implements Chromium.
+*/
+func (chrome *Chrome) STDOUT() string {
+	return chrome.stdout
+}
+
+/*
+Tabs implements Chromium.
+*/
+func (chrome *Chrome) Tabs() []*Tab {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if 0 == len(chrome.tabs) {
+		return nil
+	}
+	return append([]*Tab{}, chrome.tabs...)
+}
+
+/*
+Version implements Chromium.
+*/
+func (chrome *Chrome) Version() (*Version, error) {
+	chrome.connMux.Lock()
+	defer chrome.connMux.Unlock()
+	return chrome.queryVersion()
+}
+
+/*
+queryVersion returns the Chromium version data, querying the /json/version
+endpoint if necessary. The caller must hold connMux.
+*/
+func (chrome *Chrome) queryVersion() (*Version, error) {
+	if nil == chrome.version {
+		if _, err := chrome.Query(
+			"/json/version",
+			url.Values{},
+			&chrome.version,
+		); err != nil {
+			return nil, errs.Wrap(err, codes.ChromeVersionQueryFailed, "version query failed")
+		}
+	}
+	return chrome.version, nil
+}
+
+/*
+Workdir implements Chromium.
+
+Default value is /tmp/headless-chrome
+*/
+func (chrome *Chrome) Workdir() string {
+	if "" == chrome.workdir {
+		chrome.workdir = filepath.Join(os.TempDir(), "headless-chrome")
+	}
+	return chrome.workdir
+}
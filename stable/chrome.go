@@ -0,0 +1,51 @@
+/*
+Package chrome aims to be a complete Chrome DevTools Protocol Viewer
+implementation.
+
+This version implements the stable 1.3 API. See
+https://chromedevtools.github.io/devtools-protocol/1-3/ for details.
+
+The domain packages and the socket protocol methods are generated from the
+stable subset of the protocol by cmd/cdtpgen, so experimental and deprecated
+domains, methods and fields are not available. Browser contexts are not
+supported because opening a tab in a browser context requires experimental
+protocol fields.
+
+The rest of the chrome and socket packages is copied from the tot package tree
+by cmd/cdtpgen -runtime. The files of this package that are not marked as
+generated differ between protocol versions and are maintained here.
+*/
+package chrome
+
+import (
+	"os"
+
+	"github.com/bdlm/log"
+)
+
+/*
+If a LOG_LEVEL environment variable exists set that value as the log level.
+Useful during development.
+*/
+func init() {
+	levelFlag := os.Getenv("LOG_LEVEL")
+	if "" == levelFlag {
+		levelFlag = "info"
+	}
+	level, err := log.ParseLevel(levelFlag)
+	if nil == err {
+		log.SetLevel(level)
+	}
+}
+
+/*
+Version is a struct representing the Chromium version information.
+*/
+type Version struct {
+	Browser              string `json:"browser"`
+	ProtocolVersion      string `json:"protocol-version"`
+	UserAgent            string `json:"user-agent"`
+	V8Version            string `json:"v8-version"`
+	WebKitVersion        string `json:"webkit-version"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+}
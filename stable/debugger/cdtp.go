@@ -0,0 +1,229 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package debugger provides type definitions for use with the Chrome Debugger protocol
+
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/
+*/
+package debugger
+
+import (
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+BreakpointID represents the Debugger.BreakpointId type.
+
+Breakpoint identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-BreakpointId
+*/
+type BreakpointID string
+
+/*
+CallFrameID represents the Debugger.CallFrameId type.
+
+Call frame identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-CallFrameId
+*/
+type CallFrameID string
+
+/*
+Location represents the Debugger.Location type.
+
+Location in the source code.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-Location
+*/
+type Location struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Optional. Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber,omitempty"`
+}
+
+/*
+ScriptPosition represents the Debugger.ScriptPosition type.
+
+Location in the source code. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-ScriptPosition
+*/
+type ScriptPosition struct {
+	LineNumber int `json:"lineNumber"`
+
+	ColumnNumber int `json:"columnNumber"`
+}
+
+/*
+LocationRange represents the Debugger.LocationRange type.
+
+Location range within one script. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-LocationRange
+*/
+type LocationRange struct {
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	Start *ScriptPosition `json:"start"`
+
+	End *ScriptPosition `json:"end"`
+}
+
+/*
+CallFrame represents the Debugger.CallFrame type.
+
+JavaScript call frame. Array of call frames form the call stack.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-CallFrame
+*/
+type CallFrame struct {
+	// Call frame identifier. This identifier is only valid while the virtual
+	// machine is paused.
+	CallFrameID CallFrameID `json:"callFrameId"`
+
+	// Name of the JavaScript function called on this call frame.
+	FunctionName string `json:"functionName"`
+
+	// Optional. Location in the source code.
+	FunctionLocation *Location `json:"functionLocation,omitempty"`
+
+	// Location in the source code.
+	Location *Location `json:"location"`
+
+	// Scope chain for this call frame.
+	ScopeChain []*Scope `json:"scopeChain"`
+
+	// `this` object for this call frame.
+	This *runtime.RemoteObject `json:"this"`
+
+	// Optional. The value being returned, if the function is at return point.
+	ReturnValue *runtime.RemoteObject `json:"returnValue,omitempty"`
+}
+
+/*
+Scope represents the Debugger.Scope type.
+
+Scope description.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-Scope
+*/
+type Scope struct {
+	// Scope type. Allowed values:
+	//   - ScopeType.Global
+	//   - ScopeType.Local
+	//   - ScopeType.With
+	//   - ScopeType.Closure
+	//   - ScopeType.Catch
+	//   - ScopeType.Block
+	//   - ScopeType.Script
+	//   - ScopeType.Eval
+	//   - ScopeType.Module
+	//   - ScopeType.WasmExpressionStack
+	Type ScopeTypeEnum `json:"type"`
+
+	// Object representing the scope. For `global` and `with` scopes it represents
+	// the actual object; for the rest of the scopes, it is artificial transient
+	// object enumerating scope variables as its properties.
+	Object *runtime.RemoteObject `json:"object"`
+
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional. Location in the source code where scope starts
+	StartLocation *Location `json:"startLocation,omitempty"`
+
+	// Optional. Location in the source code where scope ends
+	EndLocation *Location `json:"endLocation,omitempty"`
+}
+
+/*
+SearchMatch represents the Debugger.SearchMatch type.
+
+Search match for resource.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-SearchMatch
+*/
+type SearchMatch struct {
+	// Line number in resource content.
+	LineNumber float64 `json:"lineNumber"`
+
+	// Line with match content.
+	LineContent string `json:"lineContent"`
+}
+
+/*
+BreakLocation represents the Debugger.BreakLocation type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-BreakLocation
+*/
+type BreakLocation struct {
+	// Script identifier as reported in the `Debugger.scriptParsed`.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Optional. Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber,omitempty"`
+
+	// Optional. Allowed values:
+	//   - BreakLocationType.DebuggerStatement
+	//   - BreakLocationType.Call
+	//   - BreakLocationType.Return
+	Type BreakLocationTypeEnum `json:"type,omitempty"`
+}
+
+/*
+WasmDisassemblyChunk represents the Debugger.WasmDisassemblyChunk type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-WasmDisassemblyChunk
+*/
+type WasmDisassemblyChunk struct {
+	// The next chunk of disassembled lines.
+	Lines []string `json:"lines"`
+
+	// The bytecode offsets describing the start of each line.
+	BytecodeOffsets []int `json:"bytecodeOffsets"`
+}
+
+/*
+DebugSymbols represents the Debugger.DebugSymbols type.
+
+Debug symbols available for a wasm script.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-DebugSymbols
+*/
+type DebugSymbols struct {
+	// Type of the debug symbols. Allowed values:
+	//   - Type.SourceMap
+	//   - Type.EmbeddedDWARF
+	//   - Type.ExternalDWARF
+	Type TypeEnum `json:"type"`
+
+	// Optional. URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+/*
+ResolvedBreakpoint represents the Debugger.ResolvedBreakpoint type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-ResolvedBreakpoint
+*/
+type ResolvedBreakpoint struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Actual breakpoint location.
+	Location *Location `json:"location"`
+}
@@ -0,0 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type breakLocationTypeEnum struct {
+	DebuggerStatement BreakLocationTypeEnum
+	Call              BreakLocationTypeEnum
+	Return            BreakLocationTypeEnum
+}
+
+/*
+BreakLocationType provides named access to the BreakLocationTypeEnum values.
+*/
+var BreakLocationType = breakLocationTypeEnum{
+	DebuggerStatement: BreakLocationTypeDebuggerStatement,
+	Call:              BreakLocationTypeCall,
+	Return:            BreakLocationTypeReturn,
+}
+
+/*
+BreakLocationTypeEnum represents the Debugger.BreakLocation.type values.
+
+Allowed values:
+  - BreakLocationType.DebuggerStatement "debuggerStatement"
+  - BreakLocationType.Call              "call"
+  - BreakLocationType.Return            "return"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-BreakLocation
+*/
+type BreakLocationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum BreakLocationTypeEnum) String() string {
+	return _breakLocationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BreakLocationTypeEnum) Unknown() bool {
+	return !_breakLocationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum BreakLocationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *BreakLocationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _breakLocationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// BreakLocationTypeDebuggerStatement represents the "debuggerStatement" value.
+	BreakLocationTypeDebuggerStatement BreakLocationTypeEnum = iota + 1
+	// BreakLocationTypeCall represents the "call" value.
+	BreakLocationTypeCall
+	// BreakLocationTypeReturn represents the "return" value.
+	BreakLocationTypeReturn
+)
+
+var _breakLocationTypeEnums = enums.New(map[BreakLocationTypeEnum]string{
+	BreakLocationTypeEnum(0):           "",
+	BreakLocationTypeDebuggerStatement: "debuggerStatement",
+	BreakLocationTypeCall:              "call",
+	BreakLocationTypeReturn:            "return",
+})
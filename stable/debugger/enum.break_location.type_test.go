@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
@@ -15,9 +17,20 @@ func TestEnumBreakLocationType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown BreakLocationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumBreakLocationType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"debuggerStatement"` != string(result) {
-		t.Errorf("Expected '\"debuggerStatement\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"debuggerStatement"`, result)
 	}
 	json.Unmarshal([]byte(`"debuggerStatement"`), &enum)
 	if BreakLocationType.DebuggerStatement != enum {
-		t.Errorf("Expcected %d, got %d", BreakLocationType.DebuggerStatement, enum)
+		t.Errorf("Expected %d, got %d", BreakLocationType.DebuggerStatement, enum)
 	}
 
 	enum = BreakLocationType.Call
@@ -47,11 +60,11 @@ func TestEnumBreakLocationType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"call"` != string(result) {
-		t.Errorf("Expected '\"call\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"call"`, result)
 	}
 	json.Unmarshal([]byte(`"call"`), &enum)
 	if BreakLocationType.Call != enum {
-		t.Errorf("Expcected %d, got %d", BreakLocationType.Call, enum)
+		t.Errorf("Expected %d, got %d", BreakLocationType.Call, enum)
 	}
 
 	enum = BreakLocationType.Return
@@ -60,10 +73,10 @@ func TestEnumBreakLocationType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"return"` != string(result) {
-		t.Errorf("Expected '\"return\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"return"`, result)
 	}
 	json.Unmarshal([]byte(`"return"`), &enum)
 	if BreakLocationType.Return != enum {
-		t.Errorf("Expcected %d, got %d", BreakLocationType.Return, enum)
+		t.Errorf("Expected %d, got %d", BreakLocationType.Return, enum)
 	}
 }
@@ -0,0 +1,132 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type scopeTypeEnum struct {
+	Global              ScopeTypeEnum
+	Local               ScopeTypeEnum
+	With                ScopeTypeEnum
+	Closure             ScopeTypeEnum
+	Catch               ScopeTypeEnum
+	Block               ScopeTypeEnum
+	Script              ScopeTypeEnum
+	Eval                ScopeTypeEnum
+	Module              ScopeTypeEnum
+	WasmExpressionStack ScopeTypeEnum
+}
+
+/*
+ScopeType provides named access to the ScopeTypeEnum values.
+*/
+var ScopeType = scopeTypeEnum{
+	Global:              ScopeTypeGlobal,
+	Local:               ScopeTypeLocal,
+	With:                ScopeTypeWith,
+	Closure:             ScopeTypeClosure,
+	Catch:               ScopeTypeCatch,
+	Block:               ScopeTypeBlock,
+	Script:              ScopeTypeScript,
+	Eval:                ScopeTypeEval,
+	Module:              ScopeTypeModule,
+	WasmExpressionStack: ScopeTypeWasmExpressionStack,
+}
+
+/*
+ScopeTypeEnum represents the Debugger.Scope.type values.
+
+Scope type.
+
+Allowed values:
+  - ScopeType.Global              "global"
+  - ScopeType.Local               "local"
+  - ScopeType.With                "with"
+  - ScopeType.Closure             "closure"
+  - ScopeType.Catch               "catch"
+  - ScopeType.Block               "block"
+  - ScopeType.Script              "script"
+  - ScopeType.Eval                "eval"
+  - ScopeType.Module              "module"
+  - ScopeType.WasmExpressionStack "wasm-expression-stack"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-Scope
+*/
+type ScopeTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ScopeTypeEnum) String() string {
+	return _scopeTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ScopeTypeEnum) Unknown() bool {
+	return !_scopeTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ScopeTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ScopeTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _scopeTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// ScopeTypeGlobal represents the "global" value.
+	ScopeTypeGlobal ScopeTypeEnum = iota + 1
+	// ScopeTypeLocal represents the "local" value.
+	ScopeTypeLocal
+	// ScopeTypeWith represents the "with" value.
+	ScopeTypeWith
+	// ScopeTypeClosure represents the "closure" value.
+	ScopeTypeClosure
+	// ScopeTypeCatch represents the "catch" value.
+	ScopeTypeCatch
+	// ScopeTypeBlock represents the "block" value.
+	ScopeTypeBlock
+	// ScopeTypeScript represents the "script" value.
+	ScopeTypeScript
+	// ScopeTypeEval represents the "eval" value.
+	ScopeTypeEval
+	// ScopeTypeModule represents the "module" value.
+	ScopeTypeModule
+	// ScopeTypeWasmExpressionStack represents the "wasm-expression-stack" value.
+	ScopeTypeWasmExpressionStack
+)
+
+var _scopeTypeEnums = enums.New(map[ScopeTypeEnum]string{
+	ScopeTypeEnum(0):             "",
+	ScopeTypeGlobal:              "global",
+	ScopeTypeLocal:               "local",
+	ScopeTypeWith:                "with",
+	ScopeTypeClosure:             "closure",
+	ScopeTypeCatch:               "catch",
+	ScopeTypeBlock:               "block",
+	ScopeTypeScript:              "script",
+	ScopeTypeEval:                "eval",
+	ScopeTypeModule:              "module",
+	ScopeTypeWasmExpressionStack: "wasm-expression-stack",
+})
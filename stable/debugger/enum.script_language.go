@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type scriptLanguageEnum struct {
+	JavaScript  ScriptLanguageEnum
+	WebAssembly ScriptLanguageEnum
+}
+
+/*
+ScriptLanguage provides named access to the ScriptLanguageEnum values.
+*/
+var ScriptLanguage = scriptLanguageEnum{
+	JavaScript:  scriptLanguageJavaScript,
+	WebAssembly: scriptLanguageWebAssembly,
+}
+
+/*
+ScriptLanguageEnum represents the Debugger.ScriptLanguage values.
+
+Enum of possible script languages.
+
+Allowed values:
+  - ScriptLanguage.JavaScript  "JavaScript"
+  - ScriptLanguage.WebAssembly "WebAssembly"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#type-ScriptLanguage
+*/
+type ScriptLanguageEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ScriptLanguageEnum) String() string {
+	return _scriptLanguageEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ScriptLanguageEnum) Unknown() bool {
+	return !_scriptLanguageEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ScriptLanguageEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ScriptLanguageEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _scriptLanguageEnums.Parse(val)
+	return nil
+}
+
+const (
+	// scriptLanguageJavaScript represents the "JavaScript" value.
+	scriptLanguageJavaScript ScriptLanguageEnum = iota + 1
+	// scriptLanguageWebAssembly represents the "WebAssembly" value.
+	scriptLanguageWebAssembly
+)
+
+var _scriptLanguageEnums = enums.New(map[ScriptLanguageEnum]string{
+	ScriptLanguageEnum(0):     "",
+	scriptLanguageJavaScript:  "JavaScript",
+	scriptLanguageWebAssembly: "WebAssembly",
+})
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type instrumentationEnum struct {
+	BeforeScriptExecution              InstrumentationEnum
+	BeforeScriptWithSourceMapExecution InstrumentationEnum
+}
+
+/*
+Instrumentation provides named access to the InstrumentationEnum values.
+*/
+var Instrumentation = instrumentationEnum{
+	BeforeScriptExecution:              instrumentationBeforeScriptExecution,
+	BeforeScriptWithSourceMapExecution: instrumentationBeforeScriptWithSourceMapExecution,
+}
+
+/*
+InstrumentationEnum represents the
+Debugger.setInstrumentationBreakpoint.instrumentation values.
+
+Instrumentation name.
+
+Allowed values:
+  - Instrumentation.BeforeScriptExecution              "beforeScriptExecution"
+  - Instrumentation.BeforeScriptWithSourceMapExecution "beforeScriptWithSourceMapExecution"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setInstrumentationBreakpoint
+*/
+type InstrumentationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InstrumentationEnum) String() string {
+	return _instrumentationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InstrumentationEnum) Unknown() bool {
+	return !_instrumentationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InstrumentationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InstrumentationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _instrumentationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// instrumentationBeforeScriptExecution represents the "beforeScriptExecution" value.
+	instrumentationBeforeScriptExecution InstrumentationEnum = iota + 1
+	// instrumentationBeforeScriptWithSourceMapExecution represents the "beforeScriptWithSourceMapExecution" value.
+	instrumentationBeforeScriptWithSourceMapExecution
+)
+
+var _instrumentationEnums = enums.New(map[InstrumentationEnum]string{
+	InstrumentationEnum(0):                            "",
+	instrumentationBeforeScriptExecution:              "beforeScriptExecution",
+	instrumentationBeforeScriptWithSourceMapExecution: "beforeScriptWithSourceMapExecution",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInstrumentation(t *testing.T) {
+	var enum InstrumentationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InstrumentationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Instrumentation.BeforeScriptExecution
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"beforeScriptExecution"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"beforeScriptExecution"`, result)
+	}
+	json.Unmarshal([]byte(`"beforeScriptExecution"`), &enum)
+	if Instrumentation.BeforeScriptExecution != enum {
+		t.Errorf("Expected %d, got %d", Instrumentation.BeforeScriptExecution, enum)
+	}
+
+	enum = Instrumentation.BeforeScriptWithSourceMapExecution
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"beforeScriptWithSourceMapExecution"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"beforeScriptWithSourceMapExecution"`, result)
+	}
+	json.Unmarshal([]byte(`"beforeScriptWithSourceMapExecution"`), &enum)
+	if Instrumentation.BeforeScriptWithSourceMapExecution != enum {
+		t.Errorf("Expected %d, got %d", Instrumentation.BeforeScriptWithSourceMapExecution, enum)
+	}
+}
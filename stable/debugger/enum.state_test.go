@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumState(t *testing.T) {
+	var enum StateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = State.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if State.None != enum {
+		t.Errorf("Expected %d, got %d", State.None, enum)
+	}
+
+	enum = State.Caught
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"caught"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"caught"`, result)
+	}
+	json.Unmarshal([]byte(`"caught"`), &enum)
+	if State.Caught != enum {
+		t.Errorf("Expected %d, got %d", State.Caught, enum)
+	}
+
+	enum = State.Uncaught
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"uncaught"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"uncaught"`, result)
+	}
+	json.Unmarshal([]byte(`"uncaught"`), &enum)
+	if State.Uncaught != enum {
+		t.Errorf("Expected %d, got %d", State.Uncaught, enum)
+	}
+
+	enum = State.All
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"all"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"all"`, result)
+	}
+	json.Unmarshal([]byte(`"all"`), &enum)
+	if State.All != enum {
+		t.Errorf("Expected %d, got %d", State.All, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type targetCallFramesEnum struct {
+	Any     TargetCallFramesEnum
+	Current TargetCallFramesEnum
+}
+
+/*
+TargetCallFrames provides named access to the TargetCallFramesEnum values.
+*/
+var TargetCallFrames = targetCallFramesEnum{
+	Any:     targetCallFramesAny,
+	Current: targetCallFramesCurrent,
+}
+
+/*
+TargetCallFramesEnum represents the Debugger.continueToLocation.targetCallFrames
+values.
+
+Allowed values:
+  - TargetCallFrames.Any     "any"
+  - TargetCallFrames.Current "current"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-continueToLocation
+*/
+type TargetCallFramesEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TargetCallFramesEnum) String() string {
+	return _targetCallFramesEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TargetCallFramesEnum) Unknown() bool {
+	return !_targetCallFramesEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TargetCallFramesEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TargetCallFramesEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _targetCallFramesEnums.Parse(val)
+	return nil
+}
+
+const (
+	// targetCallFramesAny represents the "any" value.
+	targetCallFramesAny TargetCallFramesEnum = iota + 1
+	// targetCallFramesCurrent represents the "current" value.
+	targetCallFramesCurrent
+)
+
+var _targetCallFramesEnums = enums.New(map[TargetCallFramesEnum]string{
+	TargetCallFramesEnum(0): "",
+	targetCallFramesAny:     "any",
+	targetCallFramesCurrent: "current",
+})
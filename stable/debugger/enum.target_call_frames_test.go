@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumTargetCallFrames(t *testing.T) {
+	var enum TargetCallFramesEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TargetCallFramesEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = TargetCallFrames.Any
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"any"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"any"`, result)
+	}
+	json.Unmarshal([]byte(`"any"`), &enum)
+	if TargetCallFrames.Any != enum {
+		t.Errorf("Expected %d, got %d", TargetCallFrames.Any, enum)
+	}
+
+	enum = TargetCallFrames.Current
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"current"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"current"`, result)
+	}
+	json.Unmarshal([]byte(`"current"`), &enum)
+	if TargetCallFrames.Current != enum {
+		t.Errorf("Expected %d, got %d", TargetCallFrames.Current, enum)
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package dom provides type definitions for use with the Chrome DOM protocol
+
+This domain exposes DOM read/write operations. Each DOM Node is represented with
+its mirror object that has an `id`. This `id` can be used to get additional
+information on the Node, resolve it into the JavaScript object wrapper, etc. It
+is important that client receives DOM events only for the nodes that are known
+to the client. Backend keeps track of the nodes that were sent to the client and
+never sends the same node twice. It is client's responsibility to collect
+information about the nodes that were sent to the client. Note that `iframe`
+owner elements will return corresponding document elements as their child nodes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/
+*/
+package dom
+
+import (
+	"github.com/mkenney/go-chrome/stable/page"
+)
+
+/*
+NodeID represents the DOM.NodeId type.
+
+Unique DOM node identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-NodeId
+*/
+type NodeID int
+
+/*
+BackendNodeID represents the DOM.BackendNodeId type.
+
+Unique DOM node identifier used to reference a node that may not have been
+pushed to the front-end.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-BackendNodeId
+*/
+type BackendNodeID int
+
+/*
+BackendNode represents the DOM.BackendNode type.
+
+Backend node with a friendly name.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-BackendNode
+*/
+type BackendNode struct {
+	// `Node`'s nodeType.
+	NodeType int `json:"nodeType"`
+
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+}
+
+/*
+PseudoType represents the DOM.PseudoType type.
+
+Pseudo element type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-PseudoType
+*/
+type PseudoType string
+
+/*
+ShadowRootType represents the DOM.ShadowRootType type.
+
+Shadow root type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-ShadowRootType
+*/
+type ShadowRootType string
+
+/*
+Node represents the DOM.Node type.
+
+DOM interaction is implemented in terms of mirror objects that represent the
+actual DOM nodes. DOMNode is a base node mirror type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-Node
+*/
+type Node struct {
+	// Node identifier that is passed into the rest of the DOM messages as the
+	// `nodeId`. Backend will only push node with given `id` once. It is aware of
+	// all requested nodes and will only fire DOM events for nodes known to the
+	// client.
+	NodeID NodeID `json:"nodeId"`
+
+	// Optional. The id of the parent node if any.
+	ParentID NodeID `json:"parentId,omitempty"`
+
+	// The BackendNodeId for this node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+
+	// `Node`'s nodeType.
+	NodeType int `json:"nodeType"`
+
+	// `Node`'s nodeName.
+	NodeName string `json:"nodeName"`
+
+	// `Node`'s localName.
+	LocalName string `json:"localName"`
+
+	// `Node`'s nodeValue.
+	NodeValue string `json:"nodeValue"`
+
+	// Optional. Child count for `Container` nodes.
+	ChildNodeCount int `json:"childNodeCount,omitempty"`
+
+	// Optional. Child nodes of this node when requested with children.
+	Children []*Node `json:"children,omitempty"`
+
+	// Optional. Attributes of the `Element` node in the form of flat array
+	// `[name1, value1, name2, value2]`.
+	Attributes []string `json:"attributes,omitempty"`
+
+	// Optional. Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL string `json:"documentURL,omitempty"`
+
+	// Optional. Base URL that `Document` or `FrameOwner` node uses for URL
+	// completion.
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// Optional. `DocumentType`'s publicId.
+	PublicID string `json:"publicId,omitempty"`
+
+	// Optional. `DocumentType`'s systemId.
+	SystemID string `json:"systemId,omitempty"`
+
+	// Optional. `DocumentType`'s internalSubset.
+	InternalSubset string `json:"internalSubset,omitempty"`
+
+	// Optional. `Document`'s XML version in case of XML documents.
+	XMLVersion string `json:"xmlVersion,omitempty"`
+
+	// Optional. `Attr`'s name.
+	Name string `json:"name,omitempty"`
+
+	// Optional. `Attr`'s value.
+	Value string `json:"value,omitempty"`
+
+	// Optional. Pseudo element type for this node.
+	PseudoType PseudoType `json:"pseudoType,omitempty"`
+
+	// Optional. Pseudo element identifier for this node. Only present if there is
+	// a valid pseudoType.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+
+	// Optional. Shadow root type.
+	ShadowRootType ShadowRootType `json:"shadowRootType,omitempty"`
+
+	// Optional. Frame ID for frame owner elements.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+
+	// Optional. Content document for frame owner elements.
+	ContentDocument *Node `json:"contentDocument,omitempty"`
+
+	// Optional. Shadow root list for given element host.
+	ShadowRoots []*Node `json:"shadowRoots,omitempty"`
+
+	// Optional. Content document fragment for template elements.
+	TemplateContent *Node `json:"templateContent,omitempty"`
+
+	// Optional. Pseudo elements associated with this node.
+	PseudoElements []*Node `json:"pseudoElements,omitempty"`
+
+	// Optional. Distributed nodes for given insertion point.
+	DistributedNodes []*BackendNode `json:"distributedNodes,omitempty"`
+
+	// Optional. Whether the node is SVG.
+	IsSVG bool `json:"isSVG,omitempty"`
+
+	// Optional. Allowed values:
+	//   - CompatibilityMode.QuirksMode
+	//   - CompatibilityMode.LimitedQuirksMode
+	//   - CompatibilityMode.NoQuirksMode
+	CompatibilityMode CompatibilityModeEnum `json:"compatibilityMode,omitempty"`
+
+	// Optional.
+	AssignedSlot *BackendNode `json:"assignedSlot,omitempty"`
+}
+
+/*
+DetachedElementInfo represents the DOM.DetachedElementInfo type.
+
+A structure to hold the top-level node of a detached tree and an array of its
+retained descendants.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-DetachedElementInfo
+*/
+type DetachedElementInfo struct {
+	TreeNode *Node `json:"treeNode"`
+
+	RetainedNodeIDs []NodeID `json:"retainedNodeIds"`
+}
+
+/*
+RGBA represents the DOM.RGBA type.
+
+A structure holding an RGBA color.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-RGBA
+*/
+type RGBA struct {
+	// The red component, in the [0-255] range.
+	R int `json:"r"`
+
+	// The green component, in the [0-255] range.
+	G int `json:"g"`
+
+	// The blue component, in the [0-255] range.
+	B int `json:"b"`
+
+	// Optional. The alpha component, in the [0-1] range (default: 1).
+	A float64 `json:"a,omitempty"`
+}
+
+/*
+Quad represents the DOM.Quad type.
+
+An array of quad vertices, x immediately followed by y for each point, points
+clock-wise.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-Quad
+*/
+type Quad [2]float64
+
+/*
+BoxModel represents the DOM.BoxModel type.
+
+Box model.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-BoxModel
+*/
+type BoxModel struct {
+	// Content box
+	Content Quad `json:"content"`
+
+	// Padding box
+	Padding Quad `json:"padding"`
+
+	// Border box
+	Border Quad `json:"border"`
+
+	// Margin box
+	Margin Quad `json:"margin"`
+
+	// Node width
+	Width int `json:"width"`
+
+	// Node height
+	Height int `json:"height"`
+
+	// Optional. Shape outside coordinates
+	ShapeOutside *ShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+/*
+ShapeOutsideInfo represents the DOM.ShapeOutsideInfo type.
+
+CSS Shape Outside details.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-ShapeOutsideInfo
+*/
+type ShapeOutsideInfo struct {
+	// Shape bounds
+	Bounds Quad `json:"bounds"`
+
+	// Shape coordinate details
+	Shape []interface{} `json:"shape"`
+
+	// Margin shape bounds
+	MarginShape []interface{} `json:"marginShape"`
+}
+
+/*
+Rect represents the DOM.Rect type.
+
+Rectangle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-Rect
+*/
+type Rect struct {
+	// X coordinate
+	X float64 `json:"x"`
+
+	// Y coordinate
+	Y float64 `json:"y"`
+
+	// Rectangle width
+	Width float64 `json:"width"`
+
+	// Rectangle height
+	Height float64 `json:"height"`
+}
+
+/*
+CSSComputedStyleProperty represents the DOM.CSSComputedStyleProperty type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-CSSComputedStyleProperty
+*/
+type CSSComputedStyleProperty struct {
+	// Computed style property name.
+	Name string `json:"name"`
+
+	// Computed style property value.
+	Value string `json:"value"`
+}
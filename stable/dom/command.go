@@ -0,0 +1,664 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"github.com/mkenney/go-chrome/stable/page"
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+DescribeNodeParams represents DOM.describeNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-describeNode
+*/
+type DescribeNodeParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
+
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+/*
+DescribeNodeResult represents the result of calls to DOM.describeNode.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-describeNode
+*/
+type DescribeNodeResult struct {
+	// Node description.
+	Node *Node `json:"node"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ScrollIntoViewIfNeededParams represents DOM.scrollIntoViewIfNeeded parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-scrollIntoViewIfNeeded
+*/
+type ScrollIntoViewIfNeededParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. The rect to be scrolled into view, relative to the node's border
+	// box, in CSS pixels. When omitted, center of the node will be used, similar
+	// to Element.scrollIntoView.
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+/*
+ScrollIntoViewIfNeededResult represents the result of calls to DOM.scrollIntoViewIfNeeded.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-scrollIntoViewIfNeeded
+*/
+type ScrollIntoViewIfNeededResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to DOM.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to DOM.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+FocusParams represents DOM.focus parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-focus
+*/
+type FocusParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+FocusResult represents the result of calls to DOM.focus.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-focus
+*/
+type FocusResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAttributesParams represents DOM.getAttributes parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getAttributes
+*/
+type GetAttributesParams struct {
+	// Id of the node to retrieve attributes for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+/*
+GetAttributesResult represents the result of calls to DOM.getAttributes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getAttributes
+*/
+type GetAttributesResult struct {
+	// An interleaved array of node attribute names and values.
+	Attributes []string `json:"attributes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetBoxModelParams represents DOM.getBoxModel parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getBoxModel
+*/
+type GetBoxModelParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+GetBoxModelResult represents the result of calls to DOM.getBoxModel.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getBoxModel
+*/
+type GetBoxModelResult struct {
+	// Box model for the node.
+	Model *BoxModel `json:"model"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetDocumentParams represents DOM.getDocument parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getDocument
+*/
+type GetDocumentParams struct {
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
+
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+/*
+GetDocumentResult represents the result of calls to DOM.getDocument.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getDocument
+*/
+type GetDocumentResult struct {
+	// Resulting node.
+	Root *Node `json:"root"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetNodeForLocationParams represents DOM.getNodeForLocation parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getNodeForLocation
+*/
+type GetNodeForLocationParams struct {
+	// X coordinate.
+	X int `json:"x"`
+
+	// Y coordinate.
+	Y int `json:"y"`
+
+	// Optional. False to skip to the nearest non-UA shadow root ancestor (default:
+	// false).
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+
+	// Optional. Whether to ignore pointer-events: none on elements and hit test
+	// them.
+	IgnorePointerEventsNone *bool `json:"ignorePointerEventsNone,omitempty"`
+}
+
+/*
+GetNodeForLocationResult represents the result of calls to DOM.getNodeForLocation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getNodeForLocation
+*/
+type GetNodeForLocationResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+
+	// Frame this node belongs to.
+	FrameID page.FrameID `json:"frameId"`
+
+	// Optional. Id of the node at given coordinates, only when enabled and
+	// requested document.
+	NodeID NodeID `json:"nodeId,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetOuterHTMLParams represents DOM.getOuterHTML parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getOuterHTML
+*/
+type GetOuterHTMLParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+GetOuterHTMLResult represents the result of calls to DOM.getOuterHTML.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getOuterHTML
+*/
+type GetOuterHTMLResult struct {
+	// Outer HTML markup.
+	OuterHTML string `json:"outerHTML"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HideHighlightResult represents the result of calls to DOM.hideHighlight.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-hideHighlight
+*/
+type HideHighlightResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HighlightNodeResult represents the result of calls to DOM.highlightNode.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightNode
+*/
+type HighlightNodeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HighlightRectResult represents the result of calls to DOM.highlightRect.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightRect
+*/
+type HighlightRectResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+MoveToParams represents DOM.moveTo parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-moveTo
+*/
+type MoveToParams struct {
+	// Id of the node to move.
+	NodeID NodeID `json:"nodeId"`
+
+	// Id of the element to drop the moved node into.
+	TargetNodeID NodeID `json:"targetNodeId"`
+
+	// Optional. Drop node before this one (if absent, the moved node becomes the
+	// last child of `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
+}
+
+/*
+MoveToResult represents the result of calls to DOM.moveTo.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-moveTo
+*/
+type MoveToResult struct {
+	// New id of the moved node.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+QuerySelectorParams represents DOM.querySelector parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelector
+*/
+type QuerySelectorParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+/*
+QuerySelectorResult represents the result of calls to DOM.querySelector.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelector
+*/
+type QuerySelectorResult struct {
+	// Query selector result.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+QuerySelectorAllParams represents DOM.querySelectorAll parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelectorAll
+*/
+type QuerySelectorAllParams struct {
+	// Id of the node to query upon.
+	NodeID NodeID `json:"nodeId"`
+
+	// Selector string.
+	Selector string `json:"selector"`
+}
+
+/*
+QuerySelectorAllResult represents the result of calls to DOM.querySelectorAll.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelectorAll
+*/
+type QuerySelectorAllResult struct {
+	// Query selector result.
+	NodeIDs []NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveAttributeParams represents DOM.removeAttribute parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeAttribute
+*/
+type RemoveAttributeParams struct {
+	// Id of the element to remove attribute from.
+	NodeID NodeID `json:"nodeId"`
+
+	// Name of the attribute to remove.
+	Name string `json:"name"`
+}
+
+/*
+RemoveAttributeResult represents the result of calls to DOM.removeAttribute.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeAttribute
+*/
+type RemoveAttributeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveNodeParams represents DOM.removeNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeNode
+*/
+type RemoveNodeParams struct {
+	// Id of the node to remove.
+	NodeID NodeID `json:"nodeId"`
+}
+
+/*
+RemoveNodeResult represents the result of calls to DOM.removeNode.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeNode
+*/
+type RemoveNodeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RequestChildNodesParams represents DOM.requestChildNodes parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestChildNodes
+*/
+type RequestChildNodesParams struct {
+	// Id of the node to get children for.
+	NodeID NodeID `json:"nodeId"`
+
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
+
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the sub-tree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+/*
+RequestChildNodesResult represents the result of calls to DOM.requestChildNodes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestChildNodes
+*/
+type RequestChildNodesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RequestNodeParams represents DOM.requestNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestNode
+*/
+type RequestNodeParams struct {
+	// JavaScript object id to convert into node.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+/*
+RequestNodeResult represents the result of calls to DOM.requestNode.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestNode
+*/
+type RequestNodeResult struct {
+	// Node id for given object.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResolveNodeParams represents DOM.resolveNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-resolveNode
+*/
+type ResolveNodeParams struct {
+	// Optional. Id of the node to resolve.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Backend identifier of the node to resolve.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. Symbolic group name that can be used to release multiple objects.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// Optional. Execution context in which to resolve the node.
+	ExecutionContextID *runtime.ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+/*
+ResolveNodeResult represents the result of calls to DOM.resolveNode.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-resolveNode
+*/
+type ResolveNodeResult struct {
+	// JavaScript object wrapper for given node.
+	Object *runtime.RemoteObject `json:"object"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAttributeValueParams represents DOM.setAttributeValue parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributeValue
+*/
+type SetAttributeValueParams struct {
+	// Id of the element to set attribute for.
+	NodeID NodeID `json:"nodeId"`
+
+	// Attribute name.
+	Name string `json:"name"`
+
+	// Attribute value.
+	Value string `json:"value"`
+}
+
+/*
+SetAttributeValueResult represents the result of calls to DOM.setAttributeValue.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributeValue
+*/
+type SetAttributeValueResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAttributesAsTextParams represents DOM.setAttributesAsText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributesAsText
+*/
+type SetAttributesAsTextParams struct {
+	// Id of the element to set attributes for.
+	NodeID NodeID `json:"nodeId"`
+
+	// Text with a number of attributes. Will parse this text using HTML parser.
+	Text string `json:"text"`
+
+	// Optional. Attribute name to replace with new attributes derived from text in
+	// case text parsed successfully.
+	Name *string `json:"name,omitempty"`
+}
+
+/*
+SetAttributesAsTextResult represents the result of calls to DOM.setAttributesAsText.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributesAsText
+*/
+type SetAttributesAsTextResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetFileInputFilesParams represents DOM.setFileInputFiles parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setFileInputFiles
+*/
+type SetFileInputFilesParams struct {
+	// Array of file paths to set.
+	Files []string `json:"files"`
+
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+SetFileInputFilesResult represents the result of calls to DOM.setFileInputFiles.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setFileInputFiles
+*/
+type SetFileInputFilesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetNodeNameParams represents DOM.setNodeName parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeName
+*/
+type SetNodeNameParams struct {
+	// Id of the node to set name for.
+	NodeID NodeID `json:"nodeId"`
+
+	// New node's name.
+	Name string `json:"name"`
+}
+
+/*
+SetNodeNameResult represents the result of calls to DOM.setNodeName.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeName
+*/
+type SetNodeNameResult struct {
+	// New node's id.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetNodeValueParams represents DOM.setNodeValue parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeValue
+*/
+type SetNodeValueParams struct {
+	// Id of the node to set value for.
+	NodeID NodeID `json:"nodeId"`
+
+	// New node's value.
+	Value string `json:"value"`
+}
+
+/*
+SetNodeValueResult represents the result of calls to DOM.setNodeValue.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeValue
+*/
+type SetNodeValueResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetOuterHTMLParams represents DOM.setOuterHTML parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setOuterHTML
+*/
+type SetOuterHTMLParams struct {
+	// Id of the node to set markup for.
+	NodeID NodeID `json:"nodeId"`
+
+	// Outer HTML markup to set.
+	OuterHTML string `json:"outerHTML"`
+}
+
+/*
+SetOuterHTMLResult represents the result of calls to DOM.setOuterHTML.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setOuterHTML
+*/
+type SetOuterHTMLResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,65 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package debugger provides type definitions for use with the Chrome DOMDebugger protocol
+
+DOM debugging allows setting breakpoints on particular DOM operations and
+events. JavaScript execution will stop on these operations as if there was a
+regular breakpoint set.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/
+*/
+package debugger
+
+import (
+	"github.com/mkenney/go-chrome/stable/dom"
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+DOMBreakpointType represents the DOMDebugger.DOMBreakpointType type.
+
+DOM breakpoint type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#type-DOMBreakpointType
+*/
+type DOMBreakpointType string
+
+/*
+EventListener represents the DOMDebugger.EventListener type.
+
+Object event listener.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#type-EventListener
+*/
+type EventListener struct {
+	// `EventListener`'s type.
+	Type string `json:"type"`
+
+	// `EventListener`'s useCapture.
+	UseCapture bool `json:"useCapture"`
+
+	// `EventListener`'s passive flag.
+	Passive bool `json:"passive"`
+
+	// `EventListener`'s once flag.
+	Once bool `json:"once"`
+
+	// Script id of the handler code.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber"`
+
+	// Optional. Event handler function value.
+	Handler *runtime.RemoteObject `json:"handler,omitempty"`
+
+	// Optional. Event original handler function value.
+	OriginalHandler *runtime.RemoteObject `json:"originalHandler,omitempty"`
+
+	// Optional. Node the listener is added to (if any).
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
+}
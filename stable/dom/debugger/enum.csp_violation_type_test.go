@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCSPViolationType(t *testing.T) {
+	var enum CSPViolationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CSPViolationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CSPViolationType.TrustedtypeSinkViolation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"trustedtype-sink-violation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"trustedtype-sink-violation"`, result)
+	}
+	json.Unmarshal([]byte(`"trustedtype-sink-violation"`), &enum)
+	if CSPViolationType.TrustedtypeSinkViolation != enum {
+		t.Errorf("Expected %d, got %d", CSPViolationType.TrustedtypeSinkViolation, enum)
+	}
+
+	enum = CSPViolationType.TrustedtypePolicyViolation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"trustedtype-policy-violation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"trustedtype-policy-violation"`, result)
+	}
+	json.Unmarshal([]byte(`"trustedtype-policy-violation"`), &enum)
+	if CSPViolationType.TrustedtypePolicyViolation != enum {
+		t.Errorf("Expected %d, got %d", CSPViolationType.TrustedtypePolicyViolation, enum)
+	}
+}
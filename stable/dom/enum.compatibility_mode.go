@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type compatibilityModeEnum struct {
+	QuirksMode        CompatibilityModeEnum
+	LimitedQuirksMode CompatibilityModeEnum
+	NoQuirksMode      CompatibilityModeEnum
+}
+
+/*
+CompatibilityMode provides named access to the CompatibilityModeEnum values.
+*/
+var CompatibilityMode = compatibilityModeEnum{
+	QuirksMode:        compatibilityModeQuirksMode,
+	LimitedQuirksMode: compatibilityModeLimitedQuirksMode,
+	NoQuirksMode:      compatibilityModeNoQuirksMode,
+}
+
+/*
+CompatibilityModeEnum represents the DOM.CompatibilityMode values.
+
+Document compatibility mode.
+
+Allowed values:
+  - CompatibilityMode.QuirksMode        "QuirksMode"
+  - CompatibilityMode.LimitedQuirksMode "LimitedQuirksMode"
+  - CompatibilityMode.NoQuirksMode      "NoQuirksMode"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-CompatibilityMode
+*/
+type CompatibilityModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CompatibilityModeEnum) String() string {
+	return _compatibilityModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CompatibilityModeEnum) Unknown() bool {
+	return !_compatibilityModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CompatibilityModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CompatibilityModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _compatibilityModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// compatibilityModeQuirksMode represents the "QuirksMode" value.
+	compatibilityModeQuirksMode CompatibilityModeEnum = iota + 1
+	// compatibilityModeLimitedQuirksMode represents the "LimitedQuirksMode" value.
+	compatibilityModeLimitedQuirksMode
+	// compatibilityModeNoQuirksMode represents the "NoQuirksMode" value.
+	compatibilityModeNoQuirksMode
+)
+
+var _compatibilityModeEnums = enums.New(map[CompatibilityModeEnum]string{
+	CompatibilityModeEnum(0):           "",
+	compatibilityModeQuirksMode:        "QuirksMode",
+	compatibilityModeLimitedQuirksMode: "LimitedQuirksMode",
+	compatibilityModeNoQuirksMode:      "NoQuirksMode",
+})
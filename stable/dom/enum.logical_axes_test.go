@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumLogicalAxes(t *testing.T) {
+	var enum LogicalAxesEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown LogicalAxesEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = LogicalAxes.Inline
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Inline"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Inline"`, result)
+	}
+	json.Unmarshal([]byte(`"Inline"`), &enum)
+	if LogicalAxes.Inline != enum {
+		t.Errorf("Expected %d, got %d", LogicalAxes.Inline, enum)
+	}
+
+	enum = LogicalAxes.Block
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Block"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Block"`, result)
+	}
+	json.Unmarshal([]byte(`"Block"`), &enum)
+	if LogicalAxes.Block != enum {
+		t.Errorf("Expected %d, got %d", LogicalAxes.Block, enum)
+	}
+
+	enum = LogicalAxes.Both
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Both"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Both"`, result)
+	}
+	json.Unmarshal([]byte(`"Both"`), &enum)
+	if LogicalAxes.Both != enum {
+		t.Errorf("Expected %d, got %d", LogicalAxes.Both, enum)
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumScrollOrientation(t *testing.T) {
+	var enum ScrollOrientationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ScrollOrientationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ScrollOrientation.Horizontal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"horizontal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"horizontal"`, result)
+	}
+	json.Unmarshal([]byte(`"horizontal"`), &enum)
+	if ScrollOrientation.Horizontal != enum {
+		t.Errorf("Expected %d, got %d", ScrollOrientation.Horizontal, enum)
+	}
+
+	enum = ScrollOrientation.Vertical
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"vertical"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"vertical"`, result)
+	}
+	json.Unmarshal([]byte(`"vertical"`), &enum)
+	if ScrollOrientation.Vertical != enum {
+		t.Errorf("Expected %d, got %d", ScrollOrientation.Vertical, enum)
+	}
+}
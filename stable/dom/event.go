@@ -0,0 +1,131 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+/*
+AttributeModifiedEvent represents DOM.attributeModified event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeModified
+*/
+type AttributeModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+
+	// Attribute name.
+	Name string `json:"name"`
+
+	// Attribute value.
+	Value string `json:"value"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AttributeRemovedEvent represents DOM.attributeRemoved event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeRemoved
+*/
+type AttributeRemovedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+
+	// A ttribute name.
+	Name string `json:"name"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CharacterDataModifiedEvent represents DOM.characterDataModified event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-characterDataModified
+*/
+type CharacterDataModifiedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+
+	// New text value.
+	CharacterData string `json:"characterData"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ChildNodeCountUpdatedEvent represents DOM.childNodeCountUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeCountUpdated
+*/
+type ChildNodeCountUpdatedEvent struct {
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+
+	// New node count.
+	ChildNodeCount int `json:"childNodeCount"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ChildNodeInsertedEvent represents DOM.childNodeInserted event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeInserted
+*/
+type ChildNodeInsertedEvent struct {
+	// Id of the node that has changed.
+	ParentNodeID NodeID `json:"parentNodeId"`
+
+	// Id of the previous sibling.
+	PreviousNodeID NodeID `json:"previousNodeId"`
+
+	// Inserted node data.
+	Node *Node `json:"node"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ChildNodeRemovedEvent represents DOM.childNodeRemoved event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeRemoved
+*/
+type ChildNodeRemovedEvent struct {
+	// Parent id.
+	ParentNodeID NodeID `json:"parentNodeId"`
+
+	// Id of the node that has been removed.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DocumentUpdatedEvent represents DOM.documentUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-documentUpdated
+*/
+type DocumentUpdatedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SetChildNodesEvent represents DOM.setChildNodes event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-setChildNodes
+*/
+type SetChildNodesEvent struct {
+	// Parent node id to populate with children.
+	ParentID NodeID `json:"parentId"`
+
+	// Child nodes array.
+	Nodes []*Node `json:"nodes"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,338 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"github.com/mkenney/go-chrome/stable/dom"
+)
+
+/*
+ClearDeviceMetricsOverrideResult represents the result of calls to Emulation.clearDeviceMetricsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearDeviceMetricsOverride
+*/
+type ClearDeviceMetricsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearGeolocationOverrideResult represents the result of calls to Emulation.clearGeolocationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearGeolocationOverride
+*/
+type ClearGeolocationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCPUThrottlingRateParams represents Emulation.setCPUThrottlingRate parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setCPUThrottlingRate
+*/
+type SetCPUThrottlingRateParams struct {
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown,
+	// etc).
+	Rate float64 `json:"rate"`
+}
+
+/*
+SetCPUThrottlingRateResult represents the result of calls to Emulation.setCPUThrottlingRate.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setCPUThrottlingRate
+*/
+type SetCPUThrottlingRateResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDefaultBackgroundColorOverrideParams represents Emulation.setDefaultBackgroundColorOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+type SetDefaultBackgroundColorOverrideParams struct {
+	// Optional. RGBA of the default background color. If not specified, any
+	// existing override will be cleared.
+	Color *dom.RGBA `json:"color,omitempty"`
+}
+
+/*
+SetDefaultBackgroundColorOverrideResult represents the result of calls to Emulation.setDefaultBackgroundColorOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+type SetDefaultBackgroundColorOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDeviceMetricsOverrideParams represents Emulation.setDeviceMetricsOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDeviceMetricsOverride
+*/
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Width int `json:"width"`
+
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Height int `json:"height"`
+
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay
+	// scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+
+	// Optional. Screen orientation override.
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+}
+
+/*
+SetDeviceMetricsOverrideResult represents the result of calls to Emulation.setDeviceMetricsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDeviceMetricsOverride
+*/
+type SetDeviceMetricsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetEmulatedMediaParams represents Emulation.setEmulatedMedia parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedMedia
+*/
+type SetEmulatedMediaParams struct {
+	// Optional. Media type to emulate. Empty string disables the override.
+	Media *string `json:"media,omitempty"`
+
+	// Optional. Media features to emulate.
+	Features []*MediaFeature `json:"features,omitempty"`
+}
+
+/*
+SetEmulatedMediaResult represents the result of calls to Emulation.setEmulatedMedia.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedMedia
+*/
+type SetEmulatedMediaResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetEmulatedVisionDeficiencyParams represents Emulation.setEmulatedVisionDeficiency parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedVisionDeficiency
+*/
+type SetEmulatedVisionDeficiencyParams struct {
+	// Vision deficiency to emulate. Order: best-effort emulations come first,
+	// followed by any physiologically accurate emulations for medically recognized
+	// color vision deficiencies. Allowed values:
+	//   - SetEmulatedVisionDeficiencyType.None
+	//   - SetEmulatedVisionDeficiencyType.BlurredVision
+	//   - SetEmulatedVisionDeficiencyType.ReducedContrast
+	//   - SetEmulatedVisionDeficiencyType.Achromatopsia
+	//   - SetEmulatedVisionDeficiencyType.Deuteranopia
+	//   - SetEmulatedVisionDeficiencyType.Protanopia
+	//   - SetEmulatedVisionDeficiencyType.Tritanopia
+	Type SetEmulatedVisionDeficiencyTypeEnum `json:"type"`
+}
+
+/*
+SetEmulatedVisionDeficiencyResult represents the result of calls to Emulation.setEmulatedVisionDeficiency.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedVisionDeficiency
+*/
+type SetEmulatedVisionDeficiencyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetEmulatedOSTextScaleParams represents Emulation.setEmulatedOSTextScale parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedOSTextScale
+*/
+type SetEmulatedOSTextScaleParams struct {
+	// Optional.
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+/*
+SetEmulatedOSTextScaleResult represents the result of calls to Emulation.setEmulatedOSTextScale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedOSTextScale
+*/
+type SetEmulatedOSTextScaleResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetGeolocationOverrideParams represents Emulation.setGeolocationOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setGeolocationOverride
+*/
+type SetGeolocationOverrideParams struct {
+	// Optional. Mock latitude
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Optional. Mock longitude
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Optional. Mock accuracy
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	// Optional. Mock altitude
+	Altitude *float64 `json:"altitude,omitempty"`
+
+	// Optional. Mock altitudeAccuracy
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"`
+
+	// Optional. Mock heading
+	Heading *float64 `json:"heading,omitempty"`
+
+	// Optional. Mock speed
+	Speed *float64 `json:"speed,omitempty"`
+}
+
+/*
+SetGeolocationOverrideResult represents the result of calls to Emulation.setGeolocationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setGeolocationOverride
+*/
+type SetGeolocationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetIdleOverrideParams represents Emulation.setIdleOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setIdleOverride
+*/
+type SetIdleOverrideParams struct {
+	// Mock isUserActive
+	IsUserActive bool `json:"isUserActive"`
+
+	// Mock isScreenUnlocked
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+/*
+SetIdleOverrideResult represents the result of calls to Emulation.setIdleOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setIdleOverride
+*/
+type SetIdleOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearIdleOverrideResult represents the result of calls to Emulation.clearIdleOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearIdleOverride
+*/
+type ClearIdleOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetScriptExecutionDisabledParams represents Emulation.setScriptExecutionDisabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setScriptExecutionDisabled
+*/
+type SetScriptExecutionDisabledParams struct {
+	// Whether script execution should be disabled in the page.
+	Value bool `json:"value"`
+}
+
+/*
+SetScriptExecutionDisabledResult represents the result of calls to Emulation.setScriptExecutionDisabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setScriptExecutionDisabled
+*/
+type SetScriptExecutionDisabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetTouchEmulationEnabledParams represents Emulation.setTouchEmulationEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTouchEmulationEnabled
+*/
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+
+	// Optional. Maximum touch points supported. Defaults to one.
+	MaxTouchPoints *int `json:"maxTouchPoints,omitempty"`
+}
+
+/*
+SetTouchEmulationEnabledResult represents the result of calls to Emulation.setTouchEmulationEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTouchEmulationEnabled
+*/
+type SetTouchEmulationEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetTimezoneOverrideParams represents Emulation.setTimezoneOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTimezoneOverride
+*/
+type SetTimezoneOverrideParams struct {
+	// The timezone identifier. List of supported timezones:
+	// https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	// If empty, disables the override and restores default host system timezone.
+	TimezoneID string `json:"timezoneId"`
+}
+
+/*
+SetTimezoneOverrideResult represents the result of calls to Emulation.setTimezoneOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTimezoneOverride
+*/
+type SetTimezoneOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetUserAgentOverrideParams represents Emulation.setUserAgentOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+
+	// Optional. Browser language to emulate.
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"`
+
+	// Optional. The platform navigator.platform should return.
+	Platform *string `json:"platform,omitempty"`
+}
+
+/*
+SetUserAgentOverrideResult represents the result of calls to Emulation.setUserAgentOverride.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
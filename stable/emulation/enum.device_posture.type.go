@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type typeEnum struct {
+	Continuous TypeEnum
+	Folded     TypeEnum
+}
+
+/*
+Type provides named access to the TypeEnum values.
+*/
+var Type = typeEnum{
+	Continuous: typeContinuous,
+	Folded:     typeFolded,
+}
+
+/*
+TypeEnum represents the Emulation.DevicePosture.type values.
+
+# Current posture of the device
+
+Allowed values:
+  - Type.Continuous "continuous"
+  - Type.Folded     "folded"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-DevicePosture
+*/
+type TypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TypeEnum) String() string {
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _typeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// typeContinuous represents the "continuous" value.
+	typeContinuous TypeEnum = iota + 1
+	// typeFolded represents the "folded" value.
+	typeFolded
+)
+
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):    "",
+	typeContinuous: "continuous",
+	typeFolded:     "folded",
+})
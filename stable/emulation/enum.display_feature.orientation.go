@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type orientationEnum struct {
+	Vertical   OrientationEnum
+	Horizontal OrientationEnum
+}
+
+/*
+Orientation provides named access to the OrientationEnum values.
+*/
+var Orientation = orientationEnum{
+	Vertical:   orientationVertical,
+	Horizontal: orientationHorizontal,
+}
+
+/*
+OrientationEnum represents the Emulation.DisplayFeature.orientation values.
+
+# Orientation of a display feature in relation to screen
+
+Allowed values:
+  - Orientation.Vertical   "vertical"
+  - Orientation.Horizontal "horizontal"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-DisplayFeature
+*/
+type OrientationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum OrientationEnum) String() string {
+	return _orientationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum OrientationEnum) Unknown() bool {
+	return !_orientationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum OrientationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *OrientationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _orientationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// orientationVertical represents the "vertical" value.
+	orientationVertical OrientationEnum = iota + 1
+	// orientationHorizontal represents the "horizontal" value.
+	orientationHorizontal
+)
+
+var _orientationEnums = enums.New(map[OrientationEnum]string{
+	OrientationEnum(0):    "",
+	orientationVertical:   "vertical",
+	orientationHorizontal: "horizontal",
+})
@@ -0,0 +1,76 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type pressureSourceEnum struct {
+	CPU PressureSourceEnum
+}
+
+/*
+PressureSource provides named access to the PressureSourceEnum values.
+*/
+var PressureSource = pressureSourceEnum{
+	CPU: pressureSourceCPU,
+}
+
+/*
+PressureSourceEnum represents the Emulation.PressureSource values.
+
+Allowed values:
+  - PressureSource.CPU "cpu"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-PressureSource
+*/
+type PressureSourceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PressureSourceEnum) String() string {
+	return _pressureSourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PressureSourceEnum) Unknown() bool {
+	return !_pressureSourceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PressureSourceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PressureSourceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _pressureSourceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// pressureSourceCPU represents the "cpu" value.
+	pressureSourceCPU PressureSourceEnum = iota + 1
+)
+
+var _pressureSourceEnums = enums.New(map[PressureSourceEnum]string{
+	PressureSourceEnum(0): "",
+	pressureSourceCPU:     "cpu",
+})
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type orientationTypeEnum struct {
+	PortraitPrimary    OrientationTypeEnum
+	PortraitSecondary  OrientationTypeEnum
+	LandscapePrimary   OrientationTypeEnum
+	LandscapeSecondary OrientationTypeEnum
+}
+
+/*
+OrientationType provides named access to the OrientationTypeEnum values.
+*/
+var OrientationType = orientationTypeEnum{
+	PortraitPrimary:    orientationTypePortraitPrimary,
+	PortraitSecondary:  orientationTypePortraitSecondary,
+	LandscapePrimary:   orientationTypeLandscapePrimary,
+	LandscapeSecondary: orientationTypeLandscapeSecondary,
+}
+
+/*
+OrientationTypeEnum represents the Emulation.ScreenOrientation.type values.
+
+Orientation type.
+
+Allowed values:
+  - OrientationType.PortraitPrimary    "portraitPrimary"
+  - OrientationType.PortraitSecondary  "portraitSecondary"
+  - OrientationType.LandscapePrimary   "landscapePrimary"
+  - OrientationType.LandscapeSecondary "landscapeSecondary"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-ScreenOrientation
+*/
+type OrientationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum OrientationTypeEnum) String() string {
+	return _orientationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum OrientationTypeEnum) Unknown() bool {
+	return !_orientationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum OrientationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *OrientationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _orientationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// orientationTypePortraitPrimary represents the "portraitPrimary" value.
+	orientationTypePortraitPrimary OrientationTypeEnum = iota + 1
+	// orientationTypePortraitSecondary represents the "portraitSecondary" value.
+	orientationTypePortraitSecondary
+	// orientationTypeLandscapePrimary represents the "landscapePrimary" value.
+	orientationTypeLandscapePrimary
+	// orientationTypeLandscapeSecondary represents the "landscapeSecondary" value.
+	orientationTypeLandscapeSecondary
+)
+
+var _orientationTypeEnums = enums.New(map[OrientationTypeEnum]string{
+	OrientationTypeEnum(0):            "",
+	orientationTypePortraitPrimary:    "portraitPrimary",
+	orientationTypePortraitSecondary:  "portraitSecondary",
+	orientationTypeLandscapePrimary:   "landscapePrimary",
+	orientationTypeLandscapeSecondary: "landscapeSecondary",
+})
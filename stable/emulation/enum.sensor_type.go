@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sensorTypeEnum struct {
+	AbsoluteOrientation SensorTypeEnum
+	Accelerometer       SensorTypeEnum
+	AmbientLight        SensorTypeEnum
+	Gravity             SensorTypeEnum
+	Gyroscope           SensorTypeEnum
+	LinearAcceleration  SensorTypeEnum
+	Magnetometer        SensorTypeEnum
+	RelativeOrientation SensorTypeEnum
+}
+
+/*
+SensorType provides named access to the SensorTypeEnum values.
+*/
+var SensorType = sensorTypeEnum{
+	AbsoluteOrientation: sensorTypeAbsoluteOrientation,
+	Accelerometer:       sensorTypeAccelerometer,
+	AmbientLight:        sensorTypeAmbientLight,
+	Gravity:             sensorTypeGravity,
+	Gyroscope:           sensorTypeGyroscope,
+	LinearAcceleration:  sensorTypeLinearAcceleration,
+	Magnetometer:        sensorTypeMagnetometer,
+	RelativeOrientation: sensorTypeRelativeOrientation,
+}
+
+/*
+SensorTypeEnum represents the Emulation.SensorType values.
+
+Used to specify sensor types to emulate. See
+https://w3c.github.io/sensors/#automation for more information.
+
+Allowed values:
+  - SensorType.AbsoluteOrientation "absolute-orientation"
+  - SensorType.Accelerometer       "accelerometer"
+  - SensorType.AmbientLight        "ambient-light"
+  - SensorType.Gravity             "gravity"
+  - SensorType.Gyroscope           "gyroscope"
+  - SensorType.LinearAcceleration  "linear-acceleration"
+  - SensorType.Magnetometer        "magnetometer"
+  - SensorType.RelativeOrientation "relative-orientation"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#type-SensorType
+*/
+type SensorTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SensorTypeEnum) String() string {
+	return _sensorTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SensorTypeEnum) Unknown() bool {
+	return !_sensorTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SensorTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SensorTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sensorTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sensorTypeAbsoluteOrientation represents the "absolute-orientation" value.
+	sensorTypeAbsoluteOrientation SensorTypeEnum = iota + 1
+	// sensorTypeAccelerometer represents the "accelerometer" value.
+	sensorTypeAccelerometer
+	// sensorTypeAmbientLight represents the "ambient-light" value.
+	sensorTypeAmbientLight
+	// sensorTypeGravity represents the "gravity" value.
+	sensorTypeGravity
+	// sensorTypeGyroscope represents the "gyroscope" value.
+	sensorTypeGyroscope
+	// sensorTypeLinearAcceleration represents the "linear-acceleration" value.
+	sensorTypeLinearAcceleration
+	// sensorTypeMagnetometer represents the "magnetometer" value.
+	sensorTypeMagnetometer
+	// sensorTypeRelativeOrientation represents the "relative-orientation" value.
+	sensorTypeRelativeOrientation
+)
+
+var _sensorTypeEnums = enums.New(map[SensorTypeEnum]string{
+	SensorTypeEnum(0):             "",
+	sensorTypeAbsoluteOrientation: "absolute-orientation",
+	sensorTypeAccelerometer:       "accelerometer",
+	sensorTypeAmbientLight:        "ambient-light",
+	sensorTypeGravity:             "gravity",
+	sensorTypeGyroscope:           "gyroscope",
+	sensorTypeLinearAcceleration:  "linear-acceleration",
+	sensorTypeMagnetometer:        "magnetometer",
+	sensorTypeRelativeOrientation: "relative-orientation",
+})
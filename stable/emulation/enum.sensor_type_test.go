@@ -0,0 +1,147 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSensorType(t *testing.T) {
+	var enum SensorTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SensorTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SensorType.AbsoluteOrientation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"absolute-orientation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"absolute-orientation"`, result)
+	}
+	json.Unmarshal([]byte(`"absolute-orientation"`), &enum)
+	if SensorType.AbsoluteOrientation != enum {
+		t.Errorf("Expected %d, got %d", SensorType.AbsoluteOrientation, enum)
+	}
+
+	enum = SensorType.Accelerometer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"accelerometer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"accelerometer"`, result)
+	}
+	json.Unmarshal([]byte(`"accelerometer"`), &enum)
+	if SensorType.Accelerometer != enum {
+		t.Errorf("Expected %d, got %d", SensorType.Accelerometer, enum)
+	}
+
+	enum = SensorType.AmbientLight
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ambient-light"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ambient-light"`, result)
+	}
+	json.Unmarshal([]byte(`"ambient-light"`), &enum)
+	if SensorType.AmbientLight != enum {
+		t.Errorf("Expected %d, got %d", SensorType.AmbientLight, enum)
+	}
+
+	enum = SensorType.Gravity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gravity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gravity"`, result)
+	}
+	json.Unmarshal([]byte(`"gravity"`), &enum)
+	if SensorType.Gravity != enum {
+		t.Errorf("Expected %d, got %d", SensorType.Gravity, enum)
+	}
+
+	enum = SensorType.Gyroscope
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gyroscope"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gyroscope"`, result)
+	}
+	json.Unmarshal([]byte(`"gyroscope"`), &enum)
+	if SensorType.Gyroscope != enum {
+		t.Errorf("Expected %d, got %d", SensorType.Gyroscope, enum)
+	}
+
+	enum = SensorType.LinearAcceleration
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"linear-acceleration"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"linear-acceleration"`, result)
+	}
+	json.Unmarshal([]byte(`"linear-acceleration"`), &enum)
+	if SensorType.LinearAcceleration != enum {
+		t.Errorf("Expected %d, got %d", SensorType.LinearAcceleration, enum)
+	}
+
+	enum = SensorType.Magnetometer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"magnetometer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"magnetometer"`, result)
+	}
+	json.Unmarshal([]byte(`"magnetometer"`), &enum)
+	if SensorType.Magnetometer != enum {
+		t.Errorf("Expected %d, got %d", SensorType.Magnetometer, enum)
+	}
+
+	enum = SensorType.RelativeOrientation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"relative-orientation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"relative-orientation"`, result)
+	}
+	json.Unmarshal([]byte(`"relative-orientation"`), &enum)
+	if SensorType.RelativeOrientation != enum {
+		t.Errorf("Expected %d, got %d", SensorType.RelativeOrientation, enum)
+	}
+}
@@ -0,0 +1,134 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSetEmulatedVisionDeficiencyType(t *testing.T) {
+	var enum SetEmulatedVisionDeficiencyTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SetEmulatedVisionDeficiencyTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if SetEmulatedVisionDeficiencyType.None != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.None, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.BlurredVision
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"blurredVision"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"blurredVision"`, result)
+	}
+	json.Unmarshal([]byte(`"blurredVision"`), &enum)
+	if SetEmulatedVisionDeficiencyType.BlurredVision != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.BlurredVision, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.ReducedContrast
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"reducedContrast"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"reducedContrast"`, result)
+	}
+	json.Unmarshal([]byte(`"reducedContrast"`), &enum)
+	if SetEmulatedVisionDeficiencyType.ReducedContrast != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.ReducedContrast, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.Achromatopsia
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"achromatopsia"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"achromatopsia"`, result)
+	}
+	json.Unmarshal([]byte(`"achromatopsia"`), &enum)
+	if SetEmulatedVisionDeficiencyType.Achromatopsia != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.Achromatopsia, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.Deuteranopia
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deuteranopia"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deuteranopia"`, result)
+	}
+	json.Unmarshal([]byte(`"deuteranopia"`), &enum)
+	if SetEmulatedVisionDeficiencyType.Deuteranopia != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.Deuteranopia, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.Protanopia
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"protanopia"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"protanopia"`, result)
+	}
+	json.Unmarshal([]byte(`"protanopia"`), &enum)
+	if SetEmulatedVisionDeficiencyType.Protanopia != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.Protanopia, enum)
+	}
+
+	enum = SetEmulatedVisionDeficiencyType.Tritanopia
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"tritanopia"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"tritanopia"`, result)
+	}
+	json.Unmarshal([]byte(`"tritanopia"`), &enum)
+	if SetEmulatedVisionDeficiencyType.Tritanopia != enum {
+		t.Errorf("Expected %d, got %d", SetEmulatedVisionDeficiencyType.Tritanopia, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sourceEnum struct {
+	Server SourceEnum
+	Proxy  SourceEnum
+}
+
+/*
+Source provides named access to the SourceEnum values.
+*/
+var Source = sourceEnum{
+	Server: sourceServer,
+	Proxy:  sourceProxy,
+}
+
+/*
+SourceEnum represents the Fetch.AuthChallenge.source values.
+
+Source of the authentication challenge.
+
+Allowed values:
+  - Source.Server "Server"
+  - Source.Proxy  "Proxy"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#type-AuthChallenge
+*/
+type SourceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SourceEnum) String() string {
+	return _sourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SourceEnum) Unknown() bool {
+	return !_sourceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SourceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SourceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sourceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sourceServer represents the "Server" value.
+	sourceServer SourceEnum = iota + 1
+	// sourceProxy represents the "Proxy" value.
+	sourceProxy
+)
+
+var _sourceEnums = enums.New(map[SourceEnum]string{
+	SourceEnum(0): "",
+	sourceServer:  "Server",
+	sourceProxy:   "Proxy",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumResponse(t *testing.T) {
+	var enum ResponseEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ResponseEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Response.Default
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Default"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Default"`, result)
+	}
+	json.Unmarshal([]byte(`"Default"`), &enum)
+	if Response.Default != enum {
+		t.Errorf("Expected %d, got %d", Response.Default, enum)
+	}
+
+	enum = Response.CancelAuth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CancelAuth"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CancelAuth"`, result)
+	}
+	json.Unmarshal([]byte(`"CancelAuth"`), &enum)
+	if Response.CancelAuth != enum {
+		t.Errorf("Expected %d, got %d", Response.CancelAuth, enum)
+	}
+
+	enum = Response.ProvideCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ProvideCredentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ProvideCredentials"`, result)
+	}
+	json.Unmarshal([]byte(`"ProvideCredentials"`), &enum)
+	if Response.ProvideCredentials != enum {
+		t.Errorf("Expected %d, got %d", Response.ProvideCredentials, enum)
+	}
+}
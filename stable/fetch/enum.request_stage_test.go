@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRequestStage(t *testing.T) {
+	var enum RequestStageEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RequestStageEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = RequestStage.Request
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Request"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Request"`, result)
+	}
+	json.Unmarshal([]byte(`"Request"`), &enum)
+	if RequestStage.Request != enum {
+		t.Errorf("Expected %d, got %d", RequestStage.Request, enum)
+	}
+
+	enum = RequestStage.Response
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Response"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Response"`, result)
+	}
+	json.Unmarshal([]byte(`"Response"`), &enum)
+	if RequestStage.Response != enum {
+		t.Errorf("Expected %d, got %d", RequestStage.Response, enum)
+	}
+}
@@ -0,0 +1,125 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"github.com/mkenney/go-chrome/stable/network"
+	"github.com/mkenney/go-chrome/stable/page"
+)
+
+/*
+RequestPausedEvent represents Fetch.requestPaused event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#event-requestPaused
+*/
+type RequestPausedEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+
+	// The details of the request.
+	Request *network.Request `json:"request"`
+
+	// The id of the frame that initiated the request.
+	FrameID page.FrameID `json:"frameId"`
+
+	// How the requested resource will be used. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	ResourceType page.ResourceTypeEnum `json:"resourceType"`
+
+	// Optional. Response error if intercepted at response stage. Allowed values:
+	//   - network.ErrorReason.Failed
+	//   - network.ErrorReason.Aborted
+	//   - network.ErrorReason.TimedOut
+	//   - network.ErrorReason.AccessDenied
+	//   - network.ErrorReason.ConnectionClosed
+	//   - network.ErrorReason.ConnectionReset
+	//   - network.ErrorReason.ConnectionRefused
+	//   - network.ErrorReason.ConnectionAborted
+	//   - network.ErrorReason.ConnectionFailed
+	//   - network.ErrorReason.NameNotResolved
+	//   - network.ErrorReason.InternetDisconnected
+	//   - network.ErrorReason.AddressUnreachable
+	//   - network.ErrorReason.BlockedByClient
+	//   - network.ErrorReason.BlockedByResponse
+	ResponseErrorReason network.ErrorReasonEnum `json:"responseErrorReason,omitempty"`
+
+	// Optional. Response code if intercepted at response stage.
+	ResponseStatusCode int `json:"responseStatusCode,omitempty"`
+
+	// Optional. Response status text if intercepted at response stage.
+	ResponseStatusText string `json:"responseStatusText,omitempty"`
+
+	// Optional. Response headers if intercepted at the response stage.
+	ResponseHeaders []*HeaderEntry `json:"responseHeaders,omitempty"`
+
+	// Optional. If the intercepted request had a corresponding
+	// Network.requestWillBeSent event fired for it, then this networkId will be
+	// the same as the requestId present in the requestWillBeSent event.
+	NetworkID network.RequestID `json:"networkId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AuthRequiredEvent represents Fetch.authRequired event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Fetch/#event-authRequired
+*/
+type AuthRequiredEvent struct {
+	// Each request the page makes will have a unique id.
+	RequestID RequestID `json:"requestId"`
+
+	// The details of the request.
+	Request *network.Request `json:"request"`
+
+	// The id of the frame that initiated the request.
+	FrameID page.FrameID `json:"frameId"`
+
+	// How the requested resource will be used. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	ResourceType page.ResourceTypeEnum `json:"resourceType"`
+
+	// Details of the Authorization Challenge encountered. If this is set, client
+	// should respond with continueRequest that contains AuthChallengeResponse.
+	AuthChallenge *AuthChallenge `json:"authChallenge"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,110 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package input provides type definitions for use with the Chrome Input protocol
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/
+*/
+package input
+
+/*
+TouchPoint represents the Input.TouchPoint type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-TouchPoint
+*/
+type TouchPoint struct {
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
+
+	// Optional. X radius of the touch area (default: 1.0).
+	RadiusX float64 `json:"radiusX,omitempty"`
+
+	// Optional. Y radius of the touch area (default: 1.0).
+	RadiusY float64 `json:"radiusY,omitempty"`
+
+	// Optional. Rotation angle (default: 0.0).
+	RotationAngle float64 `json:"rotationAngle,omitempty"`
+
+	// Optional. Force (default: 1.0).
+	Force float64 `json:"force,omitempty"`
+
+	// Optional. The plane angle between the Y-Z plane and the plane containing
+	// both the stylus axis and the Y axis, in degrees of the range [-90,90], a
+	// positive tiltX is to the right (default: 0)
+	TiltX float64 `json:"tiltX,omitempty"`
+
+	// Optional. The plane angle between the X-Z plane and the plane containing
+	// both the stylus axis and the X axis, in degrees of the range [-90,90], a
+	// positive tiltY is towards the user (default: 0).
+	TiltY float64 `json:"tiltY,omitempty"`
+
+	// Optional. Identifier used to track touch sources between events, must be
+	// unique within an event.
+	ID float64 `json:"id,omitempty"`
+}
+
+/*
+GestureSourceType represents the Input.GestureSourceType type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-GestureSourceType
+*/
+type GestureSourceType string
+
+/*
+TimeSinceEpoch represents the Input.TimeSinceEpoch type.
+
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+DragDataItem represents the Input.DragDataItem type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-DragDataItem
+*/
+type DragDataItem struct {
+	// Mime type of the dragged data.
+	MimeType string `json:"mimeType"`
+
+	// Depending of the value of `mimeType`, it contains the dragged link, text,
+	// HTML markup or any other data.
+	Data string `json:"data"`
+
+	// Optional. Title associated with a link. Only valid when `mimeType` ==
+	// "text/uri-list".
+	Title string `json:"title,omitempty"`
+
+	// Optional. Stores the base URL for the contained markup. Only valid when
+	// `mimeType` == "text/html".
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
+/*
+DragData represents the Input.DragData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-DragData
+*/
+type DragData struct {
+	Items []*DragDataItem `json:"items"`
+
+	// Optional. List of filenames that should be included when dropping
+	Files []string `json:"files,omitempty"`
+
+	// Bit field representing allowed drag operations. Copy = 1, Link = 2, Move =
+	// 16
+	DragOperationsMask int `json:"dragOperationsMask"`
+}
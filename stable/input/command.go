@@ -0,0 +1,219 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+/*
+DispatchKeyEventParams represents Input.dispatchKeyEvent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchKeyEvent
+*/
+type DispatchKeyEventParams struct {
+	// Type of the key event. Allowed values:
+	//   - KeyEvent.KeyDown
+	//   - KeyEvent.KeyUp
+	//   - KeyEvent.RawKeyDown
+	//   - KeyEvent.Char
+	Type KeyEventEnum `json:"type"`
+
+	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
+	// Meta/Command=4, Shift=8 (default: 0).
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Optional. Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+
+	// Optional. Text as generated by processing a virtual key code with a keyboard
+	// layout. Not needed for for `keyUp` and `rawKeyDown` events (default: "")
+	Text *string `json:"text,omitempty"`
+
+	// Optional. Text that would have been generated by the keyboard if no
+	// modifiers were pressed (except for shift). Useful for shortcut (accelerator)
+	// key handling (default: "").
+	UnmodifiedText *string `json:"unmodifiedText,omitempty"`
+
+	// Optional. Unique key identifier (e.g., 'U+0041') (default: "").
+	KeyIdentifier *string `json:"keyIdentifier,omitempty"`
+
+	// Optional. Unique DOM defined string value for each physical key (e.g.,
+	// 'KeyA') (default: "").
+	Code *string `json:"code,omitempty"`
+
+	// Optional. Unique DOM defined string value describing the meaning of the key
+	// in the context of active modifiers, keyboard layout, etc (e.g., 'AltGr')
+	// (default: "").
+	Key *string `json:"key,omitempty"`
+
+	// Optional. Windows virtual key code (default: 0).
+	WindowsVirtualKeyCode *int `json:"windowsVirtualKeyCode,omitempty"`
+
+	// Optional. Native virtual key code (default: 0).
+	NativeVirtualKeyCode *int `json:"nativeVirtualKeyCode,omitempty"`
+
+	// Optional. Whether the event was generated from auto repeat (default: false).
+	AutoRepeat *bool `json:"autoRepeat,omitempty"`
+
+	// Optional. Whether the event was generated from the keypad (default: false).
+	IsKeypad *bool `json:"isKeypad,omitempty"`
+
+	// Optional. Whether the event was a system key event (default: false).
+	IsSystemKey *bool `json:"isSystemKey,omitempty"`
+
+	// Optional. Whether the event was from the left or right side of the keyboard.
+	// 1=Left, 2=Right (default: 0).
+	Location *int `json:"location,omitempty"`
+}
+
+/*
+DispatchKeyEventResult represents the result of calls to Input.dispatchKeyEvent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchKeyEvent
+*/
+type DispatchKeyEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DispatchMouseEventParams represents Input.dispatchMouseEvent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchMouseEvent
+*/
+type DispatchMouseEventParams struct {
+	// Type of the mouse event. Allowed values:
+	//   - MouseEvent.MousePressed
+	//   - MouseEvent.MouseReleased
+	//   - MouseEvent.MouseMoved
+	//   - MouseEvent.MouseWheel
+	Type MouseEventEnum `json:"type"`
+
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
+
+	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
+	// Meta/Command=4, Shift=8 (default: 0).
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Optional. Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+
+	// Optional. Mouse button (default: "none"). Allowed values:
+	//   - ButtonEvent.None
+	//   - ButtonEvent.Left
+	//   - ButtonEvent.Middle
+	//   - ButtonEvent.Right
+	//   - ButtonEvent.Back
+	//   - ButtonEvent.Forward
+	Button ButtonEventEnum `json:"button,omitempty"`
+
+	// Optional. A number indicating which buttons are pressed on the mouse when a
+	// mouse event is triggered. Left=1, Right=2, Middle=4, Back=8, Forward=16,
+	// None=0.
+	Buttons *int `json:"buttons,omitempty"`
+
+	// Optional. Number of times the mouse button was clicked (default: 0).
+	ClickCount *int `json:"clickCount,omitempty"`
+
+	// Optional. The plane angle between the Y-Z plane and the plane containing
+	// both the stylus axis and the Y axis, in degrees of the range [-90,90], a
+	// positive tiltX is to the right (default: 0).
+	TiltX *float64 `json:"tiltX,omitempty"`
+
+	// Optional. The plane angle between the X-Z plane and the plane containing
+	// both the stylus axis and the X axis, in degrees of the range [-90,90], a
+	// positive tiltY is towards the user (default: 0).
+	TiltY *float64 `json:"tiltY,omitempty"`
+
+	// Optional. X delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaX *float64 `json:"deltaX,omitempty"`
+
+	// Optional. Y delta in CSS pixels for mouse wheel event (default: 0).
+	DeltaY *float64 `json:"deltaY,omitempty"`
+
+	// Optional. Pointer type (default: "mouse"). Allowed values:
+	//   - PointerType.Mouse
+	//   - PointerType.Pen
+	PointerType PointerTypeEnum `json:"pointerType,omitempty"`
+}
+
+/*
+DispatchMouseEventResult represents the result of calls to Input.dispatchMouseEvent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchMouseEvent
+*/
+type DispatchMouseEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DispatchTouchEventParams represents Input.dispatchTouchEvent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchTouchEvent
+*/
+type DispatchTouchEventParams struct {
+	// Type of the touch event. TouchEnd and TouchCancel must not contain any touch
+	// points, while TouchStart and TouchMove must contains at least one. Allowed values:
+	//   - TouchEvent.TouchStart
+	//   - TouchEvent.TouchEnd
+	//   - TouchEvent.TouchMove
+	//   - TouchEvent.TouchCancel
+	Type TouchEventEnum `json:"type"`
+
+	// Active touch points on the touch device. One event per any changed point
+	// (compared to previous touch event in a sequence) is generated, emulating
+	// pressing/moving/releasing points one by one.
+	TouchPoints []*TouchPoint `json:"touchPoints"`
+
+	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
+	// Meta/Command=4, Shift=8 (default: 0).
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Optional. Time at which the event occurred.
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+/*
+DispatchTouchEventResult represents the result of calls to Input.dispatchTouchEvent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchTouchEvent
+*/
+type DispatchTouchEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CancelDraggingResult represents the result of calls to Input.cancelDragging.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-cancelDragging
+*/
+type CancelDraggingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetIgnoreEventsParams represents Input.setIgnoreInputEvents parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-setIgnoreInputEvents
+*/
+type SetIgnoreEventsParams struct {
+	// Ignores input events processing when set to true.
+	Ignore bool `json:"ignore"`
+}
+
+/*
+SetIgnoreEventsResult represents the result of calls to Input.setIgnoreInputEvents.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-setIgnoreInputEvents
+*/
+type SetIgnoreEventsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,106 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type buttonEventEnum struct {
+	None    ButtonEventEnum
+	Left    ButtonEventEnum
+	Middle  ButtonEventEnum
+	Right   ButtonEventEnum
+	Back    ButtonEventEnum
+	Forward ButtonEventEnum
+}
+
+/*
+ButtonEvent provides named access to the ButtonEventEnum values.
+*/
+var ButtonEvent = buttonEventEnum{
+	None:    buttonEventNone,
+	Left:    buttonEventLeft,
+	Middle:  buttonEventMiddle,
+	Right:   buttonEventRight,
+	Back:    buttonEventBack,
+	Forward: buttonEventForward,
+}
+
+/*
+ButtonEventEnum represents the Input.MouseButton values.
+
+Allowed values:
+  - ButtonEvent.None    "none"
+  - ButtonEvent.Left    "left"
+  - ButtonEvent.Middle  "middle"
+  - ButtonEvent.Right   "right"
+  - ButtonEvent.Back    "back"
+  - ButtonEvent.Forward "forward"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#type-MouseButton
+*/
+type ButtonEventEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ButtonEventEnum) String() string {
+	return _buttonEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ButtonEventEnum) Unknown() bool {
+	return !_buttonEventEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ButtonEventEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ButtonEventEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _buttonEventEnums.Parse(val)
+	return nil
+}
+
+const (
+	// buttonEventNone represents the "none" value.
+	buttonEventNone ButtonEventEnum = iota + 1
+	// buttonEventLeft represents the "left" value.
+	buttonEventLeft
+	// buttonEventMiddle represents the "middle" value.
+	buttonEventMiddle
+	// buttonEventRight represents the "right" value.
+	buttonEventRight
+	// buttonEventBack represents the "back" value.
+	buttonEventBack
+	// buttonEventForward represents the "forward" value.
+	buttonEventForward
+)
+
+var _buttonEventEnums = enums.New(map[ButtonEventEnum]string{
+	ButtonEventEnum(0): "",
+	buttonEventNone:    "none",
+	buttonEventLeft:    "left",
+	buttonEventMiddle:  "middle",
+	buttonEventRight:   "right",
+	buttonEventBack:    "back",
+	buttonEventForward: "forward",
+})
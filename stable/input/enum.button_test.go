@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumButtonEvent(t *testing.T) {
+	var enum ButtonEventEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ButtonEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ButtonEvent.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if ButtonEvent.None != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.None, enum)
+	}
+
+	enum = ButtonEvent.Left
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"left"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"left"`, result)
+	}
+	json.Unmarshal([]byte(`"left"`), &enum)
+	if ButtonEvent.Left != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.Left, enum)
+	}
+
+	enum = ButtonEvent.Middle
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"middle"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"middle"`, result)
+	}
+	json.Unmarshal([]byte(`"middle"`), &enum)
+	if ButtonEvent.Middle != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.Middle, enum)
+	}
+
+	enum = ButtonEvent.Right
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"right"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"right"`, result)
+	}
+	json.Unmarshal([]byte(`"right"`), &enum)
+	if ButtonEvent.Right != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.Right, enum)
+	}
+
+	enum = ButtonEvent.Back
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"back"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"back"`, result)
+	}
+	json.Unmarshal([]byte(`"back"`), &enum)
+	if ButtonEvent.Back != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.Back, enum)
+	}
+
+	enum = ButtonEvent.Forward
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"forward"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"forward"`, result)
+	}
+	json.Unmarshal([]byte(`"forward"`), &enum)
+	if ButtonEvent.Forward != enum {
+		t.Errorf("Expected %d, got %d", ButtonEvent.Forward, enum)
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type keyEventEnum struct {
+	KeyDown    KeyEventEnum
+	KeyUp      KeyEventEnum
+	RawKeyDown KeyEventEnum
+	Char       KeyEventEnum
+}
+
+/*
+KeyEvent provides named access to the KeyEventEnum values.
+*/
+var KeyEvent = keyEventEnum{
+	KeyDown:    keyEventKeyDown,
+	KeyUp:      keyEventKeyUp,
+	RawKeyDown: keyEventRawKeyDown,
+	Char:       keyEventChar,
+}
+
+/*
+KeyEventEnum represents the Input.dispatchKeyEvent.type values.
+
+Type of the key event.
+
+Allowed values:
+  - KeyEvent.KeyDown    "keyDown"
+  - KeyEvent.KeyUp      "keyUp"
+  - KeyEvent.RawKeyDown "rawKeyDown"
+  - KeyEvent.Char       "char"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchKeyEvent
+*/
+type KeyEventEnum int
+
+/*
+String implements Stringer
+*/
+func (enum KeyEventEnum) String() string {
+	return _keyEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum KeyEventEnum) Unknown() bool {
+	return !_keyEventEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum KeyEventEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *KeyEventEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _keyEventEnums.Parse(val)
+	return nil
+}
+
+const (
+	// keyEventKeyDown represents the "keyDown" value.
+	keyEventKeyDown KeyEventEnum = iota + 1
+	// keyEventKeyUp represents the "keyUp" value.
+	keyEventKeyUp
+	// keyEventRawKeyDown represents the "rawKeyDown" value.
+	keyEventRawKeyDown
+	// keyEventChar represents the "char" value.
+	keyEventChar
+)
+
+var _keyEventEnums = enums.New(map[KeyEventEnum]string{
+	KeyEventEnum(0):    "",
+	keyEventKeyDown:    "keyDown",
+	keyEventKeyUp:      "keyUp",
+	keyEventRawKeyDown: "rawKeyDown",
+	keyEventChar:       "char",
+})
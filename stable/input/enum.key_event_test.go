@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumKeyEvent(t *testing.T) {
+	var enum KeyEventEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown KeyEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = KeyEvent.KeyDown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"keyDown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"keyDown"`, result)
+	}
+	json.Unmarshal([]byte(`"keyDown"`), &enum)
+	if KeyEvent.KeyDown != enum {
+		t.Errorf("Expected %d, got %d", KeyEvent.KeyDown, enum)
+	}
+
+	enum = KeyEvent.KeyUp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"keyUp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"keyUp"`, result)
+	}
+	json.Unmarshal([]byte(`"keyUp"`), &enum)
+	if KeyEvent.KeyUp != enum {
+		t.Errorf("Expected %d, got %d", KeyEvent.KeyUp, enum)
+	}
+
+	enum = KeyEvent.RawKeyDown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"rawKeyDown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"rawKeyDown"`, result)
+	}
+	json.Unmarshal([]byte(`"rawKeyDown"`), &enum)
+	if KeyEvent.RawKeyDown != enum {
+		t.Errorf("Expected %d, got %d", KeyEvent.RawKeyDown, enum)
+	}
+
+	enum = KeyEvent.Char
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"char"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"char"`, result)
+	}
+	json.Unmarshal([]byte(`"char"`), &enum)
+	if KeyEvent.Char != enum {
+		t.Errorf("Expected %d, got %d", KeyEvent.Char, enum)
+	}
+}
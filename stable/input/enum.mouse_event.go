@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type mouseEventEnum struct {
+	MousePressed  MouseEventEnum
+	MouseReleased MouseEventEnum
+	MouseMoved    MouseEventEnum
+	MouseWheel    MouseEventEnum
+}
+
+/*
+MouseEvent provides named access to the MouseEventEnum values.
+*/
+var MouseEvent = mouseEventEnum{
+	MousePressed:  mouseEventMousePressed,
+	MouseReleased: mouseEventMouseReleased,
+	MouseMoved:    mouseEventMouseMoved,
+	MouseWheel:    mouseEventMouseWheel,
+}
+
+/*
+MouseEventEnum represents the Input.dispatchMouseEvent.type values.
+
+Type of the mouse event.
+
+Allowed values:
+  - MouseEvent.MousePressed  "mousePressed"
+  - MouseEvent.MouseReleased "mouseReleased"
+  - MouseEvent.MouseMoved    "mouseMoved"
+  - MouseEvent.MouseWheel    "mouseWheel"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchMouseEvent
+*/
+type MouseEventEnum int
+
+/*
+String implements Stringer
+*/
+func (enum MouseEventEnum) String() string {
+	return _mouseEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MouseEventEnum) Unknown() bool {
+	return !_mouseEventEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum MouseEventEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *MouseEventEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _mouseEventEnums.Parse(val)
+	return nil
+}
+
+const (
+	// mouseEventMousePressed represents the "mousePressed" value.
+	mouseEventMousePressed MouseEventEnum = iota + 1
+	// mouseEventMouseReleased represents the "mouseReleased" value.
+	mouseEventMouseReleased
+	// mouseEventMouseMoved represents the "mouseMoved" value.
+	mouseEventMouseMoved
+	// mouseEventMouseWheel represents the "mouseWheel" value.
+	mouseEventMouseWheel
+)
+
+var _mouseEventEnums = enums.New(map[MouseEventEnum]string{
+	MouseEventEnum(0):       "",
+	mouseEventMousePressed:  "mousePressed",
+	mouseEventMouseReleased: "mouseReleased",
+	mouseEventMouseMoved:    "mouseMoved",
+	mouseEventMouseWheel:    "mouseWheel",
+})
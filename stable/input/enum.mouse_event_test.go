@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMouseEvent(t *testing.T) {
+	var enum MouseEventEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MouseEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = MouseEvent.MousePressed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mousePressed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mousePressed"`, result)
+	}
+	json.Unmarshal([]byte(`"mousePressed"`), &enum)
+	if MouseEvent.MousePressed != enum {
+		t.Errorf("Expected %d, got %d", MouseEvent.MousePressed, enum)
+	}
+
+	enum = MouseEvent.MouseReleased
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mouseReleased"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mouseReleased"`, result)
+	}
+	json.Unmarshal([]byte(`"mouseReleased"`), &enum)
+	if MouseEvent.MouseReleased != enum {
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseReleased, enum)
+	}
+
+	enum = MouseEvent.MouseMoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mouseMoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mouseMoved"`, result)
+	}
+	json.Unmarshal([]byte(`"mouseMoved"`), &enum)
+	if MouseEvent.MouseMoved != enum {
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseMoved, enum)
+	}
+
+	enum = MouseEvent.MouseWheel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mouseWheel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mouseWheel"`, result)
+	}
+	json.Unmarshal([]byte(`"mouseWheel"`), &enum)
+	if MouseEvent.MouseWheel != enum {
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseWheel, enum)
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type touchEventEnum struct {
+	TouchStart  TouchEventEnum
+	TouchEnd    TouchEventEnum
+	TouchMove   TouchEventEnum
+	TouchCancel TouchEventEnum
+}
+
+/*
+TouchEvent provides named access to the TouchEventEnum values.
+*/
+var TouchEvent = touchEventEnum{
+	TouchStart:  touchEventTouchStart,
+	TouchEnd:    touchEventTouchEnd,
+	TouchMove:   touchEventTouchMove,
+	TouchCancel: touchEventTouchCancel,
+}
+
+/*
+TouchEventEnum represents the Input.dispatchTouchEvent.type values.
+
+Type of the touch event. TouchEnd and TouchCancel must not contain any touch
+points, while TouchStart and TouchMove must contains at least one.
+
+Allowed values:
+  - TouchEvent.TouchStart  "touchStart"
+  - TouchEvent.TouchEnd    "touchEnd"
+  - TouchEvent.TouchMove   "touchMove"
+  - TouchEvent.TouchCancel "touchCancel"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchTouchEvent
+*/
+type TouchEventEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TouchEventEnum) String() string {
+	return _touchEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TouchEventEnum) Unknown() bool {
+	return !_touchEventEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TouchEventEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TouchEventEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _touchEventEnums.Parse(val)
+	return nil
+}
+
+const (
+	// touchEventTouchStart represents the "touchStart" value.
+	touchEventTouchStart TouchEventEnum = iota + 1
+	// touchEventTouchEnd represents the "touchEnd" value.
+	touchEventTouchEnd
+	// touchEventTouchMove represents the "touchMove" value.
+	touchEventTouchMove
+	// touchEventTouchCancel represents the "touchCancel" value.
+	touchEventTouchCancel
+)
+
+var _touchEventEnums = enums.New(map[TouchEventEnum]string{
+	TouchEventEnum(0):     "",
+	touchEventTouchStart:  "touchStart",
+	touchEventTouchEnd:    "touchEnd",
+	touchEventTouchMove:   "touchMove",
+	touchEventTouchCancel: "touchCancel",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumTouchEvent(t *testing.T) {
+	var enum TouchEventEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TouchEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = TouchEvent.TouchStart
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"touchStart"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"touchStart"`, result)
+	}
+	json.Unmarshal([]byte(`"touchStart"`), &enum)
+	if TouchEvent.TouchStart != enum {
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchStart, enum)
+	}
+
+	enum = TouchEvent.TouchEnd
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"touchEnd"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"touchEnd"`, result)
+	}
+	json.Unmarshal([]byte(`"touchEnd"`), &enum)
+	if TouchEvent.TouchEnd != enum {
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchEnd, enum)
+	}
+
+	enum = TouchEvent.TouchMove
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"touchMove"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"touchMove"`, result)
+	}
+	json.Unmarshal([]byte(`"touchMove"`), &enum)
+	if TouchEvent.TouchMove != enum {
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchMove, enum)
+	}
+
+	enum = TouchEvent.TouchCancel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"touchCancel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"touchCancel"`, result)
+	}
+	json.Unmarshal([]byte(`"touchCancel"`), &enum)
+	if TouchEvent.TouchCancel != enum {
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchCancel, enum)
+	}
+}
@@ -0,0 +1,94 @@
+package chrome
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/mkenney/go-chrome/stable/socket"
+)
+
+/*
+Chromium defines an interface for interacting with Chromium based web browsers
+*/
+type Chromium interface {
+	// Address returns the domain to use for accessing Chrome sockets (e.g.
+	// 'localhost'). Should return a sane default value such as 'localhost'.
+	Address() string
+
+	// Binary returns the path to the Chromium binary. Should return a sane
+	// default value such as '/usr/bin/google-chrome'.
+	Binary() string
+
+	// Browser returns a connected socket bound to the browser target rather
+	// than a page, for browser-wide operations such as Target, Browser,
+	// SystemInfo and Tracing commands.
+	Browser() (*socket.Socket, error)
+
+	// Close ends the Chromium process and cleans up.
+	Close() error
+
+	// DetectCapabilities reads and caches the browser's protocol description
+	// so the browser connection and tabs fail fast on unsupported commands
+	// and events.
+	DetectCapabilities(ctx context.Context) (*socket.Capabilities, error)
+
+	// GetTab returns an open Tabber instance, or an error if the requested tab
+	// does not exist.
+	GetTab(tabID string) (tab Tabber, err error)
+
+	// RemoveTab removes tab reference from chrome tabs list.
+	RemoveTab(tab *Tab)
+
+	// DebuggingAddress returns the address that the remote debugging protocol
+	// is available on. Should return a sane default value such as '0.0.0.0'.
+	DebuggingAddress() string
+
+	// DebuggingPort is the port number that the remote debugging protocol is
+	// available on. Should return a sane default value such as 9222.
+	DebuggingPort() int
+
+	// Args returns a ChromiumFlags interface used to define and manage CLI
+	// arguments to the Chromium binary. Only used when starting the chromium
+	// system process.
+	Flags() ChromiumFlags
+
+	// Launch launches the Chromium process and returns the connected Chromium
+	// struct.
+	Launch() error
+
+	// NewTab spawns a new tab and returns a reference to it.
+	NewTab(url string) (*Tab, error)
+
+	// OnNewPage adds a handler that is called when a new page target is added
+	// to the list of open tabs, including popups opened by the page and tabs
+	// created with NewTab.
+	OnNewPage(callback func(tab *Tab))
+
+	// OnUnexpectedExit adds a handler that is called when the Chromium
+	// process exits without being stopped by Close.
+	OnUnexpectedExit(callback func(exit *ProcessExit))
+
+	// Port returns the port number the developer tools endpoints will listen
+	// on. Should return a sane default value such as 9222.
+	Port() int
+
+	// Query queries the developer tools endpoints and returns JSON data in the
+	// provided struct.
+	Query(path string, params url.Values, msg interface{}) (interface{}, error)
+
+	// STDERR returns a string defining the location to write STDERR output.
+	STDERR() string
+
+	// STDOUT returns a string defining the location to write STDOUT output.
+	STDOUT() string
+
+	// Tabs returns the list of the currently open tabs.
+	Tabs() []*Tab
+
+	// Version returns Chromium version data.
+	Version() (*Version, error)
+
+	// Workdir returns the path of the Chromium working directory. Should return
+	// a sane default value such as '/tmp/headless-chrome'.
+	Workdir() string
+}
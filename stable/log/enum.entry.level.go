@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package log
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type levelEnum struct {
+	Verbose LevelEnum
+	Info    LevelEnum
+	Warning LevelEnum
+	Error   LevelEnum
+}
+
+/*
+Level provides named access to the LevelEnum values.
+*/
+var Level = levelEnum{
+	Verbose: levelVerbose,
+	Info:    levelInfo,
+	Warning: levelWarning,
+	Error:   levelError,
+}
+
+/*
+LevelEnum represents the Log.LogEntry.level values.
+
+Log entry severity.
+
+Allowed values:
+  - Level.Verbose "verbose"
+  - Level.Info    "info"
+  - Level.Warning "warning"
+  - Level.Error   "error"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#type-LogEntry
+*/
+type LevelEnum int
+
+/*
+String implements Stringer
+*/
+func (enum LevelEnum) String() string {
+	return _levelEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum LevelEnum) Unknown() bool {
+	return !_levelEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum LevelEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *LevelEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _levelEnums.Parse(val)
+	return nil
+}
+
+const (
+	// levelVerbose represents the "verbose" value.
+	levelVerbose LevelEnum = iota + 1
+	// levelInfo represents the "info" value.
+	levelInfo
+	// levelWarning represents the "warning" value.
+	levelWarning
+	// levelError represents the "error" value.
+	levelError
+)
+
+var _levelEnums = enums.New(map[LevelEnum]string{
+	LevelEnum(0): "",
+	levelVerbose: "verbose",
+	levelInfo:    "info",
+	levelWarning: "warning",
+	levelError:   "error",
+})
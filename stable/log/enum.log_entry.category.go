@@ -0,0 +1,76 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package log
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type categoryEnum struct {
+	Cors CategoryEnum
+}
+
+/*
+Category provides named access to the CategoryEnum values.
+*/
+var Category = categoryEnum{
+	Cors: categoryCors,
+}
+
+/*
+CategoryEnum represents the Log.LogEntry.category values.
+
+Allowed values:
+  - Category.Cors "cors"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#type-LogEntry
+*/
+type CategoryEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CategoryEnum) String() string {
+	return _categoryEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CategoryEnum) Unknown() bool {
+	return !_categoryEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CategoryEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CategoryEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _categoryEnums.Parse(val)
+	return nil
+}
+
+const (
+	// categoryCors represents the "cors" value.
+	categoryCors CategoryEnum = iota + 1
+)
+
+var _categoryEnums = enums.New(map[CategoryEnum]string{
+	CategoryEnum(0): "",
+	categoryCors:    "cors",
+})
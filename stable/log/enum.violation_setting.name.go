@@ -0,0 +1,114 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package log
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type nameEnum struct {
+	LongTask          NameEnum
+	LongLayout        NameEnum
+	BlockedEvent      NameEnum
+	BlockedParser     NameEnum
+	DiscouragedAPIUse NameEnum
+	Handler           NameEnum
+	RecurringHandler  NameEnum
+}
+
+/*
+Name provides named access to the NameEnum values.
+*/
+var Name = nameEnum{
+	LongTask:          nameLongTask,
+	LongLayout:        nameLongLayout,
+	BlockedEvent:      nameBlockedEvent,
+	BlockedParser:     nameBlockedParser,
+	DiscouragedAPIUse: nameDiscouragedAPIUse,
+	Handler:           nameHandler,
+	RecurringHandler:  nameRecurringHandler,
+}
+
+/*
+NameEnum represents the Log.ViolationSetting.name values.
+
+Violation type.
+
+Allowed values:
+  - Name.LongTask          "longTask"
+  - Name.LongLayout        "longLayout"
+  - Name.BlockedEvent      "blockedEvent"
+  - Name.BlockedParser     "blockedParser"
+  - Name.DiscouragedAPIUse "discouragedAPIUse"
+  - Name.Handler           "handler"
+  - Name.RecurringHandler  "recurringHandler"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#type-ViolationSetting
+*/
+type NameEnum int
+
+/*
+String implements Stringer
+*/
+func (enum NameEnum) String() string {
+	return _nameEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum NameEnum) Unknown() bool {
+	return !_nameEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum NameEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *NameEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _nameEnums.Parse(val)
+	return nil
+}
+
+const (
+	// nameLongTask represents the "longTask" value.
+	nameLongTask NameEnum = iota + 1
+	// nameLongLayout represents the "longLayout" value.
+	nameLongLayout
+	// nameBlockedEvent represents the "blockedEvent" value.
+	nameBlockedEvent
+	// nameBlockedParser represents the "blockedParser" value.
+	nameBlockedParser
+	// nameDiscouragedAPIUse represents the "discouragedAPIUse" value.
+	nameDiscouragedAPIUse
+	// nameHandler represents the "handler" value.
+	nameHandler
+	// nameRecurringHandler represents the "recurringHandler" value.
+	nameRecurringHandler
+)
+
+var _nameEnums = enums.New(map[NameEnum]string{
+	NameEnum(0):           "",
+	nameLongTask:          "longTask",
+	nameLongLayout:        "longLayout",
+	nameBlockedEvent:      "blockedEvent",
+	nameBlockedParser:     "blockedParser",
+	nameDiscouragedAPIUse: "discouragedAPIUse",
+	nameHandler:           "handler",
+	nameRecurringHandler:  "recurringHandler",
+})
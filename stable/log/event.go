@@ -0,0 +1,16 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package log
+
+/*
+EntryAddedEvent represents Log.entryAdded event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#event-entryAdded
+*/
+type EntryAddedEvent struct {
+	// The entry.
+	Entry *Entry `json:"entry"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,364 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/mkenney/go-chrome/stable/socket"
+)
+
+func NewMockSocket(url *url.URL) *MockSocket {
+	mockSocket := &MockSocket{
+		url:      url,
+		errCh:    make(chan error, 3),
+		handlers: socket.NewEventHandlerMap(),
+	}
+
+	mockSocket.browser = &socket.BrowserProtocol{Socket: mockSocket}
+	mockSocket.debugger = &socket.DebuggerProtocol{Socket: mockSocket}
+	mockSocket.domDebugger = &socket.DOMDebuggerProtocol{Socket: mockSocket}
+	mockSocket.dom = &socket.DOMProtocol{Socket: mockSocket}
+	mockSocket.emulation = &socket.EmulationProtocol{Socket: mockSocket}
+	mockSocket.fetch = &socket.FetchProtocol{Socket: mockSocket}
+	mockSocket.input = &socket.InputProtocol{Socket: mockSocket}
+	mockSocket.io = &socket.IOProtocol{Socket: mockSocket}
+	mockSocket.log = &socket.LogProtocol{Socket: mockSocket}
+	mockSocket.network = &socket.NetworkProtocol{Socket: mockSocket}
+	mockSocket.page = &socket.PageProtocol{Socket: mockSocket}
+	mockSocket.performance = &socket.PerformanceProtocol{Socket: mockSocket}
+	mockSocket.profiler = &socket.ProfilerProtocol{Socket: mockSocket}
+	mockSocket.runtime = &socket.RuntimeProtocol{Socket: mockSocket}
+	mockSocket.security = &socket.SecurityProtocol{Socket: mockSocket}
+	mockSocket.target = &socket.TargetProtocol{Socket: mockSocket}
+	mockSocket.tracing = &socket.TracingProtocol{Socket: mockSocket}
+
+	return mockSocket
+}
+
+/*
+Socket is a Socketer implementation.
+*/
+type MockSocket struct {
+	url       *url.URL
+	commandID int
+	crashed   bool
+	errCh     chan error
+	handlers  socket.EventHandlerMapper
+
+	// Protocol interfaces for the API.
+	browser     *socket.BrowserProtocol
+	debugger    *socket.DebuggerProtocol
+	domDebugger *socket.DOMDebuggerProtocol
+	dom         *socket.DOMProtocol
+	emulation   *socket.EmulationProtocol
+	fetch       *socket.FetchProtocol
+	input       *socket.InputProtocol
+	io          *socket.IOProtocol
+	log         *socket.LogProtocol
+	network     *socket.NetworkProtocol
+	page        *socket.PageProtocol
+	performance *socket.PerformanceProtocol
+	profiler    *socket.ProfilerProtocol
+	runtime     *socket.RuntimeProtocol
+	security    *socket.SecurityProtocol
+	target      *socket.TargetProtocol
+	tracing     *socket.TracingProtocol
+}
+
+/*
+AddEventHandler is a Socketer implementation.
+*/
+func (socket *MockSocket) AddEventHandler(
+	handler socket.EventHandler,
+) {
+	socket.handlers.Add(handler)
+}
+
+/*
+Capabilities is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Capabilities() *socket.Capabilities {
+	return nil
+}
+
+/*
+Call is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	command := socket.NewCommand(mockSocket, method, params)
+	response, err := mockSocket.SendCommandContext(ctx, command)
+	if nil != err {
+		return err
+	}
+	if nil != response.Error && 0 != response.Error.Code {
+		return response.Error
+	}
+	if nil != result && len(response.Result) > 0 {
+		return json.Unmarshal(response.Result, result)
+	}
+	return nil
+}
+
+/*
+Trigger synchronously delivers an event to the registered handlers.
+*/
+func (socket *MockSocket) Trigger(response *socket.Response) {
+	if "Inspector.targetCrashed" == response.Method {
+		socket.crashed = true
+	}
+	handlers, _ := socket.handlers.Get(response.Method)
+	for _, handler := range handlers {
+		handler.Handle(response)
+	}
+}
+
+/*
+Crashed is a Socketer implementation.
+*/
+func (socket *MockSocket) Crashed() bool {
+	return socket.crashed
+}
+
+/*
+CurCommandID is a Socketer implementation.
+*/
+func (socket *MockSocket) CurCommandID() int {
+	id := socket.commandID
+	return id
+}
+
+func (socket *MockSocket) Errors() chan error {
+	return socket.errCh
+}
+
+/*
+Listen starts the socket read loop and delivers messages to handleResponse() and
+handleEvent() as appropriate.
+
+Listen is a Socketer implementation.
+*/
+func (socket *MockSocket) Listen() error {
+	return nil
+}
+
+/*
+NextCommandID generates and returns the next command ID.
+*/
+func (socket *MockSocket) NextCommandID() int {
+	socket.commandID++
+	return socket.commandID
+}
+
+/*
+RemoveEventHandler is a Socketer implementation.
+*/
+func (socket *MockSocket) RemoveEventHandler(
+	handler socket.EventHandler,
+) error {
+	return nil
+}
+
+/*
+SendCommand is a Socketer implementation.
+*/
+func (socket *MockSocket) SendCommand(command socket.Commander) chan *socket.Response {
+	return command.Response()
+}
+
+/*
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *MockSocket) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	select {
+	case response := <-command.Response():
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+/*
+SetDispatchPolicy is a Socketer implementation.
+*/
+func (socket *MockSocket) SetDispatchPolicy(policy *socket.DispatchPolicy) {
+}
+
+/*
+SetReconnectPolicy is a Socketer implementation.
+*/
+func (socket *MockSocket) SetReconnectPolicy(policy *socket.ReconnectPolicy) {
+}
+
+/*
+State is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) State() socket.ConnectionState {
+	return socket.ConnectionConnected
+}
+
+/*
+Stop is a Socketer implementation.
+*/
+func (socket *MockSocket) Stop() {
+}
+
+/*
+Subscribe is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Subscribe(method string) (<-chan json.RawMessage, func()) {
+	events := make(chan json.RawMessage, 10)
+	handler := socket.NewEventHandler(method, func(response *socket.Response) {
+		events <- response.Params
+	})
+	mockSocket.AddEventHandler(handler)
+	return events, func() {
+		mockSocket.RemoveEventHandler(handler)
+	}
+}
+
+/*
+WaitFor is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *socket.Waiter[json.RawMessage] {
+	return socket.WaitForEvent(ctx, func(callback func(params json.RawMessage)) *socket.Listener {
+		handler := socket.NewEventHandler(eventName, func(response *socket.Response) {
+			callback(response.Params)
+		})
+		mockSocket.AddEventHandler(handler)
+		return socket.NewListener(mockSocket, handler)
+	}, predicate)
+}
+
+/*
+Supports is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Supports(name string) bool {
+	return true
+}
+
+/*
+URL returns the URL of the websocket connection.
+*/
+func (socket *MockSocket) URL() *url.URL {
+	return socket.url
+}
+
+/*
+Browser is a Protocoller implementation.
+*/
+func (socket *MockSocket) Browser() *socket.BrowserProtocol {
+	return socket.browser
+}
+
+/*
+Debugger is a Protocoller implementation.
+*/
+func (socket *MockSocket) Debugger() *socket.DebuggerProtocol {
+	return socket.debugger
+}
+
+/*
+DOMDebugger is a Protocoller implementation.
+*/
+func (socket *MockSocket) DOMDebugger() *socket.DOMDebuggerProtocol {
+	return socket.domDebugger
+}
+
+/*
+DOM is a Protocoller implementation.
+*/
+func (socket *MockSocket) DOM() *socket.DOMProtocol {
+	return socket.dom
+}
+
+/*
+Emulation is a Protocoller implementation.
+*/
+func (socket *MockSocket) Emulation() *socket.EmulationProtocol {
+	return socket.emulation
+}
+
+/*
+Fetch is a Protocoller implementation.
+*/
+func (socket *MockSocket) Fetch() *socket.FetchProtocol {
+	return socket.fetch
+}
+
+/*
+Input is a Protocoller implementation.
+*/
+func (socket *MockSocket) Input() *socket.InputProtocol {
+	return socket.input
+}
+
+/*
+IO is a Protocoller implementation.
+*/
+func (socket *MockSocket) IO() *socket.IOProtocol {
+	return socket.io
+}
+
+/*
+Log is a Protocoller implementation.
+*/
+func (socket *MockSocket) Log() *socket.LogProtocol {
+	return socket.log
+}
+
+/*
+Network is a Protocoller implementation.
+*/
+func (socket *MockSocket) Network() *socket.NetworkProtocol {
+	return socket.network
+}
+
+/*
+Page is a Protocoller implementation.
+*/
+func (socket *MockSocket) Page() *socket.PageProtocol {
+	return socket.page
+}
+
+/*
+Performance is a Protocoller implementation.
+*/
+func (socket *MockSocket) Performance() *socket.PerformanceProtocol {
+	return socket.performance
+}
+
+/*
+Profiler is a Protocoller implementation.
+*/
+func (socket *MockSocket) Profiler() *socket.ProfilerProtocol {
+	return socket.profiler
+}
+
+/*
+Runtime is a Protocoller implementation.
+*/
+func (socket *MockSocket) Runtime() *socket.RuntimeProtocol {
+	return socket.runtime
+}
+
+/*
+Security is a Protocoller implementation.
+*/
+func (socket *MockSocket) Security() *socket.SecurityProtocol {
+	return socket.security
+}
+
+/*
+Target is a Protocoller implementation.
+*/
+func (socket *MockSocket) Target() *socket.TargetProtocol {
+	return socket.target
+}
+
+/*
+Tracing is a Protocoller implementation.
+*/
+func (socket *MockSocket) Tracing() *socket.TracingProtocol {
+	return socket.tracing
+}
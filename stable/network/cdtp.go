@@ -0,0 +1,1329 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package network provides type definitions for use with the Chrome Network protocol
+
+Network domain allows tracking network activities of the page. It exposes
+information about http, file, data and other requests and responses, their
+headers, bodies, timing, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/
+*/
+package network
+
+import (
+	"github.com/mkenney/go-chrome/stable/io"
+	"github.com/mkenney/go-chrome/stable/page"
+	"github.com/mkenney/go-chrome/stable/runtime"
+	"github.com/mkenney/go-chrome/stable/security"
+)
+
+/*
+LoaderID represents the Network.LoaderId type.
+
+Unique loader identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoaderId
+*/
+type LoaderID string
+
+/*
+RequestID represents the Network.RequestId type.
+
+Unique network request identifier. Note that this does not identify individual
+HTTP requests that are part of a network request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-RequestId
+*/
+type RequestID string
+
+/*
+InterceptionID represents the Network.InterceptionId type.
+
+Unique intercepted request identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-InterceptionId
+*/
+type InterceptionID string
+
+/*
+TimeSinceEpoch represents the Network.TimeSinceEpoch type.
+
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+MonotonicTime represents the Network.MonotonicTime type.
+
+Monotonically increasing time in seconds since an arbitrary point in the past.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-MonotonicTime
+*/
+type MonotonicTime float64
+
+/*
+Headers represents the Network.Headers type.
+
+Request / response headers as keys / values of JSON object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Headers
+*/
+type Headers map[string]string
+
+/*
+ResourceTiming represents the Network.ResourceTiming type.
+
+Timing information for the request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ResourceTiming
+*/
+type ResourceTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
+
+	// Started resolving proxy.
+	ProxyStart float64 `json:"proxyStart"`
+
+	// Finished resolving proxy.
+	ProxyEnd float64 `json:"proxyEnd"`
+
+	// Started DNS address resolve.
+	DNSStart float64 `json:"dnsStart"`
+
+	// Finished DNS address resolve.
+	DNSEnd float64 `json:"dnsEnd"`
+
+	// Started connecting to the remote host.
+	ConnectStart float64 `json:"connectStart"`
+
+	// Connected to the remote host.
+	ConnectEnd float64 `json:"connectEnd"`
+
+	// Started SSL handshake.
+	SSLStart float64 `json:"sslStart"`
+
+	// Finished SSL handshake.
+	SSLEnd float64 `json:"sslEnd"`
+
+	// Started sending request.
+	SendStart float64 `json:"sendStart"`
+
+	// Finished sending request.
+	SendEnd float64 `json:"sendEnd"`
+
+	// Finished receiving response headers.
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+/*
+PostDataEntry represents the Network.PostDataEntry type.
+
+# Post data entry for HTTP request
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-PostDataEntry
+*/
+type PostDataEntry struct {
+	// Optional.
+	Bytes string `json:"bytes,omitempty"`
+}
+
+/*
+Request represents the Network.Request type.
+
+HTTP request data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Request
+*/
+type Request struct {
+	// Request URL (without fragment).
+	URL string `json:"url"`
+
+	// Optional. Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+
+	// HTTP request method.
+	Method string `json:"method"`
+
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+
+	// Optional. True when the request has POST data. Note that postData might
+	// still be omitted when this flag is true when the data is too long.
+	HasPostData bool `json:"hasPostData,omitempty"`
+
+	// Optional. The mixed content type of the request. Allowed values:
+	//   - security.MixedContentType.Blockable
+	//   - security.MixedContentType.OptionallyBlockable
+	//   - security.MixedContentType.None
+	MixedContentType security.MixedContentTypeEnum `json:"mixedContentType,omitempty"`
+
+	// Priority of the resource request at the time request is sent. Allowed values:
+	//   - ResourcePriority.VeryLow
+	//   - ResourcePriority.Low
+	//   - ResourcePriority.Medium
+	//   - ResourcePriority.High
+	//   - ResourcePriority.VeryHigh
+	InitialPriority ResourcePriorityEnum `json:"initialPriority"`
+
+	// The referrer policy of the request, as defined in
+	// https://www.w3.org/TR/referrer-policy/ Allowed values:
+	//   - ReferrerPolicy.UnsafeURL
+	//   - ReferrerPolicy.NoReferrerWhenDowngrade
+	//   - ReferrerPolicy.NoReferrer
+	//   - ReferrerPolicy.Origin
+	//   - ReferrerPolicy.OriginWhenCrossOrigin
+	//   - ReferrerPolicy.SameOrigin
+	//   - ReferrerPolicy.StrictOrigin
+	//   - ReferrerPolicy.StrictOriginWhenCrossOrigin
+	ReferrerPolicy ReferrerPolicyEnum `json:"referrerPolicy"`
+
+	// Optional. Whether is loaded via link preload.
+	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+}
+
+/*
+SignedCertificateTimestamp represents the Network.SignedCertificateTimestamp
+type.
+
+Details of a signed certificate timestamp (SCT).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedCertificateTimestamp
+*/
+type SignedCertificateTimestamp struct {
+	// Validation status.
+	Status string `json:"status"`
+
+	// Origin.
+	Origin string `json:"origin"`
+
+	// Log name / description.
+	LogDescription string `json:"logDescription"`
+
+	// Log ID.
+	LogID string `json:"logId"`
+
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
+
+	// Hash algorithm.
+	HashAlgorithm string `json:"hashAlgorithm"`
+
+	// Signature algorithm.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+
+	// Signature data.
+	SignatureData string `json:"signatureData"`
+}
+
+/*
+SecurityDetails represents the Network.SecurityDetails type.
+
+Security details about a request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SecurityDetails
+*/
+type SecurityDetails struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+
+	// Optional. (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+
+	// Cipher name.
+	Cipher string `json:"cipher"`
+
+	// Optional. TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+
+	// Certificate ID value.
+	CertificateID security.CertificateID `json:"certificateId"`
+
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+
+	// Subject Alternative Name (SAN) DNS names and IP addresses.
+	SanList []string `json:"sanList"`
+
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+
+	// Certificate valid from date.
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+
+	// Certificate valid to (expiration) date
+	ValidTo TimeSinceEpoch `json:"validTo"`
+
+	// List of signed certificate timestamps (SCTs).
+	SignedCertificateTimestampList []*SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+
+	// Whether the request complied with Certificate Transparency policy Allowed values:
+	//   - CertificateTransparencyCompliance.Unknown
+	//   - CertificateTransparencyCompliance.NotCompliant
+	//   - CertificateTransparencyCompliance.Compliant
+	CertificateTransparencyCompliance CertificateTransparencyComplianceEnum `json:"certificateTransparencyCompliance"`
+
+	// Optional. The signature algorithm used by the server in the TLS server
+	// signature, represented as a TLS SignatureScheme code point. Omitted if not
+	// applicable or not known.
+	ServerSignatureAlgorithm int `json:"serverSignatureAlgorithm,omitempty"`
+
+	// Whether the connection used Encrypted ClientHello
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+/*
+CorsErrorStatus represents the Network.CorsErrorStatus type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CorsErrorStatus
+*/
+type CorsErrorStatus struct {
+	// Allowed values:
+	//   - CorsError.DisallowedByMode
+	//   - CorsError.InvalidResponse
+	//   - CorsError.WildcardOriginNotAllowed
+	//   - CorsError.MissingAllowOriginHeader
+	//   - CorsError.MultipleAllowOriginValues
+	//   - CorsError.InvalidAllowOriginValue
+	//   - CorsError.AllowOriginMismatch
+	//   - CorsError.InvalidAllowCredentials
+	//   - CorsError.CorsDisabledScheme
+	//   - CorsError.PreflightInvalidStatus
+	//   - CorsError.PreflightDisallowedRedirect
+	//   - CorsError.PreflightWildcardOriginNotAllowed
+	//   - CorsError.PreflightMissingAllowOriginHeader
+	//   - CorsError.PreflightMultipleAllowOriginValues
+	//   - CorsError.PreflightInvalidAllowOriginValue
+	//   - CorsError.PreflightAllowOriginMismatch
+	//   - CorsError.PreflightInvalidAllowCredentials
+	//   - CorsError.PreflightMissingAllowExternal
+	//   - CorsError.PreflightInvalidAllowExternal
+	//   - CorsError.PreflightMissingAllowPrivateNetwork
+	//   - CorsError.PreflightInvalidAllowPrivateNetwork
+	//   - CorsError.InvalidAllowMethodsPreflightResponse
+	//   - CorsError.InvalidAllowHeadersPreflightResponse
+	//   - CorsError.MethodDisallowedByPreflightResponse
+	//   - CorsError.HeaderDisallowedByPreflightResponse
+	//   - CorsError.RedirectContainsCredentials
+	//   - CorsError.InsecurePrivateNetwork
+	//   - CorsError.InvalidPrivateNetworkAccess
+	//   - CorsError.UnexpectedPrivateNetworkAccess
+	//   - CorsError.NoCorsRedirectModeNotFollow
+	//   - CorsError.PreflightMissingPrivateNetworkAccessID
+	//   - CorsError.PreflightMissingPrivateNetworkAccessName
+	//   - CorsError.PrivateNetworkAccessPermissionUnavailable
+	//   - CorsError.PrivateNetworkAccessPermissionDenied
+	//   - CorsError.LocalNetworkAccessPermissionDenied
+	CorsError CorsErrorEnum `json:"corsError"`
+
+	FailedParameter string `json:"failedParameter"`
+}
+
+/*
+TrustTokenParams represents the Network.TrustTokenParams type.
+
+Determines what type of Trust Token operation is executed and depending on the
+type, some additional parameters. The values are specified in
+third_party/blink/renderer/core/fetch/trust_token.idl. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TrustTokenParams
+*/
+type TrustTokenParams struct {
+	// Allowed values:
+	//   - TrustTokenOperationType.Issuance
+	//   - TrustTokenOperationType.Redemption
+	//   - TrustTokenOperationType.Signing
+	Operation TrustTokenOperationTypeEnum `json:"operation"`
+
+	// Only set for "token-redemption" operation and determine whether to request a
+	// fresh SRR or use a still valid cached SRR. Allowed values:
+	//   - RefreshPolicy.UseCached
+	//   - RefreshPolicy.Refresh
+	RefreshPolicy RefreshPolicyEnum `json:"refreshPolicy"`
+
+	// Optional. Origins of issuers from whom to request tokens or redemption
+	// records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+/*
+ServiceWorkerRouterInfo represents the Network.ServiceWorkerRouterInfo type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ServiceWorkerRouterInfo
+*/
+type ServiceWorkerRouterInfo struct {
+	// Optional. ID of the rule matched. If there is a matched rule, this field
+	// will be set, otherwiser no value will be set.
+	RuleIDMatched int `json:"ruleIdMatched,omitempty"`
+
+	// Optional. The router source of the matched rule. If there is a matched rule,
+	// this field will be set, otherwise no value will be set. Allowed values:
+	//   - ServiceWorkerRouterSource.Network
+	//   - ServiceWorkerRouterSource.Cache
+	//   - ServiceWorkerRouterSource.FetchEvent
+	//   - ServiceWorkerRouterSource.RaceNetworkAndFetchHandler
+	//   - ServiceWorkerRouterSource.RaceNetworkAndCache
+	MatchedSourceType ServiceWorkerRouterSourceEnum `json:"matchedSourceType,omitempty"`
+
+	// Optional. The actual router source used. Allowed values:
+	//   - ServiceWorkerRouterSource.Network
+	//   - ServiceWorkerRouterSource.Cache
+	//   - ServiceWorkerRouterSource.FetchEvent
+	//   - ServiceWorkerRouterSource.RaceNetworkAndFetchHandler
+	//   - ServiceWorkerRouterSource.RaceNetworkAndCache
+	ActualSourceType ServiceWorkerRouterSourceEnum `json:"actualSourceType,omitempty"`
+}
+
+/*
+Response represents the Network.Response type.
+
+HTTP response data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Response
+*/
+type Response struct {
+	// Response URL. This URL can be different from CachedResource.url in case of
+	// redirect.
+	URL string `json:"url"`
+
+	// HTTP response status code.
+	Status int `json:"status"`
+
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+
+	// Optional. Refined HTTP request headers that were actually transmitted over
+	// the network.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+
+	// Specifies whether physical connection was actually reused for this request.
+	ConnectionReused bool `json:"connectionReused"`
+
+	// Physical connection id that was actually used for this request.
+	ConnectionID float64 `json:"connectionId"`
+
+	// Optional. Remote IP address.
+	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+
+	// Optional. Remote port.
+	RemotePort int `json:"remotePort,omitempty"`
+
+	// Optional. Specifies that the request was served from the disk cache.
+	FromDiskCache bool `json:"fromDiskCache,omitempty"`
+
+	// Optional. Specifies that the request was served from the ServiceWorker.
+	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
+
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache bool `json:"fromPrefetchCache,omitempty"`
+
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromEarlyHints bool `json:"fromEarlyHints,omitempty"`
+
+	// Total number of bytes received for this request so far.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+
+	// Optional. Timing information for the given request.
+	Timing *ResourceTiming `json:"timing,omitempty"`
+
+	// Optional. Response source of response from ServiceWorker. Allowed values:
+	//   - ServiceWorkerResponseSource.CacheStorage
+	//   - ServiceWorkerResponseSource.HTTPCache
+	//   - ServiceWorkerResponseSource.FallbackCode
+	//   - ServiceWorkerResponseSource.Network
+	ServiceWorkerResponseSource ServiceWorkerResponseSourceEnum `json:"serviceWorkerResponseSource,omitempty"`
+
+	// Optional. The time at which the returned response was generated.
+	ResponseTime TimeSinceEpoch `json:"responseTime,omitempty"`
+
+	// Optional. Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+
+	// Optional. Protocol used to fetch this request.
+	Protocol string `json:"protocol,omitempty"`
+
+	// Security state of the request resource. Allowed values:
+	//   - security.State.Unknown
+	//   - security.State.Neutral
+	//   - security.State.Insecure
+	//   - security.State.Secure
+	//   - security.State.Info
+	//   - security.State.InsecureBroken
+	SecurityState security.StateEnum `json:"securityState"`
+
+	// Optional. Security details for the request.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+}
+
+/*
+WebSocketRequest represents the Network.WebSocketRequest type.
+
+WebSocket request data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketRequest
+*/
+type WebSocketRequest struct {
+	// HTTP request headers.
+	Headers Headers `json:"headers"`
+}
+
+/*
+WebSocketResponse represents the Network.WebSocketResponse type.
+
+WebSocket response data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketResponse
+*/
+type WebSocketResponse struct {
+	// HTTP response status code.
+	Status int `json:"status"`
+
+	// HTTP response status text.
+	StatusText string `json:"statusText"`
+
+	// HTTP response headers.
+	Headers Headers `json:"headers"`
+
+	// Optional. HTTP response headers text.
+	HeadersText string `json:"headersText,omitempty"`
+
+	// Optional. HTTP request headers.
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
+
+	// Optional. HTTP request headers text.
+	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+}
+
+/*
+WebSocketFrame represents the Network.WebSocketFrame type.
+
+WebSocket message data. This represents an entire WebSocket message, not just a
+fragmented frame as the name suggests.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-WebSocketFrame
+*/
+type WebSocketFrame struct {
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
+
+	// WebSocket message mask.
+	Mask bool `json:"mask"`
+
+	// WebSocket message payload data. If the opcode is 1, this is a text message
+	// and payloadData is a UTF-8 string. If the opcode isn't 1, then payloadData
+	// is a base64 encoded string representing binary data.
+	PayloadData string `json:"payloadData"`
+}
+
+/*
+CachedResource represents the Network.CachedResource type.
+
+Information about the cached resource.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CachedResource
+*/
+type CachedResource struct {
+	// Resource URL. This is the url of the original network request.
+	URL string `json:"url"`
+
+	// Type of this resource. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	Type page.ResourceTypeEnum `json:"type"`
+
+	// Optional. Cached response data.
+	Response *Response `json:"response,omitempty"`
+
+	// Cached response body size.
+	BodySize float64 `json:"bodySize"`
+}
+
+/*
+Initiator represents the Network.Initiator type.
+
+Information about the request initiator.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Initiator
+*/
+type Initiator struct {
+	// Type of this initiator. Allowed values:
+	//   - InitiatorType.Parser
+	//   - InitiatorType.Script
+	//   - InitiatorType.Preload
+	//   - InitiatorType.SignedExchange
+	//   - InitiatorType.Preflight
+	//   - InitiatorType.Other
+	Type InitiatorTypeEnum `json:"type"`
+
+	// Optional. Initiator JavaScript stack trace, set for Script only. Requires
+	// the Debugger domain to be enabled.
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+
+	// Optional. Initiator URL, set for Parser type or for Script type (when script
+	// is importing module) or for SignedExchange type.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Initiator line number, set for Parser type or for Script type
+	// (when script is importing module) (0-based).
+	LineNumber float64 `json:"lineNumber,omitempty"`
+
+	// Optional. Initiator column number, set for Parser type or for Script type
+	// (when script is importing module) (0-based).
+	ColumnNumber float64 `json:"columnNumber,omitempty"`
+
+	// Optional. Set if another request triggered this request (e.g. preflight).
+	RequestID RequestID `json:"requestId,omitempty"`
+}
+
+/*
+CookiePartitionKey represents the Network.CookiePartitionKey type.
+
+cookiePartitionKey object The representation of the components of the key that
+are created by the cookiePartitionKey class contained in
+net/cookies/cookie_partition_key.h. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CookiePartitionKey
+*/
+type CookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start of the
+	// request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+
+	// Indicates if the cookie has any ancestors that are cross-site to the
+	// topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+/*
+Cookie represents the Network.Cookie type.
+
+# Cookie object
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Cookie
+*/
+type Cookie struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Cookie domain.
+	Domain string `json:"domain"`
+
+	// Cookie path.
+	Path string `json:"path"`
+
+	// Cookie expiration date as the number of seconds since the UNIX epoch.
+	Expires float64 `json:"expires"`
+
+	// Cookie size.
+	Size int `json:"size"`
+
+	// True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly"`
+
+	// True if cookie is secure.
+	Secure bool `json:"secure"`
+
+	// True in case of session cookie.
+	Session bool `json:"session"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	//   - CookieSameSite.Strict
+	//   - CookieSameSite.Lax
+	//   - CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+}
+
+/*
+BlockedSetCookieWithReason represents the Network.BlockedSetCookieWithReason
+type.
+
+A cookie which was not stored from a response with the corresponding reason.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-BlockedSetCookieWithReason
+*/
+type BlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []SetCookieBlockedReasonEnum `json:"blockedReasons"`
+
+	// The string representing this individual cookie as it would appear in the
+	// header. This is not the entire "cookie" or "set-cookie" header which could
+	// have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+
+	// Optional. The cookie object which represents the cookie which was not
+	// stored. It is optional because sometimes complete cookie information is not
+	// available, such as in the case of parsing errors.
+	Cookie *Cookie `json:"cookie,omitempty"`
+}
+
+/*
+ExemptedSetCookieWithReason represents the Network.ExemptedSetCookieWithReason
+type.
+
+A cookie should have been blocked by 3PCD but is exempted and stored from a
+response with the corresponding reason. A cookie could only have at most one
+exemption reason. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ExemptedSetCookieWithReason
+*/
+type ExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted. Allowed values:
+	//   - CookieExemptionReason.None
+	//   - CookieExemptionReason.UserSetting
+	//   - CookieExemptionReason.TPCDMetadata
+	//   - CookieExemptionReason.TPCDDeprecationTrial
+	//   - CookieExemptionReason.TopLevelTPCDDeprecationTrial
+	//   - CookieExemptionReason.TPCDHeuristics
+	//   - CookieExemptionReason.EnterprisePolicy
+	//   - CookieExemptionReason.StorageAccess
+	//   - CookieExemptionReason.TopLevelStorageAccess
+	//   - CookieExemptionReason.Scheme
+	//   - CookieExemptionReason.SameSiteNoneCookiesInSandbox
+	ExemptionReason CookieExemptionReasonEnum `json:"exemptionReason"`
+
+	// The string representing this individual cookie as it would appear in the
+	// header.
+	CookieLine string `json:"cookieLine"`
+
+	// The cookie object representing the cookie.
+	Cookie *Cookie `json:"cookie"`
+}
+
+/*
+AssociatedCookie represents the Network.AssociatedCookie type.
+
+A cookie associated with the request which may or may not be sent with it.
+Includes the cookies itself and reasons for blocking or exemption. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-AssociatedCookie
+*/
+type AssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie *Cookie `json:"cookie"`
+
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []CookieBlockedReasonEnum `json:"blockedReasons"`
+
+	// Optional. The reason the cookie should have been blocked by 3PCD but is
+	// exempted. A cookie could only have at most one exemption reason. Allowed values:
+	//   - CookieExemptionReason.None
+	//   - CookieExemptionReason.UserSetting
+	//   - CookieExemptionReason.TPCDMetadata
+	//   - CookieExemptionReason.TPCDDeprecationTrial
+	//   - CookieExemptionReason.TopLevelTPCDDeprecationTrial
+	//   - CookieExemptionReason.TPCDHeuristics
+	//   - CookieExemptionReason.EnterprisePolicy
+	//   - CookieExemptionReason.StorageAccess
+	//   - CookieExemptionReason.TopLevelStorageAccess
+	//   - CookieExemptionReason.Scheme
+	//   - CookieExemptionReason.SameSiteNoneCookiesInSandbox
+	ExemptionReason CookieExemptionReasonEnum `json:"exemptionReason,omitempty"`
+}
+
+/*
+CookieParam represents the Network.CookieParam type.
+
+# Cookie parameter object
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CookieParam
+*/
+type CookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Optional. The request-URI to associate with the setting of the cookie. This
+	// value can affect the default domain, path, source port, and source scheme
+	// values of the created cookie.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Cookie domain.
+	Domain string `json:"domain,omitempty"`
+
+	// Optional. Cookie path.
+	Path string `json:"path,omitempty"`
+
+	// Optional. True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+
+	// Optional. True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	//   - CookieSameSite.Strict
+	//   - CookieSameSite.Lax
+	//   - CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+
+	// Optional. Cookie expiration date, session cookie if not set
+	Expires TimeSinceEpoch `json:"expires,omitempty"`
+}
+
+/*
+AuthChallenge represents the Network.AuthChallenge type.
+
+Authorization challenge for HTTP status code 401 or 407. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-AuthChallenge
+*/
+type AuthChallenge struct {
+	// Optional. Source of the authentication challenge. Allowed values:
+	//   - Source.Server
+	//   - Source.Proxy
+	Source SourceEnum `json:"source,omitempty"`
+
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+/*
+AuthChallengeResponse represents the Network.AuthChallengeResponse type.
+
+Response to an AuthChallenge. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-AuthChallengeResponse
+*/
+type AuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.
+	// Default means deferring to the default behavior of the net stack, which will
+	// likely either the Cancel authentication or display a popup dialog box. Allowed values:
+	//   - ChallengeResponse.Default
+	//   - ChallengeResponse.CancelAuth
+	//   - ChallengeResponse.ProvideCredentials
+	Response ChallengeResponseEnum `json:"response"`
+
+	// Optional. The username to provide, possibly empty. Should only be set if
+	// response is ProvideCredentials.
+	Username string `json:"username,omitempty"`
+
+	// Optional. The password to provide, possibly empty. Should only be set if
+	// response is ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
+
+/*
+RequestPattern represents the Network.RequestPattern type.
+
+Request pattern for interception. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-RequestPattern
+*/
+type RequestPattern struct {
+	// Optional. Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are
+	// allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+
+	// Optional. If set, only requests for matching resource types will be
+	// intercepted. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	ResourceType page.ResourceTypeEnum `json:"resourceType,omitempty"`
+
+	// Optional. Stage at which to begin intercepting requests. Default is Request. Allowed values:
+	//   - InterceptionStage.Request
+	//   - InterceptionStage.HeadersReceived
+	InterceptionStage InterceptionStageEnum `json:"interceptionStage,omitempty"`
+}
+
+/*
+SignedExchangeSignature represents the Network.SignedExchangeSignature type.
+
+Information about a signed exchange signature.
+https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedExchangeSignature
+*/
+type SignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+
+	// Optional. Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+
+	// Optional. The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+
+	// Signed exchange signature date.
+	Date int `json:"date"`
+
+	// Signed exchange signature expires.
+	Expires int `json:"expires"`
+
+	// Optional. The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+/*
+SignedExchangeHeader represents the Network.SignedExchangeHeader type.
+
+Information about a signed exchange header.
+https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedExchangeHeader
+*/
+type SignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+
+	// Signed exchange response code.
+	ResponseCode int `json:"responseCode"`
+
+	// Signed exchange response headers.
+	ResponseHeaders Headers `json:"responseHeaders"`
+
+	// Signed exchange response signature.
+	Signatures []*SignedExchangeSignature `json:"signatures"`
+
+	// Signed exchange header integrity hash in the form of
+	// `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+/*
+SignedExchangeError represents the Network.SignedExchangeError type.
+
+Information about a signed exchange response. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedExchangeError
+*/
+type SignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+
+	// Optional. The index of the signature which caused the error.
+	SignatureIndex int `json:"signatureIndex,omitempty"`
+
+	// Optional. The field which caused the error. Allowed values:
+	//   - SignedExchangeErrorField.SignatureSig
+	//   - SignedExchangeErrorField.SignatureIntegrity
+	//   - SignedExchangeErrorField.SignatureCertURL
+	//   - SignedExchangeErrorField.SignatureCertSha256
+	//   - SignedExchangeErrorField.SignatureValidityURL
+	//   - SignedExchangeErrorField.SignatureTimestamps
+	ErrorField SignedExchangeErrorFieldEnum `json:"errorField,omitempty"`
+}
+
+/*
+SignedExchangeInfo represents the Network.SignedExchangeInfo type.
+
+Information about a signed exchange response. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SignedExchangeInfo
+*/
+type SignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse *Response `json:"outerResponse"`
+
+	// Whether network response for the signed exchange was accompanied by extra
+	// headers.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+
+	// Optional. Information about the signed exchange header.
+	Header *SignedExchangeHeader `json:"header,omitempty"`
+
+	// Optional. Security details for the signed exchange header.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+
+	// Optional. Errors occurred while handling the signed exchange.
+	Errors []*SignedExchangeError `json:"errors,omitempty"`
+}
+
+/*
+DirectTCPSocketOptions represents the Network.DirectTCPSocketOptions type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-DirectTCPSocketOptions
+*/
+type DirectTCPSocketOptions struct {
+	// TCP_NODELAY option
+	NoDelay bool `json:"noDelay"`
+
+	// Optional. Expected to be unsigned integer.
+	KeepAliveDelay float64 `json:"keepAliveDelay,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+
+	// Optional. Allowed values:
+	//   - DirectSocketDNSQueryType.Ipv4
+	//   - DirectSocketDNSQueryType.Ipv6
+	DNSQueryType DirectSocketDNSQueryTypeEnum `json:"dnsQueryType,omitempty"`
+}
+
+/*
+DirectUDPSocketOptions represents the Network.DirectUDPSocketOptions type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-DirectUDPSocketOptions
+*/
+type DirectUDPSocketOptions struct {
+	// Optional.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	// Optional. Unsigned int 16.
+	RemotePort int `json:"remotePort,omitempty"`
+
+	// Optional.
+	LocalAddr string `json:"localAddr,omitempty"`
+
+	// Optional. Unsigned int 16.
+	LocalPort int `json:"localPort,omitempty"`
+
+	// Optional. Allowed values:
+	//   - DirectSocketDNSQueryType.Ipv4
+	//   - DirectSocketDNSQueryType.Ipv6
+	DNSQueryType DirectSocketDNSQueryTypeEnum `json:"dnsQueryType,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+}
+
+/*
+DirectUDPMessage represents the Network.DirectUDPMessage type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-DirectUDPMessage
+*/
+type DirectUDPMessage struct {
+	Data string `json:"data"`
+
+	// Optional. Null for connected mode.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	// Optional. Null for connected mode. Expected to be unsigned integer.
+	RemotePort int `json:"remotePort,omitempty"`
+}
+
+/*
+ConnectTiming represents the Network.ConnectTiming type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ConnectTiming
+*/
+type ConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime. Matches
+	// ResourceTiming's requestTime for the same request (but not for redirected
+	// requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+/*
+ClientSecurityState represents the Network.ClientSecurityState type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ClientSecurityState
+*/
+type ClientSecurityState struct {
+	InitiatorIsSecureContext bool `json:"initiatorIsSecureContext"`
+
+	// Allowed values:
+	//   - IPAddressSpace.Loopback
+	//   - IPAddressSpace.Local
+	//   - IPAddressSpace.Public
+	//   - IPAddressSpace.Unknown
+	InitiatorIPAddressSpace IPAddressSpaceEnum `json:"initiatorIPAddressSpace"`
+
+	// Allowed values:
+	//   - PrivateNetworkRequestPolicy.Allow
+	//   - PrivateNetworkRequestPolicy.BlockFromInsecureToMorePrivate
+	//   - PrivateNetworkRequestPolicy.WarnFromInsecureToMorePrivate
+	//   - PrivateNetworkRequestPolicy.PreflightBlock
+	//   - PrivateNetworkRequestPolicy.PreflightWarn
+	//   - PrivateNetworkRequestPolicy.PermissionBlock
+	//   - PrivateNetworkRequestPolicy.PermissionWarn
+	PrivateNetworkRequestPolicy PrivateNetworkRequestPolicyEnum `json:"privateNetworkRequestPolicy"`
+}
+
+/*
+CrossOriginOpenerPolicyStatus represents the
+Network.CrossOriginOpenerPolicyStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CrossOriginOpenerPolicyStatus
+*/
+type CrossOriginOpenerPolicyStatus struct {
+	// Allowed values:
+	//   - CrossOriginOpenerPolicyValue.SameOrigin
+	//   - CrossOriginOpenerPolicyValue.SameOriginAllowPopups
+	//   - CrossOriginOpenerPolicyValue.RestrictProperties
+	//   - CrossOriginOpenerPolicyValue.UnsafeNone
+	//   - CrossOriginOpenerPolicyValue.SameOriginPlusCoep
+	//   - CrossOriginOpenerPolicyValue.RestrictPropertiesPlusCoep
+	//   - CrossOriginOpenerPolicyValue.NoopenerAllowPopups
+	Value CrossOriginOpenerPolicyValueEnum `json:"value"`
+
+	// Allowed values:
+	//   - CrossOriginOpenerPolicyValue.SameOrigin
+	//   - CrossOriginOpenerPolicyValue.SameOriginAllowPopups
+	//   - CrossOriginOpenerPolicyValue.RestrictProperties
+	//   - CrossOriginOpenerPolicyValue.UnsafeNone
+	//   - CrossOriginOpenerPolicyValue.SameOriginPlusCoep
+	//   - CrossOriginOpenerPolicyValue.RestrictPropertiesPlusCoep
+	//   - CrossOriginOpenerPolicyValue.NoopenerAllowPopups
+	ReportOnlyValue CrossOriginOpenerPolicyValueEnum `json:"reportOnlyValue"`
+
+	// Optional.
+	ReportingEndpoint string `json:"reportingEndpoint,omitempty"`
+
+	// Optional.
+	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+/*
+CrossOriginEmbedderPolicyStatus represents the
+Network.CrossOriginEmbedderPolicyStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CrossOriginEmbedderPolicyStatus
+*/
+type CrossOriginEmbedderPolicyStatus struct {
+	// Allowed values:
+	//   - CrossOriginEmbedderPolicyValue.None
+	//   - CrossOriginEmbedderPolicyValue.Credentialless
+	//   - CrossOriginEmbedderPolicyValue.RequireCorp
+	Value CrossOriginEmbedderPolicyValueEnum `json:"value"`
+
+	// Allowed values:
+	//   - CrossOriginEmbedderPolicyValue.None
+	//   - CrossOriginEmbedderPolicyValue.Credentialless
+	//   - CrossOriginEmbedderPolicyValue.RequireCorp
+	ReportOnlyValue CrossOriginEmbedderPolicyValueEnum `json:"reportOnlyValue"`
+
+	// Optional.
+	ReportingEndpoint string `json:"reportingEndpoint,omitempty"`
+
+	// Optional.
+	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+/*
+ContentSecurityPolicyStatus represents the Network.ContentSecurityPolicyStatus
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ContentSecurityPolicyStatus
+*/
+type ContentSecurityPolicyStatus struct {
+	EffectiveDirectives string `json:"effectiveDirectives"`
+
+	IsEnforced bool `json:"isEnforced"`
+
+	// Allowed values:
+	//   - ContentSecurityPolicySource.HTTP
+	//   - ContentSecurityPolicySource.Meta
+	Source ContentSecurityPolicySourceEnum `json:"source"`
+}
+
+/*
+SecurityIsolationStatus represents the Network.SecurityIsolationStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-SecurityIsolationStatus
+*/
+type SecurityIsolationStatus struct {
+	// Optional.
+	Coop *CrossOriginOpenerPolicyStatus `json:"coop,omitempty"`
+
+	// Optional.
+	Coep *CrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+
+	// Optional.
+	Csp []*ContentSecurityPolicyStatus `json:"csp,omitempty"`
+}
+
+/*
+ReportID represents the Network.ReportId type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ReportId
+*/
+type ReportID string
+
+/*
+ReportingAPIReport represents the Network.ReportingApiReport type.
+
+An object representing a report generated by the Reporting API. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ReportingApiReport
+*/
+type ReportingAPIReport struct {
+	ID ReportID `json:"id"`
+
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+
+	// The type of the report (specifies the set of data that is contained in the
+	// report body).
+	Type string `json:"type"`
+
+	// When the report was generated.
+	Timestamp TimeSinceEpoch `json:"timestamp"`
+
+	// How many uploads deep the related request was.
+	Depth int `json:"depth"`
+
+	// The number of delivery attempts made so far, not including an active
+	// attempt.
+	CompletedAttempts int `json:"completedAttempts"`
+
+	Body map[string]interface{} `json:"body"`
+
+	// Allowed values:
+	//   - ReportStatus.Queued
+	//   - ReportStatus.Pending
+	//   - ReportStatus.MarkedForRemoval
+	//   - ReportStatus.Success
+	Status ReportStatusEnum `json:"status"`
+}
+
+/*
+ReportingAPIEndpoint represents the Network.ReportingApiEndpoint type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ReportingApiEndpoint
+*/
+type ReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+/*
+LoadNetworkResourcePageResult represents the
+Network.LoadNetworkResourcePageResult type.
+
+An object providing the result of a network resource load. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoadNetworkResourcePageResult
+*/
+type LoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+
+	// Optional. Optional values used for error reporting.
+	NetError float64 `json:"netError,omitempty"`
+
+	// Optional.
+	NetErrorName string `json:"netErrorName,omitempty"`
+
+	// Optional.
+	HTTPStatusCode float64 `json:"httpStatusCode,omitempty"`
+
+	// Optional. If successful, one of the following two fields holds the result.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+
+	// Optional. Response headers.
+	Headers Headers `json:"headers,omitempty"`
+}
+
+/*
+LoadNetworkResourceOptions represents the Network.LoadNetworkResourceOptions
+type.
+
+An options object that may be extended later to better support CORS, CORB and
+streaming. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoadNetworkResourceOptions
+*/
+type LoadNetworkResourceOptions struct {
+	DisableCache bool `json:"disableCache"`
+
+	IncludeCredentials bool `json:"includeCredentials"`
+}
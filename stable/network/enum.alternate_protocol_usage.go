@@ -0,0 +1,120 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type alternateProtocolUsageEnum struct {
+	AlternativeJobWonWithoutRace AlternateProtocolUsageEnum
+	AlternativeJobWonRace        AlternateProtocolUsageEnum
+	MainJobWonRace               AlternateProtocolUsageEnum
+	MappingMissing               AlternateProtocolUsageEnum
+	Broken                       AlternateProtocolUsageEnum
+	DNSAlpnH3JobWonWithoutRace   AlternateProtocolUsageEnum
+	DNSAlpnH3JobWonRace          AlternateProtocolUsageEnum
+	UnspecifiedReason            AlternateProtocolUsageEnum
+}
+
+/*
+AlternateProtocolUsage provides named access to the AlternateProtocolUsageEnum values.
+*/
+var AlternateProtocolUsage = alternateProtocolUsageEnum{
+	AlternativeJobWonWithoutRace: alternateProtocolUsageAlternativeJobWonWithoutRace,
+	AlternativeJobWonRace:        alternateProtocolUsageAlternativeJobWonRace,
+	MainJobWonRace:               alternateProtocolUsageMainJobWonRace,
+	MappingMissing:               alternateProtocolUsageMappingMissing,
+	Broken:                       alternateProtocolUsageBroken,
+	DNSAlpnH3JobWonWithoutRace:   alternateProtocolUsageDNSAlpnH3JobWonWithoutRace,
+	DNSAlpnH3JobWonRace:          alternateProtocolUsageDNSAlpnH3JobWonRace,
+	UnspecifiedReason:            alternateProtocolUsageUnspecifiedReason,
+}
+
+/*
+AlternateProtocolUsageEnum represents the Network.AlternateProtocolUsage values.
+
+The reason why Chrome uses a specific transport protocol for HTTP semantics.
+
+Allowed values:
+  - AlternateProtocolUsage.AlternativeJobWonWithoutRace "alternativeJobWonWithoutRace"
+  - AlternateProtocolUsage.AlternativeJobWonRace        "alternativeJobWonRace"
+  - AlternateProtocolUsage.MainJobWonRace               "mainJobWonRace"
+  - AlternateProtocolUsage.MappingMissing               "mappingMissing"
+  - AlternateProtocolUsage.Broken                       "broken"
+  - AlternateProtocolUsage.DNSAlpnH3JobWonWithoutRace   "dnsAlpnH3JobWonWithoutRace"
+  - AlternateProtocolUsage.DNSAlpnH3JobWonRace          "dnsAlpnH3JobWonRace"
+  - AlternateProtocolUsage.UnspecifiedReason            "unspecifiedReason"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-AlternateProtocolUsage
+*/
+type AlternateProtocolUsageEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AlternateProtocolUsageEnum) String() string {
+	return _alternateProtocolUsageEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AlternateProtocolUsageEnum) Unknown() bool {
+	return !_alternateProtocolUsageEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AlternateProtocolUsageEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AlternateProtocolUsageEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _alternateProtocolUsageEnums.Parse(val)
+	return nil
+}
+
+const (
+	// alternateProtocolUsageAlternativeJobWonWithoutRace represents the "alternativeJobWonWithoutRace" value.
+	alternateProtocolUsageAlternativeJobWonWithoutRace AlternateProtocolUsageEnum = iota + 1
+	// alternateProtocolUsageAlternativeJobWonRace represents the "alternativeJobWonRace" value.
+	alternateProtocolUsageAlternativeJobWonRace
+	// alternateProtocolUsageMainJobWonRace represents the "mainJobWonRace" value.
+	alternateProtocolUsageMainJobWonRace
+	// alternateProtocolUsageMappingMissing represents the "mappingMissing" value.
+	alternateProtocolUsageMappingMissing
+	// alternateProtocolUsageBroken represents the "broken" value.
+	alternateProtocolUsageBroken
+	// alternateProtocolUsageDNSAlpnH3JobWonWithoutRace represents the "dnsAlpnH3JobWonWithoutRace" value.
+	alternateProtocolUsageDNSAlpnH3JobWonWithoutRace
+	// alternateProtocolUsageDNSAlpnH3JobWonRace represents the "dnsAlpnH3JobWonRace" value.
+	alternateProtocolUsageDNSAlpnH3JobWonRace
+	// alternateProtocolUsageUnspecifiedReason represents the "unspecifiedReason" value.
+	alternateProtocolUsageUnspecifiedReason
+)
+
+var _alternateProtocolUsageEnums = enums.New(map[AlternateProtocolUsageEnum]string{
+	AlternateProtocolUsageEnum(0):                      "",
+	alternateProtocolUsageAlternativeJobWonWithoutRace: "alternativeJobWonWithoutRace",
+	alternateProtocolUsageAlternativeJobWonRace:        "alternativeJobWonRace",
+	alternateProtocolUsageMainJobWonRace:               "mainJobWonRace",
+	alternateProtocolUsageMappingMissing:               "mappingMissing",
+	alternateProtocolUsageBroken:                       "broken",
+	alternateProtocolUsageDNSAlpnH3JobWonWithoutRace:   "dnsAlpnH3JobWonWithoutRace",
+	alternateProtocolUsageDNSAlpnH3JobWonRace:          "dnsAlpnH3JobWonRace",
+	alternateProtocolUsageUnspecifiedReason:            "unspecifiedReason",
+})
@@ -0,0 +1,93 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type challengeResponseEnum struct {
+	Default            ChallengeResponseEnum
+	CancelAuth         ChallengeResponseEnum
+	ProvideCredentials ChallengeResponseEnum
+}
+
+/*
+ChallengeResponse provides named access to the ChallengeResponseEnum values.
+*/
+var ChallengeResponse = challengeResponseEnum{
+	Default:            challengeResponseDefault,
+	CancelAuth:         challengeResponseCancelAuth,
+	ProvideCredentials: challengeResponseProvideCredentials,
+}
+
+/*
+ChallengeResponseEnum represents the Network.AuthChallengeResponse.response
+values.
+
+The decision on what to do in response to the authorization challenge. Default
+means deferring to the default behavior of the net stack, which will likely
+either the Cancel authentication or display a popup dialog box.
+
+Allowed values:
+  - ChallengeResponse.Default            "Default"
+  - ChallengeResponse.CancelAuth         "CancelAuth"
+  - ChallengeResponse.ProvideCredentials "ProvideCredentials"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-AuthChallengeResponse
+*/
+type ChallengeResponseEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ChallengeResponseEnum) String() string {
+	return _challengeResponseEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ChallengeResponseEnum) Unknown() bool {
+	return !_challengeResponseEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ChallengeResponseEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ChallengeResponseEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _challengeResponseEnums.Parse(val)
+	return nil
+}
+
+const (
+	// challengeResponseDefault represents the "Default" value.
+	challengeResponseDefault ChallengeResponseEnum = iota + 1
+	// challengeResponseCancelAuth represents the "CancelAuth" value.
+	challengeResponseCancelAuth
+	// challengeResponseProvideCredentials represents the "ProvideCredentials" value.
+	challengeResponseProvideCredentials
+)
+
+var _challengeResponseEnums = enums.New(map[ChallengeResponseEnum]string{
+	ChallengeResponseEnum(0):            "",
+	challengeResponseDefault:            "Default",
+	challengeResponseCancelAuth:         "CancelAuth",
+	challengeResponseProvideCredentials: "ProvideCredentials",
+})
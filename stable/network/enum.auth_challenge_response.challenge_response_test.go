@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumChallengeResponse(t *testing.T) {
+	var enum ChallengeResponseEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ChallengeResponseEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ChallengeResponse.Default
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Default"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Default"`, result)
+	}
+	json.Unmarshal([]byte(`"Default"`), &enum)
+	if ChallengeResponse.Default != enum {
+		t.Errorf("Expected %d, got %d", ChallengeResponse.Default, enum)
+	}
+
+	enum = ChallengeResponse.CancelAuth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CancelAuth"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CancelAuth"`, result)
+	}
+	json.Unmarshal([]byte(`"CancelAuth"`), &enum)
+	if ChallengeResponse.CancelAuth != enum {
+		t.Errorf("Expected %d, got %d", ChallengeResponse.CancelAuth, enum)
+	}
+
+	enum = ChallengeResponse.ProvideCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ProvideCredentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ProvideCredentials"`, result)
+	}
+	json.Unmarshal([]byte(`"ProvideCredentials"`), &enum)
+	if ChallengeResponse.ProvideCredentials != enum {
+		t.Errorf("Expected %d, got %d", ChallengeResponse.ProvideCredentials, enum)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type certificateTransparencyComplianceEnum struct {
+	Unknown      CertificateTransparencyComplianceEnum
+	NotCompliant CertificateTransparencyComplianceEnum
+	Compliant    CertificateTransparencyComplianceEnum
+}
+
+/*
+CertificateTransparencyCompliance provides named access to the CertificateTransparencyComplianceEnum values.
+*/
+var CertificateTransparencyCompliance = certificateTransparencyComplianceEnum{
+	Unknown:      certificateTransparencyComplianceUnknown,
+	NotCompliant: certificateTransparencyComplianceNotCompliant,
+	Compliant:    certificateTransparencyComplianceCompliant,
+}
+
+/*
+CertificateTransparencyComplianceEnum represents the
+Network.CertificateTransparencyCompliance values.
+
+Whether the request complied with Certificate Transparency policy.
+
+Allowed values:
+  - CertificateTransparencyCompliance.Unknown      "unknown"
+  - CertificateTransparencyCompliance.NotCompliant "not-compliant"
+  - CertificateTransparencyCompliance.Compliant    "compliant"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CertificateTransparencyCompliance
+*/
+type CertificateTransparencyComplianceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CertificateTransparencyComplianceEnum) String() string {
+	return _certificateTransparencyComplianceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CertificateTransparencyComplianceEnum) Unknown() bool {
+	return !_certificateTransparencyComplianceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CertificateTransparencyComplianceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CertificateTransparencyComplianceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _certificateTransparencyComplianceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// certificateTransparencyComplianceUnknown represents the "unknown" value.
+	certificateTransparencyComplianceUnknown CertificateTransparencyComplianceEnum = iota + 1
+	// certificateTransparencyComplianceNotCompliant represents the "not-compliant" value.
+	certificateTransparencyComplianceNotCompliant
+	// certificateTransparencyComplianceCompliant represents the "compliant" value.
+	certificateTransparencyComplianceCompliant
+)
+
+var _certificateTransparencyComplianceEnums = enums.New(map[CertificateTransparencyComplianceEnum]string{
+	CertificateTransparencyComplianceEnum(0):      "",
+	certificateTransparencyComplianceUnknown:      "unknown",
+	certificateTransparencyComplianceNotCompliant: "not-compliant",
+	certificateTransparencyComplianceCompliant:    "compliant",
+})
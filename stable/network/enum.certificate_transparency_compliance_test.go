@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCertificateTransparencyCompliance(t *testing.T) {
+	var enum CertificateTransparencyComplianceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CertificateTransparencyComplianceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CertificateTransparencyCompliance.Unknown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unknown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unknown"`, result)
+	}
+	json.Unmarshal([]byte(`"unknown"`), &enum)
+	if CertificateTransparencyCompliance.Unknown != enum {
+		t.Errorf("Expected %d, got %d", CertificateTransparencyCompliance.Unknown, enum)
+	}
+
+	enum = CertificateTransparencyCompliance.NotCompliant
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"not-compliant"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"not-compliant"`, result)
+	}
+	json.Unmarshal([]byte(`"not-compliant"`), &enum)
+	if CertificateTransparencyCompliance.NotCompliant != enum {
+		t.Errorf("Expected %d, got %d", CertificateTransparencyCompliance.NotCompliant, enum)
+	}
+
+	enum = CertificateTransparencyCompliance.Compliant
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"compliant"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"compliant"`, result)
+	}
+	json.Unmarshal([]byte(`"compliant"`), &enum)
+	if CertificateTransparencyCompliance.Compliant != enum {
+		t.Errorf("Expected %d, got %d", CertificateTransparencyCompliance.Compliant, enum)
+	}
+}
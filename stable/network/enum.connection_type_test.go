@@ -0,0 +1,160 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumConnectionType(t *testing.T) {
+	var enum ConnectionTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ConnectionTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ConnectionType.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if ConnectionType.None != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.None, enum)
+	}
+
+	enum = ConnectionType.Cellular2g
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cellular2g"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cellular2g"`, result)
+	}
+	json.Unmarshal([]byte(`"cellular2g"`), &enum)
+	if ConnectionType.Cellular2g != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular2g, enum)
+	}
+
+	enum = ConnectionType.Cellular3g
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cellular3g"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cellular3g"`, result)
+	}
+	json.Unmarshal([]byte(`"cellular3g"`), &enum)
+	if ConnectionType.Cellular3g != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular3g, enum)
+	}
+
+	enum = ConnectionType.Cellular4g
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cellular4g"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cellular4g"`, result)
+	}
+	json.Unmarshal([]byte(`"cellular4g"`), &enum)
+	if ConnectionType.Cellular4g != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular4g, enum)
+	}
+
+	enum = ConnectionType.Bluetooth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bluetooth"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bluetooth"`, result)
+	}
+	json.Unmarshal([]byte(`"bluetooth"`), &enum)
+	if ConnectionType.Bluetooth != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Bluetooth, enum)
+	}
+
+	enum = ConnectionType.Ethernet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ethernet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ethernet"`, result)
+	}
+	json.Unmarshal([]byte(`"ethernet"`), &enum)
+	if ConnectionType.Ethernet != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Ethernet, enum)
+	}
+
+	enum = ConnectionType.Wifi
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"wifi"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"wifi"`, result)
+	}
+	json.Unmarshal([]byte(`"wifi"`), &enum)
+	if ConnectionType.Wifi != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Wifi, enum)
+	}
+
+	enum = ConnectionType.Wimax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"wimax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"wimax"`, result)
+	}
+	json.Unmarshal([]byte(`"wimax"`), &enum)
+	if ConnectionType.Wimax != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Wimax, enum)
+	}
+
+	enum = ConnectionType.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
+	}
+	json.Unmarshal([]byte(`"other"`), &enum)
+	if ConnectionType.Other != enum {
+		t.Errorf("Expected %d, got %d", ConnectionType.Other, enum)
+	}
+}
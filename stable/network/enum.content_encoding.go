@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contentEncodingEnum struct {
+	Deflate ContentEncodingEnum
+	Gzip    ContentEncodingEnum
+	Br      ContentEncodingEnum
+	Zstd    ContentEncodingEnum
+}
+
+/*
+ContentEncoding provides named access to the ContentEncodingEnum values.
+*/
+var ContentEncoding = contentEncodingEnum{
+	Deflate: contentEncodingDeflate,
+	Gzip:    contentEncodingGzip,
+	Br:      contentEncodingBr,
+	Zstd:    contentEncodingZstd,
+}
+
+/*
+ContentEncodingEnum represents the Network.ContentEncoding values.
+
+List of content encodings supported by the backend.
+
+Allowed values:
+  - ContentEncoding.Deflate "deflate"
+  - ContentEncoding.Gzip    "gzip"
+  - ContentEncoding.Br      "br"
+  - ContentEncoding.Zstd    "zstd"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ContentEncoding
+*/
+type ContentEncodingEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContentEncodingEnum) String() string {
+	return _contentEncodingEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContentEncodingEnum) Unknown() bool {
+	return !_contentEncodingEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContentEncodingEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContentEncodingEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contentEncodingEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contentEncodingDeflate represents the "deflate" value.
+	contentEncodingDeflate ContentEncodingEnum = iota + 1
+	// contentEncodingGzip represents the "gzip" value.
+	contentEncodingGzip
+	// contentEncodingBr represents the "br" value.
+	contentEncodingBr
+	// contentEncodingZstd represents the "zstd" value.
+	contentEncodingZstd
+)
+
+var _contentEncodingEnums = enums.New(map[ContentEncodingEnum]string{
+	ContentEncodingEnum(0): "",
+	contentEncodingDeflate: "deflate",
+	contentEncodingGzip:    "gzip",
+	contentEncodingBr:      "br",
+	contentEncodingZstd:    "zstd",
+})
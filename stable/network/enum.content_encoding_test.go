@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumContentEncoding(t *testing.T) {
+	var enum ContentEncodingEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ContentEncodingEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ContentEncoding.Deflate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deflate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deflate"`, result)
+	}
+	json.Unmarshal([]byte(`"deflate"`), &enum)
+	if ContentEncoding.Deflate != enum {
+		t.Errorf("Expected %d, got %d", ContentEncoding.Deflate, enum)
+	}
+
+	enum = ContentEncoding.Gzip
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gzip"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gzip"`, result)
+	}
+	json.Unmarshal([]byte(`"gzip"`), &enum)
+	if ContentEncoding.Gzip != enum {
+		t.Errorf("Expected %d, got %d", ContentEncoding.Gzip, enum)
+	}
+
+	enum = ContentEncoding.Br
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"br"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"br"`, result)
+	}
+	json.Unmarshal([]byte(`"br"`), &enum)
+	if ContentEncoding.Br != enum {
+		t.Errorf("Expected %d, got %d", ContentEncoding.Br, enum)
+	}
+
+	enum = ContentEncoding.Zstd
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"zstd"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"zstd"`, result)
+	}
+	json.Unmarshal([]byte(`"zstd"`), &enum)
+	if ContentEncoding.Zstd != enum {
+		t.Errorf("Expected %d, got %d", ContentEncoding.Zstd, enum)
+	}
+}
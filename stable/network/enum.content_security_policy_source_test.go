@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumContentSecurityPolicySource(t *testing.T) {
+	var enum ContentSecurityPolicySourceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ContentSecurityPolicySourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ContentSecurityPolicySource.HTTP
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HTTP"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HTTP"`, result)
+	}
+	json.Unmarshal([]byte(`"HTTP"`), &enum)
+	if ContentSecurityPolicySource.HTTP != enum {
+		t.Errorf("Expected %d, got %d", ContentSecurityPolicySource.HTTP, enum)
+	}
+
+	enum = ContentSecurityPolicySource.Meta
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Meta"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Meta"`, result)
+	}
+	json.Unmarshal([]byte(`"Meta"`), &enum)
+	if ContentSecurityPolicySource.Meta != enum {
+		t.Errorf("Expected %d, got %d", ContentSecurityPolicySource.Meta, enum)
+	}
+}
@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieBlockedReasonEnum struct {
+	SecureOnly                               CookieBlockedReasonEnum
+	NotOnPath                                CookieBlockedReasonEnum
+	DomainMismatch                           CookieBlockedReasonEnum
+	SameSiteStrict                           CookieBlockedReasonEnum
+	SameSiteLax                              CookieBlockedReasonEnum
+	SameSiteUnspecifiedTreatedAsLax          CookieBlockedReasonEnum
+	SameSiteNoneInsecure                     CookieBlockedReasonEnum
+	UserPreferences                          CookieBlockedReasonEnum
+	ThirdPartyPhaseout                       CookieBlockedReasonEnum
+	ThirdPartyBlockedInFirstPartySet         CookieBlockedReasonEnum
+	UnknownError                             CookieBlockedReasonEnum
+	SchemefulSameSiteStrict                  CookieBlockedReasonEnum
+	SchemefulSameSiteLax                     CookieBlockedReasonEnum
+	SchemefulSameSiteUnspecifiedTreatedAsLax CookieBlockedReasonEnum
+	SamePartyFromCrossPartyContext           CookieBlockedReasonEnum
+	NameValuePairExceedsMaxSize              CookieBlockedReasonEnum
+	PortMismatch                             CookieBlockedReasonEnum
+	SchemeMismatch                           CookieBlockedReasonEnum
+	AnonymousContext                         CookieBlockedReasonEnum
+}
+
+/*
+CookieBlockedReason provides named access to the CookieBlockedReasonEnum values.
+*/
+var CookieBlockedReason = cookieBlockedReasonEnum{
+	SecureOnly:                               cookieBlockedReasonSecureOnly,
+	NotOnPath:                                cookieBlockedReasonNotOnPath,
+	DomainMismatch:                           cookieBlockedReasonDomainMismatch,
+	SameSiteStrict:                           cookieBlockedReasonSameSiteStrict,
+	SameSiteLax:                              cookieBlockedReasonSameSiteLax,
+	SameSiteUnspecifiedTreatedAsLax:          cookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+	SameSiteNoneInsecure:                     cookieBlockedReasonSameSiteNoneInsecure,
+	UserPreferences:                          cookieBlockedReasonUserPreferences,
+	ThirdPartyPhaseout:                       cookieBlockedReasonThirdPartyPhaseout,
+	ThirdPartyBlockedInFirstPartySet:         cookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+	UnknownError:                             cookieBlockedReasonUnknownError,
+	SchemefulSameSiteStrict:                  cookieBlockedReasonSchemefulSameSiteStrict,
+	SchemefulSameSiteLax:                     cookieBlockedReasonSchemefulSameSiteLax,
+	SchemefulSameSiteUnspecifiedTreatedAsLax: cookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+	SamePartyFromCrossPartyContext:           cookieBlockedReasonSamePartyFromCrossPartyContext,
+	NameValuePairExceedsMaxSize:              cookieBlockedReasonNameValuePairExceedsMaxSize,
+	PortMismatch:                             cookieBlockedReasonPortMismatch,
+	SchemeMismatch:                           cookieBlockedReasonSchemeMismatch,
+	AnonymousContext:                         cookieBlockedReasonAnonymousContext,
+}
+
+/*
+CookieBlockedReasonEnum represents the Network.CookieBlockedReason values.
+
+Types of reasons why a cookie may not be sent with a request.
+
+Allowed values:
+  - CookieBlockedReason.SecureOnly                               "SecureOnly"
+  - CookieBlockedReason.NotOnPath                                "NotOnPath"
+  - CookieBlockedReason.DomainMismatch                           "DomainMismatch"
+  - CookieBlockedReason.SameSiteStrict                           "SameSiteStrict"
+  - CookieBlockedReason.SameSiteLax                              "SameSiteLax"
+  - CookieBlockedReason.SameSiteUnspecifiedTreatedAsLax          "SameSiteUnspecifiedTreatedAsLax"
+  - CookieBlockedReason.SameSiteNoneInsecure                     "SameSiteNoneInsecure"
+  - CookieBlockedReason.UserPreferences                          "UserPreferences"
+  - CookieBlockedReason.ThirdPartyPhaseout                       "ThirdPartyPhaseout"
+  - CookieBlockedReason.ThirdPartyBlockedInFirstPartySet         "ThirdPartyBlockedInFirstPartySet"
+  - CookieBlockedReason.UnknownError                             "UnknownError"
+  - CookieBlockedReason.SchemefulSameSiteStrict                  "SchemefulSameSiteStrict"
+  - CookieBlockedReason.SchemefulSameSiteLax                     "SchemefulSameSiteLax"
+  - CookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax "SchemefulSameSiteUnspecifiedTreatedAsLax"
+  - CookieBlockedReason.SamePartyFromCrossPartyContext           "SamePartyFromCrossPartyContext"
+  - CookieBlockedReason.NameValuePairExceedsMaxSize              "NameValuePairExceedsMaxSize"
+  - CookieBlockedReason.PortMismatch                             "PortMismatch"
+  - CookieBlockedReason.SchemeMismatch                           "SchemeMismatch"
+  - CookieBlockedReason.AnonymousContext                         "AnonymousContext"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CookieBlockedReason
+*/
+type CookieBlockedReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieBlockedReasonEnum) String() string {
+	return _cookieBlockedReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieBlockedReasonEnum) Unknown() bool {
+	return !_cookieBlockedReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieBlockedReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieBlockedReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieBlockedReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieBlockedReasonSecureOnly represents the "SecureOnly" value.
+	cookieBlockedReasonSecureOnly CookieBlockedReasonEnum = iota + 1
+	// cookieBlockedReasonNotOnPath represents the "NotOnPath" value.
+	cookieBlockedReasonNotOnPath
+	// cookieBlockedReasonDomainMismatch represents the "DomainMismatch" value.
+	cookieBlockedReasonDomainMismatch
+	// cookieBlockedReasonSameSiteStrict represents the "SameSiteStrict" value.
+	cookieBlockedReasonSameSiteStrict
+	// cookieBlockedReasonSameSiteLax represents the "SameSiteLax" value.
+	cookieBlockedReasonSameSiteLax
+	// cookieBlockedReasonSameSiteUnspecifiedTreatedAsLax represents the "SameSiteUnspecifiedTreatedAsLax" value.
+	cookieBlockedReasonSameSiteUnspecifiedTreatedAsLax
+	// cookieBlockedReasonSameSiteNoneInsecure represents the "SameSiteNoneInsecure" value.
+	cookieBlockedReasonSameSiteNoneInsecure
+	// cookieBlockedReasonUserPreferences represents the "UserPreferences" value.
+	cookieBlockedReasonUserPreferences
+	// cookieBlockedReasonThirdPartyPhaseout represents the "ThirdPartyPhaseout" value.
+	cookieBlockedReasonThirdPartyPhaseout
+	// cookieBlockedReasonThirdPartyBlockedInFirstPartySet represents the "ThirdPartyBlockedInFirstPartySet" value.
+	cookieBlockedReasonThirdPartyBlockedInFirstPartySet
+	// cookieBlockedReasonUnknownError represents the "UnknownError" value.
+	cookieBlockedReasonUnknownError
+	// cookieBlockedReasonSchemefulSameSiteStrict represents the "SchemefulSameSiteStrict" value.
+	cookieBlockedReasonSchemefulSameSiteStrict
+	// cookieBlockedReasonSchemefulSameSiteLax represents the "SchemefulSameSiteLax" value.
+	cookieBlockedReasonSchemefulSameSiteLax
+	// cookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax represents the "SchemefulSameSiteUnspecifiedTreatedAsLax" value.
+	cookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax
+	// cookieBlockedReasonSamePartyFromCrossPartyContext represents the "SamePartyFromCrossPartyContext" value.
+	cookieBlockedReasonSamePartyFromCrossPartyContext
+	// cookieBlockedReasonNameValuePairExceedsMaxSize represents the "NameValuePairExceedsMaxSize" value.
+	cookieBlockedReasonNameValuePairExceedsMaxSize
+	// cookieBlockedReasonPortMismatch represents the "PortMismatch" value.
+	cookieBlockedReasonPortMismatch
+	// cookieBlockedReasonSchemeMismatch represents the "SchemeMismatch" value.
+	cookieBlockedReasonSchemeMismatch
+	// cookieBlockedReasonAnonymousContext represents the "AnonymousContext" value.
+	cookieBlockedReasonAnonymousContext
+)
+
+var _cookieBlockedReasonEnums = enums.New(map[CookieBlockedReasonEnum]string{
+	CookieBlockedReasonEnum(0):                                  "",
+	cookieBlockedReasonSecureOnly:                               "SecureOnly",
+	cookieBlockedReasonNotOnPath:                                "NotOnPath",
+	cookieBlockedReasonDomainMismatch:                           "DomainMismatch",
+	cookieBlockedReasonSameSiteStrict:                           "SameSiteStrict",
+	cookieBlockedReasonSameSiteLax:                              "SameSiteLax",
+	cookieBlockedReasonSameSiteUnspecifiedTreatedAsLax:          "SameSiteUnspecifiedTreatedAsLax",
+	cookieBlockedReasonSameSiteNoneInsecure:                     "SameSiteNoneInsecure",
+	cookieBlockedReasonUserPreferences:                          "UserPreferences",
+	cookieBlockedReasonThirdPartyPhaseout:                       "ThirdPartyPhaseout",
+	cookieBlockedReasonThirdPartyBlockedInFirstPartySet:         "ThirdPartyBlockedInFirstPartySet",
+	cookieBlockedReasonUnknownError:                             "UnknownError",
+	cookieBlockedReasonSchemefulSameSiteStrict:                  "SchemefulSameSiteStrict",
+	cookieBlockedReasonSchemefulSameSiteLax:                     "SchemefulSameSiteLax",
+	cookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax: "SchemefulSameSiteUnspecifiedTreatedAsLax",
+	cookieBlockedReasonSamePartyFromCrossPartyContext:           "SamePartyFromCrossPartyContext",
+	cookieBlockedReasonNameValuePairExceedsMaxSize:              "NameValuePairExceedsMaxSize",
+	cookieBlockedReasonPortMismatch:                             "PortMismatch",
+	cookieBlockedReasonSchemeMismatch:                           "SchemeMismatch",
+	cookieBlockedReasonAnonymousContext:                         "AnonymousContext",
+})
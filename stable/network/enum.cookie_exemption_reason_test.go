@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieExemptionReason(t *testing.T) {
+	var enum CookieExemptionReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieExemptionReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieExemptionReason.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"None"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"None"`, result)
+	}
+	json.Unmarshal([]byte(`"None"`), &enum)
+	if CookieExemptionReason.None != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.None, enum)
+	}
+
+	enum = CookieExemptionReason.UserSetting
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UserSetting"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UserSetting"`, result)
+	}
+	json.Unmarshal([]byte(`"UserSetting"`), &enum)
+	if CookieExemptionReason.UserSetting != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.UserSetting, enum)
+	}
+
+	enum = CookieExemptionReason.TPCDMetadata
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TPCDMetadata"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TPCDMetadata"`, result)
+	}
+	json.Unmarshal([]byte(`"TPCDMetadata"`), &enum)
+	if CookieExemptionReason.TPCDMetadata != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.TPCDMetadata, enum)
+	}
+
+	enum = CookieExemptionReason.TPCDDeprecationTrial
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TPCDDeprecationTrial"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TPCDDeprecationTrial"`, result)
+	}
+	json.Unmarshal([]byte(`"TPCDDeprecationTrial"`), &enum)
+	if CookieExemptionReason.TPCDDeprecationTrial != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.TPCDDeprecationTrial, enum)
+	}
+
+	enum = CookieExemptionReason.TopLevelTPCDDeprecationTrial
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TopLevelTPCDDeprecationTrial"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TopLevelTPCDDeprecationTrial"`, result)
+	}
+	json.Unmarshal([]byte(`"TopLevelTPCDDeprecationTrial"`), &enum)
+	if CookieExemptionReason.TopLevelTPCDDeprecationTrial != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.TopLevelTPCDDeprecationTrial, enum)
+	}
+
+	enum = CookieExemptionReason.TPCDHeuristics
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TPCDHeuristics"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TPCDHeuristics"`, result)
+	}
+	json.Unmarshal([]byte(`"TPCDHeuristics"`), &enum)
+	if CookieExemptionReason.TPCDHeuristics != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.TPCDHeuristics, enum)
+	}
+
+	enum = CookieExemptionReason.EnterprisePolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EnterprisePolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EnterprisePolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"EnterprisePolicy"`), &enum)
+	if CookieExemptionReason.EnterprisePolicy != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.EnterprisePolicy, enum)
+	}
+
+	enum = CookieExemptionReason.StorageAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"StorageAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"StorageAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"StorageAccess"`), &enum)
+	if CookieExemptionReason.StorageAccess != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.StorageAccess, enum)
+	}
+
+	enum = CookieExemptionReason.TopLevelStorageAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TopLevelStorageAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TopLevelStorageAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"TopLevelStorageAccess"`), &enum)
+	if CookieExemptionReason.TopLevelStorageAccess != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.TopLevelStorageAccess, enum)
+	}
+
+	enum = CookieExemptionReason.Scheme
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Scheme"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Scheme"`, result)
+	}
+	json.Unmarshal([]byte(`"Scheme"`), &enum)
+	if CookieExemptionReason.Scheme != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.Scheme, enum)
+	}
+
+	enum = CookieExemptionReason.SameSiteNoneCookiesInSandbox
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteNoneCookiesInSandbox"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteNoneCookiesInSandbox"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteNoneCookiesInSandbox"`), &enum)
+	if CookieExemptionReason.SameSiteNoneCookiesInSandbox != enum {
+		t.Errorf("Expected %d, got %d", CookieExemptionReason.SameSiteNoneCookiesInSandbox, enum)
+	}
+}
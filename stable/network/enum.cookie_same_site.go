@@ -0,0 +1,91 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieSameSiteEnum struct {
+	Strict CookieSameSiteEnum
+	Lax    CookieSameSiteEnum
+	None   CookieSameSiteEnum
+}
+
+/*
+CookieSameSite provides named access to the CookieSameSiteEnum values.
+*/
+var CookieSameSite = cookieSameSiteEnum{
+	Strict: cookieSameSiteStrict,
+	Lax:    cookieSameSiteLax,
+	None:   cookieSameSiteNone,
+}
+
+/*
+CookieSameSiteEnum represents the Network.CookieSameSite values.
+
+Represents the cookie's 'SameSite' status:
+https://tools.ietf.org/html/draft-west-first-party-cookies
+
+Allowed values:
+  - CookieSameSite.Strict "Strict"
+  - CookieSameSite.Lax    "Lax"
+  - CookieSameSite.None   "None"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-CookieSameSite
+*/
+type CookieSameSiteEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieSameSiteEnum) String() string {
+	return _cookieSameSiteEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieSameSiteEnum) Unknown() bool {
+	return !_cookieSameSiteEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieSameSiteEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieSameSiteEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieSameSiteEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieSameSiteStrict represents the "Strict" value.
+	cookieSameSiteStrict CookieSameSiteEnum = iota + 1
+	// cookieSameSiteLax represents the "Lax" value.
+	cookieSameSiteLax
+	// cookieSameSiteNone represents the "None" value.
+	cookieSameSiteNone
+)
+
+var _cookieSameSiteEnums = enums.New(map[CookieSameSiteEnum]string{
+	CookieSameSiteEnum(0): "",
+	cookieSameSiteStrict:  "Strict",
+	cookieSameSiteLax:     "Lax",
+	cookieSameSiteNone:    "None",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieSameSite(t *testing.T) {
+	var enum CookieSameSiteEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieSameSiteEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieSameSite.Strict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Strict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Strict"`, result)
+	}
+	json.Unmarshal([]byte(`"Strict"`), &enum)
+	if CookieSameSite.Strict != enum {
+		t.Errorf("Expected %d, got %d", CookieSameSite.Strict, enum)
+	}
+
+	enum = CookieSameSite.Lax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Lax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Lax"`, result)
+	}
+	json.Unmarshal([]byte(`"Lax"`), &enum)
+	if CookieSameSite.Lax != enum {
+		t.Errorf("Expected %d, got %d", CookieSameSite.Lax, enum)
+	}
+
+	enum = CookieSameSite.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"None"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"None"`, result)
+	}
+	json.Unmarshal([]byte(`"None"`), &enum)
+	if CookieSameSite.None != enum {
+		t.Errorf("Expected %d, got %d", CookieSameSite.None, enum)
+	}
+}
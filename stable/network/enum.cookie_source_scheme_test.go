@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieSourceScheme(t *testing.T) {
+	var enum CookieSourceSchemeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieSourceSchemeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieSourceScheme.Unset
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Unset"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Unset"`, result)
+	}
+	json.Unmarshal([]byte(`"Unset"`), &enum)
+	if CookieSourceScheme.Unset != enum {
+		t.Errorf("Expected %d, got %d", CookieSourceScheme.Unset, enum)
+	}
+
+	enum = CookieSourceScheme.NonSecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NonSecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NonSecure"`, result)
+	}
+	json.Unmarshal([]byte(`"NonSecure"`), &enum)
+	if CookieSourceScheme.NonSecure != enum {
+		t.Errorf("Expected %d, got %d", CookieSourceScheme.NonSecure, enum)
+	}
+
+	enum = CookieSourceScheme.Secure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Secure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Secure"`, result)
+	}
+	json.Unmarshal([]byte(`"Secure"`), &enum)
+	if CookieSourceScheme.Secure != enum {
+		t.Errorf("Expected %d, got %d", CookieSourceScheme.Secure, enum)
+	}
+}
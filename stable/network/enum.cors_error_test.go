@@ -0,0 +1,498 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCorsError(t *testing.T) {
+	var enum CorsErrorEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CorsErrorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CorsError.DisallowedByMode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisallowedByMode"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisallowedByMode"`, result)
+	}
+	json.Unmarshal([]byte(`"DisallowedByMode"`), &enum)
+	if CorsError.DisallowedByMode != enum {
+		t.Errorf("Expected %d, got %d", CorsError.DisallowedByMode, enum)
+	}
+
+	enum = CorsError.InvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidResponse"`), &enum)
+	if CorsError.InvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidResponse, enum)
+	}
+
+	enum = CorsError.WildcardOriginNotAllowed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WildcardOriginNotAllowed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WildcardOriginNotAllowed"`, result)
+	}
+	json.Unmarshal([]byte(`"WildcardOriginNotAllowed"`), &enum)
+	if CorsError.WildcardOriginNotAllowed != enum {
+		t.Errorf("Expected %d, got %d", CorsError.WildcardOriginNotAllowed, enum)
+	}
+
+	enum = CorsError.MissingAllowOriginHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MissingAllowOriginHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MissingAllowOriginHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"MissingAllowOriginHeader"`), &enum)
+	if CorsError.MissingAllowOriginHeader != enum {
+		t.Errorf("Expected %d, got %d", CorsError.MissingAllowOriginHeader, enum)
+	}
+
+	enum = CorsError.MultipleAllowOriginValues
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MultipleAllowOriginValues"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MultipleAllowOriginValues"`, result)
+	}
+	json.Unmarshal([]byte(`"MultipleAllowOriginValues"`), &enum)
+	if CorsError.MultipleAllowOriginValues != enum {
+		t.Errorf("Expected %d, got %d", CorsError.MultipleAllowOriginValues, enum)
+	}
+
+	enum = CorsError.InvalidAllowOriginValue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidAllowOriginValue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidAllowOriginValue"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidAllowOriginValue"`), &enum)
+	if CorsError.InvalidAllowOriginValue != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidAllowOriginValue, enum)
+	}
+
+	enum = CorsError.AllowOriginMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AllowOriginMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AllowOriginMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"AllowOriginMismatch"`), &enum)
+	if CorsError.AllowOriginMismatch != enum {
+		t.Errorf("Expected %d, got %d", CorsError.AllowOriginMismatch, enum)
+	}
+
+	enum = CorsError.InvalidAllowCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidAllowCredentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidAllowCredentials"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidAllowCredentials"`), &enum)
+	if CorsError.InvalidAllowCredentials != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidAllowCredentials, enum)
+	}
+
+	enum = CorsError.CorsDisabledScheme
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CorsDisabledScheme"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CorsDisabledScheme"`, result)
+	}
+	json.Unmarshal([]byte(`"CorsDisabledScheme"`), &enum)
+	if CorsError.CorsDisabledScheme != enum {
+		t.Errorf("Expected %d, got %d", CorsError.CorsDisabledScheme, enum)
+	}
+
+	enum = CorsError.PreflightInvalidStatus
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightInvalidStatus"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightInvalidStatus"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightInvalidStatus"`), &enum)
+	if CorsError.PreflightInvalidStatus != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightInvalidStatus, enum)
+	}
+
+	enum = CorsError.PreflightDisallowedRedirect
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightDisallowedRedirect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightDisallowedRedirect"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightDisallowedRedirect"`), &enum)
+	if CorsError.PreflightDisallowedRedirect != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightDisallowedRedirect, enum)
+	}
+
+	enum = CorsError.PreflightWildcardOriginNotAllowed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightWildcardOriginNotAllowed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightWildcardOriginNotAllowed"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightWildcardOriginNotAllowed"`), &enum)
+	if CorsError.PreflightWildcardOriginNotAllowed != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightWildcardOriginNotAllowed, enum)
+	}
+
+	enum = CorsError.PreflightMissingAllowOriginHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMissingAllowOriginHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMissingAllowOriginHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMissingAllowOriginHeader"`), &enum)
+	if CorsError.PreflightMissingAllowOriginHeader != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMissingAllowOriginHeader, enum)
+	}
+
+	enum = CorsError.PreflightMultipleAllowOriginValues
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMultipleAllowOriginValues"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMultipleAllowOriginValues"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMultipleAllowOriginValues"`), &enum)
+	if CorsError.PreflightMultipleAllowOriginValues != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMultipleAllowOriginValues, enum)
+	}
+
+	enum = CorsError.PreflightInvalidAllowOriginValue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightInvalidAllowOriginValue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightInvalidAllowOriginValue"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightInvalidAllowOriginValue"`), &enum)
+	if CorsError.PreflightInvalidAllowOriginValue != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightInvalidAllowOriginValue, enum)
+	}
+
+	enum = CorsError.PreflightAllowOriginMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightAllowOriginMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightAllowOriginMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightAllowOriginMismatch"`), &enum)
+	if CorsError.PreflightAllowOriginMismatch != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightAllowOriginMismatch, enum)
+	}
+
+	enum = CorsError.PreflightInvalidAllowCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightInvalidAllowCredentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightInvalidAllowCredentials"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightInvalidAllowCredentials"`), &enum)
+	if CorsError.PreflightInvalidAllowCredentials != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightInvalidAllowCredentials, enum)
+	}
+
+	enum = CorsError.PreflightMissingAllowExternal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMissingAllowExternal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMissingAllowExternal"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMissingAllowExternal"`), &enum)
+	if CorsError.PreflightMissingAllowExternal != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMissingAllowExternal, enum)
+	}
+
+	enum = CorsError.PreflightInvalidAllowExternal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightInvalidAllowExternal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightInvalidAllowExternal"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightInvalidAllowExternal"`), &enum)
+	if CorsError.PreflightInvalidAllowExternal != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightInvalidAllowExternal, enum)
+	}
+
+	enum = CorsError.PreflightMissingAllowPrivateNetwork
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMissingAllowPrivateNetwork"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMissingAllowPrivateNetwork"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMissingAllowPrivateNetwork"`), &enum)
+	if CorsError.PreflightMissingAllowPrivateNetwork != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMissingAllowPrivateNetwork, enum)
+	}
+
+	enum = CorsError.PreflightInvalidAllowPrivateNetwork
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightInvalidAllowPrivateNetwork"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightInvalidAllowPrivateNetwork"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightInvalidAllowPrivateNetwork"`), &enum)
+	if CorsError.PreflightInvalidAllowPrivateNetwork != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightInvalidAllowPrivateNetwork, enum)
+	}
+
+	enum = CorsError.InvalidAllowMethodsPreflightResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidAllowMethodsPreflightResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidAllowMethodsPreflightResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidAllowMethodsPreflightResponse"`), &enum)
+	if CorsError.InvalidAllowMethodsPreflightResponse != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidAllowMethodsPreflightResponse, enum)
+	}
+
+	enum = CorsError.InvalidAllowHeadersPreflightResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidAllowHeadersPreflightResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidAllowHeadersPreflightResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidAllowHeadersPreflightResponse"`), &enum)
+	if CorsError.InvalidAllowHeadersPreflightResponse != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidAllowHeadersPreflightResponse, enum)
+	}
+
+	enum = CorsError.MethodDisallowedByPreflightResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MethodDisallowedByPreflightResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MethodDisallowedByPreflightResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"MethodDisallowedByPreflightResponse"`), &enum)
+	if CorsError.MethodDisallowedByPreflightResponse != enum {
+		t.Errorf("Expected %d, got %d", CorsError.MethodDisallowedByPreflightResponse, enum)
+	}
+
+	enum = CorsError.HeaderDisallowedByPreflightResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HeaderDisallowedByPreflightResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HeaderDisallowedByPreflightResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"HeaderDisallowedByPreflightResponse"`), &enum)
+	if CorsError.HeaderDisallowedByPreflightResponse != enum {
+		t.Errorf("Expected %d, got %d", CorsError.HeaderDisallowedByPreflightResponse, enum)
+	}
+
+	enum = CorsError.RedirectContainsCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RedirectContainsCredentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RedirectContainsCredentials"`, result)
+	}
+	json.Unmarshal([]byte(`"RedirectContainsCredentials"`), &enum)
+	if CorsError.RedirectContainsCredentials != enum {
+		t.Errorf("Expected %d, got %d", CorsError.RedirectContainsCredentials, enum)
+	}
+
+	enum = CorsError.InsecurePrivateNetwork
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InsecurePrivateNetwork"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InsecurePrivateNetwork"`, result)
+	}
+	json.Unmarshal([]byte(`"InsecurePrivateNetwork"`), &enum)
+	if CorsError.InsecurePrivateNetwork != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InsecurePrivateNetwork, enum)
+	}
+
+	enum = CorsError.InvalidPrivateNetworkAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidPrivateNetworkAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidPrivateNetworkAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidPrivateNetworkAccess"`), &enum)
+	if CorsError.InvalidPrivateNetworkAccess != enum {
+		t.Errorf("Expected %d, got %d", CorsError.InvalidPrivateNetworkAccess, enum)
+	}
+
+	enum = CorsError.UnexpectedPrivateNetworkAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnexpectedPrivateNetworkAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnexpectedPrivateNetworkAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"UnexpectedPrivateNetworkAccess"`), &enum)
+	if CorsError.UnexpectedPrivateNetworkAccess != enum {
+		t.Errorf("Expected %d, got %d", CorsError.UnexpectedPrivateNetworkAccess, enum)
+	}
+
+	enum = CorsError.NoCorsRedirectModeNotFollow
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoCorsRedirectModeNotFollow"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoCorsRedirectModeNotFollow"`, result)
+	}
+	json.Unmarshal([]byte(`"NoCorsRedirectModeNotFollow"`), &enum)
+	if CorsError.NoCorsRedirectModeNotFollow != enum {
+		t.Errorf("Expected %d, got %d", CorsError.NoCorsRedirectModeNotFollow, enum)
+	}
+
+	enum = CorsError.PreflightMissingPrivateNetworkAccessID
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMissingPrivateNetworkAccessId"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMissingPrivateNetworkAccessId"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMissingPrivateNetworkAccessId"`), &enum)
+	if CorsError.PreflightMissingPrivateNetworkAccessID != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMissingPrivateNetworkAccessID, enum)
+	}
+
+	enum = CorsError.PreflightMissingPrivateNetworkAccessName
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreflightMissingPrivateNetworkAccessName"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreflightMissingPrivateNetworkAccessName"`, result)
+	}
+	json.Unmarshal([]byte(`"PreflightMissingPrivateNetworkAccessName"`), &enum)
+	if CorsError.PreflightMissingPrivateNetworkAccessName != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PreflightMissingPrivateNetworkAccessName, enum)
+	}
+
+	enum = CorsError.PrivateNetworkAccessPermissionUnavailable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrivateNetworkAccessPermissionUnavailable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrivateNetworkAccessPermissionUnavailable"`, result)
+	}
+	json.Unmarshal([]byte(`"PrivateNetworkAccessPermissionUnavailable"`), &enum)
+	if CorsError.PrivateNetworkAccessPermissionUnavailable != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PrivateNetworkAccessPermissionUnavailable, enum)
+	}
+
+	enum = CorsError.PrivateNetworkAccessPermissionDenied
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrivateNetworkAccessPermissionDenied"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrivateNetworkAccessPermissionDenied"`, result)
+	}
+	json.Unmarshal([]byte(`"PrivateNetworkAccessPermissionDenied"`), &enum)
+	if CorsError.PrivateNetworkAccessPermissionDenied != enum {
+		t.Errorf("Expected %d, got %d", CorsError.PrivateNetworkAccessPermissionDenied, enum)
+	}
+
+	enum = CorsError.LocalNetworkAccessPermissionDenied
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LocalNetworkAccessPermissionDenied"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LocalNetworkAccessPermissionDenied"`, result)
+	}
+	json.Unmarshal([]byte(`"LocalNetworkAccessPermissionDenied"`), &enum)
+	if CorsError.LocalNetworkAccessPermissionDenied != enum {
+		t.Errorf("Expected %d, got %d", CorsError.LocalNetworkAccessPermissionDenied, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCrossOriginEmbedderPolicyValue(t *testing.T) {
+	var enum CrossOriginEmbedderPolicyValueEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CrossOriginEmbedderPolicyValueEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CrossOriginEmbedderPolicyValue.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"None"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"None"`, result)
+	}
+	json.Unmarshal([]byte(`"None"`), &enum)
+	if CrossOriginEmbedderPolicyValue.None != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginEmbedderPolicyValue.None, enum)
+	}
+
+	enum = CrossOriginEmbedderPolicyValue.Credentialless
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Credentialless"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Credentialless"`, result)
+	}
+	json.Unmarshal([]byte(`"Credentialless"`), &enum)
+	if CrossOriginEmbedderPolicyValue.Credentialless != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginEmbedderPolicyValue.Credentialless, enum)
+	}
+
+	enum = CrossOriginEmbedderPolicyValue.RequireCorp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequireCorp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequireCorp"`, result)
+	}
+	json.Unmarshal([]byte(`"RequireCorp"`), &enum)
+	if CrossOriginEmbedderPolicyValue.RequireCorp != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginEmbedderPolicyValue.RequireCorp, enum)
+	}
+}
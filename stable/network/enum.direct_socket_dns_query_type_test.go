@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDirectSocketDNSQueryType(t *testing.T) {
+	var enum DirectSocketDNSQueryTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DirectSocketDNSQueryTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DirectSocketDNSQueryType.Ipv4
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ipv4"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ipv4"`, result)
+	}
+	json.Unmarshal([]byte(`"ipv4"`), &enum)
+	if DirectSocketDNSQueryType.Ipv4 != enum {
+		t.Errorf("Expected %d, got %d", DirectSocketDNSQueryType.Ipv4, enum)
+	}
+
+	enum = DirectSocketDNSQueryType.Ipv6
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ipv6"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ipv6"`, result)
+	}
+	json.Unmarshal([]byte(`"ipv6"`), &enum)
+	if DirectSocketDNSQueryType.Ipv6 != enum {
+		t.Errorf("Expected %d, got %d", DirectSocketDNSQueryType.Ipv6, enum)
+	}
+}
@@ -0,0 +1,156 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type errorReasonEnum struct {
+	Failed               ErrorReasonEnum
+	Aborted              ErrorReasonEnum
+	TimedOut             ErrorReasonEnum
+	AccessDenied         ErrorReasonEnum
+	ConnectionClosed     ErrorReasonEnum
+	ConnectionReset      ErrorReasonEnum
+	ConnectionRefused    ErrorReasonEnum
+	ConnectionAborted    ErrorReasonEnum
+	ConnectionFailed     ErrorReasonEnum
+	NameNotResolved      ErrorReasonEnum
+	InternetDisconnected ErrorReasonEnum
+	AddressUnreachable   ErrorReasonEnum
+	BlockedByClient      ErrorReasonEnum
+	BlockedByResponse    ErrorReasonEnum
+}
+
+/*
+ErrorReason provides named access to the ErrorReasonEnum values.
+*/
+var ErrorReason = errorReasonEnum{
+	Failed:               errorReasonFailed,
+	Aborted:              errorReasonAborted,
+	TimedOut:             errorReasonTimedOut,
+	AccessDenied:         errorReasonAccessDenied,
+	ConnectionClosed:     errorReasonConnectionClosed,
+	ConnectionReset:      errorReasonConnectionReset,
+	ConnectionRefused:    errorReasonConnectionRefused,
+	ConnectionAborted:    errorReasonConnectionAborted,
+	ConnectionFailed:     errorReasonConnectionFailed,
+	NameNotResolved:      errorReasonNameNotResolved,
+	InternetDisconnected: errorReasonInternetDisconnected,
+	AddressUnreachable:   errorReasonAddressUnreachable,
+	BlockedByClient:      errorReasonBlockedByClient,
+	BlockedByResponse:    errorReasonBlockedByResponse,
+}
+
+/*
+ErrorReasonEnum represents the Network.ErrorReason values.
+
+Network level fetch failure reason.
+
+Allowed values:
+  - ErrorReason.Failed               "Failed"
+  - ErrorReason.Aborted              "Aborted"
+  - ErrorReason.TimedOut             "TimedOut"
+  - ErrorReason.AccessDenied         "AccessDenied"
+  - ErrorReason.ConnectionClosed     "ConnectionClosed"
+  - ErrorReason.ConnectionReset      "ConnectionReset"
+  - ErrorReason.ConnectionRefused    "ConnectionRefused"
+  - ErrorReason.ConnectionAborted    "ConnectionAborted"
+  - ErrorReason.ConnectionFailed     "ConnectionFailed"
+  - ErrorReason.NameNotResolved      "NameNotResolved"
+  - ErrorReason.InternetDisconnected "InternetDisconnected"
+  - ErrorReason.AddressUnreachable   "AddressUnreachable"
+  - ErrorReason.BlockedByClient      "BlockedByClient"
+  - ErrorReason.BlockedByResponse    "BlockedByResponse"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ErrorReason
+*/
+type ErrorReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ErrorReasonEnum) String() string {
+	return _errorReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ErrorReasonEnum) Unknown() bool {
+	return !_errorReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ErrorReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ErrorReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _errorReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// errorReasonFailed represents the "Failed" value.
+	errorReasonFailed ErrorReasonEnum = iota + 1
+	// errorReasonAborted represents the "Aborted" value.
+	errorReasonAborted
+	// errorReasonTimedOut represents the "TimedOut" value.
+	errorReasonTimedOut
+	// errorReasonAccessDenied represents the "AccessDenied" value.
+	errorReasonAccessDenied
+	// errorReasonConnectionClosed represents the "ConnectionClosed" value.
+	errorReasonConnectionClosed
+	// errorReasonConnectionReset represents the "ConnectionReset" value.
+	errorReasonConnectionReset
+	// errorReasonConnectionRefused represents the "ConnectionRefused" value.
+	errorReasonConnectionRefused
+	// errorReasonConnectionAborted represents the "ConnectionAborted" value.
+	errorReasonConnectionAborted
+	// errorReasonConnectionFailed represents the "ConnectionFailed" value.
+	errorReasonConnectionFailed
+	// errorReasonNameNotResolved represents the "NameNotResolved" value.
+	errorReasonNameNotResolved
+	// errorReasonInternetDisconnected represents the "InternetDisconnected" value.
+	errorReasonInternetDisconnected
+	// errorReasonAddressUnreachable represents the "AddressUnreachable" value.
+	errorReasonAddressUnreachable
+	// errorReasonBlockedByClient represents the "BlockedByClient" value.
+	errorReasonBlockedByClient
+	// errorReasonBlockedByResponse represents the "BlockedByResponse" value.
+	errorReasonBlockedByResponse
+)
+
+var _errorReasonEnums = enums.New(map[ErrorReasonEnum]string{
+	ErrorReasonEnum(0):              "",
+	errorReasonFailed:               "Failed",
+	errorReasonAborted:              "Aborted",
+	errorReasonTimedOut:             "TimedOut",
+	errorReasonAccessDenied:         "AccessDenied",
+	errorReasonConnectionClosed:     "ConnectionClosed",
+	errorReasonConnectionReset:      "ConnectionReset",
+	errorReasonConnectionRefused:    "ConnectionRefused",
+	errorReasonConnectionAborted:    "ConnectionAborted",
+	errorReasonConnectionFailed:     "ConnectionFailed",
+	errorReasonNameNotResolved:      "NameNotResolved",
+	errorReasonInternetDisconnected: "InternetDisconnected",
+	errorReasonAddressUnreachable:   "AddressUnreachable",
+	errorReasonBlockedByClient:      "BlockedByClient",
+	errorReasonBlockedByResponse:    "BlockedByResponse",
+})
@@ -0,0 +1,225 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumErrorReason(t *testing.T) {
+	var enum ErrorReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ErrorReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ErrorReason.Failed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Failed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Failed"`, result)
+	}
+	json.Unmarshal([]byte(`"Failed"`), &enum)
+	if ErrorReason.Failed != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.Failed, enum)
+	}
+
+	enum = ErrorReason.Aborted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Aborted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Aborted"`, result)
+	}
+	json.Unmarshal([]byte(`"Aborted"`), &enum)
+	if ErrorReason.Aborted != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.Aborted, enum)
+	}
+
+	enum = ErrorReason.TimedOut
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TimedOut"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TimedOut"`, result)
+	}
+	json.Unmarshal([]byte(`"TimedOut"`), &enum)
+	if ErrorReason.TimedOut != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.TimedOut, enum)
+	}
+
+	enum = ErrorReason.AccessDenied
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccessDenied"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccessDenied"`, result)
+	}
+	json.Unmarshal([]byte(`"AccessDenied"`), &enum)
+	if ErrorReason.AccessDenied != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.AccessDenied, enum)
+	}
+
+	enum = ErrorReason.ConnectionClosed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConnectionClosed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionClosed"`, result)
+	}
+	json.Unmarshal([]byte(`"ConnectionClosed"`), &enum)
+	if ErrorReason.ConnectionClosed != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionClosed, enum)
+	}
+
+	enum = ErrorReason.ConnectionReset
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConnectionReset"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionReset"`, result)
+	}
+	json.Unmarshal([]byte(`"ConnectionReset"`), &enum)
+	if ErrorReason.ConnectionReset != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionReset, enum)
+	}
+
+	enum = ErrorReason.ConnectionRefused
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConnectionRefused"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionRefused"`, result)
+	}
+	json.Unmarshal([]byte(`"ConnectionRefused"`), &enum)
+	if ErrorReason.ConnectionRefused != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionRefused, enum)
+	}
+
+	enum = ErrorReason.ConnectionAborted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConnectionAborted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionAborted"`, result)
+	}
+	json.Unmarshal([]byte(`"ConnectionAborted"`), &enum)
+	if ErrorReason.ConnectionAborted != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionAborted, enum)
+	}
+
+	enum = ErrorReason.ConnectionFailed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConnectionFailed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionFailed"`, result)
+	}
+	json.Unmarshal([]byte(`"ConnectionFailed"`), &enum)
+	if ErrorReason.ConnectionFailed != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionFailed, enum)
+	}
+
+	enum = ErrorReason.NameNotResolved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NameNotResolved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NameNotResolved"`, result)
+	}
+	json.Unmarshal([]byte(`"NameNotResolved"`), &enum)
+	if ErrorReason.NameNotResolved != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.NameNotResolved, enum)
+	}
+
+	enum = ErrorReason.InternetDisconnected
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InternetDisconnected"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InternetDisconnected"`, result)
+	}
+	json.Unmarshal([]byte(`"InternetDisconnected"`), &enum)
+	if ErrorReason.InternetDisconnected != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.InternetDisconnected, enum)
+	}
+
+	enum = ErrorReason.AddressUnreachable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AddressUnreachable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AddressUnreachable"`, result)
+	}
+	json.Unmarshal([]byte(`"AddressUnreachable"`), &enum)
+	if ErrorReason.AddressUnreachable != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.AddressUnreachable, enum)
+	}
+
+	enum = ErrorReason.BlockedByClient
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByClient"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByClient"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByClient"`), &enum)
+	if ErrorReason.BlockedByClient != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.BlockedByClient, enum)
+	}
+
+	enum = ErrorReason.BlockedByResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByResponse"`), &enum)
+	if ErrorReason.BlockedByResponse != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.BlockedByResponse, enum)
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type initiatorTypeEnum struct {
+	Parser         InitiatorTypeEnum
+	Script         InitiatorTypeEnum
+	Preload        InitiatorTypeEnum
+	SignedExchange InitiatorTypeEnum
+	Preflight      InitiatorTypeEnum
+	Other          InitiatorTypeEnum
+}
+
+/*
+InitiatorType provides named access to the InitiatorTypeEnum values.
+*/
+var InitiatorType = initiatorTypeEnum{
+	Parser:         initiatorTypeParser,
+	Script:         initiatorTypeScript,
+	Preload:        initiatorTypePreload,
+	SignedExchange: initiatorTypeSignedExchange,
+	Preflight:      initiatorTypePreflight,
+	Other:          initiatorTypeOther,
+}
+
+/*
+InitiatorTypeEnum represents the Network.Initiator.type values.
+
+Type of this initiator.
+
+Allowed values:
+  - InitiatorType.Parser         "parser"
+  - InitiatorType.Script         "script"
+  - InitiatorType.Preload        "preload"
+  - InitiatorType.SignedExchange "SignedExchange"
+  - InitiatorType.Preflight      "preflight"
+  - InitiatorType.Other          "other"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Initiator
+*/
+type InitiatorTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InitiatorTypeEnum) String() string {
+	return _initiatorTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InitiatorTypeEnum) Unknown() bool {
+	return !_initiatorTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InitiatorTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InitiatorTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _initiatorTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// initiatorTypeParser represents the "parser" value.
+	initiatorTypeParser InitiatorTypeEnum = iota + 1
+	// initiatorTypeScript represents the "script" value.
+	initiatorTypeScript
+	// initiatorTypePreload represents the "preload" value.
+	initiatorTypePreload
+	// initiatorTypeSignedExchange represents the "SignedExchange" value.
+	initiatorTypeSignedExchange
+	// initiatorTypePreflight represents the "preflight" value.
+	initiatorTypePreflight
+	// initiatorTypeOther represents the "other" value.
+	initiatorTypeOther
+)
+
+var _initiatorTypeEnums = enums.New(map[InitiatorTypeEnum]string{
+	InitiatorTypeEnum(0):        "",
+	initiatorTypeParser:         "parser",
+	initiatorTypeScript:         "script",
+	initiatorTypePreload:        "preload",
+	initiatorTypeSignedExchange: "SignedExchange",
+	initiatorTypePreflight:      "preflight",
+	initiatorTypeOther:          "other",
+})
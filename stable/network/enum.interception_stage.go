@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type interceptionStageEnum struct {
+	Request         InterceptionStageEnum
+	HeadersReceived InterceptionStageEnum
+}
+
+/*
+InterceptionStage provides named access to the InterceptionStageEnum values.
+*/
+var InterceptionStage = interceptionStageEnum{
+	Request:         interceptionStageRequest,
+	HeadersReceived: interceptionStageHeadersReceived,
+}
+
+/*
+InterceptionStageEnum represents the Network.InterceptionStage values.
+
+Stages of the interception to begin intercepting. Request will intercept before
+the request is sent. Response will intercept after the response is received.
+
+Allowed values:
+  - InterceptionStage.Request         "Request"
+  - InterceptionStage.HeadersReceived "HeadersReceived"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-InterceptionStage
+*/
+type InterceptionStageEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InterceptionStageEnum) String() string {
+	return _interceptionStageEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InterceptionStageEnum) Unknown() bool {
+	return !_interceptionStageEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InterceptionStageEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InterceptionStageEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _interceptionStageEnums.Parse(val)
+	return nil
+}
+
+const (
+	// interceptionStageRequest represents the "Request" value.
+	interceptionStageRequest InterceptionStageEnum = iota + 1
+	// interceptionStageHeadersReceived represents the "HeadersReceived" value.
+	interceptionStageHeadersReceived
+)
+
+var _interceptionStageEnums = enums.New(map[InterceptionStageEnum]string{
+	InterceptionStageEnum(0):         "",
+	interceptionStageRequest:         "Request",
+	interceptionStageHeadersReceived: "HeadersReceived",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInterceptionStage(t *testing.T) {
+	var enum InterceptionStageEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InterceptionStageEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InterceptionStage.Request
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Request"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Request"`, result)
+	}
+	json.Unmarshal([]byte(`"Request"`), &enum)
+	if InterceptionStage.Request != enum {
+		t.Errorf("Expected %d, got %d", InterceptionStage.Request, enum)
+	}
+
+	enum = InterceptionStage.HeadersReceived
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HeadersReceived"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HeadersReceived"`, result)
+	}
+	json.Unmarshal([]byte(`"HeadersReceived"`), &enum)
+	if InterceptionStage.HeadersReceived != enum {
+		t.Errorf("Expected %d, got %d", InterceptionStage.HeadersReceived, enum)
+	}
+}
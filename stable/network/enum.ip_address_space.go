@@ -0,0 +1,94 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type ipAddressSpaceEnum struct {
+	Loopback IPAddressSpaceEnum
+	Local    IPAddressSpaceEnum
+	Public   IPAddressSpaceEnum
+	Unknown  IPAddressSpaceEnum
+}
+
+/*
+IPAddressSpace provides named access to the IPAddressSpaceEnum values.
+*/
+var IPAddressSpace = ipAddressSpaceEnum{
+	Loopback: ipAddressSpaceLoopback,
+	Local:    ipAddressSpaceLocal,
+	Public:   ipAddressSpacePublic,
+	Unknown:  ipAddressSpaceUnknown,
+}
+
+/*
+IPAddressSpaceEnum represents the Network.IPAddressSpace values.
+
+Allowed values:
+  - IPAddressSpace.Loopback "Loopback"
+  - IPAddressSpace.Local    "Local"
+  - IPAddressSpace.Public   "Public"
+  - IPAddressSpace.Unknown  "Unknown"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-IPAddressSpace
+*/
+type IPAddressSpaceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum IPAddressSpaceEnum) String() string {
+	return _ipAddressSpaceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum IPAddressSpaceEnum) Unknown() bool {
+	return !_ipAddressSpaceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum IPAddressSpaceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *IPAddressSpaceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _ipAddressSpaceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// ipAddressSpaceLoopback represents the "Loopback" value.
+	ipAddressSpaceLoopback IPAddressSpaceEnum = iota + 1
+	// ipAddressSpaceLocal represents the "Local" value.
+	ipAddressSpaceLocal
+	// ipAddressSpacePublic represents the "Public" value.
+	ipAddressSpacePublic
+	// ipAddressSpaceUnknown represents the "Unknown" value.
+	ipAddressSpaceUnknown
+)
+
+var _ipAddressSpaceEnums = enums.New(map[IPAddressSpaceEnum]string{
+	IPAddressSpaceEnum(0):  "",
+	ipAddressSpaceLoopback: "Loopback",
+	ipAddressSpaceLocal:    "Local",
+	ipAddressSpacePublic:   "Public",
+	ipAddressSpaceUnknown:  "Unknown",
+})
@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type referrerPolicyEnum struct {
+	UnsafeURL                   ReferrerPolicyEnum
+	NoReferrerWhenDowngrade     ReferrerPolicyEnum
+	NoReferrer                  ReferrerPolicyEnum
+	Origin                      ReferrerPolicyEnum
+	OriginWhenCrossOrigin       ReferrerPolicyEnum
+	SameOrigin                  ReferrerPolicyEnum
+	StrictOrigin                ReferrerPolicyEnum
+	StrictOriginWhenCrossOrigin ReferrerPolicyEnum
+}
+
+/*
+ReferrerPolicy provides named access to the ReferrerPolicyEnum values.
+*/
+var ReferrerPolicy = referrerPolicyEnum{
+	UnsafeURL:                   referrerPolicyUnsafeURL,
+	NoReferrerWhenDowngrade:     referrerPolicyNoReferrerWhenDowngrade,
+	NoReferrer:                  referrerPolicyNoReferrer,
+	Origin:                      referrerPolicyOrigin,
+	OriginWhenCrossOrigin:       referrerPolicyOriginWhenCrossOrigin,
+	SameOrigin:                  referrerPolicySameOrigin,
+	StrictOrigin:                referrerPolicyStrictOrigin,
+	StrictOriginWhenCrossOrigin: referrerPolicyStrictOriginWhenCrossOrigin,
+}
+
+/*
+ReferrerPolicyEnum represents the Network.Request.referrerPolicy values.
+
+The referrer policy of the request, as defined in
+https://www.w3.org/TR/referrer-policy/
+
+Allowed values:
+  - ReferrerPolicy.UnsafeURL                   "unsafe-url"
+  - ReferrerPolicy.NoReferrerWhenDowngrade     "no-referrer-when-downgrade"
+  - ReferrerPolicy.NoReferrer                  "no-referrer"
+  - ReferrerPolicy.Origin                      "origin"
+  - ReferrerPolicy.OriginWhenCrossOrigin       "origin-when-cross-origin"
+  - ReferrerPolicy.SameOrigin                  "same-origin"
+  - ReferrerPolicy.StrictOrigin                "strict-origin"
+  - ReferrerPolicy.StrictOriginWhenCrossOrigin "strict-origin-when-cross-origin"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-Request
+*/
+type ReferrerPolicyEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ReferrerPolicyEnum) String() string {
+	return _referrerPolicyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReferrerPolicyEnum) Unknown() bool {
+	return !_referrerPolicyEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ReferrerPolicyEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ReferrerPolicyEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _referrerPolicyEnums.Parse(val)
+	return nil
+}
+
+const (
+	// referrerPolicyUnsafeURL represents the "unsafe-url" value.
+	referrerPolicyUnsafeURL ReferrerPolicyEnum = iota + 1
+	// referrerPolicyNoReferrerWhenDowngrade represents the "no-referrer-when-downgrade" value.
+	referrerPolicyNoReferrerWhenDowngrade
+	// referrerPolicyNoReferrer represents the "no-referrer" value.
+	referrerPolicyNoReferrer
+	// referrerPolicyOrigin represents the "origin" value.
+	referrerPolicyOrigin
+	// referrerPolicyOriginWhenCrossOrigin represents the "origin-when-cross-origin" value.
+	referrerPolicyOriginWhenCrossOrigin
+	// referrerPolicySameOrigin represents the "same-origin" value.
+	referrerPolicySameOrigin
+	// referrerPolicyStrictOrigin represents the "strict-origin" value.
+	referrerPolicyStrictOrigin
+	// referrerPolicyStrictOriginWhenCrossOrigin represents the "strict-origin-when-cross-origin" value.
+	referrerPolicyStrictOriginWhenCrossOrigin
+)
+
+var _referrerPolicyEnums = enums.New(map[ReferrerPolicyEnum]string{
+	ReferrerPolicyEnum(0):                     "",
+	referrerPolicyUnsafeURL:                   "unsafe-url",
+	referrerPolicyNoReferrerWhenDowngrade:     "no-referrer-when-downgrade",
+	referrerPolicyNoReferrer:                  "no-referrer",
+	referrerPolicyOrigin:                      "origin",
+	referrerPolicyOriginWhenCrossOrigin:       "origin-when-cross-origin",
+	referrerPolicySameOrigin:                  "same-origin",
+	referrerPolicyStrictOrigin:                "strict-origin",
+	referrerPolicyStrictOriginWhenCrossOrigin: "strict-origin-when-cross-origin",
+})
@@ -0,0 +1,102 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type resourcePriorityEnum struct {
+	VeryLow  ResourcePriorityEnum
+	Low      ResourcePriorityEnum
+	Medium   ResourcePriorityEnum
+	High     ResourcePriorityEnum
+	VeryHigh ResourcePriorityEnum
+}
+
+/*
+ResourcePriority provides named access to the ResourcePriorityEnum values.
+*/
+var ResourcePriority = resourcePriorityEnum{
+	VeryLow:  resourcePriorityVeryLow,
+	Low:      resourcePriorityLow,
+	Medium:   resourcePriorityMedium,
+	High:     resourcePriorityHigh,
+	VeryHigh: resourcePriorityVeryHigh,
+}
+
+/*
+ResourcePriorityEnum represents the Network.ResourcePriority values.
+
+Loading priority of a resource request.
+
+Allowed values:
+  - ResourcePriority.VeryLow  "VeryLow"
+  - ResourcePriority.Low      "Low"
+  - ResourcePriority.Medium   "Medium"
+  - ResourcePriority.High     "High"
+  - ResourcePriority.VeryHigh "VeryHigh"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ResourcePriority
+*/
+type ResourcePriorityEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ResourcePriorityEnum) String() string {
+	return _resourcePriorityEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ResourcePriorityEnum) Unknown() bool {
+	return !_resourcePriorityEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ResourcePriorityEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ResourcePriorityEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _resourcePriorityEnums.Parse(val)
+	return nil
+}
+
+const (
+	// resourcePriorityVeryLow represents the "VeryLow" value.
+	resourcePriorityVeryLow ResourcePriorityEnum = iota + 1
+	// resourcePriorityLow represents the "Low" value.
+	resourcePriorityLow
+	// resourcePriorityMedium represents the "Medium" value.
+	resourcePriorityMedium
+	// resourcePriorityHigh represents the "High" value.
+	resourcePriorityHigh
+	// resourcePriorityVeryHigh represents the "VeryHigh" value.
+	resourcePriorityVeryHigh
+)
+
+var _resourcePriorityEnums = enums.New(map[ResourcePriorityEnum]string{
+	ResourcePriorityEnum(0):  "",
+	resourcePriorityVeryLow:  "VeryLow",
+	resourcePriorityLow:      "Low",
+	resourcePriorityMedium:   "Medium",
+	resourcePriorityHigh:     "High",
+	resourcePriorityVeryHigh: "VeryHigh",
+})
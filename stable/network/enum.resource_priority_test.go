@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumResourcePriority(t *testing.T) {
+	var enum ResourcePriorityEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ResourcePriorityEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ResourcePriority.VeryLow
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"VeryLow"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"VeryLow"`, result)
+	}
+	json.Unmarshal([]byte(`"VeryLow"`), &enum)
+	if ResourcePriority.VeryLow != enum {
+		t.Errorf("Expected %d, got %d", ResourcePriority.VeryLow, enum)
+	}
+
+	enum = ResourcePriority.Low
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Low"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Low"`, result)
+	}
+	json.Unmarshal([]byte(`"Low"`), &enum)
+	if ResourcePriority.Low != enum {
+		t.Errorf("Expected %d, got %d", ResourcePriority.Low, enum)
+	}
+
+	enum = ResourcePriority.Medium
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Medium"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Medium"`, result)
+	}
+	json.Unmarshal([]byte(`"Medium"`), &enum)
+	if ResourcePriority.Medium != enum {
+		t.Errorf("Expected %d, got %d", ResourcePriority.Medium, enum)
+	}
+
+	enum = ResourcePriority.High
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"High"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"High"`, result)
+	}
+	json.Unmarshal([]byte(`"High"`), &enum)
+	if ResourcePriority.High != enum {
+		t.Errorf("Expected %d, got %d", ResourcePriority.High, enum)
+	}
+
+	enum = ResourcePriority.VeryHigh
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"VeryHigh"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"VeryHigh"`, result)
+	}
+	json.Unmarshal([]byte(`"VeryHigh"`), &enum)
+	if ResourcePriority.VeryHigh != enum {
+		t.Errorf("Expected %d, got %d", ResourcePriority.VeryHigh, enum)
+	}
+}
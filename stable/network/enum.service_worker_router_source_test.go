@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumServiceWorkerRouterSource(t *testing.T) {
+	var enum ServiceWorkerRouterSourceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ServiceWorkerRouterSourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ServiceWorkerRouterSource.Network
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"network"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"network"`, result)
+	}
+	json.Unmarshal([]byte(`"network"`), &enum)
+	if ServiceWorkerRouterSource.Network != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerRouterSource.Network, enum)
+	}
+
+	enum = ServiceWorkerRouterSource.Cache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cache"`, result)
+	}
+	json.Unmarshal([]byte(`"cache"`), &enum)
+	if ServiceWorkerRouterSource.Cache != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerRouterSource.Cache, enum)
+	}
+
+	enum = ServiceWorkerRouterSource.FetchEvent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fetch-event"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fetch-event"`, result)
+	}
+	json.Unmarshal([]byte(`"fetch-event"`), &enum)
+	if ServiceWorkerRouterSource.FetchEvent != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerRouterSource.FetchEvent, enum)
+	}
+
+	enum = ServiceWorkerRouterSource.RaceNetworkAndFetchHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"race-network-and-fetch-handler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"race-network-and-fetch-handler"`, result)
+	}
+	json.Unmarshal([]byte(`"race-network-and-fetch-handler"`), &enum)
+	if ServiceWorkerRouterSource.RaceNetworkAndFetchHandler != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerRouterSource.RaceNetworkAndFetchHandler, enum)
+	}
+
+	enum = ServiceWorkerRouterSource.RaceNetworkAndCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"race-network-and-cache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"race-network-and-cache"`, result)
+	}
+	json.Unmarshal([]byte(`"race-network-and-cache"`), &enum)
+	if ServiceWorkerRouterSource.RaceNetworkAndCache != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerRouterSource.RaceNetworkAndCache, enum)
+	}
+}
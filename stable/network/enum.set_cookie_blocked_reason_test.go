@@ -0,0 +1,329 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSetCookieBlockedReason(t *testing.T) {
+	var enum SetCookieBlockedReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SetCookieBlockedReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SetCookieBlockedReason.SecureOnly
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SecureOnly"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SecureOnly"`, result)
+	}
+	json.Unmarshal([]byte(`"SecureOnly"`), &enum)
+	if SetCookieBlockedReason.SecureOnly != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SecureOnly, enum)
+	}
+
+	enum = SetCookieBlockedReason.SameSiteStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteStrict"`), &enum)
+	if SetCookieBlockedReason.SameSiteStrict != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SameSiteStrict, enum)
+	}
+
+	enum = SetCookieBlockedReason.SameSiteLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteLax"`), &enum)
+	if SetCookieBlockedReason.SameSiteLax != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SameSiteLax, enum)
+	}
+
+	enum = SetCookieBlockedReason.SameSiteUnspecifiedTreatedAsLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteUnspecifiedTreatedAsLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteUnspecifiedTreatedAsLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteUnspecifiedTreatedAsLax"`), &enum)
+	if SetCookieBlockedReason.SameSiteUnspecifiedTreatedAsLax != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SameSiteUnspecifiedTreatedAsLax, enum)
+	}
+
+	enum = SetCookieBlockedReason.SameSiteNoneInsecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteNoneInsecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteNoneInsecure"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteNoneInsecure"`), &enum)
+	if SetCookieBlockedReason.SameSiteNoneInsecure != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SameSiteNoneInsecure, enum)
+	}
+
+	enum = SetCookieBlockedReason.UserPreferences
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UserPreferences"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UserPreferences"`, result)
+	}
+	json.Unmarshal([]byte(`"UserPreferences"`), &enum)
+	if SetCookieBlockedReason.UserPreferences != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.UserPreferences, enum)
+	}
+
+	enum = SetCookieBlockedReason.ThirdPartyPhaseout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ThirdPartyPhaseout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ThirdPartyPhaseout"`, result)
+	}
+	json.Unmarshal([]byte(`"ThirdPartyPhaseout"`), &enum)
+	if SetCookieBlockedReason.ThirdPartyPhaseout != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.ThirdPartyPhaseout, enum)
+	}
+
+	enum = SetCookieBlockedReason.ThirdPartyBlockedInFirstPartySet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ThirdPartyBlockedInFirstPartySet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ThirdPartyBlockedInFirstPartySet"`, result)
+	}
+	json.Unmarshal([]byte(`"ThirdPartyBlockedInFirstPartySet"`), &enum)
+	if SetCookieBlockedReason.ThirdPartyBlockedInFirstPartySet != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.ThirdPartyBlockedInFirstPartySet, enum)
+	}
+
+	enum = SetCookieBlockedReason.SyntaxError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SyntaxError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SyntaxError"`, result)
+	}
+	json.Unmarshal([]byte(`"SyntaxError"`), &enum)
+	if SetCookieBlockedReason.SyntaxError != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SyntaxError, enum)
+	}
+
+	enum = SetCookieBlockedReason.SchemeNotSupported
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemeNotSupported"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemeNotSupported"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemeNotSupported"`), &enum)
+	if SetCookieBlockedReason.SchemeNotSupported != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SchemeNotSupported, enum)
+	}
+
+	enum = SetCookieBlockedReason.OverwriteSecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OverwriteSecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OverwriteSecure"`, result)
+	}
+	json.Unmarshal([]byte(`"OverwriteSecure"`), &enum)
+	if SetCookieBlockedReason.OverwriteSecure != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.OverwriteSecure, enum)
+	}
+
+	enum = SetCookieBlockedReason.InvalidDomain
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidDomain"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidDomain"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidDomain"`), &enum)
+	if SetCookieBlockedReason.InvalidDomain != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.InvalidDomain, enum)
+	}
+
+	enum = SetCookieBlockedReason.InvalidPrefix
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidPrefix"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidPrefix"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidPrefix"`), &enum)
+	if SetCookieBlockedReason.InvalidPrefix != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.InvalidPrefix, enum)
+	}
+
+	enum = SetCookieBlockedReason.UnknownError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnknownError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnknownError"`, result)
+	}
+	json.Unmarshal([]byte(`"UnknownError"`), &enum)
+	if SetCookieBlockedReason.UnknownError != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.UnknownError, enum)
+	}
+
+	enum = SetCookieBlockedReason.SchemefulSameSiteStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteStrict"`), &enum)
+	if SetCookieBlockedReason.SchemefulSameSiteStrict != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SchemefulSameSiteStrict, enum)
+	}
+
+	enum = SetCookieBlockedReason.SchemefulSameSiteLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteLax"`), &enum)
+	if SetCookieBlockedReason.SchemefulSameSiteLax != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SchemefulSameSiteLax, enum)
+	}
+
+	enum = SetCookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteUnspecifiedTreatedAsLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteUnspecifiedTreatedAsLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteUnspecifiedTreatedAsLax"`), &enum)
+	if SetCookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax, enum)
+	}
+
+	enum = SetCookieBlockedReason.SamePartyFromCrossPartyContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SamePartyFromCrossPartyContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SamePartyFromCrossPartyContext"`, result)
+	}
+	json.Unmarshal([]byte(`"SamePartyFromCrossPartyContext"`), &enum)
+	if SetCookieBlockedReason.SamePartyFromCrossPartyContext != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SamePartyFromCrossPartyContext, enum)
+	}
+
+	enum = SetCookieBlockedReason.SamePartyConflictsWithOtherAttributes
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SamePartyConflictsWithOtherAttributes"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SamePartyConflictsWithOtherAttributes"`, result)
+	}
+	json.Unmarshal([]byte(`"SamePartyConflictsWithOtherAttributes"`), &enum)
+	if SetCookieBlockedReason.SamePartyConflictsWithOtherAttributes != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.SamePartyConflictsWithOtherAttributes, enum)
+	}
+
+	enum = SetCookieBlockedReason.NameValuePairExceedsMaxSize
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NameValuePairExceedsMaxSize"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NameValuePairExceedsMaxSize"`, result)
+	}
+	json.Unmarshal([]byte(`"NameValuePairExceedsMaxSize"`), &enum)
+	if SetCookieBlockedReason.NameValuePairExceedsMaxSize != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.NameValuePairExceedsMaxSize, enum)
+	}
+
+	enum = SetCookieBlockedReason.DisallowedCharacter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisallowedCharacter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisallowedCharacter"`, result)
+	}
+	json.Unmarshal([]byte(`"DisallowedCharacter"`), &enum)
+	if SetCookieBlockedReason.DisallowedCharacter != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.DisallowedCharacter, enum)
+	}
+
+	enum = SetCookieBlockedReason.NoCookieContent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoCookieContent"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoCookieContent"`, result)
+	}
+	json.Unmarshal([]byte(`"NoCookieContent"`), &enum)
+	if SetCookieBlockedReason.NoCookieContent != enum {
+		t.Errorf("Expected %d, got %d", SetCookieBlockedReason.NoCookieContent, enum)
+	}
+}
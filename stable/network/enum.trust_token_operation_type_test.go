@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumTrustTokenOperationType(t *testing.T) {
+	var enum TrustTokenOperationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TrustTokenOperationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = TrustTokenOperationType.Issuance
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Issuance"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Issuance"`, result)
+	}
+	json.Unmarshal([]byte(`"Issuance"`), &enum)
+	if TrustTokenOperationType.Issuance != enum {
+		t.Errorf("Expected %d, got %d", TrustTokenOperationType.Issuance, enum)
+	}
+
+	enum = TrustTokenOperationType.Redemption
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Redemption"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Redemption"`, result)
+	}
+	json.Unmarshal([]byte(`"Redemption"`), &enum)
+	if TrustTokenOperationType.Redemption != enum {
+		t.Errorf("Expected %d, got %d", TrustTokenOperationType.Redemption, enum)
+	}
+
+	enum = TrustTokenOperationType.Signing
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Signing"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Signing"`, result)
+	}
+	json.Unmarshal([]byte(`"Signing"`), &enum)
+	if TrustTokenOperationType.Signing != enum {
+		t.Errorf("Expected %d, got %d", TrustTokenOperationType.Signing, enum)
+	}
+}
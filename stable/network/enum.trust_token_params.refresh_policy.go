@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type refreshPolicyEnum struct {
+	UseCached RefreshPolicyEnum
+	Refresh   RefreshPolicyEnum
+}
+
+/*
+RefreshPolicy provides named access to the RefreshPolicyEnum values.
+*/
+var RefreshPolicy = refreshPolicyEnum{
+	UseCached: refreshPolicyUseCached,
+	Refresh:   refreshPolicyRefresh,
+}
+
+/*
+RefreshPolicyEnum represents the Network.TrustTokenParams.refreshPolicy values.
+
+Only set for "token-redemption" operation and determine whether to request a
+fresh SRR or use a still valid cached SRR.
+
+Allowed values:
+  - RefreshPolicy.UseCached "UseCached"
+  - RefreshPolicy.Refresh   "Refresh"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TrustTokenParams
+*/
+type RefreshPolicyEnum int
+
+/*
+String implements Stringer
+*/
+func (enum RefreshPolicyEnum) String() string {
+	return _refreshPolicyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RefreshPolicyEnum) Unknown() bool {
+	return !_refreshPolicyEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum RefreshPolicyEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *RefreshPolicyEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _refreshPolicyEnums.Parse(val)
+	return nil
+}
+
+const (
+	// refreshPolicyUseCached represents the "UseCached" value.
+	refreshPolicyUseCached RefreshPolicyEnum = iota + 1
+	// refreshPolicyRefresh represents the "Refresh" value.
+	refreshPolicyRefresh
+)
+
+var _refreshPolicyEnums = enums.New(map[RefreshPolicyEnum]string{
+	RefreshPolicyEnum(0):   "",
+	refreshPolicyUseCached: "UseCached",
+	refreshPolicyRefresh:   "Refresh",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRefreshPolicy(t *testing.T) {
+	var enum RefreshPolicyEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RefreshPolicyEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = RefreshPolicy.UseCached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UseCached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UseCached"`, result)
+	}
+	json.Unmarshal([]byte(`"UseCached"`), &enum)
+	if RefreshPolicy.UseCached != enum {
+		t.Errorf("Expected %d, got %d", RefreshPolicy.UseCached, enum)
+	}
+
+	enum = RefreshPolicy.Refresh
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Refresh"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Refresh"`, result)
+	}
+	json.Unmarshal([]byte(`"Refresh"`), &enum)
+	if RefreshPolicy.Refresh != enum {
+		t.Errorf("Expected %d, got %d", RefreshPolicy.Refresh, enum)
+	}
+}
@@ -0,0 +1,451 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"github.com/mkenney/go-chrome/stable/page"
+)
+
+/*
+DataReceivedEvent represents Network.dataReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-dataReceived
+*/
+type DataReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Data chunk length.
+	DataLength int `json:"dataLength"`
+
+	// Actual bytes received (might be less than dataLength for compressed
+	// encodings).
+	EncodedDataLength int `json:"encodedDataLength"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+EventSourceMessageReceivedEvent represents Network.eventSourceMessageReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-eventSourceMessageReceived
+*/
+type EventSourceMessageReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Message type.
+	EventName string `json:"eventName"`
+
+	// Message identifier.
+	EventID string `json:"eventId"`
+
+	// Message content.
+	Data string `json:"data"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+LoadingFailedEvent represents Network.loadingFailed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFailed
+*/
+type LoadingFailedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Resource type. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	Type page.ResourceTypeEnum `json:"type"`
+
+	// Error message. List of network errors:
+	// https://cs.chromium.org/chromium/src/net/base/net_error_list.h
+	ErrorText string `json:"errorText"`
+
+	// Optional. True if loading was canceled.
+	Canceled bool `json:"canceled,omitempty"`
+
+	// Optional. The reason why loading was blocked, if any. Allowed values:
+	//   - BlockedReason.Other
+	//   - BlockedReason.Csp
+	//   - BlockedReason.MixedContent
+	//   - BlockedReason.Origin
+	//   - BlockedReason.Inspector
+	//   - BlockedReason.Integrity
+	//   - BlockedReason.SubresourceFilter
+	//   - BlockedReason.ContentType
+	//   - BlockedReason.CoepFrameResourceNeedsCoepHeader
+	//   - BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage
+	//   - BlockedReason.CorpNotSameOrigin
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	//   - BlockedReason.CorpNotSameSite
+	//   - BlockedReason.SriMessageSignatureMismatch
+	BlockedReason BlockedReasonEnum `json:"blockedReason,omitempty"`
+
+	// Optional. The reason why loading was blocked by CORS, if any.
+	CorsErrorStatus *CorsErrorStatus `json:"corsErrorStatus,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+LoadingFinishedEvent represents Network.loadingFinished event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFinished
+*/
+type LoadingFinishedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Total number of bytes received for this request.
+	EncodedDataLength float64 `json:"encodedDataLength"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+RequestServedFromCacheEvent represents Network.requestServedFromCache event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestServedFromCache
+*/
+type RequestServedFromCacheEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+RequestWillBeSentEvent represents Network.requestWillBeSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestWillBeSent
+*/
+type RequestWillBeSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// URL of the document this request is loaded for.
+	DocumentURL string `json:"documentURL"`
+
+	// Request data.
+	Request *Request `json:"request"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+
+	// Request initiator.
+	Initiator *Initiator `json:"initiator"`
+
+	// Optional. Redirect response data.
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+
+	// Optional. Type of this resource. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	Type page.ResourceTypeEnum `json:"type,omitempty"`
+
+	// Optional. Frame identifier.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+
+	// Optional. Whether the request is initiated by a user gesture. Defaults to
+	// false.
+	HasUserGesture bool `json:"hasUserGesture,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ResponseReceivedEvent represents Network.responseReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-responseReceived
+*/
+type ResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Resource type. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	Type page.ResourceTypeEnum `json:"type"`
+
+	// Response data.
+	Response *Response `json:"response"`
+
+	// Optional. Frame identifier.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketClosedEvent represents Network.webSocketClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketClosed
+*/
+type WebSocketClosedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketCreatedEvent represents Network.webSocketCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketCreated
+*/
+type WebSocketCreatedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// WebSocket request URL.
+	URL string `json:"url"`
+
+	// Optional. Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketFrameErrorEvent represents Network.webSocketFrameError event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameError
+*/
+type WebSocketFrameErrorEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketFrameReceivedEvent represents Network.webSocketFrameReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameReceived
+*/
+type WebSocketFrameReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// WebSocket response data.
+	Response *WebSocketFrame `json:"response"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketFrameSentEvent represents Network.webSocketFrameSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameSent
+*/
+type WebSocketFrameSentEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// WebSocket response data.
+	Response *WebSocketFrame `json:"response"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketHandshakeResponseReceivedEvent represents Network.webSocketHandshakeResponseReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketHandshakeResponseReceived
+*/
+type WebSocketHandshakeResponseReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// WebSocket response data.
+	Response *WebSocketResponse `json:"response"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebSocketWillSendHandshakeRequestEvent represents Network.webSocketWillSendHandshakeRequest event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketWillSendHandshakeRequest
+*/
+type WebSocketWillSendHandshakeRequestEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// UTC Timestamp.
+	WallTime TimeSinceEpoch `json:"wallTime"`
+
+	// WebSocket request data.
+	Request *WebSocketRequest `json:"request"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebTransportCreatedEvent represents Network.webTransportCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportCreated
+*/
+type WebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// WebTransport request URL.
+	URL string `json:"url"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional. Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebTransportConnectionEstablishedEvent represents Network.webTransportConnectionEstablished event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportConnectionEstablished
+*/
+type WebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebTransportClosedEvent represents Network.webTransportClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportClosed
+*/
+type WebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,1226 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package page provides type definitions for use with the Chrome Page protocol
+
+Actions and events related to the inspected page belong to the page domain.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/
+*/
+package page
+
+import (
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+TimeSinceEpoch represents the Network.TimeSinceEpoch type. This is a duplicate
+of Network.TimeSinceEpoch to avoid an invalid import cycle.
+
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+LoaderID represents the Network.LoaderId type. This is a duplicate of
+Network.LoaderId to avoid an invalid import cycle.
+
+Unique loader identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-LoaderId
+*/
+type LoaderID string
+
+/*
+Rect represents the DOM.Rect type. This is a duplicate of DOM.Rect to avoid an
+invalid import cycle.
+
+Rectangle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#type-Rect
+*/
+type Rect struct {
+	// X coordinate
+	X float64 `json:"x"`
+
+	// Y coordinate
+	Y float64 `json:"y"`
+
+	// Rectangle width
+	Width float64 `json:"width"`
+
+	// Rectangle height
+	Height float64 `json:"height"`
+}
+
+/*
+MonotonicTime represents the Network.MonotonicTime type. This is a duplicate of
+Network.MonotonicTime to avoid an invalid import cycle.
+
+Monotonically increasing time in seconds since an arbitrary point in the past.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-MonotonicTime
+*/
+type MonotonicTime float64
+
+/*
+FrameID represents the Page.FrameId type.
+
+Unique frame identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameId
+*/
+type FrameID string
+
+/*
+AdFrameStatus represents the Page.AdFrameStatus type.
+
+Indicates whether a frame has been identified as an ad and why. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AdFrameStatus
+*/
+type AdFrameStatus struct {
+	// Allowed values:
+	//   - AdFrameType.None
+	//   - AdFrameType.Child
+	//   - AdFrameType.Root
+	AdFrameType AdFrameTypeEnum `json:"adFrameType"`
+
+	// Optional.
+	Explanations []AdFrameExplanationEnum `json:"explanations,omitempty"`
+}
+
+/*
+AdScriptID represents the Page.AdScriptId type.
+
+Identifies the script which caused a script or frame to be labelled as an ad.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AdScriptId
+*/
+type AdScriptID struct {
+	// Script Id of the script which caused a script or frame to be labelled as an
+	// ad.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Id of scriptId's debugger.
+	DebuggerID runtime.UniqueDebuggerID `json:"debuggerId"`
+}
+
+/*
+AdScriptAncestry represents the Page.AdScriptAncestry type.
+
+Encapsulates the script ancestry and the root script filterlist rule that caused
+the frame to be labelled as an ad. Only created when `ancestryChain` is not
+empty. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AdScriptAncestry
+*/
+type AdScriptAncestry struct {
+	// A chain of `AdScriptId`s representing the ancestry of an ad script that led
+	// to the creation of a frame. The chain is ordered from the script itself
+	// (lower level) up to its root ancestor that was flagged by filterlist.
+	AncestryChain []*AdScriptID `json:"ancestryChain"`
+
+	// Optional. The filterlist rule that caused the root (last) script in
+	// `ancestryChain` to be ad-tagged. Only populated if the rule is available.
+	RootScriptFilterlistRule string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+/*
+PermissionsPolicyBlockLocator represents the Page.PermissionsPolicyBlockLocator
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-PermissionsPolicyBlockLocator
+*/
+type PermissionsPolicyBlockLocator struct {
+	FrameID FrameID `json:"frameId"`
+
+	// Allowed values:
+	//   - PermissionsPolicyBlockReason.Header
+	//   - PermissionsPolicyBlockReason.IframeAttribute
+	//   - PermissionsPolicyBlockReason.InFencedFrameTree
+	//   - PermissionsPolicyBlockReason.InIsolatedApp
+	BlockReason PermissionsPolicyBlockReasonEnum `json:"blockReason"`
+}
+
+/*
+PermissionsPolicyFeatureState represents the Page.PermissionsPolicyFeatureState
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-PermissionsPolicyFeatureState
+*/
+type PermissionsPolicyFeatureState struct {
+	// Allowed values:
+	//   - PermissionsPolicyFeature.Accelerometer
+	//   - PermissionsPolicyFeature.AllScreensCapture
+	//   - PermissionsPolicyFeature.AmbientLightSensor
+	//   - PermissionsPolicyFeature.AriaNotify
+	//   - PermissionsPolicyFeature.AttributionReporting
+	//   - PermissionsPolicyFeature.Autoplay
+	//   - PermissionsPolicyFeature.Bluetooth
+	//   - PermissionsPolicyFeature.BrowsingTopics
+	//   - PermissionsPolicyFeature.Camera
+	//   - PermissionsPolicyFeature.CapturedSurfaceControl
+	//   - PermissionsPolicyFeature.ChDpr
+	//   - PermissionsPolicyFeature.ChDeviceMemory
+	//   - PermissionsPolicyFeature.ChDownlink
+	//   - PermissionsPolicyFeature.ChEct
+	//   - PermissionsPolicyFeature.ChPrefersColorScheme
+	//   - PermissionsPolicyFeature.ChPrefersReducedMotion
+	//   - PermissionsPolicyFeature.ChPrefersReducedTransparency
+	//   - PermissionsPolicyFeature.ChRtt
+	//   - PermissionsPolicyFeature.ChSaveData
+	//   - PermissionsPolicyFeature.ChUa
+	//   - PermissionsPolicyFeature.ChUaArch
+	//   - PermissionsPolicyFeature.ChUaBitness
+	//   - PermissionsPolicyFeature.ChUaHighEntropyValues
+	//   - PermissionsPolicyFeature.ChUaPlatform
+	//   - PermissionsPolicyFeature.ChUaModel
+	//   - PermissionsPolicyFeature.ChUaMobile
+	//   - PermissionsPolicyFeature.ChUaFormFactors
+	//   - PermissionsPolicyFeature.ChUaFullVersion
+	//   - PermissionsPolicyFeature.ChUaFullVersionList
+	//   - PermissionsPolicyFeature.ChUaPlatformVersion
+	//   - PermissionsPolicyFeature.ChUaWow64
+	//   - PermissionsPolicyFeature.ChViewportHeight
+	//   - PermissionsPolicyFeature.ChViewportWidth
+	//   - PermissionsPolicyFeature.ChWidth
+	//   - PermissionsPolicyFeature.ClipboardRead
+	//   - PermissionsPolicyFeature.ClipboardWrite
+	//   - PermissionsPolicyFeature.ComputePressure
+	//   - PermissionsPolicyFeature.ControlledFrame
+	//   - PermissionsPolicyFeature.CrossOriginIsolated
+	//   - PermissionsPolicyFeature.DeferredFetch
+	//   - PermissionsPolicyFeature.DeferredFetchMinimal
+	//   - PermissionsPolicyFeature.DeviceAttributes
+	//   - PermissionsPolicyFeature.DigitalCredentialsGet
+	//   - PermissionsPolicyFeature.DirectSockets
+	//   - PermissionsPolicyFeature.DirectSocketsPrivate
+	//   - PermissionsPolicyFeature.DisplayCapture
+	//   - PermissionsPolicyFeature.DocumentDomain
+	//   - PermissionsPolicyFeature.EncryptedMedia
+	//   - PermissionsPolicyFeature.ExecutionWhileOutOfViewport
+	//   - PermissionsPolicyFeature.ExecutionWhileNotRendered
+	//   - PermissionsPolicyFeature.FencedUnpartitionedStorageRead
+	//   - PermissionsPolicyFeature.FocusWithoutUserActivation
+	//   - PermissionsPolicyFeature.Fullscreen
+	//   - PermissionsPolicyFeature.Frobulate
+	//   - PermissionsPolicyFeature.Gamepad
+	//   - PermissionsPolicyFeature.Geolocation
+	//   - PermissionsPolicyFeature.Gyroscope
+	//   - PermissionsPolicyFeature.Hid
+	//   - PermissionsPolicyFeature.IdentityCredentialsGet
+	//   - PermissionsPolicyFeature.IdleDetection
+	//   - PermissionsPolicyFeature.InterestCohort
+	//   - PermissionsPolicyFeature.JoinAdInterestGroup
+	//   - PermissionsPolicyFeature.KeyboardMap
+	//   - PermissionsPolicyFeature.LanguageDetector
+	//   - PermissionsPolicyFeature.LanguageModel
+	//   - PermissionsPolicyFeature.LocalFonts
+	//   - PermissionsPolicyFeature.LocalNetworkAccess
+	//   - PermissionsPolicyFeature.Magnetometer
+	//   - PermissionsPolicyFeature.MediaPlaybackWhileNotVisible
+	//   - PermissionsPolicyFeature.Microphone
+	//   - PermissionsPolicyFeature.Midi
+	//   - PermissionsPolicyFeature.OnDeviceSpeechRecognition
+	//   - PermissionsPolicyFeature.OtpCredentials
+	//   - PermissionsPolicyFeature.Payment
+	//   - PermissionsPolicyFeature.PictureInPicture
+	//   - PermissionsPolicyFeature.Popins
+	//   - PermissionsPolicyFeature.PrivateAggregation
+	//   - PermissionsPolicyFeature.PrivateStateTokenIssuance
+	//   - PermissionsPolicyFeature.PrivateStateTokenRedemption
+	//   - PermissionsPolicyFeature.PublickeyCredentialsCreate
+	//   - PermissionsPolicyFeature.PublickeyCredentialsGet
+	//   - PermissionsPolicyFeature.RecordAdAuctionEvents
+	//   - PermissionsPolicyFeature.Rewriter
+	//   - PermissionsPolicyFeature.RunAdAuction
+	//   - PermissionsPolicyFeature.ScreenWakeLock
+	//   - PermissionsPolicyFeature.Serial
+	//   - PermissionsPolicyFeature.SharedAutofill
+	//   - PermissionsPolicyFeature.SharedStorage
+	//   - PermissionsPolicyFeature.SharedStorageSelectURL
+	//   - PermissionsPolicyFeature.SmartCard
+	//   - PermissionsPolicyFeature.SpeakerSelection
+	//   - PermissionsPolicyFeature.StorageAccess
+	//   - PermissionsPolicyFeature.SubApps
+	//   - PermissionsPolicyFeature.Summarizer
+	//   - PermissionsPolicyFeature.SyncXHR
+	//   - PermissionsPolicyFeature.Translator
+	//   - PermissionsPolicyFeature.Unload
+	//   - PermissionsPolicyFeature.Usb
+	//   - PermissionsPolicyFeature.UsbUnrestricted
+	//   - PermissionsPolicyFeature.VerticalScroll
+	//   - PermissionsPolicyFeature.WebAppInstallation
+	//   - PermissionsPolicyFeature.WebPrinting
+	//   - PermissionsPolicyFeature.WebShare
+	//   - PermissionsPolicyFeature.WindowManagement
+	//   - PermissionsPolicyFeature.Writer
+	//   - PermissionsPolicyFeature.XrSpatialTracking
+	Feature PermissionsPolicyFeatureEnum `json:"feature"`
+
+	Allowed bool `json:"allowed"`
+
+	// Optional.
+	Locator *PermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+/*
+OriginTrialToken represents the Page.OriginTrialToken type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-OriginTrialToken
+*/
+type OriginTrialToken struct {
+	Origin string `json:"origin"`
+
+	MatchSubDomains bool `json:"matchSubDomains"`
+
+	TrialName string `json:"trialName"`
+
+	ExpiryTime TimeSinceEpoch `json:"expiryTime"`
+
+	IsThirdParty bool `json:"isThirdParty"`
+
+	// Allowed values:
+	//   - OriginTrialUsageRestriction.None
+	//   - OriginTrialUsageRestriction.Subset
+	UsageRestriction OriginTrialUsageRestrictionEnum `json:"usageRestriction"`
+}
+
+/*
+OriginTrialTokenWithStatus represents the Page.OriginTrialTokenWithStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-OriginTrialTokenWithStatus
+*/
+type OriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+
+	// Optional. `parsedToken` is present only when the token is extractable and
+	// parsable.
+	ParsedToken *OriginTrialToken `json:"parsedToken,omitempty"`
+
+	// Allowed values:
+	//   - OriginTrialTokenStatus.Success
+	//   - OriginTrialTokenStatus.NotSupported
+	//   - OriginTrialTokenStatus.Insecure
+	//   - OriginTrialTokenStatus.Expired
+	//   - OriginTrialTokenStatus.WrongOrigin
+	//   - OriginTrialTokenStatus.InvalidSignature
+	//   - OriginTrialTokenStatus.Malformed
+	//   - OriginTrialTokenStatus.WrongVersion
+	//   - OriginTrialTokenStatus.FeatureDisabled
+	//   - OriginTrialTokenStatus.TokenDisabled
+	//   - OriginTrialTokenStatus.FeatureDisabledForUser
+	//   - OriginTrialTokenStatus.UnknownTrial
+	Status OriginTrialTokenStatusEnum `json:"status"`
+}
+
+/*
+OriginTrial represents the Page.OriginTrial type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-OriginTrial
+*/
+type OriginTrial struct {
+	TrialName string `json:"trialName"`
+
+	// Allowed values:
+	//   - OriginTrialStatus.Enabled
+	//   - OriginTrialStatus.ValidTokenNotProvided
+	//   - OriginTrialStatus.OSNotSupported
+	//   - OriginTrialStatus.TrialNotAllowed
+	Status OriginTrialStatusEnum `json:"status"`
+
+	TokensWithStatus []*OriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+/*
+SecurityOriginDetails represents the Page.SecurityOriginDetails type.
+
+Additional information about the frame document's security origin. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-SecurityOriginDetails
+*/
+type SecurityOriginDetails struct {
+	// Indicates whether the frame document's security origin is one of the local
+	// hostnames (e.g. "localhost") or IP addresses (IPv4 127.0.0.0/8 or IPv6 ::1).
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+/*
+Frame represents the Page.Frame type.
+
+Information about the Frame on the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Frame
+*/
+type Frame struct {
+	// Frame unique identifier.
+	ID FrameID `json:"id"`
+
+	// Optional. Parent frame identifier.
+	ParentID FrameID `json:"parentId,omitempty"`
+
+	// Identifier of the loader associated with this frame.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// Optional. Frame's name as specified in the tag.
+	Name string `json:"name,omitempty"`
+
+	// Frame document's URL without fragment.
+	URL string `json:"url"`
+
+	// Frame document's security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Frame document's mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+}
+
+/*
+FrameResource represents the Page.FrameResource type.
+
+Information about the Resource on the page. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameResource
+*/
+type FrameResource struct {
+	// Resource URL.
+	URL string `json:"url"`
+
+	// Type of this resource. Allowed values:
+	//   - ResourceType.Document
+	//   - ResourceType.Stylesheet
+	//   - ResourceType.Image
+	//   - ResourceType.Media
+	//   - ResourceType.Font
+	//   - ResourceType.Script
+	//   - ResourceType.TextTrack
+	//   - ResourceType.XHR
+	//   - ResourceType.Fetch
+	//   - ResourceType.Prefetch
+	//   - ResourceType.EventSource
+	//   - ResourceType.WebSocket
+	//   - ResourceType.Manifest
+	//   - ResourceType.SignedExchange
+	//   - ResourceType.Ping
+	//   - ResourceType.CSPViolationReport
+	//   - ResourceType.Preflight
+	//   - ResourceType.FedCM
+	//   - ResourceType.Other
+	Type ResourceTypeEnum `json:"type"`
+
+	// Resource mimeType as determined by the browser.
+	MimeType string `json:"mimeType"`
+
+	// Optional. last-modified timestamp as reported by server.
+	LastModified TimeSinceEpoch `json:"lastModified,omitempty"`
+
+	// Optional. Resource content size.
+	ContentSize float64 `json:"contentSize,omitempty"`
+
+	// Optional. True if the resource failed to load.
+	Failed bool `json:"failed,omitempty"`
+
+	// Optional. True if the resource was canceled during loading.
+	Canceled bool `json:"canceled,omitempty"`
+}
+
+/*
+FrameResourceTree represents the Page.FrameResourceTree type.
+
+Information about the Frame hierarchy along with their cached resources.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameResourceTree
+*/
+type FrameResourceTree struct {
+	// Frame information for this tree item.
+	Frame *Frame `json:"frame"`
+
+	// Optional. Child frames.
+	ChildFrames []*FrameResourceTree `json:"childFrames,omitempty"`
+
+	// Information about frame resources.
+	Resources []*FrameResource `json:"resources"`
+}
+
+/*
+FrameTree represents the Page.FrameTree type.
+
+Information about the Frame hierarchy.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FrameTree
+*/
+type FrameTree struct {
+	// Frame information for this tree item.
+	Frame *Frame `json:"frame"`
+
+	// Optional. Child frames.
+	ChildFrames []*FrameTree `json:"childFrames,omitempty"`
+}
+
+/*
+ScriptIdentifier represents the Page.ScriptIdentifier type.
+
+Unique script identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ScriptIdentifier
+*/
+type ScriptIdentifier string
+
+/*
+TransitionType represents the Page.TransitionType type.
+
+Transition type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-TransitionType
+*/
+type TransitionType string
+
+/*
+NavigationEntry represents the Page.NavigationEntry type.
+
+Navigation history entry.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-NavigationEntry
+*/
+type NavigationEntry struct {
+	// Unique id of the navigation history entry.
+	ID int `json:"id"`
+
+	// URL of the navigation history entry.
+	URL string `json:"url"`
+
+	// URL that the user typed in the url bar.
+	UserTypedURL string `json:"userTypedURL"`
+
+	// Title of the navigation history entry.
+	Title string `json:"title"`
+
+	// Transition type.
+	TransitionType TransitionType `json:"transitionType"`
+}
+
+/*
+ScreencastFrameMetadata represents the Page.ScreencastFrameMetadata type.
+
+Screencast frame metadata. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ScreencastFrameMetadata
+*/
+type ScreencastFrameMetadata struct {
+	// Top offset in DIP.
+	OffsetTop float64 `json:"offsetTop"`
+
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+
+	// Device screen width in DIP.
+	DeviceWidth float64 `json:"deviceWidth"`
+
+	// Device screen height in DIP.
+	DeviceHeight float64 `json:"deviceHeight"`
+
+	// Position of horizontal scroll in CSS pixels.
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+
+	// Position of vertical scroll in CSS pixels.
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+
+	// Optional. Frame swap timestamp.
+	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+/*
+AppManifestError represents the Page.AppManifestError type.
+
+Error while paring app manifest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AppManifestError
+*/
+type AppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+
+	// If critical, this is a non-recoverable parse error.
+	Critical int `json:"critical"`
+
+	// Error line.
+	Line int `json:"line"`
+
+	// Error column.
+	Column int `json:"column"`
+}
+
+/*
+AppManifestParsedProperties represents the Page.AppManifestParsedProperties
+type.
+
+Parsed app manifest properties. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-AppManifestParsedProperties
+*/
+type AppManifestParsedProperties struct {
+	// Computed scope value
+	Scope string `json:"scope"`
+}
+
+/*
+LayoutViewport represents the Page.LayoutViewport type.
+
+Layout viewport position and dimensions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-LayoutViewport
+*/
+type LayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int `json:"pageX"`
+
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int `json:"pageY"`
+
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int `json:"clientWidth"`
+
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int `json:"clientHeight"`
+}
+
+/*
+VisualViewport represents the Page.VisualViewport type.
+
+Visual viewport position, dimensions, and scale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-VisualViewport
+*/
+type VisualViewport struct {
+	// Horizontal offset relative to the layout viewport (CSS pixels).
+	OffsetX float64 `json:"offsetX"`
+
+	// Vertical offset relative to the layout viewport (CSS pixels).
+	OffsetY float64 `json:"offsetY"`
+
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX float64 `json:"pageX"`
+
+	// Vertical offset relative to the document (CSS pixels).
+	PageY float64 `json:"pageY"`
+
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth float64 `json:"clientWidth"`
+
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight float64 `json:"clientHeight"`
+
+	// Scale relative to the ideal viewport (size at width=device-width).
+	Scale float64 `json:"scale"`
+
+	// Optional. Page zoom factor (CSS to device independent pixels ratio).
+	Zoom float64 `json:"zoom,omitempty"`
+}
+
+/*
+Viewport represents the Page.Viewport type.
+
+Viewport for capturing screenshot.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Viewport
+*/
+type Viewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+/*
+FontFamilies represents the Page.FontFamilies type.
+
+Generic font families collection. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FontFamilies
+*/
+type FontFamilies struct {
+	// Optional. The standard font-family.
+	Standard string `json:"standard,omitempty"`
+
+	// Optional. The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+
+	// Optional. The serif font-family.
+	Serif string `json:"serif,omitempty"`
+
+	// Optional. The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+
+	// Optional. The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+
+	// Optional. The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+
+	// Optional. The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+/*
+ScriptFontFamilies represents the Page.ScriptFontFamilies type.
+
+Font families collection for a script. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ScriptFontFamilies
+*/
+type ScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+
+	// Generic font families collection for the script.
+	FontFamilies *FontFamilies `json:"fontFamilies"`
+}
+
+/*
+FontSizes represents the Page.FontSizes type.
+
+Default font sizes. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FontSizes
+*/
+type FontSizes struct {
+	// Optional. Default standard font size.
+	Standard int `json:"standard,omitempty"`
+
+	// Optional. Default fixed font size.
+	Fixed int `json:"fixed,omitempty"`
+}
+
+/*
+InstallabilityErrorArgument represents the Page.InstallabilityErrorArgument
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-InstallabilityErrorArgument
+*/
+type InstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+/*
+InstallabilityError represents the Page.InstallabilityError type.
+
+The installability error EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-InstallabilityError
+*/
+type InstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels',
+	// value:'64'}).
+	ErrorArguments []*InstallabilityErrorArgument `json:"errorArguments"`
+}
+
+/*
+CompilationCacheParams represents the Page.CompilationCacheParams type.
+
+Per-script compilation cache parameters for `Page.produceCompilationCache`
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-CompilationCacheParams
+*/
+type CompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+
+	// Optional. A hint to the backend whether eager compilation is recommended.
+	// (the actual compilation mode used is upon backend discretion).
+	Eager bool `json:"eager,omitempty"`
+}
+
+/*
+FileFilter represents the Page.FileFilter type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FileFilter
+*/
+type FileFilter struct {
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional.
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+/*
+FileHandler represents the Page.FileHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-FileHandler
+*/
+type FileHandler struct {
+	Action string `json:"action"`
+
+	Name string `json:"name"`
+
+	// Optional.
+	Icons []*ImageResource `json:"icons,omitempty"`
+
+	// Optional. Mimic a map, name is the key, accepts is the value.
+	Accepts []*FileFilter `json:"accepts,omitempty"`
+
+	// Won't repeat the enums, using string for easy comparison. Same as the other
+	// enums below.
+	LaunchType string `json:"launchType"`
+}
+
+/*
+ImageResource represents the Page.ImageResource type.
+
+The image definition used in both icon and screenshot. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ImageResource
+*/
+type ImageResource struct {
+	// The src field in the definition, but changing to url in favor of
+	// consistency.
+	URL string `json:"url"`
+
+	// Optional.
+	Sizes string `json:"sizes,omitempty"`
+
+	// Optional.
+	Type string `json:"type,omitempty"`
+}
+
+/*
+LaunchHandler represents the Page.LaunchHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-LaunchHandler
+*/
+type LaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+/*
+ProtocolHandler represents the Page.ProtocolHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ProtocolHandler
+*/
+type ProtocolHandler struct {
+	Protocol string `json:"protocol"`
+
+	URL string `json:"url"`
+}
+
+/*
+RelatedApplication represents the Page.RelatedApplication type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-RelatedApplication
+*/
+type RelatedApplication struct {
+	// Optional.
+	ID string `json:"id,omitempty"`
+
+	URL string `json:"url"`
+}
+
+/*
+ScopeExtension represents the Page.ScopeExtension type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ScopeExtension
+*/
+type ScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string for
+	// easy understanding and comparison.
+	Origin string `json:"origin"`
+
+	HasOriginWildcard bool `json:"hasOriginWildcard"`
+}
+
+/*
+Screenshot represents the Page.Screenshot type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Screenshot
+*/
+type Screenshot struct {
+	Image *ImageResource `json:"image"`
+
+	FormFactor string `json:"formFactor"`
+
+	// Optional.
+	Label string `json:"label,omitempty"`
+}
+
+/*
+ShareTarget represents the Page.ShareTarget type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ShareTarget
+*/
+type ShareTarget struct {
+	Action string `json:"action"`
+
+	Method string `json:"method"`
+
+	Enctype string `json:"enctype"`
+
+	// Optional. Embed the ShareTargetParams
+	Title string `json:"title,omitempty"`
+
+	// Optional.
+	Text string `json:"text,omitempty"`
+
+	// Optional.
+	URL string `json:"url,omitempty"`
+
+	// Optional.
+	Files []*FileFilter `json:"files,omitempty"`
+}
+
+/*
+Shortcut represents the Page.Shortcut type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-Shortcut
+*/
+type Shortcut struct {
+	Name string `json:"name"`
+
+	URL string `json:"url"`
+}
+
+/*
+WebAppManifest represents the Page.WebAppManifest type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-WebAppManifest
+*/
+type WebAppManifest struct {
+	// Optional.
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+
+	// Optional. The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+
+	// Optional.
+	Dir string `json:"dir,omitempty"`
+
+	// Optional.
+	Display string `json:"display,omitempty"`
+
+	// Optional. The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+
+	// Optional. The handlers to open files.
+	FileHandlers []*FileHandler `json:"fileHandlers,omitempty"`
+
+	// Optional.
+	Icons []*ImageResource `json:"icons,omitempty"`
+
+	// Optional.
+	ID string `json:"id,omitempty"`
+
+	// Optional.
+	Lang string `json:"lang,omitempty"`
+
+	// Optional. TODO(crbug.com/1231886): This field is non-standard and part of a
+	// Chrome experiment. See:
+	// https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	LaunchHandler *LaunchHandler `json:"launchHandler,omitempty"`
+
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional.
+	Orientation string `json:"orientation,omitempty"`
+
+	// Optional.
+	PreferRelatedApplications bool `json:"preferRelatedApplications,omitempty"`
+
+	// Optional. The handlers to open protocols.
+	ProtocolHandlers []*ProtocolHandler `json:"protocolHandlers,omitempty"`
+
+	// Optional.
+	RelatedApplications []*RelatedApplication `json:"relatedApplications,omitempty"`
+
+	// Optional.
+	Scope string `json:"scope,omitempty"`
+
+	// Optional. Non-standard, see
+	// https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	ScopeExtensions []*ScopeExtension `json:"scopeExtensions,omitempty"`
+
+	// Optional. The screenshots used by chromium.
+	Screenshots []*Screenshot `json:"screenshots,omitempty"`
+
+	// Optional.
+	ShareTarget *ShareTarget `json:"shareTarget,omitempty"`
+
+	// Optional.
+	ShortName string `json:"shortName,omitempty"`
+
+	// Optional.
+	Shortcuts []*Shortcut `json:"shortcuts,omitempty"`
+
+	// Optional.
+	StartURL string `json:"startUrl,omitempty"`
+
+	// Optional.
+	ThemeColor string `json:"themeColor,omitempty"`
+}
+
+/*
+BackForwardCacheBlockingDetails represents the
+Page.BackForwardCacheBlockingDetails type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-BackForwardCacheBlockingDetails
+*/
+type BackForwardCacheBlockingDetails struct {
+	// Optional. Url of the file where blockage happened. Optional because of
+	// tests.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Function name where blockage happened. Optional because of
+	// anonymous functions and tests.
+	Function string `json:"function,omitempty"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber"`
+}
+
+/*
+BackForwardCacheNotRestoredExplanation represents the
+Page.BackForwardCacheNotRestoredExplanation type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-BackForwardCacheNotRestoredExplanation
+*/
+type BackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason Allowed values:
+	//   - BackForwardCacheNotRestoredReasonType.SupportPending
+	//   - BackForwardCacheNotRestoredReasonType.PageSupportNeeded
+	//   - BackForwardCacheNotRestoredReasonType.Circumstantial
+	Type BackForwardCacheNotRestoredReasonTypeEnum `json:"type"`
+
+	// Not restored reason Allowed values:
+	//   - BackForwardCacheNotRestoredReason.NotPrimaryMainFrame
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabled
+	//   - BackForwardCacheNotRestoredReason.RelatedActiveContentsExist
+	//   - BackForwardCacheNotRestoredReason.HTTPStatusNotOK
+	//   - BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS
+	//   - BackForwardCacheNotRestoredReason.Loading
+	//   - BackForwardCacheNotRestoredReason.WasGrantedMediaAccess
+	//   - BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled
+	//   - BackForwardCacheNotRestoredReason.DomainNotAllowed
+	//   - BackForwardCacheNotRestoredReason.HTTPMethodNotGET
+	//   - BackForwardCacheNotRestoredReason.SubframeIsNavigating
+	//   - BackForwardCacheNotRestoredReason.Timeout
+	//   - BackForwardCacheNotRestoredReason.CacheLimit
+	//   - BackForwardCacheNotRestoredReason.JavaScriptExecution
+	//   - BackForwardCacheNotRestoredReason.RendererProcessKilled
+	//   - BackForwardCacheNotRestoredReason.RendererProcessCrashed
+	//   - BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed
+	//   - BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance
+	//   - BackForwardCacheNotRestoredReason.CacheFlushed
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation
+	//   - BackForwardCacheNotRestoredReason.SessionRestored
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage
+	//   - BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded
+	//   - BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite
+	//   - BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerClaim
+	//   - BackForwardCacheNotRestoredReason.IgnoreEventAndEvict
+	//   - BackForwardCacheNotRestoredReason.HaveInnerContents
+	//   - BackForwardCacheNotRestoredReason.TimeoutPuttingInCache
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestRedirected
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestTimeout
+	//   - BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit
+	//   - BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring
+	//   - BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender
+	//   - BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers
+	//   - BackForwardCacheNotRestoredReason.ForegroundCacheLimit
+	//   - BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate
+	//   - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame
+	//   - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified
+	//   - BackForwardCacheNotRestoredReason.NoResponseHead
+	//   - BackForwardCacheNotRestoredReason.Unknown
+	//   - BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857
+	//   - BackForwardCacheNotRestoredReason.ErrorDocument
+	//   - BackForwardCacheNotRestoredReason.FencedFramesEmbedder
+	//   - BackForwardCacheNotRestoredReason.CookieDisabled
+	//   - BackForwardCacheNotRestoredReason.HTTPAuthRequired
+	//   - BackForwardCacheNotRestoredReason.CookieFlushed
+	//   - BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage
+	//   - BackForwardCacheNotRestoredReason.WebViewSettingsChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected
+	//   - BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged
+	//   - BackForwardCacheNotRestoredReason.WebSocket
+	//   - BackForwardCacheNotRestoredReason.WebTransport
+	//   - BackForwardCacheNotRestoredReason.WebRTC
+	//   - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache
+	//   - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache
+	//   - BackForwardCacheNotRestoredReason.ContainsPlugins
+	//   - BackForwardCacheNotRestoredReason.DocumentLoaded
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers
+	//   - BackForwardCacheNotRestoredReason.RequestedMIDIPermission
+	//   - BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission
+	//   - BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission
+	//   - BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors
+	//   - BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission
+	//   - BackForwardCacheNotRestoredReason.BroadcastChannel
+	//   - BackForwardCacheNotRestoredReason.WebXR
+	//   - BackForwardCacheNotRestoredReason.SharedWorker
+	//   - BackForwardCacheNotRestoredReason.SharedWorkerMessage
+	//   - BackForwardCacheNotRestoredReason.WebLocks
+	//   - BackForwardCacheNotRestoredReason.WebHID
+	//   - BackForwardCacheNotRestoredReason.WebShare
+	//   - BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant
+	//   - BackForwardCacheNotRestoredReason.WebNfc
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR
+	//   - BackForwardCacheNotRestoredReason.AppBanner
+	//   - BackForwardCacheNotRestoredReason.Printing
+	//   - BackForwardCacheNotRestoredReason.WebDatabase
+	//   - BackForwardCacheNotRestoredReason.PictureInPicture
+	//   - BackForwardCacheNotRestoredReason.SpeechRecognizer
+	//   - BackForwardCacheNotRestoredReason.IdleManager
+	//   - BackForwardCacheNotRestoredReason.PaymentManager
+	//   - BackForwardCacheNotRestoredReason.SpeechSynthesis
+	//   - BackForwardCacheNotRestoredReason.KeyboardLock
+	//   - BackForwardCacheNotRestoredReason.WebOTPService
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket
+	//   - BackForwardCacheNotRestoredReason.InjectedJavascript
+	//   - BackForwardCacheNotRestoredReason.InjectedStyleSheet
+	//   - BackForwardCacheNotRestoredReason.KeepaliveRequest
+	//   - BackForwardCacheNotRestoredReason.IndexedDBEvent
+	//   - BackForwardCacheNotRestoredReason.Dummy
+	//   - BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource
+	//   - BackForwardCacheNotRestoredReason.WebRTCSticky
+	//   - BackForwardCacheNotRestoredReason.WebTransportSticky
+	//   - BackForwardCacheNotRestoredReason.WebSocketSticky
+	//   - BackForwardCacheNotRestoredReason.SmartCard
+	//   - BackForwardCacheNotRestoredReason.LiveMediaStreamTrack
+	//   - BackForwardCacheNotRestoredReason.UnloadHandler
+	//   - BackForwardCacheNotRestoredReason.ParserAborted
+	//   - BackForwardCacheNotRestoredReason.ContentSecurityHandler
+	//   - BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI
+	//   - BackForwardCacheNotRestoredReason.ContentFileChooser
+	//   - BackForwardCacheNotRestoredReason.ContentSerial
+	//   - BackForwardCacheNotRestoredReason.ContentFileSystemAccess
+	//   - BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost
+	//   - BackForwardCacheNotRestoredReason.ContentWebBluetooth
+	//   - BackForwardCacheNotRestoredReason.ContentWebUSB
+	//   - BackForwardCacheNotRestoredReason.ContentMediaSessionService
+	//   - BackForwardCacheNotRestoredReason.ContentScreenReader
+	//   - BackForwardCacheNotRestoredReason.ContentDiscarded
+	//   - BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper
+	//   - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker
+	//   - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails
+	//   - BackForwardCacheNotRestoredReason.EmbedderAppBannerManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource
+	//   - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate
+	//   - BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper
+	//   - BackForwardCacheNotRestoredReason.EmbedderOfflinePage
+	//   - BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderModalDialog
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensions
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame
+	//   - BackForwardCacheNotRestoredReason.RequestedByWebViewClient
+	//   - BackForwardCacheNotRestoredReason.PostMessageByWebViewClient
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated
+	//   - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure
+	//   - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure
+	Reason BackForwardCacheNotRestoredReasonEnum `json:"reason"`
+
+	// Optional. Context associated with the reason. The meaning of this context is
+	// dependent on the reason:
+	// - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string `json:"context,omitempty"`
+
+	// Optional.
+	Details []*BackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+/*
+BackForwardCacheNotRestoredExplanationTree represents the
+Page.BackForwardCacheNotRestoredExplanationTree type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-BackForwardCacheNotRestoredExplanationTree
+*/
+type BackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame
+	URL string `json:"url"`
+
+	// Not restored reasons of each frame
+	Explanations []*BackForwardCacheNotRestoredExplanation `json:"explanations"`
+
+	// Array of children frame
+	Children []*BackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
@@ -0,0 +1,519 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+AddScriptToEvaluateOnNewDocumentParams represents Page.addScriptToEvaluateOnNewDocument parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+type AddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+}
+
+/*
+AddScriptToEvaluateOnNewDocumentResult represents the result of calls to Page.addScriptToEvaluateOnNewDocument.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+type AddScriptToEvaluateOnNewDocumentResult struct {
+	// Identifier of the added script.
+	Identifier ScriptIdentifier `json:"identifier"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+BringToFrontResult represents the result of calls to Page.bringToFront.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-bringToFront
+*/
+type BringToFrontResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CaptureScreenshotParams represents Page.captureScreenshot parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+type CaptureScreenshotParams struct {
+	// Optional. Image compression format (defaults to png). Allowed values:
+	//   - Format.Jpeg
+	//   - Format.Png
+	//   - Format.Webp
+	Format FormatEnum `json:"format,omitempty"`
+
+	// Optional. Compression quality from range [0..100] (jpeg only).
+	Quality *int `json:"quality,omitempty"`
+
+	// Optional. Capture the screenshot of a given region only.
+	Clip *Viewport `json:"clip,omitempty"`
+}
+
+/*
+CaptureScreenshotResult represents the result of calls to Page.captureScreenshot.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+type CaptureScreenshotResult struct {
+	// Base64-encoded image data. (Encoded as a base64 string when passed over
+	// JSON)
+	Data string `json:"data"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CreateIsolatedWorldParams represents Page.createIsolatedWorld parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-createIsolatedWorld
+*/
+type CreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameID FrameID `json:"frameId"`
+
+	// Optional. An optional name which is reported in the Execution Context.
+	WorldName *string `json:"worldName,omitempty"`
+
+	// Optional. Whether or not universal access should be granted to the isolated
+	// world. This is a powerful option, use with caution.
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
+}
+
+/*
+CreateIsolatedWorldResult represents the result of calls to Page.createIsolatedWorld.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-createIsolatedWorld
+*/
+type CreateIsolatedWorldResult struct {
+	// Execution context of the isolated world.
+	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Page.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Page.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAppManifestParams represents Page.getAppManifest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getAppManifest
+*/
+type GetAppManifestParams struct {
+	// Optional.
+	ManifestID *string `json:"manifestId,omitempty"`
+}
+
+/*
+GetAppManifestResult represents the result of calls to Page.getAppManifest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getAppManifest
+*/
+type GetAppManifestResult struct {
+	// Manifest location.
+	URL string `json:"url"`
+
+	Errors []*AppManifestError `json:"errors"`
+
+	// Optional. Manifest content.
+	Data string `json:"data,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetFrameTreeResult represents the result of calls to Page.getFrameTree.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getFrameTree
+*/
+type GetFrameTreeResult struct {
+	// Present frame tree structure.
+	FrameTree *FrameTree `json:"frameTree"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetLayoutMetricsResult represents the result of calls to Page.getLayoutMetrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getLayoutMetrics
+*/
+type GetLayoutMetricsResult struct {
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport *LayoutViewport `json:"cssLayoutViewport"`
+
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport *VisualViewport `json:"cssVisualViewport"`
+
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize *Rect `json:"cssContentSize"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetNavigationHistoryResult represents the result of calls to Page.getNavigationHistory.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getNavigationHistory
+*/
+type GetNavigationHistoryResult struct {
+	// Index of the current navigation history entry.
+	CurrentIndex int `json:"currentIndex"`
+
+	// Array of navigation history entries.
+	Entries []*NavigationEntry `json:"entries"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResetNavigationHistoryResult represents the result of calls to Page.resetNavigationHistory.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-resetNavigationHistory
+*/
+type ResetNavigationHistoryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HandleJavaScriptDialogParams represents Page.handleJavaScriptDialog parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-handleJavaScriptDialog
+*/
+type HandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+
+	// Optional. The text to enter into the dialog prompt before accepting. Used
+	// only if this is a prompt dialog.
+	PromptText *string `json:"promptText,omitempty"`
+}
+
+/*
+HandleJavaScriptDialogResult represents the result of calls to Page.handleJavaScriptDialog.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-handleJavaScriptDialog
+*/
+type HandleJavaScriptDialogResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+NavigateParams represents Page.navigate parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigate
+*/
+type NavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+
+	// Optional. Referrer URL.
+	Referrer *string `json:"referrer,omitempty"`
+
+	// Optional. Intended transition type.
+	TransitionType *TransitionType `json:"transitionType,omitempty"`
+
+	// Optional. Frame id to navigate, if not specified navigates the top frame.
+	FrameID *FrameID `json:"frameId,omitempty"`
+}
+
+/*
+NavigateResult represents the result of calls to Page.navigate.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigate
+*/
+type NavigateResult struct {
+	// Frame id that has navigated (or failed to navigate)
+	FrameID FrameID `json:"frameId"`
+
+	// Optional. Loader identifier. This is omitted in case of same-document
+	// navigation, as the previously committed loaderId would not change.
+	LoaderID LoaderID `json:"loaderId,omitempty"`
+
+	// Optional. User friendly error message, present if and only if navigation has
+	// failed.
+	ErrorText string `json:"errorText,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+NavigateToHistoryEntryParams represents Page.navigateToHistoryEntry parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigateToHistoryEntry
+*/
+type NavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryID int `json:"entryId"`
+}
+
+/*
+NavigateToHistoryEntryResult represents the result of calls to Page.navigateToHistoryEntry.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigateToHistoryEntry
+*/
+type NavigateToHistoryEntryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+PrintToPDFParams represents Page.printToPDF parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-printToPDF
+*/
+type PrintToPDFParams struct {
+	// Optional. Paper orientation. Defaults to false.
+	Landscape *bool `json:"landscape,omitempty"`
+
+	// Optional. Display header and footer. Defaults to false.
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
+
+	// Optional. Print background graphics. Defaults to false.
+	PrintBackground *bool `json:"printBackground,omitempty"`
+
+	// Optional. Scale of the webpage rendering. Defaults to 1.
+	Scale *float64 `json:"scale,omitempty"`
+
+	// Optional. Paper width in inches. Defaults to 8.5 inches.
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
+
+	// Optional. Paper height in inches. Defaults to 11 inches.
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
+
+	// Optional. Top margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginTop *float64 `json:"marginTop,omitempty"`
+
+	// Optional. Bottom margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
+
+	// Optional. Left margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
+
+	// Optional. Right margin in inches. Defaults to 1cm (~0.4 inches).
+	MarginRight *float64 `json:"marginRight,omitempty"`
+
+	// Optional. Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are
+	// printed in the document order, not in the order specified, and no more than
+	// once. Defaults to empty string, which implies the entire document is
+	// printed. The page numbers are quietly capped to actual page count of the
+	// document, and ranges beyond the end of the document are ignored. If this
+	// results in no pages to print, an error is reported. It is an error to
+	// specify a range with start greater than end.
+	PageRanges *string `json:"pageRanges,omitempty"`
+
+	// Optional. HTML template for the print header. Should be valid HTML markup
+	// with following classes used to inject printing values into them:
+	// - `date`: formatted print date
+	// - `title`: document title
+	// - `url`: document location
+	// - `pageNumber`: current page number
+	// - `totalPages`: total pages in the document
+	//
+	// For example, `<span class=title></span>` would generate span containing the
+	// title.
+	HeaderTemplate *string `json:"headerTemplate,omitempty"`
+
+	// Optional. HTML template for the print footer. Should use the same format as
+	// the `headerTemplate`.
+	FooterTemplate *string `json:"footerTemplate,omitempty"`
+
+	// Optional. Whether or not to prefer page size as defined by css. Defaults to
+	// false, in which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize *bool `json:"preferCSSPageSize,omitempty"`
+}
+
+/*
+PrintToPDFResult represents the result of calls to Page.printToPDF.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-printToPDF
+*/
+type PrintToPDFResult struct {
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as
+	// a base64 string when passed over JSON)
+	Data string `json:"data"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ReloadParams represents Page.reload parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-reload
+*/
+type ReloadParams struct {
+	// Optional. If true, browser cache is ignored (as if the user pressed
+	// Shift+refresh).
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
+
+	// Optional. If set, the script will be injected into all frames of the
+	// inspected page after reload. Argument will be ignored if reloading dataURL
+	// origin.
+	ScriptToEvaluateOnLoad *string `json:"scriptToEvaluateOnLoad,omitempty"`
+}
+
+/*
+ReloadResult represents the result of calls to Page.reload.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-reload
+*/
+type ReloadResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocumentParams represents Page.removeScriptToEvaluateOnNewDocument parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+type RemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+/*
+RemoveScriptToEvaluateOnNewDocumentResult represents the result of calls to Page.removeScriptToEvaluateOnNewDocument.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+type RemoveScriptToEvaluateOnNewDocumentResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetBypassCSPParams represents Page.setBypassCSP parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setBypassCSP
+*/
+type SetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetBypassCSPResult represents the result of calls to Page.setBypassCSP.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setBypassCSP
+*/
+type SetBypassCSPResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDocumentContentParams represents Page.setDocumentContent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setDocumentContent
+*/
+type SetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameID FrameID `json:"frameId"`
+
+	// HTML content to set.
+	HTML string `json:"html"`
+}
+
+/*
+SetDocumentContentResult represents the result of calls to Page.setDocumentContent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setDocumentContent
+*/
+type SetDocumentContentResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetLifecycleEventsEnabledParams represents Page.setLifecycleEventsEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setLifecycleEventsEnabled
+*/
+type SetLifecycleEventsEnabledParams struct {
+	// If true, starts emitting lifecycle events.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetLifecycleEventsEnabledResult represents the result of calls to Page.setLifecycleEventsEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setLifecycleEventsEnabled
+*/
+type SetLifecycleEventsEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StopLoadingResult represents the result of calls to Page.stopLoading.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-stopLoading
+*/
+type StopLoadingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CloseResult represents the result of calls to Page.close.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-close
+*/
+type CloseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetInterceptFileChooserDialogParams represents Page.setInterceptFileChooserDialog parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setInterceptFileChooserDialog
+*/
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetInterceptFileChooserDialogResult represents the result of calls to Page.setInterceptFileChooserDialog.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setInterceptFileChooserDialog
+*/
+type SetInterceptFileChooserDialogResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAdFrameExplanation(t *testing.T) {
+	var enum AdFrameExplanationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AdFrameExplanationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AdFrameExplanation.ParentIsAd
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ParentIsAd"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ParentIsAd"`, result)
+	}
+	json.Unmarshal([]byte(`"ParentIsAd"`), &enum)
+	if AdFrameExplanation.ParentIsAd != enum {
+		t.Errorf("Expected %d, got %d", AdFrameExplanation.ParentIsAd, enum)
+	}
+
+	enum = AdFrameExplanation.CreatedByAdScript
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CreatedByAdScript"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CreatedByAdScript"`, result)
+	}
+	json.Unmarshal([]byte(`"CreatedByAdScript"`), &enum)
+	if AdFrameExplanation.CreatedByAdScript != enum {
+		t.Errorf("Expected %d, got %d", AdFrameExplanation.CreatedByAdScript, enum)
+	}
+
+	enum = AdFrameExplanation.MatchedBlockingRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MatchedBlockingRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MatchedBlockingRule"`, result)
+	}
+	json.Unmarshal([]byte(`"MatchedBlockingRule"`), &enum)
+	if AdFrameExplanation.MatchedBlockingRule != enum {
+		t.Errorf("Expected %d, got %d", AdFrameExplanation.MatchedBlockingRule, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAdFrameType(t *testing.T) {
+	var enum AdFrameTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AdFrameTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AdFrameType.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if AdFrameType.None != enum {
+		t.Errorf("Expected %d, got %d", AdFrameType.None, enum)
+	}
+
+	enum = AdFrameType.Child
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"child"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"child"`, result)
+	}
+	json.Unmarshal([]byte(`"child"`), &enum)
+	if AdFrameType.Child != enum {
+		t.Errorf("Expected %d, got %d", AdFrameType.Child, enum)
+	}
+
+	enum = AdFrameType.Root
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"root"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"root"`, result)
+	}
+	json.Unmarshal([]byte(`"root"`), &enum)
+	if AdFrameType.Root != enum {
+		t.Errorf("Expected %d, got %d", AdFrameType.Root, enum)
+	}
+}
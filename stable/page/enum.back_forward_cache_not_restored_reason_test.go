@@ -0,0 +1,1902 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBackForwardCacheNotRestoredReason(t *testing.T) {
+	var enum BackForwardCacheNotRestoredReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BackForwardCacheNotRestoredReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NotPrimaryMainFrame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotPrimaryMainFrame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotPrimaryMainFrame"`, result)
+	}
+	json.Unmarshal([]byte(`"NotPrimaryMainFrame"`), &enum)
+	if BackForwardCacheNotRestoredReason.NotPrimaryMainFrame != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NotPrimaryMainFrame, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BackForwardCacheDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheDisabled"`), &enum)
+	if BackForwardCacheNotRestoredReason.BackForwardCacheDisabled != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BackForwardCacheDisabled, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RelatedActiveContentsExist
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RelatedActiveContentsExist"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RelatedActiveContentsExist"`, result)
+	}
+	json.Unmarshal([]byte(`"RelatedActiveContentsExist"`), &enum)
+	if BackForwardCacheNotRestoredReason.RelatedActiveContentsExist != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RelatedActiveContentsExist, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.HTTPStatusNotOK
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HTTPStatusNotOK"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HTTPStatusNotOK"`, result)
+	}
+	json.Unmarshal([]byte(`"HTTPStatusNotOK"`), &enum)
+	if BackForwardCacheNotRestoredReason.HTTPStatusNotOK != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.HTTPStatusNotOK, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemeNotHTTPOrHTTPS"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemeNotHTTPOrHTTPS"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemeNotHTTPOrHTTPS"`), &enum)
+	if BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.Loading
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Loading"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Loading"`, result)
+	}
+	json.Unmarshal([]byte(`"Loading"`), &enum)
+	if BackForwardCacheNotRestoredReason.Loading != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.Loading, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WasGrantedMediaAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WasGrantedMediaAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WasGrantedMediaAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"WasGrantedMediaAccess"`), &enum)
+	if BackForwardCacheNotRestoredReason.WasGrantedMediaAccess != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WasGrantedMediaAccess, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisableForRenderFrameHostCalled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisableForRenderFrameHostCalled"`, result)
+	}
+	json.Unmarshal([]byte(`"DisableForRenderFrameHostCalled"`), &enum)
+	if BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.DomainNotAllowed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DomainNotAllowed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DomainNotAllowed"`, result)
+	}
+	json.Unmarshal([]byte(`"DomainNotAllowed"`), &enum)
+	if BackForwardCacheNotRestoredReason.DomainNotAllowed != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.DomainNotAllowed, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.HTTPMethodNotGET
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HTTPMethodNotGET"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HTTPMethodNotGET"`, result)
+	}
+	json.Unmarshal([]byte(`"HTTPMethodNotGET"`), &enum)
+	if BackForwardCacheNotRestoredReason.HTTPMethodNotGET != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.HTTPMethodNotGET, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SubframeIsNavigating
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SubframeIsNavigating"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SubframeIsNavigating"`, result)
+	}
+	json.Unmarshal([]byte(`"SubframeIsNavigating"`), &enum)
+	if BackForwardCacheNotRestoredReason.SubframeIsNavigating != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SubframeIsNavigating, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.Timeout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Timeout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Timeout"`, result)
+	}
+	json.Unmarshal([]byte(`"Timeout"`), &enum)
+	if BackForwardCacheNotRestoredReason.Timeout != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.Timeout, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheLimit"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheLimit != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheLimit, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.JavaScriptExecution
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JavaScriptExecution"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JavaScriptExecution"`, result)
+	}
+	json.Unmarshal([]byte(`"JavaScriptExecution"`), &enum)
+	if BackForwardCacheNotRestoredReason.JavaScriptExecution != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.JavaScriptExecution, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RendererProcessKilled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RendererProcessKilled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RendererProcessKilled"`, result)
+	}
+	json.Unmarshal([]byte(`"RendererProcessKilled"`), &enum)
+	if BackForwardCacheNotRestoredReason.RendererProcessKilled != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RendererProcessKilled, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RendererProcessCrashed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RendererProcessCrashed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RendererProcessCrashed"`, result)
+	}
+	json.Unmarshal([]byte(`"RendererProcessCrashed"`), &enum)
+	if BackForwardCacheNotRestoredReason.RendererProcessCrashed != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RendererProcessCrashed, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchedulerTrackedFeatureUsed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchedulerTrackedFeatureUsed"`, result)
+	}
+	json.Unmarshal([]byte(`"SchedulerTrackedFeatureUsed"`), &enum)
+	if BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConflictingBrowsingInstance"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConflictingBrowsingInstance"`, result)
+	}
+	json.Unmarshal([]byte(`"ConflictingBrowsingInstance"`), &enum)
+	if BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheFlushed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheFlushed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheFlushed"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheFlushed"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheFlushed != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheFlushed, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ServiceWorkerVersionActivation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ServiceWorkerVersionActivation"`, result)
+	}
+	json.Unmarshal([]byte(`"ServiceWorkerVersionActivation"`), &enum)
+	if BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SessionRestored
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SessionRestored"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SessionRestored"`, result)
+	}
+	json.Unmarshal([]byte(`"SessionRestored"`), &enum)
+	if BackForwardCacheNotRestoredReason.SessionRestored != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SessionRestored, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ServiceWorkerPostMessage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ServiceWorkerPostMessage"`, result)
+	}
+	json.Unmarshal([]byte(`"ServiceWorkerPostMessage"`), &enum)
+	if BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EnteredBackForwardCacheBeforeServiceWorkerHostAdded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EnteredBackForwardCacheBeforeServiceWorkerHostAdded"`, result)
+	}
+	json.Unmarshal([]byte(`"EnteredBackForwardCacheBeforeServiceWorkerHostAdded"`), &enum)
+	if BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RenderFrameHostReused_SameSite"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RenderFrameHostReused_SameSite"`, result)
+	}
+	json.Unmarshal([]byte(`"RenderFrameHostReused_SameSite"`), &enum)
+	if BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RenderFrameHostReused_CrossSite"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RenderFrameHostReused_CrossSite"`, result)
+	}
+	json.Unmarshal([]byte(`"RenderFrameHostReused_CrossSite"`), &enum)
+	if BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ServiceWorkerClaim
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ServiceWorkerClaim"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ServiceWorkerClaim"`, result)
+	}
+	json.Unmarshal([]byte(`"ServiceWorkerClaim"`), &enum)
+	if BackForwardCacheNotRestoredReason.ServiceWorkerClaim != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ServiceWorkerClaim, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.IgnoreEventAndEvict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IgnoreEventAndEvict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IgnoreEventAndEvict"`, result)
+	}
+	json.Unmarshal([]byte(`"IgnoreEventAndEvict"`), &enum)
+	if BackForwardCacheNotRestoredReason.IgnoreEventAndEvict != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.IgnoreEventAndEvict, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.HaveInnerContents
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HaveInnerContents"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HaveInnerContents"`, result)
+	}
+	json.Unmarshal([]byte(`"HaveInnerContents"`), &enum)
+	if BackForwardCacheNotRestoredReason.HaveInnerContents != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.HaveInnerContents, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.TimeoutPuttingInCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TimeoutPuttingInCache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TimeoutPuttingInCache"`, result)
+	}
+	json.Unmarshal([]byte(`"TimeoutPuttingInCache"`), &enum)
+	if BackForwardCacheNotRestoredReason.TimeoutPuttingInCache != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.TimeoutPuttingInCache, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheDisabledByLowMemory"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheDisabledByLowMemory"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheDisabledByLowMemory"`), &enum)
+	if BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheDisabledByCommandLine"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheDisabledByCommandLine"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheDisabledByCommandLine"`), &enum)
+	if BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NetworkRequestDatapipeDrainedAsBytesConsumer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NetworkRequestDatapipeDrainedAsBytesConsumer"`, result)
+	}
+	json.Unmarshal([]byte(`"NetworkRequestDatapipeDrainedAsBytesConsumer"`), &enum)
+	if BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NetworkRequestRedirected
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NetworkRequestRedirected"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NetworkRequestRedirected"`, result)
+	}
+	json.Unmarshal([]byte(`"NetworkRequestRedirected"`), &enum)
+	if BackForwardCacheNotRestoredReason.NetworkRequestRedirected != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NetworkRequestRedirected, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NetworkRequestTimeout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NetworkRequestTimeout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NetworkRequestTimeout"`, result)
+	}
+	json.Unmarshal([]byte(`"NetworkRequestTimeout"`), &enum)
+	if BackForwardCacheNotRestoredReason.NetworkRequestTimeout != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NetworkRequestTimeout, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NetworkExceedsBufferLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NetworkExceedsBufferLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"NetworkExceedsBufferLimit"`), &enum)
+	if BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigationCancelledWhileRestoring"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigationCancelledWhileRestoring"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigationCancelledWhileRestoring"`), &enum)
+	if BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotMostRecentNavigationEntry"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotMostRecentNavigationEntry"`, result)
+	}
+	json.Unmarshal([]byte(`"NotMostRecentNavigationEntry"`), &enum)
+	if BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheDisabledForPrerender"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheDisabledForPrerender"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheDisabledForPrerender"`), &enum)
+	if BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UserAgentOverrideDiffers"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UserAgentOverrideDiffers"`, result)
+	}
+	json.Unmarshal([]byte(`"UserAgentOverrideDiffers"`), &enum)
+	if BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ForegroundCacheLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ForegroundCacheLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ForegroundCacheLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"ForegroundCacheLimit"`), &enum)
+	if BackForwardCacheNotRestoredReason.ForegroundCacheLimit != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ForegroundCacheLimit, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BrowsingInstanceNotSwapped"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BrowsingInstanceNotSwapped"`, result)
+	}
+	json.Unmarshal([]byte(`"BrowsingInstanceNotSwapped"`), &enum)
+	if BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheDisabledForDelegate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheDisabledForDelegate"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheDisabledForDelegate"`), &enum)
+	if BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnloadHandlerExistsInMainFrame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnloadHandlerExistsInMainFrame"`, result)
+	}
+	json.Unmarshal([]byte(`"UnloadHandlerExistsInMainFrame"`), &enum)
+	if BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnloadHandlerExistsInSubFrame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnloadHandlerExistsInSubFrame"`, result)
+	}
+	json.Unmarshal([]byte(`"UnloadHandlerExistsInSubFrame"`), &enum)
+	if BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ServiceWorkerUnregistration"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ServiceWorkerUnregistration"`, result)
+	}
+	json.Unmarshal([]byte(`"ServiceWorkerUnregistration"`), &enum)
+	if BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheControlNoStore
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheControlNoStore"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheControlNoStore"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheControlNoStore"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheControlNoStore != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheControlNoStore, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheControlNoStoreCookieModified"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheControlNoStoreCookieModified"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheControlNoStoreCookieModified"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheControlNoStoreHTTPOnlyCookieModified"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheControlNoStoreHTTPOnlyCookieModified"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheControlNoStoreHTTPOnlyCookieModified"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.NoResponseHead
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoResponseHead"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoResponseHead"`, result)
+	}
+	json.Unmarshal([]byte(`"NoResponseHead"`), &enum)
+	if BackForwardCacheNotRestoredReason.NoResponseHead != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.NoResponseHead, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.Unknown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Unknown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Unknown"`, result)
+	}
+	json.Unmarshal([]byte(`"Unknown"`), &enum)
+	if BackForwardCacheNotRestoredReason.Unknown != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.Unknown, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivationNavigationsDisallowedForBug1234857"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivationNavigationsDisallowedForBug1234857"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivationNavigationsDisallowedForBug1234857"`), &enum)
+	if BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857 != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ErrorDocument
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ErrorDocument"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ErrorDocument"`, result)
+	}
+	json.Unmarshal([]byte(`"ErrorDocument"`), &enum)
+	if BackForwardCacheNotRestoredReason.ErrorDocument != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ErrorDocument, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.FencedFramesEmbedder
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FencedFramesEmbedder"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FencedFramesEmbedder"`, result)
+	}
+	json.Unmarshal([]byte(`"FencedFramesEmbedder"`), &enum)
+	if BackForwardCacheNotRestoredReason.FencedFramesEmbedder != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.FencedFramesEmbedder, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CookieDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CookieDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CookieDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"CookieDisabled"`), &enum)
+	if BackForwardCacheNotRestoredReason.CookieDisabled != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CookieDisabled, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.HTTPAuthRequired
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HTTPAuthRequired"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HTTPAuthRequired"`, result)
+	}
+	json.Unmarshal([]byte(`"HTTPAuthRequired"`), &enum)
+	if BackForwardCacheNotRestoredReason.HTTPAuthRequired != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.HTTPAuthRequired, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CookieFlushed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CookieFlushed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CookieFlushed"`, result)
+	}
+	json.Unmarshal([]byte(`"CookieFlushed"`), &enum)
+	if BackForwardCacheNotRestoredReason.CookieFlushed != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CookieFlushed, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BroadcastChannelOnMessage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BroadcastChannelOnMessage"`, result)
+	}
+	json.Unmarshal([]byte(`"BroadcastChannelOnMessage"`), &enum)
+	if BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebViewSettingsChanged
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebViewSettingsChanged"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebViewSettingsChanged"`, result)
+	}
+	json.Unmarshal([]byte(`"WebViewSettingsChanged"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebViewSettingsChanged != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebViewSettingsChanged, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebViewJavaScriptObjectChanged"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebViewJavaScriptObjectChanged"`, result)
+	}
+	json.Unmarshal([]byte(`"WebViewJavaScriptObjectChanged"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebViewMessageListenerInjected"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebViewMessageListenerInjected"`, result)
+	}
+	json.Unmarshal([]byte(`"WebViewMessageListenerInjected"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebViewSafeBrowsingAllowlistChanged"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebViewSafeBrowsingAllowlistChanged"`, result)
+	}
+	json.Unmarshal([]byte(`"WebViewSafeBrowsingAllowlistChanged"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebViewDocumentStartJavascriptChanged"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebViewDocumentStartJavascriptChanged"`, result)
+	}
+	json.Unmarshal([]byte(`"WebViewDocumentStartJavascriptChanged"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebSocket
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebSocket"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebSocket"`, result)
+	}
+	json.Unmarshal([]byte(`"WebSocket"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebSocket != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebSocket, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebTransport
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebTransport"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebTransport"`, result)
+	}
+	json.Unmarshal([]byte(`"WebTransport"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebTransport != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebTransport, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebRTC
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebRTC"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebRTC"`, result)
+	}
+	json.Unmarshal([]byte(`"WebRTC"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebRTC != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebRTC, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MainResourceHasCacheControlNoStore"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MainResourceHasCacheControlNoStore"`, result)
+	}
+	json.Unmarshal([]byte(`"MainResourceHasCacheControlNoStore"`), &enum)
+	if BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MainResourceHasCacheControlNoCache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MainResourceHasCacheControlNoCache"`, result)
+	}
+	json.Unmarshal([]byte(`"MainResourceHasCacheControlNoCache"`), &enum)
+	if BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SubresourceHasCacheControlNoStore"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SubresourceHasCacheControlNoStore"`, result)
+	}
+	json.Unmarshal([]byte(`"SubresourceHasCacheControlNoStore"`), &enum)
+	if BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SubresourceHasCacheControlNoCache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SubresourceHasCacheControlNoCache"`, result)
+	}
+	json.Unmarshal([]byte(`"SubresourceHasCacheControlNoCache"`), &enum)
+	if BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContainsPlugins
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContainsPlugins"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContainsPlugins"`, result)
+	}
+	json.Unmarshal([]byte(`"ContainsPlugins"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContainsPlugins != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContainsPlugins, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.DocumentLoaded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DocumentLoaded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DocumentLoaded"`, result)
+	}
+	json.Unmarshal([]byte(`"DocumentLoaded"`), &enum)
+	if BackForwardCacheNotRestoredReason.DocumentLoaded != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.DocumentLoaded, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OutstandingNetworkRequestOthers"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OutstandingNetworkRequestOthers"`, result)
+	}
+	json.Unmarshal([]byte(`"OutstandingNetworkRequestOthers"`), &enum)
+	if BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedMIDIPermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedMIDIPermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedMIDIPermission"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedMIDIPermission"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedMIDIPermission != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedMIDIPermission, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedAudioCapturePermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedAudioCapturePermission"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedAudioCapturePermission"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedVideoCapturePermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedVideoCapturePermission"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedVideoCapturePermission"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedBackForwardCacheBlockedSensors"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedBackForwardCacheBlockedSensors"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedBackForwardCacheBlockedSensors"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedBackgroundWorkPermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedBackgroundWorkPermission"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedBackgroundWorkPermission"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.BroadcastChannel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BroadcastChannel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BroadcastChannel"`, result)
+	}
+	json.Unmarshal([]byte(`"BroadcastChannel"`), &enum)
+	if BackForwardCacheNotRestoredReason.BroadcastChannel != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.BroadcastChannel, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebXR
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebXR"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebXR"`, result)
+	}
+	json.Unmarshal([]byte(`"WebXR"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebXR != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebXR, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SharedWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SharedWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SharedWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"SharedWorker"`), &enum)
+	if BackForwardCacheNotRestoredReason.SharedWorker != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SharedWorker, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SharedWorkerMessage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SharedWorkerMessage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SharedWorkerMessage"`, result)
+	}
+	json.Unmarshal([]byte(`"SharedWorkerMessage"`), &enum)
+	if BackForwardCacheNotRestoredReason.SharedWorkerMessage != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SharedWorkerMessage, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebLocks
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebLocks"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebLocks"`, result)
+	}
+	json.Unmarshal([]byte(`"WebLocks"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebLocks != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebLocks, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebHID
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebHID"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebHID"`, result)
+	}
+	json.Unmarshal([]byte(`"WebHID"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebHID != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebHID, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebShare
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebShare"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebShare"`, result)
+	}
+	json.Unmarshal([]byte(`"WebShare"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebShare != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebShare, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedStorageAccessGrant"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedStorageAccessGrant"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedStorageAccessGrant"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebNfc
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebNfc"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebNfc"`, result)
+	}
+	json.Unmarshal([]byte(`"WebNfc"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebNfc != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebNfc, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OutstandingNetworkRequestFetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OutstandingNetworkRequestFetch"`, result)
+	}
+	json.Unmarshal([]byte(`"OutstandingNetworkRequestFetch"`), &enum)
+	if BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OutstandingNetworkRequestXHR"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OutstandingNetworkRequestXHR"`, result)
+	}
+	json.Unmarshal([]byte(`"OutstandingNetworkRequestXHR"`), &enum)
+	if BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.AppBanner
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AppBanner"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AppBanner"`, result)
+	}
+	json.Unmarshal([]byte(`"AppBanner"`), &enum)
+	if BackForwardCacheNotRestoredReason.AppBanner != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.AppBanner, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.Printing
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Printing"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Printing"`, result)
+	}
+	json.Unmarshal([]byte(`"Printing"`), &enum)
+	if BackForwardCacheNotRestoredReason.Printing != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.Printing, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebDatabase
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebDatabase"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebDatabase"`, result)
+	}
+	json.Unmarshal([]byte(`"WebDatabase"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebDatabase != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebDatabase, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.PictureInPicture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PictureInPicture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PictureInPicture"`, result)
+	}
+	json.Unmarshal([]byte(`"PictureInPicture"`), &enum)
+	if BackForwardCacheNotRestoredReason.PictureInPicture != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.PictureInPicture, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SpeechRecognizer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SpeechRecognizer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SpeechRecognizer"`, result)
+	}
+	json.Unmarshal([]byte(`"SpeechRecognizer"`), &enum)
+	if BackForwardCacheNotRestoredReason.SpeechRecognizer != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SpeechRecognizer, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.IdleManager
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdleManager"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdleManager"`, result)
+	}
+	json.Unmarshal([]byte(`"IdleManager"`), &enum)
+	if BackForwardCacheNotRestoredReason.IdleManager != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.IdleManager, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.PaymentManager
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PaymentManager"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PaymentManager"`, result)
+	}
+	json.Unmarshal([]byte(`"PaymentManager"`), &enum)
+	if BackForwardCacheNotRestoredReason.PaymentManager != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.PaymentManager, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SpeechSynthesis
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SpeechSynthesis"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SpeechSynthesis"`, result)
+	}
+	json.Unmarshal([]byte(`"SpeechSynthesis"`), &enum)
+	if BackForwardCacheNotRestoredReason.SpeechSynthesis != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SpeechSynthesis, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.KeyboardLock
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"KeyboardLock"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"KeyboardLock"`, result)
+	}
+	json.Unmarshal([]byte(`"KeyboardLock"`), &enum)
+	if BackForwardCacheNotRestoredReason.KeyboardLock != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.KeyboardLock, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebOTPService
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebOTPService"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebOTPService"`, result)
+	}
+	json.Unmarshal([]byte(`"WebOTPService"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebOTPService != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebOTPService, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OutstandingNetworkRequestDirectSocket"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OutstandingNetworkRequestDirectSocket"`, result)
+	}
+	json.Unmarshal([]byte(`"OutstandingNetworkRequestDirectSocket"`), &enum)
+	if BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.InjectedJavascript
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InjectedJavascript"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InjectedJavascript"`, result)
+	}
+	json.Unmarshal([]byte(`"InjectedJavascript"`), &enum)
+	if BackForwardCacheNotRestoredReason.InjectedJavascript != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.InjectedJavascript, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.InjectedStyleSheet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InjectedStyleSheet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InjectedStyleSheet"`, result)
+	}
+	json.Unmarshal([]byte(`"InjectedStyleSheet"`), &enum)
+	if BackForwardCacheNotRestoredReason.InjectedStyleSheet != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.InjectedStyleSheet, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.KeepaliveRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"KeepaliveRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"KeepaliveRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"KeepaliveRequest"`), &enum)
+	if BackForwardCacheNotRestoredReason.KeepaliveRequest != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.KeepaliveRequest, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.IndexedDBEvent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IndexedDBEvent"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IndexedDBEvent"`, result)
+	}
+	json.Unmarshal([]byte(`"IndexedDBEvent"`), &enum)
+	if BackForwardCacheNotRestoredReason.IndexedDBEvent != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.IndexedDBEvent, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.Dummy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Dummy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Dummy"`, result)
+	}
+	json.Unmarshal([]byte(`"Dummy"`), &enum)
+	if BackForwardCacheNotRestoredReason.Dummy != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.Dummy, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JsNetworkRequestReceivedCacheControlNoStoreResource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JsNetworkRequestReceivedCacheControlNoStoreResource"`, result)
+	}
+	json.Unmarshal([]byte(`"JsNetworkRequestReceivedCacheControlNoStoreResource"`), &enum)
+	if BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebRTCSticky
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebRTCSticky"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebRTCSticky"`, result)
+	}
+	json.Unmarshal([]byte(`"WebRTCSticky"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebRTCSticky != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebRTCSticky, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebTransportSticky
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebTransportSticky"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebTransportSticky"`, result)
+	}
+	json.Unmarshal([]byte(`"WebTransportSticky"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebTransportSticky != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebTransportSticky, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.WebSocketSticky
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebSocketSticky"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebSocketSticky"`, result)
+	}
+	json.Unmarshal([]byte(`"WebSocketSticky"`), &enum)
+	if BackForwardCacheNotRestoredReason.WebSocketSticky != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.WebSocketSticky, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.SmartCard
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SmartCard"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SmartCard"`, result)
+	}
+	json.Unmarshal([]byte(`"SmartCard"`), &enum)
+	if BackForwardCacheNotRestoredReason.SmartCard != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.SmartCard, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.LiveMediaStreamTrack
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LiveMediaStreamTrack"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LiveMediaStreamTrack"`, result)
+	}
+	json.Unmarshal([]byte(`"LiveMediaStreamTrack"`), &enum)
+	if BackForwardCacheNotRestoredReason.LiveMediaStreamTrack != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.LiveMediaStreamTrack, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.UnloadHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnloadHandler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnloadHandler"`, result)
+	}
+	json.Unmarshal([]byte(`"UnloadHandler"`), &enum)
+	if BackForwardCacheNotRestoredReason.UnloadHandler != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.UnloadHandler, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ParserAborted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ParserAborted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ParserAborted"`, result)
+	}
+	json.Unmarshal([]byte(`"ParserAborted"`), &enum)
+	if BackForwardCacheNotRestoredReason.ParserAborted != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ParserAborted, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentSecurityHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentSecurityHandler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentSecurityHandler"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentSecurityHandler"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentSecurityHandler != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentSecurityHandler, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentWebAuthenticationAPI"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentWebAuthenticationAPI"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentWebAuthenticationAPI"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentFileChooser
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentFileChooser"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentFileChooser"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentFileChooser"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentFileChooser != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentFileChooser, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentSerial
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentSerial"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentSerial"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentSerial"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentSerial != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentSerial, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentFileSystemAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentFileSystemAccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentFileSystemAccess"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentFileSystemAccess"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentFileSystemAccess != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentFileSystemAccess, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentMediaDevicesDispatcherHost"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentMediaDevicesDispatcherHost"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentMediaDevicesDispatcherHost"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentWebBluetooth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentWebBluetooth"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentWebBluetooth"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentWebBluetooth"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentWebBluetooth != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentWebBluetooth, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentWebUSB
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentWebUSB"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentWebUSB"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentWebUSB"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentWebUSB != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentWebUSB, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentMediaSessionService
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentMediaSessionService"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentMediaSessionService"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentMediaSessionService"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentMediaSessionService != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentMediaSessionService, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentScreenReader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentScreenReader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentScreenReader"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentScreenReader"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentScreenReader != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentScreenReader, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.ContentDiscarded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentDiscarded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentDiscarded"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentDiscarded"`), &enum)
+	if BackForwardCacheNotRestoredReason.ContentDiscarded != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.ContentDiscarded, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderPopupBlockerTabHelper"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderPopupBlockerTabHelper"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderPopupBlockerTabHelper"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderSafeBrowsingTriggeredPopupBlocker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderSafeBrowsingTriggeredPopupBlocker"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderSafeBrowsingTriggeredPopupBlocker"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderSafeBrowsingThreatDetails"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderSafeBrowsingThreatDetails"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderSafeBrowsingThreatDetails"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderAppBannerManager
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderAppBannerManager"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderAppBannerManager"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderAppBannerManager"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderAppBannerManager != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderAppBannerManager, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderDomDistillerViewerSource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderDomDistillerViewerSource"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderDomDistillerViewerSource"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderDomDistillerSelfDeletingRequestDelegate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderDomDistillerSelfDeletingRequestDelegate"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderDomDistillerSelfDeletingRequestDelegate"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderOomInterventionTabHelper"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderOomInterventionTabHelper"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderOomInterventionTabHelper"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderOfflinePage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderOfflinePage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderOfflinePage"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderOfflinePage"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderOfflinePage != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderOfflinePage, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderChromePasswordManagerClientBindCredentialManager"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderChromePasswordManagerClientBindCredentialManager"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderChromePasswordManagerClientBindCredentialManager"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderPermissionRequestManager"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderPermissionRequestManager"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderPermissionRequestManager"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderModalDialog
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderModalDialog"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderModalDialog"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderModalDialog"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderModalDialog != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderModalDialog, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderExtensions
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderExtensions"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderExtensions"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderExtensions"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderExtensions != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderExtensions, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderExtensionMessaging"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderExtensionMessaging"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderExtensionMessaging"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderExtensionMessagingForOpenPort"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderExtensionMessagingForOpenPort"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderExtensionMessagingForOpenPort"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderExtensionSentMessageToCachedFrame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderExtensionSentMessageToCachedFrame"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderExtensionSentMessageToCachedFrame"`), &enum)
+	if BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.RequestedByWebViewClient
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestedByWebViewClient"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestedByWebViewClient"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestedByWebViewClient"`), &enum)
+	if BackForwardCacheNotRestoredReason.RequestedByWebViewClient != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.RequestedByWebViewClient, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.PostMessageByWebViewClient
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PostMessageByWebViewClient"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PostMessageByWebViewClient"`, result)
+	}
+	json.Unmarshal([]byte(`"PostMessageByWebViewClient"`), &enum)
+	if BackForwardCacheNotRestoredReason.PostMessageByWebViewClient != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.PostMessageByWebViewClient, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheControlNoStoreDeviceBoundSessionTerminated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheControlNoStoreDeviceBoundSessionTerminated"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheControlNoStoreDeviceBoundSessionTerminated"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheLimitPrunedOnModerateMemoryPressure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheLimitPrunedOnModerateMemoryPressure"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheLimitPrunedOnModerateMemoryPressure"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CacheLimitPrunedOnCriticalMemoryPressure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CacheLimitPrunedOnCriticalMemoryPressure"`, result)
+	}
+	json.Unmarshal([]byte(`"CacheLimitPrunedOnCriticalMemoryPressure"`), &enum)
+	if BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure, enum)
+	}
+}
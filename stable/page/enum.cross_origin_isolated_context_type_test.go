@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCrossOriginIsolatedContextType(t *testing.T) {
+	var enum CrossOriginIsolatedContextTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CrossOriginIsolatedContextTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CrossOriginIsolatedContextType.Isolated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Isolated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Isolated"`, result)
+	}
+	json.Unmarshal([]byte(`"Isolated"`), &enum)
+	if CrossOriginIsolatedContextType.Isolated != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginIsolatedContextType.Isolated, enum)
+	}
+
+	enum = CrossOriginIsolatedContextType.NotIsolated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotIsolated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotIsolated"`, result)
+	}
+	json.Unmarshal([]byte(`"NotIsolated"`), &enum)
+	if CrossOriginIsolatedContextType.NotIsolated != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginIsolatedContextType.NotIsolated, enum)
+	}
+
+	enum = CrossOriginIsolatedContextType.NotIsolatedFeatureDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotIsolatedFeatureDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotIsolatedFeatureDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"NotIsolatedFeatureDisabled"`), &enum)
+	if CrossOriginIsolatedContextType.NotIsolatedFeatureDisabled != enum {
+		t.Errorf("Expected %d, got %d", CrossOriginIsolatedContextType.NotIsolatedFeatureDisabled, enum)
+	}
+}
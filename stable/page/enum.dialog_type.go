@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type dialogTypeEnum struct {
+	Alert        DialogTypeEnum
+	Confirm      DialogTypeEnum
+	Prompt       DialogTypeEnum
+	Beforeunload DialogTypeEnum
+}
+
+/*
+DialogType provides named access to the DialogTypeEnum values.
+*/
+var DialogType = dialogTypeEnum{
+	Alert:        dialogTypeAlert,
+	Confirm:      dialogTypeConfirm,
+	Prompt:       dialogTypePrompt,
+	Beforeunload: dialogTypeBeforeunload,
+}
+
+/*
+DialogTypeEnum represents the Page.DialogType values.
+
+Javascript dialog type.
+
+Allowed values:
+  - DialogType.Alert        "alert"
+  - DialogType.Confirm      "confirm"
+  - DialogType.Prompt       "prompt"
+  - DialogType.Beforeunload "beforeunload"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-DialogType
+*/
+type DialogTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DialogTypeEnum) String() string {
+	return _dialogTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DialogTypeEnum) Unknown() bool {
+	return !_dialogTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DialogTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DialogTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _dialogTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// dialogTypeAlert represents the "alert" value.
+	dialogTypeAlert DialogTypeEnum = iota + 1
+	// dialogTypeConfirm represents the "confirm" value.
+	dialogTypeConfirm
+	// dialogTypePrompt represents the "prompt" value.
+	dialogTypePrompt
+	// dialogTypeBeforeunload represents the "beforeunload" value.
+	dialogTypeBeforeunload
+)
+
+var _dialogTypeEnums = enums.New(map[DialogTypeEnum]string{
+	DialogTypeEnum(0):      "",
+	dialogTypeAlert:        "alert",
+	dialogTypeConfirm:      "confirm",
+	dialogTypePrompt:       "prompt",
+	dialogTypeBeforeunload: "beforeunload",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDialogType(t *testing.T) {
+	var enum DialogTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DialogTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DialogType.Alert
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"alert"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"alert"`, result)
+	}
+	json.Unmarshal([]byte(`"alert"`), &enum)
+	if DialogType.Alert != enum {
+		t.Errorf("Expected %d, got %d", DialogType.Alert, enum)
+	}
+
+	enum = DialogType.Confirm
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"confirm"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"confirm"`, result)
+	}
+	json.Unmarshal([]byte(`"confirm"`), &enum)
+	if DialogType.Confirm != enum {
+		t.Errorf("Expected %d, got %d", DialogType.Confirm, enum)
+	}
+
+	enum = DialogType.Prompt
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prompt"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prompt"`, result)
+	}
+	json.Unmarshal([]byte(`"prompt"`), &enum)
+	if DialogType.Prompt != enum {
+		t.Errorf("Expected %d, got %d", DialogType.Prompt, enum)
+	}
+
+	enum = DialogType.Beforeunload
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"beforeunload"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"beforeunload"`, result)
+	}
+	json.Unmarshal([]byte(`"beforeunload"`), &enum)
+	if DialogType.Beforeunload != enum {
+		t.Errorf("Expected %d, got %d", DialogType.Beforeunload, enum)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type formatEnum struct {
+	Jpeg FormatEnum
+	Png  FormatEnum
+	Webp FormatEnum
+}
+
+/*
+Format provides named access to the FormatEnum values.
+*/
+var Format = formatEnum{
+	Jpeg: formatJpeg,
+	Png:  formatPng,
+	Webp: formatWebp,
+}
+
+/*
+FormatEnum represents the Page.captureScreenshot.format values.
+
+Image compression format (defaults to png).
+
+Allowed values:
+  - Format.Jpeg "jpeg"
+  - Format.Png  "png"
+  - Format.Webp "webp"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+type FormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FormatEnum) String() string {
+	return _formatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FormatEnum) Unknown() bool {
+	return !_formatEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FormatEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _formatEnums.Parse(val)
+	return nil
+}
+
+const (
+	// formatJpeg represents the "jpeg" value.
+	formatJpeg FormatEnum = iota + 1
+	// formatPng represents the "png" value.
+	formatPng
+	// formatWebp represents the "webp" value.
+	formatWebp
+)
+
+var _formatEnums = enums.New(map[FormatEnum]string{
+	FormatEnum(0): "",
+	formatJpeg:    "jpeg",
+	formatPng:     "png",
+	formatWebp:    "webp",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFormat(t *testing.T) {
+	var enum FormatEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Format.Jpeg
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"jpeg"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"jpeg"`, result)
+	}
+	json.Unmarshal([]byte(`"jpeg"`), &enum)
+	if Format.Jpeg != enum {
+		t.Errorf("Expected %d, got %d", Format.Jpeg, enum)
+	}
+
+	enum = Format.Png
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"png"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"png"`, result)
+	}
+	json.Unmarshal([]byte(`"png"`), &enum)
+	if Format.Png != enum {
+		t.Errorf("Expected %d, got %d", Format.Png, enum)
+	}
+
+	enum = Format.Webp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"webp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webp"`, result)
+	}
+	json.Unmarshal([]byte(`"webp"`), &enum)
+	if Format.Webp != enum {
+		t.Errorf("Expected %d, got %d", Format.Webp, enum)
+	}
+}
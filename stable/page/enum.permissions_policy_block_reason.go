@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type permissionsPolicyBlockReasonEnum struct {
+	Header            PermissionsPolicyBlockReasonEnum
+	IframeAttribute   PermissionsPolicyBlockReasonEnum
+	InFencedFrameTree PermissionsPolicyBlockReasonEnum
+	InIsolatedApp     PermissionsPolicyBlockReasonEnum
+}
+
+/*
+PermissionsPolicyBlockReason provides named access to the PermissionsPolicyBlockReasonEnum values.
+*/
+var PermissionsPolicyBlockReason = permissionsPolicyBlockReasonEnum{
+	Header:            permissionsPolicyBlockReasonHeader,
+	IframeAttribute:   permissionsPolicyBlockReasonIframeAttribute,
+	InFencedFrameTree: permissionsPolicyBlockReasonInFencedFrameTree,
+	InIsolatedApp:     permissionsPolicyBlockReasonInIsolatedApp,
+}
+
+/*
+PermissionsPolicyBlockReasonEnum represents the
+Page.PermissionsPolicyBlockReason values.
+
+Reason for a permissions policy feature to be disabled.
+
+Allowed values:
+  - PermissionsPolicyBlockReason.Header            "Header"
+  - PermissionsPolicyBlockReason.IframeAttribute   "IframeAttribute"
+  - PermissionsPolicyBlockReason.InFencedFrameTree "InFencedFrameTree"
+  - PermissionsPolicyBlockReason.InIsolatedApp     "InIsolatedApp"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-PermissionsPolicyBlockReason
+*/
+type PermissionsPolicyBlockReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PermissionsPolicyBlockReasonEnum) String() string {
+	return _permissionsPolicyBlockReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PermissionsPolicyBlockReasonEnum) Unknown() bool {
+	return !_permissionsPolicyBlockReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PermissionsPolicyBlockReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PermissionsPolicyBlockReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _permissionsPolicyBlockReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// permissionsPolicyBlockReasonHeader represents the "Header" value.
+	permissionsPolicyBlockReasonHeader PermissionsPolicyBlockReasonEnum = iota + 1
+	// permissionsPolicyBlockReasonIframeAttribute represents the "IframeAttribute" value.
+	permissionsPolicyBlockReasonIframeAttribute
+	// permissionsPolicyBlockReasonInFencedFrameTree represents the "InFencedFrameTree" value.
+	permissionsPolicyBlockReasonInFencedFrameTree
+	// permissionsPolicyBlockReasonInIsolatedApp represents the "InIsolatedApp" value.
+	permissionsPolicyBlockReasonInIsolatedApp
+)
+
+var _permissionsPolicyBlockReasonEnums = enums.New(map[PermissionsPolicyBlockReasonEnum]string{
+	PermissionsPolicyBlockReasonEnum(0):           "",
+	permissionsPolicyBlockReasonHeader:            "Header",
+	permissionsPolicyBlockReasonIframeAttribute:   "IframeAttribute",
+	permissionsPolicyBlockReasonInFencedFrameTree: "InFencedFrameTree",
+	permissionsPolicyBlockReasonInIsolatedApp:     "InIsolatedApp",
+})
@@ -0,0 +1,711 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type permissionsPolicyFeatureEnum struct {
+	Accelerometer                  PermissionsPolicyFeatureEnum
+	AllScreensCapture              PermissionsPolicyFeatureEnum
+	AmbientLightSensor             PermissionsPolicyFeatureEnum
+	AriaNotify                     PermissionsPolicyFeatureEnum
+	AttributionReporting           PermissionsPolicyFeatureEnum
+	Autoplay                       PermissionsPolicyFeatureEnum
+	Bluetooth                      PermissionsPolicyFeatureEnum
+	BrowsingTopics                 PermissionsPolicyFeatureEnum
+	Camera                         PermissionsPolicyFeatureEnum
+	CapturedSurfaceControl         PermissionsPolicyFeatureEnum
+	ChDpr                          PermissionsPolicyFeatureEnum
+	ChDeviceMemory                 PermissionsPolicyFeatureEnum
+	ChDownlink                     PermissionsPolicyFeatureEnum
+	ChEct                          PermissionsPolicyFeatureEnum
+	ChPrefersColorScheme           PermissionsPolicyFeatureEnum
+	ChPrefersReducedMotion         PermissionsPolicyFeatureEnum
+	ChPrefersReducedTransparency   PermissionsPolicyFeatureEnum
+	ChRtt                          PermissionsPolicyFeatureEnum
+	ChSaveData                     PermissionsPolicyFeatureEnum
+	ChUa                           PermissionsPolicyFeatureEnum
+	ChUaArch                       PermissionsPolicyFeatureEnum
+	ChUaBitness                    PermissionsPolicyFeatureEnum
+	ChUaHighEntropyValues          PermissionsPolicyFeatureEnum
+	ChUaPlatform                   PermissionsPolicyFeatureEnum
+	ChUaModel                      PermissionsPolicyFeatureEnum
+	ChUaMobile                     PermissionsPolicyFeatureEnum
+	ChUaFormFactors                PermissionsPolicyFeatureEnum
+	ChUaFullVersion                PermissionsPolicyFeatureEnum
+	ChUaFullVersionList            PermissionsPolicyFeatureEnum
+	ChUaPlatformVersion            PermissionsPolicyFeatureEnum
+	ChUaWow64                      PermissionsPolicyFeatureEnum
+	ChViewportHeight               PermissionsPolicyFeatureEnum
+	ChViewportWidth                PermissionsPolicyFeatureEnum
+	ChWidth                        PermissionsPolicyFeatureEnum
+	ClipboardRead                  PermissionsPolicyFeatureEnum
+	ClipboardWrite                 PermissionsPolicyFeatureEnum
+	ComputePressure                PermissionsPolicyFeatureEnum
+	ControlledFrame                PermissionsPolicyFeatureEnum
+	CrossOriginIsolated            PermissionsPolicyFeatureEnum
+	DeferredFetch                  PermissionsPolicyFeatureEnum
+	DeferredFetchMinimal           PermissionsPolicyFeatureEnum
+	DeviceAttributes               PermissionsPolicyFeatureEnum
+	DigitalCredentialsGet          PermissionsPolicyFeatureEnum
+	DirectSockets                  PermissionsPolicyFeatureEnum
+	DirectSocketsPrivate           PermissionsPolicyFeatureEnum
+	DisplayCapture                 PermissionsPolicyFeatureEnum
+	DocumentDomain                 PermissionsPolicyFeatureEnum
+	EncryptedMedia                 PermissionsPolicyFeatureEnum
+	ExecutionWhileOutOfViewport    PermissionsPolicyFeatureEnum
+	ExecutionWhileNotRendered      PermissionsPolicyFeatureEnum
+	FencedUnpartitionedStorageRead PermissionsPolicyFeatureEnum
+	FocusWithoutUserActivation     PermissionsPolicyFeatureEnum
+	Fullscreen                     PermissionsPolicyFeatureEnum
+	Frobulate                      PermissionsPolicyFeatureEnum
+	Gamepad                        PermissionsPolicyFeatureEnum
+	Geolocation                    PermissionsPolicyFeatureEnum
+	Gyroscope                      PermissionsPolicyFeatureEnum
+	Hid                            PermissionsPolicyFeatureEnum
+	IdentityCredentialsGet         PermissionsPolicyFeatureEnum
+	IdleDetection                  PermissionsPolicyFeatureEnum
+	InterestCohort                 PermissionsPolicyFeatureEnum
+	JoinAdInterestGroup            PermissionsPolicyFeatureEnum
+	KeyboardMap                    PermissionsPolicyFeatureEnum
+	LanguageDetector               PermissionsPolicyFeatureEnum
+	LanguageModel                  PermissionsPolicyFeatureEnum
+	LocalFonts                     PermissionsPolicyFeatureEnum
+	LocalNetworkAccess             PermissionsPolicyFeatureEnum
+	Magnetometer                   PermissionsPolicyFeatureEnum
+	MediaPlaybackWhileNotVisible   PermissionsPolicyFeatureEnum
+	Microphone                     PermissionsPolicyFeatureEnum
+	Midi                           PermissionsPolicyFeatureEnum
+	OnDeviceSpeechRecognition      PermissionsPolicyFeatureEnum
+	OtpCredentials                 PermissionsPolicyFeatureEnum
+	Payment                        PermissionsPolicyFeatureEnum
+	PictureInPicture               PermissionsPolicyFeatureEnum
+	Popins                         PermissionsPolicyFeatureEnum
+	PrivateAggregation             PermissionsPolicyFeatureEnum
+	PrivateStateTokenIssuance      PermissionsPolicyFeatureEnum
+	PrivateStateTokenRedemption    PermissionsPolicyFeatureEnum
+	PublickeyCredentialsCreate     PermissionsPolicyFeatureEnum
+	PublickeyCredentialsGet        PermissionsPolicyFeatureEnum
+	RecordAdAuctionEvents          PermissionsPolicyFeatureEnum
+	Rewriter                       PermissionsPolicyFeatureEnum
+	RunAdAuction                   PermissionsPolicyFeatureEnum
+	ScreenWakeLock                 PermissionsPolicyFeatureEnum
+	Serial                         PermissionsPolicyFeatureEnum
+	SharedAutofill                 PermissionsPolicyFeatureEnum
+	SharedStorage                  PermissionsPolicyFeatureEnum
+	SharedStorageSelectURL         PermissionsPolicyFeatureEnum
+	SmartCard                      PermissionsPolicyFeatureEnum
+	SpeakerSelection               PermissionsPolicyFeatureEnum
+	StorageAccess                  PermissionsPolicyFeatureEnum
+	SubApps                        PermissionsPolicyFeatureEnum
+	Summarizer                     PermissionsPolicyFeatureEnum
+	SyncXHR                        PermissionsPolicyFeatureEnum
+	Translator                     PermissionsPolicyFeatureEnum
+	Unload                         PermissionsPolicyFeatureEnum
+	Usb                            PermissionsPolicyFeatureEnum
+	UsbUnrestricted                PermissionsPolicyFeatureEnum
+	VerticalScroll                 PermissionsPolicyFeatureEnum
+	WebAppInstallation             PermissionsPolicyFeatureEnum
+	WebPrinting                    PermissionsPolicyFeatureEnum
+	WebShare                       PermissionsPolicyFeatureEnum
+	WindowManagement               PermissionsPolicyFeatureEnum
+	Writer                         PermissionsPolicyFeatureEnum
+	XrSpatialTracking              PermissionsPolicyFeatureEnum
+}
+
+/*
+PermissionsPolicyFeature provides named access to the PermissionsPolicyFeatureEnum values.
+*/
+var PermissionsPolicyFeature = permissionsPolicyFeatureEnum{
+	Accelerometer:                  permissionsPolicyFeatureAccelerometer,
+	AllScreensCapture:              permissionsPolicyFeatureAllScreensCapture,
+	AmbientLightSensor:             permissionsPolicyFeatureAmbientLightSensor,
+	AriaNotify:                     permissionsPolicyFeatureAriaNotify,
+	AttributionReporting:           permissionsPolicyFeatureAttributionReporting,
+	Autoplay:                       permissionsPolicyFeatureAutoplay,
+	Bluetooth:                      permissionsPolicyFeatureBluetooth,
+	BrowsingTopics:                 permissionsPolicyFeatureBrowsingTopics,
+	Camera:                         permissionsPolicyFeatureCamera,
+	CapturedSurfaceControl:         permissionsPolicyFeatureCapturedSurfaceControl,
+	ChDpr:                          permissionsPolicyFeatureChDpr,
+	ChDeviceMemory:                 permissionsPolicyFeatureChDeviceMemory,
+	ChDownlink:                     permissionsPolicyFeatureChDownlink,
+	ChEct:                          permissionsPolicyFeatureChEct,
+	ChPrefersColorScheme:           permissionsPolicyFeatureChPrefersColorScheme,
+	ChPrefersReducedMotion:         permissionsPolicyFeatureChPrefersReducedMotion,
+	ChPrefersReducedTransparency:   permissionsPolicyFeatureChPrefersReducedTransparency,
+	ChRtt:                          permissionsPolicyFeatureChRtt,
+	ChSaveData:                     permissionsPolicyFeatureChSaveData,
+	ChUa:                           permissionsPolicyFeatureChUa,
+	ChUaArch:                       permissionsPolicyFeatureChUaArch,
+	ChUaBitness:                    permissionsPolicyFeatureChUaBitness,
+	ChUaHighEntropyValues:          permissionsPolicyFeatureChUaHighEntropyValues,
+	ChUaPlatform:                   permissionsPolicyFeatureChUaPlatform,
+	ChUaModel:                      permissionsPolicyFeatureChUaModel,
+	ChUaMobile:                     permissionsPolicyFeatureChUaMobile,
+	ChUaFormFactors:                permissionsPolicyFeatureChUaFormFactors,
+	ChUaFullVersion:                permissionsPolicyFeatureChUaFullVersion,
+	ChUaFullVersionList:            permissionsPolicyFeatureChUaFullVersionList,
+	ChUaPlatformVersion:            permissionsPolicyFeatureChUaPlatformVersion,
+	ChUaWow64:                      permissionsPolicyFeatureChUaWow64,
+	ChViewportHeight:               permissionsPolicyFeatureChViewportHeight,
+	ChViewportWidth:                permissionsPolicyFeatureChViewportWidth,
+	ChWidth:                        permissionsPolicyFeatureChWidth,
+	ClipboardRead:                  permissionsPolicyFeatureClipboardRead,
+	ClipboardWrite:                 permissionsPolicyFeatureClipboardWrite,
+	ComputePressure:                permissionsPolicyFeatureComputePressure,
+	ControlledFrame:                permissionsPolicyFeatureControlledFrame,
+	CrossOriginIsolated:            permissionsPolicyFeatureCrossOriginIsolated,
+	DeferredFetch:                  permissionsPolicyFeatureDeferredFetch,
+	DeferredFetchMinimal:           permissionsPolicyFeatureDeferredFetchMinimal,
+	DeviceAttributes:               permissionsPolicyFeatureDeviceAttributes,
+	DigitalCredentialsGet:          permissionsPolicyFeatureDigitalCredentialsGet,
+	DirectSockets:                  permissionsPolicyFeatureDirectSockets,
+	DirectSocketsPrivate:           permissionsPolicyFeatureDirectSocketsPrivate,
+	DisplayCapture:                 permissionsPolicyFeatureDisplayCapture,
+	DocumentDomain:                 permissionsPolicyFeatureDocumentDomain,
+	EncryptedMedia:                 permissionsPolicyFeatureEncryptedMedia,
+	ExecutionWhileOutOfViewport:    permissionsPolicyFeatureExecutionWhileOutOfViewport,
+	ExecutionWhileNotRendered:      permissionsPolicyFeatureExecutionWhileNotRendered,
+	FencedUnpartitionedStorageRead: permissionsPolicyFeatureFencedUnpartitionedStorageRead,
+	FocusWithoutUserActivation:     permissionsPolicyFeatureFocusWithoutUserActivation,
+	Fullscreen:                     permissionsPolicyFeatureFullscreen,
+	Frobulate:                      permissionsPolicyFeatureFrobulate,
+	Gamepad:                        permissionsPolicyFeatureGamepad,
+	Geolocation:                    permissionsPolicyFeatureGeolocation,
+	Gyroscope:                      permissionsPolicyFeatureGyroscope,
+	Hid:                            permissionsPolicyFeatureHid,
+	IdentityCredentialsGet:         permissionsPolicyFeatureIdentityCredentialsGet,
+	IdleDetection:                  permissionsPolicyFeatureIdleDetection,
+	InterestCohort:                 permissionsPolicyFeatureInterestCohort,
+	JoinAdInterestGroup:            permissionsPolicyFeatureJoinAdInterestGroup,
+	KeyboardMap:                    permissionsPolicyFeatureKeyboardMap,
+	LanguageDetector:               permissionsPolicyFeatureLanguageDetector,
+	LanguageModel:                  permissionsPolicyFeatureLanguageModel,
+	LocalFonts:                     permissionsPolicyFeatureLocalFonts,
+	LocalNetworkAccess:             permissionsPolicyFeatureLocalNetworkAccess,
+	Magnetometer:                   permissionsPolicyFeatureMagnetometer,
+	MediaPlaybackWhileNotVisible:   permissionsPolicyFeatureMediaPlaybackWhileNotVisible,
+	Microphone:                     permissionsPolicyFeatureMicrophone,
+	Midi:                           permissionsPolicyFeatureMidi,
+	OnDeviceSpeechRecognition:      permissionsPolicyFeatureOnDeviceSpeechRecognition,
+	OtpCredentials:                 permissionsPolicyFeatureOtpCredentials,
+	Payment:                        permissionsPolicyFeaturePayment,
+	PictureInPicture:               permissionsPolicyFeaturePictureInPicture,
+	Popins:                         permissionsPolicyFeaturePopins,
+	PrivateAggregation:             permissionsPolicyFeaturePrivateAggregation,
+	PrivateStateTokenIssuance:      permissionsPolicyFeaturePrivateStateTokenIssuance,
+	PrivateStateTokenRedemption:    permissionsPolicyFeaturePrivateStateTokenRedemption,
+	PublickeyCredentialsCreate:     permissionsPolicyFeaturePublickeyCredentialsCreate,
+	PublickeyCredentialsGet:        permissionsPolicyFeaturePublickeyCredentialsGet,
+	RecordAdAuctionEvents:          permissionsPolicyFeatureRecordAdAuctionEvents,
+	Rewriter:                       permissionsPolicyFeatureRewriter,
+	RunAdAuction:                   permissionsPolicyFeatureRunAdAuction,
+	ScreenWakeLock:                 permissionsPolicyFeatureScreenWakeLock,
+	Serial:                         permissionsPolicyFeatureSerial,
+	SharedAutofill:                 permissionsPolicyFeatureSharedAutofill,
+	SharedStorage:                  permissionsPolicyFeatureSharedStorage,
+	SharedStorageSelectURL:         permissionsPolicyFeatureSharedStorageSelectURL,
+	SmartCard:                      permissionsPolicyFeatureSmartCard,
+	SpeakerSelection:               permissionsPolicyFeatureSpeakerSelection,
+	StorageAccess:                  permissionsPolicyFeatureStorageAccess,
+	SubApps:                        permissionsPolicyFeatureSubApps,
+	Summarizer:                     permissionsPolicyFeatureSummarizer,
+	SyncXHR:                        permissionsPolicyFeatureSyncXHR,
+	Translator:                     permissionsPolicyFeatureTranslator,
+	Unload:                         permissionsPolicyFeatureUnload,
+	Usb:                            permissionsPolicyFeatureUsb,
+	UsbUnrestricted:                permissionsPolicyFeatureUsbUnrestricted,
+	VerticalScroll:                 permissionsPolicyFeatureVerticalScroll,
+	WebAppInstallation:             permissionsPolicyFeatureWebAppInstallation,
+	WebPrinting:                    permissionsPolicyFeatureWebPrinting,
+	WebShare:                       permissionsPolicyFeatureWebShare,
+	WindowManagement:               permissionsPolicyFeatureWindowManagement,
+	Writer:                         permissionsPolicyFeatureWriter,
+	XrSpatialTracking:              permissionsPolicyFeatureXrSpatialTracking,
+}
+
+/*
+PermissionsPolicyFeatureEnum represents the Page.PermissionsPolicyFeature
+values.
+
+All Permissions Policy features. This enum should match the one defined in
+services/network/public/cpp/permissions_policy/permissions_policy_features.json5.
+LINT.IfChange(PermissionsPolicyFeature)
+
+Allowed values:
+  - PermissionsPolicyFeature.Accelerometer                  "accelerometer"
+  - PermissionsPolicyFeature.AllScreensCapture              "all-screens-capture"
+  - PermissionsPolicyFeature.AmbientLightSensor             "ambient-light-sensor"
+  - PermissionsPolicyFeature.AriaNotify                     "aria-notify"
+  - PermissionsPolicyFeature.AttributionReporting           "attribution-reporting"
+  - PermissionsPolicyFeature.Autoplay                       "autoplay"
+  - PermissionsPolicyFeature.Bluetooth                      "bluetooth"
+  - PermissionsPolicyFeature.BrowsingTopics                 "browsing-topics"
+  - PermissionsPolicyFeature.Camera                         "camera"
+  - PermissionsPolicyFeature.CapturedSurfaceControl         "captured-surface-control"
+  - PermissionsPolicyFeature.ChDpr                          "ch-dpr"
+  - PermissionsPolicyFeature.ChDeviceMemory                 "ch-device-memory"
+  - PermissionsPolicyFeature.ChDownlink                     "ch-downlink"
+  - PermissionsPolicyFeature.ChEct                          "ch-ect"
+  - PermissionsPolicyFeature.ChPrefersColorScheme           "ch-prefers-color-scheme"
+  - PermissionsPolicyFeature.ChPrefersReducedMotion         "ch-prefers-reduced-motion"
+  - PermissionsPolicyFeature.ChPrefersReducedTransparency   "ch-prefers-reduced-transparency"
+  - PermissionsPolicyFeature.ChRtt                          "ch-rtt"
+  - PermissionsPolicyFeature.ChSaveData                     "ch-save-data"
+  - PermissionsPolicyFeature.ChUa                           "ch-ua"
+  - PermissionsPolicyFeature.ChUaArch                       "ch-ua-arch"
+  - PermissionsPolicyFeature.ChUaBitness                    "ch-ua-bitness"
+  - PermissionsPolicyFeature.ChUaHighEntropyValues          "ch-ua-high-entropy-values"
+  - PermissionsPolicyFeature.ChUaPlatform                   "ch-ua-platform"
+  - PermissionsPolicyFeature.ChUaModel                      "ch-ua-model"
+  - PermissionsPolicyFeature.ChUaMobile                     "ch-ua-mobile"
+  - PermissionsPolicyFeature.ChUaFormFactors                "ch-ua-form-factors"
+  - PermissionsPolicyFeature.ChUaFullVersion                "ch-ua-full-version"
+  - PermissionsPolicyFeature.ChUaFullVersionList            "ch-ua-full-version-list"
+  - PermissionsPolicyFeature.ChUaPlatformVersion            "ch-ua-platform-version"
+  - PermissionsPolicyFeature.ChUaWow64                      "ch-ua-wow64"
+  - PermissionsPolicyFeature.ChViewportHeight               "ch-viewport-height"
+  - PermissionsPolicyFeature.ChViewportWidth                "ch-viewport-width"
+  - PermissionsPolicyFeature.ChWidth                        "ch-width"
+  - PermissionsPolicyFeature.ClipboardRead                  "clipboard-read"
+  - PermissionsPolicyFeature.ClipboardWrite                 "clipboard-write"
+  - PermissionsPolicyFeature.ComputePressure                "compute-pressure"
+  - PermissionsPolicyFeature.ControlledFrame                "controlled-frame"
+  - PermissionsPolicyFeature.CrossOriginIsolated            "cross-origin-isolated"
+  - PermissionsPolicyFeature.DeferredFetch                  "deferred-fetch"
+  - PermissionsPolicyFeature.DeferredFetchMinimal           "deferred-fetch-minimal"
+  - PermissionsPolicyFeature.DeviceAttributes               "device-attributes"
+  - PermissionsPolicyFeature.DigitalCredentialsGet          "digital-credentials-get"
+  - PermissionsPolicyFeature.DirectSockets                  "direct-sockets"
+  - PermissionsPolicyFeature.DirectSocketsPrivate           "direct-sockets-private"
+  - PermissionsPolicyFeature.DisplayCapture                 "display-capture"
+  - PermissionsPolicyFeature.DocumentDomain                 "document-domain"
+  - PermissionsPolicyFeature.EncryptedMedia                 "encrypted-media"
+  - PermissionsPolicyFeature.ExecutionWhileOutOfViewport    "execution-while-out-of-viewport"
+  - PermissionsPolicyFeature.ExecutionWhileNotRendered      "execution-while-not-rendered"
+  - PermissionsPolicyFeature.FencedUnpartitionedStorageRead "fenced-unpartitioned-storage-read"
+  - PermissionsPolicyFeature.FocusWithoutUserActivation     "focus-without-user-activation"
+  - PermissionsPolicyFeature.Fullscreen                     "fullscreen"
+  - PermissionsPolicyFeature.Frobulate                      "frobulate"
+  - PermissionsPolicyFeature.Gamepad                        "gamepad"
+  - PermissionsPolicyFeature.Geolocation                    "geolocation"
+  - PermissionsPolicyFeature.Gyroscope                      "gyroscope"
+  - PermissionsPolicyFeature.Hid                            "hid"
+  - PermissionsPolicyFeature.IdentityCredentialsGet         "identity-credentials-get"
+  - PermissionsPolicyFeature.IdleDetection                  "idle-detection"
+  - PermissionsPolicyFeature.InterestCohort                 "interest-cohort"
+  - PermissionsPolicyFeature.JoinAdInterestGroup            "join-ad-interest-group"
+  - PermissionsPolicyFeature.KeyboardMap                    "keyboard-map"
+  - PermissionsPolicyFeature.LanguageDetector               "language-detector"
+  - PermissionsPolicyFeature.LanguageModel                  "language-model"
+  - PermissionsPolicyFeature.LocalFonts                     "local-fonts"
+  - PermissionsPolicyFeature.LocalNetworkAccess             "local-network-access"
+  - PermissionsPolicyFeature.Magnetometer                   "magnetometer"
+  - PermissionsPolicyFeature.MediaPlaybackWhileNotVisible   "media-playback-while-not-visible"
+  - PermissionsPolicyFeature.Microphone                     "microphone"
+  - PermissionsPolicyFeature.Midi                           "midi"
+  - PermissionsPolicyFeature.OnDeviceSpeechRecognition      "on-device-speech-recognition"
+  - PermissionsPolicyFeature.OtpCredentials                 "otp-credentials"
+  - PermissionsPolicyFeature.Payment                        "payment"
+  - PermissionsPolicyFeature.PictureInPicture               "picture-in-picture"
+  - PermissionsPolicyFeature.Popins                         "popins"
+  - PermissionsPolicyFeature.PrivateAggregation             "private-aggregation"
+  - PermissionsPolicyFeature.PrivateStateTokenIssuance      "private-state-token-issuance"
+  - PermissionsPolicyFeature.PrivateStateTokenRedemption    "private-state-token-redemption"
+  - PermissionsPolicyFeature.PublickeyCredentialsCreate     "publickey-credentials-create"
+  - PermissionsPolicyFeature.PublickeyCredentialsGet        "publickey-credentials-get"
+  - PermissionsPolicyFeature.RecordAdAuctionEvents          "record-ad-auction-events"
+  - PermissionsPolicyFeature.Rewriter                       "rewriter"
+  - PermissionsPolicyFeature.RunAdAuction                   "run-ad-auction"
+  - PermissionsPolicyFeature.ScreenWakeLock                 "screen-wake-lock"
+  - PermissionsPolicyFeature.Serial                         "serial"
+  - PermissionsPolicyFeature.SharedAutofill                 "shared-autofill"
+  - PermissionsPolicyFeature.SharedStorage                  "shared-storage"
+  - PermissionsPolicyFeature.SharedStorageSelectURL         "shared-storage-select-url"
+  - PermissionsPolicyFeature.SmartCard                      "smart-card"
+  - PermissionsPolicyFeature.SpeakerSelection               "speaker-selection"
+  - PermissionsPolicyFeature.StorageAccess                  "storage-access"
+  - PermissionsPolicyFeature.SubApps                        "sub-apps"
+  - PermissionsPolicyFeature.Summarizer                     "summarizer"
+  - PermissionsPolicyFeature.SyncXHR                        "sync-xhr"
+  - PermissionsPolicyFeature.Translator                     "translator"
+  - PermissionsPolicyFeature.Unload                         "unload"
+  - PermissionsPolicyFeature.Usb                            "usb"
+  - PermissionsPolicyFeature.UsbUnrestricted                "usb-unrestricted"
+  - PermissionsPolicyFeature.VerticalScroll                 "vertical-scroll"
+  - PermissionsPolicyFeature.WebAppInstallation             "web-app-installation"
+  - PermissionsPolicyFeature.WebPrinting                    "web-printing"
+  - PermissionsPolicyFeature.WebShare                       "web-share"
+  - PermissionsPolicyFeature.WindowManagement               "window-management"
+  - PermissionsPolicyFeature.Writer                         "writer"
+  - PermissionsPolicyFeature.XrSpatialTracking              "xr-spatial-tracking"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-PermissionsPolicyFeature
+*/
+type PermissionsPolicyFeatureEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PermissionsPolicyFeatureEnum) String() string {
+	return _permissionsPolicyFeatureEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PermissionsPolicyFeatureEnum) Unknown() bool {
+	return !_permissionsPolicyFeatureEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PermissionsPolicyFeatureEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PermissionsPolicyFeatureEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _permissionsPolicyFeatureEnums.Parse(val)
+	return nil
+}
+
+const (
+	// permissionsPolicyFeatureAccelerometer represents the "accelerometer" value.
+	permissionsPolicyFeatureAccelerometer PermissionsPolicyFeatureEnum = iota + 1
+	// permissionsPolicyFeatureAllScreensCapture represents the "all-screens-capture" value.
+	permissionsPolicyFeatureAllScreensCapture
+	// permissionsPolicyFeatureAmbientLightSensor represents the "ambient-light-sensor" value.
+	permissionsPolicyFeatureAmbientLightSensor
+	// permissionsPolicyFeatureAriaNotify represents the "aria-notify" value.
+	permissionsPolicyFeatureAriaNotify
+	// permissionsPolicyFeatureAttributionReporting represents the "attribution-reporting" value.
+	permissionsPolicyFeatureAttributionReporting
+	// permissionsPolicyFeatureAutoplay represents the "autoplay" value.
+	permissionsPolicyFeatureAutoplay
+	// permissionsPolicyFeatureBluetooth represents the "bluetooth" value.
+	permissionsPolicyFeatureBluetooth
+	// permissionsPolicyFeatureBrowsingTopics represents the "browsing-topics" value.
+	permissionsPolicyFeatureBrowsingTopics
+	// permissionsPolicyFeatureCamera represents the "camera" value.
+	permissionsPolicyFeatureCamera
+	// permissionsPolicyFeatureCapturedSurfaceControl represents the "captured-surface-control" value.
+	permissionsPolicyFeatureCapturedSurfaceControl
+	// permissionsPolicyFeatureChDpr represents the "ch-dpr" value.
+	permissionsPolicyFeatureChDpr
+	// permissionsPolicyFeatureChDeviceMemory represents the "ch-device-memory" value.
+	permissionsPolicyFeatureChDeviceMemory
+	// permissionsPolicyFeatureChDownlink represents the "ch-downlink" value.
+	permissionsPolicyFeatureChDownlink
+	// permissionsPolicyFeatureChEct represents the "ch-ect" value.
+	permissionsPolicyFeatureChEct
+	// permissionsPolicyFeatureChPrefersColorScheme represents the "ch-prefers-color-scheme" value.
+	permissionsPolicyFeatureChPrefersColorScheme
+	// permissionsPolicyFeatureChPrefersReducedMotion represents the "ch-prefers-reduced-motion" value.
+	permissionsPolicyFeatureChPrefersReducedMotion
+	// permissionsPolicyFeatureChPrefersReducedTransparency represents the "ch-prefers-reduced-transparency" value.
+	permissionsPolicyFeatureChPrefersReducedTransparency
+	// permissionsPolicyFeatureChRtt represents the "ch-rtt" value.
+	permissionsPolicyFeatureChRtt
+	// permissionsPolicyFeatureChSaveData represents the "ch-save-data" value.
+	permissionsPolicyFeatureChSaveData
+	// permissionsPolicyFeatureChUa represents the "ch-ua" value.
+	permissionsPolicyFeatureChUa
+	// permissionsPolicyFeatureChUaArch represents the "ch-ua-arch" value.
+	permissionsPolicyFeatureChUaArch
+	// permissionsPolicyFeatureChUaBitness represents the "ch-ua-bitness" value.
+	permissionsPolicyFeatureChUaBitness
+	// permissionsPolicyFeatureChUaHighEntropyValues represents the "ch-ua-high-entropy-values" value.
+	permissionsPolicyFeatureChUaHighEntropyValues
+	// permissionsPolicyFeatureChUaPlatform represents the "ch-ua-platform" value.
+	permissionsPolicyFeatureChUaPlatform
+	// permissionsPolicyFeatureChUaModel represents the "ch-ua-model" value.
+	permissionsPolicyFeatureChUaModel
+	// permissionsPolicyFeatureChUaMobile represents the "ch-ua-mobile" value.
+	permissionsPolicyFeatureChUaMobile
+	// permissionsPolicyFeatureChUaFormFactors represents the "ch-ua-form-factors" value.
+	permissionsPolicyFeatureChUaFormFactors
+	// permissionsPolicyFeatureChUaFullVersion represents the "ch-ua-full-version" value.
+	permissionsPolicyFeatureChUaFullVersion
+	// permissionsPolicyFeatureChUaFullVersionList represents the "ch-ua-full-version-list" value.
+	permissionsPolicyFeatureChUaFullVersionList
+	// permissionsPolicyFeatureChUaPlatformVersion represents the "ch-ua-platform-version" value.
+	permissionsPolicyFeatureChUaPlatformVersion
+	// permissionsPolicyFeatureChUaWow64 represents the "ch-ua-wow64" value.
+	permissionsPolicyFeatureChUaWow64
+	// permissionsPolicyFeatureChViewportHeight represents the "ch-viewport-height" value.
+	permissionsPolicyFeatureChViewportHeight
+	// permissionsPolicyFeatureChViewportWidth represents the "ch-viewport-width" value.
+	permissionsPolicyFeatureChViewportWidth
+	// permissionsPolicyFeatureChWidth represents the "ch-width" value.
+	permissionsPolicyFeatureChWidth
+	// permissionsPolicyFeatureClipboardRead represents the "clipboard-read" value.
+	permissionsPolicyFeatureClipboardRead
+	// permissionsPolicyFeatureClipboardWrite represents the "clipboard-write" value.
+	permissionsPolicyFeatureClipboardWrite
+	// permissionsPolicyFeatureComputePressure represents the "compute-pressure" value.
+	permissionsPolicyFeatureComputePressure
+	// permissionsPolicyFeatureControlledFrame represents the "controlled-frame" value.
+	permissionsPolicyFeatureControlledFrame
+	// permissionsPolicyFeatureCrossOriginIsolated represents the "cross-origin-isolated" value.
+	permissionsPolicyFeatureCrossOriginIsolated
+	// permissionsPolicyFeatureDeferredFetch represents the "deferred-fetch" value.
+	permissionsPolicyFeatureDeferredFetch
+	// permissionsPolicyFeatureDeferredFetchMinimal represents the "deferred-fetch-minimal" value.
+	permissionsPolicyFeatureDeferredFetchMinimal
+	// permissionsPolicyFeatureDeviceAttributes represents the "device-attributes" value.
+	permissionsPolicyFeatureDeviceAttributes
+	// permissionsPolicyFeatureDigitalCredentialsGet represents the "digital-credentials-get" value.
+	permissionsPolicyFeatureDigitalCredentialsGet
+	// permissionsPolicyFeatureDirectSockets represents the "direct-sockets" value.
+	permissionsPolicyFeatureDirectSockets
+	// permissionsPolicyFeatureDirectSocketsPrivate represents the "direct-sockets-private" value.
+	permissionsPolicyFeatureDirectSocketsPrivate
+	// permissionsPolicyFeatureDisplayCapture represents the "display-capture" value.
+	permissionsPolicyFeatureDisplayCapture
+	// permissionsPolicyFeatureDocumentDomain represents the "document-domain" value.
+	permissionsPolicyFeatureDocumentDomain
+	// permissionsPolicyFeatureEncryptedMedia represents the "encrypted-media" value.
+	permissionsPolicyFeatureEncryptedMedia
+	// permissionsPolicyFeatureExecutionWhileOutOfViewport represents the "execution-while-out-of-viewport" value.
+	permissionsPolicyFeatureExecutionWhileOutOfViewport
+	// permissionsPolicyFeatureExecutionWhileNotRendered represents the "execution-while-not-rendered" value.
+	permissionsPolicyFeatureExecutionWhileNotRendered
+	// permissionsPolicyFeatureFencedUnpartitionedStorageRead represents the "fenced-unpartitioned-storage-read" value.
+	permissionsPolicyFeatureFencedUnpartitionedStorageRead
+	// permissionsPolicyFeatureFocusWithoutUserActivation represents the "focus-without-user-activation" value.
+	permissionsPolicyFeatureFocusWithoutUserActivation
+	// permissionsPolicyFeatureFullscreen represents the "fullscreen" value.
+	permissionsPolicyFeatureFullscreen
+	// permissionsPolicyFeatureFrobulate represents the "frobulate" value.
+	permissionsPolicyFeatureFrobulate
+	// permissionsPolicyFeatureGamepad represents the "gamepad" value.
+	permissionsPolicyFeatureGamepad
+	// permissionsPolicyFeatureGeolocation represents the "geolocation" value.
+	permissionsPolicyFeatureGeolocation
+	// permissionsPolicyFeatureGyroscope represents the "gyroscope" value.
+	permissionsPolicyFeatureGyroscope
+	// permissionsPolicyFeatureHid represents the "hid" value.
+	permissionsPolicyFeatureHid
+	// permissionsPolicyFeatureIdentityCredentialsGet represents the "identity-credentials-get" value.
+	permissionsPolicyFeatureIdentityCredentialsGet
+	// permissionsPolicyFeatureIdleDetection represents the "idle-detection" value.
+	permissionsPolicyFeatureIdleDetection
+	// permissionsPolicyFeatureInterestCohort represents the "interest-cohort" value.
+	permissionsPolicyFeatureInterestCohort
+	// permissionsPolicyFeatureJoinAdInterestGroup represents the "join-ad-interest-group" value.
+	permissionsPolicyFeatureJoinAdInterestGroup
+	// permissionsPolicyFeatureKeyboardMap represents the "keyboard-map" value.
+	permissionsPolicyFeatureKeyboardMap
+	// permissionsPolicyFeatureLanguageDetector represents the "language-detector" value.
+	permissionsPolicyFeatureLanguageDetector
+	// permissionsPolicyFeatureLanguageModel represents the "language-model" value.
+	permissionsPolicyFeatureLanguageModel
+	// permissionsPolicyFeatureLocalFonts represents the "local-fonts" value.
+	permissionsPolicyFeatureLocalFonts
+	// permissionsPolicyFeatureLocalNetworkAccess represents the "local-network-access" value.
+	permissionsPolicyFeatureLocalNetworkAccess
+	// permissionsPolicyFeatureMagnetometer represents the "magnetometer" value.
+	permissionsPolicyFeatureMagnetometer
+	// permissionsPolicyFeatureMediaPlaybackWhileNotVisible represents the "media-playback-while-not-visible" value.
+	permissionsPolicyFeatureMediaPlaybackWhileNotVisible
+	// permissionsPolicyFeatureMicrophone represents the "microphone" value.
+	permissionsPolicyFeatureMicrophone
+	// permissionsPolicyFeatureMidi represents the "midi" value.
+	permissionsPolicyFeatureMidi
+	// permissionsPolicyFeatureOnDeviceSpeechRecognition represents the "on-device-speech-recognition" value.
+	permissionsPolicyFeatureOnDeviceSpeechRecognition
+	// permissionsPolicyFeatureOtpCredentials represents the "otp-credentials" value.
+	permissionsPolicyFeatureOtpCredentials
+	// permissionsPolicyFeaturePayment represents the "payment" value.
+	permissionsPolicyFeaturePayment
+	// permissionsPolicyFeaturePictureInPicture represents the "picture-in-picture" value.
+	permissionsPolicyFeaturePictureInPicture
+	// permissionsPolicyFeaturePopins represents the "popins" value.
+	permissionsPolicyFeaturePopins
+	// permissionsPolicyFeaturePrivateAggregation represents the "private-aggregation" value.
+	permissionsPolicyFeaturePrivateAggregation
+	// permissionsPolicyFeaturePrivateStateTokenIssuance represents the "private-state-token-issuance" value.
+	permissionsPolicyFeaturePrivateStateTokenIssuance
+	// permissionsPolicyFeaturePrivateStateTokenRedemption represents the "private-state-token-redemption" value.
+	permissionsPolicyFeaturePrivateStateTokenRedemption
+	// permissionsPolicyFeaturePublickeyCredentialsCreate represents the "publickey-credentials-create" value.
+	permissionsPolicyFeaturePublickeyCredentialsCreate
+	// permissionsPolicyFeaturePublickeyCredentialsGet represents the "publickey-credentials-get" value.
+	permissionsPolicyFeaturePublickeyCredentialsGet
+	// permissionsPolicyFeatureRecordAdAuctionEvents represents the "record-ad-auction-events" value.
+	permissionsPolicyFeatureRecordAdAuctionEvents
+	// permissionsPolicyFeatureRewriter represents the "rewriter" value.
+	permissionsPolicyFeatureRewriter
+	// permissionsPolicyFeatureRunAdAuction represents the "run-ad-auction" value.
+	permissionsPolicyFeatureRunAdAuction
+	// permissionsPolicyFeatureScreenWakeLock represents the "screen-wake-lock" value.
+	permissionsPolicyFeatureScreenWakeLock
+	// permissionsPolicyFeatureSerial represents the "serial" value.
+	permissionsPolicyFeatureSerial
+	// permissionsPolicyFeatureSharedAutofill represents the "shared-autofill" value.
+	permissionsPolicyFeatureSharedAutofill
+	// permissionsPolicyFeatureSharedStorage represents the "shared-storage" value.
+	permissionsPolicyFeatureSharedStorage
+	// permissionsPolicyFeatureSharedStorageSelectURL represents the "shared-storage-select-url" value.
+	permissionsPolicyFeatureSharedStorageSelectURL
+	// permissionsPolicyFeatureSmartCard represents the "smart-card" value.
+	permissionsPolicyFeatureSmartCard
+	// permissionsPolicyFeatureSpeakerSelection represents the "speaker-selection" value.
+	permissionsPolicyFeatureSpeakerSelection
+	// permissionsPolicyFeatureStorageAccess represents the "storage-access" value.
+	permissionsPolicyFeatureStorageAccess
+	// permissionsPolicyFeatureSubApps represents the "sub-apps" value.
+	permissionsPolicyFeatureSubApps
+	// permissionsPolicyFeatureSummarizer represents the "summarizer" value.
+	permissionsPolicyFeatureSummarizer
+	// permissionsPolicyFeatureSyncXHR represents the "sync-xhr" value.
+	permissionsPolicyFeatureSyncXHR
+	// permissionsPolicyFeatureTranslator represents the "translator" value.
+	permissionsPolicyFeatureTranslator
+	// permissionsPolicyFeatureUnload represents the "unload" value.
+	permissionsPolicyFeatureUnload
+	// permissionsPolicyFeatureUsb represents the "usb" value.
+	permissionsPolicyFeatureUsb
+	// permissionsPolicyFeatureUsbUnrestricted represents the "usb-unrestricted" value.
+	permissionsPolicyFeatureUsbUnrestricted
+	// permissionsPolicyFeatureVerticalScroll represents the "vertical-scroll" value.
+	permissionsPolicyFeatureVerticalScroll
+	// permissionsPolicyFeatureWebAppInstallation represents the "web-app-installation" value.
+	permissionsPolicyFeatureWebAppInstallation
+	// permissionsPolicyFeatureWebPrinting represents the "web-printing" value.
+	permissionsPolicyFeatureWebPrinting
+	// permissionsPolicyFeatureWebShare represents the "web-share" value.
+	permissionsPolicyFeatureWebShare
+	// permissionsPolicyFeatureWindowManagement represents the "window-management" value.
+	permissionsPolicyFeatureWindowManagement
+	// permissionsPolicyFeatureWriter represents the "writer" value.
+	permissionsPolicyFeatureWriter
+	// permissionsPolicyFeatureXrSpatialTracking represents the "xr-spatial-tracking" value.
+	permissionsPolicyFeatureXrSpatialTracking
+)
+
+var _permissionsPolicyFeatureEnums = enums.New(map[PermissionsPolicyFeatureEnum]string{
+	PermissionsPolicyFeatureEnum(0):                        "",
+	permissionsPolicyFeatureAccelerometer:                  "accelerometer",
+	permissionsPolicyFeatureAllScreensCapture:              "all-screens-capture",
+	permissionsPolicyFeatureAmbientLightSensor:             "ambient-light-sensor",
+	permissionsPolicyFeatureAriaNotify:                     "aria-notify",
+	permissionsPolicyFeatureAttributionReporting:           "attribution-reporting",
+	permissionsPolicyFeatureAutoplay:                       "autoplay",
+	permissionsPolicyFeatureBluetooth:                      "bluetooth",
+	permissionsPolicyFeatureBrowsingTopics:                 "browsing-topics",
+	permissionsPolicyFeatureCamera:                         "camera",
+	permissionsPolicyFeatureCapturedSurfaceControl:         "captured-surface-control",
+	permissionsPolicyFeatureChDpr:                          "ch-dpr",
+	permissionsPolicyFeatureChDeviceMemory:                 "ch-device-memory",
+	permissionsPolicyFeatureChDownlink:                     "ch-downlink",
+	permissionsPolicyFeatureChEct:                          "ch-ect",
+	permissionsPolicyFeatureChPrefersColorScheme:           "ch-prefers-color-scheme",
+	permissionsPolicyFeatureChPrefersReducedMotion:         "ch-prefers-reduced-motion",
+	permissionsPolicyFeatureChPrefersReducedTransparency:   "ch-prefers-reduced-transparency",
+	permissionsPolicyFeatureChRtt:                          "ch-rtt",
+	permissionsPolicyFeatureChSaveData:                     "ch-save-data",
+	permissionsPolicyFeatureChUa:                           "ch-ua",
+	permissionsPolicyFeatureChUaArch:                       "ch-ua-arch",
+	permissionsPolicyFeatureChUaBitness:                    "ch-ua-bitness",
+	permissionsPolicyFeatureChUaHighEntropyValues:          "ch-ua-high-entropy-values",
+	permissionsPolicyFeatureChUaPlatform:                   "ch-ua-platform",
+	permissionsPolicyFeatureChUaModel:                      "ch-ua-model",
+	permissionsPolicyFeatureChUaMobile:                     "ch-ua-mobile",
+	permissionsPolicyFeatureChUaFormFactors:                "ch-ua-form-factors",
+	permissionsPolicyFeatureChUaFullVersion:                "ch-ua-full-version",
+	permissionsPolicyFeatureChUaFullVersionList:            "ch-ua-full-version-list",
+	permissionsPolicyFeatureChUaPlatformVersion:            "ch-ua-platform-version",
+	permissionsPolicyFeatureChUaWow64:                      "ch-ua-wow64",
+	permissionsPolicyFeatureChViewportHeight:               "ch-viewport-height",
+	permissionsPolicyFeatureChViewportWidth:                "ch-viewport-width",
+	permissionsPolicyFeatureChWidth:                        "ch-width",
+	permissionsPolicyFeatureClipboardRead:                  "clipboard-read",
+	permissionsPolicyFeatureClipboardWrite:                 "clipboard-write",
+	permissionsPolicyFeatureComputePressure:                "compute-pressure",
+	permissionsPolicyFeatureControlledFrame:                "controlled-frame",
+	permissionsPolicyFeatureCrossOriginIsolated:            "cross-origin-isolated",
+	permissionsPolicyFeatureDeferredFetch:                  "deferred-fetch",
+	permissionsPolicyFeatureDeferredFetchMinimal:           "deferred-fetch-minimal",
+	permissionsPolicyFeatureDeviceAttributes:               "device-attributes",
+	permissionsPolicyFeatureDigitalCredentialsGet:          "digital-credentials-get",
+	permissionsPolicyFeatureDirectSockets:                  "direct-sockets",
+	permissionsPolicyFeatureDirectSocketsPrivate:           "direct-sockets-private",
+	permissionsPolicyFeatureDisplayCapture:                 "display-capture",
+	permissionsPolicyFeatureDocumentDomain:                 "document-domain",
+	permissionsPolicyFeatureEncryptedMedia:                 "encrypted-media",
+	permissionsPolicyFeatureExecutionWhileOutOfViewport:    "execution-while-out-of-viewport",
+	permissionsPolicyFeatureExecutionWhileNotRendered:      "execution-while-not-rendered",
+	permissionsPolicyFeatureFencedUnpartitionedStorageRead: "fenced-unpartitioned-storage-read",
+	permissionsPolicyFeatureFocusWithoutUserActivation:     "focus-without-user-activation",
+	permissionsPolicyFeatureFullscreen:                     "fullscreen",
+	permissionsPolicyFeatureFrobulate:                      "frobulate",
+	permissionsPolicyFeatureGamepad:                        "gamepad",
+	permissionsPolicyFeatureGeolocation:                    "geolocation",
+	permissionsPolicyFeatureGyroscope:                      "gyroscope",
+	permissionsPolicyFeatureHid:                            "hid",
+	permissionsPolicyFeatureIdentityCredentialsGet:         "identity-credentials-get",
+	permissionsPolicyFeatureIdleDetection:                  "idle-detection",
+	permissionsPolicyFeatureInterestCohort:                 "interest-cohort",
+	permissionsPolicyFeatureJoinAdInterestGroup:            "join-ad-interest-group",
+	permissionsPolicyFeatureKeyboardMap:                    "keyboard-map",
+	permissionsPolicyFeatureLanguageDetector:               "language-detector",
+	permissionsPolicyFeatureLanguageModel:                  "language-model",
+	permissionsPolicyFeatureLocalFonts:                     "local-fonts",
+	permissionsPolicyFeatureLocalNetworkAccess:             "local-network-access",
+	permissionsPolicyFeatureMagnetometer:                   "magnetometer",
+	permissionsPolicyFeatureMediaPlaybackWhileNotVisible:   "media-playback-while-not-visible",
+	permissionsPolicyFeatureMicrophone:                     "microphone",
+	permissionsPolicyFeatureMidi:                           "midi",
+	permissionsPolicyFeatureOnDeviceSpeechRecognition:      "on-device-speech-recognition",
+	permissionsPolicyFeatureOtpCredentials:                 "otp-credentials",
+	permissionsPolicyFeaturePayment:                        "payment",
+	permissionsPolicyFeaturePictureInPicture:               "picture-in-picture",
+	permissionsPolicyFeaturePopins:                         "popins",
+	permissionsPolicyFeaturePrivateAggregation:             "private-aggregation",
+	permissionsPolicyFeaturePrivateStateTokenIssuance:      "private-state-token-issuance",
+	permissionsPolicyFeaturePrivateStateTokenRedemption:    "private-state-token-redemption",
+	permissionsPolicyFeaturePublickeyCredentialsCreate:     "publickey-credentials-create",
+	permissionsPolicyFeaturePublickeyCredentialsGet:        "publickey-credentials-get",
+	permissionsPolicyFeatureRecordAdAuctionEvents:          "record-ad-auction-events",
+	permissionsPolicyFeatureRewriter:                       "rewriter",
+	permissionsPolicyFeatureRunAdAuction:                   "run-ad-auction",
+	permissionsPolicyFeatureScreenWakeLock:                 "screen-wake-lock",
+	permissionsPolicyFeatureSerial:                         "serial",
+	permissionsPolicyFeatureSharedAutofill:                 "shared-autofill",
+	permissionsPolicyFeatureSharedStorage:                  "shared-storage",
+	permissionsPolicyFeatureSharedStorageSelectURL:         "shared-storage-select-url",
+	permissionsPolicyFeatureSmartCard:                      "smart-card",
+	permissionsPolicyFeatureSpeakerSelection:               "speaker-selection",
+	permissionsPolicyFeatureStorageAccess:                  "storage-access",
+	permissionsPolicyFeatureSubApps:                        "sub-apps",
+	permissionsPolicyFeatureSummarizer:                     "summarizer",
+	permissionsPolicyFeatureSyncXHR:                        "sync-xhr",
+	permissionsPolicyFeatureTranslator:                     "translator",
+	permissionsPolicyFeatureUnload:                         "unload",
+	permissionsPolicyFeatureUsb:                            "usb",
+	permissionsPolicyFeatureUsbUnrestricted:                "usb-unrestricted",
+	permissionsPolicyFeatureVerticalScroll:                 "vertical-scroll",
+	permissionsPolicyFeatureWebAppInstallation:             "web-app-installation",
+	permissionsPolicyFeatureWebPrinting:                    "web-printing",
+	permissionsPolicyFeatureWebShare:                       "web-share",
+	permissionsPolicyFeatureWindowManagement:               "window-management",
+	permissionsPolicyFeatureWriter:                         "writer",
+	permissionsPolicyFeatureXrSpatialTracking:              "xr-spatial-tracking",
+})
@@ -0,0 +1,1421 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPermissionsPolicyFeature(t *testing.T) {
+	var enum PermissionsPolicyFeatureEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PermissionsPolicyFeatureEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PermissionsPolicyFeature.Accelerometer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"accelerometer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"accelerometer"`, result)
+	}
+	json.Unmarshal([]byte(`"accelerometer"`), &enum)
+	if PermissionsPolicyFeature.Accelerometer != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Accelerometer, enum)
+	}
+
+	enum = PermissionsPolicyFeature.AllScreensCapture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"all-screens-capture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"all-screens-capture"`, result)
+	}
+	json.Unmarshal([]byte(`"all-screens-capture"`), &enum)
+	if PermissionsPolicyFeature.AllScreensCapture != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.AllScreensCapture, enum)
+	}
+
+	enum = PermissionsPolicyFeature.AmbientLightSensor
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ambient-light-sensor"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ambient-light-sensor"`, result)
+	}
+	json.Unmarshal([]byte(`"ambient-light-sensor"`), &enum)
+	if PermissionsPolicyFeature.AmbientLightSensor != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.AmbientLightSensor, enum)
+	}
+
+	enum = PermissionsPolicyFeature.AriaNotify
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"aria-notify"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"aria-notify"`, result)
+	}
+	json.Unmarshal([]byte(`"aria-notify"`), &enum)
+	if PermissionsPolicyFeature.AriaNotify != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.AriaNotify, enum)
+	}
+
+	enum = PermissionsPolicyFeature.AttributionReporting
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"attribution-reporting"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"attribution-reporting"`, result)
+	}
+	json.Unmarshal([]byte(`"attribution-reporting"`), &enum)
+	if PermissionsPolicyFeature.AttributionReporting != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.AttributionReporting, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Autoplay
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoplay"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoplay"`, result)
+	}
+	json.Unmarshal([]byte(`"autoplay"`), &enum)
+	if PermissionsPolicyFeature.Autoplay != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Autoplay, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Bluetooth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bluetooth"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bluetooth"`, result)
+	}
+	json.Unmarshal([]byte(`"bluetooth"`), &enum)
+	if PermissionsPolicyFeature.Bluetooth != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Bluetooth, enum)
+	}
+
+	enum = PermissionsPolicyFeature.BrowsingTopics
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"browsing-topics"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"browsing-topics"`, result)
+	}
+	json.Unmarshal([]byte(`"browsing-topics"`), &enum)
+	if PermissionsPolicyFeature.BrowsingTopics != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.BrowsingTopics, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Camera
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"camera"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"camera"`, result)
+	}
+	json.Unmarshal([]byte(`"camera"`), &enum)
+	if PermissionsPolicyFeature.Camera != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Camera, enum)
+	}
+
+	enum = PermissionsPolicyFeature.CapturedSurfaceControl
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"captured-surface-control"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"captured-surface-control"`, result)
+	}
+	json.Unmarshal([]byte(`"captured-surface-control"`), &enum)
+	if PermissionsPolicyFeature.CapturedSurfaceControl != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.CapturedSurfaceControl, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChDpr
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-dpr"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-dpr"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-dpr"`), &enum)
+	if PermissionsPolicyFeature.ChDpr != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChDpr, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChDeviceMemory
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-device-memory"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-device-memory"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-device-memory"`), &enum)
+	if PermissionsPolicyFeature.ChDeviceMemory != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChDeviceMemory, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChDownlink
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-downlink"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-downlink"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-downlink"`), &enum)
+	if PermissionsPolicyFeature.ChDownlink != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChDownlink, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChEct
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ect"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ect"`), &enum)
+	if PermissionsPolicyFeature.ChEct != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChEct, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChPrefersColorScheme
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-prefers-color-scheme"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-prefers-color-scheme"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-prefers-color-scheme"`), &enum)
+	if PermissionsPolicyFeature.ChPrefersColorScheme != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChPrefersColorScheme, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChPrefersReducedMotion
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-prefers-reduced-motion"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-prefers-reduced-motion"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-prefers-reduced-motion"`), &enum)
+	if PermissionsPolicyFeature.ChPrefersReducedMotion != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChPrefersReducedMotion, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChPrefersReducedTransparency
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-prefers-reduced-transparency"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-prefers-reduced-transparency"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-prefers-reduced-transparency"`), &enum)
+	if PermissionsPolicyFeature.ChPrefersReducedTransparency != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChPrefersReducedTransparency, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChRtt
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-rtt"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-rtt"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-rtt"`), &enum)
+	if PermissionsPolicyFeature.ChRtt != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChRtt, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChSaveData
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-save-data"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-save-data"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-save-data"`), &enum)
+	if PermissionsPolicyFeature.ChSaveData != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChSaveData, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUa
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua"`), &enum)
+	if PermissionsPolicyFeature.ChUa != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUa, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaArch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-arch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-arch"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-arch"`), &enum)
+	if PermissionsPolicyFeature.ChUaArch != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaArch, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaBitness
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-bitness"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-bitness"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-bitness"`), &enum)
+	if PermissionsPolicyFeature.ChUaBitness != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaBitness, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaHighEntropyValues
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-high-entropy-values"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-high-entropy-values"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-high-entropy-values"`), &enum)
+	if PermissionsPolicyFeature.ChUaHighEntropyValues != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaHighEntropyValues, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaPlatform
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-platform"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-platform"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-platform"`), &enum)
+	if PermissionsPolicyFeature.ChUaPlatform != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaPlatform, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaModel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-model"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-model"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-model"`), &enum)
+	if PermissionsPolicyFeature.ChUaModel != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaModel, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaMobile
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-mobile"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-mobile"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-mobile"`), &enum)
+	if PermissionsPolicyFeature.ChUaMobile != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaMobile, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaFormFactors
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-form-factors"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-form-factors"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-form-factors"`), &enum)
+	if PermissionsPolicyFeature.ChUaFormFactors != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaFormFactors, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaFullVersion
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-full-version"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-full-version"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-full-version"`), &enum)
+	if PermissionsPolicyFeature.ChUaFullVersion != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaFullVersion, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaFullVersionList
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-full-version-list"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-full-version-list"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-full-version-list"`), &enum)
+	if PermissionsPolicyFeature.ChUaFullVersionList != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaFullVersionList, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaPlatformVersion
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-platform-version"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-platform-version"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-platform-version"`), &enum)
+	if PermissionsPolicyFeature.ChUaPlatformVersion != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaPlatformVersion, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChUaWow64
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-ua-wow64"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-ua-wow64"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-ua-wow64"`), &enum)
+	if PermissionsPolicyFeature.ChUaWow64 != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChUaWow64, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChViewportHeight
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-viewport-height"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-viewport-height"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-viewport-height"`), &enum)
+	if PermissionsPolicyFeature.ChViewportHeight != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChViewportHeight, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChViewportWidth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-viewport-width"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-viewport-width"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-viewport-width"`), &enum)
+	if PermissionsPolicyFeature.ChViewportWidth != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChViewportWidth, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ChWidth
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ch-width"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ch-width"`, result)
+	}
+	json.Unmarshal([]byte(`"ch-width"`), &enum)
+	if PermissionsPolicyFeature.ChWidth != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ChWidth, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ClipboardRead
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clipboard-read"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clipboard-read"`, result)
+	}
+	json.Unmarshal([]byte(`"clipboard-read"`), &enum)
+	if PermissionsPolicyFeature.ClipboardRead != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ClipboardRead, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ClipboardWrite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clipboard-write"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clipboard-write"`, result)
+	}
+	json.Unmarshal([]byte(`"clipboard-write"`), &enum)
+	if PermissionsPolicyFeature.ClipboardWrite != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ClipboardWrite, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ComputePressure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"compute-pressure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"compute-pressure"`, result)
+	}
+	json.Unmarshal([]byte(`"compute-pressure"`), &enum)
+	if PermissionsPolicyFeature.ComputePressure != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ComputePressure, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ControlledFrame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"controlled-frame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"controlled-frame"`, result)
+	}
+	json.Unmarshal([]byte(`"controlled-frame"`), &enum)
+	if PermissionsPolicyFeature.ControlledFrame != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ControlledFrame, enum)
+	}
+
+	enum = PermissionsPolicyFeature.CrossOriginIsolated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cross-origin-isolated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cross-origin-isolated"`, result)
+	}
+	json.Unmarshal([]byte(`"cross-origin-isolated"`), &enum)
+	if PermissionsPolicyFeature.CrossOriginIsolated != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.CrossOriginIsolated, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DeferredFetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deferred-fetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deferred-fetch"`, result)
+	}
+	json.Unmarshal([]byte(`"deferred-fetch"`), &enum)
+	if PermissionsPolicyFeature.DeferredFetch != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DeferredFetch, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DeferredFetchMinimal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deferred-fetch-minimal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deferred-fetch-minimal"`, result)
+	}
+	json.Unmarshal([]byte(`"deferred-fetch-minimal"`), &enum)
+	if PermissionsPolicyFeature.DeferredFetchMinimal != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DeferredFetchMinimal, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DeviceAttributes
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"device-attributes"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"device-attributes"`, result)
+	}
+	json.Unmarshal([]byte(`"device-attributes"`), &enum)
+	if PermissionsPolicyFeature.DeviceAttributes != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DeviceAttributes, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DigitalCredentialsGet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"digital-credentials-get"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"digital-credentials-get"`, result)
+	}
+	json.Unmarshal([]byte(`"digital-credentials-get"`), &enum)
+	if PermissionsPolicyFeature.DigitalCredentialsGet != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DigitalCredentialsGet, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DirectSockets
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"direct-sockets"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"direct-sockets"`, result)
+	}
+	json.Unmarshal([]byte(`"direct-sockets"`), &enum)
+	if PermissionsPolicyFeature.DirectSockets != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DirectSockets, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DirectSocketsPrivate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"direct-sockets-private"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"direct-sockets-private"`, result)
+	}
+	json.Unmarshal([]byte(`"direct-sockets-private"`), &enum)
+	if PermissionsPolicyFeature.DirectSocketsPrivate != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DirectSocketsPrivate, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DisplayCapture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"display-capture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"display-capture"`, result)
+	}
+	json.Unmarshal([]byte(`"display-capture"`), &enum)
+	if PermissionsPolicyFeature.DisplayCapture != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DisplayCapture, enum)
+	}
+
+	enum = PermissionsPolicyFeature.DocumentDomain
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"document-domain"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"document-domain"`, result)
+	}
+	json.Unmarshal([]byte(`"document-domain"`), &enum)
+	if PermissionsPolicyFeature.DocumentDomain != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.DocumentDomain, enum)
+	}
+
+	enum = PermissionsPolicyFeature.EncryptedMedia
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"encrypted-media"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"encrypted-media"`, result)
+	}
+	json.Unmarshal([]byte(`"encrypted-media"`), &enum)
+	if PermissionsPolicyFeature.EncryptedMedia != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.EncryptedMedia, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ExecutionWhileOutOfViewport
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"execution-while-out-of-viewport"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"execution-while-out-of-viewport"`, result)
+	}
+	json.Unmarshal([]byte(`"execution-while-out-of-viewport"`), &enum)
+	if PermissionsPolicyFeature.ExecutionWhileOutOfViewport != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ExecutionWhileOutOfViewport, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ExecutionWhileNotRendered
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"execution-while-not-rendered"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"execution-while-not-rendered"`, result)
+	}
+	json.Unmarshal([]byte(`"execution-while-not-rendered"`), &enum)
+	if PermissionsPolicyFeature.ExecutionWhileNotRendered != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ExecutionWhileNotRendered, enum)
+	}
+
+	enum = PermissionsPolicyFeature.FencedUnpartitionedStorageRead
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fenced-unpartitioned-storage-read"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fenced-unpartitioned-storage-read"`, result)
+	}
+	json.Unmarshal([]byte(`"fenced-unpartitioned-storage-read"`), &enum)
+	if PermissionsPolicyFeature.FencedUnpartitionedStorageRead != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.FencedUnpartitionedStorageRead, enum)
+	}
+
+	enum = PermissionsPolicyFeature.FocusWithoutUserActivation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"focus-without-user-activation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"focus-without-user-activation"`, result)
+	}
+	json.Unmarshal([]byte(`"focus-without-user-activation"`), &enum)
+	if PermissionsPolicyFeature.FocusWithoutUserActivation != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.FocusWithoutUserActivation, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Fullscreen
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fullscreen"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fullscreen"`, result)
+	}
+	json.Unmarshal([]byte(`"fullscreen"`), &enum)
+	if PermissionsPolicyFeature.Fullscreen != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Fullscreen, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Frobulate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"frobulate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"frobulate"`, result)
+	}
+	json.Unmarshal([]byte(`"frobulate"`), &enum)
+	if PermissionsPolicyFeature.Frobulate != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Frobulate, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Gamepad
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gamepad"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gamepad"`, result)
+	}
+	json.Unmarshal([]byte(`"gamepad"`), &enum)
+	if PermissionsPolicyFeature.Gamepad != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Gamepad, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Geolocation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"geolocation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"geolocation"`, result)
+	}
+	json.Unmarshal([]byte(`"geolocation"`), &enum)
+	if PermissionsPolicyFeature.Geolocation != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Geolocation, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Gyroscope
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gyroscope"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gyroscope"`, result)
+	}
+	json.Unmarshal([]byte(`"gyroscope"`), &enum)
+	if PermissionsPolicyFeature.Gyroscope != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Gyroscope, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Hid
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"hid"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"hid"`, result)
+	}
+	json.Unmarshal([]byte(`"hid"`), &enum)
+	if PermissionsPolicyFeature.Hid != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Hid, enum)
+	}
+
+	enum = PermissionsPolicyFeature.IdentityCredentialsGet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"identity-credentials-get"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"identity-credentials-get"`, result)
+	}
+	json.Unmarshal([]byte(`"identity-credentials-get"`), &enum)
+	if PermissionsPolicyFeature.IdentityCredentialsGet != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.IdentityCredentialsGet, enum)
+	}
+
+	enum = PermissionsPolicyFeature.IdleDetection
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"idle-detection"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"idle-detection"`, result)
+	}
+	json.Unmarshal([]byte(`"idle-detection"`), &enum)
+	if PermissionsPolicyFeature.IdleDetection != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.IdleDetection, enum)
+	}
+
+	enum = PermissionsPolicyFeature.InterestCohort
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"interest-cohort"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"interest-cohort"`, result)
+	}
+	json.Unmarshal([]byte(`"interest-cohort"`), &enum)
+	if PermissionsPolicyFeature.InterestCohort != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.InterestCohort, enum)
+	}
+
+	enum = PermissionsPolicyFeature.JoinAdInterestGroup
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"join-ad-interest-group"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"join-ad-interest-group"`, result)
+	}
+	json.Unmarshal([]byte(`"join-ad-interest-group"`), &enum)
+	if PermissionsPolicyFeature.JoinAdInterestGroup != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.JoinAdInterestGroup, enum)
+	}
+
+	enum = PermissionsPolicyFeature.KeyboardMap
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"keyboard-map"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"keyboard-map"`, result)
+	}
+	json.Unmarshal([]byte(`"keyboard-map"`), &enum)
+	if PermissionsPolicyFeature.KeyboardMap != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.KeyboardMap, enum)
+	}
+
+	enum = PermissionsPolicyFeature.LanguageDetector
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"language-detector"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"language-detector"`, result)
+	}
+	json.Unmarshal([]byte(`"language-detector"`), &enum)
+	if PermissionsPolicyFeature.LanguageDetector != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.LanguageDetector, enum)
+	}
+
+	enum = PermissionsPolicyFeature.LanguageModel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"language-model"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"language-model"`, result)
+	}
+	json.Unmarshal([]byte(`"language-model"`), &enum)
+	if PermissionsPolicyFeature.LanguageModel != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.LanguageModel, enum)
+	}
+
+	enum = PermissionsPolicyFeature.LocalFonts
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"local-fonts"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"local-fonts"`, result)
+	}
+	json.Unmarshal([]byte(`"local-fonts"`), &enum)
+	if PermissionsPolicyFeature.LocalFonts != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.LocalFonts, enum)
+	}
+
+	enum = PermissionsPolicyFeature.LocalNetworkAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"local-network-access"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"local-network-access"`, result)
+	}
+	json.Unmarshal([]byte(`"local-network-access"`), &enum)
+	if PermissionsPolicyFeature.LocalNetworkAccess != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.LocalNetworkAccess, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Magnetometer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"magnetometer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"magnetometer"`, result)
+	}
+	json.Unmarshal([]byte(`"magnetometer"`), &enum)
+	if PermissionsPolicyFeature.Magnetometer != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Magnetometer, enum)
+	}
+
+	enum = PermissionsPolicyFeature.MediaPlaybackWhileNotVisible
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"media-playback-while-not-visible"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"media-playback-while-not-visible"`, result)
+	}
+	json.Unmarshal([]byte(`"media-playback-while-not-visible"`), &enum)
+	if PermissionsPolicyFeature.MediaPlaybackWhileNotVisible != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.MediaPlaybackWhileNotVisible, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Microphone
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"microphone"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"microphone"`, result)
+	}
+	json.Unmarshal([]byte(`"microphone"`), &enum)
+	if PermissionsPolicyFeature.Microphone != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Microphone, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Midi
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"midi"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"midi"`, result)
+	}
+	json.Unmarshal([]byte(`"midi"`), &enum)
+	if PermissionsPolicyFeature.Midi != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Midi, enum)
+	}
+
+	enum = PermissionsPolicyFeature.OnDeviceSpeechRecognition
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"on-device-speech-recognition"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"on-device-speech-recognition"`, result)
+	}
+	json.Unmarshal([]byte(`"on-device-speech-recognition"`), &enum)
+	if PermissionsPolicyFeature.OnDeviceSpeechRecognition != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.OnDeviceSpeechRecognition, enum)
+	}
+
+	enum = PermissionsPolicyFeature.OtpCredentials
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"otp-credentials"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"otp-credentials"`, result)
+	}
+	json.Unmarshal([]byte(`"otp-credentials"`), &enum)
+	if PermissionsPolicyFeature.OtpCredentials != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.OtpCredentials, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Payment
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"payment"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"payment"`, result)
+	}
+	json.Unmarshal([]byte(`"payment"`), &enum)
+	if PermissionsPolicyFeature.Payment != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Payment, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PictureInPicture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"picture-in-picture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"picture-in-picture"`, result)
+	}
+	json.Unmarshal([]byte(`"picture-in-picture"`), &enum)
+	if PermissionsPolicyFeature.PictureInPicture != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PictureInPicture, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Popins
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"popins"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"popins"`, result)
+	}
+	json.Unmarshal([]byte(`"popins"`), &enum)
+	if PermissionsPolicyFeature.Popins != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Popins, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PrivateAggregation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"private-aggregation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"private-aggregation"`, result)
+	}
+	json.Unmarshal([]byte(`"private-aggregation"`), &enum)
+	if PermissionsPolicyFeature.PrivateAggregation != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PrivateAggregation, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PrivateStateTokenIssuance
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"private-state-token-issuance"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"private-state-token-issuance"`, result)
+	}
+	json.Unmarshal([]byte(`"private-state-token-issuance"`), &enum)
+	if PermissionsPolicyFeature.PrivateStateTokenIssuance != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PrivateStateTokenIssuance, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PrivateStateTokenRedemption
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"private-state-token-redemption"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"private-state-token-redemption"`, result)
+	}
+	json.Unmarshal([]byte(`"private-state-token-redemption"`), &enum)
+	if PermissionsPolicyFeature.PrivateStateTokenRedemption != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PrivateStateTokenRedemption, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PublickeyCredentialsCreate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"publickey-credentials-create"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"publickey-credentials-create"`, result)
+	}
+	json.Unmarshal([]byte(`"publickey-credentials-create"`), &enum)
+	if PermissionsPolicyFeature.PublickeyCredentialsCreate != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PublickeyCredentialsCreate, enum)
+	}
+
+	enum = PermissionsPolicyFeature.PublickeyCredentialsGet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"publickey-credentials-get"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"publickey-credentials-get"`, result)
+	}
+	json.Unmarshal([]byte(`"publickey-credentials-get"`), &enum)
+	if PermissionsPolicyFeature.PublickeyCredentialsGet != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.PublickeyCredentialsGet, enum)
+	}
+
+	enum = PermissionsPolicyFeature.RecordAdAuctionEvents
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"record-ad-auction-events"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"record-ad-auction-events"`, result)
+	}
+	json.Unmarshal([]byte(`"record-ad-auction-events"`), &enum)
+	if PermissionsPolicyFeature.RecordAdAuctionEvents != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.RecordAdAuctionEvents, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Rewriter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"rewriter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"rewriter"`, result)
+	}
+	json.Unmarshal([]byte(`"rewriter"`), &enum)
+	if PermissionsPolicyFeature.Rewriter != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Rewriter, enum)
+	}
+
+	enum = PermissionsPolicyFeature.RunAdAuction
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"run-ad-auction"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"run-ad-auction"`, result)
+	}
+	json.Unmarshal([]byte(`"run-ad-auction"`), &enum)
+	if PermissionsPolicyFeature.RunAdAuction != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.RunAdAuction, enum)
+	}
+
+	enum = PermissionsPolicyFeature.ScreenWakeLock
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"screen-wake-lock"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"screen-wake-lock"`, result)
+	}
+	json.Unmarshal([]byte(`"screen-wake-lock"`), &enum)
+	if PermissionsPolicyFeature.ScreenWakeLock != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.ScreenWakeLock, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Serial
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"serial"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"serial"`, result)
+	}
+	json.Unmarshal([]byte(`"serial"`), &enum)
+	if PermissionsPolicyFeature.Serial != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Serial, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SharedAutofill
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"shared-autofill"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"shared-autofill"`, result)
+	}
+	json.Unmarshal([]byte(`"shared-autofill"`), &enum)
+	if PermissionsPolicyFeature.SharedAutofill != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SharedAutofill, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SharedStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"shared-storage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"shared-storage"`, result)
+	}
+	json.Unmarshal([]byte(`"shared-storage"`), &enum)
+	if PermissionsPolicyFeature.SharedStorage != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SharedStorage, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SharedStorageSelectURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"shared-storage-select-url"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"shared-storage-select-url"`, result)
+	}
+	json.Unmarshal([]byte(`"shared-storage-select-url"`), &enum)
+	if PermissionsPolicyFeature.SharedStorageSelectURL != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SharedStorageSelectURL, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SmartCard
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"smart-card"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"smart-card"`, result)
+	}
+	json.Unmarshal([]byte(`"smart-card"`), &enum)
+	if PermissionsPolicyFeature.SmartCard != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SmartCard, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SpeakerSelection
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"speaker-selection"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"speaker-selection"`, result)
+	}
+	json.Unmarshal([]byte(`"speaker-selection"`), &enum)
+	if PermissionsPolicyFeature.SpeakerSelection != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SpeakerSelection, enum)
+	}
+
+	enum = PermissionsPolicyFeature.StorageAccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"storage-access"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"storage-access"`, result)
+	}
+	json.Unmarshal([]byte(`"storage-access"`), &enum)
+	if PermissionsPolicyFeature.StorageAccess != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.StorageAccess, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SubApps
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sub-apps"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sub-apps"`, result)
+	}
+	json.Unmarshal([]byte(`"sub-apps"`), &enum)
+	if PermissionsPolicyFeature.SubApps != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SubApps, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Summarizer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"summarizer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"summarizer"`, result)
+	}
+	json.Unmarshal([]byte(`"summarizer"`), &enum)
+	if PermissionsPolicyFeature.Summarizer != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Summarizer, enum)
+	}
+
+	enum = PermissionsPolicyFeature.SyncXHR
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sync-xhr"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sync-xhr"`, result)
+	}
+	json.Unmarshal([]byte(`"sync-xhr"`), &enum)
+	if PermissionsPolicyFeature.SyncXHR != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.SyncXHR, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Translator
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"translator"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"translator"`, result)
+	}
+	json.Unmarshal([]byte(`"translator"`), &enum)
+	if PermissionsPolicyFeature.Translator != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Translator, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Unload
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unload"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unload"`, result)
+	}
+	json.Unmarshal([]byte(`"unload"`), &enum)
+	if PermissionsPolicyFeature.Unload != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Unload, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Usb
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"usb"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"usb"`, result)
+	}
+	json.Unmarshal([]byte(`"usb"`), &enum)
+	if PermissionsPolicyFeature.Usb != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Usb, enum)
+	}
+
+	enum = PermissionsPolicyFeature.UsbUnrestricted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"usb-unrestricted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"usb-unrestricted"`, result)
+	}
+	json.Unmarshal([]byte(`"usb-unrestricted"`), &enum)
+	if PermissionsPolicyFeature.UsbUnrestricted != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.UsbUnrestricted, enum)
+	}
+
+	enum = PermissionsPolicyFeature.VerticalScroll
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"vertical-scroll"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"vertical-scroll"`, result)
+	}
+	json.Unmarshal([]byte(`"vertical-scroll"`), &enum)
+	if PermissionsPolicyFeature.VerticalScroll != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.VerticalScroll, enum)
+	}
+
+	enum = PermissionsPolicyFeature.WebAppInstallation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"web-app-installation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"web-app-installation"`, result)
+	}
+	json.Unmarshal([]byte(`"web-app-installation"`), &enum)
+	if PermissionsPolicyFeature.WebAppInstallation != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.WebAppInstallation, enum)
+	}
+
+	enum = PermissionsPolicyFeature.WebPrinting
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"web-printing"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"web-printing"`, result)
+	}
+	json.Unmarshal([]byte(`"web-printing"`), &enum)
+	if PermissionsPolicyFeature.WebPrinting != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.WebPrinting, enum)
+	}
+
+	enum = PermissionsPolicyFeature.WebShare
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"web-share"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"web-share"`, result)
+	}
+	json.Unmarshal([]byte(`"web-share"`), &enum)
+	if PermissionsPolicyFeature.WebShare != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.WebShare, enum)
+	}
+
+	enum = PermissionsPolicyFeature.WindowManagement
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"window-management"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"window-management"`, result)
+	}
+	json.Unmarshal([]byte(`"window-management"`), &enum)
+	if PermissionsPolicyFeature.WindowManagement != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.WindowManagement, enum)
+	}
+
+	enum = PermissionsPolicyFeature.Writer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"writer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"writer"`, result)
+	}
+	json.Unmarshal([]byte(`"writer"`), &enum)
+	if PermissionsPolicyFeature.Writer != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.Writer, enum)
+	}
+
+	enum = PermissionsPolicyFeature.XrSpatialTracking
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"xr-spatial-tracking"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"xr-spatial-tracking"`, result)
+	}
+	json.Unmarshal([]byte(`"xr-spatial-tracking"`), &enum)
+	if PermissionsPolicyFeature.XrSpatialTracking != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyFeature.XrSpatialTracking, enum)
+	}
+}
@@ -0,0 +1,130 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type reasonEnum struct {
+	AnchorClick            ReasonEnum
+	FormSubmissionGet      ReasonEnum
+	FormSubmissionPost     ReasonEnum
+	HTTPHeaderRefresh      ReasonEnum
+	InitialFrameNavigation ReasonEnum
+	MetaTagRefresh         ReasonEnum
+	Other                  ReasonEnum
+	PageBlockInterstitial  ReasonEnum
+	Reload                 ReasonEnum
+	ScriptInitiated        ReasonEnum
+}
+
+/*
+Reason provides named access to the ReasonEnum values.
+*/
+var Reason = reasonEnum{
+	AnchorClick:            reasonAnchorClick,
+	FormSubmissionGet:      reasonFormSubmissionGet,
+	FormSubmissionPost:     reasonFormSubmissionPost,
+	HTTPHeaderRefresh:      reasonHTTPHeaderRefresh,
+	InitialFrameNavigation: reasonInitialFrameNavigation,
+	MetaTagRefresh:         reasonMetaTagRefresh,
+	Other:                  reasonOther,
+	PageBlockInterstitial:  reasonPageBlockInterstitial,
+	Reload:                 reasonReload,
+	ScriptInitiated:        reasonScriptInitiated,
+}
+
+/*
+ReasonEnum represents the Page.ClientNavigationReason values.
+
+Allowed values:
+  - Reason.AnchorClick            "anchorClick"
+  - Reason.FormSubmissionGet      "formSubmissionGet"
+  - Reason.FormSubmissionPost     "formSubmissionPost"
+  - Reason.HTTPHeaderRefresh      "httpHeaderRefresh"
+  - Reason.InitialFrameNavigation "initialFrameNavigation"
+  - Reason.MetaTagRefresh         "metaTagRefresh"
+  - Reason.Other                  "other"
+  - Reason.PageBlockInterstitial  "pageBlockInterstitial"
+  - Reason.Reload                 "reload"
+  - Reason.ScriptInitiated        "scriptInitiated"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#type-ClientNavigationReason
+*/
+type ReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ReasonEnum) String() string {
+	return _reasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReasonEnum) Unknown() bool {
+	return !_reasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _reasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// reasonAnchorClick represents the "anchorClick" value.
+	reasonAnchorClick ReasonEnum = iota + 1
+	// reasonFormSubmissionGet represents the "formSubmissionGet" value.
+	reasonFormSubmissionGet
+	// reasonFormSubmissionPost represents the "formSubmissionPost" value.
+	reasonFormSubmissionPost
+	// reasonHTTPHeaderRefresh represents the "httpHeaderRefresh" value.
+	reasonHTTPHeaderRefresh
+	// reasonInitialFrameNavigation represents the "initialFrameNavigation" value.
+	reasonInitialFrameNavigation
+	// reasonMetaTagRefresh represents the "metaTagRefresh" value.
+	reasonMetaTagRefresh
+	// reasonOther represents the "other" value.
+	reasonOther
+	// reasonPageBlockInterstitial represents the "pageBlockInterstitial" value.
+	reasonPageBlockInterstitial
+	// reasonReload represents the "reload" value.
+	reasonReload
+	// reasonScriptInitiated represents the "scriptInitiated" value.
+	reasonScriptInitiated
+)
+
+var _reasonEnums = enums.New(map[ReasonEnum]string{
+	ReasonEnum(0):                "",
+	reasonAnchorClick:            "anchorClick",
+	reasonFormSubmissionGet:      "formSubmissionGet",
+	reasonFormSubmissionPost:     "formSubmissionPost",
+	reasonHTTPHeaderRefresh:      "httpHeaderRefresh",
+	reasonInitialFrameNavigation: "initialFrameNavigation",
+	reasonMetaTagRefresh:         "metaTagRefresh",
+	reasonOther:                  "other",
+	reasonPageBlockInterstitial:  "pageBlockInterstitial",
+	reasonReload:                 "reload",
+	reasonScriptInitiated:        "scriptInitiated",
+})
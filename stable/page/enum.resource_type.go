@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type resourceTypeEnum struct {
+	Document           ResourceTypeEnum
+	Stylesheet         ResourceTypeEnum
+	Image              ResourceTypeEnum
+	Media              ResourceTypeEnum
+	Font               ResourceTypeEnum
+	Script             ResourceTypeEnum
+	TextTrack          ResourceTypeEnum
+	XHR                ResourceTypeEnum
+	Fetch              ResourceTypeEnum
+	Prefetch           ResourceTypeEnum
+	EventSource        ResourceTypeEnum
+	WebSocket          ResourceTypeEnum
+	Manifest           ResourceTypeEnum
+	SignedExchange     ResourceTypeEnum
+	Ping               ResourceTypeEnum
+	CSPViolationReport ResourceTypeEnum
+	Preflight          ResourceTypeEnum
+	FedCM              ResourceTypeEnum
+	Other              ResourceTypeEnum
+}
+
+/*
+ResourceType provides named access to the ResourceTypeEnum values.
+*/
+var ResourceType = resourceTypeEnum{
+	Document:           resourceTypeDocument,
+	Stylesheet:         resourceTypeStylesheet,
+	Image:              resourceTypeImage,
+	Media:              resourceTypeMedia,
+	Font:               resourceTypeFont,
+	Script:             resourceTypeScript,
+	TextTrack:          resourceTypeTextTrack,
+	XHR:                resourceTypeXHR,
+	Fetch:              resourceTypeFetch,
+	Prefetch:           resourceTypePrefetch,
+	EventSource:        resourceTypeEventSource,
+	WebSocket:          resourceTypeWebSocket,
+	Manifest:           resourceTypeManifest,
+	SignedExchange:     resourceTypeSignedExchange,
+	Ping:               resourceTypePing,
+	CSPViolationReport: resourceTypeCSPViolationReport,
+	Preflight:          resourceTypePreflight,
+	FedCM:              resourceTypeFedCM,
+	Other:              resourceTypeOther,
+}
+
+/*
+ResourceTypeEnum represents the Network.ResourceType values.
+
+Resource type as it was perceived by the rendering engine.
+
+Allowed values:
+  - ResourceType.Document           "Document"
+  - ResourceType.Stylesheet         "Stylesheet"
+  - ResourceType.Image              "Image"
+  - ResourceType.Media              "Media"
+  - ResourceType.Font               "Font"
+  - ResourceType.Script             "Script"
+  - ResourceType.TextTrack          "TextTrack"
+  - ResourceType.XHR                "XHR"
+  - ResourceType.Fetch              "Fetch"
+  - ResourceType.Prefetch           "Prefetch"
+  - ResourceType.EventSource        "EventSource"
+  - ResourceType.WebSocket          "WebSocket"
+  - ResourceType.Manifest           "Manifest"
+  - ResourceType.SignedExchange     "SignedExchange"
+  - ResourceType.Ping               "Ping"
+  - ResourceType.CSPViolationReport "CSPViolationReport"
+  - ResourceType.Preflight          "Preflight"
+  - ResourceType.FedCM              "FedCM"
+  - ResourceType.Other              "Other"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-ResourceType
+*/
+type ResourceTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ResourceTypeEnum) String() string {
+	return _resourceTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ResourceTypeEnum) Unknown() bool {
+	return !_resourceTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ResourceTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ResourceTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _resourceTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// resourceTypeDocument represents the "Document" value.
+	resourceTypeDocument ResourceTypeEnum = iota + 1
+	// resourceTypeStylesheet represents the "Stylesheet" value.
+	resourceTypeStylesheet
+	// resourceTypeImage represents the "Image" value.
+	resourceTypeImage
+	// resourceTypeMedia represents the "Media" value.
+	resourceTypeMedia
+	// resourceTypeFont represents the "Font" value.
+	resourceTypeFont
+	// resourceTypeScript represents the "Script" value.
+	resourceTypeScript
+	// resourceTypeTextTrack represents the "TextTrack" value.
+	resourceTypeTextTrack
+	// resourceTypeXHR represents the "XHR" value.
+	resourceTypeXHR
+	// resourceTypeFetch represents the "Fetch" value.
+	resourceTypeFetch
+	// resourceTypePrefetch represents the "Prefetch" value.
+	resourceTypePrefetch
+	// resourceTypeEventSource represents the "EventSource" value.
+	resourceTypeEventSource
+	// resourceTypeWebSocket represents the "WebSocket" value.
+	resourceTypeWebSocket
+	// resourceTypeManifest represents the "Manifest" value.
+	resourceTypeManifest
+	// resourceTypeSignedExchange represents the "SignedExchange" value.
+	resourceTypeSignedExchange
+	// resourceTypePing represents the "Ping" value.
+	resourceTypePing
+	// resourceTypeCSPViolationReport represents the "CSPViolationReport" value.
+	resourceTypeCSPViolationReport
+	// resourceTypePreflight represents the "Preflight" value.
+	resourceTypePreflight
+	// resourceTypeFedCM represents the "FedCM" value.
+	resourceTypeFedCM
+	// resourceTypeOther represents the "Other" value.
+	resourceTypeOther
+)
+
+var _resourceTypeEnums = enums.New(map[ResourceTypeEnum]string{
+	ResourceTypeEnum(0):            "",
+	resourceTypeDocument:           "Document",
+	resourceTypeStylesheet:         "Stylesheet",
+	resourceTypeImage:              "Image",
+	resourceTypeMedia:              "Media",
+	resourceTypeFont:               "Font",
+	resourceTypeScript:             "Script",
+	resourceTypeTextTrack:          "TextTrack",
+	resourceTypeXHR:                "XHR",
+	resourceTypeFetch:              "Fetch",
+	resourceTypePrefetch:           "Prefetch",
+	resourceTypeEventSource:        "EventSource",
+	resourceTypeWebSocket:          "WebSocket",
+	resourceTypeManifest:           "Manifest",
+	resourceTypeSignedExchange:     "SignedExchange",
+	resourceTypePing:               "Ping",
+	resourceTypeCSPViolationReport: "CSPViolationReport",
+	resourceTypePreflight:          "Preflight",
+	resourceTypeFedCM:              "FedCM",
+	resourceTypeOther:              "Other",
+})
@@ -0,0 +1,290 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumResourceType(t *testing.T) {
+	var enum ResourceTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ResourceTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ResourceType.Document
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Document"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Document"`, result)
+	}
+	json.Unmarshal([]byte(`"Document"`), &enum)
+	if ResourceType.Document != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Document, enum)
+	}
+
+	enum = ResourceType.Stylesheet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Stylesheet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Stylesheet"`, result)
+	}
+	json.Unmarshal([]byte(`"Stylesheet"`), &enum)
+	if ResourceType.Stylesheet != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Stylesheet, enum)
+	}
+
+	enum = ResourceType.Image
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Image"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Image"`, result)
+	}
+	json.Unmarshal([]byte(`"Image"`), &enum)
+	if ResourceType.Image != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Image, enum)
+	}
+
+	enum = ResourceType.Media
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Media"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Media"`, result)
+	}
+	json.Unmarshal([]byte(`"Media"`), &enum)
+	if ResourceType.Media != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Media, enum)
+	}
+
+	enum = ResourceType.Font
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Font"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Font"`, result)
+	}
+	json.Unmarshal([]byte(`"Font"`), &enum)
+	if ResourceType.Font != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Font, enum)
+	}
+
+	enum = ResourceType.Script
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Script"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Script"`, result)
+	}
+	json.Unmarshal([]byte(`"Script"`), &enum)
+	if ResourceType.Script != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Script, enum)
+	}
+
+	enum = ResourceType.TextTrack
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TextTrack"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TextTrack"`, result)
+	}
+	json.Unmarshal([]byte(`"TextTrack"`), &enum)
+	if ResourceType.TextTrack != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.TextTrack, enum)
+	}
+
+	enum = ResourceType.XHR
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"XHR"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"XHR"`, result)
+	}
+	json.Unmarshal([]byte(`"XHR"`), &enum)
+	if ResourceType.XHR != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.XHR, enum)
+	}
+
+	enum = ResourceType.Fetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Fetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Fetch"`, result)
+	}
+	json.Unmarshal([]byte(`"Fetch"`), &enum)
+	if ResourceType.Fetch != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Fetch, enum)
+	}
+
+	enum = ResourceType.Prefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Prefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Prefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"Prefetch"`), &enum)
+	if ResourceType.Prefetch != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Prefetch, enum)
+	}
+
+	enum = ResourceType.EventSource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EventSource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EventSource"`, result)
+	}
+	json.Unmarshal([]byte(`"EventSource"`), &enum)
+	if ResourceType.EventSource != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.EventSource, enum)
+	}
+
+	enum = ResourceType.WebSocket
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebSocket"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebSocket"`, result)
+	}
+	json.Unmarshal([]byte(`"WebSocket"`), &enum)
+	if ResourceType.WebSocket != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.WebSocket, enum)
+	}
+
+	enum = ResourceType.Manifest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Manifest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Manifest"`, result)
+	}
+	json.Unmarshal([]byte(`"Manifest"`), &enum)
+	if ResourceType.Manifest != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Manifest, enum)
+	}
+
+	enum = ResourceType.SignedExchange
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignedExchange"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignedExchange"`, result)
+	}
+	json.Unmarshal([]byte(`"SignedExchange"`), &enum)
+	if ResourceType.SignedExchange != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.SignedExchange, enum)
+	}
+
+	enum = ResourceType.Ping
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ping"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ping"`, result)
+	}
+	json.Unmarshal([]byte(`"Ping"`), &enum)
+	if ResourceType.Ping != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Ping, enum)
+	}
+
+	enum = ResourceType.CSPViolationReport
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CSPViolationReport"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CSPViolationReport"`, result)
+	}
+	json.Unmarshal([]byte(`"CSPViolationReport"`), &enum)
+	if ResourceType.CSPViolationReport != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.CSPViolationReport, enum)
+	}
+
+	enum = ResourceType.Preflight
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Preflight"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Preflight"`, result)
+	}
+	json.Unmarshal([]byte(`"Preflight"`), &enum)
+	if ResourceType.Preflight != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Preflight, enum)
+	}
+
+	enum = ResourceType.FedCM
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FedCM"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FedCM"`, result)
+	}
+	json.Unmarshal([]byte(`"FedCM"`), &enum)
+	if ResourceType.FedCM != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.FedCM, enum)
+	}
+
+	enum = ResourceType.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Other"`, result)
+	}
+	json.Unmarshal([]byte(`"Other"`), &enum)
+	if ResourceType.Other != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Other, enum)
+	}
+}
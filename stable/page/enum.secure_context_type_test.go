@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSecureContextType(t *testing.T) {
+	var enum SecureContextTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SecureContextTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SecureContextType.Secure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Secure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Secure"`, result)
+	}
+	json.Unmarshal([]byte(`"Secure"`), &enum)
+	if SecureContextType.Secure != enum {
+		t.Errorf("Expected %d, got %d", SecureContextType.Secure, enum)
+	}
+
+	enum = SecureContextType.SecureLocalhost
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SecureLocalhost"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SecureLocalhost"`, result)
+	}
+	json.Unmarshal([]byte(`"SecureLocalhost"`), &enum)
+	if SecureContextType.SecureLocalhost != enum {
+		t.Errorf("Expected %d, got %d", SecureContextType.SecureLocalhost, enum)
+	}
+
+	enum = SecureContextType.InsecureScheme
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InsecureScheme"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InsecureScheme"`, result)
+	}
+	json.Unmarshal([]byte(`"InsecureScheme"`), &enum)
+	if SecureContextType.InsecureScheme != enum {
+		t.Errorf("Expected %d, got %d", SecureContextType.InsecureScheme, enum)
+	}
+
+	enum = SecureContextType.InsecureAncestor
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InsecureAncestor"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InsecureAncestor"`, result)
+	}
+	json.Unmarshal([]byte(`"InsecureAncestor"`), &enum)
+	if SecureContextType.InsecureAncestor != enum {
+		t.Errorf("Expected %d, got %d", SecureContextType.InsecureAncestor, enum)
+	}
+}
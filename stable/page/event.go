@@ -0,0 +1,202 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+DOMContentEventFiredEvent represents Page.domContentEventFired event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-domContentEventFired
+*/
+type DOMContentEventFiredEvent struct {
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FileChooserOpenedEvent represents Page.fileChooserOpened event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-fileChooserOpened
+*/
+type FileChooserOpenedEvent struct {
+	// Input mode. Allowed values:
+	//   - Mode.SelectSingle
+	//   - Mode.SelectMultiple
+	Mode ModeEnum `json:"mode"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FrameAttachedEvent represents Page.frameAttached event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameAttached
+*/
+type FrameAttachedEvent struct {
+	// Id of the frame that has been attached.
+	FrameID FrameID `json:"frameId"`
+
+	// Parent frame identifier.
+	ParentFrameID FrameID `json:"parentFrameId"`
+
+	// Optional. JavaScript stack trace of when frame was attached, only set if
+	// frame initiated from script.
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FrameDetachedEvent represents Page.frameDetached event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameDetached
+*/
+type FrameDetachedEvent struct {
+	// Id of the frame that has been detached.
+	FrameID FrameID `json:"frameId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FrameNavigatedEvent represents Page.frameNavigated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameNavigated
+*/
+type FrameNavigatedEvent struct {
+	// Frame object.
+	Frame *Frame `json:"frame"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InterstitialHiddenEvent represents Page.interstitialHidden event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialHidden
+*/
+type InterstitialHiddenEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InterstitialShownEvent represents Page.interstitialShown event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialShown
+*/
+type InterstitialShownEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+JavascriptDialogClosedEvent represents Page.javascriptDialogClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogClosed
+*/
+type JavascriptDialogClosedEvent struct {
+	// Whether dialog was confirmed.
+	Result bool `json:"result"`
+
+	// User input in case of prompt.
+	UserInput string `json:"userInput"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+JavascriptDialogOpeningEvent represents Page.javascriptDialogOpening event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogOpening
+*/
+type JavascriptDialogOpeningEvent struct {
+	// Frame url.
+	URL string `json:"url"`
+
+	// Message that will be displayed by the dialog.
+	Message string `json:"message"`
+
+	// Dialog type. Allowed values:
+	//   - DialogType.Alert
+	//   - DialogType.Confirm
+	//   - DialogType.Prompt
+	//   - DialogType.Beforeunload
+	Type DialogTypeEnum `json:"type"`
+
+	// True iff browser is capable showing or acting on the given dialog. When
+	// browser has no dialog handler for given target, calling alert while Page
+	// domain is engaged will stall the page execution. Execution can be resumed
+	// via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+
+	// Optional. Default dialog prompt.
+	DefaultPrompt string `json:"defaultPrompt,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+LifecycleEventEvent represents Page.lifecycleEvent event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-lifecycleEvent
+*/
+type LifecycleEventEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+
+	// Loader identifier. Empty string if the request is fetched from worker.
+	LoaderID LoaderID `json:"loaderId"`
+
+	Name string `json:"name"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+LoadEventFiredEvent represents Page.loadEventFired event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-loadEventFired
+*/
+type LoadEventFiredEvent struct {
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WindowOpenEvent represents Page.windowOpen event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-windowOpen
+*/
+type WindowOpenEvent struct {
+	// The URL for the new window.
+	URL string `json:"url"`
+
+	// Window name.
+	WindowName string `json:"windowName"`
+
+	// An array of enabled window features.
+	WindowFeatures []string `json:"windowFeatures"`
+
+	// Whether or not it was triggered by user gesture.
+	UserGesture bool `json:"userGesture"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
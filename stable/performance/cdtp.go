@@ -0,0 +1,23 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package performance provides type definitions for use with the Chrome Performance protocol
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/
+*/
+package performance
+
+/*
+Metric represents the Performance.Metric type.
+
+Run-time execution metric.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#type-Metric
+*/
+type Metric struct {
+	// Metric name.
+	Name string `json:"name"`
+
+	// Metric value.
+	Value float64 `json:"value"`
+}
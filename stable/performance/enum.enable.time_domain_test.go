@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package performance
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumTimeDomain(t *testing.T) {
+	var enum TimeDomainEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TimeDomainEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = TimeDomain.TimeTicks
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"timeTicks"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"timeTicks"`, result)
+	}
+	json.Unmarshal([]byte(`"timeTicks"`), &enum)
+	if TimeDomain.TimeTicks != enum {
+		t.Errorf("Expected %d, got %d", TimeDomain.TimeTicks, enum)
+	}
+
+	enum = TimeDomain.ThreadTicks
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"threadTicks"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"threadTicks"`, result)
+	}
+	json.Unmarshal([]byte(`"threadTicks"`), &enum)
+	if TimeDomain.ThreadTicks != enum {
+		t.Errorf("Expected %d, got %d", TimeDomain.ThreadTicks, enum)
+	}
+}
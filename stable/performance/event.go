@@ -0,0 +1,19 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package performance
+
+/*
+MetricsEvent represents Performance.metrics event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#event-metrics
+*/
+type MetricsEvent struct {
+	// Current values of the metrics.
+	Metrics []*Metric `json:"metrics"`
+
+	// Timestamp title.
+	Title string `json:"title"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
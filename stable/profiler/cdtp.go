@@ -0,0 +1,181 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package profiler provides type definitions for use with the Chrome Profiler protocol
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/
+*/
+package profiler
+
+import (
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+ProfileNode represents the Profiler.ProfileNode type.
+
+Profile node. Holds callsite information, execution statistics and child nodes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-ProfileNode
+*/
+type ProfileNode struct {
+	// Unique id of the node.
+	ID int `json:"id"`
+
+	// Function location.
+	CallFrame *runtime.CallFrame `json:"callFrame"`
+
+	// Optional. Number of samples where this node was on top of the call stack.
+	HitCount int `json:"hitCount,omitempty"`
+
+	// Optional. Child node ids.
+	Children []int `json:"children,omitempty"`
+
+	// Optional. The reason of being not optimized. The function may be deoptimized
+	// or marked as don't optimize.
+	DeoptReason string `json:"deoptReason,omitempty"`
+
+	// Optional. An array of source position ticks.
+	PositionTicks []*PositionTickInfo `json:"positionTicks,omitempty"`
+}
+
+/*
+Profile represents the Profiler.Profile type.
+
+Profile.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-Profile
+*/
+type Profile struct {
+	// The list of profile nodes. First item is the root node.
+	Nodes []*ProfileNode `json:"nodes"`
+
+	// Profiling start timestamp in microseconds.
+	StartTime float64 `json:"startTime"`
+
+	// Profiling end timestamp in microseconds.
+	EndTime float64 `json:"endTime"`
+
+	// Optional. Ids of samples top nodes.
+	Samples []int `json:"samples,omitempty"`
+
+	// Optional. Time intervals between adjacent samples in microseconds. The first
+	// delta is relative to the profile startTime.
+	TimeDeltas []int `json:"timeDeltas,omitempty"`
+}
+
+/*
+PositionTickInfo represents the Profiler.PositionTickInfo type.
+
+Specifies a number of samples attributed to a certain source position.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-PositionTickInfo
+*/
+type PositionTickInfo struct {
+	// Source line number (1-based).
+	Line int `json:"line"`
+
+	// Number of samples attributed to the source line.
+	Ticks int `json:"ticks"`
+}
+
+/*
+CoverageRange represents the Profiler.CoverageRange type.
+
+Coverage data for a source range.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-CoverageRange
+*/
+type CoverageRange struct {
+	// JavaScript script source offset for the range start.
+	StartOffset int `json:"startOffset"`
+
+	// JavaScript script source offset for the range end.
+	EndOffset int `json:"endOffset"`
+
+	// Collected execution count of the source range.
+	Count int `json:"count"`
+}
+
+/*
+FunctionCoverage represents the Profiler.FunctionCoverage type.
+
+Coverage data for a JavaScript function.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-FunctionCoverage
+*/
+type FunctionCoverage struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+
+	// Source ranges inside the function with coverage data.
+	Ranges []*CoverageRange `json:"ranges"`
+
+	// Whether coverage data for this function has block granularity.
+	IsBlockCoverage bool `json:"isBlockCoverage"`
+}
+
+/*
+ScriptCoverage represents the Profiler.ScriptCoverage type.
+
+Coverage data for a JavaScript script.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-ScriptCoverage
+*/
+type ScriptCoverage struct {
+	// JavaScript script id.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// JavaScript script name or url.
+	URL string `json:"url"`
+
+	// Functions contained in the script that has coverage data.
+	Functions []*FunctionCoverage `json:"functions"`
+}
+
+/*
+ScriptTypeProfile represents the Profiler.ScriptTypeProfile type.
+
+Is type profile data collected during runtime for a JavaScript script.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-ScriptTypeProfile
+*/
+type ScriptTypeProfile struct {
+	// JavaScript script ID.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// JavaScript script name or url.
+	URL string `json:"url"`
+
+	// Type profile entries for parameters and return values of the functions in
+	// the script.
+	Entries []*TypeProfileEntry `json:"entries"`
+}
+
+/*
+TypeObject represents the Profiler.TypeObject type.
+
+Describes a type collected during runtime. EXPERIMENTAL DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-TypeObject
+*/
+type TypeObject struct {
+	// Name of a type collected with type profiling.
+	Name string `json:"name"`
+}
+
+/*
+TypeProfileEntry represents the Profiler.TypeProfileEntry type.
+
+Is the source offset and types for a parameter or return value. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#type-TypeProfileEntry
+*/
+type TypeProfileEntry struct {
+	// Source offset of the parameter or end of function for return values.
+	Offset int `json:"offset"`
+
+	// The types for this parameter or return value.
+	Types []*TypeObject `json:"types"`
+}
@@ -0,0 +1,584 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package runtime provides type definitions for use with the Chrome Runtime protocol
+
+Runtime domain exposes JavaScript runtime by means of remote evaluation and
+mirror objects. Evaluation results are returned as mirror object that expose
+object type, string representation and unique identifier that can be used for
+further object reference. Original objects are maintained in memory unless they
+are either explicitly released or are released along with the other objects in
+their object group.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/
+*/
+package runtime
+
+/*
+ScriptID represents the Runtime.ScriptId type.
+
+Unique script identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ScriptId
+*/
+type ScriptID string
+
+/*
+SerializationOptions represents the Runtime.SerializationOptions type.
+
+Represents options for serialization. Overrides `generatePreview` and
+`returnByValue`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-SerializationOptions
+*/
+type SerializationOptions struct {
+	// Allowed values:
+	//   - Serialization.Deep
+	//   - Serialization.JSON
+	//   - Serialization.IDOnly
+	Serialization SerializationEnum `json:"serialization"`
+
+	// Optional. Deep serialization depth. Default is full depth. Respected only in
+	// `deep` serialization mode.
+	MaxDepth int `json:"maxDepth,omitempty"`
+
+	// Optional. Embedder-specific parameters. For example if connected to V8 in
+	// Chrome these control DOM serialization via `maxNodeDepth: integer` and
+	// `includeShadowTree: "none" | "open" | "all"`. Values can be only of type
+	// string or integer.
+	AdditionalParameters map[string]interface{} `json:"additionalParameters,omitempty"`
+}
+
+/*
+DeepSerializedValue represents the Runtime.DeepSerializedValue type.
+
+Represents deep serialized value.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-DeepSerializedValue
+*/
+type DeepSerializedValue struct {
+	// Allowed values:
+	//   - Type.Undefined
+	//   - Type.Null
+	//   - Type.String
+	//   - Type.Number
+	//   - Type.Boolean
+	//   - Type.Bigint
+	//   - Type.Regexp
+	//   - Type.Date
+	//   - Type.Symbol
+	//   - Type.Array
+	//   - Type.Object
+	//   - Type.Function
+	//   - Type.Map
+	//   - Type.Set
+	//   - Type.Weakmap
+	//   - Type.Weakset
+	//   - Type.Error
+	//   - Type.Proxy
+	//   - Type.Promise
+	//   - Type.Typedarray
+	//   - Type.Arraybuffer
+	//   - Type.Node
+	//   - Type.Window
+	//   - Type.Generator
+	Type TypeEnum `json:"type"`
+
+	// Optional.
+	Value interface{} `json:"value,omitempty"`
+
+	// Optional.
+	ObjectID string `json:"objectId,omitempty"`
+
+	// Optional. Set if value reference met more then once during serialization. In
+	// such case, value is provided only to one of the serialized values. Unique
+	// per value in the scope of one CDP call.
+	WeakLocalObjectReference int `json:"weakLocalObjectReference,omitempty"`
+}
+
+/*
+RemoteObjectID represents the Runtime.RemoteObjectId type.
+
+Unique object identifier.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-RemoteObjectId
+*/
+type RemoteObjectID string
+
+/*
+RemoteObject represents the Runtime.RemoteObject type.
+
+Mirror object referencing original JavaScript object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-RemoteObject
+*/
+type RemoteObject struct {
+	// Object type. Allowed values:
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
+	Type ObjectTypeEnum `json:"type"`
+
+	// Optional. Object subtype hint. Specified for `object` type values only.
+	// NOTE: If you change anything here, make sure to also update `subtype` in
+	// `ObjectPreview` and `PropertyPreview` below. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
+	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
+
+	// Optional. Object class (constructor) name. Specified for `object` type
+	// values only.
+	ClassName string `json:"className,omitempty"`
+
+	// Optional. Remote object value in case of primitive values or JSON values (if
+	// it was requested).
+	Value interface{} `json:"value,omitempty"`
+
+	// Optional. Primitive value which can not be JSON-stringified does not have
+	// `value`, but gets this property. Allowed values:
+	//   - UnserializableValue.Infinity
+	//   - UnserializableValue.NaN
+	//   - UnserializableValue.NegInfinity
+	//   - UnserializableValue.NegZero
+	UnserializableValue UnserializableValueEnum `json:"unserializableValue,omitempty"`
+
+	// Optional. String representation of the object.
+	Description string `json:"description,omitempty"`
+
+	// Optional. Unique object identifier (for non-primitive values).
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+CustomPreview represents the Runtime.CustomPreview type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-CustomPreview
+*/
+type CustomPreview struct {
+	// The JSON-stringified result of formatter.header(object, config) call. It
+	// contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+
+	// Optional. If formatter returns true as a result of formatter.hasBody call
+	// then bodyGetterId will contain RemoteObjectId for the function that returns
+	// result of formatter.body(object, config) call. The result value is json ML
+	// array.
+	BodyGetterID RemoteObjectID `json:"bodyGetterId,omitempty"`
+}
+
+/*
+ObjectPreview represents the Runtime.ObjectPreview type.
+
+Object containing abbreviated remote object value. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ObjectPreview
+*/
+type ObjectPreview struct {
+	// Object type. Allowed values:
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
+	Type ObjectTypeEnum `json:"type"`
+
+	// Optional. Object subtype hint. Specified for `object` type values only. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
+	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
+
+	// Optional. String representation of the object.
+	Description string `json:"description,omitempty"`
+
+	// True iff some of the properties or entries of the original object did not
+	// fit.
+	Overflow bool `json:"overflow"`
+
+	// List of the properties.
+	Properties []*PropertyPreview `json:"properties"`
+
+	// Optional. List of the entries. Specified for `map` and `set` subtype values
+	// only.
+	Entries []*EntryPreview `json:"entries,omitempty"`
+}
+
+/*
+PropertyPreview represents the Runtime.PropertyPreview type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-PropertyPreview
+*/
+type PropertyPreview struct {
+	// Property name.
+	Name string `json:"name"`
+
+	// Object type. Accessor means that the property itself is an accessor
+	// property. Allowed values:
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
+	Type ObjectTypeEnum `json:"type"`
+
+	// Optional. User-friendly property value string.
+	Value string `json:"value,omitempty"`
+
+	// Optional. Nested value preview.
+	ValuePreview *ObjectPreview `json:"valuePreview,omitempty"`
+
+	// Optional. Object subtype hint. Specified for `object` type values only. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
+	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
+}
+
+/*
+EntryPreview represents the Runtime.EntryPreview type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-EntryPreview
+*/
+type EntryPreview struct {
+	// Optional. Preview of the key. Specified for map-like collection entries.
+	Key *ObjectPreview `json:"key,omitempty"`
+
+	// Preview of the value.
+	Value *ObjectPreview `json:"value"`
+}
+
+/*
+PropertyDescriptor represents the Runtime.PropertyDescriptor type.
+
+Object property descriptor.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-PropertyDescriptor
+*/
+type PropertyDescriptor struct {
+	// Property name or symbol description.
+	Name string `json:"name"`
+
+	// Optional. The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+
+	// Optional. True if the value associated with the property may be changed
+	// (data descriptors only).
+	Writable bool `json:"writable,omitempty"`
+
+	// Optional. A function which serves as a getter for the property, or
+	// `undefined` if there is no getter (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+
+	// Optional. A function which serves as a setter for the property, or
+	// `undefined` if there is no setter (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+
+	// True if the type of this property descriptor may be changed and if the
+	// property may be deleted from the corresponding object.
+	Configurable bool `json:"configurable"`
+
+	// True if this property shows up during enumeration of the properties on the
+	// corresponding object.
+	Enumerable bool `json:"enumerable"`
+
+	// Optional. True if the result was thrown during the evaluation.
+	WasThrown bool `json:"wasThrown,omitempty"`
+
+	// Optional. True if the property is owned for the object.
+	IsOwn bool `json:"isOwn,omitempty"`
+
+	// Optional. Property symbol object, if the property is of the `symbol` type.
+	Symbol *RemoteObject `json:"symbol,omitempty"`
+}
+
+/*
+InternalPropertyDescriptor represents the Runtime.InternalPropertyDescriptor
+type.
+
+Object internal property descriptor. This property isn't normally visible in
+JavaScript code.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-InternalPropertyDescriptor
+*/
+type InternalPropertyDescriptor struct {
+	// Conventional property name.
+	Name string `json:"name"`
+
+	// Optional. The value associated with the property.
+	Value *RemoteObject `json:"value,omitempty"`
+}
+
+/*
+PrivatePropertyDescriptor represents the Runtime.PrivatePropertyDescriptor type.
+
+Object private field descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-PrivatePropertyDescriptor
+*/
+type PrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+
+	// Optional. The value associated with the private property.
+	Value *RemoteObject `json:"value,omitempty"`
+
+	// Optional. A function which serves as a getter for the private property, or
+	// `undefined` if there is no getter (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+
+	// Optional. A function which serves as a setter for the private property, or
+	// `undefined` if there is no setter (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+}
+
+/*
+CallArgument represents the Runtime.CallArgument type.
+
+Represents function call argument. Either remote object id `objectId`, primitive
+`value`, unserializable primitive value or neither of (for undefined) them
+should be specified.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-CallArgument
+*/
+type CallArgument struct {
+	// Optional. Primitive value or serializable javascript object.
+	Value interface{} `json:"value,omitempty"`
+
+	// Optional. Primitive value which can not be JSON-stringified. Allowed values:
+	//   - UnserializableValue.Infinity
+	//   - UnserializableValue.NaN
+	//   - UnserializableValue.NegInfinity
+	//   - UnserializableValue.NegZero
+	UnserializableValue UnserializableValueEnum `json:"unserializableValue,omitempty"`
+
+	// Optional. Remote object handle.
+	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+ExecutionContextID represents the Runtime.ExecutionContextId type.
+
+Id of an execution context.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ExecutionContextId
+*/
+type ExecutionContextID int
+
+/*
+ExecutionContextDescription represents the Runtime.ExecutionContextDescription
+type.
+
+Description of an isolated world.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ExecutionContextDescription
+*/
+type ExecutionContextDescription struct {
+	// Unique id of the execution context. It can be used to specify in which
+	// execution context script evaluation should be performed.
+	ID ExecutionContextID `json:"id"`
+
+	// Execution context origin.
+	Origin string `json:"origin"`
+
+	// Human readable name describing given context.
+	Name string `json:"name"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	AuxData map[string]interface{} `json:"auxData,omitempty"`
+}
+
+/*
+ExceptionDetails represents the Runtime.ExceptionDetails type.
+
+Detailed information about exception (or error) that was thrown during script
+compilation or execution.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ExceptionDetails
+*/
+type ExceptionDetails struct {
+	// Exception id.
+	ExceptionID int `json:"exceptionId"`
+
+	// Exception text, which should be used together with exception object when
+	// available.
+	Text string `json:"text"`
+
+	// Line number of the exception location (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Column number of the exception location (0-based).
+	ColumnNumber int `json:"columnNumber"`
+
+	// Optional. Script ID of the exception location.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
+
+	// Optional. URL of the exception location, to be used when the script was not
+	// reported.
+	URL string `json:"url,omitempty"`
+
+	// Optional. JavaScript stack trace if available.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+
+	// Optional. Exception object if available.
+	Exception *RemoteObject `json:"exception,omitempty"`
+
+	// Optional. Identifier of the context where exception happened.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+}
+
+/*
+Timestamp represents the Runtime.Timestamp type.
+
+Number of milliseconds since epoch.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-Timestamp
+*/
+type Timestamp float64
+
+/*
+TimeDelta represents the Runtime.TimeDelta type.
+
+Number of milliseconds.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-TimeDelta
+*/
+type TimeDelta float64
+
+/*
+CallFrame represents the Runtime.CallFrame type.
+
+Stack entry for runtime errors and assertions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-CallFrame
+*/
+type CallFrame struct {
+	// JavaScript function name.
+	FunctionName string `json:"functionName"`
+
+	// JavaScript script id.
+	ScriptID ScriptID `json:"scriptId"`
+
+	// JavaScript script name or url.
+	URL string `json:"url"`
+
+	// JavaScript script line number (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// JavaScript script column number (0-based).
+	ColumnNumber int `json:"columnNumber"`
+}
+
+/*
+StackTrace represents the Runtime.StackTrace type.
+
+Call frames for assertions or error messages.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-StackTrace
+*/
+type StackTrace struct {
+	// Optional. String label of this stack trace. For async traces this may be a
+	// name of the function that initiated the async call.
+	Description string `json:"description,omitempty"`
+
+	// JavaScript function name.
+	CallFrames []*CallFrame `json:"callFrames"`
+
+	// Optional. Asynchronous JavaScript stack trace that preceded this stack, if
+	// available.
+	Parent *StackTrace `json:"parent,omitempty"`
+}
+
+/*
+UniqueDebuggerID represents the Runtime.UniqueDebuggerId type.
+
+Unique identifier of current debugger. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-UniqueDebuggerId
+*/
+type UniqueDebuggerID string
+
+/*
+StackTraceID represents the Runtime.StackTraceId type.
+
+If `debuggerId` is set stack trace comes from another debugger and can be
+resolved there. This allows to track cross-debugger calls. See
+`Runtime.StackTrace` and `Debugger.paused` for usages. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-StackTraceId
+*/
+type StackTraceID struct {
+	ID string `json:"id"`
+
+	// Optional.
+	DebuggerID UniqueDebuggerID `json:"debuggerId,omitempty"`
+}
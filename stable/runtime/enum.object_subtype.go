@@ -0,0 +1,190 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type objectSubtypeEnum struct {
+	Array             ObjectSubtypeEnum
+	Null              ObjectSubtypeEnum
+	Node              ObjectSubtypeEnum
+	Regexp            ObjectSubtypeEnum
+	Date              ObjectSubtypeEnum
+	Map               ObjectSubtypeEnum
+	Set               ObjectSubtypeEnum
+	Weakmap           ObjectSubtypeEnum
+	Weakset           ObjectSubtypeEnum
+	Iterator          ObjectSubtypeEnum
+	Generator         ObjectSubtypeEnum
+	Error             ObjectSubtypeEnum
+	Proxy             ObjectSubtypeEnum
+	Promise           ObjectSubtypeEnum
+	Typedarray        ObjectSubtypeEnum
+	Arraybuffer       ObjectSubtypeEnum
+	Dataview          ObjectSubtypeEnum
+	Webassemblymemory ObjectSubtypeEnum
+	Wasmvalue         ObjectSubtypeEnum
+}
+
+/*
+ObjectSubtype provides named access to the ObjectSubtypeEnum values.
+*/
+var ObjectSubtype = objectSubtypeEnum{
+	Array:             objectSubtypeArray,
+	Null:              objectSubtypeNull,
+	Node:              objectSubtypeNode,
+	Regexp:            objectSubtypeRegexp,
+	Date:              objectSubtypeDate,
+	Map:               objectSubtypeMap,
+	Set:               objectSubtypeSet,
+	Weakmap:           objectSubtypeWeakmap,
+	Weakset:           objectSubtypeWeakset,
+	Iterator:          objectSubtypeIterator,
+	Generator:         objectSubtypeGenerator,
+	Error:             objectSubtypeError,
+	Proxy:             objectSubtypeProxy,
+	Promise:           objectSubtypePromise,
+	Typedarray:        objectSubtypeTypedarray,
+	Arraybuffer:       objectSubtypeArraybuffer,
+	Dataview:          objectSubtypeDataview,
+	Webassemblymemory: objectSubtypeWebassemblymemory,
+	Wasmvalue:         objectSubtypeWasmvalue,
+}
+
+/*
+ObjectSubtypeEnum represents the Runtime.RemoteObject.subtype values.
+
+Object subtype hint. Specified for `object` type values only. NOTE: If you
+change anything here, make sure to also update `subtype` in `ObjectPreview` and
+`PropertyPreview` below.
+
+Allowed values:
+  - ObjectSubtype.Array             "array"
+  - ObjectSubtype.Null              "null"
+  - ObjectSubtype.Node              "node"
+  - ObjectSubtype.Regexp            "regexp"
+  - ObjectSubtype.Date              "date"
+  - ObjectSubtype.Map               "map"
+  - ObjectSubtype.Set               "set"
+  - ObjectSubtype.Weakmap           "weakmap"
+  - ObjectSubtype.Weakset           "weakset"
+  - ObjectSubtype.Iterator          "iterator"
+  - ObjectSubtype.Generator         "generator"
+  - ObjectSubtype.Error             "error"
+  - ObjectSubtype.Proxy             "proxy"
+  - ObjectSubtype.Promise           "promise"
+  - ObjectSubtype.Typedarray        "typedarray"
+  - ObjectSubtype.Arraybuffer       "arraybuffer"
+  - ObjectSubtype.Dataview          "dataview"
+  - ObjectSubtype.Webassemblymemory "webassemblymemory"
+  - ObjectSubtype.Wasmvalue         "wasmvalue"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-RemoteObject
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ObjectPreview
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-PropertyPreview
+*/
+type ObjectSubtypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ObjectSubtypeEnum) String() string {
+	return _objectSubtypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ObjectSubtypeEnum) Unknown() bool {
+	return !_objectSubtypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ObjectSubtypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ObjectSubtypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _objectSubtypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// objectSubtypeArray represents the "array" value.
+	objectSubtypeArray ObjectSubtypeEnum = iota + 1
+	// objectSubtypeNull represents the "null" value.
+	objectSubtypeNull
+	// objectSubtypeNode represents the "node" value.
+	objectSubtypeNode
+	// objectSubtypeRegexp represents the "regexp" value.
+	objectSubtypeRegexp
+	// objectSubtypeDate represents the "date" value.
+	objectSubtypeDate
+	// objectSubtypeMap represents the "map" value.
+	objectSubtypeMap
+	// objectSubtypeSet represents the "set" value.
+	objectSubtypeSet
+	// objectSubtypeWeakmap represents the "weakmap" value.
+	objectSubtypeWeakmap
+	// objectSubtypeWeakset represents the "weakset" value.
+	objectSubtypeWeakset
+	// objectSubtypeIterator represents the "iterator" value.
+	objectSubtypeIterator
+	// objectSubtypeGenerator represents the "generator" value.
+	objectSubtypeGenerator
+	// objectSubtypeError represents the "error" value.
+	objectSubtypeError
+	// objectSubtypeProxy represents the "proxy" value.
+	objectSubtypeProxy
+	// objectSubtypePromise represents the "promise" value.
+	objectSubtypePromise
+	// objectSubtypeTypedarray represents the "typedarray" value.
+	objectSubtypeTypedarray
+	// objectSubtypeArraybuffer represents the "arraybuffer" value.
+	objectSubtypeArraybuffer
+	// objectSubtypeDataview represents the "dataview" value.
+	objectSubtypeDataview
+	// objectSubtypeWebassemblymemory represents the "webassemblymemory" value.
+	objectSubtypeWebassemblymemory
+	// objectSubtypeWasmvalue represents the "wasmvalue" value.
+	objectSubtypeWasmvalue
+)
+
+var _objectSubtypeEnums = enums.New(map[ObjectSubtypeEnum]string{
+	ObjectSubtypeEnum(0):           "",
+	objectSubtypeArray:             "array",
+	objectSubtypeNull:              "null",
+	objectSubtypeNode:              "node",
+	objectSubtypeRegexp:            "regexp",
+	objectSubtypeDate:              "date",
+	objectSubtypeMap:               "map",
+	objectSubtypeSet:               "set",
+	objectSubtypeWeakmap:           "weakmap",
+	objectSubtypeWeakset:           "weakset",
+	objectSubtypeIterator:          "iterator",
+	objectSubtypeGenerator:         "generator",
+	objectSubtypeError:             "error",
+	objectSubtypeProxy:             "proxy",
+	objectSubtypePromise:           "promise",
+	objectSubtypeTypedarray:        "typedarray",
+	objectSubtypeArraybuffer:       "arraybuffer",
+	objectSubtypeDataview:          "dataview",
+	objectSubtypeWebassemblymemory: "webassemblymemory",
+	objectSubtypeWasmvalue:         "wasmvalue",
+})
@@ -0,0 +1,128 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type objectTypeEnum struct {
+	Object    ObjectTypeEnum
+	Function  ObjectTypeEnum
+	Undefined ObjectTypeEnum
+	String    ObjectTypeEnum
+	Number    ObjectTypeEnum
+	Boolean   ObjectTypeEnum
+	Symbol    ObjectTypeEnum
+	Bigint    ObjectTypeEnum
+	Accessor  ObjectTypeEnum
+}
+
+/*
+ObjectType provides named access to the ObjectTypeEnum values.
+*/
+var ObjectType = objectTypeEnum{
+	Object:    objectTypeObject,
+	Function:  objectTypeFunction,
+	Undefined: objectTypeUndefined,
+	String:    objectTypeString,
+	Number:    objectTypeNumber,
+	Boolean:   objectTypeBoolean,
+	Symbol:    objectTypeSymbol,
+	Bigint:    objectTypeBigint,
+	Accessor:  objectTypeAccessor,
+}
+
+/*
+ObjectTypeEnum represents the Runtime.RemoteObject.type values.
+
+Object type.
+
+Allowed values:
+  - ObjectType.Object    "object"
+  - ObjectType.Function  "function"
+  - ObjectType.Undefined "undefined"
+  - ObjectType.String    "string"
+  - ObjectType.Number    "number"
+  - ObjectType.Boolean   "boolean"
+  - ObjectType.Symbol    "symbol"
+  - ObjectType.Bigint    "bigint"
+  - ObjectType.Accessor  "accessor"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-RemoteObject
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-ObjectPreview
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-PropertyPreview
+*/
+type ObjectTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ObjectTypeEnum) String() string {
+	return _objectTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ObjectTypeEnum) Unknown() bool {
+	return !_objectTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ObjectTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ObjectTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _objectTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// objectTypeObject represents the "object" value.
+	objectTypeObject ObjectTypeEnum = iota + 1
+	// objectTypeFunction represents the "function" value.
+	objectTypeFunction
+	// objectTypeUndefined represents the "undefined" value.
+	objectTypeUndefined
+	// objectTypeString represents the "string" value.
+	objectTypeString
+	// objectTypeNumber represents the "number" value.
+	objectTypeNumber
+	// objectTypeBoolean represents the "boolean" value.
+	objectTypeBoolean
+	// objectTypeSymbol represents the "symbol" value.
+	objectTypeSymbol
+	// objectTypeBigint represents the "bigint" value.
+	objectTypeBigint
+	// objectTypeAccessor represents the "accessor" value.
+	objectTypeAccessor
+)
+
+var _objectTypeEnums = enums.New(map[ObjectTypeEnum]string{
+	ObjectTypeEnum(0):   "",
+	objectTypeObject:    "object",
+	objectTypeFunction:  "function",
+	objectTypeUndefined: "undefined",
+	objectTypeString:    "string",
+	objectTypeNumber:    "number",
+	objectTypeBoolean:   "boolean",
+	objectTypeSymbol:    "symbol",
+	objectTypeBigint:    "bigint",
+	objectTypeAccessor:  "accessor",
+})
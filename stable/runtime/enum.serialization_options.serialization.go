@@ -0,0 +1,89 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type serializationEnum struct {
+	Deep   SerializationEnum
+	JSON   SerializationEnum
+	IDOnly SerializationEnum
+}
+
+/*
+Serialization provides named access to the SerializationEnum values.
+*/
+var Serialization = serializationEnum{
+	Deep:   serializationDeep,
+	JSON:   serializationJSON,
+	IDOnly: serializationIDOnly,
+}
+
+/*
+SerializationEnum represents the Runtime.SerializationOptions.serialization
+values.
+
+Allowed values:
+  - Serialization.Deep   "deep"
+  - Serialization.JSON   "json"
+  - Serialization.IDOnly "idOnly"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-SerializationOptions
+*/
+type SerializationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SerializationEnum) String() string {
+	return _serializationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SerializationEnum) Unknown() bool {
+	return !_serializationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SerializationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SerializationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _serializationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// serializationDeep represents the "deep" value.
+	serializationDeep SerializationEnum = iota + 1
+	// serializationJSON represents the "json" value.
+	serializationJSON
+	// serializationIDOnly represents the "idOnly" value.
+	serializationIDOnly
+)
+
+var _serializationEnums = enums.New(map[SerializationEnum]string{
+	SerializationEnum(0): "",
+	serializationDeep:    "deep",
+	serializationJSON:    "json",
+	serializationIDOnly:  "idOnly",
+})
@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type unserializableValueEnum struct {
+	Infinity    UnserializableValueEnum
+	NaN         UnserializableValueEnum
+	NegInfinity UnserializableValueEnum
+	NegZero     UnserializableValueEnum
+}
+
+/*
+UnserializableValue provides named access to the UnserializableValueEnum values.
+*/
+var UnserializableValue = unserializableValueEnum{
+	Infinity:    unserializableValueInfinity,
+	NaN:         unserializableValueNaN,
+	NegInfinity: unserializableValueNegInfinity,
+	NegZero:     unserializableValueNegZero,
+}
+
+/*
+UnserializableValueEnum represents the Runtime.UnserializableValue values.
+
+Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`,
+`Infinity`, `-Infinity`, and bigint literals.
+
+Allowed values:
+  - UnserializableValue.Infinity    "Infinity"
+  - UnserializableValue.NaN         "NaN"
+  - UnserializableValue.NegInfinity "-Infinity"
+  - UnserializableValue.NegZero     "-0"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#type-UnserializableValue
+*/
+type UnserializableValueEnum int
+
+/*
+String implements Stringer
+*/
+func (enum UnserializableValueEnum) String() string {
+	return _unserializableValueEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum UnserializableValueEnum) Unknown() bool {
+	return !_unserializableValueEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum UnserializableValueEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *UnserializableValueEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _unserializableValueEnums.Parse(val)
+	return nil
+}
+
+const (
+	// unserializableValueInfinity represents the "Infinity" value.
+	unserializableValueInfinity UnserializableValueEnum = iota + 1
+	// unserializableValueNaN represents the "NaN" value.
+	unserializableValueNaN
+	// unserializableValueNegInfinity represents the "-Infinity" value.
+	unserializableValueNegInfinity
+	// unserializableValueNegZero represents the "-0" value.
+	unserializableValueNegZero
+)
+
+var _unserializableValueEnums = enums.New(map[UnserializableValueEnum]string{
+	UnserializableValueEnum(0):     "",
+	unserializableValueInfinity:    "Infinity",
+	unserializableValueNaN:         "NaN",
+	unserializableValueNegInfinity: "-Infinity",
+	unserializableValueNegZero:     "-0",
+})
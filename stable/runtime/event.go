@@ -0,0 +1,127 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+/*
+ConsoleAPICalledEvent represents Runtime.consoleAPICalled event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-consoleAPICalled
+*/
+type ConsoleAPICalledEvent struct {
+	// Type of the call. Allowed values:
+	//   - CallType.Log
+	//   - CallType.Debug
+	//   - CallType.Info
+	//   - CallType.Error
+	//   - CallType.Warning
+	//   - CallType.Dir
+	//   - CallType.Dirxml
+	//   - CallType.Table
+	//   - CallType.Trace
+	//   - CallType.Clear
+	//   - CallType.StartGroup
+	//   - CallType.StartGroupCollapsed
+	//   - CallType.EndGroup
+	//   - CallType.Assert
+	//   - CallType.Profile
+	//   - CallType.ProfileEnd
+	//   - CallType.Count
+	//   - CallType.TimeEnd
+	Type CallTypeEnum `json:"type"`
+
+	// Call arguments.
+	Args []*RemoteObject `json:"args"`
+
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+
+	// Call timestamp.
+	Timestamp Timestamp `json:"timestamp"`
+
+	// Optional. Stack trace captured when the call was made. The async stack chain
+	// is automatically reported for the following call types: `assert`, `error`,
+	// `trace`, `warning`. For other types the async call chain can be retrieved
+	// using `Debugger.getStackTrace` and `stackTrace.parentId` field.
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ExceptionRevokedEvent represents Runtime.exceptionRevoked event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-exceptionRevoked
+*/
+type ExceptionRevokedEvent struct {
+	// Reason describing why exception was revoked.
+	Reason string `json:"reason"`
+
+	// The id of revoked exception, as reported in `exceptionThrown`.
+	ExceptionID int `json:"exceptionId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ExceptionThrownEvent represents Runtime.exceptionThrown event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-exceptionThrown
+*/
+type ExceptionThrownEvent struct {
+	// Timestamp of the exception.
+	Timestamp Timestamp `json:"timestamp"`
+
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ExecutionContextCreatedEvent represents Runtime.executionContextCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextCreated
+*/
+type ExecutionContextCreatedEvent struct {
+	// A newly created execution context.
+	Context *ExecutionContextDescription `json:"context"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ExecutionContextDestroyedEvent represents Runtime.executionContextDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextDestroyed
+*/
+type ExecutionContextDestroyedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ExecutionContextsClearedEvent represents Runtime.executionContextsCleared event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextsCleared
+*/
+type ExecutionContextsClearedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InspectRequestedEvent represents Runtime.inspectRequested event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-inspectRequested
+*/
+type InspectRequestedEvent struct {
+	Object *RemoteObject `json:"object"`
+
+	Hints map[string]interface{} `json:"hints"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
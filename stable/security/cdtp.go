@@ -0,0 +1,221 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package security provides type definitions for use with the Chrome Security protocol
+
+# Security
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/
+*/
+package security
+
+/*
+TimeSinceEpoch represents the Network.TimeSinceEpoch type. This is a duplicate
+of Network.TimeSinceEpoch to avoid an invalid import cycle.
+
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+CertificateID represents the Security.CertificateId type.
+
+An internal certificate ID value.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-CertificateId
+*/
+type CertificateID int
+
+/*
+CertificateSecurityState represents the Security.CertificateSecurityState type.
+
+Details about the security state of the page certificate. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-CertificateSecurityState
+*/
+type CertificateSecurityState struct {
+	// Protocol name (e.g. "TLS 1.2" or "QUIC").
+	Protocol string `json:"protocol"`
+
+	// Key Exchange used by the connection, or the empty string if not applicable.
+	KeyExchange string `json:"keyExchange"`
+
+	// Optional. (EC)DH group used by the connection, if applicable.
+	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+
+	// Cipher name.
+	Cipher string `json:"cipher"`
+
+	// Optional. TLS MAC. Note that AEAD ciphers do not have separate MACs.
+	Mac string `json:"mac,omitempty"`
+
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+
+	// Certificate subject name.
+	SubjectName string `json:"subjectName"`
+
+	// Name of the issuing CA.
+	Issuer string `json:"issuer"`
+
+	// Certificate valid from date.
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+
+	// Certificate valid to (expiration) date
+	ValidTo TimeSinceEpoch `json:"validTo"`
+
+	// Optional. The highest priority network error code, if the certificate has an
+	// error.
+	CertificateNetworkError string `json:"certificateNetworkError,omitempty"`
+
+	// True if the certificate uses a weak signature algorithm.
+	CertificateHasWeakSignature bool `json:"certificateHasWeakSignature"`
+
+	// True if the certificate has a SHA1 signature in the chain.
+	CertificateHasSha1Signature bool `json:"certificateHasSha1Signature"`
+
+	// True if modern SSL
+	ModernSSL bool `json:"modernSSL"`
+
+	// True if the connection is using an obsolete SSL protocol.
+	ObsoleteSSLProtocol bool `json:"obsoleteSslProtocol"`
+
+	// True if the connection is using an obsolete SSL key exchange.
+	ObsoleteSSLKeyExchange bool `json:"obsoleteSslKeyExchange"`
+
+	// True if the connection is using an obsolete SSL cipher.
+	ObsoleteSSLCipher bool `json:"obsoleteSslCipher"`
+
+	// True if the connection is using an obsolete SSL signature.
+	ObsoleteSSLSignature bool `json:"obsoleteSslSignature"`
+}
+
+/*
+SafetyTipInfo represents the Security.SafetyTipInfo type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-SafetyTipInfo
+*/
+type SafetyTipInfo struct {
+	// Describes whether the page triggers any safety tips or reputation warnings.
+	// Default is unknown. Allowed values:
+	//   - SafetyTipStatus.BadReputation
+	//   - SafetyTipStatus.Lookalike
+	SafetyTipStatus SafetyTipStatusEnum `json:"safetyTipStatus"`
+
+	// Optional. The URL the safety tip suggested ("Did you mean?"). Only filled in
+	// for lookalike matches.
+	SafeURL string `json:"safeUrl,omitempty"`
+}
+
+/*
+VisibleSecurityState represents the Security.VisibleSecurityState type.
+
+Security state information about the page. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-VisibleSecurityState
+*/
+type VisibleSecurityState struct {
+	// The security level of the page. Allowed values:
+	//   - State.Unknown
+	//   - State.Neutral
+	//   - State.Insecure
+	//   - State.Secure
+	//   - State.Info
+	//   - State.InsecureBroken
+	SecurityState StateEnum `json:"securityState"`
+
+	// Optional. Security state details about the page certificate.
+	CertificateSecurityState *CertificateSecurityState `json:"certificateSecurityState,omitempty"`
+
+	// Optional. The type of Safety Tip triggered on the page. Note that this field
+	// will be set even if the Safety Tip UI was not actually shown.
+	SafetyTipInfo *SafetyTipInfo `json:"safetyTipInfo,omitempty"`
+
+	// Array of security state issues ids.
+	SecurityStateIssueIDs []string `json:"securityStateIssueIds"`
+}
+
+/*
+StateExplanation represents the Security.SecurityStateExplanation type.
+
+An explanation of an factor contributing to the security state.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-SecurityStateExplanation
+*/
+type StateExplanation struct {
+	// Security state representing the severity of the factor being explained. Allowed values:
+	//   - State.Unknown
+	//   - State.Neutral
+	//   - State.Insecure
+	//   - State.Secure
+	//   - State.Info
+	//   - State.InsecureBroken
+	State StateEnum `json:"securityState"`
+
+	// Title describing the type of factor.
+	Title string `json:"title"`
+
+	// Short phrase describing the type of factor.
+	Summary string `json:"summary"`
+
+	// Full text explanation of the factor.
+	Description string `json:"description"`
+
+	// The type of mixed content described by the explanation. Allowed values:
+	//   - MixedContentType.Blockable
+	//   - MixedContentType.OptionallyBlockable
+	//   - MixedContentType.None
+	MixedContentType MixedContentTypeEnum `json:"mixedContentType"`
+
+	// Page certificate.
+	Certificate []string `json:"certificate"`
+
+	// Optional. Recommendations to fix any issues.
+	Recommendations []string `json:"recommendations,omitempty"`
+}
+
+/*
+InsecureContentStatus represents the Security.InsecureContentStatus type.
+
+Information about insecure content on the page. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-InsecureContentStatus
+*/
+type InsecureContentStatus struct {
+	// Always false.
+	RanMixedContent bool `json:"ranMixedContent"`
+
+	// Always false.
+	DisplayedMixedContent bool `json:"displayedMixedContent"`
+
+	// Always false.
+	ContainedMixedForm bool `json:"containedMixedForm"`
+
+	// Always false.
+	RanContentWithCertErrors bool `json:"ranContentWithCertErrors"`
+
+	// Always false.
+	DisplayedContentWithCertErrors bool `json:"displayedContentWithCertErrors"`
+
+	// Always set to unknown. Allowed values:
+	//   - State.Unknown
+	//   - State.Neutral
+	//   - State.Insecure
+	//   - State.Secure
+	//   - State.Info
+	//   - State.InsecureBroken
+	RanInsecureContentStyle StateEnum `json:"ranInsecureContentStyle"`
+
+	// Always set to unknown. Allowed values:
+	//   - State.Unknown
+	//   - State.Neutral
+	//   - State.Insecure
+	//   - State.Secure
+	//   - State.Info
+	//   - State.InsecureBroken
+	DisplayedInsecureContentStyle StateEnum `json:"displayedInsecureContentStyle"`
+}
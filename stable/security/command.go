@@ -0,0 +1,43 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+/*
+DisableResult represents the result of calls to Security.disable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Security.enable.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetIgnoreCertificateErrorsParams represents Security.setIgnoreCertificateErrors parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-setIgnoreCertificateErrors
+*/
+type SetIgnoreCertificateErrorsParams struct {
+	// If true, all certificate errors will be ignored.
+	Ignore bool `json:"ignore"`
+}
+
+/*
+SetIgnoreCertificateErrorsResult represents the result of calls to Security.setIgnoreCertificateErrors.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-setIgnoreCertificateErrors
+*/
+type SetIgnoreCertificateErrorsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,86 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type certificateErrorActionEnum struct {
+	Continue CertificateErrorActionEnum
+	Cancel   CertificateErrorActionEnum
+}
+
+/*
+CertificateErrorAction provides named access to the CertificateErrorActionEnum values.
+*/
+var CertificateErrorAction = certificateErrorActionEnum{
+	Continue: certificateErrorActionContinue,
+	Cancel:   certificateErrorActionCancel,
+}
+
+/*
+CertificateErrorActionEnum represents the Security.CertificateErrorAction
+values.
+
+The action to take when a certificate error occurs. continue will continue
+processing the request and cancel will cancel the request.
+
+Allowed values:
+  - CertificateErrorAction.Continue "continue"
+  - CertificateErrorAction.Cancel   "cancel"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-CertificateErrorAction
+*/
+type CertificateErrorActionEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CertificateErrorActionEnum) String() string {
+	return _certificateErrorActionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CertificateErrorActionEnum) Unknown() bool {
+	return !_certificateErrorActionEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CertificateErrorActionEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CertificateErrorActionEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _certificateErrorActionEnums.Parse(val)
+	return nil
+}
+
+const (
+	// certificateErrorActionContinue represents the "continue" value.
+	certificateErrorActionContinue CertificateErrorActionEnum = iota + 1
+	// certificateErrorActionCancel represents the "cancel" value.
+	certificateErrorActionCancel
+)
+
+var _certificateErrorActionEnums = enums.New(map[CertificateErrorActionEnum]string{
+	CertificateErrorActionEnum(0):  "",
+	certificateErrorActionContinue: "continue",
+	certificateErrorActionCancel:   "cancel",
+})
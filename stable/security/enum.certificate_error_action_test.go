@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCertificateErrorAction(t *testing.T) {
+	var enum CertificateErrorActionEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CertificateErrorActionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CertificateErrorAction.Continue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"continue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"continue"`, result)
+	}
+	json.Unmarshal([]byte(`"continue"`), &enum)
+	if CertificateErrorAction.Continue != enum {
+		t.Errorf("Expected %d, got %d", CertificateErrorAction.Continue, enum)
+	}
+
+	enum = CertificateErrorAction.Cancel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cancel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cancel"`, result)
+	}
+	json.Unmarshal([]byte(`"cancel"`), &enum)
+	if CertificateErrorAction.Cancel != enum {
+		t.Errorf("Expected %d, got %d", CertificateErrorAction.Cancel, enum)
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type mixedContentTypeEnum struct {
+	Blockable           MixedContentTypeEnum
+	OptionallyBlockable MixedContentTypeEnum
+	None                MixedContentTypeEnum
+}
+
+/*
+MixedContentType provides named access to the MixedContentTypeEnum values.
+*/
+var MixedContentType = mixedContentTypeEnum{
+	Blockable:           mixedContentTypeBlockable,
+	OptionallyBlockable: mixedContentTypeOptionallyBlockable,
+	None:                mixedContentTypeNone,
+}
+
+/*
+MixedContentTypeEnum represents the Security.MixedContentType values.
+
+A description of mixed content (HTTP resources on HTTPS pages), as defined by
+https://www.w3.org/TR/mixed-content/#categories
+
+Allowed values:
+  - MixedContentType.Blockable           "blockable"
+  - MixedContentType.OptionallyBlockable "optionally-blockable"
+  - MixedContentType.None                "none"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-MixedContentType
+*/
+type MixedContentTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum MixedContentTypeEnum) String() string {
+	return _mixedContentTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MixedContentTypeEnum) Unknown() bool {
+	return !_mixedContentTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum MixedContentTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *MixedContentTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _mixedContentTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// mixedContentTypeBlockable represents the "blockable" value.
+	mixedContentTypeBlockable MixedContentTypeEnum = iota + 1
+	// mixedContentTypeOptionallyBlockable represents the "optionally-blockable" value.
+	mixedContentTypeOptionallyBlockable
+	// mixedContentTypeNone represents the "none" value.
+	mixedContentTypeNone
+)
+
+var _mixedContentTypeEnums = enums.New(map[MixedContentTypeEnum]string{
+	MixedContentTypeEnum(0):             "",
+	mixedContentTypeBlockable:           "blockable",
+	mixedContentTypeOptionallyBlockable: "optionally-blockable",
+	mixedContentTypeNone:                "none",
+})
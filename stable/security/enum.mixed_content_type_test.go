@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMixedContentType(t *testing.T) {
+	var enum MixedContentTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MixedContentTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = MixedContentType.Blockable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"blockable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"blockable"`, result)
+	}
+	json.Unmarshal([]byte(`"blockable"`), &enum)
+	if MixedContentType.Blockable != enum {
+		t.Errorf("Expected %d, got %d", MixedContentType.Blockable, enum)
+	}
+
+	enum = MixedContentType.OptionallyBlockable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"optionally-blockable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"optionally-blockable"`, result)
+	}
+	json.Unmarshal([]byte(`"optionally-blockable"`), &enum)
+	if MixedContentType.OptionallyBlockable != enum {
+		t.Errorf("Expected %d, got %d", MixedContentType.OptionallyBlockable, enum)
+	}
+
+	enum = MixedContentType.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if MixedContentType.None != enum {
+		t.Errorf("Expected %d, got %d", MixedContentType.None, enum)
+	}
+}
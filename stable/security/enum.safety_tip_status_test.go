@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSafetyTipStatus(t *testing.T) {
+	var enum SafetyTipStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SafetyTipStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SafetyTipStatus.BadReputation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"badReputation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"badReputation"`, result)
+	}
+	json.Unmarshal([]byte(`"badReputation"`), &enum)
+	if SafetyTipStatus.BadReputation != enum {
+		t.Errorf("Expected %d, got %d", SafetyTipStatus.BadReputation, enum)
+	}
+
+	enum = SafetyTipStatus.Lookalike
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"lookalike"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"lookalike"`, result)
+	}
+	json.Unmarshal([]byte(`"lookalike"`), &enum)
+	if SafetyTipStatus.Lookalike != enum {
+		t.Errorf("Expected %d, got %d", SafetyTipStatus.Lookalike, enum)
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type stateEnum struct {
+	Unknown        StateEnum
+	Neutral        StateEnum
+	Insecure       StateEnum
+	Secure         StateEnum
+	Info           StateEnum
+	InsecureBroken StateEnum
+}
+
+/*
+State provides named access to the StateEnum values.
+*/
+var State = stateEnum{
+	Unknown:        stateUnknown,
+	Neutral:        stateNeutral,
+	Insecure:       stateInsecure,
+	Secure:         stateSecure,
+	Info:           stateInfo,
+	InsecureBroken: stateInsecureBroken,
+}
+
+/*
+StateEnum represents the Security.SecurityState values.
+
+The security level of a page or resource.
+
+Allowed values:
+  - State.Unknown        "unknown"
+  - State.Neutral        "neutral"
+  - State.Insecure       "insecure"
+  - State.Secure         "secure"
+  - State.Info           "info"
+  - State.InsecureBroken "insecure-broken"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#type-SecurityState
+*/
+type StateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StateEnum) String() string {
+	return _stateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StateEnum) Unknown() bool {
+	return !_stateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _stateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// stateUnknown represents the "unknown" value.
+	stateUnknown StateEnum = iota + 1
+	// stateNeutral represents the "neutral" value.
+	stateNeutral
+	// stateInsecure represents the "insecure" value.
+	stateInsecure
+	// stateSecure represents the "secure" value.
+	stateSecure
+	// stateInfo represents the "info" value.
+	stateInfo
+	// stateInsecureBroken represents the "insecure-broken" value.
+	stateInsecureBroken
+)
+
+var _stateEnums = enums.New(map[StateEnum]string{
+	StateEnum(0):        "",
+	stateUnknown:        "unknown",
+	stateNeutral:        "neutral",
+	stateInsecure:       "insecure",
+	stateSecure:         "secure",
+	stateInfo:           "info",
+	stateInsecureBroken: "insecure-broken",
+})
@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumState(t *testing.T) {
+	var enum StateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = State.Unknown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unknown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unknown"`, result)
+	}
+	json.Unmarshal([]byte(`"unknown"`), &enum)
+	if State.Unknown != enum {
+		t.Errorf("Expected %d, got %d", State.Unknown, enum)
+	}
+
+	enum = State.Neutral
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"neutral"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"neutral"`, result)
+	}
+	json.Unmarshal([]byte(`"neutral"`), &enum)
+	if State.Neutral != enum {
+		t.Errorf("Expected %d, got %d", State.Neutral, enum)
+	}
+
+	enum = State.Insecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insecure"`, result)
+	}
+	json.Unmarshal([]byte(`"insecure"`), &enum)
+	if State.Insecure != enum {
+		t.Errorf("Expected %d, got %d", State.Insecure, enum)
+	}
+
+	enum = State.Secure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"secure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"secure"`, result)
+	}
+	json.Unmarshal([]byte(`"secure"`), &enum)
+	if State.Secure != enum {
+		t.Errorf("Expected %d, got %d", State.Secure, enum)
+	}
+
+	enum = State.Info
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"info"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"info"`, result)
+	}
+	json.Unmarshal([]byte(`"info"`), &enum)
+	if State.Info != enum {
+		t.Errorf("Expected %d, got %d", State.Info, enum)
+	}
+
+	enum = State.InsecureBroken
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insecure-broken"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insecure-broken"`, result)
+	}
+	json.Unmarshal([]byte(`"insecure-broken"`), &enum)
+	if State.InsecureBroken != enum {
+		t.Errorf("Expected %d, got %d", State.InsecureBroken, enum)
+	}
+}
@@ -0,0 +1,182 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/browser"
+)
+
+/*
+BrowserProtocol provides a namespace for the Chrome Browser protocol methods.
+
+The Browser domain defines methods and events for browser managing.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/
+*/
+type BrowserProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *BrowserProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *BrowserProtocol) WithContext(ctx context.Context) *BrowserProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &BrowserProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Reset all permission management for all origins.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-resetPermissions
+*/
+func (protocol *BrowserProtocol) ResetPermissions(
+	params ...*browser.ResetPermissionsParams,
+) <-chan *browser.ResetPermissionsResult {
+	resultChan := make(chan *browser.ResetPermissionsResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.resetPermissions", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Browser.resetPermissions", params[0])
+	}
+	result := &browser.ResetPermissionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Close browser gracefully.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-close
+*/
+func (protocol *BrowserProtocol) Close() <-chan *browser.CloseResult {
+	resultChan := make(chan *browser.CloseResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.close", nil)
+	result := &browser.CloseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns version information.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-getVersion
+*/
+func (protocol *BrowserProtocol) GetVersion() <-chan *browser.GetVersionResult {
+	resultChan := make(chan *browser.GetVersionResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.getVersion", nil)
+	result := &browser.GetVersionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows a site to use privacy sandbox features that require enrollment without
+the site actually being enrolled. Only supported on page targets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+func (protocol *BrowserProtocol) AddPrivacySandboxEnrollmentOverride(
+	params *browser.AddPrivacySandboxEnrollmentOverrideParams,
+) <-chan *browser.AddPrivacySandboxEnrollmentOverrideResult {
+	resultChan := make(chan *browser.AddPrivacySandboxEnrollmentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.addPrivacySandboxEnrollmentOverride", params)
+	result := &browser.AddPrivacySandboxEnrollmentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Configures encryption keys used with a given privacy sandbox API to talk to a
+trusted coordinator. Since this is intended for test automation only,
+coordinatorOrigin must be a .test domain. No existing coordinator configuration
+for the origin may exist.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+func (protocol *BrowserProtocol) AddPrivacySandboxCoordinatorKeyConfig(
+	params *browser.AddPrivacySandboxCoordinatorKeyConfigParams,
+) <-chan *browser.AddPrivacySandboxCoordinatorKeyConfigResult {
+	resultChan := make(chan *browser.AddPrivacySandboxCoordinatorKeyConfigResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.addPrivacySandboxCoordinatorKeyConfig", params)
+	result := &browser.AddPrivacySandboxCoordinatorKeyConfigResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,68 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/stable/browser"
+)
+
+func TestBrowserCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestBrowserCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Browser.resetPermissions", func() func() error {
+			resultChan := mockSocket.Browser().ResetPermissions(&browser.ResetPermissionsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Browser.close", func() func() error {
+			resultChan := mockSocket.Browser().Close()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Browser.getVersion", func() func() error {
+			resultChan := mockSocket.Browser().GetVersion()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Browser.addPrivacySandboxEnrollmentOverride", func() func() error {
+			resultChan := mockSocket.Browser().AddPrivacySandboxEnrollmentOverride(&browser.AddPrivacySandboxEnrollmentOverrideParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Browser.addPrivacySandboxCoordinatorKeyConfig", func() func() error {
+			resultChan := mockSocket.Browser().AddPrivacySandboxCoordinatorKeyConfig(&browser.AddPrivacySandboxCoordinatorKeyConfigParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
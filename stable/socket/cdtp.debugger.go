@@ -0,0 +1,782 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/debugger"
+)
+
+/*
+DebuggerProtocol provides a namespace for the Chrome Debugger protocol methods.
+
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/
+*/
+type DebuggerProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DebuggerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DebuggerProtocol) WithContext(ctx context.Context) *DebuggerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DebuggerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Continues execution until specific location is reached.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-continueToLocation
+*/
+func (protocol *DebuggerProtocol) ContinueToLocation(
+	params *debugger.ContinueToLocationParams,
+) <-chan *debugger.ContinueToLocationResult {
+	resultChan := make(chan *debugger.ContinueToLocationResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.continueToLocation", params)
+	result := &debugger.ContinueToLocationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables debugger for given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-disable
+*/
+func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
+	resultChan := make(chan *debugger.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.disable", nil)
+	result := &debugger.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables debugger for the given page. Clients should not assume that the
+debugging has been enabled until the result for this command is received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-enable
+*/
+func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
+	resultChan := make(chan *debugger.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.enable", nil)
+	result := &debugger.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Evaluates expression on a given call frame.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-evaluateOnCallFrame
+*/
+func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
+	params *debugger.EvaluateOnCallFrameParams,
+) <-chan *debugger.EvaluateOnCallFrameResult {
+	resultChan := make(chan *debugger.EvaluateOnCallFrameResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.evaluateOnCallFrame", params)
+	result := &debugger.EvaluateOnCallFrameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns possible locations for breakpoint. scriptId in start and end range
+locations should be the same.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getPossibleBreakpoints
+*/
+func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
+	params *debugger.GetPossibleBreakpointsParams,
+) <-chan *debugger.GetPossibleBreakpointsResult {
+	resultChan := make(chan *debugger.GetPossibleBreakpointsResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getPossibleBreakpoints", params)
+	result := &debugger.GetPossibleBreakpointsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns source for the script with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-getScriptSource
+*/
+func (protocol *DebuggerProtocol) GetScriptSource(
+	params *debugger.GetScriptSourceParams,
+) <-chan *debugger.GetScriptSourceResult {
+	resultChan := make(chan *debugger.GetScriptSourceResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getScriptSource", params)
+	result := &debugger.GetScriptSourceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stops on the next JavaScript statement.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-pause
+*/
+func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
+	resultChan := make(chan *debugger.PauseResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.pause", nil)
+	result := &debugger.PauseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes JavaScript breakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-removeBreakpoint
+*/
+func (protocol *DebuggerProtocol) RemoveBreakpoint(
+	params *debugger.RemoveBreakpointParams,
+) <-chan *debugger.RemoveBreakpointResult {
+	resultChan := make(chan *debugger.RemoveBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.removeBreakpoint", params)
+	result := &debugger.RemoveBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Restarts particular call frame from the beginning. The old, deprecated behavior
+of `restartFrame` is to stay paused and allow further CDP commands after a
+restart was scheduled. This can cause problems with restarting, so we now
+continue execution immediatly after it has been scheduled until we reach the
+beginning of the restarted frame.
+
+To stay back-wards compatible, `restartFrame` now expects a `mode` parameter to
+be present. If the `mode` parameter is missing, `restartFrame` errors out.
+
+The various return values are deprecated and `callFrames` is always empty. Use
+the call frames from the `Debugger#paused` events instead, that fires once V8
+pauses at the beginning of the restarted function.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-restartFrame
+*/
+func (protocol *DebuggerProtocol) RestartFrame(
+	params *debugger.RestartFrameParams,
+) <-chan *debugger.RestartFrameResult {
+	resultChan := make(chan *debugger.RestartFrameResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.restartFrame", params)
+	result := &debugger.RestartFrameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resumes JavaScript execution.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-resume
+*/
+func (protocol *DebuggerProtocol) Resume(
+	params ...*debugger.ResumeParams,
+) <-chan *debugger.ResumeResult {
+	resultChan := make(chan *debugger.ResumeResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.resume", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Debugger.resume", params[0])
+	}
+	result := &debugger.ResumeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Searches for given string in script content.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-searchInContent
+*/
+func (protocol *DebuggerProtocol) SearchInContent(
+	params *debugger.SearchInContentParams,
+) <-chan *debugger.SearchInContentResult {
+	resultChan := make(chan *debugger.SearchInContentResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.searchInContent", params)
+	result := &debugger.SearchInContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables or disables async call stacks tracking.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setAsyncCallStackDepth
+*/
+func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
+	params *debugger.SetAsyncCallStackDepthParams,
+) <-chan *debugger.SetAsyncCallStackDepthResult {
+	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setAsyncCallStackDepth", params)
+	result := &debugger.SetAsyncCallStackDepthResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets JavaScript breakpoint at a given location.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetBreakpoint(
+	params *debugger.SetBreakpointParams,
+) <-chan *debugger.SetBreakpointResult {
+	resultChan := make(chan *debugger.SetBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpoint", params)
+	result := &debugger.SetBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets instrumentation breakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setInstrumentationBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetInstrumentationBreakpoint(
+	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setInstrumentationBreakpoint", params)
+	result := &debugger.SetInstrumentationBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets JavaScript breakpoint at given location specified either by URL or URL
+regex. Once this command is issued, all existing parsed scripts will have
+breakpoints resolved and returned in `locations` property. Further matching
+script parsing will result in subsequent `breakpointResolved` events issued.
+This logical breakpoint will survive page reloads.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointByUrl
+*/
+func (protocol *DebuggerProtocol) SetBreakpointByURL(
+	params *debugger.SetBreakpointByURLParams,
+) <-chan *debugger.SetBreakpointByURLResult {
+	resultChan := make(chan *debugger.SetBreakpointByURLResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpointByUrl", params)
+	result := &debugger.SetBreakpointByURLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Activates / deactivates all breakpoints on the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setBreakpointsActive
+*/
+func (protocol *DebuggerProtocol) SetBreakpointsActive(
+	params *debugger.SetBreakpointsActiveParams,
+) <-chan *debugger.SetBreakpointsActiveResult {
+	resultChan := make(chan *debugger.SetBreakpointsActiveResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpointsActive", params)
+	result := &debugger.SetBreakpointsActiveResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Defines pause on exceptions state. Can be set to stop on all exceptions,
+uncaught exceptions, or caught exceptions, no exceptions. Initial pause on
+exceptions state is `none`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setPauseOnExceptions
+*/
+func (protocol *DebuggerProtocol) SetPauseOnExceptions(
+	params *debugger.SetPauseOnExceptionsParams,
+) <-chan *debugger.SetPauseOnExceptionsResult {
+	resultChan := make(chan *debugger.SetPauseOnExceptionsResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setPauseOnExceptions", params)
+	result := &debugger.SetPauseOnExceptionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Edits JavaScript source live.
+
+In general, functions that are currently on the stack can not be edited with a
+single exception: If the edited function is the top-most stack frame and that is
+the only activation of that function on the stack. In this case the live edit
+will be successful and a `Debugger.restartFrame` for the top-most function is
+automatically triggered.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setScriptSource
+*/
+func (protocol *DebuggerProtocol) SetScriptSource(
+	params *debugger.SetScriptSourceParams,
+) <-chan *debugger.SetScriptSourceResult {
+	resultChan := make(chan *debugger.SetScriptSourceResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setScriptSource", params)
+	result := &debugger.SetScriptSourceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Makes page not interrupt on any pauses (breakpoint, exception, dom exception
+etc).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setSkipAllPauses
+*/
+func (protocol *DebuggerProtocol) SetSkipAllPauses(
+	params *debugger.SetSkipAllPausesParams,
+) <-chan *debugger.SetSkipAllPausesResult {
+	resultChan := make(chan *debugger.SetSkipAllPausesResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setSkipAllPauses", params)
+	result := &debugger.SetSkipAllPausesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Changes value of variable in a callframe. Object-based scopes are not supported
+and must be mutated manually.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-setVariableValue
+*/
+func (protocol *DebuggerProtocol) SetVariableValue(
+	params *debugger.SetVariableValueParams,
+) <-chan *debugger.SetVariableValueResult {
+	resultChan := make(chan *debugger.SetVariableValueResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setVariableValue", params)
+	result := &debugger.SetVariableValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Steps into the function call.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepInto
+*/
+func (protocol *DebuggerProtocol) StepInto() <-chan *debugger.StepIntoResult {
+	resultChan := make(chan *debugger.StepIntoResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepInto", nil)
+	result := &debugger.StepIntoResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Steps out of the function call.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOut
+*/
+func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
+	resultChan := make(chan *debugger.StepOutResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepOut", nil)
+	result := &debugger.StepOutResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Steps over the statement.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#method-stepOver
+*/
+func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
+	resultChan := make(chan *debugger.StepOverResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepOver", nil)
+	result := &debugger.StepOverResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnPaused adds a handler to the Debugger.paused event.
+
+Fired when the virtual machine stopped on breakpoint or exception or any other
+stop criteria.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-paused
+*/
+func (protocol *DebuggerProtocol) OnPaused(
+	callback func(event *debugger.PausedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Debugger.paused",
+		func(response *Response) {
+			event := &debugger.PausedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnResumed adds a handler to the Debugger.resumed event.
+
+Fired when the virtual machine resumed execution.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-resumed
+*/
+func (protocol *DebuggerProtocol) OnResumed(
+	callback func(event *debugger.ResumedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Debugger.resumed",
+		func(response *Response) {
+			event := &debugger.ResumedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnScriptFailedToParse adds a handler to the Debugger.scriptFailedToParse event.
+
+Fired when virtual machine fails to parse the script.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptFailedToParse
+*/
+func (protocol *DebuggerProtocol) OnScriptFailedToParse(
+	callback func(event *debugger.ScriptFailedToParseEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Debugger.scriptFailedToParse",
+		func(response *Response) {
+			event := &debugger.ScriptFailedToParseEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnScriptParsed adds a handler to the Debugger.scriptParsed event.
+
+Fired when virtual machine parses script. This event is also fired for all known
+and uncollected scripts upon enabling debugger.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Debugger/#event-scriptParsed
+*/
+func (protocol *DebuggerProtocol) OnScriptParsed(
+	callback func(event *debugger.ScriptParsedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Debugger.scriptParsed",
+		func(response *Response) {
+			event := &debugger.ScriptParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,232 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/dom/debugger"
+)
+
+/*
+DOMDebuggerProtocol provides a namespace for the Chrome DOMDebugger protocol
+methods.
+
+DOM debugging allows setting breakpoints on particular DOM operations and
+events. JavaScript execution will stop on these operations as if there was a
+regular breakpoint set.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/
+*/
+type DOMDebuggerProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMDebuggerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMDebuggerProtocol) WithContext(ctx context.Context) *DOMDebuggerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMDebuggerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Returns event listeners of the given object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-getEventListeners
+*/
+func (protocol *DOMDebuggerProtocol) GetEventListeners(
+	params *debugger.GetEventListenersParams,
+) <-chan *debugger.GetEventListenersResult {
+	resultChan := make(chan *debugger.GetEventListenersResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.getEventListeners", params)
+	result := &debugger.GetEventListenersResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
+	params *debugger.RemoveDOMBreakpointParams,
+) <-chan *debugger.RemoveDOMBreakpointResult {
+	resultChan := make(chan *debugger.RemoveDOMBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeDOMBreakpoint", params)
+	result := &debugger.RemoveDOMBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes breakpoint on particular DOM event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
+	params *debugger.RemoveEventListenerBreakpointParams,
+) <-chan *debugger.RemoveEventListenerBreakpointResult {
+	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeEventListenerBreakpoint", params)
+	result := &debugger.RemoveEventListenerBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes breakpoint from XMLHttpRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-removeXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
+	params *debugger.RemoveXHRBreakpointParams,
+) <-chan *debugger.RemoveXHRBreakpointResult {
+	resultChan := make(chan *debugger.RemoveXHRBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.removeXHRBreakpoint", params)
+	result := &debugger.RemoveXHRBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets breakpoint on particular operation with DOM.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setDOMBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
+	params *debugger.SetDOMBreakpointParams,
+) <-chan *debugger.SetDOMBreakpointResult {
+	resultChan := make(chan *debugger.SetDOMBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setDOMBreakpoint", params)
+	result := &debugger.SetDOMBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets breakpoint on particular DOM event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setEventListenerBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
+	params *debugger.SetEventListenerBreakpointParams,
+) <-chan *debugger.SetEventListenerBreakpointResult {
+	resultChan := make(chan *debugger.SetEventListenerBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setEventListenerBreakpoint", params)
+	result := &debugger.SetEventListenerBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets breakpoint on XMLHttpRequest.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOMDebugger/#method-setXHRBreakpoint
+*/
+func (protocol *DOMDebuggerProtocol) SetXHRBreakpoint(
+	params *debugger.SetXHRBreakpointParams,
+) <-chan *debugger.SetXHRBreakpointResult {
+	resultChan := make(chan *debugger.SetXHRBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setXHRBreakpoint", params)
+	result := &debugger.SetXHRBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
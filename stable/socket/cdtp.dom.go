@@ -0,0 +1,1014 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/dom"
+)
+
+/*
+DOMProtocol provides a namespace for the Chrome DOM protocol methods.
+
+This domain exposes DOM read/write operations. Each DOM Node is represented with
+its mirror object that has an `id`. This `id` can be used to get additional
+information on the Node, resolve it into the JavaScript object wrapper, etc. It
+is important that client receives DOM events only for the nodes that are known
+to the client. Backend keeps track of the nodes that were sent to the client and
+never sends the same node twice. It is client's responsibility to collect
+information about the nodes that were sent to the client. Note that `iframe`
+owner elements will return corresponding document elements as their child nodes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/
+*/
+type DOMProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMProtocol) WithContext(ctx context.Context) *DOMProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Describes node given its id, does not require domain to be enabled. Does not
+start tracking any objects, can be used for automation.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-describeNode
+*/
+func (protocol *DOMProtocol) DescribeNode(
+	params ...*dom.DescribeNodeParams,
+) <-chan *dom.DescribeNodeResult {
+	resultChan := make(chan *dom.DescribeNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.describeNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.describeNode", params[0])
+	}
+	result := &dom.DescribeNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Scrolls the specified rect of the given node into view if not already visible.
+Note: exactly one between nodeId, backendNodeId and objectId should be passed to
+identify the node.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-scrollIntoViewIfNeeded
+*/
+func (protocol *DOMProtocol) ScrollIntoViewIfNeeded(
+	params ...*dom.ScrollIntoViewIfNeededParams,
+) <-chan *dom.ScrollIntoViewIfNeededResult {
+	resultChan := make(chan *dom.ScrollIntoViewIfNeededResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.scrollIntoViewIfNeeded", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.scrollIntoViewIfNeeded", params[0])
+	}
+	result := &dom.ScrollIntoViewIfNeededResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables DOM agent for the given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-disable
+*/
+func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
+	resultChan := make(chan *dom.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.disable", nil)
+	result := &dom.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables DOM agent for the given page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-enable
+*/
+func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
+	resultChan := make(chan *dom.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.enable", nil)
+	result := &dom.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Focuses the given element.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-focus
+*/
+func (protocol *DOMProtocol) Focus(
+	params ...*dom.FocusParams,
+) <-chan *dom.FocusResult {
+	resultChan := make(chan *dom.FocusResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.focus", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.focus", params[0])
+	}
+	result := &dom.FocusResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns attributes for the specified node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getAttributes
+*/
+func (protocol *DOMProtocol) GetAttributes(
+	params *dom.GetAttributesParams,
+) <-chan *dom.GetAttributesResult {
+	resultChan := make(chan *dom.GetAttributesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getAttributes", params)
+	result := &dom.GetAttributesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns boxes for the given node.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getBoxModel
+*/
+func (protocol *DOMProtocol) GetBoxModel(
+	params ...*dom.GetBoxModelParams,
+) <-chan *dom.GetBoxModelResult {
+	resultChan := make(chan *dom.GetBoxModelResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getBoxModel", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getBoxModel", params[0])
+	}
+	result := &dom.GetBoxModelResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the root DOM node (and optionally the subtree) to the caller. Implicitly
+enables the DOM domain events for the current target.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getDocument
+*/
+func (protocol *DOMProtocol) GetDocument(
+	params ...*dom.GetDocumentParams,
+) <-chan *dom.GetDocumentResult {
+	resultChan := make(chan *dom.GetDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getDocument", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getDocument", params[0])
+	}
+	result := &dom.GetDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns node id at given location. Depending on whether DOM domain is enabled,
+nodeId is either returned or not.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getNodeForLocation
+*/
+func (protocol *DOMProtocol) GetNodeForLocation(
+	params *dom.GetNodeForLocationParams,
+) <-chan *dom.GetNodeForLocationResult {
+	resultChan := make(chan *dom.GetNodeForLocationResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getNodeForLocation", params)
+	result := &dom.GetNodeForLocationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns node's HTML markup.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-getOuterHTML
+*/
+func (protocol *DOMProtocol) GetOuterHTML(
+	params ...*dom.GetOuterHTMLParams,
+) <-chan *dom.GetOuterHTMLResult {
+	resultChan := make(chan *dom.GetOuterHTMLResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getOuterHTML", params[0])
+	}
+	result := &dom.GetOuterHTMLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Hides any highlight.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-hideHighlight
+*/
+func (protocol *DOMProtocol) HideHighlight() <-chan *dom.HideHighlightResult {
+	resultChan := make(chan *dom.HideHighlightResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.hideHighlight", nil)
+	result := &dom.HideHighlightResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlights DOM node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightNode
+*/
+func (protocol *DOMProtocol) HighlightNode() <-chan *dom.HighlightNodeResult {
+	resultChan := make(chan *dom.HighlightNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightNode", nil)
+	result := &dom.HighlightNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlights given rectangle.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-highlightRect
+*/
+func (protocol *DOMProtocol) HighlightRect() <-chan *dom.HighlightRectResult {
+	resultChan := make(chan *dom.HighlightRectResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightRect", nil)
+	result := &dom.HighlightRectResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Moves node into the new container, places it before the given anchor.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-moveTo
+*/
+func (protocol *DOMProtocol) MoveTo(
+	params *dom.MoveToParams,
+) <-chan *dom.MoveToResult {
+	resultChan := make(chan *dom.MoveToResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.moveTo", params)
+	result := &dom.MoveToResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Executes `querySelector` on a given node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelector
+*/
+func (protocol *DOMProtocol) QuerySelector(
+	params *dom.QuerySelectorParams,
+) <-chan *dom.QuerySelectorResult {
+	resultChan := make(chan *dom.QuerySelectorResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.querySelector", params)
+	result := &dom.QuerySelectorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Executes `querySelectorAll` on a given node.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-querySelectorAll
+*/
+func (protocol *DOMProtocol) QuerySelectorAll(
+	params *dom.QuerySelectorAllParams,
+) <-chan *dom.QuerySelectorAllResult {
+	resultChan := make(chan *dom.QuerySelectorAllResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.querySelectorAll", params)
+	result := &dom.QuerySelectorAllResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes attribute with given name from an element with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeAttribute
+*/
+func (protocol *DOMProtocol) RemoveAttribute(
+	params *dom.RemoveAttributeParams,
+) <-chan *dom.RemoveAttributeResult {
+	resultChan := make(chan *dom.RemoveAttributeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.removeAttribute", params)
+	result := &dom.RemoveAttributeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-removeNode
+*/
+func (protocol *DOMProtocol) RemoveNode(
+	params *dom.RemoveNodeParams,
+) <-chan *dom.RemoveNodeResult {
+	resultChan := make(chan *dom.RemoveNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.removeNode", params)
+	result := &dom.RemoveNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Requests that children of the node with given id are returned to the caller in
+form of `setChildNodes` events where not only immediate children are retrieved,
+but all children down to the specified depth.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestChildNodes
+*/
+func (protocol *DOMProtocol) RequestChildNodes(
+	params *dom.RequestChildNodesParams,
+) <-chan *dom.RequestChildNodesResult {
+	resultChan := make(chan *dom.RequestChildNodesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.requestChildNodes", params)
+	result := &dom.RequestChildNodesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Requests that the node is sent to the caller given the JavaScript node object
+reference. All nodes that form the path from the node to the root are also sent
+to the client as a series of `setChildNodes` notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-requestNode
+*/
+func (protocol *DOMProtocol) RequestNode(
+	params *dom.RequestNodeParams,
+) <-chan *dom.RequestNodeResult {
+	resultChan := make(chan *dom.RequestNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.requestNode", params)
+	result := &dom.RequestNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-resolveNode
+*/
+func (protocol *DOMProtocol) ResolveNode(
+	params ...*dom.ResolveNodeParams,
+) <-chan *dom.ResolveNodeResult {
+	resultChan := make(chan *dom.ResolveNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.resolveNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.resolveNode", params[0])
+	}
+	result := &dom.ResolveNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets attribute for an element with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributeValue
+*/
+func (protocol *DOMProtocol) SetAttributeValue(
+	params *dom.SetAttributeValueParams,
+) <-chan *dom.SetAttributeValueResult {
+	resultChan := make(chan *dom.SetAttributeValueResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setAttributeValue", params)
+	result := &dom.SetAttributeValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets attributes on element with given id. This method is useful when user edits
+some existing attribute value and types in several attribute name/value pairs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setAttributesAsText
+*/
+func (protocol *DOMProtocol) SetAttributesAsText(
+	params *dom.SetAttributesAsTextParams,
+) <-chan *dom.SetAttributesAsTextResult {
+	resultChan := make(chan *dom.SetAttributesAsTextResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setAttributesAsText", params)
+	result := &dom.SetAttributesAsTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets files for the given file input element.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setFileInputFiles
+*/
+func (protocol *DOMProtocol) SetFileInputFiles(
+	params *dom.SetFileInputFilesParams,
+) <-chan *dom.SetFileInputFilesResult {
+	resultChan := make(chan *dom.SetFileInputFilesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setFileInputFiles", params)
+	result := &dom.SetFileInputFilesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets node name for a node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeName
+*/
+func (protocol *DOMProtocol) SetNodeName(
+	params *dom.SetNodeNameParams,
+) <-chan *dom.SetNodeNameResult {
+	resultChan := make(chan *dom.SetNodeNameResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setNodeName", params)
+	result := &dom.SetNodeNameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets node value for a node with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setNodeValue
+*/
+func (protocol *DOMProtocol) SetNodeValue(
+	params *dom.SetNodeValueParams,
+) <-chan *dom.SetNodeValueResult {
+	resultChan := make(chan *dom.SetNodeValueResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setNodeValue", params)
+	result := &dom.SetNodeValueResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets node HTML markup, returns new node id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#method-setOuterHTML
+*/
+func (protocol *DOMProtocol) SetOuterHTML(
+	params *dom.SetOuterHTMLParams,
+) <-chan *dom.SetOuterHTMLResult {
+	resultChan := make(chan *dom.SetOuterHTMLResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setOuterHTML", params)
+	result := &dom.SetOuterHTMLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnAttributeModified adds a handler to the DOM.attributeModified event.
+
+Fired when `Element`'s attribute is modified.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeModified
+*/
+func (protocol *DOMProtocol) OnAttributeModified(
+	callback func(event *dom.AttributeModifiedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.attributeModified",
+		func(response *Response) {
+			event := &dom.AttributeModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAttributeRemoved adds a handler to the DOM.attributeRemoved event.
+
+Fired when `Element`'s attribute is removed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-attributeRemoved
+*/
+func (protocol *DOMProtocol) OnAttributeRemoved(
+	callback func(event *dom.AttributeRemovedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.attributeRemoved",
+		func(response *Response) {
+			event := &dom.AttributeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCharacterDataModified adds a handler to the DOM.characterDataModified event.
+
+Mirrors `DOMCharacterDataModified` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-characterDataModified
+*/
+func (protocol *DOMProtocol) OnCharacterDataModified(
+	callback func(event *dom.CharacterDataModifiedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.characterDataModified",
+		func(response *Response) {
+			event := &dom.CharacterDataModifiedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeCountUpdated adds a handler to the DOM.childNodeCountUpdated event.
+
+Fired when `Container`'s child node count has changed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeCountUpdated
+*/
+func (protocol *DOMProtocol) OnChildNodeCountUpdated(
+	callback func(event *dom.ChildNodeCountUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.childNodeCountUpdated",
+		func(response *Response) {
+			event := &dom.ChildNodeCountUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeInserted adds a handler to the DOM.childNodeInserted event.
+
+Mirrors `DOMNodeInserted` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeInserted
+*/
+func (protocol *DOMProtocol) OnChildNodeInserted(
+	callback func(event *dom.ChildNodeInsertedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.childNodeInserted",
+		func(response *Response) {
+			event := &dom.ChildNodeInsertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnChildNodeRemoved adds a handler to the DOM.childNodeRemoved event.
+
+Mirrors `DOMNodeRemoved` event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-childNodeRemoved
+*/
+func (protocol *DOMProtocol) OnChildNodeRemoved(
+	callback func(event *dom.ChildNodeRemovedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.childNodeRemoved",
+		func(response *Response) {
+			event := &dom.ChildNodeRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDocumentUpdated adds a handler to the DOM.documentUpdated event.
+
+Fired when `Document` has been totally updated. Node ids are no longer valid.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-documentUpdated
+*/
+func (protocol *DOMProtocol) OnDocumentUpdated(
+	callback func(event *dom.DocumentUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.documentUpdated",
+		func(response *Response) {
+			event := &dom.DocumentUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSetChildNodes adds a handler to the DOM.setChildNodes event.
+
+Fired when backend wants to provide client with the missing DOM structure. This
+happens upon most of the calls requesting node ids.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/DOM/#event-setChildNodes
+*/
+func (protocol *DOMProtocol) OnSetChildNodes(
+	callback func(event *dom.SetChildNodesEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.setChildNodes",
+		func(response *Response) {
+			event := &dom.SetChildNodesEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
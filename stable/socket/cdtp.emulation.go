@@ -0,0 +1,454 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/stable/emulation"
+)
+
+/*
+EmulationProtocol provides a namespace for the Chrome Emulation protocol
+methods.
+
+This domain emulates different environments for the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/
+*/
+type EmulationProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *EmulationProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *EmulationProtocol) WithContext(ctx context.Context) *EmulationProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &EmulationProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Clears the overridden device metrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulation.ClearDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearDeviceMetricsOverride", nil)
+	result := &emulation.ClearDeviceMetricsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears the overridden Geolocation Position and Error.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearGeolocationOverride
+*/
+func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.ClearGeolocationOverrideResult {
+	resultChan := make(chan *emulation.ClearGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearGeolocationOverride", nil)
+	result := &emulation.ClearGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables CPU throttling to emulate slow CPUs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setCPUThrottlingRate
+*/
+func (protocol *EmulationProtocol) SetCPUThrottlingRate(
+	params *emulation.SetCPUThrottlingRateParams,
+) <-chan *emulation.SetCPUThrottlingRateResult {
+	resultChan := make(chan *emulation.SetCPUThrottlingRateResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setCPUThrottlingRate", params)
+	result := &emulation.SetCPUThrottlingRateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets or clears an override of the default background color of the frame. This
+override is used if the content does not specify one.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDefaultBackgroundColorOverride
+*/
+func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
+	params ...*emulation.SetDefaultBackgroundColorOverrideParams,
+) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
+	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params[0])
+	}
+	result := &emulation.SetDefaultBackgroundColorOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the values of device screen dimensions (window.screen.width,
+window.screen.height, window.innerWidth, window.innerHeight, and
+"device-width"/"device-height"-related CSS media query results).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setDeviceMetricsOverride
+*/
+func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
+	params *emulation.SetDeviceMetricsOverrideParams,
+) <-chan *emulation.SetDeviceMetricsOverrideResult {
+	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDeviceMetricsOverride", params)
+	result := &emulation.SetDeviceMetricsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Emulates the given media type or media feature for CSS media queries.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedMedia
+*/
+func (protocol *EmulationProtocol) SetEmulatedMedia(
+	params ...*emulation.SetEmulatedMediaParams,
+) <-chan *emulation.SetEmulatedMediaResult {
+	resultChan := make(chan *emulation.SetEmulatedMediaResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params[0])
+	}
+	result := &emulation.SetEmulatedMediaResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Emulates the given vision deficiency.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedVisionDeficiency
+*/
+func (protocol *EmulationProtocol) SetEmulatedVisionDeficiency(
+	params *emulation.SetEmulatedVisionDeficiencyParams,
+) <-chan *emulation.SetEmulatedVisionDeficiencyResult {
+	resultChan := make(chan *emulation.SetEmulatedVisionDeficiencyResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedVisionDeficiency", params)
+	result := &emulation.SetEmulatedVisionDeficiencyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Emulates the given OS text scale.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setEmulatedOSTextScale
+*/
+func (protocol *EmulationProtocol) SetEmulatedOSTextScale(
+	params ...*emulation.SetEmulatedOSTextScaleParams,
+) <-chan *emulation.SetEmulatedOSTextScaleResult {
+	resultChan := make(chan *emulation.SetEmulatedOSTextScaleResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedOSTextScale", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setEmulatedOSTextScale", params[0])
+	}
+	result := &emulation.SetEmulatedOSTextScaleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the Geolocation Position or Error. Omitting latitude, longitude or
+accuracy emulates position unavailable.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setGeolocationOverride
+*/
+func (protocol *EmulationProtocol) SetGeolocationOverride(
+	params ...*emulation.SetGeolocationOverrideParams,
+) <-chan *emulation.SetGeolocationOverrideResult {
+	resultChan := make(chan *emulation.SetGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params[0])
+	}
+	result := &emulation.SetGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the Idle state.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setIdleOverride
+*/
+func (protocol *EmulationProtocol) SetIdleOverride(
+	params *emulation.SetIdleOverrideParams,
+) <-chan *emulation.SetIdleOverrideResult {
+	resultChan := make(chan *emulation.SetIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setIdleOverride", params)
+	result := &emulation.SetIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears Idle state overrides.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-clearIdleOverride
+*/
+func (protocol *EmulationProtocol) ClearIdleOverride() <-chan *emulation.ClearIdleOverrideResult {
+	resultChan := make(chan *emulation.ClearIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearIdleOverride", nil)
+	result := &emulation.ClearIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Switches script execution in the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setScriptExecutionDisabled
+*/
+func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
+	params *emulation.SetScriptExecutionDisabledParams,
+) <-chan *emulation.SetScriptExecutionDisabledResult {
+	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setScriptExecutionDisabled", params)
+	result := &emulation.SetScriptExecutionDisabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables touch on platforms which do not support them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTouchEmulationEnabled
+*/
+func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
+	params *emulation.SetTouchEmulationEnabledParams,
+) <-chan *emulation.SetTouchEmulationEnabledResult {
+	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setTouchEmulationEnabled", params)
+	result := &emulation.SetTouchEmulationEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides default host system timezone with the specified one.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setTimezoneOverride
+*/
+func (protocol *EmulationProtocol) SetTimezoneOverride(
+	params *emulation.SetTimezoneOverrideParams,
+) <-chan *emulation.SetTimezoneOverrideResult {
+	resultChan := make(chan *emulation.SetTimezoneOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setTimezoneOverride", params)
+	result := &emulation.SetTimezoneOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows overriding user agent with the given string. `userAgentMetadata` must be
+set for Client Hint headers to be sent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Emulation/#method-setUserAgentOverride
+*/
+func (protocol *EmulationProtocol) SetUserAgentOverride(
+	params *emulation.SetUserAgentOverrideParams,
+) <-chan *emulation.SetUserAgentOverrideResult {
+	resultChan := make(chan *emulation.SetUserAgentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setUserAgentOverride", params)
+	result := &emulation.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,170 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/stable/input"
+)
+
+/*
+InputProtocol provides a namespace for the Chrome Input protocol methods.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/
+*/
+type InputProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *InputProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *InputProtocol) WithContext(ctx context.Context) *InputProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &InputProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Dispatches a key event to the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchKeyEvent
+*/
+func (protocol *InputProtocol) DispatchKeyEvent(
+	params *input.DispatchKeyEventParams,
+) <-chan *input.DispatchKeyEventResult {
+	resultChan := make(chan *input.DispatchKeyEventResult, 1)
+	command := NewCommand(protocol.Socket, "Input.dispatchKeyEvent", params)
+	result := &input.DispatchKeyEventResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Dispatches a mouse event to the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchMouseEvent
+*/
+func (protocol *InputProtocol) DispatchMouseEvent(
+	params *input.DispatchMouseEventParams,
+) <-chan *input.DispatchMouseEventResult {
+	resultChan := make(chan *input.DispatchMouseEventResult, 1)
+	command := NewCommand(protocol.Socket, "Input.dispatchMouseEvent", params)
+	result := &input.DispatchMouseEventResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Dispatches a touch event to the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-dispatchTouchEvent
+*/
+func (protocol *InputProtocol) DispatchTouchEvent(
+	params *input.DispatchTouchEventParams,
+) <-chan *input.DispatchTouchEventResult {
+	resultChan := make(chan *input.DispatchTouchEventResult, 1)
+	command := NewCommand(protocol.Socket, "Input.dispatchTouchEvent", params)
+	result := &input.DispatchTouchEventResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Cancels any active dragging in the page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-cancelDragging
+*/
+func (protocol *InputProtocol) CancelDragging() <-chan *input.CancelDraggingResult {
+	resultChan := make(chan *input.CancelDraggingResult, 1)
+	command := NewCommand(protocol.Socket, "Input.cancelDragging", nil)
+	result := &input.CancelDraggingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Ignores input events (useful while auditing page).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Input/#method-setIgnoreInputEvents
+*/
+func (protocol *InputProtocol) SetIgnoreEvents(
+	params *input.SetIgnoreEventsParams,
+) <-chan *input.SetIgnoreEventsResult {
+	resultChan := make(chan *input.SetIgnoreEventsResult, 1)
+	command := NewCommand(protocol.Socket, "Input.setIgnoreInputEvents", params)
+	result := &input.SetIgnoreEventsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,68 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/stable/input"
+)
+
+func TestInputCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInputCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Input.dispatchKeyEvent", func() func() error {
+			resultChan := mockSocket.Input().DispatchKeyEvent(&input.DispatchKeyEventParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Input.dispatchMouseEvent", func() func() error {
+			resultChan := mockSocket.Input().DispatchMouseEvent(&input.DispatchMouseEventParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Input.dispatchTouchEvent", func() func() error {
+			resultChan := mockSocket.Input().DispatchTouchEvent(&input.DispatchTouchEventParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Input.cancelDragging", func() func() error {
+			resultChan := mockSocket.Input().CancelDragging()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Input.setIgnoreInputEvents", func() func() error {
+			resultChan := mockSocket.Input().SetIgnoreEvents(&input.SetIgnoreEventsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
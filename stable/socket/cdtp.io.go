@@ -0,0 +1,127 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/io"
+)
+
+/*
+IOProtocol provides a namespace for the Chrome IO protocol methods.
+
+Input/Output operations for streams produced by DevTools.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/
+*/
+type IOProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *IOProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *IOProtocol) WithContext(ctx context.Context) *IOProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &IOProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Close the stream, discard any temporary backing storage.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/#method-close
+*/
+func (protocol *IOProtocol) Close(
+	params *io.CloseParams,
+) <-chan *io.CloseResult {
+	resultChan := make(chan *io.CloseResult, 1)
+	command := NewCommand(protocol.Socket, "IO.close", params)
+	result := &io.CloseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Read a chunk of the stream
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/#method-read
+*/
+func (protocol *IOProtocol) Read(
+	params *io.ReadParams,
+) <-chan *io.ReadResult {
+	resultChan := make(chan *io.ReadResult, 1)
+	command := NewCommand(protocol.Socket, "IO.read", params)
+	result := &io.ReadResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Return UUID of Blob object specified by a remote object id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/IO/#method-resolveBlob
+*/
+func (protocol *IOProtocol) ResolveBlob(
+	params *io.ResolveBlobParams,
+) <-chan *io.ResolveBlobResult {
+	resultChan := make(chan *io.ResolveBlobResult, 1)
+	command := NewCommand(protocol.Socket, "IO.resolveBlob", params)
+	result := &io.ResolveBlobResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
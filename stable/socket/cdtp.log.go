@@ -0,0 +1,194 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/log"
+)
+
+/*
+LogProtocol provides a namespace for the Chrome Log protocol methods.
+
+Provides access to log entries.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/
+*/
+type LogProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *LogProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *LogProtocol) WithContext(ctx context.Context) *LogProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &LogProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Clears the log.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#method-clear
+*/
+func (protocol *LogProtocol) Clear() <-chan *log.ClearResult {
+	resultChan := make(chan *log.ClearResult, 1)
+	command := NewCommand(protocol.Socket, "Log.clear", nil)
+	result := &log.ClearResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables log domain, prevents further log entries from being reported to the
+client.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#method-disable
+*/
+func (protocol *LogProtocol) Disable() <-chan *log.DisableResult {
+	resultChan := make(chan *log.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Log.disable", nil)
+	result := &log.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables log domain, sends the entries collected so far to the client by means of
+the `entryAdded` notification.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#method-enable
+*/
+func (protocol *LogProtocol) Enable() <-chan *log.EnableResult {
+	resultChan := make(chan *log.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Log.enable", nil)
+	result := &log.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+start violation reporting.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#method-startViolationsReport
+*/
+func (protocol *LogProtocol) StartViolationsReport(
+	params *log.StartViolationsReportParams,
+) <-chan *log.StartViolationsReportResult {
+	resultChan := make(chan *log.StartViolationsReportResult, 1)
+	command := NewCommand(protocol.Socket, "Log.startViolationsReport", params)
+	result := &log.StartViolationsReportResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stop violation reporting.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#method-stopViolationsReport
+*/
+func (protocol *LogProtocol) StopViolationsReport() <-chan *log.StopViolationsReportResult {
+	resultChan := make(chan *log.StopViolationsReportResult, 1)
+	command := NewCommand(protocol.Socket, "Log.stopViolationsReport", nil)
+	result := &log.StopViolationsReportResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnEntryAdded adds a handler to the Log.entryAdded event.
+
+Issued when new message was logged.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Log/#event-entryAdded
+*/
+func (protocol *LogProtocol) OnEntryAdded(
+	callback func(event *log.EntryAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Log.entryAdded",
+		func(response *Response) {
+			event := &log.EntryAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
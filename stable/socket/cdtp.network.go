@@ -0,0 +1,884 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/network"
+)
+
+/*
+NetworkProtocol provides a namespace for the Chrome Network protocol methods.
+
+Network domain allows tracking network activities of the page. It exposes
+information about http, file, data and other requests and responses, their
+headers, bodies, timing, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/
+*/
+type NetworkProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *NetworkProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *NetworkProtocol) WithContext(ctx context.Context) *NetworkProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &NetworkProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Clears browser cache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCache
+*/
+func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowserCacheResult {
+	resultChan := make(chan *network.ClearBrowserCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Network.clearBrowserCache", nil)
+	result := &network.ClearBrowserCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears browser cookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-clearBrowserCookies
+*/
+func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrowserCookiesResult {
+	resultChan := make(chan *network.ClearBrowserCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.clearBrowserCookies", nil)
+	result := &network.ClearBrowserCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Deletes browser cookies with matching name and url or domain/path/partitionKey
+pair.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-deleteCookies
+*/
+func (protocol *NetworkProtocol) DeleteCookies(
+	params *network.DeleteCookiesParams,
+) <-chan *network.DeleteCookiesResult {
+	resultChan := make(chan *network.DeleteCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.deleteCookies", params)
+	result := &network.DeleteCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables network tracking, prevents network events from being sent to the
+client.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-disable
+*/
+func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
+	resultChan := make(chan *network.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Network.disable", nil)
+	result := &network.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Activates emulation of network conditions.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-emulateNetworkConditions
+*/
+func (protocol *NetworkProtocol) EmulateConditions(
+	params *network.EmulateConditionsParams,
+) <-chan *network.EmulateConditionsResult {
+	resultChan := make(chan *network.EmulateConditionsResult, 1)
+	command := NewCommand(protocol.Socket, "Network.emulateNetworkConditions", params)
+	result := &network.EmulateConditionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables network tracking, network events will now be delivered to the client.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-enable
+*/
+func (protocol *NetworkProtocol) Enable(
+	params ...*network.EnableParams,
+) <-chan *network.EnableResult {
+	resultChan := make(chan *network.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Network.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Network.enable", params[0])
+	}
+	result := &network.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all browser cookies for the current URL. Depending on the backend
+support, will return detailed cookie information in the `cookies` field.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getCookies
+*/
+func (protocol *NetworkProtocol) GetCookies(
+	params ...*network.GetCookiesParams,
+) <-chan *network.GetCookiesResult {
+	resultChan := make(chan *network.GetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getCookies", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Network.getCookies", params[0])
+	}
+	result := &network.GetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns content served for the given request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getResponseBody
+*/
+func (protocol *NetworkProtocol) GetResponseBody(
+	params *network.GetResponseBodyParams,
+) <-chan *network.GetResponseBodyResult {
+	resultChan := make(chan *network.GetResponseBodyResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getResponseBody", params)
+	result := &network.GetResponseBodyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns post data sent with the request. Returns an error when no data was sent
+with the request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-getRequestPostData
+*/
+func (protocol *NetworkProtocol) GetRequestPostData(
+	params *network.GetRequestPostDataParams,
+) <-chan *network.GetRequestPostDataResult {
+	resultChan := make(chan *network.GetRequestPostDataResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getRequestPostData", params)
+	result := &network.GetRequestPostDataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Toggles ignoring of service worker for each request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setBypassServiceWorker
+*/
+func (protocol *NetworkProtocol) SetBypassServiceWorker(
+	params *network.SetBypassServiceWorkerParams,
+) <-chan *network.SetBypassServiceWorkerResult {
+	resultChan := make(chan *network.SetBypassServiceWorkerResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setBypassServiceWorker", params)
+	result := &network.SetBypassServiceWorkerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Toggles ignoring cache for each request. If `true`, cache will not be used.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCacheDisabled
+*/
+func (protocol *NetworkProtocol) SetCacheDisabled(
+	params *network.SetCacheDisabledParams,
+) <-chan *network.SetCacheDisabledResult {
+	resultChan := make(chan *network.SetCacheDisabledResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCacheDisabled", params)
+	result := &network.SetCacheDisabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets a cookie with the given cookie data; may overwrite equivalent cookies if
+they exist.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookie
+*/
+func (protocol *NetworkProtocol) SetCookie(
+	params *network.SetCookieParams,
+) <-chan *network.SetCookieResult {
+	resultChan := make(chan *network.SetCookieResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCookie", params)
+	result := &network.SetCookieResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets given cookies.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setCookies
+*/
+func (protocol *NetworkProtocol) SetCookies(
+	params *network.SetCookiesParams,
+) <-chan *network.SetCookiesResult {
+	resultChan := make(chan *network.SetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCookies", params)
+	result := &network.SetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Specifies whether to always send extra HTTP headers with the requests from this
+page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setExtraHTTPHeaders
+*/
+func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
+	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	resultChan := make(chan *network.SetExtraHTTPHeadersResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
+	result := &network.SetExtraHTTPHeadersResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows overriding user agent with the given string.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#method-setUserAgentOverride
+*/
+func (protocol *NetworkProtocol) SetUserAgentOverride(
+	params *network.SetUserAgentOverrideParams,
+) <-chan *network.SetUserAgentOverrideResult {
+	resultChan := make(chan *network.SetUserAgentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setUserAgentOverride", params)
+	result := &network.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDataReceived adds a handler to the Network.dataReceived event.
+
+Fired when data chunk was received over the network.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-dataReceived
+*/
+func (protocol *NetworkProtocol) OnDataReceived(
+	callback func(event *network.DataReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.dataReceived",
+		func(response *Response) {
+			event := &network.DataReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnEventSourceMessageReceived adds a handler to the
+Network.eventSourceMessageReceived event.
+
+Fired when EventSource message is received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-eventSourceMessageReceived
+*/
+func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
+	callback func(event *network.EventSourceMessageReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.eventSourceMessageReceived",
+		func(response *Response) {
+			event := &network.EventSourceMessageReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFailed adds a handler to the Network.loadingFailed event.
+
+Fired when HTTP request has failed to load.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFailed
+*/
+func (protocol *NetworkProtocol) OnLoadingFailed(
+	callback func(event *network.LoadingFailedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.loadingFailed",
+		func(response *Response) {
+			event := &network.LoadingFailedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnLoadingFinished adds a handler to the Network.loadingFinished event.
+
+Fired when HTTP request has finished loading.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-loadingFinished
+*/
+func (protocol *NetworkProtocol) OnLoadingFinished(
+	callback func(event *network.LoadingFinishedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.loadingFinished",
+		func(response *Response) {
+			event := &network.LoadingFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnRequestServedFromCache adds a handler to the Network.requestServedFromCache
+event.
+
+Fired if request ended up loading from cache.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestServedFromCache
+*/
+func (protocol *NetworkProtocol) OnRequestServedFromCache(
+	callback func(event *network.RequestServedFromCacheEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.requestServedFromCache",
+		func(response *Response) {
+			event := &network.RequestServedFromCacheEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnRequestWillBeSent adds a handler to the Network.requestWillBeSent event.
+
+Fired when page is about to send HTTP request.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-requestWillBeSent
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSent(
+	callback func(event *network.RequestWillBeSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.requestWillBeSent",
+		func(response *Response) {
+			event := &network.RequestWillBeSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceived adds a handler to the Network.responseReceived event.
+
+Fired when HTTP response is available.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-responseReceived
+*/
+func (protocol *NetworkProtocol) OnResponseReceived(
+	callback func(event *network.ResponseReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.responseReceived",
+		func(response *Response) {
+			event := &network.ResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketClosed adds a handler to the Network.webSocketClosed event.
+
+Fired when WebSocket is closed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketClosed
+*/
+func (protocol *NetworkProtocol) OnWebSocketClosed(
+	callback func(event *network.WebSocketClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketClosed",
+		func(response *Response) {
+			event := &network.WebSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketCreated adds a handler to the Network.webSocketCreated event.
+
+Fired upon WebSocket creation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketCreated
+*/
+func (protocol *NetworkProtocol) OnWebSocketCreated(
+	callback func(event *network.WebSocketCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketCreated",
+		func(response *Response) {
+			event := &network.WebSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameError adds a handler to the Network.webSocketFrameError event.
+
+Fired when WebSocket message error occurs.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameError
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameError(
+	callback func(event *network.WebSocketFrameErrorEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketFrameError",
+		func(response *Response) {
+			event := &network.WebSocketFrameErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameReceived adds a handler to the Network.webSocketFrameReceived
+event.
+
+Fired when WebSocket message is received.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
+	callback func(event *network.WebSocketFrameReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketFrameReceived",
+		func(response *Response) {
+			event := &network.WebSocketFrameReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketFrameSent adds a handler to the Network.webSocketFrameSent event.
+
+Fired when WebSocket message is sent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketFrameSent
+*/
+func (protocol *NetworkProtocol) OnWebSocketFrameSent(
+	callback func(event *network.WebSocketFrameSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketFrameSent",
+		func(response *Response) {
+			event := &network.WebSocketFrameSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketHandshakeResponseReceived adds a handler to the
+Network.webSocketHandshakeResponseReceived event.
+
+Fired when WebSocket handshake response becomes available.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketHandshakeResponseReceived
+*/
+func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
+	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketHandshakeResponseReceived",
+		func(response *Response) {
+			event := &network.WebSocketHandshakeResponseReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebSocketWillSendHandshakeRequest adds a handler to the
+Network.webSocketWillSendHandshakeRequest event.
+
+Fired when WebSocket is about to initiate handshake.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webSocketWillSendHandshakeRequest
+*/
+func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
+	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webSocketWillSendHandshakeRequest",
+		func(response *Response) {
+			event := &network.WebSocketWillSendHandshakeRequestEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportCreated adds a handler to the Network.webTransportCreated event.
+
+Fired upon WebTransport creation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportCreated
+*/
+func (protocol *NetworkProtocol) OnWebTransportCreated(
+	callback func(event *network.WebTransportCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportCreated",
+		func(response *Response) {
+			event := &network.WebTransportCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportConnectionEstablished adds a handler to the
+Network.webTransportConnectionEstablished event.
+
+Fired when WebTransport handshake is finished.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportConnectionEstablished
+*/
+func (protocol *NetworkProtocol) OnWebTransportConnectionEstablished(
+	callback func(event *network.WebTransportConnectionEstablishedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportConnectionEstablished",
+		func(response *Response) {
+			event := &network.WebTransportConnectionEstablishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportClosed adds a handler to the Network.webTransportClosed event.
+
+Fired when WebTransport is disposed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Network/#event-webTransportClosed
+*/
+func (protocol *NetworkProtocol) OnWebTransportClosed(
+	callback func(event *network.WebTransportClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportClosed",
+		func(response *Response) {
+			event := &network.WebTransportClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
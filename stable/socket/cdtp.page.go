@@ -0,0 +1,974 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/page"
+)
+
+/*
+PageProtocol provides a namespace for the Chrome Page protocol methods.
+
+Actions and events related to the inspected page belong to the page domain.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/
+*/
+type PageProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PageProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PageProtocol) WithContext(ctx context.Context) *PageProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PageProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Evaluates given script in every frame upon creation (before loading frame's
+scripts).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-addScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
+	params *page.AddScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnNewDocument", params)
+	result := &page.AddScriptToEvaluateOnNewDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Brings page to front (activates tab).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-bringToFront
+*/
+func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
+	resultChan := make(chan *page.BringToFrontResult, 1)
+	command := NewCommand(protocol.Socket, "Page.bringToFront", nil)
+	result := &page.BringToFrontResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Capture page screenshot.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-captureScreenshot
+*/
+func (protocol *PageProtocol) CaptureScreenshot(
+	params ...*page.CaptureScreenshotParams,
+) <-chan *page.CaptureScreenshotResult {
+	resultChan := make(chan *page.CaptureScreenshotResult, 1)
+	command := NewCommand(protocol.Socket, "Page.captureScreenshot", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.captureScreenshot", params[0])
+	}
+	result := &page.CaptureScreenshotResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates an isolated world for the given frame.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-createIsolatedWorld
+*/
+func (protocol *PageProtocol) CreateIsolatedWorld(
+	params *page.CreateIsolatedWorldParams,
+) <-chan *page.CreateIsolatedWorldResult {
+	resultChan := make(chan *page.CreateIsolatedWorldResult, 1)
+	command := NewCommand(protocol.Socket, "Page.createIsolatedWorld", params)
+	result := &page.CreateIsolatedWorldResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables page domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-disable
+*/
+func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
+	resultChan := make(chan *page.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Page.disable", nil)
+	result := &page.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables page domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-enable
+*/
+func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
+	resultChan := make(chan *page.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Page.enable", nil)
+	result := &page.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets the processed manifest for this current document. This API always waits for
+the manifest to be loaded. If manifestId is provided, and it does not match the
+manifest of the current document, this API errors out. If there is not a loaded
+page, this API errors out immediately.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getAppManifest
+*/
+func (protocol *PageProtocol) GetAppManifest(
+	params ...*page.GetAppManifestParams,
+) <-chan *page.GetAppManifestResult {
+	resultChan := make(chan *page.GetAppManifestResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getAppManifest", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.getAppManifest", params[0])
+	}
+	result := &page.GetAppManifestResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns present frame tree structure.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getFrameTree
+*/
+func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
+	resultChan := make(chan *page.GetFrameTreeResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getFrameTree", nil)
+	result := &page.GetFrameTreeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns metrics relating to the layouting of the page, such as viewport
+bounds/scale.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getLayoutMetrics
+*/
+func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsResult {
+	resultChan := make(chan *page.GetLayoutMetricsResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getLayoutMetrics", nil)
+	result := &page.GetLayoutMetricsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns navigation history for the current page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-getNavigationHistory
+*/
+func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationHistoryResult {
+	resultChan := make(chan *page.GetNavigationHistoryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getNavigationHistory", nil)
+	result := &page.GetNavigationHistoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resets navigation history for the current page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-resetNavigationHistory
+*/
+func (protocol *PageProtocol) ResetNavigationHistory() <-chan *page.ResetNavigationHistoryResult {
+	resultChan := make(chan *page.ResetNavigationHistoryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.resetNavigationHistory", nil)
+	result := &page.ResetNavigationHistoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-handleJavaScriptDialog
+*/
+func (protocol *PageProtocol) HandleJavaScriptDialog(
+	params *page.HandleJavaScriptDialogParams,
+) <-chan *page.HandleJavaScriptDialogResult {
+	resultChan := make(chan *page.HandleJavaScriptDialogResult, 1)
+	command := NewCommand(protocol.Socket, "Page.handleJavaScriptDialog", params)
+	result := &page.HandleJavaScriptDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Navigates current page to the given URL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigate
+*/
+func (protocol *PageProtocol) Navigate(
+	params *page.NavigateParams,
+) <-chan *page.NavigateResult {
+	resultChan := make(chan *page.NavigateResult, 1)
+	command := NewCommand(protocol.Socket, "Page.navigate", params)
+	result := &page.NavigateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Navigates current page to the given history entry.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-navigateToHistoryEntry
+*/
+func (protocol *PageProtocol) NavigateToHistoryEntry(
+	params *page.NavigateToHistoryEntryParams,
+) <-chan *page.NavigateToHistoryEntryResult {
+	resultChan := make(chan *page.NavigateToHistoryEntryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.navigateToHistoryEntry", params)
+	result := &page.NavigateToHistoryEntryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Print page as PDF.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-printToPDF
+*/
+func (protocol *PageProtocol) PrintToPDF(
+	params ...*page.PrintToPDFParams,
+) <-chan *page.PrintToPDFResult {
+	resultChan := make(chan *page.PrintToPDFResult, 1)
+	command := NewCommand(protocol.Socket, "Page.printToPDF", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.printToPDF", params[0])
+	}
+	result := &page.PrintToPDFResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Reloads given page optionally ignoring the cache.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-reload
+*/
+func (protocol *PageProtocol) Reload(
+	params ...*page.ReloadParams,
+) <-chan *page.ReloadResult {
+	resultChan := make(chan *page.ReloadResult, 1)
+	command := NewCommand(protocol.Socket, "Page.reload", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.reload", params[0])
+	}
+	result := &page.ReloadResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes given script from the list.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-removeScriptToEvaluateOnNewDocument
+*/
+func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocument(
+	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
+) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnNewDocument", params)
+	result := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable page Content Security Policy by-passing.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setBypassCSP
+*/
+func (protocol *PageProtocol) SetBypassCSP(
+	params *page.SetBypassCSPParams,
+) <-chan *page.SetBypassCSPResult {
+	resultChan := make(chan *page.SetBypassCSPResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setBypassCSP", params)
+	result := &page.SetBypassCSPResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets given markup as the document's HTML.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setDocumentContent
+*/
+func (protocol *PageProtocol) SetDocumentContent(
+	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	resultChan := make(chan *page.SetDocumentContentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
+	result := &page.SetDocumentContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Controls whether page will emit lifecycle events.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setLifecycleEventsEnabled
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabled(
+	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	resultChan := make(chan *page.SetLifecycleEventsEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
+	result := &page.SetLifecycleEventsEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Force the page stop all navigations and pending resource fetches.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-stopLoading
+*/
+func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
+	resultChan := make(chan *page.StopLoadingResult, 1)
+	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
+	result := &page.StopLoadingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Tries to close page, running its beforeunload hooks, if any.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-close
+*/
+func (protocol *PageProtocol) Close() <-chan *page.CloseResult {
+	resultChan := make(chan *page.CloseResult, 1)
+	command := NewCommand(protocol.Socket, "Page.close", nil)
+	result := &page.CloseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Intercept file chooser requests and transfer control to protocol clients. When
+file chooser interception is enabled, native file chooser dialog is not shown.
+Instead, a protocol event `Page.fileChooserOpened` is emitted.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#method-setInterceptFileChooserDialog
+*/
+func (protocol *PageProtocol) SetInterceptFileChooserDialog(
+	params *page.SetInterceptFileChooserDialogParams,
+) <-chan *page.SetInterceptFileChooserDialogResult {
+	resultChan := make(chan *page.SetInterceptFileChooserDialogResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setInterceptFileChooserDialog", params)
+	result := &page.SetInterceptFileChooserDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDOMContentEventFired adds a handler to the Page.domContentEventFired event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-domContentEventFired
+*/
+func (protocol *PageProtocol) OnDOMContentEventFired(
+	callback func(event *page.DOMContentEventFiredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.domContentEventFired",
+		func(response *Response) {
+			event := &page.DOMContentEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFileChooserOpened adds a handler to the Page.fileChooserOpened event.
+
+Emitted only when `page.interceptFileChooser` is enabled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-fileChooserOpened
+*/
+func (protocol *PageProtocol) OnFileChooserOpened(
+	callback func(event *page.FileChooserOpenedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.fileChooserOpened",
+		func(response *Response) {
+			event := &page.FileChooserOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameAttached adds a handler to the Page.frameAttached event.
+
+Fired when frame has been attached to its parent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameAttached
+*/
+func (protocol *PageProtocol) OnFrameAttached(
+	callback func(event *page.FrameAttachedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameAttached",
+		func(response *Response) {
+			event := &page.FrameAttachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameDetached adds a handler to the Page.frameDetached event.
+
+Fired when frame has been detached from its parent.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameDetached
+*/
+func (protocol *PageProtocol) OnFrameDetached(
+	callback func(event *page.FrameDetachedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameDetached",
+		func(response *Response) {
+			event := &page.FrameDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameNavigated adds a handler to the Page.frameNavigated event.
+
+Fired once navigation of the frame has completed. Frame is now associated with
+the new loader.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-frameNavigated
+*/
+func (protocol *PageProtocol) OnFrameNavigated(
+	callback func(event *page.FrameNavigatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameNavigated",
+		func(response *Response) {
+			event := &page.FrameNavigatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialHidden adds a handler to the Page.interstitialHidden event.
+
+# Fired when interstitial page was hidden
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialHidden
+*/
+func (protocol *PageProtocol) OnInterstitialHidden(
+	callback func(event *page.InterstitialHiddenEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.interstitialHidden",
+		func(response *Response) {
+			event := &page.InterstitialHiddenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInterstitialShown adds a handler to the Page.interstitialShown event.
+
+# Fired when interstitial page was shown
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-interstitialShown
+*/
+func (protocol *PageProtocol) OnInterstitialShown(
+	callback func(event *page.InterstitialShownEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.interstitialShown",
+		func(response *Response) {
+			event := &page.InterstitialShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogClosed adds a handler to the Page.javascriptDialogClosed
+event.
+
+Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) has been closed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogClosed
+*/
+func (protocol *PageProtocol) OnJavascriptDialogClosed(
+	callback func(event *page.JavascriptDialogClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.javascriptDialogClosed",
+		func(response *Response) {
+			event := &page.JavascriptDialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnJavascriptDialogOpening adds a handler to the Page.javascriptDialogOpening
+event.
+
+Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) is about to open.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-javascriptDialogOpening
+*/
+func (protocol *PageProtocol) OnJavascriptDialogOpening(
+	callback func(event *page.JavascriptDialogOpeningEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.javascriptDialogOpening",
+		func(response *Response) {
+			event := &page.JavascriptDialogOpeningEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnLifecycleEvent adds a handler to the Page.lifecycleEvent event.
+
+Fired for lifecycle events (navigation, load, paint, etc) in the current target
+(including local frames).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-lifecycleEvent
+*/
+func (protocol *PageProtocol) OnLifecycleEvent(
+	callback func(event *page.LifecycleEventEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.lifecycleEvent",
+		func(response *Response) {
+			event := &page.LifecycleEventEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnLoadEventFired adds a handler to the Page.loadEventFired event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-loadEventFired
+*/
+func (protocol *PageProtocol) OnLoadEventFired(
+	callback func(event *page.LoadEventFiredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.loadEventFired",
+		func(response *Response) {
+			event := &page.LoadEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWindowOpen adds a handler to the Page.windowOpen event.
+
+Fired when a new window is going to be opened, via window.open(), link click,
+form submission, etc.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Page/#event-windowOpen
+*/
+func (protocol *PageProtocol) OnWindowOpen(
+	callback func(event *page.WindowOpenEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.windowOpen",
+		func(response *Response) {
+			event := &page.WindowOpenEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,150 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/performance"
+)
+
+/*
+PerformanceProtocol provides a namespace for the Chrome Performance protocol
+methods.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/
+*/
+type PerformanceProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PerformanceProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PerformanceProtocol) WithContext(ctx context.Context) *PerformanceProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PerformanceProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disable collecting and reporting metrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-disable
+*/
+func (protocol *PerformanceProtocol) Disable() <-chan *performance.DisableResult {
+	resultChan := make(chan *performance.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Performance.disable", nil)
+	result := &performance.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable collecting and reporting metrics.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-enable
+*/
+func (protocol *PerformanceProtocol) Enable(
+	params ...*performance.EnableParams,
+) <-chan *performance.EnableResult {
+	resultChan := make(chan *performance.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Performance.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Performance.enable", params[0])
+	}
+	result := &performance.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieve current values of run-time metrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#method-getMetrics
+*/
+func (protocol *PerformanceProtocol) GetMetrics() <-chan *performance.GetMetricsResult {
+	resultChan := make(chan *performance.GetMetricsResult, 1)
+	command := NewCommand(protocol.Socket, "Performance.getMetrics", nil)
+	result := &performance.GetMetricsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnMetrics adds a handler to the Performance.metrics event.
+
+Current values of the metrics.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Performance/#event-metrics
+*/
+func (protocol *PerformanceProtocol) OnMetrics(
+	callback func(event *performance.MetricsEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Performance.metrics",
+		func(response *Response) {
+			event := &performance.MetricsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,332 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/profiler"
+)
+
+/*
+ProfilerProtocol provides a namespace for the Chrome Profiler protocol methods.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/
+*/
+type ProfilerProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ProfilerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ProfilerProtocol) WithContext(ctx context.Context) *ProfilerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ProfilerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disable sends the Profiler.disable command.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-disable
+*/
+func (protocol *ProfilerProtocol) Disable() <-chan *profiler.DisableResult {
+	resultChan := make(chan *profiler.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.disable", nil)
+	result := &profiler.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable sends the Profiler.enable command.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-enable
+*/
+func (protocol *ProfilerProtocol) Enable() <-chan *profiler.EnableResult {
+	resultChan := make(chan *profiler.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.enable", nil)
+	result := &profiler.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Collect coverage data for the current isolate. The coverage data may be
+incomplete due to garbage collection.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-getBestEffortCoverage
+*/
+func (protocol *ProfilerProtocol) GetBestEffortCoverage() <-chan *profiler.GetBestEffortCoverageResult {
+	resultChan := make(chan *profiler.GetBestEffortCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.getBestEffortCoverage", nil)
+	result := &profiler.GetBestEffortCoverageResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Changes CPU profiler sampling interval. Must be called before CPU profiles
+recording started.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-setSamplingInterval
+*/
+func (protocol *ProfilerProtocol) SetSamplingInterval(
+	params *profiler.SetSamplingIntervalParams,
+) <-chan *profiler.SetSamplingIntervalResult {
+	resultChan := make(chan *profiler.SetSamplingIntervalResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.setSamplingInterval", params)
+	result := &profiler.SetSamplingIntervalResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Start sends the Profiler.start command.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-start
+*/
+func (protocol *ProfilerProtocol) Start() <-chan *profiler.StartResult {
+	resultChan := make(chan *profiler.StartResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.start", nil)
+	result := &profiler.StartResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable precise code coverage. Coverage data for JavaScript executed before
+enabling precise code coverage may be incomplete. Enabling prevents running
+optimized code and resets execution counters.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-startPreciseCoverage
+*/
+func (protocol *ProfilerProtocol) StartPreciseCoverage(
+	params ...*profiler.StartPreciseCoverageParams,
+) <-chan *profiler.StartPreciseCoverageResult {
+	resultChan := make(chan *profiler.StartPreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", params[0])
+	}
+	result := &profiler.StartPreciseCoverageResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stop sends the Profiler.stop command.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-stop
+*/
+func (protocol *ProfilerProtocol) Stop() <-chan *profiler.StopResult {
+	resultChan := make(chan *profiler.StopResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.stop", nil)
+	result := &profiler.StopResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable precise code coverage. Disabling releases unnecessary execution count
+records and allows executing optimized code.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-stopPreciseCoverage
+*/
+func (protocol *ProfilerProtocol) StopPreciseCoverage() <-chan *profiler.StopPreciseCoverageResult {
+	resultChan := make(chan *profiler.StopPreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.stopPreciseCoverage", nil)
+	result := &profiler.StopPreciseCoverageResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Collect coverage data for the current isolate, and resets execution counters.
+Precise code coverage needs to have started.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#method-takePreciseCoverage
+*/
+func (protocol *ProfilerProtocol) TakePreciseCoverage() <-chan *profiler.TakePreciseCoverageResult {
+	resultChan := make(chan *profiler.TakePreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.takePreciseCoverage", nil)
+	result := &profiler.TakePreciseCoverageResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnConsoleProfileFinished adds a handler to the Profiler.consoleProfileFinished
+event.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#event-consoleProfileFinished
+*/
+func (protocol *ProfilerProtocol) OnConsoleProfileFinished(
+	callback func(event *profiler.ConsoleProfileFinishedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Profiler.consoleProfileFinished",
+		func(response *Response) {
+			event := &profiler.ConsoleProfileFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnConsoleProfileStarted adds a handler to the Profiler.consoleProfileStarted
+event.
+
+Sent when new profile recording is started using console.profile() call.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Profiler/#event-consoleProfileStarted
+*/
+func (protocol *ProfilerProtocol) OnConsoleProfileStarted(
+	callback func(event *profiler.ConsoleProfileStartedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Profiler.consoleProfileStarted",
+		func(response *Response) {
+			event := &profiler.ConsoleProfileStartedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,693 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/runtime"
+)
+
+/*
+RuntimeProtocol provides a namespace for the Chrome Runtime protocol methods.
+
+Runtime domain exposes JavaScript runtime by means of remote evaluation and
+mirror objects. Evaluation results are returned as mirror object that expose
+object type, string representation and unique identifier that can be used for
+further object reference. Original objects are maintained in memory unless they
+are either explicitly released or are released along with the other objects in
+their object group.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/
+*/
+type RuntimeProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *RuntimeProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *RuntimeProtocol) WithContext(ctx context.Context) *RuntimeProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &RuntimeProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Add handler to promise with given promise object id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-awaitPromise
+*/
+func (protocol *RuntimeProtocol) AwaitPromise(
+	params *runtime.AwaitPromiseParams,
+) <-chan *runtime.AwaitPromiseResult {
+	resultChan := make(chan *runtime.AwaitPromiseResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.awaitPromise", params)
+	result := &runtime.AwaitPromiseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Calls function with given declaration on the given object. Object group of the
+result is inherited from the target object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-callFunctionOn
+*/
+func (protocol *RuntimeProtocol) CallFunctionOn(
+	params *runtime.CallFunctionOnParams,
+) <-chan *runtime.CallFunctionOnResult {
+	resultChan := make(chan *runtime.CallFunctionOnResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.callFunctionOn", params)
+	result := &runtime.CallFunctionOnResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Compiles expression.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-compileScript
+*/
+func (protocol *RuntimeProtocol) CompileScript(
+	params *runtime.CompileScriptParams,
+) <-chan *runtime.CompileScriptResult {
+	resultChan := make(chan *runtime.CompileScriptResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.compileScript", params)
+	result := &runtime.CompileScriptResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables reporting of execution contexts creation.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-disable
+*/
+func (protocol *RuntimeProtocol) Disable() <-chan *runtime.DisableResult {
+	resultChan := make(chan *runtime.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.disable", nil)
+	result := &runtime.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Discards collected exceptions and console API calls.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-discardConsoleEntries
+*/
+func (protocol *RuntimeProtocol) DiscardConsoleEntries() <-chan *runtime.DiscardConsoleEntriesResult {
+	resultChan := make(chan *runtime.DiscardConsoleEntriesResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.discardConsoleEntries", nil)
+	result := &runtime.DiscardConsoleEntriesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables reporting of execution contexts creation by means of
+`executionContextCreated` event. When the reporting gets enabled the event will
+be sent immediately for each existing execution context.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-enable
+*/
+func (protocol *RuntimeProtocol) Enable() <-chan *runtime.EnableResult {
+	resultChan := make(chan *runtime.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.enable", nil)
+	result := &runtime.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Evaluates expression on global object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-evaluate
+*/
+func (protocol *RuntimeProtocol) Evaluate(
+	params *runtime.EvaluateParams,
+) <-chan *runtime.EvaluateResult {
+	resultChan := make(chan *runtime.EvaluateResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.evaluate", params)
+	result := &runtime.EvaluateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns properties of a given object. Object group of the result is inherited
+from the target object.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-getProperties
+*/
+func (protocol *RuntimeProtocol) GetProperties(
+	params *runtime.GetPropertiesParams,
+) <-chan *runtime.GetPropertiesResult {
+	resultChan := make(chan *runtime.GetPropertiesResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.getProperties", params)
+	result := &runtime.GetPropertiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all let, const and class variables from global scope.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-globalLexicalScopeNames
+*/
+func (protocol *RuntimeProtocol) GlobalLexicalScopeNames(
+	params ...*runtime.GlobalLexicalScopeNamesParams,
+) <-chan *runtime.GlobalLexicalScopeNamesResult {
+	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", params[0])
+	}
+	result := &runtime.GlobalLexicalScopeNamesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+QueryObjects sends the Runtime.queryObjects command.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-queryObjects
+*/
+func (protocol *RuntimeProtocol) QueryObjects(
+	params *runtime.QueryObjectsParams,
+) <-chan *runtime.QueryObjectsResult {
+	resultChan := make(chan *runtime.QueryObjectsResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.queryObjects", params)
+	result := &runtime.QueryObjectsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Releases remote object with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-releaseObject
+*/
+func (protocol *RuntimeProtocol) ReleaseObject(
+	params *runtime.ReleaseObjectParams,
+) <-chan *runtime.ReleaseObjectResult {
+	resultChan := make(chan *runtime.ReleaseObjectResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.releaseObject", params)
+	result := &runtime.ReleaseObjectResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Releases all remote objects that belong to a given group.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-releaseObjectGroup
+*/
+func (protocol *RuntimeProtocol) ReleaseObjectGroup(
+	params *runtime.ReleaseObjectGroupParams,
+) <-chan *runtime.ReleaseObjectGroupResult {
+	resultChan := make(chan *runtime.ReleaseObjectGroupResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.releaseObjectGroup", params)
+	result := &runtime.ReleaseObjectGroupResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Tells inspected instance to run if it was waiting for debugger to attach.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-runIfWaitingForDebugger
+*/
+func (protocol *RuntimeProtocol) RunIfWaitingForDebugger() <-chan *runtime.RunIfWaitingForDebuggerResult {
+	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.runIfWaitingForDebugger", nil)
+	result := &runtime.RunIfWaitingForDebuggerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Runs script with given id in a given context.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-runScript
+*/
+func (protocol *RuntimeProtocol) RunScript(
+	params *runtime.RunScriptParams,
+) <-chan *runtime.RunScriptResult {
+	resultChan := make(chan *runtime.RunScriptResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.runScript", params)
+	result := &runtime.RunScriptResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables or disables async call stacks tracking.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-setAsyncCallStackDepth
+*/
+func (protocol *RuntimeProtocol) SetAsyncCallStackDepth(
+	params *runtime.SetAsyncCallStackDepthParams,
+) <-chan *runtime.SetAsyncCallStackDepthResult {
+	resultChan := make(chan *runtime.SetAsyncCallStackDepthResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.setAsyncCallStackDepth", params)
+	result := &runtime.SetAsyncCallStackDepthResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+If executionContextId is empty, adds binding with the given name on the global
+objects of all inspected contexts, including those created later, bindings
+survive reloads. Binding function takes exactly one argument, this argument
+should be string, in case of any other input, function throws an exception. Each
+binding function call produces Runtime.bindingCalled notification.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-addBinding
+*/
+func (protocol *RuntimeProtocol) AddBinding(
+	params *runtime.AddBindingParams,
+) <-chan *runtime.AddBindingResult {
+	resultChan := make(chan *runtime.AddBindingResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.addBinding", params)
+	result := &runtime.AddBindingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+This method does not remove binding function from global object but unsubscribes
+current runtime agent from Runtime.bindingCalled notifications.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#method-removeBinding
+*/
+func (protocol *RuntimeProtocol) RemoveBinding(
+	params *runtime.RemoveBindingParams,
+) <-chan *runtime.RemoveBindingResult {
+	resultChan := make(chan *runtime.RemoveBindingResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.removeBinding", params)
+	result := &runtime.RemoveBindingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnConsoleAPICalled adds a handler to the Runtime.consoleAPICalled event.
+
+Issued when console API was called.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-consoleAPICalled
+*/
+func (protocol *RuntimeProtocol) OnConsoleAPICalled(
+	callback func(event *runtime.ConsoleAPICalledEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.consoleAPICalled",
+		func(response *Response) {
+			event := &runtime.ConsoleAPICalledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnExceptionRevoked adds a handler to the Runtime.exceptionRevoked event.
+
+Issued when unhandled exception was revoked.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-exceptionRevoked
+*/
+func (protocol *RuntimeProtocol) OnExceptionRevoked(
+	callback func(event *runtime.ExceptionRevokedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.exceptionRevoked",
+		func(response *Response) {
+			event := &runtime.ExceptionRevokedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnExceptionThrown adds a handler to the Runtime.exceptionThrown event.
+
+Issued when exception was thrown and unhandled.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-exceptionThrown
+*/
+func (protocol *RuntimeProtocol) OnExceptionThrown(
+	callback func(event *runtime.ExceptionThrownEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.exceptionThrown",
+		func(response *Response) {
+			event := &runtime.ExceptionThrownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextCreated adds a handler to the Runtime.executionContextCreated
+event.
+
+Issued when new execution context is created.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextCreated
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextCreated(
+	callback func(event *runtime.ExecutionContextCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.executionContextCreated",
+		func(response *Response) {
+			event := &runtime.ExecutionContextCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextDestroyed adds a handler to the
+Runtime.executionContextDestroyed event.
+
+Issued when execution context is destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextDestroyed
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
+	callback func(event *runtime.ExecutionContextDestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.executionContextDestroyed",
+		func(response *Response) {
+			event := &runtime.ExecutionContextDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnExecutionContextsCleared adds a handler to the
+Runtime.executionContextsCleared event.
+
+# Issued when all executionContexts were cleared in browser
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-executionContextsCleared
+*/
+func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
+	callback func(event *runtime.ExecutionContextsClearedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.executionContextsCleared",
+		func(response *Response) {
+			event := &runtime.ExecutionContextsClearedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInspectRequested adds a handler to the Runtime.inspectRequested event.
+
+Issued when object should be inspected (for example, as a result of inspect()
+command line API call).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Runtime/#event-inspectRequested
+*/
+func (protocol *RuntimeProtocol) OnInspectRequested(
+	callback func(event *runtime.InspectRequestedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.inspectRequested",
+		func(response *Response) {
+			event := &runtime.InspectRequestedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,118 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/stable/security"
+)
+
+/*
+SecurityProtocol provides a namespace for the Chrome Security protocol methods.
+
+# Security
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/
+*/
+type SecurityProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *SecurityProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *SecurityProtocol) WithContext(ctx context.Context) *SecurityProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &SecurityProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disables tracking security state changes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-disable
+*/
+func (protocol *SecurityProtocol) Disable() <-chan *security.DisableResult {
+	resultChan := make(chan *security.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Security.disable", nil)
+	result := &security.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables tracking security state changes.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-enable
+*/
+func (protocol *SecurityProtocol) Enable() <-chan *security.EnableResult {
+	resultChan := make(chan *security.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Security.enable", nil)
+	result := &security.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable/disable whether all certificate errors should be ignored.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Security/#method-setIgnoreCertificateErrors
+*/
+func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
+	params *security.SetIgnoreCertificateErrorsParams,
+) <-chan *security.SetIgnoreCertificateErrorsResult {
+	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult, 1)
+	command := NewCommand(protocol.Socket, "Security.setIgnoreCertificateErrors", params)
+	result := &security.SetIgnoreCertificateErrorsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,477 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/target"
+)
+
+/*
+TargetProtocol provides a namespace for the Chrome Target protocol methods.
+
+Supports additional targets discovery and allows to attach to them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/
+*/
+type TargetProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *TargetProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *TargetProtocol) WithContext(ctx context.Context) *TargetProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &TargetProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Activates (focuses) the target.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-activateTarget
+*/
+func (protocol *TargetProtocol) ActivateTarget(
+	params *target.ActivateTargetParams,
+) <-chan *target.ActivateTargetResult {
+	resultChan := make(chan *target.ActivateTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.activateTarget", params)
+	result := &target.ActivateTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Attaches to the target with given id.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-attachToTarget
+*/
+func (protocol *TargetProtocol) AttachToTarget(
+	params *target.AttachToTargetParams,
+) <-chan *target.AttachToTargetResult {
+	resultChan := make(chan *target.AttachToTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.attachToTarget", params)
+	result := &target.AttachToTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Closes the target. If the target is a page that gets closed too.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-closeTarget
+*/
+func (protocol *TargetProtocol) CloseTarget(
+	params *target.CloseTargetParams,
+) <-chan *target.CloseTargetResult {
+	resultChan := make(chan *target.CloseTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.closeTarget", params)
+	result := &target.CloseTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates a new empty BrowserContext. Similar to an incognito profile but you can
+have more than one.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createBrowserContext
+*/
+func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrowserContextResult {
+	resultChan := make(chan *target.CreateBrowserContextResult, 1)
+	command := NewCommand(protocol.Socket, "Target.createBrowserContext", nil)
+	result := &target.CreateBrowserContextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all browser contexts created with `Target.createBrowserContext` method.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getBrowserContexts
+*/
+func (protocol *TargetProtocol) GetBrowserContexts() <-chan *target.GetBrowserContextsResult {
+	resultChan := make(chan *target.GetBrowserContextsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getBrowserContexts", nil)
+	result := &target.GetBrowserContextsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates a new page.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-createTarget
+*/
+func (protocol *TargetProtocol) CreateTarget(
+	params *target.CreateTargetParams,
+) <-chan *target.CreateTargetResult {
+	resultChan := make(chan *target.CreateTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.createTarget", params)
+	result := &target.CreateTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Detaches session with given id.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-detachFromTarget
+*/
+func (protocol *TargetProtocol) DetachFromTarget(
+	params ...*target.DetachFromTargetParams,
+) <-chan *target.DetachFromTargetResult {
+	resultChan := make(chan *target.DetachFromTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.detachFromTarget", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Target.detachFromTarget", params[0])
+	}
+	result := &target.DetachFromTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Deletes a BrowserContext. All the belonging pages will be closed without calling
+their beforeunload hooks.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-disposeBrowserContext
+*/
+func (protocol *TargetProtocol) DisposeBrowserContext(
+	params *target.DisposeBrowserContextParams,
+) <-chan *target.DisposeBrowserContextResult {
+	resultChan := make(chan *target.DisposeBrowserContextResult, 1)
+	command := NewCommand(protocol.Socket, "Target.disposeBrowserContext", params)
+	result := &target.DisposeBrowserContextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieves a list of available targets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-getTargets
+*/
+func (protocol *TargetProtocol) GetTargets() <-chan *target.GetTargetsResult {
+	resultChan := make(chan *target.GetTargetsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getTargets", nil)
+	result := &target.GetTargetsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Controls whether to automatically attach to new targets which are considered to
+be directly related to this one (for example, iframes or workers). When turned
+on, attaches to all existing related targets as well. When turned off,
+automatically detaches from all currently attached targets. This also clears all
+targets added by `autoAttachRelated` from the list of targets to watch for
+creation of related targets. You might want to call this recursively for
+auto-attached targets to attach to all available targets.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setAutoAttach
+*/
+func (protocol *TargetProtocol) SetAutoAttach(
+	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	resultChan := make(chan *target.SetAutoAttachResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
+	result := &target.SetAutoAttachResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Controls whether to discover available targets and notify via
+`targetCreated/targetInfoChanged/targetDestroyed` events.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#method-setDiscoverTargets
+*/
+func (protocol *TargetProtocol) SetDiscoverTargets(
+	params *target.SetDiscoverTargetsParams,
+) <-chan *target.SetDiscoverTargetsResult {
+	resultChan := make(chan *target.SetDiscoverTargetsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setDiscoverTargets", params)
+	result := &target.SetDiscoverTargetsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnReceivedMessageFromTarget adds a handler to the
+Target.receivedMessageFromTarget event.
+
+Notifies about a new protocol message received from the session (as reported in
+`attachedToTarget` event).
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-receivedMessageFromTarget
+*/
+func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
+	callback func(event *target.ReceivedMessageFromTargetEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.receivedMessageFromTarget",
+		func(response *Response) {
+			event := &target.ReceivedMessageFromTargetEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetCreated adds a handler to the Target.targetCreated event.
+
+Issued when a possible inspection target is created.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCreated
+*/
+func (protocol *TargetProtocol) OnTargetCreated(
+	callback func(event *target.CreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.targetCreated",
+		func(response *Response) {
+			event := &target.CreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetDestroyed adds a handler to the Target.targetDestroyed event.
+
+Issued when a target is destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetDestroyed
+*/
+func (protocol *TargetProtocol) OnTargetDestroyed(
+	callback func(event *target.DestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.targetDestroyed",
+		func(response *Response) {
+			event := &target.DestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetCrashed adds a handler to the Target.targetCrashed event.
+
+Issued when a target has crashed.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCrashed
+*/
+func (protocol *TargetProtocol) OnTargetCrashed(
+	callback func(event *target.CrashedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.targetCrashed",
+		func(response *Response) {
+			event := &target.CrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetInfoChanged adds a handler to the Target.targetInfoChanged event.
+
+Issued when some information about a target has changed. This only happens
+between `targetCreated` and `targetDestroyed`.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetInfoChanged
+*/
+func (protocol *TargetProtocol) OnTargetInfoChanged(
+	callback func(event *target.InfoChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.targetInfoChanged",
+		func(response *Response) {
+			event := &target.InfoChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,124 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/stable/tracing"
+)
+
+/*
+TracingProtocol provides a namespace for the Chrome Tracing protocol methods.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/
+*/
+type TracingProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *TracingProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *TracingProtocol) WithContext(ctx context.Context) *TracingProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &TracingProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Stop trace events collection.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-end
+*/
+func (protocol *TracingProtocol) End() <-chan *tracing.EndResult {
+	resultChan := make(chan *tracing.EndResult, 1)
+	command := NewCommand(protocol.Socket, "Tracing.end", nil)
+	result := &tracing.EndResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Start trace events collection.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-start
+*/
+func (protocol *TracingProtocol) Start(
+	params ...*tracing.StartParams,
+) <-chan *tracing.StartResult {
+	resultChan := make(chan *tracing.StartResult, 1)
+	command := NewCommand(protocol.Socket, "Tracing.start", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Tracing.start", params[0])
+	}
+	result := &tracing.StartResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnTracingComplete adds a handler to the Tracing.tracingComplete event.
+
+Signals that tracing is stopped and there is no trace buffers pending flush, all
+data were delivered via dataCollected events.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#event-tracingComplete
+*/
+func (protocol *TracingProtocol) OnTracingComplete(
+	callback func(event *tracing.CompleteEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Tracing.tracingComplete",
+		func(response *Response) {
+			event := &tracing.CompleteEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,103 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/stable/tracing"
+)
+
+func TestTracingCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestTracingCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Tracing.end", func() func() error {
+			resultChan := mockSocket.Tracing().End()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Tracing.start", func() func() error {
+			resultChan := mockSocket.Tracing().Start(&tracing.StartParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestTracingEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestTracingEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"Tracing.tracingComplete", func(results chan<- error) *Listener {
+			return mockSocket.Tracing().OnTracingComplete(func(event *tracing.CompleteEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+/*
+Protocoller defines the Chrome DevTools Protocol API methods
+
+https://chromedevtools.github.io/devtools-protocol/
+*/
+type Protocoller interface {
+	// Browser returns the BrowserProtocol instance.
+	Browser() *BrowserProtocol
+
+	// DOM returns the DOMProtocol instance.
+	DOM() *DOMProtocol
+
+	// DOMDebugger returns the DOMDebuggerProtocol instance.
+	DOMDebugger() *DOMDebuggerProtocol
+
+	// Debugger returns the DebuggerProtocol instance.
+	Debugger() *DebuggerProtocol
+
+	// Emulation returns the EmulationProtocol instance.
+	Emulation() *EmulationProtocol
+
+	// Fetch returns the FetchProtocol instance.
+	Fetch() *FetchProtocol
+
+	// IO returns the IOProtocol instance.
+	IO() *IOProtocol
+
+	// Input returns the InputProtocol instance.
+	Input() *InputProtocol
+
+	// Log returns the LogProtocol instance.
+	Log() *LogProtocol
+
+	// Network returns the NetworkProtocol instance.
+	Network() *NetworkProtocol
+
+	// Page returns the PageProtocol instance.
+	Page() *PageProtocol
+
+	// Performance returns the PerformanceProtocol instance.
+	Performance() *PerformanceProtocol
+
+	// Profiler returns the ProfilerProtocol instance.
+	Profiler() *ProfilerProtocol
+
+	// Runtime returns the RuntimeProtocol instance.
+	Runtime() *RuntimeProtocol
+
+	// Security returns the SecurityProtocol instance.
+	Security() *SecurityProtocol
+
+	// Target returns the TargetProtocol instance.
+	Target() *TargetProtocol
+
+	// Tracing returns the TracingProtocol instance.
+	Tracing() *TracingProtocol
+}
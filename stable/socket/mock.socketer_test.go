@@ -0,0 +1,43 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/bdlm/log"
+)
+
+func init() {
+	log.SetLevel(log.DebugLevel)
+	log.SetFormatter(&log.TextFormatter{
+		ForceTTY: true,
+	})
+}
+
+/*
+NewMock returns a Chromium Socketer mock for unit testing
+*/
+func NewMock(socketURL *url.URL) *Socket {
+	ctx, cancel := context.WithCancel(context.Background())
+	socket := &Socket{
+		commandIDMux: &sync.Mutex{},
+		commands:     NewCommandMap(),
+		domains:      make([]*Payload, 0),
+		handlers:     NewEventHandlerMap(),
+		mux:          &sync.Mutex{},
+		newSocket:    NewMockWebsocket,
+		socketID:     NextSocketID(),
+		url:          socketURL,
+		writeMux:     &sync.Mutex{},
+
+		ctx:    ctx,
+		cancel: cancel,
+		wg:     &sync.WaitGroup{},
+	}
+	log.Debugf("Created socket #%d", socket.socketID)
+
+	return socket
+}
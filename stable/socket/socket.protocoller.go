@@ -0,0 +1,156 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+/*
+Browser returns the BrowserProtocol instance.
+
+Browser is a Protocoller implementation.
+*/
+func (socket *Socket) Browser() *BrowserProtocol {
+	return &BrowserProtocol{Socket: socket}
+}
+
+/*
+DOM returns the DOMProtocol instance.
+
+DOM is a Protocoller implementation.
+*/
+func (socket *Socket) DOM() *DOMProtocol {
+	return &DOMProtocol{Socket: socket}
+}
+
+/*
+DOMDebugger returns the DOMDebuggerProtocol instance.
+
+DOMDebugger is a Protocoller implementation.
+*/
+func (socket *Socket) DOMDebugger() *DOMDebuggerProtocol {
+	return &DOMDebuggerProtocol{Socket: socket}
+}
+
+/*
+Debugger returns the DebuggerProtocol instance.
+
+Debugger is a Protocoller implementation.
+*/
+func (socket *Socket) Debugger() *DebuggerProtocol {
+	return &DebuggerProtocol{Socket: socket}
+}
+
+/*
+Emulation returns the EmulationProtocol instance.
+
+Emulation is a Protocoller implementation.
+*/
+func (socket *Socket) Emulation() *EmulationProtocol {
+	return &EmulationProtocol{Socket: socket}
+}
+
+/*
+Fetch returns the FetchProtocol instance.
+
+Fetch is a Protocoller implementation.
+*/
+func (socket *Socket) Fetch() *FetchProtocol {
+	return &FetchProtocol{Socket: socket}
+}
+
+/*
+IO returns the IOProtocol instance.
+
+IO is a Protocoller implementation.
+*/
+func (socket *Socket) IO() *IOProtocol {
+	return &IOProtocol{Socket: socket}
+}
+
+/*
+Input returns the InputProtocol instance.
+
+Input is a Protocoller implementation.
+*/
+func (socket *Socket) Input() *InputProtocol {
+	return &InputProtocol{Socket: socket}
+}
+
+/*
+Log returns the LogProtocol instance.
+
+Log is a Protocoller implementation.
+*/
+func (socket *Socket) Log() *LogProtocol {
+	return &LogProtocol{Socket: socket}
+}
+
+/*
+Network returns the NetworkProtocol instance.
+
+Network is a Protocoller implementation.
+*/
+func (socket *Socket) Network() *NetworkProtocol {
+	return &NetworkProtocol{Socket: socket}
+}
+
+/*
+Page returns the PageProtocol instance.
+
+Page is a Protocoller implementation.
+*/
+func (socket *Socket) Page() *PageProtocol {
+	return &PageProtocol{Socket: socket}
+}
+
+/*
+Performance returns the PerformanceProtocol instance.
+
+Performance is a Protocoller implementation.
+*/
+func (socket *Socket) Performance() *PerformanceProtocol {
+	return &PerformanceProtocol{Socket: socket}
+}
+
+/*
+Profiler returns the ProfilerProtocol instance.
+
+Profiler is a Protocoller implementation.
+*/
+func (socket *Socket) Profiler() *ProfilerProtocol {
+	return &ProfilerProtocol{Socket: socket}
+}
+
+/*
+Runtime returns the RuntimeProtocol instance.
+
+Runtime is a Protocoller implementation.
+*/
+func (socket *Socket) Runtime() *RuntimeProtocol {
+	return &RuntimeProtocol{Socket: socket}
+}
+
+/*
+Security returns the SecurityProtocol instance.
+
+Security is a Protocoller implementation.
+*/
+func (socket *Socket) Security() *SecurityProtocol {
+	return &SecurityProtocol{Socket: socket}
+}
+
+/*
+Target returns the TargetProtocol instance.
+
+Target is a Protocoller implementation.
+*/
+func (socket *Socket) Target() *TargetProtocol {
+	return &TargetProtocol{Socket: socket}
+}
+
+/*
+Tracing returns the TracingProtocol instance.
+
+Tracing is a Protocoller implementation.
+*/
+func (socket *Socket) Tracing() *TracingProtocol {
+	return &TracingProtocol{Socket: socket}
+}
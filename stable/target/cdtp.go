@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package target provides type definitions for use with the Chrome Target protocol
+
+Supports additional targets discovery and allows to attach to them.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/
+*/
+package target
+
+/*
+ID represents the Target.TargetID type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-TargetID
+*/
+type ID string
+
+/*
+SessionID represents the Target.SessionID type.
+
+Unique identifier of attached debugging session.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-SessionID
+*/
+type SessionID string
+
+/*
+Info represents the Target.TargetInfo type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-TargetInfo
+*/
+type Info struct {
+	ID ID `json:"targetId"`
+
+	// List of types:
+	// https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
+	Type string `json:"type"`
+
+	Title string `json:"title"`
+
+	URL string `json:"url"`
+
+	// Whether the target has an attached client.
+	Attached bool `json:"attached"`
+
+	// Optional. Opener target Id
+	OpenerID ID `json:"openerId,omitempty"`
+}
+
+/*
+FilterEntry represents the Target.FilterEntry type.
+
+A filter used by target query/discovery/auto-attach operations. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-FilterEntry
+*/
+type FilterEntry struct {
+	// Optional. If set, causes exclusion of matching targets from the list.
+	Exclude bool `json:"exclude,omitempty"`
+
+	// Optional. If not present, matches any type.
+	Type string `json:"type,omitempty"`
+}
+
+/*
+Filter represents the Target.TargetFilter type.
+
+The entries in TargetFilter are matched sequentially against targets and the
+first entry that matches determines if the target is included or not, depending
+on the value of `exclude` field in the entry. If filter is not specified, the
+one assumed is [{type: "browser", exclude: true}, {type: "tab", exclude: true},
+{}] (i.e. include everything but `browser` and `tab`). EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-TargetFilter
+*/
+type Filter []*FilterEntry
+
+/*
+RemoteLocation represents the Target.RemoteLocation type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#type-RemoteLocation
+*/
+type RemoteLocation struct {
+	Host string `json:"host"`
+
+	Port int `json:"port"`
+}
@@ -0,0 +1,72 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package target
+
+/*
+ReceivedMessageFromTargetEvent represents Target.receivedMessageFromTarget event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-receivedMessageFromTarget
+*/
+type ReceivedMessageFromTargetEvent struct {
+	// Identifier of a session which sends a message.
+	SessionID SessionID `json:"sessionId"`
+
+	Message string `json:"message"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CreatedEvent represents Target.targetCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCreated
+*/
+type CreatedEvent struct {
+	Info *Info `json:"targetInfo"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DestroyedEvent represents Target.targetDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetDestroyed
+*/
+type DestroyedEvent struct {
+	ID ID `json:"targetId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CrashedEvent represents Target.targetCrashed event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetCrashed
+*/
+type CrashedEvent struct {
+	TargetID ID `json:"targetId"`
+
+	// Termination status type.
+	Status string `json:"status"`
+
+	// Termination error code.
+	ErrorCode int `json:"errorCode"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InfoChangedEvent represents Target.targetInfoChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Target/#event-targetInfoChanged
+*/
+type InfoChangedEvent struct {
+	Info *Info `json:"targetInfo"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,31 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package tracing provides type definitions for use with the Chrome Tracing protocol
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/
+*/
+package tracing
+
+/*
+MemoryDumpConfig represents the Tracing.MemoryDumpConfig type.
+
+Configuration for memory dump. Used only when "memory-infra" category is
+enabled. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#type-MemoryDumpConfig
+*/
+type MemoryDumpConfig map[string]interface{}
+
+/*
+TraceConfig represents the Tracing.TraceConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#type-TraceConfig
+*/
+type TraceConfig struct {
+	// Optional. Included category filters.
+	IncludedCategories []string `json:"includedCategories,omitempty"`
+
+	// Optional. Excluded category filters.
+	ExcludedCategories []string `json:"excludedCategories,omitempty"`
+}
@@ -0,0 +1,45 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+/*
+EndResult represents the result of calls to Tracing.end.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-end
+*/
+type EndResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StartParams represents Tracing.start parameters.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-start
+*/
+type StartParams struct {
+	// Optional. Whether to report trace events as series of dataCollected events
+	// or to save trace to a stream (defaults to `ReportEvents`). Allowed values:
+	//   - TransferMode.ReportEvents
+	//   - TransferMode.ReturnAsStream
+	TransferMode TransferModeEnum `json:"transferMode,omitempty"`
+
+	// Optional. Trace data format to use. This only applies when using
+	// `ReturnAsStream` transfer mode (defaults to `json`). Allowed values:
+	//   - StreamFormat.JSON
+	//   - StreamFormat.Proto
+	StreamFormat StreamFormatEnum `json:"streamFormat,omitempty"`
+
+	// Optional.
+	TraceConfig *TraceConfig `json:"traceConfig,omitempty"`
+}
+
+/*
+StartResult represents the result of calls to Tracing.start.
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-start
+*/
+type StartResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,92 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type memoryDumpLevelOfDetailEnum struct {
+	Background MemoryDumpLevelOfDetailEnum
+	Light      MemoryDumpLevelOfDetailEnum
+	Detailed   MemoryDumpLevelOfDetailEnum
+}
+
+/*
+MemoryDumpLevelOfDetail provides named access to the MemoryDumpLevelOfDetailEnum values.
+*/
+var MemoryDumpLevelOfDetail = memoryDumpLevelOfDetailEnum{
+	Background: memoryDumpLevelOfDetailBackground,
+	Light:      memoryDumpLevelOfDetailLight,
+	Detailed:   memoryDumpLevelOfDetailDetailed,
+}
+
+/*
+MemoryDumpLevelOfDetailEnum represents the Tracing.MemoryDumpLevelOfDetail
+values.
+
+Details exposed when memory request explicitly declared. Keep consistent with
+memory_dump_request_args.h and memory_instrumentation.mojom
+
+Allowed values:
+  - MemoryDumpLevelOfDetail.Background "background"
+  - MemoryDumpLevelOfDetail.Light      "light"
+  - MemoryDumpLevelOfDetail.Detailed   "detailed"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#type-MemoryDumpLevelOfDetail
+*/
+type MemoryDumpLevelOfDetailEnum int
+
+/*
+String implements Stringer
+*/
+func (enum MemoryDumpLevelOfDetailEnum) String() string {
+	return _memoryDumpLevelOfDetailEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MemoryDumpLevelOfDetailEnum) Unknown() bool {
+	return !_memoryDumpLevelOfDetailEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum MemoryDumpLevelOfDetailEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *MemoryDumpLevelOfDetailEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _memoryDumpLevelOfDetailEnums.Parse(val)
+	return nil
+}
+
+const (
+	// memoryDumpLevelOfDetailBackground represents the "background" value.
+	memoryDumpLevelOfDetailBackground MemoryDumpLevelOfDetailEnum = iota + 1
+	// memoryDumpLevelOfDetailLight represents the "light" value.
+	memoryDumpLevelOfDetailLight
+	// memoryDumpLevelOfDetailDetailed represents the "detailed" value.
+	memoryDumpLevelOfDetailDetailed
+)
+
+var _memoryDumpLevelOfDetailEnums = enums.New(map[MemoryDumpLevelOfDetailEnum]string{
+	MemoryDumpLevelOfDetailEnum(0):    "",
+	memoryDumpLevelOfDetailBackground: "background",
+	memoryDumpLevelOfDetailLight:      "light",
+	memoryDumpLevelOfDetailDetailed:   "detailed",
+})
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type streamCompressionEnum struct {
+	None StreamCompressionEnum
+	Gzip StreamCompressionEnum
+}
+
+/*
+StreamCompression provides named access to the StreamCompressionEnum values.
+*/
+var StreamCompression = streamCompressionEnum{
+	None: streamCompressionNone,
+	Gzip: streamCompressionGzip,
+}
+
+/*
+StreamCompressionEnum represents the Tracing.StreamCompression values.
+
+Compression type to use for traces returned via streams.
+
+Allowed values:
+  - StreamCompression.None "none"
+  - StreamCompression.Gzip "gzip"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#type-StreamCompression
+*/
+type StreamCompressionEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StreamCompressionEnum) String() string {
+	return _streamCompressionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StreamCompressionEnum) Unknown() bool {
+	return !_streamCompressionEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StreamCompressionEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StreamCompressionEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _streamCompressionEnums.Parse(val)
+	return nil
+}
+
+const (
+	// streamCompressionNone represents the "none" value.
+	streamCompressionNone StreamCompressionEnum = iota + 1
+	// streamCompressionGzip represents the "gzip" value.
+	streamCompressionGzip
+)
+
+var _streamCompressionEnums = enums.New(map[StreamCompressionEnum]string{
+	StreamCompressionEnum(0): "",
+	streamCompressionNone:    "none",
+	streamCompressionGzip:    "gzip",
+})
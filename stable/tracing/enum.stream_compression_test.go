@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStreamCompression(t *testing.T) {
+	var enum StreamCompressionEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StreamCompressionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = StreamCompression.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if StreamCompression.None != enum {
+		t.Errorf("Expected %d, got %d", StreamCompression.None, enum)
+	}
+
+	enum = StreamCompression.Gzip
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"gzip"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"gzip"`, result)
+	}
+	json.Unmarshal([]byte(`"gzip"`), &enum)
+	if StreamCompression.Gzip != enum {
+		t.Errorf("Expected %d, got %d", StreamCompression.Gzip, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBackend(t *testing.T) {
+	var enum BackendEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BackendEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Backend.Auto
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"auto"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"auto"`, result)
+	}
+	json.Unmarshal([]byte(`"auto"`), &enum)
+	if Backend.Auto != enum {
+		t.Errorf("Expected %d, got %d", Backend.Auto, enum)
+	}
+
+	enum = Backend.Chrome
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"chrome"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"chrome"`, result)
+	}
+	json.Unmarshal([]byte(`"chrome"`), &enum)
+	if Backend.Chrome != enum {
+		t.Errorf("Expected %d, got %d", Backend.Chrome, enum)
+	}
+
+	enum = Backend.System
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"system"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"system"`, result)
+	}
+	json.Unmarshal([]byte(`"system"`), &enum)
+	if Backend.System != enum {
+		t.Errorf("Expected %d, got %d", Backend.System, enum)
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type transferModeEnum struct {
+	ReportEvents   TransferModeEnum
+	ReturnAsStream TransferModeEnum
+}
+
+/*
+TransferMode provides named access to the TransferModeEnum values.
+*/
+var TransferMode = transferModeEnum{
+	ReportEvents:   transferModeReportEvents,
+	ReturnAsStream: transferModeReturnAsStream,
+}
+
+/*
+TransferModeEnum represents the Tracing.start.transferMode values.
+
+Whether to report trace events as series of dataCollected events or to save
+trace to a stream (defaults to `ReportEvents`).
+
+Allowed values:
+  - TransferMode.ReportEvents   "ReportEvents"
+  - TransferMode.ReturnAsStream "ReturnAsStream"
+
+https://chromedevtools.github.io/devtools-protocol/1-3/Tracing/#method-start
+*/
+type TransferModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TransferModeEnum) String() string {
+	return _transferModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TransferModeEnum) Unknown() bool {
+	return !_transferModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TransferModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TransferModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _transferModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// transferModeReportEvents represents the "ReportEvents" value.
+	transferModeReportEvents TransferModeEnum = iota + 1
+	// transferModeReturnAsStream represents the "ReturnAsStream" value.
+	transferModeReturnAsStream
+)
+
+var _transferModeEnums = enums.New(map[TransferModeEnum]string{
+	TransferModeEnum(0):        "",
+	transferModeReportEvents:   "ReportEvents",
+	transferModeReturnAsStream: "ReturnAsStream",
+})
@@ -1,86 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package accessibility provides type definitions for use with the Chrome
-Accessibility protocol
+Package accessibility provides type definitions for use with the Chrome Accessibility protocol
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/
 */
 package accessibility
 
-import "github.com/mkenney/go-chrome/tot/dom"
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+)
 
 /*
-AXNode is a node in the accessibility tree.
+AXNodeID represents the Accessibility.AXNodeId type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXNode
+Unique accessibility node identifier.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXNodeId
 */
-type AXNode struct {
-	// Unique identifier for this node.
-	NodeID AXNodeID `json:"nodeId"`
+type AXNodeID string
 
-	// Whether this node is ignored for accessibility.
-	Ignored bool `json:"ignored"`
+/*
+AXValueType represents the Accessibility.AXValueType type.
 
-	// Optional. Collection of reasons why this node is hidden.
-	IgnoredReasons []*AXProperty `json:"ignoredReasons,omitempty"`
+Enum of possible property types.
 
-	// Optional. This Node's role, whether explicit or implicit.
-	Role *AXValue `json:"role,omitempty"`
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueType
+*/
+type AXValueType string
 
-	// Optional. The accessible name for this Node.
-	Name *AXValue `json:"name,omitempty"`
+/*
+AXValueSourceType represents the Accessibility.AXValueSourceType type.
 
-	// Optional. The accessible description for this Node.
-	Description *AXValue `json:"description,omitempty"`
+Enum of possible property sources.
 
-	// Optional. The value for this Node.
-	Value *AXValue `json:"value,omitempty"`
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueSourceType
+*/
+type AXValueSourceType string
 
-	// Optional. All other properties.
-	Properties []*AXProperty `json:"properties,omitempty"`
+/*
+AXValueNativeSourceType represents the Accessibility.AXValueNativeSourceType
+type.
 
-	// Optional. IDs for each of this node's child nodes.
-	ChildIDs []AXNodeID `json:"childIds,omitempty"`
+Enum of possible native property sources (as a subtype of a particular
+AXValueSourceType).
 
-	// Optional. The backend ID for the associated DOM node, if any.
-	BackendDOMNodeID dom.BackendNodeID `json:"backendDOMNodeId,omitempty"`
-}
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueNativeSourceType
+*/
+type AXValueNativeSourceType string
 
 /*
-AXNodeID is the unique accessibility node identifier.
+AXValueSource represents the Accessibility.AXValueSource type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXNodeId
+A single source for a computed AX property.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueSource
 */
-type AXNodeID string
+type AXValueSource struct {
+	// What type of source this is.
+	Type AXValueSourceType `json:"type"`
 
-/*
-AXProperty represents a property
+	// Optional. The value of this property source.
+	Value *AXValue `json:"value,omitempty"`
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXProperty
-*/
-type AXProperty struct {
-	// The name of this property.
-	Name AXPropertyName `json:"name"`
+	// Optional. The name of the relevant attribute, if any.
+	Attribute string `json:"attribute,omitempty"`
 
-	// The value of this property.
-	Value *AXValue `json:"value"`
-}
+	// Optional. The value of the relevant attribute, if any.
+	AttributeValue *AXValue `json:"attributeValue,omitempty"`
 
-/*
-AXPropertyName holds
-	- values of AXProperty name: from 'busy' to 'roledescription'
-	- states which apply to every AX node, from 'live' to 'root'
-	- attributes which apply to nodes in live regions, from 'autocomplete' to
-	'valuetext'
-	- attributes which apply to widgets, from 'checked' to 'selected'
-	- states which apply to widgets, from 'activedescendant' to 'owns'
-	- relationships between elements other than parent/child/sibling.
+	// Optional. Whether this source is superseded by a higher priority source.
+	Superseded bool `json:"superseded,omitempty"`
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXPropertyName
-*/
-type AXPropertyName string
+	// Optional. The native markup source for this value, e.g. a `<label>` element.
+	NativeSource AXValueNativeSourceType `json:"nativeSource,omitempty"`
+
+	// Optional. The value, such as a node or node list, of the native source.
+	NativeSourceValue *AXValue `json:"nativeSourceValue,omitempty"`
+
+	// Optional. Whether the value for this property is invalid.
+	Invalid bool `json:"invalid,omitempty"`
+
+	// Optional. Reason for the value being invalid, if it is.
+	InvalidReason string `json:"invalidReason,omitempty"`
+}
 
 /*
-AXRelatedNode represents a related node
+AXRelatedNode represents the Accessibility.AXRelatedNode type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXRelatedNode
 */
@@ -96,7 +105,22 @@ type AXRelatedNode struct {
 }
 
 /*
-AXValue is a single computed AX property.
+AXProperty represents the Accessibility.AXProperty type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXProperty
+*/
+type AXProperty struct {
+	// The name of this property.
+	Name AXPropertyName `json:"name"`
+
+	// The value of this property.
+	Value *AXValue `json:"value"`
+}
+
+/*
+AXValue represents the Accessibility.AXValue type.
+
+A single computed AX property.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValue
 */
@@ -110,61 +134,69 @@ type AXValue struct {
 	// Optional. One or more related nodes, if applicable.
 	RelatedNodes []*AXRelatedNode `json:"relatedNodes,omitempty"`
 
-	// Optional. The sources which contributed to the computation of this
-	// property.
+	// Optional. The sources which contributed to the computation of this property.
 	Sources []*AXValueSource `json:"sources,omitempty"`
 }
 
 /*
-AXValueNativeSourceType is an enum of possible native property sources (as a subtype of a particular
-AXValueSourceType).
+AXPropertyName represents the Accessibility.AXPropertyName type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueNativeSourceType
+Values of AXProperty name:
+- from 'busy' to 'roledescription': states which apply to every AX node
+- from 'live' to 'root': attributes which apply to nodes in live regions
+- from 'autocomplete' to 'valuetext': attributes which apply to widgets
+- from 'checked' to 'selected': states which apply to widgets
+- from 'activedescendant' to 'owns' - relationships between elements other than
+parent/child/sibling.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXPropertyName
 */
-type AXValueNativeSourceType string
+type AXPropertyName string
 
 /*
-AXValueSource is a single source for a computed AX property.
+AXNode represents the Accessibility.AXNode type.
+
+A node in the accessibility tree.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXNode
 */
-type AXValueSource struct {
-	// What type of source this is.
-	Type AXValueSourceType `json:"type"`
+type AXNode struct {
+	// Unique identifier for this node.
+	NodeID AXNodeID `json:"nodeId"`
 
-	// Optional. The value of this property source.
-	Value *AXValue `json:"value,omitempty"`
+	// Whether this node is ignored for accessibility
+	Ignored bool `json:"ignored"`
 
-	// Optional. The name of the relevant attribute, if any.
-	Attribute string `json:"attribute,omitempty"`
+	// Optional. Collection of reasons why this node is hidden.
+	IgnoredReasons []*AXProperty `json:"ignoredReasons,omitempty"`
 
-	// Optional. The value of the relevant attribute, if any.
-	AttributeValue *AXValue `json:"attributeValue,omitempty"`
+	// Optional. This `Node`'s role, whether explicit or implicit.
+	Role *AXValue `json:"role,omitempty"`
 
-	// Optional. Whether this source is superseded by a higher priority source.
-	Superseded bool `json:"superseded,omitempty"`
+	// Optional. This `Node`'s Chrome raw role.
+	ChromeRole *AXValue `json:"chromeRole,omitempty"`
 
-	// Optional. The native markup source for this value, e.g. a element.
-	NativeSource AXValueNativeSourceType `json:"nativeSource,omitempty"`
+	// Optional. The accessible name for this `Node`.
+	Name *AXValue `json:"name,omitempty"`
 
-	// Optional. The value, such as a node or node list, of the native source.
-	NativeSourceValue *AXValue `json:"nativeSourceValue,omitempty"`
+	// Optional. The accessible description for this `Node`.
+	Description *AXValue `json:"description,omitempty"`
 
-	// Optional. Whether the value for this property is invalid.
-	Invalid bool `json:"invalid,omitempty"`
+	// Optional. The value for this `Node`.
+	Value *AXValue `json:"value,omitempty"`
 
-	// Optional. Reason for the value being invalid, if it is.
-	InvalidReason string `json:"invalidReason,omitempty"`
-}
+	// Optional. All other properties
+	Properties []*AXProperty `json:"properties,omitempty"`
 
-/*
-AXValueSourceType is an enum of possible property sources.
+	// Optional. ID for this node's parent.
+	ParentID AXNodeID `json:"parentId,omitempty"`
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueSourceType
-*/
-type AXValueSourceType string
+	// Optional. IDs for each of this node's child nodes.
+	ChildIDs []AXNodeID `json:"childIds,omitempty"`
 
-/*
-AXValueType is an enum of possible property types.
+	// Optional. The backend ID for the associated DOM node, if any.
+	BackendDOMNodeID dom.BackendNodeID `json:"backendDOMNodeId,omitempty"`
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#type-AXValueType
-*/
-type AXValueType string
+	// Optional. The frame ID for the frame associated with this nodes document.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+}
@@ -1,25 +1,57 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package accessibility
 
 import (
 	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
 )
 
 /*
-PartialAXTreeParams represents Accessibility.partialAXTree parameters.
+DisableResult represents the result of calls to Accessibility.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Accessibility.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+PartialAXTreeParams represents Accessibility.getPartialAXTree parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getPartialAXTree
 */
 type PartialAXTreeParams struct {
-	// ID of the node to get the partial accessibility tree for.
-	NodeID dom.NodeID `json:"nodeId"`
+	// Optional. Identifier of the node to get the partial accessibility tree for.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
 
-	// Optional. Whether to fetch this nodes ancestors, siblings and children.
+	// Optional. Identifier of the backend node to get the partial accessibility
+	// tree for.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper to get the partial
+	// accessibility tree for.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. Whether to fetch this node's ancestors, siblings and children.
 	// Defaults to true.
-	FetchRelatives bool `json:"fetchRelatives,omitempty"`
+	FetchRelatives *bool `json:"fetchRelatives,omitempty"`
 }
 
 /*
-PartialAXTreeResult represents the result of calls to Accessibility.partialAXTree.
+PartialAXTreeResult represents the result of calls to Accessibility.getPartialAXTree.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getPartialAXTree
 */
@@ -31,3 +63,142 @@ type PartialAXTreeResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+GetFullAXTreeParams represents Accessibility.getFullAXTree parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getFullAXTree
+*/
+type GetFullAXTreeParams struct {
+	// Optional. The maximum depth at which descendants of the root node should be
+	// retrieved. If omitted, the full tree is returned.
+	Depth *int `json:"depth,omitempty"`
+
+	// Optional. The frame for whose document the AX tree should be retrieved. If
+	// omitted, the root frame is used.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+}
+
+/*
+GetFullAXTreeResult represents the result of calls to Accessibility.getFullAXTree.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getFullAXTree
+*/
+type GetFullAXTreeResult struct {
+	Nodes []*AXNode `json:"nodes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetRootAXNodeParams represents Accessibility.getRootAXNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getRootAXNode
+*/
+type GetRootAXNodeParams struct {
+	// Optional. The frame in whose document the node resides. If omitted, the root
+	// frame is used.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+}
+
+/*
+GetRootAXNodeResult represents the result of calls to Accessibility.getRootAXNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getRootAXNode
+*/
+type GetRootAXNodeResult struct {
+	Node *AXNode `json:"node"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAXNodeAndAncestorsParams represents Accessibility.getAXNodeAndAncestors parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getAXNodeAndAncestors
+*/
+type GetAXNodeAndAncestorsParams struct {
+	// Optional. Identifier of the node to get.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node to get.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper to get.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+GetAXNodeAndAncestorsResult represents the result of calls to Accessibility.getAXNodeAndAncestors.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getAXNodeAndAncestors
+*/
+type GetAXNodeAndAncestorsResult struct {
+	Nodes []*AXNode `json:"nodes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetChildAXNodesParams represents Accessibility.getChildAXNodes parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getChildAXNodes
+*/
+type GetChildAXNodesParams struct {
+	ID AXNodeID `json:"id"`
+
+	// Optional. The frame in whose document the node resides. If omitted, the root
+	// frame is used.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+}
+
+/*
+GetChildAXNodesResult represents the result of calls to Accessibility.getChildAXNodes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getChildAXNodes
+*/
+type GetChildAXNodesResult struct {
+	Nodes []*AXNode `json:"nodes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+QueryAXTreeParams represents Accessibility.queryAXTree parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-queryAXTree
+*/
+type QueryAXTreeParams struct {
+	// Optional. Identifier of the node for the root to query.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node for the root to query.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper for the root to query.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. Find nodes with this computed name.
+	AccessibleName *string `json:"accessibleName,omitempty"`
+
+	// Optional. Find nodes with this computed role.
+	Role *string `json:"role,omitempty"`
+}
+
+/*
+QueryAXTreeResult represents the result of calls to Accessibility.queryAXTree.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-queryAXTree
+*/
+type QueryAXTreeResult struct {
+	// A list of `Accessibility.AXNode` matching the specified attributes,
+	// including nodes that are ignored for accessibility.
+	Nodes []*AXNode `json:"nodes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
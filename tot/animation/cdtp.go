@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package animation provides type definitions for use with the Chrome Animation
-protocol
+Package animation provides type definitions for use with the Chrome Animation protocol
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/
 */
@@ -11,85 +14,128 @@ import (
 )
 
 /*
+Animation represents the Animation.Animation type.
+
 Animation instance.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-Animation
 */
 type Animation struct {
-	// Animation's id.
+	// `Animation`'s id.
 	ID string `json:"id"`
 
-	// Animation's name.
+	// `Animation`'s name.
 	Name string `json:"name"`
 
-	// Animation's internal paused state.
+	// `Animation`'s internal paused state.
 	PausedState bool `json:"pausedState"`
 
-	// Animation's play state.
+	// `Animation`'s play state.
 	PlayState string `json:"playState"`
 
-	// Animation's playback rate.
+	// `Animation`'s playback rate.
 	PlaybackRate float64 `json:"playbackRate"`
 
-	// Animation's start time.
-	StartTime int64 `json:"startTime"`
+	// `Animation`'s start time. Milliseconds for time based animations and
+	// percentage [0 - 100] for scroll driven animations (i.e. when
+	// viewOrScrollTimeline exists).
+	StartTime float64 `json:"startTime"`
 
-	// Animation's current time.
-	CurrentTime int64 `json:"currentTime"`
+	// `Animation`'s current time.
+	CurrentTime float64 `json:"currentTime"`
 
-	// Animation type of Animation. Allowed values:
-	//	- AnimationType.CSSTransition
-	//	- AnimationType.CSSAnimation
-	//	- AnimationType.WebAnimation
+	// Animation type of `Animation`. Allowed values:
+	//   - Type.CSSTransition
+	//   - Type.CSSAnimation
+	//   - Type.WebAnimation
 	Type TypeEnum `json:"type"`
 
-	// Optional. Animation's source animation node.
+	// Optional. `Animation`'s source animation node.
 	Source *Effect `json:"source,omitempty"`
 
-	// Optional. A unique ID for Animation representing the sources that
+	// Optional. A unique ID for `Animation` representing the sources that
 	// triggered this CSS animation/transition.
 	CSSID string `json:"cssId,omitempty"`
+
+	// Optional. View or scroll timeline
+	ViewOrScrollTimeline *ViewOrScrollTimeline `json:"viewOrScrollTimeline,omitempty"`
 }
 
 /*
-Effect instance
+ViewOrScrollTimeline represents the Animation.ViewOrScrollTimeline type.
+
+# Timeline instance
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-ViewOrScrollTimeline
+*/
+type ViewOrScrollTimeline struct {
+	// Optional. Scroll container node
+	SourceNodeID dom.BackendNodeID `json:"sourceNodeId,omitempty"`
+
+	// Optional. Represents the starting scroll position of the timeline as a
+	// length offset in pixels from scroll origin.
+	StartOffset float64 `json:"startOffset,omitempty"`
+
+	// Optional. Represents the ending scroll position of the timeline as a length
+	// offset in pixels from scroll origin.
+	EndOffset float64 `json:"endOffset,omitempty"`
+
+	// Optional. The element whose principal box's visibility in the scrollport
+	// defined the progress of the timeline. Does not exist for animations with
+	// ScrollTimeline
+	SubjectNodeID dom.BackendNodeID `json:"subjectNodeId,omitempty"`
+
+	// Orientation of the scroll Allowed values:
+	//   - dom.ScrollOrientation.Horizontal
+	//   - dom.ScrollOrientation.Vertical
+	Axis dom.ScrollOrientationEnum `json:"axis"`
+}
+
+/*
+Effect represents the Animation.AnimationEffect type.
+
+# AnimationEffect instance
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-AnimationEffect
 */
 type Effect struct {
-	// Effect's delay.
-	Delay int64 `json:"delay"`
+	// `AnimationEffect`'s delay.
+	Delay float64 `json:"delay"`
 
-	// Effect's end delay.
-	EndDelay int64 `json:"endDelay"`
+	// `AnimationEffect`'s end delay.
+	EndDelay float64 `json:"endDelay"`
 
-	// Effect's iteration start.
-	IterationStart int64 `json:"iterationStart"`
+	// `AnimationEffect`'s iteration start.
+	IterationStart float64 `json:"iterationStart"`
 
-	// Effect's iterations.
-	Iterations int64 `json:"iterations"`
+	// `AnimationEffect`'s iterations.
+	Iterations float64 `json:"iterations"`
 
-	// Effect's iteration duration.
-	Duration int64 `json:"duration"`
+	// `AnimationEffect`'s iteration duration. Milliseconds for time based
+	// animations and percentage [0 - 100] for scroll driven animations (i.e. when
+	// viewOrScrollTimeline exists).
+	Duration float64 `json:"duration"`
 
-	// Effect's playback direction.
+	// `AnimationEffect`'s playback direction.
 	Direction string `json:"direction"`
 
-	// Effect's fill mode.
+	// `AnimationEffect`'s fill mode.
 	Fill string `json:"fill"`
 
-	// Optional. Effect's target node.
+	// Optional. `AnimationEffect`'s target node.
 	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. Effect's keyframes.
+	// Optional. `AnimationEffect`'s keyframes.
 	KeyframesRule *KeyframesRule `json:"keyframesRule,omitempty"`
 
-	// Effect's timing function.
+	// `AnimationEffect`'s timing function.
 	Easing string `json:"easing"`
 }
 
 /*
-KeyframesRule is the keyframes rule
+KeyframesRule represents the Animation.KeyframesRule type.
+
+# Keyframes Rule
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-KeyframesRule
 */
@@ -102,7 +148,9 @@ type KeyframesRule struct {
 }
 
 /*
-KeyframeStyle is the keyframe Style
+KeyframeStyle represents the Animation.KeyframeStyle type.
+
+# Keyframe Style
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-KeyframeStyle
 */
@@ -110,6 +158,6 @@ type KeyframeStyle struct {
 	// Keyframe's time offset.
 	Offset string `json:"offset"`
 
-	// Effect's timing function.
+	// `AnimationEffect`'s timing function.
 	Easing string `json:"easing"`
 }
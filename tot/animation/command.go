@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package animation
 
 import (
@@ -30,7 +32,7 @@ GetCurrentTimeParams represents Animation.getCurrentTime parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurrentTime
 */
 type GetCurrentTimeParams struct {
-	// ID of animation.
+	// Id of animation.
 	ID string `json:"id"`
 }
 
@@ -40,8 +42,8 @@ GetCurrentTimeResult represents the result of calls to Animation.getCurrentTime.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurrentTime
 */
 type GetCurrentTimeResult struct {
-	// ID of animation.
-	CurrentTime int64 `json:"currentTime"`
+	// Current time of the page.
+	CurrentTime float64 `json:"currentTime"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -86,7 +88,7 @@ ResolveAnimationParams represents Animation.resolveAnimation parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-resolveAnimation
 */
 type ResolveAnimationParams struct {
-	// Animation ID.
+	// Animation id.
 	AnimationID string `json:"animationId"`
 }
 
@@ -113,7 +115,7 @@ type SeekAnimationsParams struct {
 	Animations []string `json:"animations"`
 
 	// Set the current time of each animation.
-	CurrentTime int64 `json:"currentTime"`
+	CurrentTime float64 `json:"currentTime"`
 }
 
 /*
@@ -127,7 +129,7 @@ type SeekAnimationsResult struct {
 }
 
 /*
-SetPausedParams represents Animation.setPaused parameters
+SetPausedParams represents Animation.setPaused parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPaused
 */
@@ -155,8 +157,8 @@ SetPlaybackRateParams represents Animation.setPlaybackRate parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPlaybackRate
 */
 type SetPlaybackRateParams struct {
-	// Playback rate for animations on page.
-	PlaybackRate int64 `json:"playbackRate"`
+	// Playback rate for animations on page
+	PlaybackRate float64 `json:"playbackRate"`
 }
 
 /*
@@ -175,14 +177,14 @@ SetTimingParams represents Animation.setTiming parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setTiming
 */
 type SetTimingParams struct {
-	// Animation ID.
+	// Animation id.
 	AnimationID string `json:"animationId"`
 
 	// Duration of the animation.
-	Duration int64 `json:"duration"`
+	Duration float64 `json:"duration"`
 
 	// Delay of the animation.
-	Delay int64 `json:"delay"`
+	Delay float64 `json:"delay"`
 }
 
 /*
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package animation
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type typeEnum struct {
@@ -12,7 +15,7 @@ type typeEnum struct {
 }
 
 /*
-Type provides named acces to the TypeEnum values.
+Type provides named access to the TypeEnum values.
 */
 var Type = typeEnum{
 	CSSTransition: typeCSSTransition,
@@ -21,10 +24,14 @@ var Type = typeEnum{
 }
 
 /*
-TypeEnum represents the type of Animation. Allowed values:
-	- Type.CSSTransition "CSSTransition"
-	- Type.CSSAnimation  "CSSAnimation"
-	- Type.WebAnimation  "WebAnimation"
+TypeEnum represents the Animation.Animation.type values.
+
+Animation type of `Animation`.
+
+Allowed values:
+  - Type.CSSTransition "CSSTransition"
+  - Type.CSSAnimation  "CSSAnimation"
+  - Type.WebAnimation  "WebAnimation"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#type-Animation
 */
@@ -34,7 +41,16 @@ type TypeEnum int
 String implements Stringer
 */
 func (enum TypeEnum) String() string {
-	return _typeEnums[enum]
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
 }
 
 /*
@@ -45,25 +61,16 @@ func (enum TypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _typeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid Type value", bytes)
+	*enum = _typeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -75,8 +82,9 @@ const (
 	typeWebAnimation
 )
 
-var _typeEnums = map[TypeEnum]string{
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):       "",
 	typeCSSTransition: "CSSTransition",
 	typeCSSAnimation:  "CSSAnimation",
 	typeWebAnimation:  "WebAnimation",
-}
+})
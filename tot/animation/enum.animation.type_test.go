@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package animation
 
 import (
@@ -11,29 +13,32 @@ func TestEnumType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
 	}
 
-	result, err = json.Marshal(enum)
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
-	if `""` != string(result) {
-		t.Errorf("Expected empty JSON string, got '%s'", result)
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
-	enum = Type.CSSAnimation
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
-	if `"CSSAnimation"` != string(result) {
-		t.Errorf("Expected '\"CSSAnimation\"', got '%s'", result)
-	}
-	json.Unmarshal([]byte(`"CSSAnimation"`), &enum)
-	if Type.CSSAnimation != enum {
-		t.Errorf("Expcected %d, got %d", Type.CSSAnimation, enum)
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
 	}
 
 	enum = Type.CSSTransition
@@ -42,11 +47,24 @@ func TestEnumType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"CSSTransition"` != string(result) {
-		t.Errorf("Expected '\"CSSTransition\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"CSSTransition"`, result)
 	}
 	json.Unmarshal([]byte(`"CSSTransition"`), &enum)
 	if Type.CSSTransition != enum {
-		t.Errorf("Expcected %d, got %d", Type.CSSTransition, enum)
+		t.Errorf("Expected %d, got %d", Type.CSSTransition, enum)
+	}
+
+	enum = Type.CSSAnimation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CSSAnimation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CSSAnimation"`, result)
+	}
+	json.Unmarshal([]byte(`"CSSAnimation"`), &enum)
+	if Type.CSSAnimation != enum {
+		t.Errorf("Expected %d, got %d", Type.CSSAnimation, enum)
 	}
 
 	enum = Type.WebAnimation
@@ -55,10 +73,10 @@ func TestEnumType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"WebAnimation"` != string(result) {
-		t.Errorf("Expected '\"WebAnimation\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"WebAnimation"`, result)
 	}
 	json.Unmarshal([]byte(`"WebAnimation"`), &enum)
 	if Type.WebAnimation != enum {
-		t.Errorf("Expcected %d, got %d", Type.WebAnimation, enum)
+		t.Errorf("Expected %d, got %d", Type.WebAnimation, enum)
 	}
 }
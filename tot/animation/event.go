@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package animation
 
 /*
@@ -6,7 +8,7 @@ CanceledEvent represents Animation.animationCanceled event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCanceled
 */
 type CanceledEvent struct {
-	// ID of the animation that was cancelled.
+	// Id of the animation that was cancelled.
 	ID string `json:"id"`
 
 	// Error information related to this event
@@ -19,7 +21,7 @@ CreatedEvent represents Animation.animationCreated event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCreated
 */
 type CreatedEvent struct {
-	// ID of the animation that was created.
+	// Id of the animation that was created.
 	ID string `json:"id"`
 
 	// Error information related to this event
@@ -38,3 +40,16 @@ type StartedEvent struct {
 	// Error information related to this event
 	Err error `json:"-"`
 }
+
+/*
+UpdatedEvent represents Animation.animationUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationUpdated
+*/
+type UpdatedEvent struct {
+	// Animation that was updated.
+	Animation *Animation `json:"animation"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package cache provides type definitions for use with the Chrome
-ApplicationCache protocol
+Package cache provides type definitions for use with the Chrome ApplicationCache protocol
+
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/
 */
@@ -11,7 +14,9 @@ import (
 )
 
 /*
-Resource contains detailed application cache resource information.
+Resource represents the ApplicationCache.ApplicationCacheResource type.
+
+Contains detailed application cache resource information.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#type-ApplicationCacheResource
 */
@@ -27,7 +32,9 @@ type Resource struct {
 }
 
 /*
-ApplicationCache contains detailed application cache information.
+ApplicationCache represents the ApplicationCache.ApplicationCache type.
+
+Contains detailed application cache information.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#type-ApplicationCache
 */
@@ -49,7 +56,9 @@ type ApplicationCache struct {
 }
 
 /*
-FrameWithManifest is a frame identifier / manifest URL pair.
+FrameWithManifest represents the ApplicationCache.FrameWithManifest type.
+
+Is a frame identifier / manifest URL pair.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#type-FrameWithManifest
 */
@@ -1,6 +1,984 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package audits provides type definitions for use with the Chrome Audits protocol
 
+Audits domain allows investigation of page violations and possible improvements.
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Audits/
 */
 package audits
+
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
+/*
+AffectedCookie represents the Audits.AffectedCookie type.
+
+Information about a cookie that is affected by an inspector issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-AffectedCookie
+*/
+type AffectedCookie struct {
+	// The following three properties uniquely identify a cookie
+	Name string `json:"name"`
+
+	Path string `json:"path"`
+
+	Domain string `json:"domain"`
+}
+
+/*
+AffectedRequest represents the Audits.AffectedRequest type.
+
+Information about a request that is affected by an inspector issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-AffectedRequest
+*/
+type AffectedRequest struct {
+	// Optional. The unique request id.
+	RequestID network.RequestID `json:"requestId,omitempty"`
+
+	URL string `json:"url"`
+}
+
+/*
+AffectedFrame represents the Audits.AffectedFrame type.
+
+Information about the frame affected by an inspector issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-AffectedFrame
+*/
+type AffectedFrame struct {
+	FrameID page.FrameID `json:"frameId"`
+}
+
+/*
+CookieIssueInsight represents the Audits.CookieIssueInsight type.
+
+Information about the suggested solution to a cookie issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieIssueInsight
+*/
+type CookieIssueInsight struct {
+	// Allowed values:
+	//   - InsightType.GitHubResource
+	//   - InsightType.GracePeriod
+	//   - InsightType.Heuristics
+	Type InsightTypeEnum `json:"type"`
+
+	// Optional. Link to table entry in third-party cookie migration readiness
+	// list.
+	TableEntryURL string `json:"tableEntryUrl,omitempty"`
+}
+
+/*
+CookieIssueDetails represents the Audits.CookieIssueDetails type.
+
+This information is currently necessary, as the front-end has a difficult time
+finding a specific cookie. With this, we can convey specific error information
+without the cookie.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieIssueDetails
+*/
+type CookieIssueDetails struct {
+	// Optional. If AffectedCookie is not set then rawCookieLine contains the raw
+	// Set-Cookie header string. This hints at a problem where the cookie line is
+	// syntactically or semantically malformed in a way that no valid cookie could
+	// be created.
+	Cookie *AffectedCookie `json:"cookie,omitempty"`
+
+	// Optional.
+	RawCookieLine string `json:"rawCookieLine,omitempty"`
+
+	CookieWarningReasons []CookieWarningReasonEnum `json:"cookieWarningReasons"`
+
+	CookieExclusionReasons []CookieExclusionReasonEnum `json:"cookieExclusionReasons"`
+
+	// Optionally identifies the site-for-cookies and the cookie url, which may be
+	// used by the front-end as additional context. Allowed values:
+	//   - CookieOperation.SetCookie
+	//   - CookieOperation.ReadCookie
+	Operation CookieOperationEnum `json:"operation"`
+
+	// Optional.
+	SiteForCookies string `json:"siteForCookies,omitempty"`
+
+	// Optional.
+	CookieURL string `json:"cookieUrl,omitempty"`
+
+	// Optional.
+	Request *AffectedRequest `json:"request,omitempty"`
+
+	// Optional. The recommended solution to the issue.
+	Insight *CookieIssueInsight `json:"insight,omitempty"`
+}
+
+/*
+MixedContentIssueDetails represents the Audits.MixedContentIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-MixedContentIssueDetails
+*/
+type MixedContentIssueDetails struct {
+	// Optional. The type of resource causing the mixed content issue (css, js,
+	// iframe, form,...). Marked as optional because it is mapped to from
+	// blink::mojom::RequestContextType, which will be replaced by
+	// network::mojom::RequestDestination Allowed values:
+	//   - MixedContentResourceType.AttributionSrc
+	//   - MixedContentResourceType.Audio
+	//   - MixedContentResourceType.Beacon
+	//   - MixedContentResourceType.CSPReport
+	//   - MixedContentResourceType.Download
+	//   - MixedContentResourceType.EventSource
+	//   - MixedContentResourceType.Favicon
+	//   - MixedContentResourceType.Font
+	//   - MixedContentResourceType.Form
+	//   - MixedContentResourceType.Frame
+	//   - MixedContentResourceType.Image
+	//   - MixedContentResourceType.Import
+	//   - MixedContentResourceType.JSON
+	//   - MixedContentResourceType.Manifest
+	//   - MixedContentResourceType.Ping
+	//   - MixedContentResourceType.PluginData
+	//   - MixedContentResourceType.PluginResource
+	//   - MixedContentResourceType.Prefetch
+	//   - MixedContentResourceType.Resource
+	//   - MixedContentResourceType.Script
+	//   - MixedContentResourceType.ServiceWorker
+	//   - MixedContentResourceType.SharedWorker
+	//   - MixedContentResourceType.SpeculationRules
+	//   - MixedContentResourceType.Stylesheet
+	//   - MixedContentResourceType.Track
+	//   - MixedContentResourceType.Video
+	//   - MixedContentResourceType.Worker
+	//   - MixedContentResourceType.XMLHTTPRequest
+	//   - MixedContentResourceType.XSLT
+	ResourceType MixedContentResourceTypeEnum `json:"resourceType,omitempty"`
+
+	// The way the mixed content issue is being resolved. Allowed values:
+	//   - MixedContentResolutionStatus.MixedContentBlocked
+	//   - MixedContentResolutionStatus.MixedContentAutomaticallyUpgraded
+	//   - MixedContentResolutionStatus.MixedContentWarning
+	ResolutionStatus MixedContentResolutionStatusEnum `json:"resolutionStatus"`
+
+	// The unsafe http url causing the mixed content issue.
+	InsecureURL string `json:"insecureURL"`
+
+	// The url responsible for the call to an unsafe url.
+	MainResourceURL string `json:"mainResourceURL"`
+
+	// Optional. The mixed content request. Does not always exist (e.g. for unsafe
+	// form submission urls).
+	Request *AffectedRequest `json:"request,omitempty"`
+
+	// Optional. Optional because not every mixed content issue is necessarily
+	// linked to a frame.
+	Frame *AffectedFrame `json:"frame,omitempty"`
+}
+
+/*
+BlockedByResponseIssueDetails represents the
+Audits.BlockedByResponseIssueDetails type.
+
+Details for a request that has been blocked with the BLOCKED_BY_RESPONSE code.
+Currently only used for COEP/COOP, but may be extended to include some CSP
+errors in the future.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-BlockedByResponseIssueDetails
+*/
+type BlockedByResponseIssueDetails struct {
+	Request *AffectedRequest `json:"request"`
+
+	// Optional.
+	ParentFrame *AffectedFrame `json:"parentFrame,omitempty"`
+
+	// Optional.
+	BlockedFrame *AffectedFrame `json:"blockedFrame,omitempty"`
+
+	// Allowed values:
+	//   - BlockedByResponseReason.CoepFrameResourceNeedsCoepHeader
+	//   - BlockedByResponseReason.CoopSandboxedIFrameCannotNavigateToCoopPage
+	//   - BlockedByResponseReason.CorpNotSameOrigin
+	//   - BlockedByResponseReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	//   - BlockedByResponseReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip
+	//   - BlockedByResponseReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	//   - BlockedByResponseReason.CorpNotSameSite
+	//   - BlockedByResponseReason.SRIMessageSignatureMismatch
+	Reason BlockedByResponseReasonEnum `json:"reason"`
+}
+
+/*
+HeavyAdIssueDetails represents the Audits.HeavyAdIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-HeavyAdIssueDetails
+*/
+type HeavyAdIssueDetails struct {
+	// The resolution status, either blocking the content or warning. Allowed values:
+	//   - HeavyAdResolutionStatus.HeavyAdBlocked
+	//   - HeavyAdResolutionStatus.HeavyAdWarning
+	Resolution HeavyAdResolutionStatusEnum `json:"resolution"`
+
+	// The reason the ad was blocked, total network or cpu or peak cpu. Allowed values:
+	//   - HeavyAdReason.NetworkTotalLimit
+	//   - HeavyAdReason.CPUTotalLimit
+	//   - HeavyAdReason.CPUPeakLimit
+	Reason HeavyAdReasonEnum `json:"reason"`
+
+	// The frame that was blocked.
+	Frame *AffectedFrame `json:"frame"`
+}
+
+/*
+SourceCodeLocation represents the Audits.SourceCodeLocation type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SourceCodeLocation
+*/
+type SourceCodeLocation struct {
+	// Optional.
+	ScriptID runtime.ScriptID `json:"scriptId,omitempty"`
+
+	URL string `json:"url"`
+
+	LineNumber int `json:"lineNumber"`
+
+	ColumnNumber int `json:"columnNumber"`
+}
+
+/*
+ContentSecurityPolicyIssueDetails represents the
+Audits.ContentSecurityPolicyIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-ContentSecurityPolicyIssueDetails
+*/
+type ContentSecurityPolicyIssueDetails struct {
+	// Optional. The url not included in allowed sources.
+	BlockedURL string `json:"blockedURL,omitempty"`
+
+	// Specific directive that is violated, causing the CSP issue.
+	ViolatedDirective string `json:"violatedDirective"`
+
+	IsReportOnly bool `json:"isReportOnly"`
+
+	// Allowed values:
+	//   - ContentSecurityPolicyViolationType.KInlineViolation
+	//   - ContentSecurityPolicyViolationType.KEvalViolation
+	//   - ContentSecurityPolicyViolationType.KURLViolation
+	//   - ContentSecurityPolicyViolationType.KSRIViolation
+	//   - ContentSecurityPolicyViolationType.KTrustedTypesSinkViolation
+	//   - ContentSecurityPolicyViolationType.KTrustedTypesPolicyViolation
+	//   - ContentSecurityPolicyViolationType.KWasmEvalViolation
+	ContentSecurityPolicyViolationType ContentSecurityPolicyViolationTypeEnum `json:"contentSecurityPolicyViolationType"`
+
+	// Optional.
+	FrameAncestor *AffectedFrame `json:"frameAncestor,omitempty"`
+
+	// Optional.
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation,omitempty"`
+
+	// Optional.
+	ViolatingNodeID dom.BackendNodeID `json:"violatingNodeId,omitempty"`
+}
+
+/*
+SharedArrayBufferIssueDetails represents the
+Audits.SharedArrayBufferIssueDetails type.
+
+Details for a issue arising from an SAB being instantiated in, or transferred to
+a context that is not cross-origin isolated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SharedArrayBufferIssueDetails
+*/
+type SharedArrayBufferIssueDetails struct {
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation"`
+
+	IsWarning bool `json:"isWarning"`
+
+	// Allowed values:
+	//   - SharedArrayBufferIssueType.TransferIssue
+	//   - SharedArrayBufferIssueType.CreationIssue
+	Type SharedArrayBufferIssueTypeEnum `json:"type"`
+}
+
+/*
+LowTextContrastIssueDetails represents the Audits.LowTextContrastIssueDetails
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-LowTextContrastIssueDetails
+*/
+type LowTextContrastIssueDetails struct {
+	ViolatingNodeID dom.BackendNodeID `json:"violatingNodeId"`
+
+	ViolatingNodeSelector string `json:"violatingNodeSelector"`
+
+	ContrastRatio float64 `json:"contrastRatio"`
+
+	ThresholdAA float64 `json:"thresholdAA"`
+
+	ThresholdAAA float64 `json:"thresholdAAA"`
+
+	FontSize string `json:"fontSize"`
+
+	FontWeight string `json:"fontWeight"`
+}
+
+/*
+CorsIssueDetails represents the Audits.CorsIssueDetails type.
+
+Details for a CORS related issue, e.g. a warning or error related to CORS
+RFC1918 enforcement.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CorsIssueDetails
+*/
+type CorsIssueDetails struct {
+	CorsErrorStatus *network.CorsErrorStatus `json:"corsErrorStatus"`
+
+	IsWarning bool `json:"isWarning"`
+
+	Request *AffectedRequest `json:"request"`
+
+	// Optional.
+	Location *SourceCodeLocation `json:"location,omitempty"`
+
+	// Optional.
+	InitiatorOrigin string `json:"initiatorOrigin,omitempty"`
+
+	// Optional. Allowed values:
+	//   - network.IPAddressSpace.Loopback
+	//   - network.IPAddressSpace.Local
+	//   - network.IPAddressSpace.Public
+	//   - network.IPAddressSpace.Unknown
+	ResourceIPAddressSpace network.IPAddressSpaceEnum `json:"resourceIPAddressSpace,omitempty"`
+
+	// Optional.
+	ClientSecurityState *network.ClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+/*
+AttributionReportingIssueDetails represents the
+Audits.AttributionReportingIssueDetails type.
+
+Details for issues around "Attribution Reporting API" usage. Explainer:
+https://github.com/WICG/attribution-reporting-api
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-AttributionReportingIssueDetails
+*/
+type AttributionReportingIssueDetails struct {
+	// Allowed values:
+	//   - AttributionReportingIssueType.PermissionPolicyDisabled
+	//   - AttributionReportingIssueType.UntrustworthyReportingOrigin
+	//   - AttributionReportingIssueType.InsecureContext
+	//   - AttributionReportingIssueType.InvalidHeader
+	//   - AttributionReportingIssueType.InvalidRegisterTriggerHeader
+	//   - AttributionReportingIssueType.SourceAndTriggerHeaders
+	//   - AttributionReportingIssueType.SourceIgnored
+	//   - AttributionReportingIssueType.TriggerIgnored
+	//   - AttributionReportingIssueType.OsSourceIgnored
+	//   - AttributionReportingIssueType.OsTriggerIgnored
+	//   - AttributionReportingIssueType.InvalidRegisterOsSourceHeader
+	//   - AttributionReportingIssueType.InvalidRegisterOsTriggerHeader
+	//   - AttributionReportingIssueType.WebAndOsHeaders
+	//   - AttributionReportingIssueType.NoWebOrOsSupport
+	//   - AttributionReportingIssueType.NavigationRegistrationWithoutTransientUserActivation
+	//   - AttributionReportingIssueType.InvalidInfoHeader
+	//   - AttributionReportingIssueType.NoRegisterSourceHeader
+	//   - AttributionReportingIssueType.NoRegisterTriggerHeader
+	//   - AttributionReportingIssueType.NoRegisterOsSourceHeader
+	//   - AttributionReportingIssueType.NoRegisterOsTriggerHeader
+	//   - AttributionReportingIssueType.NavigationRegistrationUniqueScopeAlreadySet
+	ViolationType AttributionReportingIssueTypeEnum `json:"violationType"`
+
+	// Optional.
+	Request *AffectedRequest `json:"request,omitempty"`
+
+	// Optional.
+	ViolatingNodeID dom.BackendNodeID `json:"violatingNodeId,omitempty"`
+
+	// Optional.
+	InvalidParameter string `json:"invalidParameter,omitempty"`
+}
+
+/*
+QuirksModeIssueDetails represents the Audits.QuirksModeIssueDetails type.
+
+Details for issues about documents in Quirks Mode or Limited Quirks Mode that
+affects page layouting.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-QuirksModeIssueDetails
+*/
+type QuirksModeIssueDetails struct {
+	// If false, it means the document's mode is "quirks" instead of
+	// "limited-quirks".
+	IsLimitedQuirksMode bool `json:"isLimitedQuirksMode"`
+
+	DocumentNodeID dom.BackendNodeID `json:"documentNodeId"`
+
+	URL string `json:"url"`
+
+	FrameID page.FrameID `json:"frameId"`
+
+	LoaderID network.LoaderID `json:"loaderId"`
+}
+
+/*
+NavigatorUserAgentIssueDetails represents the
+Audits.NavigatorUserAgentIssueDetails type.
+
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-NavigatorUserAgentIssueDetails
+*/
+type NavigatorUserAgentIssueDetails struct {
+	URL string `json:"url"`
+
+	// Optional.
+	Location *SourceCodeLocation `json:"location,omitempty"`
+}
+
+/*
+SharedDictionaryIssueDetails represents the Audits.SharedDictionaryIssueDetails
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SharedDictionaryIssueDetails
+*/
+type SharedDictionaryIssueDetails struct {
+	// Allowed values:
+	//   - SharedDictionaryError.UseErrorCrossOriginNoCorsRequest
+	//   - SharedDictionaryError.UseErrorDictionaryLoadFailure
+	//   - SharedDictionaryError.UseErrorMatchingDictionaryNotUsed
+	//   - SharedDictionaryError.UseErrorUnexpectedContentDictionaryHeader
+	//   - SharedDictionaryError.WriteErrorCossOriginNoCorsRequest
+	//   - SharedDictionaryError.WriteErrorDisallowedBySettings
+	//   - SharedDictionaryError.WriteErrorExpiredResponse
+	//   - SharedDictionaryError.WriteErrorFeatureDisabled
+	//   - SharedDictionaryError.WriteErrorInsufficientResources
+	//   - SharedDictionaryError.WriteErrorInvalidMatchField
+	//   - SharedDictionaryError.WriteErrorInvalidStructuredHeader
+	//   - SharedDictionaryError.WriteErrorNavigationRequest
+	//   - SharedDictionaryError.WriteErrorNoMatchField
+	//   - SharedDictionaryError.WriteErrorNonListMatchDestField
+	//   - SharedDictionaryError.WriteErrorNonSecureContext
+	//   - SharedDictionaryError.WriteErrorNonStringIDField
+	//   - SharedDictionaryError.WriteErrorNonStringInMatchDestList
+	//   - SharedDictionaryError.WriteErrorNonStringMatchField
+	//   - SharedDictionaryError.WriteErrorNonTokenTypeField
+	//   - SharedDictionaryError.WriteErrorRequestAborted
+	//   - SharedDictionaryError.WriteErrorShuttingDown
+	//   - SharedDictionaryError.WriteErrorTooLongIDField
+	//   - SharedDictionaryError.WriteErrorUnsupportedType
+	SharedDictionaryError SharedDictionaryErrorEnum `json:"sharedDictionaryError"`
+
+	Request *AffectedRequest `json:"request"`
+}
+
+/*
+SRIMessageSignatureIssueDetails represents the
+Audits.SRIMessageSignatureIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SRIMessageSignatureIssueDetails
+*/
+type SRIMessageSignatureIssueDetails struct {
+	// Allowed values:
+	//   - SRIMessageSignatureError.MissingSignatureHeader
+	//   - SRIMessageSignatureError.MissingSignatureInputHeader
+	//   - SRIMessageSignatureError.InvalidSignatureHeader
+	//   - SRIMessageSignatureError.InvalidSignatureInputHeader
+	//   - SRIMessageSignatureError.SignatureHeaderValueIsNotByteSequence
+	//   - SRIMessageSignatureError.SignatureHeaderValueIsParameterized
+	//   - SRIMessageSignatureError.SignatureHeaderValueIsIncorrectLength
+	//   - SRIMessageSignatureError.SignatureInputHeaderMissingLabel
+	//   - SRIMessageSignatureError.SignatureInputHeaderValueNotInnerList
+	//   - SRIMessageSignatureError.SignatureInputHeaderValueMissingComponents
+	//   - SRIMessageSignatureError.SignatureInputHeaderInvalidComponentType
+	//   - SRIMessageSignatureError.SignatureInputHeaderInvalidComponentName
+	//   - SRIMessageSignatureError.SignatureInputHeaderInvalidHeaderComponentParameter
+	//   - SRIMessageSignatureError.SignatureInputHeaderInvalidDerivedComponentParameter
+	//   - SRIMessageSignatureError.SignatureInputHeaderKeyIDLength
+	//   - SRIMessageSignatureError.SignatureInputHeaderInvalidParameter
+	//   - SRIMessageSignatureError.SignatureInputHeaderMissingRequiredParameters
+	//   - SRIMessageSignatureError.ValidationFailedSignatureExpired
+	//   - SRIMessageSignatureError.ValidationFailedInvalidLength
+	//   - SRIMessageSignatureError.ValidationFailedSignatureMismatch
+	//   - SRIMessageSignatureError.ValidationFailedIntegrityMismatch
+	Error SRIMessageSignatureErrorEnum `json:"error"`
+
+	SignatureBase string `json:"signatureBase"`
+
+	IntegrityAssertions []string `json:"integrityAssertions"`
+
+	Request *AffectedRequest `json:"request"`
+}
+
+/*
+UnencodedDigestIssueDetails represents the Audits.UnencodedDigestIssueDetails
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-UnencodedDigestIssueDetails
+*/
+type UnencodedDigestIssueDetails struct {
+	// Allowed values:
+	//   - UnencodedDigestError.MalformedDictionary
+	//   - UnencodedDigestError.UnknownAlgorithm
+	//   - UnencodedDigestError.IncorrectDigestType
+	//   - UnencodedDigestError.IncorrectDigestLength
+	Error UnencodedDigestErrorEnum `json:"error"`
+
+	Request *AffectedRequest `json:"request"`
+}
+
+/*
+GenericIssueDetails represents the Audits.GenericIssueDetails type.
+
+Depending on the concrete errorType, different properties are set.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-GenericIssueDetails
+*/
+type GenericIssueDetails struct {
+	// Issues with the same errorType are aggregated in the frontend. Allowed values:
+	//   - GenericIssueErrorType.FormLabelForNameError
+	//   - GenericIssueErrorType.FormDuplicateIDForInputError
+	//   - GenericIssueErrorType.FormInputWithNoLabelError
+	//   - GenericIssueErrorType.FormAutocompleteAttributeEmptyError
+	//   - GenericIssueErrorType.FormEmptyIDAndNameAttributesForInputError
+	//   - GenericIssueErrorType.FormAriaLabelledByToNonExistingID
+	//   - GenericIssueErrorType.FormInputAssignedAutocompleteValueToIDOrNameAttributeError
+	//   - GenericIssueErrorType.FormLabelHasNeitherForNorNestedInput
+	//   - GenericIssueErrorType.FormLabelForMatchesNonExistingIDError
+	//   - GenericIssueErrorType.FormInputHasWrongButWellIntendedAutocompleteValueError
+	//   - GenericIssueErrorType.ResponseWasBlockedByORB
+	ErrorType GenericIssueErrorTypeEnum `json:"errorType"`
+
+	// Optional.
+	FrameID page.FrameID `json:"frameId,omitempty"`
+
+	// Optional.
+	ViolatingNodeID dom.BackendNodeID `json:"violatingNodeId,omitempty"`
+
+	// Optional.
+	ViolatingNodeAttribute string `json:"violatingNodeAttribute,omitempty"`
+
+	// Optional.
+	Request *AffectedRequest `json:"request,omitempty"`
+}
+
+/*
+DeprecationIssueDetails represents the Audits.DeprecationIssueDetails type.
+
+This issue tracks information needed to print a deprecation message.
+https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-DeprecationIssueDetails
+*/
+type DeprecationIssueDetails struct {
+	// Optional.
+	AffectedFrame *AffectedFrame `json:"affectedFrame,omitempty"`
+
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation"`
+
+	// One of the deprecation names from
+	// third_party/blink/renderer/core/frame/deprecation/deprecation.json5
+	Type string `json:"type"`
+}
+
+/*
+BounceTrackingIssueDetails represents the Audits.BounceTrackingIssueDetails
+type.
+
+This issue warns about sites in the redirect chain of a finished navigation that
+may be flagged as trackers and have their state cleared if they don't receive a
+user interaction. Note that in this context 'site' means eTLD+1. For example, if
+the URL `https://example.test:80/bounce` was in the redirect chain, the site
+reported would be `example.test`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-BounceTrackingIssueDetails
+*/
+type BounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+/*
+CookieDeprecationMetadataIssueDetails represents the
+Audits.CookieDeprecationMetadataIssueDetails type.
+
+This issue warns about third-party sites that are accessing cookies on the
+current page, and have been permitted due to having a global metadata grant.
+Note that in this context 'site' means eTLD+1. For example, if the URL
+`https://example.test:80/web_page` was accessing cookies, the site reported
+would be `example.test`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieDeprecationMetadataIssueDetails
+*/
+type CookieDeprecationMetadataIssueDetails struct {
+	AllowedSites []string `json:"allowedSites"`
+
+	OptOutPercentage float64 `json:"optOutPercentage"`
+
+	IsOptOutTopLevel bool `json:"isOptOutTopLevel"`
+
+	// Allowed values:
+	//   - CookieOperation.SetCookie
+	//   - CookieOperation.ReadCookie
+	Operation CookieOperationEnum `json:"operation"`
+}
+
+/*
+FederatedAuthRequestIssueDetails represents the
+Audits.FederatedAuthRequestIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-FederatedAuthRequestIssueDetails
+*/
+type FederatedAuthRequestIssueDetails struct {
+	// Allowed values:
+	//   - FederatedAuthRequestIssueReason.ShouldEmbargo
+	//   - FederatedAuthRequestIssueReason.TooManyRequests
+	//   - FederatedAuthRequestIssueReason.WellKnownHTTPNotFound
+	//   - FederatedAuthRequestIssueReason.WellKnownNoResponse
+	//   - FederatedAuthRequestIssueReason.WellKnownInvalidResponse
+	//   - FederatedAuthRequestIssueReason.WellKnownListEmpty
+	//   - FederatedAuthRequestIssueReason.WellKnownInvalidContentType
+	//   - FederatedAuthRequestIssueReason.ConfigNotInWellKnown
+	//   - FederatedAuthRequestIssueReason.WellKnownTooBig
+	//   - FederatedAuthRequestIssueReason.ConfigHTTPNotFound
+	//   - FederatedAuthRequestIssueReason.ConfigNoResponse
+	//   - FederatedAuthRequestIssueReason.ConfigInvalidResponse
+	//   - FederatedAuthRequestIssueReason.ConfigInvalidContentType
+	//   - FederatedAuthRequestIssueReason.ClientMetadataHTTPNotFound
+	//   - FederatedAuthRequestIssueReason.ClientMetadataNoResponse
+	//   - FederatedAuthRequestIssueReason.ClientMetadataInvalidResponse
+	//   - FederatedAuthRequestIssueReason.ClientMetadataInvalidContentType
+	//   - FederatedAuthRequestIssueReason.IdpNotPotentiallyTrustworthy
+	//   - FederatedAuthRequestIssueReason.DisabledInSettings
+	//   - FederatedAuthRequestIssueReason.DisabledInFlags
+	//   - FederatedAuthRequestIssueReason.ErrorFetchingSignin
+	//   - FederatedAuthRequestIssueReason.InvalidSigninResponse
+	//   - FederatedAuthRequestIssueReason.AccountsHTTPNotFound
+	//   - FederatedAuthRequestIssueReason.AccountsNoResponse
+	//   - FederatedAuthRequestIssueReason.AccountsInvalidResponse
+	//   - FederatedAuthRequestIssueReason.AccountsListEmpty
+	//   - FederatedAuthRequestIssueReason.AccountsInvalidContentType
+	//   - FederatedAuthRequestIssueReason.IDTokenHTTPNotFound
+	//   - FederatedAuthRequestIssueReason.IDTokenNoResponse
+	//   - FederatedAuthRequestIssueReason.IDTokenInvalidResponse
+	//   - FederatedAuthRequestIssueReason.IDTokenIdpErrorResponse
+	//   - FederatedAuthRequestIssueReason.IDTokenCrossSiteIdpErrorResponse
+	//   - FederatedAuthRequestIssueReason.IDTokenInvalidRequest
+	//   - FederatedAuthRequestIssueReason.IDTokenInvalidContentType
+	//   - FederatedAuthRequestIssueReason.ErrorIDToken
+	//   - FederatedAuthRequestIssueReason.Canceled
+	//   - FederatedAuthRequestIssueReason.RpPageNotVisible
+	//   - FederatedAuthRequestIssueReason.SilentMediationFailure
+	//   - FederatedAuthRequestIssueReason.ThirdPartyCookiesBlocked
+	//   - FederatedAuthRequestIssueReason.NotSignedInWithIdp
+	//   - FederatedAuthRequestIssueReason.MissingTransientUserActivation
+	//   - FederatedAuthRequestIssueReason.ReplacedByActiveMode
+	//   - FederatedAuthRequestIssueReason.InvalidFieldsSpecified
+	//   - FederatedAuthRequestIssueReason.RelyingPartyOriginIsOpaque
+	//   - FederatedAuthRequestIssueReason.TypeNotMatching
+	//   - FederatedAuthRequestIssueReason.UIDismissedNoEmbargo
+	//   - FederatedAuthRequestIssueReason.CorsError
+	//   - FederatedAuthRequestIssueReason.SuppressedBySegmentationPlatform
+	FederatedAuthRequestIssueReason FederatedAuthRequestIssueReasonEnum `json:"federatedAuthRequestIssueReason"`
+}
+
+/*
+FederatedAuthUserInfoRequestIssueDetails represents the
+Audits.FederatedAuthUserInfoRequestIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-FederatedAuthUserInfoRequestIssueDetails
+*/
+type FederatedAuthUserInfoRequestIssueDetails struct {
+	// Allowed values:
+	//   - FederatedAuthUserInfoRequestIssueReason.NotSameOrigin
+	//   - FederatedAuthUserInfoRequestIssueReason.NotIframe
+	//   - FederatedAuthUserInfoRequestIssueReason.NotPotentiallyTrustworthy
+	//   - FederatedAuthUserInfoRequestIssueReason.NoAPIPermission
+	//   - FederatedAuthUserInfoRequestIssueReason.NotSignedInWithIdp
+	//   - FederatedAuthUserInfoRequestIssueReason.NoAccountSharingPermission
+	//   - FederatedAuthUserInfoRequestIssueReason.InvalidConfigOrWellKnown
+	//   - FederatedAuthUserInfoRequestIssueReason.InvalidAccountsResponse
+	//   - FederatedAuthUserInfoRequestIssueReason.NoReturningUserFromFetchedAccounts
+	FederatedAuthUserInfoRequestIssueReason FederatedAuthUserInfoRequestIssueReasonEnum `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+/*
+ClientHintIssueDetails represents the Audits.ClientHintIssueDetails type.
+
+This issue tracks client hints related issues. It's used to deprecate old
+features, encourage the use of new ones, and provide general guidance.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-ClientHintIssueDetails
+*/
+type ClientHintIssueDetails struct {
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation"`
+
+	// Allowed values:
+	//   - ClientHintIssueReason.MetaTagAllowListInvalidOrigin
+	//   - ClientHintIssueReason.MetaTagModifiedHTML
+	ClientHintIssueReason ClientHintIssueReasonEnum `json:"clientHintIssueReason"`
+}
+
+/*
+FailedRequestInfo represents the Audits.FailedRequestInfo type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-FailedRequestInfo
+*/
+type FailedRequestInfo struct {
+	// The URL that failed to load.
+	URL string `json:"url"`
+
+	// The failure message for the failed request.
+	FailureMessage string `json:"failureMessage"`
+
+	// Optional.
+	RequestID network.RequestID `json:"requestId,omitempty"`
+}
+
+/*
+PartitioningBlobURLIssueDetails represents the
+Audits.PartitioningBlobURLIssueDetails type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-PartitioningBlobURLIssueDetails
+*/
+type PartitioningBlobURLIssueDetails struct {
+	// The BlobURL that failed to load.
+	URL string `json:"url"`
+
+	// Additional information about the Partitioning Blob URL issue. Allowed values:
+	//   - PartitioningBlobURLInfo.BlockedCrossPartitionFetching
+	//   - PartitioningBlobURLInfo.EnforceNoopenerForNavigation
+	PartitioningBlobURLInfo PartitioningBlobURLInfoEnum `json:"partitioningBlobURLInfo"`
+}
+
+/*
+ElementAccessibilityIssueDetails represents the
+Audits.ElementAccessibilityIssueDetails type.
+
+This issue warns about errors in the select or summary element content model.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-ElementAccessibilityIssueDetails
+*/
+type ElementAccessibilityIssueDetails struct {
+	NodeID dom.BackendNodeID `json:"nodeId"`
+
+	// Allowed values:
+	//   - ElementAccessibilityIssueReason.DisallowedSelectChild
+	//   - ElementAccessibilityIssueReason.DisallowedOptGroupChild
+	//   - ElementAccessibilityIssueReason.NonPhrasingContentOptionChild
+	//   - ElementAccessibilityIssueReason.InteractiveContentOptionChild
+	//   - ElementAccessibilityIssueReason.InteractiveContentLegendChild
+	//   - ElementAccessibilityIssueReason.InteractiveContentSummaryDescendant
+	ElementAccessibilityIssueReason ElementAccessibilityIssueReasonEnum `json:"elementAccessibilityIssueReason"`
+
+	HasDisallowedAttributes bool `json:"hasDisallowedAttributes"`
+}
+
+/*
+StylesheetLoadingIssueDetails represents the
+Audits.StylesheetLoadingIssueDetails type.
+
+This issue warns when a referenced stylesheet couldn't be loaded.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-StylesheetLoadingIssueDetails
+*/
+type StylesheetLoadingIssueDetails struct {
+	// Source code position that referenced the failing stylesheet.
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation"`
+
+	// Reason why the stylesheet couldn't be loaded. Allowed values:
+	//   - StyleSheetLoadingIssueReason.LateImportRule
+	//   - StyleSheetLoadingIssueReason.RequestFailed
+	StyleSheetLoadingIssueReason StyleSheetLoadingIssueReasonEnum `json:"styleSheetLoadingIssueReason"`
+
+	// Optional. Contains additional info when the failure was due to a request.
+	FailedRequestInfo *FailedRequestInfo `json:"failedRequestInfo,omitempty"`
+}
+
+/*
+PropertyRuleIssueDetails represents the Audits.PropertyRuleIssueDetails type.
+
+This issue warns about errors in property rules that lead to property
+registrations being ignored.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-PropertyRuleIssueDetails
+*/
+type PropertyRuleIssueDetails struct {
+	// Source code position of the property rule.
+	SourceCodeLocation *SourceCodeLocation `json:"sourceCodeLocation"`
+
+	// Reason why the property rule was discarded. Allowed values:
+	//   - PropertyRuleIssueReason.InvalidSyntax
+	//   - PropertyRuleIssueReason.InvalidInitialValue
+	//   - PropertyRuleIssueReason.InvalidInherits
+	//   - PropertyRuleIssueReason.InvalidName
+	PropertyRuleIssueReason PropertyRuleIssueReasonEnum `json:"propertyRuleIssueReason"`
+
+	// Optional. The value of the property rule property that failed to parse
+	PropertyValue string `json:"propertyValue,omitempty"`
+}
+
+/*
+UserReidentificationIssueDetails represents the
+Audits.UserReidentificationIssueDetails type.
+
+This issue warns about uses of APIs that may be considered misuse to re-identify
+users.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-UserReidentificationIssueDetails
+*/
+type UserReidentificationIssueDetails struct {
+	// Allowed values:
+	//   - UserReidentificationIssueType.BlockedFrameNavigation
+	//   - UserReidentificationIssueType.BlockedSubresource
+	Type UserReidentificationIssueTypeEnum `json:"type"`
+
+	// Optional. Applies to BlockedFrameNavigation and BlockedSubresource issue
+	// types.
+	Request *AffectedRequest `json:"request,omitempty"`
+}
+
+/*
+InspectorIssueDetails represents the Audits.InspectorIssueDetails type.
+
+This struct holds a list of optional fields with additional information specific
+to the kind of issue. When adding a new issue code, please also add a new
+optional field to this type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-InspectorIssueDetails
+*/
+type InspectorIssueDetails struct {
+	// Optional.
+	CookieIssueDetails *CookieIssueDetails `json:"cookieIssueDetails,omitempty"`
+
+	// Optional.
+	MixedContentIssueDetails *MixedContentIssueDetails `json:"mixedContentIssueDetails,omitempty"`
+
+	// Optional.
+	BlockedByResponseIssueDetails *BlockedByResponseIssueDetails `json:"blockedByResponseIssueDetails,omitempty"`
+
+	// Optional.
+	HeavyAdIssueDetails *HeavyAdIssueDetails `json:"heavyAdIssueDetails,omitempty"`
+
+	// Optional.
+	ContentSecurityPolicyIssueDetails *ContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"`
+
+	// Optional.
+	SharedArrayBufferIssueDetails *SharedArrayBufferIssueDetails `json:"sharedArrayBufferIssueDetails,omitempty"`
+
+	// Optional.
+	LowTextContrastIssueDetails *LowTextContrastIssueDetails `json:"lowTextContrastIssueDetails,omitempty"`
+
+	// Optional.
+	CorsIssueDetails *CorsIssueDetails `json:"corsIssueDetails,omitempty"`
+
+	// Optional.
+	AttributionReportingIssueDetails *AttributionReportingIssueDetails `json:"attributionReportingIssueDetails,omitempty"`
+
+	// Optional.
+	QuirksModeIssueDetails *QuirksModeIssueDetails `json:"quirksModeIssueDetails,omitempty"`
+
+	// Optional.
+	PartitioningBlobURLIssueDetails *PartitioningBlobURLIssueDetails `json:"partitioningBlobURLIssueDetails,omitempty"`
+
+	// Optional. DEPRECATED.
+	NavigatorUserAgentIssueDetails *NavigatorUserAgentIssueDetails `json:"navigatorUserAgentIssueDetails,omitempty"`
+
+	// Optional.
+	GenericIssueDetails *GenericIssueDetails `json:"genericIssueDetails,omitempty"`
+
+	// Optional.
+	DeprecationIssueDetails *DeprecationIssueDetails `json:"deprecationIssueDetails,omitempty"`
+
+	// Optional.
+	ClientHintIssueDetails *ClientHintIssueDetails `json:"clientHintIssueDetails,omitempty"`
+
+	// Optional.
+	FederatedAuthRequestIssueDetails *FederatedAuthRequestIssueDetails `json:"federatedAuthRequestIssueDetails,omitempty"`
+
+	// Optional.
+	BounceTrackingIssueDetails *BounceTrackingIssueDetails `json:"bounceTrackingIssueDetails,omitempty"`
+
+	// Optional.
+	CookieDeprecationMetadataIssueDetails *CookieDeprecationMetadataIssueDetails `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+
+	// Optional.
+	StylesheetLoadingIssueDetails *StylesheetLoadingIssueDetails `json:"stylesheetLoadingIssueDetails,omitempty"`
+
+	// Optional.
+	PropertyRuleIssueDetails *PropertyRuleIssueDetails `json:"propertyRuleIssueDetails,omitempty"`
+
+	// Optional.
+	FederatedAuthUserInfoRequestIssueDetails *FederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+
+	// Optional.
+	SharedDictionaryIssueDetails *SharedDictionaryIssueDetails `json:"sharedDictionaryIssueDetails,omitempty"`
+
+	// Optional.
+	ElementAccessibilityIssueDetails *ElementAccessibilityIssueDetails `json:"elementAccessibilityIssueDetails,omitempty"`
+
+	// Optional.
+	SriMessageSignatureIssueDetails *SRIMessageSignatureIssueDetails `json:"sriMessageSignatureIssueDetails,omitempty"`
+
+	// Optional.
+	UnencodedDigestIssueDetails *UnencodedDigestIssueDetails `json:"unencodedDigestIssueDetails,omitempty"`
+
+	// Optional.
+	UserReidentificationIssueDetails *UserReidentificationIssueDetails `json:"userReidentificationIssueDetails,omitempty"`
+}
+
+/*
+IssueID represents the Audits.IssueId type.
+
+A unique id for a DevTools inspector issue. Allows other entities (e.g.
+exceptions, CDP message, console messages, etc.) to reference an issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-IssueId
+*/
+type IssueID string
+
+/*
+InspectorIssue represents the Audits.InspectorIssue type.
+
+An inspector issue reported from the back-end.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-InspectorIssue
+*/
+type InspectorIssue struct {
+	// Allowed values:
+	//   - InspectorIssueCode.CookieIssue
+	//   - InspectorIssueCode.MixedContentIssue
+	//   - InspectorIssueCode.BlockedByResponseIssue
+	//   - InspectorIssueCode.HeavyAdIssue
+	//   - InspectorIssueCode.ContentSecurityPolicyIssue
+	//   - InspectorIssueCode.SharedArrayBufferIssue
+	//   - InspectorIssueCode.LowTextContrastIssue
+	//   - InspectorIssueCode.CorsIssue
+	//   - InspectorIssueCode.AttributionReportingIssue
+	//   - InspectorIssueCode.QuirksModeIssue
+	//   - InspectorIssueCode.PartitioningBlobURLIssue
+	//   - InspectorIssueCode.NavigatorUserAgentIssue
+	//   - InspectorIssueCode.GenericIssue
+	//   - InspectorIssueCode.DeprecationIssue
+	//   - InspectorIssueCode.ClientHintIssue
+	//   - InspectorIssueCode.FederatedAuthRequestIssue
+	//   - InspectorIssueCode.BounceTrackingIssue
+	//   - InspectorIssueCode.CookieDeprecationMetadataIssue
+	//   - InspectorIssueCode.StylesheetLoadingIssue
+	//   - InspectorIssueCode.FederatedAuthUserInfoRequestIssue
+	//   - InspectorIssueCode.PropertyRuleIssue
+	//   - InspectorIssueCode.SharedDictionaryIssue
+	//   - InspectorIssueCode.ElementAccessibilityIssue
+	//   - InspectorIssueCode.SRIMessageSignatureIssue
+	//   - InspectorIssueCode.UnencodedDigestIssue
+	//   - InspectorIssueCode.UserReidentificationIssue
+	Code InspectorIssueCodeEnum `json:"code"`
+
+	Details *InspectorIssueDetails `json:"details"`
+
+	// Optional. A unique id for this issue. May be omitted if no other entity
+	// (e.g. exception, CDP message, etc.) is referencing this issue.
+	IssueID IssueID `json:"issueId,omitempty"`
+}
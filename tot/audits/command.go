@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package audits
 
 import (
@@ -5,7 +7,7 @@ import (
 )
 
 /*
-GetEncodedResponseParams represents Audits.getEncodedResponse parameters
+GetEncodedResponseParams represents Audits.getEncodedResponse parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncodedResponse
 */
@@ -14,16 +16,16 @@ type GetEncodedResponseParams struct {
 	RequestID network.RequestID `json:"requestId"`
 
 	// The encoding to use. Allowed values:
-	//	- Encoding.Webp
-	//	- Encoding.Jpeg
-	//	- Encoding.Png
+	//   - Encoding.Webp
+	//   - Encoding.Jpeg
+	//   - Encoding.Png
 	Encoding EncodingEnum `json:"encoding"`
 
-	// Optional. The quality of the encoding (0-1). (defaults to 1).
-	Quality float64 `json:"quality,omitempty"`
+	// Optional. The quality of the encoding (0-1). (defaults to 1)
+	Quality *float64 `json:"quality,omitempty"`
 
-	// Optional Whether to only return the size information (defaults to false).
-	SizeOnly bool `json:"sizeOnly,omitempty"`
+	// Optional. Whether to only return the size information (defaults to false).
+	SizeOnly *bool `json:"sizeOnly,omitempty"`
 }
 
 /*
@@ -32,15 +34,67 @@ GetEncodedResponseResult represents the result of calls to Audits.getEncodedResp
 https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncodedResponse
 */
 type GetEncodedResponseResult struct {
-	// Optional. The encoded body as a base64 string. Omitted if sizeOnly is
-	// true.
+	// Optional. The encoded body as a base64 string. Omitted if sizeOnly is true.
+	// (Encoded as a base64 string when passed over JSON)
 	Body string `json:"body,omitempty"`
 
 	// Size before re-encoding.
-	OriginalSize int64 `json:"originalSize"`
+	OriginalSize int `json:"originalSize"`
 
 	// Size after re-encoding.
-	EncodedSize int64 `json:"encodedSize"`
+	EncodedSize int `json:"encodedSize"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Audits.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Audits.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CheckContrastParams represents Audits.checkContrast parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-checkContrast
+*/
+type CheckContrastParams struct {
+	// Optional. Whether to report WCAG AAA level issues. Default is false.
+	ReportAAA *bool `json:"reportAAA,omitempty"`
+}
+
+/*
+CheckContrastResult represents the result of calls to Audits.checkContrast.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-checkContrast
+*/
+type CheckContrastResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CheckFormsIssuesResult represents the result of calls to Audits.checkFormsIssues.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-checkFormsIssues
+*/
+type CheckFormsIssuesResult struct {
+	FormIssues []*GenericIssueDetails `json:"formIssues"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumClientHintIssueReason(t *testing.T) {
+	var enum ClientHintIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ClientHintIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ClientHintIssueReason.MetaTagAllowListInvalidOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MetaTagAllowListInvalidOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MetaTagAllowListInvalidOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"MetaTagAllowListInvalidOrigin"`), &enum)
+	if ClientHintIssueReason.MetaTagAllowListInvalidOrigin != enum {
+		t.Errorf("Expected %d, got %d", ClientHintIssueReason.MetaTagAllowListInvalidOrigin, enum)
+	}
+
+	enum = ClientHintIssueReason.MetaTagModifiedHTML
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MetaTagModifiedHTML"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MetaTagModifiedHTML"`, result)
+	}
+	json.Unmarshal([]byte(`"MetaTagModifiedHTML"`), &enum)
+	if ClientHintIssueReason.MetaTagModifiedHTML != enum {
+		t.Errorf("Expected %d, got %d", ClientHintIssueReason.MetaTagModifiedHTML, enum)
+	}
+}
@@ -0,0 +1,113 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contentSecurityPolicyViolationTypeEnum struct {
+	KInlineViolation             ContentSecurityPolicyViolationTypeEnum
+	KEvalViolation               ContentSecurityPolicyViolationTypeEnum
+	KURLViolation                ContentSecurityPolicyViolationTypeEnum
+	KSRIViolation                ContentSecurityPolicyViolationTypeEnum
+	KTrustedTypesSinkViolation   ContentSecurityPolicyViolationTypeEnum
+	KTrustedTypesPolicyViolation ContentSecurityPolicyViolationTypeEnum
+	KWasmEvalViolation           ContentSecurityPolicyViolationTypeEnum
+}
+
+/*
+ContentSecurityPolicyViolationType provides named access to the ContentSecurityPolicyViolationTypeEnum values.
+*/
+var ContentSecurityPolicyViolationType = contentSecurityPolicyViolationTypeEnum{
+	KInlineViolation:             contentSecurityPolicyViolationTypeKInlineViolation,
+	KEvalViolation:               contentSecurityPolicyViolationTypeKEvalViolation,
+	KURLViolation:                contentSecurityPolicyViolationTypeKURLViolation,
+	KSRIViolation:                contentSecurityPolicyViolationTypeKSRIViolation,
+	KTrustedTypesSinkViolation:   contentSecurityPolicyViolationTypeKTrustedTypesSinkViolation,
+	KTrustedTypesPolicyViolation: contentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation,
+	KWasmEvalViolation:           contentSecurityPolicyViolationTypeKWasmEvalViolation,
+}
+
+/*
+ContentSecurityPolicyViolationTypeEnum represents the
+Audits.ContentSecurityPolicyViolationType values.
+
+Allowed values:
+  - ContentSecurityPolicyViolationType.KInlineViolation             "kInlineViolation"
+  - ContentSecurityPolicyViolationType.KEvalViolation               "kEvalViolation"
+  - ContentSecurityPolicyViolationType.KURLViolation                "kURLViolation"
+  - ContentSecurityPolicyViolationType.KSRIViolation                "kSRIViolation"
+  - ContentSecurityPolicyViolationType.KTrustedTypesSinkViolation   "kTrustedTypesSinkViolation"
+  - ContentSecurityPolicyViolationType.KTrustedTypesPolicyViolation "kTrustedTypesPolicyViolation"
+  - ContentSecurityPolicyViolationType.KWasmEvalViolation           "kWasmEvalViolation"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-ContentSecurityPolicyViolationType
+*/
+type ContentSecurityPolicyViolationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContentSecurityPolicyViolationTypeEnum) String() string {
+	return _contentSecurityPolicyViolationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContentSecurityPolicyViolationTypeEnum) Unknown() bool {
+	return !_contentSecurityPolicyViolationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContentSecurityPolicyViolationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContentSecurityPolicyViolationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contentSecurityPolicyViolationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contentSecurityPolicyViolationTypeKInlineViolation represents the "kInlineViolation" value.
+	contentSecurityPolicyViolationTypeKInlineViolation ContentSecurityPolicyViolationTypeEnum = iota + 1
+	// contentSecurityPolicyViolationTypeKEvalViolation represents the "kEvalViolation" value.
+	contentSecurityPolicyViolationTypeKEvalViolation
+	// contentSecurityPolicyViolationTypeKURLViolation represents the "kURLViolation" value.
+	contentSecurityPolicyViolationTypeKURLViolation
+	// contentSecurityPolicyViolationTypeKSRIViolation represents the "kSRIViolation" value.
+	contentSecurityPolicyViolationTypeKSRIViolation
+	// contentSecurityPolicyViolationTypeKTrustedTypesSinkViolation represents the "kTrustedTypesSinkViolation" value.
+	contentSecurityPolicyViolationTypeKTrustedTypesSinkViolation
+	// contentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation represents the "kTrustedTypesPolicyViolation" value.
+	contentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation
+	// contentSecurityPolicyViolationTypeKWasmEvalViolation represents the "kWasmEvalViolation" value.
+	contentSecurityPolicyViolationTypeKWasmEvalViolation
+)
+
+var _contentSecurityPolicyViolationTypeEnums = enums.New(map[ContentSecurityPolicyViolationTypeEnum]string{
+	ContentSecurityPolicyViolationTypeEnum(0):                      "",
+	contentSecurityPolicyViolationTypeKInlineViolation:             "kInlineViolation",
+	contentSecurityPolicyViolationTypeKEvalViolation:               "kEvalViolation",
+	contentSecurityPolicyViolationTypeKURLViolation:                "kURLViolation",
+	contentSecurityPolicyViolationTypeKSRIViolation:                "kSRIViolation",
+	contentSecurityPolicyViolationTypeKTrustedTypesSinkViolation:   "kTrustedTypesSinkViolation",
+	contentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation: "kTrustedTypesPolicyViolation",
+	contentSecurityPolicyViolationTypeKWasmEvalViolation:           "kWasmEvalViolation",
+})
@@ -0,0 +1,136 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieExclusionReasonEnum struct {
+	ExcludeSameSiteUnspecifiedTreatedAsLax        CookieExclusionReasonEnum
+	ExcludeSameSiteNoneInsecure                   CookieExclusionReasonEnum
+	ExcludeSameSiteLax                            CookieExclusionReasonEnum
+	ExcludeSameSiteStrict                         CookieExclusionReasonEnum
+	ExcludeInvalidSameParty                       CookieExclusionReasonEnum
+	ExcludeSamePartyCrossPartyContext             CookieExclusionReasonEnum
+	ExcludeDomainNonASCII                         CookieExclusionReasonEnum
+	ExcludeThirdPartyCookieBlockedInFirstPartySet CookieExclusionReasonEnum
+	ExcludeThirdPartyPhaseout                     CookieExclusionReasonEnum
+	ExcludePortMismatch                           CookieExclusionReasonEnum
+	ExcludeSchemeMismatch                         CookieExclusionReasonEnum
+}
+
+/*
+CookieExclusionReason provides named access to the CookieExclusionReasonEnum values.
+*/
+var CookieExclusionReason = cookieExclusionReasonEnum{
+	ExcludeSameSiteUnspecifiedTreatedAsLax:        cookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax,
+	ExcludeSameSiteNoneInsecure:                   cookieExclusionReasonExcludeSameSiteNoneInsecure,
+	ExcludeSameSiteLax:                            cookieExclusionReasonExcludeSameSiteLax,
+	ExcludeSameSiteStrict:                         cookieExclusionReasonExcludeSameSiteStrict,
+	ExcludeInvalidSameParty:                       cookieExclusionReasonExcludeInvalidSameParty,
+	ExcludeSamePartyCrossPartyContext:             cookieExclusionReasonExcludeSamePartyCrossPartyContext,
+	ExcludeDomainNonASCII:                         cookieExclusionReasonExcludeDomainNonASCII,
+	ExcludeThirdPartyCookieBlockedInFirstPartySet: cookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet,
+	ExcludeThirdPartyPhaseout:                     cookieExclusionReasonExcludeThirdPartyPhaseout,
+	ExcludePortMismatch:                           cookieExclusionReasonExcludePortMismatch,
+	ExcludeSchemeMismatch:                         cookieExclusionReasonExcludeSchemeMismatch,
+}
+
+/*
+CookieExclusionReasonEnum represents the Audits.CookieExclusionReason values.
+
+Allowed values:
+  - CookieExclusionReason.ExcludeSameSiteUnspecifiedTreatedAsLax        "ExcludeSameSiteUnspecifiedTreatedAsLax"
+  - CookieExclusionReason.ExcludeSameSiteNoneInsecure                   "ExcludeSameSiteNoneInsecure"
+  - CookieExclusionReason.ExcludeSameSiteLax                            "ExcludeSameSiteLax"
+  - CookieExclusionReason.ExcludeSameSiteStrict                         "ExcludeSameSiteStrict"
+  - CookieExclusionReason.ExcludeInvalidSameParty                       "ExcludeInvalidSameParty"
+  - CookieExclusionReason.ExcludeSamePartyCrossPartyContext             "ExcludeSamePartyCrossPartyContext"
+  - CookieExclusionReason.ExcludeDomainNonASCII                         "ExcludeDomainNonASCII"
+  - CookieExclusionReason.ExcludeThirdPartyCookieBlockedInFirstPartySet "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+  - CookieExclusionReason.ExcludeThirdPartyPhaseout                     "ExcludeThirdPartyPhaseout"
+  - CookieExclusionReason.ExcludePortMismatch                           "ExcludePortMismatch"
+  - CookieExclusionReason.ExcludeSchemeMismatch                         "ExcludeSchemeMismatch"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieExclusionReason
+*/
+type CookieExclusionReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieExclusionReasonEnum) String() string {
+	return _cookieExclusionReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieExclusionReasonEnum) Unknown() bool {
+	return !_cookieExclusionReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieExclusionReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieExclusionReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieExclusionReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax represents the "ExcludeSameSiteUnspecifiedTreatedAsLax" value.
+	cookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax CookieExclusionReasonEnum = iota + 1
+	// cookieExclusionReasonExcludeSameSiteNoneInsecure represents the "ExcludeSameSiteNoneInsecure" value.
+	cookieExclusionReasonExcludeSameSiteNoneInsecure
+	// cookieExclusionReasonExcludeSameSiteLax represents the "ExcludeSameSiteLax" value.
+	cookieExclusionReasonExcludeSameSiteLax
+	// cookieExclusionReasonExcludeSameSiteStrict represents the "ExcludeSameSiteStrict" value.
+	cookieExclusionReasonExcludeSameSiteStrict
+	// cookieExclusionReasonExcludeInvalidSameParty represents the "ExcludeInvalidSameParty" value.
+	cookieExclusionReasonExcludeInvalidSameParty
+	// cookieExclusionReasonExcludeSamePartyCrossPartyContext represents the "ExcludeSamePartyCrossPartyContext" value.
+	cookieExclusionReasonExcludeSamePartyCrossPartyContext
+	// cookieExclusionReasonExcludeDomainNonASCII represents the "ExcludeDomainNonASCII" value.
+	cookieExclusionReasonExcludeDomainNonASCII
+	// cookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet represents the "ExcludeThirdPartyCookieBlockedInFirstPartySet" value.
+	cookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet
+	// cookieExclusionReasonExcludeThirdPartyPhaseout represents the "ExcludeThirdPartyPhaseout" value.
+	cookieExclusionReasonExcludeThirdPartyPhaseout
+	// cookieExclusionReasonExcludePortMismatch represents the "ExcludePortMismatch" value.
+	cookieExclusionReasonExcludePortMismatch
+	// cookieExclusionReasonExcludeSchemeMismatch represents the "ExcludeSchemeMismatch" value.
+	cookieExclusionReasonExcludeSchemeMismatch
+)
+
+var _cookieExclusionReasonEnums = enums.New(map[CookieExclusionReasonEnum]string{
+	CookieExclusionReasonEnum(0):                                       "",
+	cookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax:        "ExcludeSameSiteUnspecifiedTreatedAsLax",
+	cookieExclusionReasonExcludeSameSiteNoneInsecure:                   "ExcludeSameSiteNoneInsecure",
+	cookieExclusionReasonExcludeSameSiteLax:                            "ExcludeSameSiteLax",
+	cookieExclusionReasonExcludeSameSiteStrict:                         "ExcludeSameSiteStrict",
+	cookieExclusionReasonExcludeInvalidSameParty:                       "ExcludeInvalidSameParty",
+	cookieExclusionReasonExcludeSamePartyCrossPartyContext:             "ExcludeSamePartyCrossPartyContext",
+	cookieExclusionReasonExcludeDomainNonASCII:                         "ExcludeDomainNonASCII",
+	cookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet: "ExcludeThirdPartyCookieBlockedInFirstPartySet",
+	cookieExclusionReasonExcludeThirdPartyPhaseout:                     "ExcludeThirdPartyPhaseout",
+	cookieExclusionReasonExcludePortMismatch:                           "ExcludePortMismatch",
+	cookieExclusionReasonExcludeSchemeMismatch:                         "ExcludeSchemeMismatch",
+})
@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieExclusionReason(t *testing.T) {
+	var enum CookieExclusionReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieExclusionReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieExclusionReason.ExcludeSameSiteUnspecifiedTreatedAsLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSameSiteUnspecifiedTreatedAsLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSameSiteUnspecifiedTreatedAsLax"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSameSiteUnspecifiedTreatedAsLax"`), &enum)
+	if CookieExclusionReason.ExcludeSameSiteUnspecifiedTreatedAsLax != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSameSiteUnspecifiedTreatedAsLax, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeSameSiteNoneInsecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSameSiteNoneInsecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSameSiteNoneInsecure"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSameSiteNoneInsecure"`), &enum)
+	if CookieExclusionReason.ExcludeSameSiteNoneInsecure != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSameSiteNoneInsecure, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeSameSiteLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSameSiteLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSameSiteLax"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSameSiteLax"`), &enum)
+	if CookieExclusionReason.ExcludeSameSiteLax != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSameSiteLax, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeSameSiteStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSameSiteStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSameSiteStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSameSiteStrict"`), &enum)
+	if CookieExclusionReason.ExcludeSameSiteStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSameSiteStrict, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeInvalidSameParty
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeInvalidSameParty"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeInvalidSameParty"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeInvalidSameParty"`), &enum)
+	if CookieExclusionReason.ExcludeInvalidSameParty != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeInvalidSameParty, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeSamePartyCrossPartyContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSamePartyCrossPartyContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSamePartyCrossPartyContext"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSamePartyCrossPartyContext"`), &enum)
+	if CookieExclusionReason.ExcludeSamePartyCrossPartyContext != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSamePartyCrossPartyContext, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeDomainNonASCII
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeDomainNonASCII"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeDomainNonASCII"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeDomainNonASCII"`), &enum)
+	if CookieExclusionReason.ExcludeDomainNonASCII != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeDomainNonASCII, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeThirdPartyCookieBlockedInFirstPartySet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeThirdPartyCookieBlockedInFirstPartySet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeThirdPartyCookieBlockedInFirstPartySet"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeThirdPartyCookieBlockedInFirstPartySet"`), &enum)
+	if CookieExclusionReason.ExcludeThirdPartyCookieBlockedInFirstPartySet != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeThirdPartyCookieBlockedInFirstPartySet, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeThirdPartyPhaseout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeThirdPartyPhaseout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeThirdPartyPhaseout"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeThirdPartyPhaseout"`), &enum)
+	if CookieExclusionReason.ExcludeThirdPartyPhaseout != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeThirdPartyPhaseout, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludePortMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludePortMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludePortMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludePortMismatch"`), &enum)
+	if CookieExclusionReason.ExcludePortMismatch != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludePortMismatch, enum)
+	}
+
+	enum = CookieExclusionReason.ExcludeSchemeMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExcludeSchemeMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExcludeSchemeMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"ExcludeSchemeMismatch"`), &enum)
+	if CookieExclusionReason.ExcludeSchemeMismatch != enum {
+		t.Errorf("Expected %d, got %d", CookieExclusionReason.ExcludeSchemeMismatch, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieOperationEnum struct {
+	SetCookie  CookieOperationEnum
+	ReadCookie CookieOperationEnum
+}
+
+/*
+CookieOperation provides named access to the CookieOperationEnum values.
+*/
+var CookieOperation = cookieOperationEnum{
+	SetCookie:  cookieOperationSetCookie,
+	ReadCookie: cookieOperationReadCookie,
+}
+
+/*
+CookieOperationEnum represents the Audits.CookieOperation values.
+
+Allowed values:
+  - CookieOperation.SetCookie  "SetCookie"
+  - CookieOperation.ReadCookie "ReadCookie"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieOperation
+*/
+type CookieOperationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieOperationEnum) String() string {
+	return _cookieOperationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieOperationEnum) Unknown() bool {
+	return !_cookieOperationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieOperationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieOperationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieOperationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieOperationSetCookie represents the "SetCookie" value.
+	cookieOperationSetCookie CookieOperationEnum = iota + 1
+	// cookieOperationReadCookie represents the "ReadCookie" value.
+	cookieOperationReadCookie
+)
+
+var _cookieOperationEnums = enums.New(map[CookieOperationEnum]string{
+	CookieOperationEnum(0):    "",
+	cookieOperationSetCookie:  "SetCookie",
+	cookieOperationReadCookie: "ReadCookie",
+})
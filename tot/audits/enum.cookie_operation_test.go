@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieOperation(t *testing.T) {
+	var enum CookieOperationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieOperationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieOperation.SetCookie
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SetCookie"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SetCookie"`, result)
+	}
+	json.Unmarshal([]byte(`"SetCookie"`), &enum)
+	if CookieOperation.SetCookie != enum {
+		t.Errorf("Expected %d, got %d", CookieOperation.SetCookie, enum)
+	}
+
+	enum = CookieOperation.ReadCookie
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ReadCookie"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ReadCookie"`, result)
+	}
+	json.Unmarshal([]byte(`"ReadCookie"`), &enum)
+	if CookieOperation.ReadCookie != enum {
+		t.Errorf("Expected %d, got %d", CookieOperation.ReadCookie, enum)
+	}
+}
@@ -0,0 +1,154 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieWarningReasonEnum struct {
+	WarnSameSiteUnspecifiedCrossSiteContext        CookieWarningReasonEnum
+	WarnSameSiteNoneInsecure                       CookieWarningReasonEnum
+	WarnSameSiteUnspecifiedLaxAllowUnsafe          CookieWarningReasonEnum
+	WarnSameSiteStrictLaxDowngradeStrict           CookieWarningReasonEnum
+	WarnSameSiteStrictCrossDowngradeStrict         CookieWarningReasonEnum
+	WarnSameSiteStrictCrossDowngradeLax            CookieWarningReasonEnum
+	WarnSameSiteLaxCrossDowngradeStrict            CookieWarningReasonEnum
+	WarnSameSiteLaxCrossDowngradeLax               CookieWarningReasonEnum
+	WarnAttributeValueExceedsMaxSize               CookieWarningReasonEnum
+	WarnDomainNonASCII                             CookieWarningReasonEnum
+	WarnThirdPartyPhaseout                         CookieWarningReasonEnum
+	WarnCrossSiteRedirectDowngradeChangesInclusion CookieWarningReasonEnum
+	WarnDeprecationTrialMetadata                   CookieWarningReasonEnum
+	WarnThirdPartyCookieHeuristic                  CookieWarningReasonEnum
+}
+
+/*
+CookieWarningReason provides named access to the CookieWarningReasonEnum values.
+*/
+var CookieWarningReason = cookieWarningReasonEnum{
+	WarnSameSiteUnspecifiedCrossSiteContext:        cookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext,
+	WarnSameSiteNoneInsecure:                       cookieWarningReasonWarnSameSiteNoneInsecure,
+	WarnSameSiteUnspecifiedLaxAllowUnsafe:          cookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe,
+	WarnSameSiteStrictLaxDowngradeStrict:           cookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict,
+	WarnSameSiteStrictCrossDowngradeStrict:         cookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict,
+	WarnSameSiteStrictCrossDowngradeLax:            cookieWarningReasonWarnSameSiteStrictCrossDowngradeLax,
+	WarnSameSiteLaxCrossDowngradeStrict:            cookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict,
+	WarnSameSiteLaxCrossDowngradeLax:               cookieWarningReasonWarnSameSiteLaxCrossDowngradeLax,
+	WarnAttributeValueExceedsMaxSize:               cookieWarningReasonWarnAttributeValueExceedsMaxSize,
+	WarnDomainNonASCII:                             cookieWarningReasonWarnDomainNonASCII,
+	WarnThirdPartyPhaseout:                         cookieWarningReasonWarnThirdPartyPhaseout,
+	WarnCrossSiteRedirectDowngradeChangesInclusion: cookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion,
+	WarnDeprecationTrialMetadata:                   cookieWarningReasonWarnDeprecationTrialMetadata,
+	WarnThirdPartyCookieHeuristic:                  cookieWarningReasonWarnThirdPartyCookieHeuristic,
+}
+
+/*
+CookieWarningReasonEnum represents the Audits.CookieWarningReason values.
+
+Allowed values:
+  - CookieWarningReason.WarnSameSiteUnspecifiedCrossSiteContext        "WarnSameSiteUnspecifiedCrossSiteContext"
+  - CookieWarningReason.WarnSameSiteNoneInsecure                       "WarnSameSiteNoneInsecure"
+  - CookieWarningReason.WarnSameSiteUnspecifiedLaxAllowUnsafe          "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+  - CookieWarningReason.WarnSameSiteStrictLaxDowngradeStrict           "WarnSameSiteStrictLaxDowngradeStrict"
+  - CookieWarningReason.WarnSameSiteStrictCrossDowngradeStrict         "WarnSameSiteStrictCrossDowngradeStrict"
+  - CookieWarningReason.WarnSameSiteStrictCrossDowngradeLax            "WarnSameSiteStrictCrossDowngradeLax"
+  - CookieWarningReason.WarnSameSiteLaxCrossDowngradeStrict            "WarnSameSiteLaxCrossDowngradeStrict"
+  - CookieWarningReason.WarnSameSiteLaxCrossDowngradeLax               "WarnSameSiteLaxCrossDowngradeLax"
+  - CookieWarningReason.WarnAttributeValueExceedsMaxSize               "WarnAttributeValueExceedsMaxSize"
+  - CookieWarningReason.WarnDomainNonASCII                             "WarnDomainNonASCII"
+  - CookieWarningReason.WarnThirdPartyPhaseout                         "WarnThirdPartyPhaseout"
+  - CookieWarningReason.WarnCrossSiteRedirectDowngradeChangesInclusion "WarnCrossSiteRedirectDowngradeChangesInclusion"
+  - CookieWarningReason.WarnDeprecationTrialMetadata                   "WarnDeprecationTrialMetadata"
+  - CookieWarningReason.WarnThirdPartyCookieHeuristic                  "WarnThirdPartyCookieHeuristic"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-CookieWarningReason
+*/
+type CookieWarningReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieWarningReasonEnum) String() string {
+	return _cookieWarningReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieWarningReasonEnum) Unknown() bool {
+	return !_cookieWarningReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieWarningReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieWarningReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieWarningReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext represents the "WarnSameSiteUnspecifiedCrossSiteContext" value.
+	cookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext CookieWarningReasonEnum = iota + 1
+	// cookieWarningReasonWarnSameSiteNoneInsecure represents the "WarnSameSiteNoneInsecure" value.
+	cookieWarningReasonWarnSameSiteNoneInsecure
+	// cookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe represents the "WarnSameSiteUnspecifiedLaxAllowUnsafe" value.
+	cookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe
+	// cookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict represents the "WarnSameSiteStrictLaxDowngradeStrict" value.
+	cookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict
+	// cookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict represents the "WarnSameSiteStrictCrossDowngradeStrict" value.
+	cookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict
+	// cookieWarningReasonWarnSameSiteStrictCrossDowngradeLax represents the "WarnSameSiteStrictCrossDowngradeLax" value.
+	cookieWarningReasonWarnSameSiteStrictCrossDowngradeLax
+	// cookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict represents the "WarnSameSiteLaxCrossDowngradeStrict" value.
+	cookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict
+	// cookieWarningReasonWarnSameSiteLaxCrossDowngradeLax represents the "WarnSameSiteLaxCrossDowngradeLax" value.
+	cookieWarningReasonWarnSameSiteLaxCrossDowngradeLax
+	// cookieWarningReasonWarnAttributeValueExceedsMaxSize represents the "WarnAttributeValueExceedsMaxSize" value.
+	cookieWarningReasonWarnAttributeValueExceedsMaxSize
+	// cookieWarningReasonWarnDomainNonASCII represents the "WarnDomainNonASCII" value.
+	cookieWarningReasonWarnDomainNonASCII
+	// cookieWarningReasonWarnThirdPartyPhaseout represents the "WarnThirdPartyPhaseout" value.
+	cookieWarningReasonWarnThirdPartyPhaseout
+	// cookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion represents the "WarnCrossSiteRedirectDowngradeChangesInclusion" value.
+	cookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion
+	// cookieWarningReasonWarnDeprecationTrialMetadata represents the "WarnDeprecationTrialMetadata" value.
+	cookieWarningReasonWarnDeprecationTrialMetadata
+	// cookieWarningReasonWarnThirdPartyCookieHeuristic represents the "WarnThirdPartyCookieHeuristic" value.
+	cookieWarningReasonWarnThirdPartyCookieHeuristic
+)
+
+var _cookieWarningReasonEnums = enums.New(map[CookieWarningReasonEnum]string{
+	CookieWarningReasonEnum(0):                                        "",
+	cookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext:        "WarnSameSiteUnspecifiedCrossSiteContext",
+	cookieWarningReasonWarnSameSiteNoneInsecure:                       "WarnSameSiteNoneInsecure",
+	cookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe:          "WarnSameSiteUnspecifiedLaxAllowUnsafe",
+	cookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict:           "WarnSameSiteStrictLaxDowngradeStrict",
+	cookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict:         "WarnSameSiteStrictCrossDowngradeStrict",
+	cookieWarningReasonWarnSameSiteStrictCrossDowngradeLax:            "WarnSameSiteStrictCrossDowngradeLax",
+	cookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict:            "WarnSameSiteLaxCrossDowngradeStrict",
+	cookieWarningReasonWarnSameSiteLaxCrossDowngradeLax:               "WarnSameSiteLaxCrossDowngradeLax",
+	cookieWarningReasonWarnAttributeValueExceedsMaxSize:               "WarnAttributeValueExceedsMaxSize",
+	cookieWarningReasonWarnDomainNonASCII:                             "WarnDomainNonASCII",
+	cookieWarningReasonWarnThirdPartyPhaseout:                         "WarnThirdPartyPhaseout",
+	cookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion: "WarnCrossSiteRedirectDowngradeChangesInclusion",
+	cookieWarningReasonWarnDeprecationTrialMetadata:                   "WarnDeprecationTrialMetadata",
+	cookieWarningReasonWarnThirdPartyCookieHeuristic:                  "WarnThirdPartyCookieHeuristic",
+})
@@ -0,0 +1,225 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieWarningReason(t *testing.T) {
+	var enum CookieWarningReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieWarningReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteUnspecifiedCrossSiteContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteUnspecifiedCrossSiteContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteUnspecifiedCrossSiteContext"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteUnspecifiedCrossSiteContext"`), &enum)
+	if CookieWarningReason.WarnSameSiteUnspecifiedCrossSiteContext != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteUnspecifiedCrossSiteContext, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteNoneInsecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteNoneInsecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteNoneInsecure"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteNoneInsecure"`), &enum)
+	if CookieWarningReason.WarnSameSiteNoneInsecure != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteNoneInsecure, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteUnspecifiedLaxAllowUnsafe
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteUnspecifiedLaxAllowUnsafe"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteUnspecifiedLaxAllowUnsafe"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteUnspecifiedLaxAllowUnsafe"`), &enum)
+	if CookieWarningReason.WarnSameSiteUnspecifiedLaxAllowUnsafe != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteUnspecifiedLaxAllowUnsafe, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteStrictLaxDowngradeStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteStrictLaxDowngradeStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteStrictLaxDowngradeStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteStrictLaxDowngradeStrict"`), &enum)
+	if CookieWarningReason.WarnSameSiteStrictLaxDowngradeStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteStrictLaxDowngradeStrict, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteStrictCrossDowngradeStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteStrictCrossDowngradeStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteStrictCrossDowngradeStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteStrictCrossDowngradeStrict"`), &enum)
+	if CookieWarningReason.WarnSameSiteStrictCrossDowngradeStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteStrictCrossDowngradeStrict, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteStrictCrossDowngradeLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteStrictCrossDowngradeLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteStrictCrossDowngradeLax"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteStrictCrossDowngradeLax"`), &enum)
+	if CookieWarningReason.WarnSameSiteStrictCrossDowngradeLax != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteStrictCrossDowngradeLax, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteLaxCrossDowngradeStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteLaxCrossDowngradeStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteLaxCrossDowngradeStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteLaxCrossDowngradeStrict"`), &enum)
+	if CookieWarningReason.WarnSameSiteLaxCrossDowngradeStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteLaxCrossDowngradeStrict, enum)
+	}
+
+	enum = CookieWarningReason.WarnSameSiteLaxCrossDowngradeLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnSameSiteLaxCrossDowngradeLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnSameSiteLaxCrossDowngradeLax"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnSameSiteLaxCrossDowngradeLax"`), &enum)
+	if CookieWarningReason.WarnSameSiteLaxCrossDowngradeLax != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnSameSiteLaxCrossDowngradeLax, enum)
+	}
+
+	enum = CookieWarningReason.WarnAttributeValueExceedsMaxSize
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnAttributeValueExceedsMaxSize"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnAttributeValueExceedsMaxSize"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnAttributeValueExceedsMaxSize"`), &enum)
+	if CookieWarningReason.WarnAttributeValueExceedsMaxSize != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnAttributeValueExceedsMaxSize, enum)
+	}
+
+	enum = CookieWarningReason.WarnDomainNonASCII
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnDomainNonASCII"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnDomainNonASCII"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnDomainNonASCII"`), &enum)
+	if CookieWarningReason.WarnDomainNonASCII != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnDomainNonASCII, enum)
+	}
+
+	enum = CookieWarningReason.WarnThirdPartyPhaseout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnThirdPartyPhaseout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnThirdPartyPhaseout"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnThirdPartyPhaseout"`), &enum)
+	if CookieWarningReason.WarnThirdPartyPhaseout != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnThirdPartyPhaseout, enum)
+	}
+
+	enum = CookieWarningReason.WarnCrossSiteRedirectDowngradeChangesInclusion
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnCrossSiteRedirectDowngradeChangesInclusion"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnCrossSiteRedirectDowngradeChangesInclusion"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnCrossSiteRedirectDowngradeChangesInclusion"`), &enum)
+	if CookieWarningReason.WarnCrossSiteRedirectDowngradeChangesInclusion != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnCrossSiteRedirectDowngradeChangesInclusion, enum)
+	}
+
+	enum = CookieWarningReason.WarnDeprecationTrialMetadata
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnDeprecationTrialMetadata"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnDeprecationTrialMetadata"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnDeprecationTrialMetadata"`), &enum)
+	if CookieWarningReason.WarnDeprecationTrialMetadata != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnDeprecationTrialMetadata, enum)
+	}
+
+	enum = CookieWarningReason.WarnThirdPartyCookieHeuristic
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WarnThirdPartyCookieHeuristic"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WarnThirdPartyCookieHeuristic"`, result)
+	}
+	json.Unmarshal([]byte(`"WarnThirdPartyCookieHeuristic"`), &enum)
+	if CookieWarningReason.WarnThirdPartyCookieHeuristic != enum {
+		t.Errorf("Expected %d, got %d", CookieWarningReason.WarnThirdPartyCookieHeuristic, enum)
+	}
+}
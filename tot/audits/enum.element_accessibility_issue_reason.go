@@ -0,0 +1,107 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type elementAccessibilityIssueReasonEnum struct {
+	DisallowedSelectChild               ElementAccessibilityIssueReasonEnum
+	DisallowedOptGroupChild             ElementAccessibilityIssueReasonEnum
+	NonPhrasingContentOptionChild       ElementAccessibilityIssueReasonEnum
+	InteractiveContentOptionChild       ElementAccessibilityIssueReasonEnum
+	InteractiveContentLegendChild       ElementAccessibilityIssueReasonEnum
+	InteractiveContentSummaryDescendant ElementAccessibilityIssueReasonEnum
+}
+
+/*
+ElementAccessibilityIssueReason provides named access to the ElementAccessibilityIssueReasonEnum values.
+*/
+var ElementAccessibilityIssueReason = elementAccessibilityIssueReasonEnum{
+	DisallowedSelectChild:               elementAccessibilityIssueReasonDisallowedSelectChild,
+	DisallowedOptGroupChild:             elementAccessibilityIssueReasonDisallowedOptGroupChild,
+	NonPhrasingContentOptionChild:       elementAccessibilityIssueReasonNonPhrasingContentOptionChild,
+	InteractiveContentOptionChild:       elementAccessibilityIssueReasonInteractiveContentOptionChild,
+	InteractiveContentLegendChild:       elementAccessibilityIssueReasonInteractiveContentLegendChild,
+	InteractiveContentSummaryDescendant: elementAccessibilityIssueReasonInteractiveContentSummaryDescendant,
+}
+
+/*
+ElementAccessibilityIssueReasonEnum represents the
+Audits.ElementAccessibilityIssueReason values.
+
+Allowed values:
+  - ElementAccessibilityIssueReason.DisallowedSelectChild               "DisallowedSelectChild"
+  - ElementAccessibilityIssueReason.DisallowedOptGroupChild             "DisallowedOptGroupChild"
+  - ElementAccessibilityIssueReason.NonPhrasingContentOptionChild       "NonPhrasingContentOptionChild"
+  - ElementAccessibilityIssueReason.InteractiveContentOptionChild       "InteractiveContentOptionChild"
+  - ElementAccessibilityIssueReason.InteractiveContentLegendChild       "InteractiveContentLegendChild"
+  - ElementAccessibilityIssueReason.InteractiveContentSummaryDescendant "InteractiveContentSummaryDescendant"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-ElementAccessibilityIssueReason
+*/
+type ElementAccessibilityIssueReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ElementAccessibilityIssueReasonEnum) String() string {
+	return _elementAccessibilityIssueReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ElementAccessibilityIssueReasonEnum) Unknown() bool {
+	return !_elementAccessibilityIssueReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ElementAccessibilityIssueReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ElementAccessibilityIssueReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _elementAccessibilityIssueReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// elementAccessibilityIssueReasonDisallowedSelectChild represents the "DisallowedSelectChild" value.
+	elementAccessibilityIssueReasonDisallowedSelectChild ElementAccessibilityIssueReasonEnum = iota + 1
+	// elementAccessibilityIssueReasonDisallowedOptGroupChild represents the "DisallowedOptGroupChild" value.
+	elementAccessibilityIssueReasonDisallowedOptGroupChild
+	// elementAccessibilityIssueReasonNonPhrasingContentOptionChild represents the "NonPhrasingContentOptionChild" value.
+	elementAccessibilityIssueReasonNonPhrasingContentOptionChild
+	// elementAccessibilityIssueReasonInteractiveContentOptionChild represents the "InteractiveContentOptionChild" value.
+	elementAccessibilityIssueReasonInteractiveContentOptionChild
+	// elementAccessibilityIssueReasonInteractiveContentLegendChild represents the "InteractiveContentLegendChild" value.
+	elementAccessibilityIssueReasonInteractiveContentLegendChild
+	// elementAccessibilityIssueReasonInteractiveContentSummaryDescendant represents the "InteractiveContentSummaryDescendant" value.
+	elementAccessibilityIssueReasonInteractiveContentSummaryDescendant
+)
+
+var _elementAccessibilityIssueReasonEnums = enums.New(map[ElementAccessibilityIssueReasonEnum]string{
+	ElementAccessibilityIssueReasonEnum(0):                             "",
+	elementAccessibilityIssueReasonDisallowedSelectChild:               "DisallowedSelectChild",
+	elementAccessibilityIssueReasonDisallowedOptGroupChild:             "DisallowedOptGroupChild",
+	elementAccessibilityIssueReasonNonPhrasingContentOptionChild:       "NonPhrasingContentOptionChild",
+	elementAccessibilityIssueReasonInteractiveContentOptionChild:       "InteractiveContentOptionChild",
+	elementAccessibilityIssueReasonInteractiveContentLegendChild:       "InteractiveContentLegendChild",
+	elementAccessibilityIssueReasonInteractiveContentSummaryDescendant: "InteractiveContentSummaryDescendant",
+})
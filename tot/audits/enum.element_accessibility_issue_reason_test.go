@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumElementAccessibilityIssueReason(t *testing.T) {
+	var enum ElementAccessibilityIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ElementAccessibilityIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ElementAccessibilityIssueReason.DisallowedSelectChild
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisallowedSelectChild"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisallowedSelectChild"`, result)
+	}
+	json.Unmarshal([]byte(`"DisallowedSelectChild"`), &enum)
+	if ElementAccessibilityIssueReason.DisallowedSelectChild != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.DisallowedSelectChild, enum)
+	}
+
+	enum = ElementAccessibilityIssueReason.DisallowedOptGroupChild
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisallowedOptGroupChild"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisallowedOptGroupChild"`, result)
+	}
+	json.Unmarshal([]byte(`"DisallowedOptGroupChild"`), &enum)
+	if ElementAccessibilityIssueReason.DisallowedOptGroupChild != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.DisallowedOptGroupChild, enum)
+	}
+
+	enum = ElementAccessibilityIssueReason.NonPhrasingContentOptionChild
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NonPhrasingContentOptionChild"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NonPhrasingContentOptionChild"`, result)
+	}
+	json.Unmarshal([]byte(`"NonPhrasingContentOptionChild"`), &enum)
+	if ElementAccessibilityIssueReason.NonPhrasingContentOptionChild != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.NonPhrasingContentOptionChild, enum)
+	}
+
+	enum = ElementAccessibilityIssueReason.InteractiveContentOptionChild
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InteractiveContentOptionChild"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InteractiveContentOptionChild"`, result)
+	}
+	json.Unmarshal([]byte(`"InteractiveContentOptionChild"`), &enum)
+	if ElementAccessibilityIssueReason.InteractiveContentOptionChild != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.InteractiveContentOptionChild, enum)
+	}
+
+	enum = ElementAccessibilityIssueReason.InteractiveContentLegendChild
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InteractiveContentLegendChild"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InteractiveContentLegendChild"`, result)
+	}
+	json.Unmarshal([]byte(`"InteractiveContentLegendChild"`), &enum)
+	if ElementAccessibilityIssueReason.InteractiveContentLegendChild != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.InteractiveContentLegendChild, enum)
+	}
+
+	enum = ElementAccessibilityIssueReason.InteractiveContentSummaryDescendant
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InteractiveContentSummaryDescendant"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InteractiveContentSummaryDescendant"`, result)
+	}
+	json.Unmarshal([]byte(`"InteractiveContentSummaryDescendant"`), &enum)
+	if ElementAccessibilityIssueReason.InteractiveContentSummaryDescendant != enum {
+		t.Errorf("Expected %d, got %d", ElementAccessibilityIssueReason.InteractiveContentSummaryDescendant, enum)
+	}
+}
@@ -0,0 +1,364 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type federatedAuthRequestIssueReasonEnum struct {
+	ShouldEmbargo                    FederatedAuthRequestIssueReasonEnum
+	TooManyRequests                  FederatedAuthRequestIssueReasonEnum
+	WellKnownHTTPNotFound            FederatedAuthRequestIssueReasonEnum
+	WellKnownNoResponse              FederatedAuthRequestIssueReasonEnum
+	WellKnownInvalidResponse         FederatedAuthRequestIssueReasonEnum
+	WellKnownListEmpty               FederatedAuthRequestIssueReasonEnum
+	WellKnownInvalidContentType      FederatedAuthRequestIssueReasonEnum
+	ConfigNotInWellKnown             FederatedAuthRequestIssueReasonEnum
+	WellKnownTooBig                  FederatedAuthRequestIssueReasonEnum
+	ConfigHTTPNotFound               FederatedAuthRequestIssueReasonEnum
+	ConfigNoResponse                 FederatedAuthRequestIssueReasonEnum
+	ConfigInvalidResponse            FederatedAuthRequestIssueReasonEnum
+	ConfigInvalidContentType         FederatedAuthRequestIssueReasonEnum
+	ClientMetadataHTTPNotFound       FederatedAuthRequestIssueReasonEnum
+	ClientMetadataNoResponse         FederatedAuthRequestIssueReasonEnum
+	ClientMetadataInvalidResponse    FederatedAuthRequestIssueReasonEnum
+	ClientMetadataInvalidContentType FederatedAuthRequestIssueReasonEnum
+	IdpNotPotentiallyTrustworthy     FederatedAuthRequestIssueReasonEnum
+	DisabledInSettings               FederatedAuthRequestIssueReasonEnum
+	DisabledInFlags                  FederatedAuthRequestIssueReasonEnum
+	ErrorFetchingSignin              FederatedAuthRequestIssueReasonEnum
+	InvalidSigninResponse            FederatedAuthRequestIssueReasonEnum
+	AccountsHTTPNotFound             FederatedAuthRequestIssueReasonEnum
+	AccountsNoResponse               FederatedAuthRequestIssueReasonEnum
+	AccountsInvalidResponse          FederatedAuthRequestIssueReasonEnum
+	AccountsListEmpty                FederatedAuthRequestIssueReasonEnum
+	AccountsInvalidContentType       FederatedAuthRequestIssueReasonEnum
+	IDTokenHTTPNotFound              FederatedAuthRequestIssueReasonEnum
+	IDTokenNoResponse                FederatedAuthRequestIssueReasonEnum
+	IDTokenInvalidResponse           FederatedAuthRequestIssueReasonEnum
+	IDTokenIdpErrorResponse          FederatedAuthRequestIssueReasonEnum
+	IDTokenCrossSiteIdpErrorResponse FederatedAuthRequestIssueReasonEnum
+	IDTokenInvalidRequest            FederatedAuthRequestIssueReasonEnum
+	IDTokenInvalidContentType        FederatedAuthRequestIssueReasonEnum
+	ErrorIDToken                     FederatedAuthRequestIssueReasonEnum
+	Canceled                         FederatedAuthRequestIssueReasonEnum
+	RpPageNotVisible                 FederatedAuthRequestIssueReasonEnum
+	SilentMediationFailure           FederatedAuthRequestIssueReasonEnum
+	ThirdPartyCookiesBlocked         FederatedAuthRequestIssueReasonEnum
+	NotSignedInWithIdp               FederatedAuthRequestIssueReasonEnum
+	MissingTransientUserActivation   FederatedAuthRequestIssueReasonEnum
+	ReplacedByActiveMode             FederatedAuthRequestIssueReasonEnum
+	InvalidFieldsSpecified           FederatedAuthRequestIssueReasonEnum
+	RelyingPartyOriginIsOpaque       FederatedAuthRequestIssueReasonEnum
+	TypeNotMatching                  FederatedAuthRequestIssueReasonEnum
+	UIDismissedNoEmbargo             FederatedAuthRequestIssueReasonEnum
+	CorsError                        FederatedAuthRequestIssueReasonEnum
+	SuppressedBySegmentationPlatform FederatedAuthRequestIssueReasonEnum
+}
+
+/*
+FederatedAuthRequestIssueReason provides named access to the FederatedAuthRequestIssueReasonEnum values.
+*/
+var FederatedAuthRequestIssueReason = federatedAuthRequestIssueReasonEnum{
+	ShouldEmbargo:                    federatedAuthRequestIssueReasonShouldEmbargo,
+	TooManyRequests:                  federatedAuthRequestIssueReasonTooManyRequests,
+	WellKnownHTTPNotFound:            federatedAuthRequestIssueReasonWellKnownHTTPNotFound,
+	WellKnownNoResponse:              federatedAuthRequestIssueReasonWellKnownNoResponse,
+	WellKnownInvalidResponse:         federatedAuthRequestIssueReasonWellKnownInvalidResponse,
+	WellKnownListEmpty:               federatedAuthRequestIssueReasonWellKnownListEmpty,
+	WellKnownInvalidContentType:      federatedAuthRequestIssueReasonWellKnownInvalidContentType,
+	ConfigNotInWellKnown:             federatedAuthRequestIssueReasonConfigNotInWellKnown,
+	WellKnownTooBig:                  federatedAuthRequestIssueReasonWellKnownTooBig,
+	ConfigHTTPNotFound:               federatedAuthRequestIssueReasonConfigHTTPNotFound,
+	ConfigNoResponse:                 federatedAuthRequestIssueReasonConfigNoResponse,
+	ConfigInvalidResponse:            federatedAuthRequestIssueReasonConfigInvalidResponse,
+	ConfigInvalidContentType:         federatedAuthRequestIssueReasonConfigInvalidContentType,
+	ClientMetadataHTTPNotFound:       federatedAuthRequestIssueReasonClientMetadataHTTPNotFound,
+	ClientMetadataNoResponse:         federatedAuthRequestIssueReasonClientMetadataNoResponse,
+	ClientMetadataInvalidResponse:    federatedAuthRequestIssueReasonClientMetadataInvalidResponse,
+	ClientMetadataInvalidContentType: federatedAuthRequestIssueReasonClientMetadataInvalidContentType,
+	IdpNotPotentiallyTrustworthy:     federatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy,
+	DisabledInSettings:               federatedAuthRequestIssueReasonDisabledInSettings,
+	DisabledInFlags:                  federatedAuthRequestIssueReasonDisabledInFlags,
+	ErrorFetchingSignin:              federatedAuthRequestIssueReasonErrorFetchingSignin,
+	InvalidSigninResponse:            federatedAuthRequestIssueReasonInvalidSigninResponse,
+	AccountsHTTPNotFound:             federatedAuthRequestIssueReasonAccountsHTTPNotFound,
+	AccountsNoResponse:               federatedAuthRequestIssueReasonAccountsNoResponse,
+	AccountsInvalidResponse:          federatedAuthRequestIssueReasonAccountsInvalidResponse,
+	AccountsListEmpty:                federatedAuthRequestIssueReasonAccountsListEmpty,
+	AccountsInvalidContentType:       federatedAuthRequestIssueReasonAccountsInvalidContentType,
+	IDTokenHTTPNotFound:              federatedAuthRequestIssueReasonIDTokenHTTPNotFound,
+	IDTokenNoResponse:                federatedAuthRequestIssueReasonIDTokenNoResponse,
+	IDTokenInvalidResponse:           federatedAuthRequestIssueReasonIDTokenInvalidResponse,
+	IDTokenIdpErrorResponse:          federatedAuthRequestIssueReasonIDTokenIdpErrorResponse,
+	IDTokenCrossSiteIdpErrorResponse: federatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse,
+	IDTokenInvalidRequest:            federatedAuthRequestIssueReasonIDTokenInvalidRequest,
+	IDTokenInvalidContentType:        federatedAuthRequestIssueReasonIDTokenInvalidContentType,
+	ErrorIDToken:                     federatedAuthRequestIssueReasonErrorIDToken,
+	Canceled:                         federatedAuthRequestIssueReasonCanceled,
+	RpPageNotVisible:                 federatedAuthRequestIssueReasonRpPageNotVisible,
+	SilentMediationFailure:           federatedAuthRequestIssueReasonSilentMediationFailure,
+	ThirdPartyCookiesBlocked:         federatedAuthRequestIssueReasonThirdPartyCookiesBlocked,
+	NotSignedInWithIdp:               federatedAuthRequestIssueReasonNotSignedInWithIdp,
+	MissingTransientUserActivation:   federatedAuthRequestIssueReasonMissingTransientUserActivation,
+	ReplacedByActiveMode:             federatedAuthRequestIssueReasonReplacedByActiveMode,
+	InvalidFieldsSpecified:           federatedAuthRequestIssueReasonInvalidFieldsSpecified,
+	RelyingPartyOriginIsOpaque:       federatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque,
+	TypeNotMatching:                  federatedAuthRequestIssueReasonTypeNotMatching,
+	UIDismissedNoEmbargo:             federatedAuthRequestIssueReasonUIDismissedNoEmbargo,
+	CorsError:                        federatedAuthRequestIssueReasonCorsError,
+	SuppressedBySegmentationPlatform: federatedAuthRequestIssueReasonSuppressedBySegmentationPlatform,
+}
+
+/*
+FederatedAuthRequestIssueReasonEnum represents the
+Audits.FederatedAuthRequestIssueReason values.
+
+Represents the failure reason when a federated authentication reason fails.
+Should be updated alongside RequestIdTokenStatus in
+third_party/blink/public/mojom/devtools/inspector_issue.mojom to include all
+cases except for success.
+
+Allowed values:
+  - FederatedAuthRequestIssueReason.ShouldEmbargo                    "ShouldEmbargo"
+  - FederatedAuthRequestIssueReason.TooManyRequests                  "TooManyRequests"
+  - FederatedAuthRequestIssueReason.WellKnownHTTPNotFound            "WellKnownHttpNotFound"
+  - FederatedAuthRequestIssueReason.WellKnownNoResponse              "WellKnownNoResponse"
+  - FederatedAuthRequestIssueReason.WellKnownInvalidResponse         "WellKnownInvalidResponse"
+  - FederatedAuthRequestIssueReason.WellKnownListEmpty               "WellKnownListEmpty"
+  - FederatedAuthRequestIssueReason.WellKnownInvalidContentType      "WellKnownInvalidContentType"
+  - FederatedAuthRequestIssueReason.ConfigNotInWellKnown             "ConfigNotInWellKnown"
+  - FederatedAuthRequestIssueReason.WellKnownTooBig                  "WellKnownTooBig"
+  - FederatedAuthRequestIssueReason.ConfigHTTPNotFound               "ConfigHttpNotFound"
+  - FederatedAuthRequestIssueReason.ConfigNoResponse                 "ConfigNoResponse"
+  - FederatedAuthRequestIssueReason.ConfigInvalidResponse            "ConfigInvalidResponse"
+  - FederatedAuthRequestIssueReason.ConfigInvalidContentType         "ConfigInvalidContentType"
+  - FederatedAuthRequestIssueReason.ClientMetadataHTTPNotFound       "ClientMetadataHttpNotFound"
+  - FederatedAuthRequestIssueReason.ClientMetadataNoResponse         "ClientMetadataNoResponse"
+  - FederatedAuthRequestIssueReason.ClientMetadataInvalidResponse    "ClientMetadataInvalidResponse"
+  - FederatedAuthRequestIssueReason.ClientMetadataInvalidContentType "ClientMetadataInvalidContentType"
+  - FederatedAuthRequestIssueReason.IdpNotPotentiallyTrustworthy     "IdpNotPotentiallyTrustworthy"
+  - FederatedAuthRequestIssueReason.DisabledInSettings               "DisabledInSettings"
+  - FederatedAuthRequestIssueReason.DisabledInFlags                  "DisabledInFlags"
+  - FederatedAuthRequestIssueReason.ErrorFetchingSignin              "ErrorFetchingSignin"
+  - FederatedAuthRequestIssueReason.InvalidSigninResponse            "InvalidSigninResponse"
+  - FederatedAuthRequestIssueReason.AccountsHTTPNotFound             "AccountsHttpNotFound"
+  - FederatedAuthRequestIssueReason.AccountsNoResponse               "AccountsNoResponse"
+  - FederatedAuthRequestIssueReason.AccountsInvalidResponse          "AccountsInvalidResponse"
+  - FederatedAuthRequestIssueReason.AccountsListEmpty                "AccountsListEmpty"
+  - FederatedAuthRequestIssueReason.AccountsInvalidContentType       "AccountsInvalidContentType"
+  - FederatedAuthRequestIssueReason.IDTokenHTTPNotFound              "IdTokenHttpNotFound"
+  - FederatedAuthRequestIssueReason.IDTokenNoResponse                "IdTokenNoResponse"
+  - FederatedAuthRequestIssueReason.IDTokenInvalidResponse           "IdTokenInvalidResponse"
+  - FederatedAuthRequestIssueReason.IDTokenIdpErrorResponse          "IdTokenIdpErrorResponse"
+  - FederatedAuthRequestIssueReason.IDTokenCrossSiteIdpErrorResponse "IdTokenCrossSiteIdpErrorResponse"
+  - FederatedAuthRequestIssueReason.IDTokenInvalidRequest            "IdTokenInvalidRequest"
+  - FederatedAuthRequestIssueReason.IDTokenInvalidContentType        "IdTokenInvalidContentType"
+  - FederatedAuthRequestIssueReason.ErrorIDToken                     "ErrorIdToken"
+  - FederatedAuthRequestIssueReason.Canceled                         "Canceled"
+  - FederatedAuthRequestIssueReason.RpPageNotVisible                 "RpPageNotVisible"
+  - FederatedAuthRequestIssueReason.SilentMediationFailure           "SilentMediationFailure"
+  - FederatedAuthRequestIssueReason.ThirdPartyCookiesBlocked         "ThirdPartyCookiesBlocked"
+  - FederatedAuthRequestIssueReason.NotSignedInWithIdp               "NotSignedInWithIdp"
+  - FederatedAuthRequestIssueReason.MissingTransientUserActivation   "MissingTransientUserActivation"
+  - FederatedAuthRequestIssueReason.ReplacedByActiveMode             "ReplacedByActiveMode"
+  - FederatedAuthRequestIssueReason.InvalidFieldsSpecified           "InvalidFieldsSpecified"
+  - FederatedAuthRequestIssueReason.RelyingPartyOriginIsOpaque       "RelyingPartyOriginIsOpaque"
+  - FederatedAuthRequestIssueReason.TypeNotMatching                  "TypeNotMatching"
+  - FederatedAuthRequestIssueReason.UIDismissedNoEmbargo             "UiDismissedNoEmbargo"
+  - FederatedAuthRequestIssueReason.CorsError                        "CorsError"
+  - FederatedAuthRequestIssueReason.SuppressedBySegmentationPlatform "SuppressedBySegmentationPlatform"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-FederatedAuthRequestIssueReason
+*/
+type FederatedAuthRequestIssueReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FederatedAuthRequestIssueReasonEnum) String() string {
+	return _federatedAuthRequestIssueReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FederatedAuthRequestIssueReasonEnum) Unknown() bool {
+	return !_federatedAuthRequestIssueReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FederatedAuthRequestIssueReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FederatedAuthRequestIssueReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _federatedAuthRequestIssueReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// federatedAuthRequestIssueReasonShouldEmbargo represents the "ShouldEmbargo" value.
+	federatedAuthRequestIssueReasonShouldEmbargo FederatedAuthRequestIssueReasonEnum = iota + 1
+	// federatedAuthRequestIssueReasonTooManyRequests represents the "TooManyRequests" value.
+	federatedAuthRequestIssueReasonTooManyRequests
+	// federatedAuthRequestIssueReasonWellKnownHTTPNotFound represents the "WellKnownHttpNotFound" value.
+	federatedAuthRequestIssueReasonWellKnownHTTPNotFound
+	// federatedAuthRequestIssueReasonWellKnownNoResponse represents the "WellKnownNoResponse" value.
+	federatedAuthRequestIssueReasonWellKnownNoResponse
+	// federatedAuthRequestIssueReasonWellKnownInvalidResponse represents the "WellKnownInvalidResponse" value.
+	federatedAuthRequestIssueReasonWellKnownInvalidResponse
+	// federatedAuthRequestIssueReasonWellKnownListEmpty represents the "WellKnownListEmpty" value.
+	federatedAuthRequestIssueReasonWellKnownListEmpty
+	// federatedAuthRequestIssueReasonWellKnownInvalidContentType represents the "WellKnownInvalidContentType" value.
+	federatedAuthRequestIssueReasonWellKnownInvalidContentType
+	// federatedAuthRequestIssueReasonConfigNotInWellKnown represents the "ConfigNotInWellKnown" value.
+	federatedAuthRequestIssueReasonConfigNotInWellKnown
+	// federatedAuthRequestIssueReasonWellKnownTooBig represents the "WellKnownTooBig" value.
+	federatedAuthRequestIssueReasonWellKnownTooBig
+	// federatedAuthRequestIssueReasonConfigHTTPNotFound represents the "ConfigHttpNotFound" value.
+	federatedAuthRequestIssueReasonConfigHTTPNotFound
+	// federatedAuthRequestIssueReasonConfigNoResponse represents the "ConfigNoResponse" value.
+	federatedAuthRequestIssueReasonConfigNoResponse
+	// federatedAuthRequestIssueReasonConfigInvalidResponse represents the "ConfigInvalidResponse" value.
+	federatedAuthRequestIssueReasonConfigInvalidResponse
+	// federatedAuthRequestIssueReasonConfigInvalidContentType represents the "ConfigInvalidContentType" value.
+	federatedAuthRequestIssueReasonConfigInvalidContentType
+	// federatedAuthRequestIssueReasonClientMetadataHTTPNotFound represents the "ClientMetadataHttpNotFound" value.
+	federatedAuthRequestIssueReasonClientMetadataHTTPNotFound
+	// federatedAuthRequestIssueReasonClientMetadataNoResponse represents the "ClientMetadataNoResponse" value.
+	federatedAuthRequestIssueReasonClientMetadataNoResponse
+	// federatedAuthRequestIssueReasonClientMetadataInvalidResponse represents the "ClientMetadataInvalidResponse" value.
+	federatedAuthRequestIssueReasonClientMetadataInvalidResponse
+	// federatedAuthRequestIssueReasonClientMetadataInvalidContentType represents the "ClientMetadataInvalidContentType" value.
+	federatedAuthRequestIssueReasonClientMetadataInvalidContentType
+	// federatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy represents the "IdpNotPotentiallyTrustworthy" value.
+	federatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy
+	// federatedAuthRequestIssueReasonDisabledInSettings represents the "DisabledInSettings" value.
+	federatedAuthRequestIssueReasonDisabledInSettings
+	// federatedAuthRequestIssueReasonDisabledInFlags represents the "DisabledInFlags" value.
+	federatedAuthRequestIssueReasonDisabledInFlags
+	// federatedAuthRequestIssueReasonErrorFetchingSignin represents the "ErrorFetchingSignin" value.
+	federatedAuthRequestIssueReasonErrorFetchingSignin
+	// federatedAuthRequestIssueReasonInvalidSigninResponse represents the "InvalidSigninResponse" value.
+	federatedAuthRequestIssueReasonInvalidSigninResponse
+	// federatedAuthRequestIssueReasonAccountsHTTPNotFound represents the "AccountsHttpNotFound" value.
+	federatedAuthRequestIssueReasonAccountsHTTPNotFound
+	// federatedAuthRequestIssueReasonAccountsNoResponse represents the "AccountsNoResponse" value.
+	federatedAuthRequestIssueReasonAccountsNoResponse
+	// federatedAuthRequestIssueReasonAccountsInvalidResponse represents the "AccountsInvalidResponse" value.
+	federatedAuthRequestIssueReasonAccountsInvalidResponse
+	// federatedAuthRequestIssueReasonAccountsListEmpty represents the "AccountsListEmpty" value.
+	federatedAuthRequestIssueReasonAccountsListEmpty
+	// federatedAuthRequestIssueReasonAccountsInvalidContentType represents the "AccountsInvalidContentType" value.
+	federatedAuthRequestIssueReasonAccountsInvalidContentType
+	// federatedAuthRequestIssueReasonIDTokenHTTPNotFound represents the "IdTokenHttpNotFound" value.
+	federatedAuthRequestIssueReasonIDTokenHTTPNotFound
+	// federatedAuthRequestIssueReasonIDTokenNoResponse represents the "IdTokenNoResponse" value.
+	federatedAuthRequestIssueReasonIDTokenNoResponse
+	// federatedAuthRequestIssueReasonIDTokenInvalidResponse represents the "IdTokenInvalidResponse" value.
+	federatedAuthRequestIssueReasonIDTokenInvalidResponse
+	// federatedAuthRequestIssueReasonIDTokenIdpErrorResponse represents the "IdTokenIdpErrorResponse" value.
+	federatedAuthRequestIssueReasonIDTokenIdpErrorResponse
+	// federatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse represents the "IdTokenCrossSiteIdpErrorResponse" value.
+	federatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse
+	// federatedAuthRequestIssueReasonIDTokenInvalidRequest represents the "IdTokenInvalidRequest" value.
+	federatedAuthRequestIssueReasonIDTokenInvalidRequest
+	// federatedAuthRequestIssueReasonIDTokenInvalidContentType represents the "IdTokenInvalidContentType" value.
+	federatedAuthRequestIssueReasonIDTokenInvalidContentType
+	// federatedAuthRequestIssueReasonErrorIDToken represents the "ErrorIdToken" value.
+	federatedAuthRequestIssueReasonErrorIDToken
+	// federatedAuthRequestIssueReasonCanceled represents the "Canceled" value.
+	federatedAuthRequestIssueReasonCanceled
+	// federatedAuthRequestIssueReasonRpPageNotVisible represents the "RpPageNotVisible" value.
+	federatedAuthRequestIssueReasonRpPageNotVisible
+	// federatedAuthRequestIssueReasonSilentMediationFailure represents the "SilentMediationFailure" value.
+	federatedAuthRequestIssueReasonSilentMediationFailure
+	// federatedAuthRequestIssueReasonThirdPartyCookiesBlocked represents the "ThirdPartyCookiesBlocked" value.
+	federatedAuthRequestIssueReasonThirdPartyCookiesBlocked
+	// federatedAuthRequestIssueReasonNotSignedInWithIdp represents the "NotSignedInWithIdp" value.
+	federatedAuthRequestIssueReasonNotSignedInWithIdp
+	// federatedAuthRequestIssueReasonMissingTransientUserActivation represents the "MissingTransientUserActivation" value.
+	federatedAuthRequestIssueReasonMissingTransientUserActivation
+	// federatedAuthRequestIssueReasonReplacedByActiveMode represents the "ReplacedByActiveMode" value.
+	federatedAuthRequestIssueReasonReplacedByActiveMode
+	// federatedAuthRequestIssueReasonInvalidFieldsSpecified represents the "InvalidFieldsSpecified" value.
+	federatedAuthRequestIssueReasonInvalidFieldsSpecified
+	// federatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque represents the "RelyingPartyOriginIsOpaque" value.
+	federatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque
+	// federatedAuthRequestIssueReasonTypeNotMatching represents the "TypeNotMatching" value.
+	federatedAuthRequestIssueReasonTypeNotMatching
+	// federatedAuthRequestIssueReasonUIDismissedNoEmbargo represents the "UiDismissedNoEmbargo" value.
+	federatedAuthRequestIssueReasonUIDismissedNoEmbargo
+	// federatedAuthRequestIssueReasonCorsError represents the "CorsError" value.
+	federatedAuthRequestIssueReasonCorsError
+	// federatedAuthRequestIssueReasonSuppressedBySegmentationPlatform represents the "SuppressedBySegmentationPlatform" value.
+	federatedAuthRequestIssueReasonSuppressedBySegmentationPlatform
+)
+
+var _federatedAuthRequestIssueReasonEnums = enums.New(map[FederatedAuthRequestIssueReasonEnum]string{
+	FederatedAuthRequestIssueReasonEnum(0):                          "",
+	federatedAuthRequestIssueReasonShouldEmbargo:                    "ShouldEmbargo",
+	federatedAuthRequestIssueReasonTooManyRequests:                  "TooManyRequests",
+	federatedAuthRequestIssueReasonWellKnownHTTPNotFound:            "WellKnownHttpNotFound",
+	federatedAuthRequestIssueReasonWellKnownNoResponse:              "WellKnownNoResponse",
+	federatedAuthRequestIssueReasonWellKnownInvalidResponse:         "WellKnownInvalidResponse",
+	federatedAuthRequestIssueReasonWellKnownListEmpty:               "WellKnownListEmpty",
+	federatedAuthRequestIssueReasonWellKnownInvalidContentType:      "WellKnownInvalidContentType",
+	federatedAuthRequestIssueReasonConfigNotInWellKnown:             "ConfigNotInWellKnown",
+	federatedAuthRequestIssueReasonWellKnownTooBig:                  "WellKnownTooBig",
+	federatedAuthRequestIssueReasonConfigHTTPNotFound:               "ConfigHttpNotFound",
+	federatedAuthRequestIssueReasonConfigNoResponse:                 "ConfigNoResponse",
+	federatedAuthRequestIssueReasonConfigInvalidResponse:            "ConfigInvalidResponse",
+	federatedAuthRequestIssueReasonConfigInvalidContentType:         "ConfigInvalidContentType",
+	federatedAuthRequestIssueReasonClientMetadataHTTPNotFound:       "ClientMetadataHttpNotFound",
+	federatedAuthRequestIssueReasonClientMetadataNoResponse:         "ClientMetadataNoResponse",
+	federatedAuthRequestIssueReasonClientMetadataInvalidResponse:    "ClientMetadataInvalidResponse",
+	federatedAuthRequestIssueReasonClientMetadataInvalidContentType: "ClientMetadataInvalidContentType",
+	federatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy:     "IdpNotPotentiallyTrustworthy",
+	federatedAuthRequestIssueReasonDisabledInSettings:               "DisabledInSettings",
+	federatedAuthRequestIssueReasonDisabledInFlags:                  "DisabledInFlags",
+	federatedAuthRequestIssueReasonErrorFetchingSignin:              "ErrorFetchingSignin",
+	federatedAuthRequestIssueReasonInvalidSigninResponse:            "InvalidSigninResponse",
+	federatedAuthRequestIssueReasonAccountsHTTPNotFound:             "AccountsHttpNotFound",
+	federatedAuthRequestIssueReasonAccountsNoResponse:               "AccountsNoResponse",
+	federatedAuthRequestIssueReasonAccountsInvalidResponse:          "AccountsInvalidResponse",
+	federatedAuthRequestIssueReasonAccountsListEmpty:                "AccountsListEmpty",
+	federatedAuthRequestIssueReasonAccountsInvalidContentType:       "AccountsInvalidContentType",
+	federatedAuthRequestIssueReasonIDTokenHTTPNotFound:              "IdTokenHttpNotFound",
+	federatedAuthRequestIssueReasonIDTokenNoResponse:                "IdTokenNoResponse",
+	federatedAuthRequestIssueReasonIDTokenInvalidResponse:           "IdTokenInvalidResponse",
+	federatedAuthRequestIssueReasonIDTokenIdpErrorResponse:          "IdTokenIdpErrorResponse",
+	federatedAuthRequestIssueReasonIDTokenCrossSiteIdpErrorResponse: "IdTokenCrossSiteIdpErrorResponse",
+	federatedAuthRequestIssueReasonIDTokenInvalidRequest:            "IdTokenInvalidRequest",
+	federatedAuthRequestIssueReasonIDTokenInvalidContentType:        "IdTokenInvalidContentType",
+	federatedAuthRequestIssueReasonErrorIDToken:                     "ErrorIdToken",
+	federatedAuthRequestIssueReasonCanceled:                         "Canceled",
+	federatedAuthRequestIssueReasonRpPageNotVisible:                 "RpPageNotVisible",
+	federatedAuthRequestIssueReasonSilentMediationFailure:           "SilentMediationFailure",
+	federatedAuthRequestIssueReasonThirdPartyCookiesBlocked:         "ThirdPartyCookiesBlocked",
+	federatedAuthRequestIssueReasonNotSignedInWithIdp:               "NotSignedInWithIdp",
+	federatedAuthRequestIssueReasonMissingTransientUserActivation:   "MissingTransientUserActivation",
+	federatedAuthRequestIssueReasonReplacedByActiveMode:             "ReplacedByActiveMode",
+	federatedAuthRequestIssueReasonInvalidFieldsSpecified:           "InvalidFieldsSpecified",
+	federatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque:       "RelyingPartyOriginIsOpaque",
+	federatedAuthRequestIssueReasonTypeNotMatching:                  "TypeNotMatching",
+	federatedAuthRequestIssueReasonUIDismissedNoEmbargo:             "UiDismissedNoEmbargo",
+	federatedAuthRequestIssueReasonCorsError:                        "CorsError",
+	federatedAuthRequestIssueReasonSuppressedBySegmentationPlatform: "SuppressedBySegmentationPlatform",
+})
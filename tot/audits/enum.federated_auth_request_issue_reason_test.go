@@ -0,0 +1,667 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFederatedAuthRequestIssueReason(t *testing.T) {
+	var enum FederatedAuthRequestIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FederatedAuthRequestIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ShouldEmbargo
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ShouldEmbargo"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ShouldEmbargo"`, result)
+	}
+	json.Unmarshal([]byte(`"ShouldEmbargo"`), &enum)
+	if FederatedAuthRequestIssueReason.ShouldEmbargo != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ShouldEmbargo, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.TooManyRequests
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TooManyRequests"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TooManyRequests"`, result)
+	}
+	json.Unmarshal([]byte(`"TooManyRequests"`), &enum)
+	if FederatedAuthRequestIssueReason.TooManyRequests != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.TooManyRequests, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownHTTPNotFound
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownHttpNotFound"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownHttpNotFound"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownHttpNotFound"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownHTTPNotFound != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownHTTPNotFound, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownNoResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownNoResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownNoResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownNoResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownNoResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownNoResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownInvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownInvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownInvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownInvalidResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownInvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownInvalidResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownListEmpty
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownListEmpty"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownListEmpty"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownListEmpty"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownListEmpty != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownListEmpty, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownInvalidContentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownInvalidContentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownInvalidContentType"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownInvalidContentType"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownInvalidContentType != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownInvalidContentType, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ConfigNotInWellKnown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfigNotInWellKnown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfigNotInWellKnown"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfigNotInWellKnown"`), &enum)
+	if FederatedAuthRequestIssueReason.ConfigNotInWellKnown != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ConfigNotInWellKnown, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.WellKnownTooBig
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WellKnownTooBig"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WellKnownTooBig"`, result)
+	}
+	json.Unmarshal([]byte(`"WellKnownTooBig"`), &enum)
+	if FederatedAuthRequestIssueReason.WellKnownTooBig != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.WellKnownTooBig, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ConfigHTTPNotFound
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfigHttpNotFound"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfigHttpNotFound"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfigHttpNotFound"`), &enum)
+	if FederatedAuthRequestIssueReason.ConfigHTTPNotFound != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ConfigHTTPNotFound, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ConfigNoResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfigNoResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfigNoResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfigNoResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.ConfigNoResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ConfigNoResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ConfigInvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfigInvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfigInvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfigInvalidResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.ConfigInvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ConfigInvalidResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ConfigInvalidContentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfigInvalidContentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfigInvalidContentType"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfigInvalidContentType"`), &enum)
+	if FederatedAuthRequestIssueReason.ConfigInvalidContentType != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ConfigInvalidContentType, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ClientMetadataHTTPNotFound
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientMetadataHttpNotFound"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientMetadataHttpNotFound"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientMetadataHttpNotFound"`), &enum)
+	if FederatedAuthRequestIssueReason.ClientMetadataHTTPNotFound != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ClientMetadataHTTPNotFound, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ClientMetadataNoResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientMetadataNoResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientMetadataNoResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientMetadataNoResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.ClientMetadataNoResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ClientMetadataNoResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ClientMetadataInvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientMetadataInvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientMetadataInvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientMetadataInvalidResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.ClientMetadataInvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ClientMetadataInvalidResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ClientMetadataInvalidContentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientMetadataInvalidContentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientMetadataInvalidContentType"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientMetadataInvalidContentType"`), &enum)
+	if FederatedAuthRequestIssueReason.ClientMetadataInvalidContentType != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ClientMetadataInvalidContentType, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IdpNotPotentiallyTrustworthy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdpNotPotentiallyTrustworthy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdpNotPotentiallyTrustworthy"`, result)
+	}
+	json.Unmarshal([]byte(`"IdpNotPotentiallyTrustworthy"`), &enum)
+	if FederatedAuthRequestIssueReason.IdpNotPotentiallyTrustworthy != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IdpNotPotentiallyTrustworthy, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.DisabledInSettings
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisabledInSettings"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisabledInSettings"`, result)
+	}
+	json.Unmarshal([]byte(`"DisabledInSettings"`), &enum)
+	if FederatedAuthRequestIssueReason.DisabledInSettings != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.DisabledInSettings, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.DisabledInFlags
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DisabledInFlags"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DisabledInFlags"`, result)
+	}
+	json.Unmarshal([]byte(`"DisabledInFlags"`), &enum)
+	if FederatedAuthRequestIssueReason.DisabledInFlags != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.DisabledInFlags, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ErrorFetchingSignin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ErrorFetchingSignin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ErrorFetchingSignin"`, result)
+	}
+	json.Unmarshal([]byte(`"ErrorFetchingSignin"`), &enum)
+	if FederatedAuthRequestIssueReason.ErrorFetchingSignin != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ErrorFetchingSignin, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.InvalidSigninResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSigninResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSigninResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSigninResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.InvalidSigninResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.InvalidSigninResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.AccountsHTTPNotFound
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountsHttpNotFound"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountsHttpNotFound"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountsHttpNotFound"`), &enum)
+	if FederatedAuthRequestIssueReason.AccountsHTTPNotFound != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.AccountsHTTPNotFound, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.AccountsNoResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountsNoResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountsNoResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountsNoResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.AccountsNoResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.AccountsNoResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.AccountsInvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountsInvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountsInvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountsInvalidResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.AccountsInvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.AccountsInvalidResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.AccountsListEmpty
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountsListEmpty"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountsListEmpty"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountsListEmpty"`), &enum)
+	if FederatedAuthRequestIssueReason.AccountsListEmpty != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.AccountsListEmpty, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.AccountsInvalidContentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountsInvalidContentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountsInvalidContentType"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountsInvalidContentType"`), &enum)
+	if FederatedAuthRequestIssueReason.AccountsInvalidContentType != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.AccountsInvalidContentType, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenHTTPNotFound
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenHttpNotFound"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenHttpNotFound"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenHttpNotFound"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenHTTPNotFound != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenHTTPNotFound, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenNoResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenNoResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenNoResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenNoResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenNoResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenNoResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenInvalidResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenInvalidResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenInvalidResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenInvalidResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenInvalidResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenInvalidResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenIdpErrorResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenIdpErrorResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenIdpErrorResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenIdpErrorResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenIdpErrorResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenIdpErrorResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenCrossSiteIdpErrorResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenCrossSiteIdpErrorResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenCrossSiteIdpErrorResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenCrossSiteIdpErrorResponse"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenCrossSiteIdpErrorResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenCrossSiteIdpErrorResponse, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenInvalidRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenInvalidRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenInvalidRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenInvalidRequest"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenInvalidRequest != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenInvalidRequest, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.IDTokenInvalidContentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IdTokenInvalidContentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IdTokenInvalidContentType"`, result)
+	}
+	json.Unmarshal([]byte(`"IdTokenInvalidContentType"`), &enum)
+	if FederatedAuthRequestIssueReason.IDTokenInvalidContentType != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.IDTokenInvalidContentType, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ErrorIDToken
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ErrorIdToken"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ErrorIdToken"`, result)
+	}
+	json.Unmarshal([]byte(`"ErrorIdToken"`), &enum)
+	if FederatedAuthRequestIssueReason.ErrorIDToken != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ErrorIDToken, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.Canceled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Canceled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Canceled"`, result)
+	}
+	json.Unmarshal([]byte(`"Canceled"`), &enum)
+	if FederatedAuthRequestIssueReason.Canceled != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.Canceled, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.RpPageNotVisible
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RpPageNotVisible"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RpPageNotVisible"`, result)
+	}
+	json.Unmarshal([]byte(`"RpPageNotVisible"`), &enum)
+	if FederatedAuthRequestIssueReason.RpPageNotVisible != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.RpPageNotVisible, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.SilentMediationFailure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SilentMediationFailure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SilentMediationFailure"`, result)
+	}
+	json.Unmarshal([]byte(`"SilentMediationFailure"`), &enum)
+	if FederatedAuthRequestIssueReason.SilentMediationFailure != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.SilentMediationFailure, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ThirdPartyCookiesBlocked
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ThirdPartyCookiesBlocked"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ThirdPartyCookiesBlocked"`, result)
+	}
+	json.Unmarshal([]byte(`"ThirdPartyCookiesBlocked"`), &enum)
+	if FederatedAuthRequestIssueReason.ThirdPartyCookiesBlocked != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ThirdPartyCookiesBlocked, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.NotSignedInWithIdp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotSignedInWithIdp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotSignedInWithIdp"`, result)
+	}
+	json.Unmarshal([]byte(`"NotSignedInWithIdp"`), &enum)
+	if FederatedAuthRequestIssueReason.NotSignedInWithIdp != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.NotSignedInWithIdp, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.MissingTransientUserActivation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MissingTransientUserActivation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MissingTransientUserActivation"`, result)
+	}
+	json.Unmarshal([]byte(`"MissingTransientUserActivation"`), &enum)
+	if FederatedAuthRequestIssueReason.MissingTransientUserActivation != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.MissingTransientUserActivation, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.ReplacedByActiveMode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ReplacedByActiveMode"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ReplacedByActiveMode"`, result)
+	}
+	json.Unmarshal([]byte(`"ReplacedByActiveMode"`), &enum)
+	if FederatedAuthRequestIssueReason.ReplacedByActiveMode != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.ReplacedByActiveMode, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.InvalidFieldsSpecified
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidFieldsSpecified"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidFieldsSpecified"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidFieldsSpecified"`), &enum)
+	if FederatedAuthRequestIssueReason.InvalidFieldsSpecified != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.InvalidFieldsSpecified, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.RelyingPartyOriginIsOpaque
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RelyingPartyOriginIsOpaque"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RelyingPartyOriginIsOpaque"`, result)
+	}
+	json.Unmarshal([]byte(`"RelyingPartyOriginIsOpaque"`), &enum)
+	if FederatedAuthRequestIssueReason.RelyingPartyOriginIsOpaque != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.RelyingPartyOriginIsOpaque, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.TypeNotMatching
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TypeNotMatching"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TypeNotMatching"`, result)
+	}
+	json.Unmarshal([]byte(`"TypeNotMatching"`), &enum)
+	if FederatedAuthRequestIssueReason.TypeNotMatching != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.TypeNotMatching, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.UIDismissedNoEmbargo
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UiDismissedNoEmbargo"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UiDismissedNoEmbargo"`, result)
+	}
+	json.Unmarshal([]byte(`"UiDismissedNoEmbargo"`), &enum)
+	if FederatedAuthRequestIssueReason.UIDismissedNoEmbargo != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.UIDismissedNoEmbargo, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.CorsError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CorsError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CorsError"`, result)
+	}
+	json.Unmarshal([]byte(`"CorsError"`), &enum)
+	if FederatedAuthRequestIssueReason.CorsError != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.CorsError, enum)
+	}
+
+	enum = FederatedAuthRequestIssueReason.SuppressedBySegmentationPlatform
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SuppressedBySegmentationPlatform"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SuppressedBySegmentationPlatform"`, result)
+	}
+	json.Unmarshal([]byte(`"SuppressedBySegmentationPlatform"`), &enum)
+	if FederatedAuthRequestIssueReason.SuppressedBySegmentationPlatform != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthRequestIssueReason.SuppressedBySegmentationPlatform, enum)
+	}
+}
@@ -0,0 +1,129 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type federatedAuthUserInfoRequestIssueReasonEnum struct {
+	NotSameOrigin                      FederatedAuthUserInfoRequestIssueReasonEnum
+	NotIframe                          FederatedAuthUserInfoRequestIssueReasonEnum
+	NotPotentiallyTrustworthy          FederatedAuthUserInfoRequestIssueReasonEnum
+	NoAPIPermission                    FederatedAuthUserInfoRequestIssueReasonEnum
+	NotSignedInWithIdp                 FederatedAuthUserInfoRequestIssueReasonEnum
+	NoAccountSharingPermission         FederatedAuthUserInfoRequestIssueReasonEnum
+	InvalidConfigOrWellKnown           FederatedAuthUserInfoRequestIssueReasonEnum
+	InvalidAccountsResponse            FederatedAuthUserInfoRequestIssueReasonEnum
+	NoReturningUserFromFetchedAccounts FederatedAuthUserInfoRequestIssueReasonEnum
+}
+
+/*
+FederatedAuthUserInfoRequestIssueReason provides named access to the FederatedAuthUserInfoRequestIssueReasonEnum values.
+*/
+var FederatedAuthUserInfoRequestIssueReason = federatedAuthUserInfoRequestIssueReasonEnum{
+	NotSameOrigin:                      federatedAuthUserInfoRequestIssueReasonNotSameOrigin,
+	NotIframe:                          federatedAuthUserInfoRequestIssueReasonNotIframe,
+	NotPotentiallyTrustworthy:          federatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy,
+	NoAPIPermission:                    federatedAuthUserInfoRequestIssueReasonNoAPIPermission,
+	NotSignedInWithIdp:                 federatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp,
+	NoAccountSharingPermission:         federatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission,
+	InvalidConfigOrWellKnown:           federatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown,
+	InvalidAccountsResponse:            federatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse,
+	NoReturningUserFromFetchedAccounts: federatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts,
+}
+
+/*
+FederatedAuthUserInfoRequestIssueReasonEnum represents the
+Audits.FederatedAuthUserInfoRequestIssueReason values.
+
+Represents the failure reason when a getUserInfo() call fails. Should be updated
+alongside FederatedAuthUserInfoRequestResult in
+third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+
+Allowed values:
+  - FederatedAuthUserInfoRequestIssueReason.NotSameOrigin                      "NotSameOrigin"
+  - FederatedAuthUserInfoRequestIssueReason.NotIframe                          "NotIframe"
+  - FederatedAuthUserInfoRequestIssueReason.NotPotentiallyTrustworthy          "NotPotentiallyTrustworthy"
+  - FederatedAuthUserInfoRequestIssueReason.NoAPIPermission                    "NoApiPermission"
+  - FederatedAuthUserInfoRequestIssueReason.NotSignedInWithIdp                 "NotSignedInWithIdp"
+  - FederatedAuthUserInfoRequestIssueReason.NoAccountSharingPermission         "NoAccountSharingPermission"
+  - FederatedAuthUserInfoRequestIssueReason.InvalidConfigOrWellKnown           "InvalidConfigOrWellKnown"
+  - FederatedAuthUserInfoRequestIssueReason.InvalidAccountsResponse            "InvalidAccountsResponse"
+  - FederatedAuthUserInfoRequestIssueReason.NoReturningUserFromFetchedAccounts "NoReturningUserFromFetchedAccounts"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-FederatedAuthUserInfoRequestIssueReason
+*/
+type FederatedAuthUserInfoRequestIssueReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FederatedAuthUserInfoRequestIssueReasonEnum) String() string {
+	return _federatedAuthUserInfoRequestIssueReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FederatedAuthUserInfoRequestIssueReasonEnum) Unknown() bool {
+	return !_federatedAuthUserInfoRequestIssueReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FederatedAuthUserInfoRequestIssueReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FederatedAuthUserInfoRequestIssueReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _federatedAuthUserInfoRequestIssueReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// federatedAuthUserInfoRequestIssueReasonNotSameOrigin represents the "NotSameOrigin" value.
+	federatedAuthUserInfoRequestIssueReasonNotSameOrigin FederatedAuthUserInfoRequestIssueReasonEnum = iota + 1
+	// federatedAuthUserInfoRequestIssueReasonNotIframe represents the "NotIframe" value.
+	federatedAuthUserInfoRequestIssueReasonNotIframe
+	// federatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy represents the "NotPotentiallyTrustworthy" value.
+	federatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy
+	// federatedAuthUserInfoRequestIssueReasonNoAPIPermission represents the "NoApiPermission" value.
+	federatedAuthUserInfoRequestIssueReasonNoAPIPermission
+	// federatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp represents the "NotSignedInWithIdp" value.
+	federatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp
+	// federatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission represents the "NoAccountSharingPermission" value.
+	federatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission
+	// federatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown represents the "InvalidConfigOrWellKnown" value.
+	federatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown
+	// federatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse represents the "InvalidAccountsResponse" value.
+	federatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse
+	// federatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts represents the "NoReturningUserFromFetchedAccounts" value.
+	federatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts
+)
+
+var _federatedAuthUserInfoRequestIssueReasonEnums = enums.New(map[FederatedAuthUserInfoRequestIssueReasonEnum]string{
+	FederatedAuthUserInfoRequestIssueReasonEnum(0):                            "",
+	federatedAuthUserInfoRequestIssueReasonNotSameOrigin:                      "NotSameOrigin",
+	federatedAuthUserInfoRequestIssueReasonNotIframe:                          "NotIframe",
+	federatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy:          "NotPotentiallyTrustworthy",
+	federatedAuthUserInfoRequestIssueReasonNoAPIPermission:                    "NoApiPermission",
+	federatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp:                 "NotSignedInWithIdp",
+	federatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission:         "NoAccountSharingPermission",
+	federatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown:           "InvalidConfigOrWellKnown",
+	federatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse:            "InvalidAccountsResponse",
+	federatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts: "NoReturningUserFromFetchedAccounts",
+})
@@ -0,0 +1,160 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFederatedAuthUserInfoRequestIssueReason(t *testing.T) {
+	var enum FederatedAuthUserInfoRequestIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FederatedAuthUserInfoRequestIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NotSameOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotSameOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotSameOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"NotSameOrigin"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NotSameOrigin != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NotSameOrigin, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NotIframe
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotIframe"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotIframe"`, result)
+	}
+	json.Unmarshal([]byte(`"NotIframe"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NotIframe != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NotIframe, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NotPotentiallyTrustworthy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotPotentiallyTrustworthy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotPotentiallyTrustworthy"`, result)
+	}
+	json.Unmarshal([]byte(`"NotPotentiallyTrustworthy"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NotPotentiallyTrustworthy != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NotPotentiallyTrustworthy, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NoAPIPermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoApiPermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoApiPermission"`, result)
+	}
+	json.Unmarshal([]byte(`"NoApiPermission"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NoAPIPermission != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NoAPIPermission, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NotSignedInWithIdp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotSignedInWithIdp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotSignedInWithIdp"`, result)
+	}
+	json.Unmarshal([]byte(`"NotSignedInWithIdp"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NotSignedInWithIdp != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NotSignedInWithIdp, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NoAccountSharingPermission
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoAccountSharingPermission"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoAccountSharingPermission"`, result)
+	}
+	json.Unmarshal([]byte(`"NoAccountSharingPermission"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NoAccountSharingPermission != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NoAccountSharingPermission, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.InvalidConfigOrWellKnown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidConfigOrWellKnown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidConfigOrWellKnown"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidConfigOrWellKnown"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.InvalidConfigOrWellKnown != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.InvalidConfigOrWellKnown, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.InvalidAccountsResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidAccountsResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidAccountsResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidAccountsResponse"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.InvalidAccountsResponse != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.InvalidAccountsResponse, enum)
+	}
+
+	enum = FederatedAuthUserInfoRequestIssueReason.NoReturningUserFromFetchedAccounts
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoReturningUserFromFetchedAccounts"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoReturningUserFromFetchedAccounts"`, result)
+	}
+	json.Unmarshal([]byte(`"NoReturningUserFromFetchedAccounts"`), &enum)
+	if FederatedAuthUserInfoRequestIssueReason.NoReturningUserFromFetchedAccounts != enum {
+		t.Errorf("Expected %d, got %d", FederatedAuthUserInfoRequestIssueReason.NoReturningUserFromFetchedAccounts, enum)
+	}
+}
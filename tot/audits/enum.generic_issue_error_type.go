@@ -0,0 +1,136 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type genericIssueErrorTypeEnum struct {
+	FormLabelForNameError                                      GenericIssueErrorTypeEnum
+	FormDuplicateIDForInputError                               GenericIssueErrorTypeEnum
+	FormInputWithNoLabelError                                  GenericIssueErrorTypeEnum
+	FormAutocompleteAttributeEmptyError                        GenericIssueErrorTypeEnum
+	FormEmptyIDAndNameAttributesForInputError                  GenericIssueErrorTypeEnum
+	FormAriaLabelledByToNonExistingID                          GenericIssueErrorTypeEnum
+	FormInputAssignedAutocompleteValueToIDOrNameAttributeError GenericIssueErrorTypeEnum
+	FormLabelHasNeitherForNorNestedInput                       GenericIssueErrorTypeEnum
+	FormLabelForMatchesNonExistingIDError                      GenericIssueErrorTypeEnum
+	FormInputHasWrongButWellIntendedAutocompleteValueError     GenericIssueErrorTypeEnum
+	ResponseWasBlockedByORB                                    GenericIssueErrorTypeEnum
+}
+
+/*
+GenericIssueErrorType provides named access to the GenericIssueErrorTypeEnum values.
+*/
+var GenericIssueErrorType = genericIssueErrorTypeEnum{
+	FormLabelForNameError:                                      genericIssueErrorTypeFormLabelForNameError,
+	FormDuplicateIDForInputError:                               genericIssueErrorTypeFormDuplicateIDForInputError,
+	FormInputWithNoLabelError:                                  genericIssueErrorTypeFormInputWithNoLabelError,
+	FormAutocompleteAttributeEmptyError:                        genericIssueErrorTypeFormAutocompleteAttributeEmptyError,
+	FormEmptyIDAndNameAttributesForInputError:                  genericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError,
+	FormAriaLabelledByToNonExistingID:                          genericIssueErrorTypeFormAriaLabelledByToNonExistingID,
+	FormInputAssignedAutocompleteValueToIDOrNameAttributeError: genericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError,
+	FormLabelHasNeitherForNorNestedInput:                       genericIssueErrorTypeFormLabelHasNeitherForNorNestedInput,
+	FormLabelForMatchesNonExistingIDError:                      genericIssueErrorTypeFormLabelForMatchesNonExistingIDError,
+	FormInputHasWrongButWellIntendedAutocompleteValueError:     genericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError,
+	ResponseWasBlockedByORB:                                    genericIssueErrorTypeResponseWasBlockedByORB,
+}
+
+/*
+GenericIssueErrorTypeEnum represents the Audits.GenericIssueErrorType values.
+
+Allowed values:
+  - GenericIssueErrorType.FormLabelForNameError                                      "FormLabelForNameError"
+  - GenericIssueErrorType.FormDuplicateIDForInputError                               "FormDuplicateIdForInputError"
+  - GenericIssueErrorType.FormInputWithNoLabelError                                  "FormInputWithNoLabelError"
+  - GenericIssueErrorType.FormAutocompleteAttributeEmptyError                        "FormAutocompleteAttributeEmptyError"
+  - GenericIssueErrorType.FormEmptyIDAndNameAttributesForInputError                  "FormEmptyIdAndNameAttributesForInputError"
+  - GenericIssueErrorType.FormAriaLabelledByToNonExistingID                          "FormAriaLabelledByToNonExistingId"
+  - GenericIssueErrorType.FormInputAssignedAutocompleteValueToIDOrNameAttributeError "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+  - GenericIssueErrorType.FormLabelHasNeitherForNorNestedInput                       "FormLabelHasNeitherForNorNestedInput"
+  - GenericIssueErrorType.FormLabelForMatchesNonExistingIDError                      "FormLabelForMatchesNonExistingIdError"
+  - GenericIssueErrorType.FormInputHasWrongButWellIntendedAutocompleteValueError     "FormInputHasWrongButWellIntendedAutocompleteValueError"
+  - GenericIssueErrorType.ResponseWasBlockedByORB                                    "ResponseWasBlockedByORB"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-GenericIssueErrorType
+*/
+type GenericIssueErrorTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum GenericIssueErrorTypeEnum) String() string {
+	return _genericIssueErrorTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum GenericIssueErrorTypeEnum) Unknown() bool {
+	return !_genericIssueErrorTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum GenericIssueErrorTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *GenericIssueErrorTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _genericIssueErrorTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// genericIssueErrorTypeFormLabelForNameError represents the "FormLabelForNameError" value.
+	genericIssueErrorTypeFormLabelForNameError GenericIssueErrorTypeEnum = iota + 1
+	// genericIssueErrorTypeFormDuplicateIDForInputError represents the "FormDuplicateIdForInputError" value.
+	genericIssueErrorTypeFormDuplicateIDForInputError
+	// genericIssueErrorTypeFormInputWithNoLabelError represents the "FormInputWithNoLabelError" value.
+	genericIssueErrorTypeFormInputWithNoLabelError
+	// genericIssueErrorTypeFormAutocompleteAttributeEmptyError represents the "FormAutocompleteAttributeEmptyError" value.
+	genericIssueErrorTypeFormAutocompleteAttributeEmptyError
+	// genericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError represents the "FormEmptyIdAndNameAttributesForInputError" value.
+	genericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError
+	// genericIssueErrorTypeFormAriaLabelledByToNonExistingID represents the "FormAriaLabelledByToNonExistingId" value.
+	genericIssueErrorTypeFormAriaLabelledByToNonExistingID
+	// genericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError represents the "FormInputAssignedAutocompleteValueToIdOrNameAttributeError" value.
+	genericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError
+	// genericIssueErrorTypeFormLabelHasNeitherForNorNestedInput represents the "FormLabelHasNeitherForNorNestedInput" value.
+	genericIssueErrorTypeFormLabelHasNeitherForNorNestedInput
+	// genericIssueErrorTypeFormLabelForMatchesNonExistingIDError represents the "FormLabelForMatchesNonExistingIdError" value.
+	genericIssueErrorTypeFormLabelForMatchesNonExistingIDError
+	// genericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError represents the "FormInputHasWrongButWellIntendedAutocompleteValueError" value.
+	genericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError
+	// genericIssueErrorTypeResponseWasBlockedByORB represents the "ResponseWasBlockedByORB" value.
+	genericIssueErrorTypeResponseWasBlockedByORB
+)
+
+var _genericIssueErrorTypeEnums = enums.New(map[GenericIssueErrorTypeEnum]string{
+	GenericIssueErrorTypeEnum(0):                                                    "",
+	genericIssueErrorTypeFormLabelForNameError:                                      "FormLabelForNameError",
+	genericIssueErrorTypeFormDuplicateIDForInputError:                               "FormDuplicateIdForInputError",
+	genericIssueErrorTypeFormInputWithNoLabelError:                                  "FormInputWithNoLabelError",
+	genericIssueErrorTypeFormAutocompleteAttributeEmptyError:                        "FormAutocompleteAttributeEmptyError",
+	genericIssueErrorTypeFormEmptyIDAndNameAttributesForInputError:                  "FormEmptyIdAndNameAttributesForInputError",
+	genericIssueErrorTypeFormAriaLabelledByToNonExistingID:                          "FormAriaLabelledByToNonExistingId",
+	genericIssueErrorTypeFormInputAssignedAutocompleteValueToIDOrNameAttributeError: "FormInputAssignedAutocompleteValueToIdOrNameAttributeError",
+	genericIssueErrorTypeFormLabelHasNeitherForNorNestedInput:                       "FormLabelHasNeitherForNorNestedInput",
+	genericIssueErrorTypeFormLabelForMatchesNonExistingIDError:                      "FormLabelForMatchesNonExistingIdError",
+	genericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError:     "FormInputHasWrongButWellIntendedAutocompleteValueError",
+	genericIssueErrorTypeResponseWasBlockedByORB:                                    "ResponseWasBlockedByORB",
+})
@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumGenericIssueErrorType(t *testing.T) {
+	var enum GenericIssueErrorTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown GenericIssueErrorTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = GenericIssueErrorType.FormLabelForNameError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormLabelForNameError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormLabelForNameError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormLabelForNameError"`), &enum)
+	if GenericIssueErrorType.FormLabelForNameError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormLabelForNameError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormDuplicateIDForInputError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormDuplicateIdForInputError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormDuplicateIdForInputError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormDuplicateIdForInputError"`), &enum)
+	if GenericIssueErrorType.FormDuplicateIDForInputError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormDuplicateIDForInputError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormInputWithNoLabelError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormInputWithNoLabelError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormInputWithNoLabelError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormInputWithNoLabelError"`), &enum)
+	if GenericIssueErrorType.FormInputWithNoLabelError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormInputWithNoLabelError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormAutocompleteAttributeEmptyError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormAutocompleteAttributeEmptyError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormAutocompleteAttributeEmptyError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormAutocompleteAttributeEmptyError"`), &enum)
+	if GenericIssueErrorType.FormAutocompleteAttributeEmptyError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormAutocompleteAttributeEmptyError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormEmptyIDAndNameAttributesForInputError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormEmptyIdAndNameAttributesForInputError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormEmptyIdAndNameAttributesForInputError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormEmptyIdAndNameAttributesForInputError"`), &enum)
+	if GenericIssueErrorType.FormEmptyIDAndNameAttributesForInputError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormEmptyIDAndNameAttributesForInputError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormAriaLabelledByToNonExistingID
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormAriaLabelledByToNonExistingId"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormAriaLabelledByToNonExistingId"`, result)
+	}
+	json.Unmarshal([]byte(`"FormAriaLabelledByToNonExistingId"`), &enum)
+	if GenericIssueErrorType.FormAriaLabelledByToNonExistingID != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormAriaLabelledByToNonExistingID, enum)
+	}
+
+	enum = GenericIssueErrorType.FormInputAssignedAutocompleteValueToIDOrNameAttributeError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormInputAssignedAutocompleteValueToIdOrNameAttributeError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormInputAssignedAutocompleteValueToIdOrNameAttributeError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormInputAssignedAutocompleteValueToIdOrNameAttributeError"`), &enum)
+	if GenericIssueErrorType.FormInputAssignedAutocompleteValueToIDOrNameAttributeError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormInputAssignedAutocompleteValueToIDOrNameAttributeError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormLabelHasNeitherForNorNestedInput
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormLabelHasNeitherForNorNestedInput"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormLabelHasNeitherForNorNestedInput"`, result)
+	}
+	json.Unmarshal([]byte(`"FormLabelHasNeitherForNorNestedInput"`), &enum)
+	if GenericIssueErrorType.FormLabelHasNeitherForNorNestedInput != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormLabelHasNeitherForNorNestedInput, enum)
+	}
+
+	enum = GenericIssueErrorType.FormLabelForMatchesNonExistingIDError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormLabelForMatchesNonExistingIdError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormLabelForMatchesNonExistingIdError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormLabelForMatchesNonExistingIdError"`), &enum)
+	if GenericIssueErrorType.FormLabelForMatchesNonExistingIDError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormLabelForMatchesNonExistingIDError, enum)
+	}
+
+	enum = GenericIssueErrorType.FormInputHasWrongButWellIntendedAutocompleteValueError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FormInputHasWrongButWellIntendedAutocompleteValueError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FormInputHasWrongButWellIntendedAutocompleteValueError"`, result)
+	}
+	json.Unmarshal([]byte(`"FormInputHasWrongButWellIntendedAutocompleteValueError"`), &enum)
+	if GenericIssueErrorType.FormInputHasWrongButWellIntendedAutocompleteValueError != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.FormInputHasWrongButWellIntendedAutocompleteValueError, enum)
+	}
+
+	enum = GenericIssueErrorType.ResponseWasBlockedByORB
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ResponseWasBlockedByORB"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ResponseWasBlockedByORB"`, result)
+	}
+	json.Unmarshal([]byte(`"ResponseWasBlockedByORB"`), &enum)
+	if GenericIssueErrorType.ResponseWasBlockedByORB != enum {
+		t.Errorf("Expected %d, got %d", GenericIssueErrorType.ResponseWasBlockedByORB, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package audits
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type encodingEnum struct {
@@ -12,7 +15,7 @@ type encodingEnum struct {
 }
 
 /*
-Encoding provides named acces to the EncodingEnum values.
+Encoding provides named access to the EncodingEnum values.
 */
 var Encoding = encodingEnum{
 	Webp: encodingWebp,
@@ -21,10 +24,14 @@ var Encoding = encodingEnum{
 }
 
 /*
-EncodingEnum represents the encoding to use. Allowed values:
-	- Encoding.Webp "webp"
-	- Encoding.Jpeg "jpeg"
-	- Encoding.Png  "png"
+EncodingEnum represents the Audits.getEncodedResponse.encoding values.
+
+The encoding to use.
+
+Allowed values:
+  - Encoding.Webp "webp"
+  - Encoding.Jpeg "jpeg"
+  - Encoding.Png  "png"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncodedResponse
 */
@@ -34,7 +41,16 @@ type EncodingEnum int
 String implements Stringer
 */
 func (enum EncodingEnum) String() string {
-	return _encodingEnums[enum]
+	return _encodingEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum EncodingEnum) Unknown() bool {
+	return !_encodingEnums.Known(enum)
 }
 
 /*
@@ -45,25 +61,16 @@ func (enum EncodingEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *EncodingEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _encodingEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid encoding value", bytes)
+	*enum = _encodingEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -75,8 +82,9 @@ const (
 	encodingPng
 )
 
-var _encodingEnums = map[EncodingEnum]string{
-	encodingWebp: "webp",
-	encodingJpeg: "jpeg",
-	encodingPng:  "png",
-}
+var _encodingEnums = enums.New(map[EncodingEnum]string{
+	EncodingEnum(0): "",
+	encodingWebp:    "webp",
+	encodingJpeg:    "jpeg",
+	encodingPng:     "png",
+})
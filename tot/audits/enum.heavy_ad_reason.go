@@ -0,0 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type heavyAdReasonEnum struct {
+	NetworkTotalLimit HeavyAdReasonEnum
+	CPUTotalLimit     HeavyAdReasonEnum
+	CPUPeakLimit      HeavyAdReasonEnum
+}
+
+/*
+HeavyAdReason provides named access to the HeavyAdReasonEnum values.
+*/
+var HeavyAdReason = heavyAdReasonEnum{
+	NetworkTotalLimit: heavyAdReasonNetworkTotalLimit,
+	CPUTotalLimit:     heavyAdReasonCPUTotalLimit,
+	CPUPeakLimit:      heavyAdReasonCPUPeakLimit,
+}
+
+/*
+HeavyAdReasonEnum represents the Audits.HeavyAdReason values.
+
+Allowed values:
+  - HeavyAdReason.NetworkTotalLimit "NetworkTotalLimit"
+  - HeavyAdReason.CPUTotalLimit     "CpuTotalLimit"
+  - HeavyAdReason.CPUPeakLimit      "CpuPeakLimit"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-HeavyAdReason
+*/
+type HeavyAdReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum HeavyAdReasonEnum) String() string {
+	return _heavyAdReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum HeavyAdReasonEnum) Unknown() bool {
+	return !_heavyAdReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum HeavyAdReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *HeavyAdReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _heavyAdReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// heavyAdReasonNetworkTotalLimit represents the "NetworkTotalLimit" value.
+	heavyAdReasonNetworkTotalLimit HeavyAdReasonEnum = iota + 1
+	// heavyAdReasonCPUTotalLimit represents the "CpuTotalLimit" value.
+	heavyAdReasonCPUTotalLimit
+	// heavyAdReasonCPUPeakLimit represents the "CpuPeakLimit" value.
+	heavyAdReasonCPUPeakLimit
+)
+
+var _heavyAdReasonEnums = enums.New(map[HeavyAdReasonEnum]string{
+	HeavyAdReasonEnum(0):           "",
+	heavyAdReasonNetworkTotalLimit: "NetworkTotalLimit",
+	heavyAdReasonCPUTotalLimit:     "CpuTotalLimit",
+	heavyAdReasonCPUPeakLimit:      "CpuPeakLimit",
+})
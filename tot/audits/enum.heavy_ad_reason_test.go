@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumHeavyAdReason(t *testing.T) {
+	var enum HeavyAdReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown HeavyAdReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = HeavyAdReason.NetworkTotalLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NetworkTotalLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NetworkTotalLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"NetworkTotalLimit"`), &enum)
+	if HeavyAdReason.NetworkTotalLimit != enum {
+		t.Errorf("Expected %d, got %d", HeavyAdReason.NetworkTotalLimit, enum)
+	}
+
+	enum = HeavyAdReason.CPUTotalLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CpuTotalLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CpuTotalLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"CpuTotalLimit"`), &enum)
+	if HeavyAdReason.CPUTotalLimit != enum {
+		t.Errorf("Expected %d, got %d", HeavyAdReason.CPUTotalLimit, enum)
+	}
+
+	enum = HeavyAdReason.CPUPeakLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CpuPeakLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CpuPeakLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"CpuPeakLimit"`), &enum)
+	if HeavyAdReason.CPUPeakLimit != enum {
+		t.Errorf("Expected %d, got %d", HeavyAdReason.CPUPeakLimit, enum)
+	}
+}
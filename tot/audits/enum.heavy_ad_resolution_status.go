@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type heavyAdResolutionStatusEnum struct {
+	HeavyAdBlocked HeavyAdResolutionStatusEnum
+	HeavyAdWarning HeavyAdResolutionStatusEnum
+}
+
+/*
+HeavyAdResolutionStatus provides named access to the HeavyAdResolutionStatusEnum values.
+*/
+var HeavyAdResolutionStatus = heavyAdResolutionStatusEnum{
+	HeavyAdBlocked: heavyAdResolutionStatusHeavyAdBlocked,
+	HeavyAdWarning: heavyAdResolutionStatusHeavyAdWarning,
+}
+
+/*
+HeavyAdResolutionStatusEnum represents the Audits.HeavyAdResolutionStatus
+values.
+
+Allowed values:
+  - HeavyAdResolutionStatus.HeavyAdBlocked "HeavyAdBlocked"
+  - HeavyAdResolutionStatus.HeavyAdWarning "HeavyAdWarning"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-HeavyAdResolutionStatus
+*/
+type HeavyAdResolutionStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum HeavyAdResolutionStatusEnum) String() string {
+	return _heavyAdResolutionStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum HeavyAdResolutionStatusEnum) Unknown() bool {
+	return !_heavyAdResolutionStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum HeavyAdResolutionStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *HeavyAdResolutionStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _heavyAdResolutionStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// heavyAdResolutionStatusHeavyAdBlocked represents the "HeavyAdBlocked" value.
+	heavyAdResolutionStatusHeavyAdBlocked HeavyAdResolutionStatusEnum = iota + 1
+	// heavyAdResolutionStatusHeavyAdWarning represents the "HeavyAdWarning" value.
+	heavyAdResolutionStatusHeavyAdWarning
+)
+
+var _heavyAdResolutionStatusEnums = enums.New(map[HeavyAdResolutionStatusEnum]string{
+	HeavyAdResolutionStatusEnum(0):        "",
+	heavyAdResolutionStatusHeavyAdBlocked: "HeavyAdBlocked",
+	heavyAdResolutionStatusHeavyAdWarning: "HeavyAdWarning",
+})
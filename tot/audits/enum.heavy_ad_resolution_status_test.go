@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumHeavyAdResolutionStatus(t *testing.T) {
+	var enum HeavyAdResolutionStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown HeavyAdResolutionStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = HeavyAdResolutionStatus.HeavyAdBlocked
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HeavyAdBlocked"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HeavyAdBlocked"`, result)
+	}
+	json.Unmarshal([]byte(`"HeavyAdBlocked"`), &enum)
+	if HeavyAdResolutionStatus.HeavyAdBlocked != enum {
+		t.Errorf("Expected %d, got %d", HeavyAdResolutionStatus.HeavyAdBlocked, enum)
+	}
+
+	enum = HeavyAdResolutionStatus.HeavyAdWarning
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HeavyAdWarning"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HeavyAdWarning"`, result)
+	}
+	json.Unmarshal([]byte(`"HeavyAdWarning"`), &enum)
+	if HeavyAdResolutionStatus.HeavyAdWarning != enum {
+		t.Errorf("Expected %d, got %d", HeavyAdResolutionStatus.HeavyAdWarning, enum)
+	}
+}
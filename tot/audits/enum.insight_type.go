@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type insightTypeEnum struct {
+	GitHubResource InsightTypeEnum
+	GracePeriod    InsightTypeEnum
+	Heuristics     InsightTypeEnum
+}
+
+/*
+InsightType provides named access to the InsightTypeEnum values.
+*/
+var InsightType = insightTypeEnum{
+	GitHubResource: insightTypeGitHubResource,
+	GracePeriod:    insightTypeGracePeriod,
+	Heuristics:     insightTypeHeuristics,
+}
+
+/*
+InsightTypeEnum represents the Audits.InsightType values.
+
+Represents the category of insight that a cookie issue falls under.
+
+Allowed values:
+  - InsightType.GitHubResource "GitHubResource"
+  - InsightType.GracePeriod    "GracePeriod"
+  - InsightType.Heuristics     "Heuristics"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-InsightType
+*/
+type InsightTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InsightTypeEnum) String() string {
+	return _insightTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InsightTypeEnum) Unknown() bool {
+	return !_insightTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InsightTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InsightTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _insightTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// insightTypeGitHubResource represents the "GitHubResource" value.
+	insightTypeGitHubResource InsightTypeEnum = iota + 1
+	// insightTypeGracePeriod represents the "GracePeriod" value.
+	insightTypeGracePeriod
+	// insightTypeHeuristics represents the "Heuristics" value.
+	insightTypeHeuristics
+)
+
+var _insightTypeEnums = enums.New(map[InsightTypeEnum]string{
+	InsightTypeEnum(0):        "",
+	insightTypeGitHubResource: "GitHubResource",
+	insightTypeGracePeriod:    "GracePeriod",
+	insightTypeHeuristics:     "Heuristics",
+})
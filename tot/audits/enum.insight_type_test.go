@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInsightType(t *testing.T) {
+	var enum InsightTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InsightTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InsightType.GitHubResource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"GitHubResource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"GitHubResource"`, result)
+	}
+	json.Unmarshal([]byte(`"GitHubResource"`), &enum)
+	if InsightType.GitHubResource != enum {
+		t.Errorf("Expected %d, got %d", InsightType.GitHubResource, enum)
+	}
+
+	enum = InsightType.GracePeriod
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"GracePeriod"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"GracePeriod"`, result)
+	}
+	json.Unmarshal([]byte(`"GracePeriod"`), &enum)
+	if InsightType.GracePeriod != enum {
+		t.Errorf("Expected %d, got %d", InsightType.GracePeriod, enum)
+	}
+
+	enum = InsightType.Heuristics
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Heuristics"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Heuristics"`, result)
+	}
+	json.Unmarshal([]byte(`"Heuristics"`), &enum)
+	if InsightType.Heuristics != enum {
+		t.Errorf("Expected %d, got %d", InsightType.Heuristics, enum)
+	}
+}
@@ -0,0 +1,230 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type inspectorIssueCodeEnum struct {
+	CookieIssue                       InspectorIssueCodeEnum
+	MixedContentIssue                 InspectorIssueCodeEnum
+	BlockedByResponseIssue            InspectorIssueCodeEnum
+	HeavyAdIssue                      InspectorIssueCodeEnum
+	ContentSecurityPolicyIssue        InspectorIssueCodeEnum
+	SharedArrayBufferIssue            InspectorIssueCodeEnum
+	LowTextContrastIssue              InspectorIssueCodeEnum
+	CorsIssue                         InspectorIssueCodeEnum
+	AttributionReportingIssue         InspectorIssueCodeEnum
+	QuirksModeIssue                   InspectorIssueCodeEnum
+	PartitioningBlobURLIssue          InspectorIssueCodeEnum
+	NavigatorUserAgentIssue           InspectorIssueCodeEnum
+	GenericIssue                      InspectorIssueCodeEnum
+	DeprecationIssue                  InspectorIssueCodeEnum
+	ClientHintIssue                   InspectorIssueCodeEnum
+	FederatedAuthRequestIssue         InspectorIssueCodeEnum
+	BounceTrackingIssue               InspectorIssueCodeEnum
+	CookieDeprecationMetadataIssue    InspectorIssueCodeEnum
+	StylesheetLoadingIssue            InspectorIssueCodeEnum
+	FederatedAuthUserInfoRequestIssue InspectorIssueCodeEnum
+	PropertyRuleIssue                 InspectorIssueCodeEnum
+	SharedDictionaryIssue             InspectorIssueCodeEnum
+	ElementAccessibilityIssue         InspectorIssueCodeEnum
+	SRIMessageSignatureIssue          InspectorIssueCodeEnum
+	UnencodedDigestIssue              InspectorIssueCodeEnum
+	UserReidentificationIssue         InspectorIssueCodeEnum
+}
+
+/*
+InspectorIssueCode provides named access to the InspectorIssueCodeEnum values.
+*/
+var InspectorIssueCode = inspectorIssueCodeEnum{
+	CookieIssue:                       inspectorIssueCodeCookieIssue,
+	MixedContentIssue:                 inspectorIssueCodeMixedContentIssue,
+	BlockedByResponseIssue:            inspectorIssueCodeBlockedByResponseIssue,
+	HeavyAdIssue:                      inspectorIssueCodeHeavyAdIssue,
+	ContentSecurityPolicyIssue:        inspectorIssueCodeContentSecurityPolicyIssue,
+	SharedArrayBufferIssue:            inspectorIssueCodeSharedArrayBufferIssue,
+	LowTextContrastIssue:              inspectorIssueCodeLowTextContrastIssue,
+	CorsIssue:                         inspectorIssueCodeCorsIssue,
+	AttributionReportingIssue:         inspectorIssueCodeAttributionReportingIssue,
+	QuirksModeIssue:                   inspectorIssueCodeQuirksModeIssue,
+	PartitioningBlobURLIssue:          inspectorIssueCodePartitioningBlobURLIssue,
+	NavigatorUserAgentIssue:           inspectorIssueCodeNavigatorUserAgentIssue,
+	GenericIssue:                      inspectorIssueCodeGenericIssue,
+	DeprecationIssue:                  inspectorIssueCodeDeprecationIssue,
+	ClientHintIssue:                   inspectorIssueCodeClientHintIssue,
+	FederatedAuthRequestIssue:         inspectorIssueCodeFederatedAuthRequestIssue,
+	BounceTrackingIssue:               inspectorIssueCodeBounceTrackingIssue,
+	CookieDeprecationMetadataIssue:    inspectorIssueCodeCookieDeprecationMetadataIssue,
+	StylesheetLoadingIssue:            inspectorIssueCodeStylesheetLoadingIssue,
+	FederatedAuthUserInfoRequestIssue: inspectorIssueCodeFederatedAuthUserInfoRequestIssue,
+	PropertyRuleIssue:                 inspectorIssueCodePropertyRuleIssue,
+	SharedDictionaryIssue:             inspectorIssueCodeSharedDictionaryIssue,
+	ElementAccessibilityIssue:         inspectorIssueCodeElementAccessibilityIssue,
+	SRIMessageSignatureIssue:          inspectorIssueCodeSRIMessageSignatureIssue,
+	UnencodedDigestIssue:              inspectorIssueCodeUnencodedDigestIssue,
+	UserReidentificationIssue:         inspectorIssueCodeUserReidentificationIssue,
+}
+
+/*
+InspectorIssueCodeEnum represents the Audits.InspectorIssueCode values.
+
+A unique identifier for the type of issue. Each type may use one of the optional
+fields in InspectorIssueDetails to convey more specific information about the
+kind of issue.
+
+Allowed values:
+  - InspectorIssueCode.CookieIssue                       "CookieIssue"
+  - InspectorIssueCode.MixedContentIssue                 "MixedContentIssue"
+  - InspectorIssueCode.BlockedByResponseIssue            "BlockedByResponseIssue"
+  - InspectorIssueCode.HeavyAdIssue                      "HeavyAdIssue"
+  - InspectorIssueCode.ContentSecurityPolicyIssue        "ContentSecurityPolicyIssue"
+  - InspectorIssueCode.SharedArrayBufferIssue            "SharedArrayBufferIssue"
+  - InspectorIssueCode.LowTextContrastIssue              "LowTextContrastIssue"
+  - InspectorIssueCode.CorsIssue                         "CorsIssue"
+  - InspectorIssueCode.AttributionReportingIssue         "AttributionReportingIssue"
+  - InspectorIssueCode.QuirksModeIssue                   "QuirksModeIssue"
+  - InspectorIssueCode.PartitioningBlobURLIssue          "PartitioningBlobURLIssue"
+  - InspectorIssueCode.NavigatorUserAgentIssue           "NavigatorUserAgentIssue"
+  - InspectorIssueCode.GenericIssue                      "GenericIssue"
+  - InspectorIssueCode.DeprecationIssue                  "DeprecationIssue"
+  - InspectorIssueCode.ClientHintIssue                   "ClientHintIssue"
+  - InspectorIssueCode.FederatedAuthRequestIssue         "FederatedAuthRequestIssue"
+  - InspectorIssueCode.BounceTrackingIssue               "BounceTrackingIssue"
+  - InspectorIssueCode.CookieDeprecationMetadataIssue    "CookieDeprecationMetadataIssue"
+  - InspectorIssueCode.StylesheetLoadingIssue            "StylesheetLoadingIssue"
+  - InspectorIssueCode.FederatedAuthUserInfoRequestIssue "FederatedAuthUserInfoRequestIssue"
+  - InspectorIssueCode.PropertyRuleIssue                 "PropertyRuleIssue"
+  - InspectorIssueCode.SharedDictionaryIssue             "SharedDictionaryIssue"
+  - InspectorIssueCode.ElementAccessibilityIssue         "ElementAccessibilityIssue"
+  - InspectorIssueCode.SRIMessageSignatureIssue          "SRIMessageSignatureIssue"
+  - InspectorIssueCode.UnencodedDigestIssue              "UnencodedDigestIssue"
+  - InspectorIssueCode.UserReidentificationIssue         "UserReidentificationIssue"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-InspectorIssueCode
+*/
+type InspectorIssueCodeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InspectorIssueCodeEnum) String() string {
+	return _inspectorIssueCodeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InspectorIssueCodeEnum) Unknown() bool {
+	return !_inspectorIssueCodeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InspectorIssueCodeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InspectorIssueCodeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _inspectorIssueCodeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// inspectorIssueCodeCookieIssue represents the "CookieIssue" value.
+	inspectorIssueCodeCookieIssue InspectorIssueCodeEnum = iota + 1
+	// inspectorIssueCodeMixedContentIssue represents the "MixedContentIssue" value.
+	inspectorIssueCodeMixedContentIssue
+	// inspectorIssueCodeBlockedByResponseIssue represents the "BlockedByResponseIssue" value.
+	inspectorIssueCodeBlockedByResponseIssue
+	// inspectorIssueCodeHeavyAdIssue represents the "HeavyAdIssue" value.
+	inspectorIssueCodeHeavyAdIssue
+	// inspectorIssueCodeContentSecurityPolicyIssue represents the "ContentSecurityPolicyIssue" value.
+	inspectorIssueCodeContentSecurityPolicyIssue
+	// inspectorIssueCodeSharedArrayBufferIssue represents the "SharedArrayBufferIssue" value.
+	inspectorIssueCodeSharedArrayBufferIssue
+	// inspectorIssueCodeLowTextContrastIssue represents the "LowTextContrastIssue" value.
+	inspectorIssueCodeLowTextContrastIssue
+	// inspectorIssueCodeCorsIssue represents the "CorsIssue" value.
+	inspectorIssueCodeCorsIssue
+	// inspectorIssueCodeAttributionReportingIssue represents the "AttributionReportingIssue" value.
+	inspectorIssueCodeAttributionReportingIssue
+	// inspectorIssueCodeQuirksModeIssue represents the "QuirksModeIssue" value.
+	inspectorIssueCodeQuirksModeIssue
+	// inspectorIssueCodePartitioningBlobURLIssue represents the "PartitioningBlobURLIssue" value.
+	inspectorIssueCodePartitioningBlobURLIssue
+	// inspectorIssueCodeNavigatorUserAgentIssue represents the "NavigatorUserAgentIssue" value.
+	inspectorIssueCodeNavigatorUserAgentIssue
+	// inspectorIssueCodeGenericIssue represents the "GenericIssue" value.
+	inspectorIssueCodeGenericIssue
+	// inspectorIssueCodeDeprecationIssue represents the "DeprecationIssue" value.
+	inspectorIssueCodeDeprecationIssue
+	// inspectorIssueCodeClientHintIssue represents the "ClientHintIssue" value.
+	inspectorIssueCodeClientHintIssue
+	// inspectorIssueCodeFederatedAuthRequestIssue represents the "FederatedAuthRequestIssue" value.
+	inspectorIssueCodeFederatedAuthRequestIssue
+	// inspectorIssueCodeBounceTrackingIssue represents the "BounceTrackingIssue" value.
+	inspectorIssueCodeBounceTrackingIssue
+	// inspectorIssueCodeCookieDeprecationMetadataIssue represents the "CookieDeprecationMetadataIssue" value.
+	inspectorIssueCodeCookieDeprecationMetadataIssue
+	// inspectorIssueCodeStylesheetLoadingIssue represents the "StylesheetLoadingIssue" value.
+	inspectorIssueCodeStylesheetLoadingIssue
+	// inspectorIssueCodeFederatedAuthUserInfoRequestIssue represents the "FederatedAuthUserInfoRequestIssue" value.
+	inspectorIssueCodeFederatedAuthUserInfoRequestIssue
+	// inspectorIssueCodePropertyRuleIssue represents the "PropertyRuleIssue" value.
+	inspectorIssueCodePropertyRuleIssue
+	// inspectorIssueCodeSharedDictionaryIssue represents the "SharedDictionaryIssue" value.
+	inspectorIssueCodeSharedDictionaryIssue
+	// inspectorIssueCodeElementAccessibilityIssue represents the "ElementAccessibilityIssue" value.
+	inspectorIssueCodeElementAccessibilityIssue
+	// inspectorIssueCodeSRIMessageSignatureIssue represents the "SRIMessageSignatureIssue" value.
+	inspectorIssueCodeSRIMessageSignatureIssue
+	// inspectorIssueCodeUnencodedDigestIssue represents the "UnencodedDigestIssue" value.
+	inspectorIssueCodeUnencodedDigestIssue
+	// inspectorIssueCodeUserReidentificationIssue represents the "UserReidentificationIssue" value.
+	inspectorIssueCodeUserReidentificationIssue
+)
+
+var _inspectorIssueCodeEnums = enums.New(map[InspectorIssueCodeEnum]string{
+	InspectorIssueCodeEnum(0):                           "",
+	inspectorIssueCodeCookieIssue:                       "CookieIssue",
+	inspectorIssueCodeMixedContentIssue:                 "MixedContentIssue",
+	inspectorIssueCodeBlockedByResponseIssue:            "BlockedByResponseIssue",
+	inspectorIssueCodeHeavyAdIssue:                      "HeavyAdIssue",
+	inspectorIssueCodeContentSecurityPolicyIssue:        "ContentSecurityPolicyIssue",
+	inspectorIssueCodeSharedArrayBufferIssue:            "SharedArrayBufferIssue",
+	inspectorIssueCodeLowTextContrastIssue:              "LowTextContrastIssue",
+	inspectorIssueCodeCorsIssue:                         "CorsIssue",
+	inspectorIssueCodeAttributionReportingIssue:         "AttributionReportingIssue",
+	inspectorIssueCodeQuirksModeIssue:                   "QuirksModeIssue",
+	inspectorIssueCodePartitioningBlobURLIssue:          "PartitioningBlobURLIssue",
+	inspectorIssueCodeNavigatorUserAgentIssue:           "NavigatorUserAgentIssue",
+	inspectorIssueCodeGenericIssue:                      "GenericIssue",
+	inspectorIssueCodeDeprecationIssue:                  "DeprecationIssue",
+	inspectorIssueCodeClientHintIssue:                   "ClientHintIssue",
+	inspectorIssueCodeFederatedAuthRequestIssue:         "FederatedAuthRequestIssue",
+	inspectorIssueCodeBounceTrackingIssue:               "BounceTrackingIssue",
+	inspectorIssueCodeCookieDeprecationMetadataIssue:    "CookieDeprecationMetadataIssue",
+	inspectorIssueCodeStylesheetLoadingIssue:            "StylesheetLoadingIssue",
+	inspectorIssueCodeFederatedAuthUserInfoRequestIssue: "FederatedAuthUserInfoRequestIssue",
+	inspectorIssueCodePropertyRuleIssue:                 "PropertyRuleIssue",
+	inspectorIssueCodeSharedDictionaryIssue:             "SharedDictionaryIssue",
+	inspectorIssueCodeElementAccessibilityIssue:         "ElementAccessibilityIssue",
+	inspectorIssueCodeSRIMessageSignatureIssue:          "SRIMessageSignatureIssue",
+	inspectorIssueCodeUnencodedDigestIssue:              "UnencodedDigestIssue",
+	inspectorIssueCodeUserReidentificationIssue:         "UserReidentificationIssue",
+})
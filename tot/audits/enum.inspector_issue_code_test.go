@@ -0,0 +1,381 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInspectorIssueCode(t *testing.T) {
+	var enum InspectorIssueCodeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InspectorIssueCodeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InspectorIssueCode.CookieIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CookieIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CookieIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"CookieIssue"`), &enum)
+	if InspectorIssueCode.CookieIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.CookieIssue, enum)
+	}
+
+	enum = InspectorIssueCode.MixedContentIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MixedContentIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MixedContentIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"MixedContentIssue"`), &enum)
+	if InspectorIssueCode.MixedContentIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.MixedContentIssue, enum)
+	}
+
+	enum = InspectorIssueCode.BlockedByResponseIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByResponseIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByResponseIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByResponseIssue"`), &enum)
+	if InspectorIssueCode.BlockedByResponseIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.BlockedByResponseIssue, enum)
+	}
+
+	enum = InspectorIssueCode.HeavyAdIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"HeavyAdIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"HeavyAdIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"HeavyAdIssue"`), &enum)
+	if InspectorIssueCode.HeavyAdIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.HeavyAdIssue, enum)
+	}
+
+	enum = InspectorIssueCode.ContentSecurityPolicyIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContentSecurityPolicyIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContentSecurityPolicyIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"ContentSecurityPolicyIssue"`), &enum)
+	if InspectorIssueCode.ContentSecurityPolicyIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.ContentSecurityPolicyIssue, enum)
+	}
+
+	enum = InspectorIssueCode.SharedArrayBufferIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SharedArrayBufferIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SharedArrayBufferIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"SharedArrayBufferIssue"`), &enum)
+	if InspectorIssueCode.SharedArrayBufferIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.SharedArrayBufferIssue, enum)
+	}
+
+	enum = InspectorIssueCode.LowTextContrastIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LowTextContrastIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LowTextContrastIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"LowTextContrastIssue"`), &enum)
+	if InspectorIssueCode.LowTextContrastIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.LowTextContrastIssue, enum)
+	}
+
+	enum = InspectorIssueCode.CorsIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CorsIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CorsIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"CorsIssue"`), &enum)
+	if InspectorIssueCode.CorsIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.CorsIssue, enum)
+	}
+
+	enum = InspectorIssueCode.AttributionReportingIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AttributionReportingIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AttributionReportingIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"AttributionReportingIssue"`), &enum)
+	if InspectorIssueCode.AttributionReportingIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.AttributionReportingIssue, enum)
+	}
+
+	enum = InspectorIssueCode.QuirksModeIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"QuirksModeIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"QuirksModeIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"QuirksModeIssue"`), &enum)
+	if InspectorIssueCode.QuirksModeIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.QuirksModeIssue, enum)
+	}
+
+	enum = InspectorIssueCode.PartitioningBlobURLIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PartitioningBlobURLIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PartitioningBlobURLIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"PartitioningBlobURLIssue"`), &enum)
+	if InspectorIssueCode.PartitioningBlobURLIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.PartitioningBlobURLIssue, enum)
+	}
+
+	enum = InspectorIssueCode.NavigatorUserAgentIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigatorUserAgentIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigatorUserAgentIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigatorUserAgentIssue"`), &enum)
+	if InspectorIssueCode.NavigatorUserAgentIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.NavigatorUserAgentIssue, enum)
+	}
+
+	enum = InspectorIssueCode.GenericIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"GenericIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"GenericIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"GenericIssue"`), &enum)
+	if InspectorIssueCode.GenericIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.GenericIssue, enum)
+	}
+
+	enum = InspectorIssueCode.DeprecationIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DeprecationIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DeprecationIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"DeprecationIssue"`), &enum)
+	if InspectorIssueCode.DeprecationIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.DeprecationIssue, enum)
+	}
+
+	enum = InspectorIssueCode.ClientHintIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientHintIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientHintIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientHintIssue"`), &enum)
+	if InspectorIssueCode.ClientHintIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.ClientHintIssue, enum)
+	}
+
+	enum = InspectorIssueCode.FederatedAuthRequestIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FederatedAuthRequestIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FederatedAuthRequestIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"FederatedAuthRequestIssue"`), &enum)
+	if InspectorIssueCode.FederatedAuthRequestIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.FederatedAuthRequestIssue, enum)
+	}
+
+	enum = InspectorIssueCode.BounceTrackingIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BounceTrackingIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BounceTrackingIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"BounceTrackingIssue"`), &enum)
+	if InspectorIssueCode.BounceTrackingIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.BounceTrackingIssue, enum)
+	}
+
+	enum = InspectorIssueCode.CookieDeprecationMetadataIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CookieDeprecationMetadataIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CookieDeprecationMetadataIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"CookieDeprecationMetadataIssue"`), &enum)
+	if InspectorIssueCode.CookieDeprecationMetadataIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.CookieDeprecationMetadataIssue, enum)
+	}
+
+	enum = InspectorIssueCode.StylesheetLoadingIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"StylesheetLoadingIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"StylesheetLoadingIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"StylesheetLoadingIssue"`), &enum)
+	if InspectorIssueCode.StylesheetLoadingIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.StylesheetLoadingIssue, enum)
+	}
+
+	enum = InspectorIssueCode.FederatedAuthUserInfoRequestIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FederatedAuthUserInfoRequestIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FederatedAuthUserInfoRequestIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"FederatedAuthUserInfoRequestIssue"`), &enum)
+	if InspectorIssueCode.FederatedAuthUserInfoRequestIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.FederatedAuthUserInfoRequestIssue, enum)
+	}
+
+	enum = InspectorIssueCode.PropertyRuleIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PropertyRuleIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PropertyRuleIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"PropertyRuleIssue"`), &enum)
+	if InspectorIssueCode.PropertyRuleIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.PropertyRuleIssue, enum)
+	}
+
+	enum = InspectorIssueCode.SharedDictionaryIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SharedDictionaryIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SharedDictionaryIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"SharedDictionaryIssue"`), &enum)
+	if InspectorIssueCode.SharedDictionaryIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.SharedDictionaryIssue, enum)
+	}
+
+	enum = InspectorIssueCode.ElementAccessibilityIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ElementAccessibilityIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ElementAccessibilityIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"ElementAccessibilityIssue"`), &enum)
+	if InspectorIssueCode.ElementAccessibilityIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.ElementAccessibilityIssue, enum)
+	}
+
+	enum = InspectorIssueCode.SRIMessageSignatureIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SRIMessageSignatureIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SRIMessageSignatureIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"SRIMessageSignatureIssue"`), &enum)
+	if InspectorIssueCode.SRIMessageSignatureIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.SRIMessageSignatureIssue, enum)
+	}
+
+	enum = InspectorIssueCode.UnencodedDigestIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnencodedDigestIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnencodedDigestIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"UnencodedDigestIssue"`), &enum)
+	if InspectorIssueCode.UnencodedDigestIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.UnencodedDigestIssue, enum)
+	}
+
+	enum = InspectorIssueCode.UserReidentificationIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UserReidentificationIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UserReidentificationIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"UserReidentificationIssue"`), &enum)
+	if InspectorIssueCode.UserReidentificationIssue != enum {
+		t.Errorf("Expected %d, got %d", InspectorIssueCode.UserReidentificationIssue, enum)
+	}
+}
@@ -0,0 +1,89 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type mixedContentResolutionStatusEnum struct {
+	MixedContentBlocked               MixedContentResolutionStatusEnum
+	MixedContentAutomaticallyUpgraded MixedContentResolutionStatusEnum
+	MixedContentWarning               MixedContentResolutionStatusEnum
+}
+
+/*
+MixedContentResolutionStatus provides named access to the MixedContentResolutionStatusEnum values.
+*/
+var MixedContentResolutionStatus = mixedContentResolutionStatusEnum{
+	MixedContentBlocked:               mixedContentResolutionStatusMixedContentBlocked,
+	MixedContentAutomaticallyUpgraded: mixedContentResolutionStatusMixedContentAutomaticallyUpgraded,
+	MixedContentWarning:               mixedContentResolutionStatusMixedContentWarning,
+}
+
+/*
+MixedContentResolutionStatusEnum represents the
+Audits.MixedContentResolutionStatus values.
+
+Allowed values:
+  - MixedContentResolutionStatus.MixedContentBlocked               "MixedContentBlocked"
+  - MixedContentResolutionStatus.MixedContentAutomaticallyUpgraded "MixedContentAutomaticallyUpgraded"
+  - MixedContentResolutionStatus.MixedContentWarning               "MixedContentWarning"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-MixedContentResolutionStatus
+*/
+type MixedContentResolutionStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum MixedContentResolutionStatusEnum) String() string {
+	return _mixedContentResolutionStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MixedContentResolutionStatusEnum) Unknown() bool {
+	return !_mixedContentResolutionStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum MixedContentResolutionStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *MixedContentResolutionStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _mixedContentResolutionStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// mixedContentResolutionStatusMixedContentBlocked represents the "MixedContentBlocked" value.
+	mixedContentResolutionStatusMixedContentBlocked MixedContentResolutionStatusEnum = iota + 1
+	// mixedContentResolutionStatusMixedContentAutomaticallyUpgraded represents the "MixedContentAutomaticallyUpgraded" value.
+	mixedContentResolutionStatusMixedContentAutomaticallyUpgraded
+	// mixedContentResolutionStatusMixedContentWarning represents the "MixedContentWarning" value.
+	mixedContentResolutionStatusMixedContentWarning
+)
+
+var _mixedContentResolutionStatusEnums = enums.New(map[MixedContentResolutionStatusEnum]string{
+	MixedContentResolutionStatusEnum(0):                           "",
+	mixedContentResolutionStatusMixedContentBlocked:               "MixedContentBlocked",
+	mixedContentResolutionStatusMixedContentAutomaticallyUpgraded: "MixedContentAutomaticallyUpgraded",
+	mixedContentResolutionStatusMixedContentWarning:               "MixedContentWarning",
+})
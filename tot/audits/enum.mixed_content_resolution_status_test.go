@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMixedContentResolutionStatus(t *testing.T) {
+	var enum MixedContentResolutionStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MixedContentResolutionStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = MixedContentResolutionStatus.MixedContentBlocked
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MixedContentBlocked"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MixedContentBlocked"`, result)
+	}
+	json.Unmarshal([]byte(`"MixedContentBlocked"`), &enum)
+	if MixedContentResolutionStatus.MixedContentBlocked != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResolutionStatus.MixedContentBlocked, enum)
+	}
+
+	enum = MixedContentResolutionStatus.MixedContentAutomaticallyUpgraded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MixedContentAutomaticallyUpgraded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MixedContentAutomaticallyUpgraded"`, result)
+	}
+	json.Unmarshal([]byte(`"MixedContentAutomaticallyUpgraded"`), &enum)
+	if MixedContentResolutionStatus.MixedContentAutomaticallyUpgraded != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResolutionStatus.MixedContentAutomaticallyUpgraded, enum)
+	}
+
+	enum = MixedContentResolutionStatus.MixedContentWarning
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MixedContentWarning"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MixedContentWarning"`, result)
+	}
+	json.Unmarshal([]byte(`"MixedContentWarning"`), &enum)
+	if MixedContentResolutionStatus.MixedContentWarning != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResolutionStatus.MixedContentWarning, enum)
+	}
+}
@@ -0,0 +1,245 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type mixedContentResourceTypeEnum struct {
+	AttributionSrc   MixedContentResourceTypeEnum
+	Audio            MixedContentResourceTypeEnum
+	Beacon           MixedContentResourceTypeEnum
+	CSPReport        MixedContentResourceTypeEnum
+	Download         MixedContentResourceTypeEnum
+	EventSource      MixedContentResourceTypeEnum
+	Favicon          MixedContentResourceTypeEnum
+	Font             MixedContentResourceTypeEnum
+	Form             MixedContentResourceTypeEnum
+	Frame            MixedContentResourceTypeEnum
+	Image            MixedContentResourceTypeEnum
+	Import           MixedContentResourceTypeEnum
+	JSON             MixedContentResourceTypeEnum
+	Manifest         MixedContentResourceTypeEnum
+	Ping             MixedContentResourceTypeEnum
+	PluginData       MixedContentResourceTypeEnum
+	PluginResource   MixedContentResourceTypeEnum
+	Prefetch         MixedContentResourceTypeEnum
+	Resource         MixedContentResourceTypeEnum
+	Script           MixedContentResourceTypeEnum
+	ServiceWorker    MixedContentResourceTypeEnum
+	SharedWorker     MixedContentResourceTypeEnum
+	SpeculationRules MixedContentResourceTypeEnum
+	Stylesheet       MixedContentResourceTypeEnum
+	Track            MixedContentResourceTypeEnum
+	Video            MixedContentResourceTypeEnum
+	Worker           MixedContentResourceTypeEnum
+	XMLHTTPRequest   MixedContentResourceTypeEnum
+	XSLT             MixedContentResourceTypeEnum
+}
+
+/*
+MixedContentResourceType provides named access to the MixedContentResourceTypeEnum values.
+*/
+var MixedContentResourceType = mixedContentResourceTypeEnum{
+	AttributionSrc:   mixedContentResourceTypeAttributionSrc,
+	Audio:            mixedContentResourceTypeAudio,
+	Beacon:           mixedContentResourceTypeBeacon,
+	CSPReport:        mixedContentResourceTypeCSPReport,
+	Download:         mixedContentResourceTypeDownload,
+	EventSource:      mixedContentResourceTypeEventSource,
+	Favicon:          mixedContentResourceTypeFavicon,
+	Font:             mixedContentResourceTypeFont,
+	Form:             mixedContentResourceTypeForm,
+	Frame:            mixedContentResourceTypeFrame,
+	Image:            mixedContentResourceTypeImage,
+	Import:           mixedContentResourceTypeImport,
+	JSON:             mixedContentResourceTypeJSON,
+	Manifest:         mixedContentResourceTypeManifest,
+	Ping:             mixedContentResourceTypePing,
+	PluginData:       mixedContentResourceTypePluginData,
+	PluginResource:   mixedContentResourceTypePluginResource,
+	Prefetch:         mixedContentResourceTypePrefetch,
+	Resource:         mixedContentResourceTypeResource,
+	Script:           mixedContentResourceTypeScript,
+	ServiceWorker:    mixedContentResourceTypeServiceWorker,
+	SharedWorker:     mixedContentResourceTypeSharedWorker,
+	SpeculationRules: mixedContentResourceTypeSpeculationRules,
+	Stylesheet:       mixedContentResourceTypeStylesheet,
+	Track:            mixedContentResourceTypeTrack,
+	Video:            mixedContentResourceTypeVideo,
+	Worker:           mixedContentResourceTypeWorker,
+	XMLHTTPRequest:   mixedContentResourceTypeXMLHTTPRequest,
+	XSLT:             mixedContentResourceTypeXSLT,
+}
+
+/*
+MixedContentResourceTypeEnum represents the Audits.MixedContentResourceType
+values.
+
+Allowed values:
+  - MixedContentResourceType.AttributionSrc   "AttributionSrc"
+  - MixedContentResourceType.Audio            "Audio"
+  - MixedContentResourceType.Beacon           "Beacon"
+  - MixedContentResourceType.CSPReport        "CSPReport"
+  - MixedContentResourceType.Download         "Download"
+  - MixedContentResourceType.EventSource      "EventSource"
+  - MixedContentResourceType.Favicon          "Favicon"
+  - MixedContentResourceType.Font             "Font"
+  - MixedContentResourceType.Form             "Form"
+  - MixedContentResourceType.Frame            "Frame"
+  - MixedContentResourceType.Image            "Image"
+  - MixedContentResourceType.Import           "Import"
+  - MixedContentResourceType.JSON             "JSON"
+  - MixedContentResourceType.Manifest         "Manifest"
+  - MixedContentResourceType.Ping             "Ping"
+  - MixedContentResourceType.PluginData       "PluginData"
+  - MixedContentResourceType.PluginResource   "PluginResource"
+  - MixedContentResourceType.Prefetch         "Prefetch"
+  - MixedContentResourceType.Resource         "Resource"
+  - MixedContentResourceType.Script           "Script"
+  - MixedContentResourceType.ServiceWorker    "ServiceWorker"
+  - MixedContentResourceType.SharedWorker     "SharedWorker"
+  - MixedContentResourceType.SpeculationRules "SpeculationRules"
+  - MixedContentResourceType.Stylesheet       "Stylesheet"
+  - MixedContentResourceType.Track            "Track"
+  - MixedContentResourceType.Video            "Video"
+  - MixedContentResourceType.Worker           "Worker"
+  - MixedContentResourceType.XMLHTTPRequest   "XMLHttpRequest"
+  - MixedContentResourceType.XSLT             "XSLT"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-MixedContentResourceType
+*/
+type MixedContentResourceTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum MixedContentResourceTypeEnum) String() string {
+	return _mixedContentResourceTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MixedContentResourceTypeEnum) Unknown() bool {
+	return !_mixedContentResourceTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum MixedContentResourceTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *MixedContentResourceTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _mixedContentResourceTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// mixedContentResourceTypeAttributionSrc represents the "AttributionSrc" value.
+	mixedContentResourceTypeAttributionSrc MixedContentResourceTypeEnum = iota + 1
+	// mixedContentResourceTypeAudio represents the "Audio" value.
+	mixedContentResourceTypeAudio
+	// mixedContentResourceTypeBeacon represents the "Beacon" value.
+	mixedContentResourceTypeBeacon
+	// mixedContentResourceTypeCSPReport represents the "CSPReport" value.
+	mixedContentResourceTypeCSPReport
+	// mixedContentResourceTypeDownload represents the "Download" value.
+	mixedContentResourceTypeDownload
+	// mixedContentResourceTypeEventSource represents the "EventSource" value.
+	mixedContentResourceTypeEventSource
+	// mixedContentResourceTypeFavicon represents the "Favicon" value.
+	mixedContentResourceTypeFavicon
+	// mixedContentResourceTypeFont represents the "Font" value.
+	mixedContentResourceTypeFont
+	// mixedContentResourceTypeForm represents the "Form" value.
+	mixedContentResourceTypeForm
+	// mixedContentResourceTypeFrame represents the "Frame" value.
+	mixedContentResourceTypeFrame
+	// mixedContentResourceTypeImage represents the "Image" value.
+	mixedContentResourceTypeImage
+	// mixedContentResourceTypeImport represents the "Import" value.
+	mixedContentResourceTypeImport
+	// mixedContentResourceTypeJSON represents the "JSON" value.
+	mixedContentResourceTypeJSON
+	// mixedContentResourceTypeManifest represents the "Manifest" value.
+	mixedContentResourceTypeManifest
+	// mixedContentResourceTypePing represents the "Ping" value.
+	mixedContentResourceTypePing
+	// mixedContentResourceTypePluginData represents the "PluginData" value.
+	mixedContentResourceTypePluginData
+	// mixedContentResourceTypePluginResource represents the "PluginResource" value.
+	mixedContentResourceTypePluginResource
+	// mixedContentResourceTypePrefetch represents the "Prefetch" value.
+	mixedContentResourceTypePrefetch
+	// mixedContentResourceTypeResource represents the "Resource" value.
+	mixedContentResourceTypeResource
+	// mixedContentResourceTypeScript represents the "Script" value.
+	mixedContentResourceTypeScript
+	// mixedContentResourceTypeServiceWorker represents the "ServiceWorker" value.
+	mixedContentResourceTypeServiceWorker
+	// mixedContentResourceTypeSharedWorker represents the "SharedWorker" value.
+	mixedContentResourceTypeSharedWorker
+	// mixedContentResourceTypeSpeculationRules represents the "SpeculationRules" value.
+	mixedContentResourceTypeSpeculationRules
+	// mixedContentResourceTypeStylesheet represents the "Stylesheet" value.
+	mixedContentResourceTypeStylesheet
+	// mixedContentResourceTypeTrack represents the "Track" value.
+	mixedContentResourceTypeTrack
+	// mixedContentResourceTypeVideo represents the "Video" value.
+	mixedContentResourceTypeVideo
+	// mixedContentResourceTypeWorker represents the "Worker" value.
+	mixedContentResourceTypeWorker
+	// mixedContentResourceTypeXMLHTTPRequest represents the "XMLHttpRequest" value.
+	mixedContentResourceTypeXMLHTTPRequest
+	// mixedContentResourceTypeXSLT represents the "XSLT" value.
+	mixedContentResourceTypeXSLT
+)
+
+var _mixedContentResourceTypeEnums = enums.New(map[MixedContentResourceTypeEnum]string{
+	MixedContentResourceTypeEnum(0):          "",
+	mixedContentResourceTypeAttributionSrc:   "AttributionSrc",
+	mixedContentResourceTypeAudio:            "Audio",
+	mixedContentResourceTypeBeacon:           "Beacon",
+	mixedContentResourceTypeCSPReport:        "CSPReport",
+	mixedContentResourceTypeDownload:         "Download",
+	mixedContentResourceTypeEventSource:      "EventSource",
+	mixedContentResourceTypeFavicon:          "Favicon",
+	mixedContentResourceTypeFont:             "Font",
+	mixedContentResourceTypeForm:             "Form",
+	mixedContentResourceTypeFrame:            "Frame",
+	mixedContentResourceTypeImage:            "Image",
+	mixedContentResourceTypeImport:           "Import",
+	mixedContentResourceTypeJSON:             "JSON",
+	mixedContentResourceTypeManifest:         "Manifest",
+	mixedContentResourceTypePing:             "Ping",
+	mixedContentResourceTypePluginData:       "PluginData",
+	mixedContentResourceTypePluginResource:   "PluginResource",
+	mixedContentResourceTypePrefetch:         "Prefetch",
+	mixedContentResourceTypeResource:         "Resource",
+	mixedContentResourceTypeScript:           "Script",
+	mixedContentResourceTypeServiceWorker:    "ServiceWorker",
+	mixedContentResourceTypeSharedWorker:     "SharedWorker",
+	mixedContentResourceTypeSpeculationRules: "SpeculationRules",
+	mixedContentResourceTypeStylesheet:       "Stylesheet",
+	mixedContentResourceTypeTrack:            "Track",
+	mixedContentResourceTypeVideo:            "Video",
+	mixedContentResourceTypeWorker:           "Worker",
+	mixedContentResourceTypeXMLHTTPRequest:   "XMLHttpRequest",
+	mixedContentResourceTypeXSLT:             "XSLT",
+})
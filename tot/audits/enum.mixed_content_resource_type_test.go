@@ -0,0 +1,420 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMixedContentResourceType(t *testing.T) {
+	var enum MixedContentResourceTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MixedContentResourceTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = MixedContentResourceType.AttributionSrc
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AttributionSrc"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AttributionSrc"`, result)
+	}
+	json.Unmarshal([]byte(`"AttributionSrc"`), &enum)
+	if MixedContentResourceType.AttributionSrc != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.AttributionSrc, enum)
+	}
+
+	enum = MixedContentResourceType.Audio
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Audio"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Audio"`, result)
+	}
+	json.Unmarshal([]byte(`"Audio"`), &enum)
+	if MixedContentResourceType.Audio != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Audio, enum)
+	}
+
+	enum = MixedContentResourceType.Beacon
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Beacon"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Beacon"`, result)
+	}
+	json.Unmarshal([]byte(`"Beacon"`), &enum)
+	if MixedContentResourceType.Beacon != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Beacon, enum)
+	}
+
+	enum = MixedContentResourceType.CSPReport
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CSPReport"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CSPReport"`, result)
+	}
+	json.Unmarshal([]byte(`"CSPReport"`), &enum)
+	if MixedContentResourceType.CSPReport != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.CSPReport, enum)
+	}
+
+	enum = MixedContentResourceType.Download
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Download"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Download"`, result)
+	}
+	json.Unmarshal([]byte(`"Download"`), &enum)
+	if MixedContentResourceType.Download != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Download, enum)
+	}
+
+	enum = MixedContentResourceType.EventSource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EventSource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EventSource"`, result)
+	}
+	json.Unmarshal([]byte(`"EventSource"`), &enum)
+	if MixedContentResourceType.EventSource != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.EventSource, enum)
+	}
+
+	enum = MixedContentResourceType.Favicon
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Favicon"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Favicon"`, result)
+	}
+	json.Unmarshal([]byte(`"Favicon"`), &enum)
+	if MixedContentResourceType.Favicon != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Favicon, enum)
+	}
+
+	enum = MixedContentResourceType.Font
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Font"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Font"`, result)
+	}
+	json.Unmarshal([]byte(`"Font"`), &enum)
+	if MixedContentResourceType.Font != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Font, enum)
+	}
+
+	enum = MixedContentResourceType.Form
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Form"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Form"`, result)
+	}
+	json.Unmarshal([]byte(`"Form"`), &enum)
+	if MixedContentResourceType.Form != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Form, enum)
+	}
+
+	enum = MixedContentResourceType.Frame
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Frame"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Frame"`, result)
+	}
+	json.Unmarshal([]byte(`"Frame"`), &enum)
+	if MixedContentResourceType.Frame != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Frame, enum)
+	}
+
+	enum = MixedContentResourceType.Image
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Image"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Image"`, result)
+	}
+	json.Unmarshal([]byte(`"Image"`), &enum)
+	if MixedContentResourceType.Image != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Image, enum)
+	}
+
+	enum = MixedContentResourceType.Import
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Import"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Import"`, result)
+	}
+	json.Unmarshal([]byte(`"Import"`), &enum)
+	if MixedContentResourceType.Import != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Import, enum)
+	}
+
+	enum = MixedContentResourceType.JSON
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JSON"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JSON"`, result)
+	}
+	json.Unmarshal([]byte(`"JSON"`), &enum)
+	if MixedContentResourceType.JSON != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.JSON, enum)
+	}
+
+	enum = MixedContentResourceType.Manifest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Manifest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Manifest"`, result)
+	}
+	json.Unmarshal([]byte(`"Manifest"`), &enum)
+	if MixedContentResourceType.Manifest != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Manifest, enum)
+	}
+
+	enum = MixedContentResourceType.Ping
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ping"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ping"`, result)
+	}
+	json.Unmarshal([]byte(`"Ping"`), &enum)
+	if MixedContentResourceType.Ping != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Ping, enum)
+	}
+
+	enum = MixedContentResourceType.PluginData
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PluginData"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PluginData"`, result)
+	}
+	json.Unmarshal([]byte(`"PluginData"`), &enum)
+	if MixedContentResourceType.PluginData != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.PluginData, enum)
+	}
+
+	enum = MixedContentResourceType.PluginResource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PluginResource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PluginResource"`, result)
+	}
+	json.Unmarshal([]byte(`"PluginResource"`), &enum)
+	if MixedContentResourceType.PluginResource != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.PluginResource, enum)
+	}
+
+	enum = MixedContentResourceType.Prefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Prefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Prefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"Prefetch"`), &enum)
+	if MixedContentResourceType.Prefetch != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Prefetch, enum)
+	}
+
+	enum = MixedContentResourceType.Resource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Resource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Resource"`, result)
+	}
+	json.Unmarshal([]byte(`"Resource"`), &enum)
+	if MixedContentResourceType.Resource != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Resource, enum)
+	}
+
+	enum = MixedContentResourceType.Script
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Script"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Script"`, result)
+	}
+	json.Unmarshal([]byte(`"Script"`), &enum)
+	if MixedContentResourceType.Script != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Script, enum)
+	}
+
+	enum = MixedContentResourceType.ServiceWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ServiceWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ServiceWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"ServiceWorker"`), &enum)
+	if MixedContentResourceType.ServiceWorker != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.ServiceWorker, enum)
+	}
+
+	enum = MixedContentResourceType.SharedWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SharedWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SharedWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"SharedWorker"`), &enum)
+	if MixedContentResourceType.SharedWorker != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.SharedWorker, enum)
+	}
+
+	enum = MixedContentResourceType.SpeculationRules
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SpeculationRules"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SpeculationRules"`, result)
+	}
+	json.Unmarshal([]byte(`"SpeculationRules"`), &enum)
+	if MixedContentResourceType.SpeculationRules != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.SpeculationRules, enum)
+	}
+
+	enum = MixedContentResourceType.Stylesheet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Stylesheet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Stylesheet"`, result)
+	}
+	json.Unmarshal([]byte(`"Stylesheet"`), &enum)
+	if MixedContentResourceType.Stylesheet != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Stylesheet, enum)
+	}
+
+	enum = MixedContentResourceType.Track
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Track"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Track"`, result)
+	}
+	json.Unmarshal([]byte(`"Track"`), &enum)
+	if MixedContentResourceType.Track != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Track, enum)
+	}
+
+	enum = MixedContentResourceType.Video
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Video"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Video"`, result)
+	}
+	json.Unmarshal([]byte(`"Video"`), &enum)
+	if MixedContentResourceType.Video != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Video, enum)
+	}
+
+	enum = MixedContentResourceType.Worker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Worker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Worker"`, result)
+	}
+	json.Unmarshal([]byte(`"Worker"`), &enum)
+	if MixedContentResourceType.Worker != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.Worker, enum)
+	}
+
+	enum = MixedContentResourceType.XMLHTTPRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"XMLHttpRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"XMLHttpRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"XMLHttpRequest"`), &enum)
+	if MixedContentResourceType.XMLHTTPRequest != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.XMLHTTPRequest, enum)
+	}
+
+	enum = MixedContentResourceType.XSLT
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"XSLT"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"XSLT"`, result)
+	}
+	json.Unmarshal([]byte(`"XSLT"`), &enum)
+	if MixedContentResourceType.XSLT != enum {
+		t.Errorf("Expected %d, got %d", MixedContentResourceType.XSLT, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type partitioningBlobURLInfoEnum struct {
+	BlockedCrossPartitionFetching PartitioningBlobURLInfoEnum
+	EnforceNoopenerForNavigation  PartitioningBlobURLInfoEnum
+}
+
+/*
+PartitioningBlobURLInfo provides named access to the PartitioningBlobURLInfoEnum values.
+*/
+var PartitioningBlobURLInfo = partitioningBlobURLInfoEnum{
+	BlockedCrossPartitionFetching: partitioningBlobURLInfoBlockedCrossPartitionFetching,
+	EnforceNoopenerForNavigation:  partitioningBlobURLInfoEnforceNoopenerForNavigation,
+}
+
+/*
+PartitioningBlobURLInfoEnum represents the Audits.PartitioningBlobURLInfo
+values.
+
+Allowed values:
+  - PartitioningBlobURLInfo.BlockedCrossPartitionFetching "BlockedCrossPartitionFetching"
+  - PartitioningBlobURLInfo.EnforceNoopenerForNavigation  "EnforceNoopenerForNavigation"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-PartitioningBlobURLInfo
+*/
+type PartitioningBlobURLInfoEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PartitioningBlobURLInfoEnum) String() string {
+	return _partitioningBlobURLInfoEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PartitioningBlobURLInfoEnum) Unknown() bool {
+	return !_partitioningBlobURLInfoEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PartitioningBlobURLInfoEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PartitioningBlobURLInfoEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _partitioningBlobURLInfoEnums.Parse(val)
+	return nil
+}
+
+const (
+	// partitioningBlobURLInfoBlockedCrossPartitionFetching represents the "BlockedCrossPartitionFetching" value.
+	partitioningBlobURLInfoBlockedCrossPartitionFetching PartitioningBlobURLInfoEnum = iota + 1
+	// partitioningBlobURLInfoEnforceNoopenerForNavigation represents the "EnforceNoopenerForNavigation" value.
+	partitioningBlobURLInfoEnforceNoopenerForNavigation
+)
+
+var _partitioningBlobURLInfoEnums = enums.New(map[PartitioningBlobURLInfoEnum]string{
+	PartitioningBlobURLInfoEnum(0):                       "",
+	partitioningBlobURLInfoBlockedCrossPartitionFetching: "BlockedCrossPartitionFetching",
+	partitioningBlobURLInfoEnforceNoopenerForNavigation:  "EnforceNoopenerForNavigation",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPartitioningBlobURLInfo(t *testing.T) {
+	var enum PartitioningBlobURLInfoEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PartitioningBlobURLInfoEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PartitioningBlobURLInfo.BlockedCrossPartitionFetching
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedCrossPartitionFetching"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedCrossPartitionFetching"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedCrossPartitionFetching"`), &enum)
+	if PartitioningBlobURLInfo.BlockedCrossPartitionFetching != enum {
+		t.Errorf("Expected %d, got %d", PartitioningBlobURLInfo.BlockedCrossPartitionFetching, enum)
+	}
+
+	enum = PartitioningBlobURLInfo.EnforceNoopenerForNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EnforceNoopenerForNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EnforceNoopenerForNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"EnforceNoopenerForNavigation"`), &enum)
+	if PartitioningBlobURLInfo.EnforceNoopenerForNavigation != enum {
+		t.Errorf("Expected %d, got %d", PartitioningBlobURLInfo.EnforceNoopenerForNavigation, enum)
+	}
+}
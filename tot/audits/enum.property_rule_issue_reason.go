@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type propertyRuleIssueReasonEnum struct {
+	InvalidSyntax       PropertyRuleIssueReasonEnum
+	InvalidInitialValue PropertyRuleIssueReasonEnum
+	InvalidInherits     PropertyRuleIssueReasonEnum
+	InvalidName         PropertyRuleIssueReasonEnum
+}
+
+/*
+PropertyRuleIssueReason provides named access to the PropertyRuleIssueReasonEnum values.
+*/
+var PropertyRuleIssueReason = propertyRuleIssueReasonEnum{
+	InvalidSyntax:       propertyRuleIssueReasonInvalidSyntax,
+	InvalidInitialValue: propertyRuleIssueReasonInvalidInitialValue,
+	InvalidInherits:     propertyRuleIssueReasonInvalidInherits,
+	InvalidName:         propertyRuleIssueReasonInvalidName,
+}
+
+/*
+PropertyRuleIssueReasonEnum represents the Audits.PropertyRuleIssueReason
+values.
+
+Allowed values:
+  - PropertyRuleIssueReason.InvalidSyntax       "InvalidSyntax"
+  - PropertyRuleIssueReason.InvalidInitialValue "InvalidInitialValue"
+  - PropertyRuleIssueReason.InvalidInherits     "InvalidInherits"
+  - PropertyRuleIssueReason.InvalidName         "InvalidName"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-PropertyRuleIssueReason
+*/
+type PropertyRuleIssueReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PropertyRuleIssueReasonEnum) String() string {
+	return _propertyRuleIssueReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PropertyRuleIssueReasonEnum) Unknown() bool {
+	return !_propertyRuleIssueReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PropertyRuleIssueReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PropertyRuleIssueReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _propertyRuleIssueReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// propertyRuleIssueReasonInvalidSyntax represents the "InvalidSyntax" value.
+	propertyRuleIssueReasonInvalidSyntax PropertyRuleIssueReasonEnum = iota + 1
+	// propertyRuleIssueReasonInvalidInitialValue represents the "InvalidInitialValue" value.
+	propertyRuleIssueReasonInvalidInitialValue
+	// propertyRuleIssueReasonInvalidInherits represents the "InvalidInherits" value.
+	propertyRuleIssueReasonInvalidInherits
+	// propertyRuleIssueReasonInvalidName represents the "InvalidName" value.
+	propertyRuleIssueReasonInvalidName
+)
+
+var _propertyRuleIssueReasonEnums = enums.New(map[PropertyRuleIssueReasonEnum]string{
+	PropertyRuleIssueReasonEnum(0):             "",
+	propertyRuleIssueReasonInvalidSyntax:       "InvalidSyntax",
+	propertyRuleIssueReasonInvalidInitialValue: "InvalidInitialValue",
+	propertyRuleIssueReasonInvalidInherits:     "InvalidInherits",
+	propertyRuleIssueReasonInvalidName:         "InvalidName",
+})
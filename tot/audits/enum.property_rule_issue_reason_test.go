@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPropertyRuleIssueReason(t *testing.T) {
+	var enum PropertyRuleIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PropertyRuleIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PropertyRuleIssueReason.InvalidSyntax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSyntax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSyntax"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSyntax"`), &enum)
+	if PropertyRuleIssueReason.InvalidSyntax != enum {
+		t.Errorf("Expected %d, got %d", PropertyRuleIssueReason.InvalidSyntax, enum)
+	}
+
+	enum = PropertyRuleIssueReason.InvalidInitialValue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidInitialValue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidInitialValue"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidInitialValue"`), &enum)
+	if PropertyRuleIssueReason.InvalidInitialValue != enum {
+		t.Errorf("Expected %d, got %d", PropertyRuleIssueReason.InvalidInitialValue, enum)
+	}
+
+	enum = PropertyRuleIssueReason.InvalidInherits
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidInherits"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidInherits"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidInherits"`), &enum)
+	if PropertyRuleIssueReason.InvalidInherits != enum {
+		t.Errorf("Expected %d, got %d", PropertyRuleIssueReason.InvalidInherits, enum)
+	}
+
+	enum = PropertyRuleIssueReason.InvalidName
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidName"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidName"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidName"`), &enum)
+	if PropertyRuleIssueReason.InvalidName != enum {
+		t.Errorf("Expected %d, got %d", PropertyRuleIssueReason.InvalidName, enum)
+	}
+}
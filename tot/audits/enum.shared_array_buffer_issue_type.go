@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sharedArrayBufferIssueTypeEnum struct {
+	TransferIssue SharedArrayBufferIssueTypeEnum
+	CreationIssue SharedArrayBufferIssueTypeEnum
+}
+
+/*
+SharedArrayBufferIssueType provides named access to the SharedArrayBufferIssueTypeEnum values.
+*/
+var SharedArrayBufferIssueType = sharedArrayBufferIssueTypeEnum{
+	TransferIssue: sharedArrayBufferIssueTypeTransferIssue,
+	CreationIssue: sharedArrayBufferIssueTypeCreationIssue,
+}
+
+/*
+SharedArrayBufferIssueTypeEnum represents the Audits.SharedArrayBufferIssueType
+values.
+
+Allowed values:
+  - SharedArrayBufferIssueType.TransferIssue "TransferIssue"
+  - SharedArrayBufferIssueType.CreationIssue "CreationIssue"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SharedArrayBufferIssueType
+*/
+type SharedArrayBufferIssueTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SharedArrayBufferIssueTypeEnum) String() string {
+	return _sharedArrayBufferIssueTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SharedArrayBufferIssueTypeEnum) Unknown() bool {
+	return !_sharedArrayBufferIssueTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SharedArrayBufferIssueTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SharedArrayBufferIssueTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sharedArrayBufferIssueTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sharedArrayBufferIssueTypeTransferIssue represents the "TransferIssue" value.
+	sharedArrayBufferIssueTypeTransferIssue SharedArrayBufferIssueTypeEnum = iota + 1
+	// sharedArrayBufferIssueTypeCreationIssue represents the "CreationIssue" value.
+	sharedArrayBufferIssueTypeCreationIssue
+)
+
+var _sharedArrayBufferIssueTypeEnums = enums.New(map[SharedArrayBufferIssueTypeEnum]string{
+	SharedArrayBufferIssueTypeEnum(0):       "",
+	sharedArrayBufferIssueTypeTransferIssue: "TransferIssue",
+	sharedArrayBufferIssueTypeCreationIssue: "CreationIssue",
+})
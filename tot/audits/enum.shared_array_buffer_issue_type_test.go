@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSharedArrayBufferIssueType(t *testing.T) {
+	var enum SharedArrayBufferIssueTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SharedArrayBufferIssueTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SharedArrayBufferIssueType.TransferIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TransferIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TransferIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"TransferIssue"`), &enum)
+	if SharedArrayBufferIssueType.TransferIssue != enum {
+		t.Errorf("Expected %d, got %d", SharedArrayBufferIssueType.TransferIssue, enum)
+	}
+
+	enum = SharedArrayBufferIssueType.CreationIssue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CreationIssue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CreationIssue"`, result)
+	}
+	json.Unmarshal([]byte(`"CreationIssue"`), &enum)
+	if SharedArrayBufferIssueType.CreationIssue != enum {
+		t.Errorf("Expected %d, got %d", SharedArrayBufferIssueType.CreationIssue, enum)
+	}
+}
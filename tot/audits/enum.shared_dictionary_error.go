@@ -0,0 +1,208 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sharedDictionaryErrorEnum struct {
+	UseErrorCrossOriginNoCorsRequest          SharedDictionaryErrorEnum
+	UseErrorDictionaryLoadFailure             SharedDictionaryErrorEnum
+	UseErrorMatchingDictionaryNotUsed         SharedDictionaryErrorEnum
+	UseErrorUnexpectedContentDictionaryHeader SharedDictionaryErrorEnum
+	WriteErrorCossOriginNoCorsRequest         SharedDictionaryErrorEnum
+	WriteErrorDisallowedBySettings            SharedDictionaryErrorEnum
+	WriteErrorExpiredResponse                 SharedDictionaryErrorEnum
+	WriteErrorFeatureDisabled                 SharedDictionaryErrorEnum
+	WriteErrorInsufficientResources           SharedDictionaryErrorEnum
+	WriteErrorInvalidMatchField               SharedDictionaryErrorEnum
+	WriteErrorInvalidStructuredHeader         SharedDictionaryErrorEnum
+	WriteErrorNavigationRequest               SharedDictionaryErrorEnum
+	WriteErrorNoMatchField                    SharedDictionaryErrorEnum
+	WriteErrorNonListMatchDestField           SharedDictionaryErrorEnum
+	WriteErrorNonSecureContext                SharedDictionaryErrorEnum
+	WriteErrorNonStringIDField                SharedDictionaryErrorEnum
+	WriteErrorNonStringInMatchDestList        SharedDictionaryErrorEnum
+	WriteErrorNonStringMatchField             SharedDictionaryErrorEnum
+	WriteErrorNonTokenTypeField               SharedDictionaryErrorEnum
+	WriteErrorRequestAborted                  SharedDictionaryErrorEnum
+	WriteErrorShuttingDown                    SharedDictionaryErrorEnum
+	WriteErrorTooLongIDField                  SharedDictionaryErrorEnum
+	WriteErrorUnsupportedType                 SharedDictionaryErrorEnum
+}
+
+/*
+SharedDictionaryError provides named access to the SharedDictionaryErrorEnum values.
+*/
+var SharedDictionaryError = sharedDictionaryErrorEnum{
+	UseErrorCrossOriginNoCorsRequest:          sharedDictionaryErrorUseErrorCrossOriginNoCorsRequest,
+	UseErrorDictionaryLoadFailure:             sharedDictionaryErrorUseErrorDictionaryLoadFailure,
+	UseErrorMatchingDictionaryNotUsed:         sharedDictionaryErrorUseErrorMatchingDictionaryNotUsed,
+	UseErrorUnexpectedContentDictionaryHeader: sharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader,
+	WriteErrorCossOriginNoCorsRequest:         sharedDictionaryErrorWriteErrorCossOriginNoCorsRequest,
+	WriteErrorDisallowedBySettings:            sharedDictionaryErrorWriteErrorDisallowedBySettings,
+	WriteErrorExpiredResponse:                 sharedDictionaryErrorWriteErrorExpiredResponse,
+	WriteErrorFeatureDisabled:                 sharedDictionaryErrorWriteErrorFeatureDisabled,
+	WriteErrorInsufficientResources:           sharedDictionaryErrorWriteErrorInsufficientResources,
+	WriteErrorInvalidMatchField:               sharedDictionaryErrorWriteErrorInvalidMatchField,
+	WriteErrorInvalidStructuredHeader:         sharedDictionaryErrorWriteErrorInvalidStructuredHeader,
+	WriteErrorNavigationRequest:               sharedDictionaryErrorWriteErrorNavigationRequest,
+	WriteErrorNoMatchField:                    sharedDictionaryErrorWriteErrorNoMatchField,
+	WriteErrorNonListMatchDestField:           sharedDictionaryErrorWriteErrorNonListMatchDestField,
+	WriteErrorNonSecureContext:                sharedDictionaryErrorWriteErrorNonSecureContext,
+	WriteErrorNonStringIDField:                sharedDictionaryErrorWriteErrorNonStringIDField,
+	WriteErrorNonStringInMatchDestList:        sharedDictionaryErrorWriteErrorNonStringInMatchDestList,
+	WriteErrorNonStringMatchField:             sharedDictionaryErrorWriteErrorNonStringMatchField,
+	WriteErrorNonTokenTypeField:               sharedDictionaryErrorWriteErrorNonTokenTypeField,
+	WriteErrorRequestAborted:                  sharedDictionaryErrorWriteErrorRequestAborted,
+	WriteErrorShuttingDown:                    sharedDictionaryErrorWriteErrorShuttingDown,
+	WriteErrorTooLongIDField:                  sharedDictionaryErrorWriteErrorTooLongIDField,
+	WriteErrorUnsupportedType:                 sharedDictionaryErrorWriteErrorUnsupportedType,
+}
+
+/*
+SharedDictionaryErrorEnum represents the Audits.SharedDictionaryError values.
+
+Allowed values:
+  - SharedDictionaryError.UseErrorCrossOriginNoCorsRequest          "UseErrorCrossOriginNoCorsRequest"
+  - SharedDictionaryError.UseErrorDictionaryLoadFailure             "UseErrorDictionaryLoadFailure"
+  - SharedDictionaryError.UseErrorMatchingDictionaryNotUsed         "UseErrorMatchingDictionaryNotUsed"
+  - SharedDictionaryError.UseErrorUnexpectedContentDictionaryHeader "UseErrorUnexpectedContentDictionaryHeader"
+  - SharedDictionaryError.WriteErrorCossOriginNoCorsRequest         "WriteErrorCossOriginNoCorsRequest"
+  - SharedDictionaryError.WriteErrorDisallowedBySettings            "WriteErrorDisallowedBySettings"
+  - SharedDictionaryError.WriteErrorExpiredResponse                 "WriteErrorExpiredResponse"
+  - SharedDictionaryError.WriteErrorFeatureDisabled                 "WriteErrorFeatureDisabled"
+  - SharedDictionaryError.WriteErrorInsufficientResources           "WriteErrorInsufficientResources"
+  - SharedDictionaryError.WriteErrorInvalidMatchField               "WriteErrorInvalidMatchField"
+  - SharedDictionaryError.WriteErrorInvalidStructuredHeader         "WriteErrorInvalidStructuredHeader"
+  - SharedDictionaryError.WriteErrorNavigationRequest               "WriteErrorNavigationRequest"
+  - SharedDictionaryError.WriteErrorNoMatchField                    "WriteErrorNoMatchField"
+  - SharedDictionaryError.WriteErrorNonListMatchDestField           "WriteErrorNonListMatchDestField"
+  - SharedDictionaryError.WriteErrorNonSecureContext                "WriteErrorNonSecureContext"
+  - SharedDictionaryError.WriteErrorNonStringIDField                "WriteErrorNonStringIdField"
+  - SharedDictionaryError.WriteErrorNonStringInMatchDestList        "WriteErrorNonStringInMatchDestList"
+  - SharedDictionaryError.WriteErrorNonStringMatchField             "WriteErrorNonStringMatchField"
+  - SharedDictionaryError.WriteErrorNonTokenTypeField               "WriteErrorNonTokenTypeField"
+  - SharedDictionaryError.WriteErrorRequestAborted                  "WriteErrorRequestAborted"
+  - SharedDictionaryError.WriteErrorShuttingDown                    "WriteErrorShuttingDown"
+  - SharedDictionaryError.WriteErrorTooLongIDField                  "WriteErrorTooLongIdField"
+  - SharedDictionaryError.WriteErrorUnsupportedType                 "WriteErrorUnsupportedType"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SharedDictionaryError
+*/
+type SharedDictionaryErrorEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SharedDictionaryErrorEnum) String() string {
+	return _sharedDictionaryErrorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SharedDictionaryErrorEnum) Unknown() bool {
+	return !_sharedDictionaryErrorEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SharedDictionaryErrorEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SharedDictionaryErrorEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sharedDictionaryErrorEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sharedDictionaryErrorUseErrorCrossOriginNoCorsRequest represents the "UseErrorCrossOriginNoCorsRequest" value.
+	sharedDictionaryErrorUseErrorCrossOriginNoCorsRequest SharedDictionaryErrorEnum = iota + 1
+	// sharedDictionaryErrorUseErrorDictionaryLoadFailure represents the "UseErrorDictionaryLoadFailure" value.
+	sharedDictionaryErrorUseErrorDictionaryLoadFailure
+	// sharedDictionaryErrorUseErrorMatchingDictionaryNotUsed represents the "UseErrorMatchingDictionaryNotUsed" value.
+	sharedDictionaryErrorUseErrorMatchingDictionaryNotUsed
+	// sharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader represents the "UseErrorUnexpectedContentDictionaryHeader" value.
+	sharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader
+	// sharedDictionaryErrorWriteErrorCossOriginNoCorsRequest represents the "WriteErrorCossOriginNoCorsRequest" value.
+	sharedDictionaryErrorWriteErrorCossOriginNoCorsRequest
+	// sharedDictionaryErrorWriteErrorDisallowedBySettings represents the "WriteErrorDisallowedBySettings" value.
+	sharedDictionaryErrorWriteErrorDisallowedBySettings
+	// sharedDictionaryErrorWriteErrorExpiredResponse represents the "WriteErrorExpiredResponse" value.
+	sharedDictionaryErrorWriteErrorExpiredResponse
+	// sharedDictionaryErrorWriteErrorFeatureDisabled represents the "WriteErrorFeatureDisabled" value.
+	sharedDictionaryErrorWriteErrorFeatureDisabled
+	// sharedDictionaryErrorWriteErrorInsufficientResources represents the "WriteErrorInsufficientResources" value.
+	sharedDictionaryErrorWriteErrorInsufficientResources
+	// sharedDictionaryErrorWriteErrorInvalidMatchField represents the "WriteErrorInvalidMatchField" value.
+	sharedDictionaryErrorWriteErrorInvalidMatchField
+	// sharedDictionaryErrorWriteErrorInvalidStructuredHeader represents the "WriteErrorInvalidStructuredHeader" value.
+	sharedDictionaryErrorWriteErrorInvalidStructuredHeader
+	// sharedDictionaryErrorWriteErrorNavigationRequest represents the "WriteErrorNavigationRequest" value.
+	sharedDictionaryErrorWriteErrorNavigationRequest
+	// sharedDictionaryErrorWriteErrorNoMatchField represents the "WriteErrorNoMatchField" value.
+	sharedDictionaryErrorWriteErrorNoMatchField
+	// sharedDictionaryErrorWriteErrorNonListMatchDestField represents the "WriteErrorNonListMatchDestField" value.
+	sharedDictionaryErrorWriteErrorNonListMatchDestField
+	// sharedDictionaryErrorWriteErrorNonSecureContext represents the "WriteErrorNonSecureContext" value.
+	sharedDictionaryErrorWriteErrorNonSecureContext
+	// sharedDictionaryErrorWriteErrorNonStringIDField represents the "WriteErrorNonStringIdField" value.
+	sharedDictionaryErrorWriteErrorNonStringIDField
+	// sharedDictionaryErrorWriteErrorNonStringInMatchDestList represents the "WriteErrorNonStringInMatchDestList" value.
+	sharedDictionaryErrorWriteErrorNonStringInMatchDestList
+	// sharedDictionaryErrorWriteErrorNonStringMatchField represents the "WriteErrorNonStringMatchField" value.
+	sharedDictionaryErrorWriteErrorNonStringMatchField
+	// sharedDictionaryErrorWriteErrorNonTokenTypeField represents the "WriteErrorNonTokenTypeField" value.
+	sharedDictionaryErrorWriteErrorNonTokenTypeField
+	// sharedDictionaryErrorWriteErrorRequestAborted represents the "WriteErrorRequestAborted" value.
+	sharedDictionaryErrorWriteErrorRequestAborted
+	// sharedDictionaryErrorWriteErrorShuttingDown represents the "WriteErrorShuttingDown" value.
+	sharedDictionaryErrorWriteErrorShuttingDown
+	// sharedDictionaryErrorWriteErrorTooLongIDField represents the "WriteErrorTooLongIdField" value.
+	sharedDictionaryErrorWriteErrorTooLongIDField
+	// sharedDictionaryErrorWriteErrorUnsupportedType represents the "WriteErrorUnsupportedType" value.
+	sharedDictionaryErrorWriteErrorUnsupportedType
+)
+
+var _sharedDictionaryErrorEnums = enums.New(map[SharedDictionaryErrorEnum]string{
+	SharedDictionaryErrorEnum(0):                                   "",
+	sharedDictionaryErrorUseErrorCrossOriginNoCorsRequest:          "UseErrorCrossOriginNoCorsRequest",
+	sharedDictionaryErrorUseErrorDictionaryLoadFailure:             "UseErrorDictionaryLoadFailure",
+	sharedDictionaryErrorUseErrorMatchingDictionaryNotUsed:         "UseErrorMatchingDictionaryNotUsed",
+	sharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader: "UseErrorUnexpectedContentDictionaryHeader",
+	sharedDictionaryErrorWriteErrorCossOriginNoCorsRequest:         "WriteErrorCossOriginNoCorsRequest",
+	sharedDictionaryErrorWriteErrorDisallowedBySettings:            "WriteErrorDisallowedBySettings",
+	sharedDictionaryErrorWriteErrorExpiredResponse:                 "WriteErrorExpiredResponse",
+	sharedDictionaryErrorWriteErrorFeatureDisabled:                 "WriteErrorFeatureDisabled",
+	sharedDictionaryErrorWriteErrorInsufficientResources:           "WriteErrorInsufficientResources",
+	sharedDictionaryErrorWriteErrorInvalidMatchField:               "WriteErrorInvalidMatchField",
+	sharedDictionaryErrorWriteErrorInvalidStructuredHeader:         "WriteErrorInvalidStructuredHeader",
+	sharedDictionaryErrorWriteErrorNavigationRequest:               "WriteErrorNavigationRequest",
+	sharedDictionaryErrorWriteErrorNoMatchField:                    "WriteErrorNoMatchField",
+	sharedDictionaryErrorWriteErrorNonListMatchDestField:           "WriteErrorNonListMatchDestField",
+	sharedDictionaryErrorWriteErrorNonSecureContext:                "WriteErrorNonSecureContext",
+	sharedDictionaryErrorWriteErrorNonStringIDField:                "WriteErrorNonStringIdField",
+	sharedDictionaryErrorWriteErrorNonStringInMatchDestList:        "WriteErrorNonStringInMatchDestList",
+	sharedDictionaryErrorWriteErrorNonStringMatchField:             "WriteErrorNonStringMatchField",
+	sharedDictionaryErrorWriteErrorNonTokenTypeField:               "WriteErrorNonTokenTypeField",
+	sharedDictionaryErrorWriteErrorRequestAborted:                  "WriteErrorRequestAborted",
+	sharedDictionaryErrorWriteErrorShuttingDown:                    "WriteErrorShuttingDown",
+	sharedDictionaryErrorWriteErrorTooLongIDField:                  "WriteErrorTooLongIdField",
+	sharedDictionaryErrorWriteErrorUnsupportedType:                 "WriteErrorUnsupportedType",
+})
@@ -0,0 +1,342 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSharedDictionaryError(t *testing.T) {
+	var enum SharedDictionaryErrorEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SharedDictionaryErrorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SharedDictionaryError.UseErrorCrossOriginNoCorsRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UseErrorCrossOriginNoCorsRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UseErrorCrossOriginNoCorsRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"UseErrorCrossOriginNoCorsRequest"`), &enum)
+	if SharedDictionaryError.UseErrorCrossOriginNoCorsRequest != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.UseErrorCrossOriginNoCorsRequest, enum)
+	}
+
+	enum = SharedDictionaryError.UseErrorDictionaryLoadFailure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UseErrorDictionaryLoadFailure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UseErrorDictionaryLoadFailure"`, result)
+	}
+	json.Unmarshal([]byte(`"UseErrorDictionaryLoadFailure"`), &enum)
+	if SharedDictionaryError.UseErrorDictionaryLoadFailure != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.UseErrorDictionaryLoadFailure, enum)
+	}
+
+	enum = SharedDictionaryError.UseErrorMatchingDictionaryNotUsed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UseErrorMatchingDictionaryNotUsed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UseErrorMatchingDictionaryNotUsed"`, result)
+	}
+	json.Unmarshal([]byte(`"UseErrorMatchingDictionaryNotUsed"`), &enum)
+	if SharedDictionaryError.UseErrorMatchingDictionaryNotUsed != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.UseErrorMatchingDictionaryNotUsed, enum)
+	}
+
+	enum = SharedDictionaryError.UseErrorUnexpectedContentDictionaryHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UseErrorUnexpectedContentDictionaryHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UseErrorUnexpectedContentDictionaryHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"UseErrorUnexpectedContentDictionaryHeader"`), &enum)
+	if SharedDictionaryError.UseErrorUnexpectedContentDictionaryHeader != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.UseErrorUnexpectedContentDictionaryHeader, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorCossOriginNoCorsRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorCossOriginNoCorsRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorCossOriginNoCorsRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorCossOriginNoCorsRequest"`), &enum)
+	if SharedDictionaryError.WriteErrorCossOriginNoCorsRequest != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorCossOriginNoCorsRequest, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorDisallowedBySettings
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorDisallowedBySettings"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorDisallowedBySettings"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorDisallowedBySettings"`), &enum)
+	if SharedDictionaryError.WriteErrorDisallowedBySettings != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorDisallowedBySettings, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorExpiredResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorExpiredResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorExpiredResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorExpiredResponse"`), &enum)
+	if SharedDictionaryError.WriteErrorExpiredResponse != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorExpiredResponse, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorFeatureDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorFeatureDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorFeatureDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorFeatureDisabled"`), &enum)
+	if SharedDictionaryError.WriteErrorFeatureDisabled != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorFeatureDisabled, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorInsufficientResources
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorInsufficientResources"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorInsufficientResources"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorInsufficientResources"`), &enum)
+	if SharedDictionaryError.WriteErrorInsufficientResources != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorInsufficientResources, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorInvalidMatchField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorInvalidMatchField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorInvalidMatchField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorInvalidMatchField"`), &enum)
+	if SharedDictionaryError.WriteErrorInvalidMatchField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorInvalidMatchField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorInvalidStructuredHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorInvalidStructuredHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorInvalidStructuredHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorInvalidStructuredHeader"`), &enum)
+	if SharedDictionaryError.WriteErrorInvalidStructuredHeader != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorInvalidStructuredHeader, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNavigationRequest
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNavigationRequest"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNavigationRequest"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNavigationRequest"`), &enum)
+	if SharedDictionaryError.WriteErrorNavigationRequest != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNavigationRequest, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNoMatchField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNoMatchField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNoMatchField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNoMatchField"`), &enum)
+	if SharedDictionaryError.WriteErrorNoMatchField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNoMatchField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonListMatchDestField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonListMatchDestField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonListMatchDestField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonListMatchDestField"`), &enum)
+	if SharedDictionaryError.WriteErrorNonListMatchDestField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonListMatchDestField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonSecureContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonSecureContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonSecureContext"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonSecureContext"`), &enum)
+	if SharedDictionaryError.WriteErrorNonSecureContext != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonSecureContext, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonStringIDField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonStringIdField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonStringIdField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonStringIdField"`), &enum)
+	if SharedDictionaryError.WriteErrorNonStringIDField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonStringIDField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonStringInMatchDestList
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonStringInMatchDestList"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonStringInMatchDestList"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonStringInMatchDestList"`), &enum)
+	if SharedDictionaryError.WriteErrorNonStringInMatchDestList != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonStringInMatchDestList, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonStringMatchField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonStringMatchField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonStringMatchField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonStringMatchField"`), &enum)
+	if SharedDictionaryError.WriteErrorNonStringMatchField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonStringMatchField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorNonTokenTypeField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorNonTokenTypeField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorNonTokenTypeField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorNonTokenTypeField"`), &enum)
+	if SharedDictionaryError.WriteErrorNonTokenTypeField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorNonTokenTypeField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorRequestAborted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorRequestAborted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorRequestAborted"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorRequestAborted"`), &enum)
+	if SharedDictionaryError.WriteErrorRequestAborted != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorRequestAborted, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorShuttingDown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorShuttingDown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorShuttingDown"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorShuttingDown"`), &enum)
+	if SharedDictionaryError.WriteErrorShuttingDown != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorShuttingDown, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorTooLongIDField
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorTooLongIdField"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorTooLongIdField"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorTooLongIdField"`), &enum)
+	if SharedDictionaryError.WriteErrorTooLongIDField != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorTooLongIDField, enum)
+	}
+
+	enum = SharedDictionaryError.WriteErrorUnsupportedType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WriteErrorUnsupportedType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WriteErrorUnsupportedType"`, result)
+	}
+	json.Unmarshal([]byte(`"WriteErrorUnsupportedType"`), &enum)
+	if SharedDictionaryError.WriteErrorUnsupportedType != enum {
+		t.Errorf("Expected %d, got %d", SharedDictionaryError.WriteErrorUnsupportedType, enum)
+	}
+}
@@ -0,0 +1,197 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sriMessageSignatureErrorEnum struct {
+	MissingSignatureHeader                               SRIMessageSignatureErrorEnum
+	MissingSignatureInputHeader                          SRIMessageSignatureErrorEnum
+	InvalidSignatureHeader                               SRIMessageSignatureErrorEnum
+	InvalidSignatureInputHeader                          SRIMessageSignatureErrorEnum
+	SignatureHeaderValueIsNotByteSequence                SRIMessageSignatureErrorEnum
+	SignatureHeaderValueIsParameterized                  SRIMessageSignatureErrorEnum
+	SignatureHeaderValueIsIncorrectLength                SRIMessageSignatureErrorEnum
+	SignatureInputHeaderMissingLabel                     SRIMessageSignatureErrorEnum
+	SignatureInputHeaderValueNotInnerList                SRIMessageSignatureErrorEnum
+	SignatureInputHeaderValueMissingComponents           SRIMessageSignatureErrorEnum
+	SignatureInputHeaderInvalidComponentType             SRIMessageSignatureErrorEnum
+	SignatureInputHeaderInvalidComponentName             SRIMessageSignatureErrorEnum
+	SignatureInputHeaderInvalidHeaderComponentParameter  SRIMessageSignatureErrorEnum
+	SignatureInputHeaderInvalidDerivedComponentParameter SRIMessageSignatureErrorEnum
+	SignatureInputHeaderKeyIDLength                      SRIMessageSignatureErrorEnum
+	SignatureInputHeaderInvalidParameter                 SRIMessageSignatureErrorEnum
+	SignatureInputHeaderMissingRequiredParameters        SRIMessageSignatureErrorEnum
+	ValidationFailedSignatureExpired                     SRIMessageSignatureErrorEnum
+	ValidationFailedInvalidLength                        SRIMessageSignatureErrorEnum
+	ValidationFailedSignatureMismatch                    SRIMessageSignatureErrorEnum
+	ValidationFailedIntegrityMismatch                    SRIMessageSignatureErrorEnum
+}
+
+/*
+SRIMessageSignatureError provides named access to the SRIMessageSignatureErrorEnum values.
+*/
+var SRIMessageSignatureError = sriMessageSignatureErrorEnum{
+	MissingSignatureHeader:                               sriMessageSignatureErrorMissingSignatureHeader,
+	MissingSignatureInputHeader:                          sriMessageSignatureErrorMissingSignatureInputHeader,
+	InvalidSignatureHeader:                               sriMessageSignatureErrorInvalidSignatureHeader,
+	InvalidSignatureInputHeader:                          sriMessageSignatureErrorInvalidSignatureInputHeader,
+	SignatureHeaderValueIsNotByteSequence:                sriMessageSignatureErrorSignatureHeaderValueIsNotByteSequence,
+	SignatureHeaderValueIsParameterized:                  sriMessageSignatureErrorSignatureHeaderValueIsParameterized,
+	SignatureHeaderValueIsIncorrectLength:                sriMessageSignatureErrorSignatureHeaderValueIsIncorrectLength,
+	SignatureInputHeaderMissingLabel:                     sriMessageSignatureErrorSignatureInputHeaderMissingLabel,
+	SignatureInputHeaderValueNotInnerList:                sriMessageSignatureErrorSignatureInputHeaderValueNotInnerList,
+	SignatureInputHeaderValueMissingComponents:           sriMessageSignatureErrorSignatureInputHeaderValueMissingComponents,
+	SignatureInputHeaderInvalidComponentType:             sriMessageSignatureErrorSignatureInputHeaderInvalidComponentType,
+	SignatureInputHeaderInvalidComponentName:             sriMessageSignatureErrorSignatureInputHeaderInvalidComponentName,
+	SignatureInputHeaderInvalidHeaderComponentParameter:  sriMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter,
+	SignatureInputHeaderInvalidDerivedComponentParameter: sriMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter,
+	SignatureInputHeaderKeyIDLength:                      sriMessageSignatureErrorSignatureInputHeaderKeyIDLength,
+	SignatureInputHeaderInvalidParameter:                 sriMessageSignatureErrorSignatureInputHeaderInvalidParameter,
+	SignatureInputHeaderMissingRequiredParameters:        sriMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters,
+	ValidationFailedSignatureExpired:                     sriMessageSignatureErrorValidationFailedSignatureExpired,
+	ValidationFailedInvalidLength:                        sriMessageSignatureErrorValidationFailedInvalidLength,
+	ValidationFailedSignatureMismatch:                    sriMessageSignatureErrorValidationFailedSignatureMismatch,
+	ValidationFailedIntegrityMismatch:                    sriMessageSignatureErrorValidationFailedIntegrityMismatch,
+}
+
+/*
+SRIMessageSignatureErrorEnum represents the Audits.SRIMessageSignatureError
+values.
+
+Allowed values:
+  - SRIMessageSignatureError.MissingSignatureHeader                               "MissingSignatureHeader"
+  - SRIMessageSignatureError.MissingSignatureInputHeader                          "MissingSignatureInputHeader"
+  - SRIMessageSignatureError.InvalidSignatureHeader                               "InvalidSignatureHeader"
+  - SRIMessageSignatureError.InvalidSignatureInputHeader                          "InvalidSignatureInputHeader"
+  - SRIMessageSignatureError.SignatureHeaderValueIsNotByteSequence                "SignatureHeaderValueIsNotByteSequence"
+  - SRIMessageSignatureError.SignatureHeaderValueIsParameterized                  "SignatureHeaderValueIsParameterized"
+  - SRIMessageSignatureError.SignatureHeaderValueIsIncorrectLength                "SignatureHeaderValueIsIncorrectLength"
+  - SRIMessageSignatureError.SignatureInputHeaderMissingLabel                     "SignatureInputHeaderMissingLabel"
+  - SRIMessageSignatureError.SignatureInputHeaderValueNotInnerList                "SignatureInputHeaderValueNotInnerList"
+  - SRIMessageSignatureError.SignatureInputHeaderValueMissingComponents           "SignatureInputHeaderValueMissingComponents"
+  - SRIMessageSignatureError.SignatureInputHeaderInvalidComponentType             "SignatureInputHeaderInvalidComponentType"
+  - SRIMessageSignatureError.SignatureInputHeaderInvalidComponentName             "SignatureInputHeaderInvalidComponentName"
+  - SRIMessageSignatureError.SignatureInputHeaderInvalidHeaderComponentParameter  "SignatureInputHeaderInvalidHeaderComponentParameter"
+  - SRIMessageSignatureError.SignatureInputHeaderInvalidDerivedComponentParameter "SignatureInputHeaderInvalidDerivedComponentParameter"
+  - SRIMessageSignatureError.SignatureInputHeaderKeyIDLength                      "SignatureInputHeaderKeyIdLength"
+  - SRIMessageSignatureError.SignatureInputHeaderInvalidParameter                 "SignatureInputHeaderInvalidParameter"
+  - SRIMessageSignatureError.SignatureInputHeaderMissingRequiredParameters        "SignatureInputHeaderMissingRequiredParameters"
+  - SRIMessageSignatureError.ValidationFailedSignatureExpired                     "ValidationFailedSignatureExpired"
+  - SRIMessageSignatureError.ValidationFailedInvalidLength                        "ValidationFailedInvalidLength"
+  - SRIMessageSignatureError.ValidationFailedSignatureMismatch                    "ValidationFailedSignatureMismatch"
+  - SRIMessageSignatureError.ValidationFailedIntegrityMismatch                    "ValidationFailedIntegrityMismatch"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-SRIMessageSignatureError
+*/
+type SRIMessageSignatureErrorEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SRIMessageSignatureErrorEnum) String() string {
+	return _sriMessageSignatureErrorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SRIMessageSignatureErrorEnum) Unknown() bool {
+	return !_sriMessageSignatureErrorEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SRIMessageSignatureErrorEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SRIMessageSignatureErrorEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sriMessageSignatureErrorEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sriMessageSignatureErrorMissingSignatureHeader represents the "MissingSignatureHeader" value.
+	sriMessageSignatureErrorMissingSignatureHeader SRIMessageSignatureErrorEnum = iota + 1
+	// sriMessageSignatureErrorMissingSignatureInputHeader represents the "MissingSignatureInputHeader" value.
+	sriMessageSignatureErrorMissingSignatureInputHeader
+	// sriMessageSignatureErrorInvalidSignatureHeader represents the "InvalidSignatureHeader" value.
+	sriMessageSignatureErrorInvalidSignatureHeader
+	// sriMessageSignatureErrorInvalidSignatureInputHeader represents the "InvalidSignatureInputHeader" value.
+	sriMessageSignatureErrorInvalidSignatureInputHeader
+	// sriMessageSignatureErrorSignatureHeaderValueIsNotByteSequence represents the "SignatureHeaderValueIsNotByteSequence" value.
+	sriMessageSignatureErrorSignatureHeaderValueIsNotByteSequence
+	// sriMessageSignatureErrorSignatureHeaderValueIsParameterized represents the "SignatureHeaderValueIsParameterized" value.
+	sriMessageSignatureErrorSignatureHeaderValueIsParameterized
+	// sriMessageSignatureErrorSignatureHeaderValueIsIncorrectLength represents the "SignatureHeaderValueIsIncorrectLength" value.
+	sriMessageSignatureErrorSignatureHeaderValueIsIncorrectLength
+	// sriMessageSignatureErrorSignatureInputHeaderMissingLabel represents the "SignatureInputHeaderMissingLabel" value.
+	sriMessageSignatureErrorSignatureInputHeaderMissingLabel
+	// sriMessageSignatureErrorSignatureInputHeaderValueNotInnerList represents the "SignatureInputHeaderValueNotInnerList" value.
+	sriMessageSignatureErrorSignatureInputHeaderValueNotInnerList
+	// sriMessageSignatureErrorSignatureInputHeaderValueMissingComponents represents the "SignatureInputHeaderValueMissingComponents" value.
+	sriMessageSignatureErrorSignatureInputHeaderValueMissingComponents
+	// sriMessageSignatureErrorSignatureInputHeaderInvalidComponentType represents the "SignatureInputHeaderInvalidComponentType" value.
+	sriMessageSignatureErrorSignatureInputHeaderInvalidComponentType
+	// sriMessageSignatureErrorSignatureInputHeaderInvalidComponentName represents the "SignatureInputHeaderInvalidComponentName" value.
+	sriMessageSignatureErrorSignatureInputHeaderInvalidComponentName
+	// sriMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter represents the "SignatureInputHeaderInvalidHeaderComponentParameter" value.
+	sriMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter
+	// sriMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter represents the "SignatureInputHeaderInvalidDerivedComponentParameter" value.
+	sriMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter
+	// sriMessageSignatureErrorSignatureInputHeaderKeyIDLength represents the "SignatureInputHeaderKeyIdLength" value.
+	sriMessageSignatureErrorSignatureInputHeaderKeyIDLength
+	// sriMessageSignatureErrorSignatureInputHeaderInvalidParameter represents the "SignatureInputHeaderInvalidParameter" value.
+	sriMessageSignatureErrorSignatureInputHeaderInvalidParameter
+	// sriMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters represents the "SignatureInputHeaderMissingRequiredParameters" value.
+	sriMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters
+	// sriMessageSignatureErrorValidationFailedSignatureExpired represents the "ValidationFailedSignatureExpired" value.
+	sriMessageSignatureErrorValidationFailedSignatureExpired
+	// sriMessageSignatureErrorValidationFailedInvalidLength represents the "ValidationFailedInvalidLength" value.
+	sriMessageSignatureErrorValidationFailedInvalidLength
+	// sriMessageSignatureErrorValidationFailedSignatureMismatch represents the "ValidationFailedSignatureMismatch" value.
+	sriMessageSignatureErrorValidationFailedSignatureMismatch
+	// sriMessageSignatureErrorValidationFailedIntegrityMismatch represents the "ValidationFailedIntegrityMismatch" value.
+	sriMessageSignatureErrorValidationFailedIntegrityMismatch
+)
+
+var _sriMessageSignatureErrorEnums = enums.New(map[SRIMessageSignatureErrorEnum]string{
+	SRIMessageSignatureErrorEnum(0):                                              "",
+	sriMessageSignatureErrorMissingSignatureHeader:                               "MissingSignatureHeader",
+	sriMessageSignatureErrorMissingSignatureInputHeader:                          "MissingSignatureInputHeader",
+	sriMessageSignatureErrorInvalidSignatureHeader:                               "InvalidSignatureHeader",
+	sriMessageSignatureErrorInvalidSignatureInputHeader:                          "InvalidSignatureInputHeader",
+	sriMessageSignatureErrorSignatureHeaderValueIsNotByteSequence:                "SignatureHeaderValueIsNotByteSequence",
+	sriMessageSignatureErrorSignatureHeaderValueIsParameterized:                  "SignatureHeaderValueIsParameterized",
+	sriMessageSignatureErrorSignatureHeaderValueIsIncorrectLength:                "SignatureHeaderValueIsIncorrectLength",
+	sriMessageSignatureErrorSignatureInputHeaderMissingLabel:                     "SignatureInputHeaderMissingLabel",
+	sriMessageSignatureErrorSignatureInputHeaderValueNotInnerList:                "SignatureInputHeaderValueNotInnerList",
+	sriMessageSignatureErrorSignatureInputHeaderValueMissingComponents:           "SignatureInputHeaderValueMissingComponents",
+	sriMessageSignatureErrorSignatureInputHeaderInvalidComponentType:             "SignatureInputHeaderInvalidComponentType",
+	sriMessageSignatureErrorSignatureInputHeaderInvalidComponentName:             "SignatureInputHeaderInvalidComponentName",
+	sriMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter:  "SignatureInputHeaderInvalidHeaderComponentParameter",
+	sriMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter: "SignatureInputHeaderInvalidDerivedComponentParameter",
+	sriMessageSignatureErrorSignatureInputHeaderKeyIDLength:                      "SignatureInputHeaderKeyIdLength",
+	sriMessageSignatureErrorSignatureInputHeaderInvalidParameter:                 "SignatureInputHeaderInvalidParameter",
+	sriMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters:        "SignatureInputHeaderMissingRequiredParameters",
+	sriMessageSignatureErrorValidationFailedSignatureExpired:                     "ValidationFailedSignatureExpired",
+	sriMessageSignatureErrorValidationFailedInvalidLength:                        "ValidationFailedInvalidLength",
+	sriMessageSignatureErrorValidationFailedSignatureMismatch:                    "ValidationFailedSignatureMismatch",
+	sriMessageSignatureErrorValidationFailedIntegrityMismatch:                    "ValidationFailedIntegrityMismatch",
+})
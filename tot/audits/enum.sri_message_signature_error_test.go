@@ -0,0 +1,316 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSRIMessageSignatureError(t *testing.T) {
+	var enum SRIMessageSignatureErrorEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SRIMessageSignatureErrorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SRIMessageSignatureError.MissingSignatureHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MissingSignatureHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MissingSignatureHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"MissingSignatureHeader"`), &enum)
+	if SRIMessageSignatureError.MissingSignatureHeader != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.MissingSignatureHeader, enum)
+	}
+
+	enum = SRIMessageSignatureError.MissingSignatureInputHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MissingSignatureInputHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MissingSignatureInputHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"MissingSignatureInputHeader"`), &enum)
+	if SRIMessageSignatureError.MissingSignatureInputHeader != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.MissingSignatureInputHeader, enum)
+	}
+
+	enum = SRIMessageSignatureError.InvalidSignatureHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSignatureHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSignatureHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSignatureHeader"`), &enum)
+	if SRIMessageSignatureError.InvalidSignatureHeader != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.InvalidSignatureHeader, enum)
+	}
+
+	enum = SRIMessageSignatureError.InvalidSignatureInputHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSignatureInputHeader"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSignatureInputHeader"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSignatureInputHeader"`), &enum)
+	if SRIMessageSignatureError.InvalidSignatureInputHeader != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.InvalidSignatureInputHeader, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureHeaderValueIsNotByteSequence
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureHeaderValueIsNotByteSequence"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureHeaderValueIsNotByteSequence"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureHeaderValueIsNotByteSequence"`), &enum)
+	if SRIMessageSignatureError.SignatureHeaderValueIsNotByteSequence != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureHeaderValueIsNotByteSequence, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureHeaderValueIsParameterized
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureHeaderValueIsParameterized"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureHeaderValueIsParameterized"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureHeaderValueIsParameterized"`), &enum)
+	if SRIMessageSignatureError.SignatureHeaderValueIsParameterized != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureHeaderValueIsParameterized, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureHeaderValueIsIncorrectLength
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureHeaderValueIsIncorrectLength"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureHeaderValueIsIncorrectLength"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureHeaderValueIsIncorrectLength"`), &enum)
+	if SRIMessageSignatureError.SignatureHeaderValueIsIncorrectLength != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureHeaderValueIsIncorrectLength, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderMissingLabel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderMissingLabel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderMissingLabel"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderMissingLabel"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderMissingLabel != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderMissingLabel, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderValueNotInnerList
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderValueNotInnerList"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderValueNotInnerList"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderValueNotInnerList"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderValueNotInnerList != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderValueNotInnerList, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderValueMissingComponents
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderValueMissingComponents"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderValueMissingComponents"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderValueMissingComponents"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderValueMissingComponents != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderValueMissingComponents, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderInvalidComponentType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderInvalidComponentType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderInvalidComponentType"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderInvalidComponentType"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderInvalidComponentType != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderInvalidComponentType, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderInvalidComponentName
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderInvalidComponentName"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderInvalidComponentName"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderInvalidComponentName"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderInvalidComponentName != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderInvalidComponentName, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderInvalidHeaderComponentParameter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderInvalidHeaderComponentParameter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderInvalidHeaderComponentParameter"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderInvalidHeaderComponentParameter"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderInvalidHeaderComponentParameter != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderInvalidHeaderComponentParameter, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderInvalidDerivedComponentParameter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderInvalidDerivedComponentParameter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderInvalidDerivedComponentParameter"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderInvalidDerivedComponentParameter"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderInvalidDerivedComponentParameter != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderInvalidDerivedComponentParameter, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderKeyIDLength
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderKeyIdLength"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderKeyIdLength"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderKeyIdLength"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderKeyIDLength != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderKeyIDLength, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderInvalidParameter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderInvalidParameter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderInvalidParameter"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderInvalidParameter"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderInvalidParameter != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderInvalidParameter, enum)
+	}
+
+	enum = SRIMessageSignatureError.SignatureInputHeaderMissingRequiredParameters
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignatureInputHeaderMissingRequiredParameters"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignatureInputHeaderMissingRequiredParameters"`, result)
+	}
+	json.Unmarshal([]byte(`"SignatureInputHeaderMissingRequiredParameters"`), &enum)
+	if SRIMessageSignatureError.SignatureInputHeaderMissingRequiredParameters != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.SignatureInputHeaderMissingRequiredParameters, enum)
+	}
+
+	enum = SRIMessageSignatureError.ValidationFailedSignatureExpired
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ValidationFailedSignatureExpired"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ValidationFailedSignatureExpired"`, result)
+	}
+	json.Unmarshal([]byte(`"ValidationFailedSignatureExpired"`), &enum)
+	if SRIMessageSignatureError.ValidationFailedSignatureExpired != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.ValidationFailedSignatureExpired, enum)
+	}
+
+	enum = SRIMessageSignatureError.ValidationFailedInvalidLength
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ValidationFailedInvalidLength"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ValidationFailedInvalidLength"`, result)
+	}
+	json.Unmarshal([]byte(`"ValidationFailedInvalidLength"`), &enum)
+	if SRIMessageSignatureError.ValidationFailedInvalidLength != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.ValidationFailedInvalidLength, enum)
+	}
+
+	enum = SRIMessageSignatureError.ValidationFailedSignatureMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ValidationFailedSignatureMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ValidationFailedSignatureMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"ValidationFailedSignatureMismatch"`), &enum)
+	if SRIMessageSignatureError.ValidationFailedSignatureMismatch != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.ValidationFailedSignatureMismatch, enum)
+	}
+
+	enum = SRIMessageSignatureError.ValidationFailedIntegrityMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ValidationFailedIntegrityMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ValidationFailedIntegrityMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"ValidationFailedIntegrityMismatch"`), &enum)
+	if SRIMessageSignatureError.ValidationFailedIntegrityMismatch != enum {
+		t.Errorf("Expected %d, got %d", SRIMessageSignatureError.ValidationFailedIntegrityMismatch, enum)
+	}
+}
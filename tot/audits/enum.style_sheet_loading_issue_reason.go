@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type styleSheetLoadingIssueReasonEnum struct {
+	LateImportRule StyleSheetLoadingIssueReasonEnum
+	RequestFailed  StyleSheetLoadingIssueReasonEnum
+}
+
+/*
+StyleSheetLoadingIssueReason provides named access to the StyleSheetLoadingIssueReasonEnum values.
+*/
+var StyleSheetLoadingIssueReason = styleSheetLoadingIssueReasonEnum{
+	LateImportRule: styleSheetLoadingIssueReasonLateImportRule,
+	RequestFailed:  styleSheetLoadingIssueReasonRequestFailed,
+}
+
+/*
+StyleSheetLoadingIssueReasonEnum represents the
+Audits.StyleSheetLoadingIssueReason values.
+
+Allowed values:
+  - StyleSheetLoadingIssueReason.LateImportRule "LateImportRule"
+  - StyleSheetLoadingIssueReason.RequestFailed  "RequestFailed"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-StyleSheetLoadingIssueReason
+*/
+type StyleSheetLoadingIssueReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StyleSheetLoadingIssueReasonEnum) String() string {
+	return _styleSheetLoadingIssueReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StyleSheetLoadingIssueReasonEnum) Unknown() bool {
+	return !_styleSheetLoadingIssueReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StyleSheetLoadingIssueReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StyleSheetLoadingIssueReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _styleSheetLoadingIssueReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// styleSheetLoadingIssueReasonLateImportRule represents the "LateImportRule" value.
+	styleSheetLoadingIssueReasonLateImportRule StyleSheetLoadingIssueReasonEnum = iota + 1
+	// styleSheetLoadingIssueReasonRequestFailed represents the "RequestFailed" value.
+	styleSheetLoadingIssueReasonRequestFailed
+)
+
+var _styleSheetLoadingIssueReasonEnums = enums.New(map[StyleSheetLoadingIssueReasonEnum]string{
+	StyleSheetLoadingIssueReasonEnum(0):        "",
+	styleSheetLoadingIssueReasonLateImportRule: "LateImportRule",
+	styleSheetLoadingIssueReasonRequestFailed:  "RequestFailed",
+})
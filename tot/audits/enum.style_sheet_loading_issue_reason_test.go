@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStyleSheetLoadingIssueReason(t *testing.T) {
+	var enum StyleSheetLoadingIssueReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StyleSheetLoadingIssueReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = StyleSheetLoadingIssueReason.LateImportRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LateImportRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LateImportRule"`, result)
+	}
+	json.Unmarshal([]byte(`"LateImportRule"`), &enum)
+	if StyleSheetLoadingIssueReason.LateImportRule != enum {
+		t.Errorf("Expected %d, got %d", StyleSheetLoadingIssueReason.LateImportRule, enum)
+	}
+
+	enum = StyleSheetLoadingIssueReason.RequestFailed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RequestFailed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RequestFailed"`, result)
+	}
+	json.Unmarshal([]byte(`"RequestFailed"`), &enum)
+	if StyleSheetLoadingIssueReason.RequestFailed != enum {
+		t.Errorf("Expected %d, got %d", StyleSheetLoadingIssueReason.RequestFailed, enum)
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type unencodedDigestErrorEnum struct {
+	MalformedDictionary   UnencodedDigestErrorEnum
+	UnknownAlgorithm      UnencodedDigestErrorEnum
+	IncorrectDigestType   UnencodedDigestErrorEnum
+	IncorrectDigestLength UnencodedDigestErrorEnum
+}
+
+/*
+UnencodedDigestError provides named access to the UnencodedDigestErrorEnum values.
+*/
+var UnencodedDigestError = unencodedDigestErrorEnum{
+	MalformedDictionary:   unencodedDigestErrorMalformedDictionary,
+	UnknownAlgorithm:      unencodedDigestErrorUnknownAlgorithm,
+	IncorrectDigestType:   unencodedDigestErrorIncorrectDigestType,
+	IncorrectDigestLength: unencodedDigestErrorIncorrectDigestLength,
+}
+
+/*
+UnencodedDigestErrorEnum represents the Audits.UnencodedDigestError values.
+
+Allowed values:
+  - UnencodedDigestError.MalformedDictionary   "MalformedDictionary"
+  - UnencodedDigestError.UnknownAlgorithm      "UnknownAlgorithm"
+  - UnencodedDigestError.IncorrectDigestType   "IncorrectDigestType"
+  - UnencodedDigestError.IncorrectDigestLength "IncorrectDigestLength"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-UnencodedDigestError
+*/
+type UnencodedDigestErrorEnum int
+
+/*
+String implements Stringer
+*/
+func (enum UnencodedDigestErrorEnum) String() string {
+	return _unencodedDigestErrorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum UnencodedDigestErrorEnum) Unknown() bool {
+	return !_unencodedDigestErrorEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum UnencodedDigestErrorEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *UnencodedDigestErrorEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _unencodedDigestErrorEnums.Parse(val)
+	return nil
+}
+
+const (
+	// unencodedDigestErrorMalformedDictionary represents the "MalformedDictionary" value.
+	unencodedDigestErrorMalformedDictionary UnencodedDigestErrorEnum = iota + 1
+	// unencodedDigestErrorUnknownAlgorithm represents the "UnknownAlgorithm" value.
+	unencodedDigestErrorUnknownAlgorithm
+	// unencodedDigestErrorIncorrectDigestType represents the "IncorrectDigestType" value.
+	unencodedDigestErrorIncorrectDigestType
+	// unencodedDigestErrorIncorrectDigestLength represents the "IncorrectDigestLength" value.
+	unencodedDigestErrorIncorrectDigestLength
+)
+
+var _unencodedDigestErrorEnums = enums.New(map[UnencodedDigestErrorEnum]string{
+	UnencodedDigestErrorEnum(0):               "",
+	unencodedDigestErrorMalformedDictionary:   "MalformedDictionary",
+	unencodedDigestErrorUnknownAlgorithm:      "UnknownAlgorithm",
+	unencodedDigestErrorIncorrectDigestType:   "IncorrectDigestType",
+	unencodedDigestErrorIncorrectDigestLength: "IncorrectDigestLength",
+})
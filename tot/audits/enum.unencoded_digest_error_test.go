@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumUnencodedDigestError(t *testing.T) {
+	var enum UnencodedDigestErrorEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown UnencodedDigestErrorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = UnencodedDigestError.MalformedDictionary
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MalformedDictionary"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MalformedDictionary"`, result)
+	}
+	json.Unmarshal([]byte(`"MalformedDictionary"`), &enum)
+	if UnencodedDigestError.MalformedDictionary != enum {
+		t.Errorf("Expected %d, got %d", UnencodedDigestError.MalformedDictionary, enum)
+	}
+
+	enum = UnencodedDigestError.UnknownAlgorithm
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnknownAlgorithm"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnknownAlgorithm"`, result)
+	}
+	json.Unmarshal([]byte(`"UnknownAlgorithm"`), &enum)
+	if UnencodedDigestError.UnknownAlgorithm != enum {
+		t.Errorf("Expected %d, got %d", UnencodedDigestError.UnknownAlgorithm, enum)
+	}
+
+	enum = UnencodedDigestError.IncorrectDigestType
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IncorrectDigestType"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IncorrectDigestType"`, result)
+	}
+	json.Unmarshal([]byte(`"IncorrectDigestType"`), &enum)
+	if UnencodedDigestError.IncorrectDigestType != enum {
+		t.Errorf("Expected %d, got %d", UnencodedDigestError.IncorrectDigestType, enum)
+	}
+
+	enum = UnencodedDigestError.IncorrectDigestLength
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IncorrectDigestLength"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IncorrectDigestLength"`, result)
+	}
+	json.Unmarshal([]byte(`"IncorrectDigestLength"`), &enum)
+	if UnencodedDigestError.IncorrectDigestLength != enum {
+		t.Errorf("Expected %d, got %d", UnencodedDigestError.IncorrectDigestLength, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type userReidentificationIssueTypeEnum struct {
+	BlockedFrameNavigation UserReidentificationIssueTypeEnum
+	BlockedSubresource     UserReidentificationIssueTypeEnum
+}
+
+/*
+UserReidentificationIssueType provides named access to the UserReidentificationIssueTypeEnum values.
+*/
+var UserReidentificationIssueType = userReidentificationIssueTypeEnum{
+	BlockedFrameNavigation: userReidentificationIssueTypeBlockedFrameNavigation,
+	BlockedSubresource:     userReidentificationIssueTypeBlockedSubresource,
+}
+
+/*
+UserReidentificationIssueTypeEnum represents the
+Audits.UserReidentificationIssueType values.
+
+Allowed values:
+  - UserReidentificationIssueType.BlockedFrameNavigation "BlockedFrameNavigation"
+  - UserReidentificationIssueType.BlockedSubresource     "BlockedSubresource"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#type-UserReidentificationIssueType
+*/
+type UserReidentificationIssueTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum UserReidentificationIssueTypeEnum) String() string {
+	return _userReidentificationIssueTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum UserReidentificationIssueTypeEnum) Unknown() bool {
+	return !_userReidentificationIssueTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum UserReidentificationIssueTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *UserReidentificationIssueTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _userReidentificationIssueTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// userReidentificationIssueTypeBlockedFrameNavigation represents the "BlockedFrameNavigation" value.
+	userReidentificationIssueTypeBlockedFrameNavigation UserReidentificationIssueTypeEnum = iota + 1
+	// userReidentificationIssueTypeBlockedSubresource represents the "BlockedSubresource" value.
+	userReidentificationIssueTypeBlockedSubresource
+)
+
+var _userReidentificationIssueTypeEnums = enums.New(map[UserReidentificationIssueTypeEnum]string{
+	UserReidentificationIssueTypeEnum(0):                "",
+	userReidentificationIssueTypeBlockedFrameNavigation: "BlockedFrameNavigation",
+	userReidentificationIssueTypeBlockedSubresource:     "BlockedSubresource",
+})
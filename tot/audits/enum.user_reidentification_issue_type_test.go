@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audits
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumUserReidentificationIssueType(t *testing.T) {
+	var enum UserReidentificationIssueTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown UserReidentificationIssueTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = UserReidentificationIssueType.BlockedFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedFrameNavigation"`), &enum)
+	if UserReidentificationIssueType.BlockedFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", UserReidentificationIssueType.BlockedFrameNavigation, enum)
+	}
+
+	enum = UserReidentificationIssueType.BlockedSubresource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedSubresource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedSubresource"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedSubresource"`), &enum)
+	if UserReidentificationIssueType.BlockedSubresource != enum {
+		t.Errorf("Expected %d, got %d", UserReidentificationIssueType.BlockedSubresource, enum)
+	}
+}
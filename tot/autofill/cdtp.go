@@ -0,0 +1,123 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package autofill provides type definitions for use with the Chrome Autofill protocol
+
+Defines commands and events for Autofill. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/
+*/
+package autofill
+
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+CreditCard represents the Autofill.CreditCard type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-CreditCard
+*/
+type CreditCard struct {
+	// 16-digit credit card number.
+	Number string `json:"number"`
+
+	// Name of the credit card owner.
+	Name string `json:"name"`
+
+	// 2-digit expiry month.
+	ExpiryMonth string `json:"expiryMonth"`
+
+	// 4-digit expiry year.
+	ExpiryYear string `json:"expiryYear"`
+
+	// 3-digit card verification code.
+	Cvc string `json:"cvc"`
+}
+
+/*
+AddressField represents the Autofill.AddressField type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-AddressField
+*/
+type AddressField struct {
+	// address field name, for example GIVEN_NAME.
+	Name string `json:"name"`
+
+	// address field value, for example Jon Doe.
+	Value string `json:"value"`
+}
+
+/*
+AddressFields represents the Autofill.AddressFields type.
+
+A list of address fields.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-AddressFields
+*/
+type AddressFields struct {
+	Fields []*AddressField `json:"fields"`
+}
+
+/*
+Address represents the Autofill.Address type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-Address
+*/
+type Address struct {
+	// fields and values defining an address.
+	Fields []*AddressField `json:"fields"`
+}
+
+/*
+AddressUI represents the Autofill.AddressUI type.
+
+Defines how an address can be displayed like in chrome://settings/addresses.
+Address UI is a two dimensional array, each inner array is an "address
+information line", and when rendered in a UI surface should be displayed as
+such. The following address UI for instance: [[{name: "GIVE_NAME", value:
+"Jon"}, {name: "FAMILY_NAME", value: "Doe"}], [{name: "CITY", value: "Munich"},
+{name: "ZIP", value: "81456"}]] should allow the receiver to render: Jon Doe
+Munich 81456
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-AddressUI
+*/
+type AddressUI struct {
+	// A two dimension array containing the representation of values from an
+	// address profile.
+	AddressFields []*AddressFields `json:"addressFields"`
+}
+
+/*
+FilledField represents the Autofill.FilledField type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-FilledField
+*/
+type FilledField struct {
+	// The type of the field, e.g text, password etc.
+	HTMLType string `json:"htmlType"`
+
+	// the html id
+	ID string `json:"id"`
+
+	// the html name
+	Name string `json:"name"`
+
+	// the field value
+	Value string `json:"value"`
+
+	// The actual field type, e.g FAMILY_NAME
+	AutofillType string `json:"autofillType"`
+
+	// The filling strategy Allowed values:
+	//   - FillingStrategy.AutocompleteAttribute
+	//   - FillingStrategy.AutofillInferred
+	FillingStrategy FillingStrategyEnum `json:"fillingStrategy"`
+
+	// The frame the field belongs to
+	FrameID page.FrameID `json:"frameId"`
+
+	// The form field's DOM node
+	FieldID dom.BackendNodeID `json:"fieldId"`
+}
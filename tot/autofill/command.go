@@ -0,0 +1,73 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+TriggerParams represents Autofill.trigger parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-trigger
+*/
+type TriggerParams struct {
+	// Identifies a field that serves as an anchor for autofill.
+	FieldID dom.BackendNodeID `json:"fieldId"`
+
+	// Optional. Identifies the frame that field belongs to.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+
+	// Credit card information to fill out the form. Credit card data is not saved.
+	Card *CreditCard `json:"card"`
+}
+
+/*
+TriggerResult represents the result of calls to Autofill.trigger.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-trigger
+*/
+type TriggerResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAddressesParams represents Autofill.setAddresses parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-setAddresses
+*/
+type SetAddressesParams struct {
+	Addresses []*Address `json:"addresses"`
+}
+
+/*
+SetAddressesResult represents the result of calls to Autofill.setAddresses.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-setAddresses
+*/
+type SetAddressesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Autofill.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableResult represents the result of calls to Autofill.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type fillingStrategyEnum struct {
+	AutocompleteAttribute FillingStrategyEnum
+	AutofillInferred      FillingStrategyEnum
+}
+
+/*
+FillingStrategy provides named access to the FillingStrategyEnum values.
+*/
+var FillingStrategy = fillingStrategyEnum{
+	AutocompleteAttribute: fillingStrategyAutocompleteAttribute,
+	AutofillInferred:      fillingStrategyAutofillInferred,
+}
+
+/*
+FillingStrategyEnum represents the Autofill.FillingStrategy values.
+
+Specified whether a filled field was done so by using the html autocomplete
+attribute or autofill heuristics.
+
+Allowed values:
+  - FillingStrategy.AutocompleteAttribute "autocompleteAttribute"
+  - FillingStrategy.AutofillInferred      "autofillInferred"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#type-FillingStrategy
+*/
+type FillingStrategyEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FillingStrategyEnum) String() string {
+	return _fillingStrategyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FillingStrategyEnum) Unknown() bool {
+	return !_fillingStrategyEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FillingStrategyEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FillingStrategyEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _fillingStrategyEnums.Parse(val)
+	return nil
+}
+
+const (
+	// fillingStrategyAutocompleteAttribute represents the "autocompleteAttribute" value.
+	fillingStrategyAutocompleteAttribute FillingStrategyEnum = iota + 1
+	// fillingStrategyAutofillInferred represents the "autofillInferred" value.
+	fillingStrategyAutofillInferred
+)
+
+var _fillingStrategyEnums = enums.New(map[FillingStrategyEnum]string{
+	FillingStrategyEnum(0):               "",
+	fillingStrategyAutocompleteAttribute: "autocompleteAttribute",
+	fillingStrategyAutofillInferred:      "autofillInferred",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package autofill
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFillingStrategy(t *testing.T) {
+	var enum FillingStrategyEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FillingStrategyEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = FillingStrategy.AutocompleteAttribute
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autocompleteAttribute"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autocompleteAttribute"`, result)
+	}
+	json.Unmarshal([]byte(`"autocompleteAttribute"`), &enum)
+	if FillingStrategy.AutocompleteAttribute != enum {
+		t.Errorf("Expected %d, got %d", FillingStrategy.AutocompleteAttribute, enum)
+	}
+
+	enum = FillingStrategy.AutofillInferred
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autofillInferred"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autofillInferred"`, result)
+	}
+	json.Unmarshal([]byte(`"autofillInferred"`), &enum)
+	if FillingStrategy.AutofillInferred != enum {
+		t.Errorf("Expected %d, got %d", FillingStrategy.AutofillInferred, enum)
+	}
+}
@@ -0,0 +1,20 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package autofill
+
+/*
+AddressFormFilledEvent represents Autofill.addressFormFilled event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#event-addressFormFilled
+*/
+type AddressFormFilledEvent struct {
+	// Information about the fields that were filled
+	FilledFields []*FilledField `json:"filledFields"`
+
+	// An UI representation of the address used to fill the form. Consists of a 2D
+	// array where each child represents an address/profile line.
+	AddressUI *AddressUI `json:"addressUi"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
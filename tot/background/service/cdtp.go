@@ -0,0 +1,65 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package service provides type definitions for use with the Chrome BackgroundService protocol
+
+Defines events for background web platform features. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/
+*/
+package service
+
+import (
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/service/worker"
+)
+
+/*
+EventMetadata represents the BackgroundService.EventMetadata type.
+
+A key-value pair for additional event information to pass along.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#type-EventMetadata
+*/
+type EventMetadata struct {
+	Key string `json:"key"`
+
+	Value string `json:"value"`
+}
+
+/*
+Event represents the BackgroundService.BackgroundServiceEvent type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#type-BackgroundServiceEvent
+*/
+type Event struct {
+	// Timestamp of the event (in seconds).
+	Timestamp network.TimeSinceEpoch `json:"timestamp"`
+
+	// The origin this event belongs to.
+	Origin string `json:"origin"`
+
+	// The Service Worker ID that initiated the event.
+	ServiceWorkerRegistrationID worker.RegistrationID `json:"serviceWorkerRegistrationId"`
+
+	// The Background Service this event belongs to. Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+
+	// A description of the event.
+	EventName string `json:"eventName"`
+
+	// An identifier that groups related events together.
+	InstanceID string `json:"instanceId"`
+
+	// A list of event-specific information.
+	EventMetadata []*EventMetadata `json:"eventMetadata"`
+
+	// Storage key this event belongs to.
+	StorageKey string `json:"storageKey"`
+}
@@ -0,0 +1,109 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package service
+
+/*
+StartObservingParams represents BackgroundService.startObserving parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-startObserving
+*/
+type StartObservingParams struct {
+	// Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+}
+
+/*
+StartObservingResult represents the result of calls to BackgroundService.startObserving.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-startObserving
+*/
+type StartObservingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StopObservingParams represents BackgroundService.stopObserving parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-stopObserving
+*/
+type StopObservingParams struct {
+	// Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+}
+
+/*
+StopObservingResult represents the result of calls to BackgroundService.stopObserving.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-stopObserving
+*/
+type StopObservingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetRecordingParams represents BackgroundService.setRecording parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-setRecording
+*/
+type SetRecordingParams struct {
+	ShouldRecord bool `json:"shouldRecord"`
+
+	// Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+}
+
+/*
+SetRecordingResult represents the result of calls to BackgroundService.setRecording.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-setRecording
+*/
+type SetRecordingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearEventsParams represents BackgroundService.clearEvents parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-clearEvents
+*/
+type ClearEventsParams struct {
+	// Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+}
+
+/*
+ClearEventsResult represents the result of calls to BackgroundService.clearEvents.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-clearEvents
+*/
+type ClearEventsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,109 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type nameEnum struct {
+	BackgroundFetch        NameEnum
+	BackgroundSync         NameEnum
+	PushMessaging          NameEnum
+	Notifications          NameEnum
+	PaymentHandler         NameEnum
+	PeriodicBackgroundSync NameEnum
+}
+
+/*
+Name provides named access to the NameEnum values.
+*/
+var Name = nameEnum{
+	BackgroundFetch:        nameBackgroundFetch,
+	BackgroundSync:         nameBackgroundSync,
+	PushMessaging:          namePushMessaging,
+	Notifications:          nameNotifications,
+	PaymentHandler:         namePaymentHandler,
+	PeriodicBackgroundSync: namePeriodicBackgroundSync,
+}
+
+/*
+NameEnum represents the BackgroundService.ServiceName values.
+
+The Background Service that will be associated with the commands/events. Every
+Background Service operates independently, but they share the same API.
+
+Allowed values:
+  - Name.BackgroundFetch        "backgroundFetch"
+  - Name.BackgroundSync         "backgroundSync"
+  - Name.PushMessaging          "pushMessaging"
+  - Name.Notifications          "notifications"
+  - Name.PaymentHandler         "paymentHandler"
+  - Name.PeriodicBackgroundSync "periodicBackgroundSync"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#type-ServiceName
+*/
+type NameEnum int
+
+/*
+String implements Stringer
+*/
+func (enum NameEnum) String() string {
+	return _nameEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum NameEnum) Unknown() bool {
+	return !_nameEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum NameEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *NameEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _nameEnums.Parse(val)
+	return nil
+}
+
+const (
+	// nameBackgroundFetch represents the "backgroundFetch" value.
+	nameBackgroundFetch NameEnum = iota + 1
+	// nameBackgroundSync represents the "backgroundSync" value.
+	nameBackgroundSync
+	// namePushMessaging represents the "pushMessaging" value.
+	namePushMessaging
+	// nameNotifications represents the "notifications" value.
+	nameNotifications
+	// namePaymentHandler represents the "paymentHandler" value.
+	namePaymentHandler
+	// namePeriodicBackgroundSync represents the "periodicBackgroundSync" value.
+	namePeriodicBackgroundSync
+)
+
+var _nameEnums = enums.New(map[NameEnum]string{
+	NameEnum(0):                "",
+	nameBackgroundFetch:        "backgroundFetch",
+	nameBackgroundSync:         "backgroundSync",
+	namePushMessaging:          "pushMessaging",
+	nameNotifications:          "notifications",
+	namePaymentHandler:         "paymentHandler",
+	namePeriodicBackgroundSync: "periodicBackgroundSync",
+})
@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package service
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumName(t *testing.T) {
+	var enum NameEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown NameEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Name.BackgroundFetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"backgroundFetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"backgroundFetch"`, result)
+	}
+	json.Unmarshal([]byte(`"backgroundFetch"`), &enum)
+	if Name.BackgroundFetch != enum {
+		t.Errorf("Expected %d, got %d", Name.BackgroundFetch, enum)
+	}
+
+	enum = Name.BackgroundSync
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"backgroundSync"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"backgroundSync"`, result)
+	}
+	json.Unmarshal([]byte(`"backgroundSync"`), &enum)
+	if Name.BackgroundSync != enum {
+		t.Errorf("Expected %d, got %d", Name.BackgroundSync, enum)
+	}
+
+	enum = Name.PushMessaging
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"pushMessaging"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"pushMessaging"`, result)
+	}
+	json.Unmarshal([]byte(`"pushMessaging"`), &enum)
+	if Name.PushMessaging != enum {
+		t.Errorf("Expected %d, got %d", Name.PushMessaging, enum)
+	}
+
+	enum = Name.Notifications
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"notifications"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"notifications"`, result)
+	}
+	json.Unmarshal([]byte(`"notifications"`), &enum)
+	if Name.Notifications != enum {
+		t.Errorf("Expected %d, got %d", Name.Notifications, enum)
+	}
+
+	enum = Name.PaymentHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"paymentHandler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"paymentHandler"`, result)
+	}
+	json.Unmarshal([]byte(`"paymentHandler"`), &enum)
+	if Name.PaymentHandler != enum {
+		t.Errorf("Expected %d, got %d", Name.PaymentHandler, enum)
+	}
+
+	enum = Name.PeriodicBackgroundSync
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"periodicBackgroundSync"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"periodicBackgroundSync"`, result)
+	}
+	json.Unmarshal([]byte(`"periodicBackgroundSync"`), &enum)
+	if Name.PeriodicBackgroundSync != enum {
+		t.Errorf("Expected %d, got %d", Name.PeriodicBackgroundSync, enum)
+	}
+}
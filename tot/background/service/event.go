@@ -0,0 +1,36 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package service
+
+/*
+RecordingStateChangedEvent represents BackgroundService.recordingStateChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#event-recordingStateChanged
+*/
+type RecordingStateChangedEvent struct {
+	IsRecording bool `json:"isRecording"`
+
+	// Allowed values:
+	//   - Name.BackgroundFetch
+	//   - Name.BackgroundSync
+	//   - Name.PushMessaging
+	//   - Name.Notifications
+	//   - Name.PaymentHandler
+	//   - Name.PeriodicBackgroundSync
+	Service NameEnum `json:"service"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+EventReceivedEvent represents BackgroundService.backgroundServiceEventReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#event-backgroundServiceEventReceived
+*/
+type EventReceivedEvent struct {
+	BackgroundServiceEvent *Event `json:"backgroundServiceEvent"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
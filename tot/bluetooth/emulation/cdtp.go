@@ -0,0 +1,104 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package emulation provides type definitions for use with the Chrome BluetoothEmulation protocol
+
+This domain allows configuring virtual Bluetooth devices to test the
+web-bluetooth API. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/
+*/
+package emulation
+
+/*
+ManufacturerData represents the BluetoothEmulation.ManufacturerData type.
+
+# Stores the manufacturer data
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-ManufacturerData
+*/
+type ManufacturerData struct {
+	// Company identifier
+	// https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/company_identifiers/company_identifiers.yaml
+	// https://usb.org/developers
+	Key int `json:"key"`
+
+	// Manufacturer-specific data (Encoded as a base64 string when passed over
+	// JSON)
+	Data string `json:"data"`
+}
+
+/*
+ScanRecord represents the BluetoothEmulation.ScanRecord type.
+
+Stores the byte data of the advertisement packet sent by a Bluetooth device.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-ScanRecord
+*/
+type ScanRecord struct {
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional.
+	UUIDs []string `json:"uuids,omitempty"`
+
+	// Optional. Stores the external appearance description of the device.
+	Appearance int `json:"appearance,omitempty"`
+
+	// Optional. Stores the transmission power of a broadcasting device.
+	TxPower int `json:"txPower,omitempty"`
+
+	// Optional. Key is the company identifier and the value is an array of bytes
+	// of manufacturer specific data.
+	ManufacturerData []*ManufacturerData `json:"manufacturerData,omitempty"`
+}
+
+/*
+ScanEntry represents the BluetoothEmulation.ScanEntry type.
+
+Stores the advertisement packet information that is sent by a Bluetooth device.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-ScanEntry
+*/
+type ScanEntry struct {
+	DeviceAddress string `json:"deviceAddress"`
+
+	Rssi int `json:"rssi"`
+
+	ScanRecord *ScanRecord `json:"scanRecord"`
+}
+
+/*
+CharacteristicProperties represents the
+BluetoothEmulation.CharacteristicProperties type.
+
+Describes the properties of a characteristic. This follows Bluetooth Core
+Specification BT 4.2 Vol 3 Part G 3.3.1. Characteristic Properties.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-CharacteristicProperties
+*/
+type CharacteristicProperties struct {
+	// Optional.
+	Broadcast bool `json:"broadcast,omitempty"`
+
+	// Optional.
+	Read bool `json:"read,omitempty"`
+
+	// Optional.
+	WriteWithoutResponse bool `json:"writeWithoutResponse,omitempty"`
+
+	// Optional.
+	Write bool `json:"write,omitempty"`
+
+	// Optional.
+	Notify bool `json:"notify,omitempty"`
+
+	// Optional.
+	Indicate bool `json:"indicate,omitempty"`
+
+	// Optional.
+	AuthenticatedSignedWrites bool `json:"authenticatedSignedWrites,omitempty"`
+
+	// Optional.
+	ExtendedProperties bool `json:"extendedProperties,omitempty"`
+}
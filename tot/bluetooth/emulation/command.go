@@ -0,0 +1,342 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+/*
+EnableParams represents BluetoothEmulation.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-enable
+*/
+type EnableParams struct {
+	// State of the simulated central. Allowed values:
+	//   - CentralState.Absent
+	//   - CentralState.PoweredOff
+	//   - CentralState.PoweredOn
+	State CentralStateEnum `json:"state"`
+
+	// If the simulated central supports low-energy.
+	LeSupported bool `json:"leSupported"`
+}
+
+/*
+EnableResult represents the result of calls to BluetoothEmulation.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSimulatedCentralStateParams represents BluetoothEmulation.setSimulatedCentralState parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-setSimulatedCentralState
+*/
+type SetSimulatedCentralStateParams struct {
+	// State of the simulated central. Allowed values:
+	//   - CentralState.Absent
+	//   - CentralState.PoweredOff
+	//   - CentralState.PoweredOn
+	State CentralStateEnum `json:"state"`
+}
+
+/*
+SetSimulatedCentralStateResult represents the result of calls to BluetoothEmulation.setSimulatedCentralState.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-setSimulatedCentralState
+*/
+type SetSimulatedCentralStateResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to BluetoothEmulation.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulatePreconnectedPeripheralParams represents BluetoothEmulation.simulatePreconnectedPeripheral parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulatePreconnectedPeripheral
+*/
+type SimulatePreconnectedPeripheralParams struct {
+	Address string `json:"address"`
+
+	Name string `json:"name"`
+
+	ManufacturerData []*ManufacturerData `json:"manufacturerData"`
+
+	KnownServiceUUIDs []string `json:"knownServiceUuids"`
+}
+
+/*
+SimulatePreconnectedPeripheralResult represents the result of calls to BluetoothEmulation.simulatePreconnectedPeripheral.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulatePreconnectedPeripheral
+*/
+type SimulatePreconnectedPeripheralResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulateAdvertisementParams represents BluetoothEmulation.simulateAdvertisement parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateAdvertisement
+*/
+type SimulateAdvertisementParams struct {
+	Entry *ScanEntry `json:"entry"`
+}
+
+/*
+SimulateAdvertisementResult represents the result of calls to BluetoothEmulation.simulateAdvertisement.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateAdvertisement
+*/
+type SimulateAdvertisementResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulateGATTOperationResponseParams represents BluetoothEmulation.simulateGATTOperationResponse parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTOperationResponse
+*/
+type SimulateGATTOperationResponseParams struct {
+	Address string `json:"address"`
+
+	// Allowed values:
+	//   - GATTOperationType.Connection
+	//   - GATTOperationType.Discovery
+	Type GATTOperationTypeEnum `json:"type"`
+
+	Code int `json:"code"`
+}
+
+/*
+SimulateGATTOperationResponseResult represents the result of calls to BluetoothEmulation.simulateGATTOperationResponse.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTOperationResponse
+*/
+type SimulateGATTOperationResponseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulateCharacteristicOperationResponseParams represents BluetoothEmulation.simulateCharacteristicOperationResponse parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateCharacteristicOperationResponse
+*/
+type SimulateCharacteristicOperationResponseParams struct {
+	CharacteristicID string `json:"characteristicId"`
+
+	// Allowed values:
+	//   - CharacteristicOperationType.Read
+	//   - CharacteristicOperationType.Write
+	//   - CharacteristicOperationType.SubscribeToNotifications
+	//   - CharacteristicOperationType.UnsubscribeFromNotifications
+	Type CharacteristicOperationTypeEnum `json:"type"`
+
+	Code int `json:"code"`
+
+	// Optional.
+	Data *string `json:"data,omitempty"`
+}
+
+/*
+SimulateCharacteristicOperationResponseResult represents the result of calls to BluetoothEmulation.simulateCharacteristicOperationResponse.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateCharacteristicOperationResponse
+*/
+type SimulateCharacteristicOperationResponseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulateDescriptorOperationResponseParams represents BluetoothEmulation.simulateDescriptorOperationResponse parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateDescriptorOperationResponse
+*/
+type SimulateDescriptorOperationResponseParams struct {
+	DescriptorID string `json:"descriptorId"`
+
+	// Allowed values:
+	//   - DescriptorOperationType.Read
+	//   - DescriptorOperationType.Write
+	Type DescriptorOperationTypeEnum `json:"type"`
+
+	Code int `json:"code"`
+
+	// Optional.
+	Data *string `json:"data,omitempty"`
+}
+
+/*
+SimulateDescriptorOperationResponseResult represents the result of calls to BluetoothEmulation.simulateDescriptorOperationResponse.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateDescriptorOperationResponse
+*/
+type SimulateDescriptorOperationResponseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddServiceParams represents BluetoothEmulation.addService parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addService
+*/
+type AddServiceParams struct {
+	Address string `json:"address"`
+
+	ServiceUUID string `json:"serviceUuid"`
+}
+
+/*
+AddServiceResult represents the result of calls to BluetoothEmulation.addService.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addService
+*/
+type AddServiceResult struct {
+	// An identifier that uniquely represents this service.
+	ServiceID string `json:"serviceId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveServiceParams represents BluetoothEmulation.removeService parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeService
+*/
+type RemoveServiceParams struct {
+	ServiceID string `json:"serviceId"`
+}
+
+/*
+RemoveServiceResult represents the result of calls to BluetoothEmulation.removeService.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeService
+*/
+type RemoveServiceResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddCharacteristicParams represents BluetoothEmulation.addCharacteristic parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addCharacteristic
+*/
+type AddCharacteristicParams struct {
+	ServiceID string `json:"serviceId"`
+
+	CharacteristicUUID string `json:"characteristicUuid"`
+
+	Properties *CharacteristicProperties `json:"properties"`
+}
+
+/*
+AddCharacteristicResult represents the result of calls to BluetoothEmulation.addCharacteristic.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addCharacteristic
+*/
+type AddCharacteristicResult struct {
+	// An identifier that uniquely represents this characteristic.
+	CharacteristicID string `json:"characteristicId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveCharacteristicParams represents BluetoothEmulation.removeCharacteristic parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeCharacteristic
+*/
+type RemoveCharacteristicParams struct {
+	CharacteristicID string `json:"characteristicId"`
+}
+
+/*
+RemoveCharacteristicResult represents the result of calls to BluetoothEmulation.removeCharacteristic.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeCharacteristic
+*/
+type RemoveCharacteristicResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddDescriptorParams represents BluetoothEmulation.addDescriptor parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addDescriptor
+*/
+type AddDescriptorParams struct {
+	CharacteristicID string `json:"characteristicId"`
+
+	DescriptorUUID string `json:"descriptorUuid"`
+}
+
+/*
+AddDescriptorResult represents the result of calls to BluetoothEmulation.addDescriptor.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addDescriptor
+*/
+type AddDescriptorResult struct {
+	// An identifier that uniquely represents this descriptor.
+	DescriptorID string `json:"descriptorId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveDescriptorParams represents BluetoothEmulation.removeDescriptor parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeDescriptor
+*/
+type RemoveDescriptorParams struct {
+	DescriptorID string `json:"descriptorId"`
+}
+
+/*
+RemoveDescriptorResult represents the result of calls to BluetoothEmulation.removeDescriptor.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeDescriptor
+*/
+type RemoveDescriptorResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SimulateGATTDisconnectionParams represents BluetoothEmulation.simulateGATTDisconnection parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTDisconnection
+*/
+type SimulateGATTDisconnectionParams struct {
+	Address string `json:"address"`
+}
+
+/*
+SimulateGATTDisconnectionResult represents the result of calls to BluetoothEmulation.simulateGATTDisconnection.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTDisconnection
+*/
+type SimulateGATTDisconnectionResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type centralStateEnum struct {
+	Absent     CentralStateEnum
+	PoweredOff CentralStateEnum
+	PoweredOn  CentralStateEnum
+}
+
+/*
+CentralState provides named access to the CentralStateEnum values.
+*/
+var CentralState = centralStateEnum{
+	Absent:     centralStateAbsent,
+	PoweredOff: centralStatePoweredOff,
+	PoweredOn:  centralStatePoweredOn,
+}
+
+/*
+CentralStateEnum represents the BluetoothEmulation.CentralState values.
+
+Indicates the various states of Central.
+
+Allowed values:
+  - CentralState.Absent     "absent"
+  - CentralState.PoweredOff "powered-off"
+  - CentralState.PoweredOn  "powered-on"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-CentralState
+*/
+type CentralStateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CentralStateEnum) String() string {
+	return _centralStateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CentralStateEnum) Unknown() bool {
+	return !_centralStateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CentralStateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CentralStateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _centralStateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// centralStateAbsent represents the "absent" value.
+	centralStateAbsent CentralStateEnum = iota + 1
+	// centralStatePoweredOff represents the "powered-off" value.
+	centralStatePoweredOff
+	// centralStatePoweredOn represents the "powered-on" value.
+	centralStatePoweredOn
+)
+
+var _centralStateEnums = enums.New(map[CentralStateEnum]string{
+	CentralStateEnum(0):    "",
+	centralStateAbsent:     "absent",
+	centralStatePoweredOff: "powered-off",
+	centralStatePoweredOn:  "powered-on",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCentralState(t *testing.T) {
+	var enum CentralStateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CentralStateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CentralState.Absent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"absent"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"absent"`, result)
+	}
+	json.Unmarshal([]byte(`"absent"`), &enum)
+	if CentralState.Absent != enum {
+		t.Errorf("Expected %d, got %d", CentralState.Absent, enum)
+	}
+
+	enum = CentralState.PoweredOff
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"powered-off"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"powered-off"`, result)
+	}
+	json.Unmarshal([]byte(`"powered-off"`), &enum)
+	if CentralState.PoweredOff != enum {
+		t.Errorf("Expected %d, got %d", CentralState.PoweredOff, enum)
+	}
+
+	enum = CentralState.PoweredOn
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"powered-on"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"powered-on"`, result)
+	}
+	json.Unmarshal([]byte(`"powered-on"`), &enum)
+	if CentralState.PoweredOn != enum {
+		t.Errorf("Expected %d, got %d", CentralState.PoweredOn, enum)
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type characteristicOperationTypeEnum struct {
+	Read                         CharacteristicOperationTypeEnum
+	Write                        CharacteristicOperationTypeEnum
+	SubscribeToNotifications     CharacteristicOperationTypeEnum
+	UnsubscribeFromNotifications CharacteristicOperationTypeEnum
+}
+
+/*
+CharacteristicOperationType provides named access to the CharacteristicOperationTypeEnum values.
+*/
+var CharacteristicOperationType = characteristicOperationTypeEnum{
+	Read:                         characteristicOperationTypeRead,
+	Write:                        characteristicOperationTypeWrite,
+	SubscribeToNotifications:     characteristicOperationTypeSubscribeToNotifications,
+	UnsubscribeFromNotifications: characteristicOperationTypeUnsubscribeFromNotifications,
+}
+
+/*
+CharacteristicOperationTypeEnum represents the
+BluetoothEmulation.CharacteristicOperationType values.
+
+Indicates the various types of characteristic operation.
+
+Allowed values:
+  - CharacteristicOperationType.Read                         "read"
+  - CharacteristicOperationType.Write                        "write"
+  - CharacteristicOperationType.SubscribeToNotifications     "subscribe-to-notifications"
+  - CharacteristicOperationType.UnsubscribeFromNotifications "unsubscribe-from-notifications"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-CharacteristicOperationType
+*/
+type CharacteristicOperationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CharacteristicOperationTypeEnum) String() string {
+	return _characteristicOperationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CharacteristicOperationTypeEnum) Unknown() bool {
+	return !_characteristicOperationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CharacteristicOperationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CharacteristicOperationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _characteristicOperationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// characteristicOperationTypeRead represents the "read" value.
+	characteristicOperationTypeRead CharacteristicOperationTypeEnum = iota + 1
+	// characteristicOperationTypeWrite represents the "write" value.
+	characteristicOperationTypeWrite
+	// characteristicOperationTypeSubscribeToNotifications represents the "subscribe-to-notifications" value.
+	characteristicOperationTypeSubscribeToNotifications
+	// characteristicOperationTypeUnsubscribeFromNotifications represents the "unsubscribe-from-notifications" value.
+	characteristicOperationTypeUnsubscribeFromNotifications
+)
+
+var _characteristicOperationTypeEnums = enums.New(map[CharacteristicOperationTypeEnum]string{
+	CharacteristicOperationTypeEnum(0):                      "",
+	characteristicOperationTypeRead:                         "read",
+	characteristicOperationTypeWrite:                        "write",
+	characteristicOperationTypeSubscribeToNotifications:     "subscribe-to-notifications",
+	characteristicOperationTypeUnsubscribeFromNotifications: "unsubscribe-from-notifications",
+})
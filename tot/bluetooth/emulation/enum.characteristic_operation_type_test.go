@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCharacteristicOperationType(t *testing.T) {
+	var enum CharacteristicOperationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CharacteristicOperationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CharacteristicOperationType.Read
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"read"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"read"`, result)
+	}
+	json.Unmarshal([]byte(`"read"`), &enum)
+	if CharacteristicOperationType.Read != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicOperationType.Read, enum)
+	}
+
+	enum = CharacteristicOperationType.Write
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"write"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"write"`, result)
+	}
+	json.Unmarshal([]byte(`"write"`), &enum)
+	if CharacteristicOperationType.Write != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicOperationType.Write, enum)
+	}
+
+	enum = CharacteristicOperationType.SubscribeToNotifications
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"subscribe-to-notifications"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"subscribe-to-notifications"`, result)
+	}
+	json.Unmarshal([]byte(`"subscribe-to-notifications"`), &enum)
+	if CharacteristicOperationType.SubscribeToNotifications != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicOperationType.SubscribeToNotifications, enum)
+	}
+
+	enum = CharacteristicOperationType.UnsubscribeFromNotifications
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unsubscribe-from-notifications"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unsubscribe-from-notifications"`, result)
+	}
+	json.Unmarshal([]byte(`"unsubscribe-from-notifications"`), &enum)
+	if CharacteristicOperationType.UnsubscribeFromNotifications != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicOperationType.UnsubscribeFromNotifications, enum)
+	}
+}
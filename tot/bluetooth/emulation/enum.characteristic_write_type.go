@@ -0,0 +1,91 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type characteristicWriteTypeEnum struct {
+	WriteDefaultDeprecated CharacteristicWriteTypeEnum
+	WriteWithResponse      CharacteristicWriteTypeEnum
+	WriteWithoutResponse   CharacteristicWriteTypeEnum
+}
+
+/*
+CharacteristicWriteType provides named access to the CharacteristicWriteTypeEnum values.
+*/
+var CharacteristicWriteType = characteristicWriteTypeEnum{
+	WriteDefaultDeprecated: characteristicWriteTypeWriteDefaultDeprecated,
+	WriteWithResponse:      characteristicWriteTypeWriteWithResponse,
+	WriteWithoutResponse:   characteristicWriteTypeWriteWithoutResponse,
+}
+
+/*
+CharacteristicWriteTypeEnum represents the
+BluetoothEmulation.CharacteristicWriteType values.
+
+Indicates the various types of characteristic write.
+
+Allowed values:
+  - CharacteristicWriteType.WriteDefaultDeprecated "write-default-deprecated"
+  - CharacteristicWriteType.WriteWithResponse      "write-with-response"
+  - CharacteristicWriteType.WriteWithoutResponse   "write-without-response"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-CharacteristicWriteType
+*/
+type CharacteristicWriteTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CharacteristicWriteTypeEnum) String() string {
+	return _characteristicWriteTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CharacteristicWriteTypeEnum) Unknown() bool {
+	return !_characteristicWriteTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CharacteristicWriteTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CharacteristicWriteTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _characteristicWriteTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// characteristicWriteTypeWriteDefaultDeprecated represents the "write-default-deprecated" value.
+	characteristicWriteTypeWriteDefaultDeprecated CharacteristicWriteTypeEnum = iota + 1
+	// characteristicWriteTypeWriteWithResponse represents the "write-with-response" value.
+	characteristicWriteTypeWriteWithResponse
+	// characteristicWriteTypeWriteWithoutResponse represents the "write-without-response" value.
+	characteristicWriteTypeWriteWithoutResponse
+)
+
+var _characteristicWriteTypeEnums = enums.New(map[CharacteristicWriteTypeEnum]string{
+	CharacteristicWriteTypeEnum(0):                "",
+	characteristicWriteTypeWriteDefaultDeprecated: "write-default-deprecated",
+	characteristicWriteTypeWriteWithResponse:      "write-with-response",
+	characteristicWriteTypeWriteWithoutResponse:   "write-without-response",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCharacteristicWriteType(t *testing.T) {
+	var enum CharacteristicWriteTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CharacteristicWriteTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CharacteristicWriteType.WriteDefaultDeprecated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"write-default-deprecated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"write-default-deprecated"`, result)
+	}
+	json.Unmarshal([]byte(`"write-default-deprecated"`), &enum)
+	if CharacteristicWriteType.WriteDefaultDeprecated != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicWriteType.WriteDefaultDeprecated, enum)
+	}
+
+	enum = CharacteristicWriteType.WriteWithResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"write-with-response"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"write-with-response"`, result)
+	}
+	json.Unmarshal([]byte(`"write-with-response"`), &enum)
+	if CharacteristicWriteType.WriteWithResponse != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicWriteType.WriteWithResponse, enum)
+	}
+
+	enum = CharacteristicWriteType.WriteWithoutResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"write-without-response"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"write-without-response"`, result)
+	}
+	json.Unmarshal([]byte(`"write-without-response"`), &enum)
+	if CharacteristicWriteType.WriteWithoutResponse != enum {
+		t.Errorf("Expected %d, got %d", CharacteristicWriteType.WriteWithoutResponse, enum)
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type descriptorOperationTypeEnum struct {
+	Read  DescriptorOperationTypeEnum
+	Write DescriptorOperationTypeEnum
+}
+
+/*
+DescriptorOperationType provides named access to the DescriptorOperationTypeEnum values.
+*/
+var DescriptorOperationType = descriptorOperationTypeEnum{
+	Read:  descriptorOperationTypeRead,
+	Write: descriptorOperationTypeWrite,
+}
+
+/*
+DescriptorOperationTypeEnum represents the
+BluetoothEmulation.DescriptorOperationType values.
+
+Indicates the various types of descriptor operation.
+
+Allowed values:
+  - DescriptorOperationType.Read  "read"
+  - DescriptorOperationType.Write "write"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-DescriptorOperationType
+*/
+type DescriptorOperationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DescriptorOperationTypeEnum) String() string {
+	return _descriptorOperationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DescriptorOperationTypeEnum) Unknown() bool {
+	return !_descriptorOperationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DescriptorOperationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DescriptorOperationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _descriptorOperationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// descriptorOperationTypeRead represents the "read" value.
+	descriptorOperationTypeRead DescriptorOperationTypeEnum = iota + 1
+	// descriptorOperationTypeWrite represents the "write" value.
+	descriptorOperationTypeWrite
+)
+
+var _descriptorOperationTypeEnums = enums.New(map[DescriptorOperationTypeEnum]string{
+	DescriptorOperationTypeEnum(0): "",
+	descriptorOperationTypeRead:    "read",
+	descriptorOperationTypeWrite:   "write",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDescriptorOperationType(t *testing.T) {
+	var enum DescriptorOperationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DescriptorOperationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DescriptorOperationType.Read
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"read"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"read"`, result)
+	}
+	json.Unmarshal([]byte(`"read"`), &enum)
+	if DescriptorOperationType.Read != enum {
+		t.Errorf("Expected %d, got %d", DescriptorOperationType.Read, enum)
+	}
+
+	enum = DescriptorOperationType.Write
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"write"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"write"`, result)
+	}
+	json.Unmarshal([]byte(`"write"`), &enum)
+	if DescriptorOperationType.Write != enum {
+		t.Errorf("Expected %d, got %d", DescriptorOperationType.Write, enum)
+	}
+}
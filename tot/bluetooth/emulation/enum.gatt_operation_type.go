@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type gattOperationTypeEnum struct {
+	Connection GATTOperationTypeEnum
+	Discovery  GATTOperationTypeEnum
+}
+
+/*
+GATTOperationType provides named access to the GATTOperationTypeEnum values.
+*/
+var GATTOperationType = gattOperationTypeEnum{
+	Connection: gattOperationTypeConnection,
+	Discovery:  gattOperationTypeDiscovery,
+}
+
+/*
+GATTOperationTypeEnum represents the BluetoothEmulation.GATTOperationType
+values.
+
+Indicates the various types of GATT event.
+
+Allowed values:
+  - GATTOperationType.Connection "connection"
+  - GATTOperationType.Discovery  "discovery"
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#type-GATTOperationType
+*/
+type GATTOperationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum GATTOperationTypeEnum) String() string {
+	return _gattOperationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum GATTOperationTypeEnum) Unknown() bool {
+	return !_gattOperationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum GATTOperationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *GATTOperationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _gattOperationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// gattOperationTypeConnection represents the "connection" value.
+	gattOperationTypeConnection GATTOperationTypeEnum = iota + 1
+	// gattOperationTypeDiscovery represents the "discovery" value.
+	gattOperationTypeDiscovery
+)
+
+var _gattOperationTypeEnums = enums.New(map[GATTOperationTypeEnum]string{
+	GATTOperationTypeEnum(0):    "",
+	gattOperationTypeConnection: "connection",
+	gattOperationTypeDiscovery:  "discovery",
+})
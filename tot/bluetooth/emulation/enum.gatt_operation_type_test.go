@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumGATTOperationType(t *testing.T) {
+	var enum GATTOperationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown GATTOperationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = GATTOperationType.Connection
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"connection"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"connection"`, result)
+	}
+	json.Unmarshal([]byte(`"connection"`), &enum)
+	if GATTOperationType.Connection != enum {
+		t.Errorf("Expected %d, got %d", GATTOperationType.Connection, enum)
+	}
+
+	enum = GATTOperationType.Discovery
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"discovery"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"discovery"`, result)
+	}
+	json.Unmarshal([]byte(`"discovery"`), &enum)
+	if GATTOperationType.Discovery != enum {
+		t.Errorf("Expected %d, got %d", GATTOperationType.Discovery, enum)
+	}
+}
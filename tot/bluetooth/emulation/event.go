@@ -0,0 +1,68 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+/*
+GattOperationReceivedEvent represents BluetoothEmulation.gattOperationReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-gattOperationReceived
+*/
+type GattOperationReceivedEvent struct {
+	Address string `json:"address"`
+
+	// Allowed values:
+	//   - GATTOperationType.Connection
+	//   - GATTOperationType.Discovery
+	Type GATTOperationTypeEnum `json:"type"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CharacteristicOperationReceivedEvent represents BluetoothEmulation.characteristicOperationReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-characteristicOperationReceived
+*/
+type CharacteristicOperationReceivedEvent struct {
+	CharacteristicID string `json:"characteristicId"`
+
+	// Allowed values:
+	//   - CharacteristicOperationType.Read
+	//   - CharacteristicOperationType.Write
+	//   - CharacteristicOperationType.SubscribeToNotifications
+	//   - CharacteristicOperationType.UnsubscribeFromNotifications
+	Type CharacteristicOperationTypeEnum `json:"type"`
+
+	// Optional.
+	Data string `json:"data,omitempty"`
+
+	// Optional. Allowed values:
+	//   - CharacteristicWriteType.WriteDefaultDeprecated
+	//   - CharacteristicWriteType.WriteWithResponse
+	//   - CharacteristicWriteType.WriteWithoutResponse
+	WriteType CharacteristicWriteTypeEnum `json:"writeType,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DescriptorOperationReceivedEvent represents BluetoothEmulation.descriptorOperationReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-descriptorOperationReceived
+*/
+type DescriptorOperationReceivedEvent struct {
+	DescriptorID string `json:"descriptorId"`
+
+	// Allowed values:
+	//   - DescriptorOperationType.Read
+	//   - DescriptorOperationType.Write
+	Type DescriptorOperationTypeEnum `json:"type"`
+
+	// Optional.
+	Data string `json:"data,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,9 +1,152 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package browser
 
 import (
 	"github.com/mkenney/go-chrome/tot/target"
 )
 
+/*
+SetPermissionParams represents Browser.setPermission parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setPermission
+*/
+type SetPermissionParams struct {
+	// Descriptor of permission to override.
+	Permission *PermissionDescriptor `json:"permission"`
+
+	// Setting of the permission. Allowed values:
+	//   - PermissionSetting.Granted
+	//   - PermissionSetting.Denied
+	//   - PermissionSetting.Prompt
+	Setting PermissionSettingEnum `json:"setting"`
+
+	// Optional. Origin the permission applies to, all origins if not specified.
+	Origin *string `json:"origin,omitempty"`
+
+	// Optional. Context to override. When omitted, default browser context is
+	// used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+SetPermissionResult represents the result of calls to Browser.setPermission.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setPermission
+*/
+type SetPermissionResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GrantPermissionsParams represents Browser.grantPermissions parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-grantPermissions
+*/
+type GrantPermissionsParams struct {
+	Permissions []PermissionTypeEnum `json:"permissions"`
+
+	// Optional. Origin the permission applies to, all origins if not specified.
+	Origin *string `json:"origin,omitempty"`
+
+	// Optional. BrowserContext to override permissions. When omitted, default
+	// browser context is used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+GrantPermissionsResult represents the result of calls to Browser.grantPermissions.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-grantPermissions
+*/
+type GrantPermissionsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResetPermissionsParams represents Browser.resetPermissions parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-resetPermissions
+*/
+type ResetPermissionsParams struct {
+	// Optional. BrowserContext to reset permissions. When omitted, default browser
+	// context is used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+ResetPermissionsResult represents the result of calls to Browser.resetPermissions.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-resetPermissions
+*/
+type ResetPermissionsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDownloadBehaviorParams represents Browser.setDownloadBehavior parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDownloadBehavior
+*/
+type SetDownloadBehaviorParams struct {
+	// Whether to allow all or deny all download requests, or use default Chrome
+	// behavior if available (otherwise deny). |allowAndName| allows download and
+	// names files according to their download guids. Allowed values:
+	//   - Behavior.Deny
+	//   - Behavior.Allow
+	//   - Behavior.AllowAndName
+	//   - Behavior.Default
+	Behavior BehaviorEnum `json:"behavior"`
+
+	// Optional. BrowserContext to set download behavior. When omitted, default
+	// browser context is used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+
+	// Optional. The default path to save downloaded files to. This is required if
+	// behavior is set to 'allow' or 'allowAndName'.
+	DownloadPath *string `json:"downloadPath,omitempty"`
+
+	// Optional. Whether to emit download events (defaults to false).
+	EventsEnabled *bool `json:"eventsEnabled,omitempty"`
+}
+
+/*
+SetDownloadBehaviorResult represents the result of calls to Browser.setDownloadBehavior.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDownloadBehavior
+*/
+type SetDownloadBehaviorResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CancelDownloadParams represents Browser.cancelDownload parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-cancelDownload
+*/
+type CancelDownloadParams struct {
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+
+	// Optional. BrowserContext to perform the action in. When omitted, default
+	// browser context is used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+CancelDownloadResult represents the result of calls to Browser.cancelDownload.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-cancelDownload
+*/
+type CancelDownloadResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 CloseResult represents the result of calls to Browser.close.
 
@@ -14,6 +157,26 @@ type CloseResult struct {
 	Err error `json:"-"`
 }
 
+/*
+CrashResult represents the result of calls to Browser.crash.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-crash
+*/
+type CrashResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CrashGPUProcessResult represents the result of calls to Browser.crashGpuProcess.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-crashGpuProcess
+*/
+type CrashGPUProcessResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetVersionResult represents the result of calls to Browser.getVersion.
 
@@ -39,6 +202,73 @@ type GetVersionResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetBrowserCommandLineResult represents the result of calls to Browser.getBrowserCommandLine.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getBrowserCommandLine
+*/
+type GetBrowserCommandLineResult struct {
+	// Commandline parameters
+	Arguments []string `json:"arguments"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetHistogramsParams represents Browser.getHistograms parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistograms
+*/
+type GetHistogramsParams struct {
+	// Optional. Requested substring in name. Only histograms which have query as a
+	// substring in their name are extracted. An empty or absent query returns all
+	// histograms.
+	Query *string `json:"query,omitempty"`
+
+	// Optional. If true, retrieve delta since last delta call.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+/*
+GetHistogramsResult represents the result of calls to Browser.getHistograms.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistograms
+*/
+type GetHistogramsResult struct {
+	// Histograms.
+	Histograms []*Histogram `json:"histograms"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetHistogramParams represents Browser.getHistogram parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistogram
+*/
+type GetHistogramParams struct {
+	// Requested histogram name.
+	Name string `json:"name"`
+
+	// Optional. If true, retrieve delta since last delta call.
+	Delta *bool `json:"delta,omitempty"`
+}
+
+/*
+GetHistogramResult represents the result of calls to Browser.getHistogram.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistogram
+*/
+type GetHistogramResult struct {
+	// Histogram.
+	Histogram *Histogram `json:"histogram"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetWindowBoundsParams represents Browser.getWindowBounds parameters.
 
@@ -69,8 +299,9 @@ GetWindowForTargetParams represents Browser.getWindowForTarget parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowForTarget
 */
 type GetWindowForTargetParams struct {
-	// Devtools agent host id.
-	TargetID target.ID `json:"targetId"`
+	// Optional. Devtools agent host id. If called as a part of the session,
+	// associated targetId is used.
+	TargetID *target.ID `json:"targetId,omitempty"`
 }
 
 /*
@@ -79,7 +310,7 @@ GetWindowForTargetResult represents the result of calls to Browser.getWindowForT
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowForTarget
 */
 type GetWindowForTargetResult struct {
-	// Browser window ID.
+	// Browser window id.
 	WindowID WindowID `json:"windowId"`
 
 	// Bounds information of the window. When window state is 'minimized', the
@@ -111,14 +342,138 @@ SetWindowBoundsResult represents the result of calls to Browser.setWindowBounds.
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindowBounds
 */
 type SetWindowBoundsResult struct {
-	// Browser window ID.
+	// Browser window ID. DEPRECATED.
 	WindowID WindowID `json:"windowId"`
 
 	// New window bounds. The 'minimized', 'maximized' and 'fullscreen' states
 	// cannot be combined with 'left', 'top', 'width' or 'height'. Leaves
-	// unspecified fields unchanged.
+	// unspecified fields unchanged. DEPRECATED.
 	Bounds *Bounds `json:"bounds"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+SetContentsSizeParams represents Browser.setContentsSize parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setContentsSize
+*/
+type SetContentsSizeParams struct {
+	// Browser window id.
+	WindowID WindowID `json:"windowId"`
+
+	// Optional. The window contents width in DIP. Assumes current width if
+	// omitted. Must be specified if 'height' is omitted.
+	Width *int `json:"width,omitempty"`
+
+	// Optional. The window contents height in DIP. Assumes current height if
+	// omitted. Must be specified if 'width' is omitted.
+	Height *int `json:"height,omitempty"`
+}
+
+/*
+SetContentsSizeResult represents the result of calls to Browser.setContentsSize.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setContentsSize
+*/
+type SetContentsSizeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDockTileParams represents Browser.setDockTile parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDockTile
+*/
+type SetDockTileParams struct {
+	// Optional.
+	BadgeLabel *string `json:"badgeLabel,omitempty"`
+
+	// Optional. Png encoded image. (Encoded as a base64 string when passed over
+	// JSON)
+	Image *string `json:"image,omitempty"`
+}
+
+/*
+SetDockTileResult represents the result of calls to Browser.setDockTile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDockTile
+*/
+type SetDockTileResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ExecuteBrowserCommandParams represents Browser.executeBrowserCommand parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-executeBrowserCommand
+*/
+type ExecuteBrowserCommandParams struct {
+	// Allowed values:
+	//   - CommandID.OpenTabSearch
+	//   - CommandID.CloseTabSearch
+	//   - CommandID.OpenGlic
+	CommandID CommandIDEnum `json:"commandId"`
+}
+
+/*
+ExecuteBrowserCommandResult represents the result of calls to Browser.executeBrowserCommand.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-executeBrowserCommand
+*/
+type ExecuteBrowserCommandResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddPrivacySandboxEnrollmentOverrideParams represents Browser.addPrivacySandboxEnrollmentOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+type AddPrivacySandboxEnrollmentOverrideParams struct {
+	URL string `json:"url"`
+}
+
+/*
+AddPrivacySandboxEnrollmentOverrideResult represents the result of calls to Browser.addPrivacySandboxEnrollmentOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+type AddPrivacySandboxEnrollmentOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddPrivacySandboxCoordinatorKeyConfigParams represents Browser.addPrivacySandboxCoordinatorKeyConfig parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+type AddPrivacySandboxCoordinatorKeyConfigParams struct {
+	// Allowed values:
+	//   - PrivacySandboxAPI.BiddingAndAuctionServices
+	//   - PrivacySandboxAPI.TrustedKeyValue
+	API PrivacySandboxAPIEnum `json:"api"`
+
+	CoordinatorOrigin string `json:"coordinatorOrigin"`
+
+	KeyConfig string `json:"keyConfig"`
+
+	// Optional. BrowserContext to perform the action in. When omitted, default
+	// browser context is used.
+	BrowserContextID *ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+AddPrivacySandboxCoordinatorKeyConfigResult represents the result of calls to Browser.addPrivacySandboxCoordinatorKeyConfig.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+type AddPrivacySandboxCoordinatorKeyConfigResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
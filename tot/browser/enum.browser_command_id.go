@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type commandIDEnum struct {
+	OpenTabSearch  CommandIDEnum
+	CloseTabSearch CommandIDEnum
+	OpenGlic       CommandIDEnum
+}
+
+/*
+CommandID provides named access to the CommandIDEnum values.
+*/
+var CommandID = commandIDEnum{
+	OpenTabSearch:  commandIDOpenTabSearch,
+	CloseTabSearch: commandIDCloseTabSearch,
+	OpenGlic:       commandIDOpenGlic,
+}
+
+/*
+CommandIDEnum represents the Browser.BrowserCommandId values.
+
+Browser command ids used by executeBrowserCommand.
+
+Allowed values:
+  - CommandID.OpenTabSearch  "openTabSearch"
+  - CommandID.CloseTabSearch "closeTabSearch"
+  - CommandID.OpenGlic       "openGlic"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#type-BrowserCommandId
+*/
+type CommandIDEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CommandIDEnum) String() string {
+	return _commandIDEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CommandIDEnum) Unknown() bool {
+	return !_commandIDEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CommandIDEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CommandIDEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _commandIDEnums.Parse(val)
+	return nil
+}
+
+const (
+	// commandIDOpenTabSearch represents the "openTabSearch" value.
+	commandIDOpenTabSearch CommandIDEnum = iota + 1
+	// commandIDCloseTabSearch represents the "closeTabSearch" value.
+	commandIDCloseTabSearch
+	// commandIDOpenGlic represents the "openGlic" value.
+	commandIDOpenGlic
+)
+
+var _commandIDEnums = enums.New(map[CommandIDEnum]string{
+	CommandIDEnum(0):        "",
+	commandIDOpenTabSearch:  "openTabSearch",
+	commandIDCloseTabSearch: "closeTabSearch",
+	commandIDOpenGlic:       "openGlic",
+})
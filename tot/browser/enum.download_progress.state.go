@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type stateEnum struct {
+	InProgress StateEnum
+	Completed  StateEnum
+	Canceled   StateEnum
+}
+
+/*
+State provides named access to the StateEnum values.
+*/
+var State = stateEnum{
+	InProgress: stateInProgress,
+	Completed:  stateCompleted,
+	Canceled:   stateCanceled,
+}
+
+/*
+StateEnum represents the Browser.downloadProgress.state values.
+
+Download status.
+
+Allowed values:
+  - State.InProgress "inProgress"
+  - State.Completed  "completed"
+  - State.Canceled   "canceled"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#event-downloadProgress
+*/
+type StateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StateEnum) String() string {
+	return _stateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StateEnum) Unknown() bool {
+	return !_stateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _stateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// stateInProgress represents the "inProgress" value.
+	stateInProgress StateEnum = iota + 1
+	// stateCompleted represents the "completed" value.
+	stateCompleted
+	// stateCanceled represents the "canceled" value.
+	stateCanceled
+)
+
+var _stateEnums = enums.New(map[StateEnum]string{
+	StateEnum(0):    "",
+	stateInProgress: "inProgress",
+	stateCompleted:  "completed",
+	stateCanceled:   "canceled",
+})
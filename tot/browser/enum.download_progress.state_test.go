@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumState(t *testing.T) {
+	var enum StateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = State.InProgress
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"inProgress"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"inProgress"`, result)
+	}
+	json.Unmarshal([]byte(`"inProgress"`), &enum)
+	if State.InProgress != enum {
+		t.Errorf("Expected %d, got %d", State.InProgress, enum)
+	}
+
+	enum = State.Completed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"completed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"completed"`, result)
+	}
+	json.Unmarshal([]byte(`"completed"`), &enum)
+	if State.Completed != enum {
+		t.Errorf("Expected %d, got %d", State.Completed, enum)
+	}
+
+	enum = State.Canceled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"canceled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"canceled"`, result)
+	}
+	json.Unmarshal([]byte(`"canceled"`), &enum)
+	if State.Canceled != enum {
+		t.Errorf("Expected %d, got %d", State.Canceled, enum)
+	}
+}
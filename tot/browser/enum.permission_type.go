@@ -0,0 +1,292 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type permissionTypeEnum struct {
+	Ar                       PermissionTypeEnum
+	AudioCapture             PermissionTypeEnum
+	AutomaticFullscreen      PermissionTypeEnum
+	BackgroundFetch          PermissionTypeEnum
+	BackgroundSync           PermissionTypeEnum
+	CameraPanTiltZoom        PermissionTypeEnum
+	CapturedSurfaceControl   PermissionTypeEnum
+	ClipboardReadWrite       PermissionTypeEnum
+	ClipboardSanitizedWrite  PermissionTypeEnum
+	DisplayCapture           PermissionTypeEnum
+	DurableStorage           PermissionTypeEnum
+	Geolocation              PermissionTypeEnum
+	HandTracking             PermissionTypeEnum
+	IdleDetection            PermissionTypeEnum
+	KeyboardLock             PermissionTypeEnum
+	LocalFonts               PermissionTypeEnum
+	LocalNetworkAccess       PermissionTypeEnum
+	Midi                     PermissionTypeEnum
+	MidiSysex                PermissionTypeEnum
+	Nfc                      PermissionTypeEnum
+	Notifications            PermissionTypeEnum
+	PaymentHandler           PermissionTypeEnum
+	PeriodicBackgroundSync   PermissionTypeEnum
+	PointerLock              PermissionTypeEnum
+	ProtectedMediaIdentifier PermissionTypeEnum
+	Sensors                  PermissionTypeEnum
+	SmartCard                PermissionTypeEnum
+	SpeakerSelection         PermissionTypeEnum
+	StorageAccess            PermissionTypeEnum
+	TopLevelStorageAccess    PermissionTypeEnum
+	VideoCapture             PermissionTypeEnum
+	Vr                       PermissionTypeEnum
+	WakeLockScreen           PermissionTypeEnum
+	WakeLockSystem           PermissionTypeEnum
+	WebAppInstallation       PermissionTypeEnum
+	WebPrinting              PermissionTypeEnum
+	WindowManagement         PermissionTypeEnum
+}
+
+/*
+PermissionType provides named access to the PermissionTypeEnum values.
+*/
+var PermissionType = permissionTypeEnum{
+	Ar:                       permissionTypeAr,
+	AudioCapture:             permissionTypeAudioCapture,
+	AutomaticFullscreen:      permissionTypeAutomaticFullscreen,
+	BackgroundFetch:          permissionTypeBackgroundFetch,
+	BackgroundSync:           permissionTypeBackgroundSync,
+	CameraPanTiltZoom:        permissionTypeCameraPanTiltZoom,
+	CapturedSurfaceControl:   permissionTypeCapturedSurfaceControl,
+	ClipboardReadWrite:       permissionTypeClipboardReadWrite,
+	ClipboardSanitizedWrite:  permissionTypeClipboardSanitizedWrite,
+	DisplayCapture:           permissionTypeDisplayCapture,
+	DurableStorage:           permissionTypeDurableStorage,
+	Geolocation:              permissionTypeGeolocation,
+	HandTracking:             permissionTypeHandTracking,
+	IdleDetection:            permissionTypeIdleDetection,
+	KeyboardLock:             permissionTypeKeyboardLock,
+	LocalFonts:               permissionTypeLocalFonts,
+	LocalNetworkAccess:       permissionTypeLocalNetworkAccess,
+	Midi:                     permissionTypeMidi,
+	MidiSysex:                permissionTypeMidiSysex,
+	Nfc:                      permissionTypeNfc,
+	Notifications:            permissionTypeNotifications,
+	PaymentHandler:           permissionTypePaymentHandler,
+	PeriodicBackgroundSync:   permissionTypePeriodicBackgroundSync,
+	PointerLock:              permissionTypePointerLock,
+	ProtectedMediaIdentifier: permissionTypeProtectedMediaIdentifier,
+	Sensors:                  permissionTypeSensors,
+	SmartCard:                permissionTypeSmartCard,
+	SpeakerSelection:         permissionTypeSpeakerSelection,
+	StorageAccess:            permissionTypeStorageAccess,
+	TopLevelStorageAccess:    permissionTypeTopLevelStorageAccess,
+	VideoCapture:             permissionTypeVideoCapture,
+	Vr:                       permissionTypeVr,
+	WakeLockScreen:           permissionTypeWakeLockScreen,
+	WakeLockSystem:           permissionTypeWakeLockSystem,
+	WebAppInstallation:       permissionTypeWebAppInstallation,
+	WebPrinting:              permissionTypeWebPrinting,
+	WindowManagement:         permissionTypeWindowManagement,
+}
+
+/*
+PermissionTypeEnum represents the Browser.PermissionType values.
+
+Allowed values:
+  - PermissionType.Ar                       "ar"
+  - PermissionType.AudioCapture             "audioCapture"
+  - PermissionType.AutomaticFullscreen      "automaticFullscreen"
+  - PermissionType.BackgroundFetch          "backgroundFetch"
+  - PermissionType.BackgroundSync           "backgroundSync"
+  - PermissionType.CameraPanTiltZoom        "cameraPanTiltZoom"
+  - PermissionType.CapturedSurfaceControl   "capturedSurfaceControl"
+  - PermissionType.ClipboardReadWrite       "clipboardReadWrite"
+  - PermissionType.ClipboardSanitizedWrite  "clipboardSanitizedWrite"
+  - PermissionType.DisplayCapture           "displayCapture"
+  - PermissionType.DurableStorage           "durableStorage"
+  - PermissionType.Geolocation              "geolocation"
+  - PermissionType.HandTracking             "handTracking"
+  - PermissionType.IdleDetection            "idleDetection"
+  - PermissionType.KeyboardLock             "keyboardLock"
+  - PermissionType.LocalFonts               "localFonts"
+  - PermissionType.LocalNetworkAccess       "localNetworkAccess"
+  - PermissionType.Midi                     "midi"
+  - PermissionType.MidiSysex                "midiSysex"
+  - PermissionType.Nfc                      "nfc"
+  - PermissionType.Notifications            "notifications"
+  - PermissionType.PaymentHandler           "paymentHandler"
+  - PermissionType.PeriodicBackgroundSync   "periodicBackgroundSync"
+  - PermissionType.PointerLock              "pointerLock"
+  - PermissionType.ProtectedMediaIdentifier "protectedMediaIdentifier"
+  - PermissionType.Sensors                  "sensors"
+  - PermissionType.SmartCard                "smartCard"
+  - PermissionType.SpeakerSelection         "speakerSelection"
+  - PermissionType.StorageAccess            "storageAccess"
+  - PermissionType.TopLevelStorageAccess    "topLevelStorageAccess"
+  - PermissionType.VideoCapture             "videoCapture"
+  - PermissionType.Vr                       "vr"
+  - PermissionType.WakeLockScreen           "wakeLockScreen"
+  - PermissionType.WakeLockSystem           "wakeLockSystem"
+  - PermissionType.WebAppInstallation       "webAppInstallation"
+  - PermissionType.WebPrinting              "webPrinting"
+  - PermissionType.WindowManagement         "windowManagement"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#type-PermissionType
+*/
+type PermissionTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PermissionTypeEnum) String() string {
+	return _permissionTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PermissionTypeEnum) Unknown() bool {
+	return !_permissionTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PermissionTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PermissionTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _permissionTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// permissionTypeAr represents the "ar" value.
+	permissionTypeAr PermissionTypeEnum = iota + 1
+	// permissionTypeAudioCapture represents the "audioCapture" value.
+	permissionTypeAudioCapture
+	// permissionTypeAutomaticFullscreen represents the "automaticFullscreen" value.
+	permissionTypeAutomaticFullscreen
+	// permissionTypeBackgroundFetch represents the "backgroundFetch" value.
+	permissionTypeBackgroundFetch
+	// permissionTypeBackgroundSync represents the "backgroundSync" value.
+	permissionTypeBackgroundSync
+	// permissionTypeCameraPanTiltZoom represents the "cameraPanTiltZoom" value.
+	permissionTypeCameraPanTiltZoom
+	// permissionTypeCapturedSurfaceControl represents the "capturedSurfaceControl" value.
+	permissionTypeCapturedSurfaceControl
+	// permissionTypeClipboardReadWrite represents the "clipboardReadWrite" value.
+	permissionTypeClipboardReadWrite
+	// permissionTypeClipboardSanitizedWrite represents the "clipboardSanitizedWrite" value.
+	permissionTypeClipboardSanitizedWrite
+	// permissionTypeDisplayCapture represents the "displayCapture" value.
+	permissionTypeDisplayCapture
+	// permissionTypeDurableStorage represents the "durableStorage" value.
+	permissionTypeDurableStorage
+	// permissionTypeGeolocation represents the "geolocation" value.
+	permissionTypeGeolocation
+	// permissionTypeHandTracking represents the "handTracking" value.
+	permissionTypeHandTracking
+	// permissionTypeIdleDetection represents the "idleDetection" value.
+	permissionTypeIdleDetection
+	// permissionTypeKeyboardLock represents the "keyboardLock" value.
+	permissionTypeKeyboardLock
+	// permissionTypeLocalFonts represents the "localFonts" value.
+	permissionTypeLocalFonts
+	// permissionTypeLocalNetworkAccess represents the "localNetworkAccess" value.
+	permissionTypeLocalNetworkAccess
+	// permissionTypeMidi represents the "midi" value.
+	permissionTypeMidi
+	// permissionTypeMidiSysex represents the "midiSysex" value.
+	permissionTypeMidiSysex
+	// permissionTypeNfc represents the "nfc" value.
+	permissionTypeNfc
+	// permissionTypeNotifications represents the "notifications" value.
+	permissionTypeNotifications
+	// permissionTypePaymentHandler represents the "paymentHandler" value.
+	permissionTypePaymentHandler
+	// permissionTypePeriodicBackgroundSync represents the "periodicBackgroundSync" value.
+	permissionTypePeriodicBackgroundSync
+	// permissionTypePointerLock represents the "pointerLock" value.
+	permissionTypePointerLock
+	// permissionTypeProtectedMediaIdentifier represents the "protectedMediaIdentifier" value.
+	permissionTypeProtectedMediaIdentifier
+	// permissionTypeSensors represents the "sensors" value.
+	permissionTypeSensors
+	// permissionTypeSmartCard represents the "smartCard" value.
+	permissionTypeSmartCard
+	// permissionTypeSpeakerSelection represents the "speakerSelection" value.
+	permissionTypeSpeakerSelection
+	// permissionTypeStorageAccess represents the "storageAccess" value.
+	permissionTypeStorageAccess
+	// permissionTypeTopLevelStorageAccess represents the "topLevelStorageAccess" value.
+	permissionTypeTopLevelStorageAccess
+	// permissionTypeVideoCapture represents the "videoCapture" value.
+	permissionTypeVideoCapture
+	// permissionTypeVr represents the "vr" value.
+	permissionTypeVr
+	// permissionTypeWakeLockScreen represents the "wakeLockScreen" value.
+	permissionTypeWakeLockScreen
+	// permissionTypeWakeLockSystem represents the "wakeLockSystem" value.
+	permissionTypeWakeLockSystem
+	// permissionTypeWebAppInstallation represents the "webAppInstallation" value.
+	permissionTypeWebAppInstallation
+	// permissionTypeWebPrinting represents the "webPrinting" value.
+	permissionTypeWebPrinting
+	// permissionTypeWindowManagement represents the "windowManagement" value.
+	permissionTypeWindowManagement
+)
+
+var _permissionTypeEnums = enums.New(map[PermissionTypeEnum]string{
+	PermissionTypeEnum(0):                  "",
+	permissionTypeAr:                       "ar",
+	permissionTypeAudioCapture:             "audioCapture",
+	permissionTypeAutomaticFullscreen:      "automaticFullscreen",
+	permissionTypeBackgroundFetch:          "backgroundFetch",
+	permissionTypeBackgroundSync:           "backgroundSync",
+	permissionTypeCameraPanTiltZoom:        "cameraPanTiltZoom",
+	permissionTypeCapturedSurfaceControl:   "capturedSurfaceControl",
+	permissionTypeClipboardReadWrite:       "clipboardReadWrite",
+	permissionTypeClipboardSanitizedWrite:  "clipboardSanitizedWrite",
+	permissionTypeDisplayCapture:           "displayCapture",
+	permissionTypeDurableStorage:           "durableStorage",
+	permissionTypeGeolocation:              "geolocation",
+	permissionTypeHandTracking:             "handTracking",
+	permissionTypeIdleDetection:            "idleDetection",
+	permissionTypeKeyboardLock:             "keyboardLock",
+	permissionTypeLocalFonts:               "localFonts",
+	permissionTypeLocalNetworkAccess:       "localNetworkAccess",
+	permissionTypeMidi:                     "midi",
+	permissionTypeMidiSysex:                "midiSysex",
+	permissionTypeNfc:                      "nfc",
+	permissionTypeNotifications:            "notifications",
+	permissionTypePaymentHandler:           "paymentHandler",
+	permissionTypePeriodicBackgroundSync:   "periodicBackgroundSync",
+	permissionTypePointerLock:              "pointerLock",
+	permissionTypeProtectedMediaIdentifier: "protectedMediaIdentifier",
+	permissionTypeSensors:                  "sensors",
+	permissionTypeSmartCard:                "smartCard",
+	permissionTypeSpeakerSelection:         "speakerSelection",
+	permissionTypeStorageAccess:            "storageAccess",
+	permissionTypeTopLevelStorageAccess:    "topLevelStorageAccess",
+	permissionTypeVideoCapture:             "videoCapture",
+	permissionTypeVr:                       "vr",
+	permissionTypeWakeLockScreen:           "wakeLockScreen",
+	permissionTypeWakeLockSystem:           "wakeLockSystem",
+	permissionTypeWebAppInstallation:       "webAppInstallation",
+	permissionTypeWebPrinting:              "webPrinting",
+	permissionTypeWindowManagement:         "windowManagement",
+})
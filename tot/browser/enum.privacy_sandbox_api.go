@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type privacySandboxAPIEnum struct {
+	BiddingAndAuctionServices PrivacySandboxAPIEnum
+	TrustedKeyValue           PrivacySandboxAPIEnum
+}
+
+/*
+PrivacySandboxAPI provides named access to the PrivacySandboxAPIEnum values.
+*/
+var PrivacySandboxAPI = privacySandboxAPIEnum{
+	BiddingAndAuctionServices: privacySandboxAPIBiddingAndAuctionServices,
+	TrustedKeyValue:           privacySandboxAPITrustedKeyValue,
+}
+
+/*
+PrivacySandboxAPIEnum represents the Browser.PrivacySandboxAPI values.
+
+Allowed values:
+  - PrivacySandboxAPI.BiddingAndAuctionServices "BiddingAndAuctionServices"
+  - PrivacySandboxAPI.TrustedKeyValue           "TrustedKeyValue"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#type-PrivacySandboxAPI
+*/
+type PrivacySandboxAPIEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PrivacySandboxAPIEnum) String() string {
+	return _privacySandboxAPIEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PrivacySandboxAPIEnum) Unknown() bool {
+	return !_privacySandboxAPIEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PrivacySandboxAPIEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PrivacySandboxAPIEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _privacySandboxAPIEnums.Parse(val)
+	return nil
+}
+
+const (
+	// privacySandboxAPIBiddingAndAuctionServices represents the "BiddingAndAuctionServices" value.
+	privacySandboxAPIBiddingAndAuctionServices PrivacySandboxAPIEnum = iota + 1
+	// privacySandboxAPITrustedKeyValue represents the "TrustedKeyValue" value.
+	privacySandboxAPITrustedKeyValue
+)
+
+var _privacySandboxAPIEnums = enums.New(map[PrivacySandboxAPIEnum]string{
+	PrivacySandboxAPIEnum(0):                   "",
+	privacySandboxAPIBiddingAndAuctionServices: "BiddingAndAuctionServices",
+	privacySandboxAPITrustedKeyValue:           "TrustedKeyValue",
+})
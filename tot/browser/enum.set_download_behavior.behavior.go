@@ -0,0 +1,98 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type behaviorEnum struct {
+	Deny         BehaviorEnum
+	Allow        BehaviorEnum
+	AllowAndName BehaviorEnum
+	Default      BehaviorEnum
+}
+
+/*
+Behavior provides named access to the BehaviorEnum values.
+*/
+var Behavior = behaviorEnum{
+	Deny:         behaviorDeny,
+	Allow:        behaviorAllow,
+	AllowAndName: behaviorAllowAndName,
+	Default:      behaviorDefault,
+}
+
+/*
+BehaviorEnum represents the Browser.setDownloadBehavior.behavior values.
+
+Whether to allow all or deny all download requests, or use default Chrome
+behavior if available (otherwise deny). |allowAndName| allows download and names
+files according to their download guids.
+
+Allowed values:
+  - Behavior.Deny         "deny"
+  - Behavior.Allow        "allow"
+  - Behavior.AllowAndName "allowAndName"
+  - Behavior.Default      "default"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDownloadBehavior
+*/
+type BehaviorEnum int
+
+/*
+String implements Stringer
+*/
+func (enum BehaviorEnum) String() string {
+	return _behaviorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BehaviorEnum) Unknown() bool {
+	return !_behaviorEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum BehaviorEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *BehaviorEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _behaviorEnums.Parse(val)
+	return nil
+}
+
+const (
+	// behaviorDeny represents the "deny" value.
+	behaviorDeny BehaviorEnum = iota + 1
+	// behaviorAllow represents the "allow" value.
+	behaviorAllow
+	// behaviorAllowAndName represents the "allowAndName" value.
+	behaviorAllowAndName
+	// behaviorDefault represents the "default" value.
+	behaviorDefault
+)
+
+var _behaviorEnums = enums.New(map[BehaviorEnum]string{
+	BehaviorEnum(0):      "",
+	behaviorDeny:         "deny",
+	behaviorAllow:        "allow",
+	behaviorAllowAndName: "allowAndName",
+	behaviorDefault:      "default",
+})
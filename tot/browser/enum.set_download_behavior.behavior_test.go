@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBehavior(t *testing.T) {
+	var enum BehaviorEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BehaviorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Behavior.Deny
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deny"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deny"`, result)
+	}
+	json.Unmarshal([]byte(`"deny"`), &enum)
+	if Behavior.Deny != enum {
+		t.Errorf("Expected %d, got %d", Behavior.Deny, enum)
+	}
+
+	enum = Behavior.Allow
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"allow"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"allow"`, result)
+	}
+	json.Unmarshal([]byte(`"allow"`), &enum)
+	if Behavior.Allow != enum {
+		t.Errorf("Expected %d, got %d", Behavior.Allow, enum)
+	}
+
+	enum = Behavior.AllowAndName
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"allowAndName"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"allowAndName"`, result)
+	}
+	json.Unmarshal([]byte(`"allowAndName"`), &enum)
+	if Behavior.AllowAndName != enum {
+		t.Errorf("Expected %d, got %d", Behavior.AllowAndName, enum)
+	}
+
+	enum = Behavior.Default
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"default"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"default"`, result)
+	}
+	json.Unmarshal([]byte(`"default"`), &enum)
+	if Behavior.Default != enum {
+		t.Errorf("Expected %d, got %d", Behavior.Default, enum)
+	}
+}
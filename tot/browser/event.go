@@ -0,0 +1,60 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package browser
+
+import (
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+DownloadWillBeginEvent represents Browser.downloadWillBegin event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#event-downloadWillBegin
+*/
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused the download to begin.
+	FrameID page.FrameID `json:"frameId"`
+
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+
+	// Suggested file name of the resource (the actual name of the file saved on
+	// disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DownloadProgressEvent represents Browser.downloadProgress event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#event-downloadProgress
+*/
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+
+	// Download status. Allowed values:
+	//   - State.InProgress
+	//   - State.Completed
+	//   - State.Canceled
+	State StateEnum `json:"state"`
+
+	// Optional. If download is "completed", provides the path of the downloaded
+	// file. Depending on the platform, it is not guaranteed to be set, nor the
+	// file is guaranteed to exist. EXPERIMENTAL.
+	FilePath string `json:"filePath,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,19 +1,31 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package storage provides type definitions for use with the Chrome CacheStorage protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/
 */
 package storage
 
+import (
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
 /*
-CacheID is the unique identifier of the Cache object.
+CacheID represents the CacheStorage.CacheId type.
+
+Unique identifier of the Cache object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-CacheId
 */
 type CacheID string
 
 /*
-DataEntry is a data entry.
+DataEntry represents the CacheStorage.DataEntry type.
+
+Data entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-DataEntry
 */
@@ -24,7 +36,7 @@ type DataEntry struct {
 	// Request method.
 	RequestMethod string `json:"requestMethod"`
 
-	// Request headers.
+	// Request headers
 	RequestHeaders []*Header `json:"requestHeaders"`
 
 	// Number of seconds since epoch.
@@ -36,45 +48,63 @@ type DataEntry struct {
 	// HTTP response status text.
 	ResponseStatusText string `json:"responseStatusText"`
 
-	// Response headers.
+	// HTTP response type Allowed values:
+	//   - CachedResponseType.Basic
+	//   - CachedResponseType.Cors
+	//   - CachedResponseType.Default
+	//   - CachedResponseType.Error
+	//   - CachedResponseType.OpaqueResponse
+	//   - CachedResponseType.OpaqueRedirect
+	ResponseType CachedResponseTypeEnum `json:"responseType"`
+
+	// Response headers
 	ResponseHeaders []*Header `json:"responseHeaders"`
 }
 
 /*
-Cache is a cache identifier.
+Cache represents the CacheStorage.Cache type.
+
+Cache identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-Cache
 */
 type Cache struct {
-	// An opaque unique ID of the cache.
+	// An opaque unique id of the cache.
 	CacheID CacheID `json:"cacheId"`
 
 	// Security origin of the cache.
 	SecurityOrigin string `json:"securityOrigin"`
 
+	// Storage key of the cache.
+	StorageKey string `json:"storageKey"`
+
+	// Optional. Storage bucket of the cache.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
+
 	// The name of the cache.
 	CacheName string `json:"cacheName"`
 }
 
 /*
-Header is a single header value
+Header represents the CacheStorage.Header type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-Header
 */
 type Header struct {
-	// Header name
 	Name string `json:"name"`
 
-	// Header value
 	Value string `json:"value"`
 }
 
 /*
-CachedResponse represents a cached response
+CachedResponse represents the CacheStorage.CachedResponse type.
+
+# Cached response
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-CachedResponse
 */
 type CachedResponse struct {
-	// Entry content, base64-encoded.
+	// Entry content, base64-encoded. (Encoded as a base64 string when passed over
+	// JSON)
 	Body string `json:"body"`
 }
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cachedResponseTypeEnum struct {
+	Basic          CachedResponseTypeEnum
+	Cors           CachedResponseTypeEnum
+	Default        CachedResponseTypeEnum
+	Error          CachedResponseTypeEnum
+	OpaqueResponse CachedResponseTypeEnum
+	OpaqueRedirect CachedResponseTypeEnum
+}
+
+/*
+CachedResponseType provides named access to the CachedResponseTypeEnum values.
+*/
+var CachedResponseType = cachedResponseTypeEnum{
+	Basic:          cachedResponseTypeBasic,
+	Cors:           cachedResponseTypeCors,
+	Default:        cachedResponseTypeDefault,
+	Error:          cachedResponseTypeError,
+	OpaqueResponse: cachedResponseTypeOpaqueResponse,
+	OpaqueRedirect: cachedResponseTypeOpaqueRedirect,
+}
+
+/*
+CachedResponseTypeEnum represents the CacheStorage.CachedResponseType values.
+
+type of HTTP response cached
+
+Allowed values:
+  - CachedResponseType.Basic          "basic"
+  - CachedResponseType.Cors           "cors"
+  - CachedResponseType.Default        "default"
+  - CachedResponseType.Error          "error"
+  - CachedResponseType.OpaqueResponse "opaqueResponse"
+  - CachedResponseType.OpaqueRedirect "opaqueRedirect"
+
+https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#type-CachedResponseType
+*/
+type CachedResponseTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CachedResponseTypeEnum) String() string {
+	return _cachedResponseTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CachedResponseTypeEnum) Unknown() bool {
+	return !_cachedResponseTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CachedResponseTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CachedResponseTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cachedResponseTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cachedResponseTypeBasic represents the "basic" value.
+	cachedResponseTypeBasic CachedResponseTypeEnum = iota + 1
+	// cachedResponseTypeCors represents the "cors" value.
+	cachedResponseTypeCors
+	// cachedResponseTypeDefault represents the "default" value.
+	cachedResponseTypeDefault
+	// cachedResponseTypeError represents the "error" value.
+	cachedResponseTypeError
+	// cachedResponseTypeOpaqueResponse represents the "opaqueResponse" value.
+	cachedResponseTypeOpaqueResponse
+	// cachedResponseTypeOpaqueRedirect represents the "opaqueRedirect" value.
+	cachedResponseTypeOpaqueRedirect
+)
+
+var _cachedResponseTypeEnums = enums.New(map[CachedResponseTypeEnum]string{
+	CachedResponseTypeEnum(0):        "",
+	cachedResponseTypeBasic:          "basic",
+	cachedResponseTypeCors:           "cors",
+	cachedResponseTypeDefault:        "default",
+	cachedResponseTypeError:          "error",
+	cachedResponseTypeOpaqueResponse: "opaqueResponse",
+	cachedResponseTypeOpaqueRedirect: "opaqueRedirect",
+})
@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCachedResponseType(t *testing.T) {
+	var enum CachedResponseTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CachedResponseTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CachedResponseType.Basic
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"basic"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"basic"`, result)
+	}
+	json.Unmarshal([]byte(`"basic"`), &enum)
+	if CachedResponseType.Basic != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.Basic, enum)
+	}
+
+	enum = CachedResponseType.Cors
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cors"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cors"`, result)
+	}
+	json.Unmarshal([]byte(`"cors"`), &enum)
+	if CachedResponseType.Cors != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.Cors, enum)
+	}
+
+	enum = CachedResponseType.Default
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"default"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"default"`, result)
+	}
+	json.Unmarshal([]byte(`"default"`), &enum)
+	if CachedResponseType.Default != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.Default, enum)
+	}
+
+	enum = CachedResponseType.Error
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"error"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"error"`, result)
+	}
+	json.Unmarshal([]byte(`"error"`), &enum)
+	if CachedResponseType.Error != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.Error, enum)
+	}
+
+	enum = CachedResponseType.OpaqueResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"opaqueResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"opaqueResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"opaqueResponse"`), &enum)
+	if CachedResponseType.OpaqueResponse != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.OpaqueResponse, enum)
+	}
+
+	enum = CachedResponseType.OpaqueRedirect
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"opaqueRedirect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"opaqueRedirect"`, result)
+	}
+	json.Unmarshal([]byte(`"opaqueRedirect"`), &enum)
+	if CachedResponseType.OpaqueRedirect != enum {
+		t.Errorf("Expected %d, got %d", CachedResponseType.OpaqueRedirect, enum)
+	}
+}
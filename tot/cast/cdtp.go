@@ -0,0 +1,26 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package cast provides type definitions for use with the Chrome Cast protocol
+
+A domain for interacting with Cast, Presentation API, and Remote Playback API
+functionalities. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/
+*/
+package cast
+
+/*
+Sink represents the Cast.Sink type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#type-Sink
+*/
+type Sink struct {
+	Name string `json:"name"`
+
+	ID string `json:"id"`
+
+	// Optional. Text describing the current session. Present only if there is an
+	// active session on the sink.
+	Session string `json:"session,omitempty"`
+}
@@ -0,0 +1,27 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cast
+
+/*
+SinksUpdatedEvent represents Cast.sinksUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#event-sinksUpdated
+*/
+type SinksUpdatedEvent struct {
+	Sinks []*Sink `json:"sinks"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+IssueUpdatedEvent represents Cast.issueUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#event-issueUpdated
+*/
+type IssueUpdatedEvent struct {
+	IssueMessage string `json:"issueMessage"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
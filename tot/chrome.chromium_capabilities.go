@@ -0,0 +1,52 @@
+package chrome
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+Capabilities returns the protocol description of the browser read by
+DetectCapabilities, or nil if it has not been read.
+*/
+func (chrome *Chrome) Capabilities() *socket.Capabilities {
+	chrome.connMux.Lock()
+	defer chrome.connMux.Unlock()
+	return chrome.capabilities
+}
+
+/*
+DetectCapabilities implements Chromium.
+
+The protocol description is read over the browser connection and cached. The
+browser connection, flattened session tabs and tabs opened afterwards check
+commands and events against it, so methods the browser doesn't support fail
+fast with a codes.SocketMethodUnsupported error naming the method and the
+browser version. Tabs that are already open are updated as well. Use
+Tab.Supports() to choose a fallback:
+
+	if tab.Supports("Page.printToPDF") {
+		...
+	}
+*/
+func (chrome *Chrome) DetectCapabilities(ctx context.Context) (*socket.Capabilities, error) {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, err
+	}
+	caps, err := browser.DetectCapabilities(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	chrome.connMux.Lock()
+	chrome.capabilities = caps
+	chrome.connMux.Unlock()
+	for _, tab := range chrome.Tabs() {
+		if tabSocket, ok := tab.Socket().(*socket.Socket); ok {
+			tabSocket.SetCapabilities(caps)
+		}
+	}
+	return caps, nil
+}
@@ -0,0 +1,152 @@
+//cdtpgen:experimental
+
+package chrome
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/optional"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+BrowserContext is an isolated browser context, similar to an incognito
+profile. Tabs opened in a context share its cookies and cache, which aren't
+shared with the default context or other contexts. BrowserContext is safe for
+concurrent use.
+*/
+type BrowserContext struct {
+	chrome *Chrome
+	closed bool
+	id     target.BrowserContextID
+	mux    *sync.Mutex
+}
+
+/*
+NewBrowserContext implements Chromium.
+*/
+func (chrome *Chrome) NewBrowserContext() (*BrowserContext, error) {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.ChromeBrowserContextFailed, "no browser connection")
+	}
+	result := <-browser.Target().CreateBrowserContext()
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.ChromeBrowserContextFailed, "Target.createBrowserContext failed")
+	}
+	if "" == result.BrowserContextID {
+		return nil, errs.New(codes.ChromeBrowserContextFailed, "Target.createBrowserContext returned no context ID")
+	}
+
+	context := &BrowserContext{
+		chrome: chrome,
+		id:     result.BrowserContextID,
+		mux:    &sync.Mutex{},
+	}
+	chrome.mux.Lock()
+	chrome.contexts = append(chrome.contexts, context)
+	chrome.mux.Unlock()
+	return context, nil
+}
+
+/*
+ID returns the browser context ID.
+*/
+func (context *BrowserContext) ID() target.BrowserContextID {
+	return context.id
+}
+
+/*
+NewTab opens a new tab in the browser context.
+*/
+func (context *BrowserContext) NewTab(uri string) (*Tab, error) {
+	if "" == uri {
+		uri = "about:blank"
+	}
+	if _, err := url.Parse(uri); nil != err {
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	context.mux.Lock()
+	closed := context.closed
+	context.mux.Unlock()
+	if closed {
+		return nil, errs.New(codes.ChromeBrowserContextFailed, fmt.Sprintf("browser context '%s' is closed", context.id))
+	}
+
+	browser, err := context.chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, "no browser connection")
+	}
+	result := <-browser.Target().CreateTarget(&target.CreateTargetParams{
+		URL:              uri,
+		BrowserContextID: optional.Of(context.id),
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create target for '%s' in browser context '%s'", uri, context.id))
+	}
+
+	return context.chrome.adoptTarget(&target.Info{
+		ID:               result.ID,
+		Type:             "page",
+		URL:              uri,
+		BrowserContextID: context.id,
+	})
+}
+
+/*
+Tabs returns the open tabs in the browser context.
+*/
+func (context *BrowserContext) Tabs() []*Tab {
+	var tabs []*Tab
+	for _, tab := range context.chrome.Tabs() {
+		if string(context.id) == tab.Data().BrowserContextID {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+/*
+Close disposes of the browser context and closes all its tabs.
+*/
+func (context *BrowserContext) Close() error {
+	context.mux.Lock()
+	if context.closed {
+		context.mux.Unlock()
+		return nil
+	}
+	context.closed = true
+	context.mux.Unlock()
+
+	chrome := context.chrome
+	chrome.mux.Lock()
+	for k, c := range chrome.contexts {
+		if c == context {
+			chrome.contexts = append(chrome.contexts[:k], chrome.contexts[k+1:]...)
+			break
+		}
+	}
+	chrome.mux.Unlock()
+
+	// Disposing of the context closes its targets.
+	for _, tab := range context.Tabs() {
+		tab.Detach()
+	}
+
+	browser, err := chrome.Browser()
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeBrowserContextFailed, "no browser connection")
+	}
+	result := <-browser.Target().DisposeBrowserContext(&target.DisposeBrowserContextParams{
+		BrowserContextID: context.id,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.ChromeBrowserContextFailed, fmt.Sprintf("Target.disposeBrowserContext failed for '%s'", context.id))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+//cdtpgen:experimental
+
+package chrome
+
+import (
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func TestChromiumBrowserContext(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	devtools.Results["Page.navigate"] = `{"frameId":"frame-1"}`
+
+	host, _ := devtools.Flags().Get("addr")
+	port, _ := devtools.Flags().Get("port")
+	chrome, err := Connect(host.(string), port.(int))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	context1, err := chrome.NewBrowserContext()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	context2, err := chrome.NewBrowserContext()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if context1.ID() == context2.ID() {
+		t.Errorf("Expected unique browser context IDs")
+	}
+
+	tab1, err := context1.NewTab("https://www.example.com/1")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	tab2, err := context1.NewTab("https://www.example.com/2")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if _, err := context2.NewTab(""); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if string(context1.ID()) != tab1.Data().BrowserContextID {
+		t.Errorf("Expected browser context '%s', found '%s'", context1.ID(), tab1.Data().BrowserContextID)
+	}
+	if 2 != len(context1.Tabs()) || 1 != len(context2.Tabs()) || 3 != len(chrome.Tabs()) {
+		t.Errorf("Expected 2 and 1 context tabs of 3, found %d, %d and %d", len(context1.Tabs()), len(context2.Tabs()), len(chrome.Tabs()))
+	}
+
+	// Context tabs are driven like any other tab.
+	result := <-tab2.Protocol().Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/3"})
+	if nil != result.Err {
+		t.Errorf("Expected nil, received error: %v", result.Err)
+	}
+
+	var created []map[string]interface{}
+	for _, payload := range devtools.Payloads() {
+		if "Target.createTarget" == payload.Method {
+			created = append(created, payload.Params.(map[string]interface{}))
+		}
+	}
+	if 3 != len(created) || string(context1.ID()) != created[0]["browserContextId"] || string(context2.ID()) != created[2]["browserContextId"] {
+		t.Errorf("Expected the targets to be created in their browser contexts, found %v", created)
+	}
+
+	if err := context1.Close(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(context1.Tabs()) || 1 != len(chrome.Tabs()) {
+		t.Errorf("Expected the context tabs to be closed, found %d tabs", len(chrome.Tabs()))
+	}
+	disposed := ""
+	for _, payload := range devtools.Payloads() {
+		if "Target.disposeBrowserContext" == payload.Method {
+			disposed, _ = payload.Params.(map[string]interface{})["browserContextId"].(string)
+		}
+	}
+	if string(context1.ID()) != disposed {
+		t.Errorf("Expected browser context '%s' to be disposed, found '%s'", context1.ID(), disposed)
+	}
+	if _, err := context1.NewTab(""); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+
+	// Closing a connection disposes of the remaining browser contexts.
+	chrome.Close()
+	disposed = ""
+	for _, payload := range devtools.Payloads() {
+		if "Target.disposeBrowserContext" == payload.Method {
+			disposed, _ = payload.Params.(map[string]interface{})["browserContextId"].(string)
+		}
+	}
+	if string(context2.ID()) != disposed {
+		t.Errorf("Expected browser context '%s' to be disposed, found '%s'", context2.ID(), disposed)
+	}
+}
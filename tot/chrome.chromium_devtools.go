@@ -0,0 +1,128 @@
+package chrome
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+devToolsListening matches the line Chromium writes to STDERR when the remote
+debugging endpoint is ready, capturing the browser websocket URL.
+*/
+var devToolsListening = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+/*
+dynamicPort returns whether Chromium chooses the remote debugging port itself.
+*/
+func (chrome *Chrome) dynamicPort() bool {
+	return chrome.Flags().Has("remote-debugging-port") && 0 == chrome.DebuggingPort()
+}
+
+/*
+setDevToolsEndpoint points the developer tools queries at the port in the
+browser websocket URL Chromium reported.
+*/
+func (chrome *Chrome) setDevToolsEndpoint(endpoint string) error {
+	websocketURL, err := url.Parse(endpoint)
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeStartTimeout, fmt.Sprintf("invalid DevTools endpoint '%s'", endpoint))
+	}
+	_, portStr, err := net.SplitHostPort(websocketURL.Host)
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeStartTimeout, fmt.Sprintf("invalid DevTools endpoint '%s'", endpoint))
+	}
+	port, err := strconv.Atoi(portStr)
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeStartTimeout, fmt.Sprintf("invalid DevTools endpoint '%s'", endpoint))
+	}
+
+	chrome.mux.Lock()
+	chrome.Flags().Set("port", port)
+	chrome.mux.Unlock()
+	log.WithFields(log.Fields{"endpoint": endpoint, "port": port}).
+		Info("DevTools listening")
+	return nil
+}
+
+/*
+waitForDevTools waits up to timeout for the developer tools endpoints to become
+available. Startup completes as soon as Chromium reports the endpoint on
+listening. If the port is known in advance the endpoints are also polled, in
+case the STDERR line isn't available.
+*/
+func (chrome *Chrome) waitForDevTools(listening <-chan string, timeout time.Duration) error {
+	dynamic := chrome.dynamicPort()
+	deadline := time.After(timeout)
+	poll := time.NewTicker(250 * time.Millisecond)
+	defer poll.Stop()
+
+	for {
+		select {
+		case endpoint, ok := <-listening:
+			if ok {
+				return chrome.setDevToolsEndpoint(endpoint)
+			}
+			if dynamic {
+				return errs.New(codes.ChromeStartTimeout, "chromium exited before the DevTools endpoint was reported")
+			}
+			listening = nil
+
+		case <-poll.C:
+			if dynamic {
+				continue
+			}
+			if _, err := chrome.Version(); nil == err {
+				return nil
+			}
+
+		case <-deadline:
+			return errs.New(codes.ChromeStartTimeout, "chromium took too long to start")
+		}
+	}
+}
+
+/*
+watchSTDERR copies Chromium's STDERR output to the STDERR output file and keeps
+its tail. It returns a channel that receives the browser websocket URL when
+Chromium reports the DevTools endpoint, which is closed when the output ends,
+and a channel that is closed once all output has been copied.
+*/
+func (chrome *Chrome) watchSTDERR(stderr io.ReadCloser) (<-chan string, <-chan struct{}) {
+	listening := make(chan string, 1)
+	done := make(chan struct{})
+	output := chrome.stdERRFile
+	go func() {
+		defer close(done)
+		defer close(listening)
+		defer stderr.Close()
+		reported := false
+		reader := bufio.NewReader(stderr)
+		for {
+			line, err := reader.ReadString('\n')
+			if "" != line {
+				chrome.stderrTail.add(line)
+				if nil != output {
+					_, _ = output.WriteString(line)
+				}
+				if match := devToolsListening.FindStringSubmatch(line); !reported && nil != match {
+					reported = true
+					listening <- match[1]
+				}
+			}
+			if nil != err {
+				return
+			}
+		}
+	}()
+	return listening, done
+}
@@ -3,6 +3,7 @@ package chrome
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	errs "github.com/bdlm/errors"
@@ -11,7 +12,10 @@ import (
 )
 
 /*
-Flags contains CLI arguments to the Chromium executable.
+Flags contains CLI arguments to the Chromium executable. Values may be nil for
+a bare switch, an int, a float64, a string, or a []string for list-valued
+switches such as 'enable-features', which are rendered as a comma-separated
+list.
 */
 type Flags map[string]interface{}
 
@@ -43,13 +47,7 @@ List implements ChromiumFlags
 func (flags Flags) List() []string {
 	var list []string
 
-	orderedFlags := []string{}
-	for arg := range flags {
-		orderedFlags = append(orderedFlags, arg)
-	}
-	sort.Strings(orderedFlags)
-
-	for _, arg := range orderedFlags {
+	for _, arg := range flags.names() {
 		val, err := flags.Get(arg)
 		if nil != err {
 			log.Error(err)
@@ -57,8 +55,12 @@ func (flags Flags) List() []string {
 		switch val.(type) {
 		case int:
 			arg = fmt.Sprintf("--%s=%d", arg, val.(int))
+		case float64:
+			arg = fmt.Sprintf("--%s=%s", arg, strconv.FormatFloat(val.(float64), 'f', -1, 64))
 		case string:
 			arg = fmt.Sprintf("--%s=%s", arg, val.(string))
+		case []string:
+			arg = fmt.Sprintf("--%s=%s", arg, strings.Join(val.([]string), ","))
 		default:
 			arg = fmt.Sprintf("--%s", arg)
 		}
@@ -68,8 +70,24 @@ func (flags Flags) List() []string {
 	return list
 }
 
+/*
+names returns the argument names in sorted order.
+*/
+func (flags Flags) names() []string {
+	names := []string{}
+	for arg := range flags {
+		names = append(names, arg)
+	}
+	sort.Strings(names)
+	return names
+}
+
 /*
 Set implements ChromiumFlags
+
+A []string value is merged into the current list of values rather than
+replacing it, so list-valued switches such as 'enable-features' can be built
+from several sources.
 */
 func (flags Flags) Set(arg string, value interface{}) (err error) {
 	if nil == value {
@@ -82,8 +100,12 @@ func (flags Flags) Set(arg string, value interface{}) (err error) {
 		switch value.(type) {
 		case int:
 			flags[arg] = value
+		case float64:
+			flags[arg] = value
 		case string:
 			flags[arg] = value
+		case []string:
+			flags[arg] = mergeFlagValues(flags[arg], value.([]string))
 		default:
 			return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("Invalid data type '%T' for argument %s: %+v", value, arg, value))
 		}
@@ -98,3 +120,34 @@ String implements ChromiumFlags
 func (flags Flags) String() string {
 	return strings.Join(flags.List(), " ")
 }
+
+/*
+mergeFlagValues appends values to the current list of values of a list-valued
+flag, skipping duplicates. A current string value is treated as a
+comma-separated list.
+*/
+func mergeFlagValues(current interface{}, values []string) []string {
+	merged := []string{}
+	switch current.(type) {
+	case []string:
+		merged = append(merged, current.([]string)...)
+	case string:
+		if "" != current.(string) {
+			merged = append(merged, strings.Split(current.(string), ",")...)
+		}
+	}
+
+	for _, value := range values {
+		found := false
+		for _, existing := range merged {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, value)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,235 @@
+package chrome
+
+import (
+	"fmt"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+ListFlags are the arguments that take a comma-separated list of values. String
+values for these arguments are merged by Merge and ExtraArgs rather than
+replacing the current list.
+*/
+var ListFlags = map[string]bool{
+	"disable-blink-features": true,
+	"disable-features":       true,
+	"enable-blink-features":  true,
+	"enable-features":        true,
+}
+
+/*
+FlagOption is a typed option that sets one or more CLI arguments. Options can
+be combined into presets and applied to any Flags value with Apply.
+*/
+type FlagOption func(flags Flags) error
+
+/*
+NewFlags returns a new set of CLI arguments with the options applied.
+*/
+func NewFlags(options ...FlagOption) (Flags, error) {
+	flags := Flags{}
+	if err := flags.Apply(options...); nil != err {
+		return nil, err
+	}
+	return flags, nil
+}
+
+/*
+Apply applies the options in order. Later options override earlier values,
+except for feature lists which are merged.
+*/
+func (flags Flags) Apply(options ...FlagOption) error {
+	for _, option := range options {
+		if err := option(flags); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Merge copies the arguments from each source in order. Values from later
+sources override earlier values, except for list-valued arguments such as
+feature lists which are merged.
+*/
+func (flags Flags) Merge(sources ...Flags) error {
+	for _, source := range sources {
+		for _, arg := range source.names() {
+			if err := flags.Set(arg, listValue(arg, source[arg])); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/*
+Options combines several options into one, for use as a preset.
+*/
+func Options(options ...FlagOption) FlagOption {
+	return func(flags Flags) error {
+		return flags.Apply(options...)
+	}
+}
+
+/*
+Flag sets an arbitrary argument. The value follows the same rules as Set.
+*/
+func Flag(arg string, value interface{}) FlagOption {
+	return func(flags Flags) error {
+		if "" == arg || strings.HasPrefix(arg, "-") || strings.ContainsAny(arg, "= ") {
+			return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("invalid argument name '%s'", arg))
+		}
+		return flags.Set(arg, value)
+	}
+}
+
+/*
+Headless runs Chromium without a UI.
+*/
+func Headless() FlagOption {
+	return Flag("headless", nil)
+}
+
+/*
+DisableGPU disables GPU hardware acceleration.
+*/
+func DisableGPU() FlagOption {
+	return Flag("disable-gpu", nil)
+}
+
+/*
+NoSandbox disables the Chromium sandbox, which is required when running as root
+or in containers without the necessary kernel privileges.
+*/
+func NoSandbox() FlagOption {
+	return Flag("no-sandbox", nil)
+}
+
+/*
+WindowSize sets the initial browser window size.
+*/
+func WindowSize(width, height int) FlagOption {
+	return func(flags Flags) error {
+		if width <= 0 || height <= 0 {
+			return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("invalid window size %dx%d", width, height))
+		}
+		return flags.Set("window-size", fmt.Sprintf("%d,%d", width, height))
+	}
+}
+
+/*
+ProxyServer sets the proxy server, for example 'socks5://localhost:1080'.
+*/
+func ProxyServer(server string) FlagOption {
+	return func(flags Flags) error {
+		if "" == server || strings.ContainsAny(server, " \t\n") {
+			return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("invalid proxy server '%s'", server))
+		}
+		return flags.Set("proxy-server", server)
+	}
+}
+
+/*
+EnableFeatures adds features to the 'enable-features' list.
+*/
+func EnableFeatures(features ...string) FlagOption {
+	return featureList("enable-features", features)
+}
+
+/*
+DisableFeatures adds features to the 'disable-features' list.
+*/
+func DisableFeatures(features ...string) FlagOption {
+	return featureList("disable-features", features)
+}
+
+/*
+featureList returns an option that merges features into a feature list
+argument.
+*/
+func featureList(arg string, features []string) FlagOption {
+	return func(flags Flags) error {
+		for _, feature := range features {
+			if "" == feature || strings.ContainsAny(feature, ", \t\n") {
+				return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("invalid feature name '%s' for argument %s", feature, arg))
+			}
+		}
+		return flags.Set(arg, features)
+	}
+}
+
+/*
+ExtraArgs parses arguments in the '--name' or '--name=value' format and sets
+them. List-valued arguments such as '--enable-features=A,B' are merged with the
+current values.
+*/
+func ExtraArgs(args ...string) FlagOption {
+	return func(flags Flags) error {
+		for _, arg := range args {
+			if !strings.HasPrefix(arg, "--") {
+				return errs.New(codes.FlagTypeInvalid, fmt.Sprintf("invalid argument '%s', expected '--name' or '--name=value'", arg))
+			}
+			name := strings.TrimPrefix(arg, "--")
+			var value interface{}
+			if idx := strings.Index(name, "="); idx >= 0 {
+				value = name[idx+1:]
+				name = name[:idx]
+			}
+			if err := Flag(name, listValue(name, value))(flags); nil != err {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+/*
+ContainerSafeHeadless is a preset for running headless Chromium in containers,
+where the sandbox and a large /dev/shm are usually unavailable.
+*/
+func ContainerSafeHeadless() FlagOption {
+	return Options(
+		Headless(),
+		DisableGPU(),
+		NoSandbox(),
+		Flag("disable-dev-shm-usage", nil),
+	)
+}
+
+/*
+DeterministicRendering is a preset that removes common sources of rendering
+differences between runs and machines, for screenshot comparisons.
+*/
+func DeterministicRendering() FlagOption {
+	return Options(
+		Headless(),
+		DisableGPU(),
+		Flag("deterministic-mode", nil),
+		Flag("disable-threaded-animation", nil),
+		Flag("disable-threaded-scrolling", nil),
+		Flag("disable-checker-imaging", nil),
+		Flag("disable-new-content-rendering-timeout", nil),
+		Flag("run-all-compositor-stages-before-draw", nil),
+		Flag("font-render-hinting", "none"),
+		Flag("force-color-profile", "srgb"),
+		Flag("hide-scrollbars", nil),
+	)
+}
+
+/*
+listValue splits a string value for one of the ListFlags into a list so it is
+merged with the current values.
+*/
+func listValue(arg string, value interface{}) interface{} {
+	if str, ok := value.(string); ok && ListFlags[arg] {
+		if "" == str {
+			return []string{}
+		}
+		return strings.Split(str, ",")
+	}
+	return value
+}
@@ -0,0 +1,96 @@
+package chrome
+
+import (
+	"testing"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestChromiumFlagOptions(t *testing.T) {
+	flags, err := NewFlags(
+		Headless(),
+		DisableGPU(),
+		NoSandbox(),
+		WindowSize(1280, 720),
+		ProxyServer("socks5://localhost:1080"),
+		EnableFeatures("NetworkService", "VizDisplayCompositor"),
+		DisableFeatures("Translate"),
+		ExtraArgs("--enable-features=NetworkService,OverlayScrollbar", "--mute-audio", "--lang=en-US"),
+	)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+
+	expected := "--disable-features=Translate" +
+		" --disable-gpu" +
+		" --enable-features=NetworkService,VizDisplayCompositor,OverlayScrollbar" +
+		" --headless" +
+		" --lang=en-US" +
+		" --mute-audio" +
+		" --no-sandbox" +
+		" --proxy-server=socks5://localhost:1080" +
+		" --window-size=1280,720"
+	if expected != flags.String() {
+		t.Errorf("Expected '%s', received '%s'", expected, flags.String())
+	}
+}
+
+func TestChromiumFlagOptionsInvalid(t *testing.T) {
+	for name, option := range map[string]FlagOption{
+		"window size":   WindowSize(0, 720),
+		"proxy server":  ProxyServer(""),
+		"feature name":  EnableFeatures("A,B"),
+		"empty feature": DisableFeatures(""),
+		"extra arg":     ExtraArgs("headless"),
+		"flag name":     Flag("--headless", nil),
+		"flag type":     Flag("headless", true),
+	} {
+		_, err := NewFlags(option)
+		if nil == err {
+			t.Errorf("Expected error for invalid %s, received nil", name)
+		} else if codes.FlagTypeInvalid != err.(errs.Err).Code() {
+			t.Errorf("Expected error code %d for invalid %s, received %d", codes.FlagTypeInvalid, name, err.(errs.Err).Code())
+		}
+	}
+}
+
+func TestChromiumFlagPresets(t *testing.T) {
+	flags, err := NewFlags(ContainerSafeHeadless())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "--disable-dev-shm-usage --disable-gpu --headless --no-sandbox" != flags.String() {
+		t.Errorf("Expected '--disable-dev-shm-usage --disable-gpu --headless --no-sandbox', received '%s'", flags.String())
+	}
+
+	flags, err = NewFlags(DeterministicRendering(), ContainerSafeHeadless())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	for _, arg := range []string{"deterministic-mode", "no-sandbox", "run-all-compositor-stages-before-draw"} {
+		if !flags.Has(arg) {
+			t.Errorf("Expected '%s' to be set", arg)
+		}
+	}
+	if value, _ := flags.Get("font-render-hinting"); "none" != value {
+		t.Errorf("Expected 'none', received '%v'", value)
+	}
+}
+
+func TestChromiumFlagsMerge(t *testing.T) {
+	flags := Flags{
+		"enable-features":       []string{"A"},
+		"remote-debugging-port": 9222,
+	}
+	err := flags.Merge(
+		Flags{"enable-features": []string{"B"}, "headless": nil},
+		Flags{"enable-features": "A,C", "remote-debugging-port": 0},
+	)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "--enable-features=A,B,C --headless --remote-debugging-port=0" != flags.String() {
+		t.Errorf("Expected '--enable-features=A,B,C --headless --remote-debugging-port=0', received '%s'", flags.String())
+	}
+}
@@ -104,3 +104,20 @@ func TestChromiumFlagsString(t *testing.T) {
 		t.Errorf("Expected '--test-1 --test-2=string --test-3=1', received '%s'", args)
 	}
 }
+
+func TestChromiumFlagsLists(t *testing.T) {
+	flags := &Flags{
+		"enable-features": "A,B",
+		"scale":           1.5,
+	}
+
+	if err := flags.Set("enable-features", []string{"B", "C"}); nil != err {
+		t.Errorf("Expected nil, received error '%s'", err.Error())
+	}
+	if err := flags.Set("enable-features", []string{"D"}); nil != err {
+		t.Errorf("Expected nil, received error '%s'", err.Error())
+	}
+	if "--enable-features=A,B,C,D --scale=1.5" != flags.String() {
+		t.Errorf("Expected '--enable-features=A,B,C,D --scale=1.5', received '%s'", flags.String())
+	}
+}
@@ -0,0 +1,300 @@
+package chrome
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+stderrTailLines is the number of lines of Chromium STDERR output kept for
+reporting unexpected exits.
+*/
+const stderrTailLines = 50
+
+/*
+NewShutdownPolicy returns a pointer to a ShutdownPolicy with the default grace
+periods.
+*/
+func NewShutdownPolicy() *ShutdownPolicy {
+	return &ShutdownPolicy{
+		TabCloseGracePeriod:  5 * time.Second,
+		InterruptGracePeriod: 5 * time.Second,
+		CloseGracePeriod:     5 * time.Second,
+		KillGracePeriod:      5 * time.Second,
+	}
+}
+
+/*
+ShutdownPolicy defines how long Close waits for the Chromium process to exit at
+each shutdown step. The open tabs are first closed, waiting up to
+TabCloseGracePeriod for them to close. The process is then sent SIGINT. If it
+is still running after InterruptGracePeriod the Browser.close command is sent.
+If it is still running after CloseGracePeriod the whole process group is
+killed.
+*/
+type ShutdownPolicy struct {
+	// TabCloseGracePeriod is the time to wait for the open tabs to close
+	// before the process is shut down.
+	TabCloseGracePeriod time.Duration
+
+	// InterruptGracePeriod is the time to wait for the process to exit after
+	// SIGINT is sent.
+	InterruptGracePeriod time.Duration
+
+	// CloseGracePeriod is the time to wait for the process to exit after the
+	// Browser.close command is sent.
+	CloseGracePeriod time.Duration
+
+	// KillGracePeriod is the time to wait for the process to exit after the
+	// process group is killed.
+	KillGracePeriod time.Duration
+}
+
+/*
+ProcessExit describes an unexpected exit of the Chromium process.
+*/
+type ProcessExit struct {
+	// Pid is the process ID of the Chromium process.
+	Pid int
+
+	// ExitCode is the exit code of the process, or -1 if it was terminated by
+	// a signal.
+	ExitCode int
+
+	// State is the exit state of the process.
+	State *os.ProcessState
+
+	// Stderr is the tail of the process STDERR output.
+	Stderr []string
+
+	// Err is set if the process exit state couldn't be read.
+	Err error
+}
+
+/*
+String implements Stringer.
+*/
+func (exit *ProcessExit) String() string {
+	if nil == exit.State {
+		return "unknown exit state"
+	}
+	return exit.State.String()
+}
+
+/*
+stderrTail keeps the last lines of Chromium STDERR output.
+*/
+type stderrTail struct {
+	lines []string
+	mux   *sync.Mutex
+}
+
+/*
+add appends a line to the tail, discarding the oldest line if it is full.
+*/
+func (tail *stderrTail) add(line string) {
+	tail.mux.Lock()
+	defer tail.mux.Unlock()
+	tail.lines = append(tail.lines, strings.TrimRight(line, "\r\n"))
+	if len(tail.lines) > stderrTailLines {
+		tail.lines = tail.lines[len(tail.lines)-stderrTailLines:]
+	}
+}
+
+/*
+get returns a copy of the tail.
+*/
+func (tail *stderrTail) get() []string {
+	tail.mux.Lock()
+	defer tail.mux.Unlock()
+	return append([]string{}, tail.lines...)
+}
+
+/*
+OnUnexpectedExit implements Chromium.
+*/
+func (chrome *Chrome) OnUnexpectedExit(callback func(exit *ProcessExit)) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.exitHandlers = append(chrome.exitHandlers, callback)
+}
+
+/*
+SetShutdownPolicy sets the grace periods Close waits for the Chromium process
+to exit. A nil policy restores the defaults.
+*/
+func (chrome *Chrome) SetShutdownPolicy(policy *ShutdownPolicy) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.shutdownPolicy = policy
+}
+
+/*
+closeTabs closes the open tabs concurrently before the process is shut down.
+Each close is bound to the TabCloseGracePeriod of the shutdown policy so a
+browser that stops responding cannot block Close. Tabs that are not closed in
+time are detached.
+*/
+func (chrome *Chrome) closeTabs() {
+	ctx, cancel := context.WithTimeout(context.Background(), chrome.currentShutdownPolicy().TabCloseGracePeriod)
+	defer cancel()
+	wg := &sync.WaitGroup{}
+	for _, tab := range chrome.Tabs() {
+		wg.Add(1)
+		go func(tab *Tab) {
+			defer wg.Done()
+			tab.Socket().Stop()
+			if err := chrome.closeTab(ctx, tab); nil != err {
+				log.WithFields(log.Fields{"error": err, "tabID": tab.Data().ID}).
+					Warn("could not close tab, detaching")
+			}
+			chrome.RemoveTab(tab)
+		}(tab)
+	}
+	wg.Wait()
+}
+
+/*
+closeTab closes a tab with a command or query bound to ctx. Flattened sessions
+are closed over the browser connection.
+*/
+func (chrome *Chrome) closeTab(ctx context.Context, tab *Tab) error {
+	if nil != tab.browser {
+		result := <-tab.browser.Target().WithContext(ctx).CloseTarget(&target.CloseTargetParams{ID: target.ID(tab.Data().ID)})
+		return result.Err
+	}
+	_, err := chrome.queryContext(ctx, fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, nil)
+	return err
+}
+
+/*
+currentShutdownPolicy returns the shutdown policy, or the default policy if
+none is set.
+*/
+func (chrome *Chrome) currentShutdownPolicy() *ShutdownPolicy {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	if nil == chrome.shutdownPolicy {
+		return NewShutdownPolicy()
+	}
+	return chrome.shutdownPolicy
+}
+
+/*
+shutdown stops the Chromium process, escalating from SIGINT to the Browser.close
+command to killing the process group. Any processes left in the group are
+killed once the browser exits.
+*/
+func (chrome *Chrome) shutdown() error {
+	policy := chrome.currentShutdownPolicy()
+	chrome.mux.Lock()
+	chrome.closing = true
+	chrome.mux.Unlock()
+	defer killProcessGroup(chrome.process)
+
+	if err := interruptProcess(chrome.process); nil != err {
+		log.WithFields(log.Fields{"error": err, "pid": chrome.process.Pid}).
+			Warn("chrome process interrupt failed")
+	} else if chrome.waitForExit(policy.InterruptGracePeriod) {
+		return nil
+	}
+
+	log.WithFields(log.Fields{"pid": chrome.process.Pid}).
+		Warn("Chromium did not exit after SIGINT, sending Browser.close")
+	if browser := chrome.browserConnection(); nil != browser {
+		ctx, cancel := context.WithTimeout(context.Background(), policy.CloseGracePeriod)
+		<-browser.Browser().WithContext(ctx).Close()
+		cancel()
+		if chrome.waitForExit(policy.CloseGracePeriod) {
+			return nil
+		}
+	}
+
+	log.WithFields(log.Fields{"pid": chrome.process.Pid}).
+		Warn("Chromium did not exit after Browser.close, killing the process group")
+	if err := killProcessGroup(chrome.process); nil != err {
+		return errs.Wrap(err, codes.ChromeSigintFailed, "chrome process group kill failed")
+	}
+	if !chrome.waitForExit(policy.KillGracePeriod) {
+		return errs.New(codes.ChromeExitTimeout, "error waiting for process exit, result unknown")
+	}
+	return nil
+}
+
+/*
+browserConnection returns the open browser-level connection without opening a
+new one, or nil.
+*/
+func (chrome *Chrome) browserConnection() *socket.Socket {
+	if nil != chrome.pipe {
+		return chrome.pipe
+	}
+	chrome.connMux.Lock()
+	defer chrome.connMux.Unlock()
+	return chrome.browser
+}
+
+/*
+waitForExit waits up to timeout for the Chromium process to exit and returns
+whether it did.
+*/
+func (chrome *Chrome) waitForExit(timeout time.Duration) bool {
+	select {
+	case <-chrome.exited:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+/*
+watchProcess waits for the Chromium process to exit and calls the unexpected
+exit handlers if it wasn't stopped by Close.
+*/
+func (chrome *Chrome) watchProcess(process *os.Process, stderrDone <-chan struct{}) {
+	state, err := process.Wait()
+	close(chrome.exited)
+
+	chrome.mux.Lock()
+	closing := chrome.closing
+	handlers := append([]func(exit *ProcessExit){}, chrome.exitHandlers...)
+	chrome.mux.Unlock()
+
+	exit := &ProcessExit{
+		Pid:      process.Pid,
+		ExitCode: -1,
+		State:    state,
+		Err:      err,
+	}
+	if nil != state {
+		exit.ExitCode = state.ExitCode()
+	}
+	if closing {
+		log.WithFields(log.Fields{"pid": exit.Pid, "state": exit.String()}).
+			Info("Chromium exited")
+		return
+	}
+
+	// Collect the remaining output before reporting.
+	select {
+	case <-stderrDone:
+	case <-time.After(time.Second):
+	}
+	exit.Stderr = chrome.stderrTail.get()
+	log.WithFields(log.Fields{"pid": exit.Pid, "state": exit.String(), "stderr": strings.Join(exit.Stderr, "\n")}).
+		Error("Chromium exited unexpectedly")
+	for _, handler := range handlers {
+		handler(exit)
+	}
+}
@@ -0,0 +1,140 @@
+//go:build !windows
+// +build !windows
+
+package chrome
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+/*
+processRunning returns whether a process is running. Zombie processes are not
+running.
+*/
+func processRunning(pid int) bool {
+	if stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid)); nil == err {
+		fields := strings.Fields(string(stat))
+		return len(fields) > 2 && "Z" != fields[2]
+	}
+	return nil == syscall.Kill(pid, 0)
+}
+
+func launchMockProcess(t *testing.T, mode string, stderr string) *Chrome {
+	os.Setenv(mockProcessEnv, mode)
+	defer os.Unsetenv(mockProcessEnv)
+
+	chrome := New(
+		&Flags{
+			"remote-debugging-port": 0,
+		},
+		os.Args[0],
+		"",     //"path/to/workdir",
+		"",     //"path/to/stdout",
+		stderr, //"path/to/stderr",
+	)
+	chrome.SetShutdownPolicy(&ShutdownPolicy{
+		InterruptGracePeriod: 200 * time.Millisecond,
+		CloseGracePeriod:     200 * time.Millisecond,
+		KillGracePeriod:      time.Second,
+	})
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	return chrome
+}
+
+func TestChromiumCloseInterrupt(t *testing.T) {
+	stderr := filepath.Join(os.TempDir(), fmt.Sprintf("TestChromiumCloseInterrupt-%d.log", os.Getpid()))
+	defer os.Remove(stderr)
+
+	chrome := launchMockProcess(t, "devtools", stderr)
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if processRunning(chrome.process.Pid) {
+		t.Errorf("Expected the process to exit")
+	}
+	if _, err := chrome.stdERRFile.WriteString("closed?"); nil == err {
+		t.Errorf("Expected the STDERR output file to be closed")
+	}
+	output, _ := ioutil.ReadFile(stderr)
+	if !strings.Contains(string(output), "DevTools listening on") {
+		t.Errorf("Expected STDERR output to be copied to '%s', found '%s'", stderr, output)
+	}
+}
+
+func TestChromiumCloseBrowserClose(t *testing.T) {
+	chrome := launchMockProcess(t, "closable", "")
+	start := time.Now()
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Browser.close to stop the process, %s elapsed", elapsed)
+	}
+	if processRunning(chrome.process.Pid) {
+		t.Errorf("Expected the process to exit")
+	}
+}
+
+func TestChromiumCloseKill(t *testing.T) {
+	chrome := launchMockProcess(t, "hang", "")
+
+	var childPid int
+	for _, line := range chrome.stderrTail.get() {
+		if match := regexp.MustCompile(`child pid (\d+)`).FindStringSubmatch(line); nil != match {
+			childPid, _ = strconv.Atoi(match[1])
+		}
+	}
+	if 0 == childPid || !processRunning(childPid) {
+		t.Fatalf("Expected a running child process, found pid %d", childPid)
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if processRunning(chrome.process.Pid) {
+		t.Errorf("Expected the process to be killed")
+	}
+	for a := 0; a < 10 && processRunning(childPid); a++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if processRunning(childPid) {
+		t.Errorf("Expected the child process to be killed")
+	}
+}
+
+func TestChromiumUnexpectedExit(t *testing.T) {
+	exits := make(chan *ProcessExit, 1)
+	os.Setenv(mockProcessEnv, "crash")
+	defer os.Unsetenv(mockProcessEnv)
+	chrome := New(&Flags{"remote-debugging-port": 0}, os.Args[0], "", "", "")
+	chrome.OnUnexpectedExit(func(exit *ProcessExit) {
+		exits <- exit
+	})
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	select {
+	case exit := <-exits:
+		if 3 != exit.ExitCode {
+			t.Errorf("Expected exit code 3, found %d", exit.ExitCode)
+		}
+		if 0 == len(exit.Stderr) || !strings.Contains(exit.Stderr[len(exit.Stderr)-1], "renderer crashed") {
+			t.Errorf("Expected the STDERR tail, found %v", exit.Stderr)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected an unexpected exit notification")
+	}
+}
@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package chrome
+
+import (
+	"os"
+	"syscall"
+)
+
+/*
+processAttributes returns the attributes that start Chromium in its own process
+group, so the browser and all of its child processes can be stopped together.
+*/
+func processAttributes() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+/*
+interruptProcess sends SIGINT to the Chromium process.
+*/
+func interruptProcess(process *os.Process) error {
+	return process.Signal(os.Interrupt)
+}
+
+/*
+killProcessGroup sends SIGKILL to every process in the Chromium process group.
+*/
+func killProcessGroup(process *os.Process) error {
+	err := syscall.Kill(-process.Pid, syscall.SIGKILL)
+	if syscall.ESRCH == err {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+//go:build windows
+// +build windows
+
+package chrome
+
+import (
+	"os"
+	"syscall"
+)
+
+/*
+processAttributes returns the attributes that start Chromium in its own process
+group.
+*/
+func processAttributes() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+/*
+interruptProcess is not supported on Windows, Close continues with the
+Browser.close command.
+*/
+func interruptProcess(process *os.Process) error {
+	return syscall.EWINDOWS
+}
+
+/*
+killProcessGroup kills the Chromium process.
+*/
+func killProcessGroup(process *os.Process) error {
+	err := process.Kill()
+	if os.ErrProcessDone == err {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,176 @@
+package chrome
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Profile defines the Chromium user data directory used by Launch.
+*/
+type Profile struct {
+	// Optional. Dir is the path to a persistent profile directory, which is
+	// created if it doesn't exist and kept after Close. If empty, a uniquely
+	// named temporary directory is created for each launch and deleted on
+	// Close.
+	Dir string
+
+	// Optional. Template is the path to a directory that is copied into the
+	// profile when it is new, for example to pre-install certificates or
+	// preferences. A persistent profile is only seeded if it is empty.
+	Template string
+}
+
+/*
+SetProfile sets the profile Launch uses for the Chromium user data directory. A
+nil profile restores the default, a new temporary profile for each launch. An
+explicit 'user-data-dir' flag takes precedence over the profile.
+*/
+func (chrome *Chrome) SetProfile(profile *Profile) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.profile = profile
+}
+
+/*
+ProfileDir returns the path to the Chromium user data directory, or an empty
+string if it hasn't been set.
+*/
+func (chrome *Chrome) ProfileDir() string {
+	dir, err := chrome.Flags().Get("user-data-dir")
+	if nil != err {
+		return ""
+	}
+	return fmt.Sprintf("%v", dir)
+}
+
+/*
+prepareProfile creates the profile directory and sets the 'user-data-dir' flag,
+unless the flag was set by the caller.
+*/
+func (chrome *Chrome) prepareProfile() error {
+	chrome.mux.Lock()
+	profile := chrome.profile
+	tempDir := chrome.tempProfileDir
+	chrome.mux.Unlock()
+
+	// The flag set for a previous launch refers to a temporary profile that
+	// was deleted on Close.
+	if chrome.Flags().Has("user-data-dir") && ("" == tempDir || tempDir != chrome.ProfileDir()) {
+		return nil
+	}
+	if nil == profile {
+		profile = &Profile{}
+	}
+
+	dir := profile.Dir
+	seed := false
+	if "" == dir {
+		var err error
+		dir, err = ioutil.TempDir("", "go-chrome-profile-")
+		if nil != err {
+			return errs.Wrap(err, codes.ChromeProfileFailed, "cannot create temporary profile directory")
+		}
+		seed = true
+		chrome.mux.Lock()
+		chrome.tempProfileDir = dir
+		chrome.mux.Unlock()
+	} else {
+		if err := os.MkdirAll(dir, 0700); nil != err {
+			return errs.Wrap(err, codes.ChromeProfileFailed, fmt.Sprintf("cannot create profile directory '%s'", dir))
+		}
+		entries, err := ioutil.ReadDir(dir)
+		if nil != err {
+			return errs.Wrap(err, codes.ChromeProfileFailed, fmt.Sprintf("cannot read profile directory '%s'", dir))
+		}
+		seed = 0 == len(entries)
+	}
+
+	if seed && "" != profile.Template {
+		if err := copyProfile(profile.Template, dir); nil != err {
+			chrome.removeProfile()
+			return errs.Wrap(err, codes.ChromeProfileFailed, fmt.Sprintf("cannot copy profile template '%s'", profile.Template))
+		}
+	}
+
+	return chrome.Flags().Set("user-data-dir", dir)
+}
+
+/*
+removeProfile deletes the temporary profile directory created by Launch.
+*/
+func (chrome *Chrome) removeProfile() {
+	chrome.mux.Lock()
+	dir := chrome.tempProfileDir
+	chrome.mux.Unlock()
+	if "" == dir {
+		return
+	}
+	if err := os.RemoveAll(dir); nil != err {
+		log.WithFields(log.Fields{"dir": dir, "error": err}).Warn("cannot remove temporary profile directory")
+	}
+}
+
+/*
+copyProfile recursively copies a profile template directory. Chromium's
+Singleton* lock files are skipped so the copy isn't reported as being in use by
+another browser.
+*/
+func copyProfile(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if nil != err {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), "Singleton") {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if nil != err {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case 0 != info.Mode()&os.ModeSymlink:
+			link, err := os.Readlink(path)
+			if nil != err {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		}
+		return nil
+	})
+}
+
+/*
+copyFile copies a regular file.
+*/
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if nil != err {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm|0600)
+	if nil != err {
+		return err
+	}
+	if _, err = io.Copy(out, in); nil != err {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
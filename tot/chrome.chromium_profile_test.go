@@ -0,0 +1,117 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func launchProfile(t *testing.T, chrome *Chrome) {
+	os.Setenv(mockProcessEnv, "devtools")
+	defer os.Unsetenv(mockProcessEnv)
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	flag := "--user-data-dir=" + chrome.ProfileDir()
+	for _, line := range chrome.stderrTail.get() {
+		if strings.Contains(line, flag) {
+			return
+		}
+	}
+	t.Errorf("Expected Chromium to be launched with '%s'", flag)
+}
+
+func newProfileTemplate(t *testing.T) string {
+	template, err := ioutil.TempDir("", "TestProfileTemplate")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	os.MkdirAll(filepath.Join(template, "Default"), 0700)
+	ioutil.WriteFile(filepath.Join(template, "Default", "Preferences"), []byte(`{"seeded":true}`), 0600)
+	ioutil.WriteFile(filepath.Join(template, "SingletonLock"), []byte("host-1234"), 0600)
+	return template
+}
+
+func TestChromiumTemporaryProfile(t *testing.T) {
+	template := newProfileTemplate(t)
+	defer os.RemoveAll(template)
+
+	chrome1 := New(&Flags{"remote-debugging-port": 0}, os.Args[0], "", "", "")
+	chrome1.SetProfile(&Profile{Template: template})
+	chrome2 := New(&Flags{"remote-debugging-port": 0}, os.Args[0], "", "", "")
+	launchProfile(t, chrome1)
+	launchProfile(t, chrome2)
+
+	dir1 := chrome1.ProfileDir()
+	dir2 := chrome2.ProfileDir()
+	if "" == dir1 || dir1 == dir2 {
+		t.Errorf("Expected unique profile directories, found '%s' and '%s'", dir1, dir2)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(dir1, "Default", "Preferences")); `{"seeded":true}` != string(data) {
+		t.Errorf("Expected the profile to be seeded from the template, received '%s' (%v)", data, err)
+	}
+	if _, err := os.Lstat(filepath.Join(dir1, "SingletonLock")); nil == err {
+		t.Errorf("Expected the template SingletonLock to be skipped")
+	}
+
+	chrome1.Close()
+	chrome2.Close()
+	for _, dir := range []string{dir1, dir2} {
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("Expected profile directory '%s' to be deleted", dir)
+		}
+	}
+
+	// A relaunch gets a new profile.
+	launchProfile(t, chrome1)
+	defer chrome1.Close()
+	if dir1 == chrome1.ProfileDir() {
+		t.Errorf("Expected a new profile directory")
+	}
+	if _, err := os.Stat(chrome1.ProfileDir()); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+}
+
+func TestChromiumPersistentProfile(t *testing.T) {
+	template := newProfileTemplate(t)
+	defer os.RemoveAll(template)
+	root, err := ioutil.TempDir("", "TestChromiumPersistentProfile")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer os.RemoveAll(root)
+	dir := filepath.Join(root, "profile")
+	preferences := filepath.Join(dir, "Default", "Preferences")
+
+	chrome := New(&Flags{"remote-debugging-port": 0}, os.Args[0], "", "", "")
+	chrome.SetProfile(&Profile{Dir: dir, Template: template})
+	launchProfile(t, chrome)
+	if dir != chrome.ProfileDir() {
+		t.Errorf("Expected '%s', received '%s'", dir, chrome.ProfileDir())
+	}
+	chrome.Close()
+	if data, err := ioutil.ReadFile(preferences); `{"seeded":true}` != string(data) {
+		t.Fatalf("Expected the seeded profile to be kept, received '%s' (%v)", data, err)
+	}
+
+	// An existing profile isn't seeded again.
+	ioutil.WriteFile(preferences, []byte(`{"seeded":false}`), 0600)
+	launchProfile(t, chrome)
+	chrome.Close()
+	if data, _ := ioutil.ReadFile(preferences); `{"seeded":false}` != string(data) {
+		t.Errorf("Expected the profile to be kept, received '%s'", data)
+	}
+
+	// An explicit user-data-dir flag takes precedence.
+	explicit := filepath.Join(root, "explicit")
+	os.MkdirAll(explicit, 0700)
+	chrome = New(&Flags{"remote-debugging-port": 0, "user-data-dir": explicit}, os.Args[0], "", "", "")
+	launchProfile(t, chrome)
+	chrome.Close()
+	if _, err := os.Stat(explicit); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package chrome
+
+import (
+	"fmt"
+	"net/url"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+OnNewPage implements Chromium.
+*/
+func (chrome *Chrome) OnNewPage(callback func(tab *Tab)) {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.newPageHandlers = append(chrome.newPageHandlers, callback)
+}
+
+/*
+addTab adds a tab to the list of open tabs and calls the new page handlers. If
+a tab for the same target is already open the new tab's socket is stopped and
+the open tab is returned instead.
+*/
+func (chrome *Chrome) addTab(tab *Tab) *Tab {
+	chrome.mux.Lock()
+	for _, t := range chrome.tabs {
+		if t.Data().ID == tab.Data().ID {
+			chrome.mux.Unlock()
+			tab.Socket().Stop()
+			return t
+		}
+	}
+	chrome.tabs = append(chrome.tabs, tab)
+	handlers := append([]func(tab *Tab){}, chrome.newPageHandlers...)
+	chrome.mux.Unlock()
+
+	if "page" == tab.Data().Type {
+		for _, handler := range handlers {
+			handler(tab)
+		}
+	}
+	return tab
+}
+
+/*
+adoptTarget returns the open tab for a target, connecting to the target if it
+isn't open yet.
+*/
+func (chrome *Chrome) adoptTarget(info *target.Info) (*Tab, error) {
+	if tab := chrome.tab(string(info.ID)); nil != tab {
+		return tab, nil
+	}
+	if chrome.sessionTabs() {
+		return chrome.AttachTab(string(info.ID))
+	}
+
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, err
+	}
+	websocketURL := &url.URL{
+		Scheme: browser.URL().Scheme,
+		Host:   browser.URL().Host,
+		Path:   fmt.Sprintf("/devtools/page/%s", info.ID),
+	}
+	return chrome.adoptTab(&TabData{
+		ID:                   string(info.ID),
+		OpenerID:             string(info.OpenerID),
+		BrowserContextID:     string(info.BrowserContextID),
+		Title:                info.Title,
+		Type:                 info.Type,
+		URL:                  info.URL,
+		WebSocketDebuggerURL: websocketURL.String(),
+	})
+}
+
+/*
+tab returns the open tab for the specified target, or nil.
+*/
+func (chrome *Chrome) tab(targetID string) *Tab {
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	for _, tab := range chrome.tabs {
+		if tab.Data().ID == targetID {
+			return tab
+		}
+	}
+	return nil
+}
+
+/*
+watchTargets enables target discovery on the browser connection and keeps the
+list of open tabs in sync with the browser. Page targets opened outside of
+NewTab, such as popups, are added to the list and tabs whose target is
+destroyed are removed.
+*/
+func (chrome *Chrome) watchTargets() error {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return errs.Wrap(err, codes.ChromeTargetDiscoveryFailed, "no browser connection")
+	}
+
+	browser.Target().OnTargetCreated(func(event *target.CreatedEvent) {
+		if nil != event.Err || nil == event.Info || "page" != event.Info.Type {
+			return
+		}
+		if _, err := chrome.adoptTarget(event.Info); nil != err {
+			log.WithFields(log.Fields{"error": err, "targetID": event.Info.ID}).
+				Warn("could not adopt new page target")
+		}
+	})
+	browser.Target().OnTargetDestroyed(func(event *target.DestroyedEvent) {
+		if nil != event.Err {
+			return
+		}
+		if tab := chrome.tab(string(event.ID)); nil != tab {
+			tab.Socket().Stop()
+			chrome.RemoveTab(tab)
+		}
+	})
+	browser.Target().OnTargetInfoChanged(func(event *target.InfoChangedEvent) {
+		if nil != event.Err || nil == event.Info {
+			return
+		}
+		if tab := chrome.tab(string(event.Info.ID)); nil != tab {
+			tab.update(event.Info)
+		}
+	})
+
+	result := <-browser.Target().SetDiscoverTargets(&target.SetDiscoverTargetsParams{
+		Discover: true,
+	})
+	if nil != result.Err {
+		return errs.Wrap(result.Err, codes.ChromeTargetDiscoveryFailed, "Target.setDiscoverTargets failed")
+	}
+	return nil
+}
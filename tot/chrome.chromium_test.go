@@ -1,13 +1,26 @@
 package chrome
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/internal/devtoolstest"
+	"github.com/mkenney/go-chrome/tot/page"
 )
 
 func TestChromiumNew(t *testing.T) {
+	t.Setenv(BinaryEnv, "")
+	t.Setenv("PATH", "")
 	chrome := New(
 		&Flags{},
 		"", //"path/to/chrome",
@@ -41,6 +54,200 @@ func TestChromiumNew(t *testing.T) {
 	}
 }
 
+func TestChromiumBrowser(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	devtools.Results["Browser.getVersion"] = `{"product":"HeadlessChrome/100.0.4896.60","protocolVersion":"1.3"}`
+
+	chrome := New(devtools.Flags(), "", "", "", "")
+	browser, err := chrome.Browser()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	result := <-browser.Browser().GetVersion()
+	if nil != result.Err {
+		t.Errorf("Expected nil, received error: %v", result.Err)
+	} else if "HeadlessChrome/100.0.4896.60" != result.Product {
+		t.Errorf("Expected 'HeadlessChrome/100.0.4896.60', received '%s'", result.Product)
+	}
+
+	again, err := chrome.Browser()
+	if nil != err || again != browser {
+		t.Errorf("Expected the browser connection to be reused")
+	}
+}
+
+func TestChromiumBrowserNotFound(t *testing.T) {
+	chrome := New(
+		&Flags{
+			"addr": "devnul",
+			"port": 9222,
+		},
+		"", "", "", "",
+	)
+	browser, err := chrome.Browser()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	} else if codes.ChromeBrowserConnectFailed != err.(errs.Err).Code() {
+		t.Errorf("Expected ChromeBrowserConnectFailed, received %v", err)
+	}
+	if nil != browser {
+		t.Errorf("Expected nil, received %v", browser)
+	}
+}
+
+func TestChromiumConnect(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	devtools.Targets = []*devtoolstest.Target{
+		{ID: "page-1", Type: "page", URL: "https://www.example.com/1"},
+		{ID: "worker-1", Type: "service_worker", URL: "https://www.example.com/sw.js"},
+		{ID: "page-2", Type: "page", URL: "https://www.example.com/2"},
+	}
+	devtools.Results["Page.navigate"] = `{"frameId":"frame-1"}`
+
+	host, _ := devtools.Flags().Get("addr")
+	port, _ := devtools.Flags().Get("port")
+	chrome, err := Connect(host.(string), port.(int))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 2 != len(chrome.Tabs()) {
+		t.Fatalf("Expected 2 tabs, found %d", len(chrome.Tabs()))
+	}
+
+	tab, err := chrome.GetTab("page-2")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	result := <-tab.Protocol().Page().Navigate(&page.NavigateParams{URL: "https://www.example.com/3"})
+	if nil != result.Err {
+		t.Errorf("Expected nil, received error: %v", result.Err)
+	} else if "frame-1" != string(result.FrameID) {
+		t.Errorf("Expected frame ID 'frame-1', found '%s'", result.FrameID)
+	}
+
+	if err := chrome.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	for _, path := range devtools.Paths() {
+		if strings.HasPrefix(path, "/json/close") {
+			t.Errorf("Expected adopted tabs to be detached, found request to '%s'", path)
+		}
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected 0 tabs, found %d", len(chrome.Tabs()))
+	}
+}
+
+func TestChromiumTargetDiscovery(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	devtools.Targets = []*devtoolstest.Target{
+		{ID: "page-1", Type: "page", URL: "https://www.example.com/login"},
+	}
+
+	host, _ := devtools.Flags().Get("addr")
+	port, _ := devtools.Flags().Get("port")
+	chrome, err := Connect(host.(string), port.(int))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	newPages := make(chan *Tab, 1)
+	chrome.OnNewPage(func(tab *Tab) {
+		newPages <- tab
+	})
+
+	// Already open targets are reported when discovery is enabled and must
+	// not be added twice.
+	devtools.Emit("Target.targetCreated", `{"targetInfo":{"targetId":"page-1","type":"page","url":"https://www.example.com/login"}}`)
+	devtools.Emit("Target.targetCreated", `{"targetInfo":{"targetId":"worker-1","type":"service_worker","url":"https://www.example.com/sw.js"}}`)
+	devtools.Emit("Target.targetCreated", `{"targetInfo":{"targetId":"popup-1","type":"page","url":"https://auth.example.com/","openerId":"page-1"}}`)
+
+	select {
+	case tab := <-newPages:
+		if "popup-1" != tab.Data().ID {
+			t.Errorf("Expected new page 'popup-1', found '%s'", tab.Data().ID)
+		}
+		if "page-1" != tab.Data().OpenerID {
+			t.Errorf("Expected opener 'page-1', found '%s'", tab.Data().OpenerID)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a new page notification")
+	}
+	if 2 != len(chrome.Tabs()) {
+		t.Errorf("Expected 2 tabs, found %d", len(chrome.Tabs()))
+	}
+
+	devtools.Emit("Target.targetInfoChanged", `{"targetInfo":{"targetId":"popup-1","type":"page","title":"Sign in","url":"https://auth.example.com/login"}}`)
+	devtools.Emit("Target.targetDestroyed", `{"targetId":"page-1"}`)
+	time.Sleep(100 * time.Millisecond)
+
+	tabs := chrome.Tabs()
+	if 1 != len(tabs) || "popup-1" != tabs[0].Data().ID {
+		t.Fatalf("Expected only tab 'popup-1', found %v", tabs)
+	}
+	if "Sign in" != tabs[0].Data().Title {
+		t.Errorf("Expected title 'Sign in', found '%s'", tabs[0].Data().Title)
+	}
+}
+
+func TestChromiumConcurrentTabs(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+
+	chrome := New(devtools.Flags(), "", "", "", "")
+	defer chrome.Close()
+
+	// Open and close tabs from a worker pool while reading the tab list.
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+	for a := 0; a < 8; a++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				tab, err := chrome.NewTab("https://www.example.com")
+				if nil != err {
+					t.Errorf("Expected nil, received error: %v", err)
+					continue
+				}
+				if _, err := chrome.GetTab(tab.Data().ID); nil != err {
+					t.Errorf("Expected nil, received error: %v", err)
+				}
+				_ = chrome.Tabs()
+				_, _ = chrome.Version()
+				if _, err := tab.Close(); nil != err {
+					t.Errorf("Expected nil, received error: %v", err)
+				}
+			}
+		}()
+	}
+	for a := 0; a < 40; a++ {
+		jobs <- a
+	}
+	close(jobs)
+	wg.Wait()
+
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected 0 tabs, found %d", len(chrome.Tabs()))
+	}
+}
+
+func TestChromiumConnectFailed(t *testing.T) {
+	chrome, err := Connect("devnul", 9222)
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if nil != chrome {
+		t.Errorf("Expected nil, received %v", chrome)
+	}
+}
+
 func TestChromiumClose(t *testing.T) {
 	chrome := New(
 		&Flags{},
@@ -138,3 +345,118 @@ func TestChromiumVersion(t *testing.T) {
 		t.Errorf("Expected nil, received %v", version)
 	}
 }
+
+func TestChromiumLaunchDynamicPort(t *testing.T) {
+	os.Setenv(mockProcessEnv, "devtools")
+	defer os.Unsetenv(mockProcessEnv)
+
+	chromes := []*Chrome{}
+	for a := 0; a < 2; a++ {
+		chrome := New(
+			&Flags{
+				"remote-debugging-port": 0,
+			},
+			os.Args[0],
+			"", //"path/to/stderr",
+			"", //"path/to/stdout",
+			"", //"path/to/workdir",
+		)
+		start := time.Now()
+		if err := chrome.Launch(); nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+		defer chrome.Close()
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Expected launch to finish when the endpoint is reported, %s elapsed", elapsed)
+		}
+		if 0 == chrome.Port() || 9222 == chrome.Port() {
+			t.Errorf("Expected a dynamic port, found %d", chrome.Port())
+		}
+		if 0 != chrome.DebuggingPort() {
+			t.Errorf("Expected remote-debugging-port 0, found %d", chrome.DebuggingPort())
+		}
+		version, err := chrome.Version()
+		if nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		} else if "HeadlessChrome/100.0.4896.60" != version.Browser {
+			t.Errorf("Expected 'HeadlessChrome/100.0.4896.60', received '%s'", version.Browser)
+		}
+		chromes = append(chromes, chrome)
+	}
+	if chromes[0].Port() == chromes[1].Port() {
+		t.Errorf("Expected different ports, found %d twice", chromes[0].Port())
+	}
+}
+
+func TestChromiumLaunchPipe(t *testing.T) {
+	os.Setenv(mockProcessEnv, "pipe")
+	defer os.Unsetenv(mockProcessEnv)
+
+	chrome := New(
+		&Flags{
+			"remote-debugging-pipe": nil,
+		},
+		os.Args[0],
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	if err := chrome.Launch(); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	if chrome.Flags().Has("remote-debugging-port") {
+		t.Errorf("Expected no remote-debugging-port flag in pipe mode")
+	}
+	if nil == chrome.Pipe() {
+		t.Fatalf("Expected a pipe socket, received nil")
+	}
+	version, err := chrome.Version()
+	if nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	} else if "HeadlessChrome/100.0.4896.60" != version.Browser {
+		t.Errorf("Expected 'HeadlessChrome/100.0.4896.60', received '%s'", version.Browser)
+	}
+}
+
+func TestChromiumCloseTabsTimeout(t *testing.T) {
+	// A hung browser never answers the close query.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+
+	chrome := New(
+		&Flags{
+			"addr": serverURL.Hostname(),
+			"port": port,
+		},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	chrome.SetShutdownPolicy(&ShutdownPolicy{
+		TabCloseGracePeriod: 100 * time.Millisecond,
+	})
+	tabURL, _ := url.Parse("https://TestChromiumCloseTabsTimeout")
+	chrome.addTab(&Tab{
+		chrome: chrome,
+		data:   &TabData{ID: "TestChromiumCloseTabsTimeout"},
+		mux:    &sync.Mutex{},
+		socket: NewMockSocket(tabURL),
+		url:    tabURL,
+	})
+
+	start := time.Now()
+	chrome.closeTabs()
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the tab close to time out, %s elapsed", elapsed)
+	}
+	if 0 != len(chrome.Tabs()) {
+		t.Errorf("Expected the tab to be detached, found %d tabs", len(chrome.Tabs()))
+	}
+}
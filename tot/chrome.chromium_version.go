@@ -0,0 +1,176 @@
+package chrome
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+BinaryEnv is the environment variable checked first for the path to the
+Chromium binary.
+*/
+const BinaryEnv = "CHROME_BIN"
+
+/*
+DefaultBinary is the Chromium binary used when no binary can be found, for use
+with the mkenney/chromium-headless Docker image.
+*/
+const DefaultBinary = "/usr/bin/google-chrome"
+
+/*
+BinaryNames are the Chromium binary names searched for on the PATH, in order.
+*/
+var BinaryNames = []string{
+	"google-chrome",
+	"google-chrome-stable",
+	"chromium",
+	"chromium-browser",
+	"headless_shell",
+}
+
+/*
+FindBinary returns the path to the Chromium binary named by the CHROME_BIN
+environment variable, or the first of BinaryNames found on the PATH.
+*/
+func FindBinary() (string, error) {
+	if binary := os.Getenv(BinaryEnv); "" != binary {
+		path, err := exec.LookPath(binary)
+		if nil != err {
+			return "", errs.Wrap(err, codes.ChromeBinaryNotFound, fmt.Sprintf("%s binary '%s' not found", BinaryEnv, binary))
+		}
+		return path, nil
+	}
+	for _, name := range BinaryNames {
+		if path, err := exec.LookPath(name); nil == err {
+			return path, nil
+		}
+	}
+	return "", errs.New(codes.ChromeBinaryNotFound, fmt.Sprintf("none of %s found on the PATH", strings.Join(BinaryNames, ", ")))
+}
+
+/*
+BrowserVersion is a comparable Chromium version number in the
+MAJOR.MINOR.BUILD.PATCH format.
+*/
+type BrowserVersion struct {
+	Major int
+	Minor int
+	Build int
+	Patch int
+}
+
+/*
+ParseBrowserVersion parses a Chromium version number. The product name prefix
+reported by the /json/version endpoint, such as 'HeadlessChrome/', is ignored
+and missing trailing components default to 0, so '100.0.4896.60',
+'Chrome/100.0.4896.60' and '100' are all valid.
+*/
+func ParseBrowserVersion(version string) (BrowserVersion, error) {
+	number := strings.TrimSpace(version)
+	if idx := strings.LastIndex(number, "/"); idx >= 0 {
+		number = number[idx+1:]
+	}
+
+	parts := strings.Split(number, ".")
+	if len(parts) > 4 {
+		return BrowserVersion{}, errs.New(codes.ChromeVersionInvalid, fmt.Sprintf("invalid browser version '%s'", version))
+	}
+	values := [4]int{}
+	for a, part := range parts {
+		value, err := strconv.Atoi(part)
+		if nil != err || value < 0 {
+			return BrowserVersion{}, errs.New(codes.ChromeVersionInvalid, fmt.Sprintf("invalid browser version '%s'", version))
+		}
+		values[a] = value
+	}
+	return BrowserVersion{
+		Major: values[0],
+		Minor: values[1],
+		Build: values[2],
+		Patch: values[3],
+	}, nil
+}
+
+/*
+Compare returns -1 if version is older than other, 1 if it is newer and 0 if
+they are the same.
+*/
+func (version BrowserVersion) Compare(other BrowserVersion) int {
+	left := []int{version.Major, version.Minor, version.Build, version.Patch}
+	right := []int{other.Major, other.Minor, other.Build, other.Patch}
+	for a := range left {
+		if left[a] < right[a] {
+			return -1
+		}
+		if left[a] > right[a] {
+			return 1
+		}
+	}
+	return 0
+}
+
+/*
+String implements Stringer.
+*/
+func (version BrowserVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", version.Major, version.Minor, version.Build, version.Patch)
+}
+
+/*
+BrowserVersion returns the parsed Chromium version.
+*/
+func (chrome *Chrome) BrowserVersion() (BrowserVersion, error) {
+	version, err := chrome.Version()
+	if nil != err {
+		return BrowserVersion{}, err
+	}
+	return ParseBrowserVersion(version.Browser)
+}
+
+/*
+SetMinimumVersion sets the oldest Chromium version Launch accepts. An empty
+version removes the requirement.
+*/
+func (chrome *Chrome) SetMinimumVersion(version string) error {
+	var minimum *BrowserVersion
+	if "" != version {
+		parsed, err := ParseBrowserVersion(version)
+		if nil != err {
+			return err
+		}
+		minimum = &parsed
+	}
+	chrome.mux.Lock()
+	defer chrome.mux.Unlock()
+	chrome.minimumVersion = minimum
+	return nil
+}
+
+/*
+CheckVersion returns a codes.ChromeVersionUnsupported error if the browser is
+older than the minimum version. Launch checks the version automatically; call
+CheckVersion after Connect to validate a running browser.
+*/
+func (chrome *Chrome) CheckVersion() error {
+	chrome.mux.Lock()
+	minimum := chrome.minimumVersion
+	chrome.mux.Unlock()
+	if nil == minimum {
+		return nil
+	}
+
+	version, err := chrome.BrowserVersion()
+	if nil != err {
+		return err
+	}
+	if version.Compare(*minimum) < 0 {
+		return errs.New(codes.ChromeVersionUnsupported, fmt.Sprintf("browser version %s is older than the minimum supported version %s", version, minimum))
+	}
+	return nil
+}
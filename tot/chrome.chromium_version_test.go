@@ -0,0 +1,179 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestFindBinary(t *testing.T) {
+	if "windows" == runtime.GOOS {
+		t.Skip("PATH lookup requires an executable file extension")
+	}
+	dir, err := ioutil.TempDir("", "TestFindBinary")
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"chromium-browser", "headless_shell"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755); nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+	}
+	t.Setenv("PATH", dir)
+
+	t.Setenv(BinaryEnv, "")
+	binary, err := FindBinary()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if filepath.Join(dir, "chromium-browser") != binary {
+		t.Errorf("Expected '%s', received '%s'", filepath.Join(dir, "chromium-browser"), binary)
+	}
+	if binary != New(&Flags{}, "", "", "", "").Binary() {
+		t.Errorf("Expected Binary() to return '%s'", binary)
+	}
+
+	t.Setenv(BinaryEnv, "headless_shell")
+	binary, err = FindBinary()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if filepath.Join(dir, "headless_shell") != binary {
+		t.Errorf("Expected '%s', received '%s'", filepath.Join(dir, "headless_shell"), binary)
+	}
+
+	t.Setenv(BinaryEnv, filepath.Join(dir, "chrome"))
+	_, err = FindBinary()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	} else if codes.ChromeBinaryNotFound != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %d, received %d", codes.ChromeBinaryNotFound, err.(errs.Err).Code())
+	}
+	chrome := New(&Flags{}, "", "", "", "")
+	if filepath.Join(dir, "chrome") != chrome.Binary() {
+		t.Errorf("Expected '%s', received '%s'", filepath.Join(dir, "chrome"), chrome.Binary())
+	}
+	err = chrome.Launch()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	} else if codes.ChromeBinaryNotFound != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %d, received %d", codes.ChromeBinaryNotFound, err.(errs.Err).Code())
+	}
+
+	t.Setenv(BinaryEnv, "")
+	t.Setenv("PATH", "")
+	_, err = FindBinary()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	} else if codes.ChromeBinaryNotFound != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %d, received %d", codes.ChromeBinaryNotFound, err.(errs.Err).Code())
+	}
+}
+
+func TestParseBrowserVersion(t *testing.T) {
+	for input, expected := range map[string]BrowserVersion{
+		"HeadlessChrome/100.0.4896.60": {100, 0, 4896, 60},
+		"Chrome/79.0.3945.117":         {79, 0, 3945, 117},
+		"100.0.4896":                   {100, 0, 4896, 0},
+		"90":                           {90, 0, 0, 0},
+	} {
+		version, err := ParseBrowserVersion(input)
+		if nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		}
+		if expected != version {
+			t.Errorf("Expected %s, received %s", expected, version)
+		}
+	}
+
+	for _, input := range []string{"", "Chrome/", "HeadlessChrome/100.0.x", "1.2.3.4.5", "-1"} {
+		_, err := ParseBrowserVersion(input)
+		if nil == err {
+			t.Errorf("Expected error for '%s', received nil", input)
+		} else if codes.ChromeVersionInvalid != err.(errs.Err).Code() {
+			t.Errorf("Expected error code %d, received %d", codes.ChromeVersionInvalid, err.(errs.Err).Code())
+		}
+	}
+}
+
+func TestBrowserVersionCompare(t *testing.T) {
+	version := BrowserVersion{100, 0, 4896, 60}
+	for other, expected := range map[BrowserVersion]int{
+		{100, 0, 4896, 60}: 0,
+		{100, 0, 4896, 61}: -1,
+		{100, 0, 4895, 99}: 1,
+		{99, 9, 9999, 99}:  1,
+		{101, 0, 0, 0}:     -1,
+	} {
+		if result := version.Compare(other); expected != result {
+			t.Errorf("Expected %d comparing %s to %s, received %d", expected, version, other, result)
+		}
+	}
+}
+
+func TestChromiumMinimumVersion(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	host, _ := devtools.Flags().Get("addr")
+	port, _ := devtools.Flags().Get("port")
+	chrome, err := Connect(host.(string), port.(int))
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer chrome.Close()
+
+	version, err := chrome.BrowserVersion()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if (BrowserVersion{100, 0, 4896, 60}) != version {
+		t.Errorf("Expected 100.0.4896.60, received %s", version)
+	}
+
+	if err := chrome.CheckVersion(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if err := chrome.SetMinimumVersion("99.0.4844.51"); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if err := chrome.CheckVersion(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if err := chrome.SetMinimumVersion("101"); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	err = chrome.CheckVersion()
+	if nil == err {
+		t.Errorf("Expected error, received nil")
+	} else if codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %d, received %d", codes.ChromeVersionUnsupported, err.(errs.Err).Code())
+	}
+
+	if err := chrome.SetMinimumVersion("latest"); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
+
+func TestChromiumLaunchUnsupportedVersion(t *testing.T) {
+	os.Setenv(mockProcessEnv, "devtools")
+	defer os.Unsetenv(mockProcessEnv)
+
+	chrome := New(&Flags{"remote-debugging-port": 0}, os.Args[0], "", "", "")
+	if err := chrome.SetMinimumVersion("101"); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	err := chrome.Launch()
+	if nil == err {
+		chrome.Close()
+		t.Fatalf("Expected error, received nil")
+	}
+	if codes.ChromeVersionUnsupported != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %d, received %d", codes.ChromeVersionUnsupported, err.(errs.Err).Code())
+	}
+}
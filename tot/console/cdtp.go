@@ -1,36 +1,42 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package console provides type definitions for use with the Chrome Console protocol
 
+This domain is deprecated - use Runtime or Log instead. DEPRECATED.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Console/
 */
 package console
 
 /*
-Message represents a console message.
+Message represents the Console.ConsoleMessage type.
+
+Console message.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#type-ConsoleMessage
 */
 type Message struct {
 	// Message source. Allowed values:
-	//	- MessageSource.XML         - "xml"
-	//	- MessageSource.Javascript  - "javascript"
-	//	- MessageSource.Network     - "network"
-	//	- MessageSource.ConsoleAPI  - "console-api"
-	//	- MessageSource.Storage     - "storage"
-	//	- MessageSource.Appcache    - "appcache"
-	//	- MessageSource.Rendering   - "rendering"
-	//	- MessageSource.Security    - "security"
-	//	- MessageSource.Other       - "other"
-	//	- MessageSource.Deprecation - "deprecation"
-	//	- MessageSource.Worker      - "worker"
+	//   - MessageSource.XML
+	//   - MessageSource.Javascript
+	//   - MessageSource.Network
+	//   - MessageSource.ConsoleAPI
+	//   - MessageSource.Storage
+	//   - MessageSource.Appcache
+	//   - MessageSource.Rendering
+	//   - MessageSource.Security
+	//   - MessageSource.Other
+	//   - MessageSource.Deprecation
+	//   - MessageSource.Worker
 	Source MessageSourceEnum `json:"source"`
 
 	// Message severity. Allowed values:
-	//	- MessageLevel.Log     - "log"
-	//	- MessageLevel.Warning - "warning"
-	//	- MessageLevel.Error   - "error"
-	//	- MessageLevel.Debug   - "debug"
-	//	- MessageLevel.Info    - "info"
+	//   - MessageLevel.Log
+	//   - MessageLevel.Warning
+	//   - MessageLevel.Error
+	//   - MessageLevel.Debug
+	//   - MessageLevel.Info
 	Level MessageLevelEnum `json:"level"`
 
 	// Message text.
@@ -39,8 +45,7 @@ type Message struct {
 	// Optional. URL of the message origin.
 	URL string `json:"url,omitempty"`
 
-	// Optional. Line number in the resource that generated this message
-	// (1-based).
+	// Optional. Line number in the resource that generated this message (1-based).
 	Line int `json:"line,omitempty"`
 
 	// Optional. Column number in the resource that generated this message
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package console
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type messageLevelEnum struct {
@@ -14,7 +17,7 @@ type messageLevelEnum struct {
 }
 
 /*
-MessageLevel provides named acces to the MessageLevelEnum values.
+MessageLevel provides named access to the MessageLevelEnum values.
 */
 var MessageLevel = messageLevelEnum{
 	Log:     MessageLevelLog,
@@ -25,12 +28,16 @@ var MessageLevel = messageLevelEnum{
 }
 
 /*
-MessageLevelEnum represents the message severity. Allowed values:
-	- MessageLevel.Log     "log"
-	- MessageLevel.Warning "warning"
-	- MessageLevel.Error   "error"
-	- MessageLevel.Debug   "debug"
-	- MessageLevel.Info    "info"
+MessageLevelEnum represents the Console.ConsoleMessage.level values.
+
+Message severity.
+
+Allowed values:
+  - MessageLevel.Log     "log"
+  - MessageLevel.Warning "warning"
+  - MessageLevel.Error   "error"
+  - MessageLevel.Debug   "debug"
+  - MessageLevel.Info    "info"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#type-ConsoleMessage
 */
@@ -40,7 +47,16 @@ type MessageLevelEnum int
 String implements Stringer
 */
 func (enum MessageLevelEnum) String() string {
-	return _messageLevelEnums[enum]
+	return _messageLevelEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MessageLevelEnum) Unknown() bool {
+	return !_messageLevelEnums.Known(enum)
 }
 
 /*
@@ -51,25 +67,16 @@ func (enum MessageLevelEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *MessageLevelEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _messageLevelEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid source value", bytes)
+	*enum = _messageLevelEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -85,10 +92,11 @@ const (
 	MessageLevelInfo
 )
 
-var _messageLevelEnums = map[MessageLevelEnum]string{
+var _messageLevelEnums = enums.New(map[MessageLevelEnum]string{
+	MessageLevelEnum(0): "",
 	MessageLevelLog:     "log",
 	MessageLevelWarning: "warning",
 	MessageLevelError:   "error",
 	MessageLevelDebug:   "debug",
 	MessageLevelInfo:    "info",
-}
+})
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package console
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type messageSourceEnum struct {
@@ -20,7 +23,7 @@ type messageSourceEnum struct {
 }
 
 /*
-MessageSource provides named acces to the MessageSourceEnum values.
+MessageSource provides named access to the MessageSourceEnum values.
 */
 var MessageSource = messageSourceEnum{
 	XML:         MessageSourceXML,
@@ -37,18 +40,24 @@ var MessageSource = messageSourceEnum{
 }
 
 /*
-MessageSourceEnum represents the message source. Allowed values:
-	- MessageSource.XML         "xml"
-	- MessageSource.Javascript  "javascript"
-	- MessageSource.Network     "network"
-	- MessageSource.ConsoleAPI  "console-api"
-	- MessageSource.Storage     "storage"
-	- MessageSource.Appcache    "appcache"
-	- MessageSource.Rendering   "rendering"
-	- MessageSource.Security    "security"
-	- MessageSource.Other       "other"
-	- MessageSource.Deprecation "deprecation"
-	- MessageSource.Worker      "worker"
+MessageSourceEnum represents the Console.ConsoleMessage.source values.
+
+Message source.
+
+Allowed values:
+  - MessageSource.XML         "xml"
+  - MessageSource.Javascript  "javascript"
+  - MessageSource.Network     "network"
+  - MessageSource.ConsoleAPI  "console-api"
+  - MessageSource.Storage     "storage"
+  - MessageSource.Appcache    "appcache"
+  - MessageSource.Rendering   "rendering"
+  - MessageSource.Security    "security"
+  - MessageSource.Other       "other"
+  - MessageSource.Deprecation "deprecation"
+  - MessageSource.Worker      "worker"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/#type-ConsoleMessage
 */
 type MessageSourceEnum int
 
@@ -56,7 +65,16 @@ type MessageSourceEnum int
 String implements Stringer
 */
 func (enum MessageSourceEnum) String() string {
-	return _sourceEnums[enum]
+	return _messageSourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MessageSourceEnum) Unknown() bool {
+	return !_messageSourceEnums.Known(enum)
 }
 
 /*
@@ -67,53 +85,45 @@ func (enum MessageSourceEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *MessageSourceEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _sourceEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid source value", bytes)
+	*enum = _messageSourceEnums.Parse(val)
+	return nil
 }
 
 const (
-	// MessageSourceXML represents the "xml" value
+	// MessageSourceXML represents the "xml" value.
 	MessageSourceXML MessageSourceEnum = iota + 1
-	// MessageSourceJavascript represents the "javascript" value
+	// MessageSourceJavascript represents the "javascript" value.
 	MessageSourceJavascript
-	// MessageSourceNetwork represents the "network" value
+	// MessageSourceNetwork represents the "network" value.
 	MessageSourceNetwork
-	// MessageSourceConsoleAPI represents the "console-api" value
+	// MessageSourceConsoleAPI represents the "console-api" value.
 	MessageSourceConsoleAPI
-	// MessageSourceStorage represents the "storage" value
+	// MessageSourceStorage represents the "storage" value.
 	MessageSourceStorage
-	// MessageSourceAppcache represents the "appcache" value
+	// MessageSourceAppcache represents the "appcache" value.
 	MessageSourceAppcache
-	// MessageSourceRendering represents the "rendering" value
+	// MessageSourceRendering represents the "rendering" value.
 	MessageSourceRendering
-	// MessageSourceSecurity represents the "security" value
+	// MessageSourceSecurity represents the "security" value.
 	MessageSourceSecurity
-	// MessageSourceOther represents the "other" value
+	// MessageSourceOther represents the "other" value.
 	MessageSourceOther
-	// MessageSourceDeprecation represents the "deprecation" value
+	// MessageSourceDeprecation represents the "deprecation" value.
 	MessageSourceDeprecation
-	// MessageSourceWorker represents the "worker" value
+	// MessageSourceWorker represents the "worker" value.
 	MessageSourceWorker
 )
 
-var _sourceEnums = map[MessageSourceEnum]string{
+var _messageSourceEnums = enums.New(map[MessageSourceEnum]string{
+	MessageSourceEnum(0):     "",
 	MessageSourceXML:         "xml",
 	MessageSourceJavascript:  "javascript",
 	MessageSourceNetwork:     "network",
@@ -125,4 +135,4 @@ var _sourceEnums = map[MessageSourceEnum]string{
 	MessageSourceOther:       "other",
 	MessageSourceDeprecation: "deprecation",
 	MessageSourceWorker:      "worker",
-}
+})
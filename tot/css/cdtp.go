@@ -1,6 +1,17 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package css provides type definitions for use with the Chrome CSS protocol
 
+This domain exposes CSS read/write operations. All CSS objects (stylesheets,
+rules, and styles) have an associated `id` used in subsequent operations on the
+related object. Each object type has a specific `id` structure, and those are
+not interchangeable between objects of different kinds. CSS objects can be
+loaded using the `get*ForNode()` calls (which accept a DOM node id). A client
+can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved`
+events and subsequently load the required stylesheet contents using the
+`getStyleSheet[Text]()` methods. EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/
 */
 package css
@@ -11,14 +22,16 @@ import (
 )
 
 /*
-StyleSheetID is the ID of a stylesheet
+StyleSheetID represents the CSS.StyleSheetId type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-StyleSheetId
 */
 type StyleSheetID string
 
 /*
-PseudoElementMatches is a CSS rule collection for a single pseudo style.
+PseudoElementMatches represents the CSS.PseudoElementMatches type.
+
+CSS rule collection for a single pseudo style.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-PseudoElementMatches
 */
@@ -26,27 +39,79 @@ type PseudoElementMatches struct {
 	// Pseudo element type.
 	PseudoType dom.PseudoType `json:"pseudoType"`
 
+	// Optional. Pseudo element custom ident.
+	PseudoIdentifier string `json:"pseudoIdentifier,omitempty"`
+
 	// Matches of CSS rules applicable to the pseudo style.
 	Matches []*RuleMatch `json:"matches"`
 }
 
 /*
-InheritedStyleEntry is an inherited CSS rule collection from ancestor node.
+AnimationStyle represents the CSS.CSSAnimationStyle type.
+
+CSS style coming from animations with the name of the animation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSAnimationStyle
+*/
+type AnimationStyle struct {
+	// Optional. The name of the animation.
+	Name string `json:"name,omitempty"`
+
+	// The style coming from the animation.
+	Style *Style `json:"style"`
+}
+
+/*
+InheritedStyleEntry represents the CSS.InheritedStyleEntry type.
+
+Inherited CSS rule collection from ancestor node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-InheritedStyleEntry
 */
 type InheritedStyleEntry struct {
-	// Optional. The ancestor node's inline style, if any, in the style
-	// inheritance chain.
+	// Optional. The ancestor node's inline style, if any, in the style inheritance
+	// chain.
 	InlineStyle *Style `json:"inlineStyle,omitempty"`
 
-	// Matches of CSS rules matching the ancestor node in the style
-	// inheritance chain.
+	// Matches of CSS rules matching the ancestor node in the style inheritance
+	// chain.
 	MatchedRules []*RuleMatch `json:"matchedCSSRules"`
 }
 
 /*
-RuleMatch is match data for a CSS rule.
+InheritedAnimatedStyleEntry represents the CSS.InheritedAnimatedStyleEntry type.
+
+Inherited CSS style collection for animated styles from ancestor node.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-InheritedAnimatedStyleEntry
+*/
+type InheritedAnimatedStyleEntry struct {
+	// Optional. Styles coming from the animations of the ancestor, if any, in the
+	// style inheritance chain.
+	AnimationStyles []*AnimationStyle `json:"animationStyles,omitempty"`
+
+	// Optional. The style coming from the transitions of the ancestor, if any, in
+	// the style inheritance chain.
+	TransitionsStyle *Style `json:"transitionsStyle,omitempty"`
+}
+
+/*
+InheritedPseudoElementMatches represents the CSS.InheritedPseudoElementMatches
+type.
+
+Inherited pseudo element matches from pseudos of an ancestor node.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-InheritedPseudoElementMatches
+*/
+type InheritedPseudoElementMatches struct {
+	// Matches of pseudo styles from the pseudos of an ancestor node.
+	PseudoElements []*PseudoElementMatches `json:"pseudoElements"`
+}
+
+/*
+RuleMatch represents the CSS.RuleMatch type.
+
+Match data for a CSS rule.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-RuleMatch
 */
@@ -59,7 +124,9 @@ type RuleMatch struct {
 }
 
 /*
-Value represents data for a simple selector (these are delimited by commas in a selector list).
+Value represents the CSS.Value type.
+
+Data for a simple selector (these are delimited by commas in a selector list).
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-Value
 */
@@ -69,10 +136,35 @@ type Value struct {
 
 	// Optional. Value range in the underlying resource (if available).
 	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Specificity of the selector. EXPERIMENTAL.
+	Specificity *Specificity `json:"specificity,omitempty"`
+}
+
+/*
+Specificity represents the CSS.Specificity type.
+
+Specificity: https://drafts.csswg.org/selectors/#specificity-rules EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-Specificity
+*/
+type Specificity struct {
+	// The a component, which represents the number of ID selectors.
+	A int `json:"a"`
+
+	// The b component, which represents the number of class selectors, attributes
+	// selectors, and pseudo-classes.
+	B int `json:"b"`
+
+	// The c component, which represents the number of type selectors and
+	// pseudo-elements.
+	C int `json:"c"`
 }
 
 /*
-SelectorList represents selector list data.
+SelectorList represents the CSS.SelectorList type.
+
+Selector list data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-SelectorList
 */
@@ -85,7 +177,9 @@ type SelectorList struct {
 }
 
 /*
-StyleSheetHeader holds CSS stylesheet metainformation.
+StyleSheetHeader represents the CSS.CSSStyleSheetHeader type.
+
+CSS stylesheet metainformation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSStyleSheetHeader
 */
@@ -96,23 +190,26 @@ type StyleSheetHeader struct {
 	// Owner frame identifier.
 	FrameID page.FrameID `json:"frameId"`
 
-	// Stylesheet resource URL.
+	// Stylesheet resource URL. Empty if this is a constructed stylesheet created
+	// using new CSSStyleSheet() (but non-empty if this is a constructed stylesheet
+	// imported as a CSS module script).
 	SourceURL string `json:"sourceURL"`
 
 	// Optional. URL of source map associated with the stylesheet (if any).
 	SourceMapURL string `json:"sourceMapURL,omitempty"`
 
 	// Stylesheet origin. Allowed values:
-	//	- StyleSheetOrigin.Injected "injected"
-	//	- StyleSheetOrigin.UserAgent "user-agent"
-	//	- StyleSheetOrigin.Inspector "inspector"
-	//	- StyleSheetOrigin.Log "regular"
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
 	Origin StyleSheetOriginEnum `json:"origin"`
 
 	// Stylesheet title.
 	Title string `json:"title"`
 
-	// Optional. The backend ID for the owner node of the stylesheet.
+	// Optional. The backend id for the owner node of the stylesheet.
 	OwnerNode dom.BackendNodeID `json:"ownerNode,omitempty"`
 
 	// Denotes whether the stylesheet is disabled.
@@ -122,39 +219,65 @@ type StyleSheetHeader struct {
 	// comment.
 	HasSourceURL bool `json:"hasSourceURL,omitempty"`
 
-	// Whether this stylesheet is created for STYLE tag by parser. This flag is
-	// not set for
-	// document.written STYLE tags.
+	// Whether this stylesheet is created for STYLE tag by parser. This flag is not
+	// set for document.written STYLE tags.
 	IsInline bool `json:"isInline"`
 
+	// Whether this stylesheet is mutable. Inline stylesheets become mutable after
+	// they have been modified via CSSOM API. `<link>` element's stylesheets become
+	// mutable only if DevTools modifies them. Constructed stylesheets (new
+	// CSSStyleSheet()) are mutable immediately after creation.
+	IsMutable bool `json:"isMutable"`
+
+	// True if this stylesheet is created through new CSSStyleSheet() or imported
+	// as a CSS module script.
+	IsConstructed bool `json:"isConstructed"`
+
 	// Line offset of the stylesheet within the resource (zero based).
-	StartLine int64 `json:"startLine"`
+	StartLine float64 `json:"startLine"`
 
 	// Column offset of the stylesheet within the resource (zero based).
-	StartColumn int64 `json:"startColumn"`
+	StartColumn float64 `json:"startColumn"`
 
 	// Size of the content (in characters).
-	Length int64 `json:"length"`
+	Length float64 `json:"length"`
+
+	// Line offset of the end of the stylesheet within the resource (zero based).
+	EndLine float64 `json:"endLine"`
+
+	// Column offset of the end of the stylesheet within the resource (zero based).
+	EndColumn float64 `json:"endColumn"`
+
+	// Optional. If the style sheet was loaded from a network resource, this
+	// indicates when the resource failed to load EXPERIMENTAL.
+	LoadingFailed bool `json:"loadingFailed,omitempty"`
 }
 
 /*
-Rule is a CSS rule representation.
+Rule represents the CSS.CSSRule type.
+
+CSS rule representation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSRule
 */
 type Rule struct {
-	// Optional. The css style sheet identifier (absent for user agent s
-	// tylesheet and user-specified stylesheet rules) this rule came from.
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
 	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
 
 	// Rule selector data.
 	SelectorList *SelectorList `json:"selectorList"`
 
+	// Optional. Array of selectors from ancestor style rules, sorted by distance
+	// from the current rule. EXPERIMENTAL.
+	NestingSelectors []string `json:"nestingSelectors,omitempty"`
+
 	// Parent stylesheet's origin. Allowed values:
-	//	- StyleSheetOrigin.Injected "injected"
-	//	- StyleSheetOrigin.UserAgent "user-agent"
-	//	- StyleSheetOrigin.Inspector "inspector"
-	//	- StyleSheetOrigin.Log "regular"
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
 	Origin StyleSheetOriginEnum `json:"origin"`
 
 	// Associated style declaration.
@@ -163,10 +286,39 @@ type Rule struct {
 	// Optional. Media list array (for rules involving media queries). The array
 	// enumerates media queries starting with the innermost one, going outwards.
 	Media []*Media `json:"media,omitempty"`
+
+	// Optional. Container query list array (for rules involving container
+	// queries). The array enumerates container queries starting with the innermost
+	// one, going outwards. EXPERIMENTAL.
+	ContainerQueries []*ContainerQuery `json:"containerQueries,omitempty"`
+
+	// Optional. @supports CSS at-rule array. The array enumerates @supports
+	// at-rules starting with the innermost one, going outwards. EXPERIMENTAL.
+	Supports []*Supports `json:"supports,omitempty"`
+
+	// Optional. Cascade layer array. Contains the layer hierarchy that this rule
+	// belongs to starting with the innermost layer and going outwards.
+	// EXPERIMENTAL.
+	Layers []*Layer `json:"layers,omitempty"`
+
+	// Optional. @scope CSS at-rule array. The array enumerates @scope at-rules
+	// starting with the innermost one, going outwards. EXPERIMENTAL.
+	Scopes []*Scope `json:"scopes,omitempty"`
+
+	// Optional. The array keeps the types of ancestor CSSRules from the innermost
+	// going outwards. EXPERIMENTAL.
+	RuleTypes []RuleTypeEnum `json:"ruleTypes,omitempty"`
+
+	// Optional. @starting-style CSS at-rule array. The array enumerates
+	// @starting-style at-rules starting with the innermost one, going outwards.
+	// EXPERIMENTAL.
+	StartingStyles []*StartingStyle `json:"startingStyles,omitempty"`
 }
 
 /*
-RuleUsage holds CSS coverage information.
+RuleUsage represents the CSS.RuleUsage type.
+
+CSS coverage information.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-RuleUsage
 */
@@ -175,19 +327,21 @@ type RuleUsage struct {
 	// user-specified stylesheet rules) this rule came from.
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
 
-	// Offset of the start of the rule (including selector) from the beginning
-	// of the stylesheet.
+	// Offset of the start of the rule (including selector) from the beginning of
+	// the stylesheet.
 	StartOffset float64 `json:"startOffset"`
 
 	// Offset of the end of the rule body from the beginning of the stylesheet.
 	EndOffset float64 `json:"endOffset"`
 
-	// Indicates whether the rule was actually used by some element in the DOM.
+	// Indicates whether the rule was actually used by some element in the page.
 	Used bool `json:"used"`
 }
 
 /*
-SourceRange is a text range within a resource. All numbers are zero-based.
+SourceRange represents the CSS.SourceRange type.
+
+Text range within a resource. All numbers are zero-based.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-SourceRange
 */
@@ -198,7 +352,7 @@ type SourceRange struct {
 	// Start column of range (inclusive).
 	StartColumn int `json:"startColumn"`
 
-	// End line of range.
+	// End line of range
 	EndLine int `json:"endLine"`
 
 	// End column of range (exclusive).
@@ -206,7 +360,7 @@ type SourceRange struct {
 }
 
 /*
-ShorthandEntry holds a CSS shorthand entry
+ShorthandEntry represents the CSS.ShorthandEntry type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-ShorthandEntry
 */
@@ -217,13 +371,13 @@ type ShorthandEntry struct {
 	// Shorthand value.
 	Value string `json:"value"`
 
-	// Optional. Whether the property has "!important" annotation (implies false
+	// Optional. Whether the property has "!important" annotation (implies `false`
 	// if absent).
 	Important bool `json:"important,omitempty"`
 }
 
 /*
-ComputedStyleProperty holds a computed style property
+ComputedStyleProperty represents the CSS.CSSComputedStyleProperty type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSComputedStyleProperty
 */
@@ -236,13 +390,15 @@ type ComputedStyleProperty struct {
 }
 
 /*
-Style is a CSS style representation.
+Style represents the CSS.CSSStyle type.
+
+CSS style representation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSStyle
 */
 type Style struct {
-	// Optional. The css style sheet identifier (absent for user agent
-	// stylesheet and user-specified stylesheet rules) this rule came from.
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
 	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
 
 	// CSS properties in the style.
@@ -251,8 +407,8 @@ type Style struct {
 	// Computed values for all shorthands found in the style.
 	ShorthandEntries []*ShorthandEntry `json:"shorthandEntries"`
 
-	// Style declaration text (if available).
-	Text string `json:"cssText"`
+	// Optional. Style declaration text (if available).
+	Text string `json:"cssText,omitempty"`
 
 	// Optional. Style declaration range in the enclosing stylesheet (if
 	// available).
@@ -260,7 +416,9 @@ type Style struct {
 }
 
 /*
-Property holds CSS property declaration data.
+Property represents the CSS.CSSProperty type.
+
+CSS property declaration data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSProperty
 */
@@ -271,17 +429,17 @@ type Property struct {
 	// The property value.
 	Value string `json:"value"`
 
-	// Optional. Whether the property has "!important" annotation (implies false
+	// Optional. Whether the property has "!important" annotation (implies `false`
 	// if absent).
 	Important bool `json:"important,omitempty"`
 
-	// Optional. Whether the property is implicit (implies false if absent).
+	// Optional. Whether the property is implicit (implies `false` if absent).
 	Implicit bool `json:"implicit,omitempty"`
 
 	// Optional. The full property text as specified in the style.
 	Text string `json:"text,omitempty"`
 
-	// Optional. Whether the property is understood by the browser (implies true
+	// Optional. Whether the property is understood by the browser (implies `true`
 	// if absent).
 	ParsedOk bool `json:"parsedOk,omitempty"`
 
@@ -289,13 +447,20 @@ type Property struct {
 	// source-based properties only).
 	Disabled bool `json:"disabled,omitempty"`
 
-	// Optional. The entire property range in the enclosing style declaration
-	// (if available).
+	// Optional. The entire property range in the enclosing style declaration (if
+	// available).
 	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Parsed longhand components of this property if it is a shorthand.
+	// This field will be empty if the given property is not a shorthand.
+	// EXPERIMENTAL.
+	LonghandProperties []*Property `json:"longhandProperties,omitempty"`
 }
 
 /*
-Media holds a CSS media rule descriptor.
+Media represents the CSS.CSSMedia type.
+
+CSS media rule descriptor.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSMedia
 */
@@ -303,21 +468,21 @@ type Media struct {
 	// Media query text.
 	Text string `json:"text"`
 
-	// Source of the media query:
-	//	- Source.MediaRule "injected" if specified by a @media rule
-	//	- Source.ImportRule "user-agent" if specified by an @import rule
-	//	- Source.LinkedSheet "inspector" if specified by a "media" attribute in
-	//	a linked stylesheet's LINK tag
-	//	- Source.InlineSheet "regular" if specified by a "media" attribute in an
-	//	inline stylesheet's STYLE tag.
+	// Source of the media query: "mediaRule" if specified by a @media rule,
+	// "importRule" if specified by an @import rule, "linkedSheet" if specified by
+	// a "media" attribute in a linked stylesheet's LINK tag, "inlineSheet" if
+	// specified by a "media" attribute in an inline stylesheet's STYLE tag. Allowed values:
+	//   - Source.MediaRule
+	//   - Source.ImportRule
+	//   - Source.LinkedSheet
+	//   - Source.InlineSheet
 	Source SourceEnum `json:"source"`
 
 	// Optional. URL of the document containing the media query description.
 	SourceURL string `json:"sourceURL,omitempty"`
 
 	// Optional. The associated rule (@media or @import) header range in the
-	// enclosing stylesheet
-	// (if available).
+	// enclosing stylesheet (if available).
 	Range *SourceRange `json:"range,omitempty"`
 
 	// Optional. Identifier of the stylesheet containing this object (if exists).
@@ -328,7 +493,9 @@ type Media struct {
 }
 
 /*
-MediaQuery is a media query descriptor.
+MediaQuery represents the CSS.MediaQuery type.
+
+Media query descriptor.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-MediaQuery
 */
@@ -341,7 +508,9 @@ type MediaQuery struct {
 }
 
 /*
-MediaQueryExpression is a media query expression descriptor.
+MediaQueryExpression represents the CSS.MediaQueryExpression type.
+
+Media query expression descriptor.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-MediaQueryExpression
 */
@@ -355,8 +524,8 @@ type MediaQueryExpression struct {
 	// Media query expression feature.
 	Feature string `json:"feature"`
 
-	// Optional. The associated range of the value text in the enclosing
-	// stylesheet (if available).
+	// Optional. The associated range of the value text in the enclosing stylesheet
+	// (if available).
 	ValueRange *SourceRange `json:"valueRange,omitempty"`
 
 	// Optional. Computed length of media query expression (if applicable).
@@ -364,7 +533,144 @@ type MediaQueryExpression struct {
 }
 
 /*
-PlatformFontUsage holds information about the amount of glyphs that were rendered with given font.
+ContainerQuery represents the CSS.CSSContainerQuery type.
+
+CSS container query rule descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSContainerQuery
+*/
+type ContainerQuery struct {
+	// Container query text.
+	Text string `json:"text"`
+
+	// Optional. The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Optional. Optional name for the container.
+	Name string `json:"name,omitempty"`
+
+	// Optional. Optional physical axes queried for the container. Allowed values:
+	//   - dom.PhysicalAxes.Horizontal
+	//   - dom.PhysicalAxes.Vertical
+	//   - dom.PhysicalAxes.Both
+	PhysicalAxes dom.PhysicalAxesEnum `json:"physicalAxes,omitempty"`
+
+	// Optional. Optional logical axes queried for the container. Allowed values:
+	//   - dom.LogicalAxes.Inline
+	//   - dom.LogicalAxes.Block
+	//   - dom.LogicalAxes.Both
+	LogicalAxes dom.LogicalAxesEnum `json:"logicalAxes,omitempty"`
+
+	// Optional. true if the query contains scroll-state() queries.
+	QueriesScrollState bool `json:"queriesScrollState,omitempty"`
+
+	// Optional. true if the query contains anchored() queries.
+	QueriesAnchored bool `json:"queriesAnchored,omitempty"`
+}
+
+/*
+Supports represents the CSS.CSSSupports type.
+
+CSS Supports at-rule descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSSupports
+*/
+type Supports struct {
+	// Supports rule text.
+	Text string `json:"text"`
+
+	// Whether the supports condition is satisfied.
+	Active bool `json:"active"`
+
+	// Optional. The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+/*
+Scope represents the CSS.CSSScope type.
+
+CSS Scope at-rule descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSScope
+*/
+type Scope struct {
+	// Scope rule text.
+	Text string `json:"text"`
+
+	// Optional. The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+/*
+Layer represents the CSS.CSSLayer type.
+
+CSS Layer at-rule descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSLayer
+*/
+type Layer struct {
+	// Layer name.
+	Text string `json:"text"`
+
+	// Optional. The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+/*
+StartingStyle represents the CSS.CSSStartingStyle type.
+
+CSS Starting Style at-rule descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSStartingStyle
+*/
+type StartingStyle struct {
+	// Optional. The associated rule header range in the enclosing stylesheet (if
+	// available).
+	Range *SourceRange `json:"range,omitempty"`
+
+	// Optional. Identifier of the stylesheet containing this object (if exists).
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+}
+
+/*
+LayerData represents the CSS.CSSLayerData type.
+
+CSS Layer data. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSLayerData
+*/
+type LayerData struct {
+	// Layer name.
+	Name string `json:"name"`
+
+	// Optional. Direct sub-layers
+	SubLayers []*LayerData `json:"subLayers,omitempty"`
+
+	// Layer order. The order determines the order of the layer in the cascade
+	// order. A higher number has higher priority in the cascade order.
+	Order float64 `json:"order"`
+}
+
+/*
+PlatformFontUsage represents the CSS.PlatformFontUsage type.
+
+Information about amount of glyphs that were rendered with given font.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-PlatformFontUsage
 */
@@ -372,6 +678,9 @@ type PlatformFontUsage struct {
 	// Font's family name reported by platform.
 	FamilyName string `json:"familyName"`
 
+	// Font's PostScript name reported by platform.
+	PostScriptName string `json:"postScriptName"`
+
 	// Indicates if the font was downloaded or resolved locally.
 	IsCustomFont bool `json:"isCustomFont"`
 
@@ -380,7 +689,127 @@ type PlatformFontUsage struct {
 }
 
 /*
-KeyframesRule is a CSS keyframes rule representation.
+FontVariationAxis represents the CSS.FontVariationAxis type.
+
+# Information about font variation axes for variable fonts
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-FontVariationAxis
+*/
+type FontVariationAxis struct {
+	// The font-variation-setting tag (a.k.a. "axis tag").
+	Tag string `json:"tag"`
+
+	// Human-readable variation name in the default language (normally, "en").
+	Name string `json:"name"`
+
+	// The minimum value (inclusive) the font supports for this tag.
+	MinValue float64 `json:"minValue"`
+
+	// The maximum value (inclusive) the font supports for this tag.
+	MaxValue float64 `json:"maxValue"`
+
+	// The default value.
+	DefaultValue float64 `json:"defaultValue"`
+}
+
+/*
+FontFace represents the CSS.FontFace type.
+
+Properties of a web font:
+https://www.w3.org/TR/2008/REC-CSS2-20080411/fonts.html#font-descriptions and
+additional information such as platformFontFamily and fontVariationAxes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-FontFace
+*/
+type FontFace struct {
+	// The font-family.
+	FontFamily string `json:"fontFamily"`
+
+	// The font-style.
+	FontStyle string `json:"fontStyle"`
+
+	// The font-variant.
+	FontVariant string `json:"fontVariant"`
+
+	// The font-weight.
+	FontWeight string `json:"fontWeight"`
+
+	// The font-stretch.
+	FontStretch string `json:"fontStretch"`
+
+	// The font-display.
+	FontDisplay string `json:"fontDisplay"`
+
+	// The unicode-range.
+	UnicodeRange string `json:"unicodeRange"`
+
+	// The src.
+	Src string `json:"src"`
+
+	// The resolved platform font family
+	PlatformFontFamily string `json:"platformFontFamily"`
+
+	// Optional. Available variation settings (a.k.a. "axes").
+	FontVariationAxes []*FontVariationAxis `json:"fontVariationAxes,omitempty"`
+}
+
+/*
+TryRule represents the CSS.CSSTryRule type.
+
+CSS try rule representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSTryRule
+*/
+type TryRule struct {
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Parent stylesheet's origin. Allowed values:
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
+	Origin StyleSheetOriginEnum `json:"origin"`
+
+	// Associated style declaration.
+	Style *Style `json:"style"`
+}
+
+/*
+PositionTryRule represents the CSS.CSSPositionTryRule type.
+
+CSS @position-try rule representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSPositionTryRule
+*/
+type PositionTryRule struct {
+	// The prelude dashed-ident name
+	Name *Value `json:"name"`
+
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Parent stylesheet's origin. Allowed values:
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
+	Origin StyleSheetOriginEnum `json:"origin"`
+
+	// Associated style declaration.
+	Style *Style `json:"style"`
+
+	Active bool `json:"active"`
+}
+
+/*
+KeyframesRule represents the CSS.CSSKeyframesRule type.
+
+CSS keyframes rule representation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSKeyframesRule
 */
@@ -393,20 +822,182 @@ type KeyframesRule struct {
 }
 
 /*
-KeyframeRule is a CSS keyframe rule representation.
+PropertyRegistration represents the CSS.CSSPropertyRegistration type.
+
+# Representation of a custom property registration through CSS.registerProperty
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSPropertyRegistration
+*/
+type PropertyRegistration struct {
+	PropertyName string `json:"propertyName"`
+
+	// Optional.
+	InitialValue *Value `json:"initialValue,omitempty"`
+
+	Inherits bool `json:"inherits"`
+
+	Syntax string `json:"syntax"`
+}
+
+/*
+FontPaletteValuesRule represents the CSS.CSSFontPaletteValuesRule type.
+
+CSS font-palette-values rule representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSFontPaletteValuesRule
+*/
+type FontPaletteValuesRule struct {
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Parent stylesheet's origin. Allowed values:
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
+	Origin StyleSheetOriginEnum `json:"origin"`
+
+	// Associated font palette name.
+	FontPaletteName *Value `json:"fontPaletteName"`
+
+	// Associated style declaration.
+	Style *Style `json:"style"`
+}
+
+/*
+PropertyRule represents the CSS.CSSPropertyRule type.
+
+CSS property at-rule representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSPropertyRule
+*/
+type PropertyRule struct {
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Parent stylesheet's origin. Allowed values:
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
+	Origin StyleSheetOriginEnum `json:"origin"`
+
+	// Associated property name.
+	PropertyName *Value `json:"propertyName"`
+
+	// Associated style declaration.
+	Style *Style `json:"style"`
+}
+
+/*
+FunctionParameter represents the CSS.CSSFunctionParameter type.
+
+CSS function argument representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSFunctionParameter
+*/
+type FunctionParameter struct {
+	// The parameter name.
+	Name string `json:"name"`
+
+	// The parameter type.
+	Type string `json:"type"`
+}
+
+/*
+FunctionConditionNode represents the CSS.CSSFunctionConditionNode type.
+
+CSS function conditional block representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSFunctionConditionNode
+*/
+type FunctionConditionNode struct {
+	// Optional. Media query for this conditional block. Only one type of condition
+	// should be set.
+	Media *Media `json:"media,omitempty"`
+
+	// Optional. Container query for this conditional block. Only one type of
+	// condition should be set.
+	ContainerQueries *ContainerQuery `json:"containerQueries,omitempty"`
+
+	// Optional. @supports CSS at-rule condition. Only one type of condition should
+	// be set.
+	Supports *Supports `json:"supports,omitempty"`
+
+	// Block body.
+	Children []*FunctionNode `json:"children"`
+
+	// The condition text.
+	ConditionText string `json:"conditionText"`
+}
+
+/*
+FunctionNode represents the CSS.CSSFunctionNode type.
+
+Section of the body of a CSS function rule.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSFunctionNode
+*/
+type FunctionNode struct {
+	// Optional. A conditional block. If set, style should not be set.
+	Condition *FunctionConditionNode `json:"condition,omitempty"`
+
+	// Optional. Values set by this node. If set, condition should not be set.
+	Style *Style `json:"style,omitempty"`
+}
+
+/*
+FunctionRule represents the CSS.CSSFunctionRule type.
+
+CSS function at-rule representation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSFunctionRule
+*/
+type FunctionRule struct {
+	// Name of the function.
+	Name *Value `json:"name"`
+
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
+	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
+
+	// Parent stylesheet's origin. Allowed values:
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
+	Origin StyleSheetOriginEnum `json:"origin"`
+
+	// List of parameters.
+	Parameters []*FunctionParameter `json:"parameters"`
+
+	// Function body.
+	Children []*FunctionNode `json:"children"`
+}
+
+/*
+KeyframeRule represents the CSS.CSSKeyframeRule type.
+
+CSS keyframe rule representation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSKeyframeRule
 */
 type KeyframeRule struct {
-	// Optional. The css style sheet identifier (absent for user agent
-	// stylesheet and user-specified stylesheet rules) this rule came from.
+	// Optional. The css style sheet identifier (absent for user agent stylesheet
+	// and user-specified stylesheet rules) this rule came from.
 	StyleSheetID StyleSheetID `json:"styleSheetId,omitempty"`
 
 	// Parent stylesheet's origin. Allowed values:
-	//	- StyleSheetOrigin.Injected "injected"
-	//	- StyleSheetOrigin.UserAgent "user-agent"
-	//	- StyleSheetOrigin.Inspector "inspector"
-	//	- StyleSheetOrigin.Log "regular"
+	//   - StyleSheetOrigin.Injected
+	//   - StyleSheetOrigin.UserAgent
+	//   - StyleSheetOrigin.Inspector
+	//   - StyleSheetOrigin.Regular
+	//   - StyleSheetOrigin.Log
 	Origin StyleSheetOriginEnum `json:"origin"`
 
 	// Associated key text.
@@ -417,7 +1008,9 @@ type KeyframeRule struct {
 }
 
 /*
-StyleDeclarationEdit is a descriptor of operation to mutate style declaration text.
+StyleDeclarationEdit represents the CSS.StyleDeclarationEdit type.
+
+A descriptor of operation to mutate style declaration text.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-StyleDeclarationEdit
 */
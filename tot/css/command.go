@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
@@ -19,6 +21,13 @@ type AddRuleParams struct {
 
 	// Text position of a new rule in the target style sheet.
 	Location *SourceRange `json:"location"`
+
+	// Optional. NodeId for the DOM node in whose context custom property
+	// declarations for registered properties should be validated. If omitted,
+	// declarations in the new rule text can only be validated statically, which
+	// may produce incorrect results if the declaration contains a var() for
+	// example. EXPERIMENTAL.
+	NodeForPropertySyntaxValidation *dom.NodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
 }
 
 /*
@@ -62,7 +71,13 @@ CreateStyleSheetParams represents CSS.createStyleSheet parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-createStyleSheet
 */
 type CreateStyleSheetParams struct {
+	// Identifier of the frame where "via-inspector" stylesheet should be created.
 	FrameID page.FrameID `json:"frameId"`
+
+	// Optional. If true, creates a new stylesheet for every call. If false,
+	// returns a stylesheet previously created by a call with force=false for the
+	// frame's document if it exists or creates a new stylesheet (default: false).
+	Force *bool `json:"force,omitempty"`
 }
 
 /*
@@ -104,28 +119,50 @@ ForcePseudoStateParams represents CSS.forcePseudoState parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoState
 */
 type ForcePseudoStateParams struct {
-	// The element ID for which to force the pseudo state.
+	// The element id for which to force the pseudo state.
 	NodeID dom.NodeID `json:"nodeId"`
 
-	// Element pseudo classes to force when computing the element's style.
-	// Allowed values:
-	//	- ForcedPseudoClasses.Active
-	//	- ForcedPseudoClasses.Focus
-	//	- ForcedPseudoClasses.Hover
-	//	- ForcedPseudoClasses.Visited
+	// Element pseudo classes to force when computing the element's style. Allowed values:
+	//   - ForcedPseudoClasses.Active
+	//   - ForcedPseudoClasses.Focus
+	//   - ForcedPseudoClasses.Hover
+	//   - ForcedPseudoClasses.Visited
 	ForcedPseudoClasses []ForcedPseudoClassesEnum `json:"forcedPseudoClasses"`
 }
 
 /*
-ForcePseudoStateResult represents the result of calls to CSS.ForcePseudoState.
+ForcePseudoStateResult represents the result of calls to CSS.forcePseudoState.
 
-https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-ForcePseudoState
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoState
 */
 type ForcePseudoStateResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+ForceStartingStyleParams represents CSS.forceStartingStyle parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forceStartingStyle
+*/
+type ForceStartingStyleParams struct {
+	// The element id for which to force the starting-style state.
+	NodeID dom.NodeID `json:"nodeId"`
+
+	// Boolean indicating if this is on or off.
+	Forced bool `json:"forced"`
+}
+
+/*
+ForceStartingStyleResult represents the result of calls to CSS.forceStartingStyle.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forceStartingStyle
+*/
+type ForceStartingStyleResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetBackgroundColorsParams represents CSS.getBackgroundColors parameters.
 
@@ -142,12 +179,12 @@ GetBackgroundColorsResult represents the result of calls to CSS.getBackgroundCol
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getBackgroundColors
 */
 type GetBackgroundColorsResult struct {
-	// Optional. The range of background colors behind this element, if it
-	// contains any visible text. If no visible text is present, this will be
-	// undefined. In the case of a flat background color, this will consist of
-	// simply that color. In the case of a gradient, this will consist of each
-	// of the color stops. For anything more complicated, this will be an empty
-	// array. Images will be ignored (as if the image had failed to load).
+	// Optional. The range of background colors behind this element, if it contains
+	// any visible text. If no visible text is present, this will be undefined. In
+	// the case of a flat background color, this will consist of simply that color.
+	// In the case of a gradient, this will consist of each of the color stops. For
+	// anything more complicated, this will be an empty array. Images will be
+	// ignored (as if the image had failed to load).
 	BackgroundColors []string `json:"backgroundColors,omitempty"`
 
 	// Optional. The computed font size for this node, as a CSS computed value
@@ -155,12 +192,11 @@ type GetBackgroundColorsResult struct {
 	ComputedFontSize string `json:"computedFontSize,omitempty"`
 
 	// Optional. The computed font weight for this node, as a CSS computed value
-	// string (e.g.
-	// 'normal' or '100').
+	// string (e.g. 'normal' or '100').
 	ComputedFontWeight string `json:"computedFontWeight,omitempty"`
 
 	// Optional. The computed font size for the document body, as a computed CSS
-	// value string (e.g. '16px').
+	// value string (e.g. '16px'). DEPRECATED.
 	ComputedBodyFontSize string `json:"computedBodyFontSize,omitempty"`
 
 	// Error information related to executing this method
@@ -189,6 +225,65 @@ type GetComputedStyleForNodeResult struct {
 	Err error `json:"-"`
 }
 
+/*
+ResolveValuesParams represents CSS.resolveValues parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-resolveValues
+*/
+type ResolveValuesParams struct {
+	// Substitution functions (var()/env()/attr()) and cascade-dependent keywords
+	// (revert/revert-layer) do not work.
+	Values []string `json:"values"`
+
+	// Id of the node in whose context the expression is evaluated
+	NodeID dom.NodeID `json:"nodeId"`
+
+	// Optional. Only longhands and custom property names are accepted.
+	PropertyName *string `json:"propertyName,omitempty"`
+
+	// Optional. Pseudo element type, only works for pseudo elements that generate
+	// elements in the tree, such as ::before and ::after.
+	PseudoType *dom.PseudoType `json:"pseudoType,omitempty"`
+
+	// Optional. Pseudo element custom ident.
+	PseudoIdentifier *string `json:"pseudoIdentifier,omitempty"`
+}
+
+/*
+ResolveValuesResult represents the result of calls to CSS.resolveValues.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-resolveValues
+*/
+type ResolveValuesResult struct {
+	Results []string `json:"results"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetLonghandPropertiesParams represents CSS.getLonghandProperties parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLonghandProperties
+*/
+type GetLonghandPropertiesParams struct {
+	ShorthandName string `json:"shorthandName"`
+
+	Value string `json:"value"`
+}
+
+/*
+GetLonghandPropertiesResult represents the result of calls to CSS.getLonghandProperties.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLonghandProperties
+*/
+type GetLonghandPropertiesResult struct {
+	LonghandProperties []*Property `json:"longhandProperties"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetInlineStylesForNodeParams represents CSS.getInlineStylesForNode parameters.
 
@@ -215,6 +310,35 @@ type GetInlineStylesForNodeResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetAnimatedStylesForNodeParams represents CSS.getAnimatedStylesForNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getAnimatedStylesForNode
+*/
+type GetAnimatedStylesForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+GetAnimatedStylesForNodeResult represents the result of calls to CSS.getAnimatedStylesForNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getAnimatedStylesForNode
+*/
+type GetAnimatedStylesForNodeResult struct {
+	// Optional. Styles coming from animations.
+	AnimationStyles []*AnimationStyle `json:"animationStyles,omitempty"`
+
+	// Optional. Style coming from transitions.
+	TransitionsStyle *Style `json:"transitionsStyle,omitempty"`
+
+	// Optional. Inherited style entries for animationsStyle and transitionsStyle
+	// from the inheritance chain of the element.
+	Inherited []*InheritedAnimatedStyleEntry `json:"inherited,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetMatchedStylesForNodeParams represents CSS.getMatchedStylesForNode parameters.
 
@@ -230,26 +354,67 @@ GetMatchedStylesForNodeResult represents the result of calls to CSS.getMatchedSt
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMatchedStylesForNode
 */
 type GetMatchedStylesForNodeResult struct {
-	// Inline style for the specified DOM node.
+	// Optional. Inline style for the specified DOM node.
 	InlineStyle *Style `json:"inlineStyle,omitempty"`
 
-	// Attribute-defined element style (e.g. resulting from "width=20
+	// Optional. Attribute-defined element style (e.g. resulting from "width=20
 	// height=100%").
 	AttributesStyle *Style `json:"attributesStyle,omitempty"`
 
-	// CSS rules matching this node, from all applicable stylesheets.
+	// Optional. CSS rules matching this node, from all applicable stylesheets.
 	MatchedRules []*RuleMatch `json:"matchedCSSRules,omitempty"`
 
-	// Pseudo style matches for this node.
+	// Optional. Pseudo style matches for this node.
 	PseudoElements []*PseudoElementMatches `json:"pseudoElements,omitempty"`
 
-	// A chain of inherited styles (from the immediate node parent up to the DOM
-	// tree root).
+	// Optional. A chain of inherited styles (from the immediate node parent up to
+	// the DOM tree root).
 	Inherited []*InheritedStyleEntry `json:"inherited,omitempty"`
 
-	// A list of CSS keyframed animations matching this node.
+	// Optional. A chain of inherited pseudo element styles (from the immediate
+	// node parent up to the DOM tree root).
+	InheritedPseudoElements []*InheritedPseudoElementMatches `json:"inheritedPseudoElements,omitempty"`
+
+	// Optional. A list of CSS keyframed animations matching this node.
 	KeyframesRules []*KeyframesRule `json:"cssKeyframesRules,omitempty"`
 
+	// Optional. A list of CSS @position-try rules matching this node, based on the
+	// position-try-fallbacks property.
+	CSSPositionTryRules []*PositionTryRule `json:"cssPositionTryRules,omitempty"`
+
+	// Optional. Index of the active fallback in the applied position-try-fallback
+	// property, will not be set if there is no active position-try fallback.
+	ActivePositionFallbackIndex int `json:"activePositionFallbackIndex,omitempty"`
+
+	// Optional. A list of CSS at-property rules matching this node.
+	CSSPropertyRules []*PropertyRule `json:"cssPropertyRules,omitempty"`
+
+	// Optional. A list of CSS property registrations matching this node.
+	CSSPropertyRegistrations []*PropertyRegistration `json:"cssPropertyRegistrations,omitempty"`
+
+	// Optional. A font-palette-values rule matching this node.
+	CSSFontPaletteValuesRule *FontPaletteValuesRule `json:"cssFontPaletteValuesRule,omitempty"`
+
+	// Optional. Id of the first parent element that does not have display:
+	// contents. EXPERIMENTAL.
+	ParentLayoutNodeID dom.NodeID `json:"parentLayoutNodeId,omitempty"`
+
+	// Optional. A list of CSS at-function rules referenced by styles of this node.
+	// EXPERIMENTAL.
+	CSSFunctionRules []*FunctionRule `json:"cssFunctionRules,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetEnvironmentVariablesResult represents the result of calls to CSS.getEnvironmentVariables.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getEnvironmentVariables
+*/
+type GetEnvironmentVariablesResult struct {
+	EnvironmentVariables map[string]interface{} `json:"environmentVariables"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -310,6 +475,102 @@ type GetStyleSheetTextResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetLayersForNodeParams represents CSS.getLayersForNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLayersForNode
+*/
+type GetLayersForNodeParams struct {
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+GetLayersForNodeResult represents the result of calls to CSS.getLayersForNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLayersForNode
+*/
+type GetLayersForNodeResult struct {
+	RootLayer *LayerData `json:"rootLayer"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetLocationForSelectorParams represents CSS.getLocationForSelector parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLocationForSelector
+*/
+type GetLocationForSelectorParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+
+	SelectorText string `json:"selectorText"`
+}
+
+/*
+GetLocationForSelectorResult represents the result of calls to CSS.getLocationForSelector.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLocationForSelector
+*/
+type GetLocationForSelectorResult struct {
+	Ranges []*SourceRange `json:"ranges"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TrackComputedStyleUpdatesForNodeParams represents CSS.trackComputedStyleUpdatesForNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdatesForNode
+*/
+type TrackComputedStyleUpdatesForNodeParams struct {
+	// Optional.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+}
+
+/*
+TrackComputedStyleUpdatesForNodeResult represents the result of calls to CSS.trackComputedStyleUpdatesForNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdatesForNode
+*/
+type TrackComputedStyleUpdatesForNodeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TrackComputedStyleUpdatesParams represents CSS.trackComputedStyleUpdates parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdates
+*/
+type TrackComputedStyleUpdatesParams struct {
+	PropertiesToTrack []*ComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+/*
+TrackComputedStyleUpdatesResult represents the result of calls to CSS.trackComputedStyleUpdates.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdates
+*/
+type TrackComputedStyleUpdatesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TakeComputedStyleUpdatesResult represents the result of calls to CSS.takeComputedStyleUpdates.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeComputedStyleUpdates
+*/
+type TakeComputedStyleUpdatesResult struct {
+	// The list of node Ids that have their tracked computed styles updated.
+	NodeIDs []dom.NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetEffectivePropertyValueForNodeParams represents CSS.setEffectivePropertyValueForNode parameters.
 
@@ -317,14 +578,15 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectiveP
 */
 type SetEffectivePropertyValueForNodeParams struct {
 	// The element id for which to set property.
-	NodeID       dom.NodeID `json:"nodeId"`
-	PropertyName string     `json:"propertyName"`
-	Value        string     `json:"value"`
+	NodeID dom.NodeID `json:"nodeId"`
+
+	PropertyName string `json:"propertyName"`
+
+	Value string `json:"value"`
 }
 
 /*
-SetEffectivePropertyValueForNodeResult represents the result of calls to
-CSS.setEffectivePropertyValueForNode.
+SetEffectivePropertyValueForNodeResult represents the result of calls to CSS.setEffectivePropertyValueForNode.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectivePropertyValueForNode
 */
@@ -333,6 +595,32 @@ type SetEffectivePropertyValueForNodeResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetPropertyRulePropertyNameParams represents CSS.setPropertyRulePropertyName parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setPropertyRulePropertyName
+*/
+type SetPropertyRulePropertyNameParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	PropertyName string `json:"propertyName"`
+}
+
+/*
+SetPropertyRulePropertyNameResult represents the result of calls to CSS.setPropertyRulePropertyName.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setPropertyRulePropertyName
+*/
+type SetPropertyRulePropertyNameResult struct {
+	// The resulting key text after modification.
+	PropertyName *Value `json:"propertyName"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetKeyframeKeyParams represents CSS.setKeyframeKey parameters.
 
@@ -340,8 +628,13 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setKeyframeKe
 */
 type SetKeyframeKeyParams struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
-	Range        *SourceRange `json:"range"`
-	Selector     string       `json:"selector"`
+
+	Range *SourceRange `json:"range"`
+
+	KeyText string `json:"keyText"`
+
+	// DEPRECATED.
+	Selector string `json:"selector"`
 }
 
 /*
@@ -364,8 +657,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setMediaText
 */
 type SetMediaTextParams struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
-	Range        *SourceRange `json:"range"`
-	Text         string       `json:"text"`
+
+	Range *SourceRange `json:"range"`
+
+	Text string `json:"text"`
 }
 
 /*
@@ -381,6 +676,84 @@ type SetMediaTextResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetContainerQueryTextParams represents CSS.setContainerQueryText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setContainerQueryText
+*/
+type SetContainerQueryTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	Text string `json:"text"`
+}
+
+/*
+SetContainerQueryTextResult represents the result of calls to CSS.setContainerQueryText.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setContainerQueryText
+*/
+type SetContainerQueryTextResult struct {
+	// The resulting CSS container query rule after modification.
+	ContainerQuery *ContainerQuery `json:"containerQuery"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSupportsTextParams represents CSS.setSupportsText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setSupportsText
+*/
+type SetSupportsTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	Text string `json:"text"`
+}
+
+/*
+SetSupportsTextResult represents the result of calls to CSS.setSupportsText.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setSupportsText
+*/
+type SetSupportsTextResult struct {
+	// The resulting CSS Supports rule after modification.
+	Supports *Supports `json:"supports"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetScopeTextParams represents CSS.setScopeText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setScopeText
+*/
+type SetScopeTextParams struct {
+	StyleSheetID StyleSheetID `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	Text string `json:"text"`
+}
+
+/*
+SetScopeTextResult represents the result of calls to CSS.setScopeText.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setScopeText
+*/
+type SetScopeTextResult struct {
+	// The resulting CSS Scope rule after modification.
+	Scope *Scope `json:"scope"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetRuleSelectorParams represents CSS.setRuleSelector parameters.
 
@@ -388,8 +761,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setRuleSelect
 */
 type SetRuleSelectorParams struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
-	Range        *SourceRange `json:"range"`
-	Selector     string       `json:"selector"`
+
+	Range *SourceRange `json:"range"`
+
+	Selector string `json:"selector"`
 }
 
 /*
@@ -412,7 +787,8 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheet
 */
 type SetStyleSheetTextParams struct {
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
-	Text         string       `json:"text"`
+
+	Text string `json:"text"`
 }
 
 /*
@@ -421,8 +797,8 @@ SetStyleSheetTextResult represents the result of calls to CSS.setStyleSheetText.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheetText
 */
 type SetStyleSheetTextResult struct {
-	// URL of source map associated with script (if any).
-	SourceMapURL string `json:"sourceMapURL"`
+	// Optional. URL of source map associated with script (if any).
+	SourceMapURL string `json:"sourceMapURL,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -435,6 +811,13 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleTexts
 */
 type SetStyleTextsParams struct {
 	Edits []*StyleDeclarationEdit `json:"edits"`
+
+	// Optional. NodeId for the DOM node in whose context custom property
+	// declarations for registered properties should be validated. If omitted,
+	// declarations in the new rule text can only be validated statically, which
+	// may produce incorrect results if the declaration contains a var() for
+	// example. EXPERIMENTAL.
+	NodeForPropertySyntaxValidation *dom.NodeID `json:"nodeForPropertySyntaxValidation,omitempty"`
 }
 
 /*
@@ -451,8 +834,7 @@ type SetStyleTextsResult struct {
 }
 
 /*
-StartRuleUsageTrackingResult represents the result of calls to
-CSS.startRuleUsageTracking.
+StartRuleUsageTrackingResult represents the result of calls to CSS.startRuleUsageTracking.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-startRuleUsageTracking
 */
@@ -481,6 +863,29 @@ https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeCoverageD
 type TakeCoverageDeltaResult struct {
 	Coverage []*RuleUsage `json:"coverage"`
 
+	// Monotonically increasing time, in seconds.
+	Timestamp float64 `json:"timestamp"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetLocalFontsEnabledParams represents CSS.setLocalFontsEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setLocalFontsEnabled
+*/
+type SetLocalFontsEnabledParams struct {
+	// Whether rendering of local fonts is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetLocalFontsEnabledResult represents the result of calls to CSS.setLocalFontsEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setLocalFontsEnabled
+*/
+type SetLocalFontsEnabledResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
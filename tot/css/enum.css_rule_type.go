@@ -0,0 +1,116 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type ruleTypeEnum struct {
+	MediaRule         RuleTypeEnum
+	SupportsRule      RuleTypeEnum
+	ContainerRule     RuleTypeEnum
+	LayerRule         RuleTypeEnum
+	ScopeRule         RuleTypeEnum
+	StyleRule         RuleTypeEnum
+	StartingStyleRule RuleTypeEnum
+}
+
+/*
+RuleType provides named access to the RuleTypeEnum values.
+*/
+var RuleType = ruleTypeEnum{
+	MediaRule:         ruleTypeMediaRule,
+	SupportsRule:      ruleTypeSupportsRule,
+	ContainerRule:     ruleTypeContainerRule,
+	LayerRule:         ruleTypeLayerRule,
+	ScopeRule:         ruleTypeScopeRule,
+	StyleRule:         ruleTypeStyleRule,
+	StartingStyleRule: ruleTypeStartingStyleRule,
+}
+
+/*
+RuleTypeEnum represents the CSS.CSSRuleType values.
+
+Enum indicating the type of a CSS rule, used to represent the order of a style
+rule's ancestors. This list only contains rule types that are collected during
+the ancestor rule collection.
+
+Allowed values:
+  - RuleType.MediaRule         "MediaRule"
+  - RuleType.SupportsRule      "SupportsRule"
+  - RuleType.ContainerRule     "ContainerRule"
+  - RuleType.LayerRule         "LayerRule"
+  - RuleType.ScopeRule         "ScopeRule"
+  - RuleType.StyleRule         "StyleRule"
+  - RuleType.StartingStyleRule "StartingStyleRule"
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSRuleType
+*/
+type RuleTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum RuleTypeEnum) String() string {
+	return _ruleTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RuleTypeEnum) Unknown() bool {
+	return !_ruleTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum RuleTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *RuleTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _ruleTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// ruleTypeMediaRule represents the "MediaRule" value.
+	ruleTypeMediaRule RuleTypeEnum = iota + 1
+	// ruleTypeSupportsRule represents the "SupportsRule" value.
+	ruleTypeSupportsRule
+	// ruleTypeContainerRule represents the "ContainerRule" value.
+	ruleTypeContainerRule
+	// ruleTypeLayerRule represents the "LayerRule" value.
+	ruleTypeLayerRule
+	// ruleTypeScopeRule represents the "ScopeRule" value.
+	ruleTypeScopeRule
+	// ruleTypeStyleRule represents the "StyleRule" value.
+	ruleTypeStyleRule
+	// ruleTypeStartingStyleRule represents the "StartingStyleRule" value.
+	ruleTypeStartingStyleRule
+)
+
+var _ruleTypeEnums = enums.New(map[RuleTypeEnum]string{
+	RuleTypeEnum(0):           "",
+	ruleTypeMediaRule:         "MediaRule",
+	ruleTypeSupportsRule:      "SupportsRule",
+	ruleTypeContainerRule:     "ContainerRule",
+	ruleTypeLayerRule:         "LayerRule",
+	ruleTypeScopeRule:         "ScopeRule",
+	ruleTypeStyleRule:         "StyleRule",
+	ruleTypeStartingStyleRule: "StartingStyleRule",
+})
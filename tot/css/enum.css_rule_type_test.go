@@ -0,0 +1,134 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package css
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRuleType(t *testing.T) {
+	var enum RuleTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RuleTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = RuleType.MediaRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MediaRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MediaRule"`, result)
+	}
+	json.Unmarshal([]byte(`"MediaRule"`), &enum)
+	if RuleType.MediaRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.MediaRule, enum)
+	}
+
+	enum = RuleType.SupportsRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SupportsRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SupportsRule"`, result)
+	}
+	json.Unmarshal([]byte(`"SupportsRule"`), &enum)
+	if RuleType.SupportsRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.SupportsRule, enum)
+	}
+
+	enum = RuleType.ContainerRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ContainerRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ContainerRule"`, result)
+	}
+	json.Unmarshal([]byte(`"ContainerRule"`), &enum)
+	if RuleType.ContainerRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.ContainerRule, enum)
+	}
+
+	enum = RuleType.LayerRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LayerRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LayerRule"`, result)
+	}
+	json.Unmarshal([]byte(`"LayerRule"`), &enum)
+	if RuleType.LayerRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.LayerRule, enum)
+	}
+
+	enum = RuleType.ScopeRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ScopeRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ScopeRule"`, result)
+	}
+	json.Unmarshal([]byte(`"ScopeRule"`), &enum)
+	if RuleType.ScopeRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.ScopeRule, enum)
+	}
+
+	enum = RuleType.StyleRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"StyleRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"StyleRule"`, result)
+	}
+	json.Unmarshal([]byte(`"StyleRule"`), &enum)
+	if RuleType.StyleRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.StyleRule, enum)
+	}
+
+	enum = RuleType.StartingStyleRule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"StartingStyleRule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"StartingStyleRule"`, result)
+	}
+	json.Unmarshal([]byte(`"StartingStyleRule"`), &enum)
+	if RuleType.StartingStyleRule != enum {
+		t.Errorf("Expected %d, got %d", RuleType.StartingStyleRule, enum)
+	}
+}
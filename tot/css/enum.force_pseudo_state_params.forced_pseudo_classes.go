@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type forcedPseudoClassesEnum struct {
@@ -13,7 +16,7 @@ type forcedPseudoClassesEnum struct {
 }
 
 /*
-ForcedPseudoClasses provides named acces to the ForcedPseudoClassesEnum values.
+ForcedPseudoClasses provides named access to the ForcedPseudoClassesEnum values.
 */
 var ForcedPseudoClasses = forcedPseudoClassesEnum{
 	Active:  forcedPseudoClassesActive,
@@ -23,12 +26,16 @@ var ForcedPseudoClasses = forcedPseudoClassesEnum{
 }
 
 /*
-ForcedPseudoClassesEnum represents element pseudo classes to force when
-computing the element's style. Allowed values:
-	- ForcedPseudoClasses.Active  "active"
-	- ForcedPseudoClasses.Focus   "focus"
-	- ForcedPseudoClasses.Hover   "hover"
-	- ForcedPseudoClasses.Visited "visited"
+ForcedPseudoClassesEnum represents the CSS.forcePseudoState.forcedPseudoClasses
+values.
+
+Element pseudo classes to force when computing the element's style.
+
+Allowed values:
+  - ForcedPseudoClasses.Active  "active"
+  - ForcedPseudoClasses.Focus   "focus"
+  - ForcedPseudoClasses.Hover   "hover"
+  - ForcedPseudoClasses.Visited "visited"
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoState
 */
@@ -38,7 +45,16 @@ type ForcedPseudoClassesEnum int
 String implements Stringer
 */
 func (enum ForcedPseudoClassesEnum) String() string {
-	return _forcedPseudoClassesEnums[enum]
+	return _forcedPseudoClassesEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ForcedPseudoClassesEnum) Unknown() bool {
+	return !_forcedPseudoClassesEnums.Known(enum)
 }
 
 /*
@@ -49,25 +65,16 @@ func (enum ForcedPseudoClassesEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ForcedPseudoClassesEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _forcedPseudoClassesEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid ForcedPseudoClass value", bytes)
+	*enum = _forcedPseudoClassesEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -81,9 +88,10 @@ const (
 	forcedPseudoClassesVisited
 )
 
-var _forcedPseudoClassesEnums = map[ForcedPseudoClassesEnum]string{
+var _forcedPseudoClassesEnums = enums.New(map[ForcedPseudoClassesEnum]string{
+	ForcedPseudoClassesEnum(0): "",
 	forcedPseudoClassesActive:  "active",
 	forcedPseudoClassesFocus:   "focus",
 	forcedPseudoClassesHover:   "hover",
 	forcedPseudoClassesVisited: "visited",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
@@ -11,8 +13,24 @@ func TestEnumForcedPseudoClasses(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ForcedPseudoClassesEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumForcedPseudoClasses(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"active"` != string(result) {
-		t.Errorf("Expected '\"active\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"active"`, result)
 	}
 	json.Unmarshal([]byte(`"active"`), &enum)
 	if ForcedPseudoClasses.Active != enum {
-		t.Errorf("Expcected %d, got %d", ForcedPseudoClasses.Active, enum)
+		t.Errorf("Expected %d, got %d", ForcedPseudoClasses.Active, enum)
 	}
 
 	enum = ForcedPseudoClasses.Focus
@@ -42,11 +60,11 @@ func TestEnumForcedPseudoClasses(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"focus"` != string(result) {
-		t.Errorf("Expected '\"focus\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"focus"`, result)
 	}
 	json.Unmarshal([]byte(`"focus"`), &enum)
 	if ForcedPseudoClasses.Focus != enum {
-		t.Errorf("Expcected %d, got %d", ForcedPseudoClasses.Focus, enum)
+		t.Errorf("Expected %d, got %d", ForcedPseudoClasses.Focus, enum)
 	}
 
 	enum = ForcedPseudoClasses.Hover
@@ -55,18 +73,12 @@ func TestEnumForcedPseudoClasses(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"hover"` != string(result) {
-		t.Errorf("Expected '\"hover\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"hover"`, result)
 	}
 	json.Unmarshal([]byte(`"hover"`), &enum)
 	if ForcedPseudoClasses.Hover != enum {
-		t.Errorf("Expcected %d, got %d", ForcedPseudoClasses.Hover, enum)
+		t.Errorf("Expected %d, got %d", ForcedPseudoClasses.Hover, enum)
 	}
-}
-
-func TestEnumForcedPseudoClasses2(t *testing.T) {
-	var enum ForcedPseudoClassesEnum
-	var err error
-	var result []byte
 
 	enum = ForcedPseudoClasses.Visited
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumForcedPseudoClasses2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"visited"` != string(result) {
-		t.Errorf("Expected '\"visited\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"visited"`, result)
 	}
 	json.Unmarshal([]byte(`"visited"`), &enum)
 	if ForcedPseudoClasses.Visited != enum {
-		t.Errorf("Expcected %d, got %d", ForcedPseudoClasses.Visited, enum)
+		t.Errorf("Expected %d, got %d", ForcedPseudoClasses.Visited, enum)
 	}
 }
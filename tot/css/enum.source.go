@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type sourceEnum struct {
@@ -13,7 +16,7 @@ type sourceEnum struct {
 }
 
 /*
-Source provides named acces to the SourceEnum values.
+Source provides named access to the SourceEnum values.
 */
 var Source = sourceEnum{
 	MediaRule:   SourceMediaRule,
@@ -23,15 +26,18 @@ var Source = sourceEnum{
 }
 
 /*
-SourceEnum represents the source of the media query. Allowed values:
-	- Source.MediaRule   "mediaRule" if specified by a @media rule
-	- Source.ImportRule  "importRule" if specified by an @import rule
-	- Source.LinkedSheet "linkedSheet" if specified by a "media" attribute in a
-	  linked stylesheet's LINK tag
-	- Source.InlineSheet "inlineSheet" if specified by a "media" attribute in an
-	  inline stylesheet's STYLE tag.
+SourceEnum represents the CSS.CSSMedia.source values.
+
+Source of the media query: "mediaRule" if specified by a @media rule,
+"importRule" if specified by an @import rule, "linkedSheet" if specified by a
+"media" attribute in a linked stylesheet's LINK tag, "inlineSheet" if specified
+by a "media" attribute in an inline stylesheet's STYLE tag.
 
-Allowed values: mediaRule, importRule, linkedSheet, inlineSheet.
+Allowed values:
+  - Source.MediaRule   "mediaRule"
+  - Source.ImportRule  "importRule"
+  - Source.LinkedSheet "linkedSheet"
+  - Source.InlineSheet "inlineSheet"
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-CSSMedia
 */
@@ -41,7 +47,16 @@ type SourceEnum int
 String implements Stringer
 */
 func (enum SourceEnum) String() string {
-	return _sourceEnums[enum]
+	return _sourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SourceEnum) Unknown() bool {
+	return !_sourceEnums.Known(enum)
 }
 
 /*
@@ -52,25 +67,16 @@ func (enum SourceEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *SourceEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _sourceEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid source value", bytes)
+	*enum = _sourceEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -84,9 +90,10 @@ const (
 	SourceInlineSheet
 )
 
-var _sourceEnums = map[SourceEnum]string{
+var _sourceEnums = enums.New(map[SourceEnum]string{
+	SourceEnum(0):     "",
 	SourceMediaRule:   "mediaRule",
 	SourceImportRule:  "importRule",
 	SourceLinkedSheet: "linkedSheet",
 	SourceInlineSheet: "inlineSheet",
-}
+})
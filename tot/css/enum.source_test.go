@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
@@ -11,8 +13,24 @@ func TestEnumSource(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mediaRule"` != string(result) {
-		t.Errorf("Expected '\"mediaRule\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mediaRule"`, result)
 	}
 	json.Unmarshal([]byte(`"mediaRule"`), &enum)
 	if Source.MediaRule != enum {
-		t.Errorf("Expcected %d, got %d", Source.MediaRule, enum)
+		t.Errorf("Expected %d, got %d", Source.MediaRule, enum)
 	}
 
 	enum = Source.ImportRule
@@ -42,11 +60,11 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"importRule"` != string(result) {
-		t.Errorf("Expected '\"importRule\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"importRule"`, result)
 	}
 	json.Unmarshal([]byte(`"importRule"`), &enum)
 	if Source.ImportRule != enum {
-		t.Errorf("Expcected %d, got %d", Source.ImportRule, enum)
+		t.Errorf("Expected %d, got %d", Source.ImportRule, enum)
 	}
 
 	enum = Source.LinkedSheet
@@ -55,18 +73,12 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"linkedSheet"` != string(result) {
-		t.Errorf("Expected '\"linkedSheet\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"linkedSheet"`, result)
 	}
 	json.Unmarshal([]byte(`"linkedSheet"`), &enum)
 	if Source.LinkedSheet != enum {
-		t.Errorf("Expcected %d, got %d", Source.LinkedSheet, enum)
+		t.Errorf("Expected %d, got %d", Source.LinkedSheet, enum)
 	}
-}
-
-func TestEnumSource2(t *testing.T) {
-	var enum SourceEnum
-	var err error
-	var result []byte
 
 	enum = Source.InlineSheet
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumSource2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"inlineSheet"` != string(result) {
-		t.Errorf("Expected '\"inlineSheet\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"inlineSheet"`, result)
 	}
 	json.Unmarshal([]byte(`"inlineSheet"`), &enum)
 	if Source.InlineSheet != enum {
-		t.Errorf("Expcected %d, got %d", Source.InlineSheet, enum)
+		t.Errorf("Expected %d, got %d", Source.InlineSheet, enum)
 	}
 }
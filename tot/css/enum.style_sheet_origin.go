@@ -1,35 +1,46 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type styleSheetOriginEnum struct {
 	Injected  StyleSheetOriginEnum
 	UserAgent StyleSheetOriginEnum
 	Inspector StyleSheetOriginEnum
+	Regular   StyleSheetOriginEnum
 	Log       StyleSheetOriginEnum
 }
 
 /*
-StyleSheetOrigin provides named acces to the StyleSheetOriginEnum values.
+StyleSheetOrigin provides named access to the StyleSheetOriginEnum values.
 */
 var StyleSheetOrigin = styleSheetOriginEnum{
 	Injected:  StyleSheetOriginInjected,
 	UserAgent: StyleSheetOriginUserAgent,
 	Inspector: StyleSheetOriginInspector,
+	Regular:   StyleSheetOriginRegular,
 	Log:       StyleSheetOriginLog,
 }
 
 /*
-StyleSheetOriginEnum specifies the stylesheet origin. Allowed values:
-	- StyleSheetOrigin.Injected  "injected" for stylesheets injected via
-	  extension
-	- StyleSheetOrigin.UserAgent "user-agent" for user-agent stylesheets
-	- StyleSheetOrigin.Inspector "inspector" for stylesheets created by the
-	  inspector (i.e. those holding the "via inspector" rules)
-	- StyleSheetOrigin.Log       "regular" for regular stylesheets.
+StyleSheetOriginEnum represents the CSS.StyleSheetOrigin values.
+
+Stylesheet type: "injected" for stylesheets injected via extension, "user-agent"
+for user-agent stylesheets, "inspector" for stylesheets created by the inspector
+(i.e. those holding the "via inspector" rules), "regular" for regular
+stylesheets.
+
+Allowed values:
+  - StyleSheetOrigin.Injected  "injected"
+  - StyleSheetOrigin.UserAgent "user-agent"
+  - StyleSheetOrigin.Inspector "inspector"
+  - StyleSheetOrigin.Regular   "regular"
+  - StyleSheetOrigin.Log       "log"
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#type-StyleSheetOrigin
 */
@@ -38,37 +49,37 @@ type StyleSheetOriginEnum int
 /*
 String implements Stringer
 */
-func (source StyleSheetOriginEnum) String() string {
-	return _styleSheetOriginEnums[source]
+func (enum StyleSheetOriginEnum) String() string {
+	return _styleSheetOriginEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StyleSheetOriginEnum) Unknown() bool {
+	return !_styleSheetOriginEnums.Known(enum)
 }
 
 /*
 MarshalJSON implements json.Marshaler
 */
-func (source StyleSheetOriginEnum) MarshalJSON() ([]byte, error) {
-	return json.Marshal(source.String())
+func (enum StyleSheetOriginEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
-func (source *StyleSheetOriginEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
+func (enum *StyleSheetOriginEnum) UnmarshalJSON(bytes []byte) error {
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _styleSheetOriginEnums {
-		if v == val {
-			*source = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid source value", bytes)
+	*enum = _styleSheetOriginEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -78,13 +89,17 @@ const (
 	StyleSheetOriginUserAgent
 	// StyleSheetOriginInspector represents the "inspector" value.
 	StyleSheetOriginInspector
+	// StyleSheetOriginRegular represents the "regular" value.
+	StyleSheetOriginRegular
 	// StyleSheetOriginLog represents the "log" value.
 	StyleSheetOriginLog
 )
 
-var _styleSheetOriginEnums = map[StyleSheetOriginEnum]string{
+var _styleSheetOriginEnums = enums.New(map[StyleSheetOriginEnum]string{
+	StyleSheetOriginEnum(0):   "",
 	StyleSheetOriginInjected:  "injected",
 	StyleSheetOriginUserAgent: "user-agent",
 	StyleSheetOriginInspector: "inspector",
+	StyleSheetOriginRegular:   "regular",
 	StyleSheetOriginLog:       "log",
-}
+})
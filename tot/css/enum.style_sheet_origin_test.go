@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
 import (
@@ -11,8 +13,24 @@ func TestEnumStyleSheetOrigin(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StyleSheetOriginEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumStyleSheetOrigin(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"injected"` != string(result) {
-		t.Errorf("Expected '\"injected\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"injected"`, result)
 	}
 	json.Unmarshal([]byte(`"injected"`), &enum)
 	if StyleSheetOrigin.Injected != enum {
-		t.Errorf("Expcected %d, got %d", StyleSheetOrigin.Injected, enum)
+		t.Errorf("Expected %d, got %d", StyleSheetOrigin.Injected, enum)
 	}
 
 	enum = StyleSheetOrigin.UserAgent
@@ -42,11 +60,11 @@ func TestEnumStyleSheetOrigin(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"user-agent"` != string(result) {
-		t.Errorf("Expected '\"user-agent\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"user-agent"`, result)
 	}
 	json.Unmarshal([]byte(`"user-agent"`), &enum)
 	if StyleSheetOrigin.UserAgent != enum {
-		t.Errorf("Expcected %d, got %d", StyleSheetOrigin.UserAgent, enum)
+		t.Errorf("Expected %d, got %d", StyleSheetOrigin.UserAgent, enum)
 	}
 
 	enum = StyleSheetOrigin.Inspector
@@ -55,18 +73,25 @@ func TestEnumStyleSheetOrigin(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"inspector"` != string(result) {
-		t.Errorf("Expected '\"inspector\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"inspector"`, result)
 	}
 	json.Unmarshal([]byte(`"inspector"`), &enum)
 	if StyleSheetOrigin.Inspector != enum {
-		t.Errorf("Expcected %d, got %d", StyleSheetOrigin.Inspector, enum)
+		t.Errorf("Expected %d, got %d", StyleSheetOrigin.Inspector, enum)
 	}
-}
 
-func TestEnumStyleSheetOrigin2(t *testing.T) {
-	var enum StyleSheetOriginEnum
-	var err error
-	var result []byte
+	enum = StyleSheetOrigin.Regular
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"regular"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"regular"`, result)
+	}
+	json.Unmarshal([]byte(`"regular"`), &enum)
+	if StyleSheetOrigin.Regular != enum {
+		t.Errorf("Expected %d, got %d", StyleSheetOrigin.Regular, enum)
+	}
 
 	enum = StyleSheetOrigin.Log
 	result, err = json.Marshal(enum)
@@ -74,10 +99,10 @@ func TestEnumStyleSheetOrigin2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"log"` != string(result) {
-		t.Errorf("Expected '\"log\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"log"`, result)
 	}
 	json.Unmarshal([]byte(`"log"`), &enum)
 	if StyleSheetOrigin.Log != enum {
-		t.Errorf("Expcected %d, got %d", StyleSheetOrigin.Log, enum)
+		t.Errorf("Expected %d, got %d", StyleSheetOrigin.Log, enum)
 	}
 }
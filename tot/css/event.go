@@ -1,11 +1,20 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package css
 
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+)
+
 /*
 FontsUpdatedEvent represents CSS.fontsUpdated event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-fontsUpdated
 */
 type FontsUpdatedEvent struct {
+	// Optional. The web font that has loaded.
+	Font *FontFace `json:"font,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -39,7 +48,6 @@ StyleSheetChangedEvent represents CSS.styleSheetChanged event data.
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetChanged
 */
 type StyleSheetChangedEvent struct {
-	// Identifier of the changed stylesheet.
 	StyleSheetID StyleSheetID `json:"styleSheetId"`
 
 	// Error information related to this event
@@ -58,3 +66,16 @@ type StyleSheetRemovedEvent struct {
 	// Error information related to this event
 	Err error `json:"-"`
 }
+
+/*
+ComputedStyleUpdatedEvent represents CSS.computedStyleUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-computedStyleUpdated
+*/
+type ComputedStyleUpdatedEvent struct {
+	// The node id that has updated computed styles.
+	NodeID dom.NodeID `json:"nodeId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
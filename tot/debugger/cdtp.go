@@ -1,6 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package debugger provides type definitions for use with the Chrome Debugger protocol
 
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/
 */
 package debugger
@@ -10,50 +15,73 @@ import (
 )
 
 /*
-BreakpointID is a breakpoint identifier.
+BreakpointID represents the Debugger.BreakpointId type.
+
+Breakpoint identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-BreakpointId
 */
 type BreakpointID string
 
 /*
-CallFrameID is a call frame identifier.
+CallFrameID represents the Debugger.CallFrameId type.
+
+Call frame identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-CallFrameId
 */
 type CallFrameID string
 
 /*
-Location is a location in the source code.
+Location represents the Debugger.Location type.
+
+Location in the source code.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-Location
 */
 type Location struct {
-	// Script identifier as reported in the Debugger.scriptParsed.
+	// Script identifier as reported in the `Debugger.scriptParsed`.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// Line number in the script (0-based).
-	LineNumber int64 `json:"lineNumber"`
+	LineNumber int `json:"lineNumber"`
 
 	// Optional. Column number in the script (0-based).
-	ColumnNumber int64 `json:"columnNumber,omitempty"`
+	ColumnNumber int `json:"columnNumber,omitempty"`
 }
 
 /*
-ScriptPosition is a location in the source code. EXPERIMENTAL
+ScriptPosition represents the Debugger.ScriptPosition type.
+
+Location in the source code. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-ScriptPosition
 */
 type ScriptPosition struct {
-	// Line number
-	LineNumber int64 `json:"lineNumber"`
+	LineNumber int `json:"lineNumber"`
 
-	// Column number
-	ColumnNumber int64 `json:"columnNumber"`
+	ColumnNumber int `json:"columnNumber"`
 }
 
 /*
-CallFrame is a JavaScript call frame. Array of call frames form the call stack.
+LocationRange represents the Debugger.LocationRange type.
+
+Location range within one script. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-LocationRange
+*/
+type LocationRange struct {
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	Start *ScriptPosition `json:"start"`
+
+	End *ScriptPosition `json:"end"`
+}
+
+/*
+CallFrame represents the Debugger.CallFrame type.
+
+JavaScript call frame. Array of call frames form the call stack.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-CallFrame
 */
@@ -71,7 +99,9 @@ type CallFrame struct {
 	// Location in the source code.
 	Location *Location `json:"location"`
 
-	// JavaScript script name or url.
+	// JavaScript script name or url. Deprecated in favor of using the
+	// `location.scriptId` to resolve the URL via a previously sent
+	// `Debugger.scriptParsed` event. DEPRECATED.
 	URL string `json:"url"`
 
 	// Scope chain for this call frame.
@@ -82,61 +112,72 @@ type CallFrame struct {
 
 	// Optional. The value being returned, if the function is at return point.
 	ReturnValue *runtime.RemoteObject `json:"returnValue,omitempty"`
+
+	// Optional. Valid only while the VM is paused and indicates whether this frame
+	// can be restarted or not. Note that a `true` value here does not guarantee
+	// that Debugger#restartFrame with this CallFrameId will be successful, but it
+	// is very likely. EXPERIMENTAL.
+	CanBeRestarted bool `json:"canBeRestarted,omitempty"`
 }
 
 /*
-Scope represents a scope description
+Scope represents the Debugger.Scope type.
+
+Scope description.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-Scope
 */
 type Scope struct {
 	// Scope type. Allowed values:
-	//	- ScopeType.Global
-	//	- ScopeType.Local
-	//	- ScopeType.With
-	//	- ScopeType.Closure
-	//	- ScopeType.Catch
-	//	- ScopeType.Block
-	//	- ScopeType.Script
-	//	- ScopeType.Eval
-	//	- ScopeType.Module.
+	//   - ScopeType.Global
+	//   - ScopeType.Local
+	//   - ScopeType.With
+	//   - ScopeType.Closure
+	//   - ScopeType.Catch
+	//   - ScopeType.Block
+	//   - ScopeType.Script
+	//   - ScopeType.Eval
+	//   - ScopeType.Module
+	//   - ScopeType.WasmExpressionStack
 	Type ScopeTypeEnum `json:"type"`
 
-	// Object representing the scope. For global and with scopes it represents
+	// Object representing the scope. For `global` and `with` scopes it represents
 	// the actual object; for the rest of the scopes, it is artificial transient
 	// object enumerating scope variables as its properties.
 	Object *runtime.RemoteObject `json:"object"`
 
-	// Optional. The scope name.
+	// Optional.
 	Name string `json:"name,omitempty"`
 
-	// Optional. Location in the source code where scope starts.
+	// Optional. Location in the source code where scope starts
 	StartLocation *Location `json:"startLocation,omitempty"`
 
-	// Optional. Location in the source code where scope ends.
+	// Optional. Location in the source code where scope ends
 	EndLocation *Location `json:"endLocation,omitempty"`
 }
 
 /*
-SearchMatch is a search match for a resource.
+SearchMatch represents the Debugger.SearchMatch type.
+
+Search match for resource.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-SearchMatch
 */
 type SearchMatch struct {
 	// Line number in resource content.
-	LineNumber int64 `json:"lineNumber"`
+	LineNumber float64 `json:"lineNumber"`
 
 	// Line with match content.
 	LineContent string `json:"lineContent"`
 }
 
 /*
-BreakLocation is a break location
+BreakLocation represents the Debugger.BreakLocation type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-BreakLocation
 */
 type BreakLocation struct {
-	// Script identifier as reported in the Debugger.scriptParsed.
+	// Script identifier as reported in the `Debugger.scriptParsed`.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// Line number in the script (0-based).
@@ -146,8 +187,54 @@ type BreakLocation struct {
 	ColumnNumber int `json:"columnNumber,omitempty"`
 
 	// Optional. Allowed values:
-	//	- BreakLocationType.DebuggerStatement
-	//	- BreakLocationType.Call
-	//	- BreakLocationType.Return.
+	//   - BreakLocationType.DebuggerStatement
+	//   - BreakLocationType.Call
+	//   - BreakLocationType.Return
 	Type BreakLocationTypeEnum `json:"type,omitempty"`
 }
+
+/*
+WasmDisassemblyChunk represents the Debugger.WasmDisassemblyChunk type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-WasmDisassemblyChunk
+*/
+type WasmDisassemblyChunk struct {
+	// The next chunk of disassembled lines.
+	Lines []string `json:"lines"`
+
+	// The bytecode offsets describing the start of each line.
+	BytecodeOffsets []int `json:"bytecodeOffsets"`
+}
+
+/*
+DebugSymbols represents the Debugger.DebugSymbols type.
+
+Debug symbols available for a wasm script.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-DebugSymbols
+*/
+type DebugSymbols struct {
+	// Type of the debug symbols. Allowed values:
+	//   - Type.SourceMap
+	//   - Type.EmbeddedDWARF
+	//   - Type.ExternalDWARF
+	Type TypeEnum `json:"type"`
+
+	// Optional. URL of the external symbol source.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+/*
+ResolvedBreakpoint represents the Debugger.ResolvedBreakpoint type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-ResolvedBreakpoint
+*/
+type ResolvedBreakpoint struct {
+	// Breakpoint unique identifier.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Actual breakpoint location.
+	Location *Location `json:"location"`
+}
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
-import "github.com/mkenney/go-chrome/tot/runtime"
+import (
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
 
 /*
 ContinueToLocationParams represents Debugger.continueToLocation parameters.
@@ -12,14 +16,13 @@ type ContinueToLocationParams struct {
 	Location *Location `json:"location"`
 
 	// Optional. Allowed values:
-	//	- TargetCallFrames.Any
-	//	- TargetCallFrames.Current
+	//   - TargetCallFrames.Any
+	//   - TargetCallFrames.Current
 	TargetCallFrames TargetCallFramesEnum `json:"targetCallFrames,omitempty"`
 }
 
 /*
-ContinueToLocationResult represents the result of calls to
-Debugger.continueToLocation.
+ContinueToLocationResult represents the result of calls to Debugger.continueToLocation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continueToLocation
 */
@@ -38,13 +41,25 @@ type DisableResult struct {
 	Err error `json:"-"`
 }
 
+/*
+EnableParams represents Debugger.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
+*/
+type EnableParams struct {
+	// Optional. The maximum size in bytes of collected scripts (not referenced by
+	// other heap objects) the debugger can hold. Puts no limit if parameter is
+	// omitted. EXPERIMENTAL.
+	MaxScriptsCacheSize *float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
 /*
 EnableResult represents the result of calls to Debugger.enable.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
 */
 type EnableResult struct {
-	// Unique identifier of the debugger. EXPERIMENTAL
+	// Unique identifier of the debugger. EXPERIMENTAL.
 	ID runtime.UniqueDebuggerID `json:"debuggerId"`
 
 	// Error information related to executing this method
@@ -64,28 +79,31 @@ type EvaluateOnCallFrameParams struct {
 	Expression string `json:"expression"`
 
 	// Optional. String object group name to put result into (allows rapid
-	// releasing resulting object handles using releaseObjectGroup).
-	ObjectGroup string `json:"objectGroup,omitempty"`
+	// releasing resulting object handles using `releaseObjectGroup`).
+	ObjectGroup *string `json:"objectGroup,omitempty"`
 
 	// Optional. Specifies whether command line API should be available to the
 	// evaluated expression, defaults to false.
-	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
 
 	// Optional. In silent mode exceptions thrown during evaluation are not
-	// reported and do not pause execution. Overrides setPauseOnException state.
-	Silent bool `json:"silent,omitempty"`
+	// reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
 
-	// Optional. Whether the result is expected to be a JSON object that should
-	// be sent by value.
-	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Optional. Whether the result is expected to be a JSON object that should be
+	// sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
 
-	// Optional. Whether preview should be generated for the result.
-	// EXPERIMENTAL.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Optional. Whether preview should be generated for the result. EXPERIMENTAL.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Optional. Whether to throw an exception if side effect cannot be ruled out
+	// during evaluation.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
 
-	// Optional. Whether to throw an exception if side effect cannot be ruled
-	// out during evaluation.
-	ThrowOnSideEffect bool `json:"throwOnSideEffect,omitempty"`
+	// Optional. Terminate execution after timing out (number of milliseconds).
+	// EXPERIMENTAL.
+	Timeout *runtime.TimeDelta `json:"timeout,omitempty"`
 }
 
 /*
@@ -119,7 +137,7 @@ type GetPossibleBreakpointsParams struct {
 
 	// Optional. Only consider locations which are in the same (non-nested)
 	// function as start.
-	RestrictToFunction bool `json:"restrictToFunction,omitempty"`
+	RestrictToFunction *bool `json:"restrictToFunction,omitempty"`
 }
 
 /*
@@ -141,7 +159,7 @@ GetScriptSourceParams represents Debugger.getScriptSource parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScriptSource
 */
 type GetScriptSourceParams struct {
-	// ID of the script to get source for.
+	// Id of the script to get source for.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 }
 
@@ -151,9 +169,91 @@ GetScriptSourceResult represents the result of calls to Debugger.getScriptSource
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScriptSource
 */
 type GetScriptSourceResult struct {
-	// Script source.
+	// Script source (empty in case of Wasm bytecode).
 	ScriptSource string `json:"scriptSource"`
 
+	// Optional. Wasm bytecode. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisassembleWasmModuleParams represents Debugger.disassembleWasmModule parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disassembleWasmModule
+*/
+type DisassembleWasmModuleParams struct {
+	// Id of the script to disassemble
+	ScriptID runtime.ScriptID `json:"scriptId"`
+}
+
+/*
+DisassembleWasmModuleResult represents the result of calls to Debugger.disassembleWasmModule.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disassembleWasmModule
+*/
+type DisassembleWasmModuleResult struct {
+	// Optional. For large modules, return a stream from which additional chunks of
+	// disassembly can be read successively.
+	StreamID string `json:"streamId,omitempty"`
+
+	// The total number of lines in the disassembly text.
+	TotalNumberOfLines int `json:"totalNumberOfLines"`
+
+	// The offsets of all function bodies, in the format [start1, end1, start2,
+	// end2, ...] where all ends are exclusive.
+	FunctionBodyOffsets []int `json:"functionBodyOffsets"`
+
+	// The first chunk of disassembly.
+	Chunk *WasmDisassemblyChunk `json:"chunk"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+NextWasmDisassemblyChunkParams represents Debugger.nextWasmDisassemblyChunk parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-nextWasmDisassemblyChunk
+*/
+type NextWasmDisassemblyChunkParams struct {
+	StreamID string `json:"streamId"`
+}
+
+/*
+NextWasmDisassemblyChunkResult represents the result of calls to Debugger.nextWasmDisassemblyChunk.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-nextWasmDisassemblyChunk
+*/
+type NextWasmDisassemblyChunkResult struct {
+	// The next chunk of disassembly.
+	Chunk *WasmDisassemblyChunk `json:"chunk"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetWasmBytecodeParams represents Debugger.getWasmBytecode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getWasmBytecode
+*/
+type GetWasmBytecodeParams struct {
+	// Id of the Wasm script to get source for.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+}
+
+/*
+GetWasmBytecodeResult represents the result of calls to Debugger.getWasmBytecode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getWasmBytecode
+*/
+type GetWasmBytecodeResult struct {
+	// Script source. (Encoded as a base64 string when passed over JSON)
+	Bytecode string `json:"bytecode"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -164,7 +264,7 @@ GetStackTraceParams represents Debugger.getStackTrace parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStackTrace
 */
 type GetStackTraceParams struct {
-	StackTraceID runtime.StackTraceID `json:"stackTraceId"`
+	StackTraceID *runtime.StackTraceID `json:"stackTraceId"`
 }
 
 /*
@@ -196,7 +296,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnA
 */
 type PauseOnAsyncCallParams struct {
 	// Debugger will pause when async call with given stack trace is started.
-	ParentStackTraceID runtime.StackTraceID `json:"parentStackTraceId"`
+	ParentStackTraceID *runtime.StackTraceID `json:"parentStackTraceId"`
 }
 
 /*
@@ -236,6 +336,11 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartF
 type RestartFrameParams struct {
 	// Call frame identifier to evaluate on.
 	CallFrameID CallFrameID `json:"callFrameId"`
+
+	// Optional. The `mode` parameter must be present and set to 'StepInto',
+	// otherwise `restartFrame` will error out. EXPERIMENTAL. Allowed values:
+	//   - Mode.StepInto
+	Mode ModeEnum `json:"mode,omitempty"`
 }
 
 /*
@@ -244,35 +349,39 @@ RestartFrameResult represents the result of calls to Debugger.restartFrame.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartFrame
 */
 type RestartFrameResult struct {
-	// New stack trace.
+	// New stack trace. DEPRECATED.
 	CallFrames []*CallFrame `json:"callFrames"`
 
-	// Optional. Async stack trace, if any.
+	// Optional. Async stack trace, if any. DEPRECATED.
 	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
 
-	// Optional. Async stack trace, if any. EXPERIMENTAL
-	AsyncStackTraceID runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
+	// Optional. Async stack trace, if any. DEPRECATED.
+	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-ResumeResult represents the result of calls to Debugger.resume.
+ResumeParams represents Debugger.resume parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
 */
-type ResumeResult struct {
-	// Error information related to executing this method
-	Err error `json:"-"`
+type ResumeParams struct {
+	// Optional. Set to true to terminate execution upon resuming execution. In
+	// contrast to Runtime.terminateExecution, this will allows to execute further
+	// JavaScript (i.e. via evaluation) until execution of the paused code is
+	// actually resumed, at which point termination is triggered. If execution is
+	// currently not paused, this parameter has no effect.
+	TerminateOnResume *bool `json:"terminateOnResume,omitempty"`
 }
 
 /*
-ScheduleStepIntoAsyncResult represents the result of calls to Debugger.scheduleStepIntoAsync.
+ResumeResult represents the result of calls to Debugger.resume.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-scheduleStepIntoAsync
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
 */
-type ScheduleStepIntoAsyncResult struct {
+type ResumeResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -283,17 +392,17 @@ SearchInContentParams represents Debugger.searchInContent parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-searchInContent
 */
 type SearchInContentParams struct {
-	// ID of the script to search in.
+	// Id of the script to search in.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// String to search for.
 	Query string `json:"query"`
 
 	// Optional. If true, search is case sensitive.
-	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
 
 	// Optional. If true, treats string parameter as regex.
-	IsRegex bool `json:"isRegex,omitempty"`
+	IsRegex *bool `json:"isRegex,omitempty"`
 }
 
 /*
@@ -315,7 +424,7 @@ SetAsyncCallStackDepthParams represents Debugger.setAsyncCallStackDepth paramete
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setAsyncCallStackDepth
 */
 type SetAsyncCallStackDepthParams struct {
-	// Maximum depth of async call stacks. Setting to 0 will effectively disable
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable
 	// collecting async call stacks (default).
 	MaxDepth int `json:"maxDepth"`
 }
@@ -330,6 +439,26 @@ type SetAsyncCallStackDepthResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetBlackboxExecutionContextsParams represents Debugger.setBlackboxExecutionContexts parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxExecutionContexts
+*/
+type SetBlackboxExecutionContextsParams struct {
+	// Array of execution context unique ids for the debugger to ignore.
+	UniqueIDs []string `json:"uniqueIds"`
+}
+
+/*
+SetBlackboxExecutionContextsResult represents the result of calls to Debugger.setBlackboxExecutionContexts.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxExecutionContexts
+*/
+type SetBlackboxExecutionContextsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetBlackboxPatternsParams represents Debugger.setBlackboxPatterns parameters.
 
@@ -338,6 +467,9 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlack
 type SetBlackboxPatternsParams struct {
 	// Array of regexps that will be used to check script url for blackbox state.
 	Patterns []string `json:"patterns"`
+
+	// Optional. If true, also ignore scripts with no source url.
+	SkipAnonymous *bool `json:"skipAnonymous,omitempty"`
 }
 
 /*
@@ -356,10 +488,9 @@ SetBlackboxedRangesParams represents Debugger.setBlackboxedRanges parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxedRanges
 */
 type SetBlackboxedRangesParams struct {
-	// ID of the script.
+	// Id of the script.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
-	// Script positions.
 	Positions []*ScriptPosition `json:"positions"`
 }
 
@@ -385,7 +516,7 @@ type SetBreakpointParams struct {
 	// Optional. Expression to use as a breakpoint condition. When specified,
 	// debugger will only stop on the breakpoint if this expression evaluates to
 	// true.
-	Condition string `json:"condition,omitempty"`
+	Condition *string `json:"condition,omitempty"`
 }
 
 /*
@@ -394,7 +525,7 @@ SetBreakpointResult represents the result of calls to Debugger.setBreakpoint.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpoint
 */
 type SetBreakpointResult struct {
-	// ID of the created breakpoint for further reference.
+	// Id of the created breakpoint for further reference.
 	BreakpointID BreakpointID `json:"breakpointId"`
 
 	// Location this breakpoint resolved into.
@@ -404,6 +535,31 @@ type SetBreakpointResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetInstrumentationBreakpointParams represents Debugger.setInstrumentationBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setInstrumentationBreakpoint
+*/
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name. Allowed values:
+	//   - Instrumentation.BeforeScriptExecution
+	//   - Instrumentation.BeforeScriptWithSourceMapExecution
+	Instrumentation InstrumentationEnum `json:"instrumentation"`
+}
+
+/*
+SetInstrumentationBreakpointResult represents the result of calls to Debugger.setInstrumentationBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setInstrumentationBreakpoint
+*/
+type SetInstrumentationBreakpointResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetBreakpointByURLParams represents Debugger.setBreakpointByUrl parameters.
 
@@ -411,24 +567,25 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreak
 */
 type SetBreakpointByURLParams struct {
 	// Line number to set breakpoint at.
-	LineNumber int64 `json:"lineNumber"`
+	LineNumber int `json:"lineNumber"`
 
 	// Optional. URL of the resources to set breakpoint on.
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
-	// Optional. Regex pattern for the URLs of the resources to set breakpoints
-	// on. Either url or urlRegex must be specified.
-	URLRegex string `json:"urlRegex,omitempty"`
+	// Optional. Regex pattern for the URLs of the resources to set breakpoints on.
+	// Either `url` or `urlRegex` must be specified.
+	URLRegex *string `json:"urlRegex,omitempty"`
 
 	// Optional. Script hash of the resources to set breakpoint on.
-	ScriptHash string `json:"scriptHash,omitempty"`
+	ScriptHash *string `json:"scriptHash,omitempty"`
 
 	// Optional. Offset in the line to set breakpoint at.
-	ColumnNumber int64 `json:"columnNumber,omitempty"`
+	ColumnNumber *int `json:"columnNumber,omitempty"`
 
 	// Optional. Expression to use as a breakpoint condition. When specified,
-	// debugger will only stop on the breakpoint if this expression evaluates to true.
-	Condition string `json:"condition,omitempty"`
+	// debugger will only stop on the breakpoint if this expression evaluates to
+	// true.
+	Condition *string `json:"condition,omitempty"`
 }
 
 /*
@@ -437,7 +594,7 @@ SetBreakpointByURLResult represents the result of calls to Debugger.setBreakpoin
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointByUrl
 */
 type SetBreakpointByURLResult struct {
-	// ID of the created breakpoint for further reference.
+	// Id of the created breakpoint for further reference.
 	BreakpointID BreakpointID `json:"breakpointId"`
 
 	// List of the locations this breakpoint resolved into upon addition.
@@ -447,6 +604,33 @@ type SetBreakpointByURLResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetBreakpointOnFunctionCallParams represents Debugger.setBreakpointOnFunctionCall parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointOnFunctionCall
+*/
+type SetBreakpointOnFunctionCallParams struct {
+	// Function object id.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+
+	// Optional. Expression to use as a breakpoint condition. When specified,
+	// debugger will stop on the breakpoint if this expression evaluates to true.
+	Condition *string `json:"condition,omitempty"`
+}
+
+/*
+SetBreakpointOnFunctionCallResult represents the result of calls to Debugger.setBreakpointOnFunctionCall.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointOnFunctionCall
+*/
+type SetBreakpointOnFunctionCallResult struct {
+	// Id of the created breakpoint for further reference.
+	BreakpointID BreakpointID `json:"breakpointId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetBreakpointsActiveParams represents Debugger.setBreakpointsActive parameters.
 
@@ -474,9 +658,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPause
 */
 type SetPauseOnExceptionsParams struct {
 	// Pause on exceptions mode. Allowed values:
-	//	- State.None
-	//	- State.Uncaught
-	//	- State.All
+	//   - State.None
+	//   - State.Caught
+	//   - State.Uncaught
+	//   - State.All
 	State StateEnum `json:"state"`
 }
 
@@ -516,7 +701,7 @@ SetScriptSourceParams represents Debugger.setScriptSource parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScriptSource
 */
 type SetScriptSourceParams struct {
-	// ID of the script to edit.
+	// Id of the script to edit.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// New content of the script.
@@ -524,7 +709,12 @@ type SetScriptSourceParams struct {
 
 	// Optional. If true the change will not actually be applied. Dry run may be
 	// used to get result description without actually modifying the code.
-	DryRun bool `json:"dryRun,omitempty"`
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Optional. If true, then `scriptSource` is allowed to change the function on
+	// top of the stack as long as the top-most stack frame is the only activation
+	// of that function. EXPERIMENTAL.
+	AllowTopFrameEditing *bool `json:"allowTopFrameEditing,omitempty"`
 }
 
 /*
@@ -533,21 +723,32 @@ SetScriptSourceResult represents the result of calls to Debugger.setScriptSource
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScriptSource
 */
 type SetScriptSourceResult struct {
-	// Optional. New stack trace in case editing has happened while VM was
-	// stopped.
+	// Optional. New stack trace in case editing has happened while VM was stopped.
+	// DEPRECATED.
 	CallFrames []*CallFrame `json:"callFrames,omitempty"`
 
 	// Optional. Whether current call stack was modified after applying the
-	// changes.
+	// changes. DEPRECATED.
 	StackChanged bool `json:"stackChanged,omitempty"`
 
-	// Optional. Async stack trace, if any.
+	// Optional. Async stack trace, if any. DEPRECATED.
 	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
 
-	// Optional. Async stack trace, if any. EXPERIMENTAL.
-	AsyncStackTraceID runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
-
-	// Optional. Exception details if any.
+	// Optional. Async stack trace, if any. DEPRECATED.
+	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
+
+	// Whether the operation was successful or not. Only `Ok` denotes a successful
+	// live edit while the other enum variants denote why the live edit failed.
+	// EXPERIMENTAL. Allowed values:
+	//   - Status.Ok
+	//   - Status.CompileError
+	//   - Status.BlockedByActiveGenerator
+	//   - Status.BlockedByActiveFunction
+	//   - Status.BlockedByTopLevelEsModuleChange
+	Status StatusEnum `json:"status"`
+
+	// Optional. Exception details if any. Only present when `status` is
+	// `CompileError`.
 	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"`
 
 	// Error information related to executing this method
@@ -565,8 +766,7 @@ type SetSkipAllPausesParams struct {
 }
 
 /*
-SetSkipAllPausesResult represents the result of calls to
-Debugger.setSkipAllPauses.
+SetSkipAllPausesResult represents the result of calls to Debugger.setSkipAllPauses.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setSkipAllPauses
 */
@@ -584,7 +784,7 @@ type SetVariableValueParams struct {
 	// 0-based number of scope as was listed in scope chain. Only 'local',
 	// 'closure' and 'catch' scope types are allowed. Other scopes could be
 	// manipulated manually.
-	ScopeNumber int64 `json:"scopeNumber"`
+	ScopeNumber int `json:"scopeNumber"`
 
 	// Variable name.
 	VariableName string `json:"variableName"`
@@ -592,7 +792,7 @@ type SetVariableValueParams struct {
 	// New variable value.
 	NewValue *runtime.CallArgument `json:"newValue"`
 
-	// ID of callframe that holds variable.
+	// Id of callframe that holds variable.
 	CallFrameID CallFrameID `json:"callFrameId"`
 }
 
@@ -612,9 +812,13 @@ StepIntoParams represents Debugger.stepInto parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepInto
 */
 type StepIntoParams struct {
-	// Optional. Debugger will issue additional Debugger.paused notification if
-	// any async task is scheduled before next pause. EXPERIMENTAL.
-	BreakOnAsyncCall bool `json:"breakOnAsyncCall,omitempty"`
+	// Optional. Debugger will pause on the execution of the first async task which
+	// was scheduled before next pause. EXPERIMENTAL.
+	BreakOnAsyncCall *bool `json:"breakOnAsyncCall,omitempty"`
+
+	// Optional. The skipList specifies location ranges that should be skipped on
+	// step into. EXPERIMENTAL.
+	SkipList []*LocationRange `json:"skipList,omitempty"`
 }
 
 /*
@@ -637,6 +841,17 @@ type StepOutResult struct {
 	Err error `json:"-"`
 }
 
+/*
+StepOverParams represents Debugger.stepOver parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOver
+*/
+type StepOverParams struct {
+	// Optional. The skipList specifies location ranges that should be skipped on
+	// step over. EXPERIMENTAL.
+	SkipList []*LocationRange `json:"skipList,omitempty"`
+}
+
 /*
 StepOverResult represents the result of calls to Debugger.stepOver.
 
@@ -646,3 +861,13 @@ type StepOverResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+ScheduleStepIntoAsyncResult represents the result of calls to Debugger.scheduleStepIntoAsync.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-scheduleStepIntoAsync
+*/
+type ScheduleStepIntoAsyncResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
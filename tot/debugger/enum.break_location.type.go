@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type breakLocationTypeEnum struct {
@@ -12,7 +15,7 @@ type breakLocationTypeEnum struct {
 }
 
 /*
-BreakLocationType provides named acces to the BreakLocationTypeEnum values.
+BreakLocationType provides named access to the BreakLocationTypeEnum values.
 */
 var BreakLocationType = breakLocationTypeEnum{
 	DebuggerStatement: BreakLocationTypeDebuggerStatement,
@@ -21,10 +24,12 @@ var BreakLocationType = breakLocationTypeEnum{
 }
 
 /*
-BreakLocationTypeEnum is Optional. Allowed values:
-	- BreakLocationType.DebuggerStatement "debuggerStatement"
-	- BreakLocationType.Call              "call"
-	- BreakLocationType.Return            "return"
+BreakLocationTypeEnum represents the Debugger.BreakLocation.type values.
+
+Allowed values:
+  - BreakLocationType.DebuggerStatement "debuggerStatement"
+  - BreakLocationType.Call              "call"
+  - BreakLocationType.Return            "return"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-BreakLocation
 */
@@ -34,7 +39,16 @@ type BreakLocationTypeEnum int
 String implements Stringer
 */
 func (enum BreakLocationTypeEnum) String() string {
-	return _breakLocationTypeEnums[enum]
+	return _breakLocationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BreakLocationTypeEnum) Unknown() bool {
+	return !_breakLocationTypeEnums.Known(enum)
 }
 
 /*
@@ -45,25 +59,16 @@ func (enum BreakLocationTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *BreakLocationTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _breakLocationTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid breakLocationType value", bytes)
+	*enum = _breakLocationTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -75,9 +80,9 @@ const (
 	BreakLocationTypeReturn
 )
 
-var _breakLocationTypeEnums = map[BreakLocationTypeEnum]string{
+var _breakLocationTypeEnums = enums.New(map[BreakLocationTypeEnum]string{
 	BreakLocationTypeEnum(0):           "",
 	BreakLocationTypeDebuggerStatement: "debuggerStatement",
 	BreakLocationTypeCall:              "call",
 	BreakLocationTypeReturn:            "return",
-}
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBreakLocationType(t *testing.T) {
+	var enum BreakLocationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BreakLocationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = BreakLocationType.DebuggerStatement
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"debuggerStatement"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"debuggerStatement"`, result)
+	}
+	json.Unmarshal([]byte(`"debuggerStatement"`), &enum)
+	if BreakLocationType.DebuggerStatement != enum {
+		t.Errorf("Expected %d, got %d", BreakLocationType.DebuggerStatement, enum)
+	}
+
+	enum = BreakLocationType.Call
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"call"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"call"`, result)
+	}
+	json.Unmarshal([]byte(`"call"`), &enum)
+	if BreakLocationType.Call != enum {
+		t.Errorf("Expected %d, got %d", BreakLocationType.Call, enum)
+	}
+
+	enum = BreakLocationType.Return
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"return"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"return"`, result)
+	}
+	json.Unmarshal([]byte(`"return"`), &enum)
+	if BreakLocationType.Return != enum {
+		t.Errorf("Expected %d, got %d", BreakLocationType.Return, enum)
+	}
+}
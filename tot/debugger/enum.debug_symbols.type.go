@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type typeEnum struct {
+	SourceMap     TypeEnum
+	EmbeddedDWARF TypeEnum
+	ExternalDWARF TypeEnum
+}
+
+/*
+Type provides named access to the TypeEnum values.
+*/
+var Type = typeEnum{
+	SourceMap:     typeSourceMap,
+	EmbeddedDWARF: typeEmbeddedDWARF,
+	ExternalDWARF: typeExternalDWARF,
+}
+
+/*
+TypeEnum represents the Debugger.DebugSymbols.type values.
+
+Type of the debug symbols.
+
+Allowed values:
+  - Type.SourceMap     "SourceMap"
+  - Type.EmbeddedDWARF "EmbeddedDWARF"
+  - Type.ExternalDWARF "ExternalDWARF"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-DebugSymbols
+*/
+type TypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TypeEnum) String() string {
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _typeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// typeSourceMap represents the "SourceMap" value.
+	typeSourceMap TypeEnum = iota + 1
+	// typeEmbeddedDWARF represents the "EmbeddedDWARF" value.
+	typeEmbeddedDWARF
+	// typeExternalDWARF represents the "ExternalDWARF" value.
+	typeExternalDWARF
+)
+
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):       "",
+	typeSourceMap:     "SourceMap",
+	typeEmbeddedDWARF: "EmbeddedDWARF",
+	typeExternalDWARF: "ExternalDWARF",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumType(t *testing.T) {
+	var enum TypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Type.SourceMap
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SourceMap"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SourceMap"`, result)
+	}
+	json.Unmarshal([]byte(`"SourceMap"`), &enum)
+	if Type.SourceMap != enum {
+		t.Errorf("Expected %d, got %d", Type.SourceMap, enum)
+	}
+
+	enum = Type.EmbeddedDWARF
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbeddedDWARF"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbeddedDWARF"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbeddedDWARF"`), &enum)
+	if Type.EmbeddedDWARF != enum {
+		t.Errorf("Expected %d, got %d", Type.EmbeddedDWARF, enum)
+	}
+
+	enum = Type.ExternalDWARF
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ExternalDWARF"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ExternalDWARF"`, result)
+	}
+	json.Unmarshal([]byte(`"ExternalDWARF"`), &enum)
+	if Type.ExternalDWARF != enum {
+		t.Errorf("Expected %d, got %d", Type.ExternalDWARF, enum)
+	}
+}
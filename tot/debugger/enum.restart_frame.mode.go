@@ -0,0 +1,79 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type modeEnum struct {
+	StepInto ModeEnum
+}
+
+/*
+Mode provides named access to the ModeEnum values.
+*/
+var Mode = modeEnum{
+	StepInto: modeStepInto,
+}
+
+/*
+ModeEnum represents the Debugger.restartFrame.mode values.
+
+The `mode` parameter must be present and set to 'StepInto', otherwise
+`restartFrame` will error out.
+
+Allowed values:
+  - Mode.StepInto "StepInto"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartFrame
+*/
+type ModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ModeEnum) String() string {
+	return _modeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ModeEnum) Unknown() bool {
+	return !_modeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _modeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// modeStepInto represents the "StepInto" value.
+	modeStepInto ModeEnum = iota + 1
+)
+
+var _modeEnums = enums.New(map[ModeEnum]string{
+	ModeEnum(0):  "",
+	modeStepInto: "StepInto",
+})
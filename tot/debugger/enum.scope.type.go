@@ -1,48 +1,58 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type scopeTypeEnum struct {
-	Global  ScopeTypeEnum
-	Local   ScopeTypeEnum
-	With    ScopeTypeEnum
-	Closure ScopeTypeEnum
-	Catch   ScopeTypeEnum
-	Block   ScopeTypeEnum
-	Script  ScopeTypeEnum
-	Eval    ScopeTypeEnum
-	Module  ScopeTypeEnum
+	Global              ScopeTypeEnum
+	Local               ScopeTypeEnum
+	With                ScopeTypeEnum
+	Closure             ScopeTypeEnum
+	Catch               ScopeTypeEnum
+	Block               ScopeTypeEnum
+	Script              ScopeTypeEnum
+	Eval                ScopeTypeEnum
+	Module              ScopeTypeEnum
+	WasmExpressionStack ScopeTypeEnum
 }
 
 /*
-ScopeType provides named acces to the ScopeTypeEnum values.
+ScopeType provides named access to the ScopeTypeEnum values.
 */
 var ScopeType = scopeTypeEnum{
-	Global:  ScopeTypeGlobal,
-	Local:   ScopeTypeLocal,
-	With:    ScopeTypeWith,
-	Closure: ScopeTypeClosure,
-	Catch:   ScopeTypeCatch,
-	Block:   ScopeTypeBlock,
-	Script:  ScopeTypeScript,
-	Eval:    ScopeTypeEval,
-	Module:  ScopeTypeModule,
+	Global:              ScopeTypeGlobal,
+	Local:               ScopeTypeLocal,
+	With:                ScopeTypeWith,
+	Closure:             ScopeTypeClosure,
+	Catch:               ScopeTypeCatch,
+	Block:               ScopeTypeBlock,
+	Script:              ScopeTypeScript,
+	Eval:                ScopeTypeEval,
+	Module:              ScopeTypeModule,
+	WasmExpressionStack: ScopeTypeWasmExpressionStack,
 }
 
 /*
-ScopeTypeEnum represents the scope type. Allowed values:
-	- ScopeType.Global  "global"
-	- ScopeType.Local   "local"
-	- ScopeType.With    "with"
-	- ScopeType.Closure "closure"
-	- ScopeType.Catch   "catch"
-	- ScopeType.Block   "block"
-	- ScopeType.Script  "script"
-	- ScopeType.Eval    "eval"
-	- ScopeType.Module  "module"
+ScopeTypeEnum represents the Debugger.Scope.type values.
+
+Scope type.
+
+Allowed values:
+  - ScopeType.Global              "global"
+  - ScopeType.Local               "local"
+  - ScopeType.With                "with"
+  - ScopeType.Closure             "closure"
+  - ScopeType.Catch               "catch"
+  - ScopeType.Block               "block"
+  - ScopeType.Script              "script"
+  - ScopeType.Eval                "eval"
+  - ScopeType.Module              "module"
+  - ScopeType.WasmExpressionStack "wasm-expression-stack"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#type-Scope
 */
@@ -52,7 +62,16 @@ type ScopeTypeEnum int
 String implements Stringer
 */
 func (enum ScopeTypeEnum) String() string {
-	return _scopeTypeEnums[enum]
+	return _scopeTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ScopeTypeEnum) Unknown() bool {
+	return !_scopeTypeEnums.Known(enum)
 }
 
 /*
@@ -63,25 +82,16 @@ func (enum ScopeTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ScopeTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _scopeTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid scopeType value", bytes)
+	*enum = _scopeTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -103,16 +113,20 @@ const (
 	ScopeTypeEval
 	// ScopeTypeModule represents the "module" value.
 	ScopeTypeModule
+	// ScopeTypeWasmExpressionStack represents the "wasm-expression-stack" value.
+	ScopeTypeWasmExpressionStack
 )
 
-var _scopeTypeEnums = map[ScopeTypeEnum]string{
-	ScopeTypeGlobal:  "global",
-	ScopeTypeLocal:   "local",
-	ScopeTypeWith:    "with",
-	ScopeTypeClosure: "closure",
-	ScopeTypeCatch:   "catch",
-	ScopeTypeBlock:   "block",
-	ScopeTypeScript:  "script",
-	ScopeTypeEval:    "eval",
-	ScopeTypeModule:  "module",
-}
+var _scopeTypeEnums = enums.New(map[ScopeTypeEnum]string{
+	ScopeTypeEnum(0):             "",
+	ScopeTypeGlobal:              "global",
+	ScopeTypeLocal:               "local",
+	ScopeTypeWith:                "with",
+	ScopeTypeClosure:             "closure",
+	ScopeTypeCatch:               "catch",
+	ScopeTypeBlock:               "block",
+	ScopeTypeScript:              "script",
+	ScopeTypeEval:                "eval",
+	ScopeTypeModule:              "module",
+	ScopeTypeWasmExpressionStack: "wasm-expression-stack",
+})
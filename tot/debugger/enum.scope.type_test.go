@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
@@ -11,8 +13,24 @@ func TestEnumScopeType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ScopeTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumScopeType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"global"` != string(result) {
-		t.Errorf("Expected '\"global\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"global"`, result)
 	}
 	json.Unmarshal([]byte(`"global"`), &enum)
 	if ScopeType.Global != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Global, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Global, enum)
 	}
 
 	enum = ScopeType.Local
@@ -42,11 +60,11 @@ func TestEnumScopeType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"local"` != string(result) {
-		t.Errorf("Expected '\"local\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"local"`, result)
 	}
 	json.Unmarshal([]byte(`"local"`), &enum)
 	if ScopeType.Local != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Local, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Local, enum)
 	}
 
 	enum = ScopeType.With
@@ -55,17 +73,12 @@ func TestEnumScopeType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"with"` != string(result) {
-		t.Errorf("Expected '\"with\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"with"`, result)
 	}
 	json.Unmarshal([]byte(`"with"`), &enum)
 	if ScopeType.With != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.With, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.With, enum)
 	}
-}
-func TestEnumScopeType2(t *testing.T) {
-	var enum ScopeTypeEnum
-	var err error
-	var result []byte
 
 	enum = ScopeType.Closure
 	result, err = json.Marshal(enum)
@@ -73,11 +86,11 @@ func TestEnumScopeType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"closure"` != string(result) {
-		t.Errorf("Expected '\"debuggerstatementclosure got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"closure"`, result)
 	}
-	json.Unmarshal([]byte(`"debuggerstatementclosure"`), &enum)
+	json.Unmarshal([]byte(`"closure"`), &enum)
 	if ScopeType.Closure != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Closure, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Closure, enum)
 	}
 
 	enum = ScopeType.Catch
@@ -86,11 +99,11 @@ func TestEnumScopeType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"catch"` != string(result) {
-		t.Errorf("Expected '\"catch\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"catch"`, result)
 	}
 	json.Unmarshal([]byte(`"catch"`), &enum)
 	if ScopeType.Catch != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Catch, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Catch, enum)
 	}
 
 	enum = ScopeType.Block
@@ -99,11 +112,11 @@ func TestEnumScopeType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"block"` != string(result) {
-		t.Errorf("Expected '\"block\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"block"`, result)
 	}
 	json.Unmarshal([]byte(`"block"`), &enum)
 	if ScopeType.Block != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Block, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Block, enum)
 	}
 
 	enum = ScopeType.Script
@@ -112,18 +125,12 @@ func TestEnumScopeType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"script"` != string(result) {
-		t.Errorf("Expected '\"script\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"script"`, result)
 	}
 	json.Unmarshal([]byte(`"script"`), &enum)
 	if ScopeType.Script != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Script, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Script, enum)
 	}
-}
-
-func TestEnumScopeType3(t *testing.T) {
-	var enum ScopeTypeEnum
-	var err error
-	var result []byte
 
 	enum = ScopeType.Eval
 	result, err = json.Marshal(enum)
@@ -131,11 +138,11 @@ func TestEnumScopeType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"eval"` != string(result) {
-		t.Errorf("Expected '\"eval\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"eval"`, result)
 	}
 	json.Unmarshal([]byte(`"eval"`), &enum)
 	if ScopeType.Eval != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Eval, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Eval, enum)
 	}
 
 	enum = ScopeType.Module
@@ -144,10 +151,23 @@ func TestEnumScopeType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"module"` != string(result) {
-		t.Errorf("Expected '\"module\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"module"`, result)
 	}
 	json.Unmarshal([]byte(`"module"`), &enum)
 	if ScopeType.Module != enum {
-		t.Errorf("Expcected %d, got %d", ScopeType.Module, enum)
+		t.Errorf("Expected %d, got %d", ScopeType.Module, enum)
+	}
+
+	enum = ScopeType.WasmExpressionStack
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"wasm-expression-stack"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"wasm-expression-stack"`, result)
+	}
+	json.Unmarshal([]byte(`"wasm-expression-stack"`), &enum)
+	if ScopeType.WasmExpressionStack != enum {
+		t.Errorf("Expected %d, got %d", ScopeType.WasmExpressionStack, enum)
 	}
 }
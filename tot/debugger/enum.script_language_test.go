@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumScriptLanguage(t *testing.T) {
+	var enum ScriptLanguageEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ScriptLanguageEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ScriptLanguage.JavaScript
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JavaScript"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JavaScript"`, result)
+	}
+	json.Unmarshal([]byte(`"JavaScript"`), &enum)
+	if ScriptLanguage.JavaScript != enum {
+		t.Errorf("Expected %d, got %d", ScriptLanguage.JavaScript, enum)
+	}
+
+	enum = ScriptLanguage.WebAssembly
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WebAssembly"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WebAssembly"`, result)
+	}
+	json.Unmarshal([]byte(`"WebAssembly"`), &enum)
+	if ScriptLanguage.WebAssembly != enum {
+		t.Errorf("Expected %d, got %d", ScriptLanguage.WebAssembly, enum)
+	}
+}
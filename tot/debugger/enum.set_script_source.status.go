@@ -0,0 +1,103 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type statusEnum struct {
+	Ok                              StatusEnum
+	CompileError                    StatusEnum
+	BlockedByActiveGenerator        StatusEnum
+	BlockedByActiveFunction         StatusEnum
+	BlockedByTopLevelEsModuleChange StatusEnum
+}
+
+/*
+Status provides named access to the StatusEnum values.
+*/
+var Status = statusEnum{
+	Ok:                              statusOk,
+	CompileError:                    statusCompileError,
+	BlockedByActiveGenerator:        statusBlockedByActiveGenerator,
+	BlockedByActiveFunction:         statusBlockedByActiveFunction,
+	BlockedByTopLevelEsModuleChange: statusBlockedByTopLevelEsModuleChange,
+}
+
+/*
+StatusEnum represents the Debugger.setScriptSource.status values.
+
+Whether the operation was successful or not. Only `Ok` denotes a successful live
+edit while the other enum variants denote why the live edit failed.
+
+Allowed values:
+  - Status.Ok                              "Ok"
+  - Status.CompileError                    "CompileError"
+  - Status.BlockedByActiveGenerator        "BlockedByActiveGenerator"
+  - Status.BlockedByActiveFunction         "BlockedByActiveFunction"
+  - Status.BlockedByTopLevelEsModuleChange "BlockedByTopLevelEsModuleChange"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScriptSource
+*/
+type StatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StatusEnum) String() string {
+	return _statusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StatusEnum) Unknown() bool {
+	return !_statusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _statusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// statusOk represents the "Ok" value.
+	statusOk StatusEnum = iota + 1
+	// statusCompileError represents the "CompileError" value.
+	statusCompileError
+	// statusBlockedByActiveGenerator represents the "BlockedByActiveGenerator" value.
+	statusBlockedByActiveGenerator
+	// statusBlockedByActiveFunction represents the "BlockedByActiveFunction" value.
+	statusBlockedByActiveFunction
+	// statusBlockedByTopLevelEsModuleChange represents the "BlockedByTopLevelEsModuleChange" value.
+	statusBlockedByTopLevelEsModuleChange
+)
+
+var _statusEnums = enums.New(map[StatusEnum]string{
+	StatusEnum(0):                         "",
+	statusOk:                              "Ok",
+	statusCompileError:                    "CompileError",
+	statusBlockedByActiveGenerator:        "BlockedByActiveGenerator",
+	statusBlockedByActiveFunction:         "BlockedByActiveFunction",
+	statusBlockedByTopLevelEsModuleChange: "BlockedByTopLevelEsModuleChange",
+})
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStatus(t *testing.T) {
+	var enum StatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Status.Ok
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ok"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ok"`, result)
+	}
+	json.Unmarshal([]byte(`"Ok"`), &enum)
+	if Status.Ok != enum {
+		t.Errorf("Expected %d, got %d", Status.Ok, enum)
+	}
+
+	enum = Status.CompileError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CompileError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CompileError"`, result)
+	}
+	json.Unmarshal([]byte(`"CompileError"`), &enum)
+	if Status.CompileError != enum {
+		t.Errorf("Expected %d, got %d", Status.CompileError, enum)
+	}
+
+	enum = Status.BlockedByActiveGenerator
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByActiveGenerator"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByActiveGenerator"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByActiveGenerator"`), &enum)
+	if Status.BlockedByActiveGenerator != enum {
+		t.Errorf("Expected %d, got %d", Status.BlockedByActiveGenerator, enum)
+	}
+
+	enum = Status.BlockedByActiveFunction
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByActiveFunction"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByActiveFunction"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByActiveFunction"`), &enum)
+	if Status.BlockedByActiveFunction != enum {
+		t.Errorf("Expected %d, got %d", Status.BlockedByActiveFunction, enum)
+	}
+
+	enum = Status.BlockedByTopLevelEsModuleChange
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByTopLevelEsModuleChange"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByTopLevelEsModuleChange"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByTopLevelEsModuleChange"`), &enum)
+	if Status.BlockedByTopLevelEsModuleChange != enum {
+		t.Errorf("Expected %d, got %d", Status.BlockedByTopLevelEsModuleChange, enum)
+	}
+}
@@ -1,30 +1,40 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type stateEnum struct {
 	None     StateEnum
+	Caught   StateEnum
 	Uncaught StateEnum
 	All      StateEnum
 }
 
 /*
-State provides named acces to the StateEnum values.
+State provides named access to the StateEnum values.
 */
 var State = stateEnum{
 	None:     stateNone,
+	Caught:   stateCaught,
 	Uncaught: stateUncaught,
 	All:      stateAll,
 }
 
 /*
-StateEnum represents the pause on exceptions mode. Allowed values:
-	- State.None     "none"
-	- State.Uncaught "uncaught"
-	- State.All      "all"
+StateEnum represents the Debugger.setPauseOnExceptions.state values.
+
+Pause on exceptions mode.
+
+Allowed values:
+  - State.None     "none"
+  - State.Caught   "caught"
+  - State.Uncaught "uncaught"
+  - State.All      "all"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPauseOnExceptions
 */
@@ -34,7 +44,16 @@ type StateEnum int
 String implements Stringer
 */
 func (enum StateEnum) String() string {
-	return _stateEnums[enum]
+	return _stateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StateEnum) Unknown() bool {
+	return !_stateEnums.Known(enum)
 }
 
 /*
@@ -45,38 +64,33 @@ func (enum StateEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *StateEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _stateEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid state value", bytes)
+	*enum = _stateEnums.Parse(val)
+	return nil
 }
 
 const (
 	// stateNone represents the "none" value.
 	stateNone StateEnum = iota + 1
+	// stateCaught represents the "caught" value.
+	stateCaught
 	// stateUncaught represents the "uncaught" value.
 	stateUncaught
 	// stateAll represents the "all" value.
 	stateAll
 )
 
-var _stateEnums = map[StateEnum]string{
+var _stateEnums = enums.New(map[StateEnum]string{
+	StateEnum(0):  "",
 	stateNone:     "none",
+	stateCaught:   "caught",
 	stateUncaught: "uncaught",
 	stateAll:      "all",
-}
+})
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type targetCallFramesEnum struct {
@@ -11,7 +14,7 @@ type targetCallFramesEnum struct {
 }
 
 /*
-TargetCallFrames provides named acces to the TargetCallFramesEnum values.
+TargetCallFrames provides named access to the TargetCallFramesEnum values.
 */
 var TargetCallFrames = targetCallFramesEnum{
 	Any:     targetCallFramesAny,
@@ -19,9 +22,12 @@ var TargetCallFrames = targetCallFramesEnum{
 }
 
 /*
-TargetCallFramesEnum is optional. Allowed values:
-	- TargetCallFrames.Any     "any"
-	- TargetCallFrames.Current "current"
+TargetCallFramesEnum represents the Debugger.continueToLocation.targetCallFrames
+values.
+
+Allowed values:
+  - TargetCallFrames.Any     "any"
+  - TargetCallFrames.Current "current"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continueToLocation
 */
@@ -31,7 +37,16 @@ type TargetCallFramesEnum int
 String implements Stringer
 */
 func (enum TargetCallFramesEnum) String() string {
-	return _targetCallFramesEnums[enum]
+	return _targetCallFramesEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TargetCallFramesEnum) Unknown() bool {
+	return !_targetCallFramesEnums.Known(enum)
 }
 
 /*
@@ -42,36 +57,27 @@ func (enum TargetCallFramesEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *TargetCallFramesEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _targetCallFramesEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid targetCallFrames value", bytes)
+	*enum = _targetCallFramesEnums.Parse(val)
+	return nil
 }
 
 const (
-	// BreakLocationTypeDebuggerStatement represents the "debuggerStatement" value.
+	// targetCallFramesAny represents the "any" value.
 	targetCallFramesAny TargetCallFramesEnum = iota + 1
-	// targetCallFramesCall represents the "call" value.
+	// targetCallFramesCurrent represents the "current" value.
 	targetCallFramesCurrent
 )
 
-var _targetCallFramesEnums = map[TargetCallFramesEnum]string{
+var _targetCallFramesEnums = enums.New(map[TargetCallFramesEnum]string{
 	TargetCallFramesEnum(0): "",
 	targetCallFramesAny:     "any",
 	targetCallFramesCurrent: "current",
-}
+})
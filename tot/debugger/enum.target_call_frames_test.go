@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
@@ -15,9 +17,20 @@ func TestEnumTargetCallFrames(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown TargetCallFramesEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumTargetCallFrames(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"any"` != string(result) {
-		t.Errorf("Expected '\"any\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"any"`, result)
 	}
 	json.Unmarshal([]byte(`"any"`), &enum)
 	if TargetCallFrames.Any != enum {
-		t.Errorf("Expcected %d, got %d", TargetCallFrames.Any, enum)
+		t.Errorf("Expected %d, got %d", TargetCallFrames.Any, enum)
 	}
 
 	enum = TargetCallFrames.Current
@@ -47,10 +60,10 @@ func TestEnumTargetCallFrames(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"current"` != string(result) {
-		t.Errorf("Expected '\"current\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"current"`, result)
 	}
 	json.Unmarshal([]byte(`"current"`), &enum)
 	if TargetCallFrames.Current != enum {
-		t.Errorf("Expcected %d, got %d", TargetCallFrames.Current, enum)
+		t.Errorf("Expected %d, got %d", TargetCallFrames.Current, enum)
 	}
 }
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
-import "github.com/mkenney/go-chrome/tot/runtime"
+import (
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
 
 /*
 BreakpointResolvedEvent represents Debugger.breakpointResolved event data.
@@ -27,25 +31,35 @@ type PausedEvent struct {
 	// Call stack the virtual machine stopped on.
 	CallFrames []*CallFrame `json:"callFrames"`
 
-	// Pause reason. Allowed values: XHR, DOM, EventListener, exception, assert,
-	// debugCommand, promiseRejection, OOM, other, ambiguous.
-	Reason string `json:"reason"`
+	// Pause reason. Allowed values:
+	//   - Reason.Ambiguous
+	//   - Reason.Assert
+	//   - Reason.CSPViolation
+	//   - Reason.DebugCommand
+	//   - Reason.DOM
+	//   - Reason.EventListener
+	//   - Reason.Exception
+	//   - Reason.Instrumentation
+	//   - Reason.OOM
+	//   - Reason.Other
+	//   - Reason.PromiseRejection
+	//   - Reason.XHR
+	//   - Reason.Step
+	Reason ReasonEnum `json:"reason"`
 
 	// Optional. Object containing break-specific auxiliary properties.
-	Data map[string]string `json:"data,omitempty"`
+	Data map[string]interface{} `json:"data,omitempty"`
 
-	// Optional. Hit breakpoints IDs.
+	// Optional. Hit breakpoints IDs
 	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
 
 	// Optional. Async stack trace, if any.
 	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
 
-	// Optional. Async stack trace, if any. EXPERIMENTAL
+	// Optional. Async stack trace, if any. EXPERIMENTAL.
 	AsyncStackTraceID *runtime.StackTraceID `json:"asyncStackTraceId,omitempty"`
 
-	// Optional. Just scheduled async call will have this stack trace as parent
-	// stack during async execution. This field is available only after
-	// Debugger.stepInto call with breakOnAsynCall flag. EXPERIMENTAL.
+	// Optional. Never present, will be removed. EXPERIMENTAL. DEPRECATED.
 	AsyncCallStackTraceID *runtime.StackTraceID `json:"asyncCallStackTraceId,omitempty"`
 
 	// Error information related to this event
@@ -76,25 +90,30 @@ type ScriptFailedToParseEvent struct {
 
 	// Line offset of the script within the resource with given URL (for script
 	// tags).
-	StartLine int64 `json:"startLine"`
+	StartLine int `json:"startLine"`
 
 	// Column offset of the script within the resource with given URL.
-	StartColumn int64 `json:"startColumn"`
+	StartColumn int `json:"startColumn"`
 
 	// Last line of the script.
-	EndLine int64 `json:"endLine"`
+	EndLine int `json:"endLine"`
 
 	// Length of the last line of the script.
-	EndColumn int64 `json:"endColumn"`
+	EndColumn int `json:"endColumn"`
 
 	// Specifies script creation context.
 	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
 
-	// Content hash of the script.
+	// Content hash of the script, SHA-256.
 	Hash string `json:"hash"`
 
-	// Optional. Embedder-specific auxiliary data.
-	ExecutionContextAuxData map[string]string `json:"executionContextAuxData,omitempty"`
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
 
 	// Optional. URL of source map associated with script (if any).
 	SourceMapURL string `json:"sourceMapURL,omitempty"`
@@ -106,12 +125,24 @@ type ScriptFailedToParseEvent struct {
 	IsModule bool `json:"isModule,omitempty"`
 
 	// Optional. This script length.
-	Length int64 `json:"length,omitempty"`
+	Length int `json:"length,omitempty"`
 
 	// Optional. JavaScript top stack frame of where the script parsed event was
 	// triggered if available. EXPERIMENTAL.
 	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
 
+	// Optional. If the scriptLanguage is WebAssembly, the code section offset in
+	// the module. EXPERIMENTAL.
+	CodeOffset int `json:"codeOffset,omitempty"`
+
+	// Optional. The language of the script. EXPERIMENTAL. Allowed values:
+	//   - ScriptLanguage.JavaScript
+	//   - ScriptLanguage.WebAssembly
+	ScriptLanguage ScriptLanguageEnum `json:"scriptLanguage,omitempty"`
+
+	// Optional. The name the embedder supplied for this script. EXPERIMENTAL.
+	EmbedderName string `json:"embedderName,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -144,11 +175,16 @@ type ScriptParsedEvent struct {
 	// Specifies script creation context.
 	ExecutionContextID runtime.ExecutionContextID `json:"executionContextId"`
 
-	// Content hash of the script.
+	// Content hash of the script, SHA-256.
 	Hash string `json:"hash"`
 
-	// Optional. Embedder-specific auxiliary data.
-	ExecutionContextAuxData map[string]string `json:"executionContextAuxData,omitempty"`
+	// For Wasm modules, the content of the `build_id` custom section. For
+	// JavaScript the `debugId` magic comment.
+	BuildID string `json:"buildId"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
 
 	// Optional. True, if this script is generated as a result of the live edit
 	// operation. EXPERIMENTAL.
@@ -170,6 +206,28 @@ type ScriptParsedEvent struct {
 	// triggered if available. EXPERIMENTAL.
 	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
 
+	// Optional. If the scriptLanguage is WebAssembly, the code section offset in
+	// the module. EXPERIMENTAL.
+	CodeOffset int `json:"codeOffset,omitempty"`
+
+	// Optional. The language of the script. EXPERIMENTAL. Allowed values:
+	//   - ScriptLanguage.JavaScript
+	//   - ScriptLanguage.WebAssembly
+	ScriptLanguage ScriptLanguageEnum `json:"scriptLanguage,omitempty"`
+
+	// Optional. If the scriptLanguage is WebAssembly, the source of debug symbols
+	// for the module. EXPERIMENTAL.
+	DebugSymbols []*DebugSymbols `json:"debugSymbols,omitempty"`
+
+	// Optional. The name the embedder supplied for this script. EXPERIMENTAL.
+	EmbedderName string `json:"embedderName,omitempty"`
+
+	// Optional. The list of set breakpoints in this script if calls to
+	// `setBreakpointByUrl` matches this script's URL or hash. Clients that use
+	// this list can ignore the `breakpointResolved` event. They are equivalent.
+	// EXPERIMENTAL.
+	ResolvedBreakpoints []*ResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -0,0 +1,42 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package access provides type definitions for use with the Chrome DeviceAccess protocol
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/
+*/
+package access
+
+/*
+RequestID represents the DeviceAccess.RequestId type.
+
+Device request id.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#type-RequestId
+*/
+type RequestID string
+
+/*
+DeviceID represents the DeviceAccess.DeviceId type.
+
+A device id.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#type-DeviceId
+*/
+type DeviceID string
+
+/*
+PromptDevice represents the DeviceAccess.PromptDevice type.
+
+Device information displayed in a user prompt to select a device.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#type-PromptDevice
+*/
+type PromptDevice struct {
+	ID DeviceID `json:"id"`
+
+	// Display name as it appears in a device request user prompt.
+	Name string `json:"name"`
+}
@@ -0,0 +1,17 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package access
+
+/*
+DeviceRequestPromptedEvent represents DeviceAccess.deviceRequestPrompted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#event-deviceRequestPrompted
+*/
+type DeviceRequestPromptedEvent struct {
+	ID RequestID `json:"id"`
+
+	Devices []*PromptDevice `json:"devices"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,7 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package orientation
 
 /*
-ClearOverrideResult represents the result of calls to DeviceOrientation.clearOverride.
+ClearOverrideResult represents the result of calls to DeviceOrientation.clearDeviceOrientationOverride.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-clearDeviceOrientationOverride
 */
@@ -11,24 +13,23 @@ type ClearOverrideResult struct {
 }
 
 /*
-SetOverrideParams represents DeviceOrientation.setDeviceOrientationOverride
-parameters.
+SetOverrideParams represents DeviceOrientation.setDeviceOrientationOverride parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-setDeviceOrientationOverride
 */
 type SetOverrideParams struct {
-	// Mock alpha.
-	Alpha int64 `json:"alpha"`
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
 
-	// Mock beta.
-	Beta int64 `json:"beta"`
+	// Mock beta
+	Beta float64 `json:"beta"`
 
-	// Mock gamma.
-	Gamma int64 `json:"gamma"`
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
 }
 
 /*
-SetOverrideResult represents the result of calls to DeviceOrientation.setOverride.
+SetOverrideResult represents the result of calls to DeviceOrientation.setDeviceOrientationOverride.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-setDeviceOrientationOverride
 */
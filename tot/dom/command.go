@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package dom
 
 import (
+	"github.com/mkenney/go-chrome/tot/page"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
 
@@ -10,13 +13,12 @@ CollectClassNamesFromSubtreeParams represents DOM.collectClassNamesFromSubtree p
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-collectClassNamesFromSubtree
 */
 type CollectClassNamesFromSubtreeParams struct {
-	// ID of the node to collect class names.
+	// Id of the node to collect class names.
 	NodeID NodeID `json:"nodeId"`
 }
 
 /*
-CollectClassNamesFromSubtreeResult represents the result of calls to
-DOM.collectClassNamesFromSubtree.
+CollectClassNamesFromSubtreeResult represents the result of calls to DOM.collectClassNamesFromSubtree.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-collectClassNamesFromSubtree
 */
@@ -34,15 +36,15 @@ CopyToParams represents DOM.copyTo parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo
 */
 type CopyToParams struct {
-	// ID of the node to copy.
+	// Id of the node to copy.
 	NodeID NodeID `json:"nodeId"`
 
-	// ID of the element to drop the copy into.
+	// Id of the element to drop the copy into.
 	TargetNodeID NodeID `json:"targetNodeId"`
 
 	// Optional. Drop the copy before this node (if absent, the copy becomes the
-	// last child of targetNodeId).
-	InsertBeforeNodeID NodeID `json:"insertBeforeNodeId,omitempty"`
+	// last child of `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
 }
 
 /*
@@ -51,7 +53,7 @@ CopyToResult represents the result of calls to DOM.copyTo.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo
 */
 type CopyToResult struct {
-	// ID of the new cloned node.
+	// Id of the node clone.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -64,23 +66,22 @@ DescribeNodeParams represents DOM.describeNode parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
 */
 type DescribeNodeParams struct {
-	// Optional. ID of the node.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
-	// Optional. ID of the backend node.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. JavaScript object ID of the node wrapper.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
 
-	// Optional. The maximum depth at which children should be retrieved,
-	// defaults to 1. Use -1 for the entire subtree or provide an integer larger
-	// than 0.
-	Depth int64 `json:"depth,omitempty"`
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
 
-	// Optional. Whether or not iframes and shadow roots should be traversed
-	// when returning the subtree (default is false).
-	Pierce bool `json:"pierce,omitempty"`
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
 }
 
 /*
@@ -89,13 +90,47 @@ DescribeNodeResult represents the result of calls to DOM.describeNode.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
 */
 type DescribeNodeResult struct {
-	// ID of the new cloned node.
+	// Node description.
+	Node *Node `json:"node"`
+
+	// ID of the new cloned node. DEPRECATED.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+ScrollIntoViewIfNeededParams represents DOM.scrollIntoViewIfNeeded parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-scrollIntoViewIfNeeded
+*/
+type ScrollIntoViewIfNeededParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. The rect to be scrolled into view, relative to the node's border
+	// box, in CSS pixels. When omitted, center of the node will be used, similar
+	// to Element.scrollIntoView.
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+/*
+ScrollIntoViewIfNeededResult represents the result of calls to DOM.scrollIntoViewIfNeeded.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-scrollIntoViewIfNeeded
+*/
+type ScrollIntoViewIfNeededResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 DisableResult represents the result of calls to DOM.disable.
 
@@ -112,7 +147,10 @@ DiscardSearchResultsParams represents DOM.discardSearchResults parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-discardSearchResults
 */
 type DiscardSearchResultsParams struct {
-	// Node description.
+	// Unique search session identifier.
+	SearchID string `json:"searchId"`
+
+	// Node description. DEPRECATED.
 	Node *Node `json:"node"`
 }
 
@@ -126,6 +164,19 @@ type DiscardSearchResultsResult struct {
 	Err error `json:"-"`
 }
 
+/*
+EnableParams represents DOM.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Whether to include whitespaces in the children array of returned
+	// Nodes. EXPERIMENTAL. Allowed values:
+	//   - IncludeWhitespace.None
+	//   - IncludeWhitespace.All
+	IncludeWhitespace IncludeWhitespaceEnum `json:"includeWhitespace,omitempty"`
+}
+
 /*
 EnableResult represents the result of calls to DOM.enable.
 
@@ -142,14 +193,14 @@ FocusParams represents DOM.focus parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-focus
 */
 type FocusParams struct {
-	// Optional. ID of the node.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
-	// Optional. ID of the backend node.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. JavaScript object ID of the node wrapper.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
 }
 
 /*
@@ -168,7 +219,7 @@ GetAttributesParams represents DOM.getAttributes parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAttributes
 */
 type GetAttributesParams struct {
-	// ID  of the node to retrieve attibutes for.
+	// Id of the node to retrieve attributes for.
 	NodeID NodeID `json:"nodeId"`
 }
 
@@ -191,14 +242,14 @@ GetBoxModelParams represents DOM.getBoxModel parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getBoxModel
 */
 type GetBoxModelParams struct {
-	// Optional. ID of the node.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
-	// Optional. ID of the backend node.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. JavaScript object ID of the node wrapper.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
 }
 
 /*
@@ -214,20 +265,48 @@ type GetBoxModelResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetContentQuadsParams represents DOM.getContentQuads parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContentQuads
+*/
+type GetContentQuadsParams struct {
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+GetContentQuadsResult represents the result of calls to DOM.getContentQuads.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContentQuads
+*/
+type GetContentQuadsResult struct {
+	// Quads that describe node layout relative to viewport.
+	Quads []Quad `json:"quads"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetDocumentParams represents DOM.getDocument parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDocument
 */
 type GetDocumentParams struct {
-	// Optional. The maximum depth at which children should be retrieved,
-	// defaults to 1. Use -1 for the entire subtree or provide an integer larger
-	// than 0.
-	Depth int `json:"depth,omitempty"`
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
 
-	// Optional. Whether or not iframes and shadow roots should be traversed
-	// when returning the subtree (default is false).
-	Pierce bool `json:"pierce,omitempty"`
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
 }
 
 /*
@@ -249,14 +328,13 @@ GetFlattenedDocumentParams represents DOM.getFlattenedDocument parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedDocument
 */
 type GetFlattenedDocumentParams struct {
-	// Optional. The maximum depth at which children should be retrieved,
-	// defaults to 1. Use -1 for the entire subtree or provide an integer larger
-	// than 0.
-	Depth int `json:"depth,omitempty"`
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
 
-	// Optional. Whether or not iframes and shadow roots should be traversed
-	// when returning the subtree (default is false).
-	Pierce bool `json:"pierce,omitempty"`
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
 }
 
 /*
@@ -265,13 +343,43 @@ GetFlattenedDocumentResult represents the result of calls to DOM.getFlattenedDoc
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedDocument
 */
 type GetFlattenedDocumentResult struct {
-	// Resulting nodes.
+	// Resulting node.
 	Nodes []*Node `json:"nodes"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+GetNodesForSubtreeByStyleParams represents DOM.getNodesForSubtreeByStyle parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodesForSubtreeByStyle
+*/
+type GetNodesForSubtreeByStyleParams struct {
+	// Node ID pointing to the root of a subtree.
+	NodeID NodeID `json:"nodeId"`
+
+	// The style to filter nodes by (includes nodes if any of properties matches).
+	ComputedStyles []*CSSComputedStyleProperty `json:"computedStyles"`
+
+	// Optional. Whether or not iframes and shadow roots in the same target should
+	// be traversed when returning the results (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+/*
+GetNodesForSubtreeByStyleResult represents the result of calls to DOM.getNodesForSubtreeByStyle.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodesForSubtreeByStyle
+*/
+type GetNodesForSubtreeByStyleResult struct {
+	// Resulting nodes.
+	NodeIDs []NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetNodeForLocationParams represents DOM.getNodeForLocation parameters.
 
@@ -279,14 +387,18 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLoc
 */
 type GetNodeForLocationParams struct {
 	// X coordinate.
-	X int64 `json:"x"`
+	X int `json:"x"`
 
 	// Y coordinate.
-	Y int64 `json:"y"`
+	Y int `json:"y"`
+
+	// Optional. False to skip to the nearest non-UA shadow root ancestor (default:
+	// false).
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
 
-	// Optional. False to skip to the nearest non-UA shadow root ancestor
-	// (default: false).
-	IncludeUserAgentShadowDOM bool `json:"includeUserAgentShadowDOM,omitempty"`
+	// Optional. Whether to ignore pointer-events: none on elements and hit test
+	// them.
+	IgnorePointerEventsNone *bool `json:"ignorePointerEventsNone,omitempty"`
 }
 
 /*
@@ -295,8 +407,15 @@ GetNodeForLocationResult represents the result of calls to DOM.getNodeForLocatio
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLocation
 */
 type GetNodeForLocationResult struct {
-	// ID of the node at given coordinates.
-	NodeID NodeID `json:"nodeId"`
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+
+	// Frame this node belongs to.
+	FrameID page.FrameID `json:"frameId"`
+
+	// Optional. Id of the node at given coordinates, only when enabled and
+	// requested document.
+	NodeID NodeID `json:"nodeId,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -308,14 +427,18 @@ GetOuterHTMLParams represents DOM.getOuterHTML parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getOuterHTML
 */
 type GetOuterHTMLParams struct {
-	// Optional. ID of the node.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
-	// Optional. ID of the backend node.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. JavaScript object ID of the node wrapper.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+
+	// Optional. Include all shadow roots. Equals to false if not specified.
+	// EXPERIMENTAL.
+	IncludeShadowDOM *bool `json:"includeShadowDOM,omitempty"`
 }
 
 /*
@@ -337,7 +460,7 @@ GetRelayoutBoundaryParams represents DOM.getRelayoutBoundary parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBoundary
 */
 type GetRelayoutBoundaryParams struct {
-	// ID of the node.
+	// Id of the node.
 	NodeID NodeID `json:"nodeId"`
 }
 
@@ -347,7 +470,7 @@ GetRelayoutBoundaryResult represents the result of calls to DOM.getRelayoutBound
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBoundary
 */
 type GetRelayoutBoundaryResult struct {
-	// Relayout boundary node ID for the given node.
+	// Relayout boundary node id for the given node.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -364,10 +487,10 @@ type GetSearchResultsParams struct {
 	SearchID string `json:"searchId"`
 
 	// Start index of the search result to be returned.
-	FromIndex int64 `json:"fromIndex"`
+	FromIndex int `json:"fromIndex"`
 
 	// End index of the search result to be returned.
-	ToIndex int64 `json:"toIndex"`
+	ToIndex int `json:"toIndex"`
 }
 
 /*
@@ -376,13 +499,43 @@ GetSearchResultsResult represents the result of calls to DOM.getSearchResults.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getSearchResults
 */
 type GetSearchResultsResult struct {
-	// IDs of the search result nodes.
+	// Ids of the search result nodes.
 	NodeIDs []NodeID `json:"nodeIds"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+HideHighlightResult represents the result of calls to DOM.hideHighlight.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-hideHighlight
+*/
+type HideHighlightResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HighlightNodeResult represents the result of calls to DOM.highlightNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-highlightNode
+*/
+type HighlightNodeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+HighlightRectResult represents the result of calls to DOM.highlightRect.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-highlightRect
+*/
+type HighlightRectResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 MarkUndoableStateResult represents the result of calls to DOM.markUndoableState.
 
@@ -399,15 +552,15 @@ MoveToParams represents DOM.moveTo parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
 */
 type MoveToParams struct {
-	// ID of the node to move.
+	// Id of the node to move.
 	NodeID NodeID `json:"nodeId"`
 
-	// ID of the element to drop the moved node into.
+	// Id of the element to drop the moved node into.
 	TargetNodeID NodeID `json:"targetNodeId"`
 
-	// Optional. Drop node before this one (if absent, the moved node becomes
-	// the last child of targetNodeId).
-	InsertBeforeNodeID NodeID `json:"insertBeforeNodeId,omitempty"`
+	// Optional. Drop node before this one (if absent, the moved node becomes the
+	// last child of `targetNodeId`).
+	InsertBeforeNodeID *NodeID `json:"insertBeforeNodeId,omitempty"`
 }
 
 /*
@@ -416,7 +569,7 @@ MoveToResult represents the result of calls to DOM.moveTo.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
 */
 type MoveToResult struct {
-	// New ID of the moved node.
+	// New id of the moved node.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -433,7 +586,7 @@ type PerformSearchParams struct {
 	Query string `json:"query"`
 
 	// Optional. True to search in user agent shadow DOM.
-	IncludeUserAgentShadowDOM bool `json:"includeUserAgentShadowDOM,omitempty"`
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
 }
 
 /*
@@ -468,7 +621,7 @@ PushNodeByPathToFrontendResult represents the result of calls to DOM.pushNodeByP
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodeByPathToFrontend
 */
 type PushNodeByPathToFrontendResult struct {
-	// ID of the node for given path.
+	// Id of the node for given path.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -481,19 +634,18 @@ PushNodesByBackendIDsToFrontendParams represents DOM.pushNodesByBackendIdsToFron
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodesByBackendIdsToFrontend
 */
 type PushNodesByBackendIDsToFrontendParams struct {
-	// The array of backend node IDs.
+	// The array of backend node ids.
 	BackendNodeIDs []BackendNodeID `json:"backendNodeIds"`
 }
 
 /*
-PushNodesByBackendIDsToFrontendResult represents the result of calls to
-DOM.pushNodesByBackendIdsToFrontend.
+PushNodesByBackendIDsToFrontendResult represents the result of calls to DOM.pushNodesByBackendIdsToFrontend.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodesByBackendIdsToFrontend
 */
 type PushNodesByBackendIDsToFrontendResult struct {
-	// The array of IDs of pushed nodes that correspond to the backend IDs
-	// specified in backendNodeIDs.
+	// The array of ids of pushed nodes that correspond to the backend ids
+	// specified in backendNodeIds.
 	NodeIDs []NodeID `json:"nodeIds"`
 
 	// Error information related to executing this method
@@ -506,7 +658,7 @@ QuerySelectorParams represents DOM.querySelector parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 */
 type QuerySelectorParams struct {
-	// ID of the node to query.
+	// Id of the node to query upon.
 	NodeID NodeID `json:"nodeId"`
 
 	// Selector string.
@@ -532,7 +684,7 @@ QuerySelectorAllParams represents DOM.querySelectorAll parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelectorAll
 */
 type QuerySelectorAllParams struct {
-	// ID of the node to query upon.
+	// Id of the node to query upon.
 	NodeID NodeID `json:"nodeId"`
 
 	// Selector string.
@@ -552,6 +704,48 @@ type QuerySelectorAllResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetTopLayerElementsResult represents the result of calls to DOM.getTopLayerElements.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getTopLayerElements
+*/
+type GetTopLayerElementsResult struct {
+	// NodeIds of top layer elements
+	NodeIDs []NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetElementByRelationParams represents DOM.getElementByRelation parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getElementByRelation
+*/
+type GetElementByRelationParams struct {
+	// Id of the node from which to query the relation.
+	NodeID NodeID `json:"nodeId"`
+
+	// Type of relation to get. Allowed values:
+	//   - Relation.PopoverTarget
+	//   - Relation.InterestTarget
+	//   - Relation.CommandFor
+	Relation RelationEnum `json:"relation"`
+}
+
+/*
+GetElementByRelationResult represents the result of calls to DOM.getElementByRelation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getElementByRelation
+*/
+type GetElementByRelationResult struct {
+	// NodeId of the element matching the queried relation.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 RedoResult represents the result of calls to DOM.redo.
 
@@ -568,7 +762,7 @@ RemoveAttributeParams represents DOM.removeAttribute parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeAttribute
 */
 type RemoveAttributeParams struct {
-	// ID of the element to remove attribute from.
+	// Id of the element to remove attribute from.
 	NodeID NodeID `json:"nodeId"`
 
 	// Name of the attribute to remove.
@@ -591,7 +785,7 @@ RemoveNodeParams represents DOM.removeNode parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeNode
 */
 type RemoveNodeParams struct {
-	// ID of the node to remove.
+	// Id of the node to remove.
 	NodeID NodeID `json:"nodeId"`
 }
 
@@ -611,17 +805,16 @@ RequestChildNodesParams represents DOM.requestChildNodes parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestChildNodes
 */
 type RequestChildNodesParams struct {
-	// ID of the node to get children for.
+	// Id of the node to get children for.
 	NodeID NodeID `json:"nodeId"`
 
-	// Optional. The maximum depth at which children should be retrieved,
-	// defaults to 1. Use -1 for the entire subtree or provide an integer larger
-	// than 0.
-	Depth int64 `json:"depth,omitempty"`
+	// Optional. The maximum depth at which children should be retrieved, defaults
+	// to 1. Use -1 for the entire subtree or provide an integer larger than 0.
+	Depth *int `json:"depth,omitempty"`
 
-	// Optional. Whether or not iframes and shadow roots should be traversed
-	// when returning the sub-tree (default is false).
-	Pierce bool `json:"pierce,omitempty"`
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the sub-tree (default is false).
+	Pierce *bool `json:"pierce,omitempty"`
 }
 
 /*
@@ -640,7 +833,7 @@ RequestNodeParams represents DOM.requestNode parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
 */
 type RequestNodeParams struct {
-	// JavaScript object ID to convert into node.
+	// JavaScript object id to convert into node.
 	ObjectID runtime.RemoteObjectID `json:"objectId"`
 }
 
@@ -650,7 +843,7 @@ RequestNodeResult represents the result of calls to DOM.requestNode.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
 */
 type RequestNodeResult struct {
-	// Node ID for given object.
+	// Node id for given object.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -663,15 +856,17 @@ ResolveNodeParams represents DOM.resolveNode parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-resolveNode
 */
 type ResolveNodeParams struct {
-	// Optional. ID of the node to resolve.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Id of the node to resolve.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
 	// Optional. Backend identifier of the node to resolve.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. Symbolic group name that can be used to release multiple
-	// objects.
-	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Optional. Symbolic group name that can be used to release multiple objects.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// Optional. Execution context in which to resolve the node.
+	ExecutionContextID *runtime.ExecutionContextID `json:"executionContextId,omitempty"`
 }
 
 /*
@@ -693,7 +888,7 @@ SetAttributeValueParams represents DOM.setAttributeValue parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributeValue
 */
 type SetAttributeValueParams struct {
-	// ID of the element to set attribute for.
+	// Id of the element to set attribute for.
 	NodeID NodeID `json:"nodeId"`
 
 	// Attribute name.
@@ -719,15 +914,15 @@ SetAttributesAsTextParams represents DOM.setAttributesAsText parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributesAsText
 */
 type SetAttributesAsTextParams struct {
-	// ID of the element to set attributes for.
+	// Id of the element to set attributes for.
 	NodeID NodeID `json:"nodeId"`
 
 	// Text with a number of attributes. Will parse this text using HTML parser.
 	Text string `json:"text"`
 
-	// Optional. Attribute name to replace with new attributes derived from text
-	// in case text parsed successfully.
-	Name string `json:"name,omitempty"`
+	// Optional. Attribute name to replace with new attributes derived from text in
+	// case text parsed successfully.
+	Name *string `json:"name,omitempty"`
 }
 
 /*
@@ -749,14 +944,14 @@ type SetFileInputFilesParams struct {
 	// Array of file paths to set.
 	Files []string `json:"files"`
 
-	// Optional. ID of the node.
-	NodeID NodeID `json:"nodeId,omitempty"`
+	// Optional. Identifier of the node.
+	NodeID *NodeID `json:"nodeId,omitempty"`
 
-	// Optional. ID of the backend node.
-	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+	// Optional. Identifier of the backend node.
+	BackendNodeID *BackendNodeID `json:"backendNodeId,omitempty"`
 
-	// Optional. JavaScript object ID of the node wrapper.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. JavaScript object id of the node wrapper.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
 }
 
 /*
@@ -769,13 +964,91 @@ type SetFileInputFilesResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetNodeStackTracesEnabledParams represents DOM.setNodeStackTracesEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeStackTracesEnabled
+*/
+type SetNodeStackTracesEnabledParams struct {
+	// Enable or disable.
+	Enable bool `json:"enable"`
+}
+
+/*
+SetNodeStackTracesEnabledResult represents the result of calls to DOM.setNodeStackTracesEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeStackTracesEnabled
+*/
+type SetNodeStackTracesEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetNodeStackTracesParams represents DOM.getNodeStackTraces parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeStackTraces
+*/
+type GetNodeStackTracesParams struct {
+	// Id of the node to get stack traces for.
+	NodeID NodeID `json:"nodeId"`
+}
+
+/*
+GetNodeStackTracesResult represents the result of calls to DOM.getNodeStackTraces.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeStackTraces
+*/
+type GetNodeStackTracesResult struct {
+	// Optional. Creation stack trace, if available.
+	Creation *runtime.StackTrace `json:"creation,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetFileInfoParams represents DOM.getFileInfo parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFileInfo
+*/
+type GetFileInfoParams struct {
+	// JavaScript object id of the node wrapper.
+	ObjectID runtime.RemoteObjectID `json:"objectId"`
+}
+
+/*
+GetFileInfoResult represents the result of calls to DOM.getFileInfo.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFileInfo
+*/
+type GetFileInfoResult struct {
+	Path string `json:"path"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetDetachedDOMNodesResult represents the result of calls to DOM.getDetachedDomNodes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDetachedDomNodes
+*/
+type GetDetachedDOMNodesResult struct {
+	// The list of detached nodes
+	DetachedNodes []*DetachedElementInfo `json:"detachedNodes"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetInspectedNodeParams represents DOM.setInspectedNode parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedNode
 */
 type SetInspectedNodeParams struct {
-	// DOM node ID to be accessible by means of $x command line API.
+	// DOM node id to be accessible by means of $x command line API.
 	NodeID NodeID `json:"nodeId"`
 }
 
@@ -795,10 +1068,10 @@ SetNodeNameParams represents DOM.setNodeName parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
 */
 type SetNodeNameParams struct {
-	// ID of the node to set name for.
+	// Id of the node to set name for.
 	NodeID NodeID `json:"nodeId"`
 
-	// New node name.
+	// New node's name.
 	Name string `json:"name"`
 }
 
@@ -808,7 +1081,7 @@ SetNodeNameResult represents the result of calls to DOM.setNodeName.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
 */
 type SetNodeNameResult struct {
-	// New node's ID.
+	// New node's id.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to executing this method
@@ -821,10 +1094,10 @@ SetNodeValueParams represents DOM.setNodeValue parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeValue
 */
 type SetNodeValueParams struct {
-	// ID of the node to set value for.
+	// Id of the node to set value for.
 	NodeID NodeID `json:"nodeId"`
 
-	// New node value.
+	// New node's value.
 	Value string `json:"value"`
 }
 
@@ -844,7 +1117,7 @@ SetOuterHTMLParams represents DOM.setOuterHTML parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setOuterHTML
 */
 type SetOuterHTMLParams struct {
-	// ID of the node to set markup for.
+	// Id of the node to set markup for.
 	NodeID NodeID `json:"nodeId"`
 
 	// Outer HTML markup to set.
@@ -870,3 +1143,152 @@ type UndoResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+GetFrameOwnerParams represents DOM.getFrameOwner parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFrameOwner
+*/
+type GetFrameOwnerParams struct {
+	FrameID page.FrameID `json:"frameId"`
+}
+
+/*
+GetFrameOwnerResult represents the result of calls to DOM.getFrameOwner.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFrameOwner
+*/
+type GetFrameOwnerResult struct {
+	// Resulting node.
+	BackendNodeID BackendNodeID `json:"backendNodeId"`
+
+	// Optional. Id of the node at given coordinates, only when enabled and
+	// requested document.
+	NodeID NodeID `json:"nodeId,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetContainerForNodeParams represents DOM.getContainerForNode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContainerForNode
+*/
+type GetContainerForNodeParams struct {
+	NodeID NodeID `json:"nodeId"`
+
+	// Optional.
+	ContainerName *string `json:"containerName,omitempty"`
+
+	// Optional. Allowed values:
+	//   - PhysicalAxes.Horizontal
+	//   - PhysicalAxes.Vertical
+	//   - PhysicalAxes.Both
+	PhysicalAxes PhysicalAxesEnum `json:"physicalAxes,omitempty"`
+
+	// Optional. Allowed values:
+	//   - LogicalAxes.Inline
+	//   - LogicalAxes.Block
+	//   - LogicalAxes.Both
+	LogicalAxes LogicalAxesEnum `json:"logicalAxes,omitempty"`
+
+	// Optional.
+	QueriesScrollState *bool `json:"queriesScrollState,omitempty"`
+
+	// Optional.
+	QueriesAnchored *bool `json:"queriesAnchored,omitempty"`
+}
+
+/*
+GetContainerForNodeResult represents the result of calls to DOM.getContainerForNode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContainerForNode
+*/
+type GetContainerForNodeResult struct {
+	// Optional. The container node for the given node, or null if not found.
+	NodeID NodeID `json:"nodeId,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetQueryingDescendantsForContainerParams represents DOM.getQueryingDescendantsForContainer parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getQueryingDescendantsForContainer
+*/
+type GetQueryingDescendantsForContainerParams struct {
+	// Id of the container node to find querying descendants from.
+	NodeID NodeID `json:"nodeId"`
+}
+
+/*
+GetQueryingDescendantsForContainerResult represents the result of calls to DOM.getQueryingDescendantsForContainer.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getQueryingDescendantsForContainer
+*/
+type GetQueryingDescendantsForContainerResult struct {
+	// Descendant nodes with container queries against the given container.
+	NodeIDs []NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAnchorElementParams represents DOM.getAnchorElement parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAnchorElement
+*/
+type GetAnchorElementParams struct {
+	// Id of the positioned element from which to find the anchor.
+	NodeID NodeID `json:"nodeId"`
+
+	// Optional. An optional anchor specifier, as defined in
+	// https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier. If not
+	// provided, it will return the implicit anchor element for the given
+	// positioned element.
+	AnchorSpecifier *string `json:"anchorSpecifier,omitempty"`
+}
+
+/*
+GetAnchorElementResult represents the result of calls to DOM.getAnchorElement.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAnchorElement
+*/
+type GetAnchorElementResult struct {
+	// The anchor element of the given anchor query.
+	NodeID NodeID `json:"nodeId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ForceShowPopoverParams represents DOM.forceShowPopover parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-forceShowPopover
+*/
+type ForceShowPopoverParams struct {
+	// Id of the popover HTMLElement
+	NodeID NodeID `json:"nodeId"`
+
+	// If true, opens the popover and keeps it open. If false, closes the popover
+	// if it was previously force-opened.
+	Enable bool `json:"enable"`
+}
+
+/*
+ForceShowPopoverResult represents the result of calls to DOM.forceShowPopover.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-forceShowPopover
+*/
+type ForceShowPopoverResult struct {
+	// List of popovers that were closed in order to respect popover stacking
+	// order.
+	NodeIDs []NodeID `json:"nodeIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -1,6 +1,12 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package debugger provides type definitions for use with the Chrome DOMDebugger protocol
 
+DOM debugging allows setting breakpoints on particular DOM operations and
+events. JavaScript execution will stop on these operations as if there was a
+regular breakpoint set.
+
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/
 */
 package debugger
@@ -11,38 +17,42 @@ import (
 )
 
 /*
-DOMBreakpointType is the DOM breakpoint type.
+DOMBreakpointType represents the DOMDebugger.DOMBreakpointType type.
+
+DOM breakpoint type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#type-DOMBreakpointType
 */
 type DOMBreakpointType string
 
 /*
-EventListener is the object event listener.
+EventListener represents the DOMDebugger.EventListener type.
+
+Object event listener.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#type-EventListener
 */
 type EventListener struct {
-	// EventListener's type.
+	// `EventListener`'s type.
 	Type string `json:"type"`
 
-	// EventListener's useCapture.
+	// `EventListener`'s useCapture.
 	UseCapture bool `json:"useCapture"`
 
-	// EventListener's passive flag.
+	// `EventListener`'s passive flag.
 	Passive bool `json:"passive"`
 
-	// EventListener's once flag.
+	// `EventListener`'s once flag.
 	Once bool `json:"once"`
 
-	// Script ID of the handler code.
+	// Script id of the handler code.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// Line number in the script (0-based).
-	LineNumber int64 `json:"lineNumber"`
+	LineNumber int `json:"lineNumber"`
 
 	// Column number in the script (0-based).
-	ColumnNumber int64 `json:"columnNumber"`
+	ColumnNumber int `json:"columnNumber"`
 
 	// Optional. Event handler function value.
 	Handler *runtime.RemoteObject `json:"handler,omitempty"`
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package debugger
 
 import (
@@ -11,18 +13,18 @@ GetEventListenersParams represents DOMDebugger.getEventListeners parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-getEventListeners
 */
 type GetEventListenersParams struct {
-	// ID of the object to return listeners for.
+	// Identifier of the object to return listeners for.
 	ObjectID runtime.RemoteObjectID `json:"objectId"`
 
 	// Optional. The maximum depth at which Node children should be retrieved,
 	// defaults to 1. Use -1 for the entire subtree or provide an integer larger
 	// than 0.
-	Depth int64 `json:"depth,omitempty"`
+	Depth *int `json:"depth,omitempty"`
 
-	// Optional. Whether or not iframes and shadow roots should be traversed
-	// when returning the subtree (default is false). Reports listeners for all
-	// contexts if pierce is enabled.
-	Pierce bool `json:"pierce,omitempty"`
+	// Optional. Whether or not iframes and shadow roots should be traversed when
+	// returning the subtree (default is false). Reports listeners for all contexts
+	// if pierce is enabled.
+	Pierce *bool `json:"pierce,omitempty"`
 }
 
 /*
@@ -44,7 +46,7 @@ RemoveDOMBreakpointParams represents DOMDebugger.removeDOMBreakpoint parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeDOMBreakpoint
 */
 type RemoveDOMBreakpointParams struct {
-	// ID of the node to remove breakpoint from.
+	// Identifier of the node to remove breakpoint from.
 	NodeID dom.NodeID `json:"nodeId"`
 
 	// Type of the breakpoint to remove.
@@ -62,8 +64,7 @@ type RemoveDOMBreakpointResult struct {
 }
 
 /*
-RemoveEventListenerBreakpointParams represents DOMDebugger.removeEventListenerBreakpoint
-parameters.
+RemoveEventListenerBreakpointParams represents DOMDebugger.removeEventListenerBreakpoint parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeEventListenerBreakpoint
 */
@@ -71,13 +72,12 @@ type RemoveEventListenerBreakpointParams struct {
 	// Event name.
 	EventName string `json:"eventName"`
 
-	// Optional. EventTarget interface name. EXPERIMENTAL
-	TargetName string `json:"targetName,omitempty"`
+	// Optional. EventTarget interface name. EXPERIMENTAL.
+	TargetName *string `json:"targetName,omitempty"`
 }
 
 /*
-RemoveEventListenerBreakpointResult represents the result of calls to
-DOMDebugger.removeEventListenerBreakpoint.
+RemoveEventListenerBreakpointResult represents the result of calls to DOMDebugger.removeEventListenerBreakpoint.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeEventListenerBreakpoint
 */
@@ -87,8 +87,7 @@ type RemoveEventListenerBreakpointResult struct {
 }
 
 /*
-RemoveInstrumentationBreakpointParams represents DOMDebugger.removeInstrumentationBreakpoint
-parameters.
+RemoveInstrumentationBreakpointParams represents DOMDebugger.removeInstrumentationBreakpoint parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeInstrumentationBreakpoint
 */
@@ -98,8 +97,7 @@ type RemoveInstrumentationBreakpointParams struct {
 }
 
 /*
-RemoveInstrumentationBreakpointResult represents the result of calls to
-DOMDebugger.removeInstrumentationBreakpoint.
+RemoveInstrumentationBreakpointResult represents the result of calls to DOMDebugger.removeInstrumentationBreakpoint.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeInstrumentationBreakpoint
 */
@@ -128,13 +126,33 @@ type RemoveXHRBreakpointResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetBreakOnCSPViolationParams represents DOMDebugger.setBreakOnCSPViolation parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setBreakOnCSPViolation
+*/
+type SetBreakOnCSPViolationParams struct {
+	// CSP Violations to stop upon.
+	ViolationTypes []CSPViolationTypeEnum `json:"violationTypes"`
+}
+
+/*
+SetBreakOnCSPViolationResult represents the result of calls to DOMDebugger.setBreakOnCSPViolation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setBreakOnCSPViolation
+*/
+type SetBreakOnCSPViolationResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetDOMBreakpointParams represents DOMDebugger.setDOMBreakpoint parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setDOMBreakpoint
 */
 type SetDOMBreakpointParams struct {
-	// ID of the node to set breakpoint on.
+	// Identifier of the node to set breakpoint on.
 	NodeID dom.NodeID `json:"nodeId"`
 
 	// Type of the operation to stop upon.
@@ -152,8 +170,7 @@ type SetDOMBreakpointResult struct {
 }
 
 /*
-SetEventListenerBreakpointParams represents DOMDebugger.setEventListenerBreakpoint
-parameters.
+SetEventListenerBreakpointParams represents DOMDebugger.setEventListenerBreakpoint parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEventListenerBreakpoint
 */
@@ -161,14 +178,13 @@ type SetEventListenerBreakpointParams struct {
 	// DOM Event name to stop on (any DOM event will do).
 	EventName string `json:"eventName"`
 
-	// Optional. EventTarget interface name to stop on. If equal to "*" or not
-	// provided, will stop on any EventTarget. EXPERIMENTAL
-	TargetName string `json:"targetName,omitempty"`
+	// Optional. EventTarget interface name to stop on. If equal to `"*"` or not
+	// provided, will stop on any EventTarget. EXPERIMENTAL.
+	TargetName *string `json:"targetName,omitempty"`
 }
 
 /*
-SetEventListenerBreakpointResult represents the result of calls to
-DOMDebugger.setEventListenerBreakpoint.
+SetEventListenerBreakpointResult represents the result of calls to DOMDebugger.setEventListenerBreakpoint.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEventListenerBreakpoint
 */
@@ -178,8 +194,7 @@ type SetEventListenerBreakpointResult struct {
 }
 
 /*
-SetInstrumentationBreakpointParams represents DOMDebugger.setInstrumentationBreakpoint
-parameters.
+SetInstrumentationBreakpointParams represents DOMDebugger.setInstrumentationBreakpoint parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setInstrumentationBreakpoint
 */
@@ -189,8 +204,7 @@ type SetInstrumentationBreakpointParams struct {
 }
 
 /*
-SetInstrumentationBreakpointResult represents the result of calls to
-DOMDebugger.setInstrumentationBreakpoint.
+SetInstrumentationBreakpointResult represents the result of calls to DOMDebugger.setInstrumentationBreakpoint.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setInstrumentationBreakpoint
 */
@@ -205,8 +219,8 @@ SetXHRBreakpointParams represents DOMDebugger.setXHRBreakpoint parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setXHRBreakpoint
 */
 type SetXHRBreakpointParams struct {
-	// Resource URL substring. All XHRs having this substring in the URL will
-	// get stopped upon.
+	// Resource URL substring. All XHRs having this substring in the URL will get
+	// stopped upon.
 	URL string `json:"url"`
 }
 
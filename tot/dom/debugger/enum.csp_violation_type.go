@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package debugger
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cspViolationTypeEnum struct {
+	TrustedtypeSinkViolation   CSPViolationTypeEnum
+	TrustedtypePolicyViolation CSPViolationTypeEnum
+}
+
+/*
+CSPViolationType provides named access to the CSPViolationTypeEnum values.
+*/
+var CSPViolationType = cspViolationTypeEnum{
+	TrustedtypeSinkViolation:   cspViolationTypeTrustedtypeSinkViolation,
+	TrustedtypePolicyViolation: cspViolationTypeTrustedtypePolicyViolation,
+}
+
+/*
+CSPViolationTypeEnum represents the DOMDebugger.CSPViolationType values.
+
+CSP Violation type.
+
+Allowed values:
+  - CSPViolationType.TrustedtypeSinkViolation   "trustedtype-sink-violation"
+  - CSPViolationType.TrustedtypePolicyViolation "trustedtype-policy-violation"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#type-CSPViolationType
+*/
+type CSPViolationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CSPViolationTypeEnum) String() string {
+	return _cspViolationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CSPViolationTypeEnum) Unknown() bool {
+	return !_cspViolationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CSPViolationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CSPViolationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cspViolationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cspViolationTypeTrustedtypeSinkViolation represents the "trustedtype-sink-violation" value.
+	cspViolationTypeTrustedtypeSinkViolation CSPViolationTypeEnum = iota + 1
+	// cspViolationTypeTrustedtypePolicyViolation represents the "trustedtype-policy-violation" value.
+	cspViolationTypeTrustedtypePolicyViolation
+)
+
+var _cspViolationTypeEnums = enums.New(map[CSPViolationTypeEnum]string{
+	CSPViolationTypeEnum(0):                    "",
+	cspViolationTypeTrustedtypeSinkViolation:   "trustedtype-sink-violation",
+	cspViolationTypeTrustedtypePolicyViolation: "trustedtype-policy-violation",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCompatibilityMode(t *testing.T) {
+	var enum CompatibilityModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CompatibilityModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CompatibilityMode.QuirksMode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"QuirksMode"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"QuirksMode"`, result)
+	}
+	json.Unmarshal([]byte(`"QuirksMode"`), &enum)
+	if CompatibilityMode.QuirksMode != enum {
+		t.Errorf("Expected %d, got %d", CompatibilityMode.QuirksMode, enum)
+	}
+
+	enum = CompatibilityMode.LimitedQuirksMode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LimitedQuirksMode"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LimitedQuirksMode"`, result)
+	}
+	json.Unmarshal([]byte(`"LimitedQuirksMode"`), &enum)
+	if CompatibilityMode.LimitedQuirksMode != enum {
+		t.Errorf("Expected %d, got %d", CompatibilityMode.LimitedQuirksMode, enum)
+	}
+
+	enum = CompatibilityMode.NoQuirksMode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NoQuirksMode"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NoQuirksMode"`, result)
+	}
+	json.Unmarshal([]byte(`"NoQuirksMode"`), &enum)
+	if CompatibilityMode.NoQuirksMode != enum {
+		t.Errorf("Expected %d, got %d", CompatibilityMode.NoQuirksMode, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type includeWhitespaceEnum struct {
+	None IncludeWhitespaceEnum
+	All  IncludeWhitespaceEnum
+}
+
+/*
+IncludeWhitespace provides named access to the IncludeWhitespaceEnum values.
+*/
+var IncludeWhitespace = includeWhitespaceEnum{
+	None: includeWhitespaceNone,
+	All:  includeWhitespaceAll,
+}
+
+/*
+IncludeWhitespaceEnum represents the DOM.enable.includeWhitespace values.
+
+Whether to include whitespaces in the children array of returned Nodes.
+
+Allowed values:
+  - IncludeWhitespace.None "none"
+  - IncludeWhitespace.All  "all"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
+*/
+type IncludeWhitespaceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum IncludeWhitespaceEnum) String() string {
+	return _includeWhitespaceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum IncludeWhitespaceEnum) Unknown() bool {
+	return !_includeWhitespaceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum IncludeWhitespaceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *IncludeWhitespaceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _includeWhitespaceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// includeWhitespaceNone represents the "none" value.
+	includeWhitespaceNone IncludeWhitespaceEnum = iota + 1
+	// includeWhitespaceAll represents the "all" value.
+	includeWhitespaceAll
+)
+
+var _includeWhitespaceEnums = enums.New(map[IncludeWhitespaceEnum]string{
+	IncludeWhitespaceEnum(0): "",
+	includeWhitespaceNone:    "none",
+	includeWhitespaceAll:     "all",
+})
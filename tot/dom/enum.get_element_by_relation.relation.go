@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type relationEnum struct {
+	PopoverTarget  RelationEnum
+	InterestTarget RelationEnum
+	CommandFor     RelationEnum
+}
+
+/*
+Relation provides named access to the RelationEnum values.
+*/
+var Relation = relationEnum{
+	PopoverTarget:  relationPopoverTarget,
+	InterestTarget: relationInterestTarget,
+	CommandFor:     relationCommandFor,
+}
+
+/*
+RelationEnum represents the DOM.getElementByRelation.relation values.
+
+Type of relation to get.
+
+Allowed values:
+  - Relation.PopoverTarget  "PopoverTarget"
+  - Relation.InterestTarget "InterestTarget"
+  - Relation.CommandFor     "CommandFor"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getElementByRelation
+*/
+type RelationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum RelationEnum) String() string {
+	return _relationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RelationEnum) Unknown() bool {
+	return !_relationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum RelationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *RelationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _relationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// relationPopoverTarget represents the "PopoverTarget" value.
+	relationPopoverTarget RelationEnum = iota + 1
+	// relationInterestTarget represents the "InterestTarget" value.
+	relationInterestTarget
+	// relationCommandFor represents the "CommandFor" value.
+	relationCommandFor
+)
+
+var _relationEnums = enums.New(map[RelationEnum]string{
+	RelationEnum(0):        "",
+	relationPopoverTarget:  "PopoverTarget",
+	relationInterestTarget: "InterestTarget",
+	relationCommandFor:     "CommandFor",
+})
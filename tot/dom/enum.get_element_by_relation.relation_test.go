@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRelation(t *testing.T) {
+	var enum RelationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RelationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Relation.PopoverTarget
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PopoverTarget"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PopoverTarget"`, result)
+	}
+	json.Unmarshal([]byte(`"PopoverTarget"`), &enum)
+	if Relation.PopoverTarget != enum {
+		t.Errorf("Expected %d, got %d", Relation.PopoverTarget, enum)
+	}
+
+	enum = Relation.InterestTarget
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InterestTarget"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InterestTarget"`, result)
+	}
+	json.Unmarshal([]byte(`"InterestTarget"`), &enum)
+	if Relation.InterestTarget != enum {
+		t.Errorf("Expected %d, got %d", Relation.InterestTarget, enum)
+	}
+
+	enum = Relation.CommandFor
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CommandFor"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CommandFor"`, result)
+	}
+	json.Unmarshal([]byte(`"CommandFor"`), &enum)
+	if Relation.CommandFor != enum {
+		t.Errorf("Expected %d, got %d", Relation.CommandFor, enum)
+	}
+}
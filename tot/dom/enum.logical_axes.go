@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type logicalAxesEnum struct {
+	Inline LogicalAxesEnum
+	Block  LogicalAxesEnum
+	Both   LogicalAxesEnum
+}
+
+/*
+LogicalAxes provides named access to the LogicalAxesEnum values.
+*/
+var LogicalAxes = logicalAxesEnum{
+	Inline: logicalAxesInline,
+	Block:  logicalAxesBlock,
+	Both:   logicalAxesBoth,
+}
+
+/*
+LogicalAxesEnum represents the DOM.LogicalAxes values.
+
+# ContainerSelector logical axes
+
+Allowed values:
+  - LogicalAxes.Inline "Inline"
+  - LogicalAxes.Block  "Block"
+  - LogicalAxes.Both   "Both"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#type-LogicalAxes
+*/
+type LogicalAxesEnum int
+
+/*
+String implements Stringer
+*/
+func (enum LogicalAxesEnum) String() string {
+	return _logicalAxesEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum LogicalAxesEnum) Unknown() bool {
+	return !_logicalAxesEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum LogicalAxesEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *LogicalAxesEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _logicalAxesEnums.Parse(val)
+	return nil
+}
+
+const (
+	// logicalAxesInline represents the "Inline" value.
+	logicalAxesInline LogicalAxesEnum = iota + 1
+	// logicalAxesBlock represents the "Block" value.
+	logicalAxesBlock
+	// logicalAxesBoth represents the "Both" value.
+	logicalAxesBoth
+)
+
+var _logicalAxesEnums = enums.New(map[LogicalAxesEnum]string{
+	LogicalAxesEnum(0): "",
+	logicalAxesInline:  "Inline",
+	logicalAxesBlock:   "Block",
+	logicalAxesBoth:    "Both",
+})
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type physicalAxesEnum struct {
+	Horizontal PhysicalAxesEnum
+	Vertical   PhysicalAxesEnum
+	Both       PhysicalAxesEnum
+}
+
+/*
+PhysicalAxes provides named access to the PhysicalAxesEnum values.
+*/
+var PhysicalAxes = physicalAxesEnum{
+	Horizontal: physicalAxesHorizontal,
+	Vertical:   physicalAxesVertical,
+	Both:       physicalAxesBoth,
+}
+
+/*
+PhysicalAxesEnum represents the DOM.PhysicalAxes values.
+
+# ContainerSelector physical axes
+
+Allowed values:
+  - PhysicalAxes.Horizontal "Horizontal"
+  - PhysicalAxes.Vertical   "Vertical"
+  - PhysicalAxes.Both       "Both"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#type-PhysicalAxes
+*/
+type PhysicalAxesEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PhysicalAxesEnum) String() string {
+	return _physicalAxesEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PhysicalAxesEnum) Unknown() bool {
+	return !_physicalAxesEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PhysicalAxesEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PhysicalAxesEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _physicalAxesEnums.Parse(val)
+	return nil
+}
+
+const (
+	// physicalAxesHorizontal represents the "Horizontal" value.
+	physicalAxesHorizontal PhysicalAxesEnum = iota + 1
+	// physicalAxesVertical represents the "Vertical" value.
+	physicalAxesVertical
+	// physicalAxesBoth represents the "Both" value.
+	physicalAxesBoth
+)
+
+var _physicalAxesEnums = enums.New(map[PhysicalAxesEnum]string{
+	PhysicalAxesEnum(0):    "",
+	physicalAxesHorizontal: "Horizontal",
+	physicalAxesVertical:   "Vertical",
+	physicalAxesBoth:       "Both",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPhysicalAxes(t *testing.T) {
+	var enum PhysicalAxesEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PhysicalAxesEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PhysicalAxes.Horizontal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Horizontal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Horizontal"`, result)
+	}
+	json.Unmarshal([]byte(`"Horizontal"`), &enum)
+	if PhysicalAxes.Horizontal != enum {
+		t.Errorf("Expected %d, got %d", PhysicalAxes.Horizontal, enum)
+	}
+
+	enum = PhysicalAxes.Vertical
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Vertical"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Vertical"`, result)
+	}
+	json.Unmarshal([]byte(`"Vertical"`), &enum)
+	if PhysicalAxes.Vertical != enum {
+		t.Errorf("Expected %d, got %d", PhysicalAxes.Vertical, enum)
+	}
+
+	enum = PhysicalAxes.Both
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Both"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Both"`, result)
+	}
+	json.Unmarshal([]byte(`"Both"`), &enum)
+	if PhysicalAxes.Both != enum {
+		t.Errorf("Expected %d, got %d", PhysicalAxes.Both, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package dom
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type scrollOrientationEnum struct {
+	Horizontal ScrollOrientationEnum
+	Vertical   ScrollOrientationEnum
+}
+
+/*
+ScrollOrientation provides named access to the ScrollOrientationEnum values.
+*/
+var ScrollOrientation = scrollOrientationEnum{
+	Horizontal: scrollOrientationHorizontal,
+	Vertical:   scrollOrientationVertical,
+}
+
+/*
+ScrollOrientationEnum represents the DOM.ScrollOrientation values.
+
+# Physical scroll orientation
+
+Allowed values:
+  - ScrollOrientation.Horizontal "horizontal"
+  - ScrollOrientation.Vertical   "vertical"
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#type-ScrollOrientation
+*/
+type ScrollOrientationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ScrollOrientationEnum) String() string {
+	return _scrollOrientationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ScrollOrientationEnum) Unknown() bool {
+	return !_scrollOrientationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ScrollOrientationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ScrollOrientationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _scrollOrientationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// scrollOrientationHorizontal represents the "horizontal" value.
+	scrollOrientationHorizontal ScrollOrientationEnum = iota + 1
+	// scrollOrientationVertical represents the "vertical" value.
+	scrollOrientationVertical
+)
+
+var _scrollOrientationEnums = enums.New(map[ScrollOrientationEnum]string{
+	ScrollOrientationEnum(0):    "",
+	scrollOrientationHorizontal: "horizontal",
+	scrollOrientationVertical:   "vertical",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package dom
 
 /*
@@ -6,7 +8,7 @@ AttributeModifiedEvent represents DOM.attributeModified event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeModified
 */
 type AttributeModifiedEvent struct {
-	// ID of the node that has changed.
+	// Id of the node that has changed.
 	NodeID NodeID `json:"nodeId"`
 
 	// Attribute name.
@@ -25,10 +27,10 @@ AttributeRemovedEvent represents DOM.attributeRemoved event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemoved
 */
 type AttributeRemovedEvent struct {
-	// ID of the node that has changed.
+	// Id of the node that has changed.
 	NodeID NodeID `json:"nodeId"`
 
-	// Attribute name.
+	// A ttribute name.
 	Name string `json:"name"`
 
 	// Error information related to this event
@@ -41,7 +43,7 @@ CharacterDataModifiedEvent represents DOM.characterDataModified event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataModified
 */
 type CharacterDataModifiedEvent struct {
-	// ID of the node that has changed.
+	// Id of the node that has changed.
 	NodeID NodeID `json:"nodeId"`
 
 	// New text value.
@@ -57,13 +59,19 @@ ChildNodeCountUpdatedEvent represents DOM.childNodeCountUpdated event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCountUpdated
 */
 type ChildNodeCountUpdatedEvent struct {
-	// ID of the node that has changed.
+	// Id of the node that has changed.
+	NodeID NodeID `json:"nodeId"`
+
+	// New node count.
+	ChildNodeCount int `json:"childNodeCount"`
+
+	// ID of the node that has changed. DEPRECATED.
 	ParentNodeID NodeID `json:"parentNodeId"`
 
-	// If of the previous siblint.
+	// If of the previous siblint. DEPRECATED.
 	PreviousNodeID NodeID `json:"previousNodeId"`
 
-	// Inserted node data.
+	// Inserted node data. DEPRECATED.
 	Node *Node `json:"node"`
 
 	// Error information related to this event
@@ -76,10 +84,10 @@ ChildNodeInsertedEvent represents DOM.childNodeInserted event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInserted
 */
 type ChildNodeInsertedEvent struct {
-	// ID of the node that has changed.
+	// Id of the node that has changed.
 	ParentNodeID NodeID `json:"parentNodeId"`
 
-	// If of the previous siblint.
+	// Id of the previous sibling.
 	PreviousNodeID NodeID `json:"previousNodeId"`
 
 	// Inserted node data.
@@ -95,10 +103,10 @@ ChildNodeRemovedEvent represents DOM.childNodeRemoved event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemoved
 */
 type ChildNodeRemovedEvent struct {
-	// Parent ID.
+	// Parent id.
 	ParentNodeID NodeID `json:"parentNodeId"`
 
-	// ID of the node that has been removed.
+	// Id of the node that has been removed.
 	NodeID NodeID `json:"nodeId"`
 
 	// Error information related to this event
@@ -137,7 +145,7 @@ InlineStyleInvalidatedEvent represents DOM.inlineStyleInvalidated event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInvalidated
 */
 type InlineStyleInvalidatedEvent struct {
-	// IDs of the nodes for which the inline styles have been invalidated.
+	// Ids of the nodes for which the inline styles have been invalidated.
 	NodeIDs []NodeID `json:"nodeIds"`
 
 	// Error information related to this event
@@ -150,7 +158,7 @@ PseudoElementAddedEvent represents DOM.pseudoElementAdded event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded
 */
 type PseudoElementAddedEvent struct {
-	// Pseudo element's parent element ID.
+	// Pseudo element's parent element id.
 	ParentID NodeID `json:"parentId"`
 
 	// The added pseudo element.
@@ -160,16 +168,42 @@ type PseudoElementAddedEvent struct {
 	Err error `json:"-"`
 }
 
+/*
+TopLayerElementsUpdatedEvent represents DOM.topLayerElementsUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-topLayerElementsUpdated
+*/
+type TopLayerElementsUpdatedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ScrollableFlagUpdatedEvent represents DOM.scrollableFlagUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-scrollableFlagUpdated
+*/
+type ScrollableFlagUpdatedEvent struct {
+	// The id of the node.
+	NodeID NodeID `json:"nodeId"`
+
+	// If the node is scrollable.
+	IsScrollable bool `json:"isScrollable"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 PseudoElementRemovedEvent represents DOM.pseudoElementRemoved event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved
 */
 type PseudoElementRemovedEvent struct {
-	// Pseudo element's parent element ID.
+	// Pseudo element's parent element id.
 	ParentID NodeID `json:"parentId"`
 
-	// The removed pseudo element ID.
+	// The removed pseudo element id.
 	PseudoElementID NodeID `json:"pseudoElementId"`
 
 	// Error information related to this event
@@ -182,7 +216,7 @@ SetChildNodesEvent represents DOM.setChildNodes event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
 */
 type SetChildNodesEvent struct {
-	// Parent node ID to populate with children.
+	// Parent node id to populate with children.
 	ParentID NodeID `json:"parentId"`
 
 	// Child nodes array.
@@ -198,10 +232,10 @@ ShadowRootPoppedEvent represents DOM.shadowRootPopped event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopped
 */
 type ShadowRootPoppedEvent struct {
-	// Host element ID.
+	// Host element id.
 	HostID NodeID `json:"hostId"`
 
-	// Shadow root ID.
+	// Shadow root id.
 	RootID NodeID `json:"rootId"`
 
 	// Error information related to this event
@@ -214,7 +248,7 @@ ShadowRootPushedEvent represents DOM.shadowRootPushed event data.
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPushed
 */
 type ShadowRootPushedEvent struct {
-	// Host element ID.
+	// Host element id.
 	HostID NodeID `json:"hostId"`
 
 	// Shadow root.
@@ -1,136 +1,170 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package snapshot provides type definitions for use with the Chrome DOMSnapshot protocol
 
+This domain facilitates obtaining document snapshots with DOM, layout, and style
+information. EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/
 */
 package snapshot
 
 import (
 	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/dom/debugger"
 	"github.com/mkenney/go-chrome/tot/page"
 )
 
 /*
-DOMNode is a Node in the DOM tree.
+DOMNode represents the DOMSnapshot.DOMNode type.
+
+A Node in the DOM tree.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-DOMNode
 */
 type DOMNode struct {
-	// Node's nodeType.
-	NodeType int64 `json:"nodeType"`
+	// `Node`'s nodeType.
+	NodeType int `json:"nodeType"`
 
-	// Node's nodeName.
+	// `Node`'s nodeName.
 	NodeName string `json:"nodeName"`
 
-	// Node's nodeValue.
+	// `Node`'s nodeValue.
 	NodeValue string `json:"nodeValue"`
 
 	// Optional. Only set for textarea elements, contains the text value.
 	TextValue string `json:"textValue,omitempty"`
 
-	// Optional. Only set for input elements, contains the input's associated
-	// text value.
+	// Optional. Only set for input elements, contains the input's associated text
+	// value.
 	InputValue string `json:"inputValue,omitempty"`
 
-	// Optional. Only set for radio and checkbox input elements, indicates if
-	// the element has been checked.
+	// Optional. Only set for radio and checkbox input elements, indicates if the
+	// element has been checked
 	InputChecked bool `json:"inputChecked,omitempty"`
 
 	// Optional. Only set for option elements, indicates if the element has been
-	// selected.
+	// selected
 	OptionSelected bool `json:"optionSelected,omitempty"`
 
-	// Optional. Node's ID, corresponds to DOM.Node.backendNodeID.
-	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	// `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId"`
 
-	// Optional. The indexes of the node's child nodes in the domNodes array
-	// returned by getSnapshot, if any.
-	ChildNodeIndexes []int64 `json:"childNodeIndexes,omitempty"`
+	// Optional. The indexes of the node's child nodes in the `domNodes` array
+	// returned by `getSnapshot`, if any.
+	ChildNodeIndexes []int `json:"childNodeIndexes,omitempty"`
 
-	// Optional. Attributes of an Element node.
+	// Optional. Attributes of an `Element` node.
 	Attributes []*NameValue `json:"attributes,omitempty"`
 
 	// Optional. Indexes of pseudo elements associated with this node in the
-	// domNodes array returned by getSnapshot, if any.
-	PseudoElementIndexes []int64 `json:"pseudoElementIndexes,omitempty"`
+	// `domNodes` array returned by `getSnapshot`, if any.
+	PseudoElementIndexes []int `json:"pseudoElementIndexes,omitempty"`
 
 	// Optional. The index of the node's related layout tree node in the
-	// layoutTreeNodes array returned by getSnapshot, if any.
-	LayoutNodeIndex int64 `json:"layoutNodeIndex,omitempty"`
+	// `layoutTreeNodes` array returned by `getSnapshot`, if any.
+	LayoutNodeIndex int `json:"layoutNodeIndex,omitempty"`
 
-	// Optional. Document URL that Document or FrameOwner node points to.
+	// Optional. Document URL that `Document` or `FrameOwner` node points to.
 	DocumentURL string `json:"documentURL,omitempty"`
 
-	// Optional. Base URL that Document or FrameOwner node uses for URL
+	// Optional. Base URL that `Document` or `FrameOwner` node uses for URL
 	// completion.
 	BaseURL string `json:"baseURL,omitempty"`
 
-	// Optional. Only set for documents, contains the document's content
-	// language.
+	// Optional. Only set for documents, contains the document's content language.
 	ContentLanguage string `json:"contentLanguage,omitempty"`
 
 	// Optional. Only set for documents, contains the document's character set
 	// encoding.
 	DocumentEncoding string `json:"documentEncoding,omitempty"`
 
-	// Optional. DocumentType node's publicId.
+	// Optional. `DocumentType` node's publicId.
 	PublicID string `json:"publicId,omitempty"`
 
-	// Optional. DocumentType node's systemId.
+	// Optional. `DocumentType` node's systemId.
 	SystemID string `json:"systemId,omitempty"`
 
-	// Optional. Frame ID for frame owner elements and also for the document
-	// node.
+	// Optional. Frame ID for frame owner elements and also for the document node.
 	FrameID page.FrameID `json:"frameId,omitempty"`
 
 	// Optional. The index of a frame owner element's content document in the
-	// domNodes array returned by getSnapshot, if any.
-	ContentDocumentIndex int64 `json:"contentDocumentIndex,omitempty"`
-
-	// Optional. Index of the imported document's node of a link element in the
-	// domNodes array returned by getSnapshot, if any.
-	ImportedDocumentIndex int64 `json:"importedDocumentIndex,omitempty"`
-
-	// Optional. Index of the content node of a template element in the domNodes
-	// array returned by getSnapshot.
-	TemplateContentIndex int64 `json:"templateContentIndex,omitempty"`
+	// `domNodes` array returned by `getSnapshot`, if any.
+	ContentDocumentIndex int `json:"contentDocumentIndex,omitempty"`
 
 	// Optional. Type of a pseudo element node.
 	PseudoType dom.PseudoType `json:"pseudoType,omitempty"`
 
+	// Optional. Shadow root type.
+	ShadowRootType dom.ShadowRootType `json:"shadowRootType,omitempty"`
+
 	// Optional. Whether this DOM node responds to mouse clicks. This includes
-	// nodes that have had click event listeners attached via JavaScript as well
-	// as anchor tags that naturally navigate when clicked.
+	// nodes that have had click event listeners attached via JavaScript as well as
+	// anchor tags that naturally navigate when clicked.
 	IsClickable bool `json:"isClickable,omitempty"`
+
+	// Optional. Details of the node's event listeners, if any.
+	EventListeners []*debugger.EventListener `json:"eventListeners,omitempty"`
+
+	// Optional. The selected url for nodes with a srcset attribute.
+	CurrentSourceURL string `json:"currentSourceURL,omitempty"`
+
+	// Optional. The url of the script (if any) that generates this node.
+	OriginURL string `json:"originURL,omitempty"`
+
+	// Optional. Scroll offsets, set when this node is a Document.
+	ScrollOffsetX float64 `json:"scrollOffsetX,omitempty"`
+
+	// Optional.
+	ScrollOffsetY float64 `json:"scrollOffsetY,omitempty"`
+
+	// Optional. Index of the imported document's node of a link element in the
+	// domNodes array returned by getSnapshot, if any. DEPRECATED.
+	ImportedDocumentIndex int `json:"importedDocumentIndex,omitempty"`
+
+	// Optional. Index of the content node of a template element in the domNodes
+	// array returned by getSnapshot. DEPRECATED.
+	TemplateContentIndex int `json:"templateContentIndex,omitempty"`
 }
 
 /*
-InlineTextBox contains details of post layout rendered text positions. The exact layout should not
-be regarded as stable and may change between versions.
+InlineTextBox represents the DOMSnapshot.InlineTextBox type.
+
+Details of post layout rendered text positions. The exact layout should not be
+regarded as stable and may change between versions.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-InlineTextBox
 */
 type InlineTextBox struct {
-	// The absolute position bounding box.
+	// The bounding box in document coordinates. Note that scroll offset of the
+	// document is ignored.
 	BoundingBox *dom.Rect `json:"boundingBox"`
 
 	// The starting index in characters, for this post layout textbox substring.
-	StartCharacterIndex int64 `json:"startCharacterIndex"`
+	// Characters that would be represented as a surrogate pair in UTF-16 have
+	// length 2.
+	StartCharacterIndex int `json:"startCharacterIndex"`
 
-	// The number of characters in this post layout textbox substring.
-	NumCharacters int64 `json:"numCharacters"`
+	// The number of characters in this post layout textbox substring. Characters
+	// that would be represented as a surrogate pair in UTF-16 have length 2.
+	NumCharacters int `json:"numCharacters"`
 }
 
 /*
-LayoutTreeNode contains details of an element in the DOM tree with a LayoutObject.
+LayoutTreeNode represents the DOMSnapshot.LayoutTreeNode type.
+
+Details of an element in the DOM tree with a LayoutObject.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-LayoutTreeNode
 */
 type LayoutTreeNode struct {
-	// The index of the related DOM node in the domNodes array returned by getSnapshot.
-	DomNodeIndex int64 `json:"domNodeIndex"`
+	// The index of the related DOM node in the `domNodes` array returned by
+	// `getSnapshot`.
+	DomNodeIndex int `json:"domNodeIndex"`
 
-	// The absolute position bounding box.
+	// The bounding box in document coordinates. Note that scroll offset of the
+	// document is ignored.
 	BoundingBox *dom.Rect `json:"boundingBox"`
 
 	// Optional. Contents of the LayoutText, if any.
@@ -139,12 +173,22 @@ type LayoutTreeNode struct {
 	// Optional. The post-layout inline text nodes, if any.
 	InlineTextNodes []*InlineTextBox `json:"inlineTextNodes,omitempty"`
 
-	// Optional. Index into the computedStyles array returned by getSnapshot.
-	StyleIndex int64 `json:"styleIndex,omitempty"`
+	// Optional. Index into the `computedStyles` array returned by `getSnapshot`.
+	StyleIndex int `json:"styleIndex,omitempty"`
+
+	// Optional. Global paint order index, which is determined by the stacking
+	// order of the nodes. Nodes that are painted together will have the same
+	// index. Only provided if includePaintOrder in getSnapshot was true.
+	PaintOrder int `json:"paintOrder,omitempty"`
+
+	// Optional. Set to true to indicate the element begins a new stacking context.
+	IsStackingContext bool `json:"isStackingContext,omitempty"`
 }
 
 /*
-ComputedStyle is a subset of the full ComputedStyle as defined by the request whitelist.
+ComputedStyle represents the DOMSnapshot.ComputedStyle type.
+
+A subset of the full ComputedStyle as defined by the request whitelist.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-ComputedStyle
 */
@@ -154,7 +198,9 @@ type ComputedStyle struct {
 }
 
 /*
-NameValue is a name/value pair.
+NameValue represents the DOMSnapshot.NameValue type.
+
+A name/value pair.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-NameValue
 */
@@ -165,3 +211,257 @@ type NameValue struct {
 	// Attribute/property value.
 	Value string `json:"value"`
 }
+
+/*
+StringIndex represents the DOMSnapshot.StringIndex type.
+
+Index of the string in the strings table.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-StringIndex
+*/
+type StringIndex int
+
+/*
+ArrayOfStrings represents the DOMSnapshot.ArrayOfStrings type.
+
+Index of the string in the strings table.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-ArrayOfStrings
+*/
+type ArrayOfStrings []StringIndex
+
+/*
+RareStringData represents the DOMSnapshot.RareStringData type.
+
+Data that is only present on rare nodes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-RareStringData
+*/
+type RareStringData struct {
+	Index []int `json:"index"`
+
+	Value []StringIndex `json:"value"`
+}
+
+/*
+RareBooleanData represents the DOMSnapshot.RareBooleanData type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-RareBooleanData
+*/
+type RareBooleanData struct {
+	Index []int `json:"index"`
+}
+
+/*
+RareIntegerData represents the DOMSnapshot.RareIntegerData type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-RareIntegerData
+*/
+type RareIntegerData struct {
+	Index []int `json:"index"`
+
+	Value []int `json:"value"`
+}
+
+/*
+Rectangle represents the DOMSnapshot.Rectangle type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-Rectangle
+*/
+type Rectangle []float64
+
+/*
+DocumentSnapshot represents the DOMSnapshot.DocumentSnapshot type.
+
+Document snapshot.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-DocumentSnapshot
+*/
+type DocumentSnapshot struct {
+	// Document URL that `Document` or `FrameOwner` node points to.
+	DocumentURL StringIndex `json:"documentURL"`
+
+	// Document title.
+	Title StringIndex `json:"title"`
+
+	// Base URL that `Document` or `FrameOwner` node uses for URL completion.
+	BaseURL StringIndex `json:"baseURL"`
+
+	// Contains the document's content language.
+	ContentLanguage StringIndex `json:"contentLanguage"`
+
+	// Contains the document's character set encoding.
+	EncodingName StringIndex `json:"encodingName"`
+
+	// `DocumentType` node's publicId.
+	PublicID StringIndex `json:"publicId"`
+
+	// `DocumentType` node's systemId.
+	SystemID StringIndex `json:"systemId"`
+
+	// Frame ID for frame owner elements and also for the document node.
+	FrameID StringIndex `json:"frameId"`
+
+	// A table with dom nodes.
+	Nodes *NodeTreeSnapshot `json:"nodes"`
+
+	// The nodes in the layout tree.
+	Layout *LayoutTreeSnapshot `json:"layout"`
+
+	// The post-layout inline text nodes.
+	TextBoxes *TextBoxSnapshot `json:"textBoxes"`
+
+	// Optional. Horizontal scroll offset.
+	ScrollOffsetX float64 `json:"scrollOffsetX,omitempty"`
+
+	// Optional. Vertical scroll offset.
+	ScrollOffsetY float64 `json:"scrollOffsetY,omitempty"`
+
+	// Optional. Document content width.
+	ContentWidth float64 `json:"contentWidth,omitempty"`
+
+	// Optional. Document content height.
+	ContentHeight float64 `json:"contentHeight,omitempty"`
+}
+
+/*
+NodeTreeSnapshot represents the DOMSnapshot.NodeTreeSnapshot type.
+
+Table containing nodes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-NodeTreeSnapshot
+*/
+type NodeTreeSnapshot struct {
+	// Optional. Parent node index.
+	ParentIndex []int `json:"parentIndex,omitempty"`
+
+	// Optional. `Node`'s nodeType.
+	NodeType []int `json:"nodeType,omitempty"`
+
+	// Optional. Type of the shadow root the `Node` is in. String values are equal
+	// to the `ShadowRootType` enum.
+	ShadowRootType *RareStringData `json:"shadowRootType,omitempty"`
+
+	// Optional. `Node`'s nodeName.
+	NodeName []StringIndex `json:"nodeName,omitempty"`
+
+	// Optional. `Node`'s nodeValue.
+	NodeValue []StringIndex `json:"nodeValue,omitempty"`
+
+	// Optional. `Node`'s id, corresponds to DOM.Node.backendNodeId.
+	BackendNodeID []dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. Attributes of an `Element` node. Flatten name, value pairs.
+	Attributes []ArrayOfStrings `json:"attributes,omitempty"`
+
+	// Optional. Only set for textarea elements, contains the text value.
+	TextValue *RareStringData `json:"textValue,omitempty"`
+
+	// Optional. Only set for input elements, contains the input's associated text
+	// value.
+	InputValue *RareStringData `json:"inputValue,omitempty"`
+
+	// Optional. Only set for radio and checkbox input elements, indicates if the
+	// element has been checked
+	InputChecked *RareBooleanData `json:"inputChecked,omitempty"`
+
+	// Optional. Only set for option elements, indicates if the element has been
+	// selected
+	OptionSelected *RareBooleanData `json:"optionSelected,omitempty"`
+
+	// Optional. The index of the document in the list of the snapshot documents.
+	ContentDocumentIndex *RareIntegerData `json:"contentDocumentIndex,omitempty"`
+
+	// Optional. Type of a pseudo element node.
+	PseudoType *RareStringData `json:"pseudoType,omitempty"`
+
+	// Optional. Pseudo element identifier for this node. Only present if there is
+	// a valid pseudoType.
+	PseudoIdentifier *RareStringData `json:"pseudoIdentifier,omitempty"`
+
+	// Optional. Whether this DOM node responds to mouse clicks. This includes
+	// nodes that have had click event listeners attached via JavaScript as well as
+	// anchor tags that naturally navigate when clicked.
+	IsClickable *RareBooleanData `json:"isClickable,omitempty"`
+
+	// Optional. The selected url for nodes with a srcset attribute.
+	CurrentSourceURL *RareStringData `json:"currentSourceURL,omitempty"`
+
+	// Optional. The url of the script (if any) that generates this node.
+	OriginURL *RareStringData `json:"originURL,omitempty"`
+}
+
+/*
+LayoutTreeSnapshot represents the DOMSnapshot.LayoutTreeSnapshot type.
+
+Table of details of an element in the DOM tree with a LayoutObject.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-LayoutTreeSnapshot
+*/
+type LayoutTreeSnapshot struct {
+	// Index of the corresponding node in the `NodeTreeSnapshot` array returned by
+	// `captureSnapshot`.
+	NodeIndex []int `json:"nodeIndex"`
+
+	// Array of indexes specifying computed style strings, filtered according to
+	// the `computedStyles` parameter passed to `captureSnapshot`.
+	Styles []ArrayOfStrings `json:"styles"`
+
+	// The absolute position bounding box.
+	Bounds []Rectangle `json:"bounds"`
+
+	// Contents of the LayoutText, if any.
+	Text []StringIndex `json:"text"`
+
+	// Stacking context information.
+	StackingContexts *RareBooleanData `json:"stackingContexts"`
+
+	// Optional. Global paint order index, which is determined by the stacking
+	// order of the nodes. Nodes that are painted together will have the same
+	// index. Only provided if includePaintOrder in captureSnapshot was true.
+	PaintOrders []int `json:"paintOrders,omitempty"`
+
+	// Optional. The offset rect of nodes. Only available when includeDOMRects is
+	// set to true
+	OffsetRects []Rectangle `json:"offsetRects,omitempty"`
+
+	// Optional. The scroll rect of nodes. Only available when includeDOMRects is
+	// set to true
+	ScrollRects []Rectangle `json:"scrollRects,omitempty"`
+
+	// Optional. The client rect of nodes. Only available when includeDOMRects is
+	// set to true
+	ClientRects []Rectangle `json:"clientRects,omitempty"`
+
+	// Optional. The list of background colors that are blended with colors of
+	// overlapping elements. EXPERIMENTAL.
+	BlendedBackgroundColors []StringIndex `json:"blendedBackgroundColors,omitempty"`
+
+	// Optional. The list of computed text opacities. EXPERIMENTAL.
+	TextColorOpacities []float64 `json:"textColorOpacities,omitempty"`
+}
+
+/*
+TextBoxSnapshot represents the DOMSnapshot.TextBoxSnapshot type.
+
+Table of details of the post layout rendered text positions. The exact layout
+should not be regarded as stable and may change between versions.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#type-TextBoxSnapshot
+*/
+type TextBoxSnapshot struct {
+	// Index of the layout tree node that owns this box collection.
+	LayoutIndex []int `json:"layoutIndex"`
+
+	// The absolute position bounding box.
+	Bounds []Rectangle `json:"bounds"`
+
+	// The starting index in characters, for this post layout textbox substring.
+	// Characters that would be represented as a surrogate pair in UTF-16 have
+	// length 2.
+	Start []int `json:"start"`
+
+	// The number of characters in this post layout textbox substring. Characters
+	// that would be represented as a surrogate pair in UTF-16 have length 2.
+	Length []int `json:"length"`
+}
@@ -1,9 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package snapshot
 
 /*
 DisableResult represents the result of calls to DOMSnapshot.disable.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-disable
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-disable
 */
 type DisableResult struct {
 	// Error information related to executing this method
@@ -13,7 +15,7 @@ type DisableResult struct {
 /*
 EnableResult represents the result of calls to DOMSnapshot.enable.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot#method-enable
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-enable
 */
 type EnableResult struct {
 	// Error information related to executing this method
@@ -28,6 +30,17 @@ https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-getSn
 type GetParams struct {
 	// Whitelist of computed styles to return.
 	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+
+	// Optional. Whether or not to retrieve details of DOM listeners (default
+	// false).
+	IncludeEventListeners *bool `json:"includeEventListeners,omitempty"`
+
+	// Optional. Whether to determine and include the paint order index of
+	// LayoutTreeNodes (default false).
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+
+	// Optional. Whether to include UA shadow tree in the snapshot (default false).
+	IncludeUserAgentShadowTree *bool `json:"includeUserAgentShadowTree,omitempty"`
 }
 
 /*
@@ -49,3 +62,49 @@ type GetResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+CaptureSnapshotParams represents DOMSnapshot.captureSnapshot parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-captureSnapshot
+*/
+type CaptureSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyles []string `json:"computedStyles"`
+
+	// Optional. Whether to include layout object paint orders into the snapshot.
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+
+	// Optional. Whether to include DOM rectangles (offsetRects, clientRects,
+	// scrollRects) into the snapshot
+	IncludeDOMRects *bool `json:"includeDOMRects,omitempty"`
+
+	// Optional. Whether to include blended background colors in the snapshot
+	// (default: false). Blended background color is achieved by blending
+	// background colors of all elements that overlap with the current element.
+	// EXPERIMENTAL.
+	IncludeBlendedBackgroundColors *bool `json:"includeBlendedBackgroundColors,omitempty"`
+
+	// Optional. Whether to include text color opacity in the snapshot (default:
+	// false). An element might have the opacity property set that affects the text
+	// color of the element. The final text color opacity is computed based on the
+	// opacity of all overlapping elements. EXPERIMENTAL.
+	IncludeTextColorOpacities *bool `json:"includeTextColorOpacities,omitempty"`
+}
+
+/*
+CaptureSnapshotResult represents the result of calls to DOMSnapshot.captureSnapshot.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-captureSnapshot
+*/
+type CaptureSnapshotResult struct {
+	// The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root
+	// document.
+	Documents []*DocumentSnapshot `json:"documents"`
+
+	// Shared string table that all string properties refer to with indexes.
+	Strings []string `json:"strings"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
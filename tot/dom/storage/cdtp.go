@@ -1,26 +1,44 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package storage provides type definitions for use with the Chrome DOMStorage protocol
 
+Query and modify DOM storage. EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/
 */
 package storage
 
 /*
-ID is a DOM Storage identifier.
+SerializedStorageKey represents the DOMStorage.SerializedStorageKey type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#type-SerializedStorageKey
+*/
+type SerializedStorageKey string
+
+/*
+ID represents the DOMStorage.StorageId type.
+
+DOM Storage identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#type-StorageId
 */
 type ID struct {
-	// Security origin for the storage.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. Security origin for the storage.
+	SecurityOrigin string `json:"securityOrigin,omitempty"`
+
+	// Optional. Represents a key by which DOM Storage keys its CachedStorageAreas
+	StorageKey SerializedStorageKey `json:"storageKey,omitempty"`
 
 	// Whether the storage is local storage (not session storage).
 	IsLocalStorage bool `json:"isLocalStorage"`
 }
 
 /*
-Item is a DOM Storage item.
+Item represents the DOMStorage.Item type.
+
+DOM Storage item.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#type-Item
 */
-type Item []interface{}
+type Item []string
@@ -1,21 +1,70 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package emulation provides type definitions for use with the Chrome Emulation protocol
 
+This domain emulates different environments for the page.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/
 */
 package emulation
 
 /*
-ScreenOrientation represents a screen orientation.
+TimeSinceEpoch represents the Network.TimeSinceEpoch type. This is a duplicate
+of Network.TimeSinceEpoch to avoid an invalid import cycle.
+
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TimeSinceEpoch
+*/
+type TimeSinceEpoch float64
+
+/*
+SafeAreaInsets represents the Emulation.SafeAreaInsets type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SafeAreaInsets
+*/
+type SafeAreaInsets struct {
+	// Optional. Overrides safe-area-inset-top.
+	Top int `json:"top,omitempty"`
+
+	// Optional. Overrides safe-area-max-inset-top.
+	TopMax int `json:"topMax,omitempty"`
+
+	// Optional. Overrides safe-area-inset-left.
+	Left int `json:"left,omitempty"`
+
+	// Optional. Overrides safe-area-max-inset-left.
+	LeftMax int `json:"leftMax,omitempty"`
+
+	// Optional. Overrides safe-area-inset-bottom.
+	Bottom int `json:"bottom,omitempty"`
+
+	// Optional. Overrides safe-area-max-inset-bottom.
+	BottomMax int `json:"bottomMax,omitempty"`
+
+	// Optional. Overrides safe-area-inset-right.
+	Right int `json:"right,omitempty"`
+
+	// Optional. Overrides safe-area-max-inset-right.
+	RightMax int `json:"rightMax,omitempty"`
+}
+
+/*
+ScreenOrientation represents the Emulation.ScreenOrientation type.
+
+Screen orientation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-ScreenOrientation
 */
 type ScreenOrientation struct {
 	// Orientation type. Allowed values:
-	//	- OrientationType.PortraitPrimary
-	//	- OrientationType.PortraitSecondary
-	//	- OrientationType.LandscapePrimary
-	//	- OrientationType.LandscapeSecondary
+	//   - OrientationType.PortraitPrimary
+	//   - OrientationType.PortraitSecondary
+	//   - OrientationType.LandscapePrimary
+	//   - OrientationType.LandscapeSecondary
 	Type OrientationTypeEnum `json:"type"`
 
 	// Orientation angle.
@@ -23,14 +72,202 @@ type ScreenOrientation struct {
 }
 
 /*
-VirtualTimePolicy is the time policy
-	- advance: If the scheduler runs out of immediate work, the virtual time base may fast forward
-	  to allow the next delayed task (if any) to run
-	- pause: The virtual time base may not advance
-	- pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending
-	  resource fetches.
-EXPERIMENTAL
+DisplayFeature represents the Emulation.DisplayFeature type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-DisplayFeature
+*/
+type DisplayFeature struct {
+	// Orientation of a display feature in relation to screen Allowed values:
+	//   - Orientation.Vertical
+	//   - Orientation.Horizontal
+	Orientation OrientationEnum `json:"orientation"`
+
+	// The offset from the screen origin in either the x (for vertical orientation)
+	// or y (for horizontal orientation) direction.
+	Offset int `json:"offset"`
+
+	// A display feature may mask content such that it is not physically displayed
+	// - this length along with the offset describes this area. A display feature
+	// that only splits content will have a 0 mask_length.
+	MaskLength int `json:"maskLength"`
+}
+
+/*
+DevicePosture represents the Emulation.DevicePosture type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-DevicePosture
+*/
+type DevicePosture struct {
+	// Current posture of the device Allowed values:
+	//   - Type.Continuous
+	//   - Type.Folded
+	Type TypeEnum `json:"type"`
+}
+
+/*
+MediaFeature represents the Emulation.MediaFeature type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-MediaFeature
+*/
+type MediaFeature struct {
+	Name string `json:"name"`
+
+	Value string `json:"value"`
+}
+
+/*
+VirtualTimePolicy represents the Emulation.VirtualTimePolicy type.
+
+advance: If the scheduler runs out of immediate work, the virtual time base may
+fast forward to allow the next delayed task (if any) to run; pause: The virtual
+time base may not advance; pauseIfNetworkFetchesPending: The virtual time base
+may not advance if there are any pending resource fetches. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-VirtualTimePolicy
 */
 type VirtualTimePolicy string
+
+/*
+UserAgentBrandVersion represents the Emulation.UserAgentBrandVersion type.
+
+Used to specify User Agent Client Hints to emulate. See
+https://wicg.github.io/ua-client-hints EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-UserAgentBrandVersion
+*/
+type UserAgentBrandVersion struct {
+	Brand string `json:"brand"`
+
+	Version string `json:"version"`
+}
+
+/*
+UserAgentMetadata represents the Emulation.UserAgentMetadata type.
+
+Used to specify User Agent Client Hints to emulate. See
+https://wicg.github.io/ua-client-hints Missing optional values will be filled in
+by the target with what it would normally use. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-UserAgentMetadata
+*/
+type UserAgentMetadata struct {
+	// Optional. Brands appearing in Sec-CH-UA.
+	Brands []*UserAgentBrandVersion `json:"brands,omitempty"`
+
+	// Optional. Brands appearing in Sec-CH-UA-Full-Version-List.
+	FullVersionList []*UserAgentBrandVersion `json:"fullVersionList,omitempty"`
+
+	// Optional. DEPRECATED.
+	FullVersion string `json:"fullVersion,omitempty"`
+
+	Platform string `json:"platform"`
+
+	PlatformVersion string `json:"platformVersion"`
+
+	Architecture string `json:"architecture"`
+
+	Model string `json:"model"`
+
+	Mobile bool `json:"mobile"`
+
+	// Optional.
+	Bitness string `json:"bitness,omitempty"`
+
+	// Optional.
+	Wow64 bool `json:"wow64,omitempty"`
+
+	// Optional. Used to specify User Agent form-factor values. See
+	// https://wicg.github.io/ua-client-hints/#sec-ch-ua-form-factors
+	FormFactors []string `json:"formFactors,omitempty"`
+}
+
+/*
+SensorMetadata represents the Emulation.SensorMetadata type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SensorMetadata
+*/
+type SensorMetadata struct {
+	// Optional.
+	Available bool `json:"available,omitempty"`
+
+	// Optional.
+	MinimumFrequency float64 `json:"minimumFrequency,omitempty"`
+
+	// Optional.
+	MaximumFrequency float64 `json:"maximumFrequency,omitempty"`
+}
+
+/*
+SensorReadingSingle represents the Emulation.SensorReadingSingle type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SensorReadingSingle
+*/
+type SensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+/*
+SensorReadingXYZ represents the Emulation.SensorReadingXYZ type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SensorReadingXYZ
+*/
+type SensorReadingXYZ struct {
+	X float64 `json:"x"`
+
+	Y float64 `json:"y"`
+
+	Z float64 `json:"z"`
+}
+
+/*
+SensorReadingQuaternion represents the Emulation.SensorReadingQuaternion type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SensorReadingQuaternion
+*/
+type SensorReadingQuaternion struct {
+	X float64 `json:"x"`
+
+	Y float64 `json:"y"`
+
+	Z float64 `json:"z"`
+
+	W float64 `json:"w"`
+}
+
+/*
+SensorReading represents the Emulation.SensorReading type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-SensorReading
+*/
+type SensorReading struct {
+	// Optional.
+	Single *SensorReadingSingle `json:"single,omitempty"`
+
+	// Optional.
+	Xyz *SensorReadingXYZ `json:"xyz,omitempty"`
+
+	// Optional.
+	Quaternion *SensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+/*
+PressureMetadata represents the Emulation.PressureMetadata type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-PressureMetadata
+*/
+type PressureMetadata struct {
+	// Optional.
+	Available bool `json:"available,omitempty"`
+}
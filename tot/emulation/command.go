@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package emulation
 
 import (
@@ -20,8 +22,7 @@ type CanEmulateResult struct {
 }
 
 /*
-ClearDeviceMetricsOverrideResult represents the result of calls to
-Emulation.clearDeviceMetricsOverride.
+ClearDeviceMetricsOverrideResult represents the result of calls to Emulation.clearDeviceMetricsOverride.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDeviceMetricsOverride
 */
@@ -50,14 +51,56 @@ type ResetPageScaleFactorResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetFocusEmulationEnabledParams represents Emulation.setFocusEmulationEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setFocusEmulationEnabled
+*/
+type SetFocusEmulationEnabledParams struct {
+	// Whether to enable to disable focus emulation.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetFocusEmulationEnabledResult represents the result of calls to Emulation.setFocusEmulationEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setFocusEmulationEnabled
+*/
+type SetFocusEmulationEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAutoDarkModeOverrideParams represents Emulation.setAutoDarkModeOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutoDarkModeOverride
+*/
+type SetAutoDarkModeOverrideParams struct {
+	// Optional. Whether to enable or disable automatic dark mode. If not
+	// specified, any existing override will be cleared.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+/*
+SetAutoDarkModeOverrideResult represents the result of calls to Emulation.setAutoDarkModeOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutoDarkModeOverride
+*/
+type SetAutoDarkModeOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetCPUThrottlingRateParams represents Emulation.setCPUThrottlingRate parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setCPUThrottlingRate
 */
 type SetCPUThrottlingRateParams struct {
-	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
-	Rate int64 `json:"rate"`
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown,
+	// etc).
+	Rate float64 `json:"rate"`
 }
 
 /*
@@ -71,8 +114,7 @@ type SetCPUThrottlingRateResult struct {
 }
 
 /*
-SetDefaultBackgroundColorOverrideParams represents Emulation.setDefaultBackgroundColorOverride
-parameters.
+SetDefaultBackgroundColorOverrideParams represents Emulation.setDefaultBackgroundColorOverride parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefaultBackgroundColorOverride
 */
@@ -83,8 +125,7 @@ type SetDefaultBackgroundColorOverrideParams struct {
 }
 
 /*
-SetDefaultBackgroundColorOverrideResult represents the result of calls to
-Emulation.setDefaultBackgroundColorOverride.
+SetDefaultBackgroundColorOverrideResult represents the result of calls to Emulation.setDefaultBackgroundColorOverride.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefaultBackgroundColorOverride
 */
@@ -93,49 +134,68 @@ type SetDefaultBackgroundColorOverrideResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetSafeAreaInsetsOverrideParams represents Emulation.setSafeAreaInsetsOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSafeAreaInsetsOverride
+*/
+type SetSafeAreaInsetsOverrideParams struct {
+	Insets *SafeAreaInsets `json:"insets"`
+}
+
+/*
+SetSafeAreaInsetsOverrideResult represents the result of calls to Emulation.setSafeAreaInsetsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSafeAreaInsetsOverride
+*/
+type SetSafeAreaInsetsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetDeviceMetricsOverrideParams represents Emulation.setDeviceMetricsOverride parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDeviceMetricsOverride
 */
 type SetDeviceMetricsOverrideParams struct {
-	// Overriding width value in pixels (minimum 0, maximum 10000000). 0
-	// disables the override.
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
 	Width int `json:"width"`
 
-	// Overriding height value in pixels (minimum 0, maximum 10000000). 0
-	// disables the override.
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
 	Height int `json:"height"`
 
 	// Overriding device scale factor value. 0 disables the override.
 	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
 
-	// Whether to emulate mobile device. This includes viewport meta tag,
-	// overlay scrollbars, text autosizing and more.
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay
+	// scrollbars, text autosizing and more.
 	Mobile bool `json:"mobile"`
 
-	// Optional. Scale to apply to resulting view image. EXPERIMENTAL
-	Scale int `json:"scale,omitempty"`
+	// Optional. Scale to apply to resulting view image. EXPERIMENTAL.
+	Scale *float64 `json:"scale,omitempty"`
 
 	// Optional. Overriding screen width value in pixels (minimum 0, maximum
 	// 10000000). EXPERIMENTAL.
-	ScreenWidth int `json:"screenWidth,omitempty"`
+	ScreenWidth *int `json:"screenWidth,omitempty"`
 
 	// Optional. Overriding screen height value in pixels (minimum 0, maximum
 	// 10000000). EXPERIMENTAL.
-	ScreenHeight int `json:"screenHeight,omitempty"`
+	ScreenHeight *int `json:"screenHeight,omitempty"`
 
-	// Optional. Overriding view X position on screen in pixels (minimum 0,
-	// maximum 10000000). EXPERIMENTAL.
-	PositionX int `json:"positionX,omitempty"`
+	// Optional. Overriding view X position on screen in pixels (minimum 0, maximum
+	// 10000000). EXPERIMENTAL.
+	PositionX *int `json:"positionX,omitempty"`
 
-	// Optional. Overriding view Y position on screen in pixels (minimum 0,
-	// maximum 10000000). EXPERIMENTAL.
-	PositionY int `json:"positionY,omitempty"`
+	// Optional. Overriding view Y position on screen in pixels (minimum 0, maximum
+	// 10000000). EXPERIMENTAL.
+	PositionY *int `json:"positionY,omitempty"`
 
 	// Optional. Do not set visible view size, rely upon explicit setVisibleSize
 	// call. EXPERIMENTAL.
-	DontSetVisibleSize bool `json:"dontSetVisibleSize,omitempty"`
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
 
 	// Optional. Screen orientation override.
 	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
@@ -144,11 +204,20 @@ type SetDeviceMetricsOverrideParams struct {
 	// viewport. This viewport change is not observed by the page, e.g.
 	// viewport-relative elements do not change positions. EXPERIMENTAL.
 	Viewport *page.Viewport `json:"viewport,omitempty"`
+
+	// Optional. If set, the display feature of a multi-segment screen. If not set,
+	// multi-segment support is turned-off. Deprecated, use
+	// Emulation.setDisplayFeaturesOverride. EXPERIMENTAL. DEPRECATED.
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+
+	// Optional. If set, the posture of a foldable device. If not set the posture
+	// is set to continuous. Deprecated, use Emulation.setDevicePostureOverride.
+	// EXPERIMENTAL. DEPRECATED.
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
 }
 
 /*
-SetDeviceMetricsOverrideResult represents the result of calls to
-Emulation.setDeviceMetricsOverride.
+SetDeviceMetricsOverrideResult represents the result of calls to Emulation.setDeviceMetricsOverride.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDeviceMetricsOverride
 */
@@ -157,6 +226,104 @@ type SetDeviceMetricsOverrideResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetDevicePostureOverrideParams represents Emulation.setDevicePostureOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDevicePostureOverride
+*/
+type SetDevicePostureOverrideParams struct {
+	Posture *DevicePosture `json:"posture"`
+}
+
+/*
+SetDevicePostureOverrideResult represents the result of calls to Emulation.setDevicePostureOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDevicePostureOverride
+*/
+type SetDevicePostureOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearDevicePostureOverrideResult represents the result of calls to Emulation.clearDevicePostureOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDevicePostureOverride
+*/
+type ClearDevicePostureOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDisplayFeaturesOverrideParams represents Emulation.setDisplayFeaturesOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisplayFeaturesOverride
+*/
+type SetDisplayFeaturesOverrideParams struct {
+	Features []*DisplayFeature `json:"features"`
+}
+
+/*
+SetDisplayFeaturesOverrideResult represents the result of calls to Emulation.setDisplayFeaturesOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisplayFeaturesOverride
+*/
+type SetDisplayFeaturesOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearDisplayFeaturesOverrideResult represents the result of calls to Emulation.clearDisplayFeaturesOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDisplayFeaturesOverride
+*/
+type ClearDisplayFeaturesOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetScrollbarsHiddenParams represents Emulation.setScrollbarsHidden parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScrollbarsHidden
+*/
+type SetScrollbarsHiddenParams struct {
+	// Whether scrollbars should be always hidden.
+	Hidden bool `json:"hidden"`
+}
+
+/*
+SetScrollbarsHiddenResult represents the result of calls to Emulation.setScrollbarsHidden.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScrollbarsHidden
+*/
+type SetScrollbarsHiddenResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDocumentCookieDisabledParams represents Emulation.setDocumentCookieDisabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDocumentCookieDisabled
+*/
+type SetDocumentCookieDisabledParams struct {
+	// Whether document.coookie API should be disabled.
+	Disabled bool `json:"disabled"`
+}
+
+/*
+SetDocumentCookieDisabledResult represents the result of calls to Emulation.setDocumentCookieDisabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDocumentCookieDisabled
+*/
+type SetDocumentCookieDisabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetEmitTouchEventsForMouseParams represents Emulation.setEmitTouchEventsForMouse parameters.
 
@@ -166,16 +333,14 @@ type SetEmitTouchEventsForMouseParams struct {
 	// Whether touch emulation based on mouse input should be enabled.
 	Enabled bool `json:"enabled"`
 
-	// Optional. Touch/gesture events configuration. Default: current platform.
-	// Allowed values:
-	//	- mobile
-	//	- desktop
+	// Optional. Touch/gesture events configuration. Default: current platform. Allowed values:
+	//   - Configuration.Mobile
+	//   - Configuration.Desktop
 	Configuration ConfigurationEnum `json:"configuration,omitempty"`
 }
 
 /*
-SetEmitTouchEventsForMouseResult represents the result of calls to
-Emulation.setEmitTouchEventsForMouse.
+SetEmitTouchEventsForMouseResult represents the result of calls to Emulation.setEmitTouchEventsForMouse.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmitTouchEventsForMouse
 */
@@ -190,8 +355,11 @@ SetEmulatedMediaParams represents Emulation.setEmulatedMedia parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedMedia
 */
 type SetEmulatedMediaParams struct {
-	// Media type to emulate. Empty string disables the override.
-	Media string `json:"media"`
+	// Optional. Media type to emulate. Empty string disables the override.
+	Media *string `json:"media,omitempty"`
+
+	// Optional. Media features to emulate.
+	Features []*MediaFeature `json:"features,omitempty"`
 }
 
 /*
@@ -204,20 +372,81 @@ type SetEmulatedMediaResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetEmulatedVisionDeficiencyParams represents Emulation.setEmulatedVisionDeficiency parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedVisionDeficiency
+*/
+type SetEmulatedVisionDeficiencyParams struct {
+	// Vision deficiency to emulate. Order: best-effort emulations come first,
+	// followed by any physiologically accurate emulations for medically recognized
+	// color vision deficiencies. Allowed values:
+	//   - SetEmulatedVisionDeficiencyType.None
+	//   - SetEmulatedVisionDeficiencyType.BlurredVision
+	//   - SetEmulatedVisionDeficiencyType.ReducedContrast
+	//   - SetEmulatedVisionDeficiencyType.Achromatopsia
+	//   - SetEmulatedVisionDeficiencyType.Deuteranopia
+	//   - SetEmulatedVisionDeficiencyType.Protanopia
+	//   - SetEmulatedVisionDeficiencyType.Tritanopia
+	Type SetEmulatedVisionDeficiencyTypeEnum `json:"type"`
+}
+
+/*
+SetEmulatedVisionDeficiencyResult represents the result of calls to Emulation.setEmulatedVisionDeficiency.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedVisionDeficiency
+*/
+type SetEmulatedVisionDeficiencyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetEmulatedOSTextScaleParams represents Emulation.setEmulatedOSTextScale parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedOSTextScale
+*/
+type SetEmulatedOSTextScaleParams struct {
+	// Optional.
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+/*
+SetEmulatedOSTextScaleResult represents the result of calls to Emulation.setEmulatedOSTextScale.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedOSTextScale
+*/
+type SetEmulatedOSTextScaleResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetGeolocationOverrideParams represents Emulation.setGeolocationOverride parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setGeolocationOverride
 */
 type SetGeolocationOverrideParams struct {
-	// Optional. Mock latitude.
-	Latitude int `json:"latitude,omitempty"`
+	// Optional. Mock latitude
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Optional. Mock longitude
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Optional. Mock accuracy
+	Accuracy *float64 `json:"accuracy,omitempty"`
 
-	// Optional. Mock longitude.
-	Longitude int `json:"longitude,omitempty"`
+	// Optional. Mock altitude
+	Altitude *float64 `json:"altitude,omitempty"`
 
-	// Optional. Mock accuracy.
-	Accuracy int `json:"accuracy,omitempty"`
+	// Optional. Mock altitudeAccuracy
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"`
+
+	// Optional. Mock heading
+	Heading *float64 `json:"heading,omitempty"`
+
+	// Optional. Mock speed
+	Speed *float64 `json:"speed,omitempty"`
 }
 
 /*
@@ -230,6 +459,217 @@ type SetGeolocationOverrideResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetOverriddenSensorInformationParams represents Emulation.getOverriddenSensorInformation parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-getOverriddenSensorInformation
+*/
+type GetOverriddenSensorInformationParams struct {
+	// Allowed values:
+	//   - SensorType.AbsoluteOrientation
+	//   - SensorType.Accelerometer
+	//   - SensorType.AmbientLight
+	//   - SensorType.Gravity
+	//   - SensorType.Gyroscope
+	//   - SensorType.LinearAcceleration
+	//   - SensorType.Magnetometer
+	//   - SensorType.RelativeOrientation
+	Type SensorTypeEnum `json:"type"`
+}
+
+/*
+GetOverriddenSensorInformationResult represents the result of calls to Emulation.getOverriddenSensorInformation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-getOverriddenSensorInformation
+*/
+type GetOverriddenSensorInformationResult struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSensorOverrideEnabledParams represents Emulation.setSensorOverrideEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideEnabled
+*/
+type SetSensorOverrideEnabledParams struct {
+	Enabled bool `json:"enabled"`
+
+	// Allowed values:
+	//   - SensorType.AbsoluteOrientation
+	//   - SensorType.Accelerometer
+	//   - SensorType.AmbientLight
+	//   - SensorType.Gravity
+	//   - SensorType.Gyroscope
+	//   - SensorType.LinearAcceleration
+	//   - SensorType.Magnetometer
+	//   - SensorType.RelativeOrientation
+	Type SensorTypeEnum `json:"type"`
+
+	// Optional.
+	Metadata *SensorMetadata `json:"metadata,omitempty"`
+}
+
+/*
+SetSensorOverrideEnabledResult represents the result of calls to Emulation.setSensorOverrideEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideEnabled
+*/
+type SetSensorOverrideEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSensorOverrideReadingsParams represents Emulation.setSensorOverrideReadings parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideReadings
+*/
+type SetSensorOverrideReadingsParams struct {
+	// Allowed values:
+	//   - SensorType.AbsoluteOrientation
+	//   - SensorType.Accelerometer
+	//   - SensorType.AmbientLight
+	//   - SensorType.Gravity
+	//   - SensorType.Gyroscope
+	//   - SensorType.LinearAcceleration
+	//   - SensorType.Magnetometer
+	//   - SensorType.RelativeOrientation
+	Type SensorTypeEnum `json:"type"`
+
+	Reading *SensorReading `json:"reading"`
+}
+
+/*
+SetSensorOverrideReadingsResult represents the result of calls to Emulation.setSensorOverrideReadings.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideReadings
+*/
+type SetSensorOverrideReadingsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPressureSourceOverrideEnabledParams represents Emulation.setPressureSourceOverrideEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureSourceOverrideEnabled
+*/
+type SetPressureSourceOverrideEnabledParams struct {
+	Enabled bool `json:"enabled"`
+
+	// Allowed values:
+	//   - PressureSource.CPU
+	Source PressureSourceEnum `json:"source"`
+
+	// Optional.
+	Metadata *PressureMetadata `json:"metadata,omitempty"`
+}
+
+/*
+SetPressureSourceOverrideEnabledResult represents the result of calls to Emulation.setPressureSourceOverrideEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureSourceOverrideEnabled
+*/
+type SetPressureSourceOverrideEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPressureStateOverrideParams represents Emulation.setPressureStateOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureStateOverride
+*/
+type SetPressureStateOverrideParams struct {
+	// Allowed values:
+	//   - PressureSource.CPU
+	Source PressureSourceEnum `json:"source"`
+
+	// Allowed values:
+	//   - PressureState.Nominal
+	//   - PressureState.Fair
+	//   - PressureState.Serious
+	//   - PressureState.Critical
+	State PressureStateEnum `json:"state"`
+}
+
+/*
+SetPressureStateOverrideResult represents the result of calls to Emulation.setPressureStateOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureStateOverride
+*/
+type SetPressureStateOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPressureDataOverrideParams represents Emulation.setPressureDataOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureDataOverride
+*/
+type SetPressureDataOverrideParams struct {
+	// Allowed values:
+	//   - PressureSource.CPU
+	Source PressureSourceEnum `json:"source"`
+
+	// Allowed values:
+	//   - PressureState.Nominal
+	//   - PressureState.Fair
+	//   - PressureState.Serious
+	//   - PressureState.Critical
+	State PressureStateEnum `json:"state"`
+
+	// Optional.
+	OwnContributionEstimate *float64 `json:"ownContributionEstimate,omitempty"`
+}
+
+/*
+SetPressureDataOverrideResult represents the result of calls to Emulation.setPressureDataOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureDataOverride
+*/
+type SetPressureDataOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetIdleOverrideParams represents Emulation.setIdleOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setIdleOverride
+*/
+type SetIdleOverrideParams struct {
+	// Mock isUserActive
+	IsUserActive bool `json:"isUserActive"`
+
+	// Mock isScreenUnlocked
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+/*
+SetIdleOverrideResult represents the result of calls to Emulation.setIdleOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setIdleOverride
+*/
+type SetIdleOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearIdleOverrideResult represents the result of calls to Emulation.clearIdleOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearIdleOverride
+*/
+type ClearIdleOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetNavigatorOverridesParams represents Emulation.setNavigatorOverrides parameters.
 
@@ -257,7 +697,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPage
 */
 type SetPageScaleFactorParams struct {
 	// Page scale factor.
-	PageScaleFactor int `json:"pageScaleFactor"`
+	PageScaleFactor float64 `json:"pageScaleFactor"`
 }
 
 /*
@@ -281,8 +721,7 @@ type SetScriptExecutionDisabledParams struct {
 }
 
 /*
-SetScriptExecutionDisabledResult represents the result of calls to
-Emulation.setScriptExecutionDisabled.
+SetScriptExecutionDisabledResult represents the result of calls to Emulation.setScriptExecutionDisabled.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScriptExecutionDisabled
 */
@@ -301,12 +740,11 @@ type SetTouchEmulationEnabledParams struct {
 	Enabled bool `json:"enabled"`
 
 	// Optional. Maximum touch points supported. Defaults to one.
-	MaxTouchPoints int `json:"maxTouchPoints,omitempty"`
+	MaxTouchPoints *int `json:"maxTouchPoints,omitempty"`
 }
 
 /*
-SetTouchEmulationEnabledResult represents the result of calls to
-Emulation.setTouchEmulationEnabled.
+SetTouchEmulationEnabledResult represents the result of calls to Emulation.setTouchEmulationEnabled.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTouchEmulationEnabled
 */
@@ -321,16 +759,19 @@ SetVirtualTimePolicyParams represents Emulation.setVirtualTimePolicy parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVirtualTimePolicy
 */
 type SetVirtualTimePolicyParams struct {
-	// desc.
 	Policy VirtualTimePolicy `json:"policy"`
 
-	// Optional. If set, after this many virtual milliseconds have elapsed
-	// virtual time will be paused and a virtualTimeBudgetExpired event is sent.
-	Budget int `json:"budget,omitempty"`
+	// Optional. If set, after this many virtual milliseconds have elapsed virtual
+	// time will be paused and a virtualTimeBudgetExpired event is sent.
+	Budget *float64 `json:"budget,omitempty"`
+
+	// Optional. If set this specifies the maximum number of tasks that can be run
+	// before virtual is forced forwards to prevent deadlock.
+	MaxVirtualTimeTaskStarvationCount *int `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
 
-	// Optional. If set this specifies the maximum number of tasks that can be
-	// run before virtual is forced forwards to prevent deadlock.
-	MaxVirtualTimeTaskStarvationCount int `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	// Optional. If set, base::Time::Now will be overridden to initially return
+	// this value.
+	InitialVirtualTime *TimeSinceEpoch `json:"initialVirtualTime,omitempty"`
 }
 
 /*
@@ -339,14 +780,61 @@ SetVirtualTimePolicyResult represents the result of calls to Emulation.setVirtua
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVirtualTimePolicy
 */
 type SetVirtualTimePolicyResult struct {
+	// Absolute timestamp at which virtual time was first enabled (up time in
+	// milliseconds).
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+
 	// Absolute timestamp at which virtual time was first enabled (milliseconds
-	// since epoch).
+	// since epoch). DEPRECATED.
 	VirtualTimeBase runtime.Timestamp `json:"virtualTimeBase"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+SetLocaleOverrideParams represents Emulation.setLocaleOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setLocaleOverride
+*/
+type SetLocaleOverrideParams struct {
+	// Optional. ICU style C locale (e.g. "en_US"). If not specified or empty,
+	// disables the override and restores default host system locale.
+	Locale *string `json:"locale,omitempty"`
+}
+
+/*
+SetLocaleOverrideResult represents the result of calls to Emulation.setLocaleOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setLocaleOverride
+*/
+type SetLocaleOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetTimezoneOverrideParams represents Emulation.setTimezoneOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTimezoneOverride
+*/
+type SetTimezoneOverrideParams struct {
+	// The timezone identifier. List of supported timezones:
+	// https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	// If empty, disables the override and restores default host system timezone.
+	TimezoneID string `json:"timezoneId"`
+}
+
+/*
+SetTimezoneOverrideResult represents the result of calls to Emulation.setTimezoneOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTimezoneOverride
+*/
+type SetTimezoneOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetVisibleSizeParams represents Emulation.setVisibleSize parameters.
 
@@ -369,3 +857,134 @@ type SetVisibleSizeResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+SetDisabledImageTypesParams represents Emulation.setDisabledImageTypes parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisabledImageTypes
+*/
+type SetDisabledImageTypesParams struct {
+	// Image types to disable.
+	ImageTypes []DisabledImageTypeEnum `json:"imageTypes"`
+}
+
+/*
+SetDisabledImageTypesResult represents the result of calls to Emulation.setDisabledImageTypes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisabledImageTypes
+*/
+type SetDisabledImageTypesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDataSaverOverrideParams represents Emulation.setDataSaverOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDataSaverOverride
+*/
+type SetDataSaverOverrideParams struct {
+	// Optional. Override value. Omitting the parameter disables the override.
+	DataSaverEnabled *bool `json:"dataSaverEnabled,omitempty"`
+}
+
+/*
+SetDataSaverOverrideResult represents the result of calls to Emulation.setDataSaverOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDataSaverOverride
+*/
+type SetDataSaverOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetHardwareConcurrencyOverrideParams represents Emulation.setHardwareConcurrencyOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setHardwareConcurrencyOverride
+*/
+type SetHardwareConcurrencyOverrideParams struct {
+	// Hardware concurrency to report
+	HardwareConcurrency int `json:"hardwareConcurrency"`
+}
+
+/*
+SetHardwareConcurrencyOverrideResult represents the result of calls to Emulation.setHardwareConcurrencyOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setHardwareConcurrencyOverride
+*/
+type SetHardwareConcurrencyOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetUserAgentOverrideParams represents Emulation.setUserAgentOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+
+	// Optional. Browser language to emulate.
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"`
+
+	// Optional. The platform navigator.platform should return.
+	Platform *string `json:"platform,omitempty"`
+
+	// Optional. To be sent in Sec-CH-UA-* headers and returned in
+	// navigator.userAgentData EXPERIMENTAL.
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+/*
+SetUserAgentOverrideResult represents the result of calls to Emulation.setUserAgentOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setUserAgentOverride
+*/
+type SetUserAgentOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAutomationOverrideParams represents Emulation.setAutomationOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutomationOverride
+*/
+type SetAutomationOverrideParams struct {
+	// Whether the override should be enabled.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetAutomationOverrideResult represents the result of calls to Emulation.setAutomationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutomationOverride
+*/
+type SetAutomationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSmallViewportHeightDifferenceOverrideParams represents Emulation.setSmallViewportHeightDifferenceOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSmallViewportHeightDifferenceOverride
+*/
+type SetSmallViewportHeightDifferenceOverrideParams struct {
+	// This will cause an element of size 100svh to be `difference` pixels smaller
+	// than an element of size 100lvh.
+	Difference int `json:"difference"`
+}
+
+/*
+SetSmallViewportHeightDifferenceOverrideResult represents the result of calls to Emulation.setSmallViewportHeightDifferenceOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSmallViewportHeightDifferenceOverride
+*/
+type SetSmallViewportHeightDifferenceOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
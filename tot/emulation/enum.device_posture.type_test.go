@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumType(t *testing.T) {
+	var enum TypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Type.Continuous
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"continuous"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"continuous"`, result)
+	}
+	json.Unmarshal([]byte(`"continuous"`), &enum)
+	if Type.Continuous != enum {
+		t.Errorf("Expected %d, got %d", Type.Continuous, enum)
+	}
+
+	enum = Type.Folded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"folded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"folded"`, result)
+	}
+	json.Unmarshal([]byte(`"folded"`), &enum)
+	if Type.Folded != enum {
+		t.Errorf("Expected %d, got %d", Type.Folded, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type disabledImageTypeEnum struct {
+	Avif DisabledImageTypeEnum
+	Webp DisabledImageTypeEnum
+}
+
+/*
+DisabledImageType provides named access to the DisabledImageTypeEnum values.
+*/
+var DisabledImageType = disabledImageTypeEnum{
+	Avif: disabledImageTypeAvif,
+	Webp: disabledImageTypeWebp,
+}
+
+/*
+DisabledImageTypeEnum represents the Emulation.DisabledImageType values.
+
+Enum of image types that can be disabled.
+
+Allowed values:
+  - DisabledImageType.Avif "avif"
+  - DisabledImageType.Webp "webp"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-DisabledImageType
+*/
+type DisabledImageTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DisabledImageTypeEnum) String() string {
+	return _disabledImageTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DisabledImageTypeEnum) Unknown() bool {
+	return !_disabledImageTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DisabledImageTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DisabledImageTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _disabledImageTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// disabledImageTypeAvif represents the "avif" value.
+	disabledImageTypeAvif DisabledImageTypeEnum = iota + 1
+	// disabledImageTypeWebp represents the "webp" value.
+	disabledImageTypeWebp
+)
+
+var _disabledImageTypeEnums = enums.New(map[DisabledImageTypeEnum]string{
+	DisabledImageTypeEnum(0): "",
+	disabledImageTypeAvif:    "avif",
+	disabledImageTypeWebp:    "webp",
+})
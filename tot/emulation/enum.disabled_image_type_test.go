@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDisabledImageType(t *testing.T) {
+	var enum DisabledImageTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DisabledImageTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DisabledImageType.Avif
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"avif"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"avif"`, result)
+	}
+	json.Unmarshal([]byte(`"avif"`), &enum)
+	if DisabledImageType.Avif != enum {
+		t.Errorf("Expected %d, got %d", DisabledImageType.Avif, enum)
+	}
+
+	enum = DisabledImageType.Webp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"webp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webp"`, result)
+	}
+	json.Unmarshal([]byte(`"webp"`), &enum)
+	if DisabledImageType.Webp != enum {
+		t.Errorf("Expected %d, got %d", DisabledImageType.Webp, enum)
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumOrientation(t *testing.T) {
+	var enum OrientationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown OrientationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Orientation.Vertical
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"vertical"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"vertical"`, result)
+	}
+	json.Unmarshal([]byte(`"vertical"`), &enum)
+	if Orientation.Vertical != enum {
+		t.Errorf("Expected %d, got %d", Orientation.Vertical, enum)
+	}
+
+	enum = Orientation.Horizontal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"horizontal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"horizontal"`, result)
+	}
+	json.Unmarshal([]byte(`"horizontal"`), &enum)
+	if Orientation.Horizontal != enum {
+		t.Errorf("Expected %d, got %d", Orientation.Horizontal, enum)
+	}
+}
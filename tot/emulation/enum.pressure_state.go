@@ -0,0 +1,94 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type pressureStateEnum struct {
+	Nominal  PressureStateEnum
+	Fair     PressureStateEnum
+	Serious  PressureStateEnum
+	Critical PressureStateEnum
+}
+
+/*
+PressureState provides named access to the PressureStateEnum values.
+*/
+var PressureState = pressureStateEnum{
+	Nominal:  pressureStateNominal,
+	Fair:     pressureStateFair,
+	Serious:  pressureStateSerious,
+	Critical: pressureStateCritical,
+}
+
+/*
+PressureStateEnum represents the Emulation.PressureState values.
+
+Allowed values:
+  - PressureState.Nominal  "nominal"
+  - PressureState.Fair     "fair"
+  - PressureState.Serious  "serious"
+  - PressureState.Critical "critical"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-PressureState
+*/
+type PressureStateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PressureStateEnum) String() string {
+	return _pressureStateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PressureStateEnum) Unknown() bool {
+	return !_pressureStateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PressureStateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PressureStateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _pressureStateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// pressureStateNominal represents the "nominal" value.
+	pressureStateNominal PressureStateEnum = iota + 1
+	// pressureStateFair represents the "fair" value.
+	pressureStateFair
+	// pressureStateSerious represents the "serious" value.
+	pressureStateSerious
+	// pressureStateCritical represents the "critical" value.
+	pressureStateCritical
+)
+
+var _pressureStateEnums = enums.New(map[PressureStateEnum]string{
+	PressureStateEnum(0):  "",
+	pressureStateNominal:  "nominal",
+	pressureStateFair:     "fair",
+	pressureStateSerious:  "serious",
+	pressureStateCritical: "critical",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPressureState(t *testing.T) {
+	var enum PressureStateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PressureStateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PressureState.Nominal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"nominal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"nominal"`, result)
+	}
+	json.Unmarshal([]byte(`"nominal"`), &enum)
+	if PressureState.Nominal != enum {
+		t.Errorf("Expected %d, got %d", PressureState.Nominal, enum)
+	}
+
+	enum = PressureState.Fair
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fair"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fair"`, result)
+	}
+	json.Unmarshal([]byte(`"fair"`), &enum)
+	if PressureState.Fair != enum {
+		t.Errorf("Expected %d, got %d", PressureState.Fair, enum)
+	}
+
+	enum = PressureState.Serious
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"serious"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"serious"`, result)
+	}
+	json.Unmarshal([]byte(`"serious"`), &enum)
+	if PressureState.Serious != enum {
+		t.Errorf("Expected %d, got %d", PressureState.Serious, enum)
+	}
+
+	enum = PressureState.Critical
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"critical"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"critical"`, result)
+	}
+	json.Unmarshal([]byte(`"critical"`), &enum)
+	if PressureState.Critical != enum {
+		t.Errorf("Expected %d, got %d", PressureState.Critical, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package emulation
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type orientationTypeEnum struct {
@@ -13,7 +16,7 @@ type orientationTypeEnum struct {
 }
 
 /*
-OrientationType provides named acces to the OrientationTypeEnum values.
+OrientationType provides named access to the OrientationTypeEnum values.
 */
 var OrientationType = orientationTypeEnum{
 	PortraitPrimary:    orientationTypePortraitPrimary,
@@ -23,11 +26,15 @@ var OrientationType = orientationTypeEnum{
 }
 
 /*
-OrientationTypeEnum represents the orientation type. Allowed values:
-	- OrientationType.PortraitPrimary    "portraitPrimary"
-	- OrientationType.PortraitSecondary  "portraitSecondary"
-	- OrientationType.LandscapePrimary   "landscapePrimary"
-	- OrientationType.LandscapeSecondary "landscapeSecondary"
+OrientationTypeEnum represents the Emulation.ScreenOrientation.type values.
+
+Orientation type.
+
+Allowed values:
+  - OrientationType.PortraitPrimary    "portraitPrimary"
+  - OrientationType.PortraitSecondary  "portraitSecondary"
+  - OrientationType.LandscapePrimary   "landscapePrimary"
+  - OrientationType.LandscapeSecondary "landscapeSecondary"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-ScreenOrientation
 */
@@ -37,7 +44,16 @@ type OrientationTypeEnum int
 String implements Stringer
 */
 func (enum OrientationTypeEnum) String() string {
-	return _orientationTypeEnums[enum]
+	return _orientationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum OrientationTypeEnum) Unknown() bool {
+	return !_orientationTypeEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum OrientationTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *OrientationTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _orientationTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid orientationType value", bytes)
+	*enum = _orientationTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -80,9 +87,10 @@ const (
 	orientationTypeLandscapeSecondary
 )
 
-var _orientationTypeEnums = map[OrientationTypeEnum]string{
+var _orientationTypeEnums = enums.New(map[OrientationTypeEnum]string{
+	OrientationTypeEnum(0):            "",
 	orientationTypePortraitPrimary:    "portraitPrimary",
 	orientationTypePortraitSecondary:  "portraitSecondary",
 	orientationTypeLandscapePrimary:   "landscapePrimary",
 	orientationTypeLandscapeSecondary: "landscapeSecondary",
-}
+})
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package emulation
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type configurationEnum struct {
@@ -11,7 +14,7 @@ type configurationEnum struct {
 }
 
 /*
-Configuration provides named acces to the ConfigurationEnum values.
+Configuration provides named access to the ConfigurationEnum values.
 */
 var Configuration = configurationEnum{
 	Mobile:  configurationMobile,
@@ -19,12 +22,16 @@ var Configuration = configurationEnum{
 }
 
 /*
-ConfigurationEnum is optional. Touch/gesture events configuration. Default:
-current platform. Allowed values:
-	- Configuration.Mobile  "mobile"
-	- Configuration.Desktop "desktop"
+ConfigurationEnum represents the
+Emulation.setEmitTouchEventsForMouse.configuration values.
+
+Touch/gesture events configuration. Default: current platform.
+
+Allowed values:
+  - Configuration.Mobile  "mobile"
+  - Configuration.Desktop "desktop"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-ScreenOrientation
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmitTouchEventsForMouse
 */
 type ConfigurationEnum int
 
@@ -32,7 +39,16 @@ type ConfigurationEnum int
 String implements Stringer
 */
 func (enum ConfigurationEnum) String() string {
-	return _configurationEnums[enum]
+	return _configurationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ConfigurationEnum) Unknown() bool {
+	return !_configurationEnums.Known(enum)
 }
 
 /*
@@ -43,25 +59,16 @@ func (enum ConfigurationEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ConfigurationEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _configurationEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid configuration value", bytes)
+	*enum = _configurationEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -71,8 +78,8 @@ const (
 	configurationDesktop
 )
 
-var _configurationEnums = map[ConfigurationEnum]string{
+var _configurationEnums = enums.New(map[ConfigurationEnum]string{
 	ConfigurationEnum(0): "",
 	configurationMobile:  "mobile",
 	configurationDesktop: "desktop",
-}
+})
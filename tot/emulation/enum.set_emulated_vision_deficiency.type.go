@@ -0,0 +1,117 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package emulation
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type setEmulatedVisionDeficiencyTypeEnum struct {
+	None            SetEmulatedVisionDeficiencyTypeEnum
+	BlurredVision   SetEmulatedVisionDeficiencyTypeEnum
+	ReducedContrast SetEmulatedVisionDeficiencyTypeEnum
+	Achromatopsia   SetEmulatedVisionDeficiencyTypeEnum
+	Deuteranopia    SetEmulatedVisionDeficiencyTypeEnum
+	Protanopia      SetEmulatedVisionDeficiencyTypeEnum
+	Tritanopia      SetEmulatedVisionDeficiencyTypeEnum
+}
+
+/*
+SetEmulatedVisionDeficiencyType provides named access to the SetEmulatedVisionDeficiencyTypeEnum values.
+*/
+var SetEmulatedVisionDeficiencyType = setEmulatedVisionDeficiencyTypeEnum{
+	None:            setEmulatedVisionDeficiencyTypeNone,
+	BlurredVision:   setEmulatedVisionDeficiencyTypeBlurredVision,
+	ReducedContrast: setEmulatedVisionDeficiencyTypeReducedContrast,
+	Achromatopsia:   setEmulatedVisionDeficiencyTypeAchromatopsia,
+	Deuteranopia:    setEmulatedVisionDeficiencyTypeDeuteranopia,
+	Protanopia:      setEmulatedVisionDeficiencyTypeProtanopia,
+	Tritanopia:      setEmulatedVisionDeficiencyTypeTritanopia,
+}
+
+/*
+SetEmulatedVisionDeficiencyTypeEnum represents the
+Emulation.setEmulatedVisionDeficiency.type values.
+
+Vision deficiency to emulate. Order: best-effort emulations come first, followed
+by any physiologically accurate emulations for medically recognized color vision
+deficiencies.
+
+Allowed values:
+  - SetEmulatedVisionDeficiencyType.None            "none"
+  - SetEmulatedVisionDeficiencyType.BlurredVision   "blurredVision"
+  - SetEmulatedVisionDeficiencyType.ReducedContrast "reducedContrast"
+  - SetEmulatedVisionDeficiencyType.Achromatopsia   "achromatopsia"
+  - SetEmulatedVisionDeficiencyType.Deuteranopia    "deuteranopia"
+  - SetEmulatedVisionDeficiencyType.Protanopia      "protanopia"
+  - SetEmulatedVisionDeficiencyType.Tritanopia      "tritanopia"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedVisionDeficiency
+*/
+type SetEmulatedVisionDeficiencyTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SetEmulatedVisionDeficiencyTypeEnum) String() string {
+	return _setEmulatedVisionDeficiencyTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SetEmulatedVisionDeficiencyTypeEnum) Unknown() bool {
+	return !_setEmulatedVisionDeficiencyTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SetEmulatedVisionDeficiencyTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SetEmulatedVisionDeficiencyTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _setEmulatedVisionDeficiencyTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// setEmulatedVisionDeficiencyTypeNone represents the "none" value.
+	setEmulatedVisionDeficiencyTypeNone SetEmulatedVisionDeficiencyTypeEnum = iota + 1
+	// setEmulatedVisionDeficiencyTypeBlurredVision represents the "blurredVision" value.
+	setEmulatedVisionDeficiencyTypeBlurredVision
+	// setEmulatedVisionDeficiencyTypeReducedContrast represents the "reducedContrast" value.
+	setEmulatedVisionDeficiencyTypeReducedContrast
+	// setEmulatedVisionDeficiencyTypeAchromatopsia represents the "achromatopsia" value.
+	setEmulatedVisionDeficiencyTypeAchromatopsia
+	// setEmulatedVisionDeficiencyTypeDeuteranopia represents the "deuteranopia" value.
+	setEmulatedVisionDeficiencyTypeDeuteranopia
+	// setEmulatedVisionDeficiencyTypeProtanopia represents the "protanopia" value.
+	setEmulatedVisionDeficiencyTypeProtanopia
+	// setEmulatedVisionDeficiencyTypeTritanopia represents the "tritanopia" value.
+	setEmulatedVisionDeficiencyTypeTritanopia
+)
+
+var _setEmulatedVisionDeficiencyTypeEnums = enums.New(map[SetEmulatedVisionDeficiencyTypeEnum]string{
+	SetEmulatedVisionDeficiencyTypeEnum(0):         "",
+	setEmulatedVisionDeficiencyTypeNone:            "none",
+	setEmulatedVisionDeficiencyTypeBlurredVision:   "blurredVision",
+	setEmulatedVisionDeficiencyTypeReducedContrast: "reducedContrast",
+	setEmulatedVisionDeficiencyTypeAchromatopsia:   "achromatopsia",
+	setEmulatedVisionDeficiencyTypeDeuteranopia:    "deuteranopia",
+	setEmulatedVisionDeficiencyTypeProtanopia:      "protanopia",
+	setEmulatedVisionDeficiencyTypeTritanopia:      "tritanopia",
+})
@@ -1,25 +1,27 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package emulation
 
 /*
-VirtualTimeAdvancedEvent represents Emulation.virtualTimeAdvanced event data.
+VirtualTimeBudgetExpiredEvent represents Emulation.virtualTimeBudgetExpired event data.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeAdvanced
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeBudgetExpired
 */
-type VirtualTimeAdvancedEvent struct {
-	// The amount of virtual time that has elapsed in milliseconds since virtual
-	// time was first enabled.
-	VirtualTimeElapsed int `json:"virtualTimeElapsed"`
-
+type VirtualTimeBudgetExpiredEvent struct {
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
 /*
-VirtualTimeBudgetExpiredEvent represents Emulation.virtualTimeBudgetExpired event data.
+VirtualTimeAdvancedEvent represents Emulation.virtualTimeAdvanced event data.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeBudgetExpired
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeAdvanced
 */
-type VirtualTimeBudgetExpiredEvent struct {
+type VirtualTimeAdvancedEvent struct {
+	// The amount of virtual time that has elapsed in milliseconds since virtual
+	// time was first enabled.
+	VirtualTimeElapsed int `json:"virtualTimeElapsed"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
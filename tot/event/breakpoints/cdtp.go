@@ -0,0 +1,13 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package breakpoints provides type definitions for use with the Chrome EventBreakpoints protocol
+
+EventBreakpoints permits setting JavaScript breakpoints on operations and events
+occurring in native code invoked from JavaScript. Once breakpoint is hit, it is
+reported through Debugger domain, similarly to regular breakpoints being hit.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/
+*/
+package breakpoints
@@ -0,0 +1,53 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package breakpoints
+
+/*
+SetInstrumentationBreakpointParams represents EventBreakpoints.setInstrumentationBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-setInstrumentationBreakpoint
+*/
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+/*
+SetInstrumentationBreakpointResult represents the result of calls to EventBreakpoints.setInstrumentationBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-setInstrumentationBreakpoint
+*/
+type SetInstrumentationBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveInstrumentationBreakpointParams represents EventBreakpoints.removeInstrumentationBreakpoint parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-removeInstrumentationBreakpoint
+*/
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+}
+
+/*
+RemoveInstrumentationBreakpointResult represents the result of calls to EventBreakpoints.removeInstrumentationBreakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-removeInstrumentationBreakpoint
+*/
+type RemoveInstrumentationBreakpointResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to EventBreakpoints.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
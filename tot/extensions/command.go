@@ -0,0 +1,165 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package extensions
+
+/*
+LoadUnpackedParams represents Extensions.loadUnpacked parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-loadUnpacked
+*/
+type LoadUnpackedParams struct {
+	// Absolute file path.
+	Path string `json:"path"`
+}
+
+/*
+LoadUnpackedResult represents the result of calls to Extensions.loadUnpacked.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-loadUnpacked
+*/
+type LoadUnpackedResult struct {
+	// Extension id.
+	ID string `json:"id"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+UninstallParams represents Extensions.uninstall parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-uninstall
+*/
+type UninstallParams struct {
+	// Extension id.
+	ID string `json:"id"`
+}
+
+/*
+UninstallResult represents the result of calls to Extensions.uninstall.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-uninstall
+*/
+type UninstallResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetStorageItemsParams represents Extensions.getStorageItems parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-getStorageItems
+*/
+type GetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+
+	// StorageArea to retrieve data from. Allowed values:
+	//   - StorageArea.Session
+	//   - StorageArea.Local
+	//   - StorageArea.Sync
+	//   - StorageArea.Managed
+	StorageArea StorageAreaEnum `json:"storageArea"`
+
+	// Optional. Keys to retrieve.
+	Keys []string `json:"keys,omitempty"`
+}
+
+/*
+GetStorageItemsResult represents the result of calls to Extensions.getStorageItems.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-getStorageItems
+*/
+type GetStorageItemsResult struct {
+	Data map[string]interface{} `json:"data"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveStorageItemsParams represents Extensions.removeStorageItems parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-removeStorageItems
+*/
+type RemoveStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+
+	// StorageArea to remove data from. Allowed values:
+	//   - StorageArea.Session
+	//   - StorageArea.Local
+	//   - StorageArea.Sync
+	//   - StorageArea.Managed
+	StorageArea StorageAreaEnum `json:"storageArea"`
+
+	// Keys to remove.
+	Keys []string `json:"keys"`
+}
+
+/*
+RemoveStorageItemsResult represents the result of calls to Extensions.removeStorageItems.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-removeStorageItems
+*/
+type RemoveStorageItemsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearStorageItemsParams represents Extensions.clearStorageItems parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-clearStorageItems
+*/
+type ClearStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+
+	// StorageArea to remove data from. Allowed values:
+	//   - StorageArea.Session
+	//   - StorageArea.Local
+	//   - StorageArea.Sync
+	//   - StorageArea.Managed
+	StorageArea StorageAreaEnum `json:"storageArea"`
+}
+
+/*
+ClearStorageItemsResult represents the result of calls to Extensions.clearStorageItems.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-clearStorageItems
+*/
+type ClearStorageItemsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetStorageItemsParams represents Extensions.setStorageItems parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-setStorageItems
+*/
+type SetStorageItemsParams struct {
+	// ID of extension.
+	ID string `json:"id"`
+
+	// StorageArea to set data in. Allowed values:
+	//   - StorageArea.Session
+	//   - StorageArea.Local
+	//   - StorageArea.Sync
+	//   - StorageArea.Managed
+	StorageArea StorageAreaEnum `json:"storageArea"`
+
+	// Values to set.
+	Values map[string]interface{} `json:"values"`
+}
+
+/*
+SetStorageItemsResult represents the result of calls to Extensions.setStorageItems.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-setStorageItems
+*/
+type SetStorageItemsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
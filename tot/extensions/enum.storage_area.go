@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package extensions
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type storageAreaEnum struct {
+	Session StorageAreaEnum
+	Local   StorageAreaEnum
+	Sync    StorageAreaEnum
+	Managed StorageAreaEnum
+}
+
+/*
+StorageArea provides named access to the StorageAreaEnum values.
+*/
+var StorageArea = storageAreaEnum{
+	Session: storageAreaSession,
+	Local:   storageAreaLocal,
+	Sync:    storageAreaSync,
+	Managed: storageAreaManaged,
+}
+
+/*
+StorageAreaEnum represents the Extensions.StorageArea values.
+
+Storage areas.
+
+Allowed values:
+  - StorageArea.Session "session"
+  - StorageArea.Local   "local"
+  - StorageArea.Sync    "sync"
+  - StorageArea.Managed "managed"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#type-StorageArea
+*/
+type StorageAreaEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StorageAreaEnum) String() string {
+	return _storageAreaEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StorageAreaEnum) Unknown() bool {
+	return !_storageAreaEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StorageAreaEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StorageAreaEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _storageAreaEnums.Parse(val)
+	return nil
+}
+
+const (
+	// storageAreaSession represents the "session" value.
+	storageAreaSession StorageAreaEnum = iota + 1
+	// storageAreaLocal represents the "local" value.
+	storageAreaLocal
+	// storageAreaSync represents the "sync" value.
+	storageAreaSync
+	// storageAreaManaged represents the "managed" value.
+	storageAreaManaged
+)
+
+var _storageAreaEnums = enums.New(map[StorageAreaEnum]string{
+	StorageAreaEnum(0): "",
+	storageAreaSession: "session",
+	storageAreaLocal:   "local",
+	storageAreaSync:    "sync",
+	storageAreaManaged: "managed",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package extensions
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStorageArea(t *testing.T) {
+	var enum StorageAreaEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StorageAreaEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = StorageArea.Session
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"session"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"session"`, result)
+	}
+	json.Unmarshal([]byte(`"session"`), &enum)
+	if StorageArea.Session != enum {
+		t.Errorf("Expected %d, got %d", StorageArea.Session, enum)
+	}
+
+	enum = StorageArea.Local
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"local"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"local"`, result)
+	}
+	json.Unmarshal([]byte(`"local"`), &enum)
+	if StorageArea.Local != enum {
+		t.Errorf("Expected %d, got %d", StorageArea.Local, enum)
+	}
+
+	enum = StorageArea.Sync
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sync"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sync"`, result)
+	}
+	json.Unmarshal([]byte(`"sync"`), &enum)
+	if StorageArea.Sync != enum {
+		t.Errorf("Expected %d, got %d", StorageArea.Sync, enum)
+	}
+
+	enum = StorageArea.Managed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"managed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"managed"`, result)
+	}
+	json.Unmarshal([]byte(`"managed"`), &enum)
+	if StorageArea.Managed != enum {
+		t.Errorf("Expected %d, got %d", StorageArea.Managed, enum)
+	}
+}
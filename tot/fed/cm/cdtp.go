@@ -0,0 +1,44 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package cm provides type definitions for use with the Chrome FedCm protocol
+
+This domain allows interacting with the FedCM dialog. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/
+*/
+package cm
+
+/*
+Account represents the FedCm.Account type.
+
+# Corresponds to IdentityRequestAccount
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#type-Account
+*/
+type Account struct {
+	AccountID string `json:"accountId"`
+
+	Email string `json:"email"`
+
+	Name string `json:"name"`
+
+	GivenName string `json:"givenName"`
+
+	PictureURL string `json:"pictureUrl"`
+
+	IdpConfigURL string `json:"idpConfigUrl"`
+
+	IdpLoginURL string `json:"idpLoginUrl"`
+
+	// Allowed values:
+	//   - LoginState.SignIn
+	//   - LoginState.SignUp
+	LoginState LoginStateEnum `json:"loginState"`
+
+	// Optional. These two are only set if the loginState is signUp
+	TermsOfServiceURL string `json:"termsOfServiceUrl,omitempty"`
+
+	// Optional.
+	PrivacyPolicyURL string `json:"privacyPolicyUrl,omitempty"`
+}
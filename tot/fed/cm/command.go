@@ -0,0 +1,139 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+/*
+EnableParams represents FedCm.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Allows callers to disable the promise rejection delay that would
+	// normally happen, if this is unimportant to what's being tested. (step 4 of
+	// https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in)
+	DisableRejectionDelay *bool `json:"disableRejectionDelay,omitempty"`
+}
+
+/*
+EnableResult represents the result of calls to FedCm.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to FedCm.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SelectAccountParams represents FedCm.selectAccount parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-selectAccount
+*/
+type SelectAccountParams struct {
+	DialogID string `json:"dialogId"`
+
+	AccountIndex int `json:"accountIndex"`
+}
+
+/*
+SelectAccountResult represents the result of calls to FedCm.selectAccount.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-selectAccount
+*/
+type SelectAccountResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClickDialogButtonParams represents FedCm.clickDialogButton parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-clickDialogButton
+*/
+type ClickDialogButtonParams struct {
+	DialogID string `json:"dialogId"`
+
+	// Allowed values:
+	//   - DialogButton.ConfirmIdpLoginContinue
+	//   - DialogButton.ErrorGotIt
+	//   - DialogButton.ErrorMoreDetails
+	DialogButton DialogButtonEnum `json:"dialogButton"`
+}
+
+/*
+ClickDialogButtonResult represents the result of calls to FedCm.clickDialogButton.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-clickDialogButton
+*/
+type ClickDialogButtonResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+OpenURLParams represents FedCm.openUrl parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-openUrl
+*/
+type OpenURLParams struct {
+	DialogID string `json:"dialogId"`
+
+	AccountIndex int `json:"accountIndex"`
+
+	// Allowed values:
+	//   - AccountURLType.TermsOfService
+	//   - AccountURLType.PrivacyPolicy
+	AccountURLType AccountURLTypeEnum `json:"accountUrlType"`
+}
+
+/*
+OpenURLResult represents the result of calls to FedCm.openUrl.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-openUrl
+*/
+type OpenURLResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DismissDialogParams represents FedCm.dismissDialog parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-dismissDialog
+*/
+type DismissDialogParams struct {
+	DialogID string `json:"dialogId"`
+
+	// Optional.
+	TriggerCooldown *bool `json:"triggerCooldown,omitempty"`
+}
+
+/*
+DismissDialogResult represents the result of calls to FedCm.dismissDialog.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-dismissDialog
+*/
+type DismissDialogResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResetCooldownResult represents the result of calls to FedCm.resetCooldown.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-resetCooldown
+*/
+type ResetCooldownResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
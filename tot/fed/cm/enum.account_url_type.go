@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type accountURLTypeEnum struct {
+	TermsOfService AccountURLTypeEnum
+	PrivacyPolicy  AccountURLTypeEnum
+}
+
+/*
+AccountURLType provides named access to the AccountURLTypeEnum values.
+*/
+var AccountURLType = accountURLTypeEnum{
+	TermsOfService: accountURLTypeTermsOfService,
+	PrivacyPolicy:  accountURLTypePrivacyPolicy,
+}
+
+/*
+AccountURLTypeEnum represents the FedCm.AccountUrlType values.
+
+# The URLs that each account has
+
+Allowed values:
+  - AccountURLType.TermsOfService "TermsOfService"
+  - AccountURLType.PrivacyPolicy  "PrivacyPolicy"
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#type-AccountUrlType
+*/
+type AccountURLTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AccountURLTypeEnum) String() string {
+	return _accountURLTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AccountURLTypeEnum) Unknown() bool {
+	return !_accountURLTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AccountURLTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AccountURLTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _accountURLTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// accountURLTypeTermsOfService represents the "TermsOfService" value.
+	accountURLTypeTermsOfService AccountURLTypeEnum = iota + 1
+	// accountURLTypePrivacyPolicy represents the "PrivacyPolicy" value.
+	accountURLTypePrivacyPolicy
+)
+
+var _accountURLTypeEnums = enums.New(map[AccountURLTypeEnum]string{
+	AccountURLTypeEnum(0):        "",
+	accountURLTypeTermsOfService: "TermsOfService",
+	accountURLTypePrivacyPolicy:  "PrivacyPolicy",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAccountURLType(t *testing.T) {
+	var enum AccountURLTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AccountURLTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AccountURLType.TermsOfService
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TermsOfService"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TermsOfService"`, result)
+	}
+	json.Unmarshal([]byte(`"TermsOfService"`), &enum)
+	if AccountURLType.TermsOfService != enum {
+		t.Errorf("Expected %d, got %d", AccountURLType.TermsOfService, enum)
+	}
+
+	enum = AccountURLType.PrivacyPolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrivacyPolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrivacyPolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"PrivacyPolicy"`), &enum)
+	if AccountURLType.PrivacyPolicy != enum {
+		t.Errorf("Expected %d, got %d", AccountURLType.PrivacyPolicy, enum)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type dialogButtonEnum struct {
+	ConfirmIdpLoginContinue DialogButtonEnum
+	ErrorGotIt              DialogButtonEnum
+	ErrorMoreDetails        DialogButtonEnum
+}
+
+/*
+DialogButton provides named access to the DialogButtonEnum values.
+*/
+var DialogButton = dialogButtonEnum{
+	ConfirmIdpLoginContinue: dialogButtonConfirmIdpLoginContinue,
+	ErrorGotIt:              dialogButtonErrorGotIt,
+	ErrorMoreDetails:        dialogButtonErrorMoreDetails,
+}
+
+/*
+DialogButtonEnum represents the FedCm.DialogButton values.
+
+The buttons on the FedCM dialog.
+
+Allowed values:
+  - DialogButton.ConfirmIdpLoginContinue "ConfirmIdpLoginContinue"
+  - DialogButton.ErrorGotIt              "ErrorGotIt"
+  - DialogButton.ErrorMoreDetails        "ErrorMoreDetails"
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#type-DialogButton
+*/
+type DialogButtonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DialogButtonEnum) String() string {
+	return _dialogButtonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DialogButtonEnum) Unknown() bool {
+	return !_dialogButtonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DialogButtonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DialogButtonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _dialogButtonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// dialogButtonConfirmIdpLoginContinue represents the "ConfirmIdpLoginContinue" value.
+	dialogButtonConfirmIdpLoginContinue DialogButtonEnum = iota + 1
+	// dialogButtonErrorGotIt represents the "ErrorGotIt" value.
+	dialogButtonErrorGotIt
+	// dialogButtonErrorMoreDetails represents the "ErrorMoreDetails" value.
+	dialogButtonErrorMoreDetails
+)
+
+var _dialogButtonEnums = enums.New(map[DialogButtonEnum]string{
+	DialogButtonEnum(0):                 "",
+	dialogButtonConfirmIdpLoginContinue: "ConfirmIdpLoginContinue",
+	dialogButtonErrorGotIt:              "ErrorGotIt",
+	dialogButtonErrorMoreDetails:        "ErrorMoreDetails",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDialogButton(t *testing.T) {
+	var enum DialogButtonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DialogButtonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DialogButton.ConfirmIdpLoginContinue
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfirmIdpLoginContinue"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfirmIdpLoginContinue"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfirmIdpLoginContinue"`), &enum)
+	if DialogButton.ConfirmIdpLoginContinue != enum {
+		t.Errorf("Expected %d, got %d", DialogButton.ConfirmIdpLoginContinue, enum)
+	}
+
+	enum = DialogButton.ErrorGotIt
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ErrorGotIt"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ErrorGotIt"`, result)
+	}
+	json.Unmarshal([]byte(`"ErrorGotIt"`), &enum)
+	if DialogButton.ErrorGotIt != enum {
+		t.Errorf("Expected %d, got %d", DialogButton.ErrorGotIt, enum)
+	}
+
+	enum = DialogButton.ErrorMoreDetails
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ErrorMoreDetails"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ErrorMoreDetails"`, result)
+	}
+	json.Unmarshal([]byte(`"ErrorMoreDetails"`), &enum)
+	if DialogButton.ErrorMoreDetails != enum {
+		t.Errorf("Expected %d, got %d", DialogButton.ErrorMoreDetails, enum)
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type dialogTypeEnum struct {
+	AccountChooser  DialogTypeEnum
+	AutoReauthn     DialogTypeEnum
+	ConfirmIdpLogin DialogTypeEnum
+	Error           DialogTypeEnum
+}
+
+/*
+DialogType provides named access to the DialogTypeEnum values.
+*/
+var DialogType = dialogTypeEnum{
+	AccountChooser:  dialogTypeAccountChooser,
+	AutoReauthn:     dialogTypeAutoReauthn,
+	ConfirmIdpLogin: dialogTypeConfirmIdpLogin,
+	Error:           dialogTypeError,
+}
+
+/*
+DialogTypeEnum represents the FedCm.DialogType values.
+
+The types of FedCM dialogs.
+
+Allowed values:
+  - DialogType.AccountChooser  "AccountChooser"
+  - DialogType.AutoReauthn     "AutoReauthn"
+  - DialogType.ConfirmIdpLogin "ConfirmIdpLogin"
+  - DialogType.Error           "Error"
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#type-DialogType
+*/
+type DialogTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DialogTypeEnum) String() string {
+	return _dialogTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DialogTypeEnum) Unknown() bool {
+	return !_dialogTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DialogTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DialogTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _dialogTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// dialogTypeAccountChooser represents the "AccountChooser" value.
+	dialogTypeAccountChooser DialogTypeEnum = iota + 1
+	// dialogTypeAutoReauthn represents the "AutoReauthn" value.
+	dialogTypeAutoReauthn
+	// dialogTypeConfirmIdpLogin represents the "ConfirmIdpLogin" value.
+	dialogTypeConfirmIdpLogin
+	// dialogTypeError represents the "Error" value.
+	dialogTypeError
+)
+
+var _dialogTypeEnums = enums.New(map[DialogTypeEnum]string{
+	DialogTypeEnum(0):         "",
+	dialogTypeAccountChooser:  "AccountChooser",
+	dialogTypeAutoReauthn:     "AutoReauthn",
+	dialogTypeConfirmIdpLogin: "ConfirmIdpLogin",
+	dialogTypeError:           "Error",
+})
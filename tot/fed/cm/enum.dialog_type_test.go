@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDialogType(t *testing.T) {
+	var enum DialogTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DialogTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DialogType.AccountChooser
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AccountChooser"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AccountChooser"`, result)
+	}
+	json.Unmarshal([]byte(`"AccountChooser"`), &enum)
+	if DialogType.AccountChooser != enum {
+		t.Errorf("Expected %d, got %d", DialogType.AccountChooser, enum)
+	}
+
+	enum = DialogType.AutoReauthn
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AutoReauthn"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AutoReauthn"`, result)
+	}
+	json.Unmarshal([]byte(`"AutoReauthn"`), &enum)
+	if DialogType.AutoReauthn != enum {
+		t.Errorf("Expected %d, got %d", DialogType.AutoReauthn, enum)
+	}
+
+	enum = DialogType.ConfirmIdpLogin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ConfirmIdpLogin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ConfirmIdpLogin"`, result)
+	}
+	json.Unmarshal([]byte(`"ConfirmIdpLogin"`), &enum)
+	if DialogType.ConfirmIdpLogin != enum {
+		t.Errorf("Expected %d, got %d", DialogType.ConfirmIdpLogin, enum)
+	}
+
+	enum = DialogType.Error
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Error"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Error"`, result)
+	}
+	json.Unmarshal([]byte(`"Error"`), &enum)
+	if DialogType.Error != enum {
+		t.Errorf("Expected %d, got %d", DialogType.Error, enum)
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type loginStateEnum struct {
+	SignIn LoginStateEnum
+	SignUp LoginStateEnum
+}
+
+/*
+LoginState provides named access to the LoginStateEnum values.
+*/
+var LoginState = loginStateEnum{
+	SignIn: loginStateSignIn,
+	SignUp: loginStateSignUp,
+}
+
+/*
+LoginStateEnum represents the FedCm.LoginState values.
+
+Whether this is a sign-up or sign-in action for this account, i.e. whether this
+account has ever been used to sign in to this RP before.
+
+Allowed values:
+  - LoginState.SignIn "SignIn"
+  - LoginState.SignUp "SignUp"
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#type-LoginState
+*/
+type LoginStateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum LoginStateEnum) String() string {
+	return _loginStateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum LoginStateEnum) Unknown() bool {
+	return !_loginStateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum LoginStateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *LoginStateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _loginStateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// loginStateSignIn represents the "SignIn" value.
+	loginStateSignIn LoginStateEnum = iota + 1
+	// loginStateSignUp represents the "SignUp" value.
+	loginStateSignUp
+)
+
+var _loginStateEnums = enums.New(map[LoginStateEnum]string{
+	LoginStateEnum(0): "",
+	loginStateSignIn:  "SignIn",
+	loginStateSignUp:  "SignUp",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumLoginState(t *testing.T) {
+	var enum LoginStateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown LoginStateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = LoginState.SignIn
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignIn"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignIn"`, result)
+	}
+	json.Unmarshal([]byte(`"SignIn"`), &enum)
+	if LoginState.SignIn != enum {
+		t.Errorf("Expected %d, got %d", LoginState.SignIn, enum)
+	}
+
+	enum = LoginState.SignUp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignUp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignUp"`, result)
+	}
+	json.Unmarshal([]byte(`"SignUp"`), &enum)
+	if LoginState.SignUp != enum {
+		t.Errorf("Expected %d, got %d", LoginState.SignUp, enum)
+	}
+}
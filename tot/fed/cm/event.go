@@ -0,0 +1,43 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package cm
+
+/*
+DialogShownEvent represents FedCm.dialogShown event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#event-dialogShown
+*/
+type DialogShownEvent struct {
+	DialogID string `json:"dialogId"`
+
+	// Allowed values:
+	//   - DialogType.AccountChooser
+	//   - DialogType.AutoReauthn
+	//   - DialogType.ConfirmIdpLogin
+	//   - DialogType.Error
+	DialogType DialogTypeEnum `json:"dialogType"`
+
+	Accounts []*Account `json:"accounts"`
+
+	// These exist primarily so that the caller can verify the RP context was used
+	// appropriately.
+	Title string `json:"title"`
+
+	// Optional.
+	Subtitle string `json:"subtitle,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DialogClosedEvent represents FedCm.dialogClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#event-dialogClosed
+*/
+type DialogClosedEvent struct {
+	DialogID string `json:"dialogId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,125 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package fetch provides type definitions for use with the Chrome Fetch protocol
+
+A domain for letting clients substitute browser's network layer with client
+code.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/
+*/
+package fetch
+
+import (
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+RequestID represents the Fetch.RequestId type.
+
+Unique request identifier. Note that this does not identify individual HTTP
+requests that are part of a network request.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-RequestId
+*/
+type RequestID string
+
+/*
+RequestPattern represents the Fetch.RequestPattern type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-RequestPattern
+*/
+type RequestPattern struct {
+	// Optional. Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are
+	// allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
+	URLPattern string `json:"urlPattern,omitempty"`
+
+	// Optional. If set, only requests for matching resource types will be
+	// intercepted. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
+	ResourceType page.ResourceTypeEnum `json:"resourceType,omitempty"`
+
+	// Optional. Stage at which to begin intercepting requests. Default is Request. Allowed values:
+	//   - RequestStage.Request
+	//   - RequestStage.Response
+	RequestStage RequestStageEnum `json:"requestStage,omitempty"`
+}
+
+/*
+HeaderEntry represents the Fetch.HeaderEntry type.
+
+# Response HTTP header entry
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-HeaderEntry
+*/
+type HeaderEntry struct {
+	Name string `json:"name"`
+
+	Value string `json:"value"`
+}
+
+/*
+AuthChallenge represents the Fetch.AuthChallenge type.
+
+Authorization challenge for HTTP status code 401 or 407.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-AuthChallenge
+*/
+type AuthChallenge struct {
+	// Optional. Source of the authentication challenge. Allowed values:
+	//   - Source.Server
+	//   - Source.Proxy
+	Source SourceEnum `json:"source,omitempty"`
+
+	// Origin of the challenger.
+	Origin string `json:"origin"`
+
+	// The authentication scheme used, such as basic or digest
+	Scheme string `json:"scheme"`
+
+	// The realm of the challenge. May be empty.
+	Realm string `json:"realm"`
+}
+
+/*
+AuthChallengeResponse represents the Fetch.AuthChallengeResponse type.
+
+Response to an AuthChallenge.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-AuthChallengeResponse
+*/
+type AuthChallengeResponse struct {
+	// The decision on what to do in response to the authorization challenge.
+	// Default means deferring to the default behavior of the net stack, which will
+	// likely either the Cancel authentication or display a popup dialog box. Allowed values:
+	//   - Response.Default
+	//   - Response.CancelAuth
+	//   - Response.ProvideCredentials
+	Response ResponseEnum `json:"response"`
+
+	// Optional. The username to provide, possibly empty. Should only be set if
+	// response is ProvideCredentials.
+	Username string `json:"username,omitempty"`
+
+	// Optional. The password to provide, possibly empty. Should only be set if
+	// response is ProvideCredentials.
+	Password string `json:"password,omitempty"`
+}
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSource(t *testing.T) {
+	var enum SourceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Source.Server
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Server"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Server"`, result)
+	}
+	json.Unmarshal([]byte(`"Server"`), &enum)
+	if Source.Server != enum {
+		t.Errorf("Expected %d, got %d", Source.Server, enum)
+	}
+
+	enum = Source.Proxy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Proxy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Proxy"`, result)
+	}
+	json.Unmarshal([]byte(`"Proxy"`), &enum)
+	if Source.Proxy != enum {
+		t.Errorf("Expected %d, got %d", Source.Proxy, enum)
+	}
+}
@@ -0,0 +1,92 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type responseEnum struct {
+	Default            ResponseEnum
+	CancelAuth         ResponseEnum
+	ProvideCredentials ResponseEnum
+}
+
+/*
+Response provides named access to the ResponseEnum values.
+*/
+var Response = responseEnum{
+	Default:            responseDefault,
+	CancelAuth:         responseCancelAuth,
+	ProvideCredentials: responseProvideCredentials,
+}
+
+/*
+ResponseEnum represents the Fetch.AuthChallengeResponse.response values.
+
+The decision on what to do in response to the authorization challenge. Default
+means deferring to the default behavior of the net stack, which will likely
+either the Cancel authentication or display a popup dialog box.
+
+Allowed values:
+  - Response.Default            "Default"
+  - Response.CancelAuth         "CancelAuth"
+  - Response.ProvideCredentials "ProvideCredentials"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-AuthChallengeResponse
+*/
+type ResponseEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ResponseEnum) String() string {
+	return _responseEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ResponseEnum) Unknown() bool {
+	return !_responseEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ResponseEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ResponseEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _responseEnums.Parse(val)
+	return nil
+}
+
+const (
+	// responseDefault represents the "Default" value.
+	responseDefault ResponseEnum = iota + 1
+	// responseCancelAuth represents the "CancelAuth" value.
+	responseCancelAuth
+	// responseProvideCredentials represents the "ProvideCredentials" value.
+	responseProvideCredentials
+)
+
+var _responseEnums = enums.New(map[ResponseEnum]string{
+	ResponseEnum(0):            "",
+	responseDefault:            "Default",
+	responseCancelAuth:         "CancelAuth",
+	responseProvideCredentials: "ProvideCredentials",
+})
@@ -0,0 +1,86 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package fetch
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type requestStageEnum struct {
+	Request  RequestStageEnum
+	Response RequestStageEnum
+}
+
+/*
+RequestStage provides named access to the RequestStageEnum values.
+*/
+var RequestStage = requestStageEnum{
+	Request:  requestStageRequest,
+	Response: requestStageResponse,
+}
+
+/*
+RequestStageEnum represents the Fetch.RequestStage values.
+
+Stages of the request to handle. Request will intercept before the request is
+sent. Response will intercept after the response is received (but before
+response body is received).
+
+Allowed values:
+  - RequestStage.Request  "Request"
+  - RequestStage.Response "Response"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Fetch/#type-RequestStage
+*/
+type RequestStageEnum int
+
+/*
+String implements Stringer
+*/
+func (enum RequestStageEnum) String() string {
+	return _requestStageEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RequestStageEnum) Unknown() bool {
+	return !_requestStageEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum RequestStageEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *RequestStageEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _requestStageEnums.Parse(val)
+	return nil
+}
+
+const (
+	// requestStageRequest represents the "Request" value.
+	requestStageRequest RequestStageEnum = iota + 1
+	// requestStageResponse represents the "Response" value.
+	requestStageResponse
+)
+
+var _requestStageEnums = enums.New(map[RequestStageEnum]string{
+	RequestStageEnum(0):  "",
+	requestStageRequest:  "Request",
+	requestStageResponse: "Response",
+})
@@ -0,0 +1,64 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package system provides type definitions for use with the Chrome FileSystem protocol
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/
+*/
+package system
+
+import (
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
+/*
+File represents the FileSystem.File type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#type-File
+*/
+type File struct {
+	Name string `json:"name"`
+
+	// Timestamp
+	LastModified network.TimeSinceEpoch `json:"lastModified"`
+
+	// Size in bytes
+	Size float64 `json:"size"`
+
+	Type string `json:"type"`
+}
+
+/*
+Directory represents the FileSystem.Directory type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#type-Directory
+*/
+type Directory struct {
+	Name string `json:"name"`
+
+	NestedDirectories []string `json:"nestedDirectories"`
+
+	// Files that are directly nested under this directory.
+	NestedFiles []*File `json:"nestedFiles"`
+}
+
+/*
+BucketFileSystemLocator represents the FileSystem.BucketFileSystemLocator type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#type-BucketFileSystemLocator
+*/
+type BucketFileSystemLocator struct {
+	// Storage key
+	StorageKey storage.SerializedStorageKey `json:"storageKey"`
+
+	// Optional. Bucket name. Not passing a `bucketName` will retrieve the default
+	// Bucket.
+	// (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets)
+	BucketName string `json:"bucketName,omitempty"`
+
+	// Path to the directory using each path component as an array item.
+	PathComponents []string `json:"pathComponents"`
+}
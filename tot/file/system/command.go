@@ -0,0 +1,25 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package system
+
+/*
+GetDirectoryParams represents FileSystem.getDirectory parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#method-getDirectory
+*/
+type GetDirectoryParams struct {
+	BucketFileSystemLocator *BucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+/*
+GetDirectoryResult represents the result of calls to FileSystem.getDirectory.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#method-getDirectory
+*/
+type GetDirectoryResult struct {
+	// Returns the directory object at the path.
+	Directory *Directory `json:"directory"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -1,22 +1,33 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
-Package experimental provides type definitions for use with the Chrome HeadlessExperimental
-protocol
+Package experimental provides type definitions for use with the Chrome HeadlessExperimental protocol
+
+This domain provides experimental commands only supported in headless mode.
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/
 */
 package experimental
 
 /*
-ScreenshotParams represents encoding options for a screenshot.
+ScreenshotParams represents the HeadlessExperimental.ScreenshotParams type.
+
+Encoding options for a screenshot.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#type-ScreenshotParams
 */
 type ScreenshotParams struct {
 	// Optional. Image compression format (defaults to png). Allowed values:
-	//	- jpeg
-	//	- png
+	//   - Format.Jpeg
+	//   - Format.Png
+	//   - Format.Webp
 	Format FormatEnum `json:"format,omitempty"`
 
-	// Optional. Compression quality from range [0..100] (jpeg only).
+	// Optional. Compression quality from range [0..100] (jpeg and webp only).
 	Quality int `json:"quality,omitempty"`
+
+	// Optional. Optimize image encoding for speed, not for resulting size
+	// (defaults to false)
+	OptimizeForSpeed bool `json:"optimizeForSpeed,omitempty"`
 }
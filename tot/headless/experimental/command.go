@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package experimental
 
-import "github.com/mkenney/go-chrome/tot/runtime"
+import (
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
 
 /*
 BeginFrameParams represents HeadlessExperimental.beginFrame parameters.
@@ -8,22 +12,35 @@ BeginFrameParams represents HeadlessExperimental.beginFrame parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-beginFrame
 */
 type BeginFrameParams struct {
-	// Optional. Timestamp of this BeginFrame (milliseconds since epoch). If not
-	// set, the current time will be used.
-	FrameTime runtime.Timestamp `json:"frameTime,omitempty"`
-
-	// Optional. Deadline of this BeginFrame (milliseconds since epoch). If not
-	// set, the deadline will be calculated from the frameTime and interval.
-	Deadline runtime.Timestamp `json:"deadline,omitempty"`
+	// Optional. Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds
+	// of uptime). If not set, the current time will be used.
+	FrameTimeTicks *float64 `json:"frameTimeTicks,omitempty"`
 
 	// Optional. The interval between BeginFrames that is reported to the
-	// compositor, in milliseconds. Defaults to a 60 frames/second interval,
-	// i.e. about 16.666 milliseconds.
-	Interval float64 `json:"interval,omitempty"`
+	// compositor, in milliseconds. Defaults to a 60 frames/second interval, i.e.
+	// about 16.666 milliseconds.
+	Interval *float64 `json:"interval,omitempty"`
+
+	// Optional. Whether updates should not be committed and drawn onto the
+	// display. False by default. If true, only side effects of the BeginFrame will
+	// be run, such as layout and animations, but any visual updates may not be
+	// visible on the display or in screenshots.
+	NoDisplayUpdates *bool `json:"noDisplayUpdates,omitempty"`
 
-	// Optional. If set, a screenshot of the frame will be captured and returned
-	// in the response. Otherwise, no screenshot will be captured.
+	// Optional. If set, a screenshot of the frame will be captured and returned in
+	// the response. Otherwise, no screenshot will be captured. Note that capturing
+	// a screenshot can fail, for example, during renderer initialization. In such
+	// a case, no screenshot data will be returned.
 	Screenshot *ScreenshotParams `json:"screenshot,omitempty"`
+
+	// Optional. Timestamp of this BeginFrame (milliseconds since epoch). If not
+	// set, the current time will be used. DEPRECATED.
+	FrameTime *runtime.Timestamp `json:"frameTime,omitempty"`
+
+	// Optional. Deadline of this BeginFrame (milliseconds since epoch). If not
+	// set, the deadline will be calculated from the frameTime and interval.
+	// DEPRECATED.
+	Deadline *runtime.Timestamp `json:"deadline,omitempty"`
 }
 
 /*
@@ -33,17 +50,18 @@ https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#met
 */
 type BeginFrameResult struct {
 	// Whether the BeginFrame resulted in damage and, thus, a new frame was
-	// committed to the display.
+	// committed to the display. Reported for diagnostic uses, may be removed in
+	// the future.
 	HasDamage bool `json:"hasDamage"`
 
+	// Optional. Base64-encoded image data of the screenshot, if one was requested
+	// and successfully taken. (Encoded as a base64 string when passed over JSON)
+	ScreenshotData string `json:"screenshotData,omitempty"`
+
 	// Whether the main frame submitted a new display frame in response to this
-	// BeginFrame.
+	// BeginFrame. DEPRECATED.
 	MainFrameContentUpdated bool `json:"mainFrameContentUpdated"`
 
-	// Base64-encoded image data of the screenshot, if one was requested and
-	// successfully taken.
-	ScreenshotData string `json:"screenshotData"`
-
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
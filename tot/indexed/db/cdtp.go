@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package db provides type definitions for use with the Chrome IndexedDB protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/
 */
 package db
@@ -10,7 +14,9 @@ import (
 )
 
 /*
-DatabaseWithObjectStores is a database with an array of object stores.
+DatabaseWithObjectStores represents the IndexedDB.DatabaseWithObjectStores type.
+
+Database with an array of object stores.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-DatabaseWithObjectStores
 */
@@ -18,15 +24,18 @@ type DatabaseWithObjectStores struct {
 	// Database name.
 	Name string `json:"name"`
 
-	// Database version.
-	Version int `json:"version"`
+	// Database version (type is not 'integer', as the standard requires the
+	// version number to be 'unsigned long long')
+	Version float64 `json:"version"`
 
 	// Object stores in this database.
 	ObjectStores []*ObjectStore `json:"objectStores"`
 }
 
 /*
-ObjectStore is a object store
+ObjectStore represents the IndexedDB.ObjectStore type.
+
+Object store.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-ObjectStore
 */
@@ -45,7 +54,9 @@ type ObjectStore struct {
 }
 
 /*
-ObjectStoreIndex is an object store index.
+ObjectStoreIndex represents the IndexedDB.ObjectStoreIndex type.
+
+Object store index.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-ObjectStoreIndex
 */
@@ -64,16 +75,18 @@ type ObjectStoreIndex struct {
 }
 
 /*
-Key is a key.
+Key represents the IndexedDB.Key type.
+
+Key.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-Key
 */
 type Key struct {
 	// Key type. Allowed values:
-	//	- number
-	//	- string
-	//	- date
-	//	- array
+	//   - KeyType.Number
+	//   - KeyType.String
+	//   - KeyType.Date
+	//   - KeyType.Array
 	Type KeyTypeEnum `json:"type"`
 
 	// Optional. Number value.
@@ -90,7 +103,9 @@ type Key struct {
 }
 
 /*
-KeyRange is a key range.
+KeyRange represents the IndexedDB.KeyRange type.
+
+Key range.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-KeyRange
 */
@@ -109,7 +124,9 @@ type KeyRange struct {
 }
 
 /*
-DataEntry is a data entry.
+DataEntry represents the IndexedDB.DataEntry type.
+
+Data entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-DataEntry
 */
@@ -125,15 +142,17 @@ type DataEntry struct {
 }
 
 /*
-KeyPath is a key path.
+KeyPath represents the IndexedDB.KeyPath type.
+
+Key path.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-KeyPath
 */
 type KeyPath struct {
 	// Key path type. Allowed values:
-	//	- null
-	//	- string
-	//	- array
+	//   - KeyPathType.Null
+	//   - KeyPathType.String
+	//   - KeyPathType.Array
 	Type KeyPathTypeEnum `json:"type"`
 
 	// Optional. String value.
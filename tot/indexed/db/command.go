@@ -1,13 +1,26 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package db
 
+import (
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
 /*
 ClearObjectStoreParams represents IndexedDB.clearObjectStore parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-clearObjectStore
 */
 type ClearObjectStoreParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 
 	// Database name.
 	DatabaseName string `json:"databaseName"`
@@ -32,13 +45,20 @@ DeleteDatabaseParams represents IndexedDB.deleteDatabase parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteDatabase
 */
 type DeleteDatabaseParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 
 	// Database name.
 	DatabaseName string `json:"databaseName"`
 
-	// Object store name.
+	// Object store name. DEPRECATED.
 	ObjectStoreName string `json:"objectStoreName"`
 }
 
@@ -53,22 +73,31 @@ type DeleteDatabaseResult struct {
 }
 
 /*
-DeleteObjectStoreEntriesParams represents IndexedDB.deleteObjectStoreEntries
-parameters.
+DeleteObjectStoreEntriesParams represents IndexedDB.deleteObjectStoreEntries parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteObjectStoreEntries
 */
 type DeleteObjectStoreEntriesParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 
-	// Database name.
 	DatabaseName string `json:"databaseName"`
+
+	ObjectStoreName string `json:"objectStoreName"`
+
+	// Range of entry keys to delete
+	KeyRange *KeyRange `json:"keyRange"`
 }
 
 /*
-DeleteObjectStoreEntriesResult represents the result of calls to
-IndexedDB.deleteObjectStoreEntries.
+DeleteObjectStoreEntriesResult represents the result of calls to IndexedDB.deleteObjectStoreEntries.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteObjectStoreEntries
 */
@@ -103,8 +132,15 @@ RequestDataParams represents IndexedDB.requestData parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestData
 */
 type RequestDataParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 
 	// Database name.
 	DatabaseName string `json:"databaseName"`
@@ -141,14 +177,61 @@ type RequestDataResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetMetadataParams represents IndexedDB.getMetadata parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-getMetadata
+*/
+type GetMetadataParams struct {
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
+
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+/*
+GetMetadataResult represents the result of calls to IndexedDB.getMetadata.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-getMetadata
+*/
+type GetMetadataResult struct {
+	// the entries count
+	EntriesCount float64 `json:"entriesCount"`
+
+	// the current value of key generator, to become the next inserted key into the
+	// object store. Valid if objectStore.autoIncrement is true.
+	KeyGeneratorValue float64 `json:"keyGeneratorValue"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 RequestDatabaseParams represents IndexedDB.requestDatabase parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabase
 */
 type RequestDatabaseParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 
 	// Database name.
 	DatabaseName string `json:"databaseName"`
@@ -173,8 +256,15 @@ RequestDatabaseNamesParams represents IndexedDB.requestDatabaseNames parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabaseNames
 */
 type RequestDatabaseNamesParams struct {
-	// Security origin.
-	SecurityOrigin string `json:"securityOrigin"`
+	// Optional. At least and at most one of securityOrigin, storageKey, or
+	// storageBucket must be specified. Security origin.
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+
+	// Optional. Storage key.
+	StorageKey *string `json:"storageKey,omitempty"`
+
+	// Optional. Storage bucket. If not specified, it uses the default bucket.
+	StorageBucket *storage.Bucket `json:"storageBucket,omitempty"`
 }
 
 /*
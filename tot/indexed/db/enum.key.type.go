@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package db
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type keyTypeEnum struct {
@@ -13,7 +16,7 @@ type keyTypeEnum struct {
 }
 
 /*
-KeyType provides named acces to the KeyTypeEnum values.
+KeyType provides named access to the KeyTypeEnum values.
 */
 var KeyType = keyTypeEnum{
 	Number: keyTypeNumber,
@@ -23,13 +26,17 @@ var KeyType = keyTypeEnum{
 }
 
 /*
-KeyTypeEnum represents the key type. Allowed values:
-	- KeyType.Number "number"
-	- KeyType.String "string"
-	- KeyType.Date   "date"
-	- KeyType.Array  "array"
+KeyTypeEnum represents the IndexedDB.Key.type values.
+
+Key type.
+
+Allowed values:
+  - KeyType.Number "number"
+  - KeyType.String "string"
+  - KeyType.Date   "date"
+  - KeyType.Array  "array"
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#type-ScreenshotParams
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-Key
 */
 type KeyTypeEnum int
 
@@ -37,7 +44,16 @@ type KeyTypeEnum int
 String implements Stringer
 */
 func (enum KeyTypeEnum) String() string {
-	return _keyTypeEnums[enum]
+	return _keyTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum KeyTypeEnum) Unknown() bool {
+	return !_keyTypeEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum KeyTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *KeyTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _keyTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _keyTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -80,9 +87,10 @@ const (
 	keyTypeArray
 )
 
-var _keyTypeEnums = map[KeyTypeEnum]string{
-	keyTypeNumber: "number",
-	keyTypeString: "string",
-	keyTypeDate:   "date",
-	keyTypeArray:  "array",
-}
+var _keyTypeEnums = enums.New(map[KeyTypeEnum]string{
+	KeyTypeEnum(0): "",
+	keyTypeNumber:  "number",
+	keyTypeString:  "string",
+	keyTypeDate:    "date",
+	keyTypeArray:   "array",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package db
 
 import (
@@ -11,8 +13,24 @@ func TestEnumKeyType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown KeyTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumKeyType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"number"` != string(result) {
-		t.Errorf("Expected '\"number\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"number"`, result)
 	}
 	json.Unmarshal([]byte(`"number"`), &enum)
 	if KeyType.Number != enum {
-		t.Errorf("Expcected %d, got %d", KeyType.Number, enum)
+		t.Errorf("Expected %d, got %d", KeyType.Number, enum)
 	}
 
 	enum = KeyType.String
@@ -42,11 +60,11 @@ func TestEnumKeyType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"string"` != string(result) {
-		t.Errorf("Expected '\"string\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"string"`, result)
 	}
 	json.Unmarshal([]byte(`"string"`), &enum)
 	if KeyType.String != enum {
-		t.Errorf("Expcected %d, got %d", KeyType.String, enum)
+		t.Errorf("Expected %d, got %d", KeyType.String, enum)
 	}
 
 	enum = KeyType.Date
@@ -55,18 +73,12 @@ func TestEnumKeyType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"date"` != string(result) {
-		t.Errorf("Expected '\"date\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"date"`, result)
 	}
 	json.Unmarshal([]byte(`"date"`), &enum)
 	if KeyType.Date != enum {
-		t.Errorf("Expcected %d, got %d", KeyType.Date, enum)
+		t.Errorf("Expected %d, got %d", KeyType.Date, enum)
 	}
-}
-
-func TestEnumKeyType2(t *testing.T) {
-	var enum KeyTypeEnum
-	var err error
-	var result []byte
 
 	enum = KeyType.Array
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumKeyType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"array"` != string(result) {
-		t.Errorf("Expected '\"array\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"array"`, result)
 	}
 	json.Unmarshal([]byte(`"array"`), &enum)
 	if KeyType.Array != enum {
-		t.Errorf("Expcected %d, got %d", KeyType.Array, enum)
+		t.Errorf("Expected %d, got %d", KeyType.Array, enum)
 	}
 }
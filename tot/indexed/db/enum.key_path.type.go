@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package db
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type keyPathTypeEnum struct {
@@ -12,7 +15,7 @@ type keyPathTypeEnum struct {
 }
 
 /*
-KeyPathType provides named acces to the KeyPathTypeEnum values.
+KeyPathType provides named access to the KeyPathTypeEnum values.
 */
 var KeyPathType = keyPathTypeEnum{
 	Null:   keyPathTypeNull,
@@ -21,12 +24,16 @@ var KeyPathType = keyPathTypeEnum{
 }
 
 /*
-KeyPathTypeEnum represents the key path type. Allowed values:
-	- KeyPathType.Null   "null"
-	- KeyPathType.String "string"
-	- KeyPathType.Array  "array"
+KeyPathTypeEnum represents the IndexedDB.KeyPath.type values.
+
+Key path type.
+
+Allowed values:
+  - KeyPathType.Null   "null"
+  - KeyPathType.String "string"
+  - KeyPathType.Array  "array"
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#type-ScreenshotParams
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#type-KeyPath
 */
 type KeyPathTypeEnum int
 
@@ -34,7 +41,16 @@ type KeyPathTypeEnum int
 String implements Stringer
 */
 func (enum KeyPathTypeEnum) String() string {
-	return _keyPathTypeEnums[enum]
+	return _keyPathTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum KeyPathTypeEnum) Unknown() bool {
+	return !_keyPathTypeEnums.Known(enum)
 }
 
 /*
@@ -45,25 +61,16 @@ func (enum KeyPathTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *KeyPathTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _keyPathTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _keyPathTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -75,8 +82,9 @@ const (
 	keyPathTypeArray
 )
 
-var _keyPathTypeEnums = map[KeyPathTypeEnum]string{
-	keyPathTypeNull:   "null",
-	keyPathTypeString: "string",
-	keyPathTypeArray:  "array",
-}
+var _keyPathTypeEnums = enums.New(map[KeyPathTypeEnum]string{
+	KeyPathTypeEnum(0): "",
+	keyPathTypeNull:    "null",
+	keyPathTypeString:  "string",
+	keyPathTypeArray:   "array",
+})
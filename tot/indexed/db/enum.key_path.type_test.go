@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package db
 
 import (
@@ -11,8 +13,24 @@ func TestEnumKeyPathType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown KeyPathTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumKeyPathType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"null"` != string(result) {
-		t.Errorf("Expected '\"null\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"null"`, result)
 	}
 	json.Unmarshal([]byte(`"null"`), &enum)
 	if KeyPathType.Null != enum {
-		t.Errorf("Expcected %d, got %d", KeyPathType.Null, enum)
+		t.Errorf("Expected %d, got %d", KeyPathType.Null, enum)
 	}
 
 	enum = KeyPathType.String
@@ -42,11 +60,11 @@ func TestEnumKeyPathType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"string"` != string(result) {
-		t.Errorf("Expected '\"string\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"string"`, result)
 	}
 	json.Unmarshal([]byte(`"string"`), &enum)
 	if KeyPathType.String != enum {
-		t.Errorf("Expcected %d, got %d", KeyPathType.String, enum)
+		t.Errorf("Expected %d, got %d", KeyPathType.String, enum)
 	}
 
 	enum = KeyPathType.Array
@@ -55,10 +73,10 @@ func TestEnumKeyPathType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"array"` != string(result) {
-		t.Errorf("Expected '\"array\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"array"`, result)
 	}
 	json.Unmarshal([]byte(`"array"`), &enum)
 	if KeyPathType.Array != enum {
-		t.Errorf("Expcected %d, got %d", KeyPathType.Array, enum)
+		t.Errorf("Expected %d, got %d", KeyPathType.Array, enum)
 	}
 }
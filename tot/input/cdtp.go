@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package input provides type definitions for use with the Chrome Input protocol
 
@@ -6,47 +8,111 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/
 package input
 
 /*
-TouchPoint is a touch point.
+TouchPoint represents the Input.TouchPoint type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-TouchPoint
 */
 type TouchPoint struct {
 	// X coordinate of the event relative to the main frame's viewport in CSS
 	// pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the event relative to the main frame's viewport in CSS
-	// pixels. 0 refers to the top of the viewport and Y increases as it
-	// proceeds towards the bottom of the viewport.
-	Y int `json:"y"`
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
 
 	// Optional. X radius of the touch area (default: 1.0).
-	RadiusX int `json:"radiusX,omitempty"`
+	RadiusX float64 `json:"radiusX,omitempty"`
 
 	// Optional. Y radius of the touch area (default: 1.0).
-	RadiusY int `json:"radiusY,omitempty"`
+	RadiusY float64 `json:"radiusY,omitempty"`
 
 	// Optional. Rotation angle (default: 0.0).
-	RotationAngle int `json:"rotationAngle,omitempty"`
+	RotationAngle float64 `json:"rotationAngle,omitempty"`
 
 	// Optional. Force (default: 1.0).
-	Force int `json:"force,omitempty"`
+	Force float64 `json:"force,omitempty"`
+
+	// Optional. The normalized tangential pressure, which has a range of [-1,1]
+	// (default: 0). EXPERIMENTAL.
+	TangentialPressure float64 `json:"tangentialPressure,omitempty"`
+
+	// Optional. The plane angle between the Y-Z plane and the plane containing
+	// both the stylus axis and the Y axis, in degrees of the range [-90,90], a
+	// positive tiltX is to the right (default: 0)
+	TiltX float64 `json:"tiltX,omitempty"`
+
+	// Optional. The plane angle between the X-Z plane and the plane containing
+	// both the stylus axis and the X axis, in degrees of the range [-90,90], a
+	// positive tiltY is towards the user (default: 0).
+	TiltY float64 `json:"tiltY,omitempty"`
+
+	// Optional. The clockwise rotation of a pen stylus around its own major axis,
+	// in degrees in the range [0,359] (default: 0). EXPERIMENTAL.
+	Twist int `json:"twist,omitempty"`
 
 	// Optional. Identifier used to track touch sources between events, must be
 	// unique within an event.
-	ID int `json:"id,omitempty"`
+	ID float64 `json:"id,omitempty"`
 }
 
 /*
-GestureSourceType is a gesture source type. EXPERIMENTAL
+GestureSourceType represents the Input.GestureSourceType type.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-GestureSourceType
 */
 type GestureSourceType string
 
 /*
-TimeSinceEpoch is UTC time in seconds, counted from January 1, 1970.
+TimeSinceEpoch represents the Input.TimeSinceEpoch type.
+
+UTC time in seconds, counted from January 1, 1970.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-TimeSinceEpoch
 */
-type TimeSinceEpoch int
+type TimeSinceEpoch float64
+
+/*
+DragDataItem represents the Input.DragDataItem type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-DragDataItem
+*/
+type DragDataItem struct {
+	// Mime type of the dragged data.
+	MimeType string `json:"mimeType"`
+
+	// Depending of the value of `mimeType`, it contains the dragged link, text,
+	// HTML markup or any other data.
+	Data string `json:"data"`
+
+	// Optional. Title associated with a link. Only valid when `mimeType` ==
+	// "text/uri-list".
+	Title string `json:"title,omitempty"`
+
+	// Optional. Stores the base URL for the contained markup. Only valid when
+	// `mimeType` == "text/html".
+	BaseURL string `json:"baseURL,omitempty"`
+}
+
+/*
+DragData represents the Input.DragData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-DragData
+*/
+type DragData struct {
+	Items []*DragDataItem `json:"items"`
+
+	// Optional. List of filenames that should be included when dropping
+	Files []string `json:"files,omitempty"`
+
+	// Bit field representing allowed drag operations. Copy = 1, Link = 2, Move =
+	// 16
+	DragOperationsMask int `json:"dragOperationsMask"`
+}
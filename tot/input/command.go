@@ -1,5 +1,46 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
+/*
+DispatchDragEventParams represents Input.dispatchDragEvent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+*/
+type DispatchDragEventParams struct {
+	// Type of the drag event. Allowed values:
+	//   - Type.DragEnter
+	//   - Type.DragOver
+	//   - Type.Drop
+	//   - Type.DragCancel
+	Type TypeEnum `json:"type"`
+
+	// X coordinate of the event relative to the main frame's viewport in CSS
+	// pixels.
+	X float64 `json:"x"`
+
+	// Y coordinate of the event relative to the main frame's viewport in CSS
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
+
+	Data *DragData `json:"data"`
+
+	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
+	// Meta/Command=4, Shift=8 (default: 0).
+	Modifiers *int `json:"modifiers,omitempty"`
+}
+
+/*
+DispatchDragEventResult represents the result of calls to Input.dispatchDragEvent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+*/
+type DispatchDragEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 DispatchKeyEventParams represents Input.dispatchKeyEvent parameters.
 
@@ -7,61 +48,65 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKey
 */
 type DispatchKeyEventParams struct {
 	// Type of the key event. Allowed values:
-	//	- KeyEvent.KeyDown
-	//	- KeyEvent.KeyUp
-	//	- KeyEvent.RawKeyDown
-	//	- KeyEvent.Char
+	//   - KeyEvent.KeyDown
+	//   - KeyEvent.KeyUp
+	//   - KeyEvent.RawKeyDown
+	//   - KeyEvent.Char
 	Type KeyEventEnum `json:"type"`
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
-	Modifiers int `json:"modifiers,omitempty"`
+	Modifiers *int `json:"modifiers,omitempty"`
 
 	// Optional. Time at which the event occurred.
-	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
 
-	// Optional. Text as generated by processing a virtual key code with a
-	// keyboard layout. Not needed for for `keyUp` and `rawKeyDown` events
-	// (default: "").
-	Text string `json:"text,omitempty"`
+	// Optional. Text as generated by processing a virtual key code with a keyboard
+	// layout. Not needed for for `keyUp` and `rawKeyDown` events (default: "")
+	Text *string `json:"text,omitempty"`
 
 	// Optional. Text that would have been generated by the keyboard if no
-	// modifiers were pressed (except for shift). Useful for shortcut
-	// (accelerator) key handling (default: "").
-	UnmodifiedText string `json:"unmodifiedText,omitempty"`
+	// modifiers were pressed (except for shift). Useful for shortcut (accelerator)
+	// key handling (default: "").
+	UnmodifiedText *string `json:"unmodifiedText,omitempty"`
 
 	// Optional. Unique key identifier (e.g., 'U+0041') (default: "").
-	KeyIdentifier string `json:"keyIdentifier,omitempty"`
+	KeyIdentifier *string `json:"keyIdentifier,omitempty"`
 
 	// Optional. Unique DOM defined string value for each physical key (e.g.,
 	// 'KeyA') (default: "").
-	Code string `json:"code,omitempty"`
+	Code *string `json:"code,omitempty"`
 
-	// Optional. Unique DOM defined string value describing the meaning of the
-	// key in the context of active modifiers, keyboard layout, etc (e.g.,
-	// 'AltGr') (default: "").
-	Key string `json:"key,omitempty"`
+	// Optional. Unique DOM defined string value describing the meaning of the key
+	// in the context of active modifiers, keyboard layout, etc (e.g., 'AltGr')
+	// (default: "").
+	Key *string `json:"key,omitempty"`
 
 	// Optional. Windows virtual key code (default: 0).
-	WindowsVirtualKeyCode int `json:"windowsVirtualKeyCode,omitempty"`
+	WindowsVirtualKeyCode *int `json:"windowsVirtualKeyCode,omitempty"`
 
 	// Optional. Native virtual key code (default: 0).
-	NativeVirtualKeyCode int `json:"nativeVirtualKeyCode,omitempty"`
+	NativeVirtualKeyCode *int `json:"nativeVirtualKeyCode,omitempty"`
 
-	// Optional. Whether the event was generated from auto repeat (default:
-	// false).
-	AutoRepeat bool `json:"autoRepeat,omitempty"`
+	// Optional. Whether the event was generated from auto repeat (default: false).
+	AutoRepeat *bool `json:"autoRepeat,omitempty"`
 
-	// Optional. Whether the event was generated from the keypad (default:
-	// false).
-	IsKeypad bool `json:"isKeypad,omitempty"`
+	// Optional. Whether the event was generated from the keypad (default: false).
+	IsKeypad *bool `json:"isKeypad,omitempty"`
 
 	// Optional. Whether the event was a system key event (default: false).
-	IsSystemKey bool `json:"isSystemKey,omitempty"`
-
-	// Optional. Whether the event was from the left or right side of the
-	// keyboard. 1=Left, 2=Right (default: 0).
-	Location int `json:"location,omitempty"`
+	IsSystemKey *bool `json:"isSystemKey,omitempty"`
+
+	// Optional. Whether the event was from the left or right side of the keyboard.
+	// 1=Left, 2=Right (default: 0).
+	Location *int `json:"location,omitempty"`
+
+	// Optional. Editing commands to send with the key event (e.g., 'selectAll')
+	// (default: []). These are related to but not equal the command names used in
+	// `document.execCommand` and NSStandardKeyBindingResponding. See
+	// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h
+	// for valid command names. EXPERIMENTAL.
+	Commands []string `json:"commands,omitempty"`
 }
 
 /*
@@ -74,6 +119,58 @@ type DispatchKeyEventResult struct {
 	Err error `json:"-"`
 }
 
+/*
+InsertTextParams represents Input.insertText parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+*/
+type InsertTextParams struct {
+	// The text to insert.
+	Text string `json:"text"`
+}
+
+/*
+InsertTextResult represents the result of calls to Input.insertText.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+*/
+type InsertTextResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ImeSetCompositionParams represents Input.imeSetComposition parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-imeSetComposition
+*/
+type ImeSetCompositionParams struct {
+	// The text to insert
+	Text string `json:"text"`
+
+	// selection start
+	SelectionStart int `json:"selectionStart"`
+
+	// selection end
+	SelectionEnd int `json:"selectionEnd"`
+
+	// Optional. replacement start
+	ReplacementStart *int `json:"replacementStart,omitempty"`
+
+	// Optional. replacement end
+	ReplacementEnd *int `json:"replacementEnd,omitempty"`
+}
+
+/*
+ImeSetCompositionResult represents the result of calls to Input.imeSetComposition.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-imeSetComposition
+*/
+type ImeSetCompositionResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 DispatchMouseEventParams represents Input.dispatchMouseEvent parameters.
 
@@ -81,43 +178,77 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMou
 */
 type DispatchMouseEventParams struct {
 	// Type of the mouse event. Allowed values:
-	//	- MouseEvent.MousePressed
-	//	- MouseEvent.MouseReleased
-	//	- MouseEvent.MouseMoved
-	//	- MouseEvent.MouseWheel
+	//   - MouseEvent.MousePressed
+	//   - MouseEvent.MouseReleased
+	//   - MouseEvent.MouseMoved
+	//   - MouseEvent.MouseWheel
 	Type MouseEventEnum `json:"type"`
 
 	// X coordinate of the event relative to the main frame's viewport in CSS
 	// pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the event relative to the main frame's viewport in CSS
-	// pixels. 0 refers to the top of the viewport and Y increases as it
-	// proceeds towards the bottom of the viewport.
-	Y int `json:"y"`
+	// pixels. 0 refers to the top of the viewport and Y increases as it proceeds
+	// towards the bottom of the viewport.
+	Y float64 `json:"y"`
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
-	Modifiers int `json:"modifiers,omitempty"`
+	Modifiers *int `json:"modifiers,omitempty"`
 
 	// Optional. Time at which the event occurred.
-	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
 
 	// Optional. Mouse button (default: "none"). Allowed values:
-	//	- ButtonEvent.None
-	//	- ButtonEvent.Left
-	//	- ButtonEvent.Middle
-	//	- ButtonEvent.Right
+	//   - ButtonEvent.None
+	//   - ButtonEvent.Left
+	//   - ButtonEvent.Middle
+	//   - ButtonEvent.Right
+	//   - ButtonEvent.Back
+	//   - ButtonEvent.Forward
 	Button ButtonEventEnum `json:"button,omitempty"`
 
+	// Optional. A number indicating which buttons are pressed on the mouse when a
+	// mouse event is triggered. Left=1, Right=2, Middle=4, Back=8, Forward=16,
+	// None=0.
+	Buttons *int `json:"buttons,omitempty"`
+
 	// Optional. Number of times the mouse button was clicked (default: 0).
-	ClickCount int `json:"clickCount,omitempty"`
+	ClickCount *int `json:"clickCount,omitempty"`
+
+	// Optional. The normalized pressure, which has a range of [0,1] (default: 0).
+	// EXPERIMENTAL.
+	Force *float64 `json:"force,omitempty"`
+
+	// Optional. The normalized tangential pressure, which has a range of [-1,1]
+	// (default: 0). EXPERIMENTAL.
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+
+	// Optional. The plane angle between the Y-Z plane and the plane containing
+	// both the stylus axis and the Y axis, in degrees of the range [-90,90], a
+	// positive tiltX is to the right (default: 0).
+	TiltX *float64 `json:"tiltX,omitempty"`
+
+	// Optional. The plane angle between the X-Z plane and the plane containing
+	// both the stylus axis and the X axis, in degrees of the range [-90,90], a
+	// positive tiltY is towards the user (default: 0).
+	TiltY *float64 `json:"tiltY,omitempty"`
+
+	// Optional. The clockwise rotation of a pen stylus around its own major axis,
+	// in degrees in the range [0,359] (default: 0). EXPERIMENTAL.
+	Twist *int `json:"twist,omitempty"`
 
 	// Optional. X delta in CSS pixels for mouse wheel event (default: 0).
-	DeltaX int `json:"deltaX,omitempty"`
+	DeltaX *float64 `json:"deltaX,omitempty"`
 
 	// Optional. Y delta in CSS pixels for mouse wheel event (default: 0).
-	DeltaY int `json:"deltaY,omitempty"`
+	DeltaY *float64 `json:"deltaY,omitempty"`
+
+	// Optional. Pointer type (default: "mouse"). Allowed values:
+	//   - PointerType.Mouse
+	//   - PointerType.Pen
+	PointerType PointerTypeEnum `json:"pointerType,omitempty"`
 }
 
 /*
@@ -136,13 +267,12 @@ DispatchTouchEventParams represents Input.dispatchTouchEvent parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTouchEvent
 */
 type DispatchTouchEventParams struct {
-	// Type of the touch event. TouchEnd and TouchCancel must not contain any
-	// touch points, while TouchStart and TouchMove must contains at least one.
-	// Allowed values:
-	//	- TouchEvent.TouchStart
-	//	- TouchEvent.TouchEnd
-	//	- TouchEvent.TouchMove
-	//	- TouchEvent.TouchCancel
+	// Type of the touch event. TouchEnd and TouchCancel must not contain any touch
+	// points, while TouchStart and TouchMove must contains at least one. Allowed values:
+	//   - TouchEvent.TouchStart
+	//   - TouchEvent.TouchEnd
+	//   - TouchEvent.TouchMove
+	//   - TouchEvent.TouchCancel
 	Type TouchEventEnum `json:"type"`
 
 	// Active touch points on the touch device. One event per any changed point
@@ -152,10 +282,10 @@ type DispatchTouchEventParams struct {
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
-	Modifiers int `json:"modifiers,omitempty"`
+	Modifiers *int `json:"modifiers,omitempty"`
 
 	// Optional. Time at which the event occurred.
-	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
 }
 
 /*
@@ -168,6 +298,16 @@ type DispatchTouchEventResult struct {
 	Err error `json:"-"`
 }
 
+/*
+CancelDraggingResult represents the result of calls to Input.cancelDragging.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-cancelDragging
+*/
+type CancelDraggingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 EmulateTouchFromMouseEventParams represents Input.emulateTouchFromMouseEvent parameters.
 
@@ -175,10 +315,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouc
 */
 type EmulateTouchFromMouseEventParams struct {
 	// Type of the mouse event. Allowed values:
-	//	- MouseEvent.MousePressed
-	//	- MouseEvent.MouseReleased
-	//	- MouseEvent.MouseMoved
-	//	- MouseEvent.MouseWheel
+	//   - MouseEvent.MousePressed
+	//   - MouseEvent.MouseReleased
+	//   - MouseEvent.MouseMoved
+	//   - MouseEvent.MouseWheel
 	Type MouseEventEnum `json:"type"`
 
 	// X coordinate of the mouse pointer in DIP.
@@ -187,33 +327,34 @@ type EmulateTouchFromMouseEventParams struct {
 	// Y coordinate of the mouse pointer in DIP.
 	Y int `json:"y"`
 
-	// Time at which the event occurred.
-	Timestamp TimeSinceEpoch `json:"timestamp"`
-
-	// Optional. Mouse button (default: "none"). Allowed values:
-	//	- ButtonEvent.None
-	//	- ButtonEvent.Left
-	//	- ButtonEvent.Middle
-	//	- ButtonEvent.Right
+	// Mouse button. Only "none", "left", "right" are supported. Allowed values:
+	//   - ButtonEvent.None
+	//   - ButtonEvent.Left
+	//   - ButtonEvent.Middle
+	//   - ButtonEvent.Right
+	//   - ButtonEvent.Back
+	//   - ButtonEvent.Forward
 	Button ButtonEventEnum `json:"button"`
 
+	// Optional. Time at which the event occurred (default: current time).
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+
 	// Optional. X delta in DIP for mouse wheel event (default: 0).
-	DeltaX int `json:"deltaX,omitempty"`
+	DeltaX *float64 `json:"deltaX,omitempty"`
 
 	// Optional. Y delta in DIP for mouse wheel event (default: 0).
-	DeltaY int `json:"deltaY,omitempty"`
+	DeltaY *float64 `json:"deltaY,omitempty"`
 
 	// Optional. Bit field representing pressed modifier keys. Alt=1, Ctrl=2,
 	// Meta/Command=4, Shift=8 (default: 0).
-	Modifiers int `json:"modifiers,omitempty"`
+	Modifiers *int `json:"modifiers,omitempty"`
 
 	// Optional. Number of times the mouse button was clicked (default: 0).
-	ClickCount int `json:"clickCount,omitempty"`
+	ClickCount *int `json:"clickCount,omitempty"`
 }
 
 /*
-EmulateTouchFromMouseEventResult represents the result of calls to
-Input.emulateTouchFromMouseEvent.
+EmulateTouchFromMouseEventResult represents the result of calls to Input.emulateTouchFromMouseEvent.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouchFromMouseEvent
 */
@@ -233,15 +374,34 @@ type SetIgnoreEventsParams struct {
 }
 
 /*
-SetIgnoreEventsResult represents the result of calls to Input.setIgnoreEvents.
+SetIgnoreEventsResult represents the result of calls to Input.setIgnoreInputEvents.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreEvents
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreInputEvents
 */
 type SetIgnoreEventsResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+SetInterceptDragsParams represents Input.setInterceptDrags parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+*/
+type SetInterceptDragsParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetInterceptDragsResult represents the result of calls to Input.setInterceptDrags.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+*/
+type SetInterceptDragsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SynthesizePinchGestureParams represents Input.synthesizePinchGesture parameters.
 
@@ -255,14 +415,14 @@ type SynthesizePinchGestureParams struct {
 	Y float64 `json:"y"`
 
 	// Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
-	ScaleFactor int `json:"scaleFactor"`
+	ScaleFactor float64 `json:"scaleFactor"`
 
 	// Optional. Relative pointer speed in pixels per second (default: 800).
-	RelativeSpeed int `json:"relativeSpeed,omitempty"`
+	RelativeSpeed *int `json:"relativeSpeed,omitempty"`
 
 	// Optional. Which type of input events to be generated (default: 'default',
 	// which queries the platform for the preferred input type).
-	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
 }
 
 /*
@@ -282,52 +442,51 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeS
 */
 type SynthesizeScrollGestureParams struct {
 	// X coordinate of the start of the gesture in CSS pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the start of the gesture in CSS pixels.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
-	// Optional. The distance to scroll along the X axis (positive to scroll
-	// left).
-	XDistance int `json:"xDistance,omitempty"`
+	// Optional. The distance to scroll along the X axis (positive to scroll left).
+	XDistance *float64 `json:"xDistance,omitempty"`
 
 	// Optional. The distance to scroll along the Y axis (positive to scroll up).
-	YDistance int `json:"yDistance,omitempty"`
+	YDistance *float64 `json:"yDistance,omitempty"`
 
 	// Optional. The number of additional pixels to scroll back along the X axis,
 	// in addition to the given distance.
-	XOverscroll int `json:"xOverscroll,omitempty"`
+	XOverscroll *float64 `json:"xOverscroll,omitempty"`
 
 	// Optional. The number of additional pixels to scroll back along the Y axis,
 	// in addition to the given distance.
-	YOverscroll int `json:"yOverscroll,omitempty"`
+	YOverscroll *float64 `json:"yOverscroll,omitempty"`
 
 	// Optional. Prevent fling (default: true).
-	PreventFling bool `json:"preventFling,omitempty"`
+	PreventFling *bool `json:"preventFling,omitempty"`
 
 	// Optional. Swipe speed in pixels per second (default: 800).
-	Speed int `json:"speed,omitempty"`
+	Speed *int `json:"speed,omitempty"`
 
 	// Optional. Which type of input events to be generated (default: 'default',
 	// which queries the platform for the preferred input type).
-	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
 
 	// Optional. The number of times to repeat the gesture (default: 0).
-	RepeatCount int `json:"repeatCount,omitempty"`
+	RepeatCount *int `json:"repeatCount,omitempty"`
 
 	// Optional. The number of milliseconds delay between each repeat. (default:
 	// 250).
-	RepeatDelayMs int `json:"repeatDelayMs,omitempty"`
+	RepeatDelayMs *int `json:"repeatDelayMs,omitempty"`
 
 	// Optional. The name of the interaction markers to generate, if not empty
 	// (default: "").
-	InteractionMarkerName string `json:"interactionMarkerName,omitempty"`
+	InteractionMarkerName *string `json:"interactionMarkerName,omitempty"`
 }
 
 /*
-SynthesizeScrollGestureResult represents the result of calls to Input.SynthesizeScrollGesture.
+SynthesizeScrollGestureResult represents the result of calls to Input.synthesizeScrollGesture.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-SynthesizeScrollGesture
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeScrollGesture
 */
 type SynthesizeScrollGestureResult struct {
 	// Error information related to executing this method
@@ -341,28 +500,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeT
 */
 type SynthesizeTapGestureParams struct {
 	// X coordinate of the start of the gesture in CSS pixels.
-	X int `json:"x"`
+	X float64 `json:"x"`
 
 	// Y coordinate of the start of the gesture in CSS pixels.
-	Y int `json:"y"`
+	Y float64 `json:"y"`
 
-	// Optional. Duration between touchdown and touchup events in ms (default:
-	// 50).
-	Duration int `json:"duration,omitempty"`
+	// Optional. Duration between touchdown and touchup events in ms (default: 50).
+	Duration *int `json:"duration,omitempty"`
 
 	// Optional. Number of times to perform the tap (e.g. 2 for double tap,
 	// default: 1).
-	TapCount int `json:"tapCount,omitempty"`
+	TapCount *int `json:"tapCount,omitempty"`
 
 	// Optional. Which type of input events to be generated (default: 'default',
 	// which queries the platform for the preferred input type).
-	GestureSourceType GestureSourceType `json:"gestureSourceType,omitempty"`
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
 }
 
 /*
-SynthesizeTapGestureResult represents the result of calls to Input.SynthesizeTapGesture.
+SynthesizeTapGestureResult represents the result of calls to Input.synthesizeTapGesture.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-SynthesizeTapGesture
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeTapGesture
 */
 type SynthesizeTapGestureResult struct {
 	// Error information related to executing this method
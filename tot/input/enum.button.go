@@ -1,36 +1,46 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type buttonEventEnum struct {
-	None   ButtonEventEnum
-	Left   ButtonEventEnum
-	Middle ButtonEventEnum
-	Right  ButtonEventEnum
+	None    ButtonEventEnum
+	Left    ButtonEventEnum
+	Middle  ButtonEventEnum
+	Right   ButtonEventEnum
+	Back    ButtonEventEnum
+	Forward ButtonEventEnum
 }
 
 /*
-ButtonEvent provides named acces to the ButtonEventEnum values.
+ButtonEvent provides named access to the ButtonEventEnum values.
 */
 var ButtonEvent = buttonEventEnum{
-	None:   buttonEventNone,
-	Left:   buttonEventLeft,
-	Middle: buttonEventMiddle,
-	Right:  buttonEventRight,
+	None:    buttonEventNone,
+	Left:    buttonEventLeft,
+	Middle:  buttonEventMiddle,
+	Right:   buttonEventRight,
+	Back:    buttonEventBack,
+	Forward: buttonEventForward,
 }
 
 /*
-ButtonEventEnum represents the mouse button (default: "none"). Allowed values:
-	- ButtonEvent.None   "none"
-	- ButtonEvent.Left   "left"
-	- ButtonEvent.Middle "middle"
-	- ButtonEvent.Right  "right"
+ButtonEventEnum represents the Input.MouseButton values.
+
+Allowed values:
+  - ButtonEvent.None    "none"
+  - ButtonEvent.Left    "left"
+  - ButtonEvent.Middle  "middle"
+  - ButtonEvent.Right   "right"
+  - ButtonEvent.Back    "back"
+  - ButtonEvent.Forward "forward"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouchFromMouseEvent
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#type-MouseButton
 */
 type ButtonEventEnum int
 
@@ -38,7 +48,16 @@ type ButtonEventEnum int
 String implements Stringer
 */
 func (enum ButtonEventEnum) String() string {
-	return _buttonEventEnums[enum]
+	return _buttonEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ButtonEventEnum) Unknown() bool {
+	return !_buttonEventEnums.Known(enum)
 }
 
 /*
@@ -49,25 +68,16 @@ func (enum ButtonEventEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ButtonEventEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _buttonEventEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _buttonEventEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -79,12 +89,18 @@ const (
 	buttonEventMiddle
 	// buttonEventRight represents the "right" value.
 	buttonEventRight
+	// buttonEventBack represents the "back" value.
+	buttonEventBack
+	// buttonEventForward represents the "forward" value.
+	buttonEventForward
 )
 
-var _buttonEventEnums = map[ButtonEventEnum]string{
+var _buttonEventEnums = enums.New(map[ButtonEventEnum]string{
 	ButtonEventEnum(0): "",
 	buttonEventNone:    "none",
 	buttonEventLeft:    "left",
 	buttonEventMiddle:  "middle",
 	buttonEventRight:   "right",
-}
+	buttonEventBack:    "back",
+	buttonEventForward: "forward",
+})
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type typeEnum struct {
+	DragEnter  TypeEnum
+	DragOver   TypeEnum
+	Drop       TypeEnum
+	DragCancel TypeEnum
+}
+
+/*
+Type provides named access to the TypeEnum values.
+*/
+var Type = typeEnum{
+	DragEnter:  typeDragEnter,
+	DragOver:   typeDragOver,
+	Drop:       typeDrop,
+	DragCancel: typeDragCancel,
+}
+
+/*
+TypeEnum represents the Input.dispatchDragEvent.type values.
+
+Type of the drag event.
+
+Allowed values:
+  - Type.DragEnter  "dragEnter"
+  - Type.DragOver   "dragOver"
+  - Type.Drop       "drop"
+  - Type.DragCancel "dragCancel"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+*/
+type TypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TypeEnum) String() string {
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _typeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// typeDragEnter represents the "dragEnter" value.
+	typeDragEnter TypeEnum = iota + 1
+	// typeDragOver represents the "dragOver" value.
+	typeDragOver
+	// typeDrop represents the "drop" value.
+	typeDrop
+	// typeDragCancel represents the "dragCancel" value.
+	typeDragCancel
+)
+
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):    "",
+	typeDragEnter:  "dragEnter",
+	typeDragOver:   "dragOver",
+	typeDrop:       "drop",
+	typeDragCancel: "dragCancel",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumType(t *testing.T) {
+	var enum TypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Type.DragEnter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragEnter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dragEnter"`, result)
+	}
+	json.Unmarshal([]byte(`"dragEnter"`), &enum)
+	if Type.DragEnter != enum {
+		t.Errorf("Expected %d, got %d", Type.DragEnter, enum)
+	}
+
+	enum = Type.DragOver
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragOver"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dragOver"`, result)
+	}
+	json.Unmarshal([]byte(`"dragOver"`), &enum)
+	if Type.DragOver != enum {
+		t.Errorf("Expected %d, got %d", Type.DragOver, enum)
+	}
+
+	enum = Type.Drop
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"drop"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"drop"`, result)
+	}
+	json.Unmarshal([]byte(`"drop"`), &enum)
+	if Type.Drop != enum {
+		t.Errorf("Expected %d, got %d", Type.Drop, enum)
+	}
+
+	enum = Type.DragCancel
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dragCancel"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dragCancel"`, result)
+	}
+	json.Unmarshal([]byte(`"dragCancel"`), &enum)
+	if Type.DragCancel != enum {
+		t.Errorf("Expected %d, got %d", Type.DragCancel, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type pointerTypeEnum struct {
+	Mouse PointerTypeEnum
+	Pen   PointerTypeEnum
+}
+
+/*
+PointerType provides named access to the PointerTypeEnum values.
+*/
+var PointerType = pointerTypeEnum{
+	Mouse: pointerTypeMouse,
+	Pen:   pointerTypePen,
+}
+
+/*
+PointerTypeEnum represents the Input.dispatchMouseEvent.pointerType values.
+
+Pointer type (default: "mouse").
+
+Allowed values:
+  - PointerType.Mouse "mouse"
+  - PointerType.Pen   "pen"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
+*/
+type PointerTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PointerTypeEnum) String() string {
+	return _pointerTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PointerTypeEnum) Unknown() bool {
+	return !_pointerTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PointerTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PointerTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _pointerTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// pointerTypeMouse represents the "mouse" value.
+	pointerTypeMouse PointerTypeEnum = iota + 1
+	// pointerTypePen represents the "pen" value.
+	pointerTypePen
+)
+
+var _pointerTypeEnums = enums.New(map[PointerTypeEnum]string{
+	PointerTypeEnum(0): "",
+	pointerTypeMouse:   "mouse",
+	pointerTypePen:     "pen",
+})
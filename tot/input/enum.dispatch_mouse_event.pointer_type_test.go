@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPointerType(t *testing.T) {
+	var enum PointerTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PointerTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PointerType.Mouse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mouse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mouse"`, result)
+	}
+	json.Unmarshal([]byte(`"mouse"`), &enum)
+	if PointerType.Mouse != enum {
+		t.Errorf("Expected %d, got %d", PointerType.Mouse, enum)
+	}
+
+	enum = PointerType.Pen
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"pen"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"pen"`, result)
+	}
+	json.Unmarshal([]byte(`"pen"`), &enum)
+	if PointerType.Pen != enum {
+		t.Errorf("Expected %d, got %d", PointerType.Pen, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type keyEventEnum struct {
@@ -13,7 +16,7 @@ type keyEventEnum struct {
 }
 
 /*
-KeyEvent provides named acces to the KeyEventEnum values.
+KeyEvent provides named access to the KeyEventEnum values.
 */
 var KeyEvent = keyEventEnum{
 	KeyDown:    keyEventKeyDown,
@@ -23,11 +26,15 @@ var KeyEvent = keyEventEnum{
 }
 
 /*
-KeyEventEnum represents the type of the key event. Allowed values:
-	- KeyEvent.KeyDown    "keyDown"
-	- KeyEvent.KeyUp      "keyUp"
-	- KeyEvent.RawKeyDown "rawKeyDown"
-	- KeyEvent.Char       "char"
+KeyEventEnum represents the Input.dispatchKeyEvent.type values.
+
+Type of the key event.
+
+Allowed values:
+  - KeyEvent.KeyDown    "keyDown"
+  - KeyEvent.KeyUp      "keyUp"
+  - KeyEvent.RawKeyDown "rawKeyDown"
+  - KeyEvent.Char       "char"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKeyEvent
 */
@@ -37,7 +44,16 @@ type KeyEventEnum int
 String implements Stringer
 */
 func (enum KeyEventEnum) String() string {
-	return _keyEventEnums[enum]
+	return _keyEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum KeyEventEnum) Unknown() bool {
+	return !_keyEventEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum KeyEventEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *KeyEventEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _keyEventEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _keyEventEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -80,9 +87,10 @@ const (
 	keyEventChar
 )
 
-var _keyEventEnums = map[KeyEventEnum]string{
+var _keyEventEnums = enums.New(map[KeyEventEnum]string{
+	KeyEventEnum(0):    "",
 	keyEventKeyDown:    "keyDown",
 	keyEventKeyUp:      "keyUp",
 	keyEventRawKeyDown: "rawKeyDown",
 	keyEventChar:       "char",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
@@ -11,8 +13,24 @@ func TestEnumKeyEvent(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown KeyEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumKeyEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"keyDown"` != string(result) {
-		t.Errorf("Expected '\"keyDown\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"keyDown"`, result)
 	}
 	json.Unmarshal([]byte(`"keyDown"`), &enum)
 	if KeyEvent.KeyDown != enum {
-		t.Errorf("Expcected %d, got %d", KeyEvent.KeyDown, enum)
+		t.Errorf("Expected %d, got %d", KeyEvent.KeyDown, enum)
 	}
 
 	enum = KeyEvent.KeyUp
@@ -42,11 +60,11 @@ func TestEnumKeyEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"keyUp"` != string(result) {
-		t.Errorf("Expected '\"keyUp\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"keyUp"`, result)
 	}
 	json.Unmarshal([]byte(`"keyUp"`), &enum)
 	if KeyEvent.KeyUp != enum {
-		t.Errorf("Expcected %d, got %d", KeyEvent.KeyUp, enum)
+		t.Errorf("Expected %d, got %d", KeyEvent.KeyUp, enum)
 	}
 
 	enum = KeyEvent.RawKeyDown
@@ -55,18 +73,12 @@ func TestEnumKeyEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"rawKeyDown"` != string(result) {
-		t.Errorf("Expected '\"rawKeyDown\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"rawKeyDown"`, result)
 	}
 	json.Unmarshal([]byte(`"rawKeyDown"`), &enum)
 	if KeyEvent.RawKeyDown != enum {
-		t.Errorf("Expcected %d, got %d", KeyEvent.RawKeyDown, enum)
+		t.Errorf("Expected %d, got %d", KeyEvent.RawKeyDown, enum)
 	}
-}
-
-func TestEnumKeyEvent2(t *testing.T) {
-	var enum KeyEventEnum
-	var err error
-	var result []byte
 
 	enum = KeyEvent.Char
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumKeyEvent2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"char"` != string(result) {
-		t.Errorf("Expected '\"char\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"char"`, result)
 	}
 	json.Unmarshal([]byte(`"char"`), &enum)
 	if KeyEvent.Char != enum {
-		t.Errorf("Expcected %d, got %d", KeyEvent.Char, enum)
+		t.Errorf("Expected %d, got %d", KeyEvent.Char, enum)
 	}
 }
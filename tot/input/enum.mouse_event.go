@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type mouseEventEnum struct {
@@ -13,7 +16,7 @@ type mouseEventEnum struct {
 }
 
 /*
-MouseEvent provides named acces to the MouseEventEnum values.
+MouseEvent provides named access to the MouseEventEnum values.
 */
 var MouseEvent = mouseEventEnum{
 	MousePressed:  mouseEventMousePressed,
@@ -23,11 +26,15 @@ var MouseEvent = mouseEventEnum{
 }
 
 /*
-MouseEventEnum represents the type of the mouse event. Allowed values:
-	- MouseEvent.MousePressed  "mousePressed"
-	- MouseEvent.MouseReleased "mouseReleased"
-	- MouseEvent.MouseMoved    "mouseMoved"
-	- MouseEvent.MouseWheel    "mouseWheel"
+MouseEventEnum represents the Input.dispatchMouseEvent.type values.
+
+Type of the mouse event.
+
+Allowed values:
+  - MouseEvent.MousePressed  "mousePressed"
+  - MouseEvent.MouseReleased "mouseReleased"
+  - MouseEvent.MouseMoved    "mouseMoved"
+  - MouseEvent.MouseWheel    "mouseWheel"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouchFromMouseEvent
@@ -38,7 +45,16 @@ type MouseEventEnum int
 String implements Stringer
 */
 func (enum MouseEventEnum) String() string {
-	return _mouseEventEnums[enum]
+	return _mouseEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MouseEventEnum) Unknown() bool {
+	return !_mouseEventEnums.Known(enum)
 }
 
 /*
@@ -49,25 +65,16 @@ func (enum MouseEventEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *MouseEventEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _mouseEventEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _mouseEventEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -81,9 +88,10 @@ const (
 	mouseEventMouseWheel
 )
 
-var _mouseEventEnums = map[MouseEventEnum]string{
+var _mouseEventEnums = enums.New(map[MouseEventEnum]string{
+	MouseEventEnum(0):       "",
 	mouseEventMousePressed:  "mousePressed",
 	mouseEventMouseReleased: "mouseReleased",
 	mouseEventMouseMoved:    "mouseMoved",
 	mouseEventMouseWheel:    "mouseWheel",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
@@ -11,8 +13,24 @@ func TestEnumMouseEvent(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MouseEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumMouseEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mousePressed"` != string(result) {
-		t.Errorf("Expected '\"mousePressed\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mousePressed"`, result)
 	}
 	json.Unmarshal([]byte(`"mousePressed"`), &enum)
 	if MouseEvent.MousePressed != enum {
-		t.Errorf("Expcected %d, got %d", MouseEvent.MousePressed, enum)
+		t.Errorf("Expected %d, got %d", MouseEvent.MousePressed, enum)
 	}
 
 	enum = MouseEvent.MouseReleased
@@ -42,11 +60,11 @@ func TestEnumMouseEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mouseReleased"` != string(result) {
-		t.Errorf("Expected '\"mouseReleased\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mouseReleased"`, result)
 	}
 	json.Unmarshal([]byte(`"mouseReleased"`), &enum)
 	if MouseEvent.MouseReleased != enum {
-		t.Errorf("Expcected %d, got %d", MouseEvent.MouseReleased, enum)
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseReleased, enum)
 	}
 
 	enum = MouseEvent.MouseMoved
@@ -55,18 +73,12 @@ func TestEnumMouseEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mouseMoved"` != string(result) {
-		t.Errorf("Expected '\"mouseMoved\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mouseMoved"`, result)
 	}
 	json.Unmarshal([]byte(`"mouseMoved"`), &enum)
 	if MouseEvent.MouseMoved != enum {
-		t.Errorf("Expcected %d, got %d", MouseEvent.MouseMoved, enum)
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseMoved, enum)
 	}
-}
-
-func TestEnumMouseEvent2(t *testing.T) {
-	var enum MouseEventEnum
-	var err error
-	var result []byte
 
 	enum = MouseEvent.MouseWheel
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumMouseEvent2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mouseWheel"` != string(result) {
-		t.Errorf("Expected '\"mouseWheel\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mouseWheel"`, result)
 	}
 	json.Unmarshal([]byte(`"mouseWheel"`), &enum)
 	if MouseEvent.MouseWheel != enum {
-		t.Errorf("Expcected %d, got %d", MouseEvent.MouseWheel, enum)
+		t.Errorf("Expected %d, got %d", MouseEvent.MouseWheel, enum)
 	}
 }
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type touchEventEnum struct {
@@ -13,7 +16,7 @@ type touchEventEnum struct {
 }
 
 /*
-TouchEvent provides named acces to the TouchEventEnum values.
+TouchEvent provides named access to the TouchEventEnum values.
 */
 var TouchEvent = touchEventEnum{
 	TouchStart:  touchEventTouchStart,
@@ -23,15 +26,18 @@ var TouchEvent = touchEventEnum{
 }
 
 /*
-TouchEventEnum represents the type of the touch event. TouchEnd and TouchCancel
-must not contain any touch points, while TouchStart and TouchMove must contains
-at least one. Allowed values:
-	- TouchEvent.TouchStart  "touchStart"
-	- TouchEvent.TouchEnd    "touchEnd"
-	- TouchEvent.TouchMove   "touchMove"
-	- TouchEvent.TouchCancel "touchCancel"
+TouchEventEnum represents the Input.dispatchTouchEvent.type values.
+
+Type of the touch event. TouchEnd and TouchCancel must not contain any touch
+points, while TouchStart and TouchMove must contains at least one.
+
+Allowed values:
+  - TouchEvent.TouchStart  "touchStart"
+  - TouchEvent.TouchEnd    "touchEnd"
+  - TouchEvent.TouchMove   "touchMove"
+  - TouchEvent.TouchCancel "touchCancel"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTouchEvent
 */
 type TouchEventEnum int
 
@@ -39,7 +45,16 @@ type TouchEventEnum int
 String implements Stringer
 */
 func (enum TouchEventEnum) String() string {
-	return _touchEventEnums[enum]
+	return _touchEventEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TouchEventEnum) Unknown() bool {
+	return !_touchEventEnums.Known(enum)
 }
 
 /*
@@ -50,25 +65,16 @@ func (enum TouchEventEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *TouchEventEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _touchEventEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _touchEventEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -82,9 +88,10 @@ const (
 	touchEventTouchCancel
 )
 
-var _touchEventEnums = map[TouchEventEnum]string{
+var _touchEventEnums = enums.New(map[TouchEventEnum]string{
+	TouchEventEnum(0):     "",
 	touchEventTouchStart:  "touchStart",
 	touchEventTouchEnd:    "touchEnd",
 	touchEventTouchMove:   "touchMove",
 	touchEventTouchCancel: "touchCancel",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package input
 
 import (
@@ -11,8 +13,24 @@ func TestEnumTouchEvent(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TouchEventEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumTouchEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"touchStart"` != string(result) {
-		t.Errorf("Expected '\"touchStart\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"touchStart"`, result)
 	}
 	json.Unmarshal([]byte(`"touchStart"`), &enum)
 	if TouchEvent.TouchStart != enum {
-		t.Errorf("Expcected %d, got %d", TouchEvent.TouchStart, enum)
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchStart, enum)
 	}
 
 	enum = TouchEvent.TouchEnd
@@ -42,11 +60,11 @@ func TestEnumTouchEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"touchEnd"` != string(result) {
-		t.Errorf("Expected '\"touchEnd\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"touchEnd"`, result)
 	}
 	json.Unmarshal([]byte(`"touchEnd"`), &enum)
 	if TouchEvent.TouchEnd != enum {
-		t.Errorf("Expcected %d, got %d", TouchEvent.TouchEnd, enum)
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchEnd, enum)
 	}
 
 	enum = TouchEvent.TouchMove
@@ -55,18 +73,12 @@ func TestEnumTouchEvent(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"touchMove"` != string(result) {
-		t.Errorf("Expected '\"touchMove\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"touchMove"`, result)
 	}
 	json.Unmarshal([]byte(`"touchMove"`), &enum)
 	if TouchEvent.TouchMove != enum {
-		t.Errorf("Expcected %d, got %d", TouchEvent.TouchMove, enum)
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchMove, enum)
 	}
-}
-
-func TestEnumTouchEvent2(t *testing.T) {
-	var enum TouchEventEnum
-	var err error
-	var result []byte
 
 	enum = TouchEvent.TouchCancel
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumTouchEvent2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"touchCancel"` != string(result) {
-		t.Errorf("Expected '\"touchCancel\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"touchCancel"`, result)
 	}
 	json.Unmarshal([]byte(`"touchCancel"`), &enum)
 	if TouchEvent.TouchCancel != enum {
-		t.Errorf("Expcected %d, got %d", TouchEvent.TouchCancel, enum)
+		t.Errorf("Expected %d, got %d", TouchEvent.TouchCancel, enum)
 	}
 }
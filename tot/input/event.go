@@ -0,0 +1,15 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package input
+
+/*
+DragInterceptedEvent represents Input.dragIntercepted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#event-dragIntercepted
+*/
+type DragInterceptedEvent struct {
+	Data *DragData `json:"data"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
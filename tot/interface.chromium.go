@@ -1,6 +1,11 @@
 package chrome
 
-import "net/url"
+import (
+	"context"
+	"net/url"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
 
 /*
 Chromium defines an interface for interacting with Chromium based web browsers
@@ -14,9 +19,19 @@ type Chromium interface {
 	// default value such as '/usr/bin/google-chrome'.
 	Binary() string
 
+	// Browser returns a connected socket bound to the browser target rather
+	// than a page, for browser-wide operations such as Target, Browser,
+	// SystemInfo and Tracing commands.
+	Browser() (*socket.Socket, error)
+
 	// Close ends the Chromium process and cleans up.
 	Close() error
 
+	// DetectCapabilities reads and caches the browser's protocol description
+	// so the browser connection and tabs fail fast on unsupported commands
+	// and events.
+	DetectCapabilities(ctx context.Context) (*socket.Capabilities, error)
+
 	// GetTab returns an open Tabber instance, or an error if the requested tab
 	// does not exist.
 	GetTab(tabID string) (tab Tabber, err error)
@@ -41,9 +56,22 @@ type Chromium interface {
 	// struct.
 	Launch() error
 
+	// NewBrowserContext creates an isolated browser context, similar to an
+	// incognito profile.
+	NewBrowserContext() (*BrowserContext, error)
+
 	// NewTab spawns a new tab and returns a reference to it.
 	NewTab(url string) (*Tab, error)
 
+	// OnNewPage adds a handler that is called when a new page target is added
+	// to the list of open tabs, including popups opened by the page and tabs
+	// created with NewTab.
+	OnNewPage(callback func(tab *Tab))
+
+	// OnUnexpectedExit adds a handler that is called when the Chromium
+	// process exits without being stopped by Close.
+	OnUnexpectedExit(callback func(exit *ProcessExit))
+
 	// Port returns the port number the developer tools endpoints will listen
 	// on. Should return a sane default value such as 9222.
 	Port() int
@@ -1,6 +1,9 @@
 package chrome
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -12,10 +15,34 @@ type Socketer interface {
 	// event.
 	AddEventHandler(handler socket.EventHandler)
 
+	// Capabilities returns the protocol capabilities of the connected browser,
+	// or nil if they have not been detected or set.
+	Capabilities() *socket.Capabilities
+
+	// Call sends a protocol command by name and unmarshals its result into
+	// result.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
 	// RemoveEventHandler removes a handler from the stack of listeners for an
 	// event.
 	RemoveEventHandler(handler socket.EventHandler)
 
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command socket.Commander) *socket.Payload
+
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and waits for the response or for ctx to end.
+	SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error)
+
+	// Subscribe returns a channel that receives the raw parameters of each
+	// event named by method, and a function that cancels the subscription.
+	Subscribe(method string) (<-chan json.RawMessage, func())
+
+	// Supports returns whether the connected browser supports a domain,
+	// command or event, for example "Page.printToPDF".
+	Supports(name string) bool
+
+	// WaitFor returns a Waiter for the first event named by eventName whose
+	// raw parameters match predicate.
+	WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *socket.Waiter[json.RawMessage]
 }
@@ -16,6 +16,14 @@ type Tabber interface {
 	// Close closes this chromium tab
 	Close() (interface{}, error)
 
+	// Crashed returns whether the tab's target has crashed. Commands sent to a
+	// crashed tab will not complete and the tab should be closed.
+	Crashed() bool
+
+	// Detach disconnects from this tab and removes it from the list of open
+	// tabs without closing it.
+	Detach()
+
 	// Data returns the tab metadata
 	Data() *TabData
 
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package io
 
 import (
@@ -33,13 +35,14 @@ type ReadParams struct {
 	// Handle of the stream to read.
 	Handle StreamHandle `json:"handle"`
 
-	// Optional. Seek to the specified offset before reading (if not specificed,
-	// proceed with offset following the last read).
-	Offset int `json:"offset,omitempty"`
+	// Optional. Seek to the specified offset before reading (if not specified,
+	// proceed with offset following the last read). Some types of streams may only
+	// support sequential reads.
+	Offset *int `json:"offset,omitempty"`
 
-	// Optional. Maximum number of bytes to read (left upon the agent discretion
-	// if not specified).
-	Size int `json:"size,omitempty"`
+	// Optional. Maximum number of bytes to read (left upon the agent discretion if
+	// not specified).
+	Size *int `json:"size,omitempty"`
 }
 
 /*
@@ -48,8 +51,8 @@ ReadResult represents the result of calls to IO.read.
 https://chromedevtools.github.io/devtools-protocol/tot/IO/#method-read
 */
 type ReadResult struct {
-	// Set if the data is base64-encoded.
-	Base64Encoded bool `json:"base64Encoded"`
+	// Optional. Set if the data is base64-encoded
+	Base64Encoded bool `json:"base64Encoded,omitempty"`
 
 	// Data that were read.
 	Data string `json:"data"`
@@ -67,7 +70,7 @@ ResolveBlobParams represents IO.resolveBlob parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/IO/#method-resolveBlob
 */
 type ResolveBlobParams struct {
-	// Object ID of a Blob object wrapper.
+	// Object id of a Blob object wrapper.
 	ObjectID runtime.RemoteObjectID `json:"objectId"`
 }
 
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package tree provides type definitions for use with the Chrome LayerTree protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/
 */
 package tree
@@ -10,21 +14,27 @@ import (
 )
 
 /*
-LayerID is a unique Layer identifier.
+LayerID represents the LayerTree.LayerId type.
+
+Unique Layer identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-LayerId
 */
 type LayerID string
 
 /*
-SnapshotID is a unique snapshot identifier.
+SnapshotID represents the LayerTree.SnapshotId type.
+
+Unique snapshot identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-SnapshotId
 */
 type SnapshotID string
 
 /*
-ScrollRect is a rectangle where scrolling happens on the main thread.
+ScrollRect represents the LayerTree.ScrollRect type.
+
+Rectangle where scrolling happens on the main thread.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-ScrollRect
 */
@@ -32,62 +42,68 @@ type ScrollRect struct {
 	// Rectangle itself.
 	Rect *dom.Rect `json:"rect"`
 
-	// Reason for rectangle to force scrolling on the main thread. Allowed
-	// values:
-	//	- RectType.RepaintsOnScroll
-	//	- RectType.TouchEventHandler
-	//	- RectType.WheelEventHandler
+	// Reason for rectangle to force scrolling on the main thread Allowed values:
+	//   - RectType.RepaintsOnScroll
+	//   - RectType.TouchEventHandler
+	//   - RectType.WheelEventHandler
 	Type RectTypeEnum `json:"type"`
 }
 
 /*
-StickyPositionConstraint is sticky position constraints.
+StickyPositionConstraint represents the LayerTree.StickyPositionConstraint type.
+
+Sticky position constraints.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-StickyPositionConstraint
 */
 type StickyPositionConstraint struct {
-	// Layout rectangle of the sticky element before being shifted.
+	// Layout rectangle of the sticky element before being shifted
 	StickyBoxRect *dom.Rect `json:"stickyBoxRect"`
 
-	// Layout rectangle of the containing block of the sticky element.
+	// Layout rectangle of the containing block of the sticky element
 	ContainingBlockRect *dom.Rect `json:"containingBlockRect"`
 
-	// Optional. The nearest sticky layer that shifts the sticky box.
+	// Optional. The nearest sticky layer that shifts the sticky box
 	NearestLayerShiftingStickyBox LayerID `json:"nearestLayerShiftingStickyBox,omitempty"`
 
-	// Optional. The nearest sticky layer that shifts the containing block.
+	// Optional. The nearest sticky layer that shifts the containing block
 	NearestLayerShiftingContainingBlock LayerID `json:"nearestLayerShiftingContainingBlock,omitempty"`
 }
 
 /*
-PictureTile is a serialized fragment of layer picture along with its offset within the layer.
+PictureTile represents the LayerTree.PictureTile type.
+
+Serialized fragment of layer picture along with its offset within the layer.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-PictureTile
 */
 type PictureTile struct {
-	// Offset from owning layer left boundary.
+	// Offset from owning layer left boundary
 	X float64 `json:"x"`
 
-	// Offset from owning layer top boundary.
+	// Offset from owning layer top boundary
 	Y float64 `json:"y"`
 
-	// Base64-encoded snapshot data.
+	// Base64-encoded snapshot data. (Encoded as a base64 string when passed over
+	// JSON)
 	Picture string `json:"picture"`
 }
 
 /*
-Layer is information about a compositing layer.
+Layer represents the LayerTree.Layer type.
+
+Information about a compositing layer.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-Layer
 */
 type Layer struct {
-	// The unique ID for this layer.
+	// The unique id for this layer.
 	LayerID LayerID `json:"layerId"`
 
-	// Optional. The ID of parent (not present for root).
+	// Optional. The id of parent (not present for root).
 	ParentLayerID LayerID `json:"parentLayerId,omitempty"`
 
-	// Optional. The backend ID for the node associated with this layer.
+	// Optional. The backend id for the node associated with this layer.
 	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
 
 	// Offset from parent layer, X coordinate.
@@ -102,23 +118,23 @@ type Layer struct {
 	// Layer height.
 	Height float64 `json:"height"`
 
-	// Optional. Transformation matrix for layer, default is identity matrix.
+	// Optional. Transformation matrix for layer, default is identity matrix
 	Transform []float64 `json:"transform,omitempty"`
 
-	// Optional. Transform anchor point X, absent if no transform specified.
+	// Optional. Transform anchor point X, absent if no transform specified
 	AnchorX float64 `json:"anchorX,omitempty"`
 
-	// Optional. Transform anchor point Y, absent if no transform specified.
+	// Optional. Transform anchor point Y, absent if no transform specified
 	AnchorY float64 `json:"anchorY,omitempty"`
 
-	// Optional. Transform anchor point Z, absent if no transform specified.
+	// Optional. Transform anchor point Z, absent if no transform specified
 	AnchorZ float64 `json:"anchorZ,omitempty"`
 
 	// Indicates how many time this layer has painted.
 	PaintCount int `json:"paintCount"`
 
-	// Indicates whether this layer hosts any content, rather than being used
-	// for transform/scrolling purposes only.
+	// Indicates whether this layer hosts any content, rather than being used for
+	// transform/scrolling purposes only.
 	DrawsContent bool `json:"drawsContent"`
 
 	// Optional. Set if layer is not visible.
@@ -127,13 +143,15 @@ type Layer struct {
 	// Optional. Rectangles scrolling on main thread only.
 	ScrollRects []*ScrollRect `json:"scrollRects,omitempty"`
 
-	// Optional. Sticky position constraint information.
+	// Optional. Sticky position constraint information
 	StickyPositionConstraint *StickyPositionConstraint `json:"stickyPositionConstraint,omitempty"`
 }
 
 /*
-PaintProfile is an array of timings, one per paint step.
+PaintProfile represents the LayerTree.PaintProfile type.
+
+Array of timings, one per paint step.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-PaintProfile
 */
-type PaintProfile []interface{}
+type PaintProfile []float64
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tree
 
 import (
@@ -10,8 +12,7 @@ CompositingReasonsParams represents LayerTree.compositingReasons parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-compositingReasons
 */
 type CompositingReasonsParams struct {
-	// The ID of the layer for which we want to get the reasons it was
-	// composited.
+	// The id of the layer for which we want to get the reasons it was composited.
 	LayerID LayerID `json:"layerId"`
 }
 
@@ -25,14 +26,18 @@ type CompositingReasonsResult struct {
 	// composited.
 	CompositingReasons []string `json:"compositingReasons"`
 
+	// A list of strings specifying reason IDs for the given layer to become
+	// composited.
+	CompositingReasonIDs []string `json:"compositingReasonIds"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-DisableResult represents the result of calls to LayerTree.Disable.
+DisableResult represents the result of calls to LayerTree.disable.
 
-https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-Disable
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-disable
 */
 type DisableResult struct {
 	// Error information related to executing this method
@@ -65,7 +70,7 @@ LoadSnapshotResult represents the result of calls to LayerTree.loadSnapshot.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-loadSnapshot
 */
 type LoadSnapshotResult struct {
-	// The ID of the snapshot.
+	// The id of the snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 
 	// Error information related to executing this method
@@ -78,7 +83,7 @@ MakeSnapshotParams represents LayerTree.makeSnapshot parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSnapshot
 */
 type MakeSnapshotParams struct {
-	// The ID of the layer.
+	// The id of the layer.
 	LayerID LayerID `json:"layerId"`
 }
 
@@ -88,7 +93,7 @@ MakeSnapshotResult represents the result of calls to LayerTree.makeSnapshot.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSnapshot
 */
 type MakeSnapshotResult struct {
-	// The ID of the layer snapshot.
+	// The id of the layer snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 
 	// Error information related to executing this method
@@ -101,15 +106,15 @@ ProfileSnapshotParams represents LayerTree.profileSnapshot parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profileSnapshot
 */
 type ProfileSnapshotParams struct {
-	// The ID of the layer snapshot.
+	// The id of the layer snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 
 	// Optional. The maximum number of times to replay the snapshot (1, if not
 	// specified).
-	MinRepeatCount int `json:"minRepeatCount,omitempty"`
+	MinRepeatCount *int `json:"minRepeatCount,omitempty"`
 
 	// Optional. The minimum duration (in seconds) to replay the snapshot.
-	MinDuration float64 `json:"minDuration,omitempty"`
+	MinDuration *float64 `json:"minDuration,omitempty"`
 
 	// Optional. The clip rectangle to apply when replaying the snapshot.
 	ClipRect *dom.Rect `json:"clipRect,omitempty"`
@@ -122,7 +127,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profile
 */
 type ProfileSnapshotResult struct {
 	// The array of paint profiles, one per run.
-	Timings []*PaintProfile `json:"timings"`
+	Timings []PaintProfile `json:"timings"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -134,7 +139,7 @@ ReleaseSnapshotParams represents LayerTree.releaseSnapshot parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-releaseSnapshot
 */
 type ReleaseSnapshotParams struct {
-	// The ID of the layer snapshot.
+	// The id of the layer snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 }
 
@@ -154,18 +159,18 @@ ReplaySnapshotParams represents LayerTree.replaySnapshot parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-replaySnapshot
 */
 type ReplaySnapshotParams struct {
-	// The ID of the layer snapshot.
+	// The id of the layer snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 
-	// Optional. The first step to replay from (replay from the very start if
-	// not specified).
-	FromStep int `json:"fromStep,omitempty"`
+	// Optional. The first step to replay from (replay from the very start if not
+	// specified).
+	FromStep *int `json:"fromStep,omitempty"`
 
 	// Optional. The last step to replay to (replay till the end if not specified).
-	ToStep int `json:"toStep,omitempty"`
+	ToStep *int `json:"toStep,omitempty"`
 
 	// Optional. The scale to apply while replaying (defaults to 1).
-	Scale float64 `json:"scale,omitempty"`
+	Scale *float64 `json:"scale,omitempty"`
 }
 
 /*
@@ -187,7 +192,7 @@ SnapshotCommandLogParams represents LayerTree.snapshotCommandLog parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapshotCommandLog
 */
 type SnapshotCommandLogParams struct {
-	// The ID of the layer snapshot.
+	// The id of the layer snapshot.
 	SnapshotID SnapshotID `json:"snapshotId"`
 }
 
@@ -198,7 +203,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapsho
 */
 type SnapshotCommandLogResult struct {
 	// The array of canvas function calls.
-	CommandLog []map[string]string `json:"commandLog"`
+	CommandLog []map[string]interface{} `json:"commandLog"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tree
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type rectTypeEnum struct {
@@ -12,7 +15,7 @@ type rectTypeEnum struct {
 }
 
 /*
-RectType provides named acces to the RectTypeEnum values.
+RectType provides named access to the RectTypeEnum values.
 */
 var RectType = rectTypeEnum{
 	RepaintsOnScroll:  rectTypeRepaintsOnScroll,
@@ -21,11 +24,14 @@ var RectType = rectTypeEnum{
 }
 
 /*
-RectTypeEnum represents the reason for a rectangle to force scrolling on the
-main thread. Allowed values:
-	- RectType.RepaintsOnScroll  "RepaintsOnScroll"
-	- RectType.TouchEventHandler "TouchEventHandler"
-	- RectType.WheelEventHandler "WheelEventHandler"
+RectTypeEnum represents the LayerTree.ScrollRect.type values.
+
+# Reason for rectangle to force scrolling on the main thread
+
+Allowed values:
+  - RectType.RepaintsOnScroll  "RepaintsOnScroll"
+  - RectType.TouchEventHandler "TouchEventHandler"
+  - RectType.WheelEventHandler "WheelEventHandler"
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#type-ScrollRect
 */
@@ -35,7 +41,16 @@ type RectTypeEnum int
 String implements Stringer
 */
 func (enum RectTypeEnum) String() string {
-	return _rectTypeEnums[enum]
+	return _rectTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RectTypeEnum) Unknown() bool {
+	return !_rectTypeEnums.Known(enum)
 }
 
 /*
@@ -46,25 +61,16 @@ func (enum RectTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *RectTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _rectTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _rectTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -76,8 +82,9 @@ const (
 	rectTypeWheelEventHandler
 )
 
-var _rectTypeEnums = map[RectTypeEnum]string{
+var _rectTypeEnums = enums.New(map[RectTypeEnum]string{
+	RectTypeEnum(0):           "",
 	rectTypeRepaintsOnScroll:  "RepaintsOnScroll",
 	rectTypeTouchEventHandler: "TouchEventHandler",
 	rectTypeWheelEventHandler: "WheelEventHandler",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tree
 
 import (
@@ -11,8 +13,24 @@ func TestEnumRectType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RectTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumRectType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"RepaintsOnScroll"` != string(result) {
-		t.Errorf("Expected '\"RepaintsOnScroll\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"RepaintsOnScroll"`, result)
 	}
 	json.Unmarshal([]byte(`"RepaintsOnScroll"`), &enum)
 	if RectType.RepaintsOnScroll != enum {
-		t.Errorf("Expcected %d, got %d", RectType.RepaintsOnScroll, enum)
+		t.Errorf("Expected %d, got %d", RectType.RepaintsOnScroll, enum)
 	}
 
 	enum = RectType.TouchEventHandler
@@ -42,11 +60,11 @@ func TestEnumRectType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"TouchEventHandler"` != string(result) {
-		t.Errorf("Expected '\"TouchEventHandler\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"TouchEventHandler"`, result)
 	}
 	json.Unmarshal([]byte(`"TouchEventHandler"`), &enum)
 	if RectType.TouchEventHandler != enum {
-		t.Errorf("Expcected %d, got %d", RectType.TouchEventHandler, enum)
+		t.Errorf("Expected %d, got %d", RectType.TouchEventHandler, enum)
 	}
 
 	enum = RectType.WheelEventHandler
@@ -55,10 +73,10 @@ func TestEnumRectType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"WheelEventHandler"` != string(result) {
-		t.Errorf("Expected '\"WheelEventHandler\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"WheelEventHandler"`, result)
 	}
 	json.Unmarshal([]byte(`"WheelEventHandler"`), &enum)
 	if RectType.WheelEventHandler != enum {
-		t.Errorf("Expcected %d, got %d", RectType.WheelEventHandler, enum)
+		t.Errorf("Expected %d, got %d", RectType.WheelEventHandler, enum)
 	}
 }
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package log provides type definitions for use with the Chrome Log protocol
 
+Provides access to log entries.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Log/
 */
 package log
@@ -11,37 +15,43 @@ import (
 )
 
 /*
-Entry is a log entry.
+Entry represents the Log.LogEntry type.
+
+Log entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#type-LogEntry
 */
 type Entry struct {
 	// Log entry source. Allowed values:
-	//	- Source.XML
-	//	- Source.Javascript
-	//	- Source.Network
-	//	- Source.Storage
-	//	- Source.Appcache
-	//	- Source.Rendering
-	//	- Source.Security
-	//	- Source.Deprecation
-	//	- Source.Worker
-	//	- Source.Violation
-	//	- Source.Intervention
-	//	- Source.Recommendation
-	//	- Source.Other
+	//   - Source.XML
+	//   - Source.Javascript
+	//   - Source.Network
+	//   - Source.Storage
+	//   - Source.Appcache
+	//   - Source.Rendering
+	//   - Source.Security
+	//   - Source.Deprecation
+	//   - Source.Worker
+	//   - Source.Violation
+	//   - Source.Intervention
+	//   - Source.Recommendation
+	//   - Source.Other
 	Source SourceEnum `json:"source"`
 
 	// Log entry severity. Allowed values:
-	//	- Level.Verbose
-	//	- Level.Info
-	//	- Level.Warning
-	//	- Level.Error
+	//   - Level.Verbose
+	//   - Level.Info
+	//   - Level.Warning
+	//   - Level.Error
 	Level LevelEnum `json:"level"`
 
 	// Logged text.
 	Text string `json:"text"`
 
+	// Optional. Allowed values:
+	//   - Category.Cors
+	Category CategoryEnum `json:"category,omitempty"`
+
 	// Timestamp when this entry was added.
 	Timestamp runtime.Timestamp `json:"timestamp"`
 
@@ -65,21 +75,23 @@ type Entry struct {
 }
 
 /*
-ViolationSetting is a violation configuration setting.
+ViolationSetting represents the Log.ViolationSetting type.
+
+Violation configuration setting.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#type-ViolationSetting
 */
 type ViolationSetting struct {
 	// Violation type. Allowed values:
-	//	- Name.LongTask
-	//	- Name.LongLayout
-	//	- Name.BlockedEvent
-	//	- Name.BlockedParser
-	//	- Name.DiscouragedAPIUse
-	//	- Name.Handler
-	//	- Name.RecurringHandler
+	//   - Name.LongTask
+	//   - Name.LongLayout
+	//   - Name.BlockedEvent
+	//   - Name.BlockedParser
+	//   - Name.DiscouragedAPIUse
+	//   - Name.Handler
+	//   - Name.RecurringHandler
 	Name NameEnum `json:"name"`
 
 	// Time threshold to trigger upon.
-	Threshold int `json:"threshold"`
+	Threshold float64 `json:"threshold"`
 }
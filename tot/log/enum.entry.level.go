@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type levelEnum struct {
@@ -13,7 +16,7 @@ type levelEnum struct {
 }
 
 /*
-Level provides named acces to the LevelEnum values.
+Level provides named access to the LevelEnum values.
 */
 var Level = levelEnum{
 	Verbose: levelVerbose,
@@ -23,11 +26,15 @@ var Level = levelEnum{
 }
 
 /*
-LevelEnum represents the log entry severity. Allowed values:
-	- Level.Verbose "verbose"
-	- Level.Info    "info"
-	- Level.Warning "warning"
-	- Level.Error   "error"
+LevelEnum represents the Log.LogEntry.level values.
+
+Log entry severity.
+
+Allowed values:
+  - Level.Verbose "verbose"
+  - Level.Info    "info"
+  - Level.Warning "warning"
+  - Level.Error   "error"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#type-LogEntry
 */
@@ -37,7 +44,16 @@ type LevelEnum int
 String implements Stringer
 */
 func (enum LevelEnum) String() string {
-	return _levelEnums[enum]
+	return _levelEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum LevelEnum) Unknown() bool {
+	return !_levelEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum LevelEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *LevelEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _levelEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _levelEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -80,9 +87,10 @@ const (
 	levelError
 )
 
-var _levelEnums = map[LevelEnum]string{
+var _levelEnums = enums.New(map[LevelEnum]string{
+	LevelEnum(0): "",
 	levelVerbose: "verbose",
 	levelInfo:    "info",
 	levelWarning: "warning",
 	levelError:   "error",
-}
+})
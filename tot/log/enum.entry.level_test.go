@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
@@ -11,8 +13,24 @@ func TestEnumLevel(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown LevelEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumLevel(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"verbose"` != string(result) {
-		t.Errorf("Expected '\"verbose\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"verbose"`, result)
 	}
 	json.Unmarshal([]byte(`"verbose"`), &enum)
 	if Level.Verbose != enum {
-		t.Errorf("Expcected %d, got %d", Level.Verbose, enum)
+		t.Errorf("Expected %d, got %d", Level.Verbose, enum)
 	}
 
 	enum = Level.Info
@@ -42,11 +60,11 @@ func TestEnumLevel(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"info"` != string(result) {
-		t.Errorf("Expected '\"info\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"info"`, result)
 	}
 	json.Unmarshal([]byte(`"info"`), &enum)
 	if Level.Info != enum {
-		t.Errorf("Expcected %d, got %d", Level.Info, enum)
+		t.Errorf("Expected %d, got %d", Level.Info, enum)
 	}
 
 	enum = Level.Warning
@@ -55,18 +73,12 @@ func TestEnumLevel(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"warning"` != string(result) {
-		t.Errorf("Expected '\"warning\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"warning"`, result)
 	}
 	json.Unmarshal([]byte(`"warning"`), &enum)
 	if Level.Warning != enum {
-		t.Errorf("Expcected %d, got %d", Level.Warning, enum)
+		t.Errorf("Expected %d, got %d", Level.Warning, enum)
 	}
-}
-
-func TestEnumLevel2(t *testing.T) {
-	var enum LevelEnum
-	var err error
-	var result []byte
 
 	enum = Level.Error
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumLevel2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"error"` != string(result) {
-		t.Errorf("Expected '\"error\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"error"`, result)
 	}
 	json.Unmarshal([]byte(`"error"`), &enum)
 	if Level.Error != enum {
-		t.Errorf("Expcected %d, got %d", Level.Error, enum)
+		t.Errorf("Expected %d, got %d", Level.Error, enum)
 	}
 }
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type sourceEnum struct {
@@ -22,7 +25,7 @@ type sourceEnum struct {
 }
 
 /*
-Source provides named acces to the SourceEnum values.
+Source provides named access to the SourceEnum values.
 */
 var Source = sourceEnum{
 	XML:            sourceXML,
@@ -41,20 +44,24 @@ var Source = sourceEnum{
 }
 
 /*
-SourceEnum represents the log entry source. Allowed values:
-	- Source.XML            "xml"
-	- Source.Javascript     "javascript"
-	- Source.Network        "network"
-	- Source.Storage        "storage"
-	- Source.Appcache       "appcache"
-	- Source.Rendering      "rendering"
-	- Source.Security       "security"
-	- Source.Deprecation    "deprecation"
-	- Source.Worker         "worker"
-	- Source.Violation      "violation"
-	- Source.Intervention   "intervention"
-	- Source.Recommendation "recommendation"
-	- Source.Other          "other"
+SourceEnum represents the Log.LogEntry.source values.
+
+Log entry source.
+
+Allowed values:
+  - Source.XML            "xml"
+  - Source.Javascript     "javascript"
+  - Source.Network        "network"
+  - Source.Storage        "storage"
+  - Source.Appcache       "appcache"
+  - Source.Rendering      "rendering"
+  - Source.Security       "security"
+  - Source.Deprecation    "deprecation"
+  - Source.Worker         "worker"
+  - Source.Violation      "violation"
+  - Source.Intervention   "intervention"
+  - Source.Recommendation "recommendation"
+  - Source.Other          "other"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#type-LogEntry
 */
@@ -64,7 +71,16 @@ type SourceEnum int
 String implements Stringer
 */
 func (enum SourceEnum) String() string {
-	return _sourceEnums[enum]
+	return _sourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SourceEnum) Unknown() bool {
+	return !_sourceEnums.Known(enum)
 }
 
 /*
@@ -75,25 +91,16 @@ func (enum SourceEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *SourceEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _sourceEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _sourceEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -125,7 +132,8 @@ const (
 	sourceOther
 )
 
-var _sourceEnums = map[SourceEnum]string{
+var _sourceEnums = enums.New(map[SourceEnum]string{
+	SourceEnum(0):        "",
 	sourceXML:            "xml",
 	sourceJavascript:     "javascript",
 	sourceNetwork:        "network",
@@ -139,4 +147,4 @@ var _sourceEnums = map[SourceEnum]string{
 	sourceIntervention:   "intervention",
 	sourceRecommendation: "recommendation",
 	sourceOther:          "other",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
@@ -11,8 +13,24 @@ func TestEnumSource(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"xml"` != string(result) {
-		t.Errorf("Expected '\"xml\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"xml"`, result)
 	}
 	json.Unmarshal([]byte(`"xml"`), &enum)
 	if Source.XML != enum {
-		t.Errorf("Expcected %d, got %d", Source.XML, enum)
+		t.Errorf("Expected %d, got %d", Source.XML, enum)
 	}
 
 	enum = Source.Javascript
@@ -42,11 +60,11 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"javascript"` != string(result) {
-		t.Errorf("Expected '\"javascript\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"javascript"`, result)
 	}
 	json.Unmarshal([]byte(`"javascript"`), &enum)
 	if Source.Javascript != enum {
-		t.Errorf("Expcected %d, got %d", Source.Javascript, enum)
+		t.Errorf("Expected %d, got %d", Source.Javascript, enum)
 	}
 
 	enum = Source.Network
@@ -55,18 +73,12 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"network"` != string(result) {
-		t.Errorf("Expected '\"network\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"network"`, result)
 	}
 	json.Unmarshal([]byte(`"network"`), &enum)
 	if Source.Network != enum {
-		t.Errorf("Expcected %d, got %d", Source.Network, enum)
+		t.Errorf("Expected %d, got %d", Source.Network, enum)
 	}
-}
-
-func TestEnumSource2(t *testing.T) {
-	var enum SourceEnum
-	var err error
-	var result []byte
 
 	enum = Source.Storage
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumSource2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"storage"` != string(result) {
-		t.Errorf("Expected '\"storage\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"storage"`, result)
 	}
 	json.Unmarshal([]byte(`"storage"`), &enum)
 	if Source.Storage != enum {
-		t.Errorf("Expcected %d, got %d", Source.Storage, enum)
+		t.Errorf("Expected %d, got %d", Source.Storage, enum)
 	}
 
 	enum = Source.Appcache
@@ -87,11 +99,11 @@ func TestEnumSource2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"appcache"` != string(result) {
-		t.Errorf("Expected '\"appcache\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"appcache"`, result)
 	}
 	json.Unmarshal([]byte(`"appcache"`), &enum)
 	if Source.Appcache != enum {
-		t.Errorf("Expcected %d, got %d", Source.Appcache, enum)
+		t.Errorf("Expected %d, got %d", Source.Appcache, enum)
 	}
 
 	enum = Source.Rendering
@@ -100,11 +112,11 @@ func TestEnumSource2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"rendering"` != string(result) {
-		t.Errorf("Expected '\"rendering\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"rendering"`, result)
 	}
 	json.Unmarshal([]byte(`"rendering"`), &enum)
 	if Source.Rendering != enum {
-		t.Errorf("Expcected %d, got %d", Source.Rendering, enum)
+		t.Errorf("Expected %d, got %d", Source.Rendering, enum)
 	}
 
 	enum = Source.Security
@@ -113,18 +125,12 @@ func TestEnumSource2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"security"` != string(result) {
-		t.Errorf("Expected '\"security\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"security"`, result)
 	}
 	json.Unmarshal([]byte(`"security"`), &enum)
 	if Source.Security != enum {
-		t.Errorf("Expcected %d, got %d", Source.Security, enum)
+		t.Errorf("Expected %d, got %d", Source.Security, enum)
 	}
-}
-
-func TestEnumSource3(t *testing.T) {
-	var enum SourceEnum
-	var err error
-	var result []byte
 
 	enum = Source.Deprecation
 	result, err = json.Marshal(enum)
@@ -132,11 +138,11 @@ func TestEnumSource3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"deprecation"` != string(result) {
-		t.Errorf("Expected '\"deprecation\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"deprecation"`, result)
 	}
 	json.Unmarshal([]byte(`"deprecation"`), &enum)
 	if Source.Deprecation != enum {
-		t.Errorf("Expcected %d, got %d", Source.Deprecation, enum)
+		t.Errorf("Expected %d, got %d", Source.Deprecation, enum)
 	}
 
 	enum = Source.Worker
@@ -145,11 +151,11 @@ func TestEnumSource3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"worker"` != string(result) {
-		t.Errorf("Expected '\"worker\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"worker"`, result)
 	}
 	json.Unmarshal([]byte(`"worker"`), &enum)
 	if Source.Worker != enum {
-		t.Errorf("Expcected %d, got %d", Source.Worker, enum)
+		t.Errorf("Expected %d, got %d", Source.Worker, enum)
 	}
 
 	enum = Source.Violation
@@ -158,11 +164,11 @@ func TestEnumSource3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"violation"` != string(result) {
-		t.Errorf("Expected '\"violation\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"violation"`, result)
 	}
 	json.Unmarshal([]byte(`"violation"`), &enum)
 	if Source.Violation != enum {
-		t.Errorf("Expcected %d, got %d", Source.Violation, enum)
+		t.Errorf("Expected %d, got %d", Source.Violation, enum)
 	}
 
 	enum = Source.Intervention
@@ -171,18 +177,12 @@ func TestEnumSource3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"intervention"` != string(result) {
-		t.Errorf("Expected '\"intervention\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"intervention"`, result)
 	}
 	json.Unmarshal([]byte(`"intervention"`), &enum)
 	if Source.Intervention != enum {
-		t.Errorf("Expcected %d, got %d", Source.Intervention, enum)
+		t.Errorf("Expected %d, got %d", Source.Intervention, enum)
 	}
-}
-
-func TestEnumSource4(t *testing.T) {
-	var enum SourceEnum
-	var err error
-	var result []byte
 
 	enum = Source.Recommendation
 	result, err = json.Marshal(enum)
@@ -190,11 +190,11 @@ func TestEnumSource4(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"recommendation"` != string(result) {
-		t.Errorf("Expected '\"recommendation\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"recommendation"`, result)
 	}
 	json.Unmarshal([]byte(`"recommendation"`), &enum)
 	if Source.Recommendation != enum {
-		t.Errorf("Expcected %d, got %d", Source.Recommendation, enum)
+		t.Errorf("Expected %d, got %d", Source.Recommendation, enum)
 	}
 
 	enum = Source.Other
@@ -203,10 +203,10 @@ func TestEnumSource4(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"other"` != string(result) {
-		t.Errorf("Expected '\"other\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
 	}
 	json.Unmarshal([]byte(`"other"`), &enum)
 	if Source.Other != enum {
-		t.Errorf("Expcected %d, got %d", Source.Other, enum)
+		t.Errorf("Expected %d, got %d", Source.Other, enum)
 	}
 }
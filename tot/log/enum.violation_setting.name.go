@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type nameEnum struct {
@@ -16,7 +19,7 @@ type nameEnum struct {
 }
 
 /*
-Name provides named acces to the NameEnum values.
+Name provides named access to the NameEnum values.
 */
 var Name = nameEnum{
 	LongTask:          nameLongTask,
@@ -29,14 +32,18 @@ var Name = nameEnum{
 }
 
 /*
-NameEnum represents the violation type. Allowed values:
-	- Name.LongTask          "longTask"
-	- Name.LongLayout        "longLayout"
-	- Name.BlockedEvent      "blockedEvent"
-	- Name.BlockedParser     "blockedParser"
-	- Name.DiscouragedAPIUse "discouragedAPIUse"
-	- Name.Handler           "handler"
-	- Name.RecurringHandler  "recurringHandler"
+NameEnum represents the Log.ViolationSetting.name values.
+
+Violation type.
+
+Allowed values:
+  - Name.LongTask          "longTask"
+  - Name.LongLayout        "longLayout"
+  - Name.BlockedEvent      "blockedEvent"
+  - Name.BlockedParser     "blockedParser"
+  - Name.DiscouragedAPIUse "discouragedAPIUse"
+  - Name.Handler           "handler"
+  - Name.RecurringHandler  "recurringHandler"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#type-ViolationSetting
 */
@@ -46,7 +53,16 @@ type NameEnum int
 String implements Stringer
 */
 func (enum NameEnum) String() string {
-	return _nameEnums[enum]
+	return _nameEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum NameEnum) Unknown() bool {
+	return !_nameEnums.Known(enum)
 }
 
 /*
@@ -57,25 +73,16 @@ func (enum NameEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *NameEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _nameEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _nameEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -95,7 +102,8 @@ const (
 	nameRecurringHandler
 )
 
-var _nameEnums = map[NameEnum]string{
+var _nameEnums = enums.New(map[NameEnum]string{
+	NameEnum(0):           "",
 	nameLongTask:          "longTask",
 	nameLongLayout:        "longLayout",
 	nameBlockedEvent:      "blockedEvent",
@@ -103,4 +111,4 @@ var _nameEnums = map[NameEnum]string{
 	nameDiscouragedAPIUse: "discouragedAPIUse",
 	nameHandler:           "handler",
 	nameRecurringHandler:  "recurringHandler",
-}
+})
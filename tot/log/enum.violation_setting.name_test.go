@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package log
 
 import (
@@ -11,8 +13,24 @@ func TestEnumName(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown NameEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumName(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"longTask"` != string(result) {
-		t.Errorf("Expected '\"longTask\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"longTask"`, result)
 	}
 	json.Unmarshal([]byte(`"longTask"`), &enum)
 	if Name.LongTask != enum {
-		t.Errorf("Expcected %d, got %d", Name.LongTask, enum)
+		t.Errorf("Expected %d, got %d", Name.LongTask, enum)
 	}
 
 	enum = Name.LongLayout
@@ -42,11 +60,11 @@ func TestEnumName(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"longLayout"` != string(result) {
-		t.Errorf("Expected '\"longLayout\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"longLayout"`, result)
 	}
 	json.Unmarshal([]byte(`"longLayout"`), &enum)
 	if Name.LongLayout != enum {
-		t.Errorf("Expcected %d, got %d", Name.LongLayout, enum)
+		t.Errorf("Expected %d, got %d", Name.LongLayout, enum)
 	}
 
 	enum = Name.BlockedEvent
@@ -55,18 +73,12 @@ func TestEnumName(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"blockedEvent"` != string(result) {
-		t.Errorf("Expected '\"blockedEvent\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"blockedEvent"`, result)
 	}
 	json.Unmarshal([]byte(`"blockedEvent"`), &enum)
 	if Name.BlockedEvent != enum {
-		t.Errorf("Expcected %d, got %d", Name.BlockedEvent, enum)
+		t.Errorf("Expected %d, got %d", Name.BlockedEvent, enum)
 	}
-}
-
-func TestEnumName2(t *testing.T) {
-	var enum NameEnum
-	var err error
-	var result []byte
 
 	enum = Name.BlockedParser
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumName2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"blockedParser"` != string(result) {
-		t.Errorf("Expected '\"blockedParser\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"blockedParser"`, result)
 	}
 	json.Unmarshal([]byte(`"blockedParser"`), &enum)
 	if Name.BlockedParser != enum {
-		t.Errorf("Expcected %d, got %d", Name.BlockedParser, enum)
+		t.Errorf("Expected %d, got %d", Name.BlockedParser, enum)
 	}
 
 	enum = Name.DiscouragedAPIUse
@@ -87,11 +99,11 @@ func TestEnumName2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"discouragedAPIUse"` != string(result) {
-		t.Errorf("Expected '\"discouragedAPIUse\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"discouragedAPIUse"`, result)
 	}
 	json.Unmarshal([]byte(`"discouragedAPIUse"`), &enum)
 	if Name.DiscouragedAPIUse != enum {
-		t.Errorf("Expcected %d, got %d", Name.DiscouragedAPIUse, enum)
+		t.Errorf("Expected %d, got %d", Name.DiscouragedAPIUse, enum)
 	}
 
 	enum = Name.Handler
@@ -100,11 +112,11 @@ func TestEnumName2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"handler"` != string(result) {
-		t.Errorf("Expected '\"handler\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"handler"`, result)
 	}
 	json.Unmarshal([]byte(`"handler"`), &enum)
 	if Name.Handler != enum {
-		t.Errorf("Expcected %d, got %d", Name.Handler, enum)
+		t.Errorf("Expected %d, got %d", Name.Handler, enum)
 	}
 
 	enum = Name.RecurringHandler
@@ -113,10 +125,10 @@ func TestEnumName2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"recurringHandler"` != string(result) {
-		t.Errorf("Expected '\"recurringHandler\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"recurringHandler"`, result)
 	}
 	json.Unmarshal([]byte(`"recurringHandler"`), &enum)
 	if Name.RecurringHandler != enum {
-		t.Errorf("Expcected %d, got %d", Name.RecurringHandler, enum)
+		t.Errorf("Expected %d, got %d", Name.RecurringHandler, enum)
 	}
 }
@@ -0,0 +1,116 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package media provides type definitions for use with the Chrome Media protocol
+
+This domain allows detailed inspection of media elements EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/
+*/
+package media
+
+/*
+PlayerID represents the Media.PlayerId type.
+
+Players will get an ID that is unique within the agent context.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerId
+*/
+type PlayerID string
+
+/*
+Timestamp represents the Media.Timestamp type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-Timestamp
+*/
+type Timestamp float64
+
+/*
+PlayerMessage represents the Media.PlayerMessage type.
+
+Have one type per entry in MediaLogRecord::Type Corresponds to kMessage
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerMessage
+*/
+type PlayerMessage struct {
+	// Keep in sync with MediaLogMessageLevel We are currently keeping the message
+	// level 'error' separate from the PlayerError type because right now they
+	// represent different things, this one being a DVLOG(ERROR) style log message
+	// that gets printed based on what log level is selected in the UI, and the
+	// other is a representation of a media::PipelineStatus object. Soon however
+	// we're going to be moving away from using PipelineStatus for errors and
+	// introducing a new error type which should hopefully let us integrate the
+	// error log level into the PlayerError type. Allowed values:
+	//   - Level.Error
+	//   - Level.Warning
+	//   - Level.Info
+	//   - Level.Debug
+	Level LevelEnum `json:"level"`
+
+	Message string `json:"message"`
+}
+
+/*
+PlayerProperty represents the Media.PlayerProperty type.
+
+# Corresponds to kMediaPropertyChange
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerProperty
+*/
+type PlayerProperty struct {
+	Name string `json:"name"`
+
+	Value string `json:"value"`
+}
+
+/*
+PlayerEvent represents the Media.PlayerEvent type.
+
+# Corresponds to kMediaEventTriggered
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerEvent
+*/
+type PlayerEvent struct {
+	Timestamp Timestamp `json:"timestamp"`
+
+	Value string `json:"value"`
+}
+
+/*
+PlayerErrorSourceLocation represents the Media.PlayerErrorSourceLocation type.
+
+Represents logged source line numbers reported in an error. NOTE: file and line
+are from chromium c++ implementation code, not js.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerErrorSourceLocation
+*/
+type PlayerErrorSourceLocation struct {
+	File string `json:"file"`
+
+	Line int `json:"line"`
+}
+
+/*
+PlayerError represents the Media.PlayerError type.
+
+# Corresponds to kMediaError
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerError
+*/
+type PlayerError struct {
+	ErrorType string `json:"errorType"`
+
+	// Code is the numeric enum entry for a specific set of error codes, such as
+	// PipelineStatusCodes in media/base/pipeline_status.h
+	Code int `json:"code"`
+
+	// A trace of where this error was caused / where it passed through.
+	Stack []*PlayerErrorSourceLocation `json:"stack"`
+
+	// Errors potentially have a root cause error, ie, a DecoderError might be
+	// caused by an WindowsError
+	Cause []*PlayerError `json:"cause"`
+
+	// Extra data attached to an error, such as an HRESULT, Video Codec, etc.
+	Data map[string]interface{} `json:"data"`
+}
@@ -0,0 +1,23 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package media
+
+/*
+EnableResult represents the result of calls to Media.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to Media.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
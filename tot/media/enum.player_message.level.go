@@ -0,0 +1,103 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package media
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type levelEnum struct {
+	Error   LevelEnum
+	Warning LevelEnum
+	Info    LevelEnum
+	Debug   LevelEnum
+}
+
+/*
+Level provides named access to the LevelEnum values.
+*/
+var Level = levelEnum{
+	Error:   levelError,
+	Warning: levelWarning,
+	Info:    levelInfo,
+	Debug:   levelDebug,
+}
+
+/*
+LevelEnum represents the Media.PlayerMessage.level values.
+
+Keep in sync with MediaLogMessageLevel We are currently keeping the message
+level 'error' separate from the PlayerError type because right now they
+represent different things, this one being a DVLOG(ERROR) style log message that
+gets printed based on what log level is selected in the UI, and the other is a
+representation of a media::PipelineStatus object. Soon however we're going to be
+moving away from using PipelineStatus for errors and introducing a new error
+type which should hopefully let us integrate the error log level into the
+PlayerError type.
+
+Allowed values:
+  - Level.Error   "error"
+  - Level.Warning "warning"
+  - Level.Info    "info"
+  - Level.Debug   "debug"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#type-PlayerMessage
+*/
+type LevelEnum int
+
+/*
+String implements Stringer
+*/
+func (enum LevelEnum) String() string {
+	return _levelEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum LevelEnum) Unknown() bool {
+	return !_levelEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum LevelEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *LevelEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _levelEnums.Parse(val)
+	return nil
+}
+
+const (
+	// levelError represents the "error" value.
+	levelError LevelEnum = iota + 1
+	// levelWarning represents the "warning" value.
+	levelWarning
+	// levelInfo represents the "info" value.
+	levelInfo
+	// levelDebug represents the "debug" value.
+	levelDebug
+)
+
+var _levelEnums = enums.New(map[LevelEnum]string{
+	LevelEnum(0): "",
+	levelError:   "error",
+	levelWarning: "warning",
+	levelInfo:    "info",
+	levelDebug:   "debug",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package media
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumLevel(t *testing.T) {
+	var enum LevelEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown LevelEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Level.Error
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"error"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"error"`, result)
+	}
+	json.Unmarshal([]byte(`"error"`), &enum)
+	if Level.Error != enum {
+		t.Errorf("Expected %d, got %d", Level.Error, enum)
+	}
+
+	enum = Level.Warning
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"warning"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"warning"`, result)
+	}
+	json.Unmarshal([]byte(`"warning"`), &enum)
+	if Level.Warning != enum {
+		t.Errorf("Expected %d, got %d", Level.Warning, enum)
+	}
+
+	enum = Level.Info
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"info"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"info"`, result)
+	}
+	json.Unmarshal([]byte(`"info"`), &enum)
+	if Level.Info != enum {
+		t.Errorf("Expected %d, got %d", Level.Info, enum)
+	}
+
+	enum = Level.Debug
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"debug"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"debug"`, result)
+	}
+	json.Unmarshal([]byte(`"debug"`), &enum)
+	if Level.Debug != enum {
+		t.Errorf("Expected %d, got %d", Level.Debug, enum)
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package media
+
+/*
+PlayerPropertiesChangedEvent represents Media.playerPropertiesChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerPropertiesChanged
+*/
+type PlayerPropertiesChangedEvent struct {
+	PlayerID PlayerID `json:"playerId"`
+
+	Properties []*PlayerProperty `json:"properties"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PlayerEventsAddedEvent represents Media.playerEventsAdded event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerEventsAdded
+*/
+type PlayerEventsAddedEvent struct {
+	PlayerID PlayerID `json:"playerId"`
+
+	Events []*PlayerEvent `json:"events"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PlayerMessagesLoggedEvent represents Media.playerMessagesLogged event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerMessagesLogged
+*/
+type PlayerMessagesLoggedEvent struct {
+	PlayerID PlayerID `json:"playerId"`
+
+	Messages []*PlayerMessage `json:"messages"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PlayerErrorsRaisedEvent represents Media.playerErrorsRaised event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerErrorsRaised
+*/
+type PlayerErrorsRaisedEvent struct {
+	PlayerID PlayerID `json:"playerId"`
+
+	Errors []*PlayerError `json:"errors"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PlayersCreatedEvent represents Media.playersCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playersCreated
+*/
+type PlayersCreatedEvent struct {
+	Players []PlayerID `json:"players"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
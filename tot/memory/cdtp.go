@@ -1,13 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package memory provides type definitions for use with the Chrome Memory protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/
 */
 package memory
 
 /*
-PressureLevel is the memory pressure level.
+PressureLevel represents the Memory.PressureLevel type.
+
+Memory pressure level.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#type-PressureLevel
 */
 type PressureLevel string
+
+/*
+SamplingProfileNode represents the Memory.SamplingProfileNode type.
+
+Heap profile sample.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#type-SamplingProfileNode
+*/
+type SamplingProfileNode struct {
+	// Size of the sampled allocation.
+	Size float64 `json:"size"`
+
+	// Total bytes attributed to this sample.
+	Total float64 `json:"total"`
+
+	// Execution stack at the point of allocation.
+	Stack []string `json:"stack"`
+}
+
+/*
+SamplingProfile represents the Memory.SamplingProfile type.
+
+Array of heap profile samples.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#type-SamplingProfile
+*/
+type SamplingProfile struct {
+	Samples []*SamplingProfileNode `json:"samples"`
+
+	Modules []*Module `json:"modules"`
+}
+
+/*
+Module represents the Memory.Module type.
+
+# Executable module information
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#type-Module
+*/
+type Module struct {
+	// Name of the module.
+	Name string `json:"name"`
+
+	// UUID of the module.
+	UUID string `json:"uuid"`
+
+	// Base address where the module is loaded into memory. Encoded as a decimal or
+	// hexadecimal (0x prefixed) string.
+	BaseAddress string `json:"baseAddress"`
+
+	// Size of the module in bytes.
+	Size float64 `json:"size"`
+}
+
+/*
+DOMCounter represents the Memory.DOMCounter type.
+
+DOM object counter data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#type-DOMCounter
+*/
+type DOMCounter struct {
+	// Object name. Note: object names should be presumed volatile and clients
+	// should not expect the returned names to be consistent across runs.
+	Name string `json:"name"`
+
+	// Object count.
+	Count int `json:"count"`
+}
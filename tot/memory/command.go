@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package memory
 
 /*
@@ -6,8 +8,13 @@ GetDOMCountersParams represents Memory.getDOMCounters parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCounters
 */
 type GetDOMCountersParams struct {
-	Documents        int `json:"documents"`
-	Nodes            int `json:"nodes"`
+	// DEPRECATED.
+	Documents int `json:"documents"`
+
+	// DEPRECATED.
+	Nodes int `json:"nodes"`
+
+	// DEPRECATED.
 	JsEventListeners int `json:"jsEventListeners"`
 }
 
@@ -17,6 +24,25 @@ GetDOMCountersResult represents the result of calls to Memory.getDOMCounters.
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCounters
 */
 type GetDOMCountersResult struct {
+	Documents int `json:"documents"`
+
+	Nodes int `json:"nodes"`
+
+	JsEventListeners int `json:"jsEventListeners"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetDOMCountersForLeakDetectionResult represents the result of calls to Memory.getDOMCountersForLeakDetection.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCountersForLeakDetection
+*/
+type GetDOMCountersForLeakDetectionResult struct {
+	// DOM object counters.
+	Counters []*DOMCounter `json:"counters"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -32,8 +58,17 @@ type PrepareForLeakDetectionResult struct {
 }
 
 /*
-SetPressureNotificationsSuppressedParams represents Memory.setPressureNotificationsSuppressed
-parameters.
+ForciblyPurgeJavaScriptMemoryResult represents the result of calls to Memory.forciblyPurgeJavaScriptMemory.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-forciblyPurgeJavaScriptMemory
+*/
+type ForciblyPurgeJavaScriptMemoryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPressureNotificationsSuppressedParams represents Memory.setPressureNotificationsSuppressed parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressureNotificationsSuppressed
 */
@@ -43,8 +78,7 @@ type SetPressureNotificationsSuppressedParams struct {
 }
 
 /*
-SetPressureNotificationsSuppressedResult represents the result of calls to
-Memory.setPressureNotificationsSuppressed.
+SetPressureNotificationsSuppressedResult represents the result of calls to Memory.setPressureNotificationsSuppressed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressureNotificationsSuppressed
 */
@@ -64,8 +98,7 @@ type SimulatePressureNotificationParams struct {
 }
 
 /*
-SimulatePressureNotificationResult represents the result of calls to
-Memory.simulatePressureNotification.
+SimulatePressureNotificationResult represents the result of calls to Memory.simulatePressureNotification.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-simulatePressureNotification
 */
@@ -73,3 +106,72 @@ type SimulatePressureNotificationResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+StartSamplingParams represents Memory.startSampling parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-startSampling
+*/
+type StartSamplingParams struct {
+	// Optional. Average number of bytes between samples.
+	SamplingInterval *int `json:"samplingInterval,omitempty"`
+
+	// Optional. Do not randomize intervals between samples.
+	SuppressRandomness *bool `json:"suppressRandomness,omitempty"`
+}
+
+/*
+StartSamplingResult represents the result of calls to Memory.startSampling.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-startSampling
+*/
+type StartSamplingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+StopSamplingResult represents the result of calls to Memory.stopSampling.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-stopSampling
+*/
+type StopSamplingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAllTimeSamplingProfileResult represents the result of calls to Memory.getAllTimeSamplingProfile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getAllTimeSamplingProfile
+*/
+type GetAllTimeSamplingProfileResult struct {
+	Profile *SamplingProfile `json:"profile"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetBrowserSamplingProfileResult represents the result of calls to Memory.getBrowserSamplingProfile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getBrowserSamplingProfile
+*/
+type GetBrowserSamplingProfileResult struct {
+	Profile *SamplingProfile `json:"profile"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetSamplingProfileResult represents the result of calls to Memory.getSamplingProfile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getSamplingProfile
+*/
+type GetSamplingProfileResult struct {
+	Profile *SamplingProfile `json:"profile"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
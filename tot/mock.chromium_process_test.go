@@ -0,0 +1,112 @@
+package chrome
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+/*
+mockProcessEnv is the environment variable that makes the test binary act as a
+mock Chromium process. Tests launch the test binary itself as the Chromium
+binary with this variable set.
+*/
+const mockProcessEnv = "GO_CHROME_MOCK_PROCESS"
+
+func TestMain(m *testing.M) {
+	if "" != os.Getenv(mockProcessEnv) {
+		os.Exit(mockProcess(os.Getenv(mockProcessEnv)))
+	}
+	os.Exit(m.Run())
+}
+
+/*
+mockProcess emulates the Chromium behavior requested by mode and returns the
+process exit code.
+*/
+func mockProcess(mode string) int {
+	switch mode {
+	case "devtools":
+		// Serve the developer tools endpoints on a free port and report it
+		// on STDERR, like Chromium launched with --remote-debugging-port=0.
+		devtools := NewMockDevTools()
+		fmt.Fprintf(os.Stderr, "[0101/000000.000000:ERROR:mock] starting %s\n", strings.Join(os.Args[1:], " "))
+		fmt.Fprintf(os.Stderr, "\nDevTools listening on ws://%s/devtools/browser/mock\n", devtools.Listener.Addr())
+		select {}
+
+	case "hang":
+		// Ignore SIGINT and Browser.close, with a child process in the same
+		// process group.
+		signal.Ignore(os.Interrupt)
+		devtools := NewMockDevTools()
+		child, err := os.StartProcess(os.Args[0], os.Args[:1], &os.ProcAttr{
+			Env:   append(os.Environ(), mockProcessEnv+"=sleep"),
+			Files: []*os.File{nil, nil, nil},
+		})
+		if nil != err {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "child pid %d\n", child.Pid)
+		fmt.Fprintf(os.Stderr, "DevTools listening on ws://%s/devtools/browser/mock\n", devtools.Listener.Addr())
+		select {}
+
+	case "closable":
+		// Ignore SIGINT but exit on Browser.close.
+		signal.Ignore(os.Interrupt)
+		devtools := NewMockDevTools()
+		fmt.Fprintf(os.Stderr, "DevTools listening on ws://%s/devtools/browser/mock\n", devtools.Listener.Addr())
+		for {
+			time.Sleep(10 * time.Millisecond)
+			for _, payload := range devtools.Payloads() {
+				if "Browser.close" == payload.Method {
+					return 0
+				}
+			}
+		}
+
+	case "crash":
+		// Exit with an error shortly after starting.
+		devtools := NewMockDevTools()
+		fmt.Fprintf(os.Stderr, "DevTools listening on ws://%s/devtools/browser/mock\n", devtools.Listener.Addr())
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprintf(os.Stderr, "[0101/000000.000000:FATAL:mock] renderer crashed\n")
+		return 3
+
+	case "sleep":
+		signal.Ignore(os.Interrupt)
+		time.Sleep(time.Hour)
+		return 0
+
+	case "pipe":
+		// Serve Browser.getVersion over the remote debugging pipe until it
+		// is closed.
+		in := bufio.NewReader(os.NewFile(3, "pipe-in"))
+		out := os.NewFile(4, "pipe-out")
+		for {
+			data, err := in.ReadBytes(0)
+			if nil != err {
+				return 0
+			}
+			payload := &socket.Payload{}
+			if err := json.Unmarshal(data[:len(data)-1], payload); nil != err {
+				return 1
+			}
+			response, _ := json.Marshal(&socket.Response{
+				ID:     payload.ID,
+				Result: []byte(`{"product":"HeadlessChrome/100.0.4896.60","protocolVersion":"1.3"}`),
+			})
+			if _, err := out.Write(append(response, 0)); nil != err {
+				return 1
+			}
+		}
+	}
+	return 1
+}
@@ -1,14 +1,17 @@
 package chrome
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"os"
 	"path/filepath"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/socket"
 )
 
 /*
@@ -99,6 +102,20 @@ func (chrome *MockChrome) Binary() string {
 	return chrome.binary
 }
 
+/*
+Browser implements Chromium.
+*/
+func (chrome *MockChrome) Browser() (*socket.Socket, error) {
+	return nil, errs.New(codes.ChromeBrowserConnectFailed, "no browser connection in mock")
+}
+
+/*
+DetectCapabilities implements Chromium.
+*/
+func (chrome *MockChrome) DetectCapabilities(ctx context.Context) (*socket.Capabilities, error) {
+	return nil, errs.New(codes.ChromeBrowserConnectFailed, "no browser connection in mock")
+}
+
 /*
 Close implements Chromium.
 */
@@ -210,6 +227,18 @@ func (chrome *MockChrome) Launch() error {
 	return nil
 }
 
+/*
+OnNewPage implements Chromium.
+*/
+func (chrome *MockChrome) OnNewPage(callback func(tab *Tab)) {
+}
+
+/*
+OnUnexpectedExit implements Chromium.
+*/
+func (chrome *MockChrome) OnUnexpectedExit(callback func(exit *ProcessExit)) {
+}
+
 /*
 Port implements Chromium.
 
@@ -295,6 +324,13 @@ func (chrome *MockChrome) Workdir() string {
 	return chrome.workdir
 }
 
+/*
+NewBrowserContext implements Chromium.
+*/
+func (chrome *MockChrome) NewBrowserContext() (*BrowserContext, error) {
+	return nil, errs.New(codes.ChromeBrowserContextFailed, "no browser connection in mock")
+}
+
 /*
 NewTab spawns a new Tab and returns a reference to it
 */
@@ -320,6 +356,7 @@ func (chrome *MockChrome) NewTab(uri string) (*Tab, error) {
 			URL:                  "",
 			WebSocketDebuggerURL: "",
 		},
+		mux: &sync.Mutex{},
 		url: targetURL,
 	}
 
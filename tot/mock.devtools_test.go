@@ -0,0 +1,31 @@
+package chrome
+
+import (
+	"github.com/mkenney/go-chrome/internal/devtoolstest"
+)
+
+/*
+MockDevTools is a mock of the Chromium developer tools HTTP and websocket
+endpoints for unit testing.
+*/
+type MockDevTools struct {
+	*devtoolstest.Server
+}
+
+/*
+NewMockDevTools starts and returns a mock developer tools server.
+*/
+func NewMockDevTools() *MockDevTools {
+	return &MockDevTools{Server: devtoolstest.New()}
+}
+
+/*
+Flags returns Chromium flags addressing the mock server.
+*/
+func (devtools *MockDevTools) Flags() *Flags {
+	host, port := devtools.Addr()
+	return &Flags{
+		"addr": host,
+		"port": port,
+	}
+}
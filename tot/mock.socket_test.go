@@ -1,6 +1,8 @@
 package chrome
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 
 	"github.com/mkenney/go-chrome/tot/socket"
@@ -8,39 +10,55 @@ import (
 
 func NewMockSocket(url *url.URL) *MockSocket {
 	mockSocket := &MockSocket{
-		url:   url,
-		errCh: make(chan error, 3),
+		url:      url,
+		errCh:    make(chan error, 3),
+		handlers: socket.NewEventHandlerMap(),
 	}
 
 	mockSocket.accessibility = &socket.AccessibilityProtocol{Socket: mockSocket}
 	mockSocket.animation = &socket.AnimationProtocol{Socket: mockSocket}
 	mockSocket.applicationCache = &socket.ApplicationCacheProtocol{Socket: mockSocket}
 	mockSocket.audits = &socket.AuditsProtocol{Socket: mockSocket}
+	mockSocket.autofill = &socket.AutofillProtocol{Socket: mockSocket}
+	mockSocket.backgroundService = &socket.BackgroundServiceProtocol{Socket: mockSocket}
+	mockSocket.bluetoothEmulation = &socket.BluetoothEmulationProtocol{Socket: mockSocket}
 	mockSocket.browser = &socket.BrowserProtocol{Socket: mockSocket}
 	mockSocket.cacheStorage = &socket.CacheStorageProtocol{Socket: mockSocket}
+	mockSocket.cast = &socket.CastProtocol{Socket: mockSocket}
 	mockSocket.console = &socket.ConsoleProtocol{Socket: mockSocket}
 	mockSocket.css = &socket.CSSProtocol{Socket: mockSocket}
 	mockSocket.database = &socket.DatabaseProtocol{Socket: mockSocket}
 	mockSocket.debugger = &socket.DebuggerProtocol{Socket: mockSocket}
+	mockSocket.deviceAccess = &socket.DeviceAccessProtocol{Socket: mockSocket}
 	mockSocket.deviceOrientation = &socket.DeviceOrientationProtocol{Socket: mockSocket}
 	mockSocket.domDebugger = &socket.DOMDebuggerProtocol{Socket: mockSocket}
 	mockSocket.domSnapshot = &socket.DOMSnapshotProtocol{Socket: mockSocket}
 	mockSocket.domStorage = &socket.DOMStorageProtocol{Socket: mockSocket}
 	mockSocket.dom = &socket.DOMProtocol{Socket: mockSocket}
 	mockSocket.emulation = &socket.EmulationProtocol{Socket: mockSocket}
+	mockSocket.eventBreakpoints = &socket.EventBreakpointsProtocol{Socket: mockSocket}
+	mockSocket.extensions = &socket.ExtensionsProtocol{Socket: mockSocket}
+	mockSocket.fedCm = &socket.FedCmProtocol{Socket: mockSocket}
+	mockSocket.fetch = &socket.FetchProtocol{Socket: mockSocket}
+	mockSocket.fileSystem = &socket.FileSystemProtocol{Socket: mockSocket}
 	mockSocket.headlessExperimental = &socket.HeadlessExperimentalProtocol{Socket: mockSocket}
 	mockSocket.heapProfiler = &socket.HeapProfilerProtocol{Socket: mockSocket}
 	mockSocket.indexedDB = &socket.IndexedDBProtocol{Socket: mockSocket}
 	mockSocket.input = &socket.InputProtocol{Socket: mockSocket}
+	mockSocket.inspector = &socket.InspectorProtocol{Socket: mockSocket}
 	mockSocket.io = &socket.IOProtocol{Socket: mockSocket}
 	mockSocket.layerTree = &socket.LayerTreeProtocol{Socket: mockSocket}
 	mockSocket.log = &socket.LogProtocol{Socket: mockSocket}
+	mockSocket.media = &socket.MediaProtocol{Socket: mockSocket}
 	mockSocket.memory = &socket.MemoryProtocol{Socket: mockSocket}
 	mockSocket.network = &socket.NetworkProtocol{Socket: mockSocket}
 	mockSocket.overlay = &socket.OverlayProtocol{Socket: mockSocket}
 	mockSocket.page = &socket.PageProtocol{Socket: mockSocket}
 	mockSocket.performance = &socket.PerformanceProtocol{Socket: mockSocket}
+	mockSocket.performanceTimeline = &socket.PerformanceTimelineProtocol{Socket: mockSocket}
+	mockSocket.preload = &socket.PreloadProtocol{Socket: mockSocket}
 	mockSocket.profiler = &socket.ProfilerProtocol{Socket: mockSocket}
+	mockSocket.pwa = &socket.PWAProtocol{Socket: mockSocket}
 	mockSocket.runtime = &socket.RuntimeProtocol{Socket: mockSocket}
 	mockSocket.schema = &socket.SchemaProtocol{Socket: mockSocket}
 	mockSocket.security = &socket.SecurityProtocol{Socket: mockSocket}
@@ -50,6 +68,8 @@ func NewMockSocket(url *url.URL) *MockSocket {
 	mockSocket.target = &socket.TargetProtocol{Socket: mockSocket}
 	mockSocket.tethering = &socket.TetheringProtocol{Socket: mockSocket}
 	mockSocket.tracing = &socket.TracingProtocol{Socket: mockSocket}
+	mockSocket.webAudio = &socket.WebAudioProtocol{Socket: mockSocket}
+	mockSocket.webAuthn = &socket.WebAuthnProtocol{Socket: mockSocket}
 
 	return mockSocket
 }
@@ -60,38 +80,55 @@ Socket is a Socketer implementation.
 type MockSocket struct {
 	url       *url.URL
 	commandID int
+	crashed   bool
 	errCh     chan error
+	handlers  socket.EventHandlerMapper
 
 	// Protocol interfaces for the API.
 	accessibility        *socket.AccessibilityProtocol
 	animation            *socket.AnimationProtocol
 	applicationCache     *socket.ApplicationCacheProtocol
 	audits               *socket.AuditsProtocol
+	autofill             *socket.AutofillProtocol
+	backgroundService    *socket.BackgroundServiceProtocol
+	bluetoothEmulation   *socket.BluetoothEmulationProtocol
 	browser              *socket.BrowserProtocol
 	cacheStorage         *socket.CacheStorageProtocol
+	cast                 *socket.CastProtocol
 	console              *socket.ConsoleProtocol
 	css                  *socket.CSSProtocol
 	database             *socket.DatabaseProtocol
 	debugger             *socket.DebuggerProtocol
+	deviceAccess         *socket.DeviceAccessProtocol
 	deviceOrientation    *socket.DeviceOrientationProtocol
 	domDebugger          *socket.DOMDebuggerProtocol
 	domSnapshot          *socket.DOMSnapshotProtocol
 	domStorage           *socket.DOMStorageProtocol
 	dom                  *socket.DOMProtocol
 	emulation            *socket.EmulationProtocol
+	eventBreakpoints     *socket.EventBreakpointsProtocol
+	extensions           *socket.ExtensionsProtocol
+	fedCm                *socket.FedCmProtocol
+	fetch                *socket.FetchProtocol
+	fileSystem           *socket.FileSystemProtocol
 	headlessExperimental *socket.HeadlessExperimentalProtocol
 	heapProfiler         *socket.HeapProfilerProtocol
 	indexedDB            *socket.IndexedDBProtocol
 	input                *socket.InputProtocol
+	inspector            *socket.InspectorProtocol
 	io                   *socket.IOProtocol
 	layerTree            *socket.LayerTreeProtocol
 	log                  *socket.LogProtocol
+	media                *socket.MediaProtocol
 	memory               *socket.MemoryProtocol
 	network              *socket.NetworkProtocol
 	overlay              *socket.OverlayProtocol
 	page                 *socket.PageProtocol
 	performance          *socket.PerformanceProtocol
+	performanceTimeline  *socket.PerformanceTimelineProtocol
+	preload              *socket.PreloadProtocol
 	profiler             *socket.ProfilerProtocol
+	pwa                  *socket.PWAProtocol
 	runtime              *socket.RuntimeProtocol
 	schema               *socket.SchemaProtocol
 	security             *socket.SecurityProtocol
@@ -101,6 +138,8 @@ type MockSocket struct {
 	target               *socket.TargetProtocol
 	tethering            *socket.TetheringProtocol
 	tracing              *socket.TracingProtocol
+	webAudio             *socket.WebAudioProtocol
+	webAuthn             *socket.WebAuthnProtocol
 }
 
 /*
@@ -109,6 +148,52 @@ AddEventHandler is a Socketer implementation.
 func (socket *MockSocket) AddEventHandler(
 	handler socket.EventHandler,
 ) {
+	socket.handlers.Add(handler)
+}
+
+/*
+Capabilities is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Capabilities() *socket.Capabilities {
+	return nil
+}
+
+/*
+Call is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	command := socket.NewCommand(mockSocket, method, params)
+	response, err := mockSocket.SendCommandContext(ctx, command)
+	if nil != err {
+		return err
+	}
+	if nil != response.Error && 0 != response.Error.Code {
+		return response.Error
+	}
+	if nil != result && len(response.Result) > 0 {
+		return json.Unmarshal(response.Result, result)
+	}
+	return nil
+}
+
+/*
+Trigger synchronously delivers an event to the registered handlers.
+*/
+func (socket *MockSocket) Trigger(response *socket.Response) {
+	if "Inspector.targetCrashed" == response.Method {
+		socket.crashed = true
+	}
+	handlers, _ := socket.handlers.Get(response.Method)
+	for _, handler := range handlers {
+		handler.Handle(response)
+	}
+}
+
+/*
+Crashed is a Socketer implementation.
+*/
+func (socket *MockSocket) Crashed() bool {
+	return socket.crashed
 }
 
 /*
@@ -157,12 +242,77 @@ func (socket *MockSocket) SendCommand(command socket.Commander) chan *socket.Res
 	return command.Response()
 }
 
+/*
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *MockSocket) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	select {
+	case response := <-command.Response():
+		return response, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+/*
+SetDispatchPolicy is a Socketer implementation.
+*/
+func (socket *MockSocket) SetDispatchPolicy(policy *socket.DispatchPolicy) {
+}
+
+/*
+SetReconnectPolicy is a Socketer implementation.
+*/
+func (socket *MockSocket) SetReconnectPolicy(policy *socket.ReconnectPolicy) {
+}
+
+/*
+State is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) State() socket.ConnectionState {
+	return socket.ConnectionConnected
+}
+
 /*
 Stop is a Socketer implementation.
 */
 func (socket *MockSocket) Stop() {
 }
 
+/*
+Subscribe is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Subscribe(method string) (<-chan json.RawMessage, func()) {
+	events := make(chan json.RawMessage, 10)
+	handler := socket.NewEventHandler(method, func(response *socket.Response) {
+		events <- response.Params
+	})
+	mockSocket.AddEventHandler(handler)
+	return events, func() {
+		mockSocket.RemoveEventHandler(handler)
+	}
+}
+
+/*
+WaitFor is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *socket.Waiter[json.RawMessage] {
+	return socket.WaitForEvent(ctx, func(callback func(params json.RawMessage)) *socket.Listener {
+		handler := socket.NewEventHandler(eventName, func(response *socket.Response) {
+			callback(response.Params)
+		})
+		mockSocket.AddEventHandler(handler)
+		return socket.NewListener(mockSocket, handler)
+	}, predicate)
+}
+
+/*
+Supports is a Socketer implementation.
+*/
+func (mockSocket *MockSocket) Supports(name string) bool {
+	return true
+}
+
 /*
 URL returns the URL of the websocket connection.
 */
@@ -198,6 +348,27 @@ func (socket *MockSocket) Audits() *socket.AuditsProtocol {
 	return socket.audits
 }
 
+/*
+Autofill is a Protocoller implementation.
+*/
+func (socket *MockSocket) Autofill() *socket.AutofillProtocol {
+	return socket.autofill
+}
+
+/*
+BackgroundService is a Protocoller implementation.
+*/
+func (socket *MockSocket) BackgroundService() *socket.BackgroundServiceProtocol {
+	return socket.backgroundService
+}
+
+/*
+BluetoothEmulation is a Protocoller implementation.
+*/
+func (socket *MockSocket) BluetoothEmulation() *socket.BluetoothEmulationProtocol {
+	return socket.bluetoothEmulation
+}
+
 /*
 Browser is a Protocoller implementation.
 */
@@ -212,6 +383,13 @@ func (socket *MockSocket) CacheStorage() *socket.CacheStorageProtocol {
 	return socket.cacheStorage
 }
 
+/*
+Cast is a Protocoller implementation.
+*/
+func (socket *MockSocket) Cast() *socket.CastProtocol {
+	return socket.cast
+}
+
 /*
 Console is a Protocoller implementation.
 */
@@ -240,6 +418,13 @@ func (socket *MockSocket) Debugger() *socket.DebuggerProtocol {
 	return socket.debugger
 }
 
+/*
+DeviceAccess is a Protocoller implementation.
+*/
+func (socket *MockSocket) DeviceAccess() *socket.DeviceAccessProtocol {
+	return socket.deviceAccess
+}
+
 /*
 DeviceOrientation is a Protocoller implementation.
 */
@@ -282,6 +467,41 @@ func (socket *MockSocket) Emulation() *socket.EmulationProtocol {
 	return socket.emulation
 }
 
+/*
+EventBreakpoints is a Protocoller implementation.
+*/
+func (socket *MockSocket) EventBreakpoints() *socket.EventBreakpointsProtocol {
+	return socket.eventBreakpoints
+}
+
+/*
+Extensions is a Protocoller implementation.
+*/
+func (socket *MockSocket) Extensions() *socket.ExtensionsProtocol {
+	return socket.extensions
+}
+
+/*
+FedCm is a Protocoller implementation.
+*/
+func (socket *MockSocket) FedCm() *socket.FedCmProtocol {
+	return socket.fedCm
+}
+
+/*
+Fetch is a Protocoller implementation.
+*/
+func (socket *MockSocket) Fetch() *socket.FetchProtocol {
+	return socket.fetch
+}
+
+/*
+FileSystem is a Protocoller implementation.
+*/
+func (socket *MockSocket) FileSystem() *socket.FileSystemProtocol {
+	return socket.fileSystem
+}
+
 /*
 HeadlessExperimental is a Protocoller implementation.
 */
@@ -310,6 +530,13 @@ func (socket *MockSocket) Input() *socket.InputProtocol {
 	return socket.input
 }
 
+/*
+Inspector is a Protocoller implementation.
+*/
+func (socket *MockSocket) Inspector() *socket.InspectorProtocol {
+	return socket.inspector
+}
+
 /*
 IO is a Protocoller implementation.
 */
@@ -331,6 +558,13 @@ func (socket *MockSocket) Log() *socket.LogProtocol {
 	return socket.log
 }
 
+/*
+Media is a Protocoller implementation.
+*/
+func (socket *MockSocket) Media() *socket.MediaProtocol {
+	return socket.media
+}
+
 /*
 Memory is a Protocoller implementation.
 */
@@ -366,6 +600,20 @@ func (socket *MockSocket) Performance() *socket.PerformanceProtocol {
 	return socket.performance
 }
 
+/*
+PerformanceTimeline is a Protocoller implementation.
+*/
+func (socket *MockSocket) PerformanceTimeline() *socket.PerformanceTimelineProtocol {
+	return socket.performanceTimeline
+}
+
+/*
+Preload is a Protocoller implementation.
+*/
+func (socket *MockSocket) Preload() *socket.PreloadProtocol {
+	return socket.preload
+}
+
 /*
 Profiler is a Protocoller implementation.
 */
@@ -373,6 +621,13 @@ func (socket *MockSocket) Profiler() *socket.ProfilerProtocol {
 	return socket.profiler
 }
 
+/*
+PWA is a Protocoller implementation.
+*/
+func (socket *MockSocket) PWA() *socket.PWAProtocol {
+	return socket.pwa
+}
+
 /*
 Runtime is a Protocoller implementation.
 */
@@ -435,3 +690,17 @@ Tracing is a Protocoller implementation.
 func (socket *MockSocket) Tracing() *socket.TracingProtocol {
 	return socket.tracing
 }
+
+/*
+WebAudio is a Protocoller implementation.
+*/
+func (socket *MockSocket) WebAudio() *socket.WebAudioProtocol {
+	return socket.webAudio
+}
+
+/*
+WebAuthn is a Protocoller implementation.
+*/
+func (socket *MockSocket) WebAuthn() *socket.WebAuthnProtocol {
+	return socket.webAuthn
+}
@@ -1,167 +1,244 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package network provides type definitions for use with the Chrome Network protocol
 
+Network domain allows tracking network activities of the page. It exposes
+information about http, file, data and other requests and responses, their
+headers, bodies, timing, etc.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Network/
 */
 package network
 
 import (
+	"github.com/mkenney/go-chrome/tot/io"
 	"github.com/mkenney/go-chrome/tot/page"
 	"github.com/mkenney/go-chrome/tot/runtime"
 	"github.com/mkenney/go-chrome/tot/security"
 )
 
 /*
-LoaderID is the Unique loader identifier.
+LoaderID represents the Network.LoaderId type.
+
+Unique loader identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-LoaderId
 */
 type LoaderID string
 
 /*
-RequestID is the unique request identifier.
+RequestID represents the Network.RequestId type.
+
+Unique network request identifier. Note that this does not identify individual
+HTTP requests that are part of a network request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-RequestId
 */
 type RequestID string
 
 /*
-InterceptionID is the unique intercepted request identifier.
+InterceptionID represents the Network.InterceptionId type.
+
+Unique intercepted request identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-InterceptionId
 */
 type InterceptionID string
 
 /*
-TimeSinceEpoch represents UTC time in seconds, counted from January 1, 1970.
+TimeSinceEpoch represents the Network.TimeSinceEpoch type.
+
+UTC time in seconds, counted from January 1, 1970.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TimeSinceEpoch
 */
-type TimeSinceEpoch int64
+type TimeSinceEpoch float64
 
 /*
-MonotonicTime is the monotonically increasing time in seconds since an arbitrary point in the past.
+MonotonicTime represents the Network.MonotonicTime type.
+
+Monotonically increasing time in seconds since an arbitrary point in the past.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-MonotonicTime
 */
-type MonotonicTime int
+type MonotonicTime float64
 
 /*
-Headers contains request / response headers as keys / values of JSON object.
+Headers represents the Network.Headers type.
+
+Request / response headers as keys / values of JSON object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Headers
 */
 type Headers map[string]string
 
 /*
-ResourceTiming defines the timing information for the request
+ResourceTiming represents the Network.ResourceTiming type.
+
+Timing information for the request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ResourceTiming
 */
 type ResourceTiming struct {
-	// Timing's requestTime is a baseline in seconds, while the other numbers
-	// are ticks in milliseconds relatively to this requestTime.
-	RequestTime int `json:"requestTime"`
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime.
+	RequestTime float64 `json:"requestTime"`
 
 	// Started resolving proxy.
-	ProxyStart int `json:"proxyStart"`
+	ProxyStart float64 `json:"proxyStart"`
 
 	// Finished resolving proxy.
-	ProxyEnd int `json:"proxyEnd"`
+	ProxyEnd float64 `json:"proxyEnd"`
 
 	// Started DNS address resolve.
-	DNSStart int `json:"dnsStart"`
+	DNSStart float64 `json:"dnsStart"`
 
 	// Finished DNS address resolve.
-	DNSEnd int `json:"dnsEnd"`
+	DNSEnd float64 `json:"dnsEnd"`
 
 	// Started connecting to the remote host.
-	ConnectStart int `json:"connectStart"`
+	ConnectStart float64 `json:"connectStart"`
 
 	// Connected to the remote host.
-	ConnectEnd int `json:"connectEnd"`
+	ConnectEnd float64 `json:"connectEnd"`
 
 	// Started SSL handshake.
-	SSLStart int `json:"sslStart"`
+	SSLStart float64 `json:"sslStart"`
 
 	// Finished SSL handshake.
-	SSLEnd int `json:"sslEnd"`
+	SSLEnd float64 `json:"sslEnd"`
 
 	// Started running ServiceWorker. EXPERIMENTAL.
-	WorkerStart int `json:"workerStart"`
+	WorkerStart float64 `json:"workerStart"`
 
 	// Finished Starting ServiceWorker. EXPERIMENTAL.
-	WorkerReady int `json:"workerReady"`
+	WorkerReady float64 `json:"workerReady"`
+
+	// Started fetch event. EXPERIMENTAL.
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+
+	// Settled fetch event respondWith promise. EXPERIMENTAL.
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+
+	// Optional. Started ServiceWorker static routing source evaluation.
+	// EXPERIMENTAL.
+	WorkerRouterEvaluationStart float64 `json:"workerRouterEvaluationStart,omitempty"`
+
+	// Optional. Started cache lookup when the source was evaluated to `cache`.
+	// EXPERIMENTAL.
+	WorkerCacheLookupStart float64 `json:"workerCacheLookupStart,omitempty"`
 
 	// Started sending request.
-	SendStart int `json:"sendStart"`
+	SendStart float64 `json:"sendStart"`
 
 	// Finished sending request.
-	SendEnd int `json:"sendEnd"`
+	SendEnd float64 `json:"sendEnd"`
 
 	// Time the server started pushing request. EXPERIMENTAL.
-	PushStart int `json:"pushStart"`
+	PushStart float64 `json:"pushStart"`
 
 	// Time the server finished pushing request. EXPERIMENTAL.
-	PushEnd int `json:"pushEnd"`
+	PushEnd float64 `json:"pushEnd"`
+
+	// Started receiving response headers. EXPERIMENTAL.
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
 
 	// Finished receiving response headers.
-	ReceiveHeadersEnd int `json:"receiveHeadersEnd"`
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+/*
+PostDataEntry represents the Network.PostDataEntry type.
+
+# Post data entry for HTTP request
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-PostDataEntry
+*/
+type PostDataEntry struct {
+	// Optional.
+	Bytes string `json:"bytes,omitempty"`
 }
 
 /*
-Request represents the HTTP request data.
+Request represents the Network.Request type.
+
+HTTP request data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Request
 */
 type Request struct {
-	// Request URL.
+	// Request URL (without fragment).
 	URL string `json:"url"`
 
+	// Optional. Fragment of the requested URL starting with hash, if present.
+	URLFragment string `json:"urlFragment,omitempty"`
+
 	// HTTP request method.
 	Method string `json:"method"`
 
 	// HTTP request headers.
 	Headers Headers `json:"headers"`
 
-	// Optional. HTTP POST request data.
+	// Optional. HTTP POST request data. Use postDataEntries instead. DEPRECATED.
 	PostData string `json:"postData,omitempty"`
 
-	// Optional. The mixed content type of the request.
+	// Optional. True when the request has POST data. Note that postData might
+	// still be omitted when this flag is true when the data is too long.
+	HasPostData bool `json:"hasPostData,omitempty"`
+
+	// Optional. Request body elements (post data broken into individual entries).
+	// EXPERIMENTAL.
+	PostDataEntries []*PostDataEntry `json:"postDataEntries,omitempty"`
+
+	// Optional. The mixed content type of the request. Allowed values:
+	//   - security.MixedContentType.Blockable
+	//   - security.MixedContentType.OptionallyBlockable
+	//   - security.MixedContentType.None
 	MixedContentType security.MixedContentTypeEnum `json:"mixedContentType,omitempty"`
 
-	// Priority of the resource request at the time request is sent. Allowed
-	// values:
-	//	- ResourcePriority.VeryLow
-	//	- ResourcePriority.Low
-	//	- ResourcePriority.Medium
-	//	- ResourcePriority.High
-	//	- ResourcePriority.VeryHigh
+	// Priority of the resource request at the time request is sent. Allowed values:
+	//   - ResourcePriority.VeryLow
+	//   - ResourcePriority.Low
+	//   - ResourcePriority.Medium
+	//   - ResourcePriority.High
+	//   - ResourcePriority.VeryHigh
 	InitialPriority ResourcePriorityEnum `json:"initialPriority"`
 
 	// The referrer policy of the request, as defined in
 	// https://www.w3.org/TR/referrer-policy/ Allowed values:
-	//	- ReferrerPolicy.UnsafeUrl
-	//	- ReferrerPolicy.NoReferrerWhenDowngrade
-	//	- ReferrerPolicy.NoReferrer
-	//	- ReferrerPolicy.Origin
-	//	- ReferrerPolicy.OriginWhenCrossOrigin
-	//	- ReferrerPolicy.SameOrigin
-	//	- ReferrerPolicy.StrictOrigin
-	//	- ReferrerPolicy.StrictOriginWhenCrossOrigin
+	//   - ReferrerPolicy.UnsafeURL
+	//   - ReferrerPolicy.NoReferrerWhenDowngrade
+	//   - ReferrerPolicy.NoReferrer
+	//   - ReferrerPolicy.Origin
+	//   - ReferrerPolicy.OriginWhenCrossOrigin
+	//   - ReferrerPolicy.SameOrigin
+	//   - ReferrerPolicy.StrictOrigin
+	//   - ReferrerPolicy.StrictOriginWhenCrossOrigin
 	ReferrerPolicy ReferrerPolicyEnum `json:"referrerPolicy"`
 
 	// Optional. Whether is loaded via link preload.
 	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+
+	// Optional. Set for requests when the TrustToken API is used. Contains the
+	// parameters passed by the developer (e.g. via "fetch") as understood by the
+	// backend. EXPERIMENTAL.
+	TrustTokenParams *TrustTokenParams `json:"trustTokenParams,omitempty"`
+
+	// Optional. True if this resource request is considered to be the 'same site'
+	// as the request corresponding to the main frame. EXPERIMENTAL.
+	IsSameSite bool `json:"isSameSite,omitempty"`
 }
 
 /*
-SignedCertificateTimestamp contains details of a signed certificate timestamp (SCT).
+SignedCertificateTimestamp represents the Network.SignedCertificateTimestamp
+type.
+
+Details of a signed certificate timestamp (SCT).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedCertificateTimestamp
 */
 type SignedCertificateTimestamp struct {
-
 	// Validation status.
 	Status string `json:"status"`
 
@@ -174,8 +251,9 @@ type SignedCertificateTimestamp struct {
 	// Log ID.
 	LogID string `json:"logId"`
 
-	// Issuance date.
-	Timestamp TimeSinceEpoch `json:"timestamp"`
+	// Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	// milliseconds since January 1, 1970, UTC, not the number of seconds.
+	Timestamp float64 `json:"timestamp"`
 
 	// Hash algorithm.
 	HashAlgorithm string `json:"hashAlgorithm"`
@@ -188,7 +266,9 @@ type SignedCertificateTimestamp struct {
 }
 
 /*
-SecurityDetails defines security details about a request.
+SecurityDetails represents the Network.SecurityDetails type.
+
+Security details about a request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SecurityDetails
 */
@@ -196,8 +276,7 @@ type SecurityDetails struct {
 	// Protocol name (e.g. "TLS 1.2" or "QUIC").
 	Protocol string `json:"protocol"`
 
-	// Key Exchange used by the connection, or the empty string if not
-	// applicable.
+	// Key Exchange used by the connection, or the empty string if not applicable.
 	KeyExchange string `json:"keyExchange"`
 
 	// Optional. (EC)DH group used by the connection, if applicable.
@@ -229,17 +308,136 @@ type SecurityDetails struct {
 
 	// List of signed certificate timestamps (SCTs).
 	SignedCertificateTimestampList []*SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+
+	// Whether the request complied with Certificate Transparency policy Allowed values:
+	//   - CertificateTransparencyCompliance.Unknown
+	//   - CertificateTransparencyCompliance.NotCompliant
+	//   - CertificateTransparencyCompliance.Compliant
+	CertificateTransparencyCompliance CertificateTransparencyComplianceEnum `json:"certificateTransparencyCompliance"`
+
+	// Optional. The signature algorithm used by the server in the TLS server
+	// signature, represented as a TLS SignatureScheme code point. Omitted if not
+	// applicable or not known.
+	ServerSignatureAlgorithm int `json:"serverSignatureAlgorithm,omitempty"`
+
+	// Whether the connection used Encrypted ClientHello
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+/*
+CorsErrorStatus represents the Network.CorsErrorStatus type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CorsErrorStatus
+*/
+type CorsErrorStatus struct {
+	// Allowed values:
+	//   - CorsError.DisallowedByMode
+	//   - CorsError.InvalidResponse
+	//   - CorsError.WildcardOriginNotAllowed
+	//   - CorsError.MissingAllowOriginHeader
+	//   - CorsError.MultipleAllowOriginValues
+	//   - CorsError.InvalidAllowOriginValue
+	//   - CorsError.AllowOriginMismatch
+	//   - CorsError.InvalidAllowCredentials
+	//   - CorsError.CorsDisabledScheme
+	//   - CorsError.PreflightInvalidStatus
+	//   - CorsError.PreflightDisallowedRedirect
+	//   - CorsError.PreflightWildcardOriginNotAllowed
+	//   - CorsError.PreflightMissingAllowOriginHeader
+	//   - CorsError.PreflightMultipleAllowOriginValues
+	//   - CorsError.PreflightInvalidAllowOriginValue
+	//   - CorsError.PreflightAllowOriginMismatch
+	//   - CorsError.PreflightInvalidAllowCredentials
+	//   - CorsError.PreflightMissingAllowExternal
+	//   - CorsError.PreflightInvalidAllowExternal
+	//   - CorsError.PreflightMissingAllowPrivateNetwork
+	//   - CorsError.PreflightInvalidAllowPrivateNetwork
+	//   - CorsError.InvalidAllowMethodsPreflightResponse
+	//   - CorsError.InvalidAllowHeadersPreflightResponse
+	//   - CorsError.MethodDisallowedByPreflightResponse
+	//   - CorsError.HeaderDisallowedByPreflightResponse
+	//   - CorsError.RedirectContainsCredentials
+	//   - CorsError.InsecurePrivateNetwork
+	//   - CorsError.InvalidPrivateNetworkAccess
+	//   - CorsError.UnexpectedPrivateNetworkAccess
+	//   - CorsError.NoCorsRedirectModeNotFollow
+	//   - CorsError.PreflightMissingPrivateNetworkAccessID
+	//   - CorsError.PreflightMissingPrivateNetworkAccessName
+	//   - CorsError.PrivateNetworkAccessPermissionUnavailable
+	//   - CorsError.PrivateNetworkAccessPermissionDenied
+	//   - CorsError.LocalNetworkAccessPermissionDenied
+	CorsError CorsErrorEnum `json:"corsError"`
+
+	FailedParameter string `json:"failedParameter"`
+}
+
+/*
+TrustTokenParams represents the Network.TrustTokenParams type.
+
+Determines what type of Trust Token operation is executed and depending on the
+type, some additional parameters. The values are specified in
+third_party/blink/renderer/core/fetch/trust_token.idl. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TrustTokenParams
+*/
+type TrustTokenParams struct {
+	// Allowed values:
+	//   - TrustTokenOperationType.Issuance
+	//   - TrustTokenOperationType.Redemption
+	//   - TrustTokenOperationType.Signing
+	Operation TrustTokenOperationTypeEnum `json:"operation"`
+
+	// Only set for "token-redemption" operation and determine whether to request a
+	// fresh SRR or use a still valid cached SRR. Allowed values:
+	//   - RefreshPolicy.UseCached
+	//   - RefreshPolicy.Refresh
+	RefreshPolicy RefreshPolicyEnum `json:"refreshPolicy"`
+
+	// Optional. Origins of issuers from whom to request tokens or redemption
+	// records.
+	Issuers []string `json:"issuers,omitempty"`
+}
+
+/*
+ServiceWorkerRouterInfo represents the Network.ServiceWorkerRouterInfo type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ServiceWorkerRouterInfo
+*/
+type ServiceWorkerRouterInfo struct {
+	// Optional. ID of the rule matched. If there is a matched rule, this field
+	// will be set, otherwiser no value will be set.
+	RuleIDMatched int `json:"ruleIdMatched,omitempty"`
+
+	// Optional. The router source of the matched rule. If there is a matched rule,
+	// this field will be set, otherwise no value will be set. Allowed values:
+	//   - ServiceWorkerRouterSource.Network
+	//   - ServiceWorkerRouterSource.Cache
+	//   - ServiceWorkerRouterSource.FetchEvent
+	//   - ServiceWorkerRouterSource.RaceNetworkAndFetchHandler
+	//   - ServiceWorkerRouterSource.RaceNetworkAndCache
+	MatchedSourceType ServiceWorkerRouterSourceEnum `json:"matchedSourceType,omitempty"`
+
+	// Optional. The actual router source used. Allowed values:
+	//   - ServiceWorkerRouterSource.Network
+	//   - ServiceWorkerRouterSource.Cache
+	//   - ServiceWorkerRouterSource.FetchEvent
+	//   - ServiceWorkerRouterSource.RaceNetworkAndFetchHandler
+	//   - ServiceWorkerRouterSource.RaceNetworkAndCache
+	ActualSourceType ServiceWorkerRouterSourceEnum `json:"actualSourceType,omitempty"`
 }
 
 /*
-Response contains HTTP response data.
+Response represents the Network.Response type.
+
+HTTP response data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Response
 */
 type Response struct {
-
-	// Response URL. This URL can be different from CachedResource.url in case
-	// of redirect.
+	// Response URL. This URL can be different from CachedResource.url in case of
+	// redirect.
 	URL string `json:"url"`
 
 	// HTTP response status code.
@@ -251,24 +449,29 @@ type Response struct {
 	// HTTP response headers.
 	Headers Headers `json:"headers"`
 
-	// Optional. HTTP response headers text.
+	// Optional. HTTP response headers text. This has been replaced by the headers
+	// in Network.responseReceivedExtraInfo. DEPRECATED.
 	HeadersText string `json:"headersText,omitempty"`
 
 	// Resource mimeType as determined by the browser.
 	MimeType string `json:"mimeType"`
 
-	// Optional. Refined HTTP request headers that were actually transmitted
-	// over the network.
+	// Resource charset as determined by the browser (if applicable).
+	Charset string `json:"charset"`
+
+	// Optional. Refined HTTP request headers that were actually transmitted over
+	// the network.
 	RequestHeaders Headers `json:"requestHeaders,omitempty"`
 
-	// Optional. HTTP request headers text.
+	// Optional. HTTP request headers text. This has been replaced by the headers
+	// in Network.requestWillBeSentExtraInfo. DEPRECATED.
 	RequestHeadersText string `json:"requestHeadersText,omitempty"`
 
 	// Specifies whether physical connection was actually reused for this request.
 	ConnectionReused bool `json:"connectionReused"`
 
 	// Physical connection id that was actually used for this request.
-	ConnectionID int `json:"connectionId"`
+	ConnectionID float64 `json:"connectionId"`
 
 	// Optional. Remote IP address.
 	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
@@ -282,33 +485,86 @@ type Response struct {
 	// Optional. Specifies that the request was served from the ServiceWorker.
 	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
 
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromPrefetchCache bool `json:"fromPrefetchCache,omitempty"`
+
+	// Optional. Specifies that the request was served from the prefetch cache.
+	FromEarlyHints bool `json:"fromEarlyHints,omitempty"`
+
+	// Optional. Information about how ServiceWorker Static Router API was used. If
+	// this field is set with `matchedSourceType` field, a matching rule is found.
+	// If this field is set without `matchedSource`, no matching rule is found.
+	// Otherwise, the API is not used. EXPERIMENTAL.
+	ServiceWorkerRouterInfo *ServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+
 	// Total number of bytes received for this request so far.
-	EncodedDataLength int `json:"encodedDataLength"`
+	EncodedDataLength float64 `json:"encodedDataLength"`
 
 	// Optional. Timing information for the given request.
 	Timing *ResourceTiming `json:"timing,omitempty"`
 
+	// Optional. Response source of response from ServiceWorker. Allowed values:
+	//   - ServiceWorkerResponseSource.CacheStorage
+	//   - ServiceWorkerResponseSource.HTTPCache
+	//   - ServiceWorkerResponseSource.FallbackCode
+	//   - ServiceWorkerResponseSource.Network
+	ServiceWorkerResponseSource ServiceWorkerResponseSourceEnum `json:"serviceWorkerResponseSource,omitempty"`
+
+	// Optional. The time at which the returned response was generated.
+	ResponseTime TimeSinceEpoch `json:"responseTime,omitempty"`
+
+	// Optional. Cache Storage Cache Name.
+	CacheStorageCacheName string `json:"cacheStorageCacheName,omitempty"`
+
 	// Optional. Protocol used to fetch this request.
 	Protocol string `json:"protocol,omitempty"`
 
-	// Security state of the request resource.
+	// Optional. The reason why Chrome uses a specific transport protocol for HTTP
+	// semantics. EXPERIMENTAL. Allowed values:
+	//   - AlternateProtocolUsage.AlternativeJobWonWithoutRace
+	//   - AlternateProtocolUsage.AlternativeJobWonRace
+	//   - AlternateProtocolUsage.MainJobWonRace
+	//   - AlternateProtocolUsage.MappingMissing
+	//   - AlternateProtocolUsage.Broken
+	//   - AlternateProtocolUsage.DNSAlpnH3JobWonWithoutRace
+	//   - AlternateProtocolUsage.DNSAlpnH3JobWonRace
+	//   - AlternateProtocolUsage.UnspecifiedReason
+	AlternateProtocolUsage AlternateProtocolUsageEnum `json:"alternateProtocolUsage,omitempty"`
+
+	// Security state of the request resource. Allowed values:
+	//   - security.State.Unknown
+	//   - security.State.Neutral
+	//   - security.State.Insecure
+	//   - security.State.Secure
+	//   - security.State.Info
+	//   - security.State.InsecureBroken
 	SecurityState security.StateEnum `json:"securityState"`
 
 	// Optional. Security details for the request.
 	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+
+	// Optional. Indicates whether the request was sent through IP Protection
+	// proxies. If set to true, the request used the IP Protection privacy feature.
+	// EXPERIMENTAL.
+	IsIPProtectionUsed bool `json:"isIpProtectionUsed,omitempty"`
 }
 
 /*
-WebSocketRequest contains WebSocket request data
+WebSocketRequest represents the Network.WebSocketRequest type.
+
+WebSocket request data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-WebSocketRequest
 */
 type WebSocketRequest struct {
+	// HTTP request headers.
 	Headers Headers `json:"headers"`
 }
 
 /*
-WebSocketResponse contains WebSocket response data.
+WebSocketResponse represents the Network.WebSocketResponse type.
+
+WebSocket response data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-WebSocketResponse
 */
@@ -333,23 +589,30 @@ type WebSocketResponse struct {
 }
 
 /*
-WebSocketFrame contains WebSocket frame data.
+WebSocketFrame represents the Network.WebSocketFrame type.
+
+WebSocket message data. This represents an entire WebSocket message, not just a
+fragmented frame as the name suggests.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-WebSocketFrame
 */
 type WebSocketFrame struct {
-	// WebSocket frame opcode.
-	Opcode int `json:"opcode"`
+	// WebSocket message opcode.
+	Opcode float64 `json:"opcode"`
 
-	// WebSocket frame mask.
+	// WebSocket message mask.
 	Mask bool `json:"mask"`
 
-	// WebSocket frame payload data.
+	// WebSocket message payload data. If the opcode is 1, this is a text message
+	// and payloadData is a UTF-8 string. If the opcode isn't 1, then payloadData
+	// is a base64 encoded string representing binary data.
 	PayloadData string `json:"payloadData"`
 }
 
 /*
-CachedResource contains information about the cached resource.
+CachedResource represents the Network.CachedResource type.
+
+Information about the cached resource.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CachedResource
 */
@@ -357,43 +620,95 @@ type CachedResource struct {
 	// Resource URL. This is the url of the original network request.
 	URL string `json:"url"`
 
-	// Type of this resource.
+	// Type of this resource. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	Type page.ResourceTypeEnum `json:"type"`
 
 	// Optional. Cached response data.
 	Response *Response `json:"response,omitempty"`
 
 	// Cached response body size.
-	BodySize int `json:"bodySize"`
+	BodySize float64 `json:"bodySize"`
 }
 
 /*
-Initiator contains information about the request initiator.
+Initiator represents the Network.Initiator type.
+
+Information about the request initiator.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Initiator
 */
 type Initiator struct {
 	// Type of this initiator. Allowed values:
-	//	- InitiatorType.Parser
-	//	- InitiatorType.Script
-	//	- InitiatorType.Preload
-	//	- InitiatorType.Other
+	//   - InitiatorType.Parser
+	//   - InitiatorType.Script
+	//   - InitiatorType.Preload
+	//   - InitiatorType.SignedExchange
+	//   - InitiatorType.Preflight
+	//   - InitiatorType.Other
 	Type InitiatorTypeEnum `json:"type"`
 
-	// Optional. Initiator JavaScript stack trace, set for Script only.
+	// Optional. Initiator JavaScript stack trace, set for Script only. Requires
+	// the Debugger domain to be enabled.
 	Stack *runtime.StackTrace `json:"stack,omitempty"`
 
-	// Optional. Initiator URL, set for Parser type or for Script type (when
-	// script is importing module).
+	// Optional. Initiator URL, set for Parser type or for Script type (when script
+	// is importing module) or for SignedExchange type.
 	URL string `json:"url,omitempty"`
 
 	// Optional. Initiator line number, set for Parser type or for Script type
 	// (when script is importing module) (0-based).
-	LineNumber int `json:"lineNumber,omitempty"`
+	LineNumber float64 `json:"lineNumber,omitempty"`
+
+	// Optional. Initiator column number, set for Parser type or for Script type
+	// (when script is importing module) (0-based).
+	ColumnNumber float64 `json:"columnNumber,omitempty"`
+
+	// Optional. Set if another request triggered this request (e.g. preflight).
+	RequestID RequestID `json:"requestId,omitempty"`
 }
 
 /*
-Cookie object
+CookiePartitionKey represents the Network.CookiePartitionKey type.
+
+cookiePartitionKey object The representation of the components of the key that
+are created by the cookiePartitionKey class contained in
+net/cookies/cookie_partition_key.h. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookiePartitionKey
+*/
+type CookiePartitionKey struct {
+	// The site of the top-level URL the browser was visiting at the start of the
+	// request to the endpoint that set the cookie.
+	TopLevelSite string `json:"topLevelSite"`
+
+	// Indicates if the cookie has any ancestors that are cross-site to the
+	// topLevelSite.
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+/*
+Cookie represents the Network.Cookie type.
+
+# Cookie object
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Cookie
 */
@@ -411,7 +726,7 @@ type Cookie struct {
 	Path string `json:"path"`
 
 	// Cookie expiration date as the number of seconds since the UNIX epoch.
-	Expires int64 `json:"expires"`
+	Expires float64 `json:"expires"`
 
 	// Cookie size.
 	Size int `json:"size"`
@@ -426,26 +741,210 @@ type Cookie struct {
 	Session bool `json:"session"`
 
 	// Optional. Cookie SameSite type. Allowed values:
-	//	- CookieSameSite.Strict
-	//	- CookieSameSite.Lax
+	//   - CookieSameSite.Strict
+	//   - CookieSameSite.Lax
+	//   - CookieSameSite.None
 	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+
+	// Cookie Priority EXPERIMENTAL. Allowed values:
+	//   - CookiePriority.Low
+	//   - CookiePriority.Medium
+	//   - CookiePriority.High
+	Priority CookiePriorityEnum `json:"priority"`
+
+	// True if cookie is SameParty. EXPERIMENTAL. DEPRECATED.
+	SameParty bool `json:"sameParty"`
+
+	// Cookie source scheme type. EXPERIMENTAL. Allowed values:
+	//   - CookieSourceScheme.Unset
+	//   - CookieSourceScheme.NonSecure
+	//   - CookieSourceScheme.Secure
+	SourceScheme CookieSourceSchemeEnum `json:"sourceScheme"`
+
+	// Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an
+	// unspecified port. An unspecified port value allows protocol clients to
+	// emulate legacy cookie scope for the port. This is a temporary ability and it
+	// will be removed in the future. EXPERIMENTAL.
+	SourcePort int `json:"sourcePort"`
+
+	// Optional. Cookie partition key. EXPERIMENTAL.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+
+	// Optional. True if cookie partition key is opaque. EXPERIMENTAL.
+	PartitionKeyOpaque bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+/*
+BlockedSetCookieWithReason represents the Network.BlockedSetCookieWithReason
+type.
+
+A cookie which was not stored from a response with the corresponding reason.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-BlockedSetCookieWithReason
+*/
+type BlockedSetCookieWithReason struct {
+	// The reason(s) this cookie was blocked.
+	BlockedReasons []SetCookieBlockedReasonEnum `json:"blockedReasons"`
+
+	// The string representing this individual cookie as it would appear in the
+	// header. This is not the entire "cookie" or "set-cookie" header which could
+	// have multiple cookies.
+	CookieLine string `json:"cookieLine"`
+
+	// Optional. The cookie object which represents the cookie which was not
+	// stored. It is optional because sometimes complete cookie information is not
+	// available, such as in the case of parsing errors.
+	Cookie *Cookie `json:"cookie,omitempty"`
 }
 
 /*
-AuthChallenge is an authorization challenge for HTTP status code 401 or 407. EXPERIMENTAL
+ExemptedSetCookieWithReason represents the Network.ExemptedSetCookieWithReason
+type.
+
+A cookie should have been blocked by 3PCD but is exempted and stored from a
+response with the corresponding reason. A cookie could only have at most one
+exemption reason. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ExemptedSetCookieWithReason
+*/
+type ExemptedSetCookieWithReason struct {
+	// The reason the cookie was exempted. Allowed values:
+	//   - CookieExemptionReason.None
+	//   - CookieExemptionReason.UserSetting
+	//   - CookieExemptionReason.TPCDMetadata
+	//   - CookieExemptionReason.TPCDDeprecationTrial
+	//   - CookieExemptionReason.TopLevelTPCDDeprecationTrial
+	//   - CookieExemptionReason.TPCDHeuristics
+	//   - CookieExemptionReason.EnterprisePolicy
+	//   - CookieExemptionReason.StorageAccess
+	//   - CookieExemptionReason.TopLevelStorageAccess
+	//   - CookieExemptionReason.Scheme
+	//   - CookieExemptionReason.SameSiteNoneCookiesInSandbox
+	ExemptionReason CookieExemptionReasonEnum `json:"exemptionReason"`
+
+	// The string representing this individual cookie as it would appear in the
+	// header.
+	CookieLine string `json:"cookieLine"`
+
+	// The cookie object representing the cookie.
+	Cookie *Cookie `json:"cookie"`
+}
+
+/*
+AssociatedCookie represents the Network.AssociatedCookie type.
+
+A cookie associated with the request which may or may not be sent with it.
+Includes the cookies itself and reasons for blocking or exemption. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AssociatedCookie
+*/
+type AssociatedCookie struct {
+	// The cookie object representing the cookie which was not sent.
+	Cookie *Cookie `json:"cookie"`
+
+	// The reason(s) the cookie was blocked. If empty means the cookie is included.
+	BlockedReasons []CookieBlockedReasonEnum `json:"blockedReasons"`
+
+	// Optional. The reason the cookie should have been blocked by 3PCD but is
+	// exempted. A cookie could only have at most one exemption reason. Allowed values:
+	//   - CookieExemptionReason.None
+	//   - CookieExemptionReason.UserSetting
+	//   - CookieExemptionReason.TPCDMetadata
+	//   - CookieExemptionReason.TPCDDeprecationTrial
+	//   - CookieExemptionReason.TopLevelTPCDDeprecationTrial
+	//   - CookieExemptionReason.TPCDHeuristics
+	//   - CookieExemptionReason.EnterprisePolicy
+	//   - CookieExemptionReason.StorageAccess
+	//   - CookieExemptionReason.TopLevelStorageAccess
+	//   - CookieExemptionReason.Scheme
+	//   - CookieExemptionReason.SameSiteNoneCookiesInSandbox
+	ExemptionReason CookieExemptionReasonEnum `json:"exemptionReason,omitempty"`
+}
+
+/*
+CookieParam represents the Network.CookieParam type.
+
+# Cookie parameter object
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookieParam
+*/
+type CookieParam struct {
+	// Cookie name.
+	Name string `json:"name"`
+
+	// Cookie value.
+	Value string `json:"value"`
+
+	// Optional. The request-URI to associate with the setting of the cookie. This
+	// value can affect the default domain, path, source port, and source scheme
+	// values of the created cookie.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Cookie domain.
+	Domain string `json:"domain,omitempty"`
+
+	// Optional. Cookie path.
+	Path string `json:"path,omitempty"`
+
+	// Optional. True if cookie is secure.
+	Secure bool `json:"secure,omitempty"`
+
+	// Optional. True if cookie is http-only.
+	HTTPOnly bool `json:"httpOnly,omitempty"`
+
+	// Optional. Cookie SameSite type. Allowed values:
+	//   - CookieSameSite.Strict
+	//   - CookieSameSite.Lax
+	//   - CookieSameSite.None
+	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
+
+	// Optional. Cookie expiration date, session cookie if not set
+	Expires TimeSinceEpoch `json:"expires,omitempty"`
+
+	// Optional. Cookie Priority. EXPERIMENTAL. Allowed values:
+	//   - CookiePriority.Low
+	//   - CookiePriority.Medium
+	//   - CookiePriority.High
+	Priority CookiePriorityEnum `json:"priority,omitempty"`
+
+	// Optional. True if cookie is SameParty. EXPERIMENTAL.
+	SameParty bool `json:"sameParty,omitempty"`
+
+	// Optional. Cookie source scheme type. EXPERIMENTAL. Allowed values:
+	//   - CookieSourceScheme.Unset
+	//   - CookieSourceScheme.NonSecure
+	//   - CookieSourceScheme.Secure
+	SourceScheme CookieSourceSchemeEnum `json:"sourceScheme,omitempty"`
+
+	// Optional. Cookie source port. Valid values are {-1, [1, 65535]}, -1
+	// indicates an unspecified port. An unspecified port value allows protocol
+	// clients to emulate legacy cookie scope for the port. This is a temporary
+	// ability and it will be removed in the future. EXPERIMENTAL.
+	SourcePort int `json:"sourcePort,omitempty"`
+
+	// Optional. Cookie partition key. If not set, the cookie will be set as not
+	// partitioned. EXPERIMENTAL.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+/*
+AuthChallenge represents the Network.AuthChallenge type.
+
+Authorization challenge for HTTP status code 401 or 407. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AuthChallenge
 */
 type AuthChallenge struct {
 	// Optional. Source of the authentication challenge. Allowed values:
-	//	- Source.Server
-	//	- Source.Proxy
+	//   - Source.Server
+	//   - Source.Proxy
 	Source SourceEnum `json:"source,omitempty"`
 
 	// Origin of the challenger.
 	Origin string `json:"origin"`
 
-	// The authentication scheme used, such as basic or digest.
+	// The authentication scheme used, such as basic or digest
 	Scheme string `json:"scheme"`
 
 	// The realm of the challenge. May be empty.
@@ -453,18 +952,19 @@ type AuthChallenge struct {
 }
 
 /*
-AuthChallengeResponse is the response to an AuthChallenge. EXPERIMENTAL
+AuthChallengeResponse represents the Network.AuthChallengeResponse type.
+
+Response to an AuthChallenge. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AuthChallengeResponse
 */
 type AuthChallengeResponse struct {
 	// The decision on what to do in response to the authorization challenge.
-	// Default means deferring to the default behavior of the net stack, which
-	// will likely either the Cancel authentication or display a popup dialog
-	// box. Allowed values:
-	//	- ChallengeResponse.Default
-	//	- ChallengeResponse.CancelAuth
-	//	- ChallengeResponse.ProvideCredentials
+	// Default means deferring to the default behavior of the net stack, which will
+	// likely either the Cancel authentication or display a popup dialog box. Allowed values:
+	//   - ChallengeResponse.Default
+	//   - ChallengeResponse.CancelAuth
+	//   - ChallengeResponse.ProvideCredentials
 	Response ChallengeResponseEnum `json:"response"`
 
 	// Optional. The username to provide, possibly empty. Should only be set if
@@ -477,22 +977,481 @@ type AuthChallengeResponse struct {
 }
 
 /*
-RequestPattern is the request pattern for interception. EXPERIMENTAL
+RequestPattern represents the Network.RequestPattern type.
+
+Request pattern for interception. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-RequestPattern
 */
 type RequestPattern struct {
-	// Optional. Wildcards ('*' -> zero or more, '?' -> exactly one) are allowed.
-	// Escape character is backslash. Omitting is equivalent to "*".
+	// Optional. Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are
+	// allowed. Escape character is backslash. Omitting is equivalent to `"*"`.
 	URLPattern string `json:"urlPattern,omitempty"`
 
 	// Optional. If set, only requests for matching resource types will be
-	// intercepted.
+	// intercepted. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	ResourceType page.ResourceTypeEnum `json:"resourceType,omitempty"`
 
-	// Optional. Stage at which to begin intercepting requests. Default is
-	// Request. Allowed values:
-	//	- InterceptionStage.Request
-	//	- InterceptionStage.HeadersReceived
+	// Optional. Stage at which to begin intercepting requests. Default is Request. Allowed values:
+	//   - InterceptionStage.Request
+	//   - InterceptionStage.HeadersReceived
 	InterceptionStage InterceptionStageEnum `json:"interceptionStage,omitempty"`
 }
+
+/*
+SignedExchangeSignature represents the Network.SignedExchangeSignature type.
+
+Information about a signed exchange signature.
+https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedExchangeSignature
+*/
+type SignedExchangeSignature struct {
+	// Signed exchange signature label.
+	Label string `json:"label"`
+
+	// The hex string of signed exchange signature.
+	Signature string `json:"signature"`
+
+	// Signed exchange signature integrity.
+	Integrity string `json:"integrity"`
+
+	// Optional. Signed exchange signature cert Url.
+	CertURL string `json:"certUrl,omitempty"`
+
+	// Optional. The hex string of signed exchange signature cert sha256.
+	CertSha256 string `json:"certSha256,omitempty"`
+
+	// Signed exchange signature validity Url.
+	ValidityURL string `json:"validityUrl"`
+
+	// Signed exchange signature date.
+	Date int `json:"date"`
+
+	// Signed exchange signature expires.
+	Expires int `json:"expires"`
+
+	// Optional. The encoded certificates.
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+/*
+SignedExchangeHeader represents the Network.SignedExchangeHeader type.
+
+Information about a signed exchange header.
+https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedExchangeHeader
+*/
+type SignedExchangeHeader struct {
+	// Signed exchange request URL.
+	RequestURL string `json:"requestUrl"`
+
+	// Signed exchange response code.
+	ResponseCode int `json:"responseCode"`
+
+	// Signed exchange response headers.
+	ResponseHeaders Headers `json:"responseHeaders"`
+
+	// Signed exchange response signature.
+	Signatures []*SignedExchangeSignature `json:"signatures"`
+
+	// Signed exchange header integrity hash in the form of
+	// `sha256-<base64-hash-value>`.
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+/*
+SignedExchangeError represents the Network.SignedExchangeError type.
+
+Information about a signed exchange response. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedExchangeError
+*/
+type SignedExchangeError struct {
+	// Error message.
+	Message string `json:"message"`
+
+	// Optional. The index of the signature which caused the error.
+	SignatureIndex int `json:"signatureIndex,omitempty"`
+
+	// Optional. The field which caused the error. Allowed values:
+	//   - SignedExchangeErrorField.SignatureSig
+	//   - SignedExchangeErrorField.SignatureIntegrity
+	//   - SignedExchangeErrorField.SignatureCertURL
+	//   - SignedExchangeErrorField.SignatureCertSha256
+	//   - SignedExchangeErrorField.SignatureValidityURL
+	//   - SignedExchangeErrorField.SignatureTimestamps
+	ErrorField SignedExchangeErrorFieldEnum `json:"errorField,omitempty"`
+}
+
+/*
+SignedExchangeInfo represents the Network.SignedExchangeInfo type.
+
+Information about a signed exchange response. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedExchangeInfo
+*/
+type SignedExchangeInfo struct {
+	// The outer response of signed HTTP exchange which was received from network.
+	OuterResponse *Response `json:"outerResponse"`
+
+	// Whether network response for the signed exchange was accompanied by extra
+	// headers.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+
+	// Optional. Information about the signed exchange header.
+	Header *SignedExchangeHeader `json:"header,omitempty"`
+
+	// Optional. Security details for the signed exchange header.
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+
+	// Optional. Errors occurred while handling the signed exchange.
+	Errors []*SignedExchangeError `json:"errors,omitempty"`
+}
+
+/*
+DirectTCPSocketOptions represents the Network.DirectTCPSocketOptions type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-DirectTCPSocketOptions
+*/
+type DirectTCPSocketOptions struct {
+	// TCP_NODELAY option
+	NoDelay bool `json:"noDelay"`
+
+	// Optional. Expected to be unsigned integer.
+	KeepAliveDelay float64 `json:"keepAliveDelay,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+
+	// Optional. Allowed values:
+	//   - DirectSocketDNSQueryType.Ipv4
+	//   - DirectSocketDNSQueryType.Ipv6
+	DNSQueryType DirectSocketDNSQueryTypeEnum `json:"dnsQueryType,omitempty"`
+}
+
+/*
+DirectUDPSocketOptions represents the Network.DirectUDPSocketOptions type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-DirectUDPSocketOptions
+*/
+type DirectUDPSocketOptions struct {
+	// Optional.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	// Optional. Unsigned int 16.
+	RemotePort int `json:"remotePort,omitempty"`
+
+	// Optional.
+	LocalAddr string `json:"localAddr,omitempty"`
+
+	// Optional. Unsigned int 16.
+	LocalPort int `json:"localPort,omitempty"`
+
+	// Optional. Allowed values:
+	//   - DirectSocketDNSQueryType.Ipv4
+	//   - DirectSocketDNSQueryType.Ipv6
+	DNSQueryType DirectSocketDNSQueryTypeEnum `json:"dnsQueryType,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	SendBufferSize float64 `json:"sendBufferSize,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	ReceiveBufferSize float64 `json:"receiveBufferSize,omitempty"`
+}
+
+/*
+DirectUDPMessage represents the Network.DirectUDPMessage type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-DirectUDPMessage
+*/
+type DirectUDPMessage struct {
+	Data string `json:"data"`
+
+	// Optional. Null for connected mode.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	// Optional. Null for connected mode. Expected to be unsigned integer.
+	RemotePort int `json:"remotePort,omitempty"`
+}
+
+/*
+ConnectTiming represents the Network.ConnectTiming type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ConnectTiming
+*/
+type ConnectTiming struct {
+	// Timing's requestTime is a baseline in seconds, while the other numbers are
+	// ticks in milliseconds relatively to this requestTime. Matches
+	// ResourceTiming's requestTime for the same request (but not for redirected
+	// requests).
+	RequestTime float64 `json:"requestTime"`
+}
+
+/*
+ClientSecurityState represents the Network.ClientSecurityState type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ClientSecurityState
+*/
+type ClientSecurityState struct {
+	InitiatorIsSecureContext bool `json:"initiatorIsSecureContext"`
+
+	// Allowed values:
+	//   - IPAddressSpace.Loopback
+	//   - IPAddressSpace.Local
+	//   - IPAddressSpace.Public
+	//   - IPAddressSpace.Unknown
+	InitiatorIPAddressSpace IPAddressSpaceEnum `json:"initiatorIPAddressSpace"`
+
+	// Allowed values:
+	//   - PrivateNetworkRequestPolicy.Allow
+	//   - PrivateNetworkRequestPolicy.BlockFromInsecureToMorePrivate
+	//   - PrivateNetworkRequestPolicy.WarnFromInsecureToMorePrivate
+	//   - PrivateNetworkRequestPolicy.PreflightBlock
+	//   - PrivateNetworkRequestPolicy.PreflightWarn
+	//   - PrivateNetworkRequestPolicy.PermissionBlock
+	//   - PrivateNetworkRequestPolicy.PermissionWarn
+	PrivateNetworkRequestPolicy PrivateNetworkRequestPolicyEnum `json:"privateNetworkRequestPolicy"`
+}
+
+/*
+CrossOriginOpenerPolicyStatus represents the
+Network.CrossOriginOpenerPolicyStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CrossOriginOpenerPolicyStatus
+*/
+type CrossOriginOpenerPolicyStatus struct {
+	// Allowed values:
+	//   - CrossOriginOpenerPolicyValue.SameOrigin
+	//   - CrossOriginOpenerPolicyValue.SameOriginAllowPopups
+	//   - CrossOriginOpenerPolicyValue.RestrictProperties
+	//   - CrossOriginOpenerPolicyValue.UnsafeNone
+	//   - CrossOriginOpenerPolicyValue.SameOriginPlusCoep
+	//   - CrossOriginOpenerPolicyValue.RestrictPropertiesPlusCoep
+	//   - CrossOriginOpenerPolicyValue.NoopenerAllowPopups
+	Value CrossOriginOpenerPolicyValueEnum `json:"value"`
+
+	// Allowed values:
+	//   - CrossOriginOpenerPolicyValue.SameOrigin
+	//   - CrossOriginOpenerPolicyValue.SameOriginAllowPopups
+	//   - CrossOriginOpenerPolicyValue.RestrictProperties
+	//   - CrossOriginOpenerPolicyValue.UnsafeNone
+	//   - CrossOriginOpenerPolicyValue.SameOriginPlusCoep
+	//   - CrossOriginOpenerPolicyValue.RestrictPropertiesPlusCoep
+	//   - CrossOriginOpenerPolicyValue.NoopenerAllowPopups
+	ReportOnlyValue CrossOriginOpenerPolicyValueEnum `json:"reportOnlyValue"`
+
+	// Optional.
+	ReportingEndpoint string `json:"reportingEndpoint,omitempty"`
+
+	// Optional.
+	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+/*
+CrossOriginEmbedderPolicyStatus represents the
+Network.CrossOriginEmbedderPolicyStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CrossOriginEmbedderPolicyStatus
+*/
+type CrossOriginEmbedderPolicyStatus struct {
+	// Allowed values:
+	//   - CrossOriginEmbedderPolicyValue.None
+	//   - CrossOriginEmbedderPolicyValue.Credentialless
+	//   - CrossOriginEmbedderPolicyValue.RequireCorp
+	Value CrossOriginEmbedderPolicyValueEnum `json:"value"`
+
+	// Allowed values:
+	//   - CrossOriginEmbedderPolicyValue.None
+	//   - CrossOriginEmbedderPolicyValue.Credentialless
+	//   - CrossOriginEmbedderPolicyValue.RequireCorp
+	ReportOnlyValue CrossOriginEmbedderPolicyValueEnum `json:"reportOnlyValue"`
+
+	// Optional.
+	ReportingEndpoint string `json:"reportingEndpoint,omitempty"`
+
+	// Optional.
+	ReportOnlyReportingEndpoint string `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+/*
+ContentSecurityPolicyStatus represents the Network.ContentSecurityPolicyStatus
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ContentSecurityPolicyStatus
+*/
+type ContentSecurityPolicyStatus struct {
+	EffectiveDirectives string `json:"effectiveDirectives"`
+
+	IsEnforced bool `json:"isEnforced"`
+
+	// Allowed values:
+	//   - ContentSecurityPolicySource.HTTP
+	//   - ContentSecurityPolicySource.Meta
+	Source ContentSecurityPolicySourceEnum `json:"source"`
+}
+
+/*
+SecurityIsolationStatus represents the Network.SecurityIsolationStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SecurityIsolationStatus
+*/
+type SecurityIsolationStatus struct {
+	// Optional.
+	Coop *CrossOriginOpenerPolicyStatus `json:"coop,omitempty"`
+
+	// Optional.
+	Coep *CrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+
+	// Optional.
+	Csp []*ContentSecurityPolicyStatus `json:"csp,omitempty"`
+}
+
+/*
+ReportID represents the Network.ReportId type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ReportId
+*/
+type ReportID string
+
+/*
+ReportingAPIReport represents the Network.ReportingApiReport type.
+
+An object representing a report generated by the Reporting API. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ReportingApiReport
+*/
+type ReportingAPIReport struct {
+	ID ReportID `json:"id"`
+
+	// The URL of the document that triggered the report.
+	InitiatorURL string `json:"initiatorUrl"`
+
+	// The name of the endpoint group that should be used to deliver the report.
+	Destination string `json:"destination"`
+
+	// The type of the report (specifies the set of data that is contained in the
+	// report body).
+	Type string `json:"type"`
+
+	// When the report was generated.
+	Timestamp TimeSinceEpoch `json:"timestamp"`
+
+	// How many uploads deep the related request was.
+	Depth int `json:"depth"`
+
+	// The number of delivery attempts made so far, not including an active
+	// attempt.
+	CompletedAttempts int `json:"completedAttempts"`
+
+	Body map[string]interface{} `json:"body"`
+
+	// Allowed values:
+	//   - ReportStatus.Queued
+	//   - ReportStatus.Pending
+	//   - ReportStatus.MarkedForRemoval
+	//   - ReportStatus.Success
+	Status ReportStatusEnum `json:"status"`
+}
+
+/*
+ReportingAPIEndpoint represents the Network.ReportingApiEndpoint type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ReportingApiEndpoint
+*/
+type ReportingAPIEndpoint struct {
+	// The URL of the endpoint to which reports may be delivered.
+	URL string `json:"url"`
+
+	// Name of the endpoint group.
+	GroupName string `json:"groupName"`
+}
+
+/*
+LoadNetworkResourcePageResult represents the
+Network.LoadNetworkResourcePageResult type.
+
+An object providing the result of a network resource load. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-LoadNetworkResourcePageResult
+*/
+type LoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+
+	// Optional. Optional values used for error reporting.
+	NetError float64 `json:"netError,omitempty"`
+
+	// Optional.
+	NetErrorName string `json:"netErrorName,omitempty"`
+
+	// Optional.
+	HTTPStatusCode float64 `json:"httpStatusCode,omitempty"`
+
+	// Optional. If successful, one of the following two fields holds the result.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+
+	// Optional. Response headers.
+	Headers Headers `json:"headers,omitempty"`
+}
+
+/*
+LoadNetworkResourceOptions represents the Network.LoadNetworkResourceOptions
+type.
+
+An options object that may be extended later to better support CORS, CORB and
+streaming. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-LoadNetworkResourceOptions
+*/
+type LoadNetworkResourceOptions struct {
+	DisableCache bool `json:"disableCache"`
+
+	IncludeCredentials bool `json:"includeCredentials"`
+}
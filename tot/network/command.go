@@ -1,9 +1,44 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"github.com/mkenney/go-chrome/tot/debugger"
+	"github.com/mkenney/go-chrome/tot/emulation"
+	"github.com/mkenney/go-chrome/tot/io"
+	"github.com/mkenney/go-chrome/tot/page"
 )
 
+/*
+SetAcceptedEncodingsParams represents Network.setAcceptedEncodings parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAcceptedEncodings
+*/
+type SetAcceptedEncodingsParams struct {
+	// List of accepted content encodings.
+	Encodings []ContentEncodingEnum `json:"encodings"`
+}
+
+/*
+SetAcceptedEncodingsResult represents the result of calls to Network.setAcceptedEncodings.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAcceptedEncodings
+*/
+type SetAcceptedEncodingsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearAcceptedEncodingsOverrideResult represents the result of calls to Network.clearAcceptedEncodingsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearAcceptedEncodingsOverride
+*/
+type ClearAcceptedEncodingsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 CanClearBrowserCacheResult represents the result of calls to Network.canClearBrowserCache.
 
@@ -69,56 +104,56 @@ ContinueInterceptedRequestParams represents Network.continueInterceptedRequest p
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-continueInterceptedRequest
 */
 type ContinueInterceptedRequestParams struct {
-	// The interception ID.
 	InterceptionID InterceptionID `json:"interceptionId"`
 
 	// Optional. If set this causes the request to fail with the given reason.
 	// Passing `Aborted` for requests marked with `isNavigationRequest` also
-	// cancels the navigation. Must not be set in response to an AuthChallenge.
-	// Allowed values:
-	//	- ErrorReason.Failed
-	//	- ErrorReason.Aborted
-	//	- ErrorReason.TimedOut
-	//	- ErrorReason.AccessDenied
-	//	- ErrorReason.ConnectionClosed
-	//	- ErrorReason.ConnectionReset
-	//	- ErrorReason.ConnectionRefused
-	//	- ErrorReason.ConnectionAborted
-	//	- ErrorReason.ConnectionFailed
-	//	- ErrorReason.NameNotResolved
-	//	- ErrorReason.InternetDisconnected
-	//	- ErrorReason.AddressUnreachable
+	// cancels the navigation. Must not be set in response to an authChallenge. Allowed values:
+	//   - ErrorReason.Failed
+	//   - ErrorReason.Aborted
+	//   - ErrorReason.TimedOut
+	//   - ErrorReason.AccessDenied
+	//   - ErrorReason.ConnectionClosed
+	//   - ErrorReason.ConnectionReset
+	//   - ErrorReason.ConnectionRefused
+	//   - ErrorReason.ConnectionAborted
+	//   - ErrorReason.ConnectionFailed
+	//   - ErrorReason.NameNotResolved
+	//   - ErrorReason.InternetDisconnected
+	//   - ErrorReason.AddressUnreachable
+	//   - ErrorReason.BlockedByClient
+	//   - ErrorReason.BlockedByResponse
 	ErrorReason ErrorReasonEnum `json:"errorReason,omitempty"`
 
 	// Optional. If set the requests completes using with the provided base64
-	// encoded raw response, including HTTP status line and headers etc... Must
-	// not be set in response to an AuthChallenge.
-	RawResponse string `json:"rawResponse,omitempty"`
+	// encoded raw response, including HTTP status line and headers etc... Must not
+	// be set in response to an authChallenge. (Encoded as a base64 string when
+	// passed over JSON)
+	RawResponse *string `json:"rawResponse,omitempty"`
 
-	// Optional. f set the request url will be modified in a way that's not
-	// observable by page. Must not be set in response to an AuthChallenge.
-	URL string `json:"url,omitempty"`
+	// Optional. If set the request url will be modified in a way that's not
+	// observable by page. Must not be set in response to an authChallenge.
+	URL *string `json:"url,omitempty"`
 
-	// Optional. If set this allows the request method to be overridden. Must
-	// not be set in response to an AuthChallenge.
-	Method string `json:"method,omitempty"`
+	// Optional. If set this allows the request method to be overridden. Must not
+	// be set in response to an authChallenge.
+	Method *string `json:"method,omitempty"`
 
-	// Optional. If set this allows postData to be set. Must not be set in
-	// response to an AuthChallenge.
-	PostData string `json:"postData,omitempty"`
+	// Optional. If set this allows postData to be set. Must not be set in response
+	// to an authChallenge.
+	PostData *string `json:"postData,omitempty"`
 
-	// Optional. If set this allows the request headers to be changed. Must not
-	// be set in response to an AuthChallenge.
+	// Optional. If set this allows the request headers to be changed. Must not be
+	// set in response to an authChallenge.
 	Headers Headers `json:"headers,omitempty"`
 
-	// Optional. Response to a requestIntercepted with an AuthChallenge. Must
-	// not be set otherwise.
+	// Optional. Response to a requestIntercepted with an authChallenge. Must not
+	// be set otherwise.
 	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse,omitempty"`
 }
 
 /*
-ContinueInterceptedRequestResult represents the result of calls to
-Network.continueInterceptedRequest.
+ContinueInterceptedRequestResult represents the result of calls to Network.continueInterceptedRequest.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-continueInterceptedRequest
 */
@@ -138,13 +173,18 @@ type DeleteCookiesParams struct {
 
 	// Optional. If specified, deletes all the cookies with the given name where
 	// domain and path match provided URL.
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
 	// Optional. If specified, deletes only cookies with the exact domain.
-	Domain string `json:"domain,omitempty"`
+	Domain *string `json:"domain,omitempty"`
 
 	// Optional. If specified, deletes only cookies with the exact path.
-	Path string `json:"path,omitempty"`
+	Path *string `json:"path,omitempty"`
+
+	// Optional. If specified, deletes only cookies with the the given name and
+	// partitionKey where all partition key attributes match the cookie partition
+	// key attribute. EXPERIMENTAL.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
 }
 
 /*
@@ -188,22 +228,33 @@ type EmulateConditionsParams struct {
 	UploadThroughput float64 `json:"uploadThroughput"`
 
 	// Optional. Connection type if known. Allowed values:
-	//	- ConnectionType.None
-	//	- ConnectionType.Cellular2g
-	//	- ConnectionType.Cellular3g
-	//	- ConnectionType.Cellular4g
-	//	- ConnectionType.Bluetooth
-	//	- ConnectionType.Ethernet
-	//	- ConnectionType.Wifi
-	//	- ConnectionType.Wimax
-	//	- ConnectionType.Other
+	//   - ConnectionType.None
+	//   - ConnectionType.Cellular2g
+	//   - ConnectionType.Cellular3g
+	//   - ConnectionType.Cellular4g
+	//   - ConnectionType.Bluetooth
+	//   - ConnectionType.Ethernet
+	//   - ConnectionType.Wifi
+	//   - ConnectionType.Wimax
+	//   - ConnectionType.Other
 	ConnectionType ConnectionTypeEnum `json:"connectionType,omitempty"`
+
+	// Optional. WebRTC packet loss (percent, 0-100). 0 disables packet loss
+	// emulation, 100 drops all the packets. EXPERIMENTAL.
+	PacketLoss *float64 `json:"packetLoss,omitempty"`
+
+	// Optional. WebRTC packet queue length (packet). 0 removes any queue length
+	// limitations. EXPERIMENTAL.
+	PacketQueueLength *int `json:"packetQueueLength,omitempty"`
+
+	// Optional. WebRTC packetReordering feature. EXPERIMENTAL.
+	PacketReordering *bool `json:"packetReordering,omitempty"`
 }
 
 /*
-EmulateConditionsResult represents the result of calls to Network.emulateConditions.
+EmulateConditionsResult represents the result of calls to Network.emulateNetworkConditions.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateConditions
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateNetworkConditions
 */
 type EmulateConditionsResult struct {
 	// Error information related to executing this method
@@ -218,11 +269,19 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enable
 type EnableParams struct {
 	// Optional. Buffer size in bytes to use when preserving network payloads
 	// (XHRs, etc). EXPERIMENTAL.
-	MaxTotalBufferSize int `json:"maxTotalBufferSize,omitempty"`
+	MaxTotalBufferSize *int `json:"maxTotalBufferSize,omitempty"`
+
+	// Optional. Per-resource buffer size in bytes to use when preserving network
+	// payloads (XHRs, etc). EXPERIMENTAL.
+	MaxResourceBufferSize *int `json:"maxResourceBufferSize,omitempty"`
 
-	// Optional. Per-resource buffer size in bytes to use when preserving
-	// network payloads (XHRs, etc). EXPERIMENTAL.
-	MaxResourceBufferSize int `json:"maxResourceBufferSize,omitempty"`
+	// Optional. Longest post body size (in bytes) that would be included in
+	// requestWillBeSent notification
+	MaxPostDataSize *int `json:"maxPostDataSize,omitempty"`
+
+	// Optional. Whether DirectSocket chunk send/receive events should be reported.
+	// EXPERIMENTAL.
+	ReportDirectSocketTraffic *bool `json:"reportDirectSocketTraffic,omitempty"`
 }
 
 /*
@@ -264,7 +323,6 @@ GetCertificateResult represents the result of calls to Network.getCertificate.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCertificate
 */
 type GetCertificateResult struct {
-	// The list of table names
 	TableNames []string `json:"tableNames"`
 
 	// Error information related to executing this method
@@ -277,7 +335,9 @@ GetCookiesParams represents Network.getCookies parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCookies
 */
 type GetCookiesParams struct {
-	// Optional. The list of URLs for which applicable cookies will be fetched.
+	// Optional. The list of URLs for which applicable cookies will be fetched. If
+	// not specified, it's assumed to be set to the list containing the URLs of the
+	// page and all of its subframes.
 	URLs []string `json:"urls,omitempty"`
 }
 
@@ -320,6 +380,29 @@ type GetResponseBodyResult struct {
 	Err error `json:"-"`
 }
 
+/*
+GetRequestPostDataParams represents Network.getRequestPostData parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getRequestPostData
+*/
+type GetRequestPostDataParams struct {
+	// Identifier of the network request to get content for.
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+GetRequestPostDataResult represents the result of calls to Network.getRequestPostData.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getRequestPostData
+*/
+type GetRequestPostDataResult struct {
+	// Request body string, omitting files from multipart requests
+	PostData string `json:"postData"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetResponseBodyForInterceptionParams represents Network.getResponseBodyForInterception parameters.
 
@@ -331,8 +414,7 @@ type GetResponseBodyForInterceptionParams struct {
 }
 
 /*
-GetResponseBodyForInterceptionResult represents the result of calls to
-Network.getResponseBodyForInterception.
+GetResponseBodyForInterceptionResult represents the result of calls to Network.getResponseBodyForInterception.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBodyForInterception
 */
@@ -347,6 +429,27 @@ type GetResponseBodyForInterceptionResult struct {
 	Err error `json:"-"`
 }
 
+/*
+TakeResponseBodyForInterceptionAsStreamParams represents Network.takeResponseBodyForInterceptionAsStream parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-takeResponseBodyForInterceptionAsStream
+*/
+type TakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionID InterceptionID `json:"interceptionId"`
+}
+
+/*
+TakeResponseBodyForInterceptionAsStreamResult represents the result of calls to Network.takeResponseBodyForInterceptionAsStream.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-takeResponseBodyForInterceptionAsStream
+*/
+type TakeResponseBodyForInterceptionAsStreamResult struct {
+	Stream io.StreamHandle `json:"stream"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 ReplayXHRParams represents Network.replayXHR parameters.
 
@@ -380,10 +483,10 @@ type SearchInResponseBodyParams struct {
 	Query string `json:"query"`
 
 	// Optional. If true, search is case sensitive.
-	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
 
 	// Optional. If true, treats string parameter as regex.
-	IsRegex bool `json:"isRegex,omitempty"`
+	IsRegex *bool `json:"isRegex,omitempty"`
 }
 
 /*
@@ -471,30 +574,56 @@ type SetCookieParams struct {
 	// Cookie value.
 	Value string `json:"value"`
 
-	// Optional. The request-URI to associate with the setting of the cookie.
-	// This value can affect the default domain and path values of the created
-	// cookie.
-	URL string `json:"url,omitempty"`
+	// Optional. The request-URI to associate with the setting of the cookie. This
+	// value can affect the default domain, path, source port, and source scheme
+	// values of the created cookie.
+	URL *string `json:"url,omitempty"`
 
 	// Optional. Cookie domain.
-	Domain string `json:"domain,omitempty"`
+	Domain *string `json:"domain,omitempty"`
 
 	// Optional. Cookie path.
-	Path string `json:"path,omitempty"`
+	Path *string `json:"path,omitempty"`
 
 	// Optional. True if cookie is secure.
-	Secure bool `json:"secure,omitempty"`
+	Secure *bool `json:"secure,omitempty"`
 
 	// Optional. True if cookie is http-only.
-	HTTPOnly bool `json:"httpOnly,omitempty"`
+	HTTPOnly *bool `json:"httpOnly,omitempty"`
 
 	// Optional. Cookie SameSite type. Allowed values:
-	//	- CookieSameSite.Strict
-	//	- CookieSameSite.Lax
+	//   - CookieSameSite.Strict
+	//   - CookieSameSite.Lax
+	//   - CookieSameSite.None
 	SameSite CookieSameSiteEnum `json:"sameSite,omitempty"`
 
-	// Optional. Cookie expiration date, session cookie if not set.
-	Expires TimeSinceEpoch `json:"expires,omitempty"`
+	// Optional. Cookie expiration date, session cookie if not set
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+
+	// Optional. Cookie Priority type. EXPERIMENTAL. Allowed values:
+	//   - CookiePriority.Low
+	//   - CookiePriority.Medium
+	//   - CookiePriority.High
+	Priority CookiePriorityEnum `json:"priority,omitempty"`
+
+	// Optional. True if cookie is SameParty. EXPERIMENTAL.
+	SameParty *bool `json:"sameParty,omitempty"`
+
+	// Optional. Cookie source scheme type. EXPERIMENTAL. Allowed values:
+	//   - CookieSourceScheme.Unset
+	//   - CookieSourceScheme.NonSecure
+	//   - CookieSourceScheme.Secure
+	SourceScheme CookieSourceSchemeEnum `json:"sourceScheme,omitempty"`
+
+	// Optional. Cookie source port. Valid values are {-1, [1, 65535]}, -1
+	// indicates an unspecified port. An unspecified port value allows protocol
+	// clients to emulate legacy cookie scope for the port. This is a temporary
+	// ability and it will be removed in the future. EXPERIMENTAL.
+	SourcePort *int `json:"sourcePort,omitempty"`
+
+	// Optional. Cookie partition key. If not set, the cookie will be set as not
+	// partitioned. EXPERIMENTAL.
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
 }
 
 /*
@@ -503,7 +632,8 @@ SetCookieResult represents the result of calls to Network.setCookie.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 */
 type SetCookieResult struct {
-	// True if successfully set cookie.
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error. DEPRECATED.
 	Success bool `json:"success"`
 
 	// Error information related to executing this method
@@ -517,7 +647,7 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 */
 type SetCookiesParams struct {
 	// Cookies to be set.
-	Cookies []*SetCookieParams `json:"cookies"`
+	Cookies []*CookieParam `json:"cookies"`
 }
 
 /*
@@ -531,45 +661,41 @@ type SetCookiesResult struct {
 }
 
 /*
-SetDataSizeLimitsForTestParams represents Network.setDataSizeLimitsForTest parameters.
+SetExtraHTTPHeadersParams represents Network.setExtraHTTPHeaders parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
 */
-type SetDataSizeLimitsForTestParams struct {
-	// Maximum total buffer size.
-	MaxTotalSize int `json:"maxTotalSize"`
-
-	// Maximum per-resource size.
-	MaxResourceSize int `json:"maxResourceSize"`
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
 }
 
 /*
-SetDataSizeLimitsForTestResult represents the result of calls to
-Network.setDataSizeLimitsForTest.
+SetExtraHTTPHeadersResult represents the result of calls to Network.setExtraHTTPHeaders.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
 */
-type SetDataSizeLimitsForTestResult struct {
+type SetExtraHTTPHeadersResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-SetExtraHTTPHeadersParams represents Network.setExtraHTTPHeaders parameters.
+SetAttachDebugStackParams represents Network.setAttachDebugStack parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAttachDebugStack
 */
-type SetExtraHTTPHeadersParams struct {
-	// Map with extra HTTP headers.
-	Headers Headers `json:"headers"`
+type SetAttachDebugStackParams struct {
+	// Whether to attach a page script stack for debugging purpose.
+	Enabled bool `json:"enabled"`
 }
 
 /*
-SetExtraHTTPHeadersResult represents the result of calls to Network.setExtraHTTPHeaders.
+SetAttachDebugStackResult represents the result of calls to Network.setAttachDebugStack.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAttachDebugStack
 */
-type SetExtraHTTPHeadersResult struct {
+type SetAttachDebugStackResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -580,8 +706,8 @@ SetRequestInterceptionParams represents Network.setRequestInterception parameter
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setRequestInterception
 */
 type SetRequestInterceptionParams struct {
-	// Requests matching any of these patterns will be forwarded and wait for
-	// the corresponding continueInterceptedRequest call.
+	// Requests matching any of these patterns will be forwarded and wait for the
+	// corresponding continueInterceptedRequest call.
 	Patterns []*RequestPattern `json:"patterns"`
 }
 
@@ -603,6 +729,16 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setUserAg
 type SetUserAgentOverrideParams struct {
 	// User agent to use.
 	UserAgent string `json:"userAgent"`
+
+	// Optional. Browser language to emulate.
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"`
+
+	// Optional. The platform navigator.platform should return.
+	Platform *string `json:"platform,omitempty"`
+
+	// Optional. To be sent in Sec-CH-UA-* headers and returned in
+	// navigator.userAgentData EXPERIMENTAL.
+	UserAgentMetadata *emulation.UserAgentMetadata `json:"userAgentMetadata,omitempty"`
 }
 
 /*
@@ -614,3 +750,147 @@ type SetUserAgentOverrideResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+StreamResourceContentParams represents Network.streamResourceContent parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-streamResourceContent
+*/
+type StreamResourceContentParams struct {
+	// Identifier of the request to stream.
+	RequestID RequestID `json:"requestId"`
+}
+
+/*
+StreamResourceContentResult represents the result of calls to Network.streamResourceContent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-streamResourceContent
+*/
+type StreamResourceContentResult struct {
+	// Data that has been buffered until streaming is enabled. (Encoded as a base64
+	// string when passed over JSON)
+	BufferedData string `json:"bufferedData"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetSecurityIsolationStatusParams represents Network.getSecurityIsolationStatus parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getSecurityIsolationStatus
+*/
+type GetSecurityIsolationStatusParams struct {
+	// Optional. If no frameId is provided, the status of the target is provided.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+}
+
+/*
+GetSecurityIsolationStatusResult represents the result of calls to Network.getSecurityIsolationStatus.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getSecurityIsolationStatus
+*/
+type GetSecurityIsolationStatusResult struct {
+	Status *SecurityIsolationStatus `json:"status"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+EnableReportingAPIParams represents Network.enableReportingApi parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enableReportingApi
+*/
+type EnableReportingAPIParams struct {
+	// Whether to enable or disable events for the Reporting API
+	Enable bool `json:"enable"`
+}
+
+/*
+EnableReportingAPIResult represents the result of calls to Network.enableReportingApi.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enableReportingApi
+*/
+type EnableReportingAPIResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+LoadNetworkResourceParams represents Network.loadNetworkResource parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-loadNetworkResource
+*/
+type LoadNetworkResourceParams struct {
+	// Optional. Frame id to get the resource for. Mandatory for frame targets, and
+	// should be omitted for worker targets.
+	FrameID *page.FrameID `json:"frameId,omitempty"`
+
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+
+	// Options for the request.
+	Options *LoadNetworkResourceOptions `json:"options"`
+}
+
+/*
+LoadNetworkResourceResult represents the result of calls to Network.loadNetworkResource.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-loadNetworkResource
+*/
+type LoadNetworkResourceResult struct {
+	Resource *LoadNetworkResourcePageResult `json:"resource"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCookieControlsParams represents Network.setCookieControls parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookieControls
+*/
+type SetCookieControlsParams struct {
+	// Whether 3pc restriction is enabled.
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+
+	// Whether 3pc grace period exception should be enabled; false by default.
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+
+	// Whether 3pc heuristics exceptions should be enabled; false by default.
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+/*
+SetCookieControlsResult represents the result of calls to Network.setCookieControls.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookieControls
+*/
+type SetCookieControlsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDataSizeLimitsForTestParams represents Network.setDataSizeLimitsForTest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+*/
+type SetDataSizeLimitsForTestParams struct {
+	// Maximum total buffer size.
+	MaxTotalSize int `json:"maxTotalSize"`
+
+	// Maximum per-resource size.
+	MaxResourceSize int `json:"maxResourceSize"`
+}
+
+/*
+SetDataSizeLimitsForTestResult represents the result of calls to Network.setDataSizeLimitsForTest.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+*/
+type SetDataSizeLimitsForTestResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,147 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAlternateProtocolUsage(t *testing.T) {
+	var enum AlternateProtocolUsageEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AlternateProtocolUsageEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AlternateProtocolUsage.AlternativeJobWonWithoutRace
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"alternativeJobWonWithoutRace"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"alternativeJobWonWithoutRace"`, result)
+	}
+	json.Unmarshal([]byte(`"alternativeJobWonWithoutRace"`), &enum)
+	if AlternateProtocolUsage.AlternativeJobWonWithoutRace != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.AlternativeJobWonWithoutRace, enum)
+	}
+
+	enum = AlternateProtocolUsage.AlternativeJobWonRace
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"alternativeJobWonRace"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"alternativeJobWonRace"`, result)
+	}
+	json.Unmarshal([]byte(`"alternativeJobWonRace"`), &enum)
+	if AlternateProtocolUsage.AlternativeJobWonRace != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.AlternativeJobWonRace, enum)
+	}
+
+	enum = AlternateProtocolUsage.MainJobWonRace
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mainJobWonRace"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mainJobWonRace"`, result)
+	}
+	json.Unmarshal([]byte(`"mainJobWonRace"`), &enum)
+	if AlternateProtocolUsage.MainJobWonRace != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.MainJobWonRace, enum)
+	}
+
+	enum = AlternateProtocolUsage.MappingMissing
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mappingMissing"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mappingMissing"`, result)
+	}
+	json.Unmarshal([]byte(`"mappingMissing"`), &enum)
+	if AlternateProtocolUsage.MappingMissing != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.MappingMissing, enum)
+	}
+
+	enum = AlternateProtocolUsage.Broken
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"broken"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"broken"`, result)
+	}
+	json.Unmarshal([]byte(`"broken"`), &enum)
+	if AlternateProtocolUsage.Broken != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.Broken, enum)
+	}
+
+	enum = AlternateProtocolUsage.DNSAlpnH3JobWonWithoutRace
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dnsAlpnH3JobWonWithoutRace"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dnsAlpnH3JobWonWithoutRace"`, result)
+	}
+	json.Unmarshal([]byte(`"dnsAlpnH3JobWonWithoutRace"`), &enum)
+	if AlternateProtocolUsage.DNSAlpnH3JobWonWithoutRace != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.DNSAlpnH3JobWonWithoutRace, enum)
+	}
+
+	enum = AlternateProtocolUsage.DNSAlpnH3JobWonRace
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dnsAlpnH3JobWonRace"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dnsAlpnH3JobWonRace"`, result)
+	}
+	json.Unmarshal([]byte(`"dnsAlpnH3JobWonRace"`), &enum)
+	if AlternateProtocolUsage.DNSAlpnH3JobWonRace != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.DNSAlpnH3JobWonRace, enum)
+	}
+
+	enum = AlternateProtocolUsage.UnspecifiedReason
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unspecifiedReason"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unspecifiedReason"`, result)
+	}
+	json.Unmarshal([]byte(`"unspecifiedReason"`), &enum)
+	if AlternateProtocolUsage.UnspecifiedReason != enum {
+		t.Errorf("Expected %d, got %d", AlternateProtocolUsage.UnspecifiedReason, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type sourceEnum struct {
@@ -11,7 +14,7 @@ type sourceEnum struct {
 }
 
 /*
-Source provides named acces to the SourceEnum values.
+Source provides named access to the SourceEnum values.
 */
 var Source = sourceEnum{
 	Server: sourceServer,
@@ -19,9 +22,13 @@ var Source = sourceEnum{
 }
 
 /*
-SourceEnum is optional. Source of the authentication challenge. Allowed values:
-	- Source.Server "Server"
-	- Source.Proxy  "Proxy"
+SourceEnum represents the Network.AuthChallenge.source values.
+
+Source of the authentication challenge.
+
+Allowed values:
+  - Source.Server "Server"
+  - Source.Proxy  "Proxy"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AuthChallenge
 */
@@ -31,7 +38,16 @@ type SourceEnum int
 String implements Stringer
 */
 func (enum SourceEnum) String() string {
-	return _sourceEnums[enum]
+	return _sourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SourceEnum) Unknown() bool {
+	return !_sourceEnums.Known(enum)
 }
 
 /*
@@ -42,25 +58,16 @@ func (enum SourceEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *SourceEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _sourceEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _sourceEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -70,8 +77,8 @@ const (
 	sourceProxy
 )
 
-var _sourceEnums = map[SourceEnum]string{
+var _sourceEnums = enums.New(map[SourceEnum]string{
 	SourceEnum(0): "",
 	sourceServer:  "Server",
 	sourceProxy:   "Proxy",
-}
+})
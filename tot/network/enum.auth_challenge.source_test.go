@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -15,9 +17,20 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown SourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Server"` != string(result) {
-		t.Errorf("Expected '\"Server\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Server"`, result)
 	}
 	json.Unmarshal([]byte(`"Server"`), &enum)
 	if Source.Server != enum {
-		t.Errorf("Expcected %d, got %d", Source.Server, enum)
+		t.Errorf("Expected %d, got %d", Source.Server, enum)
 	}
 
 	enum = Source.Proxy
@@ -47,10 +60,10 @@ func TestEnumSource(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Proxy"` != string(result) {
-		t.Errorf("Expected '\"Proxy\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Proxy"`, result)
 	}
 	json.Unmarshal([]byte(`"Proxy"`), &enum)
 	if Source.Proxy != enum {
-		t.Errorf("Expcected %d, got %d", Source.Proxy, enum)
+		t.Errorf("Expected %d, got %d", Source.Proxy, enum)
 	}
 }
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type challengeResponseEnum struct {
@@ -12,7 +15,7 @@ type challengeResponseEnum struct {
 }
 
 /*
-ChallengeResponse provides named acces to the ChallengeResponseEnum values.
+ChallengeResponse provides named access to the ChallengeResponseEnum values.
 */
 var ChallengeResponse = challengeResponseEnum{
 	Default:            challengeResponseDefault,
@@ -21,15 +24,19 @@ var ChallengeResponse = challengeResponseEnum{
 }
 
 /*
-ChallengeResponseEnum represents the decision on what to do in response to the
-authorization challenge. Default means deferring to the default behavior of the
-net stack, which will likely either the Cancel authentication or display a popup
-dialog box. Allowed values:
-	- ChallengeResponse.Default            "Default"
-	- ChallengeResponse.CancelAuth         "CancelAuth"
-	- ChallengeResponse.ProvideCredentials "ProvideCredentials"
+ChallengeResponseEnum represents the Network.AuthChallengeResponse.response
+values.
+
+The decision on what to do in response to the authorization challenge. Default
+means deferring to the default behavior of the net stack, which will likely
+either the Cancel authentication or display a popup dialog box.
+
+Allowed values:
+  - ChallengeResponse.Default            "Default"
+  - ChallengeResponse.CancelAuth         "CancelAuth"
+  - ChallengeResponse.ProvideCredentials "ProvideCredentials"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AuthChallenge
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-AuthChallengeResponse
 */
 type ChallengeResponseEnum int
 
@@ -37,7 +44,16 @@ type ChallengeResponseEnum int
 String implements Stringer
 */
 func (enum ChallengeResponseEnum) String() string {
-	return _challengeResponseEnums[enum]
+	return _challengeResponseEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ChallengeResponseEnum) Unknown() bool {
+	return !_challengeResponseEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum ChallengeResponseEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ChallengeResponseEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _challengeResponseEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _challengeResponseEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -78,8 +85,9 @@ const (
 	challengeResponseProvideCredentials
 )
 
-var _challengeResponseEnums = map[ChallengeResponseEnum]string{
+var _challengeResponseEnums = enums.New(map[ChallengeResponseEnum]string{
+	ChallengeResponseEnum(0):            "",
 	challengeResponseDefault:            "Default",
 	challengeResponseCancelAuth:         "CancelAuth",
 	challengeResponseProvideCredentials: "ProvideCredentials",
-}
+})
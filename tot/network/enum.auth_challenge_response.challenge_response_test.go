@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumChallengeResponse(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ChallengeResponseEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumChallengeResponse(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Default"` != string(result) {
-		t.Errorf("Expected '\"Default\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Default"`, result)
 	}
 	json.Unmarshal([]byte(`"Default"`), &enum)
 	if ChallengeResponse.Default != enum {
-		t.Errorf("Expcected %d, got %d", ChallengeResponse.Default, enum)
+		t.Errorf("Expected %d, got %d", ChallengeResponse.Default, enum)
 	}
 
 	enum = ChallengeResponse.CancelAuth
@@ -42,11 +60,11 @@ func TestEnumChallengeResponse(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"CancelAuth"` != string(result) {
-		t.Errorf("Expected '\"CancelAuth\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"CancelAuth"`, result)
 	}
 	json.Unmarshal([]byte(`"CancelAuth"`), &enum)
 	if ChallengeResponse.CancelAuth != enum {
-		t.Errorf("Expcected %d, got %d", ChallengeResponse.CancelAuth, enum)
+		t.Errorf("Expected %d, got %d", ChallengeResponse.CancelAuth, enum)
 	}
 
 	enum = ChallengeResponse.ProvideCredentials
@@ -55,10 +73,10 @@ func TestEnumChallengeResponse(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ProvideCredentials"` != string(result) {
-		t.Errorf("Expected '\"ProvideCredentials\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ProvideCredentials"`, result)
 	}
 	json.Unmarshal([]byte(`"ProvideCredentials"`), &enum)
 	if ChallengeResponse.ProvideCredentials != enum {
-		t.Errorf("Expcected %d, got %d", ChallengeResponse.ProvideCredentials, enum)
+		t.Errorf("Expected %d, got %d", ChallengeResponse.ProvideCredentials, enum)
 	}
 }
@@ -1,39 +1,76 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type blockedReasonEnum struct {
-	Csp               BlockedReasonEnum
-	MixedContent      BlockedReasonEnum
-	Origin            BlockedReasonEnum
-	Inspector         BlockedReasonEnum
-	SubresourceFilter BlockedReasonEnum
-	Other             BlockedReasonEnum
+	Other                                                   BlockedReasonEnum
+	Csp                                                     BlockedReasonEnum
+	MixedContent                                            BlockedReasonEnum
+	Origin                                                  BlockedReasonEnum
+	Inspector                                               BlockedReasonEnum
+	Integrity                                               BlockedReasonEnum
+	SubresourceFilter                                       BlockedReasonEnum
+	ContentType                                             BlockedReasonEnum
+	CoepFrameResourceNeedsCoepHeader                        BlockedReasonEnum
+	CoopSandboxedIframeCannotNavigateToCoopPage             BlockedReasonEnum
+	CorpNotSameOrigin                                       BlockedReasonEnum
+	CorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedReasonEnum
+	CorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedReasonEnum
+	CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedReasonEnum
+	CorpNotSameSite                                         BlockedReasonEnum
+	SriMessageSignatureMismatch                             BlockedReasonEnum
 }
 
 /*
-BlockedReason provides named acces to the BlockedReasonEnum values.
+BlockedReason provides named access to the BlockedReasonEnum values.
 */
 var BlockedReason = blockedReasonEnum{
-	Csp:               blockedReasonCsp,
-	MixedContent:      blockedReasonMixedContent,
-	Origin:            blockedReasonOrigin,
-	Inspector:         blockedReasonInspector,
-	SubresourceFilter: blockedReasonSubresourceFilter,
-	Other:             blockedReasonOther,
+	Other:                            blockedReasonOther,
+	Csp:                              blockedReasonCsp,
+	MixedContent:                     blockedReasonMixedContent,
+	Origin:                           blockedReasonOrigin,
+	Inspector:                        blockedReasonInspector,
+	Integrity:                        blockedReasonIntegrity,
+	SubresourceFilter:                blockedReasonSubresourceFilter,
+	ContentType:                      blockedReasonContentType,
+	CoepFrameResourceNeedsCoepHeader: blockedReasonCoepFrameResourceNeedsCoepHeader,
+	CoopSandboxedIframeCannotNavigateToCoopPage: blockedReasonCoopSandboxedIframeCannotNavigateToCoopPage,
+	CorpNotSameOrigin: blockedReasonCorpNotSameOrigin,
+	CorpNotSameOriginAfterDefaultedToSameOriginByCoep:       blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep,
+	CorpNotSameOriginAfterDefaultedToSameOriginByDip:        blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip,
+	CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip: blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip,
+	CorpNotSameSite:             blockedReasonCorpNotSameSite,
+	SriMessageSignatureMismatch: blockedReasonSriMessageSignatureMismatch,
 }
 
 /*
-BlockedReasonEnum defines the reason why request was blocked. Allowed values:
-	- BlockedReason.Csp               "csp"
-	- BlockedReason.MixedContent      "mixed-content"
-	- BlockedReason.Origin            "origin"
-	- BlockedReason.Inspector         "inspector"
-	- BlockedReason.SubresourceFilter "subresource-filter"
-	- BlockedReason.Other             "other"
+BlockedReasonEnum represents the Network.BlockedReason values.
+
+The reason why request was blocked.
+
+Allowed values:
+  - BlockedReason.Other                                                   "other"
+  - BlockedReason.Csp                                                     "csp"
+  - BlockedReason.MixedContent                                            "mixed-content"
+  - BlockedReason.Origin                                                  "origin"
+  - BlockedReason.Inspector                                               "inspector"
+  - BlockedReason.Integrity                                               "integrity"
+  - BlockedReason.SubresourceFilter                                       "subresource-filter"
+  - BlockedReason.ContentType                                             "content-type"
+  - BlockedReason.CoepFrameResourceNeedsCoepHeader                        "coep-frame-resource-needs-coep-header"
+  - BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage             "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+  - BlockedReason.CorpNotSameOrigin                                       "corp-not-same-origin"
+  - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep       "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+  - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip        "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+  - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+  - BlockedReason.CorpNotSameSite                                         "corp-not-same-site"
+  - BlockedReason.SriMessageSignatureMismatch                             "sri-message-signature-mismatch"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-BlockedReason
 */
@@ -43,7 +80,16 @@ type BlockedReasonEnum int
 String implements Stringer
 */
 func (enum BlockedReasonEnum) String() string {
-	return _blockedReasonEnums[enum]
+	return _blockedReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BlockedReasonEnum) Unknown() bool {
+	return !_blockedReasonEnums.Known(enum)
 }
 
 /*
@@ -54,48 +100,69 @@ func (enum BlockedReasonEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *BlockedReasonEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _blockedReasonEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _blockedReasonEnums.Parse(val)
+	return nil
 }
 
 const (
+	// blockedReasonOther represents the "other" value.
+	blockedReasonOther BlockedReasonEnum = iota + 1
 	// blockedReasonCsp represents the "csp" value.
-	blockedReasonCsp BlockedReasonEnum = iota + 1
+	blockedReasonCsp
 	// blockedReasonMixedContent represents the "mixed-content" value.
 	blockedReasonMixedContent
 	// blockedReasonOrigin represents the "origin" value.
 	blockedReasonOrigin
 	// blockedReasonInspector represents the "inspector" value.
 	blockedReasonInspector
+	// blockedReasonIntegrity represents the "integrity" value.
+	blockedReasonIntegrity
 	// blockedReasonSubresourceFilter represents the "subresource-filter" value.
 	blockedReasonSubresourceFilter
-	// blockedReasonOther represents the "other" value.
-	blockedReasonOther
+	// blockedReasonContentType represents the "content-type" value.
+	blockedReasonContentType
+	// blockedReasonCoepFrameResourceNeedsCoepHeader represents the "coep-frame-resource-needs-coep-header" value.
+	blockedReasonCoepFrameResourceNeedsCoepHeader
+	// blockedReasonCoopSandboxedIframeCannotNavigateToCoopPage represents the "coop-sandboxed-iframe-cannot-navigate-to-coop-page" value.
+	blockedReasonCoopSandboxedIframeCannotNavigateToCoopPage
+	// blockedReasonCorpNotSameOrigin represents the "corp-not-same-origin" value.
+	blockedReasonCorpNotSameOrigin
+	// blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep represents the "corp-not-same-origin-after-defaulted-to-same-origin-by-coep" value.
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	// blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip represents the "corp-not-same-origin-after-defaulted-to-same-origin-by-dip" value.
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip
+	// blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip represents the "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip" value.
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	// blockedReasonCorpNotSameSite represents the "corp-not-same-site" value.
+	blockedReasonCorpNotSameSite
+	// blockedReasonSriMessageSignatureMismatch represents the "sri-message-signature-mismatch" value.
+	blockedReasonSriMessageSignatureMismatch
 )
 
-var _blockedReasonEnums = map[BlockedReasonEnum]string{
-	BlockedReasonEnum(0):           "",
-	blockedReasonCsp:               "csp",
-	blockedReasonMixedContent:      "mixed-content",
-	blockedReasonOrigin:            "origin",
-	blockedReasonInspector:         "inspector",
-	blockedReasonSubresourceFilter: "subresource-filter",
-	blockedReasonOther:             "other",
-}
+var _blockedReasonEnums = enums.New(map[BlockedReasonEnum]string{
+	BlockedReasonEnum(0):                                                 "",
+	blockedReasonOther:                                                   "other",
+	blockedReasonCsp:                                                     "csp",
+	blockedReasonMixedContent:                                            "mixed-content",
+	blockedReasonOrigin:                                                  "origin",
+	blockedReasonInspector:                                               "inspector",
+	blockedReasonIntegrity:                                               "integrity",
+	blockedReasonSubresourceFilter:                                       "subresource-filter",
+	blockedReasonContentType:                                             "content-type",
+	blockedReasonCoepFrameResourceNeedsCoepHeader:                        "coep-frame-resource-needs-coep-header",
+	blockedReasonCoopSandboxedIframeCannotNavigateToCoopPage:             "coop-sandboxed-iframe-cannot-navigate-to-coop-page",
+	blockedReasonCorpNotSameOrigin:                                       "corp-not-same-origin",
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep:       "corp-not-same-origin-after-defaulted-to-same-origin-by-coep",
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip:        "corp-not-same-origin-after-defaulted-to-same-origin-by-dip",
+	blockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip: "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip",
+	blockedReasonCorpNotSameSite:                                         "corp-not-same-site",
+	blockedReasonSriMessageSignatureMismatch:                             "sri-message-signature-mismatch",
+})
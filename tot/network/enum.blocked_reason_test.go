@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -15,9 +17,20 @@ func TestEnumBlockedReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown BlockedReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -28,17 +41,30 @@ func TestEnumBlockedReason(t *testing.T) {
 		t.Errorf("Expected empty JSON string, got '%s'", result)
 	}
 
+	enum = BlockedReason.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
+	}
+	json.Unmarshal([]byte(`"other"`), &enum)
+	if BlockedReason.Other != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.Other, enum)
+	}
+
 	enum = BlockedReason.Csp
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"csp"` != string(result) {
-		t.Errorf("Expected '\"csp\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"csp"`, result)
 	}
 	json.Unmarshal([]byte(`"csp"`), &enum)
 	if BlockedReason.Csp != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.Csp, enum)
+		t.Errorf("Expected %d, got %d", BlockedReason.Csp, enum)
 	}
 
 	enum = BlockedReason.MixedContent
@@ -47,11 +73,11 @@ func TestEnumBlockedReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"mixed-content"` != string(result) {
-		t.Errorf("Expected '\"mixed-content\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"mixed-content"`, result)
 	}
 	json.Unmarshal([]byte(`"mixed-content"`), &enum)
 	if BlockedReason.MixedContent != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.MixedContent, enum)
+		t.Errorf("Expected %d, got %d", BlockedReason.MixedContent, enum)
 	}
 
 	enum = BlockedReason.Origin
@@ -60,18 +86,12 @@ func TestEnumBlockedReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"origin"` != string(result) {
-		t.Errorf("Expected '\"origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"origin"`, result)
 	}
 	json.Unmarshal([]byte(`"origin"`), &enum)
 	if BlockedReason.Origin != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.Origin, enum)
+		t.Errorf("Expected %d, got %d", BlockedReason.Origin, enum)
 	}
-}
-
-func TestEnumBlockedReason2(t *testing.T) {
-	var enum BlockedReasonEnum
-	var err error
-	var result []byte
 
 	enum = BlockedReason.Inspector
 	result, err = json.Marshal(enum)
@@ -79,11 +99,24 @@ func TestEnumBlockedReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"inspector"` != string(result) {
-		t.Errorf("Expected '\"inspector\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"inspector"`, result)
 	}
 	json.Unmarshal([]byte(`"inspector"`), &enum)
 	if BlockedReason.Inspector != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.Inspector, enum)
+		t.Errorf("Expected %d, got %d", BlockedReason.Inspector, enum)
+	}
+
+	enum = BlockedReason.Integrity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"integrity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"integrity"`, result)
+	}
+	json.Unmarshal([]byte(`"integrity"`), &enum)
+	if BlockedReason.Integrity != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.Integrity, enum)
 	}
 
 	enum = BlockedReason.SubresourceFilter
@@ -92,23 +125,127 @@ func TestEnumBlockedReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"subresource-filter"` != string(result) {
-		t.Errorf("Expected '\"subresource-filter\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"subresource-filter"`, result)
 	}
 	json.Unmarshal([]byte(`"subresource-filter"`), &enum)
 	if BlockedReason.SubresourceFilter != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.SubresourceFilter, enum)
+		t.Errorf("Expected %d, got %d", BlockedReason.SubresourceFilter, enum)
 	}
 
-	enum = BlockedReason.Other
+	enum = BlockedReason.ContentType
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
-	if `"other"` != string(result) {
-		t.Errorf("Expected '\"other\"', got '%s'", result)
+	if `"content-type"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"content-type"`, result)
 	}
-	json.Unmarshal([]byte(`"other"`), &enum)
-	if BlockedReason.Other != enum {
-		t.Errorf("Expcected %d, got %d", BlockedReason.Other, enum)
+	json.Unmarshal([]byte(`"content-type"`), &enum)
+	if BlockedReason.ContentType != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.ContentType, enum)
+	}
+
+	enum = BlockedReason.CoepFrameResourceNeedsCoepHeader
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"coep-frame-resource-needs-coep-header"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"coep-frame-resource-needs-coep-header"`, result)
+	}
+	json.Unmarshal([]byte(`"coep-frame-resource-needs-coep-header"`), &enum)
+	if BlockedReason.CoepFrameResourceNeedsCoepHeader != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CoepFrameResourceNeedsCoepHeader, enum)
+	}
+
+	enum = BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"coop-sandboxed-iframe-cannot-navigate-to-coop-page"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"coop-sandboxed-iframe-cannot-navigate-to-coop-page"`, result)
+	}
+	json.Unmarshal([]byte(`"coop-sandboxed-iframe-cannot-navigate-to-coop-page"`), &enum)
+	if BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage, enum)
+	}
+
+	enum = BlockedReason.CorpNotSameOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"corp-not-same-origin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"corp-not-same-origin"`, result)
+	}
+	json.Unmarshal([]byte(`"corp-not-same-origin"`), &enum)
+	if BlockedReason.CorpNotSameOrigin != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CorpNotSameOrigin, enum)
+	}
+
+	enum = BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"corp-not-same-origin-after-defaulted-to-same-origin-by-coep"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"corp-not-same-origin-after-defaulted-to-same-origin-by-coep"`, result)
+	}
+	json.Unmarshal([]byte(`"corp-not-same-origin-after-defaulted-to-same-origin-by-coep"`), &enum)
+	if BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep, enum)
+	}
+
+	enum = BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"corp-not-same-origin-after-defaulted-to-same-origin-by-dip"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"corp-not-same-origin-after-defaulted-to-same-origin-by-dip"`, result)
+	}
+	json.Unmarshal([]byte(`"corp-not-same-origin-after-defaulted-to-same-origin-by-dip"`), &enum)
+	if BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip, enum)
+	}
+
+	enum = BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"`, result)
+	}
+	json.Unmarshal([]byte(`"corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"`), &enum)
+	if BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip, enum)
+	}
+
+	enum = BlockedReason.CorpNotSameSite
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"corp-not-same-site"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"corp-not-same-site"`, result)
+	}
+	json.Unmarshal([]byte(`"corp-not-same-site"`), &enum)
+	if BlockedReason.CorpNotSameSite != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.CorpNotSameSite, enum)
+	}
+
+	enum = BlockedReason.SriMessageSignatureMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sri-message-signature-mismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sri-message-signature-mismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"sri-message-signature-mismatch"`), &enum)
+	if BlockedReason.SriMessageSignatureMismatch != enum {
+		t.Errorf("Expected %d, got %d", BlockedReason.SriMessageSignatureMismatch, enum)
 	}
 }
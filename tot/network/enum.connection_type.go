@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type connectionTypeEnum struct {
@@ -18,7 +21,7 @@ type connectionTypeEnum struct {
 }
 
 /*
-ConnectionType provides named acces to the ConnectionTypeEnum values.
+ConnectionType provides named access to the ConnectionTypeEnum values.
 */
 var ConnectionType = connectionTypeEnum{
 	None:       connectionTypeNone,
@@ -33,17 +36,20 @@ var ConnectionType = connectionTypeEnum{
 }
 
 /*
-ConnectionTypeEnum is the underlying connection technology that the browser is
-supposedly using. Allowed values:
-	- ConnectionType.None       "none"
-	- ConnectionType.Cellular2g "cellular2g"
-	- ConnectionType.Cellular3g "cellular3g"
-	- ConnectionType.Cellular4g "cellular4g"
-	- ConnectionType.Bluetooth  "bluetooth"
-	- ConnectionType.Ethernet   "ethernet"
-	- ConnectionType.Wifi       "wifi"
-	- ConnectionType.Wimax      "wimax"
-	- ConnectionType.Other      "other"
+ConnectionTypeEnum represents the Network.ConnectionType values.
+
+The underlying connection technology that the browser is supposedly using.
+
+Allowed values:
+  - ConnectionType.None       "none"
+  - ConnectionType.Cellular2g "cellular2g"
+  - ConnectionType.Cellular3g "cellular3g"
+  - ConnectionType.Cellular4g "cellular4g"
+  - ConnectionType.Bluetooth  "bluetooth"
+  - ConnectionType.Ethernet   "ethernet"
+  - ConnectionType.Wifi       "wifi"
+  - ConnectionType.Wimax      "wimax"
+  - ConnectionType.Other      "other"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ConnectionType
 */
@@ -53,7 +59,16 @@ type ConnectionTypeEnum int
 String implements Stringer
 */
 func (enum ConnectionTypeEnum) String() string {
-	return _connectionTypeEnums[enum]
+	return _connectionTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ConnectionTypeEnum) Unknown() bool {
+	return !_connectionTypeEnums.Known(enum)
 }
 
 /*
@@ -64,25 +79,16 @@ func (enum ConnectionTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ConnectionTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _connectionTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _connectionTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -106,7 +112,7 @@ const (
 	connectionTypeOther
 )
 
-var _connectionTypeEnums = map[ConnectionTypeEnum]string{
+var _connectionTypeEnums = enums.New(map[ConnectionTypeEnum]string{
 	ConnectionTypeEnum(0):    "",
 	connectionTypeNone:       "none",
 	connectionTypeCellular2g: "cellular2g",
@@ -117,4 +123,4 @@ var _connectionTypeEnums = map[ConnectionTypeEnum]string{
 	connectionTypeWifi:       "wifi",
 	connectionTypeWimax:      "wimax",
 	connectionTypeOther:      "other",
-}
+})
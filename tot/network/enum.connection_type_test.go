@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -15,9 +17,20 @@ func TestEnumConnectionType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown ConnectionTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumConnectionType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"none"` != string(result) {
-		t.Errorf("Expected '\"none\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
 	}
 	json.Unmarshal([]byte(`"none"`), &enum)
 	if ConnectionType.None != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.None, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.None, enum)
 	}
 
 	enum = ConnectionType.Cellular2g
@@ -47,11 +60,11 @@ func TestEnumConnectionType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"cellular2g"` != string(result) {
-		t.Errorf("Expected '\"cellular2g\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"cellular2g"`, result)
 	}
 	json.Unmarshal([]byte(`"cellular2g"`), &enum)
 	if ConnectionType.Cellular2g != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Cellular2g, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular2g, enum)
 	}
 
 	enum = ConnectionType.Cellular3g
@@ -60,18 +73,12 @@ func TestEnumConnectionType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"cellular3g"` != string(result) {
-		t.Errorf("Expected '\"cellular3g\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"cellular3g"`, result)
 	}
 	json.Unmarshal([]byte(`"cellular3g"`), &enum)
 	if ConnectionType.Cellular3g != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Cellular3g, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular3g, enum)
 	}
-}
-
-func TestEnumConnectionType2(t *testing.T) {
-	var enum ConnectionTypeEnum
-	var err error
-	var result []byte
 
 	enum = ConnectionType.Cellular4g
 	result, err = json.Marshal(enum)
@@ -79,11 +86,11 @@ func TestEnumConnectionType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"cellular4g"` != string(result) {
-		t.Errorf("Expected '\"cellular4g\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"cellular4g"`, result)
 	}
 	json.Unmarshal([]byte(`"cellular4g"`), &enum)
 	if ConnectionType.Cellular4g != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Cellular4g, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Cellular4g, enum)
 	}
 
 	enum = ConnectionType.Bluetooth
@@ -92,11 +99,11 @@ func TestEnumConnectionType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"bluetooth"` != string(result) {
-		t.Errorf("Expected '\"bluetooth\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"bluetooth"`, result)
 	}
 	json.Unmarshal([]byte(`"bluetooth"`), &enum)
 	if ConnectionType.Bluetooth != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Bluetooth, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Bluetooth, enum)
 	}
 
 	enum = ConnectionType.Ethernet
@@ -105,11 +112,11 @@ func TestEnumConnectionType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ethernet"` != string(result) {
-		t.Errorf("Expected '\"ethernet\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ethernet"`, result)
 	}
 	json.Unmarshal([]byte(`"ethernet"`), &enum)
 	if ConnectionType.Ethernet != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Ethernet, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Ethernet, enum)
 	}
 
 	enum = ConnectionType.Wifi
@@ -118,18 +125,12 @@ func TestEnumConnectionType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"wifi"` != string(result) {
-		t.Errorf("Expected '\"wifi\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"wifi"`, result)
 	}
 	json.Unmarshal([]byte(`"wifi"`), &enum)
 	if ConnectionType.Wifi != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Wifi, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Wifi, enum)
 	}
-}
-
-func TestEnumConnectionType3(t *testing.T) {
-	var enum ConnectionTypeEnum
-	var err error
-	var result []byte
 
 	enum = ConnectionType.Wimax
 	result, err = json.Marshal(enum)
@@ -137,11 +138,11 @@ func TestEnumConnectionType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"wimax"` != string(result) {
-		t.Errorf("Expected '\"wimax\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"wimax"`, result)
 	}
 	json.Unmarshal([]byte(`"wimax"`), &enum)
 	if ConnectionType.Wimax != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Wimax, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Wimax, enum)
 	}
 
 	enum = ConnectionType.Other
@@ -150,10 +151,10 @@ func TestEnumConnectionType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"other"` != string(result) {
-		t.Errorf("Expected '\"other\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
 	}
 	json.Unmarshal([]byte(`"other"`), &enum)
 	if ConnectionType.Other != enum {
-		t.Errorf("Expcected %d, got %d", ConnectionType.Other, enum)
+		t.Errorf("Expected %d, got %d", ConnectionType.Other, enum)
 	}
 }
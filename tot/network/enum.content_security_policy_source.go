@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contentSecurityPolicySourceEnum struct {
+	HTTP ContentSecurityPolicySourceEnum
+	Meta ContentSecurityPolicySourceEnum
+}
+
+/*
+ContentSecurityPolicySource provides named access to the ContentSecurityPolicySourceEnum values.
+*/
+var ContentSecurityPolicySource = contentSecurityPolicySourceEnum{
+	HTTP: contentSecurityPolicySourceHTTP,
+	Meta: contentSecurityPolicySourceMeta,
+}
+
+/*
+ContentSecurityPolicySourceEnum represents the
+Network.ContentSecurityPolicySource values.
+
+Allowed values:
+  - ContentSecurityPolicySource.HTTP "HTTP"
+  - ContentSecurityPolicySource.Meta "Meta"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ContentSecurityPolicySource
+*/
+type ContentSecurityPolicySourceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContentSecurityPolicySourceEnum) String() string {
+	return _contentSecurityPolicySourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContentSecurityPolicySourceEnum) Unknown() bool {
+	return !_contentSecurityPolicySourceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContentSecurityPolicySourceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContentSecurityPolicySourceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contentSecurityPolicySourceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contentSecurityPolicySourceHTTP represents the "HTTP" value.
+	contentSecurityPolicySourceHTTP ContentSecurityPolicySourceEnum = iota + 1
+	// contentSecurityPolicySourceMeta represents the "Meta" value.
+	contentSecurityPolicySourceMeta
+)
+
+var _contentSecurityPolicySourceEnums = enums.New(map[ContentSecurityPolicySourceEnum]string{
+	ContentSecurityPolicySourceEnum(0): "",
+	contentSecurityPolicySourceHTTP:    "HTTP",
+	contentSecurityPolicySourceMeta:    "Meta",
+})
@@ -0,0 +1,290 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookieBlockedReason(t *testing.T) {
+	var enum CookieBlockedReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookieBlockedReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookieBlockedReason.SecureOnly
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SecureOnly"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SecureOnly"`, result)
+	}
+	json.Unmarshal([]byte(`"SecureOnly"`), &enum)
+	if CookieBlockedReason.SecureOnly != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SecureOnly, enum)
+	}
+
+	enum = CookieBlockedReason.NotOnPath
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotOnPath"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotOnPath"`, result)
+	}
+	json.Unmarshal([]byte(`"NotOnPath"`), &enum)
+	if CookieBlockedReason.NotOnPath != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.NotOnPath, enum)
+	}
+
+	enum = CookieBlockedReason.DomainMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DomainMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DomainMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"DomainMismatch"`), &enum)
+	if CookieBlockedReason.DomainMismatch != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.DomainMismatch, enum)
+	}
+
+	enum = CookieBlockedReason.SameSiteStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteStrict"`), &enum)
+	if CookieBlockedReason.SameSiteStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SameSiteStrict, enum)
+	}
+
+	enum = CookieBlockedReason.SameSiteLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteLax"`), &enum)
+	if CookieBlockedReason.SameSiteLax != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SameSiteLax, enum)
+	}
+
+	enum = CookieBlockedReason.SameSiteUnspecifiedTreatedAsLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteUnspecifiedTreatedAsLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteUnspecifiedTreatedAsLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteUnspecifiedTreatedAsLax"`), &enum)
+	if CookieBlockedReason.SameSiteUnspecifiedTreatedAsLax != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SameSiteUnspecifiedTreatedAsLax, enum)
+	}
+
+	enum = CookieBlockedReason.SameSiteNoneInsecure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteNoneInsecure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteNoneInsecure"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteNoneInsecure"`), &enum)
+	if CookieBlockedReason.SameSiteNoneInsecure != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SameSiteNoneInsecure, enum)
+	}
+
+	enum = CookieBlockedReason.UserPreferences
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UserPreferences"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UserPreferences"`, result)
+	}
+	json.Unmarshal([]byte(`"UserPreferences"`), &enum)
+	if CookieBlockedReason.UserPreferences != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.UserPreferences, enum)
+	}
+
+	enum = CookieBlockedReason.ThirdPartyPhaseout
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ThirdPartyPhaseout"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ThirdPartyPhaseout"`, result)
+	}
+	json.Unmarshal([]byte(`"ThirdPartyPhaseout"`), &enum)
+	if CookieBlockedReason.ThirdPartyPhaseout != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.ThirdPartyPhaseout, enum)
+	}
+
+	enum = CookieBlockedReason.ThirdPartyBlockedInFirstPartySet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ThirdPartyBlockedInFirstPartySet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ThirdPartyBlockedInFirstPartySet"`, result)
+	}
+	json.Unmarshal([]byte(`"ThirdPartyBlockedInFirstPartySet"`), &enum)
+	if CookieBlockedReason.ThirdPartyBlockedInFirstPartySet != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.ThirdPartyBlockedInFirstPartySet, enum)
+	}
+
+	enum = CookieBlockedReason.UnknownError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnknownError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnknownError"`, result)
+	}
+	json.Unmarshal([]byte(`"UnknownError"`), &enum)
+	if CookieBlockedReason.UnknownError != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.UnknownError, enum)
+	}
+
+	enum = CookieBlockedReason.SchemefulSameSiteStrict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteStrict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteStrict"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteStrict"`), &enum)
+	if CookieBlockedReason.SchemefulSameSiteStrict != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SchemefulSameSiteStrict, enum)
+	}
+
+	enum = CookieBlockedReason.SchemefulSameSiteLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteLax"`), &enum)
+	if CookieBlockedReason.SchemefulSameSiteLax != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SchemefulSameSiteLax, enum)
+	}
+
+	enum = CookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemefulSameSiteUnspecifiedTreatedAsLax"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemefulSameSiteUnspecifiedTreatedAsLax"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemefulSameSiteUnspecifiedTreatedAsLax"`), &enum)
+	if CookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax, enum)
+	}
+
+	enum = CookieBlockedReason.SamePartyFromCrossPartyContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SamePartyFromCrossPartyContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SamePartyFromCrossPartyContext"`, result)
+	}
+	json.Unmarshal([]byte(`"SamePartyFromCrossPartyContext"`), &enum)
+	if CookieBlockedReason.SamePartyFromCrossPartyContext != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SamePartyFromCrossPartyContext, enum)
+	}
+
+	enum = CookieBlockedReason.NameValuePairExceedsMaxSize
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NameValuePairExceedsMaxSize"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NameValuePairExceedsMaxSize"`, result)
+	}
+	json.Unmarshal([]byte(`"NameValuePairExceedsMaxSize"`), &enum)
+	if CookieBlockedReason.NameValuePairExceedsMaxSize != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.NameValuePairExceedsMaxSize, enum)
+	}
+
+	enum = CookieBlockedReason.PortMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PortMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PortMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"PortMismatch"`), &enum)
+	if CookieBlockedReason.PortMismatch != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.PortMismatch, enum)
+	}
+
+	enum = CookieBlockedReason.SchemeMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SchemeMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SchemeMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"SchemeMismatch"`), &enum)
+	if CookieBlockedReason.SchemeMismatch != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.SchemeMismatch, enum)
+	}
+
+	enum = CookieBlockedReason.AnonymousContext
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AnonymousContext"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AnonymousContext"`, result)
+	}
+	json.Unmarshal([]byte(`"AnonymousContext"`), &enum)
+	if CookieBlockedReason.AnonymousContext != enum {
+		t.Errorf("Expected %d, got %d", CookieBlockedReason.AnonymousContext, enum)
+	}
+}
@@ -0,0 +1,139 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieExemptionReasonEnum struct {
+	None                         CookieExemptionReasonEnum
+	UserSetting                  CookieExemptionReasonEnum
+	TPCDMetadata                 CookieExemptionReasonEnum
+	TPCDDeprecationTrial         CookieExemptionReasonEnum
+	TopLevelTPCDDeprecationTrial CookieExemptionReasonEnum
+	TPCDHeuristics               CookieExemptionReasonEnum
+	EnterprisePolicy             CookieExemptionReasonEnum
+	StorageAccess                CookieExemptionReasonEnum
+	TopLevelStorageAccess        CookieExemptionReasonEnum
+	Scheme                       CookieExemptionReasonEnum
+	SameSiteNoneCookiesInSandbox CookieExemptionReasonEnum
+}
+
+/*
+CookieExemptionReason provides named access to the CookieExemptionReasonEnum values.
+*/
+var CookieExemptionReason = cookieExemptionReasonEnum{
+	None:                         cookieExemptionReasonNone,
+	UserSetting:                  cookieExemptionReasonUserSetting,
+	TPCDMetadata:                 cookieExemptionReasonTPCDMetadata,
+	TPCDDeprecationTrial:         cookieExemptionReasonTPCDDeprecationTrial,
+	TopLevelTPCDDeprecationTrial: cookieExemptionReasonTopLevelTPCDDeprecationTrial,
+	TPCDHeuristics:               cookieExemptionReasonTPCDHeuristics,
+	EnterprisePolicy:             cookieExemptionReasonEnterprisePolicy,
+	StorageAccess:                cookieExemptionReasonStorageAccess,
+	TopLevelStorageAccess:        cookieExemptionReasonTopLevelStorageAccess,
+	Scheme:                       cookieExemptionReasonScheme,
+	SameSiteNoneCookiesInSandbox: cookieExemptionReasonSameSiteNoneCookiesInSandbox,
+}
+
+/*
+CookieExemptionReasonEnum represents the Network.CookieExemptionReason values.
+
+Types of reasons why a cookie should have been blocked by 3PCD but is exempted
+for the request.
+
+Allowed values:
+  - CookieExemptionReason.None                         "None"
+  - CookieExemptionReason.UserSetting                  "UserSetting"
+  - CookieExemptionReason.TPCDMetadata                 "TPCDMetadata"
+  - CookieExemptionReason.TPCDDeprecationTrial         "TPCDDeprecationTrial"
+  - CookieExemptionReason.TopLevelTPCDDeprecationTrial "TopLevelTPCDDeprecationTrial"
+  - CookieExemptionReason.TPCDHeuristics               "TPCDHeuristics"
+  - CookieExemptionReason.EnterprisePolicy             "EnterprisePolicy"
+  - CookieExemptionReason.StorageAccess                "StorageAccess"
+  - CookieExemptionReason.TopLevelStorageAccess        "TopLevelStorageAccess"
+  - CookieExemptionReason.Scheme                       "Scheme"
+  - CookieExemptionReason.SameSiteNoneCookiesInSandbox "SameSiteNoneCookiesInSandbox"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookieExemptionReason
+*/
+type CookieExemptionReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieExemptionReasonEnum) String() string {
+	return _cookieExemptionReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieExemptionReasonEnum) Unknown() bool {
+	return !_cookieExemptionReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieExemptionReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieExemptionReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieExemptionReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieExemptionReasonNone represents the "None" value.
+	cookieExemptionReasonNone CookieExemptionReasonEnum = iota + 1
+	// cookieExemptionReasonUserSetting represents the "UserSetting" value.
+	cookieExemptionReasonUserSetting
+	// cookieExemptionReasonTPCDMetadata represents the "TPCDMetadata" value.
+	cookieExemptionReasonTPCDMetadata
+	// cookieExemptionReasonTPCDDeprecationTrial represents the "TPCDDeprecationTrial" value.
+	cookieExemptionReasonTPCDDeprecationTrial
+	// cookieExemptionReasonTopLevelTPCDDeprecationTrial represents the "TopLevelTPCDDeprecationTrial" value.
+	cookieExemptionReasonTopLevelTPCDDeprecationTrial
+	// cookieExemptionReasonTPCDHeuristics represents the "TPCDHeuristics" value.
+	cookieExemptionReasonTPCDHeuristics
+	// cookieExemptionReasonEnterprisePolicy represents the "EnterprisePolicy" value.
+	cookieExemptionReasonEnterprisePolicy
+	// cookieExemptionReasonStorageAccess represents the "StorageAccess" value.
+	cookieExemptionReasonStorageAccess
+	// cookieExemptionReasonTopLevelStorageAccess represents the "TopLevelStorageAccess" value.
+	cookieExemptionReasonTopLevelStorageAccess
+	// cookieExemptionReasonScheme represents the "Scheme" value.
+	cookieExemptionReasonScheme
+	// cookieExemptionReasonSameSiteNoneCookiesInSandbox represents the "SameSiteNoneCookiesInSandbox" value.
+	cookieExemptionReasonSameSiteNoneCookiesInSandbox
+)
+
+var _cookieExemptionReasonEnums = enums.New(map[CookieExemptionReasonEnum]string{
+	CookieExemptionReasonEnum(0):                      "",
+	cookieExemptionReasonNone:                         "None",
+	cookieExemptionReasonUserSetting:                  "UserSetting",
+	cookieExemptionReasonTPCDMetadata:                 "TPCDMetadata",
+	cookieExemptionReasonTPCDDeprecationTrial:         "TPCDDeprecationTrial",
+	cookieExemptionReasonTopLevelTPCDDeprecationTrial: "TopLevelTPCDDeprecationTrial",
+	cookieExemptionReasonTPCDHeuristics:               "TPCDHeuristics",
+	cookieExemptionReasonEnterprisePolicy:             "EnterprisePolicy",
+	cookieExemptionReasonStorageAccess:                "StorageAccess",
+	cookieExemptionReasonTopLevelStorageAccess:        "TopLevelStorageAccess",
+	cookieExemptionReasonScheme:                       "Scheme",
+	cookieExemptionReasonSameSiteNoneCookiesInSandbox: "SameSiteNoneCookiesInSandbox",
+})
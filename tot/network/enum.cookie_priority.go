@@ -0,0 +1,91 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookiePriorityEnum struct {
+	Low    CookiePriorityEnum
+	Medium CookiePriorityEnum
+	High   CookiePriorityEnum
+}
+
+/*
+CookiePriority provides named access to the CookiePriorityEnum values.
+*/
+var CookiePriority = cookiePriorityEnum{
+	Low:    cookiePriorityLow,
+	Medium: cookiePriorityMedium,
+	High:   cookiePriorityHigh,
+}
+
+/*
+CookiePriorityEnum represents the Network.CookiePriority values.
+
+Represents the cookie's 'Priority' status:
+https://tools.ietf.org/html/draft-west-cookie-priority-00
+
+Allowed values:
+  - CookiePriority.Low    "Low"
+  - CookiePriority.Medium "Medium"
+  - CookiePriority.High   "High"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookiePriority
+*/
+type CookiePriorityEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookiePriorityEnum) String() string {
+	return _cookiePriorityEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookiePriorityEnum) Unknown() bool {
+	return !_cookiePriorityEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookiePriorityEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookiePriorityEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookiePriorityEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookiePriorityLow represents the "Low" value.
+	cookiePriorityLow CookiePriorityEnum = iota + 1
+	// cookiePriorityMedium represents the "Medium" value.
+	cookiePriorityMedium
+	// cookiePriorityHigh represents the "High" value.
+	cookiePriorityHigh
+)
+
+var _cookiePriorityEnums = enums.New(map[CookiePriorityEnum]string{
+	CookiePriorityEnum(0): "",
+	cookiePriorityLow:     "Low",
+	cookiePriorityMedium:  "Medium",
+	cookiePriorityHigh:    "High",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCookiePriority(t *testing.T) {
+	var enum CookiePriorityEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CookiePriorityEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CookiePriority.Low
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Low"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Low"`, result)
+	}
+	json.Unmarshal([]byte(`"Low"`), &enum)
+	if CookiePriority.Low != enum {
+		t.Errorf("Expected %d, got %d", CookiePriority.Low, enum)
+	}
+
+	enum = CookiePriority.Medium
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Medium"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Medium"`, result)
+	}
+	json.Unmarshal([]byte(`"Medium"`), &enum)
+	if CookiePriority.Medium != enum {
+		t.Errorf("Expected %d, got %d", CookiePriority.Medium, enum)
+	}
+
+	enum = CookiePriority.High
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"High"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"High"`, result)
+	}
+	json.Unmarshal([]byte(`"High"`), &enum)
+	if CookiePriority.High != enum {
+		t.Errorf("Expected %d, got %d", CookiePriority.High, enum)
+	}
+}
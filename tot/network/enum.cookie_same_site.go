@@ -1,30 +1,39 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type cookieSameSiteEnum struct {
 	Strict CookieSameSiteEnum
 	Lax    CookieSameSiteEnum
+	None   CookieSameSiteEnum
 }
 
 /*
-CookieSameSite provides named acces to the CookieSameSiteEnum values.
+CookieSameSite provides named access to the CookieSameSiteEnum values.
 */
 var CookieSameSite = cookieSameSiteEnum{
 	Strict: cookieSameSiteStrict,
 	Lax:    cookieSameSiteLax,
+	None:   cookieSameSiteNone,
 }
 
 /*
-CookieSameSiteEnum represents the cookie's 'SameSite' status. Allowed values:
-	- CookieSameSite.Strict "Strict"
-	- CookieSameSite.Lax    "Lax"
+CookieSameSiteEnum represents the Network.CookieSameSite values.
 
+Represents the cookie's 'SameSite' status:
 https://tools.ietf.org/html/draft-west-first-party-cookies
 
+Allowed values:
+  - CookieSameSite.Strict "Strict"
+  - CookieSameSite.Lax    "Lax"
+  - CookieSameSite.None   "None"
+
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookieSameSite
 */
 type CookieSameSiteEnum int
@@ -33,7 +42,16 @@ type CookieSameSiteEnum int
 String implements Stringer
 */
 func (enum CookieSameSiteEnum) String() string {
-	return _cookieSameSiteEnums[enum]
+	return _cookieSameSiteEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieSameSiteEnum) Unknown() bool {
+	return !_cookieSameSiteEnums.Known(enum)
 }
 
 /*
@@ -44,25 +62,16 @@ func (enum CookieSameSiteEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *CookieSameSiteEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _cookieSameSiteEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _cookieSameSiteEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -70,10 +79,13 @@ const (
 	cookieSameSiteStrict CookieSameSiteEnum = iota + 1
 	// cookieSameSiteLax represents the "Lax" value.
 	cookieSameSiteLax
+	// cookieSameSiteNone represents the "None" value.
+	cookieSameSiteNone
 )
 
-var _cookieSameSiteEnums = map[CookieSameSiteEnum]string{
+var _cookieSameSiteEnums = enums.New(map[CookieSameSiteEnum]string{
 	CookieSameSiteEnum(0): "",
 	cookieSameSiteStrict:  "Strict",
 	cookieSameSiteLax:     "Lax",
-}
+	cookieSameSiteNone:    "None",
+})
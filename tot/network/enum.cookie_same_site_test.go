@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -15,9 +17,20 @@ func TestEnumCookieSameSite(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown CookieSameSiteEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumCookieSameSite(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Strict"` != string(result) {
-		t.Errorf("Expected '\"Strict\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Strict"`, result)
 	}
 	json.Unmarshal([]byte(`"Strict"`), &enum)
 	if CookieSameSite.Strict != enum {
-		t.Errorf("Expcected %d, got %d", CookieSameSite.Strict, enum)
+		t.Errorf("Expected %d, got %d", CookieSameSite.Strict, enum)
 	}
 
 	enum = CookieSameSite.Lax
@@ -47,10 +60,23 @@ func TestEnumCookieSameSite(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Lax"` != string(result) {
-		t.Errorf("Expected '\"Lax\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Lax"`, result)
 	}
 	json.Unmarshal([]byte(`"Lax"`), &enum)
 	if CookieSameSite.Lax != enum {
-		t.Errorf("Expcected %d, got %d", CookieSameSite.Lax, enum)
+		t.Errorf("Expected %d, got %d", CookieSameSite.Lax, enum)
+	}
+
+	enum = CookieSameSite.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"None"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"None"`, result)
+	}
+	json.Unmarshal([]byte(`"None"`), &enum)
+	if CookieSameSite.None != enum {
+		t.Errorf("Expected %d, got %d", CookieSameSite.None, enum)
 	}
 }
@@ -0,0 +1,92 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type cookieSourceSchemeEnum struct {
+	Unset     CookieSourceSchemeEnum
+	NonSecure CookieSourceSchemeEnum
+	Secure    CookieSourceSchemeEnum
+}
+
+/*
+CookieSourceScheme provides named access to the CookieSourceSchemeEnum values.
+*/
+var CookieSourceScheme = cookieSourceSchemeEnum{
+	Unset:     cookieSourceSchemeUnset,
+	NonSecure: cookieSourceSchemeNonSecure,
+	Secure:    cookieSourceSchemeSecure,
+}
+
+/*
+CookieSourceSchemeEnum represents the Network.CookieSourceScheme values.
+
+Represents the source scheme of the origin that originally set the cookie. A
+value of "Unset" allows protocol clients to emulate legacy cookie scope for the
+scheme. This is a temporary ability and it will be removed in the future.
+
+Allowed values:
+  - CookieSourceScheme.Unset     "Unset"
+  - CookieSourceScheme.NonSecure "NonSecure"
+  - CookieSourceScheme.Secure    "Secure"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CookieSourceScheme
+*/
+type CookieSourceSchemeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CookieSourceSchemeEnum) String() string {
+	return _cookieSourceSchemeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CookieSourceSchemeEnum) Unknown() bool {
+	return !_cookieSourceSchemeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CookieSourceSchemeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CookieSourceSchemeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _cookieSourceSchemeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// cookieSourceSchemeUnset represents the "Unset" value.
+	cookieSourceSchemeUnset CookieSourceSchemeEnum = iota + 1
+	// cookieSourceSchemeNonSecure represents the "NonSecure" value.
+	cookieSourceSchemeNonSecure
+	// cookieSourceSchemeSecure represents the "Secure" value.
+	cookieSourceSchemeSecure
+)
+
+var _cookieSourceSchemeEnums = enums.New(map[CookieSourceSchemeEnum]string{
+	CookieSourceSchemeEnum(0):   "",
+	cookieSourceSchemeUnset:     "Unset",
+	cookieSourceSchemeNonSecure: "NonSecure",
+	cookieSourceSchemeSecure:    "Secure",
+})
@@ -0,0 +1,282 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type corsErrorEnum struct {
+	DisallowedByMode                          CorsErrorEnum
+	InvalidResponse                           CorsErrorEnum
+	WildcardOriginNotAllowed                  CorsErrorEnum
+	MissingAllowOriginHeader                  CorsErrorEnum
+	MultipleAllowOriginValues                 CorsErrorEnum
+	InvalidAllowOriginValue                   CorsErrorEnum
+	AllowOriginMismatch                       CorsErrorEnum
+	InvalidAllowCredentials                   CorsErrorEnum
+	CorsDisabledScheme                        CorsErrorEnum
+	PreflightInvalidStatus                    CorsErrorEnum
+	PreflightDisallowedRedirect               CorsErrorEnum
+	PreflightWildcardOriginNotAllowed         CorsErrorEnum
+	PreflightMissingAllowOriginHeader         CorsErrorEnum
+	PreflightMultipleAllowOriginValues        CorsErrorEnum
+	PreflightInvalidAllowOriginValue          CorsErrorEnum
+	PreflightAllowOriginMismatch              CorsErrorEnum
+	PreflightInvalidAllowCredentials          CorsErrorEnum
+	PreflightMissingAllowExternal             CorsErrorEnum
+	PreflightInvalidAllowExternal             CorsErrorEnum
+	PreflightMissingAllowPrivateNetwork       CorsErrorEnum
+	PreflightInvalidAllowPrivateNetwork       CorsErrorEnum
+	InvalidAllowMethodsPreflightResponse      CorsErrorEnum
+	InvalidAllowHeadersPreflightResponse      CorsErrorEnum
+	MethodDisallowedByPreflightResponse       CorsErrorEnum
+	HeaderDisallowedByPreflightResponse       CorsErrorEnum
+	RedirectContainsCredentials               CorsErrorEnum
+	InsecurePrivateNetwork                    CorsErrorEnum
+	InvalidPrivateNetworkAccess               CorsErrorEnum
+	UnexpectedPrivateNetworkAccess            CorsErrorEnum
+	NoCorsRedirectModeNotFollow               CorsErrorEnum
+	PreflightMissingPrivateNetworkAccessID    CorsErrorEnum
+	PreflightMissingPrivateNetworkAccessName  CorsErrorEnum
+	PrivateNetworkAccessPermissionUnavailable CorsErrorEnum
+	PrivateNetworkAccessPermissionDenied      CorsErrorEnum
+	LocalNetworkAccessPermissionDenied        CorsErrorEnum
+}
+
+/*
+CorsError provides named access to the CorsErrorEnum values.
+*/
+var CorsError = corsErrorEnum{
+	DisallowedByMode:                          corsErrorDisallowedByMode,
+	InvalidResponse:                           corsErrorInvalidResponse,
+	WildcardOriginNotAllowed:                  corsErrorWildcardOriginNotAllowed,
+	MissingAllowOriginHeader:                  corsErrorMissingAllowOriginHeader,
+	MultipleAllowOriginValues:                 corsErrorMultipleAllowOriginValues,
+	InvalidAllowOriginValue:                   corsErrorInvalidAllowOriginValue,
+	AllowOriginMismatch:                       corsErrorAllowOriginMismatch,
+	InvalidAllowCredentials:                   corsErrorInvalidAllowCredentials,
+	CorsDisabledScheme:                        corsErrorCorsDisabledScheme,
+	PreflightInvalidStatus:                    corsErrorPreflightInvalidStatus,
+	PreflightDisallowedRedirect:               corsErrorPreflightDisallowedRedirect,
+	PreflightWildcardOriginNotAllowed:         corsErrorPreflightWildcardOriginNotAllowed,
+	PreflightMissingAllowOriginHeader:         corsErrorPreflightMissingAllowOriginHeader,
+	PreflightMultipleAllowOriginValues:        corsErrorPreflightMultipleAllowOriginValues,
+	PreflightInvalidAllowOriginValue:          corsErrorPreflightInvalidAllowOriginValue,
+	PreflightAllowOriginMismatch:              corsErrorPreflightAllowOriginMismatch,
+	PreflightInvalidAllowCredentials:          corsErrorPreflightInvalidAllowCredentials,
+	PreflightMissingAllowExternal:             corsErrorPreflightMissingAllowExternal,
+	PreflightInvalidAllowExternal:             corsErrorPreflightInvalidAllowExternal,
+	PreflightMissingAllowPrivateNetwork:       corsErrorPreflightMissingAllowPrivateNetwork,
+	PreflightInvalidAllowPrivateNetwork:       corsErrorPreflightInvalidAllowPrivateNetwork,
+	InvalidAllowMethodsPreflightResponse:      corsErrorInvalidAllowMethodsPreflightResponse,
+	InvalidAllowHeadersPreflightResponse:      corsErrorInvalidAllowHeadersPreflightResponse,
+	MethodDisallowedByPreflightResponse:       corsErrorMethodDisallowedByPreflightResponse,
+	HeaderDisallowedByPreflightResponse:       corsErrorHeaderDisallowedByPreflightResponse,
+	RedirectContainsCredentials:               corsErrorRedirectContainsCredentials,
+	InsecurePrivateNetwork:                    corsErrorInsecurePrivateNetwork,
+	InvalidPrivateNetworkAccess:               corsErrorInvalidPrivateNetworkAccess,
+	UnexpectedPrivateNetworkAccess:            corsErrorUnexpectedPrivateNetworkAccess,
+	NoCorsRedirectModeNotFollow:               corsErrorNoCorsRedirectModeNotFollow,
+	PreflightMissingPrivateNetworkAccessID:    corsErrorPreflightMissingPrivateNetworkAccessID,
+	PreflightMissingPrivateNetworkAccessName:  corsErrorPreflightMissingPrivateNetworkAccessName,
+	PrivateNetworkAccessPermissionUnavailable: corsErrorPrivateNetworkAccessPermissionUnavailable,
+	PrivateNetworkAccessPermissionDenied:      corsErrorPrivateNetworkAccessPermissionDenied,
+	LocalNetworkAccessPermissionDenied:        corsErrorLocalNetworkAccessPermissionDenied,
+}
+
+/*
+CorsErrorEnum represents the Network.CorsError values.
+
+The reason why request was blocked.
+
+Allowed values:
+  - CorsError.DisallowedByMode                          "DisallowedByMode"
+  - CorsError.InvalidResponse                           "InvalidResponse"
+  - CorsError.WildcardOriginNotAllowed                  "WildcardOriginNotAllowed"
+  - CorsError.MissingAllowOriginHeader                  "MissingAllowOriginHeader"
+  - CorsError.MultipleAllowOriginValues                 "MultipleAllowOriginValues"
+  - CorsError.InvalidAllowOriginValue                   "InvalidAllowOriginValue"
+  - CorsError.AllowOriginMismatch                       "AllowOriginMismatch"
+  - CorsError.InvalidAllowCredentials                   "InvalidAllowCredentials"
+  - CorsError.CorsDisabledScheme                        "CorsDisabledScheme"
+  - CorsError.PreflightInvalidStatus                    "PreflightInvalidStatus"
+  - CorsError.PreflightDisallowedRedirect               "PreflightDisallowedRedirect"
+  - CorsError.PreflightWildcardOriginNotAllowed         "PreflightWildcardOriginNotAllowed"
+  - CorsError.PreflightMissingAllowOriginHeader         "PreflightMissingAllowOriginHeader"
+  - CorsError.PreflightMultipleAllowOriginValues        "PreflightMultipleAllowOriginValues"
+  - CorsError.PreflightInvalidAllowOriginValue          "PreflightInvalidAllowOriginValue"
+  - CorsError.PreflightAllowOriginMismatch              "PreflightAllowOriginMismatch"
+  - CorsError.PreflightInvalidAllowCredentials          "PreflightInvalidAllowCredentials"
+  - CorsError.PreflightMissingAllowExternal             "PreflightMissingAllowExternal"
+  - CorsError.PreflightInvalidAllowExternal             "PreflightInvalidAllowExternal"
+  - CorsError.PreflightMissingAllowPrivateNetwork       "PreflightMissingAllowPrivateNetwork"
+  - CorsError.PreflightInvalidAllowPrivateNetwork       "PreflightInvalidAllowPrivateNetwork"
+  - CorsError.InvalidAllowMethodsPreflightResponse      "InvalidAllowMethodsPreflightResponse"
+  - CorsError.InvalidAllowHeadersPreflightResponse      "InvalidAllowHeadersPreflightResponse"
+  - CorsError.MethodDisallowedByPreflightResponse       "MethodDisallowedByPreflightResponse"
+  - CorsError.HeaderDisallowedByPreflightResponse       "HeaderDisallowedByPreflightResponse"
+  - CorsError.RedirectContainsCredentials               "RedirectContainsCredentials"
+  - CorsError.InsecurePrivateNetwork                    "InsecurePrivateNetwork"
+  - CorsError.InvalidPrivateNetworkAccess               "InvalidPrivateNetworkAccess"
+  - CorsError.UnexpectedPrivateNetworkAccess            "UnexpectedPrivateNetworkAccess"
+  - CorsError.NoCorsRedirectModeNotFollow               "NoCorsRedirectModeNotFollow"
+  - CorsError.PreflightMissingPrivateNetworkAccessID    "PreflightMissingPrivateNetworkAccessId"
+  - CorsError.PreflightMissingPrivateNetworkAccessName  "PreflightMissingPrivateNetworkAccessName"
+  - CorsError.PrivateNetworkAccessPermissionUnavailable "PrivateNetworkAccessPermissionUnavailable"
+  - CorsError.PrivateNetworkAccessPermissionDenied      "PrivateNetworkAccessPermissionDenied"
+  - CorsError.LocalNetworkAccessPermissionDenied        "LocalNetworkAccessPermissionDenied"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CorsError
+*/
+type CorsErrorEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CorsErrorEnum) String() string {
+	return _corsErrorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CorsErrorEnum) Unknown() bool {
+	return !_corsErrorEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CorsErrorEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CorsErrorEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _corsErrorEnums.Parse(val)
+	return nil
+}
+
+const (
+	// corsErrorDisallowedByMode represents the "DisallowedByMode" value.
+	corsErrorDisallowedByMode CorsErrorEnum = iota + 1
+	// corsErrorInvalidResponse represents the "InvalidResponse" value.
+	corsErrorInvalidResponse
+	// corsErrorWildcardOriginNotAllowed represents the "WildcardOriginNotAllowed" value.
+	corsErrorWildcardOriginNotAllowed
+	// corsErrorMissingAllowOriginHeader represents the "MissingAllowOriginHeader" value.
+	corsErrorMissingAllowOriginHeader
+	// corsErrorMultipleAllowOriginValues represents the "MultipleAllowOriginValues" value.
+	corsErrorMultipleAllowOriginValues
+	// corsErrorInvalidAllowOriginValue represents the "InvalidAllowOriginValue" value.
+	corsErrorInvalidAllowOriginValue
+	// corsErrorAllowOriginMismatch represents the "AllowOriginMismatch" value.
+	corsErrorAllowOriginMismatch
+	// corsErrorInvalidAllowCredentials represents the "InvalidAllowCredentials" value.
+	corsErrorInvalidAllowCredentials
+	// corsErrorCorsDisabledScheme represents the "CorsDisabledScheme" value.
+	corsErrorCorsDisabledScheme
+	// corsErrorPreflightInvalidStatus represents the "PreflightInvalidStatus" value.
+	corsErrorPreflightInvalidStatus
+	// corsErrorPreflightDisallowedRedirect represents the "PreflightDisallowedRedirect" value.
+	corsErrorPreflightDisallowedRedirect
+	// corsErrorPreflightWildcardOriginNotAllowed represents the "PreflightWildcardOriginNotAllowed" value.
+	corsErrorPreflightWildcardOriginNotAllowed
+	// corsErrorPreflightMissingAllowOriginHeader represents the "PreflightMissingAllowOriginHeader" value.
+	corsErrorPreflightMissingAllowOriginHeader
+	// corsErrorPreflightMultipleAllowOriginValues represents the "PreflightMultipleAllowOriginValues" value.
+	corsErrorPreflightMultipleAllowOriginValues
+	// corsErrorPreflightInvalidAllowOriginValue represents the "PreflightInvalidAllowOriginValue" value.
+	corsErrorPreflightInvalidAllowOriginValue
+	// corsErrorPreflightAllowOriginMismatch represents the "PreflightAllowOriginMismatch" value.
+	corsErrorPreflightAllowOriginMismatch
+	// corsErrorPreflightInvalidAllowCredentials represents the "PreflightInvalidAllowCredentials" value.
+	corsErrorPreflightInvalidAllowCredentials
+	// corsErrorPreflightMissingAllowExternal represents the "PreflightMissingAllowExternal" value.
+	corsErrorPreflightMissingAllowExternal
+	// corsErrorPreflightInvalidAllowExternal represents the "PreflightInvalidAllowExternal" value.
+	corsErrorPreflightInvalidAllowExternal
+	// corsErrorPreflightMissingAllowPrivateNetwork represents the "PreflightMissingAllowPrivateNetwork" value.
+	corsErrorPreflightMissingAllowPrivateNetwork
+	// corsErrorPreflightInvalidAllowPrivateNetwork represents the "PreflightInvalidAllowPrivateNetwork" value.
+	corsErrorPreflightInvalidAllowPrivateNetwork
+	// corsErrorInvalidAllowMethodsPreflightResponse represents the "InvalidAllowMethodsPreflightResponse" value.
+	corsErrorInvalidAllowMethodsPreflightResponse
+	// corsErrorInvalidAllowHeadersPreflightResponse represents the "InvalidAllowHeadersPreflightResponse" value.
+	corsErrorInvalidAllowHeadersPreflightResponse
+	// corsErrorMethodDisallowedByPreflightResponse represents the "MethodDisallowedByPreflightResponse" value.
+	corsErrorMethodDisallowedByPreflightResponse
+	// corsErrorHeaderDisallowedByPreflightResponse represents the "HeaderDisallowedByPreflightResponse" value.
+	corsErrorHeaderDisallowedByPreflightResponse
+	// corsErrorRedirectContainsCredentials represents the "RedirectContainsCredentials" value.
+	corsErrorRedirectContainsCredentials
+	// corsErrorInsecurePrivateNetwork represents the "InsecurePrivateNetwork" value.
+	corsErrorInsecurePrivateNetwork
+	// corsErrorInvalidPrivateNetworkAccess represents the "InvalidPrivateNetworkAccess" value.
+	corsErrorInvalidPrivateNetworkAccess
+	// corsErrorUnexpectedPrivateNetworkAccess represents the "UnexpectedPrivateNetworkAccess" value.
+	corsErrorUnexpectedPrivateNetworkAccess
+	// corsErrorNoCorsRedirectModeNotFollow represents the "NoCorsRedirectModeNotFollow" value.
+	corsErrorNoCorsRedirectModeNotFollow
+	// corsErrorPreflightMissingPrivateNetworkAccessID represents the "PreflightMissingPrivateNetworkAccessId" value.
+	corsErrorPreflightMissingPrivateNetworkAccessID
+	// corsErrorPreflightMissingPrivateNetworkAccessName represents the "PreflightMissingPrivateNetworkAccessName" value.
+	corsErrorPreflightMissingPrivateNetworkAccessName
+	// corsErrorPrivateNetworkAccessPermissionUnavailable represents the "PrivateNetworkAccessPermissionUnavailable" value.
+	corsErrorPrivateNetworkAccessPermissionUnavailable
+	// corsErrorPrivateNetworkAccessPermissionDenied represents the "PrivateNetworkAccessPermissionDenied" value.
+	corsErrorPrivateNetworkAccessPermissionDenied
+	// corsErrorLocalNetworkAccessPermissionDenied represents the "LocalNetworkAccessPermissionDenied" value.
+	corsErrorLocalNetworkAccessPermissionDenied
+)
+
+var _corsErrorEnums = enums.New(map[CorsErrorEnum]string{
+	CorsErrorEnum(0):                                   "",
+	corsErrorDisallowedByMode:                          "DisallowedByMode",
+	corsErrorInvalidResponse:                           "InvalidResponse",
+	corsErrorWildcardOriginNotAllowed:                  "WildcardOriginNotAllowed",
+	corsErrorMissingAllowOriginHeader:                  "MissingAllowOriginHeader",
+	corsErrorMultipleAllowOriginValues:                 "MultipleAllowOriginValues",
+	corsErrorInvalidAllowOriginValue:                   "InvalidAllowOriginValue",
+	corsErrorAllowOriginMismatch:                       "AllowOriginMismatch",
+	corsErrorInvalidAllowCredentials:                   "InvalidAllowCredentials",
+	corsErrorCorsDisabledScheme:                        "CorsDisabledScheme",
+	corsErrorPreflightInvalidStatus:                    "PreflightInvalidStatus",
+	corsErrorPreflightDisallowedRedirect:               "PreflightDisallowedRedirect",
+	corsErrorPreflightWildcardOriginNotAllowed:         "PreflightWildcardOriginNotAllowed",
+	corsErrorPreflightMissingAllowOriginHeader:         "PreflightMissingAllowOriginHeader",
+	corsErrorPreflightMultipleAllowOriginValues:        "PreflightMultipleAllowOriginValues",
+	corsErrorPreflightInvalidAllowOriginValue:          "PreflightInvalidAllowOriginValue",
+	corsErrorPreflightAllowOriginMismatch:              "PreflightAllowOriginMismatch",
+	corsErrorPreflightInvalidAllowCredentials:          "PreflightInvalidAllowCredentials",
+	corsErrorPreflightMissingAllowExternal:             "PreflightMissingAllowExternal",
+	corsErrorPreflightInvalidAllowExternal:             "PreflightInvalidAllowExternal",
+	corsErrorPreflightMissingAllowPrivateNetwork:       "PreflightMissingAllowPrivateNetwork",
+	corsErrorPreflightInvalidAllowPrivateNetwork:       "PreflightInvalidAllowPrivateNetwork",
+	corsErrorInvalidAllowMethodsPreflightResponse:      "InvalidAllowMethodsPreflightResponse",
+	corsErrorInvalidAllowHeadersPreflightResponse:      "InvalidAllowHeadersPreflightResponse",
+	corsErrorMethodDisallowedByPreflightResponse:       "MethodDisallowedByPreflightResponse",
+	corsErrorHeaderDisallowedByPreflightResponse:       "HeaderDisallowedByPreflightResponse",
+	corsErrorRedirectContainsCredentials:               "RedirectContainsCredentials",
+	corsErrorInsecurePrivateNetwork:                    "InsecurePrivateNetwork",
+	corsErrorInvalidPrivateNetworkAccess:               "InvalidPrivateNetworkAccess",
+	corsErrorUnexpectedPrivateNetworkAccess:            "UnexpectedPrivateNetworkAccess",
+	corsErrorNoCorsRedirectModeNotFollow:               "NoCorsRedirectModeNotFollow",
+	corsErrorPreflightMissingPrivateNetworkAccessID:    "PreflightMissingPrivateNetworkAccessId",
+	corsErrorPreflightMissingPrivateNetworkAccessName:  "PreflightMissingPrivateNetworkAccessName",
+	corsErrorPrivateNetworkAccessPermissionUnavailable: "PrivateNetworkAccessPermissionUnavailable",
+	corsErrorPrivateNetworkAccessPermissionDenied:      "PrivateNetworkAccessPermissionDenied",
+	corsErrorLocalNetworkAccessPermissionDenied:        "LocalNetworkAccessPermissionDenied",
+})
@@ -0,0 +1,89 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type crossOriginEmbedderPolicyValueEnum struct {
+	None           CrossOriginEmbedderPolicyValueEnum
+	Credentialless CrossOriginEmbedderPolicyValueEnum
+	RequireCorp    CrossOriginEmbedderPolicyValueEnum
+}
+
+/*
+CrossOriginEmbedderPolicyValue provides named access to the CrossOriginEmbedderPolicyValueEnum values.
+*/
+var CrossOriginEmbedderPolicyValue = crossOriginEmbedderPolicyValueEnum{
+	None:           crossOriginEmbedderPolicyValueNone,
+	Credentialless: crossOriginEmbedderPolicyValueCredentialless,
+	RequireCorp:    crossOriginEmbedderPolicyValueRequireCorp,
+}
+
+/*
+CrossOriginEmbedderPolicyValueEnum represents the
+Network.CrossOriginEmbedderPolicyValue values.
+
+Allowed values:
+  - CrossOriginEmbedderPolicyValue.None           "None"
+  - CrossOriginEmbedderPolicyValue.Credentialless "Credentialless"
+  - CrossOriginEmbedderPolicyValue.RequireCorp    "RequireCorp"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CrossOriginEmbedderPolicyValue
+*/
+type CrossOriginEmbedderPolicyValueEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CrossOriginEmbedderPolicyValueEnum) String() string {
+	return _crossOriginEmbedderPolicyValueEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CrossOriginEmbedderPolicyValueEnum) Unknown() bool {
+	return !_crossOriginEmbedderPolicyValueEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CrossOriginEmbedderPolicyValueEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CrossOriginEmbedderPolicyValueEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _crossOriginEmbedderPolicyValueEnums.Parse(val)
+	return nil
+}
+
+const (
+	// crossOriginEmbedderPolicyValueNone represents the "None" value.
+	crossOriginEmbedderPolicyValueNone CrossOriginEmbedderPolicyValueEnum = iota + 1
+	// crossOriginEmbedderPolicyValueCredentialless represents the "Credentialless" value.
+	crossOriginEmbedderPolicyValueCredentialless
+	// crossOriginEmbedderPolicyValueRequireCorp represents the "RequireCorp" value.
+	crossOriginEmbedderPolicyValueRequireCorp
+)
+
+var _crossOriginEmbedderPolicyValueEnums = enums.New(map[CrossOriginEmbedderPolicyValueEnum]string{
+	CrossOriginEmbedderPolicyValueEnum(0):        "",
+	crossOriginEmbedderPolicyValueNone:           "None",
+	crossOriginEmbedderPolicyValueCredentialless: "Credentialless",
+	crossOriginEmbedderPolicyValueRequireCorp:    "RequireCorp",
+})
@@ -0,0 +1,113 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type crossOriginOpenerPolicyValueEnum struct {
+	SameOrigin                 CrossOriginOpenerPolicyValueEnum
+	SameOriginAllowPopups      CrossOriginOpenerPolicyValueEnum
+	RestrictProperties         CrossOriginOpenerPolicyValueEnum
+	UnsafeNone                 CrossOriginOpenerPolicyValueEnum
+	SameOriginPlusCoep         CrossOriginOpenerPolicyValueEnum
+	RestrictPropertiesPlusCoep CrossOriginOpenerPolicyValueEnum
+	NoopenerAllowPopups        CrossOriginOpenerPolicyValueEnum
+}
+
+/*
+CrossOriginOpenerPolicyValue provides named access to the CrossOriginOpenerPolicyValueEnum values.
+*/
+var CrossOriginOpenerPolicyValue = crossOriginOpenerPolicyValueEnum{
+	SameOrigin:                 crossOriginOpenerPolicyValueSameOrigin,
+	SameOriginAllowPopups:      crossOriginOpenerPolicyValueSameOriginAllowPopups,
+	RestrictProperties:         crossOriginOpenerPolicyValueRestrictProperties,
+	UnsafeNone:                 crossOriginOpenerPolicyValueUnsafeNone,
+	SameOriginPlusCoep:         crossOriginOpenerPolicyValueSameOriginPlusCoep,
+	RestrictPropertiesPlusCoep: crossOriginOpenerPolicyValueRestrictPropertiesPlusCoep,
+	NoopenerAllowPopups:        crossOriginOpenerPolicyValueNoopenerAllowPopups,
+}
+
+/*
+CrossOriginOpenerPolicyValueEnum represents the
+Network.CrossOriginOpenerPolicyValue values.
+
+Allowed values:
+  - CrossOriginOpenerPolicyValue.SameOrigin                 "SameOrigin"
+  - CrossOriginOpenerPolicyValue.SameOriginAllowPopups      "SameOriginAllowPopups"
+  - CrossOriginOpenerPolicyValue.RestrictProperties         "RestrictProperties"
+  - CrossOriginOpenerPolicyValue.UnsafeNone                 "UnsafeNone"
+  - CrossOriginOpenerPolicyValue.SameOriginPlusCoep         "SameOriginPlusCoep"
+  - CrossOriginOpenerPolicyValue.RestrictPropertiesPlusCoep "RestrictPropertiesPlusCoep"
+  - CrossOriginOpenerPolicyValue.NoopenerAllowPopups        "NoopenerAllowPopups"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-CrossOriginOpenerPolicyValue
+*/
+type CrossOriginOpenerPolicyValueEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CrossOriginOpenerPolicyValueEnum) String() string {
+	return _crossOriginOpenerPolicyValueEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CrossOriginOpenerPolicyValueEnum) Unknown() bool {
+	return !_crossOriginOpenerPolicyValueEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CrossOriginOpenerPolicyValueEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CrossOriginOpenerPolicyValueEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _crossOriginOpenerPolicyValueEnums.Parse(val)
+	return nil
+}
+
+const (
+	// crossOriginOpenerPolicyValueSameOrigin represents the "SameOrigin" value.
+	crossOriginOpenerPolicyValueSameOrigin CrossOriginOpenerPolicyValueEnum = iota + 1
+	// crossOriginOpenerPolicyValueSameOriginAllowPopups represents the "SameOriginAllowPopups" value.
+	crossOriginOpenerPolicyValueSameOriginAllowPopups
+	// crossOriginOpenerPolicyValueRestrictProperties represents the "RestrictProperties" value.
+	crossOriginOpenerPolicyValueRestrictProperties
+	// crossOriginOpenerPolicyValueUnsafeNone represents the "UnsafeNone" value.
+	crossOriginOpenerPolicyValueUnsafeNone
+	// crossOriginOpenerPolicyValueSameOriginPlusCoep represents the "SameOriginPlusCoep" value.
+	crossOriginOpenerPolicyValueSameOriginPlusCoep
+	// crossOriginOpenerPolicyValueRestrictPropertiesPlusCoep represents the "RestrictPropertiesPlusCoep" value.
+	crossOriginOpenerPolicyValueRestrictPropertiesPlusCoep
+	// crossOriginOpenerPolicyValueNoopenerAllowPopups represents the "NoopenerAllowPopups" value.
+	crossOriginOpenerPolicyValueNoopenerAllowPopups
+)
+
+var _crossOriginOpenerPolicyValueEnums = enums.New(map[CrossOriginOpenerPolicyValueEnum]string{
+	CrossOriginOpenerPolicyValueEnum(0):                    "",
+	crossOriginOpenerPolicyValueSameOrigin:                 "SameOrigin",
+	crossOriginOpenerPolicyValueSameOriginAllowPopups:      "SameOriginAllowPopups",
+	crossOriginOpenerPolicyValueRestrictProperties:         "RestrictProperties",
+	crossOriginOpenerPolicyValueUnsafeNone:                 "UnsafeNone",
+	crossOriginOpenerPolicyValueSameOriginPlusCoep:         "SameOriginPlusCoep",
+	crossOriginOpenerPolicyValueRestrictPropertiesPlusCoep: "RestrictPropertiesPlusCoep",
+	crossOriginOpenerPolicyValueNoopenerAllowPopups:        "NoopenerAllowPopups",
+})
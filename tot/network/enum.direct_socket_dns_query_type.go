@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type directSocketDNSQueryTypeEnum struct {
+	Ipv4 DirectSocketDNSQueryTypeEnum
+	Ipv6 DirectSocketDNSQueryTypeEnum
+}
+
+/*
+DirectSocketDNSQueryType provides named access to the DirectSocketDNSQueryTypeEnum values.
+*/
+var DirectSocketDNSQueryType = directSocketDNSQueryTypeEnum{
+	Ipv4: directSocketDNSQueryTypeIpv4,
+	Ipv6: directSocketDNSQueryTypeIpv6,
+}
+
+/*
+DirectSocketDNSQueryTypeEnum represents the Network.DirectSocketDnsQueryType
+values.
+
+Allowed values:
+  - DirectSocketDNSQueryType.Ipv4 "ipv4"
+  - DirectSocketDNSQueryType.Ipv6 "ipv6"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-DirectSocketDnsQueryType
+*/
+type DirectSocketDNSQueryTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DirectSocketDNSQueryTypeEnum) String() string {
+	return _directSocketDNSQueryTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DirectSocketDNSQueryTypeEnum) Unknown() bool {
+	return !_directSocketDNSQueryTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DirectSocketDNSQueryTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DirectSocketDNSQueryTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _directSocketDNSQueryTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// directSocketDNSQueryTypeIpv4 represents the "ipv4" value.
+	directSocketDNSQueryTypeIpv4 DirectSocketDNSQueryTypeEnum = iota + 1
+	// directSocketDNSQueryTypeIpv6 represents the "ipv6" value.
+	directSocketDNSQueryTypeIpv6
+)
+
+var _directSocketDNSQueryTypeEnums = enums.New(map[DirectSocketDNSQueryTypeEnum]string{
+	DirectSocketDNSQueryTypeEnum(0): "",
+	directSocketDNSQueryTypeIpv4:    "ipv4",
+	directSocketDNSQueryTypeIpv6:    "ipv6",
+})
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type errorReasonEnum struct {
@@ -18,10 +21,12 @@ type errorReasonEnum struct {
 	NameNotResolved      ErrorReasonEnum
 	InternetDisconnected ErrorReasonEnum
 	AddressUnreachable   ErrorReasonEnum
+	BlockedByClient      ErrorReasonEnum
+	BlockedByResponse    ErrorReasonEnum
 }
 
 /*
-ErrorReason provides named acces to the ErrorReasonEnum values.
+ErrorReason provides named access to the ErrorReasonEnum values.
 */
 var ErrorReason = errorReasonEnum{
 	Failed:               errorReasonFailed,
@@ -36,22 +41,30 @@ var ErrorReason = errorReasonEnum{
 	NameNotResolved:      errorReasonNameNotResolved,
 	InternetDisconnected: errorReasonInternetDisconnected,
 	AddressUnreachable:   errorReasonAddressUnreachable,
+	BlockedByClient:      errorReasonBlockedByClient,
+	BlockedByResponse:    errorReasonBlockedByResponse,
 }
 
 /*
-ErrorReasonEnum is the network level fetch failure reason. Allowed values:
-	- ErrorReason.Failed               "Failed"
-	- ErrorReason.Aborted              "Aborted"
-	- ErrorReason.TimedOut             "TimedOut"
-	- ErrorReason.AccessDenied         "AccessDenied"
-	- ErrorReason.ConnectionClosed     "ConnectionClosed"
-	- ErrorReason.ConnectionReset      "ConnectionReset"
-	- ErrorReason.ConnectionRefused    "ConnectionRefused"
-	- ErrorReason.ConnectionAborted    "ConnectionAborted"
-	- ErrorReason.ConnectionFailed     "ConnectionFailed"
-	- ErrorReason.NameNotResolved      "NameNotResolved"
-	- ErrorReason.InternetDisconnected "InternetDisconnected"
-	- ErrorReason.AddressUnreachable   "AddressUnreachable"
+ErrorReasonEnum represents the Network.ErrorReason values.
+
+Network level fetch failure reason.
+
+Allowed values:
+  - ErrorReason.Failed               "Failed"
+  - ErrorReason.Aborted              "Aborted"
+  - ErrorReason.TimedOut             "TimedOut"
+  - ErrorReason.AccessDenied         "AccessDenied"
+  - ErrorReason.ConnectionClosed     "ConnectionClosed"
+  - ErrorReason.ConnectionReset      "ConnectionReset"
+  - ErrorReason.ConnectionRefused    "ConnectionRefused"
+  - ErrorReason.ConnectionAborted    "ConnectionAborted"
+  - ErrorReason.ConnectionFailed     "ConnectionFailed"
+  - ErrorReason.NameNotResolved      "NameNotResolved"
+  - ErrorReason.InternetDisconnected "InternetDisconnected"
+  - ErrorReason.AddressUnreachable   "AddressUnreachable"
+  - ErrorReason.BlockedByClient      "BlockedByClient"
+  - ErrorReason.BlockedByResponse    "BlockedByResponse"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ErrorReason
 */
@@ -61,7 +74,16 @@ type ErrorReasonEnum int
 String implements Stringer
 */
 func (enum ErrorReasonEnum) String() string {
-	return _errorReasonEnums[enum]
+	return _errorReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ErrorReasonEnum) Unknown() bool {
+	return !_errorReasonEnums.Known(enum)
 }
 
 /*
@@ -72,25 +94,16 @@ func (enum ErrorReasonEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ErrorReasonEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _errorReasonEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _errorReasonEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -118,9 +131,14 @@ const (
 	errorReasonInternetDisconnected
 	// errorReasonAddressUnreachable represents the "AddressUnreachable" value.
 	errorReasonAddressUnreachable
+	// errorReasonBlockedByClient represents the "BlockedByClient" value.
+	errorReasonBlockedByClient
+	// errorReasonBlockedByResponse represents the "BlockedByResponse" value.
+	errorReasonBlockedByResponse
 )
 
-var _errorReasonEnums = map[ErrorReasonEnum]string{
+var _errorReasonEnums = enums.New(map[ErrorReasonEnum]string{
+	ErrorReasonEnum(0):              "",
 	errorReasonFailed:               "Failed",
 	errorReasonAborted:              "Aborted",
 	errorReasonTimedOut:             "TimedOut",
@@ -133,4 +151,6 @@ var _errorReasonEnums = map[ErrorReasonEnum]string{
 	errorReasonNameNotResolved:      "NameNotResolved",
 	errorReasonInternetDisconnected: "InternetDisconnected",
 	errorReasonAddressUnreachable:   "AddressUnreachable",
-}
+	errorReasonBlockedByClient:      "BlockedByClient",
+	errorReasonBlockedByResponse:    "BlockedByResponse",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumErrorReason(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ErrorReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumErrorReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Failed"` != string(result) {
-		t.Errorf("Expected '\"Failed\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Failed"`, result)
 	}
 	json.Unmarshal([]byte(`"Failed"`), &enum)
 	if ErrorReason.Failed != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.Failed, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.Failed, enum)
 	}
 
 	enum = ErrorReason.Aborted
@@ -42,11 +60,11 @@ func TestEnumErrorReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Aborted"` != string(result) {
-		t.Errorf("Expected '\"Aborted\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Aborted"`, result)
 	}
 	json.Unmarshal([]byte(`"Aborted"`), &enum)
 	if ErrorReason.Aborted != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.Aborted, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.Aborted, enum)
 	}
 
 	enum = ErrorReason.TimedOut
@@ -55,18 +73,12 @@ func TestEnumErrorReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"TimedOut"` != string(result) {
-		t.Errorf("Expected '\"TimedOut\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"TimedOut"`, result)
 	}
 	json.Unmarshal([]byte(`"TimedOut"`), &enum)
 	if ErrorReason.TimedOut != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.TimedOut, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.TimedOut, enum)
 	}
-}
-
-func TestEnumErrorReason2(t *testing.T) {
-	var enum ErrorReasonEnum
-	var err error
-	var result []byte
 
 	enum = ErrorReason.AccessDenied
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumErrorReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"AccessDenied"` != string(result) {
-		t.Errorf("Expected '\"AccessDenied\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"AccessDenied"`, result)
 	}
 	json.Unmarshal([]byte(`"AccessDenied"`), &enum)
 	if ErrorReason.AccessDenied != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.AccessDenied, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.AccessDenied, enum)
 	}
 
 	enum = ErrorReason.ConnectionClosed
@@ -87,11 +99,11 @@ func TestEnumErrorReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ConnectionClosed"` != string(result) {
-		t.Errorf("Expected '\"ConnectionClosed\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionClosed"`, result)
 	}
 	json.Unmarshal([]byte(`"ConnectionClosed"`), &enum)
 	if ErrorReason.ConnectionClosed != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.ConnectionClosed, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionClosed, enum)
 	}
 
 	enum = ErrorReason.ConnectionReset
@@ -100,11 +112,11 @@ func TestEnumErrorReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ConnectionReset"` != string(result) {
-		t.Errorf("Expected '\"ConnectionReset\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionReset"`, result)
 	}
 	json.Unmarshal([]byte(`"ConnectionReset"`), &enum)
 	if ErrorReason.ConnectionReset != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.ConnectionReset, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionReset, enum)
 	}
 
 	enum = ErrorReason.ConnectionRefused
@@ -113,18 +125,12 @@ func TestEnumErrorReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ConnectionRefused"` != string(result) {
-		t.Errorf("Expected '\"ConnectionRefused\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionRefused"`, result)
 	}
 	json.Unmarshal([]byte(`"ConnectionRefused"`), &enum)
 	if ErrorReason.ConnectionRefused != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.ConnectionRefused, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionRefused, enum)
 	}
-}
-
-func TestEnumErrorReason3(t *testing.T) {
-	var enum ErrorReasonEnum
-	var err error
-	var result []byte
 
 	enum = ErrorReason.ConnectionAborted
 	result, err = json.Marshal(enum)
@@ -132,11 +138,11 @@ func TestEnumErrorReason3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ConnectionAborted"` != string(result) {
-		t.Errorf("Expected '\"ConnectionAborted\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionAborted"`, result)
 	}
 	json.Unmarshal([]byte(`"ConnectionAborted"`), &enum)
 	if ErrorReason.ConnectionAborted != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.ConnectionAborted, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionAborted, enum)
 	}
 
 	enum = ErrorReason.ConnectionFailed
@@ -145,11 +151,11 @@ func TestEnumErrorReason3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ConnectionFailed"` != string(result) {
-		t.Errorf("Expected '\"ConnectionFailed\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ConnectionFailed"`, result)
 	}
 	json.Unmarshal([]byte(`"ConnectionFailed"`), &enum)
 	if ErrorReason.ConnectionFailed != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.ConnectionFailed, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.ConnectionFailed, enum)
 	}
 
 	enum = ErrorReason.NameNotResolved
@@ -158,11 +164,11 @@ func TestEnumErrorReason3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"NameNotResolved"` != string(result) {
-		t.Errorf("Expected '\"NameNotResolved\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"NameNotResolved"`, result)
 	}
 	json.Unmarshal([]byte(`"NameNotResolved"`), &enum)
 	if ErrorReason.NameNotResolved != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.NameNotResolved, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.NameNotResolved, enum)
 	}
 
 	enum = ErrorReason.InternetDisconnected
@@ -171,18 +177,12 @@ func TestEnumErrorReason3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"InternetDisconnected"` != string(result) {
-		t.Errorf("Expected '\"InternetDisconnected\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"InternetDisconnected"`, result)
 	}
 	json.Unmarshal([]byte(`"InternetDisconnected"`), &enum)
 	if ErrorReason.InternetDisconnected != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.InternetDisconnected, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.InternetDisconnected, enum)
 	}
-}
-
-func TestEnumErrorReason4(t *testing.T) {
-	var enum ErrorReasonEnum
-	var err error
-	var result []byte
 
 	enum = ErrorReason.AddressUnreachable
 	result, err = json.Marshal(enum)
@@ -190,10 +190,36 @@ func TestEnumErrorReason4(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"AddressUnreachable"` != string(result) {
-		t.Errorf("Expected '\"AddressUnreachable\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"AddressUnreachable"`, result)
 	}
 	json.Unmarshal([]byte(`"AddressUnreachable"`), &enum)
 	if ErrorReason.AddressUnreachable != enum {
-		t.Errorf("Expcected %d, got %d", ErrorReason.AddressUnreachable, enum)
+		t.Errorf("Expected %d, got %d", ErrorReason.AddressUnreachable, enum)
+	}
+
+	enum = ErrorReason.BlockedByClient
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByClient"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByClient"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByClient"`), &enum)
+	if ErrorReason.BlockedByClient != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.BlockedByClient, enum)
+	}
+
+	enum = ErrorReason.BlockedByResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByResponse"`), &enum)
+	if ErrorReason.BlockedByResponse != enum {
+		t.Errorf("Expected %d, got %d", ErrorReason.BlockedByResponse, enum)
 	}
 }
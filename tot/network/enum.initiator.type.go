@@ -1,35 +1,48 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type initiatorTypeEnum struct {
-	Parser  InitiatorTypeEnum
-	Script  InitiatorTypeEnum
-	Preload InitiatorTypeEnum
-	Other   InitiatorTypeEnum
+	Parser         InitiatorTypeEnum
+	Script         InitiatorTypeEnum
+	Preload        InitiatorTypeEnum
+	SignedExchange InitiatorTypeEnum
+	Preflight      InitiatorTypeEnum
+	Other          InitiatorTypeEnum
 }
 
 /*
-InitiatorType provides named acces to the InitiatorTypeEnum values.
+InitiatorType provides named access to the InitiatorTypeEnum values.
 */
 var InitiatorType = initiatorTypeEnum{
-	Parser:  initiatorTypeParser,
-	Script:  initiatorTypeScript,
-	Preload: initiatorTypePreload,
-	Other:   initiatorTypeOther,
+	Parser:         initiatorTypeParser,
+	Script:         initiatorTypeScript,
+	Preload:        initiatorTypePreload,
+	SignedExchange: initiatorTypeSignedExchange,
+	Preflight:      initiatorTypePreflight,
+	Other:          initiatorTypeOther,
 }
 
 /*
-InitiatorTypeEnum is the type of this initiator. Allowed values:
-	- InitiatorType.Parser  "parser"
-	- InitiatorType.Script  "script"
-	- InitiatorType.Preload "preload"
-	- InitiatorType.Other   "other"
+InitiatorTypeEnum represents the Network.Initiator.type values.
+
+Type of this initiator.
+
+Allowed values:
+  - InitiatorType.Parser         "parser"
+  - InitiatorType.Script         "script"
+  - InitiatorType.Preload        "preload"
+  - InitiatorType.SignedExchange "SignedExchange"
+  - InitiatorType.Preflight      "preflight"
+  - InitiatorType.Other          "other"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-InitiatorType
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Initiator
 */
 type InitiatorTypeEnum int
 
@@ -37,7 +50,16 @@ type InitiatorTypeEnum int
 String implements Stringer
 */
 func (enum InitiatorTypeEnum) String() string {
-	return _initiatorTypeEnums[enum]
+	return _initiatorTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InitiatorTypeEnum) Unknown() bool {
+	return !_initiatorTypeEnums.Known(enum)
 }
 
 /*
@@ -48,25 +70,16 @@ func (enum InitiatorTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *InitiatorTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _initiatorTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _initiatorTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -76,13 +89,20 @@ const (
 	initiatorTypeScript
 	// initiatorTypePreload represents the "preload" value.
 	initiatorTypePreload
+	// initiatorTypeSignedExchange represents the "SignedExchange" value.
+	initiatorTypeSignedExchange
+	// initiatorTypePreflight represents the "preflight" value.
+	initiatorTypePreflight
 	// initiatorTypeOther represents the "other" value.
 	initiatorTypeOther
 )
 
-var _initiatorTypeEnums = map[InitiatorTypeEnum]string{
-	initiatorTypeParser:  "parser",
-	initiatorTypeScript:  "script",
-	initiatorTypePreload: "preload",
-	initiatorTypeOther:   "other",
-}
+var _initiatorTypeEnums = enums.New(map[InitiatorTypeEnum]string{
+	InitiatorTypeEnum(0):        "",
+	initiatorTypeParser:         "parser",
+	initiatorTypeScript:         "script",
+	initiatorTypePreload:        "preload",
+	initiatorTypeSignedExchange: "SignedExchange",
+	initiatorTypePreflight:      "preflight",
+	initiatorTypeOther:          "other",
+})
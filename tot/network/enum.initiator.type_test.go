@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumInitiatorType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InitiatorTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumInitiatorType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"parser"` != string(result) {
-		t.Errorf("Expected '\"parser\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"parser"`, result)
 	}
 	json.Unmarshal([]byte(`"parser"`), &enum)
 	if InitiatorType.Parser != enum {
-		t.Errorf("Expcected %d, got %d", InitiatorType.Parser, enum)
+		t.Errorf("Expected %d, got %d", InitiatorType.Parser, enum)
 	}
 
 	enum = InitiatorType.Script
@@ -42,11 +60,11 @@ func TestEnumInitiatorType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"script"` != string(result) {
-		t.Errorf("Expected '\"script\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"script"`, result)
 	}
 	json.Unmarshal([]byte(`"script"`), &enum)
 	if InitiatorType.Script != enum {
-		t.Errorf("Expcected %d, got %d", InitiatorType.Script, enum)
+		t.Errorf("Expected %d, got %d", InitiatorType.Script, enum)
 	}
 
 	enum = InitiatorType.Preload
@@ -55,18 +73,38 @@ func TestEnumInitiatorType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"preload"` != string(result) {
-		t.Errorf("Expected '\"preload\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"preload"`, result)
 	}
 	json.Unmarshal([]byte(`"preload"`), &enum)
 	if InitiatorType.Preload != enum {
-		t.Errorf("Expcected %d, got %d", InitiatorType.Preload, enum)
+		t.Errorf("Expected %d, got %d", InitiatorType.Preload, enum)
 	}
-}
 
-func TestEnumInitiatorType2(t *testing.T) {
-	var enum InitiatorTypeEnum
-	var err error
-	var result []byte
+	enum = InitiatorType.SignedExchange
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignedExchange"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignedExchange"`, result)
+	}
+	json.Unmarshal([]byte(`"SignedExchange"`), &enum)
+	if InitiatorType.SignedExchange != enum {
+		t.Errorf("Expected %d, got %d", InitiatorType.SignedExchange, enum)
+	}
+
+	enum = InitiatorType.Preflight
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"preflight"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"preflight"`, result)
+	}
+	json.Unmarshal([]byte(`"preflight"`), &enum)
+	if InitiatorType.Preflight != enum {
+		t.Errorf("Expected %d, got %d", InitiatorType.Preflight, enum)
+	}
 
 	enum = InitiatorType.Other
 	result, err = json.Marshal(enum)
@@ -74,10 +112,10 @@ func TestEnumInitiatorType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"other"` != string(result) {
-		t.Errorf("Expected '\"other\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
 	}
 	json.Unmarshal([]byte(`"other"`), &enum)
 	if InitiatorType.Other != enum {
-		t.Errorf("Expcected %d, got %d", InitiatorType.Other, enum)
+		t.Errorf("Expected %d, got %d", InitiatorType.Other, enum)
 	}
 }
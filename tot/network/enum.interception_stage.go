@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type interceptionStageEnum struct {
@@ -11,7 +14,7 @@ type interceptionStageEnum struct {
 }
 
 /*
-InterceptionStage provides named acces to the InterceptionStageEnum values.
+InterceptionStage provides named access to the InterceptionStageEnum values.
 */
 var InterceptionStage = interceptionStageEnum{
 	Request:         interceptionStageRequest,
@@ -19,11 +22,14 @@ var InterceptionStage = interceptionStageEnum{
 }
 
 /*
-InterceptionStageEnum represents stages of the interception to begin
-intercepting. Request will intercept before the request is sent. Response will
-intercept after the response is received. EXPERIMENTAL. Allowed values:
-	- InterceptionStage.Request         "Request"
-	- InterceptionStage.HeadersReceived "HeadersReceived"
+InterceptionStageEnum represents the Network.InterceptionStage values.
+
+Stages of the interception to begin intercepting. Request will intercept before
+the request is sent. Response will intercept after the response is received.
+
+Allowed values:
+  - InterceptionStage.Request         "Request"
+  - InterceptionStage.HeadersReceived "HeadersReceived"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-InterceptionStage
 */
@@ -33,7 +39,16 @@ type InterceptionStageEnum int
 String implements Stringer
 */
 func (enum InterceptionStageEnum) String() string {
-	return _interceptionStageEnums[enum]
+	return _interceptionStageEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InterceptionStageEnum) Unknown() bool {
+	return !_interceptionStageEnums.Known(enum)
 }
 
 /*
@@ -44,25 +59,16 @@ func (enum InterceptionStageEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *InterceptionStageEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _interceptionStageEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _interceptionStageEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -72,7 +78,8 @@ const (
 	interceptionStageHeadersReceived
 )
 
-var _interceptionStageEnums = map[InterceptionStageEnum]string{
+var _interceptionStageEnums = enums.New(map[InterceptionStageEnum]string{
+	InterceptionStageEnum(0):         "",
 	interceptionStageRequest:         "Request",
 	interceptionStageHeadersReceived: "HeadersReceived",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumInterceptionStage(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InterceptionStageEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumInterceptionStage(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Request"` != string(result) {
-		t.Errorf("Expected '\"Request\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Request"`, result)
 	}
 	json.Unmarshal([]byte(`"Request"`), &enum)
 	if InterceptionStage.Request != enum {
-		t.Errorf("Expcected %d, got %d", InterceptionStage.Request, enum)
+		t.Errorf("Expected %d, got %d", InterceptionStage.Request, enum)
 	}
 
 	enum = InterceptionStage.HeadersReceived
@@ -42,10 +60,10 @@ func TestEnumInterceptionStage(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"HeadersReceived"` != string(result) {
-		t.Errorf("Expected '\"HeadersReceived\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"HeadersReceived"`, result)
 	}
 	json.Unmarshal([]byte(`"HeadersReceived"`), &enum)
 	if InterceptionStage.HeadersReceived != enum {
-		t.Errorf("Expcected %d, got %d", InterceptionStage.HeadersReceived, enum)
+		t.Errorf("Expected %d, got %d", InterceptionStage.HeadersReceived, enum)
 	}
 }
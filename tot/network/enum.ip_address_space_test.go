@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumIPAddressSpace(t *testing.T) {
+	var enum IPAddressSpaceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown IPAddressSpaceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = IPAddressSpace.Loopback
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Loopback"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Loopback"`, result)
+	}
+	json.Unmarshal([]byte(`"Loopback"`), &enum)
+	if IPAddressSpace.Loopback != enum {
+		t.Errorf("Expected %d, got %d", IPAddressSpace.Loopback, enum)
+	}
+
+	enum = IPAddressSpace.Local
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Local"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Local"`, result)
+	}
+	json.Unmarshal([]byte(`"Local"`), &enum)
+	if IPAddressSpace.Local != enum {
+		t.Errorf("Expected %d, got %d", IPAddressSpace.Local, enum)
+	}
+
+	enum = IPAddressSpace.Public
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Public"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Public"`, result)
+	}
+	json.Unmarshal([]byte(`"Public"`), &enum)
+	if IPAddressSpace.Public != enum {
+		t.Errorf("Expected %d, got %d", IPAddressSpace.Public, enum)
+	}
+
+	enum = IPAddressSpace.Unknown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Unknown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Unknown"`, result)
+	}
+	json.Unmarshal([]byte(`"Unknown"`), &enum)
+	if IPAddressSpace.Unknown != enum {
+		t.Errorf("Expected %d, got %d", IPAddressSpace.Unknown, enum)
+	}
+}
@@ -0,0 +1,113 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type privateNetworkRequestPolicyEnum struct {
+	Allow                          PrivateNetworkRequestPolicyEnum
+	BlockFromInsecureToMorePrivate PrivateNetworkRequestPolicyEnum
+	WarnFromInsecureToMorePrivate  PrivateNetworkRequestPolicyEnum
+	PreflightBlock                 PrivateNetworkRequestPolicyEnum
+	PreflightWarn                  PrivateNetworkRequestPolicyEnum
+	PermissionBlock                PrivateNetworkRequestPolicyEnum
+	PermissionWarn                 PrivateNetworkRequestPolicyEnum
+}
+
+/*
+PrivateNetworkRequestPolicy provides named access to the PrivateNetworkRequestPolicyEnum values.
+*/
+var PrivateNetworkRequestPolicy = privateNetworkRequestPolicyEnum{
+	Allow:                          privateNetworkRequestPolicyAllow,
+	BlockFromInsecureToMorePrivate: privateNetworkRequestPolicyBlockFromInsecureToMorePrivate,
+	WarnFromInsecureToMorePrivate:  privateNetworkRequestPolicyWarnFromInsecureToMorePrivate,
+	PreflightBlock:                 privateNetworkRequestPolicyPreflightBlock,
+	PreflightWarn:                  privateNetworkRequestPolicyPreflightWarn,
+	PermissionBlock:                privateNetworkRequestPolicyPermissionBlock,
+	PermissionWarn:                 privateNetworkRequestPolicyPermissionWarn,
+}
+
+/*
+PrivateNetworkRequestPolicyEnum represents the
+Network.PrivateNetworkRequestPolicy values.
+
+Allowed values:
+  - PrivateNetworkRequestPolicy.Allow                          "Allow"
+  - PrivateNetworkRequestPolicy.BlockFromInsecureToMorePrivate "BlockFromInsecureToMorePrivate"
+  - PrivateNetworkRequestPolicy.WarnFromInsecureToMorePrivate  "WarnFromInsecureToMorePrivate"
+  - PrivateNetworkRequestPolicy.PreflightBlock                 "PreflightBlock"
+  - PrivateNetworkRequestPolicy.PreflightWarn                  "PreflightWarn"
+  - PrivateNetworkRequestPolicy.PermissionBlock                "PermissionBlock"
+  - PrivateNetworkRequestPolicy.PermissionWarn                 "PermissionWarn"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-PrivateNetworkRequestPolicy
+*/
+type PrivateNetworkRequestPolicyEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PrivateNetworkRequestPolicyEnum) String() string {
+	return _privateNetworkRequestPolicyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PrivateNetworkRequestPolicyEnum) Unknown() bool {
+	return !_privateNetworkRequestPolicyEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PrivateNetworkRequestPolicyEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PrivateNetworkRequestPolicyEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _privateNetworkRequestPolicyEnums.Parse(val)
+	return nil
+}
+
+const (
+	// privateNetworkRequestPolicyAllow represents the "Allow" value.
+	privateNetworkRequestPolicyAllow PrivateNetworkRequestPolicyEnum = iota + 1
+	// privateNetworkRequestPolicyBlockFromInsecureToMorePrivate represents the "BlockFromInsecureToMorePrivate" value.
+	privateNetworkRequestPolicyBlockFromInsecureToMorePrivate
+	// privateNetworkRequestPolicyWarnFromInsecureToMorePrivate represents the "WarnFromInsecureToMorePrivate" value.
+	privateNetworkRequestPolicyWarnFromInsecureToMorePrivate
+	// privateNetworkRequestPolicyPreflightBlock represents the "PreflightBlock" value.
+	privateNetworkRequestPolicyPreflightBlock
+	// privateNetworkRequestPolicyPreflightWarn represents the "PreflightWarn" value.
+	privateNetworkRequestPolicyPreflightWarn
+	// privateNetworkRequestPolicyPermissionBlock represents the "PermissionBlock" value.
+	privateNetworkRequestPolicyPermissionBlock
+	// privateNetworkRequestPolicyPermissionWarn represents the "PermissionWarn" value.
+	privateNetworkRequestPolicyPermissionWarn
+)
+
+var _privateNetworkRequestPolicyEnums = enums.New(map[PrivateNetworkRequestPolicyEnum]string{
+	PrivateNetworkRequestPolicyEnum(0):                        "",
+	privateNetworkRequestPolicyAllow:                          "Allow",
+	privateNetworkRequestPolicyBlockFromInsecureToMorePrivate: "BlockFromInsecureToMorePrivate",
+	privateNetworkRequestPolicyWarnFromInsecureToMorePrivate:  "WarnFromInsecureToMorePrivate",
+	privateNetworkRequestPolicyPreflightBlock:                 "PreflightBlock",
+	privateNetworkRequestPolicyPreflightWarn:                  "PreflightWarn",
+	privateNetworkRequestPolicyPermissionBlock:                "PermissionBlock",
+	privateNetworkRequestPolicyPermissionWarn:                 "PermissionWarn",
+})
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type reportStatusEnum struct {
+	Queued           ReportStatusEnum
+	Pending          ReportStatusEnum
+	MarkedForRemoval ReportStatusEnum
+	Success          ReportStatusEnum
+}
+
+/*
+ReportStatus provides named access to the ReportStatusEnum values.
+*/
+var ReportStatus = reportStatusEnum{
+	Queued:           reportStatusQueued,
+	Pending:          reportStatusPending,
+	MarkedForRemoval: reportStatusMarkedForRemoval,
+	Success:          reportStatusSuccess,
+}
+
+/*
+ReportStatusEnum represents the Network.ReportStatus values.
+
+The status of a Reporting API report.
+
+Allowed values:
+  - ReportStatus.Queued           "Queued"
+  - ReportStatus.Pending          "Pending"
+  - ReportStatus.MarkedForRemoval "MarkedForRemoval"
+  - ReportStatus.Success          "Success"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ReportStatus
+*/
+type ReportStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ReportStatusEnum) String() string {
+	return _reportStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReportStatusEnum) Unknown() bool {
+	return !_reportStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ReportStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ReportStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _reportStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// reportStatusQueued represents the "Queued" value.
+	reportStatusQueued ReportStatusEnum = iota + 1
+	// reportStatusPending represents the "Pending" value.
+	reportStatusPending
+	// reportStatusMarkedForRemoval represents the "MarkedForRemoval" value.
+	reportStatusMarkedForRemoval
+	// reportStatusSuccess represents the "Success" value.
+	reportStatusSuccess
+)
+
+var _reportStatusEnums = enums.New(map[ReportStatusEnum]string{
+	ReportStatusEnum(0):          "",
+	reportStatusQueued:           "Queued",
+	reportStatusPending:          "Pending",
+	reportStatusMarkedForRemoval: "MarkedForRemoval",
+	reportStatusSuccess:          "Success",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumReportStatus(t *testing.T) {
+	var enum ReportStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ReportStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ReportStatus.Queued
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Queued"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Queued"`, result)
+	}
+	json.Unmarshal([]byte(`"Queued"`), &enum)
+	if ReportStatus.Queued != enum {
+		t.Errorf("Expected %d, got %d", ReportStatus.Queued, enum)
+	}
+
+	enum = ReportStatus.Pending
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Pending"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Pending"`, result)
+	}
+	json.Unmarshal([]byte(`"Pending"`), &enum)
+	if ReportStatus.Pending != enum {
+		t.Errorf("Expected %d, got %d", ReportStatus.Pending, enum)
+	}
+
+	enum = ReportStatus.MarkedForRemoval
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MarkedForRemoval"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MarkedForRemoval"`, result)
+	}
+	json.Unmarshal([]byte(`"MarkedForRemoval"`), &enum)
+	if ReportStatus.MarkedForRemoval != enum {
+		t.Errorf("Expected %d, got %d", ReportStatus.MarkedForRemoval, enum)
+	}
+
+	enum = ReportStatus.Success
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Success"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Success"`, result)
+	}
+	json.Unmarshal([]byte(`"Success"`), &enum)
+	if ReportStatus.Success != enum {
+		t.Errorf("Expected %d, got %d", ReportStatus.Success, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type referrerPolicyEnum struct {
@@ -17,7 +20,7 @@ type referrerPolicyEnum struct {
 }
 
 /*
-ReferrerPolicy provides named acces to the ReferrerPolicyEnum values.
+ReferrerPolicy provides named access to the ReferrerPolicyEnum values.
 */
 var ReferrerPolicy = referrerPolicyEnum{
 	UnsafeURL:                   referrerPolicyUnsafeURL,
@@ -31,18 +34,22 @@ var ReferrerPolicy = referrerPolicyEnum{
 }
 
 /*
-ReferrerPolicyEnum represents the referrer policy of the request, as defined in
-https://www.w3.org/TR/referrer-policy/. Allowed values:
-	- ReferrerPolicy.UnsafeURL                   "unsafe-url"
-	- ReferrerPolicy.NoReferrerWhenDowngrade     "no-referrer-when-downgrade"
-	- ReferrerPolicy.NoReferrer                  "no-referrer"
-	- ReferrerPolicy.Origin                      "origin"
-	- ReferrerPolicy.OriginWhenCrossOrigin       "origin-when-cross-origin"
-	- ReferrerPolicy.SameOrigin                  "same-origin"
-	- ReferrerPolicy.StrictOrigin                "strict-origin"
-	- ReferrerPolicy.StrictOriginWhenCrossOrigin "strict-origin-when-cross-origin"
+ReferrerPolicyEnum represents the Network.Request.referrerPolicy values.
+
+The referrer policy of the request, as defined in
+https://www.w3.org/TR/referrer-policy/
+
+Allowed values:
+  - ReferrerPolicy.UnsafeURL                   "unsafe-url"
+  - ReferrerPolicy.NoReferrerWhenDowngrade     "no-referrer-when-downgrade"
+  - ReferrerPolicy.NoReferrer                  "no-referrer"
+  - ReferrerPolicy.Origin                      "origin"
+  - ReferrerPolicy.OriginWhenCrossOrigin       "origin-when-cross-origin"
+  - ReferrerPolicy.SameOrigin                  "same-origin"
+  - ReferrerPolicy.StrictOrigin                "strict-origin"
+  - ReferrerPolicy.StrictOriginWhenCrossOrigin "strict-origin-when-cross-origin"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ResourcePriority
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-Request
 */
 type ReferrerPolicyEnum int
 
@@ -50,7 +57,16 @@ type ReferrerPolicyEnum int
 String implements Stringer
 */
 func (enum ReferrerPolicyEnum) String() string {
-	return _referrerPolicyEnums[enum]
+	return _referrerPolicyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReferrerPolicyEnum) Unknown() bool {
+	return !_referrerPolicyEnums.Known(enum)
 }
 
 /*
@@ -61,25 +77,16 @@ func (enum ReferrerPolicyEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ReferrerPolicyEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _referrerPolicyEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _referrerPolicyEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -101,7 +108,8 @@ const (
 	referrerPolicyStrictOriginWhenCrossOrigin
 )
 
-var _referrerPolicyEnums = map[ReferrerPolicyEnum]string{
+var _referrerPolicyEnums = enums.New(map[ReferrerPolicyEnum]string{
+	ReferrerPolicyEnum(0):                     "",
 	referrerPolicyUnsafeURL:                   "unsafe-url",
 	referrerPolicyNoReferrerWhenDowngrade:     "no-referrer-when-downgrade",
 	referrerPolicyNoReferrer:                  "no-referrer",
@@ -110,4 +118,4 @@ var _referrerPolicyEnums = map[ReferrerPolicyEnum]string{
 	referrerPolicySameOrigin:                  "same-origin",
 	referrerPolicyStrictOrigin:                "strict-origin",
 	referrerPolicyStrictOriginWhenCrossOrigin: "strict-origin-when-cross-origin",
-}
+})
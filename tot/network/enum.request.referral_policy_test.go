@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumReferrerPolicy(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ReferrerPolicyEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumReferrerPolicy(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"unsafe-url"` != string(result) {
-		t.Errorf("Expected '\"unsafe-url\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"unsafe-url"`, result)
 	}
 	json.Unmarshal([]byte(`"unsafe-url"`), &enum)
 	if ReferrerPolicy.UnsafeURL != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.UnsafeURL, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.UnsafeURL, enum)
 	}
 
 	enum = ReferrerPolicy.NoReferrerWhenDowngrade
@@ -42,11 +60,11 @@ func TestEnumReferrerPolicy(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"no-referrer-when-downgrade"` != string(result) {
-		t.Errorf("Expected '\"no-referrer-when-downgrade\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"no-referrer-when-downgrade"`, result)
 	}
 	json.Unmarshal([]byte(`"no-referrer-when-downgrade"`), &enum)
 	if ReferrerPolicy.NoReferrerWhenDowngrade != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.NoReferrerWhenDowngrade, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.NoReferrerWhenDowngrade, enum)
 	}
 
 	enum = ReferrerPolicy.NoReferrer
@@ -55,18 +73,12 @@ func TestEnumReferrerPolicy(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"no-referrer"` != string(result) {
-		t.Errorf("Expected '\"no-referrer\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"no-referrer"`, result)
 	}
 	json.Unmarshal([]byte(`"no-referrer"`), &enum)
 	if ReferrerPolicy.NoReferrer != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.NoReferrer, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.NoReferrer, enum)
 	}
-}
-
-func TestEnumReferrerPolicy2(t *testing.T) {
-	var enum ReferrerPolicyEnum
-	var err error
-	var result []byte
 
 	enum = ReferrerPolicy.Origin
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumReferrerPolicy2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"origin"` != string(result) {
-		t.Errorf("Expected '\"origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"origin"`, result)
 	}
 	json.Unmarshal([]byte(`"origin"`), &enum)
 	if ReferrerPolicy.Origin != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.Origin, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.Origin, enum)
 	}
 
 	enum = ReferrerPolicy.OriginWhenCrossOrigin
@@ -87,11 +99,11 @@ func TestEnumReferrerPolicy2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"origin-when-cross-origin"` != string(result) {
-		t.Errorf("Expected '\"origin-when-cross-origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"origin-when-cross-origin"`, result)
 	}
 	json.Unmarshal([]byte(`"origin-when-cross-origin"`), &enum)
 	if ReferrerPolicy.OriginWhenCrossOrigin != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.OriginWhenCrossOrigin, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.OriginWhenCrossOrigin, enum)
 	}
 
 	enum = ReferrerPolicy.SameOrigin
@@ -100,11 +112,11 @@ func TestEnumReferrerPolicy2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"same-origin"` != string(result) {
-		t.Errorf("Expected '\"same-origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"same-origin"`, result)
 	}
 	json.Unmarshal([]byte(`"same-origin"`), &enum)
 	if ReferrerPolicy.SameOrigin != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.SameOrigin, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.SameOrigin, enum)
 	}
 
 	enum = ReferrerPolicy.StrictOrigin
@@ -113,18 +125,12 @@ func TestEnumReferrerPolicy2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"strict-origin"` != string(result) {
-		t.Errorf("Expected '\"strict-origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"strict-origin"`, result)
 	}
 	json.Unmarshal([]byte(`"strict-origin"`), &enum)
 	if ReferrerPolicy.StrictOrigin != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.StrictOrigin, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.StrictOrigin, enum)
 	}
-}
-
-func TestEnumReferrerPolicy3(t *testing.T) {
-	var enum ReferrerPolicyEnum
-	var err error
-	var result []byte
 
 	enum = ReferrerPolicy.StrictOriginWhenCrossOrigin
 	result, err = json.Marshal(enum)
@@ -132,10 +138,10 @@ func TestEnumReferrerPolicy3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"strict-origin-when-cross-origin"` != string(result) {
-		t.Errorf("Expected '\"strict-origin-when-cross-origin\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"strict-origin-when-cross-origin"`, result)
 	}
 	json.Unmarshal([]byte(`"strict-origin-when-cross-origin"`), &enum)
 	if ReferrerPolicy.StrictOriginWhenCrossOrigin != enum {
-		t.Errorf("Expcected %d, got %d", ReferrerPolicy.StrictOriginWhenCrossOrigin, enum)
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.StrictOriginWhenCrossOrigin, enum)
 	}
 }
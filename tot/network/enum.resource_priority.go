@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type resourcePriorityEnum struct {
@@ -14,7 +17,7 @@ type resourcePriorityEnum struct {
 }
 
 /*
-ResourcePriority provides named acces to the ResourcePriorityEnum values.
+ResourcePriority provides named access to the ResourcePriorityEnum values.
 */
 var ResourcePriority = resourcePriorityEnum{
 	VeryLow:  resourcePriorityVeryLow,
@@ -25,13 +28,16 @@ var ResourcePriority = resourcePriorityEnum{
 }
 
 /*
-ResourcePriorityEnum represents the loading priority of a resource request.
+ResourcePriorityEnum represents the Network.ResourcePriority values.
+
+Loading priority of a resource request.
+
 Allowed values:
-	- ResourcePriority.VeryLow  "VeryLow"
-	- ResourcePriority.Low      "Low"
-	- ResourcePriority.Medium   "Medium"
-	- ResourcePriority.High     "High"
-	- ResourcePriority.VeryHigh "VeryHigh"
+  - ResourcePriority.VeryLow  "VeryLow"
+  - ResourcePriority.Low      "Low"
+  - ResourcePriority.Medium   "Medium"
+  - ResourcePriority.High     "High"
+  - ResourcePriority.VeryHigh "VeryHigh"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ResourcePriority
 */
@@ -41,7 +47,16 @@ type ResourcePriorityEnum int
 String implements Stringer
 */
 func (enum ResourcePriorityEnum) String() string {
-	return _resourcePriorityEnums[enum]
+	return _resourcePriorityEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ResourcePriorityEnum) Unknown() bool {
+	return !_resourcePriorityEnums.Known(enum)
 }
 
 /*
@@ -52,25 +67,16 @@ func (enum ResourcePriorityEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ResourcePriorityEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _resourcePriorityEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _resourcePriorityEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -86,10 +92,11 @@ const (
 	resourcePriorityVeryHigh
 )
 
-var _resourcePriorityEnums = map[ResourcePriorityEnum]string{
+var _resourcePriorityEnums = enums.New(map[ResourcePriorityEnum]string{
+	ResourcePriorityEnum(0):  "",
 	resourcePriorityVeryLow:  "VeryLow",
 	resourcePriorityLow:      "Low",
 	resourcePriorityMedium:   "Medium",
 	resourcePriorityHigh:     "High",
 	resourcePriorityVeryHigh: "VeryHigh",
-}
+})
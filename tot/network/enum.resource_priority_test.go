@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -11,8 +13,24 @@ func TestEnumResourcePriority(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ResourcePriorityEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumResourcePriority(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"VeryLow"` != string(result) {
-		t.Errorf("Expected '\"VeryLow\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"VeryLow"`, result)
 	}
 	json.Unmarshal([]byte(`"VeryLow"`), &enum)
 	if ResourcePriority.VeryLow != enum {
-		t.Errorf("Expcected %d, got %d", ResourcePriority.VeryLow, enum)
+		t.Errorf("Expected %d, got %d", ResourcePriority.VeryLow, enum)
 	}
 
 	enum = ResourcePriority.Low
@@ -42,11 +60,11 @@ func TestEnumResourcePriority(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Low"` != string(result) {
-		t.Errorf("Expected '\"Low\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Low"`, result)
 	}
 	json.Unmarshal([]byte(`"Low"`), &enum)
 	if ResourcePriority.Low != enum {
-		t.Errorf("Expcected %d, got %d", ResourcePriority.Low, enum)
+		t.Errorf("Expected %d, got %d", ResourcePriority.Low, enum)
 	}
 
 	enum = ResourcePriority.Medium
@@ -55,18 +73,12 @@ func TestEnumResourcePriority(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Medium"` != string(result) {
-		t.Errorf("Expected '\"Medium\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Medium"`, result)
 	}
 	json.Unmarshal([]byte(`"Medium"`), &enum)
 	if ResourcePriority.Medium != enum {
-		t.Errorf("Expcected %d, got %d", ResourcePriority.Medium, enum)
+		t.Errorf("Expected %d, got %d", ResourcePriority.Medium, enum)
 	}
-}
-
-func TestEnumResourcePriority2(t *testing.T) {
-	var enum ResourcePriorityEnum
-	var err error
-	var result []byte
 
 	enum = ResourcePriority.High
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumResourcePriority2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"High"` != string(result) {
-		t.Errorf("Expected '\"High\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"High"`, result)
 	}
 	json.Unmarshal([]byte(`"High"`), &enum)
 	if ResourcePriority.High != enum {
-		t.Errorf("Expcected %d, got %d", ResourcePriority.High, enum)
+		t.Errorf("Expected %d, got %d", ResourcePriority.High, enum)
 	}
 
 	enum = ResourcePriority.VeryHigh
@@ -87,10 +99,10 @@ func TestEnumResourcePriority2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"VeryHigh"` != string(result) {
-		t.Errorf("Expected '\"VeryHigh\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"VeryHigh"`, result)
 	}
 	json.Unmarshal([]byte(`"VeryHigh"`), &enum)
 	if ResourcePriority.VeryHigh != enum {
-		t.Errorf("Expcected %d, got %d", ResourcePriority.VeryHigh, enum)
+		t.Errorf("Expected %d, got %d", ResourcePriority.VeryHigh, enum)
 	}
 }
@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type serviceWorkerResponseSourceEnum struct {
+	CacheStorage ServiceWorkerResponseSourceEnum
+	HTTPCache    ServiceWorkerResponseSourceEnum
+	FallbackCode ServiceWorkerResponseSourceEnum
+	Network      ServiceWorkerResponseSourceEnum
+}
+
+/*
+ServiceWorkerResponseSource provides named access to the ServiceWorkerResponseSourceEnum values.
+*/
+var ServiceWorkerResponseSource = serviceWorkerResponseSourceEnum{
+	CacheStorage: serviceWorkerResponseSourceCacheStorage,
+	HTTPCache:    serviceWorkerResponseSourceHTTPCache,
+	FallbackCode: serviceWorkerResponseSourceFallbackCode,
+	Network:      serviceWorkerResponseSourceNetwork,
+}
+
+/*
+ServiceWorkerResponseSourceEnum represents the
+Network.ServiceWorkerResponseSource values.
+
+Source of serviceworker response.
+
+Allowed values:
+  - ServiceWorkerResponseSource.CacheStorage "cache-storage"
+  - ServiceWorkerResponseSource.HTTPCache    "http-cache"
+  - ServiceWorkerResponseSource.FallbackCode "fallback-code"
+  - ServiceWorkerResponseSource.Network      "network"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ServiceWorkerResponseSource
+*/
+type ServiceWorkerResponseSourceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ServiceWorkerResponseSourceEnum) String() string {
+	return _serviceWorkerResponseSourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ServiceWorkerResponseSourceEnum) Unknown() bool {
+	return !_serviceWorkerResponseSourceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ServiceWorkerResponseSourceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ServiceWorkerResponseSourceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _serviceWorkerResponseSourceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// serviceWorkerResponseSourceCacheStorage represents the "cache-storage" value.
+	serviceWorkerResponseSourceCacheStorage ServiceWorkerResponseSourceEnum = iota + 1
+	// serviceWorkerResponseSourceHTTPCache represents the "http-cache" value.
+	serviceWorkerResponseSourceHTTPCache
+	// serviceWorkerResponseSourceFallbackCode represents the "fallback-code" value.
+	serviceWorkerResponseSourceFallbackCode
+	// serviceWorkerResponseSourceNetwork represents the "network" value.
+	serviceWorkerResponseSourceNetwork
+)
+
+var _serviceWorkerResponseSourceEnums = enums.New(map[ServiceWorkerResponseSourceEnum]string{
+	ServiceWorkerResponseSourceEnum(0):      "",
+	serviceWorkerResponseSourceCacheStorage: "cache-storage",
+	serviceWorkerResponseSourceHTTPCache:    "http-cache",
+	serviceWorkerResponseSourceFallbackCode: "fallback-code",
+	serviceWorkerResponseSourceNetwork:      "network",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumServiceWorkerResponseSource(t *testing.T) {
+	var enum ServiceWorkerResponseSourceEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ServiceWorkerResponseSourceEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ServiceWorkerResponseSource.CacheStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cache-storage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cache-storage"`, result)
+	}
+	json.Unmarshal([]byte(`"cache-storage"`), &enum)
+	if ServiceWorkerResponseSource.CacheStorage != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerResponseSource.CacheStorage, enum)
+	}
+
+	enum = ServiceWorkerResponseSource.HTTPCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"http-cache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"http-cache"`, result)
+	}
+	json.Unmarshal([]byte(`"http-cache"`), &enum)
+	if ServiceWorkerResponseSource.HTTPCache != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerResponseSource.HTTPCache, enum)
+	}
+
+	enum = ServiceWorkerResponseSource.FallbackCode
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fallback-code"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fallback-code"`, result)
+	}
+	json.Unmarshal([]byte(`"fallback-code"`), &enum)
+	if ServiceWorkerResponseSource.FallbackCode != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerResponseSource.FallbackCode, enum)
+	}
+
+	enum = ServiceWorkerResponseSource.Network
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"network"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"network"`, result)
+	}
+	json.Unmarshal([]byte(`"network"`), &enum)
+	if ServiceWorkerResponseSource.Network != enum {
+		t.Errorf("Expected %d, got %d", ServiceWorkerResponseSource.Network, enum)
+	}
+}
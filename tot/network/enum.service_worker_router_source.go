@@ -0,0 +1,103 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type serviceWorkerRouterSourceEnum struct {
+	Network                    ServiceWorkerRouterSourceEnum
+	Cache                      ServiceWorkerRouterSourceEnum
+	FetchEvent                 ServiceWorkerRouterSourceEnum
+	RaceNetworkAndFetchHandler ServiceWorkerRouterSourceEnum
+	RaceNetworkAndCache        ServiceWorkerRouterSourceEnum
+}
+
+/*
+ServiceWorkerRouterSource provides named access to the ServiceWorkerRouterSourceEnum values.
+*/
+var ServiceWorkerRouterSource = serviceWorkerRouterSourceEnum{
+	Network:                    serviceWorkerRouterSourceNetwork,
+	Cache:                      serviceWorkerRouterSourceCache,
+	FetchEvent:                 serviceWorkerRouterSourceFetchEvent,
+	RaceNetworkAndFetchHandler: serviceWorkerRouterSourceRaceNetworkAndFetchHandler,
+	RaceNetworkAndCache:        serviceWorkerRouterSourceRaceNetworkAndCache,
+}
+
+/*
+ServiceWorkerRouterSourceEnum represents the Network.ServiceWorkerRouterSource
+values.
+
+Source of service worker router.
+
+Allowed values:
+  - ServiceWorkerRouterSource.Network                    "network"
+  - ServiceWorkerRouterSource.Cache                      "cache"
+  - ServiceWorkerRouterSource.FetchEvent                 "fetch-event"
+  - ServiceWorkerRouterSource.RaceNetworkAndFetchHandler "race-network-and-fetch-handler"
+  - ServiceWorkerRouterSource.RaceNetworkAndCache        "race-network-and-cache"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ServiceWorkerRouterSource
+*/
+type ServiceWorkerRouterSourceEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ServiceWorkerRouterSourceEnum) String() string {
+	return _serviceWorkerRouterSourceEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ServiceWorkerRouterSourceEnum) Unknown() bool {
+	return !_serviceWorkerRouterSourceEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ServiceWorkerRouterSourceEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ServiceWorkerRouterSourceEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _serviceWorkerRouterSourceEnums.Parse(val)
+	return nil
+}
+
+const (
+	// serviceWorkerRouterSourceNetwork represents the "network" value.
+	serviceWorkerRouterSourceNetwork ServiceWorkerRouterSourceEnum = iota + 1
+	// serviceWorkerRouterSourceCache represents the "cache" value.
+	serviceWorkerRouterSourceCache
+	// serviceWorkerRouterSourceFetchEvent represents the "fetch-event" value.
+	serviceWorkerRouterSourceFetchEvent
+	// serviceWorkerRouterSourceRaceNetworkAndFetchHandler represents the "race-network-and-fetch-handler" value.
+	serviceWorkerRouterSourceRaceNetworkAndFetchHandler
+	// serviceWorkerRouterSourceRaceNetworkAndCache represents the "race-network-and-cache" value.
+	serviceWorkerRouterSourceRaceNetworkAndCache
+)
+
+var _serviceWorkerRouterSourceEnums = enums.New(map[ServiceWorkerRouterSourceEnum]string{
+	ServiceWorkerRouterSourceEnum(0):                    "",
+	serviceWorkerRouterSourceNetwork:                    "network",
+	serviceWorkerRouterSourceCache:                      "cache",
+	serviceWorkerRouterSourceFetchEvent:                 "fetch-event",
+	serviceWorkerRouterSourceRaceNetworkAndFetchHandler: "race-network-and-fetch-handler",
+	serviceWorkerRouterSourceRaceNetworkAndCache:        "race-network-and-cache",
+})
@@ -0,0 +1,204 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type setCookieBlockedReasonEnum struct {
+	SecureOnly                               SetCookieBlockedReasonEnum
+	SameSiteStrict                           SetCookieBlockedReasonEnum
+	SameSiteLax                              SetCookieBlockedReasonEnum
+	SameSiteUnspecifiedTreatedAsLax          SetCookieBlockedReasonEnum
+	SameSiteNoneInsecure                     SetCookieBlockedReasonEnum
+	UserPreferences                          SetCookieBlockedReasonEnum
+	ThirdPartyPhaseout                       SetCookieBlockedReasonEnum
+	ThirdPartyBlockedInFirstPartySet         SetCookieBlockedReasonEnum
+	SyntaxError                              SetCookieBlockedReasonEnum
+	SchemeNotSupported                       SetCookieBlockedReasonEnum
+	OverwriteSecure                          SetCookieBlockedReasonEnum
+	InvalidDomain                            SetCookieBlockedReasonEnum
+	InvalidPrefix                            SetCookieBlockedReasonEnum
+	UnknownError                             SetCookieBlockedReasonEnum
+	SchemefulSameSiteStrict                  SetCookieBlockedReasonEnum
+	SchemefulSameSiteLax                     SetCookieBlockedReasonEnum
+	SchemefulSameSiteUnspecifiedTreatedAsLax SetCookieBlockedReasonEnum
+	SamePartyFromCrossPartyContext           SetCookieBlockedReasonEnum
+	SamePartyConflictsWithOtherAttributes    SetCookieBlockedReasonEnum
+	NameValuePairExceedsMaxSize              SetCookieBlockedReasonEnum
+	DisallowedCharacter                      SetCookieBlockedReasonEnum
+	NoCookieContent                          SetCookieBlockedReasonEnum
+}
+
+/*
+SetCookieBlockedReason provides named access to the SetCookieBlockedReasonEnum values.
+*/
+var SetCookieBlockedReason = setCookieBlockedReasonEnum{
+	SecureOnly:                               setCookieBlockedReasonSecureOnly,
+	SameSiteStrict:                           setCookieBlockedReasonSameSiteStrict,
+	SameSiteLax:                              setCookieBlockedReasonSameSiteLax,
+	SameSiteUnspecifiedTreatedAsLax:          setCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+	SameSiteNoneInsecure:                     setCookieBlockedReasonSameSiteNoneInsecure,
+	UserPreferences:                          setCookieBlockedReasonUserPreferences,
+	ThirdPartyPhaseout:                       setCookieBlockedReasonThirdPartyPhaseout,
+	ThirdPartyBlockedInFirstPartySet:         setCookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+	SyntaxError:                              setCookieBlockedReasonSyntaxError,
+	SchemeNotSupported:                       setCookieBlockedReasonSchemeNotSupported,
+	OverwriteSecure:                          setCookieBlockedReasonOverwriteSecure,
+	InvalidDomain:                            setCookieBlockedReasonInvalidDomain,
+	InvalidPrefix:                            setCookieBlockedReasonInvalidPrefix,
+	UnknownError:                             setCookieBlockedReasonUnknownError,
+	SchemefulSameSiteStrict:                  setCookieBlockedReasonSchemefulSameSiteStrict,
+	SchemefulSameSiteLax:                     setCookieBlockedReasonSchemefulSameSiteLax,
+	SchemefulSameSiteUnspecifiedTreatedAsLax: setCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+	SamePartyFromCrossPartyContext:           setCookieBlockedReasonSamePartyFromCrossPartyContext,
+	SamePartyConflictsWithOtherAttributes:    setCookieBlockedReasonSamePartyConflictsWithOtherAttributes,
+	NameValuePairExceedsMaxSize:              setCookieBlockedReasonNameValuePairExceedsMaxSize,
+	DisallowedCharacter:                      setCookieBlockedReasonDisallowedCharacter,
+	NoCookieContent:                          setCookieBlockedReasonNoCookieContent,
+}
+
+/*
+SetCookieBlockedReasonEnum represents the Network.SetCookieBlockedReason values.
+
+Types of reasons why a cookie may not be stored from a response.
+
+Allowed values:
+  - SetCookieBlockedReason.SecureOnly                               "SecureOnly"
+  - SetCookieBlockedReason.SameSiteStrict                           "SameSiteStrict"
+  - SetCookieBlockedReason.SameSiteLax                              "SameSiteLax"
+  - SetCookieBlockedReason.SameSiteUnspecifiedTreatedAsLax          "SameSiteUnspecifiedTreatedAsLax"
+  - SetCookieBlockedReason.SameSiteNoneInsecure                     "SameSiteNoneInsecure"
+  - SetCookieBlockedReason.UserPreferences                          "UserPreferences"
+  - SetCookieBlockedReason.ThirdPartyPhaseout                       "ThirdPartyPhaseout"
+  - SetCookieBlockedReason.ThirdPartyBlockedInFirstPartySet         "ThirdPartyBlockedInFirstPartySet"
+  - SetCookieBlockedReason.SyntaxError                              "SyntaxError"
+  - SetCookieBlockedReason.SchemeNotSupported                       "SchemeNotSupported"
+  - SetCookieBlockedReason.OverwriteSecure                          "OverwriteSecure"
+  - SetCookieBlockedReason.InvalidDomain                            "InvalidDomain"
+  - SetCookieBlockedReason.InvalidPrefix                            "InvalidPrefix"
+  - SetCookieBlockedReason.UnknownError                             "UnknownError"
+  - SetCookieBlockedReason.SchemefulSameSiteStrict                  "SchemefulSameSiteStrict"
+  - SetCookieBlockedReason.SchemefulSameSiteLax                     "SchemefulSameSiteLax"
+  - SetCookieBlockedReason.SchemefulSameSiteUnspecifiedTreatedAsLax "SchemefulSameSiteUnspecifiedTreatedAsLax"
+  - SetCookieBlockedReason.SamePartyFromCrossPartyContext           "SamePartyFromCrossPartyContext"
+  - SetCookieBlockedReason.SamePartyConflictsWithOtherAttributes    "SamePartyConflictsWithOtherAttributes"
+  - SetCookieBlockedReason.NameValuePairExceedsMaxSize              "NameValuePairExceedsMaxSize"
+  - SetCookieBlockedReason.DisallowedCharacter                      "DisallowedCharacter"
+  - SetCookieBlockedReason.NoCookieContent                          "NoCookieContent"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SetCookieBlockedReason
+*/
+type SetCookieBlockedReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SetCookieBlockedReasonEnum) String() string {
+	return _setCookieBlockedReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SetCookieBlockedReasonEnum) Unknown() bool {
+	return !_setCookieBlockedReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SetCookieBlockedReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SetCookieBlockedReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _setCookieBlockedReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// setCookieBlockedReasonSecureOnly represents the "SecureOnly" value.
+	setCookieBlockedReasonSecureOnly SetCookieBlockedReasonEnum = iota + 1
+	// setCookieBlockedReasonSameSiteStrict represents the "SameSiteStrict" value.
+	setCookieBlockedReasonSameSiteStrict
+	// setCookieBlockedReasonSameSiteLax represents the "SameSiteLax" value.
+	setCookieBlockedReasonSameSiteLax
+	// setCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax represents the "SameSiteUnspecifiedTreatedAsLax" value.
+	setCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax
+	// setCookieBlockedReasonSameSiteNoneInsecure represents the "SameSiteNoneInsecure" value.
+	setCookieBlockedReasonSameSiteNoneInsecure
+	// setCookieBlockedReasonUserPreferences represents the "UserPreferences" value.
+	setCookieBlockedReasonUserPreferences
+	// setCookieBlockedReasonThirdPartyPhaseout represents the "ThirdPartyPhaseout" value.
+	setCookieBlockedReasonThirdPartyPhaseout
+	// setCookieBlockedReasonThirdPartyBlockedInFirstPartySet represents the "ThirdPartyBlockedInFirstPartySet" value.
+	setCookieBlockedReasonThirdPartyBlockedInFirstPartySet
+	// setCookieBlockedReasonSyntaxError represents the "SyntaxError" value.
+	setCookieBlockedReasonSyntaxError
+	// setCookieBlockedReasonSchemeNotSupported represents the "SchemeNotSupported" value.
+	setCookieBlockedReasonSchemeNotSupported
+	// setCookieBlockedReasonOverwriteSecure represents the "OverwriteSecure" value.
+	setCookieBlockedReasonOverwriteSecure
+	// setCookieBlockedReasonInvalidDomain represents the "InvalidDomain" value.
+	setCookieBlockedReasonInvalidDomain
+	// setCookieBlockedReasonInvalidPrefix represents the "InvalidPrefix" value.
+	setCookieBlockedReasonInvalidPrefix
+	// setCookieBlockedReasonUnknownError represents the "UnknownError" value.
+	setCookieBlockedReasonUnknownError
+	// setCookieBlockedReasonSchemefulSameSiteStrict represents the "SchemefulSameSiteStrict" value.
+	setCookieBlockedReasonSchemefulSameSiteStrict
+	// setCookieBlockedReasonSchemefulSameSiteLax represents the "SchemefulSameSiteLax" value.
+	setCookieBlockedReasonSchemefulSameSiteLax
+	// setCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax represents the "SchemefulSameSiteUnspecifiedTreatedAsLax" value.
+	setCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax
+	// setCookieBlockedReasonSamePartyFromCrossPartyContext represents the "SamePartyFromCrossPartyContext" value.
+	setCookieBlockedReasonSamePartyFromCrossPartyContext
+	// setCookieBlockedReasonSamePartyConflictsWithOtherAttributes represents the "SamePartyConflictsWithOtherAttributes" value.
+	setCookieBlockedReasonSamePartyConflictsWithOtherAttributes
+	// setCookieBlockedReasonNameValuePairExceedsMaxSize represents the "NameValuePairExceedsMaxSize" value.
+	setCookieBlockedReasonNameValuePairExceedsMaxSize
+	// setCookieBlockedReasonDisallowedCharacter represents the "DisallowedCharacter" value.
+	setCookieBlockedReasonDisallowedCharacter
+	// setCookieBlockedReasonNoCookieContent represents the "NoCookieContent" value.
+	setCookieBlockedReasonNoCookieContent
+)
+
+var _setCookieBlockedReasonEnums = enums.New(map[SetCookieBlockedReasonEnum]string{
+	SetCookieBlockedReasonEnum(0):                                  "",
+	setCookieBlockedReasonSecureOnly:                               "SecureOnly",
+	setCookieBlockedReasonSameSiteStrict:                           "SameSiteStrict",
+	setCookieBlockedReasonSameSiteLax:                              "SameSiteLax",
+	setCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax:          "SameSiteUnspecifiedTreatedAsLax",
+	setCookieBlockedReasonSameSiteNoneInsecure:                     "SameSiteNoneInsecure",
+	setCookieBlockedReasonUserPreferences:                          "UserPreferences",
+	setCookieBlockedReasonThirdPartyPhaseout:                       "ThirdPartyPhaseout",
+	setCookieBlockedReasonThirdPartyBlockedInFirstPartySet:         "ThirdPartyBlockedInFirstPartySet",
+	setCookieBlockedReasonSyntaxError:                              "SyntaxError",
+	setCookieBlockedReasonSchemeNotSupported:                       "SchemeNotSupported",
+	setCookieBlockedReasonOverwriteSecure:                          "OverwriteSecure",
+	setCookieBlockedReasonInvalidDomain:                            "InvalidDomain",
+	setCookieBlockedReasonInvalidPrefix:                            "InvalidPrefix",
+	setCookieBlockedReasonUnknownError:                             "UnknownError",
+	setCookieBlockedReasonSchemefulSameSiteStrict:                  "SchemefulSameSiteStrict",
+	setCookieBlockedReasonSchemefulSameSiteLax:                     "SchemefulSameSiteLax",
+	setCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax: "SchemefulSameSiteUnspecifiedTreatedAsLax",
+	setCookieBlockedReasonSamePartyFromCrossPartyContext:           "SamePartyFromCrossPartyContext",
+	setCookieBlockedReasonSamePartyConflictsWithOtherAttributes:    "SamePartyConflictsWithOtherAttributes",
+	setCookieBlockedReasonNameValuePairExceedsMaxSize:              "NameValuePairExceedsMaxSize",
+	setCookieBlockedReasonDisallowedCharacter:                      "DisallowedCharacter",
+	setCookieBlockedReasonNoCookieContent:                          "NoCookieContent",
+})
@@ -0,0 +1,109 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type signedExchangeErrorFieldEnum struct {
+	SignatureSig         SignedExchangeErrorFieldEnum
+	SignatureIntegrity   SignedExchangeErrorFieldEnum
+	SignatureCertURL     SignedExchangeErrorFieldEnum
+	SignatureCertSha256  SignedExchangeErrorFieldEnum
+	SignatureValidityURL SignedExchangeErrorFieldEnum
+	SignatureTimestamps  SignedExchangeErrorFieldEnum
+}
+
+/*
+SignedExchangeErrorField provides named access to the SignedExchangeErrorFieldEnum values.
+*/
+var SignedExchangeErrorField = signedExchangeErrorFieldEnum{
+	SignatureSig:         signedExchangeErrorFieldSignatureSig,
+	SignatureIntegrity:   signedExchangeErrorFieldSignatureIntegrity,
+	SignatureCertURL:     signedExchangeErrorFieldSignatureCertURL,
+	SignatureCertSha256:  signedExchangeErrorFieldSignatureCertSha256,
+	SignatureValidityURL: signedExchangeErrorFieldSignatureValidityURL,
+	SignatureTimestamps:  signedExchangeErrorFieldSignatureTimestamps,
+}
+
+/*
+SignedExchangeErrorFieldEnum represents the Network.SignedExchangeErrorField
+values.
+
+Field type for a signed exchange related error.
+
+Allowed values:
+  - SignedExchangeErrorField.SignatureSig         "signatureSig"
+  - SignedExchangeErrorField.SignatureIntegrity   "signatureIntegrity"
+  - SignedExchangeErrorField.SignatureCertURL     "signatureCertUrl"
+  - SignedExchangeErrorField.SignatureCertSha256  "signatureCertSha256"
+  - SignedExchangeErrorField.SignatureValidityURL "signatureValidityUrl"
+  - SignedExchangeErrorField.SignatureTimestamps  "signatureTimestamps"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-SignedExchangeErrorField
+*/
+type SignedExchangeErrorFieldEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SignedExchangeErrorFieldEnum) String() string {
+	return _signedExchangeErrorFieldEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SignedExchangeErrorFieldEnum) Unknown() bool {
+	return !_signedExchangeErrorFieldEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SignedExchangeErrorFieldEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SignedExchangeErrorFieldEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _signedExchangeErrorFieldEnums.Parse(val)
+	return nil
+}
+
+const (
+	// signedExchangeErrorFieldSignatureSig represents the "signatureSig" value.
+	signedExchangeErrorFieldSignatureSig SignedExchangeErrorFieldEnum = iota + 1
+	// signedExchangeErrorFieldSignatureIntegrity represents the "signatureIntegrity" value.
+	signedExchangeErrorFieldSignatureIntegrity
+	// signedExchangeErrorFieldSignatureCertURL represents the "signatureCertUrl" value.
+	signedExchangeErrorFieldSignatureCertURL
+	// signedExchangeErrorFieldSignatureCertSha256 represents the "signatureCertSha256" value.
+	signedExchangeErrorFieldSignatureCertSha256
+	// signedExchangeErrorFieldSignatureValidityURL represents the "signatureValidityUrl" value.
+	signedExchangeErrorFieldSignatureValidityURL
+	// signedExchangeErrorFieldSignatureTimestamps represents the "signatureTimestamps" value.
+	signedExchangeErrorFieldSignatureTimestamps
+)
+
+var _signedExchangeErrorFieldEnums = enums.New(map[SignedExchangeErrorFieldEnum]string{
+	SignedExchangeErrorFieldEnum(0):              "",
+	signedExchangeErrorFieldSignatureSig:         "signatureSig",
+	signedExchangeErrorFieldSignatureIntegrity:   "signatureIntegrity",
+	signedExchangeErrorFieldSignatureCertURL:     "signatureCertUrl",
+	signedExchangeErrorFieldSignatureCertSha256:  "signatureCertSha256",
+	signedExchangeErrorFieldSignatureValidityURL: "signatureValidityUrl",
+	signedExchangeErrorFieldSignatureTimestamps:  "signatureTimestamps",
+})
@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSignedExchangeErrorField(t *testing.T) {
+	var enum SignedExchangeErrorFieldEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SignedExchangeErrorFieldEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SignedExchangeErrorField.SignatureSig
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureSig"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureSig"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureSig"`), &enum)
+	if SignedExchangeErrorField.SignatureSig != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureSig, enum)
+	}
+
+	enum = SignedExchangeErrorField.SignatureIntegrity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureIntegrity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureIntegrity"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureIntegrity"`), &enum)
+	if SignedExchangeErrorField.SignatureIntegrity != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureIntegrity, enum)
+	}
+
+	enum = SignedExchangeErrorField.SignatureCertURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureCertUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureCertUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureCertUrl"`), &enum)
+	if SignedExchangeErrorField.SignatureCertURL != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureCertURL, enum)
+	}
+
+	enum = SignedExchangeErrorField.SignatureCertSha256
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureCertSha256"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureCertSha256"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureCertSha256"`), &enum)
+	if SignedExchangeErrorField.SignatureCertSha256 != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureCertSha256, enum)
+	}
+
+	enum = SignedExchangeErrorField.SignatureValidityURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureValidityUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureValidityUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureValidityUrl"`), &enum)
+	if SignedExchangeErrorField.SignatureValidityURL != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureValidityURL, enum)
+	}
+
+	enum = SignedExchangeErrorField.SignatureTimestamps
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"signatureTimestamps"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"signatureTimestamps"`, result)
+	}
+	json.Unmarshal([]byte(`"signatureTimestamps"`), &enum)
+	if SignedExchangeErrorField.SignatureTimestamps != enum {
+		t.Errorf("Expected %d, got %d", SignedExchangeErrorField.SignatureTimestamps, enum)
+	}
+}
@@ -0,0 +1,152 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type statusEnum struct {
+	Ok                 StatusEnum
+	InvalidArgument    StatusEnum
+	MissingIssuerKeys  StatusEnum
+	FailedPrecondition StatusEnum
+	ResourceExhausted  StatusEnum
+	AlreadyExists      StatusEnum
+	ResourceLimited    StatusEnum
+	Unauthorized       StatusEnum
+	BadResponse        StatusEnum
+	InternalError      StatusEnum
+	UnknownError       StatusEnum
+	FulfilledLocally   StatusEnum
+	SiteIssuerLimit    StatusEnum
+}
+
+/*
+Status provides named access to the StatusEnum values.
+*/
+var Status = statusEnum{
+	Ok:                 statusOk,
+	InvalidArgument:    statusInvalidArgument,
+	MissingIssuerKeys:  statusMissingIssuerKeys,
+	FailedPrecondition: statusFailedPrecondition,
+	ResourceExhausted:  statusResourceExhausted,
+	AlreadyExists:      statusAlreadyExists,
+	ResourceLimited:    statusResourceLimited,
+	Unauthorized:       statusUnauthorized,
+	BadResponse:        statusBadResponse,
+	InternalError:      statusInternalError,
+	UnknownError:       statusUnknownError,
+	FulfilledLocally:   statusFulfilledLocally,
+	SiteIssuerLimit:    statusSiteIssuerLimit,
+}
+
+/*
+StatusEnum represents the Network.trustTokenOperationDone.status values.
+
+Detailed success or error status of the operation. 'AlreadyExists' also
+signifies a successful operation, as the result of the operation already exists
+und thus, the operation was abort preemptively (e.g. a cache hit).
+
+Allowed values:
+  - Status.Ok                 "Ok"
+  - Status.InvalidArgument    "InvalidArgument"
+  - Status.MissingIssuerKeys  "MissingIssuerKeys"
+  - Status.FailedPrecondition "FailedPrecondition"
+  - Status.ResourceExhausted  "ResourceExhausted"
+  - Status.AlreadyExists      "AlreadyExists"
+  - Status.ResourceLimited    "ResourceLimited"
+  - Status.Unauthorized       "Unauthorized"
+  - Status.BadResponse        "BadResponse"
+  - Status.InternalError      "InternalError"
+  - Status.UnknownError       "UnknownError"
+  - Status.FulfilledLocally   "FulfilledLocally"
+  - Status.SiteIssuerLimit    "SiteIssuerLimit"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-trustTokenOperationDone
+*/
+type StatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StatusEnum) String() string {
+	return _statusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StatusEnum) Unknown() bool {
+	return !_statusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _statusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// statusOk represents the "Ok" value.
+	statusOk StatusEnum = iota + 1
+	// statusInvalidArgument represents the "InvalidArgument" value.
+	statusInvalidArgument
+	// statusMissingIssuerKeys represents the "MissingIssuerKeys" value.
+	statusMissingIssuerKeys
+	// statusFailedPrecondition represents the "FailedPrecondition" value.
+	statusFailedPrecondition
+	// statusResourceExhausted represents the "ResourceExhausted" value.
+	statusResourceExhausted
+	// statusAlreadyExists represents the "AlreadyExists" value.
+	statusAlreadyExists
+	// statusResourceLimited represents the "ResourceLimited" value.
+	statusResourceLimited
+	// statusUnauthorized represents the "Unauthorized" value.
+	statusUnauthorized
+	// statusBadResponse represents the "BadResponse" value.
+	statusBadResponse
+	// statusInternalError represents the "InternalError" value.
+	statusInternalError
+	// statusUnknownError represents the "UnknownError" value.
+	statusUnknownError
+	// statusFulfilledLocally represents the "FulfilledLocally" value.
+	statusFulfilledLocally
+	// statusSiteIssuerLimit represents the "SiteIssuerLimit" value.
+	statusSiteIssuerLimit
+)
+
+var _statusEnums = enums.New(map[StatusEnum]string{
+	StatusEnum(0):            "",
+	statusOk:                 "Ok",
+	statusInvalidArgument:    "InvalidArgument",
+	statusMissingIssuerKeys:  "MissingIssuerKeys",
+	statusFailedPrecondition: "FailedPrecondition",
+	statusResourceExhausted:  "ResourceExhausted",
+	statusAlreadyExists:      "AlreadyExists",
+	statusResourceLimited:    "ResourceLimited",
+	statusUnauthorized:       "Unauthorized",
+	statusBadResponse:        "BadResponse",
+	statusInternalError:      "InternalError",
+	statusUnknownError:       "UnknownError",
+	statusFulfilledLocally:   "FulfilledLocally",
+	statusSiteIssuerLimit:    "SiteIssuerLimit",
+})
@@ -0,0 +1,212 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStatus(t *testing.T) {
+	var enum StatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Status.Ok
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ok"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ok"`, result)
+	}
+	json.Unmarshal([]byte(`"Ok"`), &enum)
+	if Status.Ok != enum {
+		t.Errorf("Expected %d, got %d", Status.Ok, enum)
+	}
+
+	enum = Status.InvalidArgument
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidArgument"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidArgument"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidArgument"`), &enum)
+	if Status.InvalidArgument != enum {
+		t.Errorf("Expected %d, got %d", Status.InvalidArgument, enum)
+	}
+
+	enum = Status.MissingIssuerKeys
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MissingIssuerKeys"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MissingIssuerKeys"`, result)
+	}
+	json.Unmarshal([]byte(`"MissingIssuerKeys"`), &enum)
+	if Status.MissingIssuerKeys != enum {
+		t.Errorf("Expected %d, got %d", Status.MissingIssuerKeys, enum)
+	}
+
+	enum = Status.FailedPrecondition
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FailedPrecondition"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FailedPrecondition"`, result)
+	}
+	json.Unmarshal([]byte(`"FailedPrecondition"`), &enum)
+	if Status.FailedPrecondition != enum {
+		t.Errorf("Expected %d, got %d", Status.FailedPrecondition, enum)
+	}
+
+	enum = Status.ResourceExhausted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ResourceExhausted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ResourceExhausted"`, result)
+	}
+	json.Unmarshal([]byte(`"ResourceExhausted"`), &enum)
+	if Status.ResourceExhausted != enum {
+		t.Errorf("Expected %d, got %d", Status.ResourceExhausted, enum)
+	}
+
+	enum = Status.AlreadyExists
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AlreadyExists"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AlreadyExists"`, result)
+	}
+	json.Unmarshal([]byte(`"AlreadyExists"`), &enum)
+	if Status.AlreadyExists != enum {
+		t.Errorf("Expected %d, got %d", Status.AlreadyExists, enum)
+	}
+
+	enum = Status.ResourceLimited
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ResourceLimited"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ResourceLimited"`, result)
+	}
+	json.Unmarshal([]byte(`"ResourceLimited"`), &enum)
+	if Status.ResourceLimited != enum {
+		t.Errorf("Expected %d, got %d", Status.ResourceLimited, enum)
+	}
+
+	enum = Status.Unauthorized
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Unauthorized"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Unauthorized"`, result)
+	}
+	json.Unmarshal([]byte(`"Unauthorized"`), &enum)
+	if Status.Unauthorized != enum {
+		t.Errorf("Expected %d, got %d", Status.Unauthorized, enum)
+	}
+
+	enum = Status.BadResponse
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BadResponse"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BadResponse"`, result)
+	}
+	json.Unmarshal([]byte(`"BadResponse"`), &enum)
+	if Status.BadResponse != enum {
+		t.Errorf("Expected %d, got %d", Status.BadResponse, enum)
+	}
+
+	enum = Status.InternalError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InternalError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InternalError"`, result)
+	}
+	json.Unmarshal([]byte(`"InternalError"`), &enum)
+	if Status.InternalError != enum {
+		t.Errorf("Expected %d, got %d", Status.InternalError, enum)
+	}
+
+	enum = Status.UnknownError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UnknownError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UnknownError"`, result)
+	}
+	json.Unmarshal([]byte(`"UnknownError"`), &enum)
+	if Status.UnknownError != enum {
+		t.Errorf("Expected %d, got %d", Status.UnknownError, enum)
+	}
+
+	enum = Status.FulfilledLocally
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FulfilledLocally"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FulfilledLocally"`, result)
+	}
+	json.Unmarshal([]byte(`"FulfilledLocally"`), &enum)
+	if Status.FulfilledLocally != enum {
+		t.Errorf("Expected %d, got %d", Status.FulfilledLocally, enum)
+	}
+
+	enum = Status.SiteIssuerLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SiteIssuerLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SiteIssuerLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"SiteIssuerLimit"`), &enum)
+	if Status.SiteIssuerLimit != enum {
+		t.Errorf("Expected %d, got %d", Status.SiteIssuerLimit, enum)
+	}
+}
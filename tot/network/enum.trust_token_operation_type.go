@@ -0,0 +1,89 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type trustTokenOperationTypeEnum struct {
+	Issuance   TrustTokenOperationTypeEnum
+	Redemption TrustTokenOperationTypeEnum
+	Signing    TrustTokenOperationTypeEnum
+}
+
+/*
+TrustTokenOperationType provides named access to the TrustTokenOperationTypeEnum values.
+*/
+var TrustTokenOperationType = trustTokenOperationTypeEnum{
+	Issuance:   trustTokenOperationTypeIssuance,
+	Redemption: trustTokenOperationTypeRedemption,
+	Signing:    trustTokenOperationTypeSigning,
+}
+
+/*
+TrustTokenOperationTypeEnum represents the Network.TrustTokenOperationType
+values.
+
+Allowed values:
+  - TrustTokenOperationType.Issuance   "Issuance"
+  - TrustTokenOperationType.Redemption "Redemption"
+  - TrustTokenOperationType.Signing    "Signing"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TrustTokenOperationType
+*/
+type TrustTokenOperationTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TrustTokenOperationTypeEnum) String() string {
+	return _trustTokenOperationTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TrustTokenOperationTypeEnum) Unknown() bool {
+	return !_trustTokenOperationTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TrustTokenOperationTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TrustTokenOperationTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _trustTokenOperationTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// trustTokenOperationTypeIssuance represents the "Issuance" value.
+	trustTokenOperationTypeIssuance TrustTokenOperationTypeEnum = iota + 1
+	// trustTokenOperationTypeRedemption represents the "Redemption" value.
+	trustTokenOperationTypeRedemption
+	// trustTokenOperationTypeSigning represents the "Signing" value.
+	trustTokenOperationTypeSigning
+)
+
+var _trustTokenOperationTypeEnums = enums.New(map[TrustTokenOperationTypeEnum]string{
+	TrustTokenOperationTypeEnum(0):    "",
+	trustTokenOperationTypeIssuance:   "Issuance",
+	trustTokenOperationTypeRedemption: "Redemption",
+	trustTokenOperationTypeSigning:    "Signing",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package network
 
 import (
@@ -23,6 +25,10 @@ type DataReceivedEvent struct {
 	// encodings).
 	EncodedDataLength int `json:"encodedDataLength"`
 
+	// Optional. Data that was received. (Encoded as a base64 string when passed
+	// over JSON) EXPERIMENTAL.
+	Data string `json:"data,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -64,24 +70,57 @@ type LoadingFailedEvent struct {
 	// Timestamp.
 	Timestamp MonotonicTime `json:"timestamp"`
 
-	// Resource type.
+	// Resource type. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	Type page.ResourceTypeEnum `json:"type"`
 
-	// User friendly error message.
+	// Error message. List of network errors:
+	// https://cs.chromium.org/chromium/src/net/base/net_error_list.h
 	ErrorText string `json:"errorText"`
 
 	// Optional. True if loading was canceled.
 	Canceled bool `json:"canceled,omitempty"`
 
 	// Optional. The reason why loading was blocked, if any. Allowed values:
-	//	- BlockedReason.Csp
-	//	- BlockedReason.MixedContent
-	//	- BlockedReason.Origin
-	//	- BlockedReason.Inspector
-	//	- BlockedReason.SubresourceFilter
-	//	- BlockedReason.Other
+	//   - BlockedReason.Other
+	//   - BlockedReason.Csp
+	//   - BlockedReason.MixedContent
+	//   - BlockedReason.Origin
+	//   - BlockedReason.Inspector
+	//   - BlockedReason.Integrity
+	//   - BlockedReason.SubresourceFilter
+	//   - BlockedReason.ContentType
+	//   - BlockedReason.CoepFrameResourceNeedsCoepHeader
+	//   - BlockedReason.CoopSandboxedIframeCannotNavigateToCoopPage
+	//   - BlockedReason.CorpNotSameOrigin
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoep
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByDip
+	//   - BlockedReason.CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip
+	//   - BlockedReason.CorpNotSameSite
+	//   - BlockedReason.SriMessageSignatureMismatch
 	BlockedReason BlockedReasonEnum `json:"blockedReason,omitempty"`
 
+	// Optional. The reason why loading was blocked by CORS, if any.
+	CorsErrorStatus *CorsErrorStatus `json:"corsErrorStatus,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -111,46 +150,71 @@ RequestInterceptedEvent represents Network.requestIntercepted event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestIntercepted
 */
 type RequestInterceptedEvent struct {
-	// Each request the page makes will have a unique id, however if any
-	// redirects are encountered while processing that fetch, they will be
-	// reported with the same id as the original fetch. Likewise if HTTP
-	// authentication is needed then the same fetch id will be used.
+	// Each request the page makes will have a unique id, however if any redirects
+	// are encountered while processing that fetch, they will be reported with the
+	// same id as the original fetch. Likewise if HTTP authentication is needed
+	// then the same fetch id will be used.
 	InterceptionID InterceptionID `json:"interceptionId"`
 
-	// desc.
 	Request *Request `json:"request"`
 
-	// The ID of the frame that initiated the request.
+	// The id of the frame that initiated the request.
 	FrameID page.FrameID `json:"frameId"`
 
-	// How the requested resource will be used.
+	// How the requested resource will be used. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	ResourceType page.ResourceTypeEnum `json:"resourceType"`
 
 	// Whether this is a navigation request, which can abort the navigation
 	// completely.
 	IsNavigationRequest bool `json:"isNavigationRequest"`
 
+	// Optional. Set if the request is a navigation that will result in a download.
+	// Only present after response is received from the server (i.e.
+	// HeadersReceived stage).
+	IsDownload bool `json:"isDownload,omitempty"`
+
 	// Optional. Redirect location, only sent if a redirect was intercepted.
 	RedirectURL string `json:"redirectUrl,omitempty"`
 
-	// Optional. Details of the Authorization Challenge encountered. If this is
-	// set then continueInterceptedRequest must contain an authChallengeResponse.
+	// Optional. Details of the Authorization Challenge encountered. If this is set
+	// then continueInterceptedRequest must contain an authChallengeResponse.
 	AuthChallenge *AuthChallenge `json:"authChallenge,omitempty"`
 
 	// Optional. Response error if intercepted at response stage or if redirect
 	// occurred while intercepting request. Allowed values:
-	//	- ErrorReason.Failed
-	//	- ErrorReason.Aborted
-	//	- ErrorReason.TimedOut
-	//	- ErrorReason.AccessDenied
-	//	- ErrorReason.ConnectionClosed
-	//	- ErrorReason.ConnectionReset
-	//	- ErrorReason.ConnectionRefused
-	//	- ErrorReason.ConnectionAborted
-	//	- ErrorReason.ConnectionFailed
-	//	- ErrorReason.NameNotResolved
-	//	- ErrorReason.InternetDisconnected
-	//	- ErrorReason.AddressUnreachable
+	//   - ErrorReason.Failed
+	//   - ErrorReason.Aborted
+	//   - ErrorReason.TimedOut
+	//   - ErrorReason.AccessDenied
+	//   - ErrorReason.ConnectionClosed
+	//   - ErrorReason.ConnectionReset
+	//   - ErrorReason.ConnectionRefused
+	//   - ErrorReason.ConnectionAborted
+	//   - ErrorReason.ConnectionFailed
+	//   - ErrorReason.NameNotResolved
+	//   - ErrorReason.InternetDisconnected
+	//   - ErrorReason.AddressUnreachable
+	//   - ErrorReason.BlockedByClient
+	//   - ErrorReason.BlockedByResponse
 	ResponseErrorReason ErrorReasonEnum `json:"responseErrorReason,omitempty"`
 
 	// Optional. Response code if intercepted at response stage or if redirect
@@ -161,6 +225,11 @@ type RequestInterceptedEvent struct {
 	// redirect occurred while intercepting request or auth retry occurred.
 	ResponseHeaders Headers `json:"responseHeaders,omitempty"`
 
+	// Optional. If the intercepted request had a corresponding requestWillBeSent
+	// event fired for it, then this requestId will be the same as the requestId
+	// present in the requestWillBeSent event.
+	RequestID RequestID `json:"requestId,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -205,15 +274,43 @@ type RequestWillBeSentEvent struct {
 	// Request initiator.
 	Initiator *Initiator `json:"initiator"`
 
-	// Redirect response data.
-	RedirectResponse *Response `json:"redirectResponse"`
-
-	// Optional. Type of this resource.
+	// In the case that redirectResponse is populated, this flag indicates whether
+	// requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or
+	// were emitted for the request which was just redirected. EXPERIMENTAL.
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+
+	// Optional. Redirect response data.
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+
+	// Optional. Type of this resource. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	Type page.ResourceTypeEnum `json:"type,omitempty"`
 
 	// Optional. Frame identifier.
 	FrameID page.FrameID `json:"frameId,omitempty"`
 
+	// Optional. Whether the request is initiated by a user gesture. Defaults to
+	// false.
+	HasUserGesture bool `json:"hasUserGesture,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -227,12 +324,12 @@ type ResourceChangedPriorityEvent struct {
 	// Request identifier.
 	RequestID RequestID `json:"requestId"`
 
-	// New priority. Allowed values:
-	//	- ResourcePriority.VeryLow
-	//	- ResourcePriority.Low
-	//	- ResourcePriority.Medium
-	//	- ResourcePriority.High
-	//	- ResourcePriority.VeryHigh
+	// New priority Allowed values:
+	//   - ResourcePriority.VeryLow
+	//   - ResourcePriority.Low
+	//   - ResourcePriority.Medium
+	//   - ResourcePriority.High
+	//   - ResourcePriority.VeryHigh
 	NewPriority ResourcePriorityEnum `json:"newPriority"`
 
 	// Timestamp.
@@ -242,6 +339,22 @@ type ResourceChangedPriorityEvent struct {
 	Err error `json:"-"`
 }
 
+/*
+SignedExchangeReceivedEvent represents Network.signedExchangeReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-signedExchangeReceived
+*/
+type SignedExchangeReceivedEvent struct {
+	// Request identifier.
+	RequestID RequestID `json:"requestId"`
+
+	// Information about the signed exchange response.
+	Info *SignedExchangeInfo `json:"info"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 ResponseReceivedEvent represents Network.responseReceived event data.
 
@@ -257,12 +370,35 @@ type ResponseReceivedEvent struct {
 	// Timestamp.
 	Timestamp MonotonicTime `json:"timestamp"`
 
-	// Resource type.
+	// Resource type. Allowed values:
+	//   - page.ResourceType.Document
+	//   - page.ResourceType.Stylesheet
+	//   - page.ResourceType.Image
+	//   - page.ResourceType.Media
+	//   - page.ResourceType.Font
+	//   - page.ResourceType.Script
+	//   - page.ResourceType.TextTrack
+	//   - page.ResourceType.XHR
+	//   - page.ResourceType.Fetch
+	//   - page.ResourceType.Prefetch
+	//   - page.ResourceType.EventSource
+	//   - page.ResourceType.WebSocket
+	//   - page.ResourceType.Manifest
+	//   - page.ResourceType.SignedExchange
+	//   - page.ResourceType.Ping
+	//   - page.ResourceType.CSPViolationReport
+	//   - page.ResourceType.Preflight
+	//   - page.ResourceType.FedCM
+	//   - page.ResourceType.Other
 	Type page.ResourceTypeEnum `json:"type"`
 
 	// Response data.
 	Response *Response `json:"response"`
 
+	// Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo
+	// events will be or were emitted for this request. EXPERIMENTAL.
+	HasExtraInfo bool `json:"hasExtraInfo"`
+
 	// Optional. Frame identifier.
 	FrameID page.FrameID `json:"frameId,omitempty"`
 
@@ -295,10 +431,16 @@ type WebSocketCreatedEvent struct {
 	// Request identifier.
 	RequestID RequestID `json:"requestId"`
 
-	// Timestamp.
+	// WebSocket request URL.
+	URL string `json:"url"`
+
+	// Optional. Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Timestamp. DEPRECATED.
 	Timestamp MonotonicTime `json:"timestamp"`
 
-	// Optional. WebSocket frame error message.
+	// Optional. WebSocket frame error message. DEPRECATED.
 	ErrorMessage string `json:"errorMessage,omitempty"`
 
 	// Error information related to this event
@@ -317,8 +459,8 @@ type WebSocketFrameErrorEvent struct {
 	// Timestamp.
 	Timestamp MonotonicTime `json:"timestamp"`
 
-	// Optional. WebSocket frame error message.
-	ErrorMessage string `json:"errorMessage,omitempty"`
+	// WebSocket error message.
+	ErrorMessage string `json:"errorMessage"`
 
 	// Error information related to this event
 	Err error `json:"-"`
@@ -363,8 +505,7 @@ type WebSocketFrameSentEvent struct {
 }
 
 /*
-WebSocketHandshakeResponseReceivedEvent represents Network.webSocketHandshakeResponseReceived event
-data.
+WebSocketHandshakeResponseReceivedEvent represents Network.webSocketHandshakeResponseReceived event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketHandshakeResponseReceived
 */
@@ -376,15 +517,14 @@ type WebSocketHandshakeResponseReceivedEvent struct {
 	Timestamp MonotonicTime `json:"timestamp"`
 
 	// WebSocket response data.
-	Response *WebSocketFrame `json:"response"`
+	Response *WebSocketResponse `json:"response"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
 /*
-WebSocketWillSendHandshakeRequestEvent represents Network.webSocketWillSendHandshakeRequest event
-data.
+WebSocketWillSendHandshakeRequestEvent represents Network.webSocketWillSendHandshakeRequest event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketWillSendHandshakeRequest
 */
@@ -404,3 +544,558 @@ type WebSocketWillSendHandshakeRequestEvent struct {
 	// Error information related to this event
 	Err error `json:"-"`
 }
+
+/*
+WebTransportCreatedEvent represents Network.webTransportCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportCreated
+*/
+type WebTransportCreatedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// WebTransport request URL.
+	URL string `json:"url"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional. Request initiator.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebTransportConnectionEstablishedEvent represents Network.webTransportConnectionEstablished event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportConnectionEstablished
+*/
+type WebTransportConnectionEstablishedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+WebTransportClosedEvent represents Network.webTransportClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportClosed
+*/
+type WebTransportClosedEvent struct {
+	// WebTransport identifier.
+	TransportID RequestID `json:"transportId"`
+
+	// Timestamp.
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketCreatedEvent represents Network.directTCPSocketCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketCreated
+*/
+type DirectTCPSocketCreatedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	RemoteAddr string `json:"remoteAddr"`
+
+	// Unsigned int 16.
+	RemotePort int `json:"remotePort"`
+
+	Options *DirectTCPSocketOptions `json:"options"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketOpenedEvent represents Network.directTCPSocketOpened event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketOpened
+*/
+type DirectTCPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	RemoteAddr string `json:"remoteAddr"`
+
+	// Expected to be unsigned integer.
+	RemotePort int `json:"remotePort"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional.
+	LocalAddr string `json:"localAddr,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	LocalPort int `json:"localPort,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketAbortedEvent represents Network.directTCPSocketAborted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketAborted
+*/
+type DirectTCPSocketAbortedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	ErrorMessage string `json:"errorMessage"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketClosedEvent represents Network.directTCPSocketClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketClosed
+*/
+type DirectTCPSocketClosedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketChunkSentEvent represents Network.directTCPSocketChunkSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketChunkSent
+*/
+type DirectTCPSocketChunkSentEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Data string `json:"data"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectTCPSocketChunkReceivedEvent represents Network.directTCPSocketChunkReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketChunkReceived
+*/
+type DirectTCPSocketChunkReceivedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Data string `json:"data"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketCreatedEvent represents Network.directUDPSocketCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketCreated
+*/
+type DirectUDPSocketCreatedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Options *DirectUDPSocketOptions `json:"options"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional.
+	Initiator *Initiator `json:"initiator,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketOpenedEvent represents Network.directUDPSocketOpened event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketOpened
+*/
+type DirectUDPSocketOpenedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	LocalAddr string `json:"localAddr"`
+
+	// Expected to be unsigned integer.
+	LocalPort int `json:"localPort"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Optional.
+	RemoteAddr string `json:"remoteAddr,omitempty"`
+
+	// Optional. Expected to be unsigned integer.
+	RemotePort int `json:"remotePort,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketAbortedEvent represents Network.directUDPSocketAborted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketAborted
+*/
+type DirectUDPSocketAbortedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	ErrorMessage string `json:"errorMessage"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketClosedEvent represents Network.directUDPSocketClosed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketClosed
+*/
+type DirectUDPSocketClosedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketChunkSentEvent represents Network.directUDPSocketChunkSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketChunkSent
+*/
+type DirectUDPSocketChunkSentEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Message *DirectUDPMessage `json:"message"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DirectUDPSocketChunkReceivedEvent represents Network.directUDPSocketChunkReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketChunkReceived
+*/
+type DirectUDPSocketChunkReceivedEvent struct {
+	Identifier RequestID `json:"identifier"`
+
+	Message *DirectUDPMessage `json:"message"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+RequestWillBeSentExtraInfoEvent represents Network.requestWillBeSentExtraInfo event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSentExtraInfo
+*/
+type RequestWillBeSentExtraInfoEvent struct {
+	// Request identifier. Used to match this information to an existing
+	// requestWillBeSent event.
+	RequestID RequestID `json:"requestId"`
+
+	// A list of cookies potentially associated to the requested URL. This includes
+	// both cookies sent with the request and the ones not sent; the latter are
+	// distinguished by having blockedReasons field set.
+	AssociatedCookies []*AssociatedCookie `json:"associatedCookies"`
+
+	// Raw request headers as they will be sent over the wire.
+	Headers Headers `json:"headers"`
+
+	// Connection timing information for the request. EXPERIMENTAL.
+	ConnectTiming *ConnectTiming `json:"connectTiming"`
+
+	// Optional. The client security state set for the request.
+	ClientSecurityState *ClientSecurityState `json:"clientSecurityState,omitempty"`
+
+	// Optional. Whether the site has partitioned cookies stored in a partition
+	// different than the current one.
+	SiteHasCookieInOtherPartition bool `json:"siteHasCookieInOtherPartition,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ResponseReceivedExtraInfoEvent represents Network.responseReceivedExtraInfo event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceivedExtraInfo
+*/
+type ResponseReceivedExtraInfoEvent struct {
+	// Request identifier. Used to match this information to another
+	// responseReceived event.
+	RequestID RequestID `json:"requestId"`
+
+	// A list of cookies which were not stored from the response along with the
+	// corresponding reasons for blocking. The cookies here may not be valid due to
+	// syntax errors, which are represented by the invalid cookie line string
+	// instead of a proper cookie.
+	BlockedCookies []*BlockedSetCookieWithReason `json:"blockedCookies"`
+
+	// Raw response headers as they were received over the wire. Duplicate headers
+	// in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator. See also `headersText` that
+	// contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+
+	// The IP address space of the resource. The address space can only be
+	// determined once the transport established the connection, so we can't send
+	// it in `requestWillBeSentExtraInfo`. Allowed values:
+	//   - IPAddressSpace.Loopback
+	//   - IPAddressSpace.Local
+	//   - IPAddressSpace.Public
+	//   - IPAddressSpace.Unknown
+	ResourceIPAddressSpace IPAddressSpaceEnum `json:"resourceIPAddressSpace"`
+
+	// The status code of the response. This is useful in cases the request failed
+	// and no responseReceived event is triggered, which is the case for, e.g.,
+	// CORS errors. This is also the correct status code for cached requests, where
+	// the status in responseReceived is a 200 and this will be 304.
+	StatusCode int `json:"statusCode"`
+
+	// Optional. Raw response header text as it was received over the wire. The raw
+	// text may not always be available, such as in the case of HTTP/2 or QUIC.
+	HeadersText string `json:"headersText,omitempty"`
+
+	// Optional. The cookie partition key that will be used to store partitioned
+	// cookies set in this response. Only sent when partitioned cookies are
+	// enabled. EXPERIMENTAL.
+	CookiePartitionKey *CookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+
+	// Optional. True if partitioned cookies are enabled, but the partition key is
+	// not serializable to string.
+	CookiePartitionKeyOpaque bool `json:"cookiePartitionKeyOpaque,omitempty"`
+
+	// Optional. A list of cookies which should have been blocked by 3PCD but are
+	// exempted and stored from the response with the corresponding reason.
+	ExemptedCookies []*ExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ResponseReceivedEarlyHintsEvent represents Network.responseReceivedEarlyHints event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceivedEarlyHints
+*/
+type ResponseReceivedEarlyHintsEvent struct {
+	// Request identifier. Used to match this information to another
+	// responseReceived event.
+	RequestID RequestID `json:"requestId"`
+
+	// Raw response headers as they were received over the wire. Duplicate headers
+	// in the response are represented as a single key with their values
+	// concatentated using `\n` as the separator. See also `headersText` that
+	// contains verbatim text for HTTP/1.*.
+	Headers Headers `json:"headers"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+TrustTokenOperationDoneEvent represents Network.trustTokenOperationDone event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-trustTokenOperationDone
+*/
+type TrustTokenOperationDoneEvent struct {
+	// Detailed success or error status of the operation. 'AlreadyExists' also
+	// signifies a successful operation, as the result of the operation already
+	// exists und thus, the operation was abort preemptively (e.g. a cache hit). Allowed values:
+	//   - Status.Ok
+	//   - Status.InvalidArgument
+	//   - Status.MissingIssuerKeys
+	//   - Status.FailedPrecondition
+	//   - Status.ResourceExhausted
+	//   - Status.AlreadyExists
+	//   - Status.ResourceLimited
+	//   - Status.Unauthorized
+	//   - Status.BadResponse
+	//   - Status.InternalError
+	//   - Status.UnknownError
+	//   - Status.FulfilledLocally
+	//   - Status.SiteIssuerLimit
+	Status StatusEnum `json:"status"`
+
+	// Allowed values:
+	//   - TrustTokenOperationType.Issuance
+	//   - TrustTokenOperationType.Redemption
+	//   - TrustTokenOperationType.Signing
+	Type TrustTokenOperationTypeEnum `json:"type"`
+
+	RequestID RequestID `json:"requestId"`
+
+	// Optional. Top level origin. The context in which the operation was
+	// attempted.
+	TopLevelOrigin string `json:"topLevelOrigin,omitempty"`
+
+	// Optional. Origin of the issuer in case of a "Issuance" or "Redemption"
+	// operation.
+	IssuerOrigin string `json:"issuerOrigin,omitempty"`
+
+	// Optional. The number of obtained Trust Tokens on a successful "Issuance"
+	// operation.
+	IssuedTokenCount int `json:"issuedTokenCount,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PolicyUpdatedEvent represents Network.policyUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-policyUpdated
+*/
+type PolicyUpdatedEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SubresourceWebBundleMetadataReceivedEvent represents Network.subresourceWebBundleMetadataReceived event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleMetadataReceived
+*/
+type SubresourceWebBundleMetadataReceivedEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+
+	// A list of URLs of resources in the subresource Web Bundle.
+	URLs []string `json:"urls"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SubresourceWebBundleMetadataErrorEvent represents Network.subresourceWebBundleMetadataError event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleMetadataError
+*/
+type SubresourceWebBundleMetadataErrorEvent struct {
+	// Request identifier. Used to match this information to another event.
+	RequestID RequestID `json:"requestId"`
+
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SubresourceWebBundleInnerResponseParsedEvent represents Network.subresourceWebBundleInnerResponseParsed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleInnerResponseParsed
+*/
+type SubresourceWebBundleInnerResponseParsedEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+
+	// Optional. Bundle request identifier. Used to match this information to
+	// another event. This made be absent in case when the instrumentation was
+	// enabled only after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SubresourceWebBundleInnerResponseErrorEvent represents Network.subresourceWebBundleInnerResponseError event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleInnerResponseError
+*/
+type SubresourceWebBundleInnerResponseErrorEvent struct {
+	// Request identifier of the subresource request
+	InnerRequestID RequestID `json:"innerRequestId"`
+
+	// URL of the subresource resource.
+	InnerRequestURL string `json:"innerRequestURL"`
+
+	// Error message
+	ErrorMessage string `json:"errorMessage"`
+
+	// Optional. Bundle request identifier. Used to match this information to
+	// another event. This made be absent in case when the instrumentation was
+	// enabled only after webbundle was parsed.
+	BundleRequestID RequestID `json:"bundleRequestId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ReportingAPIReportAddedEvent represents Network.reportingApiReportAdded event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiReportAdded
+*/
+type ReportingAPIReportAddedEvent struct {
+	Report *ReportingAPIReport `json:"report"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ReportingAPIReportUpdatedEvent represents Network.reportingApiReportUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiReportUpdated
+*/
+type ReportingAPIReportUpdatedEvent struct {
+	Report *ReportingAPIReport `json:"report"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ReportingAPIEndpointsChangedForOriginEvent represents Network.reportingApiEndpointsChangedForOrigin event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiEndpointsChangedForOrigin
+*/
+type ReportingAPIEndpointsChangedForOriginEvent struct {
+	// Origin of the document(s) which configured the endpoints.
+	Origin string `json:"origin"`
+
+	Endpoints []*ReportingAPIEndpoint `json:"endpoints"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,6 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package overlay provides type definitions for use with the Chrome Overlay protocol
 
+This domain provides various functionality related to drawing atop the inspected
+page. EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/
 */
 package overlay
@@ -10,7 +15,183 @@ import (
 )
 
 /*
-HighlightConfig is configuration data for the highlighting of page elements.
+SourceOrderConfig represents the Overlay.SourceOrderConfig type.
+
+Configuration data for drawing the source order of an elements children.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-SourceOrderConfig
+*/
+type SourceOrderConfig struct {
+	// the color to outline the given element in.
+	ParentOutlineColor *dom.RGBA `json:"parentOutlineColor"`
+
+	// the color to outline the child elements in.
+	ChildOutlineColor *dom.RGBA `json:"childOutlineColor"`
+}
+
+/*
+GridHighlightConfig represents the Overlay.GridHighlightConfig type.
+
+Configuration data for the highlighting of Grid elements.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-GridHighlightConfig
+*/
+type GridHighlightConfig struct {
+	// Optional. Whether the extension lines from grid cells to the rulers should
+	// be shown (default: false).
+	ShowGridExtensionLines bool `json:"showGridExtensionLines,omitempty"`
+
+	// Optional. Show Positive line number labels (default: false).
+	ShowPositiveLineNumbers bool `json:"showPositiveLineNumbers,omitempty"`
+
+	// Optional. Show Negative line number labels (default: false).
+	ShowNegativeLineNumbers bool `json:"showNegativeLineNumbers,omitempty"`
+
+	// Optional. Show area name labels (default: false).
+	ShowAreaNames bool `json:"showAreaNames,omitempty"`
+
+	// Optional. Show line name labels (default: false).
+	ShowLineNames bool `json:"showLineNames,omitempty"`
+
+	// Optional. Show track size labels (default: false).
+	ShowTrackSizes bool `json:"showTrackSizes,omitempty"`
+
+	// Optional. The grid container border highlight color (default: transparent).
+	GridBorderColor *dom.RGBA `json:"gridBorderColor,omitempty"`
+
+	// Optional. The cell border color (default: transparent). Deprecated, please
+	// use rowLineColor and columnLineColor instead. DEPRECATED.
+	CellBorderColor *dom.RGBA `json:"cellBorderColor,omitempty"`
+
+	// Optional. The row line color (default: transparent).
+	RowLineColor *dom.RGBA `json:"rowLineColor,omitempty"`
+
+	// Optional. The column line color (default: transparent).
+	ColumnLineColor *dom.RGBA `json:"columnLineColor,omitempty"`
+
+	// Optional. Whether the grid border is dashed (default: false).
+	GridBorderDash bool `json:"gridBorderDash,omitempty"`
+
+	// Optional. Whether the cell border is dashed (default: false). Deprecated,
+	// please us rowLineDash and columnLineDash instead. DEPRECATED.
+	CellBorderDash bool `json:"cellBorderDash,omitempty"`
+
+	// Optional. Whether row lines are dashed (default: false).
+	RowLineDash bool `json:"rowLineDash,omitempty"`
+
+	// Optional. Whether column lines are dashed (default: false).
+	ColumnLineDash bool `json:"columnLineDash,omitempty"`
+
+	// Optional. The row gap highlight fill color (default: transparent).
+	RowGapColor *dom.RGBA `json:"rowGapColor,omitempty"`
+
+	// Optional. The row gap hatching fill color (default: transparent).
+	RowHatchColor *dom.RGBA `json:"rowHatchColor,omitempty"`
+
+	// Optional. The column gap highlight fill color (default: transparent).
+	ColumnGapColor *dom.RGBA `json:"columnGapColor,omitempty"`
+
+	// Optional. The column gap hatching fill color (default: transparent).
+	ColumnHatchColor *dom.RGBA `json:"columnHatchColor,omitempty"`
+
+	// Optional. The named grid areas border color (Default: transparent).
+	AreaBorderColor *dom.RGBA `json:"areaBorderColor,omitempty"`
+
+	// Optional. The grid container background color (Default: transparent).
+	GridBackgroundColor *dom.RGBA `json:"gridBackgroundColor,omitempty"`
+}
+
+/*
+FlexContainerHighlightConfig represents the Overlay.FlexContainerHighlightConfig
+type.
+
+Configuration data for the highlighting of Flex container elements.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-FlexContainerHighlightConfig
+*/
+type FlexContainerHighlightConfig struct {
+	// Optional. The style of the container border
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+
+	// Optional. The style of the separator between lines
+	LineSeparator *LineStyle `json:"lineSeparator,omitempty"`
+
+	// Optional. The style of the separator between items
+	ItemSeparator *LineStyle `json:"itemSeparator,omitempty"`
+
+	// Optional. Style of content-distribution space on the main axis
+	// (justify-content).
+	MainDistributedSpace *BoxStyle `json:"mainDistributedSpace,omitempty"`
+
+	// Optional. Style of content-distribution space on the cross axis
+	// (align-content).
+	CrossDistributedSpace *BoxStyle `json:"crossDistributedSpace,omitempty"`
+
+	// Optional. Style of empty space caused by row gaps (gap/row-gap).
+	RowGapSpace *BoxStyle `json:"rowGapSpace,omitempty"`
+
+	// Optional. Style of empty space caused by columns gaps (gap/column-gap).
+	ColumnGapSpace *BoxStyle `json:"columnGapSpace,omitempty"`
+
+	// Optional. Style of the self-alignment line (align-items).
+	CrossAlignment *LineStyle `json:"crossAlignment,omitempty"`
+}
+
+/*
+FlexItemHighlightConfig represents the Overlay.FlexItemHighlightConfig type.
+
+Configuration data for the highlighting of Flex item elements.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-FlexItemHighlightConfig
+*/
+type FlexItemHighlightConfig struct {
+	// Optional. Style of the box representing the item's base size
+	BaseSizeBox *BoxStyle `json:"baseSizeBox,omitempty"`
+
+	// Optional. Style of the border around the box representing the item's base
+	// size
+	BaseSizeBorder *LineStyle `json:"baseSizeBorder,omitempty"`
+
+	// Optional. Style of the arrow representing if the item grew or shrank
+	FlexibilityArrow *LineStyle `json:"flexibilityArrow,omitempty"`
+}
+
+/*
+LineStyle represents the Overlay.LineStyle type.
+
+Style information for drawing a line.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-LineStyle
+*/
+type LineStyle struct {
+	// Optional. The color of the line (default: transparent)
+	Color *dom.RGBA `json:"color,omitempty"`
+
+	// Optional. The line pattern (default: solid) Allowed values:
+	//   - Pattern.Dashed
+	//   - Pattern.Dotted
+	Pattern PatternEnum `json:"pattern,omitempty"`
+}
+
+/*
+BoxStyle represents the Overlay.BoxStyle type.
+
+Style information for drawing a box.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-BoxStyle
+*/
+type BoxStyle struct {
+	// Optional. The background color for the box (default: transparent)
+	FillColor *dom.RGBA `json:"fillColor,omitempty"`
+
+	// Optional. The hatching color for the box (default: transparent)
+	HatchColor *dom.RGBA `json:"hatchColor,omitempty"`
+}
+
+/*
+HighlightConfig represents the Overlay.HighlightConfig type.
+
+Configuration data for the highlighting of page elements.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-HighlightConfig
 */
@@ -18,16 +199,19 @@ type HighlightConfig struct {
 	// Optional. Whether the node info tooltip should be shown (default: false).
 	ShowInfo bool `json:"showInfo,omitempty"`
 
+	// Optional. Whether the node styles in the tooltip (default: false).
+	ShowStyles bool `json:"showStyles,omitempty"`
+
 	// Optional. Whether the rulers should be shown (default: false).
 	ShowRulers bool `json:"showRulers,omitempty"`
 
+	// Optional. Whether the a11y info should be shown (default: true).
+	ShowAccessibilityInfo bool `json:"showAccessibilityInfo,omitempty"`
+
 	// Optional. Whether the extension lines from node to the rulers should be
 	// shown (default: false).
 	ShowExtensionLines bool `json:"showExtensionLines,omitempty"`
 
-	// Optional. Display as material.
-	DisplayAsMaterial bool `json:"displayAsMaterial,omitempty"`
-
 	// Optional. The content box highlight fill color (default: transparent).
 	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
 
@@ -50,9 +234,200 @@ type HighlightConfig struct {
 	// Optional. The shape margin fill color (default: transparent).
 	ShapeMarginColor *dom.RGBA `json:"shapeMarginColor,omitempty"`
 
-	// Optional. Selectors to highlight relevant nodes.
-	SelectorList string `json:"selectorList,omitempty"`
-
 	// Optional. The grid layout color (default: transparent).
 	CSSGridColor *dom.RGBA `json:"cssGridColor,omitempty"`
+
+	// Optional. The color format used to format color styles (default: hex). Allowed values:
+	//   - ColorFormat.Rgb
+	//   - ColorFormat.Hsl
+	//   - ColorFormat.Hwb
+	//   - ColorFormat.Hex
+	ColorFormat ColorFormatEnum `json:"colorFormat,omitempty"`
+
+	// Optional. The grid layout highlight configuration (default: all
+	// transparent).
+	GridHighlightConfig *GridHighlightConfig `json:"gridHighlightConfig,omitempty"`
+
+	// Optional. The flex container highlight configuration (default: all
+	// transparent).
+	FlexContainerHighlightConfig *FlexContainerHighlightConfig `json:"flexContainerHighlightConfig,omitempty"`
+
+	// Optional. The flex item highlight configuration (default: all transparent).
+	FlexItemHighlightConfig *FlexItemHighlightConfig `json:"flexItemHighlightConfig,omitempty"`
+
+	// Optional. The contrast algorithm to use for the contrast ratio (default:
+	// aa). Allowed values:
+	//   - ContrastAlgorithm.Aa
+	//   - ContrastAlgorithm.Aaa
+	//   - ContrastAlgorithm.Apca
+	ContrastAlgorithm ContrastAlgorithmEnum `json:"contrastAlgorithm,omitempty"`
+
+	// Optional. The container query container highlight configuration (default:
+	// all transparent).
+	ContainerQueryContainerHighlightConfig *ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig,omitempty"`
+
+	// Optional. Display as material. DEPRECATED.
+	DisplayAsMaterial bool `json:"displayAsMaterial,omitempty"`
+
+	// Optional. Selectors to highlight relevant nodes. DEPRECATED.
+	SelectorList string `json:"selectorList,omitempty"`
+}
+
+/*
+GridNodeHighlightConfig represents the Overlay.GridNodeHighlightConfig type.
+
+# Configurations for Persistent Grid Highlight
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-GridNodeHighlightConfig
+*/
+type GridNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance.
+	GridHighlightConfig *GridHighlightConfig `json:"gridHighlightConfig"`
+
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+FlexNodeHighlightConfig represents the Overlay.FlexNodeHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-FlexNodeHighlightConfig
+*/
+type FlexNodeHighlightConfig struct {
+	// A descriptor for the highlight appearance of flex containers.
+	FlexContainerHighlightConfig *FlexContainerHighlightConfig `json:"flexContainerHighlightConfig"`
+
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+ScrollSnapContainerHighlightConfig represents the
+Overlay.ScrollSnapContainerHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ScrollSnapContainerHighlightConfig
+*/
+type ScrollSnapContainerHighlightConfig struct {
+	// Optional. The style of the snapport border (default: transparent)
+	SnapportBorder *LineStyle `json:"snapportBorder,omitempty"`
+
+	// Optional. The style of the snap area border (default: transparent)
+	SnapAreaBorder *LineStyle `json:"snapAreaBorder,omitempty"`
+
+	// Optional. The margin highlight fill color (default: transparent).
+	ScrollMarginColor *dom.RGBA `json:"scrollMarginColor,omitempty"`
+
+	// Optional. The padding highlight fill color (default: transparent).
+	ScrollPaddingColor *dom.RGBA `json:"scrollPaddingColor,omitempty"`
+}
+
+/*
+ScrollSnapHighlightConfig represents the Overlay.ScrollSnapHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ScrollSnapHighlightConfig
+*/
+type ScrollSnapHighlightConfig struct {
+	// A descriptor for the highlight appearance of scroll snap containers.
+	ScrollSnapContainerHighlightConfig *ScrollSnapContainerHighlightConfig `json:"scrollSnapContainerHighlightConfig"`
+
+	// Identifier of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+HingeConfig represents the Overlay.HingeConfig type.
+
+# Configuration for dual screen hinge
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-HingeConfig
+*/
+type HingeConfig struct {
+	// A rectangle represent hinge
+	Rect *dom.Rect `json:"rect"`
+
+	// Optional. The content box highlight fill color (default: a dark color).
+	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
+
+	// Optional. The content box highlight outline color (default: transparent).
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+}
+
+/*
+WindowControlsOverlayConfig represents the Overlay.WindowControlsOverlayConfig
+type.
+
+# Configuration for Window Controls Overlay
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-WindowControlsOverlayConfig
+*/
+type WindowControlsOverlayConfig struct {
+	// Whether the title bar CSS should be shown when emulating the Window Controls
+	// Overlay.
+	ShowCSS bool `json:"showCSS"`
+
+	// Selected platforms to show the overlay.
+	SelectedPlatform string `json:"selectedPlatform"`
+
+	// The theme color defined in app manifest.
+	ThemeColor string `json:"themeColor"`
+}
+
+/*
+ContainerQueryHighlightConfig represents the
+Overlay.ContainerQueryHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ContainerQueryHighlightConfig
+*/
+type ContainerQueryHighlightConfig struct {
+	// A descriptor for the highlight appearance of container query containers.
+	ContainerQueryContainerHighlightConfig *ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig"`
+
+	// Identifier of the container node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+ContainerQueryContainerHighlightConfig represents the
+Overlay.ContainerQueryContainerHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ContainerQueryContainerHighlightConfig
+*/
+type ContainerQueryContainerHighlightConfig struct {
+	// Optional. The style of the container border.
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+
+	// Optional. The style of the descendants' borders.
+	DescendantBorder *LineStyle `json:"descendantBorder,omitempty"`
+}
+
+/*
+IsolatedElementHighlightConfig represents the
+Overlay.IsolatedElementHighlightConfig type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-IsolatedElementHighlightConfig
+*/
+type IsolatedElementHighlightConfig struct {
+	// A descriptor for the highlight appearance of an element in isolation mode.
+	IsolationModeHighlightConfig *IsolationModeHighlightConfig `json:"isolationModeHighlightConfig"`
+
+	// Identifier of the isolated element to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+IsolationModeHighlightConfig represents the Overlay.IsolationModeHighlightConfig
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-IsolationModeHighlightConfig
+*/
+type IsolationModeHighlightConfig struct {
+	// Optional. The fill color of the resizers (default: transparent).
+	ResizerColor *dom.RGBA `json:"resizerColor,omitempty"`
+
+	// Optional. The fill color for resizer handles (default: transparent).
+	ResizerHandleColor *dom.RGBA `json:"resizerHandleColor,omitempty"`
+
+	// Optional. The fill color for the mask covering non-isolated elements
+	// (default: transparent).
+	MaskColor *dom.RGBA `json:"maskColor,omitempty"`
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package overlay
 
 import (
@@ -32,8 +34,24 @@ GetHighlightObjectForTestParams represents Overlay.getHighlightObjectForTest par
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighlightObjectForTest
 */
 type GetHighlightObjectForTestParams struct {
-	// ID of the node to get highlight object for.
+	// Id of the node to get highlight object for.
 	NodeID dom.NodeID `json:"nodeId"`
+
+	// Optional. Whether to include distance info.
+	IncludeDistance *bool `json:"includeDistance,omitempty"`
+
+	// Optional. Whether to include style info.
+	IncludeStyle *bool `json:"includeStyle,omitempty"`
+
+	// Optional. The color format to get config with (default: hex). Allowed values:
+	//   - ColorFormat.Rgb
+	//   - ColorFormat.Hsl
+	//   - ColorFormat.Hwb
+	//   - ColorFormat.Hex
+	ColorFormat ColorFormatEnum `json:"colorFormat,omitempty"`
+
+	// Optional. Whether to show accessibility info (default: true).
+	ShowAccessibilityInfo *bool `json:"showAccessibilityInfo,omitempty"`
 }
 
 /*
@@ -43,7 +61,53 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighli
 */
 type GetHighlightObjectForTestResult struct {
 	// Highlight data for the node.
-	Highlight map[string]string `json:"highlight"`
+	Highlight map[string]interface{} `json:"highlight"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetGridHighlightObjectsForTestParams represents Overlay.getGridHighlightObjectsForTest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getGridHighlightObjectsForTest
+*/
+type GetGridHighlightObjectsForTestParams struct {
+	// Ids of the node to get highlight object for.
+	NodeIDs []dom.NodeID `json:"nodeIds"`
+}
+
+/*
+GetGridHighlightObjectsForTestResult represents the result of calls to Overlay.getGridHighlightObjectsForTest.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getGridHighlightObjectsForTest
+*/
+type GetGridHighlightObjectsForTestResult struct {
+	// Grid Highlight data for the node ids provided.
+	Highlights map[string]interface{} `json:"highlights"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetSourceOrderHighlightObjectForTestParams represents Overlay.getSourceOrderHighlightObjectForTest parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getSourceOrderHighlightObjectForTest
+*/
+type GetSourceOrderHighlightObjectForTestParams struct {
+	// Id of the node to highlight.
+	NodeID dom.NodeID `json:"nodeId"`
+}
+
+/*
+GetSourceOrderHighlightObjectForTestResult represents the result of calls to Overlay.getSourceOrderHighlightObjectForTest.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getSourceOrderHighlightObjectForTest
+*/
+type GetSourceOrderHighlightObjectForTestResult struct {
+	// Source order highlight data for the node id provided.
+	Highlight map[string]interface{} `json:"highlight"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -95,13 +159,16 @@ type HighlightNodeParams struct {
 	HighlightConfig *HighlightConfig `json:"highlightConfig"`
 
 	// Optional. Identifier of the node to highlight.
-	NodeID dom.NodeID `json:"nodeId,omitempty"`
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
 
 	// Optional. Identifier of the backend node to highlight.
-	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node to be highlighted.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
 
-	// Optional. JavaScript object ID of the node to be highlighted.
-	ObjectID runtime.RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. Selectors to highlight relevant nodes.
+	Selector *string `json:"selector,omitempty"`
 }
 
 /*
@@ -120,7 +187,7 @@ HighlightQuadParams represents Overlay.highlightQuad parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightQuad
 */
 type HighlightQuadParams struct {
-	// Quad to highlight.
+	// Quad to highlight
 	Quad dom.Quad `json:"quad"`
 
 	// Optional. The highlight fill color (default: transparent).
@@ -146,16 +213,16 @@ HighlightRectParams represents Overlay.highlightRect parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightRect
 */
 type HighlightRectParams struct {
-	// X coordinate.
+	// X coordinate
 	X int `json:"x"`
 
-	// Y coordinate.
+	// Y coordinate
 	Y int `json:"y"`
 
-	// Rectangle width.
+	// Rectangle width
 	Width int `json:"width"`
 
-	// Rectangle height.
+	// Rectangle height
 	Height int `json:"height"`
 
 	// Optional. The highlight fill color (default: transparent).
@@ -175,6 +242,35 @@ type HighlightRectResult struct {
 	Err error `json:"-"`
 }
 
+/*
+HighlightSourceOrderParams represents Overlay.highlightSourceOrder parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightSourceOrder
+*/
+type HighlightSourceOrderParams struct {
+	// A descriptor for the appearance of the overlay drawing.
+	SourceOrderConfig *SourceOrderConfig `json:"sourceOrderConfig"`
+
+	// Optional. Identifier of the node to highlight.
+	NodeID *dom.NodeID `json:"nodeId,omitempty"`
+
+	// Optional. Identifier of the backend node to highlight.
+	BackendNodeID *dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional. JavaScript object id of the node to be highlighted.
+	ObjectID *runtime.RemoteObjectID `json:"objectId,omitempty"`
+}
+
+/*
+HighlightSourceOrderResult represents the result of calls to Overlay.highlightSourceOrder.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightSourceOrder
+*/
+type HighlightSourceOrderResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetInspectModeParams represents Overlay.setInspectMode parameters.
 
@@ -182,9 +278,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setInspec
 */
 type SetInspectModeParams struct {
 	// Set an inspection mode. Allowed values:
-	//	- InspectMode.SearchForNode
-	//	- InspectMode.SearchForUAShadowDOM
-	//	- InspectMode.None
+	//   - InspectMode.SearchForNode
+	//   - InspectMode.SearchForUAShadowDOM
+	//   - InspectMode.CaptureAreaScreenshot
+	//   - InspectMode.None
 	Mode InspectModeEnum `json:"mode"`
 
 	// Optional. A descriptor for the highlight appearance of hovered-over nodes.
@@ -203,20 +300,38 @@ type SetInspectModeResult struct {
 }
 
 /*
-SetPausedInDebuggerMessageParams represents Overlay.setPausedInDebuggerMessage
-parameters.
+SetShowAdHighlightsParams represents Overlay.setShowAdHighlights parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowAdHighlights
+*/
+type SetShowAdHighlightsParams struct {
+	// True for showing ad highlights
+	Show bool `json:"show"`
+}
+
+/*
+SetShowAdHighlightsResult represents the result of calls to Overlay.setShowAdHighlights.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowAdHighlights
+*/
+type SetShowAdHighlightsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPausedInDebuggerMessageParams represents Overlay.setPausedInDebuggerMessage parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPausedInDebuggerMessage
 */
 type SetPausedInDebuggerMessageParams struct {
 	// Optional. The message to display, also triggers resume and step over
 	// controls.
-	Message string `json:"message,omitempty"`
+	Message *string `json:"message,omitempty"`
 }
 
 /*
-SetPausedInDebuggerMessageResult represents the result of calls to
-Overlay.setPausedInDebuggerMessage.
+SetPausedInDebuggerMessageResult represents the result of calls to Overlay.setPausedInDebuggerMessage.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPausedInDebuggerMessage
 */
@@ -231,7 +346,7 @@ SetShowDebugBordersParams represents Overlay.setShowDebugBorders parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowDebugBorders
 */
 type SetShowDebugBordersParams struct {
-	// True for showing debug borders.
+	// True for showing debug borders
 	Show bool `json:"show"`
 }
 
@@ -251,7 +366,7 @@ SetShowFPSCounterParams represents Overlay.setShowFPSCounter parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFPSCounter
 */
 type SetShowFPSCounterParams struct {
-	// True for showing paint rectangles.
+	// True for showing the FPS counter
 	Show bool `json:"show"`
 }
 
@@ -265,13 +380,93 @@ type SetShowFPSCounterResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetShowGridOverlaysParams represents Overlay.setShowGridOverlays parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowGridOverlays
+*/
+type SetShowGridOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	GridNodeHighlightConfigs []*GridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+/*
+SetShowGridOverlaysResult represents the result of calls to Overlay.setShowGridOverlays.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowGridOverlays
+*/
+type SetShowGridOverlaysResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowFlexOverlaysParams represents Overlay.setShowFlexOverlays parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFlexOverlays
+*/
+type SetShowFlexOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	FlexNodeHighlightConfigs []*FlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+/*
+SetShowFlexOverlaysResult represents the result of calls to Overlay.setShowFlexOverlays.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFlexOverlays
+*/
+type SetShowFlexOverlaysResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowScrollSnapOverlaysParams represents Overlay.setShowScrollSnapOverlays parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollSnapOverlays
+*/
+type SetShowScrollSnapOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ScrollSnapHighlightConfigs []*ScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+/*
+SetShowScrollSnapOverlaysResult represents the result of calls to Overlay.setShowScrollSnapOverlays.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollSnapOverlays
+*/
+type SetShowScrollSnapOverlaysResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowContainerQueryOverlaysParams represents Overlay.setShowContainerQueryOverlays parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowContainerQueryOverlays
+*/
+type SetShowContainerQueryOverlaysParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	ContainerQueryHighlightConfigs []*ContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+/*
+SetShowContainerQueryOverlaysResult represents the result of calls to Overlay.setShowContainerQueryOverlays.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowContainerQueryOverlays
+*/
+type SetShowContainerQueryOverlaysResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetShowPaintRectsParams represents Overlay.setShowPaintRects parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowPaintRects
 */
 type SetShowPaintRectsParams struct {
-	// True for showing paint rectangles.
+	// True for showing paint rectangles
 	Result bool `json:"result"`
 }
 
@@ -285,27 +480,85 @@ type SetShowPaintRectsResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetShowLayoutShiftRegionsParams represents Overlay.setShowLayoutShiftRegions parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowLayoutShiftRegions
+*/
+type SetShowLayoutShiftRegionsParams struct {
+	// True for showing layout shift regions
+	Result bool `json:"result"`
+}
+
+/*
+SetShowLayoutShiftRegionsResult represents the result of calls to Overlay.setShowLayoutShiftRegions.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowLayoutShiftRegions
+*/
+type SetShowLayoutShiftRegionsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetShowScrollBottleneckRectsParams represents Overlay.setShowScrollBottleneckRects parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollBottleneckRects
 */
 type SetShowScrollBottleneckRectsParams struct {
-	// True for showing scroll bottleneck rects.
+	// True for showing scroll bottleneck rects
 	Show bool `json:"show"`
 }
 
 /*
-SetShowScrollBottleneckRectsResult represents the result of calls to
-Overlay.SetShowScrollBottleneckRects.
+SetShowScrollBottleneckRectsResult represents the result of calls to Overlay.setShowScrollBottleneckRects.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-SetShowScrollBottleneckRects
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollBottleneckRects
 */
 type SetShowScrollBottleneckRectsResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+SetShowHitTestBordersParams represents Overlay.setShowHitTestBorders parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHitTestBorders
+*/
+type SetShowHitTestBordersParams struct {
+	// True for showing hit-test borders
+	Show bool `json:"show"`
+}
+
+/*
+SetShowHitTestBordersResult represents the result of calls to Overlay.setShowHitTestBorders.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHitTestBorders
+*/
+type SetShowHitTestBordersResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowWebVitalsParams represents Overlay.setShowWebVitals parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWebVitals
+*/
+type SetShowWebVitalsParams struct {
+	Show bool `json:"show"`
+}
+
+/*
+SetShowWebVitalsResult represents the result of calls to Overlay.setShowWebVitals.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWebVitals
+*/
+type SetShowWebVitalsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetShowViewportSizeOnResizeParams represents Overlay.setShowViewportSizeOnResize parameters.
 
@@ -317,16 +570,76 @@ type SetShowViewportSizeOnResizeParams struct {
 }
 
 /*
-SetShowViewportSizeOnResizeResult represents the result of calls to
-Overlay.SetShowViewportSizeOnResize.
+SetShowViewportSizeOnResizeResult represents the result of calls to Overlay.setShowViewportSizeOnResize.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-SetShowViewportSizeOnResize
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowViewportSizeOnResize
 */
 type SetShowViewportSizeOnResizeResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+SetShowHingeParams represents Overlay.setShowHinge parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHinge
+*/
+type SetShowHingeParams struct {
+	// Optional. hinge data, null means hideHinge
+	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"`
+}
+
+/*
+SetShowHingeResult represents the result of calls to Overlay.setShowHinge.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHinge
+*/
+type SetShowHingeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowIsolatedElementsParams represents Overlay.setShowIsolatedElements parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowIsolatedElements
+*/
+type SetShowIsolatedElementsParams struct {
+	// An array of node identifiers and descriptors for the highlight appearance.
+	IsolatedElementHighlightConfigs []*IsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+/*
+SetShowIsolatedElementsResult represents the result of calls to Overlay.setShowIsolatedElements.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowIsolatedElements
+*/
+type SetShowIsolatedElementsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetShowWindowControlsOverlayParams represents Overlay.setShowWindowControlsOverlay parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWindowControlsOverlay
+*/
+type SetShowWindowControlsOverlayParams struct {
+	// Optional. Window Controls Overlay data, null means hide Window Controls
+	// Overlay
+	WindowControlsOverlayConfig *WindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+/*
+SetShowWindowControlsOverlayResult represents the result of calls to Overlay.setShowWindowControlsOverlay.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWindowControlsOverlay
+*/
+type SetShowWindowControlsOverlayResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetSuspendedParams represents Overlay.setSuspended parameters.
 
@@ -0,0 +1,94 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type colorFormatEnum struct {
+	Rgb ColorFormatEnum
+	Hsl ColorFormatEnum
+	Hwb ColorFormatEnum
+	Hex ColorFormatEnum
+}
+
+/*
+ColorFormat provides named access to the ColorFormatEnum values.
+*/
+var ColorFormat = colorFormatEnum{
+	Rgb: colorFormatRgb,
+	Hsl: colorFormatHsl,
+	Hwb: colorFormatHwb,
+	Hex: colorFormatHex,
+}
+
+/*
+ColorFormatEnum represents the Overlay.ColorFormat values.
+
+Allowed values:
+  - ColorFormat.Rgb "rgb"
+  - ColorFormat.Hsl "hsl"
+  - ColorFormat.Hwb "hwb"
+  - ColorFormat.Hex "hex"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ColorFormat
+*/
+type ColorFormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ColorFormatEnum) String() string {
+	return _colorFormatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ColorFormatEnum) Unknown() bool {
+	return !_colorFormatEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ColorFormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ColorFormatEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _colorFormatEnums.Parse(val)
+	return nil
+}
+
+const (
+	// colorFormatRgb represents the "rgb" value.
+	colorFormatRgb ColorFormatEnum = iota + 1
+	// colorFormatHsl represents the "hsl" value.
+	colorFormatHsl
+	// colorFormatHwb represents the "hwb" value.
+	colorFormatHwb
+	// colorFormatHex represents the "hex" value.
+	colorFormatHex
+)
+
+var _colorFormatEnums = enums.New(map[ColorFormatEnum]string{
+	ColorFormatEnum(0): "",
+	colorFormatRgb:     "rgb",
+	colorFormatHsl:     "hsl",
+	colorFormatHwb:     "hwb",
+	colorFormatHex:     "hex",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumColorFormat(t *testing.T) {
+	var enum ColorFormatEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ColorFormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ColorFormat.Rgb
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"rgb"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"rgb"`, result)
+	}
+	json.Unmarshal([]byte(`"rgb"`), &enum)
+	if ColorFormat.Rgb != enum {
+		t.Errorf("Expected %d, got %d", ColorFormat.Rgb, enum)
+	}
+
+	enum = ColorFormat.Hsl
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"hsl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"hsl"`, result)
+	}
+	json.Unmarshal([]byte(`"hsl"`), &enum)
+	if ColorFormat.Hsl != enum {
+		t.Errorf("Expected %d, got %d", ColorFormat.Hsl, enum)
+	}
+
+	enum = ColorFormat.Hwb
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"hwb"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"hwb"`, result)
+	}
+	json.Unmarshal([]byte(`"hwb"`), &enum)
+	if ColorFormat.Hwb != enum {
+		t.Errorf("Expected %d, got %d", ColorFormat.Hwb, enum)
+	}
+
+	enum = ColorFormat.Hex
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"hex"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"hex"`, result)
+	}
+	json.Unmarshal([]byte(`"hex"`), &enum)
+	if ColorFormat.Hex != enum {
+		t.Errorf("Expected %d, got %d", ColorFormat.Hex, enum)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contrastAlgorithmEnum struct {
+	Aa   ContrastAlgorithmEnum
+	Aaa  ContrastAlgorithmEnum
+	Apca ContrastAlgorithmEnum
+}
+
+/*
+ContrastAlgorithm provides named access to the ContrastAlgorithmEnum values.
+*/
+var ContrastAlgorithm = contrastAlgorithmEnum{
+	Aa:   contrastAlgorithmAa,
+	Aaa:  contrastAlgorithmAaa,
+	Apca: contrastAlgorithmApca,
+}
+
+/*
+ContrastAlgorithmEnum represents the Overlay.ContrastAlgorithm values.
+
+Allowed values:
+  - ContrastAlgorithm.Aa   "aa"
+  - ContrastAlgorithm.Aaa  "aaa"
+  - ContrastAlgorithm.Apca "apca"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-ContrastAlgorithm
+*/
+type ContrastAlgorithmEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContrastAlgorithmEnum) String() string {
+	return _contrastAlgorithmEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContrastAlgorithmEnum) Unknown() bool {
+	return !_contrastAlgorithmEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContrastAlgorithmEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContrastAlgorithmEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contrastAlgorithmEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contrastAlgorithmAa represents the "aa" value.
+	contrastAlgorithmAa ContrastAlgorithmEnum = iota + 1
+	// contrastAlgorithmAaa represents the "aaa" value.
+	contrastAlgorithmAaa
+	// contrastAlgorithmApca represents the "apca" value.
+	contrastAlgorithmApca
+)
+
+var _contrastAlgorithmEnums = enums.New(map[ContrastAlgorithmEnum]string{
+	ContrastAlgorithmEnum(0): "",
+	contrastAlgorithmAa:      "aa",
+	contrastAlgorithmAaa:     "aaa",
+	contrastAlgorithmApca:    "apca",
+})
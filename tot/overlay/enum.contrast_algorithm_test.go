@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumContrastAlgorithm(t *testing.T) {
+	var enum ContrastAlgorithmEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ContrastAlgorithmEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ContrastAlgorithm.Aa
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"aa"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"aa"`, result)
+	}
+	json.Unmarshal([]byte(`"aa"`), &enum)
+	if ContrastAlgorithm.Aa != enum {
+		t.Errorf("Expected %d, got %d", ContrastAlgorithm.Aa, enum)
+	}
+
+	enum = ContrastAlgorithm.Aaa
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"aaa"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"aaa"`, result)
+	}
+	json.Unmarshal([]byte(`"aaa"`), &enum)
+	if ContrastAlgorithm.Aaa != enum {
+		t.Errorf("Expected %d, got %d", ContrastAlgorithm.Aaa, enum)
+	}
+
+	enum = ContrastAlgorithm.Apca
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"apca"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"apca"`, result)
+	}
+	json.Unmarshal([]byte(`"apca"`), &enum)
+	if ContrastAlgorithm.Apca != enum {
+		t.Errorf("Expected %d, got %d", ContrastAlgorithm.Apca, enum)
+	}
+}
@@ -1,30 +1,38 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package overlay
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type inspectModeEnum struct {
-	SearchForNode        InspectModeEnum
-	SearchForUAShadowDOM InspectModeEnum
-	None                 InspectModeEnum
+	SearchForNode         InspectModeEnum
+	SearchForUAShadowDOM  InspectModeEnum
+	CaptureAreaScreenshot InspectModeEnum
+	None                  InspectModeEnum
 }
 
 /*
-InspectMode provides named acces to the InspectModeEnum values.
+InspectMode provides named access to the InspectModeEnum values.
 */
 var InspectMode = inspectModeEnum{
-	SearchForNode:        inspectModeSearchForNode,
-	SearchForUAShadowDOM: inspectModeSearchForUAShadowDOM,
-	None:                 inspectModeNone,
+	SearchForNode:         inspectModeSearchForNode,
+	SearchForUAShadowDOM:  inspectModeSearchForUAShadowDOM,
+	CaptureAreaScreenshot: inspectModeCaptureAreaScreenshot,
+	None:                  inspectModeNone,
 }
 
 /*
-InspectModeEnum represents the inspect mode. Allowed values:
-	- InspectMode.SearchForNode        "searchForNode"
-	- InspectMode.SearchForUAShadowDOM "searchForUAShadowDOM"
-	- InspectMode.None                 "none"
+InspectModeEnum represents the Overlay.InspectMode values.
+
+Allowed values:
+  - InspectMode.SearchForNode         "searchForNode"
+  - InspectMode.SearchForUAShadowDOM  "searchForUAShadowDOM"
+  - InspectMode.CaptureAreaScreenshot "captureAreaScreenshot"
+  - InspectMode.None                  "none"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-InspectMode
 */
@@ -34,7 +42,16 @@ type InspectModeEnum int
 String implements Stringer
 */
 func (enum InspectModeEnum) String() string {
-	return _inspectModeEnums[enum]
+	return _inspectModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InspectModeEnum) Unknown() bool {
+	return !_inspectModeEnums.Known(enum)
 }
 
 /*
@@ -45,25 +62,16 @@ func (enum InspectModeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *InspectModeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _inspectModeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _inspectModeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -71,12 +79,16 @@ const (
 	inspectModeSearchForNode InspectModeEnum = iota + 1
 	// inspectModeSearchForUAShadowDOM represents the "searchForUAShadowDOM" value.
 	inspectModeSearchForUAShadowDOM
+	// inspectModeCaptureAreaScreenshot represents the "captureAreaScreenshot" value.
+	inspectModeCaptureAreaScreenshot
 	// inspectModeNone represents the "none" value.
 	inspectModeNone
 )
 
-var _inspectModeEnums = map[InspectModeEnum]string{
-	inspectModeSearchForNode:        "searchForNode",
-	inspectModeSearchForUAShadowDOM: "searchForUAShadowDOM",
-	inspectModeNone:                 "none",
-}
+var _inspectModeEnums = enums.New(map[InspectModeEnum]string{
+	InspectModeEnum(0):               "",
+	inspectModeSearchForNode:         "searchForNode",
+	inspectModeSearchForUAShadowDOM:  "searchForUAShadowDOM",
+	inspectModeCaptureAreaScreenshot: "captureAreaScreenshot",
+	inspectModeNone:                  "none",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package overlay
 
 import (
@@ -11,8 +13,24 @@ func TestEnumInspectMode(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InspectModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumInspectMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"searchForNode"` != string(result) {
-		t.Errorf("Expected '\"searchForNode\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"searchForNode"`, result)
 	}
 	json.Unmarshal([]byte(`"searchForNode"`), &enum)
 	if InspectMode.SearchForNode != enum {
-		t.Errorf("Expcected %d, got %d", InspectMode.SearchForNode, enum)
+		t.Errorf("Expected %d, got %d", InspectMode.SearchForNode, enum)
 	}
 
 	enum = InspectMode.SearchForUAShadowDOM
@@ -42,11 +60,24 @@ func TestEnumInspectMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"searchForUAShadowDOM"` != string(result) {
-		t.Errorf("Expected '\"searchForUAShadowDOM\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"searchForUAShadowDOM"`, result)
 	}
 	json.Unmarshal([]byte(`"searchForUAShadowDOM"`), &enum)
 	if InspectMode.SearchForUAShadowDOM != enum {
-		t.Errorf("Expcected %d, got %d", InspectMode.SearchForUAShadowDOM, enum)
+		t.Errorf("Expected %d, got %d", InspectMode.SearchForUAShadowDOM, enum)
+	}
+
+	enum = InspectMode.CaptureAreaScreenshot
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"captureAreaScreenshot"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"captureAreaScreenshot"`, result)
+	}
+	json.Unmarshal([]byte(`"captureAreaScreenshot"`), &enum)
+	if InspectMode.CaptureAreaScreenshot != enum {
+		t.Errorf("Expected %d, got %d", InspectMode.CaptureAreaScreenshot, enum)
 	}
 
 	enum = InspectMode.None
@@ -55,10 +86,10 @@ func TestEnumInspectMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"none"` != string(result) {
-		t.Errorf("Expected '\"none\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
 	}
 	json.Unmarshal([]byte(`"none"`), &enum)
 	if InspectMode.None != enum {
-		t.Errorf("Expcected %d, got %d", InspectMode.None, enum)
+		t.Errorf("Expected %d, got %d", InspectMode.None, enum)
 	}
 }
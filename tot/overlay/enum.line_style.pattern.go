@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type patternEnum struct {
+	Dashed PatternEnum
+	Dotted PatternEnum
+}
+
+/*
+Pattern provides named access to the PatternEnum values.
+*/
+var Pattern = patternEnum{
+	Dashed: patternDashed,
+	Dotted: patternDotted,
+}
+
+/*
+PatternEnum represents the Overlay.LineStyle.pattern values.
+
+The line pattern (default: solid)
+
+Allowed values:
+  - Pattern.Dashed "dashed"
+  - Pattern.Dotted "dotted"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#type-LineStyle
+*/
+type PatternEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PatternEnum) String() string {
+	return _patternEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PatternEnum) Unknown() bool {
+	return !_patternEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PatternEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PatternEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _patternEnums.Parse(val)
+	return nil
+}
+
+const (
+	// patternDashed represents the "dashed" value.
+	patternDashed PatternEnum = iota + 1
+	// patternDotted represents the "dotted" value.
+	patternDotted
+)
+
+var _patternEnums = enums.New(map[PatternEnum]string{
+	PatternEnum(0): "",
+	patternDashed:  "dashed",
+	patternDotted:  "dotted",
+})
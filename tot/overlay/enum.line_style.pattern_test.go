@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package overlay
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPattern(t *testing.T) {
+	var enum PatternEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PatternEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Pattern.Dashed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dashed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dashed"`, result)
+	}
+	json.Unmarshal([]byte(`"dashed"`), &enum)
+	if Pattern.Dashed != enum {
+		t.Errorf("Expected %d, got %d", Pattern.Dashed, enum)
+	}
+
+	enum = Pattern.Dotted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"dotted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"dotted"`, result)
+	}
+	json.Unmarshal([]byte(`"dotted"`), &enum)
+	if Pattern.Dotted != enum {
+		t.Errorf("Expected %d, got %d", Pattern.Dotted, enum)
+	}
+}
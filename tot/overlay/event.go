@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package overlay
 
 import (
@@ -11,7 +13,7 @@ InspectNodeRequestedEvent represents Overlay.inspectNodeRequested event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectNodeRequested
 */
 type InspectNodeRequestedEvent struct {
-	// ID of the node to inspect.
+	// Id of the node to inspect.
 	BackendNodeID dom.BackendNodeID `json:"backendNodeId"`
 
 	// Error information related to this event
@@ -24,7 +26,6 @@ NodeHighlightRequestedEvent represents Overlay.nodeHighlightRequested event data
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-nodeHighlightRequested
 */
 type NodeHighlightRequestedEvent struct {
-	// ID of the node to highlight.
 	NodeID dom.NodeID `json:"nodeId"`
 
 	// Error information related to this event
@@ -37,9 +38,19 @@ ScreenshotRequestedEvent represents Overlay.screenshotRequested event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-screenshotRequested
 */
 type ScreenshotRequestedEvent struct {
-	// Viewport to capture, in CSS.
+	// Viewport to capture, in device independent pixels (dip).
 	Viewport *page.Viewport `json:"viewport"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
+
+/*
+InspectModeCanceledEvent represents Overlay.inspectModeCanceled event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectModeCanceled
+*/
+type InspectModeCanceledEvent struct {
+	// Error information related to this event
+	Err error `json:"-"`
+}
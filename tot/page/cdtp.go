@@ -1,84 +1,408 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package page provides type definitions for use with the Chrome Page protocol
 
+Actions and events related to the inspected page belong to the page domain.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Page/
 */
 package page
 
+import (
+	"github.com/mkenney/go-chrome/tot/runtime"
+)
+
 /*
-LoaderID is the Unique loader identifier.
-This is a duplicate of Network.LoaderID to avoid an invalid import cycle
+TimeSinceEpoch represents the Network.TimeSinceEpoch type. This is a duplicate
+of Network.TimeSinceEpoch to avoid an invalid import cycle.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-LoaderId
+UTC time in seconds, counted from January 1, 1970.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TimeSinceEpoch
 */
-type LoaderID string
+type TimeSinceEpoch float64
 
 /*
-MonotonicTime is the monotonically increasing time in seconds since an arbitrary point in the past.
-This is a duplicate of Network.MonotonicTime to avoid an invalid import cycle
+LoaderID represents the Network.LoaderId type. This is a duplicate of
+Network.LoaderId to avoid an invalid import cycle.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-MonotonicTime
+Unique loader identifier.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-LoaderId
 */
-type MonotonicTime int
+type LoaderID string
 
 /*
-Rect defines a rectangle.
-This is a duplicate of DOM.Rect to avoid an invalid import cycle
+Rect represents the DOM.Rect type. This is a duplicate of DOM.Rect to avoid an
+invalid import cycle.
+
+Rectangle.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#type-Rect
 */
 type Rect struct {
-	// X coordinate.
+	// X coordinate
 	X float64 `json:"x"`
 
-	// Y coordinate.
+	// Y coordinate
 	Y float64 `json:"y"`
 
-	// Rectangle width.
+	// Rectangle width
 	Width float64 `json:"width"`
 
-	// Rectangle height.
+	// Rectangle height
 	Height float64 `json:"height"`
 }
 
 /*
-TimeSinceEpoch represents UTC time in seconds, counted from January 1, 1970.
-This is a duplicate of DOM.Rect to avoid an invalid import cycle
+MonotonicTime represents the Network.MonotonicTime type. This is a duplicate of
+Network.MonotonicTime to avoid an invalid import cycle.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-TimeSinceEpoch
+Monotonically increasing time in seconds since an arbitrary point in the past.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-MonotonicTime
 */
-type TimeSinceEpoch int
+type MonotonicTime float64
 
 /*
-AppManifestError defines an error that occurs while parsing an app manifest.
+BackendNodeID represents the DOM.BackendNodeId type. This is a duplicate of
+DOM.BackendNodeId to avoid an invalid import cycle.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AppManifestError
+Unique DOM node identifier used to reference a node that may not have been
+pushed to the front-end.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#type-BackendNodeId
 */
-type AppManifestError struct {
-	// Error message.
-	Message string `json:"message"`
+type BackendNodeID int
 
-	// If criticial, this is a non-recoverable parse error.
-	Critical int `json:"critical"`
+/*
+ScreenOrientation represents the Emulation.ScreenOrientation type. This is a
+duplicate of Emulation.ScreenOrientation to avoid an invalid import cycle.
 
-	// Error line.
-	Line int `json:"line"`
+Screen orientation.
 
-	// Error column.
-	Column int `json:"column"`
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#type-ScreenOrientation
+*/
+type ScreenOrientation struct {
+	// Orientation type. Allowed values:
+	//   - OrientationType.PortraitPrimary
+	//   - OrientationType.PortraitSecondary
+	//   - OrientationType.LandscapePrimary
+	//   - OrientationType.LandscapeSecondary
+	Type OrientationTypeEnum `json:"type"`
+
+	// Orientation angle.
+	Angle int `json:"angle"`
+}
+
+/*
+FrameID represents the Page.FrameId type.
+
+Unique frame identifier.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FrameId
+*/
+type FrameID string
+
+/*
+AdFrameStatus represents the Page.AdFrameStatus type.
+
+Indicates whether a frame has been identified as an ad and why. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AdFrameStatus
+*/
+type AdFrameStatus struct {
+	// Allowed values:
+	//   - AdFrameType.None
+	//   - AdFrameType.Child
+	//   - AdFrameType.Root
+	AdFrameType AdFrameTypeEnum `json:"adFrameType"`
+
+	// Optional.
+	Explanations []AdFrameExplanationEnum `json:"explanations,omitempty"`
+}
+
+/*
+AdScriptID represents the Page.AdScriptId type.
+
+Identifies the script which caused a script or frame to be labelled as an ad.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AdScriptId
+*/
+type AdScriptID struct {
+	// Script Id of the script which caused a script or frame to be labelled as an
+	// ad.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// Id of scriptId's debugger.
+	DebuggerID runtime.UniqueDebuggerID `json:"debuggerId"`
+}
+
+/*
+AdScriptAncestry represents the Page.AdScriptAncestry type.
+
+Encapsulates the script ancestry and the root script filterlist rule that caused
+the frame to be labelled as an ad. Only created when `ancestryChain` is not
+empty. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AdScriptAncestry
+*/
+type AdScriptAncestry struct {
+	// A chain of `AdScriptId`s representing the ancestry of an ad script that led
+	// to the creation of a frame. The chain is ordered from the script itself
+	// (lower level) up to its root ancestor that was flagged by filterlist.
+	AncestryChain []*AdScriptID `json:"ancestryChain"`
+
+	// Optional. The filterlist rule that caused the root (last) script in
+	// `ancestryChain` to be ad-tagged. Only populated if the rule is available.
+	RootScriptFilterlistRule string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+/*
+PermissionsPolicyBlockLocator represents the Page.PermissionsPolicyBlockLocator
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-PermissionsPolicyBlockLocator
+*/
+type PermissionsPolicyBlockLocator struct {
+	FrameID FrameID `json:"frameId"`
+
+	// Allowed values:
+	//   - PermissionsPolicyBlockReason.Header
+	//   - PermissionsPolicyBlockReason.IframeAttribute
+	//   - PermissionsPolicyBlockReason.InFencedFrameTree
+	//   - PermissionsPolicyBlockReason.InIsolatedApp
+	BlockReason PermissionsPolicyBlockReasonEnum `json:"blockReason"`
+}
+
+/*
+PermissionsPolicyFeatureState represents the Page.PermissionsPolicyFeatureState
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-PermissionsPolicyFeatureState
+*/
+type PermissionsPolicyFeatureState struct {
+	// Allowed values:
+	//   - PermissionsPolicyFeature.Accelerometer
+	//   - PermissionsPolicyFeature.AllScreensCapture
+	//   - PermissionsPolicyFeature.AmbientLightSensor
+	//   - PermissionsPolicyFeature.AriaNotify
+	//   - PermissionsPolicyFeature.AttributionReporting
+	//   - PermissionsPolicyFeature.Autoplay
+	//   - PermissionsPolicyFeature.Bluetooth
+	//   - PermissionsPolicyFeature.BrowsingTopics
+	//   - PermissionsPolicyFeature.Camera
+	//   - PermissionsPolicyFeature.CapturedSurfaceControl
+	//   - PermissionsPolicyFeature.ChDpr
+	//   - PermissionsPolicyFeature.ChDeviceMemory
+	//   - PermissionsPolicyFeature.ChDownlink
+	//   - PermissionsPolicyFeature.ChEct
+	//   - PermissionsPolicyFeature.ChPrefersColorScheme
+	//   - PermissionsPolicyFeature.ChPrefersReducedMotion
+	//   - PermissionsPolicyFeature.ChPrefersReducedTransparency
+	//   - PermissionsPolicyFeature.ChRtt
+	//   - PermissionsPolicyFeature.ChSaveData
+	//   - PermissionsPolicyFeature.ChUa
+	//   - PermissionsPolicyFeature.ChUaArch
+	//   - PermissionsPolicyFeature.ChUaBitness
+	//   - PermissionsPolicyFeature.ChUaHighEntropyValues
+	//   - PermissionsPolicyFeature.ChUaPlatform
+	//   - PermissionsPolicyFeature.ChUaModel
+	//   - PermissionsPolicyFeature.ChUaMobile
+	//   - PermissionsPolicyFeature.ChUaFormFactors
+	//   - PermissionsPolicyFeature.ChUaFullVersion
+	//   - PermissionsPolicyFeature.ChUaFullVersionList
+	//   - PermissionsPolicyFeature.ChUaPlatformVersion
+	//   - PermissionsPolicyFeature.ChUaWow64
+	//   - PermissionsPolicyFeature.ChViewportHeight
+	//   - PermissionsPolicyFeature.ChViewportWidth
+	//   - PermissionsPolicyFeature.ChWidth
+	//   - PermissionsPolicyFeature.ClipboardRead
+	//   - PermissionsPolicyFeature.ClipboardWrite
+	//   - PermissionsPolicyFeature.ComputePressure
+	//   - PermissionsPolicyFeature.ControlledFrame
+	//   - PermissionsPolicyFeature.CrossOriginIsolated
+	//   - PermissionsPolicyFeature.DeferredFetch
+	//   - PermissionsPolicyFeature.DeferredFetchMinimal
+	//   - PermissionsPolicyFeature.DeviceAttributes
+	//   - PermissionsPolicyFeature.DigitalCredentialsGet
+	//   - PermissionsPolicyFeature.DirectSockets
+	//   - PermissionsPolicyFeature.DirectSocketsPrivate
+	//   - PermissionsPolicyFeature.DisplayCapture
+	//   - PermissionsPolicyFeature.DocumentDomain
+	//   - PermissionsPolicyFeature.EncryptedMedia
+	//   - PermissionsPolicyFeature.ExecutionWhileOutOfViewport
+	//   - PermissionsPolicyFeature.ExecutionWhileNotRendered
+	//   - PermissionsPolicyFeature.FencedUnpartitionedStorageRead
+	//   - PermissionsPolicyFeature.FocusWithoutUserActivation
+	//   - PermissionsPolicyFeature.Fullscreen
+	//   - PermissionsPolicyFeature.Frobulate
+	//   - PermissionsPolicyFeature.Gamepad
+	//   - PermissionsPolicyFeature.Geolocation
+	//   - PermissionsPolicyFeature.Gyroscope
+	//   - PermissionsPolicyFeature.Hid
+	//   - PermissionsPolicyFeature.IdentityCredentialsGet
+	//   - PermissionsPolicyFeature.IdleDetection
+	//   - PermissionsPolicyFeature.InterestCohort
+	//   - PermissionsPolicyFeature.JoinAdInterestGroup
+	//   - PermissionsPolicyFeature.KeyboardMap
+	//   - PermissionsPolicyFeature.LanguageDetector
+	//   - PermissionsPolicyFeature.LanguageModel
+	//   - PermissionsPolicyFeature.LocalFonts
+	//   - PermissionsPolicyFeature.LocalNetworkAccess
+	//   - PermissionsPolicyFeature.Magnetometer
+	//   - PermissionsPolicyFeature.MediaPlaybackWhileNotVisible
+	//   - PermissionsPolicyFeature.Microphone
+	//   - PermissionsPolicyFeature.Midi
+	//   - PermissionsPolicyFeature.OnDeviceSpeechRecognition
+	//   - PermissionsPolicyFeature.OtpCredentials
+	//   - PermissionsPolicyFeature.Payment
+	//   - PermissionsPolicyFeature.PictureInPicture
+	//   - PermissionsPolicyFeature.Popins
+	//   - PermissionsPolicyFeature.PrivateAggregation
+	//   - PermissionsPolicyFeature.PrivateStateTokenIssuance
+	//   - PermissionsPolicyFeature.PrivateStateTokenRedemption
+	//   - PermissionsPolicyFeature.PublickeyCredentialsCreate
+	//   - PermissionsPolicyFeature.PublickeyCredentialsGet
+	//   - PermissionsPolicyFeature.RecordAdAuctionEvents
+	//   - PermissionsPolicyFeature.Rewriter
+	//   - PermissionsPolicyFeature.RunAdAuction
+	//   - PermissionsPolicyFeature.ScreenWakeLock
+	//   - PermissionsPolicyFeature.Serial
+	//   - PermissionsPolicyFeature.SharedAutofill
+	//   - PermissionsPolicyFeature.SharedStorage
+	//   - PermissionsPolicyFeature.SharedStorageSelectURL
+	//   - PermissionsPolicyFeature.SmartCard
+	//   - PermissionsPolicyFeature.SpeakerSelection
+	//   - PermissionsPolicyFeature.StorageAccess
+	//   - PermissionsPolicyFeature.SubApps
+	//   - PermissionsPolicyFeature.Summarizer
+	//   - PermissionsPolicyFeature.SyncXHR
+	//   - PermissionsPolicyFeature.Translator
+	//   - PermissionsPolicyFeature.Unload
+	//   - PermissionsPolicyFeature.Usb
+	//   - PermissionsPolicyFeature.UsbUnrestricted
+	//   - PermissionsPolicyFeature.VerticalScroll
+	//   - PermissionsPolicyFeature.WebAppInstallation
+	//   - PermissionsPolicyFeature.WebPrinting
+	//   - PermissionsPolicyFeature.WebShare
+	//   - PermissionsPolicyFeature.WindowManagement
+	//   - PermissionsPolicyFeature.Writer
+	//   - PermissionsPolicyFeature.XrSpatialTracking
+	Feature PermissionsPolicyFeatureEnum `json:"feature"`
+
+	Allowed bool `json:"allowed"`
+
+	// Optional.
+	Locator *PermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+/*
+OriginTrialToken represents the Page.OriginTrialToken type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-OriginTrialToken
+*/
+type OriginTrialToken struct {
+	Origin string `json:"origin"`
+
+	MatchSubDomains bool `json:"matchSubDomains"`
+
+	TrialName string `json:"trialName"`
+
+	ExpiryTime TimeSinceEpoch `json:"expiryTime"`
+
+	IsThirdParty bool `json:"isThirdParty"`
+
+	// Allowed values:
+	//   - OriginTrialUsageRestriction.None
+	//   - OriginTrialUsageRestriction.Subset
+	UsageRestriction OriginTrialUsageRestrictionEnum `json:"usageRestriction"`
+}
+
+/*
+OriginTrialTokenWithStatus represents the Page.OriginTrialTokenWithStatus type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-OriginTrialTokenWithStatus
+*/
+type OriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+
+	// Optional. `parsedToken` is present only when the token is extractable and
+	// parsable.
+	ParsedToken *OriginTrialToken `json:"parsedToken,omitempty"`
+
+	// Allowed values:
+	//   - OriginTrialTokenStatus.Success
+	//   - OriginTrialTokenStatus.NotSupported
+	//   - OriginTrialTokenStatus.Insecure
+	//   - OriginTrialTokenStatus.Expired
+	//   - OriginTrialTokenStatus.WrongOrigin
+	//   - OriginTrialTokenStatus.InvalidSignature
+	//   - OriginTrialTokenStatus.Malformed
+	//   - OriginTrialTokenStatus.WrongVersion
+	//   - OriginTrialTokenStatus.FeatureDisabled
+	//   - OriginTrialTokenStatus.TokenDisabled
+	//   - OriginTrialTokenStatus.FeatureDisabledForUser
+	//   - OriginTrialTokenStatus.UnknownTrial
+	Status OriginTrialTokenStatusEnum `json:"status"`
+}
+
+/*
+OriginTrial represents the Page.OriginTrial type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-OriginTrial
+*/
+type OriginTrial struct {
+	TrialName string `json:"trialName"`
+
+	// Allowed values:
+	//   - OriginTrialStatus.Enabled
+	//   - OriginTrialStatus.ValidTokenNotProvided
+	//   - OriginTrialStatus.OSNotSupported
+	//   - OriginTrialStatus.TrialNotAllowed
+	Status OriginTrialStatusEnum `json:"status"`
+
+	TokensWithStatus []*OriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+/*
+SecurityOriginDetails represents the Page.SecurityOriginDetails type.
+
+Additional information about the frame document's security origin. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-SecurityOriginDetails
+*/
+type SecurityOriginDetails struct {
+	// Indicates whether the frame document's security origin is one of the local
+	// hostnames (e.g. "localhost") or IP addresses (IPv4 127.0.0.0/8 or IPv6 ::1).
+	IsLocalhost bool `json:"isLocalhost"`
 }
 
 /*
-Frame details information about the Frame on the page.
+Frame represents the Page.Frame type.
+
+Information about the Frame on the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-Frame
 */
 type Frame struct {
 	// Frame unique identifier.
-	ID string `json:"id"`
+	ID FrameID `json:"id"`
 
 	// Optional. Parent frame identifier.
-	ParentID string `json:"parentId,omitempty"`
+	ParentID FrameID `json:"parentId,omitempty"`
 
 	// Identifier of the loader associated with this frame.
 	LoaderID LoaderID `json:"loaderId"`
@@ -86,29 +410,59 @@ type Frame struct {
 	// Optional. Frame's name as specified in the tag.
 	Name string `json:"name,omitempty"`
 
-	// Frame document's URL.
+	// Frame document's URL without fragment.
 	URL string `json:"url"`
 
+	// Optional. Frame document's URL fragment including the '#'. EXPERIMENTAL.
+	URLFragment string `json:"urlFragment,omitempty"`
+
+	// Frame document's registered domain, taking the public suffixes list into
+	// account. Extracted from the Frame's url. Example URLs:
+	// http://www.google.com/file.html -> "google.com" http://a.b.co.uk/file.html
+	// -> "b.co.uk" EXPERIMENTAL.
+	DomainAndRegistry string `json:"domainAndRegistry"`
+
 	// Frame document's security origin.
 	SecurityOrigin string `json:"securityOrigin"`
 
+	// Optional. Additional details about the frame document's security origin.
+	// EXPERIMENTAL.
+	SecurityOriginDetails *SecurityOriginDetails `json:"securityOriginDetails,omitempty"`
+
 	// Frame document's mimeType as determined by the browser.
 	MimeType string `json:"mimeType"`
 
-	// Optional. If the frame failed to load, this contains the URL that could
-	// not be loaded. EXPERIMENTAL.
+	// Optional. If the frame failed to load, this contains the URL that could not
+	// be loaded. Note that unlike url above, this URL may contain a fragment.
+	// EXPERIMENTAL.
 	UnreachableURL string `json:"unreachableUrl,omitempty"`
+
+	// Optional. Indicates whether this frame was tagged as an ad and why.
+	// EXPERIMENTAL.
+	AdFrameStatus *AdFrameStatus `json:"adFrameStatus,omitempty"`
+
+	// Indicates whether the main document is a secure context and explains why
+	// that is the case. EXPERIMENTAL. Allowed values:
+	//   - SecureContextType.Secure
+	//   - SecureContextType.SecureLocalhost
+	//   - SecureContextType.InsecureScheme
+	//   - SecureContextType.InsecureAncestor
+	SecureContextType SecureContextTypeEnum `json:"secureContextType"`
+
+	// Indicates whether this is a cross origin isolated context. EXPERIMENTAL. Allowed values:
+	//   - CrossOriginIsolatedContextType.Isolated
+	//   - CrossOriginIsolatedContextType.NotIsolated
+	//   - CrossOriginIsolatedContextType.NotIsolatedFeatureDisabled
+	CrossOriginIsolatedContextType CrossOriginIsolatedContextTypeEnum `json:"crossOriginIsolatedContextType"`
+
+	// Indicated which gated APIs / features are available. EXPERIMENTAL.
+	GatedAPIFeatures []GatedAPIFeaturesEnum `json:"gatedAPIFeatures"`
 }
 
 /*
-FrameID is a unique frame identifier
+FrameResource represents the Page.FrameResource type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FrameId
-*/
-type FrameID string
-
-/*
-FrameResource provides information about the Resource on the page. EXPERIMENTAL.
+Information about the Resource on the page. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FrameResource
 */
@@ -116,20 +470,26 @@ type FrameResource struct {
 	// Resource URL.
 	URL string `json:"url"`
 
-	// Type of this resource. Allowed Values:
-	//	- ResourceType.Document
-	//	- ResourceType.Stylesheet
-	//	- ResourceType.Image
-	//	- ResourceType.Media
-	//	- ResourceType.Font
-	//	- ResourceType.Script
-	//	- ResourceType.TextTrack
-	//	- ResourceType.XHR
-	//	- ResourceType.Fetch
-	//	- ResourceType.EventSource
-	//	- ResourceType.WebSocket
-	//	- ResourceType.Manifest
-	//	- ResourceType.Other
+	// Type of this resource. Allowed values:
+	//   - ResourceType.Document
+	//   - ResourceType.Stylesheet
+	//   - ResourceType.Image
+	//   - ResourceType.Media
+	//   - ResourceType.Font
+	//   - ResourceType.Script
+	//   - ResourceType.TextTrack
+	//   - ResourceType.XHR
+	//   - ResourceType.Fetch
+	//   - ResourceType.Prefetch
+	//   - ResourceType.EventSource
+	//   - ResourceType.WebSocket
+	//   - ResourceType.Manifest
+	//   - ResourceType.SignedExchange
+	//   - ResourceType.Ping
+	//   - ResourceType.CSPViolationReport
+	//   - ResourceType.Preflight
+	//   - ResourceType.FedCM
+	//   - ResourceType.Other
 	Type ResourceTypeEnum `json:"type"`
 
 	// Resource mimeType as determined by the browser.
@@ -139,7 +499,7 @@ type FrameResource struct {
 	LastModified TimeSinceEpoch `json:"lastModified,omitempty"`
 
 	// Optional. Resource content size.
-	ContentSize int `json:"contentSize,omitempty"`
+	ContentSize float64 `json:"contentSize,omitempty"`
 
 	// Optional. True if the resource failed to load.
 	Failed bool `json:"failed,omitempty"`
@@ -149,7 +509,9 @@ type FrameResource struct {
 }
 
 /*
-FrameResourceTree provides information about the Frame hierarchy along with their cached resources.
+FrameResourceTree represents the Page.FrameResourceTree type.
+
+Information about the Frame hierarchy along with their cached resources.
 EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FrameResourceTree
@@ -166,7 +528,9 @@ type FrameResourceTree struct {
 }
 
 /*
-FrameTree provides information about the Frame hierarchy.
+FrameTree represents the Page.FrameTree type.
+
+Information about the Frame hierarchy.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FrameTree
 */
@@ -179,26 +543,27 @@ type FrameTree struct {
 }
 
 /*
-LayoutViewport defines layout viewport position and dimensions.
+ScriptIdentifier represents the Page.ScriptIdentifier type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-LayoutViewport
+Unique script identifier.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ScriptIdentifier
 */
-type LayoutViewport struct {
-	// Horizontal offset relative to the document (CSS pixels).
-	PageX int `json:"pageX"`
+type ScriptIdentifier string
 
-	// Vertical offset relative to the document (CSS pixels).
-	PageY int `json:"pageY"`
+/*
+TransitionType represents the Page.TransitionType type.
 
-	// Width (CSS pixels), excludes scrollbar if present.
-	ClientWidth int `json:"clientWidth"`
+Transition type.
 
-	// Height (CSS pixels), excludes scrollbar if present.
-	ClientHeight int `json:"clientHeight"`
-}
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-TransitionType
+*/
+type TransitionType string
 
 /*
-NavigationEntry defines a navigation history entry.
+NavigationEntry represents the Page.NavigationEntry type.
+
+Navigation history entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-NavigationEntry
 */
@@ -220,93 +585,712 @@ type NavigationEntry struct {
 }
 
 /*
-ScreencastFrameMetadata provides screencast frame metadata. EXPERIMENTAL
+ScreencastFrameMetadata represents the Page.ScreencastFrameMetadata type.
+
+Screencast frame metadata. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ScreencastFrameMetadata
 */
 type ScreencastFrameMetadata struct {
 	// Top offset in DIP.
-	OffsetTop int `json:"offsetTop"`
+	OffsetTop float64 `json:"offsetTop"`
 
 	// Page scale factor.
-	PageScaleFactor int `json:"pageScaleFactor"`
+	PageScaleFactor float64 `json:"pageScaleFactor"`
 
 	// Device screen width in DIP.
-	DeviceWidth int `json:"deviceWidth"`
+	DeviceWidth float64 `json:"deviceWidth"`
 
 	// Device screen height in DIP.
-	DeviceHeight int `json:"deviceHeight"`
+	DeviceHeight float64 `json:"deviceHeight"`
 
 	// Position of horizontal scroll in CSS pixels.
-	ScrollOffsetX int `json:"scrollOffsetX"`
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
 
 	// Position of vertical scroll in CSS pixels.
-	ScrollOffsetY int `json:"scrollOffsetY"`
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
 
 	// Optional. Frame swap timestamp.
 	Timestamp TimeSinceEpoch `json:"timestamp,omitempty"`
 }
 
 /*
-ScriptIdentifier is the unique script identifier.
+AppManifestError represents the Page.AppManifestError type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ScriptIdentifier
+Error while paring app manifest.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AppManifestError
 */
-type ScriptIdentifier string
+type AppManifestError struct {
+	// Error message.
+	Message string `json:"message"`
+
+	// If critical, this is a non-recoverable parse error.
+	Critical int `json:"critical"`
+
+	// Error line.
+	Line int `json:"line"`
+
+	// Error column.
+	Column int `json:"column"`
+}
 
 /*
-TransitionType is the transition type.
+AppManifestParsedProperties represents the Page.AppManifestParsedProperties
+type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-TransitionType
+Parsed app manifest properties. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AppManifestParsedProperties
 */
-type TransitionType string
+type AppManifestParsedProperties struct {
+	// Computed scope value
+	Scope string `json:"scope"`
+}
 
 /*
-Viewport defines the viewport for capturing screenshot.
+LayoutViewport represents the Page.LayoutViewport type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-Viewport
-*/
-type Viewport struct {
-	// Required. X offset in CSS pixels.
-	X int `json:"x"`
+Layout viewport position and dimensions.
 
-	// Required. Y offset in CSS pixels.
-	Y int `json:"y"`
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-LayoutViewport
+*/
+type LayoutViewport struct {
+	// Horizontal offset relative to the document (CSS pixels).
+	PageX int `json:"pageX"`
 
-	// Required. Rectangle width in CSS pixels
-	Width int `json:"width"`
+	// Vertical offset relative to the document (CSS pixels).
+	PageY int `json:"pageY"`
 
-	// Required. Rectangle height in CSS pixels
-	Height int `json:"height"`
+	// Width (CSS pixels), excludes scrollbar if present.
+	ClientWidth int `json:"clientWidth"`
 
-	// Required. Page scale factor.
-	Scale int `json:"scale"`
+	// Height (CSS pixels), excludes scrollbar if present.
+	ClientHeight int `json:"clientHeight"`
 }
 
 /*
-VisualViewport defines visual viewport position, dimensions, and scale.
+VisualViewport represents the Page.VisualViewport type.
+
+Visual viewport position, dimensions, and scale.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-VisualViewport
 */
 type VisualViewport struct {
 	// Horizontal offset relative to the layout viewport (CSS pixels).
-	OffsetX int `json:"offsetX"`
+	OffsetX float64 `json:"offsetX"`
 
 	// Vertical offset relative to the layout viewport (CSS pixels).
-	OffsetY int `json:"offsetY"`
+	OffsetY float64 `json:"offsetY"`
 
 	// Horizontal offset relative to the document (CSS pixels).
-	PageX int `json:"pageX"`
+	PageX float64 `json:"pageX"`
 
 	// Vertical offset relative to the document (CSS pixels).
-	PageY int `json:"pageY"`
+	PageY float64 `json:"pageY"`
 
 	// Width (CSS pixels), excludes scrollbar if present.
-	ClientWidth int `json:"clientWidth"`
+	ClientWidth float64 `json:"clientWidth"`
 
 	// Height (CSS pixels), excludes scrollbar if present.
-	ClientHeight int `json:"clientHeight"`
+	ClientHeight float64 `json:"clientHeight"`
 
 	// Scale relative to the ideal viewport (size at width=device-width).
-	Scale int `json:"scale"`
+	Scale float64 `json:"scale"`
+
+	// Optional. Page zoom factor (CSS to device independent pixels ratio).
+	Zoom float64 `json:"zoom,omitempty"`
+}
+
+/*
+Viewport represents the Page.Viewport type.
+
+Viewport for capturing screenshot.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-Viewport
+*/
+type Viewport struct {
+	// X offset in device independent pixels (dip).
+	X float64 `json:"x"`
+
+	// Y offset in device independent pixels (dip).
+	Y float64 `json:"y"`
+
+	// Rectangle width in device independent pixels (dip).
+	Width float64 `json:"width"`
+
+	// Rectangle height in device independent pixels (dip).
+	Height float64 `json:"height"`
+
+	// Page scale factor.
+	Scale float64 `json:"scale"`
+}
+
+/*
+FontFamilies represents the Page.FontFamilies type.
+
+Generic font families collection. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FontFamilies
+*/
+type FontFamilies struct {
+	// Optional. The standard font-family.
+	Standard string `json:"standard,omitempty"`
+
+	// Optional. The fixed font-family.
+	Fixed string `json:"fixed,omitempty"`
+
+	// Optional. The serif font-family.
+	Serif string `json:"serif,omitempty"`
+
+	// Optional. The sansSerif font-family.
+	SansSerif string `json:"sansSerif,omitempty"`
+
+	// Optional. The cursive font-family.
+	Cursive string `json:"cursive,omitempty"`
+
+	// Optional. The fantasy font-family.
+	Fantasy string `json:"fantasy,omitempty"`
+
+	// Optional. The math font-family.
+	Math string `json:"math,omitempty"`
+}
+
+/*
+ScriptFontFamilies represents the Page.ScriptFontFamilies type.
+
+Font families collection for a script. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ScriptFontFamilies
+*/
+type ScriptFontFamilies struct {
+	// Name of the script which these font families are defined for.
+	Script string `json:"script"`
+
+	// Generic font families collection for the script.
+	FontFamilies *FontFamilies `json:"fontFamilies"`
+}
+
+/*
+FontSizes represents the Page.FontSizes type.
+
+Default font sizes. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FontSizes
+*/
+type FontSizes struct {
+	// Optional. Default standard font size.
+	Standard int `json:"standard,omitempty"`
+
+	// Optional. Default fixed font size.
+	Fixed int `json:"fixed,omitempty"`
+}
+
+/*
+InstallabilityErrorArgument represents the Page.InstallabilityErrorArgument
+type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-InstallabilityErrorArgument
+*/
+type InstallabilityErrorArgument struct {
+	// Argument name (e.g. name:'minimum-icon-size-in-pixels').
+	Name string `json:"name"`
+
+	// Argument value (e.g. value:'64').
+	Value string `json:"value"`
+}
+
+/*
+InstallabilityError represents the Page.InstallabilityError type.
+
+The installability error EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-InstallabilityError
+*/
+type InstallabilityError struct {
+	// The error id (e.g. 'manifest-missing-suitable-icon').
+	ErrorID string `json:"errorId"`
+
+	// The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels',
+	// value:'64'}).
+	ErrorArguments []*InstallabilityErrorArgument `json:"errorArguments"`
+}
+
+/*
+CompilationCacheParams represents the Page.CompilationCacheParams type.
+
+Per-script compilation cache parameters for `Page.produceCompilationCache`
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-CompilationCacheParams
+*/
+type CompilationCacheParams struct {
+	// The URL of the script to produce a compilation cache entry for.
+	URL string `json:"url"`
+
+	// Optional. A hint to the backend whether eager compilation is recommended.
+	// (the actual compilation mode used is upon backend discretion).
+	Eager bool `json:"eager,omitempty"`
+}
+
+/*
+FileFilter represents the Page.FileFilter type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FileFilter
+*/
+type FileFilter struct {
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional.
+	Accepts []string `json:"accepts,omitempty"`
+}
+
+/*
+FileHandler represents the Page.FileHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-FileHandler
+*/
+type FileHandler struct {
+	Action string `json:"action"`
+
+	Name string `json:"name"`
+
+	// Optional.
+	Icons []*ImageResource `json:"icons,omitempty"`
+
+	// Optional. Mimic a map, name is the key, accepts is the value.
+	Accepts []*FileFilter `json:"accepts,omitempty"`
+
+	// Won't repeat the enums, using string for easy comparison. Same as the other
+	// enums below.
+	LaunchType string `json:"launchType"`
+}
+
+/*
+ImageResource represents the Page.ImageResource type.
+
+The image definition used in both icon and screenshot. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ImageResource
+*/
+type ImageResource struct {
+	// The src field in the definition, but changing to url in favor of
+	// consistency.
+	URL string `json:"url"`
+
+	// Optional.
+	Sizes string `json:"sizes,omitempty"`
+
+	// Optional.
+	Type string `json:"type,omitempty"`
+}
+
+/*
+LaunchHandler represents the Page.LaunchHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-LaunchHandler
+*/
+type LaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+/*
+ProtocolHandler represents the Page.ProtocolHandler type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ProtocolHandler
+*/
+type ProtocolHandler struct {
+	Protocol string `json:"protocol"`
+
+	URL string `json:"url"`
+}
+
+/*
+RelatedApplication represents the Page.RelatedApplication type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-RelatedApplication
+*/
+type RelatedApplication struct {
+	// Optional.
+	ID string `json:"id,omitempty"`
+
+	URL string `json:"url"`
+}
+
+/*
+ScopeExtension represents the Page.ScopeExtension type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ScopeExtension
+*/
+type ScopeExtension struct {
+	// Instead of using tuple, this field always returns the serialized string for
+	// easy understanding and comparison.
+	Origin string `json:"origin"`
+
+	HasOriginWildcard bool `json:"hasOriginWildcard"`
+}
+
+/*
+Screenshot represents the Page.Screenshot type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-Screenshot
+*/
+type Screenshot struct {
+	Image *ImageResource `json:"image"`
+
+	FormFactor string `json:"formFactor"`
+
+	// Optional.
+	Label string `json:"label,omitempty"`
+}
+
+/*
+ShareTarget represents the Page.ShareTarget type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ShareTarget
+*/
+type ShareTarget struct {
+	Action string `json:"action"`
+
+	Method string `json:"method"`
+
+	Enctype string `json:"enctype"`
+
+	// Optional. Embed the ShareTargetParams
+	Title string `json:"title,omitempty"`
+
+	// Optional.
+	Text string `json:"text,omitempty"`
+
+	// Optional.
+	URL string `json:"url,omitempty"`
+
+	// Optional.
+	Files []*FileFilter `json:"files,omitempty"`
+}
+
+/*
+Shortcut represents the Page.Shortcut type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-Shortcut
+*/
+type Shortcut struct {
+	Name string `json:"name"`
+
+	URL string `json:"url"`
+}
+
+/*
+WebAppManifest represents the Page.WebAppManifest type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-WebAppManifest
+*/
+type WebAppManifest struct {
+	// Optional.
+	BackgroundColor string `json:"backgroundColor,omitempty"`
+
+	// Optional. The extra description provided by the manifest.
+	Description string `json:"description,omitempty"`
+
+	// Optional.
+	Dir string `json:"dir,omitempty"`
+
+	// Optional.
+	Display string `json:"display,omitempty"`
+
+	// Optional. The overrided display mode controlled by the user.
+	DisplayOverrides []string `json:"displayOverrides,omitempty"`
+
+	// Optional. The handlers to open files.
+	FileHandlers []*FileHandler `json:"fileHandlers,omitempty"`
+
+	// Optional.
+	Icons []*ImageResource `json:"icons,omitempty"`
+
+	// Optional.
+	ID string `json:"id,omitempty"`
+
+	// Optional.
+	Lang string `json:"lang,omitempty"`
+
+	// Optional. TODO(crbug.com/1231886): This field is non-standard and part of a
+	// Chrome experiment. See:
+	// https://github.com/WICG/web-app-launch/blob/main/launch_handler.md
+	LaunchHandler *LaunchHandler `json:"launchHandler,omitempty"`
+
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	// Optional.
+	Orientation string `json:"orientation,omitempty"`
+
+	// Optional.
+	PreferRelatedApplications bool `json:"preferRelatedApplications,omitempty"`
+
+	// Optional. The handlers to open protocols.
+	ProtocolHandlers []*ProtocolHandler `json:"protocolHandlers,omitempty"`
+
+	// Optional.
+	RelatedApplications []*RelatedApplication `json:"relatedApplications,omitempty"`
+
+	// Optional.
+	Scope string `json:"scope,omitempty"`
+
+	// Optional. Non-standard, see
+	// https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md
+	ScopeExtensions []*ScopeExtension `json:"scopeExtensions,omitempty"`
+
+	// Optional. The screenshots used by chromium.
+	Screenshots []*Screenshot `json:"screenshots,omitempty"`
+
+	// Optional.
+	ShareTarget *ShareTarget `json:"shareTarget,omitempty"`
+
+	// Optional.
+	ShortName string `json:"shortName,omitempty"`
+
+	// Optional.
+	Shortcuts []*Shortcut `json:"shortcuts,omitempty"`
+
+	// Optional.
+	StartURL string `json:"startUrl,omitempty"`
+
+	// Optional.
+	ThemeColor string `json:"themeColor,omitempty"`
+}
+
+/*
+BackForwardCacheBlockingDetails represents the
+Page.BackForwardCacheBlockingDetails type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-BackForwardCacheBlockingDetails
+*/
+type BackForwardCacheBlockingDetails struct {
+	// Optional. Url of the file where blockage happened. Optional because of
+	// tests.
+	URL string `json:"url,omitempty"`
+
+	// Optional. Function name where blockage happened. Optional because of
+	// anonymous functions and tests.
+	Function string `json:"function,omitempty"`
+
+	// Line number in the script (0-based).
+	LineNumber int `json:"lineNumber"`
+
+	// Column number in the script (0-based).
+	ColumnNumber int `json:"columnNumber"`
+}
+
+/*
+BackForwardCacheNotRestoredExplanation represents the
+Page.BackForwardCacheNotRestoredExplanation type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-BackForwardCacheNotRestoredExplanation
+*/
+type BackForwardCacheNotRestoredExplanation struct {
+	// Type of the reason Allowed values:
+	//   - BackForwardCacheNotRestoredReasonType.SupportPending
+	//   - BackForwardCacheNotRestoredReasonType.PageSupportNeeded
+	//   - BackForwardCacheNotRestoredReasonType.Circumstantial
+	Type BackForwardCacheNotRestoredReasonTypeEnum `json:"type"`
+
+	// Not restored reason Allowed values:
+	//   - BackForwardCacheNotRestoredReason.NotPrimaryMainFrame
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabled
+	//   - BackForwardCacheNotRestoredReason.RelatedActiveContentsExist
+	//   - BackForwardCacheNotRestoredReason.HTTPStatusNotOK
+	//   - BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS
+	//   - BackForwardCacheNotRestoredReason.Loading
+	//   - BackForwardCacheNotRestoredReason.WasGrantedMediaAccess
+	//   - BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled
+	//   - BackForwardCacheNotRestoredReason.DomainNotAllowed
+	//   - BackForwardCacheNotRestoredReason.HTTPMethodNotGET
+	//   - BackForwardCacheNotRestoredReason.SubframeIsNavigating
+	//   - BackForwardCacheNotRestoredReason.Timeout
+	//   - BackForwardCacheNotRestoredReason.CacheLimit
+	//   - BackForwardCacheNotRestoredReason.JavaScriptExecution
+	//   - BackForwardCacheNotRestoredReason.RendererProcessKilled
+	//   - BackForwardCacheNotRestoredReason.RendererProcessCrashed
+	//   - BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed
+	//   - BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance
+	//   - BackForwardCacheNotRestoredReason.CacheFlushed
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation
+	//   - BackForwardCacheNotRestoredReason.SessionRestored
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage
+	//   - BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded
+	//   - BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite
+	//   - BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerClaim
+	//   - BackForwardCacheNotRestoredReason.IgnoreEventAndEvict
+	//   - BackForwardCacheNotRestoredReason.HaveInnerContents
+	//   - BackForwardCacheNotRestoredReason.TimeoutPuttingInCache
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestRedirected
+	//   - BackForwardCacheNotRestoredReason.NetworkRequestTimeout
+	//   - BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit
+	//   - BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring
+	//   - BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender
+	//   - BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers
+	//   - BackForwardCacheNotRestoredReason.ForegroundCacheLimit
+	//   - BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped
+	//   - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate
+	//   - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame
+	//   - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame
+	//   - BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified
+	//   - BackForwardCacheNotRestoredReason.NoResponseHead
+	//   - BackForwardCacheNotRestoredReason.Unknown
+	//   - BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857
+	//   - BackForwardCacheNotRestoredReason.ErrorDocument
+	//   - BackForwardCacheNotRestoredReason.FencedFramesEmbedder
+	//   - BackForwardCacheNotRestoredReason.CookieDisabled
+	//   - BackForwardCacheNotRestoredReason.HTTPAuthRequired
+	//   - BackForwardCacheNotRestoredReason.CookieFlushed
+	//   - BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage
+	//   - BackForwardCacheNotRestoredReason.WebViewSettingsChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected
+	//   - BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged
+	//   - BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged
+	//   - BackForwardCacheNotRestoredReason.WebSocket
+	//   - BackForwardCacheNotRestoredReason.WebTransport
+	//   - BackForwardCacheNotRestoredReason.WebRTC
+	//   - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache
+	//   - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore
+	//   - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache
+	//   - BackForwardCacheNotRestoredReason.ContainsPlugins
+	//   - BackForwardCacheNotRestoredReason.DocumentLoaded
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers
+	//   - BackForwardCacheNotRestoredReason.RequestedMIDIPermission
+	//   - BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission
+	//   - BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission
+	//   - BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors
+	//   - BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission
+	//   - BackForwardCacheNotRestoredReason.BroadcastChannel
+	//   - BackForwardCacheNotRestoredReason.WebXR
+	//   - BackForwardCacheNotRestoredReason.SharedWorker
+	//   - BackForwardCacheNotRestoredReason.SharedWorkerMessage
+	//   - BackForwardCacheNotRestoredReason.WebLocks
+	//   - BackForwardCacheNotRestoredReason.WebHID
+	//   - BackForwardCacheNotRestoredReason.WebShare
+	//   - BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant
+	//   - BackForwardCacheNotRestoredReason.WebNfc
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR
+	//   - BackForwardCacheNotRestoredReason.AppBanner
+	//   - BackForwardCacheNotRestoredReason.Printing
+	//   - BackForwardCacheNotRestoredReason.WebDatabase
+	//   - BackForwardCacheNotRestoredReason.PictureInPicture
+	//   - BackForwardCacheNotRestoredReason.SpeechRecognizer
+	//   - BackForwardCacheNotRestoredReason.IdleManager
+	//   - BackForwardCacheNotRestoredReason.PaymentManager
+	//   - BackForwardCacheNotRestoredReason.SpeechSynthesis
+	//   - BackForwardCacheNotRestoredReason.KeyboardLock
+	//   - BackForwardCacheNotRestoredReason.WebOTPService
+	//   - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket
+	//   - BackForwardCacheNotRestoredReason.InjectedJavascript
+	//   - BackForwardCacheNotRestoredReason.InjectedStyleSheet
+	//   - BackForwardCacheNotRestoredReason.KeepaliveRequest
+	//   - BackForwardCacheNotRestoredReason.IndexedDBEvent
+	//   - BackForwardCacheNotRestoredReason.Dummy
+	//   - BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource
+	//   - BackForwardCacheNotRestoredReason.WebRTCSticky
+	//   - BackForwardCacheNotRestoredReason.WebTransportSticky
+	//   - BackForwardCacheNotRestoredReason.WebSocketSticky
+	//   - BackForwardCacheNotRestoredReason.SmartCard
+	//   - BackForwardCacheNotRestoredReason.LiveMediaStreamTrack
+	//   - BackForwardCacheNotRestoredReason.UnloadHandler
+	//   - BackForwardCacheNotRestoredReason.ParserAborted
+	//   - BackForwardCacheNotRestoredReason.ContentSecurityHandler
+	//   - BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI
+	//   - BackForwardCacheNotRestoredReason.ContentFileChooser
+	//   - BackForwardCacheNotRestoredReason.ContentSerial
+	//   - BackForwardCacheNotRestoredReason.ContentFileSystemAccess
+	//   - BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost
+	//   - BackForwardCacheNotRestoredReason.ContentWebBluetooth
+	//   - BackForwardCacheNotRestoredReason.ContentWebUSB
+	//   - BackForwardCacheNotRestoredReason.ContentMediaSessionService
+	//   - BackForwardCacheNotRestoredReason.ContentScreenReader
+	//   - BackForwardCacheNotRestoredReason.ContentDiscarded
+	//   - BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper
+	//   - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker
+	//   - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails
+	//   - BackForwardCacheNotRestoredReason.EmbedderAppBannerManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource
+	//   - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate
+	//   - BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper
+	//   - BackForwardCacheNotRestoredReason.EmbedderOfflinePage
+	//   - BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager
+	//   - BackForwardCacheNotRestoredReason.EmbedderModalDialog
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensions
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort
+	//   - BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame
+	//   - BackForwardCacheNotRestoredReason.RequestedByWebViewClient
+	//   - BackForwardCacheNotRestoredReason.PostMessageByWebViewClient
+	//   - BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated
+	//   - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure
+	//   - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure
+	Reason BackForwardCacheNotRestoredReasonEnum `json:"reason"`
+
+	// Optional. Context associated with the reason. The meaning of this context is
+	// dependent on the reason:
+	// - EmbedderExtensionSentMessageToCachedFrame: the extension ID.
+	Context string `json:"context,omitempty"`
+
+	// Optional.
+	Details []*BackForwardCacheBlockingDetails `json:"details,omitempty"`
+}
+
+/*
+BackForwardCacheNotRestoredExplanationTree represents the
+Page.BackForwardCacheNotRestoredExplanationTree type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-BackForwardCacheNotRestoredExplanationTree
+*/
+type BackForwardCacheNotRestoredExplanationTree struct {
+	// URL of each frame
+	URL string `json:"url"`
+
+	// Not restored reasons of each frame
+	Explanations []*BackForwardCacheNotRestoredExplanation `json:"explanations"`
+
+	// Array of children frame
+	Children []*BackForwardCacheNotRestoredExplanationTree `json:"children"`
 }
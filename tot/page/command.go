@@ -1,7 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"github.com/mkenney/go-chrome/tot/debugger"
+	"github.com/mkenney/go-chrome/tot/io"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
 
@@ -28,18 +31,30 @@ type AddScriptToEvaluateOnLoadResult struct {
 }
 
 /*
-AddScriptToEvaluateOnNewDocumentParams represents Page.addScriptToEvaluateOnNewDocument
-parameters.
+AddScriptToEvaluateOnNewDocumentParams represents Page.addScriptToEvaluateOnNewDocument parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnNewDocument
 */
 type AddScriptToEvaluateOnNewDocumentParams struct {
 	Source string `json:"source"`
+
+	// Optional. If specified, creates an isolated world with the given name and
+	// evaluates given script in it. This world name will be used as the
+	// ExecutionContextDescription::name when the corresponding event is emitted.
+	// EXPERIMENTAL.
+	WorldName *string `json:"worldName,omitempty"`
+
+	// Optional. Specifies whether command line API should be available to the
+	// script, defaults to false. EXPERIMENTAL.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+
+	// Optional. If true, runs the script immediately on existing execution
+	// contexts or worlds. Default: false. EXPERIMENTAL.
+	RunImmediately *bool `json:"runImmediately,omitempty"`
 }
 
 /*
-AddScriptToEvaluateOnNewDocumentResult represents the result of calls to
-Page.addScriptToEvaluateOnNewDocument.
+AddScriptToEvaluateOnNewDocumentResult represents the result of calls to Page.addScriptToEvaluateOnNewDocument.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnNewDocument
 */
@@ -68,19 +83,28 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScree
 */
 type CaptureScreenshotParams struct {
 	// Optional. Image compression format (defaults to png). Allowed values:
-	//	- Format.Jpeg
-	//	- Format.Png
+	//   - Format.Jpeg
+	//   - Format.Png
+	//   - Format.Webp
 	Format FormatEnum `json:"format,omitempty"`
 
 	// Optional. Compression quality from range [0..100] (jpeg only).
-	Quality int `json:"quality,omitempty"`
+	Quality *int `json:"quality,omitempty"`
 
 	// Optional. Capture the screenshot of a given region only.
 	Clip *Viewport `json:"clip,omitempty"`
 
 	// Optional. Capture the screenshot from the surface, rather than the view.
 	// Defaults to true. EXPERIMENTAL.
-	FromSurface bool `json:"fromSurface,omitempty"`
+	FromSurface *bool `json:"fromSurface,omitempty"`
+
+	// Optional. Capture the screenshot beyond the viewport. Defaults to false.
+	// EXPERIMENTAL.
+	CaptureBeyondViewport *bool `json:"captureBeyondViewport,omitempty"`
+
+	// Optional. Optimize image encoding for speed, not for resulting size
+	// (defaults to false) EXPERIMENTAL.
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
 }
 
 /*
@@ -89,28 +113,83 @@ CaptureScreenshotResult represents the result of calls to Page.captureScreenshot
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot
 */
 type CaptureScreenshotResult struct {
-	// Base64-encoded image data.
+	// Base64-encoded image data. (Encoded as a base64 string when passed over
+	// JSON)
 	Data string `json:"data"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+CaptureSnapshotParams represents Page.captureSnapshot parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureSnapshot
+*/
+type CaptureSnapshotParams struct {
+	// Optional. Format (defaults to mhtml). Allowed values:
+	//   - CaptureSnapshotFormat.Mhtml
+	Format CaptureSnapshotFormatEnum `json:"format,omitempty"`
+}
+
+/*
+CaptureSnapshotResult represents the result of calls to Page.captureSnapshot.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureSnapshot
+*/
+type CaptureSnapshotResult struct {
+	// Serialized page data.
+	Data string `json:"data"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearDeviceMetricsOverrideResult represents the result of calls to Page.clearDeviceMetricsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearDeviceMetricsOverride
+*/
+type ClearDeviceMetricsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearDeviceOrientationOverrideResult represents the result of calls to Page.clearDeviceOrientationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearDeviceOrientationOverride
+*/
+type ClearDeviceOrientationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearGeolocationOverrideResult represents the result of calls to Page.clearGeolocationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearGeolocationOverride
+*/
+type ClearGeolocationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 CreateIsolatedWorldParams represents Page.createIsolatedWorld parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-createIsolatedWorld
 */
 type CreateIsolatedWorldParams struct {
-	// ID of the frame in which the isolated world should be created.
+	// Id of the frame in which the isolated world should be created.
 	FrameID FrameID `json:"frameId"`
 
 	// Optional. An optional name which is reported in the Execution Context.
-	WorldName string `json:"worldName,omitempty"`
+	WorldName *string `json:"worldName,omitempty"`
 
-	// Optional. Whether or not universal access should be granted to the
-	// isolated world. This is a powerful option, use with caution.
-	GrantUniveralAccess bool `json:"grantUniveralAccess,omitempty"`
+	// Optional. Whether or not universal access should be granted to the isolated
+	// world. This is a powerful option, use with caution.
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
 }
 
 /*
@@ -126,6 +205,29 @@ type CreateIsolatedWorldResult struct {
 	Err error `json:"-"`
 }
 
+/*
+DeleteCookieParams represents Page.deleteCookie parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-deleteCookie
+*/
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+}
+
+/*
+DeleteCookieResult represents the result of calls to Page.deleteCookie.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-deleteCookie
+*/
+type DeleteCookieResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 DisableResult represents the result of calls to Page.disable.
 
@@ -136,6 +238,18 @@ type DisableResult struct {
 	Err error `json:"-"`
 }
 
+/*
+EnableParams represents Page.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-enable
+*/
+type EnableParams struct {
+	// Optional. If true, the `Page.fileChooserOpened` event will be emitted
+	// regardless of the state set by `Page.setInterceptFileChooserDialog` command
+	// (default: false). EXPERIMENTAL.
+	EnableFileChooserOpenedEvent *bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
 /*
 EnableResult represents the result of calls to Page.enable.
 
@@ -152,14 +266,17 @@ GetAppManifestParams represents Page.getAppManifest parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManifest
 */
 type GetAppManifestParams struct {
-	// Manifest location.
+	// Optional.
+	ManifestID *string `json:"manifestId,omitempty"`
+
+	// Manifest location. DEPRECATED.
 	URL string `json:"url"`
 
-	// Errors.
+	// Errors. DEPRECATED.
 	Errors []*AppManifestError `json:"errors"`
 
-	// Optional. Manifest content.
-	Data string `json:"data,omitempty"`
+	// Optional. Manifest content. DEPRECATED.
+	Data *string `json:"data,omitempty"`
 }
 
 /*
@@ -168,6 +285,90 @@ GetAppManifestResult represents the result of calls to Page.getAppManifest.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManifest
 */
 type GetAppManifestResult struct {
+	// Manifest location.
+	URL string `json:"url"`
+
+	Errors []*AppManifestError `json:"errors"`
+
+	// Optional. Manifest content.
+	Data string `json:"data,omitempty"`
+
+	// Optional. Parsed manifest properties. Deprecated, use manifest instead.
+	// EXPERIMENTAL. DEPRECATED.
+	Parsed *AppManifestParsedProperties `json:"parsed,omitempty"`
+
+	// EXPERIMENTAL.
+	Manifest *WebAppManifest `json:"manifest"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetInstallabilityErrorsResult represents the result of calls to Page.getInstallabilityErrors.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getInstallabilityErrors
+*/
+type GetInstallabilityErrorsResult struct {
+	InstallabilityErrors []*InstallabilityError `json:"installabilityErrors"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetManifestIconsResult represents the result of calls to Page.getManifestIcons.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getManifestIcons
+*/
+type GetManifestIconsResult struct {
+	// Optional.
+	PrimaryIcon string `json:"primaryIcon,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAppIDResult represents the result of calls to Page.getAppId.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppId
+*/
+type GetAppIDResult struct {
+	// Optional. App id, either from manifest's id attribute or computed from
+	// start_url
+	AppID string `json:"appId,omitempty"`
+
+	// Optional. Recommendation for manifest's id attribute to match current id
+	// computed from start_url
+	RecommendedID string `json:"recommendedId,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAdScriptAncestryParams represents Page.getAdScriptAncestry parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAdScriptAncestry
+*/
+type GetAdScriptAncestryParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+/*
+GetAdScriptAncestryResult represents the result of calls to Page.getAdScriptAncestry.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAdScriptAncestry
+*/
+type GetAdScriptAncestryResult struct {
+	// Optional. The ancestry chain of ad script identifiers leading to this
+	// frame's creation, along with the root script's filterlist rule. The ancestry
+	// chain is ordered from the most immediate script (in the frame creation
+	// stack) to more distant ancestors (that created the immediately preceding
+	// script). Only sent if frame is labelled as an ad and ids are available.
+	AdScriptAncestry *AdScriptAncestry `json:"adScriptAncestry,omitempty"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -191,15 +392,27 @@ GetLayoutMetricsResult represents the result of calls to Page.getLayoutMetrics.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getLayoutMetrics
 */
 type GetLayoutMetricsResult struct {
-	// Metrics relating to the layout viewport.
+	// Deprecated metrics relating to the layout viewport. Is in device pixels. Use
+	// `cssLayoutViewport` instead. DEPRECATED.
 	LayoutViewport *LayoutViewport `json:"layoutViewport"`
 
-	// Metrics relating to the visual viewport.
+	// Deprecated metrics relating to the visual viewport. Is in device pixels. Use
+	// `cssVisualViewport` instead. DEPRECATED.
 	VisualViewport *VisualViewport `json:"visualViewport"`
 
-	// Size of scrollable area. Rect is a local implementation of DOM.Rect
+	// Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+	// DEPRECATED.
 	ContentSize *Rect `json:"contentSize"`
 
+	// Metrics relating to the layout viewport in CSS pixels.
+	CSSLayoutViewport *LayoutViewport `json:"cssLayoutViewport"`
+
+	// Metrics relating to the visual viewport in CSS pixels.
+	CSSVisualViewport *VisualViewport `json:"cssVisualViewport"`
+
+	// Size of scrollable area in CSS pixels.
+	CSSContentSize *Rect `json:"cssContentSize"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -220,13 +433,23 @@ type GetNavigationHistoryResult struct {
 	Err error `json:"-"`
 }
 
+/*
+ResetNavigationHistoryResult represents the result of calls to Page.resetNavigationHistory.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-resetNavigationHistory
+*/
+type ResetNavigationHistoryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetResourceContentParams represents Page.getResourceContent parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceContent
 */
 type GetResourceContentParams struct {
-	// Frame ID to get resource for.
+	// Frame id to get resource for.
 	FrameID FrameID `json:"frameId"`
 
 	// URL of the resource to get content for.
@@ -273,7 +496,7 @@ type HandleJavaScriptDialogParams struct {
 
 	// Optional. The text to enter into the dialog prompt before accepting. Used
 	// only if this is a prompt dialog.
-	PromptText string `json:"promptText,omitempty"`
+	PromptText *string `json:"promptText,omitempty"`
 }
 
 /*
@@ -296,10 +519,24 @@ type NavigateParams struct {
 	URL string `json:"url"`
 
 	// Optional. Referrer URL.
-	Referrer string `json:"referrer,omitempty"`
+	Referrer *string `json:"referrer,omitempty"`
 
 	// Optional. Intended transition type.
-	TransitionType TransitionType `json:"transitionType,omitempty"`
+	TransitionType *TransitionType `json:"transitionType,omitempty"`
+
+	// Optional. Frame id to navigate, if not specified navigates the top frame.
+	FrameID *FrameID `json:"frameId,omitempty"`
+
+	// Optional. Referrer-policy used for the navigation. EXPERIMENTAL. Allowed values:
+	//   - ReferrerPolicy.NoReferrer
+	//   - ReferrerPolicy.NoReferrerWhenDowngrade
+	//   - ReferrerPolicy.Origin
+	//   - ReferrerPolicy.OriginWhenCrossOrigin
+	//   - ReferrerPolicy.SameOrigin
+	//   - ReferrerPolicy.StrictOrigin
+	//   - ReferrerPolicy.StrictOriginWhenCrossOrigin
+	//   - ReferrerPolicy.UnsafeURL
+	ReferrerPolicy ReferrerPolicyEnum `json:"referrerPolicy,omitempty"`
 }
 
 /*
@@ -308,14 +545,19 @@ NavigateResult represents the result of calls to Page.navigate.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigate
 */
 type NavigateResult struct {
-	// Frame ID that has navigated (or failed to navigate).
+	// Frame id that has navigated (or failed to navigate)
 	FrameID FrameID `json:"frameId"`
 
-	// Loader identifier.
-	LoaderID LoaderID `json:"loaderId"`
+	// Optional. Loader identifier. This is omitted in case of same-document
+	// navigation, as the previously committed loaderId would not change.
+	LoaderID LoaderID `json:"loaderId,omitempty"`
 
-	// User friendly error message, present if and only if navigation has failed.
-	ErrorText string `json:"errorText"`
+	// Optional. User friendly error message, present if and only if navigation has
+	// failed.
+	ErrorText string `json:"errorText,omitempty"`
+
+	// Optional. Whether the navigation resulted in a download. EXPERIMENTAL.
+	IsDownload bool `json:"isDownload,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -327,7 +569,7 @@ NavigateToHistoryEntryParams represents Page.navigateToHistoryEntry parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigateToHistoryEntry
 */
 type NavigateToHistoryEntryParams struct {
-	// Unique ID of the entry to navigate to.
+	// Unique id of the entry to navigate to.
 	EntryID int `json:"entryId"`
 }
 
@@ -348,42 +590,80 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
 */
 type PrintToPDFParams struct {
 	// Optional. Paper orientation. Defaults to false.
-	Landscape bool `json:"landscape,omitempty"`
+	Landscape *bool `json:"landscape,omitempty"`
 
 	// Optional. Display header and footer. Defaults to false.
-	DisplayHeaderFooter bool `json:"displayHeaderFooter,omitempty"`
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
 
 	// Optional. Print background graphics. Defaults to false.
-	PrintBackground bool `json:"printBackground,omitempty"`
+	PrintBackground *bool `json:"printBackground,omitempty"`
 
 	// Optional. Scale of the webpage rendering. Defaults to 1.
-	Scale float64 `json:"scale,omitempty"`
+	Scale *float64 `json:"scale,omitempty"`
 
 	// Optional. Paper width in inches. Defaults to 8.5 inches.
-	PaperWidth float64 `json:"paperWidth,omitempty"`
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
 
 	// Optional. Paper height in inches. Defaults to 11 inches.
-	PaperHeight float64 `json:"paperHeight,omitempty"`
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
 
 	// Optional. Top margin in inches. Defaults to 1cm (~0.4 inches).
-	MarginTop float64 `json:"marginTop,omitempty"`
+	MarginTop *float64 `json:"marginTop,omitempty"`
 
 	// Optional. Bottom margin in inches. Defaults to 1cm (~0.4 inches).
-	MarginBottom float64 `json:"marginBottom,omitempty"`
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
 
 	// Optional. Left margin in inches. Defaults to 1cm (~0.4 inches).
-	MarginLeft float64 `json:"marginLeft,omitempty"`
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
 
 	// Optional. Right margin in inches. Defaults to 1cm (~0.4 inches).
-	MarginRight float64 `json:"marginRight,omitempty"`
-
-	// Optional. Paper ranges to print, e.g., '1-5, 8, 11-13'. Defaults to the
-	// empty string, which means print all pages.
-	PageRanges string `json:"pageRanges,omitempty"`
+	MarginRight *float64 `json:"marginRight,omitempty"`
+
+	// Optional. Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are
+	// printed in the document order, not in the order specified, and no more than
+	// once. Defaults to empty string, which implies the entire document is
+	// printed. The page numbers are quietly capped to actual page count of the
+	// document, and ranges beyond the end of the document are ignored. If this
+	// results in no pages to print, an error is reported. It is an error to
+	// specify a range with start greater than end.
+	PageRanges *string `json:"pageRanges,omitempty"`
+
+	// Optional. HTML template for the print header. Should be valid HTML markup
+	// with following classes used to inject printing values into them:
+	// - `date`: formatted print date
+	// - `title`: document title
+	// - `url`: document location
+	// - `pageNumber`: current page number
+	// - `totalPages`: total pages in the document
+	//
+	// For example, `<span class=title></span>` would generate span containing the
+	// title.
+	HeaderTemplate *string `json:"headerTemplate,omitempty"`
+
+	// Optional. HTML template for the print footer. Should use the same format as
+	// the `headerTemplate`.
+	FooterTemplate *string `json:"footerTemplate,omitempty"`
+
+	// Optional. Whether or not to prefer page size as defined by css. Defaults to
+	// false, in which case the content will be scaled to fit the paper size.
+	PreferCSSPageSize *bool `json:"preferCSSPageSize,omitempty"`
+
+	// Optional. return as stream EXPERIMENTAL. Allowed values:
+	//   - TransferMode.ReturnAsBase64
+	//   - TransferMode.ReturnAsStream
+	TransferMode TransferModeEnum `json:"transferMode,omitempty"`
+
+	// Optional. Whether or not to generate tagged (accessible) PDF. Defaults to
+	// embedder choice. EXPERIMENTAL.
+	GenerateTaggedPDF *bool `json:"generateTaggedPDF,omitempty"`
+
+	// Optional. Whether or not to embed the document outline into the PDF.
+	// EXPERIMENTAL.
+	GenerateDocumentOutline *bool `json:"generateDocumentOutline,omitempty"`
 
 	// Optional. Whether to silently ignore invalid but successfully parsed page
-	// ranges, such as '3-2'. Defaults to false.
-	IgnoreInvalidPageRanges bool `json:"ignoreInvalidPageRanges,omitempty"`
+	// ranges, such as '3-2'. Defaults to false. DEPRECATED.
+	IgnoreInvalidPageRanges *bool `json:"ignoreInvalidPageRanges,omitempty"`
 }
 
 /*
@@ -392,9 +672,14 @@ PrintToPDFResult represents the result of calls to Page.printToPDF.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
 */
 type PrintToPDFResult struct {
-	// Base64-encoded pdf data.
+	// Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as
+	// a base64 string when passed over JSON)
 	Data string `json:"data"`
 
+	// Optional. A handle of the stream that holds resulting PDF data.
+	// EXPERIMENTAL.
+	Stream io.StreamHandle `json:"stream,omitempty"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -407,11 +692,18 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-reload
 type ReloadParams struct {
 	// Optional. If true, browser cache is ignored (as if the user pressed
 	// Shift+refresh).
-	IgnoreCache bool `json:"ignoreCache,omitempty"`
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
 
 	// Optional. If set, the script will be injected into all frames of the
-	// inspected page after reload.
-	ScriptToEvaluateOnLoad string `json:"scriptToEvaluateOnLoad,omitempty"`
+	// inspected page after reload. Argument will be ignored if reloading dataURL
+	// origin.
+	ScriptToEvaluateOnLoad *string `json:"scriptToEvaluateOnLoad,omitempty"`
+
+	// Optional. If set, an error will be thrown if the target page's main frame's
+	// loader id does not match the provided id. This prevents accidentally
+	// reloading an unintended target in case there's a racing navigation.
+	// EXPERIMENTAL.
+	LoaderID *LoaderID `json:"loaderId,omitempty"`
 }
 
 /*
@@ -434,8 +726,7 @@ type RemoveScriptToEvaluateOnLoadParams struct {
 }
 
 /*
-RemoveScriptToEvaluateOnLoadResult represents the result of calls to
-Page.removeScriptToEvaluateOnLoad.
+RemoveScriptToEvaluateOnLoadResult represents the result of calls to Page.removeScriptToEvaluateOnLoad.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnLoad
 */
@@ -445,8 +736,7 @@ type RemoveScriptToEvaluateOnLoadResult struct {
 }
 
 /*
-RemoveScriptToEvaluateOnNewDocumentParams represents Page.removeScriptToEvaluateOnNewDocument
-parameters.
+RemoveScriptToEvaluateOnNewDocumentParams represents Page.removeScriptToEvaluateOnNewDocument parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnNewDocument
 */
@@ -455,8 +745,7 @@ type RemoveScriptToEvaluateOnNewDocumentParams struct {
 }
 
 /*
-RemoveScriptToEvaluateOnNewDocumentResult represents the result of calls to
-Page.removeScriptToEvaluateOnNewDocument.
+RemoveScriptToEvaluateOnNewDocumentResult represents the result of calls to Page.removeScriptToEvaluateOnNewDocument.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnNewDocument
 */
@@ -465,16 +754,6 @@ type RemoveScriptToEvaluateOnNewDocumentResult struct {
 	Err error `json:"-"`
 }
 
-/*
-RequestAppBannerResult represents the result of calls to Page.requestAppBanner.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner
-*/
-type RequestAppBannerResult struct {
-	// Error information related to executing this method
-	Err error `json:"-"`
-}
-
 /*
 ScreencastFrameAckParams represents Page.screencastFrameAck parameters.
 
@@ -501,7 +780,7 @@ SearchInResourceParams represents Page.searchInResource parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-searchInResource
 */
 type SearchInResourceParams struct {
-	// Frame ID for resource to search in.
+	// Frame id for resource to search in.
 	FrameID FrameID `json:"frameId"`
 
 	// URL of the resource to search in.
@@ -511,10 +790,10 @@ type SearchInResourceParams struct {
 	Query string `json:"query"`
 
 	// Optional. If true, search is case sensitive.
-	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
 
 	// Optional. If true, treats string parameter as regex.
-	IsRegex bool `json:"isRegex,omitempty"`
+	IsRegex *bool `json:"isRegex,omitempty"`
 }
 
 /*
@@ -551,23 +830,196 @@ type SetAdBlockingEnabledResult struct {
 }
 
 /*
-SetAutoAttachToCreatedPagesParams represents Page.setAutoAttachToCreatedPages parameters.
+SetBypassCSPParams represents Page.setBypassCSP parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setBypassCSP
 */
-type SetAutoAttachToCreatedPagesParams struct {
-	// If true, browser will open a new inspector window for every page created
-	// from this one.
-	AutoAttach bool `json:"autoAttach"`
+type SetBypassCSPParams struct {
+	// Whether to bypass page CSP.
+	Enabled bool `json:"enabled"`
 }
 
 /*
-SetAutoAttachToCreatedPagesResult represents the result of calls to
-Page.setAutoAttachToCreatedPages.
+SetBypassCSPResult represents the result of calls to Page.setBypassCSP.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setBypassCSP
 */
-type SetAutoAttachToCreatedPagesResult struct {
+type SetBypassCSPResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetPermissionsPolicyStateParams represents Page.getPermissionsPolicyState parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getPermissionsPolicyState
+*/
+type GetPermissionsPolicyStateParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+/*
+GetPermissionsPolicyStateResult represents the result of calls to Page.getPermissionsPolicyState.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getPermissionsPolicyState
+*/
+type GetPermissionsPolicyStateResult struct {
+	States []*PermissionsPolicyFeatureState `json:"states"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetOriginTrialsParams represents Page.getOriginTrials parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getOriginTrials
+*/
+type GetOriginTrialsParams struct {
+	FrameID FrameID `json:"frameId"`
+}
+
+/*
+GetOriginTrialsResult represents the result of calls to Page.getOriginTrials.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getOriginTrials
+*/
+type GetOriginTrialsResult struct {
+	OriginTrials []*OriginTrial `json:"originTrials"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDeviceMetricsOverrideParams represents Page.setDeviceMetricsOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceMetricsOverride
+*/
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Width int `json:"width"`
+
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables
+	// the override.
+	Height int `json:"height"`
+
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay
+	// scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+
+	// Optional. Scale to apply to resulting view image.
+	Scale *float64 `json:"scale,omitempty"`
+
+	// Optional. Overriding screen width value in pixels (minimum 0, maximum
+	// 10000000).
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+
+	// Optional. Overriding screen height value in pixels (minimum 0, maximum
+	// 10000000).
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+
+	// Optional. Overriding view X position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	PositionX *int `json:"positionX,omitempty"`
+
+	// Optional. Overriding view Y position on screen in pixels (minimum 0, maximum
+	// 10000000).
+	PositionY *int `json:"positionY,omitempty"`
+
+	// Optional. Do not set visible view size, rely upon explicit setVisibleSize
+	// call.
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+
+	// Optional. Screen orientation override.
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+
+	// Optional. The viewport dimensions and scale. If not set, the override is
+	// cleared.
+	Viewport *Viewport `json:"viewport,omitempty"`
+}
+
+/*
+SetDeviceMetricsOverrideResult represents the result of calls to Page.setDeviceMetricsOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceMetricsOverride
+*/
+type SetDeviceMetricsOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetDeviceOrientationOverrideParams represents Page.setDeviceOrientationOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceOrientationOverride
+*/
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+
+	// Mock beta
+	Beta float64 `json:"beta"`
+
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+}
+
+/*
+SetDeviceOrientationOverrideResult represents the result of calls to Page.setDeviceOrientationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceOrientationOverride
+*/
+type SetDeviceOrientationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetFontFamiliesParams represents Page.setFontFamilies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontFamilies
+*/
+type SetFontFamiliesParams struct {
+	// Specifies font families to set. If a font family is not specified, it won't
+	// be changed.
+	FontFamilies *FontFamilies `json:"fontFamilies"`
+
+	// Optional. Specifies font families to set for individual scripts.
+	ForScripts []*ScriptFontFamilies `json:"forScripts,omitempty"`
+}
+
+/*
+SetFontFamiliesResult represents the result of calls to Page.setFontFamilies.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontFamilies
+*/
+type SetFontFamiliesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetFontSizesParams represents Page.setFontSizes parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontSizes
+*/
+type SetFontSizesParams struct {
+	// Specifies font sizes to set. If a font size is not specified, it won't be
+	// changed.
+	FontSizes *FontSizes `json:"fontSizes"`
+}
+
+/*
+SetFontSizesResult represents the result of calls to Page.setFontSizes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontSizes
+*/
+type SetFontSizesResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -578,7 +1030,7 @@ SetDocumentContentParams represents Page.setDocumentContent parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentContent
 */
 type SetDocumentContentParams struct {
-	// Frame ID to set HTML for.
+	// Frame id to set HTML for.
 	FrameID FrameID `json:"frameId"`
 
 	// HTML content to set.
@@ -603,14 +1055,14 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDownloadB
 type SetDownloadBehaviorParams struct {
 	// Whether to allow all or deny all download requests, or use default Chrome
 	// behavior if available (otherwise deny). Allowed values:
-	//	- Behavior.Deny
-	//	- Behavior.Allow
-	//	- Behavior.Default
+	//   - Behavior.Deny
+	//   - Behavior.Allow
+	//   - Behavior.Default
 	Behavior BehaviorEnum `json:"behavior"`
 
-	// Optional. The default path to save downloaded files to. This is required
-	// if behavior is set to 'allow'.
-	DownloadPath string `json:"downloadPath,omitempty"`
+	// Optional. The default path to save downloaded files to. This is required if
+	// behavior is set to 'allow'
+	DownloadPath *string `json:"downloadPath,omitempty"`
 }
 
 /*
@@ -623,6 +1075,32 @@ type SetDownloadBehaviorResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetGeolocationOverrideParams represents Page.setGeolocationOverride parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setGeolocationOverride
+*/
+type SetGeolocationOverrideParams struct {
+	// Optional. Mock latitude
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Optional. Mock longitude
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Optional. Mock accuracy
+	Accuracy *float64 `json:"accuracy,omitempty"`
+}
+
+/*
+SetGeolocationOverrideResult represents the result of calls to Page.setGeolocationOverride.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setGeolocationOverride
+*/
+type SetGeolocationOverrideResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetLifecycleEventsEnabledParams represents Page.setLifecycleEventsEnabled parameters.
 
@@ -643,6 +1121,31 @@ type SetLifecycleEventsEnabledResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetTouchEmulationEnabledParams represents Page.setTouchEmulationEnabled parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setTouchEmulationEnabled
+*/
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+
+	// Optional. Touch/gesture events configuration. Default: current platform. Allowed values:
+	//   - Configuration.Mobile
+	//   - Configuration.Desktop
+	Configuration ConfigurationEnum `json:"configuration,omitempty"`
+}
+
+/*
+SetTouchEmulationEnabledResult represents the result of calls to Page.setTouchEmulationEnabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setTouchEmulationEnabled
+*/
+type SetTouchEmulationEnabledResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 StartScreencastParams represents Page.startScreencast parameters.
 
@@ -650,21 +1153,22 @@ https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-startScreenc
 */
 type StartScreencastParams struct {
 	// Optional. Image compression format. Allowed values:
-	//	- Format.Jpeg
-	//	- Format.Png
+	//   - Format.Jpeg
+	//   - Format.Png
+	//   - Format.Webp
 	Format FormatEnum `json:"format,omitempty"`
 
 	// Optional. Compression quality from range [0..100].
-	Quality int `json:"quality,omitempty"`
+	Quality *int `json:"quality,omitempty"`
 
 	// Optional. Maximum screenshot width.
-	MaxWidth int `json:"maxWidth,omitempty"`
+	MaxWidth *int `json:"maxWidth,omitempty"`
 
 	// Optional. Maximum screenshot height.
-	MaxHeight int `json:"maxHeight,omitempty"`
+	MaxHeight *int `json:"maxHeight,omitempty"`
 
 	// Optional. Send every n-th frame.
-	EveryNthFrame int `json:"everyNthFrame,omitempty"`
+	EveryNthFrame *int `json:"everyNthFrame,omitempty"`
 }
 
 /*
@@ -687,6 +1191,48 @@ type StopLoadingResult struct {
 	Err error `json:"-"`
 }
 
+/*
+CrashResult represents the result of calls to Page.crash.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-crash
+*/
+type CrashResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CloseResult represents the result of calls to Page.close.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-close
+*/
+type CloseResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetWebLifecycleStateParams represents Page.setWebLifecycleState parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setWebLifecycleState
+*/
+type SetWebLifecycleStateParams struct {
+	// Target lifecycle state Allowed values:
+	//   - State.Frozen
+	//   - State.Active
+	State StateEnum `json:"state"`
+}
+
+/*
+SetWebLifecycleStateResult represents the result of calls to Page.setWebLifecycleState.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setWebLifecycleState
+*/
+type SetWebLifecycleStateResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 StopScreencastResult represents the result of calls to Page.stopScreencast.
 
@@ -696,3 +1242,209 @@ type StopScreencastResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+ProduceCompilationCacheParams represents Page.produceCompilationCache parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-produceCompilationCache
+*/
+type ProduceCompilationCacheParams struct {
+	Scripts []*CompilationCacheParams `json:"scripts"`
+}
+
+/*
+ProduceCompilationCacheResult represents the result of calls to Page.produceCompilationCache.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-produceCompilationCache
+*/
+type ProduceCompilationCacheResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddCompilationCacheParams represents Page.addCompilationCache parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addCompilationCache
+*/
+type AddCompilationCacheParams struct {
+	URL string `json:"url"`
+
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+}
+
+/*
+AddCompilationCacheResult represents the result of calls to Page.addCompilationCache.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addCompilationCache
+*/
+type AddCompilationCacheResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearCompilationCacheResult represents the result of calls to Page.clearCompilationCache.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearCompilationCache
+*/
+type ClearCompilationCacheResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSPCTransactionModeParams represents Page.setSPCTransactionMode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setSPCTransactionMode
+*/
+type SetSPCTransactionModeParams struct {
+	// Allowed values:
+	//   - Mode.None
+	//   - Mode.AutoAccept
+	//   - Mode.AutoChooseToAuthAnotherWay
+	//   - Mode.AutoReject
+	//   - Mode.AutoOptOut
+	Mode ModeEnum `json:"mode"`
+}
+
+/*
+SetSPCTransactionModeResult represents the result of calls to Page.setSPCTransactionMode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setSPCTransactionMode
+*/
+type SetSPCTransactionModeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetRPHRegistrationModeParams represents Page.setRPHRegistrationMode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setRPHRegistrationMode
+*/
+type SetRPHRegistrationModeParams struct {
+	// Allowed values:
+	//   - SetRPHRegistrationModeMode.None
+	//   - SetRPHRegistrationModeMode.AutoAccept
+	//   - SetRPHRegistrationModeMode.AutoReject
+	Mode SetRPHRegistrationModeModeEnum `json:"mode"`
+}
+
+/*
+SetRPHRegistrationModeResult represents the result of calls to Page.setRPHRegistrationMode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setRPHRegistrationMode
+*/
+type SetRPHRegistrationModeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GenerateTestReportParams represents Page.generateTestReport parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-generateTestReport
+*/
+type GenerateTestReportParams struct {
+	// Message to be displayed in the report.
+	Message string `json:"message"`
+
+	// Optional. Specifies the endpoint group to deliver the report to.
+	Group *string `json:"group,omitempty"`
+}
+
+/*
+GenerateTestReportResult represents the result of calls to Page.generateTestReport.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-generateTestReport
+*/
+type GenerateTestReportResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+WaitForDebuggerResult represents the result of calls to Page.waitForDebugger.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-waitForDebugger
+*/
+type WaitForDebuggerResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetInterceptFileChooserDialogParams represents Page.setInterceptFileChooserDialog parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setInterceptFileChooserDialog
+*/
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+
+	// Optional. If true, cancels the dialog by emitting relevant events (if any)
+	// in addition to not showing it if the interception is enabled (default:
+	// false). EXPERIMENTAL.
+	Cancel *bool `json:"cancel,omitempty"`
+}
+
+/*
+SetInterceptFileChooserDialogResult represents the result of calls to Page.setInterceptFileChooserDialog.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setInterceptFileChooserDialog
+*/
+type SetInterceptFileChooserDialogResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetPrerenderingAllowedParams represents Page.setPrerenderingAllowed parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setPrerenderingAllowed
+*/
+type SetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+/*
+SetPrerenderingAllowedResult represents the result of calls to Page.setPrerenderingAllowed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setPrerenderingAllowed
+*/
+type SetPrerenderingAllowedResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RequestAppBannerResult represents the result of calls to Page.requestAppBanner.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner
+*/
+type RequestAppBannerResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAutoAttachToCreatedPagesParams represents Page.setAutoAttachToCreatedPages parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+*/
+type SetAutoAttachToCreatedPagesParams struct {
+	// If true, browser will open a new inspector window for every page created
+	// from this one.
+	AutoAttach bool `json:"autoAttach"`
+}
+
+/*
+SetAutoAttachToCreatedPagesResult represents the result of calls to Page.setAutoAttachToCreatedPages.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+*/
+type SetAutoAttachToCreatedPagesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type adFrameTypeEnum struct {
+	None  AdFrameTypeEnum
+	Child AdFrameTypeEnum
+	Root  AdFrameTypeEnum
+}
+
+/*
+AdFrameType provides named access to the AdFrameTypeEnum values.
+*/
+var AdFrameType = adFrameTypeEnum{
+	None:  adFrameTypeNone,
+	Child: adFrameTypeChild,
+	Root:  adFrameTypeRoot,
+}
+
+/*
+AdFrameTypeEnum represents the Page.AdFrameType values.
+
+Indicates whether a frame has been identified as an ad.
+
+Allowed values:
+  - AdFrameType.None  "none"
+  - AdFrameType.Child "child"
+  - AdFrameType.Root  "root"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-AdFrameType
+*/
+type AdFrameTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AdFrameTypeEnum) String() string {
+	return _adFrameTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AdFrameTypeEnum) Unknown() bool {
+	return !_adFrameTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AdFrameTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AdFrameTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _adFrameTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// adFrameTypeNone represents the "none" value.
+	adFrameTypeNone AdFrameTypeEnum = iota + 1
+	// adFrameTypeChild represents the "child" value.
+	adFrameTypeChild
+	// adFrameTypeRoot represents the "root" value.
+	adFrameTypeRoot
+)
+
+var _adFrameTypeEnums = enums.New(map[AdFrameTypeEnum]string{
+	AdFrameTypeEnum(0): "",
+	adFrameTypeNone:    "none",
+	adFrameTypeChild:   "child",
+	adFrameTypeRoot:    "root",
+})
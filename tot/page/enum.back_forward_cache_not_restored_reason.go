@@ -0,0 +1,931 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type backForwardCacheNotRestoredReasonEnum struct {
+	NotPrimaryMainFrame                                      BackForwardCacheNotRestoredReasonEnum
+	BackForwardCacheDisabled                                 BackForwardCacheNotRestoredReasonEnum
+	RelatedActiveContentsExist                               BackForwardCacheNotRestoredReasonEnum
+	HTTPStatusNotOK                                          BackForwardCacheNotRestoredReasonEnum
+	SchemeNotHTTPOrHTTPS                                     BackForwardCacheNotRestoredReasonEnum
+	Loading                                                  BackForwardCacheNotRestoredReasonEnum
+	WasGrantedMediaAccess                                    BackForwardCacheNotRestoredReasonEnum
+	DisableForRenderFrameHostCalled                          BackForwardCacheNotRestoredReasonEnum
+	DomainNotAllowed                                         BackForwardCacheNotRestoredReasonEnum
+	HTTPMethodNotGET                                         BackForwardCacheNotRestoredReasonEnum
+	SubframeIsNavigating                                     BackForwardCacheNotRestoredReasonEnum
+	Timeout                                                  BackForwardCacheNotRestoredReasonEnum
+	CacheLimit                                               BackForwardCacheNotRestoredReasonEnum
+	JavaScriptExecution                                      BackForwardCacheNotRestoredReasonEnum
+	RendererProcessKilled                                    BackForwardCacheNotRestoredReasonEnum
+	RendererProcessCrashed                                   BackForwardCacheNotRestoredReasonEnum
+	SchedulerTrackedFeatureUsed                              BackForwardCacheNotRestoredReasonEnum
+	ConflictingBrowsingInstance                              BackForwardCacheNotRestoredReasonEnum
+	CacheFlushed                                             BackForwardCacheNotRestoredReasonEnum
+	ServiceWorkerVersionActivation                           BackForwardCacheNotRestoredReasonEnum
+	SessionRestored                                          BackForwardCacheNotRestoredReasonEnum
+	ServiceWorkerPostMessage                                 BackForwardCacheNotRestoredReasonEnum
+	EnteredBackForwardCacheBeforeServiceWorkerHostAdded      BackForwardCacheNotRestoredReasonEnum
+	RenderFrameHostReusedSameSite                            BackForwardCacheNotRestoredReasonEnum
+	RenderFrameHostReusedCrossSite                           BackForwardCacheNotRestoredReasonEnum
+	ServiceWorkerClaim                                       BackForwardCacheNotRestoredReasonEnum
+	IgnoreEventAndEvict                                      BackForwardCacheNotRestoredReasonEnum
+	HaveInnerContents                                        BackForwardCacheNotRestoredReasonEnum
+	TimeoutPuttingInCache                                    BackForwardCacheNotRestoredReasonEnum
+	BackForwardCacheDisabledByLowMemory                      BackForwardCacheNotRestoredReasonEnum
+	BackForwardCacheDisabledByCommandLine                    BackForwardCacheNotRestoredReasonEnum
+	NetworkRequestDatapipeDrainedAsBytesConsumer             BackForwardCacheNotRestoredReasonEnum
+	NetworkRequestRedirected                                 BackForwardCacheNotRestoredReasonEnum
+	NetworkRequestTimeout                                    BackForwardCacheNotRestoredReasonEnum
+	NetworkExceedsBufferLimit                                BackForwardCacheNotRestoredReasonEnum
+	NavigationCancelledWhileRestoring                        BackForwardCacheNotRestoredReasonEnum
+	NotMostRecentNavigationEntry                             BackForwardCacheNotRestoredReasonEnum
+	BackForwardCacheDisabledForPrerender                     BackForwardCacheNotRestoredReasonEnum
+	UserAgentOverrideDiffers                                 BackForwardCacheNotRestoredReasonEnum
+	ForegroundCacheLimit                                     BackForwardCacheNotRestoredReasonEnum
+	BrowsingInstanceNotSwapped                               BackForwardCacheNotRestoredReasonEnum
+	BackForwardCacheDisabledForDelegate                      BackForwardCacheNotRestoredReasonEnum
+	UnloadHandlerExistsInMainFrame                           BackForwardCacheNotRestoredReasonEnum
+	UnloadHandlerExistsInSubFrame                            BackForwardCacheNotRestoredReasonEnum
+	ServiceWorkerUnregistration                              BackForwardCacheNotRestoredReasonEnum
+	CacheControlNoStore                                      BackForwardCacheNotRestoredReasonEnum
+	CacheControlNoStoreCookieModified                        BackForwardCacheNotRestoredReasonEnum
+	CacheControlNoStoreHTTPOnlyCookieModified                BackForwardCacheNotRestoredReasonEnum
+	NoResponseHead                                           BackForwardCacheNotRestoredReasonEnum
+	Unknown                                                  BackForwardCacheNotRestoredReasonEnum
+	ActivationNavigationsDisallowedForBug1234857             BackForwardCacheNotRestoredReasonEnum
+	ErrorDocument                                            BackForwardCacheNotRestoredReasonEnum
+	FencedFramesEmbedder                                     BackForwardCacheNotRestoredReasonEnum
+	CookieDisabled                                           BackForwardCacheNotRestoredReasonEnum
+	HTTPAuthRequired                                         BackForwardCacheNotRestoredReasonEnum
+	CookieFlushed                                            BackForwardCacheNotRestoredReasonEnum
+	BroadcastChannelOnMessage                                BackForwardCacheNotRestoredReasonEnum
+	WebViewSettingsChanged                                   BackForwardCacheNotRestoredReasonEnum
+	WebViewJavaScriptObjectChanged                           BackForwardCacheNotRestoredReasonEnum
+	WebViewMessageListenerInjected                           BackForwardCacheNotRestoredReasonEnum
+	WebViewSafeBrowsingAllowlistChanged                      BackForwardCacheNotRestoredReasonEnum
+	WebViewDocumentStartJavascriptChanged                    BackForwardCacheNotRestoredReasonEnum
+	WebSocket                                                BackForwardCacheNotRestoredReasonEnum
+	WebTransport                                             BackForwardCacheNotRestoredReasonEnum
+	WebRTC                                                   BackForwardCacheNotRestoredReasonEnum
+	MainResourceHasCacheControlNoStore                       BackForwardCacheNotRestoredReasonEnum
+	MainResourceHasCacheControlNoCache                       BackForwardCacheNotRestoredReasonEnum
+	SubresourceHasCacheControlNoStore                        BackForwardCacheNotRestoredReasonEnum
+	SubresourceHasCacheControlNoCache                        BackForwardCacheNotRestoredReasonEnum
+	ContainsPlugins                                          BackForwardCacheNotRestoredReasonEnum
+	DocumentLoaded                                           BackForwardCacheNotRestoredReasonEnum
+	OutstandingNetworkRequestOthers                          BackForwardCacheNotRestoredReasonEnum
+	RequestedMIDIPermission                                  BackForwardCacheNotRestoredReasonEnum
+	RequestedAudioCapturePermission                          BackForwardCacheNotRestoredReasonEnum
+	RequestedVideoCapturePermission                          BackForwardCacheNotRestoredReasonEnum
+	RequestedBackForwardCacheBlockedSensors                  BackForwardCacheNotRestoredReasonEnum
+	RequestedBackgroundWorkPermission                        BackForwardCacheNotRestoredReasonEnum
+	BroadcastChannel                                         BackForwardCacheNotRestoredReasonEnum
+	WebXR                                                    BackForwardCacheNotRestoredReasonEnum
+	SharedWorker                                             BackForwardCacheNotRestoredReasonEnum
+	SharedWorkerMessage                                      BackForwardCacheNotRestoredReasonEnum
+	WebLocks                                                 BackForwardCacheNotRestoredReasonEnum
+	WebHID                                                   BackForwardCacheNotRestoredReasonEnum
+	WebShare                                                 BackForwardCacheNotRestoredReasonEnum
+	RequestedStorageAccessGrant                              BackForwardCacheNotRestoredReasonEnum
+	WebNfc                                                   BackForwardCacheNotRestoredReasonEnum
+	OutstandingNetworkRequestFetch                           BackForwardCacheNotRestoredReasonEnum
+	OutstandingNetworkRequestXHR                             BackForwardCacheNotRestoredReasonEnum
+	AppBanner                                                BackForwardCacheNotRestoredReasonEnum
+	Printing                                                 BackForwardCacheNotRestoredReasonEnum
+	WebDatabase                                              BackForwardCacheNotRestoredReasonEnum
+	PictureInPicture                                         BackForwardCacheNotRestoredReasonEnum
+	SpeechRecognizer                                         BackForwardCacheNotRestoredReasonEnum
+	IdleManager                                              BackForwardCacheNotRestoredReasonEnum
+	PaymentManager                                           BackForwardCacheNotRestoredReasonEnum
+	SpeechSynthesis                                          BackForwardCacheNotRestoredReasonEnum
+	KeyboardLock                                             BackForwardCacheNotRestoredReasonEnum
+	WebOTPService                                            BackForwardCacheNotRestoredReasonEnum
+	OutstandingNetworkRequestDirectSocket                    BackForwardCacheNotRestoredReasonEnum
+	InjectedJavascript                                       BackForwardCacheNotRestoredReasonEnum
+	InjectedStyleSheet                                       BackForwardCacheNotRestoredReasonEnum
+	KeepaliveRequest                                         BackForwardCacheNotRestoredReasonEnum
+	IndexedDBEvent                                           BackForwardCacheNotRestoredReasonEnum
+	Dummy                                                    BackForwardCacheNotRestoredReasonEnum
+	JSNetworkRequestReceivedCacheControlNoStoreResource      BackForwardCacheNotRestoredReasonEnum
+	WebRTCSticky                                             BackForwardCacheNotRestoredReasonEnum
+	WebTransportSticky                                       BackForwardCacheNotRestoredReasonEnum
+	WebSocketSticky                                          BackForwardCacheNotRestoredReasonEnum
+	SmartCard                                                BackForwardCacheNotRestoredReasonEnum
+	LiveMediaStreamTrack                                     BackForwardCacheNotRestoredReasonEnum
+	UnloadHandler                                            BackForwardCacheNotRestoredReasonEnum
+	ParserAborted                                            BackForwardCacheNotRestoredReasonEnum
+	ContentSecurityHandler                                   BackForwardCacheNotRestoredReasonEnum
+	ContentWebAuthenticationAPI                              BackForwardCacheNotRestoredReasonEnum
+	ContentFileChooser                                       BackForwardCacheNotRestoredReasonEnum
+	ContentSerial                                            BackForwardCacheNotRestoredReasonEnum
+	ContentFileSystemAccess                                  BackForwardCacheNotRestoredReasonEnum
+	ContentMediaDevicesDispatcherHost                        BackForwardCacheNotRestoredReasonEnum
+	ContentWebBluetooth                                      BackForwardCacheNotRestoredReasonEnum
+	ContentWebUSB                                            BackForwardCacheNotRestoredReasonEnum
+	ContentMediaSessionService                               BackForwardCacheNotRestoredReasonEnum
+	ContentScreenReader                                      BackForwardCacheNotRestoredReasonEnum
+	ContentDiscarded                                         BackForwardCacheNotRestoredReasonEnum
+	EmbedderPopupBlockerTabHelper                            BackForwardCacheNotRestoredReasonEnum
+	EmbedderSafeBrowsingTriggeredPopupBlocker                BackForwardCacheNotRestoredReasonEnum
+	EmbedderSafeBrowsingThreatDetails                        BackForwardCacheNotRestoredReasonEnum
+	EmbedderAppBannerManager                                 BackForwardCacheNotRestoredReasonEnum
+	EmbedderDOMDistillerViewerSource                         BackForwardCacheNotRestoredReasonEnum
+	EmbedderDOMDistillerSelfDeletingRequestDelegate          BackForwardCacheNotRestoredReasonEnum
+	EmbedderOomInterventionTabHelper                         BackForwardCacheNotRestoredReasonEnum
+	EmbedderOfflinePage                                      BackForwardCacheNotRestoredReasonEnum
+	EmbedderChromePasswordManagerClientBindCredentialManager BackForwardCacheNotRestoredReasonEnum
+	EmbedderPermissionRequestManager                         BackForwardCacheNotRestoredReasonEnum
+	EmbedderModalDialog                                      BackForwardCacheNotRestoredReasonEnum
+	EmbedderExtensions                                       BackForwardCacheNotRestoredReasonEnum
+	EmbedderExtensionMessaging                               BackForwardCacheNotRestoredReasonEnum
+	EmbedderExtensionMessagingForOpenPort                    BackForwardCacheNotRestoredReasonEnum
+	EmbedderExtensionSentMessageToCachedFrame                BackForwardCacheNotRestoredReasonEnum
+	RequestedByWebViewClient                                 BackForwardCacheNotRestoredReasonEnum
+	PostMessageByWebViewClient                               BackForwardCacheNotRestoredReasonEnum
+	CacheControlNoStoreDeviceBoundSessionTerminated          BackForwardCacheNotRestoredReasonEnum
+	CacheLimitPrunedOnModerateMemoryPressure                 BackForwardCacheNotRestoredReasonEnum
+	CacheLimitPrunedOnCriticalMemoryPressure                 BackForwardCacheNotRestoredReasonEnum
+}
+
+/*
+BackForwardCacheNotRestoredReason provides named access to the BackForwardCacheNotRestoredReasonEnum values.
+*/
+var BackForwardCacheNotRestoredReason = backForwardCacheNotRestoredReasonEnum{
+	NotPrimaryMainFrame:             backForwardCacheNotRestoredReasonNotPrimaryMainFrame,
+	BackForwardCacheDisabled:        backForwardCacheNotRestoredReasonBackForwardCacheDisabled,
+	RelatedActiveContentsExist:      backForwardCacheNotRestoredReasonRelatedActiveContentsExist,
+	HTTPStatusNotOK:                 backForwardCacheNotRestoredReasonHTTPStatusNotOK,
+	SchemeNotHTTPOrHTTPS:            backForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS,
+	Loading:                         backForwardCacheNotRestoredReasonLoading,
+	WasGrantedMediaAccess:           backForwardCacheNotRestoredReasonWasGrantedMediaAccess,
+	DisableForRenderFrameHostCalled: backForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled,
+	DomainNotAllowed:                backForwardCacheNotRestoredReasonDomainNotAllowed,
+	HTTPMethodNotGET:                backForwardCacheNotRestoredReasonHTTPMethodNotGET,
+	SubframeIsNavigating:            backForwardCacheNotRestoredReasonSubframeIsNavigating,
+	Timeout:                         backForwardCacheNotRestoredReasonTimeout,
+	CacheLimit:                      backForwardCacheNotRestoredReasonCacheLimit,
+	JavaScriptExecution:             backForwardCacheNotRestoredReasonJavaScriptExecution,
+	RendererProcessKilled:           backForwardCacheNotRestoredReasonRendererProcessKilled,
+	RendererProcessCrashed:          backForwardCacheNotRestoredReasonRendererProcessCrashed,
+	SchedulerTrackedFeatureUsed:     backForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed,
+	ConflictingBrowsingInstance:     backForwardCacheNotRestoredReasonConflictingBrowsingInstance,
+	CacheFlushed:                    backForwardCacheNotRestoredReasonCacheFlushed,
+	ServiceWorkerVersionActivation:  backForwardCacheNotRestoredReasonServiceWorkerVersionActivation,
+	SessionRestored:                 backForwardCacheNotRestoredReasonSessionRestored,
+	ServiceWorkerPostMessage:        backForwardCacheNotRestoredReasonServiceWorkerPostMessage,
+	EnteredBackForwardCacheBeforeServiceWorkerHostAdded: backForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded,
+	RenderFrameHostReusedSameSite:                       backForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite,
+	RenderFrameHostReusedCrossSite:                      backForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite,
+	ServiceWorkerClaim:                                  backForwardCacheNotRestoredReasonServiceWorkerClaim,
+	IgnoreEventAndEvict:                                 backForwardCacheNotRestoredReasonIgnoreEventAndEvict,
+	HaveInnerContents:                                   backForwardCacheNotRestoredReasonHaveInnerContents,
+	TimeoutPuttingInCache:                               backForwardCacheNotRestoredReasonTimeoutPuttingInCache,
+	BackForwardCacheDisabledByLowMemory:                 backForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory,
+	BackForwardCacheDisabledByCommandLine:               backForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine,
+	NetworkRequestDatapipeDrainedAsBytesConsumer:        backForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer,
+	NetworkRequestRedirected:                            backForwardCacheNotRestoredReasonNetworkRequestRedirected,
+	NetworkRequestTimeout:                               backForwardCacheNotRestoredReasonNetworkRequestTimeout,
+	NetworkExceedsBufferLimit:                           backForwardCacheNotRestoredReasonNetworkExceedsBufferLimit,
+	NavigationCancelledWhileRestoring:                   backForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring,
+	NotMostRecentNavigationEntry:                        backForwardCacheNotRestoredReasonNotMostRecentNavigationEntry,
+	BackForwardCacheDisabledForPrerender:                backForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender,
+	UserAgentOverrideDiffers:                            backForwardCacheNotRestoredReasonUserAgentOverrideDiffers,
+	ForegroundCacheLimit:                                backForwardCacheNotRestoredReasonForegroundCacheLimit,
+	BrowsingInstanceNotSwapped:                          backForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped,
+	BackForwardCacheDisabledForDelegate:                 backForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate,
+	UnloadHandlerExistsInMainFrame:                      backForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame,
+	UnloadHandlerExistsInSubFrame:                       backForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame,
+	ServiceWorkerUnregistration:                         backForwardCacheNotRestoredReasonServiceWorkerUnregistration,
+	CacheControlNoStore:                                 backForwardCacheNotRestoredReasonCacheControlNoStore,
+	CacheControlNoStoreCookieModified:                   backForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified,
+	CacheControlNoStoreHTTPOnlyCookieModified:           backForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified,
+	NoResponseHead:                                      backForwardCacheNotRestoredReasonNoResponseHead,
+	Unknown:                                             backForwardCacheNotRestoredReasonUnknown,
+	ActivationNavigationsDisallowedForBug1234857:        backForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857,
+	ErrorDocument:                                       backForwardCacheNotRestoredReasonErrorDocument,
+	FencedFramesEmbedder:                                backForwardCacheNotRestoredReasonFencedFramesEmbedder,
+	CookieDisabled:                                      backForwardCacheNotRestoredReasonCookieDisabled,
+	HTTPAuthRequired:                                    backForwardCacheNotRestoredReasonHTTPAuthRequired,
+	CookieFlushed:                                       backForwardCacheNotRestoredReasonCookieFlushed,
+	BroadcastChannelOnMessage:                           backForwardCacheNotRestoredReasonBroadcastChannelOnMessage,
+	WebViewSettingsChanged:                              backForwardCacheNotRestoredReasonWebViewSettingsChanged,
+	WebViewJavaScriptObjectChanged:                      backForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged,
+	WebViewMessageListenerInjected:                      backForwardCacheNotRestoredReasonWebViewMessageListenerInjected,
+	WebViewSafeBrowsingAllowlistChanged:                 backForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged,
+	WebViewDocumentStartJavascriptChanged:               backForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged,
+	WebSocket:                                           backForwardCacheNotRestoredReasonWebSocket,
+	WebTransport:                                        backForwardCacheNotRestoredReasonWebTransport,
+	WebRTC:                                              backForwardCacheNotRestoredReasonWebRTC,
+	MainResourceHasCacheControlNoStore:                  backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore,
+	MainResourceHasCacheControlNoCache:                  backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache,
+	SubresourceHasCacheControlNoStore:                   backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore,
+	SubresourceHasCacheControlNoCache:                   backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache,
+	ContainsPlugins:                                     backForwardCacheNotRestoredReasonContainsPlugins,
+	DocumentLoaded:                                      backForwardCacheNotRestoredReasonDocumentLoaded,
+	OutstandingNetworkRequestOthers:                     backForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers,
+	RequestedMIDIPermission:                             backForwardCacheNotRestoredReasonRequestedMIDIPermission,
+	RequestedAudioCapturePermission:                     backForwardCacheNotRestoredReasonRequestedAudioCapturePermission,
+	RequestedVideoCapturePermission:                     backForwardCacheNotRestoredReasonRequestedVideoCapturePermission,
+	RequestedBackForwardCacheBlockedSensors:             backForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors,
+	RequestedBackgroundWorkPermission:                   backForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission,
+	BroadcastChannel:                                    backForwardCacheNotRestoredReasonBroadcastChannel,
+	WebXR:                                               backForwardCacheNotRestoredReasonWebXR,
+	SharedWorker:                                        backForwardCacheNotRestoredReasonSharedWorker,
+	SharedWorkerMessage:                                 backForwardCacheNotRestoredReasonSharedWorkerMessage,
+	WebLocks:                                            backForwardCacheNotRestoredReasonWebLocks,
+	WebHID:                                              backForwardCacheNotRestoredReasonWebHID,
+	WebShare:                                            backForwardCacheNotRestoredReasonWebShare,
+	RequestedStorageAccessGrant:                         backForwardCacheNotRestoredReasonRequestedStorageAccessGrant,
+	WebNfc:                                              backForwardCacheNotRestoredReasonWebNfc,
+	OutstandingNetworkRequestFetch:                      backForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch,
+	OutstandingNetworkRequestXHR:                        backForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR,
+	AppBanner:                                           backForwardCacheNotRestoredReasonAppBanner,
+	Printing:                                            backForwardCacheNotRestoredReasonPrinting,
+	WebDatabase:                                         backForwardCacheNotRestoredReasonWebDatabase,
+	PictureInPicture:                                    backForwardCacheNotRestoredReasonPictureInPicture,
+	SpeechRecognizer:                                    backForwardCacheNotRestoredReasonSpeechRecognizer,
+	IdleManager:                                         backForwardCacheNotRestoredReasonIdleManager,
+	PaymentManager:                                      backForwardCacheNotRestoredReasonPaymentManager,
+	SpeechSynthesis:                                     backForwardCacheNotRestoredReasonSpeechSynthesis,
+	KeyboardLock:                                        backForwardCacheNotRestoredReasonKeyboardLock,
+	WebOTPService:                                       backForwardCacheNotRestoredReasonWebOTPService,
+	OutstandingNetworkRequestDirectSocket:               backForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket,
+	InjectedJavascript:                                  backForwardCacheNotRestoredReasonInjectedJavascript,
+	InjectedStyleSheet:                                  backForwardCacheNotRestoredReasonInjectedStyleSheet,
+	KeepaliveRequest:                                    backForwardCacheNotRestoredReasonKeepaliveRequest,
+	IndexedDBEvent:                                      backForwardCacheNotRestoredReasonIndexedDBEvent,
+	Dummy:                                               backForwardCacheNotRestoredReasonDummy,
+	JSNetworkRequestReceivedCacheControlNoStoreResource: backForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource,
+	WebRTCSticky:                                    backForwardCacheNotRestoredReasonWebRTCSticky,
+	WebTransportSticky:                              backForwardCacheNotRestoredReasonWebTransportSticky,
+	WebSocketSticky:                                 backForwardCacheNotRestoredReasonWebSocketSticky,
+	SmartCard:                                       backForwardCacheNotRestoredReasonSmartCard,
+	LiveMediaStreamTrack:                            backForwardCacheNotRestoredReasonLiveMediaStreamTrack,
+	UnloadHandler:                                   backForwardCacheNotRestoredReasonUnloadHandler,
+	ParserAborted:                                   backForwardCacheNotRestoredReasonParserAborted,
+	ContentSecurityHandler:                          backForwardCacheNotRestoredReasonContentSecurityHandler,
+	ContentWebAuthenticationAPI:                     backForwardCacheNotRestoredReasonContentWebAuthenticationAPI,
+	ContentFileChooser:                              backForwardCacheNotRestoredReasonContentFileChooser,
+	ContentSerial:                                   backForwardCacheNotRestoredReasonContentSerial,
+	ContentFileSystemAccess:                         backForwardCacheNotRestoredReasonContentFileSystemAccess,
+	ContentMediaDevicesDispatcherHost:               backForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost,
+	ContentWebBluetooth:                             backForwardCacheNotRestoredReasonContentWebBluetooth,
+	ContentWebUSB:                                   backForwardCacheNotRestoredReasonContentWebUSB,
+	ContentMediaSessionService:                      backForwardCacheNotRestoredReasonContentMediaSessionService,
+	ContentScreenReader:                             backForwardCacheNotRestoredReasonContentScreenReader,
+	ContentDiscarded:                                backForwardCacheNotRestoredReasonContentDiscarded,
+	EmbedderPopupBlockerTabHelper:                   backForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper,
+	EmbedderSafeBrowsingTriggeredPopupBlocker:       backForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker,
+	EmbedderSafeBrowsingThreatDetails:               backForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails,
+	EmbedderAppBannerManager:                        backForwardCacheNotRestoredReasonEmbedderAppBannerManager,
+	EmbedderDOMDistillerViewerSource:                backForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource,
+	EmbedderDOMDistillerSelfDeletingRequestDelegate: backForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate,
+	EmbedderOomInterventionTabHelper:                backForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper,
+	EmbedderOfflinePage:                             backForwardCacheNotRestoredReasonEmbedderOfflinePage,
+	EmbedderChromePasswordManagerClientBindCredentialManager: backForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager,
+	EmbedderPermissionRequestManager:                         backForwardCacheNotRestoredReasonEmbedderPermissionRequestManager,
+	EmbedderModalDialog:                                      backForwardCacheNotRestoredReasonEmbedderModalDialog,
+	EmbedderExtensions:                                       backForwardCacheNotRestoredReasonEmbedderExtensions,
+	EmbedderExtensionMessaging:                               backForwardCacheNotRestoredReasonEmbedderExtensionMessaging,
+	EmbedderExtensionMessagingForOpenPort:                    backForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort,
+	EmbedderExtensionSentMessageToCachedFrame:                backForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame,
+	RequestedByWebViewClient:                                 backForwardCacheNotRestoredReasonRequestedByWebViewClient,
+	PostMessageByWebViewClient:                               backForwardCacheNotRestoredReasonPostMessageByWebViewClient,
+	CacheControlNoStoreDeviceBoundSessionTerminated:          backForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated,
+	CacheLimitPrunedOnModerateMemoryPressure:                 backForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure,
+	CacheLimitPrunedOnCriticalMemoryPressure:                 backForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure,
+}
+
+/*
+BackForwardCacheNotRestoredReasonEnum represents the
+Page.BackForwardCacheNotRestoredReason values.
+
+List of not restored reasons for back-forward cache.
+
+Allowed values:
+  - BackForwardCacheNotRestoredReason.NotPrimaryMainFrame                                      "NotPrimaryMainFrame"
+  - BackForwardCacheNotRestoredReason.BackForwardCacheDisabled                                 "BackForwardCacheDisabled"
+  - BackForwardCacheNotRestoredReason.RelatedActiveContentsExist                               "RelatedActiveContentsExist"
+  - BackForwardCacheNotRestoredReason.HTTPStatusNotOK                                          "HTTPStatusNotOK"
+  - BackForwardCacheNotRestoredReason.SchemeNotHTTPOrHTTPS                                     "SchemeNotHTTPOrHTTPS"
+  - BackForwardCacheNotRestoredReason.Loading                                                  "Loading"
+  - BackForwardCacheNotRestoredReason.WasGrantedMediaAccess                                    "WasGrantedMediaAccess"
+  - BackForwardCacheNotRestoredReason.DisableForRenderFrameHostCalled                          "DisableForRenderFrameHostCalled"
+  - BackForwardCacheNotRestoredReason.DomainNotAllowed                                         "DomainNotAllowed"
+  - BackForwardCacheNotRestoredReason.HTTPMethodNotGET                                         "HTTPMethodNotGET"
+  - BackForwardCacheNotRestoredReason.SubframeIsNavigating                                     "SubframeIsNavigating"
+  - BackForwardCacheNotRestoredReason.Timeout                                                  "Timeout"
+  - BackForwardCacheNotRestoredReason.CacheLimit                                               "CacheLimit"
+  - BackForwardCacheNotRestoredReason.JavaScriptExecution                                      "JavaScriptExecution"
+  - BackForwardCacheNotRestoredReason.RendererProcessKilled                                    "RendererProcessKilled"
+  - BackForwardCacheNotRestoredReason.RendererProcessCrashed                                   "RendererProcessCrashed"
+  - BackForwardCacheNotRestoredReason.SchedulerTrackedFeatureUsed                              "SchedulerTrackedFeatureUsed"
+  - BackForwardCacheNotRestoredReason.ConflictingBrowsingInstance                              "ConflictingBrowsingInstance"
+  - BackForwardCacheNotRestoredReason.CacheFlushed                                             "CacheFlushed"
+  - BackForwardCacheNotRestoredReason.ServiceWorkerVersionActivation                           "ServiceWorkerVersionActivation"
+  - BackForwardCacheNotRestoredReason.SessionRestored                                          "SessionRestored"
+  - BackForwardCacheNotRestoredReason.ServiceWorkerPostMessage                                 "ServiceWorkerPostMessage"
+  - BackForwardCacheNotRestoredReason.EnteredBackForwardCacheBeforeServiceWorkerHostAdded      "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+  - BackForwardCacheNotRestoredReason.RenderFrameHostReusedSameSite                            "RenderFrameHostReused_SameSite"
+  - BackForwardCacheNotRestoredReason.RenderFrameHostReusedCrossSite                           "RenderFrameHostReused_CrossSite"
+  - BackForwardCacheNotRestoredReason.ServiceWorkerClaim                                       "ServiceWorkerClaim"
+  - BackForwardCacheNotRestoredReason.IgnoreEventAndEvict                                      "IgnoreEventAndEvict"
+  - BackForwardCacheNotRestoredReason.HaveInnerContents                                        "HaveInnerContents"
+  - BackForwardCacheNotRestoredReason.TimeoutPuttingInCache                                    "TimeoutPuttingInCache"
+  - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByLowMemory                      "BackForwardCacheDisabledByLowMemory"
+  - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledByCommandLine                    "BackForwardCacheDisabledByCommandLine"
+  - BackForwardCacheNotRestoredReason.NetworkRequestDatapipeDrainedAsBytesConsumer             "NetworkRequestDatapipeDrainedAsBytesConsumer"
+  - BackForwardCacheNotRestoredReason.NetworkRequestRedirected                                 "NetworkRequestRedirected"
+  - BackForwardCacheNotRestoredReason.NetworkRequestTimeout                                    "NetworkRequestTimeout"
+  - BackForwardCacheNotRestoredReason.NetworkExceedsBufferLimit                                "NetworkExceedsBufferLimit"
+  - BackForwardCacheNotRestoredReason.NavigationCancelledWhileRestoring                        "NavigationCancelledWhileRestoring"
+  - BackForwardCacheNotRestoredReason.NotMostRecentNavigationEntry                             "NotMostRecentNavigationEntry"
+  - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForPrerender                     "BackForwardCacheDisabledForPrerender"
+  - BackForwardCacheNotRestoredReason.UserAgentOverrideDiffers                                 "UserAgentOverrideDiffers"
+  - BackForwardCacheNotRestoredReason.ForegroundCacheLimit                                     "ForegroundCacheLimit"
+  - BackForwardCacheNotRestoredReason.BrowsingInstanceNotSwapped                               "BrowsingInstanceNotSwapped"
+  - BackForwardCacheNotRestoredReason.BackForwardCacheDisabledForDelegate                      "BackForwardCacheDisabledForDelegate"
+  - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInMainFrame                           "UnloadHandlerExistsInMainFrame"
+  - BackForwardCacheNotRestoredReason.UnloadHandlerExistsInSubFrame                            "UnloadHandlerExistsInSubFrame"
+  - BackForwardCacheNotRestoredReason.ServiceWorkerUnregistration                              "ServiceWorkerUnregistration"
+  - BackForwardCacheNotRestoredReason.CacheControlNoStore                                      "CacheControlNoStore"
+  - BackForwardCacheNotRestoredReason.CacheControlNoStoreCookieModified                        "CacheControlNoStoreCookieModified"
+  - BackForwardCacheNotRestoredReason.CacheControlNoStoreHTTPOnlyCookieModified                "CacheControlNoStoreHTTPOnlyCookieModified"
+  - BackForwardCacheNotRestoredReason.NoResponseHead                                           "NoResponseHead"
+  - BackForwardCacheNotRestoredReason.Unknown                                                  "Unknown"
+  - BackForwardCacheNotRestoredReason.ActivationNavigationsDisallowedForBug1234857             "ActivationNavigationsDisallowedForBug1234857"
+  - BackForwardCacheNotRestoredReason.ErrorDocument                                            "ErrorDocument"
+  - BackForwardCacheNotRestoredReason.FencedFramesEmbedder                                     "FencedFramesEmbedder"
+  - BackForwardCacheNotRestoredReason.CookieDisabled                                           "CookieDisabled"
+  - BackForwardCacheNotRestoredReason.HTTPAuthRequired                                         "HTTPAuthRequired"
+  - BackForwardCacheNotRestoredReason.CookieFlushed                                            "CookieFlushed"
+  - BackForwardCacheNotRestoredReason.BroadcastChannelOnMessage                                "BroadcastChannelOnMessage"
+  - BackForwardCacheNotRestoredReason.WebViewSettingsChanged                                   "WebViewSettingsChanged"
+  - BackForwardCacheNotRestoredReason.WebViewJavaScriptObjectChanged                           "WebViewJavaScriptObjectChanged"
+  - BackForwardCacheNotRestoredReason.WebViewMessageListenerInjected                           "WebViewMessageListenerInjected"
+  - BackForwardCacheNotRestoredReason.WebViewSafeBrowsingAllowlistChanged                      "WebViewSafeBrowsingAllowlistChanged"
+  - BackForwardCacheNotRestoredReason.WebViewDocumentStartJavascriptChanged                    "WebViewDocumentStartJavascriptChanged"
+  - BackForwardCacheNotRestoredReason.WebSocket                                                "WebSocket"
+  - BackForwardCacheNotRestoredReason.WebTransport                                             "WebTransport"
+  - BackForwardCacheNotRestoredReason.WebRTC                                                   "WebRTC"
+  - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoStore                       "MainResourceHasCacheControlNoStore"
+  - BackForwardCacheNotRestoredReason.MainResourceHasCacheControlNoCache                       "MainResourceHasCacheControlNoCache"
+  - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoStore                        "SubresourceHasCacheControlNoStore"
+  - BackForwardCacheNotRestoredReason.SubresourceHasCacheControlNoCache                        "SubresourceHasCacheControlNoCache"
+  - BackForwardCacheNotRestoredReason.ContainsPlugins                                          "ContainsPlugins"
+  - BackForwardCacheNotRestoredReason.DocumentLoaded                                           "DocumentLoaded"
+  - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestOthers                          "OutstandingNetworkRequestOthers"
+  - BackForwardCacheNotRestoredReason.RequestedMIDIPermission                                  "RequestedMIDIPermission"
+  - BackForwardCacheNotRestoredReason.RequestedAudioCapturePermission                          "RequestedAudioCapturePermission"
+  - BackForwardCacheNotRestoredReason.RequestedVideoCapturePermission                          "RequestedVideoCapturePermission"
+  - BackForwardCacheNotRestoredReason.RequestedBackForwardCacheBlockedSensors                  "RequestedBackForwardCacheBlockedSensors"
+  - BackForwardCacheNotRestoredReason.RequestedBackgroundWorkPermission                        "RequestedBackgroundWorkPermission"
+  - BackForwardCacheNotRestoredReason.BroadcastChannel                                         "BroadcastChannel"
+  - BackForwardCacheNotRestoredReason.WebXR                                                    "WebXR"
+  - BackForwardCacheNotRestoredReason.SharedWorker                                             "SharedWorker"
+  - BackForwardCacheNotRestoredReason.SharedWorkerMessage                                      "SharedWorkerMessage"
+  - BackForwardCacheNotRestoredReason.WebLocks                                                 "WebLocks"
+  - BackForwardCacheNotRestoredReason.WebHID                                                   "WebHID"
+  - BackForwardCacheNotRestoredReason.WebShare                                                 "WebShare"
+  - BackForwardCacheNotRestoredReason.RequestedStorageAccessGrant                              "RequestedStorageAccessGrant"
+  - BackForwardCacheNotRestoredReason.WebNfc                                                   "WebNfc"
+  - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestFetch                           "OutstandingNetworkRequestFetch"
+  - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestXHR                             "OutstandingNetworkRequestXHR"
+  - BackForwardCacheNotRestoredReason.AppBanner                                                "AppBanner"
+  - BackForwardCacheNotRestoredReason.Printing                                                 "Printing"
+  - BackForwardCacheNotRestoredReason.WebDatabase                                              "WebDatabase"
+  - BackForwardCacheNotRestoredReason.PictureInPicture                                         "PictureInPicture"
+  - BackForwardCacheNotRestoredReason.SpeechRecognizer                                         "SpeechRecognizer"
+  - BackForwardCacheNotRestoredReason.IdleManager                                              "IdleManager"
+  - BackForwardCacheNotRestoredReason.PaymentManager                                           "PaymentManager"
+  - BackForwardCacheNotRestoredReason.SpeechSynthesis                                          "SpeechSynthesis"
+  - BackForwardCacheNotRestoredReason.KeyboardLock                                             "KeyboardLock"
+  - BackForwardCacheNotRestoredReason.WebOTPService                                            "WebOTPService"
+  - BackForwardCacheNotRestoredReason.OutstandingNetworkRequestDirectSocket                    "OutstandingNetworkRequestDirectSocket"
+  - BackForwardCacheNotRestoredReason.InjectedJavascript                                       "InjectedJavascript"
+  - BackForwardCacheNotRestoredReason.InjectedStyleSheet                                       "InjectedStyleSheet"
+  - BackForwardCacheNotRestoredReason.KeepaliveRequest                                         "KeepaliveRequest"
+  - BackForwardCacheNotRestoredReason.IndexedDBEvent                                           "IndexedDBEvent"
+  - BackForwardCacheNotRestoredReason.Dummy                                                    "Dummy"
+  - BackForwardCacheNotRestoredReason.JSNetworkRequestReceivedCacheControlNoStoreResource      "JsNetworkRequestReceivedCacheControlNoStoreResource"
+  - BackForwardCacheNotRestoredReason.WebRTCSticky                                             "WebRTCSticky"
+  - BackForwardCacheNotRestoredReason.WebTransportSticky                                       "WebTransportSticky"
+  - BackForwardCacheNotRestoredReason.WebSocketSticky                                          "WebSocketSticky"
+  - BackForwardCacheNotRestoredReason.SmartCard                                                "SmartCard"
+  - BackForwardCacheNotRestoredReason.LiveMediaStreamTrack                                     "LiveMediaStreamTrack"
+  - BackForwardCacheNotRestoredReason.UnloadHandler                                            "UnloadHandler"
+  - BackForwardCacheNotRestoredReason.ParserAborted                                            "ParserAborted"
+  - BackForwardCacheNotRestoredReason.ContentSecurityHandler                                   "ContentSecurityHandler"
+  - BackForwardCacheNotRestoredReason.ContentWebAuthenticationAPI                              "ContentWebAuthenticationAPI"
+  - BackForwardCacheNotRestoredReason.ContentFileChooser                                       "ContentFileChooser"
+  - BackForwardCacheNotRestoredReason.ContentSerial                                            "ContentSerial"
+  - BackForwardCacheNotRestoredReason.ContentFileSystemAccess                                  "ContentFileSystemAccess"
+  - BackForwardCacheNotRestoredReason.ContentMediaDevicesDispatcherHost                        "ContentMediaDevicesDispatcherHost"
+  - BackForwardCacheNotRestoredReason.ContentWebBluetooth                                      "ContentWebBluetooth"
+  - BackForwardCacheNotRestoredReason.ContentWebUSB                                            "ContentWebUSB"
+  - BackForwardCacheNotRestoredReason.ContentMediaSessionService                               "ContentMediaSessionService"
+  - BackForwardCacheNotRestoredReason.ContentScreenReader                                      "ContentScreenReader"
+  - BackForwardCacheNotRestoredReason.ContentDiscarded                                         "ContentDiscarded"
+  - BackForwardCacheNotRestoredReason.EmbedderPopupBlockerTabHelper                            "EmbedderPopupBlockerTabHelper"
+  - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingTriggeredPopupBlocker                "EmbedderSafeBrowsingTriggeredPopupBlocker"
+  - BackForwardCacheNotRestoredReason.EmbedderSafeBrowsingThreatDetails                        "EmbedderSafeBrowsingThreatDetails"
+  - BackForwardCacheNotRestoredReason.EmbedderAppBannerManager                                 "EmbedderAppBannerManager"
+  - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerViewerSource                         "EmbedderDomDistillerViewerSource"
+  - BackForwardCacheNotRestoredReason.EmbedderDOMDistillerSelfDeletingRequestDelegate          "EmbedderDomDistillerSelfDeletingRequestDelegate"
+  - BackForwardCacheNotRestoredReason.EmbedderOomInterventionTabHelper                         "EmbedderOomInterventionTabHelper"
+  - BackForwardCacheNotRestoredReason.EmbedderOfflinePage                                      "EmbedderOfflinePage"
+  - BackForwardCacheNotRestoredReason.EmbedderChromePasswordManagerClientBindCredentialManager "EmbedderChromePasswordManagerClientBindCredentialManager"
+  - BackForwardCacheNotRestoredReason.EmbedderPermissionRequestManager                         "EmbedderPermissionRequestManager"
+  - BackForwardCacheNotRestoredReason.EmbedderModalDialog                                      "EmbedderModalDialog"
+  - BackForwardCacheNotRestoredReason.EmbedderExtensions                                       "EmbedderExtensions"
+  - BackForwardCacheNotRestoredReason.EmbedderExtensionMessaging                               "EmbedderExtensionMessaging"
+  - BackForwardCacheNotRestoredReason.EmbedderExtensionMessagingForOpenPort                    "EmbedderExtensionMessagingForOpenPort"
+  - BackForwardCacheNotRestoredReason.EmbedderExtensionSentMessageToCachedFrame                "EmbedderExtensionSentMessageToCachedFrame"
+  - BackForwardCacheNotRestoredReason.RequestedByWebViewClient                                 "RequestedByWebViewClient"
+  - BackForwardCacheNotRestoredReason.PostMessageByWebViewClient                               "PostMessageByWebViewClient"
+  - BackForwardCacheNotRestoredReason.CacheControlNoStoreDeviceBoundSessionTerminated          "CacheControlNoStoreDeviceBoundSessionTerminated"
+  - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnModerateMemoryPressure                 "CacheLimitPrunedOnModerateMemoryPressure"
+  - BackForwardCacheNotRestoredReason.CacheLimitPrunedOnCriticalMemoryPressure                 "CacheLimitPrunedOnCriticalMemoryPressure"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-BackForwardCacheNotRestoredReason
+*/
+type BackForwardCacheNotRestoredReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum BackForwardCacheNotRestoredReasonEnum) String() string {
+	return _backForwardCacheNotRestoredReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BackForwardCacheNotRestoredReasonEnum) Unknown() bool {
+	return !_backForwardCacheNotRestoredReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum BackForwardCacheNotRestoredReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *BackForwardCacheNotRestoredReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _backForwardCacheNotRestoredReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// backForwardCacheNotRestoredReasonNotPrimaryMainFrame represents the "NotPrimaryMainFrame" value.
+	backForwardCacheNotRestoredReasonNotPrimaryMainFrame BackForwardCacheNotRestoredReasonEnum = iota + 1
+	// backForwardCacheNotRestoredReasonBackForwardCacheDisabled represents the "BackForwardCacheDisabled" value.
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabled
+	// backForwardCacheNotRestoredReasonRelatedActiveContentsExist represents the "RelatedActiveContentsExist" value.
+	backForwardCacheNotRestoredReasonRelatedActiveContentsExist
+	// backForwardCacheNotRestoredReasonHTTPStatusNotOK represents the "HTTPStatusNotOK" value.
+	backForwardCacheNotRestoredReasonHTTPStatusNotOK
+	// backForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS represents the "SchemeNotHTTPOrHTTPS" value.
+	backForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS
+	// backForwardCacheNotRestoredReasonLoading represents the "Loading" value.
+	backForwardCacheNotRestoredReasonLoading
+	// backForwardCacheNotRestoredReasonWasGrantedMediaAccess represents the "WasGrantedMediaAccess" value.
+	backForwardCacheNotRestoredReasonWasGrantedMediaAccess
+	// backForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled represents the "DisableForRenderFrameHostCalled" value.
+	backForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled
+	// backForwardCacheNotRestoredReasonDomainNotAllowed represents the "DomainNotAllowed" value.
+	backForwardCacheNotRestoredReasonDomainNotAllowed
+	// backForwardCacheNotRestoredReasonHTTPMethodNotGET represents the "HTTPMethodNotGET" value.
+	backForwardCacheNotRestoredReasonHTTPMethodNotGET
+	// backForwardCacheNotRestoredReasonSubframeIsNavigating represents the "SubframeIsNavigating" value.
+	backForwardCacheNotRestoredReasonSubframeIsNavigating
+	// backForwardCacheNotRestoredReasonTimeout represents the "Timeout" value.
+	backForwardCacheNotRestoredReasonTimeout
+	// backForwardCacheNotRestoredReasonCacheLimit represents the "CacheLimit" value.
+	backForwardCacheNotRestoredReasonCacheLimit
+	// backForwardCacheNotRestoredReasonJavaScriptExecution represents the "JavaScriptExecution" value.
+	backForwardCacheNotRestoredReasonJavaScriptExecution
+	// backForwardCacheNotRestoredReasonRendererProcessKilled represents the "RendererProcessKilled" value.
+	backForwardCacheNotRestoredReasonRendererProcessKilled
+	// backForwardCacheNotRestoredReasonRendererProcessCrashed represents the "RendererProcessCrashed" value.
+	backForwardCacheNotRestoredReasonRendererProcessCrashed
+	// backForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed represents the "SchedulerTrackedFeatureUsed" value.
+	backForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed
+	// backForwardCacheNotRestoredReasonConflictingBrowsingInstance represents the "ConflictingBrowsingInstance" value.
+	backForwardCacheNotRestoredReasonConflictingBrowsingInstance
+	// backForwardCacheNotRestoredReasonCacheFlushed represents the "CacheFlushed" value.
+	backForwardCacheNotRestoredReasonCacheFlushed
+	// backForwardCacheNotRestoredReasonServiceWorkerVersionActivation represents the "ServiceWorkerVersionActivation" value.
+	backForwardCacheNotRestoredReasonServiceWorkerVersionActivation
+	// backForwardCacheNotRestoredReasonSessionRestored represents the "SessionRestored" value.
+	backForwardCacheNotRestoredReasonSessionRestored
+	// backForwardCacheNotRestoredReasonServiceWorkerPostMessage represents the "ServiceWorkerPostMessage" value.
+	backForwardCacheNotRestoredReasonServiceWorkerPostMessage
+	// backForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded represents the "EnteredBackForwardCacheBeforeServiceWorkerHostAdded" value.
+	backForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded
+	// backForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite represents the "RenderFrameHostReused_SameSite" value.
+	backForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite
+	// backForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite represents the "RenderFrameHostReused_CrossSite" value.
+	backForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite
+	// backForwardCacheNotRestoredReasonServiceWorkerClaim represents the "ServiceWorkerClaim" value.
+	backForwardCacheNotRestoredReasonServiceWorkerClaim
+	// backForwardCacheNotRestoredReasonIgnoreEventAndEvict represents the "IgnoreEventAndEvict" value.
+	backForwardCacheNotRestoredReasonIgnoreEventAndEvict
+	// backForwardCacheNotRestoredReasonHaveInnerContents represents the "HaveInnerContents" value.
+	backForwardCacheNotRestoredReasonHaveInnerContents
+	// backForwardCacheNotRestoredReasonTimeoutPuttingInCache represents the "TimeoutPuttingInCache" value.
+	backForwardCacheNotRestoredReasonTimeoutPuttingInCache
+	// backForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory represents the "BackForwardCacheDisabledByLowMemory" value.
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory
+	// backForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine represents the "BackForwardCacheDisabledByCommandLine" value.
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine
+	// backForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer represents the "NetworkRequestDatapipeDrainedAsBytesConsumer" value.
+	backForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer
+	// backForwardCacheNotRestoredReasonNetworkRequestRedirected represents the "NetworkRequestRedirected" value.
+	backForwardCacheNotRestoredReasonNetworkRequestRedirected
+	// backForwardCacheNotRestoredReasonNetworkRequestTimeout represents the "NetworkRequestTimeout" value.
+	backForwardCacheNotRestoredReasonNetworkRequestTimeout
+	// backForwardCacheNotRestoredReasonNetworkExceedsBufferLimit represents the "NetworkExceedsBufferLimit" value.
+	backForwardCacheNotRestoredReasonNetworkExceedsBufferLimit
+	// backForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring represents the "NavigationCancelledWhileRestoring" value.
+	backForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring
+	// backForwardCacheNotRestoredReasonNotMostRecentNavigationEntry represents the "NotMostRecentNavigationEntry" value.
+	backForwardCacheNotRestoredReasonNotMostRecentNavigationEntry
+	// backForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender represents the "BackForwardCacheDisabledForPrerender" value.
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender
+	// backForwardCacheNotRestoredReasonUserAgentOverrideDiffers represents the "UserAgentOverrideDiffers" value.
+	backForwardCacheNotRestoredReasonUserAgentOverrideDiffers
+	// backForwardCacheNotRestoredReasonForegroundCacheLimit represents the "ForegroundCacheLimit" value.
+	backForwardCacheNotRestoredReasonForegroundCacheLimit
+	// backForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped represents the "BrowsingInstanceNotSwapped" value.
+	backForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped
+	// backForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate represents the "BackForwardCacheDisabledForDelegate" value.
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate
+	// backForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame represents the "UnloadHandlerExistsInMainFrame" value.
+	backForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame
+	// backForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame represents the "UnloadHandlerExistsInSubFrame" value.
+	backForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame
+	// backForwardCacheNotRestoredReasonServiceWorkerUnregistration represents the "ServiceWorkerUnregistration" value.
+	backForwardCacheNotRestoredReasonServiceWorkerUnregistration
+	// backForwardCacheNotRestoredReasonCacheControlNoStore represents the "CacheControlNoStore" value.
+	backForwardCacheNotRestoredReasonCacheControlNoStore
+	// backForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified represents the "CacheControlNoStoreCookieModified" value.
+	backForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified
+	// backForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified represents the "CacheControlNoStoreHTTPOnlyCookieModified" value.
+	backForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified
+	// backForwardCacheNotRestoredReasonNoResponseHead represents the "NoResponseHead" value.
+	backForwardCacheNotRestoredReasonNoResponseHead
+	// backForwardCacheNotRestoredReasonUnknown represents the "Unknown" value.
+	backForwardCacheNotRestoredReasonUnknown
+	// backForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857 represents the "ActivationNavigationsDisallowedForBug1234857" value.
+	backForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857
+	// backForwardCacheNotRestoredReasonErrorDocument represents the "ErrorDocument" value.
+	backForwardCacheNotRestoredReasonErrorDocument
+	// backForwardCacheNotRestoredReasonFencedFramesEmbedder represents the "FencedFramesEmbedder" value.
+	backForwardCacheNotRestoredReasonFencedFramesEmbedder
+	// backForwardCacheNotRestoredReasonCookieDisabled represents the "CookieDisabled" value.
+	backForwardCacheNotRestoredReasonCookieDisabled
+	// backForwardCacheNotRestoredReasonHTTPAuthRequired represents the "HTTPAuthRequired" value.
+	backForwardCacheNotRestoredReasonHTTPAuthRequired
+	// backForwardCacheNotRestoredReasonCookieFlushed represents the "CookieFlushed" value.
+	backForwardCacheNotRestoredReasonCookieFlushed
+	// backForwardCacheNotRestoredReasonBroadcastChannelOnMessage represents the "BroadcastChannelOnMessage" value.
+	backForwardCacheNotRestoredReasonBroadcastChannelOnMessage
+	// backForwardCacheNotRestoredReasonWebViewSettingsChanged represents the "WebViewSettingsChanged" value.
+	backForwardCacheNotRestoredReasonWebViewSettingsChanged
+	// backForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged represents the "WebViewJavaScriptObjectChanged" value.
+	backForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged
+	// backForwardCacheNotRestoredReasonWebViewMessageListenerInjected represents the "WebViewMessageListenerInjected" value.
+	backForwardCacheNotRestoredReasonWebViewMessageListenerInjected
+	// backForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged represents the "WebViewSafeBrowsingAllowlistChanged" value.
+	backForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged
+	// backForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged represents the "WebViewDocumentStartJavascriptChanged" value.
+	backForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged
+	// backForwardCacheNotRestoredReasonWebSocket represents the "WebSocket" value.
+	backForwardCacheNotRestoredReasonWebSocket
+	// backForwardCacheNotRestoredReasonWebTransport represents the "WebTransport" value.
+	backForwardCacheNotRestoredReasonWebTransport
+	// backForwardCacheNotRestoredReasonWebRTC represents the "WebRTC" value.
+	backForwardCacheNotRestoredReasonWebRTC
+	// backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore represents the "MainResourceHasCacheControlNoStore" value.
+	backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore
+	// backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache represents the "MainResourceHasCacheControlNoCache" value.
+	backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache
+	// backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore represents the "SubresourceHasCacheControlNoStore" value.
+	backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore
+	// backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache represents the "SubresourceHasCacheControlNoCache" value.
+	backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache
+	// backForwardCacheNotRestoredReasonContainsPlugins represents the "ContainsPlugins" value.
+	backForwardCacheNotRestoredReasonContainsPlugins
+	// backForwardCacheNotRestoredReasonDocumentLoaded represents the "DocumentLoaded" value.
+	backForwardCacheNotRestoredReasonDocumentLoaded
+	// backForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers represents the "OutstandingNetworkRequestOthers" value.
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers
+	// backForwardCacheNotRestoredReasonRequestedMIDIPermission represents the "RequestedMIDIPermission" value.
+	backForwardCacheNotRestoredReasonRequestedMIDIPermission
+	// backForwardCacheNotRestoredReasonRequestedAudioCapturePermission represents the "RequestedAudioCapturePermission" value.
+	backForwardCacheNotRestoredReasonRequestedAudioCapturePermission
+	// backForwardCacheNotRestoredReasonRequestedVideoCapturePermission represents the "RequestedVideoCapturePermission" value.
+	backForwardCacheNotRestoredReasonRequestedVideoCapturePermission
+	// backForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors represents the "RequestedBackForwardCacheBlockedSensors" value.
+	backForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors
+	// backForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission represents the "RequestedBackgroundWorkPermission" value.
+	backForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission
+	// backForwardCacheNotRestoredReasonBroadcastChannel represents the "BroadcastChannel" value.
+	backForwardCacheNotRestoredReasonBroadcastChannel
+	// backForwardCacheNotRestoredReasonWebXR represents the "WebXR" value.
+	backForwardCacheNotRestoredReasonWebXR
+	// backForwardCacheNotRestoredReasonSharedWorker represents the "SharedWorker" value.
+	backForwardCacheNotRestoredReasonSharedWorker
+	// backForwardCacheNotRestoredReasonSharedWorkerMessage represents the "SharedWorkerMessage" value.
+	backForwardCacheNotRestoredReasonSharedWorkerMessage
+	// backForwardCacheNotRestoredReasonWebLocks represents the "WebLocks" value.
+	backForwardCacheNotRestoredReasonWebLocks
+	// backForwardCacheNotRestoredReasonWebHID represents the "WebHID" value.
+	backForwardCacheNotRestoredReasonWebHID
+	// backForwardCacheNotRestoredReasonWebShare represents the "WebShare" value.
+	backForwardCacheNotRestoredReasonWebShare
+	// backForwardCacheNotRestoredReasonRequestedStorageAccessGrant represents the "RequestedStorageAccessGrant" value.
+	backForwardCacheNotRestoredReasonRequestedStorageAccessGrant
+	// backForwardCacheNotRestoredReasonWebNfc represents the "WebNfc" value.
+	backForwardCacheNotRestoredReasonWebNfc
+	// backForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch represents the "OutstandingNetworkRequestFetch" value.
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch
+	// backForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR represents the "OutstandingNetworkRequestXHR" value.
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR
+	// backForwardCacheNotRestoredReasonAppBanner represents the "AppBanner" value.
+	backForwardCacheNotRestoredReasonAppBanner
+	// backForwardCacheNotRestoredReasonPrinting represents the "Printing" value.
+	backForwardCacheNotRestoredReasonPrinting
+	// backForwardCacheNotRestoredReasonWebDatabase represents the "WebDatabase" value.
+	backForwardCacheNotRestoredReasonWebDatabase
+	// backForwardCacheNotRestoredReasonPictureInPicture represents the "PictureInPicture" value.
+	backForwardCacheNotRestoredReasonPictureInPicture
+	// backForwardCacheNotRestoredReasonSpeechRecognizer represents the "SpeechRecognizer" value.
+	backForwardCacheNotRestoredReasonSpeechRecognizer
+	// backForwardCacheNotRestoredReasonIdleManager represents the "IdleManager" value.
+	backForwardCacheNotRestoredReasonIdleManager
+	// backForwardCacheNotRestoredReasonPaymentManager represents the "PaymentManager" value.
+	backForwardCacheNotRestoredReasonPaymentManager
+	// backForwardCacheNotRestoredReasonSpeechSynthesis represents the "SpeechSynthesis" value.
+	backForwardCacheNotRestoredReasonSpeechSynthesis
+	// backForwardCacheNotRestoredReasonKeyboardLock represents the "KeyboardLock" value.
+	backForwardCacheNotRestoredReasonKeyboardLock
+	// backForwardCacheNotRestoredReasonWebOTPService represents the "WebOTPService" value.
+	backForwardCacheNotRestoredReasonWebOTPService
+	// backForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket represents the "OutstandingNetworkRequestDirectSocket" value.
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket
+	// backForwardCacheNotRestoredReasonInjectedJavascript represents the "InjectedJavascript" value.
+	backForwardCacheNotRestoredReasonInjectedJavascript
+	// backForwardCacheNotRestoredReasonInjectedStyleSheet represents the "InjectedStyleSheet" value.
+	backForwardCacheNotRestoredReasonInjectedStyleSheet
+	// backForwardCacheNotRestoredReasonKeepaliveRequest represents the "KeepaliveRequest" value.
+	backForwardCacheNotRestoredReasonKeepaliveRequest
+	// backForwardCacheNotRestoredReasonIndexedDBEvent represents the "IndexedDBEvent" value.
+	backForwardCacheNotRestoredReasonIndexedDBEvent
+	// backForwardCacheNotRestoredReasonDummy represents the "Dummy" value.
+	backForwardCacheNotRestoredReasonDummy
+	// backForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource represents the "JsNetworkRequestReceivedCacheControlNoStoreResource" value.
+	backForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource
+	// backForwardCacheNotRestoredReasonWebRTCSticky represents the "WebRTCSticky" value.
+	backForwardCacheNotRestoredReasonWebRTCSticky
+	// backForwardCacheNotRestoredReasonWebTransportSticky represents the "WebTransportSticky" value.
+	backForwardCacheNotRestoredReasonWebTransportSticky
+	// backForwardCacheNotRestoredReasonWebSocketSticky represents the "WebSocketSticky" value.
+	backForwardCacheNotRestoredReasonWebSocketSticky
+	// backForwardCacheNotRestoredReasonSmartCard represents the "SmartCard" value.
+	backForwardCacheNotRestoredReasonSmartCard
+	// backForwardCacheNotRestoredReasonLiveMediaStreamTrack represents the "LiveMediaStreamTrack" value.
+	backForwardCacheNotRestoredReasonLiveMediaStreamTrack
+	// backForwardCacheNotRestoredReasonUnloadHandler represents the "UnloadHandler" value.
+	backForwardCacheNotRestoredReasonUnloadHandler
+	// backForwardCacheNotRestoredReasonParserAborted represents the "ParserAborted" value.
+	backForwardCacheNotRestoredReasonParserAborted
+	// backForwardCacheNotRestoredReasonContentSecurityHandler represents the "ContentSecurityHandler" value.
+	backForwardCacheNotRestoredReasonContentSecurityHandler
+	// backForwardCacheNotRestoredReasonContentWebAuthenticationAPI represents the "ContentWebAuthenticationAPI" value.
+	backForwardCacheNotRestoredReasonContentWebAuthenticationAPI
+	// backForwardCacheNotRestoredReasonContentFileChooser represents the "ContentFileChooser" value.
+	backForwardCacheNotRestoredReasonContentFileChooser
+	// backForwardCacheNotRestoredReasonContentSerial represents the "ContentSerial" value.
+	backForwardCacheNotRestoredReasonContentSerial
+	// backForwardCacheNotRestoredReasonContentFileSystemAccess represents the "ContentFileSystemAccess" value.
+	backForwardCacheNotRestoredReasonContentFileSystemAccess
+	// backForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost represents the "ContentMediaDevicesDispatcherHost" value.
+	backForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost
+	// backForwardCacheNotRestoredReasonContentWebBluetooth represents the "ContentWebBluetooth" value.
+	backForwardCacheNotRestoredReasonContentWebBluetooth
+	// backForwardCacheNotRestoredReasonContentWebUSB represents the "ContentWebUSB" value.
+	backForwardCacheNotRestoredReasonContentWebUSB
+	// backForwardCacheNotRestoredReasonContentMediaSessionService represents the "ContentMediaSessionService" value.
+	backForwardCacheNotRestoredReasonContentMediaSessionService
+	// backForwardCacheNotRestoredReasonContentScreenReader represents the "ContentScreenReader" value.
+	backForwardCacheNotRestoredReasonContentScreenReader
+	// backForwardCacheNotRestoredReasonContentDiscarded represents the "ContentDiscarded" value.
+	backForwardCacheNotRestoredReasonContentDiscarded
+	// backForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper represents the "EmbedderPopupBlockerTabHelper" value.
+	backForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper
+	// backForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker represents the "EmbedderSafeBrowsingTriggeredPopupBlocker" value.
+	backForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker
+	// backForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails represents the "EmbedderSafeBrowsingThreatDetails" value.
+	backForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails
+	// backForwardCacheNotRestoredReasonEmbedderAppBannerManager represents the "EmbedderAppBannerManager" value.
+	backForwardCacheNotRestoredReasonEmbedderAppBannerManager
+	// backForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource represents the "EmbedderDomDistillerViewerSource" value.
+	backForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource
+	// backForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate represents the "EmbedderDomDistillerSelfDeletingRequestDelegate" value.
+	backForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate
+	// backForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper represents the "EmbedderOomInterventionTabHelper" value.
+	backForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper
+	// backForwardCacheNotRestoredReasonEmbedderOfflinePage represents the "EmbedderOfflinePage" value.
+	backForwardCacheNotRestoredReasonEmbedderOfflinePage
+	// backForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager represents the "EmbedderChromePasswordManagerClientBindCredentialManager" value.
+	backForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager
+	// backForwardCacheNotRestoredReasonEmbedderPermissionRequestManager represents the "EmbedderPermissionRequestManager" value.
+	backForwardCacheNotRestoredReasonEmbedderPermissionRequestManager
+	// backForwardCacheNotRestoredReasonEmbedderModalDialog represents the "EmbedderModalDialog" value.
+	backForwardCacheNotRestoredReasonEmbedderModalDialog
+	// backForwardCacheNotRestoredReasonEmbedderExtensions represents the "EmbedderExtensions" value.
+	backForwardCacheNotRestoredReasonEmbedderExtensions
+	// backForwardCacheNotRestoredReasonEmbedderExtensionMessaging represents the "EmbedderExtensionMessaging" value.
+	backForwardCacheNotRestoredReasonEmbedderExtensionMessaging
+	// backForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort represents the "EmbedderExtensionMessagingForOpenPort" value.
+	backForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort
+	// backForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame represents the "EmbedderExtensionSentMessageToCachedFrame" value.
+	backForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame
+	// backForwardCacheNotRestoredReasonRequestedByWebViewClient represents the "RequestedByWebViewClient" value.
+	backForwardCacheNotRestoredReasonRequestedByWebViewClient
+	// backForwardCacheNotRestoredReasonPostMessageByWebViewClient represents the "PostMessageByWebViewClient" value.
+	backForwardCacheNotRestoredReasonPostMessageByWebViewClient
+	// backForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated represents the "CacheControlNoStoreDeviceBoundSessionTerminated" value.
+	backForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated
+	// backForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure represents the "CacheLimitPrunedOnModerateMemoryPressure" value.
+	backForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure
+	// backForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure represents the "CacheLimitPrunedOnCriticalMemoryPressure" value.
+	backForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure
+)
+
+var _backForwardCacheNotRestoredReasonEnums = enums.New(map[BackForwardCacheNotRestoredReasonEnum]string{
+	BackForwardCacheNotRestoredReasonEnum(0):                                                  "",
+	backForwardCacheNotRestoredReasonNotPrimaryMainFrame:                                      "NotPrimaryMainFrame",
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabled:                                 "BackForwardCacheDisabled",
+	backForwardCacheNotRestoredReasonRelatedActiveContentsExist:                               "RelatedActiveContentsExist",
+	backForwardCacheNotRestoredReasonHTTPStatusNotOK:                                          "HTTPStatusNotOK",
+	backForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS:                                     "SchemeNotHTTPOrHTTPS",
+	backForwardCacheNotRestoredReasonLoading:                                                  "Loading",
+	backForwardCacheNotRestoredReasonWasGrantedMediaAccess:                                    "WasGrantedMediaAccess",
+	backForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled:                          "DisableForRenderFrameHostCalled",
+	backForwardCacheNotRestoredReasonDomainNotAllowed:                                         "DomainNotAllowed",
+	backForwardCacheNotRestoredReasonHTTPMethodNotGET:                                         "HTTPMethodNotGET",
+	backForwardCacheNotRestoredReasonSubframeIsNavigating:                                     "SubframeIsNavigating",
+	backForwardCacheNotRestoredReasonTimeout:                                                  "Timeout",
+	backForwardCacheNotRestoredReasonCacheLimit:                                               "CacheLimit",
+	backForwardCacheNotRestoredReasonJavaScriptExecution:                                      "JavaScriptExecution",
+	backForwardCacheNotRestoredReasonRendererProcessKilled:                                    "RendererProcessKilled",
+	backForwardCacheNotRestoredReasonRendererProcessCrashed:                                   "RendererProcessCrashed",
+	backForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed:                              "SchedulerTrackedFeatureUsed",
+	backForwardCacheNotRestoredReasonConflictingBrowsingInstance:                              "ConflictingBrowsingInstance",
+	backForwardCacheNotRestoredReasonCacheFlushed:                                             "CacheFlushed",
+	backForwardCacheNotRestoredReasonServiceWorkerVersionActivation:                           "ServiceWorkerVersionActivation",
+	backForwardCacheNotRestoredReasonSessionRestored:                                          "SessionRestored",
+	backForwardCacheNotRestoredReasonServiceWorkerPostMessage:                                 "ServiceWorkerPostMessage",
+	backForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded:      "EnteredBackForwardCacheBeforeServiceWorkerHostAdded",
+	backForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite:                            "RenderFrameHostReused_SameSite",
+	backForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite:                           "RenderFrameHostReused_CrossSite",
+	backForwardCacheNotRestoredReasonServiceWorkerClaim:                                       "ServiceWorkerClaim",
+	backForwardCacheNotRestoredReasonIgnoreEventAndEvict:                                      "IgnoreEventAndEvict",
+	backForwardCacheNotRestoredReasonHaveInnerContents:                                        "HaveInnerContents",
+	backForwardCacheNotRestoredReasonTimeoutPuttingInCache:                                    "TimeoutPuttingInCache",
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory:                      "BackForwardCacheDisabledByLowMemory",
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine:                    "BackForwardCacheDisabledByCommandLine",
+	backForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer:             "NetworkRequestDatapipeDrainedAsBytesConsumer",
+	backForwardCacheNotRestoredReasonNetworkRequestRedirected:                                 "NetworkRequestRedirected",
+	backForwardCacheNotRestoredReasonNetworkRequestTimeout:                                    "NetworkRequestTimeout",
+	backForwardCacheNotRestoredReasonNetworkExceedsBufferLimit:                                "NetworkExceedsBufferLimit",
+	backForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring:                        "NavigationCancelledWhileRestoring",
+	backForwardCacheNotRestoredReasonNotMostRecentNavigationEntry:                             "NotMostRecentNavigationEntry",
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender:                     "BackForwardCacheDisabledForPrerender",
+	backForwardCacheNotRestoredReasonUserAgentOverrideDiffers:                                 "UserAgentOverrideDiffers",
+	backForwardCacheNotRestoredReasonForegroundCacheLimit:                                     "ForegroundCacheLimit",
+	backForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped:                               "BrowsingInstanceNotSwapped",
+	backForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate:                      "BackForwardCacheDisabledForDelegate",
+	backForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame:                           "UnloadHandlerExistsInMainFrame",
+	backForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame:                            "UnloadHandlerExistsInSubFrame",
+	backForwardCacheNotRestoredReasonServiceWorkerUnregistration:                              "ServiceWorkerUnregistration",
+	backForwardCacheNotRestoredReasonCacheControlNoStore:                                      "CacheControlNoStore",
+	backForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified:                        "CacheControlNoStoreCookieModified",
+	backForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified:                "CacheControlNoStoreHTTPOnlyCookieModified",
+	backForwardCacheNotRestoredReasonNoResponseHead:                                           "NoResponseHead",
+	backForwardCacheNotRestoredReasonUnknown:                                                  "Unknown",
+	backForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857:             "ActivationNavigationsDisallowedForBug1234857",
+	backForwardCacheNotRestoredReasonErrorDocument:                                            "ErrorDocument",
+	backForwardCacheNotRestoredReasonFencedFramesEmbedder:                                     "FencedFramesEmbedder",
+	backForwardCacheNotRestoredReasonCookieDisabled:                                           "CookieDisabled",
+	backForwardCacheNotRestoredReasonHTTPAuthRequired:                                         "HTTPAuthRequired",
+	backForwardCacheNotRestoredReasonCookieFlushed:                                            "CookieFlushed",
+	backForwardCacheNotRestoredReasonBroadcastChannelOnMessage:                                "BroadcastChannelOnMessage",
+	backForwardCacheNotRestoredReasonWebViewSettingsChanged:                                   "WebViewSettingsChanged",
+	backForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged:                           "WebViewJavaScriptObjectChanged",
+	backForwardCacheNotRestoredReasonWebViewMessageListenerInjected:                           "WebViewMessageListenerInjected",
+	backForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged:                      "WebViewSafeBrowsingAllowlistChanged",
+	backForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged:                    "WebViewDocumentStartJavascriptChanged",
+	backForwardCacheNotRestoredReasonWebSocket:                                                "WebSocket",
+	backForwardCacheNotRestoredReasonWebTransport:                                             "WebTransport",
+	backForwardCacheNotRestoredReasonWebRTC:                                                   "WebRTC",
+	backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore:                       "MainResourceHasCacheControlNoStore",
+	backForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache:                       "MainResourceHasCacheControlNoCache",
+	backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore:                        "SubresourceHasCacheControlNoStore",
+	backForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache:                        "SubresourceHasCacheControlNoCache",
+	backForwardCacheNotRestoredReasonContainsPlugins:                                          "ContainsPlugins",
+	backForwardCacheNotRestoredReasonDocumentLoaded:                                           "DocumentLoaded",
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers:                          "OutstandingNetworkRequestOthers",
+	backForwardCacheNotRestoredReasonRequestedMIDIPermission:                                  "RequestedMIDIPermission",
+	backForwardCacheNotRestoredReasonRequestedAudioCapturePermission:                          "RequestedAudioCapturePermission",
+	backForwardCacheNotRestoredReasonRequestedVideoCapturePermission:                          "RequestedVideoCapturePermission",
+	backForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors:                  "RequestedBackForwardCacheBlockedSensors",
+	backForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission:                        "RequestedBackgroundWorkPermission",
+	backForwardCacheNotRestoredReasonBroadcastChannel:                                         "BroadcastChannel",
+	backForwardCacheNotRestoredReasonWebXR:                                                    "WebXR",
+	backForwardCacheNotRestoredReasonSharedWorker:                                             "SharedWorker",
+	backForwardCacheNotRestoredReasonSharedWorkerMessage:                                      "SharedWorkerMessage",
+	backForwardCacheNotRestoredReasonWebLocks:                                                 "WebLocks",
+	backForwardCacheNotRestoredReasonWebHID:                                                   "WebHID",
+	backForwardCacheNotRestoredReasonWebShare:                                                 "WebShare",
+	backForwardCacheNotRestoredReasonRequestedStorageAccessGrant:                              "RequestedStorageAccessGrant",
+	backForwardCacheNotRestoredReasonWebNfc:                                                   "WebNfc",
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch:                           "OutstandingNetworkRequestFetch",
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR:                             "OutstandingNetworkRequestXHR",
+	backForwardCacheNotRestoredReasonAppBanner:                                                "AppBanner",
+	backForwardCacheNotRestoredReasonPrinting:                                                 "Printing",
+	backForwardCacheNotRestoredReasonWebDatabase:                                              "WebDatabase",
+	backForwardCacheNotRestoredReasonPictureInPicture:                                         "PictureInPicture",
+	backForwardCacheNotRestoredReasonSpeechRecognizer:                                         "SpeechRecognizer",
+	backForwardCacheNotRestoredReasonIdleManager:                                              "IdleManager",
+	backForwardCacheNotRestoredReasonPaymentManager:                                           "PaymentManager",
+	backForwardCacheNotRestoredReasonSpeechSynthesis:                                          "SpeechSynthesis",
+	backForwardCacheNotRestoredReasonKeyboardLock:                                             "KeyboardLock",
+	backForwardCacheNotRestoredReasonWebOTPService:                                            "WebOTPService",
+	backForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket:                    "OutstandingNetworkRequestDirectSocket",
+	backForwardCacheNotRestoredReasonInjectedJavascript:                                       "InjectedJavascript",
+	backForwardCacheNotRestoredReasonInjectedStyleSheet:                                       "InjectedStyleSheet",
+	backForwardCacheNotRestoredReasonKeepaliveRequest:                                         "KeepaliveRequest",
+	backForwardCacheNotRestoredReasonIndexedDBEvent:                                           "IndexedDBEvent",
+	backForwardCacheNotRestoredReasonDummy:                                                    "Dummy",
+	backForwardCacheNotRestoredReasonJSNetworkRequestReceivedCacheControlNoStoreResource:      "JsNetworkRequestReceivedCacheControlNoStoreResource",
+	backForwardCacheNotRestoredReasonWebRTCSticky:                                             "WebRTCSticky",
+	backForwardCacheNotRestoredReasonWebTransportSticky:                                       "WebTransportSticky",
+	backForwardCacheNotRestoredReasonWebSocketSticky:                                          "WebSocketSticky",
+	backForwardCacheNotRestoredReasonSmartCard:                                                "SmartCard",
+	backForwardCacheNotRestoredReasonLiveMediaStreamTrack:                                     "LiveMediaStreamTrack",
+	backForwardCacheNotRestoredReasonUnloadHandler:                                            "UnloadHandler",
+	backForwardCacheNotRestoredReasonParserAborted:                                            "ParserAborted",
+	backForwardCacheNotRestoredReasonContentSecurityHandler:                                   "ContentSecurityHandler",
+	backForwardCacheNotRestoredReasonContentWebAuthenticationAPI:                              "ContentWebAuthenticationAPI",
+	backForwardCacheNotRestoredReasonContentFileChooser:                                       "ContentFileChooser",
+	backForwardCacheNotRestoredReasonContentSerial:                                            "ContentSerial",
+	backForwardCacheNotRestoredReasonContentFileSystemAccess:                                  "ContentFileSystemAccess",
+	backForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost:                        "ContentMediaDevicesDispatcherHost",
+	backForwardCacheNotRestoredReasonContentWebBluetooth:                                      "ContentWebBluetooth",
+	backForwardCacheNotRestoredReasonContentWebUSB:                                            "ContentWebUSB",
+	backForwardCacheNotRestoredReasonContentMediaSessionService:                               "ContentMediaSessionService",
+	backForwardCacheNotRestoredReasonContentScreenReader:                                      "ContentScreenReader",
+	backForwardCacheNotRestoredReasonContentDiscarded:                                         "ContentDiscarded",
+	backForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper:                            "EmbedderPopupBlockerTabHelper",
+	backForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker:                "EmbedderSafeBrowsingTriggeredPopupBlocker",
+	backForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails:                        "EmbedderSafeBrowsingThreatDetails",
+	backForwardCacheNotRestoredReasonEmbedderAppBannerManager:                                 "EmbedderAppBannerManager",
+	backForwardCacheNotRestoredReasonEmbedderDOMDistillerViewerSource:                         "EmbedderDomDistillerViewerSource",
+	backForwardCacheNotRestoredReasonEmbedderDOMDistillerSelfDeletingRequestDelegate:          "EmbedderDomDistillerSelfDeletingRequestDelegate",
+	backForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper:                         "EmbedderOomInterventionTabHelper",
+	backForwardCacheNotRestoredReasonEmbedderOfflinePage:                                      "EmbedderOfflinePage",
+	backForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager: "EmbedderChromePasswordManagerClientBindCredentialManager",
+	backForwardCacheNotRestoredReasonEmbedderPermissionRequestManager:                         "EmbedderPermissionRequestManager",
+	backForwardCacheNotRestoredReasonEmbedderModalDialog:                                      "EmbedderModalDialog",
+	backForwardCacheNotRestoredReasonEmbedderExtensions:                                       "EmbedderExtensions",
+	backForwardCacheNotRestoredReasonEmbedderExtensionMessaging:                               "EmbedderExtensionMessaging",
+	backForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort:                    "EmbedderExtensionMessagingForOpenPort",
+	backForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame:                "EmbedderExtensionSentMessageToCachedFrame",
+	backForwardCacheNotRestoredReasonRequestedByWebViewClient:                                 "RequestedByWebViewClient",
+	backForwardCacheNotRestoredReasonPostMessageByWebViewClient:                               "PostMessageByWebViewClient",
+	backForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated:          "CacheControlNoStoreDeviceBoundSessionTerminated",
+	backForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure:                 "CacheLimitPrunedOnModerateMemoryPressure",
+	backForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure:                 "CacheLimitPrunedOnCriticalMemoryPressure",
+})
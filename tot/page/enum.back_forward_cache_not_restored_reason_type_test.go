@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBackForwardCacheNotRestoredReasonType(t *testing.T) {
+	var enum BackForwardCacheNotRestoredReasonTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BackForwardCacheNotRestoredReasonTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = BackForwardCacheNotRestoredReasonType.SupportPending
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SupportPending"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SupportPending"`, result)
+	}
+	json.Unmarshal([]byte(`"SupportPending"`), &enum)
+	if BackForwardCacheNotRestoredReasonType.SupportPending != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReasonType.SupportPending, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReasonType.PageSupportNeeded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PageSupportNeeded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PageSupportNeeded"`, result)
+	}
+	json.Unmarshal([]byte(`"PageSupportNeeded"`), &enum)
+	if BackForwardCacheNotRestoredReasonType.PageSupportNeeded != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReasonType.PageSupportNeeded, enum)
+	}
+
+	enum = BackForwardCacheNotRestoredReasonType.Circumstantial
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Circumstantial"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Circumstantial"`, result)
+	}
+	json.Unmarshal([]byte(`"Circumstantial"`), &enum)
+	if BackForwardCacheNotRestoredReasonType.Circumstantial != enum {
+		t.Errorf("Expected %d, got %d", BackForwardCacheNotRestoredReasonType.Circumstantial, enum)
+	}
+}
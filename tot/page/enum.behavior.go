@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type behaviorEnum struct {
@@ -12,7 +15,7 @@ type behaviorEnum struct {
 }
 
 /*
-Behavior provides named acces to the BehaviorEnum values.
+Behavior provides named access to the BehaviorEnum values.
 */
 var Behavior = behaviorEnum{
 	Deny:    behaviorDeny,
@@ -21,11 +24,15 @@ var Behavior = behaviorEnum{
 }
 
 /*
-BehaviorEnum represents whether to allow all or deny all download requests, or
-use default Chrome behavior if available (otherwise deny). Allowed values:
-	- Behavior.Deny    "deny"
-	- Behavior.Allow   "allow"
-	- Behavior.Default "default"
+BehaviorEnum represents the Page.setDownloadBehavior.behavior values.
+
+Whether to allow all or deny all download requests, or use default Chrome
+behavior if available (otherwise deny).
+
+Allowed values:
+  - Behavior.Deny    "deny"
+  - Behavior.Allow   "allow"
+  - Behavior.Default "default"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDownloadBehavior
 */
@@ -35,7 +42,16 @@ type BehaviorEnum int
 String implements Stringer
 */
 func (enum BehaviorEnum) String() string {
-	return _behaviorEnums[enum]
+	return _behaviorEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BehaviorEnum) Unknown() bool {
+	return !_behaviorEnums.Known(enum)
 }
 
 /*
@@ -46,25 +62,16 @@ func (enum BehaviorEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *BehaviorEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _behaviorEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _behaviorEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -76,8 +83,9 @@ const (
 	behaviorDefault
 )
 
-var _behaviorEnums = map[BehaviorEnum]string{
+var _behaviorEnums = enums.New(map[BehaviorEnum]string{
+	BehaviorEnum(0): "",
 	behaviorDeny:    "deny",
 	behaviorAllow:   "allow",
 	behaviorDefault: "default",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -11,8 +13,24 @@ func TestEnumBehavior(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BehaviorEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumBehavior(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"deny"` != string(result) {
-		t.Errorf("Expected '\"deny\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"deny"`, result)
 	}
 	json.Unmarshal([]byte(`"deny"`), &enum)
 	if Behavior.Deny != enum {
-		t.Errorf("Expcected %d, got %d", Behavior.Deny, enum)
+		t.Errorf("Expected %d, got %d", Behavior.Deny, enum)
 	}
 
 	enum = Behavior.Allow
@@ -42,11 +60,11 @@ func TestEnumBehavior(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"allow"` != string(result) {
-		t.Errorf("Expected '\"allow\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"allow"`, result)
 	}
 	json.Unmarshal([]byte(`"allow"`), &enum)
 	if Behavior.Allow != enum {
-		t.Errorf("Expcected %d, got %d", Behavior.Allow, enum)
+		t.Errorf("Expected %d, got %d", Behavior.Allow, enum)
 	}
 
 	enum = Behavior.Default
@@ -55,10 +73,10 @@ func TestEnumBehavior(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"default"` != string(result) {
-		t.Errorf("Expected '\"default\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"default"`, result)
 	}
 	json.Unmarshal([]byte(`"default"`), &enum)
 	if Behavior.Default != enum {
-		t.Errorf("Expcected %d, got %d", Behavior.Default, enum)
+		t.Errorf("Expected %d, got %d", Behavior.Default, enum)
 	}
 }
@@ -0,0 +1,78 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type captureSnapshotFormatEnum struct {
+	Mhtml CaptureSnapshotFormatEnum
+}
+
+/*
+CaptureSnapshotFormat provides named access to the CaptureSnapshotFormatEnum values.
+*/
+var CaptureSnapshotFormat = captureSnapshotFormatEnum{
+	Mhtml: captureSnapshotFormatMhtml,
+}
+
+/*
+CaptureSnapshotFormatEnum represents the Page.captureSnapshot.format values.
+
+Format (defaults to mhtml).
+
+Allowed values:
+  - CaptureSnapshotFormat.Mhtml "mhtml"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureSnapshot
+*/
+type CaptureSnapshotFormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum CaptureSnapshotFormatEnum) String() string {
+	return _captureSnapshotFormatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CaptureSnapshotFormatEnum) Unknown() bool {
+	return !_captureSnapshotFormatEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum CaptureSnapshotFormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *CaptureSnapshotFormatEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _captureSnapshotFormatEnums.Parse(val)
+	return nil
+}
+
+const (
+	// captureSnapshotFormatMhtml represents the "mhtml" value.
+	captureSnapshotFormatMhtml CaptureSnapshotFormatEnum = iota + 1
+)
+
+var _captureSnapshotFormatEnums = enums.New(map[CaptureSnapshotFormatEnum]string{
+	CaptureSnapshotFormatEnum(0): "",
+	captureSnapshotFormatMhtml:   "mhtml",
+})
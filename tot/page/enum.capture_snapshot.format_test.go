@@ -0,0 +1,56 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCaptureSnapshotFormat(t *testing.T) {
+	var enum CaptureSnapshotFormatEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CaptureSnapshotFormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = CaptureSnapshotFormat.Mhtml
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mhtml"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mhtml"`, result)
+	}
+	json.Unmarshal([]byte(`"mhtml"`), &enum)
+	if CaptureSnapshotFormat.Mhtml != enum {
+		t.Errorf("Expected %d, got %d", CaptureSnapshotFormat.Mhtml, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type dialogTypeEnum struct {
@@ -13,7 +16,7 @@ type dialogTypeEnum struct {
 }
 
 /*
-DialogType provides named acces to the DialogTypeEnum values.
+DialogType provides named access to the DialogTypeEnum values.
 */
 var DialogType = dialogTypeEnum{
 	Alert:        dialogTypeAlert,
@@ -23,11 +26,15 @@ var DialogType = dialogTypeEnum{
 }
 
 /*
-DialogTypeEnum defines the Javascript dialog type. Allowed values:
-	- DialogType.Alert        "alert"
-	- DialogType.Confirm      "confirm"
-	- DialogType.Prompt       "prompt"
-	- DialogType.Beforeunload "beforeunload"
+DialogTypeEnum represents the Page.DialogType values.
+
+Javascript dialog type.
+
+Allowed values:
+  - DialogType.Alert        "alert"
+  - DialogType.Confirm      "confirm"
+  - DialogType.Prompt       "prompt"
+  - DialogType.Beforeunload "beforeunload"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-DialogType
 */
@@ -37,7 +44,16 @@ type DialogTypeEnum int
 String implements Stringer
 */
 func (enum DialogTypeEnum) String() string {
-	return _dialogTypeEnums[enum]
+	return _dialogTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DialogTypeEnum) Unknown() bool {
+	return !_dialogTypeEnums.Known(enum)
 }
 
 /*
@@ -48,25 +64,16 @@ func (enum DialogTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *DialogTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _dialogTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _dialogTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -80,9 +87,10 @@ const (
 	dialogTypeBeforeunload
 )
 
-var _dialogTypeEnums = map[DialogTypeEnum]string{
+var _dialogTypeEnums = enums.New(map[DialogTypeEnum]string{
+	DialogTypeEnum(0):      "",
 	dialogTypeAlert:        "alert",
 	dialogTypeConfirm:      "confirm",
 	dialogTypePrompt:       "prompt",
 	dialogTypeBeforeunload: "beforeunload",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -11,8 +13,24 @@ func TestEnumDialogType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DialogTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumDialogType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"alert"` != string(result) {
-		t.Errorf("Expected '\"alert\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"alert"`, result)
 	}
 	json.Unmarshal([]byte(`"alert"`), &enum)
 	if DialogType.Alert != enum {
-		t.Errorf("Expcected %d, got %d", DialogType.Alert, enum)
+		t.Errorf("Expected %d, got %d", DialogType.Alert, enum)
 	}
 
 	enum = DialogType.Confirm
@@ -42,11 +60,11 @@ func TestEnumDialogType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"confirm"` != string(result) {
-		t.Errorf("Expected '\"confirm\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"confirm"`, result)
 	}
 	json.Unmarshal([]byte(`"confirm"`), &enum)
 	if DialogType.Confirm != enum {
-		t.Errorf("Expcected %d, got %d", DialogType.Confirm, enum)
+		t.Errorf("Expected %d, got %d", DialogType.Confirm, enum)
 	}
 
 	enum = DialogType.Prompt
@@ -55,18 +73,12 @@ func TestEnumDialogType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"prompt"` != string(result) {
-		t.Errorf("Expected '\"prompt\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"prompt"`, result)
 	}
 	json.Unmarshal([]byte(`"prompt"`), &enum)
 	if DialogType.Prompt != enum {
-		t.Errorf("Expcected %d, got %d", DialogType.Prompt, enum)
+		t.Errorf("Expected %d, got %d", DialogType.Prompt, enum)
 	}
-}
-
-func TestEnumDialogType2(t *testing.T) {
-	var enum DialogTypeEnum
-	var err error
-	var result []byte
 
 	enum = DialogType.Beforeunload
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumDialogType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"beforeunload"` != string(result) {
-		t.Errorf("Expected '\"beforeunload\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"beforeunload"`, result)
 	}
 	json.Unmarshal([]byte(`"beforeunload"`), &enum)
 	if DialogType.Beforeunload != enum {
-		t.Errorf("Expcected %d, got %d", DialogType.Beforeunload, enum)
+		t.Errorf("Expected %d, got %d", DialogType.Beforeunload, enum)
 	}
 }
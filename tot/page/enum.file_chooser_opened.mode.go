@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type fileChooserOpenedModeEnum struct {
+	SelectSingle   FileChooserOpenedModeEnum
+	SelectMultiple FileChooserOpenedModeEnum
+}
+
+/*
+FileChooserOpenedMode provides named access to the FileChooserOpenedModeEnum values.
+*/
+var FileChooserOpenedMode = fileChooserOpenedModeEnum{
+	SelectSingle:   fileChooserOpenedModeSelectSingle,
+	SelectMultiple: fileChooserOpenedModeSelectMultiple,
+}
+
+/*
+FileChooserOpenedModeEnum represents the Page.fileChooserOpened.mode values.
+
+Input mode.
+
+Allowed values:
+  - FileChooserOpenedMode.SelectSingle   "selectSingle"
+  - FileChooserOpenedMode.SelectMultiple "selectMultiple"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-fileChooserOpened
+*/
+type FileChooserOpenedModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FileChooserOpenedModeEnum) String() string {
+	return _fileChooserOpenedModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FileChooserOpenedModeEnum) Unknown() bool {
+	return !_fileChooserOpenedModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FileChooserOpenedModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FileChooserOpenedModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _fileChooserOpenedModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// fileChooserOpenedModeSelectSingle represents the "selectSingle" value.
+	fileChooserOpenedModeSelectSingle FileChooserOpenedModeEnum = iota + 1
+	// fileChooserOpenedModeSelectMultiple represents the "selectMultiple" value.
+	fileChooserOpenedModeSelectMultiple
+)
+
+var _fileChooserOpenedModeEnums = enums.New(map[FileChooserOpenedModeEnum]string{
+	FileChooserOpenedModeEnum(0):        "",
+	fileChooserOpenedModeSelectSingle:   "selectSingle",
+	fileChooserOpenedModeSelectMultiple: "selectMultiple",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFileChooserOpenedMode(t *testing.T) {
+	var enum FileChooserOpenedModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FileChooserOpenedModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = FileChooserOpenedMode.SelectSingle
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"selectSingle"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"selectSingle"`, result)
+	}
+	json.Unmarshal([]byte(`"selectSingle"`), &enum)
+	if FileChooserOpenedMode.SelectSingle != enum {
+		t.Errorf("Expected %d, got %d", FileChooserOpenedMode.SelectSingle, enum)
+	}
+
+	enum = FileChooserOpenedMode.SelectMultiple
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"selectMultiple"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"selectMultiple"`, result)
+	}
+	json.Unmarshal([]byte(`"selectMultiple"`), &enum)
+	if FileChooserOpenedMode.SelectMultiple != enum {
+		t.Errorf("Expected %d, got %d", FileChooserOpenedMode.SelectMultiple, enum)
+	}
+}
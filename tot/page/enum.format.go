@@ -1,27 +1,37 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type formatEnum struct {
-	Png  FormatEnum
 	Jpeg FormatEnum
+	Png  FormatEnum
+	Webp FormatEnum
 }
 
 /*
-Format provides named acces to the FormatEnum values.
+Format provides named access to the FormatEnum values.
 */
 var Format = formatEnum{
-	Png:  formatPng,
 	Jpeg: formatJpeg,
+	Png:  formatPng,
+	Webp: formatWebp,
 }
 
 /*
-FormatEnum defines the Javascript dialog type. Allowed values:
-	- Format.Png  "png"
-	- Format.Jpeg "jpeg"
+FormatEnum represents the Page.captureScreenshot.format values.
+
+Image compression format (defaults to png).
+
+Allowed values:
+  - Format.Jpeg "jpeg"
+  - Format.Png  "png"
+  - Format.Webp "webp"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-startScreencast
@@ -32,7 +42,16 @@ type FormatEnum int
 String implements Stringer
 */
 func (enum FormatEnum) String() string {
-	return _formatEnums[enum]
+	return _formatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FormatEnum) Unknown() bool {
+	return !_formatEnums.Known(enum)
 }
 
 /*
@@ -43,36 +62,30 @@ func (enum FormatEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *FormatEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _formatEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _formatEnums.Parse(val)
+	return nil
 }
 
 const (
-	// formatPng represents the "png" value.
-	formatPng FormatEnum = iota + 1
 	// formatJpeg represents the "jpeg" value.
-	formatJpeg
+	formatJpeg FormatEnum = iota + 1
+	// formatPng represents the "png" value.
+	formatPng
+	// formatWebp represents the "webp" value.
+	formatWebp
 )
 
-var _formatEnums = map[FormatEnum]string{
+var _formatEnums = enums.New(map[FormatEnum]string{
 	FormatEnum(0): "",
-	formatPng:     "png",
 	formatJpeg:    "jpeg",
-}
+	formatPng:     "png",
+	formatWebp:    "webp",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -15,9 +17,20 @@ func TestEnumFormat(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown FormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -28,29 +41,42 @@ func TestEnumFormat(t *testing.T) {
 		t.Errorf("Expected empty JSON string, got '%s'", result)
 	}
 
+	enum = Format.Jpeg
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"jpeg"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"jpeg"`, result)
+	}
+	json.Unmarshal([]byte(`"jpeg"`), &enum)
+	if Format.Jpeg != enum {
+		t.Errorf("Expected %d, got %d", Format.Jpeg, enum)
+	}
+
 	enum = Format.Png
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"png"` != string(result) {
-		t.Errorf("Expected '\"png\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"png"`, result)
 	}
 	json.Unmarshal([]byte(`"png"`), &enum)
 	if Format.Png != enum {
-		t.Errorf("Expcected %d, got %d", Format.Png, enum)
+		t.Errorf("Expected %d, got %d", Format.Png, enum)
 	}
 
-	enum = Format.Jpeg
+	enum = Format.Webp
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
-	if `"jpeg"` != string(result) {
-		t.Errorf("Expected '\"jpeg\"', got '%s'", result)
+	if `"webp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webp"`, result)
 	}
-	json.Unmarshal([]byte(`"jpeg"`), &enum)
-	if Format.Jpeg != enum {
-		t.Errorf("Expcected %d, got %d", Format.Jpeg, enum)
+	json.Unmarshal([]byte(`"webp"`), &enum)
+	if Format.Webp != enum {
+		t.Errorf("Expected %d, got %d", Format.Webp, enum)
 	}
 }
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type frameDetachedReasonEnum struct {
+	Remove FrameDetachedReasonEnum
+	Swap   FrameDetachedReasonEnum
+}
+
+/*
+FrameDetachedReason provides named access to the FrameDetachedReasonEnum values.
+*/
+var FrameDetachedReason = frameDetachedReasonEnum{
+	Remove: frameDetachedReasonRemove,
+	Swap:   frameDetachedReasonSwap,
+}
+
+/*
+FrameDetachedReasonEnum represents the Page.frameDetached.reason values.
+
+Allowed values:
+  - FrameDetachedReason.Remove "remove"
+  - FrameDetachedReason.Swap   "swap"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
+*/
+type FrameDetachedReasonEnum int
+
+/*
+String implements Stringer
+*/
+func (enum FrameDetachedReasonEnum) String() string {
+	return _frameDetachedReasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum FrameDetachedReasonEnum) Unknown() bool {
+	return !_frameDetachedReasonEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum FrameDetachedReasonEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *FrameDetachedReasonEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _frameDetachedReasonEnums.Parse(val)
+	return nil
+}
+
+const (
+	// frameDetachedReasonRemove represents the "remove" value.
+	frameDetachedReasonRemove FrameDetachedReasonEnum = iota + 1
+	// frameDetachedReasonSwap represents the "swap" value.
+	frameDetachedReasonSwap
+)
+
+var _frameDetachedReasonEnums = enums.New(map[FrameDetachedReasonEnum]string{
+	FrameDetachedReasonEnum(0): "",
+	frameDetachedReasonRemove:  "remove",
+	frameDetachedReasonSwap:    "swap",
+})
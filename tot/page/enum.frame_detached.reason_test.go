@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumFrameDetachedReason(t *testing.T) {
+	var enum FrameDetachedReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown FrameDetachedReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = FrameDetachedReason.Remove
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"remove"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"remove"`, result)
+	}
+	json.Unmarshal([]byte(`"remove"`), &enum)
+	if FrameDetachedReason.Remove != enum {
+		t.Errorf("Expected %d, got %d", FrameDetachedReason.Remove, enum)
+	}
+
+	enum = FrameDetachedReason.Swap
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"swap"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"swap"`, result)
+	}
+	json.Unmarshal([]byte(`"swap"`), &enum)
+	if FrameDetachedReason.Swap != enum {
+		t.Errorf("Expected %d, got %d", FrameDetachedReason.Swap, enum)
+	}
+}
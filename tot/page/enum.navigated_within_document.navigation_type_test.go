@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumNavigatedWithinDocumentNavigationType(t *testing.T) {
+	var enum NavigatedWithinDocumentNavigationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown NavigatedWithinDocumentNavigationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = NavigatedWithinDocumentNavigationType.Fragment
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"fragment"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"fragment"`, result)
+	}
+	json.Unmarshal([]byte(`"fragment"`), &enum)
+	if NavigatedWithinDocumentNavigationType.Fragment != enum {
+		t.Errorf("Expected %d, got %d", NavigatedWithinDocumentNavigationType.Fragment, enum)
+	}
+
+	enum = NavigatedWithinDocumentNavigationType.HistoryAPI
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"historyApi"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"historyApi"`, result)
+	}
+	json.Unmarshal([]byte(`"historyApi"`), &enum)
+	if NavigatedWithinDocumentNavigationType.HistoryAPI != enum {
+		t.Errorf("Expected %d, got %d", NavigatedWithinDocumentNavigationType.HistoryAPI, enum)
+	}
+
+	enum = NavigatedWithinDocumentNavigationType.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
+	}
+	json.Unmarshal([]byte(`"other"`), &enum)
+	if NavigatedWithinDocumentNavigationType.Other != enum {
+		t.Errorf("Expected %d, got %d", NavigatedWithinDocumentNavigationType.Other, enum)
+	}
+}
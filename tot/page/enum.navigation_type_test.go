@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumNavigationType(t *testing.T) {
+	var enum NavigationTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown NavigationTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = NavigationType.Navigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Navigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Navigation"`, result)
+	}
+	json.Unmarshal([]byte(`"Navigation"`), &enum)
+	if NavigationType.Navigation != enum {
+		t.Errorf("Expected %d, got %d", NavigationType.Navigation, enum)
+	}
+
+	enum = NavigationType.BackForwardCacheRestore
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BackForwardCacheRestore"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BackForwardCacheRestore"`, result)
+	}
+	json.Unmarshal([]byte(`"BackForwardCacheRestore"`), &enum)
+	if NavigationType.BackForwardCacheRestore != enum {
+		t.Errorf("Expected %d, got %d", NavigationType.BackForwardCacheRestore, enum)
+	}
+}
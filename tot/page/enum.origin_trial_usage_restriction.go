@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type originTrialUsageRestrictionEnum struct {
+	None   OriginTrialUsageRestrictionEnum
+	Subset OriginTrialUsageRestrictionEnum
+}
+
+/*
+OriginTrialUsageRestriction provides named access to the OriginTrialUsageRestrictionEnum values.
+*/
+var OriginTrialUsageRestriction = originTrialUsageRestrictionEnum{
+	None:   originTrialUsageRestrictionNone,
+	Subset: originTrialUsageRestrictionSubset,
+}
+
+/*
+OriginTrialUsageRestrictionEnum represents the Page.OriginTrialUsageRestriction
+values.
+
+Allowed values:
+  - OriginTrialUsageRestriction.None   "None"
+  - OriginTrialUsageRestriction.Subset "Subset"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-OriginTrialUsageRestriction
+*/
+type OriginTrialUsageRestrictionEnum int
+
+/*
+String implements Stringer
+*/
+func (enum OriginTrialUsageRestrictionEnum) String() string {
+	return _originTrialUsageRestrictionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum OriginTrialUsageRestrictionEnum) Unknown() bool {
+	return !_originTrialUsageRestrictionEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum OriginTrialUsageRestrictionEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *OriginTrialUsageRestrictionEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _originTrialUsageRestrictionEnums.Parse(val)
+	return nil
+}
+
+const (
+	// originTrialUsageRestrictionNone represents the "None" value.
+	originTrialUsageRestrictionNone OriginTrialUsageRestrictionEnum = iota + 1
+	// originTrialUsageRestrictionSubset represents the "Subset" value.
+	originTrialUsageRestrictionSubset
+)
+
+var _originTrialUsageRestrictionEnums = enums.New(map[OriginTrialUsageRestrictionEnum]string{
+	OriginTrialUsageRestrictionEnum(0): "",
+	originTrialUsageRestrictionNone:    "None",
+	originTrialUsageRestrictionSubset:  "Subset",
+})
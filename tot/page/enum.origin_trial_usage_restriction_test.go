@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumOriginTrialUsageRestriction(t *testing.T) {
+	var enum OriginTrialUsageRestrictionEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown OriginTrialUsageRestrictionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = OriginTrialUsageRestriction.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"None"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"None"`, result)
+	}
+	json.Unmarshal([]byte(`"None"`), &enum)
+	if OriginTrialUsageRestriction.None != enum {
+		t.Errorf("Expected %d, got %d", OriginTrialUsageRestriction.None, enum)
+	}
+
+	enum = OriginTrialUsageRestriction.Subset
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Subset"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Subset"`, result)
+	}
+	json.Unmarshal([]byte(`"Subset"`), &enum)
+	if OriginTrialUsageRestriction.Subset != enum {
+		t.Errorf("Expected %d, got %d", OriginTrialUsageRestriction.Subset, enum)
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPermissionsPolicyBlockReason(t *testing.T) {
+	var enum PermissionsPolicyBlockReasonEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PermissionsPolicyBlockReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PermissionsPolicyBlockReason.Header
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Header"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Header"`, result)
+	}
+	json.Unmarshal([]byte(`"Header"`), &enum)
+	if PermissionsPolicyBlockReason.Header != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyBlockReason.Header, enum)
+	}
+
+	enum = PermissionsPolicyBlockReason.IframeAttribute
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"IframeAttribute"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"IframeAttribute"`, result)
+	}
+	json.Unmarshal([]byte(`"IframeAttribute"`), &enum)
+	if PermissionsPolicyBlockReason.IframeAttribute != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyBlockReason.IframeAttribute, enum)
+	}
+
+	enum = PermissionsPolicyBlockReason.InFencedFrameTree
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InFencedFrameTree"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InFencedFrameTree"`, result)
+	}
+	json.Unmarshal([]byte(`"InFencedFrameTree"`), &enum)
+	if PermissionsPolicyBlockReason.InFencedFrameTree != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyBlockReason.InFencedFrameTree, enum)
+	}
+
+	enum = PermissionsPolicyBlockReason.InIsolatedApp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InIsolatedApp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InIsolatedApp"`, result)
+	}
+	json.Unmarshal([]byte(`"InIsolatedApp"`), &enum)
+	if PermissionsPolicyBlockReason.InIsolatedApp != enum {
+		t.Errorf("Expected %d, got %d", PermissionsPolicyBlockReason.InIsolatedApp, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type transferModeEnum struct {
+	ReturnAsBase64 TransferModeEnum
+	ReturnAsStream TransferModeEnum
+}
+
+/*
+TransferMode provides named access to the TransferModeEnum values.
+*/
+var TransferMode = transferModeEnum{
+	ReturnAsBase64: transferModeReturnAsBase64,
+	ReturnAsStream: transferModeReturnAsStream,
+}
+
+/*
+TransferModeEnum represents the Page.printToPDF.transferMode values.
+
+return as stream
+
+Allowed values:
+  - TransferMode.ReturnAsBase64 "ReturnAsBase64"
+  - TransferMode.ReturnAsStream "ReturnAsStream"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
+*/
+type TransferModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TransferModeEnum) String() string {
+	return _transferModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TransferModeEnum) Unknown() bool {
+	return !_transferModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TransferModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TransferModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _transferModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// transferModeReturnAsBase64 represents the "ReturnAsBase64" value.
+	transferModeReturnAsBase64 TransferModeEnum = iota + 1
+	// transferModeReturnAsStream represents the "ReturnAsStream" value.
+	transferModeReturnAsStream
+)
+
+var _transferModeEnums = enums.New(map[TransferModeEnum]string{
+	TransferModeEnum(0):        "",
+	transferModeReturnAsBase64: "ReturnAsBase64",
+	transferModeReturnAsStream: "ReturnAsStream",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumTransferMode(t *testing.T) {
+	var enum TransferModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TransferModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = TransferMode.ReturnAsBase64
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ReturnAsBase64"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ReturnAsBase64"`, result)
+	}
+	json.Unmarshal([]byte(`"ReturnAsBase64"`), &enum)
+	if TransferMode.ReturnAsBase64 != enum {
+		t.Errorf("Expected %d, got %d", TransferMode.ReturnAsBase64, enum)
+	}
+
+	enum = TransferMode.ReturnAsStream
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ReturnAsStream"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ReturnAsStream"`, result)
+	}
+	json.Unmarshal([]byte(`"ReturnAsStream"`), &enum)
+	if TransferMode.ReturnAsStream != enum {
+		t.Errorf("Expected %d, got %d", TransferMode.ReturnAsStream, enum)
+	}
+}
@@ -1,44 +1,58 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type reasonEnum struct {
-	FormSubmissionGet     ReasonEnum
-	FormSubmissionPost    ReasonEnum
-	HTTPHeaderRefresh     ReasonEnum
-	ScriptInitiated       ReasonEnum
-	MetaTagRefresh        ReasonEnum
-	PageBlockInterstitial ReasonEnum
-	Reload                ReasonEnum
+	AnchorClick            ReasonEnum
+	FormSubmissionGet      ReasonEnum
+	FormSubmissionPost     ReasonEnum
+	HTTPHeaderRefresh      ReasonEnum
+	InitialFrameNavigation ReasonEnum
+	MetaTagRefresh         ReasonEnum
+	Other                  ReasonEnum
+	PageBlockInterstitial  ReasonEnum
+	Reload                 ReasonEnum
+	ScriptInitiated        ReasonEnum
 }
 
 /*
-Reason provides named acces to the ReasonEnum values.
+Reason provides named access to the ReasonEnum values.
 */
 var Reason = reasonEnum{
-	FormSubmissionGet:     reasonFormSubmissionGet,
-	FormSubmissionPost:    reasonFormSubmissionPost,
-	HTTPHeaderRefresh:     reasonHTTPHeaderRefresh,
-	ScriptInitiated:       reasonScriptInitiated,
-	MetaTagRefresh:        reasonMetaTagRefresh,
-	PageBlockInterstitial: reasonPageBlockInterstitial,
-	Reload:                reasonReload,
+	AnchorClick:            reasonAnchorClick,
+	FormSubmissionGet:      reasonFormSubmissionGet,
+	FormSubmissionPost:     reasonFormSubmissionPost,
+	HTTPHeaderRefresh:      reasonHTTPHeaderRefresh,
+	InitialFrameNavigation: reasonInitialFrameNavigation,
+	MetaTagRefresh:         reasonMetaTagRefresh,
+	Other:                  reasonOther,
+	PageBlockInterstitial:  reasonPageBlockInterstitial,
+	Reload:                 reasonReload,
+	ScriptInitiated:        reasonScriptInitiated,
 }
 
 /*
-ReasonEnum represents the reason for the navigation. Allowed values:
-	- Reason.FormSubmissionGet     "formSubmissionGet"
-	- Reason.FormSubmissionPost    "formSubmissionPost"
-	- Reason.HTTPHeaderRefresh     "httpHeaderRefresh"
-	- Reason.ScriptInitiated       "scriptInitiated"
-	- Reason.MetaTagRefresh        "metaTagRefresh"
-	- Reason.PageBlockInterstitial "pageBlockInterstitial"
-	- Reason.Reload                "reload"
+ReasonEnum represents the Page.ClientNavigationReason values.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
+Allowed values:
+  - Reason.AnchorClick            "anchorClick"
+  - Reason.FormSubmissionGet      "formSubmissionGet"
+  - Reason.FormSubmissionPost     "formSubmissionPost"
+  - Reason.HTTPHeaderRefresh      "httpHeaderRefresh"
+  - Reason.InitialFrameNavigation "initialFrameNavigation"
+  - Reason.MetaTagRefresh         "metaTagRefresh"
+  - Reason.Other                  "other"
+  - Reason.PageBlockInterstitial  "pageBlockInterstitial"
+  - Reason.Reload                 "reload"
+  - Reason.ScriptInitiated        "scriptInitiated"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ClientNavigationReason
 */
 type ReasonEnum int
 
@@ -46,7 +60,16 @@ type ReasonEnum int
 String implements Stringer
 */
 func (enum ReasonEnum) String() string {
-	return _reasonEnums[enum]
+	return _reasonEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReasonEnum) Unknown() bool {
+	return !_reasonEnums.Known(enum)
 }
 
 /*
@@ -57,50 +80,51 @@ func (enum ReasonEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ReasonEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _reasonEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _reasonEnums.Parse(val)
+	return nil
 }
 
 const (
+	// reasonAnchorClick represents the "anchorClick" value.
+	reasonAnchorClick ReasonEnum = iota + 1
 	// reasonFormSubmissionGet represents the "formSubmissionGet" value.
-	reasonFormSubmissionGet ReasonEnum = iota + 1
+	reasonFormSubmissionGet
 	// reasonFormSubmissionPost represents the "formSubmissionPost" value.
 	reasonFormSubmissionPost
 	// reasonHTTPHeaderRefresh represents the "httpHeaderRefresh" value.
 	reasonHTTPHeaderRefresh
-	// reasonScriptInitiated represents the "scriptInitiated" value.
-	reasonScriptInitiated
+	// reasonInitialFrameNavigation represents the "initialFrameNavigation" value.
+	reasonInitialFrameNavigation
 	// reasonMetaTagRefresh represents the "metaTagRefresh" value.
 	reasonMetaTagRefresh
+	// reasonOther represents the "other" value.
+	reasonOther
 	// reasonPageBlockInterstitial represents the "pageBlockInterstitial" value.
 	reasonPageBlockInterstitial
 	// reasonReload represents the "reload" value.
 	reasonReload
+	// reasonScriptInitiated represents the "scriptInitiated" value.
+	reasonScriptInitiated
 )
 
-var _reasonEnums = map[ReasonEnum]string{
-	reasonFormSubmissionGet:     "formSubmissionGet",
-	reasonFormSubmissionPost:    "formSubmissionPost",
-	reasonHTTPHeaderRefresh:     "httpHeaderRefresh",
-	reasonScriptInitiated:       "scriptInitiated",
-	reasonMetaTagRefresh:        "metaTagRefresh",
-	reasonPageBlockInterstitial: "pageBlockInterstitial",
-	reasonReload:                "reload",
-}
+var _reasonEnums = enums.New(map[ReasonEnum]string{
+	ReasonEnum(0):                "",
+	reasonAnchorClick:            "anchorClick",
+	reasonFormSubmissionGet:      "formSubmissionGet",
+	reasonFormSubmissionPost:     "formSubmissionPost",
+	reasonHTTPHeaderRefresh:      "httpHeaderRefresh",
+	reasonInitialFrameNavigation: "initialFrameNavigation",
+	reasonMetaTagRefresh:         "metaTagRefresh",
+	reasonOther:                  "other",
+	reasonPageBlockInterstitial:  "pageBlockInterstitial",
+	reasonReload:                 "reload",
+	reasonScriptInitiated:        "scriptInitiated",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -11,8 +13,24 @@ func TestEnumReason(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ReasonEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -23,17 +41,30 @@ func TestEnumReason(t *testing.T) {
 		t.Errorf("Expected empty JSON string, got '%s'", result)
 	}
 
+	enum = Reason.AnchorClick
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"anchorClick"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"anchorClick"`, result)
+	}
+	json.Unmarshal([]byte(`"anchorClick"`), &enum)
+	if Reason.AnchorClick != enum {
+		t.Errorf("Expected %d, got %d", Reason.AnchorClick, enum)
+	}
+
 	enum = Reason.FormSubmissionGet
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"formSubmissionGet"` != string(result) {
-		t.Errorf("Expected '\"formSubmissionGet\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"formSubmissionGet"`, result)
 	}
 	json.Unmarshal([]byte(`"formSubmissionGet"`), &enum)
 	if Reason.FormSubmissionGet != enum {
-		t.Errorf("Expcected %d, got %d", Reason.FormSubmissionGet, enum)
+		t.Errorf("Expected %d, got %d", Reason.FormSubmissionGet, enum)
 	}
 
 	enum = Reason.FormSubmissionPost
@@ -42,11 +73,11 @@ func TestEnumReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"formSubmissionPost"` != string(result) {
-		t.Errorf("Expected '\"formSubmissionPost\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"formSubmissionPost"`, result)
 	}
 	json.Unmarshal([]byte(`"formSubmissionPost"`), &enum)
 	if Reason.FormSubmissionPost != enum {
-		t.Errorf("Expcected %d, got %d", Reason.FormSubmissionPost, enum)
+		t.Errorf("Expected %d, got %d", Reason.FormSubmissionPost, enum)
 	}
 
 	enum = Reason.HTTPHeaderRefresh
@@ -55,29 +86,24 @@ func TestEnumReason(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"httpHeaderRefresh"` != string(result) {
-		t.Errorf("Expected '\"httpHeaderRefresh\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"httpHeaderRefresh"`, result)
 	}
 	json.Unmarshal([]byte(`"httpHeaderRefresh"`), &enum)
 	if Reason.HTTPHeaderRefresh != enum {
-		t.Errorf("Expcected %d, got %d", Reason.HTTPHeaderRefresh, enum)
+		t.Errorf("Expected %d, got %d", Reason.HTTPHeaderRefresh, enum)
 	}
-}
-func TestEnumReason2(t *testing.T) {
-	var enum ReasonEnum
-	var err error
-	var result []byte
 
-	enum = Reason.ScriptInitiated
+	enum = Reason.InitialFrameNavigation
 	result, err = json.Marshal(enum)
 	if nil != err {
 		t.Errorf("Expected nil, got error")
 	}
-	if `"scriptInitiated"` != string(result) {
-		t.Errorf("Expected '\"scriptInitiated\"', got '%s'", result)
+	if `"initialFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"initialFrameNavigation"`, result)
 	}
-	json.Unmarshal([]byte(`"scriptInitiated"`), &enum)
-	if Reason.ScriptInitiated != enum {
-		t.Errorf("Expcected %d, got %d", Reason.ScriptInitiated, enum)
+	json.Unmarshal([]byte(`"initialFrameNavigation"`), &enum)
+	if Reason.InitialFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", Reason.InitialFrameNavigation, enum)
 	}
 
 	enum = Reason.MetaTagRefresh
@@ -86,11 +112,24 @@ func TestEnumReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"metaTagRefresh"` != string(result) {
-		t.Errorf("Expected '\"metaTagRefresh\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"metaTagRefresh"`, result)
 	}
 	json.Unmarshal([]byte(`"metaTagRefresh"`), &enum)
 	if Reason.MetaTagRefresh != enum {
-		t.Errorf("Expcected %d, got %d", Reason.MetaTagRefresh, enum)
+		t.Errorf("Expected %d, got %d", Reason.MetaTagRefresh, enum)
+	}
+
+	enum = Reason.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
+	}
+	json.Unmarshal([]byte(`"other"`), &enum)
+	if Reason.Other != enum {
+		t.Errorf("Expected %d, got %d", Reason.Other, enum)
 	}
 
 	enum = Reason.PageBlockInterstitial
@@ -99,11 +138,11 @@ func TestEnumReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"pageBlockInterstitial"` != string(result) {
-		t.Errorf("Expected '\"pageBlockInterstitial\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"pageBlockInterstitial"`, result)
 	}
 	json.Unmarshal([]byte(`"pageBlockInterstitial"`), &enum)
 	if Reason.PageBlockInterstitial != enum {
-		t.Errorf("Expcected %d, got %d", Reason.PageBlockInterstitial, enum)
+		t.Errorf("Expected %d, got %d", Reason.PageBlockInterstitial, enum)
 	}
 
 	enum = Reason.Reload
@@ -112,10 +151,23 @@ func TestEnumReason2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"reload"` != string(result) {
-		t.Errorf("Expected '\"reload\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"reload"`, result)
 	}
 	json.Unmarshal([]byte(`"reload"`), &enum)
 	if Reason.Reload != enum {
-		t.Errorf("Expcected %d, got %d", Reason.Reload, enum)
+		t.Errorf("Expected %d, got %d", Reason.Reload, enum)
+	}
+
+	enum = Reason.ScriptInitiated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"scriptInitiated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"scriptInitiated"`, result)
+	}
+	json.Unmarshal([]byte(`"scriptInitiated"`), &enum)
+	if Reason.ScriptInitiated != enum {
+		t.Errorf("Expected %d, got %d", Reason.ScriptInitiated, enum)
 	}
 }
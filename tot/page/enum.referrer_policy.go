@@ -0,0 +1,120 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type referrerPolicyEnum struct {
+	NoReferrer                  ReferrerPolicyEnum
+	NoReferrerWhenDowngrade     ReferrerPolicyEnum
+	Origin                      ReferrerPolicyEnum
+	OriginWhenCrossOrigin       ReferrerPolicyEnum
+	SameOrigin                  ReferrerPolicyEnum
+	StrictOrigin                ReferrerPolicyEnum
+	StrictOriginWhenCrossOrigin ReferrerPolicyEnum
+	UnsafeURL                   ReferrerPolicyEnum
+}
+
+/*
+ReferrerPolicy provides named access to the ReferrerPolicyEnum values.
+*/
+var ReferrerPolicy = referrerPolicyEnum{
+	NoReferrer:                  referrerPolicyNoReferrer,
+	NoReferrerWhenDowngrade:     referrerPolicyNoReferrerWhenDowngrade,
+	Origin:                      referrerPolicyOrigin,
+	OriginWhenCrossOrigin:       referrerPolicyOriginWhenCrossOrigin,
+	SameOrigin:                  referrerPolicySameOrigin,
+	StrictOrigin:                referrerPolicyStrictOrigin,
+	StrictOriginWhenCrossOrigin: referrerPolicyStrictOriginWhenCrossOrigin,
+	UnsafeURL:                   referrerPolicyUnsafeURL,
+}
+
+/*
+ReferrerPolicyEnum represents the Page.ReferrerPolicy values.
+
+The referring-policy used for the navigation.
+
+Allowed values:
+  - ReferrerPolicy.NoReferrer                  "noReferrer"
+  - ReferrerPolicy.NoReferrerWhenDowngrade     "noReferrerWhenDowngrade"
+  - ReferrerPolicy.Origin                      "origin"
+  - ReferrerPolicy.OriginWhenCrossOrigin       "originWhenCrossOrigin"
+  - ReferrerPolicy.SameOrigin                  "sameOrigin"
+  - ReferrerPolicy.StrictOrigin                "strictOrigin"
+  - ReferrerPolicy.StrictOriginWhenCrossOrigin "strictOriginWhenCrossOrigin"
+  - ReferrerPolicy.UnsafeURL                   "unsafeUrl"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ReferrerPolicy
+*/
+type ReferrerPolicyEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ReferrerPolicyEnum) String() string {
+	return _referrerPolicyEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ReferrerPolicyEnum) Unknown() bool {
+	return !_referrerPolicyEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ReferrerPolicyEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ReferrerPolicyEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _referrerPolicyEnums.Parse(val)
+	return nil
+}
+
+const (
+	// referrerPolicyNoReferrer represents the "noReferrer" value.
+	referrerPolicyNoReferrer ReferrerPolicyEnum = iota + 1
+	// referrerPolicyNoReferrerWhenDowngrade represents the "noReferrerWhenDowngrade" value.
+	referrerPolicyNoReferrerWhenDowngrade
+	// referrerPolicyOrigin represents the "origin" value.
+	referrerPolicyOrigin
+	// referrerPolicyOriginWhenCrossOrigin represents the "originWhenCrossOrigin" value.
+	referrerPolicyOriginWhenCrossOrigin
+	// referrerPolicySameOrigin represents the "sameOrigin" value.
+	referrerPolicySameOrigin
+	// referrerPolicyStrictOrigin represents the "strictOrigin" value.
+	referrerPolicyStrictOrigin
+	// referrerPolicyStrictOriginWhenCrossOrigin represents the "strictOriginWhenCrossOrigin" value.
+	referrerPolicyStrictOriginWhenCrossOrigin
+	// referrerPolicyUnsafeURL represents the "unsafeUrl" value.
+	referrerPolicyUnsafeURL
+)
+
+var _referrerPolicyEnums = enums.New(map[ReferrerPolicyEnum]string{
+	ReferrerPolicyEnum(0):                     "",
+	referrerPolicyNoReferrer:                  "noReferrer",
+	referrerPolicyNoReferrerWhenDowngrade:     "noReferrerWhenDowngrade",
+	referrerPolicyOrigin:                      "origin",
+	referrerPolicyOriginWhenCrossOrigin:       "originWhenCrossOrigin",
+	referrerPolicySameOrigin:                  "sameOrigin",
+	referrerPolicyStrictOrigin:                "strictOrigin",
+	referrerPolicyStrictOriginWhenCrossOrigin: "strictOriginWhenCrossOrigin",
+	referrerPolicyUnsafeURL:                   "unsafeUrl",
+})
@@ -0,0 +1,147 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumReferrerPolicy(t *testing.T) {
+	var enum ReferrerPolicyEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ReferrerPolicyEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ReferrerPolicy.NoReferrer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noReferrer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noReferrer"`, result)
+	}
+	json.Unmarshal([]byte(`"noReferrer"`), &enum)
+	if ReferrerPolicy.NoReferrer != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.NoReferrer, enum)
+	}
+
+	enum = ReferrerPolicy.NoReferrerWhenDowngrade
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noReferrerWhenDowngrade"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noReferrerWhenDowngrade"`, result)
+	}
+	json.Unmarshal([]byte(`"noReferrerWhenDowngrade"`), &enum)
+	if ReferrerPolicy.NoReferrerWhenDowngrade != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.NoReferrerWhenDowngrade, enum)
+	}
+
+	enum = ReferrerPolicy.Origin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"origin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"origin"`, result)
+	}
+	json.Unmarshal([]byte(`"origin"`), &enum)
+	if ReferrerPolicy.Origin != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.Origin, enum)
+	}
+
+	enum = ReferrerPolicy.OriginWhenCrossOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"originWhenCrossOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"originWhenCrossOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"originWhenCrossOrigin"`), &enum)
+	if ReferrerPolicy.OriginWhenCrossOrigin != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.OriginWhenCrossOrigin, enum)
+	}
+
+	enum = ReferrerPolicy.SameOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sameOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sameOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"sameOrigin"`), &enum)
+	if ReferrerPolicy.SameOrigin != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.SameOrigin, enum)
+	}
+
+	enum = ReferrerPolicy.StrictOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"strictOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"strictOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"strictOrigin"`), &enum)
+	if ReferrerPolicy.StrictOrigin != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.StrictOrigin, enum)
+	}
+
+	enum = ReferrerPolicy.StrictOriginWhenCrossOrigin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"strictOriginWhenCrossOrigin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"strictOriginWhenCrossOrigin"`, result)
+	}
+	json.Unmarshal([]byte(`"strictOriginWhenCrossOrigin"`), &enum)
+	if ReferrerPolicy.StrictOriginWhenCrossOrigin != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.StrictOriginWhenCrossOrigin, enum)
+	}
+
+	enum = ReferrerPolicy.UnsafeURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unsafeUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unsafeUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"unsafeUrl"`), &enum)
+	if ReferrerPolicy.UnsafeURL != enum {
+		t.Errorf("Expected %d, got %d", ReferrerPolicy.UnsafeURL, enum)
+	}
+}
@@ -1,63 +1,87 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type resourceTypeEnum struct {
-	Document    ResourceTypeEnum
-	Stylesheet  ResourceTypeEnum
-	Image       ResourceTypeEnum
-	Media       ResourceTypeEnum
-	Font        ResourceTypeEnum
-	Script      ResourceTypeEnum
-	TextTrack   ResourceTypeEnum
-	XHR         ResourceTypeEnum
-	Fetch       ResourceTypeEnum
-	EventSource ResourceTypeEnum
-	WebSocket   ResourceTypeEnum
-	Manifest    ResourceTypeEnum
-	Other       ResourceTypeEnum
+	Document           ResourceTypeEnum
+	Stylesheet         ResourceTypeEnum
+	Image              ResourceTypeEnum
+	Media              ResourceTypeEnum
+	Font               ResourceTypeEnum
+	Script             ResourceTypeEnum
+	TextTrack          ResourceTypeEnum
+	XHR                ResourceTypeEnum
+	Fetch              ResourceTypeEnum
+	Prefetch           ResourceTypeEnum
+	EventSource        ResourceTypeEnum
+	WebSocket          ResourceTypeEnum
+	Manifest           ResourceTypeEnum
+	SignedExchange     ResourceTypeEnum
+	Ping               ResourceTypeEnum
+	CSPViolationReport ResourceTypeEnum
+	Preflight          ResourceTypeEnum
+	FedCM              ResourceTypeEnum
+	Other              ResourceTypeEnum
 }
 
 /*
-ResourceType provides named acces to the ResourceTypeEnum values.
+ResourceType provides named access to the ResourceTypeEnum values.
 */
 var ResourceType = resourceTypeEnum{
-	Document:    resourceTypeDocument,
-	Stylesheet:  resourceTypeStylesheet,
-	Image:       resourceTypeImage,
-	Media:       resourceTypeMedia,
-	Font:        resourceTypeFont,
-	Script:      resourceTypeScript,
-	TextTrack:   resourceTypeTextTrack,
-	XHR:         resourceTypeXHR,
-	Fetch:       resourceTypeFetch,
-	EventSource: resourceTypeEventSource,
-	WebSocket:   resourceTypeWebSocket,
-	Manifest:    resourceTypeManifest,
-	Other:       resourceTypeOther,
+	Document:           resourceTypeDocument,
+	Stylesheet:         resourceTypeStylesheet,
+	Image:              resourceTypeImage,
+	Media:              resourceTypeMedia,
+	Font:               resourceTypeFont,
+	Script:             resourceTypeScript,
+	TextTrack:          resourceTypeTextTrack,
+	XHR:                resourceTypeXHR,
+	Fetch:              resourceTypeFetch,
+	Prefetch:           resourceTypePrefetch,
+	EventSource:        resourceTypeEventSource,
+	WebSocket:          resourceTypeWebSocket,
+	Manifest:           resourceTypeManifest,
+	SignedExchange:     resourceTypeSignedExchange,
+	Ping:               resourceTypePing,
+	CSPViolationReport: resourceTypeCSPViolationReport,
+	Preflight:          resourceTypePreflight,
+	FedCM:              resourceTypeFedCM,
+	Other:              resourceTypeOther,
 }
 
 /*
-ResourceTypeEnum represents the resource type as it was perceived by the
-rendering engine. Allowed Values:
-	- ResourceType.Document    "Document"
-	- ResourceType.Stylesheet  "Stylesheet"
-	- ResourceType.Image       "Image"
-	- ResourceType.Media       "Media"
-	- ResourceType.Font        "Font"
-	- ResourceType.Script      "Script"
-	- ResourceType.TextTrack   "TextTrack"
-	- ResourceType.XHR         "XHR"
-	- ResourceType.Fetch       "Fetch"
-	- ResourceType.EventSource "EventSource"
-	- ResourceType.WebSocket   "WebSocket"
-	- ResourceType.Manifest    "Manifest"
-	- ResourceType.Other       "Other"
+ResourceTypeEnum represents the Network.ResourceType values.
+
+Resource type as it was perceived by the rendering engine.
+
+Allowed values:
+  - ResourceType.Document           "Document"
+  - ResourceType.Stylesheet         "Stylesheet"
+  - ResourceType.Image              "Image"
+  - ResourceType.Media              "Media"
+  - ResourceType.Font               "Font"
+  - ResourceType.Script             "Script"
+  - ResourceType.TextTrack          "TextTrack"
+  - ResourceType.XHR                "XHR"
+  - ResourceType.Fetch              "Fetch"
+  - ResourceType.Prefetch           "Prefetch"
+  - ResourceType.EventSource        "EventSource"
+  - ResourceType.WebSocket          "WebSocket"
+  - ResourceType.Manifest           "Manifest"
+  - ResourceType.SignedExchange     "SignedExchange"
+  - ResourceType.Ping               "Ping"
+  - ResourceType.CSPViolationReport "CSPViolationReport"
+  - ResourceType.Preflight          "Preflight"
+  - ResourceType.FedCM              "FedCM"
+  - ResourceType.Other              "Other"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-ResourceType
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#type-ResourceType
 */
 type ResourceTypeEnum int
 
@@ -65,7 +89,16 @@ type ResourceTypeEnum int
 String implements Stringer
 */
 func (enum ResourceTypeEnum) String() string {
-	return _resourceTypeEnums[enum]
+	return _resourceTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ResourceTypeEnum) Unknown() bool {
+	return !_resourceTypeEnums.Known(enum)
 }
 
 /*
@@ -76,25 +109,16 @@ func (enum ResourceTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ResourceTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _resourceTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _resourceTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -116,28 +140,47 @@ const (
 	resourceTypeXHR
 	// resourceTypeFetch represents the "Fetch" value.
 	resourceTypeFetch
+	// resourceTypePrefetch represents the "Prefetch" value.
+	resourceTypePrefetch
 	// resourceTypeEventSource represents the "EventSource" value.
 	resourceTypeEventSource
 	// resourceTypeWebSocket represents the "WebSocket" value.
 	resourceTypeWebSocket
 	// resourceTypeManifest represents the "Manifest" value.
 	resourceTypeManifest
+	// resourceTypeSignedExchange represents the "SignedExchange" value.
+	resourceTypeSignedExchange
+	// resourceTypePing represents the "Ping" value.
+	resourceTypePing
+	// resourceTypeCSPViolationReport represents the "CSPViolationReport" value.
+	resourceTypeCSPViolationReport
+	// resourceTypePreflight represents the "Preflight" value.
+	resourceTypePreflight
+	// resourceTypeFedCM represents the "FedCM" value.
+	resourceTypeFedCM
 	// resourceTypeOther represents the "Other" value.
 	resourceTypeOther
 )
 
-var _resourceTypeEnums = map[ResourceTypeEnum]string{
-	resourceTypeDocument:    "Document",
-	resourceTypeStylesheet:  "Stylesheet",
-	resourceTypeImage:       "Image",
-	resourceTypeMedia:       "Media",
-	resourceTypeFont:        "Font",
-	resourceTypeScript:      "Script",
-	resourceTypeTextTrack:   "TextTrack",
-	resourceTypeXHR:         "XHR",
-	resourceTypeFetch:       "Fetch",
-	resourceTypeEventSource: "EventSource",
-	resourceTypeWebSocket:   "WebSocket",
-	resourceTypeManifest:    "Manifest",
-	resourceTypeOther:       "Other",
-}
+var _resourceTypeEnums = enums.New(map[ResourceTypeEnum]string{
+	ResourceTypeEnum(0):            "",
+	resourceTypeDocument:           "Document",
+	resourceTypeStylesheet:         "Stylesheet",
+	resourceTypeImage:              "Image",
+	resourceTypeMedia:              "Media",
+	resourceTypeFont:               "Font",
+	resourceTypeScript:             "Script",
+	resourceTypeTextTrack:          "TextTrack",
+	resourceTypeXHR:                "XHR",
+	resourceTypeFetch:              "Fetch",
+	resourceTypePrefetch:           "Prefetch",
+	resourceTypeEventSource:        "EventSource",
+	resourceTypeWebSocket:          "WebSocket",
+	resourceTypeManifest:           "Manifest",
+	resourceTypeSignedExchange:     "SignedExchange",
+	resourceTypePing:               "Ping",
+	resourceTypeCSPViolationReport: "CSPViolationReport",
+	resourceTypePreflight:          "Preflight",
+	resourceTypeFedCM:              "FedCM",
+	resourceTypeOther:              "Other",
+})
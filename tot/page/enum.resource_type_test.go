@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -11,8 +13,24 @@ func TestEnumResourceType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ResourceTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumResourceType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Document"` != string(result) {
-		t.Errorf("Expected '\"Document\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Document"`, result)
 	}
 	json.Unmarshal([]byte(`"Document"`), &enum)
 	if ResourceType.Document != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Document, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Document, enum)
 	}
 
 	enum = ResourceType.Stylesheet
@@ -42,11 +60,11 @@ func TestEnumResourceType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Stylesheet"` != string(result) {
-		t.Errorf("Expected '\"Stylesheet\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Stylesheet"`, result)
 	}
 	json.Unmarshal([]byte(`"Stylesheet"`), &enum)
 	if ResourceType.Stylesheet != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Stylesheet, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Stylesheet, enum)
 	}
 
 	enum = ResourceType.Image
@@ -55,18 +73,12 @@ func TestEnumResourceType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Image"` != string(result) {
-		t.Errorf("Expected '\"Image\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Image"`, result)
 	}
 	json.Unmarshal([]byte(`"Image"`), &enum)
 	if ResourceType.Image != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Image, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Image, enum)
 	}
-}
-
-func TestEnumResourceType2(t *testing.T) {
-	var enum ResourceTypeEnum
-	var err error
-	var result []byte
 
 	enum = ResourceType.Media
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumResourceType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Media"` != string(result) {
-		t.Errorf("Expected '\"Media\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Media"`, result)
 	}
 	json.Unmarshal([]byte(`"Media"`), &enum)
 	if ResourceType.Media != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Media, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Media, enum)
 	}
 
 	enum = ResourceType.Font
@@ -87,11 +99,11 @@ func TestEnumResourceType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Font"` != string(result) {
-		t.Errorf("Expected '\"Font\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Font"`, result)
 	}
 	json.Unmarshal([]byte(`"Font"`), &enum)
 	if ResourceType.Font != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Font, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Font, enum)
 	}
 
 	enum = ResourceType.Script
@@ -100,11 +112,11 @@ func TestEnumResourceType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Script"` != string(result) {
-		t.Errorf("Expected '\"Script\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Script"`, result)
 	}
 	json.Unmarshal([]byte(`"Script"`), &enum)
 	if ResourceType.Script != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Script, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Script, enum)
 	}
 
 	enum = ResourceType.TextTrack
@@ -113,18 +125,12 @@ func TestEnumResourceType2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"TextTrack"` != string(result) {
-		t.Errorf("Expected '\"TextTrack\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"TextTrack"`, result)
 	}
 	json.Unmarshal([]byte(`"TextTrack"`), &enum)
 	if ResourceType.TextTrack != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.TextTrack, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.TextTrack, enum)
 	}
-}
-
-func TestEnumResourceType3(t *testing.T) {
-	var enum ResourceTypeEnum
-	var err error
-	var result []byte
 
 	enum = ResourceType.XHR
 	result, err = json.Marshal(enum)
@@ -132,11 +138,11 @@ func TestEnumResourceType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"XHR"` != string(result) {
-		t.Errorf("Expected '\"XHR\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"XHR"`, result)
 	}
 	json.Unmarshal([]byte(`"XHR"`), &enum)
 	if ResourceType.XHR != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.XHR, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.XHR, enum)
 	}
 
 	enum = ResourceType.Fetch
@@ -145,11 +151,24 @@ func TestEnumResourceType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Fetch"` != string(result) {
-		t.Errorf("Expected '\"Fetch\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Fetch"`, result)
 	}
 	json.Unmarshal([]byte(`"Fetch"`), &enum)
 	if ResourceType.Fetch != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Fetch, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Fetch, enum)
+	}
+
+	enum = ResourceType.Prefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Prefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Prefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"Prefetch"`), &enum)
+	if ResourceType.Prefetch != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Prefetch, enum)
 	}
 
 	enum = ResourceType.EventSource
@@ -158,11 +177,11 @@ func TestEnumResourceType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"EventSource"` != string(result) {
-		t.Errorf("Expected '\"EventSource\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"EventSource"`, result)
 	}
 	json.Unmarshal([]byte(`"EventSource"`), &enum)
 	if ResourceType.EventSource != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.EventSource, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.EventSource, enum)
 	}
 
 	enum = ResourceType.WebSocket
@@ -171,18 +190,12 @@ func TestEnumResourceType3(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"WebSocket"` != string(result) {
-		t.Errorf("Expected '\"WebSocket\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"WebSocket"`, result)
 	}
 	json.Unmarshal([]byte(`"WebSocket"`), &enum)
 	if ResourceType.WebSocket != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.WebSocket, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.WebSocket, enum)
 	}
-}
-
-func TestEnumResourceType4(t *testing.T) {
-	var enum ResourceTypeEnum
-	var err error
-	var result []byte
 
 	enum = ResourceType.Manifest
 	result, err = json.Marshal(enum)
@@ -190,11 +203,76 @@ func TestEnumResourceType4(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Manifest"` != string(result) {
-		t.Errorf("Expected '\"Manifest\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Manifest"`, result)
 	}
 	json.Unmarshal([]byte(`"Manifest"`), &enum)
 	if ResourceType.Manifest != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Manifest, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Manifest, enum)
+	}
+
+	enum = ResourceType.SignedExchange
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SignedExchange"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SignedExchange"`, result)
+	}
+	json.Unmarshal([]byte(`"SignedExchange"`), &enum)
+	if ResourceType.SignedExchange != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.SignedExchange, enum)
+	}
+
+	enum = ResourceType.Ping
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ping"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ping"`, result)
+	}
+	json.Unmarshal([]byte(`"Ping"`), &enum)
+	if ResourceType.Ping != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Ping, enum)
+	}
+
+	enum = ResourceType.CSPViolationReport
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CSPViolationReport"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CSPViolationReport"`, result)
+	}
+	json.Unmarshal([]byte(`"CSPViolationReport"`), &enum)
+	if ResourceType.CSPViolationReport != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.CSPViolationReport, enum)
+	}
+
+	enum = ResourceType.Preflight
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Preflight"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Preflight"`, result)
+	}
+	json.Unmarshal([]byte(`"Preflight"`), &enum)
+	if ResourceType.Preflight != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.Preflight, enum)
+	}
+
+	enum = ResourceType.FedCM
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"FedCM"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"FedCM"`, result)
+	}
+	json.Unmarshal([]byte(`"FedCM"`), &enum)
+	if ResourceType.FedCM != enum {
+		t.Errorf("Expected %d, got %d", ResourceType.FedCM, enum)
 	}
 
 	enum = ResourceType.Other
@@ -203,10 +281,10 @@ func TestEnumResourceType4(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"Other"` != string(result) {
-		t.Errorf("Expected '\"Other\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"Other"`, result)
 	}
 	json.Unmarshal([]byte(`"Other"`), &enum)
 	if ResourceType.Other != enum {
-		t.Errorf("Expcected %d, got %d", ResourceType.Other, enum)
+		t.Errorf("Expected %d, got %d", ResourceType.Other, enum)
 	}
 }
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type secureContextTypeEnum struct {
+	Secure           SecureContextTypeEnum
+	SecureLocalhost  SecureContextTypeEnum
+	InsecureScheme   SecureContextTypeEnum
+	InsecureAncestor SecureContextTypeEnum
+}
+
+/*
+SecureContextType provides named access to the SecureContextTypeEnum values.
+*/
+var SecureContextType = secureContextTypeEnum{
+	Secure:           secureContextTypeSecure,
+	SecureLocalhost:  secureContextTypeSecureLocalhost,
+	InsecureScheme:   secureContextTypeInsecureScheme,
+	InsecureAncestor: secureContextTypeInsecureAncestor,
+}
+
+/*
+SecureContextTypeEnum represents the Page.SecureContextType values.
+
+Indicates whether the frame is a secure context and why it is the case.
+
+Allowed values:
+  - SecureContextType.Secure           "Secure"
+  - SecureContextType.SecureLocalhost  "SecureLocalhost"
+  - SecureContextType.InsecureScheme   "InsecureScheme"
+  - SecureContextType.InsecureAncestor "InsecureAncestor"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#type-SecureContextType
+*/
+type SecureContextTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SecureContextTypeEnum) String() string {
+	return _secureContextTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SecureContextTypeEnum) Unknown() bool {
+	return !_secureContextTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SecureContextTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SecureContextTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _secureContextTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// secureContextTypeSecure represents the "Secure" value.
+	secureContextTypeSecure SecureContextTypeEnum = iota + 1
+	// secureContextTypeSecureLocalhost represents the "SecureLocalhost" value.
+	secureContextTypeSecureLocalhost
+	// secureContextTypeInsecureScheme represents the "InsecureScheme" value.
+	secureContextTypeInsecureScheme
+	// secureContextTypeInsecureAncestor represents the "InsecureAncestor" value.
+	secureContextTypeInsecureAncestor
+)
+
+var _secureContextTypeEnums = enums.New(map[SecureContextTypeEnum]string{
+	SecureContextTypeEnum(0):          "",
+	secureContextTypeSecure:           "Secure",
+	secureContextTypeSecureLocalhost:  "SecureLocalhost",
+	secureContextTypeInsecureScheme:   "InsecureScheme",
+	secureContextTypeInsecureAncestor: "InsecureAncestor",
+})
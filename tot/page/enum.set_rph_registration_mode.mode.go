@@ -0,0 +1,89 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type setRPHRegistrationModeModeEnum struct {
+	None       SetRPHRegistrationModeModeEnum
+	AutoAccept SetRPHRegistrationModeModeEnum
+	AutoReject SetRPHRegistrationModeModeEnum
+}
+
+/*
+SetRPHRegistrationModeMode provides named access to the SetRPHRegistrationModeModeEnum values.
+*/
+var SetRPHRegistrationModeMode = setRPHRegistrationModeModeEnum{
+	None:       setRPHRegistrationModeModeNone,
+	AutoAccept: setRPHRegistrationModeModeAutoAccept,
+	AutoReject: setRPHRegistrationModeModeAutoReject,
+}
+
+/*
+SetRPHRegistrationModeModeEnum represents the Page.setRPHRegistrationMode.mode
+values.
+
+Allowed values:
+  - SetRPHRegistrationModeMode.None       "none"
+  - SetRPHRegistrationModeMode.AutoAccept "autoAccept"
+  - SetRPHRegistrationModeMode.AutoReject "autoReject"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setRPHRegistrationMode
+*/
+type SetRPHRegistrationModeModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SetRPHRegistrationModeModeEnum) String() string {
+	return _setRPHRegistrationModeModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SetRPHRegistrationModeModeEnum) Unknown() bool {
+	return !_setRPHRegistrationModeModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SetRPHRegistrationModeModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SetRPHRegistrationModeModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _setRPHRegistrationModeModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// setRPHRegistrationModeModeNone represents the "none" value.
+	setRPHRegistrationModeModeNone SetRPHRegistrationModeModeEnum = iota + 1
+	// setRPHRegistrationModeModeAutoAccept represents the "autoAccept" value.
+	setRPHRegistrationModeModeAutoAccept
+	// setRPHRegistrationModeModeAutoReject represents the "autoReject" value.
+	setRPHRegistrationModeModeAutoReject
+)
+
+var _setRPHRegistrationModeModeEnums = enums.New(map[SetRPHRegistrationModeModeEnum]string{
+	SetRPHRegistrationModeModeEnum(0):    "",
+	setRPHRegistrationModeModeNone:       "none",
+	setRPHRegistrationModeModeAutoAccept: "autoAccept",
+	setRPHRegistrationModeModeAutoReject: "autoReject",
+})
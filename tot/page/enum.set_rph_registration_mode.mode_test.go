@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSetRPHRegistrationModeMode(t *testing.T) {
+	var enum SetRPHRegistrationModeModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SetRPHRegistrationModeModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SetRPHRegistrationModeMode.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if SetRPHRegistrationModeMode.None != enum {
+		t.Errorf("Expected %d, got %d", SetRPHRegistrationModeMode.None, enum)
+	}
+
+	enum = SetRPHRegistrationModeMode.AutoAccept
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoAccept"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoAccept"`, result)
+	}
+	json.Unmarshal([]byte(`"autoAccept"`), &enum)
+	if SetRPHRegistrationModeMode.AutoAccept != enum {
+		t.Errorf("Expected %d, got %d", SetRPHRegistrationModeMode.AutoAccept, enum)
+	}
+
+	enum = SetRPHRegistrationModeMode.AutoReject
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoReject"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoReject"`, result)
+	}
+	json.Unmarshal([]byte(`"autoReject"`), &enum)
+	if SetRPHRegistrationModeMode.AutoReject != enum {
+		t.Errorf("Expected %d, got %d", SetRPHRegistrationModeMode.AutoReject, enum)
+	}
+}
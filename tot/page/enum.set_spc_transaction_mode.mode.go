@@ -0,0 +1,100 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type modeEnum struct {
+	None                       ModeEnum
+	AutoAccept                 ModeEnum
+	AutoChooseToAuthAnotherWay ModeEnum
+	AutoReject                 ModeEnum
+	AutoOptOut                 ModeEnum
+}
+
+/*
+Mode provides named access to the ModeEnum values.
+*/
+var Mode = modeEnum{
+	None:                       modeNone,
+	AutoAccept:                 modeAutoAccept,
+	AutoChooseToAuthAnotherWay: modeAutoChooseToAuthAnotherWay,
+	AutoReject:                 modeAutoReject,
+	AutoOptOut:                 modeAutoOptOut,
+}
+
+/*
+ModeEnum represents the Page.setSPCTransactionMode.mode values.
+
+Allowed values:
+  - Mode.None                       "none"
+  - Mode.AutoAccept                 "autoAccept"
+  - Mode.AutoChooseToAuthAnotherWay "autoChooseToAuthAnotherWay"
+  - Mode.AutoReject                 "autoReject"
+  - Mode.AutoOptOut                 "autoOptOut"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setSPCTransactionMode
+*/
+type ModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ModeEnum) String() string {
+	return _modeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ModeEnum) Unknown() bool {
+	return !_modeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _modeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// modeNone represents the "none" value.
+	modeNone ModeEnum = iota + 1
+	// modeAutoAccept represents the "autoAccept" value.
+	modeAutoAccept
+	// modeAutoChooseToAuthAnotherWay represents the "autoChooseToAuthAnotherWay" value.
+	modeAutoChooseToAuthAnotherWay
+	// modeAutoReject represents the "autoReject" value.
+	modeAutoReject
+	// modeAutoOptOut represents the "autoOptOut" value.
+	modeAutoOptOut
+)
+
+var _modeEnums = enums.New(map[ModeEnum]string{
+	ModeEnum(0):                    "",
+	modeNone:                       "none",
+	modeAutoAccept:                 "autoAccept",
+	modeAutoChooseToAuthAnotherWay: "autoChooseToAuthAnotherWay",
+	modeAutoReject:                 "autoReject",
+	modeAutoOptOut:                 "autoOptOut",
+})
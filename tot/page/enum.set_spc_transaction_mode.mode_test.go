@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMode(t *testing.T) {
+	var enum ModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Mode.None
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"none"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
+	}
+	json.Unmarshal([]byte(`"none"`), &enum)
+	if Mode.None != enum {
+		t.Errorf("Expected %d, got %d", Mode.None, enum)
+	}
+
+	enum = Mode.AutoAccept
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoAccept"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoAccept"`, result)
+	}
+	json.Unmarshal([]byte(`"autoAccept"`), &enum)
+	if Mode.AutoAccept != enum {
+		t.Errorf("Expected %d, got %d", Mode.AutoAccept, enum)
+	}
+
+	enum = Mode.AutoChooseToAuthAnotherWay
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoChooseToAuthAnotherWay"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoChooseToAuthAnotherWay"`, result)
+	}
+	json.Unmarshal([]byte(`"autoChooseToAuthAnotherWay"`), &enum)
+	if Mode.AutoChooseToAuthAnotherWay != enum {
+		t.Errorf("Expected %d, got %d", Mode.AutoChooseToAuthAnotherWay, enum)
+	}
+
+	enum = Mode.AutoReject
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoReject"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoReject"`, result)
+	}
+	json.Unmarshal([]byte(`"autoReject"`), &enum)
+	if Mode.AutoReject != enum {
+		t.Errorf("Expected %d, got %d", Mode.AutoReject, enum)
+	}
+
+	enum = Mode.AutoOptOut
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"autoOptOut"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"autoOptOut"`, result)
+	}
+	json.Unmarshal([]byte(`"autoOptOut"`), &enum)
+	if Mode.AutoOptOut != enum {
+		t.Errorf("Expected %d, got %d", Mode.AutoOptOut, enum)
+	}
+}
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type configurationEnum struct {
+	Mobile  ConfigurationEnum
+	Desktop ConfigurationEnum
+}
+
+/*
+Configuration provides named access to the ConfigurationEnum values.
+*/
+var Configuration = configurationEnum{
+	Mobile:  configurationMobile,
+	Desktop: configurationDesktop,
+}
+
+/*
+ConfigurationEnum represents the Page.setTouchEmulationEnabled.configuration
+values.
+
+Touch/gesture events configuration. Default: current platform.
+
+Allowed values:
+  - Configuration.Mobile  "mobile"
+  - Configuration.Desktop "desktop"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setTouchEmulationEnabled
+*/
+type ConfigurationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ConfigurationEnum) String() string {
+	return _configurationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ConfigurationEnum) Unknown() bool {
+	return !_configurationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ConfigurationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ConfigurationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _configurationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// configurationMobile represents the "mobile" value.
+	configurationMobile ConfigurationEnum = iota + 1
+	// configurationDesktop represents the "desktop" value.
+	configurationDesktop
+)
+
+var _configurationEnums = enums.New(map[ConfigurationEnum]string{
+	ConfigurationEnum(0): "",
+	configurationMobile:  "mobile",
+	configurationDesktop: "desktop",
+})
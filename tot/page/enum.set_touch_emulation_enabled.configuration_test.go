@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumConfiguration(t *testing.T) {
+	var enum ConfigurationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ConfigurationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Configuration.Mobile
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"mobile"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"mobile"`, result)
+	}
+	json.Unmarshal([]byte(`"mobile"`), &enum)
+	if Configuration.Mobile != enum {
+		t.Errorf("Expected %d, got %d", Configuration.Mobile, enum)
+	}
+
+	enum = Configuration.Desktop
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"desktop"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"desktop"`, result)
+	}
+	json.Unmarshal([]byte(`"desktop"`), &enum)
+	if Configuration.Desktop != enum {
+		t.Errorf("Expected %d, got %d", Configuration.Desktop, enum)
+	}
+}
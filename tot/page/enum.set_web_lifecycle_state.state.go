@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type stateEnum struct {
+	Frozen StateEnum
+	Active StateEnum
+}
+
+/*
+State provides named access to the StateEnum values.
+*/
+var State = stateEnum{
+	Frozen: stateFrozen,
+	Active: stateActive,
+}
+
+/*
+StateEnum represents the Page.setWebLifecycleState.state values.
+
+# Target lifecycle state
+
+Allowed values:
+  - State.Frozen "frozen"
+  - State.Active "active"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setWebLifecycleState
+*/
+type StateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StateEnum) String() string {
+	return _stateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StateEnum) Unknown() bool {
+	return !_stateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _stateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// stateFrozen represents the "frozen" value.
+	stateFrozen StateEnum = iota + 1
+	// stateActive represents the "active" value.
+	stateActive
+)
+
+var _stateEnums = enums.New(map[StateEnum]string{
+	StateEnum(0): "",
+	stateFrozen:  "frozen",
+	stateActive:  "active",
+})
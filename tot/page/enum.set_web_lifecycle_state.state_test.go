@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package page
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumState(t *testing.T) {
+	var enum StateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = State.Frozen
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"frozen"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"frozen"`, result)
+	}
+	json.Unmarshal([]byte(`"frozen"`), &enum)
+	if State.Frozen != enum {
+		t.Errorf("Expected %d, got %d", State.Frozen, enum)
+	}
+
+	enum = State.Active
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"active"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"active"`, result)
+	}
+	json.Unmarshal([]byte(`"active"`), &enum)
+	if State.Active != enum {
+		t.Errorf("Expected %d, got %d", State.Active, enum)
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package page
 
 import (
@@ -16,13 +18,35 @@ type DOMContentEventFiredEvent struct {
 	Err error `json:"-"`
 }
 
+/*
+FileChooserOpenedEvent represents Page.fileChooserOpened event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-fileChooserOpened
+*/
+type FileChooserOpenedEvent struct {
+	// Id of the frame containing input node. EXPERIMENTAL.
+	FrameID FrameID `json:"frameId"`
+
+	// Input mode. Allowed values:
+	//   - FileChooserOpenedMode.SelectSingle
+	//   - FileChooserOpenedMode.SelectMultiple
+	Mode FileChooserOpenedModeEnum `json:"mode"`
+
+	// Optional. Input node id. Only present for file choosers opened via an
+	// `<input type="file">` element. EXPERIMENTAL.
+	BackendNodeID BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 FrameAttachedEvent represents Page.frameAttached event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
 */
 type FrameAttachedEvent struct {
-	// ID of the frame that has been attached.
+	// Id of the frame that has been attached.
 	FrameID FrameID `json:"frameId"`
 
 	// Parent frame identifier.
@@ -37,13 +61,12 @@ type FrameAttachedEvent struct {
 }
 
 /*
-FrameClearedScheduledNavigationEvent represents Page.frameClearedScheduledNavigation event
-data.
+FrameClearedScheduledNavigationEvent represents Page.frameClearedScheduledNavigation event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameClearedScheduledNavigation
 */
 type FrameClearedScheduledNavigationEvent struct {
-	// ID of the frame that has cleared its scheduled navigation.
+	// Id of the frame that has cleared its scheduled navigation.
 	FrameID FrameID `json:"frameId"`
 
 	// Error information related to this event
@@ -56,7 +79,25 @@ FrameDetachedEvent represents Page.frameDetached event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
 */
 type FrameDetachedEvent struct {
-	// ID of the frame that has been detached.
+	// Id of the frame that has been detached.
+	FrameID FrameID `json:"frameId"`
+
+	// EXPERIMENTAL. Allowed values:
+	//   - FrameDetachedReason.Remove
+	//   - FrameDetachedReason.Swap
+	Reason FrameDetachedReasonEnum `json:"reason"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FrameSubtreeWillBeDetachedEvent represents Page.frameSubtreeWillBeDetached event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameSubtreeWillBeDetached
+*/
+type FrameSubtreeWillBeDetachedEvent struct {
+	// Id of the frame that is the root of the subtree that will be detached.
 	FrameID FrameID `json:"frameId"`
 
 	// Error information related to this event
@@ -72,6 +113,24 @@ type FrameNavigatedEvent struct {
 	// Frame object.
 	Frame *Frame `json:"frame"`
 
+	// EXPERIMENTAL. Allowed values:
+	//   - NavigationType.Navigation
+	//   - NavigationType.BackForwardCacheRestore
+	Type NavigationTypeEnum `json:"type"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DocumentOpenedEvent represents Page.documentOpened event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-documentOpened
+*/
+type DocumentOpenedEvent struct {
+	// Frame object.
+	Frame *Frame `json:"frame"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -86,13 +145,81 @@ type FrameResizedEvent struct {
 	Err error `json:"-"`
 }
 
+/*
+FrameStartedNavigatingEvent represents Page.frameStartedNavigating event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedNavigating
+*/
+type FrameStartedNavigatingEvent struct {
+	// ID of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+
+	// The URL the navigation started with. The final URL can be different.
+	URL string `json:"url"`
+
+	// Loader identifier. Even though it is present in case of same-document
+	// navigation, the previously committed loaderId would not change unless the
+	// navigation changes from a same-document to a cross-document navigation.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// Allowed values:
+	//   - FrameStartedNavigatingNavigationType.Reload
+	//   - FrameStartedNavigatingNavigationType.ReloadBypassingCache
+	//   - FrameStartedNavigatingNavigationType.Restore
+	//   - FrameStartedNavigatingNavigationType.RestoreWithPost
+	//   - FrameStartedNavigatingNavigationType.HistorySameDocument
+	//   - FrameStartedNavigatingNavigationType.HistoryDifferentDocument
+	//   - FrameStartedNavigatingNavigationType.SameDocument
+	//   - FrameStartedNavigatingNavigationType.DifferentDocument
+	NavigationType FrameStartedNavigatingNavigationTypeEnum `json:"navigationType"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+FrameRequestedNavigationEvent represents Page.frameRequestedNavigation event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameRequestedNavigation
+*/
+type FrameRequestedNavigationEvent struct {
+	// Id of the frame that is being navigated.
+	FrameID FrameID `json:"frameId"`
+
+	// The reason for the navigation. Allowed values:
+	//   - Reason.AnchorClick
+	//   - Reason.FormSubmissionGet
+	//   - Reason.FormSubmissionPost
+	//   - Reason.HTTPHeaderRefresh
+	//   - Reason.InitialFrameNavigation
+	//   - Reason.MetaTagRefresh
+	//   - Reason.Other
+	//   - Reason.PageBlockInterstitial
+	//   - Reason.Reload
+	//   - Reason.ScriptInitiated
+	Reason ReasonEnum `json:"reason"`
+
+	// The destination URL for the requested navigation.
+	URL string `json:"url"`
+
+	// The disposition for the navigation. Allowed values:
+	//   - ClientNavigationDisposition.CurrentTab
+	//   - ClientNavigationDisposition.NewTab
+	//   - ClientNavigationDisposition.NewWindow
+	//   - ClientNavigationDisposition.Download
+	Disposition ClientNavigationDispositionEnum `json:"disposition"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 FrameScheduledNavigationEvent represents Page.frameScheduledNavigation event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
 */
 type FrameScheduledNavigationEvent struct {
-	// ID of the frame that has scheduled a navigation.
+	// Id of the frame that has scheduled a navigation.
 	FrameID FrameID `json:"frameId"`
 
 	// Delay (in seconds) until the navigation is scheduled to begin. The
@@ -100,13 +227,16 @@ type FrameScheduledNavigationEvent struct {
 	Delay float64 `json:"delay"`
 
 	// The reason for the navigation. Allowed values:
-	//	- formSubmissionGet
-	//	- formSubmissionPost
-	//	- httpHeaderRefresh
-	//	- scriptInitiated
-	//	- metaTagRefresh
-	//	- pageBlockInterstitial
-	//	- reload
+	//   - Reason.AnchorClick
+	//   - Reason.FormSubmissionGet
+	//   - Reason.FormSubmissionPost
+	//   - Reason.HTTPHeaderRefresh
+	//   - Reason.InitialFrameNavigation
+	//   - Reason.MetaTagRefresh
+	//   - Reason.Other
+	//   - Reason.PageBlockInterstitial
+	//   - Reason.Reload
+	//   - Reason.ScriptInitiated
 	Reason ReasonEnum `json:"reason"`
 
 	// The destination URL for the scheduled navigation.
@@ -122,7 +252,7 @@ FrameStartedLoadingEvent represents Page.frameStartedLoading event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedLoading
 */
 type FrameStartedLoadingEvent struct {
-	// ID of the frame that has started loading.
+	// Id of the frame that has started loading.
 	FrameID FrameID `json:"frameId"`
 
 	// Error information related to this event
@@ -135,13 +265,61 @@ FrameStoppedLoadingEvent represents Page.frameStoppedLoading event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStoppedLoading
 */
 type FrameStoppedLoadingEvent struct {
-	// ID of the frame that has stopped loading.
+	// Id of the frame that has stopped loading.
 	FrameID FrameID `json:"frameId"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
+/*
+DownloadWillBeginEvent represents Page.downloadWillBegin event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-downloadWillBegin
+*/
+type DownloadWillBeginEvent struct {
+	// Id of the frame that caused download to begin.
+	FrameID FrameID `json:"frameId"`
+
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+
+	// URL of the resource being downloaded.
+	URL string `json:"url"`
+
+	// Suggested file name of the resource (the actual name of the file saved on
+	// disk may differ).
+	SuggestedFilename string `json:"suggestedFilename"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+DownloadProgressEvent represents Page.downloadProgress event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-downloadProgress
+*/
+type DownloadProgressEvent struct {
+	// Global unique identifier of the download.
+	GUID string `json:"guid"`
+
+	// Total expected bytes to download.
+	TotalBytes float64 `json:"totalBytes"`
+
+	// Total bytes received.
+	ReceivedBytes float64 `json:"receivedBytes"`
+
+	// Download status. Allowed values:
+	//   - DownloadProgressState.InProgress
+	//   - DownloadProgressState.Completed
+	//   - DownloadProgressState.Canceled
+	State DownloadProgressStateEnum `json:"state"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 InterstitialHiddenEvent represents Page.interstitialHidden event data.
 
@@ -168,6 +346,9 @@ JavascriptDialogClosedEvent represents Page.javascriptDialogClosed event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogClosed
 */
 type JavascriptDialogClosedEvent struct {
+	// Frame id. EXPERIMENTAL.
+	FrameID FrameID `json:"frameId"`
+
 	// Whether dialog was confirmed.
 	Result bool `json:"result"`
 
@@ -187,16 +368,25 @@ type JavascriptDialogOpeningEvent struct {
 	// Frame url.
 	URL string `json:"url"`
 
+	// Frame id. EXPERIMENTAL.
+	FrameID FrameID `json:"frameId"`
+
 	// Message that will be displayed by the dialog.
 	Message string `json:"message"`
 
 	// Dialog type. Allowed values:
-	//	- DialogType.Alert
-	//	- DialogType.Confirm
-	//	- DialogType.Prompt
-	//	- DialogType.Beforeunload
+	//   - DialogType.Alert
+	//   - DialogType.Confirm
+	//   - DialogType.Prompt
+	//   - DialogType.Beforeunload
 	Type DialogTypeEnum `json:"type"`
 
+	// True iff browser is capable showing or acting on the given dialog. When
+	// browser has no dialog handler for given target, calling alert while Page
+	// domain is engaged will stall the page execution. Execution can be resumed
+	// via calling Page.handleJavaScriptDialog.
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+
 	// Optional. Default dialog prompt.
 	DefaultPrompt string `json:"defaultPrompt,omitempty"`
 
@@ -210,42 +400,85 @@ LifecycleEventEvent represents Page.lifecycleEvent event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEvent
 */
 type LifecycleEventEvent struct {
-	// ID of the frame.
+	// Id of the frame.
 	FrameID FrameID `json:"frameId"`
 
 	// Loader identifier. Empty string if the request is fetched from worker.
 	LoaderID LoaderID `json:"loaderId"`
 
-	// name.
 	Name string `json:"name"`
 
-	// timestamp.
 	Timestamp MonotonicTime `json:"timestamp"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
+/*
+BackForwardCacheNotUsedEvent represents Page.backForwardCacheNotUsed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-backForwardCacheNotUsed
+*/
+type BackForwardCacheNotUsedEvent struct {
+	// The loader id for the associated navigation.
+	LoaderID LoaderID `json:"loaderId"`
+
+	// The frame id of the associated frame.
+	FrameID FrameID `json:"frameId"`
+
+	// Array of reasons why the page could not be cached. This must not be empty.
+	NotRestoredExplanations []*BackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+
+	// Optional. Tree structure of reasons why the page could not be cached for
+	// each frame.
+	NotRestoredExplanationsTree *BackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 LoadEventFiredEvent represents Page.loadEventFired event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFired
 */
 type LoadEventFiredEvent struct {
-	// timestamp.
 	Timestamp MonotonicTime `json:"timestamp"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
+/*
+NavigatedWithinDocumentEvent represents Page.navigatedWithinDocument event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-navigatedWithinDocument
+*/
+type NavigatedWithinDocumentEvent struct {
+	// Id of the frame.
+	FrameID FrameID `json:"frameId"`
+
+	// Frame's new url.
+	URL string `json:"url"`
+
+	// Navigation type Allowed values:
+	//   - NavigatedWithinDocumentNavigationType.Fragment
+	//   - NavigatedWithinDocumentNavigationType.HistoryAPI
+	//   - NavigatedWithinDocumentNavigationType.Other
+	NavigationType NavigatedWithinDocumentNavigationTypeEnum `json:"navigationType"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 ScreencastFrameEvent represents Page.screencastFrame event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastFrame
 */
 type ScreencastFrameEvent struct {
-	// Base64-encoded compressed image.
+	// Base64-encoded compressed image. (Encoded as a base64 string when passed
+	// over JSON)
 	Data string `json:"data"`
 
 	// Screencast frame metadata.
@@ -292,3 +525,18 @@ type WindowOpenEvent struct {
 	// Error information related to this event
 	Err error `json:"-"`
 }
+
+/*
+CompilationCacheProducedEvent represents Page.compilationCacheProduced event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-compilationCacheProduced
+*/
+type CompilationCacheProducedEvent struct {
+	URL string `json:"url"`
+
+	// Base64-encoded data (Encoded as a base64 string when passed over JSON)
+	Data string `json:"data"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package performance
 
 /*
@@ -10,6 +12,18 @@ type DisableResult struct {
 	Err error `json:"-"`
 }
 
+/*
+EnableParams represents Performance.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Time domain to use for collecting and reporting duration metrics. Allowed values:
+	//   - TimeDomain.TimeTicks
+	//   - TimeDomain.ThreadTicks
+	TimeDomain TimeDomainEnum `json:"timeDomain,omitempty"`
+}
+
 /*
 EnableResult represents the result of calls to Performance.enable.
 
@@ -20,6 +34,28 @@ type EnableResult struct {
 	Err error `json:"-"`
 }
 
+/*
+SetTimeDomainParams represents Performance.setTimeDomain parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-setTimeDomain
+*/
+type SetTimeDomainParams struct {
+	// Time domain Allowed values:
+	//   - TimeDomain.TimeTicks
+	//   - TimeDomain.ThreadTicks
+	TimeDomain TimeDomainEnum `json:"timeDomain"`
+}
+
+/*
+SetTimeDomainResult represents the result of calls to Performance.setTimeDomain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-setTimeDomain
+*/
+type SetTimeDomainResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetMetricsResult represents the result of calls to Performance.getMetrics.
 
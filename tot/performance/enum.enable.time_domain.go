@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package performance
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type timeDomainEnum struct {
+	TimeTicks   TimeDomainEnum
+	ThreadTicks TimeDomainEnum
+}
+
+/*
+TimeDomain provides named access to the TimeDomainEnum values.
+*/
+var TimeDomain = timeDomainEnum{
+	TimeTicks:   timeDomainTimeTicks,
+	ThreadTicks: timeDomainThreadTicks,
+}
+
+/*
+TimeDomainEnum represents the Performance.enable.timeDomain values.
+
+Time domain to use for collecting and reporting duration metrics.
+
+Allowed values:
+  - TimeDomain.TimeTicks   "timeTicks"
+  - TimeDomain.ThreadTicks "threadTicks"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-setTimeDomain
+*/
+type TimeDomainEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TimeDomainEnum) String() string {
+	return _timeDomainEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TimeDomainEnum) Unknown() bool {
+	return !_timeDomainEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TimeDomainEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TimeDomainEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _timeDomainEnums.Parse(val)
+	return nil
+}
+
+const (
+	// timeDomainTimeTicks represents the "timeTicks" value.
+	timeDomainTimeTicks TimeDomainEnum = iota + 1
+	// timeDomainThreadTicks represents the "threadTicks" value.
+	timeDomainThreadTicks
+)
+
+var _timeDomainEnums = enums.New(map[TimeDomainEnum]string{
+	TimeDomainEnum(0):     "",
+	timeDomainTimeTicks:   "timeTicks",
+	timeDomainThreadTicks: "threadTicks",
+})
@@ -0,0 +1,111 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package timeline provides type definitions for use with the Chrome PerformanceTimeline protocol
+
+Reporting of performance timeline events, as specified in
+https://w3c.github.io/performance-timeline/#dom-performanceobserver.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/
+*/
+package timeline
+
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+LargestContentfulPaint represents the PerformanceTimeline.LargestContentfulPaint
+type.
+
+See https://github.com/WICG/LargestContentfulPaint and
+largest_contentful_paint.idl
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#type-LargestContentfulPaint
+*/
+type LargestContentfulPaint struct {
+	RenderTime network.TimeSinceEpoch `json:"renderTime"`
+
+	LoadTime network.TimeSinceEpoch `json:"loadTime"`
+
+	// The number of pixels being painted.
+	Size float64 `json:"size"`
+
+	// Optional. The id attribute of the element, if available.
+	ElementID string `json:"elementId,omitempty"`
+
+	// Optional. The URL of the image (may be trimmed).
+	URL string `json:"url,omitempty"`
+
+	// Optional.
+	NodeID dom.BackendNodeID `json:"nodeId,omitempty"`
+}
+
+/*
+LayoutShiftAttribution represents the PerformanceTimeline.LayoutShiftAttribution
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#type-LayoutShiftAttribution
+*/
+type LayoutShiftAttribution struct {
+	PreviousRect *dom.Rect `json:"previousRect"`
+
+	CurrentRect *dom.Rect `json:"currentRect"`
+
+	// Optional.
+	NodeID dom.BackendNodeID `json:"nodeId,omitempty"`
+}
+
+/*
+LayoutShift represents the PerformanceTimeline.LayoutShift type.
+
+See https://wicg.github.io/layout-instability/#sec-layout-shift and
+layout_shift.idl
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#type-LayoutShift
+*/
+type LayoutShift struct {
+	// Score increment produced by this event.
+	Value float64 `json:"value"`
+
+	HadRecentInput bool `json:"hadRecentInput"`
+
+	LastInputTime network.TimeSinceEpoch `json:"lastInputTime"`
+
+	Sources []*LayoutShiftAttribution `json:"sources"`
+}
+
+/*
+Event represents the PerformanceTimeline.TimelineEvent type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#type-TimelineEvent
+*/
+type Event struct {
+	// Identifies the frame that this event is related to. Empty for non-frame
+	// targets.
+	FrameID page.FrameID `json:"frameId"`
+
+	// The event type, as specified in
+	// https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// This determines which of the optional "details" fields is present.
+	Type string `json:"type"`
+
+	// Name may be empty depending on the type.
+	Name string `json:"name"`
+
+	// Time in seconds since Epoch, monotonically increasing within document
+	// lifetime.
+	Time network.TimeSinceEpoch `json:"time"`
+
+	// Optional. Event duration, if applicable.
+	Duration float64 `json:"duration,omitempty"`
+
+	// Optional.
+	LcpDetails *LargestContentfulPaint `json:"lcpDetails,omitempty"`
+
+	// Optional.
+	LayoutShiftDetails *LayoutShift `json:"layoutShiftDetails,omitempty"`
+}
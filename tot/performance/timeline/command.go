@@ -0,0 +1,27 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package timeline
+
+/*
+EnableParams represents PerformanceTimeline.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#method-enable
+*/
+type EnableParams struct {
+	// The types of event to report, as specified in
+	// https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	// The specified filter overrides any previous filters, passing empty filter
+	// disables recording. Note that not all types exposed to the web platform are
+	// currently supported.
+	EventTypes []string `json:"eventTypes"`
+}
+
+/*
+EnableResult represents the result of calls to PerformanceTimeline.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,15 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package timeline
+
+/*
+EventAddedEvent represents PerformanceTimeline.timelineEventAdded event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#event-timelineEventAdded
+*/
+type EventAddedEvent struct {
+	Event *Event `json:"event"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,28 @@
+package pool
+
+import (
+	"github.com/mkenney/go-chrome/internal/devtoolstest"
+	chrome "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+MockDevTools is a mock of the Chromium developer tools HTTP and websocket
+endpoints for unit testing.
+*/
+type MockDevTools struct {
+	*devtoolstest.Server
+}
+
+/*
+NewMockDevTools starts and returns a mock developer tools server.
+*/
+func NewMockDevTools() *MockDevTools {
+	return &MockDevTools{Server: devtoolstest.New()}
+}
+
+/*
+Connect connects a Chrome instance to the mock server.
+*/
+func (devtools *MockDevTools) Connect() (*chrome.Chrome, error) {
+	return chrome.Connect(devtools.Addr())
+}
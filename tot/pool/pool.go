@@ -0,0 +1,607 @@
+/*
+Package pool keeps a set of Chromium browsers running and leases their tabs to
+concurrent callers, for services that render many pages.
+
+	browsers, err := pool.New(&pool.Config{Browsers: 2, TabsPerBrowser: 4})
+	if nil != err {
+		panic(err)
+	}
+	defer browsers.Close()
+
+	tab, err := browsers.Acquire(ctx)
+	if nil != err {
+		return err
+	}
+	defer browsers.Release(tab)
+
+Released tabs are reset and leased again: the browser's cookies and the storage
+of the page's origin are cleared and the tab is navigated to about:blank.
+Cookies are cleared for the whole browser, so tabs leased from the same browser
+at the same time should not depend on each other's cookies. Set
+Config.BrowserContexts to open each leased tab in its own browser context
+instead.
+*/
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/optional"
+	chrome "github.com/mkenney/go-chrome/tot"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/runtime"
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
+/*
+Config defines the size of a pool and how its browsers are started.
+*/
+type Config struct {
+	// Optional. Browsers is the number of browsers kept running. Defaults to
+	// 1.
+	Browsers int
+
+	// Optional. TabsPerBrowser is the maximum number of tabs leased from each
+	// browser at the same time. Defaults to 4.
+	TabsPerBrowser int
+
+	// Optional. MaxUses is the number of tab leases after which a browser is
+	// replaced by a new one. Defaults to 0, browsers are only replaced when
+	// they crash.
+	MaxUses int
+
+	// Optional. ResetTimeout is the time allowed for resetting a tab's state,
+	// or disposing of its browser context, when it is released. Defaults to
+	// 10 seconds.
+	ResetTimeout time.Duration
+
+	// Optional. BrowserContexts opens each leased tab in its own browser
+	// context, which is disposed of when the tab is released, so tabs leased
+	// at the same time never share cookies, storage or cache. Tabs are not
+	// reused. Browser contexts use experimental protocol commands. Defaults
+	// to false, released tabs are reset and reused.
+	BrowserContexts bool
+
+	// Optional. NewBrowser starts a browser. Defaults to LaunchHeadless.
+	NewBrowser func() (chrome.Chromium, error)
+}
+
+/*
+Stats contains pool usage statistics.
+*/
+type Stats struct {
+	// Browsers is the number of running browsers.
+	Browsers int
+
+	// InUse is the number of leased tabs.
+	InUse int
+
+	// Idle is the number of open tabs waiting to be leased.
+	Idle int
+
+	// Waiting is the number of callers waiting in Acquire.
+	Waiting int
+
+	// Acquired is the total number of tab leases.
+	Acquired int64
+
+	// Recycled is the total number of browsers replaced after reaching the
+	// maximum number of uses or crashing.
+	Recycled int64
+
+	// Crashed is the total number of browsers that exited unexpectedly.
+	Crashed int64
+
+	// WaitTime is the total time callers spent waiting in Acquire.
+	WaitTime time.Duration
+}
+
+/*
+AverageWait returns the average time callers waited for a tab.
+*/
+func (stats Stats) AverageWait() time.Duration {
+	if 0 == stats.Acquired {
+		return 0
+	}
+	return stats.WaitTime / time.Duration(stats.Acquired)
+}
+
+/*
+LaunchHeadless launches a headless browser suitable for running in a container
+on a dynamically chosen debugging port. It is the default Config.NewBrowser.
+*/
+func LaunchHeadless() (chrome.Chromium, error) {
+	flags, err := chrome.NewFlags(
+		chrome.ContainerSafeHeadless(),
+		chrome.Flag("remote-debugging-port", 0),
+	)
+	if nil != err {
+		return nil, err
+	}
+	browser := chrome.New(flags, "", "", "", "")
+	if err := browser.Launch(); nil != err {
+		return nil, err
+	}
+	return browser, nil
+}
+
+/*
+browser is a pooled browser and its tabs.
+*/
+type browser struct {
+	chromium chrome.Chromium
+
+	// idle is the list of open tabs waiting to be leased.
+	idle []*chrome.Tab
+
+	// inUse is the number of leased tabs.
+	inUse int
+
+	// uses is the total number of tab leases.
+	uses int
+
+	// retired is set when the browser reaches the maximum number of uses. It
+	// isn't leased again and is closed once all its tabs are released.
+	retired bool
+
+	// crashed is set when the browser exits unexpectedly.
+	crashed bool
+}
+
+/*
+lease is a leased tab's browser and the browser context the tab was opened in,
+if Config.BrowserContexts is set.
+*/
+type lease struct {
+	browser *browser
+	context *chrome.BrowserContext
+}
+
+/*
+Pool is a pool of browsers that leases their tabs. Pool is safe for concurrent
+use.
+*/
+type Pool struct {
+	config Config
+
+	// browsers is the list of running browsers, including retired browsers
+	// with leased tabs.
+	browsers []*browser
+
+	// leases maps leased tabs to their leases.
+	leases map[*chrome.Tab]*lease
+
+	// changed is closed and replaced whenever a tab may have become
+	// available.
+	changed chan struct{}
+
+	// starting is the number of browsers being started.
+	starting int
+
+	// startErr is the error from the last failed browser start.
+	startErr error
+
+	closed bool
+	mux    *sync.Mutex
+	stats  Stats
+}
+
+/*
+New starts the configured number of browsers and returns a new pool.
+*/
+func New(config *Config) (*Pool, error) {
+	pool := &Pool{
+		changed: make(chan struct{}),
+		leases:  map[*chrome.Tab]*lease{},
+		mux:     &sync.Mutex{},
+	}
+	if nil != config {
+		pool.config = *config
+	}
+	if pool.config.Browsers <= 0 {
+		pool.config.Browsers = 1
+	}
+	if pool.config.TabsPerBrowser <= 0 {
+		pool.config.TabsPerBrowser = 4
+	}
+	if pool.config.ResetTimeout <= 0 {
+		pool.config.ResetTimeout = 10 * time.Second
+	}
+	if nil == pool.config.NewBrowser {
+		pool.config.NewBrowser = LaunchHeadless
+	}
+
+	for a := 0; a < pool.config.Browsers; a++ {
+		browser, err := pool.startBrowser()
+		if nil != err {
+			pool.Close()
+			return nil, err
+		}
+		pool.browsers = append(pool.browsers, browser)
+	}
+	return pool, nil
+}
+
+/*
+Acquire leases a tab, waiting until one is available or ctx is done. Leased tabs
+must be returned with Release.
+*/
+func (pool *Pool) Acquire(ctx context.Context) (*chrome.Tab, error) {
+	start := time.Now()
+
+	pool.mux.Lock()
+	for {
+		if pool.closed {
+			pool.mux.Unlock()
+			return nil, errs.New(codes.PoolClosed, "the pool is closed")
+		}
+
+		if browser := pool.available(); nil != browser {
+			browser.inUse++
+			browser.uses++
+			if pool.config.MaxUses > 0 && browser.uses >= pool.config.MaxUses {
+				browser.retired = true
+				pool.fill()
+			}
+			var tab *chrome.Tab
+			if idle := len(browser.idle); idle > 0 {
+				tab = browser.idle[idle-1]
+				browser.idle = browser.idle[:idle-1]
+			}
+			pool.stats.Acquired++
+			pool.stats.WaitTime += time.Since(start)
+			pool.mux.Unlock()
+
+			leased := &lease{browser: browser}
+			var err error
+			if nil == tab {
+				leased, tab, err = pool.newLease(browser)
+			}
+			if nil != err {
+				pool.mux.Lock()
+				browser.inUse--
+				pool.drain(browser)
+				pool.notify()
+				pool.mux.Unlock()
+				return nil, errs.Wrap(err, codes.PoolTabFailed, "could not open a tab")
+			}
+
+			pool.mux.Lock()
+			pool.leases[tab] = leased
+			pool.mux.Unlock()
+			return tab, nil
+		}
+
+		pool.fill()
+		changed := pool.changed
+		pool.stats.Waiting++
+		pool.mux.Unlock()
+
+		select {
+		case <-changed:
+			pool.mux.Lock()
+			pool.stats.Waiting--
+
+		case <-ctx.Done():
+			pool.mux.Lock()
+			pool.stats.Waiting--
+			pool.stats.WaitTime += time.Since(start)
+			err := pool.startErr
+			pool.mux.Unlock()
+			if nil != err {
+				return nil, errs.Wrap(err, codes.PoolAcquireCancelled, "no tab available, a browser could not be started")
+			}
+			return nil, errs.Wrap(ctx.Err(), codes.PoolAcquireCancelled, "no tab available")
+		}
+	}
+}
+
+/*
+Release returns a leased tab to the pool. The browser's cookies and the storage
+of the page's origin are cleared and the tab is navigated to about:blank before
+it is leased again. Tabs that can't be reset, crashed, or belong to a crashed
+or retired browser are closed instead.
+
+If Config.BrowserContexts is set, the tab's browser context is disposed of
+instead, which closes the tab and discards its cookies, storage and cache.
+*/
+func (pool *Pool) Release(tab *chrome.Tab) error {
+	pool.mux.Lock()
+	leased, ok := pool.leases[tab]
+	if !ok {
+		pool.mux.Unlock()
+		return errs.New(codes.PoolTabNotLeased, "the tab is not leased from this pool")
+	}
+	delete(pool.leases, tab)
+	browser := leased.browser
+	crashed := browser.crashed
+	reuse := nil == leased.context && !crashed && !browser.retired && !pool.closed
+	pool.mux.Unlock()
+
+	var err error
+	if nil != leased.context && !crashed {
+		if err = pool.dispose(leased.context); nil != err {
+			err = errs.Wrap(err, codes.PoolTabResetFailed, fmt.Sprintf("could not dispose of the browser context of tab %s", tab.Data().ID))
+		}
+	}
+	if reuse && tab.Crashed() {
+		reuse = false
+	}
+	if reuse {
+		if err = pool.reset(tab); nil != err {
+			reuse = false
+			err = errs.Wrap(err, codes.PoolTabResetFailed, fmt.Sprintf("could not reset tab %s", tab.Data().ID))
+		}
+	}
+
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	browser.inUse--
+	if reuse && !browser.crashed && !browser.retired && !pool.closed {
+		browser.idle = append(browser.idle, tab)
+	} else if nil == leased.context && !browser.crashed {
+		go closeTab(tab)
+	}
+	pool.drain(browser)
+	pool.notify()
+	return err
+}
+
+/*
+Stats returns the pool usage statistics.
+*/
+func (pool *Pool) Stats() Stats {
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	stats := pool.stats
+	stats.Browsers = len(pool.browsers)
+	for _, browser := range pool.browsers {
+		stats.InUse += browser.inUse
+		stats.Idle += len(browser.idle)
+	}
+	return stats
+}
+
+/*
+Close closes all browsers, including their leased tabs. Waiting and future calls
+to Acquire fail.
+*/
+func (pool *Pool) Close() error {
+	pool.mux.Lock()
+	pool.closed = true
+	browsers := pool.browsers
+	pool.browsers = nil
+	pool.notify()
+	pool.mux.Unlock()
+
+	var err error
+	for _, browser := range browsers {
+		if closeErr := browser.chromium.Close(); nil != closeErr && nil == err {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+/*
+available returns the browser to lease a tab from, preferring browsers with idle
+tabs and then the least busy browser. The caller must hold the lock.
+*/
+func (pool *Pool) available() *browser {
+	var found *browser
+	for _, browser := range pool.browsers {
+		if browser.retired || browser.crashed || browser.inUse >= pool.config.TabsPerBrowser {
+			continue
+		}
+		if nil == found ||
+			(len(browser.idle) > 0 && 0 == len(found.idle)) ||
+			((len(browser.idle) > 0) == (len(found.idle) > 0) && browser.inUse < found.inUse) {
+			found = browser
+		}
+	}
+	return found
+}
+
+/*
+fill starts browsers to replace retired and crashed browsers. The caller must
+hold the lock.
+*/
+func (pool *Pool) fill() {
+	active := pool.starting
+	for _, browser := range pool.browsers {
+		if !browser.retired && !browser.crashed {
+			active++
+		}
+	}
+	for ; active < pool.config.Browsers; active++ {
+		pool.starting++
+		go func() {
+			browser, err := pool.startBrowser()
+			if nil != err {
+				log.WithFields(log.Fields{"error": err}).Error("could not start a pooled browser")
+				// Give the environment a moment before the next attempt.
+				time.Sleep(time.Second)
+			}
+
+			pool.mux.Lock()
+			defer pool.mux.Unlock()
+			pool.starting--
+			pool.startErr = err
+			if nil == err && !browser.crashed {
+				if pool.closed {
+					go browser.chromium.Close()
+					return
+				}
+				pool.browsers = append(pool.browsers, browser)
+			}
+			pool.notify()
+		}()
+	}
+}
+
+/*
+drain closes a retired browser once all its tabs are released. The caller must
+hold the lock.
+*/
+func (pool *Pool) drain(browser *browser) {
+	if !browser.retired || browser.inUse > 0 {
+		return
+	}
+	if pool.remove(browser) {
+		pool.stats.Recycled++
+		go browser.chromium.Close()
+	}
+}
+
+/*
+remove removes a browser from the pool. It returns false if the browser was
+already removed. The caller must hold the lock.
+*/
+func (pool *Pool) remove(browser *browser) bool {
+	for k, pooled := range pool.browsers {
+		if pooled == browser {
+			pool.browsers = append(pool.browsers[:k], pool.browsers[k+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+/*
+notify wakes callers waiting in Acquire. The caller must hold the lock.
+*/
+func (pool *Pool) notify() {
+	close(pool.changed)
+	pool.changed = make(chan struct{})
+}
+
+/*
+startBrowser starts a browser and watches it for crashes.
+*/
+func (pool *Pool) startBrowser() (*browser, error) {
+	chromium, err := pool.config.NewBrowser()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.PoolBrowserFailed, "could not start a browser")
+	}
+	pooled := &browser{chromium: chromium}
+	chromium.OnUnexpectedExit(func(exit *chrome.ProcessExit) {
+		pool.crashed(pooled, exit)
+	})
+	return pooled, nil
+}
+
+/*
+crashed replaces a browser that exited unexpectedly. Its leased tabs are
+discarded when they are released.
+*/
+func (pool *Pool) crashed(browser *browser, exit *chrome.ProcessExit) {
+	log.WithFields(log.Fields{"exit": exit}).Warn("pooled browser crashed, replacing it")
+
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	browser.crashed = true
+	browser.idle = nil
+	pool.stats.Crashed++
+	if pool.remove(browser) {
+		pool.stats.Recycled++
+		go browser.chromium.Close()
+	}
+	if !pool.closed {
+		pool.fill()
+	}
+	pool.notify()
+}
+
+/*
+newLease opens a new tab, in a new browser context if Config.BrowserContexts is
+set.
+*/
+func (pool *Pool) newLease(browser *browser) (*lease, *chrome.Tab, error) {
+	if !pool.config.BrowserContexts {
+		tab, err := browser.chromium.NewTab("about:blank")
+		if nil != err {
+			return nil, nil, err
+		}
+		return &lease{browser: browser}, tab, nil
+	}
+
+	browserContext, err := browser.chromium.NewBrowserContext()
+	if nil != err {
+		return nil, nil, err
+	}
+	tab, err := browserContext.NewTab("about:blank")
+	if nil != err {
+		go browserContext.Close()
+		return nil, nil, err
+	}
+	return &lease{browser: browser, context: browserContext}, tab, nil
+}
+
+/*
+dispose disposes of a browser context, waiting up to Config.ResetTimeout.
+*/
+func (pool *Pool) dispose(browserContext *chrome.BrowserContext) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- browserContext.Close()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(pool.config.ResetTimeout):
+		return errs.New(codes.PoolTabResetFailed, fmt.Sprintf("timed out after %s", pool.config.ResetTimeout))
+	}
+}
+
+/*
+closeTab closes a tab that is no longer used.
+*/
+func closeTab(tab *chrome.Tab) {
+	if _, err := tab.Close(); nil != err {
+		log.WithFields(log.Fields{"error": err, "tabID": tab.Data().ID}).Warn("could not close pooled tab")
+	}
+}
+
+/*
+reset clears the browser's cookies and the storage of the page's origin and
+navigates the tab to about:blank.
+*/
+func (pool *Pool) reset(tab *chrome.Tab) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pool.config.ResetTimeout)
+	defer cancel()
+	protocol := tab.Protocol()
+
+	origin := <-protocol.Runtime().WithContext(ctx).Evaluate(&runtime.EvaluateParams{
+		Expression:    "location.origin",
+		ReturnByValue: optional.Bool(true),
+	})
+	if nil != origin.Err {
+		return origin.Err
+	}
+
+	cookies := <-protocol.Network().WithContext(ctx).ClearBrowserCookies()
+	if nil != cookies.Err {
+		return cookies.Err
+	}
+
+	if nil != origin.Result {
+		if value, ok := origin.Result.Value.(string); ok && "" != value && "null" != value {
+			cleared := <-protocol.Storage().WithContext(ctx).ClearDataForOrigin(&storage.ClearDataForOriginParams{
+				Origin: value,
+				Types:  "all",
+			})
+			if nil != cleared.Err {
+				return cleared.Err
+			}
+		}
+	}
+
+	navigated := <-protocol.Page().WithContext(ctx).Navigate(&page.NavigateParams{URL: "about:blank"})
+	return navigated.Err
+}
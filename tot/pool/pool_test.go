@@ -0,0 +1,344 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	chrome "github.com/mkenney/go-chrome/tot"
+)
+
+/*
+mockBrowser is a browser connected to a MockDevTools server that can report an
+unexpected exit.
+*/
+type mockBrowser struct {
+	*chrome.Chrome
+	exit func(exit *chrome.ProcessExit)
+}
+
+func (browser *mockBrowser) OnUnexpectedExit(callback func(exit *chrome.ProcessExit)) {
+	browser.exit = callback
+}
+
+/*
+mockBrowsers returns a Config.NewBrowser function for the mock server and the
+list of started browsers.
+*/
+func mockBrowsers(devtools *MockDevTools) (func() (chrome.Chromium, error), func() []*mockBrowser) {
+	mux := &sync.Mutex{}
+	browsers := []*mockBrowser{}
+	start := func() (chrome.Chromium, error) {
+		connected, err := devtools.Connect()
+		if nil != err {
+			return nil, err
+		}
+		browser := &mockBrowser{Chrome: connected}
+		mux.Lock()
+		browsers = append(browsers, browser)
+		mux.Unlock()
+		return browser, nil
+	}
+	started := func() []*mockBrowser {
+		mux.Lock()
+		defer mux.Unlock()
+		return append([]*mockBrowser{}, browsers...)
+	}
+	return start, started
+}
+
+func assertCode(t *testing.T, err error, code interface{}) {
+	t.Helper()
+	if nil == err {
+		t.Errorf("Expected error code %v, received nil", code)
+	} else if code != err.(errs.Err).Code() {
+		t.Errorf("Expected error code %v, received %v", code, err.(errs.Err).Code())
+	}
+}
+
+func TestPoolAcquireRelease(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	devtools.Results["Runtime.evaluate"] = `{"result":{"type":"string","value":"https://www.example.com"}}`
+	start, _ := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{TabsPerBrowser: 2, NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Close()
+
+	tab1, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	tab2, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if tab1 == tab2 {
+		t.Errorf("Expected different tabs")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = browsers.Acquire(ctx)
+	assertCode(t, err, codes.PoolAcquireCancelled)
+
+	stats := browsers.Stats()
+	if 1 != stats.Browsers || 2 != stats.InUse || 0 != stats.Idle || 2 != stats.Acquired {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	if err := browsers.Release(tab1); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	expected := []string{"Runtime.evaluate", "Network.clearBrowserCookies", "Storage.clearDataForOrigin", "Page.navigate"}
+	methods := devtools.Methods(tab1.Data().ID)
+	if len(expected) != len(methods) {
+		t.Fatalf("Expected %v, received %v", expected, methods)
+	}
+	for k, method := range expected {
+		if method != methods[k] {
+			t.Errorf("Expected %v, received %v", expected, methods)
+		}
+	}
+	payloads := devtools.TargetPayloads(tab1.Data().ID)
+	if params, _ := payloads[2].Params.(map[string]interface{}); "https://www.example.com" != params["origin"] {
+		t.Errorf("Expected the storage of https://www.example.com to be cleared, received %v", payloads[2].Params)
+	}
+	if params, _ := payloads[3].Params.(map[string]interface{}); "about:blank" != params["url"] {
+		t.Errorf("Expected the tab to be navigated to about:blank, received %v", payloads[3].Params)
+	}
+
+	stats = browsers.Stats()
+	if 1 != stats.InUse || 1 != stats.Idle {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	// Released tabs are reused.
+	tab3, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if tab1 != tab3 {
+		t.Errorf("Expected the released tab to be reused")
+	}
+	if 2 != devtools.News() {
+		t.Errorf("Expected 2 tabs to be opened, found %d", devtools.News())
+	}
+
+	if err := browsers.Release(tab1); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	assertCode(t, browsers.Release(tab1), codes.PoolTabNotLeased)
+}
+
+func TestPoolBrowserContexts(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	start, _ := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{TabsPerBrowser: 2, BrowserContexts: true, NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Close()
+
+	tab1, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	tab2, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if tab1 == tab2 {
+		t.Errorf("Expected different tabs")
+	}
+	if "" == tab1.Data().BrowserContextID || tab1.Data().BrowserContextID == tab2.Data().BrowserContextID {
+		t.Errorf("Expected the tabs to be opened in their own browser contexts, found '%s' and '%s'", tab1.Data().BrowserContextID, tab2.Data().BrowserContextID)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = browsers.Acquire(ctx)
+	assertCode(t, err, codes.PoolAcquireCancelled)
+
+	stats := browsers.Stats()
+	if 1 != stats.Browsers || 2 != stats.InUse || 0 != stats.Idle || 2 != stats.Acquired {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	if err := browsers.Release(tab1); nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	disposed := []string{}
+	for _, payload := range devtools.TargetPayloads("browser") {
+		if "Target.disposeBrowserContext" == payload.Method {
+			contextID, _ := payload.Params.(map[string]interface{})["browserContextId"].(string)
+			disposed = append(disposed, contextID)
+		}
+	}
+	if 1 != len(disposed) || tab1.Data().BrowserContextID != disposed[0] {
+		t.Errorf("Expected the browser context '%s' to be disposed of, found %v", tab1.Data().BrowserContextID, disposed)
+	}
+	if 0 != len(devtools.Methods(tab1.Data().ID)) {
+		t.Errorf("Expected no commands to be sent to the released tab, found %v", devtools.Methods(tab1.Data().ID))
+	}
+
+	stats = browsers.Stats()
+	if 1 != stats.InUse || 0 != stats.Idle {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+
+	// Released tabs are not reused.
+	tab3, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if tab1 == tab3 || tab1.Data().BrowserContextID == tab3.Data().BrowserContextID {
+		t.Errorf("Expected a new tab in a new browser context")
+	}
+	if 0 != devtools.News() {
+		t.Errorf("Expected no tabs to be opened outside a browser context, found %d", devtools.News())
+	}
+
+	if err := browsers.Release(tab3); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	assertCode(t, browsers.Release(tab3), codes.PoolTabNotLeased)
+}
+
+func TestPoolWait(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	start, _ := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{TabsPerBrowser: 1, NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Close()
+
+	tab, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		browsers.Release(tab)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	waited, err := browsers.Acquire(ctx)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if tab != waited {
+		t.Errorf("Expected the released tab")
+	}
+	stats := browsers.Stats()
+	if stats.WaitTime < 50*time.Millisecond || stats.AverageWait() < 25*time.Millisecond {
+		t.Errorf("Expected the wait time to be recorded, received %+v", stats)
+	}
+}
+
+func TestPoolMaxUses(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	start, started := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{MaxUses: 2, NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Close()
+
+	for a := 0; a < 2; a++ {
+		tab, err := browsers.Acquire(context.Background())
+		if nil != err {
+			t.Fatalf("Expected nil, received error: %v", err)
+		}
+		browsers.Release(tab)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tab, err := browsers.Acquire(ctx)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Release(tab)
+
+	launched := started()
+	if 2 != len(launched) {
+		t.Fatalf("Expected 2 browsers to be started, found %d", len(launched))
+	}
+	if tab.Chromium() != launched[1].Chrome {
+		t.Errorf("Expected the tab to be leased from the new browser")
+	}
+	stats := browsers.Stats()
+	if 1 != stats.Browsers || 1 != stats.Recycled {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestPoolCrash(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	start, started := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	defer browsers.Close()
+
+	tab, err := browsers.Acquire(context.Background())
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	started()[0].exit(&chrome.ProcessExit{ExitCode: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	replacement, err := browsers.Acquire(ctx)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if replacement.Chromium() != started()[1].Chrome {
+		t.Errorf("Expected the tab to be leased from the replacement browser")
+	}
+
+	if err := browsers.Release(tab); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 0 != len(devtools.Methods(tab.Data().ID)) {
+		t.Errorf("Expected the tab of the crashed browser to be discarded")
+	}
+	stats := browsers.Stats()
+	if 1 != stats.Browsers || 1 != stats.Crashed || 1 != stats.Recycled || 0 != stats.Idle {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	devtools := NewMockDevTools()
+	defer devtools.Close()
+	start, _ := mockBrowsers(devtools)
+
+	browsers, err := New(&Config{NewBrowser: start})
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if err := browsers.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	_, err = browsers.Acquire(context.Background())
+	assertCode(t, err, codes.PoolClosed)
+}
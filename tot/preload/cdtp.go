@@ -0,0 +1,155 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package preload provides type definitions for use with the Chrome Preload protocol
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/
+*/
+package preload
+
+import (
+	"github.com/mkenney/go-chrome/tot/dom"
+	"github.com/mkenney/go-chrome/tot/network"
+)
+
+/*
+RuleSetID represents the Preload.RuleSetId type.
+
+# Unique id
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-RuleSetId
+*/
+type RuleSetID string
+
+/*
+RuleSet represents the Preload.RuleSet type.
+
+# Corresponds to SpeculationRuleSet
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-RuleSet
+*/
+type RuleSet struct {
+	ID RuleSetID `json:"id"`
+
+	// Identifies a document which the rule set is associated with.
+	LoaderID network.LoaderID `json:"loaderId"`
+
+	// Source text of JSON representing the rule set. If it comes from `<script>`
+	// tag, it is the textContent of the node. Note that it is a JSON for valid
+	// case.
+	//
+	// See also:
+	// - https://wicg.github.io/nav-speculation/speculation-rules.html
+	// - https://github.com/WICG/nav-speculation/blob/main/triggers.md
+	SourceText string `json:"sourceText"`
+
+	// Optional. A speculation rule set is either added through an inline
+	// `<script>` tag or through an external resource via the 'Speculation-Rules'
+	// HTTP header. For the first case, we include the BackendNodeId of the
+	// relevant `<script>` tag. For the second case, we include the external URL
+	// where the rule set was loaded from, and also RequestId if Network domain is
+	// enabled.
+	//
+	// See also:
+	// -
+	// https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script
+	// -
+	// https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header
+	BackendNodeID dom.BackendNodeID `json:"backendNodeId,omitempty"`
+
+	// Optional.
+	URL string `json:"url,omitempty"`
+
+	// Optional.
+	RequestID network.RequestID `json:"requestId,omitempty"`
+
+	// Optional. Error information `errorMessage` is null iff `errorType` is null. Allowed values:
+	//   - RuleSetErrorType.SourceIsNotJSONObject
+	//   - RuleSetErrorType.InvalidRulesSkipped
+	//   - RuleSetErrorType.InvalidRulesetLevelTag
+	ErrorType RuleSetErrorTypeEnum `json:"errorType,omitempty"`
+
+	// Optional. TODO(https://crbug.com/1425354): Replace this property with
+	// structured error. DEPRECATED.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+/*
+PreloadingAttemptKey represents the Preload.PreloadingAttemptKey type.
+
+A key that identifies a preloading attempt.
+
+The url used is the url specified by the trigger (i.e. the initial URL), and not
+the final url that is navigated to. For example, prerendering allows same-origin
+main frame navigations during the attempt, but the attempt is still keyed with
+the initial URL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PreloadingAttemptKey
+*/
+type PreloadingAttemptKey struct {
+	LoaderID network.LoaderID `json:"loaderId"`
+
+	// Allowed values:
+	//   - SpeculationAction.Prefetch
+	//   - SpeculationAction.Prerender
+	Action SpeculationActionEnum `json:"action"`
+
+	URL string `json:"url"`
+
+	// Optional. Allowed values:
+	//   - SpeculationTargetHint.Blank
+	//   - SpeculationTargetHint.Self
+	TargetHint SpeculationTargetHintEnum `json:"targetHint,omitempty"`
+}
+
+/*
+PreloadingAttemptSource represents the Preload.PreloadingAttemptSource type.
+
+Lists sources for a preloading attempt, specifically the ids of rule sets that
+had a speculation rule that triggered the attempt, and the BackendNodeIds of <a
+href> or <area href> elements that triggered the attempt (in the case of
+attempts triggered by a document rule). It is possible for multiple rule sets
+and links to trigger a single attempt.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PreloadingAttemptSource
+*/
+type PreloadingAttemptSource struct {
+	Key *PreloadingAttemptKey `json:"key"`
+
+	RuleSetIDs []RuleSetID `json:"ruleSetIds"`
+
+	NodeIDs []dom.BackendNodeID `json:"nodeIds"`
+}
+
+/*
+PipelineID represents the Preload.PreloadPipelineId type.
+
+Chrome manages different types of preloads together using a concept of
+preloading pipeline. For example, if a site uses a SpeculationRules for
+prerender, Chrome first starts a prefetch and then upgrades it to prerender.
+
+CDP events for them are emitted separately but they share `PreloadPipelineId`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PreloadPipelineId
+*/
+type PipelineID string
+
+/*
+PrerenderMismatchedHeaders represents the Preload.PrerenderMismatchedHeaders
+type.
+
+Information of headers to be displayed when the header mismatch occurred.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PrerenderMismatchedHeaders
+*/
+type PrerenderMismatchedHeaders struct {
+	HeaderName string `json:"headerName"`
+
+	// Optional.
+	InitialValue string `json:"initialValue,omitempty"`
+
+	// Optional.
+	ActivationValue string `json:"activationValue,omitempty"`
+}
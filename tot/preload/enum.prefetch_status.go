@@ -0,0 +1,277 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type prefetchStatusEnum struct {
+	PrefetchAllowed                                             PrefetchStatusEnum
+	PrefetchFailedIneligibleRedirect                            PrefetchStatusEnum
+	PrefetchFailedInvalidRedirect                               PrefetchStatusEnum
+	PrefetchFailedMIMENotSupported                              PrefetchStatusEnum
+	PrefetchFailedNetError                                      PrefetchStatusEnum
+	PrefetchFailedNon2XX                                        PrefetchStatusEnum
+	PrefetchEvictedAfterBrowsingDataRemoved                     PrefetchStatusEnum
+	PrefetchEvictedAfterCandidateRemoved                        PrefetchStatusEnum
+	PrefetchEvictedForNewerPrefetch                             PrefetchStatusEnum
+	PrefetchHeldback                                            PrefetchStatusEnum
+	PrefetchIneligibleRetryAfter                                PrefetchStatusEnum
+	PrefetchIsPrivacyDecoy                                      PrefetchStatusEnum
+	PrefetchIsStale                                             PrefetchStatusEnum
+	PrefetchNotEligibleBrowserContextOffTheRecord               PrefetchStatusEnum
+	PrefetchNotEligibleDataSaverEnabled                         PrefetchStatusEnum
+	PrefetchNotEligibleExistingProxy                            PrefetchStatusEnum
+	PrefetchNotEligibleHostIsNonUnique                          PrefetchStatusEnum
+	PrefetchNotEligibleNonDefaultStoragePartition               PrefetchStatusEnum
+	PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PrefetchStatusEnum
+	PrefetchNotEligibleSchemeIsNotHTTPS                         PrefetchStatusEnum
+	PrefetchNotEligibleUserHasCookies                           PrefetchStatusEnum
+	PrefetchNotEligibleUserHasServiceWorker                     PrefetchStatusEnum
+	PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       PrefetchStatusEnum
+	PrefetchNotEligibleRedirectFromServiceWorker                PrefetchStatusEnum
+	PrefetchNotEligibleRedirectToServiceWorker                  PrefetchStatusEnum
+	PrefetchNotEligibleBatterySaverEnabled                      PrefetchStatusEnum
+	PrefetchNotEligiblePreloadingDisabled                       PrefetchStatusEnum
+	PrefetchNotFinishedInTime                                   PrefetchStatusEnum
+	PrefetchNotStarted                                          PrefetchStatusEnum
+	PrefetchNotUsedCookiesChanged                               PrefetchStatusEnum
+	PrefetchProxyNotAvailable                                   PrefetchStatusEnum
+	PrefetchResponseUsed                                        PrefetchStatusEnum
+	PrefetchSuccessfulButNotUsed                                PrefetchStatusEnum
+	PrefetchNotUsedProbeFailed                                  PrefetchStatusEnum
+}
+
+/*
+PrefetchStatus provides named access to the PrefetchStatusEnum values.
+*/
+var PrefetchStatus = prefetchStatusEnum{
+	PrefetchAllowed:                                             prefetchStatusPrefetchAllowed,
+	PrefetchFailedIneligibleRedirect:                            prefetchStatusPrefetchFailedIneligibleRedirect,
+	PrefetchFailedInvalidRedirect:                               prefetchStatusPrefetchFailedInvalidRedirect,
+	PrefetchFailedMIMENotSupported:                              prefetchStatusPrefetchFailedMIMENotSupported,
+	PrefetchFailedNetError:                                      prefetchStatusPrefetchFailedNetError,
+	PrefetchFailedNon2XX:                                        prefetchStatusPrefetchFailedNon2XX,
+	PrefetchEvictedAfterBrowsingDataRemoved:                     prefetchStatusPrefetchEvictedAfterBrowsingDataRemoved,
+	PrefetchEvictedAfterCandidateRemoved:                        prefetchStatusPrefetchEvictedAfterCandidateRemoved,
+	PrefetchEvictedForNewerPrefetch:                             prefetchStatusPrefetchEvictedForNewerPrefetch,
+	PrefetchHeldback:                                            prefetchStatusPrefetchHeldback,
+	PrefetchIneligibleRetryAfter:                                prefetchStatusPrefetchIneligibleRetryAfter,
+	PrefetchIsPrivacyDecoy:                                      prefetchStatusPrefetchIsPrivacyDecoy,
+	PrefetchIsStale:                                             prefetchStatusPrefetchIsStale,
+	PrefetchNotEligibleBrowserContextOffTheRecord:               prefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord,
+	PrefetchNotEligibleDataSaverEnabled:                         prefetchStatusPrefetchNotEligibleDataSaverEnabled,
+	PrefetchNotEligibleExistingProxy:                            prefetchStatusPrefetchNotEligibleExistingProxy,
+	PrefetchNotEligibleHostIsNonUnique:                          prefetchStatusPrefetchNotEligibleHostIsNonUnique,
+	PrefetchNotEligibleNonDefaultStoragePartition:               prefetchStatusPrefetchNotEligibleNonDefaultStoragePartition,
+	PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy: prefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy,
+	PrefetchNotEligibleSchemeIsNotHTTPS:                         prefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS,
+	PrefetchNotEligibleUserHasCookies:                           prefetchStatusPrefetchNotEligibleUserHasCookies,
+	PrefetchNotEligibleUserHasServiceWorker:                     prefetchStatusPrefetchNotEligibleUserHasServiceWorker,
+	PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler:       prefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler,
+	PrefetchNotEligibleRedirectFromServiceWorker:                prefetchStatusPrefetchNotEligibleRedirectFromServiceWorker,
+	PrefetchNotEligibleRedirectToServiceWorker:                  prefetchStatusPrefetchNotEligibleRedirectToServiceWorker,
+	PrefetchNotEligibleBatterySaverEnabled:                      prefetchStatusPrefetchNotEligibleBatterySaverEnabled,
+	PrefetchNotEligiblePreloadingDisabled:                       prefetchStatusPrefetchNotEligiblePreloadingDisabled,
+	PrefetchNotFinishedInTime:                                   prefetchStatusPrefetchNotFinishedInTime,
+	PrefetchNotStarted:                                          prefetchStatusPrefetchNotStarted,
+	PrefetchNotUsedCookiesChanged:                               prefetchStatusPrefetchNotUsedCookiesChanged,
+	PrefetchProxyNotAvailable:                                   prefetchStatusPrefetchProxyNotAvailable,
+	PrefetchResponseUsed:                                        prefetchStatusPrefetchResponseUsed,
+	PrefetchSuccessfulButNotUsed:                                prefetchStatusPrefetchSuccessfulButNotUsed,
+	PrefetchNotUsedProbeFailed:                                  prefetchStatusPrefetchNotUsedProbeFailed,
+}
+
+/*
+PrefetchStatusEnum represents the Preload.PrefetchStatus values.
+
+TODO(https://crbug.com/1384419): revisit the list of PrefetchStatus and filter
+out the ones that aren't necessary to the developers.
+
+Allowed values:
+  - PrefetchStatus.PrefetchAllowed                                             "PrefetchAllowed"
+  - PrefetchStatus.PrefetchFailedIneligibleRedirect                            "PrefetchFailedIneligibleRedirect"
+  - PrefetchStatus.PrefetchFailedInvalidRedirect                               "PrefetchFailedInvalidRedirect"
+  - PrefetchStatus.PrefetchFailedMIMENotSupported                              "PrefetchFailedMIMENotSupported"
+  - PrefetchStatus.PrefetchFailedNetError                                      "PrefetchFailedNetError"
+  - PrefetchStatus.PrefetchFailedNon2XX                                        "PrefetchFailedNon2XX"
+  - PrefetchStatus.PrefetchEvictedAfterBrowsingDataRemoved                     "PrefetchEvictedAfterBrowsingDataRemoved"
+  - PrefetchStatus.PrefetchEvictedAfterCandidateRemoved                        "PrefetchEvictedAfterCandidateRemoved"
+  - PrefetchStatus.PrefetchEvictedForNewerPrefetch                             "PrefetchEvictedForNewerPrefetch"
+  - PrefetchStatus.PrefetchHeldback                                            "PrefetchHeldback"
+  - PrefetchStatus.PrefetchIneligibleRetryAfter                                "PrefetchIneligibleRetryAfter"
+  - PrefetchStatus.PrefetchIsPrivacyDecoy                                      "PrefetchIsPrivacyDecoy"
+  - PrefetchStatus.PrefetchIsStale                                             "PrefetchIsStale"
+  - PrefetchStatus.PrefetchNotEligibleBrowserContextOffTheRecord               "PrefetchNotEligibleBrowserContextOffTheRecord"
+  - PrefetchStatus.PrefetchNotEligibleDataSaverEnabled                         "PrefetchNotEligibleDataSaverEnabled"
+  - PrefetchStatus.PrefetchNotEligibleExistingProxy                            "PrefetchNotEligibleExistingProxy"
+  - PrefetchStatus.PrefetchNotEligibleHostIsNonUnique                          "PrefetchNotEligibleHostIsNonUnique"
+  - PrefetchStatus.PrefetchNotEligibleNonDefaultStoragePartition               "PrefetchNotEligibleNonDefaultStoragePartition"
+  - PrefetchStatus.PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+  - PrefetchStatus.PrefetchNotEligibleSchemeIsNotHTTPS                         "PrefetchNotEligibleSchemeIsNotHttps"
+  - PrefetchStatus.PrefetchNotEligibleUserHasCookies                           "PrefetchNotEligibleUserHasCookies"
+  - PrefetchStatus.PrefetchNotEligibleUserHasServiceWorker                     "PrefetchNotEligibleUserHasServiceWorker"
+  - PrefetchStatus.PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"
+  - PrefetchStatus.PrefetchNotEligibleRedirectFromServiceWorker                "PrefetchNotEligibleRedirectFromServiceWorker"
+  - PrefetchStatus.PrefetchNotEligibleRedirectToServiceWorker                  "PrefetchNotEligibleRedirectToServiceWorker"
+  - PrefetchStatus.PrefetchNotEligibleBatterySaverEnabled                      "PrefetchNotEligibleBatterySaverEnabled"
+  - PrefetchStatus.PrefetchNotEligiblePreloadingDisabled                       "PrefetchNotEligiblePreloadingDisabled"
+  - PrefetchStatus.PrefetchNotFinishedInTime                                   "PrefetchNotFinishedInTime"
+  - PrefetchStatus.PrefetchNotStarted                                          "PrefetchNotStarted"
+  - PrefetchStatus.PrefetchNotUsedCookiesChanged                               "PrefetchNotUsedCookiesChanged"
+  - PrefetchStatus.PrefetchProxyNotAvailable                                   "PrefetchProxyNotAvailable"
+  - PrefetchStatus.PrefetchResponseUsed                                        "PrefetchResponseUsed"
+  - PrefetchStatus.PrefetchSuccessfulButNotUsed                                "PrefetchSuccessfulButNotUsed"
+  - PrefetchStatus.PrefetchNotUsedProbeFailed                                  "PrefetchNotUsedProbeFailed"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PrefetchStatus
+*/
+type PrefetchStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PrefetchStatusEnum) String() string {
+	return _prefetchStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PrefetchStatusEnum) Unknown() bool {
+	return !_prefetchStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PrefetchStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PrefetchStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _prefetchStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// prefetchStatusPrefetchAllowed represents the "PrefetchAllowed" value.
+	prefetchStatusPrefetchAllowed PrefetchStatusEnum = iota + 1
+	// prefetchStatusPrefetchFailedIneligibleRedirect represents the "PrefetchFailedIneligibleRedirect" value.
+	prefetchStatusPrefetchFailedIneligibleRedirect
+	// prefetchStatusPrefetchFailedInvalidRedirect represents the "PrefetchFailedInvalidRedirect" value.
+	prefetchStatusPrefetchFailedInvalidRedirect
+	// prefetchStatusPrefetchFailedMIMENotSupported represents the "PrefetchFailedMIMENotSupported" value.
+	prefetchStatusPrefetchFailedMIMENotSupported
+	// prefetchStatusPrefetchFailedNetError represents the "PrefetchFailedNetError" value.
+	prefetchStatusPrefetchFailedNetError
+	// prefetchStatusPrefetchFailedNon2XX represents the "PrefetchFailedNon2XX" value.
+	prefetchStatusPrefetchFailedNon2XX
+	// prefetchStatusPrefetchEvictedAfterBrowsingDataRemoved represents the "PrefetchEvictedAfterBrowsingDataRemoved" value.
+	prefetchStatusPrefetchEvictedAfterBrowsingDataRemoved
+	// prefetchStatusPrefetchEvictedAfterCandidateRemoved represents the "PrefetchEvictedAfterCandidateRemoved" value.
+	prefetchStatusPrefetchEvictedAfterCandidateRemoved
+	// prefetchStatusPrefetchEvictedForNewerPrefetch represents the "PrefetchEvictedForNewerPrefetch" value.
+	prefetchStatusPrefetchEvictedForNewerPrefetch
+	// prefetchStatusPrefetchHeldback represents the "PrefetchHeldback" value.
+	prefetchStatusPrefetchHeldback
+	// prefetchStatusPrefetchIneligibleRetryAfter represents the "PrefetchIneligibleRetryAfter" value.
+	prefetchStatusPrefetchIneligibleRetryAfter
+	// prefetchStatusPrefetchIsPrivacyDecoy represents the "PrefetchIsPrivacyDecoy" value.
+	prefetchStatusPrefetchIsPrivacyDecoy
+	// prefetchStatusPrefetchIsStale represents the "PrefetchIsStale" value.
+	prefetchStatusPrefetchIsStale
+	// prefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord represents the "PrefetchNotEligibleBrowserContextOffTheRecord" value.
+	prefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord
+	// prefetchStatusPrefetchNotEligibleDataSaverEnabled represents the "PrefetchNotEligibleDataSaverEnabled" value.
+	prefetchStatusPrefetchNotEligibleDataSaverEnabled
+	// prefetchStatusPrefetchNotEligibleExistingProxy represents the "PrefetchNotEligibleExistingProxy" value.
+	prefetchStatusPrefetchNotEligibleExistingProxy
+	// prefetchStatusPrefetchNotEligibleHostIsNonUnique represents the "PrefetchNotEligibleHostIsNonUnique" value.
+	prefetchStatusPrefetchNotEligibleHostIsNonUnique
+	// prefetchStatusPrefetchNotEligibleNonDefaultStoragePartition represents the "PrefetchNotEligibleNonDefaultStoragePartition" value.
+	prefetchStatusPrefetchNotEligibleNonDefaultStoragePartition
+	// prefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy represents the "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy" value.
+	prefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy
+	// prefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS represents the "PrefetchNotEligibleSchemeIsNotHttps" value.
+	prefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS
+	// prefetchStatusPrefetchNotEligibleUserHasCookies represents the "PrefetchNotEligibleUserHasCookies" value.
+	prefetchStatusPrefetchNotEligibleUserHasCookies
+	// prefetchStatusPrefetchNotEligibleUserHasServiceWorker represents the "PrefetchNotEligibleUserHasServiceWorker" value.
+	prefetchStatusPrefetchNotEligibleUserHasServiceWorker
+	// prefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler represents the "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler" value.
+	prefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler
+	// prefetchStatusPrefetchNotEligibleRedirectFromServiceWorker represents the "PrefetchNotEligibleRedirectFromServiceWorker" value.
+	prefetchStatusPrefetchNotEligibleRedirectFromServiceWorker
+	// prefetchStatusPrefetchNotEligibleRedirectToServiceWorker represents the "PrefetchNotEligibleRedirectToServiceWorker" value.
+	prefetchStatusPrefetchNotEligibleRedirectToServiceWorker
+	// prefetchStatusPrefetchNotEligibleBatterySaverEnabled represents the "PrefetchNotEligibleBatterySaverEnabled" value.
+	prefetchStatusPrefetchNotEligibleBatterySaverEnabled
+	// prefetchStatusPrefetchNotEligiblePreloadingDisabled represents the "PrefetchNotEligiblePreloadingDisabled" value.
+	prefetchStatusPrefetchNotEligiblePreloadingDisabled
+	// prefetchStatusPrefetchNotFinishedInTime represents the "PrefetchNotFinishedInTime" value.
+	prefetchStatusPrefetchNotFinishedInTime
+	// prefetchStatusPrefetchNotStarted represents the "PrefetchNotStarted" value.
+	prefetchStatusPrefetchNotStarted
+	// prefetchStatusPrefetchNotUsedCookiesChanged represents the "PrefetchNotUsedCookiesChanged" value.
+	prefetchStatusPrefetchNotUsedCookiesChanged
+	// prefetchStatusPrefetchProxyNotAvailable represents the "PrefetchProxyNotAvailable" value.
+	prefetchStatusPrefetchProxyNotAvailable
+	// prefetchStatusPrefetchResponseUsed represents the "PrefetchResponseUsed" value.
+	prefetchStatusPrefetchResponseUsed
+	// prefetchStatusPrefetchSuccessfulButNotUsed represents the "PrefetchSuccessfulButNotUsed" value.
+	prefetchStatusPrefetchSuccessfulButNotUsed
+	// prefetchStatusPrefetchNotUsedProbeFailed represents the "PrefetchNotUsedProbeFailed" value.
+	prefetchStatusPrefetchNotUsedProbeFailed
+)
+
+var _prefetchStatusEnums = enums.New(map[PrefetchStatusEnum]string{
+	PrefetchStatusEnum(0):                                                     "",
+	prefetchStatusPrefetchAllowed:                                             "PrefetchAllowed",
+	prefetchStatusPrefetchFailedIneligibleRedirect:                            "PrefetchFailedIneligibleRedirect",
+	prefetchStatusPrefetchFailedInvalidRedirect:                               "PrefetchFailedInvalidRedirect",
+	prefetchStatusPrefetchFailedMIMENotSupported:                              "PrefetchFailedMIMENotSupported",
+	prefetchStatusPrefetchFailedNetError:                                      "PrefetchFailedNetError",
+	prefetchStatusPrefetchFailedNon2XX:                                        "PrefetchFailedNon2XX",
+	prefetchStatusPrefetchEvictedAfterBrowsingDataRemoved:                     "PrefetchEvictedAfterBrowsingDataRemoved",
+	prefetchStatusPrefetchEvictedAfterCandidateRemoved:                        "PrefetchEvictedAfterCandidateRemoved",
+	prefetchStatusPrefetchEvictedForNewerPrefetch:                             "PrefetchEvictedForNewerPrefetch",
+	prefetchStatusPrefetchHeldback:                                            "PrefetchHeldback",
+	prefetchStatusPrefetchIneligibleRetryAfter:                                "PrefetchIneligibleRetryAfter",
+	prefetchStatusPrefetchIsPrivacyDecoy:                                      "PrefetchIsPrivacyDecoy",
+	prefetchStatusPrefetchIsStale:                                             "PrefetchIsStale",
+	prefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord:               "PrefetchNotEligibleBrowserContextOffTheRecord",
+	prefetchStatusPrefetchNotEligibleDataSaverEnabled:                         "PrefetchNotEligibleDataSaverEnabled",
+	prefetchStatusPrefetchNotEligibleExistingProxy:                            "PrefetchNotEligibleExistingProxy",
+	prefetchStatusPrefetchNotEligibleHostIsNonUnique:                          "PrefetchNotEligibleHostIsNonUnique",
+	prefetchStatusPrefetchNotEligibleNonDefaultStoragePartition:               "PrefetchNotEligibleNonDefaultStoragePartition",
+	prefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy: "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy",
+	prefetchStatusPrefetchNotEligibleSchemeIsNotHTTPS:                         "PrefetchNotEligibleSchemeIsNotHttps",
+	prefetchStatusPrefetchNotEligibleUserHasCookies:                           "PrefetchNotEligibleUserHasCookies",
+	prefetchStatusPrefetchNotEligibleUserHasServiceWorker:                     "PrefetchNotEligibleUserHasServiceWorker",
+	prefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler:       "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler",
+	prefetchStatusPrefetchNotEligibleRedirectFromServiceWorker:                "PrefetchNotEligibleRedirectFromServiceWorker",
+	prefetchStatusPrefetchNotEligibleRedirectToServiceWorker:                  "PrefetchNotEligibleRedirectToServiceWorker",
+	prefetchStatusPrefetchNotEligibleBatterySaverEnabled:                      "PrefetchNotEligibleBatterySaverEnabled",
+	prefetchStatusPrefetchNotEligiblePreloadingDisabled:                       "PrefetchNotEligiblePreloadingDisabled",
+	prefetchStatusPrefetchNotFinishedInTime:                                   "PrefetchNotFinishedInTime",
+	prefetchStatusPrefetchNotStarted:                                          "PrefetchNotStarted",
+	prefetchStatusPrefetchNotUsedCookiesChanged:                               "PrefetchNotUsedCookiesChanged",
+	prefetchStatusPrefetchProxyNotAvailable:                                   "PrefetchProxyNotAvailable",
+	prefetchStatusPrefetchResponseUsed:                                        "PrefetchResponseUsed",
+	prefetchStatusPrefetchSuccessfulButNotUsed:                                "PrefetchSuccessfulButNotUsed",
+	prefetchStatusPrefetchNotUsedProbeFailed:                                  "PrefetchNotUsedProbeFailed",
+})
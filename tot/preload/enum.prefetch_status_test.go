@@ -0,0 +1,485 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPrefetchStatus(t *testing.T) {
+	var enum PrefetchStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PrefetchStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PrefetchStatus.PrefetchAllowed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchAllowed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchAllowed"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchAllowed"`), &enum)
+	if PrefetchStatus.PrefetchAllowed != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchAllowed, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchFailedIneligibleRedirect
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchFailedIneligibleRedirect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchFailedIneligibleRedirect"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchFailedIneligibleRedirect"`), &enum)
+	if PrefetchStatus.PrefetchFailedIneligibleRedirect != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchFailedIneligibleRedirect, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchFailedInvalidRedirect
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchFailedInvalidRedirect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchFailedInvalidRedirect"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchFailedInvalidRedirect"`), &enum)
+	if PrefetchStatus.PrefetchFailedInvalidRedirect != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchFailedInvalidRedirect, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchFailedMIMENotSupported
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchFailedMIMENotSupported"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchFailedMIMENotSupported"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchFailedMIMENotSupported"`), &enum)
+	if PrefetchStatus.PrefetchFailedMIMENotSupported != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchFailedMIMENotSupported, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchFailedNetError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchFailedNetError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchFailedNetError"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchFailedNetError"`), &enum)
+	if PrefetchStatus.PrefetchFailedNetError != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchFailedNetError, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchFailedNon2XX
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchFailedNon2XX"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchFailedNon2XX"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchFailedNon2XX"`), &enum)
+	if PrefetchStatus.PrefetchFailedNon2XX != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchFailedNon2XX, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchEvictedAfterBrowsingDataRemoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchEvictedAfterBrowsingDataRemoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchEvictedAfterBrowsingDataRemoved"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchEvictedAfterBrowsingDataRemoved"`), &enum)
+	if PrefetchStatus.PrefetchEvictedAfterBrowsingDataRemoved != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchEvictedAfterBrowsingDataRemoved, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchEvictedAfterCandidateRemoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchEvictedAfterCandidateRemoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchEvictedAfterCandidateRemoved"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchEvictedAfterCandidateRemoved"`), &enum)
+	if PrefetchStatus.PrefetchEvictedAfterCandidateRemoved != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchEvictedAfterCandidateRemoved, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchEvictedForNewerPrefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchEvictedForNewerPrefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchEvictedForNewerPrefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchEvictedForNewerPrefetch"`), &enum)
+	if PrefetchStatus.PrefetchEvictedForNewerPrefetch != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchEvictedForNewerPrefetch, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchHeldback
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchHeldback"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchHeldback"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchHeldback"`), &enum)
+	if PrefetchStatus.PrefetchHeldback != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchHeldback, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchIneligibleRetryAfter
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchIneligibleRetryAfter"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchIneligibleRetryAfter"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchIneligibleRetryAfter"`), &enum)
+	if PrefetchStatus.PrefetchIneligibleRetryAfter != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchIneligibleRetryAfter, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchIsPrivacyDecoy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchIsPrivacyDecoy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchIsPrivacyDecoy"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchIsPrivacyDecoy"`), &enum)
+	if PrefetchStatus.PrefetchIsPrivacyDecoy != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchIsPrivacyDecoy, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchIsStale
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchIsStale"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchIsStale"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchIsStale"`), &enum)
+	if PrefetchStatus.PrefetchIsStale != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchIsStale, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleBrowserContextOffTheRecord
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleBrowserContextOffTheRecord"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleBrowserContextOffTheRecord"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleBrowserContextOffTheRecord"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleBrowserContextOffTheRecord != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleBrowserContextOffTheRecord, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleDataSaverEnabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleDataSaverEnabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleDataSaverEnabled"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleDataSaverEnabled"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleDataSaverEnabled != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleDataSaverEnabled, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleExistingProxy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleExistingProxy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleExistingProxy"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleExistingProxy"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleExistingProxy != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleExistingProxy, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleHostIsNonUnique
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleHostIsNonUnique"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleHostIsNonUnique"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleHostIsNonUnique"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleHostIsNonUnique != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleHostIsNonUnique, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleNonDefaultStoragePartition
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleNonDefaultStoragePartition"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleNonDefaultStoragePartition"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleNonDefaultStoragePartition"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleNonDefaultStoragePartition != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleNonDefaultStoragePartition, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleSchemeIsNotHTTPS
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleSchemeIsNotHttps"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleSchemeIsNotHttps"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleSchemeIsNotHttps"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleSchemeIsNotHTTPS != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleSchemeIsNotHTTPS, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleUserHasCookies
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleUserHasCookies"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleUserHasCookies"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleUserHasCookies"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleUserHasCookies != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleUserHasCookies, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleUserHasServiceWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleUserHasServiceWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleUserHasServiceWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleUserHasServiceWorker"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleUserHasServiceWorker != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleUserHasServiceWorker, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleRedirectFromServiceWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleRedirectFromServiceWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleRedirectFromServiceWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleRedirectFromServiceWorker"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleRedirectFromServiceWorker != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleRedirectFromServiceWorker, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleRedirectToServiceWorker
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleRedirectToServiceWorker"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleRedirectToServiceWorker"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleRedirectToServiceWorker"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleRedirectToServiceWorker != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleRedirectToServiceWorker, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligibleBatterySaverEnabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligibleBatterySaverEnabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligibleBatterySaverEnabled"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligibleBatterySaverEnabled"`), &enum)
+	if PrefetchStatus.PrefetchNotEligibleBatterySaverEnabled != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligibleBatterySaverEnabled, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotEligiblePreloadingDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotEligiblePreloadingDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotEligiblePreloadingDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotEligiblePreloadingDisabled"`), &enum)
+	if PrefetchStatus.PrefetchNotEligiblePreloadingDisabled != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotEligiblePreloadingDisabled, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotFinishedInTime
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotFinishedInTime"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotFinishedInTime"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotFinishedInTime"`), &enum)
+	if PrefetchStatus.PrefetchNotFinishedInTime != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotFinishedInTime, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotStarted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotStarted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotStarted"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotStarted"`), &enum)
+	if PrefetchStatus.PrefetchNotStarted != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotStarted, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotUsedCookiesChanged
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotUsedCookiesChanged"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotUsedCookiesChanged"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotUsedCookiesChanged"`), &enum)
+	if PrefetchStatus.PrefetchNotUsedCookiesChanged != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotUsedCookiesChanged, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchProxyNotAvailable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchProxyNotAvailable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchProxyNotAvailable"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchProxyNotAvailable"`), &enum)
+	if PrefetchStatus.PrefetchProxyNotAvailable != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchProxyNotAvailable, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchResponseUsed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchResponseUsed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchResponseUsed"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchResponseUsed"`), &enum)
+	if PrefetchStatus.PrefetchResponseUsed != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchResponseUsed, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchSuccessfulButNotUsed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchSuccessfulButNotUsed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchSuccessfulButNotUsed"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchSuccessfulButNotUsed"`), &enum)
+	if PrefetchStatus.PrefetchSuccessfulButNotUsed != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchSuccessfulButNotUsed, enum)
+	}
+
+	enum = PrefetchStatus.PrefetchNotUsedProbeFailed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrefetchNotUsedProbeFailed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrefetchNotUsedProbeFailed"`, result)
+	}
+	json.Unmarshal([]byte(`"PrefetchNotUsedProbeFailed"`), &enum)
+	if PrefetchStatus.PrefetchNotUsedProbeFailed != enum {
+		t.Errorf("Expected %d, got %d", PrefetchStatus.PrefetchNotUsedProbeFailed, enum)
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type preloadingStatusEnum struct {
+	Pending      PreloadingStatusEnum
+	Running      PreloadingStatusEnum
+	Ready        PreloadingStatusEnum
+	Success      PreloadingStatusEnum
+	Failure      PreloadingStatusEnum
+	NotSupported PreloadingStatusEnum
+}
+
+/*
+PreloadingStatus provides named access to the PreloadingStatusEnum values.
+*/
+var PreloadingStatus = preloadingStatusEnum{
+	Pending:      preloadingStatusPending,
+	Running:      preloadingStatusRunning,
+	Ready:        preloadingStatusReady,
+	Success:      preloadingStatusSuccess,
+	Failure:      preloadingStatusFailure,
+	NotSupported: preloadingStatusNotSupported,
+}
+
+/*
+PreloadingStatusEnum represents the Preload.PreloadingStatus values.
+
+Preloading status values, see also PreloadingTriggeringOutcome. This status is
+shared by prefetchStatusUpdated and prerenderStatusUpdated.
+
+Allowed values:
+  - PreloadingStatus.Pending      "Pending"
+  - PreloadingStatus.Running      "Running"
+  - PreloadingStatus.Ready        "Ready"
+  - PreloadingStatus.Success      "Success"
+  - PreloadingStatus.Failure      "Failure"
+  - PreloadingStatus.NotSupported "NotSupported"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PreloadingStatus
+*/
+type PreloadingStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PreloadingStatusEnum) String() string {
+	return _preloadingStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PreloadingStatusEnum) Unknown() bool {
+	return !_preloadingStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PreloadingStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PreloadingStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _preloadingStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// preloadingStatusPending represents the "Pending" value.
+	preloadingStatusPending PreloadingStatusEnum = iota + 1
+	// preloadingStatusRunning represents the "Running" value.
+	preloadingStatusRunning
+	// preloadingStatusReady represents the "Ready" value.
+	preloadingStatusReady
+	// preloadingStatusSuccess represents the "Success" value.
+	preloadingStatusSuccess
+	// preloadingStatusFailure represents the "Failure" value.
+	preloadingStatusFailure
+	// preloadingStatusNotSupported represents the "NotSupported" value.
+	preloadingStatusNotSupported
+)
+
+var _preloadingStatusEnums = enums.New(map[PreloadingStatusEnum]string{
+	PreloadingStatusEnum(0):      "",
+	preloadingStatusPending:      "Pending",
+	preloadingStatusRunning:      "Running",
+	preloadingStatusReady:        "Ready",
+	preloadingStatusSuccess:      "Success",
+	preloadingStatusFailure:      "Failure",
+	preloadingStatusNotSupported: "NotSupported",
+})
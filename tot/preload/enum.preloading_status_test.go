@@ -0,0 +1,121 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPreloadingStatus(t *testing.T) {
+	var enum PreloadingStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PreloadingStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PreloadingStatus.Pending
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Pending"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Pending"`, result)
+	}
+	json.Unmarshal([]byte(`"Pending"`), &enum)
+	if PreloadingStatus.Pending != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.Pending, enum)
+	}
+
+	enum = PreloadingStatus.Running
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Running"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Running"`, result)
+	}
+	json.Unmarshal([]byte(`"Running"`), &enum)
+	if PreloadingStatus.Running != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.Running, enum)
+	}
+
+	enum = PreloadingStatus.Ready
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Ready"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Ready"`, result)
+	}
+	json.Unmarshal([]byte(`"Ready"`), &enum)
+	if PreloadingStatus.Ready != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.Ready, enum)
+	}
+
+	enum = PreloadingStatus.Success
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Success"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Success"`, result)
+	}
+	json.Unmarshal([]byte(`"Success"`), &enum)
+	if PreloadingStatus.Success != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.Success, enum)
+	}
+
+	enum = PreloadingStatus.Failure
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Failure"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Failure"`, result)
+	}
+	json.Unmarshal([]byte(`"Failure"`), &enum)
+	if PreloadingStatus.Failure != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.Failure, enum)
+	}
+
+	enum = PreloadingStatus.NotSupported
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NotSupported"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NotSupported"`, result)
+	}
+	json.Unmarshal([]byte(`"NotSupported"`), &enum)
+	if PreloadingStatus.NotSupported != enum {
+		t.Errorf("Expected %d, got %d", PreloadingStatus.NotSupported, enum)
+	}
+}
@@ -0,0 +1,516 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type prerenderFinalStatusEnum struct {
+	Activated                                                  PrerenderFinalStatusEnum
+	Destroyed                                                  PrerenderFinalStatusEnum
+	LowEndDevice                                               PrerenderFinalStatusEnum
+	InvalidSchemeRedirect                                      PrerenderFinalStatusEnum
+	InvalidSchemeNavigation                                    PrerenderFinalStatusEnum
+	NavigationRequestBlockedByCsp                              PrerenderFinalStatusEnum
+	MojoBinderPolicy                                           PrerenderFinalStatusEnum
+	RendererProcessCrashed                                     PrerenderFinalStatusEnum
+	RendererProcessKilled                                      PrerenderFinalStatusEnum
+	Download                                                   PrerenderFinalStatusEnum
+	TriggerDestroyed                                           PrerenderFinalStatusEnum
+	NavigationNotCommitted                                     PrerenderFinalStatusEnum
+	NavigationBadHTTPStatus                                    PrerenderFinalStatusEnum
+	ClientCertRequested                                        PrerenderFinalStatusEnum
+	NavigationRequestNetworkError                              PrerenderFinalStatusEnum
+	CancelAllHostsForTesting                                   PrerenderFinalStatusEnum
+	DidFailLoad                                                PrerenderFinalStatusEnum
+	Stop                                                       PrerenderFinalStatusEnum
+	SSLCertificateError                                        PrerenderFinalStatusEnum
+	LoginAuthRequested                                         PrerenderFinalStatusEnum
+	UaChangeRequiresReload                                     PrerenderFinalStatusEnum
+	BlockedByClient                                            PrerenderFinalStatusEnum
+	AudioOutputDeviceRequested                                 PrerenderFinalStatusEnum
+	MixedContent                                               PrerenderFinalStatusEnum
+	TriggerBackgrounded                                        PrerenderFinalStatusEnum
+	MemoryLimitExceeded                                        PrerenderFinalStatusEnum
+	DataSaverEnabled                                           PrerenderFinalStatusEnum
+	TriggerURLHasEffectiveURL                                  PrerenderFinalStatusEnum
+	ActivatedBeforeStarted                                     PrerenderFinalStatusEnum
+	InactivePageRestriction                                    PrerenderFinalStatusEnum
+	StartFailed                                                PrerenderFinalStatusEnum
+	TimeoutBackgrounded                                        PrerenderFinalStatusEnum
+	CrossSiteRedirectInInitialNavigation                       PrerenderFinalStatusEnum
+	CrossSiteNavigationInInitialNavigation                     PrerenderFinalStatusEnum
+	SameSiteCrossOriginRedirectNotOptInInInitialNavigation     PrerenderFinalStatusEnum
+	SameSiteCrossOriginNavigationNotOptInInInitialNavigation   PrerenderFinalStatusEnum
+	ActivationNavigationParameterMismatch                      PrerenderFinalStatusEnum
+	ActivatedInBackground                                      PrerenderFinalStatusEnum
+	EmbedderHostDisallowed                                     PrerenderFinalStatusEnum
+	ActivationNavigationDestroyedBeforeSuccess                 PrerenderFinalStatusEnum
+	TabClosedByUserGesture                                     PrerenderFinalStatusEnum
+	TabClosedWithoutUserGesture                                PrerenderFinalStatusEnum
+	PrimaryMainFrameRendererProcessCrashed                     PrerenderFinalStatusEnum
+	PrimaryMainFrameRendererProcessKilled                      PrerenderFinalStatusEnum
+	ActivationFramePolicyNotCompatible                         PrerenderFinalStatusEnum
+	PreloadingDisabled                                         PrerenderFinalStatusEnum
+	BatterySaverEnabled                                        PrerenderFinalStatusEnum
+	ActivatedDuringMainFrameNavigation                         PrerenderFinalStatusEnum
+	PreloadingUnsupportedByWebContents                         PrerenderFinalStatusEnum
+	CrossSiteRedirectInMainFrameNavigation                     PrerenderFinalStatusEnum
+	CrossSiteNavigationInMainFrameNavigation                   PrerenderFinalStatusEnum
+	SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PrerenderFinalStatusEnum
+	SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PrerenderFinalStatusEnum
+	MemoryPressureOnTrigger                                    PrerenderFinalStatusEnum
+	MemoryPressureAfterTriggered                               PrerenderFinalStatusEnum
+	PrerenderingDisabledByDevTools                             PrerenderFinalStatusEnum
+	SpeculationRuleRemoved                                     PrerenderFinalStatusEnum
+	ActivatedWithAuxiliaryBrowsingContexts                     PrerenderFinalStatusEnum
+	MaxNumOfRunningEagerPrerendersExceeded                     PrerenderFinalStatusEnum
+	MaxNumOfRunningNonEagerPrerendersExceeded                  PrerenderFinalStatusEnum
+	MaxNumOfRunningEmbedderPrerendersExceeded                  PrerenderFinalStatusEnum
+	PrerenderingURLHasEffectiveURL                             PrerenderFinalStatusEnum
+	RedirectedPrerenderingURLHasEffectiveURL                   PrerenderFinalStatusEnum
+	ActivationURLHasEffectiveURL                               PrerenderFinalStatusEnum
+	JavaScriptInterfaceAdded                                   PrerenderFinalStatusEnum
+	JavaScriptInterfaceRemoved                                 PrerenderFinalStatusEnum
+	AllPrerenderingCanceled                                    PrerenderFinalStatusEnum
+	WindowClosed                                               PrerenderFinalStatusEnum
+	SlowNetwork                                                PrerenderFinalStatusEnum
+	OtherPrerenderedPageActivated                              PrerenderFinalStatusEnum
+	V8OptimizerDisabled                                        PrerenderFinalStatusEnum
+	PrerenderFailedDuringPrefetch                              PrerenderFinalStatusEnum
+	BrowsingDataRemoved                                        PrerenderFinalStatusEnum
+	PrerenderHostReused                                        PrerenderFinalStatusEnum
+}
+
+/*
+PrerenderFinalStatus provides named access to the PrerenderFinalStatusEnum values.
+*/
+var PrerenderFinalStatus = prerenderFinalStatusEnum{
+	Activated:                              prerenderFinalStatusActivated,
+	Destroyed:                              prerenderFinalStatusDestroyed,
+	LowEndDevice:                           prerenderFinalStatusLowEndDevice,
+	InvalidSchemeRedirect:                  prerenderFinalStatusInvalidSchemeRedirect,
+	InvalidSchemeNavigation:                prerenderFinalStatusInvalidSchemeNavigation,
+	NavigationRequestBlockedByCsp:          prerenderFinalStatusNavigationRequestBlockedByCsp,
+	MojoBinderPolicy:                       prerenderFinalStatusMojoBinderPolicy,
+	RendererProcessCrashed:                 prerenderFinalStatusRendererProcessCrashed,
+	RendererProcessKilled:                  prerenderFinalStatusRendererProcessKilled,
+	Download:                               prerenderFinalStatusDownload,
+	TriggerDestroyed:                       prerenderFinalStatusTriggerDestroyed,
+	NavigationNotCommitted:                 prerenderFinalStatusNavigationNotCommitted,
+	NavigationBadHTTPStatus:                prerenderFinalStatusNavigationBadHTTPStatus,
+	ClientCertRequested:                    prerenderFinalStatusClientCertRequested,
+	NavigationRequestNetworkError:          prerenderFinalStatusNavigationRequestNetworkError,
+	CancelAllHostsForTesting:               prerenderFinalStatusCancelAllHostsForTesting,
+	DidFailLoad:                            prerenderFinalStatusDidFailLoad,
+	Stop:                                   prerenderFinalStatusStop,
+	SSLCertificateError:                    prerenderFinalStatusSSLCertificateError,
+	LoginAuthRequested:                     prerenderFinalStatusLoginAuthRequested,
+	UaChangeRequiresReload:                 prerenderFinalStatusUaChangeRequiresReload,
+	BlockedByClient:                        prerenderFinalStatusBlockedByClient,
+	AudioOutputDeviceRequested:             prerenderFinalStatusAudioOutputDeviceRequested,
+	MixedContent:                           prerenderFinalStatusMixedContent,
+	TriggerBackgrounded:                    prerenderFinalStatusTriggerBackgrounded,
+	MemoryLimitExceeded:                    prerenderFinalStatusMemoryLimitExceeded,
+	DataSaverEnabled:                       prerenderFinalStatusDataSaverEnabled,
+	TriggerURLHasEffectiveURL:              prerenderFinalStatusTriggerURLHasEffectiveURL,
+	ActivatedBeforeStarted:                 prerenderFinalStatusActivatedBeforeStarted,
+	InactivePageRestriction:                prerenderFinalStatusInactivePageRestriction,
+	StartFailed:                            prerenderFinalStatusStartFailed,
+	TimeoutBackgrounded:                    prerenderFinalStatusTimeoutBackgrounded,
+	CrossSiteRedirectInInitialNavigation:   prerenderFinalStatusCrossSiteRedirectInInitialNavigation,
+	CrossSiteNavigationInInitialNavigation: prerenderFinalStatusCrossSiteNavigationInInitialNavigation,
+	SameSiteCrossOriginRedirectNotOptInInInitialNavigation:     prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation,
+	SameSiteCrossOriginNavigationNotOptInInInitialNavigation:   prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation,
+	ActivationNavigationParameterMismatch:                      prerenderFinalStatusActivationNavigationParameterMismatch,
+	ActivatedInBackground:                                      prerenderFinalStatusActivatedInBackground,
+	EmbedderHostDisallowed:                                     prerenderFinalStatusEmbedderHostDisallowed,
+	ActivationNavigationDestroyedBeforeSuccess:                 prerenderFinalStatusActivationNavigationDestroyedBeforeSuccess,
+	TabClosedByUserGesture:                                     prerenderFinalStatusTabClosedByUserGesture,
+	TabClosedWithoutUserGesture:                                prerenderFinalStatusTabClosedWithoutUserGesture,
+	PrimaryMainFrameRendererProcessCrashed:                     prerenderFinalStatusPrimaryMainFrameRendererProcessCrashed,
+	PrimaryMainFrameRendererProcessKilled:                      prerenderFinalStatusPrimaryMainFrameRendererProcessKilled,
+	ActivationFramePolicyNotCompatible:                         prerenderFinalStatusActivationFramePolicyNotCompatible,
+	PreloadingDisabled:                                         prerenderFinalStatusPreloadingDisabled,
+	BatterySaverEnabled:                                        prerenderFinalStatusBatterySaverEnabled,
+	ActivatedDuringMainFrameNavigation:                         prerenderFinalStatusActivatedDuringMainFrameNavigation,
+	PreloadingUnsupportedByWebContents:                         prerenderFinalStatusPreloadingUnsupportedByWebContents,
+	CrossSiteRedirectInMainFrameNavigation:                     prerenderFinalStatusCrossSiteRedirectInMainFrameNavigation,
+	CrossSiteNavigationInMainFrameNavigation:                   prerenderFinalStatusCrossSiteNavigationInMainFrameNavigation,
+	SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation:   prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation,
+	SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation: prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation,
+	MemoryPressureOnTrigger:                                    prerenderFinalStatusMemoryPressureOnTrigger,
+	MemoryPressureAfterTriggered:                               prerenderFinalStatusMemoryPressureAfterTriggered,
+	PrerenderingDisabledByDevTools:                             prerenderFinalStatusPrerenderingDisabledByDevTools,
+	SpeculationRuleRemoved:                                     prerenderFinalStatusSpeculationRuleRemoved,
+	ActivatedWithAuxiliaryBrowsingContexts:                     prerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts,
+	MaxNumOfRunningEagerPrerendersExceeded:                     prerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded,
+	MaxNumOfRunningNonEagerPrerendersExceeded:                  prerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded,
+	MaxNumOfRunningEmbedderPrerendersExceeded:                  prerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded,
+	PrerenderingURLHasEffectiveURL:                             prerenderFinalStatusPrerenderingURLHasEffectiveURL,
+	RedirectedPrerenderingURLHasEffectiveURL:                   prerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL,
+	ActivationURLHasEffectiveURL:                               prerenderFinalStatusActivationURLHasEffectiveURL,
+	JavaScriptInterfaceAdded:                                   prerenderFinalStatusJavaScriptInterfaceAdded,
+	JavaScriptInterfaceRemoved:                                 prerenderFinalStatusJavaScriptInterfaceRemoved,
+	AllPrerenderingCanceled:                                    prerenderFinalStatusAllPrerenderingCanceled,
+	WindowClosed:                                               prerenderFinalStatusWindowClosed,
+	SlowNetwork:                                                prerenderFinalStatusSlowNetwork,
+	OtherPrerenderedPageActivated:                              prerenderFinalStatusOtherPrerenderedPageActivated,
+	V8OptimizerDisabled:                                        prerenderFinalStatusV8OptimizerDisabled,
+	PrerenderFailedDuringPrefetch:                              prerenderFinalStatusPrerenderFailedDuringPrefetch,
+	BrowsingDataRemoved:                                        prerenderFinalStatusBrowsingDataRemoved,
+	PrerenderHostReused:                                        prerenderFinalStatusPrerenderHostReused,
+}
+
+/*
+PrerenderFinalStatusEnum represents the Preload.PrerenderFinalStatus values.
+
+List of FinalStatus reasons for Prerender2.
+
+Allowed values:
+  - PrerenderFinalStatus.Activated                                                  "Activated"
+  - PrerenderFinalStatus.Destroyed                                                  "Destroyed"
+  - PrerenderFinalStatus.LowEndDevice                                               "LowEndDevice"
+  - PrerenderFinalStatus.InvalidSchemeRedirect                                      "InvalidSchemeRedirect"
+  - PrerenderFinalStatus.InvalidSchemeNavigation                                    "InvalidSchemeNavigation"
+  - PrerenderFinalStatus.NavigationRequestBlockedByCsp                              "NavigationRequestBlockedByCsp"
+  - PrerenderFinalStatus.MojoBinderPolicy                                           "MojoBinderPolicy"
+  - PrerenderFinalStatus.RendererProcessCrashed                                     "RendererProcessCrashed"
+  - PrerenderFinalStatus.RendererProcessKilled                                      "RendererProcessKilled"
+  - PrerenderFinalStatus.Download                                                   "Download"
+  - PrerenderFinalStatus.TriggerDestroyed                                           "TriggerDestroyed"
+  - PrerenderFinalStatus.NavigationNotCommitted                                     "NavigationNotCommitted"
+  - PrerenderFinalStatus.NavigationBadHTTPStatus                                    "NavigationBadHttpStatus"
+  - PrerenderFinalStatus.ClientCertRequested                                        "ClientCertRequested"
+  - PrerenderFinalStatus.NavigationRequestNetworkError                              "NavigationRequestNetworkError"
+  - PrerenderFinalStatus.CancelAllHostsForTesting                                   "CancelAllHostsForTesting"
+  - PrerenderFinalStatus.DidFailLoad                                                "DidFailLoad"
+  - PrerenderFinalStatus.Stop                                                       "Stop"
+  - PrerenderFinalStatus.SSLCertificateError                                        "SslCertificateError"
+  - PrerenderFinalStatus.LoginAuthRequested                                         "LoginAuthRequested"
+  - PrerenderFinalStatus.UaChangeRequiresReload                                     "UaChangeRequiresReload"
+  - PrerenderFinalStatus.BlockedByClient                                            "BlockedByClient"
+  - PrerenderFinalStatus.AudioOutputDeviceRequested                                 "AudioOutputDeviceRequested"
+  - PrerenderFinalStatus.MixedContent                                               "MixedContent"
+  - PrerenderFinalStatus.TriggerBackgrounded                                        "TriggerBackgrounded"
+  - PrerenderFinalStatus.MemoryLimitExceeded                                        "MemoryLimitExceeded"
+  - PrerenderFinalStatus.DataSaverEnabled                                           "DataSaverEnabled"
+  - PrerenderFinalStatus.TriggerURLHasEffectiveURL                                  "TriggerUrlHasEffectiveUrl"
+  - PrerenderFinalStatus.ActivatedBeforeStarted                                     "ActivatedBeforeStarted"
+  - PrerenderFinalStatus.InactivePageRestriction                                    "InactivePageRestriction"
+  - PrerenderFinalStatus.StartFailed                                                "StartFailed"
+  - PrerenderFinalStatus.TimeoutBackgrounded                                        "TimeoutBackgrounded"
+  - PrerenderFinalStatus.CrossSiteRedirectInInitialNavigation                       "CrossSiteRedirectInInitialNavigation"
+  - PrerenderFinalStatus.CrossSiteNavigationInInitialNavigation                     "CrossSiteNavigationInInitialNavigation"
+  - PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInInitialNavigation     "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+  - PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInInitialNavigation   "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+  - PrerenderFinalStatus.ActivationNavigationParameterMismatch                      "ActivationNavigationParameterMismatch"
+  - PrerenderFinalStatus.ActivatedInBackground                                      "ActivatedInBackground"
+  - PrerenderFinalStatus.EmbedderHostDisallowed                                     "EmbedderHostDisallowed"
+  - PrerenderFinalStatus.ActivationNavigationDestroyedBeforeSuccess                 "ActivationNavigationDestroyedBeforeSuccess"
+  - PrerenderFinalStatus.TabClosedByUserGesture                                     "TabClosedByUserGesture"
+  - PrerenderFinalStatus.TabClosedWithoutUserGesture                                "TabClosedWithoutUserGesture"
+  - PrerenderFinalStatus.PrimaryMainFrameRendererProcessCrashed                     "PrimaryMainFrameRendererProcessCrashed"
+  - PrerenderFinalStatus.PrimaryMainFrameRendererProcessKilled                      "PrimaryMainFrameRendererProcessKilled"
+  - PrerenderFinalStatus.ActivationFramePolicyNotCompatible                         "ActivationFramePolicyNotCompatible"
+  - PrerenderFinalStatus.PreloadingDisabled                                         "PreloadingDisabled"
+  - PrerenderFinalStatus.BatterySaverEnabled                                        "BatterySaverEnabled"
+  - PrerenderFinalStatus.ActivatedDuringMainFrameNavigation                         "ActivatedDuringMainFrameNavigation"
+  - PrerenderFinalStatus.PreloadingUnsupportedByWebContents                         "PreloadingUnsupportedByWebContents"
+  - PrerenderFinalStatus.CrossSiteRedirectInMainFrameNavigation                     "CrossSiteRedirectInMainFrameNavigation"
+  - PrerenderFinalStatus.CrossSiteNavigationInMainFrameNavigation                   "CrossSiteNavigationInMainFrameNavigation"
+  - PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+  - PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+  - PrerenderFinalStatus.MemoryPressureOnTrigger                                    "MemoryPressureOnTrigger"
+  - PrerenderFinalStatus.MemoryPressureAfterTriggered                               "MemoryPressureAfterTriggered"
+  - PrerenderFinalStatus.PrerenderingDisabledByDevTools                             "PrerenderingDisabledByDevTools"
+  - PrerenderFinalStatus.SpeculationRuleRemoved                                     "SpeculationRuleRemoved"
+  - PrerenderFinalStatus.ActivatedWithAuxiliaryBrowsingContexts                     "ActivatedWithAuxiliaryBrowsingContexts"
+  - PrerenderFinalStatus.MaxNumOfRunningEagerPrerendersExceeded                     "MaxNumOfRunningEagerPrerendersExceeded"
+  - PrerenderFinalStatus.MaxNumOfRunningNonEagerPrerendersExceeded                  "MaxNumOfRunningNonEagerPrerendersExceeded"
+  - PrerenderFinalStatus.MaxNumOfRunningEmbedderPrerendersExceeded                  "MaxNumOfRunningEmbedderPrerendersExceeded"
+  - PrerenderFinalStatus.PrerenderingURLHasEffectiveURL                             "PrerenderingUrlHasEffectiveUrl"
+  - PrerenderFinalStatus.RedirectedPrerenderingURLHasEffectiveURL                   "RedirectedPrerenderingUrlHasEffectiveUrl"
+  - PrerenderFinalStatus.ActivationURLHasEffectiveURL                               "ActivationUrlHasEffectiveUrl"
+  - PrerenderFinalStatus.JavaScriptInterfaceAdded                                   "JavaScriptInterfaceAdded"
+  - PrerenderFinalStatus.JavaScriptInterfaceRemoved                                 "JavaScriptInterfaceRemoved"
+  - PrerenderFinalStatus.AllPrerenderingCanceled                                    "AllPrerenderingCanceled"
+  - PrerenderFinalStatus.WindowClosed                                               "WindowClosed"
+  - PrerenderFinalStatus.SlowNetwork                                                "SlowNetwork"
+  - PrerenderFinalStatus.OtherPrerenderedPageActivated                              "OtherPrerenderedPageActivated"
+  - PrerenderFinalStatus.V8OptimizerDisabled                                        "V8OptimizerDisabled"
+  - PrerenderFinalStatus.PrerenderFailedDuringPrefetch                              "PrerenderFailedDuringPrefetch"
+  - PrerenderFinalStatus.BrowsingDataRemoved                                        "BrowsingDataRemoved"
+  - PrerenderFinalStatus.PrerenderHostReused                                        "PrerenderHostReused"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-PrerenderFinalStatus
+*/
+type PrerenderFinalStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum PrerenderFinalStatusEnum) String() string {
+	return _prerenderFinalStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum PrerenderFinalStatusEnum) Unknown() bool {
+	return !_prerenderFinalStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum PrerenderFinalStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *PrerenderFinalStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _prerenderFinalStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// prerenderFinalStatusActivated represents the "Activated" value.
+	prerenderFinalStatusActivated PrerenderFinalStatusEnum = iota + 1
+	// prerenderFinalStatusDestroyed represents the "Destroyed" value.
+	prerenderFinalStatusDestroyed
+	// prerenderFinalStatusLowEndDevice represents the "LowEndDevice" value.
+	prerenderFinalStatusLowEndDevice
+	// prerenderFinalStatusInvalidSchemeRedirect represents the "InvalidSchemeRedirect" value.
+	prerenderFinalStatusInvalidSchemeRedirect
+	// prerenderFinalStatusInvalidSchemeNavigation represents the "InvalidSchemeNavigation" value.
+	prerenderFinalStatusInvalidSchemeNavigation
+	// prerenderFinalStatusNavigationRequestBlockedByCsp represents the "NavigationRequestBlockedByCsp" value.
+	prerenderFinalStatusNavigationRequestBlockedByCsp
+	// prerenderFinalStatusMojoBinderPolicy represents the "MojoBinderPolicy" value.
+	prerenderFinalStatusMojoBinderPolicy
+	// prerenderFinalStatusRendererProcessCrashed represents the "RendererProcessCrashed" value.
+	prerenderFinalStatusRendererProcessCrashed
+	// prerenderFinalStatusRendererProcessKilled represents the "RendererProcessKilled" value.
+	prerenderFinalStatusRendererProcessKilled
+	// prerenderFinalStatusDownload represents the "Download" value.
+	prerenderFinalStatusDownload
+	// prerenderFinalStatusTriggerDestroyed represents the "TriggerDestroyed" value.
+	prerenderFinalStatusTriggerDestroyed
+	// prerenderFinalStatusNavigationNotCommitted represents the "NavigationNotCommitted" value.
+	prerenderFinalStatusNavigationNotCommitted
+	// prerenderFinalStatusNavigationBadHTTPStatus represents the "NavigationBadHttpStatus" value.
+	prerenderFinalStatusNavigationBadHTTPStatus
+	// prerenderFinalStatusClientCertRequested represents the "ClientCertRequested" value.
+	prerenderFinalStatusClientCertRequested
+	// prerenderFinalStatusNavigationRequestNetworkError represents the "NavigationRequestNetworkError" value.
+	prerenderFinalStatusNavigationRequestNetworkError
+	// prerenderFinalStatusCancelAllHostsForTesting represents the "CancelAllHostsForTesting" value.
+	prerenderFinalStatusCancelAllHostsForTesting
+	// prerenderFinalStatusDidFailLoad represents the "DidFailLoad" value.
+	prerenderFinalStatusDidFailLoad
+	// prerenderFinalStatusStop represents the "Stop" value.
+	prerenderFinalStatusStop
+	// prerenderFinalStatusSSLCertificateError represents the "SslCertificateError" value.
+	prerenderFinalStatusSSLCertificateError
+	// prerenderFinalStatusLoginAuthRequested represents the "LoginAuthRequested" value.
+	prerenderFinalStatusLoginAuthRequested
+	// prerenderFinalStatusUaChangeRequiresReload represents the "UaChangeRequiresReload" value.
+	prerenderFinalStatusUaChangeRequiresReload
+	// prerenderFinalStatusBlockedByClient represents the "BlockedByClient" value.
+	prerenderFinalStatusBlockedByClient
+	// prerenderFinalStatusAudioOutputDeviceRequested represents the "AudioOutputDeviceRequested" value.
+	prerenderFinalStatusAudioOutputDeviceRequested
+	// prerenderFinalStatusMixedContent represents the "MixedContent" value.
+	prerenderFinalStatusMixedContent
+	// prerenderFinalStatusTriggerBackgrounded represents the "TriggerBackgrounded" value.
+	prerenderFinalStatusTriggerBackgrounded
+	// prerenderFinalStatusMemoryLimitExceeded represents the "MemoryLimitExceeded" value.
+	prerenderFinalStatusMemoryLimitExceeded
+	// prerenderFinalStatusDataSaverEnabled represents the "DataSaverEnabled" value.
+	prerenderFinalStatusDataSaverEnabled
+	// prerenderFinalStatusTriggerURLHasEffectiveURL represents the "TriggerUrlHasEffectiveUrl" value.
+	prerenderFinalStatusTriggerURLHasEffectiveURL
+	// prerenderFinalStatusActivatedBeforeStarted represents the "ActivatedBeforeStarted" value.
+	prerenderFinalStatusActivatedBeforeStarted
+	// prerenderFinalStatusInactivePageRestriction represents the "InactivePageRestriction" value.
+	prerenderFinalStatusInactivePageRestriction
+	// prerenderFinalStatusStartFailed represents the "StartFailed" value.
+	prerenderFinalStatusStartFailed
+	// prerenderFinalStatusTimeoutBackgrounded represents the "TimeoutBackgrounded" value.
+	prerenderFinalStatusTimeoutBackgrounded
+	// prerenderFinalStatusCrossSiteRedirectInInitialNavigation represents the "CrossSiteRedirectInInitialNavigation" value.
+	prerenderFinalStatusCrossSiteRedirectInInitialNavigation
+	// prerenderFinalStatusCrossSiteNavigationInInitialNavigation represents the "CrossSiteNavigationInInitialNavigation" value.
+	prerenderFinalStatusCrossSiteNavigationInInitialNavigation
+	// prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation represents the "SameSiteCrossOriginRedirectNotOptInInInitialNavigation" value.
+	prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation
+	// prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation represents the "SameSiteCrossOriginNavigationNotOptInInInitialNavigation" value.
+	prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation
+	// prerenderFinalStatusActivationNavigationParameterMismatch represents the "ActivationNavigationParameterMismatch" value.
+	prerenderFinalStatusActivationNavigationParameterMismatch
+	// prerenderFinalStatusActivatedInBackground represents the "ActivatedInBackground" value.
+	prerenderFinalStatusActivatedInBackground
+	// prerenderFinalStatusEmbedderHostDisallowed represents the "EmbedderHostDisallowed" value.
+	prerenderFinalStatusEmbedderHostDisallowed
+	// prerenderFinalStatusActivationNavigationDestroyedBeforeSuccess represents the "ActivationNavigationDestroyedBeforeSuccess" value.
+	prerenderFinalStatusActivationNavigationDestroyedBeforeSuccess
+	// prerenderFinalStatusTabClosedByUserGesture represents the "TabClosedByUserGesture" value.
+	prerenderFinalStatusTabClosedByUserGesture
+	// prerenderFinalStatusTabClosedWithoutUserGesture represents the "TabClosedWithoutUserGesture" value.
+	prerenderFinalStatusTabClosedWithoutUserGesture
+	// prerenderFinalStatusPrimaryMainFrameRendererProcessCrashed represents the "PrimaryMainFrameRendererProcessCrashed" value.
+	prerenderFinalStatusPrimaryMainFrameRendererProcessCrashed
+	// prerenderFinalStatusPrimaryMainFrameRendererProcessKilled represents the "PrimaryMainFrameRendererProcessKilled" value.
+	prerenderFinalStatusPrimaryMainFrameRendererProcessKilled
+	// prerenderFinalStatusActivationFramePolicyNotCompatible represents the "ActivationFramePolicyNotCompatible" value.
+	prerenderFinalStatusActivationFramePolicyNotCompatible
+	// prerenderFinalStatusPreloadingDisabled represents the "PreloadingDisabled" value.
+	prerenderFinalStatusPreloadingDisabled
+	// prerenderFinalStatusBatterySaverEnabled represents the "BatterySaverEnabled" value.
+	prerenderFinalStatusBatterySaverEnabled
+	// prerenderFinalStatusActivatedDuringMainFrameNavigation represents the "ActivatedDuringMainFrameNavigation" value.
+	prerenderFinalStatusActivatedDuringMainFrameNavigation
+	// prerenderFinalStatusPreloadingUnsupportedByWebContents represents the "PreloadingUnsupportedByWebContents" value.
+	prerenderFinalStatusPreloadingUnsupportedByWebContents
+	// prerenderFinalStatusCrossSiteRedirectInMainFrameNavigation represents the "CrossSiteRedirectInMainFrameNavigation" value.
+	prerenderFinalStatusCrossSiteRedirectInMainFrameNavigation
+	// prerenderFinalStatusCrossSiteNavigationInMainFrameNavigation represents the "CrossSiteNavigationInMainFrameNavigation" value.
+	prerenderFinalStatusCrossSiteNavigationInMainFrameNavigation
+	// prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation represents the "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation" value.
+	prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation
+	// prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation represents the "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation" value.
+	prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation
+	// prerenderFinalStatusMemoryPressureOnTrigger represents the "MemoryPressureOnTrigger" value.
+	prerenderFinalStatusMemoryPressureOnTrigger
+	// prerenderFinalStatusMemoryPressureAfterTriggered represents the "MemoryPressureAfterTriggered" value.
+	prerenderFinalStatusMemoryPressureAfterTriggered
+	// prerenderFinalStatusPrerenderingDisabledByDevTools represents the "PrerenderingDisabledByDevTools" value.
+	prerenderFinalStatusPrerenderingDisabledByDevTools
+	// prerenderFinalStatusSpeculationRuleRemoved represents the "SpeculationRuleRemoved" value.
+	prerenderFinalStatusSpeculationRuleRemoved
+	// prerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts represents the "ActivatedWithAuxiliaryBrowsingContexts" value.
+	prerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts
+	// prerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded represents the "MaxNumOfRunningEagerPrerendersExceeded" value.
+	prerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded
+	// prerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded represents the "MaxNumOfRunningNonEagerPrerendersExceeded" value.
+	prerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded
+	// prerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded represents the "MaxNumOfRunningEmbedderPrerendersExceeded" value.
+	prerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded
+	// prerenderFinalStatusPrerenderingURLHasEffectiveURL represents the "PrerenderingUrlHasEffectiveUrl" value.
+	prerenderFinalStatusPrerenderingURLHasEffectiveURL
+	// prerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL represents the "RedirectedPrerenderingUrlHasEffectiveUrl" value.
+	prerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL
+	// prerenderFinalStatusActivationURLHasEffectiveURL represents the "ActivationUrlHasEffectiveUrl" value.
+	prerenderFinalStatusActivationURLHasEffectiveURL
+	// prerenderFinalStatusJavaScriptInterfaceAdded represents the "JavaScriptInterfaceAdded" value.
+	prerenderFinalStatusJavaScriptInterfaceAdded
+	// prerenderFinalStatusJavaScriptInterfaceRemoved represents the "JavaScriptInterfaceRemoved" value.
+	prerenderFinalStatusJavaScriptInterfaceRemoved
+	// prerenderFinalStatusAllPrerenderingCanceled represents the "AllPrerenderingCanceled" value.
+	prerenderFinalStatusAllPrerenderingCanceled
+	// prerenderFinalStatusWindowClosed represents the "WindowClosed" value.
+	prerenderFinalStatusWindowClosed
+	// prerenderFinalStatusSlowNetwork represents the "SlowNetwork" value.
+	prerenderFinalStatusSlowNetwork
+	// prerenderFinalStatusOtherPrerenderedPageActivated represents the "OtherPrerenderedPageActivated" value.
+	prerenderFinalStatusOtherPrerenderedPageActivated
+	// prerenderFinalStatusV8OptimizerDisabled represents the "V8OptimizerDisabled" value.
+	prerenderFinalStatusV8OptimizerDisabled
+	// prerenderFinalStatusPrerenderFailedDuringPrefetch represents the "PrerenderFailedDuringPrefetch" value.
+	prerenderFinalStatusPrerenderFailedDuringPrefetch
+	// prerenderFinalStatusBrowsingDataRemoved represents the "BrowsingDataRemoved" value.
+	prerenderFinalStatusBrowsingDataRemoved
+	// prerenderFinalStatusPrerenderHostReused represents the "PrerenderHostReused" value.
+	prerenderFinalStatusPrerenderHostReused
+)
+
+var _prerenderFinalStatusEnums = enums.New(map[PrerenderFinalStatusEnum]string{
+	PrerenderFinalStatusEnum(0):                                                    "",
+	prerenderFinalStatusActivated:                                                  "Activated",
+	prerenderFinalStatusDestroyed:                                                  "Destroyed",
+	prerenderFinalStatusLowEndDevice:                                               "LowEndDevice",
+	prerenderFinalStatusInvalidSchemeRedirect:                                      "InvalidSchemeRedirect",
+	prerenderFinalStatusInvalidSchemeNavigation:                                    "InvalidSchemeNavigation",
+	prerenderFinalStatusNavigationRequestBlockedByCsp:                              "NavigationRequestBlockedByCsp",
+	prerenderFinalStatusMojoBinderPolicy:                                           "MojoBinderPolicy",
+	prerenderFinalStatusRendererProcessCrashed:                                     "RendererProcessCrashed",
+	prerenderFinalStatusRendererProcessKilled:                                      "RendererProcessKilled",
+	prerenderFinalStatusDownload:                                                   "Download",
+	prerenderFinalStatusTriggerDestroyed:                                           "TriggerDestroyed",
+	prerenderFinalStatusNavigationNotCommitted:                                     "NavigationNotCommitted",
+	prerenderFinalStatusNavigationBadHTTPStatus:                                    "NavigationBadHttpStatus",
+	prerenderFinalStatusClientCertRequested:                                        "ClientCertRequested",
+	prerenderFinalStatusNavigationRequestNetworkError:                              "NavigationRequestNetworkError",
+	prerenderFinalStatusCancelAllHostsForTesting:                                   "CancelAllHostsForTesting",
+	prerenderFinalStatusDidFailLoad:                                                "DidFailLoad",
+	prerenderFinalStatusStop:                                                       "Stop",
+	prerenderFinalStatusSSLCertificateError:                                        "SslCertificateError",
+	prerenderFinalStatusLoginAuthRequested:                                         "LoginAuthRequested",
+	prerenderFinalStatusUaChangeRequiresReload:                                     "UaChangeRequiresReload",
+	prerenderFinalStatusBlockedByClient:                                            "BlockedByClient",
+	prerenderFinalStatusAudioOutputDeviceRequested:                                 "AudioOutputDeviceRequested",
+	prerenderFinalStatusMixedContent:                                               "MixedContent",
+	prerenderFinalStatusTriggerBackgrounded:                                        "TriggerBackgrounded",
+	prerenderFinalStatusMemoryLimitExceeded:                                        "MemoryLimitExceeded",
+	prerenderFinalStatusDataSaverEnabled:                                           "DataSaverEnabled",
+	prerenderFinalStatusTriggerURLHasEffectiveURL:                                  "TriggerUrlHasEffectiveUrl",
+	prerenderFinalStatusActivatedBeforeStarted:                                     "ActivatedBeforeStarted",
+	prerenderFinalStatusInactivePageRestriction:                                    "InactivePageRestriction",
+	prerenderFinalStatusStartFailed:                                                "StartFailed",
+	prerenderFinalStatusTimeoutBackgrounded:                                        "TimeoutBackgrounded",
+	prerenderFinalStatusCrossSiteRedirectInInitialNavigation:                       "CrossSiteRedirectInInitialNavigation",
+	prerenderFinalStatusCrossSiteNavigationInInitialNavigation:                     "CrossSiteNavigationInInitialNavigation",
+	prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation:     "SameSiteCrossOriginRedirectNotOptInInInitialNavigation",
+	prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation:   "SameSiteCrossOriginNavigationNotOptInInInitialNavigation",
+	prerenderFinalStatusActivationNavigationParameterMismatch:                      "ActivationNavigationParameterMismatch",
+	prerenderFinalStatusActivatedInBackground:                                      "ActivatedInBackground",
+	prerenderFinalStatusEmbedderHostDisallowed:                                     "EmbedderHostDisallowed",
+	prerenderFinalStatusActivationNavigationDestroyedBeforeSuccess:                 "ActivationNavigationDestroyedBeforeSuccess",
+	prerenderFinalStatusTabClosedByUserGesture:                                     "TabClosedByUserGesture",
+	prerenderFinalStatusTabClosedWithoutUserGesture:                                "TabClosedWithoutUserGesture",
+	prerenderFinalStatusPrimaryMainFrameRendererProcessCrashed:                     "PrimaryMainFrameRendererProcessCrashed",
+	prerenderFinalStatusPrimaryMainFrameRendererProcessKilled:                      "PrimaryMainFrameRendererProcessKilled",
+	prerenderFinalStatusActivationFramePolicyNotCompatible:                         "ActivationFramePolicyNotCompatible",
+	prerenderFinalStatusPreloadingDisabled:                                         "PreloadingDisabled",
+	prerenderFinalStatusBatterySaverEnabled:                                        "BatterySaverEnabled",
+	prerenderFinalStatusActivatedDuringMainFrameNavigation:                         "ActivatedDuringMainFrameNavigation",
+	prerenderFinalStatusPreloadingUnsupportedByWebContents:                         "PreloadingUnsupportedByWebContents",
+	prerenderFinalStatusCrossSiteRedirectInMainFrameNavigation:                     "CrossSiteRedirectInMainFrameNavigation",
+	prerenderFinalStatusCrossSiteNavigationInMainFrameNavigation:                   "CrossSiteNavigationInMainFrameNavigation",
+	prerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation:   "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation",
+	prerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation: "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation",
+	prerenderFinalStatusMemoryPressureOnTrigger:                                    "MemoryPressureOnTrigger",
+	prerenderFinalStatusMemoryPressureAfterTriggered:                               "MemoryPressureAfterTriggered",
+	prerenderFinalStatusPrerenderingDisabledByDevTools:                             "PrerenderingDisabledByDevTools",
+	prerenderFinalStatusSpeculationRuleRemoved:                                     "SpeculationRuleRemoved",
+	prerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts:                     "ActivatedWithAuxiliaryBrowsingContexts",
+	prerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded:                     "MaxNumOfRunningEagerPrerendersExceeded",
+	prerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded:                  "MaxNumOfRunningNonEagerPrerendersExceeded",
+	prerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded:                  "MaxNumOfRunningEmbedderPrerendersExceeded",
+	prerenderFinalStatusPrerenderingURLHasEffectiveURL:                             "PrerenderingUrlHasEffectiveUrl",
+	prerenderFinalStatusRedirectedPrerenderingURLHasEffectiveURL:                   "RedirectedPrerenderingUrlHasEffectiveUrl",
+	prerenderFinalStatusActivationURLHasEffectiveURL:                               "ActivationUrlHasEffectiveUrl",
+	prerenderFinalStatusJavaScriptInterfaceAdded:                                   "JavaScriptInterfaceAdded",
+	prerenderFinalStatusJavaScriptInterfaceRemoved:                                 "JavaScriptInterfaceRemoved",
+	prerenderFinalStatusAllPrerenderingCanceled:                                    "AllPrerenderingCanceled",
+	prerenderFinalStatusWindowClosed:                                               "WindowClosed",
+	prerenderFinalStatusSlowNetwork:                                                "SlowNetwork",
+	prerenderFinalStatusOtherPrerenderedPageActivated:                              "OtherPrerenderedPageActivated",
+	prerenderFinalStatusV8OptimizerDisabled:                                        "V8OptimizerDisabled",
+	prerenderFinalStatusPrerenderFailedDuringPrefetch:                              "PrerenderFailedDuringPrefetch",
+	prerenderFinalStatusBrowsingDataRemoved:                                        "BrowsingDataRemoved",
+	prerenderFinalStatusPrerenderHostReused:                                        "PrerenderHostReused",
+})
@@ -0,0 +1,1005 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumPrerenderFinalStatus(t *testing.T) {
+	var enum PrerenderFinalStatusEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown PrerenderFinalStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = PrerenderFinalStatus.Activated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Activated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Activated"`, result)
+	}
+	json.Unmarshal([]byte(`"Activated"`), &enum)
+	if PrerenderFinalStatus.Activated != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.Activated, enum)
+	}
+
+	enum = PrerenderFinalStatus.Destroyed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Destroyed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Destroyed"`, result)
+	}
+	json.Unmarshal([]byte(`"Destroyed"`), &enum)
+	if PrerenderFinalStatus.Destroyed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.Destroyed, enum)
+	}
+
+	enum = PrerenderFinalStatus.LowEndDevice
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LowEndDevice"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LowEndDevice"`, result)
+	}
+	json.Unmarshal([]byte(`"LowEndDevice"`), &enum)
+	if PrerenderFinalStatus.LowEndDevice != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.LowEndDevice, enum)
+	}
+
+	enum = PrerenderFinalStatus.InvalidSchemeRedirect
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSchemeRedirect"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSchemeRedirect"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSchemeRedirect"`), &enum)
+	if PrerenderFinalStatus.InvalidSchemeRedirect != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.InvalidSchemeRedirect, enum)
+	}
+
+	enum = PrerenderFinalStatus.InvalidSchemeNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidSchemeNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidSchemeNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidSchemeNavigation"`), &enum)
+	if PrerenderFinalStatus.InvalidSchemeNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.InvalidSchemeNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.NavigationRequestBlockedByCsp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigationRequestBlockedByCsp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigationRequestBlockedByCsp"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigationRequestBlockedByCsp"`), &enum)
+	if PrerenderFinalStatus.NavigationRequestBlockedByCsp != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.NavigationRequestBlockedByCsp, enum)
+	}
+
+	enum = PrerenderFinalStatus.MojoBinderPolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MojoBinderPolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MojoBinderPolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"MojoBinderPolicy"`), &enum)
+	if PrerenderFinalStatus.MojoBinderPolicy != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MojoBinderPolicy, enum)
+	}
+
+	enum = PrerenderFinalStatus.RendererProcessCrashed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RendererProcessCrashed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RendererProcessCrashed"`, result)
+	}
+	json.Unmarshal([]byte(`"RendererProcessCrashed"`), &enum)
+	if PrerenderFinalStatus.RendererProcessCrashed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.RendererProcessCrashed, enum)
+	}
+
+	enum = PrerenderFinalStatus.RendererProcessKilled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RendererProcessKilled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RendererProcessKilled"`, result)
+	}
+	json.Unmarshal([]byte(`"RendererProcessKilled"`), &enum)
+	if PrerenderFinalStatus.RendererProcessKilled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.RendererProcessKilled, enum)
+	}
+
+	enum = PrerenderFinalStatus.Download
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Download"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Download"`, result)
+	}
+	json.Unmarshal([]byte(`"Download"`), &enum)
+	if PrerenderFinalStatus.Download != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.Download, enum)
+	}
+
+	enum = PrerenderFinalStatus.TriggerDestroyed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TriggerDestroyed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TriggerDestroyed"`, result)
+	}
+	json.Unmarshal([]byte(`"TriggerDestroyed"`), &enum)
+	if PrerenderFinalStatus.TriggerDestroyed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TriggerDestroyed, enum)
+	}
+
+	enum = PrerenderFinalStatus.NavigationNotCommitted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigationNotCommitted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigationNotCommitted"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigationNotCommitted"`), &enum)
+	if PrerenderFinalStatus.NavigationNotCommitted != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.NavigationNotCommitted, enum)
+	}
+
+	enum = PrerenderFinalStatus.NavigationBadHTTPStatus
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigationBadHttpStatus"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigationBadHttpStatus"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigationBadHttpStatus"`), &enum)
+	if PrerenderFinalStatus.NavigationBadHTTPStatus != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.NavigationBadHTTPStatus, enum)
+	}
+
+	enum = PrerenderFinalStatus.ClientCertRequested
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ClientCertRequested"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ClientCertRequested"`, result)
+	}
+	json.Unmarshal([]byte(`"ClientCertRequested"`), &enum)
+	if PrerenderFinalStatus.ClientCertRequested != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ClientCertRequested, enum)
+	}
+
+	enum = PrerenderFinalStatus.NavigationRequestNetworkError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"NavigationRequestNetworkError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"NavigationRequestNetworkError"`, result)
+	}
+	json.Unmarshal([]byte(`"NavigationRequestNetworkError"`), &enum)
+	if PrerenderFinalStatus.NavigationRequestNetworkError != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.NavigationRequestNetworkError, enum)
+	}
+
+	enum = PrerenderFinalStatus.CancelAllHostsForTesting
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CancelAllHostsForTesting"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CancelAllHostsForTesting"`, result)
+	}
+	json.Unmarshal([]byte(`"CancelAllHostsForTesting"`), &enum)
+	if PrerenderFinalStatus.CancelAllHostsForTesting != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.CancelAllHostsForTesting, enum)
+	}
+
+	enum = PrerenderFinalStatus.DidFailLoad
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DidFailLoad"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DidFailLoad"`, result)
+	}
+	json.Unmarshal([]byte(`"DidFailLoad"`), &enum)
+	if PrerenderFinalStatus.DidFailLoad != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.DidFailLoad, enum)
+	}
+
+	enum = PrerenderFinalStatus.Stop
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Stop"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Stop"`, result)
+	}
+	json.Unmarshal([]byte(`"Stop"`), &enum)
+	if PrerenderFinalStatus.Stop != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.Stop, enum)
+	}
+
+	enum = PrerenderFinalStatus.SSLCertificateError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SslCertificateError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SslCertificateError"`, result)
+	}
+	json.Unmarshal([]byte(`"SslCertificateError"`), &enum)
+	if PrerenderFinalStatus.SSLCertificateError != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SSLCertificateError, enum)
+	}
+
+	enum = PrerenderFinalStatus.LoginAuthRequested
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"LoginAuthRequested"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"LoginAuthRequested"`, result)
+	}
+	json.Unmarshal([]byte(`"LoginAuthRequested"`), &enum)
+	if PrerenderFinalStatus.LoginAuthRequested != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.LoginAuthRequested, enum)
+	}
+
+	enum = PrerenderFinalStatus.UaChangeRequiresReload
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"UaChangeRequiresReload"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"UaChangeRequiresReload"`, result)
+	}
+	json.Unmarshal([]byte(`"UaChangeRequiresReload"`), &enum)
+	if PrerenderFinalStatus.UaChangeRequiresReload != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.UaChangeRequiresReload, enum)
+	}
+
+	enum = PrerenderFinalStatus.BlockedByClient
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BlockedByClient"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BlockedByClient"`, result)
+	}
+	json.Unmarshal([]byte(`"BlockedByClient"`), &enum)
+	if PrerenderFinalStatus.BlockedByClient != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.BlockedByClient, enum)
+	}
+
+	enum = PrerenderFinalStatus.AudioOutputDeviceRequested
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AudioOutputDeviceRequested"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AudioOutputDeviceRequested"`, result)
+	}
+	json.Unmarshal([]byte(`"AudioOutputDeviceRequested"`), &enum)
+	if PrerenderFinalStatus.AudioOutputDeviceRequested != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.AudioOutputDeviceRequested, enum)
+	}
+
+	enum = PrerenderFinalStatus.MixedContent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MixedContent"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MixedContent"`, result)
+	}
+	json.Unmarshal([]byte(`"MixedContent"`), &enum)
+	if PrerenderFinalStatus.MixedContent != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MixedContent, enum)
+	}
+
+	enum = PrerenderFinalStatus.TriggerBackgrounded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TriggerBackgrounded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TriggerBackgrounded"`, result)
+	}
+	json.Unmarshal([]byte(`"TriggerBackgrounded"`), &enum)
+	if PrerenderFinalStatus.TriggerBackgrounded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TriggerBackgrounded, enum)
+	}
+
+	enum = PrerenderFinalStatus.MemoryLimitExceeded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MemoryLimitExceeded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MemoryLimitExceeded"`, result)
+	}
+	json.Unmarshal([]byte(`"MemoryLimitExceeded"`), &enum)
+	if PrerenderFinalStatus.MemoryLimitExceeded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MemoryLimitExceeded, enum)
+	}
+
+	enum = PrerenderFinalStatus.DataSaverEnabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"DataSaverEnabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"DataSaverEnabled"`, result)
+	}
+	json.Unmarshal([]byte(`"DataSaverEnabled"`), &enum)
+	if PrerenderFinalStatus.DataSaverEnabled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.DataSaverEnabled, enum)
+	}
+
+	enum = PrerenderFinalStatus.TriggerURLHasEffectiveURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TriggerUrlHasEffectiveUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TriggerUrlHasEffectiveUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"TriggerUrlHasEffectiveUrl"`), &enum)
+	if PrerenderFinalStatus.TriggerURLHasEffectiveURL != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TriggerURLHasEffectiveURL, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivatedBeforeStarted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivatedBeforeStarted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivatedBeforeStarted"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivatedBeforeStarted"`), &enum)
+	if PrerenderFinalStatus.ActivatedBeforeStarted != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivatedBeforeStarted, enum)
+	}
+
+	enum = PrerenderFinalStatus.InactivePageRestriction
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InactivePageRestriction"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InactivePageRestriction"`, result)
+	}
+	json.Unmarshal([]byte(`"InactivePageRestriction"`), &enum)
+	if PrerenderFinalStatus.InactivePageRestriction != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.InactivePageRestriction, enum)
+	}
+
+	enum = PrerenderFinalStatus.StartFailed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"StartFailed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"StartFailed"`, result)
+	}
+	json.Unmarshal([]byte(`"StartFailed"`), &enum)
+	if PrerenderFinalStatus.StartFailed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.StartFailed, enum)
+	}
+
+	enum = PrerenderFinalStatus.TimeoutBackgrounded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TimeoutBackgrounded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TimeoutBackgrounded"`, result)
+	}
+	json.Unmarshal([]byte(`"TimeoutBackgrounded"`), &enum)
+	if PrerenderFinalStatus.TimeoutBackgrounded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TimeoutBackgrounded, enum)
+	}
+
+	enum = PrerenderFinalStatus.CrossSiteRedirectInInitialNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CrossSiteRedirectInInitialNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CrossSiteRedirectInInitialNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"CrossSiteRedirectInInitialNavigation"`), &enum)
+	if PrerenderFinalStatus.CrossSiteRedirectInInitialNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.CrossSiteRedirectInInitialNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.CrossSiteNavigationInInitialNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CrossSiteNavigationInInitialNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CrossSiteNavigationInInitialNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"CrossSiteNavigationInInitialNavigation"`), &enum)
+	if PrerenderFinalStatus.CrossSiteNavigationInInitialNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.CrossSiteNavigationInInitialNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInInitialNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteCrossOriginRedirectNotOptInInInitialNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteCrossOriginRedirectNotOptInInInitialNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteCrossOriginRedirectNotOptInInInitialNavigation"`), &enum)
+	if PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInInitialNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInInitialNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInInitialNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteCrossOriginNavigationNotOptInInInitialNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteCrossOriginNavigationNotOptInInInitialNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteCrossOriginNavigationNotOptInInInitialNavigation"`), &enum)
+	if PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInInitialNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInInitialNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivationNavigationParameterMismatch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivationNavigationParameterMismatch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivationNavigationParameterMismatch"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivationNavigationParameterMismatch"`), &enum)
+	if PrerenderFinalStatus.ActivationNavigationParameterMismatch != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivationNavigationParameterMismatch, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivatedInBackground
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivatedInBackground"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivatedInBackground"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivatedInBackground"`), &enum)
+	if PrerenderFinalStatus.ActivatedInBackground != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivatedInBackground, enum)
+	}
+
+	enum = PrerenderFinalStatus.EmbedderHostDisallowed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"EmbedderHostDisallowed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"EmbedderHostDisallowed"`, result)
+	}
+	json.Unmarshal([]byte(`"EmbedderHostDisallowed"`), &enum)
+	if PrerenderFinalStatus.EmbedderHostDisallowed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.EmbedderHostDisallowed, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivationNavigationDestroyedBeforeSuccess
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivationNavigationDestroyedBeforeSuccess"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivationNavigationDestroyedBeforeSuccess"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivationNavigationDestroyedBeforeSuccess"`), &enum)
+	if PrerenderFinalStatus.ActivationNavigationDestroyedBeforeSuccess != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivationNavigationDestroyedBeforeSuccess, enum)
+	}
+
+	enum = PrerenderFinalStatus.TabClosedByUserGesture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TabClosedByUserGesture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TabClosedByUserGesture"`, result)
+	}
+	json.Unmarshal([]byte(`"TabClosedByUserGesture"`), &enum)
+	if PrerenderFinalStatus.TabClosedByUserGesture != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TabClosedByUserGesture, enum)
+	}
+
+	enum = PrerenderFinalStatus.TabClosedWithoutUserGesture
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"TabClosedWithoutUserGesture"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"TabClosedWithoutUserGesture"`, result)
+	}
+	json.Unmarshal([]byte(`"TabClosedWithoutUserGesture"`), &enum)
+	if PrerenderFinalStatus.TabClosedWithoutUserGesture != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.TabClosedWithoutUserGesture, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrimaryMainFrameRendererProcessCrashed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrimaryMainFrameRendererProcessCrashed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrimaryMainFrameRendererProcessCrashed"`, result)
+	}
+	json.Unmarshal([]byte(`"PrimaryMainFrameRendererProcessCrashed"`), &enum)
+	if PrerenderFinalStatus.PrimaryMainFrameRendererProcessCrashed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrimaryMainFrameRendererProcessCrashed, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrimaryMainFrameRendererProcessKilled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrimaryMainFrameRendererProcessKilled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrimaryMainFrameRendererProcessKilled"`, result)
+	}
+	json.Unmarshal([]byte(`"PrimaryMainFrameRendererProcessKilled"`), &enum)
+	if PrerenderFinalStatus.PrimaryMainFrameRendererProcessKilled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrimaryMainFrameRendererProcessKilled, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivationFramePolicyNotCompatible
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivationFramePolicyNotCompatible"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivationFramePolicyNotCompatible"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivationFramePolicyNotCompatible"`), &enum)
+	if PrerenderFinalStatus.ActivationFramePolicyNotCompatible != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivationFramePolicyNotCompatible, enum)
+	}
+
+	enum = PrerenderFinalStatus.PreloadingDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreloadingDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreloadingDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"PreloadingDisabled"`), &enum)
+	if PrerenderFinalStatus.PreloadingDisabled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PreloadingDisabled, enum)
+	}
+
+	enum = PrerenderFinalStatus.BatterySaverEnabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BatterySaverEnabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BatterySaverEnabled"`, result)
+	}
+	json.Unmarshal([]byte(`"BatterySaverEnabled"`), &enum)
+	if PrerenderFinalStatus.BatterySaverEnabled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.BatterySaverEnabled, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivatedDuringMainFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivatedDuringMainFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivatedDuringMainFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivatedDuringMainFrameNavigation"`), &enum)
+	if PrerenderFinalStatus.ActivatedDuringMainFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivatedDuringMainFrameNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.PreloadingUnsupportedByWebContents
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PreloadingUnsupportedByWebContents"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PreloadingUnsupportedByWebContents"`, result)
+	}
+	json.Unmarshal([]byte(`"PreloadingUnsupportedByWebContents"`), &enum)
+	if PrerenderFinalStatus.PreloadingUnsupportedByWebContents != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PreloadingUnsupportedByWebContents, enum)
+	}
+
+	enum = PrerenderFinalStatus.CrossSiteRedirectInMainFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CrossSiteRedirectInMainFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CrossSiteRedirectInMainFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"CrossSiteRedirectInMainFrameNavigation"`), &enum)
+	if PrerenderFinalStatus.CrossSiteRedirectInMainFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.CrossSiteRedirectInMainFrameNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.CrossSiteNavigationInMainFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"CrossSiteNavigationInMainFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"CrossSiteNavigationInMainFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"CrossSiteNavigationInMainFrameNavigation"`), &enum)
+	if PrerenderFinalStatus.CrossSiteNavigationInMainFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.CrossSiteNavigationInMainFrameNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"`), &enum)
+	if PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"`, result)
+	}
+	json.Unmarshal([]byte(`"SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"`), &enum)
+	if PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation, enum)
+	}
+
+	enum = PrerenderFinalStatus.MemoryPressureOnTrigger
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MemoryPressureOnTrigger"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MemoryPressureOnTrigger"`, result)
+	}
+	json.Unmarshal([]byte(`"MemoryPressureOnTrigger"`), &enum)
+	if PrerenderFinalStatus.MemoryPressureOnTrigger != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MemoryPressureOnTrigger, enum)
+	}
+
+	enum = PrerenderFinalStatus.MemoryPressureAfterTriggered
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MemoryPressureAfterTriggered"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MemoryPressureAfterTriggered"`, result)
+	}
+	json.Unmarshal([]byte(`"MemoryPressureAfterTriggered"`), &enum)
+	if PrerenderFinalStatus.MemoryPressureAfterTriggered != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MemoryPressureAfterTriggered, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrerenderingDisabledByDevTools
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrerenderingDisabledByDevTools"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrerenderingDisabledByDevTools"`, result)
+	}
+	json.Unmarshal([]byte(`"PrerenderingDisabledByDevTools"`), &enum)
+	if PrerenderFinalStatus.PrerenderingDisabledByDevTools != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrerenderingDisabledByDevTools, enum)
+	}
+
+	enum = PrerenderFinalStatus.SpeculationRuleRemoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SpeculationRuleRemoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SpeculationRuleRemoved"`, result)
+	}
+	json.Unmarshal([]byte(`"SpeculationRuleRemoved"`), &enum)
+	if PrerenderFinalStatus.SpeculationRuleRemoved != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SpeculationRuleRemoved, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivatedWithAuxiliaryBrowsingContexts
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivatedWithAuxiliaryBrowsingContexts"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivatedWithAuxiliaryBrowsingContexts"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivatedWithAuxiliaryBrowsingContexts"`), &enum)
+	if PrerenderFinalStatus.ActivatedWithAuxiliaryBrowsingContexts != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivatedWithAuxiliaryBrowsingContexts, enum)
+	}
+
+	enum = PrerenderFinalStatus.MaxNumOfRunningEagerPrerendersExceeded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MaxNumOfRunningEagerPrerendersExceeded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MaxNumOfRunningEagerPrerendersExceeded"`, result)
+	}
+	json.Unmarshal([]byte(`"MaxNumOfRunningEagerPrerendersExceeded"`), &enum)
+	if PrerenderFinalStatus.MaxNumOfRunningEagerPrerendersExceeded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MaxNumOfRunningEagerPrerendersExceeded, enum)
+	}
+
+	enum = PrerenderFinalStatus.MaxNumOfRunningNonEagerPrerendersExceeded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MaxNumOfRunningNonEagerPrerendersExceeded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MaxNumOfRunningNonEagerPrerendersExceeded"`, result)
+	}
+	json.Unmarshal([]byte(`"MaxNumOfRunningNonEagerPrerendersExceeded"`), &enum)
+	if PrerenderFinalStatus.MaxNumOfRunningNonEagerPrerendersExceeded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MaxNumOfRunningNonEagerPrerendersExceeded, enum)
+	}
+
+	enum = PrerenderFinalStatus.MaxNumOfRunningEmbedderPrerendersExceeded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"MaxNumOfRunningEmbedderPrerendersExceeded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"MaxNumOfRunningEmbedderPrerendersExceeded"`, result)
+	}
+	json.Unmarshal([]byte(`"MaxNumOfRunningEmbedderPrerendersExceeded"`), &enum)
+	if PrerenderFinalStatus.MaxNumOfRunningEmbedderPrerendersExceeded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.MaxNumOfRunningEmbedderPrerendersExceeded, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrerenderingURLHasEffectiveURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrerenderingUrlHasEffectiveUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrerenderingUrlHasEffectiveUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"PrerenderingUrlHasEffectiveUrl"`), &enum)
+	if PrerenderFinalStatus.PrerenderingURLHasEffectiveURL != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrerenderingURLHasEffectiveURL, enum)
+	}
+
+	enum = PrerenderFinalStatus.RedirectedPrerenderingURLHasEffectiveURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"RedirectedPrerenderingUrlHasEffectiveUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"RedirectedPrerenderingUrlHasEffectiveUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"RedirectedPrerenderingUrlHasEffectiveUrl"`), &enum)
+	if PrerenderFinalStatus.RedirectedPrerenderingURLHasEffectiveURL != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.RedirectedPrerenderingURLHasEffectiveURL, enum)
+	}
+
+	enum = PrerenderFinalStatus.ActivationURLHasEffectiveURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ActivationUrlHasEffectiveUrl"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ActivationUrlHasEffectiveUrl"`, result)
+	}
+	json.Unmarshal([]byte(`"ActivationUrlHasEffectiveUrl"`), &enum)
+	if PrerenderFinalStatus.ActivationURLHasEffectiveURL != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.ActivationURLHasEffectiveURL, enum)
+	}
+
+	enum = PrerenderFinalStatus.JavaScriptInterfaceAdded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JavaScriptInterfaceAdded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JavaScriptInterfaceAdded"`, result)
+	}
+	json.Unmarshal([]byte(`"JavaScriptInterfaceAdded"`), &enum)
+	if PrerenderFinalStatus.JavaScriptInterfaceAdded != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.JavaScriptInterfaceAdded, enum)
+	}
+
+	enum = PrerenderFinalStatus.JavaScriptInterfaceRemoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"JavaScriptInterfaceRemoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"JavaScriptInterfaceRemoved"`, result)
+	}
+	json.Unmarshal([]byte(`"JavaScriptInterfaceRemoved"`), &enum)
+	if PrerenderFinalStatus.JavaScriptInterfaceRemoved != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.JavaScriptInterfaceRemoved, enum)
+	}
+
+	enum = PrerenderFinalStatus.AllPrerenderingCanceled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"AllPrerenderingCanceled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"AllPrerenderingCanceled"`, result)
+	}
+	json.Unmarshal([]byte(`"AllPrerenderingCanceled"`), &enum)
+	if PrerenderFinalStatus.AllPrerenderingCanceled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.AllPrerenderingCanceled, enum)
+	}
+
+	enum = PrerenderFinalStatus.WindowClosed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"WindowClosed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"WindowClosed"`, result)
+	}
+	json.Unmarshal([]byte(`"WindowClosed"`), &enum)
+	if PrerenderFinalStatus.WindowClosed != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.WindowClosed, enum)
+	}
+
+	enum = PrerenderFinalStatus.SlowNetwork
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SlowNetwork"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SlowNetwork"`, result)
+	}
+	json.Unmarshal([]byte(`"SlowNetwork"`), &enum)
+	if PrerenderFinalStatus.SlowNetwork != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.SlowNetwork, enum)
+	}
+
+	enum = PrerenderFinalStatus.OtherPrerenderedPageActivated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"OtherPrerenderedPageActivated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"OtherPrerenderedPageActivated"`, result)
+	}
+	json.Unmarshal([]byte(`"OtherPrerenderedPageActivated"`), &enum)
+	if PrerenderFinalStatus.OtherPrerenderedPageActivated != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.OtherPrerenderedPageActivated, enum)
+	}
+
+	enum = PrerenderFinalStatus.V8OptimizerDisabled
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"V8OptimizerDisabled"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"V8OptimizerDisabled"`, result)
+	}
+	json.Unmarshal([]byte(`"V8OptimizerDisabled"`), &enum)
+	if PrerenderFinalStatus.V8OptimizerDisabled != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.V8OptimizerDisabled, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrerenderFailedDuringPrefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrerenderFailedDuringPrefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrerenderFailedDuringPrefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"PrerenderFailedDuringPrefetch"`), &enum)
+	if PrerenderFinalStatus.PrerenderFailedDuringPrefetch != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrerenderFailedDuringPrefetch, enum)
+	}
+
+	enum = PrerenderFinalStatus.BrowsingDataRemoved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"BrowsingDataRemoved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"BrowsingDataRemoved"`, result)
+	}
+	json.Unmarshal([]byte(`"BrowsingDataRemoved"`), &enum)
+	if PrerenderFinalStatus.BrowsingDataRemoved != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.BrowsingDataRemoved, enum)
+	}
+
+	enum = PrerenderFinalStatus.PrerenderHostReused
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"PrerenderHostReused"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"PrerenderHostReused"`, result)
+	}
+	json.Unmarshal([]byte(`"PrerenderHostReused"`), &enum)
+	if PrerenderFinalStatus.PrerenderHostReused != enum {
+		t.Errorf("Expected %d, got %d", PrerenderFinalStatus.PrerenderHostReused, enum)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type ruleSetErrorTypeEnum struct {
+	SourceIsNotJSONObject  RuleSetErrorTypeEnum
+	InvalidRulesSkipped    RuleSetErrorTypeEnum
+	InvalidRulesetLevelTag RuleSetErrorTypeEnum
+}
+
+/*
+RuleSetErrorType provides named access to the RuleSetErrorTypeEnum values.
+*/
+var RuleSetErrorType = ruleSetErrorTypeEnum{
+	SourceIsNotJSONObject:  ruleSetErrorTypeSourceIsNotJSONObject,
+	InvalidRulesSkipped:    ruleSetErrorTypeInvalidRulesSkipped,
+	InvalidRulesetLevelTag: ruleSetErrorTypeInvalidRulesetLevelTag,
+}
+
+/*
+RuleSetErrorTypeEnum represents the Preload.RuleSetErrorType values.
+
+Allowed values:
+  - RuleSetErrorType.SourceIsNotJSONObject  "SourceIsNotJsonObject"
+  - RuleSetErrorType.InvalidRulesSkipped    "InvalidRulesSkipped"
+  - RuleSetErrorType.InvalidRulesetLevelTag "InvalidRulesetLevelTag"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-RuleSetErrorType
+*/
+type RuleSetErrorTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum RuleSetErrorTypeEnum) String() string {
+	return _ruleSetErrorTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RuleSetErrorTypeEnum) Unknown() bool {
+	return !_ruleSetErrorTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum RuleSetErrorTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *RuleSetErrorTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _ruleSetErrorTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// ruleSetErrorTypeSourceIsNotJSONObject represents the "SourceIsNotJsonObject" value.
+	ruleSetErrorTypeSourceIsNotJSONObject RuleSetErrorTypeEnum = iota + 1
+	// ruleSetErrorTypeInvalidRulesSkipped represents the "InvalidRulesSkipped" value.
+	ruleSetErrorTypeInvalidRulesSkipped
+	// ruleSetErrorTypeInvalidRulesetLevelTag represents the "InvalidRulesetLevelTag" value.
+	ruleSetErrorTypeInvalidRulesetLevelTag
+)
+
+var _ruleSetErrorTypeEnums = enums.New(map[RuleSetErrorTypeEnum]string{
+	RuleSetErrorTypeEnum(0):                "",
+	ruleSetErrorTypeSourceIsNotJSONObject:  "SourceIsNotJsonObject",
+	ruleSetErrorTypeInvalidRulesSkipped:    "InvalidRulesSkipped",
+	ruleSetErrorTypeInvalidRulesetLevelTag: "InvalidRulesetLevelTag",
+})
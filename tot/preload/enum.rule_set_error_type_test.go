@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumRuleSetErrorType(t *testing.T) {
+	var enum RuleSetErrorTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown RuleSetErrorTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = RuleSetErrorType.SourceIsNotJSONObject
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"SourceIsNotJsonObject"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"SourceIsNotJsonObject"`, result)
+	}
+	json.Unmarshal([]byte(`"SourceIsNotJsonObject"`), &enum)
+	if RuleSetErrorType.SourceIsNotJSONObject != enum {
+		t.Errorf("Expected %d, got %d", RuleSetErrorType.SourceIsNotJSONObject, enum)
+	}
+
+	enum = RuleSetErrorType.InvalidRulesSkipped
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidRulesSkipped"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidRulesSkipped"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidRulesSkipped"`), &enum)
+	if RuleSetErrorType.InvalidRulesSkipped != enum {
+		t.Errorf("Expected %d, got %d", RuleSetErrorType.InvalidRulesSkipped, enum)
+	}
+
+	enum = RuleSetErrorType.InvalidRulesetLevelTag
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"InvalidRulesetLevelTag"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"InvalidRulesetLevelTag"`, result)
+	}
+	json.Unmarshal([]byte(`"InvalidRulesetLevelTag"`), &enum)
+	if RuleSetErrorType.InvalidRulesetLevelTag != enum {
+		t.Errorf("Expected %d, got %d", RuleSetErrorType.InvalidRulesetLevelTag, enum)
+	}
+}
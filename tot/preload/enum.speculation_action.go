@@ -0,0 +1,86 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type speculationActionEnum struct {
+	Prefetch  SpeculationActionEnum
+	Prerender SpeculationActionEnum
+}
+
+/*
+SpeculationAction provides named access to the SpeculationActionEnum values.
+*/
+var SpeculationAction = speculationActionEnum{
+	Prefetch:  speculationActionPrefetch,
+	Prerender: speculationActionPrerender,
+}
+
+/*
+SpeculationActionEnum represents the Preload.SpeculationAction values.
+
+The type of preloading attempted. It corresponds to mojom::SpeculationAction
+(although PrefetchWithSubresources is omitted as it isn't being used by
+clients).
+
+Allowed values:
+  - SpeculationAction.Prefetch  "Prefetch"
+  - SpeculationAction.Prerender "Prerender"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-SpeculationAction
+*/
+type SpeculationActionEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SpeculationActionEnum) String() string {
+	return _speculationActionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SpeculationActionEnum) Unknown() bool {
+	return !_speculationActionEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SpeculationActionEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SpeculationActionEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _speculationActionEnums.Parse(val)
+	return nil
+}
+
+const (
+	// speculationActionPrefetch represents the "Prefetch" value.
+	speculationActionPrefetch SpeculationActionEnum = iota + 1
+	// speculationActionPrerender represents the "Prerender" value.
+	speculationActionPrerender
+)
+
+var _speculationActionEnums = enums.New(map[SpeculationActionEnum]string{
+	SpeculationActionEnum(0):   "",
+	speculationActionPrefetch:  "Prefetch",
+	speculationActionPrerender: "Prerender",
+})
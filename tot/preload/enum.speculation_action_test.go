@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSpeculationAction(t *testing.T) {
+	var enum SpeculationActionEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SpeculationActionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SpeculationAction.Prefetch
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Prefetch"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Prefetch"`, result)
+	}
+	json.Unmarshal([]byte(`"Prefetch"`), &enum)
+	if SpeculationAction.Prefetch != enum {
+		t.Errorf("Expected %d, got %d", SpeculationAction.Prefetch, enum)
+	}
+
+	enum = SpeculationAction.Prerender
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Prerender"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Prerender"`, result)
+	}
+	json.Unmarshal([]byte(`"Prerender"`), &enum)
+	if SpeculationAction.Prerender != enum {
+		t.Errorf("Expected %d, got %d", SpeculationAction.Prerender, enum)
+	}
+}
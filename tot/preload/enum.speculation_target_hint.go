@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type speculationTargetHintEnum struct {
+	Blank SpeculationTargetHintEnum
+	Self  SpeculationTargetHintEnum
+}
+
+/*
+SpeculationTargetHint provides named access to the SpeculationTargetHintEnum values.
+*/
+var SpeculationTargetHint = speculationTargetHintEnum{
+	Blank: speculationTargetHintBlank,
+	Self:  speculationTargetHintSelf,
+}
+
+/*
+SpeculationTargetHintEnum represents the Preload.SpeculationTargetHint values.
+
+Corresponds to mojom::SpeculationTargetHint. See
+https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+
+Allowed values:
+  - SpeculationTargetHint.Blank "Blank"
+  - SpeculationTargetHint.Self  "Self"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#type-SpeculationTargetHint
+*/
+type SpeculationTargetHintEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SpeculationTargetHintEnum) String() string {
+	return _speculationTargetHintEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SpeculationTargetHintEnum) Unknown() bool {
+	return !_speculationTargetHintEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SpeculationTargetHintEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SpeculationTargetHintEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _speculationTargetHintEnums.Parse(val)
+	return nil
+}
+
+const (
+	// speculationTargetHintBlank represents the "Blank" value.
+	speculationTargetHintBlank SpeculationTargetHintEnum = iota + 1
+	// speculationTargetHintSelf represents the "Self" value.
+	speculationTargetHintSelf
+)
+
+var _speculationTargetHintEnums = enums.New(map[SpeculationTargetHintEnum]string{
+	SpeculationTargetHintEnum(0): "",
+	speculationTargetHintBlank:   "Blank",
+	speculationTargetHintSelf:    "Self",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSpeculationTargetHint(t *testing.T) {
+	var enum SpeculationTargetHintEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SpeculationTargetHintEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SpeculationTargetHint.Blank
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Blank"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Blank"`, result)
+	}
+	json.Unmarshal([]byte(`"Blank"`), &enum)
+	if SpeculationTargetHint.Blank != enum {
+		t.Errorf("Expected %d, got %d", SpeculationTargetHint.Blank, enum)
+	}
+
+	enum = SpeculationTargetHint.Self
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"Self"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"Self"`, result)
+	}
+	json.Unmarshal([]byte(`"Self"`), &enum)
+	if SpeculationTargetHint.Self != enum {
+		t.Errorf("Expected %d, got %d", SpeculationTargetHint.Self, enum)
+	}
+}
@@ -0,0 +1,241 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package preload
+
+import (
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+RuleSetUpdatedEvent represents Preload.ruleSetUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-ruleSetUpdated
+*/
+type RuleSetUpdatedEvent struct {
+	RuleSet *RuleSet `json:"ruleSet"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+RuleSetRemovedEvent represents Preload.ruleSetRemoved event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-ruleSetRemoved
+*/
+type RuleSetRemovedEvent struct {
+	ID RuleSetID `json:"id"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+EnabledStateUpdatedEvent represents Preload.preloadEnabledStateUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-preloadEnabledStateUpdated
+*/
+type EnabledStateUpdatedEvent struct {
+	DisabledByPreference bool `json:"disabledByPreference"`
+
+	DisabledByDataSaver bool `json:"disabledByDataSaver"`
+
+	DisabledByBatterySaver bool `json:"disabledByBatterySaver"`
+
+	DisabledByHoldbackPrefetchSpeculationRules bool `json:"disabledByHoldbackPrefetchSpeculationRules"`
+
+	DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PrefetchStatusUpdatedEvent represents Preload.prefetchStatusUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-prefetchStatusUpdated
+*/
+type PrefetchStatusUpdatedEvent struct {
+	Key *PreloadingAttemptKey `json:"key"`
+
+	PipelineID PipelineID `json:"pipelineId"`
+
+	// The frame id of the frame initiating prefetch.
+	InitiatingFrameID page.FrameID `json:"initiatingFrameId"`
+
+	PrefetchURL string `json:"prefetchUrl"`
+
+	// Allowed values:
+	//   - PreloadingStatus.Pending
+	//   - PreloadingStatus.Running
+	//   - PreloadingStatus.Ready
+	//   - PreloadingStatus.Success
+	//   - PreloadingStatus.Failure
+	//   - PreloadingStatus.NotSupported
+	Status PreloadingStatusEnum `json:"status"`
+
+	// Allowed values:
+	//   - PrefetchStatus.PrefetchAllowed
+	//   - PrefetchStatus.PrefetchFailedIneligibleRedirect
+	//   - PrefetchStatus.PrefetchFailedInvalidRedirect
+	//   - PrefetchStatus.PrefetchFailedMIMENotSupported
+	//   - PrefetchStatus.PrefetchFailedNetError
+	//   - PrefetchStatus.PrefetchFailedNon2XX
+	//   - PrefetchStatus.PrefetchEvictedAfterBrowsingDataRemoved
+	//   - PrefetchStatus.PrefetchEvictedAfterCandidateRemoved
+	//   - PrefetchStatus.PrefetchEvictedForNewerPrefetch
+	//   - PrefetchStatus.PrefetchHeldback
+	//   - PrefetchStatus.PrefetchIneligibleRetryAfter
+	//   - PrefetchStatus.PrefetchIsPrivacyDecoy
+	//   - PrefetchStatus.PrefetchIsStale
+	//   - PrefetchStatus.PrefetchNotEligibleBrowserContextOffTheRecord
+	//   - PrefetchStatus.PrefetchNotEligibleDataSaverEnabled
+	//   - PrefetchStatus.PrefetchNotEligibleExistingProxy
+	//   - PrefetchStatus.PrefetchNotEligibleHostIsNonUnique
+	//   - PrefetchStatus.PrefetchNotEligibleNonDefaultStoragePartition
+	//   - PrefetchStatus.PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy
+	//   - PrefetchStatus.PrefetchNotEligibleSchemeIsNotHTTPS
+	//   - PrefetchStatus.PrefetchNotEligibleUserHasCookies
+	//   - PrefetchStatus.PrefetchNotEligibleUserHasServiceWorker
+	//   - PrefetchStatus.PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler
+	//   - PrefetchStatus.PrefetchNotEligibleRedirectFromServiceWorker
+	//   - PrefetchStatus.PrefetchNotEligibleRedirectToServiceWorker
+	//   - PrefetchStatus.PrefetchNotEligibleBatterySaverEnabled
+	//   - PrefetchStatus.PrefetchNotEligiblePreloadingDisabled
+	//   - PrefetchStatus.PrefetchNotFinishedInTime
+	//   - PrefetchStatus.PrefetchNotStarted
+	//   - PrefetchStatus.PrefetchNotUsedCookiesChanged
+	//   - PrefetchStatus.PrefetchProxyNotAvailable
+	//   - PrefetchStatus.PrefetchResponseUsed
+	//   - PrefetchStatus.PrefetchSuccessfulButNotUsed
+	//   - PrefetchStatus.PrefetchNotUsedProbeFailed
+	PrefetchStatus PrefetchStatusEnum `json:"prefetchStatus"`
+
+	RequestID network.RequestID `json:"requestId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PrerenderStatusUpdatedEvent represents Preload.prerenderStatusUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-prerenderStatusUpdated
+*/
+type PrerenderStatusUpdatedEvent struct {
+	Key *PreloadingAttemptKey `json:"key"`
+
+	PipelineID PipelineID `json:"pipelineId"`
+
+	// Allowed values:
+	//   - PreloadingStatus.Pending
+	//   - PreloadingStatus.Running
+	//   - PreloadingStatus.Ready
+	//   - PreloadingStatus.Success
+	//   - PreloadingStatus.Failure
+	//   - PreloadingStatus.NotSupported
+	Status PreloadingStatusEnum `json:"status"`
+
+	// Optional. Allowed values:
+	//   - PrerenderFinalStatus.Activated
+	//   - PrerenderFinalStatus.Destroyed
+	//   - PrerenderFinalStatus.LowEndDevice
+	//   - PrerenderFinalStatus.InvalidSchemeRedirect
+	//   - PrerenderFinalStatus.InvalidSchemeNavigation
+	//   - PrerenderFinalStatus.NavigationRequestBlockedByCsp
+	//   - PrerenderFinalStatus.MojoBinderPolicy
+	//   - PrerenderFinalStatus.RendererProcessCrashed
+	//   - PrerenderFinalStatus.RendererProcessKilled
+	//   - PrerenderFinalStatus.Download
+	//   - PrerenderFinalStatus.TriggerDestroyed
+	//   - PrerenderFinalStatus.NavigationNotCommitted
+	//   - PrerenderFinalStatus.NavigationBadHTTPStatus
+	//   - PrerenderFinalStatus.ClientCertRequested
+	//   - PrerenderFinalStatus.NavigationRequestNetworkError
+	//   - PrerenderFinalStatus.CancelAllHostsForTesting
+	//   - PrerenderFinalStatus.DidFailLoad
+	//   - PrerenderFinalStatus.Stop
+	//   - PrerenderFinalStatus.SSLCertificateError
+	//   - PrerenderFinalStatus.LoginAuthRequested
+	//   - PrerenderFinalStatus.UaChangeRequiresReload
+	//   - PrerenderFinalStatus.BlockedByClient
+	//   - PrerenderFinalStatus.AudioOutputDeviceRequested
+	//   - PrerenderFinalStatus.MixedContent
+	//   - PrerenderFinalStatus.TriggerBackgrounded
+	//   - PrerenderFinalStatus.MemoryLimitExceeded
+	//   - PrerenderFinalStatus.DataSaverEnabled
+	//   - PrerenderFinalStatus.TriggerURLHasEffectiveURL
+	//   - PrerenderFinalStatus.ActivatedBeforeStarted
+	//   - PrerenderFinalStatus.InactivePageRestriction
+	//   - PrerenderFinalStatus.StartFailed
+	//   - PrerenderFinalStatus.TimeoutBackgrounded
+	//   - PrerenderFinalStatus.CrossSiteRedirectInInitialNavigation
+	//   - PrerenderFinalStatus.CrossSiteNavigationInInitialNavigation
+	//   - PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInInitialNavigation
+	//   - PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInInitialNavigation
+	//   - PrerenderFinalStatus.ActivationNavigationParameterMismatch
+	//   - PrerenderFinalStatus.ActivatedInBackground
+	//   - PrerenderFinalStatus.EmbedderHostDisallowed
+	//   - PrerenderFinalStatus.ActivationNavigationDestroyedBeforeSuccess
+	//   - PrerenderFinalStatus.TabClosedByUserGesture
+	//   - PrerenderFinalStatus.TabClosedWithoutUserGesture
+	//   - PrerenderFinalStatus.PrimaryMainFrameRendererProcessCrashed
+	//   - PrerenderFinalStatus.PrimaryMainFrameRendererProcessKilled
+	//   - PrerenderFinalStatus.ActivationFramePolicyNotCompatible
+	//   - PrerenderFinalStatus.PreloadingDisabled
+	//   - PrerenderFinalStatus.BatterySaverEnabled
+	//   - PrerenderFinalStatus.ActivatedDuringMainFrameNavigation
+	//   - PrerenderFinalStatus.PreloadingUnsupportedByWebContents
+	//   - PrerenderFinalStatus.CrossSiteRedirectInMainFrameNavigation
+	//   - PrerenderFinalStatus.CrossSiteNavigationInMainFrameNavigation
+	//   - PrerenderFinalStatus.SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation
+	//   - PrerenderFinalStatus.SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation
+	//   - PrerenderFinalStatus.MemoryPressureOnTrigger
+	//   - PrerenderFinalStatus.MemoryPressureAfterTriggered
+	//   - PrerenderFinalStatus.PrerenderingDisabledByDevTools
+	//   - PrerenderFinalStatus.SpeculationRuleRemoved
+	//   - PrerenderFinalStatus.ActivatedWithAuxiliaryBrowsingContexts
+	//   - PrerenderFinalStatus.MaxNumOfRunningEagerPrerendersExceeded
+	//   - PrerenderFinalStatus.MaxNumOfRunningNonEagerPrerendersExceeded
+	//   - PrerenderFinalStatus.MaxNumOfRunningEmbedderPrerendersExceeded
+	//   - PrerenderFinalStatus.PrerenderingURLHasEffectiveURL
+	//   - PrerenderFinalStatus.RedirectedPrerenderingURLHasEffectiveURL
+	//   - PrerenderFinalStatus.ActivationURLHasEffectiveURL
+	//   - PrerenderFinalStatus.JavaScriptInterfaceAdded
+	//   - PrerenderFinalStatus.JavaScriptInterfaceRemoved
+	//   - PrerenderFinalStatus.AllPrerenderingCanceled
+	//   - PrerenderFinalStatus.WindowClosed
+	//   - PrerenderFinalStatus.SlowNetwork
+	//   - PrerenderFinalStatus.OtherPrerenderedPageActivated
+	//   - PrerenderFinalStatus.V8OptimizerDisabled
+	//   - PrerenderFinalStatus.PrerenderFailedDuringPrefetch
+	//   - PrerenderFinalStatus.BrowsingDataRemoved
+	//   - PrerenderFinalStatus.PrerenderHostReused
+	PrerenderStatus PrerenderFinalStatusEnum `json:"prerenderStatus,omitempty"`
+
+	// Optional. This is used to give users more information about the name of Mojo
+	// interface that is incompatible with prerender and has caused the
+	// cancellation of the attempt.
+	DisallowedMojoInterface string `json:"disallowedMojoInterface,omitempty"`
+
+	// Optional.
+	MismatchedHeaders []*PrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PreloadingAttemptSourcesUpdatedEvent represents Preload.preloadingAttemptSourcesUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-preloadingAttemptSourcesUpdated
+*/
+type PreloadingAttemptSourcesUpdatedEvent struct {
+	LoaderID network.LoaderID `json:"loaderId"`
+
+	PreloadingAttemptSources []*PreloadingAttemptSource `json:"preloadingAttemptSources"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package profiler provides type definitions for use with the Chrome Profiler protocol
 
@@ -10,12 +12,14 @@ import (
 )
 
 /*
-ProfileNode holds callsite information, execution statistics and child nodes.
+ProfileNode represents the Profiler.ProfileNode type.
+
+Profile node. Holds callsite information, execution statistics and child nodes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ProfileNode
 */
 type ProfileNode struct {
-	// Unique ID of the node.
+	// Unique id of the node.
 	ID int `json:"id"`
 
 	// Function location.
@@ -27,8 +31,8 @@ type ProfileNode struct {
 	// Optional. Child node ids.
 	Children []int `json:"children,omitempty"`
 
-	// Optional. The reason of being not optimized. The function may be
-	// deoptimized or marked as don't optimize.
+	// Optional. The reason of being not optimized. The function may be deoptimized
+	// or marked as don't optimize.
 	DeoptReason string `json:"deoptReason,omitempty"`
 
 	// Optional. An array of source position ticks.
@@ -36,7 +40,9 @@ type ProfileNode struct {
 }
 
 /*
-Profile defines a profile
+Profile represents the Profiler.Profile type.
+
+Profile.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-Profile
 */
@@ -45,21 +51,23 @@ type Profile struct {
 	Nodes []*ProfileNode `json:"nodes"`
 
 	// Profiling start timestamp in microseconds.
-	StartTime int `json:"startTime"`
+	StartTime float64 `json:"startTime"`
 
 	// Profiling end timestamp in microseconds.
-	EndTime int `json:"endTime"`
+	EndTime float64 `json:"endTime"`
 
 	// Optional. Ids of samples top nodes.
 	Samples []int `json:"samples,omitempty"`
 
-	// Optional. Time intervals between adjacent samples in microseconds. The
-	// first delta is relative to the profile startTime.
+	// Optional. Time intervals between adjacent samples in microseconds. The first
+	// delta is relative to the profile startTime.
 	TimeDeltas []int `json:"timeDeltas,omitempty"`
 }
 
 /*
-PositionTickInfo specifies a number of samples attributed to a certain source position.
+PositionTickInfo represents the Profiler.PositionTickInfo type.
+
+Specifies a number of samples attributed to a certain source position.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-PositionTickInfo
 */
@@ -72,7 +80,9 @@ type PositionTickInfo struct {
 }
 
 /*
-CoverageRange defines coverage data for a source range.
+CoverageRange represents the Profiler.CoverageRange type.
+
+Coverage data for a source range.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-CoverageRange
 */
@@ -88,7 +98,9 @@ type CoverageRange struct {
 }
 
 /*
-FunctionCoverage defines coverage data for a JavaScript function.
+FunctionCoverage represents the Profiler.FunctionCoverage type.
+
+Coverage data for a JavaScript function.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-FunctionCoverage
 */
@@ -104,12 +116,14 @@ type FunctionCoverage struct {
 }
 
 /*
-ScriptCoverage defines coverage data for a JavaScript script.
+ScriptCoverage represents the Profiler.ScriptCoverage type.
+
+Coverage data for a JavaScript script.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptCoverage
 */
 type ScriptCoverage struct {
-	// JavaScript script ID.
+	// JavaScript script id.
 	ScriptID runtime.ScriptID `json:"scriptId"`
 
 	// JavaScript script name or url.
@@ -120,7 +134,29 @@ type ScriptCoverage struct {
 }
 
 /*
-TypeObject describes a type collected during runtime. EXPERIMENTAL
+ScriptTypeProfile represents the Profiler.ScriptTypeProfile type.
+
+Is type profile data collected during runtime for a JavaScript script.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptTypeProfile
+*/
+type ScriptTypeProfile struct {
+	// JavaScript script ID.
+	ScriptID runtime.ScriptID `json:"scriptId"`
+
+	// JavaScript script name or url.
+	URL string `json:"url"`
+
+	// Type profile entries for parameters and return values of the functions in
+	// the script.
+	Entries []*TypeProfileEntry `json:"entries"`
+}
+
+/*
+TypeObject represents the Profiler.TypeObject type.
+
+Describes a type collected during runtime. EXPERIMENTAL DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-TypeObject
 */
@@ -130,7 +166,9 @@ type TypeObject struct {
 }
 
 /*
-TypeProfileEntry is the source offset and types for a parameter or return value. EXPERIMENTAL.
+TypeProfileEntry represents the Profiler.TypeProfileEntry type.
+
+Is the source offset and types for a parameter or return value. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-TypeProfileEntry
 */
@@ -141,21 +179,3 @@ type TypeProfileEntry struct {
 	// The types for this parameter or return value.
 	Types []*TypeObject `json:"types"`
 }
-
-/*
-ScriptTypeProfile is type profile data collected during runtime for a JavaScript script.
-EXPERIMENTAL.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#type-ScriptTypeProfile
-*/
-type ScriptTypeProfile struct {
-	// JavaScript script ID.
-	ScriptID runtime.ScriptID `json:"scriptId"`
-
-	// JavaScript script name or url.
-	URL string `json:"url"`
-
-	// Type profile entries for parameters and return values of the functions in
-	// the script.
-	Entries []*TypeProfileEntry `json:"entries"`
-}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package profiler
 
 /*
@@ -69,11 +71,15 @@ StartPreciseCoverageParams represents Profiler.startPreciseCoverage parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startPreciseCoverage
 */
 type StartPreciseCoverageParams struct {
-	// Collect accurate call counts beyond simple 'covered' or 'not covered'.
-	CallCount bool `json:"callCount"`
+	// Optional. Collect accurate call counts beyond simple 'covered' or 'not
+	// covered'.
+	CallCount *bool `json:"callCount,omitempty"`
+
+	// Optional. Collect block-based coverage.
+	Detailed *bool `json:"detailed,omitempty"`
 
-	// Collect block-based coverage.
-	Detailed bool `json:"detailed"`
+	// Optional. Allow the backend to send updates on its own initiative
+	AllowTriggeredUpdates *bool `json:"allowTriggeredUpdates,omitempty"`
 }
 
 /*
@@ -82,16 +88,10 @@ StartPreciseCoverageResult represents the result of calls to Profiler.startPreci
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startPreciseCoverage
 */
 type StartPreciseCoverageResult struct {
-	// Error information related to executing this method
-	Err error `json:"-"`
-}
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
 
-/*
-StartTypeProfileResult represents the result of calls to Profiler.startTypeProfile.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startTypeProfile
-*/
-type StartTypeProfileResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -120,24 +120,38 @@ type StopPreciseCoverageResult struct {
 }
 
 /*
-StopTypeProfileResult represents the result of calls to Profiler.stopTypeProfile.
+TakePreciseCoverageResult represents the result of calls to Profiler.takePreciseCoverage.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopTypeProfile
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takePreciseCoverage
 */
-type StopTypeProfileResult struct {
+type TakePreciseCoverageResult struct {
+	// Coverage data for the current isolate.
+	Result []*ScriptCoverage `json:"result"`
+
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-TakePreciseCoverageResult represents the result of calls to Profiler.takePreciseCoverage.
+StartTypeProfileResult represents the result of calls to Profiler.startTypeProfile.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takePreciseCoverage
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startTypeProfile
 */
-type TakePreciseCoverageResult struct {
-	// Coverage data for the current isolate.
-	Result []*ScriptCoverage `json:"result"`
+type StartTypeProfileResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
 
+/*
+StopTypeProfileResult represents the result of calls to Profiler.stopTypeProfile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopTypeProfile
+*/
+type StopTypeProfileResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package profiler
 
 import (
@@ -5,41 +7,58 @@ import (
 )
 
 /*
-ConsoleProfileFinishedEvent represents Overlay.consoleProfileFinished event data.
+ConsoleProfileFinishedEvent represents Profiler.consoleProfileFinished event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consoleProfileFinished
 */
 type ConsoleProfileFinishedEvent struct {
-	// Profile ID.
 	ID string `json:"id"`
 
 	// Location of console.profileEnd().
 	Location *debugger.Location `json:"location"`
 
-	// Profile data.
 	Profile *Profile `json:"profile"`
 
-	// Profile title passed as an argument to console.profile().
-	Title string `json:"title"`
+	// Optional. Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
 /*
-ConsoleProfileStartedEvent represents Overlay.consoleProfileStarted event data.
+ConsoleProfileStartedEvent represents Profiler.consoleProfileStarted event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consoleProfileStarted
 */
 type ConsoleProfileStartedEvent struct {
-	// Profile ID.
 	ID string `json:"id"`
 
 	// Location of console.profile().
 	Location *debugger.Location `json:"location"`
 
-	// Profile title passed as an argument to console.profile().
-	Title string `json:"title"`
+	// Optional. Profile title passed as an argument to console.profile().
+	Title string `json:"title,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+PreciseCoverageDeltaUpdateEvent represents Profiler.preciseCoverageDeltaUpdate event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-preciseCoverageDeltaUpdate
+*/
+type PreciseCoverageDeltaUpdateEvent struct {
+	// Monotonically increasing time (in seconds) when the coverage update was
+	// taken in the backend.
+	Timestamp float64 `json:"timestamp"`
+
+	// Identifier for distinguishing coverage events.
+	Occasion string `json:"occasion"`
+
+	// Coverage data for the current isolate.
+	Result []*ScriptCoverage `json:"result"`
 
 	// Error information related to this event
 	Err error `json:"-"`
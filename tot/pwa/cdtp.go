@@ -0,0 +1,39 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package pwa provides type definitions for use with the Chrome PWA protocol
+
+This domain allows interacting with the browser to control PWAs. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/
+*/
+package pwa
+
+/*
+FileHandlerAccept represents the PWA.FileHandlerAccept type.
+
+The following types are the replica of
+https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#type-FileHandlerAccept
+*/
+type FileHandlerAccept struct {
+	// New name of the mimetype according to
+	// https://www.iana.org/assignments/media-types/media-types.xhtml
+	MediaType string `json:"mediaType"`
+
+	FileExtensions []string `json:"fileExtensions"`
+}
+
+/*
+FileHandler represents the PWA.FileHandler type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#type-FileHandler
+*/
+type FileHandler struct {
+	Action string `json:"action"`
+
+	Accepts []*FileHandlerAccept `json:"accepts"`
+
+	DisplayName string `json:"displayName"`
+}
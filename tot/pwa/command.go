@@ -0,0 +1,178 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package pwa
+
+import (
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+GetOsAppStateParams represents PWA.getOsAppState parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-getOsAppState
+*/
+type GetOsAppStateParams struct {
+	// The id from the webapp's manifest file, commonly it's the url of the site
+	// installing the webapp. See https://web.dev/learn/pwa/web-app-manifest.
+	ManifestID string `json:"manifestId"`
+}
+
+/*
+GetOsAppStateResult represents the result of calls to PWA.getOsAppState.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-getOsAppState
+*/
+type GetOsAppStateResult struct {
+	BadgeCount int `json:"badgeCount"`
+
+	FileHandlers []*FileHandler `json:"fileHandlers"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+InstallParams represents PWA.install parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-install
+*/
+type InstallParams struct {
+	ManifestID string `json:"manifestId"`
+
+	// Optional. The location of the app or bundle overriding the one derived from
+	// the manifestId.
+	InstallURLOrBundleURL *string `json:"installUrlOrBundleUrl,omitempty"`
+}
+
+/*
+InstallResult represents the result of calls to PWA.install.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-install
+*/
+type InstallResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+UninstallParams represents PWA.uninstall parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-uninstall
+*/
+type UninstallParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+/*
+UninstallResult represents the result of calls to PWA.uninstall.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-uninstall
+*/
+type UninstallResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+LaunchParams represents PWA.launch parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-launch
+*/
+type LaunchParams struct {
+	ManifestID string `json:"manifestId"`
+
+	// Optional.
+	URL *string `json:"url,omitempty"`
+}
+
+/*
+LaunchResult represents the result of calls to PWA.launch.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-launch
+*/
+type LaunchResult struct {
+	// ID of the tab target created as a result.
+	TargetID target.ID `json:"targetId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+LaunchFilesInAppParams represents PWA.launchFilesInApp parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-launchFilesInApp
+*/
+type LaunchFilesInAppParams struct {
+	ManifestID string `json:"manifestId"`
+
+	Files []string `json:"files"`
+}
+
+/*
+LaunchFilesInAppResult represents the result of calls to PWA.launchFilesInApp.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-launchFilesInApp
+*/
+type LaunchFilesInAppResult struct {
+	// IDs of the tab targets created as the result.
+	TargetIDs []target.ID `json:"targetIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+OpenCurrentPageInAppParams represents PWA.openCurrentPageInApp parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-openCurrentPageInApp
+*/
+type OpenCurrentPageInAppParams struct {
+	ManifestID string `json:"manifestId"`
+}
+
+/*
+OpenCurrentPageInAppResult represents the result of calls to PWA.openCurrentPageInApp.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-openCurrentPageInApp
+*/
+type OpenCurrentPageInAppResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ChangeAppUserSettingsParams represents PWA.changeAppUserSettings parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-changeAppUserSettings
+*/
+type ChangeAppUserSettingsParams struct {
+	ManifestID string `json:"manifestId"`
+
+	// Optional. If user allows the links clicked on by the user in the app's
+	// scope, or extended scope if the manifest has scope extensions and the flags
+	// `DesktopPWAsLinkCapturingWithScopeExtensions` and
+	// `WebAppEnableScopeExtensions` are enabled.
+	//
+	// Note, the API does not support resetting the linkCapturing to the initial
+	// value, uninstalling and installing the web app again will reset it.
+	//
+	// TODO(crbug.com/339453269): Setting this value on ChromeOS is not supported
+	// yet.
+	LinkCapturing *bool `json:"linkCapturing,omitempty"`
+
+	// Optional. Allowed values:
+	//   - DisplayMode.Standalone
+	//   - DisplayMode.Browser
+	DisplayMode DisplayModeEnum `json:"displayMode,omitempty"`
+}
+
+/*
+ChangeAppUserSettingsResult represents the result of calls to PWA.changeAppUserSettings.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#method-changeAppUserSettings
+*/
+type ChangeAppUserSettingsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package pwa
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type displayModeEnum struct {
+	Standalone DisplayModeEnum
+	Browser    DisplayModeEnum
+}
+
+/*
+DisplayMode provides named access to the DisplayModeEnum values.
+*/
+var DisplayMode = displayModeEnum{
+	Standalone: displayModeStandalone,
+	Browser:    displayModeBrowser,
+}
+
+/*
+DisplayModeEnum represents the PWA.DisplayMode values.
+
+If user prefers opening the app in browser or an app window.
+
+Allowed values:
+  - DisplayMode.Standalone "standalone"
+  - DisplayMode.Browser    "browser"
+
+https://chromedevtools.github.io/devtools-protocol/tot/PWA/#type-DisplayMode
+*/
+type DisplayModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum DisplayModeEnum) String() string {
+	return _displayModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum DisplayModeEnum) Unknown() bool {
+	return !_displayModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum DisplayModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *DisplayModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _displayModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// displayModeStandalone represents the "standalone" value.
+	displayModeStandalone DisplayModeEnum = iota + 1
+	// displayModeBrowser represents the "browser" value.
+	displayModeBrowser
+)
+
+var _displayModeEnums = enums.New(map[DisplayModeEnum]string{
+	DisplayModeEnum(0):    "",
+	displayModeStandalone: "standalone",
+	displayModeBrowser:    "browser",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package pwa
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumDisplayMode(t *testing.T) {
+	var enum DisplayModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown DisplayModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = DisplayMode.Standalone
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"standalone"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"standalone"`, result)
+	}
+	json.Unmarshal([]byte(`"standalone"`), &enum)
+	if DisplayMode.Standalone != enum {
+		t.Errorf("Expected %d, got %d", DisplayMode.Standalone, enum)
+	}
+
+	enum = DisplayMode.Browser
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"browser"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"browser"`, result)
+	}
+	json.Unmarshal([]byte(`"browser"`), &enum)
+	if DisplayMode.Browser != enum {
+		t.Errorf("Expected %d, got %d", DisplayMode.Browser, enum)
+	}
+}
@@ -1,79 +1,181 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package runtime provides type definitions for use with the Chrome Runtime protocol
 
+Runtime domain exposes JavaScript runtime by means of remote evaluation and
+mirror objects. Evaluation results are returned as mirror object that expose
+object type, string representation and unique identifier that can be used for
+further object reference. Original objects are maintained in memory unless they
+are either explicitly released or are released along with the other objects in
+their object group.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/
 */
 package runtime
 
 /*
-ScriptID is a unique script identifier.
+ScriptID represents the Runtime.ScriptId type.
+
+Unique script identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ScriptId
 */
 type ScriptID string
 
 /*
-RemoteObjectID is a unique object identifier.
+SerializationOptions represents the Runtime.SerializationOptions type.
+
+Represents options for serialization. Overrides `generatePreview` and
+`returnByValue`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-SerializationOptions
+*/
+type SerializationOptions struct {
+	// Allowed values:
+	//   - Serialization.Deep
+	//   - Serialization.JSON
+	//   - Serialization.IDOnly
+	Serialization SerializationEnum `json:"serialization"`
+
+	// Optional. Deep serialization depth. Default is full depth. Respected only in
+	// `deep` serialization mode.
+	MaxDepth int `json:"maxDepth,omitempty"`
+
+	// Optional. Embedder-specific parameters. For example if connected to V8 in
+	// Chrome these control DOM serialization via `maxNodeDepth: integer` and
+	// `includeShadowTree: "none" | "open" | "all"`. Values can be only of type
+	// string or integer.
+	AdditionalParameters map[string]interface{} `json:"additionalParameters,omitempty"`
+}
+
+/*
+DeepSerializedValue represents the Runtime.DeepSerializedValue type.
+
+Represents deep serialized value.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-DeepSerializedValue
+*/
+type DeepSerializedValue struct {
+	// Allowed values:
+	//   - Type.Undefined
+	//   - Type.Null
+	//   - Type.String
+	//   - Type.Number
+	//   - Type.Boolean
+	//   - Type.Bigint
+	//   - Type.Regexp
+	//   - Type.Date
+	//   - Type.Symbol
+	//   - Type.Array
+	//   - Type.Object
+	//   - Type.Function
+	//   - Type.Map
+	//   - Type.Set
+	//   - Type.Weakmap
+	//   - Type.Weakset
+	//   - Type.Error
+	//   - Type.Proxy
+	//   - Type.Promise
+	//   - Type.Typedarray
+	//   - Type.Arraybuffer
+	//   - Type.Node
+	//   - Type.Window
+	//   - Type.Generator
+	Type TypeEnum `json:"type"`
+
+	// Optional.
+	Value interface{} `json:"value,omitempty"`
+
+	// Optional.
+	ObjectID string `json:"objectId,omitempty"`
+
+	// Optional. Set if value reference met more then once during serialization. In
+	// such case, value is provided only to one of the serialized values. Unique
+	// per value in the scope of one CDP call.
+	WeakLocalObjectReference int `json:"weakLocalObjectReference,omitempty"`
+}
+
+/*
+RemoteObjectID represents the Runtime.RemoteObjectId type.
+
+Unique object identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-RemoteObjectId
 */
 type RemoteObjectID string
 
 /*
-RemoteObject is a mirror object referencing the original JavaScript object.
+RemoteObject represents the Runtime.RemoteObject type.
+
+Mirror object referencing original JavaScript object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-RemoteObject
 */
 type RemoteObject struct {
 	// Object type. Allowed values:
-	//	- object
-	//	- function
-	//	- undefined
-	//	- string
-	//	- number
-	//	- boolean
-	//	- symbol
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
 	Type ObjectTypeEnum `json:"type"`
 
-	// Optional. Object subtype hint. Specified for object type values only.
-	// Allowed values:
-	//	- array
-	//	- null
-	//	- node
-	//	- regexp
-	//	- date
-	//	- map
-	//	- set
-	//	- weakmap
-	//	- weakset
-	//	- iterator
-	//	- generator
-	//	- error
-	//	- proxy
-	//	- promise
-	//	- typedarray
+	// Optional. Object subtype hint. Specified for `object` type values only.
+	// NOTE: If you change anything here, make sure to also update `subtype` in
+	// `ObjectPreview` and `PropertyPreview` below. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
 	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
 
-	// Optional. Object class (constructor) name. Specified for object type
+	// Optional. Object class (constructor) name. Specified for `object` type
 	// values only.
 	ClassName string `json:"className,omitempty"`
 
-	// Optional. Remote object value in case of primitive values or JSON values
-	// (if it was requested).
+	// Optional. Remote object value in case of primitive values or JSON values (if
+	// it was requested).
 	Value interface{} `json:"value,omitempty"`
 
 	// Optional. Primitive value which can not be JSON-stringified does not have
-	// value, but gets this property.
+	// `value`, but gets this property. Allowed values:
+	//   - UnserializableValue.Infinity
+	//   - UnserializableValue.NaN
+	//   - UnserializableValue.NegInfinity
+	//   - UnserializableValue.NegZero
 	UnserializableValue UnserializableValueEnum `json:"unserializableValue,omitempty"`
 
 	// Optional. String representation of the object.
 	Description string `json:"description,omitempty"`
 
+	// Optional. Deep serialized value. EXPERIMENTAL.
+	DeepSerializedValue *DeepSerializedValue `json:"deepSerializedValue,omitempty"`
+
 	// Optional. Unique object identifier (for non-primitive values).
 	ObjectID RemoteObjectID `json:"objectId,omitempty"`
 
 	// Optional. Preview containing abbreviated property values. Specified for
-	// object type values only. EXPERIMENTAL.
+	// `object` type values only. EXPERIMENTAL.
 	Preview *ObjectPreview `json:"preview,omitempty"`
 
 	// Optional. EXPERIMENTAL.
@@ -81,50 +183,77 @@ type RemoteObject struct {
 }
 
 /*
-CustomPreview is EXPERIMENTAL.
+CustomPreview represents the Runtime.CustomPreview type.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-CustomPreview
 */
 type CustomPreview struct {
-	Header                     string         `json:"header"`
-	HasBody                    bool           `json:"hasBody"`
-	FormatterObjectID          RemoteObjectID `json:"formatterObjectId"`
+	// The JSON-stringified result of formatter.header(object, config) call. It
+	// contains json ML array that represents RemoteObject.
+	Header string `json:"header"`
+
+	// Optional. If formatter returns true as a result of formatter.hasBody call
+	// then bodyGetterId will contain RemoteObjectId for the function that returns
+	// result of formatter.body(object, config) call. The result value is json ML
+	// array.
+	BodyGetterID RemoteObjectID `json:"bodyGetterId,omitempty"`
+
+	// DEPRECATED.
+	HasBody bool `json:"hasBody"`
+
+	// DEPRECATED.
+	FormatterObjectID RemoteObjectID `json:"formatterObjectId"`
+
+	// DEPRECATED.
 	BindRemoteObjectFunctionID RemoteObjectID `json:"bindRemoteObjectFunctionId"`
-	// Optional.
-	ConfigObjectID RemoteObjectID `json:"configObjectId,omitempty,omitempty"`
+
+	// Optional. DEPRECATED.
+	ConfigObjectID RemoteObjectID `json:"configObjectId,omitempty"`
 }
 
 /*
-ObjectPreview is an object containing abbreviated remote object value. EXPERIMENTAL.
+ObjectPreview represents the Runtime.ObjectPreview type.
+
+Object containing abbreviated remote object value. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ObjectPreview
 */
 type ObjectPreview struct {
 	// Object type. Allowed values:
-	//	- object
-	//	- function
-	//	- undefined
-	//	- string
-	//	- number
-	//	- boolean
-	//	- symbol
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
 	Type ObjectTypeEnum `json:"type"`
 
-	// Object subtype hint. Specified for object type values only. Allowed
-	// values:
-	//	- array
-	//	- null
-	//	- node
-	//	- regexp
-	//	- date
-	//	- map
-	//	- set
-	//	- weakmap
-	//	- weakset
-	//	- iterator
-	//	- generator
-	//	- error
-	Subtype ObjectSubtypeEnum `json:"subtype"`
+	// Optional. Object subtype hint. Specified for `object` type values only. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
+	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
 
 	// Optional. String representation of the object.
 	Description string `json:"description,omitempty"`
@@ -136,13 +265,15 @@ type ObjectPreview struct {
 	// List of the properties.
 	Properties []*PropertyPreview `json:"properties"`
 
-	// Optional. List of the entries. Specified for map and set subtype values
+	// Optional. List of the entries. Specified for `map` and `set` subtype values
 	// only.
 	Entries []*EntryPreview `json:"entries,omitempty"`
 }
 
 /*
-PropertyPreview is EXPERIMENTAL
+PropertyPreview represents the Runtime.PropertyPreview type.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-PropertyPreview
 */
@@ -152,14 +283,15 @@ type PropertyPreview struct {
 
 	// Object type. Accessor means that the property itself is an accessor
 	// property. Allowed values:
-	//	- object
-	//	- function
-	//	- undefined
-	//	- string
-	//	- number
-	//	- boolean
-	//	- symbol
-	//	- accessor
+	//   - ObjectType.Object
+	//   - ObjectType.Function
+	//   - ObjectType.Undefined
+	//   - ObjectType.String
+	//   - ObjectType.Number
+	//   - ObjectType.Boolean
+	//   - ObjectType.Symbol
+	//   - ObjectType.Bigint
+	//   - ObjectType.Accessor
 	Type ObjectTypeEnum `json:"type"`
 
 	// Optional. User-friendly property value string.
@@ -168,25 +300,33 @@ type PropertyPreview struct {
 	// Optional. Nested value preview.
 	ValuePreview *ObjectPreview `json:"valuePreview,omitempty"`
 
-	// Optional. Object subtype hint. Specified for object type values only.
-	// Allowed values:
-	//	- array
-	//	- null
-	//	- node
-	//	- regexp
-	//	- date
-	//	- map
-	//	- set
-	//	- weakmap
-	//	- weakset
-	//	- iterator
-	//	- generator
-	//	- error
+	// Optional. Object subtype hint. Specified for `object` type values only. Allowed values:
+	//   - ObjectSubtype.Array
+	//   - ObjectSubtype.Null
+	//   - ObjectSubtype.Node
+	//   - ObjectSubtype.Regexp
+	//   - ObjectSubtype.Date
+	//   - ObjectSubtype.Map
+	//   - ObjectSubtype.Set
+	//   - ObjectSubtype.Weakmap
+	//   - ObjectSubtype.Weakset
+	//   - ObjectSubtype.Iterator
+	//   - ObjectSubtype.Generator
+	//   - ObjectSubtype.Error
+	//   - ObjectSubtype.Proxy
+	//   - ObjectSubtype.Promise
+	//   - ObjectSubtype.Typedarray
+	//   - ObjectSubtype.Arraybuffer
+	//   - ObjectSubtype.Dataview
+	//   - ObjectSubtype.Webassemblymemory
+	//   - ObjectSubtype.Wasmvalue
 	Subtype ObjectSubtypeEnum `json:"subtype,omitempty"`
 }
 
 /*
-EntryPreview is EXPERIMENTAL
+EntryPreview represents the Runtime.EntryPreview type.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-EntryPreview
 */
@@ -199,7 +339,9 @@ type EntryPreview struct {
 }
 
 /*
-PropertyDescriptor is an object property descriptor.
+PropertyDescriptor represents the Runtime.PropertyDescriptor type.
+
+Object property descriptor.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-PropertyDescriptor
 */
@@ -215,19 +357,19 @@ type PropertyDescriptor struct {
 	Writable bool `json:"writable,omitempty"`
 
 	// Optional. A function which serves as a getter for the property, or
-	// undefined if there is no getter (accessor descriptors only).
+	// `undefined` if there is no getter (accessor descriptors only).
 	Get *RemoteObject `json:"get,omitempty"`
 
 	// Optional. A function which serves as a setter for the property, or
-	// undefined if there is no setter (accessor descriptors only).
+	// `undefined` if there is no setter (accessor descriptors only).
 	Set *RemoteObject `json:"set,omitempty"`
 
 	// True if the type of this property descriptor may be changed and if the
 	// property may be deleted from the corresponding object.
 	Configurable bool `json:"configurable"`
 
-	// True if this property shows up during enumeration of the properties on
-	// the corresponding object.
+	// True if this property shows up during enumeration of the properties on the
+	// corresponding object.
 	Enumerable bool `json:"enumerable"`
 
 	// Optional. True if the result was thrown during the evaluation.
@@ -236,13 +378,16 @@ type PropertyDescriptor struct {
 	// Optional. True if the property is owned for the object.
 	IsOwn bool `json:"isOwn,omitempty"`
 
-	// Optional. Property symbol object, if the property is of the symbol type.
+	// Optional. Property symbol object, if the property is of the `symbol` type.
 	Symbol *RemoteObject `json:"symbol,omitempty"`
 }
 
 /*
-InternalPropertyDescriptor is an object's internal property descriptor. This property isn't normally
-visible in JavaScript code.
+InternalPropertyDescriptor represents the Runtime.InternalPropertyDescriptor
+type.
+
+Object internal property descriptor. This property isn't normally visible in
+JavaScript code.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-InternalPropertyDescriptor
 */
@@ -255,8 +400,34 @@ type InternalPropertyDescriptor struct {
 }
 
 /*
-CallArgument represents a function call argument. Either remote object id objectId, primitive value,
-unserializable primitive value or neither of (for undefined) them should be specified.
+PrivatePropertyDescriptor represents the Runtime.PrivatePropertyDescriptor type.
+
+Object private field descriptor. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-PrivatePropertyDescriptor
+*/
+type PrivatePropertyDescriptor struct {
+	// Private property name.
+	Name string `json:"name"`
+
+	// Optional. The value associated with the private property.
+	Value *RemoteObject `json:"value,omitempty"`
+
+	// Optional. A function which serves as a getter for the private property, or
+	// `undefined` if there is no getter (accessor descriptors only).
+	Get *RemoteObject `json:"get,omitempty"`
+
+	// Optional. A function which serves as a setter for the private property, or
+	// `undefined` if there is no setter (accessor descriptors only).
+	Set *RemoteObject `json:"set,omitempty"`
+}
+
+/*
+CallArgument represents the Runtime.CallArgument type.
+
+Represents function call argument. Either remote object id `objectId`, primitive
+`value`, unserializable primitive value or neither of (for undefined) them
+should be specified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-CallArgument
 */
@@ -264,7 +435,11 @@ type CallArgument struct {
 	// Optional. Primitive value or serializable javascript object.
 	Value interface{} `json:"value,omitempty"`
 
-	// Optional. Primitive value which can not be JSON-stringified.
+	// Optional. Primitive value which can not be JSON-stringified. Allowed values:
+	//   - UnserializableValue.Infinity
+	//   - UnserializableValue.NaN
+	//   - UnserializableValue.NegInfinity
+	//   - UnserializableValue.NegZero
 	UnserializableValue UnserializableValueEnum `json:"unserializableValue,omitempty"`
 
 	// Optional. Remote object handle.
@@ -272,19 +447,24 @@ type CallArgument struct {
 }
 
 /*
-ExecutionContextID is the ID of an execution context.
+ExecutionContextID represents the Runtime.ExecutionContextId type.
+
+Id of an execution context.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ExecutionContextId
 */
 type ExecutionContextID int
 
 /*
-ExecutionContextDescription is the description of an isolated world.
+ExecutionContextDescription represents the Runtime.ExecutionContextDescription
+type.
+
+Description of an isolated world.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ExecutionContextDescription
 */
 type ExecutionContextDescription struct {
-	// Unique ID of the execution context. It can be used to specify in which
+	// Unique id of the execution context. It can be used to specify in which
 	// execution context script evaluation should be performed.
 	ID ExecutionContextID `json:"id"`
 
@@ -294,13 +474,21 @@ type ExecutionContextDescription struct {
 	// Human readable name describing given context.
 	Name string `json:"name"`
 
-	// Optional. Embedder-specific auxiliary data.
-	AuxData map[string]string `json:"auxData,omitempty"`
+	// A system-unique execution context identifier. Unlike the id, this is unique
+	// across multiple processes, so can be reliably used to identify specific
+	// context while backend performs a cross-process navigation. EXPERIMENTAL.
+	UniqueID string `json:"uniqueId"`
+
+	// Optional. Embedder-specific auxiliary data likely matching {isDefault:
+	// boolean, type: 'default'|'isolated'|'worker', frameId: string}
+	AuxData map[string]interface{} `json:"auxData,omitempty"`
 }
 
 /*
-ExceptionDetails contains detailed information about exception (or error) that was thrown during
-script compilation or execution.
+ExceptionDetails represents the Runtime.ExceptionDetails type.
+
+Detailed information about exception (or error) that was thrown during script
+compilation or execution.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ExceptionDetails
 */
@@ -321,8 +509,8 @@ type ExceptionDetails struct {
 	// Optional. Script ID of the exception location.
 	ScriptID ScriptID `json:"scriptId,omitempty"`
 
-	// Optional. URL of the exception location, to be used when the script was
-	// not reported.
+	// Optional. URL of the exception location, to be used when the script was not
+	// reported.
 	URL string `json:"url,omitempty"`
 
 	// Optional. JavaScript stack trace if available.
@@ -333,17 +521,35 @@ type ExceptionDetails struct {
 
 	// Optional. Identifier of the context where exception happened.
 	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+
+	// Optional. Dictionary with entries of meta data that the client associated
+	// with this exception, such as information about associated network requests,
+	// etc. EXPERIMENTAL.
+	ExceptionMetaData map[string]interface{} `json:"exceptionMetaData,omitempty"`
 }
 
 /*
-Timestamp is the number of milliseconds since epoch.
+Timestamp represents the Runtime.Timestamp type.
+
+Number of milliseconds since epoch.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-Timestamp
 */
-type Timestamp int
+type Timestamp float64
 
 /*
-CallFrame is a stack entry for runtime errors and assertions.
+TimeDelta represents the Runtime.TimeDelta type.
+
+Number of milliseconds.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-TimeDelta
+*/
+type TimeDelta float64
+
+/*
+CallFrame represents the Runtime.CallFrame type.
+
+Stack entry for runtime errors and assertions.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-CallFrame
 */
@@ -365,43 +571,50 @@ type CallFrame struct {
 }
 
 /*
-StackTrace contains call frames for assertions or error messages.
+StackTrace represents the Runtime.StackTrace type.
+
+Call frames for assertions or error messages.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-StackTrace
 */
 type StackTrace struct {
-	// Optional. String label of this stack trace. For async traces this may be
-	// a name of the function that initiated the async call.
+	// Optional. String label of this stack trace. For async traces this may be a
+	// name of the function that initiated the async call.
 	Description string `json:"description,omitempty"`
 
 	// JavaScript function name.
 	CallFrames []*CallFrame `json:"callFrames"`
 
-	// Optional. Asynchronous JavaScript stack trace that preceded this stack,
-	// if available.
+	// Optional. Asynchronous JavaScript stack trace that preceded this stack, if
+	// available.
 	Parent *StackTrace `json:"parent,omitempty"`
 
-	// Optional. Asynchronous JavaScript stack trace that preceded this stack,
-	// if available. EXPERIMENTAL.
+	// Optional. Asynchronous JavaScript stack trace that preceded this stack, if
+	// available. EXPERIMENTAL.
 	ParentID *StackTraceID `json:"parentId,omitempty"`
 }
 
 /*
-UniqueDebuggerID is the unique identifier of the  current debugger. EXPERIMENTAL.
+UniqueDebuggerID represents the Runtime.UniqueDebuggerId type.
+
+Unique identifier of current debugger. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-UniqueDebuggerId
 */
 type UniqueDebuggerID string
 
 /*
-StackTraceID - If debuggerID is set stack trace comes from another debugger and can be resolved
-there. This allows to track cross-debugger calls. See StackTrace and Debugger.paused for usages.
-EXPERIMENTAL.
+StackTraceID represents the Runtime.StackTraceId type.
+
+If `debuggerId` is set stack trace comes from another debugger and can be
+resolved there. This allows to track cross-debugger calls. See
+`Runtime.StackTrace` and `Debugger.paused` for usages. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-StackTraceId
 */
 type StackTraceID struct {
 	ID string `json:"id"`
+
 	// Optional.
 	DebuggerID UniqueDebuggerID `json:"debuggerId,omitempty"`
 }
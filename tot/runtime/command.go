@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
 /*
@@ -9,12 +11,12 @@ type AwaitPromiseParams struct {
 	// Identifier of the promise.
 	PromiseObjectID RemoteObjectID `json:"promiseObjectId"`
 
-	// Optional. Whether the result is expected to be a JSON object that should
-	// be sent by value.
-	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Optional. Whether the result is expected to be a JSON object that should be
+	// sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
 
 	// Optional. Whether preview should be generated for the result.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
 }
 
 /*
@@ -26,8 +28,8 @@ type AwaitPromiseResult struct {
 	// Promise result. Will contain rejected value if promise was rejected.
 	Result *RemoteObject `json:"result"`
 
-	// Exception details if stack strace is available.
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	// Optional. Exception details if stack strace is available.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -42,43 +44,57 @@ type CallFunctionOnParams struct {
 	// Declaration of the function to call.
 	FunctionDeclaration string `json:"functionDeclaration"`
 
-	// Optional. Identifier of the object to call function on. Either objectID
-	// or executionContextID should be specified.
-	ObjectID RemoteObjectID `json:"objectId,omitempty"`
+	// Optional. Identifier of the object to call function on. Either objectId or
+	// executionContextId should be specified.
+	ObjectID *RemoteObjectID `json:"objectId,omitempty"`
 
 	// Optional. Call arguments. All call arguments must belong to the same
 	// JavaScript world as the target object.
 	Arguments []*CallArgument `json:"arguments,omitempty"`
 
 	// Optional. In silent mode exceptions thrown during evaluation are not
-	// reported and do not pause execution. Overrides setPauseOnException state.
-	Silent bool `json:"silent,omitempty"`
+	// reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
 
-	// Whether the result is expected to be a JSON object which should be sent
-	// by value.
-	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Optional. Whether the result is expected to be a JSON object which should be
+	// sent by value. Can be overriden by `serializationOptions`.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
 
-	// Optional. Whether preview should be generated for the result.
-	// EXPERIMENTAL.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Optional. Whether preview should be generated for the result. EXPERIMENTAL.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
 
 	// Optional. Whether execution should be treated as initiated by user in the
 	// UI.
-	UserGesture bool `json:"userGesture,omitempty"`
+	UserGesture *bool `json:"userGesture,omitempty"`
 
-	// Optional. Whether execution should await for resulting value and return
+	// Optional. Whether execution should `await` for resulting value and return
 	// once awaited promise is resolved.
-	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
 
 	// Optional. Specifies execution context which global object will be used to
-	// call function on. Either executionContextID or objectID should be
-	// specified.
-	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
-
-	// Optional. Symbolic group name that can be used to release multiple
-	// objects. If objectGroup is not specified and objectID is, objectGroup
-	// will be inherited from object.
-	ObjectGroup string `json:"objectGroup,omitempty"`
+	// call function on. Either executionContextId or objectId should be specified.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+
+	// Optional. Symbolic group name that can be used to release multiple objects.
+	// If objectGroup is not specified and objectId is, objectGroup will be
+	// inherited from object.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// Optional. Whether to throw an exception if side effect cannot be ruled out
+	// during evaluation. EXPERIMENTAL.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+
+	// Optional. An alternative way to specify the execution context to call
+	// function on. Compared to contextId that may be reused across processes, this
+	// is guaranteed to be system-unique, so it can be used to prevent accidental
+	// function call in context different than intended (e.g. as a result of
+	// navigation across process boundaries). This is mutually exclusive with
+	// `executionContextId`. EXPERIMENTAL.
+	UniqueContextID *string `json:"uniqueContextId,omitempty"`
+
+	// Optional. Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`. EXPERIMENTAL.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
 }
 
 /*
@@ -90,8 +106,8 @@ type CallFunctionOnResult struct {
 	// Call result.
 	Result *RemoteObject `json:"result"`
 
-	// Exception details.
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	// Optional. Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -112,10 +128,10 @@ type CompileScriptParams struct {
 	// Specifies whether the compiled script should be persisted.
 	PersistScript bool `json:"persistScript"`
 
-	// Optional. Specifies in which execution context to perform script run. If
-	// the parameter is omitted the evaluation will be performed in the context
-	// of the inspected page.
-	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// Optional. Specifies in which execution context to perform script run. If the
+	// parameter is omitted the evaluation will be performed in the context of the
+	// inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
 }
 
 /*
@@ -124,11 +140,11 @@ CompileScriptResult represents the result of calls to Runtime.compileScript.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-compileScript
 */
 type CompileScriptResult struct {
-	// ID of the script.
-	ScriptID ScriptID `json:"scriptId"`
+	// Optional. Id of the script.
+	ScriptID ScriptID `json:"scriptId,omitempty"`
 
-	// Exception details.
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	// Optional. Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -173,38 +189,73 @@ type EvaluateParams struct {
 	// Expression to evaluate.
 	Expression string `json:"expression"`
 
-	// Optional. Symbolic group name that can be used to release multiple
-	// objects.
-	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Optional. Symbolic group name that can be used to release multiple objects.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
 
-	// Optional. Determines whether Command Line API should be available during
-	// the evaluation.
-	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// Optional. Determines whether Command Line API should be available during the
+	// evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
 
 	// Optional. In silent mode exceptions thrown during evaluation are not
-	// reported and do not pause execution. Overrides setPauseOnException state.
-	Silent bool `json:"silent,omitempty"`
+	// reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
 
-	// Optional. Specifies in which execution context to perform evaluation. If
-	// the parameter is omitted the evaluation will be performed in the context
-	// of the inspected page.
-	ContextID ExecutionContextID `json:"contextId,omitempty"`
+	// Optional. Specifies in which execution context to perform evaluation. If the
+	// parameter is omitted the evaluation will be performed in the context of the
+	// inspected page. This is mutually exclusive with `uniqueContextId`, which
+	// offers an alternative way to identify the execution context that is more
+	// reliable in a multi-process environment.
+	ContextID *ExecutionContextID `json:"contextId,omitempty"`
 
-	// Optional. Whether the result is expected to be a JSON object that should
-	// be sent by value.
-	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Optional. Whether the result is expected to be a JSON object that should be
+	// sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
 
-	// Optional. Whether preview should be generated for the result.
-	// EXPERIMENTAL.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Optional. Whether preview should be generated for the result. EXPERIMENTAL.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
 
 	// Optional. Whether execution should be treated as initiated by user in the
 	// UI.
-	UserGesture bool `json:"userGesture,omitempty"`
+	UserGesture *bool `json:"userGesture,omitempty"`
 
-	// Optional. Whether execution should await for resulting value and return
+	// Optional. Whether execution should `await` for resulting value and return
 	// once awaited promise is resolved.
-	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+
+	// Optional. Whether to throw an exception if side effect cannot be ruled out
+	// during evaluation. This implies `disableBreaks` below. EXPERIMENTAL.
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+
+	// Optional. Terminate execution after timing out (number of milliseconds).
+	// EXPERIMENTAL.
+	Timeout *TimeDelta `json:"timeout,omitempty"`
+
+	// Optional. Disable breakpoints during execution. EXPERIMENTAL.
+	DisableBreaks *bool `json:"disableBreaks,omitempty"`
+
+	// Optional. Setting this flag to true enables `let` re-declaration and
+	// top-level `await`. Note that `let` variables can only be re-declared if they
+	// originate from `replMode` themselves. EXPERIMENTAL.
+	ReplMode *bool `json:"replMode,omitempty"`
+
+	// Optional. The Content Security Policy (CSP) for the target might block
+	// 'unsafe-eval' which includes eval(), Function(), setTimeout() and
+	// setInterval() when called with non-callable arguments. This flag bypasses
+	// CSP for this evaluation and allows unsafe-eval. Defaults to true.
+	// EXPERIMENTAL.
+	AllowUnsafeEvalBlockedByCSP *bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+
+	// Optional. An alternative way to specify the execution context to evaluate
+	// in. Compared to contextId that may be reused across processes, this is
+	// guaranteed to be system-unique, so it can be used to prevent accidental
+	// evaluation of the expression in context different than intended (e.g. as a
+	// result of navigation across process boundaries). This is mutually exclusive
+	// with `contextId`. EXPERIMENTAL.
+	UniqueContextID *string `json:"uniqueContextId,omitempty"`
+
+	// Optional. Specifies the result serialization. If provided, overrides
+	// `generatePreview` and `returnByValue`. EXPERIMENTAL.
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
 }
 
 /*
@@ -216,8 +267,43 @@ type EvaluateResult struct {
 	// Evaluation result.
 	Result *RemoteObject `json:"result"`
 
-	// Exception details.
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	// Optional. Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetIsolateIDResult represents the result of calls to Runtime.getIsolateId.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getIsolateId
+*/
+type GetIsolateIDResult struct {
+	// The isolate id.
+	ID string `json:"id"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetHeapUsageResult represents the result of calls to Runtime.getHeapUsage.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getHeapUsage
+*/
+type GetHeapUsageResult struct {
+	// Used JavaScript heap size in bytes.
+	UsedSize float64 `json:"usedSize"`
+
+	// Allocated JavaScript heap size in bytes.
+	TotalSize float64 `json:"totalSize"`
+
+	// Used size in bytes in the embedder's garbage-collected heap.
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+
+	// Size in bytes of backing storage for array buffers and external strings.
+	BackingStorageSize float64 `json:"backingStorageSize"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -232,17 +318,19 @@ type GetPropertiesParams struct {
 	// Identifier of the object to return properties for.
 	ObjectID RemoteObjectID `json:"objectId"`
 
-	// Optional. If true, returns properties belonging only to the element
-	// itself, not to its prototype chain.
-	OwnProperties bool `json:"ownProperties,omitempty"`
+	// Optional. If true, returns properties belonging only to the element itself,
+	// not to its prototype chain.
+	OwnProperties *bool `json:"ownProperties,omitempty"`
 
 	// Optional. If true, returns accessor properties (with getter/setter) only;
 	// internal properties are not returned either. EXPERIMENTAL.
-	AccessorPropertiesOnly bool `json:"accessorPropertiesOnly,omitempty"`
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
 
-	// Optional. Whether preview should be generated for the results.
-	// EXPERIMENTAL.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	// Optional. Whether preview should be generated for the results. EXPERIMENTAL.
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Optional. If true, returns non-indexed properties only. EXPERIMENTAL.
+	NonIndexedPropertiesOnly *bool `json:"nonIndexedPropertiesOnly,omitempty"`
 }
 
 /*
@@ -254,11 +342,14 @@ type GetPropertiesResult struct {
 	// Object properties.
 	Result []*PropertyDescriptor `json:"result"`
 
-	// Internal object properties (only of the element itself).
-	InternalProperties []*InternalPropertyDescriptor `json:"internalProperties"`
+	// Optional. Internal object properties (only of the element itself).
+	InternalProperties []*InternalPropertyDescriptor `json:"internalProperties,omitempty"`
 
-	// Exception details.
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	// Optional. Object private properties. EXPERIMENTAL.
+	PrivateProperties []*PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+
+	// Optional. Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
@@ -270,8 +361,9 @@ GlobalLexicalScopeNamesParams represents Runtime.globalLexicalScopeNames paramet
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-globalLexicalScopeNames
 */
 type GlobalLexicalScopeNamesParams struct {
-	// Optional. Specifies in which execution context to lookup global scope variables.
-	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// Optional. Specifies in which execution context to lookup global scope
+	// variables.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
 }
 
 /*
@@ -294,6 +386,9 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObje
 type QueryObjectsParams struct {
 	// Identifier of the prototype to return objects for.
 	PrototypeObjectID RemoteObjectID `json:"prototypeObjectId"`
+
+	// Optional. Symbolic group name that can be used to release the results.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
 }
 
 /*
@@ -302,7 +397,10 @@ QueryObjectsResult represents the result of calls to Runtime.queryObjects.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObjects
 */
 type QueryObjectsResult struct {
-	// Identifier of the object to release.
+	// Array with objects.
+	Objects *RemoteObject `json:"objects"`
+
+	// Identifier of the object to release. DEPRECATED.
 	ObjectID RemoteObjectID `json:"objectId"`
 
 	// Error information related to executing this method
@@ -365,36 +463,35 @@ RunScriptParams represents Runtime.runScript parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
 */
 type RunScriptParams struct {
-	// ID of the script to run.
+	// Id of the script to run.
 	ScriptID ScriptID `json:"scriptId"`
 
-	// Optional. Specifies in which execution context to perform script run. If
-	// the parameter is omitted the evaluation will be performed in the context
-	// of the inspected page.
-	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
+	// Optional. Specifies in which execution context to perform script run. If the
+	// parameter is omitted the evaluation will be performed in the context of the
+	// inspected page.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
 
-	// Optional. Symbolic group name that can be used to release multiple
-	// objects.
-	ObjectGroup string `json:"objectGroup,omitempty"`
+	// Optional. Symbolic group name that can be used to release multiple objects.
+	ObjectGroup *string `json:"objectGroup,omitempty"`
 
 	// Optional. In silent mode exceptions thrown during evaluation are not
-	// reported and do not pause execution. Overrides setPauseOnException state.
-	Silent bool `json:"silent,omitempty"`
+	// reported and do not pause execution. Overrides `setPauseOnException` state.
+	Silent *bool `json:"silent,omitempty"`
 
-	// Optional. Determines whether Command Line API should be available during
-	// the evaluation.
-	IncludeCommandLineAPI bool `json:"includeCommandLineAPI,omitempty"`
+	// Optional. Determines whether Command Line API should be available during the
+	// evaluation.
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
 
-	// Optional. Whether the result is expected to be a JSON object which should
-	// be sent by value.
-	ReturnByValue bool `json:"returnByValue,omitempty"`
+	// Optional. Whether the result is expected to be a JSON object which should be
+	// sent by value.
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
 
 	// Optional. Whether preview should be generated for the result.
-	GeneratePreview bool `json:"generatePreview,omitempty"`
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
 
-	// Optional. Whether execution should await for resulting value and return
+	// Optional. Whether execution should `await` for resulting value and return
 	// once awaited promise is resolved.
-	AwaitPromise bool `json:"awaitPromise,omitempty"`
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
 }
 
 /*
@@ -403,29 +500,57 @@ RunScriptResult represents the result of calls to Runtime.runScript.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
 */
 type RunScriptResult struct {
-	// Identifier of the object to release.
+	// Run result.
+	Result *RemoteObject `json:"result"`
+
+	// Optional. Exception details.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+
+	// Identifier of the object to release. DEPRECATED.
 	ObjectID RemoteObjectID `json:"objectId"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+SetAsyncCallStackDepthParams represents Runtime.setAsyncCallStackDepth parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setAsyncCallStackDepth
+*/
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to `0` will effectively disable
+	// collecting async call stacks (default).
+	MaxDepth int `json:"maxDepth"`
+}
+
+/*
+SetAsyncCallStackDepthResult represents the result of calls to Runtime.setAsyncCallStackDepth.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setAsyncCallStackDepth
+*/
+type SetAsyncCallStackDepthResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 SetCustomObjectFormatterEnabledParams represents Runtime.setCustomObjectFormatterEnabled parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setCustomObjectFormatterEnabled
 */
 type SetCustomObjectFormatterEnabledParams struct {
-	// Run result.
+	Enabled bool `json:"enabled"`
+
+	// Run result. DEPRECATED.
 	Result *RemoteObject `json:"result"`
 
-	// Exception details.
+	// Exception details. DEPRECATED.
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
 }
 
 /*
-SetCustomObjectFormatterEnabledResult represents the result of calls to
-Runtime.setCustomObjectFormatterEnabled.
+SetCustomObjectFormatterEnabledResult represents the result of calls to Runtime.setCustomObjectFormatterEnabled.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setCustomObjectFormatterEnabled
 */
@@ -433,3 +558,109 @@ type SetCustomObjectFormatterEnabledResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+SetMaxCallStackSizeToCaptureParams represents Runtime.setMaxCallStackSizeToCapture parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setMaxCallStackSizeToCapture
+*/
+type SetMaxCallStackSizeToCaptureParams struct {
+	Size int `json:"size"`
+}
+
+/*
+SetMaxCallStackSizeToCaptureResult represents the result of calls to Runtime.setMaxCallStackSizeToCapture.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setMaxCallStackSizeToCapture
+*/
+type SetMaxCallStackSizeToCaptureResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+TerminateExecutionResult represents the result of calls to Runtime.terminateExecution.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-terminateExecution
+*/
+type TerminateExecutionResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddBindingParams represents Runtime.addBinding parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-addBinding
+*/
+type AddBindingParams struct {
+	Name string `json:"name"`
+
+	// Optional. If specified, the binding would only be exposed to the specified
+	// execution context. If omitted and `executionContextName` is not set, the
+	// binding is exposed to all execution contexts of the target. This parameter
+	// is mutually exclusive with `executionContextName`. Deprecated in favor of
+	// `executionContextName` due to an unclear use case and bugs in implementation
+	// (crbug.com/1169639). `executionContextId` will be removed in the future.
+	// EXPERIMENTAL. DEPRECATED.
+	ExecutionContextID *ExecutionContextID `json:"executionContextId,omitempty"`
+
+	// Optional. If specified, the binding is exposed to the executionContext with
+	// matching name, even for contexts created after the binding is added. See
+	// also `ExecutionContext.name` and `worldName` parameter to
+	// `Page.addScriptToEvaluateOnNewDocument`. This parameter is mutually
+	// exclusive with `executionContextId`.
+	ExecutionContextName *string `json:"executionContextName,omitempty"`
+}
+
+/*
+AddBindingResult represents the result of calls to Runtime.addBinding.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-addBinding
+*/
+type AddBindingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveBindingParams represents Runtime.removeBinding parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-removeBinding
+*/
+type RemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+/*
+RemoveBindingResult represents the result of calls to Runtime.removeBinding.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-removeBinding
+*/
+type RemoveBindingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetExceptionDetailsParams represents Runtime.getExceptionDetails parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getExceptionDetails
+*/
+type GetExceptionDetailsParams struct {
+	// The error object for which to resolve the exception details.
+	ErrorObjectID RemoteObjectID `json:"errorObjectId"`
+}
+
+/*
+GetExceptionDetailsResult represents the result of calls to Runtime.getExceptionDetails.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getExceptionDetails
+*/
+type GetExceptionDetailsResult struct {
+	// Optional.
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type callTypeEnum struct {
@@ -27,7 +30,7 @@ type callTypeEnum struct {
 }
 
 /*
-CallType provides named acces to the CallTypeEnum values.
+CallType provides named access to the CallTypeEnum values.
 */
 var CallType = callTypeEnum{
 	Log:                 callTypeLog,
@@ -51,25 +54,29 @@ var CallType = callTypeEnum{
 }
 
 /*
-CallTypeEnum represents the type of the call. Allowed values:
-	- CallType.Log                 "log"
-	- CallType.Debug               "debug"
-	- CallType.Info                "info"
-	- CallType.Error               "error"
-	- CallType.Warning             "warning"
-	- CallType.Dir                 "dir"
-	- CallType.Dirxml              "dirxml"
-	- CallType.Table               "table"
-	- CallType.Trace               "trace"
-	- CallType.Clear               "clear"
-	- CallType.StartGroup          "startGroup"
-	- CallType.StartGroupCollapsed "startGroupCollapsed"
-	- CallType.EndGroup            "endGroup"
-	- CallType.Assert              "assert"
-	- CallType.Profile             "profile"
-	- CallType.ProfileEnd          "profileEnd"
-	- CallType.Count               "count"
-	- CallType.TimeEnd             "timeEnd"
+CallTypeEnum represents the Runtime.consoleAPICalled.type values.
+
+Type of the call.
+
+Allowed values:
+  - CallType.Log                 "log"
+  - CallType.Debug               "debug"
+  - CallType.Info                "info"
+  - CallType.Error               "error"
+  - CallType.Warning             "warning"
+  - CallType.Dir                 "dir"
+  - CallType.Dirxml              "dirxml"
+  - CallType.Table               "table"
+  - CallType.Trace               "trace"
+  - CallType.Clear               "clear"
+  - CallType.StartGroup          "startGroup"
+  - CallType.StartGroupCollapsed "startGroupCollapsed"
+  - CallType.EndGroup            "endGroup"
+  - CallType.Assert              "assert"
+  - CallType.Profile             "profile"
+  - CallType.ProfileEnd          "profileEnd"
+  - CallType.Count               "count"
+  - CallType.TimeEnd             "timeEnd"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPICalled
 */
@@ -79,7 +86,16 @@ type CallTypeEnum int
 String implements Stringer
 */
 func (enum CallTypeEnum) String() string {
-	return _callTypeEnums[enum]
+	return _callTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CallTypeEnum) Unknown() bool {
+	return !_callTypeEnums.Known(enum)
 }
 
 /*
@@ -90,25 +106,16 @@ func (enum CallTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *CallTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _callTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _callTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -150,7 +157,8 @@ const (
 	callTypeTimeEnd
 )
 
-var _callTypeEnums = map[CallTypeEnum]string{
+var _callTypeEnums = enums.New(map[CallTypeEnum]string{
+	CallTypeEnum(0):             "",
 	callTypeLog:                 "log",
 	callTypeDebug:               "debug",
 	callTypeInfo:                "info",
@@ -169,4 +177,4 @@ var _callTypeEnums = map[CallTypeEnum]string{
 	callTypeProfileEnd:          "profileEnd",
 	callTypeCount:               "count",
 	callTypeTimeEnd:             "timeEnd",
-}
+})
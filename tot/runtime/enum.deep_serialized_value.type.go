@@ -0,0 +1,214 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type typeEnum struct {
+	Undefined   TypeEnum
+	Null        TypeEnum
+	String      TypeEnum
+	Number      TypeEnum
+	Boolean     TypeEnum
+	Bigint      TypeEnum
+	Regexp      TypeEnum
+	Date        TypeEnum
+	Symbol      TypeEnum
+	Array       TypeEnum
+	Object      TypeEnum
+	Function    TypeEnum
+	Map         TypeEnum
+	Set         TypeEnum
+	Weakmap     TypeEnum
+	Weakset     TypeEnum
+	Error       TypeEnum
+	Proxy       TypeEnum
+	Promise     TypeEnum
+	Typedarray  TypeEnum
+	Arraybuffer TypeEnum
+	Node        TypeEnum
+	Window      TypeEnum
+	Generator   TypeEnum
+}
+
+/*
+Type provides named access to the TypeEnum values.
+*/
+var Type = typeEnum{
+	Undefined:   typeUndefined,
+	Null:        typeNull,
+	String:      typeString,
+	Number:      typeNumber,
+	Boolean:     typeBoolean,
+	Bigint:      typeBigint,
+	Regexp:      typeRegexp,
+	Date:        typeDate,
+	Symbol:      typeSymbol,
+	Array:       typeArray,
+	Object:      typeObject,
+	Function:    typeFunction,
+	Map:         typeMap,
+	Set:         typeSet,
+	Weakmap:     typeWeakmap,
+	Weakset:     typeWeakset,
+	Error:       typeError,
+	Proxy:       typeProxy,
+	Promise:     typePromise,
+	Typedarray:  typeTypedarray,
+	Arraybuffer: typeArraybuffer,
+	Node:        typeNode,
+	Window:      typeWindow,
+	Generator:   typeGenerator,
+}
+
+/*
+TypeEnum represents the Runtime.DeepSerializedValue.type values.
+
+Allowed values:
+  - Type.Undefined   "undefined"
+  - Type.Null        "null"
+  - Type.String      "string"
+  - Type.Number      "number"
+  - Type.Boolean     "boolean"
+  - Type.Bigint      "bigint"
+  - Type.Regexp      "regexp"
+  - Type.Date        "date"
+  - Type.Symbol      "symbol"
+  - Type.Array       "array"
+  - Type.Object      "object"
+  - Type.Function    "function"
+  - Type.Map         "map"
+  - Type.Set         "set"
+  - Type.Weakmap     "weakmap"
+  - Type.Weakset     "weakset"
+  - Type.Error       "error"
+  - Type.Proxy       "proxy"
+  - Type.Promise     "promise"
+  - Type.Typedarray  "typedarray"
+  - Type.Arraybuffer "arraybuffer"
+  - Type.Node        "node"
+  - Type.Window      "window"
+  - Type.Generator   "generator"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-DeepSerializedValue
+*/
+type TypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum TypeEnum) String() string {
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum TypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _typeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// typeUndefined represents the "undefined" value.
+	typeUndefined TypeEnum = iota + 1
+	// typeNull represents the "null" value.
+	typeNull
+	// typeString represents the "string" value.
+	typeString
+	// typeNumber represents the "number" value.
+	typeNumber
+	// typeBoolean represents the "boolean" value.
+	typeBoolean
+	// typeBigint represents the "bigint" value.
+	typeBigint
+	// typeRegexp represents the "regexp" value.
+	typeRegexp
+	// typeDate represents the "date" value.
+	typeDate
+	// typeSymbol represents the "symbol" value.
+	typeSymbol
+	// typeArray represents the "array" value.
+	typeArray
+	// typeObject represents the "object" value.
+	typeObject
+	// typeFunction represents the "function" value.
+	typeFunction
+	// typeMap represents the "map" value.
+	typeMap
+	// typeSet represents the "set" value.
+	typeSet
+	// typeWeakmap represents the "weakmap" value.
+	typeWeakmap
+	// typeWeakset represents the "weakset" value.
+	typeWeakset
+	// typeError represents the "error" value.
+	typeError
+	// typeProxy represents the "proxy" value.
+	typeProxy
+	// typePromise represents the "promise" value.
+	typePromise
+	// typeTypedarray represents the "typedarray" value.
+	typeTypedarray
+	// typeArraybuffer represents the "arraybuffer" value.
+	typeArraybuffer
+	// typeNode represents the "node" value.
+	typeNode
+	// typeWindow represents the "window" value.
+	typeWindow
+	// typeGenerator represents the "generator" value.
+	typeGenerator
+)
+
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):     "",
+	typeUndefined:   "undefined",
+	typeNull:        "null",
+	typeString:      "string",
+	typeNumber:      "number",
+	typeBoolean:     "boolean",
+	typeBigint:      "bigint",
+	typeRegexp:      "regexp",
+	typeDate:        "date",
+	typeSymbol:      "symbol",
+	typeArray:       "array",
+	typeObject:      "object",
+	typeFunction:    "function",
+	typeMap:         "map",
+	typeSet:         "set",
+	typeWeakmap:     "weakmap",
+	typeWeakset:     "weakset",
+	typeError:       "error",
+	typeProxy:       "proxy",
+	typePromise:     "promise",
+	typeTypedarray:  "typedarray",
+	typeArraybuffer: "arraybuffer",
+	typeNode:        "node",
+	typeWindow:      "window",
+	typeGenerator:   "generator",
+})
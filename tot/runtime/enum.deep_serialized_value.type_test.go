@@ -0,0 +1,355 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumType(t *testing.T) {
+	var enum TypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Type.Undefined
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"undefined"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"undefined"`, result)
+	}
+	json.Unmarshal([]byte(`"undefined"`), &enum)
+	if Type.Undefined != enum {
+		t.Errorf("Expected %d, got %d", Type.Undefined, enum)
+	}
+
+	enum = Type.Null
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"null"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"null"`, result)
+	}
+	json.Unmarshal([]byte(`"null"`), &enum)
+	if Type.Null != enum {
+		t.Errorf("Expected %d, got %d", Type.Null, enum)
+	}
+
+	enum = Type.String
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"string"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"string"`, result)
+	}
+	json.Unmarshal([]byte(`"string"`), &enum)
+	if Type.String != enum {
+		t.Errorf("Expected %d, got %d", Type.String, enum)
+	}
+
+	enum = Type.Number
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"number"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"number"`, result)
+	}
+	json.Unmarshal([]byte(`"number"`), &enum)
+	if Type.Number != enum {
+		t.Errorf("Expected %d, got %d", Type.Number, enum)
+	}
+
+	enum = Type.Boolean
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"boolean"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"boolean"`, result)
+	}
+	json.Unmarshal([]byte(`"boolean"`), &enum)
+	if Type.Boolean != enum {
+		t.Errorf("Expected %d, got %d", Type.Boolean, enum)
+	}
+
+	enum = Type.Bigint
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bigint"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bigint"`, result)
+	}
+	json.Unmarshal([]byte(`"bigint"`), &enum)
+	if Type.Bigint != enum {
+		t.Errorf("Expected %d, got %d", Type.Bigint, enum)
+	}
+
+	enum = Type.Regexp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"regexp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"regexp"`, result)
+	}
+	json.Unmarshal([]byte(`"regexp"`), &enum)
+	if Type.Regexp != enum {
+		t.Errorf("Expected %d, got %d", Type.Regexp, enum)
+	}
+
+	enum = Type.Date
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"date"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"date"`, result)
+	}
+	json.Unmarshal([]byte(`"date"`), &enum)
+	if Type.Date != enum {
+		t.Errorf("Expected %d, got %d", Type.Date, enum)
+	}
+
+	enum = Type.Symbol
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"symbol"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"symbol"`, result)
+	}
+	json.Unmarshal([]byte(`"symbol"`), &enum)
+	if Type.Symbol != enum {
+		t.Errorf("Expected %d, got %d", Type.Symbol, enum)
+	}
+
+	enum = Type.Array
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"array"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"array"`, result)
+	}
+	json.Unmarshal([]byte(`"array"`), &enum)
+	if Type.Array != enum {
+		t.Errorf("Expected %d, got %d", Type.Array, enum)
+	}
+
+	enum = Type.Object
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"object"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"object"`, result)
+	}
+	json.Unmarshal([]byte(`"object"`), &enum)
+	if Type.Object != enum {
+		t.Errorf("Expected %d, got %d", Type.Object, enum)
+	}
+
+	enum = Type.Function
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"function"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"function"`, result)
+	}
+	json.Unmarshal([]byte(`"function"`), &enum)
+	if Type.Function != enum {
+		t.Errorf("Expected %d, got %d", Type.Function, enum)
+	}
+
+	enum = Type.Map
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"map"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"map"`, result)
+	}
+	json.Unmarshal([]byte(`"map"`), &enum)
+	if Type.Map != enum {
+		t.Errorf("Expected %d, got %d", Type.Map, enum)
+	}
+
+	enum = Type.Set
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"set"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"set"`, result)
+	}
+	json.Unmarshal([]byte(`"set"`), &enum)
+	if Type.Set != enum {
+		t.Errorf("Expected %d, got %d", Type.Set, enum)
+	}
+
+	enum = Type.Weakmap
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"weakmap"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"weakmap"`, result)
+	}
+	json.Unmarshal([]byte(`"weakmap"`), &enum)
+	if Type.Weakmap != enum {
+		t.Errorf("Expected %d, got %d", Type.Weakmap, enum)
+	}
+
+	enum = Type.Weakset
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"weakset"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"weakset"`, result)
+	}
+	json.Unmarshal([]byte(`"weakset"`), &enum)
+	if Type.Weakset != enum {
+		t.Errorf("Expected %d, got %d", Type.Weakset, enum)
+	}
+
+	enum = Type.Error
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"error"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"error"`, result)
+	}
+	json.Unmarshal([]byte(`"error"`), &enum)
+	if Type.Error != enum {
+		t.Errorf("Expected %d, got %d", Type.Error, enum)
+	}
+
+	enum = Type.Proxy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"proxy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"proxy"`, result)
+	}
+	json.Unmarshal([]byte(`"proxy"`), &enum)
+	if Type.Proxy != enum {
+		t.Errorf("Expected %d, got %d", Type.Proxy, enum)
+	}
+
+	enum = Type.Promise
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"promise"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"promise"`, result)
+	}
+	json.Unmarshal([]byte(`"promise"`), &enum)
+	if Type.Promise != enum {
+		t.Errorf("Expected %d, got %d", Type.Promise, enum)
+	}
+
+	enum = Type.Typedarray
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"typedarray"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"typedarray"`, result)
+	}
+	json.Unmarshal([]byte(`"typedarray"`), &enum)
+	if Type.Typedarray != enum {
+		t.Errorf("Expected %d, got %d", Type.Typedarray, enum)
+	}
+
+	enum = Type.Arraybuffer
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"arraybuffer"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"arraybuffer"`, result)
+	}
+	json.Unmarshal([]byte(`"arraybuffer"`), &enum)
+	if Type.Arraybuffer != enum {
+		t.Errorf("Expected %d, got %d", Type.Arraybuffer, enum)
+	}
+
+	enum = Type.Node
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"node"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"node"`, result)
+	}
+	json.Unmarshal([]byte(`"node"`), &enum)
+	if Type.Node != enum {
+		t.Errorf("Expected %d, got %d", Type.Node, enum)
+	}
+
+	enum = Type.Window
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"window"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"window"`, result)
+	}
+	json.Unmarshal([]byte(`"window"`), &enum)
+	if Type.Window != enum {
+		t.Errorf("Expected %d, got %d", Type.Window, enum)
+	}
+
+	enum = Type.Generator
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"generator"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"generator"`, result)
+	}
+	json.Unmarshal([]byte(`"generator"`), &enum)
+	if Type.Generator != enum {
+		t.Errorf("Expected %d, got %d", Type.Generator, enum)
+	}
+}
@@ -1,67 +1,87 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type objectSubtypeEnum struct {
-	Array      ObjectSubtypeEnum
-	Null       ObjectSubtypeEnum
-	Node       ObjectSubtypeEnum
-	Regexp     ObjectSubtypeEnum
-	Date       ObjectSubtypeEnum
-	Map        ObjectSubtypeEnum
-	Set        ObjectSubtypeEnum
-	Weakmap    ObjectSubtypeEnum
-	Weakset    ObjectSubtypeEnum
-	Iterator   ObjectSubtypeEnum
-	Generator  ObjectSubtypeEnum
-	Error      ObjectSubtypeEnum
-	Proxy      ObjectSubtypeEnum
-	Promise    ObjectSubtypeEnum
-	Typedarray ObjectSubtypeEnum
+	Array             ObjectSubtypeEnum
+	Null              ObjectSubtypeEnum
+	Node              ObjectSubtypeEnum
+	Regexp            ObjectSubtypeEnum
+	Date              ObjectSubtypeEnum
+	Map               ObjectSubtypeEnum
+	Set               ObjectSubtypeEnum
+	Weakmap           ObjectSubtypeEnum
+	Weakset           ObjectSubtypeEnum
+	Iterator          ObjectSubtypeEnum
+	Generator         ObjectSubtypeEnum
+	Error             ObjectSubtypeEnum
+	Proxy             ObjectSubtypeEnum
+	Promise           ObjectSubtypeEnum
+	Typedarray        ObjectSubtypeEnum
+	Arraybuffer       ObjectSubtypeEnum
+	Dataview          ObjectSubtypeEnum
+	Webassemblymemory ObjectSubtypeEnum
+	Wasmvalue         ObjectSubtypeEnum
 }
 
 /*
-ObjectSubtype provides named acces to the ObjectSubtypeEnum values.
+ObjectSubtype provides named access to the ObjectSubtypeEnum values.
 */
 var ObjectSubtype = objectSubtypeEnum{
-	Array:      objectSubtypeArray,
-	Null:       objectSubtypeNull,
-	Node:       objectSubtypeNode,
-	Regexp:     objectSubtypeRegexp,
-	Date:       objectSubtypeDate,
-	Map:        objectSubtypeMap,
-	Set:        objectSubtypeSet,
-	Weakmap:    objectSubtypeWeakmap,
-	Weakset:    objectSubtypeWeakset,
-	Iterator:   objectSubtypeIterator,
-	Generator:  objectSubtypeGenerator,
-	Error:      objectSubtypeError,
-	Proxy:      objectSubtypeProxy,
-	Promise:    objectSubtypePromise,
-	Typedarray: objectSubtypeTypedarray,
+	Array:             objectSubtypeArray,
+	Null:              objectSubtypeNull,
+	Node:              objectSubtypeNode,
+	Regexp:            objectSubtypeRegexp,
+	Date:              objectSubtypeDate,
+	Map:               objectSubtypeMap,
+	Set:               objectSubtypeSet,
+	Weakmap:           objectSubtypeWeakmap,
+	Weakset:           objectSubtypeWeakset,
+	Iterator:          objectSubtypeIterator,
+	Generator:         objectSubtypeGenerator,
+	Error:             objectSubtypeError,
+	Proxy:             objectSubtypeProxy,
+	Promise:           objectSubtypePromise,
+	Typedarray:        objectSubtypeTypedarray,
+	Arraybuffer:       objectSubtypeArraybuffer,
+	Dataview:          objectSubtypeDataview,
+	Webassemblymemory: objectSubtypeWebassemblymemory,
+	Wasmvalue:         objectSubtypeWasmvalue,
 }
 
 /*
-ObjectSubtypeEnum represents an object subtype hint. Specified for object type
-values only. Allowed values:
-	- ObjectSubtype.Array      "array"
-	- ObjectSubtype.Null       "null"
-	- ObjectSubtype.Node       "node"
-	- ObjectSubtype.Regexp     "regexp"
-	- ObjectSubtype.Date       "date"
-	- ObjectSubtype.Map        "map"
-	- ObjectSubtype.Set        "set"
-	- ObjectSubtype.Weakmap    "weakmap"
-	- ObjectSubtype.Weakset    "weakset"
-	- ObjectSubtype.Iterator   "iterator"
-	- ObjectSubtype.Generator  "generator"
-	- ObjectSubtype.Error      "error"
-	- ObjectSubtype.Proxy      "proxy"
-	- ObjectSubtype.Promise    "promise"
-	- ObjectSubtype.Typedarray "typedarray"
+ObjectSubtypeEnum represents the Runtime.RemoteObject.subtype values.
+
+Object subtype hint. Specified for `object` type values only. NOTE: If you
+change anything here, make sure to also update `subtype` in `ObjectPreview` and
+`PropertyPreview` below.
+
+Allowed values:
+  - ObjectSubtype.Array             "array"
+  - ObjectSubtype.Null              "null"
+  - ObjectSubtype.Node              "node"
+  - ObjectSubtype.Regexp            "regexp"
+  - ObjectSubtype.Date              "date"
+  - ObjectSubtype.Map               "map"
+  - ObjectSubtype.Set               "set"
+  - ObjectSubtype.Weakmap           "weakmap"
+  - ObjectSubtype.Weakset           "weakset"
+  - ObjectSubtype.Iterator          "iterator"
+  - ObjectSubtype.Generator         "generator"
+  - ObjectSubtype.Error             "error"
+  - ObjectSubtype.Proxy             "proxy"
+  - ObjectSubtype.Promise           "promise"
+  - ObjectSubtype.Typedarray        "typedarray"
+  - ObjectSubtype.Arraybuffer       "arraybuffer"
+  - ObjectSubtype.Dataview          "dataview"
+  - ObjectSubtype.Webassemblymemory "webassemblymemory"
+  - ObjectSubtype.Wasmvalue         "wasmvalue"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-RemoteObject
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ObjectPreview
@@ -73,7 +93,16 @@ type ObjectSubtypeEnum int
 String implements Stringer
 */
 func (enum ObjectSubtypeEnum) String() string {
-	return _objectSubtypeEnums[enum]
+	return _objectSubtypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ObjectSubtypeEnum) Unknown() bool {
+	return !_objectSubtypeEnums.Known(enum)
 }
 
 /*
@@ -84,25 +113,16 @@ func (enum ObjectSubtypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ObjectSubtypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _objectSubtypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _objectSubtypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -136,23 +156,35 @@ const (
 	objectSubtypePromise
 	// objectSubtypeTypedarray represents the "typedarray" value.
 	objectSubtypeTypedarray
+	// objectSubtypeArraybuffer represents the "arraybuffer" value.
+	objectSubtypeArraybuffer
+	// objectSubtypeDataview represents the "dataview" value.
+	objectSubtypeDataview
+	// objectSubtypeWebassemblymemory represents the "webassemblymemory" value.
+	objectSubtypeWebassemblymemory
+	// objectSubtypeWasmvalue represents the "wasmvalue" value.
+	objectSubtypeWasmvalue
 )
 
-var _objectSubtypeEnums = map[ObjectSubtypeEnum]string{
-	ObjectSubtypeEnum(0):    "",
-	objectSubtypeArray:      "array",
-	objectSubtypeNull:       "null",
-	objectSubtypeNode:       "node",
-	objectSubtypeRegexp:     "regexp",
-	objectSubtypeDate:       "date",
-	objectSubtypeMap:        "map",
-	objectSubtypeSet:        "set",
-	objectSubtypeWeakmap:    "weakmap",
-	objectSubtypeWeakset:    "weakset",
-	objectSubtypeIterator:   "iterator",
-	objectSubtypeGenerator:  "generator",
-	objectSubtypeError:      "error",
-	objectSubtypeProxy:      "proxy",
-	objectSubtypePromise:    "promise",
-	objectSubtypeTypedarray: "typedarray",
-}
+var _objectSubtypeEnums = enums.New(map[ObjectSubtypeEnum]string{
+	ObjectSubtypeEnum(0):           "",
+	objectSubtypeArray:             "array",
+	objectSubtypeNull:              "null",
+	objectSubtypeNode:              "node",
+	objectSubtypeRegexp:            "regexp",
+	objectSubtypeDate:              "date",
+	objectSubtypeMap:               "map",
+	objectSubtypeSet:               "set",
+	objectSubtypeWeakmap:           "weakmap",
+	objectSubtypeWeakset:           "weakset",
+	objectSubtypeIterator:          "iterator",
+	objectSubtypeGenerator:         "generator",
+	objectSubtypeError:             "error",
+	objectSubtypeProxy:             "proxy",
+	objectSubtypePromise:           "promise",
+	objectSubtypeTypedarray:        "typedarray",
+	objectSubtypeArraybuffer:       "arraybuffer",
+	objectSubtypeDataview:          "dataview",
+	objectSubtypeWebassemblymemory: "webassemblymemory",
+	objectSubtypeWasmvalue:         "wasmvalue",
+})
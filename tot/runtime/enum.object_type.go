@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type objectTypeEnum struct {
@@ -13,11 +16,12 @@ type objectTypeEnum struct {
 	Number    ObjectTypeEnum
 	Boolean   ObjectTypeEnum
 	Symbol    ObjectTypeEnum
+	Bigint    ObjectTypeEnum
 	Accessor  ObjectTypeEnum
 }
 
 /*
-ObjectType provides named acces to the ObjectTypeEnum values.
+ObjectType provides named access to the ObjectTypeEnum values.
 */
 var ObjectType = objectTypeEnum{
 	Object:    objectTypeObject,
@@ -27,20 +31,25 @@ var ObjectType = objectTypeEnum{
 	Number:    objectTypeNumber,
 	Boolean:   objectTypeBoolean,
 	Symbol:    objectTypeSymbol,
+	Bigint:    objectTypeBigint,
 	Accessor:  objectTypeAccessor,
 }
 
 /*
-ObjectTypeEnum represents the object type. For properties, "accessor" means that
-the property itself is an accessor property. Allowed values:
-	- ObjectType.Object    "object"
-	- ObjectType.Function  "function"
-	- ObjectType.Undefined "undefined"
-	- ObjectType.String    "string"
-	- ObjectType.Number    "number"
-	- ObjectType.Boolean   "boolean"
-	- ObjectType.Symbol    "symbol"
-	- ObjectType.Accessor  "accessor"
+ObjectTypeEnum represents the Runtime.RemoteObject.type values.
+
+Object type.
+
+Allowed values:
+  - ObjectType.Object    "object"
+  - ObjectType.Function  "function"
+  - ObjectType.Undefined "undefined"
+  - ObjectType.String    "string"
+  - ObjectType.Number    "number"
+  - ObjectType.Boolean   "boolean"
+  - ObjectType.Symbol    "symbol"
+  - ObjectType.Bigint    "bigint"
+  - ObjectType.Accessor  "accessor"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-RemoteObject
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-ObjectPreview
@@ -52,7 +61,16 @@ type ObjectTypeEnum int
 String implements Stringer
 */
 func (enum ObjectTypeEnum) String() string {
-	return _objectTypeEnums[enum]
+	return _objectTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ObjectTypeEnum) Unknown() bool {
+	return !_objectTypeEnums.Known(enum)
 }
 
 /*
@@ -63,25 +81,16 @@ func (enum ObjectTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *ObjectTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _objectTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _objectTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -99,11 +108,14 @@ const (
 	objectTypeBoolean
 	// objectTypeSymbol represents the "symbol" value.
 	objectTypeSymbol
+	// objectTypeBigint represents the "bigint" value.
+	objectTypeBigint
 	// objectTypeAccessor represents the "accessor" value.
 	objectTypeAccessor
 )
 
-var _objectTypeEnums = map[ObjectTypeEnum]string{
+var _objectTypeEnums = enums.New(map[ObjectTypeEnum]string{
+	ObjectTypeEnum(0):   "",
 	objectTypeObject:    "object",
 	objectTypeFunction:  "function",
 	objectTypeUndefined: "undefined",
@@ -111,5 +123,6 @@ var _objectTypeEnums = map[ObjectTypeEnum]string{
 	objectTypeNumber:    "number",
 	objectTypeBoolean:   "boolean",
 	objectTypeSymbol:    "symbol",
+	objectTypeBigint:    "bigint",
 	objectTypeAccessor:  "accessor",
-}
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSerialization(t *testing.T) {
+	var enum SerializationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SerializationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Serialization.Deep
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deep"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deep"`, result)
+	}
+	json.Unmarshal([]byte(`"deep"`), &enum)
+	if Serialization.Deep != enum {
+		t.Errorf("Expected %d, got %d", Serialization.Deep, enum)
+	}
+
+	enum = Serialization.JSON
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"json"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"json"`, result)
+	}
+	json.Unmarshal([]byte(`"json"`), &enum)
+	if Serialization.JSON != enum {
+		t.Errorf("Expected %d, got %d", Serialization.JSON, enum)
+	}
+
+	enum = Serialization.IDOnly
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"idOnly"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"idOnly"`, result)
+	}
+	json.Unmarshal([]byte(`"idOnly"`), &enum)
+	if Serialization.IDOnly != enum {
+		t.Errorf("Expected %d, got %d", Serialization.IDOnly, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type unserializableValueEnum struct {
@@ -13,7 +16,7 @@ type unserializableValueEnum struct {
 }
 
 /*
-UnserializableValue provides named acces to the UnserializableValueEnum values.
+UnserializableValue provides named access to the UnserializableValueEnum values.
 */
 var UnserializableValue = unserializableValueEnum{
 	Infinity:    unserializableValueInfinity,
@@ -23,12 +26,16 @@ var UnserializableValue = unserializableValueEnum{
 }
 
 /*
-UnserializableValueEnum is a primitive value which cannot be JSON-stringified.
+UnserializableValueEnum represents the Runtime.UnserializableValue values.
+
+Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`,
+`Infinity`, `-Infinity`, and bigint literals.
+
 Allowed values:
-	- UnserializableValue.Infinity    "Infinity"
-	- UnserializableValue.NaN         "NaN"
-	- UnserializableValue.NegInfinity "-Infinity"
-	- UnserializableValue.NegZero     "-0"
+  - UnserializableValue.Infinity    "Infinity"
+  - UnserializableValue.NaN         "NaN"
+  - UnserializableValue.NegInfinity "-Infinity"
+  - UnserializableValue.NegZero     "-0"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#type-UnserializableValue
 */
@@ -38,7 +45,16 @@ type UnserializableValueEnum int
 String implements Stringer
 */
 func (enum UnserializableValueEnum) String() string {
-	return _unserializableValueEnums[enum]
+	return _unserializableValueEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum UnserializableValueEnum) Unknown() bool {
+	return !_unserializableValueEnums.Known(enum)
 }
 
 /*
@@ -49,25 +65,16 @@ func (enum UnserializableValueEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *UnserializableValueEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _unserializableValueEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _unserializableValueEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -81,9 +88,10 @@ const (
 	unserializableValueNegZero
 )
 
-var _unserializableValueEnums = map[UnserializableValueEnum]string{
+var _unserializableValueEnums = enums.New(map[UnserializableValueEnum]string{
+	UnserializableValueEnum(0):     "",
 	unserializableValueInfinity:    "Infinity",
 	unserializableValueNaN:         "NaN",
 	unserializableValueNegInfinity: "-Infinity",
 	unserializableValueNegZero:     "-0",
-}
+})
@@ -1,5 +1,24 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package runtime
 
+/*
+BindingCalledEvent represents Runtime.bindingCalled event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-bindingCalled
+*/
+type BindingCalledEvent struct {
+	Name string `json:"name"`
+
+	Payload string `json:"payload"`
+
+	// Identifier of the context where the call was made.
+	ExecutionContextID ExecutionContextID `json:"executionContextId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 ConsoleAPICalledEvent represents Runtime.consoleAPICalled event data.
 
@@ -7,24 +26,24 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPI
 */
 type ConsoleAPICalledEvent struct {
 	// Type of the call. Allowed values:
-	//	- log
-	//	- debug
-	//	- info
-	//	- error
-	//	- warning
-	//	- dir
-	//	- dirxml
-	//	- table
-	//	- trace
-	//	- clear
-	//	- startGroup
-	//	- startGroupCollapsed
-	//	- endGroup
-	//	- assert
-	//	- profile
-	//	- profileEnd
-	//	- count
-	//	- timeEnd
+	//   - CallType.Log
+	//   - CallType.Debug
+	//   - CallType.Info
+	//   - CallType.Error
+	//   - CallType.Warning
+	//   - CallType.Dir
+	//   - CallType.Dirxml
+	//   - CallType.Table
+	//   - CallType.Trace
+	//   - CallType.Clear
+	//   - CallType.StartGroup
+	//   - CallType.StartGroupCollapsed
+	//   - CallType.EndGroup
+	//   - CallType.Assert
+	//   - CallType.Profile
+	//   - CallType.ProfileEnd
+	//   - CallType.Count
+	//   - CallType.TimeEnd
 	Type CallTypeEnum `json:"type"`
 
 	// Call arguments.
@@ -36,7 +55,10 @@ type ConsoleAPICalledEvent struct {
 	// Call timestamp.
 	Timestamp Timestamp `json:"timestamp"`
 
-	// Optional. Stack trace captured when the call was made.
+	// Optional. Stack trace captured when the call was made. The async stack chain
+	// is automatically reported for the following call types: `assert`, `error`,
+	// `trace`, `warning`. For other types the async call chain can be retrieved
+	// using `Debugger.getStackTrace` and `stackTrace.parentId` field.
 	StackTrace *StackTrace `json:"stackTrace,omitempty"`
 
 	// Optional. Console context descriptor for calls on non-default console
@@ -57,7 +79,7 @@ type ExceptionRevokedEvent struct {
 	// Reason describing why exception was revoked.
 	Reason string `json:"reason"`
 
-	// The ID of revoked exception, as reported in exceptionThrown.
+	// The id of revoked exception, as reported in `exceptionThrown`.
 	ExceptionID int `json:"exceptionId"`
 
 	// Error information related to this event
@@ -73,7 +95,6 @@ type ExceptionThrownEvent struct {
 	// Timestamp of the exception.
 	Timestamp Timestamp `json:"timestamp"`
 
-	// Exception details.
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
 
 	// Error information related to this event
@@ -99,9 +120,12 @@ ExecutionContextDestroyedEvent represents Runtime.executionContextDestroyed even
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextDestroyed
 */
 type ExecutionContextDestroyedEvent struct {
-	// ID of the destroyed context.
+	// Id of the destroyed context DEPRECATED.
 	ExecutionContextID ExecutionContextID `json:"executionContextId"`
 
+	// Unique Id of the destroyed context EXPERIMENTAL.
+	ExecutionContextUniqueID string `json:"executionContextUniqueId"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -122,11 +146,12 @@ InspectRequestedEvent represents Runtime.inspectRequested event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-inspectRequested
 */
 type InspectRequestedEvent struct {
-	// Remote object.
 	Object *RemoteObject `json:"object"`
 
-	// Hints.
-	Hints map[string]string `json:"hints"`
+	Hints map[string]interface{} `json:"hints"`
+
+	// Optional. Identifier of the context where the call was made. EXPERIMENTAL.
+	ExecutionContextID ExecutionContextID `json:"executionContextId,omitempty"`
 
 	// Error information related to this event
 	Err error `json:"-"`
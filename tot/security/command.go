@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 /*
@@ -21,47 +23,46 @@ type EnableResult struct {
 }
 
 /*
-HandleCertificateErrorParams represents Security.handleCertificateError parameters.
+SetIgnoreCertificateErrorsParams represents Security.setIgnoreCertificateErrors parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
 */
-type HandleCertificateErrorParams struct {
-	// The ID of the event.
-	EventID int `json:"eventId"`
-
-	// The action to take on the certificate error. Allowed values:
-	//	- CertificateErrorAction.Continue
-	//	- CertificateErrorAction.Cancel
-	Action CertificateErrorActionEnum `json:"action"`
+type SetIgnoreCertificateErrorsParams struct {
+	// If true, all certificate errors will be ignored.
+	Ignore bool `json:"ignore"`
 }
 
 /*
-HandleCertificateErrorResult represents the result of calls to Security.handleCertificateError.
+SetIgnoreCertificateErrorsResult represents the result of calls to Security.setIgnoreCertificateErrors.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
 */
-type HandleCertificateErrorResult struct {
+type SetIgnoreCertificateErrorsResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-SetIgnoreCertificateErrorsParams represents Security.setIgnoreCertificateErrors parameters.
+HandleCertificateErrorParams represents Security.handleCertificateError parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
 */
-type SetIgnoreCertificateErrorsParams struct {
-	// If true, all certificate errors will be ignored.
-	Ignore bool `json:"ignore"`
+type HandleCertificateErrorParams struct {
+	// The ID of the event.
+	EventID int `json:"eventId"`
+
+	// The action to take on the certificate error. Allowed values:
+	//   - CertificateErrorAction.Continue
+	//   - CertificateErrorAction.Cancel
+	Action CertificateErrorActionEnum `json:"action"`
 }
 
 /*
-SetIgnoreCertificateErrorsResult represents the result of calls to
-Security.setIgnoreCertificateErrors.
+HandleCertificateErrorResult represents the result of calls to Security.handleCertificateError.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
 */
-type SetIgnoreCertificateErrorsResult struct {
+type HandleCertificateErrorResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -77,8 +78,7 @@ type SetOverrideCertificateErrorsParams struct {
 }
 
 /*
-SetOverrideCertificateErrorsResult represents the result of calls to
-Security.setOverrideCertificateErrors.
+SetOverrideCertificateErrorsResult represents the result of calls to Security.setOverrideCertificateErrors.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setOverrideCertificateErrors
 */
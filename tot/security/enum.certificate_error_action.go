@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type certificateErrorActionEnum struct {
@@ -11,7 +14,7 @@ type certificateErrorActionEnum struct {
 }
 
 /*
-CertificateErrorAction provides named acces to the CertificateErrorActionEnum values.
+CertificateErrorAction provides named access to the CertificateErrorActionEnum values.
 */
 var CertificateErrorAction = certificateErrorActionEnum{
 	Continue: certificateErrorActionContinue,
@@ -19,11 +22,15 @@ var CertificateErrorAction = certificateErrorActionEnum{
 }
 
 /*
-CertificateErrorActionEnum describes the action to take when a certificate error
-occurs. 'continue' will continue processing the request and 'cancel' will cancel
-the request. Allowed values:
-	- CertificateErrorAction.Continue "continue"
-	- CertificateErrorAction.Cancel   "cancel"
+CertificateErrorActionEnum represents the Security.CertificateErrorAction
+values.
+
+The action to take when a certificate error occurs. continue will continue
+processing the request and cancel will cancel the request.
+
+Allowed values:
+  - CertificateErrorAction.Continue "continue"
+  - CertificateErrorAction.Cancel   "cancel"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#type-CertificateErrorAction
 */
@@ -33,7 +40,16 @@ type CertificateErrorActionEnum int
 String implements Stringer
 */
 func (enum CertificateErrorActionEnum) String() string {
-	return _certificateErrorActionEnums[enum]
+	return _certificateErrorActionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum CertificateErrorActionEnum) Unknown() bool {
+	return !_certificateErrorActionEnums.Known(enum)
 }
 
 /*
@@ -44,25 +60,16 @@ func (enum CertificateErrorActionEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *CertificateErrorActionEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _certificateErrorActionEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _certificateErrorActionEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -72,7 +79,8 @@ const (
 	certificateErrorActionCancel
 )
 
-var _certificateErrorActionEnums = map[CertificateErrorActionEnum]string{
+var _certificateErrorActionEnums = enums.New(map[CertificateErrorActionEnum]string{
+	CertificateErrorActionEnum(0):  "",
 	certificateErrorActionContinue: "continue",
 	certificateErrorActionCancel:   "cancel",
-}
+})
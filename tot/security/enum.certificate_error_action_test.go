@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
@@ -11,8 +13,24 @@ func TestEnumCertificateErrorAction(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown CertificateErrorActionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumCertificateErrorAction(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"continue"` != string(result) {
-		t.Errorf("Expected '\"continue\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"continue"`, result)
 	}
 	json.Unmarshal([]byte(`"continue"`), &enum)
 	if CertificateErrorAction.Continue != enum {
-		t.Errorf("Expcected %d, got %d", CertificateErrorAction.Continue, enum)
+		t.Errorf("Expected %d, got %d", CertificateErrorAction.Continue, enum)
 	}
 
 	enum = CertificateErrorAction.Cancel
@@ -42,10 +60,10 @@ func TestEnumCertificateErrorAction(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"cancel"` != string(result) {
-		t.Errorf("Expected '\"cancel\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"cancel"`, result)
 	}
 	json.Unmarshal([]byte(`"cancel"`), &enum)
 	if CertificateErrorAction.Cancel != enum {
-		t.Errorf("Expcected %d, got %d", CertificateErrorAction.Cancel, enum)
+		t.Errorf("Expected %d, got %d", CertificateErrorAction.Cancel, enum)
 	}
 }
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type mixedContentTypeEnum struct {
@@ -12,7 +15,7 @@ type mixedContentTypeEnum struct {
 }
 
 /*
-MixedContentType provides named acces to the MixedContentTypeEnum values.
+MixedContentType provides named access to the MixedContentTypeEnum values.
 */
 var MixedContentType = mixedContentTypeEnum{
 	Blockable:           mixedContentTypeBlockable,
@@ -21,12 +24,15 @@ var MixedContentType = mixedContentTypeEnum{
 }
 
 /*
-MixedContentTypeEnum is a description of mixed content (HTTP resources on HTTPS
-pages), as defined by https://www.w3.org/TR/mixed-content/#categories
+MixedContentTypeEnum represents the Security.MixedContentType values.
+
+A description of mixed content (HTTP resources on HTTPS pages), as defined by
+https://www.w3.org/TR/mixed-content/#categories
+
 Allowed values:
-	- MixedContentType.Blockable           "blockable"
-	- MixedContentType.OptionallyBlockable "optionally-blockable"
-	- MixedContentType.None                "none"
+  - MixedContentType.Blockable           "blockable"
+  - MixedContentType.OptionallyBlockable "optionally-blockable"
+  - MixedContentType.None                "none"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#type-MixedContentType
 */
@@ -36,7 +42,16 @@ type MixedContentTypeEnum int
 String implements Stringer
 */
 func (enum MixedContentTypeEnum) String() string {
-	return _mixedContentTypeEnums[enum]
+	return _mixedContentTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum MixedContentTypeEnum) Unknown() bool {
+	return !_mixedContentTypeEnums.Known(enum)
 }
 
 /*
@@ -47,25 +62,16 @@ func (enum MixedContentTypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *MixedContentTypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _mixedContentTypeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _mixedContentTypeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -77,8 +83,9 @@ const (
 	mixedContentTypeNone
 )
 
-var _mixedContentTypeEnums = map[MixedContentTypeEnum]string{
+var _mixedContentTypeEnums = enums.New(map[MixedContentTypeEnum]string{
+	MixedContentTypeEnum(0):             "",
 	mixedContentTypeBlockable:           "blockable",
 	mixedContentTypeOptionallyBlockable: "optionally-blockable",
 	mixedContentTypeNone:                "none",
-}
+})
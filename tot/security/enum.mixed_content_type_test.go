@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
@@ -11,8 +13,24 @@ func TestEnumMixedContentType(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MixedContentTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumMixedContentType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"blockable"` != string(result) {
-		t.Errorf("Expected '\"blockable\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"blockable"`, result)
 	}
 	json.Unmarshal([]byte(`"blockable"`), &enum)
 	if MixedContentType.Blockable != enum {
-		t.Errorf("Expcected %d, got %d", MixedContentType.Blockable, enum)
+		t.Errorf("Expected %d, got %d", MixedContentType.Blockable, enum)
 	}
 
 	enum = MixedContentType.OptionallyBlockable
@@ -42,11 +60,11 @@ func TestEnumMixedContentType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"optionally-blockable"` != string(result) {
-		t.Errorf("Expected '\"optionally-blockable\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"optionally-blockable"`, result)
 	}
 	json.Unmarshal([]byte(`"optionally-blockable"`), &enum)
 	if MixedContentType.OptionallyBlockable != enum {
-		t.Errorf("Expcected %d, got %d", MixedContentType.OptionallyBlockable, enum)
+		t.Errorf("Expected %d, got %d", MixedContentType.OptionallyBlockable, enum)
 	}
 
 	enum = MixedContentType.None
@@ -55,10 +73,10 @@ func TestEnumMixedContentType(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"none"` != string(result) {
-		t.Errorf("Expected '\"none\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
 	}
 	json.Unmarshal([]byte(`"none"`), &enum)
 	if MixedContentType.None != enum {
-		t.Errorf("Expcected %d, got %d", MixedContentType.None, enum)
+		t.Errorf("Expected %d, got %d", MixedContentType.None, enum)
 	}
 }
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package security
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type safetyTipStatusEnum struct {
+	BadReputation SafetyTipStatusEnum
+	Lookalike     SafetyTipStatusEnum
+}
+
+/*
+SafetyTipStatus provides named access to the SafetyTipStatusEnum values.
+*/
+var SafetyTipStatus = safetyTipStatusEnum{
+	BadReputation: safetyTipStatusBadReputation,
+	Lookalike:     safetyTipStatusLookalike,
+}
+
+/*
+SafetyTipStatusEnum represents the Security.SafetyTipStatus values.
+
+Allowed values:
+  - SafetyTipStatus.BadReputation "badReputation"
+  - SafetyTipStatus.Lookalike     "lookalike"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#type-SafetyTipStatus
+*/
+type SafetyTipStatusEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SafetyTipStatusEnum) String() string {
+	return _safetyTipStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SafetyTipStatusEnum) Unknown() bool {
+	return !_safetyTipStatusEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SafetyTipStatusEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SafetyTipStatusEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _safetyTipStatusEnums.Parse(val)
+	return nil
+}
+
+const (
+	// safetyTipStatusBadReputation represents the "badReputation" value.
+	safetyTipStatusBadReputation SafetyTipStatusEnum = iota + 1
+	// safetyTipStatusLookalike represents the "lookalike" value.
+	safetyTipStatusLookalike
+)
+
+var _safetyTipStatusEnums = enums.New(map[SafetyTipStatusEnum]string{
+	SafetyTipStatusEnum(0):       "",
+	safetyTipStatusBadReputation: "badReputation",
+	safetyTipStatusLookalike:     "lookalike",
+})
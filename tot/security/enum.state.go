@@ -1,36 +1,46 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type stateEnum struct {
-	Unknown  StateEnum
-	Neutral  StateEnum
-	Insecure StateEnum
-	Secure   StateEnum
-	Info     StateEnum
+	Unknown        StateEnum
+	Neutral        StateEnum
+	Insecure       StateEnum
+	Secure         StateEnum
+	Info           StateEnum
+	InsecureBroken StateEnum
 }
 
 /*
-State provides named acces to the StateEnum values.
+State provides named access to the StateEnum values.
 */
 var State = stateEnum{
-	Unknown:  stateUnknown,
-	Neutral:  stateNeutral,
-	Insecure: stateInsecure,
-	Secure:   stateSecure,
-	Info:     stateInfo,
+	Unknown:        stateUnknown,
+	Neutral:        stateNeutral,
+	Insecure:       stateInsecure,
+	Secure:         stateSecure,
+	Info:           stateInfo,
+	InsecureBroken: stateInsecureBroken,
 }
 
 /*
-StateEnum is the security level of a page or resource. Allowed values:
-	- State.Unknown  "unknown"
-	- State.Neutral  "neutral"
-	- State.Insecure "insecure"
-	- State.Secure   "secure"
-	- State.Info     "info"
+StateEnum represents the Security.SecurityState values.
+
+The security level of a page or resource.
+
+Allowed values:
+  - State.Unknown        "unknown"
+  - State.Neutral        "neutral"
+  - State.Insecure       "insecure"
+  - State.Secure         "secure"
+  - State.Info           "info"
+  - State.InsecureBroken "insecure-broken"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#type-SecurityState
 */
@@ -40,7 +50,16 @@ type StateEnum int
 String implements Stringer
 */
 func (enum StateEnum) String() string {
-	return _stateEnums[enum]
+	return _stateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StateEnum) Unknown() bool {
+	return !_stateEnums.Known(enum)
 }
 
 /*
@@ -51,25 +70,16 @@ func (enum StateEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *StateEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _stateEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _stateEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -83,12 +93,16 @@ const (
 	stateSecure
 	// stateInfo represents the "info" value.
 	stateInfo
+	// stateInsecureBroken represents the "insecure-broken" value.
+	stateInsecureBroken
 )
 
-var _stateEnums = map[StateEnum]string{
-	stateUnknown:  "unknown",
-	stateNeutral:  "neutral",
-	stateInsecure: "insecure",
-	stateSecure:   "secure",
-	stateInfo:     "info",
-}
+var _stateEnums = enums.New(map[StateEnum]string{
+	StateEnum(0):        "",
+	stateUnknown:        "unknown",
+	stateNeutral:        "neutral",
+	stateInsecure:       "insecure",
+	stateSecure:         "secure",
+	stateInfo:           "info",
+	stateInsecureBroken: "insecure-broken",
+})
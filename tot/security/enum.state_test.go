@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 import (
@@ -11,8 +13,24 @@ func TestEnumState(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumState(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"unknown"` != string(result) {
-		t.Errorf("Expected '\"unknown\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"unknown"`, result)
 	}
 	json.Unmarshal([]byte(`"unknown"`), &enum)
 	if State.Unknown != enum {
-		t.Errorf("Expcected %d, got %d", State.Unknown, enum)
+		t.Errorf("Expected %d, got %d", State.Unknown, enum)
 	}
 
 	enum = State.Neutral
@@ -42,11 +60,11 @@ func TestEnumState(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"neutral"` != string(result) {
-		t.Errorf("Expected '\"neutral\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"neutral"`, result)
 	}
 	json.Unmarshal([]byte(`"neutral"`), &enum)
 	if State.Neutral != enum {
-		t.Errorf("Expcected %d, got %d", State.Neutral, enum)
+		t.Errorf("Expected %d, got %d", State.Neutral, enum)
 	}
 
 	enum = State.Insecure
@@ -55,18 +73,12 @@ func TestEnumState(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"insecure"` != string(result) {
-		t.Errorf("Expected '\"insecure\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"insecure"`, result)
 	}
 	json.Unmarshal([]byte(`"insecure"`), &enum)
 	if State.Insecure != enum {
-		t.Errorf("Expcected %d, got %d", State.Insecure, enum)
+		t.Errorf("Expected %d, got %d", State.Insecure, enum)
 	}
-}
-
-func TestEnumState2(t *testing.T) {
-	var enum StateEnum
-	var err error
-	var result []byte
 
 	enum = State.Secure
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumState2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"secure"` != string(result) {
-		t.Errorf("Expected '\"secure\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"secure"`, result)
 	}
 	json.Unmarshal([]byte(`"secure"`), &enum)
 	if State.Secure != enum {
-		t.Errorf("Expcected %d, got %d", State.Secure, enum)
+		t.Errorf("Expected %d, got %d", State.Secure, enum)
 	}
 
 	enum = State.Info
@@ -87,10 +99,23 @@ func TestEnumState2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"info"` != string(result) {
-		t.Errorf("Expected '\"info\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"info"`, result)
 	}
 	json.Unmarshal([]byte(`"info"`), &enum)
 	if State.Info != enum {
-		t.Errorf("Expcected %d, got %d", State.Info, enum)
+		t.Errorf("Expected %d, got %d", State.Info, enum)
+	}
+
+	enum = State.InsecureBroken
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insecure-broken"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insecure-broken"`, result)
+	}
+	json.Unmarshal([]byte(`"insecure-broken"`), &enum)
+	if State.InsecureBroken != enum {
+		t.Errorf("Expected %d, got %d", State.InsecureBroken, enum)
 	}
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package security
 
 /*
@@ -19,6 +21,19 @@ type CertificateErrorEvent struct {
 	Err error `json:"-"`
 }
 
+/*
+VisibleSecurityStateChangedEvent represents Security.visibleSecurityStateChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-visibleSecurityStateChanged
+*/
+type VisibleSecurityStateChangedEvent struct {
+	// Security state information about the page.
+	VisibleSecurityState *VisibleSecurityState `json:"visibleSecurityState"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 StateChangedEvent represents Security.securityStateChanged event data.
 
@@ -26,25 +41,27 @@ https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-securityS
 */
 type StateChangedEvent struct {
 	// Security state. Allowed values:
-	//	- State.Unknown
-	//	- State.Neutral
-	//	- State.Insecure
-	//	- State.Secure
-	//	- State.Info
+	//   - State.Unknown
+	//   - State.Neutral
+	//   - State.Insecure
+	//   - State.Secure
+	//   - State.Info
+	//   - State.InsecureBroken
 	State StateEnum `json:"securityState"`
 
 	// True if the page was loaded over cryptographic transport such as HTTPS.
+	// DEPRECATED.
 	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
 
-	// List of explanations for the security state. If the overall security
-	// state is `insecure` or `warning`, at least one corresponding explanation
-	// should be included.
+	// Previously a list of explanations for the security state. Now always empty.
+	// DEPRECATED.
 	Explanations []*StateExplanation `json:"explanations"`
 
-	// Information about insecure content on the page.
+	// Information about insecure content on the page. DEPRECATED.
 	InsecureContentStatus *InsecureContentStatus `json:"insecureContentStatus"`
 
-	// Optional. Overrides user-visible description of the state.
+	// Optional. Overrides user-visible description of the state. Always omitted.
+	// DEPRECATED.
 	Summary string `json:"summary,omitempty"`
 
 	// Error information related to this event
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package worker provides type definitions for use with the Chrome ServiceWorker protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/
 */
 package worker
@@ -10,78 +14,92 @@ import (
 )
 
 /*
-Registration is a ServiceWorker registration.
+RegistrationID represents the ServiceWorker.RegistrationID type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-RegistrationID
+*/
+type RegistrationID string
+
+/*
+Registration represents the ServiceWorker.ServiceWorkerRegistration type.
+
+ServiceWorker registration.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-ServiceWorkerRegistration
 */
 type Registration struct {
-	// desc.
-	RegistrationID string `json:"registrationId"`
+	RegistrationID RegistrationID `json:"registrationId"`
 
-	// desc.
 	ScopeURL string `json:"scopeURL"`
 
-	// desc.
 	IsDeleted bool `json:"isDeleted"`
 }
 
 /*
-Version is the ServiceWorker version.
+Version represents the ServiceWorker.ServiceWorkerVersion type.
+
+ServiceWorker version.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-ServiceWorkerVersion
 */
 type Version struct {
-	// versionId.
 	VersionID string `json:"versionId"`
 
-	// registrationId.
-	RegistrationID string `json:"registrationId"`
+	RegistrationID RegistrationID `json:"registrationId"`
 
-	// scriptURL.
 	ScriptURL string `json:"scriptURL"`
 
-	// runningStatus.
+	// Allowed values:
+	//   - VersionRunningStatus.Stopped
+	//   - VersionRunningStatus.Starting
+	//   - VersionRunningStatus.Running
+	//   - VersionRunningStatus.Stopping
 	RunningStatus VersionRunningStatusEnum `json:"runningStatus"`
 
-	// status.
+	// Allowed values:
+	//   - VersionStatus.New
+	//   - VersionStatus.Installing
+	//   - VersionStatus.Installed
+	//   - VersionStatus.Activating
+	//   - VersionStatus.Activated
+	//   - VersionStatus.Redundant
 	Status VersionStatusEnum `json:"status"`
 
 	// Optional. The Last-Modified header value of the main script.
-	ScriptLastModified int64 `json:"scriptLastModified,omitempty"`
+	ScriptLastModified float64 `json:"scriptLastModified,omitempty"`
 
 	// Optional. The time at which the response headers of the main script were
 	// received from the server. For cached script it is the last time the cache
 	// entry was validated.
-	ScriptResponseTime int64 `json:"scriptResponseTime,omitempty"`
+	ScriptResponseTime float64 `json:"scriptResponseTime,omitempty"`
 
-	// Optional. controlledClients.
+	// Optional.
 	ControlledClients []target.ID `json:"controlledClients,omitempty"`
 
-	// Optional. targetId.
+	// Optional.
 	TargetID target.ID `json:"targetId,omitempty"`
+
+	// Optional.
+	RouterRules string `json:"routerRules,omitempty"`
 }
 
 /*
-ErrorMessage is a ServiceWorker error message.
+ErrorMessage represents the ServiceWorker.ServiceWorkerErrorMessage type.
+
+ServiceWorker error message.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-ServiceWorkerErrorMessage
 */
 type ErrorMessage struct {
-	// desc.
 	ErrorMessage string `json:"errorMessage"`
 
-	// desc.
-	RegistrationID string `json:"registrationId"`
+	RegistrationID RegistrationID `json:"registrationId"`
 
-	// desc.
 	VersionID string `json:"versionId"`
 
-	// desc.
 	SourceURL string `json:"sourceURL"`
 
-	// desc.
 	LineNumber int `json:"lineNumber"`
 
-	// desc.
 	ColumnNumber int `json:"columnNumber"`
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package worker
 
 /*
@@ -6,13 +8,10 @@ DeliverPushMessageParams represents ServiceWorker.deliverPushMessage parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-deliverPushMessage
 */
 type DeliverPushMessageParams struct {
-	// Origin.
 	Origin string `json:"origin"`
 
-	// Registration ID.
-	RegistrationID string `json:"registrationId"`
+	RegistrationID RegistrationID `json:"registrationId"`
 
-	// Data.
 	Data string `json:"data"`
 }
 
@@ -42,16 +41,12 @@ DispatchSyncEventParams represents ServiceWorker.dispatchSyncEvent parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchSyncEvent
 */
 type DispatchSyncEventParams struct {
-	// Origin.
 	Origin string `json:"origin"`
 
-	// Registration ID.
-	RegistrationID string `json:"registrationId"`
+	RegistrationID RegistrationID `json:"registrationId"`
 
-	// Tag.
 	Tag string `json:"tag"`
 
-	// Last chance.
 	LastChance bool `json:"lastChance"`
 }
 
@@ -66,31 +61,34 @@ type DispatchSyncEventResult struct {
 }
 
 /*
-EnableResult represents the result of calls to ServiceWorker.enable.
+DispatchPeriodicSyncEventParams represents ServiceWorker.dispatchPeriodicSyncEvent parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-enable
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchPeriodicSyncEvent
 */
-type EnableResult struct {
-	// Error information related to executing this method
-	Err error `json:"-"`
+type DispatchPeriodicSyncEventParams struct {
+	Origin string `json:"origin"`
+
+	RegistrationID RegistrationID `json:"registrationId"`
+
+	Tag string `json:"tag"`
 }
 
 /*
-InspectWorkerParams represents ServiceWorker.inspectWorker parameters.
+DispatchPeriodicSyncEventResult represents the result of calls to ServiceWorker.dispatchPeriodicSyncEvent.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchPeriodicSyncEvent
 */
-type InspectWorkerParams struct {
-	// Version ID.
-	VersionID string `json:"versionId"`
+type DispatchPeriodicSyncEventResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
 }
 
 /*
-InspectWorkerResult represents the result of calls to ServiceWorker.inspectWorker.
+EnableResult represents the result of calls to ServiceWorker.enable.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-enable
 */
-type InspectWorkerResult struct {
+type EnableResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -101,13 +99,11 @@ SetForceUpdateOnPageLoadParams represents ServiceWorker.setForceUpdateOnPageLoad
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-setForceUpdateOnPageLoad
 */
 type SetForceUpdateOnPageLoadParams struct {
-	// Force update on page load.
 	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
 }
 
 /*
-SetForceUpdateOnPageLoadResult represents the result of calls to
-ServiceWorker.setForceUpdateOnPageLoad.
+SetForceUpdateOnPageLoadResult represents the result of calls to ServiceWorker.setForceUpdateOnPageLoad.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-setForceUpdateOnPageLoad
 */
@@ -122,7 +118,6 @@ SkipWaitingParams represents ServiceWorker.skipWaiting parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-skipWaiting
 */
 type SkipWaitingParams struct {
-	// Scope URL.
 	ScopeURL string `json:"scopeURL"`
 }
 
@@ -142,7 +137,6 @@ StartWorkerParams represents ServiceWorker.startWorker parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-startWorker
 */
 type StartWorkerParams struct {
-	// Scope URL.
 	ScopeURL string `json:"scopeURL"`
 }
 
@@ -172,7 +166,6 @@ StopWorkerParams represents ServiceWorker.stopWorker parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-stopWorker
 */
 type StopWorkerParams struct {
-	// Version ID.
 	VersionID string `json:"versionId"`
 }
 
@@ -192,7 +185,6 @@ UnregisterParams represents ServiceWorker.unregister parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-unregister
 */
 type UnregisterParams struct {
-	// Scope URL.
 	ScopeURL string `json:"scopeURL"`
 }
 
@@ -212,7 +204,6 @@ UpdateRegistrationParams represents ServiceWorker.updateRegistration parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-updateRegistration
 */
 type UpdateRegistrationParams struct {
-	// Scope URL.
 	ScopeURL string `json:"scopeURL"`
 }
 
@@ -225,3 +216,23 @@ type UpdateRegistrationResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+InspectWorkerParams represents ServiceWorker.inspectWorker parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+*/
+type InspectWorkerParams struct {
+	// Version ID.
+	VersionID string `json:"versionId"`
+}
+
+/*
+InspectWorkerResult represents the result of calls to ServiceWorker.inspectWorker.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+*/
+type InspectWorkerResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
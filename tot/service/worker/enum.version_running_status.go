@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package worker
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type versionRunningStatusEnum struct {
@@ -13,8 +16,7 @@ type versionRunningStatusEnum struct {
 }
 
 /*
-VersionRunningStatus provides named acces to the VersionRunningStatusEnum
-values.
+VersionRunningStatus provides named access to the VersionRunningStatusEnum values.
 */
 var VersionRunningStatus = versionRunningStatusEnum{
 	Stopped:  versionRunningStatusStopped,
@@ -24,11 +26,14 @@ var VersionRunningStatus = versionRunningStatusEnum{
 }
 
 /*
-VersionRunningStatusEnum is the version running status. Allowed values:
-	- VersionRunningStatus.Stopped  "stopped"
-	- VersionRunningStatus.Starting "starting"
-	- VersionRunningStatus.Running  "running"
-	- VersionRunningStatus.Stopping "stopping"
+VersionRunningStatusEnum represents the
+ServiceWorker.ServiceWorkerVersionRunningStatus values.
+
+Allowed values:
+  - VersionRunningStatus.Stopped  "stopped"
+  - VersionRunningStatus.Starting "starting"
+  - VersionRunningStatus.Running  "running"
+  - VersionRunningStatus.Stopping "stopping"
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-ServiceWorkerVersionRunningStatus
 */
@@ -38,7 +43,16 @@ type VersionRunningStatusEnum int
 String implements Stringer
 */
 func (enum VersionRunningStatusEnum) String() string {
-	return _versionRunningStatusEnums[enum]
+	return _versionRunningStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum VersionRunningStatusEnum) Unknown() bool {
+	return !_versionRunningStatusEnums.Known(enum)
 }
 
 /*
@@ -49,25 +63,16 @@ func (enum VersionRunningStatusEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *VersionRunningStatusEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _versionRunningStatusEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _versionRunningStatusEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -81,9 +86,10 @@ const (
 	versionRunningStatusStopping
 )
 
-var _versionRunningStatusEnums = map[VersionRunningStatusEnum]string{
+var _versionRunningStatusEnums = enums.New(map[VersionRunningStatusEnum]string{
+	VersionRunningStatusEnum(0):  "",
 	versionRunningStatusStopped:  "stopped",
 	versionRunningStatusStarting: "starting",
 	versionRunningStatusRunning:  "running",
 	versionRunningStatusStopping: "stopping",
-}
+})
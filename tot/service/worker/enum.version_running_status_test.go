@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package worker
 
 import (
@@ -11,8 +13,24 @@ func TestEnumVersionRunningStatus(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown VersionRunningStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumVersionRunningStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"stopped"` != string(result) {
-		t.Errorf("Expected '\"stopped\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"stopped"`, result)
 	}
 	json.Unmarshal([]byte(`"stopped"`), &enum)
 	if VersionRunningStatus.Stopped != enum {
-		t.Errorf("Expcected %d, got %d", VersionRunningStatus.Stopped, enum)
+		t.Errorf("Expected %d, got %d", VersionRunningStatus.Stopped, enum)
 	}
 
 	enum = VersionRunningStatus.Starting
@@ -42,11 +60,11 @@ func TestEnumVersionRunningStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"starting"` != string(result) {
-		t.Errorf("Expected '\"starting\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"starting"`, result)
 	}
 	json.Unmarshal([]byte(`"starting"`), &enum)
 	if VersionRunningStatus.Starting != enum {
-		t.Errorf("Expcected %d, got %d", VersionRunningStatus.Starting, enum)
+		t.Errorf("Expected %d, got %d", VersionRunningStatus.Starting, enum)
 	}
 
 	enum = VersionRunningStatus.Running
@@ -55,18 +73,12 @@ func TestEnumVersionRunningStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"running"` != string(result) {
-		t.Errorf("Expected '\"running\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"running"`, result)
 	}
 	json.Unmarshal([]byte(`"running"`), &enum)
 	if VersionRunningStatus.Running != enum {
-		t.Errorf("Expcected %d, got %d", VersionRunningStatus.Running, enum)
+		t.Errorf("Expected %d, got %d", VersionRunningStatus.Running, enum)
 	}
-}
-
-func TestEnumVersionRunningStatus2(t *testing.T) {
-	var enum VersionRunningStatusEnum
-	var err error
-	var result []byte
 
 	enum = VersionRunningStatus.Stopping
 	result, err = json.Marshal(enum)
@@ -74,10 +86,10 @@ func TestEnumVersionRunningStatus2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"stopping"` != string(result) {
-		t.Errorf("Expected '\"stopping\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"stopping"`, result)
 	}
 	json.Unmarshal([]byte(`"stopping"`), &enum)
 	if VersionRunningStatus.Stopping != enum {
-		t.Errorf("Expcected %d, got %d", VersionRunningStatus.Stopping, enum)
+		t.Errorf("Expected %d, got %d", VersionRunningStatus.Stopping, enum)
 	}
 }
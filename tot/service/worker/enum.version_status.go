@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package worker
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type versionStatusEnum struct {
@@ -15,7 +18,7 @@ type versionStatusEnum struct {
 }
 
 /*
-VersionStatus provides named acces to the VersionStatusEnum values.
+VersionStatus provides named access to the VersionStatusEnum values.
 */
 var VersionStatus = versionStatusEnum{
 	New:        versionStatusNew,
@@ -27,13 +30,16 @@ var VersionStatus = versionStatusEnum{
 }
 
 /*
-VersionStatusEnum is the version status. Allowed values:
-	- VersionStatus.New        "new"
-	- VersionStatus.Installing "installing"
-	- VersionStatus.Installed  "installed"
-	- VersionStatus.Activating "activating"
-	- VersionStatus.Activated  "activated"
-	- VersionStatus.Redundant  "redundant"
+VersionStatusEnum represents the ServiceWorker.ServiceWorkerVersionStatus
+values.
+
+Allowed values:
+  - VersionStatus.New        "new"
+  - VersionStatus.Installing "installing"
+  - VersionStatus.Installed  "installed"
+  - VersionStatus.Activating "activating"
+  - VersionStatus.Activated  "activated"
+  - VersionStatus.Redundant  "redundant"
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#type-ServiceWorkerVersionStatus
 */
@@ -43,7 +49,16 @@ type VersionStatusEnum int
 String implements Stringer
 */
 func (enum VersionStatusEnum) String() string {
-	return _versionStatusEnums[enum]
+	return _versionStatusEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum VersionStatusEnum) Unknown() bool {
+	return !_versionStatusEnums.Known(enum)
 }
 
 /*
@@ -54,25 +69,16 @@ func (enum VersionStatusEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *VersionStatusEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _versionStatusEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _versionStatusEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -90,11 +96,12 @@ const (
 	versionStatusRedundant
 )
 
-var _versionStatusEnums = map[VersionStatusEnum]string{
+var _versionStatusEnums = enums.New(map[VersionStatusEnum]string{
+	VersionStatusEnum(0):    "",
 	versionStatusNew:        "new",
 	versionStatusInstalling: "installing",
 	versionStatusInstalled:  "installed",
 	versionStatusActivating: "activating",
 	versionStatusActivated:  "activated",
 	versionStatusRedundant:  "redundant",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package worker
 
 import (
@@ -11,8 +13,24 @@ func TestEnumVersionStatus(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown VersionStatusEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumVersionStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"new"` != string(result) {
-		t.Errorf("Expected '\"new\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"new"`, result)
 	}
 	json.Unmarshal([]byte(`"new"`), &enum)
 	if VersionStatus.New != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.New, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.New, enum)
 	}
 
 	enum = VersionStatus.Installing
@@ -42,11 +60,11 @@ func TestEnumVersionStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"installing"` != string(result) {
-		t.Errorf("Expected '\"installing\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"installing"`, result)
 	}
 	json.Unmarshal([]byte(`"installing"`), &enum)
 	if VersionStatus.Installing != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.Installing, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.Installing, enum)
 	}
 
 	enum = VersionStatus.Installed
@@ -55,18 +73,12 @@ func TestEnumVersionStatus(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"installed"` != string(result) {
-		t.Errorf("Expected '\"installed\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"installed"`, result)
 	}
 	json.Unmarshal([]byte(`"installed"`), &enum)
 	if VersionStatus.Installed != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.Installed, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.Installed, enum)
 	}
-}
-
-func TestEnumVersionStatus2(t *testing.T) {
-	var enum VersionStatusEnum
-	var err error
-	var result []byte
 
 	enum = VersionStatus.Activating
 	result, err = json.Marshal(enum)
@@ -74,11 +86,11 @@ func TestEnumVersionStatus2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"activating"` != string(result) {
-		t.Errorf("Expected '\"activating\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"activating"`, result)
 	}
 	json.Unmarshal([]byte(`"activating"`), &enum)
 	if VersionStatus.Activating != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.Activating, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.Activating, enum)
 	}
 
 	enum = VersionStatus.Activated
@@ -87,11 +99,11 @@ func TestEnumVersionStatus2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"activated"` != string(result) {
-		t.Errorf("Expected '\"activated\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"activated"`, result)
 	}
 	json.Unmarshal([]byte(`"activated"`), &enum)
 	if VersionStatus.Activated != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.Activated, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.Activated, enum)
 	}
 
 	enum = VersionStatus.Redundant
@@ -100,10 +112,10 @@ func TestEnumVersionStatus2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"redundant"` != string(result) {
-		t.Errorf("Expected '\"redundant\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"redundant"`, result)
 	}
 	json.Unmarshal([]byte(`"redundant"`), &enum)
 	if VersionStatus.Redundant != enum {
-		t.Errorf("Expcected %d, got %d", VersionStatus.Redundant, enum)
+		t.Errorf("Expected %d, got %d", VersionStatus.Redundant, enum)
 	}
 }
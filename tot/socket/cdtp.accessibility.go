@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/accessibility"
@@ -10,29 +13,144 @@ import (
 AccessibilityProtocol provides a namespace for the Chrome Accessibility protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/
 */
 type AccessibilityProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *AccessibilityProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *AccessibilityProtocol) WithContext(ctx context.Context) *AccessibilityProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &AccessibilityProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-GetPartialAXTree fetches the accessibility node and partial accessibility tree
-for this DOM node, if it exists.
+Disables the accessibility domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-disable
+*/
+func (protocol *AccessibilityProtocol) Disable() <-chan *accessibility.DisableResult {
+	resultChan := make(chan *accessibility.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.disable", nil)
+	result := &accessibility.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables the accessibility domain which causes `AXNodeId`s to remain consistent
+between method calls. This turns on accessibility for the page, which can impact
+performance until accessibility is disabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-enable
+*/
+func (protocol *AccessibilityProtocol) Enable() <-chan *accessibility.EnableResult {
+	resultChan := make(chan *accessibility.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.enable", nil)
+	result := &accessibility.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetches the accessibility node and partial accessibility tree for this DOM node,
+if it exists.
+
+The parameters are optional. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getPartialAXTree
 */
 func (protocol *AccessibilityProtocol) GetPartialAXTree(
-	params *accessibility.PartialAXTreeParams,
+	params ...*accessibility.PartialAXTreeParams,
 ) <-chan *accessibility.PartialAXTreeResult {
-	resultChan := make(chan *accessibility.PartialAXTreeResult)
-	command := NewCommand(protocol.Socket, "Accessibility.getPartialAXTree", params)
+	resultChan := make(chan *accessibility.PartialAXTreeResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.getPartialAXTree", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Accessibility.getPartialAXTree", params[0])
+	}
 	result := &accessibility.PartialAXTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetches the entire accessibility tree for the root Document
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getFullAXTree
+*/
+func (protocol *AccessibilityProtocol) GetFullAXTree(
+	params ...*accessibility.GetFullAXTreeParams,
+) <-chan *accessibility.GetFullAXTreeResult {
+	resultChan := make(chan *accessibility.GetFullAXTreeResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.getFullAXTree", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Accessibility.getFullAXTree", params[0])
+	}
+	result := &accessibility.GetFullAXTreeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -43,3 +161,189 @@ func (protocol *AccessibilityProtocol) GetPartialAXTree(
 
 	return resultChan
 }
+
+/*
+Fetches the root node. Requires `enable()` to have been called previously.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getRootAXNode
+*/
+func (protocol *AccessibilityProtocol) GetRootAXNode(
+	params ...*accessibility.GetRootAXNodeParams,
+) <-chan *accessibility.GetRootAXNodeResult {
+	resultChan := make(chan *accessibility.GetRootAXNodeResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.getRootAXNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Accessibility.getRootAXNode", params[0])
+	}
+	result := &accessibility.GetRootAXNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetches a node and all ancestors up to and including the root. Requires
+`enable()` to have been called previously.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getAXNodeAndAncestors
+*/
+func (protocol *AccessibilityProtocol) GetAXNodeAndAncestors(
+	params ...*accessibility.GetAXNodeAndAncestorsParams,
+) <-chan *accessibility.GetAXNodeAndAncestorsResult {
+	resultChan := make(chan *accessibility.GetAXNodeAndAncestorsResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.getAXNodeAndAncestors", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Accessibility.getAXNodeAndAncestors", params[0])
+	}
+	result := &accessibility.GetAXNodeAndAncestorsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetches a particular accessibility node by AXNodeId. Requires `enable()` to have
+been called previously. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-getChildAXNodes
+*/
+func (protocol *AccessibilityProtocol) GetChildAXNodes(
+	params *accessibility.GetChildAXNodesParams,
+) <-chan *accessibility.GetChildAXNodesResult {
+	resultChan := make(chan *accessibility.GetChildAXNodesResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.getChildAXNodes", params)
+	result := &accessibility.GetChildAXNodesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Query a DOM node's accessibility subtree for accessible name and role. This
+command computes the name and role for all nodes in the subtree, including those
+that are ignored for accessibility, and returns those that match the specified
+name and role. If no DOM node is specified, or the DOM node does not exist, the
+command returns an error. If neither `accessibleName` or `role` is specified, it
+returns all the accessibility nodes in the subtree.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#method-queryAXTree
+*/
+func (protocol *AccessibilityProtocol) QueryAXTree(
+	params ...*accessibility.QueryAXTreeParams,
+) <-chan *accessibility.QueryAXTreeResult {
+	resultChan := make(chan *accessibility.QueryAXTreeResult, 1)
+	command := NewCommand(protocol.Socket, "Accessibility.queryAXTree", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Accessibility.queryAXTree", params[0])
+	}
+	result := &accessibility.QueryAXTreeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnLoadComplete adds a handler to the Accessibility.loadComplete event.
+
+The loadComplete event mirrors the load complete event sent by the browser to
+assistive technology when the web page has finished loading. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#event-loadComplete
+*/
+func (protocol *AccessibilityProtocol) OnLoadComplete(
+	callback func(event *accessibility.LoadCompleteEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Accessibility.loadComplete",
+		func(response *Response) {
+			event := &accessibility.LoadCompleteEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNodesUpdated adds a handler to the Accessibility.nodesUpdated event.
+
+The nodesUpdated event is sent every time a previously requested node has
+changed the in tree. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Accessibility/#event-nodesUpdated
+*/
+func (protocol *AccessibilityProtocol) OnNodesUpdated(
+	callback func(event *accessibility.NodesUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Accessibility.nodesUpdated",
+		func(response *Response) {
+			event := &accessibility.NodesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
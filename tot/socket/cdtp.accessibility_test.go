@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/accessibility"
 	"github.com/mkenney/go-chrome/tot/dom"
 )
@@ -16,8 +17,8 @@ func TestAccessibilityGetPartialAXTree(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &accessibility.PartialAXTreeParams{
-		NodeID:         dom.NodeID(1),
-		FetchRelatives: true,
+		NodeID:         optional.Of(dom.NodeID(1)),
+		FetchRelatives: optional.Bool(true),
 	}
 	resultChan := mockSocket.Accessibility().GetPartialAXTree(params)
 	mockResult := accessibility.PartialAXTreeResult{}
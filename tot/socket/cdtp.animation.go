@@ -1,33 +1,65 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/animation"
 )
 
 /*
-AnimationProtocol provides a namespace for the Chrome Animation protocol methods.
+AnimationProtocol provides a namespace for the Chrome Animation protocol
+methods.
+
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Animation/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/
 */
 type AnimationProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *AnimationProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-Disable animation domain notifications.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *AnimationProtocol) WithContext(ctx context.Context) *AnimationProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &AnimationProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disables animation domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-disable
 */
 func (protocol *AnimationProtocol) Disable() <-chan *animation.DisableResult {
-	resultChan := make(chan *animation.DisableResult)
+	resultChan := make(chan *animation.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.disable", nil)
 	result := &animation.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,18 +70,20 @@ func (protocol *AnimationProtocol) Disable() <-chan *animation.DisableResult {
 }
 
 /*
-Enable animation domain notifications.
+Enables animation domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-enable
 */
 func (protocol *AnimationProtocol) Enable() <-chan *animation.EnableResult {
-	resultChan := make(chan *animation.EnableResult)
+	resultChan := make(chan *animation.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.enable", nil)
 	result := &animation.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -60,20 +94,22 @@ func (protocol *AnimationProtocol) Enable() <-chan *animation.EnableResult {
 }
 
 /*
-GetCurrentTime returns the current time of the an animation.
+Returns the current time of the an animation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getCurrentTime
 */
 func (protocol *AnimationProtocol) GetCurrentTime(
 	params *animation.GetCurrentTimeParams,
 ) <-chan *animation.GetCurrentTimeResult {
-	resultChan := make(chan *animation.GetCurrentTimeResult)
+	resultChan := make(chan *animation.GetCurrentTimeResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.getCurrentTime", params)
 	result := &animation.GetCurrentTimeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -86,18 +122,20 @@ func (protocol *AnimationProtocol) GetCurrentTime(
 }
 
 /*
-GetPlaybackRate gets the playback rate of the document timeline.
+Gets the playback rate of the document timeline.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-getPlaybackRate
 */
 func (protocol *AnimationProtocol) GetPlaybackRate() <-chan *animation.GetPlaybackRateResult {
-	resultChan := make(chan *animation.GetPlaybackRateResult)
+	resultChan := make(chan *animation.GetPlaybackRateResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.getPlaybackRate", nil)
 	result := &animation.GetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -110,20 +148,22 @@ func (protocol *AnimationProtocol) GetPlaybackRate() <-chan *animation.GetPlayba
 }
 
 /*
-ReleaseAnimations releases a set of animations to no longer be manipulated.
+Releases a set of animations to no longer be manipulated.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-releaseAnimations
 */
 func (protocol *AnimationProtocol) ReleaseAnimations(
 	params *animation.ReleaseAnimationsParams,
 ) <-chan *animation.ReleaseAnimationsResult {
-	resultChan := make(chan *animation.ReleaseAnimationsResult)
+	resultChan := make(chan *animation.ReleaseAnimationsResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.releaseAnimations", params)
 	result := &animation.ReleaseAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -134,20 +174,22 @@ func (protocol *AnimationProtocol) ReleaseAnimations(
 }
 
 /*
-ResolveAnimation gets the remote object of the Animation.
+Gets the remote object of the Animation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-resolveAnimation
 */
 func (protocol *AnimationProtocol) ResolveAnimation(
 	params *animation.ResolveAnimationParams,
 ) <-chan *animation.ResolveAnimationResult {
-	resultChan := make(chan *animation.ResolveAnimationResult)
+	resultChan := make(chan *animation.ResolveAnimationResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.resolveAnimation", params)
 	result := &animation.ResolveAnimationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -160,21 +202,22 @@ func (protocol *AnimationProtocol) ResolveAnimation(
 }
 
 /*
-SeekAnimations seeks a set of animations to a particular time within each
-animation.
+Seek a set of animations to a particular time within each animation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-seekAnimations
 */
 func (protocol *AnimationProtocol) SeekAnimations(
 	params *animation.SeekAnimationsParams,
 ) <-chan *animation.SeekAnimationsResult {
-	resultChan := make(chan *animation.SeekAnimationsResult)
+	resultChan := make(chan *animation.SeekAnimationsResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.seekAnimations", params)
 	result := &animation.SeekAnimationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -185,20 +228,22 @@ func (protocol *AnimationProtocol) SeekAnimations(
 }
 
 /*
-SetPaused sets the paused state of a set of animations.
+Sets the paused state of a set of animations.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPaused
 */
 func (protocol *AnimationProtocol) SetPaused(
 	params *animation.SetPausedParams,
 ) <-chan *animation.SetPausedResult {
-	resultChan := make(chan *animation.SetPausedResult)
+	resultChan := make(chan *animation.SetPausedResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setPaused", params)
 	result := &animation.SetPausedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -209,20 +254,22 @@ func (protocol *AnimationProtocol) SetPaused(
 }
 
 /*
-SetPlaybackRate sets the playback rate of the document timeline.
+Sets the playback rate of the document timeline.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setPlaybackRate
 */
 func (protocol *AnimationProtocol) SetPlaybackRate(
 	params *animation.SetPlaybackRateParams,
 ) <-chan *animation.SetPlaybackRateResult {
-	resultChan := make(chan *animation.SetPlaybackRateResult)
+	resultChan := make(chan *animation.SetPlaybackRateResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setPlaybackRate", params)
 	result := &animation.SetPlaybackRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -233,20 +280,22 @@ func (protocol *AnimationProtocol) SetPlaybackRate(
 }
 
 /*
-SetTiming sets the timing of an animation node.
+Sets the timing of an animation node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#method-setTiming
 */
 func (protocol *AnimationProtocol) SetTiming(
 	params *animation.SetTimingParams,
 ) <-chan *animation.SetTimingResult {
-	resultChan := make(chan *animation.SetTimingResult)
+	resultChan := make(chan *animation.SetTimingResult, 1)
 	command := NewCommand(protocol.Socket, "Animation.setTiming", params)
 	result := &animation.SetTimingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -257,14 +306,15 @@ func (protocol *AnimationProtocol) SetTiming(
 }
 
 /*
-OnAnimationCanceled adds a handler to the Animation.Canceled event.
-Animation.Canceled fires when when an animation has been cancelled.
+OnAnimationCanceled adds a handler to the Animation.animationCanceled event.
+
+Event for when an animation has been cancelled.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCanceled
 */
 func (protocol *AnimationProtocol) OnAnimationCanceled(
 	callback func(event *animation.CanceledEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Animation.animationCanceled",
 		func(response *Response) {
@@ -277,17 +327,19 @@ func (protocol *AnimationProtocol) OnAnimationCanceled(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnAnimationCreated adds a handler to the Animation.Created event.
-Animation.Created fires for each animation that has been created.
+OnAnimationCreated adds a handler to the Animation.animationCreated event.
+
+Event for each animation that has been created.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationCreated
 */
 func (protocol *AnimationProtocol) OnAnimationCreated(
 	callback func(event *animation.CreatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Animation.animationCreated",
 		func(response *Response) {
@@ -300,17 +352,19 @@ func (protocol *AnimationProtocol) OnAnimationCreated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnAnimationStarted adds a handler to the Animation.Started event.
-Animation.Started fires for each animation that has been started.
+OnAnimationStarted adds a handler to the Animation.animationStarted event.
+
+Event for animation that has been started.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationStarted
 */
 func (protocol *AnimationProtocol) OnAnimationStarted(
 	callback func(event *animation.StartedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Animation.animationStarted",
 		func(response *Response) {
@@ -323,4 +377,30 @@ func (protocol *AnimationProtocol) OnAnimationStarted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAnimationUpdated adds a handler to the Animation.animationUpdated event.
+
+Event for animation that has been updated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Animation/#event-animationUpdated
+*/
+func (protocol *AnimationProtocol) OnAnimationUpdated(
+	callback func(event *animation.UpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Animation.animationUpdated",
+		func(response *Response) {
+			event := &animation.UpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
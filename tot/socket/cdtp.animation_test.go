@@ -96,14 +96,14 @@ func TestAnimationGetCurrentTime(t *testing.T) {
 		t.Errorf("Expected success, got error: '%s'", result.Err.Error())
 	}
 	if 0 != result.CurrentTime {
-		t.Errorf("Expected 0, got %d", result.CurrentTime)
+		t.Errorf("Expected 0, got %v", result.CurrentTime)
 	}
 
 	resultChan = mockSocket.Animation().GetCurrentTime(&animation.GetCurrentTimeParams{
 		ID: "animation-id",
 	})
 	mockResult := &animation.GetCurrentTimeResult{
-		CurrentTime: time.Now().Unix(),
+		CurrentTime: float64(time.Now().Unix()),
 	}
 	mockResultBytes, _ = json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
@@ -117,7 +117,7 @@ func TestAnimationGetCurrentTime(t *testing.T) {
 	}
 	if result.CurrentTime != mockResult.CurrentTime {
 		t.Errorf(
-			"Expected %d, received %d",
+			"Expected %v, received %v",
 			mockResult.CurrentTime,
 			result.CurrentTime,
 		)
@@ -543,8 +543,8 @@ func TestAnimationOnAnimationStarted(t *testing.T) {
 			PausedState:  true,
 			PlayState:    "play state",
 			PlaybackRate: 1.1,
-			StartTime:    time.Now().Unix(),
-			CurrentTime:  time.Now().Unix(),
+			StartTime:    float64(time.Now().Unix()),
+			CurrentTime:  float64(time.Now().Unix()),
 			Type:         animation.Type.CSSTransition,
 			Source: &animation.Effect{
 				Delay:          1,
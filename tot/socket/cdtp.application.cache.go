@@ -1,34 +1,65 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/application/cache"
 )
 
 /*
-ApplicationCacheProtocol provides a namespace for the Chrome Animation protocol
-methods.
+ApplicationCacheProtocol provides a namespace for the Chrome ApplicationCache
+protocol methods.
+
+DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/
 */
 type ApplicationCacheProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ApplicationCacheProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ApplicationCacheProtocol) WithContext(ctx context.Context) *ApplicationCacheProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ApplicationCacheProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-Enable enables application cache domain notifications.
+Enables application cache domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-enable
 */
 func (protocol *ApplicationCacheProtocol) Enable() <-chan *cache.EnableResult {
-	resultChan := make(chan *cache.EnableResult)
+	resultChan := make(chan *cache.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.enable", nil)
 	result := &cache.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,21 +70,22 @@ func (protocol *ApplicationCacheProtocol) Enable() <-chan *cache.EnableResult {
 }
 
 /*
-GetForFrame returns relevant application cache data for the document
-in given frame.
+Returns relevant application cache data for the document in given frame.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getApplicationCacheForFrame
 */
 func (protocol *ApplicationCacheProtocol) GetForFrame(
 	params *cache.GetForFrameParams,
 ) <-chan *cache.GetForFrameResult {
-	resultChan := make(chan *cache.GetForFrameResult)
+	resultChan := make(chan *cache.GetForFrameResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getApplicationCacheForFrame", params)
 	result := &cache.GetForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -66,19 +98,21 @@ func (protocol *ApplicationCacheProtocol) GetForFrame(
 }
 
 /*
-GetFramesWithManifests returns array of frame identifiers with manifest urls for
-each frame containing a document associated with some application cache.
+Returns array of frame identifiers with manifest urls for each frame containing
+a document associated with some application cache.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getFramesWithManifests
 */
 func (protocol *ApplicationCacheProtocol) GetFramesWithManifests() <-chan *cache.GetFramesWithManifestsResult {
-	resultChan := make(chan *cache.GetFramesWithManifestsResult)
+	resultChan := make(chan *cache.GetFramesWithManifestsResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getFramesWithManifests", nil)
 	result := &cache.GetFramesWithManifestsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -91,20 +125,22 @@ func (protocol *ApplicationCacheProtocol) GetFramesWithManifests() <-chan *cache
 }
 
 /*
-GetManifestForFrame returns manifest URL for document in the given frame.
+Returns manifest URL for document in the given frame.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#method-getManifestForFrame
 */
 func (protocol *ApplicationCacheProtocol) GetManifestForFrame(
 	params *cache.GetManifestForFrameParams,
 ) <-chan *cache.GetManifestForFrameResult {
-	resultChan := make(chan *cache.GetManifestForFrameResult)
+	resultChan := make(chan *cache.GetManifestForFrameResult, 1)
 	command := NewCommand(protocol.Socket, "ApplicationCache.getManifestForFrame", params)
 	result := &cache.GetManifestForFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -118,13 +154,13 @@ func (protocol *ApplicationCacheProtocol) GetManifestForFrame(
 
 /*
 OnApplicationCacheStatusUpdated adds a handler to the
-ApplicationCache.StatusUpdated event.
+ApplicationCache.applicationCacheStatusUpdated event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-applicationCacheStatusUpdated
 */
 func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 	callback func(event *cache.StatusUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"ApplicationCache.applicationCacheStatusUpdated",
 		func(response *Response) {
@@ -137,16 +173,18 @@ func (protocol *ApplicationCacheProtocol) OnApplicationCacheStatusUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnNetworkStateUpdated adds a handler to the ApplicationCache.StatusUpdated event.
+OnNetworkStateUpdated adds a handler to the ApplicationCache.networkStateUpdated
+event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ApplicationCache/#event-networkStateUpdated
 */
 func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 	callback func(event *cache.NetworkStateUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"ApplicationCache.networkStateUpdated",
 		func(response *Response) {
@@ -159,4 +197,5 @@ func (protocol *ApplicationCacheProtocol) OnNetworkStateUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
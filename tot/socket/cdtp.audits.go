@@ -1,38 +1,177 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/audits"
 )
 
 /*
-AuditsProtocol provides a namespace for the Chrome Audits protocol methods. The
-Audits protocol allows investigation of page violations and possible
-improvements.
+AuditsProtocol provides a namespace for the Chrome Audits protocol methods.
+
+Audits domain allows investigation of page violations and possible improvements.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Audits/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/
 */
 type AuditsProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *AuditsProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *AuditsProtocol) WithContext(ctx context.Context) *AuditsProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &AuditsProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-GetEncodedResponse returns the response body and size if it were re-encoded with
-the specified settings. Only applies to images.
+Returns the response body and size if it were re-encoded with the specified
+settings. Only applies to images.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-getEncodedResponse
 */
 func (protocol *AuditsProtocol) GetEncodedResponse(
 	params *audits.GetEncodedResponseParams,
 ) <-chan *audits.GetEncodedResponseResult {
-	resultChan := make(chan *audits.GetEncodedResponseResult)
+	resultChan := make(chan *audits.GetEncodedResponseResult, 1)
 	command := NewCommand(protocol.Socket, "Audits.getEncodedResponse", params)
 	result := &audits.GetEncodedResponseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables issues domain, prevents further issues from being reported to the
+client.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-disable
+*/
+func (protocol *AuditsProtocol) Disable() <-chan *audits.DisableResult {
+	resultChan := make(chan *audits.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Audits.disable", nil)
+	result := &audits.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables issues domain, sends the issues collected so far to the client by means
+of the `issueAdded` event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-enable
+*/
+func (protocol *AuditsProtocol) Enable() <-chan *audits.EnableResult {
+	resultChan := make(chan *audits.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Audits.enable", nil)
+	result := &audits.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Runs the contrast check for the target page. Found issues are reported using
+Audits.issueAdded event.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-checkContrast
+*/
+func (protocol *AuditsProtocol) CheckContrast(
+	params ...*audits.CheckContrastParams,
+) <-chan *audits.CheckContrastResult {
+	resultChan := make(chan *audits.CheckContrastResult, 1)
+	command := NewCommand(protocol.Socket, "Audits.checkContrast", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Audits.checkContrast", params[0])
+	}
+	result := &audits.CheckContrastResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Runs the form issues check for the target page. Found issues are reported using
+Audits.issueAdded event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#method-checkFormsIssues
+*/
+func (protocol *AuditsProtocol) CheckFormsIssues() <-chan *audits.CheckFormsIssuesResult {
+	resultChan := make(chan *audits.CheckFormsIssuesResult, 1)
+	command := NewCommand(protocol.Socket, "Audits.checkFormsIssues", nil)
+	result := &audits.CheckFormsIssuesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -43,3 +182,26 @@ func (protocol *AuditsProtocol) GetEncodedResponse(
 
 	return resultChan
 }
+
+/*
+OnIssueAdded adds a handler to the Audits.issueAdded event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Audits/#event-issueAdded
+*/
+func (protocol *AuditsProtocol) OnIssueAdded(
+	callback func(event *audits.IssueAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Audits.issueAdded",
+		func(response *Response) {
+			event := &audits.IssueAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
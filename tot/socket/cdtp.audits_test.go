@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	audits "github.com/mkenney/go-chrome/tot/audits"
 	network "github.com/mkenney/go-chrome/tot/network"
 )
@@ -18,8 +19,8 @@ func TestAuditsGetEncodedResponse(t *testing.T) {
 	resultChan := mockSocket.Audits().GetEncodedResponse(&audits.GetEncodedResponseParams{
 		RequestID: network.RequestID("audit-id"),
 		Encoding:  audits.Encoding.Webp,
-		Quality:   1,
-		SizeOnly:  true,
+		Quality:   optional.Float64(1),
+		SizeOnly:  optional.Bool(true),
 	})
 	mockResult := &audits.GetEncodedResponseResult{
 		Body:         "Response body",
@@ -47,8 +48,8 @@ func TestAuditsGetEncodedResponse(t *testing.T) {
 	resultChan = mockSocket.Audits().GetEncodedResponse(&audits.GetEncodedResponseParams{
 		RequestID: network.RequestID("audit-id"),
 		Encoding:  audits.Encoding.Webp,
-		Quality:   1,
-		SizeOnly:  true,
+		Quality:   optional.Float64(1),
+		SizeOnly:  optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
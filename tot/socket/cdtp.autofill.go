@@ -0,0 +1,171 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/autofill"
+)
+
+/*
+AutofillProtocol provides a namespace for the Chrome Autofill protocol methods.
+
+Defines commands and events for Autofill. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/
+*/
+type AutofillProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *AutofillProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *AutofillProtocol) WithContext(ctx context.Context) *AutofillProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &AutofillProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Trigger autofill on a form identified by the fieldId. If the field and related
+form cannot be autofilled, returns an error.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-trigger
+*/
+func (protocol *AutofillProtocol) Trigger(
+	params *autofill.TriggerParams,
+) <-chan *autofill.TriggerResult {
+	resultChan := make(chan *autofill.TriggerResult, 1)
+	command := NewCommand(protocol.Socket, "Autofill.trigger", params)
+	result := &autofill.TriggerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set addresses so that developers can verify their forms implementation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-setAddresses
+*/
+func (protocol *AutofillProtocol) SetAddresses(
+	params *autofill.SetAddressesParams,
+) <-chan *autofill.SetAddressesResult {
+	resultChan := make(chan *autofill.SetAddressesResult, 1)
+	command := NewCommand(protocol.Socket, "Autofill.setAddresses", params)
+	result := &autofill.SetAddressesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables autofill domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-disable
+*/
+func (protocol *AutofillProtocol) Disable() <-chan *autofill.DisableResult {
+	resultChan := make(chan *autofill.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Autofill.disable", nil)
+	result := &autofill.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables autofill domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#method-enable
+*/
+func (protocol *AutofillProtocol) Enable() <-chan *autofill.EnableResult {
+	resultChan := make(chan *autofill.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Autofill.enable", nil)
+	result := &autofill.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnAddressFormFilled adds a handler to the Autofill.addressFormFilled event.
+
+Emitted when an address form is filled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Autofill/#event-addressFormFilled
+*/
+func (protocol *AutofillProtocol) OnAddressFormFilled(
+	callback func(event *autofill.AddressFormFilledEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Autofill.addressFormFilled",
+		func(response *Response) {
+			event := &autofill.AddressFormFilledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,111 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/autofill"
+)
+
+func TestAutofillCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestAutofillCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Autofill.trigger", func() func() error {
+			resultChan := mockSocket.Autofill().Trigger(&autofill.TriggerParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Autofill.setAddresses", func() func() error {
+			resultChan := mockSocket.Autofill().SetAddresses(&autofill.SetAddressesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Autofill.disable", func() func() error {
+			resultChan := mockSocket.Autofill().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Autofill.enable", func() func() error {
+			resultChan := mockSocket.Autofill().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestAutofillEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestAutofillEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"Autofill.addressFormFilled", func(results chan<- error) *Listener {
+			return mockSocket.Autofill().OnAddressFormFilled(func(event *autofill.AddressFormFilledEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
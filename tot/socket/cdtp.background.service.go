@@ -0,0 +1,203 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/background/service"
+)
+
+/*
+BackgroundServiceProtocol provides a namespace for the Chrome BackgroundService
+protocol methods.
+
+Defines events for background web platform features. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/
+*/
+type BackgroundServiceProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *BackgroundServiceProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *BackgroundServiceProtocol) WithContext(ctx context.Context) *BackgroundServiceProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &BackgroundServiceProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enables event updates for the service.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-startObserving
+*/
+func (protocol *BackgroundServiceProtocol) StartObserving(
+	params *service.StartObservingParams,
+) <-chan *service.StartObservingResult {
+	resultChan := make(chan *service.StartObservingResult, 1)
+	command := NewCommand(protocol.Socket, "BackgroundService.startObserving", params)
+	result := &service.StartObservingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables event updates for the service.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-stopObserving
+*/
+func (protocol *BackgroundServiceProtocol) StopObserving(
+	params *service.StopObservingParams,
+) <-chan *service.StopObservingResult {
+	resultChan := make(chan *service.StopObservingResult, 1)
+	command := NewCommand(protocol.Socket, "BackgroundService.stopObserving", params)
+	result := &service.StopObservingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set the recording state for the service.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-setRecording
+*/
+func (protocol *BackgroundServiceProtocol) SetRecording(
+	params *service.SetRecordingParams,
+) <-chan *service.SetRecordingResult {
+	resultChan := make(chan *service.SetRecordingResult, 1)
+	command := NewCommand(protocol.Socket, "BackgroundService.setRecording", params)
+	result := &service.SetRecordingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears all stored data for the service.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#method-clearEvents
+*/
+func (protocol *BackgroundServiceProtocol) ClearEvents(
+	params *service.ClearEventsParams,
+) <-chan *service.ClearEventsResult {
+	resultChan := make(chan *service.ClearEventsResult, 1)
+	command := NewCommand(protocol.Socket, "BackgroundService.clearEvents", params)
+	result := &service.ClearEventsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnRecordingStateChanged adds a handler to the
+BackgroundService.recordingStateChanged event.
+
+Called when the recording state for the service has been updated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#event-recordingStateChanged
+*/
+func (protocol *BackgroundServiceProtocol) OnRecordingStateChanged(
+	callback func(event *service.RecordingStateChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"BackgroundService.recordingStateChanged",
+		func(response *Response) {
+			event := &service.RecordingStateChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnBackgroundServiceEventReceived adds a handler to the
+BackgroundService.backgroundServiceEventReceived event.
+
+Called with all existing backgroundServiceEvents when enabled, and all new
+events afterwards if enabled and recording.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BackgroundService/#event-backgroundServiceEventReceived
+*/
+func (protocol *BackgroundServiceProtocol) OnBackgroundServiceEventReceived(
+	callback func(event *service.EventReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"BackgroundService.backgroundServiceEventReceived",
+		func(response *Response) {
+			event := &service.EventReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,537 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/bluetooth/emulation"
+)
+
+/*
+BluetoothEmulationProtocol provides a namespace for the Chrome
+BluetoothEmulation protocol methods.
+
+This domain allows configuring virtual Bluetooth devices to test the
+web-bluetooth API. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/
+*/
+type BluetoothEmulationProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *BluetoothEmulationProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *BluetoothEmulationProtocol) WithContext(ctx context.Context) *BluetoothEmulationProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &BluetoothEmulationProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enable the BluetoothEmulation domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-enable
+*/
+func (protocol *BluetoothEmulationProtocol) Enable(
+	params *emulation.EnableParams,
+) <-chan *emulation.EnableResult {
+	resultChan := make(chan *emulation.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.enable", params)
+	result := &emulation.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set the state of the simulated central.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-setSimulatedCentralState
+*/
+func (protocol *BluetoothEmulationProtocol) SetSimulatedCentralState(
+	params *emulation.SetSimulatedCentralStateParams,
+) <-chan *emulation.SetSimulatedCentralStateResult {
+	resultChan := make(chan *emulation.SetSimulatedCentralStateResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.setSimulatedCentralState", params)
+	result := &emulation.SetSimulatedCentralStateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable the BluetoothEmulation domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-disable
+*/
+func (protocol *BluetoothEmulationProtocol) Disable() <-chan *emulation.DisableResult {
+	resultChan := make(chan *emulation.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.disable", nil)
+	result := &emulation.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates a peripheral with |address|, |name| and |knownServiceUuids| that has
+already been connected to the system.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulatePreconnectedPeripheral
+*/
+func (protocol *BluetoothEmulationProtocol) SimulatePreconnectedPeripheral(
+	params *emulation.SimulatePreconnectedPeripheralParams,
+) <-chan *emulation.SimulatePreconnectedPeripheralResult {
+	resultChan := make(chan *emulation.SimulatePreconnectedPeripheralResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulatePreconnectedPeripheral", params)
+	result := &emulation.SimulatePreconnectedPeripheralResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates an advertisement packet described in |entry| being received by the
+central.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateAdvertisement
+*/
+func (protocol *BluetoothEmulationProtocol) SimulateAdvertisement(
+	params *emulation.SimulateAdvertisementParams,
+) <-chan *emulation.SimulateAdvertisementResult {
+	resultChan := make(chan *emulation.SimulateAdvertisementResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulateAdvertisement", params)
+	result := &emulation.SimulateAdvertisementResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates the response code from the peripheral with |address| for a GATT
+operation of |type|. The |code| value follows the HCI Error Codes from Bluetooth
+Core Specification Vol 2 Part D 1.3 List Of Error Codes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTOperationResponse
+*/
+func (protocol *BluetoothEmulationProtocol) SimulateGATTOperationResponse(
+	params *emulation.SimulateGATTOperationResponseParams,
+) <-chan *emulation.SimulateGATTOperationResponseResult {
+	resultChan := make(chan *emulation.SimulateGATTOperationResponseResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulateGATTOperationResponse", params)
+	result := &emulation.SimulateGATTOperationResponseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates the response from the characteristic with |characteristicId| for a
+characteristic operation of |type|. The |code| value follows the Error Codes
+from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response. The
+|data| is expected to exist when simulating a successful read operation
+response.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateCharacteristicOperationResponse
+*/
+func (protocol *BluetoothEmulationProtocol) SimulateCharacteristicOperationResponse(
+	params *emulation.SimulateCharacteristicOperationResponseParams,
+) <-chan *emulation.SimulateCharacteristicOperationResponseResult {
+	resultChan := make(chan *emulation.SimulateCharacteristicOperationResponseResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulateCharacteristicOperationResponse", params)
+	result := &emulation.SimulateCharacteristicOperationResponseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates the response from the descriptor with |descriptorId| for a descriptor
+operation of |type|. The |code| value follows the Error Codes from Bluetooth
+Core Specification Vol 3 Part F 3.4.1.1 Error Response. The |data| is expected
+to exist when simulating a successful read operation response.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateDescriptorOperationResponse
+*/
+func (protocol *BluetoothEmulationProtocol) SimulateDescriptorOperationResponse(
+	params *emulation.SimulateDescriptorOperationResponseParams,
+) <-chan *emulation.SimulateDescriptorOperationResponseResult {
+	resultChan := make(chan *emulation.SimulateDescriptorOperationResponseResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulateDescriptorOperationResponse", params)
+	result := &emulation.SimulateDescriptorOperationResponseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Adds a service with |serviceUuid| to the peripheral with |address|.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addService
+*/
+func (protocol *BluetoothEmulationProtocol) AddService(
+	params *emulation.AddServiceParams,
+) <-chan *emulation.AddServiceResult {
+	resultChan := make(chan *emulation.AddServiceResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.addService", params)
+	result := &emulation.AddServiceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes the service respresented by |serviceId| from the simulated central.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeService
+*/
+func (protocol *BluetoothEmulationProtocol) RemoveService(
+	params *emulation.RemoveServiceParams,
+) <-chan *emulation.RemoveServiceResult {
+	resultChan := make(chan *emulation.RemoveServiceResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.removeService", params)
+	result := &emulation.RemoveServiceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Adds a characteristic with |characteristicUuid| and |properties| to the service
+represented by |serviceId|.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addCharacteristic
+*/
+func (protocol *BluetoothEmulationProtocol) AddCharacteristic(
+	params *emulation.AddCharacteristicParams,
+) <-chan *emulation.AddCharacteristicResult {
+	resultChan := make(chan *emulation.AddCharacteristicResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.addCharacteristic", params)
+	result := &emulation.AddCharacteristicResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes the characteristic respresented by |characteristicId| from the simulated
+central.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeCharacteristic
+*/
+func (protocol *BluetoothEmulationProtocol) RemoveCharacteristic(
+	params *emulation.RemoveCharacteristicParams,
+) <-chan *emulation.RemoveCharacteristicResult {
+	resultChan := make(chan *emulation.RemoveCharacteristicResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.removeCharacteristic", params)
+	result := &emulation.RemoveCharacteristicResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Adds a descriptor with |descriptorUuid| to the characteristic respresented by
+|characteristicId|.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-addDescriptor
+*/
+func (protocol *BluetoothEmulationProtocol) AddDescriptor(
+	params *emulation.AddDescriptorParams,
+) <-chan *emulation.AddDescriptorResult {
+	resultChan := make(chan *emulation.AddDescriptorResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.addDescriptor", params)
+	result := &emulation.AddDescriptorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes the descriptor with |descriptorId| from the simulated central.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-removeDescriptor
+*/
+func (protocol *BluetoothEmulationProtocol) RemoveDescriptor(
+	params *emulation.RemoveDescriptorParams,
+) <-chan *emulation.RemoveDescriptorResult {
+	resultChan := make(chan *emulation.RemoveDescriptorResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.removeDescriptor", params)
+	result := &emulation.RemoveDescriptorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Simulates a GATT disconnection from the peripheral with |address|.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#method-simulateGATTDisconnection
+*/
+func (protocol *BluetoothEmulationProtocol) SimulateGATTDisconnection(
+	params *emulation.SimulateGATTDisconnectionParams,
+) <-chan *emulation.SimulateGATTDisconnectionResult {
+	resultChan := make(chan *emulation.SimulateGATTDisconnectionResult, 1)
+	command := NewCommand(protocol.Socket, "BluetoothEmulation.simulateGATTDisconnection", params)
+	result := &emulation.SimulateGATTDisconnectionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnGattOperationReceived adds a handler to the
+BluetoothEmulation.gattOperationReceived event.
+
+Event for when a GATT operation of |type| to the peripheral with |address|
+happened.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-gattOperationReceived
+*/
+func (protocol *BluetoothEmulationProtocol) OnGattOperationReceived(
+	callback func(event *emulation.GattOperationReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"BluetoothEmulation.gattOperationReceived",
+		func(response *Response) {
+			event := &emulation.GattOperationReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCharacteristicOperationReceived adds a handler to the
+BluetoothEmulation.characteristicOperationReceived event.
+
+Event for when a characteristic operation of |type| to the characteristic
+respresented by |characteristicId| happened. |data| and |writeType| is expected
+to exist when |type| is write.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-characteristicOperationReceived
+*/
+func (protocol *BluetoothEmulationProtocol) OnCharacteristicOperationReceived(
+	callback func(event *emulation.CharacteristicOperationReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"BluetoothEmulation.characteristicOperationReceived",
+		func(response *Response) {
+			event := &emulation.CharacteristicOperationReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDescriptorOperationReceived adds a handler to the
+BluetoothEmulation.descriptorOperationReceived event.
+
+Event for when a descriptor operation of |type| to the descriptor respresented
+by |descriptorId| happened. |data| is expected to exist when |type| is write.
+
+https://chromedevtools.github.io/devtools-protocol/tot/BluetoothEmulation/#event-descriptorOperationReceived
+*/
+func (protocol *BluetoothEmulationProtocol) OnDescriptorOperationReceived(
+	callback func(event *emulation.DescriptorOperationReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"BluetoothEmulation.descriptorOperationReceived",
+		func(response *Response) {
+			event := &emulation.DescriptorOperationReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/browser"
@@ -8,27 +11,190 @@ import (
 
 /*
 BrowserProtocol provides a namespace for the Chrome Browser protocol methods.
-The Browser protocol defines methods and events for browser management.
+
+The Browser domain defines methods and events for browser managing.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/
 */
 type BrowserProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *BrowserProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *BrowserProtocol) WithContext(ctx context.Context) *BrowserProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &BrowserProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Set permission settings for given origin. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setPermission
+*/
+func (protocol *BrowserProtocol) SetPermission(
+	params *browser.SetPermissionParams,
+) <-chan *browser.SetPermissionResult {
+	resultChan := make(chan *browser.SetPermissionResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.setPermission", params)
+	result := &browser.SetPermissionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Grant specific permissions to the given origin and reject all others.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-grantPermissions
+*/
+func (protocol *BrowserProtocol) GrantPermissions(
+	params *browser.GrantPermissionsParams,
+) <-chan *browser.GrantPermissionsResult {
+	resultChan := make(chan *browser.GrantPermissionsResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.grantPermissions", params)
+	result := &browser.GrantPermissionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
 }
 
 /*
-Close closes the browser gracefully.
+Reset all permission management for all origins.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-resetPermissions
+*/
+func (protocol *BrowserProtocol) ResetPermissions(
+	params ...*browser.ResetPermissionsParams,
+) <-chan *browser.ResetPermissionsResult {
+	resultChan := make(chan *browser.ResetPermissionsResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.resetPermissions", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Browser.resetPermissions", params[0])
+	}
+	result := &browser.ResetPermissionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set the behavior when downloading a file. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDownloadBehavior
+*/
+func (protocol *BrowserProtocol) SetDownloadBehavior(
+	params *browser.SetDownloadBehaviorParams,
+) <-chan *browser.SetDownloadBehaviorResult {
+	resultChan := make(chan *browser.SetDownloadBehaviorResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.setDownloadBehavior", params)
+	result := &browser.SetDownloadBehaviorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Cancel a download if in progress EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-cancelDownload
+*/
+func (protocol *BrowserProtocol) CancelDownload(
+	params *browser.CancelDownloadParams,
+) <-chan *browser.CancelDownloadResult {
+	resultChan := make(chan *browser.CancelDownloadResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.cancelDownload", params)
+	result := &browser.CancelDownloadResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Close browser gracefully.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-close
 */
 func (protocol *BrowserProtocol) Close() <-chan *browser.CloseResult {
-	resultChan := make(chan *browser.CloseResult)
+	resultChan := make(chan *browser.CloseResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.close", nil)
 	result := &browser.CloseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,18 +205,68 @@ func (protocol *BrowserProtocol) Close() <-chan *browser.CloseResult {
 }
 
 /*
-GetVersion returns version information.
+Crashes browser on the main thread. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-crash
+*/
+func (protocol *BrowserProtocol) Crash() <-chan *browser.CrashResult {
+	resultChan := make(chan *browser.CrashResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.crash", nil)
+	result := &browser.CrashResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Crashes GPU process. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-crashGpuProcess
+*/
+func (protocol *BrowserProtocol) CrashGPUProcess() <-chan *browser.CrashGPUProcessResult {
+	resultChan := make(chan *browser.CrashGPUProcessResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.crashGpuProcess", nil)
+	result := &browser.CrashGPUProcessResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns version information.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getVersion
 */
 func (protocol *BrowserProtocol) GetVersion() <-chan *browser.GetVersionResult {
-	resultChan := make(chan *browser.GetVersionResult)
+	resultChan := make(chan *browser.GetVersionResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.getVersion", nil)
 	result := &browser.GetVersionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -63,20 +279,110 @@ func (protocol *BrowserProtocol) GetVersion() <-chan *browser.GetVersionResult {
 }
 
 /*
-GetWindowBounds sets the position and/or size of the browser window.
+Returns the command line switches for the browser process if, and only if
+--enable-automation is on the commandline. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowBounds EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getBrowserCommandLine
+*/
+func (protocol *BrowserProtocol) GetBrowserCommandLine() <-chan *browser.GetBrowserCommandLineResult {
+	resultChan := make(chan *browser.GetBrowserCommandLineResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.getBrowserCommandLine", nil)
+	result := &browser.GetBrowserCommandLineResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Get Chrome histograms.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistograms
+*/
+func (protocol *BrowserProtocol) GetHistograms(
+	params ...*browser.GetHistogramsParams,
+) <-chan *browser.GetHistogramsResult {
+	resultChan := make(chan *browser.GetHistogramsResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.getHistograms", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Browser.getHistograms", params[0])
+	}
+	result := &browser.GetHistogramsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Get a Chrome histogram by name. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getHistogram
+*/
+func (protocol *BrowserProtocol) GetHistogram(
+	params *browser.GetHistogramParams,
+) <-chan *browser.GetHistogramResult {
+	resultChan := make(chan *browser.GetHistogramResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.getHistogram", params)
+	result := &browser.GetHistogramResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Get position and size of the browser window. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowBounds
 */
 func (protocol *BrowserProtocol) GetWindowBounds(
 	params *browser.GetWindowBoundsParams,
 ) <-chan *browser.GetWindowBoundsResult {
-	resultChan := make(chan *browser.GetWindowBoundsResult)
+	resultChan := make(chan *browser.GetWindowBoundsResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.getWindowBounds", params)
 	result := &browser.GetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -89,21 +395,27 @@ func (protocol *BrowserProtocol) GetWindowBounds(
 }
 
 /*
-GetWindowForTarget gets the browser window that contains the devtools target.
+Get the browser window that contains the devtools target.
+
+The parameters are optional. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-getWindowForTarget
-EXPERIMENTAL.
 */
 func (protocol *BrowserProtocol) GetWindowForTarget(
-	params *browser.GetWindowForTargetParams,
+	params ...*browser.GetWindowForTargetParams,
 ) <-chan *browser.GetWindowForTargetResult {
-	resultChan := make(chan *browser.GetWindowForTargetResult)
-	command := NewCommand(protocol.Socket, "Browser.getWindowForTarget", params)
+	resultChan := make(chan *browser.GetWindowForTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.getWindowForTarget", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Browser.getWindowForTarget", params[0])
+	}
 	result := &browser.GetWindowForTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -116,20 +428,22 @@ func (protocol *BrowserProtocol) GetWindowForTarget(
 }
 
 /*
-SetWindowBounds sets the position and/or size of the browser window.
+Set position and/or size of the browser window. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindowBounds EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setWindowBounds
 */
 func (protocol *BrowserProtocol) SetWindowBounds(
 	params *browser.SetWindowBoundsParams,
 ) <-chan *browser.SetWindowBoundsResult {
-	resultChan := make(chan *browser.SetWindowBoundsResult)
+	resultChan := make(chan *browser.SetWindowBoundsResult, 1)
 	command := NewCommand(protocol.Socket, "Browser.setWindowBounds", params)
 	result := &browser.SetWindowBoundsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -140,3 +454,193 @@ func (protocol *BrowserProtocol) SetWindowBounds(
 
 	return resultChan
 }
+
+/*
+Set size of the browser contents resizing browser window as necessary.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setContentsSize
+*/
+func (protocol *BrowserProtocol) SetContentsSize(
+	params *browser.SetContentsSizeParams,
+) <-chan *browser.SetContentsSizeResult {
+	resultChan := make(chan *browser.SetContentsSizeResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.setContentsSize", params)
+	result := &browser.SetContentsSizeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set dock tile details, platform-specific.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-setDockTile
+*/
+func (protocol *BrowserProtocol) SetDockTile(
+	params ...*browser.SetDockTileParams,
+) <-chan *browser.SetDockTileResult {
+	resultChan := make(chan *browser.SetDockTileResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.setDockTile", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Browser.setDockTile", params[0])
+	}
+	result := &browser.SetDockTileResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Invoke custom browser commands used by telemetry. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-executeBrowserCommand
+*/
+func (protocol *BrowserProtocol) ExecuteBrowserCommand(
+	params *browser.ExecuteBrowserCommandParams,
+) <-chan *browser.ExecuteBrowserCommandResult {
+	resultChan := make(chan *browser.ExecuteBrowserCommandResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.executeBrowserCommand", params)
+	result := &browser.ExecuteBrowserCommandResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows a site to use privacy sandbox features that require enrollment without
+the site actually being enrolled. Only supported on page targets.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxEnrollmentOverride
+*/
+func (protocol *BrowserProtocol) AddPrivacySandboxEnrollmentOverride(
+	params *browser.AddPrivacySandboxEnrollmentOverrideParams,
+) <-chan *browser.AddPrivacySandboxEnrollmentOverrideResult {
+	resultChan := make(chan *browser.AddPrivacySandboxEnrollmentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.addPrivacySandboxEnrollmentOverride", params)
+	result := &browser.AddPrivacySandboxEnrollmentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Configures encryption keys used with a given privacy sandbox API to talk to a
+trusted coordinator. Since this is intended for test automation only,
+coordinatorOrigin must be a .test domain. No existing coordinator configuration
+for the origin may exist.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#method-addPrivacySandboxCoordinatorKeyConfig
+*/
+func (protocol *BrowserProtocol) AddPrivacySandboxCoordinatorKeyConfig(
+	params *browser.AddPrivacySandboxCoordinatorKeyConfigParams,
+) <-chan *browser.AddPrivacySandboxCoordinatorKeyConfigResult {
+	resultChan := make(chan *browser.AddPrivacySandboxCoordinatorKeyConfigResult, 1)
+	command := NewCommand(protocol.Socket, "Browser.addPrivacySandboxCoordinatorKeyConfig", params)
+	result := &browser.AddPrivacySandboxCoordinatorKeyConfigResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDownloadWillBegin adds a handler to the Browser.downloadWillBegin event.
+
+Fired when page is about to start a download. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#event-downloadWillBegin
+*/
+func (protocol *BrowserProtocol) OnDownloadWillBegin(
+	callback func(event *browser.DownloadWillBeginEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Browser.downloadWillBegin",
+		func(response *Response) {
+			event := &browser.DownloadWillBeginEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDownloadProgress adds a handler to the Browser.downloadProgress event.
+
+Fired when download makes progress. Last call has |done| == true. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#event-downloadProgress
+*/
+func (protocol *BrowserProtocol) OnDownloadProgress(
+	callback func(event *browser.DownloadProgressEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Browser.downloadProgress",
+		func(response *Response) {
+			event := &browser.DownloadProgressEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	browser "github.com/mkenney/go-chrome/tot/browser"
 	target "github.com/mkenney/go-chrome/tot/target"
 )
@@ -147,7 +148,7 @@ func TestBrowserGetWindowForTarget(t *testing.T) {
 	defer mockSocket.Stop()
 
 	resultChan := mockSocket.Browser().GetWindowForTarget(&browser.GetWindowForTargetParams{
-		TargetID: target.ID("target-id"),
+		TargetID: optional.Of(target.ID("target-id")),
 	})
 	mockResult := &browser.GetWindowForTargetResult{
 		WindowID: browser.WindowID(1),
@@ -185,7 +186,7 @@ func TestBrowserGetWindowForTarget(t *testing.T) {
 	}
 
 	resultChan = mockSocket.Browser().GetWindowForTarget(&browser.GetWindowForTargetParams{
-		TargetID: target.ID("target-id"),
+		TargetID: optional.Of(target.ID("target-id")),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
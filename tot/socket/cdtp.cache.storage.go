@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/cache/storage"
@@ -10,27 +13,55 @@ import (
 CacheStorageProtocol provides a namespace for the Chrome CacheStorage protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/ EXPERIMENTAL.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/
 */
 type CacheStorageProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-DeleteCache deletes a cache.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *CacheStorageProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *CacheStorageProtocol) WithContext(ctx context.Context) *CacheStorageProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &CacheStorageProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Deletes a cache.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-deleteCache
 */
 func (protocol *CacheStorageProtocol) DeleteCache(
 	params *storage.DeleteCacheParams,
 ) <-chan *storage.DeleteCacheResult {
-	resultChan := make(chan *storage.DeleteCacheResult)
+	resultChan := make(chan *storage.DeleteCacheResult, 1)
 	command := NewCommand(protocol.Socket, "CacheStorage.deleteCache", params)
 	result := &storage.DeleteCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -41,20 +72,22 @@ func (protocol *CacheStorageProtocol) DeleteCache(
 }
 
 /*
-DeleteEntry deletes a cache entry.
+Deletes a cache entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-deleteEntry
 */
 func (protocol *CacheStorageProtocol) DeleteEntry(
 	params *storage.DeleteEntryParams,
 ) <-chan *storage.DeleteEntryResult {
-	resultChan := make(chan *storage.DeleteEntryResult)
+	resultChan := make(chan *storage.DeleteEntryResult, 1)
 	command := NewCommand(protocol.Socket, "CacheStorage.deleteEntry", params)
 	result := &storage.DeleteEntryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,20 +98,27 @@ func (protocol *CacheStorageProtocol) DeleteEntry(
 }
 
 /*
-RequestCacheNames requests cache names.
+Requests cache names.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requestCacheNames
 */
 func (protocol *CacheStorageProtocol) RequestCacheNames(
-	params *storage.RequestCacheNamesParams,
+	params ...*storage.RequestCacheNamesParams,
 ) <-chan *storage.RequestCacheNamesResult {
-	resultChan := make(chan *storage.RequestCacheNamesResult)
-	command := NewCommand(protocol.Socket, "CacheStorage.requestCacheNames", params)
+	resultChan := make(chan *storage.RequestCacheNamesResult, 1)
+	command := NewCommand(protocol.Socket, "CacheStorage.requestCacheNames", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "CacheStorage.requestCacheNames", params[0])
+	}
 	result := &storage.RequestCacheNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -91,20 +131,22 @@ func (protocol *CacheStorageProtocol) RequestCacheNames(
 }
 
 /*
-RequestCachedResponse fetches cache entry.
+Fetches cache entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requestCachedResponse
 */
 func (protocol *CacheStorageProtocol) RequestCachedResponse(
 	params *storage.RequestCachedResponseParams,
 ) <-chan *storage.RequestCachedResponseResult {
-	resultChan := make(chan *storage.RequestCachedResponseResult)
+	resultChan := make(chan *storage.RequestCachedResponseResult, 1)
 	command := NewCommand(protocol.Socket, "CacheStorage.requestCachedResponse", params)
 	result := &storage.RequestCachedResponseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -117,20 +159,22 @@ func (protocol *CacheStorageProtocol) RequestCachedResponse(
 }
 
 /*
-RequestEntries requests data from cache.
+Requests data from cache.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CacheStorage/#method-requestEntries
 */
 func (protocol *CacheStorageProtocol) RequestEntries(
 	params *storage.RequestEntriesParams,
 ) <-chan *storage.RequestEntriesResult {
-	resultChan := make(chan *storage.RequestEntriesResult)
+	resultChan := make(chan *storage.RequestEntriesResult, 1)
 	command := NewCommand(protocol.Socket, "CacheStorage.requestEntries", params)
 	result := &storage.RequestEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
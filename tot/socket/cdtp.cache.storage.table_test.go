@@ -0,0 +1,68 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/cache/storage"
+)
+
+func TestCacheStorageCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestCacheStorageCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"CacheStorage.deleteCache", func() func() error {
+			resultChan := mockSocket.CacheStorage().DeleteCache(&storage.DeleteCacheParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"CacheStorage.deleteEntry", func() func() error {
+			resultChan := mockSocket.CacheStorage().DeleteEntry(&storage.DeleteEntryParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"CacheStorage.requestCacheNames", func() func() error {
+			resultChan := mockSocket.CacheStorage().RequestCacheNames(&storage.RequestCacheNamesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"CacheStorage.requestCachedResponse", func() func() error {
+			resultChan := mockSocket.CacheStorage().RequestCachedResponse(&storage.RequestCachedResponseParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"CacheStorage.requestEntries", func() func() error {
+			resultChan := mockSocket.CacheStorage().RequestEntries(&storage.RequestEntriesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	cacheStorage "github.com/mkenney/go-chrome/tot/cache/storage"
 )
 
@@ -87,7 +88,7 @@ func TestCacheStorageRequestCacheNames(t *testing.T) {
 	defer mockSocket.Stop()
 
 	resultChan := mockSocket.CacheStorage().RequestCacheNames(&cacheStorage.RequestCacheNamesParams{
-		SecurityOrigin: "security-origin",
+		SecurityOrigin: optional.String("security-origin"),
 	})
 	mockResult := &cacheStorage.RequestCacheNamesResult{
 		Caches: []*cacheStorage.Cache{{
@@ -115,7 +116,7 @@ func TestCacheStorageRequestCacheNames(t *testing.T) {
 	}
 
 	resultChan = mockSocket.CacheStorage().RequestCacheNames(&cacheStorage.RequestCacheNamesParams{
-		SecurityOrigin: "security-origin",
+		SecurityOrigin: optional.String("security-origin"),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -190,8 +191,8 @@ func TestCacheStorageRequestEntries(t *testing.T) {
 
 	resultChan := mockSocket.CacheStorage().RequestEntries(&cacheStorage.RequestEntriesParams{
 		CacheID:   cacheStorage.CacheID("security-origin"),
-		SkipCount: 1,
-		PageSize:  1,
+		SkipCount: optional.Int(1),
+		PageSize:  optional.Int(1),
 	})
 	mockResult := &cacheStorage.RequestEntriesResult{
 		CacheDataEntries: []*cacheStorage.DataEntry{{
@@ -231,8 +232,8 @@ func TestCacheStorageRequestEntries(t *testing.T) {
 
 	resultChan = mockSocket.CacheStorage().RequestEntries(&cacheStorage.RequestEntriesParams{
 		CacheID:   cacheStorage.CacheID("security-origin"),
-		SkipCount: 1,
-		PageSize:  1,
+		SkipCount: optional.Int(1),
+		PageSize:  optional.Int(1),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
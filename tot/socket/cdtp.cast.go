@@ -0,0 +1,261 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/cast"
+)
+
+/*
+CastProtocol provides a namespace for the Chrome Cast protocol methods.
+
+A domain for interacting with Cast, Presentation API, and Remote Playback API
+functionalities. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/
+*/
+type CastProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *CastProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *CastProtocol) WithContext(ctx context.Context) *CastProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &CastProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Starts observing for sinks that can be used for tab mirroring, and if set, sinks
+compatible with |presentationUrl| as well. When sinks are found, a
+|sinksUpdated| event is fired. Also starts observing for issue messages. When an
+issue is added or removed, an |issueUpdated| event is fired.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-enable
+*/
+func (protocol *CastProtocol) Enable(
+	params ...*cast.EnableParams,
+) <-chan *cast.EnableResult {
+	resultChan := make(chan *cast.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Cast.enable", params[0])
+	}
+	result := &cast.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stops observing for sinks and issues.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-disable
+*/
+func (protocol *CastProtocol) Disable() <-chan *cast.DisableResult {
+	resultChan := make(chan *cast.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.disable", nil)
+	result := &cast.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets a sink to be used when the web page requests the browser to choose a sink
+via Presentation API, Remote Playback API, or Cast SDK.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-setSinkToUse
+*/
+func (protocol *CastProtocol) SetSinkToUse(
+	params *cast.SetSinkToUseParams,
+) <-chan *cast.SetSinkToUseResult {
+	resultChan := make(chan *cast.SetSinkToUseResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.setSinkToUse", params)
+	result := &cast.SetSinkToUseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Starts mirroring the desktop to the sink.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-startDesktopMirroring
+*/
+func (protocol *CastProtocol) StartDesktopMirroring(
+	params *cast.StartDesktopMirroringParams,
+) <-chan *cast.StartDesktopMirroringResult {
+	resultChan := make(chan *cast.StartDesktopMirroringResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.startDesktopMirroring", params)
+	result := &cast.StartDesktopMirroringResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Starts mirroring the tab to the sink.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-startTabMirroring
+*/
+func (protocol *CastProtocol) StartTabMirroring(
+	params *cast.StartTabMirroringParams,
+) <-chan *cast.StartTabMirroringResult {
+	resultChan := make(chan *cast.StartTabMirroringResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.startTabMirroring", params)
+	result := &cast.StartTabMirroringResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stops the active Cast session on the sink.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#method-stopCasting
+*/
+func (protocol *CastProtocol) StopCasting(
+	params *cast.StopCastingParams,
+) <-chan *cast.StopCastingResult {
+	resultChan := make(chan *cast.StopCastingResult, 1)
+	command := NewCommand(protocol.Socket, "Cast.stopCasting", params)
+	result := &cast.StopCastingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnSinksUpdated adds a handler to the Cast.sinksUpdated event.
+
+This is fired whenever the list of available sinks changes. A sink is a device
+or a software surface that you can cast to.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#event-sinksUpdated
+*/
+func (protocol *CastProtocol) OnSinksUpdated(
+	callback func(event *cast.SinksUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Cast.sinksUpdated",
+		func(response *Response) {
+			event := &cast.SinksUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnIssueUpdated adds a handler to the Cast.issueUpdated event.
+
+This is fired whenever the outstanding issue/error message changes.
+|issueMessage| is empty if there is no issue.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Cast/#event-issueUpdated
+*/
+func (protocol *CastProtocol) OnIssueUpdated(
+	callback func(event *cast.IssueUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Cast.issueUpdated",
+		func(response *Response) {
+			event := &cast.IssueUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
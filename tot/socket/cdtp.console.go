@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/console"
@@ -9,26 +12,53 @@ import (
 /*
 ConsoleProtocol provides a namespace for the Chrome Console protocol methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Console/ DEPRECATED - use
-Runtime or Log instead.
+This domain is deprecated - use Runtime or Log instead. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Console/
 */
 type ConsoleProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-ClearMessages does nothing.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ConsoleProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ConsoleProtocol) WithContext(ctx context.Context) *ConsoleProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ConsoleProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Does nothing.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-clearMessages
 */
 func (protocol *ConsoleProtocol) ClearMessages() <-chan *console.ClearMessagesResult {
-	resultChan := make(chan *console.ClearMessagesResult)
+	resultChan := make(chan *console.ClearMessagesResult, 1)
 	command := NewCommand(protocol.Socket, "Console.clearMessages", nil)
 	result := &console.ClearMessagesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,19 +69,21 @@ func (protocol *ConsoleProtocol) ClearMessages() <-chan *console.ClearMessagesRe
 }
 
 /*
-Disable disables console domain, prevents further console messages from being
-reported to the client.
+Disables console domain, prevents further console messages from being reported
+to the client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-disable
 */
 func (protocol *ConsoleProtocol) Disable() <-chan *console.DisableResult {
-	resultChan := make(chan *console.DisableResult)
+	resultChan := make(chan *console.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Console.disable", nil)
 	result := &console.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,19 +94,21 @@ func (protocol *ConsoleProtocol) Disable() <-chan *console.DisableResult {
 }
 
 /*
-Enable enables console domain, sends the messages collected so far to the client
-by means of the messageAdded notification.
+Enables console domain, sends the messages collected so far to the client by
+means of the `messageAdded` notification.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#method-enable
 */
 func (protocol *ConsoleProtocol) Enable() <-chan *console.EnableResult {
-	resultChan := make(chan *console.EnableResult)
+	resultChan := make(chan *console.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Console.enable", nil)
 	result := &console.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -86,13 +120,14 @@ func (protocol *ConsoleProtocol) Enable() <-chan *console.EnableResult {
 
 /*
 OnMessageAdded adds a handler to the Console.messageAdded event.
-Console.messageAdded fires whenever an active document stylesheet is removed.
+
+Issued when new console message is added.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Console/#event-messageAdded
 */
 func (protocol *ConsoleProtocol) OnMessageAdded(
 	callback func(event *console.MessageAddedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Console.messageAdded",
 		func(response *Response) {
@@ -105,4 +140,5 @@ func (protocol *ConsoleProtocol) OnMessageAdded(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -0,0 +1,107 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/console"
+)
+
+func TestConsoleCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestConsoleCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Console.clearMessages", func() func() error {
+			resultChan := mockSocket.Console().ClearMessages()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Console.disable", func() func() error {
+			resultChan := mockSocket.Console().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Console.enable", func() func() error {
+			resultChan := mockSocket.Console().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestConsoleEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestConsoleEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"Console.messageAdded", func(results chan<- error) *Listener {
+			return mockSocket.Console().OnMessageAdded(func(event *console.MessageAddedEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
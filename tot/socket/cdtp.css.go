@@ -1,44 +1,74 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/css"
 )
 
 /*
-CSSProtocol provides a namespace for the Chrome CSS protocol methods. The CSS
-protocol exposes CSS read/write operations. All CSS objects (stylesheets, rules,
-and styles) have an associated id used in subsequent operations on the related
-object. Each object type has a specific ID structure, and those are not
-interchangeable between objects of different kinds. CSS objects can be loaded
-using the get*ForNode() calls (which accept a DOM node id). A client can also
-keep track of stylesheets via the styleSheetAdded/styleSheetRemoved events and
-subsequently load the required stylesheet contents using the
-getStyleSheet[Text]() methods.
+CSSProtocol provides a namespace for the Chrome CSS protocol methods.
+
+This domain exposes CSS read/write operations. All CSS objects (stylesheets,
+rules, and styles) have an associated `id` used in subsequent operations on the
+related object. Each object type has a specific `id` structure, and those are
+not interchangeable between objects of different kinds. CSS objects can be
+loaded using the `get*ForNode()` calls (which accept a DOM node id). A client
+can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved`
+events and subsequently load the required stylesheet contents using the
+`getStyleSheet[Text]()` methods. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/
 */
 type CSSProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-AddRule inserts a new rule with the given ruleText in a stylesheet with given
-styleSheetId, at the position specified by location.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *CSSProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *CSSProtocol) WithContext(ctx context.Context) *CSSProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &CSSProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Inserts a new rule with the given `ruleText` in a stylesheet with given
+`styleSheetId`, at the position specified by `location`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-addRule
 */
 func (protocol *CSSProtocol) AddRule(
 	params *css.AddRuleParams,
 ) <-chan *css.AddRuleResult {
-	resultChan := make(chan *css.AddRuleResult)
+	resultChan := make(chan *css.AddRuleResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.addRule", params)
 	result := &css.AddRuleResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -51,20 +81,22 @@ func (protocol *CSSProtocol) AddRule(
 }
 
 /*
-CollectClassNames returns all class names from specified stylesheet.
+Returns all class names from specified stylesheet.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-collectClassNames
 */
 func (protocol *CSSProtocol) CollectClassNames(
 	params *css.CollectClassNamesParams,
 ) <-chan *css.CollectClassNamesResult {
-	resultChan := make(chan *css.CollectClassNamesResult)
+	resultChan := make(chan *css.CollectClassNamesResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.collectClassNames", params)
 	result := &css.CollectClassNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -77,21 +109,23 @@ func (protocol *CSSProtocol) CollectClassNames(
 }
 
 /*
-CreateStyleSheet creates a new special "via-inspector" stylesheet in the frame
-with given frameId.
+Creates a new special "via-inspector" stylesheet in the frame with given
+`frameId`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-createStyleSheet
 */
 func (protocol *CSSProtocol) CreateStyleSheet(
 	params *css.CreateStyleSheetParams,
 ) <-chan *css.CreateStyleSheetResult {
-	resultChan := make(chan *css.CreateStyleSheetResult)
+	resultChan := make(chan *css.CreateStyleSheetResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.createStyleSheet", params)
 	result := &css.CreateStyleSheetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -104,18 +138,20 @@ func (protocol *CSSProtocol) CreateStyleSheet(
 }
 
 /*
-Disable disables the CSS agent for the given page.
+Disables the CSS agent for the given page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-disable
 */
 func (protocol *CSSProtocol) Disable() <-chan *css.DisableResult {
-	resultChan := make(chan *css.DisableResult)
+	resultChan := make(chan *css.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.disable", nil)
 	result := &css.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -126,19 +162,21 @@ func (protocol *CSSProtocol) Disable() <-chan *css.DisableResult {
 }
 
 /*
-Enable enables the CSS agent for the given page. Clients should not assume that
-the CSS agent has been enabled until the result of this command is received.
+Enables the CSS agent for the given page. Clients should not assume that the CSS
+agent has been enabled until the result of this command is received.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-enable
 */
 func (protocol *CSSProtocol) Enable() <-chan *css.EnableResult {
-	resultChan := make(chan *css.EnableResult)
+	resultChan := make(chan *css.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.enable", nil)
 	result := &css.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -149,21 +187,23 @@ func (protocol *CSSProtocol) Enable() <-chan *css.EnableResult {
 }
 
 /*
-ForcePseudoState ensures that the given node will have specified pseudo-classes
-whenever its style is computed by the browser.
+Ensures that the given node will have specified pseudo-classes whenever its
+style is computed by the browser.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forcePseudoState
 */
 func (protocol *CSSProtocol) ForcePseudoState(
 	params *css.ForcePseudoStateParams,
 ) <-chan *css.ForcePseudoStateResult {
-	resultChan := make(chan *css.ForcePseudoStateResult)
+	resultChan := make(chan *css.ForcePseudoStateResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.forcePseudoState", params)
 	result := &css.ForcePseudoStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -174,20 +214,48 @@ func (protocol *CSSProtocol) ForcePseudoState(
 }
 
 /*
-GetBackgroundColors gets background colors for a node.
+Ensures that the given node is in its starting-style state.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-forceStartingStyle
+*/
+func (protocol *CSSProtocol) ForceStartingStyle(
+	params *css.ForceStartingStyleParams,
+) <-chan *css.ForceStartingStyleResult {
+	resultChan := make(chan *css.ForceStartingStyleResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.forceStartingStyle", params)
+	result := &css.ForceStartingStyleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetBackgroundColors sends the CSS.getBackgroundColors command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getBackgroundColors
 */
 func (protocol *CSSProtocol) GetBackgroundColors(
 	params *css.GetBackgroundColorsParams,
 ) <-chan *css.GetBackgroundColorsResult {
-	resultChan := make(chan *css.GetBackgroundColorsResult)
+	resultChan := make(chan *css.GetBackgroundColorsResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getBackgroundColors", params)
 	result := &css.GetBackgroundColorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -200,21 +268,57 @@ func (protocol *CSSProtocol) GetBackgroundColors(
 }
 
 /*
-GetComputedStyleForNode returns the computed style for a DOM node identified by
-nodeId.
+Returns the computed style for a DOM node identified by `nodeId`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getComputedStyleForNode
 */
 func (protocol *CSSProtocol) GetComputedStyleForNode(
 	params *css.GetComputedStyleForNodeParams,
 ) <-chan *css.GetComputedStyleForNodeResult {
-	resultChan := make(chan *css.GetComputedStyleForNodeResult)
+	resultChan := make(chan *css.GetComputedStyleForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getComputedStyleForNode", params)
 	result := &css.GetComputedStyleForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resolve the specified values in the context of the provided element. For
+example, a value of '1em' is evaluated according to the computed 'font-size' of
+the element and a value 'calc(1px + 2px)' will be resolved to '3px'. If the
+`propertyName` was specified the `values` are resolved as if they were
+property's declaration. If a value cannot be parsed according to the provided
+property syntax, the value is parsed using combined syntax as if null
+`propertyName` was provided. If the value cannot be resolved even then, return
+the provided value without any changes. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-resolveValues
+*/
+func (protocol *CSSProtocol) ResolveValues(
+	params *css.ResolveValuesParams,
+) <-chan *css.ResolveValuesResult {
+	resultChan := make(chan *css.ResolveValuesResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.resolveValues", params)
+	result := &css.ResolveValuesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -227,22 +331,51 @@ func (protocol *CSSProtocol) GetComputedStyleForNode(
 }
 
 /*
-GetInlineStylesForNode returns the styles defined inline (explicitly in the
-"style" attribute and  implicitly, using DOM attributes) for a DOM node
-identified by nodeId.
+GetLonghandProperties sends the CSS.getLonghandProperties command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLonghandProperties
+*/
+func (protocol *CSSProtocol) GetLonghandProperties(
+	params *css.GetLonghandPropertiesParams,
+) <-chan *css.GetLonghandPropertiesResult {
+	resultChan := make(chan *css.GetLonghandPropertiesResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.getLonghandProperties", params)
+	result := &css.GetLonghandPropertiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the styles defined inline (explicitly in the "style" attribute and
+implicitly, using DOM attributes) for a DOM node identified by `nodeId`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getInlineStylesForNode
 */
 func (protocol *CSSProtocol) GetInlineStylesForNode(
 	params *css.GetInlineStylesForNodeParams,
 ) <-chan *css.GetInlineStylesForNodeResult {
-	resultChan := make(chan *css.GetInlineStylesForNodeResult)
+	resultChan := make(chan *css.GetInlineStylesForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getInlineStylesForNode", params)
 	result := &css.GetInlineStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -255,21 +388,51 @@ func (protocol *CSSProtocol) GetInlineStylesForNode(
 }
 
 /*
-GetMatchedStylesForNode returns requested styles for a DOM node identified by
-nodeId.
+Returns the styles coming from animations & transitions including the animation
+& transition styles coming from inheritance chain. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getAnimatedStylesForNode
+*/
+func (protocol *CSSProtocol) GetAnimatedStylesForNode(
+	params *css.GetAnimatedStylesForNodeParams,
+) <-chan *css.GetAnimatedStylesForNodeResult {
+	resultChan := make(chan *css.GetAnimatedStylesForNodeResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.getAnimatedStylesForNode", params)
+	result := &css.GetAnimatedStylesForNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns requested styles for a DOM node identified by `nodeId`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMatchedStylesForNode
 */
 func (protocol *CSSProtocol) GetMatchedStylesForNode(
 	params *css.GetMatchedStylesForNodeParams,
 ) <-chan *css.GetMatchedStylesForNodeResult {
-	resultChan := make(chan *css.GetMatchedStylesForNodeResult)
+	resultChan := make(chan *css.GetMatchedStylesForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getMatchedStylesForNode", params)
 	result := &css.GetMatchedStylesForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -282,18 +445,47 @@ func (protocol *CSSProtocol) GetMatchedStylesForNode(
 }
 
 /*
-GetMediaQueries returns all media queries parsed by the rendering engine.
+Returns the values of the default UA-defined environment variables used in env()
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getEnvironmentVariables
+*/
+func (protocol *CSSProtocol) GetEnvironmentVariables() <-chan *css.GetEnvironmentVariablesResult {
+	resultChan := make(chan *css.GetEnvironmentVariablesResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.getEnvironmentVariables", nil)
+	result := &css.GetEnvironmentVariablesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all media queries parsed by the rendering engine.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getMediaQueries
 */
 func (protocol *CSSProtocol) GetMediaQueries() <-chan *css.GetMediaQueriesResult {
-	resultChan := make(chan *css.GetMediaQueriesResult)
+	resultChan := make(chan *css.GetMediaQueriesResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getMediaQueries", nil)
 	result := &css.GetMediaQueriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -306,21 +498,23 @@ func (protocol *CSSProtocol) GetMediaQueries() <-chan *css.GetMediaQueriesResult
 }
 
 /*
-GetPlatformFontsForNode requests information about platform fonts which we used
-to render child TextNodes in the given node.
+Requests information about platform fonts which we used to render child
+TextNodes in the given node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getPlatformFontsForNode
 */
 func (protocol *CSSProtocol) GetPlatformFontsForNode(
 	params *css.GetPlatformFontsForNodeParams,
 ) <-chan *css.GetPlatformFontsForNodeResult {
-	resultChan := make(chan *css.GetPlatformFontsForNodeResult)
+	resultChan := make(chan *css.GetPlatformFontsForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getPlatformFontsForNode", params)
 	result := &css.GetPlatformFontsForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -333,21 +527,175 @@ func (protocol *CSSProtocol) GetPlatformFontsForNode(
 }
 
 /*
-GetStyleSheetText returns the current textual content and the URL for a
-stylesheet.
+Returns the current textual content for a stylesheet.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getStyleSheetText
 */
 func (protocol *CSSProtocol) GetStyleSheetText(
 	params *css.GetStyleSheetTextParams,
 ) <-chan *css.GetStyleSheetTextResult {
-	resultChan := make(chan *css.GetStyleSheetTextResult)
+	resultChan := make(chan *css.GetStyleSheetTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.getStyleSheetText", params)
 	result := &css.GetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all layers parsed by the rendering engine for the tree scope of a node.
+Given a DOM element identified by nodeId, getLayersForNode returns the root
+layer for the nearest ancestor document or shadow root. The layer root contains
+the full layer tree for the tree scope and their ordering. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLayersForNode
+*/
+func (protocol *CSSProtocol) GetLayersForNode(
+	params *css.GetLayersForNodeParams,
+) <-chan *css.GetLayersForNodeResult {
+	resultChan := make(chan *css.GetLayersForNodeResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.getLayersForNode", params)
+	result := &css.GetLayersForNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Given a CSS selector text and a style sheet ID, getLocationForSelector returns
+an array of locations of the CSS selector in the style sheet. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-getLocationForSelector
+*/
+func (protocol *CSSProtocol) GetLocationForSelector(
+	params *css.GetLocationForSelectorParams,
+) <-chan *css.GetLocationForSelectorResult {
+	resultChan := make(chan *css.GetLocationForSelectorResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.getLocationForSelector", params)
+	result := &css.GetLocationForSelectorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Starts tracking the given node for the computed style updates and whenever the
+computed style is updated for node, it queues a `computedStyleUpdated` event
+with throttling. There can only be 1 node tracked for computed style updates so
+passing a new node id removes tracking from the previous node. Pass `undefined`
+to disable tracking.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdatesForNode
+*/
+func (protocol *CSSProtocol) TrackComputedStyleUpdatesForNode(
+	params ...*css.TrackComputedStyleUpdatesForNodeParams,
+) <-chan *css.TrackComputedStyleUpdatesForNodeResult {
+	resultChan := make(chan *css.TrackComputedStyleUpdatesForNodeResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.trackComputedStyleUpdatesForNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "CSS.trackComputedStyleUpdatesForNode", params[0])
+	}
+	result := &css.TrackComputedStyleUpdatesForNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Starts tracking the given computed styles for updates. The specified array of
+properties replaces the one previously specified. Pass empty array to disable
+tracking. Use takeComputedStyleUpdates to retrieve the list of nodes that had
+properties modified. The changes to computed style properties are only tracked
+for nodes pushed to the front-end by the DOM agent. If no changes to the tracked
+properties occur after the node has been pushed to the front-end, no updates
+will be issued for the node. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-trackComputedStyleUpdates
+*/
+func (protocol *CSSProtocol) TrackComputedStyleUpdates(
+	params *css.TrackComputedStyleUpdatesParams,
+) <-chan *css.TrackComputedStyleUpdatesResult {
+	resultChan := make(chan *css.TrackComputedStyleUpdatesResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.trackComputedStyleUpdates", params)
+	result := &css.TrackComputedStyleUpdatesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Polls the next batch of computed style updates. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeComputedStyleUpdates
+*/
+func (protocol *CSSProtocol) TakeComputedStyleUpdates() <-chan *css.TakeComputedStyleUpdatesResult {
+	resultChan := make(chan *css.TakeComputedStyleUpdatesResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.takeComputedStyleUpdates", nil)
+	result := &css.TakeComputedStyleUpdatesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -360,22 +708,52 @@ func (protocol *CSSProtocol) GetStyleSheetText(
 }
 
 /*
-SetEffectivePropertyValueForNode finds a rule with the given active property for
-the given node and sets the new value for that property.
+Find a rule with the given active property for the given node and set the new
+value for this property
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setEffectivePropertyValueForNode
 */
 func (protocol *CSSProtocol) SetEffectivePropertyValueForNode(
 	params *css.SetEffectivePropertyValueForNodeParams,
 ) <-chan *css.SetEffectivePropertyValueForNodeResult {
-	resultChan := make(chan *css.SetEffectivePropertyValueForNodeResult)
+	resultChan := make(chan *css.SetEffectivePropertyValueForNodeResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setEffectivePropertyValueForNode", params)
 	result := &css.SetEffectivePropertyValueForNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Modifies the property rule property name.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setPropertyRulePropertyName
+*/
+func (protocol *CSSProtocol) SetPropertyRulePropertyName(
+	params *css.SetPropertyRulePropertyNameParams,
+) <-chan *css.SetPropertyRulePropertyNameResult {
+	resultChan := make(chan *css.SetPropertyRulePropertyNameResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.setPropertyRulePropertyName", params)
+	result := &css.SetPropertyRulePropertyNameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -385,20 +763,22 @@ func (protocol *CSSProtocol) SetEffectivePropertyValueForNode(
 }
 
 /*
-SetKeyframeKey modifies the keyframe rule key text.
+Modifies the keyframe rule key text.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setKeyframeKey
 */
 func (protocol *CSSProtocol) SetKeyframeKey(
 	params *css.SetKeyframeKeyParams,
 ) <-chan *css.SetKeyframeKeyResult {
-	resultChan := make(chan *css.SetKeyframeKeyResult)
+	resultChan := make(chan *css.SetKeyframeKeyResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setKeyframeKey", params)
 	result := &css.SetKeyframeKeyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -411,20 +791,22 @@ func (protocol *CSSProtocol) SetKeyframeKey(
 }
 
 /*
-SetMediaText modifies the rule selector.
+Modifies the rule selector.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setMediaText
 */
 func (protocol *CSSProtocol) SetMediaText(
 	params *css.SetMediaTextParams,
 ) <-chan *css.SetMediaTextResult {
-	resultChan := make(chan *css.SetMediaTextResult)
+	resultChan := make(chan *css.SetMediaTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setMediaText", params)
 	result := &css.SetMediaTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -437,20 +819,106 @@ func (protocol *CSSProtocol) SetMediaText(
 }
 
 /*
-SetRuleSelector modifies the rule selector.
+Modifies the expression of a container query. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setContainerQueryText
+*/
+func (protocol *CSSProtocol) SetContainerQueryText(
+	params *css.SetContainerQueryTextParams,
+) <-chan *css.SetContainerQueryTextResult {
+	resultChan := make(chan *css.SetContainerQueryTextResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.setContainerQueryText", params)
+	result := &css.SetContainerQueryTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Modifies the expression of a supports at-rule. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setSupportsText
+*/
+func (protocol *CSSProtocol) SetSupportsText(
+	params *css.SetSupportsTextParams,
+) <-chan *css.SetSupportsTextResult {
+	resultChan := make(chan *css.SetSupportsTextResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.setSupportsText", params)
+	result := &css.SetSupportsTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Modifies the expression of a scope at-rule. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setScopeText
+*/
+func (protocol *CSSProtocol) SetScopeText(
+	params *css.SetScopeTextParams,
+) <-chan *css.SetScopeTextResult {
+	resultChan := make(chan *css.SetScopeTextResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.setScopeText", params)
+	result := &css.SetScopeTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Modifies the rule selector.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setRuleSelector
 */
 func (protocol *CSSProtocol) SetRuleSelector(
 	params *css.SetRuleSelectorParams,
 ) <-chan *css.SetRuleSelectorResult {
-	resultChan := make(chan *css.SetRuleSelectorResult)
+	resultChan := make(chan *css.SetRuleSelectorResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setRuleSelector", params)
 	result := &css.SetRuleSelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -463,20 +931,22 @@ func (protocol *CSSProtocol) SetRuleSelector(
 }
 
 /*
-SetStyleSheetText sets the new stylesheet text.
+Sets the new stylesheet text.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleSheetText
 */
 func (protocol *CSSProtocol) SetStyleSheetText(
 	params *css.SetStyleSheetTextParams,
 ) <-chan *css.SetStyleSheetTextResult {
-	resultChan := make(chan *css.SetStyleSheetTextResult)
+	resultChan := make(chan *css.SetStyleSheetTextResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setStyleSheetText", params)
 	result := &css.SetStyleSheetTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -489,20 +959,22 @@ func (protocol *CSSProtocol) SetStyleSheetText(
 }
 
 /*
-SetStyleTexts applies specified style edits one after another in the given order.
+Applies specified style edits one after another in the given order.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setStyleTexts
 */
 func (protocol *CSSProtocol) SetStyleTexts(
 	params *css.SetStyleTextsParams,
 ) <-chan *css.SetStyleTextsResult {
-	resultChan := make(chan *css.SetStyleTextsResult)
+	resultChan := make(chan *css.SetStyleTextsResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.setStyleTexts", params)
 	result := &css.SetStyleTextsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -515,18 +987,20 @@ func (protocol *CSSProtocol) SetStyleTexts(
 }
 
 /*
-StartRuleUsageTracking enables the selector recording.
+Enables the selector recording.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-startRuleUsageTracking
 */
 func (protocol *CSSProtocol) StartRuleUsageTracking() <-chan *css.StartRuleUsageTrackingResult {
-	resultChan := make(chan *css.StartRuleUsageTrackingResult)
+	resultChan := make(chan *css.StartRuleUsageTrackingResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.startRuleUsageTracking", nil)
 	result := &css.StartRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -537,19 +1011,21 @@ func (protocol *CSSProtocol) StartRuleUsageTracking() <-chan *css.StartRuleUsage
 }
 
 /*
-StopRuleUsageTracking returns he list of rules with an indication of whether
-they were used.
+Stop tracking rule usage and return the list of rules that were used since last
+call to `takeCoverageDelta` (or since start of coverage instrumentation).
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-stopRuleUsageTracking
 */
 func (protocol *CSSProtocol) StopRuleUsageTracking() <-chan *css.StopRuleUsageTrackingResult {
-	resultChan := make(chan *css.StopRuleUsageTrackingResult)
+	resultChan := make(chan *css.StopRuleUsageTrackingResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.stopRuleUsageTracking", nil)
 	result := &css.StopRuleUsageTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -562,19 +1038,21 @@ func (protocol *CSSProtocol) StopRuleUsageTracking() <-chan *css.StopRuleUsageTr
 }
 
 /*
-TakeCoverageDelta obtains the list of rules that became used since last call to
-this method (or since start of coverage instrumentation).
+Obtain list of rules that became used since last call to this method (or since
+start of coverage instrumentation).
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-takeCoverageDelta
 */
 func (protocol *CSSProtocol) TakeCoverageDelta() <-chan *css.TakeCoverageDeltaResult {
-	resultChan := make(chan *css.TakeCoverageDeltaResult)
+	resultChan := make(chan *css.TakeCoverageDeltaResult, 1)
 	command := NewCommand(protocol.Socket, "CSS.takeCoverageDelta", nil)
 	result := &css.TakeCoverageDeltaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -587,14 +1065,43 @@ func (protocol *CSSProtocol) TakeCoverageDelta() <-chan *css.TakeCoverageDeltaRe
 }
 
 /*
-OnFontsUpdated adds a handler to the CSS.fontsUpdated event. CSS.fontsUpdated
-fires whenever a web font gets loaded.
+Enables/disables rendering of local CSS fonts (enabled by default).
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#method-setLocalFontsEnabled
+*/
+func (protocol *CSSProtocol) SetLocalFontsEnabled(
+	params *css.SetLocalFontsEnabledParams,
+) <-chan *css.SetLocalFontsEnabledResult {
+	resultChan := make(chan *css.SetLocalFontsEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "CSS.setLocalFontsEnabled", params)
+	result := &css.SetLocalFontsEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnFontsUpdated adds a handler to the CSS.fontsUpdated event.
+
+Fires whenever a web font is updated. A non-empty font parameter indicates a
+successfully loaded web font.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-fontsUpdated
 */
 func (protocol *CSSProtocol) OnFontsUpdated(
 	callback func(event *css.FontsUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"CSS.fontsUpdated",
 		func(response *Response) {
@@ -607,19 +1114,22 @@ func (protocol *CSSProtocol) OnFontsUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnMediaQueryResultChanged adds a handler to the CSS.mediaQueryResultChanged
-event. CSS.mediaQueryResultChanged fires whenever a MediaQuery result changes
-(for example, after a browser window has been resized.) The current
-implementation considers only viewport-dependent media features.
+event.
+
+Fires whenever a MediaQuery result changes (for example, after a browser window
+has been resized.) The current implementation considers only viewport-dependent
+media features.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-mediaQueryResultChanged
 */
 func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 	callback func(event *css.MediaQueryResultChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"CSS.mediaQueryResultChanged",
 		func(response *Response) {
@@ -632,17 +1142,19 @@ func (protocol *CSSProtocol) OnMediaQueryResultChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnStyleSheetAdded adds a handler to the CSS.styleSheetAdded event.
-CSS.styleSheetAdded fires whenever an active document stylesheet is added.
+
+Fired whenever an active document stylesheet is added.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetAdded
 */
 func (protocol *CSSProtocol) OnStyleSheetAdded(
 	callback func(event *css.StyleSheetAddedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"CSS.styleSheetAdded",
 		func(response *Response) {
@@ -655,18 +1167,19 @@ func (protocol *CSSProtocol) OnStyleSheetAdded(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnStyleSheetChanged adds a handler to the CSS.styleSheetChanged event.
-CSS.styleSheetChanged fires whenever a stylesheet is changed as a result of the
-client operation.
+
+Fired whenever a stylesheet is changed as a result of the client operation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetChanged
 */
 func (protocol *CSSProtocol) OnStyleSheetChanged(
 	callback func(event *css.StyleSheetChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"CSS.styleSheetChanged",
 		func(response *Response) {
@@ -679,17 +1192,19 @@ func (protocol *CSSProtocol) OnStyleSheetChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnStyleSheetRemoved adds a handler to the CSS.styleSheetRemoved event.
-CSS.styleSheetRemoved fires whenever an active document stylesheet is removed.
+
+Fired whenever an active document stylesheet is removed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-styleSheetRemoved
 */
 func (protocol *CSSProtocol) OnStyleSheetRemoved(
 	callback func(event *css.StyleSheetRemovedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"CSS.styleSheetRemoved",
 		func(response *Response) {
@@ -702,4 +1217,30 @@ func (protocol *CSSProtocol) OnStyleSheetRemoved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnComputedStyleUpdated adds a handler to the CSS.computedStyleUpdated event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/CSS/#event-computedStyleUpdated
+*/
+func (protocol *CSSProtocol) OnComputedStyleUpdated(
+	callback func(event *css.ComputedStyleUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"CSS.computedStyleUpdated",
+		func(response *Response) {
+			event := &css.ComputedStyleUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/database"
@@ -9,26 +12,54 @@ import (
 /*
 DatabaseProtocol provides a namespace for the Chrome Database protocol methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Database/ EXPERIMENTAL.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Database/
 */
 type DatabaseProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DatabaseProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DatabaseProtocol) WithContext(ctx context.Context) *DatabaseProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DatabaseProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-Disable disables database tracking, prevents database events from being sent to
-the client.
+Disables database tracking, prevents database events from being sent to the
+client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-disable
 */
 func (protocol *DatabaseProtocol) Disable() <-chan *database.DisableResult {
-	resultChan := make(chan *database.DisableResult)
+	resultChan := make(chan *database.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Database.disable", nil)
 	result := &database.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,19 +70,20 @@ func (protocol *DatabaseProtocol) Disable() <-chan *database.DisableResult {
 }
 
 /*
-Enable enables database tracking, database events will now be delivered to the
-client.
+Enables database tracking, database events will now be delivered to the client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-enable
 */
 func (protocol *DatabaseProtocol) Enable() <-chan *database.EnableResult {
-	resultChan := make(chan *database.EnableResult)
+	resultChan := make(chan *database.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Database.enable", nil)
 	result := &database.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,20 +94,22 @@ func (protocol *DatabaseProtocol) Enable() <-chan *database.EnableResult {
 }
 
 /*
-ExecuteSQL executes a SQL query.
+Executes a SQL query.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-executeSQL
 */
 func (protocol *DatabaseProtocol) ExecuteSQL(
 	params *database.ExecuteSQLParams,
 ) <-chan *database.ExecuteSQLResult {
-	resultChan := make(chan *database.ExecuteSQLResult)
+	resultChan := make(chan *database.ExecuteSQLResult, 1)
 	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
 	result := &database.ExecuteSQLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -88,20 +122,22 @@ func (protocol *DatabaseProtocol) ExecuteSQL(
 }
 
 /*
-GetTableNames gets database table names.
+Gets database table names.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#method-getDatabaseTableNames
 */
 func (protocol *DatabaseProtocol) GetTableNames(
 	params *database.GetTableNamesParams,
 ) <-chan *database.GetTableNamesResult {
-	resultChan := make(chan *database.GetTableNamesResult)
-	command := NewCommand(protocol.Socket, "Database.executeSQL", params)
+	resultChan := make(chan *database.GetTableNamesResult, 1)
+	command := NewCommand(protocol.Socket, "Database.getDatabaseTableNames", params)
 	result := &database.GetTableNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -114,14 +150,15 @@ func (protocol *DatabaseProtocol) GetTableNames(
 }
 
 /*
-OnAdd adds a handler to the Database.addDatabase event. Database.addDatabase
-fires whenever a database is added
+OnAdd adds a handler to the Database.addDatabase event.
+
+# Database.addDatabase fires whenever a database is added
 
 https://chromedevtools.github.io/devtools-protocol/tot/Database/#event-addDatabase
 */
 func (protocol *DatabaseProtocol) OnAdd(
 	callback func(event *database.AddEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Database.addDatabase",
 		func(response *Response) {
@@ -134,4 +171,5 @@ func (protocol *DatabaseProtocol) OnAdd(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
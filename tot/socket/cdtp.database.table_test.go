@@ -0,0 +1,111 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/database"
+)
+
+func TestDatabaseCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDatabaseCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Database.disable", func() func() error {
+			resultChan := mockSocket.Database().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Database.enable", func() func() error {
+			resultChan := mockSocket.Database().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Database.executeSQL", func() func() error {
+			resultChan := mockSocket.Database().ExecuteSQL(&database.ExecuteSQLParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Database.getDatabaseTableNames", func() func() error {
+			resultChan := mockSocket.Database().GetTableNames(&database.GetTableNamesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestDatabaseEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDatabaseEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"Database.addDatabase", func(results chan<- error) *Listener {
+			return mockSocket.Database().OnAdd(func(event *database.AddEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
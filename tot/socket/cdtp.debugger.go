@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/debugger"
@@ -8,31 +11,57 @@ import (
 
 /*
 DebuggerProtocol provides a namespace for the Chrome Debugger protocol methods.
-The Debugger protocol exposes JavaScript debugging capabilities. It allows
-setting and removing breakpoints, stepping through execution, exploring stack
-traces, etc.
+
+Debugger domain exposes JavaScript debugging capabilities. It allows setting and
+removing breakpoints, stepping through execution, exploring stack traces, etc.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/
 */
 type DebuggerProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DebuggerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DebuggerProtocol) WithContext(ctx context.Context) *DebuggerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DebuggerProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-ContinueToLocation continues execution until specific location is reached.
+Continues execution until specific location is reached.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-continueToLocation
 */
 func (protocol *DebuggerProtocol) ContinueToLocation(
 	params *debugger.ContinueToLocationParams,
 ) <-chan *debugger.ContinueToLocationResult {
-	resultChan := make(chan *debugger.ContinueToLocationResult)
+	resultChan := make(chan *debugger.ContinueToLocationResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.continueToLocation", params)
 	result := &debugger.ContinueToLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,18 +72,20 @@ func (protocol *DebuggerProtocol) ContinueToLocation(
 }
 
 /*
-Disable disables debugger for given page.
+Disables debugger for given page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disable
 */
 func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
-	resultChan := make(chan *debugger.DisableResult)
+	resultChan := make(chan *debugger.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.disable", nil)
 	result := &debugger.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,19 +96,28 @@ func (protocol *DebuggerProtocol) Disable() <-chan *debugger.DisableResult {
 }
 
 /*
-Enable enables debugger for the given page. Clients should not assume that the
+Enables debugger for the given page. Clients should not assume that the
 debugging has been enabled until the result for this command is received.
 
+The parameters are optional.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-enable
 */
-func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
-	resultChan := make(chan *debugger.EnableResult)
+func (protocol *DebuggerProtocol) Enable(
+	params ...*debugger.EnableParams,
+) <-chan *debugger.EnableResult {
+	resultChan := make(chan *debugger.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Debugger.enable", params[0])
+	}
 	result := &debugger.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -90,20 +130,22 @@ func (protocol *DebuggerProtocol) Enable() <-chan *debugger.EnableResult {
 }
 
 /*
-EvaluateOnCallFrame evaluates expression on a given call frame.
+Evaluates expression on a given call frame.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-evaluateOnCallFrame
 */
 func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
 	params *debugger.EvaluateOnCallFrameParams,
 ) <-chan *debugger.EvaluateOnCallFrameResult {
-	resultChan := make(chan *debugger.EvaluateOnCallFrameResult)
+	resultChan := make(chan *debugger.EvaluateOnCallFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.evaluateOnCallFrame", params)
 	result := &debugger.EvaluateOnCallFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -116,21 +158,23 @@ func (protocol *DebuggerProtocol) EvaluateOnCallFrame(
 }
 
 /*
-GetPossibleBreakpoints returns possible locations for breakpoint. scriptId in
-start and end range locations should be the same.
+Returns possible locations for breakpoint. scriptId in start and end range
+locations should be the same.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getPossibleBreakpoints
 */
 func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
 	params *debugger.GetPossibleBreakpointsParams,
 ) <-chan *debugger.GetPossibleBreakpointsResult {
-	resultChan := make(chan *debugger.GetPossibleBreakpointsResult)
+	resultChan := make(chan *debugger.GetPossibleBreakpointsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getPossibleBreakpoints", params)
 	result := &debugger.GetPossibleBreakpointsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -143,20 +187,51 @@ func (protocol *DebuggerProtocol) GetPossibleBreakpoints(
 }
 
 /*
-GetScriptSource returns source for the script with given id.
+Returns source for the script with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getScriptSource
 */
 func (protocol *DebuggerProtocol) GetScriptSource(
 	params *debugger.GetScriptSourceParams,
 ) <-chan *debugger.GetScriptSourceResult {
-	resultChan := make(chan *debugger.GetScriptSourceResult)
+	resultChan := make(chan *debugger.GetScriptSourceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getScriptSource", params)
 	result := &debugger.GetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+DisassembleWasmModule sends the Debugger.disassembleWasmModule command.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-disassembleWasmModule
+*/
+func (protocol *DebuggerProtocol) DisassembleWasmModule(
+	params *debugger.DisassembleWasmModuleParams,
+) <-chan *debugger.DisassembleWasmModuleResult {
+	resultChan := make(chan *debugger.DisassembleWasmModuleResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.disassembleWasmModule", params)
+	result := &debugger.DisassembleWasmModuleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -169,20 +244,81 @@ func (protocol *DebuggerProtocol) GetScriptSource(
 }
 
 /*
-GetStackTrace returns stack trace with given stackTraceId.
+Disassemble the next chunk of lines for the module corresponding to the stream.
+If disassembly is complete, this API will invalidate the streamId and return an
+empty chunk. Any subsequent calls for the now invalid stream will return errors.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStackTrace EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-nextWasmDisassemblyChunk
+*/
+func (protocol *DebuggerProtocol) NextWasmDisassemblyChunk(
+	params *debugger.NextWasmDisassemblyChunkParams,
+) <-chan *debugger.NextWasmDisassemblyChunkResult {
+	resultChan := make(chan *debugger.NextWasmDisassemblyChunkResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.nextWasmDisassemblyChunk", params)
+	result := &debugger.NextWasmDisassemblyChunkResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+This command is deprecated. Use getScriptSource instead. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getWasmBytecode
+*/
+func (protocol *DebuggerProtocol) GetWasmBytecode(
+	params *debugger.GetWasmBytecodeParams,
+) <-chan *debugger.GetWasmBytecodeResult {
+	resultChan := make(chan *debugger.GetWasmBytecodeResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.getWasmBytecode", params)
+	result := &debugger.GetWasmBytecodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns stack trace with given `stackTraceId`. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-getStackTrace
 */
 func (protocol *DebuggerProtocol) GetStackTrace(
 	params *debugger.GetStackTraceParams,
 ) <-chan *debugger.GetStackTraceResult {
-	resultChan := make(chan *debugger.GetStackTraceResult)
+	resultChan := make(chan *debugger.GetStackTraceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.getStackTrace", params)
 	result := &debugger.GetStackTraceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -195,18 +331,20 @@ func (protocol *DebuggerProtocol) GetStackTrace(
 }
 
 /*
-Pause stops on the next JavaScript statement.
+Stops on the next JavaScript statement.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pause
 */
 func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
-	resultChan := make(chan *debugger.PauseResult)
+	resultChan := make(chan *debugger.PauseResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.pause", nil)
 	result := &debugger.PauseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -217,20 +355,23 @@ func (protocol *DebuggerProtocol) Pause() <-chan *debugger.PauseResult {
 }
 
 /*
-PauseOnAsyncCall is experimental
+PauseOnAsyncCall sends the Debugger.pauseOnAsyncCall command. EXPERIMENTAL.
+DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnAsyncCall EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-pauseOnAsyncCall
 */
 func (protocol *DebuggerProtocol) PauseOnAsyncCall(
 	params *debugger.PauseOnAsyncCallParams,
 ) <-chan *debugger.PauseOnAsyncCallResult {
-	resultChan := make(chan *debugger.PauseOnAsyncCallResult)
+	resultChan := make(chan *debugger.PauseOnAsyncCallResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.pauseOnAsyncCall", params)
 	result := &debugger.PauseOnAsyncCallResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -241,20 +382,22 @@ func (protocol *DebuggerProtocol) PauseOnAsyncCall(
 }
 
 /*
-RemoveBreakpoint removes JavaScript breakpoint.
+Removes JavaScript breakpoint.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-removeBreakpoint
 */
 func (protocol *DebuggerProtocol) RemoveBreakpoint(
 	params *debugger.RemoveBreakpointParams,
 ) <-chan *debugger.RemoveBreakpointResult {
-	resultChan := make(chan *debugger.RemoveBreakpointResult)
+	resultChan := make(chan *debugger.RemoveBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.removeBreakpoint", params)
 	result := &debugger.RemoveBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -265,20 +408,33 @@ func (protocol *DebuggerProtocol) RemoveBreakpoint(
 }
 
 /*
-RestartFrame restarts particular call frame from the beginning.
+Restarts particular call frame from the beginning. The old, deprecated behavior
+of `restartFrame` is to stay paused and allow further CDP commands after a
+restart was scheduled. This can cause problems with restarting, so we now
+continue execution immediatly after it has been scheduled until we reach the
+beginning of the restarted frame.
+
+To stay back-wards compatible, `restartFrame` now expects a `mode` parameter to
+be present. If the `mode` parameter is missing, `restartFrame` errors out.
+
+The various return values are deprecated and `callFrames` is always empty. Use
+the call frames from the `Debugger#paused` events instead, that fires once V8
+pauses at the beginning of the restarted function.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-restartFrame
 */
 func (protocol *DebuggerProtocol) RestartFrame(
 	params *debugger.RestartFrameParams,
 ) <-chan *debugger.RestartFrameResult {
-	resultChan := make(chan *debugger.RestartFrameResult)
+	resultChan := make(chan *debugger.RestartFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.restartFrame", params)
 	result := &debugger.RestartFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -291,18 +447,27 @@ func (protocol *DebuggerProtocol) RestartFrame(
 }
 
 /*
-Resume resumes JavaScript execution.
+Resumes JavaScript execution.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-resume
 */
-func (protocol *DebuggerProtocol) Resume() <-chan *debugger.ResumeResult {
-	resultChan := make(chan *debugger.ResumeResult)
+func (protocol *DebuggerProtocol) Resume(
+	params ...*debugger.ResumeParams,
+) <-chan *debugger.ResumeResult {
+	resultChan := make(chan *debugger.ResumeResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.resume", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Debugger.resume", params[0])
+	}
 	result := &debugger.ResumeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -313,47 +478,22 @@ func (protocol *DebuggerProtocol) Resume() <-chan *debugger.ResumeResult {
 }
 
 /*
-ScheduleStepIntoAsync is deprecated - use Debugger.stepInto with
-breakOnAsyncCall and Debugger.pauseOnAsyncTask instead. Steps into next
-scheduled async task if any is scheduled before next pause. Returns success when
-async task is actually scheduled, returns error if no task were scheduled or
-another scheduleStepIntoAsync was called.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-scheduleStepIntoAsync
-EXPERIMENTAL. DEPRECATED.
-*/
-func (protocol *DebuggerProtocol) ScheduleStepIntoAsync() <-chan *debugger.ScheduleStepIntoAsyncResult {
-	resultChan := make(chan *debugger.ScheduleStepIntoAsyncResult)
-	command := NewCommand(protocol.Socket, "Debugger.scheduleStepIntoAsync", nil)
-	result := &debugger.ScheduleStepIntoAsyncResult{}
-
-	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
-			result.Err = response.Error
-		}
-		resultChan <- result
-		close(resultChan)
-	}()
-
-	return resultChan
-}
-
-/*
-SearchInContent searches for given string in script content.
+Searches for given string in script content.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-searchInContent
 */
 func (protocol *DebuggerProtocol) SearchInContent(
 	params *debugger.SearchInContentParams,
 ) <-chan *debugger.SearchInContentResult {
-	resultChan := make(chan *debugger.SearchInContentResult)
+	resultChan := make(chan *debugger.SearchInContentResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.searchInContent", params)
 	result := &debugger.SearchInContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -366,20 +506,22 @@ func (protocol *DebuggerProtocol) SearchInContent(
 }
 
 /*
-SetAsyncCallStackDepth enables or disables async call stacks tracking.
+Enables or disables async call stacks tracking.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setAsyncCallStackDepth
 */
 func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
 	params *debugger.SetAsyncCallStackDepthParams,
 ) <-chan *debugger.SetAsyncCallStackDepthResult {
-	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult)
+	resultChan := make(chan *debugger.SetAsyncCallStackDepthResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setAsyncCallStackDepth", params)
 	result := &debugger.SetAsyncCallStackDepthResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -390,25 +532,54 @@ func (protocol *DebuggerProtocol) SetAsyncCallStackDepth(
 }
 
 /*
-SetBlackboxPatterns replaces previous blackbox patterns with passed ones. Forces
-backend to skip stepping/pausing in scripts with url matching one of the
-patterns. VM will try to leave blackboxed script by performing 'step in' several
-times, finally resorting to 'step out' if unsuccessful.
+Replace previous blackbox execution contexts with passed ones. Forces backend to
+skip stepping/pausing in scripts in these execution contexts. VM will try to
+leave blackboxed script by performing 'step in' several times, finally resorting
+to 'step out' if unsuccessful. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxExecutionContexts
+*/
+func (protocol *DebuggerProtocol) SetBlackboxExecutionContexts(
+	params *debugger.SetBlackboxExecutionContextsParams,
+) <-chan *debugger.SetBlackboxExecutionContextsResult {
+	resultChan := make(chan *debugger.SetBlackboxExecutionContextsResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBlackboxExecutionContexts", params)
+	result := &debugger.SetBlackboxExecutionContextsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
 
+/*
+Replace previous blackbox patterns with passed ones. Forces backend to skip
+stepping/pausing in scripts with url matching one of the patterns. VM will try
+to leave blackboxed script by performing 'step in' several times, finally
+resorting to 'step out' if unsuccessful. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxPatterns
-EXPERIMENTAL.
 */
 func (protocol *DebuggerProtocol) SetBlackboxPatterns(
 	params *debugger.SetBlackboxPatternsParams,
 ) <-chan *debugger.SetBlackboxPatternsResult {
-	resultChan := make(chan *debugger.SetBlackboxPatternsResult)
+	resultChan := make(chan *debugger.SetBlackboxPatternsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxPatterns", params)
 	result := &debugger.SetBlackboxPatternsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -419,23 +590,26 @@ func (protocol *DebuggerProtocol) SetBlackboxPatterns(
 }
 
 /*
-SetBlackboxedRanges makes backend skip steps in the script in blackboxed ranges. VM will try leave
-blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if
-unsuccessful. Positions array contains positions where blackbox state is changed. First interval
-isn't blackboxed. Array should be sorted.
+Makes backend skip steps in the script in blackboxed ranges. VM will try leave
+blacklisted scripts by performing 'step in' several times, finally resorting to
+'step out' if unsuccessful. Positions array contains positions where blackbox
+state is changed. First interval isn't blackboxed. Array should be sorted.
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBlackboxedRanges
 */
 func (protocol *DebuggerProtocol) SetBlackboxedRanges(
 	params *debugger.SetBlackboxedRangesParams,
 ) <-chan *debugger.SetBlackboxedRangesResult {
-	resultChan := make(chan *debugger.SetBlackboxedRangesResult)
+	resultChan := make(chan *debugger.SetBlackboxedRangesResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBlackboxedRanges", params)
 	result := &debugger.SetBlackboxedRangesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -446,20 +620,22 @@ func (protocol *DebuggerProtocol) SetBlackboxedRanges(
 }
 
 /*
-SetBreakpoint sets JavaScript breakpoint at a given location.
+Sets JavaScript breakpoint at a given location.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpoint
 */
 func (protocol *DebuggerProtocol) SetBreakpoint(
 	params *debugger.SetBreakpointParams,
 ) <-chan *debugger.SetBreakpointResult {
-	resultChan := make(chan *debugger.SetBreakpointResult)
+	resultChan := make(chan *debugger.SetBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpoint", params)
 	result := &debugger.SetBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -472,23 +648,84 @@ func (protocol *DebuggerProtocol) SetBreakpoint(
 }
 
 /*
-SetBreakpointByURL sets JavaScript breakpoint at given location specified either by URL or URL
-regex. Once this command is issued, all existing parsed scripts will have breakpoints resolved and
-returned in locations property. Further matching script parsing will result in subsequent
-breakpointResolved events issued. This logical breakpoint will survive page reloads.
+Sets instrumentation breakpoint.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setInstrumentationBreakpoint
+*/
+func (protocol *DebuggerProtocol) SetInstrumentationBreakpoint(
+	params *debugger.SetInstrumentationBreakpointParams,
+) <-chan *debugger.SetInstrumentationBreakpointResult {
+	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setInstrumentationBreakpoint", params)
+	result := &debugger.SetInstrumentationBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets JavaScript breakpoint at given location specified either by URL or URL
+regex. Once this command is issued, all existing parsed scripts will have
+breakpoints resolved and returned in `locations` property. Further matching
+script parsing will result in subsequent `breakpointResolved` events issued.
+This logical breakpoint will survive page reloads.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointByUrl
 */
 func (protocol *DebuggerProtocol) SetBreakpointByURL(
 	params *debugger.SetBreakpointByURLParams,
 ) <-chan *debugger.SetBreakpointByURLResult {
-	resultChan := make(chan *debugger.SetBreakpointByURLResult)
+	resultChan := make(chan *debugger.SetBreakpointByURLResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointByUrl", params)
 	result := &debugger.SetBreakpointByURLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets JavaScript breakpoint before each call to the given function. If another
+function was created from the same source as a given one, calling it will also
+trigger the breakpoint. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointOnFunctionCall
+*/
+func (protocol *DebuggerProtocol) SetBreakpointOnFunctionCall(
+	params *debugger.SetBreakpointOnFunctionCallParams,
+) <-chan *debugger.SetBreakpointOnFunctionCallResult {
+	resultChan := make(chan *debugger.SetBreakpointOnFunctionCallResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.setBreakpointOnFunctionCall", params)
+	result := &debugger.SetBreakpointOnFunctionCallResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -501,20 +738,22 @@ func (protocol *DebuggerProtocol) SetBreakpointByURL(
 }
 
 /*
-SetBreakpointsActive activates / deactivates all breakpoints on the page.
+Activates / deactivates all breakpoints on the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setBreakpointsActive
 */
 func (protocol *DebuggerProtocol) SetBreakpointsActive(
 	params *debugger.SetBreakpointsActiveParams,
 ) <-chan *debugger.SetBreakpointsActiveResult {
-	resultChan := make(chan *debugger.SetBreakpointsActiveResult)
+	resultChan := make(chan *debugger.SetBreakpointsActiveResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setBreakpointsActive", params)
 	result := &debugger.SetBreakpointsActiveResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -525,21 +764,24 @@ func (protocol *DebuggerProtocol) SetBreakpointsActive(
 }
 
 /*
-SetPauseOnExceptions defines the pause on exceptions state. Can be set to stop on all exceptions,
-uncaught exceptions or no exceptions. Initial pause on exceptions state is none.
+Defines pause on exceptions state. Can be set to stop on all exceptions,
+uncaught exceptions, or caught exceptions, no exceptions. Initial pause on
+exceptions state is `none`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setPauseOnExceptions
 */
 func (protocol *DebuggerProtocol) SetPauseOnExceptions(
 	params *debugger.SetPauseOnExceptionsParams,
 ) <-chan *debugger.SetPauseOnExceptionsResult {
-	resultChan := make(chan *debugger.SetPauseOnExceptionsResult)
+	resultChan := make(chan *debugger.SetPauseOnExceptionsResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setPauseOnExceptions", params)
 	result := &debugger.SetPauseOnExceptionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -550,21 +792,23 @@ func (protocol *DebuggerProtocol) SetPauseOnExceptions(
 }
 
 /*
-SetReturnValue changes return value in top frame. Available only at return break position.
-
+Changes return value in top frame. Available only at return break position.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setReturnValue EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setReturnValue
 */
 func (protocol *DebuggerProtocol) SetReturnValue(
 	params *debugger.SetReturnValueParams,
 ) <-chan *debugger.SetReturnValueResult {
-	resultChan := make(chan *debugger.SetReturnValueResult)
+	resultChan := make(chan *debugger.SetReturnValueResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setReturnValue", params)
 	result := &debugger.SetReturnValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -575,20 +819,28 @@ func (protocol *DebuggerProtocol) SetReturnValue(
 }
 
 /*
-SetScriptSource edits JavaScript source live.
+Edits JavaScript source live.
+
+In general, functions that are currently on the stack can not be edited with a
+single exception: If the edited function is the top-most stack frame and that is
+the only activation of that function on the stack. In this case the live edit
+will be successful and a `Debugger.restartFrame` for the top-most function is
+automatically triggered.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setScriptSource
 */
 func (protocol *DebuggerProtocol) SetScriptSource(
 	params *debugger.SetScriptSourceParams,
 ) <-chan *debugger.SetScriptSourceResult {
-	resultChan := make(chan *debugger.SetScriptSourceResult)
+	resultChan := make(chan *debugger.SetScriptSourceResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setScriptSource", params)
 	result := &debugger.SetScriptSourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -601,20 +853,23 @@ func (protocol *DebuggerProtocol) SetScriptSource(
 }
 
 /*
-SetSkipAllPauses makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
+Makes page not interrupt on any pauses (breakpoint, exception, dom exception
+etc).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setSkipAllPauses
 */
 func (protocol *DebuggerProtocol) SetSkipAllPauses(
 	params *debugger.SetSkipAllPausesParams,
 ) <-chan *debugger.SetSkipAllPausesResult {
-	resultChan := make(chan *debugger.SetSkipAllPausesResult)
+	resultChan := make(chan *debugger.SetSkipAllPausesResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setSkipAllPauses", params)
 	result := &debugger.SetSkipAllPausesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -625,21 +880,23 @@ func (protocol *DebuggerProtocol) SetSkipAllPauses(
 }
 
 /*
-SetVariableValue changes value of variable in a callframe. Object-based scopes are not supported and
-must be mutated manually.
+Changes value of variable in a callframe. Object-based scopes are not supported
+and must be mutated manually.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-setVariableValue
 */
 func (protocol *DebuggerProtocol) SetVariableValue(
 	params *debugger.SetVariableValueParams,
 ) <-chan *debugger.SetVariableValueResult {
-	resultChan := make(chan *debugger.SetVariableValueResult)
+	resultChan := make(chan *debugger.SetVariableValueResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.setVariableValue", params)
 	result := &debugger.SetVariableValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -650,20 +907,27 @@ func (protocol *DebuggerProtocol) SetVariableValue(
 }
 
 /*
-StepInto steps into the function call.
+Steps into the function call.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepInto
 */
 func (protocol *DebuggerProtocol) StepInto(
-	params *debugger.StepIntoParams,
+	params ...*debugger.StepIntoParams,
 ) <-chan *debugger.StepIntoResult {
-	resultChan := make(chan *debugger.StepIntoResult)
-	command := NewCommand(protocol.Socket, "Debugger.stepInto", params)
+	resultChan := make(chan *debugger.StepIntoResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.stepInto", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Debugger.stepInto", params[0])
+	}
 	result := &debugger.StepIntoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -674,18 +938,20 @@ func (protocol *DebuggerProtocol) StepInto(
 }
 
 /*
-StepOut steps out of the function call.
+Steps out of the function call.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOut
 */
 func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
-	resultChan := make(chan *debugger.StepOutResult)
+	resultChan := make(chan *debugger.StepOutResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.stepOut", nil)
 	result := &debugger.StepOutResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -696,18 +962,55 @@ func (protocol *DebuggerProtocol) StepOut() <-chan *debugger.StepOutResult {
 }
 
 /*
-StepOver steps over the statement.
+Steps over the statement.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-stepOver
 */
-func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
-	resultChan := make(chan *debugger.StepOverResult)
+func (protocol *DebuggerProtocol) StepOver(
+	params ...*debugger.StepOverParams,
+) <-chan *debugger.StepOverResult {
+	resultChan := make(chan *debugger.StepOverResult, 1)
 	command := NewCommand(protocol.Socket, "Debugger.stepOver", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Debugger.stepOver", params[0])
+	}
 	result := &debugger.StepOverResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Is deprecated - use Debugger.stepInto with breakOnAsyncCall and
+Debugger.pauseOnAsyncTask instead. Steps into next scheduled async task if any
+is scheduled before next pause. Returns success when async task is actually
+scheduled, returns error if no task were scheduled or another
+scheduleStepIntoAsync was called. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#method-scheduleStepIntoAsync
+*/
+func (protocol *DebuggerProtocol) ScheduleStepIntoAsync() <-chan *debugger.ScheduleStepIntoAsyncResult {
+	resultChan := make(chan *debugger.ScheduleStepIntoAsyncResult, 1)
+	command := NewCommand(protocol.Socket, "Debugger.scheduleStepIntoAsync", nil)
+	result := &debugger.ScheduleStepIntoAsyncResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -719,13 +1022,15 @@ func (protocol *DebuggerProtocol) StepOver() <-chan *debugger.StepOverResult {
 
 /*
 OnBreakpointResolved adds a handler to the Debugger.breakpointResolved event.
-Debugger.breakpointResolved fires when breakpoint is resolved to an actual script and location.
+
+Fired when breakpoint is resolved to an actual script and location. Deprecated
+in favor of `resolvedBreakpoints` in the `scriptParsed` event. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-breakpointResolved
 */
 func (protocol *DebuggerProtocol) OnBreakpointResolved(
 	callback func(event *debugger.BreakpointResolvedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Debugger.breakpointResolved",
 		func(response *Response) {
@@ -738,17 +1043,20 @@ func (protocol *DebuggerProtocol) OnBreakpointResolved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnPaused adds a handler to the Debugger.paused event. Debugger.paused fires when the virtual machine
-stopped on breakpoint or exception or any other stop criteria.
+OnPaused adds a handler to the Debugger.paused event.
+
+Fired when the virtual machine stopped on breakpoint or exception or any other
+stop criteria.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-paused
 */
 func (protocol *DebuggerProtocol) OnPaused(
 	callback func(event *debugger.PausedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Debugger.paused",
 		func(response *Response) {
@@ -761,17 +1069,19 @@ func (protocol *DebuggerProtocol) OnPaused(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnResumed adds a handler to the Debugger.resumed event. Debugger.resumed fires when the virtual
-machine resumes execution.
+OnResumed adds a handler to the Debugger.resumed event.
+
+Fired when the virtual machine resumed execution.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-resumed
 */
 func (protocol *DebuggerProtocol) OnResumed(
 	callback func(event *debugger.ResumedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Debugger.resumed",
 		func(response *Response) {
@@ -784,17 +1094,19 @@ func (protocol *DebuggerProtocol) OnResumed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnScriptFailedToParse adds a handler to the Debugger.scriptFailedToParse event.
-Debugger.scriptFailedToParse fires when the virtual machine fails to parse the script.
+
+Fired when virtual machine fails to parse the script.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptFailedToParse
 */
 func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 	callback func(event *debugger.ScriptFailedToParseEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Debugger.scriptFailedToParse",
 		func(response *Response) {
@@ -807,18 +1119,20 @@ func (protocol *DebuggerProtocol) OnScriptFailedToParse(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnScriptParsed adds a handler to the Debugger.ScriptParsed event. Debugger.ScriptParsed fires when
-virtual machine parses script. This event is also fired for all known and uncollected scripts upon
-enabling debugger.
+OnScriptParsed adds a handler to the Debugger.scriptParsed event.
+
+Fired when virtual machine parses script. This event is also fired for all known
+and uncollected scripts upon enabling debugger.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Debugger/#event-scriptParsed
 */
 func (protocol *DebuggerProtocol) OnScriptParsed(
 	callback func(event *debugger.ScriptParsedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Debugger.scriptParsed",
 		func(response *Response) {
@@ -831,4 +1145,5 @@ func (protocol *DebuggerProtocol) OnScriptParsed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/debugger"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
@@ -134,12 +135,12 @@ func TestDebuggerEvaluateOnCallFrame(t *testing.T) {
 	resultChan := mockSocket.Debugger().EvaluateOnCallFrame(&debugger.EvaluateOnCallFrameParams{
 		CallFrameID:           debugger.CallFrameID("call-frame-id"),
 		Expression:            "expression",
-		ObjectGroup:           "object-group",
-		IncludeCommandLineAPI: true,
-		Silent:                true,
-		ReturnByValue:         true,
-		GeneratePreview:       true,
-		ThrowOnSideEffect:     true,
+		ObjectGroup:           optional.String("object-group"),
+		IncludeCommandLineAPI: optional.Bool(true),
+		Silent:                optional.Bool(true),
+		ReturnByValue:         optional.Bool(true),
+		GeneratePreview:       optional.Bool(true),
+		ThrowOnSideEffect:     optional.Bool(true),
 	})
 	mockResult := &debugger.EvaluateOnCallFrameResult{
 		Result: &runtime.RemoteObject{
@@ -191,12 +192,12 @@ func TestDebuggerEvaluateOnCallFrame(t *testing.T) {
 	resultChan = mockSocket.Debugger().EvaluateOnCallFrame(&debugger.EvaluateOnCallFrameParams{
 		CallFrameID:           debugger.CallFrameID("call-frame-id"),
 		Expression:            "expression",
-		ObjectGroup:           "object-group",
-		IncludeCommandLineAPI: true,
-		Silent:                true,
-		ReturnByValue:         true,
-		GeneratePreview:       true,
-		ThrowOnSideEffect:     true,
+		ObjectGroup:           optional.String("object-group"),
+		IncludeCommandLineAPI: optional.Bool(true),
+		Silent:                optional.Bool(true),
+		ReturnByValue:         optional.Bool(true),
+		GeneratePreview:       optional.Bool(true),
+		ThrowOnSideEffect:     optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -229,7 +230,7 @@ func TestDebuggerGetPossibleBreakpoints(t *testing.T) {
 			LineNumber:   2,
 			ColumnNumber: 2,
 		},
-		RestrictToFunction: true,
+		RestrictToFunction: optional.Bool(true),
 	})
 	mockResult := &debugger.GetPossibleBreakpointsResult{
 		Locations: []*debugger.BreakLocation{{
@@ -264,7 +265,7 @@ func TestDebuggerGetPossibleBreakpoints(t *testing.T) {
 			LineNumber:   2,
 			ColumnNumber: 2,
 		},
-		RestrictToFunction: true,
+		RestrictToFunction: optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -330,7 +331,7 @@ func TestDebuggerGetStackTrace(t *testing.T) {
 	defer mockSocket.Stop()
 
 	resultChan := mockSocket.Debugger().GetStackTrace(&debugger.GetStackTraceParams{
-		StackTraceID: runtime.StackTraceID{
+		StackTraceID: &runtime.StackTraceID{
 			ID:         "stack-trace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -361,7 +362,7 @@ func TestDebuggerGetStackTrace(t *testing.T) {
 	}
 
 	resultChan = mockSocket.Debugger().GetStackTrace(&debugger.GetStackTraceParams{
-		StackTraceID: runtime.StackTraceID{
+		StackTraceID: &runtime.StackTraceID{
 			ID:         "stack-trace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -421,7 +422,7 @@ func TestDebuggerPauseOnAsyncCall(t *testing.T) {
 	defer mockSocket.Stop()
 
 	resultChan := mockSocket.Debugger().PauseOnAsyncCall(&debugger.PauseOnAsyncCallParams{
-		ParentStackTraceID: runtime.StackTraceID{
+		ParentStackTraceID: &runtime.StackTraceID{
 			ID:         "stack-trace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -439,7 +440,7 @@ func TestDebuggerPauseOnAsyncCall(t *testing.T) {
 	}
 
 	resultChan = mockSocket.Debugger().PauseOnAsyncCall(&debugger.PauseOnAsyncCallParams{
-		ParentStackTraceID: runtime.StackTraceID{
+		ParentStackTraceID: &runtime.StackTraceID{
 			ID:         "stack-trace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -545,7 +546,7 @@ func TestDebuggerRestartFrame(t *testing.T) {
 			},
 		}},
 		AsyncStackTrace: &runtime.StackTrace{},
-		AsyncStackTraceID: runtime.StackTraceID{
+		AsyncStackTraceID: &runtime.StackTraceID{
 			ID:         "stack-trace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -658,8 +659,8 @@ func TestDebuggerSearchInContent(t *testing.T) {
 	resultChan := mockSocket.Debugger().SearchInContent(&debugger.SearchInContentParams{
 		ScriptID:      runtime.ScriptID("script-id"),
 		Query:         "search string",
-		CaseSensitive: true,
-		IsRegex:       true,
+		CaseSensitive: optional.Bool(true),
+		IsRegex:       optional.Bool(true),
 	})
 	mockResult := &debugger.SearchInContentResult{
 		Result: []*debugger.SearchMatch{{
@@ -678,14 +679,14 @@ func TestDebuggerSearchInContent(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 	if mockResult.Result[0].LineNumber != result.Result[0].LineNumber {
-		t.Errorf("Expected '%d', got '%d'", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
+		t.Errorf("Expected '%v', got '%v'", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
 	}
 
 	resultChan = mockSocket.Debugger().SearchInContent(&debugger.SearchInContentParams{
 		ScriptID:      runtime.ScriptID("script-id"),
 		Query:         "search string",
-		CaseSensitive: true,
-		IsRegex:       true,
+		CaseSensitive: optional.Bool(true),
+		IsRegex:       optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -835,7 +836,7 @@ func TestDebuggerSetBreakpoint(t *testing.T) {
 			LineNumber:   1,
 			ColumnNumber: 1,
 		},
-		Condition: "breakpoint-condition",
+		Condition: optional.String("breakpoint-condition"),
 	})
 	mockResult := &debugger.SetBreakpointResult{
 		BreakpointID: debugger.BreakpointID("breakpoint-id"),
@@ -865,7 +866,7 @@ func TestDebuggerSetBreakpoint(t *testing.T) {
 			LineNumber:   1,
 			ColumnNumber: 1,
 		},
-		Condition: "breakpoint-condition",
+		Condition: optional.String("breakpoint-condition"),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -889,11 +890,11 @@ func TestDebuggerSetBreakpointByURL(t *testing.T) {
 
 	resultChan := mockSocket.Debugger().SetBreakpointByURL(&debugger.SetBreakpointByURLParams{
 		LineNumber:   1,
-		URL:          "http://some.url",
-		URLRegex:     "some regex",
-		ScriptHash:   "some hash",
-		ColumnNumber: 1,
-		Condition:    "some condition",
+		URL:          optional.String("http://some.url"),
+		URLRegex:     optional.String("some regex"),
+		ScriptHash:   optional.String("some hash"),
+		ColumnNumber: optional.Int(1),
+		Condition:    optional.String("some condition"),
 	})
 	mockResult := &debugger.SetBreakpointByURLResult{
 		BreakpointID: debugger.BreakpointID("breakpoint-id"),
@@ -919,11 +920,11 @@ func TestDebuggerSetBreakpointByURL(t *testing.T) {
 
 	resultChan = mockSocket.Debugger().SetBreakpointByURL(&debugger.SetBreakpointByURLParams{
 		LineNumber:   1,
-		URL:          "http://some.url",
-		URLRegex:     "some regex",
-		ScriptHash:   "some hash",
-		ColumnNumber: 1,
-		Condition:    "some condition",
+		URL:          optional.String("http://some.url"),
+		URLRegex:     optional.String("some regex"),
+		ScriptHash:   optional.String("some hash"),
+		ColumnNumber: optional.Int(1),
+		Condition:    optional.String("some condition"),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -1070,7 +1071,7 @@ func TestDebuggerSetScriptSource(t *testing.T) {
 	resultChan := mockSocket.Debugger().SetScriptSource(&debugger.SetScriptSourceParams{
 		ScriptID:     runtime.ScriptID("script-id"),
 		ScriptSource: "http://script.source",
-		DryRun:       true,
+		DryRun:       optional.Bool(true),
 	})
 	mockResult := &debugger.SetScriptSourceResult{
 		CallFrames: []*debugger.CallFrame{{
@@ -1121,7 +1122,7 @@ func TestDebuggerSetScriptSource(t *testing.T) {
 				DebuggerID: runtime.UniqueDebuggerID("parent-unique-debugger-id"),
 			},
 		},
-		AsyncStackTraceID: runtime.StackTraceID{
+		AsyncStackTraceID: &runtime.StackTraceID{
 			ID:         "stacktrace-id",
 			DebuggerID: runtime.UniqueDebuggerID("unique-debugger-id"),
 		},
@@ -1156,7 +1157,7 @@ func TestDebuggerSetScriptSource(t *testing.T) {
 	resultChan = mockSocket.Debugger().SetScriptSource(&debugger.SetScriptSourceParams{
 		ScriptID:     runtime.ScriptID("script-id"),
 		ScriptSource: "http://script.source",
-		DryRun:       true,
+		DryRun:       optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -1269,7 +1270,7 @@ func TestDebuggerStepInto(t *testing.T) {
 	defer mockSocket.Stop()
 
 	resultChan := mockSocket.Debugger().StepInto(&debugger.StepIntoParams{
-		BreakOnAsyncCall: true,
+		BreakOnAsyncCall: optional.Bool(true),
 	})
 	mockResult := &debugger.StepIntoResult{}
 	mockResultBytes, _ := json.Marshal(mockResult)
@@ -1284,7 +1285,7 @@ func TestDebuggerStepInto(t *testing.T) {
 	}
 
 	resultChan = mockSocket.Debugger().StepInto(&debugger.StepIntoParams{
-		BreakOnAsyncCall: true,
+		BreakOnAsyncCall: optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -1431,8 +1432,8 @@ func TestDebuggerOnPaused(t *testing.T) {
 		CallFrames: []*debugger.CallFrame{{
 			CallFrameID: debugger.CallFrameID("call-frame-id"),
 		}},
-		Reason:                "reason",
-		Data:                  map[string]string{"key": "value"},
+		Reason:                debugger.Reason.Other,
+		Data:                  map[string]interface{}{"key": "value"},
 		HitBreakpoints:        []string{"breakpoint1"},
 		AsyncStackTrace:       &runtime.StackTrace{},
 		AsyncStackTraceID:     &runtime.StackTraceID{},
@@ -1530,7 +1531,7 @@ func TestDebuggerOnScriptFailedToParse(t *testing.T) {
 		EndColumn:               10,
 		ExecutionContextID:      runtime.ExecutionContextID(1),
 		Hash:                    "some hash",
-		ExecutionContextAuxData: map[string]string{"key": "value"},
+		ExecutionContextAuxData: map[string]interface{}{"key": "value"},
 		SourceMapURL:            "http://source-map.url",
 		HasSourceURL:            true,
 		IsModule:                true,
@@ -1587,7 +1588,7 @@ func TestDebuggerOnScriptParsed(t *testing.T) {
 		EndColumn:               10,
 		ExecutionContextID:      runtime.ExecutionContextID(1),
 		Hash:                    "some hash",
-		ExecutionContextAuxData: map[string]string{"key": "value"},
+		ExecutionContextAuxData: map[string]interface{}{"key": "value"},
 		IsLiveEdit:              true,
 		SourceMapURL:            "http://source-map.url",
 		HasSourceURL:            true,
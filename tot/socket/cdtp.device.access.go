@@ -0,0 +1,173 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/device/access"
+)
+
+/*
+DeviceAccessProtocol provides a namespace for the Chrome DeviceAccess protocol
+methods.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/
+*/
+type DeviceAccessProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DeviceAccessProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DeviceAccessProtocol) WithContext(ctx context.Context) *DeviceAccessProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DeviceAccessProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enable events in this domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#method-enable
+*/
+func (protocol *DeviceAccessProtocol) Enable() <-chan *access.EnableResult {
+	resultChan := make(chan *access.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "DeviceAccess.enable", nil)
+	result := &access.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable events in this domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#method-disable
+*/
+func (protocol *DeviceAccessProtocol) Disable() <-chan *access.DisableResult {
+	resultChan := make(chan *access.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "DeviceAccess.disable", nil)
+	result := &access.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Select a device in response to a DeviceAccess.deviceRequestPrompted event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#method-selectPrompt
+*/
+func (protocol *DeviceAccessProtocol) SelectPrompt(
+	params *access.SelectPromptParams,
+) <-chan *access.SelectPromptResult {
+	resultChan := make(chan *access.SelectPromptResult, 1)
+	command := NewCommand(protocol.Socket, "DeviceAccess.selectPrompt", params)
+	result := &access.SelectPromptResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Cancel a prompt in response to a DeviceAccess.deviceRequestPrompted event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#method-cancelPrompt
+*/
+func (protocol *DeviceAccessProtocol) CancelPrompt(
+	params *access.CancelPromptParams,
+) <-chan *access.CancelPromptResult {
+	resultChan := make(chan *access.CancelPromptResult, 1)
+	command := NewCommand(protocol.Socket, "DeviceAccess.cancelPrompt", params)
+	result := &access.CancelPromptResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDeviceRequestPrompted adds a handler to the DeviceAccess.deviceRequestPrompted
+event.
+
+A device request opened a user prompt to select a device. Respond with the
+selectPrompt or cancelPrompt command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceAccess/#event-deviceRequestPrompted
+*/
+func (protocol *DeviceAccessProtocol) OnDeviceRequestPrompted(
+	callback func(event *access.DeviceRequestPromptedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DeviceAccess.deviceRequestPrompted",
+		func(response *Response) {
+			event := &access.DeviceRequestPromptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
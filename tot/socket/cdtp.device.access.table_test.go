@@ -0,0 +1,111 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/device/access"
+)
+
+func TestDeviceAccessCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDeviceAccessCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"DeviceAccess.enable", func() func() error {
+			resultChan := mockSocket.DeviceAccess().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DeviceAccess.disable", func() func() error {
+			resultChan := mockSocket.DeviceAccess().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DeviceAccess.selectPrompt", func() func() error {
+			resultChan := mockSocket.DeviceAccess().SelectPrompt(&access.SelectPromptParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DeviceAccess.cancelPrompt", func() func() error {
+			resultChan := mockSocket.DeviceAccess().CancelPrompt(&access.CancelPromptParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestDeviceAccessEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDeviceAccessEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"DeviceAccess.deviceRequestPrompted", func(results chan<- error) *Listener {
+			return mockSocket.DeviceAccess().OnDeviceRequestPrompted(func(event *access.DeviceRequestPromptedEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
@@ -1,6 +1,10 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
+
 	"github.com/mkenney/go-chrome/tot/device/orientation"
 )
 
@@ -8,26 +12,53 @@ import (
 DeviceOrientationProtocol provides a namespace for the Chrome DeviceOrientation
 protocol methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/
 */
 type DeviceOrientationProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DeviceOrientationProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DeviceOrientationProtocol) WithContext(ctx context.Context) *DeviceOrientationProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DeviceOrientationProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-ClearOverride clears the overridden Device Orientation.
+Clears the overridden Device Orientation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-clearDeviceOrientationOverride
 */
 func (protocol *DeviceOrientationProtocol) ClearOverride() <-chan *orientation.ClearOverrideResult {
-	resultChan := make(chan *orientation.ClearOverrideResult)
+	resultChan := make(chan *orientation.ClearOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.clearDeviceOrientationOverride", nil)
 	result := &orientation.ClearOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,20 +69,22 @@ func (protocol *DeviceOrientationProtocol) ClearOverride() <-chan *orientation.C
 }
 
 /*
-SetOverride overrides the Device Orientation.
+Overrides the Device Orientation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DeviceOrientation/#method-setDeviceOrientationOverride
 */
 func (protocol *DeviceOrientationProtocol) SetOverride(
 	params *orientation.SetOverrideParams,
 ) <-chan *orientation.SetOverrideResult {
-	resultChan := make(chan *orientation.SetOverrideResult)
+	resultChan := make(chan *orientation.SetOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "DeviceOrientation.setDeviceOrientationOverride", params)
 	result := &orientation.SetOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -0,0 +1,56 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/device/orientation"
+)
+
+func TestDeviceOrientationCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDeviceOrientationCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"DeviceOrientation.clearDeviceOrientationOverride", func() func() error {
+			resultChan := mockSocket.DeviceOrientation().ClearOverride()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DeviceOrientation.setDeviceOrientationOverride", func() func() error {
+			resultChan := mockSocket.DeviceOrientation().SetOverride(&orientation.SetOverrideParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/debugger"
@@ -8,31 +11,59 @@ import (
 
 /*
 DOMDebuggerProtocol provides a namespace for the Chrome DOMDebugger protocol
-methods. The DOMDebugger protocol allows setting breakpoints on particular DOM
-operations and events. JavaScript execution will stop on these operations as if
-there was a regular breakpoint set.
+methods.
+
+DOM debugging allows setting breakpoints on particular DOM operations and
+events. JavaScript execution will stop on these operations as if there was a
+regular breakpoint set.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/
 */
 type DOMDebuggerProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMDebuggerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-GetEventListeners returns event listeners of the given object.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMDebuggerProtocol) WithContext(ctx context.Context) *DOMDebuggerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMDebuggerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Returns event listeners of the given object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-getEventListeners
 */
 func (protocol *DOMDebuggerProtocol) GetEventListeners(
 	params *debugger.GetEventListenersParams,
 ) <-chan *debugger.GetEventListenersResult {
-	resultChan := make(chan *debugger.GetEventListenersResult)
+	resultChan := make(chan *debugger.GetEventListenersResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.getEventListeners", params)
 	result := &debugger.GetEventListenersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -45,21 +76,22 @@ func (protocol *DOMDebuggerProtocol) GetEventListeners(
 }
 
 /*
-RemoveDOMBreakpoint removes the DOM breakpoint that was set using
-setDOMBreakpoint.
+Removes DOM breakpoint that was set using `setDOMBreakpoint`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeDOMBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
 	params *debugger.RemoveDOMBreakpointParams,
 ) <-chan *debugger.RemoveDOMBreakpointResult {
-	resultChan := make(chan *debugger.RemoveDOMBreakpointResult)
+	resultChan := make(chan *debugger.RemoveDOMBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeDOMBreakpoint", params)
 	result := &debugger.RemoveDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -70,20 +102,22 @@ func (protocol *DOMDebuggerProtocol) RemoveDOMBreakpoint(
 }
 
 /*
-RemoveEventListenerBreakpoint removes breakpoint on particular DOM event.
+Removes breakpoint on particular DOM event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeEventListenerBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
 	params *debugger.RemoveEventListenerBreakpointParams,
 ) <-chan *debugger.RemoveEventListenerBreakpointResult {
-	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult)
+	resultChan := make(chan *debugger.RemoveEventListenerBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeEventListenerBreakpoint", params)
 	result := &debugger.RemoveEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -94,21 +128,22 @@ func (protocol *DOMDebuggerProtocol) RemoveEventListenerBreakpoint(
 }
 
 /*
-RemoveInstrumentationBreakpoint removes breakpoint on particular native event.
+Removes breakpoint on particular native event. EXPERIMENTAL. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeInstrumentationBreakpoint
-EXPERIMENTAL.
 */
 func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpoint(
 	params *debugger.RemoveInstrumentationBreakpointParams,
 ) <-chan *debugger.RemoveInstrumentationBreakpointResult {
-	resultChan := make(chan *debugger.RemoveInstrumentationBreakpointResult)
+	resultChan := make(chan *debugger.RemoveInstrumentationBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeInstrumentationBreakpoint", params)
 	result := &debugger.RemoveInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -119,20 +154,48 @@ func (protocol *DOMDebuggerProtocol) RemoveInstrumentationBreakpoint(
 }
 
 /*
-RemoveXHRBreakpoint removes breakpoint from XMLHttpRequest.
+Removes breakpoint from XMLHttpRequest.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-removeXHRBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
 	params *debugger.RemoveXHRBreakpointParams,
 ) <-chan *debugger.RemoveXHRBreakpointResult {
-	resultChan := make(chan *debugger.RemoveXHRBreakpointResult)
+	resultChan := make(chan *debugger.RemoveXHRBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.removeXHRBreakpoint", params)
 	result := &debugger.RemoveXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets breakpoint on particular CSP violations. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setBreakOnCSPViolation
+*/
+func (protocol *DOMDebuggerProtocol) SetBreakOnCSPViolation(
+	params *debugger.SetBreakOnCSPViolationParams,
+) <-chan *debugger.SetBreakOnCSPViolationResult {
+	resultChan := make(chan *debugger.SetBreakOnCSPViolationResult, 1)
+	command := NewCommand(protocol.Socket, "DOMDebugger.setBreakOnCSPViolation", params)
+	result := &debugger.SetBreakOnCSPViolationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -143,20 +206,22 @@ func (protocol *DOMDebuggerProtocol) RemoveXHRBreakpoint(
 }
 
 /*
-SetDOMBreakpoint sets a breakpoint on a particular operation with DOM.
+Sets breakpoint on particular operation with DOM.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setDOMBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
 	params *debugger.SetDOMBreakpointParams,
 ) <-chan *debugger.SetDOMBreakpointResult {
-	resultChan := make(chan *debugger.SetDOMBreakpointResult)
+	resultChan := make(chan *debugger.SetDOMBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setDOMBreakpoint", params)
 	result := &debugger.SetDOMBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -167,20 +232,22 @@ func (protocol *DOMDebuggerProtocol) SetDOMBreakpoint(
 }
 
 /*
-SetEventListenerBreakpoint sets the breakpoint on a particular DOM event.
+Sets breakpoint on particular DOM event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setEventListenerBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
 	params *debugger.SetEventListenerBreakpointParams,
 ) <-chan *debugger.SetEventListenerBreakpointResult {
-	resultChan := make(chan *debugger.SetEventListenerBreakpointResult)
+	resultChan := make(chan *debugger.SetEventListenerBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setEventListenerBreakpoint", params)
 	result := &debugger.SetEventListenerBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -191,21 +258,22 @@ func (protocol *DOMDebuggerProtocol) SetEventListenerBreakpoint(
 }
 
 /*
-SetInstrumentationBreakpoint sets breakpoint on particular native event.
+Sets breakpoint on particular native event. EXPERIMENTAL. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setInstrumentationBreakpoint
-EXPERIMENTAL.
 */
 func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpoint(
 	params *debugger.SetInstrumentationBreakpointParams,
 ) <-chan *debugger.SetInstrumentationBreakpointResult {
-	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult)
+	resultChan := make(chan *debugger.SetInstrumentationBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setInstrumentationBreakpoint", params)
 	result := &debugger.SetInstrumentationBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -216,20 +284,22 @@ func (protocol *DOMDebuggerProtocol) SetInstrumentationBreakpoint(
 }
 
 /*
-SetXHRBreakpoint sets breakpoint on XMLHttpRequest.
+Sets breakpoint on XMLHttpRequest.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMDebugger/#method-setXHRBreakpoint
 */
 func (protocol *DOMDebuggerProtocol) SetXHRBreakpoint(
 	params *debugger.SetXHRBreakpointParams,
 ) <-chan *debugger.SetXHRBreakpointResult {
-	resultChan := make(chan *debugger.SetXHRBreakpointResult)
+	resultChan := make(chan *debugger.SetXHRBreakpointResult, 1)
 	command := NewCommand(protocol.Socket, "DOMDebugger.setXHRBreakpoint", params)
 	result := &debugger.SetXHRBreakpointResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
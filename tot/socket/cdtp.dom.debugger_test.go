@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/dom/debugger"
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -18,8 +19,8 @@ func TestDOMDebuggerGetEventListeners(t *testing.T) {
 
 	resultChan := mockSocket.DOMDebugger().GetEventListeners(&debugger.GetEventListenersParams{
 		ObjectID: runtime.RemoteObjectID("remote-object-id"),
-		Depth:    1,
-		Pierce:   true,
+		Depth:    optional.Int(1),
+		Pierce:   optional.Bool(true),
 	})
 	mockResult := &debugger.GetEventListenersResult{
 		Listeners: []*debugger.EventListener{{
@@ -55,8 +56,8 @@ func TestDOMDebuggerGetEventListeners(t *testing.T) {
 
 	resultChan = mockSocket.DOMDebugger().GetEventListeners(&debugger.GetEventListenersParams{
 		ObjectID: runtime.RemoteObjectID("remote-object-id"),
-		Depth:    1,
-		Pierce:   true,
+		Depth:    optional.Int(1),
+		Pierce:   optional.Bool(true),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -120,7 +121,7 @@ func TestDOMDebuggerRemoveEventListenerBreakpoint(t *testing.T) {
 
 	resultChan := mockSocket.DOMDebugger().RemoveEventListenerBreakpoint(&debugger.RemoveEventListenerBreakpointParams{
 		EventName:  "event name",
-		TargetName: "target name",
+		TargetName: optional.String("target name"),
 	})
 	mockResult := &debugger.RemoveEventListenerBreakpointResult{}
 	mockResultBytes, _ := json.Marshal(mockResult)
@@ -136,7 +137,7 @@ func TestDOMDebuggerRemoveEventListenerBreakpoint(t *testing.T) {
 
 	resultChan = mockSocket.DOMDebugger().RemoveEventListenerBreakpoint(&debugger.RemoveEventListenerBreakpointParams{
 		EventName:  "event name",
-		TargetName: "target name",
+		TargetName: optional.String("target name"),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -276,7 +277,7 @@ func TestDOMDebuggerSetEventListenerBreakpoint(t *testing.T) {
 
 	resultChan := mockSocket.DOMDebugger().SetEventListenerBreakpoint(&debugger.SetEventListenerBreakpointParams{
 		EventName:  "event name",
-		TargetName: "target name",
+		TargetName: optional.String("target name"),
 	})
 	mockResult := &debugger.SetEventListenerBreakpointResult{}
 	mockResultBytes, _ := json.Marshal(mockResult)
@@ -292,7 +293,7 @@ func TestDOMDebuggerSetEventListenerBreakpoint(t *testing.T) {
 
 	resultChan = mockSocket.DOMDebugger().SetEventListenerBreakpoint(&debugger.SetEventListenerBreakpointParams{
 		EventName:  "event name",
-		TargetName: "target name",
+		TargetName: optional.String("target name"),
 	})
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID: mockSocket.CurCommandID(),
@@ -1,46 +1,74 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom"
 )
 
 /*
-DOMProtocol provides a namespace for the Chrome DOM protocol methods. The DOM
-protocol exposes DOM read/write operations. Each DOM Node is represented with
-its mirror object that has an ID. This ID can be used to get additional
+DOMProtocol provides a namespace for the Chrome DOM protocol methods.
+
+This domain exposes DOM read/write operations. Each DOM Node is represented with
+its mirror object that has an `id`. This `id` can be used to get additional
 information on the Node, resolve it into the JavaScript object wrapper, etc. It
 is important that client receives DOM events only for the nodes that are known
 to the client. Backend keeps track of the nodes that were sent to the client and
 never sends the same node twice. It is client's responsibility to collect
-information about the nodes that were sent to the client.
-
-Note that iframe owner elements will return corresponding document elements as their child nodes.
+information about the nodes that were sent to the client. Note that `iframe`
+owner elements will return corresponding document elements as their child nodes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/
 */
 type DOMProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-CollectClassNamesFromSubtree creates a deep copy of the specified node and
-places it into the target container before the given anchor.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-collectClassNamesFromSubtree
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMProtocol) WithContext(ctx context.Context) *DOMProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Collects class names for the node with given id and all of it's child nodes.
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-collectClassNamesFromSubtree
 */
 func (protocol *DOMProtocol) CollectClassNamesFromSubtree(
 	params *dom.CollectClassNamesFromSubtreeParams,
 ) <-chan *dom.CollectClassNamesFromSubtreeResult {
-	resultChan := make(chan *dom.CollectClassNamesFromSubtreeResult)
+	resultChan := make(chan *dom.CollectClassNamesFromSubtreeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.collectClassNamesFromSubtree", params)
 	result := &dom.CollectClassNamesFromSubtreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -53,21 +81,23 @@ func (protocol *DOMProtocol) CollectClassNamesFromSubtree(
 }
 
 /*
-CopyTo creates a deep copy of the specified node and places it into the target
-container before the given anchor.
+Creates a deep copy of the specified node and places it into the target
+container before the given anchor. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-copyTo
 */
 func (protocol *DOMProtocol) CopyTo(
 	params *dom.CopyToParams,
 ) <-chan *dom.CopyToResult {
-	resultChan := make(chan *dom.CopyToResult)
+	resultChan := make(chan *dom.CopyToResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.copyTo", params)
 	result := &dom.CopyToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -80,21 +110,28 @@ func (protocol *DOMProtocol) CopyTo(
 }
 
 /*
-DescribeNode describes node given its id, does not require domain to be enabled.
-Does not start tracking any objects, can be used for automation.
+Describes node given its id, does not require domain to be enabled. Does not
+start tracking any objects, can be used for automation.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-describeNode
 */
 func (protocol *DOMProtocol) DescribeNode(
-	params *dom.DescribeNodeParams,
+	params ...*dom.DescribeNodeParams,
 ) <-chan *dom.DescribeNodeResult {
-	resultChan := make(chan *dom.DescribeNodeResult)
-	command := NewCommand(protocol.Socket, "DOM.describeNode", params)
+	resultChan := make(chan *dom.DescribeNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.describeNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.describeNode", params[0])
+	}
 	result := &dom.DescribeNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -107,18 +144,53 @@ func (protocol *DOMProtocol) DescribeNode(
 }
 
 /*
-Disable disables the DOM agent for the given page.
+Scrolls the specified rect of the given node into view if not already visible.
+Note: exactly one between nodeId, backendNodeId and objectId should be passed to
+identify the node.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-scrollIntoViewIfNeeded
+*/
+func (protocol *DOMProtocol) ScrollIntoViewIfNeeded(
+	params ...*dom.ScrollIntoViewIfNeededParams,
+) <-chan *dom.ScrollIntoViewIfNeededResult {
+	resultChan := make(chan *dom.ScrollIntoViewIfNeededResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.scrollIntoViewIfNeeded", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.scrollIntoViewIfNeeded", params[0])
+	}
+	result := &dom.ScrollIntoViewIfNeededResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables DOM agent for the given page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-disable
 */
 func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
-	resultChan := make(chan *dom.DisableResult)
+	resultChan := make(chan *dom.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.disable", nil)
 	result := &dom.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -129,22 +201,23 @@ func (protocol *DOMProtocol) Disable() <-chan *dom.DisableResult {
 }
 
 /*
-DiscardSearchResults discards search results from the session with the given id.
-getSearchResults should no longer be called for that search.
+Discards search results from the session with the given id. `getSearchResults`
+should no longer be called for that search. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-discardSearchResults
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) DiscardSearchResults(
 	params *dom.DiscardSearchResultsParams,
 ) <-chan *dom.DiscardSearchResultsResult {
-	resultChan := make(chan *dom.DiscardSearchResultsResult)
+	resultChan := make(chan *dom.DiscardSearchResultsResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.discardSearchResults", params)
 	result := &dom.DiscardSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -155,18 +228,27 @@ func (protocol *DOMProtocol) DiscardSearchResults(
 }
 
 /*
-Enable enables the DOM agent for the given page.
+Enables DOM agent for the given page.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-enable
 */
-func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
-	resultChan := make(chan *dom.EnableResult)
+func (protocol *DOMProtocol) Enable(
+	params ...*dom.EnableParams,
+) <-chan *dom.EnableResult {
+	resultChan := make(chan *dom.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.enable", params[0])
+	}
 	result := &dom.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -177,20 +259,27 @@ func (protocol *DOMProtocol) Enable() <-chan *dom.EnableResult {
 }
 
 /*
-Focus focuses the given element.
+Focuses the given element.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-focus
 */
 func (protocol *DOMProtocol) Focus(
-	params *dom.FocusParams,
+	params ...*dom.FocusParams,
 ) <-chan *dom.FocusResult {
-	resultChan := make(chan *dom.FocusResult)
-	command := NewCommand(protocol.Socket, "DOM.focus", params)
+	resultChan := make(chan *dom.FocusResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.focus", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.focus", params[0])
+	}
 	result := &dom.FocusResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -201,20 +290,22 @@ func (protocol *DOMProtocol) Focus(
 }
 
 /*
-GetAttributes returns attributes for the specified node.
+Returns attributes for the specified node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAttributes
 */
 func (protocol *DOMProtocol) GetAttributes(
 	params *dom.GetAttributesParams,
 ) <-chan *dom.GetAttributesResult {
-	resultChan := make(chan *dom.GetAttributesResult)
+	resultChan := make(chan *dom.GetAttributesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getAttributes", params)
 	result := &dom.GetAttributesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -227,20 +318,61 @@ func (protocol *DOMProtocol) GetAttributes(
 }
 
 /*
-GetBoxModel returns boxes for the given node.
+Returns boxes for the given node.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getBoxModel
 */
 func (protocol *DOMProtocol) GetBoxModel(
-	params *dom.GetBoxModelParams,
+	params ...*dom.GetBoxModelParams,
 ) <-chan *dom.GetBoxModelResult {
-	resultChan := make(chan *dom.GetBoxModelResult)
-	command := NewCommand(protocol.Socket, "DOM.getBoxModel", params)
+	resultChan := make(chan *dom.GetBoxModelResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getBoxModel", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getBoxModel", params[0])
+	}
 	result := &dom.GetBoxModelResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns quads that describe node position on the page. This method might return
+multiple quads for inline nodes.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContentQuads
+*/
+func (protocol *DOMProtocol) GetContentQuads(
+	params ...*dom.GetContentQuadsParams,
+) <-chan *dom.GetContentQuadsResult {
+	resultChan := make(chan *dom.GetContentQuadsResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getContentQuads", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getContentQuads", params[0])
+	}
+	result := &dom.GetContentQuadsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -253,21 +385,28 @@ func (protocol *DOMProtocol) GetBoxModel(
 }
 
 /*
-GetDocument returns the root DOM node (and optionally the subtree) to the
-caller.
+Returns the root DOM node (and optionally the subtree) to the caller. Implicitly
+enables the DOM domain events for the current target.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDocument
 */
 func (protocol *DOMProtocol) GetDocument(
-	params *dom.GetDocumentParams,
+	params ...*dom.GetDocumentParams,
 ) <-chan *dom.GetDocumentResult {
-	resultChan := make(chan *dom.GetDocumentResult)
-	command := NewCommand(protocol.Socket, "DOM.getDocument", params)
+	resultChan := make(chan *dom.GetDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getDocument", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getDocument", params[0])
+	}
 	result := &dom.GetDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -280,21 +419,29 @@ func (protocol *DOMProtocol) GetDocument(
 }
 
 /*
-GetFlattenedDocument returns the root DOM node (and optionally the subtree) to
-the caller.
+Returns the root DOM node (and optionally the subtree) to the caller.
+Deprecated, as it is not designed to work well with the rest of the DOM agent.
+Use DOMSnapshot.captureSnapshot instead.
+
+The parameters are optional. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFlattenedDocument
 */
 func (protocol *DOMProtocol) GetFlattenedDocument(
-	params *dom.GetFlattenedDocumentParams,
+	params ...*dom.GetFlattenedDocumentParams,
 ) <-chan *dom.GetFlattenedDocumentResult {
-	resultChan := make(chan *dom.GetFlattenedDocumentResult)
-	command := NewCommand(protocol.Socket, "DOM.getFlattenedDocument", params)
+	resultChan := make(chan *dom.GetFlattenedDocumentResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getFlattenedDocument", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getFlattenedDocument", params[0])
+	}
 	result := &dom.GetFlattenedDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -307,20 +454,51 @@ func (protocol *DOMProtocol) GetFlattenedDocument(
 }
 
 /*
-GetNodeForLocation returns node id at given location.
+Finds nodes with a given computed style in a subtree. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLocation EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodesForSubtreeByStyle
+*/
+func (protocol *DOMProtocol) GetNodesForSubtreeByStyle(
+	params *dom.GetNodesForSubtreeByStyleParams,
+) <-chan *dom.GetNodesForSubtreeByStyleResult {
+	resultChan := make(chan *dom.GetNodesForSubtreeByStyleResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getNodesForSubtreeByStyle", params)
+	result := &dom.GetNodesForSubtreeByStyleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns node id at given location. Depending on whether DOM domain is enabled,
+nodeId is either returned or not.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeForLocation
 */
 func (protocol *DOMProtocol) GetNodeForLocation(
 	params *dom.GetNodeForLocationParams,
 ) <-chan *dom.GetNodeForLocationResult {
-	resultChan := make(chan *dom.GetNodeForLocationResult)
+	resultChan := make(chan *dom.GetNodeForLocationResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getNodeForLocation", params)
 	result := &dom.GetNodeForLocationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -333,20 +511,27 @@ func (protocol *DOMProtocol) GetNodeForLocation(
 }
 
 /*
-GetOuterHTML returns node's HTML markup.
+Returns node's HTML markup.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getOuterHTML
 */
 func (protocol *DOMProtocol) GetOuterHTML(
-	params *dom.GetOuterHTMLParams,
+	params ...*dom.GetOuterHTMLParams,
 ) <-chan *dom.GetOuterHTMLResult {
-	resultChan := make(chan *dom.GetOuterHTMLResult)
-	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", params)
+	resultChan := make(chan *dom.GetOuterHTMLResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getOuterHTML", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.getOuterHTML", params[0])
+	}
 	result := &dom.GetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -359,21 +544,23 @@ func (protocol *DOMProtocol) GetOuterHTML(
 }
 
 /*
-GetRelayoutBoundary returns the id of the nearest ancestor that is a relayout
-boundary.
+Returns the id of the nearest ancestor that is a relayout boundary.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBoundary EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getRelayoutBoundary
 */
 func (protocol *DOMProtocol) GetRelayoutBoundary(
 	params *dom.GetRelayoutBoundaryParams,
 ) <-chan *dom.GetRelayoutBoundaryResult {
-	resultChan := make(chan *dom.GetRelayoutBoundaryResult)
+	resultChan := make(chan *dom.GetRelayoutBoundaryResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getRelayoutBoundary", params)
 	result := &dom.GetRelayoutBoundaryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -386,22 +573,23 @@ func (protocol *DOMProtocol) GetRelayoutBoundary(
 }
 
 /*
-GetSearchResults returns search results from given fromIndex to given toIndex
-from the search with the given identifier.
+Returns search results from given `fromIndex` to given `toIndex` from the search
+with the given identifier. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getSearchResults
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) GetSearchResults(
 	params *dom.GetSearchResultsParams,
 ) <-chan *dom.GetSearchResultsResult {
-	resultChan := make(chan *dom.GetSearchResultsResult)
+	resultChan := make(chan *dom.GetSearchResultsResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.getSearchResults", params)
 	result := &dom.GetSearchResultsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -414,19 +602,92 @@ func (protocol *DOMProtocol) GetSearchResults(
 }
 
 /*
-MarkUndoableState marks last undoable state.
+Hides any highlight.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-hideHighlight
+*/
+func (protocol *DOMProtocol) HideHighlight() <-chan *dom.HideHighlightResult {
+	resultChan := make(chan *dom.HideHighlightResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.hideHighlight", nil)
+	result := &dom.HideHighlightResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlights DOM node.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-highlightNode
+*/
+func (protocol *DOMProtocol) HighlightNode() <-chan *dom.HighlightNodeResult {
+	resultChan := make(chan *dom.HighlightNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightNode", nil)
+	result := &dom.HighlightNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlights given rectangle.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-highlightRect
+*/
+func (protocol *DOMProtocol) HighlightRect() <-chan *dom.HighlightRectResult {
+	resultChan := make(chan *dom.HighlightRectResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.highlightRect", nil)
+	result := &dom.HighlightRectResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Marks last undoable state. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-markUndoableState
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) MarkUndoableState() <-chan *dom.MarkUndoableStateResult {
-	resultChan := make(chan *dom.MarkUndoableStateResult)
+	resultChan := make(chan *dom.MarkUndoableStateResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.markUndoableState", nil)
 	result := &dom.MarkUndoableStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -437,20 +698,22 @@ func (protocol *DOMProtocol) MarkUndoableState() <-chan *dom.MarkUndoableStateRe
 }
 
 /*
-MoveTo moves node into the new container, places it before the given anchor.
+Moves node into the new container, places it before the given anchor.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-moveTo
 */
 func (protocol *DOMProtocol) MoveTo(
 	params *dom.MoveToParams,
 ) <-chan *dom.MoveToResult {
-	resultChan := make(chan *dom.MoveToResult)
+	resultChan := make(chan *dom.MoveToResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.moveTo", params)
 	result := &dom.MoveToResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -463,22 +726,23 @@ func (protocol *DOMProtocol) MoveTo(
 }
 
 /*
-PerformSearch searches for a given string in the DOM tree. Use getSearchResults
-to access search results or cancelSearch to end this search session.
+Searches for a given string in the DOM tree. Use `getSearchResults` to access
+search results or `cancelSearch` to end this search session. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-performSearch
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) PerformSearch(
 	params *dom.PerformSearchParams,
 ) <-chan *dom.PerformSearchResult {
-	resultChan := make(chan *dom.PerformSearchResult)
+	resultChan := make(chan *dom.PerformSearchResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.performSearch", params)
 	result := &dom.PerformSearchResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -491,22 +755,23 @@ func (protocol *DOMProtocol) PerformSearch(
 }
 
 /*
-PushNodeByPathToFrontend requests that the node is sent to the caller given its
-path.
+Requests that the node is sent to the caller given its path. // FIXME, use XPath
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodeByPathToFrontend
-EXPERIMENTAL. @TODO, use XPath.
 */
 func (protocol *DOMProtocol) PushNodeByPathToFrontend(
 	params *dom.PushNodeByPathToFrontendParams,
 ) <-chan *dom.PushNodeByPathToFrontendResult {
-	resultChan := make(chan *dom.PushNodeByPathToFrontendResult)
+	resultChan := make(chan *dom.PushNodeByPathToFrontendResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.pushNodeByPathToFrontend", params)
 	result := &dom.PushNodeByPathToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -519,22 +784,23 @@ func (protocol *DOMProtocol) PushNodeByPathToFrontend(
 }
 
 /*
-PushNodesByBackendIDsToFrontend requests that a batch of nodes is sent to the
-caller given their backend node IDs.
+Requests that a batch of nodes is sent to the caller given their backend node
+ids. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-pushNodesByBackendIdsToFrontend
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontend(
 	params *dom.PushNodesByBackendIDsToFrontendParams,
 ) <-chan *dom.PushNodesByBackendIDsToFrontendResult {
-	resultChan := make(chan *dom.PushNodesByBackendIDsToFrontendResult)
+	resultChan := make(chan *dom.PushNodesByBackendIDsToFrontendResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.pushNodesByBackendIdsToFrontend", params)
 	result := &dom.PushNodesByBackendIDsToFrontendResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -547,20 +813,22 @@ func (protocol *DOMProtocol) PushNodesByBackendIDsToFrontend(
 }
 
 /*
-QuerySelector executes querySelector on a given node.
+Executes `querySelector` on a given node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelector
 */
 func (protocol *DOMProtocol) QuerySelector(
 	params *dom.QuerySelectorParams,
 ) <-chan *dom.QuerySelectorResult {
-	resultChan := make(chan *dom.QuerySelectorResult)
+	resultChan := make(chan *dom.QuerySelectorResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.querySelector", params)
 	result := &dom.QuerySelectorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -573,20 +841,79 @@ func (protocol *DOMProtocol) QuerySelector(
 }
 
 /*
-QuerySelectorAll executes querySelectorAll on a given node.
+Executes `querySelectorAll` on a given node.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-querySelectorAll
 */
 func (protocol *DOMProtocol) QuerySelectorAll(
 	params *dom.QuerySelectorAllParams,
 ) <-chan *dom.QuerySelectorAllResult {
-	resultChan := make(chan *dom.QuerySelectorAllResult)
+	resultChan := make(chan *dom.QuerySelectorAllResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.querySelectorAll", params)
 	result := &dom.QuerySelectorAllResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns NodeIds of current top layer elements. Top layer is rendered closest to
+the user within a viewport, therefore its elements always appear on top of all
+other content. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getTopLayerElements
+*/
+func (protocol *DOMProtocol) GetTopLayerElements() <-chan *dom.GetTopLayerElementsResult {
+	resultChan := make(chan *dom.GetTopLayerElementsResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getTopLayerElements", nil)
+	result := &dom.GetTopLayerElementsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the NodeId of the matched element according to certain relations.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getElementByRelation
+*/
+func (protocol *DOMProtocol) GetElementByRelation(
+	params *dom.GetElementByRelationParams,
+) <-chan *dom.GetElementByRelationResult {
+	resultChan := make(chan *dom.GetElementByRelationResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getElementByRelation", params)
+	result := &dom.GetElementByRelationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -599,18 +926,20 @@ func (protocol *DOMProtocol) QuerySelectorAll(
 }
 
 /*
-Redo re-does the last undone action.
+Re-does the last undone action. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-redo EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-redo
 */
 func (protocol *DOMProtocol) Redo() <-chan *dom.RedoResult {
-	resultChan := make(chan *dom.RedoResult)
+	resultChan := make(chan *dom.RedoResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.redo", nil)
 	result := &dom.RedoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -621,20 +950,22 @@ func (protocol *DOMProtocol) Redo() <-chan *dom.RedoResult {
 }
 
 /*
-RemoveAttribute removes attribute with given name from an element with given id.
+Removes attribute with given name from an element with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeAttribute
 */
 func (protocol *DOMProtocol) RemoveAttribute(
 	params *dom.RemoveAttributeParams,
 ) <-chan *dom.RemoveAttributeResult {
-	resultChan := make(chan *dom.RemoveAttributeResult)
+	resultChan := make(chan *dom.RemoveAttributeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.removeAttribute", params)
 	result := &dom.RemoveAttributeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -645,20 +976,22 @@ func (protocol *DOMProtocol) RemoveAttribute(
 }
 
 /*
-RemoveNode removes the specified node.
+Removes node with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-removeNode
 */
 func (protocol *DOMProtocol) RemoveNode(
 	params *dom.RemoveNodeParams,
 ) <-chan *dom.RemoveNodeResult {
-	resultChan := make(chan *dom.RemoveNodeResult)
+	resultChan := make(chan *dom.RemoveNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.removeNode", params)
 	result := &dom.RemoveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -669,22 +1002,24 @@ func (protocol *DOMProtocol) RemoveNode(
 }
 
 /*
-RequestChildNodes requests that children of the node with given id are returned
-to the caller in form of setChildNodes events where not only immediate children
-are retrieved, but all children down to the specified depth.
+Requests that children of the node with given id are returned to the caller in
+form of `setChildNodes` events where not only immediate children are retrieved,
+but all children down to the specified depth.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestChildNodes
 */
 func (protocol *DOMProtocol) RequestChildNodes(
 	params *dom.RequestChildNodesParams,
 ) <-chan *dom.RequestChildNodesResult {
-	resultChan := make(chan *dom.RequestChildNodesResult)
+	resultChan := make(chan *dom.RequestChildNodesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.requestChildNodes", params)
 	result := &dom.RequestChildNodesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -695,22 +1030,24 @@ func (protocol *DOMProtocol) RequestChildNodes(
 }
 
 /*
-RequestNode requests that the node is sent to the caller given the JavaScript
-node object reference. All nodes that form the path from the node to the root
-are also sent to the client as a series of setChildNodes notifications.
+Requests that the node is sent to the caller given the JavaScript node object
+reference. All nodes that form the path from the node to the root are also sent
+to the client as a series of `setChildNodes` notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-requestNode
 */
 func (protocol *DOMProtocol) RequestNode(
 	params *dom.RequestNodeParams,
 ) <-chan *dom.RequestNodeResult {
-	resultChan := make(chan *dom.RequestNodeResult)
+	resultChan := make(chan *dom.RequestNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.requestNode", params)
 	result := &dom.RequestNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -723,21 +1060,27 @@ func (protocol *DOMProtocol) RequestNode(
 }
 
 /*
-ResolveNode resolves the JavaScript node object for a given NodeID or
-BackendNodeID.
+Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-resolveNode
 */
 func (protocol *DOMProtocol) ResolveNode(
-	params *dom.ResolveNodeParams,
+	params ...*dom.ResolveNodeParams,
 ) <-chan *dom.ResolveNodeResult {
-	resultChan := make(chan *dom.ResolveNodeResult)
-	command := NewCommand(protocol.Socket, "DOM.resolveNode", params)
+	resultChan := make(chan *dom.ResolveNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.resolveNode", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "DOM.resolveNode", params[0])
+	}
 	result := &dom.ResolveNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -750,20 +1093,22 @@ func (protocol *DOMProtocol) ResolveNode(
 }
 
 /*
-SetAttributeValue sets attribute for an element with given id.
+Sets attribute for an element with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributeValue
 */
 func (protocol *DOMProtocol) SetAttributeValue(
 	params *dom.SetAttributeValueParams,
 ) <-chan *dom.SetAttributeValueResult {
-	resultChan := make(chan *dom.SetAttributeValueResult)
+	resultChan := make(chan *dom.SetAttributeValueResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setAttributeValue", params)
 	result := &dom.SetAttributeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -774,22 +1119,23 @@ func (protocol *DOMProtocol) SetAttributeValue(
 }
 
 /*
-SetAttributesAsText sets attributes on element with given id. This method is
-useful when user edits some existing attribute value and types in several
-attribute name/value pairs.
+Sets attributes on element with given id. This method is useful when user edits
+some existing attribute value and types in several attribute name/value pairs.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setAttributesAsText
 */
 func (protocol *DOMProtocol) SetAttributesAsText(
 	params *dom.SetAttributesAsTextParams,
 ) <-chan *dom.SetAttributesAsTextResult {
-	resultChan := make(chan *dom.SetAttributesAsTextResult)
+	resultChan := make(chan *dom.SetAttributesAsTextResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setAttributesAsText", params)
 	result := &dom.SetAttributesAsTextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -800,20 +1146,22 @@ func (protocol *DOMProtocol) SetAttributesAsText(
 }
 
 /*
-SetFileInputFiles sets files for the given file input element.
+Sets files for the given file input element.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setFileInputFiles
 */
 func (protocol *DOMProtocol) SetFileInputFiles(
 	params *dom.SetFileInputFilesParams,
 ) <-chan *dom.SetFileInputFilesResult {
-	resultChan := make(chan *dom.SetFileInputFilesResult)
+	resultChan := make(chan *dom.SetFileInputFilesResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setFileInputFiles", params)
 	result := &dom.SetFileInputFilesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -824,21 +1172,133 @@ func (protocol *DOMProtocol) SetFileInputFiles(
 }
 
 /*
-SetInspectedNode enables console to refer to the node with given id via $x (see
-Command Line API for more details $x functions).
+Sets if stack traces should be captured for Nodes. See
+`Node.getNodeStackTraces`. Default is disabled. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedNode EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeStackTracesEnabled
+*/
+func (protocol *DOMProtocol) SetNodeStackTracesEnabled(
+	params *dom.SetNodeStackTracesEnabledParams,
+) <-chan *dom.SetNodeStackTracesEnabledResult {
+	resultChan := make(chan *dom.SetNodeStackTracesEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.setNodeStackTracesEnabled", params)
+	result := &dom.SetNodeStackTracesEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets stack traces associated with a Node. As of now, only provides stack trace
+for Node creation. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getNodeStackTraces
+*/
+func (protocol *DOMProtocol) GetNodeStackTraces(
+	params *dom.GetNodeStackTracesParams,
+) <-chan *dom.GetNodeStackTracesResult {
+	resultChan := make(chan *dom.GetNodeStackTracesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getNodeStackTraces", params)
+	result := &dom.GetNodeStackTracesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns file information for the given File wrapper. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFileInfo
+*/
+func (protocol *DOMProtocol) GetFileInfo(
+	params *dom.GetFileInfoParams,
+) <-chan *dom.GetFileInfoResult {
+	resultChan := make(chan *dom.GetFileInfoResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getFileInfo", params)
+	result := &dom.GetFileInfoResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns list of detached nodes EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getDetachedDomNodes
+*/
+func (protocol *DOMProtocol) GetDetachedDOMNodes() <-chan *dom.GetDetachedDOMNodesResult {
+	resultChan := make(chan *dom.GetDetachedDOMNodesResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getDetachedDomNodes", nil)
+	result := &dom.GetDetachedDOMNodesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables console to refer to the node with given id via $x (see Command Line API
+for more details $x functions). EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setInspectedNode
 */
 func (protocol *DOMProtocol) SetInspectedNode(
 	params *dom.SetInspectedNodeParams,
 ) <-chan *dom.SetInspectedNodeResult {
-	resultChan := make(chan *dom.SetInspectedNodeResult)
+	resultChan := make(chan *dom.SetInspectedNodeResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setInspectedNode", params)
 	result := &dom.SetInspectedNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -849,20 +1309,22 @@ func (protocol *DOMProtocol) SetInspectedNode(
 }
 
 /*
-SetNodeName sets node name for the specified node.
+Sets node name for a node with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeName
 */
 func (protocol *DOMProtocol) SetNodeName(
 	params *dom.SetNodeNameParams,
 ) <-chan *dom.SetNodeNameResult {
-	resultChan := make(chan *dom.SetNodeNameResult)
+	resultChan := make(chan *dom.SetNodeNameResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setNodeName", params)
 	result := &dom.SetNodeNameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -875,20 +1337,22 @@ func (protocol *DOMProtocol) SetNodeName(
 }
 
 /*
-SetNodeValue sets node value for the specified node.
+Sets node value for a node with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setNodeValue
 */
 func (protocol *DOMProtocol) SetNodeValue(
 	params *dom.SetNodeValueParams,
 ) <-chan *dom.SetNodeValueResult {
-	resultChan := make(chan *dom.SetNodeValueResult)
+	resultChan := make(chan *dom.SetNodeValueResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setNodeValue", params)
 	result := &dom.SetNodeValueResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -899,20 +1363,22 @@ func (protocol *DOMProtocol) SetNodeValue(
 }
 
 /*
-SetOuterHTML sets node HTML markup, returns new node id.
+Sets node HTML markup, returns new node id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-setOuterHTML
 */
 func (protocol *DOMProtocol) SetOuterHTML(
 	params *dom.SetOuterHTMLParams,
 ) <-chan *dom.SetOuterHTMLResult {
-	resultChan := make(chan *dom.SetOuterHTMLResult)
+	resultChan := make(chan *dom.SetOuterHTMLResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.setOuterHTML", params)
 	result := &dom.SetOuterHTMLResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -923,20 +1389,169 @@ func (protocol *DOMProtocol) SetOuterHTML(
 }
 
 /*
-Undo undoes the last performed action.
+Undoes the last performed action. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-undo
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) Undo() <-chan *dom.UndoResult {
-	resultChan := make(chan *dom.UndoResult)
+	resultChan := make(chan *dom.UndoResult, 1)
 	command := NewCommand(protocol.Socket, "DOM.undo", nil)
 	result := &dom.UndoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns iframe node that owns iframe with the given domain. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getFrameOwner
+*/
+func (protocol *DOMProtocol) GetFrameOwner(
+	params *dom.GetFrameOwnerParams,
+) <-chan *dom.GetFrameOwnerResult {
+	resultChan := make(chan *dom.GetFrameOwnerResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getFrameOwner", params)
+	result := &dom.GetFrameOwnerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the query container of the given node based on container query
+conditions: containerName, physical and logical axes, and whether it queries
+scroll-state or anchored elements. If no axes are provided and
+queriesScrollState is false, the style container is returned, which is the
+direct parent or the closest element with a matching container-name.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getContainerForNode
+*/
+func (protocol *DOMProtocol) GetContainerForNode(
+	params *dom.GetContainerForNodeParams,
+) <-chan *dom.GetContainerForNodeResult {
+	resultChan := make(chan *dom.GetContainerForNodeResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getContainerForNode", params)
+	result := &dom.GetContainerForNodeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the descendants of a container query container that have container
+queries against this container. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getQueryingDescendantsForContainer
+*/
+func (protocol *DOMProtocol) GetQueryingDescendantsForContainer(
+	params *dom.GetQueryingDescendantsForContainerParams,
+) <-chan *dom.GetQueryingDescendantsForContainerResult {
+	resultChan := make(chan *dom.GetQueryingDescendantsForContainerResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getQueryingDescendantsForContainer", params)
+	result := &dom.GetQueryingDescendantsForContainerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the target anchor element of the given anchor query according to
+https://www.w3.org/TR/css-anchor-position-1/#target. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-getAnchorElement
+*/
+func (protocol *DOMProtocol) GetAnchorElement(
+	params *dom.GetAnchorElementParams,
+) <-chan *dom.GetAnchorElementResult {
+	resultChan := make(chan *dom.GetAnchorElementResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.getAnchorElement", params)
+	result := &dom.GetAnchorElementResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+When enabling, this API force-opens the popover identified by nodeId and keeps
+it open until disabled. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#method-forceShowPopover
+*/
+func (protocol *DOMProtocol) ForceShowPopover(
+	params *dom.ForceShowPopoverParams,
+) <-chan *dom.ForceShowPopoverResult {
+	resultChan := make(chan *dom.ForceShowPopoverResult, 1)
+	command := NewCommand(protocol.Socket, "DOM.forceShowPopover", params)
+	result := &dom.ForceShowPopoverResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -947,13 +1562,14 @@ func (protocol *DOMProtocol) Undo() <-chan *dom.UndoResult {
 
 /*
 OnAttributeModified adds a handler to the DOM.attributeModified event.
-DOM.attributeModified fires when Element's attribute is modified.
+
+Fired when `Element`'s attribute is modified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeModified
 */
 func (protocol *DOMProtocol) OnAttributeModified(
 	callback func(event *dom.AttributeModifiedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.attributeModified",
 		func(response *Response) {
@@ -966,17 +1582,19 @@ func (protocol *DOMProtocol) OnAttributeModified(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnAttributeRemoved adds a handler to the DOM.attributeRemoved event.
-DOM.attributeRemoved fires when Element's attribute is modified.
+
+Fired when `Element`'s attribute is removed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-attributeRemoved
 */
 func (protocol *DOMProtocol) OnAttributeRemoved(
 	callback func(event *dom.AttributeRemovedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.attributeRemoved",
 		func(response *Response) {
@@ -989,17 +1607,19 @@ func (protocol *DOMProtocol) OnAttributeRemoved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnCharacterDataModified adds a handler to the DOM.characterDataModified event.
-DOM.characterDataModified mirrors the DOMCharacterDataModified event.
+
+Mirrors `DOMCharacterDataModified` event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-characterDataModified
 */
 func (protocol *DOMProtocol) OnCharacterDataModified(
 	callback func(event *dom.CharacterDataModifiedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.characterDataModified",
 		func(response *Response) {
@@ -1012,17 +1632,19 @@ func (protocol *DOMProtocol) OnCharacterDataModified(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnChildNodeCountUpdated adds a handler to the DOM.childNodeCountUpdated event.
-DOM.childNodeCountUpdated fires when Container's child node count has changed.
+
+Fired when `Container`'s child node count has changed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeCountUpdated
 */
 func (protocol *DOMProtocol) OnChildNodeCountUpdated(
 	callback func(event *dom.ChildNodeCountUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.childNodeCountUpdated",
 		func(response *Response) {
@@ -1035,17 +1657,19 @@ func (protocol *DOMProtocol) OnChildNodeCountUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnChildNodeInserted adds a handler to the DOM.childNodeInserted event.
-DOM.childNodeInserted mirrors the DOMNodeInserted event.
+
+Mirrors `DOMNodeInserted` event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeInserted
 */
 func (protocol *DOMProtocol) OnChildNodeInserted(
 	callback func(event *dom.ChildNodeInsertedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.childNodeInserted",
 		func(response *Response) {
@@ -1058,17 +1682,19 @@ func (protocol *DOMProtocol) OnChildNodeInserted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnChildNodeRemoved adds a handler to the DOM.childNodeRemoved event.
-DOM.childNodeRemoved mirrors the DOMNodeRemoved event.
+
+Mirrors `DOMNodeRemoved` event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-childNodeRemoved
 */
 func (protocol *DOMProtocol) OnChildNodeRemoved(
 	callback func(event *dom.ChildNodeRemovedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.childNodeRemoved",
 		func(response *Response) {
@@ -1081,18 +1707,20 @@ func (protocol *DOMProtocol) OnChildNodeRemoved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnDistributedNodesUpdated adds a handler to the DOM.distributedNodesUpdated
-event. DOM.distributedNodesUpdated fires when distribution is changed.
+event.
+
+Called when distribution is changed. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-distributedNodesUpdated
-EXPERIMENTAL.
 */
 func (protocol *DOMProtocol) OnDistributedNodesUpdated(
 	callback func(event *dom.DistributedNodesUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.distributedNodesUpdated",
 		func(response *Response) {
@@ -1105,18 +1733,19 @@ func (protocol *DOMProtocol) OnDistributedNodesUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnDocumentUpdated adds a handler to the DOM.documentUpdated event.
-DOM.documentUpdated fires when Document has been totally updated. Node IDs are
-no longer valid.
+
+Fired when `Document` has been totally updated. Node ids are no longer valid.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-documentUpdated
 */
 func (protocol *DOMProtocol) OnDocumentUpdated(
 	callback func(event *dom.DocumentUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.documentUpdated",
 		func(response *Response) {
@@ -1129,17 +1758,20 @@ func (protocol *DOMProtocol) OnDocumentUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnInlineStyleInvalidated adds a handler to the DOM.inlineStyleInvalidated event.
-DOM.inlineStyleInvalidated fires when Element's attribute is removed.
+
+Fired when `Element`'s inline style is modified via a CSS property modification.
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-inlineStyleInvalidated
 */
 func (protocol *DOMProtocol) OnInlineStyleInvalidated(
 	callback func(event *dom.InlineStyleInvalidatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.inlineStyleInvalidated",
 		func(response *Response) {
@@ -1152,17 +1784,19 @@ func (protocol *DOMProtocol) OnInlineStyleInvalidated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnPseudoElementAdded adds a handler to the DOM.pseudoElementAdded event.
-DOM.pseudoElementAdded fires when a pseudo element is added to an element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded EXPERIMENTAL.
+Called when a pseudo element is added to an element. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementAdded
 */
 func (protocol *DOMProtocol) OnPseudoElementAdded(
 	callback func(event *dom.PseudoElementAddedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.pseudoElementAdded",
 		func(response *Response) {
@@ -1175,17 +1809,70 @@ func (protocol *DOMProtocol) OnPseudoElementAdded(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTopLayerElementsUpdated adds a handler to the DOM.topLayerElementsUpdated
+event.
+
+Called when top layer elements are changed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-topLayerElementsUpdated
+*/
+func (protocol *DOMProtocol) OnTopLayerElementsUpdated(
+	callback func(event *dom.TopLayerElementsUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.topLayerElementsUpdated",
+		func(response *Response) {
+			event := &dom.TopLayerElementsUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnScrollableFlagUpdated adds a handler to the DOM.scrollableFlagUpdated event.
+
+Fired when a node's scrollability state changes. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-scrollableFlagUpdated
+*/
+func (protocol *DOMProtocol) OnScrollableFlagUpdated(
+	callback func(event *dom.ScrollableFlagUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"DOM.scrollableFlagUpdated",
+		func(response *Response) {
+			event := &dom.ScrollableFlagUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnPseudoElementRemoved adds a handler to the DOM.pseudoElementRemoved event.
-DOM.pseudoElementRemoved fires when a pseudo element is removed from an element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved EXPERIMENTAL.
+Called when a pseudo element is removed from an element. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-pseudoElementRemoved
 */
 func (protocol *DOMProtocol) OnPseudoElementRemoved(
 	callback func(event *dom.PseudoElementRemovedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.pseudoElementRemoved",
 		func(response *Response) {
@@ -1198,18 +1885,20 @@ func (protocol *DOMProtocol) OnPseudoElementRemoved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnSetChildNodes adds a handler to the DOM.setChildNodes event. DOM.setChildNodes
-fires when backend wants to provide client with the missing DOM structure. This
-happens upon most of the calls requesting node IDs.
+OnSetChildNodes adds a handler to the DOM.setChildNodes event.
+
+Fired when backend wants to provide client with the missing DOM structure. This
+happens upon most of the calls requesting node ids.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-setChildNodes
 */
 func (protocol *DOMProtocol) OnSetChildNodes(
 	callback func(event *dom.SetChildNodesEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.setChildNodes",
 		func(response *Response) {
@@ -1222,17 +1911,19 @@ func (protocol *DOMProtocol) OnSetChildNodes(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnShadowRootPopped adds a handler to the DOM.shadowRootPopped event.
-DOM.shadowRootPopped fires when shadow root is popped from the element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopped EXPERIMENTAL.
+Called when shadow root is popped from the element. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPopped
 */
 func (protocol *DOMProtocol) OnShadowRootPopped(
 	callback func(event *dom.ShadowRootPoppedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.shadowRootPopped",
 		func(response *Response) {
@@ -1245,17 +1936,19 @@ func (protocol *DOMProtocol) OnShadowRootPopped(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnShadowRootPushed adds a handler to the DOM.shadowRootPushed event.
-DOM.shadowRootPushed fires when shadow root is pushed into the element.
 
-https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPushed EXPERIMENTAL.
+Called when shadow root is pushed into the element. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOM/#event-shadowRootPushed
 */
 func (protocol *DOMProtocol) OnShadowRootPushed(
 	callback func(event *dom.ShadowRootPushedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOM.shadowRootPushed",
 		func(response *Response) {
@@ -1268,4 +1961,5 @@ func (protocol *DOMProtocol) OnShadowRootPushed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
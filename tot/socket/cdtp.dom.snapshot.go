@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/snapshot"
@@ -8,28 +11,56 @@ import (
 
 /*
 DOMSnapshotProtocol provides a namespace for the Chrome DOMSnapshot protocol
-methods. The DOMSnapshot protocol facilitates obtaining document snapshots with
-DOM, layout, and style information.
+methods.
+
+This domain facilitates obtaining document snapshots with DOM, layout, and style
+information. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/
 */
 type DOMSnapshotProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMSnapshotProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-Disable disables the DOM snapshot functionality for the given page.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMSnapshotProtocol) WithContext(ctx context.Context) *DOMSnapshotProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMSnapshotProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disables DOM snapshot agent for the given page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-disable
 */
 func (protocol *DOMSnapshotProtocol) Disable() <-chan *snapshot.DisableResult {
-	resultChan := make(chan *snapshot.DisableResult)
+	resultChan := make(chan *snapshot.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.disable", nil)
 	result := &snapshot.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -40,18 +71,20 @@ func (protocol *DOMSnapshotProtocol) Disable() <-chan *snapshot.DisableResult {
 }
 
 /*
-Enable enables the DOM snapshot functionality for the given page.
+Enables DOM snapshot agent for the given page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-enable
 */
 func (protocol *DOMSnapshotProtocol) Enable() <-chan *snapshot.EnableResult {
-	resultChan := make(chan *snapshot.EnableResult)
+	resultChan := make(chan *snapshot.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.enable", nil)
 	result := &snapshot.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,23 +95,56 @@ func (protocol *DOMSnapshotProtocol) Enable() <-chan *snapshot.EnableResult {
 }
 
 /*
-Get returns a document snapshot, including the full DOM tree of the root node
+Returns a document snapshot, including the full DOM tree of the root node
 (including iframes, template contents, and imported documents) in a flattened
 array, as well as layout and white-listed computed style information for the
-nodes. Shadow DOM in the returned DOM tree is flattened.
+nodes. Shadow DOM in the returned DOM tree is flattened. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-getSnapshot
 */
 func (protocol *DOMSnapshotProtocol) Get(
 	params *snapshot.GetParams,
 ) <-chan *snapshot.GetResult {
-	resultChan := make(chan *snapshot.GetResult)
+	resultChan := make(chan *snapshot.GetResult, 1)
 	command := NewCommand(protocol.Socket, "DOMSnapshot.getSnapshot", params)
 	result := &snapshot.GetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns a document snapshot, including the full DOM tree of the root node
+(including iframes, template contents, and imported documents) in a flattened
+array, as well as layout and white-listed computed style information for the
+nodes. Shadow DOM in the returned DOM tree is flattened.
+
+https://chromedevtools.github.io/devtools-protocol/tot/DOMSnapshot/#method-captureSnapshot
+*/
+func (protocol *DOMSnapshotProtocol) CaptureSnapshot(
+	params *snapshot.CaptureSnapshotParams,
+) <-chan *snapshot.CaptureSnapshotResult {
+	resultChan := make(chan *snapshot.CaptureSnapshotResult, 1)
+	command := NewCommand(protocol.Socket, "DOMSnapshot.captureSnapshot", params)
+	result := &snapshot.CaptureSnapshotResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
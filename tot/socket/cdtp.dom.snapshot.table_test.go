@@ -0,0 +1,64 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/dom/snapshot"
+)
+
+func TestDOMSnapshotCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestDOMSnapshotCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"DOMSnapshot.disable", func() func() error {
+			resultChan := mockSocket.DOMSnapshot().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DOMSnapshot.enable", func() func() error {
+			resultChan := mockSocket.DOMSnapshot().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DOMSnapshot.getSnapshot", func() func() error {
+			resultChan := mockSocket.DOMSnapshot().Get(&snapshot.GetParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"DOMSnapshot.captureSnapshot", func() func() error {
+			resultChan := mockSocket.DOMSnapshot().CaptureSnapshot(&snapshot.CaptureSnapshotParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
@@ -97,9 +97,9 @@ func TestDOMSnapshotGet(t *testing.T) {
 			InputChecked:          true,
 			OptionSelected:        true,
 			BackendNodeID:         dom.BackendNodeID(1),
-			ChildNodeIndexes:      []int64{1, 2, 3},
+			ChildNodeIndexes:      []int{1, 2, 3},
 			Attributes:            []*snapshot.NameValue{},
-			PseudoElementIndexes:  []int64{1, 2, 3},
+			PseudoElementIndexes:  []int{1, 2, 3},
 			LayoutNodeIndex:       1,
 			DocumentURL:           "http://document.url",
 			BaseURL:               "http://base.url",
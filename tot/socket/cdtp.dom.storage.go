@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/dom/storage"
@@ -8,29 +11,57 @@ import (
 
 /*
 DOMStorageProtocol provides a namespace for the Chrome DOMStorage protocol
-methods. The DOMStorage protocol queries and modifies DOM storage.
+methods.
+
+Query and modify DOM storage. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/
 */
 type DOMStorageProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *DOMStorageProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-Clear clears  a stored item.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *DOMStorageProtocol) WithContext(ctx context.Context) *DOMStorageProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &DOMStorageProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Clear sends the DOMStorage.clear command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-clear
 */
 func (protocol *DOMStorageProtocol) Clear(
 	params *storage.ClearParams,
 ) <-chan *storage.ClearResult {
-	resultChan := make(chan *storage.ClearResult)
+	resultChan := make(chan *storage.ClearResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.clear", params)
 	result := &storage.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -41,19 +72,21 @@ func (protocol *DOMStorageProtocol) Clear(
 }
 
 /*
-Disable disables storage tracking, prevents storage events from being sent to
-the client.
+Disables storage tracking, prevents storage events from being sent to the
+client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-disable
 */
 func (protocol *DOMStorageProtocol) Disable() <-chan *storage.DisableResult {
-	resultChan := make(chan *storage.DisableResult)
+	resultChan := make(chan *storage.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.disable", nil)
 	result := &storage.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -64,19 +97,20 @@ func (protocol *DOMStorageProtocol) Disable() <-chan *storage.DisableResult {
 }
 
 /*
-Enable enables storage tracking, storage events will now be delivered to the
-client.
+Enables storage tracking, storage events will now be delivered to the client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-enable
 */
 func (protocol *DOMStorageProtocol) Enable() <-chan *storage.EnableResult {
-	resultChan := make(chan *storage.EnableResult)
+	resultChan := make(chan *storage.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.enable", nil)
 	result := &storage.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -87,20 +121,22 @@ func (protocol *DOMStorageProtocol) Enable() <-chan *storage.EnableResult {
 }
 
 /*
-GetItems gets a stored item.
+GetItems sends the DOMStorage.getDOMStorageItems command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-getDOMStorageItems
 */
 func (protocol *DOMStorageProtocol) GetItems(
 	params *storage.GetItemsParams,
 ) <-chan *storage.GetItemsResult {
-	resultChan := make(chan *storage.GetItemsResult)
+	resultChan := make(chan *storage.GetItemsResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.getDOMStorageItems", params)
 	result := &storage.GetItemsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -113,20 +149,22 @@ func (protocol *DOMStorageProtocol) GetItems(
 }
 
 /*
-RemoveItem removes  a stored item.
+RemoveItem sends the DOMStorage.removeDOMStorageItem command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-removeDOMStorageItem
 */
 func (protocol *DOMStorageProtocol) RemoveItem(
 	params *storage.RemoveItemParams,
 ) <-chan *storage.RemoveItemResult {
-	resultChan := make(chan *storage.RemoveItemResult)
+	resultChan := make(chan *storage.RemoveItemResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.removeDOMStorageItem", params)
 	result := &storage.RemoveItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -137,20 +175,22 @@ func (protocol *DOMStorageProtocol) RemoveItem(
 }
 
 /*
-SetItem sets a stored item.
+SetItem sends the DOMStorage.setDOMStorageItem command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#method-setDOMStorageItem
 */
 func (protocol *DOMStorageProtocol) SetItem(
 	params *storage.SetItemParams,
 ) <-chan *storage.SetItemResult {
-	resultChan := make(chan *storage.SetItemResult)
+	resultChan := make(chan *storage.SetItemResult, 1)
 	command := NewCommand(protocol.Socket, "DOMStorage.setDOMStorageItem", params)
 	result := &storage.SetItemResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -162,13 +202,12 @@ func (protocol *DOMStorageProtocol) SetItem(
 
 /*
 OnItemAdded adds a handler to the DOMStorage.domStorageItemAdded event.
-DOMStorage.domStorageItemAdded fires when an item is added to DOM storage.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemAdded
 */
 func (protocol *DOMStorageProtocol) OnItemAdded(
 	callback func(event *storage.ItemAddedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemAdded",
 		func(response *Response) {
@@ -181,17 +220,17 @@ func (protocol *DOMStorageProtocol) OnItemAdded(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnItemRemoved adds a handler to the DOMStorage.domStorageItemRemoved event.
-DOMStorage.domStorageItemRemoved fires when an item is removed from DOM storage.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemRemoved
 */
 func (protocol *DOMStorageProtocol) OnItemRemoved(
 	callback func(event *storage.ItemRemovedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemRemoved",
 		func(response *Response) {
@@ -204,17 +243,17 @@ func (protocol *DOMStorageProtocol) OnItemRemoved(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnItemUpdated adds a handler to the DOMStorage.domStorageItemUpdated event.
-DOMStorage.domStorageItemUpdated fires when an item in DOM storage is updated.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemUpdated
 */
 func (protocol *DOMStorageProtocol) OnItemUpdated(
 	callback func(event *storage.ItemUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemUpdated",
 		func(response *Response) {
@@ -227,17 +266,17 @@ func (protocol *DOMStorageProtocol) OnItemUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnItemsCleared adds a handler to the DOMStorage.domStorageItemsCleared event.
-DOMStorage.domStorageItemsCleared fires when items in DOM storage are cleared.
 
 https://chromedevtools.github.io/devtools-protocol/tot/DOMStorage/#event-domStorageItemsCleared
 */
 func (protocol *DOMStorageProtocol) OnItemsCleared(
 	callback func(event *storage.ItemsClearedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"DOMStorage.domStorageItemsCleared",
 		func(response *Response) {
@@ -250,4 +289,5 @@ func (protocol *DOMStorageProtocol) OnItemsCleared(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
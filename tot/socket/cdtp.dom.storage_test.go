@@ -133,7 +133,7 @@ func TestDOMStorageGetItems(t *testing.T) {
 		},
 	})
 	mockResult := &storage.GetItemsResult{
-		Entries: []storage.Item{{float64(1), "two"}},
+		Entries: []storage.Item{{"one", "two"}},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
@@ -145,12 +145,12 @@ func TestDOMStorageGetItems(t *testing.T) {
 	if nil != result.Err {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
-	if mockResult.Entries[0][0].(float64) != result.Entries[0][0].(float64) {
-		t.Errorf("Expected '%d', got '%d'", mockResult.Entries[0][0], result.Entries[0][0])
-	}
-	if mockResult.Entries[0][1].(string) != result.Entries[0][1].(string) {
+	if mockResult.Entries[0][0] != result.Entries[0][0] {
 		t.Errorf("Expected '%s', got '%s'", mockResult.Entries[0][0], result.Entries[0][0])
 	}
+	if mockResult.Entries[0][1] != result.Entries[0][1] {
+		t.Errorf("Expected '%s', got '%s'", mockResult.Entries[0][1], result.Entries[0][1])
+	}
 
 	resultChan = mockSocket.DOMStorage().GetItems(&storage.GetItemsParams{
 		StorageID: &storage.ID{
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/page"
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -61,7 +62,7 @@ func TestDOMCopyTo(t *testing.T) {
 	params := &dom.CopyToParams{
 		NodeID:             dom.NodeID(1),
 		TargetNodeID:       dom.NodeID(1),
-		InsertBeforeNodeID: dom.NodeID(1),
+		InsertBeforeNodeID: optional.Of(dom.NodeID(1)),
 	}
 	resultChan := mockSocket.DOM().CopyTo(params)
 	mockResult := &dom.CopyToResult{
@@ -103,11 +104,11 @@ func TestDOMDescribeNode(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.DescribeNodeParams{
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectID:      runtime.RemoteObjectID("remote-object-id"),
-		Depth:         1,
-		Pierce:        true,
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectID:      optional.Of(runtime.RemoteObjectID("remote-object-id")),
+		Depth:         optional.Int(1),
+		Pierce:        optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().DescribeNode(params)
 	mockResult := &dom.DescribeNodeResult{
@@ -287,9 +288,9 @@ func TestDOMFocus(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.FocusParams{
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectID:      runtime.RemoteObjectID("remote-object-id"),
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectID:      optional.Of(runtime.RemoteObjectID("remote-object-id")),
 	}
 	resultChan := mockSocket.DOM().Focus(params)
 	mockResult := &dom.FocusResult{}
@@ -368,9 +369,9 @@ func TestDOMGetBoxModel(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.GetBoxModelParams{
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectID:      runtime.RemoteObjectID("remote-object-id"),
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectID:      optional.Of(runtime.RemoteObjectID("remote-object-id")),
 	}
 	resultChan := mockSocket.DOM().GetBoxModel(params)
 	mockResult := &dom.GetBoxModelResult{
@@ -424,8 +425,8 @@ func TestDOMGetDocument(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.GetDocumentParams{
-		Depth:  1,
-		Pierce: true,
+		Depth:  optional.Int(1),
+		Pierce: optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().GetDocument(params)
 	mockResult := &dom.GetDocumentResult{
@@ -500,8 +501,8 @@ func TestDOMGetFlattenedDocument(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.GetFlattenedDocumentParams{
-		Depth:  1,
-		Pierce: true,
+		Depth:  optional.Int(1),
+		Pierce: optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().GetFlattenedDocument(params)
 	mockResult := &dom.GetFlattenedDocumentResult{
@@ -578,7 +579,7 @@ func TestDOMGetNodeForLocation(t *testing.T) {
 	params := &dom.GetNodeForLocationParams{
 		X:                         1,
 		Y:                         1,
-		IncludeUserAgentShadowDOM: true,
+		IncludeUserAgentShadowDOM: optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().GetNodeForLocation(params)
 	mockResult := &dom.GetNodeForLocationResult{
@@ -620,9 +621,9 @@ func TestDOMGetOuterHTML(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.GetOuterHTMLParams{
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectID:      runtime.RemoteObjectID("remote-object-id"),
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectID:      optional.Of(runtime.RemoteObjectID("remote-object-id")),
 	}
 	resultChan := mockSocket.DOM().GetOuterHTML(params)
 	mockResult := &dom.GetOuterHTMLResult{
@@ -786,7 +787,7 @@ func TestDOMMoveTo(t *testing.T) {
 	params := &dom.MoveToParams{
 		NodeID:             dom.NodeID(1),
 		TargetNodeID:       dom.NodeID(2),
-		InsertBeforeNodeID: dom.NodeID(3),
+		InsertBeforeNodeID: optional.Of(dom.NodeID(3)),
 	}
 	resultChan := mockSocket.DOM().MoveTo(params)
 	mockResult := &dom.MoveToResult{
@@ -829,7 +830,7 @@ func TestDOMPerformSearch(t *testing.T) {
 
 	params := &dom.PerformSearchParams{
 		Query:                     "search query",
-		IncludeUserAgentShadowDOM: true,
+		IncludeUserAgentShadowDOM: optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().PerformSearch(params)
 	mockResult := &dom.PerformSearchResult{
@@ -1152,8 +1153,8 @@ func TestDOMRequestChildNodes(t *testing.T) {
 
 	params := &dom.RequestChildNodesParams{
 		NodeID: dom.NodeID(1),
-		Depth:  1,
-		Pierce: true,
+		Depth:  optional.Int(1),
+		Pierce: optional.Bool(true),
 	}
 	resultChan := mockSocket.DOM().RequestChildNodes(params)
 	mockResult := &dom.RequestChildNodesResult{}
@@ -1232,9 +1233,9 @@ func TestDOMResolveNode(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &dom.ResolveNodeParams{
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectGroup:   "object-group",
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectGroup:   optional.String("object-group"),
 	}
 	resultChan := mockSocket.DOM().ResolveNode(params)
 	mockResult := &dom.ResolveNodeResult{
@@ -1340,7 +1341,7 @@ func TestDOMSetAttributesAsText(t *testing.T) {
 	params := &dom.SetAttributesAsTextParams{
 		NodeID: dom.NodeID(1),
 		Text:   "some text",
-		Name:   "name",
+		Name:   optional.String("name"),
 	}
 	resultChan := mockSocket.DOM().SetAttributesAsText(params)
 	mockResult := &dom.SetAttributesAsTextResult{}
@@ -1378,9 +1379,9 @@ func TestDOMSetFileInputFiles(t *testing.T) {
 
 	params := &dom.SetFileInputFilesParams{
 		Files:         []string{"file1", "file2"},
-		NodeID:        dom.NodeID(1),
-		BackendNodeID: dom.BackendNodeID(1),
-		ObjectID:      runtime.RemoteObjectID("remote-object-id"),
+		NodeID:        optional.Of(dom.NodeID(1)),
+		BackendNodeID: optional.Of(dom.BackendNodeID(1)),
+		ObjectID:      optional.Of(runtime.RemoteObjectID("remote-object-id")),
 	}
 	resultChan := mockSocket.DOM().SetFileInputFiles(params)
 	mockResult := &dom.SetFileInputFilesResult{}
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/emulation"
@@ -8,27 +11,55 @@ import (
 
 /*
 EmulationProtocol provides a namespace for the Chrome Emulation protocol
-methods. The Emulation protocol emulates different environments for the page.
+methods.
+
+This domain emulates different environments for the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/
 */
 type EmulationProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *EmulationProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *EmulationProtocol) WithContext(ctx context.Context) *EmulationProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &EmulationProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-CanEmulate tells whether emulation is supported.
+Tells whether emulation is supported. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-canEmulate
 */
 func (protocol *EmulationProtocol) CanEmulate() <-chan *emulation.CanEmulateResult {
-	resultChan := make(chan *emulation.CanEmulateResult)
+	resultChan := make(chan *emulation.CanEmulateResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.canEmulate", nil)
 	result := &emulation.CanEmulateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -41,18 +72,20 @@ func (protocol *EmulationProtocol) CanEmulate() <-chan *emulation.CanEmulateResu
 }
 
 /*
-ClearDeviceMetricsOverride clears the overridden device metrics.
+Clears the overridden device metrics.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDeviceMetricsOverride
 */
 func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulation.ClearDeviceMetricsOverrideResult {
-	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult)
+	resultChan := make(chan *emulation.ClearDeviceMetricsOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.clearDeviceMetricsOverride", nil)
 	result := &emulation.ClearDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -63,18 +96,20 @@ func (protocol *EmulationProtocol) ClearDeviceMetricsOverride() <-chan *emulatio
 }
 
 /*
-ClearGeolocationOverride clears the overridden Geolocation Position and Error.
+Clears the overridden Geolocation Position and Error.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearGeolocationOverride
 */
 func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.ClearGeolocationOverrideResult {
-	resultChan := make(chan *emulation.ClearGeolocationOverrideResult)
+	resultChan := make(chan *emulation.ClearGeolocationOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.clearGeolocationOverride", nil)
 	result := &emulation.ClearGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -85,19 +120,46 @@ func (protocol *EmulationProtocol) ClearGeolocationOverride() <-chan *emulation.
 }
 
 /*
-ResetPageScaleFactor requests that page scale factor is reset to initial values.
+Requests that page scale factor is reset to initial values. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-resetPageScaleFactor
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) ResetPageScaleFactor() <-chan *emulation.ResetPageScaleFactorResult {
-	resultChan := make(chan *emulation.ResetPageScaleFactorResult)
+	resultChan := make(chan *emulation.ResetPageScaleFactorResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.resetPageScaleFactor", nil)
 	result := &emulation.ResetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables or disables simulating a focused and active page. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setFocusEmulationEnabled
+*/
+func (protocol *EmulationProtocol) SetFocusEmulationEnabled(
+	params *emulation.SetFocusEmulationEnabledParams,
+) <-chan *emulation.SetFocusEmulationEnabledResult {
+	resultChan := make(chan *emulation.SetFocusEmulationEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setFocusEmulationEnabled", params)
+	result := &emulation.SetFocusEmulationEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -108,21 +170,53 @@ func (protocol *EmulationProtocol) ResetPageScaleFactor() <-chan *emulation.Rese
 }
 
 /*
-SetCPUThrottlingRate enables CPU throttling to emulate slow CPUs.
+Automatically render all web contents using a dark theme.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutoDarkModeOverride
+*/
+func (protocol *EmulationProtocol) SetAutoDarkModeOverride(
+	params ...*emulation.SetAutoDarkModeOverrideParams,
+) <-chan *emulation.SetAutoDarkModeOverrideResult {
+	resultChan := make(chan *emulation.SetAutoDarkModeOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setAutoDarkModeOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setAutoDarkModeOverride", params[0])
+	}
+	result := &emulation.SetAutoDarkModeOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables CPU throttling to emulate slow CPUs.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setCPUThrottlingRate
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetCPUThrottlingRate(
 	params *emulation.SetCPUThrottlingRateParams,
 ) <-chan *emulation.SetCPUThrottlingRateResult {
-	resultChan := make(chan *emulation.SetCPUThrottlingRateResult)
+	resultChan := make(chan *emulation.SetCPUThrottlingRateResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setCPUThrottlingRate", params)
 	result := &emulation.SetCPUThrottlingRateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -133,22 +227,28 @@ func (protocol *EmulationProtocol) SetCPUThrottlingRate(
 }
 
 /*
-SetDefaultBackgroundColorOverride sets or clears an override of the default
-background color of the frame. This override is used if the content does not
-specify one.
+Sets or clears an override of the default background color of the frame. This
+override is used if the content does not specify one.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDefaultBackgroundColorOverride
 */
 func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
-	params *emulation.SetDefaultBackgroundColorOverrideParams,
+	params ...*emulation.SetDefaultBackgroundColorOverrideParams,
 ) <-chan *emulation.SetDefaultBackgroundColorOverrideResult {
-	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult)
-	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params)
+	resultChan := make(chan *emulation.SetDefaultBackgroundColorOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setDefaultBackgroundColorOverride", params[0])
+	}
 	result := &emulation.SetDefaultBackgroundColorOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -159,23 +259,52 @@ func (protocol *EmulationProtocol) SetDefaultBackgroundColorOverride(
 }
 
 /*
-SetDeviceMetricsOverride overrides the values of device screen dimensions
-(window.screen.width, window.screen.height, window.innerWidth,
-window.innerHeight, and "device-width"/"device-height" related CSS media query
-results).
+Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*).
+Unset values will cause the respective variables to be undefined, even if
+previously overridden. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSafeAreaInsetsOverride
+*/
+func (protocol *EmulationProtocol) SetSafeAreaInsetsOverride(
+	params *emulation.SetSafeAreaInsetsOverrideParams,
+) <-chan *emulation.SetSafeAreaInsetsOverrideResult {
+	resultChan := make(chan *emulation.SetSafeAreaInsetsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setSafeAreaInsetsOverride", params)
+	result := &emulation.SetSafeAreaInsetsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the values of device screen dimensions (window.screen.width,
+window.screen.height, window.innerWidth, window.innerHeight, and
+"device-width"/"device-height"-related CSS media query results).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDeviceMetricsOverride
 */
 func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
 	params *emulation.SetDeviceMetricsOverrideParams,
 ) <-chan *emulation.SetDeviceMetricsOverrideResult {
-	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult)
+	resultChan := make(chan *emulation.SetDeviceMetricsOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setDeviceMetricsOverride", params)
 	result := &emulation.SetDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -186,21 +315,183 @@ func (protocol *EmulationProtocol) SetDeviceMetricsOverride(
 }
 
 /*
-SetEmitTouchEventsForMouse enables touch events using a mouse.
+Start reporting the given posture value to the Device Posture API. This override
+can also be set in setDeviceMetricsOverride(). EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmitTouchEventsForMouse
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDevicePostureOverride
+*/
+func (protocol *EmulationProtocol) SetDevicePostureOverride(
+	params *emulation.SetDevicePostureOverrideParams,
+) <-chan *emulation.SetDevicePostureOverrideResult {
+	resultChan := make(chan *emulation.SetDevicePostureOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDevicePostureOverride", params)
+	result := &emulation.SetDevicePostureOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears a device posture override set with either setDeviceMetricsOverride() or
+setDevicePostureOverride() and starts using posture information from the
+platform again. Does nothing if no override is set. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDevicePostureOverride
+*/
+func (protocol *EmulationProtocol) ClearDevicePostureOverride() <-chan *emulation.ClearDevicePostureOverrideResult {
+	resultChan := make(chan *emulation.ClearDevicePostureOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearDevicePostureOverride", nil)
+	result := &emulation.ClearDevicePostureOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Start using the given display features to pupulate the Viewport Segments API.
+This override can also be set in setDeviceMetricsOverride(). EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisplayFeaturesOverride
+*/
+func (protocol *EmulationProtocol) SetDisplayFeaturesOverride(
+	params *emulation.SetDisplayFeaturesOverrideParams,
+) <-chan *emulation.SetDisplayFeaturesOverrideResult {
+	resultChan := make(chan *emulation.SetDisplayFeaturesOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDisplayFeaturesOverride", params)
+	result := &emulation.SetDisplayFeaturesOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears the display features override set with either setDeviceMetricsOverride()
+or setDisplayFeaturesOverride() and starts using display features from the
+platform again. Does nothing if no override is set. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearDisplayFeaturesOverride
+*/
+func (protocol *EmulationProtocol) ClearDisplayFeaturesOverride() <-chan *emulation.ClearDisplayFeaturesOverrideResult {
+	resultChan := make(chan *emulation.ClearDisplayFeaturesOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearDisplayFeaturesOverride", nil)
+	result := &emulation.ClearDisplayFeaturesOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetScrollbarsHidden sends the Emulation.setScrollbarsHidden command.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScrollbarsHidden
+*/
+func (protocol *EmulationProtocol) SetScrollbarsHidden(
+	params *emulation.SetScrollbarsHiddenParams,
+) <-chan *emulation.SetScrollbarsHiddenResult {
+	resultChan := make(chan *emulation.SetScrollbarsHiddenResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setScrollbarsHidden", params)
+	result := &emulation.SetScrollbarsHiddenResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetDocumentCookieDisabled sends the Emulation.setDocumentCookieDisabled command.
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDocumentCookieDisabled
+*/
+func (protocol *EmulationProtocol) SetDocumentCookieDisabled(
+	params *emulation.SetDocumentCookieDisabledParams,
+) <-chan *emulation.SetDocumentCookieDisabledResult {
+	resultChan := make(chan *emulation.SetDocumentCookieDisabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDocumentCookieDisabled", params)
+	result := &emulation.SetDocumentCookieDisabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetEmitTouchEventsForMouse sends the Emulation.setEmitTouchEventsForMouse
+command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmitTouchEventsForMouse
 */
 func (protocol *EmulationProtocol) SetEmitTouchEventsForMouse(
 	params *emulation.SetEmitTouchEventsForMouseParams,
 ) <-chan *emulation.SetEmitTouchEventsForMouseResult {
-	resultChan := make(chan *emulation.SetEmitTouchEventsForMouseResult)
+	resultChan := make(chan *emulation.SetEmitTouchEventsForMouseResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setEmitTouchEventsForMouse", params)
 	result := &emulation.SetEmitTouchEventsForMouseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -211,20 +502,27 @@ func (protocol *EmulationProtocol) SetEmitTouchEventsForMouse(
 }
 
 /*
-SetEmulatedMedia emulates the given media for CSS media queries.
+Emulates the given media type or media feature for CSS media queries.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedMedia
 */
 func (protocol *EmulationProtocol) SetEmulatedMedia(
-	params *emulation.SetEmulatedMediaParams,
+	params ...*emulation.SetEmulatedMediaParams,
 ) <-chan *emulation.SetEmulatedMediaResult {
-	resultChan := make(chan *emulation.SetEmulatedMediaResult)
-	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params)
+	resultChan := make(chan *emulation.SetEmulatedMediaResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setEmulatedMedia", params[0])
+	}
 	result := &emulation.SetEmulatedMediaResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -235,21 +533,171 @@ func (protocol *EmulationProtocol) SetEmulatedMedia(
 }
 
 /*
-SetGeolocationOverride overrides the Geolocation Position or Error. Omitting any
-of the parameters emulates position unavailable.
+Emulates the given vision deficiency.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedVisionDeficiency
+*/
+func (protocol *EmulationProtocol) SetEmulatedVisionDeficiency(
+	params *emulation.SetEmulatedVisionDeficiencyParams,
+) <-chan *emulation.SetEmulatedVisionDeficiencyResult {
+	resultChan := make(chan *emulation.SetEmulatedVisionDeficiencyResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedVisionDeficiency", params)
+	result := &emulation.SetEmulatedVisionDeficiencyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Emulates the given OS text scale.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setEmulatedOSTextScale
+*/
+func (protocol *EmulationProtocol) SetEmulatedOSTextScale(
+	params ...*emulation.SetEmulatedOSTextScaleParams,
+) <-chan *emulation.SetEmulatedOSTextScaleResult {
+	resultChan := make(chan *emulation.SetEmulatedOSTextScaleResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setEmulatedOSTextScale", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setEmulatedOSTextScale", params[0])
+	}
+	result := &emulation.SetEmulatedOSTextScaleResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the Geolocation Position or Error. Omitting latitude, longitude or
+accuracy emulates position unavailable.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setGeolocationOverride
 */
 func (protocol *EmulationProtocol) SetGeolocationOverride(
-	params *emulation.SetGeolocationOverrideParams,
+	params ...*emulation.SetGeolocationOverrideParams,
 ) <-chan *emulation.SetGeolocationOverrideResult {
-	resultChan := make(chan *emulation.SetGeolocationOverrideResult)
-	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params)
+	resultChan := make(chan *emulation.SetGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setGeolocationOverride", params[0])
+	}
 	result := &emulation.SetGeolocationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetOverriddenSensorInformation sends the
+Emulation.getOverriddenSensorInformation command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-getOverriddenSensorInformation
+*/
+func (protocol *EmulationProtocol) GetOverriddenSensorInformation(
+	params *emulation.GetOverriddenSensorInformationParams,
+) <-chan *emulation.GetOverriddenSensorInformationResult {
+	resultChan := make(chan *emulation.GetOverriddenSensorInformationResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.getOverriddenSensorInformation", params)
+	result := &emulation.GetOverriddenSensorInformationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides a platform sensor of a given type. If |enabled| is true, calls to
+Sensor.start() will use a virtual sensor as backend rather than fetching data
+from a real hardware sensor. Otherwise, existing virtual sensor-backend Sensor
+objects will fire an error event and new calls to Sensor.start() will attempt to
+use a real sensor instead. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideEnabled
+*/
+func (protocol *EmulationProtocol) SetSensorOverrideEnabled(
+	params *emulation.SetSensorOverrideEnabledParams,
+) <-chan *emulation.SetSensorOverrideEnabledResult {
+	resultChan := make(chan *emulation.SetSensorOverrideEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setSensorOverrideEnabled", params)
+	result := &emulation.SetSensorOverrideEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Updates the sensor readings reported by a sensor type previously overridden by
+setSensorOverrideEnabled. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSensorOverrideReadings
+*/
+func (protocol *EmulationProtocol) SetSensorOverrideReadings(
+	params *emulation.SetSensorOverrideReadingsParams,
+) <-chan *emulation.SetSensorOverrideReadingsResult {
+	resultChan := make(chan *emulation.SetSensorOverrideReadingsResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setSensorOverrideReadings", params)
+	result := &emulation.SetSensorOverrideReadingsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -260,22 +708,159 @@ func (protocol *EmulationProtocol) SetGeolocationOverride(
 }
 
 /*
-SetNavigatorOverrides overrides value returned by the javascript navigator
-object.
+Overrides a pressure source of a given type, as used by the Compute Pressure
+API, so that updates to PressureObserver.observe() are provided via
+setPressureStateOverride instead of being retrieved from platform-provided
+telemetry data. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureSourceOverrideEnabled
+*/
+func (protocol *EmulationProtocol) SetPressureSourceOverrideEnabled(
+	params *emulation.SetPressureSourceOverrideEnabledParams,
+) <-chan *emulation.SetPressureSourceOverrideEnabledResult {
+	resultChan := make(chan *emulation.SetPressureSourceOverrideEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setPressureSourceOverrideEnabled", params)
+	result := &emulation.SetPressureSourceOverrideEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+TODO: OBSOLETE: To remove when setPressureDataOverride is merged. Provides a
+given pressure state that will be processed and eventually be delivered to
+PressureObserver users. |source| must have been previously overridden by
+setPressureSourceOverrideEnabled. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureStateOverride
+*/
+func (protocol *EmulationProtocol) SetPressureStateOverride(
+	params *emulation.SetPressureStateOverrideParams,
+) <-chan *emulation.SetPressureStateOverrideResult {
+	resultChan := make(chan *emulation.SetPressureStateOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setPressureStateOverride", params)
+	result := &emulation.SetPressureStateOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Provides a given pressure data set that will be processed and eventually be
+delivered to PressureObserver users. |source| must have been previously
+overridden by setPressureSourceOverrideEnabled. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPressureDataOverride
+*/
+func (protocol *EmulationProtocol) SetPressureDataOverride(
+	params *emulation.SetPressureDataOverrideParams,
+) <-chan *emulation.SetPressureDataOverrideResult {
+	resultChan := make(chan *emulation.SetPressureDataOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setPressureDataOverride", params)
+	result := &emulation.SetPressureDataOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the Idle state.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setIdleOverride
+*/
+func (protocol *EmulationProtocol) SetIdleOverride(
+	params *emulation.SetIdleOverrideParams,
+) <-chan *emulation.SetIdleOverrideResult {
+	resultChan := make(chan *emulation.SetIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setIdleOverride", params)
+	result := &emulation.SetIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears Idle state overrides.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-clearIdleOverride
+*/
+func (protocol *EmulationProtocol) ClearIdleOverride() <-chan *emulation.ClearIdleOverrideResult {
+	resultChan := make(chan *emulation.ClearIdleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.clearIdleOverride", nil)
+	result := &emulation.ClearIdleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides value returned by the javascript navigator object. EXPERIMENTAL.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setNavigatorOverrides
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetNavigatorOverrides(
 	params *emulation.SetNavigatorOverridesParams,
 ) <-chan *emulation.SetNavigatorOverridesResult {
-	resultChan := make(chan *emulation.SetNavigatorOverridesResult)
+	resultChan := make(chan *emulation.SetNavigatorOverridesResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setNavigatorOverrides", params)
 	result := &emulation.SetNavigatorOverridesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -286,21 +871,22 @@ func (protocol *EmulationProtocol) SetNavigatorOverrides(
 }
 
 /*
-SetPageScaleFactor sets a specified page scale factor.
+Sets a specified page scale factor. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setPageScaleFactor
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetPageScaleFactor(
 	params *emulation.SetPageScaleFactorParams,
 ) <-chan *emulation.SetPageScaleFactorResult {
-	resultChan := make(chan *emulation.SetPageScaleFactorResult)
+	resultChan := make(chan *emulation.SetPageScaleFactorResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setPageScaleFactor", params)
 	result := &emulation.SetPageScaleFactorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -311,20 +897,22 @@ func (protocol *EmulationProtocol) SetPageScaleFactor(
 }
 
 /*
-SetScriptExecutionDisabled switches script execution in the page.
+Switches script execution in the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setScriptExecutionDisabled
 */
 func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
 	params *emulation.SetScriptExecutionDisabledParams,
 ) <-chan *emulation.SetScriptExecutionDisabledResult {
-	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult)
+	resultChan := make(chan *emulation.SetScriptExecutionDisabledResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setScriptExecutionDisabled", params)
 	result := &emulation.SetScriptExecutionDisabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -335,20 +923,22 @@ func (protocol *EmulationProtocol) SetScriptExecutionDisabled(
 }
 
 /*
-SetTouchEmulationEnabled enables touch on platforms which do not support it.
+Enables touch on platforms which do not support them.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTouchEmulationEnabled
 */
 func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
 	params *emulation.SetTouchEmulationEnabledParams,
 ) <-chan *emulation.SetTouchEmulationEnabledResult {
-	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult)
+	resultChan := make(chan *emulation.SetTouchEmulationEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setTouchEmulationEnabled", params)
 	result := &emulation.SetTouchEmulationEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -359,23 +949,24 @@ func (protocol *EmulationProtocol) SetTouchEmulationEnabled(
 }
 
 /*
-SetVirtualTimePolicy turns on virtual time for all frames (replacing real-time
-with a synthetic time source) and sets the current virtual time policy. Note
-this supersedes any previous time budget.
+Turns on virtual time for all frames (replacing real-time with a synthetic time
+source) and sets the current virtual time policy. Note this supersedes any
+previous time budget. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVirtualTimePolicy
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) SetVirtualTimePolicy(
 	params *emulation.SetVirtualTimePolicyParams,
 ) <-chan *emulation.SetVirtualTimePolicyResult {
-	resultChan := make(chan *emulation.SetVirtualTimePolicyResult)
-	command := NewCommand(protocol.Socket, "Emulation.SetVirtualTimePolicy", nil)
+	resultChan := make(chan *emulation.SetVirtualTimePolicyResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setVirtualTimePolicy", params)
 	result := &emulation.SetVirtualTimePolicyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -388,23 +979,81 @@ func (protocol *EmulationProtocol) SetVirtualTimePolicy(
 }
 
 /*
-SetVisibleSize resizes the frame/viewport of the page. Note that this does not
-affect the frame's container (e.g. browser window). Can be used to produce
-screenshots of the specified size. Not supported on Android.
+Overrides default host system locale with the specified one.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setLocaleOverride
+*/
+func (protocol *EmulationProtocol) SetLocaleOverride(
+	params ...*emulation.SetLocaleOverrideParams,
+) <-chan *emulation.SetLocaleOverrideResult {
+	resultChan := make(chan *emulation.SetLocaleOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setLocaleOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setLocaleOverride", params[0])
+	}
+	result := &emulation.SetLocaleOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides default host system timezone with the specified one.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setTimezoneOverride
+*/
+func (protocol *EmulationProtocol) SetTimezoneOverride(
+	params *emulation.SetTimezoneOverrideParams,
+) <-chan *emulation.SetTimezoneOverrideResult {
+	resultChan := make(chan *emulation.SetTimezoneOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setTimezoneOverride", params)
+	result := &emulation.SetTimezoneOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resizes the frame/viewport of the page. Note that this does not affect the
+frame's container (e.g. browser window). Can be used to produce screenshots of
+the specified size. Not supported on Android. EXPERIMENTAL. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setVisibleSize
-EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *EmulationProtocol) SetVisibleSize(
 	params *emulation.SetVisibleSizeParams,
 ) <-chan *emulation.SetVisibleSizeResult {
-	resultChan := make(chan *emulation.SetVisibleSizeResult)
+	resultChan := make(chan *emulation.SetVisibleSizeResult, 1)
 	command := NewCommand(protocol.Socket, "Emulation.setVisibleSize", params)
 	result := &emulation.SetVisibleSizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -415,19 +1064,187 @@ func (protocol *EmulationProtocol) SetVisibleSize(
 }
 
 /*
-OnVirtualTimeAdvanced adds a handler to the Emulation.virtualTimeAdvanced event.
-Emulation.virtualTimeAdvanced fires after the virtual time has advanced.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeAdvanced
+SetDisabledImageTypes sends the Emulation.setDisabledImageTypes command.
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDisabledImageTypes
 */
-func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
-	callback func(event *emulation.VirtualTimeAdvancedEvent),
-) {
+func (protocol *EmulationProtocol) SetDisabledImageTypes(
+	params *emulation.SetDisabledImageTypesParams,
+) <-chan *emulation.SetDisabledImageTypesResult {
+	resultChan := make(chan *emulation.SetDisabledImageTypesResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDisabledImageTypes", params)
+	result := &emulation.SetDisabledImageTypesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Override the value of navigator.connection.saveData
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setDataSaverOverride
+*/
+func (protocol *EmulationProtocol) SetDataSaverOverride(
+	params ...*emulation.SetDataSaverOverrideParams,
+) <-chan *emulation.SetDataSaverOverrideResult {
+	resultChan := make(chan *emulation.SetDataSaverOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setDataSaverOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Emulation.setDataSaverOverride", params[0])
+	}
+	result := &emulation.SetDataSaverOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetHardwareConcurrencyOverride sends the
+Emulation.setHardwareConcurrencyOverride command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setHardwareConcurrencyOverride
+*/
+func (protocol *EmulationProtocol) SetHardwareConcurrencyOverride(
+	params *emulation.SetHardwareConcurrencyOverrideParams,
+) <-chan *emulation.SetHardwareConcurrencyOverrideResult {
+	resultChan := make(chan *emulation.SetHardwareConcurrencyOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setHardwareConcurrencyOverride", params)
+	result := &emulation.SetHardwareConcurrencyOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows overriding user agent with the given string. `userAgentMetadata` must be
+set for Client Hint headers to be sent.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setUserAgentOverride
+*/
+func (protocol *EmulationProtocol) SetUserAgentOverride(
+	params *emulation.SetUserAgentOverrideParams,
+) <-chan *emulation.SetUserAgentOverrideResult {
+	resultChan := make(chan *emulation.SetUserAgentOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setUserAgentOverride", params)
+	result := &emulation.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows overriding the automation flag. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setAutomationOverride
+*/
+func (protocol *EmulationProtocol) SetAutomationOverride(
+	params *emulation.SetAutomationOverrideParams,
+) <-chan *emulation.SetAutomationOverrideResult {
+	resultChan := make(chan *emulation.SetAutomationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setAutomationOverride", params)
+	result := &emulation.SetAutomationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows overriding the difference between the small and large viewport sizes,
+which determine the value of the `svh` and `lvh` unit, respectively. Only
+supported for top-level frames. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#method-setSmallViewportHeightDifferenceOverride
+*/
+func (protocol *EmulationProtocol) SetSmallViewportHeightDifferenceOverride(
+	params *emulation.SetSmallViewportHeightDifferenceOverrideParams,
+) <-chan *emulation.SetSmallViewportHeightDifferenceOverrideResult {
+	resultChan := make(chan *emulation.SetSmallViewportHeightDifferenceOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Emulation.setSmallViewportHeightDifferenceOverride", params)
+	result := &emulation.SetSmallViewportHeightDifferenceOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnVirtualTimeBudgetExpired adds a handler to the
+Emulation.virtualTimeBudgetExpired event.
+
+Notification sent after the virtual time budget for the current
+VirtualTimePolicy has run out. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeBudgetExpired
+*/
+func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
+	callback func(event *emulation.VirtualTimeBudgetExpiredEvent),
+) *Listener {
 	handler := NewEventHandler(
-		"Emulation.virtualTimeAdvanced",
+		"Emulation.virtualTimeBudgetExpired",
 		func(response *Response) {
-			event := &emulation.VirtualTimeAdvancedEvent{}
+			event := &emulation.VirtualTimeBudgetExpiredEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -436,23 +1253,24 @@ func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnVirtualTimeBudgetExpired adds a handler to the Emulation.virtualTimeBudgetExpired
-event. Emulation.virtualTimeBudgetExpired fires after the virtual time budget
-for the current VirtualTimePolicy has run out.
+OnVirtualTimeAdvanced adds a handler to the Emulation.virtualTimeAdvanced event.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeBudgetExpired
-EXPERIMENTAL.
+Emulation.virtualTimeAdvanced fires after the virtual time has advanced.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimeAdvanced
 */
-func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
-	callback func(event *emulation.VirtualTimeBudgetExpiredEvent),
-) {
+func (protocol *EmulationProtocol) OnVirtualTimeAdvanced(
+	callback func(event *emulation.VirtualTimeAdvancedEvent),
+) *Listener {
 	handler := NewEventHandler(
-		"Emulation.virtualTimeBudgetExpired",
+		"Emulation.virtualTimeAdvanced",
 		func(response *Response) {
-			event := &emulation.VirtualTimeBudgetExpiredEvent{}
+			event := &emulation.VirtualTimeAdvancedEvent{}
 			json.Unmarshal([]byte(response.Params), event)
 			if nil != response.Error && 0 != response.Error.Code {
 				event.Err = response.Error
@@ -461,18 +1279,19 @@ func (protocol *EmulationProtocol) OnVirtualTimeBudgetExpired(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnVirtualTimePaused adds a handler to the Emulation.virtualTimePaused event.
-Emulation.virtualTimePaused fires after the virtual time has paused.
+
+Emulation.virtualTimePaused fires after the virtual time has paused. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Emulation/#event-virtualTimePaused
-EXPERIMENTAL.
 */
 func (protocol *EmulationProtocol) OnVirtualTimePaused(
 	callback func(event *emulation.VirtualTimePausedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Emulation.virtualTimePaused",
 		func(response *Response) {
@@ -485,4 +1304,5 @@ func (protocol *EmulationProtocol) OnVirtualTimePaused(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
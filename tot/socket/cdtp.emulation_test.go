@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/emulation"
 	"github.com/mkenney/go-chrome/tot/page"
@@ -243,12 +244,12 @@ func TestEmulationSetDeviceMetricsOverride(t *testing.T) {
 		Height:             1,
 		DeviceScaleFactor:  1,
 		Mobile:             true,
-		Scale:              1,
-		ScreenWidth:        1,
-		ScreenHeight:       1,
-		PositionX:          1,
-		PositionY:          1,
-		DontSetVisibleSize: true,
+		Scale:              optional.Float64(1),
+		ScreenWidth:        optional.Int(1),
+		ScreenHeight:       optional.Int(1),
+		PositionX:          optional.Int(1),
+		PositionY:          optional.Int(1),
+		DontSetVisibleSize: optional.Bool(true),
 		ScreenOrientation: &emulation.ScreenOrientation{
 			Type:  emulation.OrientationType.PortraitPrimary,
 			Angle: 1,
@@ -334,7 +335,7 @@ func TestEmulationSetEmulatedMedia(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &emulation.SetEmulatedMediaParams{
-		Media: "media",
+		Media: optional.String("media"),
 	}
 	resultChan := mockSocket.Emulation().SetEmulatedMedia(params)
 	mockResult := &emulation.SetEmulatedMediaResult{}
@@ -371,9 +372,9 @@ func TestEmulationSetGeolocationOverride(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &emulation.SetGeolocationOverrideParams{
-		Latitude:  1,
-		Longitude: 1,
-		Accuracy:  1,
+		Latitude:  optional.Float64(1),
+		Longitude: optional.Float64(1),
+		Accuracy:  optional.Float64(1),
 	}
 	resultChan := mockSocket.Emulation().SetGeolocationOverride(params)
 	mockResult := &emulation.SetGeolocationOverrideResult{}
@@ -522,7 +523,7 @@ func TestEmulationSetTouchEmulationEnabled(t *testing.T) {
 
 	params := &emulation.SetTouchEmulationEnabledParams{
 		Enabled:        true,
-		MaxTouchPoints: 1,
+		MaxTouchPoints: optional.Int(1),
 	}
 	resultChan := mockSocket.Emulation().SetTouchEmulationEnabled(params)
 	mockResult := &emulation.SetTouchEmulationEnabledResult{}
@@ -560,8 +561,8 @@ func TestEmulationSetVirtualTimePolicy(t *testing.T) {
 
 	params := &emulation.SetVirtualTimePolicyParams{
 		Policy:                            emulation.VirtualTimePolicy("policy"),
-		Budget:                            1,
-		MaxVirtualTimeTaskStarvationCount: 1,
+		Budget:                            optional.Float64(1),
+		MaxVirtualTimeTaskStarvationCount: optional.Int(1),
 	}
 	resultChan := mockSocket.Emulation().SetVirtualTimePolicy(params)
 	mockResult := &emulation.SetVirtualTimePolicyResult{
@@ -0,0 +1,124 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+
+	"github.com/mkenney/go-chrome/tot/event/breakpoints"
+)
+
+/*
+EventBreakpointsProtocol provides a namespace for the Chrome EventBreakpoints
+protocol methods.
+
+EventBreakpoints permits setting JavaScript breakpoints on operations and events
+occurring in native code invoked from JavaScript. Once breakpoint is hit, it is
+reported through Debugger domain, similarly to regular breakpoints being hit.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/
+*/
+type EventBreakpointsProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *EventBreakpointsProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *EventBreakpointsProtocol) WithContext(ctx context.Context) *EventBreakpointsProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &EventBreakpointsProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Sets breakpoint on particular native event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-setInstrumentationBreakpoint
+*/
+func (protocol *EventBreakpointsProtocol) SetInstrumentationBreakpoint(
+	params *breakpoints.SetInstrumentationBreakpointParams,
+) <-chan *breakpoints.SetInstrumentationBreakpointResult {
+	resultChan := make(chan *breakpoints.SetInstrumentationBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "EventBreakpoints.setInstrumentationBreakpoint", params)
+	result := &breakpoints.SetInstrumentationBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes breakpoint on particular native event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-removeInstrumentationBreakpoint
+*/
+func (protocol *EventBreakpointsProtocol) RemoveInstrumentationBreakpoint(
+	params *breakpoints.RemoveInstrumentationBreakpointParams,
+) <-chan *breakpoints.RemoveInstrumentationBreakpointResult {
+	resultChan := make(chan *breakpoints.RemoveInstrumentationBreakpointResult, 1)
+	command := NewCommand(protocol.Socket, "EventBreakpoints.removeInstrumentationBreakpoint", params)
+	result := &breakpoints.RemoveInstrumentationBreakpointResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes all breakpoints
+
+https://chromedevtools.github.io/devtools-protocol/tot/EventBreakpoints/#method-disable
+*/
+func (protocol *EventBreakpointsProtocol) Disable() <-chan *breakpoints.DisableResult {
+	resultChan := make(chan *breakpoints.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "EventBreakpoints.disable", nil)
+	result := &breakpoints.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,60 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/event/breakpoints"
+)
+
+func TestEventBreakpointsCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEventBreakpointsCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"EventBreakpoints.setInstrumentationBreakpoint", func() func() error {
+			resultChan := mockSocket.EventBreakpoints().SetInstrumentationBreakpoint(&breakpoints.SetInstrumentationBreakpointParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"EventBreakpoints.removeInstrumentationBreakpoint", func() func() error {
+			resultChan := mockSocket.EventBreakpoints().RemoveInstrumentationBreakpoint(&breakpoints.RemoveInstrumentationBreakpointParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"EventBreakpoints.disable", func() func() error {
+			resultChan := mockSocket.EventBreakpoints().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
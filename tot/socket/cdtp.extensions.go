@@ -0,0 +1,213 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/extensions"
+)
+
+/*
+ExtensionsProtocol provides a namespace for the Chrome Extensions protocol
+methods.
+
+Defines commands and events for browser extensions. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/
+*/
+type ExtensionsProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ExtensionsProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ExtensionsProtocol) WithContext(ctx context.Context) *ExtensionsProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ExtensionsProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Installs an unpacked extension from the filesystem similar to --load-extension
+CLI flags. Returns extension ID once the extension has been installed. Available
+if the client is connected using the --remote-debugging-pipe flag and the
+--enable-unsafe-extension-debugging flag is set.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-loadUnpacked
+*/
+func (protocol *ExtensionsProtocol) LoadUnpacked(
+	params *extensions.LoadUnpackedParams,
+) <-chan *extensions.LoadUnpackedResult {
+	resultChan := make(chan *extensions.LoadUnpackedResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.loadUnpacked", params)
+	result := &extensions.LoadUnpackedResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Uninstalls an unpacked extension (others not supported) from the profile.
+Available if the client is connected using the --remote-debugging-pipe flag and
+the --enable-unsafe-extension-debugging.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-uninstall
+*/
+func (protocol *ExtensionsProtocol) Uninstall(
+	params *extensions.UninstallParams,
+) <-chan *extensions.UninstallResult {
+	resultChan := make(chan *extensions.UninstallResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.uninstall", params)
+	result := &extensions.UninstallResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets data from extension storage in the given `storageArea`. If `keys` is
+specified, these are used to filter the result.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-getStorageItems
+*/
+func (protocol *ExtensionsProtocol) GetStorageItems(
+	params *extensions.GetStorageItemsParams,
+) <-chan *extensions.GetStorageItemsResult {
+	resultChan := make(chan *extensions.GetStorageItemsResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.getStorageItems", params)
+	result := &extensions.GetStorageItemsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes `keys` from extension storage in the given `storageArea`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-removeStorageItems
+*/
+func (protocol *ExtensionsProtocol) RemoveStorageItems(
+	params *extensions.RemoveStorageItemsParams,
+) <-chan *extensions.RemoveStorageItemsResult {
+	resultChan := make(chan *extensions.RemoveStorageItemsResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.removeStorageItems", params)
+	result := &extensions.RemoveStorageItemsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears extension storage in the given `storageArea`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-clearStorageItems
+*/
+func (protocol *ExtensionsProtocol) ClearStorageItems(
+	params *extensions.ClearStorageItemsParams,
+) <-chan *extensions.ClearStorageItemsResult {
+	resultChan := make(chan *extensions.ClearStorageItemsResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.clearStorageItems", params)
+	result := &extensions.ClearStorageItemsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets `values` in extension storage in the given `storageArea`. The provided
+`values` will be merged with existing values in the storage area.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Extensions/#method-setStorageItems
+*/
+func (protocol *ExtensionsProtocol) SetStorageItems(
+	params *extensions.SetStorageItemsParams,
+) <-chan *extensions.SetStorageItemsResult {
+	resultChan := make(chan *extensions.SetStorageItemsResult, 1)
+	command := NewCommand(protocol.Socket, "Extensions.setStorageItems", params)
+	result := &extensions.SetStorageItemsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
@@ -0,0 +1,72 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/extensions"
+)
+
+func TestExtensionsCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestExtensionsCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Extensions.loadUnpacked", func() func() error {
+			resultChan := mockSocket.Extensions().LoadUnpacked(&extensions.LoadUnpackedParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Extensions.uninstall", func() func() error {
+			resultChan := mockSocket.Extensions().Uninstall(&extensions.UninstallParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Extensions.getStorageItems", func() func() error {
+			resultChan := mockSocket.Extensions().GetStorageItems(&extensions.GetStorageItemsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Extensions.removeStorageItems", func() func() error {
+			resultChan := mockSocket.Extensions().RemoveStorageItems(&extensions.RemoveStorageItemsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Extensions.clearStorageItems", func() func() error {
+			resultChan := mockSocket.Extensions().ClearStorageItems(&extensions.ClearStorageItemsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Extensions.setStorageItems", func() func() error {
+			resultChan := mockSocket.Extensions().SetStorageItems(&extensions.SetStorageItemsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
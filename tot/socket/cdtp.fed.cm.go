@@ -0,0 +1,278 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/fed/cm"
+)
+
+/*
+FedCmProtocol provides a namespace for the Chrome FedCm protocol methods.
+
+This domain allows interacting with the FedCM dialog. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/
+*/
+type FedCmProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *FedCmProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *FedCmProtocol) WithContext(ctx context.Context) *FedCmProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &FedCmProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enable sends the FedCm.enable command.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-enable
+*/
+func (protocol *FedCmProtocol) Enable(
+	params ...*cm.EnableParams,
+) <-chan *cm.EnableResult {
+	resultChan := make(chan *cm.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "FedCm.enable", params[0])
+	}
+	result := &cm.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable sends the FedCm.disable command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-disable
+*/
+func (protocol *FedCmProtocol) Disable() <-chan *cm.DisableResult {
+	resultChan := make(chan *cm.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.disable", nil)
+	result := &cm.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SelectAccount sends the FedCm.selectAccount command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-selectAccount
+*/
+func (protocol *FedCmProtocol) SelectAccount(
+	params *cm.SelectAccountParams,
+) <-chan *cm.SelectAccountResult {
+	resultChan := make(chan *cm.SelectAccountResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.selectAccount", params)
+	result := &cm.SelectAccountResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+ClickDialogButton sends the FedCm.clickDialogButton command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-clickDialogButton
+*/
+func (protocol *FedCmProtocol) ClickDialogButton(
+	params *cm.ClickDialogButtonParams,
+) <-chan *cm.ClickDialogButtonResult {
+	resultChan := make(chan *cm.ClickDialogButtonResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.clickDialogButton", params)
+	result := &cm.ClickDialogButtonResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OpenURL sends the FedCm.openUrl command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-openUrl
+*/
+func (protocol *FedCmProtocol) OpenURL(
+	params *cm.OpenURLParams,
+) <-chan *cm.OpenURLResult {
+	resultChan := make(chan *cm.OpenURLResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.openUrl", params)
+	result := &cm.OpenURLResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+DismissDialog sends the FedCm.dismissDialog command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-dismissDialog
+*/
+func (protocol *FedCmProtocol) DismissDialog(
+	params *cm.DismissDialogParams,
+) <-chan *cm.DismissDialogResult {
+	resultChan := make(chan *cm.DismissDialogResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.dismissDialog", params)
+	result := &cm.DismissDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resets the cooldown time, if any, to allow the next FedCM call to show a dialog
+even if one was recently dismissed by the user.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#method-resetCooldown
+*/
+func (protocol *FedCmProtocol) ResetCooldown() <-chan *cm.ResetCooldownResult {
+	resultChan := make(chan *cm.ResetCooldownResult, 1)
+	command := NewCommand(protocol.Socket, "FedCm.resetCooldown", nil)
+	result := &cm.ResetCooldownResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDialogShown adds a handler to the FedCm.dialogShown event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#event-dialogShown
+*/
+func (protocol *FedCmProtocol) OnDialogShown(
+	callback func(event *cm.DialogShownEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"FedCm.dialogShown",
+		func(response *Response) {
+			event := &cm.DialogShownEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDialogClosed adds a handler to the FedCm.dialogClosed event.
+
+Triggered when a dialog is closed, either by user action, JS abort, or a command
+below.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FedCm/#event-dialogClosed
+*/
+func (protocol *FedCmProtocol) OnDialogClosed(
+	callback func(event *cm.DialogClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"FedCm.dialogClosed",
+		func(response *Response) {
+			event := &cm.DialogClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,74 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/file/system"
+)
+
+/*
+FileSystemProtocol provides a namespace for the Chrome FileSystem protocol
+methods.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/
+*/
+type FileSystemProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *FileSystemProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *FileSystemProtocol) WithContext(ctx context.Context) *FileSystemProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &FileSystemProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+GetDirectory sends the FileSystem.getDirectory command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/FileSystem/#method-getDirectory
+*/
+func (protocol *FileSystemProtocol) GetDirectory(
+	params *system.GetDirectoryParams,
+) <-chan *system.GetDirectoryResult {
+	resultChan := make(chan *system.GetDirectoryResult, 1)
+	command := NewCommand(protocol.Socket, "FileSystem.getDirectory", params)
+	result := &system.GetDirectoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
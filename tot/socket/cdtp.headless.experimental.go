@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/headless/experimental"
@@ -8,32 +11,67 @@ import (
 
 /*
 HeadlessExperimentalProtocol provides a namespace for the Chrome
-HeadlessExperimental protocol methods. The HeadlessExperimental protocol
-provides experimental commands only supported in headless mode.
+HeadlessExperimental protocol methods.
+
+This domain provides experimental commands only supported in headless mode.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/
 */
 type HeadlessExperimentalProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-BeginFrame sends a BeginFrame to the target and returns when the frame was
-completed. Optionally captures a screenshot from the resulting frame. Requires
-that the target was created with enabled BeginFrameControl.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *HeadlessExperimentalProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *HeadlessExperimentalProtocol) WithContext(ctx context.Context) *HeadlessExperimentalProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &HeadlessExperimentalProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Sends a BeginFrame to the target and returns when the frame was completed.
+Optionally captures a screenshot from the resulting frame. Requires that the
+target was created with enabled BeginFrameControl. Designed for use with
+--run-all-compositor-stages-before-draw, see also
+https://goo.gle/chrome-headless-rendering for more background.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-beginFrame
 */
 func (protocol *HeadlessExperimentalProtocol) BeginFrame(
-	params *experimental.BeginFrameParams,
+	params ...*experimental.BeginFrameParams,
 ) <-chan *experimental.BeginFrameResult {
-	resultChan := make(chan *experimental.BeginFrameResult)
-	command := NewCommand(protocol.Socket, "HeadlessExperimental.beginFrame", params)
+	resultChan := make(chan *experimental.BeginFrameResult, 1)
+	command := NewCommand(protocol.Socket, "HeadlessExperimental.beginFrame", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "HeadlessExperimental.beginFrame", params[0])
+	}
 	result := &experimental.BeginFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -46,18 +84,20 @@ func (protocol *HeadlessExperimentalProtocol) BeginFrame(
 }
 
 /*
-Disable disables headless events for the target.
+Disables headless events for the target. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-disable
 */
 func (protocol *HeadlessExperimentalProtocol) Disable() <-chan *experimental.DisableResult {
-	resultChan := make(chan *experimental.DisableResult)
+	resultChan := make(chan *experimental.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.disable", nil)
 	result := &experimental.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -68,18 +108,20 @@ func (protocol *HeadlessExperimentalProtocol) Disable() <-chan *experimental.Dis
 }
 
 /*
-Enable enables headless events for the target.
+Enables headless events for the target. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#method-enable
 */
 func (protocol *HeadlessExperimentalProtocol) Enable() <-chan *experimental.EnableResult {
-	resultChan := make(chan *experimental.EnableResult)
+	resultChan := make(chan *experimental.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "HeadlessExperimental.enable", nil)
 	result := &experimental.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -90,16 +132,18 @@ func (protocol *HeadlessExperimentalProtocol) Enable() <-chan *experimental.Enab
 }
 
 /*
-OnMainFrameReadyForScreenshots adds a handler to the HeadlessExperimental.mainFrameReadyForScreenshots
-event. HeadlessExperimental.mainFrameReadyForScreenshots fires when the main
-frame has first submitted a frame to the browser. May only be fired while a
-BeginFrame is in flight. Before this event, screenshotting requests may fail.
+OnMainFrameReadyForScreenshots adds a handler to the
+HeadlessExperimental.mainFrameReadyForScreenshots event.
+
+Fired when the main frame has first submitted a frame to the browser. May only
+be fired while a BeginFrame is in flight. Before this event, screenshotting
+requests may fail. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#event-mainFrameReadyForScreenshots
 */
 func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 	callback func(event *experimental.MainFrameReadyForScreenshotsEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeadlessExperimental.mainFrameReadyForScreenshots",
 		func(response *Response) {
@@ -112,18 +156,20 @@ func (protocol *HeadlessExperimentalProtocol) OnMainFrameReadyForScreenshots(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnNeedsBeginFramesChanged adds a handler to the HeadlessExperimental.needsBeginFramesChanged
-event. HeadlessExperimental.needsBeginFramesChanged fires when the target starts
-or stops needing BeginFrames.
+OnNeedsBeginFramesChanged adds a handler to the
+HeadlessExperimental.needsBeginFramesChanged event.
+
+Fired when the target starts or stops needing BeginFrames. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeadlessExperimental/#event-needsBeginFramesChanged
 */
 func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 	callback func(event *experimental.NeedsBeginFramesChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeadlessExperimental.needsBeginFramesChanged",
 		func(response *Response) {
@@ -136,4 +182,5 @@ func (protocol *HeadlessExperimentalProtocol) OnNeedsBeginFramesChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -0,0 +1,112 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/headless/experimental"
+)
+
+func TestHeadlessExperimentalCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestHeadlessExperimentalCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"HeadlessExperimental.beginFrame", func() func() error {
+			resultChan := mockSocket.HeadlessExperimental().BeginFrame(&experimental.BeginFrameParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"HeadlessExperimental.disable", func() func() error {
+			resultChan := mockSocket.HeadlessExperimental().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"HeadlessExperimental.enable", func() func() error {
+			resultChan := mockSocket.HeadlessExperimental().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestHeadlessExperimentalEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestHeadlessExperimentalEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"HeadlessExperimental.mainFrameReadyForScreenshots", func(results chan<- error) *Listener {
+			return mockSocket.HeadlessExperimental().OnMainFrameReadyForScreenshots(func(event *experimental.MainFrameReadyForScreenshotsEvent) {
+				results <- event.Err
+			})
+		}},
+		{"HeadlessExperimental.needsBeginFramesChanged", func(results chan<- error) *Listener {
+			return mockSocket.HeadlessExperimental().OnNeedsBeginFramesChanged(func(event *experimental.NeedsBeginFramesChangedEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/headless/experimental"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
@@ -17,9 +18,9 @@ func TestHeadlessExperimentalBeginFrame(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &experimental.BeginFrameParams{
-		FrameTime: runtime.Timestamp(time.Now().Unix()),
-		Deadline:  runtime.Timestamp(time.Now().Unix()),
-		Interval:  1.1,
+		FrameTime: optional.Of(runtime.Timestamp(time.Now().Unix())),
+		Deadline:  optional.Of(runtime.Timestamp(time.Now().Unix())),
+		Interval:  optional.Float64(1.1),
 		Screenshot: &experimental.ScreenshotParams{
 			Format:  experimental.Format.Jpeg,
 			Quality: 100,
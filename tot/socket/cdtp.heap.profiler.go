@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/heap/profiler"
@@ -10,28 +13,56 @@ import (
 HeapProfilerProtocol provides a namespace for the Chrome HeapProfiler protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/ EXPERIMENTAL.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/
 */
 type HeapProfilerProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *HeapProfilerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *HeapProfilerProtocol) WithContext(ctx context.Context) *HeapProfilerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &HeapProfilerProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-AddInspectedHeapObject enables console to refer to the node with given id via $x
-(see Command Line API for more details $x functions).
+Enables console to refer to the node with given id via $x (see Command Line API
+for more details $x functions).
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-addInspectedHeapObject
 */
 func (protocol *HeapProfilerProtocol) AddInspectedHeapObject(
 	params *profiler.AddInspectedHeapObjectParams,
 ) <-chan *profiler.AddInspectedHeapObjectResult {
-	resultChan := make(chan *profiler.AddInspectedHeapObjectResult)
+	resultChan := make(chan *profiler.AddInspectedHeapObjectResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.addInspectedHeapObject", params)
 	result := &profiler.AddInspectedHeapObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -42,19 +73,20 @@ func (protocol *HeapProfilerProtocol) AddInspectedHeapObject(
 }
 
 /*
-CollectGarbage is experimental.
+CollectGarbage sends the HeapProfiler.collectGarbage command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-collectGarbage
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *profiler.CollectGarbageResult {
-	resultChan := make(chan *profiler.CollectGarbageResult)
+	resultChan := make(chan *profiler.CollectGarbageResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.collectGarbage", nil)
 	result := &profiler.CollectGarbageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,18 +97,20 @@ func (protocol *HeapProfilerProtocol) CollectGarbage() <-chan *profiler.CollectG
 }
 
 /*
-Disable disables the HeapProfiler.
+Disable sends the HeapProfiler.disable command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-disable
 */
 func (protocol *HeapProfilerProtocol) Disable() <-chan *profiler.DisableResult {
-	resultChan := make(chan *profiler.DisableResult)
+	resultChan := make(chan *profiler.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.disable", nil)
 	result := &profiler.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -87,18 +121,20 @@ func (protocol *HeapProfilerProtocol) Disable() <-chan *profiler.DisableResult {
 }
 
 /*
-Enable enables the HeapProfiler.
+Enable sends the HeapProfiler.enable command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-enable
 */
 func (protocol *HeapProfilerProtocol) Enable() <-chan *profiler.EnableResult {
-	resultChan := make(chan *profiler.EnableResult)
+	resultChan := make(chan *profiler.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.enable", nil)
 	result := &profiler.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -109,21 +145,22 @@ func (protocol *HeapProfilerProtocol) Enable() <-chan *profiler.EnableResult {
 }
 
 /*
-GetHeapObjectID is experimental.
+GetHeapObjectID sends the HeapProfiler.getHeapObjectId command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getHeapObjectId
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetHeapObjectID(
 	params *profiler.GetHeapObjectIDParams,
 ) <-chan *profiler.GetHeapObjectIDResult {
-	resultChan := make(chan *profiler.GetHeapObjectIDResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.getHeapObjectID", params)
+	resultChan := make(chan *profiler.GetHeapObjectIDResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.getHeapObjectId", params)
 	result := &profiler.GetHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -136,21 +173,22 @@ func (protocol *HeapProfilerProtocol) GetHeapObjectID(
 }
 
 /*
-GetObjectByHeapObjectID is experimental.
+GetObjectByHeapObjectID sends the HeapProfiler.getObjectByHeapObjectId command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getObjectByHeapObjectId
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectID(
 	params *profiler.GetObjectByHeapObjectIDParams,
 ) <-chan *profiler.GetObjectByHeapObjectIDResult {
-	resultChan := make(chan *profiler.GetObjectByHeapObjectIDResult)
+	resultChan := make(chan *profiler.GetObjectByHeapObjectIDResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getObjectByHeapObjectId", params)
 	result := &profiler.GetObjectByHeapObjectIDResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -163,22 +201,25 @@ func (protocol *HeapProfilerProtocol) GetObjectByHeapObjectID(
 }
 
 /*
-GetSamplingProfile is experimental.
+GetSamplingProfile sends the HeapProfiler.getSamplingProfile command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-getSamplingProfile
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) GetSamplingProfile(
 	params *profiler.GetSamplingProfileParams,
 ) <-chan *profiler.GetSamplingProfileResult {
-	resultChan := make(chan *profiler.GetSamplingProfileResult)
+	resultChan := make(chan *profiler.GetSamplingProfileResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.getSamplingProfile", params)
 	result := &profiler.GetSamplingProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -188,21 +229,27 @@ func (protocol *HeapProfilerProtocol) GetSamplingProfile(
 }
 
 /*
-StartSampling is experimental.
+StartSampling sends the HeapProfiler.startSampling command.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startSampling
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartSampling(
-	params *profiler.StartSamplingParams,
+	params ...*profiler.StartSamplingParams,
 ) <-chan *profiler.StartSamplingResult {
-	resultChan := make(chan *profiler.StartSamplingResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.startSampling", params)
+	resultChan := make(chan *profiler.StartSamplingResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.startSampling", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "HeapProfiler.startSampling", params[0])
+	}
 	result := &profiler.StartSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -213,21 +260,28 @@ func (protocol *HeapProfilerProtocol) StartSampling(
 }
 
 /*
-StartTrackingHeapObjects is experimental.
+StartTrackingHeapObjects sends the HeapProfiler.startTrackingHeapObjects
+command.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-startTrackingHeapObjects
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StartTrackingHeapObjects(
-	params *profiler.StartTrackingHeapObjectsParams,
+	params ...*profiler.StartTrackingHeapObjectsParams,
 ) <-chan *profiler.StartTrackingHeapObjectsResult {
-	resultChan := make(chan *profiler.StartTrackingHeapObjectsResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.startTrackingHeapObjects", params)
+	resultChan := make(chan *profiler.StartTrackingHeapObjectsResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.startTrackingHeapObjects", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "HeapProfiler.startTrackingHeapObjects", params[0])
+	}
 	result := &profiler.StartTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -238,22 +292,25 @@ func (protocol *HeapProfilerProtocol) StartTrackingHeapObjects(
 }
 
 /*
-StopSampling is experimental.
+StopSampling sends the HeapProfiler.stopSampling command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopSampling
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StopSampling(
 	params *profiler.StopSamplingParams,
 ) <-chan *profiler.StopSamplingResult {
-	resultChan := make(chan *profiler.StopSamplingResult)
+	resultChan := make(chan *profiler.StopSamplingResult, 1)
 	command := NewCommand(protocol.Socket, "HeapProfiler.stopSampling", params)
 	result := &profiler.StopSamplingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -263,21 +320,27 @@ func (protocol *HeapProfilerProtocol) StopSampling(
 }
 
 /*
-StopTrackingHeapObjects is experimental.
+StopTrackingHeapObjects sends the HeapProfiler.stopTrackingHeapObjects command.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-stopTrackingHeapObjects
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) StopTrackingHeapObjects(
-	params *profiler.StopTrackingHeapObjectsParams,
+	params ...*profiler.StopTrackingHeapObjectsParams,
 ) <-chan *profiler.StopTrackingHeapObjectsResult {
-	resultChan := make(chan *profiler.StopTrackingHeapObjectsResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.stopTrackingHeapObjects", params)
+	resultChan := make(chan *profiler.StopTrackingHeapObjectsResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.stopTrackingHeapObjects", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "HeapProfiler.stopTrackingHeapObjects", params[0])
+	}
 	result := &profiler.StopTrackingHeapObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -288,21 +351,27 @@ func (protocol *HeapProfilerProtocol) StopTrackingHeapObjects(
 }
 
 /*
-TakeHeapSnapshot is experimental.
+TakeHeapSnapshot sends the HeapProfiler.takeHeapSnapshot command.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#method-takeHeapSnapshot
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) TakeHeapSnapshot(
-	params *profiler.TakeHeapSnapshotParams,
+	params ...*profiler.TakeHeapSnapshotParams,
 ) <-chan *profiler.TakeHeapSnapshotResult {
-	resultChan := make(chan *profiler.TakeHeapSnapshotResult)
-	command := NewCommand(protocol.Socket, "HeapProfiler.takeHeapSnapshot", params)
+	resultChan := make(chan *profiler.TakeHeapSnapshotResult, 1)
+	command := NewCommand(protocol.Socket, "HeapProfiler.takeHeapSnapshot", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "HeapProfiler.takeHeapSnapshot", params[0])
+	}
 	result := &profiler.TakeHeapSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -313,15 +382,14 @@ func (protocol *HeapProfilerProtocol) TakeHeapSnapshot(
 }
 
 /*
-OnAddHeapSnapshotChunk adds a handler to the HeapProfiler.AddHeapSnapshotChunk
+OnAddHeapSnapshotChunk adds a handler to the HeapProfiler.addHeapSnapshotChunk
 event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-addHeapSnapshotChunk
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
 	callback func(event *profiler.AddHeapSnapshotChunkEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeapProfiler.addHeapSnapshotChunk",
 		func(response *Response) {
@@ -334,18 +402,20 @@ func (protocol *HeapProfilerProtocol) OnAddHeapSnapshotChunk(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnHeapStatsUpdate adds a handler to the DOM.heapStatsUpdate event. DOM.heapStatsUpdate
-fires if heap objects tracking has been started then backend may send update for
-one or more fragments.
+OnHeapStatsUpdate adds a handler to the HeapProfiler.heapStatsUpdate event.
+
+If heap objects tracking has been started then backend may send update for one
+or more fragments
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-heapStatsUpdate
 */
 func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
 	callback func(event *profiler.HeapStatsUpdateEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeapProfiler.heapStatsUpdate",
 		func(response *Response) {
@@ -358,22 +428,24 @@ func (protocol *HeapProfilerProtocol) OnHeapStatsUpdate(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnLastSeenObjectID adds a handler to the DOM.LastSeenObjectID event. DOM.LastSeenObjectID
-fires if heap objects tracking has been started then backend regularly sends a
-current value for last seen object id and corresponding timestamp. If the were
-changes in the heap since last event then one or more heapStatsUpdate events
-will be sent before a new lastSeenObjectId event.
+OnLastSeenObjectID adds a handler to the HeapProfiler.lastSeenObjectId event.
+
+If heap objects tracking has been started then backend regularly sends a current
+value for last seen object id and corresponding timestamp. If the were changes
+in the heap since last event then one or more heapStatsUpdate events will be
+sent before a new lastSeenObjectId event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-lastSeenObjectId
 */
 func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
 	callback func(event *profiler.LastSeenObjectIDEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
-		"HeapProfiler.lastSeenObjectID",
+		"HeapProfiler.lastSeenObjectId",
 		func(response *Response) {
 			event := &profiler.LastSeenObjectIDEvent{}
 			json.Unmarshal([]byte(response.Params), event)
@@ -384,19 +456,18 @@ func (protocol *HeapProfilerProtocol) OnLastSeenObjectID(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnReportHeapSnapshotProgress adds a handler to the DOM.ReportHeapSnapshotProgress
-event.
-
+OnReportHeapSnapshotProgress adds a handler to the
+HeapProfiler.reportHeapSnapshotProgress event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-reportHeapSnapshotProgress
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
 	callback func(event *profiler.ReportHeapSnapshotProgressEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeapProfiler.reportHeapSnapshotProgress",
 		func(response *Response) {
@@ -409,17 +480,17 @@ func (protocol *HeapProfilerProtocol) OnReportHeapSnapshotProgress(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnResetProfiles adds a handler to the HeapProfiler.ResetProfiles event.
+OnResetProfiles adds a handler to the HeapProfiler.resetProfiles event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/HeapProfiler/#event-resetProfiles
-EXPERIMENTAL.
 */
 func (protocol *HeapProfilerProtocol) OnResetProfiles(
 	callback func(event *profiler.ResetProfilesEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"HeapProfiler.resetProfiles",
 		func(response *Response) {
@@ -432,4 +503,5 @@ func (protocol *HeapProfilerProtocol) OnResetProfiles(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/heap/profiler"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
@@ -299,7 +300,7 @@ func TestHeapProfilerStartSampling(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &profiler.StartSamplingParams{
-		SamplingInterval: 1,
+		SamplingInterval: optional.Float64(1),
 	}
 	resultChan := mockSocket.HeapProfiler().StartSampling(params)
 	mockResult := &profiler.StartSamplingResult{}
@@ -336,7 +337,7 @@ func TestHeapProfilerStartTrackingHeapObjects(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &profiler.StartTrackingHeapObjectsParams{
-		TrackAllocations: true,
+		TrackAllocations: optional.Bool(true),
 	}
 	resultChan := mockSocket.HeapProfiler().StartTrackingHeapObjects(params)
 	mockResult := &profiler.StartTrackingHeapObjectsResult{}
@@ -422,7 +423,7 @@ func TestHeapProfilerStopTrackingHeapObjects(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &profiler.StopTrackingHeapObjectsParams{
-		ReportProgress: true,
+		ReportProgress: optional.Bool(true),
 	}
 	resultChan := mockSocket.HeapProfiler().StopTrackingHeapObjects(params)
 	mockResult := &profiler.StopTrackingHeapObjectsResult{}
@@ -459,7 +460,7 @@ func TestHeapProfilerTakeHeapSnapshot(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &profiler.TakeHeapSnapshotParams{
-		ReportProgress: true,
+		ReportProgress: optional.Bool(true),
 	}
 	resultChan := mockSocket.HeapProfiler().TakeHeapSnapshot(params)
 	mockResult := &profiler.TakeHeapSnapshotResult{}
@@ -595,7 +596,7 @@ func TestHeapProfilerOnLastSeenObjectID(t *testing.T) {
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
 		ID:     0,
 		Error:  &Error{},
-		Method: "HeapProfiler.lastSeenObjectID",
+		Method: "HeapProfiler.lastSeenObjectId",
 		Params: mockResultBytes,
 	})
 	result := <-resultChan
@@ -614,7 +615,7 @@ func TestHeapProfilerOnLastSeenObjectID(t *testing.T) {
 			Data:    []byte(`"error data"`),
 			Message: "error message",
 		},
-		Method: "HeapProfiler.lastSeenObjectID",
+		Method: "HeapProfiler.lastSeenObjectId",
 	})
 	result = <-resultChan
 	if nil == result.Err {
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/indexed/db"
@@ -10,27 +13,55 @@ import (
 IndexedDBProtocol provides a namespace for the Chrome IndexedDB protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/ EXPERIMENTAL.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/
 */
 type IndexedDBProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *IndexedDBProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *IndexedDBProtocol) WithContext(ctx context.Context) *IndexedDBProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &IndexedDBProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-ClearObjectStore clears all entries from an object store.
+Clears all entries from an object store.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-clearObjectStore
 */
 func (protocol *IndexedDBProtocol) ClearObjectStore(
 	params *db.ClearObjectStoreParams,
 ) <-chan *db.ClearObjectStoreResult {
-	resultChan := make(chan *db.ClearObjectStoreResult)
+	resultChan := make(chan *db.ClearObjectStoreResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.clearObjectStore", params)
 	result := &db.ClearObjectStoreResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -41,20 +72,22 @@ func (protocol *IndexedDBProtocol) ClearObjectStore(
 }
 
 /*
-DeleteDatabase deletes a database.
+Deletes a database.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteDatabase
 */
 func (protocol *IndexedDBProtocol) DeleteDatabase(
 	params *db.DeleteDatabaseParams,
 ) <-chan *db.DeleteDatabaseResult {
-	resultChan := make(chan *db.DeleteDatabaseResult)
+	resultChan := make(chan *db.DeleteDatabaseResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteDatabase", params)
 	result := &db.DeleteDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,20 +98,22 @@ func (protocol *IndexedDBProtocol) DeleteDatabase(
 }
 
 /*
-DeleteObjectStoreEntries deletes a range of entries from an object store.
+Delete a range of entries from an object store
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-deleteObjectStoreEntries
 */
 func (protocol *IndexedDBProtocol) DeleteObjectStoreEntries(
 	params *db.DeleteObjectStoreEntriesParams,
 ) <-chan *db.DeleteObjectStoreEntriesResult {
-	resultChan := make(chan *db.DeleteObjectStoreEntriesResult)
+	resultChan := make(chan *db.DeleteObjectStoreEntriesResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.deleteObjectStoreEntries", params)
 	result := &db.DeleteObjectStoreEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -89,18 +124,20 @@ func (protocol *IndexedDBProtocol) DeleteObjectStoreEntries(
 }
 
 /*
-Disable disables events from backend.
+Disables events from backend.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-disable
 */
 func (protocol *IndexedDBProtocol) Disable() <-chan *db.DisableResult {
-	resultChan := make(chan *db.DisableResult)
+	resultChan := make(chan *db.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.disable", nil)
 	result := &db.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -111,18 +148,20 @@ func (protocol *IndexedDBProtocol) Disable() <-chan *db.DisableResult {
 }
 
 /*
-Enable enables events from backend.
+Enables events from backend.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-enable
 */
 func (protocol *IndexedDBProtocol) Enable() <-chan *db.EnableResult {
-	resultChan := make(chan *db.EnableResult)
+	resultChan := make(chan *db.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.enable", nil)
 	result := &db.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -133,20 +172,22 @@ func (protocol *IndexedDBProtocol) Enable() <-chan *db.EnableResult {
 }
 
 /*
-RequestData requests data from object store or index.
+Requests data from object store or index.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestData
 */
 func (protocol *IndexedDBProtocol) RequestData(
 	params *db.RequestDataParams,
 ) <-chan *db.RequestDataResult {
-	resultChan := make(chan *db.RequestDataResult)
+	resultChan := make(chan *db.RequestDataResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestData", params)
 	result := &db.RequestDataResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -159,20 +200,50 @@ func (protocol *IndexedDBProtocol) RequestData(
 }
 
 /*
-RequestDatabase requests database with given name in given frame.
+Gets metadata of an object store.
+
+https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-getMetadata
+*/
+func (protocol *IndexedDBProtocol) GetMetadata(
+	params *db.GetMetadataParams,
+) <-chan *db.GetMetadataResult {
+	resultChan := make(chan *db.GetMetadataResult, 1)
+	command := NewCommand(protocol.Socket, "IndexedDB.getMetadata", params)
+	result := &db.GetMetadataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Requests database with given name in given frame.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabase
 */
 func (protocol *IndexedDBProtocol) RequestDatabase(
 	params *db.RequestDatabaseParams,
 ) <-chan *db.RequestDatabaseResult {
-	resultChan := make(chan *db.RequestDatabaseResult)
+	resultChan := make(chan *db.RequestDatabaseResult, 1)
 	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabase", params)
 	result := &db.RequestDatabaseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -185,20 +256,27 @@ func (protocol *IndexedDBProtocol) RequestDatabase(
 }
 
 /*
-RequestDatabaseNames requests database names for given security origin.
+Requests database names for given security origin.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/IndexedDB/#method-requestDatabaseNames
 */
 func (protocol *IndexedDBProtocol) RequestDatabaseNames(
-	params *db.RequestDatabaseNamesParams,
+	params ...*db.RequestDatabaseNamesParams,
 ) <-chan *db.RequestDatabaseNamesResult {
-	resultChan := make(chan *db.RequestDatabaseNamesResult)
-	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabaseNames", params)
+	resultChan := make(chan *db.RequestDatabaseNamesResult, 1)
+	command := NewCommand(protocol.Socket, "IndexedDB.requestDatabaseNames", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "IndexedDB.requestDatabaseNames", params[0])
+	}
 	result := &db.RequestDatabaseNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
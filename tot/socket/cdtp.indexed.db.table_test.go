@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/indexed/db"
+)
+
+func TestIndexedDBCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestIndexedDBCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"IndexedDB.clearObjectStore", func() func() error {
+			resultChan := mockSocket.IndexedDB().ClearObjectStore(&db.ClearObjectStoreParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.deleteDatabase", func() func() error {
+			resultChan := mockSocket.IndexedDB().DeleteDatabase(&db.DeleteDatabaseParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.deleteObjectStoreEntries", func() func() error {
+			resultChan := mockSocket.IndexedDB().DeleteObjectStoreEntries(&db.DeleteObjectStoreEntriesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.disable", func() func() error {
+			resultChan := mockSocket.IndexedDB().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.enable", func() func() error {
+			resultChan := mockSocket.IndexedDB().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.requestData", func() func() error {
+			resultChan := mockSocket.IndexedDB().RequestData(&db.RequestDataParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.getMetadata", func() func() error {
+			resultChan := mockSocket.IndexedDB().GetMetadata(&db.GetMetadataParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.requestDatabase", func() func() error {
+			resultChan := mockSocket.IndexedDB().RequestDatabase(&db.RequestDatabaseParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IndexedDB.requestDatabaseNames", func() func() error {
+			resultChan := mockSocket.IndexedDB().RequestDatabaseNames(&db.RequestDatabaseNamesParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
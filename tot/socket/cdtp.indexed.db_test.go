@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/indexed/db"
 )
 
@@ -15,7 +16,7 @@ func TestIndexedDBClearObjectStore(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.ClearObjectStoreParams{
-		SecurityOrigin:  "security-origin",
+		SecurityOrigin:  optional.String("security-origin"),
 		DatabaseName:    "database-name",
 		ObjectStoreName: "object-store-name",
 	}
@@ -54,7 +55,7 @@ func TestIndexedDBDeleteDatabase(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.DeleteDatabaseParams{
-		SecurityOrigin:  "security-origin",
+		SecurityOrigin:  optional.String("security-origin"),
 		DatabaseName:    "database-name",
 		ObjectStoreName: "object-store-name",
 	}
@@ -93,7 +94,7 @@ func TestIndexedDBDeleteObjectStoreEntries(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.DeleteObjectStoreEntriesParams{
-		SecurityOrigin: "security-origin",
+		SecurityOrigin: optional.String("security-origin"),
 		DatabaseName:   "database-name",
 	}
 	resultChan := mockSocket.IndexedDB().DeleteObjectStoreEntries(params)
@@ -199,7 +200,7 @@ func TestIndexedDBRequestData(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.RequestDataParams{
-		SecurityOrigin:  "security-origin",
+		SecurityOrigin:  optional.String("security-origin"),
 		DatabaseName:    "database-name",
 		ObjectStoreName: "object-store-name",
 		IndexName:       "index-name",
@@ -269,7 +270,7 @@ func TestIndexedDBRequestDatabase(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.RequestDatabaseParams{
-		SecurityOrigin: "security-origin",
+		SecurityOrigin: optional.String("security-origin"),
 		DatabaseName:   "database-name",
 	}
 	resultChan := mockSocket.IndexedDB().RequestDatabase(params)
@@ -334,7 +335,7 @@ func TestIndexedDBRequestDatabaseNames(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &db.RequestDatabaseNamesParams{
-		SecurityOrigin: "security-origin",
+		SecurityOrigin: optional.String("security-origin"),
 	}
 	resultChan := mockSocket.IndexedDB().RequestDatabaseNames(params)
 	mockResult := &db.RequestDatabaseNamesResult{
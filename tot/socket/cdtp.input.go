@@ -1,6 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/mkenney/go-chrome/tot/input"
 )
 
@@ -11,23 +16,75 @@ https://chromedevtools.github.io/devtools-protocol/tot/Input/
 */
 type InputProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *InputProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *InputProtocol) WithContext(ctx context.Context) *InputProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &InputProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Dispatches a drag event into the page. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchDragEvent
+*/
+func (protocol *InputProtocol) DispatchDragEvent(
+	params *input.DispatchDragEventParams,
+) <-chan *input.DispatchDragEventResult {
+	resultChan := make(chan *input.DispatchDragEventResult, 1)
+	command := NewCommand(protocol.Socket, "Input.dispatchDragEvent", params)
+	result := &input.DispatchDragEventResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
 }
 
 /*
-DispatchKeyEvent dispatches a key event to the page.
+Dispatches a key event to the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchKeyEvent
 */
 func (protocol *InputProtocol) DispatchKeyEvent(
 	params *input.DispatchKeyEventParams,
 ) <-chan *input.DispatchKeyEventResult {
-	resultChan := make(chan *input.DispatchKeyEventResult)
+	resultChan := make(chan *input.DispatchKeyEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchKeyEvent", params)
 	result := &input.DispatchKeyEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,20 +95,77 @@ func (protocol *InputProtocol) DispatchKeyEvent(
 }
 
 /*
-DispatchMouseEvent dispatches a mouse event to the page.
+This method emulates inserting text that doesn't come from a key press, for
+example an emoji keyboard or an IME. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-insertText
+*/
+func (protocol *InputProtocol) InsertText(
+	params *input.InsertTextParams,
+) <-chan *input.InsertTextResult {
+	resultChan := make(chan *input.InsertTextResult, 1)
+	command := NewCommand(protocol.Socket, "Input.insertText", params)
+	result := &input.InsertTextResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+This method sets the current candidate text for IME. Use imeCommitComposition to
+commit the final text. Use imeSetComposition with empty string as text to cancel
+composition. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-imeSetComposition
+*/
+func (protocol *InputProtocol) ImeSetComposition(
+	params *input.ImeSetCompositionParams,
+) <-chan *input.ImeSetCompositionResult {
+	resultChan := make(chan *input.ImeSetCompositionResult, 1)
+	command := NewCommand(protocol.Socket, "Input.imeSetComposition", params)
+	result := &input.ImeSetCompositionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Dispatches a mouse event to the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchMouseEvent
 */
 func (protocol *InputProtocol) DispatchMouseEvent(
 	params *input.DispatchMouseEventParams,
 ) <-chan *input.DispatchMouseEventResult {
-	resultChan := make(chan *input.DispatchMouseEventResult)
+	resultChan := make(chan *input.DispatchMouseEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchMouseEvent", params)
 	result := &input.DispatchMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,20 +176,22 @@ func (protocol *InputProtocol) DispatchMouseEvent(
 }
 
 /*
-DispatchTouchEvent dispatches a touch event to the page.
+Dispatches a touch event to the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-dispatchTouchEvent
 */
 func (protocol *InputProtocol) DispatchTouchEvent(
 	params *input.DispatchTouchEventParams,
 ) <-chan *input.DispatchTouchEventResult {
-	resultChan := make(chan *input.DispatchTouchEventResult)
+	resultChan := make(chan *input.DispatchTouchEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.dispatchTouchEvent", params)
 	result := &input.DispatchTouchEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -86,21 +202,46 @@ func (protocol *InputProtocol) DispatchTouchEvent(
 }
 
 /*
-EmulateTouchFromMouseEvent emulates touch event from the mouse event parameters.
+Cancels any active dragging in the page.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-cancelDragging
+*/
+func (protocol *InputProtocol) CancelDragging() <-chan *input.CancelDraggingResult {
+	resultChan := make(chan *input.CancelDraggingResult, 1)
+	command := NewCommand(protocol.Socket, "Input.cancelDragging", nil)
+	result := &input.CancelDraggingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Emulates touch event from the mouse event parameters. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-emulateTouchFromMouseEvent
-EXPERIMENTAL.
 */
 func (protocol *InputProtocol) EmulateTouchFromMouseEvent(
 	params *input.EmulateTouchFromMouseEventParams,
 ) <-chan *input.EmulateTouchFromMouseEventResult {
-	resultChan := make(chan *input.EmulateTouchFromMouseEventResult)
+	resultChan := make(chan *input.EmulateTouchFromMouseEventResult, 1)
 	command := NewCommand(protocol.Socket, "Input.emulateTouchFromMouseEvent", params)
 	result := &input.EmulateTouchFromMouseEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -111,20 +252,22 @@ func (protocol *InputProtocol) EmulateTouchFromMouseEvent(
 }
 
 /*
-SetIgnoreEvents ignores input events (useful while auditing page).
+Ignores input events (useful while auditing page).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setIgnoreInputEvents
 */
 func (protocol *InputProtocol) SetIgnoreEvents(
 	params *input.SetIgnoreEventsParams,
 ) <-chan *input.SetIgnoreEventsResult {
-	resultChan := make(chan *input.SetIgnoreEventsResult)
+	resultChan := make(chan *input.SetIgnoreEventsResult, 1)
 	command := NewCommand(protocol.Socket, "Input.setIgnoreInputEvents", params)
 	result := &input.SetIgnoreEventsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -135,22 +278,51 @@ func (protocol *InputProtocol) SetIgnoreEvents(
 }
 
 /*
-SynthesizePinchGesture synthesizes a pinch gesture over a time period by issuing
-appropriate touch events.
+Prevents default drag and drop behavior and instead emits
+`Input.dragIntercepted` events. Drag and drop behavior can be directly
+controlled via `Input.dispatchDragEvent`. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-setInterceptDrags
+*/
+func (protocol *InputProtocol) SetInterceptDrags(
+	params *input.SetInterceptDragsParams,
+) <-chan *input.SetInterceptDragsResult {
+	resultChan := make(chan *input.SetInterceptDragsResult, 1)
+	command := NewCommand(protocol.Socket, "Input.setInterceptDrags", params)
+	result := &input.SetInterceptDragsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Synthesizes a pinch gesture over a time period by issuing appropriate touch
+events. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizePinchGesture
-EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizePinchGesture(
 	params *input.SynthesizePinchGestureParams,
 ) <-chan *input.SynthesizePinchGestureResult {
-	resultChan := make(chan *input.SynthesizePinchGestureResult)
+	resultChan := make(chan *input.SynthesizePinchGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizePinchGesture", params)
 	result := &input.SynthesizePinchGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -161,22 +333,23 @@ func (protocol *InputProtocol) SynthesizePinchGesture(
 }
 
 /*
-SynthesizeScrollGesture synthesizes a scroll gesture over a time period by
-issuing appropriate touch events.
+Synthesizes a scroll gesture over a time period by issuing appropriate touch
+events. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeScrollGesture
-EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizeScrollGesture(
 	params *input.SynthesizeScrollGestureParams,
 ) <-chan *input.SynthesizeScrollGestureResult {
-	resultChan := make(chan *input.SynthesizeScrollGestureResult)
+	resultChan := make(chan *input.SynthesizeScrollGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizeScrollGesture", params)
 	result := &input.SynthesizeScrollGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -187,22 +360,23 @@ func (protocol *InputProtocol) SynthesizeScrollGesture(
 }
 
 /*
-SynthesizeTapGesture synthesizes a tap gesture over a time period by issuing
-appropriate touch events.
+Synthesizes a tap gesture over a time period by issuing appropriate touch
+events. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Input/#method-synthesizeTapGesture
-EXPERIMENTAL.
 */
 func (protocol *InputProtocol) SynthesizeTapGesture(
 	params *input.SynthesizeTapGestureParams,
 ) <-chan *input.SynthesizeTapGestureResult {
-	resultChan := make(chan *input.SynthesizeTapGestureResult)
+	resultChan := make(chan *input.SynthesizeTapGestureResult, 1)
 	command := NewCommand(protocol.Socket, "Input.synthesizeTapGesture", params)
 	result := &input.SynthesizeTapGestureResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -211,3 +385,30 @@ func (protocol *InputProtocol) SynthesizeTapGesture(
 
 	return resultChan
 }
+
+/*
+OnDragIntercepted adds a handler to the Input.dragIntercepted event.
+
+Emitted only when `Input.setInterceptDrags` is enabled. Use this data with
+`Input.dispatchDragEvent` to restore normal drag and drop behavior.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Input/#event-dragIntercepted
+*/
+func (protocol *InputProtocol) OnDragIntercepted(
+	callback func(event *input.DragInterceptedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Input.dragIntercepted",
+		func(response *Response) {
+			event := &input.DragInterceptedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
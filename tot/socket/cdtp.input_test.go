@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/input"
 )
 
@@ -17,19 +18,19 @@ func TestInputDispatchKeyEvent(t *testing.T) {
 
 	params := &input.DispatchKeyEventParams{
 		Type:                  input.KeyEvent.KeyDown,
-		Modifiers:             1,
-		Timestamp:             input.TimeSinceEpoch(time.Now().Unix()),
-		Text:                  "text",
-		UnmodifiedText:        "unmodified text",
-		KeyIdentifier:         "key-id",
-		Code:                  "code",
-		Key:                   "key",
-		WindowsVirtualKeyCode: 1,
-		NativeVirtualKeyCode:  1,
-		AutoRepeat:            true,
-		IsKeypad:              true,
-		IsSystemKey:           true,
-		Location:              1,
+		Modifiers:             optional.Int(1),
+		Timestamp:             optional.Of(input.TimeSinceEpoch(time.Now().Unix())),
+		Text:                  optional.String("text"),
+		UnmodifiedText:        optional.String("unmodified text"),
+		KeyIdentifier:         optional.String("key-id"),
+		Code:                  optional.String("code"),
+		Key:                   optional.String("key"),
+		WindowsVirtualKeyCode: optional.Int(1),
+		NativeVirtualKeyCode:  optional.Int(1),
+		AutoRepeat:            optional.Bool(true),
+		IsKeypad:              optional.Bool(true),
+		IsSystemKey:           optional.Bool(true),
+		Location:              optional.Int(1),
 	}
 	resultChan := mockSocket.Input().DispatchKeyEvent(params)
 	mockResult := &input.DispatchKeyEventResult{}
@@ -69,12 +70,12 @@ func TestInputDispatchMouseEvent(t *testing.T) {
 		Type:       input.MouseEvent.MousePressed,
 		X:          1,
 		Y:          1,
-		Modifiers:  1,
-		Timestamp:  input.TimeSinceEpoch(time.Now().Unix()),
+		Modifiers:  optional.Int(1),
+		Timestamp:  optional.Of(input.TimeSinceEpoch(time.Now().Unix())),
 		Button:     input.ButtonEvent.None,
-		ClickCount: 1,
-		DeltaX:     1,
-		DeltaY:     1,
+		ClickCount: optional.Int(1),
+		DeltaX:     optional.Float64(1),
+		DeltaY:     optional.Float64(1),
 	}
 	resultChan := mockSocket.Input().DispatchMouseEvent(params)
 	mockResult := &input.DispatchMouseEventResult{}
@@ -121,8 +122,8 @@ func TestInputDispatchTouchEvent(t *testing.T) {
 			Force:         1,
 			ID:            1,
 		}},
-		Modifiers: 1,
-		Timestamp: input.TimeSinceEpoch(time.Now().Unix()),
+		Modifiers: optional.Int(1),
+		Timestamp: optional.Of(input.TimeSinceEpoch(time.Now().Unix())),
 	}
 	resultChan := mockSocket.Input().DispatchTouchEvent(params)
 	mockResult := &input.DispatchTouchEventResult{}
@@ -162,12 +163,12 @@ func TestInputEmulateTouchFromMouseEvent(t *testing.T) {
 		Type:       input.MouseEvent.MousePressed,
 		X:          1,
 		Y:          1,
-		Timestamp:  input.TimeSinceEpoch(time.Now().Unix()),
+		Timestamp:  optional.Of(input.TimeSinceEpoch(time.Now().Unix())),
 		Button:     input.ButtonEvent.None,
-		DeltaX:     1,
-		DeltaY:     1,
-		Modifiers:  1,
-		ClickCount: 1,
+		DeltaX:     optional.Float64(1),
+		DeltaY:     optional.Float64(1),
+		Modifiers:  optional.Int(1),
+		ClickCount: optional.Int(1),
 	}
 	resultChan := mockSocket.Input().EmulateTouchFromMouseEvent(params)
 	mockResult := &input.EmulateTouchFromMouseEventResult{}
@@ -244,8 +245,8 @@ func TestInputSynthesizePinchGesture(t *testing.T) {
 		X:                 1,
 		Y:                 1,
 		ScaleFactor:       1,
-		RelativeSpeed:     1,
-		GestureSourceType: input.GestureSourceType("gesture-source-type"),
+		RelativeSpeed:     optional.Int(1),
+		GestureSourceType: optional.Of(input.GestureSourceType("gesture-source-type")),
 	}
 	resultChan := mockSocket.Input().SynthesizePinchGesture(params)
 	mockResult := &input.SynthesizePinchGestureResult{}
@@ -284,16 +285,16 @@ func TestInputSynthesizeScrollGesture(t *testing.T) {
 	params := &input.SynthesizeScrollGestureParams{
 		X:                     1,
 		Y:                     1,
-		XDistance:             1,
-		YDistance:             1,
-		XOverscroll:           1,
-		YOverscroll:           1,
-		PreventFling:          true,
-		Speed:                 1,
-		GestureSourceType:     input.GestureSourceType("gesture-source-type"),
-		RepeatCount:           1,
-		RepeatDelayMs:         1,
-		InteractionMarkerName: "marker-name",
+		XDistance:             optional.Float64(1),
+		YDistance:             optional.Float64(1),
+		XOverscroll:           optional.Float64(1),
+		YOverscroll:           optional.Float64(1),
+		PreventFling:          optional.Bool(true),
+		Speed:                 optional.Int(1),
+		GestureSourceType:     optional.Of(input.GestureSourceType("gesture-source-type")),
+		RepeatCount:           optional.Int(1),
+		RepeatDelayMs:         optional.Int(1),
+		InteractionMarkerName: optional.String("marker-name"),
 	}
 	resultChan := mockSocket.Input().SynthesizeScrollGesture(params)
 	mockResult := &input.SynthesizeScrollGestureResult{}
@@ -332,9 +333,9 @@ func TestInputSynthesizeTapGesture(t *testing.T) {
 	params := &input.SynthesizeTapGestureParams{
 		X:                 1,
 		Y:                 1,
-		Duration:          1,
-		TapCount:          1,
-		GestureSourceType: input.GestureSourceType("gesture-source-type"),
+		Duration:          optional.Int(1),
+		TapCount:          optional.Int(1),
+		GestureSourceType: optional.Of(input.GestureSourceType("gesture-source-type")),
 	}
 	resultChan := mockSocket.Input().SynthesizeTapGesture(params)
 	mockResult := &input.SynthesizeTapGestureResult{}
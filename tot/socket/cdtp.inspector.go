@@ -0,0 +1,171 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/inspector"
+)
+
+/*
+InspectorProtocol provides a namespace for the Chrome Inspector protocol
+methods.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/
+*/
+type InspectorProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *InspectorProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *InspectorProtocol) WithContext(ctx context.Context) *InspectorProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &InspectorProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disables inspector domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-disable
+*/
+func (protocol *InspectorProtocol) Disable() <-chan *inspector.DisableResult {
+	resultChan := make(chan *inspector.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Inspector.disable", nil)
+	result := &inspector.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables inspector domain notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#method-enable
+*/
+func (protocol *InspectorProtocol) Enable() <-chan *inspector.EnableResult {
+	resultChan := make(chan *inspector.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Inspector.enable", nil)
+	result := &inspector.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDetached adds a handler to the Inspector.detached event.
+
+Fired when remote debugging connection is about to be terminated. Contains
+detach reason.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-detached
+*/
+func (protocol *InspectorProtocol) OnDetached(
+	callback func(event *inspector.DetachedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Inspector.detached",
+		func(response *Response) {
+			event := &inspector.DetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetCrashed adds a handler to the Inspector.targetCrashed event.
+
+# Fired when debugging target has crashed
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-targetCrashed
+*/
+func (protocol *InspectorProtocol) OnTargetCrashed(
+	callback func(event *inspector.TargetCrashedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Inspector.targetCrashed",
+		func(response *Response) {
+			event := &inspector.TargetCrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetReloadedAfterCrash adds a handler to the
+Inspector.targetReloadedAfterCrash event.
+
+# Fired when debugging target has reloaded after crash
+
+https://chromedevtools.github.io/devtools-protocol/tot/Inspector/#event-targetReloadedAfterCrash
+*/
+func (protocol *InspectorProtocol) OnTargetReloadedAfterCrash(
+	callback func(event *inspector.TargetReloadedAfterCrashEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Inspector.targetReloadedAfterCrash",
+		func(response *Response) {
+			event := &inspector.TargetReloadedAfterCrashEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,113 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/inspector"
+)
+
+func TestInspectorCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInspectorCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"Inspector.disable", func() func() error {
+			resultChan := mockSocket.Inspector().Disable()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"Inspector.enable", func() func() error {
+			resultChan := mockSocket.Inspector().Enable()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
+
+func TestInspectorEvents(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestInspectorEvents")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		event  string
+		listen func(results chan<- error) *Listener
+	}{
+		{"Inspector.detached", func(results chan<- error) *Listener {
+			return mockSocket.Inspector().OnDetached(func(event *inspector.DetachedEvent) {
+				results <- event.Err
+			})
+		}},
+		{"Inspector.targetCrashed", func(results chan<- error) *Listener {
+			return mockSocket.Inspector().OnTargetCrashed(func(event *inspector.TargetCrashedEvent) {
+				results <- event.Err
+			})
+		}},
+		{"Inspector.targetReloadedAfterCrash", func(results chan<- error) *Listener {
+			return mockSocket.Inspector().OnTargetReloadedAfterCrash(func(event *inspector.TargetReloadedAfterCrashEvent) {
+				results <- event.Err
+			})
+		}},
+	}
+
+	for _, test := range tests {
+		results := make(chan error, 1)
+		listener := test.listen(results)
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     0,
+			Error:  &Error{},
+			Method: test.event,
+			Params: []byte(`{}`),
+		})
+		if err := <-results; nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.event, err.Error())
+		}
+
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: 0,
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+			Method: test.event,
+		})
+		if err := <-results; nil == err {
+			t.Errorf("%s: expected error, got success", test.event)
+		}
+		listener.Cancel()
+	}
+}
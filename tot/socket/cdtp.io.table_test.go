@@ -0,0 +1,60 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/io"
+)
+
+func TestIOCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestIOCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"IO.close", func() func() error {
+			resultChan := mockSocket.IO().Close(&io.CloseParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IO.read", func() func() error {
+			resultChan := mockSocket.IO().Read(&io.ReadParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"IO.resolveBlob", func() func() error {
+			resultChan := mockSocket.IO().ResolveBlob(&io.ResolveBlobParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/io"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
@@ -54,8 +55,8 @@ func TestIORead(t *testing.T) {
 
 	params := &io.ReadParams{
 		Handle: io.StreamHandle("stream-handle"),
-		Offset: 1,
-		Size:   1,
+		Offset: optional.Int(1),
+		Size:   optional.Int(1),
 	}
 	resultChan := mockSocket.IO().Read(params)
 	mockResult := &io.ReadResult{
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/layer/tree"
@@ -10,27 +13,55 @@ import (
 LayerTreeProtocol provides a namespace for the Chrome LayerTree protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/ EXPERIMENTAL.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/
 */
 type LayerTreeProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *LayerTreeProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *LayerTreeProtocol) WithContext(ctx context.Context) *LayerTreeProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &LayerTreeProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-CompositingReasons provides the reasons why the given layer was composited.
+Provides the reasons why the given layer was composited.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-compositingReasons
 */
 func (protocol *LayerTreeProtocol) CompositingReasons(
 	params *tree.CompositingReasonsParams,
 ) <-chan *tree.CompositingReasonsResult {
-	resultChan := make(chan *tree.CompositingReasonsResult)
+	resultChan := make(chan *tree.CompositingReasonsResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.compositingReasons", params)
 	result := &tree.CompositingReasonsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -43,18 +74,20 @@ func (protocol *LayerTreeProtocol) CompositingReasons(
 }
 
 /*
-Disable disables compositing tree inspection.
+Disables compositing tree inspection.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-disable
 */
 func (protocol *LayerTreeProtocol) Disable() <-chan *tree.DisableResult {
-	resultChan := make(chan *tree.DisableResult)
+	resultChan := make(chan *tree.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.disable", nil)
 	result := &tree.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -65,18 +98,20 @@ func (protocol *LayerTreeProtocol) Disable() <-chan *tree.DisableResult {
 }
 
 /*
-Enable enables compositing tree inspection.
+Enables compositing tree inspection.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-enable
 */
 func (protocol *LayerTreeProtocol) Enable() <-chan *tree.EnableResult {
-	resultChan := make(chan *tree.EnableResult)
+	resultChan := make(chan *tree.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.enable", nil)
 	result := &tree.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -87,20 +122,22 @@ func (protocol *LayerTreeProtocol) Enable() <-chan *tree.EnableResult {
 }
 
 /*
-LoadSnapshot returns the snapshot identifier.
+Returns the snapshot identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-loadSnapshot
 */
 func (protocol *LayerTreeProtocol) LoadSnapshot(
 	params *tree.LoadSnapshotParams,
 ) <-chan *tree.LoadSnapshotResult {
-	resultChan := make(chan *tree.LoadSnapshotResult)
+	resultChan := make(chan *tree.LoadSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.loadSnapshot", params)
 	result := &tree.LoadSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -113,20 +150,22 @@ func (protocol *LayerTreeProtocol) LoadSnapshot(
 }
 
 /*
-MakeSnapshot returns the layer snapshot identifier.
+Returns the layer snapshot identifier.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-makeSnapshot
 */
 func (protocol *LayerTreeProtocol) MakeSnapshot(
 	params *tree.MakeSnapshotParams,
 ) <-chan *tree.MakeSnapshotResult {
-	resultChan := make(chan *tree.MakeSnapshotResult)
+	resultChan := make(chan *tree.MakeSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.makeSnapshot", params)
 	result := &tree.MakeSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -139,20 +178,22 @@ func (protocol *LayerTreeProtocol) MakeSnapshot(
 }
 
 /*
-ProfileSnapshot profiles a snapshot.
+ProfileSnapshot sends the LayerTree.profileSnapshot command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-profileSnapshot
 */
 func (protocol *LayerTreeProtocol) ProfileSnapshot(
 	params *tree.ProfileSnapshotParams,
 ) <-chan *tree.ProfileSnapshotResult {
-	resultChan := make(chan *tree.ProfileSnapshotResult)
+	resultChan := make(chan *tree.ProfileSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.profileSnapshot", params)
 	result := &tree.ProfileSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -165,20 +206,22 @@ func (protocol *LayerTreeProtocol) ProfileSnapshot(
 }
 
 /*
-ReleaseSnapshot releases layer snapshot captured by the back-end.
+Releases layer snapshot captured by the back-end.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-releaseSnapshot
 */
 func (protocol *LayerTreeProtocol) ReleaseSnapshot(
 	params *tree.ReleaseSnapshotParams,
 ) <-chan *tree.ReleaseSnapshotResult {
-	resultChan := make(chan *tree.ReleaseSnapshotResult)
+	resultChan := make(chan *tree.ReleaseSnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.releaseSnapshot", params)
 	result := &tree.ReleaseSnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -189,20 +232,22 @@ func (protocol *LayerTreeProtocol) ReleaseSnapshot(
 }
 
 /*
-ReplaySnapshot replays the layer snapshot and returns the resulting bitmap.
+Replays the layer snapshot and returns the resulting bitmap.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-replaySnapshot
 */
 func (protocol *LayerTreeProtocol) ReplaySnapshot(
 	params *tree.ReplaySnapshotParams,
 ) <-chan *tree.ReplaySnapshotResult {
-	resultChan := make(chan *tree.ReplaySnapshotResult)
+	resultChan := make(chan *tree.ReplaySnapshotResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.replaySnapshot", params)
 	result := &tree.ReplaySnapshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -215,20 +260,22 @@ func (protocol *LayerTreeProtocol) ReplaySnapshot(
 }
 
 /*
-SnapshotCommandLog replays the layer snapshot and returns canvas log.
+Replays the layer snapshot and returns canvas log.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#method-snapshotCommandLog
 */
 func (protocol *LayerTreeProtocol) SnapshotCommandLog(
 	params *tree.SnapshotCommandLogParams,
 ) <-chan *tree.SnapshotCommandLogResult {
-	resultChan := make(chan *tree.SnapshotCommandLogResult)
+	resultChan := make(chan *tree.SnapshotCommandLogResult, 1)
 	command := NewCommand(protocol.Socket, "LayerTree.snapshotCommandLog", params)
 	result := &tree.SnapshotCommandLogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -241,14 +288,13 @@ func (protocol *LayerTreeProtocol) SnapshotCommandLog(
 }
 
 /*
-OnLayerPainted adds a handler to the LayerTree.layerPainted event. LayerTree.layerPainted
-fires when the layer is painted.
+OnLayerPainted adds a handler to the LayerTree.layerPainted event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerPainted
 */
 func (protocol *LayerTreeProtocol) OnLayerPainted(
 	callback func(event *tree.LayerPaintedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"LayerTree.layerPainted",
 		func(response *Response) {
@@ -261,17 +307,17 @@ func (protocol *LayerTreeProtocol) OnLayerPainted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnLayerTreeDidChange adds a handler to the LayerTree.DidChange event.
-LayerTree.DidChange fires when the layer tree changes.
+OnLayerTreeDidChange adds a handler to the LayerTree.layerTreeDidChange event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/LayerTree/#event-layerTreeDidChange
 */
 func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 	callback func(event *tree.DidChangeEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"LayerTree.layerTreeDidChange",
 		func(response *Response) {
@@ -284,4 +330,5 @@ func (protocol *LayerTreeProtocol) OnLayerTreeDidChange(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/layer/tree"
 )
@@ -215,8 +216,8 @@ func TestLayerTreeProfileSnapshot(t *testing.T) {
 
 	params := &tree.ProfileSnapshotParams{
 		SnapshotID:     tree.SnapshotID("snapshot-id"),
-		MinRepeatCount: 1,
-		MinDuration:    1,
+		MinRepeatCount: optional.Int(1),
+		MinDuration:    optional.Float64(1),
 		ClipRect: &dom.Rect{
 			X:      1,
 			Y:      1,
@@ -226,7 +227,7 @@ func TestLayerTreeProfileSnapshot(t *testing.T) {
 	}
 	resultChan := mockSocket.LayerTree().ProfileSnapshot(params)
 	mockResult := &tree.ProfileSnapshotResult{
-		Timings: []*tree.PaintProfile{},
+		Timings: []tree.PaintProfile{},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
@@ -299,9 +300,9 @@ func TestLayerTreeReplaySnapshot(t *testing.T) {
 
 	params := &tree.ReplaySnapshotParams{
 		SnapshotID: tree.SnapshotID("snapshot-id"),
-		FromStep:   1,
-		ToStep:     2,
-		Scale:      1,
+		FromStep:   optional.Int(1),
+		ToStep:     optional.Int(2),
+		Scale:      optional.Float64(1),
 	}
 	resultChan := mockSocket.LayerTree().ReplaySnapshot(params)
 	mockResult := &tree.ReplaySnapshotResult{
@@ -347,7 +348,7 @@ func TestLayerTreeSnapshotCommandLog(t *testing.T) {
 	}
 	resultChan := mockSocket.LayerTree().SnapshotCommandLog(params)
 	mockResult := &tree.SnapshotCommandLogResult{
-		CommandLog: []map[string]string{{"command1": "log1"}},
+		CommandLog: []map[string]interface{}{{"command1": "log1"}},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
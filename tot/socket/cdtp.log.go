@@ -1,34 +1,64 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/log"
 )
 
 /*
-LogProtocol provides a namespace for the Chrome Log protocol methods. The Log
-protocol provides access to log entries.
+LogProtocol provides a namespace for the Chrome Log protocol methods.
+
+Provides access to log entries.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/
 */
 type LogProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-Clear clears the log.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *LogProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *LogProtocol) WithContext(ctx context.Context) *LogProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &LogProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Clears the log.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-clear
 */
 func (protocol *LogProtocol) Clear() <-chan *log.ClearResult {
-	resultChan := make(chan *log.ClearResult)
+	resultChan := make(chan *log.ClearResult, 1)
 	command := NewCommand(protocol.Socket, "Log.clear", nil)
 	result := &log.ClearResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,19 +69,21 @@ func (protocol *LogProtocol) Clear() <-chan *log.ClearResult {
 }
 
 /*
-Disable disables log domain, prevents further log entries from being reported to
-the client.
+Disables log domain, prevents further log entries from being reported to the
+client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-disable
 */
 func (protocol *LogProtocol) Disable() <-chan *log.DisableResult {
-	resultChan := make(chan *log.DisableResult)
+	resultChan := make(chan *log.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Log.disable", nil)
 	result := &log.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,19 +94,21 @@ func (protocol *LogProtocol) Disable() <-chan *log.DisableResult {
 }
 
 /*
-Enable enables log domain, sends the entries collected so far to the client by
-means of the `entryAdded` notification.
+Enables log domain, sends the entries collected so far to the client by means of
+the `entryAdded` notification.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-enable
 */
 func (protocol *LogProtocol) Enable() <-chan *log.EnableResult {
-	resultChan := make(chan *log.EnableResult)
+	resultChan := make(chan *log.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Log.enable", nil)
 	result := &log.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -85,20 +119,22 @@ func (protocol *LogProtocol) Enable() <-chan *log.EnableResult {
 }
 
 /*
-StartViolationsReport starts violation reporting.
+start violation reporting.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-startViolationsReport
 */
 func (protocol *LogProtocol) StartViolationsReport(
 	params *log.StartViolationsReportParams,
 ) <-chan *log.StartViolationsReportResult {
-	resultChan := make(chan *log.StartViolationsReportResult)
+	resultChan := make(chan *log.StartViolationsReportResult, 1)
 	command := NewCommand(protocol.Socket, "Log.startViolationsReport", params)
 	result := &log.StartViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -109,18 +145,20 @@ func (protocol *LogProtocol) StartViolationsReport(
 }
 
 /*
-StopViolationsReport stops violation reporting.
+Stop violation reporting.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#method-stopViolationsReport
 */
 func (protocol *LogProtocol) StopViolationsReport() <-chan *log.StopViolationsReportResult {
-	resultChan := make(chan *log.StopViolationsReportResult)
+	resultChan := make(chan *log.StopViolationsReportResult, 1)
 	command := NewCommand(protocol.Socket, "Log.stopViolationsReport", nil)
 	result := &log.StopViolationsReportResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -131,14 +169,15 @@ func (protocol *LogProtocol) StopViolationsReport() <-chan *log.StopViolationsRe
 }
 
 /*
-OnEntryAdded adds a handler to the Log.entryAdded event. Log.entryAdded fires
-when a new message is logged.
+OnEntryAdded adds a handler to the Log.entryAdded event.
+
+Issued when new message was logged.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Log/#event-entryAdded
 */
 func (protocol *LogProtocol) OnEntryAdded(
 	callback func(event *log.EntryAddedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Log.entryAdded",
 		func(response *Response) {
@@ -151,4 +190,5 @@ func (protocol *LogProtocol) OnEntryAdded(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -0,0 +1,223 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/media"
+)
+
+/*
+MediaProtocol provides a namespace for the Chrome Media protocol methods.
+
+This domain allows detailed inspection of media elements EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/
+*/
+type MediaProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *MediaProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *MediaProtocol) WithContext(ctx context.Context) *MediaProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &MediaProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enables the Media domain
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#method-enable
+*/
+func (protocol *MediaProtocol) Enable() <-chan *media.EnableResult {
+	resultChan := make(chan *media.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Media.enable", nil)
+	result := &media.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables the Media domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#method-disable
+*/
+func (protocol *MediaProtocol) Disable() <-chan *media.DisableResult {
+	resultChan := make(chan *media.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Media.disable", nil)
+	result := &media.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnPlayerPropertiesChanged adds a handler to the Media.playerPropertiesChanged
+event.
+
+This can be called multiple times, and can be used to set / override / remove
+player properties. A null propValue indicates removal.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerPropertiesChanged
+*/
+func (protocol *MediaProtocol) OnPlayerPropertiesChanged(
+	callback func(event *media.PlayerPropertiesChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Media.playerPropertiesChanged",
+		func(response *Response) {
+			event := &media.PlayerPropertiesChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPlayerEventsAdded adds a handler to the Media.playerEventsAdded event.
+
+Send events as a list, allowing them to be batched on the browser for less
+congestion. If batched, events must ALWAYS be in chronological order.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerEventsAdded
+*/
+func (protocol *MediaProtocol) OnPlayerEventsAdded(
+	callback func(event *media.PlayerEventsAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Media.playerEventsAdded",
+		func(response *Response) {
+			event := &media.PlayerEventsAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPlayerMessagesLogged adds a handler to the Media.playerMessagesLogged event.
+
+Send a list of any messages that need to be delivered.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerMessagesLogged
+*/
+func (protocol *MediaProtocol) OnPlayerMessagesLogged(
+	callback func(event *media.PlayerMessagesLoggedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Media.playerMessagesLogged",
+		func(response *Response) {
+			event := &media.PlayerMessagesLoggedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPlayerErrorsRaised adds a handler to the Media.playerErrorsRaised event.
+
+Send a list of any errors that need to be delivered.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playerErrorsRaised
+*/
+func (protocol *MediaProtocol) OnPlayerErrorsRaised(
+	callback func(event *media.PlayerErrorsRaisedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Media.playerErrorsRaised",
+		func(response *Response) {
+			event := &media.PlayerErrorsRaisedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPlayersCreated adds a handler to the Media.playersCreated event.
+
+Called whenever a player is created, or when a new agent joins and receives a
+list of active players. If an agent is restored, it will receive the full list
+of player ids and all events again.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Media/#event-playersCreated
+*/
+func (protocol *MediaProtocol) OnPlayersCreated(
+	callback func(event *media.PlayersCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Media.playersCreated",
+		func(response *Response) {
+			event := &media.PlayersCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -1,35 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/mkenney/go-chrome/tot/memory"
 )
 
 /*
 MemoryProtocol provides a namespace for the Chrome Memory protocol methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Memory/ EXPERIMENTAL.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/
 */
 type MemoryProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-GetDOMCounters is experimental.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *MemoryProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *MemoryProtocol) WithContext(ctx context.Context) *MemoryProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &MemoryProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Retruns current DOM object counters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCounters
-EXPERIMENTAL.
 */
 func (protocol *MemoryProtocol) GetDOMCounters(
 	params *memory.GetDOMCountersParams,
 ) <-chan *memory.GetDOMCountersResult {
-	resultChan := make(chan *memory.GetDOMCountersResult)
+	resultChan := make(chan *memory.GetDOMCountersResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.getDOMCounters", params)
 	result := &memory.GetDOMCountersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -39,19 +73,47 @@ func (protocol *MemoryProtocol) GetDOMCounters(
 }
 
 /*
-PrepareForLeakDetection experimental
+Retruns DOM object counters after preparing renderer for leak detection.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getDOMCountersForLeakDetection
+*/
+func (protocol *MemoryProtocol) GetDOMCountersForLeakDetection() <-chan *memory.GetDOMCountersForLeakDetectionResult {
+	resultChan := make(chan *memory.GetDOMCountersForLeakDetectionResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.getDOMCountersForLeakDetection", nil)
+	result := &memory.GetDOMCountersForLeakDetectionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Prepares for leak detection by terminating workers, stopping spellcheckers,
+dropping non-essential internal caches, running garbage collections, etc.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-prepareForLeakDetection
-EXPERIMENTAL.
 */
 func (protocol *MemoryProtocol) PrepareForLeakDetection() <-chan *memory.PrepareForLeakDetectionResult {
-	resultChan := make(chan *memory.PrepareForLeakDetectionResult)
+	resultChan := make(chan *memory.PrepareForLeakDetectionResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.prepareForLeakDetection", nil)
 	result := &memory.PrepareForLeakDetectionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,21 +124,46 @@ func (protocol *MemoryProtocol) PrepareForLeakDetection() <-chan *memory.Prepare
 }
 
 /*
-SetPressureNotificationsSuppressed enables/disables suppressing memory pressure
-notifications in all processes.
+Simulate OomIntervention by purging V8 memory.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-forciblyPurgeJavaScriptMemory
+*/
+func (protocol *MemoryProtocol) ForciblyPurgeJavaScriptMemory() <-chan *memory.ForciblyPurgeJavaScriptMemoryResult {
+	resultChan := make(chan *memory.ForciblyPurgeJavaScriptMemoryResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.forciblyPurgeJavaScriptMemory", nil)
+	result := &memory.ForciblyPurgeJavaScriptMemoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable/disable suppressing memory pressure notifications in all processes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-setPressureNotificationsSuppressed
 */
 func (protocol *MemoryProtocol) SetPressureNotificationsSuppressed(
 	params *memory.SetPressureNotificationsSuppressedParams,
 ) <-chan *memory.SetPressureNotificationsSuppressedResult {
-	resultChan := make(chan *memory.SetPressureNotificationsSuppressedResult)
+	resultChan := make(chan *memory.SetPressureNotificationsSuppressedResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.setPressureNotificationsSuppressed", params)
 	result := &memory.SetPressureNotificationsSuppressedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -87,22 +174,159 @@ func (protocol *MemoryProtocol) SetPressureNotificationsSuppressed(
 }
 
 /*
-SimulatePressureNotification simulates a memory pressure notification in all
-processes.
+Simulate a memory pressure notification in all processes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-simulatePressureNotification
 */
 func (protocol *MemoryProtocol) SimulatePressureNotification(
 	params *memory.SimulatePressureNotificationParams,
 ) <-chan *memory.SimulatePressureNotificationResult {
-	resultChan := make(chan *memory.SimulatePressureNotificationResult)
+	resultChan := make(chan *memory.SimulatePressureNotificationResult, 1)
 	command := NewCommand(protocol.Socket, "Memory.simulatePressureNotification", params)
 	result := &memory.SimulatePressureNotificationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Start collecting native memory profile.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-startSampling
+*/
+func (protocol *MemoryProtocol) StartSampling(
+	params ...*memory.StartSamplingParams,
+) <-chan *memory.StartSamplingResult {
+	resultChan := make(chan *memory.StartSamplingResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.startSampling", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Memory.startSampling", params[0])
+	}
+	result := &memory.StartSamplingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stop collecting native memory profile.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-stopSampling
+*/
+func (protocol *MemoryProtocol) StopSampling() <-chan *memory.StopSamplingResult {
+	resultChan := make(chan *memory.StopSamplingResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.stopSampling", nil)
+	result := &memory.StopSamplingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieve native memory allocations profile collected since renderer process
+startup.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getAllTimeSamplingProfile
+*/
+func (protocol *MemoryProtocol) GetAllTimeSamplingProfile() <-chan *memory.GetAllTimeSamplingProfileResult {
+	resultChan := make(chan *memory.GetAllTimeSamplingProfileResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.getAllTimeSamplingProfile", nil)
+	result := &memory.GetAllTimeSamplingProfileResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieve native memory allocations profile collected since browser process
+startup.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getBrowserSamplingProfile
+*/
+func (protocol *MemoryProtocol) GetBrowserSamplingProfile() <-chan *memory.GetBrowserSamplingProfileResult {
+	resultChan := make(chan *memory.GetBrowserSamplingProfileResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.getBrowserSamplingProfile", nil)
+	result := &memory.GetBrowserSamplingProfileResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieve native memory allocations profile collected since last `startSampling`
+call.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Memory/#method-getSamplingProfile
+*/
+func (protocol *MemoryProtocol) GetSamplingProfile() <-chan *memory.GetSamplingProfileResult {
+	resultChan := make(chan *memory.GetSamplingProfileResult, 1)
+	command := NewCommand(protocol.Socket, "Memory.getSamplingProfile", nil)
+	result := &memory.GetSamplingProfileResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/network"
@@ -8,7 +11,8 @@ import (
 
 /*
 NetworkProtocol provides a namespace for the Chrome Network protocol methods.
-The Network protocol allows tracking network activities of the page. It exposes
+
+Network domain allows tracking network activities of the page. It exposes
 information about http, file, data and other requests and responses, their
 headers, bodies, timing, etc.
 
@@ -16,22 +20,98 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/
 */
 type NetworkProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *NetworkProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *NetworkProtocol) WithContext(ctx context.Context) *NetworkProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &NetworkProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Sets a list of content encodings that will be accepted. Empty list means no
+encoding is accepted. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAcceptedEncodings
+*/
+func (protocol *NetworkProtocol) SetAcceptedEncodings(
+	params *network.SetAcceptedEncodingsParams,
+) <-chan *network.SetAcceptedEncodingsResult {
+	resultChan := make(chan *network.SetAcceptedEncodingsResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setAcceptedEncodings", params)
+	result := &network.SetAcceptedEncodingsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears accepted encodings set by setAcceptedEncodings EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearAcceptedEncodingsOverride
+*/
+func (protocol *NetworkProtocol) ClearAcceptedEncodingsOverride() <-chan *network.ClearAcceptedEncodingsOverrideResult {
+	resultChan := make(chan *network.ClearAcceptedEncodingsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Network.clearAcceptedEncodingsOverride", nil)
+	result := &network.ClearAcceptedEncodingsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
 }
 
 /*
-CanClearBrowserCache tells whether clearing browser cache is supported.
+Tells whether clearing browser cache is supported. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearBrowserCache
-DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCache() <-chan *network.CanClearBrowserCacheResult {
-	resultChan := make(chan *network.CanClearBrowserCacheResult)
+	resultChan := make(chan *network.CanClearBrowserCacheResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCache", nil)
 	result := &network.CanClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -44,19 +124,20 @@ func (protocol *NetworkProtocol) CanClearBrowserCache() <-chan *network.CanClear
 }
 
 /*
-CanClearBrowserCookies tells whether clearing browser cookies is supported.
+Tells whether clearing browser cookies is supported. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canClearBrowserCookies
-DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanClearBrowserCookies() <-chan *network.CanClearBrowserCookiesResult {
-	resultChan := make(chan *network.CanClearBrowserCookiesResult)
+	resultChan := make(chan *network.CanClearBrowserCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canClearBrowserCookies", nil)
 	result := &network.CanClearBrowserCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -69,19 +150,20 @@ func (protocol *NetworkProtocol) CanClearBrowserCookies() <-chan *network.CanCle
 }
 
 /*
-CanEmulateConditions tells whether emulation of network conditions is supported.
+Tells whether emulation of network conditions is supported. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-canEmulateNetworkConditions
-DEPRECATED.
 */
 func (protocol *NetworkProtocol) CanEmulateConditions() <-chan *network.CanEmulateConditionsResult {
-	resultChan := make(chan *network.CanEmulateConditionsResult)
+	resultChan := make(chan *network.CanEmulateConditionsResult, 1)
 	command := NewCommand(protocol.Socket, "Network.canEmulateNetworkConditions", nil)
 	result := &network.CanEmulateConditionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -94,18 +176,20 @@ func (protocol *NetworkProtocol) CanEmulateConditions() <-chan *network.CanEmula
 }
 
 /*
-ClearBrowserCache clears browser cache.
+Clears browser cache.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCache
 */
 func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowserCacheResult {
-	resultChan := make(chan *network.ClearBrowserCacheResult)
+	resultChan := make(chan *network.ClearBrowserCacheResult, 1)
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCache", nil)
 	result := &network.ClearBrowserCacheResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -116,18 +200,20 @@ func (protocol *NetworkProtocol) ClearBrowserCache() <-chan *network.ClearBrowse
 }
 
 /*
-ClearBrowserCookies clears browser cookies.
+Clears browser cookies.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-clearBrowserCookies
 */
 func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrowserCookiesResult {
-	resultChan := make(chan *network.ClearBrowserCookiesResult)
-	result := &network.ClearBrowserCookiesResult{}
+	resultChan := make(chan *network.ClearBrowserCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.clearBrowserCookies", nil)
+	result := &network.ClearBrowserCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -138,25 +224,27 @@ func (protocol *NetworkProtocol) ClearBrowserCookies() <-chan *network.ClearBrow
 }
 
 /*
-ContinueInterceptedRequest response to Network.requestIntercepted which either
-modifies the request to continue with any modifications, or blocks it, or
-completes it with the provided response bytes. If a network fetch occurs as a
-result which encounters a redirect an additional Network.requestIntercepted
-event will be sent with the same InterceptionID.
+Response to Network.requestIntercepted which either modifies the request to
+continue with any modifications, or blocks it, or completes it with the provided
+response bytes. If a network fetch occurs as a result which encounters a
+redirect an additional Network.requestIntercepted event will be sent with the
+same InterceptionId. Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest
+and Fetch.failRequest instead. EXPERIMENTAL. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-continueInterceptedRequest
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) ContinueInterceptedRequest(
 	params *network.ContinueInterceptedRequestParams,
 ) <-chan *network.ContinueInterceptedRequestResult {
-	resultChan := make(chan *network.ContinueInterceptedRequestResult)
-	result := &network.ContinueInterceptedRequestResult{}
+	resultChan := make(chan *network.ContinueInterceptedRequestResult, 1)
 	command := NewCommand(protocol.Socket, "Network.continueInterceptedRequest", params)
+	result := &network.ContinueInterceptedRequestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -167,7 +255,7 @@ func (protocol *NetworkProtocol) ContinueInterceptedRequest(
 }
 
 /*
-DeleteCookies deletes browser cookies with matching name and url or domain/path
+Deletes browser cookies with matching name and url or domain/path/partitionKey
 pair.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-deleteCookies
@@ -175,13 +263,15 @@ https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-deleteCoo
 func (protocol *NetworkProtocol) DeleteCookies(
 	params *network.DeleteCookiesParams,
 ) <-chan *network.DeleteCookiesResult {
-	resultChan := make(chan *network.DeleteCookiesResult)
-	result := &network.DeleteCookiesResult{}
+	resultChan := make(chan *network.DeleteCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.deleteCookies", params)
+	result := &network.DeleteCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -192,19 +282,21 @@ func (protocol *NetworkProtocol) DeleteCookies(
 }
 
 /*
-Disable disables network tracking, prevents network events from being sent to
-the client.
+Disables network tracking, prevents network events from being sent to the
+client.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-disable
 */
 func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
-	resultChan := make(chan *network.DisableResult)
-	result := &network.DisableResult{}
+	resultChan := make(chan *network.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Network.disable", nil)
+	result := &network.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -215,20 +307,22 @@ func (protocol *NetworkProtocol) Disable() <-chan *network.DisableResult {
 }
 
 /*
-EmulateConditions activates emulation of network conditions.
+Activates emulation of network conditions.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-emulateNetworkConditions
 */
 func (protocol *NetworkProtocol) EmulateConditions(
 	params *network.EmulateConditionsParams,
 ) <-chan *network.EmulateConditionsResult {
-	resultChan := make(chan *network.EmulateConditionsResult)
-	result := &network.EmulateConditionsResult{}
+	resultChan := make(chan *network.EmulateConditionsResult, 1)
 	command := NewCommand(protocol.Socket, "Network.emulateNetworkConditions", params)
+	result := &network.EmulateConditionsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -239,21 +333,27 @@ func (protocol *NetworkProtocol) EmulateConditions(
 }
 
 /*
-Enable enables network tracking, network events will now be delivered to the
-client.
+Enables network tracking, network events will now be delivered to the client.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enable
 */
 func (protocol *NetworkProtocol) Enable(
-	params *network.EnableParams,
+	params ...*network.EnableParams,
 ) <-chan *network.EnableResult {
-	resultChan := make(chan *network.EnableResult)
+	resultChan := make(chan *network.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Network.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Network.enable", params[0])
+	}
 	result := &network.EnableResult{}
-	command := NewCommand(protocol.Socket, "Network.enable", params)
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -264,19 +364,22 @@ func (protocol *NetworkProtocol) Enable(
 }
 
 /*
-GetAllCookies returns all browser cookies. Depending on the backend support,
-will return detailed cookie information in the `cookies` field.
+Returns all browser cookies. Depending on the backend support, will return
+detailed cookie information in the `cookies` field. Deprecated. Use
+Storage.getCookies instead. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getAllCookies
 */
 func (protocol *NetworkProtocol) GetAllCookies() <-chan *network.GetAllCookiesResult {
-	resultChan := make(chan *network.GetAllCookiesResult)
+	resultChan := make(chan *network.GetAllCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getAllCookies", nil)
 	result := &network.GetAllCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -289,21 +392,22 @@ func (protocol *NetworkProtocol) GetAllCookies() <-chan *network.GetAllCookiesRe
 }
 
 /*
-GetCertificate returns the DER-encoded certificate.
+Returns the DER-encoded certificate. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCertificate
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) GetCertificate(
 	params *network.GetCertificateParams,
 ) <-chan *network.GetCertificateResult {
-	resultChan := make(chan *network.GetCertificateResult)
+	resultChan := make(chan *network.GetCertificateResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getCertificate", params)
 	result := &network.GetCertificateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -316,21 +420,28 @@ func (protocol *NetworkProtocol) GetCertificate(
 }
 
 /*
-GetCookies returns all browser cookies for the current URL. Depending on the
-backend support, will return detailed cookie information in the `cookies` field.
+Returns all browser cookies for the current URL. Depending on the backend
+support, will return detailed cookie information in the `cookies` field.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getCookies
 */
 func (protocol *NetworkProtocol) GetCookies(
-	params *network.GetCookiesParams,
+	params ...*network.GetCookiesParams,
 ) <-chan *network.GetCookiesResult {
-	resultChan := make(chan *network.GetCookiesResult)
-	command := NewCommand(protocol.Socket, "Network.getCookies", params)
+	resultChan := make(chan *network.GetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getCookies", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Network.getCookies", params[0])
+	}
 	result := &network.GetCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -343,20 +454,22 @@ func (protocol *NetworkProtocol) GetCookies(
 }
 
 /*
-GetResponseBody returns content served for the given request.
+Returns content served for the given request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBody
 */
 func (protocol *NetworkProtocol) GetResponseBody(
 	params *network.GetResponseBodyParams,
 ) <-chan *network.GetResponseBodyResult {
-	resultChan := make(chan *network.GetResponseBodyResult)
+	resultChan := make(chan *network.GetResponseBodyResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getResponseBody", params)
 	result := &network.GetResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -369,22 +482,83 @@ func (protocol *NetworkProtocol) GetResponseBody(
 }
 
 /*
-GetResponseBodyForInterception returns content served for the given currently
-intercepted request.
+Returns post data sent with the request. Returns an error when no data was sent
+with the request.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBodyForInterception
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getRequestPostData
+*/
+func (protocol *NetworkProtocol) GetRequestPostData(
+	params *network.GetRequestPostDataParams,
+) <-chan *network.GetRequestPostDataResult {
+	resultChan := make(chan *network.GetRequestPostDataResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getRequestPostData", params)
+	result := &network.GetRequestPostDataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns content served for the given currently intercepted request.
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getResponseBodyForInterception
 */
 func (protocol *NetworkProtocol) GetResponseBodyForInterception(
 	params *network.GetResponseBodyForInterceptionParams,
 ) <-chan *network.GetResponseBodyForInterceptionResult {
-	resultChan := make(chan *network.GetResponseBodyForInterceptionResult)
+	resultChan := make(chan *network.GetResponseBodyForInterceptionResult, 1)
 	command := NewCommand(protocol.Socket, "Network.getResponseBodyForInterception", params)
 	result := &network.GetResponseBodyForInterceptionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns a handle to the stream representing the response body. Note that after
+this command, the intercepted request can't be continued as is -- you either
+need to cancel it or to provide the response body. The stream only supports
+sequential read, IO.read will fail if the position is specified. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-takeResponseBodyForInterceptionAsStream
+*/
+func (protocol *NetworkProtocol) TakeResponseBodyForInterceptionAsStream(
+	params *network.TakeResponseBodyForInterceptionAsStreamParams,
+) <-chan *network.TakeResponseBodyForInterceptionAsStreamResult {
+	resultChan := make(chan *network.TakeResponseBodyForInterceptionAsStreamResult, 1)
+	command := NewCommand(protocol.Socket, "Network.takeResponseBodyForInterceptionAsStream", params)
+	result := &network.TakeResponseBodyForInterceptionAsStreamResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -397,22 +571,24 @@ func (protocol *NetworkProtocol) GetResponseBodyForInterception(
 }
 
 /*
-ReplayXHR sends a new XMLHttpRequest which is identical to the original one. The
-following parameters should be identical: method, url, async, request body,
-extra headers, withCredentials attribute, user, password.
+This method sends a new XMLHttpRequest which is identical to the original one.
+The following parameters should be identical: method, url, async, request body,
+extra headers, withCredentials attribute, user, password. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-replayXHR EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-replayXHR
 */
 func (protocol *NetworkProtocol) ReplayXHR(
 	params *network.ReplayXHRParams,
 ) <-chan *network.ReplayXHRResult {
-	resultChan := make(chan *network.ReplayXHRResult)
-	result := &network.ReplayXHRResult{}
+	resultChan := make(chan *network.ReplayXHRResult, 1)
 	command := NewCommand(protocol.Socket, "Network.replayXHR", params)
+	result := &network.ReplayXHRResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -423,21 +599,22 @@ func (protocol *NetworkProtocol) ReplayXHR(
 }
 
 /*
-SearchInResponseBody searches for given string in response content.
+Searches for given string in response content. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-searchInResponseBody
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SearchInResponseBody(
 	params *network.SearchInResponseBodyParams,
 ) <-chan *network.SearchInResponseBodyResult {
-	resultChan := make(chan *network.SearchInResponseBodyResult)
+	resultChan := make(chan *network.SearchInResponseBodyResult, 1)
 	command := NewCommand(protocol.Socket, "Network.searchInResponseBody", params)
 	result := &network.SearchInResponseBodyResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -450,21 +627,22 @@ func (protocol *NetworkProtocol) SearchInResponseBody(
 }
 
 /*
-SetBlockedURLs blocks URLs from loading.
+Blocks URLs from loading. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setBlockedURLs
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetBlockedURLs(
 	params *network.SetBlockedURLsParams,
 ) <-chan *network.SetBlockedURLsResult {
-	resultChan := make(chan *network.SetBlockedURLsResult)
-	result := &network.SetBlockedURLsResult{}
+	resultChan := make(chan *network.SetBlockedURLsResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setBlockedURLs", params)
+	result := &network.SetBlockedURLsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -475,21 +653,22 @@ func (protocol *NetworkProtocol) SetBlockedURLs(
 }
 
 /*
-SetBypassServiceWorker toggles ignoring of service worker for each request.
+Toggles ignoring of service worker for each request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setBypassServiceWorker
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetBypassServiceWorker(
 	params *network.SetBypassServiceWorkerParams,
 ) <-chan *network.SetBypassServiceWorkerResult {
-	resultChan := make(chan *network.SetBypassServiceWorkerResult)
-	result := &network.SetBypassServiceWorkerResult{}
+	resultChan := make(chan *network.SetBypassServiceWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setBypassServiceWorker", params)
+	result := &network.SetBypassServiceWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -500,21 +679,22 @@ func (protocol *NetworkProtocol) SetBypassServiceWorker(
 }
 
 /*
-SetCacheDisabled toggles ignoring cache for each request. If `true`, cache will
-not be used.
+Toggles ignoring cache for each request. If `true`, cache will not be used.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCacheDisabled
 */
 func (protocol *NetworkProtocol) SetCacheDisabled(
 	params *network.SetCacheDisabledParams,
 ) <-chan *network.SetCacheDisabledResult {
-	resultChan := make(chan *network.SetCacheDisabledResult)
-	result := &network.SetCacheDisabledResult{}
+	resultChan := make(chan *network.SetCacheDisabledResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setCacheDisabled", params)
+	result := &network.SetCacheDisabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -525,21 +705,23 @@ func (protocol *NetworkProtocol) SetCacheDisabled(
 }
 
 /*
-SetCookie sets a cookie with the given cookie data; may overwrite equivalent
-cookies if they exist.
+Sets a cookie with the given cookie data; may overwrite equivalent cookies if
+they exist.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookie
 */
 func (protocol *NetworkProtocol) SetCookie(
 	params *network.SetCookieParams,
 ) <-chan *network.SetCookieResult {
-	resultChan := make(chan *network.SetCookieResult)
+	resultChan := make(chan *network.SetCookieResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setCookie", params)
 	result := &network.SetCookieResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -552,20 +734,22 @@ func (protocol *NetworkProtocol) SetCookie(
 }
 
 /*
-SetCookies sets given cookies.
+Sets given cookies.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookies
 */
 func (protocol *NetworkProtocol) SetCookies(
 	params *network.SetCookiesParams,
 ) <-chan *network.SetCookiesResult {
-	resultChan := make(chan *network.SetCookiesResult)
-	result := &network.SetCookiesResult{}
+	resultChan := make(chan *network.SetCookiesResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setCookies", params)
+	result := &network.SetCookiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -576,21 +760,23 @@ func (protocol *NetworkProtocol) SetCookies(
 }
 
 /*
-SetDataSizeLimitsForTest is for testing.
+Specifies whether to always send extra HTTP headers with the requests from this
+page.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
 */
-func (protocol *NetworkProtocol) SetDataSizeLimitsForTest(
-	params *network.SetDataSizeLimitsForTestParams,
-) <-chan *network.SetDataSizeLimitsForTestResult {
-	resultChan := make(chan *network.SetDataSizeLimitsForTestResult)
-	result := &network.SetDataSizeLimitsForTestResult{}
-	command := NewCommand(protocol.Socket, "Network.setDataSizeLimitsForTest", params)
+func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
+	params *network.SetExtraHTTPHeadersParams,
+) <-chan *network.SetExtraHTTPHeadersResult {
+	resultChan := make(chan *network.SetExtraHTTPHeadersResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
+	result := &network.SetExtraHTTPHeadersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -601,21 +787,22 @@ func (protocol *NetworkProtocol) SetDataSizeLimitsForTest(
 }
 
 /*
-SetExtraHTTPHeaders specifies whether to always send extra HTTP headers with the
-requests from this page.
+Specifies whether to attach a page script stack id in requests EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setExtraHTTPHeaders
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setAttachDebugStack
 */
-func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
-	params *network.SetExtraHTTPHeadersParams,
-) <-chan *network.SetExtraHTTPHeadersResult {
-	resultChan := make(chan *network.SetExtraHTTPHeadersResult)
-	result := &network.SetExtraHTTPHeadersResult{}
-	command := NewCommand(protocol.Socket, "Network.setExtraHTTPHeaders", params)
+func (protocol *NetworkProtocol) SetAttachDebugStack(
+	params *network.SetAttachDebugStackParams,
+) <-chan *network.SetAttachDebugStackResult {
+	resultChan := make(chan *network.SetAttachDebugStackResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setAttachDebugStack", params)
+	result := &network.SetAttachDebugStackResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -626,22 +813,24 @@ func (protocol *NetworkProtocol) SetExtraHTTPHeaders(
 }
 
 /*
-SetRequestInterception sets the requests to intercept that match a the provided
-patterns and optionally resource types.
+Sets the requests to intercept that match the provided patterns and optionally
+resource types. Deprecated, please use Fetch.enable instead. EXPERIMENTAL.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setRequestInterception
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) SetRequestInterception(
 	params *network.SetRequestInterceptionParams,
 ) <-chan *network.SetRequestInterceptionResult {
-	resultChan := make(chan *network.SetRequestInterceptionResult)
-	result := &network.SetRequestInterceptionResult{}
+	resultChan := make(chan *network.SetRequestInterceptionResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setRequestInterception", params)
+	result := &network.SetRequestInterceptionResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -652,20 +841,194 @@ func (protocol *NetworkProtocol) SetRequestInterception(
 }
 
 /*
-SetUserAgentOverride allows overriding user agent with the given string.
+Allows overriding user agent with the given string.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setUserAgentOverride
 */
 func (protocol *NetworkProtocol) SetUserAgentOverride(
 	params *network.SetUserAgentOverrideParams,
 ) <-chan *network.SetUserAgentOverrideResult {
-	resultChan := make(chan *network.SetUserAgentOverrideResult)
-	result := &network.SetUserAgentOverrideResult{}
+	resultChan := make(chan *network.SetUserAgentOverrideResult, 1)
 	command := NewCommand(protocol.Socket, "Network.setUserAgentOverride", params)
+	result := &network.SetUserAgentOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables streaming of the response for the given requestId. If enabled, the
+dataReceived event contains the data that was received during streaming.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-streamResourceContent
+*/
+func (protocol *NetworkProtocol) StreamResourceContent(
+	params *network.StreamResourceContentParams,
+) <-chan *network.StreamResourceContentResult {
+	resultChan := make(chan *network.StreamResourceContentResult, 1)
+	command := NewCommand(protocol.Socket, "Network.streamResourceContent", params)
+	result := &network.StreamResourceContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns information about the COEP/COOP isolation status.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-getSecurityIsolationStatus
+*/
+func (protocol *NetworkProtocol) GetSecurityIsolationStatus(
+	params ...*network.GetSecurityIsolationStatusParams,
+) <-chan *network.GetSecurityIsolationStatusResult {
+	resultChan := make(chan *network.GetSecurityIsolationStatusResult, 1)
+	command := NewCommand(protocol.Socket, "Network.getSecurityIsolationStatus", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Network.getSecurityIsolationStatus", params[0])
+	}
+	result := &network.GetSecurityIsolationStatusResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables tracking for the Reporting API, events generated by the Reporting API
+will now be delivered to the client. Enabling triggers 'reportingApiReportAdded'
+for all existing reports. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-enableReportingApi
+*/
+func (protocol *NetworkProtocol) EnableReportingAPI(
+	params *network.EnableReportingAPIParams,
+) <-chan *network.EnableReportingAPIResult {
+	resultChan := make(chan *network.EnableReportingAPIResult, 1)
+	command := NewCommand(protocol.Socket, "Network.enableReportingApi", params)
+	result := &network.EnableReportingAPIResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetches the resource and returns the content. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-loadNetworkResource
+*/
+func (protocol *NetworkProtocol) LoadNetworkResource(
+	params *network.LoadNetworkResourceParams,
+) <-chan *network.LoadNetworkResourceResult {
+	resultChan := make(chan *network.LoadNetworkResourceResult, 1)
+	command := NewCommand(protocol.Socket, "Network.loadNetworkResource", params)
+	result := &network.LoadNetworkResourceResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets Controls for third-party cookie access Page reload is required before the
+new cookie behavior will be observed EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setCookieControls
+*/
+func (protocol *NetworkProtocol) SetCookieControls(
+	params *network.SetCookieControlsParams,
+) <-chan *network.SetCookieControlsResult {
+	resultChan := make(chan *network.SetCookieControlsResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setCookieControls", params)
+	result := &network.SetCookieControlsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Is for testing. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#method-setDataSizeLimitsForTest
+*/
+func (protocol *NetworkProtocol) SetDataSizeLimitsForTest(
+	params *network.SetDataSizeLimitsForTestParams,
+) <-chan *network.SetDataSizeLimitsForTestResult {
+	resultChan := make(chan *network.SetDataSizeLimitsForTestResult, 1)
+	command := NewCommand(protocol.Socket, "Network.setDataSizeLimitsForTest", params)
+	result := &network.SetDataSizeLimitsForTestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -676,14 +1039,15 @@ func (protocol *NetworkProtocol) SetUserAgentOverride(
 }
 
 /*
-OnDataReceived adds a handler to the Network.dataReceived event. Network.dataReceived
-fires when a data chunk was received over the network.
+OnDataReceived adds a handler to the Network.dataReceived event.
+
+Fired when data chunk was received over the network.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-dataReceived
 */
 func (protocol *NetworkProtocol) OnDataReceived(
 	callback func(event *network.DataReceivedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.dataReceived",
 		func(response *Response) {
@@ -696,18 +1060,20 @@ func (protocol *NetworkProtocol) OnDataReceived(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnEventSourceMessageReceived adds a handler to the Network.eventSourceMessageReceived
-event. Network.eventSourceMessageReceived fires when EventSource message is
-received.
+OnEventSourceMessageReceived adds a handler to the
+Network.eventSourceMessageReceived event.
+
+Fired when EventSource message is received.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-eventSourceMessageReceived
 */
 func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
 	callback func(event *network.EventSourceMessageReceivedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.eventSourceMessageReceived",
 		func(response *Response) {
@@ -720,17 +1086,19 @@ func (protocol *NetworkProtocol) OnEventSourceMessageReceived(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnLoadingFailed adds a handler to the Network.loadingFailed event. Network.loadingFailed
-fires when HTTP request has failed to load.
+OnLoadingFailed adds a handler to the Network.loadingFailed event.
+
+Fired when HTTP request has failed to load.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFailed
 */
 func (protocol *NetworkProtocol) OnLoadingFailed(
 	callback func(event *network.LoadingFailedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.loadingFailed",
 		func(response *Response) {
@@ -743,17 +1111,19 @@ func (protocol *NetworkProtocol) OnLoadingFailed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnLoadingFinished adds a handler to the Network.loadingFinished event.
-Network.loadingFinished fires when HTTP request has finished loading.
+
+Fired when HTTP request has finished loading.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-loadingFinished
 */
 func (protocol *NetworkProtocol) OnLoadingFinished(
 	callback func(event *network.LoadingFinishedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.loadingFinished",
 		func(response *Response) {
@@ -766,19 +1136,21 @@ func (protocol *NetworkProtocol) OnLoadingFinished(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnRequestIntercepted adds a handler to the Network.requestIntercepted event.
-Network.requestIntercepted fires when a HTTP request is intercepted and returns
-details, which must be either allowed, blocked, modified or mocked.
+
+Details of an intercepted HTTP request, which must be either allowed, blocked,
+modified or mocked. Deprecated, use Fetch.requestPaused instead. EXPERIMENTAL.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestIntercepted
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) OnRequestIntercepted(
 	callback func(event *network.RequestInterceptedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.requestIntercepted",
 		func(response *Response) {
@@ -791,18 +1163,20 @@ func (protocol *NetworkProtocol) OnRequestIntercepted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnRequestServedFromCache adds a handler to the Network.requestServedFromCache
-event. Network.requestServedFromCache fires when request ended up loading from
-cache.
+event.
+
+Fired if request ended up loading from cache.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestServedFromCache
 */
 func (protocol *NetworkProtocol) OnRequestServedFromCache(
 	callback func(event *network.RequestServedFromCacheEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.requestServedFromCache",
 		func(response *Response) {
@@ -815,17 +1189,19 @@ func (protocol *NetworkProtocol) OnRequestServedFromCache(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnRequestWillBeSent adds a handler to the Network.requestWillBeSent event.
-Network.requestWillBeSent fires when the page is about to send HTTP request.
+
+Fired when page is about to send HTTP request.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSent
 */
 func (protocol *NetworkProtocol) OnRequestWillBeSent(
 	callback func(event *network.RequestWillBeSentEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.requestWillBeSent",
 		func(response *Response) {
@@ -838,19 +1214,20 @@ func (protocol *NetworkProtocol) OnRequestWillBeSent(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnResourceChangedPriority adds a handler to the Network.resourceChangedPriority
-event. Network.resourceChangedPriority fires when resource loading priority is
-changed.
+event.
+
+Fired when resource loading priority is changed EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-resourceChangedPriority
-EXPERIMENTAL.
 */
 func (protocol *NetworkProtocol) OnResourceChangedPriority(
 	callback func(event *network.ResourceChangedPriorityEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.resourceChangedPriority",
 		func(response *Response) {
@@ -863,17 +1240,45 @@ func (protocol *NetworkProtocol) OnResourceChangedPriority(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSignedExchangeReceived adds a handler to the Network.signedExchangeReceived
+event.
+
+Fired when a signed exchange was received over the network EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-signedExchangeReceived
+*/
+func (protocol *NetworkProtocol) OnSignedExchangeReceived(
+	callback func(event *network.SignedExchangeReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.signedExchangeReceived",
+		func(response *Response) {
+			event := &network.SignedExchangeReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnResponseReceived adds a handler to the Network.responseReceived event.
-Network.responseReceived fires when HTTP response is available.
+
+Fired when HTTP response is available.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceived
 */
 func (protocol *NetworkProtocol) OnResponseReceived(
 	callback func(event *network.ResponseReceivedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.responseReceived",
 		func(response *Response) {
@@ -886,17 +1291,19 @@ func (protocol *NetworkProtocol) OnResponseReceived(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnWebSocketClosed adds a handler to the Network.webSocketClosed event.
-Network.webSocketClosed fires when WebSocket is closed.
+
+Fired when WebSocket is closed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketClosed
 */
 func (protocol *NetworkProtocol) OnWebSocketClosed(
 	callback func(event *network.WebSocketClosedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketClosed",
 		func(response *Response) {
@@ -909,17 +1316,19 @@ func (protocol *NetworkProtocol) OnWebSocketClosed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnWebSocketCreated adds a handler to the Network.webSocketCreated event.
-Network.webSocketCreated fires upon WebSocket creation.
+
+Fired upon WebSocket creation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketCreated
 */
 func (protocol *NetworkProtocol) OnWebSocketCreated(
 	callback func(event *network.WebSocketCreatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketCreated",
 		func(response *Response) {
@@ -932,17 +1341,19 @@ func (protocol *NetworkProtocol) OnWebSocketCreated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnWebSocketFrameError adds a handler to the Network.webSocketFrameError event.
-Network.webSocketFrameError fires when a WebSocket frame error occurs.
+
+Fired when WebSocket message error occurs.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameError
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameError(
 	callback func(event *network.WebSocketFrameErrorEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketFrameError",
 		func(response *Response) {
@@ -955,17 +1366,20 @@ func (protocol *NetworkProtocol) OnWebSocketFrameError(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnWebSocketFrameReceived adds a handler to the Network.webSocketFrameReceived
-event. Network.webSocketFrameReceived fires when WebSocket frame is received.
+event.
+
+Fired when WebSocket message is received.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameReceived
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
 	callback func(event *network.WebSocketFrameReceivedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketFrameReceived",
 		func(response *Response) {
@@ -978,17 +1392,19 @@ func (protocol *NetworkProtocol) OnWebSocketFrameReceived(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnWebSocketFrameSent adds a handler to the Network.webSocketFrameSent event.
-Network.webSocketFrameSent fires when WebSocket frame is sent.
+
+Fired when WebSocket message is sent.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketFrameSent
 */
 func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 	callback func(event *network.WebSocketFrameSentEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketFrameSent",
 		func(response *Response) {
@@ -1001,18 +1417,20 @@ func (protocol *NetworkProtocol) OnWebSocketFrameSent(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnWebSocketHandshakeResponseReceived adds a handler to the Network.webSocketHandshakeResponseReceived
-event. Network.webSocketHandshakeResponseReceived fires when WebSocket handshake
-response becomes available.
+OnWebSocketHandshakeResponseReceived adds a handler to the
+Network.webSocketHandshakeResponseReceived event.
+
+Fired when WebSocket handshake response becomes available.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketHandshakeResponseReceived
 */
 func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 	callback func(event *network.WebSocketHandshakeResponseReceivedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketHandshakeResponseReceived",
 		func(response *Response) {
@@ -1025,18 +1443,20 @@ func (protocol *NetworkProtocol) OnWebSocketHandshakeResponseReceived(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnWebSocketWillSendHandshakeRequest adds a handler to the Network.webSocketWillSendHandshakeRequest
-event. Network.webSocketWillSendHandshakeRequest fires when WebSocket is about
-to initiate handshake.
+OnWebSocketWillSendHandshakeRequest adds a handler to the
+Network.webSocketWillSendHandshakeRequest event.
+
+Fired when WebSocket is about to initiate handshake.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webSocketWillSendHandshakeRequest
 */
 func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 	callback func(event *network.WebSocketWillSendHandshakeRequestEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Network.webSocketWillSendHandshakeRequest",
 		func(response *Response) {
@@ -1049,4 +1469,720 @@ func (protocol *NetworkProtocol) OnWebSocketWillSendHandshakeRequest(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportCreated adds a handler to the Network.webTransportCreated event.
+
+Fired upon WebTransport creation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportCreated
+*/
+func (protocol *NetworkProtocol) OnWebTransportCreated(
+	callback func(event *network.WebTransportCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportCreated",
+		func(response *Response) {
+			event := &network.WebTransportCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportConnectionEstablished adds a handler to the
+Network.webTransportConnectionEstablished event.
+
+Fired when WebTransport handshake is finished.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportConnectionEstablished
+*/
+func (protocol *NetworkProtocol) OnWebTransportConnectionEstablished(
+	callback func(event *network.WebTransportConnectionEstablishedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportConnectionEstablished",
+		func(response *Response) {
+			event := &network.WebTransportConnectionEstablishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnWebTransportClosed adds a handler to the Network.webTransportClosed event.
+
+Fired when WebTransport is disposed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-webTransportClosed
+*/
+func (protocol *NetworkProtocol) OnWebTransportClosed(
+	callback func(event *network.WebTransportClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.webTransportClosed",
+		func(response *Response) {
+			event := &network.WebTransportClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketCreated adds a handler to the Network.directTCPSocketCreated
+event.
+
+Fired upon direct_socket.TCPSocket creation. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketCreated
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketCreated(
+	callback func(event *network.DirectTCPSocketCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketCreated",
+		func(response *Response) {
+			event := &network.DirectTCPSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketOpened adds a handler to the Network.directTCPSocketOpened
+event.
+
+Fired when direct_socket.TCPSocket connection is opened. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketOpened
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketOpened(
+	callback func(event *network.DirectTCPSocketOpenedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketOpened",
+		func(response *Response) {
+			event := &network.DirectTCPSocketOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketAborted adds a handler to the Network.directTCPSocketAborted
+event.
+
+Fired when direct_socket.TCPSocket is aborted. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketAborted
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketAborted(
+	callback func(event *network.DirectTCPSocketAbortedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketAborted",
+		func(response *Response) {
+			event := &network.DirectTCPSocketAbortedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketClosed adds a handler to the Network.directTCPSocketClosed
+event.
+
+Fired when direct_socket.TCPSocket is closed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketClosed
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketClosed(
+	callback func(event *network.DirectTCPSocketClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketClosed",
+		func(response *Response) {
+			event := &network.DirectTCPSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketChunkSent adds a handler to the
+Network.directTCPSocketChunkSent event.
+
+Fired when data is sent to tcp direct socket stream. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketChunkSent
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketChunkSent(
+	callback func(event *network.DirectTCPSocketChunkSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketChunkSent",
+		func(response *Response) {
+			event := &network.DirectTCPSocketChunkSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectTCPSocketChunkReceived adds a handler to the
+Network.directTCPSocketChunkReceived event.
+
+Fired when data is received from tcp direct socket stream. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directTCPSocketChunkReceived
+*/
+func (protocol *NetworkProtocol) OnDirectTCPSocketChunkReceived(
+	callback func(event *network.DirectTCPSocketChunkReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directTCPSocketChunkReceived",
+		func(response *Response) {
+			event := &network.DirectTCPSocketChunkReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketCreated adds a handler to the Network.directUDPSocketCreated
+event.
+
+Fired upon direct_socket.UDPSocket creation. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketCreated
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketCreated(
+	callback func(event *network.DirectUDPSocketCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketCreated",
+		func(response *Response) {
+			event := &network.DirectUDPSocketCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketOpened adds a handler to the Network.directUDPSocketOpened
+event.
+
+Fired when direct_socket.UDPSocket connection is opened. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketOpened
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketOpened(
+	callback func(event *network.DirectUDPSocketOpenedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketOpened",
+		func(response *Response) {
+			event := &network.DirectUDPSocketOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketAborted adds a handler to the Network.directUDPSocketAborted
+event.
+
+Fired when direct_socket.UDPSocket is aborted. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketAborted
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketAborted(
+	callback func(event *network.DirectUDPSocketAbortedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketAborted",
+		func(response *Response) {
+			event := &network.DirectUDPSocketAbortedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketClosed adds a handler to the Network.directUDPSocketClosed
+event.
+
+Fired when direct_socket.UDPSocket is closed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketClosed
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketClosed(
+	callback func(event *network.DirectUDPSocketClosedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketClosed",
+		func(response *Response) {
+			event := &network.DirectUDPSocketClosedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketChunkSent adds a handler to the
+Network.directUDPSocketChunkSent event.
+
+Fired when message is sent to udp direct socket stream. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketChunkSent
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketChunkSent(
+	callback func(event *network.DirectUDPSocketChunkSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketChunkSent",
+		func(response *Response) {
+			event := &network.DirectUDPSocketChunkSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDirectUDPSocketChunkReceived adds a handler to the
+Network.directUDPSocketChunkReceived event.
+
+Fired when message is received from udp direct socket stream. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-directUDPSocketChunkReceived
+*/
+func (protocol *NetworkProtocol) OnDirectUDPSocketChunkReceived(
+	callback func(event *network.DirectUDPSocketChunkReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.directUDPSocketChunkReceived",
+		func(response *Response) {
+			event := &network.DirectUDPSocketChunkReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnRequestWillBeSentExtraInfo adds a handler to the
+Network.requestWillBeSentExtraInfo event.
+
+Fired when additional information about a requestWillBeSent event is available
+from the network stack. Not every requestWillBeSent event will have an
+additional requestWillBeSentExtraInfo fired for it, and there is no guarantee
+whether requestWillBeSent or requestWillBeSentExtraInfo will be fired first for
+the same request. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-requestWillBeSentExtraInfo
+*/
+func (protocol *NetworkProtocol) OnRequestWillBeSentExtraInfo(
+	callback func(event *network.RequestWillBeSentExtraInfoEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.requestWillBeSentExtraInfo",
+		func(response *Response) {
+			event := &network.RequestWillBeSentExtraInfoEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceivedExtraInfo adds a handler to the
+Network.responseReceivedExtraInfo event.
+
+Fired when additional information about a responseReceived event is available
+from the network stack. Not every responseReceived event will have an additional
+responseReceivedExtraInfo for it, and responseReceivedExtraInfo may be fired
+before or after responseReceived. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceivedExtraInfo
+*/
+func (protocol *NetworkProtocol) OnResponseReceivedExtraInfo(
+	callback func(event *network.ResponseReceivedExtraInfoEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.responseReceivedExtraInfo",
+		func(response *Response) {
+			event := &network.ResponseReceivedExtraInfoEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnResponseReceivedEarlyHints adds a handler to the
+Network.responseReceivedEarlyHints event.
+
+Fired when 103 Early Hints headers is received in addition to the common
+response. Not every responseReceived event will have an
+responseReceivedEarlyHints fired. Only one responseReceivedEarlyHints may be
+fired for eached responseReceived event. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-responseReceivedEarlyHints
+*/
+func (protocol *NetworkProtocol) OnResponseReceivedEarlyHints(
+	callback func(event *network.ResponseReceivedEarlyHintsEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.responseReceivedEarlyHints",
+		func(response *Response) {
+			event := &network.ResponseReceivedEarlyHintsEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTrustTokenOperationDone adds a handler to the Network.trustTokenOperationDone
+event.
+
+Fired exactly once for each Trust Token operation. Depending on the type of the
+operation and whether the operation succeeded or failed, the event is fired
+before the corresponding request was sent or after the response was received.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-trustTokenOperationDone
+*/
+func (protocol *NetworkProtocol) OnTrustTokenOperationDone(
+	callback func(event *network.TrustTokenOperationDoneEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.trustTokenOperationDone",
+		func(response *Response) {
+			event := &network.TrustTokenOperationDoneEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPolicyUpdated adds a handler to the Network.policyUpdated event.
+
+Fired once security policy has been updated. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-policyUpdated
+*/
+func (protocol *NetworkProtocol) OnPolicyUpdated(
+	callback func(event *network.PolicyUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.policyUpdated",
+		func(response *Response) {
+			event := &network.PolicyUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSubresourceWebBundleMetadataReceived adds a handler to the
+Network.subresourceWebBundleMetadataReceived event.
+
+Fired once when parsing the .wbn file has succeeded. The event contains the
+information about the web bundle contents. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleMetadataReceived
+*/
+func (protocol *NetworkProtocol) OnSubresourceWebBundleMetadataReceived(
+	callback func(event *network.SubresourceWebBundleMetadataReceivedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.subresourceWebBundleMetadataReceived",
+		func(response *Response) {
+			event := &network.SubresourceWebBundleMetadataReceivedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSubresourceWebBundleMetadataError adds a handler to the
+Network.subresourceWebBundleMetadataError event.
+
+Fired once when parsing the .wbn file has failed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleMetadataError
+*/
+func (protocol *NetworkProtocol) OnSubresourceWebBundleMetadataError(
+	callback func(event *network.SubresourceWebBundleMetadataErrorEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.subresourceWebBundleMetadataError",
+		func(response *Response) {
+			event := &network.SubresourceWebBundleMetadataErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSubresourceWebBundleInnerResponseParsed adds a handler to the
+Network.subresourceWebBundleInnerResponseParsed event.
+
+Fired when handling requests for resources within a .wbn file. Note: this will
+only be fired for resources that are requested by the webpage. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleInnerResponseParsed
+*/
+func (protocol *NetworkProtocol) OnSubresourceWebBundleInnerResponseParsed(
+	callback func(event *network.SubresourceWebBundleInnerResponseParsedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.subresourceWebBundleInnerResponseParsed",
+		func(response *Response) {
+			event := &network.SubresourceWebBundleInnerResponseParsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSubresourceWebBundleInnerResponseError adds a handler to the
+Network.subresourceWebBundleInnerResponseError event.
+
+Fired when request for resources within a .wbn file failed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-subresourceWebBundleInnerResponseError
+*/
+func (protocol *NetworkProtocol) OnSubresourceWebBundleInnerResponseError(
+	callback func(event *network.SubresourceWebBundleInnerResponseErrorEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.subresourceWebBundleInnerResponseError",
+		func(response *Response) {
+			event := &network.SubresourceWebBundleInnerResponseErrorEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnReportingAPIReportAdded adds a handler to the Network.reportingApiReportAdded
+event.
+
+Is sent whenever a new report is added. And after 'enableReportingApi' for all
+existing reports. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiReportAdded
+*/
+func (protocol *NetworkProtocol) OnReportingAPIReportAdded(
+	callback func(event *network.ReportingAPIReportAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.reportingApiReportAdded",
+		func(response *Response) {
+			event := &network.ReportingAPIReportAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnReportingAPIReportUpdated adds a handler to the
+Network.reportingApiReportUpdated event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiReportUpdated
+*/
+func (protocol *NetworkProtocol) OnReportingAPIReportUpdated(
+	callback func(event *network.ReportingAPIReportUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.reportingApiReportUpdated",
+		func(response *Response) {
+			event := &network.ReportingAPIReportUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnReportingAPIEndpointsChangedForOrigin adds a handler to the
+Network.reportingApiEndpointsChangedForOrigin event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Network/#event-reportingApiEndpointsChangedForOrigin
+*/
+func (protocol *NetworkProtocol) OnReportingAPIEndpointsChangedForOrigin(
+	callback func(event *network.ReportingAPIEndpointsChangedForOriginEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Network.reportingApiEndpointsChangedForOrigin",
+		func(response *Response) {
+			event := &network.ReportingAPIEndpointsChangedForOriginEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/debugger"
 	"github.com/mkenney/go-chrome/tot/network"
 	"github.com/mkenney/go-chrome/tot/page"
@@ -207,10 +208,10 @@ func TestNetworkContinueInterceptedRequest(t *testing.T) {
 	params := &network.ContinueInterceptedRequestParams{
 		InterceptionID: network.InterceptionID("interception-id"),
 		ErrorReason:    network.ErrorReason.Failed,
-		RawResponse:    "raw response",
-		URL:            "http://some.url",
-		Method:         "someMethod",
-		PostData:       "post data",
+		RawResponse:    optional.String("raw response"),
+		URL:            optional.String("http://some.url"),
+		Method:         optional.String("someMethod"),
+		PostData:       optional.String("post data"),
 		Headers:        network.Headers{"header": "value"},
 		AuthChallengeResponse: &network.AuthChallengeResponse{
 			Response: network.ChallengeResponse.Default,
@@ -254,9 +255,9 @@ func TestNetworkDeleteCookies(t *testing.T) {
 
 	params := &network.DeleteCookiesParams{
 		Name:   "name",
-		URL:    "http://some.url",
-		Domain: "some.url",
-		Path:   "/",
+		URL:    optional.String("http://some.url"),
+		Domain: optional.String("some.url"),
+		Path:   optional.String("/"),
 	}
 	resultChan := mockSocket.Network().DeleteCookies(params)
 	mockResult := &network.DeleteCookiesResult{}
@@ -367,8 +368,8 @@ func TestNetworkEnable(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &network.EnableParams{
-		MaxTotalBufferSize:    1,
-		MaxResourceBufferSize: 1,
+		MaxTotalBufferSize:    optional.Int(1),
+		MaxResourceBufferSize: optional.Int(1),
 	}
 	resultChan := mockSocket.Network().Enable(params)
 	mockResult := &network.EnableResult{}
@@ -411,7 +412,7 @@ func TestNetworkGetAllCookies(t *testing.T) {
 			Value:    "value",
 			Domain:   "domain",
 			Path:     "/",
-			Expires:  time.Now().Unix() + 10,
+			Expires:  float64(time.Now().Unix() + 10),
 			Size:     1,
 			HTTPOnly: true,
 			Secure:   true,
@@ -506,7 +507,7 @@ func TestNetworkGetCookies(t *testing.T) {
 			Value:    "value",
 			Domain:   "domain",
 			Path:     "/",
-			Expires:  time.Now().Unix() + 10,
+			Expires:  float64(time.Now().Unix() + 10),
 			Size:     1,
 			HTTPOnly: true,
 			Secure:   true,
@@ -675,8 +676,8 @@ func TestNetworkSearchInResponseBody(t *testing.T) {
 	params := &network.SearchInResponseBodyParams{
 		RequestID:     network.RequestID("request-id"),
 		Query:         "query string",
-		CaseSensitive: true,
-		IsRegex:       true,
+		CaseSensitive: optional.Bool(true),
+		IsRegex:       optional.Bool(true),
 	}
 	resultChan := mockSocket.Network().SearchInResponseBody(params)
 	mockResult := &network.SearchInResponseBodyResult{
@@ -696,7 +697,7 @@ func TestNetworkSearchInResponseBody(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 	if mockResult.Result[0].LineNumber != result.Result[0].LineNumber {
-		t.Errorf("Expected %d, got %d", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
+		t.Errorf("Expected %v, got %v", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
 	}
 
 	resultChan = mockSocket.Network().SearchInResponseBody(params)
@@ -834,13 +835,13 @@ func TestNetworkSetCookie(t *testing.T) {
 	params := &network.SetCookieParams{
 		Name:     "name",
 		Value:    "value",
-		URL:      "http://some.url",
-		Domain:   "some.url",
-		Path:     "/",
-		Secure:   true,
-		HTTPOnly: true,
+		URL:      optional.String("http://some.url"),
+		Domain:   optional.String("some.url"),
+		Path:     optional.String("/"),
+		Secure:   optional.Bool(true),
+		HTTPOnly: optional.Bool(true),
 		SameSite: network.CookieSameSite.Strict,
-		Expires:  network.TimeSinceEpoch(time.Now().Unix()),
+		Expires:  optional.Of(network.TimeSinceEpoch(time.Now().Unix())),
 	}
 	resultChan := mockSocket.Network().SetCookie(params)
 	mockResult := &network.SetCookieResult{
@@ -882,7 +883,7 @@ func TestNetworkSetCookies(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &network.SetCookiesParams{
-		Cookies: []*network.SetCookieParams{{
+		Cookies: []*network.CookieParam{{
 			Name:     "name",
 			Value:    "value",
 			URL:      "http://some.url",
@@ -1479,7 +1480,7 @@ func TestNetworkOnRequestWillBeSent(t *testing.T) {
 					Origin:             "origin",
 					LogDescription:     "description",
 					LogID:              "log-id",
-					Timestamp:          network.TimeSinceEpoch(time.Now().Unix()),
+					Timestamp:          float64(time.Now().Unix()),
 					HashAlgorithm:      "alg",
 					SignatureAlgorithm: "alg",
 					SignatureData:      "data",
@@ -1889,10 +1890,9 @@ func TestNetworkOnWebSocketHandshakeResponseReceived(t *testing.T) {
 	mockResult := &network.WebSocketHandshakeResponseReceivedEvent{
 		RequestID: network.RequestID("request-id"),
 		Timestamp: network.MonotonicTime(1),
-		Response: &network.WebSocketFrame{
-			Opcode:      1,
-			Mask:        true,
-			PayloadData: "payload",
+		Response: &network.WebSocketResponse{
+			Status:     101,
+			StatusText: "Switching Protocols",
 		},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/overlay"
@@ -8,28 +11,55 @@ import (
 
 /*
 OverlayProtocol provides a namespace for the Chrome Overlay protocol methods.
-The Overlay protocol provides various functionality related to drawing atop the
-inspected page.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Overlay/ EXPERIMENTAL.
+This domain provides various functionality related to drawing atop the inspected
+page. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/
 */
 type OverlayProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *OverlayProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-Disable disables domain notifications.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *OverlayProtocol) WithContext(ctx context.Context) *OverlayProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &OverlayProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disables domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-disable
 */
 func (protocol *OverlayProtocol) Disable() <-chan *overlay.DisableResult {
-	resultChan := make(chan *overlay.DisableResult)
+	resultChan := make(chan *overlay.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.disable", nil)
 	result := &overlay.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -40,18 +70,20 @@ func (protocol *OverlayProtocol) Disable() <-chan *overlay.DisableResult {
 }
 
 /*
-Enable enables domain notifications.
+Enables domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-enable
 */
 func (protocol *OverlayProtocol) Enable() <-chan *overlay.EnableResult {
-	resultChan := make(chan *overlay.EnableResult)
+	resultChan := make(chan *overlay.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.enable", nil)
 	result := &overlay.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -62,20 +94,78 @@ func (protocol *OverlayProtocol) Enable() <-chan *overlay.EnableResult {
 }
 
 /*
-GetHighlightObjectForTest is for testing.
+For testing.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getHighlightObjectForTest
 */
 func (protocol *OverlayProtocol) GetHighlightObjectForTest(
 	params *overlay.GetHighlightObjectForTestParams,
 ) <-chan *overlay.GetHighlightObjectForTestResult {
-	resultChan := make(chan *overlay.GetHighlightObjectForTestResult)
+	resultChan := make(chan *overlay.GetHighlightObjectForTestResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.getHighlightObjectForTest", params)
 	result := &overlay.GetHighlightObjectForTestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+For Persistent Grid testing.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getGridHighlightObjectsForTest
+*/
+func (protocol *OverlayProtocol) GetGridHighlightObjectsForTest(
+	params *overlay.GetGridHighlightObjectsForTestParams,
+) <-chan *overlay.GetGridHighlightObjectsForTestResult {
+	resultChan := make(chan *overlay.GetGridHighlightObjectsForTestResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.getGridHighlightObjectsForTest", params)
+	result := &overlay.GetGridHighlightObjectsForTestResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+For Source Order Viewer testing.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-getSourceOrderHighlightObjectForTest
+*/
+func (protocol *OverlayProtocol) GetSourceOrderHighlightObjectForTest(
+	params *overlay.GetSourceOrderHighlightObjectForTestParams,
+) <-chan *overlay.GetSourceOrderHighlightObjectForTestResult {
+	resultChan := make(chan *overlay.GetSourceOrderHighlightObjectForTestResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.getSourceOrderHighlightObjectForTest", params)
+	result := &overlay.GetSourceOrderHighlightObjectForTestResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -88,18 +178,20 @@ func (protocol *OverlayProtocol) GetHighlightObjectForTest(
 }
 
 /*
-HideHighlight hides any highlight.
+Hides any highlight.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-hideHighlight
 */
 func (protocol *OverlayProtocol) HideHighlight() <-chan *overlay.HideHighlightResult {
-	resultChan := make(chan *overlay.HideHighlightResult)
+	resultChan := make(chan *overlay.HideHighlightResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.hideHighlight", nil)
 	result := &overlay.HideHighlightResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -110,20 +202,25 @@ func (protocol *OverlayProtocol) HideHighlight() <-chan *overlay.HideHighlightRe
 }
 
 /*
-HighlightFrame highlights owner element of the frame with given ID.
+Highlights owner element of the frame with given id. Deprecated: Doesn't work
+reliably and cannot be fixed due to process separation (the owner node might be
+in a different process). Determine the owner node in the client and use
+highlightNode. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightFrame
 */
 func (protocol *OverlayProtocol) HighlightFrame(
 	params *overlay.HighlightFrameParams,
 ) <-chan *overlay.HighlightFrameResult {
-	resultChan := make(chan *overlay.HighlightFrameResult)
+	resultChan := make(chan *overlay.HighlightFrameResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightFrame", params)
 	result := &overlay.HighlightFrameResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -134,21 +231,23 @@ func (protocol *OverlayProtocol) HighlightFrame(
 }
 
 /*
-HighlightNode highlights DOM node with given ID or with the given JavaScript
-object wrapper. Either nodeID or objectID must be specified.
+Highlights DOM node with given id or with the given JavaScript object wrapper.
+Either nodeId or objectId must be specified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightNode
 */
 func (protocol *OverlayProtocol) HighlightNode(
 	params *overlay.HighlightNodeParams,
 ) <-chan *overlay.HighlightNodeResult {
-	resultChan := make(chan *overlay.HighlightNodeResult)
+	resultChan := make(chan *overlay.HighlightNodeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightNode", params)
 	result := &overlay.HighlightNodeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -159,21 +258,23 @@ func (protocol *OverlayProtocol) HighlightNode(
 }
 
 /*
-HighlightQuad highlights given quad. Coordinates are absolute with respect to
-the main frame viewport.
+Highlights given quad. Coordinates are absolute with respect to the main frame
+viewport.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightQuad
 */
 func (protocol *OverlayProtocol) HighlightQuad(
 	params *overlay.HighlightQuadParams,
 ) <-chan *overlay.HighlightQuadResult {
-	resultChan := make(chan *overlay.HighlightQuadResult)
+	resultChan := make(chan *overlay.HighlightQuadResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightQuad", params)
 	result := &overlay.HighlightQuadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -184,21 +285,23 @@ func (protocol *OverlayProtocol) HighlightQuad(
 }
 
 /*
-HighlightRect highlights given rectangle. Coordinates are absolute with respect
-to the main frame viewport.
+Highlights given rectangle. Coordinates are absolute with respect to the main
+frame viewport.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightRect
 */
 func (protocol *OverlayProtocol) HighlightRect(
 	params *overlay.HighlightRectParams,
 ) <-chan *overlay.HighlightRectResult {
-	resultChan := make(chan *overlay.HighlightRectResult)
+	resultChan := make(chan *overlay.HighlightRectResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.highlightRect", params)
 	result := &overlay.HighlightRectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -209,22 +312,78 @@ func (protocol *OverlayProtocol) HighlightRect(
 }
 
 /*
-SetInspectMode enters the 'inspect' mode. In this mode, elements that user is
-hovering over are highlighted. Backend then generates 'inspectNodeRequested'
-event upon element selection.
+Highlights the source order of the children of the DOM node with given id or
+with the given JavaScript object wrapper. Either nodeId or objectId must be
+specified.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-highlightSourceOrder
+*/
+func (protocol *OverlayProtocol) HighlightSourceOrder(
+	params *overlay.HighlightSourceOrderParams,
+) <-chan *overlay.HighlightSourceOrderResult {
+	resultChan := make(chan *overlay.HighlightSourceOrderResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.highlightSourceOrder", params)
+	result := &overlay.HighlightSourceOrderResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enters the 'inspect' mode. In this mode, elements that user is hovering over are
+highlighted. Backend then generates 'inspectNodeRequested' event upon element
+selection.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setInspectMode
 */
 func (protocol *OverlayProtocol) SetInspectMode(
 	params *overlay.SetInspectModeParams,
 ) <-chan *overlay.SetInspectModeResult {
-	resultChan := make(chan *overlay.SetInspectModeResult)
+	resultChan := make(chan *overlay.SetInspectModeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setInspectMode", params)
 	result := &overlay.SetInspectModeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlights owner element of all frames detected to be ads.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowAdHighlights
+*/
+func (protocol *OverlayProtocol) SetShowAdHighlights(
+	params *overlay.SetShowAdHighlightsParams,
+) <-chan *overlay.SetShowAdHighlightsResult {
+	resultChan := make(chan *overlay.SetShowAdHighlightsResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowAdHighlights", params)
+	result := &overlay.SetShowAdHighlightsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -235,20 +394,27 @@ func (protocol *OverlayProtocol) SetInspectMode(
 }
 
 /*
-SetPausedInDebuggerMessage sets the paused message
+SetPausedInDebuggerMessage sends the Overlay.setPausedInDebuggerMessage command.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setPausedInDebuggerMessage
 */
 func (protocol *OverlayProtocol) SetPausedInDebuggerMessage(
-	params *overlay.SetPausedInDebuggerMessageParams,
+	params ...*overlay.SetPausedInDebuggerMessageParams,
 ) <-chan *overlay.SetPausedInDebuggerMessageResult {
-	resultChan := make(chan *overlay.SetPausedInDebuggerMessageResult)
-	command := NewCommand(protocol.Socket, "Overlay.setPausedInDebuggerMessage", params)
+	resultChan := make(chan *overlay.SetPausedInDebuggerMessageResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setPausedInDebuggerMessage", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Overlay.setPausedInDebuggerMessage", params[0])
+	}
 	result := &overlay.SetPausedInDebuggerMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -259,20 +425,22 @@ func (protocol *OverlayProtocol) SetPausedInDebuggerMessage(
 }
 
 /*
-SetShowDebugBorders requests that backend shows debug borders on layers.
+Requests that backend shows debug borders on layers
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowDebugBorders
 */
 func (protocol *OverlayProtocol) SetShowDebugBorders(
 	params *overlay.SetShowDebugBordersParams,
 ) <-chan *overlay.SetShowDebugBordersResult {
-	resultChan := make(chan *overlay.SetShowDebugBordersResult)
+	resultChan := make(chan *overlay.SetShowDebugBordersResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowDebugBorders", params)
 	result := &overlay.SetShowDebugBordersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -283,20 +451,127 @@ func (protocol *OverlayProtocol) SetShowDebugBorders(
 }
 
 /*
-SetShowFPSCounter requests that backend shows the FPS counter.
+Requests that backend shows the FPS counter
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFPSCounter
 */
 func (protocol *OverlayProtocol) SetShowFPSCounter(
 	params *overlay.SetShowFPSCounterParams,
 ) <-chan *overlay.SetShowFPSCounterResult {
-	resultChan := make(chan *overlay.SetShowFPSCounterResult)
+	resultChan := make(chan *overlay.SetShowFPSCounterResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowFPSCounter", params)
 	result := &overlay.SetShowFPSCounterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Highlight multiple elements with the CSS Grid overlay.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowGridOverlays
+*/
+func (protocol *OverlayProtocol) SetShowGridOverlays(
+	params *overlay.SetShowGridOverlaysParams,
+) <-chan *overlay.SetShowGridOverlaysResult {
+	resultChan := make(chan *overlay.SetShowGridOverlaysResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowGridOverlays", params)
+	result := &overlay.SetShowGridOverlaysResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetShowFlexOverlays sends the Overlay.setShowFlexOverlays command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowFlexOverlays
+*/
+func (protocol *OverlayProtocol) SetShowFlexOverlays(
+	params *overlay.SetShowFlexOverlaysParams,
+) <-chan *overlay.SetShowFlexOverlaysResult {
+	resultChan := make(chan *overlay.SetShowFlexOverlaysResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowFlexOverlays", params)
+	result := &overlay.SetShowFlexOverlaysResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetShowScrollSnapOverlays sends the Overlay.setShowScrollSnapOverlays command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollSnapOverlays
+*/
+func (protocol *OverlayProtocol) SetShowScrollSnapOverlays(
+	params *overlay.SetShowScrollSnapOverlaysParams,
+) <-chan *overlay.SetShowScrollSnapOverlaysResult {
+	resultChan := make(chan *overlay.SetShowScrollSnapOverlaysResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowScrollSnapOverlays", params)
+	result := &overlay.SetShowScrollSnapOverlaysResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetShowContainerQueryOverlays sends the Overlay.setShowContainerQueryOverlays
+command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowContainerQueryOverlays
+*/
+func (protocol *OverlayProtocol) SetShowContainerQueryOverlays(
+	params *overlay.SetShowContainerQueryOverlaysParams,
+) <-chan *overlay.SetShowContainerQueryOverlaysResult {
+	resultChan := make(chan *overlay.SetShowContainerQueryOverlaysResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowContainerQueryOverlays", params)
+	result := &overlay.SetShowContainerQueryOverlaysResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -307,20 +582,22 @@ func (protocol *OverlayProtocol) SetShowFPSCounter(
 }
 
 /*
-SetShowPaintRects that backend shows paint rectangles.
+Requests that backend shows paint rectangles
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowPaintRects
 */
 func (protocol *OverlayProtocol) SetShowPaintRects(
 	params *overlay.SetShowPaintRectsParams,
 ) <-chan *overlay.SetShowPaintRectsResult {
-	resultChan := make(chan *overlay.SetShowPaintRectsResult)
+	resultChan := make(chan *overlay.SetShowPaintRectsResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowPaintRects", params)
 	result := &overlay.SetShowPaintRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -331,20 +608,74 @@ func (protocol *OverlayProtocol) SetShowPaintRects(
 }
 
 /*
-SetShowScrollBottleneckRects requests that backend shows scroll bottleneck rects.
+Requests that backend shows layout shift regions
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowLayoutShiftRegions
+*/
+func (protocol *OverlayProtocol) SetShowLayoutShiftRegions(
+	params *overlay.SetShowLayoutShiftRegionsParams,
+) <-chan *overlay.SetShowLayoutShiftRegionsResult {
+	resultChan := make(chan *overlay.SetShowLayoutShiftRegionsResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowLayoutShiftRegions", params)
+	result := &overlay.SetShowLayoutShiftRegionsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Requests that backend shows scroll bottleneck rects
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowScrollBottleneckRects
 */
 func (protocol *OverlayProtocol) SetShowScrollBottleneckRects(
 	params *overlay.SetShowScrollBottleneckRectsParams,
 ) <-chan *overlay.SetShowScrollBottleneckRectsResult {
-	resultChan := make(chan *overlay.SetShowScrollBottleneckRectsResult)
+	resultChan := make(chan *overlay.SetShowScrollBottleneckRectsResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowScrollBottleneckRects", params)
 	result := &overlay.SetShowScrollBottleneckRectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Deprecated, no longer has any effect. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHitTestBorders
+*/
+func (protocol *OverlayProtocol) SetShowHitTestBorders(
+	params *overlay.SetShowHitTestBordersParams,
+) <-chan *overlay.SetShowHitTestBordersResult {
+	resultChan := make(chan *overlay.SetShowHitTestBordersResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowHitTestBorders", params)
+	result := &overlay.SetShowHitTestBordersResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -355,20 +686,136 @@ func (protocol *OverlayProtocol) SetShowScrollBottleneckRects(
 }
 
 /*
-SetShowViewportSizeOnResize paints viewport size upon main frame resize.
+Deprecated, no longer has any effect. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWebVitals
+*/
+func (protocol *OverlayProtocol) SetShowWebVitals(
+	params *overlay.SetShowWebVitalsParams,
+) <-chan *overlay.SetShowWebVitalsResult {
+	resultChan := make(chan *overlay.SetShowWebVitalsResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowWebVitals", params)
+	result := &overlay.SetShowWebVitalsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Paints viewport size upon main frame resize.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowViewportSizeOnResize
 */
 func (protocol *OverlayProtocol) SetShowViewportSizeOnResize(
 	params *overlay.SetShowViewportSizeOnResizeParams,
 ) <-chan *overlay.SetShowViewportSizeOnResizeResult {
-	resultChan := make(chan *overlay.SetShowViewportSizeOnResizeResult)
+	resultChan := make(chan *overlay.SetShowViewportSizeOnResizeResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setShowViewportSizeOnResize", params)
 	result := &overlay.SetShowViewportSizeOnResizeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Add a dual screen device hinge
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowHinge
+*/
+func (protocol *OverlayProtocol) SetShowHinge(
+	params ...*overlay.SetShowHingeParams,
+) <-chan *overlay.SetShowHingeResult {
+	resultChan := make(chan *overlay.SetShowHingeResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowHinge", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Overlay.setShowHinge", params[0])
+	}
+	result := &overlay.SetShowHingeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Show elements in isolation mode with overlays.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowIsolatedElements
+*/
+func (protocol *OverlayProtocol) SetShowIsolatedElements(
+	params *overlay.SetShowIsolatedElementsParams,
+) <-chan *overlay.SetShowIsolatedElementsResult {
+	resultChan := make(chan *overlay.SetShowIsolatedElementsResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowIsolatedElements", params)
+	result := &overlay.SetShowIsolatedElementsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Show Window Controls Overlay for PWA
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setShowWindowControlsOverlay
+*/
+func (protocol *OverlayProtocol) SetShowWindowControlsOverlay(
+	params ...*overlay.SetShowWindowControlsOverlayParams,
+) <-chan *overlay.SetShowWindowControlsOverlayResult {
+	resultChan := make(chan *overlay.SetShowWindowControlsOverlayResult, 1)
+	command := NewCommand(protocol.Socket, "Overlay.setShowWindowControlsOverlay", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Overlay.setShowWindowControlsOverlay", params[0])
+	}
+	result := &overlay.SetShowWindowControlsOverlayResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -379,20 +826,22 @@ func (protocol *OverlayProtocol) SetShowViewportSizeOnResize(
 }
 
 /*
-SetSuspended sets the suspended state
+Sets the suspended state DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#method-setSuspended
 */
 func (protocol *OverlayProtocol) SetSuspended(
 	params *overlay.SetSuspendedParams,
 ) <-chan *overlay.SetSuspendedResult {
-	resultChan := make(chan *overlay.SetSuspendedResult)
+	resultChan := make(chan *overlay.SetSuspendedResult, 1)
 	command := NewCommand(protocol.Socket, "Overlay.setSuspended", params)
 	result := &overlay.SetSuspendedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -404,15 +853,15 @@ func (protocol *OverlayProtocol) SetSuspended(
 
 /*
 OnInspectNodeRequested adds a handler to the Overlay.inspectNodeRequested event.
-Overlay.inspectNodeRequested fires when the node should be inspected. This
-happens after call to `setInspectMode` or when user manually inspects an
-element.
+
+Fired when the node should be inspected. This happens after call to
+`setInspectMode` or when user manually inspects an element.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectNodeRequested
 */
 func (protocol *OverlayProtocol) OnInspectNodeRequested(
 	callback func(event *overlay.InspectNodeRequestedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Overlay.inspectNodeRequested",
 		func(response *Response) {
@@ -425,18 +874,21 @@ func (protocol *OverlayProtocol) OnInspectNodeRequested(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnNodeHighlightRequested adds a handler to the Overlay.nodeHighlightRequested
-event. Overlay.nodeHighlightRequested fires when the node should be highlighted.
-This happens after call to `setInspectMode`.
+event.
+
+Fired when the node should be highlighted. This happens after call to
+`setInspectMode`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-nodeHighlightRequested
 */
 func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 	callback func(event *overlay.NodeHighlightRequestedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Overlay.nodeHighlightRequested",
 		func(response *Response) {
@@ -449,18 +901,19 @@ func (protocol *OverlayProtocol) OnNodeHighlightRequested(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnScreenshotRequested adds a handler to the Overlay.screenshotRequested event.
-Overlay.screenshotRequested fires when user asks to capture screenshot of some
-area on the page.
+
+Fired when user asks to capture screenshot of some area on the page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-screenshotRequested
 */
 func (protocol *OverlayProtocol) OnScreenshotRequested(
 	callback func(event *overlay.ScreenshotRequestedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Overlay.screenshotRequested",
 		func(response *Response) {
@@ -473,4 +926,30 @@ func (protocol *OverlayProtocol) OnScreenshotRequested(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInspectModeCanceled adds a handler to the Overlay.inspectModeCanceled event.
+
+Fired when user cancels the inspect mode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Overlay/#event-inspectModeCanceled
+*/
+func (protocol *OverlayProtocol) OnInspectModeCanceled(
+	callback func(event *overlay.InspectModeCanceledEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Overlay.inspectModeCanceled",
+		func(response *Response) {
+			event := &overlay.InspectModeCanceledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
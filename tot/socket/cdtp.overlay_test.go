@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/dom"
 	"github.com/mkenney/go-chrome/tot/overlay"
 	"github.com/mkenney/go-chrome/tot/page"
@@ -90,7 +91,7 @@ func TestOverlayGetHighlightObjectForTest(t *testing.T) {
 	}
 	resultChan := mockSocket.Overlay().GetHighlightObjectForTest(params)
 	mockResult := &overlay.GetHighlightObjectForTestResult{
-		Highlight: map[string]string{"key": "data"},
+		Highlight: map[string]interface{}{"key": "data"},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
@@ -202,9 +203,9 @@ func TestOverlayHighlightNode(t *testing.T) {
 
 	params := &overlay.HighlightNodeParams{
 		HighlightConfig: &overlay.HighlightConfig{},
-		NodeID:          dom.NodeID(1),
-		BackendNodeID:   dom.BackendNodeID(1),
-		ObjectID:        runtime.RemoteObjectID("remote-object-id"),
+		NodeID:          optional.Of(dom.NodeID(1)),
+		BackendNodeID:   optional.Of(dom.BackendNodeID(1)),
+		ObjectID:        optional.Of(runtime.RemoteObjectID("remote-object-id")),
 	}
 	resultChan := mockSocket.Overlay().HighlightNode(params)
 	mockResult := &overlay.HighlightNodeResult{}
@@ -359,7 +360,7 @@ func TestOverlaySetPausedInDebuggerMessage(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &overlay.SetPausedInDebuggerMessageParams{
-		Message: "message",
+		Message: optional.String("message"),
 	}
 	resultChan := mockSocket.Overlay().SetPausedInDebuggerMessage(params)
 	mockResult := &overlay.SetPausedInDebuggerMessageResult{}
@@ -736,7 +737,7 @@ func TestOverlayOnScreenshotRequested(t *testing.T) {
 		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
 	}
 	if mockResult.Viewport.X != result.Viewport.X {
-		t.Errorf("Expected %d, got %d", mockResult.Viewport.X, result.Viewport.X)
+		t.Errorf("Expected %v, got %v", mockResult.Viewport.X, result.Viewport.X)
 	}
 
 	resultChan = make(chan *overlay.ScreenshotRequestedEvent)
@@ -1,39 +1,67 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/page"
 )
 
 /*
-PageProtocol provides a namespace for the Chrome Page protocol methods. The Page
-protocol provides actions and events related to the inspected page belong to the
-page domain.
+PageProtocol provides a namespace for the Chrome Page protocol methods.
+
+Actions and events related to the inspected page belong to the page domain.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/
 */
 type PageProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PageProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PageProtocol) WithContext(ctx context.Context) *PageProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PageProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-AddScriptToEvaluateOnLoad is eprecated, please use addScriptToEvaluateOnNewDocument
-instead.
+Deprecated, please use addScriptToEvaluateOnNewDocument instead. EXPERIMENTAL.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnLoad
-EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *PageProtocol) AddScriptToEvaluateOnLoad(
 	params *page.AddScriptToEvaluateOnLoadParams,
 ) <-chan *page.AddScriptToEvaluateOnLoadResult {
-	resultChan := make(chan *page.AddScriptToEvaluateOnLoadResult)
+	resultChan := make(chan *page.AddScriptToEvaluateOnLoadResult, 1)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnLoad", params)
 	result := &page.AddScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -46,21 +74,23 @@ func (protocol *PageProtocol) AddScriptToEvaluateOnLoad(
 }
 
 /*
-AddScriptToEvaluateOnNewDocument evaluates given script in every frame upon
-creation (before loading frame's scripts).
+Evaluates given script in every frame upon creation (before loading frame's
+scripts).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addScriptToEvaluateOnNewDocument
 */
 func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
 	params *page.AddScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.AddScriptToEvaluateOnNewDocumentResult {
-	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult)
+	resultChan := make(chan *page.AddScriptToEvaluateOnNewDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.addScriptToEvaluateOnNewDocument", params)
 	result := &page.AddScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -73,18 +103,20 @@ func (protocol *PageProtocol) AddScriptToEvaluateOnNewDocument(
 }
 
 /*
-BringToFront brings page to front (activates tab).
+Brings page to front (activates tab).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-bringToFront
 */
 func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
-	resultChan := make(chan *page.BringToFrontResult)
+	resultChan := make(chan *page.BringToFrontResult, 1)
 	command := NewCommand(protocol.Socket, "Page.bringToFront", nil)
 	result := &page.BringToFrontResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -95,20 +127,61 @@ func (protocol *PageProtocol) BringToFront() <-chan *page.BringToFrontResult {
 }
 
 /*
-CaptureScreenshot capture a page screenshot.
+Capture page screenshot.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureScreenshot
 */
 func (protocol *PageProtocol) CaptureScreenshot(
-	params *page.CaptureScreenshotParams,
+	params ...*page.CaptureScreenshotParams,
 ) <-chan *page.CaptureScreenshotResult {
-	resultChan := make(chan *page.CaptureScreenshotResult)
-	command := NewCommand(protocol.Socket, "Page.captureScreenshot", params)
+	resultChan := make(chan *page.CaptureScreenshotResult, 1)
+	command := NewCommand(protocol.Socket, "Page.captureScreenshot", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.captureScreenshot", params[0])
+	}
 	result := &page.CaptureScreenshotResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns a snapshot of the page as a string. For MHTML format, the serialization
+includes iframes, shadow DOM, external resources, and element-inline styles.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-captureSnapshot
+*/
+func (protocol *PageProtocol) CaptureSnapshot(
+	params ...*page.CaptureSnapshotParams,
+) <-chan *page.CaptureSnapshotResult {
+	resultChan := make(chan *page.CaptureSnapshotResult, 1)
+	command := NewCommand(protocol.Socket, "Page.captureSnapshot", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.captureSnapshot", params[0])
+	}
+	result := &page.CaptureSnapshotResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -121,20 +194,94 @@ func (protocol *PageProtocol) CaptureScreenshot(
 }
 
 /*
-CreateIsolatedWorld creates an isolated world for the given frame.
+Clears the overridden device metrics. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearDeviceMetricsOverride
+*/
+func (protocol *PageProtocol) ClearDeviceMetricsOverride() <-chan *page.ClearDeviceMetricsOverrideResult {
+	resultChan := make(chan *page.ClearDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.clearDeviceMetricsOverride", nil)
+	result := &page.ClearDeviceMetricsOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears the overridden Device Orientation. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearDeviceOrientationOverride
+*/
+func (protocol *PageProtocol) ClearDeviceOrientationOverride() <-chan *page.ClearDeviceOrientationOverrideResult {
+	resultChan := make(chan *page.ClearDeviceOrientationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.clearDeviceOrientationOverride", nil)
+	result := &page.ClearDeviceOrientationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears the overridden Geolocation Position and Error. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearGeolocationOverride
+*/
+func (protocol *PageProtocol) ClearGeolocationOverride() <-chan *page.ClearGeolocationOverrideResult {
+	resultChan := make(chan *page.ClearGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.clearGeolocationOverride", nil)
+	result := &page.ClearGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates an isolated world for the given frame.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-createIsolatedWorld
 */
 func (protocol *PageProtocol) CreateIsolatedWorld(
 	params *page.CreateIsolatedWorldParams,
 ) <-chan *page.CreateIsolatedWorldResult {
-	resultChan := make(chan *page.CreateIsolatedWorldResult)
+	resultChan := make(chan *page.CreateIsolatedWorldResult, 1)
 	command := NewCommand(protocol.Socket, "Page.createIsolatedWorld", params)
 	result := &page.CreateIsolatedWorldResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -147,18 +294,47 @@ func (protocol *PageProtocol) CreateIsolatedWorld(
 }
 
 /*
-Disable disables page domain notifications.
+Deletes browser cookie with given name, domain and path. EXPERIMENTAL.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-deleteCookie
+*/
+func (protocol *PageProtocol) DeleteCookie(
+	params *page.DeleteCookieParams,
+) <-chan *page.DeleteCookieResult {
+	resultChan := make(chan *page.DeleteCookieResult, 1)
+	command := NewCommand(protocol.Socket, "Page.deleteCookie", params)
+	result := &page.DeleteCookieResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables page domain notifications.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-disable
 */
 func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
-	resultChan := make(chan *page.DisableResult)
+	resultChan := make(chan *page.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Page.disable", nil)
 	result := &page.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -169,18 +345,27 @@ func (protocol *PageProtocol) Disable() <-chan *page.DisableResult {
 }
 
 /*
-Enable Ennables page domain notifications.
+Enables page domain notifications.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-enable
 */
-func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
-	resultChan := make(chan *page.EnableResult)
+func (protocol *PageProtocol) Enable(
+	params ...*page.EnableParams,
+) <-chan *page.EnableResult {
+	resultChan := make(chan *page.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Page.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.enable", params[0])
+	}
 	result := &page.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -191,21 +376,137 @@ func (protocol *PageProtocol) Enable() <-chan *page.EnableResult {
 }
 
 /*
-GetAppManifest gets the app manifest.
+Gets the processed manifest for this current document. This API always waits for
+the manifest to be loaded. If manifestId is provided, and it does not match the
+manifest of the current document, this API errors out. If there is not a loaded
+page, this API errors out immediately.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppManifest
 */
 func (protocol *PageProtocol) GetAppManifest(
 	params *page.GetAppManifestParams,
 ) <-chan *page.GetAppManifestResult {
-	resultChan := make(chan *page.GetAppManifestResult)
+	resultChan := make(chan *page.GetAppManifestResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getAppManifest", params)
 	result := &page.GetAppManifestResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetInstallabilityErrors sends the Page.getInstallabilityErrors command.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getInstallabilityErrors
+*/
+func (protocol *PageProtocol) GetInstallabilityErrors() <-chan *page.GetInstallabilityErrorsResult {
+	resultChan := make(chan *page.GetInstallabilityErrorsResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getInstallabilityErrors", nil)
+	result := &page.GetInstallabilityErrorsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Deprecated because it's not guaranteed that the returned icon is in fact the one
+used for PWA installation. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getManifestIcons
+*/
+func (protocol *PageProtocol) GetManifestIcons() <-chan *page.GetManifestIconsResult {
+	resultChan := make(chan *page.GetManifestIconsResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getManifestIcons", nil)
+	result := &page.GetManifestIconsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the unique (PWA) app id. Only returns values if the feature flag
+'WebAppEnableManifestId' is enabled EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAppId
+*/
+func (protocol *PageProtocol) GetAppID() <-chan *page.GetAppIDResult {
+	resultChan := make(chan *page.GetAppIDResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getAppId", nil)
+	result := &page.GetAppIDResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+GetAdScriptAncestry sends the Page.getAdScriptAncestry command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getAdScriptAncestry
+*/
+func (protocol *PageProtocol) GetAdScriptAncestry(
+	params *page.GetAdScriptAncestryParams,
+) <-chan *page.GetAdScriptAncestryResult {
+	resultChan := make(chan *page.GetAdScriptAncestryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getAdScriptAncestry", params)
+	result := &page.GetAdScriptAncestryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -215,18 +516,20 @@ func (protocol *PageProtocol) GetAppManifest(
 }
 
 /*
-GetFrameTree returns present frame tree structure.
+Returns present frame tree structure.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getFrameTree
 */
 func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
-	resultChan := make(chan *page.GetFrameTreeResult)
+	resultChan := make(chan *page.GetFrameTreeResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getFrameTree", nil)
 	result := &page.GetFrameTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -239,19 +542,21 @@ func (protocol *PageProtocol) GetFrameTree() <-chan *page.GetFrameTreeResult {
 }
 
 /*
-GetLayoutMetrics returns metrics relating to the layouting of the page, such as
-viewport bounds/scale.
+Returns metrics relating to the layouting of the page, such as viewport
+bounds/scale.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getLayoutMetrics
 */
 func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsResult {
-	resultChan := make(chan *page.GetLayoutMetricsResult)
+	resultChan := make(chan *page.GetLayoutMetricsResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getLayoutMetrics", nil)
 	result := &page.GetLayoutMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -264,18 +569,20 @@ func (protocol *PageProtocol) GetLayoutMetrics() <-chan *page.GetLayoutMetricsRe
 }
 
 /*
-GetNavigationHistory returns navigation history for the current page.
+Returns navigation history for the current page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getNavigationHistory
 */
 func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationHistoryResult {
-	resultChan := make(chan *page.GetNavigationHistoryResult)
+	resultChan := make(chan *page.GetNavigationHistoryResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getNavigationHistory", nil)
 	result := &page.GetNavigationHistoryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -288,21 +595,46 @@ func (protocol *PageProtocol) GetNavigationHistory() <-chan *page.GetNavigationH
 }
 
 /*
-GetResourceContent returns content of the given resource.
+Resets navigation history for the current page.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-resetNavigationHistory
+*/
+func (protocol *PageProtocol) ResetNavigationHistory() <-chan *page.ResetNavigationHistoryResult {
+	resultChan := make(chan *page.ResetNavigationHistoryResult, 1)
+	command := NewCommand(protocol.Socket, "Page.resetNavigationHistory", nil)
+	result := &page.ResetNavigationHistoryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns content of the given resource. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceContent
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) GetResourceContent(
 	params *page.GetResourceContentParams,
 ) <-chan *page.GetResourceContentResult {
-	resultChan := make(chan *page.GetResourceContentResult)
+	resultChan := make(chan *page.GetResourceContentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getResourceContent", params)
 	result := &page.GetResourceContentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -315,19 +647,20 @@ func (protocol *PageProtocol) GetResourceContent(
 }
 
 /*
-GetResourceTree returns present frame / resource tree structure.
+Returns present frame / resource tree structure. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getResourceTree
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) GetResourceTree() <-chan *page.GetResourceTreeResult {
-	resultChan := make(chan *page.GetResourceTreeResult)
+	resultChan := make(chan *page.GetResourceTreeResult, 1)
 	command := NewCommand(protocol.Socket, "Page.getResourceTree", nil)
 	result := &page.GetResourceTreeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -340,21 +673,23 @@ func (protocol *PageProtocol) GetResourceTree() <-chan *page.GetResourceTreeResu
 }
 
 /*
-HandleJavaScriptDialog accepts or dismisses a JavaScript initiated dialog
-(alert, confirm, prompt, or onbeforeunload).
+Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-handleJavaScriptDialog
 */
 func (protocol *PageProtocol) HandleJavaScriptDialog(
 	params *page.HandleJavaScriptDialogParams,
 ) <-chan *page.HandleJavaScriptDialogResult {
-	resultChan := make(chan *page.HandleJavaScriptDialogResult)
+	resultChan := make(chan *page.HandleJavaScriptDialogResult, 1)
 	command := NewCommand(protocol.Socket, "Page.handleJavaScriptDialog", params)
 	result := &page.HandleJavaScriptDialogResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -365,20 +700,22 @@ func (protocol *PageProtocol) HandleJavaScriptDialog(
 }
 
 /*
-Navigate navigates current page to the given URL.
+Navigates current page to the given URL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigate
 */
 func (protocol *PageProtocol) Navigate(
 	params *page.NavigateParams,
 ) <-chan *page.NavigateResult {
-	resultChan := make(chan *page.NavigateResult)
+	resultChan := make(chan *page.NavigateResult, 1)
 	command := NewCommand(protocol.Socket, "Page.navigate", params)
 	result := &page.NavigateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -391,20 +728,22 @@ func (protocol *PageProtocol) Navigate(
 }
 
 /*
-NavigateToHistoryEntry navigates current page to the given history entry.
+Navigates current page to the given history entry.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-navigateToHistoryEntry
 */
 func (protocol *PageProtocol) NavigateToHistoryEntry(
 	params *page.NavigateToHistoryEntryParams,
 ) <-chan *page.NavigateToHistoryEntryResult {
-	resultChan := make(chan *page.NavigateToHistoryEntryResult)
+	resultChan := make(chan *page.NavigateToHistoryEntryResult, 1)
 	command := NewCommand(protocol.Socket, "Page.navigateToHistoryEntry", params)
 	result := &page.NavigateToHistoryEntryResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -415,20 +754,27 @@ func (protocol *PageProtocol) NavigateToHistoryEntry(
 }
 
 /*
-PrintToPDF print page as PDF.
+Print page as PDF.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-printToPDF
 */
 func (protocol *PageProtocol) PrintToPDF(
-	params *page.PrintToPDFParams,
+	params ...*page.PrintToPDFParams,
 ) <-chan *page.PrintToPDFResult {
-	resultChan := make(chan *page.PrintToPDFResult)
-	command := NewCommand(protocol.Socket, "Page.printToPDF", params)
+	resultChan := make(chan *page.PrintToPDFResult, 1)
+	command := NewCommand(protocol.Socket, "Page.printToPDF", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.printToPDF", params[0])
+	}
 	result := &page.PrintToPDFResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -441,20 +787,27 @@ func (protocol *PageProtocol) PrintToPDF(
 }
 
 /*
-Reload reloads given page optionally ignoring the cache.
+Reloads given page optionally ignoring the cache.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-reload
 */
 func (protocol *PageProtocol) Reload(
-	params *page.ReloadParams,
+	params ...*page.ReloadParams,
 ) <-chan *page.ReloadResult {
-	resultChan := make(chan *page.ReloadResult)
-	command := NewCommand(protocol.Socket, "Page.reload", params)
+	resultChan := make(chan *page.ReloadResult, 1)
+	command := NewCommand(protocol.Socket, "Page.reload", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.reload", params[0])
+	}
 	result := &page.ReloadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -465,22 +818,23 @@ func (protocol *PageProtocol) Reload(
 }
 
 /*
-RemoveScriptToEvaluateOnLoad deprecated, please use removeScriptToEvaluateOnNewDocument
-instead.
+Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+EXPERIMENTAL. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnLoad
-EXPERIMENTAL. DEPRECATED.
 */
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoad(
 	params *page.RemoveScriptToEvaluateOnLoadParams,
 ) <-chan *page.RemoveScriptToEvaluateOnLoadResult {
-	resultChan := make(chan *page.RemoveScriptToEvaluateOnLoadResult)
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnLoadResult, 1)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnLoad", params)
 	result := &page.RemoveScriptToEvaluateOnLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -491,42 +845,22 @@ func (protocol *PageProtocol) RemoveScriptToEvaluateOnLoad(
 }
 
 /*
-RemoveScriptToEvaluateOnNewDocument removes given script from the list.
+Removes given script from the list.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-removeScriptToEvaluateOnNewDocument
 */
 func (protocol *PageProtocol) RemoveScriptToEvaluateOnNewDocument(
 	params *page.RemoveScriptToEvaluateOnNewDocumentParams,
 ) <-chan *page.RemoveScriptToEvaluateOnNewDocumentResult {
-	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult)
+	resultChan := make(chan *page.RemoveScriptToEvaluateOnNewDocumentResult, 1)
 	command := NewCommand(protocol.Socket, "Page.removeScriptToEvaluateOnNewDocument", params)
 	result := &page.RemoveScriptToEvaluateOnNewDocumentResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
-			result.Err = response.Error
-		}
-		resultChan <- result
-		close(resultChan)
-	}()
-
-	return resultChan
-}
-
-/*
-RequestAppBanner is experimental.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner EXPERIMENTAL.
-*/
-func (protocol *PageProtocol) RequestAppBanner() <-chan *page.RequestAppBannerResult {
-	resultChan := make(chan *page.RequestAppBannerResult)
-	command := NewCommand(protocol.Socket, "Page.requestAppBanner", nil)
-	result := &page.RequestAppBannerResult{}
-
-	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -537,21 +871,23 @@ func (protocol *PageProtocol) RequestAppBanner() <-chan *page.RequestAppBannerRe
 }
 
 /*
-ScreencastFrameAck acknowledges that a screencast frame has been received by the
-frontend.
+Acknowledges that a screencast frame has been received by the frontend.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-screencastFrameAck EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-screencastFrameAck
 */
 func (protocol *PageProtocol) ScreencastFrameAck(
 	params *page.ScreencastFrameAckParams,
 ) <-chan *page.ScreencastFrameAckResult {
-	resultChan := make(chan *page.ScreencastFrameAckResult)
+	resultChan := make(chan *page.ScreencastFrameAckResult, 1)
 	command := NewCommand(protocol.Socket, "Page.screencastFrameAck", params)
 	result := &page.ScreencastFrameAckResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -562,21 +898,22 @@ func (protocol *PageProtocol) ScreencastFrameAck(
 }
 
 /*
-SearchInResource searches for given string in resource content.
+Searches for given string in resource content. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-searchInResource
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SearchInResource(
 	params *page.SearchInResourceParams,
 ) <-chan *page.SearchInResourceResult {
-	resultChan := make(chan *page.SearchInResourceResult)
+	resultChan := make(chan *page.SearchInResourceResult, 1)
 	command := NewCommand(protocol.Socket, "Page.searchInResource", params)
 	result := &page.SearchInResourceResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -589,21 +926,22 @@ func (protocol *PageProtocol) SearchInResource(
 }
 
 /*
-SetAdBlockingEnabled enable Chrome's experimental ad filter on all sites.
+Enable Chrome's experimental ad filter on all sites. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAdBlockingEnabled
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) SetAdBlockingEnabled(
 	params *page.SetAdBlockingEnabledParams,
 ) <-chan *page.SetAdBlockingEnabledResult {
-	resultChan := make(chan *page.SetAdBlockingEnabledResult)
+	resultChan := make(chan *page.SetAdBlockingEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Page.setAdBlockingEnabled", params)
 	result := &page.SetAdBlockingEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -614,22 +952,22 @@ func (protocol *PageProtocol) SetAdBlockingEnabled(
 }
 
 /*
-SetAutoAttachToCreatedPages controls whether browser will open a new inspector
-window for connected pages.
+Enable page Content Security Policy by-passing.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setBypassCSP
 */
-func (protocol *PageProtocol) SetAutoAttachToCreatedPages(
-	params *page.SetAutoAttachToCreatedPagesParams,
-) <-chan *page.SetAutoAttachToCreatedPagesResult {
-	resultChan := make(chan *page.SetAutoAttachToCreatedPagesResult)
-	command := NewCommand(protocol.Socket, "Page.setAutoAttachToCreatedPages", params)
-	result := &page.SetAutoAttachToCreatedPagesResult{}
+func (protocol *PageProtocol) SetBypassCSP(
+	params *page.SetBypassCSPParams,
+) <-chan *page.SetBypassCSPResult {
+	resultChan := make(chan *page.SetBypassCSPResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setBypassCSP", params)
+	result := &page.SetBypassCSPResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -640,21 +978,25 @@ func (protocol *PageProtocol) SetAutoAttachToCreatedPages(
 }
 
 /*
-SetDocumentContent sets given markup as the document's HTML.
+Get Permissions Policy state on given frame. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentContent
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getPermissionsPolicyState
 */
-func (protocol *PageProtocol) SetDocumentContent(
-	params *page.SetDocumentContentParams,
-) <-chan *page.SetDocumentContentResult {
-	resultChan := make(chan *page.SetDocumentContentResult)
-	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
-	result := &page.SetDocumentContentResult{}
+func (protocol *PageProtocol) GetPermissionsPolicyState(
+	params *page.GetPermissionsPolicyStateParams,
+) <-chan *page.GetPermissionsPolicyStateResult {
+	resultChan := make(chan *page.GetPermissionsPolicyStateResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getPermissionsPolicyState", params)
+	result := &page.GetPermissionsPolicyStateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -664,22 +1006,25 @@ func (protocol *PageProtocol) SetDocumentContent(
 }
 
 /*
-SetDownloadBehavior sets the behavior when downloading a file.
+Get Origin Trials on given frame. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDownloadBehavior
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-getOriginTrials
 */
-func (protocol *PageProtocol) SetDownloadBehavior(
-	params *page.SetDownloadBehaviorParams,
-) <-chan *page.SetDownloadBehaviorResult {
-	resultChan := make(chan *page.SetDownloadBehaviorResult)
-	command := NewCommand(protocol.Socket, "Page.setDownloadBehavior", params)
-	result := &page.SetDownloadBehaviorResult{}
+func (protocol *PageProtocol) GetOriginTrials(
+	params *page.GetOriginTrialsParams,
+) <-chan *page.GetOriginTrialsResult {
+	resultChan := make(chan *page.GetOriginTrialsResult, 1)
+	command := NewCommand(protocol.Socket, "Page.getOriginTrials", params)
+	result := &page.GetOriginTrialsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -689,21 +1034,25 @@ func (protocol *PageProtocol) SetDownloadBehavior(
 }
 
 /*
-SetLifecycleEventsEnabled controls whether page will emit lifecycle events.
+Overrides the values of device screen dimensions (window.screen.width,
+window.screen.height, window.innerWidth, window.innerHeight, and
+"device-width"/"device-height"-related CSS media query results). EXPERIMENTAL.
+DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setLifecycleEventsEnabled
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceMetricsOverride
 */
-func (protocol *PageProtocol) SetLifecycleEventsEnabled(
-	params *page.SetLifecycleEventsEnabledParams,
-) <-chan *page.SetLifecycleEventsEnabledResult {
-	resultChan := make(chan *page.SetLifecycleEventsEnabledResult)
-	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
-	result := &page.SetLifecycleEventsEnabledResult{}
+func (protocol *PageProtocol) SetDeviceMetricsOverride(
+	params *page.SetDeviceMetricsOverrideParams,
+) <-chan *page.SetDeviceMetricsOverrideResult {
+	resultChan := make(chan *page.SetDeviceMetricsOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDeviceMetricsOverride", params)
+	result := &page.SetDeviceMetricsOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -714,21 +1063,22 @@ func (protocol *PageProtocol) SetLifecycleEventsEnabled(
 }
 
 /*
-StartScreencast starts sending each frame using the `screencastFrame` event.
+Overrides the Device Orientation. EXPERIMENTAL. DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-startScreencast
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDeviceOrientationOverride
 */
-func (protocol *PageProtocol) StartScreencast(
-	params *page.StartScreencastParams,
-) <-chan *page.StartScreencastResult {
-	resultChan := make(chan *page.StartScreencastResult)
-	command := NewCommand(protocol.Socket, "Page.startScreencast", params)
-	result := &page.StartScreencastResult{}
+func (protocol *PageProtocol) SetDeviceOrientationOverride(
+	params *page.SetDeviceOrientationOverrideParams,
+) <-chan *page.SetDeviceOrientationOverrideResult {
+	resultChan := make(chan *page.SetDeviceOrientationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDeviceOrientationOverride", params)
+	result := &page.SetDeviceOrientationOverrideResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -739,18 +1089,22 @@ func (protocol *PageProtocol) StartScreencast(
 }
 
 /*
-StopLoading force the page stop all navigations and pending resource fetches.
+Set generic font families. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopLoading
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontFamilies
 */
-func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
-	resultChan := make(chan *page.StopLoadingResult)
-	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
-	result := &page.StopLoadingResult{}
+func (protocol *PageProtocol) SetFontFamilies(
+	params *page.SetFontFamiliesParams,
+) <-chan *page.SetFontFamiliesResult {
+	resultChan := make(chan *page.SetFontFamiliesResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setFontFamilies", params)
+	result := &page.SetFontFamiliesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -761,19 +1115,22 @@ func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
 }
 
 /*
-StopScreencast stops sending each frame in the `screencastFrame`.
+Set default font sizes. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopScreencast
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setFontSizes
 */
-func (protocol *PageProtocol) StopScreencast() <-chan *page.StopScreencastResult {
-	resultChan := make(chan *page.StopScreencastResult)
-	command := NewCommand(protocol.Socket, "Page.stopScreencast", nil)
-	result := &page.StopScreencastResult{}
+func (protocol *PageProtocol) SetFontSizes(
+	params *page.SetFontSizesParams,
+) <-chan *page.SetFontSizesResult {
+	resultChan := make(chan *page.SetFontSizesResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setFontSizes", params)
+	result := &page.SetFontSizesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -784,37 +1141,655 @@ func (protocol *PageProtocol) StopScreencast() <-chan *page.StopScreencastResult
 }
 
 /*
-OnDOMContentEventFired adds a handler to the Page.domContentEventFired event.
-Page.domContentEventFired fires when a content event occurs in the DOM.
+Sets given markup as the document's HTML.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-domContentEventFired
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDocumentContent
 */
-func (protocol *PageProtocol) OnDOMContentEventFired(
-	callback func(event *page.DOMContentEventFiredEvent),
-) {
-	handler := NewEventHandler(
-		"Page.domContentEventFired",
-		func(response *Response) {
-			event := &page.DOMContentEventFiredEvent{}
-			json.Unmarshal([]byte(response.Params), event)
-			if nil != response.Error && 0 != response.Error.Code {
-				event.Err = response.Error
-			}
-			callback(event)
-		},
-	)
-	protocol.Socket.AddEventHandler(handler)
-}
+func (protocol *PageProtocol) SetDocumentContent(
+	params *page.SetDocumentContentParams,
+) <-chan *page.SetDocumentContentResult {
+	resultChan := make(chan *page.SetDocumentContentResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDocumentContent", params)
+	result := &page.SetDocumentContentResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set the behavior when downloading a file. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setDownloadBehavior
+*/
+func (protocol *PageProtocol) SetDownloadBehavior(
+	params *page.SetDownloadBehaviorParams,
+) <-chan *page.SetDownloadBehaviorResult {
+	resultChan := make(chan *page.SetDownloadBehaviorResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setDownloadBehavior", params)
+	result := &page.SetDownloadBehaviorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Overrides the Geolocation Position or Error. Omitting any of the parameters
+emulates position unavailable.
+
+The parameters are optional. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setGeolocationOverride
+*/
+func (protocol *PageProtocol) SetGeolocationOverride(
+	params ...*page.SetGeolocationOverrideParams,
+) <-chan *page.SetGeolocationOverrideResult {
+	resultChan := make(chan *page.SetGeolocationOverrideResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setGeolocationOverride", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.setGeolocationOverride", params[0])
+	}
+	result := &page.SetGeolocationOverrideResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Controls whether page will emit lifecycle events.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setLifecycleEventsEnabled
+*/
+func (protocol *PageProtocol) SetLifecycleEventsEnabled(
+	params *page.SetLifecycleEventsEnabledParams,
+) <-chan *page.SetLifecycleEventsEnabledResult {
+	resultChan := make(chan *page.SetLifecycleEventsEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setLifecycleEventsEnabled", params)
+	result := &page.SetLifecycleEventsEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Toggles mouse event-based touch event emulation. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setTouchEmulationEnabled
+*/
+func (protocol *PageProtocol) SetTouchEmulationEnabled(
+	params *page.SetTouchEmulationEnabledParams,
+) <-chan *page.SetTouchEmulationEnabledResult {
+	resultChan := make(chan *page.SetTouchEmulationEnabledResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setTouchEmulationEnabled", params)
+	result := &page.SetTouchEmulationEnabledResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Starts sending each frame using the `screencastFrame` event.
+
+The parameters are optional. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-startScreencast
+*/
+func (protocol *PageProtocol) StartScreencast(
+	params ...*page.StartScreencastParams,
+) <-chan *page.StartScreencastResult {
+	resultChan := make(chan *page.StartScreencastResult, 1)
+	command := NewCommand(protocol.Socket, "Page.startScreencast", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Page.startScreencast", params[0])
+	}
+	result := &page.StartScreencastResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Force the page stop all navigations and pending resource fetches.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopLoading
+*/
+func (protocol *PageProtocol) StopLoading() <-chan *page.StopLoadingResult {
+	resultChan := make(chan *page.StopLoadingResult, 1)
+	command := NewCommand(protocol.Socket, "Page.stopLoading", nil)
+	result := &page.StopLoadingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Crashes renderer on the IO thread, generates minidumps. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-crash
+*/
+func (protocol *PageProtocol) Crash() <-chan *page.CrashResult {
+	resultChan := make(chan *page.CrashResult, 1)
+	command := NewCommand(protocol.Socket, "Page.crash", nil)
+	result := &page.CrashResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Tries to close page, running its beforeunload hooks, if any.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-close
+*/
+func (protocol *PageProtocol) Close() <-chan *page.CloseResult {
+	resultChan := make(chan *page.CloseResult, 1)
+	command := NewCommand(protocol.Socket, "Page.close", nil)
+	result := &page.CloseResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Tries to update the web lifecycle state of the page. It will transition the page
+to the given state according to: https://github.com/WICG/web-lifecycle/
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setWebLifecycleState
+*/
+func (protocol *PageProtocol) SetWebLifecycleState(
+	params *page.SetWebLifecycleStateParams,
+) <-chan *page.SetWebLifecycleStateResult {
+	resultChan := make(chan *page.SetWebLifecycleStateResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setWebLifecycleState", params)
+	result := &page.SetWebLifecycleStateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Stops sending each frame in the `screencastFrame`. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-stopScreencast
+*/
+func (protocol *PageProtocol) StopScreencast() <-chan *page.StopScreencastResult {
+	resultChan := make(chan *page.StopScreencastResult, 1)
+	command := NewCommand(protocol.Socket, "Page.stopScreencast", nil)
+	result := &page.StopScreencastResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Requests backend to produce compilation cache for the specified scripts.
+`scripts` are appended to the list of scripts for which the cache would be
+produced. The list may be reset during page navigation. When script with a
+matching URL is encountered, the cache is optionally produced upon backend
+discretion, based on internal heuristics. See also:
+`Page.compilationCacheProduced`. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-produceCompilationCache
+*/
+func (protocol *PageProtocol) ProduceCompilationCache(
+	params *page.ProduceCompilationCacheParams,
+) <-chan *page.ProduceCompilationCacheResult {
+	resultChan := make(chan *page.ProduceCompilationCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Page.produceCompilationCache", params)
+	result := &page.ProduceCompilationCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Seeds compilation cache for given url. Compilation cache does not survive
+cross-process navigation. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-addCompilationCache
+*/
+func (protocol *PageProtocol) AddCompilationCache(
+	params *page.AddCompilationCacheParams,
+) <-chan *page.AddCompilationCacheResult {
+	resultChan := make(chan *page.AddCompilationCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Page.addCompilationCache", params)
+	result := &page.AddCompilationCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears seeded compilation cache. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-clearCompilationCache
+*/
+func (protocol *PageProtocol) ClearCompilationCache() <-chan *page.ClearCompilationCacheResult {
+	resultChan := make(chan *page.ClearCompilationCacheResult, 1)
+	command := NewCommand(protocol.Socket, "Page.clearCompilationCache", nil)
+	result := &page.ClearCompilationCacheResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets the Secure Payment Confirmation transaction mode.
+https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setSPCTransactionMode
+*/
+func (protocol *PageProtocol) SetSPCTransactionMode(
+	params *page.SetSPCTransactionModeParams,
+) <-chan *page.SetSPCTransactionModeResult {
+	resultChan := make(chan *page.SetSPCTransactionModeResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setSPCTransactionMode", params)
+	result := &page.SetSPCTransactionModeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Extensions for Custom Handlers API:
+https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setRPHRegistrationMode
+*/
+func (protocol *PageProtocol) SetRPHRegistrationMode(
+	params *page.SetRPHRegistrationModeParams,
+) <-chan *page.SetRPHRegistrationModeResult {
+	resultChan := make(chan *page.SetRPHRegistrationModeResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setRPHRegistrationMode", params)
+	result := &page.SetRPHRegistrationModeResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Generates a report for testing. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-generateTestReport
+*/
+func (protocol *PageProtocol) GenerateTestReport(
+	params *page.GenerateTestReportParams,
+) <-chan *page.GenerateTestReportResult {
+	resultChan := make(chan *page.GenerateTestReportResult, 1)
+	command := NewCommand(protocol.Socket, "Page.generateTestReport", params)
+	result := &page.GenerateTestReportResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Pauses page execution. Can be resumed using generic
+Runtime.runIfWaitingForDebugger. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-waitForDebugger
+*/
+func (protocol *PageProtocol) WaitForDebugger() <-chan *page.WaitForDebuggerResult {
+	resultChan := make(chan *page.WaitForDebuggerResult, 1)
+	command := NewCommand(protocol.Socket, "Page.waitForDebugger", nil)
+	result := &page.WaitForDebuggerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Intercept file chooser requests and transfer control to protocol clients. When
+file chooser interception is enabled, native file chooser dialog is not shown.
+Instead, a protocol event `Page.fileChooserOpened` is emitted.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setInterceptFileChooserDialog
+*/
+func (protocol *PageProtocol) SetInterceptFileChooserDialog(
+	params *page.SetInterceptFileChooserDialogParams,
+) <-chan *page.SetInterceptFileChooserDialogResult {
+	resultChan := make(chan *page.SetInterceptFileChooserDialogResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setInterceptFileChooserDialog", params)
+	result := &page.SetInterceptFileChooserDialogResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enable/disable prerendering manually.
+
+This command is a short-term solution for https://crbug.com/1440085. See
+https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA
+for more details.
+
+TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab
+targets. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setPrerenderingAllowed
+*/
+func (protocol *PageProtocol) SetPrerenderingAllowed(
+	params *page.SetPrerenderingAllowedParams,
+) <-chan *page.SetPrerenderingAllowedResult {
+	resultChan := make(chan *page.SetPrerenderingAllowedResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setPrerenderingAllowed", params)
+	result := &page.SetPrerenderingAllowedResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Is experimental. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-requestAppBanner
+*/
+func (protocol *PageProtocol) RequestAppBanner() <-chan *page.RequestAppBannerResult {
+	resultChan := make(chan *page.RequestAppBannerResult, 1)
+	command := NewCommand(protocol.Socket, "Page.requestAppBanner", nil)
+	result := &page.RequestAppBannerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Controls whether browser will open a new inspector window for connected pages.
+DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#method-setAutoAttachToCreatedPages
+*/
+func (protocol *PageProtocol) SetAutoAttachToCreatedPages(
+	params *page.SetAutoAttachToCreatedPagesParams,
+) <-chan *page.SetAutoAttachToCreatedPagesResult {
+	resultChan := make(chan *page.SetAutoAttachToCreatedPagesResult, 1)
+	command := NewCommand(protocol.Socket, "Page.setAutoAttachToCreatedPages", params)
+	result := &page.SetAutoAttachToCreatedPagesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnDOMContentEventFired adds a handler to the Page.domContentEventFired event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-domContentEventFired
+*/
+func (protocol *PageProtocol) OnDOMContentEventFired(
+	callback func(event *page.DOMContentEventFiredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.domContentEventFired",
+		func(response *Response) {
+			event := &page.DOMContentEventFiredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFileChooserOpened adds a handler to the Page.fileChooserOpened event.
+
+Emitted only when `page.interceptFileChooser` is enabled.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-fileChooserOpened
+*/
+func (protocol *PageProtocol) OnFileChooserOpened(
+	callback func(event *page.FileChooserOpenedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.fileChooserOpened",
+		func(response *Response) {
+			event := &page.FileChooserOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
 
 /*
-OnFrameAttached adds a handler to the Page.frameAttached event. Page.frameAttached
-fires when a frame has been attached to its parent.
+OnFrameAttached adds a handler to the Page.frameAttached event.
+
+Fired when frame has been attached to its parent.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameAttached
 */
 func (protocol *PageProtocol) OnFrameAttached(
 	callback func(event *page.FrameAttachedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameAttached",
 		func(response *Response) {
@@ -827,19 +1802,20 @@ func (protocol *PageProtocol) OnFrameAttached(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnFrameClearedScheduledNavigation adds a handler to the Page.frameClearedScheduledNavigation
-event. Page.frameClearedScheduledNavigation fires when a frame no longer has a
-scheduled navigation.
+OnFrameClearedScheduledNavigation adds a handler to the
+Page.frameClearedScheduledNavigation event.
+
+Fired when frame no longer has a scheduled navigation. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameClearedScheduledNavigation
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
 	callback func(event *page.FrameClearedScheduledNavigationEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameClearedScheduledNavigation",
 		func(response *Response) {
@@ -852,17 +1828,19 @@ func (protocol *PageProtocol) OnFrameClearedScheduledNavigation(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnFrameDetached adds a handler to the Page.frameDetached event. Page.frameDetached
-fires when a frame has been detached from its parent.
+OnFrameDetached adds a handler to the Page.frameDetached event.
+
+Fired when frame has been detached from its parent.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameDetached
 */
 func (protocol *PageProtocol) OnFrameDetached(
 	callback func(event *page.FrameDetachedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameDetached",
 		func(response *Response) {
@@ -875,18 +1853,47 @@ func (protocol *PageProtocol) OnFrameDetached(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameSubtreeWillBeDetached adds a handler to the
+Page.frameSubtreeWillBeDetached event.
+
+Fired before frame subtree is detached. Emitted before any frame of the subtree
+is actually detached. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameSubtreeWillBeDetached
+*/
+func (protocol *PageProtocol) OnFrameSubtreeWillBeDetached(
+	callback func(event *page.FrameSubtreeWillBeDetachedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameSubtreeWillBeDetached",
+		func(response *Response) {
+			event := &page.FrameSubtreeWillBeDetachedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnFrameNavigated adds a handler to the Page.frameNavigated event. Page.frameNavigated
-fires once navigation of the frame has completed. Frame is now associated with
+OnFrameNavigated adds a handler to the Page.frameNavigated event.
+
+Fired once navigation of the frame has completed. Frame is now associated with
 the new loader.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameNavigated
 */
 func (protocol *PageProtocol) OnFrameNavigated(
 	callback func(event *page.FrameNavigatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameNavigated",
 		func(response *Response) {
@@ -899,18 +1906,44 @@ func (protocol *PageProtocol) OnFrameNavigated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnFrameResized adds a handler to the Page.frameResized event. Page.frameResized
-fires when frame is resized.
+OnDocumentOpened adds a handler to the Page.documentOpened event.
+
+Fired when opening document to write to. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-documentOpened
+*/
+func (protocol *PageProtocol) OnDocumentOpened(
+	callback func(event *page.DocumentOpenedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.documentOpened",
+		func(response *Response) {
+			event := &page.DocumentOpenedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameResized adds a handler to the Page.frameResized event.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameResized
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameResized
 */
 func (protocol *PageProtocol) OnFrameResized(
 	callback func(event *page.FrameResizedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameResized",
 		func(response *Response) {
@@ -923,19 +1956,78 @@ func (protocol *PageProtocol) OnFrameResized(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameStartedNavigating adds a handler to the Page.frameStartedNavigating
+event.
+
+Fired when a navigation starts. This event is fired for both renderer-initiated
+and browser-initiated navigations. For renderer-initiated navigations, the event
+is fired after `frameRequestedNavigation`. Navigation may still be cancelled
+after the event is issued. Multiple events can be fired for a single navigation,
+for example, when a same-document navigation becomes a cross-document navigation
+(such as in the case of a frameset). EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedNavigating
+*/
+func (protocol *PageProtocol) OnFrameStartedNavigating(
+	callback func(event *page.FrameStartedNavigatingEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameStartedNavigating",
+		func(response *Response) {
+			event := &page.FrameStartedNavigatingEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnFrameRequestedNavigation adds a handler to the Page.frameRequestedNavigation
+event.
+
+Fired when a renderer-initiated navigation is requested. Navigation may still be
+cancelled after the event is issued. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameRequestedNavigation
+*/
+func (protocol *PageProtocol) OnFrameRequestedNavigation(
+	callback func(event *page.FrameRequestedNavigationEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.frameRequestedNavigation",
+		func(response *Response) {
+			event := &page.FrameRequestedNavigationEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnFrameScheduledNavigation adds a handler to the Page.frameScheduledNavigation
-event. Page.frameScheduledNavigation fires when frame schedules a potential
-navigation.
+event.
+
+Fired when frame schedules a potential navigation. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameScheduledNavigation
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameScheduledNavigation(
 	callback func(event *page.FrameScheduledNavigationEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameScheduledNavigation",
 		func(response *Response) {
@@ -948,18 +2040,19 @@ func (protocol *PageProtocol) OnFrameScheduledNavigation(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnFrameStartedLoading adds a handler to the Page.frameStartedLoading event.
-Page.frameStartedLoading fires when frame has started loading.
+
+Fired when frame has started loading. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStartedLoading
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameStartedLoading(
 	callback func(event *page.FrameStartedLoadingEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameStartedLoading",
 		func(response *Response) {
@@ -972,18 +2065,19 @@ func (protocol *PageProtocol) OnFrameStartedLoading(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnFrameStoppedLoading adds a handler to the Page.frameStoppedLoading event.
-Page.frameStoppedLoading fires when frame has stopped loading.
+
+Fired when frame has stopped loading. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-frameStoppedLoading
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnFrameStoppedLoading(
 	callback func(event *page.FrameStoppedLoadingEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.frameStoppedLoading",
 		func(response *Response) {
@@ -996,17 +2090,71 @@ func (protocol *PageProtocol) OnFrameStoppedLoading(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDownloadWillBegin adds a handler to the Page.downloadWillBegin event.
+
+Fired when page is about to start a download. Deprecated. Use
+Browser.downloadWillBegin instead. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-downloadWillBegin
+*/
+func (protocol *PageProtocol) OnDownloadWillBegin(
+	callback func(event *page.DownloadWillBeginEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.downloadWillBegin",
+		func(response *Response) {
+			event := &page.DownloadWillBeginEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnDownloadProgress adds a handler to the Page.downloadProgress event.
+
+Fired when download makes progress. Last call has |done| == true. Deprecated.
+Use Browser.downloadProgress instead. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-downloadProgress
+*/
+func (protocol *PageProtocol) OnDownloadProgress(
+	callback func(event *page.DownloadProgressEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.downloadProgress",
+		func(response *Response) {
+			event := &page.DownloadProgressEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnInterstitialHidden adds a handler to the Page.interstitialHidden event.
-Page.interstitialHidden fires when interstitial page was hidden.
+
+# Fired when interstitial page was hidden
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialHidden
 */
 func (protocol *PageProtocol) OnInterstitialHidden(
 	callback func(event *page.InterstitialHiddenEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.interstitialHidden",
 		func(response *Response) {
@@ -1019,17 +2167,19 @@ func (protocol *PageProtocol) OnInterstitialHidden(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnInterstitialShown adds a handler to the Page.interstitialShown event.
-Page.interstitialShown fires when interstitial page was shown.
+
+# Fired when interstitial page was shown
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-interstitialShown
 */
 func (protocol *PageProtocol) OnInterstitialShown(
 	callback func(event *page.InterstitialShownEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.interstitialShown",
 		func(response *Response) {
@@ -1042,18 +2192,21 @@ func (protocol *PageProtocol) OnInterstitialShown(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnJavascriptDialogClosed adds a handler to the Page.javascriptDialogClosed
-event. Page.javascriptDialogClosed fires when a JavaScript initiated dialog
-(alert, confirm, prompt, or onbeforeunload) has been closed.
+event.
+
+Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) has been closed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogClosed
 */
 func (protocol *PageProtocol) OnJavascriptDialogClosed(
 	callback func(event *page.JavascriptDialogClosedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.javascriptDialogClosed",
 		func(response *Response) {
@@ -1066,18 +2219,21 @@ func (protocol *PageProtocol) OnJavascriptDialogClosed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnJavascriptDialogOpening adds a handler to the Page.javascriptDialogOpening
-event. Page.javascriptDialogOpening fires when a JavaScript initiated dialog
-(alert, confirm, prompt, or onbeforeunload) is about to open.
+event.
+
+Fired when a JavaScript initiated dialog (alert, confirm, prompt, or
+onbeforeunload) is about to open.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-javascriptDialogOpening
 */
 func (protocol *PageProtocol) OnJavascriptDialogOpening(
 	callback func(event *page.JavascriptDialogOpeningEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.javascriptDialogOpening",
 		func(response *Response) {
@@ -1090,17 +2246,20 @@ func (protocol *PageProtocol) OnJavascriptDialogOpening(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnLifecycleEvent adds a handler to the Page.lifecycleEvent event. Page.lifecycleEvent
-fires for top level page lifecycle events such as navigation, load, paint, etc.
+OnLifecycleEvent adds a handler to the Page.lifecycleEvent event.
+
+Fired for lifecycle events (navigation, load, paint, etc) in the current target
+(including local frames).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-lifecycleEvent
 */
 func (protocol *PageProtocol) OnLifecycleEvent(
 	callback func(event *page.LifecycleEventEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.lifecycleEvent",
 		func(response *Response) {
@@ -1113,17 +2272,46 @@ func (protocol *PageProtocol) OnLifecycleEvent(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnBackForwardCacheNotUsed adds a handler to the Page.backForwardCacheNotUsed
+event.
+
+Fired for failed bfcache history navigations if BackForwardCache feature is
+enabled. Do not assume any ordering with the Page.frameNavigated event. This
+event is fired only for main-frame history navigation where the document changes
+(non-same-document navigations), when bfcache navigation fails. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-backForwardCacheNotUsed
+*/
+func (protocol *PageProtocol) OnBackForwardCacheNotUsed(
+	callback func(event *page.BackForwardCacheNotUsedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.backForwardCacheNotUsed",
+		func(response *Response) {
+			event := &page.BackForwardCacheNotUsedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnLoadEventFired adds a handler to the Page.loadEventFired event. Page.loadEventFired
-fires when the page has finished loading.
+OnLoadEventFired adds a handler to the Page.loadEventFired event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-loadEventFired
 */
 func (protocol *PageProtocol) OnLoadEventFired(
 	callback func(event *page.LoadEventFiredEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.loadEventFired",
 		func(response *Response) {
@@ -1136,18 +2324,46 @@ func (protocol *PageProtocol) OnLoadEventFired(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNavigatedWithinDocument adds a handler to the Page.navigatedWithinDocument
+event.
+
+Fired when same-document navigation happens, e.g. due to history API usage or
+anchor navigation. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-navigatedWithinDocument
+*/
+func (protocol *PageProtocol) OnNavigatedWithinDocument(
+	callback func(event *page.NavigatedWithinDocumentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.navigatedWithinDocument",
+		func(response *Response) {
+			event := &page.NavigatedWithinDocumentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnScreencastFrame adds a handler to the Page.screencastFrame event. Page.screencastFrame
-fires when compressed image data is requested by the `startScreencast` method.
+OnScreencastFrame adds a handler to the Page.screencastFrame event.
+
+Compressed image data requested by the `startScreencast`. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastFrame
-EXPERIMENTAL.
 */
 func (protocol *PageProtocol) OnScreencastFrame(
 	callback func(event *page.ScreencastFrameEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.screencastFrame",
 		func(response *Response) {
@@ -1160,19 +2376,21 @@ func (protocol *PageProtocol) OnScreencastFrame(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnScreencastVisibilityChanged adds a handler to the Page.screencastVisibilityChanged
-event. Page.screencastVisibilityChanged fires when the page with currently
-enabled screencast was shown or hidden.
+OnScreencastVisibilityChanged adds a handler to the
+Page.screencastVisibilityChanged event.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastVisibilityChanged
+Fired when the page with currently enabled screencast was shown or hidden `.
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-screencastVisibilityChanged
 */
 func (protocol *PageProtocol) OnScreencastVisibilityChanged(
 	callback func(event *page.ScreencastVisibilityChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.screencastVisibilityChanged",
 		func(response *Response) {
@@ -1185,18 +2403,20 @@ func (protocol *PageProtocol) OnScreencastVisibilityChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnWindowOpen adds a handler to the Page.windowOpen event. Page.windowOpen fires
-when a new window is going to be opened, via window.open(), link click, form
-submission, etc.
+OnWindowOpen adds a handler to the Page.windowOpen event.
+
+Fired when a new window is going to be opened, via window.open(), link click,
+form submission, etc.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-windowOpen
 */
 func (protocol *PageProtocol) OnWindowOpen(
 	callback func(event *page.WindowOpenEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Page.windowOpen",
 		func(response *Response) {
@@ -1209,4 +2429,31 @@ func (protocol *PageProtocol) OnWindowOpen(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCompilationCacheProduced adds a handler to the Page.compilationCacheProduced
+event.
+
+Issued for every compilation cache generated. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Page/#event-compilationCacheProduced
+*/
+func (protocol *PageProtocol) OnCompilationCacheProduced(
+	callback func(event *page.CompilationCacheProducedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Page.compilationCacheProduced",
+		func(response *Response) {
+			event := &page.CompilationCacheProducedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
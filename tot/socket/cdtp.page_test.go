@@ -1,11 +1,13 @@
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/debugger"
 	"github.com/mkenney/go-chrome/tot/page"
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -95,6 +97,29 @@ func TestPageAddScriptToEvaluateOnNewDocument(t *testing.T) {
 	}
 }
 
+func TestPageWithContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPageWithContext")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	if context.Background() != mockSocket.Page().Context() {
+		t.Errorf("Expected the background context by default")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	protocol := mockSocket.Page().WithContext(ctx)
+	if ctx != protocol.Context() {
+		t.Errorf("Expected the protocol to be bound to the provided context")
+	}
+
+	result := <-protocol.CaptureScreenshot(&page.CaptureScreenshotParams{})
+	if nil == result.Err {
+		t.Errorf("Expected error, got success")
+	}
+}
+
 func TestPageBringToFront(t *testing.T) {
 	socketURL, _ := url.Parse("https://test:9222/TestPageBringToFront")
 	mockSocket := NewMock(socketURL)
@@ -137,7 +162,7 @@ func TestPageCaptureScreenshot(t *testing.T) {
 
 	params := &page.CaptureScreenshotParams{
 		Format:  page.Format.Jpeg,
-		Quality: 50,
+		Quality: optional.Int(50),
 		Clip: &page.Viewport{
 			X:      1,
 			Y:      1,
@@ -145,7 +170,7 @@ func TestPageCaptureScreenshot(t *testing.T) {
 			Height: 1,
 			Scale:  1,
 		},
-		FromSurface: true,
+		FromSurface: optional.Bool(true),
 	}
 	resultChan := mockSocket.Page().CaptureScreenshot(params)
 	mockResult := &page.CaptureScreenshotResult{
@@ -188,8 +213,8 @@ func TestPageCreateIsolatedWorld(t *testing.T) {
 
 	params := &page.CreateIsolatedWorldParams{
 		FrameID:             page.FrameID("frame-id"),
-		WorldName:           "world-name",
-		GrantUniveralAccess: true,
+		WorldName:           optional.String("world-name"),
+		GrantUniveralAccess: optional.Bool(true),
 	}
 	resultChan := mockSocket.Page().CreateIsolatedWorld(params)
 	mockResult := &page.CreateIsolatedWorldResult{
@@ -306,7 +331,7 @@ func TestPageGetAppManifest(t *testing.T) {
 			Line:     1,
 			Column:   1,
 		}},
-		Data: "some data",
+		Data: optional.String("some data"),
 	}
 	resultChan := mockSocket.Page().GetAppManifest(params)
 	mockResult := &page.GetAppManifestResult{}
@@ -595,7 +620,7 @@ func TestPageHandleJavaScriptDialog(t *testing.T) {
 
 	params := &page.HandleJavaScriptDialogParams{
 		Accept:     true,
-		PromptText: "prompt text",
+		PromptText: optional.String("prompt text"),
 	}
 	resultChan := mockSocket.Page().HandleJavaScriptDialog(params)
 	mockResult := &page.HandleJavaScriptDialogResult{}
@@ -633,8 +658,8 @@ func TestPageNavigate(t *testing.T) {
 
 	params := &page.NavigateParams{
 		URL:            "http://some.url",
-		Referrer:       "http://referrer.url",
-		TransitionType: page.TransitionType("transition-type"),
+		Referrer:       optional.String("http://referrer.url"),
+		TransitionType: optional.Of(page.TransitionType("transition-type")),
 	}
 	resultChan := mockSocket.Page().Navigate(params)
 	mockResult := &page.NavigateResult{
@@ -715,18 +740,18 @@ func TestPagePrintToPDF(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &page.PrintToPDFParams{
-		Landscape:               true,
-		DisplayHeaderFooter:     true,
-		PrintBackground:         true,
-		Scale:                   1,
-		PaperWidth:              1,
-		PaperHeight:             1,
-		MarginTop:               1,
-		MarginBottom:            1,
-		MarginLeft:              1,
-		MarginRight:             1,
-		PageRanges:              "1-2",
-		IgnoreInvalidPageRanges: true,
+		Landscape:               optional.Bool(true),
+		DisplayHeaderFooter:     optional.Bool(true),
+		PrintBackground:         optional.Bool(true),
+		Scale:                   optional.Float64(1),
+		PaperWidth:              optional.Float64(1),
+		PaperHeight:             optional.Float64(1),
+		MarginTop:               optional.Float64(1),
+		MarginBottom:            optional.Float64(1),
+		MarginLeft:              optional.Float64(1),
+		MarginRight:             optional.Float64(1),
+		PageRanges:              optional.String("1-2"),
+		IgnoreInvalidPageRanges: optional.Bool(true),
 	}
 	resultChan := mockSocket.Page().PrintToPDF(params)
 	mockResult := &page.PrintToPDFResult{
@@ -768,8 +793,8 @@ func TestPageReload(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &page.ReloadParams{
-		IgnoreCache:            true,
-		ScriptToEvaluateOnLoad: "some-script",
+		IgnoreCache:            optional.Bool(true),
+		ScriptToEvaluateOnLoad: optional.String("some-script"),
 	}
 	resultChan := mockSocket.Page().Reload(params)
 	mockResult := &page.ReloadResult{}
@@ -954,8 +979,8 @@ func TestPageSearchInResource(t *testing.T) {
 		FrameID:       page.FrameID("frame-id"),
 		URL:           "http://some.url",
 		Query:         "some query",
-		CaseSensitive: true,
-		IsRegex:       true,
+		CaseSensitive: optional.Bool(true),
+		IsRegex:       optional.Bool(true),
 	}
 	resultChan := mockSocket.Page().SearchInResource(params)
 	mockResult := &page.SearchInResourceResult{
@@ -975,7 +1000,7 @@ func TestPageSearchInResource(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 	if mockResult.Result[0].LineNumber != result.Result[0].LineNumber {
-		t.Errorf("Expected %d, got %d", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
+		t.Errorf("Expected %v, got %v", mockResult.Result[0].LineNumber, result.Result[0].LineNumber)
 	}
 
 	resultChan = mockSocket.Page().SearchInResource(params)
@@ -1113,7 +1138,7 @@ func TestPageSetDownloadBehavior(t *testing.T) {
 
 	params := &page.SetDownloadBehaviorParams{
 		Behavior:     page.Behavior.Allow,
-		DownloadPath: "/some/path",
+		DownloadPath: optional.String("/some/path"),
 	}
 	resultChan := mockSocket.Page().SetDownloadBehavior(params)
 	mockResult := &page.SetDownloadBehaviorResult{}
@@ -1188,10 +1213,10 @@ func TestPageStartScreencast(t *testing.T) {
 
 	params := &page.StartScreencastParams{
 		Format:        page.Format.Jpeg,
-		Quality:       1,
-		MaxWidth:      1,
-		MaxHeight:     1,
-		EveryNthFrame: 1,
+		Quality:       optional.Int(1),
+		MaxWidth:      optional.Int(1),
+		MaxHeight:     optional.Int(1),
+		EveryNthFrame: optional.Int(1),
 	}
 	resultChan := mockSocket.Page().StartScreencast(params)
 	mockResult := &page.StartScreencastResult{}
@@ -1314,7 +1339,7 @@ func TestPageOnDOMContentEventFired(t *testing.T) {
 		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
 	}
 	if mockResult.Timestamp != result.Timestamp {
-		t.Errorf("Expected %d, got %d", mockResult.Timestamp, result.Timestamp)
+		t.Errorf("Expected %v, got %v", mockResult.Timestamp, result.Timestamp)
 	}
 
 	resultChan = make(chan *page.DOMContentEventFiredEvent)
@@ -1978,7 +2003,7 @@ func TestPageOnLoadEventFired(t *testing.T) {
 		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
 	}
 	if mockResult.Timestamp != result.Timestamp {
-		t.Errorf("Expected %d, got %d", mockResult.Timestamp, result.Timestamp)
+		t.Errorf("Expected %v, got %v", mockResult.Timestamp, result.Timestamp)
 	}
 
 	resultChan = make(chan *page.LoadEventFiredEvent)
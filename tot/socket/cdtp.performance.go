@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/performance"
@@ -14,21 +17,47 @@ https://chromedevtools.github.io/devtools-protocol/tot/Performance/
 */
 type PerformanceProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-Disable disables collecting and reporting metrics.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PerformanceProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PerformanceProtocol) WithContext(ctx context.Context) *PerformanceProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PerformanceProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disable collecting and reporting metrics.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-disable
 */
 func (protocol *PerformanceProtocol) Disable() <-chan *performance.DisableResult {
-	resultChan := make(chan *performance.DisableResult)
+	resultChan := make(chan *performance.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.disable", nil)
 	result := &performance.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -39,18 +68,27 @@ func (protocol *PerformanceProtocol) Disable() <-chan *performance.DisableResult
 }
 
 /*
-Enable enables collecting and reporting metrics.
+Enable collecting and reporting metrics.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-enable
 */
-func (protocol *PerformanceProtocol) Enable() <-chan *performance.EnableResult {
-	resultChan := make(chan *performance.EnableResult)
+func (protocol *PerformanceProtocol) Enable(
+	params ...*performance.EnableParams,
+) <-chan *performance.EnableResult {
+	resultChan := make(chan *performance.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Performance.enable", params[0])
+	}
 	result := &performance.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -61,18 +99,48 @@ func (protocol *PerformanceProtocol) Enable() <-chan *performance.EnableResult {
 }
 
 /*
-GetMetrics retrieves current values of run-time metrics.
+Sets time domain to use for collecting and reporting duration metrics. Note that
+this must be called before enabling metrics collection. Calling this method
+while metrics collection is enabled returns an error. EXPERIMENTAL. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-setTimeDomain
+*/
+func (protocol *PerformanceProtocol) SetTimeDomain(
+	params *performance.SetTimeDomainParams,
+) <-chan *performance.SetTimeDomainResult {
+	resultChan := make(chan *performance.SetTimeDomainResult, 1)
+	command := NewCommand(protocol.Socket, "Performance.setTimeDomain", params)
+	result := &performance.SetTimeDomainResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Retrieve current values of run-time metrics.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#method-getMetrics
 */
 func (protocol *PerformanceProtocol) GetMetrics() <-chan *performance.GetMetricsResult {
-	resultChan := make(chan *performance.GetMetricsResult)
+	resultChan := make(chan *performance.GetMetricsResult, 1)
 	command := NewCommand(protocol.Socket, "Performance.getMetrics", nil)
 	result := &performance.GetMetricsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -85,14 +153,15 @@ func (protocol *PerformanceProtocol) GetMetrics() <-chan *performance.GetMetrics
 }
 
 /*
-OnMetrics adds a handler to the Performance.metrics event. Performance.metrics
-returns current values of the metrics.
+OnMetrics adds a handler to the Performance.metrics event.
+
+Current values of the metrics.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Performance/#event-metrics
 */
 func (protocol *PerformanceProtocol) OnMetrics(
 	callback func(event *performance.MetricsEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Performance.metrics",
 		func(response *Response) {
@@ -105,4 +174,5 @@ func (protocol *PerformanceProtocol) OnMetrics(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -0,0 +1,102 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/performance/timeline"
+)
+
+/*
+PerformanceTimelineProtocol provides a namespace for the Chrome
+PerformanceTimeline protocol methods.
+
+Reporting of performance timeline events, as specified in
+https://w3c.github.io/performance-timeline/#dom-performanceobserver.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/
+*/
+type PerformanceTimelineProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PerformanceTimelineProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PerformanceTimelineProtocol) WithContext(ctx context.Context) *PerformanceTimelineProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PerformanceTimelineProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Previously buffered events would be reported before method returns. See also:
+timelineEventAdded
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#method-enable
+*/
+func (protocol *PerformanceTimelineProtocol) Enable(
+	params *timeline.EnableParams,
+) <-chan *timeline.EnableResult {
+	resultChan := make(chan *timeline.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "PerformanceTimeline.enable", params)
+	result := &timeline.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnTimelineEventAdded adds a handler to the
+PerformanceTimeline.timelineEventAdded event.
+
+Sent when a performance timeline event is added. See reportPerformanceTimeline
+method.
+
+https://chromedevtools.github.io/devtools-protocol/tot/PerformanceTimeline/#event-timelineEventAdded
+*/
+func (protocol *PerformanceTimelineProtocol) OnTimelineEventAdded(
+	callback func(event *timeline.EventAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"PerformanceTimeline.timelineEventAdded",
+		func(response *Response) {
+			event := &timeline.EventAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
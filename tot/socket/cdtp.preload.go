@@ -0,0 +1,245 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/preload"
+)
+
+/*
+PreloadProtocol provides a namespace for the Chrome Preload protocol methods.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/
+*/
+type PreloadProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *PreloadProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *PreloadProtocol) WithContext(ctx context.Context) *PreloadProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &PreloadProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enable sends the Preload.enable command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#method-enable
+*/
+func (protocol *PreloadProtocol) Enable() <-chan *preload.EnableResult {
+	resultChan := make(chan *preload.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "Preload.enable", nil)
+	result := &preload.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable sends the Preload.disable command.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#method-disable
+*/
+func (protocol *PreloadProtocol) Disable() <-chan *preload.DisableResult {
+	resultChan := make(chan *preload.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "Preload.disable", nil)
+	result := &preload.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnRuleSetUpdated adds a handler to the Preload.ruleSetUpdated event.
+
+Upsert. Currently, it is only emitted when a rule set added.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-ruleSetUpdated
+*/
+func (protocol *PreloadProtocol) OnRuleSetUpdated(
+	callback func(event *preload.RuleSetUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.ruleSetUpdated",
+		func(response *Response) {
+			event := &preload.RuleSetUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnRuleSetRemoved adds a handler to the Preload.ruleSetRemoved event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-ruleSetRemoved
+*/
+func (protocol *PreloadProtocol) OnRuleSetRemoved(
+	callback func(event *preload.RuleSetRemovedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.ruleSetRemoved",
+		func(response *Response) {
+			event := &preload.RuleSetRemovedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPreloadEnabledStateUpdated adds a handler to the
+Preload.preloadEnabledStateUpdated event.
+
+Fired when a preload enabled state is updated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-preloadEnabledStateUpdated
+*/
+func (protocol *PreloadProtocol) OnPreloadEnabledStateUpdated(
+	callback func(event *preload.EnabledStateUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.preloadEnabledStateUpdated",
+		func(response *Response) {
+			event := &preload.EnabledStateUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPrefetchStatusUpdated adds a handler to the Preload.prefetchStatusUpdated
+event.
+
+Fired when a prefetch attempt is updated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-prefetchStatusUpdated
+*/
+func (protocol *PreloadProtocol) OnPrefetchStatusUpdated(
+	callback func(event *preload.PrefetchStatusUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.prefetchStatusUpdated",
+		func(response *Response) {
+			event := &preload.PrefetchStatusUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPrerenderStatusUpdated adds a handler to the Preload.prerenderStatusUpdated
+event.
+
+Fired when a prerender attempt is updated.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-prerenderStatusUpdated
+*/
+func (protocol *PreloadProtocol) OnPrerenderStatusUpdated(
+	callback func(event *preload.PrerenderStatusUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.prerenderStatusUpdated",
+		func(response *Response) {
+			event := &preload.PrerenderStatusUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPreloadingAttemptSourcesUpdated adds a handler to the
+Preload.preloadingAttemptSourcesUpdated event.
+
+Send a list of sources for all preloading attempts in a document.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Preload/#event-preloadingAttemptSourcesUpdated
+*/
+func (protocol *PreloadProtocol) OnPreloadingAttemptSourcesUpdated(
+	callback func(event *preload.PreloadingAttemptSourcesUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Preload.preloadingAttemptSourcesUpdated",
+		func(response *Response) {
+			event := &preload.PreloadingAttemptSourcesUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
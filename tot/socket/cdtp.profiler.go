@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/profiler"
@@ -13,21 +16,47 @@ https://chromedevtools.github.io/devtools-protocol/tot/Profiler/
 */
 type ProfilerProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ProfilerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-Disable disables profiling.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ProfilerProtocol) WithContext(ctx context.Context) *ProfilerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ProfilerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Disable sends the Profiler.disable command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-disable
 */
 func (protocol *ProfilerProtocol) Disable() <-chan *profiler.DisableResult {
-	resultChan := make(chan *profiler.DisableResult)
+	resultChan := make(chan *profiler.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.disable", nil)
 	result := &profiler.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,18 +67,20 @@ func (protocol *ProfilerProtocol) Disable() <-chan *profiler.DisableResult {
 }
 
 /*
-Enable enables profiling.
+Enable sends the Profiler.enable command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-enable
 */
 func (protocol *ProfilerProtocol) Enable() <-chan *profiler.EnableResult {
-	resultChan := make(chan *profiler.EnableResult)
+	resultChan := make(chan *profiler.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.enable", nil)
 	result := &profiler.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -60,19 +91,21 @@ func (protocol *ProfilerProtocol) Enable() <-chan *profiler.EnableResult {
 }
 
 /*
-GetBestEffortCoverage collects coverage data for the current isolate. The
-coverage data may be incomplete due to garbage collection.
+Collect coverage data for the current isolate. The coverage data may be
+incomplete due to garbage collection.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-getBestEffortCoverage
 */
 func (protocol *ProfilerProtocol) GetBestEffortCoverage() <-chan *profiler.GetBestEffortCoverageResult {
-	resultChan := make(chan *profiler.GetBestEffortCoverageResult)
+	resultChan := make(chan *profiler.GetBestEffortCoverageResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.getBestEffortCoverage", nil)
 	result := &profiler.GetBestEffortCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -85,21 +118,23 @@ func (protocol *ProfilerProtocol) GetBestEffortCoverage() <-chan *profiler.GetBe
 }
 
 /*
-SetSamplingInterval changes CPU profiler sampling interval. Must be called
-before CPU profiles recording started.
+Changes CPU profiler sampling interval. Must be called before CPU profiles
+recording started.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-setSamplingInterval
 */
 func (protocol *ProfilerProtocol) SetSamplingInterval(
 	params *profiler.SetSamplingIntervalParams,
 ) <-chan *profiler.SetSamplingIntervalResult {
-	resultChan := make(chan *profiler.SetSamplingIntervalResult)
+	resultChan := make(chan *profiler.SetSamplingIntervalResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.setSamplingInterval", params)
 	result := &profiler.SetSamplingIntervalResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -110,18 +145,20 @@ func (protocol *ProfilerProtocol) SetSamplingInterval(
 }
 
 /*
-Start starts profiling.
+Start sends the Profiler.start command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-start
 */
 func (protocol *ProfilerProtocol) Start() <-chan *profiler.StartResult {
-	resultChan := make(chan *profiler.StartResult)
+	resultChan := make(chan *profiler.StartResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.start", nil)
 	result := &profiler.StartResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -132,23 +169,32 @@ func (protocol *ProfilerProtocol) Start() <-chan *profiler.StartResult {
 }
 
 /*
-StartPreciseCoverage enable precise code coverage. Coverage data for JavaScript
-executed before enabling precise code coverage may be incomplete. Enabling
-prevents running optimized code and resets execution counters.
+Enable precise code coverage. Coverage data for JavaScript executed before
+enabling precise code coverage may be incomplete. Enabling prevents running
+optimized code and resets execution counters.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startPreciseCoverage
 */
 func (protocol *ProfilerProtocol) StartPreciseCoverage(
-	params *profiler.StartPreciseCoverageParams,
+	params ...*profiler.StartPreciseCoverageParams,
 ) <-chan *profiler.StartPreciseCoverageResult {
-	resultChan := make(chan *profiler.StartPreciseCoverageResult)
-	command := NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", params)
+	resultChan := make(chan *profiler.StartPreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Profiler.startPreciseCoverage", params[0])
+	}
 	result := &profiler.StartPreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -158,20 +204,23 @@ func (protocol *ProfilerProtocol) StartPreciseCoverage(
 }
 
 /*
-StartTypeProfile enables type profile.
+Stop sends the Profiler.stop command.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startTypeProfile
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stop
 */
-func (protocol *ProfilerProtocol) StartTypeProfile() <-chan *profiler.StartTypeProfileResult {
-	resultChan := make(chan *profiler.StartTypeProfileResult)
-	command := NewCommand(protocol.Socket, "Profiler.startTypeProfile", nil)
-	result := &profiler.StartTypeProfileResult{}
+func (protocol *ProfilerProtocol) Stop() <-chan *profiler.StopResult {
+	resultChan := make(chan *profiler.StopResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.stop", nil)
+	result := &profiler.StopResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -181,21 +230,22 @@ func (protocol *ProfilerProtocol) StartTypeProfile() <-chan *profiler.StartTypeP
 }
 
 /*
-Stop stops profiling.
+Disable precise code coverage. Disabling releases unnecessary execution count
+records and allows executing optimized code.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stop
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopPreciseCoverage
 */
-func (protocol *ProfilerProtocol) Stop() <-chan *profiler.StopResult {
-	resultChan := make(chan *profiler.StopResult)
-	command := NewCommand(protocol.Socket, "Profiler.stop", nil)
-	result := &profiler.StopResult{}
+func (protocol *ProfilerProtocol) StopPreciseCoverage() <-chan *profiler.StopPreciseCoverageResult {
+	resultChan := make(chan *profiler.StopPreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.stopPreciseCoverage", nil)
+	result := &profiler.StopPreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
-		} else {
-			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -205,20 +255,24 @@ func (protocol *ProfilerProtocol) Stop() <-chan *profiler.StopResult {
 }
 
 /*
-StopPreciseCoverage disable precise code coverage. Disabling releases
-unnecessary execution count records and allows executing optimized code.
+Collect coverage data for the current isolate, and resets execution counters.
+Precise code coverage needs to have started.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopPreciseCoverage
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takePreciseCoverage
 */
-func (protocol *ProfilerProtocol) StopPreciseCoverage() <-chan *profiler.StopPreciseCoverageResult {
-	resultChan := make(chan *profiler.StopPreciseCoverageResult)
-	command := NewCommand(protocol.Socket, "Profiler.stopPreciseCoverage", nil)
-	result := &profiler.StopPreciseCoverageResult{}
+func (protocol *ProfilerProtocol) TakePreciseCoverage() <-chan *profiler.TakePreciseCoverageResult {
+	resultChan := make(chan *profiler.TakePreciseCoverageResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.takePreciseCoverage", nil)
+	result := &profiler.TakePreciseCoverageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -228,20 +282,20 @@ func (protocol *ProfilerProtocol) StopPreciseCoverage() <-chan *profiler.StopPre
 }
 
 /*
-StopTypeProfile disables type profile. Disabling releases type profile data
-collected so far.
+Enables type profile. DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopTypeProfile
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-startTypeProfile
 */
-func (protocol *ProfilerProtocol) StopTypeProfile() <-chan *profiler.StopTypeProfileResult {
-	resultChan := make(chan *profiler.StopTypeProfileResult)
-	command := NewCommand(protocol.Socket, "Profiler.stopTypeProfile", nil)
-	result := &profiler.StopTypeProfileResult{}
+func (protocol *ProfilerProtocol) StartTypeProfile() <-chan *profiler.StartTypeProfileResult {
+	resultChan := make(chan *profiler.StartTypeProfileResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.startTypeProfile", nil)
+	result := &profiler.StartTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -252,22 +306,22 @@ func (protocol *ProfilerProtocol) StopTypeProfile() <-chan *profiler.StopTypePro
 }
 
 /*
-TakePreciseCoverage collects coverage data for the current isolate, and resets
-execution counters. Precise code coverage needs to have started.
+Disables type profile. Disabling releases type profile data collected so far.
+DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takePreciseCoverage
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-stopTypeProfile
 */
-func (protocol *ProfilerProtocol) TakePreciseCoverage() <-chan *profiler.TakePreciseCoverageResult {
-	resultChan := make(chan *profiler.TakePreciseCoverageResult)
-	command := NewCommand(protocol.Socket, "Profiler.takePreciseCoverage", nil)
-	result := &profiler.TakePreciseCoverageResult{}
+func (protocol *ProfilerProtocol) StopTypeProfile() <-chan *profiler.StopTypeProfileResult {
+	resultChan := make(chan *profiler.StopTypeProfileResult, 1)
+	command := NewCommand(protocol.Socket, "Profiler.stopTypeProfile", nil)
+	result := &profiler.StopTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
-		} else {
-			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -277,19 +331,20 @@ func (protocol *ProfilerProtocol) TakePreciseCoverage() <-chan *profiler.TakePre
 }
 
 /*
-TakeTypeProfile collect type profile.
+Collect type profile. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#method-takeTypeProfile
-EXPERIMENTAL.
 */
 func (protocol *ProfilerProtocol) TakeTypeProfile() <-chan *profiler.TakeTypeProfileResult {
-	resultChan := make(chan *profiler.TakeTypeProfileResult)
+	resultChan := make(chan *profiler.TakeTypeProfileResult, 1)
 	command := NewCommand(protocol.Socket, "Profiler.takeTypeProfile", nil)
 	result := &profiler.TakeTypeProfileResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -303,13 +358,13 @@ func (protocol *ProfilerProtocol) TakeTypeProfile() <-chan *profiler.TakeTypePro
 
 /*
 OnConsoleProfileFinished adds a handler to the Profiler.consoleProfileFinished
-event. Profiler.consoleProfileFinished fires when profile recording finishes.
+event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consoleProfileFinished
 */
 func (protocol *ProfilerProtocol) OnConsoleProfileFinished(
 	callback func(event *profiler.ConsoleProfileFinishedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Profiler.consoleProfileFinished",
 		func(response *Response) {
@@ -322,18 +377,20 @@ func (protocol *ProfilerProtocol) OnConsoleProfileFinished(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnConsoleProfileStarted adds a handler to the Profiler.consoleProfileStarted
-event. Profiler.consoleProfileStarted fires when new profile recording is
-started using console.profile() call.
+event.
+
+Sent when new profile recording is started using console.profile() call.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-consoleProfileStarted
 */
 func (protocol *ProfilerProtocol) OnConsoleProfileStarted(
 	callback func(event *profiler.ConsoleProfileStartedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Profiler.consoleProfileStarted",
 		func(response *Response) {
@@ -346,4 +403,35 @@ func (protocol *ProfilerProtocol) OnConsoleProfileStarted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnPreciseCoverageDeltaUpdate adds a handler to the
+Profiler.preciseCoverageDeltaUpdate event.
+
+Reports coverage delta since the last poll (either from an event like this, or
+from `takePreciseCoverage` for the current isolate. May only be sent if precise
+code coverage has been started. This event can be trigged by the embedder to,
+for example, trigger collection of coverage data immediately at a certain point
+in time. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Profiler/#event-preciseCoverageDeltaUpdate
+*/
+func (protocol *ProfilerProtocol) OnPreciseCoverageDeltaUpdate(
+	callback func(event *profiler.PreciseCoverageDeltaUpdateEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Profiler.preciseCoverageDeltaUpdate",
+		func(response *Response) {
+			event := &profiler.PreciseCoverageDeltaUpdateEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
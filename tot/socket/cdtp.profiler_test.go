@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/debugger"
 	"github.com/mkenney/go-chrome/tot/profiler"
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -207,8 +208,8 @@ func TestProfilerStartPreciseCoverage(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &profiler.StartPreciseCoverageParams{
-		CallCount: true,
-		Detailed:  true,
+		CallCount: optional.Bool(true),
+		Detailed:  optional.Bool(true),
 	}
 	resultChan := mockSocket.Profiler().StartPreciseCoverage(params)
 	mockResult := &profiler.StartPreciseCoverageResult{}
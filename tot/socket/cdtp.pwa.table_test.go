@@ -0,0 +1,76 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/pwa"
+)
+
+func TestPWACommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestPWACommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"PWA.getOsAppState", func() func() error {
+			resultChan := mockSocket.PWA().GetOsAppState(&pwa.GetOsAppStateParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.install", func() func() error {
+			resultChan := mockSocket.PWA().Install(&pwa.InstallParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.uninstall", func() func() error {
+			resultChan := mockSocket.PWA().Uninstall(&pwa.UninstallParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.launch", func() func() error {
+			resultChan := mockSocket.PWA().Launch(&pwa.LaunchParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.launchFilesInApp", func() func() error {
+			resultChan := mockSocket.PWA().LaunchFilesInApp(&pwa.LaunchFilesInAppParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.openCurrentPageInApp", func() func() error {
+			resultChan := mockSocket.PWA().OpenCurrentPageInApp(&pwa.OpenCurrentPageInAppParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"PWA.changeAppUserSettings", func() func() error {
+			resultChan := mockSocket.PWA().ChangeAppUserSettings(&pwa.ChangeAppUserSettingsParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
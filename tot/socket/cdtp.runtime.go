@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/runtime"
@@ -8,8 +11,9 @@ import (
 
 /*
 RuntimeProtocol provides a namespace for the Chrome Runtime protocol methods.
-The Runtime protocol exposes JavaScript runtime by means of remote evaluation
-and mirror objects. Evaluation results are returned as mirror object that expose
+
+Runtime domain exposes JavaScript runtime by means of remote evaluation and
+mirror objects. Evaluation results are returned as mirror object that expose
 object type, string representation and unique identifier that can be used for
 further object reference. Original objects are maintained in memory unless they
 are either explicitly released or are released along with the other objects in
@@ -19,23 +23,49 @@ https://chromedevtools.github.io/devtools-protocol/tot/Runtime/
 */
 type RuntimeProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *RuntimeProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *RuntimeProtocol) WithContext(ctx context.Context) *RuntimeProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &RuntimeProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-AwaitPromise adds handler to promise with given promise object ID.
+Add handler to promise with given promise object id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-awaitPromise
 */
 func (protocol *RuntimeProtocol) AwaitPromise(
 	params *runtime.AwaitPromiseParams,
 ) <-chan *runtime.AwaitPromiseResult {
-	resultChan := make(chan *runtime.AwaitPromiseResult)
+	resultChan := make(chan *runtime.AwaitPromiseResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.awaitPromise", params)
 	result := &runtime.AwaitPromiseResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -48,21 +78,23 @@ func (protocol *RuntimeProtocol) AwaitPromise(
 }
 
 /*
-CallFunctionOn calls a function with given declaration on the given object.
-Object group of the result is inherited from the target object.
+Calls function with given declaration on the given object. Object group of the
+result is inherited from the target object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-callFunctionOn
 */
 func (protocol *RuntimeProtocol) CallFunctionOn(
 	params *runtime.CallFunctionOnParams,
 ) <-chan *runtime.CallFunctionOnResult {
-	resultChan := make(chan *runtime.CallFunctionOnResult)
+	resultChan := make(chan *runtime.CallFunctionOnResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.callFunctionOn", params)
 	result := &runtime.CallFunctionOnResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -75,20 +107,22 @@ func (protocol *RuntimeProtocol) CallFunctionOn(
 }
 
 /*
-CompileScript compiles an expression.
+Compiles expression.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-compileScript
 */
 func (protocol *RuntimeProtocol) CompileScript(
 	params *runtime.CompileScriptParams,
 ) <-chan *runtime.CompileScriptResult {
-	resultChan := make(chan *runtime.CompileScriptResult)
+	resultChan := make(chan *runtime.CompileScriptResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.compileScript", params)
 	result := &runtime.CompileScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -101,18 +135,20 @@ func (protocol *RuntimeProtocol) CompileScript(
 }
 
 /*
-Disable disables reporting of execution contexts creation.
+Disables reporting of execution contexts creation.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-disable
 */
 func (protocol *RuntimeProtocol) Disable() <-chan *runtime.DisableResult {
-	resultChan := make(chan *runtime.DisableResult)
+	resultChan := make(chan *runtime.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.disable", nil)
 	result := &runtime.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -123,18 +159,20 @@ func (protocol *RuntimeProtocol) Disable() <-chan *runtime.DisableResult {
 }
 
 /*
-DiscardConsoleEntries discards collected exceptions and console API calls.
+Discards collected exceptions and console API calls.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-discardConsoleEntries
 */
 func (protocol *RuntimeProtocol) DiscardConsoleEntries() <-chan *runtime.DiscardConsoleEntriesResult {
-	resultChan := make(chan *runtime.DiscardConsoleEntriesResult)
+	resultChan := make(chan *runtime.DiscardConsoleEntriesResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.discardConsoleEntries", nil)
 	result := &runtime.DiscardConsoleEntriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -145,20 +183,22 @@ func (protocol *RuntimeProtocol) DiscardConsoleEntries() <-chan *runtime.Discard
 }
 
 /*
-Enable enables reporting of execution contexts creation by means of the
-Runtime.executionContextCreated event. When the reporting gets enabled the event
-will be sent immediately for each existing execution context.
+Enables reporting of execution contexts creation by means of
+`executionContextCreated` event. When the reporting gets enabled the event will
+be sent immediately for each existing execution context.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-enable
 */
 func (protocol *RuntimeProtocol) Enable() <-chan *runtime.EnableResult {
-	resultChan := make(chan *runtime.EnableResult)
+	resultChan := make(chan *runtime.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.enable", nil)
 	result := &runtime.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -169,20 +209,75 @@ func (protocol *RuntimeProtocol) Enable() <-chan *runtime.EnableResult {
 }
 
 /*
-Evaluate evaluates expression on global object.
+Evaluates expression on global object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-evaluate
 */
 func (protocol *RuntimeProtocol) Evaluate(
 	params *runtime.EvaluateParams,
 ) <-chan *runtime.EvaluateResult {
-	resultChan := make(chan *runtime.EvaluateResult)
+	resultChan := make(chan *runtime.EvaluateResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.evaluate", params)
 	result := &runtime.EvaluateResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the isolate id. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getIsolateId
+*/
+func (protocol *RuntimeProtocol) GetIsolateID() <-chan *runtime.GetIsolateIDResult {
+	resultChan := make(chan *runtime.GetIsolateIDResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.getIsolateId", nil)
+	result := &runtime.GetIsolateIDResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the JavaScript heap usage. It is the total usage of the corresponding
+isolate not scoped to a particular Runtime. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getHeapUsage
+*/
+func (protocol *RuntimeProtocol) GetHeapUsage() <-chan *runtime.GetHeapUsageResult {
+	resultChan := make(chan *runtime.GetHeapUsageResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.getHeapUsage", nil)
+	result := &runtime.GetHeapUsageResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -195,21 +290,23 @@ func (protocol *RuntimeProtocol) Evaluate(
 }
 
 /*
-GetProperties returns properties of a given object. Object group of the result
-is inherited from the target object.
+Returns properties of a given object. Object group of the result is inherited
+from the target object.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getProperties
 */
 func (protocol *RuntimeProtocol) GetProperties(
 	params *runtime.GetPropertiesParams,
 ) <-chan *runtime.GetPropertiesResult {
-	resultChan := make(chan *runtime.GetPropertiesResult)
+	resultChan := make(chan *runtime.GetPropertiesResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.getProperties", params)
 	result := &runtime.GetPropertiesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -222,21 +319,27 @@ func (protocol *RuntimeProtocol) GetProperties(
 }
 
 /*
-GlobalLexicalScopeNames returns all let, const and class variables from global
-scope.
+Returns all let, const and class variables from global scope.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-globalLexicalScopeNames
 */
 func (protocol *RuntimeProtocol) GlobalLexicalScopeNames(
-	params *runtime.GlobalLexicalScopeNamesParams,
+	params ...*runtime.GlobalLexicalScopeNamesParams,
 ) <-chan *runtime.GlobalLexicalScopeNamesResult {
-	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult)
-	command := NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", params)
+	resultChan := make(chan *runtime.GlobalLexicalScopeNamesResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Runtime.globalLexicalScopeNames", params[0])
+	}
 	result := &runtime.GlobalLexicalScopeNamesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -249,20 +352,22 @@ func (protocol *RuntimeProtocol) GlobalLexicalScopeNames(
 }
 
 /*
-QueryObjects returns objects for a given prototype ID.
+QueryObjects sends the Runtime.queryObjects command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-queryObjects
 */
 func (protocol *RuntimeProtocol) QueryObjects(
 	params *runtime.QueryObjectsParams,
 ) <-chan *runtime.QueryObjectsResult {
-	resultChan := make(chan *runtime.QueryObjectsResult)
+	resultChan := make(chan *runtime.QueryObjectsResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.queryObjects", params)
 	result := &runtime.QueryObjectsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -275,20 +380,22 @@ func (protocol *RuntimeProtocol) QueryObjects(
 }
 
 /*
-ReleaseObject releases remote object with given id.
+Releases remote object with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseObject
 */
 func (protocol *RuntimeProtocol) ReleaseObject(
 	params *runtime.ReleaseObjectParams,
 ) <-chan *runtime.ReleaseObjectResult {
-	resultChan := make(chan *runtime.ReleaseObjectResult)
+	resultChan := make(chan *runtime.ReleaseObjectResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObject", params)
 	result := &runtime.ReleaseObjectResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -299,20 +406,22 @@ func (protocol *RuntimeProtocol) ReleaseObject(
 }
 
 /*
-ReleaseObjectGroup releases all remote objects that belong to a given group.
+Releases all remote objects that belong to a given group.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-releaseObjectGroup
 */
 func (protocol *RuntimeProtocol) ReleaseObjectGroup(
 	params *runtime.ReleaseObjectGroupParams,
 ) <-chan *runtime.ReleaseObjectGroupResult {
-	resultChan := make(chan *runtime.ReleaseObjectGroupResult)
+	resultChan := make(chan *runtime.ReleaseObjectGroupResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.releaseObjectGroup", params)
 	result := &runtime.ReleaseObjectGroupResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -323,19 +432,20 @@ func (protocol *RuntimeProtocol) ReleaseObjectGroup(
 }
 
 /*
-RunIfWaitingForDebugger tells inspected instance to run if it was waiting for
-debugger to attach.
+Tells inspected instance to run if it was waiting for debugger to attach.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runIfWaitingForDebugger
 */
 func (protocol *RuntimeProtocol) RunIfWaitingForDebugger() <-chan *runtime.RunIfWaitingForDebuggerResult {
-	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult)
+	resultChan := make(chan *runtime.RunIfWaitingForDebuggerResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.runIfWaitingForDebugger", nil)
 	result := &runtime.RunIfWaitingForDebuggerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -346,20 +456,22 @@ func (protocol *RuntimeProtocol) RunIfWaitingForDebugger() <-chan *runtime.RunIf
 }
 
 /*
-RunScript runs the script with given ID in a given context.
+Runs script with given id in a given context.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-runScript
 */
 func (protocol *RuntimeProtocol) RunScript(
 	params *runtime.RunScriptParams,
 ) <-chan *runtime.RunScriptResult {
-	resultChan := make(chan *runtime.RunScriptResult)
+	resultChan := make(chan *runtime.RunScriptResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.runScript", params)
 	result := &runtime.RunScriptResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -372,22 +484,190 @@ func (protocol *RuntimeProtocol) RunScript(
 }
 
 /*
-SetCustomObjectFormatterEnabled is experimental.
+Enables or disables async call stacks tracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setAsyncCallStackDepth
+*/
+func (protocol *RuntimeProtocol) SetAsyncCallStackDepth(
+	params *runtime.SetAsyncCallStackDepthParams,
+) <-chan *runtime.SetAsyncCallStackDepthResult {
+	resultChan := make(chan *runtime.SetAsyncCallStackDepthResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.setAsyncCallStackDepth", params)
+	result := &runtime.SetAsyncCallStackDepthResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetCustomObjectFormatterEnabled sends the
+Runtime.setCustomObjectFormatterEnabled command. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setCustomObjectFormatterEnabled
-EXPERIMENTAL.
 */
 func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabled(
 	params *runtime.SetCustomObjectFormatterEnabledParams,
 ) <-chan *runtime.SetCustomObjectFormatterEnabledResult {
-	resultChan := make(chan *runtime.SetCustomObjectFormatterEnabledResult)
+	resultChan := make(chan *runtime.SetCustomObjectFormatterEnabledResult, 1)
 	command := NewCommand(protocol.Socket, "Runtime.setCustomObjectFormatterEnabled", params)
 	result := &runtime.SetCustomObjectFormatterEnabledResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+SetMaxCallStackSizeToCapture sends the Runtime.setMaxCallStackSizeToCapture
+command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-setMaxCallStackSizeToCapture
+*/
+func (protocol *RuntimeProtocol) SetMaxCallStackSizeToCapture(
+	params *runtime.SetMaxCallStackSizeToCaptureParams,
+) <-chan *runtime.SetMaxCallStackSizeToCaptureResult {
+	resultChan := make(chan *runtime.SetMaxCallStackSizeToCaptureResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.setMaxCallStackSizeToCapture", params)
+	result := &runtime.SetMaxCallStackSizeToCaptureResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Terminate current or next JavaScript execution. Will cancel the termination when
+the outer-most script execution ends. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-terminateExecution
+*/
+func (protocol *RuntimeProtocol) TerminateExecution() <-chan *runtime.TerminateExecutionResult {
+	resultChan := make(chan *runtime.TerminateExecutionResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.terminateExecution", nil)
+	result := &runtime.TerminateExecutionResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+If executionContextId is empty, adds binding with the given name on the global
+objects of all inspected contexts, including those created later, bindings
+survive reloads. Binding function takes exactly one argument, this argument
+should be string, in case of any other input, function throws an exception. Each
+binding function call produces Runtime.bindingCalled notification.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-addBinding
+*/
+func (protocol *RuntimeProtocol) AddBinding(
+	params *runtime.AddBindingParams,
+) <-chan *runtime.AddBindingResult {
+	resultChan := make(chan *runtime.AddBindingResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.addBinding", params)
+	result := &runtime.AddBindingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+This method does not remove binding function from global object but unsubscribes
+current runtime agent from Runtime.bindingCalled notifications.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-removeBinding
+*/
+func (protocol *RuntimeProtocol) RemoveBinding(
+	params *runtime.RemoveBindingParams,
+) <-chan *runtime.RemoveBindingResult {
+	resultChan := make(chan *runtime.RemoveBindingResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.removeBinding", params)
+	result := &runtime.RemoveBindingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+This method tries to lookup and populate exception details for a JavaScript
+Error object. Note that the stackTrace portion of the resulting exceptionDetails
+will only be populated if the Runtime domain was enabled at the time when the
+Error was thrown. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#method-getExceptionDetails
+*/
+func (protocol *RuntimeProtocol) GetExceptionDetails(
+	params *runtime.GetExceptionDetailsParams,
+) <-chan *runtime.GetExceptionDetailsResult {
+	resultChan := make(chan *runtime.GetExceptionDetailsResult, 1)
+	command := NewCommand(protocol.Socket, "Runtime.getExceptionDetails", params)
+	result := &runtime.GetExceptionDetailsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -396,15 +676,41 @@ func (protocol *RuntimeProtocol) SetCustomObjectFormatterEnabled(
 	return resultChan
 }
 
+/*
+OnBindingCalled adds a handler to the Runtime.bindingCalled event.
+
+Notification is issued every time when binding is called. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-bindingCalled
+*/
+func (protocol *RuntimeProtocol) OnBindingCalled(
+	callback func(event *runtime.BindingCalledEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Runtime.bindingCalled",
+		func(response *Response) {
+			event := &runtime.BindingCalledEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
 /*
 OnConsoleAPICalled adds a handler to the Runtime.consoleAPICalled event.
-Runtime.consoleAPICalled fires when the console API is called.
+
+Issued when console API was called.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-consoleAPICalled
 */
 func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 	callback func(event *runtime.ConsoleAPICalledEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.consoleAPICalled",
 		func(response *Response) {
@@ -417,17 +723,19 @@ func (protocol *RuntimeProtocol) OnConsoleAPICalled(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnExceptionRevoked adds a handler to the Runtime.exceptionRevoked event.
-Runtime.exceptionRevoked fires when an unhandled exception is revoked.
+
+Issued when unhandled exception was revoked.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionRevoked
 */
 func (protocol *RuntimeProtocol) OnExceptionRevoked(
 	callback func(event *runtime.ExceptionRevokedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.exceptionRevoked",
 		func(response *Response) {
@@ -440,17 +748,19 @@ func (protocol *RuntimeProtocol) OnExceptionRevoked(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnExceptionThrown adds a handler to the Runtime.exceptionThrown event.
-Runtime.exceptionThrown fires when an exception is thrown and is unhandled.
+
+Issued when exception was thrown and unhandled.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-exceptionThrown
 */
 func (protocol *RuntimeProtocol) OnExceptionThrown(
 	callback func(event *runtime.ExceptionThrownEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.exceptionThrown",
 		func(response *Response) {
@@ -463,18 +773,20 @@ func (protocol *RuntimeProtocol) OnExceptionThrown(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnExecutionContextCreated adds a handler to the Runtime.executionContextCreated
-event. Runtime.executionContextCreated fires when a new execution context is
-created.
+event.
+
+Issued when new execution context is created.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextCreated
 */
 func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 	callback func(event *runtime.ExecutionContextCreatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.executionContextCreated",
 		func(response *Response) {
@@ -487,18 +799,20 @@ func (protocol *RuntimeProtocol) OnExecutionContextCreated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnExecutionContextDestroyed adds a handler to the Runtime.executionContextDestroyed
-event. Runtime.executionContextDestroyed fires when execution context is
-destroyed.
+OnExecutionContextDestroyed adds a handler to the
+Runtime.executionContextDestroyed event.
+
+Issued when execution context is destroyed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextDestroyed
 */
 func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 	callback func(event *runtime.ExecutionContextDestroyedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.executionContextDestroyed",
 		func(response *Response) {
@@ -511,18 +825,20 @@ func (protocol *RuntimeProtocol) OnExecutionContextDestroyed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnExecutionContextsCleared adds a handler to the Runtime.executionContextsCleared
-event. Runtime.executionContextsCleared fires when all executionContexts were
-cleared in browser.
+OnExecutionContextsCleared adds a handler to the
+Runtime.executionContextsCleared event.
+
+# Issued when all executionContexts were cleared in browser
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-executionContextsCleared
 */
 func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 	callback func(event *runtime.ExecutionContextsClearedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.executionContextsCleared",
 		func(response *Response) {
@@ -535,18 +851,20 @@ func (protocol *RuntimeProtocol) OnExecutionContextsCleared(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnInspectRequested adds a handler to the Runtime.inspectRequested event.
-Runtime.inspectRequested fires when an object should be inspected (for example,
-as a result of inspect() command line API call).
+
+Issued when object should be inspected (for example, as a result of inspect()
+command line API call).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Runtime/#event-inspectRequested
 */
 func (protocol *RuntimeProtocol) OnInspectRequested(
 	callback func(event *runtime.InspectRequestedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Runtime.inspectRequested",
 		func(response *Response) {
@@ -559,4 +877,5 @@ func (protocol *RuntimeProtocol) OnInspectRequested(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/runtime"
 )
 
@@ -17,8 +18,8 @@ func TestRuntimeAwaitPromise(t *testing.T) {
 
 	params := &runtime.AwaitPromiseParams{
 		PromiseObjectID: runtime.RemoteObjectID("remote-object-id"),
-		ReturnByValue:   true,
-		GeneratePreview: true,
+		ReturnByValue:   optional.Bool(true),
+		GeneratePreview: optional.Bool(true),
 	}
 	resultChan := mockSocket.Runtime().AwaitPromise(params)
 	mockResult := &runtime.AwaitPromiseResult{
@@ -126,19 +127,19 @@ func TestRuntimeCallFunctionOn(t *testing.T) {
 
 	params := &runtime.CallFunctionOnParams{
 		FunctionDeclaration: "function(){}",
-		ObjectID:            runtime.RemoteObjectID("remote-object-id"),
+		ObjectID:            optional.Of(runtime.RemoteObjectID("remote-object-id")),
 		Arguments: []*runtime.CallArgument{{
 			Value:               "value",
 			UnserializableValue: runtime.UnserializableValue.Infinity,
 			ObjectID:            runtime.RemoteObjectID("remote-object-id"),
 		}},
-		Silent:             true,
-		ReturnByValue:      true,
-		GeneratePreview:    true,
-		UserGesture:        true,
-		AwaitPromise:       true,
-		ExecutionContextID: runtime.ExecutionContextID(1),
-		ObjectGroup:        "object group",
+		Silent:             optional.Bool(true),
+		ReturnByValue:      optional.Bool(true),
+		GeneratePreview:    optional.Bool(true),
+		UserGesture:        optional.Bool(true),
+		AwaitPromise:       optional.Bool(true),
+		ExecutionContextID: optional.Of(runtime.ExecutionContextID(1)),
+		ObjectGroup:        optional.String("object group"),
 	}
 	resultChan := mockSocket.Runtime().CallFunctionOn(params)
 	mockResult := &runtime.CallFunctionOnResult{
@@ -248,7 +249,7 @@ func TestRuntimeCompileScript(t *testing.T) {
 		Expression:         "expression",
 		SourceURL:          "http://some.url",
 		PersistScript:      true,
-		ExecutionContextID: runtime.ExecutionContextID(1),
+		ExecutionContextID: optional.Of(runtime.ExecutionContextID(1)),
 	}
 	resultChan := mockSocket.Runtime().CompileScript(params)
 	mockResult := &runtime.CompileScriptResult{
@@ -420,14 +421,14 @@ func TestRuntimeEvaluate(t *testing.T) {
 
 	params := &runtime.EvaluateParams{
 		Expression:            "expression",
-		ObjectGroup:           "object-group",
-		IncludeCommandLineAPI: true,
-		Silent:                true,
-		ContextID:             runtime.ExecutionContextID(1),
-		ReturnByValue:         true,
-		GeneratePreview:       true,
-		UserGesture:           true,
-		AwaitPromise:          true,
+		ObjectGroup:           optional.String("object-group"),
+		IncludeCommandLineAPI: optional.Bool(true),
+		Silent:                optional.Bool(true),
+		ContextID:             optional.Of(runtime.ExecutionContextID(1)),
+		ReturnByValue:         optional.Bool(true),
+		GeneratePreview:       optional.Bool(true),
+		UserGesture:           optional.Bool(true),
+		AwaitPromise:          optional.Bool(true),
 	}
 	resultChan := mockSocket.Runtime().Evaluate(params)
 	mockResult := &runtime.EvaluateResult{
@@ -535,9 +536,9 @@ func TestRuntimeGetProperties(t *testing.T) {
 
 	params := &runtime.GetPropertiesParams{
 		ObjectID:               runtime.RemoteObjectID("remote-object-id"),
-		OwnProperties:          true,
-		AccessorPropertiesOnly: true,
-		GeneratePreview:        true,
+		OwnProperties:          optional.Bool(true),
+		AccessorPropertiesOnly: optional.Bool(true),
+		GeneratePreview:        optional.Bool(true),
 	}
 	resultChan := mockSocket.Runtime().GetProperties(params)
 	mockResult := &runtime.GetPropertiesResult{
@@ -631,7 +632,7 @@ func TestRuntimeGlobalLexicalScopeNames(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &runtime.GlobalLexicalScopeNamesParams{
-		ExecutionContextID: runtime.ExecutionContextID(1),
+		ExecutionContextID: optional.Of(runtime.ExecutionContextID(1)),
 	}
 	resultChan := mockSocket.Runtime().GlobalLexicalScopeNames(params)
 	mockResult := &runtime.GlobalLexicalScopeNamesResult{
@@ -824,13 +825,13 @@ func TestRuntimeRunScript(t *testing.T) {
 
 	params := &runtime.RunScriptParams{
 		ScriptID:              runtime.ScriptID("script-id"),
-		ExecutionContextID:    runtime.ExecutionContextID(1),
-		ObjectGroup:           "object-group",
-		Silent:                true,
-		IncludeCommandLineAPI: true,
-		ReturnByValue:         true,
-		GeneratePreview:       true,
-		AwaitPromise:          true,
+		ExecutionContextID:    optional.Of(runtime.ExecutionContextID(1)),
+		ObjectGroup:           optional.String("object-group"),
+		Silent:                optional.Bool(true),
+		IncludeCommandLineAPI: optional.Bool(true),
+		ReturnByValue:         optional.Bool(true),
+		GeneratePreview:       optional.Bool(true),
+		AwaitPromise:          optional.Bool(true),
 	}
 	resultChan := mockSocket.Runtime().RunScript(params)
 	mockResult := &runtime.RunScriptResult{
@@ -1031,7 +1032,7 @@ func TestRuntimeOnExceptionThrown(t *testing.T) {
 		t.Errorf("Expected '%v', got: '%v'", mockResult, result)
 	}
 	if mockResult.Timestamp != result.Timestamp {
-		t.Errorf("Expected %d, got %d", mockResult.Timestamp, result.Timestamp)
+		t.Errorf("Expected %v, got %v", mockResult.Timestamp, result.Timestamp)
 	}
 
 	resultChan = make(chan *runtime.ExceptionThrownEvent)
@@ -1068,7 +1069,7 @@ func TestRuntimeOnExecutionContextCreated(t *testing.T) {
 			ID:      runtime.ExecutionContextID(1),
 			Origin:  "origin",
 			Name:    "name",
-			AuxData: map[string]string{"key": "value"},
+			AuxData: map[string]interface{}{"key": "value"},
 		},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
@@ -1244,7 +1245,7 @@ func TestRuntimeOnInspectRequested(t *testing.T) {
 				ConfigObjectID:             runtime.RemoteObjectID("remote-object-id"),
 			},
 		},
-		Hints: map[string]string{"key": "value"},
+		Hints: map[string]interface{}{"key": "value"},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
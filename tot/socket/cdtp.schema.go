@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/schema"
@@ -9,26 +12,53 @@ import (
 /*
 SchemaProtocol provides a namespace for the Chrome Schema protocol methods.
 
+This domain is deprecated. DEPRECATED.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Schema/
-DEPRECATED.
 */
 type SchemaProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *SchemaProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *SchemaProtocol) WithContext(ctx context.Context) *SchemaProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &SchemaProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-GetDomains returns supported domains.
+Returns supported domains.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Schema/#method-getDomains
 */
 func (protocol *SchemaProtocol) GetDomains() <-chan *schema.GetDomainsResult {
-	resultChan := make(chan *schema.GetDomainsResult)
+	resultChan := make(chan *schema.GetDomainsResult, 1)
 	command := NewCommand(protocol.Socket, "Schema.getDomains", nil)
 	result := &schema.GetDomainsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
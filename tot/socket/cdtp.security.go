@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/security"
@@ -9,25 +12,53 @@ import (
 /*
 SecurityProtocol provides a namespace for the Chrome Security protocol methods.
 
+# Security
+
 https://chromedevtools.github.io/devtools-protocol/tot/Security/
 */
 type SecurityProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *SecurityProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *SecurityProtocol) WithContext(ctx context.Context) *SecurityProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &SecurityProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-Disable disables tracking security state changes.
+Disables tracking security state changes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-disable
 */
 func (protocol *SecurityProtocol) Disable() <-chan *security.DisableResult {
-	resultChan := make(chan *security.DisableResult)
+	resultChan := make(chan *security.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "Security.disable", nil)
 	result := &security.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,18 +69,20 @@ func (protocol *SecurityProtocol) Disable() <-chan *security.DisableResult {
 }
 
 /*
-Enable tracking security state changes.
+Enables tracking security state changes.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-enable
 */
 func (protocol *SecurityProtocol) Enable() <-chan *security.EnableResult {
-	resultChan := make(chan *security.EnableResult)
+	resultChan := make(chan *security.EnableResult, 1)
 	command := NewCommand(protocol.Socket, "Security.enable", nil)
 	result := &security.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -60,21 +93,22 @@ func (protocol *SecurityProtocol) Enable() <-chan *security.EnableResult {
 }
 
 /*
-HandleCertificateError handles a certificate error that fired a certificateError
-event.
+Enable/disable whether all certificate errors should be ignored.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
 */
-func (protocol *SecurityProtocol) HandleCertificateError(
-	params *security.HandleCertificateErrorParams,
-) <-chan *security.HandleCertificateErrorResult {
-	resultChan := make(chan *security.HandleCertificateErrorResult)
-	command := NewCommand(protocol.Socket, "Security.handleCertificateError", params)
-	result := &security.HandleCertificateErrorResult{}
+func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
+	params *security.SetIgnoreCertificateErrorsParams,
+) <-chan *security.SetIgnoreCertificateErrorsResult {
+	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult, 1)
+	command := NewCommand(protocol.Socket, "Security.setIgnoreCertificateErrors", params)
+	result := &security.SetIgnoreCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -85,22 +119,22 @@ func (protocol *SecurityProtocol) HandleCertificateError(
 }
 
 /*
-SetIgnoreCertificateErrors enables/disables whether all certificate errors
-should be ignored.
+Handles a certificate error that fired a certificateError event. DEPRECATED.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setIgnoreCertificateErrors
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-handleCertificateError
 */
-func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
-	params *security.SetIgnoreCertificateErrorsParams,
-) <-chan *security.SetIgnoreCertificateErrorsResult {
-	resultChan := make(chan *security.SetIgnoreCertificateErrorsResult)
-	command := NewCommand(protocol.Socket, "Security.setIgnoreCertificateErrors", params)
-	result := &security.SetIgnoreCertificateErrorsResult{}
+func (protocol *SecurityProtocol) HandleCertificateError(
+	params *security.HandleCertificateErrorParams,
+) <-chan *security.HandleCertificateErrorResult {
+	resultChan := make(chan *security.HandleCertificateErrorResult, 1)
+	command := NewCommand(protocol.Socket, "Security.handleCertificateError", params)
+	result := &security.HandleCertificateErrorResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -111,22 +145,24 @@ func (protocol *SecurityProtocol) SetIgnoreCertificateErrors(
 }
 
 /*
-SetOverrideCertificateErrors enables/disables overriding certificate errors. If
-enabled, all certificate error events need to be handled by the DevTools client
-and should be answered with HandleCertificateError commands.
+Enable/disable overriding certificate errors. If enabled, all certificate error
+events need to be handled by the DevTools client and should be answered with
+`handleCertificateError` commands. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#method-setOverrideCertificateErrors
 */
 func (protocol *SecurityProtocol) SetOverrideCertificateErrors(
 	params *security.SetOverrideCertificateErrorsParams,
 ) <-chan *security.SetOverrideCertificateErrorsResult {
-	resultChan := make(chan *security.SetOverrideCertificateErrorsResult)
+	resultChan := make(chan *security.SetOverrideCertificateErrorsResult, 1)
 	command := NewCommand(protocol.Socket, "Security.setOverrideCertificateErrors", params)
 	result := &security.SetOverrideCertificateErrorsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -138,16 +174,18 @@ func (protocol *SecurityProtocol) SetOverrideCertificateErrors(
 
 /*
 OnCertificateError adds a handler to the Security.certificateError event.
-Security.certificateError fires when there is a certificate error. If overriding
-certificate errors is enabled, then it should be handled with the
-HandleCertificateError command. Note: this event does not fire if the
-certificate error has been allowed internally.
+
+There is a certificate error. If overriding certificate errors is enabled, then
+it should be handled with the `handleCertificateError` command. Note: this event
+does not fire if the certificate error has been allowed internally. Only one
+client per target should override certificate errors at the same time.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-certificateError
 */
 func (protocol *SecurityProtocol) OnCertificateError(
 	callback func(event *security.CertificateErrorEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Security.certificateError",
 		func(response *Response) {
@@ -160,17 +198,46 @@ func (protocol *SecurityProtocol) OnCertificateError(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnSecurityStateChanged adds a handler to the Security.StateChanged event.
-Security.StateChanged fires when the security state of the page changed.
+OnVisibleSecurityStateChanged adds a handler to the
+Security.visibleSecurityStateChanged event.
+
+The security state of the page changed. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-visibleSecurityStateChanged
+*/
+func (protocol *SecurityProtocol) OnVisibleSecurityStateChanged(
+	callback func(event *security.VisibleSecurityStateChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Security.visibleSecurityStateChanged",
+		func(response *Response) {
+			event := &security.VisibleSecurityStateChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSecurityStateChanged adds a handler to the Security.securityStateChanged
+event.
+
+The security state of the page changed. No longer being sent. DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Security/#event-securityStateChanged
 */
 func (protocol *SecurityProtocol) OnSecurityStateChanged(
 	callback func(event *security.StateChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Security.securityStateChanged",
 		func(response *Response) {
@@ -183,4 +250,5 @@ func (protocol *SecurityProtocol) OnSecurityStateChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
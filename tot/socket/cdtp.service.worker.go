@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/service/worker"
@@ -10,29 +13,55 @@ import (
 ServiceWorkerProtocol provides a namespace for the Chrome ServiceWorker protocol
 methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/
 EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/
 */
 type ServiceWorkerProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *ServiceWorkerProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
 }
 
 /*
-DeliverPushMessage is experimental.
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *ServiceWorkerProtocol) WithContext(ctx context.Context) *ServiceWorkerProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &ServiceWorkerProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+DeliverPushMessage sends the ServiceWorker.deliverPushMessage command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-deliverPushMessage
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) DeliverPushMessage(
 	params *worker.DeliverPushMessageParams,
 ) <-chan *worker.DeliverPushMessageResult {
-	resultChan := make(chan *worker.DeliverPushMessageResult)
+	resultChan := make(chan *worker.DeliverPushMessageResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.deliverPushMessage", params)
 	result := &worker.DeliverPushMessageResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -43,19 +72,20 @@ func (protocol *ServiceWorkerProtocol) DeliverPushMessage(
 }
 
 /*
-Disable is experimental.
+Disable sends the ServiceWorker.disable command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-disable
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Disable() <-chan *worker.DisableResult {
-	resultChan := make(chan *worker.DisableResult)
+	resultChan := make(chan *worker.DisableResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.disable", nil)
 	result := &worker.DisableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,21 +96,22 @@ func (protocol *ServiceWorkerProtocol) Disable() <-chan *worker.DisableResult {
 }
 
 /*
-DispatchSyncEvent is experimental.
+DispatchSyncEvent sends the ServiceWorker.dispatchSyncEvent command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchSyncEvent
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) DispatchSyncEvent(
 	params *worker.DispatchSyncEventParams,
 ) <-chan *worker.DispatchSyncEventResult {
-	resultChan := make(chan *worker.DispatchSyncEventResult)
+	resultChan := make(chan *worker.DispatchSyncEventResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.dispatchSyncEvent", params)
 	result := &worker.DispatchSyncEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -91,19 +122,23 @@ func (protocol *ServiceWorkerProtocol) DispatchSyncEvent(
 }
 
 /*
-Enable is experimental.
+DispatchPeriodicSyncEvent sends the ServiceWorker.dispatchPeriodicSyncEvent
+command.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-enable
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-dispatchPeriodicSyncEvent
 */
-func (protocol *ServiceWorkerProtocol) Enable() <-chan *worker.EnableResult {
-	resultChan := make(chan *worker.EnableResult)
-	command := NewCommand(protocol.Socket, "ServiceWorker.enable", nil)
-	result := &worker.EnableResult{}
+func (protocol *ServiceWorkerProtocol) DispatchPeriodicSyncEvent(
+	params *worker.DispatchPeriodicSyncEventParams,
+) <-chan *worker.DispatchPeriodicSyncEventResult {
+	resultChan := make(chan *worker.DispatchPeriodicSyncEventResult, 1)
+	command := NewCommand(protocol.Socket, "ServiceWorker.dispatchPeriodicSyncEvent", params)
+	result := &worker.DispatchPeriodicSyncEventResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -114,21 +149,20 @@ func (protocol *ServiceWorkerProtocol) Enable() <-chan *worker.EnableResult {
 }
 
 /*
-InspectWorker is experimental.
+Enable sends the ServiceWorker.enable command.
 
-https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
-EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-enable
 */
-func (protocol *ServiceWorkerProtocol) InspectWorker(
-	params *worker.InspectWorkerParams,
-) <-chan *worker.InspectWorkerResult {
-	resultChan := make(chan *worker.InspectWorkerResult)
-	command := NewCommand(protocol.Socket, "ServiceWorker.inspectWorker", params)
-	result := &worker.InspectWorkerResult{}
+func (protocol *ServiceWorkerProtocol) Enable() <-chan *worker.EnableResult {
+	resultChan := make(chan *worker.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "ServiceWorker.enable", nil)
+	result := &worker.EnableResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -139,21 +173,23 @@ func (protocol *ServiceWorkerProtocol) InspectWorker(
 }
 
 /*
-SetForceUpdateOnPageLoad is experimental.
+SetForceUpdateOnPageLoad sends the ServiceWorker.setForceUpdateOnPageLoad
+command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-setForceUpdateOnPageLoad
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoad(
 	params *worker.SetForceUpdateOnPageLoadParams,
 ) <-chan *worker.SetForceUpdateOnPageLoadResult {
-	resultChan := make(chan *worker.SetForceUpdateOnPageLoadResult)
+	resultChan := make(chan *worker.SetForceUpdateOnPageLoadResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.setForceUpdateOnPageLoad", params)
 	result := &worker.SetForceUpdateOnPageLoadResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -164,21 +200,22 @@ func (protocol *ServiceWorkerProtocol) SetForceUpdateOnPageLoad(
 }
 
 /*
-SkipWaiting is experimental.
+SkipWaiting sends the ServiceWorker.skipWaiting command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-skipWaiting
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) SkipWaiting(
 	params *worker.SkipWaitingParams,
 ) <-chan *worker.SkipWaitingResult {
-	resultChan := make(chan *worker.SkipWaitingResult)
+	resultChan := make(chan *worker.SkipWaitingResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.skipWaiting", params)
 	result := &worker.SkipWaitingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -189,21 +226,22 @@ func (protocol *ServiceWorkerProtocol) SkipWaiting(
 }
 
 /*
-StartWorker is experimental.
+StartWorker sends the ServiceWorker.startWorker command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-startWorker
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StartWorker(
 	params *worker.StartWorkerParams,
 ) <-chan *worker.StartWorkerResult {
-	resultChan := make(chan *worker.StartWorkerResult)
+	resultChan := make(chan *worker.StartWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.startWorker", params)
 	result := &worker.StartWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -214,19 +252,20 @@ func (protocol *ServiceWorkerProtocol) StartWorker(
 }
 
 /*
-StopAllWorkers is experimental.
+StopAllWorkers sends the ServiceWorker.stopAllWorkers command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-stopAllWorkers
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StopAllWorkers() <-chan *worker.StopAllWorkersResult {
-	resultChan := make(chan *worker.StopAllWorkersResult)
+	resultChan := make(chan *worker.StopAllWorkersResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopAllWorkers", nil)
 	result := &worker.StopAllWorkersResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -237,21 +276,22 @@ func (protocol *ServiceWorkerProtocol) StopAllWorkers() <-chan *worker.StopAllWo
 }
 
 /*
-StopWorker is experimental.
+StopWorker sends the ServiceWorker.stopWorker command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-stopWorker
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) StopWorker(
 	params *worker.StopWorkerParams,
 ) <-chan *worker.StopWorkerResult {
-	resultChan := make(chan *worker.StopWorkerResult)
+	resultChan := make(chan *worker.StopWorkerResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.stopWorker", params)
 	result := &worker.StopWorkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -262,21 +302,22 @@ func (protocol *ServiceWorkerProtocol) StopWorker(
 }
 
 /*
-Unregister is experimental.
+Unregister sends the ServiceWorker.unregister command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-unregister
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) Unregister(
 	params *worker.UnregisterParams,
 ) <-chan *worker.UnregisterResult {
-	resultChan := make(chan *worker.UnregisterResult)
+	resultChan := make(chan *worker.UnregisterResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.unregister", params)
 	result := &worker.UnregisterResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -287,21 +328,48 @@ func (protocol *ServiceWorkerProtocol) Unregister(
 }
 
 /*
-UpdateRegistration is experimental.
+UpdateRegistration sends the ServiceWorker.updateRegistration command.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-updateRegistration
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) UpdateRegistration(
 	params *worker.UpdateRegistrationParams,
 ) <-chan *worker.UpdateRegistrationResult {
-	resultChan := make(chan *worker.UpdateRegistrationResult)
+	resultChan := make(chan *worker.UpdateRegistrationResult, 1)
 	command := NewCommand(protocol.Socket, "ServiceWorker.updateRegistration", params)
 	result := &worker.UpdateRegistrationResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Is experimental. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#method-inspectWorker
+*/
+func (protocol *ServiceWorkerProtocol) InspectWorker(
+	params *worker.InspectWorkerParams,
+) <-chan *worker.InspectWorkerResult {
+	resultChan := make(chan *worker.InspectWorkerResult, 1)
+	command := NewCommand(protocol.Socket, "ServiceWorker.inspectWorker", params)
+	result := &worker.InspectWorkerResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -312,14 +380,14 @@ func (protocol *ServiceWorkerProtocol) UpdateRegistration(
 }
 
 /*
-OnWorkerErrorReported is experimental.
+OnWorkerErrorReported adds a handler to the ServiceWorker.workerErrorReported
+event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerErrorReported
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 	callback func(event *worker.ErrorReportedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"ServiceWorker.workerErrorReported",
 		func(response *Response) {
@@ -332,17 +400,18 @@ func (protocol *ServiceWorkerProtocol) OnWorkerErrorReported(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnWorkerRegistrationUpdated is experimental.
+OnWorkerRegistrationUpdated adds a handler to the
+ServiceWorker.workerRegistrationUpdated event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerRegistrationUpdated
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 	callback func(event *worker.RegistrationUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"ServiceWorker.workerRegistrationUpdated",
 		func(response *Response) {
@@ -355,17 +424,18 @@ func (protocol *ServiceWorkerProtocol) OnWorkerRegistrationUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnWorkerVersionUpdated is experimental.
+OnWorkerVersionUpdated adds a handler to the ServiceWorker.workerVersionUpdated
+event.
 
 https://chromedevtools.github.io/devtools-protocol/tot/ServiceWorker/#event-workerVersionUpdated
-EXPERIMENTAL.
 */
 func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 	callback func(event *worker.VersionUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"ServiceWorker.workerVersionUpdated",
 		func(response *Response) {
@@ -378,4 +448,5 @@ func (protocol *ServiceWorkerProtocol) OnWorkerVersionUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -572,8 +572,8 @@ func TestServiceWorkerOnWorkerVersionUpdated(t *testing.T) {
 			ScriptURL:          "http://some.url",
 			RunningStatus:      worker.VersionRunningStatus.Stopped,
 			Status:             worker.VersionStatus.New,
-			ScriptLastModified: time.Now().Unix(),
-			ScriptResponseTime: time.Now().Unix(),
+			ScriptLastModified: float64(time.Now().Unix()),
+			ScriptResponseTime: float64(time.Now().Unix()),
 			ControlledClients:  []target.ID{"target-id"},
 			TargetID:           target.ID("target-id"),
 		}},
@@ -1,35 +1,905 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
-import (
-	"encoding/json"
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/storage"
+)
+
+/*
+StorageProtocol provides a namespace for the Chrome Storage protocol methods.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/
+*/
+type StorageProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *StorageProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *StorageProtocol) WithContext(ctx context.Context) *StorageProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &StorageProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Returns a storage key given a frame id.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getStorageKeyForFrame
+*/
+func (protocol *StorageProtocol) GetStorageKeyForFrame(
+	params *storage.GetStorageKeyForFrameParams,
+) <-chan *storage.GetStorageKeyForFrameResult {
+	resultChan := make(chan *storage.GetStorageKeyForFrameResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getStorageKeyForFrame", params)
+	result := &storage.GetStorageKeyForFrameResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears storage for origin.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForOrigin
+*/
+func (protocol *StorageProtocol) ClearDataForOrigin(
+	params *storage.ClearDataForOriginParams,
+) <-chan *storage.ClearDataForOriginResult {
+	resultChan := make(chan *storage.ClearDataForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.clearDataForOrigin", params)
+	result := &storage.ClearDataForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears storage for storage key.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForStorageKey
+*/
+func (protocol *StorageProtocol) ClearDataForStorageKey(
+	params *storage.ClearDataForStorageKeyParams,
+) <-chan *storage.ClearDataForStorageKeyResult {
+	resultChan := make(chan *storage.ClearDataForStorageKeyResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.clearDataForStorageKey", params)
+	result := &storage.ClearDataForStorageKeyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all browser cookies.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getCookies
+*/
+func (protocol *StorageProtocol) GetCookies(
+	params ...*storage.GetCookiesParams,
+) <-chan *storage.GetCookiesResult {
+	resultChan := make(chan *storage.GetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getCookies", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Storage.getCookies", params[0])
+	}
+	result := &storage.GetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets given cookies.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setCookies
+*/
+func (protocol *StorageProtocol) SetCookies(
+	params *storage.SetCookiesParams,
+) <-chan *storage.SetCookiesResult {
+	resultChan := make(chan *storage.SetCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setCookies", params)
+	result := &storage.SetCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears cookies.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearCookies
+*/
+func (protocol *StorageProtocol) ClearCookies(
+	params ...*storage.ClearCookiesParams,
+) <-chan *storage.ClearCookiesResult {
+	resultChan := make(chan *storage.ClearCookiesResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.clearCookies", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Storage.clearCookies", params[0])
+	}
+	result := &storage.ClearCookiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns usage and quota in bytes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageAndQuota
+*/
+func (protocol *StorageProtocol) GetUsageAndQuota(
+	params *storage.GetUsageAndQuotaParams,
+) <-chan *storage.GetUsageAndQuotaResult {
+	resultChan := make(chan *storage.GetUsageAndQuotaResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getUsageAndQuota", params)
+	result := &storage.GetUsageAndQuotaResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Override quota for the specified origin EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-overrideQuotaForOrigin
+*/
+func (protocol *StorageProtocol) OverrideQuotaForOrigin(
+	params *storage.OverrideQuotaForOriginParams,
+) <-chan *storage.OverrideQuotaForOriginResult {
+	resultChan := make(chan *storage.OverrideQuotaForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.overrideQuotaForOrigin", params)
+	result := &storage.OverrideQuotaForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Registers origin to be notified when an update occurs to its cache storage list.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForOrigin
+*/
+func (protocol *StorageProtocol) TrackCacheStorageForOrigin(
+	params *storage.TrackCacheStorageForOriginParams,
+) <-chan *storage.TrackCacheStorageForOriginResult {
+	resultChan := make(chan *storage.TrackCacheStorageForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.trackCacheStorageForOrigin", params)
+	result := &storage.TrackCacheStorageForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Registers storage key to be notified when an update occurs to its cache storage
+list.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForStorageKey
+*/
+func (protocol *StorageProtocol) TrackCacheStorageForStorageKey(
+	params *storage.TrackCacheStorageForStorageKeyParams,
+) <-chan *storage.TrackCacheStorageForStorageKeyResult {
+	resultChan := make(chan *storage.TrackCacheStorageForStorageKeyResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.trackCacheStorageForStorageKey", params)
+	result := &storage.TrackCacheStorageForStorageKeyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Registers origin to be notified when an update occurs to its IndexedDB.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForOrigin
+*/
+func (protocol *StorageProtocol) TrackIndexedDBForOrigin(
+	params *storage.TrackIndexedDBForOriginParams,
+) <-chan *storage.TrackIndexedDBForOriginResult {
+	resultChan := make(chan *storage.TrackIndexedDBForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.trackIndexedDBForOrigin", params)
+	result := &storage.TrackIndexedDBForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Registers storage key to be notified when an update occurs to its IndexedDB.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForStorageKey
+*/
+func (protocol *StorageProtocol) TrackIndexedDBForStorageKey(
+	params *storage.TrackIndexedDBForStorageKeyParams,
+) <-chan *storage.TrackIndexedDBForStorageKeyResult {
+	resultChan := make(chan *storage.TrackIndexedDBForStorageKeyResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.trackIndexedDBForStorageKey", params)
+	result := &storage.TrackIndexedDBForStorageKeyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Unregisters origin from receiving notifications for cache storage.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForOrigin
+*/
+func (protocol *StorageProtocol) UntrackCacheStorageForOrigin(
+	params *storage.UntrackCacheStorageForOriginParams,
+) <-chan *storage.UntrackCacheStorageForOriginResult {
+	resultChan := make(chan *storage.UntrackCacheStorageForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.untrackCacheStorageForOrigin", params)
+	result := &storage.UntrackCacheStorageForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Unregisters storage key from receiving notifications for cache storage.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForStorageKey
+*/
+func (protocol *StorageProtocol) UntrackCacheStorageForStorageKey(
+	params *storage.UntrackCacheStorageForStorageKeyParams,
+) <-chan *storage.UntrackCacheStorageForStorageKeyResult {
+	resultChan := make(chan *storage.UntrackCacheStorageForStorageKeyResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.untrackCacheStorageForStorageKey", params)
+	result := &storage.UntrackCacheStorageForStorageKeyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Unregisters origin from receiving notifications for IndexedDB.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForOrigin
+*/
+func (protocol *StorageProtocol) UntrackIndexedDBForOrigin(
+	params *storage.UntrackIndexedDBForOriginParams,
+) <-chan *storage.UntrackIndexedDBForOriginResult {
+	resultChan := make(chan *storage.UntrackIndexedDBForOriginResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.untrackIndexedDBForOrigin", params)
+	result := &storage.UntrackIndexedDBForOriginResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Unregisters storage key from receiving notifications for IndexedDB.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForStorageKey
+*/
+func (protocol *StorageProtocol) UntrackIndexedDBForStorageKey(
+	params *storage.UntrackIndexedDBForStorageKeyParams,
+) <-chan *storage.UntrackIndexedDBForStorageKeyResult {
+	resultChan := make(chan *storage.UntrackIndexedDBForStorageKeyResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.untrackIndexedDBForStorageKey", params)
+	result := &storage.UntrackIndexedDBForStorageKeyResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns the number of stored Trust Tokens per issuer for the current browsing
+context. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getTrustTokens
+*/
+func (protocol *StorageProtocol) GetTrustTokens() <-chan *storage.GetTrustTokensResult {
+	resultChan := make(chan *storage.GetTrustTokensResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getTrustTokens", nil)
+	result := &storage.GetTrustTokensResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes all Trust Tokens issued by the provided issuerOrigin. Leaves other
+stored data, including the issuer's Redemption Records, intact. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearTrustTokens
+*/
+func (protocol *StorageProtocol) ClearTrustTokens(
+	params *storage.ClearTrustTokensParams,
+) <-chan *storage.ClearTrustTokensResult {
+	resultChan := make(chan *storage.ClearTrustTokensResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.clearTrustTokens", params)
+	result := &storage.ClearTrustTokensResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets details for a named interest group. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getInterestGroupDetails
+*/
+func (protocol *StorageProtocol) GetInterestGroupDetails(
+	params *storage.GetInterestGroupDetailsParams,
+) <-chan *storage.GetInterestGroupDetailsResult {
+	resultChan := make(chan *storage.GetInterestGroupDetailsResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getInterestGroupDetails", params)
+	result := &storage.GetInterestGroupDetailsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables/Disables issuing of interestGroupAccessed events. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupTracking
+*/
+func (protocol *StorageProtocol) SetInterestGroupTracking(
+	params *storage.SetInterestGroupTrackingParams,
+) <-chan *storage.SetInterestGroupTrackingResult {
+	resultChan := make(chan *storage.SetInterestGroupTrackingResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setInterestGroupTracking", params)
+	result := &storage.SetInterestGroupTrackingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables/Disables issuing of interestGroupAuctionEventOccurred and
+interestGroupAuctionNetworkRequestCreated. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupAuctionTracking
+*/
+func (protocol *StorageProtocol) SetInterestGroupAuctionTracking(
+	params *storage.SetInterestGroupAuctionTrackingParams,
+) <-chan *storage.SetInterestGroupAuctionTrackingResult {
+	resultChan := make(chan *storage.SetInterestGroupAuctionTrackingResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setInterestGroupAuctionTracking", params)
+	result := &storage.SetInterestGroupAuctionTrackingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets metadata for an origin's shared storage. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageMetadata
+*/
+func (protocol *StorageProtocol) GetSharedStorageMetadata(
+	params *storage.GetSharedStorageMetadataParams,
+) <-chan *storage.GetSharedStorageMetadataResult {
+	resultChan := make(chan *storage.GetSharedStorageMetadataResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getSharedStorageMetadata", params)
+	result := &storage.GetSharedStorageMetadataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Gets the entries in an given origin's shared storage. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageEntries
+*/
+func (protocol *StorageProtocol) GetSharedStorageEntries(
+	params *storage.GetSharedStorageEntriesParams,
+) <-chan *storage.GetSharedStorageEntriesResult {
+	resultChan := make(chan *storage.GetSharedStorageEntriesResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getSharedStorageEntries", params)
+	result := &storage.GetSharedStorageEntriesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets entry with `key` and `value` for a given origin's shared storage.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageEntry
+*/
+func (protocol *StorageProtocol) SetSharedStorageEntry(
+	params *storage.SetSharedStorageEntryParams,
+) <-chan *storage.SetSharedStorageEntryResult {
+	resultChan := make(chan *storage.SetSharedStorageEntryResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setSharedStorageEntry", params)
+	result := &storage.SetSharedStorageEntryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Deletes entry for `key` (if it exists) for a given origin's shared storage.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteSharedStorageEntry
+*/
+func (protocol *StorageProtocol) DeleteSharedStorageEntry(
+	params *storage.DeleteSharedStorageEntryParams,
+) <-chan *storage.DeleteSharedStorageEntryResult {
+	resultChan := make(chan *storage.DeleteSharedStorageEntryResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.deleteSharedStorageEntry", params)
+	result := &storage.DeleteSharedStorageEntryResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears all entries for a given origin's shared storage. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearSharedStorageEntries
+*/
+func (protocol *StorageProtocol) ClearSharedStorageEntries(
+	params *storage.ClearSharedStorageEntriesParams,
+) <-chan *storage.ClearSharedStorageEntriesResult {
+	resultChan := make(chan *storage.ClearSharedStorageEntriesResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.clearSharedStorageEntries", params)
+	result := &storage.ClearSharedStorageEntriesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-resetSharedStorageBudget
+*/
+func (protocol *StorageProtocol) ResetSharedStorageBudget(
+	params *storage.ResetSharedStorageBudgetParams,
+) <-chan *storage.ResetSharedStorageBudgetResult {
+	resultChan := make(chan *storage.ResetSharedStorageBudgetResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.resetSharedStorageBudget", params)
+	result := &storage.ResetSharedStorageBudgetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Enables/disables issuing of sharedStorageAccessed events. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageTracking
+*/
+func (protocol *StorageProtocol) SetSharedStorageTracking(
+	params *storage.SetSharedStorageTrackingParams,
+) <-chan *storage.SetSharedStorageTrackingResult {
+	resultChan := make(chan *storage.SetSharedStorageTrackingResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setSharedStorageTracking", params)
+	result := &storage.SetSharedStorageTrackingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Set tracking for a storage key's buckets. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setStorageBucketTracking
+*/
+func (protocol *StorageProtocol) SetStorageBucketTracking(
+	params *storage.SetStorageBucketTrackingParams,
+) <-chan *storage.SetStorageBucketTrackingResult {
+	resultChan := make(chan *storage.SetStorageBucketTrackingResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setStorageBucketTracking", params)
+	result := &storage.SetStorageBucketTrackingResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
 
-	"github.com/mkenney/go-chrome/tot/storage"
-)
+/*
+Deletes the Storage Bucket with the given storage key and bucket name.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteStorageBucket
+*/
+func (protocol *StorageProtocol) DeleteStorageBucket(
+	params *storage.DeleteStorageBucketParams,
+) <-chan *storage.DeleteStorageBucketResult {
+	resultChan := make(chan *storage.DeleteStorageBucketResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.deleteStorageBucket", params)
+	result := &storage.DeleteStorageBucketResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
 
 /*
-StorageProtocol provides a namespace for the Chrome Storage protocol methods.
+Deletes state for sites identified as potential bounce trackers, immediately.
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-runBounceTrackingMitigations
 */
-type StorageProtocol struct {
-	Socket Socketer
+func (protocol *StorageProtocol) RunBounceTrackingMitigations() <-chan *storage.RunBounceTrackingMitigationsResult {
+	resultChan := make(chan *storage.RunBounceTrackingMitigationsResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.runBounceTrackingMitigations", nil)
+	result := &storage.RunBounceTrackingMitigationsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
 }
 
 /*
-ClearDataForOrigin clears storage for origin.
+https://wicg.github.io/attribution-reporting-api/ EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForOrigin
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingLocalTestingMode
 */
-func (protocol *StorageProtocol) ClearDataForOrigin(
-	params *storage.ClearDataForOriginParams,
-) <-chan *storage.ClearDataForOriginResult {
-	resultChan := make(chan *storage.ClearDataForOriginResult)
-	command := NewCommand(protocol.Socket, "Storage.clearDataForOrigin", params)
-	result := &storage.ClearDataForOriginResult{}
+func (protocol *StorageProtocol) SetAttributionReportingLocalTestingMode(
+	params *storage.SetAttributionReportingLocalTestingModeParams,
+) <-chan *storage.SetAttributionReportingLocalTestingModeResult {
+	resultChan := make(chan *storage.SetAttributionReportingLocalTestingModeResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setAttributionReportingLocalTestingMode", params)
+	result := &storage.SetAttributionReportingLocalTestingModeResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -40,23 +910,23 @@ func (protocol *StorageProtocol) ClearDataForOrigin(
 }
 
 /*
-GetUsageAndQuota returns usage and quota in bytes.
+Enables/disables issuing of Attribution Reporting events. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageAndQuota
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingTracking
 */
-func (protocol *StorageProtocol) GetUsageAndQuota(
-	params *storage.GetUsageAndQuotaParams,
-) <-chan *storage.GetUsageAndQuotaResult {
-	resultChan := make(chan *storage.GetUsageAndQuotaResult)
-	command := NewCommand(protocol.Socket, "storage.getUsageAndQuota", params)
-	result := &storage.GetUsageAndQuotaResult{}
+func (protocol *StorageProtocol) SetAttributionReportingTracking(
+	params *storage.SetAttributionReportingTrackingParams,
+) <-chan *storage.SetAttributionReportingTrackingResult {
+	resultChan := make(chan *storage.SetAttributionReportingTrackingResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setAttributionReportingTracking", params)
+	result := &storage.SetAttributionReportingTrackingResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
-		} else {
-			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -66,22 +936,24 @@ func (protocol *StorageProtocol) GetUsageAndQuota(
 }
 
 /*
-TrackCacheStorageForOrigin registers origin to be notified when an update occurs
-to its cache storage list.
+Sends all pending Attribution Reports immediately, regardless of their scheduled
+report time. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForOrigin
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-sendPendingAttributionReports
 */
-func (protocol *StorageProtocol) TrackCacheStorageForOrigin(
-	params *storage.TrackCacheStorageForOriginParams,
-) <-chan *storage.TrackCacheStorageForOriginResult {
-	resultChan := make(chan *storage.TrackCacheStorageForOriginResult)
-	command := NewCommand(protocol.Socket, "Storage.trackCacheStorageForOrigin", params)
-	result := &storage.TrackCacheStorageForOriginResult{}
+func (protocol *StorageProtocol) SendPendingAttributionReports() <-chan *storage.SendPendingAttributionReportsResult {
+	resultChan := make(chan *storage.SendPendingAttributionReportsResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.sendPendingAttributionReports", nil)
+	result := &storage.SendPendingAttributionReportsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -91,22 +963,25 @@ func (protocol *StorageProtocol) TrackCacheStorageForOrigin(
 }
 
 /*
-TrackIndexedDBForOrigin registers origin to be notified when an update occurs to
-its IndexedDB.
+Returns the effective Related Website Sets in use by this profile for the
+browser session. The effective Related Website Sets will not change during a
+browser session. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForOrigin
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getRelatedWebsiteSets
 */
-func (protocol *StorageProtocol) TrackIndexedDBForOrigin(
-	params *storage.TrackIndexedDBForOriginParams,
-) <-chan *storage.TrackIndexedDBForOriginResult {
-	resultChan := make(chan *storage.TrackIndexedDBForOriginResult)
-	command := NewCommand(protocol.Socket, "Storage.trackIndexedDBForOrigin", params)
-	result := &storage.TrackIndexedDBForOriginResult{}
+func (protocol *StorageProtocol) GetRelatedWebsiteSets() <-chan *storage.GetRelatedWebsiteSetsResult {
+	resultChan := make(chan *storage.GetRelatedWebsiteSetsResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getRelatedWebsiteSets", nil)
+	result := &storage.GetRelatedWebsiteSetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -116,22 +991,28 @@ func (protocol *StorageProtocol) TrackIndexedDBForOrigin(
 }
 
 /*
-UntrackCacheStorageForOrigin unregisters origin from receiving notifications for
-cache storage.
+Returns the list of URLs from a page and its embedded resources that match
+existing grace period URL pattern rules.
+https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period
+EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForOrigin
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getAffectedUrlsForThirdPartyCookieMetadata
 */
-func (protocol *StorageProtocol) UntrackCacheStorageForOrigin(
-	params *storage.UntrackCacheStorageForOriginParams,
-) <-chan *storage.UntrackCacheStorageForOriginResult {
-	resultChan := make(chan *storage.UntrackCacheStorageForOriginResult)
-	command := NewCommand(protocol.Socket, "Storage.untrackCacheStorageForOrigin", params)
-	result := &storage.UntrackCacheStorageForOriginResult{}
+func (protocol *StorageProtocol) GetAffectedURLsForThirdPartyCookieMetadata(
+	params *storage.GetAffectedURLsForThirdPartyCookieMetadataParams,
+) <-chan *storage.GetAffectedURLsForThirdPartyCookieMetadataResult {
+	resultChan := make(chan *storage.GetAffectedURLsForThirdPartyCookieMetadataResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.getAffectedUrlsForThirdPartyCookieMetadata", params)
+	result := &storage.GetAffectedURLsForThirdPartyCookieMetadataResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -141,21 +1022,23 @@ func (protocol *StorageProtocol) UntrackCacheStorageForOrigin(
 }
 
 /*
-UntrackIndexedDBForOrigin unregisters origin from receiving notifications for
-IndexedDB.
+SetProtectedAudienceKAnonymity sends the Storage.setProtectedAudienceKAnonymity
+command.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForOrigin
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setProtectedAudienceKAnonymity
 */
-func (protocol *StorageProtocol) UntrackIndexedDBForOrigin(
-	params *storage.UntrackIndexedDBForOriginParams,
-) <-chan *storage.UntrackIndexedDBForOriginResult {
-	resultChan := make(chan *storage.UntrackIndexedDBForOriginResult)
-	command := NewCommand(protocol.Socket, "Storage.untrackIndexedDBForOrigin", params)
-	result := &storage.UntrackIndexedDBForOriginResult{}
+func (protocol *StorageProtocol) SetProtectedAudienceKAnonymity(
+	params *storage.SetProtectedAudienceKAnonymityParams,
+) <-chan *storage.SetProtectedAudienceKAnonymityResult {
+	resultChan := make(chan *storage.SetProtectedAudienceKAnonymityResult, 1)
+	command := NewCommand(protocol.Socket, "Storage.setProtectedAudienceKAnonymity", params)
+	result := &storage.SetProtectedAudienceKAnonymityResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -166,15 +1049,16 @@ func (protocol *StorageProtocol) UntrackIndexedDBForOrigin(
 }
 
 /*
-OnCacheStorageContentUpdated adds a handler to the Storage.cacheStorageContentUpdated
-event. Storage.cacheStorageContentUpdated fires when a cache's contents have
-been modified.
+OnCacheStorageContentUpdated adds a handler to the
+Storage.cacheStorageContentUpdated event.
+
+A cache's contents have been modified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStorageContentUpdated
 */
 func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 	callback func(event *storage.CacheStorageContentUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Storage.cacheStorageContentUpdated",
 		func(response *Response) {
@@ -187,17 +1071,20 @@ func (protocol *StorageProtocol) OnCacheStorageContentUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnCacheStorageListUpdated adds a handler to the Storage.cacheStorageListUpdated
-event. Storage.cacheStorageListUpdated fires when cache has been added/deleted.
+event.
+
+A cache has been added/deleted.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-cacheStorageListUpdated
 */
 func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 	callback func(event *storage.CacheStorageListUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Storage.cacheStorageListUpdated",
 		func(response *Response) {
@@ -210,18 +1097,20 @@ func (protocol *StorageProtocol) OnCacheStorageListUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnIndexedDBContentUpdated adds a handler to the Storage.indexedDBContentUpdated
-event. Storage.indexedDBContentUpdated fires when the origin's IndexedDB object
-store has been modified.
+event.
+
+The origin's IndexedDB object store has been modified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBContentUpdated
 */
 func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 	callback func(event *storage.IndexedDBContentUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Storage.indexedDBContentUpdated",
 		func(response *Response) {
@@ -234,18 +1123,19 @@ func (protocol *StorageProtocol) OnIndexedDBContentUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnIndexedDBListUpdated adds a handler to the Storage.indexedDBListUpdated event.
-Storage.indexedDBListUpdated fires when the origin's IndexedDB database list has
-been modified.
+
+The origin's IndexedDB database list has been modified.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-indexedDBListUpdated
 */
 func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 	callback func(event *storage.IndexedDBListUpdatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Storage.indexedDBListUpdated",
 		func(response *Response) {
@@ -258,4 +1148,292 @@ func (protocol *StorageProtocol) OnIndexedDBListUpdated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInterestGroupAccessed adds a handler to the Storage.interestGroupAccessed
+event.
+
+One of the interest groups was accessed. Note that these events are global to
+all targets sharing an interest group store.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAccessed
+*/
+func (protocol *StorageProtocol) OnInterestGroupAccessed(
+	callback func(event *storage.InterestGroupAccessedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.interestGroupAccessed",
+		func(response *Response) {
+			event := &storage.InterestGroupAccessedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInterestGroupAuctionEventOccurred adds a handler to the
+Storage.interestGroupAuctionEventOccurred event.
+
+An auction involving interest groups is taking place. These events are
+target-specific.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAuctionEventOccurred
+*/
+func (protocol *StorageProtocol) OnInterestGroupAuctionEventOccurred(
+	callback func(event *storage.InterestGroupAuctionEventOccurredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.interestGroupAuctionEventOccurred",
+		func(response *Response) {
+			event := &storage.InterestGroupAuctionEventOccurredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnInterestGroupAuctionNetworkRequestCreated adds a handler to the
+Storage.interestGroupAuctionNetworkRequestCreated event.
+
+Specifies which auctions a particular network fetch may be related to, and in
+what role. Note that it is not ordered with respect to Network.requestWillBeSent
+(but will happen before loadingFinished loadingFailed).
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAuctionNetworkRequestCreated
+*/
+func (protocol *StorageProtocol) OnInterestGroupAuctionNetworkRequestCreated(
+	callback func(event *storage.InterestGroupAuctionNetworkRequestCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.interestGroupAuctionNetworkRequestCreated",
+		func(response *Response) {
+			event := &storage.InterestGroupAuctionNetworkRequestCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSharedStorageAccessed adds a handler to the Storage.sharedStorageAccessed
+event.
+
+Shared storage was accessed by the associated page. The following parameters are
+included in all events.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-sharedStorageAccessed
+*/
+func (protocol *StorageProtocol) OnSharedStorageAccessed(
+	callback func(event *storage.SharedStorageAccessedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.sharedStorageAccessed",
+		func(response *Response) {
+			event := &storage.SharedStorageAccessedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnSharedStorageWorkletOperationExecutionFinished adds a handler to the
+Storage.sharedStorageWorkletOperationExecutionFinished event.
+
+A shared storage run or selectURL operation finished its execution. The
+following parameters are included in all events.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-sharedStorageWorkletOperationExecutionFinished
+*/
+func (protocol *StorageProtocol) OnSharedStorageWorkletOperationExecutionFinished(
+	callback func(event *storage.SharedStorageWorkletOperationExecutionFinishedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.sharedStorageWorkletOperationExecutionFinished",
+		func(response *Response) {
+			event := &storage.SharedStorageWorkletOperationExecutionFinishedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnStorageBucketCreatedOrUpdated adds a handler to the
+Storage.storageBucketCreatedOrUpdated event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-storageBucketCreatedOrUpdated
+*/
+func (protocol *StorageProtocol) OnStorageBucketCreatedOrUpdated(
+	callback func(event *storage.BucketCreatedOrUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.storageBucketCreatedOrUpdated",
+		func(response *Response) {
+			event := &storage.BucketCreatedOrUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnStorageBucketDeleted adds a handler to the Storage.storageBucketDeleted event.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-storageBucketDeleted
+*/
+func (protocol *StorageProtocol) OnStorageBucketDeleted(
+	callback func(event *storage.BucketDeletedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.storageBucketDeleted",
+		func(response *Response) {
+			event := &storage.BucketDeletedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAttributionReportingSourceRegistered adds a handler to the
+Storage.attributionReportingSourceRegistered event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingSourceRegistered
+*/
+func (protocol *StorageProtocol) OnAttributionReportingSourceRegistered(
+	callback func(event *storage.AttributionReportingSourceRegisteredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.attributionReportingSourceRegistered",
+		func(response *Response) {
+			event := &storage.AttributionReportingSourceRegisteredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAttributionReportingTriggerRegistered adds a handler to the
+Storage.attributionReportingTriggerRegistered event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingTriggerRegistered
+*/
+func (protocol *StorageProtocol) OnAttributionReportingTriggerRegistered(
+	callback func(event *storage.AttributionReportingTriggerRegisteredEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.attributionReportingTriggerRegistered",
+		func(response *Response) {
+			event := &storage.AttributionReportingTriggerRegisteredEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAttributionReportingReportSent adds a handler to the
+Storage.attributionReportingReportSent event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingReportSent
+*/
+func (protocol *StorageProtocol) OnAttributionReportingReportSent(
+	callback func(event *storage.AttributionReportingReportSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.attributionReportingReportSent",
+		func(response *Response) {
+			event := &storage.AttributionReportingReportSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAttributionReportingVerboseDebugReportSent adds a handler to the
+Storage.attributionReportingVerboseDebugReportSent event.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingVerboseDebugReportSent
+*/
+func (protocol *StorageProtocol) OnAttributionReportingVerboseDebugReportSent(
+	callback func(event *storage.AttributionReportingVerboseDebugReportSentEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Storage.attributionReportingVerboseDebugReportSent",
+		func(response *Response) {
+			event := &storage.AttributionReportingVerboseDebugReportSentEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/system/info"
@@ -8,28 +11,110 @@ import (
 
 /*
 SystemInfoProtocol provides a namespace for the Chrome SystemInfo protocol
-methods. The SystemInfo protocol defines methods and events for querying
-low-level system information.
+methods.
+
+The SystemInfo domain defines methods and events for querying low-level system
+information. EXPERIMENTAL.
 
-https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/
 */
 type SystemInfoProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-GetInfo returns information about the system.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *SystemInfoProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *SystemInfoProtocol) WithContext(ctx context.Context) *SystemInfoProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &SystemInfoProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Returns information about the system.
 
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getInfo
 */
 func (protocol *SystemInfoProtocol) GetInfo() <-chan *info.GetInfoResult {
-	resultChan := make(chan *info.GetInfoResult)
+	resultChan := make(chan *info.GetInfoResult, 1)
 	command := NewCommand(protocol.Socket, "SystemInfo.getInfo", nil)
 	result := &info.GetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns information about the feature state.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getFeatureState
+*/
+func (protocol *SystemInfoProtocol) GetFeatureState(
+	params *info.GetFeatureStateParams,
+) <-chan *info.GetFeatureStateResult {
+	resultChan := make(chan *info.GetFeatureStateResult, 1)
+	command := NewCommand(protocol.Socket, "SystemInfo.getFeatureState", params)
+	result := &info.GetFeatureStateResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns information about all running processes.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getProcessInfo
+*/
+func (protocol *SystemInfoProtocol) GetProcessInfo() <-chan *info.GetProcessInfoResult {
+	resultChan := make(chan *info.GetProcessInfoResult, 1)
+	command := NewCommand(protocol.Socket, "SystemInfo.getProcessInfo", nil)
+	result := &info.GetProcessInfoResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
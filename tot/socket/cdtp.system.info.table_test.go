@@ -0,0 +1,60 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/system/info"
+)
+
+func TestSystemInfoCommands(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSystemInfoCommands")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	tests := []struct {
+		method string
+		send   func() func() error
+	}{
+		{"SystemInfo.getInfo", func() func() error {
+			resultChan := mockSocket.SystemInfo().GetInfo()
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"SystemInfo.getFeatureState", func() func() error {
+			resultChan := mockSocket.SystemInfo().GetFeatureState(&info.GetFeatureStateParams{})
+			return func() error { return (<-resultChan).Err }
+		}},
+		{"SystemInfo.getProcessInfo", func() func() error {
+			resultChan := mockSocket.SystemInfo().GetProcessInfo()
+			return func() error { return (<-resultChan).Err }
+		}},
+	}
+
+	for _, test := range tests {
+		wait := test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID:     mockSocket.CurCommandID(),
+			Error:  &Error{},
+			Result: []byte(`{}`),
+		})
+		if err := wait(); nil != err {
+			t.Errorf("%s: expected success, got error: '%s'", test.method, err.Error())
+		}
+
+		wait = test.send()
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			ID: mockSocket.CurCommandID(),
+			Error: &Error{
+				Code:    1,
+				Data:    []byte(`"error data"`),
+				Message: "error message",
+			},
+		})
+		if err := wait(); nil == err {
+			t.Errorf("%s: expected error, got success", test.method)
+		}
+	}
+}
@@ -23,8 +23,8 @@ func TestSystemInfoGetInfo(t *testing.T) {
 				VendorString: "VendorString",
 				DeviceString: "DeviceString",
 			}},
-			AuxAttributes:        map[string]string{"AuxAttributes": "value"},
-			FeatureStatus:        map[string]string{"FeatureStatus": "value"},
+			AuxAttributes:        map[string]interface{}{"AuxAttributes": "value"},
+			FeatureStatus:        map[string]interface{}{"FeatureStatus": "value"},
 			DriverBugWorkarounds: []string{"DriverBugWorkarounds1", "DriverBugWorkarounds2"},
 		},
 		ModelName:    "ModelName",
@@ -42,7 +42,7 @@ func TestSystemInfoGetInfo(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", result.Err.Error())
 	}
 	if mockResult.GPU.Devices[0].VendorID != result.GPU.Devices[0].VendorID {
-		t.Errorf("Expected %d, got %d", mockResult.GPU.Devices[0].VendorID, result.GPU.Devices[0].VendorID)
+		t.Errorf("Expected %v, got %v", mockResult.GPU.Devices[0].VendorID, result.GPU.Devices[0].VendorID)
 	}
 
 	resultChan = mockSocket.SystemInfo().GetInfo()
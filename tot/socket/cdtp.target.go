@@ -1,36 +1,66 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/target"
 )
 
 /*
-TargetProtocol provides a namespace for the Chrome Target protocol methods. The Target protocol
-supports additional targets discovery and allows to attach to them.
+TargetProtocol provides a namespace for the Chrome Target protocol methods.
+
+Supports additional targets discovery and allows to attach to them.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/
 */
 type TargetProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *TargetProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *TargetProtocol) WithContext(ctx context.Context) *TargetProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &TargetProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-ActivateTarget activates (focuses) the target.
+Activates (focuses) the target.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-activateTarget
 */
 func (protocol *TargetProtocol) ActivateTarget(
 	params *target.ActivateTargetParams,
 ) <-chan *target.ActivateTargetResult {
-	resultChan := make(chan *target.ActivateTargetResult)
+	resultChan := make(chan *target.ActivateTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.activateTarget", params)
 	result := &target.ActivateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -41,20 +71,22 @@ func (protocol *TargetProtocol) ActivateTarget(
 }
 
 /*
-AttachToTarget attaches to the target with given id.
+Attaches to the target with given id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTarget
 */
 func (protocol *TargetProtocol) AttachToTarget(
 	params *target.AttachToTargetParams,
 ) <-chan *target.AttachToTargetResult {
-	resultChan := make(chan *target.AttachToTargetResult)
+	resultChan := make(chan *target.AttachToTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.attachToTarget", params)
 	result := &target.AttachToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -67,20 +99,48 @@ func (protocol *TargetProtocol) AttachToTarget(
 }
 
 /*
-CloseTarget closes the target. If the target is a page that gets closed too.
+Attaches to the browser target, only uses flat sessionId mode. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToBrowserTarget
+*/
+func (protocol *TargetProtocol) AttachToBrowserTarget() <-chan *target.AttachToBrowserTargetResult {
+	resultChan := make(chan *target.AttachToBrowserTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.attachToBrowserTarget", nil)
+	result := &target.AttachToBrowserTargetResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Closes the target. If the target is a page that gets closed too.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarget
 */
 func (protocol *TargetProtocol) CloseTarget(
 	params *target.CloseTargetParams,
 ) <-chan *target.CloseTargetResult {
-	resultChan := make(chan *target.CloseTargetResult)
+	resultChan := make(chan *target.CloseTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.closeTarget", params)
 	result := &target.CloseTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -93,20 +153,63 @@ func (protocol *TargetProtocol) CloseTarget(
 }
 
 /*
-CreateBrowserContext creates a new empty BrowserContext. Similar to an incognito
-profile but you can have more than one.
+Inject object to the target's main frame that provides a communication channel
+with browser target.
+
+Injected object will be available as `window[bindingName]`.
+
+The object has the following API:
+- `binding.send(json)` - a method to send messages over the remote debugging
+protocol
+- `binding.onmessage = json => handleMessage(json)` - a callback that will be
+called for the protocol notifications and command responses. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-exposeDevToolsProtocol
+*/
+func (protocol *TargetProtocol) ExposeDevToolsProtocol(
+	params *target.ExposeDevToolsProtocolParams,
+) <-chan *target.ExposeDevToolsProtocolResult {
+	resultChan := make(chan *target.ExposeDevToolsProtocolResult, 1)
+	command := NewCommand(protocol.Socket, "Target.exposeDevToolsProtocol", params)
+	result := &target.ExposeDevToolsProtocolResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates a new empty BrowserContext. Similar to an incognito profile but you can
+have more than one.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrowserContext
-EXPERIMENTAL.
 */
-func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrowserContextResult {
-	resultChan := make(chan *target.CreateBrowserContextResult)
+func (protocol *TargetProtocol) CreateBrowserContext(
+	params ...*target.CreateBrowserContextParams,
+) <-chan *target.CreateBrowserContextResult {
+	resultChan := make(chan *target.CreateBrowserContextResult, 1)
 	command := NewCommand(protocol.Socket, "Target.createBrowserContext", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Target.createBrowserContext", params[0])
+	}
 	result := &target.CreateBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -119,20 +222,48 @@ func (protocol *TargetProtocol) CreateBrowserContext() <-chan *target.CreateBrow
 }
 
 /*
-CreateTarget creates a new page.
+Returns all browser contexts created with `Target.createBrowserContext` method.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getBrowserContexts
+*/
+func (protocol *TargetProtocol) GetBrowserContexts() <-chan *target.GetBrowserContextsResult {
+	resultChan := make(chan *target.GetBrowserContextsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getBrowserContexts", nil)
+	result := &target.GetBrowserContextsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates a new page.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarget
 */
 func (protocol *TargetProtocol) CreateTarget(
 	params *target.CreateTargetParams,
 ) <-chan *target.CreateTargetResult {
-	resultChan := make(chan *target.CreateTargetResult)
+	resultChan := make(chan *target.CreateTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.createTarget", params)
 	result := &target.CreateTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -145,20 +276,27 @@ func (protocol *TargetProtocol) CreateTarget(
 }
 
 /*
-DetachFromTarget detaches session with given id.
+Detaches session with given id.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-detachFromTarget
 */
 func (protocol *TargetProtocol) DetachFromTarget(
-	params *target.DetachFromTargetParams,
+	params ...*target.DetachFromTargetParams,
 ) <-chan *target.DetachFromTargetResult {
-	resultChan := make(chan *target.DetachFromTargetResult)
-	command := NewCommand(protocol.Socket, "Target.detachFromTarget", params)
+	resultChan := make(chan *target.DetachFromTargetResult, 1)
+	command := NewCommand(protocol.Socket, "Target.detachFromTarget", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Target.detachFromTarget", params[0])
+	}
 	result := &target.DetachFromTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -169,22 +307,23 @@ func (protocol *TargetProtocol) DetachFromTarget(
 }
 
 /*
-DisposeBrowserContext deletes a BrowserContext, will fail of any open page uses
-it.
+Deletes a BrowserContext. All the belonging pages will be closed without calling
+their beforeunload hooks.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-disposeBrowserContext
-EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) DisposeBrowserContext(
 	params *target.DisposeBrowserContextParams,
 ) <-chan *target.DisposeBrowserContextResult {
-	resultChan := make(chan *target.DisposeBrowserContextResult)
+	resultChan := make(chan *target.DisposeBrowserContextResult, 1)
 	command := NewCommand(protocol.Socket, "Target.disposeBrowserContext", params)
 	result := &target.DisposeBrowserContextResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -197,21 +336,27 @@ func (protocol *TargetProtocol) DisposeBrowserContext(
 }
 
 /*
-GetTargetInfo returns information about a target.
+Returns information about a target.
+
+The parameters are optional. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargetInfo
-EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) GetTargetInfo(
-	params *target.GetTargetInfoParams,
+	params ...*target.GetTargetInfoParams,
 ) <-chan *target.GetTargetInfoResult {
-	resultChan := make(chan *target.GetTargetInfoResult)
-	command := NewCommand(protocol.Socket, "Target.getTargetInfo", params)
+	resultChan := make(chan *target.GetTargetInfoResult, 1)
+	command := NewCommand(protocol.Socket, "Target.getTargetInfo", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Target.getTargetInfo", params[0])
+	}
 	result := &target.GetTargetInfoResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -224,21 +369,25 @@ func (protocol *TargetProtocol) GetTargetInfo(
 }
 
 /*
-GetTargets retrieves a list of available targets.
+Retrieves a list of available targets.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 */
 func (protocol *TargetProtocol) GetTargets(
 	params *target.GetTargetsParams,
 ) <-chan *target.GetTargetsResult {
-	resultChan := make(chan *target.GetTargetsResult)
+	resultChan := make(chan *target.GetTargetsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.getTargets", params)
 	result := &target.GetTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
 		}
 		resultChan <- result
 		close(resultChan)
@@ -248,20 +397,24 @@ func (protocol *TargetProtocol) GetTargets(
 }
 
 /*
-SendMessageToTarget sends protocol message over session with given id.
+Sends protocol message over session with given id. Consider using flat mode
+instead; see commands attachToTarget, setAutoAttach, and crbug.com/991325.
+DEPRECATED.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-sendMessageToTarget
 */
 func (protocol *TargetProtocol) SendMessageToTarget(
 	params *target.SendMessageToTargetParams,
 ) <-chan *target.SendMessageToTargetResult {
-	resultChan := make(chan *target.SendMessageToTargetResult)
+	resultChan := make(chan *target.SendMessageToTargetResult, 1)
 	command := NewCommand(protocol.Socket, "Target.sendMessageToTarget", params)
 	result := &target.SendMessageToTargetResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -272,21 +425,28 @@ func (protocol *TargetProtocol) SendMessageToTarget(
 }
 
 /*
-SetAttachToFrames is experimental.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
-EXPERIMENTAL.
+Controls whether to automatically attach to new targets which are considered to
+be directly related to this one (for example, iframes or workers). When turned
+on, attaches to all existing related targets as well. When turned off,
+automatically detaches from all currently attached targets. This also clears all
+targets added by `autoAttachRelated` from the list of targets to watch for
+creation of related targets. You might want to call this recursively for
+auto-attached targets to attach to all available targets.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach
 */
-func (protocol *TargetProtocol) SetAttachToFrames(
-	params *target.SetAttachToFramesParams,
-) <-chan *target.SetAttachToFramesResult {
-	resultChan := make(chan *target.SetAttachToFramesResult)
-	command := NewCommand(protocol.Socket, "Target.setAttachToFrames", params)
-	result := &target.SetAttachToFramesResult{}
+func (protocol *TargetProtocol) SetAutoAttach(
+	params *target.SetAutoAttachParams,
+) <-chan *target.SetAutoAttachResult {
+	resultChan := make(chan *target.SetAutoAttachResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
+	result := &target.SetAutoAttachResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -297,23 +457,27 @@ func (protocol *TargetProtocol) SetAttachToFrames(
 }
 
 /*
-SetAutoAttach controls whether to automatically attach to new targets which are
-considered to be related to this one. When turned on, attaches to all existing
-related targets as well. When turned off, automatically detaches from all
-currently attached targets.
-
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach EXPERIMENTAL.
+Adds the specified target to the list of targets that will be monitored for any
+related target creation (such as child frames, child workers and new versions of
+service worker) and reported through `attachedToTarget`. The specified target is
+also auto-attached. This cancels the effect of any previous `setAutoAttach` and
+is also cancelled by subsequent `setAutoAttach`. Only available at the Browser
+target. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-autoAttachRelated
 */
-func (protocol *TargetProtocol) SetAutoAttach(
-	params *target.SetAutoAttachParams,
-) <-chan *target.SetAutoAttachResult {
-	resultChan := make(chan *target.SetAutoAttachResult)
-	command := NewCommand(protocol.Socket, "Target.setAutoAttach", params)
-	result := &target.SetAutoAttachResult{}
+func (protocol *TargetProtocol) AutoAttachRelated(
+	params *target.AutoAttachRelatedParams,
+) <-chan *target.AutoAttachRelatedResult {
+	resultChan := make(chan *target.AutoAttachRelatedResult, 1)
+	command := NewCommand(protocol.Socket, "Target.autoAttachRelated", params)
+	result := &target.AutoAttachRelatedResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -324,21 +488,23 @@ func (protocol *TargetProtocol) SetAutoAttach(
 }
 
 /*
-SetDiscoverTargets controls whether to discover available targets and notify via
-`targetCreated`, `targetInfoChanged` and `targetDestroyed` events.
+Controls whether to discover available targets and notify via
+`targetCreated/targetInfoChanged/targetDestroyed` events.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setDiscoverTargets
 */
 func (protocol *TargetProtocol) SetDiscoverTargets(
 	params *target.SetDiscoverTargetsParams,
 ) <-chan *target.SetDiscoverTargetsResult {
-	resultChan := make(chan *target.SetDiscoverTargetsResult)
+	resultChan := make(chan *target.SetDiscoverTargetsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setDiscoverTargets", params)
 	result := &target.SetDiscoverTargetsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -349,22 +515,77 @@ func (protocol *TargetProtocol) SetDiscoverTargets(
 }
 
 /*
-SetRemoteLocations enables target discovery for the specified locations, when
-SetDiscoverTargets was set to `true`.
+Enables target discovery for the specified locations, when `setDiscoverTargets`
+was set to `true`. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setRemoteLocations
-EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) SetRemoteLocations(
 	params *target.SetRemoteLocationsParams,
 ) <-chan *target.SetRemoteLocationsResult {
-	resultChan := make(chan *target.SetRemoteLocationsResult)
+	resultChan := make(chan *target.SetRemoteLocationsResult, 1)
 	command := NewCommand(protocol.Socket, "Target.setRemoteLocations", params)
 	result := &target.SetRemoteLocationsResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Opens a DevTools window for the target. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-openDevTools
+*/
+func (protocol *TargetProtocol) OpenDevTools(
+	params *target.OpenDevToolsParams,
+) <-chan *target.OpenDevToolsResult {
+	resultChan := make(chan *target.OpenDevToolsResult, 1)
+	command := NewCommand(protocol.Socket, "Target.openDevTools", params)
+	result := &target.OpenDevToolsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Is experimental. DEPRECATED.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+*/
+func (protocol *TargetProtocol) SetAttachToFrames(
+	params *target.SetAttachToFramesParams,
+) <-chan *target.SetAttachToFramesResult {
+	resultChan := make(chan *target.SetAttachToFramesResult, 1)
+	command := NewCommand(protocol.Socket, "Target.setAttachToFrames", params)
+	result := &target.SetAttachToFramesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -376,14 +597,15 @@ func (protocol *TargetProtocol) SetRemoteLocations(
 
 /*
 OnAttachedToTarget adds a handler to the Target.attachedToTarget event.
-Target.attachedToTarget fires when attached to target because of auto-attach or
-AttachToTarget command.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToTarget EXPERIMENTAL.
+Issued when attached to target because of auto-attach or `attachToTarget`
+command. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-attachedToTarget
 */
 func (protocol *TargetProtocol) OnAttachedToTarget(
 	callback func(event *target.AttachedToTargetEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.attachedToTarget",
 		func(response *Response) {
@@ -396,20 +618,21 @@ func (protocol *TargetProtocol) OnAttachedToTarget(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
 OnDetachedFromTarget adds a handler to the Target.detachedFromTarget event.
-Target.detachedFromTarget fires when detached from target for any reason
-(including `DetachFromTarget` command). Can be issued multiple times per target
-if multiple sessions have been attached to it.
+
+Issued when detached from target for any reason (including `detachFromTarget`
+command). Can be issued multiple times per target if multiple sessions have been
+attached to it. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-detachedFromTarget
-EXPERIMENTAL.
 */
 func (protocol *TargetProtocol) OnDetachedFromTarget(
 	callback func(event *target.DetachedFromTargetEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.detachedFromTarget",
 		func(response *Response) {
@@ -422,18 +645,21 @@ func (protocol *TargetProtocol) OnDetachedFromTarget(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnReceivedMessageFromTarget adds a handler to the Target.receivedMessageFromTarget
-event. Target.receivedMessageFromTarget fires when a new protocol message
-received from the session (as reported in `attachedToTarget` event).
+OnReceivedMessageFromTarget adds a handler to the
+Target.receivedMessageFromTarget event.
+
+Notifies about a new protocol message received from the session (as reported in
+`attachedToTarget` event).
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-receivedMessageFromTarget
 */
 func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 	callback func(event *target.ReceivedMessageFromTargetEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.receivedMessageFromTarget",
 		func(response *Response) {
@@ -446,17 +672,19 @@ func (protocol *TargetProtocol) OnReceivedMessageFromTarget(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnTargetCreated adds a handler to the Target.Created event. Target.Created fires
-when a possible inspection target is created.
+OnTargetCreated adds a handler to the Target.targetCreated event.
+
+Issued when a possible inspection target is created.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCreated
 */
 func (protocol *TargetProtocol) OnTargetCreated(
 	callback func(event *target.CreatedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.targetCreated",
 		func(response *Response) {
@@ -469,17 +697,19 @@ func (protocol *TargetProtocol) OnTargetCreated(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnTargetDestroyed adds a handler to the Target.Destroyed event. Target.Destroyed
-fires when a target is destroyed.
+OnTargetDestroyed adds a handler to the Target.targetDestroyed event.
+
+Issued when a target is destroyed.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetDestroyed
 */
 func (protocol *TargetProtocol) OnTargetDestroyed(
 	callback func(event *target.DestroyedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.targetDestroyed",
 		func(response *Response) {
@@ -492,18 +722,45 @@ func (protocol *TargetProtocol) OnTargetDestroyed(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnTargetInfoChanged adds a handler to the Target.InfoChanged event. Target.InfoChanged
-fires when some information about a target has changed. This only happens
-between `targetCreated` and `targetDestroyed` events.
+OnTargetCrashed adds a handler to the Target.targetCrashed event.
+
+Issued when a target has crashed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCrashed
+*/
+func (protocol *TargetProtocol) OnTargetCrashed(
+	callback func(event *target.CrashedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"Target.targetCrashed",
+		func(response *Response) {
+			event := &target.CrashedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnTargetInfoChanged adds a handler to the Target.targetInfoChanged event.
+
+Issued when some information about a target has changed. This only happens
+between `targetCreated` and `targetDestroyed`.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetInfoChanged
 */
 func (protocol *TargetProtocol) OnTargetInfoChanged(
 	callback func(event *target.InfoChangedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Target.targetInfoChanged",
 		func(response *Response) {
@@ -516,4 +773,5 @@ func (protocol *TargetProtocol) OnTargetInfoChanged(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/target"
 )
 
@@ -176,10 +177,10 @@ func TestTargetCreateTarget(t *testing.T) {
 
 	params := &target.CreateTargetParams{
 		URL:                     "http://some.url",
-		Width:                   1,
-		Height:                  1,
-		BrowserContextID:        target.BrowserContextID("BrowserContextID"),
-		EnableBeginFrameControl: true,
+		Width:                   optional.Int(1),
+		Height:                  optional.Int(1),
+		BrowserContextID:        optional.Of(target.BrowserContextID("BrowserContextID")),
+		EnableBeginFrameControl: optional.Bool(true),
 	}
 	resultChan := mockSocket.Target().CreateTarget(params)
 	mockResult := &target.CreateTargetResult{
@@ -221,8 +222,8 @@ func TestTargetDetachFromTarget(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &target.DetachFromTargetParams{
-		SessionID: target.SessionID("SessionID"),
-		ID:        target.ID("ID"),
+		SessionID: optional.Of(target.SessionID("SessionID")),
+		ID:        optional.Of(target.ID("ID")),
 	}
 	resultChan := mockSocket.Target().DetachFromTarget(params)
 	mockResult := &target.DetachFromTargetResult{}
@@ -259,7 +260,7 @@ func TestTargetDisposeBrowserContext(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &target.DisposeBrowserContextParams{
-		ID: target.ID("ID"),
+		ID: optional.Of(target.ID("ID")),
 	}
 	resultChan := mockSocket.Target().DisposeBrowserContext(params)
 	mockResult := &target.DisposeBrowserContextResult{
@@ -301,7 +302,7 @@ func TestTargetGetTargetInfo(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &target.GetTargetInfoParams{
-		ID: target.ID("ID"),
+		ID: optional.Of(target.ID("ID")),
 	}
 	resultChan := mockSocket.Target().GetTargetInfo(params)
 	mockResult := &target.GetTargetInfoResult{
@@ -395,8 +396,8 @@ func TestTargetSendMessageToTarget(t *testing.T) {
 
 	params := &target.SendMessageToTargetParams{
 		Message:   "message",
-		SessionID: target.SessionID("SessionID"),
-		ID:        target.ID("ID"),
+		SessionID: optional.Of(target.SessionID("SessionID")),
+		ID:        optional.Of(target.ID("ID")),
 	}
 	resultChan := mockSocket.Target().SendMessageToTarget(params)
 	mockResult := &target.SendMessageToTargetResult{}
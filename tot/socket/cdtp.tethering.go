@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tethering"
@@ -8,30 +11,58 @@ import (
 
 /*
 TetheringProtocol provides a namespace for the Chrome Tethering protocol
-methods. The Tethering protocol defines methods and events for browser port
-binding.
+methods.
+
+The Tethering domain defines methods and events for browser port binding.
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/
 */
 type TetheringProtocol struct {
 	Socket Socketer
+	ctx    context.Context
 }
 
 /*
-Bind requests browser port binding.
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *TetheringProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *TetheringProtocol) WithContext(ctx context.Context) *TetheringProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &TetheringProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Request browser port binding.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-bind
 */
 func (protocol *TetheringProtocol) Bind(
 	params *tethering.BindParams,
 ) <-chan *tethering.BindResult {
-	resultChan := make(chan *tethering.BindResult)
+	resultChan := make(chan *tethering.BindResult, 1)
 	command := NewCommand(protocol.Socket, "Tethering.bind", params)
 	result := &tethering.BindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -42,20 +73,22 @@ func (protocol *TetheringProtocol) Bind(
 }
 
 /*
-Unbind requests browser port unbinding.
+Request browser port unbinding.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#method-unbind
 */
 func (protocol *TetheringProtocol) Unbind(
 	params *tethering.UnbindParams,
 ) <-chan *tethering.UnbindResult {
-	resultChan := make(chan *tethering.UnbindResult)
+	resultChan := make(chan *tethering.UnbindResult, 1)
 	command := NewCommand(protocol.Socket, "Tethering.unbind", params)
 	result := &tethering.UnbindResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -66,14 +99,15 @@ func (protocol *TetheringProtocol) Unbind(
 }
 
 /*
-OnAccepted adds a handler to the Tethering.accepted event. Tethering.accepted
-fires when a port was successfully bound and got a specified connection id.
+OnAccepted adds a handler to the Tethering.accepted event.
+
+Informs that port was successfully bound and got a specified connection id.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tethering/#event-accepted
 */
 func (protocol *TetheringProtocol) OnAccepted(
 	callback func(event *tethering.AcceptedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Tethering.accepted",
 		func(response *Response) {
@@ -86,4 +120,5 @@ func (protocol *TetheringProtocol) OnAccepted(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
@@ -1,6 +1,9 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/mkenney/go-chrome/tot/tracing"
@@ -9,25 +12,51 @@ import (
 /*
 TracingProtocol provides a namespace for the Chrome Tracing protocol methods.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Tracing/ EXPERIMENTAL.
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/
 */
 type TracingProtocol struct {
 	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *TracingProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *TracingProtocol) WithContext(ctx context.Context) *TracingProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &TracingProtocol{Socket: protocol.Socket, ctx: ctx}
 }
 
 /*
-End stops trace events collection.
+Stop trace events collection.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-end
 */
 func (protocol *TracingProtocol) End() <-chan *tracing.EndResult {
-	resultChan := make(chan *tracing.EndResult)
+	resultChan := make(chan *tracing.EndResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.end", nil)
 	result := &tracing.EndResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -38,18 +67,20 @@ func (protocol *TracingProtocol) End() <-chan *tracing.EndResult {
 }
 
 /*
-GetCategories gets supported tracing categories.
+Gets supported tracing categories. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-getCategories
 */
 func (protocol *TracingProtocol) GetCategories() <-chan *tracing.GetCategoriesResult {
-	resultChan := make(chan *tracing.GetCategoriesResult)
+	resultChan := make(chan *tracing.GetCategoriesResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.getCategories", nil)
 	result := &tracing.GetCategoriesResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -57,24 +88,27 @@ func (protocol *TracingProtocol) GetCategories() <-chan *tracing.GetCategoriesRe
 		resultChan <- result
 		close(resultChan)
 	}()
+
 	return resultChan
 }
 
 /*
-RecordClockSyncMarker records a clock sync marker in the trace.
+Record a clock sync marker in the trace. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-recordClockSyncMarker
 */
 func (protocol *TracingProtocol) RecordClockSyncMarker(
 	params *tracing.RecordClockSyncMarkerParams,
 ) <-chan *tracing.RecordClockSyncMarkerResult {
-	resultChan := make(chan *tracing.RecordClockSyncMarkerResult)
+	resultChan := make(chan *tracing.RecordClockSyncMarkerResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.recordClockSyncMarker", params)
 	result := &tracing.RecordClockSyncMarkerResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -85,18 +119,27 @@ func (protocol *TracingProtocol) RecordClockSyncMarker(
 }
 
 /*
-RequestMemoryDump requests a global memory dump.
+Request a global memory dump.
+
+The parameters are optional. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-requestMemoryDump
 */
-func (protocol *TracingProtocol) RequestMemoryDump() <-chan *tracing.RequestMemoryDumpResult {
-	resultChan := make(chan *tracing.RequestMemoryDumpResult)
+func (protocol *TracingProtocol) RequestMemoryDump(
+	params ...*tracing.RequestMemoryDumpParams,
+) <-chan *tracing.RequestMemoryDumpResult {
+	resultChan := make(chan *tracing.RequestMemoryDumpResult, 1)
 	command := NewCommand(protocol.Socket, "Tracing.requestMemoryDump", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Tracing.requestMemoryDump", params[0])
+	}
 	result := &tracing.RequestMemoryDumpResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		} else {
 			result.Err = json.Unmarshal(response.Result, &result)
@@ -104,24 +147,32 @@ func (protocol *TracingProtocol) RequestMemoryDump() <-chan *tracing.RequestMemo
 		resultChan <- result
 		close(resultChan)
 	}()
+
 	return resultChan
 }
 
 /*
-Start starts trace events collection.
+Start trace events collection.
+
+The parameters are optional.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
 */
 func (protocol *TracingProtocol) Start(
-	params *tracing.StartParams,
+	params ...*tracing.StartParams,
 ) <-chan *tracing.StartResult {
-	resultChan := make(chan *tracing.StartResult)
-	command := NewCommand(protocol.Socket, "Tracing.start", params)
+	resultChan := make(chan *tracing.StartResult, 1)
+	command := NewCommand(protocol.Socket, "Tracing.start", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "Tracing.start", params[0])
+	}
 	result := &tracing.StartResult{}
 
 	go func() {
-		response := <-protocol.Socket.SendCommand(command)
-		if nil != response.Error && 0 != response.Error.Code {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
 			result.Err = response.Error
 		}
 		resultChan <- result
@@ -132,14 +183,15 @@ func (protocol *TracingProtocol) Start(
 }
 
 /*
-OnBufferUsage adds a handler to the Tracing.bufferUsage event. Tracing.bufferUsage
-fires when a buffer is used.
+OnBufferUsage adds a handler to the Tracing.bufferUsage event.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-bufferUsage
 */
 func (protocol *TracingProtocol) OnBufferUsage(
 	callback func(event *tracing.BufferUsageEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Tracing.bufferUsage",
 		func(response *Response) {
@@ -152,19 +204,21 @@ func (protocol *TracingProtocol) OnBufferUsage(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnDataCollected adds a handler to the Tracing.dataCollected event. Tracing.dataCollected
-fires when tracing is stopped, collected events will be sent as a sequence of
-dataCollected events followed by tracingComplete event. Contains an bucket of
-collected trace events.
+OnDataCollected adds a handler to the Tracing.dataCollected event.
+
+Contains a bucket of collected trace events. When tracing is stopped collected
+events will be sent as a sequence of dataCollected events followed by
+tracingComplete event. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-dataCollected
 */
 func (protocol *TracingProtocol) OnDataCollected(
 	callback func(event *tracing.DataCollectedEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Tracing.dataCollected",
 		func(response *Response) {
@@ -177,18 +231,20 @@ func (protocol *TracingProtocol) OnDataCollected(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
 
 /*
-OnTracingComplete adds a handler to the Tracing.Complete event. Tracing.Complete
-fires when tracing is stopped and there is no trace buffers pending flush, all
-data were delivered via DataCollected events.
+OnTracingComplete adds a handler to the Tracing.tracingComplete event.
+
+Signals that tracing is stopped and there is no trace buffers pending flush, all
+data were delivered via dataCollected events.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-tracingComplete
 */
 func (protocol *TracingProtocol) OnTracingComplete(
 	callback func(event *tracing.CompleteEvent),
-) {
+) *Listener {
 	handler := NewEventHandler(
 		"Tracing.tracingComplete",
 		func(response *Response) {
@@ -201,4 +257,5 @@ func (protocol *TracingProtocol) OnTracingComplete(
 		},
 	)
 	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
 }
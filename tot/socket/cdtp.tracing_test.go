@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/io"
 	"github.com/mkenney/go-chrome/tot/tracing"
 )
@@ -166,9 +167,9 @@ func TestTracingStart(t *testing.T) {
 	defer mockSocket.Stop()
 
 	params := &tracing.StartParams{
-		Categories:                   "Categories",
-		Options:                      "Options",
-		BufferUsageReportingInterval: 1,
+		Categories:                   optional.String("Categories"),
+		Options:                      optional.String("Options"),
+		BufferUsageReportingInterval: optional.Float64(1),
 		TransferMode:                 tracing.TransferMode.ReportEvents,
 		TraceConfig: &tracing.TraceConfig{
 			RecordMode:           tracing.RecordMode.RecordUntilFull,
@@ -269,7 +270,7 @@ func TestTracingOnDataCollected(t *testing.T) {
 		resultChan <- eventData
 	})
 	mockResult := &tracing.DataCollectedEvent{
-		Value: []map[string]string{{"key": "value"}},
+		Value: []map[string]interface{}{{"key": "value"}},
 	}
 	mockResultBytes, _ := json.Marshal(mockResult)
 	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
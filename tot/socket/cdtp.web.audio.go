@@ -0,0 +1,455 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/web/audio"
+)
+
+/*
+WebAudioProtocol provides a namespace for the Chrome WebAudio protocol methods.
+
+This domain allows inspection of Web Audio API.
+https://webaudio.github.io/web-audio-api/ EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/
+*/
+type WebAudioProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *WebAudioProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *WebAudioProtocol) WithContext(ctx context.Context) *WebAudioProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &WebAudioProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enables the WebAudio domain and starts sending context lifetime events.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-enable
+*/
+func (protocol *WebAudioProtocol) Enable() <-chan *audio.EnableResult {
+	resultChan := make(chan *audio.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "WebAudio.enable", nil)
+	result := &audio.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disables the WebAudio domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-disable
+*/
+func (protocol *WebAudioProtocol) Disable() <-chan *audio.DisableResult {
+	resultChan := make(chan *audio.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "WebAudio.disable", nil)
+	result := &audio.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Fetch the realtime data from the registered contexts.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-getRealtimeData
+*/
+func (protocol *WebAudioProtocol) GetRealtimeData(
+	params *audio.GetRealtimeDataParams,
+) <-chan *audio.GetRealtimeDataResult {
+	resultChan := make(chan *audio.GetRealtimeDataResult, 1)
+	command := NewCommand(protocol.Socket, "WebAudio.getRealtimeData", params)
+	result := &audio.GetRealtimeDataResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnContextCreated adds a handler to the WebAudio.contextCreated event.
+
+Notifies that a new BaseAudioContext has been created.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextCreated
+*/
+func (protocol *WebAudioProtocol) OnContextCreated(
+	callback func(event *audio.ContextCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.contextCreated",
+		func(response *Response) {
+			event := &audio.ContextCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnContextWillBeDestroyed adds a handler to the WebAudio.contextWillBeDestroyed
+event.
+
+Notifies that an existing BaseAudioContext will be destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextWillBeDestroyed
+*/
+func (protocol *WebAudioProtocol) OnContextWillBeDestroyed(
+	callback func(event *audio.ContextWillBeDestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.contextWillBeDestroyed",
+		func(response *Response) {
+			event := &audio.ContextWillBeDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnContextChanged adds a handler to the WebAudio.contextChanged event.
+
+Notifies that existing BaseAudioContext has changed some properties (id stays
+the same)..
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextChanged
+*/
+func (protocol *WebAudioProtocol) OnContextChanged(
+	callback func(event *audio.ContextChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.contextChanged",
+		func(response *Response) {
+			event := &audio.ContextChangedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioListenerCreated adds a handler to the WebAudio.audioListenerCreated
+event.
+
+Notifies that the construction of an AudioListener has finished.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioListenerCreated
+*/
+func (protocol *WebAudioProtocol) OnAudioListenerCreated(
+	callback func(event *audio.ListenerCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioListenerCreated",
+		func(response *Response) {
+			event := &audio.ListenerCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioListenerWillBeDestroyed adds a handler to the
+WebAudio.audioListenerWillBeDestroyed event.
+
+Notifies that a new AudioListener has been created.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioListenerWillBeDestroyed
+*/
+func (protocol *WebAudioProtocol) OnAudioListenerWillBeDestroyed(
+	callback func(event *audio.ListenerWillBeDestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioListenerWillBeDestroyed",
+		func(response *Response) {
+			event := &audio.ListenerWillBeDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioNodeCreated adds a handler to the WebAudio.audioNodeCreated event.
+
+Notifies that a new AudioNode has been created.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioNodeCreated
+*/
+func (protocol *WebAudioProtocol) OnAudioNodeCreated(
+	callback func(event *audio.NodeCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioNodeCreated",
+		func(response *Response) {
+			event := &audio.NodeCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioNodeWillBeDestroyed adds a handler to the
+WebAudio.audioNodeWillBeDestroyed event.
+
+Notifies that an existing AudioNode has been destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioNodeWillBeDestroyed
+*/
+func (protocol *WebAudioProtocol) OnAudioNodeWillBeDestroyed(
+	callback func(event *audio.NodeWillBeDestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioNodeWillBeDestroyed",
+		func(response *Response) {
+			event := &audio.NodeWillBeDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioParamCreated adds a handler to the WebAudio.audioParamCreated event.
+
+Notifies that a new AudioParam has been created.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioParamCreated
+*/
+func (protocol *WebAudioProtocol) OnAudioParamCreated(
+	callback func(event *audio.ParamCreatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioParamCreated",
+		func(response *Response) {
+			event := &audio.ParamCreatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnAudioParamWillBeDestroyed adds a handler to the
+WebAudio.audioParamWillBeDestroyed event.
+
+Notifies that an existing AudioParam has been destroyed.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioParamWillBeDestroyed
+*/
+func (protocol *WebAudioProtocol) OnAudioParamWillBeDestroyed(
+	callback func(event *audio.ParamWillBeDestroyedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.audioParamWillBeDestroyed",
+		func(response *Response) {
+			event := &audio.ParamWillBeDestroyedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNodesConnected adds a handler to the WebAudio.nodesConnected event.
+
+Notifies that two AudioNodes are connected.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodesConnected
+*/
+func (protocol *WebAudioProtocol) OnNodesConnected(
+	callback func(event *audio.NodesConnectedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.nodesConnected",
+		func(response *Response) {
+			event := &audio.NodesConnectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNodesDisconnected adds a handler to the WebAudio.nodesDisconnected event.
+
+Notifies that AudioNodes are disconnected. The destination can be null, and it
+means all the outgoing connections from the source are disconnected.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodesDisconnected
+*/
+func (protocol *WebAudioProtocol) OnNodesDisconnected(
+	callback func(event *audio.NodesDisconnectedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.nodesDisconnected",
+		func(response *Response) {
+			event := &audio.NodesDisconnectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNodeParamConnected adds a handler to the WebAudio.nodeParamConnected event.
+
+Notifies that an AudioNode is connected to an AudioParam.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodeParamConnected
+*/
+func (protocol *WebAudioProtocol) OnNodeParamConnected(
+	callback func(event *audio.NodeParamConnectedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.nodeParamConnected",
+		func(response *Response) {
+			event := &audio.NodeParamConnectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnNodeParamDisconnected adds a handler to the WebAudio.nodeParamDisconnected
+event.
+
+Notifies that an AudioNode is disconnected to an AudioParam.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodeParamDisconnected
+*/
+func (protocol *WebAudioProtocol) OnNodeParamDisconnected(
+	callback func(event *audio.NodeParamDisconnectedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAudio.nodeParamDisconnected",
+		func(response *Response) {
+			event := &audio.NodeParamDisconnectedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -0,0 +1,501 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package socket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/tot/web/authn"
+)
+
+/*
+WebAuthnProtocol provides a namespace for the Chrome WebAuthn protocol methods.
+
+This domain allows configuring virtual authenticators to test the WebAuthn API.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/
+*/
+type WebAuthnProtocol struct {
+	Socket Socketer
+	ctx    context.Context
+}
+
+/*
+Context returns the context that commands sent through this protocol are bound
+to. The default is context.Background().
+*/
+func (protocol *WebAuthnProtocol) Context() context.Context {
+	if nil == protocol.ctx {
+		return context.Background()
+	}
+	return protocol.ctx
+}
+
+/*
+WithContext returns a copy of the protocol with its commands bound to ctx. When
+ctx is cancelled or its deadline passes, pending commands are abandoned and
+their results contain an error.
+*/
+func (protocol *WebAuthnProtocol) WithContext(ctx context.Context) *WebAuthnProtocol {
+	if nil == ctx {
+		panic("nil context")
+	}
+	return &WebAuthnProtocol{Socket: protocol.Socket, ctx: ctx}
+}
+
+/*
+Enable the WebAuthn domain and start intercepting credential storage and
+retrieval with a virtual authenticator.
+
+The parameters are optional.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-enable
+*/
+func (protocol *WebAuthnProtocol) Enable(
+	params ...*authn.EnableParams,
+) <-chan *authn.EnableResult {
+	resultChan := make(chan *authn.EnableResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.enable", nil)
+	if 0 != len(params) {
+		command = NewCommand(protocol.Socket, "WebAuthn.enable", params[0])
+	}
+	result := &authn.EnableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Disable the WebAuthn domain.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-disable
+*/
+func (protocol *WebAuthnProtocol) Disable() <-chan *authn.DisableResult {
+	resultChan := make(chan *authn.DisableResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.disable", nil)
+	result := &authn.DisableResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Creates and adds a virtual authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addVirtualAuthenticator
+*/
+func (protocol *WebAuthnProtocol) AddVirtualAuthenticator(
+	params *authn.AddVirtualAuthenticatorParams,
+) <-chan *authn.AddVirtualAuthenticatorResult {
+	resultChan := make(chan *authn.AddVirtualAuthenticatorResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.addVirtualAuthenticator", params)
+	result := &authn.AddVirtualAuthenticatorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not
+present.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setResponseOverrideBits
+*/
+func (protocol *WebAuthnProtocol) SetResponseOverrideBits(
+	params *authn.SetResponseOverrideBitsParams,
+) <-chan *authn.SetResponseOverrideBitsResult {
+	resultChan := make(chan *authn.SetResponseOverrideBitsResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.setResponseOverrideBits", params)
+	result := &authn.SetResponseOverrideBitsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes the given authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeVirtualAuthenticator
+*/
+func (protocol *WebAuthnProtocol) RemoveVirtualAuthenticator(
+	params *authn.RemoveVirtualAuthenticatorParams,
+) <-chan *authn.RemoveVirtualAuthenticatorResult {
+	resultChan := make(chan *authn.RemoveVirtualAuthenticatorResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.removeVirtualAuthenticator", params)
+	result := &authn.RemoveVirtualAuthenticatorResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Adds the credential to the specified authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addCredential
+*/
+func (protocol *WebAuthnProtocol) AddCredential(
+	params *authn.AddCredentialParams,
+) <-chan *authn.AddCredentialResult {
+	resultChan := make(chan *authn.AddCredentialResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.addCredential", params)
+	result := &authn.AddCredentialResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns a single credential stored in the given virtual authenticator that
+matches the credential ID.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredential
+*/
+func (protocol *WebAuthnProtocol) GetCredential(
+	params *authn.GetCredentialParams,
+) <-chan *authn.GetCredentialResult {
+	resultChan := make(chan *authn.GetCredentialResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.getCredential", params)
+	result := &authn.GetCredentialResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Returns all the credentials stored in the given virtual authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredentials
+*/
+func (protocol *WebAuthnProtocol) GetCredentials(
+	params *authn.GetCredentialsParams,
+) <-chan *authn.GetCredentialsResult {
+	resultChan := make(chan *authn.GetCredentialsResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.getCredentials", params)
+	result := &authn.GetCredentialsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		} else {
+			result.Err = json.Unmarshal(response.Result, &result)
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Removes a credential from the authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeCredential
+*/
+func (protocol *WebAuthnProtocol) RemoveCredential(
+	params *authn.RemoveCredentialParams,
+) <-chan *authn.RemoveCredentialResult {
+	resultChan := make(chan *authn.RemoveCredentialResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.removeCredential", params)
+	result := &authn.RemoveCredentialResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Clears all the credentials from the specified device.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-clearCredentials
+*/
+func (protocol *WebAuthnProtocol) ClearCredentials(
+	params *authn.ClearCredentialsParams,
+) <-chan *authn.ClearCredentialsResult {
+	resultChan := make(chan *authn.ClearCredentialsResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.clearCredentials", params)
+	result := &authn.ClearCredentialsResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets whether User Verification succeeds or fails for an authenticator. The
+default is true.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setUserVerified
+*/
+func (protocol *WebAuthnProtocol) SetUserVerified(
+	params *authn.SetUserVerifiedParams,
+) <-chan *authn.SetUserVerifiedResult {
+	resultChan := make(chan *authn.SetUserVerifiedResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.setUserVerified", params)
+	result := &authn.SetUserVerifiedResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Sets whether tests of user presence will succeed immediately (if true) or fail
+to resolve (if false) for an authenticator. The default is true.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setAutomaticPresenceSimulation
+*/
+func (protocol *WebAuthnProtocol) SetAutomaticPresenceSimulation(
+	params *authn.SetAutomaticPresenceSimulationParams,
+) <-chan *authn.SetAutomaticPresenceSimulationResult {
+	resultChan := make(chan *authn.SetAutomaticPresenceSimulationResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.setAutomaticPresenceSimulation", params)
+	result := &authn.SetAutomaticPresenceSimulationResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+Allows setting credential properties.
+https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setCredentialProperties
+*/
+func (protocol *WebAuthnProtocol) SetCredentialProperties(
+	params *authn.SetCredentialPropertiesParams,
+) <-chan *authn.SetCredentialPropertiesResult {
+	resultChan := make(chan *authn.SetCredentialPropertiesResult, 1)
+	command := NewCommand(protocol.Socket, "WebAuthn.setCredentialProperties", params)
+	result := &authn.SetCredentialPropertiesResult{}
+
+	go func() {
+		response, err := protocol.Socket.SendCommandContext(protocol.Context(), command)
+		if nil != err {
+			result.Err = err
+		} else if nil != response.Error && 0 != response.Error.Code {
+			result.Err = response.Error
+		}
+		resultChan <- result
+		close(resultChan)
+	}()
+
+	return resultChan
+}
+
+/*
+OnCredentialAdded adds a handler to the WebAuthn.credentialAdded event.
+
+Triggered when a credential is added to an authenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialAdded
+*/
+func (protocol *WebAuthnProtocol) OnCredentialAdded(
+	callback func(event *authn.CredentialAddedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAuthn.credentialAdded",
+		func(response *Response) {
+			event := &authn.CredentialAddedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCredentialDeleted adds a handler to the WebAuthn.credentialDeleted event.
+
+Triggered when a credential is deleted, e.g. through
+PublicKeyCredential.signalUnknownCredential().
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialDeleted
+*/
+func (protocol *WebAuthnProtocol) OnCredentialDeleted(
+	callback func(event *authn.CredentialDeletedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAuthn.credentialDeleted",
+		func(response *Response) {
+			event := &authn.CredentialDeletedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCredentialUpdated adds a handler to the WebAuthn.credentialUpdated event.
+
+Triggered when a credential is updated, e.g. through
+PublicKeyCredential.signalCurrentUserDetails().
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialUpdated
+*/
+func (protocol *WebAuthnProtocol) OnCredentialUpdated(
+	callback func(event *authn.CredentialUpdatedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAuthn.credentialUpdated",
+		func(response *Response) {
+			event := &authn.CredentialUpdatedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
+
+/*
+OnCredentialAsserted adds a handler to the WebAuthn.credentialAsserted event.
+
+Triggered when a credential is used in a webauthn assertion.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialAsserted
+*/
+func (protocol *WebAuthnProtocol) OnCredentialAsserted(
+	callback func(event *authn.CredentialAssertedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		"WebAuthn.credentialAsserted",
+		func(response *Response) {
+			event := &authn.CredentialAssertedEvent{}
+			json.Unmarshal([]byte(response.Params), event)
+			if nil != response.Error && 0 != response.Error.Code {
+				event.Err = response.Error
+			}
+			callback(event)
+		},
+	)
+	protocol.Socket.AddEventHandler(handler)
+	return NewListener(protocol.Socket, handler)
+}
@@ -4,6 +4,9 @@ package socket
 CommandMapper defines a management interface for the stack of pending commands.
 */
 type CommandMapper interface {
+	// Clear removes every command from the stack and returns them.
+	Clear() []Commander
+
 	// Delete removes a command from the stack.
 	Delete(commandID int)
 
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 /*
@@ -18,17 +20,41 @@ type Protocoller interface {
 	// Audits returns the AuditsProtocol instance.
 	Audits() *AuditsProtocol
 
+	// Autofill returns the AutofillProtocol instance.
+	Autofill() *AutofillProtocol
+
+	// BackgroundService returns the BackgroundServiceProtocol instance.
+	BackgroundService() *BackgroundServiceProtocol
+
+	// BluetoothEmulation returns the BluetoothEmulationProtocol instance.
+	BluetoothEmulation() *BluetoothEmulationProtocol
+
 	// Browser returns the BrowserProtocol instance.
 	Browser() *BrowserProtocol
 
+	// CSS returns the CSSProtocol instance.
+	CSS() *CSSProtocol
+
 	// CacheStorage returns the CacheStorageProtocol instance.
 	CacheStorage() *CacheStorageProtocol
 
+	// Cast returns the CastProtocol instance.
+	Cast() *CastProtocol
+
 	// Console returns the ConsoleProtocol instance.
 	Console() *ConsoleProtocol
 
-	// CSS returns the CSSProtocol instance.
-	CSS() *CSSProtocol
+	// DOM returns the DOMProtocol instance.
+	DOM() *DOMProtocol
+
+	// DOMDebugger returns the DOMDebuggerProtocol instance.
+	DOMDebugger() *DOMDebuggerProtocol
+
+	// DOMSnapshot returns the DOMSnapshotProtocol instance.
+	DOMSnapshot() *DOMSnapshotProtocol
+
+	// DOMStorage returns the DOMStorageProtocol instance.
+	DOMStorage() *DOMStorageProtocol
 
 	// Database returns the DatabaseProtocol instance.
 	Database() *DatabaseProtocol
@@ -36,23 +62,29 @@ type Protocoller interface {
 	// Debugger returns the DebuggerProtocol instance.
 	Debugger() *DebuggerProtocol
 
+	// DeviceAccess returns the DeviceAccessProtocol instance.
+	DeviceAccess() *DeviceAccessProtocol
+
 	// DeviceOrientation returns the DeviceOrientationProtocol instance.
 	DeviceOrientation() *DeviceOrientationProtocol
 
-	// DOMDebugger returns the DOMDebuggerProtocol instance.
-	DOMDebugger() *DOMDebuggerProtocol
+	// Emulation returns the EmulationProtocol instance.
+	Emulation() *EmulationProtocol
 
-	// DOMSnapshot returns the DOMSnapshotProtocol instance.
-	DOMSnapshot() *DOMSnapshotProtocol
+	// EventBreakpoints returns the EventBreakpointsProtocol instance.
+	EventBreakpoints() *EventBreakpointsProtocol
 
-	// DOMStorage returns the DOMStorageProtocol instance.
-	DOMStorage() *DOMStorageProtocol
+	// Extensions returns the ExtensionsProtocol instance.
+	Extensions() *ExtensionsProtocol
 
-	// DOM returns the DOMProtocol instance.
-	DOM() *DOMProtocol
+	// FedCm returns the FedCmProtocol instance.
+	FedCm() *FedCmProtocol
 
-	// Emulation returns the EmulationProtocol instance.
-	Emulation() *EmulationProtocol
+	// Fetch returns the FetchProtocol instance.
+	Fetch() *FetchProtocol
+
+	// FileSystem returns the FileSystemProtocol instance.
+	FileSystem() *FileSystemProtocol
 
 	// HeadlessExperimental returns the HeadlessExperimentalProtocol instance.
 	HeadlessExperimental() *HeadlessExperimentalProtocol
@@ -60,14 +92,17 @@ type Protocoller interface {
 	// HeapProfiler returns the HeapProfilerProtocol instance.
 	HeapProfiler() *HeapProfilerProtocol
 
+	// IO returns the IOProtocol instance.
+	IO() *IOProtocol
+
 	// IndexedDB returns the IndexedDBProtocol instance.
 	IndexedDB() *IndexedDBProtocol
 
 	// Input returns the InputProtocol instance.
 	Input() *InputProtocol
 
-	// IO returns the IOProtocol instance.
-	IO() *IOProtocol
+	// Inspector returns the InspectorProtocol instance.
+	Inspector() *InspectorProtocol
 
 	// LayerTree returns the LayerTreeProtocol instance.
 	LayerTree() *LayerTreeProtocol
@@ -75,6 +110,9 @@ type Protocoller interface {
 	// Log returns the LogProtocol instance.
 	Log() *LogProtocol
 
+	// Media returns the MediaProtocol instance.
+	Media() *MediaProtocol
+
 	// Memory returns the MemoryProtocol instance.
 	Memory() *MemoryProtocol
 
@@ -84,12 +122,21 @@ type Protocoller interface {
 	// Overlay returns the OverlayProtocol instance.
 	Overlay() *OverlayProtocol
 
+	// PWA returns the PWAProtocol instance.
+	PWA() *PWAProtocol
+
 	// Page returns the PageProtocol instance.
 	Page() *PageProtocol
 
 	// Performance returns the PerformanceProtocol instance.
 	Performance() *PerformanceProtocol
 
+	// PerformanceTimeline returns the PerformanceTimelineProtocol instance.
+	PerformanceTimeline() *PerformanceTimelineProtocol
+
+	// Preload returns the PreloadProtocol instance.
+	Preload() *PreloadProtocol
+
 	// Profiler returns the ProfilerProtocol instance.
 	Profiler() *ProfilerProtocol
 
@@ -119,4 +166,10 @@ type Protocoller interface {
 
 	// Tracing returns the TracingProtocol instance.
 	Tracing() *TracingProtocol
+
+	// WebAudio returns the WebAudioProtocol instance.
+	WebAudio() *WebAudioProtocol
+
+	// WebAuthn returns the WebAuthnProtocol instance.
+	WebAuthn() *WebAuthnProtocol
 }
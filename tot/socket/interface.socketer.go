@@ -1,6 +1,8 @@
 package socket
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 )
 
@@ -12,6 +14,18 @@ type Socketer interface {
 	// event.
 	AddEventHandler(handler EventHandler)
 
+	// Capabilities returns the protocol capabilities of the connected browser,
+	// or nil if they have not been detected or set.
+	Capabilities() *Capabilities
+
+	// Call sends a protocol command by name and unmarshals its result into
+	// result. It supports protocol methods that have no typed wrapper.
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+
+	// Crashed returns whether the target crashed. Commands pending when it
+	// crashed fail with codes.SocketTargetCrashed.
+	Crashed() bool
+
 	// CurCommandID returns the latest command ID.
 	CurCommandID() int
 
@@ -29,10 +43,38 @@ type Socketer interface {
 	// SendCommand delivers a command payload to the websocket connection.
 	SendCommand(command Commander) chan *Response
 
+	// SendCommandContext delivers a command payload to the websocket
+	// connection and waits for the response or for ctx to end, whichever
+	// happens first.
+	SendCommandContext(ctx context.Context, command Commander) (*Response, error)
+
+	// SetDispatchPolicy sets the policy used to deliver events to event
+	// handlers.
+	SetDispatchPolicy(policy *DispatchPolicy)
+
+	// SetReconnectPolicy sets the policy used to re-establish a dropped
+	// websocket connection. A nil policy disables reconnection.
+	SetReconnectPolicy(policy *ReconnectPolicy)
+
+	// State returns the current connection state.
+	State() ConnectionState
+
 	// Stop signals the socket read loop to stop listening for data and close
 	// the websocket connection.
 	Stop()
 
+	// Subscribe returns a channel that receives the raw parameters of each
+	// event named by method, and a function that cancels the subscription.
+	Subscribe(method string) (<-chan json.RawMessage, func())
+
+	// Supports returns whether the connected browser supports a domain,
+	// command or event, for example "Page.printToPDF".
+	Supports(name string) bool
+
 	// URL returns the URL of the websocket connection.
 	URL() *url.URL
+
+	// WaitFor returns a Waiter for the first event named by eventName whose
+	// raw parameters match predicate.
+	WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *Waiter[json.RawMessage]
 }
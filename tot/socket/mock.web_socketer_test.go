@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
 	errs "github.com/bdlm/errors"
@@ -19,25 +20,54 @@ func NewMockWebsocket(socketURL *url.URL) (WebSocketer, error) {
 	log.Infof("Mock websocket connection to %s established", socketURL.String())
 	return &MockChromeWebSocket{
 		mockResponses: make([]*Response, 0),
+		mux:           &sync.Mutex{},
 	}, nil
 }
 
 type MockChromeWebSocket struct {
+	mockErr       error
 	mockResponses []*Response
+	mux           *sync.Mutex
+	payloads      []interface{}
 	sleep         time.Duration
 }
 
 func (socket *MockChromeWebSocket) Close() error {
+	socket.mux.Lock()
 	socket.mockResponses = []*Response{{}, {}, {}, {}, {}}
+	socket.mux.Unlock()
 	return nil
 }
 
+/*
+AddMockError sets an error to be returned by the next ReadJSON call to
+replicate a dropped connection.
+*/
+func (socket *MockChromeWebSocket) AddMockError(err error) {
+	socket.mux.Lock()
+	socket.mockErr = err
+	socket.mux.Unlock()
+}
+
 /*
 This method populates a queue of mock data that will be delivered to the
 websocket API for testing.
 */
 func (socket *MockChromeWebSocket) AddMockData(response *Response) {
+	socket.mux.Lock()
 	socket.mockResponses = append(socket.mockResponses, response)
+	socket.mux.Unlock()
+}
+
+/*
+Payloads returns the data written to the websocket.
+*/
+func (socket *MockChromeWebSocket) Payloads() []interface{} {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	payloads := make([]interface{}, len(socket.payloads))
+	copy(payloads, socket.payloads)
+	return payloads
 }
 
 /*
@@ -51,15 +81,29 @@ ReadJSON is a WebSocketer implementation.
 func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 	var data interface{}
 
-	if socket.sleep > 0 {
-		time.Sleep(socket.sleep)
-		socket.sleep = 0
+	socket.mux.Lock()
+	sleep := socket.sleep
+	socket.sleep = 0
+	socket.mux.Unlock()
+	if sleep > 0 {
+		time.Sleep(sleep)
 	}
 
-	for range time.After(time.Millisecond * 10) {
+	// Poll the mock response stack until data is available.
+	ticker := time.NewTicker(time.Millisecond * 10)
+	defer ticker.Stop()
+	for range ticker.C {
+		socket.mux.Lock()
+		if nil != socket.mockErr {
+			err := socket.mockErr
+			socket.mockErr = nil
+			socket.mux.Unlock()
+			return err
+		}
 		if len(socket.mockResponses) > 0 {
 			data = socket.mockResponses[0]
 			socket.mockResponses = socket.mockResponses[1:]
+			socket.mux.Unlock()
 
 			jsonBytes, _ := json.Marshal(data)
 			log.Debugf("Mock ReadJSON(): returning mock data %s", jsonBytes)
@@ -69,6 +113,7 @@ func (socket *MockChromeWebSocket) ReadJSON(v interface{}) error {
 			}
 			return nil
 		}
+		socket.mux.Unlock()
 	}
 	return nil
 }
@@ -78,7 +123,9 @@ Sleep sets the sleep duration for the next ReadJSON call to replicate
 timeouts and delays.
 */
 func (socket *MockChromeWebSocket) Sleep(duration time.Duration) {
+	socket.mux.Lock()
 	socket.sleep = duration
+	socket.mux.Unlock()
 }
 
 /*
@@ -87,5 +134,8 @@ WriteJSON marshalls the provided data as JSON and writes it to the websocket.
 WriteJSON is a WebSocketer implementation.
 */
 func (socket *MockChromeWebSocket) WriteJSON(v interface{}) error {
+	socket.mux.Lock()
+	socket.payloads = append(socket.payloads, v)
+	socket.mux.Unlock()
 	return nil
 }
@@ -0,0 +1,254 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Capabilities describes the protocol domains, commands and events supported by
+a connected browser. It is read from the browser's protocol description, served
+at /json/protocol, or from the Schema.getDomains command, which only lists
+domains.
+
+A nil *Capabilities supports everything, so checks are skipped until the
+capabilities of a socket have been detected or set.
+*/
+type Capabilities struct {
+	// Browser is the product name and version of the browser the capabilities
+	// were read from, for example "HeadlessChrome/120.0.6099.109".
+	Browser string
+
+	// domains maps domain names to the set of their command and event names.
+	// The set is nil if only the domain is known.
+	domains map[string]map[string]bool
+}
+
+/*
+protocolDescription is the format of the /json/protocol document.
+*/
+type protocolDescription struct {
+	Domains []struct {
+		Domain   string `json:"domain"`
+		Commands []struct {
+			Name string `json:"name"`
+		} `json:"commands"`
+		Events []struct {
+			Name string `json:"name"`
+		} `json:"events"`
+	} `json:"domains"`
+}
+
+/*
+ParseCapabilities returns the capabilities described by a protocol description
+document in the /json/protocol format.
+*/
+func ParseCapabilities(browser string, description []byte) (*Capabilities, error) {
+	protocol := &protocolDescription{}
+	if err := json.Unmarshal(description, protocol); nil != err {
+		return nil, errs.Wrap(err, codes.SocketCapabilitiesFailed, "could not parse the protocol description")
+	}
+	if 0 == len(protocol.Domains) {
+		return nil, errs.New(codes.SocketCapabilitiesFailed, "the protocol description contains no domains")
+	}
+
+	caps := &Capabilities{
+		Browser: browser,
+		domains: make(map[string]map[string]bool, len(protocol.Domains)),
+	}
+	for _, domain := range protocol.Domains {
+		methods := make(map[string]bool, len(domain.Commands)+len(domain.Events))
+		for _, command := range domain.Commands {
+			methods[command.Name] = true
+		}
+		for _, event := range domain.Events {
+			methods[event.Name] = true
+		}
+		caps.domains[domain.Domain] = methods
+	}
+	return caps, nil
+}
+
+/*
+NewDomainCapabilities returns capabilities that only list domains, as reported
+by the Schema.getDomains command. Every command and event of a listed domain is
+assumed to be supported.
+*/
+func NewDomainCapabilities(browser string, domains []string) *Capabilities {
+	caps := &Capabilities{
+		Browser: browser,
+		domains: make(map[string]map[string]bool, len(domains)),
+	}
+	for _, domain := range domains {
+		caps.domains[domain] = nil
+	}
+	return caps
+}
+
+/*
+Check returns a codes.SocketMethodUnsupported error naming the method and the
+browser if the method is not supported.
+*/
+func (caps *Capabilities) Check(method string) error {
+	if caps.Supports(method) {
+		return nil
+	}
+	browser := caps.Browser
+	if "" == browser {
+		browser = "unknown version"
+	}
+	return errs.New(codes.SocketMethodUnsupported, fmt.Sprintf("'%s' is not supported by the connected browser (%s)", method, browser))
+}
+
+/*
+Domains returns the names of the supported domains, sorted alphabetically.
+*/
+func (caps *Capabilities) Domains() []string {
+	if nil == caps {
+		return nil
+	}
+	domains := make([]string, 0, len(caps.domains))
+	for domain := range caps.domains {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains
+}
+
+/*
+Supports returns whether a domain, for example "Page", or a command or event,
+for example "Page.printToPDF", is supported. A nil *Capabilities supports
+everything.
+*/
+func (caps *Capabilities) Supports(name string) bool {
+	if nil == caps {
+		return true
+	}
+	parts := strings.SplitN(name, ".", 2)
+	methods, ok := caps.domains[parts[0]]
+	if !ok {
+		return false
+	}
+	if 1 == len(parts) || nil == methods {
+		return true
+	}
+	return methods[parts[1]]
+}
+
+/*
+Capabilities returns the capabilities the socket checks commands and events
+against, or nil if they have not been detected or set. Flattened sessions use
+the capabilities of their browser connection unless their own are set.
+
+Capabilities is a Socketer implementation.
+*/
+func (socket *Socket) Capabilities() *Capabilities {
+	socket.mux.Lock()
+	caps := socket.capabilities
+	socket.mux.Unlock()
+	if nil == caps && nil != socket.parent {
+		return socket.parent.Capabilities()
+	}
+	return caps
+}
+
+/*
+DetectCapabilities reads the protocol description of the connected browser,
+caches it and returns it. Commands and events the browser does not support then
+fail fast with a codes.SocketMethodUnsupported error instead of a protocol
+error.
+
+The full description is fetched from the /json/protocol endpoint of the
+websocket's host. If that fails, for example over a remote debugging pipe, the
+domains reported by Schema.getDomains are used instead.
+*/
+func (socket *Socket) DetectCapabilities(ctx context.Context) (*Capabilities, error) {
+	version := struct {
+		Product string `json:"product"`
+	}{}
+	socket.Call(ctx, "Browser.getVersion", nil, &version)
+
+	caps, err := socket.fetchProtocol(ctx, version.Product)
+	if nil != err {
+		result := struct {
+			Domains []struct {
+				Name string `json:"name"`
+			} `json:"domains"`
+		}{}
+		if err := socket.Call(ctx, "Schema.getDomains", nil, &result); nil != err {
+			return nil, errs.Wrap(err, codes.SocketCapabilitiesFailed, "could not read the browser protocol description")
+		}
+		domains := make([]string, 0, len(result.Domains))
+		for _, domain := range result.Domains {
+			domains = append(domains, domain.Name)
+		}
+		caps = NewDomainCapabilities(version.Product, domains)
+	}
+
+	socket.SetCapabilities(caps)
+	return caps, nil
+}
+
+/*
+fetchProtocol fetches and parses the /json/protocol document from the HTTP
+endpoint of the websocket's host.
+*/
+func (socket *Socket) fetchProtocol(ctx context.Context, browser string) (*Capabilities, error) {
+	scheme := "http"
+	switch socket.url.Scheme {
+	case "ws":
+	case "wss":
+		scheme = "https"
+	default:
+		return nil, errs.New(codes.SocketCapabilitiesFailed, fmt.Sprintf("no protocol endpoint for '%s' connections", socket.url.Scheme))
+	}
+	uri := &url.URL{Scheme: scheme, Host: socket.url.Host, Path: "/json/protocol"}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri.String(), nil)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.SocketCapabilitiesFailed, "could not create the protocol request")
+	}
+	response, err := http.DefaultClient.Do(request)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.SocketCapabilitiesFailed, fmt.Sprintf("get '%s' failed", uri))
+	}
+	defer response.Body.Close()
+	if http.StatusOK != response.StatusCode {
+		return nil, errs.New(codes.SocketCapabilitiesFailed, fmt.Sprintf("get '%s' failed: %s", uri, response.Status))
+	}
+	description, err := io.ReadAll(response.Body)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.SocketCapabilitiesFailed, fmt.Sprintf("read '%s' failed", uri))
+	}
+	return ParseCapabilities(browser, description)
+}
+
+/*
+SetCapabilities sets the capabilities the socket checks commands and events
+against. A nil value disables the checks, which is the default.
+*/
+func (socket *Socket) SetCapabilities(caps *Capabilities) {
+	socket.mux.Lock()
+	socket.capabilities = caps
+	socket.mux.Unlock()
+}
+
+/*
+Supports returns whether the connected browser supports a domain, command or
+event, for example "Page.printToPDF". It returns true if the capabilities have
+not been detected or set, since support is then unknown.
+
+Supports is a Socketer implementation.
+*/
+func (socket *Socket) Supports(name string) bool {
+	return socket.Capabilities().Supports(name)
+}
@@ -0,0 +1,206 @@
+package socket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+var testProtocolDescription = []byte(`{
+	"version": {"major": "1", "minor": "3"},
+	"domains": [
+		{
+			"domain": "Page",
+			"commands": [{"name": "navigate"}, {"name": "reload"}],
+			"events": [{"name": "loadEventFired"}]
+		},
+		{
+			"domain": "Runtime",
+			"commands": [{"name": "evaluate"}]
+		}
+	]
+}`)
+
+func TestCapabilities(t *testing.T) {
+	caps, err := ParseCapabilities("HeadlessChrome/100.0.4896.60", testProtocolDescription)
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	for name, expected := range map[string]bool{
+		"Page":                true,
+		"Page.navigate":       true,
+		"Page.loadEventFired": true,
+		"Page.printToPDF":     false,
+		"Runtime.evaluate":    true,
+		"Fetch":               false,
+		"Fetch.enable":        false,
+	} {
+		if expected != caps.Supports(name) {
+			t.Errorf("Expected Supports('%s') to be %v", name, expected)
+		}
+	}
+	if domains := caps.Domains(); 2 != len(domains) || "Page" != domains[0] || "Runtime" != domains[1] {
+		t.Errorf("Expected [Page Runtime], received %v", domains)
+	}
+
+	err = caps.Check("Page.printToPDF")
+	if e, ok := err.(errs.Err); !ok || codes.SocketMethodUnsupported != e.Code() {
+		t.Errorf("Expected a SocketMethodUnsupported error, received %v", err)
+	}
+	if nil != caps.Check("Page.navigate") {
+		t.Errorf("Expected nil, received error")
+	}
+
+	if _, err := ParseCapabilities("", []byte(`{"domains": []}`)); nil == err {
+		t.Errorf("Expected an error for a description without domains")
+	}
+
+	domainCaps := NewDomainCapabilities("", []string{"Page"})
+	if !domainCaps.Supports("Page.printToPDF") || domainCaps.Supports("Fetch.enable") {
+		t.Errorf("Expected domain capabilities to support all methods of the listed domains only")
+	}
+
+	var nilCaps *Capabilities
+	if !nilCaps.Supports("Fetch.enable") || nil != nilCaps.Check("Fetch.enable") {
+		t.Errorf("Expected nil capabilities to support everything")
+	}
+}
+
+func TestSocketCapabilities(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCapabilities")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	if !mockSocket.Supports("Page.printToPDF") {
+		t.Errorf("Expected support to be assumed before capabilities are set")
+	}
+
+	caps, _ := ParseCapabilities("HeadlessChrome/100.0.4896.60", testProtocolDescription)
+	mockSocket.SetCapabilities(caps)
+	if mockSocket.Supports("Page.printToPDF") {
+		t.Errorf("Expected Page.printToPDF to be unsupported")
+	}
+
+	err := mockSocket.Call(context.Background(), "Page.printToPDF", nil, nil)
+	if e, ok := err.(errs.Err); !ok || codes.SocketMethodUnsupported != e.Code() {
+		t.Errorf("Expected a SocketMethodUnsupported error, received %v", err)
+	}
+	response := <-mockSocket.SendCommand(NewCommand(mockSocket, "Page.printToPDF", nil))
+	if nil == response.Error || int(codes.SocketMethodUnsupported) != response.Error.Code {
+		t.Errorf("Expected a SocketMethodUnsupported error response, received %v", response.Error)
+	} else if !strings.Contains(response.Error.Message, "Page.printToPDF") || !strings.Contains(response.Error.Message, "100.0.4896.60") {
+		t.Errorf("Expected the error to name the method and browser, received '%s'", response.Error.Message)
+	}
+	if nil != lastPayload(mockSocket) {
+		t.Errorf("Expected unsupported commands not to be sent")
+	}
+
+	waiter := mockSocket.WaitFor(context.Background(), "Page.frameNavigated", nil)
+	if _, err := waiter.Wait(); nil == err {
+		t.Errorf("Expected unsupported events to fail")
+	}
+	events, listener := EventChannel(mockSocket.Page().OnFrameNavigated, nil)
+	if e, ok := listener.Err().(errs.Err); !ok || codes.SocketMethodUnsupported != e.Code() {
+		t.Errorf("Expected a SocketMethodUnsupported error, received %v", listener.Err())
+	}
+	listener.Cancel()
+	if _, ok := <-events; ok {
+		t.Errorf("Expected the event channel to be closed")
+	}
+
+	session := mockSocket.NewSession("session-1")
+	if session.Supports("Page.printToPDF") {
+		t.Errorf("Expected sessions to use the capabilities of their browser connection")
+	}
+
+	mockSocket.SetCapabilities(nil)
+	if !mockSocket.Supports("Page.printToPDF") {
+		t.Errorf("Expected nil capabilities to disable the checks")
+	}
+}
+
+func TestSocketDetectCapabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if "/json/protocol" != request.URL.Path {
+			http.NotFound(writer, request)
+			return
+		}
+		writer.Write(testProtocolDescription)
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	socketURL, _ := url.Parse("ws://" + serverURL.Host + "/devtools/page/TestSocketDetectCapabilities")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	capsChan := make(chan *Capabilities, 1)
+	go func() {
+		caps, err := mockSocket.DetectCapabilities(context.Background())
+		if nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		}
+		capsChan <- caps
+	}()
+	time.Sleep(100 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     lastPayload(mockSocket).ID,
+		Error:  &Error{},
+		Result: []byte(`{"product":"HeadlessChrome/100.0.4896.60"}`),
+	})
+	caps := <-capsChan
+	if nil == caps || "HeadlessChrome/100.0.4896.60" != caps.Browser {
+		t.Fatalf("Expected the browser version to be read, received %v", caps)
+	}
+	if mockSocket.Capabilities() != caps {
+		t.Errorf("Expected the capabilities to be cached")
+	}
+	if !mockSocket.Supports("Page.navigate") || mockSocket.Supports("Page.printToPDF") {
+		t.Errorf("Expected the capabilities to be read from /json/protocol")
+	}
+}
+
+func TestSocketDetectCapabilitiesSchema(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketDetectCapabilitiesSchema")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	capsChan := make(chan *Capabilities, 1)
+	go func() {
+		caps, err := mockSocket.DetectCapabilities(context.Background())
+		if nil != err {
+			t.Errorf("Expected nil, received error: %v", err)
+		}
+		capsChan <- caps
+	}()
+	time.Sleep(100 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     lastPayload(mockSocket).ID,
+		Error:  &Error{},
+		Result: []byte(`{"product":"HeadlessChrome/100.0.4896.60"}`),
+	})
+	time.Sleep(100 * time.Millisecond)
+	payload := lastPayload(mockSocket)
+	if "Schema.getDomains" != payload.Method {
+		t.Fatalf("Expected a Schema.getDomains payload, found %v", payload)
+	}
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     payload.ID,
+		Error:  &Error{},
+		Result: []byte(`{"domains":[{"name":"Page","version":"1.3"}]}`),
+	})
+	caps := <-capsChan
+	if !caps.Supports("Page.printToPDF") || caps.Supports("Fetch.enable") {
+		t.Errorf("Expected the capabilities to be read from Schema.getDomains")
+	}
+}
@@ -27,6 +27,22 @@ type CommandMap struct {
 	stack map[int]Commander
 }
 
+/*
+Clear removes every command from the stack and returns them.
+
+Clear is a CommandMapper implementation.
+*/
+func (stack *CommandMap) Clear() []Commander {
+	stack.mux.Lock()
+	commands := make([]Commander, 0, len(stack.stack))
+	for id, command := range stack.stack {
+		commands = append(commands, command)
+		delete(stack.stack, id)
+	}
+	stack.mux.Unlock()
+	return commands
+}
+
 /*
 Delete removes a command from the stack.
 
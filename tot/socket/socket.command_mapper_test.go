@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"net/url"
 	"testing"
 )
 
@@ -11,3 +12,18 @@ func TestSocketCommandMapperError(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestSocketCommandMapperClear(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCommandMapperClear")
+	mockSocket := NewMock(socketURL)
+	commandMap := NewCommandMap()
+	commandMap.Set(NewCommand(mockSocket, "Some.method", nil))
+	commandMap.Set(NewCommand(mockSocket, "Some.method", nil))
+
+	if commands := commandMap.Clear(); 2 != len(commands) {
+		t.Errorf("Expected 2 commands, received %d", len(commands))
+	}
+	if commands := commandMap.Clear(); 0 != len(commands) {
+		t.Errorf("Expected 0 commands, received %d", len(commands))
+	}
+}
@@ -9,7 +9,7 @@ func NewCommand(socket Socketer, method string, params interface{}) *Command {
 		id:       socket.NextCommandID(),
 		method:   method,
 		params:   params,
-		response: make(chan *Response),
+		response: make(chan *Response, 1),
 		socket:   socket,
 	}
 }
@@ -76,7 +76,8 @@ func (cmd *Command) Params() interface{} {
 }
 
 /*
-Respond sends a response to the command response channel.
+Respond sends a response to the command response channel. The channel is
+buffered so a response to an abandoned command never blocks the socket.
 
 Respond is a Commander implementation.
 */
@@ -0,0 +1,114 @@
+package socket
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+/*
+ConnectionStateChanged is the name of the synthetic event a Socket dispatches to
+its event handlers whenever the state of its connection changes.
+*/
+const ConnectionStateChanged = "Socket.connectionStateChanged"
+
+/*
+ConnectionState describes the state of a socket connection.
+*/
+type ConnectionState string
+
+const (
+	// ConnectionConnected indicates the websocket connection is established.
+	ConnectionConnected ConnectionState = "connected"
+
+	// ConnectionReconnecting indicates the websocket connection dropped and a
+	// reconnection attempt is pending.
+	ConnectionReconnecting ConnectionState = "reconnecting"
+
+	// ConnectionClosed indicates the websocket connection is closed and will
+	// not be re-established.
+	ConnectionClosed ConnectionState = "closed"
+)
+
+/*
+ConnectionStateChangedEvent represents a Socket.connectionStateChanged event.
+*/
+type ConnectionStateChangedEvent struct {
+	// The new connection state.
+	State ConnectionState `json:"state"`
+
+	// Optional. The reconnection attempt, numbered from 1.
+	Attempt int `json:"attempt,omitempty"`
+
+	// Optional. A description of the error that caused the state change.
+	Reason string `json:"reason,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+OnConnectionStateChanged adds a handler to the Socket.connectionStateChanged
+event. Socket.connectionStateChanged fires when the socket connects, starts
+reconnecting after a dropped connection, or closes. The returned Listener's
+Cancel method removes the handler.
+*/
+func (socket *Socket) OnConnectionStateChanged(
+	callback func(event *ConnectionStateChangedEvent),
+) *Listener {
+	handler := NewEventHandler(
+		ConnectionStateChanged,
+		func(response *Response) {
+			event := &ConnectionStateChangedEvent{}
+			if err := json.Unmarshal([]byte(response.Params), event); nil != err {
+				event.Err = err
+			}
+			callback(event)
+		},
+	)
+	socket.AddEventHandler(handler)
+	// The event is synthetic, so it is never checked against the browser's
+	// capabilities.
+	return &Listener{
+		cancel: func() {
+			socket.RemoveEventHandler(handler)
+		},
+		handler: handler,
+		once:    &sync.Once{},
+	}
+}
+
+/*
+State returns the current connection state.
+*/
+func (socket *Socket) State() ConnectionState {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.state
+}
+
+/*
+setState records the connection state and notifies any
+Socket.connectionStateChanged event handlers.
+*/
+func (socket *Socket) setState(state ConnectionState, attempt int, reason error) {
+	socket.mux.Lock()
+	if socket.state == state && ConnectionReconnecting != state {
+		socket.mux.Unlock()
+		return
+	}
+	socket.state = state
+	socket.mux.Unlock()
+
+	event := &ConnectionStateChangedEvent{
+		State:   state,
+		Attempt: attempt,
+	}
+	if nil != reason {
+		event.Reason = reason.Error()
+	}
+	params, _ := json.Marshal(event)
+	socket.handleEvent(&Response{
+		Method: ConnectionStateChanged,
+		Params: params,
+	})
+}
@@ -14,7 +14,12 @@ Conn returns the current web socket pointer.
 Conn is a Conner implementation.
 */
 func (socket *Socket) Conn() WebSocketer {
+	if nil != socket.parent {
+		return socket.parent.Conn()
+	}
 	socket.Connect()
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	return socket.conn
 }
 
@@ -24,6 +29,9 @@ Connect establishes a websocket connection.
 Connect is a Conner implementation.
 */
 func (socket *Socket) Connect() error {
+	if nil != socket.parent {
+		return socket.parent.Connect()
+	}
 	socket.mux.Lock()
 	defer socket.mux.Unlock()
 
@@ -45,12 +53,32 @@ func (socket *Socket) Connect() error {
 	return nil
 }
 
+/*
+connection connects if necessary and returns the current connection.
+*/
+func (socket *Socket) connection() (WebSocketer, error) {
+	if err := socket.Connect(); nil != err {
+		return nil, err
+	}
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if nil == socket.conn {
+		return nil, errs.New(codes.SocketNotConnected, "not connected")
+	}
+	return socket.conn, nil
+}
+
 /*
 Connected returns whether a connection exists.
 
 Connected is a Conner implementation.
 */
 func (socket *Socket) Connected() bool {
+	if nil != socket.parent {
+		return socket.parent.Connected()
+	}
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
 	return nil != socket.conn
 }
 
@@ -60,15 +88,23 @@ Disconnect closes a websocket connection.
 Disconnect is a Conner implementation.
 */
 func (socket *Socket) Disconnect() error {
-	if !socket.Connected() {
+	if nil != socket.parent {
+		// Sessions share the parent connection, detach instead.
+		return nil
+	}
+	socket.mux.Lock()
+	conn := socket.conn
+	socket.conn = nil
+	socket.connected = false
+	socket.mux.Unlock()
+
+	if nil == conn {
 		return fmt.Errorf("not connected")
 	}
-	err := socket.conn.Close()
+	err := conn.Close()
 	if nil != err {
 		err = errs.Wrap(err, codes.SocketCloseFailed, "could not close socket connection")
 	}
-	socket.conn = nil
-	socket.connected = false
 	return err
 }
 
@@ -78,12 +114,15 @@ ReadJSON reads data from a websocket connection.
 ReadJSON is a Conner implementation.
 */
 func (socket *Socket) ReadJSON(v interface{}) error {
-	err := socket.Connect()
+	if nil != socket.parent {
+		return errs.New(codes.SocketReadFailed, "session messages are read by the parent socket")
+	}
+	conn, err := socket.connection()
 	if nil != err {
 		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
 	}
 
-	err = socket.conn.ReadJSON(&v)
+	err = conn.ReadJSON(&v)
 	if nil != err {
 		return err
 	}
@@ -97,12 +136,18 @@ WriteJSON writes data to a websocket connection.
 WriteJSON is a Conner implementation.
 */
 func (socket *Socket) WriteJSON(v interface{}) error {
-	err := socket.Connect()
+	if nil != socket.parent {
+		return socket.parent.WriteJSON(v)
+	}
+	conn, err := socket.connection()
 	if nil != err {
 		return errs.Wrap(err, codes.SocketNotConnected, "not connected")
 	}
 
-	err = socket.conn.WriteJSON(v)
+	// Websocket connections support a single concurrent writer.
+	socket.writeMux.Lock()
+	err = conn.WriteJSON(v)
+	socket.writeMux.Unlock()
 	if nil != err {
 		return errs.Wrap(err, codes.SocketWriteFailed, "socket write failed")
 	}
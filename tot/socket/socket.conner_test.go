@@ -2,6 +2,7 @@ package socket
 
 import (
 	"net/url"
+	"sync"
 	"testing"
 )
 
@@ -24,3 +25,23 @@ func TestConner(t *testing.T) {
 		t.Errorf("Expected nil, got error: '%s'", err.Error())
 	}
 }
+
+func TestConnerConcurrency(t *testing.T) {
+	socketURL, _ := url.Parse("http://test:9222/TestConnerConcurrency")
+	socket := NewMock(socketURL)
+
+	wg := &sync.WaitGroup{}
+	for a := 0; a < 20; a++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := 0; b < 50; b++ {
+				_ = socket.Connect()
+				_ = socket.Connected()
+				_ = socket.WriteJSON(&Payload{Method: "Test.method"})
+				_ = socket.Disconnect()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,232 @@
+package socket
+
+import (
+	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+DefaultQueueSize is the event queue depth used when a DispatchPolicy doesn't
+specify one.
+*/
+const DefaultQueueSize = 1000
+
+/*
+DispatchPolicy defines ordered event delivery for a Socket. Each event handler
+gets a queue and a goroutine that calls it with one event at a time, in the
+order the events were read from the connection. Panics in event handlers are
+recovered and reported to OnError instead of crashing the program.
+*/
+type DispatchPolicy struct {
+	// Optional. QueueSize is the number of events that can be waiting for
+	// each handler. Defaults to DefaultQueueSize.
+	QueueSize int
+
+	// Optional. Overflow is the policy applied when a handler's queue is
+	// full. OverflowBlock stops reading from the connection until the
+	// handler catches up, which also delays command responses. Defaults to
+	// OverflowBlock.
+	Overflow OverflowPolicy
+
+	// Optional. OnError is called with a SocketEventHandlerPanic error when
+	// a handler panics and a SocketEventDropped error when an event is
+	// discarded from a full queue. Errors are always logged.
+	OnError func(response *Response, err error)
+}
+
+/*
+eventQueue delivers events to a single event handler in order.
+*/
+type eventQueue struct {
+	done    chan struct{}
+	events  chan *Response
+	handler EventHandler
+	policy  *DispatchPolicy
+	socket  *Socket
+
+	// pinned is set for queues added with addQueuedEventHandler, which are
+	// kept when the dispatch policy changes.
+	pinned bool
+}
+
+/*
+newEventQueue starts and returns the event queue for a handler.
+*/
+func newEventQueue(socket *Socket, handler EventHandler, policy *DispatchPolicy) *eventQueue {
+	size := policy.QueueSize
+	if size <= 0 {
+		size = DefaultQueueSize
+	}
+	queue := &eventQueue{
+		done:    make(chan struct{}),
+		events:  make(chan *Response, size),
+		handler: handler,
+		policy:  policy,
+		socket:  socket,
+	}
+	go queue.run()
+	return queue
+}
+
+/*
+push adds an event to the queue, applying the overflow policy if it is full.
+*/
+func (queue *eventQueue) push(response *Response) {
+	switch queue.policy.Overflow {
+	case OverflowDropNewest:
+		select {
+		case queue.events <- response:
+		default:
+			queue.dropped(response)
+		}
+
+	case OverflowDropOldest:
+		for {
+			select {
+			case queue.events <- response:
+				return
+			default:
+			}
+			select {
+			case oldest := <-queue.events:
+				queue.dropped(oldest)
+			default:
+			}
+		}
+
+	default:
+		select {
+		case queue.events <- response:
+		case <-queue.done:
+		case <-queue.socket.ctx.Done():
+		}
+	}
+}
+
+/*
+run delivers queued events until the queue is stopped or the socket closes.
+*/
+func (queue *eventQueue) run() {
+	for {
+		select {
+		case <-queue.done:
+			return
+		case <-queue.socket.ctx.Done():
+			return
+		case response := <-queue.events:
+			queue.handle(response)
+		}
+	}
+}
+
+/*
+handle calls the event handler, recovering from panics.
+*/
+func (queue *eventQueue) handle(response *Response) {
+	defer func() {
+		if r := recover(); nil != r {
+			message := fmt.Sprintf("recovered from panic in '%s' event handler: %v", queue.handler.Name(), r)
+			err := errs.New(codes.SocketEventHandlerPanic, message)
+			if e, ok := r.(error); ok {
+				err = errs.Wrap(e, codes.SocketEventHandlerPanic, message)
+			}
+			queue.report(response, err)
+		}
+	}()
+	queue.handler.Handle(response)
+}
+
+/*
+dropped reports an event discarded from a full queue.
+*/
+func (queue *eventQueue) dropped(response *Response) {
+	queue.report(response, errs.New(
+		codes.SocketEventDropped,
+		fmt.Sprintf("event queue for '%s' is full, event dropped", queue.handler.Name()),
+	))
+}
+
+/*
+report logs an error and passes it to the policy error hook.
+*/
+func (queue *eventQueue) report(response *Response, err error) {
+	log.WithFields(log.Fields{"error": err, "event": response.Method, "socketID": queue.socket.socketID}).
+		Error(err)
+	if nil != queue.policy.OnError {
+		queue.policy.OnError(response, err)
+	}
+}
+
+/*
+stop stops delivering events. Events that are still queued are discarded.
+*/
+func (queue *eventQueue) stop() {
+	close(queue.done)
+}
+
+/*
+queueFor returns the event queue for a handler, starting it if needed. It
+returns false if the handler should not receive the event because it has been
+removed.
+*/
+func (socket *Socket) queueFor(handler EventHandler) (*eventQueue, bool) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if queue, ok := socket.queues[handler]; ok {
+		return queue, true
+	}
+	if nil == socket.dispatchPolicy {
+		return nil, true
+	}
+
+	// The handler may have been removed since the event was received.
+	handlers, _ := socket.handlers.Get(handler.Name())
+	for _, hndlr := range handlers {
+		if hndlr == handler {
+			if nil == socket.queues {
+				socket.queues = make(map[EventHandler]*eventQueue)
+			}
+			queue := newEventQueue(socket, handler, socket.dispatchPolicy)
+			socket.queues[handler] = queue
+			return queue, true
+		}
+	}
+	return nil, false
+}
+
+/*
+addQueuedEventHandler adds an event handler that is called with one event at a
+time, in order, whether or not a dispatch policy is set. Its queue uses the
+dispatch policy, or the default policy if none is set.
+*/
+func (socket *Socket) addQueuedEventHandler(handler EventHandler) {
+	socket.mux.Lock()
+	policy := socket.dispatchPolicy
+	if nil == policy {
+		policy = &DispatchPolicy{}
+	}
+	queue := newEventQueue(socket, handler, policy)
+	queue.pinned = true
+	if nil == socket.queues {
+		socket.queues = make(map[EventHandler]*eventQueue)
+	}
+	socket.queues[handler] = queue
+	socket.mux.Unlock()
+
+	socket.AddEventHandler(handler)
+}
+
+/*
+stopQueue stops the event queue for a removed handler.
+*/
+func (socket *Socket) stopQueue(handler EventHandler) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	if queue, ok := socket.queues[handler]; ok {
+		queue.stop()
+		delete(socket.queues, handler)
+	}
+}
@@ -0,0 +1,181 @@
+package socket
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func emitThings(mockSocket *Socket, from, to int) {
+	for a := from; a <= to; a++ {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Method: "Page.newThingHappened",
+			Params: []byte(fmt.Sprintf(`{"thing":%d}`, a)),
+		})
+	}
+}
+
+func thing(response *Response) int {
+	params := struct {
+		Thing int `json:"thing"`
+	}{}
+	json.Unmarshal(response.Params, &params)
+	return params.Thing
+}
+
+func countQueues(mockSocket *Socket) int {
+	mockSocket.mux.Lock()
+	defer mockSocket.mux.Unlock()
+	return len(mockSocket.queues)
+}
+
+func TestSocketDispatchOrdered(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketDispatchOrdered")
+	mockSocket := NewMock(socketURL)
+	errors := make(chan error, 10)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{
+		OnError: func(response *Response, err error) {
+			errors <- err
+		},
+	})
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	things := make(chan int, 5)
+	mockSocket.AddEventHandler(NewEventHandler("Page.newThingHappened", func(response *Response) {
+		// Slower handling of earlier events would reorder goroutine delivery.
+		time.Sleep(time.Duration(6-thing(response)) * 20 * time.Millisecond)
+		things <- thing(response)
+		if 1 == thing(response) {
+			panic("handler failed")
+		}
+	}))
+	emitThings(mockSocket, 1, 5)
+
+	for a := 1; a <= 5; a++ {
+		select {
+		case received := <-things:
+			if a != received {
+				t.Fatalf("Expected event %d, received %d", a, received)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Expected event %d", a)
+		}
+	}
+	select {
+	case err := <-errors:
+		if e, ok := err.(errs.Err); !ok || codes.SocketEventHandlerPanic != e.Code() {
+			t.Errorf("Expected SocketEventHandlerPanic, received %v", err)
+		}
+	default:
+		t.Errorf("Expected the handler panic to be reported")
+	}
+}
+
+func TestSocketDispatchOverflow(t *testing.T) {
+	tests := []struct {
+		policy  OverflowPolicy
+		dropped []int
+		handled []int
+	}{
+		{OverflowDropNewest, []int{3, 4, 5}, []int{1, 2}},
+		{OverflowDropOldest, []int{2, 3, 4}, []int{1, 5}},
+	}
+	for _, test := range tests {
+		socketURL, _ := url.Parse("https://test:9222/TestSocketDispatchOverflow")
+		mockSocket := NewMock(socketURL)
+		mux := &sync.Mutex{}
+		dropped := []int{}
+		mockSocket.SetDispatchPolicy(&DispatchPolicy{
+			QueueSize: 1,
+			Overflow:  test.policy,
+			OnError: func(response *Response, err error) {
+				if e, ok := err.(errs.Err); !ok || codes.SocketEventDropped != e.Code() {
+					t.Errorf("Expected SocketEventDropped, received %v", err)
+				}
+				mux.Lock()
+				dropped = append(dropped, thing(response))
+				mux.Unlock()
+			},
+		})
+		go func() { _ = mockSocket.Listen() }()
+
+		release := make(chan struct{})
+		handled := []int{}
+		mockSocket.AddEventHandler(NewEventHandler("Page.newThingHappened", func(response *Response) {
+			<-release
+			mux.Lock()
+			handled = append(handled, thing(response))
+			mux.Unlock()
+		}))
+
+		// The handler holds the first event while the rest fill the queue.
+		emitThings(mockSocket, 1, 1)
+		time.Sleep(50 * time.Millisecond)
+		emitThings(mockSocket, 2, 5)
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+		time.Sleep(50 * time.Millisecond)
+		mockSocket.Stop()
+
+		mux.Lock()
+		if fmt.Sprint(test.dropped) != fmt.Sprint(dropped) {
+			t.Errorf("Expected dropped events %v with policy %d, found %v", test.dropped, test.policy, dropped)
+		}
+		if fmt.Sprint(test.handled) != fmt.Sprint(handled) {
+			t.Errorf("Expected handled events %v with policy %d, found %v", test.handled, test.policy, handled)
+		}
+		mux.Unlock()
+	}
+}
+
+func TestSocketDispatchRemoveHandler(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketDispatchRemoveHandler")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetDispatchPolicy(&DispatchPolicy{QueueSize: 1})
+	go func() { _ = mockSocket.Listen() }()
+
+	things := make(chan int, 5)
+	handler := NewEventHandler("Page.newThingHappened", func(response *Response) {
+		things <- thing(response)
+	})
+	mockSocket.AddEventHandler(handler)
+	emitThings(mockSocket, 1, 1)
+	time.Sleep(50 * time.Millisecond)
+	if queues := countQueues(mockSocket); 1 != queues {
+		t.Errorf("Expected 1 event queue, found %d", queues)
+	}
+
+	mockSocket.RemoveEventHandler(handler)
+	if queues := countQueues(mockSocket); 0 != queues {
+		t.Errorf("Expected the event queue to be stopped, found %d", queues)
+	}
+	emitThings(mockSocket, 2, 2)
+	time.Sleep(50 * time.Millisecond)
+	if 1 != len(things) {
+		t.Errorf("Expected 1 event, received %d", len(things))
+	}
+
+	// A blocked queue doesn't prevent the socket from stopping.
+	mockSocket.AddEventHandler(NewEventHandler("Page.newThingHappened", func(response *Response) {
+		select {}
+	}))
+	emitThings(mockSocket, 3, 6)
+	time.Sleep(100 * time.Millisecond)
+	stopped := make(chan struct{})
+	go func() {
+		mockSocket.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Errorf("Expected Stop() to return")
+	}
+}
@@ -14,8 +14,9 @@ NewEventHandlerMap creates and returns a pointer to an EventHandlerMapper.
 */
 func NewEventHandlerMap() *EventHandlerMap {
 	return &EventHandlerMap{
-		stack: make(map[string][]EventHandler),
-		mux:   &sync.Mutex{},
+		stack:    make(map[string][]EventHandler),
+		stackMux: &sync.RWMutex{},
+		mux:      &sync.Mutex{},
 	}
 }
 
@@ -25,7 +26,14 @@ handler stack.
 */
 type EventHandlerMap struct {
 	stack map[string][]EventHandler
-	mux   *sync.Mutex
+
+	// stackMux guards the handler stack. Every method acquires it so the map
+	// is safe for concurrent use.
+	stackMux *sync.RWMutex
+
+	// mux is the lock exposed by Lock and Unlock for callers that need to
+	// make several changes to the map atomically.
+	mux *sync.Mutex
 }
 
 /*
@@ -64,7 +72,9 @@ Delete is an EventHandlerMapper implementation.
 func (stack *EventHandlerMap) Delete(
 	name string,
 ) {
+	stack.stackMux.Lock()
 	delete(stack.stack, name)
+	stack.stackMux.Unlock()
 }
 
 /*
@@ -75,8 +85,10 @@ Get is an EventHandlerMapper implementation.
 func (stack *EventHandlerMap) Get(
 	name string,
 ) ([]EventHandler, error) {
+	stack.stackMux.RLock()
+	defer stack.stackMux.RUnlock()
 	if handlers, ok := stack.stack[name]; ok {
-		return handlers, nil
+		return append([]EventHandler{}, handlers...), nil
 	}
 	return nil, errs.New(codes.SocketDuplicateEventHandler, fmt.Sprintf("No event listeners found for %s", name))
 }
@@ -101,9 +113,10 @@ func (stack *EventHandlerMap) Remove(
 	stack.Lock()
 	defer stack.Unlock()
 
-	for k, hndl := range stack.stack[handler.Name()] {
+	handlers, _ := stack.Get(handler.Name())
+	for k, hndl := range handlers {
 		if hndl == handler {
-			stack.stack[handler.Name()] = append(stack.stack[handler.Name()][:k], stack.stack[handler.Name()][k+1:]...)
+			stack.Set(handler.Name(), append(handlers[:k], handlers[k+1:]...))
 			return nil
 		}
 	}
@@ -119,7 +132,9 @@ func (stack *EventHandlerMap) Set(
 	eventName string,
 	handlers []EventHandler,
 ) {
+	stack.stackMux.Lock()
 	stack.stack[eventName] = handlers
+	stack.stackMux.Unlock()
 }
 
 /*
@@ -1,6 +1,7 @@
 package socket
 
 import (
+	"sync"
 	"testing"
 )
 
@@ -36,3 +37,29 @@ func TestEventHandlerMapper(t *testing.T) {
 	// no-op
 	handlerMap.Delete("eventName")
 }
+
+func TestEventHandlerMapperConcurrency(t *testing.T) {
+	handlerMap := NewEventHandlerMap()
+	wg := &sync.WaitGroup{}
+	for a := 0; a < 20; a++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handler := NewEventHandler(
+				"eventName",
+				func(response *Response) {},
+			)
+			for b := 0; b < 50; b++ {
+				_ = handlerMap.Add(handler)
+				_, _ = handlerMap.Get("eventName")
+				_ = handlerMap.Remove(handler)
+			}
+		}()
+	}
+	wg.Wait()
+
+	handlers, _ := handlerMap.Get("eventName")
+	if 0 != len(handlers) {
+		t.Errorf("Expected 0 handlers, found %d", len(handlers))
+	}
+}
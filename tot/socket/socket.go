@@ -8,8 +8,12 @@ See https://chromedevtools.github.io/devtools-protocol/ for details.
 package socket
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
 )
 
 /*
@@ -32,11 +36,12 @@ func (err Error) Error() string {
 Response represents a socket message.
 */
 type Response struct {
-	Error  *Error          `json:"error"`
-	ID     int             `json:"id"`
-	Method string          `json:"method"`
-	Params json.RawMessage `json:"params"`
-	Result json.RawMessage `json:"result"`
+	Error     *Error          `json:"error"`
+	ID        int             `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	Result    json.RawMessage `json:"result"`
+	SessionID string          `json:"sessionId,omitempty"`
 }
 
 /*
@@ -44,7 +49,19 @@ Payload represents a WebSocket JSON payload for a sending a command to the
 websocket.
 */
 type Payload struct {
-	ID     int         `json:"id"`
-	Method string      `json:"method"`
-	Params interface{} `json:"params"`
+	ID        int         `json:"id"`
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params"`
+	SessionID string      `json:"sessionId,omitempty"`
+}
+
+/*
+contextError returns the error reported to callers when a command's context
+ends before the command completes.
+*/
+func contextError(ctx context.Context, command Commander) error {
+	if context.DeadlineExceeded == ctx.Err() {
+		return errs.Wrap(ctx.Err(), codes.SocketCommandTimeout, fmt.Sprintf("command #%d '%s' timed out", command.ID(), command.Method()))
+	}
+	return errs.Wrap(ctx.Err(), codes.SocketCommandCancelled, fmt.Sprintf("command #%d '%s' cancelled", command.ID(), command.Method()))
 }
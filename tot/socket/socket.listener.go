@@ -0,0 +1,173 @@
+package socket
+
+import (
+	"sync"
+)
+
+/*
+Listener is an event handler added by one of the protocol On* methods. Cancel
+removes the handler from the socket.
+*/
+type Listener struct {
+	cancel  func()
+	err     error
+	handler EventHandler
+	once    *sync.Once
+}
+
+/*
+NewListener returns the listener for a handler added to a socket.
+*/
+func NewListener(socket Socketer, handler EventHandler) *Listener {
+	return &Listener{
+		cancel: func() {
+			socket.RemoveEventHandler(handler)
+		},
+		err:     socket.Capabilities().Check(handler.Name()),
+		handler: handler,
+		once:    &sync.Once{},
+	}
+}
+
+/*
+Cancel removes the event handler. It is safe to call Cancel more than once.
+*/
+func (listener *Listener) Cancel() {
+	listener.once.Do(listener.cancel)
+}
+
+/*
+Err returns a codes.SocketMethodUnsupported error if the socket's capabilities
+show the connected browser doesn't support the event, in which case the handler
+is never called.
+*/
+func (listener *Listener) Err() error {
+	return listener.err
+}
+
+/*
+Handler returns the event handler.
+*/
+func (listener *Listener) Handler() EventHandler {
+	return listener.handler
+}
+
+/*
+OverflowPolicy defines what happens to events delivered to a full event
+channel.
+*/
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until the channel has room for the event. A reader
+	// that stops reading holds up delivery of further events to the channel.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropNewest discards the event.
+	OverflowDropNewest
+
+	// OverflowDropOldest discards the oldest event in the channel to make
+	// room for the event.
+	OverflowDropOldest
+)
+
+/*
+DefaultChannelSize is the event channel buffer size used when none is
+specified.
+*/
+const DefaultChannelSize = 64
+
+/*
+ChannelOptions defines the buffer size and overflow policy of an event
+channel.
+*/
+type ChannelOptions struct {
+	// Optional. Size is the channel buffer size. Defaults to
+	// DefaultChannelSize.
+	Size int
+
+	// Optional. Overflow is the policy applied when the channel is full.
+	// Defaults to OverflowBlock.
+	Overflow OverflowPolicy
+}
+
+/*
+EventChannel delivers the events of one of the protocol On* methods on a
+buffered channel instead of calling a function:
+
+	events, listener := socket.EventChannel(tab.Network().OnResponseReceived, &socket.ChannelOptions{
+		Size:     100,
+		Overflow: socket.OverflowDropOldest,
+	})
+	defer listener.Cancel()
+	for event := range events {
+		...
+	}
+
+options may be nil to use the defaults. Cancelling the listener removes the
+event handler and closes the channel.
+*/
+func EventChannel[T any](on func(callback func(event T)) *Listener, options *ChannelOptions) (<-chan T, *Listener) {
+	size := DefaultChannelSize
+	policy := OverflowBlock
+	if nil != options {
+		if options.Size > 0 {
+			size = options.Size
+		}
+		policy = options.Overflow
+	}
+
+	events := make(chan T, size)
+	done := make(chan struct{})
+	mux := &sync.RWMutex{}
+	listener := on(func(event T) {
+		mux.RLock()
+		defer mux.RUnlock()
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		switch policy {
+		case OverflowDropNewest:
+			select {
+			case events <- event:
+			default:
+			}
+
+		case OverflowDropOldest:
+			for {
+				select {
+				case events <- event:
+					return
+				default:
+				}
+				select {
+				case <-events:
+				default:
+				}
+			}
+
+		default:
+			select {
+			case events <- event:
+			case <-done:
+			}
+		}
+	})
+
+	return events, &Listener{
+		cancel: func() {
+			close(done)
+			listener.Cancel()
+			// Wait for handlers that are delivering an event to return.
+			mux.Lock()
+			close(events)
+			mux.Unlock()
+		},
+		err:     listener.Err(),
+		handler: listener.Handler(),
+		once:    &sync.Once{},
+	}
+}
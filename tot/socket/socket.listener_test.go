@@ -0,0 +1,104 @@
+package socket
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+func emitLoadEvents(mockSocket *Socket, count int) {
+	for a := 1; a <= count; a++ {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Method: "Page.loadEventFired",
+			Params: []byte(fmt.Sprintf(`{"timestamp":%d}`, a)),
+		})
+	}
+	time.Sleep(100 * time.Millisecond)
+}
+
+func TestListenerCancel(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestListenerCancel")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	calls := make(chan *page.LoadEventFiredEvent, 10)
+	listener := mockSocket.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		calls <- event
+	})
+	if "Page.loadEventFired" != listener.Handler().Name() {
+		t.Errorf("Expected 'Page.loadEventFired', received '%s'", listener.Handler().Name())
+	}
+	emitLoadEvents(mockSocket, 1)
+	if 1 != len(calls) {
+		t.Fatalf("Expected 1 event, received %d", len(calls))
+	}
+
+	listener.Cancel()
+	listener.Cancel()
+	emitLoadEvents(mockSocket, 1)
+	if 1 != len(calls) {
+		t.Errorf("Expected no events after Cancel, received %d", len(calls)-1)
+	}
+	handlers, _ := mockSocket.handlers.Get("Page.loadEventFired")
+	if 0 != len(handlers) {
+		t.Errorf("Expected the event handler to be removed, found %d", len(handlers))
+	}
+}
+
+func TestEventChannel(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestEventChannel")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	// OverflowBlock delivers every event.
+	events, listener := EventChannel(mockSocket.Page().OnLoadEventFired, &ChannelOptions{Size: 1})
+	emitLoadEvents(mockSocket, 3)
+	for a := 0; a < 3; a++ {
+		select {
+		case <-events:
+		case <-time.After(time.Second):
+			t.Fatalf("Expected 3 events, received %d", a)
+		}
+	}
+	listener.Cancel()
+	listener.Cancel()
+	if _, ok := <-events; ok {
+		t.Errorf("Expected the channel to be closed")
+	}
+	handlers, _ := mockSocket.handlers.Get("Page.loadEventFired")
+	if 0 != len(handlers) {
+		t.Errorf("Expected the event handler to be removed, found %d", len(handlers))
+	}
+
+	// The drop policies keep the channel at its buffer size.
+	for _, policy := range []OverflowPolicy{OverflowDropNewest, OverflowDropOldest} {
+		events, listener := EventChannel(mockSocket.Page().OnLoadEventFired, &ChannelOptions{
+			Size:     1,
+			Overflow: policy,
+		})
+		emitLoadEvents(mockSocket, 3)
+		if 1 != len(events) {
+			t.Errorf("Expected 1 buffered event with policy %d, found %d", policy, len(events))
+		}
+		listener.Cancel()
+		count := 0
+		for range events {
+			count++
+		}
+		if 1 != count {
+			t.Errorf("Expected 1 event with policy %d, received %d", policy, count)
+		}
+	}
+
+	// A cancelled channel doesn't block pending handlers.
+	events, listener = EventChannel(mockSocket.Page().OnLoadEventFired, &ChannelOptions{Size: 1})
+	emitLoadEvents(mockSocket, 3)
+	listener.Cancel()
+	for range events {
+	}
+}
@@ -0,0 +1,116 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+NewPipe returns a pointer to a ChromePipe that implements the WebSocketer
+interface over a pair of streams, for example the file descriptors 3 and 4
+Chromium opens when it is started with the --remote-debugging-pipe flag.
+Commands are written to in and responses are read from out.
+*/
+func NewPipe(in io.WriteCloser, out io.ReadCloser) *ChromePipe {
+	return &ChromePipe{
+		in:        in,
+		out:       out,
+		reader:    bufio.NewReader(out),
+		readMux:   &sync.Mutex{},
+		writeMux:  &sync.Mutex{},
+		closeOnce: &sync.Once{},
+	}
+}
+
+/*
+NewPipeSocket returns a pointer to a Socket that communicates over the provided
+pipe instead of a websocket. A pipe can't be re-established, so the socket's
+reconnect policy has no effect.
+*/
+func NewPipeSocket(pipe *ChromePipe) *Socket {
+	dialed := false
+	return NewWithDialer(
+		&url.URL{Scheme: "pipe", Host: "remote-debugging-pipe"},
+		func(socketURL *url.URL) (WebSocketer, error) {
+			if dialed {
+				return nil, errs.New(codes.WebsocketConnectFailed, "the remote debugging pipe can't be reconnected")
+			}
+			dialed = true
+			return pipe, nil
+		},
+	)
+}
+
+/*
+ChromePipe provides a WebSocketer interface for managing a remote debugging pipe
+connection. Messages on the pipe are JSON encoded and NUL delimited. Unlike
+websocket connections, payloads are not limited to 1MB.
+*/
+type ChromePipe struct {
+	in        io.WriteCloser
+	out       io.ReadCloser
+	reader    *bufio.Reader
+	readMux   *sync.Mutex
+	writeMux  *sync.Mutex
+	closeOnce *sync.Once
+}
+
+/*
+Close closes both ends of the pipe.
+
+Close is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) Close() error {
+	var err error
+	pipe.closeOnce.Do(func() {
+		inErr := pipe.in.Close()
+		outErr := pipe.out.Close()
+		if nil != inErr {
+			err = errs.Wrap(inErr, codes.SocketCloseFailed, "could not close pipe")
+		} else if nil != outErr {
+			err = errs.Wrap(outErr, codes.SocketCloseFailed, "could not close pipe")
+		}
+	})
+	return err
+}
+
+/*
+ReadJSON reads the next NUL delimited message from the pipe and unmarshalls it
+into the provided variable.
+
+ReadJSON is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) ReadJSON(v interface{}) error {
+	pipe.readMux.Lock()
+	data, err := pipe.reader.ReadBytes(0)
+	pipe.readMux.Unlock()
+	if nil != err {
+		return errs.Wrap(err, codes.SocketReadFailed, "pipe read failed")
+	}
+	return json.Unmarshal(data[:len(data)-1], v)
+}
+
+/*
+WriteJSON marshalls the provided data as JSON and writes it to the pipe followed
+by a NUL delimiter.
+
+WriteJSON is a WebSocketer implementation.
+*/
+func (pipe *ChromePipe) WriteJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if nil != err {
+		return errs.Wrap(err, codes.SocketWriteFailed, "could not marshal pipe payload")
+	}
+	pipe.writeMux.Lock()
+	defer pipe.writeMux.Unlock()
+	if _, err = pipe.in.Write(append(data, 0)); nil != err {
+		return errs.Wrap(err, codes.SocketWriteFailed, "pipe write failed")
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package socket
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestChromePipe(t *testing.T) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+	pipe := NewPipe(cmdWriter, respReader)
+
+	// Emulate the browser end of the pipe: echo each command's ID back as a
+	// response.
+	go func() {
+		reader := bufio.NewReader(cmdReader)
+		for {
+			data, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			json.Unmarshal(data[:len(data)-1], payload)
+			response, _ := json.Marshal(&Response{
+				ID:     payload.ID,
+				Result: []byte(`{"method":"` + payload.Method + `"}`),
+			})
+			respWriter.Write(append(response, 0))
+		}
+	}()
+
+	if err := pipe.WriteJSON(&Payload{ID: 1, Method: "Browser.getVersion"}); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	response := &Response{}
+	if err := pipe.ReadJSON(response); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if 1 != response.ID {
+		t.Errorf("Expected ID 1, received %d", response.ID)
+	}
+	if `{"method":"Browser.getVersion"}` != string(response.Result) {
+		t.Errorf("Invalid result: %s", response.Result)
+	}
+
+	if err := pipe.Close(); nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if err := pipe.ReadJSON(response); nil == err {
+		t.Errorf("Expected error reading a closed pipe, received nil")
+	}
+}
+
+func TestPipeSocket(t *testing.T) {
+	cmdReader, cmdWriter := io.Pipe()
+	respReader, respWriter := io.Pipe()
+	go func() {
+		reader := bufio.NewReader(cmdReader)
+		for {
+			data, err := reader.ReadBytes(0)
+			if nil != err {
+				return
+			}
+			payload := &Payload{}
+			json.Unmarshal(data[:len(data)-1], payload)
+			response, _ := json.Marshal(&Response{
+				ID:     payload.ID,
+				Result: []byte(`{"product":"HeadlessChrome/100.0.0.0"}`),
+			})
+			respWriter.Write(append(response, 0))
+		}
+	}()
+
+	pipeSocket := NewPipeSocket(NewPipe(cmdWriter, respReader))
+	defer pipeSocket.Stop()
+	select {
+	case result := <-pipeSocket.Browser().GetVersion():
+		if nil != result.Err {
+			t.Errorf("Expected nil, received error: %v", result.Err)
+		}
+		if "HeadlessChrome/100.0.0.0" != result.Product {
+			t.Errorf("Expected 'HeadlessChrome/100.0.0.0', received '%s'", result.Product)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Timed out waiting for a response over the pipe")
+	}
+}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package socket
 
 /*
@@ -6,7 +8,7 @@ Accessibility returns the AccessibilityProtocol instance.
 Accessibility is a Protocoller implementation.
 */
 func (socket *Socket) Accessibility() *AccessibilityProtocol {
-	return socket.accessibility
+	return &AccessibilityProtocol{Socket: socket}
 }
 
 /*
@@ -15,7 +17,7 @@ Animation returns the AnimationProtocol instance.
 Animation is a Protocoller implementation.
 */
 func (socket *Socket) Animation() *AnimationProtocol {
-	return socket.animation
+	return &AnimationProtocol{Socket: socket}
 }
 
 /*
@@ -24,7 +26,7 @@ ApplicationCache returns the ApplicationCacheProtocol instance.
 ApplicationCache is a Protocoller implementation.
 */
 func (socket *Socket) ApplicationCache() *ApplicationCacheProtocol {
-	return socket.applicationCache
+	return &ApplicationCacheProtocol{Socket: socket}
 }
 
 /*
@@ -33,7 +35,34 @@ Audits returns the AuditsProtocol instance.
 Audits is a Protocoller implementation.
 */
 func (socket *Socket) Audits() *AuditsProtocol {
-	return socket.audits
+	return &AuditsProtocol{Socket: socket}
+}
+
+/*
+Autofill returns the AutofillProtocol instance.
+
+Autofill is a Protocoller implementation.
+*/
+func (socket *Socket) Autofill() *AutofillProtocol {
+	return &AutofillProtocol{Socket: socket}
+}
+
+/*
+BackgroundService returns the BackgroundServiceProtocol instance.
+
+BackgroundService is a Protocoller implementation.
+*/
+func (socket *Socket) BackgroundService() *BackgroundServiceProtocol {
+	return &BackgroundServiceProtocol{Socket: socket}
+}
+
+/*
+BluetoothEmulation returns the BluetoothEmulationProtocol instance.
+
+BluetoothEmulation is a Protocoller implementation.
+*/
+func (socket *Socket) BluetoothEmulation() *BluetoothEmulationProtocol {
+	return &BluetoothEmulationProtocol{Socket: socket}
 }
 
 /*
@@ -42,7 +71,16 @@ Browser returns the BrowserProtocol instance.
 Browser is a Protocoller implementation.
 */
 func (socket *Socket) Browser() *BrowserProtocol {
-	return socket.browser
+	return &BrowserProtocol{Socket: socket}
+}
+
+/*
+CSS returns the CSSProtocol instance.
+
+CSS is a Protocoller implementation.
+*/
+func (socket *Socket) CSS() *CSSProtocol {
+	return &CSSProtocol{Socket: socket}
 }
 
 /*
@@ -51,7 +89,16 @@ CacheStorage returns the CacheStorageProtocol instance.
 CacheStorage is a Protocoller implementation.
 */
 func (socket *Socket) CacheStorage() *CacheStorageProtocol {
-	return socket.cacheStorage
+	return &CacheStorageProtocol{Socket: socket}
+}
+
+/*
+Cast returns the CastProtocol instance.
+
+Cast is a Protocoller implementation.
+*/
+func (socket *Socket) Cast() *CastProtocol {
+	return &CastProtocol{Socket: socket}
 }
 
 /*
@@ -60,16 +107,43 @@ Console returns the ConsoleProtocol instance.
 Console is a Protocoller implementation.
 */
 func (socket *Socket) Console() *ConsoleProtocol {
-	return socket.console
+	return &ConsoleProtocol{Socket: socket}
 }
 
 /*
-CSS returns the CSSProtocol instance.
+DOM returns the DOMProtocol instance.
 
-CSS is a Protocoller implementation.
+DOM is a Protocoller implementation.
 */
-func (socket *Socket) CSS() *CSSProtocol {
-	return socket.css
+func (socket *Socket) DOM() *DOMProtocol {
+	return &DOMProtocol{Socket: socket}
+}
+
+/*
+DOMDebugger returns the DOMDebuggerProtocol instance.
+
+DOMDebugger is a Protocoller implementation.
+*/
+func (socket *Socket) DOMDebugger() *DOMDebuggerProtocol {
+	return &DOMDebuggerProtocol{Socket: socket}
+}
+
+/*
+DOMSnapshot returns the DOMSnapshotProtocol instance.
+
+DOMSnapshot is a Protocoller implementation.
+*/
+func (socket *Socket) DOMSnapshot() *DOMSnapshotProtocol {
+	return &DOMSnapshotProtocol{Socket: socket}
+}
+
+/*
+DOMStorage returns the DOMStorageProtocol instance.
+
+DOMStorage is a Protocoller implementation.
+*/
+func (socket *Socket) DOMStorage() *DOMStorageProtocol {
+	return &DOMStorageProtocol{Socket: socket}
 }
 
 /*
@@ -78,7 +152,7 @@ Database returns the DatabaseProtocol instance.
 Database is a Protocoller implementation.
 */
 func (socket *Socket) Database() *DatabaseProtocol {
-	return socket.database
+	return &DatabaseProtocol{Socket: socket}
 }
 
 /*
@@ -87,7 +161,16 @@ Debugger returns the DebuggerProtocol instance.
 Debugger is a Protocoller implementation.
 */
 func (socket *Socket) Debugger() *DebuggerProtocol {
-	return socket.debugger
+	return &DebuggerProtocol{Socket: socket}
+}
+
+/*
+DeviceAccess returns the DeviceAccessProtocol instance.
+
+DeviceAccess is a Protocoller implementation.
+*/
+func (socket *Socket) DeviceAccess() *DeviceAccessProtocol {
+	return &DeviceAccessProtocol{Socket: socket}
 }
 
 /*
@@ -96,52 +179,61 @@ DeviceOrientation returns the DeviceOrientationProtocol instance.
 DeviceOrientation is a Protocoller implementation.
 */
 func (socket *Socket) DeviceOrientation() *DeviceOrientationProtocol {
-	return socket.deviceOrientation
+	return &DeviceOrientationProtocol{Socket: socket}
 }
 
 /*
-DOMDebugger returns the DOMDebuggerProtocol instance.
+Emulation returns the EmulationProtocol instance.
 
-DOMDebugger is a Protocoller implementation.
+Emulation is a Protocoller implementation.
 */
-func (socket *Socket) DOMDebugger() *DOMDebuggerProtocol {
-	return socket.domDebugger
+func (socket *Socket) Emulation() *EmulationProtocol {
+	return &EmulationProtocol{Socket: socket}
 }
 
 /*
-DOMSnapshot returns the DOMSnapshotProtocol instance.
+EventBreakpoints returns the EventBreakpointsProtocol instance.
 
-DOMSnapshot is a Protocoller implementation.
+EventBreakpoints is a Protocoller implementation.
 */
-func (socket *Socket) DOMSnapshot() *DOMSnapshotProtocol {
-	return socket.domSnapshot
+func (socket *Socket) EventBreakpoints() *EventBreakpointsProtocol {
+	return &EventBreakpointsProtocol{Socket: socket}
 }
 
 /*
-DOMStorage returns the DOMStorageProtocol instance.
+Extensions returns the ExtensionsProtocol instance.
 
-DOMStorage is a Protocoller implementation.
+Extensions is a Protocoller implementation.
 */
-func (socket *Socket) DOMStorage() *DOMStorageProtocol {
-	return socket.domStorage
+func (socket *Socket) Extensions() *ExtensionsProtocol {
+	return &ExtensionsProtocol{Socket: socket}
 }
 
 /*
-DOM returns the DOMProtocol instance.
+FedCm returns the FedCmProtocol instance.
 
-DOM is a Protocoller implementation.
+FedCm is a Protocoller implementation.
 */
-func (socket *Socket) DOM() *DOMProtocol {
-	return socket.dom
+func (socket *Socket) FedCm() *FedCmProtocol {
+	return &FedCmProtocol{Socket: socket}
 }
 
 /*
-Emulation returns the EmulationProtocol instance.
+Fetch returns the FetchProtocol instance.
 
-Emulation is a Protocoller implementation.
+Fetch is a Protocoller implementation.
 */
-func (socket *Socket) Emulation() *EmulationProtocol {
-	return socket.emulation
+func (socket *Socket) Fetch() *FetchProtocol {
+	return &FetchProtocol{Socket: socket}
+}
+
+/*
+FileSystem returns the FileSystemProtocol instance.
+
+FileSystem is a Protocoller implementation.
+*/
+func (socket *Socket) FileSystem() *FileSystemProtocol {
+	return &FileSystemProtocol{Socket: socket}
 }
 
 /*
@@ -150,7 +242,7 @@ HeadlessExperimental returns the HeadlessExperimentalProtocol instance.
 HeadlessExperimental is a Protocoller implementation.
 */
 func (socket *Socket) HeadlessExperimental() *HeadlessExperimentalProtocol {
-	return socket.headlessExperimental
+	return &HeadlessExperimentalProtocol{Socket: socket}
 }
 
 /*
@@ -159,7 +251,16 @@ HeapProfiler returns the HeapProfilerProtocol instance.
 HeapProfiler is a Protocoller implementation.
 */
 func (socket *Socket) HeapProfiler() *HeapProfilerProtocol {
-	return socket.heapProfiler
+	return &HeapProfilerProtocol{Socket: socket}
+}
+
+/*
+IO returns the IOProtocol instance.
+
+IO is a Protocoller implementation.
+*/
+func (socket *Socket) IO() *IOProtocol {
+	return &IOProtocol{Socket: socket}
 }
 
 /*
@@ -168,7 +269,7 @@ IndexedDB returns the IndexedDBProtocol instance.
 IndexedDB is a Protocoller implementation.
 */
 func (socket *Socket) IndexedDB() *IndexedDBProtocol {
-	return socket.indexedDB
+	return &IndexedDBProtocol{Socket: socket}
 }
 
 /*
@@ -177,16 +278,16 @@ Input returns the InputProtocol instance.
 Input is a Protocoller implementation.
 */
 func (socket *Socket) Input() *InputProtocol {
-	return socket.input
+	return &InputProtocol{Socket: socket}
 }
 
 /*
-IO returns the IOProtocol instance.
+Inspector returns the InspectorProtocol instance.
 
-IO is a Protocoller implementation.
+Inspector is a Protocoller implementation.
 */
-func (socket *Socket) IO() *IOProtocol {
-	return socket.io
+func (socket *Socket) Inspector() *InspectorProtocol {
+	return &InspectorProtocol{Socket: socket}
 }
 
 /*
@@ -195,7 +296,7 @@ LayerTree returns the LayerTreeProtocol instance.
 LayerTree is a Protocoller implementation.
 */
 func (socket *Socket) LayerTree() *LayerTreeProtocol {
-	return socket.layerTree
+	return &LayerTreeProtocol{Socket: socket}
 }
 
 /*
@@ -204,7 +305,16 @@ Log returns the LogProtocol instance.
 Log is a Protocoller implementation.
 */
 func (socket *Socket) Log() *LogProtocol {
-	return socket.log
+	return &LogProtocol{Socket: socket}
+}
+
+/*
+Media returns the MediaProtocol instance.
+
+Media is a Protocoller implementation.
+*/
+func (socket *Socket) Media() *MediaProtocol {
+	return &MediaProtocol{Socket: socket}
 }
 
 /*
@@ -213,7 +323,7 @@ Memory returns the MemoryProtocol instance.
 Memory is a Protocoller implementation.
 */
 func (socket *Socket) Memory() *MemoryProtocol {
-	return socket.memory
+	return &MemoryProtocol{Socket: socket}
 }
 
 /*
@@ -222,7 +332,7 @@ Network returns the NetworkProtocol instance.
 Network is a Protocoller implementation.
 */
 func (socket *Socket) Network() *NetworkProtocol {
-	return socket.network
+	return &NetworkProtocol{Socket: socket}
 }
 
 /*
@@ -231,7 +341,16 @@ Overlay returns the OverlayProtocol instance.
 Overlay is a Protocoller implementation.
 */
 func (socket *Socket) Overlay() *OverlayProtocol {
-	return socket.overlay
+	return &OverlayProtocol{Socket: socket}
+}
+
+/*
+PWA returns the PWAProtocol instance.
+
+PWA is a Protocoller implementation.
+*/
+func (socket *Socket) PWA() *PWAProtocol {
+	return &PWAProtocol{Socket: socket}
 }
 
 /*
@@ -240,7 +359,7 @@ Page returns the PageProtocol instance.
 Page is a Protocoller implementation.
 */
 func (socket *Socket) Page() *PageProtocol {
-	return socket.page
+	return &PageProtocol{Socket: socket}
 }
 
 /*
@@ -249,7 +368,25 @@ Performance returns the PerformanceProtocol instance.
 Performance is a Protocoller implementation.
 */
 func (socket *Socket) Performance() *PerformanceProtocol {
-	return socket.performance
+	return &PerformanceProtocol{Socket: socket}
+}
+
+/*
+PerformanceTimeline returns the PerformanceTimelineProtocol instance.
+
+PerformanceTimeline is a Protocoller implementation.
+*/
+func (socket *Socket) PerformanceTimeline() *PerformanceTimelineProtocol {
+	return &PerformanceTimelineProtocol{Socket: socket}
+}
+
+/*
+Preload returns the PreloadProtocol instance.
+
+Preload is a Protocoller implementation.
+*/
+func (socket *Socket) Preload() *PreloadProtocol {
+	return &PreloadProtocol{Socket: socket}
 }
 
 /*
@@ -258,7 +395,7 @@ Profiler returns the ProfilerProtocol instance.
 Profiler is a Protocoller implementation.
 */
 func (socket *Socket) Profiler() *ProfilerProtocol {
-	return socket.profiler
+	return &ProfilerProtocol{Socket: socket}
 }
 
 /*
@@ -267,7 +404,7 @@ Runtime returns the RuntimeProtocol instance.
 Runtime is a Protocoller implementation.
 */
 func (socket *Socket) Runtime() *RuntimeProtocol {
-	return socket.runtime
+	return &RuntimeProtocol{Socket: socket}
 }
 
 /*
@@ -276,7 +413,7 @@ Schema returns the SchemaProtocol instance.
 Schema is a Protocoller implementation.
 */
 func (socket *Socket) Schema() *SchemaProtocol {
-	return socket.schema
+	return &SchemaProtocol{Socket: socket}
 }
 
 /*
@@ -285,7 +422,7 @@ Security returns the SecurityProtocol instance.
 Security is a Protocoller implementation.
 */
 func (socket *Socket) Security() *SecurityProtocol {
-	return socket.security
+	return &SecurityProtocol{Socket: socket}
 }
 
 /*
@@ -294,7 +431,7 @@ ServiceWorker returns the ServiceWorkerProtocol instance.
 ServiceWorker is a Protocoller implementation.
 */
 func (socket *Socket) ServiceWorker() *ServiceWorkerProtocol {
-	return socket.serviceWorker
+	return &ServiceWorkerProtocol{Socket: socket}
 }
 
 /*
@@ -303,7 +440,7 @@ Storage returns the StorageProtocol instance.
 Storage is a Protocoller implementation.
 */
 func (socket *Socket) Storage() *StorageProtocol {
-	return socket.storage
+	return &StorageProtocol{Socket: socket}
 }
 
 /*
@@ -312,7 +449,7 @@ SystemInfo returns the SystemInfoProtocol instance.
 SystemInfo is a Protocoller implementation.
 */
 func (socket *Socket) SystemInfo() *SystemInfoProtocol {
-	return socket.systemInfo
+	return &SystemInfoProtocol{Socket: socket}
 }
 
 /*
@@ -321,7 +458,7 @@ Target returns the TargetProtocol instance.
 Target is a Protocoller implementation.
 */
 func (socket *Socket) Target() *TargetProtocol {
-	return socket.target
+	return &TargetProtocol{Socket: socket}
 }
 
 /*
@@ -330,7 +467,7 @@ Tethering returns the TetheringProtocol instance.
 Tethering is a Protocoller implementation.
 */
 func (socket *Socket) Tethering() *TetheringProtocol {
-	return socket.tethering
+	return &TetheringProtocol{Socket: socket}
 }
 
 /*
@@ -339,5 +476,23 @@ Tracing returns the TracingProtocol instance.
 Tracing is a Protocoller implementation.
 */
 func (socket *Socket) Tracing() *TracingProtocol {
-	return socket.tracing
+	return &TracingProtocol{Socket: socket}
+}
+
+/*
+WebAudio returns the WebAudioProtocol instance.
+
+WebAudio is a Protocoller implementation.
+*/
+func (socket *Socket) WebAudio() *WebAudioProtocol {
+	return &WebAudioProtocol{Socket: socket}
+}
+
+/*
+WebAuthn returns the WebAuthnProtocol instance.
+
+WebAuthn is a Protocoller implementation.
+*/
+func (socket *Socket) WebAuthn() *WebAuthnProtocol {
+	return &WebAuthnProtocol{Socket: socket}
 }
@@ -0,0 +1,88 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+/*
+Call sends a protocol command by name and waits for the response or for ctx
+to end, whichever happens first. It supports protocol methods that have no
+typed wrapper, for example methods added in newer Chromium versions:
+
+	result := struct {
+		Product string `json:"product"`
+	}{}
+	err := socket.Call(ctx, "Browser.getVersion", nil, &result)
+
+params is marshalled to JSON as the command parameters and may be nil. If
+result is not nil the JSON result is unmarshalled into it. A protocol error
+response is returned as an *Error.
+
+Call is a Socketer implementation.
+*/
+func (socket *Socket) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	command := NewCommand(socket, method, params)
+	response, err := socket.SendCommandContext(ctx, command)
+	if nil != err {
+		return err
+	}
+	if nil != response.Error && 0 != response.Error.Code {
+		return response.Error
+	}
+	if nil != result && len(response.Result) > 0 {
+		if err := json.Unmarshal(response.Result, result); nil != err {
+			return errs.Wrap(err, codes.SocketReadFailed, fmt.Sprintf("could not unmarshal the '%s' result", method))
+		}
+	}
+	return nil
+}
+
+/*
+Subscribe returns a channel that receives the raw JSON parameters of each
+event named by method, for example "Page.lifecycleEvent". It supports events
+that have no typed wrapper.
+
+Events are delivered in the order they are received, whether or not a
+DispatchPolicy is set. Up to DefaultChannelSize events are buffered in the
+channel, and more wait in an event queue that uses the socket's DispatchPolicy,
+or the default policy. If a subscriber stops reading and the queue fills up
+the policy's Overflow applies; OverflowBlock stops reading from the connection
+until the subscriber catches up. Calling cancel removes the subscription and
+closes the channel.
+
+Subscribe is a Socketer implementation.
+*/
+func (socket *Socket) Subscribe(method string) (<-chan json.RawMessage, func()) {
+	events := make(chan json.RawMessage, DefaultChannelSize)
+	done := make(chan struct{})
+	mux := &sync.RWMutex{}
+
+	handler := NewEventHandler(method, func(response *Response) {
+		mux.RLock()
+		defer mux.RUnlock()
+		select {
+		case <-done:
+		case events <- response.Params:
+		}
+	})
+	socket.addQueuedEventHandler(handler)
+
+	once := &sync.Once{}
+	cancel := func() {
+		once.Do(func() {
+			close(done)
+			socket.RemoveEventHandler(handler)
+			// Wait for handlers that are delivering an event to return.
+			mux.Lock()
+			close(events)
+			mux.Unlock()
+		})
+	}
+	return events, cancel
+}
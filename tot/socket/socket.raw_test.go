@@ -0,0 +1,138 @@
+package socket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSocketCall(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketCall")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	type result struct {
+		Product string `json:"product"`
+	}
+	params := map[string]interface{}{"newField": true}
+
+	resultChan := make(chan *result, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		res := &result{}
+		errChan <- mockSocket.Call(context.Background(), "Browser.getNewThing", params, res)
+		resultChan <- res
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	payload := lastPayload(mockSocket)
+	if nil == payload || "Browser.getNewThing" != payload.Method {
+		t.Fatalf("Expected Browser.getNewThing payload, found %v", payload)
+	}
+	if true != payload.Params.(map[string]interface{})["newField"] {
+		t.Errorf("Expected the command parameters to be sent, found %v", payload.Params)
+	}
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     payload.ID,
+		Error:  &Error{},
+		Result: []byte(`{"product":"HeadlessChrome/100.0.4896.60"}`),
+	})
+	if err := <-errChan; nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if res := <-resultChan; "HeadlessChrome/100.0.4896.60" != res.Product {
+		t.Errorf("Expected 'HeadlessChrome/100.0.4896.60', received '%s'", res.Product)
+	}
+
+	// Protocol errors
+	go func() {
+		errChan <- mockSocket.Call(context.Background(), "Browser.getNewThing", nil, nil)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID: lastPayload(mockSocket).ID,
+		Error: &Error{
+			Code:    -32601,
+			Message: "'Browser.getNewThing' wasn't found",
+		},
+	})
+	err := <-errChan
+	if protocolErr, ok := err.(*Error); !ok || -32601 != protocolErr.Code {
+		t.Errorf("Expected a protocol error, received %v", err)
+	}
+
+	// Cancellation
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := mockSocket.Call(ctx, "Browser.getNewThing", nil, nil); nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+}
+
+func TestSocketSubscribe(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketSubscribe")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	events, cancel := mockSocket.Subscribe("Page.newThingHappened")
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Page.newThingHappened",
+		Params: []byte(`{"thing":1}`),
+	})
+	select {
+	case params := <-events:
+		if `{"thing":1}` != string(params) {
+			t.Errorf("Expected '{\"thing\":1}', received '%s'", params)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected an event")
+	}
+
+	// A cancelled subscription is closed and doesn't block delivery.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Page.newThingHappened",
+		Params: []byte(`{"thing":2}`),
+	})
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	cancel()
+	for range events {
+	}
+	handlers, _ := mockSocket.handlers.Get("Page.newThingHappened")
+	if 0 != len(handlers) {
+		t.Errorf("Expected the event handler to be removed, found %d", len(handlers))
+	}
+}
+
+func TestSocketSubscribeOrder(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketSubscribeOrder")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	events, cancel := mockSocket.Subscribe("Page.newThingHappened")
+	defer cancel()
+	// The subscription keeps its queue when the dispatch policy changes.
+	mockSocket.SetDispatchPolicy(nil)
+	emitThings(mockSocket, 1, 20)
+	time.Sleep(400 * time.Millisecond)
+	if 20 != len(events) {
+		t.Fatalf("Expected 20 buffered events, found %d", len(events))
+	}
+	for a := 1; a <= 20; a++ {
+		if expected, params := fmt.Sprintf(`{"thing":%d}`, a), <-events; expected != string(params) {
+			t.Fatalf("Expected '%s', received '%s'", expected, params)
+		}
+	}
+	if 1 != countQueues(mockSocket) {
+		t.Errorf("Expected the subscription queue, found %d queues", countQueues(mockSocket))
+	}
+	cancel()
+	if 0 != countQueues(mockSocket) {
+		t.Errorf("Expected the cancelled subscription queue to be removed")
+	}
+}
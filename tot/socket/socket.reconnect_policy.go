@@ -0,0 +1,76 @@
+package socket
+
+import (
+	"time"
+)
+
+/*
+ReconnectPolicy defines how a Socket attempts to re-establish a dropped
+websocket connection. Delays between attempts grow exponentially from
+InitialInterval by Multiplier and are capped at MaxInterval.
+*/
+type ReconnectPolicy struct {
+	// Optional. InitialInterval is the delay before the first reconnection
+	// attempt. Defaults to 100ms.
+	InitialInterval time.Duration
+
+	// Optional. MaxAttempts is the number of reconnection attempts made before
+	// the socket is closed. A value of 0 retries until the socket is stopped.
+	MaxAttempts int
+
+	// Optional. MaxInterval is the maximum delay between reconnection
+	// attempts. Defaults to 30s.
+	MaxInterval time.Duration
+
+	// Optional. Multiplier is the factor the delay grows by after each failed
+	// attempt. Defaults to 2.
+	Multiplier float64
+}
+
+/*
+NewReconnectPolicy returns a pointer to a ReconnectPolicy with the default
+backoff settings that retries up to maxAttempts times.
+*/
+func NewReconnectPolicy(maxAttempts int) *ReconnectPolicy {
+	return &ReconnectPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxAttempts:     maxAttempts,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+	}
+}
+
+/*
+Backoff returns the delay before the specified reconnection attempt. Attempts
+are numbered from 1.
+*/
+func (policy *ReconnectPolicy) Backoff(attempt int) time.Duration {
+	interval := policy.InitialInterval
+	if interval <= 0 {
+		interval = 100 * time.Millisecond
+	}
+	maxInterval := policy.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(interval)
+	for a := 1; a < attempt; a++ {
+		delay *= multiplier
+		if delay >= float64(maxInterval) {
+			return maxInterval
+		}
+	}
+	return time.Duration(delay)
+}
+
+/*
+Retry returns whether another reconnection attempt should be made.
+*/
+func (policy *ReconnectPolicy) Retry(attempt int) bool {
+	return policy.MaxAttempts <= 0 || attempt <= policy.MaxAttempts
+}
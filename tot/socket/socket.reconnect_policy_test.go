@@ -0,0 +1,47 @@
+package socket
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconnectPolicyBackoff(t *testing.T) {
+	policy := &ReconnectPolicy{
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     time.Second,
+		Multiplier:      2,
+	}
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	for a, delay := range expected {
+		if backoff := policy.Backoff(a + 1); delay != backoff {
+			t.Errorf("Attempt %d: expected %s, received %s", a+1, delay, backoff)
+		}
+	}
+
+	policy = &ReconnectPolicy{}
+	if backoff := policy.Backoff(1); 100*time.Millisecond != backoff {
+		t.Errorf("Expected default of 100ms, received %s", backoff)
+	}
+}
+
+func TestReconnectPolicyRetry(t *testing.T) {
+	policy := NewReconnectPolicy(2)
+	if !policy.Retry(1) || !policy.Retry(2) {
+		t.Errorf("Expected retries for attempts 1 and 2")
+	}
+	if policy.Retry(3) {
+		t.Errorf("Expected no retry for attempt 3")
+	}
+
+	policy = NewReconnectPolicy(0)
+	if !policy.Retry(1000) {
+		t.Errorf("Expected unlimited retries")
+	}
+}
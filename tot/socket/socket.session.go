@@ -0,0 +1,171 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/bdlm/log"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/optional"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+/*
+Attach attaches to the specified target using a flattened session and returns a
+socket bound to that session. The session shares this socket's connection, so
+many targets (pages, iframes, workers, etc.) can be driven over a single
+browser-level websocket. The returned socket implements the full Socketer and
+Protocoller interfaces.
+*/
+func (socket *Socket) Attach(ctx context.Context, targetID target.ID) (*Socket, error) {
+	if nil != socket.parent {
+		return socket.parent.Attach(ctx, targetID)
+	}
+	result := <-socket.Target().WithContext(ctx).AttachToTarget(&target.AttachToTargetParams{
+		ID:      targetID,
+		Flatten: optional.Bool(true),
+	})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.SocketAttachFailed, fmt.Sprintf("could not attach to target '%s'", targetID))
+	}
+	return socket.NewSession(string(result.SessionID)), nil
+}
+
+/*
+NewSession returns a socket bound to an existing flattened session. Commands
+sent through the session socket include the session ID, and messages received
+for the session are routed to it.
+*/
+func (socket *Socket) NewSession(sessionID string) *Socket {
+	if nil != socket.parent {
+		return socket.parent.NewSession(sessionID)
+	}
+
+	session := initSocket(socket.url, socket.newSocket)
+	session.parent = socket
+	session.sessionID = sessionID
+	session.state = ConnectionConnected
+
+	socket.mux.Lock()
+	session.dispatchPolicy = socket.dispatchPolicy
+	if nil == socket.sessions {
+		socket.sessions = make(map[string]*Socket)
+	}
+	socket.sessions[sessionID] = session
+	socket.mux.Unlock()
+
+	log.WithFields(log.Fields{"sessionID": sessionID, "socketID": socket.socketID}).
+		Debug("session attached")
+	return session
+}
+
+/*
+SessionID returns the ID of the flattened session the socket is bound to, or an
+empty string if the socket owns its connection.
+*/
+func (socket *Socket) SessionID() string {
+	return socket.sessionID
+}
+
+/*
+Sessions returns the flattened session sockets attached through this socket.
+*/
+func (socket *Socket) Sessions() []*Socket {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	sessions := make([]*Socket, 0, len(socket.sessions))
+	for _, session := range socket.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+/*
+closeSession fails any pending session commands and marks the session closed.
+*/
+func (socket *Socket) closeSession() {
+	socket.cancel()
+	socket.failCommands(codes.SocketDisconnected, "session detached before a response was received")
+	socket.setState(ConnectionClosed, 0, nil)
+}
+
+/*
+closeSessions closes every attached session. Sessions don't survive the loss of
+the parent connection.
+*/
+func (socket *Socket) closeSessions() {
+	socket.mux.Lock()
+	sessions := socket.sessions
+	socket.sessions = make(map[string]*Socket)
+	socket.mux.Unlock()
+
+	for _, session := range sessions {
+		session.closeSession()
+	}
+}
+
+/*
+detach detaches a session socket from its target.
+*/
+func (socket *Socket) detach() {
+	parent := socket.parent
+	if nil == parent.removeSession(socket.sessionID) {
+		return
+	}
+	log.WithFields(log.Fields{"sessionID": socket.sessionID, "socketID": parent.socketID}).
+		Info("detaching session")
+	if nil == parent.ctx.Err() {
+		parent.SendCommand(NewCommand(parent, "Target.detachFromTarget", &target.DetachFromTargetParams{
+			SessionID: optional.Of(target.SessionID(socket.sessionID)),
+		}))
+	}
+	socket.closeSession()
+}
+
+/*
+detachedFromTargetEvent is the part of the Target.detachedFromTarget event used
+to close sessions. The event is experimental, so the stable target package
+does not define it.
+*/
+type detachedFromTargetEvent struct {
+	SessionID string `json:"sessionId"`
+}
+
+/*
+detachSession closes the session named in a Target.detachedFromTarget event.
+*/
+func (socket *Socket) detachSession(response *Response) {
+	event := &detachedFromTargetEvent{}
+	if err := json.Unmarshal([]byte(response.Params), event); nil != err {
+		return
+	}
+	if session := socket.removeSession(event.SessionID); nil != session {
+		session.closeSession()
+	}
+}
+
+/*
+removeSession removes a session from the session map and returns it, or nil if
+it isn't attached.
+*/
+func (socket *Socket) removeSession(sessionID string) *Socket {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	session, ok := socket.sessions[sessionID]
+	if !ok {
+		return nil
+	}
+	delete(socket.sessions, sessionID)
+	return session
+}
+
+/*
+session returns the attached session with the specified ID, or nil.
+*/
+func (socket *Socket) session(sessionID string) *Socket {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.sessions[sessionID]
+}
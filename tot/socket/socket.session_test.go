@@ -0,0 +1,127 @@
+package socket
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
+func lastPayload(mockSocket *Socket) *Payload {
+	payloads := mockSocket.Conn().(*MockChromeWebSocket).Payloads()
+	if 0 == len(payloads) {
+		return nil
+	}
+	payload, _ := payloads[len(payloads)-1].(*Payload)
+	return payload
+}
+
+func TestSocketAttach(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketAttach")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	sessionChan := make(chan *Socket, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		session, err := mockSocket.Attach(context.Background(), target.ID("target-1"))
+		sessionChan <- session
+		errChan <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	payload := lastPayload(mockSocket)
+	if nil == payload || "Target.attachToTarget" != payload.Method {
+		t.Fatalf("Expected Target.attachToTarget payload, found %v", payload)
+	}
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     payload.ID,
+		Result: []byte(`{"sessionId":"session-1"}`),
+	})
+
+	session := <-sessionChan
+	if err := <-errChan; nil != err {
+		t.Fatalf("Expected nil error, received %v", err)
+	}
+	if "session-1" != session.SessionID() {
+		t.Errorf("Expected session ID 'session-1', found '%s'", session.SessionID())
+	}
+	if 1 != len(mockSocket.Sessions()) {
+		t.Errorf("Expected 1 session, found %d", len(mockSocket.Sessions()))
+	}
+}
+
+func TestSessionRouting(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionRouting")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	session := mockSocket.NewSession("session-1")
+	resultChan := session.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com"})
+	time.Sleep(100 * time.Millisecond)
+
+	payload := lastPayload(mockSocket)
+	if nil == payload || "session-1" != payload.SessionID {
+		t.Fatalf("Expected payload for session 'session-1', found %v", payload)
+	}
+
+	eventChan := make(chan *page.LoadEventFiredEvent, 1)
+	session.Page().OnLoadEventFired(func(event *page.LoadEventFiredEvent) {
+		eventChan <- event
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method:    "Page.loadEventFired",
+		Params:    []byte(`{"timestamp":1}`),
+		SessionID: "session-1",
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:        payload.ID,
+		Result:    []byte(`{"frameId":"frame-1"}`),
+		SessionID: "session-1",
+	})
+
+	result := <-resultChan
+	if nil != result.Err {
+		t.Errorf("Expected nil error, received %v", result.Err)
+	}
+	if "frame-1" != string(result.FrameID) {
+		t.Errorf("Expected frame ID 'frame-1', found '%s'", result.FrameID)
+	}
+	select {
+	case <-eventChan:
+	case <-time.After(time.Second):
+		t.Errorf("Expected session event to be dispatched")
+	}
+}
+
+func TestSessionDetached(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSessionDetached")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	session := mockSocket.NewSession("session-1")
+	resultChan := session.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com"})
+	time.Sleep(100 * time.Millisecond)
+
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Target.detachedFromTarget",
+		Params: []byte(`{"sessionId":"session-1"}`),
+	})
+	result := <-resultChan
+	if err, ok := result.Err.(*Error); !ok || int(codes.SocketDisconnected) != err.Code {
+		t.Errorf("Expected SocketDisconnected error, received %v", result.Err)
+	}
+	if 0 != len(mockSocket.Sessions()) {
+		t.Errorf("Expected 0 sessions, found %d", len(mockSocket.Sessions()))
+	}
+	if ConnectionClosed != session.State() {
+		t.Errorf("Expected session state '%s', found '%s'", ConnectionClosed, session.State())
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"sync"
+	"time"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
+	std "github.com/bdlm/std/error"
 	"github.com/mkenney/go-chrome/codes"
 )
 
@@ -17,71 +20,53 @@ New returns a pointer to a websocket struct that implements Socketer interface
 listening to the specified URL.
 */
 func New(url *url.URL) *Socket {
+	return NewWithDialer(url, NewWebsocket)
+}
+
+/*
+NewWithDialer returns a pointer to a struct that implements the Socketer
+interface using dial to establish the underlying WebSocketer connection for the
+specified URL.
+*/
+func NewWithDialer(url *url.URL, dial func(socketURL *url.URL) (WebSocketer, error)) *Socket {
+	socket := initSocket(url, dial)
+
+	go func() {
+		err := socket.Listen()
+		if nil != err {
+			log.WithError(err).Error("could not start socket listener")
+		}
+	}()
+
+	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
+		Info("New socket connection listening")
+
+	return socket
+}
+
+/*
+initSocket returns a pointer to an initialized Socket that is not listening.
+*/
+func initSocket(url *url.URL, dial func(socketURL *url.URL) (WebSocketer, error)) *Socket {
 	ctx, cancel := context.WithCancel(context.Background())
 	socket := &Socket{
 		commandIDMux: &sync.Mutex{},
 		commands:     NewCommandMap(),
+		domains:      make([]*Payload, 0),
 		handlers:     NewEventHandlerMap(),
 		mux:          &sync.Mutex{},
-		newSocket:    NewWebsocket,
+		newSocket:    dial,
+		queues:       make(map[EventHandler]*eventQueue),
+		sessions:     make(map[string]*Socket),
 		socketID:     NextSocketID(),
 		url:          url,
+		writeMux:     &sync.Mutex{},
 
 		ctx:    ctx,
 		cancel: cancel,
 		wg:     &sync.WaitGroup{},
 	}
 
-	// Init the protocol interfaces for the API.
-	socket.accessibility = &AccessibilityProtocol{Socket: socket}
-	socket.animation = &AnimationProtocol{Socket: socket}
-	socket.applicationCache = &ApplicationCacheProtocol{Socket: socket}
-	socket.audits = &AuditsProtocol{Socket: socket}
-	socket.browser = &BrowserProtocol{Socket: socket}
-	socket.cacheStorage = &CacheStorageProtocol{Socket: socket}
-	socket.console = &ConsoleProtocol{Socket: socket}
-	socket.css = &CSSProtocol{Socket: socket}
-	socket.database = &DatabaseProtocol{Socket: socket}
-	socket.debugger = &DebuggerProtocol{Socket: socket}
-	socket.deviceOrientation = &DeviceOrientationProtocol{Socket: socket}
-	socket.domDebugger = &DOMDebuggerProtocol{Socket: socket}
-	socket.domSnapshot = &DOMSnapshotProtocol{Socket: socket}
-	socket.domStorage = &DOMStorageProtocol{Socket: socket}
-	socket.dom = &DOMProtocol{Socket: socket}
-	socket.emulation = &EmulationProtocol{Socket: socket}
-	socket.headlessExperimental = &HeadlessExperimentalProtocol{Socket: socket}
-	socket.heapProfiler = &HeapProfilerProtocol{Socket: socket}
-	socket.indexedDB = &IndexedDBProtocol{Socket: socket}
-	socket.input = &InputProtocol{Socket: socket}
-	socket.io = &IOProtocol{Socket: socket}
-	socket.layerTree = &LayerTreeProtocol{Socket: socket}
-	socket.log = &LogProtocol{Socket: socket}
-	socket.memory = &MemoryProtocol{Socket: socket}
-	socket.network = &NetworkProtocol{Socket: socket}
-	socket.overlay = &OverlayProtocol{Socket: socket}
-	socket.page = &PageProtocol{Socket: socket}
-	socket.performance = &PerformanceProtocol{Socket: socket}
-	socket.profiler = &ProfilerProtocol{Socket: socket}
-	socket.runtime = &RuntimeProtocol{Socket: socket}
-	socket.schema = &SchemaProtocol{Socket: socket}
-	socket.security = &SecurityProtocol{Socket: socket}
-	socket.serviceWorker = &ServiceWorkerProtocol{Socket: socket}
-	socket.storage = &StorageProtocol{Socket: socket}
-	socket.systemInfo = &SystemInfoProtocol{Socket: socket}
-	socket.target = &TargetProtocol{Socket: socket}
-	socket.tethering = &TetheringProtocol{Socket: socket}
-	socket.tracing = &TracingProtocol{Socket: socket}
-
-	go func() {
-		err := socket.Listen()
-		if nil != err {
-			log.WithError(err).Error("could not start socket listener")
-		}
-	}()
-
-	log.WithFields(log.Fields{"socketID": socket.socketID, "url": socket.url.String()}).
-		Info("New socket connection listening")
-
 	return socket
 }
 
@@ -115,49 +100,53 @@ type Socket struct {
 	socketID     int
 	url          *url.URL
 
+	// writeMux serializes writes to the connection. Websocket connections
+	// support a single concurrent writer.
+	writeMux *sync.Mutex
+
+	// domains is the list of domain enable commands that have succeeded, in
+	// the order they were enabled. They are replayed after a reconnect.
+	domains []*Payload
+
+	// dispatchPolicy defines ordered event delivery. If nil each event is
+	// delivered to each handler in a new goroutine.
+	dispatchPolicy *DispatchPolicy
+
+	// queues maps event handlers to their event queues when a dispatch
+	// policy is set, and Subscribe handlers to theirs.
+	queues map[EventHandler]*eventQueue
+
+	// reconnectPolicy defines how a dropped connection is re-established. If
+	// nil the socket closes when the connection drops.
+	reconnectPolicy *ReconnectPolicy
+
+	// capabilities are the protocol capabilities of the connected browser
+	// that commands and events are checked against. If nil nothing is
+	// checked.
+	capabilities *Capabilities
+
+	// state is the current connection state.
+	state ConnectionState
+
+	// crashed is set when the target crashes, before pending commands are
+	// failed.
+	crashed bool
+
+	// parent is the browser-level socket a flattened session communicates
+	// through. It is nil for sockets that own their connection.
+	parent *Socket
+
+	// sessionID is the ID of the flattened target session this socket is
+	// bound to, if any.
+	sessionID string
+
+	// sessions maps session IDs to the flattened session sockets attached
+	// through this socket.
+	sessions map[string]*Socket
+
 	ctx    context.Context
 	cancel context.CancelFunc
 	wg     *sync.WaitGroup
-
-	// Protocol interfaces for the API.
-	accessibility        *AccessibilityProtocol
-	animation            *AnimationProtocol
-	applicationCache     *ApplicationCacheProtocol
-	audits               *AuditsProtocol
-	browser              *BrowserProtocol
-	cacheStorage         *CacheStorageProtocol
-	console              *ConsoleProtocol
-	css                  *CSSProtocol
-	database             *DatabaseProtocol
-	debugger             *DebuggerProtocol
-	deviceOrientation    *DeviceOrientationProtocol
-	domDebugger          *DOMDebuggerProtocol
-	domSnapshot          *DOMSnapshotProtocol
-	domStorage           *DOMStorageProtocol
-	dom                  *DOMProtocol
-	emulation            *EmulationProtocol
-	headlessExperimental *HeadlessExperimentalProtocol
-	heapProfiler         *HeapProfilerProtocol
-	indexedDB            *IndexedDBProtocol
-	input                *InputProtocol
-	io                   *IOProtocol
-	layerTree            *LayerTreeProtocol
-	log                  *LogProtocol
-	memory               *MemoryProtocol
-	network              *NetworkProtocol
-	overlay              *OverlayProtocol
-	page                 *PageProtocol
-	performance          *PerformanceProtocol
-	profiler             *ProfilerProtocol
-	runtime              *RuntimeProtocol
-	schema               *SchemaProtocol
-	security             *SecurityProtocol
-	serviceWorker        *ServiceWorkerProtocol
-	storage              *StorageProtocol
-	systemInfo           *SystemInfoProtocol
-	target               *TargetProtocol
-	tethering            *TetheringProtocol
-	tracing              *TracingProtocol
 }
 
 /*
@@ -183,6 +172,38 @@ func (socket *Socket) CurCommandID() int {
 	return id
 }
 
+/*
+Crashed returns whether the target crashed. It is set before the pending
+commands fail, so Crashed returns true for any command that failed with
+codes.SocketTargetCrashed.
+
+Crashed is a Socketer implementation.
+*/
+func (socket *Socket) Crashed() bool {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	return socket.crashed
+}
+
+/*
+failCommands completes every pending command with an error. Chrome will never
+respond to commands that were pending when the connection closed or the target
+crashed.
+*/
+func (socket *Socket) failCommands(code std.Code, message string) {
+	for _, command := range socket.commands.Clear() {
+		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
+			Debug(message)
+		command.Respond(&Response{
+			Error: &Error{
+				Code:    int(code),
+				Message: message,
+			},
+			ID: command.ID(),
+		})
+	}
+}
+
 /*
 handleResponse receives the responses to requests sent to the websocket
 connection.
@@ -197,8 +218,9 @@ func (socket *Socket) handleResponse(response *Response) {
 	} else {
 		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
 			Debug("executing handler")
-		command.Respond(response)
 		socket.commands.Delete(command.ID())
+		socket.trackDomain(command, response)
+		command.Respond(response)
 		log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID, "url": socket.url.String()}).
 			Debug("Command complete")
 	}
@@ -217,6 +239,14 @@ func (socket *Socket) handleEvent(
 	if response.Method == "Inspector.targetCrashed" {
 		log.WithFields(log.Fields{"socketID": socket.socketID}).
 			Error("Chrome has crashed!")
+		socket.mux.Lock()
+		socket.crashed = true
+		socket.mux.Unlock()
+		socket.failCommands(codes.SocketTargetCrashed, "target crashed before a response was received")
+	}
+
+	if response.Method == "Target.detachedFromTarget" && nil == socket.parent {
+		socket.detachSession(response)
 	}
 
 	if handlers, err := socket.handlers.Get(response.Method); nil != err {
@@ -226,7 +256,11 @@ func (socket *Socket) handleEvent(
 		for a, event := range handlers {
 			log.WithFields(log.Fields{"event": response.Method, "handler#": a, "socketID": socket.socketID}).
 				Info("Executing handler")
-			go event.Handle(response)
+			if queue, ok := socket.queueFor(event); nil != queue {
+				queue.push(response)
+			} else if ok {
+				go event.Handle(response)
+			}
 		}
 	}
 }
@@ -268,6 +302,11 @@ Listen is a Socketer implementation.
 func (socket *Socket) Listen() error {
 	var err error
 
+	// Session messages are read and routed by the parent socket.
+	if nil != socket.parent {
+		return nil
+	}
+
 	// recover socket panics caused by defunct or dead connections. This
 	// also returns the error signal.
 	defer func() {
@@ -286,12 +325,14 @@ func (socket *Socket) Listen() error {
 		return errs.Wrap(err, 0, "socket connection failed")
 	}
 	defer socket.Disconnect()
+	socket.setState(ConnectionConnected, 0, nil)
 
 	socket.wg.Add(1)
 	defer socket.wg.Done()
 
-	readCh := make(chan *Response) // websocket data
-	errCh := make(chan error)      // websocket errors
+	// Buffered so the background reader can always exit.
+	readCh := make(chan *Response, 1) // websocket data
+	errCh := make(chan error, 1)      // websocket errors
 	for {
 
 		// Fetch the next socket response in the background.
@@ -309,45 +350,79 @@ func (socket *Socket) Listen() error {
 		// Shutdown when signaled.
 		case <-socket.ctx.Done():
 			log.WithField("socketID", socket.socketID).Debug("shutting down socket listener")
+			socket.failCommands(codes.SocketDisconnected, "socket closed before a response was received")
+			socket.closeSessions()
+			socket.setState(ConnectionClosed, 0, nil)
 			return nil
 
 		// Process any errors
 		case err := <-errCh:
-			return err
+			socket.failCommands(codes.SocketDisconnected, "connection lost before a response was received")
+			socket.closeSessions()
+			if nil != socket.ctx.Err() {
+				socket.setState(ConnectionClosed, 0, nil)
+				return nil
+			}
+			if reconnectErr := socket.reconnect(err); nil != reconnectErr {
+				return reconnectErr
+			}
+			if nil != socket.ctx.Err() {
+				socket.setState(ConnectionClosed, 0, nil)
+				return nil
+			}
 
 		// Process the next socket response.
 		case response := <-readCh:
-			if 0 == response.ID &&
-				"" == response.Method &&
-				0 == len(response.Params) &&
-				0 == len(response.Result) {
-				log.WithFields(log.Fields{"socketID": socket.socketID}).
-					Debug("nil response from socket")
-			}
+			socket.dispatch(response)
+		}
+	}
+}
 
-			if response.ID > 0 {
-				log.WithFields(log.Fields{"responseID": response.ID, "socketID": socket.socketID}).
-					Debug("sending to command handler")
-				socket.handleResponse(response)
+/*
+dispatch delivers a socket message to handleResponse(), handleEvent() or
+handleUnknown() as appropriate. Messages for a flattened session are delivered
+to the session socket.
+*/
+func (socket *Socket) dispatch(response *Response) {
+	if "" != response.SessionID && nil == socket.parent {
+		if session := socket.session(response.SessionID); nil != session {
+			session.dispatch(response)
+		} else {
+			log.WithFields(log.Fields{"method": response.Method, "responseID": response.ID, "sessionID": response.SessionID, "socketID": socket.socketID}).
+				Debug("message for unknown session")
+		}
+		return
+	}
 
-			} else if "" != response.Method {
-				log.WithFields(log.Fields{"method": response.Method, "socketID": socket.socketID}).
-					Debug("sending to event handler")
-				socket.handleEvent(response)
+	if 0 == response.ID &&
+		"" == response.Method &&
+		0 == len(response.Params) &&
+		0 == len(response.Result) {
+		log.WithFields(log.Fields{"socketID": socket.socketID}).
+			Debug("nil response from socket")
+	}
 
-			} else {
-				tmp, _ := json.Marshal(response)
-				log.WithFields(log.Fields{"data": string(tmp), "method": response.Method, "responseID": response.ID, "socketID": socket.socketID}).
-					Error("Unknown response from web socket")
-
-				if nil == response.Error {
-					response.Error = &Error{
-						Message: "Unknown response from web socket",
-					}
-				}
-				socket.handleUnknown(response)
+	if response.ID > 0 {
+		log.WithFields(log.Fields{"responseID": response.ID, "socketID": socket.socketID}).
+			Debug("sending to command handler")
+		socket.handleResponse(response)
+
+	} else if "" != response.Method {
+		log.WithFields(log.Fields{"method": response.Method, "socketID": socket.socketID}).
+			Debug("sending to event handler")
+		socket.handleEvent(response)
+
+	} else {
+		tmp, _ := json.Marshal(response)
+		log.WithFields(log.Fields{"data": string(tmp), "method": response.Method, "responseID": response.ID, "socketID": socket.socketID}).
+			Error("Unknown response from web socket")
+
+		if nil == response.Error {
+			response.Error = &Error{
+				Message: "Unknown response from web socket",
 			}
 		}
+		socket.handleUnknown(response)
 	}
 }
 
@@ -364,6 +439,97 @@ func (socket *Socket) NextCommandID() int {
 	return id
 }
 
+/*
+reconnect re-establishes a dropped websocket connection according to the
+reconnect policy. Event handlers are preserved and any enabled domains are
+re-enabled on the new connection. If no policy is set or every attempt fails
+the socket is closed and an error is returned.
+*/
+func (socket *Socket) reconnect(cause error) error {
+	socket.Disconnect()
+
+	socket.mux.Lock()
+	policy := socket.reconnectPolicy
+	socket.mux.Unlock()
+
+	if nil == policy {
+		socket.setState(ConnectionClosed, 0, cause)
+		return cause
+	}
+
+	err := cause
+	for attempt := 1; policy.Retry(attempt); attempt++ {
+		log.WithFields(log.Fields{"attempt": attempt, "error": err, "socketID": socket.socketID}).
+			Warn("websocket connection lost, reconnecting")
+		socket.setState(ConnectionReconnecting, attempt, err)
+
+		select {
+		case <-socket.ctx.Done():
+			return nil
+		case <-time.After(policy.Backoff(attempt)):
+		}
+
+		if err = socket.Connect(); nil == err {
+			log.WithFields(log.Fields{"attempt": attempt, "socketID": socket.socketID, "url": socket.url.String()}).
+				Info("websocket reconnected")
+			socket.setState(ConnectionConnected, attempt, nil)
+			socket.restoreDomains()
+			return nil
+		}
+	}
+
+	err = errs.Wrap(err, codes.SocketReconnectFailed, fmt.Sprintf("could not reconnect to %s", socket.url.String()))
+	socket.setState(ConnectionClosed, 0, err)
+	return err
+}
+
+/*
+restoreDomains re-sends the enable command for each enabled domain.
+*/
+func (socket *Socket) restoreDomains() {
+	socket.mux.Lock()
+	domains := make([]*Payload, len(socket.domains))
+	copy(domains, socket.domains)
+	socket.mux.Unlock()
+
+	for _, domain := range domains {
+		log.WithFields(log.Fields{"method": domain.Method, "socketID": socket.socketID}).
+			Debug("restoring domain")
+		socket.SendCommand(NewCommand(socket, domain.Method, domain.Params))
+	}
+}
+
+/*
+trackDomain records successful domain enable and disable commands so they can be
+restored after a reconnect.
+*/
+func (socket *Socket) trackDomain(command Commander, response *Response) {
+	if nil != response.Error && 0 != response.Error.Code {
+		return
+	}
+	method := command.Method()
+	enable := strings.HasSuffix(method, ".enable")
+	if !enable && !strings.HasSuffix(method, ".disable") {
+		return
+	}
+	domain := method[:strings.LastIndex(method, ".")]
+
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	for k, payload := range socket.domains {
+		if strings.HasPrefix(payload.Method, domain+".") {
+			socket.domains = append(socket.domains[:k], socket.domains[k+1:]...)
+			break
+		}
+	}
+	if enable {
+		socket.domains = append(socket.domains, &Payload{
+			Method: method,
+			Params: command.Params(),
+		})
+	}
+}
+
 /*
 RemoveEventHandler removes a handler from the stack of listeners for an event.
 
@@ -386,6 +552,7 @@ func (socket *Socket) RemoveEventHandler(
 		if hndlr == handler {
 			handlers = append(handlers[:i], handlers[i+1:]...)
 			socket.handlers.Set(handler.Name(), handlers)
+			socket.stopQueue(handler)
 			log.WithFields(log.Fields{"handler": handler.Name(), "handlerID": i, "socketID": socket.socketID}).
 				Info("Removed event handler")
 			return nil
@@ -403,40 +570,120 @@ SendCommand delivers a command payload to the websocket connection.
 SendCommand is a Socketer implementation.
 
 Workflow:
-	1. The socket's command mutex is locked.
-	2. The command counter is incremented.
-	3. The payload is sent to the socket connection and the mutex is unlocked.
-	4. The command is stored using the generated ID.
-	5. When the command has been executed and the socket responds,
-	socket.HandleCmd() is triggered from the command instance to generate the
-	response and the command unlocks itself.
+ 1. The command is stored using its ID.
+ 2. The payload is sent to the socket connection in the background.
+ 3. When the command has been executed and the socket responds,
+    socket.handleResponse() is triggered to deliver the response to the
+    command's response channel.
+
+If the socket's capabilities show the connected browser doesn't support the
+command it is not sent. The response error then has the
+codes.SocketMethodUnsupported code and a message naming the method and the
+browser version.
 */
 func (socket *Socket) SendCommand(command Commander) chan *Response {
 	log.WithFields(log.Fields{"commandID": command.ID(), "method": command.Method(), "socketID": socket.socketID}).
 		Debug("sending command payload to socket")
+
+	if err := socket.Capabilities().Check(command.Method()); nil != err {
+		command.Respond(&Response{Error: &Error{
+			Code:    int(codes.SocketMethodUnsupported),
+			Message: err.(errs.Err).Msg(),
+		}})
+		return command.Response()
+	}
+
+	// Store the command before writing the payload so a fast response can't
+	// arrive before the command is listening for it.
+	socket.commands.Set(command)
+
 	go func() {
 		payload := &Payload{
-			ID:     command.ID(),
-			Method: command.Method(),
-			Params: command.Params(),
+			ID:        command.ID(),
+			Method:    command.Method(),
+			Params:    command.Params(),
+			SessionID: socket.sessionID,
 		}
 
 		if err := socket.WriteJSON(payload); err != nil {
 			err = errs.Wrap(err, 0, "write failed: could not write data to websocket")
+			socket.commands.Delete(command.ID())
 			command.Respond(&Response{Error: &Error{
 				Code:    1,
 				Data:    []byte(fmt.Sprintf(`"%#v"`, err)),
 				Message: "Failed to send command payload to socket connection",
 			}})
-			return
 		}
-
-		socket.commands.Set(command)
 	}()
 
 	return command.Response()
 }
 
+/*
+SendCommandContext delivers a command payload to the websocket connection and
+waits for the response.
+
+If ctx is cancelled or its deadline passes before a response is received the
+command is removed from the command stack so a late response is discarded, and
+an error coded codes.SocketCommandCancelled or codes.SocketCommandTimeout is
+returned.
+
+If the socket's capabilities show the connected browser doesn't support the
+command, a codes.SocketMethodUnsupported error is returned without sending it.
+
+SendCommandContext is a Socketer implementation.
+*/
+func (socket *Socket) SendCommandContext(ctx context.Context, command Commander) (*Response, error) {
+	if err := socket.Capabilities().Check(command.Method()); nil != err {
+		return nil, err
+	}
+	select {
+	case response := <-socket.SendCommand(command):
+		return response, nil
+	case <-ctx.Done():
+		socket.commands.Delete(command.ID())
+		log.WithFields(log.Fields{"commandID": command.ID(), "error": ctx.Err(), "method": command.Method(), "socketID": socket.socketID}).
+			Debug("command abandoned")
+		return nil, contextError(ctx, command)
+	}
+}
+
+/*
+SetDispatchPolicy sets the policy used to deliver events to event handlers. A
+nil policy delivers each event to each handler in a new goroutine, which is
+the default. Events that are queued when the policy changes are discarded, so
+the policy should be set before events are received. The queues of Subscribe
+channels are kept.
+
+SetDispatchPolicy is a Socketer implementation.
+*/
+func (socket *Socket) SetDispatchPolicy(policy *DispatchPolicy) {
+	socket.mux.Lock()
+	defer socket.mux.Unlock()
+	socket.dispatchPolicy = policy
+	for handler, queue := range socket.queues {
+		if queue.pinned {
+			continue
+		}
+		queue.stop()
+		delete(socket.queues, handler)
+	}
+}
+
+/*
+SetReconnectPolicy sets the policy used to re-establish a dropped websocket
+connection. Event handlers are preserved across reconnects and domains that
+were enabled are re-enabled. A nil policy disables reconnection, which is the
+default.
+
+SetReconnectPolicy is a Socketer implementation.
+*/
+func (socket *Socket) SetReconnectPolicy(policy *ReconnectPolicy) {
+	socket.mux.Lock()
+	socket.reconnectPolicy = policy
+	socket.mux.Unlock()
+}
+
 /*
 Stop signals the socket read loop to stop listening for data and close the
 websocket connection.
@@ -444,6 +691,10 @@ websocket connection.
 Stop is a Socketer implementation.
 */
 func (socket *Socket) Stop() {
+	if nil != socket.parent {
+		socket.detach()
+		return
+	}
 	log.WithFields(log.Fields{"socketID": socket.socketID}).Info("closing websocket")
 	socket.cancel()
 	socket.wg.Wait()
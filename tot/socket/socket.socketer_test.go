@@ -1,10 +1,17 @@
 package socket
 
 import (
+	"context"
+	"fmt"
 	"net/url"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/page"
 )
 
 func TestNewSocket(t *testing.T) {
@@ -130,6 +137,211 @@ func TestListenCommandUnknown(t *testing.T) {
 	}
 }
 
+func TestSendCommandContext(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSendCommandContext")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	// Deadline passes before a response is received.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	command := NewCommand(mockSocket, "Some.method", nil)
+	response, err := mockSocket.SendCommandContext(ctx, command)
+	if nil != response {
+		t.Errorf("Expected nil response, received %v", response)
+	}
+	if e, ok := err.(errs.Err); !ok || codes.SocketCommandTimeout != e.Code() {
+		t.Errorf("Expected SocketCommandTimeout error, received %v", err)
+	}
+	if _, err := mockSocket.commands.Get(command.ID()); nil == err {
+		t.Errorf("Expected command #%d to be removed from the command stack", command.ID())
+	}
+
+	// Context is cancelled before a response is received.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	command = NewCommand(mockSocket, "Some.method", nil)
+	_, err = mockSocket.SendCommandContext(ctx, command)
+	if e, ok := err.(errs.Err); !ok || codes.SocketCommandCancelled != e.Code() {
+		t.Errorf("Expected SocketCommandCancelled error, received %v", err)
+	}
+
+	// Response is received before the deadline.
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	command = NewCommand(mockSocket, "Some.method", nil)
+	type result struct {
+		response *Response
+		err      error
+	}
+	resultChan := make(chan result)
+	go func() {
+		response, err := mockSocket.SendCommandContext(ctx, command)
+		resultChan <- result{response, err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Error:  &Error{},
+		Method: "Some.method",
+		Result: []byte(`"Mock Command Result"`),
+	})
+	res := <-resultChan
+	if nil != res.err {
+		t.Errorf("Expected nil, received error: %v", res.err)
+	} else if `"Mock Command Result"` != string(res.response.Result) {
+		t.Errorf("Invalid result: expected 'Mock Command Result', received '%s'", res.response.Result)
+	}
+}
+
+func TestSocketConnectionStateListener(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketConnectionStateListener")
+	mockSocket := NewMock(socketURL)
+
+	states := make(chan ConnectionState, 10)
+	listener := mockSocket.OnConnectionStateChanged(func(event *ConnectionStateChangedEvent) {
+		states <- event.State
+	})
+	mockSocket.setState(ConnectionConnected, 0, nil)
+	if state := <-states; ConnectionConnected != state {
+		t.Errorf("Expected state '%s', received '%s'", ConnectionConnected, state)
+	}
+
+	listener.Cancel()
+	if nil != listener.Err() {
+		t.Errorf("Expected nil, received error: %v", listener.Err())
+	}
+	mockSocket.setState(ConnectionClosed, 0, nil)
+	select {
+	case state := <-states:
+		t.Errorf("Expected the handler to be removed, received state '%s'", state)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSocketReconnect(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketReconnect")
+	mockSocket := NewMock(socketURL)
+	mockSocket.SetReconnectPolicy(&ReconnectPolicy{
+		InitialInterval: 10 * time.Millisecond,
+		MaxAttempts:     3,
+	})
+
+	states := make(chan *ConnectionStateChangedEvent, 10)
+	mockSocket.OnConnectionStateChanged(func(event *ConnectionStateChangedEvent) {
+		states <- event
+	})
+	events := make(chan *Response, 10)
+	mockSocket.AddEventHandler(NewEventHandler("Some.event", func(response *Response) {
+		events <- response
+	}))
+
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+	if event := <-states; ConnectionConnected != event.State {
+		t.Errorf("Expected state '%s', received '%s'", ConnectionConnected, event.State)
+	}
+
+	// Enable a domain so it is restored after reconnecting.
+	command := NewCommand(mockSocket, "Page.enable", nil)
+	resultChan := mockSocket.SendCommand(command)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		ID:     command.ID(),
+		Result: []byte(`{}`),
+	})
+	<-resultChan
+
+	// Drop the connection.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockError(fmt.Errorf("connection reset"))
+	if event := <-states; ConnectionReconnecting != event.State || 1 != event.Attempt {
+		t.Errorf("Expected state '%s' attempt 1, received '%s' attempt %d", ConnectionReconnecting, event.State, event.Attempt)
+	}
+	if event := <-states; ConnectionConnected != event.State {
+		t.Errorf("Expected state '%s', received '%s'", ConnectionConnected, event.State)
+	}
+	if ConnectionConnected != mockSocket.State() {
+		t.Errorf("Expected state '%s', received '%s'", ConnectionConnected, mockSocket.State())
+	}
+
+	// Domains are re-enabled on the new connection.
+	time.Sleep(50 * time.Millisecond)
+	payloads := mockSocket.Conn().(*MockChromeWebSocket).Payloads()
+	if 1 != len(payloads) || "Page.enable" != payloads[0].(*Payload).Method {
+		t.Errorf("Expected Page.enable to be restored, received %v", payloads)
+	}
+
+	// Event handlers are preserved.
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Some.event",
+		Params: []byte(`{}`),
+	})
+	select {
+	case <-events:
+	case <-time.After(time.Second):
+		t.Errorf("Expected event handler to survive the reconnect")
+	}
+}
+
+func TestSocketReconnectDisabled(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketReconnectDisabled")
+	mockSocket := NewMock(socketURL)
+	errCh := make(chan error)
+	go func() { errCh <- mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockError(fmt.Errorf("connection reset"))
+	if err := <-errCh; nil == err {
+		t.Errorf("Expected error, received nil")
+	}
+	if ConnectionClosed != mockSocket.State() {
+		t.Errorf("Expected state '%s', received '%s'", ConnectionClosed, mockSocket.State())
+	}
+}
+
+func TestFailCommandsOnCrash(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestFailCommandsOnCrash")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+	if mockSocket.Crashed() {
+		t.Errorf("Expected false, received true")
+	}
+
+	resultChan := mockSocket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com"})
+	time.Sleep(50 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Inspector.targetCrashed",
+		Params: []byte(`{}`),
+	})
+	result := <-resultChan
+	if err, ok := result.Err.(*Error); !ok || int(codes.SocketTargetCrashed) != err.Code {
+		t.Errorf("Expected SocketTargetCrashed error, received %v", result.Err)
+	}
+	if !mockSocket.Crashed() {
+		t.Errorf("Expected the crash to be recorded before the command failed")
+	}
+}
+
+func TestFailCommandsOnDisconnect(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestFailCommandsOnDisconnect")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+	if mockSocket.Crashed() {
+		t.Errorf("Expected false, received true")
+	}
+
+	resultChan := mockSocket.Page().Navigate(&page.NavigateParams{URL: "https://www.example.com"})
+	time.Sleep(50 * time.Millisecond)
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockError(fmt.Errorf("connection reset"))
+	result := <-resultChan
+	if err, ok := result.Err.(*Error); !ok || int(codes.SocketDisconnected) != err.Code {
+		t.Errorf("Expected SocketDisconnected error, received %v", result.Err)
+	}
+}
+
 func TestRemoveEventHandler(t *testing.T) {
 	var err error
 	socketURL, _ := url.Parse("https://test:9222/TestRemoveEventHandler")
@@ -262,3 +474,60 @@ func TestResponseType(t *testing.T) {
 		t.Errorf("Response.Result should have a default value of nil, %v found", v.Result)
 	}
 }
+
+func TestSocketConcurrency(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketConcurrency")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	// Respond to every command written to the mock websocket.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		responded := map[int]bool{}
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+			for _, data := range mockSocket.Conn().(*MockChromeWebSocket).Payloads() {
+				payload, ok := data.(*Payload)
+				if !ok || responded[payload.ID] {
+					continue
+				}
+				responded[payload.ID] = true
+				mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+					ID:     payload.ID,
+					Result: []byte(`{"frameId":"frame-1"}`),
+				})
+				mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+					Method: "Page.loadEventFired",
+					Params: []byte(`{"timestamp":1}`),
+				})
+			}
+		}
+	}()
+
+	wg := &sync.WaitGroup{}
+	for a := 0; a < 20; a++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handler := NewEventHandler("Page.loadEventFired", func(response *Response) {})
+			mockSocket.AddEventHandler(handler)
+			defer mockSocket.RemoveEventHandler(handler)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			result := <-mockSocket.Page().WithContext(ctx).Navigate(&page.NavigateParams{URL: "https://www.example.com"})
+			if nil != result.Err {
+				t.Errorf("Expected nil, received error: %v", result.Err)
+			}
+			_ = mockSocket.CurCommandID()
+			_ = mockSocket.State()
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,146 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+Waiter waits for the first event that matches a predicate. The event handler is
+added when the Waiter is created, so the command that triggers the event should
+be sent after creating the Waiter and before calling Wait:
+
+	waiter := tab.Page().WaitLoadEventFired(ctx)
+	defer waiter.Cancel()
+	if result := <-tab.Page().Navigate(&page.NavigateParams{URL: uri}); nil != result.Err {
+		return result.Err
+	}
+	event, err := waiter.Wait()
+*/
+type Waiter[T any] struct {
+	ctx      context.Context
+	events   chan T
+	listener *Listener
+}
+
+/*
+WaitForEvent returns a Waiter for the first event delivered by one of the
+protocol On* methods that matches predicate. A nil predicate matches any event.
+The Waiter is bound to ctx:
+
+	waiter := socket.WaitForEvent(ctx, tab.Page().OnFrameNavigated, func(event *page.FrameNavigatedEvent) bool {
+		return "" == event.Frame.ParentID
+	})
+*/
+func WaitForEvent[T any](ctx context.Context, on func(callback func(event T)) *Listener, predicate func(event T) bool) *Waiter[T] {
+	if nil == ctx {
+		panic("nil context")
+	}
+	events := make(chan T, 1)
+	listener := on(func(event T) {
+		if nil != predicate && !predicate(event) {
+			return
+		}
+		select {
+		case events <- event:
+		default:
+		}
+	})
+	return &Waiter[T]{
+		ctx:      ctx,
+		events:   events,
+		listener: listener,
+	}
+}
+
+/*
+Cancel removes the event handler without waiting. It is safe to call Cancel
+more than once, and after Wait.
+*/
+func (waiter *Waiter[T]) Cancel() {
+	waiter.listener.Cancel()
+}
+
+/*
+Wait blocks until a matching event is received or the Waiter's context ends,
+then removes the event handler. If the context ends first the error has the
+code SocketEventTimeout or SocketEventCancelled. If the connected browser
+doesn't support the event, Wait returns a SocketMethodUnsupported error
+immediately.
+*/
+func (waiter *Waiter[T]) Wait() (T, error) {
+	defer waiter.Cancel()
+	if err := waiter.listener.Err(); nil != err {
+		var event T
+		return event, err
+	}
+	select {
+	case event := <-waiter.events:
+		return event, nil
+	case <-waiter.ctx.Done():
+		var event T
+		name := waiter.listener.Handler().Name()
+		if context.DeadlineExceeded == waiter.ctx.Err() {
+			return event, errs.Wrap(waiter.ctx.Err(), codes.SocketEventTimeout, fmt.Sprintf("timed out waiting for event '%s'", name))
+		}
+		return event, errs.Wrap(waiter.ctx.Err(), codes.SocketEventCancelled, fmt.Sprintf("cancelled waiting for event '%s'", name))
+	}
+}
+
+/*
+WaitFor returns a Waiter for the first event named by eventName whose raw JSON
+parameters match predicate. A nil predicate matches any event. It supports
+events that have no typed wrapper.
+
+WaitFor is a Socketer implementation.
+*/
+func (socket *Socket) WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *Waiter[json.RawMessage] {
+	return WaitForEvent(ctx, func(callback func(params json.RawMessage)) *Listener {
+		handler := NewEventHandler(eventName, func(response *Response) {
+			callback(response.Params)
+		})
+		socket.AddEventHandler(handler)
+		return NewListener(socket, handler)
+	}, predicate)
+}
+
+/*
+WaitDOMContentEventFired returns a Waiter for the next Page.domContentEventFired
+event.
+*/
+func (protocol *PageProtocol) WaitDOMContentEventFired(
+	ctx context.Context,
+) *Waiter[*page.DOMContentEventFiredEvent] {
+	return WaitForEvent(ctx, protocol.OnDOMContentEventFired, nil)
+}
+
+/*
+WaitLoadEventFired returns a Waiter for the next Page.loadEventFired event.
+*/
+func (protocol *PageProtocol) WaitLoadEventFired(
+	ctx context.Context,
+) *Waiter[*page.LoadEventFiredEvent] {
+	return WaitForEvent(ctx, protocol.OnLoadEventFired, nil)
+}
+
+/*
+WaitResponse returns a Waiter for the next Network.responseReceived event for a
+response URL that matches urlMatcher. A nil urlMatcher matches any URL.
+*/
+func (protocol *NetworkProtocol) WaitResponse(
+	ctx context.Context,
+	urlMatcher func(url string) bool,
+) *Waiter[*network.ResponseReceivedEvent] {
+	return WaitForEvent(ctx, protocol.OnResponseReceived, func(event *network.ResponseReceivedEvent) bool {
+		if nil == urlMatcher {
+			return true
+		}
+		return nil != event.Response && urlMatcher(event.Response.URL)
+	})
+}
@@ -0,0 +1,100 @@
+package socket
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/bdlm/errors"
+	"github.com/mkenney/go-chrome/codes"
+)
+
+func TestSocketWaitFor(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketWaitFor")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	waiter := mockSocket.WaitFor(ctx, "Page.newThingHappened", func(params json.RawMessage) bool {
+		return strings.Contains(string(params), `"thing":2`)
+	})
+	for _, params := range []string{`{"thing":1}`, `{"thing":2}`, `{"thing":3}`} {
+		mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+			Method: "Page.newThingHappened",
+			Params: []byte(params),
+		})
+	}
+	params, err := waiter.Wait()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if `{"thing":2}` != string(params) {
+		t.Errorf("Expected '{\"thing\":2}', received '%s'", params)
+	}
+	handlers, _ := mockSocket.handlers.Get("Page.newThingHappened")
+	if 0 != len(handlers) {
+		t.Errorf("Expected the event handler to be removed, found %d", len(handlers))
+	}
+
+	// Timeout
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = mockSocket.WaitFor(ctx, "Page.otherThingHappened", nil).Wait()
+	if e, ok := err.(errs.Err); !ok || codes.SocketEventTimeout != e.Code() {
+		t.Errorf("Expected SocketEventTimeout, received %v", err)
+	}
+
+	// Cancellation
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = mockSocket.WaitFor(ctx, "Page.otherThingHappened", nil).Wait()
+	if e, ok := err.(errs.Err); !ok || codes.SocketEventCancelled != e.Code() {
+		t.Errorf("Expected SocketEventCancelled, received %v", err)
+	}
+}
+
+func TestSocketWaitForTyped(t *testing.T) {
+	socketURL, _ := url.Parse("https://test:9222/TestSocketWaitForTyped")
+	mockSocket := NewMock(socketURL)
+	go func() { _ = mockSocket.Listen() }()
+	defer mockSocket.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	loadWaiter := mockSocket.Page().WaitLoadEventFired(ctx)
+	responseWaiter := mockSocket.Network().WaitResponse(ctx, func(url string) bool {
+		return strings.HasSuffix(url, "/app.js")
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Network.responseReceived",
+		Params: []byte(`{"requestId":"1","response":{"url":"http://localhost/"}}`),
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Network.responseReceived",
+		Params: []byte(`{"requestId":"2","response":{"url":"http://localhost/app.js"}}`),
+	})
+	mockSocket.Conn().(*MockChromeWebSocket).AddMockData(&Response{
+		Method: "Page.loadEventFired",
+		Params: []byte(`{"timestamp":1}`),
+	})
+
+	response, err := responseWaiter.Wait()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if "2" != string(response.RequestID) {
+		t.Errorf("Expected request '2', received '%s'", response.RequestID)
+	}
+	load, err := loadWaiter.Wait()
+	if nil != err {
+		t.Fatalf("Expected nil, received error: %v", err)
+	}
+	if 1 != load.Timestamp {
+		t.Errorf("Expected timestamp 1, received %v", load.Timestamp)
+	}
+}
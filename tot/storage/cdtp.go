@@ -1,19 +1,676 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package storage provides type definitions for use with the Chrome Storage protocol
 
+EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/
 */
 package storage
 
+import (
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
 /*
-UsageForType is usage for a storage type.
+SerializedStorageKey represents the Storage.SerializedStorageKey type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SerializedStorageKey
+*/
+type SerializedStorageKey string
+
+/*
+UsageForType represents the Storage.UsageForType type.
+
+Usage for a storage type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-UsageForType
 */
 type UsageForType struct {
-	// Name of storage type.
+	// Name of storage type. Allowed values:
+	//   - Type.Cookies
+	//   - Type.FileSystems
+	//   - Type.Indexeddb
+	//   - Type.LocalStorage
+	//   - Type.ShaderCache
+	//   - Type.Websql
+	//   - Type.ServiceWorkers
+	//   - Type.CacheStorage
+	//   - Type.InterestGroups
+	//   - Type.SharedStorage
+	//   - Type.StorageBuckets
+	//   - Type.All
+	//   - Type.Other
+	//   - Type.Appcache
 	Type TypeEnum `json:"storageType"`
 
 	// Storage usage (bytes).
-	Usage int `json:"usage"`
+	Usage float64 `json:"usage"`
+}
+
+/*
+TrustTokens represents the Storage.TrustTokens type.
+
+Pair of issuer origin and number of available (signed, but not used) Trust
+Tokens from that issuer. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-TrustTokens
+*/
+type TrustTokens struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+
+	Count float64 `json:"count"`
+}
+
+/*
+InterestGroupAuctionID represents the Storage.InterestGroupAuctionId type.
+
+Protected audience interest group auction identifier.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-InterestGroupAuctionId
+*/
+type InterestGroupAuctionID string
+
+/*
+SharedStorageEntry represents the Storage.SharedStorageEntry type.
+
+Struct for a single key-value pair in an origin's shared storage.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageEntry
+*/
+type SharedStorageEntry struct {
+	Key string `json:"key"`
+
+	Value string `json:"value"`
+}
+
+/*
+SharedStorageMetadata represents the Storage.SharedStorageMetadata type.
+
+Details for an origin's shared storage.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageMetadata
+*/
+type SharedStorageMetadata struct {
+	// Time when the origin's shared storage was last created.
+	CreationTime network.TimeSinceEpoch `json:"creationTime"`
+
+	// Number of key-value pairs stored in origin's shared storage.
+	Length int `json:"length"`
+
+	// Current amount of bits of entropy remaining in the navigation budget.
+	RemainingBudget float64 `json:"remainingBudget"`
+
+	// Total number of bytes stored as key-value pairs in origin's shared storage.
+	BytesUsed int `json:"bytesUsed"`
+}
+
+/*
+SharedStoragePrivateAggregationConfig represents the
+Storage.SharedStoragePrivateAggregationConfig type.
+
+Represents a dictionary object passed in as privateAggregationConfig to run or
+selectURL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStoragePrivateAggregationConfig
+*/
+type SharedStoragePrivateAggregationConfig struct {
+	// Optional. The chosen aggregation service deployment.
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"`
+
+	// Optional. The context ID provided.
+	ContextID string `json:"contextId,omitempty"`
+
+	// Configures the maximum size allowed for filtering IDs.
+	FilteringIDMaxBytes int `json:"filteringIdMaxBytes"`
+
+	// Optional. The limit on the number of contributions in the final report.
+	MaxContributions int `json:"maxContributions,omitempty"`
+}
+
+/*
+SharedStorageReportingMetadata represents the
+Storage.SharedStorageReportingMetadata type.
+
+Pair of reporting metadata details for a candidate URL for `selectURL()`.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageReportingMetadata
+*/
+type SharedStorageReportingMetadata struct {
+	EventType string `json:"eventType"`
+
+	ReportingURL string `json:"reportingUrl"`
+}
+
+/*
+SharedStorageURLWithMetadata represents the Storage.SharedStorageUrlWithMetadata
+type.
+
+Bundles a candidate URL with its reporting metadata.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageUrlWithMetadata
+*/
+type SharedStorageURLWithMetadata struct {
+	// Spec of candidate URL.
+	URL string `json:"url"`
+
+	// Any associated reporting metadata.
+	ReportingMetadata []*SharedStorageReportingMetadata `json:"reportingMetadata"`
+}
+
+/*
+SharedStorageAccessParams represents the Storage.SharedStorageAccessParams type.
+
+Bundles the parameters for shared storage access events whose presence/absence
+can vary according to SharedStorageAccessType.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageAccessParams
+*/
+type SharedStorageAccessParams struct {
+	// Optional. Spec of the module script URL. Present only for
+	// SharedStorageAccessMethods: addModule and createWorklet.
+	ScriptSourceURL string `json:"scriptSourceUrl,omitempty"`
+
+	// Optional. String denoting "context-origin", "script-origin", or a custom
+	// origin to be used as the worklet's data origin. Present only for
+	// SharedStorageAccessMethod: createWorklet.
+	DataOrigin string `json:"dataOrigin,omitempty"`
+
+	// Optional. Name of the registered operation to be run. Present only for
+	// SharedStorageAccessMethods: run and selectURL.
+	OperationName string `json:"operationName,omitempty"`
+
+	// Optional. ID of the operation call. Present only for
+	// SharedStorageAccessMethods: run and selectURL.
+	OperationID string `json:"operationId,omitempty"`
+
+	// Optional. Whether or not to keep the worket alive for future run or
+	// selectURL calls. Present only for SharedStorageAccessMethods: run and
+	// selectURL.
+	KeepAlive bool `json:"keepAlive,omitempty"`
+
+	// Optional. Configures the private aggregation options. Present only for
+	// SharedStorageAccessMethods: run and selectURL.
+	PrivateAggregationConfig *SharedStoragePrivateAggregationConfig `json:"privateAggregationConfig,omitempty"`
+
+	// Optional. The operation's serialized data in bytes (converted to a string).
+	// Present only for SharedStorageAccessMethods: run and selectURL.
+	// TODO(crbug.com/401011862): Consider updating this parameter to binary.
+	SerializedData string `json:"serializedData,omitempty"`
+
+	// Optional. Array of candidate URLs' specs, along with any associated
+	// metadata. Present only for SharedStorageAccessMethod: selectURL.
+	URLsWithMetadata []*SharedStorageURLWithMetadata `json:"urlsWithMetadata,omitempty"`
+
+	// Optional. Spec of the URN:UUID generated for a selectURL call. Present only
+	// for SharedStorageAccessMethod: selectURL.
+	UrnUUID string `json:"urnUuid,omitempty"`
+
+	// Optional. Key for a specific entry in an origin's shared storage. Present
+	// only for SharedStorageAccessMethods: set, append, delete, and get.
+	Key string `json:"key,omitempty"`
+
+	// Optional. Value for a specific entry in an origin's shared storage. Present
+	// only for SharedStorageAccessMethods: set and append.
+	Value string `json:"value,omitempty"`
+
+	// Optional. Whether or not to set an entry for a key if that key is already
+	// present. Present only for SharedStorageAccessMethod: set.
+	IgnoreIfPresent bool `json:"ignoreIfPresent,omitempty"`
+
+	// Optional. A number denoting the (0-based) order of the worklet's creation
+	// relative to all other shared storage worklets created by documents using the
+	// current storage partition. Present only for SharedStorageAccessMethods:
+	// addModule, createWorklet.
+	WorkletOrdinal int `json:"workletOrdinal,omitempty"`
+
+	// Optional. Hex representation of the DevTools token used as the TargetID for
+	// the associated shared storage worklet. Present only for
+	// SharedStorageAccessMethods: addModule, createWorklet, run, selectURL, and
+	// any other SharedStorageAccessMethod when the SharedStorageAccessScope is
+	// sharedStorageWorklet.
+	WorkletTargetID target.ID `json:"workletTargetId,omitempty"`
+
+	// Optional. Name of the lock to be acquired, if present. Optionally present
+	// only for SharedStorageAccessMethods: batchUpdate, set, append, delete, and
+	// clear.
+	WithLock string `json:"withLock,omitempty"`
+
+	// Optional. If the method has been called as part of a batchUpdate, then this
+	// number identifies the batch to which it belongs. Optionally present only for
+	// SharedStorageAccessMethods: batchUpdate (required), set, append, delete, and
+	// clear.
+	BatchUpdateID string `json:"batchUpdateId,omitempty"`
+
+	// Optional. Number of modifier methods sent in batch. Present only for
+	// SharedStorageAccessMethod: batchUpdate.
+	BatchSize int `json:"batchSize,omitempty"`
+}
+
+/*
+Bucket represents the Storage.StorageBucket type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-StorageBucket
+*/
+type Bucket struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+
+	// Optional. If not specified, it is the default bucket of the storageKey.
+	Name string `json:"name,omitempty"`
+}
+
+/*
+BucketInfo represents the Storage.StorageBucketInfo type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-StorageBucketInfo
+*/
+type BucketInfo struct {
+	Bucket *Bucket `json:"bucket"`
+
+	ID string `json:"id"`
+
+	Expiration network.TimeSinceEpoch `json:"expiration"`
+
+	// Storage quota (bytes).
+	Quota float64 `json:"quota"`
+
+	Persistent bool `json:"persistent"`
+
+	// Allowed values:
+	//   - BucketsDurability.Relaxed
+	//   - BucketsDurability.Strict
+	Durability BucketsDurabilityEnum `json:"durability"`
+}
+
+/*
+UnsignedInt64AsBase10 represents the Storage.UnsignedInt64AsBase10 type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-UnsignedInt64AsBase10
+*/
+type UnsignedInt64AsBase10 string
+
+/*
+UnsignedInt128AsBase16 represents the Storage.UnsignedInt128AsBase16 type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-UnsignedInt128AsBase16
+*/
+type UnsignedInt128AsBase16 string
+
+/*
+SignedInt64AsBase10 represents the Storage.SignedInt64AsBase10 type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SignedInt64AsBase10
+*/
+type SignedInt64AsBase10 string
+
+/*
+AttributionReportingFilterDataEntry represents the
+Storage.AttributionReportingFilterDataEntry type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingFilterDataEntry
+*/
+type AttributionReportingFilterDataEntry struct {
+	Key string `json:"key"`
+
+	Values []string `json:"values"`
+}
+
+/*
+AttributionReportingFilterConfig represents the
+Storage.AttributionReportingFilterConfig type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingFilterConfig
+*/
+type AttributionReportingFilterConfig struct {
+	FilterValues []*AttributionReportingFilterDataEntry `json:"filterValues"`
+
+	// Optional. duration in seconds
+	LookbackWindow int `json:"lookbackWindow,omitempty"`
+}
+
+/*
+AttributionReportingFilterPair represents the
+Storage.AttributionReportingFilterPair type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingFilterPair
+*/
+type AttributionReportingFilterPair struct {
+	Filters []*AttributionReportingFilterConfig `json:"filters"`
+
+	NotFilters []*AttributionReportingFilterConfig `json:"notFilters"`
+}
+
+/*
+AttributionReportingAggregationKeysEntry represents the
+Storage.AttributionReportingAggregationKeysEntry type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregationKeysEntry
+*/
+type AttributionReportingAggregationKeysEntry struct {
+	Key string `json:"key"`
+
+	Value UnsignedInt128AsBase16 `json:"value"`
+}
+
+/*
+AttributionReportingEventReportWindows represents the
+Storage.AttributionReportingEventReportWindows type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingEventReportWindows
+*/
+type AttributionReportingEventReportWindows struct {
+	// duration in seconds
+	Start int `json:"start"`
+
+	// duration in seconds
+	Ends []int `json:"ends"`
+}
+
+/*
+AttributionReportingAggregatableDebugReportingData represents the
+Storage.AttributionReportingAggregatableDebugReportingData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableDebugReportingData
+*/
+type AttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece UnsignedInt128AsBase16 `json:"keyPiece"`
+
+	// number instead of integer because not all uint32 can be represented by int
+	Value float64 `json:"value"`
+
+	Types []string `json:"types"`
+}
+
+/*
+AttributionReportingAggregatableDebugReportingConfig represents the
+Storage.AttributionReportingAggregatableDebugReportingConfig type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableDebugReportingConfig
+*/
+type AttributionReportingAggregatableDebugReportingConfig struct {
+	// Optional. number instead of integer because not all uint32 can be
+	// represented by int, only present for source registrations
+	Budget float64 `json:"budget,omitempty"`
+
+	KeyPiece UnsignedInt128AsBase16 `json:"keyPiece"`
+
+	DebugData []*AttributionReportingAggregatableDebugReportingData `json:"debugData"`
+
+	// Optional.
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"`
+}
+
+/*
+AttributionScopesData represents the Storage.AttributionScopesData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionScopesData
+*/
+type AttributionScopesData struct {
+	Values []string `json:"values"`
+
+	// number instead of integer because not all uint32 can be represented by int
+	Limit float64 `json:"limit"`
+
+	MaxEventStates float64 `json:"maxEventStates"`
+}
+
+/*
+AttributionReportingNamedBudgetDef represents the
+Storage.AttributionReportingNamedBudgetDef type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingNamedBudgetDef
+*/
+type AttributionReportingNamedBudgetDef struct {
+	Name string `json:"name"`
+
+	Budget int `json:"budget"`
+}
+
+/*
+AttributionReportingSourceRegistration represents the
+Storage.AttributionReportingSourceRegistration type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingSourceRegistration
+*/
+type AttributionReportingSourceRegistration struct {
+	Time network.TimeSinceEpoch `json:"time"`
+
+	// duration in seconds
+	Expiry int `json:"expiry"`
+
+	// number instead of integer because not all uint32 can be represented by int
+	TriggerData []float64 `json:"triggerData"`
+
+	EventReportWindows *AttributionReportingEventReportWindows `json:"eventReportWindows"`
+
+	// duration in seconds
+	AggregatableReportWindow int `json:"aggregatableReportWindow"`
+
+	// Allowed values:
+	//   - AttributionReportingSourceType.Navigation
+	//   - AttributionReportingSourceType.Event
+	Type AttributionReportingSourceTypeEnum `json:"type"`
+
+	SourceOrigin string `json:"sourceOrigin"`
+
+	ReportingOrigin string `json:"reportingOrigin"`
+
+	DestinationSites []string `json:"destinationSites"`
+
+	EventID UnsignedInt64AsBase10 `json:"eventId"`
+
+	Priority SignedInt64AsBase10 `json:"priority"`
+
+	FilterData []*AttributionReportingFilterDataEntry `json:"filterData"`
+
+	AggregationKeys []*AttributionReportingAggregationKeysEntry `json:"aggregationKeys"`
+
+	// Optional.
+	DebugKey UnsignedInt64AsBase10 `json:"debugKey,omitempty"`
+
+	// Allowed values:
+	//   - AttributionReportingTriggerDataMatching.Exact
+	//   - AttributionReportingTriggerDataMatching.Modulus
+	TriggerDataMatching AttributionReportingTriggerDataMatchingEnum `json:"triggerDataMatching"`
+
+	DestinationLimitPriority SignedInt64AsBase10 `json:"destinationLimitPriority"`
+
+	AggregatableDebugReportingConfig *AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+
+	// Optional.
+	ScopesData *AttributionScopesData `json:"scopesData,omitempty"`
+
+	MaxEventLevelReports int `json:"maxEventLevelReports"`
+
+	NamedBudgets []*AttributionReportingNamedBudgetDef `json:"namedBudgets"`
+
+	DebugReporting bool `json:"debugReporting"`
+
+	EventLevelEpsilon float64 `json:"eventLevelEpsilon"`
+}
+
+/*
+AttributionReportingAggregatableValueDictEntry represents the
+Storage.AttributionReportingAggregatableValueDictEntry type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableValueDictEntry
+*/
+type AttributionReportingAggregatableValueDictEntry struct {
+	Key string `json:"key"`
+
+	// number instead of integer because not all uint32 can be represented by int
+	Value float64 `json:"value"`
+
+	FilteringID UnsignedInt64AsBase10 `json:"filteringId"`
+}
+
+/*
+AttributionReportingAggregatableValueEntry represents the
+Storage.AttributionReportingAggregatableValueEntry type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableValueEntry
+*/
+type AttributionReportingAggregatableValueEntry struct {
+	Values []*AttributionReportingAggregatableValueDictEntry `json:"values"`
+
+	Filters *AttributionReportingFilterPair `json:"filters"`
+}
+
+/*
+AttributionReportingEventTriggerData represents the
+Storage.AttributionReportingEventTriggerData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingEventTriggerData
+*/
+type AttributionReportingEventTriggerData struct {
+	Data UnsignedInt64AsBase10 `json:"data"`
+
+	Priority SignedInt64AsBase10 `json:"priority"`
+
+	// Optional.
+	DedupKey UnsignedInt64AsBase10 `json:"dedupKey,omitempty"`
+
+	Filters *AttributionReportingFilterPair `json:"filters"`
+}
+
+/*
+AttributionReportingAggregatableTriggerData represents the
+Storage.AttributionReportingAggregatableTriggerData type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableTriggerData
+*/
+type AttributionReportingAggregatableTriggerData struct {
+	KeyPiece UnsignedInt128AsBase16 `json:"keyPiece"`
+
+	SourceKeys []string `json:"sourceKeys"`
+
+	Filters *AttributionReportingFilterPair `json:"filters"`
+}
+
+/*
+AttributionReportingAggregatableDedupKey represents the
+Storage.AttributionReportingAggregatableDedupKey type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableDedupKey
+*/
+type AttributionReportingAggregatableDedupKey struct {
+	// Optional.
+	DedupKey UnsignedInt64AsBase10 `json:"dedupKey,omitempty"`
+
+	Filters *AttributionReportingFilterPair `json:"filters"`
+}
+
+/*
+AttributionReportingNamedBudgetCandidate represents the
+Storage.AttributionReportingNamedBudgetCandidate type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingNamedBudgetCandidate
+*/
+type AttributionReportingNamedBudgetCandidate struct {
+	// Optional.
+	Name string `json:"name,omitempty"`
+
+	Filters *AttributionReportingFilterPair `json:"filters"`
+}
+
+/*
+AttributionReportingTriggerRegistration represents the
+Storage.AttributionReportingTriggerRegistration type.
+
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingTriggerRegistration
+*/
+type AttributionReportingTriggerRegistration struct {
+	Filters *AttributionReportingFilterPair `json:"filters"`
+
+	// Optional.
+	DebugKey UnsignedInt64AsBase10 `json:"debugKey,omitempty"`
+
+	AggregatableDedupKeys []*AttributionReportingAggregatableDedupKey `json:"aggregatableDedupKeys"`
+
+	EventTriggerData []*AttributionReportingEventTriggerData `json:"eventTriggerData"`
+
+	AggregatableTriggerData []*AttributionReportingAggregatableTriggerData `json:"aggregatableTriggerData"`
+
+	AggregatableValues []*AttributionReportingAggregatableValueEntry `json:"aggregatableValues"`
+
+	AggregatableFilteringIDMaxBytes int `json:"aggregatableFilteringIdMaxBytes"`
+
+	DebugReporting bool `json:"debugReporting"`
+
+	// Optional.
+	AggregationCoordinatorOrigin string `json:"aggregationCoordinatorOrigin,omitempty"`
+
+	// Allowed values:
+	//   - AttributionReportingSourceRegistrationTimeConfig.Include
+	//   - AttributionReportingSourceRegistrationTimeConfig.Exclude
+	SourceRegistrationTimeConfig AttributionReportingSourceRegistrationTimeConfigEnum `json:"sourceRegistrationTimeConfig"`
+
+	// Optional.
+	TriggerContextID string `json:"triggerContextId,omitempty"`
+
+	AggregatableDebugReportingConfig *AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+
+	Scopes []string `json:"scopes"`
+
+	NamedBudgets []*AttributionReportingNamedBudgetCandidate `json:"namedBudgets"`
+}
+
+/*
+RelatedWebsiteSet represents the Storage.RelatedWebsiteSet type.
+
+A single Related Website Set object. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-RelatedWebsiteSet
+*/
+type RelatedWebsiteSet struct {
+	// The primary site of this set, along with the ccTLDs if there is any.
+	PrimarySites []string `json:"primarySites"`
+
+	// The associated sites of this set, along with the ccTLDs if there is any.
+	AssociatedSites []string `json:"associatedSites"`
+
+	// The service sites of this set, along with the ccTLDs if there is any.
+	ServiceSites []string `json:"serviceSites"`
 }
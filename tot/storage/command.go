@@ -1,5 +1,34 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package storage
 
+import (
+	"github.com/mkenney/go-chrome/tot/browser"
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
+/*
+GetStorageKeyForFrameParams represents Storage.getStorageKeyForFrame parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getStorageKeyForFrame
+*/
+type GetStorageKeyForFrameParams struct {
+	FrameID page.FrameID `json:"frameId"`
+}
+
+/*
+GetStorageKeyForFrameResult represents the result of calls to Storage.getStorageKeyForFrame.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getStorageKeyForFrame
+*/
+type GetStorageKeyForFrameResult struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 ClearDataForOriginParams represents Storage.clearDataForOrigin parameters.
 
@@ -9,7 +38,7 @@ type ClearDataForOriginParams struct {
 	// Security origin.
 	Origin string `json:"origin"`
 
-	// Comma separated origin names.
+	// Comma separated list of StorageType to clear.
 	Types string `json:"storageTypes"`
 }
 
@@ -23,6 +52,95 @@ type ClearDataForOriginResult struct {
 	Err error `json:"-"`
 }
 
+/*
+ClearDataForStorageKeyParams represents Storage.clearDataForStorageKey parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForStorageKey
+*/
+type ClearDataForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+
+	// Comma separated list of StorageType to clear.
+	StorageTypes string `json:"storageTypes"`
+}
+
+/*
+ClearDataForStorageKeyResult represents the result of calls to Storage.clearDataForStorageKey.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearDataForStorageKey
+*/
+type ClearDataForStorageKeyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetCookiesParams represents Storage.getCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getCookies
+*/
+type GetCookiesParams struct {
+	// Optional. Browser context to use when called on the browser endpoint.
+	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+GetCookiesResult represents the result of calls to Storage.getCookies.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getCookies
+*/
+type GetCookiesResult struct {
+	// Array of cookie objects.
+	Cookies []*network.Cookie `json:"cookies"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCookiesParams represents Storage.setCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setCookies
+*/
+type SetCookiesParams struct {
+	// Cookies to be set.
+	Cookies []*network.CookieParam `json:"cookies"`
+
+	// Optional. Browser context to use when called on the browser endpoint.
+	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+SetCookiesResult represents the result of calls to Storage.setCookies.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setCookies
+*/
+type SetCookiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearCookiesParams represents Storage.clearCookies parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearCookies
+*/
+type ClearCookiesParams struct {
+	// Optional. Browser context to use when called on the browser endpoint.
+	BrowserContextID *browser.ContextID `json:"browserContextId,omitempty"`
+}
+
+/*
+ClearCookiesResult represents the result of calls to Storage.clearCookies.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearCookies
+*/
+type ClearCookiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 GetUsageAndQuotaParams represents Storage.getUsageAndQuota parameters.
 
@@ -40,10 +158,13 @@ https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getUsageA
 */
 type GetUsageAndQuotaResult struct {
 	// Storage usage (bytes).
-	Usage int64 `json:"usage"`
+	Usage float64 `json:"usage"`
 
 	// Storage quota (bytes).
-	Quota int64 `json:"quota"`
+	Quota float64 `json:"quota"`
+
+	// Whether or not the origin has an active storage quota override
+	OverrideActive bool `json:"overrideActive"`
 
 	// Storage usage per type (bytes).
 	UsageBreakdown []*UsageForType `json:"usageBreakdown"`
@@ -52,6 +173,35 @@ type GetUsageAndQuotaResult struct {
 	Err error `json:"-"`
 }
 
+/*
+OverrideQuotaForOriginParams represents Storage.overrideQuotaForOrigin parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-overrideQuotaForOrigin
+*/
+type OverrideQuotaForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+
+	// Optional. The quota size (in bytes) to override the original quota with. If
+	// this is called multiple times, the overridden quota will be equal to the
+	// quotaSize provided in the final call. If this is called without specifying a
+	// quotaSize, the quota will be reset to the default value for the specified
+	// origin. If this is called multiple times with different origins, the
+	// override will be maintained for each origin until it is disabled (called
+	// without a quotaSize).
+	QuotaSize *float64 `json:"quotaSize,omitempty"`
+}
+
+/*
+OverrideQuotaForOriginResult represents the result of calls to Storage.overrideQuotaForOrigin.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-overrideQuotaForOrigin
+*/
+type OverrideQuotaForOriginResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 TrackCacheStorageForOriginParams represents Storage.trackCacheStorageForOrigin parameters.
 
@@ -63,8 +213,7 @@ type TrackCacheStorageForOriginParams struct {
 }
 
 /*
-TrackCacheStorageForOriginResult represents the result of calls to
-Storage.trackCacheStorageForOrigin.
+TrackCacheStorageForOriginResult represents the result of calls to Storage.trackCacheStorageForOrigin.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForOrigin
 */
@@ -73,6 +222,26 @@ type TrackCacheStorageForOriginResult struct {
 	Err error `json:"-"`
 }
 
+/*
+TrackCacheStorageForStorageKeyParams represents Storage.trackCacheStorageForStorageKey parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForStorageKey
+*/
+type TrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+/*
+TrackCacheStorageForStorageKeyResult represents the result of calls to Storage.trackCacheStorageForStorageKey.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackCacheStorageForStorageKey
+*/
+type TrackCacheStorageForStorageKeyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 TrackIndexedDBForOriginParams represents Storage.trackIndexedDBForOrigin parameters.
 
@@ -93,6 +262,26 @@ type TrackIndexedDBForOriginResult struct {
 	Err error `json:"-"`
 }
 
+/*
+TrackIndexedDBForStorageKeyParams represents Storage.trackIndexedDBForStorageKey parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForStorageKey
+*/
+type TrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+/*
+TrackIndexedDBForStorageKeyResult represents the result of calls to Storage.trackIndexedDBForStorageKey.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-trackIndexedDBForStorageKey
+*/
+type TrackIndexedDBForStorageKeyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 UntrackCacheStorageForOriginParams represents Storage.untrackCacheStorageForOrigin parameters.
 
@@ -104,8 +293,7 @@ type UntrackCacheStorageForOriginParams struct {
 }
 
 /*
-UntrackCacheStorageForOriginResult represents the result of calls to
-Storage.untrackCacheStorageForOrigin.
+UntrackCacheStorageForOriginResult represents the result of calls to Storage.untrackCacheStorageForOrigin.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForOrigin
 */
@@ -114,6 +302,26 @@ type UntrackCacheStorageForOriginResult struct {
 	Err error `json:"-"`
 }
 
+/*
+UntrackCacheStorageForStorageKeyParams represents Storage.untrackCacheStorageForStorageKey parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForStorageKey
+*/
+type UntrackCacheStorageForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+/*
+UntrackCacheStorageForStorageKeyResult represents the result of calls to Storage.untrackCacheStorageForStorageKey.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackCacheStorageForStorageKey
+*/
+type UntrackCacheStorageForStorageKeyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 UntrackIndexedDBForOriginParams represents Storage.untrackIndexedDBForOrigin parameters.
 
@@ -125,8 +333,7 @@ type UntrackIndexedDBForOriginParams struct {
 }
 
 /*
-UntrackIndexedDBForOriginResult represents the result of calls to
-Storage.untrackIndexedDBForOrigin.
+UntrackIndexedDBForOriginResult represents the result of calls to Storage.untrackIndexedDBForOrigin.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForOrigin
 */
@@ -134,3 +341,435 @@ type UntrackIndexedDBForOriginResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+UntrackIndexedDBForStorageKeyParams represents Storage.untrackIndexedDBForStorageKey parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForStorageKey
+*/
+type UntrackIndexedDBForStorageKeyParams struct {
+	// Storage key.
+	StorageKey string `json:"storageKey"`
+}
+
+/*
+UntrackIndexedDBForStorageKeyResult represents the result of calls to Storage.untrackIndexedDBForStorageKey.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-untrackIndexedDBForStorageKey
+*/
+type UntrackIndexedDBForStorageKeyResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetTrustTokensResult represents the result of calls to Storage.getTrustTokens.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getTrustTokens
+*/
+type GetTrustTokensResult struct {
+	Tokens []*TrustTokens `json:"tokens"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearTrustTokensParams represents Storage.clearTrustTokens parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearTrustTokens
+*/
+type ClearTrustTokensParams struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+/*
+ClearTrustTokensResult represents the result of calls to Storage.clearTrustTokens.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearTrustTokens
+*/
+type ClearTrustTokensResult struct {
+	// True if any tokens were deleted, false otherwise.
+	DidDeleteTokens bool `json:"didDeleteTokens"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetInterestGroupDetailsParams represents Storage.getInterestGroupDetails parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getInterestGroupDetails
+*/
+type GetInterestGroupDetailsParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	Name string `json:"name"`
+}
+
+/*
+GetInterestGroupDetailsResult represents the result of calls to Storage.getInterestGroupDetails.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getInterestGroupDetails
+*/
+type GetInterestGroupDetailsResult struct {
+	// This largely corresponds to:
+	// https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup but has
+	// absolute expirationTime instead of relative lifetimeMs and also adds
+	// joiningOrigin.
+	Details map[string]interface{} `json:"details"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetInterestGroupTrackingParams represents Storage.setInterestGroupTracking parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupTracking
+*/
+type SetInterestGroupTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+/*
+SetInterestGroupTrackingResult represents the result of calls to Storage.setInterestGroupTracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupTracking
+*/
+type SetInterestGroupTrackingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetInterestGroupAuctionTrackingParams represents Storage.setInterestGroupAuctionTracking parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupAuctionTracking
+*/
+type SetInterestGroupAuctionTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+/*
+SetInterestGroupAuctionTrackingResult represents the result of calls to Storage.setInterestGroupAuctionTracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setInterestGroupAuctionTracking
+*/
+type SetInterestGroupAuctionTrackingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetSharedStorageMetadataParams represents Storage.getSharedStorageMetadata parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageMetadata
+*/
+type GetSharedStorageMetadataParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+/*
+GetSharedStorageMetadataResult represents the result of calls to Storage.getSharedStorageMetadata.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageMetadata
+*/
+type GetSharedStorageMetadataResult struct {
+	Metadata *SharedStorageMetadata `json:"metadata"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetSharedStorageEntriesParams represents Storage.getSharedStorageEntries parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageEntries
+*/
+type GetSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+/*
+GetSharedStorageEntriesResult represents the result of calls to Storage.getSharedStorageEntries.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getSharedStorageEntries
+*/
+type GetSharedStorageEntriesResult struct {
+	Entries []*SharedStorageEntry `json:"entries"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSharedStorageEntryParams represents Storage.setSharedStorageEntry parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageEntry
+*/
+type SetSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	Key string `json:"key"`
+
+	Value string `json:"value"`
+
+	// Optional. If `ignoreIfPresent` is included and true, then only sets the
+	// entry if `key` doesn't already exist.
+	IgnoreIfPresent *bool `json:"ignoreIfPresent,omitempty"`
+}
+
+/*
+SetSharedStorageEntryResult represents the result of calls to Storage.setSharedStorageEntry.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageEntry
+*/
+type SetSharedStorageEntryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DeleteSharedStorageEntryParams represents Storage.deleteSharedStorageEntry parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteSharedStorageEntry
+*/
+type DeleteSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	Key string `json:"key"`
+}
+
+/*
+DeleteSharedStorageEntryResult represents the result of calls to Storage.deleteSharedStorageEntry.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteSharedStorageEntry
+*/
+type DeleteSharedStorageEntryResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearSharedStorageEntriesParams represents Storage.clearSharedStorageEntries parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearSharedStorageEntries
+*/
+type ClearSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+/*
+ClearSharedStorageEntriesResult represents the result of calls to Storage.clearSharedStorageEntries.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-clearSharedStorageEntries
+*/
+type ClearSharedStorageEntriesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ResetSharedStorageBudgetParams represents Storage.resetSharedStorageBudget parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-resetSharedStorageBudget
+*/
+type ResetSharedStorageBudgetParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+/*
+ResetSharedStorageBudgetResult represents the result of calls to Storage.resetSharedStorageBudget.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-resetSharedStorageBudget
+*/
+type ResetSharedStorageBudgetResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetSharedStorageTrackingParams represents Storage.setSharedStorageTracking parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageTracking
+*/
+type SetSharedStorageTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+/*
+SetSharedStorageTrackingResult represents the result of calls to Storage.setSharedStorageTracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setSharedStorageTracking
+*/
+type SetSharedStorageTrackingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetStorageBucketTrackingParams represents Storage.setStorageBucketTracking parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setStorageBucketTracking
+*/
+type SetStorageBucketTrackingParams struct {
+	StorageKey string `json:"storageKey"`
+
+	Enable bool `json:"enable"`
+}
+
+/*
+SetStorageBucketTrackingResult represents the result of calls to Storage.setStorageBucketTracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setStorageBucketTracking
+*/
+type SetStorageBucketTrackingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DeleteStorageBucketParams represents Storage.deleteStorageBucket parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteStorageBucket
+*/
+type DeleteStorageBucketParams struct {
+	Bucket *Bucket `json:"bucket"`
+}
+
+/*
+DeleteStorageBucketResult represents the result of calls to Storage.deleteStorageBucket.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-deleteStorageBucket
+*/
+type DeleteStorageBucketResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RunBounceTrackingMitigationsResult represents the result of calls to Storage.runBounceTrackingMitigations.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-runBounceTrackingMitigations
+*/
+type RunBounceTrackingMitigationsResult struct {
+	DeletedSites []string `json:"deletedSites"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAttributionReportingLocalTestingModeParams represents Storage.setAttributionReportingLocalTestingMode parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingLocalTestingMode
+*/
+type SetAttributionReportingLocalTestingModeParams struct {
+	// If enabled, noise is suppressed and reports are sent immediately.
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetAttributionReportingLocalTestingModeResult represents the result of calls to Storage.setAttributionReportingLocalTestingMode.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingLocalTestingMode
+*/
+type SetAttributionReportingLocalTestingModeResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAttributionReportingTrackingParams represents Storage.setAttributionReportingTracking parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingTracking
+*/
+type SetAttributionReportingTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+/*
+SetAttributionReportingTrackingResult represents the result of calls to Storage.setAttributionReportingTracking.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setAttributionReportingTracking
+*/
+type SetAttributionReportingTrackingResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SendPendingAttributionReportsResult represents the result of calls to Storage.sendPendingAttributionReports.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-sendPendingAttributionReports
+*/
+type SendPendingAttributionReportsResult struct {
+	// The number of reports that were sent.
+	NumSent int `json:"numSent"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetRelatedWebsiteSetsResult represents the result of calls to Storage.getRelatedWebsiteSets.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getRelatedWebsiteSets
+*/
+type GetRelatedWebsiteSetsResult struct {
+	Sets []*RelatedWebsiteSet `json:"sets"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetAffectedURLsForThirdPartyCookieMetadataParams represents Storage.getAffectedUrlsForThirdPartyCookieMetadata parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getAffectedUrlsForThirdPartyCookieMetadata
+*/
+type GetAffectedURLsForThirdPartyCookieMetadataParams struct {
+	// The URL of the page currently being visited.
+	FirstPartyURL string `json:"firstPartyUrl"`
+
+	// The list of embedded resource URLs from the page.
+	ThirdPartyURLs []string `json:"thirdPartyUrls"`
+}
+
+/*
+GetAffectedURLsForThirdPartyCookieMetadataResult represents the result of calls to Storage.getAffectedUrlsForThirdPartyCookieMetadata.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-getAffectedUrlsForThirdPartyCookieMetadata
+*/
+type GetAffectedURLsForThirdPartyCookieMetadataResult struct {
+	// Array of matching URLs. If there is a primary pattern match for the first-
+	// party URL, only the first-party URL is returned in the array.
+	MatchedURLs []string `json:"matchedUrls"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetProtectedAudienceKAnonymityParams represents Storage.setProtectedAudienceKAnonymity parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setProtectedAudienceKAnonymity
+*/
+type SetProtectedAudienceKAnonymityParams struct {
+	Owner string `json:"owner"`
+
+	Name string `json:"name"`
+
+	Hashes []string `json:"hashes"`
+}
+
+/*
+SetProtectedAudienceKAnonymityResult represents the result of calls to Storage.setProtectedAudienceKAnonymity.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#method-setProtectedAudienceKAnonymity
+*/
+type SetProtectedAudienceKAnonymityResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,161 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingAggregatableResultEnum struct {
+	Success                             AttributionReportingAggregatableResultEnum
+	InternalError                       AttributionReportingAggregatableResultEnum
+	NoCapacityForAttributionDestination AttributionReportingAggregatableResultEnum
+	NoMatchingSources                   AttributionReportingAggregatableResultEnum
+	ExcessiveAttributions               AttributionReportingAggregatableResultEnum
+	ExcessiveReportingOrigins           AttributionReportingAggregatableResultEnum
+	NoHistograms                        AttributionReportingAggregatableResultEnum
+	InsufficientBudget                  AttributionReportingAggregatableResultEnum
+	InsufficientNamedBudget             AttributionReportingAggregatableResultEnum
+	NoMatchingSourceFilterData          AttributionReportingAggregatableResultEnum
+	NotRegistered                       AttributionReportingAggregatableResultEnum
+	ProhibitedByBrowserPolicy           AttributionReportingAggregatableResultEnum
+	Deduplicated                        AttributionReportingAggregatableResultEnum
+	ReportWindowPassed                  AttributionReportingAggregatableResultEnum
+	ExcessiveReports                    AttributionReportingAggregatableResultEnum
+}
+
+/*
+AttributionReportingAggregatableResult provides named access to the AttributionReportingAggregatableResultEnum values.
+*/
+var AttributionReportingAggregatableResult = attributionReportingAggregatableResultEnum{
+	Success:                             attributionReportingAggregatableResultSuccess,
+	InternalError:                       attributionReportingAggregatableResultInternalError,
+	NoCapacityForAttributionDestination: attributionReportingAggregatableResultNoCapacityForAttributionDestination,
+	NoMatchingSources:                   attributionReportingAggregatableResultNoMatchingSources,
+	ExcessiveAttributions:               attributionReportingAggregatableResultExcessiveAttributions,
+	ExcessiveReportingOrigins:           attributionReportingAggregatableResultExcessiveReportingOrigins,
+	NoHistograms:                        attributionReportingAggregatableResultNoHistograms,
+	InsufficientBudget:                  attributionReportingAggregatableResultInsufficientBudget,
+	InsufficientNamedBudget:             attributionReportingAggregatableResultInsufficientNamedBudget,
+	NoMatchingSourceFilterData:          attributionReportingAggregatableResultNoMatchingSourceFilterData,
+	NotRegistered:                       attributionReportingAggregatableResultNotRegistered,
+	ProhibitedByBrowserPolicy:           attributionReportingAggregatableResultProhibitedByBrowserPolicy,
+	Deduplicated:                        attributionReportingAggregatableResultDeduplicated,
+	ReportWindowPassed:                  attributionReportingAggregatableResultReportWindowPassed,
+	ExcessiveReports:                    attributionReportingAggregatableResultExcessiveReports,
+}
+
+/*
+AttributionReportingAggregatableResultEnum represents the
+Storage.AttributionReportingAggregatableResult values.
+
+Allowed values:
+  - AttributionReportingAggregatableResult.Success                             "success"
+  - AttributionReportingAggregatableResult.InternalError                       "internalError"
+  - AttributionReportingAggregatableResult.NoCapacityForAttributionDestination "noCapacityForAttributionDestination"
+  - AttributionReportingAggregatableResult.NoMatchingSources                   "noMatchingSources"
+  - AttributionReportingAggregatableResult.ExcessiveAttributions               "excessiveAttributions"
+  - AttributionReportingAggregatableResult.ExcessiveReportingOrigins           "excessiveReportingOrigins"
+  - AttributionReportingAggregatableResult.NoHistograms                        "noHistograms"
+  - AttributionReportingAggregatableResult.InsufficientBudget                  "insufficientBudget"
+  - AttributionReportingAggregatableResult.InsufficientNamedBudget             "insufficientNamedBudget"
+  - AttributionReportingAggregatableResult.NoMatchingSourceFilterData          "noMatchingSourceFilterData"
+  - AttributionReportingAggregatableResult.NotRegistered                       "notRegistered"
+  - AttributionReportingAggregatableResult.ProhibitedByBrowserPolicy           "prohibitedByBrowserPolicy"
+  - AttributionReportingAggregatableResult.Deduplicated                        "deduplicated"
+  - AttributionReportingAggregatableResult.ReportWindowPassed                  "reportWindowPassed"
+  - AttributionReportingAggregatableResult.ExcessiveReports                    "excessiveReports"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingAggregatableResult
+*/
+type AttributionReportingAggregatableResultEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingAggregatableResultEnum) String() string {
+	return _attributionReportingAggregatableResultEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingAggregatableResultEnum) Unknown() bool {
+	return !_attributionReportingAggregatableResultEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingAggregatableResultEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingAggregatableResultEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingAggregatableResultEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingAggregatableResultSuccess represents the "success" value.
+	attributionReportingAggregatableResultSuccess AttributionReportingAggregatableResultEnum = iota + 1
+	// attributionReportingAggregatableResultInternalError represents the "internalError" value.
+	attributionReportingAggregatableResultInternalError
+	// attributionReportingAggregatableResultNoCapacityForAttributionDestination represents the "noCapacityForAttributionDestination" value.
+	attributionReportingAggregatableResultNoCapacityForAttributionDestination
+	// attributionReportingAggregatableResultNoMatchingSources represents the "noMatchingSources" value.
+	attributionReportingAggregatableResultNoMatchingSources
+	// attributionReportingAggregatableResultExcessiveAttributions represents the "excessiveAttributions" value.
+	attributionReportingAggregatableResultExcessiveAttributions
+	// attributionReportingAggregatableResultExcessiveReportingOrigins represents the "excessiveReportingOrigins" value.
+	attributionReportingAggregatableResultExcessiveReportingOrigins
+	// attributionReportingAggregatableResultNoHistograms represents the "noHistograms" value.
+	attributionReportingAggregatableResultNoHistograms
+	// attributionReportingAggregatableResultInsufficientBudget represents the "insufficientBudget" value.
+	attributionReportingAggregatableResultInsufficientBudget
+	// attributionReportingAggregatableResultInsufficientNamedBudget represents the "insufficientNamedBudget" value.
+	attributionReportingAggregatableResultInsufficientNamedBudget
+	// attributionReportingAggregatableResultNoMatchingSourceFilterData represents the "noMatchingSourceFilterData" value.
+	attributionReportingAggregatableResultNoMatchingSourceFilterData
+	// attributionReportingAggregatableResultNotRegistered represents the "notRegistered" value.
+	attributionReportingAggregatableResultNotRegistered
+	// attributionReportingAggregatableResultProhibitedByBrowserPolicy represents the "prohibitedByBrowserPolicy" value.
+	attributionReportingAggregatableResultProhibitedByBrowserPolicy
+	// attributionReportingAggregatableResultDeduplicated represents the "deduplicated" value.
+	attributionReportingAggregatableResultDeduplicated
+	// attributionReportingAggregatableResultReportWindowPassed represents the "reportWindowPassed" value.
+	attributionReportingAggregatableResultReportWindowPassed
+	// attributionReportingAggregatableResultExcessiveReports represents the "excessiveReports" value.
+	attributionReportingAggregatableResultExcessiveReports
+)
+
+var _attributionReportingAggregatableResultEnums = enums.New(map[AttributionReportingAggregatableResultEnum]string{
+	AttributionReportingAggregatableResultEnum(0):                             "",
+	attributionReportingAggregatableResultSuccess:                             "success",
+	attributionReportingAggregatableResultInternalError:                       "internalError",
+	attributionReportingAggregatableResultNoCapacityForAttributionDestination: "noCapacityForAttributionDestination",
+	attributionReportingAggregatableResultNoMatchingSources:                   "noMatchingSources",
+	attributionReportingAggregatableResultExcessiveAttributions:               "excessiveAttributions",
+	attributionReportingAggregatableResultExcessiveReportingOrigins:           "excessiveReportingOrigins",
+	attributionReportingAggregatableResultNoHistograms:                        "noHistograms",
+	attributionReportingAggregatableResultInsufficientBudget:                  "insufficientBudget",
+	attributionReportingAggregatableResultInsufficientNamedBudget:             "insufficientNamedBudget",
+	attributionReportingAggregatableResultNoMatchingSourceFilterData:          "noMatchingSourceFilterData",
+	attributionReportingAggregatableResultNotRegistered:                       "notRegistered",
+	attributionReportingAggregatableResultProhibitedByBrowserPolicy:           "prohibitedByBrowserPolicy",
+	attributionReportingAggregatableResultDeduplicated:                        "deduplicated",
+	attributionReportingAggregatableResultReportWindowPassed:                  "reportWindowPassed",
+	attributionReportingAggregatableResultExcessiveReports:                    "excessiveReports",
+})
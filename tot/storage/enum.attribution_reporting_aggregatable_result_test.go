@@ -0,0 +1,238 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingAggregatableResult(t *testing.T) {
+	var enum AttributionReportingAggregatableResultEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingAggregatableResultEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingAggregatableResult.Success
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"success"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"success"`, result)
+	}
+	json.Unmarshal([]byte(`"success"`), &enum)
+	if AttributionReportingAggregatableResult.Success != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.Success, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.InternalError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"internalError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"internalError"`, result)
+	}
+	json.Unmarshal([]byte(`"internalError"`), &enum)
+	if AttributionReportingAggregatableResult.InternalError != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.InternalError, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.NoCapacityForAttributionDestination
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noCapacityForAttributionDestination"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noCapacityForAttributionDestination"`, result)
+	}
+	json.Unmarshal([]byte(`"noCapacityForAttributionDestination"`), &enum)
+	if AttributionReportingAggregatableResult.NoCapacityForAttributionDestination != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.NoCapacityForAttributionDestination, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.NoMatchingSources
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingSources"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingSources"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingSources"`), &enum)
+	if AttributionReportingAggregatableResult.NoMatchingSources != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.NoMatchingSources, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.ExcessiveAttributions
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveAttributions"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveAttributions"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveAttributions"`), &enum)
+	if AttributionReportingAggregatableResult.ExcessiveAttributions != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.ExcessiveAttributions, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.ExcessiveReportingOrigins
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveReportingOrigins"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveReportingOrigins"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveReportingOrigins"`), &enum)
+	if AttributionReportingAggregatableResult.ExcessiveReportingOrigins != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.ExcessiveReportingOrigins, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.NoHistograms
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noHistograms"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noHistograms"`, result)
+	}
+	json.Unmarshal([]byte(`"noHistograms"`), &enum)
+	if AttributionReportingAggregatableResult.NoHistograms != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.NoHistograms, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.InsufficientBudget
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insufficientBudget"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insufficientBudget"`, result)
+	}
+	json.Unmarshal([]byte(`"insufficientBudget"`), &enum)
+	if AttributionReportingAggregatableResult.InsufficientBudget != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.InsufficientBudget, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.InsufficientNamedBudget
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insufficientNamedBudget"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insufficientNamedBudget"`, result)
+	}
+	json.Unmarshal([]byte(`"insufficientNamedBudget"`), &enum)
+	if AttributionReportingAggregatableResult.InsufficientNamedBudget != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.InsufficientNamedBudget, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.NoMatchingSourceFilterData
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingSourceFilterData"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingSourceFilterData"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingSourceFilterData"`), &enum)
+	if AttributionReportingAggregatableResult.NoMatchingSourceFilterData != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.NoMatchingSourceFilterData, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.NotRegistered
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"notRegistered"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"notRegistered"`, result)
+	}
+	json.Unmarshal([]byte(`"notRegistered"`), &enum)
+	if AttributionReportingAggregatableResult.NotRegistered != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.NotRegistered, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.ProhibitedByBrowserPolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prohibitedByBrowserPolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prohibitedByBrowserPolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"prohibitedByBrowserPolicy"`), &enum)
+	if AttributionReportingAggregatableResult.ProhibitedByBrowserPolicy != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.ProhibitedByBrowserPolicy, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.Deduplicated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deduplicated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deduplicated"`, result)
+	}
+	json.Unmarshal([]byte(`"deduplicated"`), &enum)
+	if AttributionReportingAggregatableResult.Deduplicated != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.Deduplicated, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.ReportWindowPassed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"reportWindowPassed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"reportWindowPassed"`, result)
+	}
+	json.Unmarshal([]byte(`"reportWindowPassed"`), &enum)
+	if AttributionReportingAggregatableResult.ReportWindowPassed != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.ReportWindowPassed, enum)
+	}
+
+	enum = AttributionReportingAggregatableResult.ExcessiveReports
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveReports"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveReports"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveReports"`), &enum)
+	if AttributionReportingAggregatableResult.ExcessiveReports != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingAggregatableResult.ExcessiveReports, enum)
+	}
+}
@@ -0,0 +1,185 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingEventLevelResultEnum struct {
+	Success                             AttributionReportingEventLevelResultEnum
+	SuccessDroppedLowerPriority         AttributionReportingEventLevelResultEnum
+	InternalError                       AttributionReportingEventLevelResultEnum
+	NoCapacityForAttributionDestination AttributionReportingEventLevelResultEnum
+	NoMatchingSources                   AttributionReportingEventLevelResultEnum
+	Deduplicated                        AttributionReportingEventLevelResultEnum
+	ExcessiveAttributions               AttributionReportingEventLevelResultEnum
+	PriorityTooLow                      AttributionReportingEventLevelResultEnum
+	NeverAttributedSource               AttributionReportingEventLevelResultEnum
+	ExcessiveReportingOrigins           AttributionReportingEventLevelResultEnum
+	NoMatchingSourceFilterData          AttributionReportingEventLevelResultEnum
+	ProhibitedByBrowserPolicy           AttributionReportingEventLevelResultEnum
+	NoMatchingConfigurations            AttributionReportingEventLevelResultEnum
+	ExcessiveReports                    AttributionReportingEventLevelResultEnum
+	FalselyAttributedSource             AttributionReportingEventLevelResultEnum
+	ReportWindowPassed                  AttributionReportingEventLevelResultEnum
+	NotRegistered                       AttributionReportingEventLevelResultEnum
+	ReportWindowNotStarted              AttributionReportingEventLevelResultEnum
+	NoMatchingTriggerData               AttributionReportingEventLevelResultEnum
+}
+
+/*
+AttributionReportingEventLevelResult provides named access to the AttributionReportingEventLevelResultEnum values.
+*/
+var AttributionReportingEventLevelResult = attributionReportingEventLevelResultEnum{
+	Success:                             attributionReportingEventLevelResultSuccess,
+	SuccessDroppedLowerPriority:         attributionReportingEventLevelResultSuccessDroppedLowerPriority,
+	InternalError:                       attributionReportingEventLevelResultInternalError,
+	NoCapacityForAttributionDestination: attributionReportingEventLevelResultNoCapacityForAttributionDestination,
+	NoMatchingSources:                   attributionReportingEventLevelResultNoMatchingSources,
+	Deduplicated:                        attributionReportingEventLevelResultDeduplicated,
+	ExcessiveAttributions:               attributionReportingEventLevelResultExcessiveAttributions,
+	PriorityTooLow:                      attributionReportingEventLevelResultPriorityTooLow,
+	NeverAttributedSource:               attributionReportingEventLevelResultNeverAttributedSource,
+	ExcessiveReportingOrigins:           attributionReportingEventLevelResultExcessiveReportingOrigins,
+	NoMatchingSourceFilterData:          attributionReportingEventLevelResultNoMatchingSourceFilterData,
+	ProhibitedByBrowserPolicy:           attributionReportingEventLevelResultProhibitedByBrowserPolicy,
+	NoMatchingConfigurations:            attributionReportingEventLevelResultNoMatchingConfigurations,
+	ExcessiveReports:                    attributionReportingEventLevelResultExcessiveReports,
+	FalselyAttributedSource:             attributionReportingEventLevelResultFalselyAttributedSource,
+	ReportWindowPassed:                  attributionReportingEventLevelResultReportWindowPassed,
+	NotRegistered:                       attributionReportingEventLevelResultNotRegistered,
+	ReportWindowNotStarted:              attributionReportingEventLevelResultReportWindowNotStarted,
+	NoMatchingTriggerData:               attributionReportingEventLevelResultNoMatchingTriggerData,
+}
+
+/*
+AttributionReportingEventLevelResultEnum represents the
+Storage.AttributionReportingEventLevelResult values.
+
+Allowed values:
+  - AttributionReportingEventLevelResult.Success                             "success"
+  - AttributionReportingEventLevelResult.SuccessDroppedLowerPriority         "successDroppedLowerPriority"
+  - AttributionReportingEventLevelResult.InternalError                       "internalError"
+  - AttributionReportingEventLevelResult.NoCapacityForAttributionDestination "noCapacityForAttributionDestination"
+  - AttributionReportingEventLevelResult.NoMatchingSources                   "noMatchingSources"
+  - AttributionReportingEventLevelResult.Deduplicated                        "deduplicated"
+  - AttributionReportingEventLevelResult.ExcessiveAttributions               "excessiveAttributions"
+  - AttributionReportingEventLevelResult.PriorityTooLow                      "priorityTooLow"
+  - AttributionReportingEventLevelResult.NeverAttributedSource               "neverAttributedSource"
+  - AttributionReportingEventLevelResult.ExcessiveReportingOrigins           "excessiveReportingOrigins"
+  - AttributionReportingEventLevelResult.NoMatchingSourceFilterData          "noMatchingSourceFilterData"
+  - AttributionReportingEventLevelResult.ProhibitedByBrowserPolicy           "prohibitedByBrowserPolicy"
+  - AttributionReportingEventLevelResult.NoMatchingConfigurations            "noMatchingConfigurations"
+  - AttributionReportingEventLevelResult.ExcessiveReports                    "excessiveReports"
+  - AttributionReportingEventLevelResult.FalselyAttributedSource             "falselyAttributedSource"
+  - AttributionReportingEventLevelResult.ReportWindowPassed                  "reportWindowPassed"
+  - AttributionReportingEventLevelResult.NotRegistered                       "notRegistered"
+  - AttributionReportingEventLevelResult.ReportWindowNotStarted              "reportWindowNotStarted"
+  - AttributionReportingEventLevelResult.NoMatchingTriggerData               "noMatchingTriggerData"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingEventLevelResult
+*/
+type AttributionReportingEventLevelResultEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingEventLevelResultEnum) String() string {
+	return _attributionReportingEventLevelResultEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingEventLevelResultEnum) Unknown() bool {
+	return !_attributionReportingEventLevelResultEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingEventLevelResultEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingEventLevelResultEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingEventLevelResultEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingEventLevelResultSuccess represents the "success" value.
+	attributionReportingEventLevelResultSuccess AttributionReportingEventLevelResultEnum = iota + 1
+	// attributionReportingEventLevelResultSuccessDroppedLowerPriority represents the "successDroppedLowerPriority" value.
+	attributionReportingEventLevelResultSuccessDroppedLowerPriority
+	// attributionReportingEventLevelResultInternalError represents the "internalError" value.
+	attributionReportingEventLevelResultInternalError
+	// attributionReportingEventLevelResultNoCapacityForAttributionDestination represents the "noCapacityForAttributionDestination" value.
+	attributionReportingEventLevelResultNoCapacityForAttributionDestination
+	// attributionReportingEventLevelResultNoMatchingSources represents the "noMatchingSources" value.
+	attributionReportingEventLevelResultNoMatchingSources
+	// attributionReportingEventLevelResultDeduplicated represents the "deduplicated" value.
+	attributionReportingEventLevelResultDeduplicated
+	// attributionReportingEventLevelResultExcessiveAttributions represents the "excessiveAttributions" value.
+	attributionReportingEventLevelResultExcessiveAttributions
+	// attributionReportingEventLevelResultPriorityTooLow represents the "priorityTooLow" value.
+	attributionReportingEventLevelResultPriorityTooLow
+	// attributionReportingEventLevelResultNeverAttributedSource represents the "neverAttributedSource" value.
+	attributionReportingEventLevelResultNeverAttributedSource
+	// attributionReportingEventLevelResultExcessiveReportingOrigins represents the "excessiveReportingOrigins" value.
+	attributionReportingEventLevelResultExcessiveReportingOrigins
+	// attributionReportingEventLevelResultNoMatchingSourceFilterData represents the "noMatchingSourceFilterData" value.
+	attributionReportingEventLevelResultNoMatchingSourceFilterData
+	// attributionReportingEventLevelResultProhibitedByBrowserPolicy represents the "prohibitedByBrowserPolicy" value.
+	attributionReportingEventLevelResultProhibitedByBrowserPolicy
+	// attributionReportingEventLevelResultNoMatchingConfigurations represents the "noMatchingConfigurations" value.
+	attributionReportingEventLevelResultNoMatchingConfigurations
+	// attributionReportingEventLevelResultExcessiveReports represents the "excessiveReports" value.
+	attributionReportingEventLevelResultExcessiveReports
+	// attributionReportingEventLevelResultFalselyAttributedSource represents the "falselyAttributedSource" value.
+	attributionReportingEventLevelResultFalselyAttributedSource
+	// attributionReportingEventLevelResultReportWindowPassed represents the "reportWindowPassed" value.
+	attributionReportingEventLevelResultReportWindowPassed
+	// attributionReportingEventLevelResultNotRegistered represents the "notRegistered" value.
+	attributionReportingEventLevelResultNotRegistered
+	// attributionReportingEventLevelResultReportWindowNotStarted represents the "reportWindowNotStarted" value.
+	attributionReportingEventLevelResultReportWindowNotStarted
+	// attributionReportingEventLevelResultNoMatchingTriggerData represents the "noMatchingTriggerData" value.
+	attributionReportingEventLevelResultNoMatchingTriggerData
+)
+
+var _attributionReportingEventLevelResultEnums = enums.New(map[AttributionReportingEventLevelResultEnum]string{
+	AttributionReportingEventLevelResultEnum(0):                             "",
+	attributionReportingEventLevelResultSuccess:                             "success",
+	attributionReportingEventLevelResultSuccessDroppedLowerPriority:         "successDroppedLowerPriority",
+	attributionReportingEventLevelResultInternalError:                       "internalError",
+	attributionReportingEventLevelResultNoCapacityForAttributionDestination: "noCapacityForAttributionDestination",
+	attributionReportingEventLevelResultNoMatchingSources:                   "noMatchingSources",
+	attributionReportingEventLevelResultDeduplicated:                        "deduplicated",
+	attributionReportingEventLevelResultExcessiveAttributions:               "excessiveAttributions",
+	attributionReportingEventLevelResultPriorityTooLow:                      "priorityTooLow",
+	attributionReportingEventLevelResultNeverAttributedSource:               "neverAttributedSource",
+	attributionReportingEventLevelResultExcessiveReportingOrigins:           "excessiveReportingOrigins",
+	attributionReportingEventLevelResultNoMatchingSourceFilterData:          "noMatchingSourceFilterData",
+	attributionReportingEventLevelResultProhibitedByBrowserPolicy:           "prohibitedByBrowserPolicy",
+	attributionReportingEventLevelResultNoMatchingConfigurations:            "noMatchingConfigurations",
+	attributionReportingEventLevelResultExcessiveReports:                    "excessiveReports",
+	attributionReportingEventLevelResultFalselyAttributedSource:             "falselyAttributedSource",
+	attributionReportingEventLevelResultReportWindowPassed:                  "reportWindowPassed",
+	attributionReportingEventLevelResultNotRegistered:                       "notRegistered",
+	attributionReportingEventLevelResultReportWindowNotStarted:              "reportWindowNotStarted",
+	attributionReportingEventLevelResultNoMatchingTriggerData:               "noMatchingTriggerData",
+})
@@ -0,0 +1,290 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingEventLevelResult(t *testing.T) {
+	var enum AttributionReportingEventLevelResultEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingEventLevelResultEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingEventLevelResult.Success
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"success"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"success"`, result)
+	}
+	json.Unmarshal([]byte(`"success"`), &enum)
+	if AttributionReportingEventLevelResult.Success != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.Success, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.SuccessDroppedLowerPriority
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"successDroppedLowerPriority"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"successDroppedLowerPriority"`, result)
+	}
+	json.Unmarshal([]byte(`"successDroppedLowerPriority"`), &enum)
+	if AttributionReportingEventLevelResult.SuccessDroppedLowerPriority != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.SuccessDroppedLowerPriority, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.InternalError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"internalError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"internalError"`, result)
+	}
+	json.Unmarshal([]byte(`"internalError"`), &enum)
+	if AttributionReportingEventLevelResult.InternalError != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.InternalError, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NoCapacityForAttributionDestination
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noCapacityForAttributionDestination"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noCapacityForAttributionDestination"`, result)
+	}
+	json.Unmarshal([]byte(`"noCapacityForAttributionDestination"`), &enum)
+	if AttributionReportingEventLevelResult.NoCapacityForAttributionDestination != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NoCapacityForAttributionDestination, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NoMatchingSources
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingSources"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingSources"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingSources"`), &enum)
+	if AttributionReportingEventLevelResult.NoMatchingSources != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NoMatchingSources, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.Deduplicated
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"deduplicated"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"deduplicated"`, result)
+	}
+	json.Unmarshal([]byte(`"deduplicated"`), &enum)
+	if AttributionReportingEventLevelResult.Deduplicated != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.Deduplicated, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ExcessiveAttributions
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveAttributions"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveAttributions"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveAttributions"`), &enum)
+	if AttributionReportingEventLevelResult.ExcessiveAttributions != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ExcessiveAttributions, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.PriorityTooLow
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"priorityTooLow"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"priorityTooLow"`, result)
+	}
+	json.Unmarshal([]byte(`"priorityTooLow"`), &enum)
+	if AttributionReportingEventLevelResult.PriorityTooLow != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.PriorityTooLow, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NeverAttributedSource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"neverAttributedSource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"neverAttributedSource"`, result)
+	}
+	json.Unmarshal([]byte(`"neverAttributedSource"`), &enum)
+	if AttributionReportingEventLevelResult.NeverAttributedSource != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NeverAttributedSource, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ExcessiveReportingOrigins
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveReportingOrigins"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveReportingOrigins"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveReportingOrigins"`), &enum)
+	if AttributionReportingEventLevelResult.ExcessiveReportingOrigins != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ExcessiveReportingOrigins, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NoMatchingSourceFilterData
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingSourceFilterData"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingSourceFilterData"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingSourceFilterData"`), &enum)
+	if AttributionReportingEventLevelResult.NoMatchingSourceFilterData != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NoMatchingSourceFilterData, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ProhibitedByBrowserPolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prohibitedByBrowserPolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prohibitedByBrowserPolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"prohibitedByBrowserPolicy"`), &enum)
+	if AttributionReportingEventLevelResult.ProhibitedByBrowserPolicy != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ProhibitedByBrowserPolicy, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NoMatchingConfigurations
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingConfigurations"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingConfigurations"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingConfigurations"`), &enum)
+	if AttributionReportingEventLevelResult.NoMatchingConfigurations != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NoMatchingConfigurations, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ExcessiveReports
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveReports"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveReports"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveReports"`), &enum)
+	if AttributionReportingEventLevelResult.ExcessiveReports != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ExcessiveReports, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.FalselyAttributedSource
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"falselyAttributedSource"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"falselyAttributedSource"`, result)
+	}
+	json.Unmarshal([]byte(`"falselyAttributedSource"`), &enum)
+	if AttributionReportingEventLevelResult.FalselyAttributedSource != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.FalselyAttributedSource, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ReportWindowPassed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"reportWindowPassed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"reportWindowPassed"`, result)
+	}
+	json.Unmarshal([]byte(`"reportWindowPassed"`), &enum)
+	if AttributionReportingEventLevelResult.ReportWindowPassed != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ReportWindowPassed, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NotRegistered
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"notRegistered"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"notRegistered"`, result)
+	}
+	json.Unmarshal([]byte(`"notRegistered"`), &enum)
+	if AttributionReportingEventLevelResult.NotRegistered != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NotRegistered, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.ReportWindowNotStarted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"reportWindowNotStarted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"reportWindowNotStarted"`, result)
+	}
+	json.Unmarshal([]byte(`"reportWindowNotStarted"`), &enum)
+	if AttributionReportingEventLevelResult.ReportWindowNotStarted != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.ReportWindowNotStarted, enum)
+	}
+
+	enum = AttributionReportingEventLevelResult.NoMatchingTriggerData
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"noMatchingTriggerData"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"noMatchingTriggerData"`, result)
+	}
+	json.Unmarshal([]byte(`"noMatchingTriggerData"`), &enum)
+	if AttributionReportingEventLevelResult.NoMatchingTriggerData != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingEventLevelResult.NoMatchingTriggerData, enum)
+	}
+}
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingReportResultEnum struct {
+	Sent             AttributionReportingReportResultEnum
+	Prohibited       AttributionReportingReportResultEnum
+	FailedToAssemble AttributionReportingReportResultEnum
+	Expired          AttributionReportingReportResultEnum
+}
+
+/*
+AttributionReportingReportResult provides named access to the AttributionReportingReportResultEnum values.
+*/
+var AttributionReportingReportResult = attributionReportingReportResultEnum{
+	Sent:             attributionReportingReportResultSent,
+	Prohibited:       attributionReportingReportResultProhibited,
+	FailedToAssemble: attributionReportingReportResultFailedToAssemble,
+	Expired:          attributionReportingReportResultExpired,
+}
+
+/*
+AttributionReportingReportResultEnum represents the
+Storage.AttributionReportingReportResult values.
+
+Allowed values:
+  - AttributionReportingReportResult.Sent             "sent"
+  - AttributionReportingReportResult.Prohibited       "prohibited"
+  - AttributionReportingReportResult.FailedToAssemble "failedToAssemble"
+  - AttributionReportingReportResult.Expired          "expired"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingReportResult
+*/
+type AttributionReportingReportResultEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingReportResultEnum) String() string {
+	return _attributionReportingReportResultEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingReportResultEnum) Unknown() bool {
+	return !_attributionReportingReportResultEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingReportResultEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingReportResultEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingReportResultEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingReportResultSent represents the "sent" value.
+	attributionReportingReportResultSent AttributionReportingReportResultEnum = iota + 1
+	// attributionReportingReportResultProhibited represents the "prohibited" value.
+	attributionReportingReportResultProhibited
+	// attributionReportingReportResultFailedToAssemble represents the "failedToAssemble" value.
+	attributionReportingReportResultFailedToAssemble
+	// attributionReportingReportResultExpired represents the "expired" value.
+	attributionReportingReportResultExpired
+)
+
+var _attributionReportingReportResultEnums = enums.New(map[AttributionReportingReportResultEnum]string{
+	AttributionReportingReportResultEnum(0):          "",
+	attributionReportingReportResultSent:             "sent",
+	attributionReportingReportResultProhibited:       "prohibited",
+	attributionReportingReportResultFailedToAssemble: "failedToAssemble",
+	attributionReportingReportResultExpired:          "expired",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingReportResult(t *testing.T) {
+	var enum AttributionReportingReportResultEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingReportResultEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingReportResult.Sent
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sent"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sent"`, result)
+	}
+	json.Unmarshal([]byte(`"sent"`), &enum)
+	if AttributionReportingReportResult.Sent != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingReportResult.Sent, enum)
+	}
+
+	enum = AttributionReportingReportResult.Prohibited
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prohibited"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prohibited"`, result)
+	}
+	json.Unmarshal([]byte(`"prohibited"`), &enum)
+	if AttributionReportingReportResult.Prohibited != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingReportResult.Prohibited, enum)
+	}
+
+	enum = AttributionReportingReportResult.FailedToAssemble
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"failedToAssemble"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"failedToAssemble"`, result)
+	}
+	json.Unmarshal([]byte(`"failedToAssemble"`), &enum)
+	if AttributionReportingReportResult.FailedToAssemble != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingReportResult.FailedToAssemble, enum)
+	}
+
+	enum = AttributionReportingReportResult.Expired
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"expired"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"expired"`, result)
+	}
+	json.Unmarshal([]byte(`"expired"`), &enum)
+	if AttributionReportingReportResult.Expired != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingReportResult.Expired, enum)
+	}
+}
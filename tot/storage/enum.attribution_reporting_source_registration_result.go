@@ -0,0 +1,167 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingSourceRegistrationResultEnum struct {
+	Success                                AttributionReportingSourceRegistrationResultEnum
+	InternalError                          AttributionReportingSourceRegistrationResultEnum
+	InsufficientSourceCapacity             AttributionReportingSourceRegistrationResultEnum
+	InsufficientUniqueDestinationCapacity  AttributionReportingSourceRegistrationResultEnum
+	ExcessiveReportingOrigins              AttributionReportingSourceRegistrationResultEnum
+	ProhibitedByBrowserPolicy              AttributionReportingSourceRegistrationResultEnum
+	SuccessNoised                          AttributionReportingSourceRegistrationResultEnum
+	DestinationReportingLimitReached       AttributionReportingSourceRegistrationResultEnum
+	DestinationGlobalLimitReached          AttributionReportingSourceRegistrationResultEnum
+	DestinationBothLimitsReached           AttributionReportingSourceRegistrationResultEnum
+	ReportingOriginsPerSiteLimitReached    AttributionReportingSourceRegistrationResultEnum
+	ExceedsMaxChannelCapacity              AttributionReportingSourceRegistrationResultEnum
+	ExceedsMaxScopesChannelCapacity        AttributionReportingSourceRegistrationResultEnum
+	ExceedsMaxTriggerStateCardinality      AttributionReportingSourceRegistrationResultEnum
+	ExceedsMaxEventStatesLimit             AttributionReportingSourceRegistrationResultEnum
+	DestinationPerDayReportingLimitReached AttributionReportingSourceRegistrationResultEnum
+}
+
+/*
+AttributionReportingSourceRegistrationResult provides named access to the AttributionReportingSourceRegistrationResultEnum values.
+*/
+var AttributionReportingSourceRegistrationResult = attributionReportingSourceRegistrationResultEnum{
+	Success:                                attributionReportingSourceRegistrationResultSuccess,
+	InternalError:                          attributionReportingSourceRegistrationResultInternalError,
+	InsufficientSourceCapacity:             attributionReportingSourceRegistrationResultInsufficientSourceCapacity,
+	InsufficientUniqueDestinationCapacity:  attributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity,
+	ExcessiveReportingOrigins:              attributionReportingSourceRegistrationResultExcessiveReportingOrigins,
+	ProhibitedByBrowserPolicy:              attributionReportingSourceRegistrationResultProhibitedByBrowserPolicy,
+	SuccessNoised:                          attributionReportingSourceRegistrationResultSuccessNoised,
+	DestinationReportingLimitReached:       attributionReportingSourceRegistrationResultDestinationReportingLimitReached,
+	DestinationGlobalLimitReached:          attributionReportingSourceRegistrationResultDestinationGlobalLimitReached,
+	DestinationBothLimitsReached:           attributionReportingSourceRegistrationResultDestinationBothLimitsReached,
+	ReportingOriginsPerSiteLimitReached:    attributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached,
+	ExceedsMaxChannelCapacity:              attributionReportingSourceRegistrationResultExceedsMaxChannelCapacity,
+	ExceedsMaxScopesChannelCapacity:        attributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity,
+	ExceedsMaxTriggerStateCardinality:      attributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality,
+	ExceedsMaxEventStatesLimit:             attributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit,
+	DestinationPerDayReportingLimitReached: attributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached,
+}
+
+/*
+AttributionReportingSourceRegistrationResultEnum represents the
+Storage.AttributionReportingSourceRegistrationResult values.
+
+Allowed values:
+  - AttributionReportingSourceRegistrationResult.Success                                "success"
+  - AttributionReportingSourceRegistrationResult.InternalError                          "internalError"
+  - AttributionReportingSourceRegistrationResult.InsufficientSourceCapacity             "insufficientSourceCapacity"
+  - AttributionReportingSourceRegistrationResult.InsufficientUniqueDestinationCapacity  "insufficientUniqueDestinationCapacity"
+  - AttributionReportingSourceRegistrationResult.ExcessiveReportingOrigins              "excessiveReportingOrigins"
+  - AttributionReportingSourceRegistrationResult.ProhibitedByBrowserPolicy              "prohibitedByBrowserPolicy"
+  - AttributionReportingSourceRegistrationResult.SuccessNoised                          "successNoised"
+  - AttributionReportingSourceRegistrationResult.DestinationReportingLimitReached       "destinationReportingLimitReached"
+  - AttributionReportingSourceRegistrationResult.DestinationGlobalLimitReached          "destinationGlobalLimitReached"
+  - AttributionReportingSourceRegistrationResult.DestinationBothLimitsReached           "destinationBothLimitsReached"
+  - AttributionReportingSourceRegistrationResult.ReportingOriginsPerSiteLimitReached    "reportingOriginsPerSiteLimitReached"
+  - AttributionReportingSourceRegistrationResult.ExceedsMaxChannelCapacity              "exceedsMaxChannelCapacity"
+  - AttributionReportingSourceRegistrationResult.ExceedsMaxScopesChannelCapacity        "exceedsMaxScopesChannelCapacity"
+  - AttributionReportingSourceRegistrationResult.ExceedsMaxTriggerStateCardinality      "exceedsMaxTriggerStateCardinality"
+  - AttributionReportingSourceRegistrationResult.ExceedsMaxEventStatesLimit             "exceedsMaxEventStatesLimit"
+  - AttributionReportingSourceRegistrationResult.DestinationPerDayReportingLimitReached "destinationPerDayReportingLimitReached"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingSourceRegistrationResult
+*/
+type AttributionReportingSourceRegistrationResultEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingSourceRegistrationResultEnum) String() string {
+	return _attributionReportingSourceRegistrationResultEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingSourceRegistrationResultEnum) Unknown() bool {
+	return !_attributionReportingSourceRegistrationResultEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingSourceRegistrationResultEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingSourceRegistrationResultEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingSourceRegistrationResultEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingSourceRegistrationResultSuccess represents the "success" value.
+	attributionReportingSourceRegistrationResultSuccess AttributionReportingSourceRegistrationResultEnum = iota + 1
+	// attributionReportingSourceRegistrationResultInternalError represents the "internalError" value.
+	attributionReportingSourceRegistrationResultInternalError
+	// attributionReportingSourceRegistrationResultInsufficientSourceCapacity represents the "insufficientSourceCapacity" value.
+	attributionReportingSourceRegistrationResultInsufficientSourceCapacity
+	// attributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity represents the "insufficientUniqueDestinationCapacity" value.
+	attributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity
+	// attributionReportingSourceRegistrationResultExcessiveReportingOrigins represents the "excessiveReportingOrigins" value.
+	attributionReportingSourceRegistrationResultExcessiveReportingOrigins
+	// attributionReportingSourceRegistrationResultProhibitedByBrowserPolicy represents the "prohibitedByBrowserPolicy" value.
+	attributionReportingSourceRegistrationResultProhibitedByBrowserPolicy
+	// attributionReportingSourceRegistrationResultSuccessNoised represents the "successNoised" value.
+	attributionReportingSourceRegistrationResultSuccessNoised
+	// attributionReportingSourceRegistrationResultDestinationReportingLimitReached represents the "destinationReportingLimitReached" value.
+	attributionReportingSourceRegistrationResultDestinationReportingLimitReached
+	// attributionReportingSourceRegistrationResultDestinationGlobalLimitReached represents the "destinationGlobalLimitReached" value.
+	attributionReportingSourceRegistrationResultDestinationGlobalLimitReached
+	// attributionReportingSourceRegistrationResultDestinationBothLimitsReached represents the "destinationBothLimitsReached" value.
+	attributionReportingSourceRegistrationResultDestinationBothLimitsReached
+	// attributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached represents the "reportingOriginsPerSiteLimitReached" value.
+	attributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached
+	// attributionReportingSourceRegistrationResultExceedsMaxChannelCapacity represents the "exceedsMaxChannelCapacity" value.
+	attributionReportingSourceRegistrationResultExceedsMaxChannelCapacity
+	// attributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity represents the "exceedsMaxScopesChannelCapacity" value.
+	attributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity
+	// attributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality represents the "exceedsMaxTriggerStateCardinality" value.
+	attributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality
+	// attributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit represents the "exceedsMaxEventStatesLimit" value.
+	attributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit
+	// attributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached represents the "destinationPerDayReportingLimitReached" value.
+	attributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached
+)
+
+var _attributionReportingSourceRegistrationResultEnums = enums.New(map[AttributionReportingSourceRegistrationResultEnum]string{
+	AttributionReportingSourceRegistrationResultEnum(0):                                "",
+	attributionReportingSourceRegistrationResultSuccess:                                "success",
+	attributionReportingSourceRegistrationResultInternalError:                          "internalError",
+	attributionReportingSourceRegistrationResultInsufficientSourceCapacity:             "insufficientSourceCapacity",
+	attributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity:  "insufficientUniqueDestinationCapacity",
+	attributionReportingSourceRegistrationResultExcessiveReportingOrigins:              "excessiveReportingOrigins",
+	attributionReportingSourceRegistrationResultProhibitedByBrowserPolicy:              "prohibitedByBrowserPolicy",
+	attributionReportingSourceRegistrationResultSuccessNoised:                          "successNoised",
+	attributionReportingSourceRegistrationResultDestinationReportingLimitReached:       "destinationReportingLimitReached",
+	attributionReportingSourceRegistrationResultDestinationGlobalLimitReached:          "destinationGlobalLimitReached",
+	attributionReportingSourceRegistrationResultDestinationBothLimitsReached:           "destinationBothLimitsReached",
+	attributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached:    "reportingOriginsPerSiteLimitReached",
+	attributionReportingSourceRegistrationResultExceedsMaxChannelCapacity:              "exceedsMaxChannelCapacity",
+	attributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity:        "exceedsMaxScopesChannelCapacity",
+	attributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality:      "exceedsMaxTriggerStateCardinality",
+	attributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit:             "exceedsMaxEventStatesLimit",
+	attributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached: "destinationPerDayReportingLimitReached",
+})
@@ -0,0 +1,251 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingSourceRegistrationResult(t *testing.T) {
+	var enum AttributionReportingSourceRegistrationResultEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingSourceRegistrationResultEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.Success
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"success"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"success"`, result)
+	}
+	json.Unmarshal([]byte(`"success"`), &enum)
+	if AttributionReportingSourceRegistrationResult.Success != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.Success, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.InternalError
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"internalError"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"internalError"`, result)
+	}
+	json.Unmarshal([]byte(`"internalError"`), &enum)
+	if AttributionReportingSourceRegistrationResult.InternalError != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.InternalError, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.InsufficientSourceCapacity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insufficientSourceCapacity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insufficientSourceCapacity"`, result)
+	}
+	json.Unmarshal([]byte(`"insufficientSourceCapacity"`), &enum)
+	if AttributionReportingSourceRegistrationResult.InsufficientSourceCapacity != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.InsufficientSourceCapacity, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.InsufficientUniqueDestinationCapacity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"insufficientUniqueDestinationCapacity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"insufficientUniqueDestinationCapacity"`, result)
+	}
+	json.Unmarshal([]byte(`"insufficientUniqueDestinationCapacity"`), &enum)
+	if AttributionReportingSourceRegistrationResult.InsufficientUniqueDestinationCapacity != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.InsufficientUniqueDestinationCapacity, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ExcessiveReportingOrigins
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"excessiveReportingOrigins"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"excessiveReportingOrigins"`, result)
+	}
+	json.Unmarshal([]byte(`"excessiveReportingOrigins"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ExcessiveReportingOrigins != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ExcessiveReportingOrigins, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ProhibitedByBrowserPolicy
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"prohibitedByBrowserPolicy"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"prohibitedByBrowserPolicy"`, result)
+	}
+	json.Unmarshal([]byte(`"prohibitedByBrowserPolicy"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ProhibitedByBrowserPolicy != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ProhibitedByBrowserPolicy, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.SuccessNoised
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"successNoised"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"successNoised"`, result)
+	}
+	json.Unmarshal([]byte(`"successNoised"`), &enum)
+	if AttributionReportingSourceRegistrationResult.SuccessNoised != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.SuccessNoised, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.DestinationReportingLimitReached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"destinationReportingLimitReached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"destinationReportingLimitReached"`, result)
+	}
+	json.Unmarshal([]byte(`"destinationReportingLimitReached"`), &enum)
+	if AttributionReportingSourceRegistrationResult.DestinationReportingLimitReached != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.DestinationReportingLimitReached, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.DestinationGlobalLimitReached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"destinationGlobalLimitReached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"destinationGlobalLimitReached"`, result)
+	}
+	json.Unmarshal([]byte(`"destinationGlobalLimitReached"`), &enum)
+	if AttributionReportingSourceRegistrationResult.DestinationGlobalLimitReached != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.DestinationGlobalLimitReached, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.DestinationBothLimitsReached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"destinationBothLimitsReached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"destinationBothLimitsReached"`, result)
+	}
+	json.Unmarshal([]byte(`"destinationBothLimitsReached"`), &enum)
+	if AttributionReportingSourceRegistrationResult.DestinationBothLimitsReached != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.DestinationBothLimitsReached, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ReportingOriginsPerSiteLimitReached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"reportingOriginsPerSiteLimitReached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"reportingOriginsPerSiteLimitReached"`, result)
+	}
+	json.Unmarshal([]byte(`"reportingOriginsPerSiteLimitReached"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ReportingOriginsPerSiteLimitReached != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ReportingOriginsPerSiteLimitReached, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ExceedsMaxChannelCapacity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exceedsMaxChannelCapacity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exceedsMaxChannelCapacity"`, result)
+	}
+	json.Unmarshal([]byte(`"exceedsMaxChannelCapacity"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ExceedsMaxChannelCapacity != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ExceedsMaxChannelCapacity, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ExceedsMaxScopesChannelCapacity
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exceedsMaxScopesChannelCapacity"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exceedsMaxScopesChannelCapacity"`, result)
+	}
+	json.Unmarshal([]byte(`"exceedsMaxScopesChannelCapacity"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ExceedsMaxScopesChannelCapacity != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ExceedsMaxScopesChannelCapacity, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ExceedsMaxTriggerStateCardinality
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exceedsMaxTriggerStateCardinality"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exceedsMaxTriggerStateCardinality"`, result)
+	}
+	json.Unmarshal([]byte(`"exceedsMaxTriggerStateCardinality"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ExceedsMaxTriggerStateCardinality != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ExceedsMaxTriggerStateCardinality, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.ExceedsMaxEventStatesLimit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exceedsMaxEventStatesLimit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exceedsMaxEventStatesLimit"`, result)
+	}
+	json.Unmarshal([]byte(`"exceedsMaxEventStatesLimit"`), &enum)
+	if AttributionReportingSourceRegistrationResult.ExceedsMaxEventStatesLimit != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.ExceedsMaxEventStatesLimit, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationResult.DestinationPerDayReportingLimitReached
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"destinationPerDayReportingLimitReached"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"destinationPerDayReportingLimitReached"`, result)
+	}
+	json.Unmarshal([]byte(`"destinationPerDayReportingLimitReached"`), &enum)
+	if AttributionReportingSourceRegistrationResult.DestinationPerDayReportingLimitReached != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationResult.DestinationPerDayReportingLimitReached, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingSourceRegistrationTimeConfigEnum struct {
+	Include AttributionReportingSourceRegistrationTimeConfigEnum
+	Exclude AttributionReportingSourceRegistrationTimeConfigEnum
+}
+
+/*
+AttributionReportingSourceRegistrationTimeConfig provides named access to the AttributionReportingSourceRegistrationTimeConfigEnum values.
+*/
+var AttributionReportingSourceRegistrationTimeConfig = attributionReportingSourceRegistrationTimeConfigEnum{
+	Include: attributionReportingSourceRegistrationTimeConfigInclude,
+	Exclude: attributionReportingSourceRegistrationTimeConfigExclude,
+}
+
+/*
+AttributionReportingSourceRegistrationTimeConfigEnum represents the
+Storage.AttributionReportingSourceRegistrationTimeConfig values.
+
+Allowed values:
+  - AttributionReportingSourceRegistrationTimeConfig.Include "include"
+  - AttributionReportingSourceRegistrationTimeConfig.Exclude "exclude"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingSourceRegistrationTimeConfig
+*/
+type AttributionReportingSourceRegistrationTimeConfigEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingSourceRegistrationTimeConfigEnum) String() string {
+	return _attributionReportingSourceRegistrationTimeConfigEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingSourceRegistrationTimeConfigEnum) Unknown() bool {
+	return !_attributionReportingSourceRegistrationTimeConfigEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingSourceRegistrationTimeConfigEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingSourceRegistrationTimeConfigEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingSourceRegistrationTimeConfigEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingSourceRegistrationTimeConfigInclude represents the "include" value.
+	attributionReportingSourceRegistrationTimeConfigInclude AttributionReportingSourceRegistrationTimeConfigEnum = iota + 1
+	// attributionReportingSourceRegistrationTimeConfigExclude represents the "exclude" value.
+	attributionReportingSourceRegistrationTimeConfigExclude
+)
+
+var _attributionReportingSourceRegistrationTimeConfigEnums = enums.New(map[AttributionReportingSourceRegistrationTimeConfigEnum]string{
+	AttributionReportingSourceRegistrationTimeConfigEnum(0): "",
+	attributionReportingSourceRegistrationTimeConfigInclude: "include",
+	attributionReportingSourceRegistrationTimeConfigExclude: "exclude",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingSourceRegistrationTimeConfig(t *testing.T) {
+	var enum AttributionReportingSourceRegistrationTimeConfigEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingSourceRegistrationTimeConfigEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingSourceRegistrationTimeConfig.Include
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"include"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"include"`, result)
+	}
+	json.Unmarshal([]byte(`"include"`), &enum)
+	if AttributionReportingSourceRegistrationTimeConfig.Include != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationTimeConfig.Include, enum)
+	}
+
+	enum = AttributionReportingSourceRegistrationTimeConfig.Exclude
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exclude"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exclude"`, result)
+	}
+	json.Unmarshal([]byte(`"exclude"`), &enum)
+	if AttributionReportingSourceRegistrationTimeConfig.Exclude != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceRegistrationTimeConfig.Exclude, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingSourceTypeEnum struct {
+	Navigation AttributionReportingSourceTypeEnum
+	Event      AttributionReportingSourceTypeEnum
+}
+
+/*
+AttributionReportingSourceType provides named access to the AttributionReportingSourceTypeEnum values.
+*/
+var AttributionReportingSourceType = attributionReportingSourceTypeEnum{
+	Navigation: attributionReportingSourceTypeNavigation,
+	Event:      attributionReportingSourceTypeEvent,
+}
+
+/*
+AttributionReportingSourceTypeEnum represents the
+Storage.AttributionReportingSourceType values.
+
+Allowed values:
+  - AttributionReportingSourceType.Navigation "navigation"
+  - AttributionReportingSourceType.Event      "event"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingSourceType
+*/
+type AttributionReportingSourceTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingSourceTypeEnum) String() string {
+	return _attributionReportingSourceTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingSourceTypeEnum) Unknown() bool {
+	return !_attributionReportingSourceTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingSourceTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingSourceTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingSourceTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingSourceTypeNavigation represents the "navigation" value.
+	attributionReportingSourceTypeNavigation AttributionReportingSourceTypeEnum = iota + 1
+	// attributionReportingSourceTypeEvent represents the "event" value.
+	attributionReportingSourceTypeEvent
+)
+
+var _attributionReportingSourceTypeEnums = enums.New(map[AttributionReportingSourceTypeEnum]string{
+	AttributionReportingSourceTypeEnum(0):    "",
+	attributionReportingSourceTypeNavigation: "navigation",
+	attributionReportingSourceTypeEvent:      "event",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingSourceType(t *testing.T) {
+	var enum AttributionReportingSourceTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingSourceTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingSourceType.Navigation
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"navigation"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"navigation"`, result)
+	}
+	json.Unmarshal([]byte(`"navigation"`), &enum)
+	if AttributionReportingSourceType.Navigation != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceType.Navigation, enum)
+	}
+
+	enum = AttributionReportingSourceType.Event
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"event"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"event"`, result)
+	}
+	json.Unmarshal([]byte(`"event"`), &enum)
+	if AttributionReportingSourceType.Event != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingSourceType.Event, enum)
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type attributionReportingTriggerDataMatchingEnum struct {
+	Exact   AttributionReportingTriggerDataMatchingEnum
+	Modulus AttributionReportingTriggerDataMatchingEnum
+}
+
+/*
+AttributionReportingTriggerDataMatching provides named access to the AttributionReportingTriggerDataMatchingEnum values.
+*/
+var AttributionReportingTriggerDataMatching = attributionReportingTriggerDataMatchingEnum{
+	Exact:   attributionReportingTriggerDataMatchingExact,
+	Modulus: attributionReportingTriggerDataMatchingModulus,
+}
+
+/*
+AttributionReportingTriggerDataMatchingEnum represents the
+Storage.AttributionReportingTriggerDataMatching values.
+
+Allowed values:
+  - AttributionReportingTriggerDataMatching.Exact   "exact"
+  - AttributionReportingTriggerDataMatching.Modulus "modulus"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-AttributionReportingTriggerDataMatching
+*/
+type AttributionReportingTriggerDataMatchingEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AttributionReportingTriggerDataMatchingEnum) String() string {
+	return _attributionReportingTriggerDataMatchingEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AttributionReportingTriggerDataMatchingEnum) Unknown() bool {
+	return !_attributionReportingTriggerDataMatchingEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AttributionReportingTriggerDataMatchingEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AttributionReportingTriggerDataMatchingEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _attributionReportingTriggerDataMatchingEnums.Parse(val)
+	return nil
+}
+
+const (
+	// attributionReportingTriggerDataMatchingExact represents the "exact" value.
+	attributionReportingTriggerDataMatchingExact AttributionReportingTriggerDataMatchingEnum = iota + 1
+	// attributionReportingTriggerDataMatchingModulus represents the "modulus" value.
+	attributionReportingTriggerDataMatchingModulus
+)
+
+var _attributionReportingTriggerDataMatchingEnums = enums.New(map[AttributionReportingTriggerDataMatchingEnum]string{
+	AttributionReportingTriggerDataMatchingEnum(0): "",
+	attributionReportingTriggerDataMatchingExact:   "exact",
+	attributionReportingTriggerDataMatchingModulus: "modulus",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAttributionReportingTriggerDataMatching(t *testing.T) {
+	var enum AttributionReportingTriggerDataMatchingEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AttributionReportingTriggerDataMatchingEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AttributionReportingTriggerDataMatching.Exact
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"exact"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"exact"`, result)
+	}
+	json.Unmarshal([]byte(`"exact"`), &enum)
+	if AttributionReportingTriggerDataMatching.Exact != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingTriggerDataMatching.Exact, enum)
+	}
+
+	enum = AttributionReportingTriggerDataMatching.Modulus
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"modulus"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"modulus"`, result)
+	}
+	json.Unmarshal([]byte(`"modulus"`), &enum)
+	if AttributionReportingTriggerDataMatching.Modulus != enum {
+		t.Errorf("Expected %d, got %d", AttributionReportingTriggerDataMatching.Modulus, enum)
+	}
+}
@@ -0,0 +1,139 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type interestGroupAccessTypeEnum struct {
+	Join                  InterestGroupAccessTypeEnum
+	Leave                 InterestGroupAccessTypeEnum
+	Update                InterestGroupAccessTypeEnum
+	Loaded                InterestGroupAccessTypeEnum
+	Bid                   InterestGroupAccessTypeEnum
+	Win                   InterestGroupAccessTypeEnum
+	AdditionalBid         InterestGroupAccessTypeEnum
+	AdditionalBidWin      InterestGroupAccessTypeEnum
+	TopLevelBid           InterestGroupAccessTypeEnum
+	TopLevelAdditionalBid InterestGroupAccessTypeEnum
+	Clear                 InterestGroupAccessTypeEnum
+}
+
+/*
+InterestGroupAccessType provides named access to the InterestGroupAccessTypeEnum values.
+*/
+var InterestGroupAccessType = interestGroupAccessTypeEnum{
+	Join:                  interestGroupAccessTypeJoin,
+	Leave:                 interestGroupAccessTypeLeave,
+	Update:                interestGroupAccessTypeUpdate,
+	Loaded:                interestGroupAccessTypeLoaded,
+	Bid:                   interestGroupAccessTypeBid,
+	Win:                   interestGroupAccessTypeWin,
+	AdditionalBid:         interestGroupAccessTypeAdditionalBid,
+	AdditionalBidWin:      interestGroupAccessTypeAdditionalBidWin,
+	TopLevelBid:           interestGroupAccessTypeTopLevelBid,
+	TopLevelAdditionalBid: interestGroupAccessTypeTopLevelAdditionalBid,
+	Clear:                 interestGroupAccessTypeClear,
+}
+
+/*
+InterestGroupAccessTypeEnum represents the Storage.InterestGroupAccessType
+values.
+
+Enum of interest group access types.
+
+Allowed values:
+  - InterestGroupAccessType.Join                  "join"
+  - InterestGroupAccessType.Leave                 "leave"
+  - InterestGroupAccessType.Update                "update"
+  - InterestGroupAccessType.Loaded                "loaded"
+  - InterestGroupAccessType.Bid                   "bid"
+  - InterestGroupAccessType.Win                   "win"
+  - InterestGroupAccessType.AdditionalBid         "additionalBid"
+  - InterestGroupAccessType.AdditionalBidWin      "additionalBidWin"
+  - InterestGroupAccessType.TopLevelBid           "topLevelBid"
+  - InterestGroupAccessType.TopLevelAdditionalBid "topLevelAdditionalBid"
+  - InterestGroupAccessType.Clear                 "clear"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-InterestGroupAccessType
+*/
+type InterestGroupAccessTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InterestGroupAccessTypeEnum) String() string {
+	return _interestGroupAccessTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InterestGroupAccessTypeEnum) Unknown() bool {
+	return !_interestGroupAccessTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InterestGroupAccessTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InterestGroupAccessTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _interestGroupAccessTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// interestGroupAccessTypeJoin represents the "join" value.
+	interestGroupAccessTypeJoin InterestGroupAccessTypeEnum = iota + 1
+	// interestGroupAccessTypeLeave represents the "leave" value.
+	interestGroupAccessTypeLeave
+	// interestGroupAccessTypeUpdate represents the "update" value.
+	interestGroupAccessTypeUpdate
+	// interestGroupAccessTypeLoaded represents the "loaded" value.
+	interestGroupAccessTypeLoaded
+	// interestGroupAccessTypeBid represents the "bid" value.
+	interestGroupAccessTypeBid
+	// interestGroupAccessTypeWin represents the "win" value.
+	interestGroupAccessTypeWin
+	// interestGroupAccessTypeAdditionalBid represents the "additionalBid" value.
+	interestGroupAccessTypeAdditionalBid
+	// interestGroupAccessTypeAdditionalBidWin represents the "additionalBidWin" value.
+	interestGroupAccessTypeAdditionalBidWin
+	// interestGroupAccessTypeTopLevelBid represents the "topLevelBid" value.
+	interestGroupAccessTypeTopLevelBid
+	// interestGroupAccessTypeTopLevelAdditionalBid represents the "topLevelAdditionalBid" value.
+	interestGroupAccessTypeTopLevelAdditionalBid
+	// interestGroupAccessTypeClear represents the "clear" value.
+	interestGroupAccessTypeClear
+)
+
+var _interestGroupAccessTypeEnums = enums.New(map[InterestGroupAccessTypeEnum]string{
+	InterestGroupAccessTypeEnum(0):               "",
+	interestGroupAccessTypeJoin:                  "join",
+	interestGroupAccessTypeLeave:                 "leave",
+	interestGroupAccessTypeUpdate:                "update",
+	interestGroupAccessTypeLoaded:                "loaded",
+	interestGroupAccessTypeBid:                   "bid",
+	interestGroupAccessTypeWin:                   "win",
+	interestGroupAccessTypeAdditionalBid:         "additionalBid",
+	interestGroupAccessTypeAdditionalBidWin:      "additionalBidWin",
+	interestGroupAccessTypeTopLevelBid:           "topLevelBid",
+	interestGroupAccessTypeTopLevelAdditionalBid: "topLevelAdditionalBid",
+	interestGroupAccessTypeClear:                 "clear",
+})
@@ -0,0 +1,186 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInterestGroupAccessType(t *testing.T) {
+	var enum InterestGroupAccessTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InterestGroupAccessTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InterestGroupAccessType.Join
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"join"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"join"`, result)
+	}
+	json.Unmarshal([]byte(`"join"`), &enum)
+	if InterestGroupAccessType.Join != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Join, enum)
+	}
+
+	enum = InterestGroupAccessType.Leave
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"leave"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"leave"`, result)
+	}
+	json.Unmarshal([]byte(`"leave"`), &enum)
+	if InterestGroupAccessType.Leave != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Leave, enum)
+	}
+
+	enum = InterestGroupAccessType.Update
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"update"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"update"`, result)
+	}
+	json.Unmarshal([]byte(`"update"`), &enum)
+	if InterestGroupAccessType.Update != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Update, enum)
+	}
+
+	enum = InterestGroupAccessType.Loaded
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"loaded"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"loaded"`, result)
+	}
+	json.Unmarshal([]byte(`"loaded"`), &enum)
+	if InterestGroupAccessType.Loaded != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Loaded, enum)
+	}
+
+	enum = InterestGroupAccessType.Bid
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bid"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bid"`, result)
+	}
+	json.Unmarshal([]byte(`"bid"`), &enum)
+	if InterestGroupAccessType.Bid != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Bid, enum)
+	}
+
+	enum = InterestGroupAccessType.Win
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"win"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"win"`, result)
+	}
+	json.Unmarshal([]byte(`"win"`), &enum)
+	if InterestGroupAccessType.Win != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Win, enum)
+	}
+
+	enum = InterestGroupAccessType.AdditionalBid
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"additionalBid"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"additionalBid"`, result)
+	}
+	json.Unmarshal([]byte(`"additionalBid"`), &enum)
+	if InterestGroupAccessType.AdditionalBid != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.AdditionalBid, enum)
+	}
+
+	enum = InterestGroupAccessType.AdditionalBidWin
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"additionalBidWin"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"additionalBidWin"`, result)
+	}
+	json.Unmarshal([]byte(`"additionalBidWin"`), &enum)
+	if InterestGroupAccessType.AdditionalBidWin != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.AdditionalBidWin, enum)
+	}
+
+	enum = InterestGroupAccessType.TopLevelBid
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"topLevelBid"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"topLevelBid"`, result)
+	}
+	json.Unmarshal([]byte(`"topLevelBid"`), &enum)
+	if InterestGroupAccessType.TopLevelBid != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.TopLevelBid, enum)
+	}
+
+	enum = InterestGroupAccessType.TopLevelAdditionalBid
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"topLevelAdditionalBid"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"topLevelAdditionalBid"`, result)
+	}
+	json.Unmarshal([]byte(`"topLevelAdditionalBid"`), &enum)
+	if InterestGroupAccessType.TopLevelAdditionalBid != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.TopLevelAdditionalBid, enum)
+	}
+
+	enum = InterestGroupAccessType.Clear
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clear"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clear"`, result)
+	}
+	json.Unmarshal([]byte(`"clear"`), &enum)
+	if InterestGroupAccessType.Clear != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAccessType.Clear, enum)
+	}
+}
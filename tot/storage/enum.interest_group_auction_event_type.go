@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type interestGroupAuctionEventTypeEnum struct {
+	Started        InterestGroupAuctionEventTypeEnum
+	ConfigResolved InterestGroupAuctionEventTypeEnum
+}
+
+/*
+InterestGroupAuctionEventType provides named access to the InterestGroupAuctionEventTypeEnum values.
+*/
+var InterestGroupAuctionEventType = interestGroupAuctionEventTypeEnum{
+	Started:        interestGroupAuctionEventTypeStarted,
+	ConfigResolved: interestGroupAuctionEventTypeConfigResolved,
+}
+
+/*
+InterestGroupAuctionEventTypeEnum represents the
+Storage.InterestGroupAuctionEventType values.
+
+Enum of auction events.
+
+Allowed values:
+  - InterestGroupAuctionEventType.Started        "started"
+  - InterestGroupAuctionEventType.ConfigResolved "configResolved"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-InterestGroupAuctionEventType
+*/
+type InterestGroupAuctionEventTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InterestGroupAuctionEventTypeEnum) String() string {
+	return _interestGroupAuctionEventTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InterestGroupAuctionEventTypeEnum) Unknown() bool {
+	return !_interestGroupAuctionEventTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InterestGroupAuctionEventTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InterestGroupAuctionEventTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _interestGroupAuctionEventTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// interestGroupAuctionEventTypeStarted represents the "started" value.
+	interestGroupAuctionEventTypeStarted InterestGroupAuctionEventTypeEnum = iota + 1
+	// interestGroupAuctionEventTypeConfigResolved represents the "configResolved" value.
+	interestGroupAuctionEventTypeConfigResolved
+)
+
+var _interestGroupAuctionEventTypeEnums = enums.New(map[InterestGroupAuctionEventTypeEnum]string{
+	InterestGroupAuctionEventTypeEnum(0):        "",
+	interestGroupAuctionEventTypeStarted:        "started",
+	interestGroupAuctionEventTypeConfigResolved: "configResolved",
+})
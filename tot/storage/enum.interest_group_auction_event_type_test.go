@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInterestGroupAuctionEventType(t *testing.T) {
+	var enum InterestGroupAuctionEventTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InterestGroupAuctionEventTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InterestGroupAuctionEventType.Started
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"started"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"started"`, result)
+	}
+	json.Unmarshal([]byte(`"started"`), &enum)
+	if InterestGroupAuctionEventType.Started != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionEventType.Started, enum)
+	}
+
+	enum = InterestGroupAuctionEventType.ConfigResolved
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"configResolved"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"configResolved"`, result)
+	}
+	json.Unmarshal([]byte(`"configResolved"`), &enum)
+	if InterestGroupAuctionEventType.ConfigResolved != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionEventType.ConfigResolved, enum)
+	}
+}
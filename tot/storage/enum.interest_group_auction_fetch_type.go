@@ -0,0 +1,103 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type interestGroupAuctionFetchTypeEnum struct {
+	BidderJS             InterestGroupAuctionFetchTypeEnum
+	BidderWasm           InterestGroupAuctionFetchTypeEnum
+	SellerJS             InterestGroupAuctionFetchTypeEnum
+	BidderTrustedSignals InterestGroupAuctionFetchTypeEnum
+	SellerTrustedSignals InterestGroupAuctionFetchTypeEnum
+}
+
+/*
+InterestGroupAuctionFetchType provides named access to the InterestGroupAuctionFetchTypeEnum values.
+*/
+var InterestGroupAuctionFetchType = interestGroupAuctionFetchTypeEnum{
+	BidderJS:             interestGroupAuctionFetchTypeBidderJS,
+	BidderWasm:           interestGroupAuctionFetchTypeBidderWasm,
+	SellerJS:             interestGroupAuctionFetchTypeSellerJS,
+	BidderTrustedSignals: interestGroupAuctionFetchTypeBidderTrustedSignals,
+	SellerTrustedSignals: interestGroupAuctionFetchTypeSellerTrustedSignals,
+}
+
+/*
+InterestGroupAuctionFetchTypeEnum represents the
+Storage.InterestGroupAuctionFetchType values.
+
+Enum of network fetches auctions can do.
+
+Allowed values:
+  - InterestGroupAuctionFetchType.BidderJS             "bidderJs"
+  - InterestGroupAuctionFetchType.BidderWasm           "bidderWasm"
+  - InterestGroupAuctionFetchType.SellerJS             "sellerJs"
+  - InterestGroupAuctionFetchType.BidderTrustedSignals "bidderTrustedSignals"
+  - InterestGroupAuctionFetchType.SellerTrustedSignals "sellerTrustedSignals"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-InterestGroupAuctionFetchType
+*/
+type InterestGroupAuctionFetchTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum InterestGroupAuctionFetchTypeEnum) String() string {
+	return _interestGroupAuctionFetchTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum InterestGroupAuctionFetchTypeEnum) Unknown() bool {
+	return !_interestGroupAuctionFetchTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum InterestGroupAuctionFetchTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *InterestGroupAuctionFetchTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _interestGroupAuctionFetchTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// interestGroupAuctionFetchTypeBidderJS represents the "bidderJs" value.
+	interestGroupAuctionFetchTypeBidderJS InterestGroupAuctionFetchTypeEnum = iota + 1
+	// interestGroupAuctionFetchTypeBidderWasm represents the "bidderWasm" value.
+	interestGroupAuctionFetchTypeBidderWasm
+	// interestGroupAuctionFetchTypeSellerJS represents the "sellerJs" value.
+	interestGroupAuctionFetchTypeSellerJS
+	// interestGroupAuctionFetchTypeBidderTrustedSignals represents the "bidderTrustedSignals" value.
+	interestGroupAuctionFetchTypeBidderTrustedSignals
+	// interestGroupAuctionFetchTypeSellerTrustedSignals represents the "sellerTrustedSignals" value.
+	interestGroupAuctionFetchTypeSellerTrustedSignals
+)
+
+var _interestGroupAuctionFetchTypeEnums = enums.New(map[InterestGroupAuctionFetchTypeEnum]string{
+	InterestGroupAuctionFetchTypeEnum(0):              "",
+	interestGroupAuctionFetchTypeBidderJS:             "bidderJs",
+	interestGroupAuctionFetchTypeBidderWasm:           "bidderWasm",
+	interestGroupAuctionFetchTypeSellerJS:             "sellerJs",
+	interestGroupAuctionFetchTypeBidderTrustedSignals: "bidderTrustedSignals",
+	interestGroupAuctionFetchTypeSellerTrustedSignals: "sellerTrustedSignals",
+})
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumInterestGroupAuctionFetchType(t *testing.T) {
+	var enum InterestGroupAuctionFetchTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown InterestGroupAuctionFetchTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = InterestGroupAuctionFetchType.BidderJS
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bidderJs"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bidderJs"`, result)
+	}
+	json.Unmarshal([]byte(`"bidderJs"`), &enum)
+	if InterestGroupAuctionFetchType.BidderJS != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionFetchType.BidderJS, enum)
+	}
+
+	enum = InterestGroupAuctionFetchType.BidderWasm
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bidderWasm"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bidderWasm"`, result)
+	}
+	json.Unmarshal([]byte(`"bidderWasm"`), &enum)
+	if InterestGroupAuctionFetchType.BidderWasm != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionFetchType.BidderWasm, enum)
+	}
+
+	enum = InterestGroupAuctionFetchType.SellerJS
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sellerJs"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sellerJs"`, result)
+	}
+	json.Unmarshal([]byte(`"sellerJs"`), &enum)
+	if InterestGroupAuctionFetchType.SellerJS != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionFetchType.SellerJS, enum)
+	}
+
+	enum = InterestGroupAuctionFetchType.BidderTrustedSignals
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"bidderTrustedSignals"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"bidderTrustedSignals"`, result)
+	}
+	json.Unmarshal([]byte(`"bidderTrustedSignals"`), &enum)
+	if InterestGroupAuctionFetchType.BidderTrustedSignals != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionFetchType.BidderTrustedSignals, enum)
+	}
+
+	enum = InterestGroupAuctionFetchType.SellerTrustedSignals
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sellerTrustedSignals"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sellerTrustedSignals"`, result)
+	}
+	json.Unmarshal([]byte(`"sellerTrustedSignals"`), &enum)
+	if InterestGroupAuctionFetchType.SellerTrustedSignals != enum {
+		t.Errorf("Expected %d, got %d", InterestGroupAuctionFetchType.SellerTrustedSignals, enum)
+	}
+}
@@ -0,0 +1,163 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sharedStorageAccessMethodEnum struct {
+	AddModule       SharedStorageAccessMethodEnum
+	CreateWorklet   SharedStorageAccessMethodEnum
+	SelectURL       SharedStorageAccessMethodEnum
+	Run             SharedStorageAccessMethodEnum
+	BatchUpdate     SharedStorageAccessMethodEnum
+	Set             SharedStorageAccessMethodEnum
+	Append          SharedStorageAccessMethodEnum
+	Delete          SharedStorageAccessMethodEnum
+	Clear           SharedStorageAccessMethodEnum
+	Get             SharedStorageAccessMethodEnum
+	Keys            SharedStorageAccessMethodEnum
+	Values          SharedStorageAccessMethodEnum
+	Entries         SharedStorageAccessMethodEnum
+	Length          SharedStorageAccessMethodEnum
+	RemainingBudget SharedStorageAccessMethodEnum
+}
+
+/*
+SharedStorageAccessMethod provides named access to the SharedStorageAccessMethodEnum values.
+*/
+var SharedStorageAccessMethod = sharedStorageAccessMethodEnum{
+	AddModule:       sharedStorageAccessMethodAddModule,
+	CreateWorklet:   sharedStorageAccessMethodCreateWorklet,
+	SelectURL:       sharedStorageAccessMethodSelectURL,
+	Run:             sharedStorageAccessMethodRun,
+	BatchUpdate:     sharedStorageAccessMethodBatchUpdate,
+	Set:             sharedStorageAccessMethodSet,
+	Append:          sharedStorageAccessMethodAppend,
+	Delete:          sharedStorageAccessMethodDelete,
+	Clear:           sharedStorageAccessMethodClear,
+	Get:             sharedStorageAccessMethodGet,
+	Keys:            sharedStorageAccessMethodKeys,
+	Values:          sharedStorageAccessMethodValues,
+	Entries:         sharedStorageAccessMethodEntries,
+	Length:          sharedStorageAccessMethodLength,
+	RemainingBudget: sharedStorageAccessMethodRemainingBudget,
+}
+
+/*
+SharedStorageAccessMethodEnum represents the Storage.SharedStorageAccessMethod
+values.
+
+Enum of shared storage access methods.
+
+Allowed values:
+  - SharedStorageAccessMethod.AddModule       "addModule"
+  - SharedStorageAccessMethod.CreateWorklet   "createWorklet"
+  - SharedStorageAccessMethod.SelectURL       "selectURL"
+  - SharedStorageAccessMethod.Run             "run"
+  - SharedStorageAccessMethod.BatchUpdate     "batchUpdate"
+  - SharedStorageAccessMethod.Set             "set"
+  - SharedStorageAccessMethod.Append          "append"
+  - SharedStorageAccessMethod.Delete          "delete"
+  - SharedStorageAccessMethod.Clear           "clear"
+  - SharedStorageAccessMethod.Get             "get"
+  - SharedStorageAccessMethod.Keys            "keys"
+  - SharedStorageAccessMethod.Values          "values"
+  - SharedStorageAccessMethod.Entries         "entries"
+  - SharedStorageAccessMethod.Length          "length"
+  - SharedStorageAccessMethod.RemainingBudget "remainingBudget"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageAccessMethod
+*/
+type SharedStorageAccessMethodEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SharedStorageAccessMethodEnum) String() string {
+	return _sharedStorageAccessMethodEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SharedStorageAccessMethodEnum) Unknown() bool {
+	return !_sharedStorageAccessMethodEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SharedStorageAccessMethodEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SharedStorageAccessMethodEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sharedStorageAccessMethodEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sharedStorageAccessMethodAddModule represents the "addModule" value.
+	sharedStorageAccessMethodAddModule SharedStorageAccessMethodEnum = iota + 1
+	// sharedStorageAccessMethodCreateWorklet represents the "createWorklet" value.
+	sharedStorageAccessMethodCreateWorklet
+	// sharedStorageAccessMethodSelectURL represents the "selectURL" value.
+	sharedStorageAccessMethodSelectURL
+	// sharedStorageAccessMethodRun represents the "run" value.
+	sharedStorageAccessMethodRun
+	// sharedStorageAccessMethodBatchUpdate represents the "batchUpdate" value.
+	sharedStorageAccessMethodBatchUpdate
+	// sharedStorageAccessMethodSet represents the "set" value.
+	sharedStorageAccessMethodSet
+	// sharedStorageAccessMethodAppend represents the "append" value.
+	sharedStorageAccessMethodAppend
+	// sharedStorageAccessMethodDelete represents the "delete" value.
+	sharedStorageAccessMethodDelete
+	// sharedStorageAccessMethodClear represents the "clear" value.
+	sharedStorageAccessMethodClear
+	// sharedStorageAccessMethodGet represents the "get" value.
+	sharedStorageAccessMethodGet
+	// sharedStorageAccessMethodKeys represents the "keys" value.
+	sharedStorageAccessMethodKeys
+	// sharedStorageAccessMethodValues represents the "values" value.
+	sharedStorageAccessMethodValues
+	// sharedStorageAccessMethodEntries represents the "entries" value.
+	sharedStorageAccessMethodEntries
+	// sharedStorageAccessMethodLength represents the "length" value.
+	sharedStorageAccessMethodLength
+	// sharedStorageAccessMethodRemainingBudget represents the "remainingBudget" value.
+	sharedStorageAccessMethodRemainingBudget
+)
+
+var _sharedStorageAccessMethodEnums = enums.New(map[SharedStorageAccessMethodEnum]string{
+	SharedStorageAccessMethodEnum(0):         "",
+	sharedStorageAccessMethodAddModule:       "addModule",
+	sharedStorageAccessMethodCreateWorklet:   "createWorklet",
+	sharedStorageAccessMethodSelectURL:       "selectURL",
+	sharedStorageAccessMethodRun:             "run",
+	sharedStorageAccessMethodBatchUpdate:     "batchUpdate",
+	sharedStorageAccessMethodSet:             "set",
+	sharedStorageAccessMethodAppend:          "append",
+	sharedStorageAccessMethodDelete:          "delete",
+	sharedStorageAccessMethodClear:           "clear",
+	sharedStorageAccessMethodGet:             "get",
+	sharedStorageAccessMethodKeys:            "keys",
+	sharedStorageAccessMethodValues:          "values",
+	sharedStorageAccessMethodEntries:         "entries",
+	sharedStorageAccessMethodLength:          "length",
+	sharedStorageAccessMethodRemainingBudget: "remainingBudget",
+})
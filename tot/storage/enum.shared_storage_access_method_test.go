@@ -0,0 +1,238 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSharedStorageAccessMethod(t *testing.T) {
+	var enum SharedStorageAccessMethodEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SharedStorageAccessMethodEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SharedStorageAccessMethod.AddModule
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"addModule"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"addModule"`, result)
+	}
+	json.Unmarshal([]byte(`"addModule"`), &enum)
+	if SharedStorageAccessMethod.AddModule != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.AddModule, enum)
+	}
+
+	enum = SharedStorageAccessMethod.CreateWorklet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"createWorklet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"createWorklet"`, result)
+	}
+	json.Unmarshal([]byte(`"createWorklet"`), &enum)
+	if SharedStorageAccessMethod.CreateWorklet != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.CreateWorklet, enum)
+	}
+
+	enum = SharedStorageAccessMethod.SelectURL
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"selectURL"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"selectURL"`, result)
+	}
+	json.Unmarshal([]byte(`"selectURL"`), &enum)
+	if SharedStorageAccessMethod.SelectURL != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.SelectURL, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Run
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"run"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"run"`, result)
+	}
+	json.Unmarshal([]byte(`"run"`), &enum)
+	if SharedStorageAccessMethod.Run != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Run, enum)
+	}
+
+	enum = SharedStorageAccessMethod.BatchUpdate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"batchUpdate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"batchUpdate"`, result)
+	}
+	json.Unmarshal([]byte(`"batchUpdate"`), &enum)
+	if SharedStorageAccessMethod.BatchUpdate != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.BatchUpdate, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Set
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"set"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"set"`, result)
+	}
+	json.Unmarshal([]byte(`"set"`), &enum)
+	if SharedStorageAccessMethod.Set != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Set, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Append
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"append"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"append"`, result)
+	}
+	json.Unmarshal([]byte(`"append"`), &enum)
+	if SharedStorageAccessMethod.Append != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Append, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Delete
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"delete"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"delete"`, result)
+	}
+	json.Unmarshal([]byte(`"delete"`), &enum)
+	if SharedStorageAccessMethod.Delete != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Delete, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Clear
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clear"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clear"`, result)
+	}
+	json.Unmarshal([]byte(`"clear"`), &enum)
+	if SharedStorageAccessMethod.Clear != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Clear, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Get
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"get"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"get"`, result)
+	}
+	json.Unmarshal([]byte(`"get"`), &enum)
+	if SharedStorageAccessMethod.Get != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Get, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Keys
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"keys"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"keys"`, result)
+	}
+	json.Unmarshal([]byte(`"keys"`), &enum)
+	if SharedStorageAccessMethod.Keys != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Keys, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Values
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"values"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"values"`, result)
+	}
+	json.Unmarshal([]byte(`"values"`), &enum)
+	if SharedStorageAccessMethod.Values != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Values, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Entries
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"entries"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"entries"`, result)
+	}
+	json.Unmarshal([]byte(`"entries"`), &enum)
+	if SharedStorageAccessMethod.Entries != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Entries, enum)
+	}
+
+	enum = SharedStorageAccessMethod.Length
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"length"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"length"`, result)
+	}
+	json.Unmarshal([]byte(`"length"`), &enum)
+	if SharedStorageAccessMethod.Length != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.Length, enum)
+	}
+
+	enum = SharedStorageAccessMethod.RemainingBudget
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"remainingBudget"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"remainingBudget"`, result)
+	}
+	json.Unmarshal([]byte(`"remainingBudget"`), &enum)
+	if SharedStorageAccessMethod.RemainingBudget != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessMethod.RemainingBudget, enum)
+	}
+}
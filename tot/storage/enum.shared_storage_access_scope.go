@@ -0,0 +1,97 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type sharedStorageAccessScopeEnum struct {
+	Window                   SharedStorageAccessScopeEnum
+	SharedStorageWorklet     SharedStorageAccessScopeEnum
+	ProtectedAudienceWorklet SharedStorageAccessScopeEnum
+	Header                   SharedStorageAccessScopeEnum
+}
+
+/*
+SharedStorageAccessScope provides named access to the SharedStorageAccessScopeEnum values.
+*/
+var SharedStorageAccessScope = sharedStorageAccessScopeEnum{
+	Window:                   sharedStorageAccessScopeWindow,
+	SharedStorageWorklet:     sharedStorageAccessScopeSharedStorageWorklet,
+	ProtectedAudienceWorklet: sharedStorageAccessScopeProtectedAudienceWorklet,
+	Header:                   sharedStorageAccessScopeHeader,
+}
+
+/*
+SharedStorageAccessScopeEnum represents the Storage.SharedStorageAccessScope
+values.
+
+Enum of shared storage access scopes.
+
+Allowed values:
+  - SharedStorageAccessScope.Window                   "window"
+  - SharedStorageAccessScope.SharedStorageWorklet     "sharedStorageWorklet"
+  - SharedStorageAccessScope.ProtectedAudienceWorklet "protectedAudienceWorklet"
+  - SharedStorageAccessScope.Header                   "header"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-SharedStorageAccessScope
+*/
+type SharedStorageAccessScopeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SharedStorageAccessScopeEnum) String() string {
+	return _sharedStorageAccessScopeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SharedStorageAccessScopeEnum) Unknown() bool {
+	return !_sharedStorageAccessScopeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SharedStorageAccessScopeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SharedStorageAccessScopeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _sharedStorageAccessScopeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// sharedStorageAccessScopeWindow represents the "window" value.
+	sharedStorageAccessScopeWindow SharedStorageAccessScopeEnum = iota + 1
+	// sharedStorageAccessScopeSharedStorageWorklet represents the "sharedStorageWorklet" value.
+	sharedStorageAccessScopeSharedStorageWorklet
+	// sharedStorageAccessScopeProtectedAudienceWorklet represents the "protectedAudienceWorklet" value.
+	sharedStorageAccessScopeProtectedAudienceWorklet
+	// sharedStorageAccessScopeHeader represents the "header" value.
+	sharedStorageAccessScopeHeader
+)
+
+var _sharedStorageAccessScopeEnums = enums.New(map[SharedStorageAccessScopeEnum]string{
+	SharedStorageAccessScopeEnum(0):                  "",
+	sharedStorageAccessScopeWindow:                   "window",
+	sharedStorageAccessScopeSharedStorageWorklet:     "sharedStorageWorklet",
+	sharedStorageAccessScopeProtectedAudienceWorklet: "protectedAudienceWorklet",
+	sharedStorageAccessScopeHeader:                   "header",
+})
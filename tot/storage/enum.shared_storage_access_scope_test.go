@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSharedStorageAccessScope(t *testing.T) {
+	var enum SharedStorageAccessScopeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SharedStorageAccessScopeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SharedStorageAccessScope.Window
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"window"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"window"`, result)
+	}
+	json.Unmarshal([]byte(`"window"`), &enum)
+	if SharedStorageAccessScope.Window != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessScope.Window, enum)
+	}
+
+	enum = SharedStorageAccessScope.SharedStorageWorklet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"sharedStorageWorklet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"sharedStorageWorklet"`, result)
+	}
+	json.Unmarshal([]byte(`"sharedStorageWorklet"`), &enum)
+	if SharedStorageAccessScope.SharedStorageWorklet != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessScope.SharedStorageWorklet, enum)
+	}
+
+	enum = SharedStorageAccessScope.ProtectedAudienceWorklet
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"protectedAudienceWorklet"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"protectedAudienceWorklet"`, result)
+	}
+	json.Unmarshal([]byte(`"protectedAudienceWorklet"`), &enum)
+	if SharedStorageAccessScope.ProtectedAudienceWorklet != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessScope.ProtectedAudienceWorklet, enum)
+	}
+
+	enum = SharedStorageAccessScope.Header
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"header"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"header"`, result)
+	}
+	json.Unmarshal([]byte(`"header"`), &enum)
+	if SharedStorageAccessScope.Header != enum {
+		t.Errorf("Expected %d, got %d", SharedStorageAccessScope.Header, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type bucketsDurabilityEnum struct {
+	Relaxed BucketsDurabilityEnum
+	Strict  BucketsDurabilityEnum
+}
+
+/*
+BucketsDurability provides named access to the BucketsDurabilityEnum values.
+*/
+var BucketsDurability = bucketsDurabilityEnum{
+	Relaxed: bucketsDurabilityRelaxed,
+	Strict:  bucketsDurabilityStrict,
+}
+
+/*
+BucketsDurabilityEnum represents the Storage.StorageBucketsDurability values.
+
+Allowed values:
+  - BucketsDurability.Relaxed "relaxed"
+  - BucketsDurability.Strict  "strict"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-StorageBucketsDurability
+*/
+type BucketsDurabilityEnum int
+
+/*
+String implements Stringer
+*/
+func (enum BucketsDurabilityEnum) String() string {
+	return _bucketsDurabilityEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BucketsDurabilityEnum) Unknown() bool {
+	return !_bucketsDurabilityEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum BucketsDurabilityEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *BucketsDurabilityEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _bucketsDurabilityEnums.Parse(val)
+	return nil
+}
+
+const (
+	// bucketsDurabilityRelaxed represents the "relaxed" value.
+	bucketsDurabilityRelaxed BucketsDurabilityEnum = iota + 1
+	// bucketsDurabilityStrict represents the "strict" value.
+	bucketsDurabilityStrict
+)
+
+var _bucketsDurabilityEnums = enums.New(map[BucketsDurabilityEnum]string{
+	BucketsDurabilityEnum(0): "",
+	bucketsDurabilityRelaxed: "relaxed",
+	bucketsDurabilityStrict:  "strict",
+})
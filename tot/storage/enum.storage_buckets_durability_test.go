@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumBucketsDurability(t *testing.T) {
+	var enum BucketsDurabilityEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown BucketsDurabilityEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = BucketsDurability.Relaxed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"relaxed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"relaxed"`, result)
+	}
+	json.Unmarshal([]byte(`"relaxed"`), &enum)
+	if BucketsDurability.Relaxed != enum {
+		t.Errorf("Expected %d, got %d", BucketsDurability.Relaxed, enum)
+	}
+
+	enum = BucketsDurability.Strict
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"strict"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"strict"`, result)
+	}
+	json.Unmarshal([]byte(`"strict"`), &enum)
+	if BucketsDurability.Strict != enum {
+		t.Errorf("Expected %d, got %d", BucketsDurability.Strict, enum)
+	}
+}
@@ -1,12 +1,14 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package storage
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type typeEnum struct {
-	Appcache       TypeEnum
 	Cookies        TypeEnum
 	FileSystems    TypeEnum
 	Indexeddb      TypeEnum
@@ -15,15 +17,18 @@ type typeEnum struct {
 	Websql         TypeEnum
 	ServiceWorkers TypeEnum
 	CacheStorage   TypeEnum
+	InterestGroups TypeEnum
+	SharedStorage  TypeEnum
+	StorageBuckets TypeEnum
 	All            TypeEnum
 	Other          TypeEnum
+	Appcache       TypeEnum
 }
 
 /*
-Type provides named acces to the TypeEnum values.
+Type provides named access to the TypeEnum values.
 */
 var Type = typeEnum{
-	Appcache:       typeAppcache,
 	Cookies:        typeCookies,
 	FileSystems:    typeFileSystems,
 	Indexeddb:      typeIndexeddb,
@@ -32,23 +37,34 @@ var Type = typeEnum{
 	Websql:         typeWebsql,
 	ServiceWorkers: typeServiceWorkers,
 	CacheStorage:   typeCacheStorage,
+	InterestGroups: typeInterestGroups,
+	SharedStorage:  typeSharedStorage,
+	StorageBuckets: typeStorageBuckets,
 	All:            typeAll,
 	Other:          typeOther,
+	Appcache:       typeAppcache,
 }
 
 /*
-TypeEnum represents possible storage types. Allowed values:
-	- Type.Appcache       "appcache"
-	- Type.Cookies        "cookies"
-	- Type.FileSystems    "file_systems"
-	- Type.Indexeddb      "indexeddb"
-	- Type.LocalStorage   "local_storage"
-	- Type.ShaderCache    "shader_cache"
-	- Type.Websql         "websql"
-	- Type.ServiceWorkers "service_workers"
-	- Type.CacheStorage   "cache_storage"
-	- Type.All            "all"
-	- Type.Other          "other"
+TypeEnum represents the Storage.StorageType values.
+
+Enum of possible storage types.
+
+Allowed values:
+  - Type.Cookies        "cookies"
+  - Type.FileSystems    "file_systems"
+  - Type.Indexeddb      "indexeddb"
+  - Type.LocalStorage   "local_storage"
+  - Type.ShaderCache    "shader_cache"
+  - Type.Websql         "websql"
+  - Type.ServiceWorkers "service_workers"
+  - Type.CacheStorage   "cache_storage"
+  - Type.InterestGroups "interest_groups"
+  - Type.SharedStorage  "shared_storage"
+  - Type.StorageBuckets "storage_buckets"
+  - Type.All            "all"
+  - Type.Other          "other"
+  - Type.Appcache       "appcache"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Storage/#type-StorageType
 */
@@ -58,7 +74,16 @@ type TypeEnum int
 String implements Stringer
 */
 func (enum TypeEnum) String() string {
-	return _typeEnums[enum]
+	return _typeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TypeEnum) Unknown() bool {
+	return !_typeEnums.Known(enum)
 }
 
 /*
@@ -69,32 +94,21 @@ func (enum TypeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *TypeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _typeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _typeEnums.Parse(val)
+	return nil
 }
 
 const (
-	// typeAppcache represents the "appcache" value.
-	typeAppcache TypeEnum = iota + 1
 	// typeCookies represents the "cookies" value.
-	typeCookies
+	typeCookies TypeEnum = iota + 1
 	// typeFileSystems represents the "file_systems" value.
 	typeFileSystems
 	// typeIndexeddb represents the "indexeddb" value.
@@ -109,14 +123,22 @@ const (
 	typeServiceWorkers
 	// typeCacheStorage represents the "cache_storage" value.
 	typeCacheStorage
+	// typeInterestGroups represents the "interest_groups" value.
+	typeInterestGroups
+	// typeSharedStorage represents the "shared_storage" value.
+	typeSharedStorage
+	// typeStorageBuckets represents the "storage_buckets" value.
+	typeStorageBuckets
 	// typeAll represents the "all" value.
 	typeAll
 	// typeOther represents the "other" value.
 	typeOther
+	// typeAppcache represents the "appcache" value.
+	typeAppcache
 )
 
-var _typeEnums = map[TypeEnum]string{
-	typeAppcache:       "appcache",
+var _typeEnums = enums.New(map[TypeEnum]string{
+	TypeEnum(0):        "",
 	typeCookies:        "cookies",
 	typeFileSystems:    "file_systems",
 	typeIndexeddb:      "indexeddb",
@@ -125,6 +147,10 @@ var _typeEnums = map[TypeEnum]string{
 	typeWebsql:         "websql",
 	typeServiceWorkers: "service_workers",
 	typeCacheStorage:   "cache_storage",
+	typeInterestGroups: "interest_groups",
+	typeSharedStorage:  "shared_storage",
+	typeStorageBuckets: "storage_buckets",
 	typeAll:            "all",
 	typeOther:          "other",
-}
+	typeAppcache:       "appcache",
+})
@@ -0,0 +1,225 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumType(t *testing.T) {
+	var enum TypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown TypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Type.Cookies
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cookies"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cookies"`, result)
+	}
+	json.Unmarshal([]byte(`"cookies"`), &enum)
+	if Type.Cookies != enum {
+		t.Errorf("Expected %d, got %d", Type.Cookies, enum)
+	}
+
+	enum = Type.FileSystems
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"file_systems"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"file_systems"`, result)
+	}
+	json.Unmarshal([]byte(`"file_systems"`), &enum)
+	if Type.FileSystems != enum {
+		t.Errorf("Expected %d, got %d", Type.FileSystems, enum)
+	}
+
+	enum = Type.Indexeddb
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"indexeddb"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"indexeddb"`, result)
+	}
+	json.Unmarshal([]byte(`"indexeddb"`), &enum)
+	if Type.Indexeddb != enum {
+		t.Errorf("Expected %d, got %d", Type.Indexeddb, enum)
+	}
+
+	enum = Type.LocalStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"local_storage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"local_storage"`, result)
+	}
+	json.Unmarshal([]byte(`"local_storage"`), &enum)
+	if Type.LocalStorage != enum {
+		t.Errorf("Expected %d, got %d", Type.LocalStorage, enum)
+	}
+
+	enum = Type.ShaderCache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"shader_cache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"shader_cache"`, result)
+	}
+	json.Unmarshal([]byte(`"shader_cache"`), &enum)
+	if Type.ShaderCache != enum {
+		t.Errorf("Expected %d, got %d", Type.ShaderCache, enum)
+	}
+
+	enum = Type.Websql
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"websql"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"websql"`, result)
+	}
+	json.Unmarshal([]byte(`"websql"`), &enum)
+	if Type.Websql != enum {
+		t.Errorf("Expected %d, got %d", Type.Websql, enum)
+	}
+
+	enum = Type.ServiceWorkers
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"service_workers"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"service_workers"`, result)
+	}
+	json.Unmarshal([]byte(`"service_workers"`), &enum)
+	if Type.ServiceWorkers != enum {
+		t.Errorf("Expected %d, got %d", Type.ServiceWorkers, enum)
+	}
+
+	enum = Type.CacheStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cache_storage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cache_storage"`, result)
+	}
+	json.Unmarshal([]byte(`"cache_storage"`), &enum)
+	if Type.CacheStorage != enum {
+		t.Errorf("Expected %d, got %d", Type.CacheStorage, enum)
+	}
+
+	enum = Type.InterestGroups
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"interest_groups"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"interest_groups"`, result)
+	}
+	json.Unmarshal([]byte(`"interest_groups"`), &enum)
+	if Type.InterestGroups != enum {
+		t.Errorf("Expected %d, got %d", Type.InterestGroups, enum)
+	}
+
+	enum = Type.SharedStorage
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"shared_storage"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"shared_storage"`, result)
+	}
+	json.Unmarshal([]byte(`"shared_storage"`), &enum)
+	if Type.SharedStorage != enum {
+		t.Errorf("Expected %d, got %d", Type.SharedStorage, enum)
+	}
+
+	enum = Type.StorageBuckets
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"storage_buckets"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"storage_buckets"`, result)
+	}
+	json.Unmarshal([]byte(`"storage_buckets"`), &enum)
+	if Type.StorageBuckets != enum {
+		t.Errorf("Expected %d, got %d", Type.StorageBuckets, enum)
+	}
+
+	enum = Type.All
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"all"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"all"`, result)
+	}
+	json.Unmarshal([]byte(`"all"`), &enum)
+	if Type.All != enum {
+		t.Errorf("Expected %d, got %d", Type.All, enum)
+	}
+
+	enum = Type.Other
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"other"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"other"`, result)
+	}
+	json.Unmarshal([]byte(`"other"`), &enum)
+	if Type.Other != enum {
+		t.Errorf("Expected %d, got %d", Type.Other, enum)
+	}
+
+	enum = Type.Appcache
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"appcache"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"appcache"`, result)
+	}
+	json.Unmarshal([]byte(`"appcache"`), &enum)
+	if Type.Appcache != enum {
+		t.Errorf("Expected %d, got %d", Type.Appcache, enum)
+	}
+}
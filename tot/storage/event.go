@@ -1,5 +1,13 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package storage
 
+import (
+	"github.com/mkenney/go-chrome/tot/network"
+	"github.com/mkenney/go-chrome/tot/page"
+	"github.com/mkenney/go-chrome/tot/target"
+)
+
 /*
 CacheStorageContentUpdatedEvent represents Storage.cacheStorageContentUpdated event data.
 
@@ -9,6 +17,12 @@ type CacheStorageContentUpdatedEvent struct {
 	// Origin to update.
 	Origin string `json:"origin"`
 
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+
 	// Name of cache in origin.
 	CacheName string `json:"cacheName"`
 
@@ -25,6 +39,12 @@ type CacheStorageListUpdatedEvent struct {
 	// Origin to update.
 	Origin string `json:"origin"`
 
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -38,6 +58,12 @@ type IndexedDBContentUpdatedEvent struct {
 	// Origin to update.
 	Origin string `json:"origin"`
 
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+
 	// Database to update.
 	DatabaseName string `json:"databaseName"`
 
@@ -57,6 +83,366 @@ type IndexedDBListUpdatedEvent struct {
 	// Origin to update.
 	Origin string `json:"origin"`
 
+	// Storage key to update.
+	StorageKey string `json:"storageKey"`
+
+	// Storage bucket to update.
+	BucketID string `json:"bucketId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InterestGroupAccessedEvent represents Storage.interestGroupAccessed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAccessed
+*/
+type InterestGroupAccessedEvent struct {
+	AccessTime network.TimeSinceEpoch `json:"accessTime"`
+
+	// Allowed values:
+	//   - InterestGroupAccessType.Join
+	//   - InterestGroupAccessType.Leave
+	//   - InterestGroupAccessType.Update
+	//   - InterestGroupAccessType.Loaded
+	//   - InterestGroupAccessType.Bid
+	//   - InterestGroupAccessType.Win
+	//   - InterestGroupAccessType.AdditionalBid
+	//   - InterestGroupAccessType.AdditionalBidWin
+	//   - InterestGroupAccessType.TopLevelBid
+	//   - InterestGroupAccessType.TopLevelAdditionalBid
+	//   - InterestGroupAccessType.Clear
+	Type InterestGroupAccessTypeEnum `json:"type"`
+
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	Name string `json:"name"`
+
+	// Optional. For topLevelBid/topLevelAdditionalBid, and when appropriate, win
+	// and additionalBidWin
+	ComponentSellerOrigin string `json:"componentSellerOrigin,omitempty"`
+
+	// Optional. For bid or somethingBid event, if done locally and not on a
+	// server.
+	Bid float64 `json:"bid,omitempty"`
+
+	// Optional.
+	BidCurrency string `json:"bidCurrency,omitempty"`
+
+	// Optional. For non-global events --- links to interestGroupAuctionEvent
+	UniqueAuctionID InterestGroupAuctionID `json:"uniqueAuctionId,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InterestGroupAuctionEventOccurredEvent represents Storage.interestGroupAuctionEventOccurred event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAuctionEventOccurred
+*/
+type InterestGroupAuctionEventOccurredEvent struct {
+	EventTime network.TimeSinceEpoch `json:"eventTime"`
+
+	// Allowed values:
+	//   - InterestGroupAuctionEventType.Started
+	//   - InterestGroupAuctionEventType.ConfigResolved
+	Type InterestGroupAuctionEventTypeEnum `json:"type"`
+
+	UniqueAuctionID InterestGroupAuctionID `json:"uniqueAuctionId"`
+
+	// Optional. Set for child auctions.
+	ParentAuctionID InterestGroupAuctionID `json:"parentAuctionId,omitempty"`
+
+	// Optional. Set for started and configResolved
+	AuctionConfig map[string]interface{} `json:"auctionConfig,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+InterestGroupAuctionNetworkRequestCreatedEvent represents Storage.interestGroupAuctionNetworkRequestCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-interestGroupAuctionNetworkRequestCreated
+*/
+type InterestGroupAuctionNetworkRequestCreatedEvent struct {
+	// Allowed values:
+	//   - InterestGroupAuctionFetchType.BidderJS
+	//   - InterestGroupAuctionFetchType.BidderWasm
+	//   - InterestGroupAuctionFetchType.SellerJS
+	//   - InterestGroupAuctionFetchType.BidderTrustedSignals
+	//   - InterestGroupAuctionFetchType.SellerTrustedSignals
+	Type InterestGroupAuctionFetchTypeEnum `json:"type"`
+
+	RequestID network.RequestID `json:"requestId"`
+
+	// This is the set of the auctions using the worklet that issued this request.
+	// In the case of trusted signals, it's possible that only some of them
+	// actually care about the keys being queried.
+	Auctions []InterestGroupAuctionID `json:"auctions"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SharedStorageAccessedEvent represents Storage.sharedStorageAccessed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-sharedStorageAccessed
+*/
+type SharedStorageAccessedEvent struct {
+	// Time of the access.
+	AccessTime network.TimeSinceEpoch `json:"accessTime"`
+
+	// Enum value indicating the access scope. Allowed values:
+	//   - SharedStorageAccessScope.Window
+	//   - SharedStorageAccessScope.SharedStorageWorklet
+	//   - SharedStorageAccessScope.ProtectedAudienceWorklet
+	//   - SharedStorageAccessScope.Header
+	Scope SharedStorageAccessScopeEnum `json:"scope"`
+
+	// Enum value indicating the Shared Storage API method invoked. Allowed values:
+	//   - SharedStorageAccessMethod.AddModule
+	//   - SharedStorageAccessMethod.CreateWorklet
+	//   - SharedStorageAccessMethod.SelectURL
+	//   - SharedStorageAccessMethod.Run
+	//   - SharedStorageAccessMethod.BatchUpdate
+	//   - SharedStorageAccessMethod.Set
+	//   - SharedStorageAccessMethod.Append
+	//   - SharedStorageAccessMethod.Delete
+	//   - SharedStorageAccessMethod.Clear
+	//   - SharedStorageAccessMethod.Get
+	//   - SharedStorageAccessMethod.Keys
+	//   - SharedStorageAccessMethod.Values
+	//   - SharedStorageAccessMethod.Entries
+	//   - SharedStorageAccessMethod.Length
+	//   - SharedStorageAccessMethod.RemainingBudget
+	Method SharedStorageAccessMethodEnum `json:"method"`
+
+	// DevTools Frame Token for the primary frame tree's root.
+	MainFrameID page.FrameID `json:"mainFrameId"`
+
+	// Serialization of the origin owning the Shared Storage data.
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	// Serialization of the site owning the Shared Storage data.
+	OwnerSite string `json:"ownerSite"`
+
+	// The sub-parameters wrapped by `params` are all optional and their
+	// presence/absence depends on `type`.
+	Params *SharedStorageAccessParams `json:"params"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+SharedStorageWorkletOperationExecutionFinishedEvent represents Storage.sharedStorageWorkletOperationExecutionFinished event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-sharedStorageWorkletOperationExecutionFinished
+*/
+type SharedStorageWorkletOperationExecutionFinishedEvent struct {
+	// Time that the operation finished.
+	FinishedTime network.TimeSinceEpoch `json:"finishedTime"`
+
+	// Time, in microseconds, from start of shared storage JS API call until end of
+	// operation execution in the worklet.
+	ExecutionTime int `json:"executionTime"`
+
+	// Enum value indicating the Shared Storage API method invoked. Allowed values:
+	//   - SharedStorageAccessMethod.AddModule
+	//   - SharedStorageAccessMethod.CreateWorklet
+	//   - SharedStorageAccessMethod.SelectURL
+	//   - SharedStorageAccessMethod.Run
+	//   - SharedStorageAccessMethod.BatchUpdate
+	//   - SharedStorageAccessMethod.Set
+	//   - SharedStorageAccessMethod.Append
+	//   - SharedStorageAccessMethod.Delete
+	//   - SharedStorageAccessMethod.Clear
+	//   - SharedStorageAccessMethod.Get
+	//   - SharedStorageAccessMethod.Keys
+	//   - SharedStorageAccessMethod.Values
+	//   - SharedStorageAccessMethod.Entries
+	//   - SharedStorageAccessMethod.Length
+	//   - SharedStorageAccessMethod.RemainingBudget
+	Method SharedStorageAccessMethodEnum `json:"method"`
+
+	// ID of the operation call.
+	OperationID string `json:"operationId"`
+
+	// Hex representation of the DevTools token used as the TargetID for the
+	// associated shared storage worklet.
+	WorkletTargetID target.ID `json:"workletTargetId"`
+
+	// DevTools Frame Token for the primary frame tree's root.
+	MainFrameID page.FrameID `json:"mainFrameId"`
+
+	// Serialization of the origin owning the Shared Storage data.
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+BucketCreatedOrUpdatedEvent represents Storage.storageBucketCreatedOrUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-storageBucketCreatedOrUpdated
+*/
+type BucketCreatedOrUpdatedEvent struct {
+	BucketInfo *BucketInfo `json:"bucketInfo"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+BucketDeletedEvent represents Storage.storageBucketDeleted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-storageBucketDeleted
+*/
+type BucketDeletedEvent struct {
+	BucketID string `json:"bucketId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AttributionReportingSourceRegisteredEvent represents Storage.attributionReportingSourceRegistered event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingSourceRegistered
+*/
+type AttributionReportingSourceRegisteredEvent struct {
+	Registration *AttributionReportingSourceRegistration `json:"registration"`
+
+	// Allowed values:
+	//   - AttributionReportingSourceRegistrationResult.Success
+	//   - AttributionReportingSourceRegistrationResult.InternalError
+	//   - AttributionReportingSourceRegistrationResult.InsufficientSourceCapacity
+	//   - AttributionReportingSourceRegistrationResult.InsufficientUniqueDestinationCapacity
+	//   - AttributionReportingSourceRegistrationResult.ExcessiveReportingOrigins
+	//   - AttributionReportingSourceRegistrationResult.ProhibitedByBrowserPolicy
+	//   - AttributionReportingSourceRegistrationResult.SuccessNoised
+	//   - AttributionReportingSourceRegistrationResult.DestinationReportingLimitReached
+	//   - AttributionReportingSourceRegistrationResult.DestinationGlobalLimitReached
+	//   - AttributionReportingSourceRegistrationResult.DestinationBothLimitsReached
+	//   - AttributionReportingSourceRegistrationResult.ReportingOriginsPerSiteLimitReached
+	//   - AttributionReportingSourceRegistrationResult.ExceedsMaxChannelCapacity
+	//   - AttributionReportingSourceRegistrationResult.ExceedsMaxScopesChannelCapacity
+	//   - AttributionReportingSourceRegistrationResult.ExceedsMaxTriggerStateCardinality
+	//   - AttributionReportingSourceRegistrationResult.ExceedsMaxEventStatesLimit
+	//   - AttributionReportingSourceRegistrationResult.DestinationPerDayReportingLimitReached
+	Result AttributionReportingSourceRegistrationResultEnum `json:"result"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AttributionReportingTriggerRegisteredEvent represents Storage.attributionReportingTriggerRegistered event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingTriggerRegistered
+*/
+type AttributionReportingTriggerRegisteredEvent struct {
+	Registration *AttributionReportingTriggerRegistration `json:"registration"`
+
+	// Allowed values:
+	//   - AttributionReportingEventLevelResult.Success
+	//   - AttributionReportingEventLevelResult.SuccessDroppedLowerPriority
+	//   - AttributionReportingEventLevelResult.InternalError
+	//   - AttributionReportingEventLevelResult.NoCapacityForAttributionDestination
+	//   - AttributionReportingEventLevelResult.NoMatchingSources
+	//   - AttributionReportingEventLevelResult.Deduplicated
+	//   - AttributionReportingEventLevelResult.ExcessiveAttributions
+	//   - AttributionReportingEventLevelResult.PriorityTooLow
+	//   - AttributionReportingEventLevelResult.NeverAttributedSource
+	//   - AttributionReportingEventLevelResult.ExcessiveReportingOrigins
+	//   - AttributionReportingEventLevelResult.NoMatchingSourceFilterData
+	//   - AttributionReportingEventLevelResult.ProhibitedByBrowserPolicy
+	//   - AttributionReportingEventLevelResult.NoMatchingConfigurations
+	//   - AttributionReportingEventLevelResult.ExcessiveReports
+	//   - AttributionReportingEventLevelResult.FalselyAttributedSource
+	//   - AttributionReportingEventLevelResult.ReportWindowPassed
+	//   - AttributionReportingEventLevelResult.NotRegistered
+	//   - AttributionReportingEventLevelResult.ReportWindowNotStarted
+	//   - AttributionReportingEventLevelResult.NoMatchingTriggerData
+	EventLevel AttributionReportingEventLevelResultEnum `json:"eventLevel"`
+
+	// Allowed values:
+	//   - AttributionReportingAggregatableResult.Success
+	//   - AttributionReportingAggregatableResult.InternalError
+	//   - AttributionReportingAggregatableResult.NoCapacityForAttributionDestination
+	//   - AttributionReportingAggregatableResult.NoMatchingSources
+	//   - AttributionReportingAggregatableResult.ExcessiveAttributions
+	//   - AttributionReportingAggregatableResult.ExcessiveReportingOrigins
+	//   - AttributionReportingAggregatableResult.NoHistograms
+	//   - AttributionReportingAggregatableResult.InsufficientBudget
+	//   - AttributionReportingAggregatableResult.InsufficientNamedBudget
+	//   - AttributionReportingAggregatableResult.NoMatchingSourceFilterData
+	//   - AttributionReportingAggregatableResult.NotRegistered
+	//   - AttributionReportingAggregatableResult.ProhibitedByBrowserPolicy
+	//   - AttributionReportingAggregatableResult.Deduplicated
+	//   - AttributionReportingAggregatableResult.ReportWindowPassed
+	//   - AttributionReportingAggregatableResult.ExcessiveReports
+	Aggregatable AttributionReportingAggregatableResultEnum `json:"aggregatable"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AttributionReportingReportSentEvent represents Storage.attributionReportingReportSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingReportSent
+*/
+type AttributionReportingReportSentEvent struct {
+	URL string `json:"url"`
+
+	Body map[string]interface{} `json:"body"`
+
+	// Allowed values:
+	//   - AttributionReportingReportResult.Sent
+	//   - AttributionReportingReportResult.Prohibited
+	//   - AttributionReportingReportResult.FailedToAssemble
+	//   - AttributionReportingReportResult.Expired
+	Result AttributionReportingReportResultEnum `json:"result"`
+
+	// Optional. If result is `sent`, populated with net/HTTP status.
+	NetError int `json:"netError,omitempty"`
+
+	// Optional.
+	NetErrorName string `json:"netErrorName,omitempty"`
+
+	// Optional.
+	HTTPStatusCode int `json:"httpStatusCode,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+AttributionReportingVerboseDebugReportSentEvent represents Storage.attributionReportingVerboseDebugReportSent event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Storage/#event-attributionReportingVerboseDebugReportSent
+*/
+type AttributionReportingVerboseDebugReportSentEvent struct {
+	URL string `json:"url"`
+
+	// Optional.
+	Body []map[string]interface{} `json:"body,omitempty"`
+
+	// Optional.
+	NetError int `json:"netError,omitempty"`
+
+	// Optional.
+	NetErrorName string `json:"netErrorName,omitempty"`
+
+	// Optional.
+	HTTPStatusCode int `json:"httpStatusCode,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
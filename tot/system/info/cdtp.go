@@ -1,31 +1,137 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package info provides type definitions for use with the Chrome SystemInfo protocol
 
+The SystemInfo domain defines methods and events for querying low-level system
+information. EXPERIMENTAL.
+
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/
 */
 package info
 
 /*
-GPUDevice describes a single graphics processor (GPU).
+GPUDevice represents the SystemInfo.GPUDevice type.
+
+Describes a single graphics processor (GPU).
 
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-GPUDevice
 */
 type GPUDevice struct {
 	// PCI ID of the GPU vendor, if available; 0 otherwise.
-	VendorID int `json:"vendorId"`
+	VendorID float64 `json:"vendorId"`
 
 	// PCI ID of the GPU device, if available; 0 otherwise.
-	DeviceID int `json:"deviceId"`
+	DeviceID float64 `json:"deviceId"`
+
+	// Optional. Sub sys ID of the GPU, only available on Windows.
+	SubSysID float64 `json:"subSysId,omitempty"`
+
+	// Optional. Revision of the GPU, only available on Windows.
+	Revision float64 `json:"revision,omitempty"`
 
 	// String description of the GPU vendor, if the PCI ID is not available.
 	VendorString string `json:"vendorString"`
 
 	// String description of the GPU device, if the PCI ID is not available.
 	DeviceString string `json:"deviceString"`
+
+	// String description of the GPU driver vendor.
+	DriverVendor string `json:"driverVendor"`
+
+	// String description of the GPU driver version.
+	DriverVersion string `json:"driverVersion"`
+}
+
+/*
+Size represents the SystemInfo.Size type.
+
+Describes the width and height dimensions of an entity.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-Size
+*/
+type Size struct {
+	// Width in pixels.
+	Width int `json:"width"`
+
+	// Height in pixels.
+	Height int `json:"height"`
+}
+
+/*
+VideoDecodeAcceleratorCapability represents the
+SystemInfo.VideoDecodeAcceleratorCapability type.
+
+Describes a supported video decoding profile with its associated minimum and
+maximum resolutions.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-VideoDecodeAcceleratorCapability
+*/
+type VideoDecodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g. VP9 Profile 2.
+	Profile string `json:"profile"`
+
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution *Size `json:"maxResolution"`
+
+	// Minimum video dimensions in pixels supported for this |profile|.
+	MinResolution *Size `json:"minResolution"`
+}
+
+/*
+VideoEncodeAcceleratorCapability represents the
+SystemInfo.VideoEncodeAcceleratorCapability type.
+
+Describes a supported video encoding profile with its associated maximum
+resolution and maximum framerate.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-VideoEncodeAcceleratorCapability
+*/
+type VideoEncodeAcceleratorCapability struct {
+	// Video codec profile that is supported, e.g H264 Main.
+	Profile string `json:"profile"`
+
+	// Maximum video dimensions in pixels supported for this |profile|.
+	MaxResolution *Size `json:"maxResolution"`
+
+	// Maximum encoding framerate in frames per second supported for this
+	// |profile|, as fraction's numerator and denominator, e.g. 24/1 fps,
+	// 24000/1001 fps, etc.
+	MaxFramerateNumerator int `json:"maxFramerateNumerator"`
+
+	MaxFramerateDenominator int `json:"maxFramerateDenominator"`
+}
+
+/*
+ImageDecodeAcceleratorCapability represents the
+SystemInfo.ImageDecodeAcceleratorCapability type.
+
+Describes a supported image decoding profile with its associated minimum and
+maximum resolutions and subsampling.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-ImageDecodeAcceleratorCapability
+*/
+type ImageDecodeAcceleratorCapability struct {
+	// Image coded, e.g. Jpeg. Allowed values:
+	//   - ImageType.Jpeg
+	//   - ImageType.Webp
+	//   - ImageType.Unknown
+	ImageType ImageTypeEnum `json:"imageType"`
+
+	// Maximum supported dimensions of the image in pixels.
+	MaxDimensions *Size `json:"maxDimensions"`
+
+	// Minimum supported dimensions of the image in pixels.
+	MinDimensions *Size `json:"minDimensions"`
+
+	// Optional array of supported subsampling formats, e.g. 4:2:0, if known.
+	Subsamplings []SubsamplingFormatEnum `json:"subsamplings"`
 }
 
 /*
-GPUInfo provides information about the GPU(s) on the system.
+GPUInfo represents the SystemInfo.GPUInfo type.
+
+Provides information about the GPU(s) on the system.
 
 https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-GPUInfo
 */
@@ -34,11 +140,39 @@ type GPUInfo struct {
 	Devices []*GPUDevice `json:"devices"`
 
 	// Optional. An optional dictionary of additional GPU related attributes.
-	AuxAttributes map[string]string `json:"auxAttributes,omitempty"`
+	AuxAttributes map[string]interface{} `json:"auxAttributes,omitempty"`
 
 	// Optional. An optional dictionary of graphics features and their status.
-	FeatureStatus map[string]string `json:"featureStatus,omitempty"`
+	FeatureStatus map[string]interface{} `json:"featureStatus,omitempty"`
 
 	// An optional array of GPU driver bug workarounds.
 	DriverBugWorkarounds []string `json:"driverBugWorkarounds"`
+
+	// Supported accelerated video decoding capabilities.
+	VideoDecoding []*VideoDecodeAcceleratorCapability `json:"videoDecoding"`
+
+	// Supported accelerated video encoding capabilities.
+	VideoEncoding []*VideoEncodeAcceleratorCapability `json:"videoEncoding"`
+
+	// Supported accelerated image decoding capabilities.
+	ImageDecoding []*ImageDecodeAcceleratorCapability `json:"imageDecoding"`
+}
+
+/*
+ProcessInfo represents the SystemInfo.ProcessInfo type.
+
+Represents process info.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-ProcessInfo
+*/
+type ProcessInfo struct {
+	// Specifies process type.
+	Type string `json:"type"`
+
+	// Specifies process id.
+	ID int `json:"id"`
+
+	// Specifies cumulative CPU usage in seconds across all threads of the process
+	// since the process start.
+	CPUTime float64 `json:"cpuTime"`
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package info
 
 /*
@@ -18,10 +20,44 @@ type GetInfoResult struct {
 	// this is, for example, '10.1'. Will be the empty string if not supported.
 	ModelVersion string `json:"modelVersion"`
 
-	// The command line string used to launch the browser. Will be the empty
-	// string if not supported.
+	// The command line string used to launch the browser. Will be the empty string
+	// if not supported.
 	CommandLine string `json:"commandLine"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+GetFeatureStateParams represents SystemInfo.getFeatureState parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getFeatureState
+*/
+type GetFeatureStateParams struct {
+	FeatureState string `json:"featureState"`
+}
+
+/*
+GetFeatureStateResult represents the result of calls to SystemInfo.getFeatureState.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getFeatureState
+*/
+type GetFeatureStateResult struct {
+	FeatureEnabled bool `json:"featureEnabled"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetProcessInfoResult represents the result of calls to SystemInfo.getProcessInfo.
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#method-getProcessInfo
+*/
+type GetProcessInfoResult struct {
+	// An array of process info blocks.
+	ProcessInfo []*ProcessInfo `json:"processInfo"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
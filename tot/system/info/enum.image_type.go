@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package info
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type imageTypeEnum struct {
+	Jpeg    ImageTypeEnum
+	Webp    ImageTypeEnum
+	Unknown ImageTypeEnum
+}
+
+/*
+ImageType provides named access to the ImageTypeEnum values.
+*/
+var ImageType = imageTypeEnum{
+	Jpeg:    imageTypeJpeg,
+	Webp:    imageTypeWebp,
+	Unknown: imageTypeUnknown,
+}
+
+/*
+ImageTypeEnum represents the SystemInfo.ImageType values.
+
+Image format of a given image.
+
+Allowed values:
+  - ImageType.Jpeg    "jpeg"
+  - ImageType.Webp    "webp"
+  - ImageType.Unknown "unknown"
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-ImageType
+*/
+type ImageTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ImageTypeEnum) String() string {
+	return _imageTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ImageTypeEnum) Unknown() bool {
+	return !_imageTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ImageTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ImageTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _imageTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// imageTypeJpeg represents the "jpeg" value.
+	imageTypeJpeg ImageTypeEnum = iota + 1
+	// imageTypeWebp represents the "webp" value.
+	imageTypeWebp
+	// imageTypeUnknown represents the "unknown" value.
+	imageTypeUnknown
+)
+
+var _imageTypeEnums = enums.New(map[ImageTypeEnum]string{
+	ImageTypeEnum(0): "",
+	imageTypeJpeg:    "jpeg",
+	imageTypeWebp:    "webp",
+	imageTypeUnknown: "unknown",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package info
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumImageType(t *testing.T) {
+	var enum ImageTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ImageTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ImageType.Jpeg
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"jpeg"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"jpeg"`, result)
+	}
+	json.Unmarshal([]byte(`"jpeg"`), &enum)
+	if ImageType.Jpeg != enum {
+		t.Errorf("Expected %d, got %d", ImageType.Jpeg, enum)
+	}
+
+	enum = ImageType.Webp
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"webp"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"webp"`, result)
+	}
+	json.Unmarshal([]byte(`"webp"`), &enum)
+	if ImageType.Webp != enum {
+		t.Errorf("Expected %d, got %d", ImageType.Webp, enum)
+	}
+
+	enum = ImageType.Unknown
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"unknown"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"unknown"`, result)
+	}
+	json.Unmarshal([]byte(`"unknown"`), &enum)
+	if ImageType.Unknown != enum {
+		t.Errorf("Expected %d, got %d", ImageType.Unknown, enum)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package info
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type subsamplingFormatEnum struct {
+	Yuv420 SubsamplingFormatEnum
+	Yuv422 SubsamplingFormatEnum
+	Yuv444 SubsamplingFormatEnum
+}
+
+/*
+SubsamplingFormat provides named access to the SubsamplingFormatEnum values.
+*/
+var SubsamplingFormat = subsamplingFormatEnum{
+	Yuv420: subsamplingFormatYuv420,
+	Yuv422: subsamplingFormatYuv422,
+	Yuv444: subsamplingFormatYuv444,
+}
+
+/*
+SubsamplingFormatEnum represents the SystemInfo.SubsamplingFormat values.
+
+YUV subsampling type of the pixels of a given image.
+
+Allowed values:
+  - SubsamplingFormat.Yuv420 "yuv420"
+  - SubsamplingFormat.Yuv422 "yuv422"
+  - SubsamplingFormat.Yuv444 "yuv444"
+
+https://chromedevtools.github.io/devtools-protocol/tot/SystemInfo/#type-SubsamplingFormat
+*/
+type SubsamplingFormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum SubsamplingFormatEnum) String() string {
+	return _subsamplingFormatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum SubsamplingFormatEnum) Unknown() bool {
+	return !_subsamplingFormatEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum SubsamplingFormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *SubsamplingFormatEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _subsamplingFormatEnums.Parse(val)
+	return nil
+}
+
+const (
+	// subsamplingFormatYuv420 represents the "yuv420" value.
+	subsamplingFormatYuv420 SubsamplingFormatEnum = iota + 1
+	// subsamplingFormatYuv422 represents the "yuv422" value.
+	subsamplingFormatYuv422
+	// subsamplingFormatYuv444 represents the "yuv444" value.
+	subsamplingFormatYuv444
+)
+
+var _subsamplingFormatEnums = enums.New(map[SubsamplingFormatEnum]string{
+	SubsamplingFormatEnum(0): "",
+	subsamplingFormatYuv420:  "yuv420",
+	subsamplingFormatYuv422:  "yuv422",
+	subsamplingFormatYuv444:  "yuv444",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package info
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumSubsamplingFormat(t *testing.T) {
+	var enum SubsamplingFormatEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown SubsamplingFormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = SubsamplingFormat.Yuv420
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"yuv420"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"yuv420"`, result)
+	}
+	json.Unmarshal([]byte(`"yuv420"`), &enum)
+	if SubsamplingFormat.Yuv420 != enum {
+		t.Errorf("Expected %d, got %d", SubsamplingFormat.Yuv420, enum)
+	}
+
+	enum = SubsamplingFormat.Yuv422
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"yuv422"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"yuv422"`, result)
+	}
+	json.Unmarshal([]byte(`"yuv422"`), &enum)
+	if SubsamplingFormat.Yuv422 != enum {
+		t.Errorf("Expected %d, got %d", SubsamplingFormat.Yuv422, enum)
+	}
+
+	enum = SubsamplingFormat.Yuv444
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"yuv444"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"yuv444"`, result)
+	}
+	json.Unmarshal([]byte(`"yuv444"`), &enum)
+	if SubsamplingFormat.Yuv444 != enum {
+		t.Errorf("Expected %d, got %d", SubsamplingFormat.Yuv444, enum)
+	}
+}
@@ -7,6 +7,8 @@ type TabData struct {
 	Description          string `json:"description"`
 	DevtoolsFrontendURL  string `json:"devtoolsFrontendURL"`
 	ID                   string `json:"id"`
+	OpenerID             string `json:"openerId,omitempty"`
+	BrowserContextID     string `json:"browserContextId,omitempty"`
 	Title                string `json:"title"`
 	Type                 string `json:"type"`
 	URL                  string `json:"url"`
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package chrome
 
 import (
@@ -32,6 +34,27 @@ func (tab *Tab) Audits() *socket.AuditsProtocol {
 	return tab.protocol.Audits()
 }
 
+/*
+Autofill implements socket.Protocoller
+*/
+func (tab *Tab) Autofill() *socket.AutofillProtocol {
+	return tab.protocol.Autofill()
+}
+
+/*
+BackgroundService implements socket.Protocoller
+*/
+func (tab *Tab) BackgroundService() *socket.BackgroundServiceProtocol {
+	return tab.protocol.BackgroundService()
+}
+
+/*
+BluetoothEmulation implements socket.Protocoller
+*/
+func (tab *Tab) BluetoothEmulation() *socket.BluetoothEmulationProtocol {
+	return tab.protocol.BluetoothEmulation()
+}
+
 /*
 Browser implements socket.Protocoller
 */
@@ -39,6 +62,13 @@ func (tab *Tab) Browser() *socket.BrowserProtocol {
 	return tab.protocol.Browser()
 }
 
+/*
+CSS implements socket.Protocoller
+*/
+func (tab *Tab) CSS() *socket.CSSProtocol {
+	return tab.protocol.CSS()
+}
+
 /*
 CacheStorage implements socket.Protocoller
 */
@@ -46,6 +76,13 @@ func (tab *Tab) CacheStorage() *socket.CacheStorageProtocol {
 	return tab.protocol.CacheStorage()
 }
 
+/*
+Cast implements socket.Protocoller
+*/
+func (tab *Tab) Cast() *socket.CastProtocol {
+	return tab.protocol.Cast()
+}
+
 /*
 Console implements socket.Protocoller
 */
@@ -54,10 +91,31 @@ func (tab *Tab) Console() *socket.ConsoleProtocol {
 }
 
 /*
-CSS implements socket.Protocoller
+DOM implements socket.Protocoller
 */
-func (tab *Tab) CSS() *socket.CSSProtocol {
-	return tab.protocol.CSS()
+func (tab *Tab) DOM() *socket.DOMProtocol {
+	return tab.protocol.DOM()
+}
+
+/*
+DOMDebugger implements socket.Protocoller
+*/
+func (tab *Tab) DOMDebugger() *socket.DOMDebuggerProtocol {
+	return tab.protocol.DOMDebugger()
+}
+
+/*
+DOMSnapshot implements socket.Protocoller
+*/
+func (tab *Tab) DOMSnapshot() *socket.DOMSnapshotProtocol {
+	return tab.protocol.DOMSnapshot()
+}
+
+/*
+DOMStorage implements socket.Protocoller
+*/
+func (tab *Tab) DOMStorage() *socket.DOMStorageProtocol {
+	return tab.protocol.DOMStorage()
 }
 
 /*
@@ -74,6 +132,13 @@ func (tab *Tab) Debugger() *socket.DebuggerProtocol {
 	return tab.protocol.Debugger()
 }
 
+/*
+DeviceAccess implements socket.Protocoller
+*/
+func (tab *Tab) DeviceAccess() *socket.DeviceAccessProtocol {
+	return tab.protocol.DeviceAccess()
+}
+
 /*
 DeviceOrientation implements socket.Protocoller
 */
@@ -82,38 +147,45 @@ func (tab *Tab) DeviceOrientation() *socket.DeviceOrientationProtocol {
 }
 
 /*
-DOMDebugger implements socket.Protocoller
+Emulation implements socket.Protocoller
 */
-func (tab *Tab) DOMDebugger() *socket.DOMDebuggerProtocol {
-	return tab.protocol.DOMDebugger()
+func (tab *Tab) Emulation() *socket.EmulationProtocol {
+	return tab.protocol.Emulation()
 }
 
 /*
-DOMSnapshot implements socket.Protocoller
+EventBreakpoints implements socket.Protocoller
 */
-func (tab *Tab) DOMSnapshot() *socket.DOMSnapshotProtocol {
-	return tab.protocol.DOMSnapshot()
+func (tab *Tab) EventBreakpoints() *socket.EventBreakpointsProtocol {
+	return tab.protocol.EventBreakpoints()
 }
 
 /*
-DOMStorage implements socket.Protocoller
+Extensions implements socket.Protocoller
 */
-func (tab *Tab) DOMStorage() *socket.DOMStorageProtocol {
-	return tab.protocol.DOMStorage()
+func (tab *Tab) Extensions() *socket.ExtensionsProtocol {
+	return tab.protocol.Extensions()
 }
 
 /*
-DOM implements socket.Protocoller
+FedCm implements socket.Protocoller
 */
-func (tab *Tab) DOM() *socket.DOMProtocol {
-	return tab.protocol.DOM()
+func (tab *Tab) FedCm() *socket.FedCmProtocol {
+	return tab.protocol.FedCm()
 }
 
 /*
-Emulation implements socket.Protocoller
+Fetch implements socket.Protocoller
 */
-func (tab *Tab) Emulation() *socket.EmulationProtocol {
-	return tab.protocol.Emulation()
+func (tab *Tab) Fetch() *socket.FetchProtocol {
+	return tab.protocol.Fetch()
+}
+
+/*
+FileSystem implements socket.Protocoller
+*/
+func (tab *Tab) FileSystem() *socket.FileSystemProtocol {
+	return tab.protocol.FileSystem()
 }
 
 /*
@@ -130,6 +202,13 @@ func (tab *Tab) HeapProfiler() *socket.HeapProfilerProtocol {
 	return tab.protocol.HeapProfiler()
 }
 
+/*
+IO implements socket.Protocoller
+*/
+func (tab *Tab) IO() *socket.IOProtocol {
+	return tab.protocol.IO()
+}
+
 /*
 IndexedDB implements socket.Protocoller
 */
@@ -145,10 +224,10 @@ func (tab *Tab) Input() *socket.InputProtocol {
 }
 
 /*
-IO implements socket.Protocoller
+Inspector implements socket.Protocoller
 */
-func (tab *Tab) IO() *socket.IOProtocol {
-	return tab.protocol.IO()
+func (tab *Tab) Inspector() *socket.InspectorProtocol {
+	return tab.protocol.Inspector()
 }
 
 /*
@@ -165,6 +244,13 @@ func (tab *Tab) Log() *socket.LogProtocol {
 	return tab.protocol.Log()
 }
 
+/*
+Media implements socket.Protocoller
+*/
+func (tab *Tab) Media() *socket.MediaProtocol {
+	return tab.protocol.Media()
+}
+
 /*
 Memory implements socket.Protocoller
 */
@@ -186,6 +272,13 @@ func (tab *Tab) Overlay() *socket.OverlayProtocol {
 	return tab.protocol.Overlay()
 }
 
+/*
+PWA implements socket.Protocoller
+*/
+func (tab *Tab) PWA() *socket.PWAProtocol {
+	return tab.protocol.PWA()
+}
+
 /*
 Page implements socket.Protocoller
 */
@@ -200,6 +293,20 @@ func (tab *Tab) Performance() *socket.PerformanceProtocol {
 	return tab.protocol.Performance()
 }
 
+/*
+PerformanceTimeline implements socket.Protocoller
+*/
+func (tab *Tab) PerformanceTimeline() *socket.PerformanceTimelineProtocol {
+	return tab.protocol.PerformanceTimeline()
+}
+
+/*
+Preload implements socket.Protocoller
+*/
+func (tab *Tab) Preload() *socket.PreloadProtocol {
+	return tab.protocol.Preload()
+}
+
 /*
 Profiler implements socket.Protocoller
 */
@@ -269,3 +376,17 @@ Tracing implements socket.Protocoller
 func (tab *Tab) Tracing() *socket.TracingProtocol {
 	return tab.protocol.Tracing()
 }
+
+/*
+WebAudio implements socket.Protocoller
+*/
+func (tab *Tab) WebAudio() *socket.WebAudioProtocol {
+	return tab.protocol.WebAudio()
+}
+
+/*
+WebAuthn implements socket.Protocoller
+*/
+func (tab *Tab) WebAuthn() *socket.WebAuthnProtocol {
+	return tab.protocol.WebAuthn()
+}
@@ -1,6 +1,9 @@
 package chrome
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/mkenney/go-chrome/tot/socket"
 )
 
@@ -11,6 +14,20 @@ func (tab *Tab) AddEventHandler(handler socket.EventHandler) {
 	tab.Socket().AddEventHandler(handler)
 }
 
+/*
+Capabilities implements Socketer
+*/
+func (tab *Tab) Capabilities() *socket.Capabilities {
+	return tab.Socket().Capabilities()
+}
+
+/*
+Call implements Socketer
+*/
+func (tab *Tab) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	return tab.Socket().Call(ctx, method, params, result)
+}
+
 /*
 RemoveEventHandler implements Socketer
 */
@@ -24,3 +41,31 @@ SendCommand implements Socketer
 func (tab *Tab) SendCommand(command socket.Commander) chan *socket.Response {
 	return tab.Socket().SendCommand(command)
 }
+
+/*
+SendCommandContext implements Socketer
+*/
+func (tab *Tab) SendCommandContext(ctx context.Context, command socket.Commander) (*socket.Response, error) {
+	return tab.Socket().SendCommandContext(ctx, command)
+}
+
+/*
+Subscribe implements Socketer
+*/
+func (tab *Tab) Subscribe(method string) (<-chan json.RawMessage, func()) {
+	return tab.Socket().Subscribe(method)
+}
+
+/*
+Supports implements Socketer
+*/
+func (tab *Tab) Supports(name string) bool {
+	return tab.Socket().Supports(name)
+}
+
+/*
+WaitFor implements Socketer
+*/
+func (tab *Tab) WaitFor(ctx context.Context, eventName string, predicate func(params json.RawMessage) bool) *socket.Waiter[json.RawMessage] {
+	return tab.Socket().WaitFor(ctx, eventName, predicate)
+}
@@ -1,13 +1,17 @@
 package chrome
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"sync"
 
 	errs "github.com/bdlm/errors"
 	"github.com/bdlm/log"
 	"github.com/mkenney/go-chrome/codes"
+	"github.com/mkenney/go-chrome/optional"
 	"github.com/mkenney/go-chrome/tot/socket"
+	"github.com/mkenney/go-chrome/tot/target"
 )
 
 /*
@@ -24,45 +28,150 @@ func (chrome *Chrome) NewTab(uri string) (*Tab, error) {
 		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
 	}
 
-	tab := &Tab{
-		chrome: chrome,
-		data:   &TabData{},
-		url:    targetURL,
+	if chrome.sessionTabs() {
+		return chrome.newSessionTab(uri)
 	}
 
-	_, err = tab.Chromium().Query(
+	data := &TabData{}
+	_, err = chrome.Query(
 		fmt.Sprintf("/json/new?%s", url.QueryEscape(uri)),
 		url.Values{},
-		tab.data,
+		data,
 	)
 	if nil != err {
 		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("/new?%s query failed", url.QueryEscape(uri)))
 	}
+	if "" == data.URL {
+		data.URL = targetURL.String()
+	}
+
+	return chrome.adoptTab(data)
+}
 
-	websocketURL, err := url.Parse(tab.Data().WebSocketDebuggerURL)
+/*
+adoptTab connects to the target described by data over its own websocket and
+adds it to the list of open tabs.
+*/
+func (chrome *Chrome) adoptTab(data *TabData) (*Tab, error) {
+	targetURL, err := url.Parse(data.URL)
 	if nil != err {
-		return nil, errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", tab.Data().WebSocketDebuggerURL))
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	if "" == data.WebSocketDebuggerURL {
+		return nil, errs.New(codes.TabWebsocketURLInvalid, fmt.Sprintf("no websocket URL for target '%s'", data.ID))
+	}
+	websocketURL, err := url.Parse(data.WebSocketDebuggerURL)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabWebsocketURLInvalid, fmt.Sprintf("invalid websocket URL '%s'", data.WebSocketDebuggerURL))
 	}
 
 	socket := socket.New(websocketURL)
-	tab.socket = socket
-	tab.protocol = socket
-	chrome.tabs = append(chrome.tabs, tab)
+	socket.SetCapabilities(chrome.Capabilities())
+	tab := &Tab{
+		chrome:   chrome,
+		data:     data,
+		mux:      &sync.Mutex{},
+		protocol: socket,
+		socket:   socket,
+		url:      targetURL,
+	}
 
-	return tab, nil
+	return chrome.addTab(tab), nil
+}
+
+/*
+newSessionTab creates a new page target and attaches to it as a flattened
+session on the browser-level connection.
+*/
+func (chrome *Chrome) newSessionTab(uri string) (*Tab, error) {
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, "no browser connection")
+	}
+	result := <-browser.Target().CreateTarget(&target.CreateTargetParams{URL: uri})
+	if nil != result.Err {
+		return nil, errs.Wrap(result.Err, codes.TabQueryFailed, fmt.Sprintf("could not create target for '%s'", uri))
+	}
+	return chrome.AttachTab(string(result.ID))
+}
+
+/*
+AttachTab attaches to an existing target as a flattened session on the
+browser-level connection and returns a Tab for it. Any target type can be
+attached, including pages, iframes and workers.
+*/
+func (chrome *Chrome) AttachTab(targetID string) (*Tab, error) {
+	if tab := chrome.tab(targetID); nil != tab {
+		return tab, nil
+	}
+	browser, err := chrome.Browser()
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, "no browser connection")
+	}
+
+	info := <-browser.Target().GetTargetInfo(&target.GetTargetInfoParams{ID: optional.Of(target.ID(targetID))})
+	if nil != info.Err {
+		return nil, errs.Wrap(info.Err, codes.TabQueryFailed, fmt.Sprintf("target '%s' info query failed", targetID))
+	}
+	data := &TabData{ID: targetID}
+	if nil != info.Info {
+		data.OpenerID = string(info.Info.OpenerID)
+		data.BrowserContextID = string(info.Info.BrowserContextID)
+		data.Title = info.Info.Title
+		data.Type = info.Info.Type
+		data.URL = info.Info.URL
+	}
+	targetURL, err := url.Parse(data.URL)
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabURLInvalid, "invalid URL")
+	}
+
+	session, err := browser.Attach(context.Background(), target.ID(targetID))
+	if nil != err {
+		return nil, errs.Wrap(err, codes.TabQueryFailed, fmt.Sprintf("could not attach to target '%s'", targetID))
+	}
+
+	tab := &Tab{
+		browser:  browser,
+		chrome:   chrome,
+		data:     data,
+		mux:      &sync.Mutex{},
+		protocol: session,
+		socket:   session,
+		url:      targetURL,
+	}
+
+	return chrome.addTab(tab), nil
 }
 
 /*
 Tab is a struct representing an individual Chrome tab
 */
 type Tab struct {
+	browser  *socket.Socket
 	chrome   Chromium
 	data     *TabData
+	mux      *sync.Mutex
 	protocol socket.Protocoller
 	socket   socket.Socketer
 	url      *url.URL
 }
 
+/*
+update updates the tab metadata from a change in the target's info. The data is
+replaced rather than modified so values returned by Data() are never changed
+concurrently.
+*/
+func (tab *Tab) update(info *target.Info) {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
+	data := *tab.data
+	data.Title = info.Title
+	data.URL = info.URL
+	tab.data = &data
+}
+
 /*
 Chromium implements Tabber.
 */
@@ -77,6 +186,17 @@ func (tab *Tab) Close() (interface{}, error) {
 	var err error
 	var result interface{}
 	tab.Socket().Stop()
+
+	// Flattened sessions are closed over the browser connection.
+	if nil != tab.browser {
+		closeResult := <-tab.browser.Target().CloseTarget(&target.CloseTargetParams{ID: target.ID(tab.Data().ID)})
+		if nil != closeResult.Err {
+			return nil, errs.Wrap(closeResult.Err, 0, fmt.Sprintf("close target %s failed", tab.Data().ID))
+		}
+		tab.Chromium().RemoveTab(tab)
+		return closeResult.Success, nil
+	}
+
 	_, err = tab.Chromium().Query(fmt.Sprintf("/json/close/%s", tab.Data().ID), url.Values{}, &result)
 	if nil != err {
 		log.WithFields(log.Fields{
@@ -89,10 +209,27 @@ func (tab *Tab) Close() (interface{}, error) {
 	return result, nil
 }
 
+/*
+Detach implements Tabber.
+*/
+func (tab *Tab) Detach() {
+	tab.Socket().Stop()
+	tab.Chromium().RemoveTab(tab)
+}
+
+/*
+Crashed implements Tabber.
+*/
+func (tab *Tab) Crashed() bool {
+	return tab.Socket().Crashed()
+}
+
 /*
 Data implements Tabber.
 */
 func (tab *Tab) Data() *TabData {
+	tab.mux.Lock()
+	defer tab.mux.Unlock()
 	return tab.data
 }
 
@@ -0,0 +1,31 @@
+package chrome
+
+import (
+	"testing"
+
+	"github.com/mkenney/go-chrome/tot/socket"
+)
+
+func TestTabCrashed(t *testing.T) {
+	browser := NewMock(
+		&Flags{},
+		"", //"path/to/chrome",
+		"", //"path/to/stderr",
+		"", //"path/to/stdout",
+		"", //"path/to/workdir",
+	)
+	tab, err := browser.NewTab("https://TestTabCrashed")
+	if nil != err {
+		t.Errorf("Expected nil, received error: %v", err)
+	}
+	if tab.Crashed() {
+		t.Errorf("Expected false, received true")
+	}
+
+	tab.Socket().(*MockSocket).Trigger(&socket.Response{
+		Method: "Inspector.targetCrashed",
+	})
+	if !tab.Crashed() {
+		t.Errorf("Expected true, received false")
+	}
+}
@@ -1,65 +1,119 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package target provides type definitions for use with the Chrome Target protocol
 
+Supports additional targets discovery and allows to attach to them.
+
 https://chromedevtools.github.io/devtools-protocol/tot/Target/
 */
 package target
 
+import (
+	"github.com/mkenney/go-chrome/tot/page"
+)
+
 /*
-ID is the target ID.
+BrowserContextID represents the Browser.BrowserContextID type. This is a
+duplicate of Browser.BrowserContextID to avoid an invalid import cycle.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-TargetID
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Browser/#type-BrowserContextID
 */
-type ID string
+type BrowserContextID string
 
 /*
-SessionID is a unique identifier of attached debugging session.
+ID represents the Target.TargetID type.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-SessionID
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-TargetID
 */
-type SessionID string
+type ID string
 
 /*
-BrowserContextID is EXPERIMENTAL
+SessionID represents the Target.SessionID type.
+
+Unique identifier of attached debugging session.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-BrowserContextID
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-SessionID
 */
-type BrowserContextID string
+type SessionID string
 
 /*
-Info holds the target info.
+Info represents the Target.TargetInfo type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-TargetInfo
 */
 type Info struct {
-	// desc.
 	ID ID `json:"targetId"`
 
-	// desc.
+	// List of types:
+	// https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22
 	Type string `json:"type"`
 
-	// desc.
 	Title string `json:"title"`
 
-	// desc.
 	URL string `json:"url"`
 
 	// Whether the target has an attached client.
 	Attached bool `json:"attached"`
 
-	// Optional. Opener target Id.
+	// Optional. Opener target Id
 	OpenerID ID `json:"openerId,omitempty"`
+
+	// Whether the target has access to the originating window. EXPERIMENTAL.
+	CanAccessOpener bool `json:"canAccessOpener"`
+
+	// Optional. Frame id of originating window (is only set if target has an
+	// opener). EXPERIMENTAL.
+	OpenerFrameID page.FrameID `json:"openerFrameId,omitempty"`
+
+	// Optional. EXPERIMENTAL.
+	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+
+	// Optional. Provides additional details for specific target types. For
+	// example, for the type of "page", this may be set to "prerender".
+	// EXPERIMENTAL.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+/*
+FilterEntry represents the Target.FilterEntry type.
+
+A filter used by target query/discovery/auto-attach operations. EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-FilterEntry
+*/
+type FilterEntry struct {
+	// Optional. If set, causes exclusion of matching targets from the list.
+	Exclude bool `json:"exclude,omitempty"`
+
+	// Optional. If not present, matches any type.
+	Type string `json:"type,omitempty"`
 }
 
 /*
-RemoteLocation is EXPERIMENTAL
+Filter represents the Target.TargetFilter type.
+
+The entries in TargetFilter are matched sequentially against targets and the
+first entry that matches determines if the target is included or not, depending
+on the value of `exclude` field in the entry. If filter is not specified, the
+one assumed is [{type: "browser", exclude: true}, {type: "tab", exclude: true},
+{}] (i.e. include everything but `browser` and `tab`). EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-TargetFilter
+*/
+type Filter []*FilterEntry
+
+/*
+RemoteLocation represents the Target.RemoteLocation type.
+
+EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#type-RemoteLocation
 */
 type RemoteLocation struct {
-	// desc.
 	Host string `json:"host"`
 
-	// desc.
 	Port int `json:"port"`
 }
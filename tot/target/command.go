@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package target
 
 /*
@@ -6,7 +8,6 @@ ActivateTargetParams represents Target.activateTarget parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-activateTarget
 */
 type ActivateTargetParams struct {
-	// Target ID.
 	ID ID `json:"targetId"`
 }
 
@@ -26,8 +27,12 @@ AttachToTargetParams represents Target.attachToTarget parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToTarget
 */
 type AttachToTargetParams struct {
-	// Target ID.
 	ID ID `json:"targetId"`
+
+	// Optional. Enables "flat" access to the session via specifying sessionId
+	// attribute in the commands. We plan to make this the default, deprecate
+	// non-flattened mode, and eventually retire it. See crbug.com/991325.
+	Flatten *bool `json:"flatten,omitempty"`
 }
 
 /*
@@ -43,13 +48,25 @@ type AttachToTargetResult struct {
 	Err error `json:"-"`
 }
 
+/*
+AttachToBrowserTargetResult represents the result of calls to Target.attachToBrowserTarget.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-attachToBrowserTarget
+*/
+type AttachToBrowserTargetResult struct {
+	// Id assigned to the session.
+	SessionID SessionID `json:"sessionId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 CloseTargetParams represents Target.closeTarget parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarget
 */
 type CloseTargetParams struct {
-	// Target ID.
 	ID ID `json:"targetId"`
 }
 
@@ -59,48 +76,148 @@ CloseTargetResult represents the result of calls to Target.closeTarget.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-closeTarget
 */
 type CloseTargetResult struct {
-	// Target close status
+	// Always set to true. If an error occurs, the response indicates protocol
+	// error. DEPRECATED.
 	Success bool `json:"success"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+ExposeDevToolsProtocolParams represents Target.exposeDevToolsProtocol parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-exposeDevToolsProtocol
+*/
+type ExposeDevToolsProtocolParams struct {
+	TargetID ID `json:"targetId"`
+
+	// Optional. Binding name, 'cdp' if not specified.
+	BindingName *string `json:"bindingName,omitempty"`
+
+	// Optional. If true, inherits the current root session's permissions (default:
+	// false).
+	InheritPermissions *bool `json:"inheritPermissions,omitempty"`
+}
+
+/*
+ExposeDevToolsProtocolResult represents the result of calls to Target.exposeDevToolsProtocol.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-exposeDevToolsProtocol
+*/
+type ExposeDevToolsProtocolResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+CreateBrowserContextParams represents Target.createBrowserContext parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrowserContext
+*/
+type CreateBrowserContextParams struct {
+	// Optional. If specified, disposes this context when debugging session
+	// disconnects. EXPERIMENTAL.
+	DisposeOnDetach *bool `json:"disposeOnDetach,omitempty"`
+
+	// Optional. Proxy server, similar to the one passed to --proxy-server
+	// EXPERIMENTAL.
+	ProxyServer *string `json:"proxyServer,omitempty"`
+
+	// Optional. Proxy bypass list, similar to the one passed to
+	// --proxy-bypass-list EXPERIMENTAL.
+	ProxyBypassList *string `json:"proxyBypassList,omitempty"`
+
+	// Optional. An optional list of origins to grant unlimited cross-origin access
+	// to. Parts of the URL other than those constituting origin are ignored.
+	// EXPERIMENTAL.
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess,omitempty"`
+}
+
 /*
 CreateBrowserContextResult represents the result of calls to Target.createBrowserContext.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createBrowserContext
 */
 type CreateBrowserContextResult struct {
-	// The ID of the context created.
+	// The id of the context created.
 	BrowserContextID BrowserContextID `json:"browserContextId"`
 
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
+/*
+GetBrowserContextsResult represents the result of calls to Target.getBrowserContexts.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getBrowserContexts
+*/
+type GetBrowserContextsResult struct {
+	// An array of browser context ids.
+	BrowserContextIDs []BrowserContextID `json:"browserContextIds"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
 /*
 CreateTargetParams represents Target.createTarget parameters.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarget
 */
 type CreateTargetParams struct {
-	// The initial URL the page will be navigated to.
+	// The initial URL the page will be navigated to. An empty string indicates
+	// about:blank.
 	URL string `json:"url"`
 
-	// Optional. Frame width in DIP (headless chrome only).
-	Width int `json:"width,omitempty"`
+	// Optional. Frame left origin in DIP (requires newWindow to be true or
+	// headless shell). EXPERIMENTAL.
+	Left *int `json:"left,omitempty"`
+
+	// Optional. Frame top origin in DIP (requires newWindow to be true or headless
+	// shell). EXPERIMENTAL.
+	Top *int `json:"top,omitempty"`
+
+	// Optional. Frame width in DIP (requires newWindow to be true or headless
+	// shell).
+	Width *int `json:"width,omitempty"`
+
+	// Optional. Frame height in DIP (requires newWindow to be true or headless
+	// shell).
+	Height *int `json:"height,omitempty"`
 
-	// Optional. Frame height in DIP (headless chrome only).
-	Height int `json:"height,omitempty"`
+	// Optional. Frame window state (requires newWindow to be true or headless
+	// shell). Default is normal. Allowed values:
+	//   - WindowState.Normal
+	//   - WindowState.Minimized
+	//   - WindowState.Maximized
+	//   - WindowState.Fullscreen
+	WindowState WindowStateEnum `json:"windowState,omitempty"`
 
-	// Optional. The browser context to create the page in (headless chrome only).
-	BrowserContextID BrowserContextID `json:"browserContextId,omitempty"`
+	// Optional. The browser context to create the page in. EXPERIMENTAL.
+	BrowserContextID *BrowserContextID `json:"browserContextId,omitempty"`
 
 	// Optional. Whether BeginFrames for this target will be controlled via
-	// DevTools (headless chrome only, not supported on MacOS yet, false by
+	// DevTools (headless shell only, not supported on MacOS yet, false by
 	// default). EXPERIMENTAL.
-	EnableBeginFrameControl bool `json:"enableBeginFrameControl,omitempty"`
+	EnableBeginFrameControl *bool `json:"enableBeginFrameControl,omitempty"`
+
+	// Optional. Whether to create a new Window or Tab (false by default, not
+	// supported by headless shell).
+	NewWindow *bool `json:"newWindow,omitempty"`
+
+	// Optional. Whether to create the target in background or foreground (false by
+	// default, not supported by headless shell).
+	Background *bool `json:"background,omitempty"`
+
+	// Optional. Whether to create the target of type "tab". EXPERIMENTAL.
+	ForTab *bool `json:"forTab,omitempty"`
+
+	// Optional. Whether to create a hidden target. The hidden target is observable
+	// via protocol, but not present in the tab UI strip. Cannot be created with
+	// `forTab: true`, `newWindow: true` or `background: false`. The life-time of
+	// the tab is limited to the life-time of the session. EXPERIMENTAL.
+	Hidden *bool `json:"hidden,omitempty"`
 }
 
 /*
@@ -109,7 +226,7 @@ CreateTargetResult represents the result of calls to Target.createTarget.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-createTarget
 */
 type CreateTargetResult struct {
-	// The ID of the page opened.
+	// The id of the page opened.
 	ID ID `json:"targetId"`
 
 	// Error information related to executing this method
@@ -123,10 +240,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-detachFrom
 */
 type DetachFromTargetParams struct {
 	// Optional. Session to detach.
-	SessionID SessionID `json:"sessionId,omitempty"`
+	SessionID *SessionID `json:"sessionId,omitempty"`
 
-	// Optional. DEPRECATED
-	ID ID `json:"targetId,omitempty"`
+	// Optional. Deprecated. DEPRECATED.
+	ID *ID `json:"targetId,omitempty"`
 }
 
 /*
@@ -145,8 +262,10 @@ DisposeBrowserContextParams represents Target.disposeBrowserContext parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-disposeBrowserContext
 */
 type DisposeBrowserContextParams struct {
-	// Target ID.
-	ID ID `json:"targetId"`
+	BrowserContextID BrowserContextID `json:"browserContextId"`
+
+	// Optional. Deprecated. Use BrowserContextID. DEPRECATED.
+	ID *ID `json:"targetId,omitempty"`
 }
 
 /*
@@ -155,6 +274,7 @@ DisposeBrowserContextResult represents the result of calls to Target.disposeBrow
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-disposeBrowserContext
 */
 type DisposeBrowserContextResult struct {
+	// DEPRECATED.
 	Success bool `json:"success"`
 
 	// Error information related to executing this method
@@ -167,8 +287,8 @@ GetTargetInfoParams represents Target.getTargetInfo parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargetInfo
 */
 type GetTargetInfoParams struct {
-	// Target ID.
-	ID ID `json:"targetId"`
+	// Optional.
+	ID *ID `json:"targetId,omitempty"`
 }
 
 /*
@@ -177,7 +297,9 @@ GetTargetInfoResult represents the result of calls to Target.getTargetInfo.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargetInfo
 */
 type GetTargetInfoResult struct {
-	// The list of targets.
+	Info *Info `json:"targetInfo"`
+
+	// The list of targets. DEPRECATED.
 	Infos []*Info `json:"targetInfos"`
 
 	// Error information related to executing this method
@@ -190,7 +312,12 @@ GetTargetsParams represents Target.getTargets parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 */
 type GetTargetsParams struct {
-	// The list of targets.
+	// Optional. Only targets matching filter will be reported. If filter is not
+	// specified and target discovery is currently enabled, a filter used for
+	// target discovery is used for consistency. EXPERIMENTAL.
+	Filter Filter `json:"filter,omitempty"`
+
+	// The list of targets. DEPRECATED.
 	Infos []*Info `json:"targetInfos"`
 }
 
@@ -200,6 +327,9 @@ GetTargetsResult represents the result of calls to Target.getTargets.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-getTargets
 */
 type GetTargetsResult struct {
+	// The list of targets.
+	Infos []*Info `json:"targetInfos"`
+
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -210,14 +340,13 @@ SendMessageToTargetParams represents Target.sendMessageToTarget parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-sendMessageToTarget
 */
 type SendMessageToTargetParams struct {
-	// Message.
 	Message string `json:"message"`
 
 	// Optional. Identifier of the session.
-	SessionID SessionID `json:"sessionId,omitempty"`
+	SessionID *SessionID `json:"sessionId,omitempty"`
 
-	// Optional. DEPRECATED
-	ID ID `json:"targetId,omitempty"`
+	// Optional. Deprecated. DEPRECATED.
+	ID *ID `json:"targetId,omitempty"`
 }
 
 /*
@@ -231,45 +360,60 @@ type SendMessageToTargetResult struct {
 }
 
 /*
-SetAttachToFramesParams represents Target.setAttachToFrames parameters.
+SetAutoAttachParams represents Target.setAutoAttach parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach
 */
-type SetAttachToFramesParams struct {
-	// Whether to attach to frames.
-	Value bool `json:"value"`
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+
+	// Whether to pause new targets when attaching to them. Use
+	// `Runtime.runIfWaitingForDebugger` to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+
+	// Optional. Enables "flat" access to the session via specifying sessionId
+	// attribute in the commands. We plan to make this the default, deprecate
+	// non-flattened mode, and eventually retire it. See crbug.com/991325.
+	// EXPERIMENTAL.
+	Flatten *bool `json:"flatten,omitempty"`
+
+	// Optional. Only targets matching filter will be attached. EXPERIMENTAL.
+	Filter Filter `json:"filter,omitempty"`
 }
 
 /*
-SetAttachToFramesResult represents the result of calls to Target.setAttachToFrames.
+SetAutoAttachResult represents the result of calls to Target.setAutoAttach.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach
 */
-type SetAttachToFramesResult struct {
+type SetAutoAttachResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
 
 /*
-SetAutoAttachParams represents Target.setAutoAttach parameters.
+AutoAttachRelatedParams represents Target.autoAttachRelated parameters.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-autoAttachRelated
 */
-type SetAutoAttachParams struct {
-	// Whether to auto-attach to related targets.
-	AutoAttach bool `json:"autoAttach"`
+type AutoAttachRelatedParams struct {
+	TargetID ID `json:"targetId"`
 
 	// Whether to pause new targets when attaching to them. Use
 	// `Runtime.runIfWaitingForDebugger` to run paused targets.
 	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+
+	// Optional. Only targets matching filter will be attached. EXPERIMENTAL.
+	Filter Filter `json:"filter,omitempty"`
 }
 
 /*
-SetAutoAttachResult represents the result of calls to Target.setAutoAttach.
+AutoAttachRelatedResult represents the result of calls to Target.autoAttachRelated.
 
-https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAutoAttach
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-autoAttachRelated
 */
-type SetAutoAttachResult struct {
+type AutoAttachRelatedResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
@@ -282,6 +426,10 @@ https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setDiscove
 type SetDiscoverTargetsParams struct {
 	// Whether to discover available targets.
 	Discover bool `json:"discover"`
+
+	// Optional. Only targets matching filter will be attached. If `discover` is
+	// false, `filter` must be omitted or empty. EXPERIMENTAL.
+	Filter Filter `json:"filter,omitempty"`
 }
 
 /*
@@ -313,3 +461,46 @@ type SetRemoteLocationsResult struct {
 	// Error information related to executing this method
 	Err error `json:"-"`
 }
+
+/*
+OpenDevToolsParams represents Target.openDevTools parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-openDevTools
+*/
+type OpenDevToolsParams struct {
+	// This can be the page or tab target ID.
+	TargetID ID `json:"targetId"`
+}
+
+/*
+OpenDevToolsResult represents the result of calls to Target.openDevTools.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-openDevTools
+*/
+type OpenDevToolsResult struct {
+	// The targetId of DevTools page target.
+	TargetID ID `json:"targetId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAttachToFramesParams represents Target.setAttachToFrames parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+*/
+type SetAttachToFramesParams struct {
+	// Whether to attach to frames.
+	Value bool `json:"value"`
+}
+
+/*
+SetAttachToFramesResult represents the result of calls to Target.setAttachToFrames.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#method-setAttachToFrames
+*/
+type SetAttachToFramesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package target
 
 /*
@@ -9,10 +11,8 @@ type AttachedToTargetEvent struct {
 	// Identifier assigned to the session used to send/receive messages.
 	SessionID SessionID `json:"sessionId"`
 
-	// Target info.
 	Info *Info `json:"targetInfo"`
 
-	// Waiting for debugger.
 	WaitingForDebugger bool `json:"waitingForDebugger"`
 
 	// Error information related to this event
@@ -28,8 +28,8 @@ type DetachedFromTargetEvent struct {
 	// Detached session identifier.
 	SessionID SessionID `json:"sessionId"`
 
-	// DEPRECATED
-	ID ID `json:"targetId"`
+	// Optional. Deprecated. DEPRECATED.
+	ID ID `json:"targetId,omitempty"`
 
 	// Error information related to this event
 	Err error `json:"-"`
@@ -44,7 +44,6 @@ type ReceivedMessageFromTargetEvent struct {
 	// Identifier of a session which sends a message.
 	SessionID SessionID `json:"sessionId"`
 
-	// Message.
 	Message string `json:"message"`
 
 	// Optional. Deprecated. DEPRECATED.
@@ -60,7 +59,6 @@ CreatedEvent represents Target.targetCreated event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCreated
 */
 type CreatedEvent struct {
-	// Target info.
 	Info *Info `json:"targetInfo"`
 
 	// Error information related to this event
@@ -73,20 +71,36 @@ DestroyedEvent represents Target.targetDestroyed event data.
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetDestroyed
 */
 type DestroyedEvent struct {
-	// Target ID.
 	ID ID `json:"targetId"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
+/*
+CrashedEvent represents Target.targetCrashed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetCrashed
+*/
+type CrashedEvent struct {
+	TargetID ID `json:"targetId"`
+
+	// Termination status type.
+	Status string `json:"status"`
+
+	// Termination error code.
+	ErrorCode int `json:"errorCode"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
 /*
 InfoChangedEvent represents Target.targetInfoChanged event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Target/#event-targetInfoChanged
 */
 type InfoChangedEvent struct {
-	// Target info.
 	Info *Info `json:"targetInfo"`
 
 	// Error information related to this event
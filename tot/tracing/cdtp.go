@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 /*
 Package tracing provides type definitions for use with the Chrome Tracing protocol
 
@@ -6,33 +8,40 @@ https://chromedevtools.github.io/devtools-protocol/tot/Tracing/
 package tracing
 
 /*
-MemoryDumpConfig is the configuration for memory dump. Used only when "memory-infra" category is
-enabled.
+MemoryDumpConfig represents the Tracing.MemoryDumpConfig type.
+
+Configuration for memory dump. Used only when "memory-infra" category is
+enabled. EXPERIMENTAL.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-MemoryDumpConfig
 */
-type MemoryDumpConfig map[string]string
+type MemoryDumpConfig map[string]interface{}
 
 /*
-TraceConfig is the trace configuration
+TraceConfig represents the Tracing.TraceConfig type.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-TraceConfig
 */
 type TraceConfig struct {
-	// Optional. Controls how the trace buffer stores data. Allowed values:
-	//	- RecordMode.RecordUntilFull
-	//	- RecordMode.RecordContinuously
-	//	- RecordMode.RecordAsMuchAsPossible
-	//	- RecordMode.EchoToConsole
+	// Optional. Controls how the trace buffer stores data. The default is
+	// `recordUntilFull`. EXPERIMENTAL. Allowed values:
+	//   - RecordMode.RecordUntilFull
+	//   - RecordMode.RecordContinuously
+	//   - RecordMode.RecordAsMuchAsPossible
+	//   - RecordMode.EchoToConsole
 	RecordMode RecordModeEnum `json:"recordMode,omitempty"`
 
-	// Optional. Turns on JavaScript stack sampling.
+	// Optional. Size of the trace buffer in kilobytes. If not specified or zero is
+	// passed, a default value of 200 MB would be used. EXPERIMENTAL.
+	TraceBufferSizeInKb float64 `json:"traceBufferSizeInKb,omitempty"`
+
+	// Optional. Turns on JavaScript stack sampling. EXPERIMENTAL.
 	EnableSampling bool `json:"enableSampling,omitempty"`
 
-	// Optional. Turns on system tracing.
+	// Optional. Turns on system tracing. EXPERIMENTAL.
 	EnableSystrace bool `json:"enableSystrace,omitempty"`
 
-	// Optional. Turns on argument filter.
+	// Optional. Turns on argument filter. EXPERIMENTAL.
 	EnableArgumentFilter bool `json:"enableArgumentFilter,omitempty"`
 
 	// Optional. Included category filters.
@@ -41,10 +50,10 @@ type TraceConfig struct {
 	// Optional. Excluded category filters.
 	ExcludedCategories []string `json:"excludedCategories,omitempty"`
 
-	// Optional. Configuration to synthesize the delays in tracing.
+	// Optional. Configuration to synthesize the delays in tracing. EXPERIMENTAL.
 	SyntheticDelays []string `json:"syntheticDelays,omitempty"`
 
 	// Optional. Configuration for memory dump triggers. Used only when
-	// "memory-infra" category is enabled.
+	// "memory-infra" category is enabled. EXPERIMENTAL.
 	MemoryDumpConfig MemoryDumpConfig `json:"memoryDumpConfig,omitempty"`
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 /*
@@ -43,6 +45,22 @@ type RecordClockSyncMarkerResult struct {
 	Err error `json:"-"`
 }
 
+/*
+RequestMemoryDumpParams represents Tracing.requestMemoryDump parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-requestMemoryDump
+*/
+type RequestMemoryDumpParams struct {
+	// Optional. Enables more deterministic results by forcing garbage collection
+	Deterministic *bool `json:"deterministic,omitempty"`
+
+	// Optional. Specifies level of details in memory dump. Defaults to "detailed". Allowed values:
+	//   - MemoryDumpLevelOfDetail.Background
+	//   - MemoryDumpLevelOfDetail.Light
+	//   - MemoryDumpLevelOfDetail.Detailed
+	LevelOfDetail MemoryDumpLevelOfDetailEnum `json:"levelOfDetail,omitempty"`
+}
+
 /*
 RequestMemoryDumpResult represents the result of calls to Tracing.requestMemoryDump.
 
@@ -52,7 +70,7 @@ type RequestMemoryDumpResult struct {
 	// GUID of the resulting global memory dump.
 	DumpGUID string `json:"dumpGuid"`
 
-	// True if the global memory dump succeeded.
+	// True iff the global memory dump succeeded.
 	Success bool `json:"success"`
 
 	// Error information related to executing this method
@@ -65,25 +83,48 @@ StartParams represents Tracing.start parameters.
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
 */
 type StartParams struct {
-	// Optional. Category/tag filter.
-	Categories string `json:"categories,omitempty"`
+	// Optional. Category/tag filter EXPERIMENTAL. DEPRECATED.
+	Categories *string `json:"categories,omitempty"`
 
-	// Optional. Tracing options.
-	Options string `json:"options,omitempty"`
+	// Optional. Tracing options EXPERIMENTAL. DEPRECATED.
+	Options *string `json:"options,omitempty"`
 
-	// Optional. If set, the agent will issue bufferUsage events at this
-	// interval, specified in milliseconds.
-	BufferUsageReportingInterval int64 `json:"bufferUsageReportingInterval,omitempty"`
+	// Optional. If set, the agent will issue bufferUsage events at this interval,
+	// specified in milliseconds EXPERIMENTAL.
+	BufferUsageReportingInterval *float64 `json:"bufferUsageReportingInterval,omitempty"`
 
-	// Optional. Whether to report trace events as series of dataCollected
-	// events or to save trace to a stream (defaults to `ReportEvents`). Allowed
-	// values:
-	//	- ReportEvents
-	//	- ReturnAsStream
+	// Optional. Whether to report trace events as series of dataCollected events
+	// or to save trace to a stream (defaults to `ReportEvents`). Allowed values:
+	//   - TransferMode.ReportEvents
+	//   - TransferMode.ReturnAsStream
 	TransferMode TransferModeEnum `json:"transferMode,omitempty"`
 
-	// Optional. Trace config.
+	// Optional. Trace data format to use. This only applies when using
+	// `ReturnAsStream` transfer mode (defaults to `json`). Allowed values:
+	//   - StreamFormat.JSON
+	//   - StreamFormat.Proto
+	StreamFormat StreamFormatEnum `json:"streamFormat,omitempty"`
+
+	// Optional. Compression format to use. This only applies when using
+	// `ReturnAsStream` transfer mode (defaults to `none`) EXPERIMENTAL. Allowed values:
+	//   - StreamCompression.None
+	//   - StreamCompression.Gzip
+	StreamCompression StreamCompressionEnum `json:"streamCompression,omitempty"`
+
+	// Optional.
 	TraceConfig *TraceConfig `json:"traceConfig,omitempty"`
+
+	// Optional. Base64-encoded serialized perfetto.protos.TraceConfig protobuf
+	// message When specified, the parameters `categories`, `options`,
+	// `traceConfig` are ignored. (Encoded as a base64 string when passed over
+	// JSON) EXPERIMENTAL.
+	PerfettoConfig *string `json:"perfettoConfig,omitempty"`
+
+	// Optional. Backend type (defaults to `auto`) EXPERIMENTAL. Allowed values:
+	//   - Backend.Auto
+	//   - Backend.Chrome
+	//   - Backend.System
+	TracingBackend BackendEnum `json:"tracingBackend,omitempty"`
 }
 
 /*
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumMemoryDumpLevelOfDetail(t *testing.T) {
+	var enum MemoryDumpLevelOfDetailEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown MemoryDumpLevelOfDetailEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = MemoryDumpLevelOfDetail.Background
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"background"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"background"`, result)
+	}
+	json.Unmarshal([]byte(`"background"`), &enum)
+	if MemoryDumpLevelOfDetail.Background != enum {
+		t.Errorf("Expected %d, got %d", MemoryDumpLevelOfDetail.Background, enum)
+	}
+
+	enum = MemoryDumpLevelOfDetail.Light
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"light"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"light"`, result)
+	}
+	json.Unmarshal([]byte(`"light"`), &enum)
+	if MemoryDumpLevelOfDetail.Light != enum {
+		t.Errorf("Expected %d, got %d", MemoryDumpLevelOfDetail.Light, enum)
+	}
+
+	enum = MemoryDumpLevelOfDetail.Detailed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"detailed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"detailed"`, result)
+	}
+	json.Unmarshal([]byte(`"detailed"`), &enum)
+	if MemoryDumpLevelOfDetail.Detailed != enum {
+		t.Errorf("Expected %d, got %d", MemoryDumpLevelOfDetail.Detailed, enum)
+	}
+}
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type recordModeEnum struct {
@@ -13,7 +16,7 @@ type recordModeEnum struct {
 }
 
 /*
-RecordMode provides named acces to the RecordModeEnum values.
+RecordMode provides named access to the RecordModeEnum values.
 */
 var RecordMode = recordModeEnum{
 	RecordUntilFull:        recordModeRecordUntilFull,
@@ -23,12 +26,15 @@ var RecordMode = recordModeEnum{
 }
 
 /*
-RecordModeEnum is optional. Controls how the trace buffer stores data. Allowed
-values:
-	- RecordMode.RecordUntilFull        "recordUntilFull"
-	- RecordMode.RecordContinuously     "recordContinuously"
-	- RecordMode.RecordAsMuchAsPossible "recordAsMuchAsPossible"
-	- RecordMode.EchoToConsole          "echoToConsole"
+RecordModeEnum represents the Tracing.TraceConfig.recordMode values.
+
+Controls how the trace buffer stores data. The default is `recordUntilFull`.
+
+Allowed values:
+  - RecordMode.RecordUntilFull        "recordUntilFull"
+  - RecordMode.RecordContinuously     "recordContinuously"
+  - RecordMode.RecordAsMuchAsPossible "recordAsMuchAsPossible"
+  - RecordMode.EchoToConsole          "echoToConsole"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-TraceConfig
 */
@@ -38,7 +44,16 @@ type RecordModeEnum int
 String implements Stringer
 */
 func (enum RecordModeEnum) String() string {
-	return _recordModeEnums[enum]
+	return _recordModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum RecordModeEnum) Unknown() bool {
+	return !_recordModeEnums.Known(enum)
 }
 
 /*
@@ -49,25 +64,16 @@ func (enum RecordModeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *RecordModeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _recordModeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _recordModeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -81,10 +87,10 @@ const (
 	recordModeEchoToConsole
 )
 
-var _recordModeEnums = map[RecordModeEnum]string{
+var _recordModeEnums = enums.New(map[RecordModeEnum]string{
 	RecordModeEnum(0):                "",
 	recordModeRecordUntilFull:        "recordUntilFull",
 	recordModeRecordContinuously:     "recordContinuously",
 	recordModeRecordAsMuchAsPossible: "recordAsMuchAsPossible",
 	recordModeEchoToConsole:          "echoToConsole",
-}
+})
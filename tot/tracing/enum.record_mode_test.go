@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
@@ -15,9 +17,20 @@ func TestEnumRecordMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown RecordModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumRecordMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"recordUntilFull"` != string(result) {
-		t.Errorf("Expected '\"recordUntilFull\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"recordUntilFull"`, result)
 	}
 	json.Unmarshal([]byte(`"recordUntilFull"`), &enum)
 	if RecordMode.RecordUntilFull != enum {
-		t.Errorf("Expcected %d, got %d", RecordMode.RecordUntilFull, enum)
+		t.Errorf("Expected %d, got %d", RecordMode.RecordUntilFull, enum)
 	}
 
 	enum = RecordMode.RecordContinuously
@@ -47,11 +60,11 @@ func TestEnumRecordMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"recordContinuously"` != string(result) {
-		t.Errorf("Expected '\"recordContinuously\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"recordContinuously"`, result)
 	}
 	json.Unmarshal([]byte(`"recordContinuously"`), &enum)
 	if RecordMode.RecordContinuously != enum {
-		t.Errorf("Expcected %d, got %d", RecordMode.RecordContinuously, enum)
+		t.Errorf("Expected %d, got %d", RecordMode.RecordContinuously, enum)
 	}
 
 	enum = RecordMode.RecordAsMuchAsPossible
@@ -60,18 +73,12 @@ func TestEnumRecordMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"recordAsMuchAsPossible"` != string(result) {
-		t.Errorf("Expected '\"recordAsMuchAsPossible\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"recordAsMuchAsPossible"`, result)
 	}
 	json.Unmarshal([]byte(`"recordAsMuchAsPossible"`), &enum)
 	if RecordMode.RecordAsMuchAsPossible != enum {
-		t.Errorf("Expcected %d, got %d", RecordMode.RecordAsMuchAsPossible, enum)
+		t.Errorf("Expected %d, got %d", RecordMode.RecordAsMuchAsPossible, enum)
 	}
-}
-
-func TestEnumRecordMode2(t *testing.T) {
-	var enum RecordModeEnum
-	var err error
-	var result []byte
 
 	enum = RecordMode.EchoToConsole
 	result, err = json.Marshal(enum)
@@ -79,10 +86,10 @@ func TestEnumRecordMode2(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"echoToConsole"` != string(result) {
-		t.Errorf("Expected '\"echoToConsole\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"echoToConsole"`, result)
 	}
 	json.Unmarshal([]byte(`"echoToConsole"`), &enum)
 	if RecordMode.EchoToConsole != enum {
-		t.Errorf("Expcected %d, got %d", RecordMode.EchoToConsole, enum)
+		t.Errorf("Expected %d, got %d", RecordMode.EchoToConsole, enum)
 	}
 }
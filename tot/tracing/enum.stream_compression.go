@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type streamCompressionEnum struct {
@@ -11,7 +14,7 @@ type streamCompressionEnum struct {
 }
 
 /*
-StreamCompression provides named acces to the StreamCompressionEnum values.
+StreamCompression provides named access to the StreamCompressionEnum values.
 */
 var StreamCompression = streamCompressionEnum{
 	None: streamCompressionNone,
@@ -19,10 +22,13 @@ var StreamCompression = streamCompressionEnum{
 }
 
 /*
-StreamCompressionEnum represents the compression type to use for traces returned
-via streams. Allowed values:
-	- StreamCompression.None "none"
-	- StreamCompression.Gzip "gzip"
+StreamCompressionEnum represents the Tracing.StreamCompression values.
+
+Compression type to use for traces returned via streams.
+
+Allowed values:
+  - StreamCompression.None "none"
+  - StreamCompression.Gzip "gzip"
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-StreamCompression
 */
@@ -32,7 +38,16 @@ type StreamCompressionEnum int
 String implements Stringer
 */
 func (enum StreamCompressionEnum) String() string {
-	return _streamCompressionEnums[enum]
+	return _streamCompressionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StreamCompressionEnum) Unknown() bool {
+	return !_streamCompressionEnums.Known(enum)
 }
 
 /*
@@ -43,25 +58,16 @@ func (enum StreamCompressionEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *StreamCompressionEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _streamCompressionEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _streamCompressionEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -71,7 +77,8 @@ const (
 	streamCompressionGzip
 )
 
-var _streamCompressionEnums = map[StreamCompressionEnum]string{
-	streamCompressionNone: "none",
-	streamCompressionGzip: "gzip",
-}
+var _streamCompressionEnums = enums.New(map[StreamCompressionEnum]string{
+	StreamCompressionEnum(0): "",
+	streamCompressionNone:    "none",
+	streamCompressionGzip:    "gzip",
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
@@ -11,8 +13,24 @@ func TestEnumStreamCompression(t *testing.T) {
 	var result []byte
 
 	err = json.Unmarshal([]byte(`""`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StreamCompressionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -29,11 +47,11 @@ func TestEnumStreamCompression(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"none"` != string(result) {
-		t.Errorf("Expected '\"none\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"none"`, result)
 	}
 	json.Unmarshal([]byte(`"none"`), &enum)
 	if StreamCompression.None != enum {
-		t.Errorf("Expcected %d, got %d", StreamCompression.None, enum)
+		t.Errorf("Expected %d, got %d", StreamCompression.None, enum)
 	}
 
 	enum = StreamCompression.Gzip
@@ -42,10 +60,10 @@ func TestEnumStreamCompression(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"gzip"` != string(result) {
-		t.Errorf("Expected '\"gzip\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"gzip"`, result)
 	}
 	json.Unmarshal([]byte(`"gzip"`), &enum)
 	if StreamCompression.Gzip != enum {
-		t.Errorf("Expcected %d, got %d", StreamCompression.Gzip, enum)
+		t.Errorf("Expected %d, got %d", StreamCompression.Gzip, enum)
 	}
 }
@@ -0,0 +1,85 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type streamFormatEnum struct {
+	JSON  StreamFormatEnum
+	Proto StreamFormatEnum
+}
+
+/*
+StreamFormat provides named access to the StreamFormatEnum values.
+*/
+var StreamFormat = streamFormatEnum{
+	JSON:  streamFormatJSON,
+	Proto: streamFormatProto,
+}
+
+/*
+StreamFormatEnum represents the Tracing.StreamFormat values.
+
+Data format of a trace. Can be either the legacy JSON format or the protocol
+buffer format. Note that the JSON format will be deprecated soon.
+
+Allowed values:
+  - StreamFormat.JSON  "json"
+  - StreamFormat.Proto "proto"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-StreamFormat
+*/
+type StreamFormatEnum int
+
+/*
+String implements Stringer
+*/
+func (enum StreamFormatEnum) String() string {
+	return _streamFormatEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum StreamFormatEnum) Unknown() bool {
+	return !_streamFormatEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum StreamFormatEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *StreamFormatEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _streamFormatEnums.Parse(val)
+	return nil
+}
+
+const (
+	// streamFormatJSON represents the "json" value.
+	streamFormatJSON StreamFormatEnum = iota + 1
+	// streamFormatProto represents the "proto" value.
+	streamFormatProto
+)
+
+var _streamFormatEnums = enums.New(map[StreamFormatEnum]string{
+	StreamFormatEnum(0): "",
+	streamFormatJSON:    "json",
+	streamFormatProto:   "proto",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumStreamFormat(t *testing.T) {
+	var enum StreamFormatEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown StreamFormatEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = StreamFormat.JSON
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"json"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"json"`, result)
+	}
+	json.Unmarshal([]byte(`"json"`), &enum)
+	if StreamFormat.JSON != enum {
+		t.Errorf("Expected %d, got %d", StreamFormat.JSON, enum)
+	}
+
+	enum = StreamFormat.Proto
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"proto"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"proto"`, result)
+	}
+	json.Unmarshal([]byte(`"proto"`), &enum)
+	if StreamFormat.Proto != enum {
+		t.Errorf("Expected %d, got %d", StreamFormat.Proto, enum)
+	}
+}
@@ -0,0 +1,94 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package tracing
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type backendEnum struct {
+	Auto   BackendEnum
+	Chrome BackendEnum
+	System BackendEnum
+}
+
+/*
+Backend provides named access to the BackendEnum values.
+*/
+var Backend = backendEnum{
+	Auto:   backendAuto,
+	Chrome: backendChrome,
+	System: backendSystem,
+}
+
+/*
+BackendEnum represents the Tracing.TracingBackend values.
+
+Backend type to use for tracing. `chrome` uses the Chrome-integrated tracing
+service and is supported on all platforms. `system` is only supported on Chrome
+OS and uses the Perfetto system tracing service. `auto` chooses `system` when
+the perfettoConfig provided to Tracing.start specifies at least one non-Chrome
+data source; otherwise uses `chrome`.
+
+Allowed values:
+  - Backend.Auto   "auto"
+  - Backend.Chrome "chrome"
+  - Backend.System "system"
+
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-TracingBackend
+*/
+type BackendEnum int
+
+/*
+String implements Stringer
+*/
+func (enum BackendEnum) String() string {
+	return _backendEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum BackendEnum) Unknown() bool {
+	return !_backendEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum BackendEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *BackendEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _backendEnums.Parse(val)
+	return nil
+}
+
+const (
+	// backendAuto represents the "auto" value.
+	backendAuto BackendEnum = iota + 1
+	// backendChrome represents the "chrome" value.
+	backendChrome
+	// backendSystem represents the "system" value.
+	backendSystem
+)
+
+var _backendEnums = enums.New(map[BackendEnum]string{
+	BackendEnum(0): "",
+	backendAuto:    "auto",
+	backendChrome:  "chrome",
+	backendSystem:  "system",
+})
@@ -1,8 +1,11 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
 	"encoding/json"
-	"fmt"
+
+	"github.com/mkenney/go-chrome/enums"
 )
 
 type transferModeEnum struct {
@@ -11,7 +14,7 @@ type transferModeEnum struct {
 }
 
 /*
-TransferMode provides named acces to the TransferModeEnum values.
+TransferMode provides named access to the TransferModeEnum values.
 */
 var TransferMode = transferModeEnum{
 	ReportEvents:   transferModeReportEvents,
@@ -19,13 +22,16 @@ var TransferMode = transferModeEnum{
 }
 
 /*
-TransferModeEnum is optional. Whether to report trace events as series of
-dataCollected events or to save trace to a stream (defaults to `ReportEvents`).
+TransferModeEnum represents the Tracing.start.transferMode values.
+
+Whether to report trace events as series of dataCollected events or to save
+trace to a stream (defaults to `ReportEvents`).
+
 Allowed values:
-	- TransferMode.ReportEvents   "ReportEvents"
-	- TransferMode.ReturnAsStream "ReturnAsStream"
+  - TransferMode.ReportEvents   "ReportEvents"
+  - TransferMode.ReturnAsStream "ReturnAsStream"
 
-https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#type-TraceConfig
+https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#method-start
 */
 type TransferModeEnum int
 
@@ -33,7 +39,16 @@ type TransferModeEnum int
 String implements Stringer
 */
 func (enum TransferModeEnum) String() string {
-	return _transferModeEnums[enum]
+	return _transferModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum TransferModeEnum) Unknown() bool {
+	return !_transferModeEnums.Known(enum)
 }
 
 /*
@@ -44,25 +59,16 @@ func (enum TransferModeEnum) MarshalJSON() ([]byte, error) {
 }
 
 /*
-UnmarshalJSON implements json.Unmarshaler
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
 */
 func (enum *TransferModeEnum) UnmarshalJSON(bytes []byte) error {
-	var err error
 	var val string
-
-	err = json.Unmarshal(bytes, &val)
-	if nil != err {
+	if err := json.Unmarshal(bytes, &val); nil != err {
 		return err
 	}
-
-	for k, v := range _transferModeEnums {
-		if v == val {
-			*enum = k
-			return nil
-		}
-	}
-
-	return fmt.Errorf("%s is not a valid type value", bytes)
+	*enum = _transferModeEnums.Parse(val)
+	return nil
 }
 
 const (
@@ -72,8 +78,8 @@ const (
 	transferModeReturnAsStream
 )
 
-var _transferModeEnums = map[TransferModeEnum]string{
+var _transferModeEnums = enums.New(map[TransferModeEnum]string{
 	TransferModeEnum(0):        "",
 	transferModeReportEvents:   "ReportEvents",
 	transferModeReturnAsStream: "ReturnAsStream",
-}
+})
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
@@ -15,9 +17,20 @@ func TestEnumTransferMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 
-	err = json.Unmarshal([]byte(`"invalid value"`), &enum)
-	if nil == err {
-		t.Errorf("Expected error, got nil")
+	var unknown TransferModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
 	}
 
 	result, err = json.Marshal(enum)
@@ -34,11 +47,11 @@ func TestEnumTransferMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ReportEvents"` != string(result) {
-		t.Errorf("Expected '\"ReportEvents\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ReportEvents"`, result)
 	}
 	json.Unmarshal([]byte(`"ReportEvents"`), &enum)
 	if TransferMode.ReportEvents != enum {
-		t.Errorf("Expcected %d, got %d", TransferMode.ReportEvents, enum)
+		t.Errorf("Expected %d, got %d", TransferMode.ReportEvents, enum)
 	}
 
 	enum = TransferMode.ReturnAsStream
@@ -47,10 +60,10 @@ func TestEnumTransferMode(t *testing.T) {
 		t.Errorf("Expected nil, got error")
 	}
 	if `"ReturnAsStream"` != string(result) {
-		t.Errorf("Expected '\"ReturnAsStream\"', got '%s'", result)
+		t.Errorf("Expected '%s', got '%s'", `"ReturnAsStream"`, result)
 	}
 	json.Unmarshal([]byte(`"ReturnAsStream"`), &enum)
 	if TransferMode.ReturnAsStream != enum {
-		t.Errorf("Expcected %d, got %d", TransferMode.ReturnAsStream, enum)
+		t.Errorf("Expected %d, got %d", TransferMode.ReturnAsStream, enum)
 	}
 }
@@ -1,3 +1,5 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
 package tracing
 
 import (
@@ -5,7 +7,7 @@ import (
 )
 
 /*
-BufferUsageEvent represents Overlay.bufferUsage event data.
+BufferUsageEvent represents Tracing.bufferUsage event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-bufferUsage
 */
@@ -15,7 +17,7 @@ type BufferUsageEvent struct {
 	PercentFull float64 `json:"percentFull,omitempty"`
 
 	// Optional. An approximate number of events in the trace log.
-	EventCount int64 `json:"eventCount,omitempty"`
+	EventCount float64 `json:"eventCount,omitempty"`
 
 	// Optional. A number in range [0..1] that indicates the used size of event
 	// buffer as a fraction of its total size.
@@ -26,26 +28,40 @@ type BufferUsageEvent struct {
 }
 
 /*
-DataCollectedEvent represents Overlay.dataCollected event data.
+DataCollectedEvent represents Tracing.dataCollected event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-dataCollected
 */
 type DataCollectedEvent struct {
-	Value []map[string]string `json:"value"`
+	Value []map[string]interface{} `json:"value"`
 
 	// Error information related to this event
 	Err error `json:"-"`
 }
 
 /*
-CompleteEvent represents Overlay.tracingComplete event data.
+CompleteEvent represents Tracing.tracingComplete event data.
 
 https://chromedevtools.github.io/devtools-protocol/tot/Tracing/#event-tracingComplete
 */
 type CompleteEvent struct {
+	// Indicates whether some trace data is known to have been lost, e.g. because
+	// the trace ring buffer wrapped around.
+	DataLossOccurred bool `json:"dataLossOccurred"`
+
 	// Optional. A handle of the stream that holds resulting trace data.
 	Stream io.StreamHandle `json:"stream,omitempty"`
 
+	// Optional. Trace data format of returned stream. Allowed values:
+	//   - StreamFormat.JSON
+	//   - StreamFormat.Proto
+	TraceFormat StreamFormatEnum `json:"traceFormat,omitempty"`
+
+	// Optional. Compression format of returned stream. Allowed values:
+	//   - StreamCompression.None
+	//   - StreamCompression.Gzip
+	StreamCompression StreamCompressionEnum `json:"streamCompression,omitempty"`
+
 	// Error information related to this event
 	Err error `json:"-"`
 }
@@ -0,0 +1,170 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package audio provides type definitions for use with the Chrome WebAudio protocol
+
+This domain allows inspection of Web Audio API.
+https://webaudio.github.io/web-audio-api/ EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/
+*/
+package audio
+
+/*
+GraphObjectID represents the WebAudio.GraphObjectId type.
+
+An unique ID for a graph object (AudioContext, AudioNode, AudioParam) in Web
+Audio API
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-GraphObjectId
+*/
+type GraphObjectID string
+
+/*
+NodeType represents the WebAudio.NodeType type.
+
+# Enum of AudioNode types
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-NodeType
+*/
+type NodeType string
+
+/*
+ParamType represents the WebAudio.ParamType type.
+
+# Enum of AudioParam types
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ParamType
+*/
+type ParamType string
+
+/*
+ContextRealtimeData represents the WebAudio.ContextRealtimeData type.
+
+Fields in AudioContext that change in real-time.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ContextRealtimeData
+*/
+type ContextRealtimeData struct {
+	// The current context time in second in BaseAudioContext.
+	CurrentTime float64 `json:"currentTime"`
+
+	// The time spent on rendering graph divided by render quantum duration, and
+	// multiplied by 100. 100 means the audio renderer reached the full capacity
+	// and glitch may occur.
+	RenderCapacity float64 `json:"renderCapacity"`
+
+	// A running mean of callback interval.
+	CallbackIntervalMean float64 `json:"callbackIntervalMean"`
+
+	// A running variance of callback interval.
+	CallbackIntervalVariance float64 `json:"callbackIntervalVariance"`
+}
+
+/*
+BaseAudioContext represents the WebAudio.BaseAudioContext type.
+
+# Protocol object for BaseAudioContext
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-BaseAudioContext
+*/
+type BaseAudioContext struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	// Allowed values:
+	//   - ContextType.Realtime
+	//   - ContextType.Offline
+	ContextType ContextTypeEnum `json:"contextType"`
+
+	// Allowed values:
+	//   - ContextState.Suspended
+	//   - ContextState.Running
+	//   - ContextState.Closed
+	//   - ContextState.Interrupted
+	ContextState ContextStateEnum `json:"contextState"`
+
+	// Optional.
+	RealtimeData *ContextRealtimeData `json:"realtimeData,omitempty"`
+
+	// Platform-dependent callback buffer size.
+	CallbackBufferSize float64 `json:"callbackBufferSize"`
+
+	// Number of output channels supported by audio hardware in use.
+	MaxOutputChannelCount float64 `json:"maxOutputChannelCount"`
+
+	// Context sample rate.
+	SampleRate float64 `json:"sampleRate"`
+}
+
+/*
+Listener represents the WebAudio.AudioListener type.
+
+# Protocol object for AudioListener
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-AudioListener
+*/
+type Listener struct {
+	ListenerID GraphObjectID `json:"listenerId"`
+
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+/*
+Node represents the WebAudio.AudioNode type.
+
+# Protocol object for AudioNode
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-AudioNode
+*/
+type Node struct {
+	NodeID GraphObjectID `json:"nodeId"`
+
+	ContextID GraphObjectID `json:"contextId"`
+
+	NodeType NodeType `json:"nodeType"`
+
+	NumberOfInputs float64 `json:"numberOfInputs"`
+
+	NumberOfOutputs float64 `json:"numberOfOutputs"`
+
+	ChannelCount float64 `json:"channelCount"`
+
+	// Allowed values:
+	//   - ChannelCountMode.ClampedMax
+	//   - ChannelCountMode.Explicit
+	//   - ChannelCountMode.Max
+	ChannelCountMode ChannelCountModeEnum `json:"channelCountMode"`
+
+	// Allowed values:
+	//   - ChannelInterpretation.Discrete
+	//   - ChannelInterpretation.Speakers
+	ChannelInterpretation ChannelInterpretationEnum `json:"channelInterpretation"`
+}
+
+/*
+Param represents the WebAudio.AudioParam type.
+
+# Protocol object for AudioParam
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-AudioParam
+*/
+type Param struct {
+	ParamID GraphObjectID `json:"paramId"`
+
+	NodeID GraphObjectID `json:"nodeId"`
+
+	ContextID GraphObjectID `json:"contextId"`
+
+	ParamType ParamType `json:"paramType"`
+
+	// Allowed values:
+	//   - AutomationRate.ARate
+	//   - AutomationRate.KRate
+	Rate AutomationRateEnum `json:"rate"`
+
+	DefaultValue float64 `json:"defaultValue"`
+
+	MinValue float64 `json:"minValue"`
+
+	MaxValue float64 `json:"maxValue"`
+}
@@ -0,0 +1,44 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+/*
+EnableResult represents the result of calls to WebAudio.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to WebAudio.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetRealtimeDataParams represents WebAudio.getRealtimeData parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-getRealtimeData
+*/
+type GetRealtimeDataParams struct {
+	ContextID GraphObjectID `json:"contextId"`
+}
+
+/*
+GetRealtimeDataResult represents the result of calls to WebAudio.getRealtimeData.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#method-getRealtimeData
+*/
+type GetRealtimeDataResult struct {
+	RealtimeData *ContextRealtimeData `json:"realtimeData"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type automationRateEnum struct {
+	ARate AutomationRateEnum
+	KRate AutomationRateEnum
+}
+
+/*
+AutomationRate provides named access to the AutomationRateEnum values.
+*/
+var AutomationRate = automationRateEnum{
+	ARate: automationRateARate,
+	KRate: automationRateKRate,
+}
+
+/*
+AutomationRateEnum represents the WebAudio.AutomationRate values.
+
+Enum of AudioParam::AutomationRate from the spec
+
+Allowed values:
+  - AutomationRate.ARate "a-rate"
+  - AutomationRate.KRate "k-rate"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-AutomationRate
+*/
+type AutomationRateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AutomationRateEnum) String() string {
+	return _automationRateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AutomationRateEnum) Unknown() bool {
+	return !_automationRateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AutomationRateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AutomationRateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _automationRateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// automationRateARate represents the "a-rate" value.
+	automationRateARate AutomationRateEnum = iota + 1
+	// automationRateKRate represents the "k-rate" value.
+	automationRateKRate
+)
+
+var _automationRateEnums = enums.New(map[AutomationRateEnum]string{
+	AutomationRateEnum(0): "",
+	automationRateARate:   "a-rate",
+	automationRateKRate:   "k-rate",
+})
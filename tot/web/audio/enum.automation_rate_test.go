@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAutomationRate(t *testing.T) {
+	var enum AutomationRateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AutomationRateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AutomationRate.ARate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"a-rate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"a-rate"`, result)
+	}
+	json.Unmarshal([]byte(`"a-rate"`), &enum)
+	if AutomationRate.ARate != enum {
+		t.Errorf("Expected %d, got %d", AutomationRate.ARate, enum)
+	}
+
+	enum = AutomationRate.KRate
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"k-rate"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"k-rate"`, result)
+	}
+	json.Unmarshal([]byte(`"k-rate"`), &enum)
+	if AutomationRate.KRate != enum {
+		t.Errorf("Expected %d, got %d", AutomationRate.KRate, enum)
+	}
+}
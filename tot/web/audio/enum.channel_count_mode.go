@@ -0,0 +1,90 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type channelCountModeEnum struct {
+	ClampedMax ChannelCountModeEnum
+	Explicit   ChannelCountModeEnum
+	Max        ChannelCountModeEnum
+}
+
+/*
+ChannelCountMode provides named access to the ChannelCountModeEnum values.
+*/
+var ChannelCountMode = channelCountModeEnum{
+	ClampedMax: channelCountModeClampedMax,
+	Explicit:   channelCountModeExplicit,
+	Max:        channelCountModeMax,
+}
+
+/*
+ChannelCountModeEnum represents the WebAudio.ChannelCountMode values.
+
+Enum of AudioNode::ChannelCountMode from the spec
+
+Allowed values:
+  - ChannelCountMode.ClampedMax "clamped-max"
+  - ChannelCountMode.Explicit   "explicit"
+  - ChannelCountMode.Max        "max"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ChannelCountMode
+*/
+type ChannelCountModeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ChannelCountModeEnum) String() string {
+	return _channelCountModeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ChannelCountModeEnum) Unknown() bool {
+	return !_channelCountModeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ChannelCountModeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ChannelCountModeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _channelCountModeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// channelCountModeClampedMax represents the "clamped-max" value.
+	channelCountModeClampedMax ChannelCountModeEnum = iota + 1
+	// channelCountModeExplicit represents the "explicit" value.
+	channelCountModeExplicit
+	// channelCountModeMax represents the "max" value.
+	channelCountModeMax
+)
+
+var _channelCountModeEnums = enums.New(map[ChannelCountModeEnum]string{
+	ChannelCountModeEnum(0):    "",
+	channelCountModeClampedMax: "clamped-max",
+	channelCountModeExplicit:   "explicit",
+	channelCountModeMax:        "max",
+})
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumChannelCountMode(t *testing.T) {
+	var enum ChannelCountModeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ChannelCountModeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ChannelCountMode.ClampedMax
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"clamped-max"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"clamped-max"`, result)
+	}
+	json.Unmarshal([]byte(`"clamped-max"`), &enum)
+	if ChannelCountMode.ClampedMax != enum {
+		t.Errorf("Expected %d, got %d", ChannelCountMode.ClampedMax, enum)
+	}
+
+	enum = ChannelCountMode.Explicit
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"explicit"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"explicit"`, result)
+	}
+	json.Unmarshal([]byte(`"explicit"`), &enum)
+	if ChannelCountMode.Explicit != enum {
+		t.Errorf("Expected %d, got %d", ChannelCountMode.Explicit, enum)
+	}
+
+	enum = ChannelCountMode.Max
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"max"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"max"`, result)
+	}
+	json.Unmarshal([]byte(`"max"`), &enum)
+	if ChannelCountMode.Max != enum {
+		t.Errorf("Expected %d, got %d", ChannelCountMode.Max, enum)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type channelInterpretationEnum struct {
+	Discrete ChannelInterpretationEnum
+	Speakers ChannelInterpretationEnum
+}
+
+/*
+ChannelInterpretation provides named access to the ChannelInterpretationEnum values.
+*/
+var ChannelInterpretation = channelInterpretationEnum{
+	Discrete: channelInterpretationDiscrete,
+	Speakers: channelInterpretationSpeakers,
+}
+
+/*
+ChannelInterpretationEnum represents the WebAudio.ChannelInterpretation values.
+
+Enum of AudioNode::ChannelInterpretation from the spec
+
+Allowed values:
+  - ChannelInterpretation.Discrete "discrete"
+  - ChannelInterpretation.Speakers "speakers"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ChannelInterpretation
+*/
+type ChannelInterpretationEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ChannelInterpretationEnum) String() string {
+	return _channelInterpretationEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ChannelInterpretationEnum) Unknown() bool {
+	return !_channelInterpretationEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ChannelInterpretationEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ChannelInterpretationEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _channelInterpretationEnums.Parse(val)
+	return nil
+}
+
+const (
+	// channelInterpretationDiscrete represents the "discrete" value.
+	channelInterpretationDiscrete ChannelInterpretationEnum = iota + 1
+	// channelInterpretationSpeakers represents the "speakers" value.
+	channelInterpretationSpeakers
+)
+
+var _channelInterpretationEnums = enums.New(map[ChannelInterpretationEnum]string{
+	ChannelInterpretationEnum(0):  "",
+	channelInterpretationDiscrete: "discrete",
+	channelInterpretationSpeakers: "speakers",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumChannelInterpretation(t *testing.T) {
+	var enum ChannelInterpretationEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ChannelInterpretationEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ChannelInterpretation.Discrete
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"discrete"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"discrete"`, result)
+	}
+	json.Unmarshal([]byte(`"discrete"`), &enum)
+	if ChannelInterpretation.Discrete != enum {
+		t.Errorf("Expected %d, got %d", ChannelInterpretation.Discrete, enum)
+	}
+
+	enum = ChannelInterpretation.Speakers
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"speakers"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"speakers"`, result)
+	}
+	json.Unmarshal([]byte(`"speakers"`), &enum)
+	if ChannelInterpretation.Speakers != enum {
+		t.Errorf("Expected %d, got %d", ChannelInterpretation.Speakers, enum)
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contextStateEnum struct {
+	Suspended   ContextStateEnum
+	Running     ContextStateEnum
+	Closed      ContextStateEnum
+	Interrupted ContextStateEnum
+}
+
+/*
+ContextState provides named access to the ContextStateEnum values.
+*/
+var ContextState = contextStateEnum{
+	Suspended:   contextStateSuspended,
+	Running:     contextStateRunning,
+	Closed:      contextStateClosed,
+	Interrupted: contextStateInterrupted,
+}
+
+/*
+ContextStateEnum represents the WebAudio.ContextState values.
+
+# Enum of AudioContextState from the spec
+
+Allowed values:
+  - ContextState.Suspended   "suspended"
+  - ContextState.Running     "running"
+  - ContextState.Closed      "closed"
+  - ContextState.Interrupted "interrupted"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ContextState
+*/
+type ContextStateEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContextStateEnum) String() string {
+	return _contextStateEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContextStateEnum) Unknown() bool {
+	return !_contextStateEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContextStateEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContextStateEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contextStateEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contextStateSuspended represents the "suspended" value.
+	contextStateSuspended ContextStateEnum = iota + 1
+	// contextStateRunning represents the "running" value.
+	contextStateRunning
+	// contextStateClosed represents the "closed" value.
+	contextStateClosed
+	// contextStateInterrupted represents the "interrupted" value.
+	contextStateInterrupted
+)
+
+var _contextStateEnums = enums.New(map[ContextStateEnum]string{
+	ContextStateEnum(0):     "",
+	contextStateSuspended:   "suspended",
+	contextStateRunning:     "running",
+	contextStateClosed:      "closed",
+	contextStateInterrupted: "interrupted",
+})
@@ -0,0 +1,95 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumContextState(t *testing.T) {
+	var enum ContextStateEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ContextStateEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ContextState.Suspended
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"suspended"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"suspended"`, result)
+	}
+	json.Unmarshal([]byte(`"suspended"`), &enum)
+	if ContextState.Suspended != enum {
+		t.Errorf("Expected %d, got %d", ContextState.Suspended, enum)
+	}
+
+	enum = ContextState.Running
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"running"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"running"`, result)
+	}
+	json.Unmarshal([]byte(`"running"`), &enum)
+	if ContextState.Running != enum {
+		t.Errorf("Expected %d, got %d", ContextState.Running, enum)
+	}
+
+	enum = ContextState.Closed
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"closed"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"closed"`, result)
+	}
+	json.Unmarshal([]byte(`"closed"`), &enum)
+	if ContextState.Closed != enum {
+		t.Errorf("Expected %d, got %d", ContextState.Closed, enum)
+	}
+
+	enum = ContextState.Interrupted
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"interrupted"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"interrupted"`, result)
+	}
+	json.Unmarshal([]byte(`"interrupted"`), &enum)
+	if ContextState.Interrupted != enum {
+		t.Errorf("Expected %d, got %d", ContextState.Interrupted, enum)
+	}
+}
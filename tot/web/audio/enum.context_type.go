@@ -0,0 +1,84 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type contextTypeEnum struct {
+	Realtime ContextTypeEnum
+	Offline  ContextTypeEnum
+}
+
+/*
+ContextType provides named access to the ContextTypeEnum values.
+*/
+var ContextType = contextTypeEnum{
+	Realtime: contextTypeRealtime,
+	Offline:  contextTypeOffline,
+}
+
+/*
+ContextTypeEnum represents the WebAudio.ContextType values.
+
+# Enum of BaseAudioContext types
+
+Allowed values:
+  - ContextType.Realtime "realtime"
+  - ContextType.Offline  "offline"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#type-ContextType
+*/
+type ContextTypeEnum int
+
+/*
+String implements Stringer
+*/
+func (enum ContextTypeEnum) String() string {
+	return _contextTypeEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum ContextTypeEnum) Unknown() bool {
+	return !_contextTypeEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum ContextTypeEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *ContextTypeEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _contextTypeEnums.Parse(val)
+	return nil
+}
+
+const (
+	// contextTypeRealtime represents the "realtime" value.
+	contextTypeRealtime ContextTypeEnum = iota + 1
+	// contextTypeOffline represents the "offline" value.
+	contextTypeOffline
+)
+
+var _contextTypeEnums = enums.New(map[ContextTypeEnum]string{
+	ContextTypeEnum(0):  "",
+	contextTypeRealtime: "realtime",
+	contextTypeOffline:  "offline",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumContextType(t *testing.T) {
+	var enum ContextTypeEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown ContextTypeEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = ContextType.Realtime
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"realtime"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"realtime"`, result)
+	}
+	json.Unmarshal([]byte(`"realtime"`), &enum)
+	if ContextType.Realtime != enum {
+		t.Errorf("Expected %d, got %d", ContextType.Realtime, enum)
+	}
+
+	enum = ContextType.Offline
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"offline"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"offline"`, result)
+	}
+	json.Unmarshal([]byte(`"offline"`), &enum)
+	if ContextType.Offline != enum {
+		t.Errorf("Expected %d, got %d", ContextType.Offline, enum)
+	}
+}
@@ -0,0 +1,201 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package audio
+
+/*
+ContextCreatedEvent represents WebAudio.contextCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextCreated
+*/
+type ContextCreatedEvent struct {
+	Context *BaseAudioContext `json:"context"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ContextWillBeDestroyedEvent represents WebAudio.contextWillBeDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextWillBeDestroyed
+*/
+type ContextWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ContextChangedEvent represents WebAudio.contextChanged event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-contextChanged
+*/
+type ContextChangedEvent struct {
+	Context *BaseAudioContext `json:"context"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ListenerCreatedEvent represents WebAudio.audioListenerCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioListenerCreated
+*/
+type ListenerCreatedEvent struct {
+	Listener *Listener `json:"listener"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ListenerWillBeDestroyedEvent represents WebAudio.audioListenerWillBeDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioListenerWillBeDestroyed
+*/
+type ListenerWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	ListenerID GraphObjectID `json:"listenerId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodeCreatedEvent represents WebAudio.audioNodeCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioNodeCreated
+*/
+type NodeCreatedEvent struct {
+	Node *Node `json:"node"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodeWillBeDestroyedEvent represents WebAudio.audioNodeWillBeDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioNodeWillBeDestroyed
+*/
+type NodeWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	NodeID GraphObjectID `json:"nodeId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ParamCreatedEvent represents WebAudio.audioParamCreated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioParamCreated
+*/
+type ParamCreatedEvent struct {
+	Param *Param `json:"param"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+ParamWillBeDestroyedEvent represents WebAudio.audioParamWillBeDestroyed event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-audioParamWillBeDestroyed
+*/
+type ParamWillBeDestroyedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	NodeID GraphObjectID `json:"nodeId"`
+
+	ParamID GraphObjectID `json:"paramId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodesConnectedEvent represents WebAudio.nodesConnected event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodesConnected
+*/
+type NodesConnectedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	SourceID GraphObjectID `json:"sourceId"`
+
+	DestinationID GraphObjectID `json:"destinationId"`
+
+	// Optional.
+	SourceOutputIndex float64 `json:"sourceOutputIndex,omitempty"`
+
+	// Optional.
+	DestinationInputIndex float64 `json:"destinationInputIndex,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodesDisconnectedEvent represents WebAudio.nodesDisconnected event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodesDisconnected
+*/
+type NodesDisconnectedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	SourceID GraphObjectID `json:"sourceId"`
+
+	DestinationID GraphObjectID `json:"destinationId"`
+
+	// Optional.
+	SourceOutputIndex float64 `json:"sourceOutputIndex,omitempty"`
+
+	// Optional.
+	DestinationInputIndex float64 `json:"destinationInputIndex,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodeParamConnectedEvent represents WebAudio.nodeParamConnected event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodeParamConnected
+*/
+type NodeParamConnectedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	SourceID GraphObjectID `json:"sourceId"`
+
+	DestinationID GraphObjectID `json:"destinationId"`
+
+	// Optional.
+	SourceOutputIndex float64 `json:"sourceOutputIndex,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+NodeParamDisconnectedEvent represents WebAudio.nodeParamDisconnected event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAudio/#event-nodeParamDisconnected
+*/
+type NodeParamDisconnectedEvent struct {
+	ContextID GraphObjectID `json:"contextId"`
+
+	SourceID GraphObjectID `json:"sourceId"`
+
+	DestinationID GraphObjectID `json:"destinationId"`
+
+	// Optional.
+	SourceOutputIndex float64 `json:"sourceOutputIndex,omitempty"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
@@ -0,0 +1,140 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+/*
+Package authn provides type definitions for use with the Chrome WebAuthn protocol
+
+This domain allows configuring virtual authenticators to test the WebAuthn API.
+EXPERIMENTAL.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/
+*/
+package authn
+
+/*
+AuthenticatorID represents the WebAuthn.AuthenticatorId type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-AuthenticatorId
+*/
+type AuthenticatorID string
+
+/*
+VirtualAuthenticatorOptions represents the WebAuthn.VirtualAuthenticatorOptions
+type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-VirtualAuthenticatorOptions
+*/
+type VirtualAuthenticatorOptions struct {
+	// Allowed values:
+	//   - AuthenticatorProtocol.U2f
+	//   - AuthenticatorProtocol.Ctap2
+	Protocol AuthenticatorProtocolEnum `json:"protocol"`
+
+	// Optional. Defaults to ctap2_0. Ignored if |protocol| == u2f. Allowed values:
+	//   - Ctap2Version.Ctap20
+	//   - Ctap2Version.Ctap21
+	Ctap2Version Ctap2VersionEnum `json:"ctap2Version,omitempty"`
+
+	// Allowed values:
+	//   - AuthenticatorTransport.Usb
+	//   - AuthenticatorTransport.Nfc
+	//   - AuthenticatorTransport.Ble
+	//   - AuthenticatorTransport.Cable
+	//   - AuthenticatorTransport.Internal
+	Transport AuthenticatorTransportEnum `json:"transport"`
+
+	// Optional. Defaults to false.
+	HasResidentKey bool `json:"hasResidentKey,omitempty"`
+
+	// Optional. Defaults to false.
+	HasUserVerification bool `json:"hasUserVerification,omitempty"`
+
+	// Optional. If set to true, the authenticator will support the largeBlob
+	// extension. https://w3c.github.io/webauthn#largeBlob Defaults to false.
+	HasLargeBlob bool `json:"hasLargeBlob,omitempty"`
+
+	// Optional. If set to true, the authenticator will support the credBlob
+	// extension.
+	// https://fidoalliance.org/specs/fido-v2.1-rd-20201208/fido-client-to-authenticator-protocol-v2.1-rd-20201208.html#sctn-credBlob-extension
+	// Defaults to false.
+	HasCredBlob bool `json:"hasCredBlob,omitempty"`
+
+	// Optional. If set to true, the authenticator will support the minPinLength
+	// extension.
+	// https://fidoalliance.org/specs/fido-v2.1-ps-20210615/fido-client-to-authenticator-protocol-v2.1-ps-20210615.html#sctn-minpinlength-extension
+	// Defaults to false.
+	HasMinPinLength bool `json:"hasMinPinLength,omitempty"`
+
+	// Optional. If set to true, the authenticator will support the prf extension.
+	// https://w3c.github.io/webauthn/#prf-extension Defaults to false.
+	HasPrf bool `json:"hasPrf,omitempty"`
+
+	// Optional. If set to true, tests of user presence will succeed immediately.
+	// Otherwise, they will not be resolved. Defaults to true.
+	AutomaticPresenceSimulation bool `json:"automaticPresenceSimulation,omitempty"`
+
+	// Optional. Sets whether User Verification succeeds or fails for an
+	// authenticator. Defaults to false.
+	IsUserVerified bool `json:"isUserVerified,omitempty"`
+
+	// Optional. Credentials created by this authenticator will have the backup
+	// eligibility (BE) flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupEligibility bool `json:"defaultBackupEligibility,omitempty"`
+
+	// Optional. Credentials created by this authenticator will have the backup
+	// state (BS) flag set to this value. Defaults to false.
+	// https://w3c.github.io/webauthn/#sctn-credential-backup
+	DefaultBackupState bool `json:"defaultBackupState,omitempty"`
+}
+
+/*
+Credential represents the WebAuthn.Credential type.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-Credential
+*/
+type Credential struct {
+	CredentialID string `json:"credentialId"`
+
+	IsResidentCredential bool `json:"isResidentCredential"`
+
+	// Optional. Relying Party ID the credential is scoped to. Must be set when
+	// adding a credential.
+	RpID string `json:"rpId,omitempty"`
+
+	// The ECDSA P-256 private key in PKCS#8 format. (Encoded as a base64 string
+	// when passed over JSON)
+	PrivateKey string `json:"privateKey"`
+
+	// Optional. An opaque byte sequence with a maximum size of 64 bytes mapping
+	// the credential to a specific user. (Encoded as a base64 string when passed
+	// over JSON)
+	UserHandle string `json:"userHandle,omitempty"`
+
+	// Signature counter. This is incremented by one for each successful assertion.
+	// See https://w3c.github.io/webauthn/#signature-counter
+	SignCount int `json:"signCount"`
+
+	// Optional. The large blob associated with the credential. See
+	// https://w3c.github.io/webauthn/#sctn-large-blob-extension (Encoded as a
+	// base64 string when passed over JSON)
+	LargeBlob string `json:"largeBlob,omitempty"`
+
+	// Optional. Assertions returned by this credential will have the backup
+	// eligibility (BE) flag set to this value. Defaults to the authenticator's
+	// defaultBackupEligibility value.
+	BackupEligibility bool `json:"backupEligibility,omitempty"`
+
+	// Optional. Assertions returned by this credential will have the backup state
+	// (BS) flag set to this value. Defaults to the authenticator's
+	// defaultBackupState value.
+	BackupState bool `json:"backupState,omitempty"`
+
+	// Optional. The credential's user.name property. Equivalent to empty if not
+	// set. https://w3c.github.io/webauthn/#dom-publickeycredentialentity-name
+	UserName string `json:"userName,omitempty"`
+
+	// Optional. The credential's user.displayName property. Equivalent to empty if
+	// not set.
+	// https://w3c.github.io/webauthn/#dom-publickeycredentialuserentity-displayname
+	UserDisplayName string `json:"userDisplayName,omitempty"`
+}
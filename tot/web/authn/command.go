@@ -0,0 +1,281 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+/*
+EnableParams represents WebAuthn.enable parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-enable
+*/
+type EnableParams struct {
+	// Optional. Whether to enable the WebAuthn user interface. Enabling the UI is
+	// recommended for debugging and demo purposes, as it is closer to the real
+	// experience. Disabling the UI is recommended for automated testing. Supported
+	// at the embedder's discretion if UI is available. Defaults to false.
+	EnableUI *bool `json:"enableUI,omitempty"`
+}
+
+/*
+EnableResult represents the result of calls to WebAuthn.enable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-enable
+*/
+type EnableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+DisableResult represents the result of calls to WebAuthn.disable.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-disable
+*/
+type DisableResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddVirtualAuthenticatorParams represents WebAuthn.addVirtualAuthenticator parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addVirtualAuthenticator
+*/
+type AddVirtualAuthenticatorParams struct {
+	Options *VirtualAuthenticatorOptions `json:"options"`
+}
+
+/*
+AddVirtualAuthenticatorResult represents the result of calls to WebAuthn.addVirtualAuthenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addVirtualAuthenticator
+*/
+type AddVirtualAuthenticatorResult struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetResponseOverrideBitsParams represents WebAuthn.setResponseOverrideBits parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setResponseOverrideBits
+*/
+type SetResponseOverrideBitsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	// Optional. If isBogusSignature is set, overrides the signature in the
+	// authenticator response to be zero. Defaults to false.
+	IsBogusSignature *bool `json:"isBogusSignature,omitempty"`
+
+	// Optional. If isBadUV is set, overrides the UV bit in the flags in the
+	// authenticator response to be zero. Defaults to false.
+	IsBadUV *bool `json:"isBadUV,omitempty"`
+
+	// Optional. If isBadUP is set, overrides the UP bit in the flags in the
+	// authenticator response to be zero. Defaults to false.
+	IsBadUP *bool `json:"isBadUP,omitempty"`
+}
+
+/*
+SetResponseOverrideBitsResult represents the result of calls to WebAuthn.setResponseOverrideBits.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setResponseOverrideBits
+*/
+type SetResponseOverrideBitsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveVirtualAuthenticatorParams represents WebAuthn.removeVirtualAuthenticator parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeVirtualAuthenticator
+*/
+type RemoveVirtualAuthenticatorParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+/*
+RemoveVirtualAuthenticatorResult represents the result of calls to WebAuthn.removeVirtualAuthenticator.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeVirtualAuthenticator
+*/
+type RemoveVirtualAuthenticatorResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+AddCredentialParams represents WebAuthn.addCredential parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addCredential
+*/
+type AddCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	Credential *Credential `json:"credential"`
+}
+
+/*
+AddCredentialResult represents the result of calls to WebAuthn.addCredential.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-addCredential
+*/
+type AddCredentialResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetCredentialParams represents WebAuthn.getCredential parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredential
+*/
+type GetCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	CredentialID string `json:"credentialId"`
+}
+
+/*
+GetCredentialResult represents the result of calls to WebAuthn.getCredential.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredential
+*/
+type GetCredentialResult struct {
+	Credential *Credential `json:"credential"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+GetCredentialsParams represents WebAuthn.getCredentials parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredentials
+*/
+type GetCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+/*
+GetCredentialsResult represents the result of calls to WebAuthn.getCredentials.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-getCredentials
+*/
+type GetCredentialsResult struct {
+	Credentials []*Credential `json:"credentials"`
+
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+RemoveCredentialParams represents WebAuthn.removeCredential parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeCredential
+*/
+type RemoveCredentialParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	CredentialID string `json:"credentialId"`
+}
+
+/*
+RemoveCredentialResult represents the result of calls to WebAuthn.removeCredential.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-removeCredential
+*/
+type RemoveCredentialResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+ClearCredentialsParams represents WebAuthn.clearCredentials parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-clearCredentials
+*/
+type ClearCredentialsParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+}
+
+/*
+ClearCredentialsResult represents the result of calls to WebAuthn.clearCredentials.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-clearCredentials
+*/
+type ClearCredentialsResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetUserVerifiedParams represents WebAuthn.setUserVerified parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setUserVerified
+*/
+type SetUserVerifiedParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	IsUserVerified bool `json:"isUserVerified"`
+}
+
+/*
+SetUserVerifiedResult represents the result of calls to WebAuthn.setUserVerified.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setUserVerified
+*/
+type SetUserVerifiedResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetAutomaticPresenceSimulationParams represents WebAuthn.setAutomaticPresenceSimulation parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setAutomaticPresenceSimulation
+*/
+type SetAutomaticPresenceSimulationParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	Enabled bool `json:"enabled"`
+}
+
+/*
+SetAutomaticPresenceSimulationResult represents the result of calls to WebAuthn.setAutomaticPresenceSimulation.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setAutomaticPresenceSimulation
+*/
+type SetAutomaticPresenceSimulationResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
+
+/*
+SetCredentialPropertiesParams represents WebAuthn.setCredentialProperties parameters.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setCredentialProperties
+*/
+type SetCredentialPropertiesParams struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	CredentialID string `json:"credentialId"`
+
+	// Optional.
+	BackupEligibility *bool `json:"backupEligibility,omitempty"`
+
+	// Optional.
+	BackupState *bool `json:"backupState,omitempty"`
+}
+
+/*
+SetCredentialPropertiesResult represents the result of calls to WebAuthn.setCredentialProperties.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#method-setCredentialProperties
+*/
+type SetCredentialPropertiesResult struct {
+	// Error information related to executing this method
+	Err error `json:"-"`
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type authenticatorProtocolEnum struct {
+	U2f   AuthenticatorProtocolEnum
+	Ctap2 AuthenticatorProtocolEnum
+}
+
+/*
+AuthenticatorProtocol provides named access to the AuthenticatorProtocolEnum values.
+*/
+var AuthenticatorProtocol = authenticatorProtocolEnum{
+	U2f:   authenticatorProtocolU2f,
+	Ctap2: authenticatorProtocolCtap2,
+}
+
+/*
+AuthenticatorProtocolEnum represents the WebAuthn.AuthenticatorProtocol values.
+
+Allowed values:
+  - AuthenticatorProtocol.U2f   "u2f"
+  - AuthenticatorProtocol.Ctap2 "ctap2"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-AuthenticatorProtocol
+*/
+type AuthenticatorProtocolEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AuthenticatorProtocolEnum) String() string {
+	return _authenticatorProtocolEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AuthenticatorProtocolEnum) Unknown() bool {
+	return !_authenticatorProtocolEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AuthenticatorProtocolEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AuthenticatorProtocolEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _authenticatorProtocolEnums.Parse(val)
+	return nil
+}
+
+const (
+	// authenticatorProtocolU2f represents the "u2f" value.
+	authenticatorProtocolU2f AuthenticatorProtocolEnum = iota + 1
+	// authenticatorProtocolCtap2 represents the "ctap2" value.
+	authenticatorProtocolCtap2
+)
+
+var _authenticatorProtocolEnums = enums.New(map[AuthenticatorProtocolEnum]string{
+	AuthenticatorProtocolEnum(0): "",
+	authenticatorProtocolU2f:     "u2f",
+	authenticatorProtocolCtap2:   "ctap2",
+})
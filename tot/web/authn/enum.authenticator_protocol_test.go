@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAuthenticatorProtocol(t *testing.T) {
+	var enum AuthenticatorProtocolEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AuthenticatorProtocolEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AuthenticatorProtocol.U2f
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"u2f"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"u2f"`, result)
+	}
+	json.Unmarshal([]byte(`"u2f"`), &enum)
+	if AuthenticatorProtocol.U2f != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorProtocol.U2f, enum)
+	}
+
+	enum = AuthenticatorProtocol.Ctap2
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ctap2"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ctap2"`, result)
+	}
+	json.Unmarshal([]byte(`"ctap2"`), &enum)
+	if AuthenticatorProtocol.Ctap2 != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorProtocol.Ctap2, enum)
+	}
+}
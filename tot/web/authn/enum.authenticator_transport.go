@@ -0,0 +1,101 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type authenticatorTransportEnum struct {
+	Usb      AuthenticatorTransportEnum
+	Nfc      AuthenticatorTransportEnum
+	Ble      AuthenticatorTransportEnum
+	Cable    AuthenticatorTransportEnum
+	Internal AuthenticatorTransportEnum
+}
+
+/*
+AuthenticatorTransport provides named access to the AuthenticatorTransportEnum values.
+*/
+var AuthenticatorTransport = authenticatorTransportEnum{
+	Usb:      authenticatorTransportUsb,
+	Nfc:      authenticatorTransportNfc,
+	Ble:      authenticatorTransportBle,
+	Cable:    authenticatorTransportCable,
+	Internal: authenticatorTransportInternal,
+}
+
+/*
+AuthenticatorTransportEnum represents the WebAuthn.AuthenticatorTransport
+values.
+
+Allowed values:
+  - AuthenticatorTransport.Usb      "usb"
+  - AuthenticatorTransport.Nfc      "nfc"
+  - AuthenticatorTransport.Ble      "ble"
+  - AuthenticatorTransport.Cable    "cable"
+  - AuthenticatorTransport.Internal "internal"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-AuthenticatorTransport
+*/
+type AuthenticatorTransportEnum int
+
+/*
+String implements Stringer
+*/
+func (enum AuthenticatorTransportEnum) String() string {
+	return _authenticatorTransportEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum AuthenticatorTransportEnum) Unknown() bool {
+	return !_authenticatorTransportEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum AuthenticatorTransportEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *AuthenticatorTransportEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _authenticatorTransportEnums.Parse(val)
+	return nil
+}
+
+const (
+	// authenticatorTransportUsb represents the "usb" value.
+	authenticatorTransportUsb AuthenticatorTransportEnum = iota + 1
+	// authenticatorTransportNfc represents the "nfc" value.
+	authenticatorTransportNfc
+	// authenticatorTransportBle represents the "ble" value.
+	authenticatorTransportBle
+	// authenticatorTransportCable represents the "cable" value.
+	authenticatorTransportCable
+	// authenticatorTransportInternal represents the "internal" value.
+	authenticatorTransportInternal
+)
+
+var _authenticatorTransportEnums = enums.New(map[AuthenticatorTransportEnum]string{
+	AuthenticatorTransportEnum(0):  "",
+	authenticatorTransportUsb:      "usb",
+	authenticatorTransportNfc:      "nfc",
+	authenticatorTransportBle:      "ble",
+	authenticatorTransportCable:    "cable",
+	authenticatorTransportInternal: "internal",
+})
@@ -0,0 +1,108 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumAuthenticatorTransport(t *testing.T) {
+	var enum AuthenticatorTransportEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown AuthenticatorTransportEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = AuthenticatorTransport.Usb
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"usb"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"usb"`, result)
+	}
+	json.Unmarshal([]byte(`"usb"`), &enum)
+	if AuthenticatorTransport.Usb != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorTransport.Usb, enum)
+	}
+
+	enum = AuthenticatorTransport.Nfc
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"nfc"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"nfc"`, result)
+	}
+	json.Unmarshal([]byte(`"nfc"`), &enum)
+	if AuthenticatorTransport.Nfc != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorTransport.Nfc, enum)
+	}
+
+	enum = AuthenticatorTransport.Ble
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ble"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ble"`, result)
+	}
+	json.Unmarshal([]byte(`"ble"`), &enum)
+	if AuthenticatorTransport.Ble != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorTransport.Ble, enum)
+	}
+
+	enum = AuthenticatorTransport.Cable
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"cable"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"cable"`, result)
+	}
+	json.Unmarshal([]byte(`"cable"`), &enum)
+	if AuthenticatorTransport.Cable != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorTransport.Cable, enum)
+	}
+
+	enum = AuthenticatorTransport.Internal
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"internal"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"internal"`, result)
+	}
+	json.Unmarshal([]byte(`"internal"`), &enum)
+	if AuthenticatorTransport.Internal != enum {
+		t.Errorf("Expected %d, got %d", AuthenticatorTransport.Internal, enum)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+
+	"github.com/mkenney/go-chrome/enums"
+)
+
+type ctap2VersionEnum struct {
+	Ctap20 Ctap2VersionEnum
+	Ctap21 Ctap2VersionEnum
+}
+
+/*
+Ctap2Version provides named access to the Ctap2VersionEnum values.
+*/
+var Ctap2Version = ctap2VersionEnum{
+	Ctap20: ctap2VersionCtap20,
+	Ctap21: ctap2VersionCtap21,
+}
+
+/*
+Ctap2VersionEnum represents the WebAuthn.Ctap2Version values.
+
+Allowed values:
+  - Ctap2Version.Ctap20 "ctap2_0"
+  - Ctap2Version.Ctap21 "ctap2_1"
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#type-Ctap2Version
+*/
+type Ctap2VersionEnum int
+
+/*
+String implements Stringer
+*/
+func (enum Ctap2VersionEnum) String() string {
+	return _ctap2VersionEnums.String(enum)
+}
+
+/*
+Unknown returns true if the value was decoded from a string that is not an
+allowed value, for example a value added in a newer Chrome version. Unknown
+values keep their string and are encoded unchanged.
+*/
+func (enum Ctap2VersionEnum) Unknown() bool {
+	return !_ctap2VersionEnums.Known(enum)
+}
+
+/*
+MarshalJSON implements json.Marshaler
+*/
+func (enum Ctap2VersionEnum) MarshalJSON() ([]byte, error) {
+	return json.Marshal(enum.String())
+}
+
+/*
+UnmarshalJSON implements json.Unmarshaler. Strings that are not allowed values
+are kept as unknown values rather than returning an error.
+*/
+func (enum *Ctap2VersionEnum) UnmarshalJSON(bytes []byte) error {
+	var val string
+	if err := json.Unmarshal(bytes, &val); nil != err {
+		return err
+	}
+	*enum = _ctap2VersionEnums.Parse(val)
+	return nil
+}
+
+const (
+	// ctap2VersionCtap20 represents the "ctap2_0" value.
+	ctap2VersionCtap20 Ctap2VersionEnum = iota + 1
+	// ctap2VersionCtap21 represents the "ctap2_1" value.
+	ctap2VersionCtap21
+)
+
+var _ctap2VersionEnums = enums.New(map[Ctap2VersionEnum]string{
+	Ctap2VersionEnum(0): "",
+	ctap2VersionCtap20:  "ctap2_0",
+	ctap2VersionCtap21:  "ctap2_1",
+})
@@ -0,0 +1,69 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumCtap2Version(t *testing.T) {
+	var enum Ctap2VersionEnum
+	var err error
+	var result []byte
+
+	err = json.Unmarshal([]byte(`""`), &enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+
+	var unknown Ctap2VersionEnum
+	err = json.Unmarshal([]byte(`"invalid value"`), &unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if !unknown.Unknown() {
+		t.Errorf("Expected an unknown value")
+	}
+	result, err = json.Marshal(unknown)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"invalid value"` != string(result) {
+		t.Errorf("Expected '\"invalid value\"', got '%s'", result)
+	}
+
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `""` != string(result) {
+		t.Errorf("Expected empty JSON string, got '%s'", result)
+	}
+
+	enum = Ctap2Version.Ctap20
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ctap2_0"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ctap2_0"`, result)
+	}
+	json.Unmarshal([]byte(`"ctap2_0"`), &enum)
+	if Ctap2Version.Ctap20 != enum {
+		t.Errorf("Expected %d, got %d", Ctap2Version.Ctap20, enum)
+	}
+
+	enum = Ctap2Version.Ctap21
+	result, err = json.Marshal(enum)
+	if nil != err {
+		t.Errorf("Expected nil, got error")
+	}
+	if `"ctap2_1"` != string(result) {
+		t.Errorf("Expected '%s', got '%s'", `"ctap2_1"`, result)
+	}
+	json.Unmarshal([]byte(`"ctap2_1"`), &enum)
+	if Ctap2Version.Ctap21 != enum {
+		t.Errorf("Expected %d, got %d", Ctap2Version.Ctap21, enum)
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by cdtpgen. DO NOT EDIT.
+
+package authn
+
+/*
+CredentialAddedEvent represents WebAuthn.credentialAdded event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialAdded
+*/
+type CredentialAddedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	Credential *Credential `json:"credential"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CredentialDeletedEvent represents WebAuthn.credentialDeleted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialDeleted
+*/
+type CredentialDeletedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	CredentialID string `json:"credentialId"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CredentialUpdatedEvent represents WebAuthn.credentialUpdated event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialUpdated
+*/
+type CredentialUpdatedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	Credential *Credential `json:"credential"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}
+
+/*
+CredentialAssertedEvent represents WebAuthn.credentialAsserted event data.
+
+https://chromedevtools.github.io/devtools-protocol/tot/WebAuthn/#event-credentialAsserted
+*/
+type CredentialAssertedEvent struct {
+	AuthenticatorID AuthenticatorID `json:"authenticatorId"`
+
+	Credential *Credential `json:"credential"`
+
+	// Error information related to this event
+	Err error `json:"-"`
+}